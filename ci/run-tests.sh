@@ -126,6 +126,21 @@ if docker compose up --abort-on-container-exit --exit-code-from test-runner test
   echo -e "${GREEN}✓ Integration tests passed!${NC}"
   echo ""
 
+  # Run Terraform provider acceptance tests
+  echo -e "${YELLOW}Starting Terraform provider acceptance tests...${NC}"
+  echo ""
+
+  if docker compose up --abort-on-container-exit --exit-code-from provider-tests provider-tests; then
+    echo ""
+    echo -e "${GREEN}✓ Provider acceptance tests passed!${NC}"
+    EXIT_CODE=0
+  else
+    echo ""
+    echo -e "${RED}✗ Provider acceptance tests failed!${NC}"
+    EXIT_CODE=1
+  fi
+  echo ""
+
   # Run dashboard E2E tests
   echo -e "${YELLOW}Starting dashboard E2E tests...${NC}"
   echo ""
@@ -133,7 +148,6 @@ if docker compose up --abort-on-container-exit --exit-code-from test-runner test
   if docker compose up --abort-on-container-exit --exit-code-from dash-tests dash-tests; then
     echo ""
     echo -e "${GREEN}✓ Dashboard E2E tests passed!${NC}"
-    EXIT_CODE=0
   else
     echo ""
     echo -e "${RED}✗ Dashboard E2E tests failed!${NC}"
@@ -143,7 +157,7 @@ if docker compose up --abort-on-container-exit --exit-code-from test-runner test
 else
   echo ""
   echo -e "${RED}✗ Integration tests failed!${NC}"
-  echo -e "${YELLOW}Skipping dashboard E2E tests due to integration test failure${NC}"
+  echo -e "${YELLOW}Skipping provider and dashboard E2E tests due to integration test failure${NC}"
   EXIT_CODE=1
 fi
 
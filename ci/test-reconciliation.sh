@@ -71,10 +71,10 @@ insert_test_event() {
   echo ""
   echo "Select event type:"
   echo "  1) SSH key created (site-level)"
-  echo "  2) Site member created (site-level)"
+  echo "  2) Site member added (site-level)"
   echo "  3) Site secret updated (site-level)"
-  echo "  4) Organization firewall created (org-level)"
-  echo "  5) Project firewall created (project-level)"
+  echo "  4) Organization firewall rule added (org-level)"
+  echo "  5) Project firewall rule added (project-level)"
   read -p "Enter choice [1-5]: " choice
 
   EVENT_ID="test-evt-manual-$(date +%s)"
@@ -82,30 +82,35 @@ insert_test_event() {
   case $choice in
     1)
       EVENT_TYPE="io.libops.ssh_key.created.v1"
+      EVENT_TYPE_NUMBER=0D # libops.v1.events.EventType, in hex
       ORG_ID=2
       PROJ_ID=2
       SITE_ID=1
       ;;
     2)
-      EVENT_TYPE="io.libops.site_member.created.v1"
+      EVENT_TYPE="io.libops.site.member.added.v1"
+      EVENT_TYPE_NUMBER=25 # libops.v1.events.EventType, in hex
       ORG_ID=2
       PROJ_ID=2
       SITE_ID=1
       ;;
     3)
-      EVENT_TYPE="io.libops.site_secret.updated.v1"
+      EVENT_TYPE="io.libops.site.secret.updated.v1"
+      EVENT_TYPE_NUMBER=2B # libops.v1.events.EventType, in hex
       ORG_ID=2
       PROJ_ID=2
       SITE_ID=1
       ;;
     4)
-      EVENT_TYPE="io.libops.organization_firewall.created.v1"
+      EVENT_TYPE="io.libops.organization.firewall_rule.added.v1"
+      EVENT_TYPE_NUMBER=12 # libops.v1.events.EventType, in hex
       ORG_ID=2
       PROJ_ID=NULL
       SITE_ID=NULL
       ;;
     5)
-      EVENT_TYPE="io.libops.project_firewall.created.v1"
+      EVENT_TYPE="io.libops.project.firewall_rule.added.v1"
+      EVENT_TYPE_NUMBER=1D # libops.v1.events.EventType, in hex
       ORG_ID=2
       PROJ_ID=2
       SITE_ID=NULL
@@ -116,6 +121,7 @@ insert_test_event() {
       ;;
   esac
 
+  # A libops.v1.events.Envelope: schema_version 1 (08 01) and the event type (10 <number>)
   SQL="INSERT INTO event_queue (
     event_id, event_type, event_source, event_subject,
     event_data, content_type,
@@ -126,8 +132,8 @@ insert_test_event() {
     '$EVENT_TYPE',
     'manual-test',
     'test/manual',
-    '',
-    'application/protobuf',
+    UNHEX('0801${EVENT_TYPE_NUMBER}'),
+    'application/protobuf; proto=libops.v1.events.Envelope',
     $ORG_ID, $PROJ_ID, $SITE_ID,
     'pending',
     NOW()
@@ -345,17 +345,6 @@ def generate_vault_init():
 
     # Common setup
     lines.append("""
-# Helper to enable secrets engine if not already enabled
-enable_secrets() {
-    path=$1
-    if vault secrets list | grep -q \"^$path/\" ; then
-        echo "Secrets engine at $path/ already enabled"
-    else
-        vault secrets enable -path=$path -version=1 kv
-        echo "Enabled $path KV engine"
-    fi
-}
-
 # Create a token role that allows the API to create entity tokens with specific policies
 vault write auth/token/roles/entity-token \
     allowed_policies="default,libops-user" \
@@ -364,10 +353,8 @@ vault write auth/token/roles/entity-token \
     renewable=true \
     token_type="service"
 
-# Enable KV v2 secrets engine for organization secrets
-enable_secrets \"secret-organization\"
-enable_secrets \"secret-project\"
-enable_secrets \"secret-site\"
+# Organization secrets engines, policy and group are provisioned by the API
+# the first time it opens an organization's Vault
 
 # Enable KV v1 secrets engine for API keys (application expects v1 at 'keys/')
 if vault secrets list | grep -q \"^keys/\" ; then
@@ -0,0 +1,54 @@
+path "keys/*" {
+  capabilities = ["create", "read", "update", "delete", "list"]
+}
+
+path "identity/oidc/client/libops-api" {
+  capabilities = [ "read" ]
+}
+
+path "identity/entity" {
+  capabilities = [ "create", "update" ]
+}
+
+path "identity/entity/id/*" {
+  capabilities = [ "create", "read", "update", "delete" ]
+}
+
+path "identity/entity-alias" {
+  capabilities = [ "create", "update" ]
+}
+
+path "identity/entity-alias/id/*" {
+  capabilities = [ "read", "update", "delete", "list" ]
+}
+
+path "auth/userpass/users/*" {
+  capabilities = [ "create", "read", "update", "delete", "list" ]
+}
+
+path "auth/token/create/entity-token" {
+  capabilities = [ "create", "update"]
+}
+
+path "sys/auth" {
+  capabilities = ["read", "list"]
+}
+
+path "secret/libops-api" {
+  capabilities = ["read", "list"]
+}
+
+path "secret/libops-api/*" {
+  capabilities = ["read"]
+}
+
+# CI keeps customer secrets in the API's own Vault
+path "secret-organization/*" {
+  capabilities = ["create", "update", "read", "delete", "list"]
+}
+path "secret-project/*" {
+  capabilities = ["create", "update", "read", "delete", "list"]
+}
+path "secret-site/*" {
+  capabilities = ["create", "update", "read", "delete", "list"]
+}
@@ -1,121 +1,10 @@
 #!/usr/bin/env bash
 set -eou pipefail
-echo "Initializing Vault with AUTO-GENERATED data..."
+echo "Loading Vault test fixtures..."
 
 
-# Helper to enable secrets engine if not already enabled
-enable_secrets() {
-    path=$1
-    if vault secrets list | grep -q "^$path/" ; then
-        echo "Secrets engine at $path/ already enabled"
-    else
-        vault secrets enable -path=$path -version=1 kv
-        echo "Enabled $path KV engine"
-    fi
-}
-
-# Create a token role that allows the API to create entity tokens with specific policies
-vault write auth/token/roles/entity-token     allowed_policies="default,libops-user"     allowed_entity_aliases="*"     orphan=true     renewable=true     token_type="service"
-
-# Enable KV v2 secrets engine for organization secrets
-enable_secrets "secret-organization"
-enable_secrets "secret-project"
-enable_secrets "secret-site"
-
-# Enable KV v1 secrets engine for API keys (application expects v1 at 'keys/')
-if vault secrets list | grep -q "^keys/" ; then
-    echo "Secrets engine at keys/ already enabled"
-else
-    vault secrets enable -path=keys -version=1 kv
-    echo "Enabled keys KV v1 engine"
-fi
-
-# Enable userpass auth method
-if vault auth list | grep -q "^userpass/" ; then
-    echo "Auth method userpass/ already enabled"
-else
-    vault auth enable userpass
-    echo "Enabled userpass auth method"
-fi
-
-# Configure OIDC Provider
-echo "Configuring OIDC Provider..."
-vault write identity/oidc/key/libops-api allowed_client_ids='*' verification_ttl='2h' rotation_period='24h' algorithm='RS256'
-vault write identity/oidc/client/libops-api redirect_uris='http://api:8080/auth/callback' key='libops-api' id_token_ttl='30m' access_token_ttl='1h'
-vault write identity/oidc/provider/libops-api allowed_client_ids='*' scopes='openid,email,profile' issuer_host='http://vault:8200'
-vault write identity/oidc/role/libops-api key='libops-api' template='{"account_id": {{identity.entity.metadata.account_id}},"email": {{identity.entity.metadata.email}},"name": {{identity.entity.name}}}' ttl='1h'
-
-# Create libops-user policy
-vault policy write libops-user - <<EOF
-path "identity/oidc/token/libops-api" {
-  capabilities = ["read", "update"]
-}
-
-# Allow users to manage their own API keys using ACL templating
-# The account_uuid metadata is set on the entity in lowercase no-dashes format
-path "keys/{{identity.entity.metadata.account_uuid}}/*" {
-  capabilities = ["create", "read", "update", "delete", "list"]
-}
-
-
-EOF
-
-vault policy write api - <<EOF
-path "keys/*" {
-  capabilities = ["create", "read", "update", "delete", "list"]
-}
-
-path "identity/oidc/client/libops-api" {
-  capabilities = [ "read" ]
-}
-
-path "identity/entity" {
-  capabilities = [ "create", "update" ]
-}
-
-path "identity/entity/id/*" {
-  capabilities = [ "create", "read", "update", "delete" ]
-}
-
-path "identity/entity-alias" {
-  capabilities = [ "create", "update" ]
-}
-
-path "identity/entity-alias/id/*" {
-  capabilities = [ "read", "update", "delete", "list" ]
-}
-
-path "auth/userpass/users/*" {
-  capabilities = [ "create", "read", "update", "delete", "list" ]
-}
-
-path "auth/token/create/entity-token" {
-  capabilities = [ "create", "update"]
-}
-
-path "sys/auth" {
-  capabilities = ["read", "list"]
-}
-
-path "secret/libops-api" {
-  capabilities = ["read", "list"]
-}
-
-path "secret/libops-api/*" {
-  capabilities = ["read"]
-}
-
-
-path "secret-organization/*" {
-  capabilities = ["create", "update", "read", "delete", "list"]
-}
-path "secret-project/*" {
-  capabilities = ["create", "update", "read", "delete", "list"]
-}
-path "secret-site/*" {
-  capabilities = ["create", "update", "read", "delete", "list"]
-}
-EOF
+# Mounts, auth methods, the OIDC provider and policies are set up by
+# cmd/bootstrap in the vault-bootstrap service; this adds the test accounts
 
 create_test_user() {
     email=$1
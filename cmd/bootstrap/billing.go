@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/price"
+
+	"github.com/libops/api/db"
+)
+
+// catalogMachine is a machine type customers can pick, priced monthly
+type catalogMachine struct {
+	machineType string
+	displayName string
+	vcpu        int32
+	memoryGiB   int32
+	priceCents  int32
+}
+
+// machineCatalog matches the plans on the onboarding page
+var machineCatalog = []catalogMachine{
+	{"e2-medium", "Small", 1, 4, 12500},
+	{"n4-standard-2", "Medium", 2, 8, 29000},
+	{"n4-standard-4", "Large", 4, 16, 55000},
+	{"n4-standard-8", "XLarge", 8, 32, 100000},
+}
+
+// Disk storage is billed per GB a month
+const (
+	storagePriceCents = 10
+	storageMinGB      = 10
+	storageMaxGB      = 2000
+)
+
+// storageLookupKey is the Stripe lookup key of the disk storage price
+const storageLookupKey = "libops_disk_storage_gb"
+
+// machineLookupKey is the Stripe lookup key of a machine type's price
+func machineLookupKey(machineType string) string {
+	return "libops_machine_" + machineType
+}
+
+// provisionStripe creates a product and monthly price for every machine type
+// and for disk storage. Prices are found again by lookup key; one whose
+// amount changed is replaced by a new price that takes over the key.
+func (b *bootstrapper) provisionStripe(ctx context.Context) error {
+	stripe.Key = os.Getenv("STRIPE_SECRET_KEY")
+	if stripe.Key == "" {
+		return errors.New("STRIPE_SECRET_KEY is required")
+	}
+	b.prices = map[string]string{}
+	for _, m := range machineCatalog {
+		id, err := ensureStripePrice(machineLookupKey(m.machineType), "LibOps "+m.displayName+" ("+m.machineType+")", int64(m.priceCents))
+		if err != nil {
+			return err
+		}
+		b.prices[machineLookupKey(m.machineType)] = id
+	}
+	id, err := ensureStripePrice(storageLookupKey, "LibOps disk storage (per GB)", storagePriceCents)
+	if err != nil {
+		return err
+	}
+	b.prices[storageLookupKey] = id
+	return nil
+}
+
+func ensureStripePrice(lookupKey, productName string, cents int64) (string, error) {
+	it := price.List(&stripe.PriceListParams{LookupKeys: stripe.StringSlice([]string{lookupKey})})
+	var existing *stripe.Price
+	for it.Next() {
+		existing = it.Price()
+	}
+	if err := it.Err(); err != nil {
+		return "", fmt.Errorf("failed to look up price %s: %w", lookupKey, err)
+	}
+	if existing != nil && existing.Active && existing.UnitAmount == cents {
+		fmt.Printf("  - %s: %s\n", lookupKey, existing.ID)
+		return existing.ID, nil
+	}
+
+	params := &stripe.PriceParams{
+		Currency:          stripe.String(string(stripe.CurrencyUSD)),
+		UnitAmount:        stripe.Int64(cents),
+		Recurring:         &stripe.PriceRecurringParams{Interval: stripe.String(string(stripe.PriceRecurringIntervalMonth))},
+		LookupKey:         stripe.String(lookupKey),
+		TransferLookupKey: stripe.Bool(true),
+	}
+	if existing != nil && existing.Product != nil {
+		params.Product = stripe.String(existing.Product.ID)
+	} else {
+		params.ProductData = &stripe.PriceProductDataParams{Name: stripe.String(productName)}
+	}
+	created, err := price.New(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to create price %s: %w", lookupKey, err)
+	}
+	fmt.Printf("  ✓ %s: %s\n", lookupKey, created.ID)
+	return created.ID, nil
+}
+
+// provisionSettings writes the machine catalog and disk storage settings
+func (b *bootstrapper) provisionSettings(ctx context.Context) error {
+	queries, err := b.database()
+	if err != nil {
+		return err
+	}
+	return seedSettings(ctx, queries, b.prices)
+}
+
+// seedSettings creates or updates the machine types and storage config with
+// the Stripe prices by lookup key. Without a price, settings keep the one they
+// have, or get a placeholder that works with DISABLE_BILLING.
+func seedSettings(ctx context.Context, queries db.Querier, prices map[string]string) error {
+	existing, err := queries.ListAllMachineTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list machine types: %w", err)
+	}
+	byType := map[string]db.MachineType{}
+	for _, mt := range existing {
+		byType[mt.MachineType] = mt
+	}
+
+	active := sql.NullBool{Bool: true, Valid: true}
+	for _, m := range machineCatalog {
+		current, ok := byType[m.machineType]
+		priceID := prices[machineLookupKey(m.machineType)]
+		if priceID == "" {
+			priceID = current.StripePriceID
+		}
+		if priceID == "" {
+			priceID = "unbilled_" + machineLookupKey(m.machineType)
+		}
+
+		if !ok {
+			err := queries.CreateMachineType(ctx, db.CreateMachineTypeParams{
+				MachineType:       m.machineType,
+				DisplayName:       m.displayName,
+				Vcpu:              m.vcpu,
+				MemoryGib:         m.memoryGiB,
+				StripePriceID:     priceID,
+				MonthlyPriceCents: m.priceCents,
+				Active:            active,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create machine type %s: %w", m.machineType, err)
+			}
+			fmt.Printf("  ✓ Created machine type %s\n", m.machineType)
+			continue
+		}
+		if current.DisplayName == m.displayName && current.Vcpu == m.vcpu && current.MemoryGib == m.memoryGiB &&
+			current.StripePriceID == priceID && current.MonthlyPriceCents == m.priceCents && current.Active == active {
+			fmt.Printf("  - Machine type %s is current\n", m.machineType)
+			continue
+		}
+		err := queries.UpdateMachineType(ctx, db.UpdateMachineTypeParams{
+			DisplayName:       m.displayName,
+			Vcpu:              m.vcpu,
+			MemoryGib:         m.memoryGiB,
+			StripePriceID:     priceID,
+			MonthlyPriceCents: m.priceCents,
+			Active:            active,
+			MachineType:       m.machineType,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update machine type %s: %w", m.machineType, err)
+		}
+		fmt.Printf("  ✓ Updated machine type %s\n", m.machineType)
+	}
+
+	storagePriceID := prices[storageLookupKey]
+	if storagePriceID == "" {
+		current, err := queries.GetStorageConfig(ctx)
+		switch {
+		case err == nil:
+			storagePriceID = current.StripePriceID
+		case errors.Is(err, sql.ErrNoRows):
+			storagePriceID = "unbilled_" + storageLookupKey
+		default:
+			return fmt.Errorf("failed to get storage config: %w", err)
+		}
+	}
+	err = queries.UpsertStorageConfig(ctx, db.UpsertStorageConfigParams{
+		StripePriceID:   storagePriceID,
+		PricePerGbCents: storagePriceCents,
+		MinSizeGb:       storageMinGB,
+		MaxSizeGb:       storageMaxGB,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write storage config: %w", err)
+	}
+	fmt.Printf("  ✓ Disk storage: %d¢/GB, %d-%d GB\n", storagePriceCents, storageMinGB, storageMaxGB)
+	return nil
+}
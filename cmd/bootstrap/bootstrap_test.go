@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// fakeStore keeps what bootstrap writes and counts the writes
+type fakeStore struct {
+	*testutils.MockQuerier
+	accounts map[string]db.GetAccountByEmailRow
+	orgs     map[int64]db.GetOrganizationByIDRow
+	members  map[[2]int64]bool
+	machines map[string]db.MachineType
+	storage  *db.StorageConfig
+	writes   int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		MockQuerier: &testutils.MockQuerier{},
+		accounts:    map[string]db.GetAccountByEmailRow{},
+		orgs:        map[int64]db.GetOrganizationByIDRow{},
+		members:     map[[2]int64]bool{},
+		machines:    map[string]db.MachineType{},
+	}
+}
+
+func (f *fakeStore) GetAccountByEmail(ctx context.Context, email string) (db.GetAccountByEmailRow, error) {
+	account, ok := f.accounts[email]
+	if !ok {
+		return account, sql.ErrNoRows
+	}
+	return account, nil
+}
+
+func (f *fakeStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) error {
+	f.writes++
+	f.accounts[arg.Email] = db.GetAccountByEmailRow{ID: int64(len(f.accounts) + 1), PublicID: uuid.NewString(), Email: arg.Email, AuthMethod: arg.AuthMethod, Verified: arg.Verified}
+	return nil
+}
+
+func (f *fakeStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) error {
+	f.writes++
+	account := f.accounts[arg.Email]
+	account.VaultEntityID = arg.VaultEntityID
+	f.accounts[arg.Email] = account
+	return nil
+}
+
+func (f *fakeStore) GetOrganizationByID(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+	org, ok := f.orgs[id]
+	if !ok {
+		return org, sql.ErrNoRows
+	}
+	return org, nil
+}
+
+func (f *fakeStore) CreateOrganization(ctx context.Context, arg db.CreateOrganizationParams) error {
+	f.writes++
+	id := int64(len(f.orgs) + 1)
+	f.orgs[id] = db.GetOrganizationByIDRow{ID: id, PublicID: arg.PublicID, Name: arg.Name}
+	return nil
+}
+
+func (f *fakeStore) GetOrganization(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+	for _, org := range f.orgs {
+		if org.PublicID == publicID {
+			return db.GetOrganizationRow{ID: org.ID, PublicID: org.PublicID, Name: org.Name}, nil
+		}
+	}
+	return db.GetOrganizationRow{}, sql.ErrNoRows
+}
+
+func (f *fakeStore) GetOrganizationMemberByAccountAndOrganization(ctx context.Context, arg db.GetOrganizationMemberByAccountAndOrganizationParams) (db.OrganizationMember, error) {
+	if !f.members[[2]int64{arg.OrganizationID, arg.AccountID}] {
+		return db.OrganizationMember{}, sql.ErrNoRows
+	}
+	return db.OrganizationMember{OrganizationID: arg.OrganizationID, AccountID: arg.AccountID}, nil
+}
+
+func (f *fakeStore) CreateOrganizationMember(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+	f.writes++
+	f.members[[2]int64{arg.OrganizationID, arg.AccountID}] = true
+	return nil
+}
+
+func (f *fakeStore) ListAllMachineTypes(ctx context.Context) ([]db.MachineType, error) {
+	var machines []db.MachineType
+	for _, mt := range f.machines {
+		machines = append(machines, mt)
+	}
+	return machines, nil
+}
+
+func (f *fakeStore) CreateMachineType(ctx context.Context, arg db.CreateMachineTypeParams) error {
+	f.writes++
+	f.machines[arg.MachineType] = db.MachineType{MachineType: arg.MachineType, DisplayName: arg.DisplayName, Vcpu: arg.Vcpu, MemoryGib: arg.MemoryGib, StripePriceID: arg.StripePriceID, MonthlyPriceCents: arg.MonthlyPriceCents, Active: arg.Active}
+	return nil
+}
+
+func (f *fakeStore) UpdateMachineType(ctx context.Context, arg db.UpdateMachineTypeParams) error {
+	f.writes++
+	f.machines[arg.MachineType] = db.MachineType{MachineType: arg.MachineType, DisplayName: arg.DisplayName, Vcpu: arg.Vcpu, MemoryGib: arg.MemoryGib, StripePriceID: arg.StripePriceID, MonthlyPriceCents: arg.MonthlyPriceCents, Active: arg.Active}
+	return nil
+}
+
+func (f *fakeStore) GetStorageConfig(ctx context.Context) (db.StorageConfig, error) {
+	if f.storage == nil {
+		return db.StorageConfig{}, sql.ErrNoRows
+	}
+	return *f.storage, nil
+}
+
+func (f *fakeStore) UpsertStorageConfig(ctx context.Context, arg db.UpsertStorageConfigParams) error {
+	f.storage = &db.StorageConfig{ConfigKey: "disk_storage", StripePriceID: arg.StripePriceID, PricePerGbCents: arg.PricePerGbCents, MinSizeGb: arg.MinSizeGb, MaxSizeGb: arg.MaxSizeGb}
+	return nil
+}
+
+// fakeVault keeps userpass users and entities
+type fakeVault struct {
+	users    map[string]string
+	entities map[string]map[string]string
+	aliases  int
+}
+
+func (v *fakeVault) EnsureUserpassUser(ctx context.Context, mountPath, username, password string, policies []string) error {
+	v.users[username] = password
+	return nil
+}
+
+func (v *fakeVault) GetEntityIDByName(ctx context.Context, name string) (string, error) {
+	if _, ok := v.entities[name]; !ok {
+		return "", nil
+	}
+	return "entity-" + name, nil
+}
+
+func (v *fakeVault) CreateEntity(ctx context.Context, name string, metadata map[string]string, policies []string) (string, error) {
+	v.entities[name] = metadata
+	return "entity-" + name, nil
+}
+
+func (v *fakeVault) GetAuthMountAccessor(ctx context.Context, mountType string) (string, error) {
+	return "auth_userpass_1234", nil
+}
+
+func (v *fakeVault) CreateEntityAlias(ctx context.Context, entityID, mountAccessor, name string) error {
+	v.aliases++
+	return nil
+}
+
+// TestEnsureRoot tests that the admin, root organization and membership are
+// created once, and that running again changes nothing but the password.
+func TestEnsureRoot(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeStore()
+	accounts := &fakeVault{users: map[string]string{}, entities: map[string]map[string]string{}}
+	o := options{rootOrgID: 1, rootOrgName: "libops", adminEmail: "admin@libops.io", adminName: "Admin", adminAuth: "userpass"}
+
+	_, err := ensureRoot(ctx, store, accounts, o, "")
+	assert.Error(t, err, "a userpass admin needs a password")
+
+	org, err := ensureRoot(ctx, store, accounts, o, "hunter2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), org.ID)
+	assert.Equal(t, "libops", org.Name)
+	admin := store.accounts["admin@libops.io"]
+	assert.True(t, store.members[[2]int64{org.ID, admin.ID}])
+	assert.Equal(t, "entity-admin@libops.io", admin.VaultEntityID.String)
+	assert.Equal(t, "1", accounts.entities["admin@libops.io"]["account_id"])
+	assert.Equal(t, "hunter2", accounts.users["admin_libops.io"])
+	assert.Equal(t, 1, accounts.aliases)
+	writes := store.writes
+
+	again, err := ensureRoot(ctx, store, accounts, o, "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, org.PublicID, again.PublicID)
+	assert.Equal(t, writes, store.writes, "nothing is written twice")
+	assert.Equal(t, 1, accounts.aliases)
+	assert.Equal(t, "correct horse", accounts.users["admin_libops.io"])
+
+	o.adminAuth = "okta"
+	_, err = ensureRoot(ctx, store, accounts, o, "")
+	assert.Error(t, err)
+}
+
+// TestSeedSettings tests that the catalog gets placeholder prices without
+// Stripe, picks up Stripe prices when they're known and keeps them otherwise.
+func TestSeedSettings(t *testing.T) {
+	ctx := context.Background()
+	store := newFakeStore()
+
+	require.NoError(t, seedSettings(ctx, store, nil))
+	require.Len(t, store.machines, len(machineCatalog))
+	assert.Equal(t, "unbilled_libops_machine_e2-medium", store.machines["e2-medium"].StripePriceID)
+	assert.Equal(t, "unbilled_libops_disk_storage_gb", store.storage.StripePriceID)
+
+	prices := map[string]string{storageLookupKey: "price_disk"}
+	for _, m := range machineCatalog {
+		prices[machineLookupKey(m.machineType)] = "price_" + m.machineType
+	}
+	require.NoError(t, seedSettings(ctx, store, prices))
+	assert.Equal(t, "price_n4-standard-2", store.machines["n4-standard-2"].StripePriceID)
+	assert.Equal(t, int32(29000), store.machines["n4-standard-2"].MonthlyPriceCents)
+	assert.Equal(t, "price_disk", store.storage.StripePriceID)
+	writes := store.writes
+
+	require.NoError(t, seedSettings(ctx, store, nil))
+	assert.Equal(t, writes, store.writes, "a current catalog is left alone")
+	assert.Equal(t, "price_n4-standard-2", store.machines["n4-standard-2"].StripePriceID, "prices are kept without Stripe")
+	assert.Equal(t, "price_disk", store.storage.StripePriceID)
+}
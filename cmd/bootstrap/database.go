@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/vault"
+)
+
+// accountVault is what the database step needs of Vault to give a userpass
+// admin an identity
+type accountVault interface {
+	EnsureUserpassUser(ctx context.Context, mountPath, username, password string, policies []string) error
+	GetEntityIDByName(ctx context.Context, name string) (string, error)
+	CreateEntity(ctx context.Context, name string, metadata map[string]string, policies []string) (string, error)
+	GetAuthMountAccessor(ctx context.Context, mountType string) (string, error)
+	CreateEntityAlias(ctx context.Context, entityID, mountAccessor, name string) error
+}
+
+// provisionDatabase creates the root organization and its owner
+func (b *bootstrapper) provisionDatabase(ctx context.Context) error {
+	if b.adminEmail == "" {
+		return errors.New("-admin-email is required")
+	}
+	queries, err := b.database()
+	if err != nil {
+		return err
+	}
+	var accounts accountVault
+	if b.adminAuth == string(db.AccountsAuthMethodUserpass) {
+		if accounts, err = b.vaultClient(); err != nil {
+			return err
+		}
+	}
+	org, err := ensureRoot(ctx, queries, accounts, b.options, os.Getenv("LIBOPS_ADMIN_PASSWORD"))
+	if err != nil {
+		return err
+	}
+	b.rootOrgPublicID = org.PublicID
+	return nil
+}
+
+// ensureRoot creates the admin account, the root organization and the
+// admin's ownership of it, whichever are missing
+func ensureRoot(ctx context.Context, queries db.Querier, accounts accountVault, o options, password string) (db.GetOrganizationByIDRow, error) {
+	authMethods := []db.AccountsAuthMethod{db.AccountsAuthMethodUserpass, db.AccountsAuthMethodGoogle, db.AccountsAuthMethodGithub}
+	authMethod := db.AccountsAuthMethod(o.adminAuth)
+	if !slices.Contains(authMethods, authMethod) {
+		return db.GetOrganizationByIDRow{}, fmt.Errorf("-admin-auth must be userpass, google or github")
+	}
+	if authMethod == db.AccountsAuthMethodUserpass && password == "" {
+		return db.GetOrganizationByIDRow{}, errors.New("LIBOPS_ADMIN_PASSWORD is required for a userpass admin")
+	}
+
+	account, err := queries.GetAccountByEmail(ctx, o.adminEmail)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = queries.CreateAccount(ctx, db.CreateAccountParams{
+			Email:      o.adminEmail,
+			Name:       sql.NullString{String: o.adminName, Valid: o.adminName != ""},
+			AuthMethod: authMethod,
+			Verified:   true,
+			VerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		})
+		if err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to create account %s: %w", o.adminEmail, err)
+		}
+		account, err = queries.GetAccountByEmail(ctx, o.adminEmail)
+		if err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to retrieve created account: %w", err)
+		}
+		fmt.Printf("  ✓ Created account %s (%s)\n", account.Email, account.PublicID)
+	} else if err != nil {
+		return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to look up account %s: %w", o.adminEmail, err)
+	} else {
+		fmt.Printf("  - Account %s (%s) exists\n", account.Email, account.PublicID)
+	}
+
+	if authMethod == db.AccountsAuthMethodUserpass {
+		if err := ensureUserpassIdentity(ctx, queries, accounts, account, password); err != nil {
+			return db.GetOrganizationByIDRow{}, err
+		}
+	}
+
+	org, err := queries.GetOrganizationByID(ctx, o.rootOrgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		publicID := uuid.New().String()
+		err = queries.CreateOrganization(ctx, db.CreateOrganizationParams{
+			PublicID:  publicID,
+			Name:      o.rootOrgName,
+			Status:    db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusActive, Valid: true},
+			Labels:    service.LabelsToJSON(nil),
+			CreatedBy: sql.NullInt64{Int64: account.ID, Valid: true},
+			UpdatedBy: sql.NullInt64{Int64: account.ID, Valid: true},
+		})
+		if err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to create root organization: %w", err)
+		}
+		created, err := queries.GetOrganization(ctx, publicID)
+		if err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to retrieve created organization: %w", err)
+		}
+		if created.ID != o.rootOrgID {
+			// IDs come from AUTO_INCREMENT, so an organization deleted before
+			// bootstrap ran moves the root off the expected ID
+			fmt.Printf("  ! The root organization got ID %d; set LIBOPS_ROOT_ORG=%d for the API\n", created.ID, created.ID)
+		}
+		if org, err = queries.GetOrganizationByID(ctx, created.ID); err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to retrieve created organization: %w", err)
+		}
+		fmt.Printf("  ✓ Created root organization %s (%s, id %d)\n", org.Name, org.PublicID, org.ID)
+	} else if err != nil {
+		return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to look up root organization %d: %w", o.rootOrgID, err)
+	} else {
+		fmt.Printf("  - Root organization %s (%s, id %d) exists\n", org.Name, org.PublicID, org.ID)
+	}
+
+	_, err = queries.GetOrganizationMemberByAccountAndOrganization(ctx, db.GetOrganizationMemberByAccountAndOrganizationParams{
+		AccountID:      account.ID,
+		OrganizationID: org.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		err = queries.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			OrganizationID: org.ID,
+			AccountID:      account.ID,
+			Role:           db.OrganizationMembersRoleOwner,
+			Status:         db.NullOrganizationMembersStatus{OrganizationMembersStatus: db.OrganizationMembersStatusActive, Valid: true},
+			CreatedBy:      sql.NullInt64{Int64: account.ID, Valid: true},
+			UpdatedBy:      sql.NullInt64{Int64: account.ID, Valid: true},
+		})
+		if err != nil {
+			return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to add %s to the root organization: %w", account.Email, err)
+		}
+		fmt.Printf("  ✓ Made %s an owner of %s\n", account.Email, org.Name)
+	} else if err != nil {
+		return db.GetOrganizationByIDRow{}, fmt.Errorf("failed to look up membership: %w", err)
+	} else {
+		fmt.Printf("  - %s is a member of %s\n", account.Email, org.Name)
+	}
+	return org, nil
+}
+
+// ensureUserpassIdentity gives a userpass account its Vault user, entity and
+// alias the way registration does. The password is reset on every run.
+func ensureUserpassIdentity(ctx context.Context, queries db.Querier, accounts accountVault, account db.GetAccountByEmailRow, password string) error {
+	policies := vault.DeterminePolicies(string(db.AccountsAuthMethodUserpass))
+	username := strings.ReplaceAll(account.Email, "@", "_")
+	if err := accounts.EnsureUserpassUser(ctx, "userpass", username, password, policies); err != nil {
+		return err
+	}
+
+	entityID, err := accounts.GetEntityIDByName(ctx, account.Email)
+	if err != nil {
+		return err
+	}
+	if entityID == "" {
+		metadata := map[string]string{
+			"email":        account.Email,
+			"account_id":   fmt.Sprintf("%d", account.ID),
+			"account_uuid": strings.ReplaceAll(strings.ToLower(account.PublicID), "-", ""),
+		}
+		if entityID, err = accounts.CreateEntity(ctx, account.Email, metadata, policies); err != nil {
+			return err
+		}
+		accessor, err := accounts.GetAuthMountAccessor(ctx, "userpass")
+		if err != nil {
+			return err
+		}
+		if err := accounts.CreateEntityAlias(ctx, entityID, accessor, username); err != nil {
+			return err
+		}
+		fmt.Printf("  ✓ Created Vault entity %s for %s\n", entityID, account.Email)
+	}
+
+	if account.VaultEntityID.String == entityID {
+		return nil
+	}
+	return queries.UpdateAccount(ctx, db.UpdateAccountParams{
+		Email:          account.Email,
+		Name:           account.Name,
+		GithubUsername: account.GithubUsername,
+		VaultEntityID:  sql.NullString{String: entityID, Valid: true},
+		AuthMethod:     account.AuthMethod,
+		Verified:       account.Verified,
+		VerifiedAt:     account.VerifiedAt,
+		PublicID:       account.PublicID,
+	})
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudrun "google.golang.org/api/run/v2"
+)
+
+// runnerTimeout bounds a single terraform run
+const runnerTimeout = "3600s"
+
+// provisionJob creates or updates the Cloud Run job terraform runs in. Runs
+// pass their run ID, organization and state bucket when they execute it.
+func (b *bootstrapper) provisionJob(ctx context.Context) error {
+	if b.jobProject == "" {
+		return errors.New("-job-project or LIBOPS_ORCHESTRATOR_PROJECT is required")
+	}
+	jobs, err := cloudrun.NewService(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Cloud Run client: %w", err)
+	}
+
+	name := fmt.Sprintf("projects/%s/locations/%s/jobs/%s", b.jobProject, b.jobRegion, b.jobName)
+	job := &cloudrun.GoogleCloudRunV2Job{
+		Labels: map[string]string{"managed-by": "libops-bootstrap"},
+		Template: &cloudrun.GoogleCloudRunV2ExecutionTemplate{
+			TaskCount: 1,
+			Template: &cloudrun.GoogleCloudRunV2TaskTemplate{
+				Containers: []*cloudrun.GoogleCloudRunV2Container{{
+					Image: b.jobImage,
+					Env: []*cloudrun.GoogleCloudRunV2EnvVar{
+						{Name: "LIBOPS_API_URL", Value: b.apiURL},
+						{Name: "LIBOPS_API_AUDIENCE", Value: b.apiURL},
+						{Name: "SERVICE_ACCOUNT", Value: b.jobServiceAccount},
+					},
+				}},
+				ServiceAccount: b.jobServiceAccount,
+				Timeout:        runnerTimeout,
+				// A failed apply is retried through the API, not by re-running it blind
+				MaxRetries:      0,
+				ForceSendFields: []string{"MaxRetries"},
+			},
+		},
+	}
+	// With AllowMissing a patch creates the job when there's none
+	op, err := jobs.Projects.Locations.Jobs.Patch(name, job).AllowMissing(true).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to write job %s: %w", name, err)
+	}
+	fmt.Printf("  ✓ Cloud Run job %s (%s)\n", name, b.jobImage)
+	if op.Name != "" && !op.Done {
+		fmt.Printf("    Rolling out: %s\n", op.Name)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+// Command bootstrap sets up a fresh LibOps environment: the API's Vault
+// mounts and policies, the root organization and its admin account, the
+// Stripe products and prices, the machine catalog and storage settings they
+// price, and the Cloud Run job terraform runs in. Every step checks what's
+// already there first, so it's safe to run again after a partial failure or
+// to pick up changed settings.
+//
+// Usage:
+//
+//	bootstrap -admin-email admin@example.com
+//	bootstrap -steps vault -policies ci/testdata/policies
+//	bootstrap -steps stripe,settings
+//
+// Configuration comes from the same environment variables the API reads:
+// VAULT_ADDR and VAULT_TOKEN, MARIADB_PASSWORD_FILE or DATABASE_URL,
+// STRIPE_SECRET_KEY and LIBOPS_ROOT_ORG.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/config"
+	"github.com/libops/api/internal/vault"
+)
+
+// steps run in this order; later ones use what earlier ones set up
+var steps = []string{"vault", "database", "stripe", "settings", "job"}
+
+// options are the command's flags
+type options struct {
+	steps []string
+
+	policyDir         string
+	oidcIssuer        string
+	redirectURIs      string
+	apiServiceAccount string
+	jwtAudience       string
+
+	rootOrgID   int64
+	rootOrgName string
+	adminEmail  string
+	adminName   string
+	adminAuth   string
+
+	jobProject        string
+	jobRegion         string
+	jobName           string
+	jobImage          string
+	jobServiceAccount string
+	apiURL            string
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("bootstrap", flag.ExitOnError)
+	var o options
+	stepList := fs.String("steps", strings.Join(steps, ","), "Comma-separated steps to run: "+strings.Join(steps, ", "))
+	fs.StringVar(&o.policyDir, "policies", "conf/init/policies", "Directory of Vault policies, one <name>.hcl per policy")
+	fs.StringVar(&o.oidcIssuer, "oidc-issuer", env("VAULT_ADDR", "http://vault:8200"), "Issuer of the Vault OIDC provider")
+	fs.StringVar(&o.redirectURIs, "oidc-redirect-uris", "http://api:8080/auth/callback", "Comma-separated OIDC redirect URIs")
+	fs.StringVar(&o.apiServiceAccount, "api-service-account", "", "Google service account the API's Vault agent signs in as")
+	fs.StringVar(&o.jwtAudience, "jwt-audience", "https://vault.libops.io", "Audience of the API's Google ID tokens")
+	fs.Int64Var(&o.rootOrgID, "root-org-id", envInt("LIBOPS_ROOT_ORG", 1), "ID of the root organization (default $LIBOPS_ROOT_ORG)")
+	fs.StringVar(&o.rootOrgName, "root-org-name", "libops", "Name of the root organization")
+	fs.StringVar(&o.adminEmail, "admin-email", os.Getenv("LIBOPS_ADMIN_EMAIL"), "Email of the root organization's owner (default $LIBOPS_ADMIN_EMAIL)")
+	fs.StringVar(&o.adminName, "admin-name", "LibOps Admin", "Name of the root organization's owner")
+	fs.StringVar(&o.adminAuth, "admin-auth", "userpass", "How the admin signs in: userpass (password from $LIBOPS_ADMIN_PASSWORD), google or github")
+	fs.StringVar(&o.jobProject, "job-project", os.Getenv("LIBOPS_ORCHESTRATOR_PROJECT"), "GCP project of the terraform runner job (default $LIBOPS_ORCHESTRATOR_PROJECT)")
+	fs.StringVar(&o.jobRegion, "job-region", "us-central1", "Region of the terraform runner job")
+	fs.StringVar(&o.jobName, "job-name", "libops-terraform-runner", "Name of the terraform runner job")
+	fs.StringVar(&o.jobImage, "job-image", "ghcr.io/libops/terraform-runner:main", "Terraform runner image")
+	fs.StringVar(&o.jobServiceAccount, "job-service-account", "", "Service account the terraform runner job runs as")
+	fs.StringVar(&o.apiURL, "api-url", env("API_BASE_URL", "https://api.libops.io"), "API URL the terraform runner reports to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	for _, step := range strings.Split(*stepList, ",") {
+		step = strings.TrimSpace(step)
+		if !slices.Contains(steps, step) {
+			return fmt.Errorf("unknown step %q, expected one of %s", step, strings.Join(steps, ", "))
+		}
+		o.steps = append(o.steps, step)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	b := &bootstrapper{options: o}
+	defer b.close()
+	for _, step := range steps {
+		if !slices.Contains(o.steps, step) {
+			continue
+		}
+		fmt.Printf("==> %s\n", step)
+		if err := b.run(ctx, step); err != nil {
+			return fmt.Errorf("%s: %w", step, err)
+		}
+	}
+	fmt.Println("\n✓ Bootstrap complete")
+	if b.rootOrgPublicID != "" {
+		fmt.Printf("\nProvision the root organization's GCP project with:\n  tf-runner --org %s --bootstrap\n", b.rootOrgPublicID)
+	}
+	return nil
+}
+
+// bootstrapper holds the clients steps share, opened the first time a step
+// needs them
+type bootstrapper struct {
+	options
+
+	sqlDB   *sql.DB
+	queries db.Querier
+	vault   *vault.Client
+
+	// Set by the stripe step for the settings step; nil when it didn't run
+	prices map[string]string
+
+	rootOrgPublicID string
+}
+
+func (b *bootstrapper) run(ctx context.Context, step string) error {
+	switch step {
+	case "vault":
+		return b.provisionVault(ctx)
+	case "database":
+		return b.provisionDatabase(ctx)
+	case "stripe":
+		return b.provisionStripe(ctx)
+	case "settings":
+		return b.provisionSettings(ctx)
+	case "job":
+		return b.provisionJob(ctx)
+	}
+	return nil
+}
+
+func (b *bootstrapper) close() {
+	if b.sqlDB != nil {
+		b.sqlDB.Close()
+	}
+}
+
+// database opens the API's database
+func (b *bootstrapper) database() (db.Querier, error) {
+	if b.queries != nil {
+		return b.queries, nil
+	}
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		var err error
+		if dsn, err = config.LoadDatabaseURL(); err != nil {
+			return nil, fmt.Errorf("DATABASE_URL is unset: %w", err)
+		}
+	}
+	sqlDB, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	b.sqlDB = sqlDB
+	b.queries = db.New(sqlDB)
+	return b.queries, nil
+}
+
+// vaultClient connects to the API's Vault with VAULT_TOKEN
+func (b *bootstrapper) vaultClient() (*vault.Client, error) {
+	if b.vault != nil {
+		return b.vault, nil
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return nil, errors.New("VAULT_TOKEN is required")
+	}
+	client, err := vault.NewClient(&vault.Config{Address: env("VAULT_ADDR", "http://vault:8200"), Token: token})
+	if err != nil {
+		return nil, err
+	}
+	b.vault = client
+	return client, nil
+}
+
+// provisionVault sets up the API's Vault with the policies in policyDir
+func (b *bootstrapper) provisionVault(ctx context.Context) error {
+	client, err := b.vaultClient()
+	if err != nil {
+		return err
+	}
+	files, err := filepath.Glob(filepath.Join(b.policyDir, "*.hcl"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no policies in %s", b.policyDir)
+	}
+	policies := map[string]string{}
+	for _, file := range files {
+		policy, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		policies[strings.TrimSuffix(filepath.Base(file), ".hcl")] = string(policy)
+	}
+
+	err = client.ProvisionPlatform(ctx, vault.PlatformConfig{
+		OIDCIssuer:        b.oidcIssuer,
+		OIDCRedirectURIs:  strings.Split(b.redirectURIs, ","),
+		APIServiceAccount: b.apiServiceAccount,
+		JWTAudience:       b.jwtAudience,
+		Policies:          policies,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("  ✓ Mounts, auth methods and OIDC provider %s\n", vault.PlatformOIDCProvider)
+	fmt.Printf("  ✓ Policies: %s\n", strings.Join(slices.Sorted(maps.Keys(policies)), ", "))
+	return nil
+}
+
+func env(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func envInt(key string, fallback int64) int64 {
+	var value int64
+	if _, err := fmt.Sscan(os.Getenv(key), &value); err != nil {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// client holds the Connect clients the commands use.
+type client struct {
+	organizations libopsv1connect.OrganizationServiceClient
+	projects      libopsv1connect.ProjectServiceClient
+	sites         libopsv1connect.SiteServiceClient
+	operations    libopsv1connect.SiteOperationsServiceClient
+
+	organizationSecrets libopsv1connect.OrganizationSecretServiceClient
+	projectSecrets      libopsv1connect.ProjectSecretServiceClient
+	siteSecrets         libopsv1connect.SiteSecretServiceClient
+
+	organizationFirewall libopsv1connect.FirewallServiceClient
+	projectFirewall      libopsv1connect.ProjectFirewallServiceClient
+	siteFirewall         libopsv1connect.SiteFirewallServiceClient
+}
+
+func newClient(httpClient *http.Client, endpoint, apiKey string, urgent bool) *client {
+	opts := []connect.ClientOption{connect.WithInterceptors(authInterceptor(apiKey, urgent))}
+	return &client{
+		organizations:        libopsv1connect.NewOrganizationServiceClient(httpClient, endpoint, opts...),
+		projects:             libopsv1connect.NewProjectServiceClient(httpClient, endpoint, opts...),
+		sites:                libopsv1connect.NewSiteServiceClient(httpClient, endpoint, opts...),
+		operations:           libopsv1connect.NewSiteOperationsServiceClient(httpClient, endpoint, opts...),
+		organizationSecrets:  libopsv1connect.NewOrganizationSecretServiceClient(httpClient, endpoint, opts...),
+		projectSecrets:       libopsv1connect.NewProjectSecretServiceClient(httpClient, endpoint, opts...),
+		siteSecrets:          libopsv1connect.NewSiteSecretServiceClient(httpClient, endpoint, opts...),
+		organizationFirewall: libopsv1connect.NewFirewallServiceClient(httpClient, endpoint, opts...),
+		projectFirewall:      libopsv1connect.NewProjectFirewallServiceClient(httpClient, endpoint, opts...),
+		siteFirewall:         libopsv1connect.NewSiteFirewallServiceClient(httpClient, endpoint, opts...),
+	}
+}
+
+// authInterceptor sends the API key and identifies the CLI. Urgent requests
+// reconcile sites at once instead of waiting for their maintenance windows.
+func authInterceptor(apiKey string, urgent bool) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+apiKey)
+			req.Header().Set("User-Agent", "libops-cli/"+version)
+			if urgent {
+				req.Header().Set("Libops-Urgent", "true")
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// credentials is what libops login saves.
+type credentials struct {
+	Endpoint string `json:"endpoint"`
+	APIKey   string `json:"api_key"`
+}
+
+func defaultConfigPath() (string, error) {
+	if path := os.Getenv("LIBOPS_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("find config directory: %w", err)
+	}
+	return filepath.Join(dir, "libops", "credentials.json"), nil
+}
+
+// loadCredentials reads the saved login. A missing file isn't an error.
+func (a *app) loadCredentials() (credentials, error) {
+	var creds credentials
+	data, err := os.ReadFile(a.configPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return creds, nil
+	}
+	if err != nil {
+		return creds, fmt.Errorf("read %s: %w", a.configPath, err)
+	}
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return creds, fmt.Errorf("parse %s: %w", a.configPath, err)
+	}
+	return creds, nil
+}
+
+// saveCredentials writes the login so only the user can read it.
+func (a *app) saveCredentials(creds credentials) error {
+	if err := os.MkdirAll(filepath.Dir(a.configPath), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	data, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(a.configPath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", a.configPath, err)
+	}
+	return nil
+}
+
+// resolveEndpoint picks the endpoint: the -endpoint flag, then LIBOPS_ENDPOINT,
+// then the saved login, then the default.
+func (a *app) resolveEndpoint(creds credentials) string {
+	for _, endpoint := range []string{a.endpoint, os.Getenv("LIBOPS_ENDPOINT"), creds.Endpoint, defaultEndpoint} {
+		if endpoint != "" {
+			return strings.TrimSuffix(endpoint, "/")
+		}
+	}
+	return defaultEndpoint
+}
+
+// client returns API clients authenticated with LIBOPS_API_KEY or the saved login.
+func (a *app) client() (*client, error) {
+	creds, err := a.loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := os.Getenv("LIBOPS_API_KEY")
+	if apiKey == "" {
+		apiKey = creds.APIKey
+	}
+	if apiKey == "" {
+		return nil, errors.New("not signed in; run libops login or set LIBOPS_API_KEY")
+	}
+	return newClient(a.httpClient, a.resolveEndpoint(creds), apiKey, a.urgent), nil
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// followInterval is how often deploy -follow checks on the deployment.
+var followInterval = 3 * time.Second
+
+func runDeploy(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("deploy", "-site <site_id> [-ref <git_ref>] [-follow=false]")
+	siteID := fs.String("site", "", "Site to deploy (required)")
+	ref := fs.String("ref", "", "Branch, tag or commit to deploy (default: the site's ref)")
+	follow := fs.Bool("follow", true, "Follow the deployment until it finishes")
+	timeout := fs.Duration("timeout", 30*time.Minute, "How long to follow the deployment")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *siteID == "" {
+		return errors.New("-site is required")
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	req := &libopsv1.DeploySiteRequest{SiteId: *siteID}
+	if *ref != "" {
+		req.GitRef = ref
+	}
+	resp, err := c.operations.DeploySite(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if !*follow {
+		t := &table{headers: []string{"DEPLOYMENT", "SITE", "STATUS"}}
+		t.add(resp.Msg.DeploymentId, *siteID, resp.Msg.Status.GetStatus())
+		return a.print(resp.Msg, t)
+	}
+
+	fmt.Fprintf(a.stderr, "Deployment %s started\n", resp.Msg.DeploymentId)
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	deployment, err := a.followDeployment(ctx, c, *siteID, resp.Msg.DeploymentId)
+	if err != nil {
+		return err
+	}
+	if a.output == "json" {
+		if err := a.print(deployment, nil); err != nil {
+			return err
+		}
+	}
+	if deployment.Status == "failed" {
+		return fmt.Errorf("deployment %s failed: %s", deployment.DeploymentId, orDash(deployment.ErrorMessage))
+	}
+	return nil
+}
+
+// followDeployment reports each change of the deployment on stderr until it
+// succeeds or fails. The API keeps no deployment logs of its own, so this
+// follows the deployment's status and points at the workflow run doing the work.
+func (a *app) followDeployment(ctx context.Context, c *client, siteID, deploymentID string) (*libopsv1.Deployment, error) {
+	var lastStatus, lastRunURL string
+	for {
+		deployment, err := findDeployment(ctx, c, siteID, deploymentID)
+		if err != nil {
+			return nil, err
+		}
+		if deployment != nil {
+			if deployment.RunUrl != "" && deployment.RunUrl != lastRunURL {
+				fmt.Fprintf(a.stderr, "%s  run: %s\n", time.Now().Format("15:04:05"), deployment.RunUrl)
+				lastRunURL = deployment.RunUrl
+			}
+			if deployment.Status != lastStatus {
+				line := deployment.Status
+				if deployment.CommitSha != "" {
+					line += " " + shortSHA(deployment.CommitSha)
+				}
+				if deployment.ErrorMessage != "" {
+					line += ": " + deployment.ErrorMessage
+				}
+				fmt.Fprintf(a.stderr, "%s  %s\n", time.Now().Format("15:04:05"), line)
+				lastStatus = deployment.Status
+			}
+			if deployment.Status == "success" || deployment.Status == "failed" {
+				return deployment, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("gave up following deployment %s; it's still %s", deploymentID, orDash(lastStatus))
+			}
+			return nil, ctx.Err()
+		case <-time.After(followInterval):
+		}
+	}
+}
+
+// findDeployment looks for the deployment among the site's most recent ones.
+func findDeployment(ctx context.Context, c *client, siteID, deploymentID string) (*libopsv1.Deployment, error) {
+	resp, err := c.operations.ListSiteDeployments(ctx, connect.NewRequest(&libopsv1.ListSiteDeploymentsRequest{SiteId: siteID, PageSize: 20}))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Msg.Deployments {
+		if d.DeploymentId == deploymentID {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+func runDeployments(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "deployments", args, map[string]func(context.Context, *app, []string) error{
+		"list": listDeployments,
+	})
+}
+
+func listDeployments(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("deployments list", "-site <site_id> [-n <count>]")
+	siteID := fs.String("site", "", "Site whose deployments to list (required)")
+	count := fs.Int("n", 20, "Number of deployments to list, newest first")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *siteID == "" {
+		return errors.New("-site is required")
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.operations.ListSiteDeployments(ctx, connect.NewRequest(&libopsv1.ListSiteDeploymentsRequest{SiteId: *siteID, PageSize: int32(*count)}))
+	if err != nil {
+		return err
+	}
+	t := &table{headers: []string{"ID", "STATUS", "REF", "COMMIT", "STARTED", "COMPLETED"}}
+	for _, d := range resp.Msg.Deployments {
+		t.add(d.DeploymentId, d.Status, orDash(d.GitRef), orDash(shortSHA(d.CommitSha)), unixTime(d.StartedAt), unixTime(d.CompletedAt))
+	}
+	return a.print(resp.Msg, t)
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
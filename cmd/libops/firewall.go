@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ruleTypes maps -type to the API's rule types.
+var ruleTypes = map[string]libopsv1.FirewallRuleType{
+	"https_allowed": libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_HTTPS_ALLOWED,
+	"ssh_allowed":   libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED,
+	"blocked":       libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_BLOCKED,
+}
+
+// rule is a firewall rule at any level.
+type rule struct {
+	id       string
+	ruleType libopsv1.FirewallRuleType
+	cidr     string
+	name     string
+}
+
+func ruleTypeName(t libopsv1.FirewallRuleType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "FIREWALL_RULE_TYPE_"))
+}
+
+func runFirewall(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "firewall", args, map[string]func(context.Context, *app, []string) error{
+		"list":   listRules,
+		"add":    addRule,
+		"remove": removeRule,
+	})
+}
+
+func listRules(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("firewall list", "-org|-project|-site <id>")
+	sf := addScopeFlags(fs, "rules")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	rules, all, err := listFirewallRules(ctx, c, parent)
+	if err != nil {
+		return err
+	}
+	t := &table{headers: []string{"ID", "TYPE", "CIDR", "NAME"}}
+	for _, r := range rules {
+		t.add(r.id, ruleTypeName(r.ruleType), r.cidr, r.name)
+	}
+	return a.print(all, t)
+}
+
+func addRule(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("firewall add", "-org|-project|-site <id> -type <type> -name <name> [-urgent] <cidr>")
+	sf := addScopeFlags(fs, "rule")
+	fs.BoolVar(&a.urgent, "urgent", false, "Apply the change now instead of in the sites' maintenance windows")
+	typeName := fs.String("type", "https_allowed", "Rule type: https_allowed, ssh_allowed or blocked")
+	name := fs.String("name", "", "Rule name (required)")
+	if err := a.parse(fs, args, 1); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	ruleType, ok := ruleTypes[*typeName]
+	if !ok {
+		return fmt.Errorf("-type must be https_allowed, ssh_allowed or blocked, got %q", *typeName)
+	}
+	if *name == "" {
+		return fmt.Errorf("-name is required")
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	cidr := fs.Arg(0)
+	var created rule
+	var msg proto.Message
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationFirewall.CreateOrganizationFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateOrganizationFirewallRuleRequest{
+			OrganizationId: parent.id, RuleType: ruleType, Cidr: cidr, Name: *name,
+		}))
+		if err != nil {
+			return err
+		}
+		r := resp.Msg.Rule
+		created, msg = rule{r.RuleId, r.RuleType, r.Cidr, r.Name}, resp.Msg
+	case levelProject:
+		resp, err := c.projectFirewall.CreateProjectFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateProjectFirewallRuleRequest{
+			ProjectId: parent.id, RuleType: ruleType, Cidr: cidr, Name: *name,
+		}))
+		if err != nil {
+			return err
+		}
+		r := resp.Msg.Rule
+		created, msg = rule{r.RuleId, r.RuleType, r.Cidr, r.Name}, resp.Msg
+	case levelSite:
+		resp, err := c.siteFirewall.CreateSiteFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateSiteFirewallRuleRequest{
+			SiteId: parent.id, RuleType: ruleType, Cidr: cidr, Name: *name,
+		}))
+		if err != nil {
+			return err
+		}
+		r := resp.Msg.Rule
+		created, msg = rule{r.RuleId, r.RuleType, r.Cidr, r.Name}, resp.Msg
+	}
+
+	t := &table{headers: []string{"ID", "TYPE", "CIDR", "NAME"}}
+	t.add(created.id, ruleTypeName(created.ruleType), created.cidr, created.name)
+	return a.print(msg, t)
+}
+
+func removeRule(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("firewall remove", "-org|-project|-site <id> [-urgent] <rule_id>")
+	sf := addScopeFlags(fs, "rule")
+	fs.BoolVar(&a.urgent, "urgent", false, "Apply the change now instead of in the sites' maintenance windows")
+	if err := a.parse(fs, args, 1); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	ruleID := fs.Arg(0)
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationFirewall.DeleteOrganizationFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationFirewallRuleRequest{OrganizationId: parent.id, RuleId: ruleID}))
+	case levelProject:
+		_, err = c.projectFirewall.DeleteProjectFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteProjectFirewallRuleRequest{ProjectId: parent.id, RuleId: ruleID}))
+	case levelSite:
+		_, err = c.siteFirewall.DeleteSiteFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteSiteFirewallRuleRequest{SiteId: parent.id, RuleId: ruleID}))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "Removed firewall rule %s\n", ruleID)
+	return nil
+}
+
+// listFirewallRules lists every rule of the parent. It also returns the
+// combined list response for -o json.
+func listFirewallRules(ctx context.Context, c *client, parent scope) ([]rule, proto.Message, error) {
+	var rules []rule
+	switch parent.level {
+	case levelOrganization:
+		all := &libopsv1.ListOrganizationFirewallRulesResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.organizationFirewall.ListOrganizationFirewallRules(ctx, connect.NewRequest(&libopsv1.ListOrganizationFirewallRulesRequest{OrganizationId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Rules = append(all.Rules, resp.Msg.Rules...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, r := range all.Rules {
+			rules = append(rules, rule{r.RuleId, r.RuleType, r.Cidr, r.Name})
+		}
+		return rules, all, nil
+	case levelProject:
+		all := &libopsv1.ListProjectFirewallRulesResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.projectFirewall.ListProjectFirewallRules(ctx, connect.NewRequest(&libopsv1.ListProjectFirewallRulesRequest{ProjectId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Rules = append(all.Rules, resp.Msg.Rules...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, r := range all.Rules {
+			rules = append(rules, rule{r.RuleId, r.RuleType, r.Cidr, r.Name})
+		}
+		return rules, all, nil
+	default:
+		all := &libopsv1.ListSiteFirewallRulesResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.siteFirewall.ListSiteFirewallRules(ctx, connect.NewRequest(&libopsv1.ListSiteFirewallRulesRequest{SiteId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Rules = append(all.Rules, resp.Msg.Rules...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, r := range all.Rules {
+			rules = append(rules, rule{r.RuleId, r.RuleType, r.Cidr, r.Name})
+		}
+		return rules, all, nil
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+func newTestApp(t *testing.T) (*app, *bytes.Buffer) {
+	t.Helper()
+	t.Setenv("LIBOPS_API_KEY", "")
+	t.Setenv("LIBOPS_ENDPOINT", "")
+	stdout := &bytes.Buffer{}
+	return &app{
+		stdout:     stdout,
+		stderr:     &bytes.Buffer{},
+		configPath: filepath.Join(t.TempDir(), "libops", "credentials.json"),
+		httpClient: http.DefaultClient,
+	}, stdout
+}
+
+func TestLogin(t *testing.T) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/device/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.FormValue("client_name"), "libops CLI")
+		_ = json.NewEncoder(w).Encode(deviceCode{
+			DeviceCode:      "device-code",
+			UserCode:        "BCDF-GHJK",
+			VerificationURI: "https://dash.example/auth/device",
+			ExpiresIn:       900,
+			Interval:        1,
+		})
+	})
+	mux.HandleFunc("POST /auth/device/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "device-code", r.FormValue("device_code"))
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.FormValue("grant_type"))
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(tokenResponse{Error: "authorization_pending"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tokenResponse{AccessToken: "libops_key", ExpiresIn: 90 * 24 * 60 * 60})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	a, _ := newTestApp(t)
+	require.NoError(t, a.run(context.Background(), []string{"login", "-endpoint", srv.URL}))
+	assert.Equal(t, 2, polls)
+
+	info, err := os.Stat(a.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	creds, err := a.loadCredentials()
+	require.NoError(t, err)
+	assert.Equal(t, credentials{Endpoint: srv.URL, APIKey: "libops_key"}, creds)
+
+	require.NoError(t, a.run(context.Background(), []string{"logout"}))
+	_, err = os.Stat(a.configPath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoginDenied(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/device/code", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(deviceCode{DeviceCode: "device-code", Interval: 1})
+	})
+	mux.HandleFunc("POST /auth/device/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(tokenResponse{Error: "access_denied"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	a, _ := newTestApp(t)
+	err := a.run(context.Background(), []string{"login", "-endpoint", srv.URL})
+	assert.ErrorContains(t, err, "denied")
+	_, err = os.Stat(a.configPath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+type testOrganizations struct {
+	libopsv1connect.UnimplementedOrganizationServiceHandler
+	authorization string
+}
+
+func (s *testOrganizations) ListOrganizations(ctx context.Context, req *connect.Request[libopsv1.ListOrganizationsRequest]) (*connect.Response[libopsv1.ListOrganizationsResponse], error) {
+	s.authorization = req.Header().Get("Authorization")
+	if req.Msg.PageToken == "" {
+		return connect.NewResponse(&libopsv1.ListOrganizationsResponse{
+			Organizations: []*commonv1.FolderConfig{{OrganizationId: "org-1", OrganizationName: "Acme", Status: commonv1.Status_STATUS_ACTIVE}},
+			NextPageToken: "next",
+		}), nil
+	}
+	return connect.NewResponse(&libopsv1.ListOrganizationsResponse{
+		Organizations: []*commonv1.FolderConfig{{OrganizationId: "org-2", OrganizationName: "Globex", Labels: map[string]string{"team": "b", "env": "prod"}}},
+	}), nil
+}
+
+func TestOrgsList(t *testing.T) {
+	orgs := &testOrganizations{}
+	mux := http.NewServeMux()
+	mux.Handle(libopsv1connect.NewOrganizationServiceHandler(orgs))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	a, stdout := newTestApp(t)
+	require.NoError(t, a.saveCredentials(credentials{Endpoint: srv.URL, APIKey: "libops_key"}))
+
+	require.NoError(t, a.run(context.Background(), []string{"orgs", "list"}))
+	assert.Equal(t, "Bearer libops_key", orgs.authorization)
+	assert.Contains(t, stdout.String(), "org-1  Acme")
+	assert.Contains(t, stdout.String(), "env=prod,team=b")
+
+	stdout.Reset()
+	require.NoError(t, a.run(context.Background(), []string{"orgs", "list", "-o", "json"}))
+	var out struct {
+		Organizations []struct {
+			OrganizationID string `json:"organizationId"`
+		} `json:"organizations"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out.Organizations, 2)
+	assert.Equal(t, "org-2", out.Organizations[1].OrganizationID)
+
+	// LIBOPS_API_KEY wins over the saved login.
+	t.Setenv("LIBOPS_API_KEY", "env_key")
+	require.NoError(t, a.run(context.Background(), []string{"orgs", "list"}))
+	assert.Equal(t, "Bearer env_key", orgs.authorization)
+}
+
+type testOperations struct {
+	libopsv1connect.UnimplementedSiteOperationsServiceHandler
+	polls int
+}
+
+func (s *testOperations) DeploySite(ctx context.Context, req *connect.Request[libopsv1.DeploySiteRequest]) (*connect.Response[libopsv1.DeploySiteResponse], error) {
+	if req.Msg.GetGitRef() != "heads/main" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+	}
+	return connect.NewResponse(&libopsv1.DeploySiteResponse{DeploymentId: "dep-1"}), nil
+}
+
+func (s *testOperations) ListSiteDeployments(ctx context.Context, req *connect.Request[libopsv1.ListSiteDeploymentsRequest]) (*connect.Response[libopsv1.ListSiteDeploymentsResponse], error) {
+	s.polls++
+	latest := &libopsv1.Deployment{DeploymentId: "dep-1", Status: "in_progress", RunUrl: "https://github.com/acme/site/actions/runs/1"}
+	if s.polls > 1 {
+		latest.Status, latest.ErrorMessage = "failed", "composer install failed"
+	}
+	return connect.NewResponse(&libopsv1.ListSiteDeploymentsResponse{
+		Deployments: []*libopsv1.Deployment{latest, {DeploymentId: "dep-0", Status: "success"}},
+	}), nil
+}
+
+func TestDeployFollow(t *testing.T) {
+	followInterval = 10 * time.Millisecond
+	ops := &testOperations{}
+	mux := http.NewServeMux()
+	mux.Handle(libopsv1connect.NewSiteOperationsServiceHandler(ops))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	a, _ := newTestApp(t)
+	t.Setenv("LIBOPS_API_KEY", "libops_key")
+	err := a.run(context.Background(), []string{"deploy", "-endpoint", srv.URL, "-site", "site-1", "-ref", "heads/main"})
+	assert.ErrorContains(t, err, "deployment dep-1 failed: composer install failed")
+	assert.Equal(t, 2, ops.polls)
+	stderr := a.stderr.(*bytes.Buffer).String()
+	assert.Contains(t, stderr, "run: https://github.com/acme/site/actions/runs/1")
+	assert.Contains(t, stderr, "in_progress")
+}
+
+type testSiteFirewall struct {
+	libopsv1connect.UnimplementedSiteFirewallServiceHandler
+	urgent string
+}
+
+func (s *testSiteFirewall) DeleteSiteFirewallRule(ctx context.Context, req *connect.Request[libopsv1.DeleteSiteFirewallRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	s.urgent = req.Header().Get("Libops-Urgent")
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func TestFirewallRemoveUrgent(t *testing.T) {
+	firewall := &testSiteFirewall{}
+	mux := http.NewServeMux()
+	mux.Handle(libopsv1connect.NewSiteFirewallServiceHandler(firewall))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	a, _ := newTestApp(t)
+	t.Setenv("LIBOPS_API_KEY", "libops_key")
+	require.NoError(t, a.run(context.Background(), []string{"firewall", "remove", "-endpoint", srv.URL, "-site", "site-1", "rule-1"}))
+	assert.Empty(t, firewall.urgent, "changes wait for the maintenance window by default")
+
+	require.NoError(t, a.run(context.Background(), []string{"firewall", "remove", "-endpoint", srv.URL, "-site", "site-1", "-urgent", "rule-1"}))
+	assert.Equal(t, "true", firewall.urgent)
+}
+
+func TestScopeFlags(t *testing.T) {
+	a, _ := newTestApp(t)
+
+	fs := a.flags("secrets list", "")
+	sf := addScopeFlags(fs, "secrets")
+	require.NoError(t, a.parse(fs, []string{"-project", "proj-1"}, 0))
+	got, err := sf.scope()
+	require.NoError(t, err)
+	assert.Equal(t, scope{levelProject, "proj-1"}, got)
+
+	fs = a.flags("secrets list", "")
+	sf = addScopeFlags(fs, "secrets")
+	require.NoError(t, a.parse(fs, []string{"-project", "proj-1", "-site", "site-1"}, 0))
+	_, err = sf.scope()
+	assert.Error(t, err)
+
+	fs = a.flags("secrets list", "")
+	addScopeFlags(fs, "secrets")
+	assert.ErrorIs(t, a.parse(fs, []string{"-o", "yaml"}, 0), errUsage)
+}
+
+func TestReadValue(t *testing.T) {
+	value, err := readValue(bytes.NewBufferString("hunter2\r\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", value)
+
+	_, err = readValue(bytes.NewBufferString(""))
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// deviceCode is the API's device authorization response (RFC 8628).
+type deviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// tokenResponse is the token endpoint's answer, either a token or an OAuth error.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func runLogin(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("login", "")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	creds, err := a.loadCredentials()
+	if err != nil {
+		return err
+	}
+	endpoint := a.resolveEndpoint(creds)
+
+	hostname, _ := os.Hostname()
+	clientName := "libops CLI"
+	if hostname != "" {
+		clientName = "libops CLI on " + hostname
+	}
+
+	var code deviceCode
+	if err := a.postForm(ctx, endpoint+"/auth/device/code", url.Values{"client_name": {clientName}}, &code); err != nil {
+		return fmt.Errorf("start sign-in: %w", err)
+	}
+	fmt.Fprintf(a.stderr, "Open %s and enter the code %s\n", code.VerificationURI, code.UserCode)
+	fmt.Fprintf(a.stderr, "or go straight to %s\n\nWaiting for approval...\n", code.VerificationURIComplete)
+
+	apiKey, expiresIn, err := a.pollToken(ctx, endpoint, code)
+	if err != nil {
+		return err
+	}
+	if err := a.saveCredentials(credentials{Endpoint: endpoint, APIKey: apiKey}); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "Signed in. The API key is saved in %s and expires in %d days.\n",
+		a.configPath, expiresIn/(24*60*60))
+	return nil
+}
+
+// pollToken polls until the user approved or denied the request, or it expired.
+func (a *app) pollToken(ctx context.Context, endpoint string, code deviceCode) (string, int, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {code.DeviceCode},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", 0, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		var resp tokenResponse
+		if err := a.postForm(ctx, endpoint+"/auth/device/token", form, &resp); err != nil {
+			return "", 0, fmt.Errorf("finish sign-in: %w", err)
+		}
+		switch resp.Error {
+		case "":
+			return resp.AccessToken, resp.ExpiresIn, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return "", 0, errors.New("sign-in was denied in the dashboard")
+		case "expired_token":
+			return "", 0, errors.New("the code expired before it was approved; run libops login again")
+		default:
+			return "", 0, fmt.Errorf("sign-in failed: %s %s", resp.Error, resp.ErrorDescription)
+		}
+	}
+}
+
+// postForm posts a form and decodes the JSON answer. OAuth errors come back
+// with status 400 and are decoded like any other answer.
+func (a *app) postForm(ctx context.Context, endpoint string, form url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "libops-cli/"+version)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest {
+		return fmt.Errorf("%s returned %s", endpoint, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode %s response: %w", endpoint, err)
+	}
+	return nil
+}
+
+func runLogout(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("logout", "")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if err := os.Remove(a.configPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	fmt.Fprintln(a.stderr, "Signed out. The API key stays valid until it expires; delete it on the API keys page to revoke it now.")
+	return nil
+}
@@ -0,0 +1,200 @@
+// Command libops is the LibOps command-line client. It signs in with the
+// dashboard through the OAuth 2.0 device flow and talks to the API with the
+// generated Connect clients.
+//
+// Usage:
+//
+//	libops login
+//	libops orgs list
+//	libops sites list -project <project_id>
+//	libops secrets set -site <site_id> DATABASE_PASSWORD hunter2
+//	libops deploy -site <site_id> -ref heads/main
+//	libops firewall add -site <site_id> -type https_allowed -name office 203.0.113.0/24
+//
+// Every command takes -o json to print the API's response as JSON instead of a table.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// version is set at build time with -ldflags "-X main.version=...".
+var version = "dev"
+
+// defaultEndpoint is the API used when neither -endpoint, LIBOPS_ENDPOINT nor
+// the saved login say otherwise.
+const defaultEndpoint = "https://api.libops.io"
+
+// errUsage is returned when a command is called wrong; the usage has been printed.
+var errUsage = errors.New("usage")
+
+// app holds what every command needs.
+type app struct {
+	stdout     io.Writer
+	stderr     io.Writer
+	configPath string
+	httpClient *http.Client
+
+	// Set from the common flags
+	endpoint string
+	output   string
+
+	// Set by -urgent on commands that change a VM
+	urgent bool
+}
+
+// command is a top-level command; commands with subcommands dispatch on args[0].
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, a *app, args []string) error
+}
+
+var commands = []command{
+	{"login", "Sign in through the dashboard and save an API key", runLogin},
+	{"logout", "Forget the saved API key", runLogout},
+	{"orgs", "List organizations", runOrgs},
+	{"projects", "List projects", runProjects},
+	{"sites", "List sites and show their status", runSites},
+	{"secrets", "List, set, get and delete secrets", runSecrets},
+	{"deploy", "Deploy a site and follow the deployment", runDeploy},
+	{"deployments", "List a site's deployments", runDeployments},
+	{"firewall", "List, add and remove firewall rules", runFirewall},
+	{"version", "Print the CLI version", runVersion},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	configPath, err := defaultConfigPath()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "libops:", err)
+		os.Exit(1)
+	}
+	a := &app{
+		stdout:     os.Stdout,
+		stderr:     os.Stderr,
+		configPath: configPath,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+	if err := a.run(ctx, os.Args[1:]); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "libops:", describe(err))
+		}
+		os.Exit(1)
+	}
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		a.usage()
+		if len(args) == 0 {
+			return errUsage
+		}
+		return nil
+	}
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(ctx, a, args[1:])
+		}
+	}
+	fmt.Fprintf(a.stderr, "libops: unknown command %q\n\n", args[0])
+	a.usage()
+	return errUsage
+}
+
+func (a *app) usage() {
+	fmt.Fprintln(a.stderr, "Usage: libops <command> [flags]")
+	fmt.Fprintln(a.stderr)
+	fmt.Fprintln(a.stderr, "Commands:")
+	w := tabwriter.NewWriter(a.stderr, 0, 4, 2, ' ', 0)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	_ = w.Flush()
+	fmt.Fprintln(a.stderr)
+	fmt.Fprintln(a.stderr, "Run libops <command> -h for a command's flags.")
+}
+
+// flags creates a command's flag set with the flags every command shares.
+func (a *app) flags(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet("libops "+name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: libops %s %s\n\nFlags:\n", name, usage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&a.endpoint, "endpoint", "", "API endpoint (default $LIBOPS_ENDPOINT, the saved login or "+defaultEndpoint+")")
+	fs.StringVar(&a.output, "o", "table", "Output format: table or json")
+	return fs
+}
+
+// parse parses a command's flags, wanting exactly nargs positional arguments.
+func (a *app) parse(fs *flag.FlagSet, args []string, nargs int) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage // flag has printed the error or -h usage
+	}
+	if a.output != "table" && a.output != "json" {
+		fmt.Fprintf(a.stderr, "-o must be table or json, got %q\n", a.output)
+		return errUsage
+	}
+	if fs.NArg() != nargs {
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+// subcommand dispatches to one of a command's subcommands.
+func (a *app) subcommand(ctx context.Context, name string, args []string, subs map[string]func(context.Context, *app, []string) error) error {
+	if len(args) > 0 {
+		if run, ok := subs[args[0]]; ok {
+			return run(ctx, a, args[1:])
+		}
+	}
+	names := slices.Sorted(maps.Keys(subs))
+	fmt.Fprintf(a.stderr, "Usage: libops %s <%s> [flags]\n", name, strings.Join(names, "|"))
+	return errUsage
+}
+
+func runVersion(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("version", "")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	fmt.Fprintln(a.stdout, "libops", version)
+	return nil
+}
+
+// describe turns API errors into one readable line.
+func describe(err error) string {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return err.Error()
+	}
+	switch connectErr.Code() {
+	case connect.CodeUnauthenticated:
+		return "not signed in or the API key expired; run libops login"
+	case connect.CodePermissionDenied:
+		return "permission denied: " + connectErr.Message()
+	case connect.CodeNotFound:
+		return "not found: " + connectErr.Message()
+	default:
+		return connectErr.Message()
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+func runOrgs(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "orgs", args, map[string]func(context.Context, *app, []string) error{
+		"list": listOrgs,
+	})
+}
+
+func listOrgs(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("orgs list", "")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	all := &libopsv1.ListOrganizationsResponse{}
+	for pageToken := ""; ; {
+		resp, err := c.organizations.ListOrganizations(ctx, connect.NewRequest(&libopsv1.ListOrganizationsRequest{PageToken: pageToken}))
+		if err != nil {
+			return err
+		}
+		all.Organizations = append(all.Organizations, resp.Msg.Organizations...)
+		if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "REGION", "STATUS", "LABELS"}}
+	for _, org := range all.Organizations {
+		t.add(org.OrganizationId, org.OrganizationName, orDash(org.Region), status(org.Status), labels(org.Labels))
+	}
+	return a.print(all, t)
+}
+
+func runProjects(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "projects", args, map[string]func(context.Context, *app, []string) error{
+		"list": listProjects,
+	})
+}
+
+func listProjects(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("projects list", "[-org <organization_id>] [-l key=value,...]")
+	orgID := fs.String("org", "", "Only list the organization's projects")
+	selector := fs.String("l", "", "Only list projects with these labels, e.g. env=prod")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	all := &libopsv1.ListProjectsResponse{}
+	for pageToken := ""; ; {
+		req := &libopsv1.ListProjectsRequest{PageToken: pageToken}
+		if *orgID != "" {
+			req.OrganizationId = orgID
+		}
+		if *selector != "" {
+			req.LabelSelector = selector
+		}
+		resp, err := c.projects.ListProjects(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		all.Projects = append(all.Projects, resp.Msg.Projects...)
+		if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "ORGANIZATION", "REGION", "MACHINE TYPE", "STATUS"}}
+	for _, p := range all.Projects {
+		t.add(p.ProjectId, p.ProjectName, p.OrganizationId, orDash(p.Region), orDash(p.MachineType), status(p.Status))
+	}
+	return a.print(all, t)
+}
+
+func runSites(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "sites", args, map[string]func(context.Context, *app, []string) error{
+		"list":   listSites,
+		"status": siteStatus,
+	})
+}
+
+func listSites(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("sites list", "[-org <organization_id>] [-project <project_id>] [-l key=value,...]")
+	orgID := fs.String("org", "", "Only list the organization's sites")
+	projectID := fs.String("project", "", "Only list the project's sites")
+	selector := fs.String("l", "", "Only list sites with these labels, e.g. env=prod")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	all := &libopsv1.ListSitesResponse{}
+	for pageToken := ""; ; {
+		req := &libopsv1.ListSitesRequest{PageToken: pageToken}
+		if *orgID != "" {
+			req.OrganizationId = orgID
+		}
+		if *projectID != "" {
+			req.ProjectId = projectID
+		}
+		if *selector != "" {
+			req.LabelSelector = selector
+		}
+		resp, err := c.sites.ListSites(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		all.Sites = append(all.Sites, resp.Msg.Sites...)
+		if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	t := &table{headers: []string{"ID", "NAME", "PROJECT", "REPOSITORY", "REF", "STATUS"}}
+	for _, s := range all.Sites {
+		t.add(s.SiteId, s.SiteName, s.ProjectId, orDash(s.GithubRepository), orDash(s.GithubRef), status(s.Status))
+	}
+	return a.print(all, t)
+}
+
+func siteStatus(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("sites status", "<site_id>")
+	if err := a.parse(fs, args, 1); err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.operations.GetSiteStatus(ctx, connect.NewRequest(&libopsv1.GetSiteStatusRequest{SiteId: fs.Arg(0)}))
+	if err != nil {
+		return err
+	}
+	s := resp.Msg.Status
+	t := &table{headers: []string{"SITE", "STATUS", "DEPLOYED AT", "MESSAGE"}}
+	t.add(s.SiteId, s.Status, orDash(s.GetDeployedAt()), orDash(s.GetMessage()))
+	if len(s.Components) > 0 {
+		t = &table{headers: []string{"COMPONENT", "STATE", "OBSERVED AT", "MESSAGE"}}
+		for _, c := range s.Components {
+			state := strings.ToLower(strings.TrimPrefix(c.State.String(), "SITE_COMPONENT_STATE_"))
+			t.add(c.Component, state, unixTime(c.ObservedAt), c.Message)
+		}
+	}
+	return a.print(resp.Msg, t)
+}
+
+// status shows a resource status without the enum prefix, e.g. "active".
+func status(s commonv1.Status) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "STATUS_"))
+}
+
+// labels shows labels as sorted key=value pairs.
+func labels(l map[string]string) string {
+	pairs := make([]string, 0, len(l))
+	for k, v := range l {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	slices.Sort(pairs)
+	return orDash(strings.Join(pairs, ","))
+}
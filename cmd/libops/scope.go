@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"flag"
+)
+
+// Secrets and firewall rules exist on an organization, a project or a site.
+const (
+	levelOrganization = "organization"
+	levelProject      = "project"
+	levelSite         = "site"
+)
+
+// scope is the parent a secret or firewall rule belongs to.
+type scope struct {
+	level string
+	id    string
+}
+
+// scopeFlags adds -org, -project and -site to a flag set.
+type scopeFlags struct {
+	org, project, site *string
+}
+
+func addScopeFlags(fs *flag.FlagSet, kind string) scopeFlags {
+	return scopeFlags{
+		org:     fs.String("org", "", "Organization the "+kind+" belongs to"),
+		project: fs.String("project", "", "Project the "+kind+" belongs to"),
+		site:    fs.String("site", "", "Site the "+kind+" belongs to"),
+	}
+}
+
+// scope returns the one parent that was given.
+func (f scopeFlags) scope() (scope, error) {
+	var found []scope
+	if *f.org != "" {
+		found = append(found, scope{levelOrganization, *f.org})
+	}
+	if *f.project != "" {
+		found = append(found, scope{levelProject, *f.project})
+	}
+	if *f.site != "" {
+		found = append(found, scope{levelSite, *f.site})
+	}
+	if len(found) != 1 {
+		return scope{}, errors.New("give exactly one of -org, -project and -site")
+	}
+	return found[0], nil
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// secret is a secret at any level. The API never returns secret values.
+type secret struct {
+	id     string
+	name   string
+	status commonv1.Status
+	msg    proto.Message
+}
+
+func runSecrets(ctx context.Context, a *app, args []string) error {
+	return a.subcommand(ctx, "secrets", args, map[string]func(context.Context, *app, []string) error{
+		"list":   listSecretsCmd,
+		"set":    setSecret,
+		"get":    getSecret,
+		"delete": deleteSecret,
+	})
+}
+
+func listSecretsCmd(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("secrets list", "-org|-project|-site <id>")
+	sf := addScopeFlags(fs, "secrets")
+	if err := a.parse(fs, args, 0); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	secrets, all, err := listSecrets(ctx, c, parent)
+	if err != nil {
+		return err
+	}
+	t := &table{headers: []string{"NAME", "ID", "STATUS"}}
+	for _, s := range secrets {
+		t.add(s.name, s.id, status(s.status))
+	}
+	return a.print(all, t)
+}
+
+// setSecret creates the secret, or changes its value if it exists. A value of
+// "-" is read from stdin, which keeps it out of the shell history.
+func setSecret(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("secrets set", "-org|-project|-site <id> [-urgent] <NAME> <value|->")
+	sf := addScopeFlags(fs, "secret")
+	fs.BoolVar(&a.urgent, "urgent", false, "Apply the change now instead of in the sites' maintenance windows")
+	if err := a.parse(fs, args, 2); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	name, value := fs.Arg(0), fs.Arg(1)
+	if value == "-" {
+		if value, err = readValue(os.Stdin); err != nil {
+			return err
+		}
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	existing, err := findSecret(ctx, c, parent, name)
+	if err != nil {
+		return err
+	}
+	var s *secret
+	if existing == nil {
+		s, err = createSecret(ctx, c, parent, name, value)
+	} else {
+		s, err = updateSecret(ctx, c, parent, existing.id, value)
+	}
+	if err != nil {
+		return err
+	}
+
+	t := &table{headers: []string{"NAME", "ID", "STATUS"}}
+	t.add(s.name, s.id, status(s.status))
+	return a.print(s.msg, t)
+}
+
+// getSecret shows a secret. Values are write-only, so only its metadata is shown.
+func getSecret(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("secrets get", "-org|-project|-site <id> <NAME>")
+	sf := addScopeFlags(fs, "secret")
+	if err := a.parse(fs, args, 1); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	s, err := findSecret(ctx, c, parent, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("no secret named %s on %s %s", fs.Arg(0), parent.level, parent.id)
+	}
+	t := &table{headers: []string{"NAME", "ID", "STATUS"}}
+	t.add(s.name, s.id, status(s.status))
+	return a.print(s.msg, t)
+}
+
+func deleteSecret(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("secrets delete", "-org|-project|-site <id> [-urgent] <NAME>")
+	sf := addScopeFlags(fs, "secret")
+	fs.BoolVar(&a.urgent, "urgent", false, "Apply the change now instead of in the sites' maintenance windows")
+	if err := a.parse(fs, args, 1); err != nil {
+		return err
+	}
+	parent, err := sf.scope()
+	if err != nil {
+		return err
+	}
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	s, err := findSecret(ctx, c, parent, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return fmt.Errorf("no secret named %s on %s %s", fs.Arg(0), parent.level, parent.id)
+	}
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationSecrets.DeleteOrganizationSecret(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationSecretRequest{OrganizationId: parent.id, SecretId: s.id}))
+	case levelProject:
+		_, err = c.projectSecrets.DeleteProjectSecret(ctx, connect.NewRequest(&libopsv1.DeleteProjectSecretRequest{ProjectId: parent.id, SecretId: s.id}))
+	case levelSite:
+		_, err = c.siteSecrets.DeleteSiteSecret(ctx, connect.NewRequest(&libopsv1.DeleteSiteSecretRequest{SiteId: parent.id, SecretId: s.id}))
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "Deleted secret %s\n", s.name)
+	return nil
+}
+
+// listSecrets lists every secret of the parent. It also returns the combined
+// list response for -o json.
+func listSecrets(ctx context.Context, c *client, parent scope) ([]secret, proto.Message, error) {
+	var secrets []secret
+	switch parent.level {
+	case levelOrganization:
+		all := &libopsv1.ListOrganizationSecretsResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.organizationSecrets.ListOrganizationSecrets(ctx, connect.NewRequest(&libopsv1.ListOrganizationSecretsRequest{OrganizationId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Secrets = append(all.Secrets, resp.Msg.Secrets...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, s := range all.Secrets {
+			secrets = append(secrets, secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s})
+		}
+		return secrets, all, nil
+	case levelProject:
+		all := &libopsv1.ListProjectSecretsResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.projectSecrets.ListProjectSecrets(ctx, connect.NewRequest(&libopsv1.ListProjectSecretsRequest{ProjectId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Secrets = append(all.Secrets, resp.Msg.Secrets...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, s := range all.Secrets {
+			secrets = append(secrets, secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s})
+		}
+		return secrets, all, nil
+	default:
+		all := &libopsv1.ListSiteSecretsResponse{}
+		for pageToken := ""; ; {
+			resp, err := c.siteSecrets.ListSiteSecrets(ctx, connect.NewRequest(&libopsv1.ListSiteSecretsRequest{SiteId: parent.id, PageToken: pageToken}))
+			if err != nil {
+				return nil, nil, err
+			}
+			all.Secrets = append(all.Secrets, resp.Msg.Secrets...)
+			if pageToken = resp.Msg.NextPageToken; pageToken == "" {
+				break
+			}
+		}
+		for _, s := range all.Secrets {
+			secrets = append(secrets, secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s})
+		}
+		return secrets, all, nil
+	}
+}
+
+// findSecret finds a secret by name; it returns nil if there's none.
+func findSecret(ctx context.Context, c *client, parent scope, name string) (*secret, error) {
+	secrets, _, err := listSecrets(ctx, c, parent)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range secrets {
+		if s.name == name {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
+
+func createSecret(ctx context.Context, c *client, parent scope, name, value string) (*secret, error) {
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationSecrets.CreateOrganizationSecret(ctx, connect.NewRequest(&libopsv1.CreateOrganizationSecretRequest{OrganizationId: parent.id, Name: name, Value: value}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	case levelProject:
+		resp, err := c.projectSecrets.CreateProjectSecret(ctx, connect.NewRequest(&libopsv1.CreateProjectSecretRequest{ProjectId: parent.id, Name: name, Value: value}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	default:
+		resp, err := c.siteSecrets.CreateSiteSecret(ctx, connect.NewRequest(&libopsv1.CreateSiteSecretRequest{SiteId: parent.id, Name: name, Value: value}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	}
+}
+
+func updateSecret(ctx context.Context, c *client, parent scope, secretID, value string) (*secret, error) {
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"value"}}
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationSecrets.UpdateOrganizationSecret(ctx, connect.NewRequest(&libopsv1.UpdateOrganizationSecretRequest{OrganizationId: parent.id, SecretId: secretID, Value: &value, UpdateMask: mask}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	case levelProject:
+		resp, err := c.projectSecrets.UpdateProjectSecret(ctx, connect.NewRequest(&libopsv1.UpdateProjectSecretRequest{ProjectId: parent.id, SecretId: secretID, Value: &value, UpdateMask: mask}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	default:
+		resp, err := c.siteSecrets.UpdateSiteSecret(ctx, connect.NewRequest(&libopsv1.UpdateSiteSecretRequest{SiteId: parent.id, SecretId: secretID, Value: &value, UpdateMask: mask}))
+		if err != nil {
+			return nil, err
+		}
+		s := resp.Msg.Secret
+		return &secret{id: s.SecretId, name: s.Name, status: s.Status, msg: s}, nil
+	}
+}
+
+// readValue reads a secret value from r, dropping one trailing newline.
+func readValue(r io.Reader) (string, error) {
+	data, err := io.ReadAll(bufio.NewReader(r))
+	if err != nil {
+		return "", fmt.Errorf("read secret value: %w", err)
+	}
+	value := strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+	if value == "" {
+		return "", fmt.Errorf("read secret value: stdin was empty")
+	}
+	return value, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// maxFleetBatch is the most site IDs the API queues in one request
+const maxFleetBatch = 500
+
+// reconcileFleet queues a reconciliation type for every site matching the
+// filters. The matching sites are listed first, then queued in batches with a
+// bounded number of requests in flight.
+func reconcileFleet(ctx context.Context, args []string) error {
+	f := newAdminFlags("fleet")
+	orgID := f.String("org", "", "Only sites in this organization (public ID)")
+	labels := f.String("label", "", "Only sites with these labels, e.g. env=prod,department=library")
+	controllerVersion := f.String("controller-version", "", "Only sites running this controller version")
+	status := f.String("status", "", "Only sites with this status")
+	requestType := f.String("type", "", "Reconciliation type: secrets or firewall (required)")
+	reason := f.String("reason", "", "Why the fleet is being reconciled (required)")
+	batchSize := f.Int("batch-size", 50, "Sites queued per request")
+	concurrency := f.Int("concurrency", 4, "Requests in flight at once")
+	dryRun := f.Bool("dry-run", false, "List matching sites without queueing anything")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	if *requestType != "secrets" && *requestType != "firewall" {
+		return fmt.Errorf("--type must be secrets or firewall")
+	}
+	if *reason == "" {
+		return fmt.Errorf("--reason is required")
+	}
+	if *batchSize < 1 || *batchSize > maxFleetBatch {
+		return fmt.Errorf("--batch-size must be between 1 and %d", maxFleetBatch)
+	}
+	if *concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+	client, err := f.client()
+	if err != nil {
+		return err
+	}
+
+	filter := &libopsv1.FleetFilter{OrganizationId: *orgID}
+	if *labels != "" {
+		filter.LabelSelector = labels
+	}
+	if *controllerVersion != "" {
+		filter.ControllerVersion = controllerVersion
+	}
+	if *status != "" {
+		filter.Status = status
+	}
+	matched, err := client.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{
+		Filter:             filter,
+		ReconciliationType: *requestType,
+		Reason:             *reason,
+		DryRun:             true,
+	}))
+	if err != nil {
+		return err
+	}
+	siteIDs := matched.Msg.SiteIds
+	fmt.Printf("%d sites match\n", len(siteIDs))
+	if *dryRun {
+		for _, siteID := range siteIDs {
+			fmt.Println("  " + siteID)
+		}
+		return nil
+	}
+	if len(siteIDs) == 0 {
+		return nil
+	}
+
+	var batches [][]string
+	for start := 0; start < len(siteIDs); start += *batchSize {
+		batches = append(batches, siteIDs[start:min(start+*batchSize, len(siteIDs))])
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		done     int
+		queued   int
+		failures []*libopsv1.FleetReconcileFailure
+	)
+	sem := make(chan struct{}, *concurrency)
+	for _, batch := range batches {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(batch []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := client.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{
+				SiteIds:            batch,
+				ReconciliationType: *requestType,
+				Reason:             *reason,
+			}))
+
+			mu.Lock()
+			defer mu.Unlock()
+			done++
+			if err != nil {
+				for _, siteID := range batch {
+					failures = append(failures, &libopsv1.FleetReconcileFailure{SiteId: siteID, Error: err.Error()})
+				}
+				fmt.Printf("[%d/%d] queued 0, failed %d: %v\n", done, len(batches), len(batch), err)
+				return
+			}
+			queued += len(resp.Msg.SiteIds)
+			failures = append(failures, resp.Msg.Failures...)
+			fmt.Printf("[%d/%d] queued %d, failed %d\n", done, len(batches), len(resp.Msg.SiteIds), len(resp.Msg.Failures))
+		}(batch)
+	}
+	wg.Wait()
+
+	fmt.Printf("\n✓ Queued %s reconciliation for %d of %d sites\n", *requestType, queued, len(siteIDs))
+	fmt.Println("  Sites reconcile as their maintenance windows open.")
+	if len(failures) == 0 {
+		return nil
+	}
+	fmt.Printf("\nFailed (%d):\n", len(failures))
+	for _, failure := range failures {
+		fmt.Printf("  %s  %s\n", failure.SiteId, failure.Error)
+	}
+	return fmt.Errorf("%d sites were not queued", len(failures))
+}
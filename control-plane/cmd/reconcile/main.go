@@ -23,6 +23,17 @@ type SiteReconciliationRequest struct {
 }
 
 func main() {
+	// Subcommands manage existing reconciliations; without one, publish a request
+	if len(os.Args) > 1 {
+		if command, ok := runCommands[os.Args[1]]; ok {
+			if err := command(context.Background(), os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	// CLI flags
 	var (
 		sitePublicID    = flag.String("site-public-id", "", "Site Public ID (UUID) (required)")
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	logging "cloud.google.com/go/logging/apiv2"
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"connectrpc.com/connect"
+	"google.golang.org/api/iterator"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// defaultEndpoint is the admin API used when neither --api-url nor
+// LIBOPS_ENDPOINT say otherwise
+const defaultEndpoint = "https://api.libops.io"
+
+// runCommands manage site reconciliations through the admin API
+var runCommands = map[string]func(ctx context.Context, args []string) error{
+	"list":   listRuns,
+	"status": showRun,
+	"retry":  retryRun,
+	"cancel": cancelRun,
+	"fleet":  reconcileFleet,
+}
+
+// adminFlags are the flags every run command takes
+type adminFlags struct {
+	*flag.FlagSet
+	apiURL *string
+}
+
+func newAdminFlags(name string) adminFlags {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	endpoint := os.Getenv("LIBOPS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	return adminFlags{
+		FlagSet: fs,
+		apiURL:  fs.String("api-url", endpoint, "LibOps API URL (default $LIBOPS_ENDPOINT)"),
+	}
+}
+
+// runID parses the command's flags and returns its single run ID argument
+func (f adminFlags) runID(args []string) (string, error) {
+	if err := f.Parse(args); err != nil {
+		return "", err
+	}
+	if f.NArg() != 1 {
+		return "", fmt.Errorf("usage: reconcile %s [flags] <run-id>", f.Name())
+	}
+	return f.Arg(0), nil
+}
+
+// client is an admin API client authenticated with LIBOPS_API_KEY
+func (f adminFlags) client() (libopsv1connect.AdminServiceClient, error) {
+	apiKey := os.Getenv("LIBOPS_API_KEY")
+	if apiKey == "" {
+		return nil, errors.New("LIBOPS_API_KEY environment variable is required")
+	}
+	auth := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+apiKey)
+			return next(ctx, req)
+		}
+	})
+	endpoint := strings.TrimSuffix(*f.apiURL, "/")
+	return libopsv1connect.NewAdminServiceClient(http.DefaultClient, endpoint, connect.WithInterceptors(auth)), nil
+}
+
+func listRuns(ctx context.Context, args []string) error {
+	f := newAdminFlags("list")
+	status := f.String("status", "", "Only runs with this status: completed, failed")
+	orgID := f.String("org", "", "Only runs for this organization (public ID)")
+	siteID := f.String("site", "", "Only runs for this site (public ID)")
+	limit := f.Int("limit", 20, "Number of reconciliations to list")
+	pageToken := f.String("page-token", "", "Page token from a previous list")
+	if err := f.Parse(args); err != nil {
+		return err
+	}
+	client, err := f.client()
+	if err != nil {
+		return err
+	}
+
+	runType := "reconciliation"
+	req := &libopsv1.ListReconciliationRunsRequest{
+		PageSize:  int32(*limit),
+		PageToken: *pageToken,
+		RunType:   &runType,
+	}
+	if *status != "" {
+		req.Status = status
+	}
+	if *orgID != "" {
+		req.OrganizationId = orgID
+	}
+	if *siteID != "" {
+		req.SiteId = siteID
+	}
+	resp, err := client.ListReconciliationRuns(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-48s  %-10s  %-10s  %-20s  %s\n", "RUN ID", "STATUS", "TYPE", "CREATED", "SITE")
+	for _, r := range resp.Msg.Runs {
+		fmt.Printf("%-48s  %-10s  %-10s  %-20s  %s\n",
+			r.RunId, r.Status, r.ReconciliationType, formatTime(r.CreatedAt), r.SiteId)
+	}
+	if resp.Msg.NextPageToken != "" {
+		fmt.Printf("\nMore runs: --page-token=%s\n", resp.Msg.NextPageToken)
+	}
+	return nil
+}
+
+func showRun(ctx context.Context, args []string) error {
+	f := newAdminFlags("status")
+	lines := f.Int("lines", 50, "Number of log lines to show (0 for none)")
+	gcpProject := f.String("gcp-project", "", "GCP project ID of the control plane, whose logs are shown")
+	runID, err := f.runID(args)
+	if err != nil {
+		return err
+	}
+	client, err := f.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DescribeReconciliationRun(ctx, connect.NewRequest(&libopsv1.DescribeReconciliationRunRequest{RunId: runID}))
+	if err != nil {
+		return err
+	}
+	printRun(resp.Msg.Run)
+
+	if len(resp.Msg.Results) > 0 {
+		fmt.Println("\nResults:")
+		for _, result := range resp.Msg.Results {
+			target := result.ModuleType
+			if result.SiteId != "" {
+				target = "site " + result.SiteId
+			}
+			fmt.Printf("  %-10s  %-48s  %s\n", result.Status, target, formatTime(result.CompletedAt))
+			if result.ErrorMessage != "" {
+				fmt.Printf("    %s\n", result.ErrorMessage)
+			}
+		}
+	}
+
+	if *lines == 0 || resp.Msg.LogFilter == "" {
+		return nil
+	}
+	logProject := resp.Msg.LogProject
+	if logProject == "" {
+		logProject = *gcpProject
+	}
+	if logProject == "" {
+		fmt.Printf("\nLogs (pass --gcp-project to show them): %s\n", resp.Msg.LogFilter)
+		return nil
+	}
+	fmt.Printf("\nLast %d log lines:\n", *lines)
+	return printLogs(ctx, logProject, resp.Msg.LogFilter, *lines)
+}
+
+// retryRun schedules a failed reconciliation for the event router to publish
+// again
+func retryRun(ctx context.Context, args []string) error {
+	f := newAdminFlags("retry")
+	reason := f.String("reason", "", "Why the reconciliation is being retried (required)")
+	runID, err := f.runID(args)
+	if err != nil {
+		return err
+	}
+	client, err := f.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RetryReconciliationRun(ctx, connect.NewRequest(&libopsv1.RetryReconciliationRunRequest{
+		RunId:  runID,
+		Reason: *reason,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Scheduled retry of run: %s\n", resp.Msg.Run.RunId)
+	fmt.Println("  The event router publishes it again on its next retry pass.")
+	return nil
+}
+
+func cancelRun(ctx context.Context, args []string) error {
+	f := newAdminFlags("cancel")
+	reason := f.String("reason", "", "Why retries of the reconciliation are being cancelled (required)")
+	runID, err := f.runID(args)
+	if err != nil {
+		return err
+	}
+	client, err := f.client()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CancelReconciliationRun(ctx, connect.NewRequest(&libopsv1.CancelReconciliationRunRequest{
+		RunId:  runID,
+		Reason: *reason,
+	}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✓ Cancelled retries of run: %s\n", resp.Msg.Run.RunId)
+	return nil
+}
+
+func printRun(r *libopsv1.PlatformReconciliationRun) {
+	fmt.Printf("Run ID:       %s\n", r.RunId)
+	fmt.Printf("Status:       %s\n", r.Status)
+	fmt.Printf("Type:         %s\n", r.ReconciliationType)
+	fmt.Printf("Attempt:      %d\n", r.Attempt)
+	if r.RetryState != "" {
+		fmt.Printf("Retry:        %s\n", r.RetryState)
+	}
+	if r.NextRetryAt != 0 {
+		fmt.Printf("Next retry:   %s\n", formatTime(r.NextRetryAt))
+	}
+	if r.OrganizationId != "" {
+		fmt.Printf("Organization: %s\n", r.OrganizationId)
+	}
+	if r.ProjectId != "" {
+		fmt.Printf("Project:      %s\n", r.ProjectId)
+	}
+	if r.SiteId != "" {
+		fmt.Printf("Site:         %s\n", r.SiteId)
+	}
+	fmt.Printf("Created:      %s\n", formatTime(r.CreatedAt))
+	if r.StartedAt != 0 {
+		fmt.Printf("Started:      %s\n", formatTime(r.StartedAt))
+	}
+	if r.CompletedAt != 0 {
+		fmt.Printf("Completed:    %s\n", formatTime(r.CompletedAt))
+	}
+	if r.ErrorMessage != "" {
+		fmt.Printf("Error:        %s\n", r.ErrorMessage)
+	}
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return "-"
+	}
+	return time.Unix(unix, 0).UTC().Format("2006-01-02 15:04:05")
+}
+
+// printLogs prints the last n log entries matching filter, oldest first
+func printLogs(ctx context.Context, project, filter string, n int) error {
+	client, err := logging.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	it := client.ListLogEntries(ctx, &loggingpb.ListLogEntriesRequest{
+		ResourceNames: []string{"projects/" + project},
+		Filter:        filter,
+		OrderBy:       "timestamp desc",
+		PageSize:      int32(n),
+	})
+	var entries []*loggingpb.LogEntry
+	for len(entries) < n {
+		entry, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	slices.Reverse(entries)
+	for _, entry := range entries {
+		fmt.Println(logMessage(entry))
+	}
+	return nil
+}
+
+// logMessage is the text of a log entry; the control plane logs JSON with the
+// message under "msg"
+func logMessage(entry *loggingpb.LogEntry) string {
+	if payload := entry.GetJsonPayload(); payload != nil {
+		fields := payload.AsMap()
+		if msg, ok := fields["msg"].(string); ok {
+			delete(fields, "msg")
+			delete(fields, "time")
+			delete(fields, "level")
+			if len(fields) == 0 {
+				return msg
+			}
+			attrs, _ := json.Marshal(fields)
+			return msg + " " + string(attrs)
+		}
+		out, _ := json.Marshal(payload.AsMap())
+		return string(out)
+	}
+	return entry.GetTextPayload()
+}
@@ -10,6 +10,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/libops/control-plane/internal/database"
 	"github.com/libops/control-plane/internal/siteproxy"
 )
 
@@ -28,8 +29,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Record reconciliation outcomes for the sites' reconciliation timelines when a database is configured
+	var recorder siteproxy.Recorder
+	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
+		db, err := database.NewPool(databaseURL, database.DefaultConfig())
+		if err != nil {
+			slog.Error("Failed to connect to database", "error", err)
+			os.Exit(1)
+		}
+		defer db.Close()
+		recorder = database.NewQuerier(db)
+	} else {
+		slog.Warn("DATABASE_URL not set, reconciliation outcomes will not be recorded")
+	}
+
 	// Create proxy handler
-	proxy := siteproxy.NewProxy(apiURL)
+	proxy := siteproxy.NewProxy(apiURL, recorder)
 
 	// Setup HTTP server
 	mux := http.NewServeMux()
@@ -8,22 +8,37 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
-	"os/exec"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	logging "cloud.google.com/go/logging/apiv2"
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	run "cloud.google.com/go/run/apiv2"
+	"cloud.google.com/go/run/apiv2/runpb"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/googleapis/gax-go/v2"
 	"github.com/libops/api/db"
 	"github.com/libops/api/db/types"
 )
 
 func main() {
+	// Subcommands manage existing runs; without one, create and trigger a run
+	if len(os.Args) > 1 {
+		if command, ok := runCommands[os.Args[1]]; ok {
+			if err := command(context.Background(), os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	// CLI flags
 	var (
-		orgID      = flag.Int64("org-id", 0, "Organization ID (required)")
-		projectID  = flag.Int64("project-id", 0, "Project ID (optional, for project-level runs)")
-		siteID     = flag.Int64("site-id", 0, "Site ID (optional, for site-level runs)")
+		orgRef     = flag.String("org", "", "Organization public ID or name (required unless --project or --site identify it)")
+		projectRef = flag.String("project", "", "Project public ID or name (optional, for project-level runs)")
+		siteRef    = flag.String("site", "", "Site public ID or name (optional, for site-level runs)")
 		dryRun     = flag.Bool("dry-run", false, "Create run but don't trigger Cloud Run job")
 		watch      = flag.Bool("watch", false, "Watch the job execution and tail logs")
 		bootstrap  = flag.Bool("bootstrap", false, "Bootstrap organization (create folder, project, and state bucket)")
@@ -35,14 +50,14 @@ func main() {
 	flag.Parse()
 
 	// Validate required flags
-	if *orgID == 0 {
-		fmt.Fprintf(os.Stderr, "Error: --org-id is required\n")
+	if *orgRef == "" && *projectRef == "" && *siteRef == "" {
+		fmt.Fprintf(os.Stderr, "Error: --org is required\n")
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	if *bootstrap && (*projectID != 0 || *siteID != 0) {
-		fmt.Fprintf(os.Stderr, "Error: --bootstrap cannot be used with --project-id or --site-id\n")
+	if *bootstrap && (*projectRef != "" || *siteRef != "") {
+		fmt.Fprintf(os.Stderr, "Error: --bootstrap cannot be used with --project or --site\n")
 		os.Exit(1)
 	}
 
@@ -71,8 +86,15 @@ func main() {
 
 	queries := db.New(sqlDB)
 
-	// Fetch organization to get public_id
-	org, err := queries.GetOrganizationByID(ctx, *orgID)
+	// Resolve names and public IDs to the internal IDs runs are recorded with
+	orgRes, projectRes, siteRes, err := resolveScope(ctx, queries, *orgRef, *projectRef, *siteRef)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	orgID := orgRes.ID
+
+	org, err := queries.GetOrganizationByID(ctx, orgID)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to fetch organization: %v\n", err)
 		os.Exit(1)
@@ -86,25 +108,27 @@ func main() {
 	if *bootstrap {
 		scope = "bootstrap"
 		modules = []string{"organization"}
-		slog.Info("Bootstrapping organization", "org_id", *orgID, "public_id", org.PublicID)
-	} else if *siteID != 0 {
+		slog.Info("Bootstrapping organization", "org_id", orgID, "org_public_id", org.PublicID)
+	} else if siteRes.ID != 0 {
 		scope = "site"
 		modules = []string{"site"}
-		projID = projectID
-		if *projectID != 0 {
-			projID = projectID
-		}
-		sID = siteID
-		slog.Info("Running terraform for site", "org_id", *orgID, "project_id", *projectID, "site_id", *siteID)
-	} else if *projectID != 0 {
+		projID = &projectRes.ID
+		sID = &siteRes.ID
+		slog.Info("Running terraform for site",
+			"org_id", orgID, "org_public_id", orgRes.PublicID,
+			"project_id", projectRes.ID, "project_public_id", projectRes.PublicID,
+			"site_id", siteRes.ID, "site_public_id", siteRes.PublicID)
+	} else if projectRes.ID != 0 {
 		scope = "project"
 		modules = []string{"organization", "project"}
-		projID = projectID
-		slog.Info("Running terraform for project", "org_id", *orgID, "project_id", *projectID)
+		projID = &projectRes.ID
+		slog.Info("Running terraform for project",
+			"org_id", orgID, "org_public_id", orgRes.PublicID,
+			"project_id", projectRes.ID, "project_public_id", projectRes.PublicID)
 	} else {
 		scope = "organization"
 		modules = []string{"organization"}
-		slog.Info("Running terraform for organization", "org_id", *orgID)
+		slog.Info("Running terraform for organization", "org_id", orgID, "org_public_id", orgRes.PublicID)
 	}
 
 	// Determine GCP project for the job
@@ -123,7 +147,7 @@ func main() {
 		if *bootstrap {
 			fmt.Fprintf(os.Stderr, "Error: --gcp-project or LIBOPS_ORCHESTRATOR_PROJECT env var is required for bootstrap\n")
 		} else {
-			fmt.Fprintf(os.Stderr, "Error: --gcp-project is required (organization %d has no gcp_project_id set)\n", *orgID)
+			fmt.Fprintf(os.Stderr, "Error: --gcp-project is required (organization %s has no gcp_project_id set)\n", orgRes)
 		}
 		os.Exit(1)
 	}
@@ -147,7 +171,7 @@ func main() {
 	now := time.Now()
 
 	var orgIDParam, projIDParam, sIDParam sql.NullInt64
-	orgIDParam = sql.NullInt64{Int64: *orgID, Valid: true}
+	orgIDParam = sql.NullInt64{Int64: orgID, Valid: true}
 	if projID != nil {
 		projIDParam = sql.NullInt64{Int64: *projID, Valid: true}
 	}
@@ -178,12 +202,20 @@ func main() {
 
 	fmt.Printf("✓ Created reconciliation run: %s\n", runID)
 	fmt.Printf("  Scope: %s\n", scope)
+	fmt.Printf("  Organization: %s\n", orgRes)
+	if projID != nil {
+		fmt.Printf("  Project: %s\n", projectRes)
+	}
+	if sID != nil {
+		fmt.Printf("  Site: %s\n", siteRes)
+	}
 	fmt.Printf("  Modules: %s\n", strings.Join(modules, ", "))
 
 	stateBucket := fmt.Sprintf("libops-org-%s-tfstate", org.PublicID[:8])
 	envVars := []string{
 		"RUN_ID=" + runID,
 		"TERRAFORM_STATE_BUCKET=" + stateBucket,
+		"LIBOPS_ORGANIZATION_ID=" + org.PublicID,
 	}
 	if *bootstrap {
 		envVars = append(envVars, "BOOTSTRAP=true")
@@ -208,50 +240,182 @@ func main() {
 	// Trigger Cloud Run job
 	fmt.Printf("\n⚡ Triggering Cloud Run job in project %s...\n", targetProject)
 
-	args := []string{
-		"run", "jobs", "execute", *jobName,
-		"--project=" + targetProject,
-		"--region=" + *region,
-		"--set-env-vars=" + strings.Join(envVars, ","),
-	}
-
-	if *watch {
-		args = append(args, "--wait")
+	jobs, err := run.NewJobsClient(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create Cloud Run client: %v\n", err)
+		os.Exit(1)
 	}
+	defer jobs.Close()
 
-	cmd := exec.CommandContext(ctx, "gcloud", args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
+	op, execution, err := triggerJob(ctx, jobs, targetProject, *region, *jobName, envVars)
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "\nFailed to execute Cloud Run job: %v\n", err)
 		os.Exit(1)
 	}
 
 	fmt.Printf("\n✓ Cloud Run job triggered successfully\n")
 	fmt.Printf("\nRun ID: %s\n", runID)
+	fmt.Printf("Execution: %s\n", execution.Name)
 
 	if *watch {
-		// Poll for final status
-		fmt.Println("\n⏳ Checking final status...")
-		time.Sleep(2 * time.Second)
+		// Stream the execution's logs until it finishes
+		logsCtx, stopLogs := context.WithCancel(ctx)
+		logsDone := make(chan struct{})
+		go func() {
+			defer close(logsDone)
+			if err := tailExecutionLogs(logsCtx, targetProject, execution.Name); err != nil && logsCtx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "Failed to stream execution logs: %v\n", err)
+			}
+		}()
+
+		execution, err = op.Wait(ctx)
+		// Entries reach the tail a few seconds after they're written
+		time.Sleep(logFlushDelay)
+		stopLogs()
+		<-logsDone
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "\nFailed to wait for Cloud Run job: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("\nExecution finished: %d succeeded, %d failed, %d cancelled\n",
+			execution.SucceededCount, execution.FailedCount, execution.CancelledCount)
 
-		run, err := queries.GetReconciliationRunByID(ctx, runID)
+		reconciliation, err := queries.GetReconciliationRunByID(ctx, runID)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Failed to get run status: %v\n", err)
 			os.Exit(1)
 		}
 
-		fmt.Printf("Final Status: %s\n", run.Status)
-		if run.ErrorMessage.Valid {
-			fmt.Printf("Error: %s\n", run.ErrorMessage.String)
+		fmt.Printf("Final Status: %s\n", reconciliation.Status.ReconciliationsStatus)
+		if reconciliation.ErrorMessage.Valid {
+			fmt.Printf("Error: %s\n", reconciliation.ErrorMessage.String)
 		}
-	} else {
+		if execution.FailedCount > 0 || execution.CancelledCount > 0 {
+			os.Exit(1)
+		}
+	} else if execution.LogUri != "" {
 		fmt.Println("\nMonitor with:")
-		fmt.Printf("  gcloud run jobs executions list \\\n")
-		fmt.Printf("    --project=%s \\\n", targetProject)
-		fmt.Printf("    --region=%s \\\n", *region)
-		fmt.Printf("    --job=%s\n", *jobName)
+		fmt.Printf("  %s\n", execution.LogUri)
+	}
+}
+
+// logFlushDelay is how long to keep streaming logs after an execution
+// finishes, so its last entries aren't cut off
+const logFlushDelay = 10 * time.Second
+
+// triggerJob runs the terraform runner job with the given environment and
+// returns once Cloud Run has created its execution
+func triggerJob(ctx context.Context, jobs *run.JobsClient, project, region, jobName string, envVars []string) (*run.RunJobOperation, *runpb.Execution, error) {
+	op, err := jobs.RunJob(ctx, &runpb.RunJobRequest{
+		Name: fmt.Sprintf("projects/%s/locations/%s/jobs/%s", project, region, jobName),
+		Overrides: &runpb.RunJobRequest_Overrides{
+			ContainerOverrides: []*runpb.RunJobRequest_Overrides_ContainerOverride{{Env: containerEnv(envVars)}},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	execution, err := startedExecution(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	return op, execution, nil
+}
+
+// containerEnv converts NAME=value pairs to Cloud Run environment variables
+func containerEnv(envVars []string) []*runpb.EnvVar {
+	env := make([]*runpb.EnvVar, 0, len(envVars))
+	for _, envVar := range envVars {
+		name, value, _ := strings.Cut(envVar, "=")
+		env = append(env, &runpb.EnvVar{Name: name, Values: &runpb.EnvVar_Value{Value: value}})
+	}
+	return env
+}
+
+// executionOperation is the part of a *run.RunJobOperation that
+// startedExecution polls
+type executionOperation interface {
+	Metadata() (*runpb.Execution, error)
+	Done() bool
+	Wait(ctx context.Context, opts ...gax.CallOption) (*runpb.Execution, error)
+	Poll(ctx context.Context, opts ...gax.CallOption) (*runpb.Execution, error)
+}
+
+// startedExecution polls a job run until Cloud Run reports the execution it
+// created
+func startedExecution(ctx context.Context, op executionOperation) (*runpb.Execution, error) {
+	for {
+		execution, err := op.Metadata()
+		if err != nil {
+			return nil, err
+		}
+		if execution != nil && execution.Name != "" {
+			return execution, nil
+		}
+		if op.Done() {
+			return op.Wait(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+		if _, err := op.Poll(ctx); err != nil {
+			return nil, err
+		}
 	}
 }
 
+// tailExecutionLogs prints an execution's log entries as Cloud Logging
+// receives them, until ctx is cancelled
+func tailExecutionLogs(ctx context.Context, project, executionName string) error {
+	client, err := logging.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.TailLogEntries(ctx)
+	if err != nil {
+		return err
+	}
+	execution := executionName[strings.LastIndex(executionName, "/")+1:]
+	err = stream.Send(&loggingpb.TailLogEntriesRequest{
+		ResourceNames: []string{"projects/" + project},
+		Filter:        fmt.Sprintf(`resource.type="cloud_run_job" AND labels."run.googleapis.com/execution_name"=%q`, execution),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		for _, entry := range resp.Entries {
+			fmt.Println(logMessage(entry))
+		}
+	}
+}
+
+// logMessage is the text of a log entry; the runner logs JSON with the
+// message under "msg"
+func logMessage(entry *loggingpb.LogEntry) string {
+	if payload := entry.GetJsonPayload(); payload != nil {
+		fields := payload.AsMap()
+		if msg, ok := fields["msg"].(string); ok {
+			delete(fields, "msg")
+			delete(fields, "time")
+			delete(fields, "level")
+			if len(fields) == 0 {
+				return msg
+			}
+			attrs, _ := json.Marshal(fields)
+			return msg + " " + string(attrs)
+		}
+		out, _ := json.Marshal(payload.AsMap())
+		return string(out)
+	}
+	return entry.GetTextPayload()
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"cloud.google.com/go/run/apiv2/runpb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestContainerEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		envVars []string
+		want    map[string]string
+	}{
+		{
+			name: "none",
+			want: map[string]string{},
+		},
+		{
+			name:    "pairs",
+			envVars: []string{"ORGANIZATION_ID=1", "TF_ACTION=apply"},
+			want:    map[string]string{"ORGANIZATION_ID": "1", "TF_ACTION": "apply"},
+		},
+		{
+			name:    "value containing equals",
+			envVars: []string{"TF_VAR_filter=a=b"},
+			want:    map[string]string{"TF_VAR_filter": "a=b"},
+		},
+		{
+			name:    "empty value",
+			envVars: []string{"SITE_ID=", "PROJECT_ID"},
+			want:    map[string]string{"SITE_ID": "", "PROJECT_ID": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := containerEnv(tt.envVars)
+			if len(env) != len(tt.envVars) {
+				t.Fatalf("got %d variables, want %d", len(env), len(tt.envVars))
+			}
+			for _, v := range env {
+				want, ok := tt.want[v.Name]
+				if !ok {
+					t.Errorf("unexpected variable %q", v.Name)
+					continue
+				}
+				if got := v.GetValue(); got != want {
+					t.Errorf("%s = %q, want %q", v.Name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLogMessage(t *testing.T) {
+	jsonEntry := func(fields map[string]any) *loggingpb.LogEntry {
+		payload, err := structpb.NewStruct(fields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &loggingpb.LogEntry{Payload: &loggingpb.LogEntry_JsonPayload{JsonPayload: payload}}
+	}
+
+	tests := []struct {
+		name  string
+		entry *loggingpb.LogEntry
+		want  string
+	}{
+		{
+			name:  "message",
+			entry: jsonEntry(map[string]any{"msg": "terraform apply", "time": "2026-01-02T03:04:05Z", "level": "INFO"}),
+			want:  "terraform apply",
+		},
+		{
+			name:  "message with attributes",
+			entry: jsonEntry(map[string]any{"msg": "module applied", "level": "INFO", "module": "site", "resources": 3}),
+			want:  `module applied {"module":"site","resources":3}`,
+		},
+		{
+			name:  "json without message",
+			entry: jsonEntry(map[string]any{"level": "ERROR", "error": "exit status 1"}),
+			want:  `{"error":"exit status 1","level":"ERROR"}`,
+		},
+		{
+			name:  "text",
+			entry: &loggingpb.LogEntry{Payload: &loggingpb.LogEntry_TextPayload{TextPayload: "Apply complete!"}},
+			want:  "Apply complete!",
+		},
+		{
+			name:  "empty",
+			entry: &loggingpb.LogEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logMessage(tt.entry); got != tt.want {
+				t.Errorf("logMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeOperation reports metadata from a list of polls, one per Poll call
+type fakeOperation struct {
+	metadata []*runpb.Execution
+	done     bool
+	result   *runpb.Execution
+	err      error
+	polls    int
+}
+
+func (op *fakeOperation) Metadata() (*runpb.Execution, error) {
+	if op.err != nil {
+		return nil, op.err
+	}
+	return op.metadata[op.polls], nil
+}
+
+func (op *fakeOperation) Done() bool {
+	return op.done
+}
+
+func (op *fakeOperation) Wait(ctx context.Context, opts ...gax.CallOption) (*runpb.Execution, error) {
+	return op.result, nil
+}
+
+func (op *fakeOperation) Poll(ctx context.Context, opts ...gax.CallOption) (*runpb.Execution, error) {
+	op.polls++
+	return nil, nil
+}
+
+func TestStartedExecution(t *testing.T) {
+	execution := &runpb.Execution{Name: "projects/p/locations/us-east1/jobs/tf-runner/executions/tf-runner-abc"}
+	errMetadata := errors.New("metadata unavailable")
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		op        *fakeOperation
+		want      *runpb.Execution
+		wantPolls int
+		wantErr   error
+	}{
+		{
+			name: "started",
+			op:   &fakeOperation{metadata: []*runpb.Execution{execution}},
+			want: execution,
+		},
+		{
+			name:      "started after a poll",
+			op:        &fakeOperation{metadata: []*runpb.Execution{nil, execution}},
+			want:      execution,
+			wantPolls: 1,
+		},
+		{
+			name: "finished before metadata",
+			op:   &fakeOperation{metadata: []*runpb.Execution{{}}, done: true, result: execution},
+			want: execution,
+		},
+		{
+			name:    "cancelled while waiting",
+			ctx:     cancelled,
+			op:      &fakeOperation{metadata: []*runpb.Execution{nil, execution}},
+			wantErr: context.Canceled,
+		},
+		{
+			name:    "metadata error",
+			op:      &fakeOperation{err: errMetadata},
+			wantErr: errMetadata,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got, err := startedExecution(ctx, tt.op)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("startedExecution() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("startedExecution() = %v, want %v", got, tt.want)
+			}
+			if tt.op.polls != tt.wantPolls {
+				t.Errorf("polled %d times, want %d", tt.op.polls, tt.wantPolls)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/libops/api/db"
+)
+
+// resource is an organization, project or site resolved from a public ID or
+// name, with the internal IDs of its parents
+type resource struct {
+	ID             int64
+	PublicID       string
+	Name           string
+	OrganizationID int64
+	ProjectID      int64
+}
+
+// String shows both identifiers, so output can be matched against the
+// dashboard and the database alike
+func (r resource) String() string {
+	return fmt.Sprintf("%s (%s, id %d)", r.Name, r.PublicID, r.ID)
+}
+
+// resolveOrganization finds an organization by public ID or name
+func resolveOrganization(ctx context.Context, queries db.Querier, ref string) (resource, error) {
+	if _, err := uuid.Parse(ref); err == nil {
+		org, err := queries.GetOrganization(ctx, ref)
+		if err != nil {
+			return resource{}, lookupError("organization", ref, err)
+		}
+		return resource{ID: org.ID, PublicID: org.PublicID, Name: org.Name, OrganizationID: org.ID}, nil
+	}
+
+	rows, err := queries.ListOrganizationsByName(ctx, ref)
+	if err != nil {
+		return resource{}, lookupError("organization", ref, err)
+	}
+	matches := make([]resource, 0, len(rows))
+	for _, row := range rows {
+		matches = append(matches, resource{ID: row.ID, PublicID: row.PublicID, Name: row.Name, OrganizationID: row.ID})
+	}
+	return onlyMatch("organization", ref, matches)
+}
+
+// resolveProject finds a project by public ID or name. A name is looked up in
+// orgID's projects when it's set.
+func resolveProject(ctx context.Context, queries db.Querier, ref string, orgID int64) (resource, error) {
+	if _, err := uuid.Parse(ref); err == nil {
+		project, err := queries.GetProject(ctx, ref)
+		if err != nil {
+			return resource{}, lookupError("project", ref, err)
+		}
+		return resource{ID: project.ID, PublicID: project.PublicID, Name: project.Name, OrganizationID: project.OrganizationID, ProjectID: project.ID}, nil
+	}
+
+	rows, err := queries.ListProjectsByName(ctx, db.ListProjectsByNameParams{
+		Name:           ref,
+		OrganizationID: sql.NullInt64{Int64: orgID, Valid: orgID != 0},
+	})
+	if err != nil {
+		return resource{}, lookupError("project", ref, err)
+	}
+	matches := make([]resource, 0, len(rows))
+	for _, row := range rows {
+		matches = append(matches, resource{ID: row.ID, PublicID: row.PublicID, Name: row.Name, OrganizationID: row.OrganizationID, ProjectID: row.ID})
+	}
+	return onlyMatch("project", ref, matches)
+}
+
+// resolveSite finds a site by public ID or name. A name is looked up in
+// projectID's or orgID's sites when they're set.
+func resolveSite(ctx context.Context, queries db.Querier, ref string, orgID, projectID int64) (resource, error) {
+	if _, err := uuid.Parse(ref); err == nil {
+		site, err := queries.GetSite(ctx, ref)
+		if err != nil {
+			return resource{}, lookupError("site", ref, err)
+		}
+		project, err := queries.GetProjectByID(ctx, site.ProjectID)
+		if err != nil {
+			return resource{}, lookupError("site", ref, err)
+		}
+		return resource{ID: site.ID, PublicID: site.PublicID, Name: site.Name, OrganizationID: project.OrganizationID, ProjectID: site.ProjectID}, nil
+	}
+
+	rows, err := queries.ListSitesByName(ctx, db.ListSitesByNameParams{
+		Name:           ref,
+		OrganizationID: sql.NullInt64{Int64: orgID, Valid: orgID != 0},
+		ProjectID:      sql.NullInt64{Int64: projectID, Valid: projectID != 0},
+	})
+	if err != nil {
+		return resource{}, lookupError("site", ref, err)
+	}
+	matches := make([]resource, 0, len(rows))
+	for _, row := range rows {
+		matches = append(matches, resource{ID: row.ID, PublicID: row.PublicID, Name: row.Name, OrganizationID: row.OrganizationID, ProjectID: row.ProjectID})
+	}
+	return onlyMatch("site", ref, matches)
+}
+
+func lookupError(kind, ref string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("no %s %q", kind, ref)
+	}
+	return fmt.Errorf("failed to look up %s %q: %w", kind, ref, err)
+}
+
+// onlyMatch is the single resource a name matched; names that match several
+// are refused with their public IDs, so the operator can pick one
+func onlyMatch(kind, ref string, matches []resource) (resource, error) {
+	switch len(matches) {
+	case 0:
+		return resource{}, fmt.Errorf("no %s named %q", kind, ref)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.PublicID)
+	}
+	return resource{}, fmt.Errorf("%d %ss are named %q; use a public ID: %s", len(matches), kind, ref, strings.Join(ids, ", "))
+}
+
+// resolveScope resolves the organization, project and site a run is for from
+// whichever of them were named, filling in the parents of the most specific
+// one. Unnamed levels below it are left zero.
+func resolveScope(ctx context.Context, queries db.Querier, orgRef, projectRef, siteRef string) (org, project, site resource, err error) {
+	if orgRef != "" {
+		if org, err = resolveOrganization(ctx, queries, orgRef); err != nil {
+			return
+		}
+	}
+	if projectRef != "" {
+		if project, err = resolveProject(ctx, queries, projectRef, org.ID); err != nil {
+			return
+		}
+		if org.ID != 0 && project.OrganizationID != org.ID {
+			err = fmt.Errorf("project %s is not in organization %s", project, org)
+			return
+		}
+	}
+	if siteRef != "" {
+		if site, err = resolveSite(ctx, queries, siteRef, org.ID, project.ID); err != nil {
+			return
+		}
+		if project.ID != 0 && site.ProjectID != project.ID {
+			err = fmt.Errorf("site %s is not in project %s", site, project)
+			return
+		}
+		if org.ID != 0 && site.OrganizationID != org.ID {
+			err = fmt.Errorf("site %s is not in organization %s", site, org)
+			return
+		}
+		if project.ID == 0 {
+			row, lookupErr := queries.GetProjectByID(ctx, site.ProjectID)
+			if lookupErr != nil {
+				err = lookupError("project", fmt.Sprint(site.ProjectID), lookupErr)
+				return
+			}
+			project = resource{ID: row.ID, PublicID: row.PublicID, Name: row.Name, OrganizationID: row.OrganizationID, ProjectID: row.ID}
+		}
+	}
+	if org.ID == 0 && project.ID != 0 {
+		row, lookupErr := queries.GetOrganizationByID(ctx, project.OrganizationID)
+		if lookupErr != nil {
+			err = lookupError("organization", fmt.Sprint(project.OrganizationID), lookupErr)
+			return
+		}
+		org = resource{ID: row.ID, PublicID: row.PublicID, Name: row.Name, OrganizationID: row.ID}
+	}
+	return
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/libops/api/db"
+)
+
+const (
+	acmeID    = "0194d3a0-0000-7000-8000-000000000001"
+	globexID  = "0194d3a0-0000-7000-8000-000000000002"
+	websiteID = "0194d3a0-0000-7000-8000-000000000010"
+	blogID    = "0194d3a0-0000-7000-8000-000000000100"
+	missingID = "0194d3a0-0000-7000-8000-0000000000ff"
+)
+
+// fakeQuerier serves two organizations, each with a project named "websites"
+// holding a site named "blog"
+type fakeQuerier struct {
+	db.Querier
+}
+
+var (
+	fakeOrganizations = []db.ListOrganizationsByNameRow{
+		{ID: 1, PublicID: acmeID, Name: "acme"},
+		{ID: 2, PublicID: globexID, Name: "globex"},
+	}
+	fakeProjects = []db.ListProjectsByNameRow{
+		{ID: 10, PublicID: websiteID, OrganizationID: 1, Name: "websites"},
+		{ID: 20, PublicID: "0194d3a0-0000-7000-8000-000000000020", OrganizationID: 2, Name: "websites"},
+	}
+	fakeSites = []db.ListSitesByNameRow{
+		{ID: 100, PublicID: blogID, ProjectID: 10, OrganizationID: 1, Name: "blog"},
+		{ID: 200, PublicID: "0194d3a0-0000-7000-8000-000000000200", ProjectID: 20, OrganizationID: 2, Name: "blog"},
+	}
+)
+
+func (fakeQuerier) GetOrganization(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+	for _, org := range fakeOrganizations {
+		if org.PublicID == publicID {
+			return db.GetOrganizationRow{ID: org.ID, PublicID: org.PublicID, Name: org.Name}, nil
+		}
+	}
+	return db.GetOrganizationRow{}, sql.ErrNoRows
+}
+
+func (fakeQuerier) GetOrganizationByID(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+	for _, org := range fakeOrganizations {
+		if org.ID == id {
+			return db.GetOrganizationByIDRow{ID: org.ID, PublicID: org.PublicID, Name: org.Name}, nil
+		}
+	}
+	return db.GetOrganizationByIDRow{}, sql.ErrNoRows
+}
+
+func (fakeQuerier) ListOrganizationsByName(ctx context.Context, name string) ([]db.ListOrganizationsByNameRow, error) {
+	var rows []db.ListOrganizationsByNameRow
+	for _, org := range fakeOrganizations {
+		if org.Name == name {
+			rows = append(rows, org)
+		}
+	}
+	return rows, nil
+}
+
+func (fakeQuerier) GetProject(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+	for _, project := range fakeProjects {
+		if project.PublicID == publicID {
+			return db.GetProjectRow{ID: project.ID, PublicID: project.PublicID, OrganizationID: project.OrganizationID, Name: project.Name}, nil
+		}
+	}
+	return db.GetProjectRow{}, sql.ErrNoRows
+}
+
+func (fakeQuerier) GetProjectByID(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+	for _, project := range fakeProjects {
+		if project.ID == id {
+			return db.GetProjectByIDRow{ID: project.ID, PublicID: project.PublicID, OrganizationID: project.OrganizationID, Name: project.Name}, nil
+		}
+	}
+	return db.GetProjectByIDRow{}, sql.ErrNoRows
+}
+
+func (fakeQuerier) ListProjectsByName(ctx context.Context, arg db.ListProjectsByNameParams) ([]db.ListProjectsByNameRow, error) {
+	var rows []db.ListProjectsByNameRow
+	for _, project := range fakeProjects {
+		if project.Name == arg.Name && (!arg.OrganizationID.Valid || project.OrganizationID == arg.OrganizationID.Int64) {
+			rows = append(rows, project)
+		}
+	}
+	return rows, nil
+}
+
+func (fakeQuerier) GetSite(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+	for _, site := range fakeSites {
+		if site.PublicID == publicID {
+			return db.GetSiteRow{ID: site.ID, PublicID: site.PublicID, ProjectID: site.ProjectID, Name: site.Name}, nil
+		}
+	}
+	return db.GetSiteRow{}, sql.ErrNoRows
+}
+
+func (fakeQuerier) ListSitesByName(ctx context.Context, arg db.ListSitesByNameParams) ([]db.ListSitesByNameRow, error) {
+	var rows []db.ListSitesByNameRow
+	for _, site := range fakeSites {
+		if site.Name == arg.Name &&
+			(!arg.OrganizationID.Valid || site.OrganizationID == arg.OrganizationID.Int64) &&
+			(!arg.ProjectID.Valid || site.ProjectID == arg.ProjectID.Int64) {
+			rows = append(rows, site)
+		}
+	}
+	return rows, nil
+}
+
+func TestResolveScope(t *testing.T) {
+	tests := []struct {
+		name                           string
+		orgRef, projectRef, siteRef    string
+		wantOrg, wantProject, wantSite int64
+		wantErr                        string
+	}{
+		{
+			name:    "organization by public ID",
+			orgRef:  acmeID,
+			wantOrg: 1,
+		},
+		{
+			name:    "organization by name",
+			orgRef:  "globex",
+			wantOrg: 2,
+		},
+		{
+			name:    "organization not found",
+			orgRef:  missingID,
+			wantErr: `no organization "` + missingID + `"`,
+		},
+		{
+			name:    "organization name not found",
+			orgRef:  "initech",
+			wantErr: `no organization named "initech"`,
+		},
+		{
+			name:        "project by public ID fills in its organization",
+			projectRef:  websiteID,
+			wantOrg:     1,
+			wantProject: 10,
+		},
+		{
+			name:       "ambiguous project name",
+			projectRef: "websites",
+			wantErr:    `2 projects are named "websites"; use a public ID: ` + websiteID + ", 0194d3a0-0000-7000-8000-000000000020",
+		},
+		{
+			name:        "project name within an organization",
+			orgRef:      "globex",
+			projectRef:  "websites",
+			wantOrg:     2,
+			wantProject: 20,
+		},
+		{
+			name:       "project outside the organization",
+			orgRef:     "globex",
+			projectRef: websiteID,
+			wantErr:    "project websites (" + websiteID + ", id 10) is not in organization globex (" + globexID + ", id 2)",
+		},
+		{
+			name:        "site by public ID fills in its parents",
+			siteRef:     blogID,
+			wantOrg:     1,
+			wantProject: 10,
+			wantSite:    100,
+		},
+		{
+			name:    "ambiguous site name",
+			siteRef: "blog",
+			wantErr: `2 sites are named "blog"`,
+		},
+		{
+			name:        "site name within a project",
+			projectRef:  websiteID,
+			siteRef:     "blog",
+			wantOrg:     1,
+			wantProject: 10,
+			wantSite:    100,
+		},
+		{
+			name:        "site name within an organization",
+			orgRef:      "globex",
+			siteRef:     "blog",
+			wantOrg:     2,
+			wantProject: 20,
+			wantSite:    200,
+		},
+		{
+			name:    "site outside the organization",
+			orgRef:  "globex",
+			siteRef: blogID,
+			wantErr: "is not in organization globex",
+		},
+		{
+			name:    "site not found",
+			siteRef: missingID,
+			wantErr: `no site "` + missingID + `"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, project, site, err := resolveScope(context.Background(), fakeQuerier{}, tt.orgRef, tt.projectRef, tt.siteRef)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveScope() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveScope() error = %v", err)
+			}
+			if org.ID != tt.wantOrg || project.ID != tt.wantProject || site.ID != tt.wantSite {
+				t.Errorf("resolveScope() = %d/%d/%d, want %d/%d/%d", org.ID, project.ID, site.ID, tt.wantOrg, tt.wantProject, tt.wantSite)
+			}
+		})
+	}
+}
@@ -1,5 +1,24 @@
 module github.com/libops/controller
 
-go 1.23
+go 1.25.3
 
-require golang.org/x/time v0.9.0
+require (
+	connectrpc.com/connect v1.19.1
+	github.com/libops/api/proto v0.0.0
+	golang.org/x/time v0.9.0
+	google.golang.org/protobuf v1.36.10
+)
+
+require (
+	github.com/google/gnostic v0.7.1 // indirect
+	github.com/google/gnostic-models v0.7.0 // indirect
+	go.yaml.in/yaml/v3 v3.0.4 // indirect
+	golang.org/x/net v0.46.1-0.20251013234738-63d1a5100f82 // indirect
+	golang.org/x/sys v0.37.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20251022142026-3a174f9686a8 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251103181224-f26f9409b101 // indirect
+	google.golang.org/grpc v1.77.0 // indirect
+)
+
+replace github.com/libops/api/proto => ../../proto
@@ -0,0 +1,142 @@
+// Package clientcert keeps the client certificate the controller presents to
+// the API over mutual TLS. The controller generates its key on the VM and
+// sends the API a CSR, so the key never leaves it; each renewal rotates the
+// key along with the certificate.
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Store holds the controller's client certificate and its key on disk
+type Store struct {
+	keyPath  string
+	certPath string
+
+	mu      sync.Mutex
+	current *tls.Certificate
+	// pending is the key of the CSR awaiting its certificate
+	pending *ecdsa.PrivateKey
+}
+
+// New creates a store keeping the key and certificate at the given paths
+func New(keyPath, certPath string) *Store {
+	return &Store{keyPath: keyPath, certPath: certPath}
+}
+
+// Load reads the key and certificate saved by a previous run. A missing pair
+// isn't an error; the controller requests a certificate on its next check-in.
+func (s *Store) Load() error {
+	cert, err := tls.LoadX509KeyPair(s.certPath, s.keyPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = &cert
+	return nil
+}
+
+// NeedsRenewal reports whether the controller should request a certificate:
+// it has none, or two thirds of the current one's lifetime have passed
+func (s *Store) NeedsRenewal(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current == nil || s.current.Leaf == nil {
+		return true
+	}
+	leaf := s.current.Leaf
+	renewAfter := leaf.NotBefore.Add(leaf.NotAfter.Sub(leaf.NotBefore) * 2 / 3)
+	return !now.Before(renewAfter)
+}
+
+// CSR generates a new key and returns a PEM certificate request for it. The
+// key is only saved once Save receives its certificate.
+func (s *Store) CSR() (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate client key: %w", err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create csr: %w", err)
+	}
+
+	s.mu.Lock()
+	s.pending = key
+	s.mu.Unlock()
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
+
+// Save installs the certificate issued for the last CSR, writing it and its
+// key to disk
+func (s *Store) Save(certPEM string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == nil {
+		return errors.New("no certificate request pending")
+	}
+	keyDER, err := x509.MarshalECPrivateKey(s.pending)
+	if err != nil {
+		return fmt.Errorf("failed to encode client key: %w", err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cert, err := tls.X509KeyPair([]byte(certPEM), keyPEM)
+	if err != nil {
+		return fmt.Errorf("issued certificate doesn't match the client key: %w", err)
+	}
+
+	if err := writeFile(s.keyPath, keyPEM, 0o600); err != nil {
+		return err
+	}
+	if err := writeFile(s.certPath, []byte(certPEM), 0o644); err != nil {
+		return err
+	}
+	s.current = &cert
+	s.pending = nil
+	return nil
+}
+
+// Certificate returns the certificate to present, or none when the controller
+// has yet to get one
+func (s *Store) Certificate() *tls.Certificate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current == nil {
+		return &tls.Certificate{}
+	}
+	return s.current
+}
+
+// writeFile replaces a file atomically, so a crash never leaves it half written
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
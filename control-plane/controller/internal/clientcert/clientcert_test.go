@@ -0,0 +1,103 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// sign issues a certificate for a PEM CSR from a throwaway CA
+func sign(t *testing.T, csrPEM string, notBefore, notAfter time.Time) string {
+	t.Helper()
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	block, _ := pem.Decode([]byte(csrPEM))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatalf("invalid csr: %v", err)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, csr.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to sign csr: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestRenewal(t *testing.T) {
+	dir := t.TempDir()
+	keyPath, certPath := filepath.Join(dir, "client.key"), filepath.Join(dir, "client.crt")
+	store := New(keyPath, certPath)
+	if err := store.Load(); err != nil {
+		t.Fatalf("Load() without a certificate = %v", err)
+	}
+	if !store.NeedsRenewal(time.Now()) {
+		t.Error("a store without a certificate doesn't need one")
+	}
+	if len(store.Certificate().Certificate) != 0 {
+		t.Error("a store without a certificate presents one")
+	}
+
+	if err := store.Save("not a certificate"); err == nil {
+		t.Error("Save() without a pending CSR succeeded")
+	}
+
+	csr, err := store.CSR()
+	if err != nil {
+		t.Fatalf("CSR() = %v", err)
+	}
+	now := time.Now()
+	if err := store.Save(sign(t, csr, now, now.Add(30*time.Hour))); err != nil {
+		t.Fatalf("Save() = %v", err)
+	}
+
+	// The saved pair is presented again after a restart, until two thirds
+	// of its lifetime have passed
+	restarted := New(keyPath, certPath)
+	if err := restarted.Load(); err != nil {
+		t.Fatalf("Load() = %v", err)
+	}
+	if len(restarted.Certificate().Certificate) == 0 {
+		t.Error("the saved certificate isn't presented")
+	}
+	if restarted.NeedsRenewal(now.Add(19 * time.Hour)) {
+		t.Error("the certificate needs renewal before two thirds of its lifetime")
+	}
+	if !restarted.NeedsRenewal(now.Add(21 * time.Hour)) {
+		t.Error("the certificate doesn't need renewal after two thirds of its lifetime")
+	}
+}
+
+func TestSaveMismatchedKey(t *testing.T) {
+	dir := t.TempDir()
+	store := New(filepath.Join(dir, "client.key"), filepath.Join(dir, "client.crt"))
+
+	other, err := New(filepath.Join(dir, "other.key"), filepath.Join(dir, "other.crt")).CSR()
+	if err != nil {
+		t.Fatalf("CSR() = %v", err)
+	}
+	if _, err := store.CSR(); err != nil {
+		t.Fatalf("CSR() = %v", err)
+	}
+	if err := store.Save(sign(t, other, time.Now(), time.Now().Add(time.Hour))); err == nil {
+		t.Error("Save() accepted a certificate for another key")
+	}
+}
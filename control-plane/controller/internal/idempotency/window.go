@@ -0,0 +1,75 @@
+// Package idempotency remembers the reconciliation requests a controller handled recently,
+// so Pub/Sub redeliveries and requests republished by a restarted event router don't
+// repeat work like docker restarts.
+package idempotency
+
+import (
+	"sync"
+	"time"
+)
+
+// Result of starting a request
+type Result int
+
+const (
+	Started    Result = iota // The key is new; run the request and call Done
+	Duplicate                // The key succeeded within the window; skip the request
+	InProgress               // A request with the key is running; retry later
+)
+
+// Window tracks idempotency keys of running requests and, for the window's duration,
+// of requests that succeeded. Failed requests are forgotten so their retries run.
+type Window struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	running   map[string]bool
+	completed map[string]time.Time // Key to when it succeeded
+	now       func() time.Time
+}
+
+// NewWindow creates a window remembering successful keys for ttl
+func NewWindow(ttl time.Duration) *Window {
+	return &Window{
+		ttl:       ttl,
+		running:   make(map[string]bool),
+		completed: make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// Start claims a key for a request
+func (w *Window) Start(key string) Result {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.expire()
+	if _, ok := w.completed[key]; ok {
+		return Duplicate
+	}
+	if w.running[key] {
+		return InProgress
+	}
+	w.running[key] = true
+	return Started
+}
+
+// Done releases a started key, remembering it if the request succeeded
+func (w *Window) Done(key string, succeeded bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.running, key)
+	if succeeded {
+		w.completed[key] = w.now()
+	}
+}
+
+// expire drops keys older than the window; callers hold mu
+func (w *Window) expire() {
+	cutoff := w.now().Add(-w.ttl)
+	for key, at := range w.completed {
+		if at.Before(cutoff) {
+			delete(w.completed, key)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	w := NewWindow(15 * time.Minute)
+	w.now = func() time.Time { return now }
+
+	if got := w.Start("a"); got != Started {
+		t.Fatalf("Start(a) = %v, want Started", got)
+	}
+	if got := w.Start("a"); got != InProgress {
+		t.Errorf("Start(a) while running = %v, want InProgress", got)
+	}
+	w.Done("a", true)
+	if got := w.Start("a"); got != Duplicate {
+		t.Errorf("Start(a) after success = %v, want Duplicate", got)
+	}
+
+	if got := w.Start("b"); got != Started {
+		t.Fatalf("Start(b) = %v, want Started", got)
+	}
+	w.Done("b", false)
+	if got := w.Start("b"); got != Started {
+		t.Errorf("Start(b) after failure = %v, want Started", got)
+	}
+
+	now = now.Add(16 * time.Minute)
+	if got := w.Start("a"); got != Started {
+		t.Errorf("Start(a) after the window = %v, want Started", got)
+	}
+}
@@ -0,0 +1,250 @@
+package reconciler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// accessModeBasicAuth puts the site behind HTTP basic auth
+	accessModeBasicAuth = "SITE_ACCESS_MODE_BASIC_AUTH"
+	// accessModeMembersOnly puts the site behind the libops sign-in gate
+	accessModeMembersOnly = "SITE_ACCESS_MODE_MEMBERS_ONLY"
+
+	// accessConfigFile is the snippet holding the site's access protection
+	accessConfigFile = "access.conf"
+	// htpasswdFile holds the basic auth credentials; it isn't a *.conf file,
+	// so nginx only reads it through auth_basic_user_file
+	htpasswdFile = "htpasswd"
+
+	// accessCookie holds a members only session
+	accessCookie = "libops_site_access"
+	// accessSessionTTL is how long a members only session lasts. People
+	// removed from the site keep access until theirs ends
+	accessSessionTTL = 8 * time.Hour
+)
+
+// ProxyAccess is the site's access protection
+type ProxyAccess struct {
+	Mode                  string `json:"mode"`
+	BasicAuthUsername     string `json:"basicAuthUsername"`
+	BasicAuthPasswordHash string `json:"basicAuthPasswordHash"`
+	GateSecret            string `json:"gateSecret"`
+	GateURL               string `json:"gateUrl"`
+}
+
+// accessClaims are what a gate ticket or session cookie vouches for; the API
+// signs tickets in the same format
+type accessClaims struct {
+	SiteID  string `json:"site"`
+	Subject string `json:"sub"`
+	Expires int64  `json:"exp"`
+}
+
+// SetControllerPort sets the port nginx reaches the controller's access
+// handlers on
+func (r *Reconciler) SetControllerPort(port string) {
+	r.controllerPort = port
+}
+
+// renderAccess renders the site's access protection as server-level nginx
+// directives, along with the htpasswd file basic auth reads
+func (r *Reconciler) renderAccess(access ProxyAccess) map[string]string {
+	header := "# Managed by libops; changes are overwritten on reconciliation\n"
+	files := map[string]string{
+		accessConfigFile: header,
+		htpasswdFile:     "",
+	}
+
+	switch access.Mode {
+	case accessModeBasicAuth:
+		// bcrypt hashes need an nginx built against a crypt(3) that knows them,
+		// as glibc's libxcrypt does
+		files[htpasswdFile] = fmt.Sprintf("%s:%s\n", access.BasicAuthUsername, access.BasicAuthPasswordHash)
+		files[accessConfigFile] = header + fmt.Sprintf("auth_basic \"Restricted\";\nauth_basic_user_file %s/%s;\n", proxyConfigDir, htpasswdFile)
+
+	case accessModeMembersOnly:
+		upstream := fmt.Sprintf("http://127.0.0.1:%s", r.controllerPort)
+		files[accessConfigFile] = header + fmt.Sprintf(`auth_request /_libops/access/check;
+error_page 401 = /_libops/access/login;
+
+location = /_libops/access/check {
+    internal;
+    auth_request off;
+    proxy_pass %[1]s/access/check;
+    proxy_pass_request_body off;
+    proxy_set_header Content-Length "";
+}
+
+location = /_libops/access/login {
+    auth_request off;
+    proxy_pass %[1]s/access/login;
+    proxy_set_header X-Original-URI $request_uri;
+    proxy_set_header X-Forwarded-Host $http_host;
+    proxy_set_header X-Forwarded-Proto $scheme;
+}
+
+location = /_libops/access/callback {
+    auth_request off;
+    proxy_pass %[1]s/access/callback$is_args$args;
+    proxy_set_header X-Forwarded-Proto $scheme;
+}
+`, upstream)
+	}
+
+	return files
+}
+
+// HandleAccessCheck answers nginx's auth_request for members only sites: 204
+// with a valid session cookie, 401 otherwise
+func (r *Reconciler) HandleAccessCheck(w http.ResponseWriter, req *http.Request) {
+	cookie, err := req.Cookie(accessCookie)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if _, err := r.verifyAccess(cookie.Value); err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleAccessLogin sends visitors without a session to the gate, which
+// signs them in and sends them back to where they were going
+func (r *Reconciler) HandleAccessLogin(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	gateURL := r.access.GateURL
+	r.mu.Unlock()
+	if gateURL == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	returnTo := url.URL{
+		Scheme: forwardedProto(req),
+		Host:   req.Header.Get("X-Forwarded-Host"),
+	}
+	if uri, err := url.ParseRequestURI(req.Header.Get("X-Original-URI")); err == nil {
+		returnTo.Path = uri.Path
+		returnTo.RawPath = uri.RawPath
+		returnTo.RawQuery = uri.RawQuery
+	}
+
+	http.Redirect(w, req, gateURL+"?"+url.Values{"return_to": {returnTo.String()}}.Encode(), http.StatusFound)
+}
+
+// HandleAccessCallback trades a gate ticket for a session cookie
+func (r *Reconciler) HandleAccessCallback(w http.ResponseWriter, req *http.Request) {
+	claims, err := r.verifyAccess(req.URL.Query().Get("ticket"))
+	if err != nil {
+		slog.Warn("rejected access gate ticket", "error", err)
+		http.Error(w, "Invalid or expired sign-in; reload the page to try again", http.StatusForbidden)
+		return
+	}
+
+	session, err := r.signAccess(accessClaims{
+		SiteID:  r.siteID,
+		Subject: claims.Subject,
+		Expires: time.Now().Add(accessSessionTTL).Unix(),
+	})
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     accessCookie,
+		Value:    session,
+		Path:     "/",
+		MaxAge:   int(accessSessionTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   forwardedProto(req) == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	// Only paths on this host, so the callback can't be used to send people
+	// anywhere else
+	returnTo := req.URL.Query().Get("return_to")
+	if !strings.HasPrefix(returnTo, "/") || strings.HasPrefix(returnTo, "//") || strings.HasPrefix(returnTo, "/\\") {
+		returnTo = "/"
+	}
+	http.Redirect(w, req, returnTo, http.StatusFound)
+}
+
+// signAccess signs claims with the site's gate secret
+func (r *Reconciler) signAccess(claims accessClaims) (string, error) {
+	r.mu.Lock()
+	secret := r.access.GateSecret
+	r.mu.Unlock()
+	if secret == "" {
+		return "", fmt.Errorf("the site has no gate secret")
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(accessMAC(secret, payload)), nil
+}
+
+// verifyAccess checks a ticket or session cookie was signed with the site's
+// gate secret for this site and hasn't expired
+func (r *Reconciler) verifyAccess(token string) (*accessClaims, error) {
+	r.mu.Lock()
+	secret := r.access.GateSecret
+	r.mu.Unlock()
+	if secret == "" {
+		return nil, fmt.Errorf("the site has no gate secret")
+	}
+
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("malformed token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed token: %w", err)
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, fmt.Errorf("malformed token: %w", err)
+	}
+	if !hmac.Equal(mac, accessMAC(secret, payload)) {
+		return nil, fmt.Errorf("invalid signature")
+	}
+
+	var claims accessClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed token: %w", err)
+	}
+	if claims.SiteID != r.siteID {
+		return nil, fmt.Errorf("token is for site %s", claims.SiteID)
+	}
+	if time.Now().Unix() >= claims.Expires {
+		return nil, fmt.Errorf("token expired")
+	}
+
+	return &claims, nil
+}
+
+func accessMAC(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// forwardedProto is the scheme nginx received the request on
+func forwardedProto(req *http.Request) string {
+	if req.Header.Get("X-Forwarded-Proto") == "https" {
+		return "https"
+	}
+	return "http"
+}
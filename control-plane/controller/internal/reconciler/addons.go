@@ -0,0 +1,371 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// addonsProject is the compose project the site's add-ons run in, apart
+	// from the site's own so deploying the site leaves them running
+	addonsProject = "libops-addons"
+	// addonsComposeFile is rendered from the add-ons the API lists. Compose
+	// reads JSON as YAML; the file holds the add-ons' passwords
+	addonsComposeFile = dataDiskPath + "/libops/addons/compose.json"
+	// addonsDataDir keeps the add-ons' data on the data disk
+	addonsDataDir = dataDiskPath + "/addons"
+	// addonHost is where the site's containers reach the add-ons, like the
+	// co-located MySQL server: the docker bridge's gateway
+	addonHost = mysqlHost
+	// addonReadyTimeout bounds how long started add-ons get to accept
+	// connections before they're reported unhealthy
+	addonReadyTimeout = 90 * time.Second
+)
+
+// AddonSpec is an add-on the API says the site has
+type AddonSpec struct {
+	Kind     string `json:"kind"`
+	Password string `json:"password"`
+}
+
+// addonDefinition is how the controller runs one kind of add-on
+type addonDefinition struct {
+	// port is the one the add-on is published on at addonHost
+	port int
+	// services renders the add-on's compose services, keyed by container
+	// name
+	services func(password string) map[string]composeService
+}
+
+// composeService is the part of a compose service the add-ons use
+type composeService struct {
+	Image         string            `json:"image"`
+	ContainerName string            `json:"container_name"`
+	Restart       string            `json:"restart"`
+	Command       []string          `json:"command,omitempty"`
+	Environment   map[string]string `json:"environment,omitempty"`
+	Ports         []string          `json:"ports,omitempty"`
+	Volumes       []string          `json:"volumes,omitempty"`
+	DependsOn     []string          `json:"depends_on,omitempty"`
+}
+
+// addonDefinitions are the kinds of add-on the controller can run
+var addonDefinitions = map[string]addonDefinition{
+	"solr": {
+		port: 8983,
+		services: func(string) map[string]composeService {
+			return map[string]composeService{
+				"libops-solr": {
+					Image:   "solr:9",
+					Ports:   []string{publishedPort(8983, 8983)},
+					Volumes: []string{addonsDataDir + "/solr:/var/solr"},
+				},
+			}
+		},
+	},
+	"memcached": {
+		port: 11211,
+		services: func(string) map[string]composeService {
+			return map[string]composeService{
+				"libops-memcached": {
+					Image:   "memcached:1.6",
+					Command: []string{"memcached", "-m", "256"},
+					Ports:   []string{publishedPort(11211, 11211)},
+				},
+			}
+		},
+	},
+	"redis": {
+		port: 6379,
+		services: func(password string) map[string]composeService {
+			return map[string]composeService{
+				"libops-redis": {
+					Image:   "redis:7",
+					Command: []string{"redis-server", "--requirepass", password, "--appendonly", "yes"},
+					Ports:   []string{publishedPort(6379, 6379)},
+					Volumes: []string{addonsDataDir + "/redis:/data"},
+				},
+			}
+		},
+	},
+	"matomo": {
+		port: 8081,
+		services: func(password string) map[string]composeService {
+			return map[string]composeService{
+				"libops-matomo": {
+					Image: "matomo:5",
+					Environment: map[string]string{
+						"MATOMO_DATABASE_HOST":     "libops-matomo-db",
+						"MATOMO_DATABASE_USERNAME": "matomo",
+						"MATOMO_DATABASE_PASSWORD": password,
+						"MATOMO_DATABASE_DBNAME":   "matomo",
+					},
+					Ports:     []string{publishedPort(8081, 80)},
+					Volumes:   []string{addonsDataDir + "/matomo:/var/www/html"},
+					DependsOn: []string{"libops-matomo-db"},
+				},
+				"libops-matomo-db": {
+					Image: "mariadb:11",
+					Environment: map[string]string{
+						"MARIADB_DATABASE":             "matomo",
+						"MARIADB_USER":                 "matomo",
+						"MARIADB_PASSWORD":             password,
+						"MARIADB_RANDOM_ROOT_PASSWORD": "1",
+					},
+					Volumes: []string{addonsDataDir + "/matomo-db:/var/lib/mysql"},
+				},
+			}
+		},
+	},
+}
+
+// addonDataOwners are the users add-on images run as, which need to own
+// their data directories
+var addonDataOwners = map[string]int{
+	"solr": 8983,
+}
+
+// ReconcileAddons renders the site's add-ons into their compose project,
+// brings it up and reports each add-on's health. A site without add-ons has
+// its project taken down; add-on data stays on the data disk
+func (r *Reconciler) ReconcileAddons(ctx context.Context) error {
+	slog.Info("reconciling add-ons", "site_id", r.siteID)
+
+	token, err := r.getVMServiceAccountToken(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get service account token: %w", err)
+	}
+
+	addons, err := r.fetchAddons(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to fetch add-ons: %w", err)
+	}
+	if len(addons) == 0 {
+		return removeAddons(ctx)
+	}
+
+	failures := map[string]string{}
+	if err := startAddons(ctx, addons); err != nil {
+		for _, addon := range addons {
+			failures[addon.Kind] = err.Error()
+		}
+	} else {
+		failures = checkAddons(ctx, addons)
+	}
+
+	reported := make([]map[string]any, 0, len(addons))
+	for _, addon := range addons {
+		definition, ok := addonDefinitions[addon.Kind]
+		if !ok {
+			reported = append(reported, map[string]any{"kind": addon.Kind, "error": failures[addon.Kind]})
+			continue
+		}
+		reported = append(reported, map[string]any{
+			"kind":  addon.Kind,
+			"host":  addonHost,
+			"port":  definition.port,
+			"error": failures[addon.Kind],
+		})
+	}
+	if err := r.reportAddons(ctx, token, reported); err != nil {
+		return fmt.Errorf("failed to report add-ons: %w", err)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d add-ons are unhealthy", len(failures), len(addons))
+	}
+	slog.Info("add-ons reconciled", "site_id", r.siteID, "count", len(addons))
+	return nil
+}
+
+// fetchAddons fetches the site's add-ons from the API
+func (r *Reconciler) fetchAddons(ctx context.Context, token string) ([]AddonSpec, error) {
+	payload, err := json.Marshal(map[string]string{"siteId": r.siteID})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/GetSiteAddons", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Addons []AddonSpec `json:"addons"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Addons, nil
+}
+
+// startAddons renders the add-ons' compose file and brings the project up,
+// removing the services of add-ons the site no longer has
+func startAddons(ctx context.Context, addons []AddonSpec) error {
+	services := map[string]composeService{}
+	for _, addon := range addons {
+		definition, ok := addonDefinitions[addon.Kind]
+		if !ok {
+			return fmt.Errorf("unknown add-on %q", addon.Kind)
+		}
+		if addon.Password != "" && !databasePasswordPattern.MatchString(addon.Password) {
+			return fmt.Errorf("invalid password for %s", addon.Kind)
+		}
+		for name, service := range definition.services(addon.Password) {
+			service.ContainerName = name
+			service.Restart = "unless-stopped"
+			services[name] = service
+
+			for _, volume := range service.Volumes {
+				dir, _, _ := strings.Cut(volume, ":")
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return fmt.Errorf("failed to create %s: %w", dir, err)
+				}
+				if uid, ok := addonDataOwners[addon.Kind]; ok {
+					if err := os.Chown(dir, uid, uid); err != nil {
+						return fmt.Errorf("failed to chown %s: %w", dir, err)
+					}
+				}
+			}
+		}
+	}
+
+	content, err := json.MarshalIndent(map[string]any{"services": services}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(addonsComposeFile), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(addonsComposeFile), err)
+	}
+	if err := os.WriteFile(addonsComposeFile, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", addonsComposeFile, err)
+	}
+
+	args := []string{"compose", "-p", addonsProject, "-f", addonsComposeFile, "up", "-d", "--remove-orphans"}
+	if output, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("docker compose up failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// checkAddons waits for the add-ons to accept connections, returning why
+// each one that doesn't by the deadline is unhealthy
+func checkAddons(ctx context.Context, addons []AddonSpec) map[string]string {
+	deadline := time.Now().Add(addonReadyTimeout)
+	for {
+		failures := map[string]string{}
+		for _, addon := range addons {
+			if err := checkAddon(ctx, addon.Kind); err != nil {
+				failures[addon.Kind] = err.Error()
+			}
+		}
+		if len(failures) == 0 || time.Now().After(deadline) {
+			return failures
+		}
+		select {
+		case <-ctx.Done():
+			return failures
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
+
+// checkAddon checks that an add-on's containers run and that it accepts
+// connections where the site reaches it
+func checkAddon(ctx context.Context, kind string) error {
+	definition := addonDefinitions[kind]
+	for name := range definition.services("") {
+		output, err := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.State.Status}}", name).Output()
+		if err != nil {
+			return fmt.Errorf("container %s doesn't exist", name)
+		}
+		if state := strings.TrimSpace(string(output)); state != "running" {
+			return fmt.Errorf("container %s is %s", name, state)
+		}
+	}
+
+	address := net.JoinHostPort(addonHost, strconv.Itoa(definition.port))
+	conn, err := (&net.Dialer{Timeout: 3 * time.Second}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("not accepting connections on %s", address)
+	}
+	return conn.Close()
+}
+
+// removeAddons takes down the add-ons' project once the site has none
+func removeAddons(ctx context.Context) error {
+	if _, err := os.Stat(addonsComposeFile); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	args := []string{"compose", "-p", addonsProject, "-f", addonsComposeFile, "down", "--remove-orphans"}
+	if output, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("docker compose down failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	if err := os.Remove(addonsComposeFile); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", addonsComposeFile, err)
+	}
+	slog.Info("add-ons removed")
+	return nil
+}
+
+// reportAddons reports the add-ons' health, and where the site reaches them,
+// to the API
+func (r *Reconciler) reportAddons(ctx context.Context, token string, addons []map[string]any) error {
+	payload, err := json.Marshal(map[string]any{
+		"siteId": r.siteID,
+		"addons": addons,
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/ReportSiteAddons", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// publishedPort publishes a container port on addonHost
+func publishedPort(hostPort, containerPort int) string {
+	return fmt.Sprintf("%s:%d:%d", addonHost, hostPort, containerPort)
+}
@@ -0,0 +1,60 @@
+package reconciler
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+
+	"github.com/libops/controller/internal/selfupdate"
+)
+
+// agentClient returns a SiteAgentService client for the current API URL,
+// which changes once a private service connect endpoint is resolved
+func (r *Reconciler) agentClient() libopsv1connect.SiteAgentServiceClient {
+	return libopsv1connect.NewSiteAgentServiceClient(r.httpClient, r.baseURL())
+}
+
+// agentRequest wraps a message in a request authenticated with the VM's
+// service account token
+func agentRequest[T any](token string, msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	return req
+}
+
+// remoteConfigFromProto converts the controller config returned on check-in
+func remoteConfigFromProto(cfg *libopsv1.ControllerConfig) *RemoteConfig {
+	if cfg == nil {
+		return nil
+	}
+	optionalInt := func(v *int32) *int {
+		if v == nil {
+			return nil
+		}
+		i := int(*v)
+		return &i
+	}
+	return &RemoteConfig{
+		Version:                  cfg.Version,
+		RateLimitRPS:             optionalInt(cfg.RateLimitRps),
+		RateLimitBurst:           optionalInt(cfg.RateLimitBurst),
+		ReconcileIntervalMinutes: optionalInt(cfg.ReconcileIntervalMinutes),
+		CheckInIntervalSeconds:   optionalInt(cfg.CheckinIntervalSeconds),
+		FeatureFlags:             cfg.FeatureFlags,
+	}
+}
+
+// releaseFromProto converts the controller release advertised on check-in
+func releaseFromProto(release *libopsv1.ControllerRelease) *selfupdate.Release {
+	if release == nil {
+		return nil
+	}
+	return &selfupdate.Release{
+		Version:     release.Version,
+		DownloadURL: release.DownloadUrl,
+		SHA256:      release.Sha256,
+		Signature:   release.Signature,
+	}
+}
@@ -0,0 +1,29 @@
+package reconciler
+
+import "testing"
+
+func TestApplyAPIRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		follow bool
+		url    string
+		want   string
+	}{
+		{name: "follows the site's region", follow: true, url: "https://eu.api.libops.io", want: "https://eu.api.libops.io"},
+		{name: "single region", follow: true, url: "", want: "https://api.libops.io"},
+		{name: "configured URL", follow: false, url: "https://eu.api.libops.io", want: "https://api.libops.io"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReconciler("https://api.libops.io", "site")
+			if tt.follow {
+				r.FollowAPIRegion()
+			}
+			r.applyAPIRegion("europe-west3", tt.url)
+			if got := r.baseURL(); got != tt.want {
+				t.Errorf("baseURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package reconciler
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/libops/controller/internal/clientcert"
+)
+
+// SetClientCertStore presents the store's certificate to the API over mutual
+// TLS while the mtls feature flag is on
+func (r *Reconciler) SetClientCertStore(store *clientcert.Store) {
+	r.clientCert = store
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if !r.FeatureEnabled(FeatureMTLS) {
+				return &tls.Certificate{}, nil
+			}
+			return store.Certificate(), nil
+		},
+	}
+	r.httpClient.Transport = transport
+}
+
+// renewClientCertificate requests a certificate for a new key from the API,
+// authenticating with the VM's service account token
+func (r *Reconciler) renewClientCertificate(ctx context.Context, token string) error {
+	csr, err := r.clientCert.CSR()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]string{
+		"siteId": r.siteID,
+		"csrPem": csr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal certificate request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/IssueSiteClientCertificate", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(string(payload)))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call certificate endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("certificate request returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var issued struct {
+		CertificatePEM string `json:"certificatePem"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&issued); err != nil {
+		return fmt.Errorf("failed to decode certificate response: %w", err)
+	}
+	if err := r.clientCert.Save(issued.CertificatePEM); err != nil {
+		return err
+	}
+
+	slog.Info("renewed client certificate", "site_id", r.siteID)
+	return nil
+}
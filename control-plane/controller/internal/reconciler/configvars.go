@@ -0,0 +1,88 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// configVarsPath is the environment file holding the site's config vars. They
+// aren't sensitive, so unlike secrets.env it's readable by the site's users
+const configVarsPath = "/etc/libops/config.env"
+
+// ConfigVars are the site's plaintext environment variables: its config vars
+// and the environment settings it inherits from its organization and project
+type ConfigVars struct {
+	Vars []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"configVars"`
+	Revision int64 `json:"revision,string"`
+}
+
+// fetchConfigVars fetches the site's config vars from the API
+func (r *Reconciler) fetchConfigVars(ctx context.Context, token string) (ConfigVars, error) {
+	var result ConfigVars
+	payload, err := json.Marshal(map[string]string{"siteId": r.siteID})
+	if err != nil {
+		return result, err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/GetSiteConfigVars", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return result, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return result, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// applyConfigVars writes the site's config vars to their environment file,
+// noting the revision they're at so it's clear which one the VM runs
+func applyConfigVars(configVars ConfigVars) error {
+	var content strings.Builder
+	content.WriteString("# LibOps config vars - Auto-generated, do not edit manually\n")
+	content.WriteString(fmt.Sprintf("# Revision: %d\n", configVars.Revision))
+	content.WriteString(fmt.Sprintf("# Generated at: %s\n\n", time.Now().UTC().Format(time.RFC3339)))
+
+	for _, v := range configVars.Vars {
+		value := strings.ReplaceAll(v.Value, "\"", "\\\"")
+		content.WriteString(fmt.Sprintf("%s=\"%s\"\n", v.Key, value))
+	}
+
+	if err := os.MkdirAll("/etc/libops", 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	tempPath := configVarsPath + ".tmp"
+	if err := os.WriteFile(tempPath, []byte(content.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write temporary config vars file: %w", err)
+	}
+	if err := os.Rename(tempPath, configVarsPath); err != nil {
+		return fmt.Errorf("failed to rename temporary config vars file: %w", err)
+	}
+
+	slog.Info("config vars file updated", "path", configVarsPath, "count", len(configVars.Vars), "revision", configVars.Revision)
+	return nil
+}
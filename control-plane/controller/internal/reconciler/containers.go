@@ -0,0 +1,40 @@
+package reconciler
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// dockerPsTimeout bounds listing the VM's containers on check-in
+const dockerPsTimeout = 10 * time.Second
+
+// containerHealth counts the running containers and how many of them fail
+// their health check, for the site's health score
+func containerHealth(ctx context.Context) (running, unhealthy int32, err error) {
+	ctx, cancel := context.WithTimeout(ctx, dockerPsTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "docker", "ps", "--format", "{{.Status}}").Output()
+	if err != nil {
+		return 0, 0, err
+	}
+	running, unhealthy = countContainers(string(out))
+	return running, unhealthy, nil
+}
+
+// countContainers counts docker ps statuses, one per line. Docker appends
+// "(unhealthy)" to the status of a container failing its health check.
+func countContainers(statuses string) (running, unhealthy int32) {
+	for _, status := range strings.Split(statuses, "\n") {
+		status = strings.TrimSpace(status)
+		if status == "" {
+			continue
+		}
+		running++
+		if strings.Contains(status, "(unhealthy)") {
+			unhealthy++
+		}
+	}
+	return running, unhealthy
+}
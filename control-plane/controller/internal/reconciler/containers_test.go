@@ -0,0 +1,16 @@
+package reconciler
+
+import "testing"
+
+func TestCountContainers(t *testing.T) {
+	statuses := "Up 3 hours (healthy)\nUp 3 hours (unhealthy)\nUp 2 minutes\n\n"
+	running, unhealthy := countContainers(statuses)
+	if running != 3 || unhealthy != 1 {
+		t.Errorf("countContainers() = %d, %d, want 3, 1", running, unhealthy)
+	}
+
+	running, unhealthy = countContainers("")
+	if running != 0 || unhealthy != 0 {
+		t.Errorf("countContainers(\"\") = %d, %d, want 0, 0", running, unhealthy)
+	}
+}
@@ -0,0 +1,265 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// mysqlContainer runs the MySQL server the site's co-located databases
+	// live on, next to the site's own compose services
+	mysqlContainer = "libops-mysql"
+	mysqlImage     = "mysql:8.0"
+	// mysqlDataDir keeps the server's data on the data disk, so it survives
+	// the VM being replaced
+	mysqlDataDir = dataDiskPath + "/mysql"
+	// mysqlEnvFile holds the server's root password, generated on the VM
+	// and never sent anywhere
+	mysqlEnvFile = dataDiskPath + "/libops/mysql.env"
+	// mysqlHost and mysqlPort are where the site's containers reach the
+	// server: the docker bridge's gateway, on a port the site's own MySQL
+	// doesn't use
+	mysqlHost = "172.17.0.1"
+	mysqlPort = 3307
+	// mysqlReadyTimeout bounds how long a new server gets to initialize
+	mysqlReadyTimeout = 2 * time.Minute
+)
+
+var (
+	// databaseNamePattern matches the names the API allows, which need no
+	// quoting as database or user names
+	databaseNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+	// databasePasswordPattern matches the hex passwords the API generates
+	databasePasswordPattern = regexp.MustCompile(`^[0-9a-f]{16,128}$`)
+)
+
+// ColocatedDatabase is a database the site's MySQL server should have, with
+// a user of the same name
+type ColocatedDatabase struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// ReconcileDatabases makes sure the site's MySQL server runs and has each of
+// the site's co-located databases and their users, then reports which it
+// could provision. A site without co-located databases doesn't get a server
+func (r *Reconciler) ReconcileDatabases(ctx context.Context) error {
+	slog.Info("reconciling databases", "site_id", r.siteID)
+
+	token, err := r.getVMServiceAccountToken(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get service account token: %w", err)
+	}
+
+	databases, err := r.fetchDatabases(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to fetch databases: %w", err)
+	}
+	if len(databases) == 0 {
+		return nil
+	}
+
+	failures := map[string]string{}
+	if err := ensureMysql(ctx); err != nil {
+		for _, database := range databases {
+			failures[database.Name] = err.Error()
+		}
+	} else {
+		for _, database := range databases {
+			if err := provisionDatabase(ctx, database); err != nil {
+				failures[database.Name] = err.Error()
+			}
+		}
+	}
+
+	reported := make([]map[string]string, 0, len(databases))
+	for _, database := range databases {
+		reported = append(reported, map[string]string{
+			"name":  database.Name,
+			"error": failures[database.Name],
+		})
+	}
+	if err := r.reportDatabases(ctx, token, reported); err != nil {
+		return fmt.Errorf("failed to report databases: %w", err)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d databases failed to provision", len(failures), len(databases))
+	}
+	slog.Info("databases reconciled", "site_id", r.siteID, "count", len(databases))
+	return nil
+}
+
+// fetchDatabases fetches the site's co-located databases from the API
+func (r *Reconciler) fetchDatabases(ctx context.Context, token string) ([]ColocatedDatabase, error) {
+	payload, err := json.Marshal(map[string]string{"siteId": r.siteID})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/GetSiteDatabases", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Databases []ColocatedDatabase `json:"databases"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Databases, nil
+}
+
+// ensureMysql starts the site's MySQL server, creating it with a new root
+// password the first time, and waits for it to accept connections
+func ensureMysql(ctx context.Context) error {
+	if _, err := os.Stat(mysqlEnvFile); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(mysqlEnvFile), 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(mysqlEnvFile), err)
+		}
+		b := make([]byte, 24)
+		if _, err := rand.Read(b); err != nil {
+			return fmt.Errorf("failed to generate MySQL root password: %w", err)
+		}
+		content := fmt.Sprintf("MYSQL_ROOT_PASSWORD=%s\n", hex.EncodeToString(b))
+		if err := os.WriteFile(mysqlEnvFile, []byte(content), 0o600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", mysqlEnvFile, err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to read %s: %w", mysqlEnvFile, err)
+	}
+
+	output, err := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.State.Running}}", mysqlContainer).Output()
+	switch {
+	case err != nil:
+		if err := os.MkdirAll(mysqlDataDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", mysqlDataDir, err)
+		}
+		args := []string{
+			"run", "-d",
+			"--name", mysqlContainer,
+			"--restart", "unless-stopped",
+			"--env-file", mysqlEnvFile,
+			"-v", mysqlDataDir + ":/var/lib/mysql",
+			"-p", fmt.Sprintf("%s:%d:3306", mysqlHost, mysqlPort),
+			mysqlImage,
+		}
+		if output, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to start MySQL: %w: %s", err, strings.TrimSpace(string(output)))
+		}
+	case strings.TrimSpace(string(output)) != "true":
+		if output, err := exec.CommandContext(ctx, "docker", "start", mysqlContainer).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to start MySQL: %w: %s", err, strings.TrimSpace(string(output)))
+		}
+	}
+
+	// A new server first initializes without networking, so it's only ready
+	// once it answers over TCP
+	deadline := time.Now().Add(mysqlReadyTimeout)
+	for {
+		err := runMysql(ctx, "mysqladmin --protocol=tcp -h127.0.0.1 ping --silent", "")
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("MySQL isn't accepting connections: %w", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// provisionDatabase creates a database and its user, or brings an existing
+// user's password and grants in line
+func provisionDatabase(ctx context.Context, database ColocatedDatabase) error {
+	if !databaseNamePattern.MatchString(database.Name) {
+		return fmt.Errorf("invalid database name")
+	}
+	if !databasePasswordPattern.MatchString(database.Password) {
+		return fmt.Errorf("invalid database password")
+	}
+
+	statements := fmt.Sprintf(
+		"CREATE DATABASE IF NOT EXISTS `%[1]s`;\n"+
+			"CREATE USER IF NOT EXISTS '%[1]s'@'%%' IDENTIFIED BY '%[2]s';\n"+
+			"ALTER USER '%[1]s'@'%%' IDENTIFIED BY '%[2]s';\n"+
+			"GRANT ALL PRIVILEGES ON `%[1]s`.* TO '%[1]s'@'%%';\n",
+		database.Name, database.Password)
+	return runMysql(ctx, "mysql", statements)
+}
+
+// runMysql runs a MySQL client command as root inside the server's
+// container, with the root password from the container's environment so it
+// never appears on the host, and statements on its stdin
+func runMysql(ctx context.Context, command, statements string) error {
+	cmd := exec.CommandContext(ctx, "docker", "exec", "-i", mysqlContainer,
+		"sh", "-c", `MYSQL_PWD="$MYSQL_ROOT_PASSWORD" exec `+command+" -uroot")
+	cmd.Stdin = strings.NewReader(statements)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// reportDatabases reports which databases were provisioned, and where the
+// site reaches them, to the API
+func (r *Reconciler) reportDatabases(ctx context.Context, token string, databases []map[string]string) error {
+	payload, err := json.Marshal(map[string]any{
+		"siteId":    r.siteID,
+		"host":      mysqlHost,
+		"port":      mysqlPort,
+		"databases": databases,
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/ReportSiteDatabases", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package reconciler
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+const (
+	firewallBackendIptables = "iptables"
+	firewallBackendNftables = "nftables"
+
+	// nftablesTable holds the site's firewall rules when nftables is the backend
+	nftablesTable = "libops"
+)
+
+// nftablesProtocol matches the protocol names a rule can be rendered with
+var nftablesProtocol = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// renderNftablesRules renders a script that replaces the libops table with the
+// site's firewall rules. nft applies a script in one transaction, so traffic is
+// never filtered by a partial rule set. Rules that can't be rendered are
+// skipped, like rules iptables rejects
+func renderNftablesRules(rules []FirewallRule) string {
+	var b strings.Builder
+	// Declaring the table first lets the delete succeed on the first run
+	fmt.Fprintf(&b, "table inet %s\n", nftablesTable)
+	fmt.Fprintf(&b, "delete table inet %s\n", nftablesTable)
+	fmt.Fprintf(&b, "table inet %s {\n", nftablesTable)
+	b.WriteString("\tchain input {\n")
+	b.WriteString("\t\ttype filter hook input priority 0; policy accept;\n")
+
+	for _, rule := range rules {
+		statement, err := nftablesStatement(rule)
+		if err != nil {
+			slog.Error("failed to apply firewall rule",
+				"protocol", rule.Protocol,
+				"port", rule.Port,
+				"source", rule.Source,
+				"action", rule.Action,
+				"error", err)
+			continue
+		}
+		fmt.Fprintf(&b, "\t\t%s\n", statement)
+	}
+
+	b.WriteString("\t}\n")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// nftablesStatement renders one firewall rule as an nft rule statement
+func nftablesStatement(rule FirewallRule) (string, error) {
+	var parts []string
+
+	if rule.Source != "" {
+		family := "ip"
+		if _, network, err := net.ParseCIDR(rule.Source); err == nil {
+			if network.IP.To4() == nil {
+				family = "ip6"
+			}
+		} else if ip := net.ParseIP(rule.Source); ip != nil {
+			if ip.To4() == nil {
+				family = "ip6"
+			}
+		} else {
+			return "", fmt.Errorf("invalid source %q", rule.Source)
+		}
+		parts = append(parts, family, "saddr", rule.Source)
+	}
+
+	protocol := strings.ToLower(rule.Protocol)
+	if protocol == "all" {
+		protocol = ""
+	}
+	if protocol != "" && !nftablesProtocol.MatchString(protocol) {
+		return "", fmt.Errorf("invalid protocol %q", rule.Protocol)
+	}
+
+	switch {
+	case rule.Port > 0 && (protocol == "tcp" || protocol == "udp"):
+		parts = append(parts, protocol, "dport", fmt.Sprintf("%d", rule.Port))
+	case rule.Port > 0 && protocol == "":
+		parts = append(parts, "th", "dport", fmt.Sprintf("%d", rule.Port))
+	case rule.Port > 0:
+		return "", fmt.Errorf("protocol %q has no ports", rule.Protocol)
+	case protocol != "":
+		parts = append(parts, "meta", "l4proto", protocol)
+	}
+
+	// Map action to the nft verdict
+	verdict := "accept"
+	if rule.Action == "deny" || rule.Action == "drop" {
+		verdict = "drop"
+	} else if rule.Action == "reject" {
+		verdict = "reject"
+	}
+	parts = append(parts, verdict)
+
+	return strings.Join(parts, " "), nil
+}
+
+// applyNftablesRules replaces the libops nftables table with the site's firewall rules
+func applyNftablesRules(rules []FirewallRule) error {
+	slog.Info("applying firewall rules with nftables", "rule_count", len(rules))
+
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(renderNftablesRules(rules))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to apply nftables rules: %s: %w", string(output), err)
+	}
+	return nil
+}
+
+// otherFirewallBackend returns the backend that isn't in use
+func otherFirewallBackend(backend string) string {
+	if backend == firewallBackendNftables {
+		return firewallBackendIptables
+	}
+	return firewallBackendNftables
+}
+
+// removeFirewallBackend removes the rules a backend applied. It's best effort:
+// the rules may never have been applied, or the tool may not be installed
+func removeFirewallBackend(backend string) {
+	var commands [][]string
+	if backend == firewallBackendNftables {
+		commands = [][]string{{"nft", "delete", "table", "inet", nftablesTable}}
+	} else {
+		commands = [][]string{
+			{"iptables", "-D", "INPUT", "-j", "LIBOPS-FIREWALL"},
+			{"iptables", "-F", "LIBOPS-FIREWALL"},
+			{"iptables", "-X", "LIBOPS-FIREWALL"},
+		}
+	}
+
+	for _, args := range commands {
+		if err := exec.Command(args[0], args[1:]...).Run(); err != nil {
+			slog.Debug("firewall cleanup command failed", "backend", backend, "command", strings.Join(args, " "), "error", err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package reconciler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNftablesStatement(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    FirewallRule
+		want    string
+		wantErr bool
+	}{
+		{name: "tcp port from cidr", rule: FirewallRule{Protocol: "tcp", Port: 22, Source: "10.0.0.0/8", Action: "allow"}, want: "ip saddr 10.0.0.0/8 tcp dport 22 accept"},
+		{name: "ipv6 source", rule: FirewallRule{Source: "2001:db8::/32", Action: "deny"}, want: "ip6 saddr 2001:db8::/32 drop"},
+		{name: "single address", rule: FirewallRule{Protocol: "udp", Port: 53, Source: "192.0.2.1", Action: "reject"}, want: "ip saddr 192.0.2.1 udp dport 53 reject"},
+		{name: "port without protocol", rule: FirewallRule{Port: 443, Action: "drop"}, want: "th dport 443 drop"},
+		{name: "protocol without port", rule: FirewallRule{Protocol: "icmp", Action: "allow"}, want: "meta l4proto icmp accept"},
+		{name: "all protocols", rule: FirewallRule{Protocol: "all", Action: "allow"}, want: "accept"},
+		{name: "invalid source", rule: FirewallRule{Source: "10.0.0.0/8; flush ruleset"}, wantErr: true},
+		{name: "invalid protocol", rule: FirewallRule{Protocol: "tcp accept;"}, wantErr: true},
+		{name: "port on portless protocol", rule: FirewallRule{Protocol: "icmp", Port: 22}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nftablesStatement(tt.rule)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderNftablesRules(t *testing.T) {
+	script := renderNftablesRules([]FirewallRule{
+		{Protocol: "tcp", Port: 22, Source: "10.0.0.0/8", Action: "allow"},
+		{Source: "not an address", Action: "deny"},
+	})
+
+	if !strings.HasPrefix(script, "table inet libops\ndelete table inet libops\n") {
+		t.Errorf("script doesn't recreate the table:\n%s", script)
+	}
+	if !strings.Contains(script, "\t\tip saddr 10.0.0.0/8 tcp dport 22 accept\n") {
+		t.Errorf("script is missing the valid rule:\n%s", script)
+	}
+	if strings.Contains(script, "not an address") {
+		t.Errorf("script includes the invalid rule:\n%s", script)
+	}
+}
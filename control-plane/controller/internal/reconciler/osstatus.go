@@ -0,0 +1,78 @@
+package reconciler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// osReleasePath identifies the VM's OS image
+	osReleasePath = "/etc/os-release"
+	// rebootRequiredPath is created by Debian-style package updates that need a reboot
+	rebootRequiredPath = "/var/run/reboot-required"
+	// updateEngineTimeout bounds asking Container-Optimized OS's updater for its status
+	updateEngineTimeout = 5 * time.Second
+)
+
+// osVersion returns the OS image the VM runs, named the way Compute Engine
+// names images, e.g. "cos-125-19216-104-74"
+func osVersion() (string, error) {
+	f, err := os.Open(osReleasePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return parseOSRelease(f)
+}
+
+// parseOSRelease builds the image name from os-release's ID, VERSION_ID and,
+// when set, BUILD_ID
+func parseOSRelease(r io.Reader) (string, error) {
+	fields := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok || strings.HasPrefix(key, "#") {
+			continue
+		}
+		fields[key] = strings.Trim(value, `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if fields["ID"] == "" || fields["VERSION_ID"] == "" {
+		return "", errors.New("os-release has no ID or VERSION_ID")
+	}
+
+	parts := []string{fields["ID"], fields["VERSION_ID"]}
+	if fields["BUILD_ID"] != "" {
+		parts = append(parts, fields["BUILD_ID"])
+	}
+	return strings.ReplaceAll(strings.Join(parts, "-"), ".", "-"), nil
+}
+
+// osRebootRequired reports whether an installed OS update waits for a reboot.
+// Container-Optimized OS applies updates to its inactive partition and reports
+// them through update_engine; other images flag them with a file.
+func osRebootRequired(ctx context.Context) bool {
+	if _, err := os.Stat(rebootRequiredPath); err == nil {
+		return true
+	}
+	if _, err := exec.LookPath("update_engine_client"); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateEngineTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "update_engine_client", "--status").CombinedOutput()
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(out), "UPDATE_STATUS_UPDATED_NEED_REBOOT")
+}
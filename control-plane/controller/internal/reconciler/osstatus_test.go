@@ -0,0 +1,42 @@
+package reconciler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOSRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		release string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "container-optimized os",
+			release: "NAME=\"Container-Optimized OS\"\nID=cos\nVERSION=125\nVERSION_ID=125\nBUILD_ID=19216.104.74\n",
+			want:    "cos-125-19216-104-74",
+		},
+		{
+			name:    "debian",
+			release: "# comment\nID=debian\nVERSION_ID=\"12\"\n",
+			want:    "debian-12",
+		},
+		{
+			name:    "missing version",
+			release: "ID=cos\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOSRelease(strings.NewReader(tt.release))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOSRelease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseOSRelease() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
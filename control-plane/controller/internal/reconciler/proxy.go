@@ -0,0 +1,277 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// proxyConfigDir holds the nginx snippets the controller renders; the
+	// site's nginx server block includes every *.conf file in it, and its
+	// http block every *.conf file in the http directory under it
+	proxyConfigDir = dataDiskPath + "/libops/nginx"
+	// redirectsConfigFile is the snippet holding the site's redirects
+	redirectsConfigFile = "redirects.conf"
+)
+
+// ProxyConfig is what the API says belongs in the site's reverse proxy
+type ProxyConfig struct {
+	Redirects  []Redirect  `json:"redirects"`
+	Access     ProxyAccess `json:"access"`
+	Waf        ProxyWaf    `json:"waf"`
+	RateLimits []RateLimit `json:"rateLimits"`
+	Tls        ProxyTls    `json:"tls"`
+}
+
+// Redirect is an HTTP redirect served by the site's reverse proxy
+type Redirect struct {
+	ID            string `json:"redirectId"`
+	FromHost      string `json:"fromHost"`
+	FromPath      string `json:"fromPath"`
+	To            string `json:"to"`
+	StatusCode    int    `json:"statusCode"`
+	PreserveQuery bool   `json:"preserveQuery"`
+}
+
+// ReconcileProxy fetches the site's proxy config from the API, renders it
+// into nginx snippets and reloads nginx
+func (r *Reconciler) ReconcileProxy(ctx context.Context) error {
+	slog.Info("reconciling proxy config", "site_id", r.siteID)
+
+	token, err := r.getVMServiceAccountToken(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get service account token: %w", err)
+	}
+
+	config, err := r.fetchProxyConfig(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to fetch proxy config: %w", err)
+	}
+
+	files := r.renderAccess(config.Access)
+	files[redirectsConfigFile] = renderRedirects(config.Redirects)
+	for name, content := range renderWaf(config.Waf) {
+		files[name] = content
+	}
+	for name, content := range renderRateLimits(config.RateLimits) {
+		files[name] = content
+	}
+	for name, content := range renderTls(config.Tls) {
+		files[name] = content
+	}
+	if err := applyProxyConfig(ctx, files); err != nil {
+		return fmt.Errorf("failed to apply proxy config: %w", err)
+	}
+
+	// The access handlers and WAF and rate limit reports follow what nginx
+	// was just configured with
+	r.mu.Lock()
+	r.access = config.Access
+	r.waf = config.Waf
+	r.rateLimitZones = map[string]string{}
+	for _, rateLimit := range config.RateLimits {
+		if zone, ok := rateLimitZone(rateLimit.RuleID); ok {
+			r.rateLimitZones[zone] = rateLimit.RuleID
+		}
+	}
+	r.mu.Unlock()
+
+	slog.Info("proxy config reconciled successfully",
+		"site_id", r.siteID,
+		"redirect_count", len(config.Redirects),
+		"access_mode", config.Access.Mode,
+		"waf_enabled", config.Waf.Enabled,
+		"rate_limit_count", len(config.RateLimits),
+		"tls_min_version", config.Tls.MinVersion)
+
+	// A policy nginx accepted can still not be what it serves, e.g. when the
+	// site's own config overrides it, so what it serves is checked
+	r.probeAndReportTls(ctx, token, config.Tls)
+
+	return nil
+}
+
+// fetchProxyConfig fetches the site's proxy config from the admin API
+func (r *Reconciler) fetchProxyConfig(ctx context.Context, token string) (*ProxyConfig, error) {
+	payload, err := json.Marshal(map[string]string{"siteId": r.siteID})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/GetSiteProxyConfig", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned status %d", resp.StatusCode)
+	}
+
+	var config ProxyConfig
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// renderRedirects renders redirects as server-level nginx rules. The API
+// returns them most specific first and nginx answers with the first match
+func renderRedirects(redirects []Redirect) string {
+	var b strings.Builder
+	b.WriteString("# Managed by libops; changes are overwritten on reconciliation\n")
+	if len(redirects) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("set $libops_redirect_target \"$host$uri\";\n")
+	for _, redirect := range redirects {
+		host := "[^/]*"
+		if redirect.FromHost != "" {
+			host = regexp.QuoteMeta(redirect.FromHost)
+		}
+
+		path := redirect.FromPath
+		to := redirect.To
+		if prefix, ok := strings.CutSuffix(path, "*"); ok {
+			path = regexp.QuoteMeta(prefix) + "(.*)"
+			to = strings.Replace(to, "*", "$1", 1)
+		} else {
+			path = regexp.QuoteMeta(path)
+		}
+		if redirect.PreserveQuery {
+			to += "$is_args$args"
+		}
+
+		fmt.Fprintf(&b, "\n# %s\n", redirect.ID)
+		fmt.Fprintf(&b, "if ($libops_redirect_target ~ \"^%s%s$\") {\n", host, path)
+		fmt.Fprintf(&b, "    return %d \"%s\";\n", redirect.StatusCode, to)
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// applyProxyConfig writes the rendered snippets and reloads nginx. Snippets
+// nginx rejects are rolled back so a bad config never takes the site down
+func applyProxyConfig(ctx context.Context, files map[string]string) error {
+	previous := map[string][]byte{}
+	changed := false
+	for name, content := range files {
+		path := filepath.Join(proxyConfigDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			restoreProxyConfig(previous)
+			return fmt.Errorf("failed to create proxy config directory: %w", err)
+		}
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err == nil && string(existing) == content {
+			continue
+		}
+
+		previous[name] = existing
+		if err := os.WriteFile(path, []byte(content), proxyFileMode(name)); err != nil {
+			restoreProxyConfig(previous)
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if output, err := exec.CommandContext(ctx, "nginx", "-t").CombinedOutput(); err != nil {
+		restoreProxyConfig(previous)
+		return fmt.Errorf("nginx rejected the proxy config: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	if output, err := exec.CommandContext(ctx, "nginx", "-s", "reload").CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to reload nginx: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// proxyFileMode keeps private keys readable only by root, which nginx's
+// master process reads them as
+func proxyFileMode(name string) os.FileMode {
+	if strings.HasSuffix(name, ".key") {
+		return 0o600
+	}
+	return 0o644
+}
+
+// readNewLogLines returns the complete lines appended to a log since offset
+// and advances offset past them. A log that shrank was rotated and is read
+// from the start; a log that doesn't exist yet has no lines
+func readNewLogLines(path string, offset *int64) ([][]byte, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() < *offset {
+		*offset = 0
+	}
+	if _, err := file.Seek(*offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// A trailing line without a newline is still being written
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		return nil, nil
+	}
+	*offset += int64(end + 1)
+	return bytes.Split(data[:end], []byte("\n")), nil
+}
+
+// restoreProxyConfig puts back the snippets applyProxyConfig overwrote;
+// snippets that didn't exist before are removed
+func restoreProxyConfig(previous map[string][]byte) {
+	for name, content := range previous {
+		path := filepath.Join(proxyConfigDir, name)
+		var err error
+		if content == nil {
+			err = os.Remove(path)
+		} else {
+			err = os.WriteFile(path, content, proxyFileMode(name))
+		}
+		if err != nil && !os.IsNotExist(err) {
+			slog.Error("failed to restore proxy config", "path", path, "error", err)
+		}
+	}
+}
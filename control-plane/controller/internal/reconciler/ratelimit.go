@@ -0,0 +1,142 @@
+package reconciler
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const (
+	// rateLimitWindowMinute limits requests per minute rather than per second
+	rateLimitWindowMinute = "RATE_LIMIT_WINDOW_MINUTE"
+
+	// rateLimitConfigFile is the snippet applying the site's rate limits
+	rateLimitConfigFile = "ratelimit.conf"
+	// rateLimitZonesFile defines the rate limits' zones. limit_req_zone only
+	// works at http level, so it goes in the http directory, which the site's
+	// nginx includes there rather than in the server block
+	rateLimitZonesFile = "http/ratelimit.conf"
+	// rateLimitZonePrefix starts the name of every zone the controller renders
+	rateLimitZonePrefix = "libops_rl_"
+
+	// nginxErrorLog is where nginx logs the requests it rejected over a rate limit
+	nginxErrorLog = "/var/log/nginx/error.log"
+)
+
+// rateLimitRejected matches the error log line nginx writes for each request
+// it rejects over a limit; delayed and dry run requests are logged differently
+var rateLimitRejected = regexp.MustCompile(`limiting requests, excess: [0-9.]+ by zone "(` + rateLimitZonePrefix + `[0-9a-f]+)"`)
+
+// RateLimit limits how many requests each client IP can make to the site
+type RateLimit struct {
+	RuleID     string `json:"ruleId"`
+	PathPrefix string `json:"pathPrefix"`
+	Requests   int    `json:"requests"`
+	Window     string `json:"window"`
+	Burst      int    `json:"burst"`
+}
+
+// RateLimitRejections is how many requests a rate limit rejected, as reported
+// on check-in
+type RateLimitRejections struct {
+	RuleID   string `json:"rule_id"`
+	Rejected int64  `json:"rejected"`
+}
+
+// renderRateLimits renders the site's rate limits as http-level limit_req
+// zones and the server-level snippet that applies them. Rules under a path
+// key their zone on a map that's empty elsewhere, and nginx doesn't count
+// requests with an empty key
+func renderRateLimits(rateLimits []RateLimit) map[string]string {
+	header := "# Managed by libops; changes are overwritten on reconciliation\n"
+	var zones, limits strings.Builder
+	zones.WriteString(header)
+	limits.WriteString(header)
+	if len(rateLimits) > 0 {
+		limits.WriteString("limit_req_status 429;\n")
+	}
+
+	for _, rateLimit := range rateLimits {
+		zone, ok := rateLimitZone(rateLimit.RuleID)
+		if !ok {
+			slog.Warn("skipping rate limit with an invalid ID", "rule_id", rateLimit.RuleID)
+			continue
+		}
+
+		key := "$binary_remote_addr"
+		if rateLimit.PathPrefix != "" {
+			key = "$" + zone + "_key"
+			fmt.Fprintf(&zones, "\n# %s\nmap $uri %s {\n    default \"\";\n    \"~^%s\" $binary_remote_addr;\n}\n",
+				rateLimit.RuleID, key, regexp.QuoteMeta(rateLimit.PathPrefix))
+		} else {
+			fmt.Fprintf(&zones, "\n# %s\n", rateLimit.RuleID)
+		}
+
+		unit := "r/s"
+		if rateLimit.Window == rateLimitWindowMinute {
+			unit = "r/m"
+		}
+		fmt.Fprintf(&zones, "limit_req_zone %s zone=%s:10m rate=%d%s;\n", key, zone, rateLimit.Requests, unit)
+		fmt.Fprintf(&limits, "limit_req zone=%s burst=%d nodelay;\n", zone, rateLimit.Burst)
+	}
+
+	return map[string]string{
+		rateLimitZonesFile:  zones.String(),
+		rateLimitConfigFile: limits.String(),
+	}
+}
+
+// rateLimitZone names a rule's zone after its ID, which the API issues as a
+// UUID
+func rateLimitZone(ruleID string) (string, bool) {
+	id := strings.ReplaceAll(ruleID, "-", "")
+	if id == "" || strings.Trim(id, "0123456789abcdef") != "" {
+		return "", false
+	}
+	return rateLimitZonePrefix + id, true
+}
+
+// collectRateLimitRejections counts the rejections nginx logged since the
+// last call and returns every count not yet reported
+func (r *Reconciler) collectRateLimitRejections() []RateLimitRejections {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lines, err := readNewLogLines(nginxErrorLog, &r.errorLogOffset)
+	if err != nil {
+		slog.Warn("failed to read nginx error log", "error", err)
+	}
+	for _, line := range lines {
+		match := rateLimitRejected.FindSubmatch(line)
+		if match == nil {
+			continue
+		}
+		// Zones of rules deleted since are dropped with them
+		if ruleID, ok := r.rateLimitZones[string(match[1])]; ok {
+			if r.rateLimitRejections == nil {
+				r.rateLimitRejections = map[string]int64{}
+			}
+			r.rateLimitRejections[ruleID]++
+		}
+	}
+
+	rejections := make([]RateLimitRejections, 0, len(r.rateLimitRejections))
+	for ruleID, rejected := range r.rateLimitRejections {
+		rejections = append(rejections, RateLimitRejections{RuleID: ruleID, Rejected: rejected})
+	}
+	return rejections
+}
+
+// dropRateLimitRejections forgets rejections once the API has counted them;
+// ones logged since keep counting toward the next check-in
+func (r *Reconciler) dropRateLimitRejections(reported []RateLimitRejections) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rejections := range reported {
+		r.rateLimitRejections[rejections.RuleID] -= rejections.Rejected
+		if r.rateLimitRejections[rejections.RuleID] <= 0 {
+			delete(r.rateLimitRejections, rejections.RuleID)
+		}
+	}
+}
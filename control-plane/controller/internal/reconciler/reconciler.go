@@ -4,20 +4,85 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+
+	"github.com/libops/controller/internal/clientcert"
+	"github.com/libops/controller/internal/selfupdate"
+)
+
+const (
+	// defaultDeployPath is where the site's repository is checked out
+	defaultDeployPath = "/opt/app"
+	// defaultComposeFile is the compose file used when a deployment does not name one
+	defaultComposeFile = "docker-compose.yml"
+	// siteStatusSuspended is the site status reported on check-in while the site is suspended
+	siteStatusSuspended = "suspended"
+	// dataDiskPath is the mount point of the site's data disk
+	dataDiskPath = "/mnt/disks/data"
+	// netStatsPath holds per-interface network counters
+	netStatsPath = "/sys/class/net"
 )
 
 // Reconciler handles VM-level reconciliation of configuration
 type Reconciler struct {
-	apiURL     string
+	// apiURL is where the API is reached; it moves to a site's API region
+	// when followRegion is set
+	apiURLMu     sync.RWMutex
+	apiURL       string
+	followRegion bool
+
 	siteID     string
 	httpClient *http.Client
+
+	// suspended tracks whether the application was stopped because the site is suspended
+	mu        sync.Mutex
+	suspended bool
+
+	// lastTxBytes is the interface transmit counter at the last successful check-in
+	lastTxBytes int64
+
+	// controllerPort is where nginx reaches the members only gate handlers
+	controllerPort string
+	// access is the access protection nginx was last configured with
+	access ProxyAccess
+
+	// waf is the WAF nginx was last configured with
+	waf ProxyWaf
+	// wafLogOffset is how far the WAF audit log has been read
+	wafLogOffset int64
+	// wafBlocks are the blocks read from the WAF audit log and not yet reported
+	wafBlocks []WafBlock
+
+	// rateLimitZones maps the zones nginx was last configured with to their rules
+	rateLimitZones map[string]string
+	// errorLogOffset is how far the nginx error log has been read
+	errorLogOffset int64
+	// rateLimitRejections counts the rejections per rule not yet reported
+	rateLimitRejections map[string]int64
+
+	// remoteConfig is the controller config last returned on check-in
+	configMu            sync.Mutex
+	remoteConfig        RemoteConfig
+	remoteConfigApplied bool
+	onRemoteConfig      func(RemoteConfig)
+	onControllerRelease func(selfupdate.Release)
+	// controllerVersion is the release the controller reports running on check-in
+	controllerVersion string
+	// firewallBackend is the backend the firewall rules were last applied with
+	firewallBackend string
+
+	// clientCert is the certificate presented to the API over mutual TLS
+	clientCert *clientcert.Store
 }
 
 // NewReconciler creates a new VM reconciler
@@ -28,6 +93,7 @@ func NewReconciler(apiURL, siteID string) *Reconciler {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		controllerPort: "8080",
 	}
 }
 
@@ -37,6 +103,7 @@ type Member struct {
 	Name     string   `json:"name"`
 	Email    string   `json:"email"`
 	SSHKeys  []SSHKey `json:"ssh_keys"`
+	SftpOnly bool     `json:"sftp_only"` // Only gets an SFTP login to the site's files
 }
 
 // SSHKey represents an SSH public key
@@ -47,14 +114,15 @@ type SSHKey struct {
 
 // Secret represents a secret key-value pair
 type Secret struct {
-	ID    string `json:"id"`    // Secret ID for status tracking
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	ID        string `json:"id"` // Secret ID for status tracking
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Reference string `json:"reference"` // External secret to resolve the value from
 }
 
 // FirewallRule represents a firewall rule
 type FirewallRule struct {
-	ID       string `json:"id"`       // Firewall rule ID for status tracking
+	ID       string `json:"id"` // Firewall rule ID for status tracking
 	Protocol string `json:"protocol"`
 	Port     int    `json:"port"`
 	Source   string `json:"source"`
@@ -85,6 +153,19 @@ func (r *Reconciler) ReconcileAll(ctx context.Context) error {
 		// Continue with other reconciliations
 	}
 
+	// Databases go first: provisioning one writes its address to the
+	// secrets reconciled next
+	if err := r.ReconcileDatabases(ctx); err != nil {
+		slog.Error("database reconciliation failed", "error", err)
+		// Continue with other reconciliations
+	}
+
+	// Add-ons likewise write their addresses to secrets
+	if err := r.ReconcileAddons(ctx); err != nil {
+		slog.Error("add-on reconciliation failed", "error", err)
+		// Continue with other reconciliations
+	}
+
 	if err := r.ReconcileSecrets(ctx); err != nil {
 		slog.Error("secrets reconciliation failed", "error", err)
 		// Continue with other reconciliations
@@ -95,6 +176,11 @@ func (r *Reconciler) ReconcileAll(ctx context.Context) error {
 		// Continue with other reconciliations
 	}
 
+	if err := r.ReconcileProxy(ctx); err != nil {
+		slog.Error("proxy reconciliation failed", "error", err)
+		// Continue with other reconciliations
+	}
+
 	// Note: Deployment is NOT run on periodic reconciliation
 	// It is only triggered manually or via webhook
 
@@ -163,14 +249,30 @@ func (r *Reconciler) ReconcileSecrets(ctx context.Context) error {
 		return fmt.Errorf("failed to fetch secrets: %w", err)
 	}
 
-	// 3. Apply secrets to .env file
+	// 3. Resolve references to secrets kept in the customer's own store
+	if err := r.resolveReferences(ctx, secrets); err != nil {
+		r.reportReconciliationStatus(ctx, token, "secrets", nil, "failed", err.Error())
+		return fmt.Errorf("failed to resolve secret references: %w", err)
+	}
+
+	// 4. Apply secrets to .env file
 	if err := r.applySecrets(secrets); err != nil {
 		// Report failure
 		r.reportReconciliationStatus(ctx, token, "secrets", nil, "failed", err.Error())
 		return fmt.Errorf("failed to apply secrets: %w", err)
 	}
 
-	// 4. Report successful reconciliation to API (marks secrets as active)
+	// Config vars are delivered with the secrets, in a plaintext file of their own
+	configVars, err := r.fetchConfigVars(ctx, token)
+	if err != nil {
+		return fmt.Errorf("failed to fetch config vars: %w", err)
+	}
+	if err := applyConfigVars(configVars); err != nil {
+		r.reportReconciliationStatus(ctx, token, "secrets", nil, "failed", err.Error())
+		return fmt.Errorf("failed to apply config vars: %w", err)
+	}
+
+	// 5. Report successful reconciliation to API (marks secrets as active)
 	secretIDs := make([]string, len(secrets))
 	for i, secret := range secrets {
 		secretIDs[i] = secret.ID
@@ -269,6 +371,64 @@ func (r *Reconciler) ReconcileDeployment(ctx context.Context) error {
 	return nil
 }
 
+// ResolveAPIURL switches the reconciler to the organization's Private Service
+// Connect endpoint for the API when it has one; otherwise the configured URL
+// is kept
+func (r *Reconciler) ResolveAPIURL(ctx context.Context) {
+	token, err := r.getVMServiceAccountToken(ctx)
+	if err != nil {
+		slog.Warn("failed to get service account token, keeping the configured API URL", "error", err)
+		return
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"siteId": r.siteID,
+		"target": "PRIVATE_SERVICE_CONNECT_TARGET_API",
+	})
+	if err != nil {
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminReconciliationService/ResolvePrivateServiceConnectEndpoint", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(string(payload)))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		slog.Warn("failed to resolve private service connect endpoint, keeping the configured API URL", "error", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// 404 means the organization has no active endpoint for the API
+		slog.Info("no private service connect endpoint for the API, using the configured API URL", "status", resp.StatusCode, "api_url", r.baseURL())
+		return
+	}
+
+	var resolved struct {
+		Endpoint struct {
+			IPAddress string `json:"ipAddress"`
+		} `json:"endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&resolved); err != nil || resolved.Endpoint.IPAddress == "" {
+		slog.Warn("invalid private service connect endpoint response, keeping the configured API URL", "error", err)
+		return
+	}
+
+	// The endpoint is private to the site's network, so it's kept even if
+	// the API later says another region serves the site
+	r.apiURLMu.Lock()
+	r.apiURL = fmt.Sprintf("https://%s", resolved.Endpoint.IPAddress)
+	r.followRegion = false
+	r.apiURLMu.Unlock()
+	slog.Info("using private service connect endpoint for the API", "api_url", r.baseURL())
+}
+
 // CheckIn updates the site's check-in timestamp
 func (r *Reconciler) CheckIn(ctx context.Context) error {
 	// Get VM service account token
@@ -277,27 +437,185 @@ func (r *Reconciler) CheckIn(ctx context.Context) error {
 		return fmt.Errorf("failed to get service account token: %w", err)
 	}
 
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/checkin", r.apiURL, r.siteID)
+	if r.clientCert != nil && r.FeatureEnabled(FeatureMTLS) && r.clientCert.NeedsRenewal(time.Now()) {
+		// The current certificate, if any, stays valid for a third of its
+		// lifetime, leaving later check-ins to retry
+		if err := r.renewClientCertificate(ctx, token); err != nil {
+			slog.Warn("failed to renew client certificate", "error", err)
+		}
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, nil)
+	// Report usage for metered billing alongside the check-in
+	txBytes, err := readTxBytes()
+	if err != nil {
+		slog.Warn("failed to read network counters", "error", err)
+	}
+	diskUsed, err := diskUsedBytes(dataDiskPath)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		slog.Warn("failed to read disk usage", "error", err)
+	}
+	// Report the OS image so the fleet overview shows VMs that need patching
+	osImage, err := osVersion()
+	if err != nil {
+		slog.Warn("failed to read OS version", "error", err)
+	}
+	wafBlocks := r.collectWafBlocks()
+	rejections := r.collectRateLimitRejections()
+	msg := &libopsv1.SiteCheckInRequest{
+		SiteId:            r.siteID,
+		EgressBytes:       r.egressSinceLastCheckIn(txBytes),
+		DiskUsedBytes:     diskUsed,
+		ControllerVersion: r.controllerVersion,
+		OsVersion:         osImage,
+		OsRebootRequired:  osRebootRequired(ctx),
+	}
+	// Container health feeds the site's health score; without docker it is left unset
+	if running, unhealthy, err := containerHealth(ctx); err != nil {
+		slog.Warn("failed to list containers", "error", err)
+	} else {
+		msg.ContainersRunning = &running
+		msg.ContainersUnhealthy = &unhealthy
+	}
+	for _, block := range wafBlocks {
+		msg.WafBlocks = append(msg.WafBlocks, &libopsv1.WafBlock{
+			RuleId:     int32(block.RuleID),
+			Message:    block.Message,
+			Method:     block.Method,
+			Uri:        block.URI,
+			ClientIp:   block.ClientIP,
+			Blocked:    block.Blocked,
+			OccurredAt: block.OccurredAt,
+		})
+	}
+	for _, rejection := range rejections {
+		msg.RateLimitRejections = append(msg.RateLimitRejections, &libopsv1.RateLimitRejections{
+			RuleId:   rejection.RuleID,
+			Rejected: rejection.Rejected,
+		})
+	}
+
+	resp, err := r.agentClient().CheckIn(ctx, agentRequest(token, msg))
+	if err != nil {
+		return fmt.Errorf("check-in failed: %w", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	// The API has recorded the egress, so the next check-in reports from here
+	r.mu.Lock()
+	r.lastTxBytes = txBytes
+	r.mu.Unlock()
+	r.dropWafBlocks(len(wafBlocks))
+	r.dropRateLimitRejections(rejections)
 
-	resp, err := r.httpClient.Do(req)
+	slog.Debug("check-in successful", "site_id", r.siteID, "status", resp.Msg.Status)
+	r.applyRemoteConfig(remoteConfigFromProto(resp.Msg.ControllerConfig))
+	r.applyControllerRelease(releaseFromProto(resp.Msg.ControllerRelease))
+	r.applyAPIRegion(resp.Msg.ApiRegion, resp.Msg.ApiUrl)
+	return r.applySiteStatus(ctx, resp.Msg.Status)
+}
+
+// baseURL returns the API URL requests are sent to
+func (r *Reconciler) baseURL() string {
+	r.apiURLMu.RLock()
+	defer r.apiURLMu.RUnlock()
+	return r.apiURL
+}
+
+// FollowAPIRegion makes the reconciler switch to the API region that serves
+// the site when check-in names one. It's only used when the API URL was
+// discovered rather than configured.
+func (r *Reconciler) FollowAPIRegion() {
+	r.apiURLMu.Lock()
+	defer r.apiURLMu.Unlock()
+	r.followRegion = true
+}
+
+// applyAPIRegion switches to the API region that serves the site
+func (r *Reconciler) applyAPIRegion(region, url string) {
+	r.apiURLMu.Lock()
+	defer r.apiURLMu.Unlock()
+	if !r.followRegion || url == "" || url == r.apiURL {
+		return
+	}
+	slog.Info("switching to the site's API region", "api_region", region, "from", r.apiURL, "to", url)
+	r.apiURL = url
+}
+
+// egressSinceLastCheckIn returns the bytes transmitted since the last successful check-in.
+// The first check-in after the controller starts only establishes a baseline.
+func (r *Reconciler) egressSinceLastCheckIn(txBytes int64) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lastTxBytes == 0 || txBytes == 0 {
+		return 0
+	}
+	if txBytes < r.lastTxBytes {
+		// Counters reset when an interface is recreated
+		return txBytes
+	}
+	return txBytes - r.lastTxBytes
+}
+
+// readTxBytes sums the transmit counters of every non-loopback network interface
+func readTxBytes() (int64, error) {
+	entries, err := os.ReadDir(netStatsPath)
 	if err != nil {
-		return fmt.Errorf("failed to call check-in endpoint: %w", err)
+		return 0, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("check-in returned status %d: %s", resp.StatusCode, string(body))
+	var total int64
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == "lo" || strings.HasPrefix(name, "docker") || strings.HasPrefix(name, "veth") || strings.HasPrefix(name, "br-") {
+			continue
+		}
+		data, err := os.ReadFile(fmt.Sprintf("%s/%s/statistics/tx_bytes", netStatsPath, name))
+		if err != nil {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			continue
+		}
+		total += n
 	}
+	return total, nil
+}
 
-	slog.Debug("check-in successful", "site_id", r.siteID)
+// diskUsedBytes returns the bytes in use on the filesystem mounted at path
+func diskUsedBytes(path string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return int64(stat.Blocks-stat.Bfree) * int64(stat.Bsize), nil
+}
+
+// applySiteStatus stops the application while the site is suspended (e.g. for
+// unpaid billing) and starts it again once the site is reactivated
+func (r *Reconciler) applySiteStatus(ctx context.Context, status string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	suspend := status == siteStatusSuspended
+	if suspend == r.suspended {
+		return nil
+	}
+
+	composePath := fmt.Sprintf("%s/%s", defaultDeployPath, defaultComposeFile)
+	args := []string{"compose", "-f", composePath, "up", "-d"}
+	if suspend {
+		args = []string{"compose", "-f", composePath, "stop"}
+	}
+
+	slog.Info("applying site status", "site_id", r.siteID, "status", status)
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Dir = defaultDeployPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("docker compose %s failed: %s: %w", args[len(args)-1], string(output), err)
+	}
+
+	r.suspended = suspend
 	return nil
 }
 
@@ -335,103 +653,69 @@ func (r *Reconciler) getVMServiceAccountToken(ctx context.Context) (string, erro
 	return tokenResp.AccessToken, nil
 }
 
-// fetchMembers fetches members with SSH keys from admin API
+// fetchMembers fetches members with SSH keys from admin API, grouping the
+// site's keys by the account that owns them
 func (r *Reconciler) fetchMembers(ctx context.Context, token string) ([]Member, error) {
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/members", r.apiURL, r.siteID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	resp, err := r.httpClient.Do(req)
+	resp, err := r.agentClient().GetSSHKeys(ctx, agentRequest(token, &libopsv1.GetSiteSSHKeysRequest{SiteId: r.siteID}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch members: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var result struct {
-		Members []Member `json:"members"`
-	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	var members []Member
+	index := make(map[string]int)
+	for _, key := range resp.Msg.Keys {
+		if key.AccountId == "" {
+			continue
+		}
+		i, ok := index[key.AccountId]
+		if !ok {
+			i = len(members)
+			index[key.AccountId] = i
+			members = append(members, Member{PublicID: key.AccountId, SftpOnly: key.SftpOnly})
+		}
+		members[i].SSHKeys = append(members[i].SSHKeys, SSHKey{PublicKey: key.PublicKey, Fingerprint: key.Fingerprint})
 	}
 
-	return result.Members, nil
+	return members, nil
 }
 
 // fetchFirewallRules fetches firewall rules from admin API
 func (r *Reconciler) fetchFirewallRules(ctx context.Context, token string) ([]FirewallRule, error) {
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/firewall", r.apiURL, r.siteID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	resp, err := r.httpClient.Do(req)
+	resp, err := r.agentClient().GetFirewall(ctx, agentRequest(token, &libopsv1.GetSiteFirewallRequest{SiteId: r.siteID}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch firewall rules: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var result struct {
-		Rules []FirewallRule `json:"rules"`
-	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	rules := make([]FirewallRule, 0, len(resp.Msg.Rules))
+	for _, rule := range resp.Msg.Rules {
+		rules = append(rules, FirewallRule{
+			ID:       fmt.Sprintf("%s/%d/%s", rule.Protocol, rule.Port, rule.Source),
+			Protocol: rule.Protocol,
+			Port:     int(rule.Port),
+			Source:   rule.Source,
+			Action:   rule.Action,
+		})
 	}
-
-	return result.Rules, nil
+	return rules, nil
 }
 
 // fetchSecrets fetches secrets from admin API
 func (r *Reconciler) fetchSecrets(ctx context.Context, token string) ([]Secret, error) {
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/secrets", r.apiURL, r.siteID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	resp, err := r.httpClient.Do(req)
+	resp, err := r.agentClient().GetSecrets(ctx, agentRequest(token, &libopsv1.GetSiteSecretsRequest{SiteId: r.siteID}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch secrets: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
 
-	var result struct {
-		Secrets []Secret `json:"secrets"`
+	secrets := make([]Secret, 0, len(resp.Msg.Secrets))
+	for _, secret := range resp.Msg.Secrets {
+		secrets = append(secrets, Secret{
+			ID:        secret.Key,
+			Key:       secret.Key,
+			Value:     secret.Value,
+			Reference: secret.Reference,
+		})
 	}
-
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return result.Secrets, nil
+	return secrets, nil
 }
 
 // reconcileMembers ensures user accounts exist on host and SSH keys are configured
@@ -444,8 +728,18 @@ func (r *Reconciler) reconcileMembers(members []Member) error {
 
 	// 2. Track desired users
 	desiredUsers := make(map[string]bool)
+	sftpOnly := false
 	for _, member := range members {
 		desiredUsers[member.PublicID] = true
+		sftpOnly = sftpOnly || member.SftpOnly
+	}
+
+	// SFTP-only logins need their group, chroot and sshd Match block in
+	// place before any account is put in the group
+	if sftpOnly {
+		if err := ensureSftp(); err != nil {
+			return fmt.Errorf("failed to set up SFTP access: %w", err)
+		}
 	}
 
 	// 3. Create/update users and SSH keys
@@ -464,6 +758,12 @@ func (r *Reconciler) reconcileMembers(members []Member) error {
 			}
 		}
 
+		// Switch the user between a shell and an SFTP-only login
+		if err := setSftpOnly(username, member.SftpOnly); err != nil {
+			slog.Error("failed to set login type", "username", username, "sftp_only", member.SftpOnly, "error", err)
+			continue
+		}
+
 		// Update SSH keys for user
 		if err := r.updateUserSSHKeys(username, member.SSHKeys); err != nil {
 			slog.Error("failed to update SSH keys", "username", username, "error", err)
@@ -650,8 +950,37 @@ func (r *Reconciler) applySecrets(secrets []Secret) error {
 	return nil
 }
 
-// applyFirewallRules applies firewall rules via iptables
+// applyFirewallRules applies firewall rules with the backend the remote config selects
+// and removes the rules of the other backend after switching
 func (r *Reconciler) applyFirewallRules(rules []FirewallRule) error {
+	backend := firewallBackendIptables
+	if r.FeatureEnabled(FeatureNftables) {
+		backend = firewallBackendNftables
+	}
+
+	var err error
+	if backend == firewallBackendNftables {
+		err = applyNftablesRules(rules)
+	} else {
+		err = applyIptablesRules(rules)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Rules the other backend left behind would still filter traffic
+	r.configMu.Lock()
+	previous := r.firewallBackend
+	r.firewallBackend = backend
+	r.configMu.Unlock()
+	if previous != backend {
+		removeFirewallBackend(otherFirewallBackend(backend))
+	}
+	return nil
+}
+
+// applyIptablesRules applies firewall rules in the LIBOPS-FIREWALL iptables chain
+func applyIptablesRules(rules []FirewallRule) error {
 	// Flush existing LibOps rules
 	// (In production, use a dedicated chain for LibOps rules)
 	slog.Info("applying firewall rules", "rule_count", len(rules))
@@ -722,106 +1051,50 @@ func (r *Reconciler) applyFirewallRules(rules []FirewallRule) error {
 
 // fetchDeployment fetches deployment config from admin API
 func (r *Reconciler) fetchDeployment(ctx context.Context, token string) (*Deployment, error) {
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/deployment", r.apiURL, r.siteID)
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-	resp, err := r.httpClient.Do(req)
+	resp, err := r.agentClient().GetDeployment(ctx, agentRequest(token, &libopsv1.GetAgentDeploymentRequest{SiteId: r.siteID}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch deployment: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
-	}
 
-	var deployment Deployment
-	if err := json.NewDecoder(resp.Body).Decode(&deployment); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &deployment, nil
+	deployment := resp.Msg.Deployment
+	return &Deployment{
+		GitHubRepo:     deployment.GithubRepo,
+		GitHubRef:      deployment.GitRef,
+		DeploymentPath: deployment.ComposePath,
+		ComposeFile:    deployment.ComposeFile,
+		DeploymentID:   deployment.DeploymentId,
+		CommitSHA:      deployment.CommitSha,
+		CommitMessage:  deployment.CommitMessage,
+	}, nil
 }
 
 // reportDeploymentStatus reports deployment status back to API
 func (r *Reconciler) reportDeploymentStatus(ctx context.Context, token, deploymentID, status, errorMsg string) error {
-	endpoint := fmt.Sprintf("%s/admin/deployments/%s/status", r.apiURL, deploymentID)
-
-	payload := map[string]string{
-		"status": status,
-		"error":  errorMsg,
-	}
-
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(string(body)))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := r.httpClient.Do(req)
+	_, err := r.agentClient().ReportDeploymentStatus(ctx, agentRequest(token, &libopsv1.ReportAgentDeploymentStatusRequest{
+		SiteId:       r.siteID,
+		DeploymentId: deploymentID,
+		Status:       status,
+		Error:        errorMsg,
+	}))
 	if err != nil {
 		return fmt.Errorf("failed to report status: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
-	}
-
 	return nil
 }
 
 // reportReconciliationStatus reports the status of a reconciliation to the API
 // This marks resources as "active" after successful reconciliation
 func (r *Reconciler) reportReconciliationStatus(ctx context.Context, token, reconciliationType string, resourceIDs []string, status string, errorMsg string) error {
-	endpoint := fmt.Sprintf("%s/admin/sites/%s/reconciliation/status", r.apiURL, r.siteID)
-
-	payload := map[string]interface{}{
-		"type":         reconciliationType, // "ssh_keys", "secrets", "firewall", "deployment"
-		"status":       status,              // "active", "failed"
-		"resource_ids": resourceIDs,         // IDs of resources that were reconciled
-		"error":        errorMsg,
-		"timestamp":    time.Now().UTC().Format(time.RFC3339),
-	}
-
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(string(body)))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := r.httpClient.Do(req)
+	_, err := r.agentClient().ReportReconciliationStatus(ctx, agentRequest(token, &libopsv1.ReportAgentReconciliationStatusRequest{
+		SiteId:      r.siteID,
+		Type:        reconciliationType, // "ssh_keys", "secrets", "firewall", "deployment"
+		Status:      status,             // "active", "failed"
+		ResourceIds: resourceIDs,        // IDs of resources that were reconciled
+		Error:       errorMsg,
+	}))
 	if err != nil {
 		return fmt.Errorf("failed to report status: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(respBody))
-	}
 
 	slog.Info("reported reconciliation status",
 		"type", reconciliationType,
@@ -841,7 +1114,7 @@ func (r *Reconciler) executeDeployment(ctx context.Context, deployment *Deployme
 
 	deployPath := deployment.DeploymentPath
 	if deployPath == "" {
-		deployPath = "/opt/app"
+		deployPath = defaultDeployPath
 	}
 
 	// 1. Clone or update repository
@@ -857,7 +1130,7 @@ func (r *Reconciler) executeDeployment(ctx context.Context, deployment *Deployme
 	// 3. Run docker-compose
 	composeFile := deployment.ComposeFile
 	if composeFile == "" {
-		composeFile = "docker-compose.yml"
+		composeFile = defaultComposeFile
 	}
 
 	if err := r.deployWithCompose(ctx, deployPath, composeFile); err != nil {
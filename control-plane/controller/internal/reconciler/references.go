@@ -0,0 +1,247 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// secretManagerURL is the Secret Manager API gcp-sm:// references are read from
+	secretManagerURL = "https://secretmanager.googleapis.com/v1"
+	// identityTokenEndpoint issues the VM's identity tokens, exchanged for Vault tokens
+	identityTokenEndpoint = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/identity"
+	// defaultVaultRole is the Vault GCP auth role used when a reference names none
+	defaultVaultRole = "libops"
+	// defaultVaultField is the field read from a Vault secret when a reference names none
+	defaultVaultField = "value"
+)
+
+// referenceResolver resolves references to secrets kept in the customer's own
+// store, using the VM's service account. It lives for one reconciliation so
+// tokens are fetched once per pass.
+type referenceResolver struct {
+	r           *Reconciler
+	accessToken string
+	vaultTokens map[string]string // by address and role
+}
+
+// resolveReferences fills in the value of each secret that references an
+// external secret. Any reference that can't be resolved fails the whole
+// pass, so the secrets file is left as it was rather than written without it.
+func (r *Reconciler) resolveReferences(ctx context.Context, secrets []Secret) error {
+	resolver := &referenceResolver{r: r, vaultTokens: map[string]string{}}
+	resolved := 0
+	for i, secret := range secrets {
+		if secret.Reference == "" {
+			continue
+		}
+		value, err := resolver.resolve(ctx, secret.Reference)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s from %s: %w", secret.Key, secret.Reference, err)
+		}
+		secrets[i].Value = value
+		resolved++
+	}
+	if resolved > 0 {
+		slog.Info("resolved external secret references", "count", resolved)
+	}
+	return nil
+}
+
+// resolve reads the value a reference points at.
+func (rr *referenceResolver) resolve(ctx context.Context, reference string) (string, error) {
+	u, err := url.Parse(reference)
+	if err != nil {
+		return "", fmt.Errorf("invalid reference: %w", err)
+	}
+	switch u.Scheme {
+	case "gcp-sm":
+		// gcp-sm://PROJECT/NAME[/VERSION]
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if u.Host == "" || parts[0] == "" || len(parts) > 2 {
+			return "", fmt.Errorf("invalid Secret Manager reference")
+		}
+		version := "latest"
+		if len(parts) == 2 {
+			version = parts[1]
+		}
+		return rr.secretManager(ctx, u.Host, parts[0], version)
+	case "vault":
+		// vault://HOST[:PORT]/PATH[#FIELD][?role=ROLE]
+		path := strings.Trim(u.Path, "/")
+		if u.Host == "" || path == "" {
+			return "", fmt.Errorf("invalid Vault reference")
+		}
+		field := u.Fragment
+		if field == "" {
+			field = defaultVaultField
+		}
+		role := u.Query().Get("role")
+		if role == "" {
+			role = defaultVaultRole
+		}
+		return rr.vault(ctx, "https://"+u.Host, path, field, role)
+	default:
+		return "", fmt.Errorf("unsupported reference scheme %q", u.Scheme)
+	}
+}
+
+// secretManager accesses a Secret Manager secret version.
+func (rr *referenceResolver) secretManager(ctx context.Context, project, name, version string) (string, error) {
+	if rr.accessToken == "" {
+		token, err := rr.r.getVMServiceAccountToken(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get service account token: %w", err)
+		}
+		rr.accessToken = token
+	}
+
+	endpoint := fmt.Sprintf("%s/projects/%s/secrets/%s/versions/%s:access",
+		secretManagerURL, url.PathEscape(project), url.PathEscape(name), url.PathEscape(version))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", rr.accessToken))
+
+	var result struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := rr.do(req, &result); err != nil {
+		return "", fmt.Errorf("failed to access secret version: %w", err)
+	}
+	value, err := base64.StdEncoding.DecodeString(result.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret payload: %w", err)
+	}
+	return string(value), nil
+}
+
+// vault reads a field of a secret from the customer's Vault, logging in with
+// the VM's identity through Vault's GCP auth method. KV version 1 and 2
+// responses are both understood.
+func (rr *referenceResolver) vault(ctx context.Context, address, path, field, role string) (string, error) {
+	token, err := rr.vaultToken(ctx, address, role)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s", address, path), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	var result struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := rr.do(req, &result); err != nil {
+		return "", fmt.Errorf("failed to read Vault secret: %w", err)
+	}
+	data := result.Data
+	if nested, ok := data["data"].(map[string]any); ok {
+		data = nested
+	}
+	value, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("Vault secret has no field %q", field)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode field %q: %w", field, err)
+	}
+	return string(encoded), nil
+}
+
+// vaultToken logs in to a Vault with the VM's identity token.
+func (rr *referenceResolver) vaultToken(ctx context.Context, address, role string) (string, error) {
+	key := address + "|" + role
+	if token, ok := rr.vaultTokens[key]; ok {
+		return token, nil
+	}
+
+	jwt, err := rr.r.getVMIdentityToken(ctx, "http://vault/"+role)
+	if err != nil {
+		return "", fmt.Errorf("failed to get identity token: %w", err)
+	}
+	payload, err := json.Marshal(map[string]string{"role": role, "jwt": jwt})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address+"/v1/auth/gcp/login", bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var result struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := rr.do(req, &result); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault: %w", err)
+	}
+	if result.Auth.ClientToken == "" {
+		return "", fmt.Errorf("Vault login returned no token")
+	}
+	rr.vaultTokens[key] = result.Auth.ClientToken
+	return result.Auth.ClientToken, nil
+}
+
+// do sends a request and decodes its JSON response.
+func (rr *referenceResolver) do(req *http.Request, result any) error {
+	resp, err := rr.r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// getVMIdentityToken fetches a signed identity token for the VM's service
+// account from the metadata server.
+func (r *Reconciler) getVMIdentityToken(ctx context.Context, audience string) (string, error) {
+	endpoint := fmt.Sprintf("%s?audience=%s&format=full", identityTokenEndpoint, url.QueryEscape(audience))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch identity token from metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata server returned status %d", resp.StatusCode)
+	}
+	token, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read identity token: %w", err)
+	}
+	return string(token), nil
+}
@@ -0,0 +1,94 @@
+package reconciler
+
+import (
+	"log/slog"
+
+	"github.com/libops/controller/internal/selfupdate"
+)
+
+const (
+	// FeatureNftables applies the site's firewall rules with nftables instead of iptables
+	FeatureNftables = "nftables"
+	// FeatureMTLS presents a client certificate from the LibOps controller CA
+	// to the API, requesting and renewing it on check-in
+	FeatureMTLS = "mtls"
+)
+
+// RemoteConfig is the controller configuration the API returns on check-in.
+// Unset settings keep the value the controller was started with
+type RemoteConfig struct {
+	Version                  string          `json:"version"`
+	RateLimitRPS             *int            `json:"rateLimitRps"`
+	RateLimitBurst           *int            `json:"rateLimitBurst"`
+	ReconcileIntervalMinutes *int            `json:"reconcileIntervalMinutes"`
+	CheckInIntervalSeconds   *int            `json:"checkinIntervalSeconds"`
+	FeatureFlags             map[string]bool `json:"featureFlags"`
+}
+
+// OnRemoteConfig registers a function called with the remote config each
+// time its version changes, including the first check-in
+func (r *Reconciler) OnRemoteConfig(fn func(RemoteConfig)) {
+	r.configMu.Lock()
+	defer r.configMu.Unlock()
+	r.onRemoteConfig = fn
+}
+
+// OnControllerRelease registers a function called with the controller release
+// the API advertises on each check-in
+func (r *Reconciler) OnControllerRelease(fn func(selfupdate.Release)) {
+	r.configMu.Lock()
+	defer r.configMu.Unlock()
+	r.onControllerRelease = fn
+}
+
+// SetControllerVersion sets the release the controller reports running on check-in
+func (r *Reconciler) SetControllerVersion(version string) {
+	r.controllerVersion = version
+}
+
+// FeatureEnabled reports whether the remote config turns on a feature flag
+func (r *Reconciler) FeatureEnabled(name string) bool {
+	r.configMu.Lock()
+	defer r.configMu.Unlock()
+	return r.remoteConfig.FeatureFlags[name]
+}
+
+// applyRemoteConfig stores the config returned on check-in and notifies the
+// listener when it changed. An API that predates remote config returns none,
+// which leaves the current config in place
+func (r *Reconciler) applyRemoteConfig(config *RemoteConfig) {
+	if config == nil {
+		return
+	}
+
+	r.configMu.Lock()
+	if r.remoteConfigApplied && config.Version == r.remoteConfig.Version {
+		r.configMu.Unlock()
+		return
+	}
+	previous := r.remoteConfig
+	r.remoteConfig = *config
+	r.remoteConfigApplied = true
+	listener := r.onRemoteConfig
+	r.configMu.Unlock()
+
+	slog.Info("applying controller config", "site_id", r.siteID, "version", config.Version, "previous_version", previous.Version)
+	if listener != nil {
+		listener(*config)
+	}
+}
+
+// applyControllerRelease passes the release advertised on check-in to the listener
+func (r *Reconciler) applyControllerRelease(release *selfupdate.Release) {
+	if release == nil {
+		return
+	}
+
+	r.configMu.Lock()
+	listener := r.onControllerRelease
+	r.configMu.Unlock()
+
+	if listener != nil {
+		listener(*release)
+	}
+}
@@ -0,0 +1,117 @@
+package reconciler
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// sftpGroup holds the accounts that only get an SFTP login
+	sftpGroup = "libops-sftp"
+	// sftpRoot is the chroot of SFTP-only logins. sshd requires it, and every
+	// directory above it, to be owned by root and writable by no one else
+	sftpRoot = dataDiskPath + "/sftp"
+	// sftpFilesDir is the site's files directory, the one directory SFTP-only
+	// logins can write to. The site's compose file mounts it where the
+	// application serves files from
+	sftpFilesDir = sftpRoot + "/files"
+	// sftpConfigFile holds the sshd Match block for SFTP-only logins
+	sftpConfigFile = "/etc/ssh/sshd_config.d/libops-sftp.conf"
+
+	// loginShell and sftpShell are the shells of full and SFTP-only logins
+	loginShell = "/bin/bash"
+	sftpShell  = "/usr/sbin/nologin"
+)
+
+// sftpConfig confines the group's logins to the site's files directory over
+// SFTP, with no shell, forwarding or tunnels
+const sftpConfig = `# Managed by libops; changes are overwritten on reconciliation
+Match Group ` + sftpGroup + `
+    ChrootDirectory ` + sftpRoot + `
+    ForceCommand internal-sftp -d /files
+    AllowTcpForwarding no
+    AllowAgentForwarding no
+    AllowStreamLocalForwarding no
+    PermitTunnel no
+    PermitTTY no
+    X11Forwarding no
+`
+
+// ensureSftp sets up the group, chroot and sshd configuration of SFTP-only
+// logins, reloading sshd when its configuration changes
+func ensureSftp() error {
+	if err := exec.Command("getent", "group", sftpGroup).Run(); err != nil {
+		if output, err := exec.Command("groupadd", "--system", sftpGroup).CombinedOutput(); err != nil {
+			return fmt.Errorf("groupadd failed: %s: %w", strings.TrimSpace(string(output)), err)
+		}
+	}
+
+	if err := os.MkdirAll(sftpRoot, 0755); err != nil {
+		return fmt.Errorf("failed to create SFTP root: %w", err)
+	}
+	if err := os.Chown(sftpRoot, 0, 0); err != nil {
+		return fmt.Errorf("failed to chown SFTP root: %w", err)
+	}
+	if err := os.Chmod(sftpRoot, 0755); err != nil {
+		return fmt.Errorf("failed to chmod SFTP root: %w", err)
+	}
+
+	// The setgid bit keeps uploads in the group, so every SFTP-only login
+	// and the site's containers can work on each other's files
+	if err := os.MkdirAll(sftpFilesDir, 0775); err != nil {
+		return fmt.Errorf("failed to create files directory: %w", err)
+	}
+	if output, err := exec.Command("chown", "root:"+sftpGroup, sftpFilesDir).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to chown files directory: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+	if err := os.Chmod(sftpFilesDir, 0775|os.ModeSetgid); err != nil {
+		return fmt.Errorf("failed to chmod files directory: %w", err)
+	}
+
+	current, err := os.ReadFile(sftpConfigFile)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read sshd config: %w", err)
+	}
+	if bytes.Equal(current, []byte(sftpConfig)) {
+		return nil
+	}
+	if err := os.WriteFile(sftpConfigFile, []byte(sftpConfig), 0644); err != nil {
+		return fmt.Errorf("failed to write sshd config: %w", err)
+	}
+
+	// A broken sshd config would lock every account out, so it's checked
+	// before sshd picks it up and rolled back if it fails
+	if output, err := exec.Command("sshd", "-t").CombinedOutput(); err != nil {
+		if current == nil {
+			os.Remove(sftpConfigFile)
+		} else {
+			os.WriteFile(sftpConfigFile, current, 0644)
+		}
+		return fmt.Errorf("sshd config check failed: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+	if output, err := exec.Command("systemctl", "reload", "ssh").CombinedOutput(); err != nil {
+		return fmt.Errorf("sshd reload failed: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+	return nil
+}
+
+// setSftpOnly switches a user between a full login, with a shell and access
+// to docker, and an SFTP-only one
+func setSftpOnly(username string, sftpOnly bool) error {
+	shell, join, leave := loginShell, "docker", sftpGroup
+	if sftpOnly {
+		shell, join, leave = sftpShell, sftpGroup, "docker"
+	}
+
+	if output, err := exec.Command("usermod", "--shell", shell, "-aG", join, username).CombinedOutput(); err != nil {
+		return fmt.Errorf("usermod failed: %s: %w", strings.TrimSpace(string(output)), err)
+	}
+
+	// gpasswd fails when the user isn't in the group, or the group doesn't
+	// exist yet, both of which leave the user where it should be
+	exec.Command("gpasswd", "--delete", username, leave).Run()
+	return nil
+}
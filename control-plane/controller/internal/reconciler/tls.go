@@ -0,0 +1,243 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// tlsVersion13 only accepts TLS 1.3 handshakes
+	tlsVersion13 = "TLS_VERSION_1_3"
+
+	// tlsConfigFile is the snippet holding the site's TLS policy
+	tlsConfigFile = "tls.conf"
+	// tlsCertificateFile and tlsPrivateKeyFile hold an uploaded certificate.
+	// They aren't *.conf files, so nginx only reads them through tls.conf,
+	// and they're emptied while the site serves its ACME certificate
+	tlsCertificateFile = "tls/site.crt"
+	tlsPrivateKeyFile  = "tls/site.key"
+
+	// tlsProbeAddr is where the site's nginx terminates TLS
+	tlsProbeAddr = "127.0.0.1:443"
+	// tlsProbeSettle gives nginx's new workers time to take over after a
+	// reload, so the probe doesn't reach the old ones
+	tlsProbeSettle = 2 * time.Second
+	// tlsProbeTimeout bounds each connection the probe makes
+	tlsProbeTimeout = 10 * time.Second
+)
+
+// ProxyTls is the site's TLS policy
+type ProxyTls struct {
+	MinVersion            string   `json:"minVersion"`
+	HstsMaxAgeSeconds     int      `json:"hstsMaxAgeSeconds"`
+	HstsIncludeSubdomains bool     `json:"hstsIncludeSubdomains"`
+	HstsPreload           bool     `json:"hstsPreload"`
+	OcspStapling          bool     `json:"ocspStapling"`
+	CertificatePem        string   `json:"certificatePem"`
+	PrivateKeyPem         string   `json:"privateKeyPem"`
+	Domains               []string `json:"domains"`
+}
+
+// renderTls renders the site's TLS policy as a server-level nginx snippet,
+// along with its uploaded certificate and key when it has one
+func renderTls(policy ProxyTls) map[string]string {
+	var b strings.Builder
+	b.WriteString("# Managed by libops; changes are overwritten on reconciliation\n")
+
+	if policy.MinVersion == tlsVersion13 {
+		b.WriteString("ssl_protocols TLSv1.3;\n")
+	} else {
+		b.WriteString("ssl_protocols TLSv1.2 TLSv1.3;\n")
+	}
+
+	if header := hstsHeader(policy); header != "" {
+		fmt.Fprintf(&b, "add_header Strict-Transport-Security \"%s\" always;\n", header)
+	}
+
+	if policy.OcspStapling {
+		b.WriteString("ssl_stapling on;\nssl_stapling_verify on;\n")
+	} else {
+		b.WriteString("ssl_stapling off;\n")
+	}
+
+	files := map[string]string{
+		tlsCertificateFile: "",
+		tlsPrivateKeyFile:  "",
+	}
+	if policy.CertificatePem != "" && policy.PrivateKeyPem != "" {
+		fmt.Fprintf(&b, "ssl_certificate %s/%s;\n", proxyConfigDir, tlsCertificateFile)
+		fmt.Fprintf(&b, "ssl_certificate_key %s/%s;\n", proxyConfigDir, tlsPrivateKeyFile)
+		files[tlsCertificateFile] = policy.CertificatePem
+		files[tlsPrivateKeyFile] = policy.PrivateKeyPem
+	}
+
+	files[tlsConfigFile] = b.String()
+	return files
+}
+
+// hstsHeader is the Strict-Transport-Security header the policy sends, or
+// empty when it sends none
+func hstsHeader(policy ProxyTls) string {
+	if policy.HstsMaxAgeSeconds <= 0 {
+		return ""
+	}
+	header := fmt.Sprintf("max-age=%d", policy.HstsMaxAgeSeconds)
+	if policy.HstsIncludeSubdomains {
+		header += "; includeSubDomains"
+	}
+	if policy.HstsPreload {
+		header += "; preload"
+	}
+	return header
+}
+
+// probeTls connects to the site's nginx as each of its domains and checks
+// it enforces the policy: it serves a certificate covering the domain, the
+// uploaded one if there is one, refuses TLS 1.2 when the policy requires 1.3,
+// and sends the policy's HSTS header. It returns what failed, or nothing
+func probeTls(ctx context.Context, policy ProxyTls) []string {
+	var uploaded []byte
+	if block, _ := pem.Decode([]byte(policy.CertificatePem)); block != nil {
+		uploaded = block.Bytes
+	}
+
+	var failures []string
+	for _, domain := range policy.Domains {
+		dialer := &tls.Dialer{
+			NetDialer: &net.Dialer{Timeout: tlsProbeTimeout},
+			// The certificate is checked below; the probe only cares that
+			// it's the right one and valid, not who issued it
+			Config: &tls.Config{ServerName: domain, MinVersion: tls.VersionTLS12, InsecureSkipVerify: true},
+		}
+		conn, err := dialer.DialContext(ctx, "tcp", tlsProbeAddr)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: handshake failed: %v", domain, err))
+			continue
+		}
+		state := conn.(*tls.Conn).ConnectionState()
+		conn.Close()
+
+		leaf := state.PeerCertificates[0]
+		now := time.Now()
+		switch {
+		case uploaded != nil && !bytes.Equal(leaf.Raw, uploaded):
+			failures = append(failures, fmt.Sprintf("%s: serves a certificate other than the uploaded one", domain))
+		case leaf.VerifyHostname(domain) != nil:
+			failures = append(failures, fmt.Sprintf("%s: certificate doesn't cover the domain", domain))
+		case now.After(leaf.NotAfter):
+			failures = append(failures, fmt.Sprintf("%s: certificate expired at %s", domain, leaf.NotAfter.UTC().Format(time.RFC3339)))
+		}
+		if policy.OcspStapling && len(state.OCSPResponse) == 0 {
+			// nginx fetches staples lazily, so the first handshakes after a
+			// reload go without one
+			slog.Debug("TLS probe got no OCSP staple", "domain", domain)
+		}
+
+		if policy.MinVersion == tlsVersion13 {
+			dialer.Config = &tls.Config{ServerName: domain, MinVersion: tls.VersionTLS12, MaxVersion: tls.VersionTLS12, InsecureSkipVerify: true}
+			if conn, err := dialer.DialContext(ctx, "tcp", tlsProbeAddr); err == nil {
+				conn.Close()
+				failures = append(failures, fmt.Sprintf("%s: accepted TLS 1.2", domain))
+			}
+		}
+
+		if want := hstsHeader(policy); want != "" {
+			got, err := probeHsts(ctx, domain)
+			switch {
+			case err != nil:
+				failures = append(failures, fmt.Sprintf("%s: HTTPS request failed: %v", domain, err))
+			case got != want:
+				failures = append(failures, fmt.Sprintf("%s: sent Strict-Transport-Security %q, want %q", domain, got, want))
+			}
+		}
+	}
+	return failures
+}
+
+// probeHsts requests the domain's home page from the site's nginx and
+// returns the Strict-Transport-Security header it sent
+func probeHsts(ctx context.Context, domain string) (string, error) {
+	client := &http.Client{
+		Timeout: tlsProbeTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{Timeout: tlsProbeTimeout}).DialContext(ctx, network, tlsProbeAddr)
+			},
+			TLSClientConfig: &tls.Config{ServerName: domain, InsecureSkipVerify: true},
+		},
+		// The header is sent on redirects too, so the first response is enough
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+domain+"/", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	return resp.Header.Get("Strict-Transport-Security"), nil
+}
+
+// probeAndReportTls probes the TLS policy nginx was just configured with and
+// reports the result to the API. A site without domains has nothing to probe
+func (r *Reconciler) probeAndReportTls(ctx context.Context, token string, policy ProxyTls) {
+	if len(policy.Domains) == 0 {
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(tlsProbeSettle):
+	}
+
+	failures := probeTls(ctx, policy)
+	if len(failures) > 0 {
+		slog.Warn("site failed its TLS probe", "site_id", r.siteID, "failures", failures)
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"siteId":  r.siteID,
+		"passed":  len(failures) == 0,
+		"message": strings.Join(failures, "; "),
+	})
+	if err != nil {
+		slog.Error("failed to marshal TLS probe report", "error", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("%s/libops.v1.AdminSiteService/ReportSiteTlsProbe", r.baseURL())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		slog.Error("failed to create TLS probe report", "error", err)
+		return
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		slog.Error("failed to report TLS probe", "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		slog.Error("TLS probe report returned an error", "status", resp.StatusCode, "body", string(body))
+	}
+}
@@ -0,0 +1,223 @@
+package reconciler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// wafModeDetectionOnly reports requests the WAF matches without blocking them
+	wafModeDetectionOnly = "WAF_MODE_DETECTION_ONLY"
+
+	// wafConfigFile is the snippet that turns ModSecurity on for the site
+	wafConfigFile = "waf.conf"
+	// wafRulesFile holds the site's ModSecurity rules; it isn't a *.conf file,
+	// so nginx only reads it through modsecurity_rules_file
+	wafRulesFile = "modsecurity.rules"
+
+	// modSecurityConfig is ModSecurity's recommended base config, shipped
+	// with the site image alongside the OWASP core rule set
+	modSecurityConfig = "/etc/nginx/modsecurity/modsecurity.conf"
+	// crsPath is where the site image installs the OWASP core rule set
+	crsPath = "/usr/share/modsecurity-crs"
+	// wafAuditLog is where ModSecurity logs the requests it matched, one JSON
+	// object a line
+	wafAuditLog = "/var/log/nginx/modsec_audit.json"
+
+	// wafExclusionBaseID is the first ID of the rules rendered for path scoped
+	// exclusions, in the range the core rule set leaves for local rules
+	wafExclusionBaseID = 10000
+	// maxPendingWafBlocks caps the blocks held for the next check-in; the
+	// oldest are dropped when the API can't be reached
+	maxPendingWafBlocks = 1000
+)
+
+// ProxyWaf is the site's WAF
+type ProxyWaf struct {
+	Enabled       bool               `json:"enabled"`
+	Mode          string             `json:"mode"`
+	ParanoiaLevel int                `json:"paranoiaLevel"`
+	Exclusions    []WafRuleExclusion `json:"exclusions"`
+}
+
+// WafRuleExclusion turns a core rule set rule off, everywhere or under a path
+type WafRuleExclusion struct {
+	RuleID     int    `json:"ruleId"`
+	PathPrefix string `json:"pathPrefix"`
+}
+
+// WafBlock is a request the WAF matched, as reported on check-in
+type WafBlock struct {
+	RuleID     int    `json:"rule_id"`
+	Message    string `json:"message"`
+	Method     string `json:"method"`
+	URI        string `json:"uri"`
+	ClientIP   string `json:"client_ip"`
+	Blocked    bool   `json:"blocked"`
+	OccurredAt int64  `json:"occurred_at"`
+}
+
+// renderWaf renders the site's WAF as a server-level nginx snippet and the
+// ModSecurity rules file it loads
+func renderWaf(waf ProxyWaf) map[string]string {
+	header := "# Managed by libops; changes are overwritten on reconciliation\n"
+	files := map[string]string{
+		wafConfigFile: header,
+		wafRulesFile:  header,
+	}
+	if !waf.Enabled {
+		return files
+	}
+
+	files[wafConfigFile] = header + fmt.Sprintf("modsecurity on;\nmodsecurity_rules_file %s/%s;\n", proxyConfigDir, wafRulesFile)
+
+	engine := "On"
+	if waf.Mode == wafModeDetectionOnly {
+		engine = "DetectionOnly"
+	}
+	paranoiaLevel := waf.ParanoiaLevel
+	if paranoiaLevel < 1 || paranoiaLevel > 4 {
+		paranoiaLevel = 1
+	}
+
+	var b strings.Builder
+	b.WriteString(header)
+	fmt.Fprintf(&b, "Include %s\n", modSecurityConfig)
+	fmt.Fprintf(&b, "SecRuleEngine %s\n", engine)
+	b.WriteString("SecAuditEngine RelevantOnly\n")
+	b.WriteString("SecAuditLogParts ABFHZ\n")
+	b.WriteString("SecAuditLogType Serial\n")
+	b.WriteString("SecAuditLogFormat JSON\n")
+	fmt.Fprintf(&b, "SecAuditLog %s\n", wafAuditLog)
+	fmt.Fprintf(&b, "SecAction \"id:900000,phase:1,pass,t:none,nolog,setvar:tx.blocking_paranoia_level=%d\"\n", paranoiaLevel)
+	fmt.Fprintf(&b, "Include %s/crs-setup.conf\n", crsPath)
+
+	// Exclusions under a path remove the rule per request, so they have to
+	// run before the rules they remove
+	var global []string
+	for i, exclusion := range waf.Exclusions {
+		if exclusion.PathPrefix == "" {
+			global = append(global, strconv.Itoa(exclusion.RuleID))
+			continue
+		}
+		fmt.Fprintf(&b, "SecRule REQUEST_FILENAME \"@beginsWith %s\" \"id:%d,phase:1,pass,t:none,nolog,ctl:ruleRemoveById=%d\"\n",
+			exclusion.PathPrefix, wafExclusionBaseID+i, exclusion.RuleID)
+	}
+
+	fmt.Fprintf(&b, "Include %s/rules/*.conf\n", crsPath)
+
+	// Exclusions everywhere remove the rule at startup, so they have to come
+	// after it's defined
+	if len(global) > 0 {
+		fmt.Fprintf(&b, "SecRuleRemoveById %s\n", strings.Join(global, " "))
+	}
+
+	files[wafRulesFile] = b.String()
+	return files
+}
+
+// collectWafBlocks reads what ModSecurity logged since the last call and
+// returns every block not yet reported
+func (r *Reconciler) collectWafBlocks() []WafBlock {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lines, err := readNewLogLines(wafAuditLog, &r.wafLogOffset)
+	if err != nil {
+		slog.Warn("failed to read WAF audit log", "error", err)
+	}
+	for _, line := range lines {
+		block, ok := parseWafAuditEntry(line, r.waf.Mode != wafModeDetectionOnly)
+		if ok {
+			r.wafBlocks = append(r.wafBlocks, block)
+		}
+	}
+	if len(r.wafBlocks) > maxPendingWafBlocks {
+		r.wafBlocks = r.wafBlocks[len(r.wafBlocks)-maxPendingWafBlocks:]
+	}
+
+	return append([]WafBlock(nil), r.wafBlocks...)
+}
+
+// dropWafBlocks forgets the first n pending blocks once the API has them
+func (r *Reconciler) dropWafBlocks(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n > len(r.wafBlocks) {
+		n = len(r.wafBlocks)
+	}
+	r.wafBlocks = r.wafBlocks[n:]
+}
+
+// wafAuditEntry is the part of a ModSecurity JSON audit log entry the
+// controller reports
+type wafAuditEntry struct {
+	Transaction struct {
+		ClientIP  string `json:"client_ip"`
+		TimeStamp string `json:"time_stamp"`
+		Request   struct {
+			Method string `json:"method"`
+			URI    string `json:"uri"`
+		} `json:"request"`
+		Response struct {
+			HTTPCode int `json:"http_code"`
+		} `json:"response"`
+		Messages []struct {
+			Message string `json:"message"`
+			Details struct {
+				RuleID string `json:"ruleId"`
+			} `json:"details"`
+		} `json:"messages"`
+	} `json:"transaction"`
+}
+
+// parseWafAuditEntry turns an audit log entry into a block. A request matches
+// the rule that detected it and the core rule set's anomaly scoring rules, so
+// it's reported under the first rule that isn't scoring
+func parseWafAuditEntry(line []byte, blocking bool) (WafBlock, bool) {
+	var entry wafAuditEntry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return WafBlock{}, false
+	}
+	transaction := entry.Transaction
+	if len(transaction.Messages) == 0 {
+		return WafBlock{}, false
+	}
+
+	message := transaction.Messages[0]
+	for _, candidate := range transaction.Messages {
+		if !isAnomalyScoringRule(candidate.Details.RuleID) {
+			message = candidate
+			break
+		}
+	}
+	ruleID, err := strconv.Atoi(message.Details.RuleID)
+	if err != nil {
+		return WafBlock{}, false
+	}
+
+	occurredAt := time.Now()
+	if t, err := time.ParseInLocation(time.ANSIC, transaction.TimeStamp, time.Local); err == nil {
+		occurredAt = t
+	}
+
+	return WafBlock{
+		RuleID:     ruleID,
+		Message:    message.Message,
+		Method:     transaction.Request.Method,
+		URI:        transaction.Request.URI,
+		ClientIP:   transaction.ClientIP,
+		Blocked:    blocking && transaction.Response.HTTPCode == 403,
+		OccurredAt: occurredAt.Unix(),
+	}, true
+}
+
+// isAnomalyScoringRule reports whether a core rule set rule only adds up the
+// scores of the rules that matched before it
+func isAnomalyScoringRule(ruleID string) bool {
+	return strings.HasPrefix(ruleID, "949") || strings.HasPrefix(ruleID, "959") || strings.HasPrefix(ruleID, "980")
+}
@@ -0,0 +1,373 @@
+// Package selfupdate installs the controller release the API advertises on check-in.
+// A release is only installed when its binary matches the advertised digest and is
+// signed with the release key. The new binary replaces the running one, and the
+// controller exits for systemd to start it; the new build stays on trial until its
+// first successful check-in and is rolled back if it never gets there.
+package selfupdate
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// maxBinarySize caps a release download
+	maxBinarySize = 256 << 20
+	// maxTrialBoots is how many times a new build can start without checking in
+	// before it's rolled back, so a build that crashes on start can't loop forever
+	maxTrialBoots = 3
+	// previousSuffix names the copy of the binary a release replaced
+	previousSuffix = ".previous"
+	// downloadSuffix names a release binary being downloaded
+	downloadSuffix = ".download"
+)
+
+// ErrRolledBack is returned when the running build was rolled back; the
+// controller should exit so the restored build starts
+var ErrRolledBack = errors.New("controller release rolled back")
+
+// Release is the controller release the API advertises on check-in
+type Release struct {
+	Version     string `json:"version"`
+	DownloadURL string `json:"downloadUrl"`
+	SHA256      string `json:"sha256"`
+	Signature   string `json:"signature"`
+}
+
+// state is what the updater keeps between restarts
+type state struct {
+	// Trial is the release installed but not yet confirmed healthy
+	Trial string `json:"trial,omitempty"`
+	// Previous is the version the trial release replaced
+	Previous string `json:"previous,omitempty"`
+	// Boots counts the trial release's starts
+	Boots int `json:"boots,omitempty"`
+	// Failed are releases that were rolled back; they aren't installed again
+	Failed []string `json:"failed,omitempty"`
+}
+
+// Updater installs controller releases over the running binary
+type Updater struct {
+	current    string
+	executable string
+	statePath  string
+	publicKey  ed25519.PublicKey
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	updating bool
+}
+
+// New creates an updater for the running build. Without a public key, releases
+// can't be verified, so none are installed.
+func New(current, executable, statePath string, publicKey ed25519.PublicKey) *Updater {
+	return &Updater{
+		current:    current,
+		executable: executable,
+		statePath:  statePath,
+		publicKey:  publicKey,
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+// ParsePublicKey decodes a base64 ed25519 public key
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release public key: %w", err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid release public key: %d bytes, want %d", len(key), ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// Boot counts a start of a build on trial, and rolls it back once it has
+// started too many times without confirming. It returns ErrRolledBack when it
+// did; the caller should exit.
+func (u *Updater) Boot() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	s, err := u.load()
+	if err != nil {
+		return err
+	}
+	if s.Trial == "" {
+		return nil
+	}
+	if s.Trial != u.current {
+		// A release whose binary reports another version would be installed
+		// again on every check-in
+		slog.Error("controller release reports another version", "release", s.Trial, "version", u.current)
+		return u.rollBack(s)
+	}
+
+	s.Boots++
+	if s.Boots > maxTrialBoots {
+		slog.Error("controller release failed to start", "version", u.current, "boots", s.Boots-1)
+		return u.rollBack(s)
+	}
+	return u.save(s)
+}
+
+// OnTrial reports whether the running build is a release not yet confirmed healthy
+func (u *Updater) OnTrial() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	s, err := u.load()
+	return err == nil && s.Trial != "" && s.Trial == u.current
+}
+
+// Confirm keeps the running build once it's healthy, removing the binary it replaced
+func (u *Updater) Confirm() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	s, err := u.load()
+	if err != nil {
+		return err
+	}
+	if s.Trial == "" || s.Trial != u.current {
+		return nil
+	}
+
+	slog.Info("controller release confirmed", "version", u.current, "previous_version", s.Previous)
+	s.Trial, s.Previous, s.Boots = "", "", 0
+	if err := u.save(s); err != nil {
+		return err
+	}
+	if err := os.Remove(u.executable + previousSuffix); err != nil && !os.IsNotExist(err) {
+		slog.Warn("failed to remove previous controller binary", "error", err)
+	}
+	return nil
+}
+
+// RollBack restores the binary the running build replaced and returns
+// ErrRolledBack; the caller should exit
+func (u *Updater) RollBack() error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	s, err := u.load()
+	if err != nil {
+		return err
+	}
+	if s.Trial == "" || s.Trial != u.current {
+		return nil
+	}
+	return u.rollBack(s)
+}
+
+func (u *Updater) rollBack(s state) error {
+	// Without the previous binary there's nothing to restore, but the release
+	// is still marked failed so it isn't installed again
+	if err := os.Rename(u.executable+previousSuffix, u.executable); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to restore previous controller binary: %w", err)
+	}
+	slog.Warn("rolled back controller release", "version", u.current, "restored_version", s.Previous)
+
+	if !slices.Contains(s.Failed, s.Trial) {
+		s.Failed = append(s.Failed, s.Trial)
+	}
+	s.Trial, s.Previous, s.Boots = "", "", 0
+	if err := u.save(s); err != nil {
+		return err
+	}
+	return ErrRolledBack
+}
+
+// Apply installs a release over the running binary. It reports whether it did,
+// in which case the caller should exit so the new build starts. Releases that
+// are already running, were rolled back before, or can't be verified aren't
+// installed.
+func (u *Updater) Apply(ctx context.Context, release Release) (bool, error) {
+	if release.Version == "" || release.Version == u.current {
+		return false, nil
+	}
+	if u.publicKey == nil {
+		slog.Warn("no release public key configured, not updating controller", "version", release.Version)
+		return false, nil
+	}
+
+	u.mu.Lock()
+	if u.updating {
+		u.mu.Unlock()
+		return false, nil
+	}
+	s, err := u.load()
+	if err != nil {
+		u.mu.Unlock()
+		return false, err
+	}
+	if slices.Contains(s.Failed, release.Version) || s.Trial == u.current {
+		// Don't stack an update on a build still on trial
+		u.mu.Unlock()
+		return false, nil
+	}
+	u.updating = true
+	u.mu.Unlock()
+	defer func() {
+		u.mu.Lock()
+		u.updating = false
+		u.mu.Unlock()
+	}()
+
+	slog.Info("updating controller", "version", release.Version, "current_version", u.current)
+	binary, err := u.download(ctx, release.DownloadURL)
+	if err != nil {
+		return false, err
+	}
+	if err := Verify(binary, release, u.publicKey); err != nil {
+		// Releases don't change once published, so don't download it again
+		u.mu.Lock()
+		s.Failed = append(s.Failed, release.Version)
+		saveErr := u.save(s)
+		u.mu.Unlock()
+		if saveErr != nil {
+			slog.Error("failed to record unverified controller release", "error", saveErr)
+		}
+		return false, err
+	}
+
+	if err := u.install(binary); err != nil {
+		return false, err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	s.Trial, s.Previous, s.Boots = release.Version, u.current, 0
+	if err := u.save(s); err != nil {
+		return false, err
+	}
+	slog.Info("installed controller release", "version", release.Version)
+	return true, nil
+}
+
+// Verify checks a release binary against its digest and signature
+func Verify(binary []byte, release Release, publicKey ed25519.PublicKey) error {
+	sum := sha256.Sum256(binary)
+	if hex.EncodeToString(sum[:]) != release.SHA256 {
+		return fmt.Errorf("controller release %s doesn't match its digest", release.Version)
+	}
+	signature, err := base64.StdEncoding.DecodeString(release.Signature)
+	if err != nil {
+		return fmt.Errorf("controller release %s has an invalid signature: %w", release.Version, err)
+	}
+	if !ed25519.Verify(publicKey, binary, signature) {
+		return fmt.Errorf("controller release %s isn't signed with the release key", release.Version)
+	}
+	return nil
+}
+
+// download fetches a release binary
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download controller release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("controller release download returned status %d", resp.StatusCode)
+	}
+	binary, err := io.ReadAll(io.LimitReader(resp.Body, maxBinarySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download controller release: %w", err)
+	}
+	if len(binary) > maxBinarySize {
+		return nil, fmt.Errorf("controller release is larger than %d bytes", maxBinarySize)
+	}
+	return binary, nil
+}
+
+// install swaps the release binary in, keeping the running one to roll back to.
+// Renames within the binary's directory are atomic, so systemd always finds a
+// complete binary to start.
+func (u *Updater) install(binary []byte) error {
+	download := u.executable + downloadSuffix
+	f, err := os.OpenFile(download, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to write controller release: %w", err)
+	}
+	if _, err := io.Copy(f, bytes.NewReader(binary)); err != nil {
+		f.Close()
+		os.Remove(download)
+		return fmt.Errorf("failed to write controller release: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(download)
+		return fmt.Errorf("failed to write controller release: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(download)
+		return fmt.Errorf("failed to write controller release: %w", err)
+	}
+
+	if err := os.Rename(u.executable, u.executable+previousSuffix); err != nil {
+		os.Remove(download)
+		return fmt.Errorf("failed to keep running controller binary: %w", err)
+	}
+	if err := os.Rename(download, u.executable); err != nil {
+		// Put the running binary back so the next start finds one
+		if restoreErr := os.Rename(u.executable+previousSuffix, u.executable); restoreErr != nil {
+			slog.Error("failed to restore controller binary", "error", restoreErr)
+		}
+		return fmt.Errorf("failed to install controller release: %w", err)
+	}
+	return nil
+}
+
+func (u *Updater) load() (state, error) {
+	var s state
+	data, err := os.ReadFile(u.statePath)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, fmt.Errorf("failed to read update state: %w", err)
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("failed to decode update state: %w", err)
+	}
+	return s, nil
+}
+
+func (u *Updater) save(s state) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode update state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(u.statePath), 0o755); err != nil {
+		return fmt.Errorf("failed to write update state: %w", err)
+	}
+	tmp := u.statePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write update state: %w", err)
+	}
+	if err := os.Rename(tmp, u.statePath); err != nil {
+		return fmt.Errorf("failed to write update state: %w", err)
+	}
+	return nil
+}
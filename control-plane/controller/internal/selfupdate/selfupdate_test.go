@@ -0,0 +1,146 @@
+package selfupdate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newRelease signs a binary served by a test server
+func newRelease(t *testing.T, version string, binary []byte, key ed25519.PrivateKey) Release {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary)
+	}))
+	t.Cleanup(server.Close)
+
+	sum := sha256.Sum256(binary)
+	return Release{
+		Version:     version,
+		DownloadURL: server.URL,
+		SHA256:      hex.EncodeToString(sum[:]),
+		Signature:   base64.StdEncoding.EncodeToString(ed25519.Sign(key, binary)),
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	return string(data)
+}
+
+func TestApplyConfirm(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(nil)
+	dir := t.TempDir()
+	executable := filepath.Join(dir, "libops-controller")
+	statePath := filepath.Join(dir, "state", "update.json")
+	os.WriteFile(executable, []byte("v1"), 0o755)
+
+	release := newRelease(t, "v2", []byte("v2"), private)
+	updated, err := New("v1", executable, statePath, public).Apply(context.Background(), release)
+	if err != nil || !updated {
+		t.Fatalf("Apply() = %v, %v, want installed", updated, err)
+	}
+	if got := readFile(t, executable); got != "v2" {
+		t.Errorf("installed binary = %q, want v2", got)
+	}
+	if got := readFile(t, executable+previousSuffix); got != "v1" {
+		t.Errorf("previous binary = %q, want v1", got)
+	}
+
+	// The new build starts on trial and is kept once confirmed
+	next := New("v2", executable, statePath, public)
+	if err := next.Boot(); err != nil {
+		t.Fatalf("Boot() = %v", err)
+	}
+	if !next.OnTrial() {
+		t.Fatal("new build isn't on trial")
+	}
+	if updated, _ := next.Apply(context.Background(), newRelease(t, "v3", []byte("v3"), private)); updated {
+		t.Error("installed a release over a build on trial")
+	}
+	if err := next.Confirm(); err != nil {
+		t.Fatalf("Confirm() = %v", err)
+	}
+	if next.OnTrial() {
+		t.Error("confirmed build is still on trial")
+	}
+	if _, err := os.Stat(executable + previousSuffix); !os.IsNotExist(err) {
+		t.Error("previous binary wasn't removed")
+	}
+}
+
+func TestRollBack(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(nil)
+	dir := t.TempDir()
+	executable := filepath.Join(dir, "libops-controller")
+	statePath := filepath.Join(dir, "update.json")
+	os.WriteFile(executable, []byte("v1"), 0o755)
+
+	release := newRelease(t, "v2", []byte("v2"), private)
+	if _, err := New("v1", executable, statePath, public).Apply(context.Background(), release); err != nil {
+		t.Fatalf("Apply() = %v", err)
+	}
+
+	// A build that keeps crashing is rolled back on the start after the last trial boot
+	next := New("v2", executable, statePath, public)
+	for i := 0; i < maxTrialBoots; i++ {
+		if err := next.Boot(); err != nil {
+			t.Fatalf("Boot() #%d = %v", i+1, err)
+		}
+	}
+	if err := next.Boot(); !errors.Is(err, ErrRolledBack) {
+		t.Fatalf("Boot() past the trial = %v, want ErrRolledBack", err)
+	}
+	if got := readFile(t, executable); got != "v1" {
+		t.Errorf("binary after rollback = %q, want v1", got)
+	}
+
+	// The restored build doesn't install the failed release again
+	restored := New("v1", executable, statePath, public)
+	if err := restored.Boot(); err != nil {
+		t.Fatalf("Boot() = %v", err)
+	}
+	if updated, err := restored.Apply(context.Background(), release); err != nil || updated {
+		t.Errorf("Apply(failed release) = %v, %v, want skipped", updated, err)
+	}
+}
+
+func TestApplyUnverified(t *testing.T) {
+	public, private, _ := ed25519.GenerateKey(nil)
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+	dir := t.TempDir()
+	executable := filepath.Join(dir, "libops-controller")
+	os.WriteFile(executable, []byte("v1"), 0o755)
+	updater := New("v1", executable, filepath.Join(dir, "update.json"), public)
+
+	tampered := newRelease(t, "v2", []byte("v2"), private)
+	tampered.SHA256 = hex.EncodeToString(make([]byte, 32))
+	unsigned := newRelease(t, "v3", []byte("v3"), otherKey)
+
+	for _, release := range []Release{tampered, unsigned} {
+		if updated, err := updater.Apply(context.Background(), release); err == nil || updated {
+			t.Errorf("Apply(%s) = %v, %v, want an error", release.Version, updated, err)
+		}
+	}
+	if got := readFile(t, executable); got != "v1" {
+		t.Errorf("binary = %q, want v1 untouched", got)
+	}
+
+	// Without a key nothing can be verified, so nothing is installed
+	valid := newRelease(t, "v4", []byte("v4"), private)
+	if updated, err := New("v1", executable, filepath.Join(dir, "update.json"), nil).Apply(context.Background(), valid); err != nil || updated {
+		t.Errorf("Apply() without a key = %v, %v, want skipped", updated, err)
+	}
+}
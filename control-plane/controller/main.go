@@ -4,29 +4,194 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/libops/controller/internal/clientcert"
+	"github.com/libops/controller/internal/idempotency"
 	"github.com/libops/controller/internal/reconciler"
+	"github.com/libops/controller/internal/selfupdate"
 	"golang.org/x/time/rate"
 )
 
+// version is the controller release, and releasePublicKey the base64 ed25519 key
+// releases are signed with. Both are set at build time with -ldflags -X.
+var (
+	version          = "dev"
+	releasePublicKey = ""
+)
+
+const (
+	// updateStatePath is where the updater tracks a release on trial between restarts
+	updateStatePath = "/var/lib/libops/controller-update.json"
+	// trialTimeout is how long a new release has to check in before it's rolled back
+	trialTimeout = 10 * time.Minute
+	// clientKeyPath and clientCertPath hold the key and certificate presented
+	// to the API over mutual TLS
+	clientKeyPath  = "/var/lib/libops/controller-client.key"
+	clientCertPath = "/var/lib/libops/controller-client.crt"
+)
+
+// idempotencyWindow is how long a reconciled request's idempotency key is remembered.
+// It outlasts Pub/Sub's redelivery of an unacknowledged message and an event router restart.
+const idempotencyWindow = 15 * time.Minute
+
+// Settings are the controller settings the remote config can change
+type Settings struct {
+	RateLimitRPS      int
+	RateLimitBurst    int
+	ReconcileInterval time.Duration
+	CheckInInterval   time.Duration
+	Nftables          bool
+}
+
+// withRemoteConfig returns the settings with the remote config's overrides applied.
+// Settings the remote config leaves unset keep their boot value
+func (s Settings) withRemoteConfig(config reconciler.RemoteConfig) Settings {
+	if config.RateLimitRPS != nil {
+		s.RateLimitRPS = *config.RateLimitRPS
+	}
+	if config.RateLimitBurst != nil {
+		s.RateLimitBurst = *config.RateLimitBurst
+	}
+	if config.ReconcileIntervalMinutes != nil {
+		s.ReconcileInterval = time.Duration(*config.ReconcileIntervalMinutes) * time.Minute
+	}
+	if config.CheckInIntervalSeconds != nil {
+		s.CheckInInterval = time.Duration(*config.CheckInIntervalSeconds) * time.Second
+	}
+	s.Nftables = config.FeatureFlags[reconciler.FeatureNftables]
+	return s
+}
+
 // Controller handles HTTP requests and coordinates reconciliation
 type Controller struct {
 	reconciler *reconciler.Reconciler
 	limiter    *rate.Limiter
+	handled    *idempotency.Window
+
+	// boot are the settings from the environment; current adds the remote config
+	mu      sync.Mutex
+	boot    Settings
+	current Settings
+
+	reconcileTicker *time.Ticker
+	checkInTicker   *time.Ticker
+
+	updater *selfupdate.Updater
+	// restart is signaled to exit, so systemd starts the installed or restored release
+	restart chan struct{}
 }
 
 // NewController creates a new controller
-func NewController(r *reconciler.Reconciler, rps int, burst int) *Controller {
+func NewController(r *reconciler.Reconciler, settings Settings, updater *selfupdate.Updater) *Controller {
 	return &Controller{
-		reconciler: r,
-		limiter:    rate.NewLimiter(rate.Limit(rps), burst),
+		reconciler:      r,
+		limiter:         rate.NewLimiter(rate.Limit(settings.RateLimitRPS), settings.RateLimitBurst),
+		handled:         idempotency.NewWindow(idempotencyWindow),
+		boot:            settings,
+		current:         settings,
+		reconcileTicker: time.NewTicker(settings.ReconcileInterval),
+		checkInTicker:   time.NewTicker(settings.CheckInInterval),
+		updater:         updater,
+		restart:         make(chan struct{}, 1),
+	}
+}
+
+// applyRelease installs the controller release advertised on check-in and
+// restarts into it. The download runs in the background so check-ins go on.
+func (c *Controller) applyRelease(release selfupdate.Release) {
+	if release.Version == version {
+		return
+	}
+	go func() {
+		updated, err := c.updater.Apply(context.Background(), release)
+		if err != nil {
+			slog.Error("controller update failed", "version", release.Version, "error", err)
+			return
+		}
+		if updated {
+			c.requestRestart()
+		}
+	}()
+}
+
+// watchTrial rolls a release on trial back unless it checks in before the timeout
+func (c *Controller) watchTrial(ctx context.Context) {
+	if !c.updater.OnTrial() {
+		return
+	}
+	slog.Info("controller release on trial", "version", version, "timeout", trialTimeout.String())
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(trialTimeout):
+	}
+	if !c.updater.OnTrial() {
+		return
+	}
+	slog.Error("controller release didn't check in, rolling back", "version", version)
+	if err := c.updater.RollBack(); errors.Is(err, selfupdate.ErrRolledBack) {
+		c.requestRestart()
+	} else if err != nil {
+		slog.Error("controller rollback failed", "error", err)
+	}
+}
+
+// requestRestart asks main to shut down so systemd starts the controller again
+func (c *Controller) requestRestart() {
+	select {
+	case c.restart <- struct{}{}:
+	default:
+	}
+}
+
+// applyRemoteConfig applies the remote config returned on check-in without a restart
+func (c *Controller) applyRemoteConfig(config reconciler.RemoteConfig) {
+	c.mu.Lock()
+	previous := c.current
+	next := c.boot.withRemoteConfig(config)
+	c.current = next
+	c.mu.Unlock()
+
+	if next.RateLimitRPS != previous.RateLimitRPS {
+		c.limiter.SetLimit(rate.Limit(next.RateLimitRPS))
+	}
+	if next.RateLimitBurst != previous.RateLimitBurst {
+		c.limiter.SetBurst(next.RateLimitBurst)
+	}
+	if next.ReconcileInterval != previous.ReconcileInterval {
+		c.reconcileTicker.Reset(next.ReconcileInterval)
+	}
+	if next.CheckInInterval != previous.CheckInInterval {
+		c.checkInTicker.Reset(next.CheckInInterval)
+	}
+
+	slog.Info("controller config applied",
+		"version", config.Version,
+		"rate_limit_rps", next.RateLimitRPS,
+		"rate_limit_burst", next.RateLimitBurst,
+		"reconcile_interval", next.ReconcileInterval.String(),
+		"checkin_interval", next.CheckInInterval.String(),
+		"nftables", next.Nftables)
+
+	// Move the firewall rules to the backend the config selects
+	if next.Nftables != previous.Nftables {
+		go func() {
+			if err := c.reconciler.ReconcileFirewall(context.Background()); err != nil {
+				slog.Error("firewall reconciliation after backend change failed", "error", err)
+			}
+		}()
 	}
 }
 
@@ -41,6 +206,46 @@ func (c *Controller) rateLimitMiddleware(next http.HandlerFunc) http.HandlerFunc
 	}
 }
 
+// idempotencyMiddleware skips reconciliation requests whose Idempotency-Key already succeeded
+// and asks for a retry while one with the same key is running. Requests without a key,
+// like periodic or manual ones, always run.
+func (c *Controller) idempotencyMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next(w, r)
+			return
+		}
+
+		switch c.handled.Start(key) {
+		case idempotency.Duplicate:
+			slog.Info("skipping duplicate reconciliation request", "path", r.URL.Path, "idempotency_key", key)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, "Already reconciled\n")
+			return
+		case idempotency.InProgress:
+			slog.Info("reconciliation request already in progress", "path", r.URL.Path, "idempotency_key", key)
+			http.Error(w, "Reconciliation already in progress", http.StatusConflict)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next(recorder, r)
+		c.handled.Done(key, recorder.status == http.StatusOK)
+	}
+}
+
+// statusRecorder captures the status code a handler responds with
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
 // handleSSHKeysReconcile handles SSH key reconciliation requests
 func (c *Controller) handleSSHKeysReconcile(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -152,12 +357,12 @@ func (c *Controller) handleHealth(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, "OK\n")
 }
 
-// startPeriodicReconciliation runs full reconciliation every 12 hours
+// startPeriodicReconciliation runs full reconciliation every reconcile interval
 func (c *Controller) startPeriodicReconciliation(ctx context.Context) {
-	ticker := time.NewTicker(12 * time.Hour)
+	ticker := c.reconcileTicker
 	defer ticker.Stop()
 
-	slog.Info("starting periodic reconciliation (every 12 hours)")
+	slog.Info("starting periodic reconciliation", "interval", c.boot.ReconcileInterval.String())
 
 	// Run once immediately
 	if err := c.reconciler.ReconcileAll(ctx); err != nil {
@@ -178,16 +383,26 @@ func (c *Controller) startPeriodicReconciliation(ctx context.Context) {
 	}
 }
 
-// startCheckInTask runs check-in every 60 seconds
+// confirmRelease keeps a release on trial once it has checked in, which shows
+// it runs and reaches the API
+func (c *Controller) confirmRelease() {
+	if err := c.updater.Confirm(); err != nil {
+		slog.Error("failed to confirm controller release", "error", err)
+	}
+}
+
+// startCheckInTask runs check-in every check-in interval
 func (c *Controller) startCheckInTask(ctx context.Context) {
-	ticker := time.NewTicker(60 * time.Second)
+	ticker := c.checkInTicker
 	defer ticker.Stop()
 
-	slog.Info("starting check-in task (every 60 seconds)")
+	slog.Info("starting check-in task", "interval", c.boot.CheckInInterval.String())
 
 	// Run once immediately
 	if err := c.reconciler.CheckIn(ctx); err != nil {
 		slog.Error("initial check-in failed", "error", err)
+	} else {
+		c.confirmRelease()
 	}
 
 	for {
@@ -198,6 +413,8 @@ func (c *Controller) startCheckInTask(ctx context.Context) {
 		case <-ticker.C:
 			if err := c.reconciler.CheckIn(ctx); err != nil {
 				slog.Error("check-in failed", "error", err)
+			} else {
+				c.confirmRelease()
 			}
 		}
 	}
@@ -213,8 +430,11 @@ func main() {
 	slog.Info("starting VM controller service")
 
 	// Get configuration from environment
+	// An explicit URL wins; otherwise the site's organization endpoint is
+	// discovered from the public API once the reconciler exists
 	apiURL := os.Getenv("LIBOPS_API_URL")
-	if apiURL == "" {
+	discoverAPIURL := apiURL == ""
+	if discoverAPIURL {
 		apiURL = "https://api.libops.io"
 	}
 
@@ -229,30 +449,76 @@ func main() {
 		port = "8080"
 	}
 
-	// Rate limiting configuration
+	// Rate limiting and scheduling configuration. The API's remote config
+	// can override these on check-in
 	rps := 10
 	burst := 5
+	reconcileMinutes := 12 * 60
+	checkInSeconds := 60
 	if rpsEnv := os.Getenv("RATE_LIMIT_RPS"); rpsEnv != "" {
 		fmt.Sscanf(rpsEnv, "%d", &rps)
 	}
 	if burstEnv := os.Getenv("RATE_LIMIT_BURST"); burstEnv != "" {
 		fmt.Sscanf(burstEnv, "%d", &burst)
 	}
+	if reconcileEnv := os.Getenv("RECONCILE_INTERVAL_MINUTES"); reconcileEnv != "" {
+		fmt.Sscanf(reconcileEnv, "%d", &reconcileMinutes)
+	}
+	if checkInEnv := os.Getenv("CHECKIN_INTERVAL_SECONDS"); checkInEnv != "" {
+		fmt.Sscanf(checkInEnv, "%d", &checkInSeconds)
+	}
+	settings := Settings{
+		RateLimitRPS:      rps,
+		RateLimitBurst:    burst,
+		ReconcileInterval: time.Duration(max(reconcileMinutes, 1)) * time.Minute,
+		CheckInInterval:   time.Duration(max(checkInSeconds, 1)) * time.Second,
+	}
+
+	// A release that keeps failing to start is rolled back before it does anything
+	updater := newUpdater()
+	if err := updater.Boot(); errors.Is(err, selfupdate.ErrRolledBack) {
+		os.Exit(1)
+	} else if err != nil {
+		slog.Error("failed to check controller release", "error", err)
+	}
 
 	// Initialize reconciler
 	rec := reconciler.NewReconciler(apiURL, siteID)
+	rec.SetControllerPort(port)
+	rec.SetControllerVersion(version)
+	clientCert := clientcert.New(clientKeyPath, clientCertPath)
+	if err := clientCert.Load(); err != nil {
+		// A new certificate is requested on check-in
+		slog.Warn("failed to load client certificate", "error", err)
+	}
+	rec.SetClientCertStore(clientCert)
+	if discoverAPIURL {
+		// A discovered URL also follows the site to the API region serving it
+		rec.FollowAPIRegion()
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		rec.ResolveAPIURL(ctx)
+		cancel()
+	}
 
 	// Initialize controller
-	controller := NewController(rec, rps, burst)
+	controller := NewController(rec, settings, updater)
+	rec.OnRemoteConfig(controller.applyRemoteConfig)
+	rec.OnControllerRelease(controller.applyRelease)
 
 	// Setup HTTP server
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", controller.handleHealth)
-	mux.HandleFunc("/reconcile/ssh-keys", controller.rateLimitMiddleware(controller.handleSSHKeysReconcile))
-	mux.HandleFunc("/reconcile/secrets", controller.rateLimitMiddleware(controller.handleSecretsReconcile))
-	mux.HandleFunc("/reconcile/firewall", controller.rateLimitMiddleware(controller.handleFirewallReconcile))
-	mux.HandleFunc("/reconcile/general", controller.rateLimitMiddleware(controller.handleGeneralReconcile))
-	mux.HandleFunc("/reconcile/deployment", controller.rateLimitMiddleware(controller.handleDeployment))
+	mux.HandleFunc("/reconcile/ssh-keys", controller.rateLimitMiddleware(controller.idempotencyMiddleware(controller.handleSSHKeysReconcile)))
+	mux.HandleFunc("/reconcile/secrets", controller.rateLimitMiddleware(controller.idempotencyMiddleware(controller.handleSecretsReconcile)))
+	mux.HandleFunc("/reconcile/firewall", controller.rateLimitMiddleware(controller.idempotencyMiddleware(controller.handleFirewallReconcile)))
+	mux.HandleFunc("/reconcile/general", controller.rateLimitMiddleware(controller.idempotencyMiddleware(controller.handleGeneralReconcile)))
+	mux.HandleFunc("/reconcile/deployment", controller.rateLimitMiddleware(controller.idempotencyMiddleware(controller.handleDeployment)))
+
+	// nginx asks these on every request to a members only site, so they
+	// aren't rate limited
+	mux.HandleFunc("GET /access/check", rec.HandleAccessCheck)
+	mux.HandleFunc("GET /access/login", rec.HandleAccessLogin)
+	mux.HandleFunc("GET /access/callback", rec.HandleAccessCallback)
 
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%s", port),
@@ -269,6 +535,7 @@ func main() {
 	// Start background tasks
 	go controller.startPeriodicReconciliation(ctx)
 	go controller.startCheckInTask(ctx)
+	go controller.watchTrial(ctx)
 
 	// Start server in goroutine
 	go func() {
@@ -276,7 +543,8 @@ func main() {
 			"port", port,
 			"rate_limit_rps", rps,
 			"rate_limit_burst", burst,
-			"site_id", siteID)
+			"site_id", siteID,
+			"version", version)
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			slog.Error("server error", "error", err)
@@ -284,12 +552,15 @@ func main() {
 		}
 	}()
 
-	// Wait for shutdown signal
+	// Wait for shutdown signal, or a restart into another release
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	slog.Info("shutting down gracefully")
+	select {
+	case <-quit:
+		slog.Info("shutting down gracefully")
+	case <-controller.restart:
+		slog.Info("restarting into another controller release")
+	}
 
 	// Cancel background tasks
 	cancel()
@@ -304,3 +575,29 @@ func main() {
 
 	slog.Info("server stopped")
 }
+
+// newUpdater creates the updater for the running binary. The release key from
+// CONTROLLER_RELEASE_PUBLIC_KEY overrides the one built in; without either,
+// releases can't be verified and the controller doesn't update itself.
+func newUpdater() *selfupdate.Updater {
+	executable, err := os.Executable()
+	if err == nil {
+		executable, err = filepath.EvalSymlinks(executable)
+	}
+	if err != nil {
+		slog.Error("failed to locate controller binary", "error", err)
+	}
+
+	encodedKey := releasePublicKey
+	if keyEnv := os.Getenv("CONTROLLER_RELEASE_PUBLIC_KEY"); keyEnv != "" {
+		encodedKey = keyEnv
+	}
+	var publicKey ed25519.PublicKey
+	if encodedKey != "" && executable != "" {
+		publicKey, err = selfupdate.ParsePublicKey(encodedKey)
+		if err != nil {
+			slog.Error("controller updates disabled", "error", err)
+		}
+	}
+	return selfupdate.New(version, executable, updateStatePath, publicKey)
+}
@@ -0,0 +1,252 @@
+// Package alerts delivers organization alerts to Slack and Microsoft Teams notification channels.
+// PagerDuty and Opsgenie channels are paged by the API directly.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/libops/control-plane/internal/database"
+	"github.com/libops/control-plane/internal/workflows"
+)
+
+// Category is an alert category a notification channel can subscribe to.
+// Values match the names the API stores in notification_channels.categories.
+type Category string
+
+const (
+	CategoryDeployments            Category = "deployments"
+	CategoryReconciliationFailures Category = "reconciliation_failures"
+	CategoryBilling                Category = "billing"
+	CategorySecurity               Category = "security"
+	CategoryDowntime               Category = "downtime"
+)
+
+// Event types with dedicated alert text
+const (
+	eventTypeDeploymentSucceeded  = "io.libops.deployment.succeeded.v1"
+	eventTypeDeploymentFailed     = "io.libops.deployment.failed.v1"
+	eventTypeReconciliationFailed = "io.libops.reconciliation.failed.v1"
+	eventTypePaymentFailed        = "io.libops.billing.payment_failed.v1"
+	eventTypeBillingStateChanged  = "io.libops.billing.state_changed.v1"
+	eventTypeChannelTest          = "io.libops.notification_channel.test.v1"
+	eventTypeIncidentOpened       = "io.libops.incident.opened.v1"
+	eventTypeIncidentResolved     = "io.libops.incident.resolved.v1"
+)
+
+// maxErrorLength bounds error text in alerts and in a channel's recorded last error
+const maxErrorLength = 1000
+
+// Store is the database access the dispatcher needs; *database.Querier implements it
+type Store interface {
+	GetNotificationChannelsForCategory(ctx context.Context, orgID int64, category string) ([]database.NotificationChannel, error)
+	GetNotificationChannel(ctx context.Context, orgID int64, publicID string) (*database.NotificationChannel, error)
+	RecordNotificationChannelDelivery(ctx context.Context, channelID int64, deliveryError string) error
+	GetAlertResource(ctx context.Context, orgID int64, projectID, siteID *int64) (*database.AlertResource, error)
+	GetReconciliationError(ctx context.Context, runID string) (string, error)
+}
+
+// Compile-time check.
+var _ Store = (*database.Querier)(nil)
+
+// Dispatcher turns events into alerts and posts them to the organization's subscribed channels
+type Dispatcher struct {
+	store       Store
+	client      *http.Client
+	baseURL     string // Dashboard URL alerts link to, e.g. https://api.libops.io
+	slackAPIURL string
+}
+
+// NewDispatcher creates a dispatcher whose alerts link to the dashboard at baseURL
+func NewDispatcher(store Store, baseURL string) *Dispatcher {
+	return &Dispatcher{
+		store:       store,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		slackAPIURL: "https://slack.com/api/chat.postMessage",
+	}
+}
+
+// CategoryFor returns the alert category of an event type, if it has one
+func CategoryFor(eventType string) (Category, bool) {
+	switch {
+	case eventType == eventTypeDeploymentSucceeded || eventType == eventTypeDeploymentFailed:
+		return CategoryDeployments, true
+	case eventType == eventTypeReconciliationFailed:
+		return CategoryReconciliationFailures, true
+	case eventType == eventTypeIncidentOpened || eventType == eventTypeIncidentResolved:
+		return CategoryDowntime, true
+	case strings.HasPrefix(eventType, "io.libops.billing."):
+		return CategoryBilling, true
+	case securityObject(eventType) != "":
+		return CategorySecurity, true
+	default:
+		return "", false
+	}
+}
+
+// Dispatch delivers an event to every enabled channel of its organization subscribed to the
+// event's category. Channel test events go only to the channel named by the event subject.
+// Delivery failures are recorded on the channel rather than returned.
+func (d *Dispatcher) Dispatch(ctx context.Context, event workflows.Event) error {
+	var channels []database.NotificationChannel
+	if event.EventType == eventTypeChannelTest {
+		channel, err := d.store.GetNotificationChannel(ctx, event.OrganizationID, event.EventSubject)
+		if err != nil {
+			return err
+		}
+		channels = append(channels, *channel)
+	} else {
+		category, ok := CategoryFor(event.EventType)
+		if !ok {
+			return nil
+		}
+		var err error
+		channels, err = d.store.GetNotificationChannelsForCategory(ctx, event.OrganizationID, string(category))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(channels) == 0 {
+		return nil
+	}
+
+	alert, err := d.buildAlert(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		deliveryError := ""
+		if err := d.send(ctx, channel, alert); err != nil {
+			slog.Warn("Failed to deliver alert",
+				"event_id", event.EventID,
+				"channel_id", channel.PublicID,
+				"kind", channel.Kind,
+				"error", err)
+			deliveryError = truncate(err.Error(), maxErrorLength)
+		}
+		if err := d.store.RecordNotificationChannelDelivery(ctx, channel.ID, deliveryError); err != nil {
+			slog.Error("Failed to record alert delivery", "channel_id", channel.PublicID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// buildAlert describes an event for people, naming the most specific resource it is about
+func (d *Dispatcher) buildAlert(ctx context.Context, event workflows.Event) (Alert, error) {
+	resource, err := d.store.GetAlertResource(ctx, event.OrganizationID, event.ProjectID, event.SiteID)
+	if err != nil {
+		return Alert{}, err
+	}
+
+	alert := Alert{
+		Context:  "Organization: " + resource.OrgName,
+		Severity: SeverityInfo,
+	}
+	name, path := resource.OrgName, "/organizations/"+resource.OrgPublicID
+	switch {
+	case resource.SitePublicID != "":
+		name, path = resource.SiteName, "/sites/"+resource.SitePublicID
+		alert.Context += " · Project: " + resource.ProjectName
+	case resource.ProjectPublicID != "":
+		name, path = resource.ProjectName, "/projects/"+resource.ProjectPublicID
+	}
+	if d.baseURL != "" {
+		alert.Link = d.baseURL + path
+	}
+
+	switch event.EventType {
+	case eventTypeDeploymentSucceeded:
+		alert.Title = fmt.Sprintf("Deployment of %s succeeded", name)
+		alert.Severity = SeveritySuccess
+	case eventTypeDeploymentFailed:
+		alert.Title = fmt.Sprintf("Deployment of %s failed", name)
+		alert.Text = d.runError(ctx, event.EventSubject)
+		alert.Severity = SeverityError
+	case eventTypeReconciliationFailed:
+		alert.Title = fmt.Sprintf("Reconciliation of %s failed", name)
+		alert.Text = d.runError(ctx, event.EventSubject)
+		alert.Severity = SeverityError
+	case eventTypePaymentFailed:
+		alert.Title = fmt.Sprintf("Payment failed for %s", resource.OrgName)
+		alert.Text = "We could not collect the latest invoice. Update the payment method to keep sites running."
+		alert.Severity = SeverityWarning
+	case eventTypeBillingStateChanged:
+		alert.Title = fmt.Sprintf("Billing status changed for %s", resource.OrgName)
+		alert.Text = "Review the organization's billing page for details."
+		alert.Severity = SeverityWarning
+	case eventTypeIncidentOpened:
+		alert.Title = fmt.Sprintf("%s is down", name)
+		alert.Text = "The site's controller has stopped checking in."
+		alert.Severity = SeverityError
+	case eventTypeIncidentResolved:
+		alert.Title = fmt.Sprintf("%s is back up", name)
+		alert.Severity = SeveritySuccess
+	case eventTypeChannelTest:
+		alert.Title = "Test alert from LibOps"
+		alert.Text = fmt.Sprintf("This channel is set up to receive alerts for %s.", resource.OrgName)
+	default:
+		alert.Title = fmt.Sprintf("%s %s on %s", securityObject(event.EventType), securityAction(event.EventType), name)
+	}
+
+	return alert, nil
+}
+
+// runError returns the error a reconciliation run failed with; the event subject is the run ID
+func (d *Dispatcher) runError(ctx context.Context, runID string) string {
+	if runID == "" {
+		return ""
+	}
+	message, err := d.store.GetReconciliationError(ctx, runID)
+	if err != nil {
+		slog.Warn("Failed to load reconciliation error for alert", "run_id", runID, "error", err)
+		return ""
+	}
+	return truncate(message, maxErrorLength)
+}
+
+// securityObject names the security-relevant object a child event is about, e.g. "Firewall rule"
+// for io.libops.site.firewall_rule.added.v1, or "" for other events
+func securityObject(eventType string) string {
+	parts := strings.Split(eventType, ".")
+	if len(parts) != 6 || parts[0] != "io" || parts[1] != "libops" {
+		return ""
+	}
+	switch parts[3] {
+	case "member":
+		return "Member"
+	case "firewall_rule":
+		return "Firewall rule"
+	case "secret":
+		return "Secret"
+	case "elevation":
+		return "Elevated access"
+	case "policy":
+		return "Policy"
+	default:
+		return ""
+	}
+}
+
+// securityAction returns the action of a child event, e.g. "added"
+func securityAction(eventType string) string {
+	parts := strings.Split(eventType, ".")
+	if len(parts) != 6 {
+		return "changed"
+	}
+	return parts[4]
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -0,0 +1,191 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/libops/control-plane/internal/database"
+)
+
+// Severity colors an alert
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeveritySuccess Severity = "success"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Alert is a channel-agnostic message about an event
+type Alert struct {
+	Title    string
+	Text     string
+	Context  string // Where the event happened, e.g. "Organization: Acme · Project: Web"
+	Link     string // Dashboard URL, empty when no base URL is configured
+	Severity Severity
+}
+
+// send posts an alert to a channel in the format its kind expects
+func (d *Dispatcher) send(ctx context.Context, channel database.NotificationChannel, alert Alert) error {
+	switch channel.Kind {
+	case "slack_webhook":
+		return d.post(ctx, channel.WebhookURL, "", slackPayload(alert, ""), nil)
+	case "slack_app":
+		var resp struct {
+			OK    bool   `json:"ok"`
+			Error string `json:"error"`
+		}
+		if err := d.post(ctx, d.slackAPIURL, channel.SlackBotToken, slackPayload(alert, channel.SlackChannel), &resp); err != nil {
+			return err
+		}
+		// Slack's Web API reports failures in the body of a 200 response
+		if !resp.OK {
+			return fmt.Errorf("slack rejected the message: %s", resp.Error)
+		}
+		return nil
+	case "teams_webhook":
+		return d.post(ctx, channel.WebhookURL, "", teamsPayload(alert), nil)
+	default:
+		return fmt.Errorf("unsupported notification channel kind %q", channel.Kind)
+	}
+}
+
+// post sends a JSON payload and decodes the response into out when it is non-nil
+func (d *Dispatcher) post(ctx context.Context, endpoint, bearerToken string, payload any, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		// The error would include the URL, which is a credential for webhooks
+		return fmt.Errorf("invalid channel URL")
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("channel returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode channel response: %w", err)
+		}
+	}
+	return nil
+}
+
+// unwrapURLError drops the request URL from client errors so webhook secrets are not recorded
+func unwrapURLError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+var slackColors = map[Severity]string{
+	SeverityInfo:    "#6b7280",
+	SeveritySuccess: "#16a34a",
+	SeverityWarning: "#d97706",
+	SeverityError:   "#dc2626",
+}
+
+// slackPayload builds a chat.postMessage / incoming webhook body. channel is only set for Slack apps.
+func slackPayload(alert Alert, channel string) map[string]any {
+	title := "*" + slackEscape(alert.Title) + "*"
+	if alert.Link != "" {
+		title = "*<" + alert.Link + "|" + slackEscape(alert.Title) + ">*"
+	}
+	text := title
+	if alert.Text != "" {
+		text += "\n" + slackEscape(alert.Text)
+	}
+
+	payload := map[string]any{
+		// Fallback for notifications and clients without attachment support
+		"text": alert.Title,
+		"attachments": []map[string]any{{
+			"color": slackColors[alert.Severity],
+			"blocks": []map[string]any{
+				{
+					"type": "section",
+					"text": map[string]any{"type": "mrkdwn", "text": text},
+				},
+				{
+					"type":     "context",
+					"elements": []map[string]any{{"type": "mrkdwn", "text": slackEscape(alert.Context)}},
+				},
+			},
+		}},
+	}
+	if channel != "" {
+		payload["channel"] = channel
+	}
+	return payload
+}
+
+// slackEscape escapes the characters Slack's mrkdwn treats as control sequences
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+var teamsColors = map[Severity]string{
+	SeverityInfo:    "Default",
+	SeveritySuccess: "Good",
+	SeverityWarning: "Warning",
+	SeverityError:   "Attention",
+}
+
+// teamsPayload builds an Adaptive Card message, which both Teams incoming webhooks
+// and Power Automate workflow webhooks accept
+func teamsPayload(alert Alert) map[string]any {
+	body := []map[string]any{{
+		"type":   "TextBlock",
+		"text":   alert.Title,
+		"weight": "Bolder",
+		"size":   "Medium",
+		"color":  teamsColors[alert.Severity],
+		"wrap":   true,
+	}}
+	if alert.Text != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": alert.Text, "wrap": true})
+	}
+	body = append(body, map[string]any{"type": "TextBlock", "text": alert.Context, "isSubtle": true, "size": "Small", "wrap": true})
+
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	}
+	if alert.Link != "" {
+		card["actions"] = []map[string]any{{"type": "Action.OpenUrl", "title": "Open in LibOps", "url": alert.Link}}
+	}
+
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content":     card,
+		}},
+	}
+}
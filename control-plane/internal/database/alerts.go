@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// NotificationChannel is a Slack or Microsoft Teams channel that receives an organization's alerts
+type NotificationChannel struct {
+	ID            int64
+	PublicID      string
+	Name          string
+	Kind          string // slack_webhook, slack_app or teams_webhook
+	WebhookURL    string
+	SlackBotToken string
+	SlackChannel  string
+}
+
+// AlertResource names the organization, project and site an event is about.
+// Project and site fields are empty when the event is scoped above them.
+type AlertResource struct {
+	OrgName         string
+	OrgPublicID     string
+	ProjectName     string
+	ProjectPublicID string
+	SiteName        string
+	SitePublicID    string
+}
+
+const notificationChannelColumns = `id, BIN_TO_UUID(public_id), name, kind, webhook_url, slack_bot_token, slack_channel`
+
+// GetNotificationChannelsForCategory returns an organization's enabled channels subscribed to an alert category.
+// PagerDuty and Opsgenie channels are paged by the API and never returned.
+func (q *Querier) GetNotificationChannelsForCategory(ctx context.Context, orgID int64, category string) ([]NotificationChannel, error) {
+	query := `SELECT ` + notificationChannelColumns + `
+		FROM notification_channels
+		WHERE organization_id = ?
+		AND enabled = TRUE
+		AND kind IN ('slack_webhook', 'slack_app', 'teams_webhook')
+		AND JSON_CONTAINS(categories, JSON_QUOTE(?))
+	`
+
+	rows, err := q.db.QueryContext(ctx, query, orgID, category)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification channels for org %d: %w", orgID, err)
+	}
+	defer rows.Close()
+
+	var channels []NotificationChannel
+	for rows.Next() {
+		var channel NotificationChannel
+		if err := rows.Scan(&channel.ID, &channel.PublicID, &channel.Name, &channel.Kind, &channel.WebhookURL, &channel.SlackBotToken, &channel.SlackChannel); err != nil {
+			return nil, fmt.Errorf("failed to scan notification channel: %w", err)
+		}
+		channels = append(channels, channel)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification channels: %w", err)
+	}
+
+	return channels, nil
+}
+
+// GetNotificationChannel returns one of an organization's channels by public ID, whether or not it is enabled
+func (q *Querier) GetNotificationChannel(ctx context.Context, orgID int64, publicID string) (*NotificationChannel, error) {
+	query := `SELECT ` + notificationChannelColumns + `
+		FROM notification_channels
+		WHERE organization_id = ?
+		AND public_id = UUID_TO_BIN(?)
+	`
+
+	var channel NotificationChannel
+	err := q.db.QueryRowContext(ctx, query, orgID, publicID).Scan(
+		&channel.ID,
+		&channel.PublicID,
+		&channel.Name,
+		&channel.Kind,
+		&channel.WebhookURL,
+		&channel.SlackBotToken,
+		&channel.SlackChannel,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("notification channel %s not found", publicID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notification channel %s: %w", publicID, err)
+	}
+
+	return &channel, nil
+}
+
+// RecordNotificationChannelDelivery stores the outcome of a delivery attempt.
+// An empty deliveryError clears the channel's last error.
+func (q *Querier) RecordNotificationChannelDelivery(ctx context.Context, channelID int64, deliveryError string) error {
+	query := `UPDATE notification_channels SET last_delivery_at = NOW(), last_error = ? WHERE id = ?`
+
+	lastError := sql.NullString{String: deliveryError, Valid: deliveryError != ""}
+	if _, err := q.db.ExecContext(ctx, query, lastError, channelID); err != nil {
+		return fmt.Errorf("failed to record delivery for notification channel %d: %w", channelID, err)
+	}
+	return nil
+}
+
+// GetAlertResource looks up the names of the organization, project and site an event is scoped to
+func (q *Querier) GetAlertResource(ctx context.Context, orgID int64, projectID, siteID *int64) (*AlertResource, error) {
+	var resource AlertResource
+
+	query := `SELECT name, BIN_TO_UUID(public_id) FROM organizations WHERE id = ?`
+	if err := q.db.QueryRowContext(ctx, query, orgID).Scan(&resource.OrgName, &resource.OrgPublicID); err != nil {
+		return nil, fmt.Errorf("failed to query organization %d: %w", orgID, err)
+	}
+
+	if projectID != nil {
+		query := `SELECT name, BIN_TO_UUID(public_id) FROM projects WHERE id = ?`
+		if err := q.db.QueryRowContext(ctx, query, *projectID).Scan(&resource.ProjectName, &resource.ProjectPublicID); err != nil {
+			return nil, fmt.Errorf("failed to query project %d: %w", *projectID, err)
+		}
+	}
+
+	if siteID != nil {
+		query := `SELECT name, BIN_TO_UUID(public_id) FROM sites WHERE id = ?`
+		if err := q.db.QueryRowContext(ctx, query, *siteID).Scan(&resource.SiteName, &resource.SitePublicID); err != nil {
+			return nil, fmt.Errorf("failed to query site %d: %w", *siteID, err)
+		}
+	}
+
+	return &resource, nil
+}
+
+// GetReconciliationError returns the error message a reconciliation run finished with
+func (q *Querier) GetReconciliationError(ctx context.Context, runID string) (string, error) {
+	query := `SELECT COALESCE(error_message, '') FROM reconciliations WHERE run_id = ?`
+
+	var errorMessage string
+	if err := q.db.QueryRowContext(ctx, query, runID).Scan(&errorMessage); err != nil {
+		return "", fmt.Errorf("failed to query reconciliation %s: %w", runID, err)
+	}
+	return errorMessage, nil
+}
@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+// MaintenanceWindow is the hours on some weekdays a site's VM may be changed by
+// non-urgent secrets and firewall reconciliations
+type MaintenanceWindow struct {
+	Days      []string // Lowercase weekdays; empty for every day
+	StartHour int
+	EndHour   int // 1-24
+	Timezone  string
+}
+
+// Open reports whether the window is open at t. A window with an unknown time zone
+// is always open, so changes are never held back forever.
+func (w MaintenanceWindow) Open(t time.Time) bool {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return true
+	}
+	local := t.In(loc)
+	if len(w.Days) > 0 && !slices.Contains(w.Days, strings.ToLower(local.Weekday().String())) {
+		return false
+	}
+	return local.Hour() >= w.StartHour && local.Hour() < w.EndHour
+}
+
+// DeferredReconciliation is a reconciliation held back until its site's maintenance window opens
+type DeferredReconciliation struct {
+	Site        Site
+	RequestType string
+	EventIDs    []string
+	// Window is nil once the site's window is removed
+	Window *MaintenanceWindow
+}
+
+// GetMaintenanceWindow returns a site's maintenance window, or nil if it has none
+func (q *Querier) GetMaintenanceWindow(ctx context.Context, siteID int64) (*MaintenanceWindow, error) {
+	query := `SELECT days, start_hour, end_hour, timezone FROM site_maintenance_windows WHERE site_id = ?`
+
+	var w MaintenanceWindow
+	var days []byte
+	err := q.db.QueryRowContext(ctx, query, siteID).Scan(&days, &w.StartHour, &w.EndHour, &w.Timezone)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query maintenance window for site %d: %w", siteID, err)
+	}
+	if err := json.Unmarshal(days, &w.Days); err != nil {
+		return nil, fmt.Errorf("invalid days in maintenance window for site %d: %w", siteID, err)
+	}
+
+	return &w, nil
+}
+
+// DeferReconciliation holds back a site's reconciliation until its window opens,
+// adding the events to any reconciliation of the same type already waiting
+func (q *Querier) DeferReconciliation(ctx context.Context, siteID int64, requestType string, eventIDs []string) error {
+	query := `
+		INSERT INTO deferred_reconciliations (site_id, request_type, event_ids)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE event_ids = JSON_MERGE_PRESERVE(event_ids, VALUES(event_ids))
+	`
+
+	if eventIDs == nil {
+		eventIDs = []string{}
+	}
+	ids, err := json.Marshal(eventIDs)
+	if err != nil {
+		return fmt.Errorf("failed to encode event IDs: %w", err)
+	}
+	if _, err := q.db.ExecContext(ctx, query, siteID, requestType, ids); err != nil {
+		return fmt.Errorf("failed to defer %s reconciliation for site %d: %w", requestType, siteID, err)
+	}
+	return nil
+}
+
+// ListDeferredReconciliations returns every deferred reconciliation with its site's window
+func (q *Querier) ListDeferredReconciliations(ctx context.Context) ([]DeferredReconciliation, error) {
+	query := `
+		SELECT s.id, BIN_TO_UUID(s.public_id), s.project_id, BIN_TO_UUID(p.public_id),
+		       p.organization_id, BIN_TO_UUID(o.public_id),
+		       d.request_type, d.event_ids,
+		       w.days, w.start_hour, w.end_hour, w.timezone
+		FROM deferred_reconciliations d
+		JOIN sites s ON d.site_id = s.id
+		JOIN projects p ON s.project_id = p.id
+		JOIN organizations o ON p.organization_id = o.id
+		LEFT JOIN site_maintenance_windows w ON w.site_id = s.id
+		WHERE s.status != 'deleted'
+		ORDER BY d.created_at
+	`
+
+	rows, err := q.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deferred reconciliations: %w", err)
+	}
+	defer rows.Close()
+
+	var deferred []DeferredReconciliation
+	for rows.Next() {
+		var d DeferredReconciliation
+		var eventIDs, days []byte
+		var startHour, endHour sql.NullInt64
+		var timezone sql.NullString
+		if err := rows.Scan(
+			&d.Site.ID,
+			&d.Site.PublicID,
+			&d.Site.ProjectID,
+			&d.Site.ProjectPublicID,
+			&d.Site.OrgID,
+			&d.Site.OrgPublicID,
+			&d.RequestType,
+			&eventIDs,
+			&days,
+			&startHour,
+			&endHour,
+			&timezone,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan deferred reconciliation: %w", err)
+		}
+		if err := json.Unmarshal(eventIDs, &d.EventIDs); err != nil {
+			return nil, fmt.Errorf("invalid event IDs deferred for site %d: %w", d.Site.ID, err)
+		}
+		if timezone.Valid {
+			d.Window = &MaintenanceWindow{
+				StartHour: int(startHour.Int64),
+				EndHour:   int(endHour.Int64),
+				Timezone:  timezone.String,
+			}
+			if err := json.Unmarshal(days, &d.Window.Days); err != nil {
+				return nil, fmt.Errorf("invalid days in maintenance window for site %d: %w", d.Site.ID, err)
+			}
+		}
+		deferred = append(deferred, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating deferred reconciliations: %w", err)
+	}
+
+	return deferred, nil
+}
+
+// DeleteDeferredReconciliations drops a site's deferred reconciliations of the given types,
+// once they ran or a reconciliation covering them did
+func (q *Querier) DeleteDeferredReconciliations(ctx context.Context, siteID int64, requestTypes ...string) error {
+	if len(requestTypes) == 0 {
+		return nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(requestTypes)), ", ")
+	query := `DELETE FROM deferred_reconciliations WHERE site_id = ? AND request_type IN (` + placeholders + `)`
+
+	args := []any{siteID}
+	for _, t := range requestTypes {
+		args = append(args, t)
+	}
+	if _, err := q.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete deferred reconciliations for site %d: %w", siteID, err)
+	}
+	return nil
+}
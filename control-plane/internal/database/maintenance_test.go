@@ -0,0 +1,34 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	weekends := MaintenanceWindow{Days: []string{"saturday", "sunday"}, StartHour: 2, EndHour: 6, Timezone: "America/New_York"}
+	nightly := MaintenanceWindow{StartHour: 22, EndHour: 24, Timezone: "UTC"}
+
+	tests := []struct {
+		name   string
+		window MaintenanceWindow
+		at     time.Time
+		want   bool
+	}{
+		{"weekend inside", weekends, time.Date(2026, 3, 14, 7, 0, 0, 0, time.UTC), true},
+		{"weekend before start", weekends, time.Date(2026, 3, 14, 5, 59, 0, 0, time.UTC), false},
+		{"weekend at end", weekends, time.Date(2026, 3, 14, 10, 0, 0, 0, time.UTC), false},
+		{"weekday hours", weekends, time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC), false},
+		{"every day", nightly, time.Date(2026, 3, 11, 23, 59, 0, 0, time.UTC), true},
+		{"every day closed", nightly, time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC), false},
+		{"unknown time zone", MaintenanceWindow{StartHour: 2, EndHour: 3, Timezone: "Mars/Olympus_Mons"}, time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Open(tt.at); got != tt.want {
+				t.Errorf("Open(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
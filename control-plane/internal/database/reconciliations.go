@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SiteReconciliationRun is a reconciliation the site proxy forwarded to a site's controller
+type SiteReconciliationRun struct {
+	RunID       string
+	SiteID      int64
+	RequestType string // ssh_keys, secrets, firewall or general
+	EventIDs    []string
+	StartedAt   time.Time
+	CompletedAt time.Time
+	Error       string // Empty when the controller succeeded
+	Attempt     int    // 1 for the first call, counting up with each retry
+}
+
+// RecordSiteReconciliation stores a finished VM reconciliation for the site's reconciliation timeline
+func (q *Querier) RecordSiteReconciliation(ctx context.Context, run SiteReconciliationRun) error {
+	query := `
+		INSERT INTO reconciliations (
+			run_id, site_id, run_type, reconciliation_type, target_site_ids, event_ids,
+			first_event_at, last_event_at, status, error_message, attempt,
+			triggered_at, started_at, completed_at
+		) VALUES (?, ?, 'reconciliation', ?, JSON_ARRAY(?), ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	eventIDs := run.EventIDs
+	if eventIDs == nil {
+		eventIDs = []string{}
+	}
+	ids, err := json.Marshal(eventIDs)
+	if err != nil {
+		return fmt.Errorf("failed to encode event IDs: %w", err)
+	}
+
+	status := "completed"
+	if run.Error != "" {
+		status = "failed"
+	}
+	errorMessage := sql.NullString{String: run.Error, Valid: run.Error != ""}
+	attempt := max(run.Attempt, 1)
+
+	_, err = q.db.ExecContext(ctx, query,
+		run.RunID, run.SiteID, run.RequestType, run.SiteID, ids,
+		run.StartedAt, run.StartedAt, status, errorMessage, attempt,
+		run.StartedAt, run.StartedAt, run.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record %s reconciliation for site %d: %w", run.RequestType, run.SiteID, err)
+	}
+	return nil
+}
+
+// FailedReconciliation is a failed VM reconciliation the event router has yet to retry or give up on
+type FailedReconciliation struct {
+	ID          int64
+	RunID       string
+	Site        Site
+	RequestType string // ssh_keys, secrets, firewall or general
+	EventIDs    []string
+	Attempt     int
+	CompletedAt time.Time
+	Scheduled   bool // A retry is scheduled and due; otherwise the failure is new
+	Superseded  bool // A later reconciliation of the site applied the same changes
+}
+
+// ListRetryableReconciliations returns failed VM reconciliations that aren't scheduled yet,
+// or whose scheduled retry is due at now
+func (q *Querier) ListRetryableReconciliations(ctx context.Context, now time.Time) ([]FailedReconciliation, error) {
+	query := `
+		SELECT r.id, r.run_id, s.id, BIN_TO_UUID(s.public_id), s.project_id, BIN_TO_UUID(p.public_id),
+		       p.organization_id, BIN_TO_UUID(o.public_id),
+		       r.reconciliation_type, r.event_ids, r.attempt, COALESCE(r.completed_at, r.created_at),
+		       r.retry_state IS NOT NULL,
+		       EXISTS (
+		           SELECT 1 FROM reconciliations later
+		           WHERE later.site_id = r.site_id
+		             AND later.run_type = 'reconciliation'
+		             AND later.reconciliation_type IN (r.reconciliation_type, 'general')
+		             AND later.id > r.id
+		       )
+		FROM reconciliations r
+		JOIN sites s ON r.site_id = s.id
+		JOIN projects p ON s.project_id = p.id
+		JOIN organizations o ON p.organization_id = o.id
+		WHERE r.run_type = 'reconciliation'
+		  AND r.status = 'failed'
+		  AND r.reconciliation_type IS NOT NULL
+		  AND (r.retry_state IS NULL OR (r.retry_state = 'scheduled' AND r.next_retry_at <= ?))
+		  AND s.status != 'deleted'
+		ORDER BY r.id
+	`
+
+	rows, err := q.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query retryable reconciliations: %w", err)
+	}
+	defer rows.Close()
+
+	var failed []FailedReconciliation
+	for rows.Next() {
+		var f FailedReconciliation
+		var eventIDs []byte
+		if err := rows.Scan(
+			&f.ID,
+			&f.RunID,
+			&f.Site.ID,
+			&f.Site.PublicID,
+			&f.Site.ProjectID,
+			&f.Site.ProjectPublicID,
+			&f.Site.OrgID,
+			&f.Site.OrgPublicID,
+			&f.RequestType,
+			&eventIDs,
+			&f.Attempt,
+			&f.CompletedAt,
+			&f.Scheduled,
+			&f.Superseded,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan retryable reconciliation: %w", err)
+		}
+		if err := json.Unmarshal(eventIDs, &f.EventIDs); err != nil {
+			return nil, fmt.Errorf("invalid event IDs in reconciliation %s: %w", f.RunID, err)
+		}
+		failed = append(failed, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating retryable reconciliations: %w", err)
+	}
+
+	return failed, nil
+}
+
+// SetReconciliationRetry records what the event router did with a failed reconciliation:
+// scheduled (with the time it retries), retried, superseded or exhausted
+func (q *Querier) SetReconciliationRetry(ctx context.Context, id int64, state string, nextRetryAt *time.Time) error {
+	query := `UPDATE reconciliations SET retry_state = ?, next_retry_at = ? WHERE id = ?`
+
+	if _, err := q.db.ExecContext(ctx, query, state, nextRetryAt, id); err != nil {
+		return fmt.Errorf("failed to mark reconciliation %d %s: %w", id, state, err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// EventSink is a customer-owned Pub/Sub topic or Cloud Storage bucket an organization's events are published to
+type EventSink struct {
+	ID            int64
+	PublicID      string
+	Kind          string // pubsub_topic or storage_bucket
+	Destination   string // projects/PROJECT/topics/TOPIC or a bucket name
+	EventTypes    []string
+	SigningSecret string
+	// GCPProjectID is the organization's project, whose platform service account publishes.
+	// Empty until the project is provisioned.
+	GCPProjectID string
+}
+
+// Matches reports whether the sink publishes an event type. A sink without event types publishes every event.
+func (s EventSink) Matches(eventType string) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, prefix := range s.EventTypes {
+		if len(eventType) >= len(prefix) && eventType[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+const eventSinkColumns = `s.id, BIN_TO_UUID(s.public_id), s.kind, s.destination, s.event_types, s.signing_secret, COALESCE(o.gcp_project_id, '')`
+
+// GetEnabledEventSinks returns an organization's enabled event sinks
+func (q *Querier) GetEnabledEventSinks(ctx context.Context, orgID int64) ([]EventSink, error) {
+	query := `SELECT ` + eventSinkColumns + `
+		FROM event_sinks s
+		JOIN organizations o ON s.organization_id = o.id
+		WHERE s.organization_id = ?
+		AND s.enabled = TRUE
+	`
+
+	rows, err := q.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event sinks for org %d: %w", orgID, err)
+	}
+	defer rows.Close()
+
+	var sinks []EventSink
+	for rows.Next() {
+		sink, err := scanEventSink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event sink: %w", err)
+		}
+		sinks = append(sinks, *sink)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating event sinks: %w", err)
+	}
+
+	return sinks, nil
+}
+
+// GetEventSink returns one of an organization's event sinks by public ID, whether or not it is enabled
+func (q *Querier) GetEventSink(ctx context.Context, orgID int64, publicID string) (*EventSink, error) {
+	query := `SELECT ` + eventSinkColumns + `
+		FROM event_sinks s
+		JOIN organizations o ON s.organization_id = o.id
+		WHERE s.organization_id = ?
+		AND s.public_id = UUID_TO_BIN(?)
+	`
+
+	sink, err := scanEventSink(q.db.QueryRowContext(ctx, query, orgID, publicID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("event sink %s not found", publicID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event sink %s: %w", publicID, err)
+	}
+
+	return sink, nil
+}
+
+// RecordEventSinkDelivery stores the outcome of a delivery attempt.
+// An empty deliveryError clears the sink's last error.
+func (q *Querier) RecordEventSinkDelivery(ctx context.Context, sinkID int64, deliveryError string) error {
+	query := `UPDATE event_sinks SET last_delivery_at = NOW(), last_error = ? WHERE id = ?`
+
+	lastError := sql.NullString{String: deliveryError, Valid: deliveryError != ""}
+	if _, err := q.db.ExecContext(ctx, query, lastError, sinkID); err != nil {
+		return fmt.Errorf("failed to record delivery for event sink %d: %w", sinkID, err)
+	}
+	return nil
+}
+
+func scanEventSink(row interface{ Scan(...any) error }) (*EventSink, error) {
+	var sink EventSink
+	var eventTypes []byte
+	if err := row.Scan(&sink.ID, &sink.PublicID, &sink.Kind, &sink.Destination, &eventTypes, &sink.SigningSecret, &sink.GCPProjectID); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(eventTypes, &sink.EventTypes); err != nil {
+		return nil, fmt.Errorf("failed to decode event types of event sink %s: %w", sink.PublicID, err)
+	}
+	return &sink, nil
+}
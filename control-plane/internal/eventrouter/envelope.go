@@ -0,0 +1,108 @@
+package eventrouter
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+	"github.com/libops/control-plane/internal/workflows"
+
+	// Register the payload types an envelope's data can hold
+	_ "github.com/libops/api/proto/libops/v1"
+	_ "github.com/libops/api/proto/libops/v1/admin"
+	_ "github.com/libops/api/proto/libops/v1/common"
+)
+
+// envelopeContentType is the content type the API queues events with
+const envelopeContentType = "application/protobuf; proto=libops.v1.events.Envelope"
+
+// legacyContentType is the content type of events queued before the envelope, whose
+// data is their bare payload. Accept them until the queue has drained of them.
+const legacyContentType = "application/protobuf"
+
+// decodeEnvelope decodes an event's data and checks it is a well-formed envelope of a
+// schema version this router knows, declaring the event's type, with a payload of a
+// type registered for it.
+func decodeEnvelope(event workflows.Event) (*eventsv1.Envelope, error) {
+	if event.ContentType == legacyContentType {
+		return legacyEnvelope(event)
+	}
+	if event.ContentType != envelopeContentType {
+		return nil, fmt.Errorf("unsupported content type %q", event.ContentType)
+	}
+
+	envelope := &eventsv1.Envelope{}
+	if err := proto.Unmarshal(event.EventData, envelope); err != nil {
+		return nil, fmt.Errorf("failed to decode envelope: %w", err)
+	}
+
+	if envelope.SchemaVersion != eventsv1.SchemaVersion_SCHEMA_VERSION_1 {
+		return nil, fmt.Errorf("unsupported schema version %d", envelope.SchemaVersion)
+	}
+
+	eventType := cloudEventType(envelope.Type)
+	if eventType == "" {
+		return nil, fmt.Errorf("unregistered event type %d", envelope.Type)
+	}
+	if eventType != event.EventType {
+		return nil, fmt.Errorf("envelope type %s does not match event type %s", eventType, event.EventType)
+	}
+
+	if envelope.Data != nil {
+		if !registeredPayload(envelope.Type, envelope.Data) {
+			return nil, fmt.Errorf("%s is not a registered payload of event type %s", envelope.Data.GetTypeUrl(), eventType)
+		}
+		if _, err := envelope.Data.UnmarshalNew(); err != nil {
+			return nil, fmt.Errorf("invalid %s payload: %w", envelope.Data.GetTypeUrl(), err)
+		}
+	}
+
+	return envelope, nil
+}
+
+// legacyEnvelope wraps an event queued before the envelope in one without data, once
+// its type is registered. Its bare payload can't be typed, so it isn't decoded; sinks
+// still publish it as it was queued.
+func legacyEnvelope(event workflows.Event) (*eventsv1.Envelope, error) {
+	values := eventsv1.EventType(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		t := eventsv1.EventType(values.Get(i).Number())
+		if t != eventsv1.EventType_EVENT_TYPE_UNSPECIFIED && cloudEventType(t) == event.EventType {
+			return &eventsv1.Envelope{
+				SchemaVersion: eventsv1.SchemaVersion_SCHEMA_VERSION_1,
+				Type:          t,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unregistered event type %s", event.EventType)
+}
+
+// registeredPayload reports whether data holds one of the payload types registered for t
+func registeredPayload(t eventsv1.EventType, data *anypb.Any) bool {
+	value := t.Descriptor().Values().ByNumber(t.Number())
+	if value == nil {
+		return false
+	}
+	for _, name := range proto.GetExtension(value.Options(), optionsv1.E_PayloadType).([]string) {
+		payloadType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+		if err == nil && data.MessageIs(payloadType.Zero().Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+// cloudEventType returns the CloudEvents type a registered event type is queued as,
+// or "" for values missing from the registry
+func cloudEventType(t eventsv1.EventType) string {
+	value := t.Descriptor().Values().ByNumber(t.Number())
+	if value == nil {
+		return ""
+	}
+	return proto.GetExtension(value.Options(), optionsv1.E_CloudeventType).(string)
+}
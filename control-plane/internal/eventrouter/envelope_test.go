@@ -0,0 +1,157 @@
+package eventrouter
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+	"github.com/libops/control-plane/internal/workflows"
+)
+
+func TestDecodeEnvelope(t *testing.T) {
+	payload, err := anypb.New(&libopsv1.UpdateSiteResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unregistered, err := anypb.New(&libopsv1.DeploySiteRequest{SiteId: "d8e8fca2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := proto.Marshal(&libopsv1.DeploySiteRequest{SiteId: "d8e8fca2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := &eventsv1.Envelope{
+		SchemaVersion: eventsv1.SchemaVersion_SCHEMA_VERSION_1,
+		Type:          eventsv1.EventType_EVENT_TYPE_SITE_UPDATED,
+		Data:          payload,
+	}
+
+	tests := []struct {
+		name        string
+		eventType   string
+		contentType string
+		envelope    func() *eventsv1.Envelope
+		data        []byte
+		wantErr     bool
+	}{
+		{
+			name:     "valid",
+			envelope: func() *eventsv1.Envelope { return valid },
+		},
+		{
+			name: "without data",
+			envelope: func() *eventsv1.Envelope {
+				e := proto.Clone(valid).(*eventsv1.Envelope)
+				e.Data = nil
+				return e
+			},
+		},
+		{
+			name:        "json",
+			contentType: "application/json",
+			data:        []byte(`{}`),
+			wantErr:     true,
+		},
+		{
+			name:    "garbage",
+			data:    []byte{0xff, 0xff, 0xff},
+			wantErr: true,
+		},
+		{
+			name: "unknown schema version",
+			envelope: func() *eventsv1.Envelope {
+				e := proto.Clone(valid).(*eventsv1.Envelope)
+				e.SchemaVersion = 2
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name:      "type mismatch",
+			eventType: "io.libops.site.deleted.v1",
+			envelope:  func() *eventsv1.Envelope { return valid },
+			wantErr:   true,
+		},
+		{
+			name: "unregistered type",
+			envelope: func() *eventsv1.Envelope {
+				e := proto.Clone(valid).(*eventsv1.Envelope)
+				e.Type = 10000
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name: "payload not registered for the type",
+			envelope: func() *eventsv1.Envelope {
+				e := proto.Clone(valid).(*eventsv1.Envelope)
+				e.Data = unregistered
+				return e
+			},
+			wantErr: true,
+		},
+		{
+			name:        "legacy",
+			contentType: legacyContentType,
+			data:        legacy,
+		},
+		{
+			name:        "legacy unregistered type",
+			eventType:   "io.libops.site.renamed.v1",
+			contentType: legacyContentType,
+			data:        legacy,
+			wantErr:     true,
+		},
+		{
+			name: "unknown payload",
+			envelope: func() *eventsv1.Envelope {
+				e := proto.Clone(valid).(*eventsv1.Envelope)
+				e.Data.TypeUrl = "type.googleapis.com/libops.v1.Unknown"
+				return e
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := workflows.Event{
+				EventID:     "evt-1",
+				EventType:   "io.libops.site.updated.v1",
+				ContentType: envelopeContentType,
+				EventData:   tt.data,
+			}
+			if tt.eventType != "" {
+				event.EventType = tt.eventType
+			}
+			if tt.contentType != "" {
+				event.ContentType = tt.contentType
+			}
+			if tt.envelope != nil {
+				data, err := proto.Marshal(tt.envelope())
+				if err != nil {
+					t.Fatal(err)
+				}
+				event.EventData = data
+			}
+
+			envelope, err := decodeEnvelope(event)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("decodeEnvelope() = %v, want error", envelope)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeEnvelope() error = %v", err)
+			}
+			if envelope.Type != eventsv1.EventType_EVENT_TYPE_SITE_UPDATED {
+				t.Errorf("envelope type = %v", envelope.Type)
+			}
+		})
+	}
+}
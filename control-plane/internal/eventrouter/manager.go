@@ -117,9 +117,11 @@ func (rm *ReconciliationManager) processOrgEvents(ctx context.Context, orgID int
 	// Collect event types and IDs
 	eventIDs := make([]string, len(events))
 	eventTypes := make([]string, len(events))
+	urgent := false
 	for i, e := range events {
 		eventIDs[i] = e.EventID
 		eventTypes[i] = e.EventType
+		urgent = urgent || e.Envelope.GetUrgent()
 	}
 
 	// Determine reconciliation type
@@ -133,6 +135,7 @@ func (rm *ReconciliationManager) processOrgEvents(ctx context.Context, orgID int
 		EventTypes:         eventTypes,
 		Scope:              scope,
 		ReconciliationType: reconciliationType,
+		Urgent:             urgent,
 	}
 
 	// Execute activity directly
@@ -143,3 +146,17 @@ func (rm *ReconciliationManager) processOrgEvents(ctx context.Context, orgID int
 		slog.Info("Reconciliation completed", "org_id", orgID)
 	}
 }
+
+// ReleaseDeferred publishes the deferred reconciliations whose sites' maintenance windows are open
+func (rm *ReconciliationManager) ReleaseDeferred(ctx context.Context) {
+	if err := rm.activityHandler.ReleaseDeferredReconciliations(ctx, time.Now()); err != nil {
+		slog.Error("Failed to release deferred reconciliations", "error", err)
+	}
+}
+
+// RetryFailed schedules failed site reconciliations and publishes the retries that are due
+func (rm *ReconciliationManager) RetryFailed(ctx context.Context) {
+	if err := rm.activityHandler.RetryFailedReconciliations(ctx, time.Now()); err != nil {
+		slog.Error("Failed to retry failed reconciliations", "error", err)
+	}
+}
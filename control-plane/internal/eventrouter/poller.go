@@ -7,21 +7,30 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/libops/control-plane/internal/alerts"
+	"github.com/libops/control-plane/internal/sinks"
 	"github.com/libops/control-plane/internal/workflows"
 )
 
-// EventPoller polls the event_queue table and dispatches events to the reconciliation manager
+// EventPoller relays the API's outbox into the event_queue table, then polls it and dispatches
+// events to the reconciliation manager, the organization's alert channels and its event sinks
 type EventPoller struct {
 	db      *sql.DB
+	relay   *OutboxRelay
 	manager *ReconciliationManager
+	alerts  *alerts.Dispatcher
+	sinks   *sinks.Dispatcher
 	config  *Config
 }
 
 // NewEventPoller creates a new event poller
-func NewEventPoller(db *sql.DB, manager *ReconciliationManager, config *Config) *EventPoller {
+func NewEventPoller(db *sql.DB, manager *ReconciliationManager, dispatcher *alerts.Dispatcher, sinkDispatcher *sinks.Dispatcher, config *Config) *EventPoller {
 	return &EventPoller{
 		db:      db,
+		relay:   NewOutboxRelay(db),
 		manager: manager,
+		alerts:  dispatcher,
+		sinks:   sinkDispatcher,
 		config:  config,
 	}
 }
@@ -39,9 +48,14 @@ func (p *EventPoller) Start(ctx context.Context) {
 			slog.Info("Event poller stopped")
 			return
 		case <-ticker.C:
+			if _, err := p.relay.Relay(ctx); err != nil {
+				slog.Error("Failed to relay outbox events", "error", err)
+			}
 			if err := p.pollAndDispatch(ctx); err != nil {
 				slog.Error("Failed to poll and dispatch events", "error", err)
 			}
+			p.manager.ReleaseDeferred(ctx)
+			p.manager.RetryFailed(ctx)
 		}
 	}
 }
@@ -73,7 +87,8 @@ func (p *EventPoller) pollAndDispatch(ctx context.Context) error {
 	defer rows.Close()
 
 	var events []workflows.Event
-	
+	var rejected []rejectedEvent
+
 	for rows.Next() {
 		var event workflows.Event
 		var eventSubject sql.NullString
@@ -108,6 +123,17 @@ func (p *EventPoller) pollAndDispatch(ctx context.Context) error {
 			event.SiteID = &sID
 		}
 
+		envelope, err := decodeEnvelope(event)
+		if err != nil {
+			slog.Error("Rejecting malformed event",
+				"event_id", event.EventID,
+				"event_type", event.EventType,
+				"error", err)
+			rejected = append(rejected, rejectedEvent{eventID: event.EventID, err: err})
+			continue
+		}
+		event.Envelope = envelope
+
 		events = append(events, event)
 	}
 
@@ -115,6 +141,15 @@ func (p *EventPoller) pollAndDispatch(ctx context.Context) error {
 		return fmt.Errorf("error iterating events: %w", err)
 	}
 
+	// Malformed events are never dispatched, or they would be retried forever
+	for _, r := range rejected {
+		if err := p.markEventDeadLetter(ctx, r.eventID, r.err); err != nil {
+			slog.Error("Failed to dead letter event",
+				"event_id", r.eventID,
+				"error", err)
+		}
+	}
+
 	if len(events) == 0 {
 		return nil
 	}
@@ -123,7 +158,30 @@ func (p *EventPoller) pollAndDispatch(ctx context.Context) error {
 
 	// Dispatch events to manager
 	for _, event := range events {
-		p.manager.AcceptEvent(ctx, event)
+		// Notification events are delivered elsewhere and never trigger reconciliation
+		if !workflows.IsNotificationEvent(event.EventType) {
+			p.manager.AcceptEvent(ctx, event)
+		}
+
+		// Alerts are best effort: a failed delivery is recorded on the channel and never retried
+		if p.alerts != nil {
+			if err := p.alerts.Dispatch(ctx, event); err != nil {
+				slog.Error("Failed to dispatch alerts",
+					"event_id", event.EventID,
+					"event_type", event.EventType,
+					"error", err)
+			}
+		}
+
+		// So are event sinks: a failed publish is recorded on the sink and never retried
+		if p.sinks != nil {
+			if err := p.sinks.Dispatch(ctx, event); err != nil {
+				slog.Error("Failed to dispatch to event sinks",
+					"event_id", event.EventID,
+					"event_type", event.EventType,
+					"error", err)
+			}
+		}
 
 		// Mark events as sent
 		if err := p.markEventSent(ctx, event.EventID); err != nil {
@@ -136,6 +194,21 @@ func (p *EventPoller) pollAndDispatch(ctx context.Context) error {
 	return nil
 }
 
+// rejectedEvent is a polled event that failed validation
+type rejectedEvent struct {
+	eventID string
+	err     error
+}
+
+func (p *EventPoller) markEventDeadLetter(ctx context.Context, eventID string, reason error) error {
+	query := `UPDATE event_queue SET status = 'dead_letter', last_error = ? WHERE event_id = ?`
+	_, err := p.db.ExecContext(ctx, query, reason.Error(), eventID)
+	if err != nil {
+		return fmt.Errorf("failed to update event status: %w", err)
+	}
+	return nil
+}
+
 func (p *EventPoller) markEventSent(ctx context.Context, eventID string) error {
 	query := `UPDATE event_queue SET status = 'sent', sent_at = NOW() WHERE event_id = ?`
 	_, err := p.db.ExecContext(ctx, query, eventID)
@@ -144,4 +217,3 @@ func (p *EventPoller) markEventSent(ctx context.Context, eventID string) error {
 	}
 	return nil
 }
-
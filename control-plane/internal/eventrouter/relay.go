@@ -0,0 +1,98 @@
+package eventrouter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// relayBatch caps how many outbox events are moved per relay pass.
+const relayBatch = 500
+
+// OutboxRelay moves events the API committed to event_outbox into event_queue.
+// The API writes an event in the same transaction as the change it describes,
+// so only committed changes ever reach the queue.
+type OutboxRelay struct {
+	db *sql.DB
+}
+
+// NewOutboxRelay creates a new outbox relay
+func NewOutboxRelay(db *sql.DB) *OutboxRelay {
+	return &OutboxRelay{db: db}
+}
+
+// Relay moves one batch of outbox events into event_queue, oldest first, and
+// returns how many it moved. Rows are copied and deleted in one transaction,
+// and events already queued are skipped, so a relay that dies partway
+// through neither loses nor duplicates an event.
+func (r *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin outbox relay: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM event_outbox ORDER BY id LIMIT ? FOR UPDATE SKIP LOCKED`, relayBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query outbox: %w", err)
+	}
+	var ids []any
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read outbox: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	// Only the locked ids, since rows committed meanwhile may have lower ones
+	in := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	_, err = tx.ExecContext(ctx, `
+		INSERT IGNORE INTO event_queue (
+			event_id,
+			event_type,
+			event_source,
+			event_subject,
+			event_data,
+			content_type,
+			organization_id,
+			project_id,
+			site_id,
+			created_at
+		)
+		SELECT
+			event_id,
+			event_type,
+			event_source,
+			event_subject,
+			event_data,
+			content_type,
+			organization_id,
+			project_id,
+			site_id,
+			created_at
+		FROM event_outbox
+		WHERE id IN (`+in+`)
+		ORDER BY id
+	`, ids...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to queue outbox events: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_outbox WHERE id IN (`+in+`)`, ids...); err != nil {
+		return 0, fmt.Errorf("failed to clear outbox events: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit outbox relay: %w", err)
+	}
+	return len(ids), nil
+}
@@ -13,8 +13,10 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/libops/control-plane/internal/alerts"
 	"github.com/libops/control-plane/internal/database"
 	"github.com/libops/control-plane/internal/publisher"
+	"github.com/libops/control-plane/internal/sinks"
 )
 
 // Config holds event router configuration
@@ -25,6 +27,7 @@ type Config struct {
 	LogLevel            string
 	Port                string
 	ProjectID           string // GCP project ID for Pub/Sub
+	APIBaseURL          string // Dashboard URL that alerts link to
 }
 
 // Run starts the event router service
@@ -73,8 +76,14 @@ func Run() error {
 	// Create reconciliation manager
 	manager := NewReconciliationManager(activityHandler)
 
+	// Create alert dispatcher for Slack and Teams notification channels
+	dispatcher := alerts.NewDispatcher(dbQuerier, cfg.APIBaseURL)
+
+	// Create dispatcher for customer Pub/Sub topic and Cloud Storage bucket sinks
+	sinkDispatcher := sinks.NewDispatcher(dbQuerier, sinks.NewGCPPublisher())
+
 	// Create event poller
-	poller := NewEventPoller(eventsDB, manager, cfg)
+	poller := NewEventPoller(eventsDB, manager, dispatcher, sinkDispatcher, cfg)
 
 	// Start event poller
 	go poller.Start(ctx)
@@ -104,6 +113,7 @@ func loadConfig() (*Config, error) {
 		LogLevel:            getEnv("LOG_LEVEL", "INFO"),
 		Port:                getEnv("PORT", "8081"),
 		ProjectID:           getEnv("PROJECT_ID", ""),
+		APIBaseURL:          getEnv("API_BASE_URL", ""),
 	}
 
 	if passwordFile := os.Getenv("MARIADB_PASSWORD_FILE"); passwordFile != "" {
@@ -18,6 +18,12 @@ type DatabaseQuerier interface {
 	GetSitesForOrgMembers(ctx context.Context, orgID int64) ([]database.Site, error)
 	GetSitesForProjectMembers(ctx context.Context, projectID int64) ([]database.Site, error)
 	GetSitesForSiteMembers(ctx context.Context, siteID int64) ([]database.Site, error)
+	GetMaintenanceWindow(ctx context.Context, siteID int64) (*database.MaintenanceWindow, error)
+	DeferReconciliation(ctx context.Context, siteID int64, requestType string, eventIDs []string) error
+	ListDeferredReconciliations(ctx context.Context) ([]database.DeferredReconciliation, error)
+	DeleteDeferredReconciliations(ctx context.Context, siteID int64, requestTypes ...string) error
+	ListRetryableReconciliations(ctx context.Context, now time.Time) ([]database.FailedReconciliation, error)
+	SetReconciliationRetry(ctx context.Context, id int64, state string, nextRetryAt *time.Time) error
 }
 
 // Publisher interface for publishing reconciliation requests
@@ -29,6 +35,7 @@ type Publisher interface {
 type ActivityHandler struct {
 	db        DatabaseQuerier
 	publisher Publisher
+	retry     RetryPolicy
 }
 
 // NewActivityHandler creates a new activity handler
@@ -36,6 +43,7 @@ func NewActivityHandler(db DatabaseQuerier, publisher Publisher) *ActivityHandle
 	return &ActivityHandler{
 		db:        db,
 		publisher: publisher,
+		retry:     DefaultRetryPolicy,
 	}
 }
 
@@ -72,8 +80,31 @@ func (h *ActivityHandler) PublishSiteReconciliation(ctx context.Context, input w
 	requestType := string(input.ReconciliationType)
 
 	// Publish reconciliation request for each site
-	timestamp := time.Now().UTC().Format(time.RFC3339)
+	now := time.Now()
+	timestamp := now.UTC().Format(time.RFC3339)
+	deferred := 0
 	for _, site := range sites {
+		if !input.Urgent && deferrable(input.ReconciliationType) {
+			window, err := h.db.GetMaintenanceWindow(ctx, site.ID)
+			if err != nil {
+				slog.Error("Failed to get maintenance window",
+					"site_public_id", site.PublicID,
+					"error", err)
+			} else if window != nil && !window.Open(now) {
+				if err := h.db.DeferReconciliation(ctx, site.ID, requestType, input.EventIDs); err != nil {
+					slog.Error("Failed to defer site reconciliation",
+						"site_public_id", site.PublicID,
+						"error", err)
+				} else {
+					slog.Info("Deferred site reconciliation to maintenance window",
+						"site_public_id", site.PublicID,
+						"request_type", requestType)
+					deferred++
+				}
+				continue
+			}
+		}
+
 		req := SiteReconciliationRequest{
 			SitePublicID:    site.PublicID,
 			ProjectPublicID: site.ProjectPublicID,
@@ -81,6 +112,7 @@ func (h *ActivityHandler) PublishSiteReconciliation(ctx context.Context, input w
 			RequestType:     requestType,
 			EventIDs:        input.EventIDs,
 			Timestamp:       timestamp,
+			IdempotencyKey:  IdempotencyKey(site.PublicID, requestType, input.EventIDs),
 		}
 
 		if err := h.publisher.PublishSiteReconciliation(ctx, req); err != nil {
@@ -90,20 +122,98 @@ func (h *ActivityHandler) PublishSiteReconciliation(ctx context.Context, input w
 			// Continue with other sites even if one fails
 			continue
 		}
+
+		// The reconciliation applied the site's current state, so nothing deferred is left to do
+		if covered := coveredDeferrals(input.ReconciliationType); len(covered) > 0 {
+			if err := h.db.DeleteDeferredReconciliations(ctx, site.ID, covered...); err != nil {
+				slog.Error("Failed to clear deferred reconciliations",
+					"site_public_id", site.PublicID,
+					"error", err)
+			}
+		}
 	}
 
 	slog.Info("Successfully published site reconciliation requests",
 		"org_id", input.OrgID,
 		"sites_affected", len(sites),
+		"sites_deferred", deferred,
 		"request_type", requestType)
 
 	return workflows.ReconciliationResult{
 		Status:        "success",
-		Message:       fmt.Sprintf("Published reconciliation for %d sites", len(sites)),
+		Message:       fmt.Sprintf("Published reconciliation for %d sites, deferred %d", len(sites)-deferred, deferred),
 		SitesAffected: len(sites),
 	}, nil
 }
 
+// ReleaseDeferredReconciliations publishes the deferred reconciliations of sites whose
+// maintenance window is open at now, or was removed. Rows that fail to publish stay
+// deferred and are retried on the next call.
+func (h *ActivityHandler) ReleaseDeferredReconciliations(ctx context.Context, now time.Time) error {
+	deferred, err := h.db.ListDeferredReconciliations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list deferred reconciliations: %w", err)
+	}
+
+	timestamp := now.UTC().Format(time.RFC3339)
+	for _, d := range deferred {
+		if d.Window != nil && !d.Window.Open(now) {
+			continue
+		}
+
+		req := SiteReconciliationRequest{
+			SitePublicID:    d.Site.PublicID,
+			ProjectPublicID: d.Site.ProjectPublicID,
+			OrgPublicID:     d.Site.OrgPublicID,
+			RequestType:     d.RequestType,
+			EventIDs:        d.EventIDs,
+			Timestamp:       timestamp,
+			IdempotencyKey:  IdempotencyKey(d.Site.PublicID, d.RequestType, d.EventIDs),
+		}
+		if err := h.publisher.PublishSiteReconciliation(ctx, req); err != nil {
+			slog.Error("Failed to publish deferred site reconciliation",
+				"site_public_id", d.Site.PublicID,
+				"request_type", d.RequestType,
+				"error", err)
+			continue
+		}
+
+		if err := h.db.DeleteDeferredReconciliations(ctx, d.Site.ID, d.RequestType); err != nil {
+			// The idempotency key makes the controller skip the repeat on the next call
+			slog.Error("Failed to delete released reconciliation",
+				"site_public_id", d.Site.PublicID,
+				"request_type", d.RequestType,
+				"error", err)
+			continue
+		}
+
+		slog.Info("Released deferred site reconciliation",
+			"site_public_id", d.Site.PublicID,
+			"request_type", d.RequestType,
+			"event_count", len(d.EventIDs))
+	}
+
+	return nil
+}
+
+// deferrable reports whether a reconciliation type waits for maintenance windows.
+// Only secrets and firewall changes restart services on the VM without a user asking for it.
+func deferrable(t workflows.ReconciliationType) bool {
+	return t == workflows.ReconcileSecrets || t == workflows.ReconcileFirewall
+}
+
+// coveredDeferrals returns the deferrable types a reconciliation also applies
+func coveredDeferrals(t workflows.ReconciliationType) []string {
+	switch t {
+	case workflows.ReconcileSecrets, workflows.ReconcileFirewall:
+		return []string{string(t)}
+	case workflows.ReconcileFull:
+		return []string{string(workflows.ReconcileSecrets), string(workflows.ReconcileFirewall)}
+	default:
+		return nil
+	}
+}
+
 func (h *ActivityHandler) getAffectedSites(ctx context.Context, input workflows.ReconciliationInput) ([]database.Site, error) {
 	switch input.Scope {
 	case workflows.ScopeOrg:
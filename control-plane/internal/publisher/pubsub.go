@@ -3,9 +3,12 @@ package publisher
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"cloud.google.com/go/pubsub"
 )
@@ -80,6 +83,7 @@ func (p *PubSubPublisher) PublishSiteReconciliation(ctx context.Context, req Sit
 			"org_public_id":     req.OrgPublicID,
 			"project_public_id": req.ProjectPublicID,
 			"request_type":      req.RequestType,
+			"idempotency_key":   req.IdempotencyKey,
 		},
 	})
 
@@ -93,6 +97,7 @@ func (p *PubSubPublisher) PublishSiteReconciliation(ctx context.Context, req Sit
 		"site_public_id", req.SitePublicID,
 		"request_type", req.RequestType,
 		"event_count", len(req.EventIDs),
+		"idempotency_key", req.IdempotencyKey,
 		"message_id", messageID)
 
 	return nil
@@ -116,4 +121,21 @@ type SiteReconciliationRequest struct {
 	RequestType     string   `json:"request_type"` // "ssh_keys", "secrets", "firewall", "full"
 	EventIDs        []string `json:"event_ids"`    // Original event IDs that triggered this
 	Timestamp       string   `json:"timestamp"`
+	IdempotencyKey  string   `json:"idempotency_key"`   // Same for every publish of the same events to the same site
+	Attempt         int      `json:"attempt,omitempty"` // Set on retries of a failed reconciliation, starting at 2
+}
+
+// IdempotencyKey identifies a site's reconciliation for a set of events, so a request
+// republished after the event router restarts, or redelivered by Pub/Sub, is recognized
+// by the site controller as one it already handled
+func IdempotencyKey(sitePublicID, requestType string, eventIDs []string) string {
+	sorted := slices.Clone(eventIDs)
+	slices.Sort(sorted)
+
+	h := sha256.New()
+	h.Write([]byte(sitePublicID + "\n" + requestType + "\n"))
+	for _, id := range sorted {
+		h.Write([]byte(id + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
 }
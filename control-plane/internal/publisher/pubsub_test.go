@@ -0,0 +1,20 @@
+package publisher
+
+import "testing"
+
+func TestIdempotencyKey(t *testing.T) {
+	key := IdempotencyKey("site-1", "secrets", []string{"evt-2", "evt-1"})
+
+	if got := IdempotencyKey("site-1", "secrets", []string{"evt-1", "evt-2"}); got != key {
+		t.Errorf("key depends on event order: %s != %s", got, key)
+	}
+	for _, other := range []string{
+		IdempotencyKey("site-2", "secrets", []string{"evt-1", "evt-2"}),
+		IdempotencyKey("site-1", "full", []string{"evt-1", "evt-2"}),
+		IdempotencyKey("site-1", "secrets", []string{"evt-1"}),
+	} {
+		if other == key {
+			t.Errorf("different requests share key %s", key)
+		}
+	}
+}
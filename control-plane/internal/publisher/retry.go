@@ -0,0 +1,111 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Retry states of failed reconciliations, as stored in reconciliations.retry_state
+const (
+	RetryScheduled  = "scheduled"
+	RetryRetried    = "retried"
+	RetrySuperseded = "superseded"
+	RetryExhausted  = "exhausted"
+)
+
+// RetryPolicy decides when failed site reconciliations are published again
+type RetryPolicy struct {
+	MaxAttempts int           // Including the first; a failure on the last one is dead-lettered
+	BaseDelay   time.Duration // Before the second attempt, doubling before each one after it
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy retries a failed reconciliation four times over about eight minutes,
+// well before the site controller's periodic reconciliation would pick the change up
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   30 * time.Second,
+	MaxDelay:    30 * time.Minute,
+}
+
+// Backoff returns how long to wait after a failed attempt before the next one
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// RetryFailedReconciliations works through failed site reconciliations recorded by the site
+// proxy or reported to the API. New failures are scheduled with exponential backoff, or
+// dead-lettered once out of attempts, and due retries are published again with the same
+// idempotency key. Failures a later reconciliation already covered are left alone.
+func (h *ActivityHandler) RetryFailedReconciliations(ctx context.Context, now time.Time) error {
+	failed, err := h.db.ListRetryableReconciliations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to list retryable reconciliations: %w", err)
+	}
+
+	timestamp := now.UTC().Format(time.RFC3339)
+	for _, f := range failed {
+		var state string
+		var nextRetryAt *time.Time
+		switch {
+		case f.Superseded:
+			state = RetrySuperseded
+		case !f.Scheduled && f.Attempt >= h.retry.MaxAttempts:
+			state = RetryExhausted
+			slog.Error("Site reconciliation failed on its last attempt, giving up",
+				"site_public_id", f.Site.PublicID,
+				"request_type", f.RequestType,
+				"run_id", f.RunID,
+				"attempts", f.Attempt)
+		case !f.Scheduled:
+			state = RetryScheduled
+			at := f.CompletedAt.Add(h.retry.Backoff(f.Attempt))
+			nextRetryAt = &at
+		default:
+			requestType := f.RequestType
+			if requestType == "general" {
+				requestType = "full"
+			}
+			req := SiteReconciliationRequest{
+				SitePublicID:    f.Site.PublicID,
+				ProjectPublicID: f.Site.ProjectPublicID,
+				OrgPublicID:     f.Site.OrgPublicID,
+				RequestType:     requestType,
+				EventIDs:        f.EventIDs,
+				Timestamp:       timestamp,
+				IdempotencyKey:  IdempotencyKey(f.Site.PublicID, requestType, f.EventIDs),
+				Attempt:         f.Attempt + 1,
+			}
+			if err := h.publisher.PublishSiteReconciliation(ctx, req); err != nil {
+				// Still scheduled, so it's published on the next call
+				slog.Error("Failed to publish site reconciliation retry",
+					"site_public_id", f.Site.PublicID,
+					"request_type", requestType,
+					"error", err)
+				continue
+			}
+			state = RetryRetried
+			slog.Info("Retried failed site reconciliation",
+				"site_public_id", f.Site.PublicID,
+				"request_type", requestType,
+				"attempt", req.Attempt)
+		}
+
+		if err := h.db.SetReconciliationRetry(ctx, f.ID, state, nextRetryAt); err != nil {
+			// A retried run is published again on the next call; the idempotency key makes
+			// the controller skip it if the first one succeeded
+			slog.Error("Failed to update reconciliation retry",
+				"run_id", f.RunID,
+				"retry_state", state,
+				"error", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package publisher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libops/control-plane/internal/database"
+)
+
+type retryDB struct {
+	DatabaseQuerier
+	failed []database.FailedReconciliation
+	states map[int64]string
+	next   map[int64]time.Time
+}
+
+func (d *retryDB) ListRetryableReconciliations(ctx context.Context, now time.Time) ([]database.FailedReconciliation, error) {
+	return d.failed, nil
+}
+
+func (d *retryDB) SetReconciliationRetry(ctx context.Context, id int64, state string, nextRetryAt *time.Time) error {
+	d.states[id] = state
+	if nextRetryAt != nil {
+		d.next[id] = *nextRetryAt
+	}
+	return nil
+}
+
+type recordingPublisher struct {
+	requests []SiteReconciliationRequest
+}
+
+func (p *recordingPublisher) PublishSiteReconciliation(ctx context.Context, req SiteReconciliationRequest) error {
+	p.requests = append(p.requests, req)
+	return nil
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 30 * time.Second, MaxDelay: 5 * time.Minute}
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, w := range want {
+		if got := policy.Backoff(i + 1); got != w {
+			t.Errorf("Backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestRetryFailedReconciliations(t *testing.T) {
+	now := time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC)
+	site := database.Site{ID: 5, PublicID: "site-1", ProjectPublicID: "project-1", OrgPublicID: "org-1"}
+	db := &retryDB{
+		failed: []database.FailedReconciliation{
+			{ID: 1, Site: site, RequestType: "secrets", EventIDs: []string{"evt-1"}, Attempt: 2, CompletedAt: now},
+			{ID: 2, Site: site, RequestType: "general", EventIDs: []string{"evt-2"}, Attempt: 2, Scheduled: true},
+			{ID: 3, Site: site, RequestType: "firewall", Attempt: 5, CompletedAt: now},
+			{ID: 4, Site: site, RequestType: "ssh_keys", Attempt: 1, Superseded: true},
+		},
+		states: map[int64]string{},
+		next:   map[int64]time.Time{},
+	}
+	pub := &recordingPublisher{}
+	h := NewActivityHandler(db, pub)
+
+	if err := h.RetryFailedReconciliations(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[int64]string{1: RetryScheduled, 2: RetryRetried, 3: RetryExhausted, 4: RetrySuperseded}
+	for id, state := range want {
+		if db.states[id] != state {
+			t.Errorf("reconciliation %d is %q, want %q", id, db.states[id], state)
+		}
+	}
+	if got := db.next[1]; !got.Equal(now.Add(time.Minute)) {
+		t.Errorf("second failure retries at %v, want a minute later", got)
+	}
+
+	if len(pub.requests) != 1 {
+		t.Fatalf("published %d retries, want 1", len(pub.requests))
+	}
+	req := pub.requests[0]
+	if req.RequestType != "full" || req.Attempt != 3 || req.SitePublicID != "site-1" {
+		t.Errorf("published %+v", req)
+	}
+	if req.IdempotencyKey != IdempotencyKey("site-1", "full", []string{"evt-2"}) {
+		t.Error("retries must keep the original idempotency key")
+	}
+}
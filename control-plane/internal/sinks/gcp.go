@@ -0,0 +1,115 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	pubsub "google.golang.org/api/pubsub/v1"
+	storage "google.golang.org/api/storage/v1"
+)
+
+// GCPPublisher writes to Pub/Sub and Cloud Storage by impersonating each organization's
+// platform service account, so a sink only reaches the topics and buckets its customer
+// granted that account. The event router's own identity needs
+// roles/iam.serviceAccountTokenCreator on the platform service accounts.
+type GCPPublisher struct {
+	mu      sync.Mutex
+	clients map[string]*gcpClients // By principal
+}
+
+type gcpClients struct {
+	pubsub  *pubsub.Service
+	storage *storage.Service
+}
+
+// Compile-time check.
+var _ Publisher = (*GCPPublisher)(nil)
+
+// NewGCPPublisher creates a publisher that impersonates with Application Default Credentials
+func NewGCPPublisher() *GCPPublisher {
+	return &GCPPublisher{clients: make(map[string]*gcpClients)}
+}
+
+// Publish publishes a message to a topic as principal
+func (p *GCPPublisher) Publish(ctx context.Context, principal, topic string, msg Message) error {
+	clients, err := p.clientsFor(principal)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.pubsub.Projects.Topics.Publish(topic, &pubsub.PublishRequest{
+		Messages: []*pubsub.PubsubMessage{{
+			Data:       base64.StdEncoding.EncodeToString(msg.Data),
+			Attributes: msg.Attributes,
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to publish to %s as %s: %w", topic, principal, err)
+	}
+	return nil
+}
+
+// Upload creates an object in a bucket as principal. Objects are never overwritten, so an
+// object left by an earlier attempt at the same event counts as delivered.
+func (p *GCPPublisher) Upload(ctx context.Context, principal, bucket string, msg Message) error {
+	clients, err := p.clientsFor(principal)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.storage.Objects.Insert(bucket, &storage.Object{
+		Name:        msg.Name,
+		ContentType: msg.ContentType,
+		Metadata:    msg.Attributes,
+	}).IfGenerationMatch(0).Media(bytes.NewReader(msg.Data)).Context(ctx).Do()
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upload %s to bucket %s as %s: %w", msg.Name, bucket, principal, err)
+	}
+	return nil
+}
+
+// clientsFor returns API clients authenticated as principal, reusing them so tokens are cached
+func (p *GCPPublisher) clientsFor(principal string) (*gcpClients, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if clients, ok := p.clients[principal]; ok {
+		return clients, nil
+	}
+
+	// Token sources outlive any one delivery, so they don't get its context
+	ctx := context.Background()
+	ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: principal,
+		Scopes:          []string{pubsub.PubsubScope, storage.DevstorageReadWriteScope},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to impersonate %s: %w", principal, err)
+	}
+
+	pubsubService, err := pubsub.NewService(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Pub/Sub client: %w", err)
+	}
+	storageService, err := storage.NewService(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Cloud Storage client: %w", err)
+	}
+
+	clients := &gcpClients{pubsub: pubsubService, storage: storageService}
+	p.clients[principal] = clients
+	return clients, nil
+}
@@ -0,0 +1,246 @@
+// Package sinks publishes an organization's events to the Pub/Sub topics and Cloud Storage
+// buckets it registered as event sinks, signed with each sink's secret so customers can
+// verify they came from LibOps.
+package sinks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/libops/control-plane/internal/database"
+	"github.com/libops/control-plane/internal/workflows"
+)
+
+// Sink kinds, matching event_sinks.kind
+const (
+	KindPubSubTopic   = "pubsub_topic"
+	KindStorageBucket = "storage_bucket"
+)
+
+// eventTypeSinkTest is delivered only to the sink named by the event subject
+const eventTypeSinkTest = "io.libops.event_sink.test.v1"
+
+// Attributes (or object metadata) carrying a message's signature
+const (
+	SignatureAttribute = "libops-signature"
+	specVersion        = "1.0"
+)
+
+// maxErrorLength bounds a sink's recorded last error
+const maxErrorLength = 1000
+
+// Store is the database access the dispatcher needs; *database.Querier implements it
+type Store interface {
+	GetEnabledEventSinks(ctx context.Context, orgID int64) ([]database.EventSink, error)
+	GetEventSink(ctx context.Context, orgID int64, publicID string) (*database.EventSink, error)
+	RecordEventSinkDelivery(ctx context.Context, sinkID int64, deliveryError string) error
+	GetAlertResource(ctx context.Context, orgID int64, projectID, siteID *int64) (*database.AlertResource, error)
+}
+
+// Compile-time check.
+var _ Store = (*database.Querier)(nil)
+
+// Message is what gets written to a sink: a Pub/Sub message, or an object for buckets
+type Message struct {
+	Name        string // Object name, only used for buckets
+	ContentType string
+	Data        []byte
+	Attributes  map[string]string // Pub/Sub attributes or object metadata
+}
+
+// Publisher writes messages to topics and buckets as a service account
+type Publisher interface {
+	Publish(ctx context.Context, principal, topic string, msg Message) error
+	Upload(ctx context.Context, principal, bucket string, msg Message) error
+}
+
+// Dispatcher publishes events to the organization's matching sinks
+type Dispatcher struct {
+	store     Store
+	publisher Publisher
+	now       func() time.Time
+}
+
+// NewDispatcher creates a dispatcher that writes through publisher
+func NewDispatcher(store Store, publisher Publisher) *Dispatcher {
+	return &Dispatcher{
+		store:     store,
+		publisher: publisher,
+		now:       time.Now,
+	}
+}
+
+// Dispatch publishes an event to every enabled sink of its organization whose event types match.
+// Sink test events go only to the sink named by the event subject.
+// Delivery failures are recorded on the sink rather than returned.
+func (d *Dispatcher) Dispatch(ctx context.Context, event workflows.Event) error {
+	if event.OrganizationID == 0 {
+		return nil
+	}
+
+	var sinks []database.EventSink
+	if event.EventType == eventTypeSinkTest {
+		sink, err := d.store.GetEventSink(ctx, event.OrganizationID, event.EventSubject)
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, *sink)
+	} else {
+		enabled, err := d.store.GetEnabledEventSinks(ctx, event.OrganizationID)
+		if err != nil {
+			return err
+		}
+		for _, sink := range enabled {
+			if sink.Matches(event.EventType) {
+				sinks = append(sinks, sink)
+			}
+		}
+	}
+
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	resource, err := d.store.GetAlertResource(ctx, event.OrganizationID, event.ProjectID, event.SiteID)
+	if err != nil {
+		return err
+	}
+
+	for _, sink := range sinks {
+		deliveryError := ""
+		if err := d.send(ctx, sink, event, resource); err != nil {
+			slog.Warn("Failed to publish event to sink",
+				"event_id", event.EventID,
+				"sink_id", sink.PublicID,
+				"kind", sink.Kind,
+				"error", err)
+			deliveryError = truncate(err.Error(), maxErrorLength)
+		}
+		if err := d.store.RecordEventSinkDelivery(ctx, sink.ID, deliveryError); err != nil {
+			slog.Error("Failed to record event sink delivery", "sink_id", sink.PublicID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, sink database.EventSink, event workflows.Event, resource *database.AlertResource) error {
+	if sink.GCPProjectID == "" {
+		return fmt.Errorf("the organization's GCP project is not provisioned yet")
+	}
+	principal := PublisherPrincipal(sink.GCPProjectID)
+	timestamp := d.now()
+
+	switch sink.Kind {
+	case KindPubSubTopic:
+		return d.publisher.Publish(ctx, principal, sink.Destination, PubSubMessage(sink.SigningSecret, event, resource, timestamp))
+	case KindStorageBucket:
+		msg, err := StorageObject(sink.SigningSecret, event, resource, timestamp)
+		if err != nil {
+			return err
+		}
+		return d.publisher.Upload(ctx, principal, sink.Destination, msg)
+	default:
+		return fmt.Errorf("unsupported event sink kind %q", sink.Kind)
+	}
+}
+
+// PublisherPrincipal is the organization platform service account sinks are written as.
+// It matches the API's gcp.GetPlatformServiceAccountEmail.
+func PublisherPrincipal(projectID string) string {
+	return "libops-platform@" + projectID + ".iam.gserviceaccount.com"
+}
+
+// PubSubMessage builds a CloudEvents Pub/Sub binary mode message: the event data is the
+// message data and the context attributes are ce- prefixed message attributes.
+func PubSubMessage(secret string, event workflows.Event, resource *database.AlertResource, timestamp time.Time) Message {
+	attributes := map[string]string{
+		"ce-specversion": specVersion,
+		"ce-id":          event.EventID,
+		"ce-source":      event.EventSource,
+		"ce-type":        event.EventType,
+		"ce-time":        event.CreatedAt.UTC().Format(time.RFC3339),
+		"content-type":   event.ContentType,
+	}
+	for name, value := range extensions(event, resource) {
+		attributes["ce-"+name] = value
+	}
+	attributes[SignatureAttribute] = Sign(secret, event.EventID, timestamp, event.EventData)
+
+	return Message{
+		ContentType: event.ContentType,
+		Data:        event.EventData,
+		Attributes:  attributes,
+	}
+}
+
+// StorageObject builds a CloudEvents JSON object named events/YYYY/MM/DD/<event id>.json,
+// with the signature of its content in the object's metadata.
+func StorageObject(secret string, event workflows.Event, resource *database.AlertResource, timestamp time.Time) (Message, error) {
+	envelope := map[string]string{
+		"specversion":     specVersion,
+		"id":              event.EventID,
+		"source":          event.EventSource,
+		"type":            event.EventType,
+		"time":            event.CreatedAt.UTC().Format(time.RFC3339),
+		"datacontenttype": event.ContentType,
+		"data_base64":     base64.StdEncoding.EncodeToString(event.EventData),
+	}
+	for name, value := range extensions(event, resource) {
+		envelope[name] = value
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return Message{
+		Name:        "events/" + event.CreatedAt.UTC().Format("2006/01/02") + "/" + event.EventID + ".json",
+		ContentType: "application/cloudevents+json",
+		Data:        data,
+		Attributes:  map[string]string{SignatureAttribute: Sign(secret, event.EventID, timestamp, data)},
+	}, nil
+}
+
+// extensions are the event's subject and the public IDs of the resources it is scoped to.
+// CloudEvents extension names are lowercase alphanumerics.
+func extensions(event workflows.Event, resource *database.AlertResource) map[string]string {
+	values := map[string]string{
+		"subject":              event.EventSubject,
+		"libopsorganizationid": resource.OrgPublicID,
+		"libopsprojectid":      resource.ProjectPublicID,
+		"libopssiteid":         resource.SitePublicID,
+	}
+	for name, value := range values {
+		if value == "" {
+			delete(values, name)
+		}
+	}
+	return values
+}
+
+// Sign returns a payload's signature as "t=<unix time>,v1=<hex HMAC-SHA256>", where the HMAC
+// covers "<event id>.<unix time>.<payload>" so a captured message can't be replayed as another
+// event or passed off as recent.
+func Sign(secret, eventID string, timestamp time.Time, payload []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(eventID + "." + unix + "."))
+	mac.Write(payload)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "…"
+}
@@ -3,26 +3,41 @@ package siteproxy
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/control-plane/internal/database"
 )
 
+// errReconcileInProgress is returned while the site controller is running a request with the same idempotency key
+var errReconcileInProgress = errors.New("site controller is already running this reconciliation")
+
+// Recorder stores the outcome of reconciliations forwarded to site controllers
+type Recorder interface {
+	RecordSiteReconciliation(ctx context.Context, run database.SiteReconciliationRun) error
+}
+
 // Proxy handles Pub/Sub push notifications and fans out to site controllers
 type Proxy struct {
 	apiURL     string
 	httpClient *http.Client
+	recorder   Recorder
 }
 
-// NewProxy creates a new site proxy
-func NewProxy(apiURL string) *Proxy {
+// NewProxy creates a new site proxy. A nil recorder skips recording reconciliation outcomes.
+func NewProxy(apiURL string, recorder Recorder) *Proxy {
 	return &Proxy{
 		apiURL: apiURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		recorder: recorder,
 	}
 }
 
@@ -45,6 +60,8 @@ type SiteReconciliationRequest struct {
 	RequestType     string   `json:"request_type"` // "ssh_keys", "secrets", "firewall", "deployment", "full"
 	EventIDs        []string `json:"event_ids"`
 	Timestamp       string   `json:"timestamp"`
+	IdempotencyKey  string   `json:"idempotency_key"`
+	Attempt         int      `json:"attempt,omitempty"` // Set by the event router on retries
 }
 
 // Site represents minimal site information needed for routing
@@ -90,7 +107,8 @@ func (p *Proxy) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
 		"message_id", pubsubMsg.Message.MessageID,
 		"site_public_id", req.SitePublicID,
 		"request_type", req.RequestType,
-		"event_count", len(req.EventIDs))
+		"event_count", len(req.EventIDs),
+		"idempotency_key", req.IdempotencyKey)
 
 	// Get site details from API (including external IP)
 	site, err := p.getSiteDetails(ctx, req.SitePublicID)
@@ -109,11 +127,21 @@ func (p *Proxy) HandlePubSubPush(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Fan out to site controller
-	if err := p.callSiteController(ctx, site, req); err != nil {
+	startedAt := time.Now()
+	err = p.callSiteController(ctx, site, req)
+	recorded := p.record(ctx, site, req, startedAt, err)
+	if err != nil {
 		slog.Error("Failed to call site controller",
 			"site_public_id", req.SitePublicID,
 			"external_ip", site.GCPExternalIP,
+			"attempt", max(req.Attempt, 1),
 			"error", err)
+		if recorded {
+			// The event router retries recorded failures with backoff, so ack the message
+			// rather than have Pub/Sub redeliver it as well
+			w.WriteHeader(http.StatusOK)
+			return
+		}
 		// Return 500 to trigger Pub/Sub retry
 		http.Error(w, fmt.Sprintf("Failed to notify site: %v", err), http.StatusInternalServerError)
 		return
@@ -194,6 +222,10 @@ func (p *Proxy) callSiteController(ctx context.Context, site *Site, req SiteReco
 	httpReq.Header.Set("Content-Type", "application/json")
 	httpReq.Header.Set("X-Event-IDs", fmt.Sprintf("%v", req.EventIDs))
 	httpReq.Header.Set("X-Request-Type", req.RequestType)
+	if req.IdempotencyKey != "" {
+		// The controller skips keys it already reconciled, so redeliveries don't restart containers twice
+		httpReq.Header.Set("Idempotency-Key", req.IdempotencyKey)
+	}
 
 	resp, err := p.httpClient.Do(httpReq)
 	if err != nil {
@@ -201,6 +233,10 @@ func (p *Proxy) callSiteController(ctx context.Context, site *Site, req SiteReco
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusConflict {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: %s", errReconcileInProgress, string(body))
+	}
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("site controller returned status %d: %s", resp.StatusCode, string(body))
@@ -212,3 +248,43 @@ func (p *Proxy) callSiteController(ctx context.Context, site *Site, req SiteReco
 
 	return nil
 }
+
+// record stores a controller call's outcome for the site's reconciliation timeline and
+// reports whether it did. Calls rejected because the same request is already running are
+// left to that run. Deployments are tracked by the API and aren't recorded here.
+func (p *Proxy) record(ctx context.Context, site *Site, req SiteReconciliationRequest, startedAt time.Time, callErr error) bool {
+	if p.recorder == nil || errors.Is(callErr, errReconcileInProgress) {
+		return false
+	}
+
+	var requestType string
+	switch req.RequestType {
+	case "ssh_keys", "secrets", "firewall":
+		requestType = req.RequestType
+	case "full":
+		requestType = "general"
+	default:
+		return false
+	}
+
+	run := database.SiteReconciliationRun{
+		RunID:       uuid.NewString(),
+		SiteID:      site.ID,
+		RequestType: requestType,
+		EventIDs:    req.EventIDs,
+		StartedAt:   startedAt,
+		CompletedAt: time.Now(),
+		Attempt:     max(req.Attempt, 1),
+	}
+	if callErr != nil {
+		run.Error = callErr.Error()
+	}
+	if err := p.recorder.RecordSiteReconciliation(ctx, run); err != nil {
+		slog.Error("Failed to record site reconciliation",
+			"site_public_id", site.PublicID,
+			"request_type", requestType,
+			"error", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package siteproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libops/control-plane/internal/database"
+)
+
+type fakeRecorder struct {
+	runs []database.SiteReconciliationRun
+	err  error
+}
+
+func (f *fakeRecorder) RecordSiteReconciliation(ctx context.Context, run database.SiteReconciliationRun) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.runs = append(f.runs, run)
+	return nil
+}
+
+func TestRecord(t *testing.T) {
+	site := &Site{ID: 5, PublicID: "site-1"}
+	startedAt := time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name        string
+		requestType string
+		attempt     int
+		err         error
+		wantType    string // Empty when nothing is recorded
+		wantErr     string
+		wantAttempt int
+	}{
+		{name: "succeeded", requestType: "firewall", wantType: "firewall", wantAttempt: 1},
+		{name: "failed", requestType: "secrets", err: errors.New("site controller returned status 500: boom"), wantType: "secrets", wantErr: "site controller returned status 500: boom", wantAttempt: 1},
+		{name: "retry", requestType: "secrets", attempt: 3, err: errors.New("site controller returned status 500: boom"), wantType: "secrets", wantErr: "site controller returned status 500: boom", wantAttempt: 3},
+		{name: "full", requestType: "full", wantType: "general", wantAttempt: 1},
+		{name: "deployment", requestType: "deployment"},
+		{name: "in progress", requestType: "ssh_keys", err: fmt.Errorf("%w: busy", errReconcileInProgress)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &fakeRecorder{}
+			p := NewProxy("http://api", recorder)
+			recorded := p.record(context.Background(), site, SiteReconciliationRequest{RequestType: tt.requestType, EventIDs: []string{"evt-1"}, Attempt: tt.attempt}, startedAt, tt.err)
+			if recorded != (tt.wantType != "") {
+				t.Errorf("record() = %v", recorded)
+			}
+
+			if tt.wantType == "" {
+				if len(recorder.runs) != 0 {
+					t.Fatalf("recorded %v, want nothing", recorder.runs)
+				}
+				return
+			}
+			if len(recorder.runs) != 1 {
+				t.Fatalf("recorded %d runs, want 1", len(recorder.runs))
+			}
+			run := recorder.runs[0]
+			if run.RequestType != tt.wantType || run.Error != tt.wantErr || run.Attempt != tt.wantAttempt || run.SiteID != 5 || run.RunID == "" {
+				t.Errorf("recorded %+v", run)
+			}
+			if run.CompletedAt.Before(run.StartedAt) {
+				t.Errorf("completed %v before started %v", run.CompletedAt, run.StartedAt)
+			}
+		})
+	}
+}
+
+// TestRecordFailure tests that failures the proxy couldn't record are left to Pub/Sub to retry
+func TestRecordFailure(t *testing.T) {
+	p := NewProxy("http://api", &fakeRecorder{err: errors.New("database is down")})
+	if p.record(context.Background(), &Site{ID: 5}, SiteReconciliationRequest{RequestType: "secrets"}, time.Now(), errors.New("boom")) {
+		t.Error("record() = true for a run that wasn't stored")
+	}
+}
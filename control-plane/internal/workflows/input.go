@@ -9,6 +9,8 @@ type ReconciliationInput struct {
 	EventTypes         []string
 	Scope              EventScope
 	ReconciliationType ReconciliationType
+	// Urgent skips the sites' maintenance windows; set when any event was queued urgent
+	Urgent bool
 }
 
 // ReconciliationResult is the result of the PublishSiteReconciliation activity
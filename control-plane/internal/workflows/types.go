@@ -1,6 +1,10 @@
 package workflows
 
-import "time"
+import (
+	"time"
+
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+)
 
 // Event represents a control plane event from the event_queue table
 type Event struct {
@@ -14,6 +18,9 @@ type Event struct {
 	ProjectID      *int64
 	SiteID         *int64
 	CreatedAt      time.Time
+
+	// Envelope is EventData decoded; the event router only dispatches events whose envelope is valid
+	Envelope *eventsv1.Envelope
 }
 
 // EventScope determines the scope of reconciliation needed
@@ -78,6 +85,35 @@ func DetermineScope(event Event) EventScope {
 	return ScopeOrg
 }
 
+// IsNotificationEvent reports whether an event only notifies users (e.g. billing,
+// deployment outcomes, downtime incidents or channel and sink tests) and never requires reconciliation.
+// Reconciliation outcome events must stay here so a failed run cannot trigger another.
+func IsNotificationEvent(eventType string) bool {
+	notificationEvents := []string{
+		"io.libops.billing.",
+		"io.libops.deployment.",
+		"io.libops.reconciliation.",
+		"io.libops.notification_channel.",
+		"io.libops.event_sink.",
+		"io.libops.incident.",
+		// Pending and rejected relationships don't grant any access
+		"io.libops.relationship.created.",
+		"io.libops.relationship.rejected.",
+		// Nor do pending and rejected elevation requests, which alert site owners
+		"io.libops.site.elevation.requested.",
+		"io.libops.site.elevation.rejected.",
+		// Policies only gate the API's own checks
+		"io.libops.organization.policy.",
+	}
+
+	for _, prefix := range notificationEvents {
+		if len(eventType) >= len(prefix) && eventType[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
 func isOrgLevelEvent(eventType string) bool {
 	orgEvents := []string{
 		"io.libops.organization.created",
@@ -108,6 +144,7 @@ func isProjectLevelEvent(eventType string) bool {
 		"io.libops.project_firewall.deleted",
 		"io.libops.project_member.created",
 		"io.libops.project_member.removed",
+		"io.libops.project.setting.",
 	}
 
 	for _, prefix := range projectEvents {
@@ -142,16 +179,30 @@ func DetermineReconciliationType(eventTypes []string) ReconciliationType {
 
 	for _, eventType := range eventTypes {
 		switch {
-		// Member events → SSH key reconciliation
+		// Member, relationship and elevation events → SSH key reconciliation
 		case contains(eventType, "member.created"),
 			contains(eventType, "member.removed"),
-			contains(eventType, "member.updated"):
+			contains(eventType, "member.updated"),
+			contains(eventType, "ssh_access.granted.v1"),
+			contains(eventType, "ssh_access.updated.v1"),
+			contains(eventType, "ssh_access.revoked.v1"),
+			contains(eventType, "relationship.approved.v1"),
+			contains(eventType, "relationship.updated.v1"),
+			contains(eventType, "relationship.revoked.v1"),
+			contains(eventType, "elevation.approved.v1"),
+			contains(eventType, "elevation.revoked.v1"),
+			contains(eventType, "elevation.expired.v1"):
 			hasSSHKeys = true
 
-		// Secret events → Secrets reconciliation
+		// Secret, config var and setting events → Secrets reconciliation
 		case contains(eventType, "secret.created"),
 			contains(eventType, "secret.updated"),
-			contains(eventType, "secret.deleted"):
+			contains(eventType, "secret.deleted"),
+			contains(eventType, "config_var.set.v1"),
+			contains(eventType, "config_var.deleted.v1"),
+			contains(eventType, "setting.created.v1"),
+			contains(eventType, "setting.updated.v1"),
+			contains(eventType, "setting.deleted.v1"):
 			hasSecrets = true
 
 		// Firewall events → Firewall reconciliation
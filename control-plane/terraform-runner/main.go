@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -24,6 +25,10 @@ type Config struct {
 	// API audience for JWT authentication
 	APIAudience string
 
+	// Public ID of the organization this runner belongs to, used to
+	// discover its Private Service Connect endpoint for the API
+	OrganizationID string
+
 	// Run ID to execute
 	RunID string
 
@@ -42,29 +47,51 @@ type Config struct {
 
 // ReconciliationRun represents a terraform run
 type ReconciliationRun struct {
-	RunID              string   `json:"run_id"`
-	RunType            string   `json:"run_type"`
-	ReconciliationType *string  `json:"reconciliation_type,omitempty"`
-	Modules            []string `json:"modules"`
-	TargetSiteIDs      []string `json:"target_site_ids"`
-	EventIDs           []string `json:"event_ids"`
-	OrganizationID     *int64   `json:"organization_id,omitempty"`
-	ProjectID          *int64   `json:"project_id,omitempty"`
-	SiteID             *int64   `json:"site_id,omitempty"`
-	Status             string   `json:"status"`
+	RunID              string      `json:"run_id"`
+	RunType            string      `json:"run_type"`
+	ReconciliationType *string     `json:"reconciliation_type,omitempty"`
+	Modules            []string    `json:"modules"`
+	TargetSiteIDs      []string    `json:"target_site_ids"`
+	EventIDs           []string    `json:"event_ids"`
+	OrganizationID     *int64      `json:"organization_id,omitempty"`
+	ProjectID          *int64      `json:"project_id,omitempty"`
+	SiteID             *int64      `json:"site_id,omitempty"`
+	Status             string      `json:"status"`
+	Workspaces         []Workspace `json:"workspaces,omitempty"`
+}
+
+// Workspace is one terraform state a run plans and applies
+type Workspace struct {
+	StatePrefix    string   `json:"state_prefix"`
+	OrganizationID *int64   `json:"organization_id,omitempty"`
+	ProjectID      *int64   `json:"project_id,omitempty"`
+	SiteID         *int64   `json:"site_id,omitempty"`
+	Isolated       bool     `json:"isolated,omitempty"`
+	Targets        []string `json:"targets,omitempty"`
+	// Address of the module a state migration moves into this workspace
+	Address string `json:"address,omitempty"`
 }
 
+// organizationStatePrefix is the backend prefix of the organization's
+// monolithic state, and of its own resources once it's isolated
+const organizationStatePrefix = "terraform/state"
+
+// migrationModule is the module of a run that splits the monolithic state
+// into its workspaces
+const migrationModule = "state_migration"
+
 // TerraformVarsResponse from API
 type TerraformVarsResponse struct {
 	TfvarsJSON string `json:"tfvars_json"`
 }
 
 func main() {
-	// Setup structured logging
+	// Setup structured logging. Every entry carries the run ID, which the admin
+	// API's log filter for a run matches on.
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}))
-	slog.SetDefault(logger)
+	slog.SetDefault(logger.With("run_id", os.Getenv("RUN_ID")))
 
 	slog.Info("starting terraform runner")
 
@@ -81,6 +108,10 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 55*time.Minute)
 	defer cancel()
 
+	if config.APIURL == "" {
+		config.APIURL = resolveAPIURL(ctx, config)
+	}
+
 	// Run terraform
 	if err := runTerraform(ctx, config); err != nil {
 		slog.Error("terraform execution failed", "error", err)
@@ -92,16 +123,8 @@ func main() {
 
 // loadConfig loads configuration from environment variables
 func loadConfig() *Config {
+	// An explicit URL wins; otherwise it's discovered from the API at startup
 	apiURL := os.Getenv("LIBOPS_API_URL")
-	if apiURL == "" {
-		// Try PSC endpoint
-		pscIP := os.Getenv("LIBOPS_PSC_IP")
-		if pscIP != "" {
-			apiURL = fmt.Sprintf("https://%s", pscIP)
-		} else {
-			apiURL = "https://psc-api.libops.internal"
-		}
-	}
 
 	apiAudience := os.Getenv("LIBOPS_API_AUDIENCE")
 	if apiAudience == "" {
@@ -121,6 +144,7 @@ func loadConfig() *Config {
 	return &Config{
 		APIURL:         apiURL,
 		APIAudience:    apiAudience,
+		OrganizationID: os.Getenv("LIBOPS_ORGANIZATION_ID"),
 		RunID:          os.Getenv("RUN_ID"),
 		WorkspaceDir:   workspaceDir,
 		StateBucket:    stateBucket,
@@ -134,9 +158,6 @@ func validateConfig(config *Config) error {
 	if config.RunID == "" {
 		return fmt.Errorf("RUN_ID environment variable is required")
 	}
-	if config.APIURL == "" {
-		return fmt.Errorf("LIBOPS_API_URL or LIBOPS_PSC_IP must be set")
-	}
 	if config.StateBucket == "" {
 		return fmt.Errorf("TERRAFORM_STATE_BUCKET is required")
 	}
@@ -163,26 +184,16 @@ func runTerraform(ctx context.Context, config *Config) error {
 		"modules", run.Modules,
 		"bootstrap", config.Bootstrap)
 
-	// 3. Generate terraform vars
-	tfvarsJSON, err := generateTerraformVars(ctx, config, run)
-	if err != nil {
-		updateStatus(ctx, config, "failed", err)
-		return fmt.Errorf("failed to generate terraform vars: %w", err)
-	}
-
-	// 4. Write tfvars to file
-	tfvarsPath := filepath.Join(config.WorkspaceDir, "terraform.tfvars.json")
-	if err := os.WriteFile(tfvarsPath, []byte(tfvarsJSON), 0644); err != nil {
-		updateStatus(ctx, config, "failed", err)
-		return fmt.Errorf("failed to write tfvars file: %w", err)
-	}
-
-	slog.Info("wrote terraform vars", "path", tfvarsPath)
-
 	if config.Bootstrap {
 		// Bootstrap flow
 		slog.Info("starting bootstrap flow")
 
+		workspace := legacyWorkspace(run)
+		if err := writeTerraformVars(ctx, config, workspace); err != nil {
+			updateStatus(ctx, config, "failed", err)
+			return err
+		}
+
 		// Disable GCS backend to use local state initially
 		if err := disableBackend(config.WorkspaceDir); err != nil {
 			updateStatus(ctx, config, "failed", err)
@@ -190,13 +201,13 @@ func runTerraform(ctx context.Context, config *Config) error {
 		}
 
 		// Init without backend config (local)
-		if err := terraformInit(ctx, config, false); err != nil {
+		if err := terraformInit(ctx, config, ""); err != nil {
 			updateStatus(ctx, config, "failed", err)
 			return fmt.Errorf("terraform init (local) failed: %w", err)
 		}
 
 		// Plan
-		if err := terraformPlan(ctx, config, run); err != nil {
+		if err := terraformPlan(ctx, config, workspace.Targets); err != nil {
 			updateStatus(ctx, config, "failed", err)
 			return fmt.Errorf("terraform plan (local) failed: %w", err)
 		}
@@ -215,30 +226,35 @@ func runTerraform(ctx context.Context, config *Config) error {
 
 		// Init with migration to GCS
 		slog.Info("migrating state to GCS bucket")
-		if err := terraformInit(ctx, config, true, "-migrate-state", "-force-copy"); err != nil {
+		if err := terraformInit(ctx, config, organizationStatePrefix, "-migrate-state", "-force-copy"); err != nil {
 			updateStatus(ctx, config, "failed", err)
 			return fmt.Errorf("terraform init (migrate) failed: %w", err)
 		}
 
-	} else {
-		// Standard flow
-
-		// 5. Initialize terraform
-		if err := terraformInit(ctx, config, true); err != nil {
+		if err := reportOutputs(ctx, config, run); err != nil {
 			updateStatus(ctx, config, "failed", err)
-			return fmt.Errorf("terraform init failed: %w", err)
+			return err
 		}
 
-		// 6. Run terraform plan
-		if err := terraformPlan(ctx, config, run); err != nil {
+	} else if slices.Contains(run.Modules, migrationModule) {
+		// Split the monolithic state into a workspace per project and site
+		if err := migrateState(ctx, config, run); err != nil {
 			updateStatus(ctx, config, "failed", err)
-			return fmt.Errorf("terraform plan failed: %w", err)
+			return fmt.Errorf("state migration failed: %w", err)
 		}
 
-		// 7. Run terraform apply
-		if err := terraformApply(ctx, config, run); err != nil {
-			updateStatus(ctx, config, "failed", err)
-			return fmt.Errorf("terraform apply failed: %w", err)
+	} else {
+		// Standard flow: plan and apply each workspace in turn. An API that
+		// doesn't list workspaces gets the organization's single state.
+		workspaces := run.Workspaces
+		if len(workspaces) == 0 {
+			workspaces = []Workspace{legacyWorkspace(run)}
+		}
+		for _, workspace := range workspaces {
+			if err := applyWorkspace(ctx, config, run, workspace); err != nil {
+				updateStatus(ctx, config, "failed", err)
+				return fmt.Errorf("workspace %s: %w", workspace.StatePrefix, err)
+			}
 		}
 	}
 
@@ -274,8 +290,166 @@ func fetchReconciliationRun(ctx context.Context, config *Config) (*Reconciliatio
 	return &run, nil
 }
 
-// generateTerraformVars generates terraform vars from API
-func generateTerraformVars(ctx context.Context, config *Config, run *ReconciliationRun) (string, error) {
+// legacyWorkspace is the organization's single state, targeted at the run's
+// modules, for APIs that don't list a run's workspaces
+func legacyWorkspace(run *ReconciliationRun) Workspace {
+	workspace := Workspace{
+		StatePrefix:    organizationStatePrefix,
+		OrganizationID: run.OrganizationID,
+		ProjectID:      run.ProjectID,
+		SiteID:         run.SiteID,
+	}
+	for _, module := range run.Modules {
+		switch module {
+		case "organization":
+			if run.OrganizationID != nil {
+				workspace.Targets = append(workspace.Targets, fmt.Sprintf("module.organizations[%d]", *run.OrganizationID))
+			}
+		case "project":
+			if run.ProjectID != nil {
+				workspace.Targets = append(workspace.Targets, fmt.Sprintf("module.projects[%d]", *run.ProjectID))
+			}
+		case "site":
+			if run.SiteID != nil {
+				workspace.Targets = append(workspace.Targets, fmt.Sprintf("module.sites[%d]", *run.SiteID))
+			}
+		}
+	}
+	return workspace
+}
+
+// applyWorkspace plans and applies one workspace, then reports what terraform
+// assigned in it back to the API
+func applyWorkspace(ctx context.Context, config *Config, run *ReconciliationRun, workspace Workspace) error {
+	slog.Info("applying workspace", "state_prefix", workspace.StatePrefix, "isolated", workspace.Isolated, "targets", workspace.Targets)
+
+	if err := writeTerraformVars(ctx, config, workspace); err != nil {
+		return err
+	}
+	if err := terraformInit(ctx, config, workspace.StatePrefix, "-reconfigure"); err != nil {
+		return err
+	}
+	if err := terraformPlan(ctx, config, workspace.Targets); err != nil {
+		return err
+	}
+	if err := terraformApply(ctx, config, run); err != nil {
+		return err
+	}
+	return reportOutputs(ctx, config, run)
+}
+
+// reportOutputs sends what terraform assigned and ran in the current
+// workspace back to the API so it can show it
+func reportOutputs(ctx context.Context, config *Config, run *ReconciliationRun) error {
+	for _, module := range run.Modules {
+		switch module {
+		case "organization":
+			if err := reportPrivateServiceConnectEndpoints(ctx, config); err != nil {
+				return fmt.Errorf("failed to report private service connect endpoints: %w", err)
+			}
+		case "site":
+			if err := reportSites(ctx, config); err != nil {
+				return fmt.Errorf("failed to report sites: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeTerraformVars generates a workspace's terraform vars and writes them
+// where terraform loads them
+func writeTerraformVars(ctx context.Context, config *Config, workspace Workspace) error {
+	tfvarsJSON, err := generateTerraformVars(ctx, config, workspace)
+	if err != nil {
+		return fmt.Errorf("failed to generate terraform vars: %w", err)
+	}
+
+	tfvarsPath := filepath.Join(config.WorkspaceDir, "terraform.tfvars.json")
+	if err := os.WriteFile(tfvarsPath, []byte(tfvarsJSON), 0644); err != nil {
+		return fmt.Errorf("failed to write tfvars file: %w", err)
+	}
+
+	slog.Info("wrote terraform vars", "path", tfvarsPath)
+	return nil
+}
+
+// migrateState moves each workspace's module out of the organization's
+// monolithic state into a state of its own under the workspace's prefix.
+// The local copies are edited in an empty directory so terraform treats them
+// as plain state files rather than the configured backend.
+func migrateState(ctx context.Context, config *Config, run *ReconciliationRun) error {
+	scratch, err := os.MkdirTemp("", "state-migration")
+	if err != nil {
+		return fmt.Errorf("failed to create scratch directory: %w", err)
+	}
+	defer os.RemoveAll(scratch)
+
+	if err := terraformInit(ctx, config, organizationStatePrefix, "-reconfigure"); err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, "terraform", "state", "pull")
+	cmd.Dir = config.WorkspaceDir
+	cmd.Stderr = os.Stderr
+	state, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("terraform state pull failed: %w", err)
+	}
+	monolithic := filepath.Join(scratch, "monolithic.tfstate")
+	if err := os.WriteFile(monolithic, state, 0600); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+
+	for _, workspace := range run.Workspaces {
+		cmd := exec.CommandContext(ctx, "terraform", "state", "list", "-state="+monolithic, workspace.Address)
+		cmd.Dir = scratch
+		cmd.Stderr = os.Stderr
+		resources, err := cmd.Output()
+		if err != nil {
+			return fmt.Errorf("terraform state list failed: %w", err)
+		}
+		if strings.TrimSpace(string(resources)) == "" {
+			slog.Info("no resources to migrate", "address", workspace.Address)
+			continue
+		}
+
+		slog.Info("migrating workspace state", "address", workspace.Address, "state_prefix", workspace.StatePrefix)
+		moved := filepath.Join(scratch, "workspace.tfstate")
+		if err := os.RemoveAll(moved); err != nil {
+			return err
+		}
+		if err := terraform(ctx, scratch, "state", "mv", "-state="+monolithic, "-state-out="+moved, workspace.Address, workspace.Address); err != nil {
+			return err
+		}
+		if err := terraformInit(ctx, config, workspace.StatePrefix, "-reconfigure"); err != nil {
+			return err
+		}
+		if err := terraform(ctx, config.WorkspaceDir, "state", "push", "-force", moved); err != nil {
+			return err
+		}
+	}
+
+	// Write back what's left: the organization's own resources
+	if err := terraformInit(ctx, config, organizationStatePrefix, "-reconfigure"); err != nil {
+		return err
+	}
+	return terraform(ctx, config.WorkspaceDir, "state", "push", monolithic)
+}
+
+// terraform runs a terraform command in dir
+func terraform(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "terraform", args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("terraform %s failed: %w", args[0], err)
+	}
+	return nil
+}
+
+// generateTerraformVars generates a workspace's terraform vars from API
+func generateTerraformVars(ctx context.Context, config *Config, workspace Workspace) (string, error) {
 	url := fmt.Sprintf("%s/admin/v1/reconciliations/terraform-vars", config.APIURL)
 
 	token, err := getIDToken(ctx, config.APIAudience)
@@ -285,14 +459,17 @@ func generateTerraformVars(ctx context.Context, config *Config, run *Reconciliat
 
 	// Build request body
 	reqBody := map[string]interface{}{}
-	if run.OrganizationID != nil {
-		reqBody["organization_id"] = *run.OrganizationID
+	if workspace.OrganizationID != nil {
+		reqBody["organization_id"] = *workspace.OrganizationID
 	}
-	if run.ProjectID != nil {
-		reqBody["project_id"] = *run.ProjectID
+	if workspace.ProjectID != nil {
+		reqBody["project_id"] = *workspace.ProjectID
 	}
-	if run.SiteID != nil {
-		reqBody["site_id"] = *run.SiteID
+	if workspace.SiteID != nil {
+		reqBody["site_id"] = *workspace.SiteID
+	}
+	if workspace.Isolated {
+		reqBody["isolated"] = true
 	}
 
 	reqJSON, err := json.Marshal(reqBody)
@@ -321,15 +498,16 @@ func generateTerraformVars(ctx context.Context, config *Config, run *Reconciliat
 	return resp.TfvarsJSON, nil
 }
 
-// terraformInit initializes terraform
-func terraformInit(ctx context.Context, config *Config, useBackend bool, extraArgs ...string) error {
-	slog.Info("running terraform init", "use_backend", useBackend, "extra_args", extraArgs)
+// terraformInit initializes terraform with its state under prefix in the
+// state bucket, or with local state when prefix is empty
+func terraformInit(ctx context.Context, config *Config, prefix string, extraArgs ...string) error {
+	slog.Info("running terraform init", "prefix", prefix, "extra_args", extraArgs)
 
 	args := []string{"init"}
-	if useBackend {
+	if prefix != "" {
 		args = append(args,
 			"-backend-config=bucket="+config.StateBucket,
-			"-backend-config=prefix=terraform/state",
+			"-backend-config=prefix="+prefix,
 		)
 	} else {
 		args = append(args, "-backend=false")
@@ -348,29 +526,13 @@ func terraformInit(ctx context.Context, config *Config, useBackend bool, extraAr
 	return nil
 }
 
-// terraformPlan runs terraform plan
-func terraformPlan(ctx context.Context, config *Config, run *ReconciliationRun) error {
-	slog.Info("running terraform plan")
+// terraformPlan runs terraform plan, limited to targets when there are any
+func terraformPlan(ctx context.Context, config *Config, targets []string) error {
+	slog.Info("running terraform plan", "targets", targets)
 
 	args := []string{"plan", "-out=tfplan"}
-
-	// Add targets based on modules
-	for _, module := range run.Modules {
-		switch module {
-		case "organization":
-			if run.OrganizationID != nil {
-				// Target specific organization module
-				args = append(args, fmt.Sprintf("-target=module.organizations[%d]", *run.OrganizationID))
-			}
-		case "project":
-			if run.ProjectID != nil {
-				args = append(args, fmt.Sprintf("-target=module.projects[%d]", *run.ProjectID))
-			}
-		case "site":
-			if run.SiteID != nil {
-				args = append(args, fmt.Sprintf("-target=module.sites[%d]", *run.SiteID))
-			}
-		}
+	for _, target := range targets {
+		args = append(args, "-target="+target)
 	}
 
 	cmd := exec.CommandContext(ctx, "terraform", args...)
@@ -415,7 +577,7 @@ func disableBackend(dir string) error {
     # Bucket configured via -backend-config flag
     prefix = "libops"
   }`
-	
+
 	commentedBlock := `/*
   backend "gcs" {
     # Bucket configured via -backend-config flag
@@ -443,7 +605,7 @@ func enableBackend(dir string) error {
     # Bucket configured via -backend-config flag
     prefix = "libops"
   }`
-	
+
 	commentedBlock := `/*
   backend "gcs" {
     # Bucket configured via -backend-config flag
@@ -459,6 +621,169 @@ func enableBackend(dir string) error {
 	return os.WriteFile(path, []byte(newContent), 0644)
 }
 
+// resolveAPIURL asks the public API for the organization's Private Service
+// Connect endpoint to the API, falling back to the public API when the
+// organization doesn't have one
+func resolveAPIURL(ctx context.Context, config *Config) string {
+	publicURL := config.APIAudience
+	if config.OrganizationID == "" {
+		return publicURL
+	}
+
+	var resp struct {
+		Endpoint struct {
+			IPAddress string `json:"ipAddress"`
+		} `json:"endpoint"`
+	}
+	err := callAdminReconciliation(ctx, config, publicURL, "ResolvePrivateServiceConnectEndpoint", map[string]interface{}{
+		"organizationId": config.OrganizationID,
+		"target":         "PRIVATE_SERVICE_CONNECT_TARGET_API",
+	}, &resp)
+	if err != nil || resp.Endpoint.IPAddress == "" {
+		slog.Info("no private service connect endpoint for the API, using the public API", "organization_id", config.OrganizationID, "error", err)
+		return publicURL
+	}
+
+	slog.Info("using private service connect endpoint for the API", "ip_address", resp.Endpoint.IPAddress)
+	return fmt.Sprintf("https://%s", resp.Endpoint.IPAddress)
+}
+
+// reportPrivateServiceConnectEndpoints sends the endpoints in the terraform
+// state for each organization back to the API
+func reportPrivateServiceConnectEndpoints(ctx context.Context, config *Config) error {
+	cmd := exec.CommandContext(ctx, "terraform", "output", "-json", "organizations")
+	cmd.Dir = config.WorkspaceDir
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("terraform output failed: %w", err)
+	}
+
+	var organizations map[string]struct {
+		PSCEndpoints map[string]struct {
+			IPAddress      string `json:"ip_address"`
+			ForwardingRule string `json:"forwarding_rule"`
+		} `json:"psc_endpoints"`
+	}
+	if err := json.Unmarshal(output, &organizations); err != nil {
+		return fmt.Errorf("failed to parse organizations output: %w", err)
+	}
+
+	for organizationID, org := range organizations {
+		endpoints := []map[string]string{}
+		for target, endpoint := range org.PSCEndpoints {
+			endpoints = append(endpoints, map[string]string{
+				"target":         "PRIVATE_SERVICE_CONNECT_TARGET_" + strings.ToUpper(target),
+				"ipAddress":      endpoint.IPAddress,
+				"forwardingRule": endpoint.ForwardingRule,
+			})
+		}
+		err := callAdminReconciliation(ctx, config, config.APIURL, "ReportPrivateServiceConnectEndpoints", map[string]interface{}{
+			"organizationId": organizationID,
+			"endpoints":      endpoints,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("organization %s: %w", organizationID, err)
+		}
+		slog.Info("reported private service connect endpoints", "organization_id", organizationID, "count", len(endpoints))
+	}
+
+	return nil
+}
+
+// reportSites sends each site's reserved egress address, CDN load balancer
+// address and the cache purges it ran in the terraform state back to the API
+func reportSites(ctx context.Context, config *Config) error {
+	cmd := exec.CommandContext(ctx, "terraform", "output", "-json", "sites")
+	cmd.Dir = config.WorkspaceDir
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("terraform output failed: %w", err)
+	}
+
+	var sites map[string]struct {
+		StaticEgressIP *string  `json:"static_egress_ip"`
+		CDNIPAddress   *string  `json:"cdn_ip_address"`
+		CachePurges    []string `json:"cache_purges"`
+		CloudSQLIP     *string  `json:"cloud_sql_ip"`
+	}
+	if err := json.Unmarshal(output, &sites); err != nil {
+		return fmt.Errorf("failed to parse sites output: %w", err)
+	}
+
+	for siteID, site := range sites {
+		err := callAdminReconciliation(ctx, config, config.APIURL, "ReportSiteStaticEgressIp", map[string]interface{}{
+			"siteId":    siteID,
+			"ipAddress": stringValue(site.StaticEgressIP),
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("site %s: %w", siteID, err)
+		}
+
+		err = callAdminReconciliation(ctx, config, config.APIURL, "ReportSiteCdn", map[string]interface{}{
+			"siteId":    siteID,
+			"ipAddress": stringValue(site.CDNIPAddress),
+			"purgedIds": site.CachePurges,
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("site %s: %w", siteID, err)
+		}
+
+		err = callAdminReconciliation(ctx, config, config.APIURL, "ReportSiteDatabaseInstance", map[string]interface{}{
+			"siteId":    siteID,
+			"ipAddress": stringValue(site.CloudSQLIP),
+		}, nil)
+		if err != nil {
+			return fmt.Errorf("site %s: %w", siteID, err)
+		}
+	}
+
+	return nil
+}
+
+// stringValue returns the string a nullable terraform output points to, or
+// "" for null
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// callAdminReconciliation calls an AdminReconciliationService method using
+// the Connect protocol's JSON encoding
+func callAdminReconciliation(ctx context.Context, config *Config, baseURL, method string, req, resp interface{}) error {
+	url := fmt.Sprintf("%s/libops.v1.AdminReconciliationService/%s", baseURL, method)
+
+	token, err := getIDToken(ctx, config.APIAudience)
+	if err != nil {
+		return fmt.Errorf("failed to get ID token: %w", err)
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "curl", "-s", "--fail-with-body",
+		"-X", "POST",
+		"-H", "Content-Type: application/json",
+		"-H", fmt.Sprintf("Authorization: Bearer %s", token),
+		"-d", string(reqJSON),
+		url)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w: %s", method, err, string(output))
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(output, resp); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", method, err)
+	}
+	return nil
+}
+
 // updateStatus updates reconciliation run status in API
 func updateStatus(ctx context.Context, config *Config, status string, err error) error {
 	url := fmt.Sprintf("%s/admin/v1/reconciliations/%s/status", config.APIURL, config.RunID)
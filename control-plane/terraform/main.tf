@@ -16,11 +16,17 @@ terraform {
 }
 
 variable "orchestrator_psc_ip" {
-  description = "IP address of the Orchestrator PSC endpoint"
+  description = "IP address of the Orchestrator PSC endpoint, used when an organization has none of its own"
   type        = string
   default     = "10.128.0.99"
 }
 
+variable "psc_service_attachments" {
+  description = "Service attachment self links for each Private Service Connect target, keyed by target"
+  type        = map(string)
+  default     = {}
+}
+
 variable "organizations" {
   description = "Map of organizations keyed by public_id"
   type = map(object({
@@ -29,6 +35,11 @@ variable "organizations" {
     gcp_billing_account = string
     gcp_parent          = string
     location            = string
+    data_residency      = optional(string, "")
+    kms_keys            = optional(map(string), {})
+    psc_endpoints = optional(map(object({
+      ip_address = optional(string)
+    })), {})
   }))
   default = {}
 }
@@ -72,6 +83,18 @@ variable "sites" {
       name       = string
       vault_path = string
     }))
+    static_egress_ip = optional(bool, false)
+    cdn = optional(object({
+      cache_mode  = string
+      default_ttl = number
+      domains     = list(string)
+      purges      = map(string)
+    }))
+    cloud_sql_databases = optional(map(object({
+      password = string
+    })), {})
+    backup_location = optional(string)
+    kms_keys        = optional(map(string), {})
   }))
   default = {}
 }
@@ -87,7 +110,12 @@ module "organizations" {
   gcp_billing_account = each.value.gcp_billing_account
   gcp_parent          = each.value.gcp_parent
   location            = each.value.location
+  data_residency      = each.value.data_residency
+  kms_keys            = each.value.kms_keys
   orchestrator_psc_ip = var.orchestrator_psc_ip
+
+  psc_endpoints           = each.value.psc_endpoints
+  psc_service_attachments = var.psc_service_attachments
 }
 
 # Create projects
@@ -122,6 +150,13 @@ module "sites" {
   firewall_rules = each.value.firewall_rules
   members        = each.value.members
   secrets        = each.value.secrets
+
+  static_egress_ip = each.value.static_egress_ip
+  cdn              = each.value.cdn
+
+  cloud_sql_databases = each.value.cloud_sql_databases
+  backup_location     = each.value.backup_location
+  kms_keys            = each.value.kms_keys
   users = {
     (each.value.project_id) = []
     (each.key)              = []
@@ -135,6 +170,7 @@ output "organizations" {
       folder_id      = org.folder_id
       project_id     = org.project_id
       project_number = org.project_number
+      psc_endpoints  = org.psc_endpoints
     }
   }
 }
@@ -42,11 +42,63 @@ variable "location" {
   default     = "us-central1"
 }
 
+variable "data_residency" {
+  description = "Geography the organization's data must stay in (eu or us); empty for anywhere"
+  type        = string
+  default     = ""
+
+  validation {
+    condition     = contains(["", "eu", "us"], var.data_residency)
+    error_message = "data_residency must be eu, us or empty."
+  }
+}
+
 variable "orchestrator_psc_ip" {
-  description = "IP address of the Orchestrator PSC endpoint"
+  description = "IP address of the Orchestrator PSC endpoint, used when the organization has none of its own"
   type        = string
 }
 
+variable "psc_endpoints" {
+  description = "Private Service Connect endpoints keyed by target (api, orchestrator, smtp_relay)"
+  type = map(object({
+    ip_address = optional(string)
+  }))
+  default = {}
+}
+
+variable "psc_service_attachments" {
+  description = "Service attachment self links keyed by target"
+  type        = map(string)
+  default     = {}
+}
+
+variable "kms_keys" {
+  description = "Customer-managed Cloud KMS keys keyed by location; data in a location without one uses Google-managed encryption"
+  type        = map(string)
+  default     = {}
+}
+
+variable "state_versions_retained" {
+  description = "Noncurrent versions of the terraform state kept for restores"
+  type        = number
+  default     = 50
+}
+
+variable "state_version_retention_days" {
+  description = "Days a noncurrent version of the terraform state is kept, however many newer versions there are"
+  type        = number
+  default     = 90
+}
+
+locals {
+  orchestrator_psc_ip = try(google_compute_address.psc["orchestrator"].address, var.orchestrator_psc_ip)
+  # The state holds the organization's data, so it's kept in the residency's
+  # multi-region when there is one
+  state_location = var.data_residency != "" ? upper(var.data_residency) : var.location
+  # A bucket can only be encrypted with a key in its own location
+  state_kms_key_name = lookup(var.kms_keys, lower(local.state_location), null)
+}
+
 # Create folder for organization
 resource "google_folder" "org_folder" {
   display_name = var.name
@@ -94,6 +146,30 @@ resource "google_compute_subnetwork" "org_subnet" {
   network       = google_compute_network.org_network.id
 }
 
+# Private Service Connect endpoints
+resource "google_compute_address" "psc" {
+  for_each = var.psc_endpoints
+
+  project      = google_project.org_project.project_id
+  name         = "psc-${replace(each.key, "_", "-")}"
+  region       = var.location
+  subnetwork   = google_compute_subnetwork.org_subnet.id
+  address_type = "INTERNAL"
+  address      = each.value.ip_address
+}
+
+resource "google_compute_forwarding_rule" "psc" {
+  for_each = var.psc_endpoints
+
+  project               = google_project.org_project.project_id
+  name                  = "psc-${replace(each.key, "_", "-")}"
+  region                = var.location
+  network               = google_compute_network.org_network.id
+  ip_address            = google_compute_address.psc[each.key].id
+  target                = var.psc_service_attachments[each.key]
+  load_balancing_scheme = ""
+}
+
 # Cloud NAT
 resource "google_compute_router" "router" {
   project = google_project.org_project.project_id
@@ -129,11 +205,47 @@ resource "google_project_iam_member" "vm_owner" {
 resource "google_storage_bucket" "terraform_state" {
   project       = google_project.org_project.project_id
   name          = "libops-org-${substr(var.public_id, 0, 8)}-tfstate"
-  location      = var.location
+  location      = local.state_location
   force_destroy = false
   versioning {
     enabled = true
   }
+
+  # Every state a run writes is kept as a noncurrent version that operators can
+  # restore; only the most recent ones are kept, and only for so long
+  lifecycle_rule {
+    condition {
+      num_newer_versions = var.state_versions_retained
+      with_state         = "ARCHIVED"
+    }
+    action {
+      type = "Delete"
+    }
+  }
+  lifecycle_rule {
+    condition {
+      days_since_noncurrent_time = var.state_version_retention_days
+      with_state                 = "ARCHIVED"
+    }
+    action {
+      type = "Delete"
+    }
+  }
+
+  # New state objects are encrypted with the organization's key; existing ones
+  # keep their key until they're rewritten
+  dynamic "encryption" {
+    for_each = local.state_kms_key_name != null ? [local.state_kms_key_name] : []
+    content {
+      default_kms_key_name = encryption.value
+    }
+  }
+
+  # A bucket can't move, so an organization that sets a residency later keeps
+  # its state where it is until an operator migrates it
+  lifecycle {
+    ignore_changes = [location]
+  }
 }
 
 # VM
@@ -162,7 +274,7 @@ resource "google_compute_instance" "org_vm" {
 
   metadata = {
     startup-script = templatefile("${path.module}/startup.sh", {
-      orchestrator_psc_ip = var.orchestrator_psc_ip
+      orchestrator_psc_ip = local.orchestrator_psc_ip
       organization_id     = var.public_id
       gcs_bucket          = google_storage_bucket.terraform_state.name
     })
@@ -186,4 +298,13 @@ output "project_id" {
 output "project_number" {
   description = "The project number"
   value       = google_project.org_project.number
-}
\ No newline at end of file
+}
+output "psc_endpoints" {
+  description = "Private Service Connect endpoints keyed by target"
+  value = {
+    for target, rule in google_compute_forwarding_rule.psc : target => {
+      ip_address      = google_compute_address.psc[target].address
+      forwarding_rule = rule.name
+    }
+  }
+}
@@ -65,6 +65,7 @@ resource "google_project_service" "services" {
   for_each = toset([
     "compute.googleapis.com",
     "container.googleapis.com",
+    "sqladmin.googleapis.com",
   ])
 
   project = google_project.project.project_id
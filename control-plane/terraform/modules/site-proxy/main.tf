@@ -4,6 +4,7 @@
  * This module creates a Cloud Run service that:
  * - Receives Pub/Sub push notifications for site reconciliation requests
  * - Fans out HTTP calls to site controllers at their external IPs
+ * - Records the outcomes for the sites' reconciliation timelines
  * - Deployed in each customer GCP project to access sites in private VPC
  */
 
@@ -22,6 +23,15 @@ resource "google_project_iam_member" "site_proxy_invoker" {
   member  = "serviceAccount:${google_service_account.site_proxy.email}"
 }
 
+# Grant access to the database URL secret
+resource "google_secret_manager_secret_iam_member" "site_proxy_database_url" {
+  count     = var.database_url_secret_id == "" ? 0 : 1
+  project   = var.project_id
+  secret_id = var.database_url_secret_id
+  role      = "roles/secretmanager.secretAccessor"
+  member    = "serviceAccount:${google_service_account.site_proxy.email}"
+}
+
 # Cloud Run service
 resource "google_cloud_run_service" "site_proxy" {
   name     = var.service_name
@@ -45,6 +55,20 @@ resource "google_cloud_run_service" "site_proxy" {
           value = "8080"
         }
 
+        # Records reconciliation outcomes for the sites' reconciliation timelines
+        dynamic "env" {
+          for_each = var.database_url_secret_id == "" ? [] : [var.database_url_secret_id]
+          content {
+            name = "DATABASE_URL"
+            value_from {
+              secret_key_ref {
+                name = env.value
+                key  = "latest"
+              }
+            }
+          }
+        }
+
         resources {
           limits = {
             cpu    = var.cpu_limit
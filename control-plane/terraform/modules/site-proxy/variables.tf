@@ -31,6 +31,12 @@ variable "api_url" {
   type        = string
 }
 
+variable "database_url_secret_id" {
+  description = "Secret Manager secret holding the database URL reconciliation outcomes are recorded in; empty to not record them"
+  type        = string
+  default     = ""
+}
+
 variable "cpu_limit" {
   description = "CPU limit for each container instance"
   type        = string
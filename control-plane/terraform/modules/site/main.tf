@@ -1,5 +1,5 @@
 terraform {
-  required_version = ">= 1.0"
+  required_version = ">= 1.4"
   required_providers {
     google = {
       source  = "hashicorp/google"
@@ -118,6 +118,44 @@ variable "https_allowed_rules" {
   default     = []
 }
 
+variable "static_egress_ip" {
+  description = "Reserve the instance's external address so the site's outbound traffic keeps the same IP"
+  type        = bool
+  default     = false
+}
+
+variable "cdn" {
+  description = "Cloud CDN load balancer in front of the site, and the cache purges to run on this apply keyed by purge ID; null for none"
+  type = object({
+    cache_mode  = string
+    default_ttl = number
+    domains     = list(string)
+    purges      = map(string)
+  })
+  default = null
+}
+
+variable "backup_location" {
+  description = "Multi-region the Cloud SQL backups are stored in (e.g. EU); null for the one nearest the instance"
+  type        = string
+  default     = null
+}
+
+variable "kms_keys" {
+  description = "The organization's customer-managed Cloud KMS keys keyed by location; the one in the site's region encrypts its Cloud SQL instance and backups"
+  type        = map(string)
+  default     = {}
+}
+
+variable "cloud_sql_databases" {
+  description = "Databases on the site's Cloud SQL instance keyed by name, which each one's user shares; empty for no instance"
+  type = map(object({
+    password = string
+  }))
+  default   = {}
+  sensitive = true
+}
+
 locals {
   https_allowed_rules = [
     for rule in var.firewall_rules : rule.cidr
@@ -129,6 +167,17 @@ locals {
   ]
   # Fallback for docker_compose_repo
   final_docker_compose_repo = var.docker_compose_repo != "" ? var.docker_compose_repo : var.github_repo
+
+  cdn_enabled = var.cdn != null
+  cdn_name    = "site-${substr(var.public_id, 0, 8)}-cdn"
+
+  # The managed certificate needs at least one domain; until the site has one
+  # the CDN only serves plain HTTP
+  cdn_https = local.cdn_enabled && try(length(var.cdn.domains), 0) > 0
+
+  # Only the passwords are secret; the names decide which resources exist
+  cloud_sql_names   = nonsensitive(toset(keys(var.cloud_sql_databases)))
+  cloud_sql_enabled = length(local.cloud_sql_names) > 0
 }
 
 resource "google_compute_firewall" "ssh_allowed" {
@@ -179,6 +228,241 @@ module "machine" {
   ]
 }
 
+# Static egress IP: promotes the instance's ephemeral external address to a
+# reservation, so it survives restarts and can be given to vendors that
+# allowlist by IP. Releasing it returns the address to ephemeral.
+resource "google_compute_address" "egress" {
+  count = var.static_egress_ip ? 1 : 0
+
+  project      = var.gcp_project_id
+  name         = "site-${substr(var.public_id, 0, 8)}-egress"
+  region       = var.region
+  address_type = "EXTERNAL"
+  address      = module.machine.external_ip
+}
+
+# CDN: an external load balancer with Cloud CDN in front of the instance.
+# The site's domains point at its address instead of the instance's.
+resource "google_compute_firewall" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project = var.gcp_project_id
+  name    = local.cdn_name
+  network = "default"
+
+  allow {
+    protocol = "tcp"
+    ports    = ["443"]
+  }
+
+  # Google front end and health check ranges
+  source_ranges = ["130.211.0.0/22", "35.191.0.0/16"]
+  target_tags   = ["libops-${substr(var.public_id, 0, 8)}"]
+}
+
+resource "google_compute_instance_group" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project   = var.gcp_project_id
+  name      = local.cdn_name
+  zone      = var.zone
+  instances = ["projects/${var.gcp_project_id}/zones/${var.zone}/instances/${var.name}"]
+
+  named_port {
+    name = "https"
+    port = 443
+  }
+
+  depends_on = [module.machine]
+}
+
+resource "google_compute_health_check" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project = var.gcp_project_id
+  name    = local.cdn_name
+
+  tcp_health_check {
+    port = 443
+  }
+}
+
+resource "google_compute_backend_service" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project               = var.gcp_project_id
+  name                  = local.cdn_name
+  protocol              = "HTTPS"
+  port_name             = "https"
+  load_balancing_scheme = "EXTERNAL_MANAGED"
+  health_checks         = [google_compute_health_check.cdn[0].id]
+  enable_cdn            = true
+
+  backend {
+    group = google_compute_instance_group.cdn[0].id
+  }
+
+  cdn_policy {
+    cache_mode = upper(var.cdn.cache_mode)
+
+    # Cloud CDN rejects TTLs when it only follows the origin's headers
+    default_ttl      = var.cdn.cache_mode == "use_origin_headers" ? null : var.cdn.default_ttl
+    client_ttl       = var.cdn.cache_mode == "use_origin_headers" ? null : var.cdn.default_ttl
+    max_ttl          = var.cdn.cache_mode == "use_origin_headers" ? null : max(var.cdn.default_ttl, 86400)
+    negative_caching = true
+  }
+}
+
+resource "google_compute_url_map" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project         = var.gcp_project_id
+  name            = local.cdn_name
+  default_service = google_compute_backend_service.cdn[0].id
+}
+
+resource "google_compute_global_address" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project = var.gcp_project_id
+  name    = local.cdn_name
+}
+
+resource "google_compute_target_http_proxy" "cdn" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project = var.gcp_project_id
+  name    = local.cdn_name
+  url_map = google_compute_url_map.cdn[0].id
+}
+
+resource "google_compute_global_forwarding_rule" "cdn_http" {
+  count = local.cdn_enabled ? 1 : 0
+
+  project               = var.gcp_project_id
+  name                  = "${local.cdn_name}-http"
+  target                = google_compute_target_http_proxy.cdn[0].id
+  ip_address            = google_compute_global_address.cdn[0].id
+  port_range            = "80"
+  load_balancing_scheme = "EXTERNAL_MANAGED"
+}
+
+resource "google_compute_managed_ssl_certificate" "cdn" {
+  count = local.cdn_https ? 1 : 0
+
+  project = var.gcp_project_id
+  name    = "${local.cdn_name}-${substr(sha256(join(",", var.cdn.domains)), 0, 8)}"
+
+  managed {
+    domains = var.cdn.domains
+  }
+
+  # A certificate can't change its domains; swap in a new one first
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "google_compute_target_https_proxy" "cdn" {
+  count = local.cdn_https ? 1 : 0
+
+  project          = var.gcp_project_id
+  name             = local.cdn_name
+  url_map          = google_compute_url_map.cdn[0].id
+  ssl_certificates = [google_compute_managed_ssl_certificate.cdn[0].id]
+}
+
+resource "google_compute_global_forwarding_rule" "cdn_https" {
+  count = local.cdn_https ? 1 : 0
+
+  project               = var.gcp_project_id
+  name                  = "${local.cdn_name}-https"
+  target                = google_compute_target_https_proxy.cdn[0].id
+  ip_address            = google_compute_global_address.cdn[0].id
+  port_range            = "443"
+  load_balancing_scheme = "EXTERNAL_MANAGED"
+}
+
+# Cache purges run once each: a purge leaves the site's vars after the runner
+# reports it, and destroying its terraform_data does nothing
+resource "terraform_data" "cache_purge" {
+  for_each = local.cdn_enabled ? var.cdn.purges : {}
+
+  triggers_replace = [each.key]
+
+  provisioner "local-exec" {
+    command = "gcloud compute url-maps invalidate-cdn-cache \"$URL_MAP\" --path \"$PURGE_PATH\" --project \"$PROJECT\" --global --async"
+
+    environment = {
+      URL_MAP    = google_compute_url_map.cdn[0].name
+      PURGE_PATH = each.value
+      PROJECT    = var.gcp_project_id
+    }
+  }
+}
+
+# Cloud SQL: a MySQL instance of the site's own for its databases. Only the
+# instance's address is reachable, and only from the site's VM, over TLS.
+resource "google_sql_database_instance" "databases" {
+  count = local.cloud_sql_enabled ? 1 : 0
+
+  project          = var.gcp_project_id
+  name             = "site-${substr(var.public_id, 0, 8)}-db"
+  region           = var.region
+  database_version = "MYSQL_8_0"
+
+  # The API never removes a site's last database, so the instance only goes
+  # with the site
+  deletion_protection = false
+
+  settings {
+    tier              = "db-g1-small"
+    availability_type = "ZONAL"
+
+    backup_configuration {
+      enabled            = true
+      binary_log_enabled = true
+      location           = var.backup_location
+    }
+
+    ip_configuration {
+      ipv4_enabled = true
+      ssl_mode     = "ENCRYPTED_ONLY"
+
+      authorized_networks {
+        name  = "site-vm"
+        value = "${module.machine.external_ip}/32"
+      }
+    }
+  }
+
+  # Cloud SQL only takes a key in the instance's region, and only when the
+  # instance is created; an instance that already exists keeps its encryption
+  # rather than being replaced
+  encryption_key_name = lookup(var.kms_keys, var.region, null)
+  lifecycle {
+    ignore_changes = [encryption_key_name]
+  }
+}
+
+resource "google_sql_database" "databases" {
+  for_each = local.cloud_sql_names
+
+  project  = var.gcp_project_id
+  instance = google_sql_database_instance.databases[0].name
+  name     = each.key
+}
+
+resource "google_sql_user" "databases" {
+  for_each = local.cloud_sql_names
+
+  project  = var.gcp_project_id
+  instance = google_sql_database_instance.databases[0].name
+  name     = each.key
+  host     = "%"
+  password = var.cloud_sql_databases[each.key].password
+}
+
 output "external_ip" {
   description = "External IP address of the instance"
   value       = module.machine.external_ip
@@ -191,8 +475,12 @@ output "instance_id" {
 
 output "site_info" {
   value = {
-    public_id       = var.public_id
-    service_account = google_service_account.site.email
-    external_ip     = module.machine.external_ip
+    public_id        = var.public_id
+    service_account  = google_service_account.site.email
+    external_ip      = module.machine.external_ip
+    static_egress_ip = try(google_compute_address.egress[0].address, null)
+    cdn_ip_address   = try(google_compute_global_address.cdn[0].address, null)
+    cache_purges     = keys(terraform_data.cache_purge)
+    cloud_sql_ip     = try(google_sql_database_instance.databases[0].public_ip_address, null)
   }
 }
\ No newline at end of file
@@ -9,6 +9,12 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+
+	"github.com/libops/control-plane/internal/eventrouter"
 )
 
 // TestEventRouterHierarchy verifies event processing at all resource hierarchies
@@ -62,7 +68,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Org Member Added",
 			hierarchy:       "org",
 			operation:       "create",
-			eventType:       "io.libops.organization.member.created.v1",
+			eventType:       "io.libops.organization.member.added.v1",
 			orgID:           1,
 			expectedScope:   "ScopeOrg",
 			expectedSites:   10, // All sites need SSH keys updated
@@ -73,7 +79,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Org Member Removed",
 			hierarchy:       "org",
 			operation:       "delete",
-			eventType:       "io.libops.organization.member.deleted.v1",
+			eventType:       "io.libops.organization.member.removed.v1",
 			orgID:           1,
 			expectedScope:   "ScopeOrg",
 			expectedSites:   10, // All sites need SSH keys updated
@@ -109,7 +115,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Project Member Added",
 			hierarchy:       "project",
 			operation:       "create",
-			eventType:       "io.libops.project.member.created.v1",
+			eventType:       "io.libops.project.member.added.v1",
 			orgID:           1,
 			projectID:       ptr(int64(1)),
 			expectedScope:   "ScopeProject",
@@ -133,7 +139,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Project Firewall Rule Added",
 			hierarchy:       "project",
 			operation:       "create",
-			eventType:       "io.libops.project.firewall.created.v1",
+			eventType:       "io.libops.project.firewall_rule.added.v1",
 			orgID:           1,
 			projectID:       ptr(int64(1)),
 			expectedScope:   "ScopeProject",
@@ -171,7 +177,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Site Member Added",
 			hierarchy:       "site",
 			operation:       "create",
-			eventType:       "io.libops.site.member.created.v1",
+			eventType:       "io.libops.site.member.added.v1",
 			orgID:           1,
 			projectID:       ptr(int64(1)),
 			siteID:          ptr(int64(1)),
@@ -197,7 +203,7 @@ func TestEventRouterHierarchy(t *testing.T) {
 			name:            "Site Firewall Rule Added",
 			hierarchy:       "site",
 			operation:       "create",
-			eventType:       "io.libops.site.firewall.created.v1",
+			eventType:       "io.libops.site.firewall_rule.added.v1",
 			orgID:           1,
 			projectID:       ptr(int64(1)),
 			siteID:          ptr(int64(1)),
@@ -304,9 +310,68 @@ func TestEventRouterHierarchy(t *testing.T) {
 		// Test that SSH key events → "ssh_keys", secret events → "secrets", etc.
 		testRequestTypeDetermination(t, db)
 	})
+
+	// Test malformed events are rejected
+	t.Run("MalformedEvents", func(t *testing.T) {
+		testMalformedEvents(t, db)
+	})
+}
+
+// TestOutboxRelay verifies outbox events are moved into the queue exactly once
+func TestOutboxRelay(t *testing.T) {
+	db, err := sql.Open("mysql", getTestDatabaseURL())
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	eventID := fmt.Sprintf("outbox-test-%d", time.Now().UnixNano())
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO event_outbox (
+			event_id, event_type, event_source, event_data, content_type, organization_id
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`, eventID, "io.libops.organization.updated.v1", "test", []byte{}, "application/protobuf", 1)
+	if err != nil {
+		t.Fatalf("Failed to insert outbox event: %v", err)
+	}
+
+	relay := eventrouter.NewOutboxRelay(db)
+	for range 2 {
+		if _, err := relay.Relay(ctx); err != nil {
+			t.Fatalf("Failed to relay outbox: %v", err)
+		}
+	}
+
+	var queued int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM event_queue WHERE event_id = ?`, eventID).Scan(&queued); err != nil {
+		t.Fatalf("Failed to count queued events: %v", err)
+	}
+	if queued != 1 {
+		t.Errorf("Expected the event queued once, got %d", queued)
+	}
+
+	var left int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM event_outbox WHERE event_id = ?`, eventID).Scan(&left); err != nil {
+		t.Fatalf("Failed to count outbox events: %v", err)
+	}
+	if left != 0 {
+		t.Errorf("Expected the outbox event deleted, %d left", left)
+	}
 }
 
 func insertTestEvent(ctx context.Context, db *sql.DB, eventID, eventType string, orgID int64, projectID, siteID *int64) error {
+	data, err := proto.Marshal(&eventsv1.Envelope{
+		SchemaVersion: eventsv1.SchemaVersion_SCHEMA_VERSION_1,
+		Type:          registeredEventType(eventType),
+	})
+	if err != nil {
+		return err
+	}
+	return insertRawEvent(ctx, db, eventID, eventType, data, "application/protobuf; proto=libops.v1.events.Envelope", orgID, projectID, siteID)
+}
+
+func insertRawEvent(ctx context.Context, db *sql.DB, eventID, eventType string, data []byte, contentType string, orgID int64, projectID, siteID *int64) error {
 	query := `
 		INSERT INTO event_queue (
 			event_id, event_type, event_source, event_data, content_type,
@@ -318,8 +383,8 @@ func insertTestEvent(ctx context.Context, db *sql.DB, eventID, eventType string,
 		eventID,
 		eventType,
 		"test",
-		[]byte("{}"),
-		"application/json",
+		data,
+		contentType,
 		orgID,
 		projectID,
 		siteID,
@@ -327,6 +392,18 @@ func insertTestEvent(ctx context.Context, db *sql.DB, eventID, eventType string,
 	return err
 }
 
+// registeredEventType returns the libops.v1.events.EventType declaring a CloudEvents type
+func registeredEventType(eventType string) eventsv1.EventType {
+	values := eventsv1.EventType(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		if proto.GetExtension(value.Options(), optionsv1.E_CloudeventType).(string) == eventType {
+			return eventsv1.EventType(value.Number())
+		}
+	}
+	return eventsv1.EventType_EVENT_TYPE_UNSPECIFIED
+}
+
 func getEventStatus(ctx context.Context, db *sql.DB, eventID string) (string, error) {
 	var status string
 	query := `SELECT status FROM event_queue WHERE event_id = ?`
@@ -471,15 +548,20 @@ func testRequestTypeDetermination(t *testing.T, db *sql.DB) {
 		eventType           string
 		expectedRequestType string
 	}{
-		{"io.libops.organization.member.created.v1", "ssh_keys"},
-		{"io.libops.project.member.created.v1", "ssh_keys"},
-		{"io.libops.site.member.created.v1", "ssh_keys"},
+		{"io.libops.organization.member.added.v1", "ssh_keys"},
+		{"io.libops.project.member.added.v1", "ssh_keys"},
+		{"io.libops.site.member.added.v1", "ssh_keys"},
+		{"io.libops.site.ssh_access.granted.v1", "ssh_keys"},
+		{"io.libops.relationship.approved.v1", "ssh_keys"},
+		{"io.libops.site.elevation.expired.v1", "ssh_keys"},
+		{"io.libops.site.config_var.set.v1", "secrets"},
+		{"io.libops.project.setting.updated.v1", "secrets"},
 		{"io.libops.organization.secret.created.v1", "secrets"},
 		{"io.libops.project.secret.created.v1", "secrets"},
 		{"io.libops.site.secret.created.v1", "secrets"},
-		{"io.libops.organization.firewall.created.v1", "firewall"},
-		{"io.libops.project.firewall.created.v1", "firewall"},
-		{"io.libops.site.firewall.created.v1", "firewall"},
+		{"io.libops.organization.firewall_rule.added.v1", "firewall"},
+		{"io.libops.project.firewall_rule.added.v1", "firewall"},
+		{"io.libops.site.firewall_rule.added.v1", "firewall"},
 		{"io.libops.organization.updated.v1", "full"},
 		{"io.libops.project.updated.v1", "full"},
 		{"io.libops.site.updated.v1", "full"},
@@ -511,6 +593,48 @@ func testRequestTypeDetermination(t *testing.T, db *sql.DB) {
 	}
 }
 
+func testMalformedEvents(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+
+	mismatched, err := proto.Marshal(&eventsv1.Envelope{
+		SchemaVersion: eventsv1.SchemaVersion_SCHEMA_VERSION_1,
+		Type:          eventsv1.EventType_EVENT_TYPE_SITE_DELETED,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		eventType   string
+		data        []byte
+		contentType string
+	}{
+		{"json", "io.libops.site.updated.v1", []byte("{}"), "application/json"},
+		{"unregistered type", "io.libops.site.renamed.v1", mismatched, "application/protobuf; proto=libops.v1.events.Envelope"},
+		{"type mismatch", "io.libops.site.updated.v1", mismatched, "application/protobuf; proto=libops.v1.events.Envelope"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eventID := fmt.Sprintf("malformed-test-%d", time.Now().UnixNano())
+			if err := insertRawEvent(ctx, db, eventID, tc.eventType, tc.data, tc.contentType, 1, nil, nil); err != nil {
+				t.Fatalf("Failed to insert event: %v", err)
+			}
+
+			time.Sleep(4 * time.Second)
+
+			status, err := getEventStatus(ctx, db, eventID)
+			if err != nil {
+				t.Fatalf("Failed to get event status: %v", err)
+			}
+			if status != "dead_letter" {
+				t.Errorf("Expected event status 'dead_letter', got '%s'", status)
+			}
+		})
+	}
+}
+
 func getTestDatabaseURL() string {
 	// Use environment variable or default to mariadb service
 	url := os.Getenv("DATABASE_URL")
@@ -61,6 +61,22 @@ insert_test_event() {
     local project_id="${4:-NULL}"
     local site_id="${5:-NULL}"
 
+    # A libops.v1.events.Envelope: schema_version 1 (08 01) and the
+    # libops.v1.events.EventType number in hex (10 <number>)
+    local event_type_number
+    case "$event_type" in
+        io.libops.organization.updated.v1) event_type_number=05 ;;
+        io.libops.project.updated.v1) event_type_number=08 ;;
+        io.libops.site.updated.v1) event_type_number=0B ;;
+        io.libops.organization.member.added.v1) event_type_number=0F ;;
+        io.libops.project.secret.created.v1) event_type_number=1F ;;
+        io.libops.site.firewall_rule.added.v1) event_type_number=28 ;;
+        *)
+            log_error "No envelope for event type $event_type"
+            return 1
+            ;;
+    esac
+
     if [ "$project_id" = "NULL" ]; then
         project_id_sql="NULL"
     else
@@ -81,8 +97,8 @@ INSERT INTO event_queue (
     '$event_id',
     '$event_type',
     'test',
-    '{}',
-    'application/json',
+    UNHEX('0801${event_type_number}'),
+    'application/protobuf; proto=libops.v1.events.Envelope',
     $org_id,
     $project_id_sql,
     $site_id_sql,
@@ -245,7 +261,7 @@ main() {
     # Test 6: Request type determination
     run_test "Request Type: SSH Keys (member event)"
     local member_event_id="test-member-$(date +%s)"
-    insert_test_event "$member_event_id" "io.libops.organization.member.created.v1" 2
+    insert_test_event "$member_event_id" "io.libops.organization.member.added.v1" 2
 
     log_info "Waiting 4 seconds for event processing..."
     sleep 4
@@ -275,7 +291,7 @@ main() {
 
     run_test "Request Type: Firewall (firewall event)"
     local firewall_event_id="test-firewall-$(date +%s)"
-    insert_test_event "$firewall_event_id" "io.libops.site.firewall.created.v1" 2 2 1
+    insert_test_event "$firewall_event_id" "io.libops.site.firewall_rule.added.v1" 2 2 1
 
     log_info "Waiting 7 seconds for event processing..."
     sleep 7
@@ -12,6 +12,16 @@ import (
 	"time"
 )
 
+const cleanupExpiredPasswordResetTokens = `-- name: CleanupExpiredPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE expires_at < NOW()
+`
+
+func (q *Queries) CleanupExpiredPasswordResetTokens(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, cleanupExpiredPasswordResetTokens)
+	return err
+}
+
 const cleanupExpiredVerificationTokens = `-- name: CleanupExpiredVerificationTokens :exec
 DELETE FROM email_verification_tokens
 WHERE expires_at < NOW()
@@ -96,6 +106,16 @@ func (q *Queries) DeleteEmailVerificationToken(ctx context.Context, email string
 	return err
 }
 
+const deletePasswordResetToken = `-- name: DeletePasswordResetToken :exec
+DELETE FROM password_reset_tokens
+WHERE account_id = ?
+`
+
+func (q *Queries) DeletePasswordResetToken(ctx context.Context, accountID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePasswordResetToken, accountID)
+	return err
+}
+
 const getAccount = `-- name: GetAccount :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, email, ` + "`" + `name` + "`" + `, github_username, vault_entity_id,
        auth_method, verified, verified_at, onboarding_completed, onboarding_session_id, created_at, updated_at
@@ -139,6 +159,17 @@ func (q *Queries) GetAccount(ctx context.Context, publicID string) (GetAccountRo
 	return i, err
 }
 
+const getAccountAvatar = `-- name: GetAccountAvatar :one
+SELECT avatar_object FROM accounts WHERE public_id = UUID_TO_BIN(?)
+`
+
+func (q *Queries) GetAccountAvatar(ctx context.Context, publicID string) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, getAccountAvatar, publicID)
+	var avatar_object sql.NullString
+	err := row.Scan(&avatar_object)
+	return avatar_object, err
+}
+
 const getAccountByEmail = `-- name: GetAccountByEmail :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, email, ` + "`" + `name` + "`" + `, github_username, vault_entity_id,
        auth_method, verified, verified_at, failed_login_attempts, last_failed_login_at,
@@ -273,6 +304,55 @@ func (q *Queries) GetAccountByVaultEntityID(ctx context.Context, vaultEntityID s
 	return i, err
 }
 
+const getAccountPreferences = `-- name: GetAccountPreferences :one
+SELECT account_id, theme, timezone, default_organization_id, page_size, created_at, updated_at, locale
+FROM account_preferences WHERE account_id = ?
+`
+
+func (q *Queries) GetAccountPreferences(ctx context.Context, accountID int64) (AccountPreference, error) {
+	row := q.db.QueryRowContext(ctx, getAccountPreferences, accountID)
+	var i AccountPreference
+	err := row.Scan(
+		&i.AccountID,
+		&i.Theme,
+		&i.Timezone,
+		&i.DefaultOrganizationID,
+		&i.PageSize,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const getAccountProfile = `-- name: GetAccountProfile :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, email, ` + "`" + `name` + "`" + `, phone, avatar_object
+FROM accounts WHERE id = ?
+`
+
+type GetAccountProfileRow struct {
+	ID           int64          `json:"id"`
+	PublicID     string         `json:"public_id"`
+	Email        string         `json:"email"`
+	Name         sql.NullString `json:"name"`
+	Phone        sql.NullString `json:"phone"`
+	AvatarObject sql.NullString `json:"avatar_object"`
+}
+
+func (q *Queries) GetAccountProfile(ctx context.Context, id int64) (GetAccountProfileRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountProfile, id)
+	var i GetAccountProfileRow
+	err := row.Scan(
+		&i.ID,
+		&i.PublicID,
+		&i.Email,
+		&i.Name,
+		&i.Phone,
+		&i.AvatarObject,
+	)
+	return i, err
+}
+
 const getEmailVerificationToken = `-- name: GetEmailVerificationToken :one
 SELECT id, email, token, password_hash, created_at, expires_at
 FROM email_verification_tokens
@@ -324,7 +404,7 @@ func (q *Queries) GetEmailVerificationTokenByEmail(ctx context.Context, email st
 const getOnboardingSessionByAccountID = `-- name: GetOnboardingSessionByAccountID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name,
        CASE WHEN organization_public_id IS NULL THEN NULL ELSE BIN_TO_UUID(organization_public_id) END AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
@@ -340,6 +420,8 @@ type GetOnboardingSessionByAccountIDRow struct {
 	MachineType             sql.NullString `json:"machine_type"`
 	MachinePriceID          sql.NullString `json:"machine_price_id"`
 	DiskSizeGb              sql.NullInt32  `json:"disk_size_gb"`
+	PromoCode               sql.NullString `json:"promo_code"`
+	DiscountDescription     sql.NullString `json:"discount_description"`
 	StripeCheckoutSessionID sql.NullString `json:"stripe_checkout_session_id"`
 	StripeCheckoutUrl       sql.NullString `json:"stripe_checkout_url"`
 	StripeSubscriptionID    sql.NullString `json:"stripe_subscription_id"`
@@ -370,6 +452,8 @@ func (q *Queries) GetOnboardingSessionByAccountID(ctx context.Context, accountID
 		&i.MachineType,
 		&i.MachinePriceID,
 		&i.DiskSizeGb,
+		&i.PromoCode,
+		&i.DiscountDescription,
 		&i.StripeCheckoutSessionID,
 		&i.StripeCheckoutUrl,
 		&i.StripeSubscriptionID,
@@ -390,10 +474,31 @@ func (q *Queries) GetOnboardingSessionByAccountID(ctx context.Context, accountID
 	return i, err
 }
 
+const getPasswordResetToken = `-- name: GetPasswordResetToken :one
+SELECT t.account_id, a.email, t.expires_at
+FROM password_reset_tokens t
+JOIN accounts a ON a.id = t.account_id
+WHERE t.token_hash = ?
+  AND t.expires_at > NOW()
+`
+
+type GetPasswordResetTokenRow struct {
+	AccountID int64     `json:"account_id"`
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) GetPasswordResetToken(ctx context.Context, tokenHash string) (GetPasswordResetTokenRow, error) {
+	row := q.db.QueryRowContext(ctx, getPasswordResetToken, tokenHash)
+	var i GetPasswordResetTokenRow
+	err := row.Scan(&i.AccountID, &i.Email, &i.ExpiresAt)
+	return i, err
+}
+
 const getProjectMemberByAccountAndProject = `-- name: GetProjectMemberByAccountAndProject :one
 
 
-SELECT id, public_id, project_id, account_id, role, status, created_at, updated_at, created_by, updated_by FROM project_members
+SELECT id, public_id, project_id, account_id, role, status, created_at, updated_at, created_by, updated_by, expires_at FROM project_members
 WHERE account_id = ? AND project_id = ? AND status = 'active'
 `
 
@@ -419,12 +524,13 @@ func (q *Queries) GetProjectMemberByAccountAndProject(ctx context.Context, arg G
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
 
 const getSiteMemberByAccountAndSite = `-- name: GetSiteMemberByAccountAndSite :one
-SELECT id, public_id, site_id, account_id, role, status, created_at, updated_at, created_by, updated_by FROM site_members
+SELECT id, public_id, site_id, account_id, role, status, created_at, updated_at, created_by, updated_by, expires_at FROM site_members
 WHERE account_id = ? AND site_id = ? AND status = 'active'
 `
 
@@ -447,6 +553,7 @@ func (q *Queries) GetSiteMemberByAccountAndSite(ctx context.Context, arg GetSite
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -468,6 +575,7 @@ const listAPIKeysByAccount = `-- name: ListAPIKeysByAccount :many
 
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, ` + "`" + `name` + "`" + `, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys
 WHERE account_id = ?
@@ -482,17 +590,18 @@ type ListAPIKeysByAccountParams struct {
 }
 
 type ListAPIKeysByAccountRow struct {
-	ID          int64           `json:"id"`
-	PublicID    string          `json:"public_id"`
-	AccountID   int64           `json:"account_id"`
-	Name        string          `json:"name"`
-	Description sql.NullString  `json:"description"`
-	Scopes      json.RawMessage `json:"scopes"`
-	CreatedAt   sql.NullTime    `json:"created_at"`
-	LastUsedAt  sql.NullTime    `json:"last_used_at"`
-	ExpiresAt   sql.NullTime    `json:"expires_at"`
-	Active      bool            `json:"active"`
-	CreatedBy   sql.NullInt64   `json:"created_by"`
+	ID           int64           `json:"id"`
+	PublicID     string          `json:"public_id"`
+	AccountID    int64           `json:"account_id"`
+	Name         string          `json:"name"`
+	Description  sql.NullString  `json:"description"`
+	Scopes       json.RawMessage `json:"scopes"`
+	AllowedCidrs json.RawMessage `json:"allowed_cidrs"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	LastUsedAt   sql.NullTime    `json:"last_used_at"`
+	ExpiresAt    sql.NullTime    `json:"expires_at"`
+	Active       bool            `json:"active"`
+	CreatedBy    sql.NullInt64   `json:"created_by"`
 }
 
 // =============================================================================
@@ -514,6 +623,7 @@ func (q *Queries) ListAPIKeysByAccount(ctx context.Context, arg ListAPIKeysByAcc
 			&i.Name,
 			&i.Description,
 			&i.Scopes,
+			&i.AllowedCidrs,
 			&i.CreatedAt,
 			&i.LastUsedAt,
 			&i.ExpiresAt,
@@ -641,7 +751,7 @@ func (q *Queries) ListAccountSites(ctx context.Context, arg ListAccountSitesPara
 const listAccountSshAccess = `-- name: ListAccountSshAccess :many
 
 
-SELECT id, account_id, site_id, created_at, updated_at, created_by, updated_by FROM ssh_access
+SELECT id, account_id, site_id, created_at, updated_at, created_by, updated_by, sftp_only FROM ssh_access
 WHERE account_id = ?
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
@@ -673,6 +783,7 @@ func (q *Queries) ListAccountSshAccess(ctx context.Context, arg ListAccountSshAc
 			&i.UpdatedAt,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.SftpOnly,
 		); err != nil {
 			return nil, err
 		}
@@ -760,6 +871,7 @@ SELECT sk.id, BIN_TO_UUID(sk.public_id) AS public_id,
 FROM ssh_keys sk
 JOIN accounts a ON sk.account_id = a.id
 WHERE a.public_id = UUID_TO_BIN(?)
+  AND sk.expires_at IS NULL
 ORDER BY sk.created_at DESC
 `
 
@@ -855,6 +967,23 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) er
 	return err
 }
 
+const updateAccountAvatar = `-- name: UpdateAccountAvatar :exec
+UPDATE accounts SET
+  avatar_object = ?,
+  updated_at = NOW()
+WHERE id = ?
+`
+
+type UpdateAccountAvatarParams struct {
+	AvatarObject sql.NullString `json:"avatar_object"`
+	ID           int64          `json:"id"`
+}
+
+func (q *Queries) UpdateAccountAvatar(ctx context.Context, arg UpdateAccountAvatarParams) error {
+	_, err := q.db.ExecContext(ctx, updateAccountAvatar, arg.AvatarObject, arg.ID)
+	return err
+}
+
 const updateAccountOnboarding = `-- name: UpdateAccountOnboarding :exec
 UPDATE accounts SET
   onboarding_completed = ?,
@@ -873,3 +1002,77 @@ func (q *Queries) UpdateAccountOnboarding(ctx context.Context, arg UpdateAccount
 	_, err := q.db.ExecContext(ctx, updateAccountOnboarding, arg.OnboardingCompleted, arg.OnboardingSessionID, arg.ID)
 	return err
 }
+
+const updateAccountProfile = `-- name: UpdateAccountProfile :exec
+UPDATE accounts SET
+  ` + "`" + `name` + "`" + ` = ?,
+  phone = ?,
+  updated_at = NOW()
+WHERE id = ?
+`
+
+type UpdateAccountProfileParams struct {
+	Name  sql.NullString `json:"name"`
+	Phone sql.NullString `json:"phone"`
+	ID    int64          `json:"id"`
+}
+
+func (q *Queries) UpdateAccountProfile(ctx context.Context, arg UpdateAccountProfileParams) error {
+	_, err := q.db.ExecContext(ctx, updateAccountProfile, arg.Name, arg.Phone, arg.ID)
+	return err
+}
+
+const upsertAccountPreferences = `-- name: UpsertAccountPreferences :exec
+INSERT INTO account_preferences (account_id, theme, timezone, default_organization_id, page_size, locale)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  theme = VALUES(theme),
+  timezone = VALUES(timezone),
+  default_organization_id = VALUES(default_organization_id),
+  page_size = VALUES(page_size),
+  locale = VALUES(locale)
+`
+
+type UpsertAccountPreferencesParams struct {
+	AccountID             int64                   `json:"account_id"`
+	Theme                 AccountPreferencesTheme `json:"theme"`
+	Timezone              string                  `json:"timezone"`
+	DefaultOrganizationID sql.NullInt64           `json:"default_organization_id"`
+	PageSize              int32                   `json:"page_size"`
+	Locale                string                  `json:"locale"`
+}
+
+func (q *Queries) UpsertAccountPreferences(ctx context.Context, arg UpsertAccountPreferencesParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAccountPreferences,
+		arg.AccountID,
+		arg.Theme,
+		arg.Timezone,
+		arg.DefaultOrganizationID,
+		arg.PageSize,
+		arg.Locale,
+	)
+	return err
+}
+
+const upsertPasswordResetToken = `-- name: UpsertPasswordResetToken :exec
+INSERT INTO password_reset_tokens (
+    account_id,
+    token_hash,
+    expires_at
+) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    token_hash = VALUES(token_hash),
+    expires_at = VALUES(expires_at),
+    created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertPasswordResetTokenParams struct {
+	AccountID int64     `json:"account_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) UpsertPasswordResetToken(ctx context.Context, arg UpsertPasswordResetTokenParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPasswordResetToken, arg.AccountID, arg.TokenHash, arg.ExpiresAt)
+	return err
+}
@@ -15,19 +15,20 @@ import (
 
 const createAPIKey = `-- name: CreateAPIKey :exec
 INSERT INTO api_keys (
-  public_id, account_id, ` + "`" + `name` + "`" + `, description, scopes, created_at, expires_at, active, created_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, NOW(), ?, ?, ?)
+  public_id, account_id, ` + "`" + `name` + "`" + `, description, scopes, allowed_cidrs, created_at, expires_at, active, created_by
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, NOW(), ?, ?, ?)
 `
 
 type CreateAPIKeyParams struct {
-	PublicID    string         `json:"public_id"`
-	AccountID   int64          `json:"account_id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
-	Scopes      types.RawJSON  `json:"scopes"`
-	ExpiresAt   sql.NullTime   `json:"expires_at"`
-	Active      bool           `json:"active"`
-	CreatedBy   sql.NullInt64  `json:"created_by"`
+	PublicID     string         `json:"public_id"`
+	AccountID    int64          `json:"account_id"`
+	Name         string         `json:"name"`
+	Description  sql.NullString `json:"description"`
+	Scopes       types.RawJSON  `json:"scopes"`
+	AllowedCidrs types.RawJSON  `json:"allowed_cidrs"`
+	ExpiresAt    sql.NullTime   `json:"expires_at"`
+	Active       bool           `json:"active"`
+	CreatedBy    sql.NullInt64  `json:"created_by"`
 }
 
 func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error {
@@ -37,6 +38,7 @@ func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) erro
 		arg.Name,
 		arg.Description,
 		arg.Scopes,
+		arg.AllowedCidrs,
 		arg.ExpiresAt,
 		arg.Active,
 		arg.CreatedBy,
@@ -56,22 +58,24 @@ func (q *Queries) DeleteAPIKey(ctx context.Context, publicID string) error {
 const getAPIKeyByID = `-- name: GetAPIKeyByID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, ` + "`" + `name` + "`" + `, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys WHERE id = ?
 `
 
 type GetAPIKeyByIDRow struct {
-	ID          int64           `json:"id"`
-	PublicID    string          `json:"public_id"`
-	AccountID   int64           `json:"account_id"`
-	Name        string          `json:"name"`
-	Description sql.NullString  `json:"description"`
-	Scopes      json.RawMessage `json:"scopes"`
-	CreatedAt   sql.NullTime    `json:"created_at"`
-	LastUsedAt  sql.NullTime    `json:"last_used_at"`
-	ExpiresAt   sql.NullTime    `json:"expires_at"`
-	Active      bool            `json:"active"`
-	CreatedBy   sql.NullInt64   `json:"created_by"`
+	ID           int64           `json:"id"`
+	PublicID     string          `json:"public_id"`
+	AccountID    int64           `json:"account_id"`
+	Name         string          `json:"name"`
+	Description  sql.NullString  `json:"description"`
+	Scopes       json.RawMessage `json:"scopes"`
+	AllowedCidrs json.RawMessage `json:"allowed_cidrs"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	LastUsedAt   sql.NullTime    `json:"last_used_at"`
+	ExpiresAt    sql.NullTime    `json:"expires_at"`
+	Active       bool            `json:"active"`
+	CreatedBy    sql.NullInt64   `json:"created_by"`
 }
 
 func (q *Queries) GetAPIKeyByID(ctx context.Context, id int64) (GetAPIKeyByIDRow, error) {
@@ -84,6 +88,7 @@ func (q *Queries) GetAPIKeyByID(ctx context.Context, id int64) (GetAPIKeyByIDRow
 		&i.Name,
 		&i.Description,
 		&i.Scopes,
+		&i.AllowedCidrs,
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
@@ -96,22 +101,24 @@ func (q *Queries) GetAPIKeyByID(ctx context.Context, id int64) (GetAPIKeyByIDRow
 const getAPIKeyByUUID = `-- name: GetAPIKeyByUUID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, ` + "`" + `name` + "`" + `, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys WHERE public_id = UUID_TO_BIN(?)
 `
 
 type GetAPIKeyByUUIDRow struct {
-	ID          int64           `json:"id"`
-	PublicID    string          `json:"public_id"`
-	AccountID   int64           `json:"account_id"`
-	Name        string          `json:"name"`
-	Description sql.NullString  `json:"description"`
-	Scopes      json.RawMessage `json:"scopes"`
-	CreatedAt   sql.NullTime    `json:"created_at"`
-	LastUsedAt  sql.NullTime    `json:"last_used_at"`
-	ExpiresAt   sql.NullTime    `json:"expires_at"`
-	Active      bool            `json:"active"`
-	CreatedBy   sql.NullInt64   `json:"created_by"`
+	ID           int64           `json:"id"`
+	PublicID     string          `json:"public_id"`
+	AccountID    int64           `json:"account_id"`
+	Name         string          `json:"name"`
+	Description  sql.NullString  `json:"description"`
+	Scopes       json.RawMessage `json:"scopes"`
+	AllowedCidrs json.RawMessage `json:"allowed_cidrs"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	LastUsedAt   sql.NullTime    `json:"last_used_at"`
+	ExpiresAt    sql.NullTime    `json:"expires_at"`
+	Active       bool            `json:"active"`
+	CreatedBy    sql.NullInt64   `json:"created_by"`
 }
 
 func (q *Queries) GetAPIKeyByUUID(ctx context.Context, publicID string) (GetAPIKeyByUUIDRow, error) {
@@ -124,6 +131,7 @@ func (q *Queries) GetAPIKeyByUUID(ctx context.Context, publicID string) (GetAPIK
 		&i.Name,
 		&i.Description,
 		&i.Scopes,
+		&i.AllowedCidrs,
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
@@ -136,6 +144,7 @@ func (q *Queries) GetAPIKeyByUUID(ctx context.Context, publicID string) (GetAPIK
 const getActiveAPIKeyByUUID = `-- name: GetActiveAPIKeyByUUID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, ` + "`" + `name` + "`" + `, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys
 WHERE public_id = UUID_TO_BIN(?)
@@ -144,17 +153,18 @@ WHERE public_id = UUID_TO_BIN(?)
 `
 
 type GetActiveAPIKeyByUUIDRow struct {
-	ID          int64           `json:"id"`
-	PublicID    string          `json:"public_id"`
-	AccountID   int64           `json:"account_id"`
-	Name        string          `json:"name"`
-	Description sql.NullString  `json:"description"`
-	Scopes      json.RawMessage `json:"scopes"`
-	CreatedAt   sql.NullTime    `json:"created_at"`
-	LastUsedAt  sql.NullTime    `json:"last_used_at"`
-	ExpiresAt   sql.NullTime    `json:"expires_at"`
-	Active      bool            `json:"active"`
-	CreatedBy   sql.NullInt64   `json:"created_by"`
+	ID           int64           `json:"id"`
+	PublicID     string          `json:"public_id"`
+	AccountID    int64           `json:"account_id"`
+	Name         string          `json:"name"`
+	Description  sql.NullString  `json:"description"`
+	Scopes       json.RawMessage `json:"scopes"`
+	AllowedCidrs json.RawMessage `json:"allowed_cidrs"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	LastUsedAt   sql.NullTime    `json:"last_used_at"`
+	ExpiresAt    sql.NullTime    `json:"expires_at"`
+	Active       bool            `json:"active"`
+	CreatedBy    sql.NullInt64   `json:"created_by"`
 }
 
 func (q *Queries) GetActiveAPIKeyByUUID(ctx context.Context, publicID string) (GetActiveAPIKeyByUUIDRow, error) {
@@ -167,6 +177,7 @@ func (q *Queries) GetActiveAPIKeyByUUID(ctx context.Context, publicID string) (G
 		&i.Name,
 		&i.Description,
 		&i.Scopes,
+		&i.AllowedCidrs,
 		&i.CreatedAt,
 		&i.LastUsedAt,
 		&i.ExpiresAt,
@@ -176,6 +187,174 @@ func (q *Queries) GetActiveAPIKeyByUUID(ctx context.Context, publicID string) (G
 	return i, err
 }
 
+const getOrganizationAPIKey = `-- name: GetOrganizationAPIKey :one
+SELECT k.id, BIN_TO_UUID(k.public_id) AS public_id, k.` + "`" + `name` + "`" + `, k.description,
+       COALESCE(k.scopes, '[]') as scopes,
+       COALESCE(k.allowed_cidrs, '[]') as allowed_cidrs,
+       k.created_at, k.last_used_at, k.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.auth_method
+FROM api_keys k
+JOIN accounts a ON k.account_id = a.id
+WHERE k.public_id = UUID_TO_BIN(?)
+  AND k.active = TRUE
+  AND (k.expires_at IS NULL OR k.expires_at > NOW())
+  AND k.account_id IN (
+    SELECT om.account_id FROM organization_members om
+    WHERE om.organization_id = ? AND om.status = 'active'
+    UNION
+    SELECT pm.account_id FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE p.organization_id = ? AND pm.status = 'active'
+    UNION
+    SELECT sm.account_id FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE p.organization_id = ? AND sm.status = 'active'
+  )
+`
+
+type GetOrganizationAPIKeyParams struct {
+	PublicID       string `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+}
+
+type GetOrganizationAPIKeyRow struct {
+	ID              int64              `json:"id"`
+	PublicID        string             `json:"public_id"`
+	Name            string             `json:"name"`
+	Description     sql.NullString     `json:"description"`
+	Scopes          json.RawMessage    `json:"scopes"`
+	AllowedCidrs    json.RawMessage    `json:"allowed_cidrs"`
+	CreatedAt       sql.NullTime       `json:"created_at"`
+	LastUsedAt      sql.NullTime       `json:"last_used_at"`
+	ExpiresAt       sql.NullTime       `json:"expires_at"`
+	AccountPublicID string             `json:"account_public_id"`
+	Email           string             `json:"email"`
+	UserName        sql.NullString     `json:"user_name"`
+	AuthMethod      AccountsAuthMethod `json:"auth_method"`
+}
+
+// One of the keys ListOrganizationAPIKeys lists.
+func (q *Queries) GetOrganizationAPIKey(ctx context.Context, arg GetOrganizationAPIKeyParams) (GetOrganizationAPIKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationAPIKey,
+		arg.PublicID,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.OrganizationID,
+	)
+	var i GetOrganizationAPIKeyRow
+	err := row.Scan(
+		&i.ID,
+		&i.PublicID,
+		&i.Name,
+		&i.Description,
+		&i.Scopes,
+		&i.AllowedCidrs,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.AccountPublicID,
+		&i.Email,
+		&i.UserName,
+		&i.AuthMethod,
+	)
+	return i, err
+}
+
+const listOrganizationAPIKeys = `-- name: ListOrganizationAPIKeys :many
+SELECT k.id, BIN_TO_UUID(k.public_id) AS public_id, k.` + "`" + `name` + "`" + `, k.description,
+       COALESCE(k.scopes, '[]') as scopes,
+       COALESCE(k.allowed_cidrs, '[]') as allowed_cidrs,
+       k.created_at, k.last_used_at, k.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.auth_method
+FROM api_keys k
+JOIN accounts a ON k.account_id = a.id
+WHERE k.active = TRUE
+  AND (k.expires_at IS NULL OR k.expires_at > NOW())
+  AND k.account_id IN (
+    SELECT om.account_id FROM organization_members om
+    WHERE om.organization_id = ? AND om.status = 'active'
+    UNION
+    SELECT pm.account_id FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE p.organization_id = ? AND pm.status = 'active'
+    UNION
+    SELECT sm.account_id FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE p.organization_id = ? AND sm.status = 'active'
+  )
+ORDER BY k.created_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListOrganizationAPIKeysParams struct {
+	OrganizationID int64 `json:"organization_id"`
+	Limit          int32 `json:"limit"`
+	Offset         int32 `json:"offset"`
+}
+
+type ListOrganizationAPIKeysRow struct {
+	ID              int64              `json:"id"`
+	PublicID        string             `json:"public_id"`
+	Name            string             `json:"name"`
+	Description     sql.NullString     `json:"description"`
+	Scopes          json.RawMessage    `json:"scopes"`
+	AllowedCidrs    json.RawMessage    `json:"allowed_cidrs"`
+	CreatedAt       sql.NullTime       `json:"created_at"`
+	LastUsedAt      sql.NullTime       `json:"last_used_at"`
+	ExpiresAt       sql.NullTime       `json:"expires_at"`
+	AccountPublicID string             `json:"account_public_id"`
+	Email           string             `json:"email"`
+	UserName        sql.NullString     `json:"user_name"`
+	AuthMethod      AccountsAuthMethod `json:"auth_method"`
+}
+
+// Working API keys held by anyone who is a member of the organization, or of one
+// of its projects or sites, so owners can see who can reach its resources.
+func (q *Queries) ListOrganizationAPIKeys(ctx context.Context, arg ListOrganizationAPIKeysParams) ([]ListOrganizationAPIKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationAPIKeys,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationAPIKeysRow{}
+	for rows.Next() {
+		var i ListOrganizationAPIKeysRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicID,
+			&i.Name,
+			&i.Description,
+			&i.Scopes,
+			&i.AllowedCidrs,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.AccountPublicID,
+			&i.Email,
+			&i.UserName,
+			&i.AuthMethod,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAPIKeyActive = `-- name: UpdateAPIKeyActive :exec
 UPDATE api_keys SET
   active = ?
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createAuditEvent = `-- name: CreateAuditEvent :exec
@@ -33,3 +34,117 @@ func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventPara
 	)
 	return err
 }
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT a.id, a.account_id, ac.email AS actor_email, ac.name AS actor_name,
+       a.entity_type, a.entity_id,
+       CONCAT_WS('', eo.name, ep.name, es.name) AS entity_name,
+       CONCAT_WS('', BIN_TO_UUID(eo.public_id), BIN_TO_UUID(ep.public_id), BIN_TO_UUID(es.public_id)) AS entity_public_id,
+       a.event_name, a.event_data, a.created_at
+FROM audit a
+LEFT JOIN accounts ac ON ac.id = a.account_id
+LEFT JOIN organizations eo ON a.entity_type = 'organizations' AND eo.id = a.entity_id
+LEFT JOIN projects ep ON a.entity_type = 'projects' AND ep.id = a.entity_id
+LEFT JOIN sites es ON a.entity_type = 'sites' AND es.id = a.entity_id
+WHERE (? IS NULL
+    OR (a.entity_type = 'organizations' AND a.entity_id = ?)
+    OR (a.entity_type = 'projects' AND a.entity_id IN (SELECT p.id FROM projects p WHERE p.organization_id = ?))
+    OR (a.entity_type = 'sites' AND a.entity_id IN (
+        SELECT s.id FROM sites s JOIN projects p ON p.id = s.project_id WHERE p.organization_id = ?
+    )))
+  AND (? IS NULL
+    OR (a.entity_type = 'projects' AND a.entity_id = ?)
+    OR (a.entity_type = 'sites' AND a.entity_id IN (SELECT s.id FROM sites s WHERE s.project_id = ?)))
+  AND (? IS NULL OR (a.entity_type = 'sites' AND a.entity_id = ?))
+  AND (? IS NULL OR a.account_id = ?)
+  AND (? IS NULL OR ac.email = ?)
+  AND (? IS NULL OR a.event_name LIKE ?)
+  AND (? IS NULL OR a.created_at >= ?)
+ORDER BY a.id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListAuditEventsParams struct {
+	OrganizationID sql.NullInt64  `json:"organization_id"`
+	ProjectID      sql.NullInt64  `json:"project_id"`
+	SiteID         sql.NullInt64  `json:"site_id"`
+	AccountID      sql.NullInt64  `json:"account_id"`
+	ActorEmail     sql.NullString `json:"actor_email"`
+	EventPattern   sql.NullString `json:"event_pattern"`
+	Since          sql.NullTime   `json:"since"`
+	Limit          int32          `json:"limit"`
+	Offset         int32          `json:"offset"`
+}
+
+type ListAuditEventsRow struct {
+	ID             int64           `json:"id"`
+	AccountID      int64           `json:"account_id"`
+	ActorEmail     sql.NullString  `json:"actor_email"`
+	ActorName      sql.NullString  `json:"actor_name"`
+	EntityType     AuditEntityType `json:"entity_type"`
+	EntityID       int64           `json:"entity_id"`
+	EntityName     string          `json:"entity_name"`
+	EntityPublicID string          `json:"entity_public_id"`
+	EventName      string          `json:"event_name"`
+	EventData      []byte          `json:"event_data"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+}
+
+// Audit events, newest first, for the dashboard's activity pages. Each scope includes the
+// resources beneath it: an organization's projects and sites, a project's sites. Scopes and
+// filters left NULL match everything; event_pattern is a LIKE pattern. entity_name and
+// entity_public_id are empty once the entity is deleted.
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]ListAuditEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEvents,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.ProjectID,
+		arg.SiteID,
+		arg.SiteID,
+		arg.AccountID,
+		arg.AccountID,
+		arg.ActorEmail,
+		arg.ActorEmail,
+		arg.EventPattern,
+		arg.EventPattern,
+		arg.Since,
+		arg.Since,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAuditEventsRow{}
+	for rows.Next() {
+		var i ListAuditEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.ActorEmail,
+			&i.ActorName,
+			&i.EntityType,
+			&i.EntityID,
+			&i.EntityName,
+			&i.EntityPublicID,
+			&i.EventName,
+			&i.EventData,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
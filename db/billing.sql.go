@@ -10,6 +10,17 @@ import (
 	"database/sql"
 )
 
+const clearOrganizationPaymentFailed = `-- name: ClearOrganizationPaymentFailed :exec
+UPDATE organizations
+SET payment_failed_at = NULL, payment_failed_invoice_id = NULL
+WHERE id = ?
+`
+
+func (q *Queries) ClearOrganizationPaymentFailed(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, clearOrganizationPaymentFailed, id)
+	return err
+}
+
 const createMachineType = `-- name: CreateMachineType :exec
 INSERT INTO machine_types (machine_type, display_name, vcpu, memory_gib, stripe_price_id, monthly_price_cents, active)
 VALUES (?, ?, ?, ?, ?, ?, ?)
@@ -141,7 +152,7 @@ const getOnboardingSessionByStripeCheckoutID = `-- name: GetOnboardingSessionByS
 
 
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name, BIN_TO_UUID(organization_public_id) AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
@@ -157,6 +168,8 @@ type GetOnboardingSessionByStripeCheckoutIDRow struct {
 	MachineType             sql.NullString `json:"machine_type"`
 	MachinePriceID          sql.NullString `json:"machine_price_id"`
 	DiskSizeGb              sql.NullInt32  `json:"disk_size_gb"`
+	PromoCode               sql.NullString `json:"promo_code"`
+	DiscountDescription     sql.NullString `json:"discount_description"`
 	StripeCheckoutSessionID sql.NullString `json:"stripe_checkout_session_id"`
 	StripeCheckoutUrl       sql.NullString `json:"stripe_checkout_url"`
 	StripeSubscriptionID    sql.NullString `json:"stripe_subscription_id"`
@@ -190,6 +203,8 @@ func (q *Queries) GetOnboardingSessionByStripeCheckoutID(ctx context.Context, st
 		&i.MachineType,
 		&i.MachinePriceID,
 		&i.DiskSizeGb,
+		&i.PromoCode,
+		&i.DiscountDescription,
 		&i.StripeCheckoutSessionID,
 		&i.StripeCheckoutUrl,
 		&i.StripeSubscriptionID,
@@ -210,6 +225,40 @@ func (q *Queries) GetOnboardingSessionByStripeCheckoutID(ctx context.Context, st
 	return i, err
 }
 
+const getOrganizationBillingState = `-- name: GetOrganizationBillingState :one
+SELECT billing_state, billing_state_changed_at
+FROM organizations WHERE id = ?
+`
+
+type GetOrganizationBillingStateRow struct {
+	BillingState          OrganizationsBillingState `json:"billing_state"`
+	BillingStateChangedAt sql.NullTime              `json:"billing_state_changed_at"`
+}
+
+func (q *Queries) GetOrganizationBillingState(ctx context.Context, id int64) (GetOrganizationBillingStateRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationBillingState, id)
+	var i GetOrganizationBillingStateRow
+	err := row.Scan(&i.BillingState, &i.BillingStateChangedAt)
+	return i, err
+}
+
+const getOrganizationPaymentFailure = `-- name: GetOrganizationPaymentFailure :one
+SELECT payment_failed_at, payment_failed_invoice_id
+FROM organizations WHERE id = ?
+`
+
+type GetOrganizationPaymentFailureRow struct {
+	PaymentFailedAt        sql.NullTime   `json:"payment_failed_at"`
+	PaymentFailedInvoiceID sql.NullString `json:"payment_failed_invoice_id"`
+}
+
+func (q *Queries) GetOrganizationPaymentFailure(ctx context.Context, id int64) (GetOrganizationPaymentFailureRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationPaymentFailure, id)
+	var i GetOrganizationPaymentFailureRow
+	err := row.Scan(&i.PaymentFailedAt, &i.PaymentFailedInvoiceID)
+	return i, err
+}
+
 const getStorageConfig = `-- name: GetStorageConfig :one
 SELECT id, config_key, stripe_price_id, price_per_gb_cents, min_size_gb, max_size_gb, active, created_at, updated_at
 FROM storage_config
@@ -285,6 +334,66 @@ func (q *Queries) GetStripeSubscription(ctx context.Context, publicID string) (G
 	return i, err
 }
 
+const getStripeSubscriptionByCustomerID = `-- name: GetStripeSubscriptionByCustomerID :one
+
+
+
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, stripe_subscription_id, stripe_customer_id, stripe_checkout_session_id,
+       status, current_period_start, current_period_end, trial_start, trial_end,
+       cancel_at_period_end, canceled_at, machine_type, disk_size_gb, created_at, updated_at
+FROM stripe_subscriptions WHERE stripe_customer_id = ?
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetStripeSubscriptionByCustomerIDRow struct {
+	ID                      int64                     `json:"id"`
+	PublicID                string                    `json:"public_id"`
+	OrganizationID          int64                     `json:"organization_id"`
+	StripeSubscriptionID    string                    `json:"stripe_subscription_id"`
+	StripeCustomerID        string                    `json:"stripe_customer_id"`
+	StripeCheckoutSessionID sql.NullString            `json:"stripe_checkout_session_id"`
+	Status                  StripeSubscriptionsStatus `json:"status"`
+	CurrentPeriodStart      sql.NullTime              `json:"current_period_start"`
+	CurrentPeriodEnd        sql.NullTime              `json:"current_period_end"`
+	TrialStart              sql.NullTime              `json:"trial_start"`
+	TrialEnd                sql.NullTime              `json:"trial_end"`
+	CancelAtPeriodEnd       sql.NullBool              `json:"cancel_at_period_end"`
+	CanceledAt              sql.NullTime              `json:"canceled_at"`
+	MachineType             sql.NullString            `json:"machine_type"`
+	DiskSizeGb              sql.NullInt32             `json:"disk_size_gb"`
+	CreatedAt               sql.NullTime              `json:"created_at"`
+	UpdatedAt               sql.NullTime              `json:"updated_at"`
+}
+
+// =============================================================================
+// VM RECONCILIATION ADMIN API
+// =============================================================================
+func (q *Queries) GetStripeSubscriptionByCustomerID(ctx context.Context, stripeCustomerID string) (GetStripeSubscriptionByCustomerIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getStripeSubscriptionByCustomerID, stripeCustomerID)
+	var i GetStripeSubscriptionByCustomerIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.PublicID,
+		&i.OrganizationID,
+		&i.StripeSubscriptionID,
+		&i.StripeCustomerID,
+		&i.StripeCheckoutSessionID,
+		&i.Status,
+		&i.CurrentPeriodStart,
+		&i.CurrentPeriodEnd,
+		&i.TrialStart,
+		&i.TrialEnd,
+		&i.CancelAtPeriodEnd,
+		&i.CanceledAt,
+		&i.MachineType,
+		&i.DiskSizeGb,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getStripeSubscriptionByStripeID = `-- name: GetStripeSubscriptionByStripeID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, stripe_subscription_id, stripe_customer_id, stripe_checkout_session_id,
        status, current_period_start, current_period_end, trial_start, trial_end,
@@ -418,6 +527,151 @@ func (q *Queries) ListMachineTypes(ctx context.Context) ([]MachineType, error) {
 	return items, nil
 }
 
+const listOrganizationsByBillingState = `-- name: ListOrganizationsByBillingState :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, billing_state, billing_state_changed_at
+FROM organizations
+WHERE billing_state = ? AND billing_state_changed_at < ?
+ORDER BY billing_state_changed_at
+`
+
+type ListOrganizationsByBillingStateParams struct {
+	BillingState          OrganizationsBillingState `json:"billing_state"`
+	BillingStateChangedAt sql.NullTime              `json:"billing_state_changed_at"`
+}
+
+type ListOrganizationsByBillingStateRow struct {
+	ID                    int64                     `json:"id"`
+	PublicID              string                    `json:"public_id"`
+	BillingState          OrganizationsBillingState `json:"billing_state"`
+	BillingStateChangedAt sql.NullTime              `json:"billing_state_changed_at"`
+}
+
+func (q *Queries) ListOrganizationsByBillingState(ctx context.Context, arg ListOrganizationsByBillingStateParams) ([]ListOrganizationsByBillingStateRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationsByBillingState, arg.BillingState, arg.BillingStateChangedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationsByBillingStateRow{}
+	for rows.Next() {
+		var i ListOrganizationsByBillingStateRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicID,
+			&i.BillingState,
+			&i.BillingStateChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resumeOrganizationSites = `-- name: ResumeOrganizationSites :execrows
+UPDATE sites
+SET ` + "`" + `status` + "`" + ` = 'active'
+WHERE ` + "`" + `status` + "`" + ` = 'suspended'
+  AND project_id IN (
+    SELECT p.id FROM projects p
+    JOIN organizations o ON p.organization_id = o.id
+    WHERE o.id = ? AND o.` + "`" + `status` + "`" + ` != 'suspended' AND o.billing_state IN ('active', 'past_due')
+  )
+`
+
+// Sites stay suspended while either the billing or an operator suspension holds.
+func (q *Queries) ResumeOrganizationSites(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, resumeOrganizationSites, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setOrganizationBillingState = `-- name: SetOrganizationBillingState :exec
+UPDATE organizations
+SET billing_state = ?, billing_state_changed_at = NOW()
+WHERE id = ?
+`
+
+type SetOrganizationBillingStateParams struct {
+	BillingState OrganizationsBillingState `json:"billing_state"`
+	ID           int64                     `json:"id"`
+}
+
+func (q *Queries) SetOrganizationBillingState(ctx context.Context, arg SetOrganizationBillingStateParams) error {
+	_, err := q.db.ExecContext(ctx, setOrganizationBillingState, arg.BillingState, arg.ID)
+	return err
+}
+
+const setOrganizationPaymentFailed = `-- name: SetOrganizationPaymentFailed :exec
+UPDATE organizations
+SET payment_failed_at = ?, payment_failed_invoice_id = ?
+WHERE id = ?
+`
+
+type SetOrganizationPaymentFailedParams struct {
+	PaymentFailedAt        sql.NullTime   `json:"payment_failed_at"`
+	PaymentFailedInvoiceID sql.NullString `json:"payment_failed_invoice_id"`
+	ID                     int64          `json:"id"`
+}
+
+func (q *Queries) SetOrganizationPaymentFailed(ctx context.Context, arg SetOrganizationPaymentFailedParams) error {
+	_, err := q.db.ExecContext(ctx, setOrganizationPaymentFailed, arg.PaymentFailedAt, arg.PaymentFailedInvoiceID, arg.ID)
+	return err
+}
+
+const suspendOrganizationSites = `-- name: SuspendOrganizationSites :execrows
+UPDATE sites
+SET ` + "`" + `status` + "`" + ` = 'suspended'
+WHERE ` + "`" + `status` + "`" + ` = 'active'
+  AND project_id IN (SELECT id FROM projects WHERE organization_id = ?)
+`
+
+func (q *Queries) SuspendOrganizationSites(ctx context.Context, organizationID int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, suspendOrganizationSites, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listOrganizationSitePublicIDs = `-- name: ListOrganizationSitePublicIDs :many
+SELECT BIN_TO_UUID(s.public_id) AS public_id
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+WHERE p.organization_id = ?
+`
+
+func (q *Queries) ListOrganizationSitePublicIDs(ctx context.Context, organizationID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationSitePublicIDs, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var public_id string
+		if err := rows.Scan(&public_id); err != nil {
+			return nil, err
+		}
+		items = append(items, public_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateMachineType = `-- name: UpdateMachineType :exec
 UPDATE machine_types
 SET display_name = ?, vcpu = ?, memory_gib = ?, stripe_price_id = ?, monthly_price_cents = ?, active = ?, updated_at = NOW()
@@ -490,3 +744,32 @@ func (q *Queries) UpdateStripeSubscription(ctx context.Context, arg UpdateStripe
 	)
 	return err
 }
+
+const upsertStorageConfig = `-- name: UpsertStorageConfig :exec
+INSERT INTO storage_config (config_key, stripe_price_id, price_per_gb_cents, min_size_gb, max_size_gb, active)
+VALUES ('disk_storage', ?, ?, ?, ?, TRUE)
+ON DUPLICATE KEY UPDATE
+  stripe_price_id = VALUES(stripe_price_id),
+  price_per_gb_cents = VALUES(price_per_gb_cents),
+  min_size_gb = VALUES(min_size_gb),
+  max_size_gb = VALUES(max_size_gb),
+  active = TRUE,
+  updated_at = NOW()
+`
+
+type UpsertStorageConfigParams struct {
+	StripePriceID   string `json:"stripe_price_id"`
+	PricePerGbCents int32  `json:"price_per_gb_cents"`
+	MinSizeGb       int32  `json:"min_size_gb"`
+	MaxSizeGb       int32  `json:"max_size_gb"`
+}
+
+func (q *Queries) UpsertStorageConfig(ctx context.Context, arg UpsertStorageConfigParams) error {
+	_, err := q.db.ExecContext(ctx, upsertStorageConfig,
+		arg.StripePriceID,
+		arg.PricePerGbCents,
+		arg.MinSizeGb,
+		arg.MaxSizeGb,
+	)
+	return err
+}
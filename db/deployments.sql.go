@@ -12,19 +12,24 @@ import (
 
 const createDeployment = `-- name: CreateDeployment :exec
 INSERT INTO deployments (
-  id, site_id, ` + "`" + `status` + "`" + `, github_run_id, github_run_url, started_at, completed_at, error_message, created_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW())
+  id, site_id, ` + "`" + `status` + "`" + `, git_ref, commit_sha, commit_message, rollback_of, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, created_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, UNIX_TIMESTAMP(), ?)
 `
 
 type CreateDeploymentParams struct {
-	ID           string            `json:"id"`
-	SiteID       string            `json:"site_id"`
-	Status       DeploymentsStatus `json:"status"`
-	GithubRunID  sql.NullString    `json:"github_run_id"`
-	GithubRunUrl sql.NullString    `json:"github_run_url"`
-	StartedAt    int64             `json:"started_at"`
-	CompletedAt  sql.NullInt64     `json:"completed_at"`
-	ErrorMessage sql.NullString    `json:"error_message"`
+	ID            string            `json:"id"`
+	SiteID        string            `json:"site_id"`
+	Status        DeploymentsStatus `json:"status"`
+	GitRef        sql.NullString    `json:"git_ref"`
+	CommitSha     sql.NullString    `json:"commit_sha"`
+	CommitMessage sql.NullString    `json:"commit_message"`
+	RollbackOf    sql.NullString    `json:"rollback_of"`
+	GithubRunID   sql.NullString    `json:"github_run_id"`
+	GithubRunUrl  sql.NullString    `json:"github_run_url"`
+	StartedAt     int64             `json:"started_at"`
+	CompletedAt   sql.NullInt64     `json:"completed_at"`
+	ErrorMessage  sql.NullString    `json:"error_message"`
+	CreatedBy     sql.NullInt64     `json:"created_by"`
 }
 
 func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error {
@@ -32,11 +37,16 @@ func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentPara
 		arg.ID,
 		arg.SiteID,
 		arg.Status,
+		arg.GitRef,
+		arg.CommitSha,
+		arg.CommitMessage,
+		arg.RollbackOf,
 		arg.GithubRunID,
 		arg.GithubRunUrl,
 		arg.StartedAt,
 		arg.CompletedAt,
 		arg.ErrorMessage,
+		arg.CreatedBy,
 	)
 	return err
 }
@@ -51,7 +61,7 @@ func (q *Queries) DeleteDeployment(ctx context.Context, id string) error {
 }
 
 const getDeployment = `-- name: GetDeployment :one
-SELECT id, site_id, ` + "`" + `status` + "`" + `, github_run_id, github_run_url, started_at, completed_at, error_message, created_at
+SELECT id, site_id, ` + "`" + `status` + "`" + `, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, git_ref, commit_sha, commit_message, rollback_of, created_by
 FROM deployments WHERE id = ?
 `
 
@@ -68,12 +78,17 @@ func (q *Queries) GetDeployment(ctx context.Context, id string) (Deployment, err
 		&i.CompletedAt,
 		&i.ErrorMessage,
 		&i.CreatedAt,
+		&i.GitRef,
+		&i.CommitSha,
+		&i.CommitMessage,
+		&i.RollbackOf,
+		&i.CreatedBy,
 	)
 	return i, err
 }
 
 const getLatestSiteDeployment = `-- name: GetLatestSiteDeployment :one
-SELECT id, site_id, status, github_run_id, github_run_url, started_at, completed_at, error_message, created_at FROM deployments
+SELECT id, site_id, status, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, git_ref, commit_sha, commit_message, rollback_of, created_by FROM deployments
 WHERE site_id = ?
 ORDER BY created_at DESC
 LIMIT 1
@@ -92,14 +107,19 @@ func (q *Queries) GetLatestSiteDeployment(ctx context.Context, siteID string) (D
 		&i.CompletedAt,
 		&i.ErrorMessage,
 		&i.CreatedAt,
+		&i.GitRef,
+		&i.CommitSha,
+		&i.CommitMessage,
+		&i.RollbackOf,
+		&i.CreatedBy,
 	)
 	return i, err
 }
 
 const listSiteDeployments = `-- name: ListSiteDeployments :many
-SELECT id, site_id, status, github_run_id, github_run_url, started_at, completed_at, error_message, created_at FROM deployments
+SELECT id, site_id, status, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, git_ref, commit_sha, commit_message, rollback_of, created_by FROM deployments
 WHERE site_id = ?
-ORDER BY created_at DESC
+ORDER BY created_at DESC, started_at DESC
 LIMIT ? OFFSET ?
 `
 
@@ -128,6 +148,11 @@ func (q *Queries) ListSiteDeployments(ctx context.Context, arg ListSiteDeploymen
 			&i.CompletedAt,
 			&i.ErrorMessage,
 			&i.CreatedAt,
+			&i.GitRef,
+			&i.CommitSha,
+			&i.CommitMessage,
+			&i.RollbackOf,
+			&i.CreatedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -13,7 +13,7 @@ import (
 
 const enqueueEvent = `-- name: EnqueueEvent :exec
 
-INSERT INTO event_queue (
+INSERT INTO event_outbox (
     event_id,
     event_type,
     event_source,
@@ -40,6 +40,7 @@ type EnqueueEventParams struct {
 }
 
 // EVENT QUEUE
+// Writes to the outbox in the caller's transaction; the event-router relays it into event_queue
 func (q *Queries) EnqueueEvent(ctx context.Context, arg EnqueueEventParams) error {
 	_, err := q.db.ExecContext(ctx, enqueueEvent,
 		arg.EventID,
@@ -55,6 +56,26 @@ func (q *Queries) EnqueueEvent(ctx context.Context, arg EnqueueEventParams) erro
 	return err
 }
 
+const getOldestOutboxEvent = `-- name: GetOldestOutboxEvent :one
+SELECT event_id, created_at
+FROM event_outbox
+ORDER BY id ASC
+LIMIT 1
+`
+
+type GetOldestOutboxEventRow struct {
+	EventID   string    `json:"event_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// The oldest event the event-router hasn't relayed yet
+func (q *Queries) GetOldestOutboxEvent(ctx context.Context) (GetOldestOutboxEventRow, error) {
+	row := q.db.QueryRowContext(ctx, getOldestOutboxEvent)
+	var i GetOldestOutboxEventRow
+	err := row.Scan(&i.EventID, &i.CreatedAt)
+	return i, err
+}
+
 const getPendingEvents = `-- name: GetPendingEvents :many
 SELECT id, event_id, event_type, event_source, event_subject, event_data, content_type,
         organization_id, project_id, site_id, created_at
@@ -11,13 +11,8 @@ import (
 )
 
 const listUserFirewallRules = `-- name: ListUserFirewallRules :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = ? AND reachable
 )
 SELECT id, public_id, name, status, created_at, updated_at, rule_type, cidr, parent_type, parent_name, parent_public_id FROM (
     SELECT
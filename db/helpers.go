@@ -5,3 +5,9 @@ package db
 func (q *Queries) GetDB() DBTX {
 	return q.db
 }
+
+// DBProvider is implemented by Querier implementations (including wrappers
+// such as caches) that can expose the underlying DBTX for raw SQL.
+type DBProvider interface {
+	GetDB() DBTX
+}
@@ -60,6 +60,42 @@ func (q *Queries) CreateSiteMember(ctx context.Context, arg CreateSiteMemberPara
 	return err
 }
 
+const deleteExpiredProjectMember = `-- name: DeleteExpiredProjectMember :execrows
+DELETE FROM project_members WHERE id = ? AND expires_at <= ?
+`
+
+type DeleteExpiredProjectMemberParams struct {
+	ID  int64        `json:"id"`
+	Now sql.NullTime `json:"now"`
+}
+
+// Deletes a membership only if it's still expired, so one extended since it was listed stays
+func (q *Queries) DeleteExpiredProjectMember(ctx context.Context, arg DeleteExpiredProjectMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredProjectMember, arg.ID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredSiteMember = `-- name: DeleteExpiredSiteMember :execrows
+DELETE FROM site_members WHERE id = ? AND expires_at <= ?
+`
+
+type DeleteExpiredSiteMemberParams struct {
+	ID  int64        `json:"id"`
+	Now sql.NullTime `json:"now"`
+}
+
+// Deletes a membership only if it's still expired, so one extended since it was listed stays
+func (q *Queries) DeleteExpiredSiteMember(ctx context.Context, arg DeleteExpiredSiteMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredSiteMember, arg.ID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteProjectMember = `-- name: DeleteProjectMember :exec
 DELETE FROM project_members WHERE project_id = ? AND account_id = ?
 `
@@ -128,7 +164,7 @@ func (q *Queries) GetOrganizationsByAccountID(ctx context.Context, arg GetOrgani
 }
 
 const getProjectMember = `-- name: GetProjectMember :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, account_id, ` + "`" + `role` + "`" + `, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, account_id, ` + "`" + `role` + "`" + `, expires_at, created_at, updated_at, created_by, updated_by
 FROM project_members WHERE project_id = ? AND account_id = ?
 `
 
@@ -143,6 +179,7 @@ type GetProjectMemberRow struct {
 	ProjectID int64              `json:"project_id"`
 	AccountID int64              `json:"account_id"`
 	Role      ProjectMembersRole `json:"role"`
+	ExpiresAt sql.NullTime       `json:"expires_at"`
 	CreatedAt sql.NullTime       `json:"created_at"`
 	UpdatedAt sql.NullTime       `json:"updated_at"`
 	CreatedBy sql.NullInt64      `json:"created_by"`
@@ -158,6 +195,7 @@ func (q *Queries) GetProjectMember(ctx context.Context, arg GetProjectMemberPara
 		&i.ProjectID,
 		&i.AccountID,
 		&i.Role,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -167,7 +205,7 @@ func (q *Queries) GetProjectMember(ctx context.Context, arg GetProjectMemberPara
 }
 
 const getSiteMember = `-- name: GetSiteMember :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, account_id, ` + "`" + `role` + "`" + `, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, account_id, ` + "`" + `role` + "`" + `, expires_at, created_at, updated_at, created_by, updated_by
 FROM site_members WHERE site_id = ? AND account_id = ?
 `
 
@@ -182,6 +220,7 @@ type GetSiteMemberRow struct {
 	SiteID    int64           `json:"site_id"`
 	AccountID int64           `json:"account_id"`
 	Role      SiteMembersRole `json:"role"`
+	ExpiresAt sql.NullTime    `json:"expires_at"`
 	CreatedAt sql.NullTime    `json:"created_at"`
 	UpdatedAt sql.NullTime    `json:"updated_at"`
 	CreatedBy sql.NullInt64   `json:"created_by"`
@@ -197,6 +236,7 @@ func (q *Queries) GetSiteMember(ctx context.Context, arg GetSiteMemberParams) (G
 		&i.SiteID,
 		&i.AccountID,
 		&i.Role,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -205,8 +245,134 @@ func (q *Queries) GetSiteMember(ctx context.Context, arg GetSiteMemberParams) (G
 	return i, err
 }
 
+const listExpiredProjectMembers = `-- name: ListExpiredProjectMembers :many
+SELECT pm.id, pm.project_id, pm.account_id, pm.` + "`" + `role` + "`" + `, pm.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(acc.public_id) AS account_public_id
+FROM project_members pm
+JOIN projects p ON p.id = pm.project_id
+JOIN organizations o ON o.id = p.organization_id
+JOIN accounts acc ON acc.id = pm.account_id
+WHERE pm.expires_at <= ?
+ORDER BY pm.expires_at
+LIMIT ?
+`
+
+type ListExpiredProjectMembersParams struct {
+	Now   sql.NullTime `json:"now"`
+	Limit int32        `json:"limit"`
+}
+
+type ListExpiredProjectMembersRow struct {
+	ID                   int64              `json:"id"`
+	ProjectID            int64              `json:"project_id"`
+	AccountID            int64              `json:"account_id"`
+	Role                 ProjectMembersRole `json:"role"`
+	ExpiresAt            sql.NullTime       `json:"expires_at"`
+	OrganizationPublicID string             `json:"organization_public_id"`
+	ProjectPublicID      string             `json:"project_public_id"`
+	AccountPublicID      string             `json:"account_public_id"`
+}
+
+// Memberships past their expiry, oldest first
+func (q *Queries) ListExpiredProjectMembers(ctx context.Context, arg ListExpiredProjectMembersParams) ([]ListExpiredProjectMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredProjectMembers, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListExpiredProjectMembersRow{}
+	for rows.Next() {
+		var i ListExpiredProjectMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.AccountID,
+			&i.Role,
+			&i.ExpiresAt,
+			&i.OrganizationPublicID,
+			&i.ProjectPublicID,
+			&i.AccountPublicID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredSiteMembers = `-- name: ListExpiredSiteMembers :many
+SELECT sm.id, sm.site_id, sm.account_id, sm.` + "`" + `role` + "`" + `, sm.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(p.public_id) AS project_public_id,
+       BIN_TO_UUID(s.public_id) AS site_public_id, BIN_TO_UUID(acc.public_id) AS account_public_id
+FROM site_members sm
+JOIN sites s ON s.id = sm.site_id
+JOIN projects p ON p.id = s.project_id
+JOIN organizations o ON o.id = p.organization_id
+JOIN accounts acc ON acc.id = sm.account_id
+WHERE sm.expires_at <= ?
+ORDER BY sm.expires_at
+LIMIT ?
+`
+
+type ListExpiredSiteMembersParams struct {
+	Now   sql.NullTime `json:"now"`
+	Limit int32        `json:"limit"`
+}
+
+type ListExpiredSiteMembersRow struct {
+	ID                   int64           `json:"id"`
+	SiteID               int64           `json:"site_id"`
+	AccountID            int64           `json:"account_id"`
+	Role                 SiteMembersRole `json:"role"`
+	ExpiresAt            sql.NullTime    `json:"expires_at"`
+	OrganizationPublicID string          `json:"organization_public_id"`
+	ProjectPublicID      string          `json:"project_public_id"`
+	SitePublicID         string          `json:"site_public_id"`
+	AccountPublicID      string          `json:"account_public_id"`
+}
+
+// Memberships past their expiry, oldest first
+func (q *Queries) ListExpiredSiteMembers(ctx context.Context, arg ListExpiredSiteMembersParams) ([]ListExpiredSiteMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredSiteMembers, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListExpiredSiteMembersRow{}
+	for rows.Next() {
+		var i ListExpiredSiteMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SiteID,
+			&i.AccountID,
+			&i.Role,
+			&i.ExpiresAt,
+			&i.OrganizationPublicID,
+			&i.ProjectPublicID,
+			&i.SitePublicID,
+			&i.AccountPublicID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProjectMembers = `-- name: ListProjectMembers :many
-SELECT pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.project_id, pm.account_id, pm.` + "`" + `role` + "`" + `, pm.status, pm.created_at, pm.updated_at,
+SELECT pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.project_id, pm.account_id, pm.` + "`" + `role` + "`" + `, pm.status, pm.expires_at, pm.created_at, pm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.` + "`" + `name` + "`" + `, a.github_username
 FROM project_members pm
 JOIN accounts a ON pm.account_id = a.id
@@ -228,6 +394,7 @@ type ListProjectMembersRow struct {
 	AccountID       int64                    `json:"account_id"`
 	Role            ProjectMembersRole       `json:"role"`
 	Status          NullProjectMembersStatus `json:"status"`
+	ExpiresAt       sql.NullTime             `json:"expires_at"`
 	CreatedAt       sql.NullTime             `json:"created_at"`
 	UpdatedAt       sql.NullTime             `json:"updated_at"`
 	AccountPublicID string                   `json:"account_public_id"`
@@ -252,6 +419,7 @@ func (q *Queries) ListProjectMembers(ctx context.Context, arg ListProjectMembers
 			&i.AccountID,
 			&i.Role,
 			&i.Status,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.AccountPublicID,
@@ -273,7 +441,7 @@ func (q *Queries) ListProjectMembers(ctx context.Context, arg ListProjectMembers
 }
 
 const listSiteMembers = `-- name: ListSiteMembers :many
-SELECT sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.site_id, sm.account_id, sm.` + "`" + `role` + "`" + `, sm.status, sm.created_at, sm.updated_at,
+SELECT sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.site_id, sm.account_id, sm.` + "`" + `role` + "`" + `, sm.status, sm.expires_at, sm.created_at, sm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.` + "`" + `name` + "`" + `, a.github_username
 FROM site_members sm
 JOIN accounts a ON sm.account_id = a.id
@@ -295,6 +463,7 @@ type ListSiteMembersRow struct {
 	AccountID       int64                 `json:"account_id"`
 	Role            SiteMembersRole       `json:"role"`
 	Status          NullSiteMembersStatus `json:"status"`
+	ExpiresAt       sql.NullTime          `json:"expires_at"`
 	CreatedAt       sql.NullTime          `json:"created_at"`
 	UpdatedAt       sql.NullTime          `json:"updated_at"`
 	AccountPublicID string                `json:"account_public_id"`
@@ -319,6 +488,7 @@ func (q *Queries) ListSiteMembers(ctx context.Context, arg ListSiteMembersParams
 			&i.AccountID,
 			&i.Role,
 			&i.Status,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.AccountPublicID,
@@ -340,18 +510,13 @@ func (q *Queries) ListSiteMembers(ctx context.Context, arg ListSiteMembersParams
 }
 
 const listUserMemberships = `-- name: ListUserMemberships :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = ? AND reachable
 )
-SELECT id, public_id, status, created_at, updated_at, role, email, user_name, account_public_id, parent_type, parent_name, parent_public_id FROM (
+SELECT id, public_id, status, created_at, updated_at, role, email, user_name, avatar_object, account_public_id, parent_type, parent_name, parent_public_id FROM (
     SELECT
         om.id, BIN_TO_UUID(om.public_id) AS public_id, om.status, om.created_at, om.updated_at, om.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'organization' AS parent_type,
         o.name AS parent_name,
         BIN_TO_UUID(o.public_id) AS parent_public_id
@@ -365,7 +530,7 @@ SELECT id, public_id, status, created_at, updated_at, role, email, user_name, ac
 
     SELECT
         pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.status, pm.created_at, pm.updated_at, pm.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'project' AS parent_type,
         p.name AS parent_name,
         BIN_TO_UUID(p.public_id) AS parent_public_id
@@ -381,7 +546,7 @@ SELECT id, public_id, status, created_at, updated_at, role, email, user_name, ac
 
     SELECT
         sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.status, sm.created_at, sm.updated_at, sm.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'site' AS parent_type,
         s.name AS parent_name,
         BIN_TO_UUID(s.public_id) AS parent_public_id
@@ -414,6 +579,7 @@ type ListUserMembershipsRow struct {
 	Role            OrganizationMembersRole       `json:"role"`
 	Email           string                        `json:"email"`
 	UserName        sql.NullString                `json:"user_name"`
+	AvatarObject    sql.NullString                `json:"avatar_object"`
 	AccountPublicID string                        `json:"account_public_id"`
 	ParentType      string                        `json:"parent_type"`
 	ParentName      string                        `json:"parent_name"`
@@ -445,6 +611,7 @@ func (q *Queries) ListUserMemberships(ctx context.Context, arg ListUserMembershi
 			&i.Role,
 			&i.Email,
 			&i.UserName,
+			&i.AvatarObject,
 			&i.AccountPublicID,
 			&i.ParentType,
 			&i.ParentName,
@@ -463,6 +630,42 @@ func (q *Queries) ListUserMemberships(ctx context.Context, arg ListUserMembershi
 	return items, nil
 }
 
+const setProjectMemberExpiry = `-- name: SetProjectMemberExpiry :exec
+UPDATE project_members SET
+  expires_at = ?,
+  updated_at = NOW()
+WHERE project_id = ? AND account_id = ?
+`
+
+type SetProjectMemberExpiryParams struct {
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	ProjectID int64        `json:"project_id"`
+	AccountID int64        `json:"account_id"`
+}
+
+func (q *Queries) SetProjectMemberExpiry(ctx context.Context, arg SetProjectMemberExpiryParams) error {
+	_, err := q.db.ExecContext(ctx, setProjectMemberExpiry, arg.ExpiresAt, arg.ProjectID, arg.AccountID)
+	return err
+}
+
+const setSiteMemberExpiry = `-- name: SetSiteMemberExpiry :exec
+UPDATE site_members SET
+  expires_at = ?,
+  updated_at = NOW()
+WHERE site_id = ? AND account_id = ?
+`
+
+type SetSiteMemberExpiryParams struct {
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	SiteID    int64        `json:"site_id"`
+	AccountID int64        `json:"account_id"`
+}
+
+func (q *Queries) SetSiteMemberExpiry(ctx context.Context, arg SetSiteMemberExpiryParams) error {
+	_, err := q.db.ExecContext(ctx, setSiteMemberExpiry, arg.ExpiresAt, arg.SiteID, arg.AccountID)
+	return err
+}
+
 const updateProjectMember = `-- name: UpdateProjectMember :exec
 UPDATE project_members SET
   ` + "`" + `role` + "`" + ` = ?,
@@ -14,6 +14,49 @@ import (
 	"github.com/libops/api/db/types"
 )
 
+type AccountPreferencesTheme string
+
+const (
+	AccountPreferencesThemeSystem AccountPreferencesTheme = "system"
+	AccountPreferencesThemeLight  AccountPreferencesTheme = "light"
+	AccountPreferencesThemeDark   AccountPreferencesTheme = "dark"
+)
+
+func (e *AccountPreferencesTheme) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AccountPreferencesTheme(s)
+	case string:
+		*e = AccountPreferencesTheme(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AccountPreferencesTheme: %T", src)
+	}
+	return nil
+}
+
+type NullAccountPreferencesTheme struct {
+	AccountPreferencesTheme AccountPreferencesTheme `json:"account_preferences_theme"`
+	Valid                   bool                    `json:"valid"` // Valid is true if AccountPreferencesTheme is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAccountPreferencesTheme) Scan(value interface{}) error {
+	if value == nil {
+		ns.AccountPreferencesTheme, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AccountPreferencesTheme.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAccountPreferencesTheme) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AccountPreferencesTheme), nil
+}
+
 type AccountsAuthMethod string
 
 const (
@@ -106,6 +149,48 @@ func (ns NullAuditEntityType) Value() (driver.Value, error) {
 	return string(ns.AuditEntityType), nil
 }
 
+type DeferredReconciliationsRequestType string
+
+const (
+	DeferredReconciliationsRequestTypeSecrets  DeferredReconciliationsRequestType = "secrets"
+	DeferredReconciliationsRequestTypeFirewall DeferredReconciliationsRequestType = "firewall"
+)
+
+func (e *DeferredReconciliationsRequestType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DeferredReconciliationsRequestType(s)
+	case string:
+		*e = DeferredReconciliationsRequestType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DeferredReconciliationsRequestType: %T", src)
+	}
+	return nil
+}
+
+type NullDeferredReconciliationsRequestType struct {
+	DeferredReconciliationsRequestType DeferredReconciliationsRequestType `json:"deferred_reconciliations_request_type"`
+	Valid                              bool                               `json:"valid"` // Valid is true if DeferredReconciliationsRequestType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDeferredReconciliationsRequestType) Scan(value interface{}) error {
+	if value == nil {
+		ns.DeferredReconciliationsRequestType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DeferredReconciliationsRequestType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDeferredReconciliationsRequestType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DeferredReconciliationsRequestType), nil
+}
+
 type DeploymentsStatus string
 
 const (
@@ -150,1344 +235,3237 @@ func (ns NullDeploymentsStatus) Value() (driver.Value, error) {
 	return string(ns.DeploymentsStatus), nil
 }
 
-type EventQueueStatus string
+type DeviceAuthorizationsStatus string
 
 const (
-	EventQueueStatusPending    EventQueueStatus = "pending"
-	EventQueueStatusProcessing EventQueueStatus = "processing"
-	EventQueueStatusSent       EventQueueStatus = "sent"
-	EventQueueStatusDeadLetter EventQueueStatus = "dead_letter"
-	EventQueueStatusExecuted   EventQueueStatus = "executed"
-	EventQueueStatusCollapsed  EventQueueStatus = "collapsed"
+	DeviceAuthorizationsStatusPending  DeviceAuthorizationsStatus = "pending"
+	DeviceAuthorizationsStatusApproved DeviceAuthorizationsStatus = "approved"
+	DeviceAuthorizationsStatusDenied   DeviceAuthorizationsStatus = "denied"
+	DeviceAuthorizationsStatusRedeemed DeviceAuthorizationsStatus = "redeemed"
 )
 
-func (e *EventQueueStatus) Scan(src interface{}) error {
+func (e *DeviceAuthorizationsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = EventQueueStatus(s)
+		*e = DeviceAuthorizationsStatus(s)
 	case string:
-		*e = EventQueueStatus(s)
+		*e = DeviceAuthorizationsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for EventQueueStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for DeviceAuthorizationsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullEventQueueStatus struct {
-	EventQueueStatus EventQueueStatus `json:"event_queue_status"`
-	Valid            bool             `json:"valid"` // Valid is true if EventQueueStatus is not NULL
+type NullDeviceAuthorizationsStatus struct {
+	DeviceAuthorizationsStatus DeviceAuthorizationsStatus `json:"device_authorizations_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if DeviceAuthorizationsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullEventQueueStatus) Scan(value interface{}) error {
+func (ns *NullDeviceAuthorizationsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.EventQueueStatus, ns.Valid = "", false
+		ns.DeviceAuthorizationsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.EventQueueStatus.Scan(value)
+	return ns.DeviceAuthorizationsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullEventQueueStatus) Value() (driver.Value, error) {
+func (ns NullDeviceAuthorizationsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.EventQueueStatus), nil
+	return string(ns.DeviceAuthorizationsStatus), nil
 }
 
-type OrganizationFirewallRulesRuleType string
+type EmailSendsStatus string
 
 const (
-	OrganizationFirewallRulesRuleTypeHttpsAllowed OrganizationFirewallRulesRuleType = "https_allowed"
-	OrganizationFirewallRulesRuleTypeSshAllowed   OrganizationFirewallRulesRuleType = "ssh_allowed"
-	OrganizationFirewallRulesRuleTypeBlocked      OrganizationFirewallRulesRuleType = "blocked"
+	EmailSendsStatusSent   EmailSendsStatus = "sent"
+	EmailSendsStatusFailed EmailSendsStatus = "failed"
 )
 
-func (e *OrganizationFirewallRulesRuleType) Scan(src interface{}) error {
+func (e *EmailSendsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationFirewallRulesRuleType(s)
+		*e = EmailSendsStatus(s)
 	case string:
-		*e = OrganizationFirewallRulesRuleType(s)
+		*e = EmailSendsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationFirewallRulesRuleType: %T", src)
+		return fmt.Errorf("unsupported scan type for EmailSendsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationFirewallRulesRuleType struct {
-	OrganizationFirewallRulesRuleType OrganizationFirewallRulesRuleType `json:"organization_firewall_rules_rule_type"`
-	Valid                             bool                              `json:"valid"` // Valid is true if OrganizationFirewallRulesRuleType is not NULL
+type NullEmailSendsStatus struct {
+	EmailSendsStatus EmailSendsStatus `json:"email_sends_status"`
+	Valid            bool             `json:"valid"` // Valid is true if EmailSendsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationFirewallRulesRuleType) Scan(value interface{}) error {
+func (ns *NullEmailSendsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationFirewallRulesRuleType, ns.Valid = "", false
+		ns.EmailSendsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationFirewallRulesRuleType.Scan(value)
+	return ns.EmailSendsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationFirewallRulesRuleType) Value() (driver.Value, error) {
+func (ns NullEmailSendsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationFirewallRulesRuleType), nil
+	return string(ns.EmailSendsStatus), nil
 }
 
-type OrganizationFirewallRulesStatus string
+type EventQueueStatus string
 
 const (
-	OrganizationFirewallRulesStatusUnspecified  OrganizationFirewallRulesStatus = "unspecified"
-	OrganizationFirewallRulesStatusActive       OrganizationFirewallRulesStatus = "active"
-	OrganizationFirewallRulesStatusProvisioning OrganizationFirewallRulesStatus = "provisioning"
-	OrganizationFirewallRulesStatusFailed       OrganizationFirewallRulesStatus = "failed"
-	OrganizationFirewallRulesStatusSuspended    OrganizationFirewallRulesStatus = "suspended"
-	OrganizationFirewallRulesStatusDeleted      OrganizationFirewallRulesStatus = "deleted"
+	EventQueueStatusPending    EventQueueStatus = "pending"
+	EventQueueStatusProcessing EventQueueStatus = "processing"
+	EventQueueStatusSent       EventQueueStatus = "sent"
+	EventQueueStatusDeadLetter EventQueueStatus = "dead_letter"
+	EventQueueStatusExecuted   EventQueueStatus = "executed"
+	EventQueueStatusCollapsed  EventQueueStatus = "collapsed"
 )
 
-func (e *OrganizationFirewallRulesStatus) Scan(src interface{}) error {
+func (e *EventQueueStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationFirewallRulesStatus(s)
+		*e = EventQueueStatus(s)
 	case string:
-		*e = OrganizationFirewallRulesStatus(s)
+		*e = EventQueueStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationFirewallRulesStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for EventQueueStatus: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationFirewallRulesStatus struct {
-	OrganizationFirewallRulesStatus OrganizationFirewallRulesStatus `json:"organization_firewall_rules_status"`
-	Valid                           bool                            `json:"valid"` // Valid is true if OrganizationFirewallRulesStatus is not NULL
+type NullEventQueueStatus struct {
+	EventQueueStatus EventQueueStatus `json:"event_queue_status"`
+	Valid            bool             `json:"valid"` // Valid is true if EventQueueStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationFirewallRulesStatus) Scan(value interface{}) error {
+func (ns *NullEventQueueStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationFirewallRulesStatus, ns.Valid = "", false
+		ns.EventQueueStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationFirewallRulesStatus.Scan(value)
+	return ns.EventQueueStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationFirewallRulesStatus) Value() (driver.Value, error) {
+func (ns NullEventQueueStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationFirewallRulesStatus), nil
+	return string(ns.EventQueueStatus), nil
 }
 
-type OrganizationMembersRole string
+type EventSinksKind string
 
 const (
-	OrganizationMembersRoleOwner     OrganizationMembersRole = "owner"
-	OrganizationMembersRoleDeveloper OrganizationMembersRole = "developer"
-	OrganizationMembersRoleRead      OrganizationMembersRole = "read"
+	EventSinksKindPubsubTopic   EventSinksKind = "pubsub_topic"
+	EventSinksKindStorageBucket EventSinksKind = "storage_bucket"
 )
 
-func (e *OrganizationMembersRole) Scan(src interface{}) error {
+func (e *EventSinksKind) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationMembersRole(s)
+		*e = EventSinksKind(s)
 	case string:
-		*e = OrganizationMembersRole(s)
+		*e = EventSinksKind(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationMembersRole: %T", src)
+		return fmt.Errorf("unsupported scan type for EventSinksKind: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationMembersRole struct {
-	OrganizationMembersRole OrganizationMembersRole `json:"organization_members_role"`
-	Valid                   bool                    `json:"valid"` // Valid is true if OrganizationMembersRole is not NULL
+type NullEventSinksKind struct {
+	EventSinksKind EventSinksKind `json:"event_sinks_kind"`
+	Valid          bool           `json:"valid"` // Valid is true if EventSinksKind is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationMembersRole) Scan(value interface{}) error {
+func (ns *NullEventSinksKind) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationMembersRole, ns.Valid = "", false
+		ns.EventSinksKind, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationMembersRole.Scan(value)
+	return ns.EventSinksKind.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationMembersRole) Value() (driver.Value, error) {
+func (ns NullEventSinksKind) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationMembersRole), nil
+	return string(ns.EventSinksKind), nil
 }
 
-type OrganizationMembersStatus string
+type IdempotencyKeysStatus string
 
 const (
-	OrganizationMembersStatusUnspecified  OrganizationMembersStatus = "unspecified"
-	OrganizationMembersStatusActive       OrganizationMembersStatus = "active"
-	OrganizationMembersStatusProvisioning OrganizationMembersStatus = "provisioning"
-	OrganizationMembersStatusFailed       OrganizationMembersStatus = "failed"
-	OrganizationMembersStatusSuspended    OrganizationMembersStatus = "suspended"
-	OrganizationMembersStatusDeleted      OrganizationMembersStatus = "deleted"
+	IdempotencyKeysStatusPending   IdempotencyKeysStatus = "pending"
+	IdempotencyKeysStatusCompleted IdempotencyKeysStatus = "completed"
 )
 
-func (e *OrganizationMembersStatus) Scan(src interface{}) error {
+func (e *IdempotencyKeysStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationMembersStatus(s)
+		*e = IdempotencyKeysStatus(s)
 	case string:
-		*e = OrganizationMembersStatus(s)
+		*e = IdempotencyKeysStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationMembersStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for IdempotencyKeysStatus: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationMembersStatus struct {
-	OrganizationMembersStatus OrganizationMembersStatus `json:"organization_members_status"`
-	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationMembersStatus is not NULL
+type NullIdempotencyKeysStatus struct {
+	IdempotencyKeysStatus IdempotencyKeysStatus `json:"idempotency_keys_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if IdempotencyKeysStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationMembersStatus) Scan(value interface{}) error {
+func (ns *NullIdempotencyKeysStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationMembersStatus, ns.Valid = "", false
+		ns.IdempotencyKeysStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationMembersStatus.Scan(value)
+	return ns.IdempotencyKeysStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationMembersStatus) Value() (driver.Value, error) {
+func (ns NullIdempotencyKeysStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationMembersStatus), nil
+	return string(ns.IdempotencyKeysStatus), nil
 }
 
-type OrganizationSecretsStatus string
+type MemberInvitationsResourceType string
 
 const (
-	OrganizationSecretsStatusUnspecified  OrganizationSecretsStatus = "unspecified"
-	OrganizationSecretsStatusActive       OrganizationSecretsStatus = "active"
-	OrganizationSecretsStatusProvisioning OrganizationSecretsStatus = "provisioning"
-	OrganizationSecretsStatusFailed       OrganizationSecretsStatus = "failed"
-	OrganizationSecretsStatusSuspended    OrganizationSecretsStatus = "suspended"
-	OrganizationSecretsStatusDeleted      OrganizationSecretsStatus = "deleted"
+	MemberInvitationsResourceTypeOrganization MemberInvitationsResourceType = "organization"
+	MemberInvitationsResourceTypeProject      MemberInvitationsResourceType = "project"
+	MemberInvitationsResourceTypeSite         MemberInvitationsResourceType = "site"
 )
 
-func (e *OrganizationSecretsStatus) Scan(src interface{}) error {
+func (e *MemberInvitationsResourceType) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationSecretsStatus(s)
+		*e = MemberInvitationsResourceType(s)
 	case string:
-		*e = OrganizationSecretsStatus(s)
+		*e = MemberInvitationsResourceType(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationSecretsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for MemberInvitationsResourceType: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationSecretsStatus struct {
-	OrganizationSecretsStatus OrganizationSecretsStatus `json:"organization_secrets_status"`
-	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationSecretsStatus is not NULL
+type NullMemberInvitationsResourceType struct {
+	MemberInvitationsResourceType MemberInvitationsResourceType `json:"member_invitations_resource_type"`
+	Valid                         bool                          `json:"valid"` // Valid is true if MemberInvitationsResourceType is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationSecretsStatus) Scan(value interface{}) error {
+func (ns *NullMemberInvitationsResourceType) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationSecretsStatus, ns.Valid = "", false
+		ns.MemberInvitationsResourceType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationSecretsStatus.Scan(value)
+	return ns.MemberInvitationsResourceType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationSecretsStatus) Value() (driver.Value, error) {
+func (ns NullMemberInvitationsResourceType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationSecretsStatus), nil
+	return string(ns.MemberInvitationsResourceType), nil
 }
 
-type OrganizationSettingsStatus string
+type MemberInvitationsRole string
 
 const (
-	OrganizationSettingsStatusUnspecified  OrganizationSettingsStatus = "unspecified"
-	OrganizationSettingsStatusActive       OrganizationSettingsStatus = "active"
-	OrganizationSettingsStatusProvisioning OrganizationSettingsStatus = "provisioning"
-	OrganizationSettingsStatusFailed       OrganizationSettingsStatus = "failed"
-	OrganizationSettingsStatusSuspended    OrganizationSettingsStatus = "suspended"
-	OrganizationSettingsStatusDeleted      OrganizationSettingsStatus = "deleted"
+	MemberInvitationsRoleOwner     MemberInvitationsRole = "owner"
+	MemberInvitationsRoleDeveloper MemberInvitationsRole = "developer"
+	MemberInvitationsRoleRead      MemberInvitationsRole = "read"
 )
 
-func (e *OrganizationSettingsStatus) Scan(src interface{}) error {
+func (e *MemberInvitationsRole) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationSettingsStatus(s)
+		*e = MemberInvitationsRole(s)
 	case string:
-		*e = OrganizationSettingsStatus(s)
+		*e = MemberInvitationsRole(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationSettingsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for MemberInvitationsRole: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationSettingsStatus struct {
-	OrganizationSettingsStatus OrganizationSettingsStatus `json:"organization_settings_status"`
-	Valid                      bool                       `json:"valid"` // Valid is true if OrganizationSettingsStatus is not NULL
+type NullMemberInvitationsRole struct {
+	MemberInvitationsRole MemberInvitationsRole `json:"member_invitations_role"`
+	Valid                 bool                  `json:"valid"` // Valid is true if MemberInvitationsRole is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationSettingsStatus) Scan(value interface{}) error {
+func (ns *NullMemberInvitationsRole) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationSettingsStatus, ns.Valid = "", false
+		ns.MemberInvitationsRole, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationSettingsStatus.Scan(value)
+	return ns.MemberInvitationsRole.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationSettingsStatus) Value() (driver.Value, error) {
+func (ns NullMemberInvitationsRole) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationSettingsStatus), nil
+	return string(ns.MemberInvitationsRole), nil
 }
 
-type OrganizationsLocation string
+type MemberInvitationsStatus string
 
 const (
-	OrganizationsLocationUnspecified OrganizationsLocation = "unspecified"
-	OrganizationsLocationAsia        OrganizationsLocation = "asia"
-	OrganizationsLocationAu          OrganizationsLocation = "au"
-	OrganizationsLocationCa          OrganizationsLocation = "ca"
-	OrganizationsLocationDe          OrganizationsLocation = "de"
-	OrganizationsLocationEu          OrganizationsLocation = "eu"
-	OrganizationsLocationIn          OrganizationsLocation = "in"
-	OrganizationsLocationIt          OrganizationsLocation = "it"
-	OrganizationsLocationUs          OrganizationsLocation = "us"
+	MemberInvitationsStatusPending  MemberInvitationsStatus = "pending"
+	MemberInvitationsStatusAccepted MemberInvitationsStatus = "accepted"
+	MemberInvitationsStatusRevoked  MemberInvitationsStatus = "revoked"
 )
 
-func (e *OrganizationsLocation) Scan(src interface{}) error {
+func (e *MemberInvitationsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationsLocation(s)
+		*e = MemberInvitationsStatus(s)
 	case string:
-		*e = OrganizationsLocation(s)
+		*e = MemberInvitationsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationsLocation: %T", src)
+		return fmt.Errorf("unsupported scan type for MemberInvitationsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationsLocation struct {
-	OrganizationsLocation OrganizationsLocation `json:"organizations_location"`
-	Valid                 bool                  `json:"valid"` // Valid is true if OrganizationsLocation is not NULL
+type NullMemberInvitationsStatus struct {
+	MemberInvitationsStatus MemberInvitationsStatus `json:"member_invitations_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if MemberInvitationsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationsLocation) Scan(value interface{}) error {
+func (ns *NullMemberInvitationsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationsLocation, ns.Valid = "", false
+		ns.MemberInvitationsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationsLocation.Scan(value)
+	return ns.MemberInvitationsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationsLocation) Value() (driver.Value, error) {
+func (ns NullMemberInvitationsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationsLocation), nil
+	return string(ns.MemberInvitationsStatus), nil
 }
 
-type OrganizationsStatus string
+type NotificationChannelsKind string
 
 const (
-	OrganizationsStatusUnspecified  OrganizationsStatus = "unspecified"
-	OrganizationsStatusActive       OrganizationsStatus = "active"
-	OrganizationsStatusProvisioning OrganizationsStatus = "provisioning"
-	OrganizationsStatusFailed       OrganizationsStatus = "failed"
-	OrganizationsStatusSuspended    OrganizationsStatus = "suspended"
-	OrganizationsStatusDeleted      OrganizationsStatus = "deleted"
+	NotificationChannelsKindSlackWebhook NotificationChannelsKind = "slack_webhook"
+	NotificationChannelsKindSlackApp     NotificationChannelsKind = "slack_app"
+	NotificationChannelsKindTeamsWebhook NotificationChannelsKind = "teams_webhook"
+	NotificationChannelsKindPagerduty    NotificationChannelsKind = "pagerduty"
+	NotificationChannelsKindOpsgenie     NotificationChannelsKind = "opsgenie"
 )
 
-func (e *OrganizationsStatus) Scan(src interface{}) error {
+func (e *NotificationChannelsKind) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrganizationsStatus(s)
+		*e = NotificationChannelsKind(s)
 	case string:
-		*e = OrganizationsStatus(s)
+		*e = NotificationChannelsKind(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrganizationsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for NotificationChannelsKind: %T", src)
 	}
 	return nil
 }
 
-type NullOrganizationsStatus struct {
-	OrganizationsStatus OrganizationsStatus `json:"organizations_status"`
-	Valid               bool                `json:"valid"` // Valid is true if OrganizationsStatus is not NULL
+type NullNotificationChannelsKind struct {
+	NotificationChannelsKind NotificationChannelsKind `json:"notification_channels_kind"`
+	Valid                    bool                     `json:"valid"` // Valid is true if NotificationChannelsKind is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrganizationsStatus) Scan(value interface{}) error {
+func (ns *NullNotificationChannelsKind) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrganizationsStatus, ns.Valid = "", false
+		ns.NotificationChannelsKind, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrganizationsStatus.Scan(value)
+	return ns.NotificationChannelsKind.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrganizationsStatus) Value() (driver.Value, error) {
+func (ns NullNotificationChannelsKind) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrganizationsStatus), nil
+	return string(ns.NotificationChannelsKind), nil
 }
 
-type ProjectFirewallRulesRuleType string
+type OrganizationEncryptionKeysStatus string
 
 const (
-	ProjectFirewallRulesRuleTypeHttpsAllowed ProjectFirewallRulesRuleType = "https_allowed"
-	ProjectFirewallRulesRuleTypeSshAllowed   ProjectFirewallRulesRuleType = "ssh_allowed"
-	ProjectFirewallRulesRuleTypeBlocked      ProjectFirewallRulesRuleType = "blocked"
+	OrganizationEncryptionKeysStatusPending   OrganizationEncryptionKeysStatus = "pending"
+	OrganizationEncryptionKeysStatusHealthy   OrganizationEncryptionKeysStatus = "healthy"
+	OrganizationEncryptionKeysStatusUnhealthy OrganizationEncryptionKeysStatus = "unhealthy"
 )
 
-func (e *ProjectFirewallRulesRuleType) Scan(src interface{}) error {
+func (e *OrganizationEncryptionKeysStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectFirewallRulesRuleType(s)
+		*e = OrganizationEncryptionKeysStatus(s)
 	case string:
-		*e = ProjectFirewallRulesRuleType(s)
+		*e = OrganizationEncryptionKeysStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectFirewallRulesRuleType: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationEncryptionKeysStatus: %T", src)
 	}
 	return nil
 }
 
-type NullProjectFirewallRulesRuleType struct {
-	ProjectFirewallRulesRuleType ProjectFirewallRulesRuleType `json:"project_firewall_rules_rule_type"`
-	Valid                        bool                         `json:"valid"` // Valid is true if ProjectFirewallRulesRuleType is not NULL
+type NullOrganizationEncryptionKeysStatus struct {
+	OrganizationEncryptionKeysStatus OrganizationEncryptionKeysStatus `json:"organization_encryption_keys_status"`
+	Valid                            bool                             `json:"valid"` // Valid is true if OrganizationEncryptionKeysStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectFirewallRulesRuleType) Scan(value interface{}) error {
+func (ns *NullOrganizationEncryptionKeysStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectFirewallRulesRuleType, ns.Valid = "", false
+		ns.OrganizationEncryptionKeysStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectFirewallRulesRuleType.Scan(value)
+	return ns.OrganizationEncryptionKeysStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectFirewallRulesRuleType) Value() (driver.Value, error) {
+func (ns NullOrganizationEncryptionKeysStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectFirewallRulesRuleType), nil
+	return string(ns.OrganizationEncryptionKeysStatus), nil
 }
 
-type ProjectFirewallRulesStatus string
+type OrganizationExportsStatus string
 
 const (
-	ProjectFirewallRulesStatusUnspecified  ProjectFirewallRulesStatus = "unspecified"
-	ProjectFirewallRulesStatusActive       ProjectFirewallRulesStatus = "active"
-	ProjectFirewallRulesStatusProvisioning ProjectFirewallRulesStatus = "provisioning"
-	ProjectFirewallRulesStatusFailed       ProjectFirewallRulesStatus = "failed"
-	ProjectFirewallRulesStatusSuspended    ProjectFirewallRulesStatus = "suspended"
-	ProjectFirewallRulesStatusDeleted      ProjectFirewallRulesStatus = "deleted"
+	OrganizationExportsStatusPending   OrganizationExportsStatus = "pending"
+	OrganizationExportsStatusRunning   OrganizationExportsStatus = "running"
+	OrganizationExportsStatusSucceeded OrganizationExportsStatus = "succeeded"
+	OrganizationExportsStatusFailed    OrganizationExportsStatus = "failed"
 )
 
-func (e *ProjectFirewallRulesStatus) Scan(src interface{}) error {
+func (e *OrganizationExportsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectFirewallRulesStatus(s)
+		*e = OrganizationExportsStatus(s)
 	case string:
-		*e = ProjectFirewallRulesStatus(s)
+		*e = OrganizationExportsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectFirewallRulesStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationExportsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullProjectFirewallRulesStatus struct {
-	ProjectFirewallRulesStatus ProjectFirewallRulesStatus `json:"project_firewall_rules_status"`
-	Valid                      bool                       `json:"valid"` // Valid is true if ProjectFirewallRulesStatus is not NULL
+type NullOrganizationExportsStatus struct {
+	OrganizationExportsStatus OrganizationExportsStatus `json:"organization_exports_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationExportsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectFirewallRulesStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationExportsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectFirewallRulesStatus, ns.Valid = "", false
+		ns.OrganizationExportsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectFirewallRulesStatus.Scan(value)
+	return ns.OrganizationExportsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectFirewallRulesStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationExportsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectFirewallRulesStatus), nil
+	return string(ns.OrganizationExportsStatus), nil
 }
 
-type ProjectMembersRole string
+type OrganizationFirewallRulesRuleType string
 
 const (
-	ProjectMembersRoleOwner     ProjectMembersRole = "owner"
-	ProjectMembersRoleDeveloper ProjectMembersRole = "developer"
-	ProjectMembersRoleRead      ProjectMembersRole = "read"
+	OrganizationFirewallRulesRuleTypeHttpsAllowed OrganizationFirewallRulesRuleType = "https_allowed"
+	OrganizationFirewallRulesRuleTypeSshAllowed   OrganizationFirewallRulesRuleType = "ssh_allowed"
+	OrganizationFirewallRulesRuleTypeBlocked      OrganizationFirewallRulesRuleType = "blocked"
 )
 
-func (e *ProjectMembersRole) Scan(src interface{}) error {
+func (e *OrganizationFirewallRulesRuleType) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectMembersRole(s)
+		*e = OrganizationFirewallRulesRuleType(s)
 	case string:
-		*e = ProjectMembersRole(s)
+		*e = OrganizationFirewallRulesRuleType(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectMembersRole: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationFirewallRulesRuleType: %T", src)
 	}
 	return nil
 }
 
-type NullProjectMembersRole struct {
-	ProjectMembersRole ProjectMembersRole `json:"project_members_role"`
-	Valid              bool               `json:"valid"` // Valid is true if ProjectMembersRole is not NULL
+type NullOrganizationFirewallRulesRuleType struct {
+	OrganizationFirewallRulesRuleType OrganizationFirewallRulesRuleType `json:"organization_firewall_rules_rule_type"`
+	Valid                             bool                              `json:"valid"` // Valid is true if OrganizationFirewallRulesRuleType is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectMembersRole) Scan(value interface{}) error {
+func (ns *NullOrganizationFirewallRulesRuleType) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectMembersRole, ns.Valid = "", false
+		ns.OrganizationFirewallRulesRuleType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectMembersRole.Scan(value)
+	return ns.OrganizationFirewallRulesRuleType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectMembersRole) Value() (driver.Value, error) {
+func (ns NullOrganizationFirewallRulesRuleType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectMembersRole), nil
+	return string(ns.OrganizationFirewallRulesRuleType), nil
 }
 
-type ProjectMembersStatus string
+type OrganizationFirewallRulesStatus string
 
 const (
-	ProjectMembersStatusUnspecified  ProjectMembersStatus = "unspecified"
-	ProjectMembersStatusActive       ProjectMembersStatus = "active"
-	ProjectMembersStatusProvisioning ProjectMembersStatus = "provisioning"
-	ProjectMembersStatusFailed       ProjectMembersStatus = "failed"
-	ProjectMembersStatusSuspended    ProjectMembersStatus = "suspended"
-	ProjectMembersStatusDeleted      ProjectMembersStatus = "deleted"
+	OrganizationFirewallRulesStatusUnspecified  OrganizationFirewallRulesStatus = "unspecified"
+	OrganizationFirewallRulesStatusActive       OrganizationFirewallRulesStatus = "active"
+	OrganizationFirewallRulesStatusProvisioning OrganizationFirewallRulesStatus = "provisioning"
+	OrganizationFirewallRulesStatusFailed       OrganizationFirewallRulesStatus = "failed"
+	OrganizationFirewallRulesStatusSuspended    OrganizationFirewallRulesStatus = "suspended"
+	OrganizationFirewallRulesStatusDeleted      OrganizationFirewallRulesStatus = "deleted"
 )
 
-func (e *ProjectMembersStatus) Scan(src interface{}) error {
+func (e *OrganizationFirewallRulesStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectMembersStatus(s)
+		*e = OrganizationFirewallRulesStatus(s)
 	case string:
-		*e = ProjectMembersStatus(s)
+		*e = OrganizationFirewallRulesStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectMembersStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationFirewallRulesStatus: %T", src)
 	}
 	return nil
 }
 
-type NullProjectMembersStatus struct {
-	ProjectMembersStatus ProjectMembersStatus `json:"project_members_status"`
-	Valid                bool                 `json:"valid"` // Valid is true if ProjectMembersStatus is not NULL
+type NullOrganizationFirewallRulesStatus struct {
+	OrganizationFirewallRulesStatus OrganizationFirewallRulesStatus `json:"organization_firewall_rules_status"`
+	Valid                           bool                            `json:"valid"` // Valid is true if OrganizationFirewallRulesStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectMembersStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationFirewallRulesStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectMembersStatus, ns.Valid = "", false
+		ns.OrganizationFirewallRulesStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectMembersStatus.Scan(value)
+	return ns.OrganizationFirewallRulesStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectMembersStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationFirewallRulesStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectMembersStatus), nil
+	return string(ns.OrganizationFirewallRulesStatus), nil
 }
 
-type ProjectSecretsStatus string
+type OrganizationJoinDomainsRole string
 
 const (
-	ProjectSecretsStatusUnspecified  ProjectSecretsStatus = "unspecified"
-	ProjectSecretsStatusActive       ProjectSecretsStatus = "active"
-	ProjectSecretsStatusProvisioning ProjectSecretsStatus = "provisioning"
-	ProjectSecretsStatusFailed       ProjectSecretsStatus = "failed"
-	ProjectSecretsStatusSuspended    ProjectSecretsStatus = "suspended"
-	ProjectSecretsStatusDeleted      ProjectSecretsStatus = "deleted"
+	OrganizationJoinDomainsRoleDeveloper OrganizationJoinDomainsRole = "developer"
+	OrganizationJoinDomainsRoleRead      OrganizationJoinDomainsRole = "read"
 )
 
-func (e *ProjectSecretsStatus) Scan(src interface{}) error {
+func (e *OrganizationJoinDomainsRole) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectSecretsStatus(s)
+		*e = OrganizationJoinDomainsRole(s)
 	case string:
-		*e = ProjectSecretsStatus(s)
+		*e = OrganizationJoinDomainsRole(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectSecretsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationJoinDomainsRole: %T", src)
 	}
 	return nil
 }
 
-type NullProjectSecretsStatus struct {
-	ProjectSecretsStatus ProjectSecretsStatus `json:"project_secrets_status"`
-	Valid                bool                 `json:"valid"` // Valid is true if ProjectSecretsStatus is not NULL
+type NullOrganizationJoinDomainsRole struct {
+	OrganizationJoinDomainsRole OrganizationJoinDomainsRole `json:"organization_join_domains_role"`
+	Valid                       bool                        `json:"valid"` // Valid is true if OrganizationJoinDomainsRole is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectSecretsStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationJoinDomainsRole) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectSecretsStatus, ns.Valid = "", false
+		ns.OrganizationJoinDomainsRole, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectSecretsStatus.Scan(value)
+	return ns.OrganizationJoinDomainsRole.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectSecretsStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationJoinDomainsRole) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectSecretsStatus), nil
+	return string(ns.OrganizationJoinDomainsRole), nil
 }
 
-type ProjectSettingsStatus string
+type OrganizationJoinRequestsRole string
 
 const (
-	ProjectSettingsStatusUnspecified  ProjectSettingsStatus = "unspecified"
-	ProjectSettingsStatusActive       ProjectSettingsStatus = "active"
-	ProjectSettingsStatusProvisioning ProjectSettingsStatus = "provisioning"
-	ProjectSettingsStatusFailed       ProjectSettingsStatus = "failed"
-	ProjectSettingsStatusSuspended    ProjectSettingsStatus = "suspended"
-	ProjectSettingsStatusDeleted      ProjectSettingsStatus = "deleted"
+	OrganizationJoinRequestsRoleDeveloper OrganizationJoinRequestsRole = "developer"
+	OrganizationJoinRequestsRoleRead      OrganizationJoinRequestsRole = "read"
 )
 
-func (e *ProjectSettingsStatus) Scan(src interface{}) error {
+func (e *OrganizationJoinRequestsRole) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectSettingsStatus(s)
+		*e = OrganizationJoinRequestsRole(s)
 	case string:
-		*e = ProjectSettingsStatus(s)
+		*e = OrganizationJoinRequestsRole(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectSettingsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationJoinRequestsRole: %T", src)
 	}
 	return nil
 }
 
-type NullProjectSettingsStatus struct {
-	ProjectSettingsStatus ProjectSettingsStatus `json:"project_settings_status"`
-	Valid                 bool                  `json:"valid"` // Valid is true if ProjectSettingsStatus is not NULL
+type NullOrganizationJoinRequestsRole struct {
+	OrganizationJoinRequestsRole OrganizationJoinRequestsRole `json:"organization_join_requests_role"`
+	Valid                        bool                         `json:"valid"` // Valid is true if OrganizationJoinRequestsRole is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectSettingsStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationJoinRequestsRole) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectSettingsStatus, ns.Valid = "", false
+		ns.OrganizationJoinRequestsRole, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectSettingsStatus.Scan(value)
+	return ns.OrganizationJoinRequestsRole.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectSettingsStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationJoinRequestsRole) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectSettingsStatus), nil
+	return string(ns.OrganizationJoinRequestsRole), nil
 }
 
-type ProjectsPromoteStrategy string
+type OrganizationJoinRequestsStatus string
 
 const (
-	ProjectsPromoteStrategyUnspecified   ProjectsPromoteStrategy = "unspecified"
-	ProjectsPromoteStrategyGithubTag     ProjectsPromoteStrategy = "github_tag"
-	ProjectsPromoteStrategyGithubRelease ProjectsPromoteStrategy = "github_release"
+	OrganizationJoinRequestsStatusPending  OrganizationJoinRequestsStatus = "pending"
+	OrganizationJoinRequestsStatusApproved OrganizationJoinRequestsStatus = "approved"
+	OrganizationJoinRequestsStatusDenied   OrganizationJoinRequestsStatus = "denied"
 )
 
-func (e *ProjectsPromoteStrategy) Scan(src interface{}) error {
+func (e *OrganizationJoinRequestsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectsPromoteStrategy(s)
+		*e = OrganizationJoinRequestsStatus(s)
 	case string:
-		*e = ProjectsPromoteStrategy(s)
+		*e = OrganizationJoinRequestsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectsPromoteStrategy: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationJoinRequestsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullProjectsPromoteStrategy struct {
-	ProjectsPromoteStrategy ProjectsPromoteStrategy `json:"projects_promote_strategy"`
-	Valid                   bool                    `json:"valid"` // Valid is true if ProjectsPromoteStrategy is not NULL
+type NullOrganizationJoinRequestsStatus struct {
+	OrganizationJoinRequestsStatus OrganizationJoinRequestsStatus `json:"organization_join_requests_status"`
+	Valid                          bool                           `json:"valid"` // Valid is true if OrganizationJoinRequestsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectsPromoteStrategy) Scan(value interface{}) error {
+func (ns *NullOrganizationJoinRequestsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectsPromoteStrategy, ns.Valid = "", false
+		ns.OrganizationJoinRequestsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectsPromoteStrategy.Scan(value)
+	return ns.OrganizationJoinRequestsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectsPromoteStrategy) Value() (driver.Value, error) {
+func (ns NullOrganizationJoinRequestsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectsPromoteStrategy), nil
+	return string(ns.OrganizationJoinRequestsStatus), nil
 }
 
-type ProjectsStatus string
+type OrganizationMembersRole string
 
 const (
-	ProjectsStatusUnspecified  ProjectsStatus = "unspecified"
-	ProjectsStatusActive       ProjectsStatus = "active"
-	ProjectsStatusProvisioning ProjectsStatus = "provisioning"
-	ProjectsStatusFailed       ProjectsStatus = "failed"
-	ProjectsStatusSuspended    ProjectsStatus = "suspended"
-	ProjectsStatusDeleted      ProjectsStatus = "deleted"
+	OrganizationMembersRoleOwner     OrganizationMembersRole = "owner"
+	OrganizationMembersRoleDeveloper OrganizationMembersRole = "developer"
+	OrganizationMembersRoleRead      OrganizationMembersRole = "read"
 )
 
-func (e *ProjectsStatus) Scan(src interface{}) error {
+func (e *OrganizationMembersRole) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProjectsStatus(s)
+		*e = OrganizationMembersRole(s)
 	case string:
-		*e = ProjectsStatus(s)
+		*e = OrganizationMembersRole(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProjectsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationMembersRole: %T", src)
 	}
 	return nil
 }
 
-type NullProjectsStatus struct {
-	ProjectsStatus ProjectsStatus `json:"projects_status"`
-	Valid          bool           `json:"valid"` // Valid is true if ProjectsStatus is not NULL
+type NullOrganizationMembersRole struct {
+	OrganizationMembersRole OrganizationMembersRole `json:"organization_members_role"`
+	Valid                   bool                    `json:"valid"` // Valid is true if OrganizationMembersRole is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProjectsStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationMembersRole) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProjectsStatus, ns.Valid = "", false
+		ns.OrganizationMembersRole, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProjectsStatus.Scan(value)
+	return ns.OrganizationMembersRole.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProjectsStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationMembersRole) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProjectsStatus), nil
+	return string(ns.OrganizationMembersRole), nil
 }
 
-type ReconciliationResultsResultType string
+type OrganizationMembersStatus string
 
 const (
-	ReconciliationResultsResultTypeTerraformModule  ReconciliationResultsResultType = "terraform_module"
-	ReconciliationResultsResultTypeVmReconciliation ReconciliationResultsResultType = "vm_reconciliation"
+	OrganizationMembersStatusUnspecified  OrganizationMembersStatus = "unspecified"
+	OrganizationMembersStatusActive       OrganizationMembersStatus = "active"
+	OrganizationMembersStatusProvisioning OrganizationMembersStatus = "provisioning"
+	OrganizationMembersStatusFailed       OrganizationMembersStatus = "failed"
+	OrganizationMembersStatusSuspended    OrganizationMembersStatus = "suspended"
+	OrganizationMembersStatusDeleted      OrganizationMembersStatus = "deleted"
 )
 
-func (e *ReconciliationResultsResultType) Scan(src interface{}) error {
+func (e *OrganizationMembersStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ReconciliationResultsResultType(s)
+		*e = OrganizationMembersStatus(s)
 	case string:
-		*e = ReconciliationResultsResultType(s)
+		*e = OrganizationMembersStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ReconciliationResultsResultType: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationMembersStatus: %T", src)
 	}
 	return nil
 }
 
-type NullReconciliationResultsResultType struct {
-	ReconciliationResultsResultType ReconciliationResultsResultType `json:"reconciliation_results_result_type"`
-	Valid                           bool                            `json:"valid"` // Valid is true if ReconciliationResultsResultType is not NULL
+type NullOrganizationMembersStatus struct {
+	OrganizationMembersStatus OrganizationMembersStatus `json:"organization_members_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationMembersStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullReconciliationResultsResultType) Scan(value interface{}) error {
+func (ns *NullOrganizationMembersStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ReconciliationResultsResultType, ns.Valid = "", false
+		ns.OrganizationMembersStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ReconciliationResultsResultType.Scan(value)
+	return ns.OrganizationMembersStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullReconciliationResultsResultType) Value() (driver.Value, error) {
+func (ns NullOrganizationMembersStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ReconciliationResultsResultType), nil
+	return string(ns.OrganizationMembersStatus), nil
 }
 
-type ReconciliationResultsStatus string
+type OrganizationOwnershipTransfersStatus string
 
 const (
-	ReconciliationResultsStatusSuccess ReconciliationResultsStatus = "success"
-	ReconciliationResultsStatusFailed  ReconciliationResultsStatus = "failed"
+	OrganizationOwnershipTransfersStatusPending   OrganizationOwnershipTransfersStatus = "pending"
+	OrganizationOwnershipTransfersStatusAccepted  OrganizationOwnershipTransfersStatus = "accepted"
+	OrganizationOwnershipTransfersStatusCancelled OrganizationOwnershipTransfersStatus = "cancelled"
 )
 
-func (e *ReconciliationResultsStatus) Scan(src interface{}) error {
+func (e *OrganizationOwnershipTransfersStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ReconciliationResultsStatus(s)
+		*e = OrganizationOwnershipTransfersStatus(s)
 	case string:
-		*e = ReconciliationResultsStatus(s)
+		*e = OrganizationOwnershipTransfersStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ReconciliationResultsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationOwnershipTransfersStatus: %T", src)
 	}
 	return nil
 }
 
-type NullReconciliationResultsStatus struct {
-	ReconciliationResultsStatus ReconciliationResultsStatus `json:"reconciliation_results_status"`
-	Valid                       bool                        `json:"valid"` // Valid is true if ReconciliationResultsStatus is not NULL
+type NullOrganizationOwnershipTransfersStatus struct {
+	OrganizationOwnershipTransfersStatus OrganizationOwnershipTransfersStatus `json:"organization_ownership_transfers_status"`
+	Valid                                bool                                 `json:"valid"` // Valid is true if OrganizationOwnershipTransfersStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullReconciliationResultsStatus) Scan(value interface{}) error {
+func (ns *NullOrganizationOwnershipTransfersStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ReconciliationResultsStatus, ns.Valid = "", false
+		ns.OrganizationOwnershipTransfersStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ReconciliationResultsStatus.Scan(value)
+	return ns.OrganizationOwnershipTransfersStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullReconciliationResultsStatus) Value() (driver.Value, error) {
+func (ns NullOrganizationOwnershipTransfersStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ReconciliationResultsStatus), nil
+	return string(ns.OrganizationOwnershipTransfersStatus), nil
 }
 
-type ReconciliationsReconciliationType string
+type OrganizationPoliciesEnforcement string
 
 const (
-	ReconciliationsReconciliationTypeSshKeys  ReconciliationsReconciliationType = "ssh_keys"
-	ReconciliationsReconciliationTypeSecrets  ReconciliationsReconciliationType = "secrets"
-	ReconciliationsReconciliationTypeFirewall ReconciliationsReconciliationType = "firewall"
-	ReconciliationsReconciliationTypeGeneral  ReconciliationsReconciliationType = "general"
+	OrganizationPoliciesEnforcementEnforce OrganizationPoliciesEnforcement = "enforce"
+	OrganizationPoliciesEnforcementAudit   OrganizationPoliciesEnforcement = "audit"
 )
 
-func (e *ReconciliationsReconciliationType) Scan(src interface{}) error {
+func (e *OrganizationPoliciesEnforcement) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ReconciliationsReconciliationType(s)
+		*e = OrganizationPoliciesEnforcement(s)
 	case string:
-		*e = ReconciliationsReconciliationType(s)
+		*e = OrganizationPoliciesEnforcement(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ReconciliationsReconciliationType: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationPoliciesEnforcement: %T", src)
 	}
 	return nil
 }
 
-type NullReconciliationsReconciliationType struct {
-	ReconciliationsReconciliationType ReconciliationsReconciliationType `json:"reconciliations_reconciliation_type"`
-	Valid                             bool                              `json:"valid"` // Valid is true if ReconciliationsReconciliationType is not NULL
+type NullOrganizationPoliciesEnforcement struct {
+	OrganizationPoliciesEnforcement OrganizationPoliciesEnforcement `json:"organization_policies_enforcement"`
+	Valid                           bool                            `json:"valid"` // Valid is true if OrganizationPoliciesEnforcement is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullReconciliationsReconciliationType) Scan(value interface{}) error {
+func (ns *NullOrganizationPoliciesEnforcement) Scan(value interface{}) error {
 	if value == nil {
-		ns.ReconciliationsReconciliationType, ns.Valid = "", false
+		ns.OrganizationPoliciesEnforcement, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ReconciliationsReconciliationType.Scan(value)
+	return ns.OrganizationPoliciesEnforcement.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullReconciliationsReconciliationType) Value() (driver.Value, error) {
+func (ns NullOrganizationPoliciesEnforcement) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ReconciliationsReconciliationType), nil
+	return string(ns.OrganizationPoliciesEnforcement), nil
 }
 
-type ReconciliationsRunType string
+type OrganizationPoliciesRule string
 
 const (
-	ReconciliationsRunTypeTerraform      ReconciliationsRunType = "terraform"
-	ReconciliationsRunTypeReconciliation ReconciliationsRunType = "reconciliation"
+	OrganizationPoliciesRuleProductionSecretsOwnerOnly OrganizationPoliciesRule = "production_secrets_owner_only"
+	OrganizationPoliciesRuleNoPublicSsh                OrganizationPoliciesRule = "no_public_ssh"
+	OrganizationPoliciesRuleProductionDeployWindow     OrganizationPoliciesRule = "production_deploy_window"
+	OrganizationPoliciesRuleServiceAccountWriteKeys    OrganizationPoliciesRule = "service_account_write_keys"
 )
 
-func (e *ReconciliationsRunType) Scan(src interface{}) error {
+func (e *OrganizationPoliciesRule) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ReconciliationsRunType(s)
+		*e = OrganizationPoliciesRule(s)
 	case string:
-		*e = ReconciliationsRunType(s)
+		*e = OrganizationPoliciesRule(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ReconciliationsRunType: %T", src)
+		return fmt.Errorf("unsupported scan type for OrganizationPoliciesRule: %T", src)
 	}
 	return nil
 }
 
-type NullReconciliationsRunType struct {
-	ReconciliationsRunType ReconciliationsRunType `json:"reconciliations_run_type"`
-	Valid                  bool                   `json:"valid"` // Valid is true if ReconciliationsRunType is not NULL
+type NullOrganizationPoliciesRule struct {
+	OrganizationPoliciesRule OrganizationPoliciesRule `json:"organization_policies_rule"`
+	Valid                    bool                     `json:"valid"` // Valid is true if OrganizationPoliciesRule is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullReconciliationsRunType) Scan(value interface{}) error {
+func (ns *NullOrganizationPoliciesRule) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationPoliciesRule, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationPoliciesRule.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationPoliciesRule) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationPoliciesRule), nil
+}
+
+type OrganizationSecretsStatus string
+
+const (
+	OrganizationSecretsStatusUnspecified  OrganizationSecretsStatus = "unspecified"
+	OrganizationSecretsStatusActive       OrganizationSecretsStatus = "active"
+	OrganizationSecretsStatusProvisioning OrganizationSecretsStatus = "provisioning"
+	OrganizationSecretsStatusFailed       OrganizationSecretsStatus = "failed"
+	OrganizationSecretsStatusSuspended    OrganizationSecretsStatus = "suspended"
+	OrganizationSecretsStatusDeleted      OrganizationSecretsStatus = "deleted"
+)
+
+func (e *OrganizationSecretsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationSecretsStatus(s)
+	case string:
+		*e = OrganizationSecretsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationSecretsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationSecretsStatus struct {
+	OrganizationSecretsStatus OrganizationSecretsStatus `json:"organization_secrets_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationSecretsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationSecretsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationSecretsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationSecretsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationSecretsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationSecretsStatus), nil
+}
+
+type OrganizationSettingsStatus string
+
+const (
+	OrganizationSettingsStatusUnspecified  OrganizationSettingsStatus = "unspecified"
+	OrganizationSettingsStatusActive       OrganizationSettingsStatus = "active"
+	OrganizationSettingsStatusProvisioning OrganizationSettingsStatus = "provisioning"
+	OrganizationSettingsStatusFailed       OrganizationSettingsStatus = "failed"
+	OrganizationSettingsStatusSuspended    OrganizationSettingsStatus = "suspended"
+	OrganizationSettingsStatusDeleted      OrganizationSettingsStatus = "deleted"
+)
+
+func (e *OrganizationSettingsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationSettingsStatus(s)
+	case string:
+		*e = OrganizationSettingsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationSettingsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationSettingsStatus struct {
+	OrganizationSettingsStatus OrganizationSettingsStatus `json:"organization_settings_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if OrganizationSettingsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationSettingsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationSettingsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationSettingsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationSettingsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationSettingsStatus), nil
+}
+
+type OrganizationSettingsValueType string
+
+const (
+	OrganizationSettingsValueTypeString OrganizationSettingsValueType = "string"
+	OrganizationSettingsValueTypeInt    OrganizationSettingsValueType = "int"
+	OrganizationSettingsValueTypeBool   OrganizationSettingsValueType = "bool"
+	OrganizationSettingsValueTypeEnum   OrganizationSettingsValueType = "enum"
+)
+
+func (e *OrganizationSettingsValueType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationSettingsValueType(s)
+	case string:
+		*e = OrganizationSettingsValueType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationSettingsValueType: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationSettingsValueType struct {
+	OrganizationSettingsValueType OrganizationSettingsValueType `json:"organization_settings_value_type"`
+	Valid                         bool                          `json:"valid"` // Valid is true if OrganizationSettingsValueType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationSettingsValueType) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationSettingsValueType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationSettingsValueType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationSettingsValueType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationSettingsValueType), nil
+}
+
+type OrganizationsBillingState string
+
+const (
+	OrganizationsBillingStateActive               OrganizationsBillingState = "active"
+	OrganizationsBillingStatePastDue              OrganizationsBillingState = "past_due"
+	OrganizationsBillingStateSuspended            OrganizationsBillingState = "suspended"
+	OrganizationsBillingStateScheduledForDeletion OrganizationsBillingState = "scheduled_for_deletion"
+)
+
+func (e *OrganizationsBillingState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationsBillingState(s)
+	case string:
+		*e = OrganizationsBillingState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationsBillingState: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationsBillingState struct {
+	OrganizationsBillingState OrganizationsBillingState `json:"organizations_billing_state"`
+	Valid                     bool                      `json:"valid"` // Valid is true if OrganizationsBillingState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationsBillingState) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationsBillingState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationsBillingState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationsBillingState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationsBillingState), nil
+}
+
+type OrganizationsDataResidency string
+
+const (
+	OrganizationsDataResidencyEu OrganizationsDataResidency = "eu"
+	OrganizationsDataResidencyUs OrganizationsDataResidency = "us"
+)
+
+func (e *OrganizationsDataResidency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationsDataResidency(s)
+	case string:
+		*e = OrganizationsDataResidency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationsDataResidency: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationsDataResidency struct {
+	OrganizationsDataResidency OrganizationsDataResidency `json:"organizations_data_residency"`
+	Valid                      bool                       `json:"valid"` // Valid is true if OrganizationsDataResidency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationsDataResidency) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationsDataResidency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationsDataResidency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationsDataResidency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationsDataResidency), nil
+}
+
+type OrganizationsLocation string
+
+const (
+	OrganizationsLocationUnspecified OrganizationsLocation = "unspecified"
+	OrganizationsLocationAsia        OrganizationsLocation = "asia"
+	OrganizationsLocationAu          OrganizationsLocation = "au"
+	OrganizationsLocationCa          OrganizationsLocation = "ca"
+	OrganizationsLocationDe          OrganizationsLocation = "de"
+	OrganizationsLocationEu          OrganizationsLocation = "eu"
+	OrganizationsLocationIn          OrganizationsLocation = "in"
+	OrganizationsLocationIt          OrganizationsLocation = "it"
+	OrganizationsLocationUs          OrganizationsLocation = "us"
+)
+
+func (e *OrganizationsLocation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationsLocation(s)
+	case string:
+		*e = OrganizationsLocation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationsLocation: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationsLocation struct {
+	OrganizationsLocation OrganizationsLocation `json:"organizations_location"`
+	Valid                 bool                  `json:"valid"` // Valid is true if OrganizationsLocation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationsLocation) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationsLocation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationsLocation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationsLocation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationsLocation), nil
+}
+
+type OrganizationsStatus string
+
+const (
+	OrganizationsStatusUnspecified  OrganizationsStatus = "unspecified"
+	OrganizationsStatusActive       OrganizationsStatus = "active"
+	OrganizationsStatusProvisioning OrganizationsStatus = "provisioning"
+	OrganizationsStatusFailed       OrganizationsStatus = "failed"
+	OrganizationsStatusSuspended    OrganizationsStatus = "suspended"
+	OrganizationsStatusDeleted      OrganizationsStatus = "deleted"
+)
+
+func (e *OrganizationsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationsStatus(s)
+	case string:
+		*e = OrganizationsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationsStatus struct {
+	OrganizationsStatus OrganizationsStatus `json:"organizations_status"`
+	Valid               bool                `json:"valid"` // Valid is true if OrganizationsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationsStatus), nil
+}
+
+type PolicyViolationsRule string
+
+const (
+	PolicyViolationsRuleProductionSecretsOwnerOnly PolicyViolationsRule = "production_secrets_owner_only"
+	PolicyViolationsRuleNoPublicSsh                PolicyViolationsRule = "no_public_ssh"
+	PolicyViolationsRuleProductionDeployWindow     PolicyViolationsRule = "production_deploy_window"
+	PolicyViolationsRuleServiceAccountWriteKeys    PolicyViolationsRule = "service_account_write_keys"
+)
+
+func (e *PolicyViolationsRule) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PolicyViolationsRule(s)
+	case string:
+		*e = PolicyViolationsRule(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PolicyViolationsRule: %T", src)
+	}
+	return nil
+}
+
+type NullPolicyViolationsRule struct {
+	PolicyViolationsRule PolicyViolationsRule `json:"policy_violations_rule"`
+	Valid                bool                 `json:"valid"` // Valid is true if PolicyViolationsRule is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPolicyViolationsRule) Scan(value interface{}) error {
+	if value == nil {
+		ns.PolicyViolationsRule, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PolicyViolationsRule.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPolicyViolationsRule) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PolicyViolationsRule), nil
+}
+
+type PrivateServiceConnectEndpointsStatus string
+
+const (
+	PrivateServiceConnectEndpointsStatusProvisioning PrivateServiceConnectEndpointsStatus = "provisioning"
+	PrivateServiceConnectEndpointsStatusActive       PrivateServiceConnectEndpointsStatus = "active"
+	PrivateServiceConnectEndpointsStatusDeleting     PrivateServiceConnectEndpointsStatus = "deleting"
+)
+
+func (e *PrivateServiceConnectEndpointsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrivateServiceConnectEndpointsStatus(s)
+	case string:
+		*e = PrivateServiceConnectEndpointsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrivateServiceConnectEndpointsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPrivateServiceConnectEndpointsStatus struct {
+	PrivateServiceConnectEndpointsStatus PrivateServiceConnectEndpointsStatus `json:"private_service_connect_endpoints_status"`
+	Valid                                bool                                 `json:"valid"` // Valid is true if PrivateServiceConnectEndpointsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrivateServiceConnectEndpointsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrivateServiceConnectEndpointsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrivateServiceConnectEndpointsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrivateServiceConnectEndpointsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrivateServiceConnectEndpointsStatus), nil
+}
+
+type PrivateServiceConnectEndpointsTarget string
+
+const (
+	PrivateServiceConnectEndpointsTargetApi          PrivateServiceConnectEndpointsTarget = "api"
+	PrivateServiceConnectEndpointsTargetOrchestrator PrivateServiceConnectEndpointsTarget = "orchestrator"
+	PrivateServiceConnectEndpointsTargetSmtpRelay    PrivateServiceConnectEndpointsTarget = "smtp_relay"
+)
+
+func (e *PrivateServiceConnectEndpointsTarget) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrivateServiceConnectEndpointsTarget(s)
+	case string:
+		*e = PrivateServiceConnectEndpointsTarget(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrivateServiceConnectEndpointsTarget: %T", src)
+	}
+	return nil
+}
+
+type NullPrivateServiceConnectEndpointsTarget struct {
+	PrivateServiceConnectEndpointsTarget PrivateServiceConnectEndpointsTarget `json:"private_service_connect_endpoints_target"`
+	Valid                                bool                                 `json:"valid"` // Valid is true if PrivateServiceConnectEndpointsTarget is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrivateServiceConnectEndpointsTarget) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrivateServiceConnectEndpointsTarget, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrivateServiceConnectEndpointsTarget.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrivateServiceConnectEndpointsTarget) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrivateServiceConnectEndpointsTarget), nil
+}
+
+type ProjectFirewallRulesRuleType string
+
+const (
+	ProjectFirewallRulesRuleTypeHttpsAllowed ProjectFirewallRulesRuleType = "https_allowed"
+	ProjectFirewallRulesRuleTypeSshAllowed   ProjectFirewallRulesRuleType = "ssh_allowed"
+	ProjectFirewallRulesRuleTypeBlocked      ProjectFirewallRulesRuleType = "blocked"
+)
+
+func (e *ProjectFirewallRulesRuleType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectFirewallRulesRuleType(s)
+	case string:
+		*e = ProjectFirewallRulesRuleType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectFirewallRulesRuleType: %T", src)
+	}
+	return nil
+}
+
+type NullProjectFirewallRulesRuleType struct {
+	ProjectFirewallRulesRuleType ProjectFirewallRulesRuleType `json:"project_firewall_rules_rule_type"`
+	Valid                        bool                         `json:"valid"` // Valid is true if ProjectFirewallRulesRuleType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectFirewallRulesRuleType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectFirewallRulesRuleType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectFirewallRulesRuleType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectFirewallRulesRuleType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectFirewallRulesRuleType), nil
+}
+
+type ProjectFirewallRulesStatus string
+
+const (
+	ProjectFirewallRulesStatusUnspecified  ProjectFirewallRulesStatus = "unspecified"
+	ProjectFirewallRulesStatusActive       ProjectFirewallRulesStatus = "active"
+	ProjectFirewallRulesStatusProvisioning ProjectFirewallRulesStatus = "provisioning"
+	ProjectFirewallRulesStatusFailed       ProjectFirewallRulesStatus = "failed"
+	ProjectFirewallRulesStatusSuspended    ProjectFirewallRulesStatus = "suspended"
+	ProjectFirewallRulesStatusDeleted      ProjectFirewallRulesStatus = "deleted"
+)
+
+func (e *ProjectFirewallRulesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectFirewallRulesStatus(s)
+	case string:
+		*e = ProjectFirewallRulesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectFirewallRulesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectFirewallRulesStatus struct {
+	ProjectFirewallRulesStatus ProjectFirewallRulesStatus `json:"project_firewall_rules_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if ProjectFirewallRulesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectFirewallRulesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectFirewallRulesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectFirewallRulesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectFirewallRulesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectFirewallRulesStatus), nil
+}
+
+type ProjectMembersRole string
+
+const (
+	ProjectMembersRoleOwner     ProjectMembersRole = "owner"
+	ProjectMembersRoleDeveloper ProjectMembersRole = "developer"
+	ProjectMembersRoleRead      ProjectMembersRole = "read"
+)
+
+func (e *ProjectMembersRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectMembersRole(s)
+	case string:
+		*e = ProjectMembersRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectMembersRole: %T", src)
+	}
+	return nil
+}
+
+type NullProjectMembersRole struct {
+	ProjectMembersRole ProjectMembersRole `json:"project_members_role"`
+	Valid              bool               `json:"valid"` // Valid is true if ProjectMembersRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectMembersRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectMembersRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectMembersRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectMembersRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectMembersRole), nil
+}
+
+type ProjectMembersStatus string
+
+const (
+	ProjectMembersStatusUnspecified  ProjectMembersStatus = "unspecified"
+	ProjectMembersStatusActive       ProjectMembersStatus = "active"
+	ProjectMembersStatusProvisioning ProjectMembersStatus = "provisioning"
+	ProjectMembersStatusFailed       ProjectMembersStatus = "failed"
+	ProjectMembersStatusSuspended    ProjectMembersStatus = "suspended"
+	ProjectMembersStatusDeleted      ProjectMembersStatus = "deleted"
+)
+
+func (e *ProjectMembersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectMembersStatus(s)
+	case string:
+		*e = ProjectMembersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectMembersStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectMembersStatus struct {
+	ProjectMembersStatus ProjectMembersStatus `json:"project_members_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if ProjectMembersStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectMembersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectMembersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectMembersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectMembersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectMembersStatus), nil
+}
+
+type ProjectSecretsStatus string
+
+const (
+	ProjectSecretsStatusUnspecified  ProjectSecretsStatus = "unspecified"
+	ProjectSecretsStatusActive       ProjectSecretsStatus = "active"
+	ProjectSecretsStatusProvisioning ProjectSecretsStatus = "provisioning"
+	ProjectSecretsStatusFailed       ProjectSecretsStatus = "failed"
+	ProjectSecretsStatusSuspended    ProjectSecretsStatus = "suspended"
+	ProjectSecretsStatusDeleted      ProjectSecretsStatus = "deleted"
+)
+
+func (e *ProjectSecretsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectSecretsStatus(s)
+	case string:
+		*e = ProjectSecretsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectSecretsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectSecretsStatus struct {
+	ProjectSecretsStatus ProjectSecretsStatus `json:"project_secrets_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if ProjectSecretsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectSecretsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectSecretsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectSecretsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectSecretsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectSecretsStatus), nil
+}
+
+type ProjectSettingsStatus string
+
+const (
+	ProjectSettingsStatusUnspecified  ProjectSettingsStatus = "unspecified"
+	ProjectSettingsStatusActive       ProjectSettingsStatus = "active"
+	ProjectSettingsStatusProvisioning ProjectSettingsStatus = "provisioning"
+	ProjectSettingsStatusFailed       ProjectSettingsStatus = "failed"
+	ProjectSettingsStatusSuspended    ProjectSettingsStatus = "suspended"
+	ProjectSettingsStatusDeleted      ProjectSettingsStatus = "deleted"
+)
+
+func (e *ProjectSettingsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectSettingsStatus(s)
+	case string:
+		*e = ProjectSettingsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectSettingsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectSettingsStatus struct {
+	ProjectSettingsStatus ProjectSettingsStatus `json:"project_settings_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if ProjectSettingsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectSettingsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectSettingsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectSettingsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectSettingsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectSettingsStatus), nil
+}
+
+type ProjectSettingsValueType string
+
+const (
+	ProjectSettingsValueTypeString ProjectSettingsValueType = "string"
+	ProjectSettingsValueTypeInt    ProjectSettingsValueType = "int"
+	ProjectSettingsValueTypeBool   ProjectSettingsValueType = "bool"
+	ProjectSettingsValueTypeEnum   ProjectSettingsValueType = "enum"
+)
+
+func (e *ProjectSettingsValueType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectSettingsValueType(s)
+	case string:
+		*e = ProjectSettingsValueType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectSettingsValueType: %T", src)
+	}
+	return nil
+}
+
+type NullProjectSettingsValueType struct {
+	ProjectSettingsValueType ProjectSettingsValueType `json:"project_settings_value_type"`
+	Valid                    bool                     `json:"valid"` // Valid is true if ProjectSettingsValueType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectSettingsValueType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectSettingsValueType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectSettingsValueType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectSettingsValueType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectSettingsValueType), nil
+}
+
+type ProjectsPromoteStrategy string
+
+const (
+	ProjectsPromoteStrategyUnspecified   ProjectsPromoteStrategy = "unspecified"
+	ProjectsPromoteStrategyGithubTag     ProjectsPromoteStrategy = "github_tag"
+	ProjectsPromoteStrategyGithubRelease ProjectsPromoteStrategy = "github_release"
+)
+
+func (e *ProjectsPromoteStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectsPromoteStrategy(s)
+	case string:
+		*e = ProjectsPromoteStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectsPromoteStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullProjectsPromoteStrategy struct {
+	ProjectsPromoteStrategy ProjectsPromoteStrategy `json:"projects_promote_strategy"`
+	Valid                   bool                    `json:"valid"` // Valid is true if ProjectsPromoteStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectsPromoteStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectsPromoteStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectsPromoteStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectsPromoteStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectsPromoteStrategy), nil
+}
+
+type ProjectsStatus string
+
+const (
+	ProjectsStatusUnspecified  ProjectsStatus = "unspecified"
+	ProjectsStatusActive       ProjectsStatus = "active"
+	ProjectsStatusProvisioning ProjectsStatus = "provisioning"
+	ProjectsStatusFailed       ProjectsStatus = "failed"
+	ProjectsStatusSuspended    ProjectsStatus = "suspended"
+	ProjectsStatusDeleted      ProjectsStatus = "deleted"
+)
+
+func (e *ProjectsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProjectsStatus(s)
+	case string:
+		*e = ProjectsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProjectsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProjectsStatus struct {
+	ProjectsStatus ProjectsStatus `json:"projects_status"`
+	Valid          bool           `json:"valid"` // Valid is true if ProjectsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProjectsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProjectsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProjectsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProjectsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProjectsStatus), nil
+}
+
+type ReconciliationResultsResultType string
+
+const (
+	ReconciliationResultsResultTypeTerraformModule  ReconciliationResultsResultType = "terraform_module"
+	ReconciliationResultsResultTypeVmReconciliation ReconciliationResultsResultType = "vm_reconciliation"
+)
+
+func (e *ReconciliationResultsResultType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationResultsResultType(s)
+	case string:
+		*e = ReconciliationResultsResultType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationResultsResultType: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationResultsResultType struct {
+	ReconciliationResultsResultType ReconciliationResultsResultType `json:"reconciliation_results_result_type"`
+	Valid                           bool                            `json:"valid"` // Valid is true if ReconciliationResultsResultType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationResultsResultType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationResultsResultType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationResultsResultType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationResultsResultType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationResultsResultType), nil
+}
+
+type ReconciliationResultsStatus string
+
+const (
+	ReconciliationResultsStatusSuccess ReconciliationResultsStatus = "success"
+	ReconciliationResultsStatusFailed  ReconciliationResultsStatus = "failed"
+)
+
+func (e *ReconciliationResultsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationResultsStatus(s)
+	case string:
+		*e = ReconciliationResultsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationResultsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationResultsStatus struct {
+	ReconciliationResultsStatus ReconciliationResultsStatus `json:"reconciliation_results_status"`
+	Valid                       bool                        `json:"valid"` // Valid is true if ReconciliationResultsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationResultsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationResultsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationResultsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationResultsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationResultsStatus), nil
+}
+
+type ReconciliationsReconciliationType string
+
+const (
+	ReconciliationsReconciliationTypeSshKeys  ReconciliationsReconciliationType = "ssh_keys"
+	ReconciliationsReconciliationTypeSecrets  ReconciliationsReconciliationType = "secrets"
+	ReconciliationsReconciliationTypeFirewall ReconciliationsReconciliationType = "firewall"
+	ReconciliationsReconciliationTypeGeneral  ReconciliationsReconciliationType = "general"
+)
+
+func (e *ReconciliationsReconciliationType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationsReconciliationType(s)
+	case string:
+		*e = ReconciliationsReconciliationType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationsReconciliationType: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationsReconciliationType struct {
+	ReconciliationsReconciliationType ReconciliationsReconciliationType `json:"reconciliations_reconciliation_type"`
+	Valid                             bool                              `json:"valid"` // Valid is true if ReconciliationsReconciliationType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationsReconciliationType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationsReconciliationType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationsReconciliationType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationsReconciliationType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationsReconciliationType), nil
+}
+
+type ReconciliationsRetryState string
+
+const (
+	ReconciliationsRetryStateScheduled  ReconciliationsRetryState = "scheduled"
+	ReconciliationsRetryStateRetried    ReconciliationsRetryState = "retried"
+	ReconciliationsRetryStateSuperseded ReconciliationsRetryState = "superseded"
+	ReconciliationsRetryStateExhausted  ReconciliationsRetryState = "exhausted"
+)
+
+func (e *ReconciliationsRetryState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationsRetryState(s)
+	case string:
+		*e = ReconciliationsRetryState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationsRetryState: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationsRetryState struct {
+	ReconciliationsRetryState ReconciliationsRetryState `json:"reconciliations_retry_state"`
+	Valid                     bool                      `json:"valid"` // Valid is true if ReconciliationsRetryState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationsRetryState) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationsRetryState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationsRetryState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationsRetryState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationsRetryState), nil
+}
+
+type ReconciliationsRunType string
+
+const (
+	ReconciliationsRunTypeTerraform      ReconciliationsRunType = "terraform"
+	ReconciliationsRunTypeReconciliation ReconciliationsRunType = "reconciliation"
+)
+
+func (e *ReconciliationsRunType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationsRunType(s)
+	case string:
+		*e = ReconciliationsRunType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationsRunType: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationsRunType struct {
+	ReconciliationsRunType ReconciliationsRunType `json:"reconciliations_run_type"`
+	Valid                  bool                   `json:"valid"` // Valid is true if ReconciliationsRunType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationsRunType) Scan(value interface{}) error {
 	if value == nil {
 		ns.ReconciliationsRunType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ReconciliationsRunType.Scan(value)
+	return ns.ReconciliationsRunType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationsRunType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationsRunType), nil
+}
+
+type ReconciliationsStatus string
+
+const (
+	ReconciliationsStatusPending   ReconciliationsStatus = "pending"
+	ReconciliationsStatusTriggered ReconciliationsStatus = "triggered"
+	ReconciliationsStatusRunning   ReconciliationsStatus = "running"
+	ReconciliationsStatusCompleted ReconciliationsStatus = "completed"
+	ReconciliationsStatusFailed    ReconciliationsStatus = "failed"
+	ReconciliationsStatusCancelled ReconciliationsStatus = "cancelled"
+)
+
+func (e *ReconciliationsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReconciliationsStatus(s)
+	case string:
+		*e = ReconciliationsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReconciliationsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReconciliationsStatus struct {
+	ReconciliationsStatus ReconciliationsStatus `json:"reconciliations_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if ReconciliationsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReconciliationsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReconciliationsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReconciliationsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReconciliationsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReconciliationsStatus), nil
+}
+
+type RelationshipsMaxRole string
+
+const (
+	RelationshipsMaxRoleOwner     RelationshipsMaxRole = "owner"
+	RelationshipsMaxRoleDeveloper RelationshipsMaxRole = "developer"
+	RelationshipsMaxRoleRead      RelationshipsMaxRole = "read"
+)
+
+func (e *RelationshipsMaxRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RelationshipsMaxRole(s)
+	case string:
+		*e = RelationshipsMaxRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RelationshipsMaxRole: %T", src)
+	}
+	return nil
+}
+
+type NullRelationshipsMaxRole struct {
+	RelationshipsMaxRole RelationshipsMaxRole `json:"relationships_max_role"`
+	Valid                bool                 `json:"valid"` // Valid is true if RelationshipsMaxRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRelationshipsMaxRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.RelationshipsMaxRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RelationshipsMaxRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRelationshipsMaxRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RelationshipsMaxRole), nil
+}
+
+type RelationshipsRelationshipType string
+
+const (
+	RelationshipsRelationshipTypeAccess RelationshipsRelationshipType = "access"
+	RelationshipsRelationshipTypeMerge  RelationshipsRelationshipType = "merge"
+)
+
+func (e *RelationshipsRelationshipType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RelationshipsRelationshipType(s)
+	case string:
+		*e = RelationshipsRelationshipType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RelationshipsRelationshipType: %T", src)
+	}
+	return nil
+}
+
+type NullRelationshipsRelationshipType struct {
+	RelationshipsRelationshipType RelationshipsRelationshipType `json:"relationships_relationship_type"`
+	Valid                         bool                          `json:"valid"` // Valid is true if RelationshipsRelationshipType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRelationshipsRelationshipType) Scan(value interface{}) error {
+	if value == nil {
+		ns.RelationshipsRelationshipType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RelationshipsRelationshipType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRelationshipsRelationshipType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RelationshipsRelationshipType), nil
+}
+
+type RelationshipsStatus string
+
+const (
+	RelationshipsStatusPending  RelationshipsStatus = "pending"
+	RelationshipsStatusApproved RelationshipsStatus = "approved"
+	RelationshipsStatusRejected RelationshipsStatus = "rejected"
+	RelationshipsStatusRevoked  RelationshipsStatus = "revoked"
+)
+
+func (e *RelationshipsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RelationshipsStatus(s)
+	case string:
+		*e = RelationshipsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RelationshipsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRelationshipsStatus struct {
+	RelationshipsStatus RelationshipsStatus `json:"relationships_status"`
+	Valid               bool                `json:"valid"` // Valid is true if RelationshipsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRelationshipsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RelationshipsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RelationshipsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRelationshipsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RelationshipsStatus), nil
+}
+
+type SiteAccessProtectionsMode string
+
+const (
+	SiteAccessProtectionsModeNone        SiteAccessProtectionsMode = "none"
+	SiteAccessProtectionsModeBasicAuth   SiteAccessProtectionsMode = "basic_auth"
+	SiteAccessProtectionsModeMembersOnly SiteAccessProtectionsMode = "members_only"
+)
+
+func (e *SiteAccessProtectionsMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteAccessProtectionsMode(s)
+	case string:
+		*e = SiteAccessProtectionsMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteAccessProtectionsMode: %T", src)
+	}
+	return nil
+}
+
+type NullSiteAccessProtectionsMode struct {
+	SiteAccessProtectionsMode SiteAccessProtectionsMode `json:"site_access_protections_mode"`
+	Valid                     bool                      `json:"valid"` // Valid is true if SiteAccessProtectionsMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteAccessProtectionsMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteAccessProtectionsMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteAccessProtectionsMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteAccessProtectionsMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteAccessProtectionsMode), nil
+}
+
+type SiteAddonsKind string
+
+const (
+	SiteAddonsKindSolr      SiteAddonsKind = "solr"
+	SiteAddonsKindMemcached SiteAddonsKind = "memcached"
+	SiteAddonsKindRedis     SiteAddonsKind = "redis"
+	SiteAddonsKindMatomo    SiteAddonsKind = "matomo"
+)
+
+func (e *SiteAddonsKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteAddonsKind(s)
+	case string:
+		*e = SiteAddonsKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteAddonsKind: %T", src)
+	}
+	return nil
+}
+
+type NullSiteAddonsKind struct {
+	SiteAddonsKind SiteAddonsKind `json:"site_addons_kind"`
+	Valid          bool           `json:"valid"` // Valid is true if SiteAddonsKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteAddonsKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteAddonsKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteAddonsKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteAddonsKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteAddonsKind), nil
+}
+
+type SiteAddonsStatus string
+
+const (
+	SiteAddonsStatusProvisioning SiteAddonsStatus = "provisioning"
+	SiteAddonsStatusHealthy      SiteAddonsStatus = "healthy"
+	SiteAddonsStatusUnhealthy    SiteAddonsStatus = "unhealthy"
+)
+
+func (e *SiteAddonsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteAddonsStatus(s)
+	case string:
+		*e = SiteAddonsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteAddonsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteAddonsStatus struct {
+	SiteAddonsStatus SiteAddonsStatus `json:"site_addons_status"`
+	Valid            bool             `json:"valid"` // Valid is true if SiteAddonsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteAddonsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteAddonsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteAddonsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteAddonsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteAddonsStatus), nil
+}
+
+type SiteCachePurgesStatus string
+
+const (
+	SiteCachePurgesStatusPending   SiteCachePurgesStatus = "pending"
+	SiteCachePurgesStatusCompleted SiteCachePurgesStatus = "completed"
+)
+
+func (e *SiteCachePurgesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteCachePurgesStatus(s)
+	case string:
+		*e = SiteCachePurgesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteCachePurgesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteCachePurgesStatus struct {
+	SiteCachePurgesStatus SiteCachePurgesStatus `json:"site_cache_purges_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if SiteCachePurgesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteCachePurgesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteCachePurgesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteCachePurgesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteCachePurgesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteCachePurgesStatus), nil
+}
+
+type SiteCdnConfigsCacheMode string
+
+const (
+	SiteCdnConfigsCacheModeCacheAllStatic   SiteCdnConfigsCacheMode = "cache_all_static"
+	SiteCdnConfigsCacheModeUseOriginHeaders SiteCdnConfigsCacheMode = "use_origin_headers"
+	SiteCdnConfigsCacheModeForceCacheAll    SiteCdnConfigsCacheMode = "force_cache_all"
+)
+
+func (e *SiteCdnConfigsCacheMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteCdnConfigsCacheMode(s)
+	case string:
+		*e = SiteCdnConfigsCacheMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteCdnConfigsCacheMode: %T", src)
+	}
+	return nil
+}
+
+type NullSiteCdnConfigsCacheMode struct {
+	SiteCdnConfigsCacheMode SiteCdnConfigsCacheMode `json:"site_cdn_configs_cache_mode"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SiteCdnConfigsCacheMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteCdnConfigsCacheMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteCdnConfigsCacheMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteCdnConfigsCacheMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteCdnConfigsCacheMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteCdnConfigsCacheMode), nil
+}
+
+type SiteCdnConfigsStatus string
+
+const (
+	SiteCdnConfigsStatusProvisioning SiteCdnConfigsStatus = "provisioning"
+	SiteCdnConfigsStatusActive       SiteCdnConfigsStatus = "active"
+	SiteCdnConfigsStatusDisabling    SiteCdnConfigsStatus = "disabling"
+)
+
+func (e *SiteCdnConfigsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteCdnConfigsStatus(s)
+	case string:
+		*e = SiteCdnConfigsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteCdnConfigsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteCdnConfigsStatus struct {
+	SiteCdnConfigsStatus SiteCdnConfigsStatus `json:"site_cdn_configs_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if SiteCdnConfigsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteCdnConfigsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteCdnConfigsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteCdnConfigsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteCdnConfigsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteCdnConfigsStatus), nil
+}
+
+type SiteDatabasesStatus string
+
+const (
+	SiteDatabasesStatusProvisioning SiteDatabasesStatus = "provisioning"
+	SiteDatabasesStatusActive       SiteDatabasesStatus = "active"
+	SiteDatabasesStatusFailed       SiteDatabasesStatus = "failed"
+)
+
+func (e *SiteDatabasesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteDatabasesStatus(s)
+	case string:
+		*e = SiteDatabasesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteDatabasesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteDatabasesStatus struct {
+	SiteDatabasesStatus SiteDatabasesStatus `json:"site_databases_status"`
+	Valid               bool                `json:"valid"` // Valid is true if SiteDatabasesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteDatabasesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteDatabasesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteDatabasesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteDatabasesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteDatabasesStatus), nil
+}
+
+type SiteDatabasesTier string
+
+const (
+	SiteDatabasesTierColocated SiteDatabasesTier = "colocated"
+	SiteDatabasesTierCloudSql  SiteDatabasesTier = "cloud_sql"
+)
+
+func (e *SiteDatabasesTier) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteDatabasesTier(s)
+	case string:
+		*e = SiteDatabasesTier(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteDatabasesTier: %T", src)
+	}
+	return nil
+}
+
+type NullSiteDatabasesTier struct {
+	SiteDatabasesTier SiteDatabasesTier `json:"site_databases_tier"`
+	Valid             bool              `json:"valid"` // Valid is true if SiteDatabasesTier is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteDatabasesTier) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteDatabasesTier, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteDatabasesTier.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteDatabasesTier) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteDatabasesTier), nil
+}
+
+type SiteElevationsRole string
+
+const (
+	SiteElevationsRoleOwner     SiteElevationsRole = "owner"
+	SiteElevationsRoleDeveloper SiteElevationsRole = "developer"
+)
+
+func (e *SiteElevationsRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteElevationsRole(s)
+	case string:
+		*e = SiteElevationsRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteElevationsRole: %T", src)
+	}
+	return nil
+}
+
+type NullSiteElevationsRole struct {
+	SiteElevationsRole SiteElevationsRole `json:"site_elevations_role"`
+	Valid              bool               `json:"valid"` // Valid is true if SiteElevationsRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteElevationsRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteElevationsRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteElevationsRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteElevationsRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteElevationsRole), nil
+}
+
+type SiteElevationsStatus string
+
+const (
+	SiteElevationsStatusPending  SiteElevationsStatus = "pending"
+	SiteElevationsStatusApproved SiteElevationsStatus = "approved"
+	SiteElevationsStatusRejected SiteElevationsStatus = "rejected"
+	SiteElevationsStatusRevoked  SiteElevationsStatus = "revoked"
+	SiteElevationsStatusExpired  SiteElevationsStatus = "expired"
+)
+
+func (e *SiteElevationsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteElevationsStatus(s)
+	case string:
+		*e = SiteElevationsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteElevationsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteElevationsStatus struct {
+	SiteElevationsStatus SiteElevationsStatus `json:"site_elevations_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if SiteElevationsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteElevationsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteElevationsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteElevationsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteElevationsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteElevationsStatus), nil
+}
+
+type SiteFirewallRulesRuleType string
+
+const (
+	SiteFirewallRulesRuleTypeHttpsAllowed SiteFirewallRulesRuleType = "https_allowed"
+	SiteFirewallRulesRuleTypeSshAllowed   SiteFirewallRulesRuleType = "ssh_allowed"
+	SiteFirewallRulesRuleTypeBlocked      SiteFirewallRulesRuleType = "blocked"
+)
+
+func (e *SiteFirewallRulesRuleType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteFirewallRulesRuleType(s)
+	case string:
+		*e = SiteFirewallRulesRuleType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteFirewallRulesRuleType: %T", src)
+	}
+	return nil
+}
+
+type NullSiteFirewallRulesRuleType struct {
+	SiteFirewallRulesRuleType SiteFirewallRulesRuleType `json:"site_firewall_rules_rule_type"`
+	Valid                     bool                      `json:"valid"` // Valid is true if SiteFirewallRulesRuleType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteFirewallRulesRuleType) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteFirewallRulesRuleType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteFirewallRulesRuleType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteFirewallRulesRuleType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteFirewallRulesRuleType), nil
+}
+
+type SiteFirewallRulesStatus string
+
+const (
+	SiteFirewallRulesStatusUnspecified  SiteFirewallRulesStatus = "unspecified"
+	SiteFirewallRulesStatusActive       SiteFirewallRulesStatus = "active"
+	SiteFirewallRulesStatusProvisioning SiteFirewallRulesStatus = "provisioning"
+	SiteFirewallRulesStatusFailed       SiteFirewallRulesStatus = "failed"
+	SiteFirewallRulesStatusSuspended    SiteFirewallRulesStatus = "suspended"
+	SiteFirewallRulesStatusDeleted      SiteFirewallRulesStatus = "deleted"
+)
+
+func (e *SiteFirewallRulesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteFirewallRulesStatus(s)
+	case string:
+		*e = SiteFirewallRulesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteFirewallRulesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteFirewallRulesStatus struct {
+	SiteFirewallRulesStatus SiteFirewallRulesStatus `json:"site_firewall_rules_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SiteFirewallRulesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteFirewallRulesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteFirewallRulesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteFirewallRulesStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullReconciliationsRunType) Value() (driver.Value, error) {
+func (ns NullSiteFirewallRulesStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ReconciliationsRunType), nil
+	return string(ns.SiteFirewallRulesStatus), nil
 }
 
-type ReconciliationsStatus string
+type SiteIncidentsCause string
 
 const (
-	ReconciliationsStatusPending   ReconciliationsStatus = "pending"
-	ReconciliationsStatusTriggered ReconciliationsStatus = "triggered"
-	ReconciliationsStatusRunning   ReconciliationsStatus = "running"
-	ReconciliationsStatusCompleted ReconciliationsStatus = "completed"
-	ReconciliationsStatusFailed    ReconciliationsStatus = "failed"
+	SiteIncidentsCauseCheckinMissed  SiteIncidentsCause = "checkin_missed"
+	SiteIncidentsCauseProbeFailed    SiteIncidentsCause = "probe_failed"
+	SiteIncidentsCauseHealthDegraded SiteIncidentsCause = "health_degraded"
 )
 
-func (e *ReconciliationsStatus) Scan(src interface{}) error {
+func (e *SiteIncidentsCause) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ReconciliationsStatus(s)
+		*e = SiteIncidentsCause(s)
 	case string:
-		*e = ReconciliationsStatus(s)
+		*e = SiteIncidentsCause(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ReconciliationsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteIncidentsCause: %T", src)
 	}
 	return nil
 }
 
-type NullReconciliationsStatus struct {
-	ReconciliationsStatus ReconciliationsStatus `json:"reconciliations_status"`
-	Valid                 bool                  `json:"valid"` // Valid is true if ReconciliationsStatus is not NULL
+type NullSiteIncidentsCause struct {
+	SiteIncidentsCause SiteIncidentsCause `json:"site_incidents_cause"`
+	Valid              bool               `json:"valid"` // Valid is true if SiteIncidentsCause is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullReconciliationsStatus) Scan(value interface{}) error {
+func (ns *NullSiteIncidentsCause) Scan(value interface{}) error {
 	if value == nil {
-		ns.ReconciliationsStatus, ns.Valid = "", false
+		ns.SiteIncidentsCause, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ReconciliationsStatus.Scan(value)
+	return ns.SiteIncidentsCause.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullReconciliationsStatus) Value() (driver.Value, error) {
+func (ns NullSiteIncidentsCause) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ReconciliationsStatus), nil
+	return string(ns.SiteIncidentsCause), nil
 }
 
-type RelationshipsRelationshipType string
+type SiteIncidentsStatus string
 
 const (
-	RelationshipsRelationshipTypeAccess RelationshipsRelationshipType = "access"
-	RelationshipsRelationshipTypeMerge  RelationshipsRelationshipType = "merge"
+	SiteIncidentsStatusOpen     SiteIncidentsStatus = "open"
+	SiteIncidentsStatusResolved SiteIncidentsStatus = "resolved"
 )
 
-func (e *RelationshipsRelationshipType) Scan(src interface{}) error {
+func (e *SiteIncidentsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = RelationshipsRelationshipType(s)
+		*e = SiteIncidentsStatus(s)
 	case string:
-		*e = RelationshipsRelationshipType(s)
+		*e = SiteIncidentsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for RelationshipsRelationshipType: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteIncidentsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullRelationshipsRelationshipType struct {
-	RelationshipsRelationshipType RelationshipsRelationshipType `json:"relationships_relationship_type"`
-	Valid                         bool                          `json:"valid"` // Valid is true if RelationshipsRelationshipType is not NULL
+type NullSiteIncidentsStatus struct {
+	SiteIncidentsStatus SiteIncidentsStatus `json:"site_incidents_status"`
+	Valid               bool                `json:"valid"` // Valid is true if SiteIncidentsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullRelationshipsRelationshipType) Scan(value interface{}) error {
+func (ns *NullSiteIncidentsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.RelationshipsRelationshipType, ns.Valid = "", false
+		ns.SiteIncidentsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.RelationshipsRelationshipType.Scan(value)
+	return ns.SiteIncidentsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullRelationshipsRelationshipType) Value() (driver.Value, error) {
+func (ns NullSiteIncidentsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.RelationshipsRelationshipType), nil
+	return string(ns.SiteIncidentsStatus), nil
 }
 
-type RelationshipsStatus string
+type SiteMembersRole string
 
 const (
-	RelationshipsStatusPending  RelationshipsStatus = "pending"
-	RelationshipsStatusApproved RelationshipsStatus = "approved"
-	RelationshipsStatusRejected RelationshipsStatus = "rejected"
+	SiteMembersRoleOwner     SiteMembersRole = "owner"
+	SiteMembersRoleDeveloper SiteMembersRole = "developer"
+	SiteMembersRoleRead      SiteMembersRole = "read"
 )
 
-func (e *RelationshipsStatus) Scan(src interface{}) error {
+func (e *SiteMembersRole) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = RelationshipsStatus(s)
+		*e = SiteMembersRole(s)
 	case string:
-		*e = RelationshipsStatus(s)
+		*e = SiteMembersRole(s)
 	default:
-		return fmt.Errorf("unsupported scan type for RelationshipsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteMembersRole: %T", src)
 	}
 	return nil
 }
 
-type NullRelationshipsStatus struct {
-	RelationshipsStatus RelationshipsStatus `json:"relationships_status"`
-	Valid               bool                `json:"valid"` // Valid is true if RelationshipsStatus is not NULL
+type NullSiteMembersRole struct {
+	SiteMembersRole SiteMembersRole `json:"site_members_role"`
+	Valid           bool            `json:"valid"` // Valid is true if SiteMembersRole is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullRelationshipsStatus) Scan(value interface{}) error {
+func (ns *NullSiteMembersRole) Scan(value interface{}) error {
 	if value == nil {
-		ns.RelationshipsStatus, ns.Valid = "", false
+		ns.SiteMembersRole, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.RelationshipsStatus.Scan(value)
+	return ns.SiteMembersRole.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullRelationshipsStatus) Value() (driver.Value, error) {
+func (ns NullSiteMembersRole) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.RelationshipsStatus), nil
+	return string(ns.SiteMembersRole), nil
 }
 
-type SiteFirewallRulesRuleType string
+type SiteMembersStatus string
 
 const (
-	SiteFirewallRulesRuleTypeHttpsAllowed SiteFirewallRulesRuleType = "https_allowed"
-	SiteFirewallRulesRuleTypeSshAllowed   SiteFirewallRulesRuleType = "ssh_allowed"
-	SiteFirewallRulesRuleTypeBlocked      SiteFirewallRulesRuleType = "blocked"
+	SiteMembersStatusUnspecified  SiteMembersStatus = "unspecified"
+	SiteMembersStatusActive       SiteMembersStatus = "active"
+	SiteMembersStatusProvisioning SiteMembersStatus = "provisioning"
+	SiteMembersStatusFailed       SiteMembersStatus = "failed"
+	SiteMembersStatusSuspended    SiteMembersStatus = "suspended"
+	SiteMembersStatusDeleted      SiteMembersStatus = "deleted"
 )
 
-func (e *SiteFirewallRulesRuleType) Scan(src interface{}) error {
+func (e *SiteMembersStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteFirewallRulesRuleType(s)
+		*e = SiteMembersStatus(s)
 	case string:
-		*e = SiteFirewallRulesRuleType(s)
+		*e = SiteMembersStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteFirewallRulesRuleType: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteMembersStatus: %T", src)
 	}
 	return nil
 }
 
-type NullSiteFirewallRulesRuleType struct {
-	SiteFirewallRulesRuleType SiteFirewallRulesRuleType `json:"site_firewall_rules_rule_type"`
-	Valid                     bool                      `json:"valid"` // Valid is true if SiteFirewallRulesRuleType is not NULL
+type NullSiteMembersStatus struct {
+	SiteMembersStatus SiteMembersStatus `json:"site_members_status"`
+	Valid             bool              `json:"valid"` // Valid is true if SiteMembersStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteFirewallRulesRuleType) Scan(value interface{}) error {
+func (ns *NullSiteMembersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteMembersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteMembersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteMembersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteMembersStatus), nil
+}
+
+type SiteRateLimitRulesRateWindow string
+
+const (
+	SiteRateLimitRulesRateWindowSecond SiteRateLimitRulesRateWindow = "second"
+	SiteRateLimitRulesRateWindowMinute SiteRateLimitRulesRateWindow = "minute"
+)
+
+func (e *SiteRateLimitRulesRateWindow) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteRateLimitRulesRateWindow(s)
+	case string:
+		*e = SiteRateLimitRulesRateWindow(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteRateLimitRulesRateWindow: %T", src)
+	}
+	return nil
+}
+
+type NullSiteRateLimitRulesRateWindow struct {
+	SiteRateLimitRulesRateWindow SiteRateLimitRulesRateWindow `json:"site_rate_limit_rules_rate_window"`
+	Valid                        bool                         `json:"valid"` // Valid is true if SiteRateLimitRulesRateWindow is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteRateLimitRulesRateWindow) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteRateLimitRulesRateWindow, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteRateLimitRulesRateWindow.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteRateLimitRulesRateWindow) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteRateLimitRulesRateWindow), nil
+}
+
+type SiteSecretsStatus string
+
+const (
+	SiteSecretsStatusUnspecified  SiteSecretsStatus = "unspecified"
+	SiteSecretsStatusActive       SiteSecretsStatus = "active"
+	SiteSecretsStatusProvisioning SiteSecretsStatus = "provisioning"
+	SiteSecretsStatusFailed       SiteSecretsStatus = "failed"
+	SiteSecretsStatusSuspended    SiteSecretsStatus = "suspended"
+	SiteSecretsStatusDeleted      SiteSecretsStatus = "deleted"
+)
+
+func (e *SiteSecretsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteSecretsStatus(s)
+	case string:
+		*e = SiteSecretsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteSecretsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteSecretsStatus struct {
+	SiteSecretsStatus SiteSecretsStatus `json:"site_secrets_status"`
+	Valid             bool              `json:"valid"` // Valid is true if SiteSecretsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteSecretsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteSecretsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteSecretsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteSecretsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteSecretsStatus), nil
+}
+
+type SiteSettingsStatus string
+
+const (
+	SiteSettingsStatusUnspecified  SiteSettingsStatus = "unspecified"
+	SiteSettingsStatusActive       SiteSettingsStatus = "active"
+	SiteSettingsStatusProvisioning SiteSettingsStatus = "provisioning"
+	SiteSettingsStatusFailed       SiteSettingsStatus = "failed"
+	SiteSettingsStatusSuspended    SiteSettingsStatus = "suspended"
+	SiteSettingsStatusDeleted      SiteSettingsStatus = "deleted"
+)
+
+func (e *SiteSettingsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteSettingsStatus(s)
+	case string:
+		*e = SiteSettingsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteSettingsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSiteSettingsStatus struct {
+	SiteSettingsStatus SiteSettingsStatus `json:"site_settings_status"`
+	Valid              bool               `json:"valid"` // Valid is true if SiteSettingsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteSettingsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SiteSettingsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SiteSettingsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSiteSettingsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SiteSettingsStatus), nil
+}
+
+type SiteSettingsValueType string
+
+const (
+	SiteSettingsValueTypeString SiteSettingsValueType = "string"
+	SiteSettingsValueTypeInt    SiteSettingsValueType = "int"
+	SiteSettingsValueTypeBool   SiteSettingsValueType = "bool"
+	SiteSettingsValueTypeEnum   SiteSettingsValueType = "enum"
+)
+
+func (e *SiteSettingsValueType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SiteSettingsValueType(s)
+	case string:
+		*e = SiteSettingsValueType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SiteSettingsValueType: %T", src)
+	}
+	return nil
+}
+
+type NullSiteSettingsValueType struct {
+	SiteSettingsValueType SiteSettingsValueType `json:"site_settings_value_type"`
+	Valid                 bool                  `json:"valid"` // Valid is true if SiteSettingsValueType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSiteSettingsValueType) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteFirewallRulesRuleType, ns.Valid = "", false
+		ns.SiteSettingsValueType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteFirewallRulesRuleType.Scan(value)
+	return ns.SiteSettingsValueType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteFirewallRulesRuleType) Value() (driver.Value, error) {
+func (ns NullSiteSettingsValueType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteFirewallRulesRuleType), nil
+	return string(ns.SiteSettingsValueType), nil
 }
 
-type SiteFirewallRulesStatus string
+type SiteStaticEgressIpsStatus string
 
 const (
-	SiteFirewallRulesStatusUnspecified  SiteFirewallRulesStatus = "unspecified"
-	SiteFirewallRulesStatusActive       SiteFirewallRulesStatus = "active"
-	SiteFirewallRulesStatusProvisioning SiteFirewallRulesStatus = "provisioning"
-	SiteFirewallRulesStatusFailed       SiteFirewallRulesStatus = "failed"
-	SiteFirewallRulesStatusSuspended    SiteFirewallRulesStatus = "suspended"
-	SiteFirewallRulesStatusDeleted      SiteFirewallRulesStatus = "deleted"
+	SiteStaticEgressIpsStatusProvisioning SiteStaticEgressIpsStatus = "provisioning"
+	SiteStaticEgressIpsStatusActive       SiteStaticEgressIpsStatus = "active"
+	SiteStaticEgressIpsStatusReleasing    SiteStaticEgressIpsStatus = "releasing"
 )
 
-func (e *SiteFirewallRulesStatus) Scan(src interface{}) error {
+func (e *SiteStaticEgressIpsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteFirewallRulesStatus(s)
+		*e = SiteStaticEgressIpsStatus(s)
 	case string:
-		*e = SiteFirewallRulesStatus(s)
+		*e = SiteStaticEgressIpsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteFirewallRulesStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteStaticEgressIpsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullSiteFirewallRulesStatus struct {
-	SiteFirewallRulesStatus SiteFirewallRulesStatus `json:"site_firewall_rules_status"`
-	Valid                   bool                    `json:"valid"` // Valid is true if SiteFirewallRulesStatus is not NULL
+type NullSiteStaticEgressIpsStatus struct {
+	SiteStaticEgressIpsStatus SiteStaticEgressIpsStatus `json:"site_static_egress_ips_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if SiteStaticEgressIpsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteFirewallRulesStatus) Scan(value interface{}) error {
+func (ns *NullSiteStaticEgressIpsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteFirewallRulesStatus, ns.Valid = "", false
+		ns.SiteStaticEgressIpsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteFirewallRulesStatus.Scan(value)
+	return ns.SiteStaticEgressIpsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteFirewallRulesStatus) Value() (driver.Value, error) {
+func (ns NullSiteStaticEgressIpsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteFirewallRulesStatus), nil
+	return string(ns.SiteStaticEgressIpsStatus), nil
 }
 
-type SiteMembersRole string
+type SiteTlsPoliciesCertificateSource string
 
 const (
-	SiteMembersRoleOwner     SiteMembersRole = "owner"
-	SiteMembersRoleDeveloper SiteMembersRole = "developer"
-	SiteMembersRoleRead      SiteMembersRole = "read"
+	SiteTlsPoliciesCertificateSourceAcme   SiteTlsPoliciesCertificateSource = "acme"
+	SiteTlsPoliciesCertificateSourceCustom SiteTlsPoliciesCertificateSource = "custom"
 )
 
-func (e *SiteMembersRole) Scan(src interface{}) error {
+func (e *SiteTlsPoliciesCertificateSource) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteMembersRole(s)
+		*e = SiteTlsPoliciesCertificateSource(s)
 	case string:
-		*e = SiteMembersRole(s)
+		*e = SiteTlsPoliciesCertificateSource(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteMembersRole: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteTlsPoliciesCertificateSource: %T", src)
 	}
 	return nil
 }
 
-type NullSiteMembersRole struct {
-	SiteMembersRole SiteMembersRole `json:"site_members_role"`
-	Valid           bool            `json:"valid"` // Valid is true if SiteMembersRole is not NULL
+type NullSiteTlsPoliciesCertificateSource struct {
+	SiteTlsPoliciesCertificateSource SiteTlsPoliciesCertificateSource `json:"site_tls_policies_certificate_source"`
+	Valid                            bool                             `json:"valid"` // Valid is true if SiteTlsPoliciesCertificateSource is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteMembersRole) Scan(value interface{}) error {
+func (ns *NullSiteTlsPoliciesCertificateSource) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteMembersRole, ns.Valid = "", false
+		ns.SiteTlsPoliciesCertificateSource, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteMembersRole.Scan(value)
+	return ns.SiteTlsPoliciesCertificateSource.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteMembersRole) Value() (driver.Value, error) {
+func (ns NullSiteTlsPoliciesCertificateSource) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteMembersRole), nil
+	return string(ns.SiteTlsPoliciesCertificateSource), nil
 }
 
-type SiteMembersStatus string
+type SiteTlsPoliciesMinVersion string
 
 const (
-	SiteMembersStatusUnspecified  SiteMembersStatus = "unspecified"
-	SiteMembersStatusActive       SiteMembersStatus = "active"
-	SiteMembersStatusProvisioning SiteMembersStatus = "provisioning"
-	SiteMembersStatusFailed       SiteMembersStatus = "failed"
-	SiteMembersStatusSuspended    SiteMembersStatus = "suspended"
-	SiteMembersStatusDeleted      SiteMembersStatus = "deleted"
+	SiteTlsPoliciesMinVersion12 SiteTlsPoliciesMinVersion = "1.2"
+	SiteTlsPoliciesMinVersion13 SiteTlsPoliciesMinVersion = "1.3"
 )
 
-func (e *SiteMembersStatus) Scan(src interface{}) error {
+func (e *SiteTlsPoliciesMinVersion) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteMembersStatus(s)
+		*e = SiteTlsPoliciesMinVersion(s)
 	case string:
-		*e = SiteMembersStatus(s)
+		*e = SiteTlsPoliciesMinVersion(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteMembersStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteTlsPoliciesMinVersion: %T", src)
 	}
 	return nil
 }
 
-type NullSiteMembersStatus struct {
-	SiteMembersStatus SiteMembersStatus `json:"site_members_status"`
-	Valid             bool              `json:"valid"` // Valid is true if SiteMembersStatus is not NULL
+type NullSiteTlsPoliciesMinVersion struct {
+	SiteTlsPoliciesMinVersion SiteTlsPoliciesMinVersion `json:"site_tls_policies_min_version"`
+	Valid                     bool                      `json:"valid"` // Valid is true if SiteTlsPoliciesMinVersion is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteMembersStatus) Scan(value interface{}) error {
+func (ns *NullSiteTlsPoliciesMinVersion) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteMembersStatus, ns.Valid = "", false
+		ns.SiteTlsPoliciesMinVersion, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteMembersStatus.Scan(value)
+	return ns.SiteTlsPoliciesMinVersion.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteMembersStatus) Value() (driver.Value, error) {
+func (ns NullSiteTlsPoliciesMinVersion) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteMembersStatus), nil
+	return string(ns.SiteTlsPoliciesMinVersion), nil
 }
 
-type SiteSecretsStatus string
+type SiteTlsPoliciesProbeStatus string
 
 const (
-	SiteSecretsStatusUnspecified  SiteSecretsStatus = "unspecified"
-	SiteSecretsStatusActive       SiteSecretsStatus = "active"
-	SiteSecretsStatusProvisioning SiteSecretsStatus = "provisioning"
-	SiteSecretsStatusFailed       SiteSecretsStatus = "failed"
-	SiteSecretsStatusSuspended    SiteSecretsStatus = "suspended"
-	SiteSecretsStatusDeleted      SiteSecretsStatus = "deleted"
+	SiteTlsPoliciesProbeStatusPending SiteTlsPoliciesProbeStatus = "pending"
+	SiteTlsPoliciesProbeStatusPassed  SiteTlsPoliciesProbeStatus = "passed"
+	SiteTlsPoliciesProbeStatusFailed  SiteTlsPoliciesProbeStatus = "failed"
 )
 
-func (e *SiteSecretsStatus) Scan(src interface{}) error {
+func (e *SiteTlsPoliciesProbeStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteSecretsStatus(s)
+		*e = SiteTlsPoliciesProbeStatus(s)
 	case string:
-		*e = SiteSecretsStatus(s)
+		*e = SiteTlsPoliciesProbeStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteSecretsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteTlsPoliciesProbeStatus: %T", src)
 	}
 	return nil
 }
 
-type NullSiteSecretsStatus struct {
-	SiteSecretsStatus SiteSecretsStatus `json:"site_secrets_status"`
-	Valid             bool              `json:"valid"` // Valid is true if SiteSecretsStatus is not NULL
+type NullSiteTlsPoliciesProbeStatus struct {
+	SiteTlsPoliciesProbeStatus SiteTlsPoliciesProbeStatus `json:"site_tls_policies_probe_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if SiteTlsPoliciesProbeStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteSecretsStatus) Scan(value interface{}) error {
+func (ns *NullSiteTlsPoliciesProbeStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteSecretsStatus, ns.Valid = "", false
+		ns.SiteTlsPoliciesProbeStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteSecretsStatus.Scan(value)
+	return ns.SiteTlsPoliciesProbeStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteSecretsStatus) Value() (driver.Value, error) {
+func (ns NullSiteTlsPoliciesProbeStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteSecretsStatus), nil
+	return string(ns.SiteTlsPoliciesProbeStatus), nil
 }
 
-type SiteSettingsStatus string
+type SiteWafConfigsMode string
 
 const (
-	SiteSettingsStatusUnspecified  SiteSettingsStatus = "unspecified"
-	SiteSettingsStatusActive       SiteSettingsStatus = "active"
-	SiteSettingsStatusProvisioning SiteSettingsStatus = "provisioning"
-	SiteSettingsStatusFailed       SiteSettingsStatus = "failed"
-	SiteSettingsStatusSuspended    SiteSettingsStatus = "suspended"
-	SiteSettingsStatusDeleted      SiteSettingsStatus = "deleted"
+	SiteWafConfigsModeDetectionOnly SiteWafConfigsMode = "detection_only"
+	SiteWafConfigsModeBlocking      SiteWafConfigsMode = "blocking"
 )
 
-func (e *SiteSettingsStatus) Scan(src interface{}) error {
+func (e *SiteWafConfigsMode) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SiteSettingsStatus(s)
+		*e = SiteWafConfigsMode(s)
 	case string:
-		*e = SiteSettingsStatus(s)
+		*e = SiteWafConfigsMode(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SiteSettingsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for SiteWafConfigsMode: %T", src)
 	}
 	return nil
 }
 
-type NullSiteSettingsStatus struct {
-	SiteSettingsStatus SiteSettingsStatus `json:"site_settings_status"`
-	Valid              bool               `json:"valid"` // Valid is true if SiteSettingsStatus is not NULL
+type NullSiteWafConfigsMode struct {
+	SiteWafConfigsMode SiteWafConfigsMode `json:"site_waf_configs_mode"`
+	Valid              bool               `json:"valid"` // Valid is true if SiteWafConfigsMode is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSiteSettingsStatus) Scan(value interface{}) error {
+func (ns *NullSiteWafConfigsMode) Scan(value interface{}) error {
 	if value == nil {
-		ns.SiteSettingsStatus, ns.Valid = "", false
+		ns.SiteWafConfigsMode, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SiteSettingsStatus.Scan(value)
+	return ns.SiteWafConfigsMode.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSiteSettingsStatus) Value() (driver.Value, error) {
+func (ns NullSiteWafConfigsMode) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SiteSettingsStatus), nil
+	return string(ns.SiteWafConfigsMode), nil
 }
 
 type SitesStatus string
@@ -1536,6 +3514,50 @@ func (ns NullSitesStatus) Value() (driver.Value, error) {
 	return string(ns.SitesStatus), nil
 }
 
+type StatusPageUpdatesStatus string
+
+const (
+	StatusPageUpdatesStatusInvestigating StatusPageUpdatesStatus = "investigating"
+	StatusPageUpdatesStatusIdentified    StatusPageUpdatesStatus = "identified"
+	StatusPageUpdatesStatusMonitoring    StatusPageUpdatesStatus = "monitoring"
+	StatusPageUpdatesStatusResolved      StatusPageUpdatesStatus = "resolved"
+)
+
+func (e *StatusPageUpdatesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StatusPageUpdatesStatus(s)
+	case string:
+		*e = StatusPageUpdatesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StatusPageUpdatesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStatusPageUpdatesStatus struct {
+	StatusPageUpdatesStatus StatusPageUpdatesStatus `json:"status_page_updates_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if StatusPageUpdatesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStatusPageUpdatesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StatusPageUpdatesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StatusPageUpdatesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStatusPageUpdatesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StatusPageUpdatesStatus), nil
+}
+
 type StripeSubscriptionsStatus string
 
 const (
@@ -1583,11 +3605,58 @@ func (ns NullStripeSubscriptionsStatus) Value() (driver.Value, error) {
 	return string(ns.StripeSubscriptionsStatus), nil
 }
 
+type TerraformStateLayoutsLayout string
+
+const (
+	TerraformStateLayoutsLayoutMonolithic TerraformStateLayoutsLayout = "monolithic"
+	TerraformStateLayoutsLayoutMigrating  TerraformStateLayoutsLayout = "migrating"
+	TerraformStateLayoutsLayoutIsolated   TerraformStateLayoutsLayout = "isolated"
+)
+
+func (e *TerraformStateLayoutsLayout) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TerraformStateLayoutsLayout(s)
+	case string:
+		*e = TerraformStateLayoutsLayout(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TerraformStateLayoutsLayout: %T", src)
+	}
+	return nil
+}
+
+type NullTerraformStateLayoutsLayout struct {
+	TerraformStateLayoutsLayout TerraformStateLayoutsLayout `json:"terraform_state_layouts_layout"`
+	Valid                       bool                        `json:"valid"` // Valid is true if TerraformStateLayoutsLayout is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTerraformStateLayoutsLayout) Scan(value interface{}) error {
+	if value == nil {
+		ns.TerraformStateLayoutsLayout, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TerraformStateLayoutsLayout.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTerraformStateLayoutsLayout) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TerraformStateLayoutsLayout), nil
+}
+
 type Account struct {
-	ID                  int64              `json:"id"`
-	PublicID            []byte             `json:"public_id"`
-	Email               string             `json:"email"`
-	Name                sql.NullString     `json:"name"`
+	ID       int64          `json:"id"`
+	PublicID []byte         `json:"public_id"`
+	Email    string         `json:"email"`
+	Name     sql.NullString `json:"name"`
+	// Contact phone number in E.164 format
+	Phone sql.NullString `json:"phone"`
+	// Cloud Storage object of the avatar, named after its content
+	AvatarObject        sql.NullString     `json:"avatar_object"`
 	GithubUsername      sql.NullString     `json:"github_username"`
 	VaultEntityID       sql.NullString     `json:"vault_entity_id"`
 	AuthMethod          AccountsAuthMethod `json:"auth_method"`
@@ -1601,6 +3670,21 @@ type Account struct {
 	UpdatedAt           sql.NullTime       `json:"updated_at"`
 }
 
+type AccountPreference struct {
+	AccountID int64                   `json:"account_id"`
+	Theme     AccountPreferencesTheme `json:"theme"`
+	// IANA time zone dashboard timestamps are shown in
+	Timezone string `json:"timezone"`
+	// Organization preselected in dashboard forms
+	DefaultOrganizationID sql.NullInt64 `json:"default_organization_id"`
+	// Rows per page in dashboard tables
+	PageSize  int32        `json:"page_size"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+	// Dashboard language, empty to follow the browser
+	Locale string `json:"locale"`
+}
+
 type ApiKey struct {
 	ID          int64          `json:"id"`
 	PublicID    []byte         `json:"public_id"`
@@ -1613,6 +3697,8 @@ type ApiKey struct {
 	ExpiresAt   sql.NullTime   `json:"expires_at"`
 	Active      bool           `json:"active"`
 	CreatedBy   sql.NullInt64  `json:"created_by"`
+	// CIDRs the key can be used from
+	AllowedCidrs types.RawJSON `json:"allowed_cidrs"`
 }
 
 type Audit struct {
@@ -1625,6 +3711,50 @@ type Audit struct {
 	CreatedAt  sql.NullTime    `json:"created_at"`
 }
 
+type ControllerConfig struct {
+	ID int64 `json:"id"`
+	// NULL for the fleet-wide document
+	SiteID   sql.NullInt64 `json:"site_id"`
+	ScopeKey sql.NullInt64 `json:"scope_key"`
+	// Reconcile requests per second the controller accepts
+	RateLimitRps   sql.NullInt32 `json:"rate_limit_rps"`
+	RateLimitBurst sql.NullInt32 `json:"rate_limit_burst"`
+	// Between periodic full reconciliations
+	ReconcileIntervalMinutes sql.NullInt32 `json:"reconcile_interval_minutes"`
+	CheckinIntervalSeconds   sql.NullInt32 `json:"checkin_interval_seconds"`
+	// Release the controllers should run
+	ControllerVersion sql.NullString `json:"controller_version"`
+	// Flag name to enabled, e.g. {"nftables": true}
+	FeatureFlags json.RawMessage `json:"feature_flags"`
+	CreatedAt    sql.NullTime    `json:"created_at"`
+	UpdatedAt    sql.NullTime    `json:"updated_at"`
+	UpdatedBy    sql.NullInt64   `json:"updated_by"`
+}
+
+type ControllerRelease struct {
+	ID      int64  `json:"id"`
+	Version string `json:"version"`
+	// HTTPS URL of the linux/amd64 binary
+	DownloadUrl string `json:"download_url"`
+	// Hex SHA-256 digest of the binary
+	Sha256 string `json:"sha256"`
+	// Base64 ed25519 signature of the binary
+	Signature string         `json:"signature"`
+	Notes     sql.NullString `json:"notes"`
+	CreatedAt sql.NullTime   `json:"created_at"`
+	CreatedBy sql.NullInt64  `json:"created_by"`
+}
+
+type DeferredReconciliation struct {
+	ID          int64                              `json:"id"`
+	SiteID      int64                              `json:"site_id"`
+	RequestType DeferredReconciliationsRequestType `json:"request_type"`
+	// Events the reconciliation was deferred for
+	EventIds json.RawMessage `json:"event_ids"`
+	// When the first change was deferred
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
 type Deployment struct {
 	ID           string            `json:"id"`
 	SiteID       string            `json:"site_id"`
@@ -1635,6 +3765,29 @@ type Deployment struct {
 	CompletedAt  sql.NullInt64     `json:"completed_at"`
 	ErrorMessage sql.NullString    `json:"error_message"`
 	CreatedAt    int64             `json:"created_at"`
+	// Branch, tag or commit requested
+	GitRef        sql.NullString `json:"git_ref"`
+	CommitSha     sql.NullString `json:"commit_sha"`
+	CommitMessage sql.NullString `json:"commit_message"`
+	RollbackOf    sql.NullString `json:"rollback_of"`
+	CreatedBy     sql.NullInt64  `json:"created_by"`
+}
+
+type DeviceAuthorization struct {
+	ID int64 `json:"id"`
+	// SHA-256 of the device code the CLI polls with
+	DeviceCodeHash string `json:"device_code_hash"`
+	// Code the user types in, e.g. WDJB-MJHT
+	UserCode string `json:"user_code"`
+	// Shown on the approval page and used to name the API key
+	ClientName string                     `json:"client_name"`
+	Status     DeviceAuthorizationsStatus `json:"status"`
+	// Account that approved or denied the request
+	AccountID    sql.NullInt64 `json:"account_id"`
+	ExpiresAt    time.Time     `json:"expires_at"`
+	LastPolledAt sql.NullTime  `json:"last_polled_at"`
+	CreatedAt    sql.NullTime  `json:"created_at"`
+	DecidedAt    sql.NullTime  `json:"decided_at"`
 }
 
 type Domain struct {
@@ -1644,6 +3797,34 @@ type Domain struct {
 	CreatedAt sql.NullTime `json:"created_at"`
 }
 
+type EffectiveAccessStale struct {
+	AccountID int64     `json:"account_id"`
+	MarkedAt  time.Time `json:"marked_at"`
+}
+
+type EffectiveOrganizationAccess struct {
+	AccountID      int64 `json:"account_id"`
+	OrganizationID int64 `json:"organization_id"`
+	Reachable      bool  `json:"reachable"`
+	InheritedRead  bool  `json:"inherited_read"`
+}
+
+type EmailSend struct {
+	ID int64 `json:"id"`
+	// Message template, e.g. verification
+	Template string `json:"template"`
+	// Locale the message was rendered in
+	Locale    string `json:"locale"`
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+	// Provider that handled the send: log, smtp, sendgrid or ses
+	Provider          string           `json:"provider"`
+	ProviderMessageID sql.NullString   `json:"provider_message_id"`
+	Status            EmailSendsStatus `json:"status"`
+	Error             sql.NullString   `json:"error"`
+	CreatedAt         sql.NullTime     `json:"created_at"`
+}
+
 type EmailVerificationToken struct {
 	ID           int64        `json:"id"`
 	Email        string       `json:"email"`
@@ -1653,6 +3834,20 @@ type EmailVerificationToken struct {
 	ExpiresAt    time.Time    `json:"expires_at"`
 }
 
+type EventOutbox struct {
+	ID             int64          `json:"id"`
+	EventID        string         `json:"event_id"`
+	EventType      string         `json:"event_type"`
+	EventSource    string         `json:"event_source"`
+	EventSubject   sql.NullString `json:"event_subject"`
+	EventData      []byte         `json:"event_data"`
+	ContentType    string         `json:"content_type"`
+	OrganizationID sql.NullInt64  `json:"organization_id"`
+	ProjectID      sql.NullInt64  `json:"project_id"`
+	SiteID         sql.NullInt64  `json:"site_id"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
 type EventQueue struct {
 	ID                 int64            `json:"id"`
 	EventID            string           `json:"event_id"`
@@ -1677,6 +3872,39 @@ type EventQueue struct {
 	ProcessedAt        sql.NullTime     `json:"processed_at"`
 }
 
+type EventSink struct {
+	ID             int64          `json:"id"`
+	PublicID       []byte         `json:"public_id"`
+	OrganizationID int64          `json:"organization_id"`
+	Name           string         `json:"name"`
+	Kind           EventSinksKind `json:"kind"`
+	// projects/PROJECT/topics/TOPIC for pubsub_topic, the bucket name for storage_bucket
+	Destination string `json:"destination"`
+	// Event type prefixes to publish, e.g. io.libops.deployment.; empty publishes every event
+	EventTypes json.RawMessage `json:"event_types"`
+	// HMAC-SHA256 key events are signed with
+	SigningSecret  string       `json:"signing_secret"`
+	Enabled        bool         `json:"enabled"`
+	LastDeliveryAt sql.NullTime `json:"last_delivery_at"`
+	// Error from the most recent delivery, NULL when it succeeded
+	LastError sql.NullString `json:"last_error"`
+	CreatedBy sql.NullInt64  `json:"created_by"`
+	CreatedAt sql.NullTime   `json:"created_at"`
+	UpdatedAt sql.NullTime   `json:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	ID             int64                 `json:"id"`
+	AccountID      int64                 `json:"account_id"`
+	ProcedureName  string                `json:"procedure_name"`
+	IdempotencyKey string                `json:"idempotency_key"`
+	RequestHash    string                `json:"request_hash"`
+	Status         IdempotencyKeysStatus `json:"status"`
+	ResponseBody   sql.NullString        `json:"response_body"`
+	CreatedAt      sql.NullTime          `json:"created_at"`
+	ExpiresAt      time.Time             `json:"expires_at"`
+}
+
 type MachineType struct {
 	ID int64 `json:"id"`
 	// Machine type identifier (e.g., e2-medium, n4-standard-2)
@@ -1697,6 +3925,82 @@ type MachineType struct {
 	UpdatedAt sql.NullTime `json:"updated_at"`
 }
 
+type MemberInvitation struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	// SHA-256 of the token in the accept link
+	TokenHash    string                        `json:"token_hash"`
+	Email        string                        `json:"email"`
+	ResourceType MemberInvitationsResourceType `json:"resource_type"`
+	// organizations.id, projects.id or sites.id
+	ResourceID int64                   `json:"resource_id"`
+	Role       MemberInvitationsRole   `json:"role"`
+	Status     MemberInvitationsStatus `json:"status"`
+	ExpiresAt  time.Time               `json:"expires_at"`
+	AcceptedBy sql.NullInt64           `json:"accepted_by"`
+	AcceptedAt sql.NullTime            `json:"accepted_at"`
+	CreatedAt  sql.NullTime            `json:"created_at"`
+	CreatedBy  sql.NullInt64           `json:"created_by"`
+}
+
+type MeteredPrice struct {
+	ID int64 `json:"id"`
+	// usage_records metric, e.g. egress_bytes
+	Metric string `json:"metric"`
+	// Stripe metered price ID
+	StripePriceID string `json:"stripe_price_id"`
+	// Stripe billing meter event name
+	StripeMeterEventName string `json:"stripe_meter_event_name"`
+	// Bytes per billed unit
+	UnitBytes int64 `json:"unit_bytes"`
+	// Price per billed unit in cents (for previews)
+	UnitPriceCents int32 `json:"unit_price_cents"`
+	// Free units per billing period
+	IncludedUnits int64        `json:"included_units"`
+	Active        sql.NullBool `json:"active"`
+	CreatedAt     sql.NullTime `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type Notification struct {
+	ID        int64  `json:"id"`
+	PublicID  []byte `json:"public_id"`
+	AccountID int64  `json:"account_id"`
+	// deploy_finished, member_added or certificate_expiring
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// Dashboard path the notification opens
+	Link      string       `json:"link"`
+	ReadAt    sql.NullTime `json:"read_at"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type NotificationChannel struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	Name           string `json:"name"`
+	// Incoming webhook URL for slack_webhook and teams_webhook
+	WebhookUrl string `json:"webhook_url"`
+	// Bot token for slack_app
+	SlackBotToken string `json:"slack_bot_token"`
+	// Channel ID or name for slack_app
+	SlackChannel string `json:"slack_channel"`
+	// Subscribed alert categories: deployments, reconciliation_failures, billing, security
+	Categories     json.RawMessage `json:"categories"`
+	Enabled        bool            `json:"enabled"`
+	LastDeliveryAt sql.NullTime    `json:"last_delivery_at"`
+	// Error from the most recent delivery, NULL when it succeeded
+	LastError sql.NullString           `json:"last_error"`
+	CreatedBy sql.NullInt64            `json:"created_by"`
+	CreatedAt sql.NullTime             `json:"created_at"`
+	UpdatedAt sql.NullTime             `json:"updated_at"`
+	Kind      NotificationChannelsKind `json:"kind"`
+	// PagerDuty routing key or Opsgenie API key
+	IntegrationKey string `json:"integration_key"`
+}
+
 type OnboardingSession struct {
 	ID                      int64          `json:"id"`
 	PublicID                []byte         `json:"public_id"`
@@ -1722,25 +4026,103 @@ type OnboardingSession struct {
 	CreatedAt               sql.NullTime   `json:"created_at"`
 	UpdatedAt               sql.NullTime   `json:"updated_at"`
 	ExpiresAt               sql.NullTime   `json:"expires_at"`
+	PromoCode               sql.NullString `json:"promo_code"`
+	DiscountDescription     sql.NullString `json:"discount_description"`
 }
 
 type Organization struct {
-	ID                int64                     `json:"id"`
-	PublicID          []byte                    `json:"public_id"`
-	Name              string                    `json:"name"`
-	GcpOrgID          string                    `json:"gcp_org_id"`
-	GcpBillingAccount string                    `json:"gcp_billing_account"`
-	GcpParent         string                    `json:"gcp_parent"`
-	Location          NullOrganizationsLocation `json:"location"`
-	Region            sql.NullString            `json:"region"`
-	GcpFolderID       sql.NullString            `json:"gcp_folder_id"`
-	Status            NullOrganizationsStatus   `json:"status"`
-	GcpProjectID      sql.NullString            `json:"gcp_project_id"`
-	GcpProjectNumber  sql.NullString            `json:"gcp_project_number"`
-	CreatedAt         sql.NullTime              `json:"created_at"`
-	UpdatedAt         sql.NullTime              `json:"updated_at"`
-	CreatedBy         sql.NullInt64             `json:"created_by"`
-	UpdatedBy         sql.NullInt64             `json:"updated_by"`
+	ID                     int64                     `json:"id"`
+	PublicID               []byte                    `json:"public_id"`
+	Name                   string                    `json:"name"`
+	GcpOrgID               string                    `json:"gcp_org_id"`
+	GcpBillingAccount      string                    `json:"gcp_billing_account"`
+	GcpParent              string                    `json:"gcp_parent"`
+	Location               NullOrganizationsLocation `json:"location"`
+	Region                 sql.NullString            `json:"region"`
+	GcpFolderID            sql.NullString            `json:"gcp_folder_id"`
+	Status                 NullOrganizationsStatus   `json:"status"`
+	GcpProjectID           sql.NullString            `json:"gcp_project_id"`
+	GcpProjectNumber       sql.NullString            `json:"gcp_project_number"`
+	CreatedAt              sql.NullTime              `json:"created_at"`
+	UpdatedAt              sql.NullTime              `json:"updated_at"`
+	CreatedBy              sql.NullInt64             `json:"created_by"`
+	UpdatedBy              sql.NullInt64             `json:"updated_by"`
+	Labels                 types.RawJSON             `json:"labels"`
+	PaymentFailedAt        sql.NullTime              `json:"payment_failed_at"`
+	PaymentFailedInvoiceID sql.NullString            `json:"payment_failed_invoice_id"`
+	BillingState           OrganizationsBillingState `json:"billing_state"`
+	BillingStateChangedAt  sql.NullTime              `json:"billing_state_changed_at"`
+	// When an operator suspended the organization
+	SuspendedAt sql.NullTime `json:"suspended_at"`
+	// Why an operator suspended the organization
+	SuspendedReason sql.NullString `json:"suspended_reason"`
+	// Account of the operator who suspended the organization
+	SuspendedBy sql.NullInt64 `json:"suspended_by"`
+	// CIDRs the organization can be reached from
+	AllowedCidrs types.RawJSON `json:"allowed_cidrs"`
+	// Geography the organization's data must stay in; NULL for anywhere
+	DataResidency NullOrganizationsDataResidency `json:"data_residency"`
+	Version       int64                          `json:"version"`
+}
+
+type OrganizationBranding struct {
+	OrganizationID int64 `json:"organization_id"`
+	// Hex color of buttons and headers, empty for the default
+	PrimaryColor string `json:"primary_color"`
+	// Hex color of links and highlights, empty for the default
+	AccentColor string `json:"accent_color"`
+	// PNG or JPEG image, at most 256 KiB
+	Logo            sql.NullString `json:"logo"`
+	LogoContentType string         `json:"logo_content_type"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+}
+
+type OrganizationDataKey struct {
+	ID             int64 `json:"id"`
+	OrganizationID int64 `json:"organization_id"`
+	KeyVersion     int32 `json:"key_version"`
+	// Data key encrypted by the key encryption key
+	WrappedKey string        `json:"wrapped_key"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+	CreatedBy  sql.NullInt64 `json:"created_by"`
+}
+
+type OrganizationEncryptionKey struct {
+	OrganizationID int64 `json:"organization_id"`
+	// Location of the key, e.g. us-central1 or eu; it encrypts data there
+	Location string `json:"location"`
+	// projects/*/locations/*/keyRings/*/cryptoKeys/*
+	KmsKeyName string `json:"kms_key_name"`
+	// Result of the last check; pending while nothing in the location uses the key
+	Status OrganizationEncryptionKeysStatus `json:"status"`
+	// Why the last check failed
+	StatusMessage sql.NullString `json:"status_message"`
+	CheckedAt     sql.NullTime   `json:"checked_at"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	CreatedBy     sql.NullInt64  `json:"created_by"`
+	UpdatedBy     sql.NullInt64  `json:"updated_by"`
+}
+
+type OrganizationExport struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// Whether secret values are read from Vault into the bundle
+	IncludeSecrets bool                      `json:"include_secrets"`
+	Status         OrganizationExportsStatus `json:"status"`
+	Error          sql.NullString            `json:"error"`
+	// Object in the export bucket
+	ObjectName sql.NullString `json:"object_name"`
+	SizeBytes  sql.NullInt64  `json:"size_bytes"`
+	Sha256     sql.NullString `json:"sha256"`
+	// When the bundle is deleted from the bucket
+	ExpiresAt   sql.NullTime  `json:"expires_at"`
+	StartedAt   sql.NullTime  `json:"started_at"`
+	CompletedAt sql.NullTime  `json:"completed_at"`
+	CreatedAt   sql.NullTime  `json:"created_at"`
+	CreatedBy   sql.NullInt64 `json:"created_by"`
 }
 
 type OrganizationFirewallRule struct {
@@ -1757,6 +4139,30 @@ type OrganizationFirewallRule struct {
 	UpdatedBy      sql.NullInt64                       `json:"updated_by"`
 }
 
+type OrganizationJoinDomain struct {
+	ID             int64 `json:"id"`
+	OrganizationID int64 `json:"organization_id"`
+	// Lowercase email domain, e.g. mylibrary.edu
+	Domain string                      `json:"domain"`
+	Role   OrganizationJoinDomainsRole `json:"role"`
+	// Create a join request instead of a membership
+	ApprovalRequired bool          `json:"approval_required"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	CreatedBy        sql.NullInt64 `json:"created_by"`
+}
+
+type OrganizationJoinRequest struct {
+	ID             int64                          `json:"id"`
+	PublicID       []byte                         `json:"public_id"`
+	OrganizationID int64                          `json:"organization_id"`
+	AccountID      int64                          `json:"account_id"`
+	Role           OrganizationJoinRequestsRole   `json:"role"`
+	Status         OrganizationJoinRequestsStatus `json:"status"`
+	CreatedAt      sql.NullTime                   `json:"created_at"`
+	DecidedAt      sql.NullTime                   `json:"decided_at"`
+	DecidedBy      sql.NullInt64                  `json:"decided_by"`
+}
+
 type OrganizationMember struct {
 	ID             int64                         `json:"id"`
 	PublicID       []byte                        `json:"public_id"`
@@ -1768,6 +4174,48 @@ type OrganizationMember struct {
 	UpdatedAt      sql.NullTime                  `json:"updated_at"`
 	CreatedBy      sql.NullInt64                 `json:"created_by"`
 	UpdatedBy      sql.NullInt64                 `json:"updated_by"`
+	ExpiresAt      sql.NullTime                  `json:"expires_at"`
+}
+
+type OrganizationOwnershipTransfer struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// Owner handing the organization over, demoted to developer on acceptance
+	FromAccountID int64 `json:"from_account_id"`
+	// Member who becomes an owner on acceptance
+	ToAccountID int64                                `json:"to_account_id"`
+	Status      OrganizationOwnershipTransfersStatus `json:"status"`
+	ExpiresAt   time.Time                            `json:"expires_at"`
+	AcceptedAt  sql.NullTime                         `json:"accepted_at"`
+	CreatedAt   sql.NullTime                         `json:"created_at"`
+	UpdatedAt   sql.NullTime                         `json:"updated_at"`
+	CreatedBy   sql.NullInt64                        `json:"created_by"`
+}
+
+type OrganizationPolicy struct {
+	ID             int64                           `json:"id"`
+	PublicID       []byte                          `json:"public_id"`
+	OrganizationID int64                           `json:"organization_id"`
+	Rule           OrganizationPoliciesRule        `json:"rule"`
+	Enforcement    OrganizationPoliciesEnforcement `json:"enforcement"`
+	// Rule settings, such as the days and hours of a deploy window
+	Config    types.RawJSON `json:"config"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	CreatedBy sql.NullInt64 `json:"created_by"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+}
+
+type OrganizationQuota struct {
+	ID             int64         `json:"id"`
+	OrganizationID int64         `json:"organization_id"`
+	Resource       string        `json:"resource"`
+	QuotaLimit     int64         `json:"quota_limit"`
+	CreatedAt      sql.NullTime  `json:"created_at"`
+	UpdatedAt      sql.NullTime  `json:"updated_at"`
+	CreatedBy      sql.NullInt64 `json:"created_by"`
+	UpdatedBy      sql.NullInt64 `json:"updated_by"`
 }
 
 type OrganizationSecret struct {
@@ -1781,6 +4229,10 @@ type OrganizationSecret struct {
 	UpdatedAt      int64                         `json:"updated_at"`
 	CreatedBy      sql.NullInt64                 `json:"created_by"`
 	UpdatedBy      sql.NullInt64                 `json:"updated_by"`
+	// Data key version the value is sealed with
+	KeyVersion sql.NullInt32 `json:"key_version"`
+	// External secret reference, resolved by the site controller
+	Reference sql.NullString `json:"reference"`
 }
 
 type OrganizationSetting struct {
@@ -1796,6 +4248,60 @@ type OrganizationSetting struct {
 	UpdatedAt      sql.NullTime                   `json:"updated_at"`
 	CreatedBy      sql.NullInt64                  `json:"created_by"`
 	UpdatedBy      sql.NullInt64                  `json:"updated_by"`
+	ValueType      OrganizationSettingsValueType  `json:"value_type"`
+	// Values an enum setting allows
+	AllowedValues types.RawJSON `json:"allowed_values"`
+}
+
+type PasswordResetToken struct {
+	ID        int64        `json:"id"`
+	AccountID int64        `json:"account_id"`
+	TokenHash string       `json:"token_hash"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	ExpiresAt time.Time    `json:"expires_at"`
+}
+
+type PlatformAuditor struct {
+	AccountID int64        `json:"account_id"`
+	Reason    string       `json:"reason"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	// Account ID of the admin who granted it
+	CreatedBy sql.NullInt64 `json:"created_by"`
+}
+
+type PolicyViolation struct {
+	ID             int64         `json:"id"`
+	PublicID       []byte        `json:"public_id"`
+	OrganizationID int64         `json:"organization_id"`
+	PolicyID       sql.NullInt64 `json:"policy_id"`
+	// Account that made the change
+	AccountID     sql.NullInt64        `json:"account_id"`
+	Rule          PolicyViolationsRule `json:"rule"`
+	ProcedureName string               `json:"procedure_name"`
+	// Public ID of the organization, project or site being changed
+	ResourceID string `json:"resource_id"`
+	Message    string `json:"message"`
+	// Whether the change was rejected
+	Blocked   bool         `json:"blocked"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type PrivateServiceConnectEndpoint struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// LibOps service the endpoint connects to
+	Target PrivateServiceConnectEndpointsTarget `json:"target"`
+	// Address to reserve in the organization subnet; terraform picks one when NULL
+	RequestedIpAddress sql.NullString `json:"requested_ip_address"`
+	// Address reported by the terraform runner once the forwarding rule exists
+	IpAddress      sql.NullString                       `json:"ip_address"`
+	ForwardingRule sql.NullString                       `json:"forwarding_rule"`
+	Status         PrivateServiceConnectEndpointsStatus `json:"status"`
+	CreatedAt      sql.NullTime                         `json:"created_at"`
+	UpdatedAt      sql.NullTime                         `json:"updated_at"`
+	CreatedBy      sql.NullInt64                        `json:"created_by"`
 }
 
 type Project struct {
@@ -1824,6 +4330,8 @@ type Project struct {
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
 	UpdatedBy                 sql.NullInt64               `json:"updated_by"`
+	Labels                    types.RawJSON               `json:"labels"`
+	Version                   int64                       `json:"version"`
 }
 
 type ProjectFirewallRule struct {
@@ -1851,6 +4359,7 @@ type ProjectMember struct {
 	UpdatedAt sql.NullTime             `json:"updated_at"`
 	CreatedBy sql.NullInt64            `json:"created_by"`
 	UpdatedBy sql.NullInt64            `json:"updated_by"`
+	ExpiresAt sql.NullTime             `json:"expires_at"`
 }
 
 type ProjectSecret struct {
@@ -1864,6 +4373,10 @@ type ProjectSecret struct {
 	UpdatedAt int64                    `json:"updated_at"`
 	CreatedBy sql.NullInt64            `json:"created_by"`
 	UpdatedBy sql.NullInt64            `json:"updated_by"`
+	// Data key version the value is sealed with
+	KeyVersion sql.NullInt32 `json:"key_version"`
+	// External secret reference, resolved by the site controller
+	Reference sql.NullString `json:"reference"`
 }
 
 type ProjectSetting struct {
@@ -1879,6 +4392,9 @@ type ProjectSetting struct {
 	UpdatedAt    sql.NullTime              `json:"updated_at"`
 	CreatedBy    sql.NullInt64             `json:"created_by"`
 	UpdatedBy    sql.NullInt64             `json:"updated_by"`
+	ValueType    ProjectSettingsValueType  `json:"value_type"`
+	// Values an enum setting allows
+	AllowedValues types.RawJSON `json:"allowed_values"`
 }
 
 type Reconciliation struct {
@@ -1894,15 +4410,18 @@ type Reconciliation struct {
 	// For reconciliation: array of site IDs
 	TargetSiteIds types.RawJSON `json:"target_site_ids"`
 	// Array of event IDs that triggered this run
-	EventIds     json.RawMessage           `json:"event_ids"`
-	FirstEventAt time.Time                 `json:"first_event_at"`
-	LastEventAt  time.Time                 `json:"last_event_at"`
-	Status       NullReconciliationsStatus `json:"status"`
-	ErrorMessage sql.NullString            `json:"error_message"`
-	CreatedAt    sql.NullTime              `json:"created_at"`
-	TriggeredAt  sql.NullTime              `json:"triggered_at"`
-	StartedAt    sql.NullTime              `json:"started_at"`
-	CompletedAt  sql.NullTime              `json:"completed_at"`
+	EventIds     json.RawMessage               `json:"event_ids"`
+	FirstEventAt time.Time                     `json:"first_event_at"`
+	LastEventAt  time.Time                     `json:"last_event_at"`
+	Status       NullReconciliationsStatus     `json:"status"`
+	ErrorMessage sql.NullString                `json:"error_message"`
+	Attempt      int32                         `json:"attempt"`
+	RetryState   NullReconciliationsRetryState `json:"retry_state"`
+	NextRetryAt  sql.NullTime                  `json:"next_retry_at"`
+	CreatedAt    sql.NullTime                  `json:"created_at"`
+	TriggeredAt  sql.NullTime                  `json:"triggered_at"`
+	StartedAt    sql.NullTime                  `json:"started_at"`
+	CompletedAt  sql.NullTime                  `json:"completed_at"`
 }
 
 type ReconciliationResult struct {
@@ -1923,16 +4442,66 @@ type ReconciliationResult struct {
 	CompletedAt  time.Time      `json:"completed_at"`
 }
 
+type Region struct {
+	ID int64 `json:"id"`
+	// GCP region code (e.g., us-central1)
+	Code string `json:"code"`
+	// Human-readable location (e.g., Iowa)
+	DisplayName string `json:"display_name"`
+	// Country/area grouping code (e.g., us, eu)
+	Country            string `json:"country"`
+	CountryDisplayName string `json:"country_display_name"`
+	// Approximate location, for picking the nearest region
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// GCP compute cost relative to the cheapest region
+	RelativePrice float64 `json:"relative_price"`
+	// Whether this region is offered for new projects
+	Active    sql.NullBool `json:"active"`
+	SortOrder int32        `json:"sort_order"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
+
+type RegionMachineSeries struct {
+	RegionID  int64        `json:"region_id"`
+	Series    string       `json:"series"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
 type Relationship struct {
 	ID                   int64                         `json:"id"`
 	PublicID             []byte                        `json:"public_id"`
 	SourceOrganizationID int64                         `json:"source_organization_id"`
 	TargetOrganizationID int64                         `json:"target_organization_id"`
 	RelationshipType     RelationshipsRelationshipType `json:"relationship_type"`
-	Status               RelationshipsStatus           `json:"status"`
 	CreatedAt            sql.NullTime                  `json:"created_at"`
 	ResolvedAt           sql.NullTime                  `json:"resolved_at"`
 	ResolvedBy           sql.NullInt64                 `json:"resolved_by"`
+	// Highest role source members get in the target organization
+	MaxRole RelationshipsMaxRole `json:"max_role"`
+	// Account ID who requested the relationship
+	RequestedBy sql.NullInt64       `json:"requested_by"`
+	Status      RelationshipsStatus `json:"status"`
+	ExpiresAt   sql.NullTime        `json:"expires_at"`
+}
+
+type SecretAccessLog struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// Site the secret was read for
+	SiteID sql.NullInt64 `json:"site_id"`
+	// Account that read the secret
+	AccountID sql.NullInt64 `json:"account_id"`
+	// Email of the user or service account that read the secret
+	Principal     string `json:"principal"`
+	SecretName    string `json:"secret_name"`
+	SourceIp      string `json:"source_ip"`
+	ProcedureName string `json:"procedure_name"`
+	// Why the read was flagged as unusual
+	Anomaly   sql.NullString `json:"anomaly"`
+	CreatedAt sql.NullTime   `json:"created_at"`
 }
 
 type Site struct {
@@ -1964,6 +4533,155 @@ type Site struct {
 	UpdatedAt               sql.NullTime    `json:"updated_at"`
 	CreatedBy               sql.NullInt64   `json:"created_by"`
 	UpdatedBy               sql.NullInt64   `json:"updated_by"`
+	Labels                  types.RawJSON   `json:"labels"`
+	DiskUsedBytes           sql.NullInt64   `json:"disk_used_bytes"`
+	// Path requested by uptime probes
+	HealthCheckPath  string         `json:"health_check_path"`
+	AccessGateSecret sql.NullString `json:"access_gate_secret"`
+	// Release the controller reported on its last check-in
+	ControllerVersion sql.NullString `json:"controller_version"`
+	// OS image the controller reported on its last check-in
+	OsVersion sql.NullString `json:"os_version"`
+	// An installed OS update waits for a reboot
+	OsRebootRequired bool `json:"os_reboot_required"`
+	// Containers the controller saw running at its last check-in
+	ContainersRunning sql.NullInt32 `json:"containers_running"`
+	// Running containers whose health check is failing
+	ContainersUnhealthy sql.NullInt32 `json:"containers_unhealthy"`
+	// API region serving the site's controller
+	ApiRegion sql.NullString `json:"api_region"`
+	Version   int64          `json:"version"`
+}
+
+type SiteAccessProtection struct {
+	ID                int64                     `json:"id"`
+	SiteID            int64                     `json:"site_id"`
+	Mode              SiteAccessProtectionsMode `json:"mode"`
+	BasicAuthUsername sql.NullString            `json:"basic_auth_username"`
+	// bcrypt hash, written to the htpasswd file nginx reads
+	BasicAuthPasswordHash sql.NullString `json:"basic_auth_password_hash"`
+	CreatedAt             sql.NullTime   `json:"created_at"`
+	UpdatedAt             sql.NullTime   `json:"updated_at"`
+	UpdatedBy             sql.NullInt64  `json:"updated_by"`
+}
+
+type SiteAddon struct {
+	ID       int64            `json:"id"`
+	PublicID []byte           `json:"public_id"`
+	SiteID   int64            `json:"site_id"`
+	Kind     SiteAddonsKind   `json:"kind"`
+	Status   SiteAddonsStatus `json:"status"`
+	// Why the add-on is unhealthy
+	StatusMessage sql.NullString `json:"status_message"`
+	// Address the site reaches the add-on at, reported once it runs
+	Host sql.NullString `json:"host"`
+	Port sql.NullInt32  `json:"port"`
+	// When the controller last reported the add-on's health
+	CheckedAt sql.NullTime  `json:"checked_at"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	CreatedBy sql.NullInt64 `json:"created_by"`
+}
+
+type SiteCachePurge struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	SiteID   int64  `json:"site_id"`
+	// Path to invalidate; a trailing * matches a prefix
+	Path        string                `json:"path"`
+	Status      SiteCachePurgesStatus `json:"status"`
+	CreatedAt   sql.NullTime          `json:"created_at"`
+	CompletedAt sql.NullTime          `json:"completed_at"`
+	CreatedBy   sql.NullInt64         `json:"created_by"`
+}
+
+type SiteCdnConfig struct {
+	ID        int64                   `json:"id"`
+	SiteID    int64                   `json:"site_id"`
+	CacheMode SiteCdnConfigsCacheMode `json:"cache_mode"`
+	// TTL for responses without cache headers of their own
+	DefaultTtlSeconds int32 `json:"default_ttl_seconds"`
+	// Load balancer address reported by the terraform runner
+	IpAddress sql.NullString       `json:"ip_address"`
+	Status    SiteCdnConfigsStatus `json:"status"`
+	CreatedAt sql.NullTime         `json:"created_at"`
+	UpdatedAt sql.NullTime         `json:"updated_at"`
+	CreatedBy sql.NullInt64        `json:"created_by"`
+}
+
+type SiteClientCertificate struct {
+	ID     int64 `json:"id"`
+	SiteID int64 `json:"site_id"`
+	// Hex certificate serial number
+	SerialNumber string `json:"serial_number"`
+	// Hex SHA-256 of the DER certificate
+	Fingerprint string       `json:"fingerprint"`
+	NotBefore   time.Time    `json:"not_before"`
+	NotAfter    time.Time    `json:"not_after"`
+	RevokedAt   sql.NullTime `json:"revoked_at"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+}
+
+type SiteConfigVar struct {
+	ID     int64  `json:"id"`
+	SiteID int64  `json:"site_id"`
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	// Revision that last set the value
+	Revision  int64         `json:"revision"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+}
+
+type SiteConfigVarRevision struct {
+	ID       int64  `json:"id"`
+	SiteID   int64  `json:"site_id"`
+	Revision int64  `json:"revision"`
+	Name     string `json:"name"`
+	// NULL when the change added the var
+	PreviousValue sql.NullString `json:"previous_value"`
+	// NULL when the change removed the var
+	Value     sql.NullString `json:"value"`
+	CreatedAt sql.NullTime   `json:"created_at"`
+	CreatedBy sql.NullInt64  `json:"created_by"`
+}
+
+type SiteDatabase struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	SiteID   int64  `json:"site_id"`
+	// Database name, also the name of its user
+	Name   string              `json:"name"`
+	Tier   SiteDatabasesTier   `json:"tier"`
+	Status SiteDatabasesStatus `json:"status"`
+	// Why provisioning failed
+	StatusMessage sql.NullString `json:"status_message"`
+	// Address the site reaches the database at, reported once it is provisioned
+	Host              sql.NullString `json:"host"`
+	Port              int32          `json:"port"`
+	PasswordRotatedAt sql.NullTime   `json:"password_rotated_at"`
+	CreatedAt         sql.NullTime   `json:"created_at"`
+	UpdatedAt         sql.NullTime   `json:"updated_at"`
+	CreatedBy         sql.NullInt64  `json:"created_by"`
+}
+
+type SiteElevation struct {
+	ID        int64              `json:"id"`
+	PublicID  []byte             `json:"public_id"`
+	SiteID    int64              `json:"site_id"`
+	AccountID int64              `json:"account_id"`
+	Role      SiteElevationsRole `json:"role"`
+	Reason    string             `json:"reason"`
+	// How long the role lasts once approved
+	DurationMinutes int32                `json:"duration_minutes"`
+	Status          SiteElevationsStatus `json:"status"`
+	CreatedAt       sql.NullTime         `json:"created_at"`
+	ResolvedAt      sql.NullTime         `json:"resolved_at"`
+	// Account ID who approved, rejected or revoked it
+	ResolvedBy sql.NullInt64 `json:"resolved_by"`
+	// Set on approval
+	ExpiresAt sql.NullTime `json:"expires_at"`
 }
 
 type SiteFirewallRule struct {
@@ -1980,6 +4698,44 @@ type SiteFirewallRule struct {
 	UpdatedBy sql.NullInt64               `json:"updated_by"`
 }
 
+type SiteHealth struct {
+	SiteID          int64         `json:"site_id"`
+	Score           int32         `json:"score"`
+	CheckinScore    sql.NullInt32 `json:"checkin_score"`
+	ContainerScore  sql.NullInt32 `json:"container_score"`
+	ProbeScore      sql.NullInt32 `json:"probe_score"`
+	DeploymentScore sql.NullInt32 `json:"deployment_score"`
+	DegradedSince   sql.NullTime  `json:"degraded_since"`
+	ComputedAt      time.Time     `json:"computed_at"`
+}
+
+type SiteIncident struct {
+	ID             int64               `json:"id"`
+	PublicID       []byte              `json:"public_id"`
+	OrganizationID int64               `json:"organization_id"`
+	SiteID         int64               `json:"site_id"`
+	OpenSiteID     sql.NullInt64       `json:"open_site_id"`
+	Status         SiteIncidentsStatus `json:"status"`
+	OpenedAt       sql.NullTime        `json:"opened_at"`
+	ResolvedAt     sql.NullTime        `json:"resolved_at"`
+	Cause          SiteIncidentsCause  `json:"cause"`
+}
+
+type SiteMaintenanceWindow struct {
+	ID     int64 `json:"id"`
+	SiteID int64 `json:"site_id"`
+	// Lowercase weekdays the window opens on; empty for every day
+	Days json.RawMessage `json:"days"`
+	// First hour of the window, 0-23
+	StartHour int32 `json:"start_hour"`
+	// Hour the window closes, 1-24
+	EndHour   int32         `json:"end_hour"`
+	Timezone  string        `json:"timezone"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+}
+
 type SiteMember struct {
 	ID        int64                 `json:"id"`
 	PublicID  []byte                `json:"public_id"`
@@ -1991,6 +4747,57 @@ type SiteMember struct {
 	UpdatedAt sql.NullTime          `json:"updated_at"`
 	CreatedBy sql.NullInt64         `json:"created_by"`
 	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	ExpiresAt sql.NullTime          `json:"expires_at"`
+}
+
+type SiteProbe struct {
+	ID       int64        `json:"id"`
+	SiteID   int64        `json:"site_id"`
+	ProbedAt sql.NullTime `json:"probed_at"`
+	Up       bool         `json:"up"`
+	// HTTP status, 0 when no response was received
+	StatusCode     int32  `json:"status_code"`
+	ResponseTimeMs int32  `json:"response_time_ms"`
+	Error          string `json:"error"`
+}
+
+type SiteRateLimitRejection struct {
+	RuleID       int64     `json:"rule_id"`
+	RejectedDate time.Time `json:"rejected_date"`
+	Rejected     int64     `json:"rejected"`
+}
+
+type SiteRateLimitRule struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	SiteID   int64  `json:"site_id"`
+	Name     string `json:"name"`
+	// Empty limits every request
+	PathPrefix string `json:"path_prefix"`
+	// Requests each client IP may make per window
+	Requests   int32                        `json:"requests"`
+	RateWindow SiteRateLimitRulesRateWindow `json:"rate_window"`
+	// Requests over the rate let through before rejecting
+	Burst     int32         `json:"burst"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	CreatedBy sql.NullInt64 `json:"created_by"`
+}
+
+type SiteRedirect struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	SiteID   int64  `json:"site_id"`
+	// Host to match; empty matches every host
+	FromHost string `json:"from_host"`
+	// Path to match; a trailing * matches a prefix
+	FromPath string `json:"from_path"`
+	// Path or absolute URL; a trailing * is replaced by the matched suffix
+	ToUrl         string        `json:"to_url"`
+	StatusCode    int16         `json:"status_code"`
+	PreserveQuery bool          `json:"preserve_query"`
+	CreatedAt     sql.NullTime  `json:"created_at"`
+	UpdatedAt     sql.NullTime  `json:"updated_at"`
+	CreatedBy     sql.NullInt64 `json:"created_by"`
 }
 
 type SiteSecret struct {
@@ -2004,6 +4811,11 @@ type SiteSecret struct {
 	UpdatedAt int64                 `json:"updated_at"`
 	CreatedBy sql.NullInt64         `json:"created_by"`
 	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	Managed   bool                  `json:"managed"`
+	// Data key version the value is sealed with
+	KeyVersion sql.NullInt32 `json:"key_version"`
+	// External secret reference, resolved by the site controller
+	Reference sql.NullString `json:"reference"`
 }
 
 type SiteSetting struct {
@@ -2019,6 +4831,78 @@ type SiteSetting struct {
 	UpdatedAt    sql.NullTime           `json:"updated_at"`
 	CreatedBy    sql.NullInt64          `json:"created_by"`
 	UpdatedBy    sql.NullInt64          `json:"updated_by"`
+	ValueType    SiteSettingsValueType  `json:"value_type"`
+	// Values an enum setting allows
+	AllowedValues types.RawJSON `json:"allowed_values"`
+}
+
+type SiteStaticEgressIp struct {
+	ID     int64 `json:"id"`
+	SiteID int64 `json:"site_id"`
+	// Address reported by the terraform runner once it is reserved
+	IpAddress sql.NullString            `json:"ip_address"`
+	Status    SiteStaticEgressIpsStatus `json:"status"`
+	CreatedAt sql.NullTime              `json:"created_at"`
+	UpdatedAt sql.NullTime              `json:"updated_at"`
+	CreatedBy sql.NullInt64             `json:"created_by"`
+}
+
+type SiteTlsPolicy struct {
+	ID         int64                     `json:"id"`
+	SiteID     int64                     `json:"site_id"`
+	MinVersion SiteTlsPoliciesMinVersion `json:"min_version"`
+	// 0 sends no HSTS header
+	HstsMaxAgeSeconds     int32                            `json:"hsts_max_age_seconds"`
+	HstsIncludeSubdomains bool                             `json:"hsts_include_subdomains"`
+	HstsPreload           bool                             `json:"hsts_preload"`
+	OcspStapling          bool                             `json:"ocsp_stapling"`
+	CertificateSource     SiteTlsPoliciesCertificateSource `json:"certificate_source"`
+	CertificatePem        sql.NullString                   `json:"certificate_pem"`
+	PrivateKeyVaultPath   sql.NullString                   `json:"private_key_vault_path"`
+	CertificateNotAfter   sql.NullTime                     `json:"certificate_not_after"`
+	ProbeStatus           SiteTlsPoliciesProbeStatus       `json:"probe_status"`
+	ProbeMessage          string                           `json:"probe_message"`
+	ProbedAt              sql.NullTime                     `json:"probed_at"`
+	CreatedAt             sql.NullTime                     `json:"created_at"`
+	UpdatedAt             sql.NullTime                     `json:"updated_at"`
+	UpdatedBy             sql.NullInt64                    `json:"updated_by"`
+}
+
+type SiteWafBlock struct {
+	ID       int64  `json:"id"`
+	SiteID   int64  `json:"site_id"`
+	RuleID   int32  `json:"rule_id"`
+	Message  string `json:"message"`
+	Method   string `json:"method"`
+	Uri      string `json:"uri"`
+	ClientIp string `json:"client_ip"`
+	// False when the WAF only detected the request
+	Blocked    bool      `json:"blocked"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type SiteWafConfig struct {
+	ID      int64              `json:"id"`
+	SiteID  int64              `json:"site_id"`
+	Enabled bool               `json:"enabled"`
+	Mode    SiteWafConfigsMode `json:"mode"`
+	// OWASP CRS paranoia level, 1 to 4
+	ParanoiaLevel int8          `json:"paranoia_level"`
+	CreatedAt     sql.NullTime  `json:"created_at"`
+	UpdatedAt     sql.NullTime  `json:"updated_at"`
+	UpdatedBy     sql.NullInt64 `json:"updated_by"`
+}
+
+type SiteWafRuleExclusion struct {
+	ID       int64  `json:"id"`
+	PublicID []byte `json:"public_id"`
+	SiteID   int64  `json:"site_id"`
+	RuleID   int32  `json:"rule_id"`
+	// Empty excludes the rule everywhere
+	PathPrefix string        `json:"path_prefix"`
+	Reason     string        `json:"reason"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+	CreatedBy  sql.NullInt64 `json:"created_by"`
 }
 
 type SshAccess struct {
@@ -2029,6 +4913,7 @@ type SshAccess struct {
 	UpdatedAt sql.NullTime  `json:"updated_at"`
 	CreatedBy sql.NullInt64 `json:"created_by"`
 	UpdatedBy sql.NullInt64 `json:"updated_by"`
+	SftpOnly  bool          `json:"sftp_only"`
 }
 
 type SshKey struct {
@@ -2040,6 +4925,40 @@ type SshKey struct {
 	Fingerprint sql.NullString `json:"fingerprint"`
 	CreatedAt   sql.NullTime   `json:"created_at"`
 	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	// NULL for keys users added themselves
+	ExpiresAt sql.NullTime `json:"expires_at"`
+}
+
+type StatusPage struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// DNS label, served as status.<slug>.<status page domain>
+	Slug      string       `json:"slug"`
+	Title     string       `json:"title"`
+	IsPublic  bool         `json:"is_public"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
+
+type StatusPageSite struct {
+	StatusPageID int64 `json:"status_page_id"`
+	SiteID       int64 `json:"site_id"`
+	// Shown instead of the site name when set
+	DisplayName string `json:"display_name"`
+	Position    int32  `json:"position"`
+}
+
+type StatusPageUpdate struct {
+	ID             int64                   `json:"id"`
+	PublicID       []byte                  `json:"public_id"`
+	StatusPageID   int64                   `json:"status_page_id"`
+	SiteIncidentID sql.NullInt64           `json:"site_incident_id"`
+	Status         StatusPageUpdatesStatus `json:"status"`
+	Title          string                  `json:"title"`
+	Message        string                  `json:"message"`
+	CreatedAt      sql.NullTime            `json:"created_at"`
+	CreatedBy      sql.NullInt64           `json:"created_by"`
 }
 
 type StorageConfig struct {
@@ -2077,3 +4996,54 @@ type StripeSubscription struct {
 	CreatedAt               sql.NullTime              `json:"created_at"`
 	UpdatedAt               sql.NullTime              `json:"updated_at"`
 }
+
+type TerraformStateBackup struct {
+	ID             int64  `json:"id"`
+	PublicID       []byte `json:"public_id"`
+	OrganizationID int64  `json:"organization_id"`
+	// Backup object in the tfstate bucket
+	Object string `json:"object"`
+	// GCS generation of the backup object
+	Generation int64 `json:"generation"`
+	// Generation of the state object that was copied
+	SourceGeneration int64         `json:"source_generation"`
+	SizeBytes        int64         `json:"size_bytes"`
+	Reason           string        `json:"reason"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	CreatedBy        sql.NullInt64 `json:"created_by"`
+}
+
+type TerraformStateLayout struct {
+	OrganizationID int64                       `json:"organization_id"`
+	Layout         TerraformStateLayoutsLayout `json:"layout"`
+	// Terraform run moving the state into workspaces
+	MigrationRunID sql.NullString `json:"migration_run_id"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+}
+
+type UsageRecord struct {
+	ID             int64 `json:"id"`
+	OrganizationID int64 `json:"organization_id"`
+	ProjectID      int64 `json:"project_id"`
+	// backup_storage_bytes or egress_bytes
+	Metric    string       `json:"metric"`
+	UsageDate time.Time    `json:"usage_date"`
+	Quantity  int64        `json:"quantity"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
+
+type UsageReport struct {
+	ID             int64     `json:"id"`
+	OrganizationID int64     `json:"organization_id"`
+	Metric         string    `json:"metric"`
+	UsageDate      time.Time `json:"usage_date"`
+	// Raw metric quantity for the day
+	Quantity int64 `json:"quantity"`
+	// Billed units sent to Stripe
+	Units                    int64          `json:"units"`
+	StripeSubscriptionItemID sql.NullString `json:"stripe_subscription_item_id"`
+	// Meter event identifier (idempotency key)
+	StripeIdentifier string       `json:"stripe_identifier"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+}
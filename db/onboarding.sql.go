@@ -75,7 +75,7 @@ func (q *Queries) DeleteExpiredOnboardingSessions(ctx context.Context) error {
 const getOnboardingSession = `-- name: GetOnboardingSession :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name,
        CASE WHEN organization_public_id IS NULL THEN NULL ELSE BIN_TO_UUID(organization_public_id) END AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
@@ -91,6 +91,8 @@ type GetOnboardingSessionRow struct {
 	MachineType             sql.NullString `json:"machine_type"`
 	MachinePriceID          sql.NullString `json:"machine_price_id"`
 	DiskSizeGb              sql.NullInt32  `json:"disk_size_gb"`
+	PromoCode               sql.NullString `json:"promo_code"`
+	DiscountDescription     sql.NullString `json:"discount_description"`
 	StripeCheckoutSessionID sql.NullString `json:"stripe_checkout_session_id"`
 	StripeCheckoutUrl       sql.NullString `json:"stripe_checkout_url"`
 	StripeSubscriptionID    sql.NullString `json:"stripe_subscription_id"`
@@ -121,6 +123,8 @@ func (q *Queries) GetOnboardingSession(ctx context.Context, publicID string) (Ge
 		&i.MachineType,
 		&i.MachinePriceID,
 		&i.DiskSizeGb,
+		&i.PromoCode,
+		&i.DiscountDescription,
 		&i.StripeCheckoutSessionID,
 		&i.StripeCheckoutUrl,
 		&i.StripeSubscriptionID,
@@ -141,6 +145,25 @@ func (q *Queries) GetOnboardingSession(ctx context.Context, publicID string) (Ge
 	return i, err
 }
 
+const setOnboardingSessionDiscount = `-- name: SetOnboardingSessionDiscount :exec
+UPDATE onboarding_sessions SET
+  promo_code = ?,
+  discount_description = ?,
+  updated_at = NOW()
+WHERE id = ?
+`
+
+type SetOnboardingSessionDiscountParams struct {
+	PromoCode           sql.NullString `json:"promo_code"`
+	DiscountDescription sql.NullString `json:"discount_description"`
+	ID                  int64          `json:"id"`
+}
+
+func (q *Queries) SetOnboardingSessionDiscount(ctx context.Context, arg SetOnboardingSessionDiscountParams) error {
+	_, err := q.db.ExecContext(ctx, setOnboardingSessionDiscount, arg.PromoCode, arg.DiscountDescription, arg.ID)
+	return err
+}
+
 const updateOnboardingSession = `-- name: UpdateOnboardingSession :exec
 UPDATE onboarding_sessions SET
   org_name = ?,
@@ -8,6 +8,9 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+
+	"github.com/libops/api/db/types"
 )
 
 const countOrganizationSecrets = `-- name: CountOrganizationSecrets :one
@@ -37,8 +40,8 @@ func (q *Queries) CountUserOrganizations(ctx context.Context, accountID int64) (
 
 const createOrganization = `-- name: CreateOrganization :exec
 INSERT INTO organizations (
-  public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+  public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, labels, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateOrganizationParams struct {
@@ -49,6 +52,7 @@ type CreateOrganizationParams struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	CreatedBy         sql.NullInt64           `json:"created_by"`
 	UpdatedBy         sql.NullInt64           `json:"updated_by"`
 }
@@ -62,6 +66,7 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		arg.GcpParent,
 		arg.GcpFolderID,
 		arg.Status,
+		arg.Labels,
 		arg.CreatedBy,
 		arg.UpdatedBy,
 	)
@@ -126,8 +131,8 @@ const createOrganizationSecret = `-- name: CreateOrganizationSecret :execresult
 
 
 INSERT INTO organization_secrets (
-    public_id, organization_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?)
+    public_id, organization_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateOrganizationSecretParams struct {
@@ -140,6 +145,8 @@ type CreateOrganizationSecretParams struct {
 	UpdatedAt      int64                         `json:"updated_at"`
 	CreatedBy      sql.NullInt64                 `json:"created_by"`
 	UpdatedBy      sql.NullInt64                 `json:"updated_by"`
+	KeyVersion     sql.NullInt32                 `json:"key_version"`
+	Reference      sql.NullString                `json:"reference"`
 }
 
 // =============================================================================
@@ -156,9 +163,29 @@ func (q *Queries) CreateOrganizationSecret(ctx context.Context, arg CreateOrgani
 		arg.UpdatedAt,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.KeyVersion,
+		arg.Reference,
 	)
 }
 
+const deleteExpiredOrganizationMember = `-- name: DeleteExpiredOrganizationMember :execrows
+DELETE FROM organization_members WHERE id = ? AND expires_at <= ?
+`
+
+type DeleteExpiredOrganizationMemberParams struct {
+	ID  int64        `json:"id"`
+	Now sql.NullTime `json:"now"`
+}
+
+// Deletes a membership only if it's still expired, so one extended since it was listed stays
+func (q *Queries) DeleteExpiredOrganizationMember(ctx context.Context, arg DeleteExpiredOrganizationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredOrganizationMember, arg.ID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteOrganization = `-- name: DeleteOrganization :exec
 DELETE FROM organizations WHERE public_id = UUID_TO_BIN(?)
 `
@@ -218,7 +245,7 @@ func (q *Queries) DeleteOrganizationSecret(ctx context.Context, arg DeleteOrgani
 }
 
 const getOrganization = `-- name: GetOrganization :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by, version
 FROM organizations WHERE public_id = UUID_TO_BIN(?)
 `
 
@@ -231,12 +258,14 @@ type GetOrganizationRow struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	GcpProjectID      sql.NullString          `json:"gcp_project_id"`
 	GcpProjectNumber  sql.NullString          `json:"gcp_project_number"`
 	CreatedAt         sql.NullTime            `json:"created_at"`
 	UpdatedAt         sql.NullTime            `json:"updated_at"`
 	CreatedBy         sql.NullInt64           `json:"created_by"`
 	UpdatedBy         sql.NullInt64           `json:"updated_by"`
+	Version           int64                   `json:"version"`
 }
 
 func (q *Queries) GetOrganization(ctx context.Context, publicID string) (GetOrganizationRow, error) {
@@ -251,18 +280,44 @@ func (q *Queries) GetOrganization(ctx context.Context, publicID string) (GetOrga
 		&i.GcpParent,
 		&i.GcpFolderID,
 		&i.Status,
+		&i.Labels,
 		&i.GcpProjectID,
 		&i.GcpProjectNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.Version,
 	)
 	return i, err
 }
 
+const getOrganizationAllowedCidrs = `-- name: GetOrganizationAllowedCidrs :one
+SELECT COALESCE(allowed_cidrs, '[]') AS allowed_cidrs
+FROM organizations WHERE id = ?
+`
+
+func (q *Queries) GetOrganizationAllowedCidrs(ctx context.Context, id int64) (json.RawMessage, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationAllowedCidrs, id)
+	var allowed_cidrs json.RawMessage
+	err := row.Scan(&allowed_cidrs)
+	return allowed_cidrs, err
+}
+
+const getOrganizationDataResidency = `-- name: GetOrganizationDataResidency :one
+SELECT data_residency
+FROM organizations WHERE id = ?
+`
+
+func (q *Queries) GetOrganizationDataResidency(ctx context.Context, id int64) (NullOrganizationsDataResidency, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationDataResidency, id)
+	var data_residency NullOrganizationsDataResidency
+	err := row.Scan(&data_residency)
+	return data_residency, err
+}
+
 const getOrganizationByGCPProjectID = `-- name: GetOrganizationByGCPProjectID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations WHERE gcp_project_id = ?
 `
 
@@ -275,6 +330,7 @@ type GetOrganizationByGCPProjectIDRow struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	GcpProjectID      sql.NullString          `json:"gcp_project_id"`
 	GcpProjectNumber  sql.NullString          `json:"gcp_project_number"`
 	CreatedAt         sql.NullTime            `json:"created_at"`
@@ -295,6 +351,7 @@ func (q *Queries) GetOrganizationByGCPProjectID(ctx context.Context, gcpProjectI
 		&i.GcpParent,
 		&i.GcpFolderID,
 		&i.Status,
+		&i.Labels,
 		&i.GcpProjectID,
 		&i.GcpProjectNumber,
 		&i.CreatedAt,
@@ -306,7 +363,7 @@ func (q *Queries) GetOrganizationByGCPProjectID(ctx context.Context, gcpProjectI
 }
 
 const getOrganizationByID = `-- name: GetOrganizationByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations WHERE id = ?
 `
 
@@ -319,6 +376,7 @@ type GetOrganizationByIDRow struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	GcpProjectID      sql.NullString          `json:"gcp_project_id"`
 	GcpProjectNumber  sql.NullString          `json:"gcp_project_number"`
 	CreatedAt         sql.NullTime            `json:"created_at"`
@@ -339,6 +397,7 @@ func (q *Queries) GetOrganizationByID(ctx context.Context, id int64) (GetOrganiz
 		&i.GcpParent,
 		&i.GcpFolderID,
 		&i.Status,
+		&i.Labels,
 		&i.GcpProjectID,
 		&i.GcpProjectNumber,
 		&i.CreatedAt,
@@ -390,7 +449,7 @@ func (q *Queries) GetOrganizationFirewallRuleByPublicID(ctx context.Context, uui
 const getOrganizationMember = `-- name: GetOrganizationMember :one
 
 
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, account_id, ` + "`" + `role` + "`" + `, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, account_id, ` + "`" + `role` + "`" + `, expires_at, created_at, updated_at, created_by, updated_by
 FROM organization_members WHERE organization_id = ? AND account_id = ?
 `
 
@@ -405,6 +464,7 @@ type GetOrganizationMemberRow struct {
 	OrganizationID int64                   `json:"organization_id"`
 	AccountID      int64                   `json:"account_id"`
 	Role           OrganizationMembersRole `json:"role"`
+	ExpiresAt      sql.NullTime            `json:"expires_at"`
 	CreatedAt      sql.NullTime            `json:"created_at"`
 	UpdatedAt      sql.NullTime            `json:"updated_at"`
 	CreatedBy      sql.NullInt64           `json:"created_by"`
@@ -423,6 +483,7 @@ func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganization
 		&i.OrganizationID,
 		&i.AccountID,
 		&i.Role,
+		&i.ExpiresAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -434,7 +495,7 @@ func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganization
 const getOrganizationMemberByAccountAndOrganization = `-- name: GetOrganizationMemberByAccountAndOrganization :one
 
 
-SELECT id, public_id, organization_id, account_id, role, status, created_at, updated_at, created_by, updated_by FROM organization_members
+SELECT id, public_id, organization_id, account_id, role, status, created_at, updated_at, created_by, updated_by, expires_at FROM organization_members
 WHERE account_id = ? AND organization_id = ? AND status = 'active'
 `
 
@@ -460,6 +521,7 @@ func (q *Queries) GetOrganizationMemberByAccountAndOrganization(ctx context.Cont
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.ExpiresAt,
 	)
 	return i, err
 }
@@ -619,7 +681,7 @@ func (q *Queries) GetOrganizationSecretByName(ctx context.Context, arg GetOrgani
 
 const getOrganizationSecretByPublicID = `-- name: GetOrganizationSecretByPublicID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM organization_secrets WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
 
@@ -634,6 +696,8 @@ type GetOrganizationSecretByPublicIDRow struct {
 	UpdatedAt      int64                         `json:"updated_at"`
 	CreatedBy      sql.NullInt64                 `json:"created_by"`
 	UpdatedBy      sql.NullInt64                 `json:"updated_by"`
+	KeyVersion     sql.NullInt32                 `json:"key_version"`
+	Reference      sql.NullString                `json:"reference"`
 }
 
 func (q *Queries) GetOrganizationSecretByPublicID(ctx context.Context, publicID string) (GetOrganizationSecretByPublicIDRow, error) {
@@ -650,6 +714,8 @@ func (q *Queries) GetOrganizationSecretByPublicID(ctx context.Context, publicID
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.KeyVersion,
+		&i.Reference,
 	)
 	return i, err
 }
@@ -662,8 +728,8 @@ SELECT
     p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id,
     p.promote_strategy,
     p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path,
-    p.gcp_project_id, p.gcp_project_number, p.create_branch_sites, p.status,
-    p.created_at, p.updated_at, p.created_by, p.updated_by,
+    p.gcp_project_id, p.gcp_project_number, p.create_branch_sites, p.status, p.labels,
+    p.created_at, p.updated_at, p.created_by, p.updated_by, p.version,
     c.gcp_billing_account
 FROM projects p
 JOIN organizations c ON p.organization_id = c.id
@@ -691,10 +757,12 @@ type GetProjectWithOrganizationRow struct {
 	GcpProjectNumber          sql.NullString              `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
 	UpdatedBy                 sql.NullInt64               `json:"updated_by"`
+	Version                   int64                       `json:"version"`
 	GcpBillingAccount         string                      `json:"gcp_billing_account"`
 }
 
@@ -725,10 +793,12 @@ func (q *Queries) GetProjectWithOrganization(ctx context.Context, publicID strin
 		&i.GcpProjectNumber,
 		&i.CreateBranchSites,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.Version,
 		&i.GcpBillingAccount,
 	)
 	return i, err
@@ -819,18 +889,9 @@ func (q *Queries) HasUserProjectAccessInOrganization(ctx context.Context, arg Ha
 }
 
 const hasUserRelationshipAccessToOrganization = `-- name: HasUserRelationshipAccessToOrganization :one
-WITH RECURSIVE user_access AS (
-    SELECT organization_id
-    FROM organization_members
-    WHERE account_id = ? AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_access ua ON r.source_organization_id = ua.organization_id
-    WHERE r.status = 'approved'
-)
 SELECT EXISTS (
-    SELECT 1 FROM user_access WHERE organization_id = ?
+    SELECT 1 FROM effective_organization_access
+    WHERE account_id = ? AND organization_id = ? AND reachable
 )
 `
 
@@ -925,7 +986,7 @@ func (q *Queries) ListAccountOrganizations(ctx context.Context, arg ListAccountO
 }
 
 const listAllOrganizations = `-- name: ListAllOrganizations :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, ` + "`" + `name` + "`" + `, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, ` + "`" + `status` + "`" + `, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations
 ORDER BY created_at DESC
 `
@@ -939,6 +1000,7 @@ type ListAllOrganizationsRow struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	GcpProjectID      sql.NullString          `json:"gcp_project_id"`
 	GcpProjectNumber  sql.NullString          `json:"gcp_project_number"`
 	CreatedAt         sql.NullTime            `json:"created_at"`
@@ -965,6 +1027,7 @@ func (q *Queries) ListAllOrganizations(ctx context.Context) ([]ListAllOrganizati
 			&i.GcpParent,
 			&i.GcpFolderID,
 			&i.Status,
+			&i.Labels,
 			&i.GcpProjectID,
 			&i.GcpProjectNumber,
 			&i.CreatedAt,
@@ -1053,6 +1116,64 @@ func (q *Queries) ListApprovedRelatedOrganizationsForAccount(ctx context.Context
 	return items, nil
 }
 
+const listExpiredOrganizationMembers = `-- name: ListExpiredOrganizationMembers :many
+SELECT om.id, om.organization_id, om.account_id, om.` + "`" + `role` + "`" + `, om.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(a.public_id) AS account_public_id
+FROM organization_members om
+JOIN organizations o ON o.id = om.organization_id
+JOIN accounts a ON a.id = om.account_id
+WHERE om.expires_at <= ?
+ORDER BY om.expires_at
+LIMIT ?
+`
+
+type ListExpiredOrganizationMembersParams struct {
+	Now   sql.NullTime `json:"now"`
+	Limit int32        `json:"limit"`
+}
+
+type ListExpiredOrganizationMembersRow struct {
+	ID                   int64                   `json:"id"`
+	OrganizationID       int64                   `json:"organization_id"`
+	AccountID            int64                   `json:"account_id"`
+	Role                 OrganizationMembersRole `json:"role"`
+	ExpiresAt            sql.NullTime            `json:"expires_at"`
+	OrganizationPublicID string                  `json:"organization_public_id"`
+	AccountPublicID      string                  `json:"account_public_id"`
+}
+
+// Memberships past their expiry, oldest first
+func (q *Queries) ListExpiredOrganizationMembers(ctx context.Context, arg ListExpiredOrganizationMembersParams) ([]ListExpiredOrganizationMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredOrganizationMembers, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListExpiredOrganizationMembersRow{}
+	for rows.Next() {
+		var i ListExpiredOrganizationMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.AccountID,
+			&i.Role,
+			&i.ExpiresAt,
+			&i.OrganizationPublicID,
+			&i.AccountPublicID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrganizationFirewallRules = `-- name: ListOrganizationFirewallRules :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, rule_type, cidr, name, status, created_at, updated_at, created_by, updated_by
 FROM organization_firewall_rules
@@ -1110,7 +1231,7 @@ func (q *Queries) ListOrganizationFirewallRules(ctx context.Context, organizatio
 }
 
 const listOrganizationMembers = `-- name: ListOrganizationMembers :many
-SELECT cm.id, BIN_TO_UUID(cm.public_id) AS public_id, cm.organization_id, cm.account_id, cm.` + "`" + `role` + "`" + `, cm.status, cm.created_at, cm.updated_at,
+SELECT cm.id, BIN_TO_UUID(cm.public_id) AS public_id, cm.organization_id, cm.account_id, cm.` + "`" + `role` + "`" + `, cm.status, cm.expires_at, cm.created_at, cm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.` + "`" + `name` + "`" + `, a.github_username, a.verified, a.auth_method
 FROM organization_members cm
 JOIN accounts a ON cm.account_id = a.id
@@ -1132,6 +1253,7 @@ type ListOrganizationMembersRow struct {
 	AccountID       int64                         `json:"account_id"`
 	Role            OrganizationMembersRole       `json:"role"`
 	Status          NullOrganizationMembersStatus `json:"status"`
+	ExpiresAt       sql.NullTime                  `json:"expires_at"`
 	CreatedAt       sql.NullTime                  `json:"created_at"`
 	UpdatedAt       sql.NullTime                  `json:"updated_at"`
 	AccountPublicID string                        `json:"account_public_id"`
@@ -1158,6 +1280,7 @@ func (q *Queries) ListOrganizationMembers(ctx context.Context, arg ListOrganizat
 			&i.AccountID,
 			&i.Role,
 			&i.Status,
+			&i.ExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.AccountPublicID,
@@ -1181,7 +1304,7 @@ func (q *Queries) ListOrganizationMembers(ctx context.Context, arg ListOrganizat
 }
 
 const listOrganizationProjects = `-- name: ListOrganizationProjects :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id, promote_strategy, monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path, gcp_project_id, gcp_project_number, organization_project, create_branch_sites, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id, promote_strategy, monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path, gcp_project_id, gcp_project_number, organization_project, create_branch_sites, status, labels, created_at, updated_at, created_by, updated_by
 FROM projects
 WHERE organization_id = ?
 ORDER BY created_at DESC
@@ -1216,6 +1339,7 @@ type ListOrganizationProjectsRow struct {
 	OrganizationProject       sql.NullBool                `json:"organization_project"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
@@ -1253,6 +1377,7 @@ func (q *Queries) ListOrganizationProjects(ctx context.Context, arg ListOrganiza
 			&i.OrganizationProject,
 			&i.CreateBranchSites,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
@@ -1271,11 +1396,48 @@ func (q *Queries) ListOrganizationProjects(ctx context.Context, arg ListOrganiza
 	return items, nil
 }
 
+const listOrganizationProjectRegions = `-- name: ListOrganizationProjectRegions :many
+SELECT DISTINCT p.gcp_region, r.country
+FROM projects p
+LEFT JOIN regions r ON r.code = p.gcp_region
+WHERE p.organization_id = ? AND p.status != 'deleted' AND p.gcp_region IS NOT NULL
+ORDER BY p.gcp_region
+`
+
+type ListOrganizationProjectRegionsRow struct {
+	GcpRegion sql.NullString `json:"gcp_region"`
+	Country   sql.NullString `json:"country"`
+}
+
+// The regions an organization's live projects run in, with their country grouping.
+func (q *Queries) ListOrganizationProjectRegions(ctx context.Context, organizationID int64) ([]ListOrganizationProjectRegionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationProjectRegions, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationProjectRegionsRow
+	for rows.Next() {
+		var i ListOrganizationProjectRegionsRow
+		if err := rows.Scan(&i.GcpRegion, &i.Country); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrganizationRelationships = `-- name: ListOrganizationRelationships :many
 
 
 SELECT r.id, BIN_TO_UUID(r.public_id) AS public_id, r.source_organization_id, r.target_organization_id,
-       r.relationship_type, r.` + "`" + `status` + "`" + `, r.created_at, r.resolved_at, r.resolved_by
+       r.relationship_type, r.max_role, r.` + "`" + `status` + "`" + `, r.created_at, r.resolved_at, r.resolved_by
 FROM relationships r
 WHERE r.source_organization_id = ? OR r.target_organization_id = ?
 ORDER BY r.created_at DESC
@@ -1292,6 +1454,7 @@ type ListOrganizationRelationshipsRow struct {
 	SourceOrganizationID int64                         `json:"source_organization_id"`
 	TargetOrganizationID int64                         `json:"target_organization_id"`
 	RelationshipType     RelationshipsRelationshipType `json:"relationship_type"`
+	MaxRole              RelationshipsMaxRole          `json:"max_role"`
 	Status               RelationshipsStatus           `json:"status"`
 	CreatedAt            sql.NullTime                  `json:"created_at"`
 	ResolvedAt           sql.NullTime                  `json:"resolved_at"`
@@ -1316,6 +1479,7 @@ func (q *Queries) ListOrganizationRelationships(ctx context.Context, arg ListOrg
 			&i.SourceOrganizationID,
 			&i.TargetOrganizationID,
 			&i.RelationshipType,
+			&i.MaxRole,
 			&i.Status,
 			&i.CreatedAt,
 			&i.ResolvedAt,
@@ -1336,7 +1500,7 @@ func (q *Queries) ListOrganizationRelationships(ctx context.Context, arg ListOrg
 
 const listOrganizationSecrets = `-- name: ListOrganizationSecrets :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, reference
 FROM organization_secrets
 WHERE organization_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -1360,6 +1524,7 @@ type ListOrganizationSecretsRow struct {
 	UpdatedAt      int64                         `json:"updated_at"`
 	CreatedBy      sql.NullInt64                 `json:"created_by"`
 	UpdatedBy      sql.NullInt64                 `json:"updated_by"`
+	Reference      sql.NullString                `json:"reference"`
 }
 
 func (q *Queries) ListOrganizationSecrets(ctx context.Context, arg ListOrganizationSecretsParams) ([]ListOrganizationSecretsRow, error) {
@@ -1382,6 +1547,7 @@ func (q *Queries) ListOrganizationSecrets(ctx context.Context, arg ListOrganizat
 			&i.UpdatedAt,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.Reference,
 		); err != nil {
 			return nil, err
 		}
@@ -1397,25 +1563,22 @@ func (q *Queries) ListOrganizationSecrets(ctx context.Context, arg ListOrganizat
 }
 
 const listOrganizations = `-- name: ListOrganizations :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE account_id = ? AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = ? AND reachable
 )
-SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name, o.gcp_org_id, o.gcp_billing_account, o.gcp_parent, o.location, o.region, o.gcp_folder_id, o.status, o.gcp_project_id, o.gcp_project_number, o.created_at, o.updated_at, o.created_by, o.updated_by
+SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name, o.gcp_org_id, o.gcp_billing_account, o.gcp_parent, o.location, o.region, o.gcp_folder_id, o.status, o.labels, o.gcp_project_id, o.gcp_project_number, o.created_at, o.updated_at, o.created_by, o.updated_by
 FROM organizations o
 INNER JOIN user_orgs uo ON o.id = uo.organization_id
-ORDER BY o.created_at DESC
-LIMIT ? OFFSET ?
+WHERE (? IS NULL OR o.created_at < ? OR (o.created_at = ? AND o.id < ?))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ?
 `
 
 type ListOrganizationsParams struct {
-	AccountID int64 `json:"account_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	AccountID       int64         `json:"account_id"`
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+	CursorID        sql.NullInt64 `json:"cursor_id"`
+	Limit           int32         `json:"limit"`
 }
 
 type ListOrganizationsRow struct {
@@ -1429,6 +1592,7 @@ type ListOrganizationsRow struct {
 	Region            sql.NullString            `json:"region"`
 	GcpFolderID       sql.NullString            `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus   `json:"status"`
+	Labels            types.RawJSON             `json:"labels"`
 	GcpProjectID      sql.NullString            `json:"gcp_project_id"`
 	GcpProjectNumber  sql.NullString            `json:"gcp_project_number"`
 	CreatedAt         sql.NullTime              `json:"created_at"`
@@ -1438,7 +1602,14 @@ type ListOrganizationsRow struct {
 }
 
 func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]ListOrganizationsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listOrganizations, arg.AccountID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listOrganizations,
+		arg.AccountID,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1457,6 +1628,7 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 			&i.Region,
 			&i.GcpFolderID,
 			&i.Status,
+			&i.Labels,
 			&i.GcpProjectID,
 			&i.GcpProjectNumber,
 			&i.CreatedAt,
@@ -1478,42 +1650,41 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 }
 
 const listUserOrganizations = `-- name: ListUserOrganizations :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name,
-       COALESCE(om.role, 'read') AS role
-FROM organizations o
-JOIN user_orgs uo ON o.id = uo.organization_id
+SELECT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name,
+       COALESCE(om.role, 'read') AS role, o.created_at
+FROM effective_organization_access ea
+JOIN organizations o ON o.id = ea.organization_id
 LEFT JOIN organization_members om ON o.id = om.organization_id AND om.account_id = ?
-ORDER BY o.created_at DESC
-LIMIT ? OFFSET ?
+WHERE ea.account_id = ? AND ea.reachable
+AND (? IS NULL OR o.created_at < ? OR (o.created_at = ? AND o.id < ?))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ?
 `
 
 type ListUserOrganizationsParams struct {
-	AccountID int64 `json:"account_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	AccountID       int64         `json:"account_id"`
+	CursorCreatedAt sql.NullTime  `json:"cursor_created_at"`
+	CursorID        sql.NullInt64 `json:"cursor_id"`
+	Limit           int32         `json:"limit"`
 }
 
 type ListUserOrganizationsRow struct {
-	ID       int64                   `json:"id"`
-	PublicID string                  `json:"public_id"`
-	Name     string                  `json:"name"`
-	Role     OrganizationMembersRole `json:"role"`
+	ID        int64                   `json:"id"`
+	PublicID  string                  `json:"public_id"`
+	Name      string                  `json:"name"`
+	Role      OrganizationMembersRole `json:"role"`
+	CreatedAt sql.NullTime            `json:"created_at"`
 }
 
 func (q *Queries) ListUserOrganizations(ctx context.Context, arg ListUserOrganizationsParams) ([]ListUserOrganizationsRow, error) {
 	rows, err := q.db.QueryContext(ctx, listUserOrganizations,
 		arg.AccountID,
 		arg.AccountID,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -1527,6 +1698,7 @@ func (q *Queries) ListUserOrganizations(ctx context.Context, arg ListUserOrganiz
 			&i.PublicID,
 			&i.Name,
 			&i.Role,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1541,7 +1713,25 @@ func (q *Queries) ListUserOrganizations(ctx context.Context, arg ListUserOrganiz
 	return items, nil
 }
 
-const updateOrganization = `-- name: UpdateOrganization :exec
+const setOrganizationMemberExpiry = `-- name: SetOrganizationMemberExpiry :exec
+UPDATE organization_members SET
+  expires_at = ?,
+  updated_at = NOW()
+WHERE organization_id = ? AND account_id = ?
+`
+
+type SetOrganizationMemberExpiryParams struct {
+	ExpiresAt      sql.NullTime `json:"expires_at"`
+	OrganizationID int64        `json:"organization_id"`
+	AccountID      int64        `json:"account_id"`
+}
+
+func (q *Queries) SetOrganizationMemberExpiry(ctx context.Context, arg SetOrganizationMemberExpiryParams) error {
+	_, err := q.db.ExecContext(ctx, setOrganizationMemberExpiry, arg.ExpiresAt, arg.OrganizationID, arg.AccountID)
+	return err
+}
+
+const updateOrganization = `-- name: UpdateOrganization :execrows
 UPDATE organizations SET
   ` + "`" + `name` + "`" + ` = ?,
   gcp_org_id = ?,
@@ -1549,9 +1739,11 @@ UPDATE organizations SET
   gcp_parent = ?,
   gcp_folder_id = ?,
   ` + "`" + `status` + "`" + ` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(?)
+WHERE public_id = UUID_TO_BIN(?) AND version = ?
 `
 
 type UpdateOrganizationParams struct {
@@ -1561,21 +1753,68 @@ type UpdateOrganizationParams struct {
 	GcpParent         string                  `json:"gcp_parent"`
 	GcpFolderID       sql.NullString          `json:"gcp_folder_id"`
 	Status            NullOrganizationsStatus `json:"status"`
+	Labels            types.RawJSON           `json:"labels"`
 	UpdatedBy         sql.NullInt64           `json:"updated_by"`
 	PublicID          string                  `json:"public_id"`
+	Version           int64                   `json:"version"`
 }
 
-func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) error {
-	_, err := q.db.ExecContext(ctx, updateOrganization,
+// Applies the change only if the organization is still at the version it was read at;
+// no rows are affected when someone else changed it first
+func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateOrganization,
 		arg.Name,
 		arg.GcpOrgID,
 		arg.GcpBillingAccount,
 		arg.GcpParent,
 		arg.GcpFolderID,
 		arg.Status,
+		arg.Labels,
 		arg.UpdatedBy,
 		arg.PublicID,
+		arg.Version,
 	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateOrganizationAllowedCidrs = `-- name: UpdateOrganizationAllowedCidrs :exec
+UPDATE organizations SET
+  allowed_cidrs = ?,
+  updated_at = NOW(),
+  updated_by = ?
+WHERE id = ?
+`
+
+type UpdateOrganizationAllowedCidrsParams struct {
+	AllowedCidrs types.RawJSON `json:"allowed_cidrs"`
+	UpdatedBy    sql.NullInt64 `json:"updated_by"`
+	ID           int64         `json:"id"`
+}
+
+func (q *Queries) UpdateOrganizationAllowedCidrs(ctx context.Context, arg UpdateOrganizationAllowedCidrsParams) error {
+	_, err := q.db.ExecContext(ctx, updateOrganizationAllowedCidrs, arg.AllowedCidrs, arg.UpdatedBy, arg.ID)
+	return err
+}
+
+const updateOrganizationDataResidency = `-- name: UpdateOrganizationDataResidency :exec
+UPDATE organizations SET
+  data_residency = ?,
+  updated_at = NOW(),
+  updated_by = ?
+WHERE id = ?
+`
+
+type UpdateOrganizationDataResidencyParams struct {
+	DataResidency NullOrganizationsDataResidency `json:"data_residency"`
+	UpdatedBy     sql.NullInt64                  `json:"updated_by"`
+	ID            int64                          `json:"id"`
+}
+
+func (q *Queries) UpdateOrganizationDataResidency(ctx context.Context, arg UpdateOrganizationDataResidencyParams) error {
+	_, err := q.db.ExecContext(ctx, updateOrganizationDataResidency, arg.DataResidency, arg.UpdatedBy, arg.ID)
 	return err
 }
 
@@ -1623,20 +1862,24 @@ func (q *Queries) UpdateOrganizationMemberStatus(ctx context.Context, arg Update
 
 const updateOrganizationSecret = `-- name: UpdateOrganizationSecret :exec
 UPDATE organization_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?
 `
 
 type UpdateOrganizationSecretParams struct {
-	VaultPath string        `json:"vault_path"`
-	UpdatedBy sql.NullInt64 `json:"updated_by"`
-	UpdatedAt int64         `json:"updated_at"`
-	ID        int64         `json:"id"`
+	VaultPath  string         `json:"vault_path"`
+	KeyVersion sql.NullInt32  `json:"key_version"`
+	Reference  sql.NullString `json:"reference"`
+	UpdatedBy  sql.NullInt64  `json:"updated_by"`
+	UpdatedAt  int64          `json:"updated_at"`
+	ID         int64          `json:"id"`
 }
 
 func (q *Queries) UpdateOrganizationSecret(ctx context.Context, arg UpdateOrganizationSecretParams) error {
 	_, err := q.db.ExecContext(ctx, updateOrganizationSecret,
 		arg.VaultPath,
+		arg.KeyVersion,
+		arg.Reference,
 		arg.UpdatedBy,
 		arg.UpdatedAt,
 		arg.ID,
@@ -42,9 +42,9 @@ INSERT INTO projects (
   public_id, organization_id, ` + "`" + `name` + "`" + `,
   gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
   monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-  gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `,
+  gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `, labels,
   created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateProjectParams struct {
@@ -66,6 +66,7 @@ type CreateProjectParams struct {
 	GcpProjectNumber          sql.NullString     `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool       `json:"create_branch_sites"`
 	Status                    NullProjectsStatus `json:"status"`
+	Labels                    types.RawJSON      `json:"labels"`
 	CreatedBy                 sql.NullInt64      `json:"created_by"`
 	UpdatedBy                 sql.NullInt64      `json:"updated_by"`
 }
@@ -90,6 +91,7 @@ func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) er
 		arg.GcpProjectNumber,
 		arg.CreateBranchSites,
 		arg.Status,
+		arg.Labels,
 		arg.CreatedBy,
 		arg.UpdatedBy,
 	)
@@ -127,20 +129,22 @@ const createProjectSecret = `-- name: CreateProjectSecret :execresult
 
 
 INSERT INTO project_secrets (
-    public_id, project_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?)
+    public_id, project_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateProjectSecretParams struct {
-	PublicID  string                   `json:"public_id"`
-	ProjectID int64                    `json:"project_id"`
-	Name      string                   `json:"name"`
-	VaultPath string                   `json:"vault_path"`
-	Status    NullProjectSecretsStatus `json:"status"`
-	CreatedAt int64                    `json:"created_at"`
-	UpdatedAt int64                    `json:"updated_at"`
-	CreatedBy sql.NullInt64            `json:"created_by"`
-	UpdatedBy sql.NullInt64            `json:"updated_by"`
+	PublicID   string                   `json:"public_id"`
+	ProjectID  int64                    `json:"project_id"`
+	Name       string                   `json:"name"`
+	VaultPath  string                   `json:"vault_path"`
+	Status     NullProjectSecretsStatus `json:"status"`
+	CreatedAt  int64                    `json:"created_at"`
+	UpdatedAt  int64                    `json:"updated_at"`
+	CreatedBy  sql.NullInt64            `json:"created_by"`
+	UpdatedBy  sql.NullInt64            `json:"updated_by"`
+	KeyVersion sql.NullInt32            `json:"key_version"`
+	Reference  sql.NullString           `json:"reference"`
 }
 
 // =============================================================================
@@ -157,6 +161,8 @@ func (q *Queries) CreateProjectSecret(ctx context.Context, arg CreateProjectSecr
 		arg.UpdatedAt,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.KeyVersion,
+		arg.Reference,
 	)
 }
 
@@ -209,8 +215,8 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, ` + "`" + `name
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `,
-       created_at, updated_at, created_by, updated_by
+       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM projects WHERE public_id = UUID_TO_BIN(?)
 `
 
@@ -235,10 +241,12 @@ type GetProjectRow struct {
 	GcpProjectNumber          sql.NullString              `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
 	UpdatedBy                 sql.NullInt64               `json:"updated_by"`
+	Version                   int64                       `json:"version"`
 }
 
 func (q *Queries) GetProject(ctx context.Context, publicID string) (GetProjectRow, error) {
@@ -265,10 +273,12 @@ func (q *Queries) GetProject(ctx context.Context, publicID string) (GetProjectRo
 		&i.GcpProjectNumber,
 		&i.CreateBranchSites,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.Version,
 	)
 	return i, err
 }
@@ -278,7 +288,7 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, ` + "`" + `name
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `,
+       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `, labels,
        created_at, updated_at, created_by, updated_by
 FROM projects WHERE gcp_project_id = ?
 `
@@ -304,6 +314,7 @@ type GetProjectByGCPProjectIDRow struct {
 	GcpProjectNumber          sql.NullString              `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
@@ -334,6 +345,7 @@ func (q *Queries) GetProjectByGCPProjectID(ctx context.Context, gcpProjectID sql
 		&i.GcpProjectNumber,
 		&i.CreateBranchSites,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -347,7 +359,7 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, ` + "`" + `name
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `,
+       gcp_project_id, gcp_project_number, create_branch_sites, ` + "`" + `status` + "`" + `, labels,
        created_at, updated_at, created_by, updated_by
 FROM projects WHERE id = ?
 `
@@ -373,6 +385,7 @@ type GetProjectByIDRow struct {
 	GcpProjectNumber          sql.NullString              `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
@@ -403,6 +416,7 @@ func (q *Queries) GetProjectByID(ctx context.Context, id int64) (GetProjectByIDR
 		&i.GcpProjectNumber,
 		&i.CreateBranchSites,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -531,21 +545,23 @@ func (q *Queries) GetProjectSecretByName(ctx context.Context, arg GetProjectSecr
 
 const getProjectSecretByPublicID = `-- name: GetProjectSecretByPublicID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM project_secrets WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
 
 type GetProjectSecretByPublicIDRow struct {
-	ID        int64                    `json:"id"`
-	PublicID  string                   `json:"public_id"`
-	ProjectID int64                    `json:"project_id"`
-	Name      string                   `json:"name"`
-	VaultPath string                   `json:"vault_path"`
-	Status    NullProjectSecretsStatus `json:"status"`
-	CreatedAt int64                    `json:"created_at"`
-	UpdatedAt int64                    `json:"updated_at"`
-	CreatedBy sql.NullInt64            `json:"created_by"`
-	UpdatedBy sql.NullInt64            `json:"updated_by"`
+	ID         int64                    `json:"id"`
+	PublicID   string                   `json:"public_id"`
+	ProjectID  int64                    `json:"project_id"`
+	Name       string                   `json:"name"`
+	VaultPath  string                   `json:"vault_path"`
+	Status     NullProjectSecretsStatus `json:"status"`
+	CreatedAt  int64                    `json:"created_at"`
+	UpdatedAt  int64                    `json:"updated_at"`
+	CreatedBy  sql.NullInt64            `json:"created_by"`
+	UpdatedBy  sql.NullInt64            `json:"updated_by"`
+	KeyVersion sql.NullInt32            `json:"key_version"`
+	Reference  sql.NullString           `json:"reference"`
 }
 
 func (q *Queries) GetProjectSecretByPublicID(ctx context.Context, publicID string) (GetProjectSecretByPublicIDRow, error) {
@@ -562,6 +578,8 @@ func (q *Queries) GetProjectSecretByPublicID(ctx context.Context, publicID strin
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.KeyVersion,
+		&i.Reference,
 	)
 	return i, err
 }
@@ -569,8 +587,8 @@ func (q *Queries) GetProjectSecretByPublicID(ctx context.Context, publicID strin
 const getSiteByProjectAndName = `-- name: GetSiteByProjectAndName :one
 
 
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM sites WHERE project_id = ? AND ` + "`" + `name` + "`" + ` = ?
 `
 
@@ -599,10 +617,12 @@ type GetSiteByProjectAndNameRow struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedAt        sql.NullTime    `json:"created_at"`
 	UpdatedAt        sql.NullTime    `json:"updated_at"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
 	UpdatedBy        sql.NullInt64   `json:"updated_by"`
+	Version          int64           `json:"version"`
 }
 
 // =============================================================================
@@ -631,10 +651,12 @@ func (q *Queries) GetSiteByProjectAndName(ctx context.Context, arg GetSiteByProj
 		&i.IsProduction,
 		&i.GcpExternalIp,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.Version,
 	)
 	return i, err
 }
@@ -731,7 +753,7 @@ func (q *Queries) ListProjectFirewallRules(ctx context.Context, projectID sql.Nu
 
 const listProjectSecrets = `-- name: ListProjectSecrets :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, reference
 FROM project_secrets
 WHERE project_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -755,6 +777,7 @@ type ListProjectSecretsRow struct {
 	UpdatedAt int64                    `json:"updated_at"`
 	CreatedBy sql.NullInt64            `json:"created_by"`
 	UpdatedBy sql.NullInt64            `json:"updated_by"`
+	Reference sql.NullString           `json:"reference"`
 }
 
 func (q *Queries) ListProjectSecrets(ctx context.Context, arg ListProjectSecretsParams) ([]ListProjectSecretsRow, error) {
@@ -777,6 +800,7 @@ func (q *Queries) ListProjectSecrets(ctx context.Context, arg ListProjectSecrets
 			&i.UpdatedAt,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.Reference,
 		); err != nil {
 			return nil, err
 		}
@@ -792,7 +816,7 @@ func (q *Queries) ListProjectSecrets(ctx context.Context, arg ListProjectSecrets
 }
 
 const listProjectSites = `-- name: ListProjectSites :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, status, labels, created_at, updated_at, created_by, updated_by
 FROM sites
 WHERE project_id = ?
 ORDER BY created_at DESC
@@ -825,6 +849,7 @@ type ListProjectSitesRow struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedAt        sql.NullTime    `json:"created_at"`
 	UpdatedAt        sql.NullTime    `json:"updated_at"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
@@ -860,6 +885,7 @@ func (q *Queries) ListProjectSites(ctx context.Context, arg ListProjectSitesPara
 			&i.IsProduction,
 			&i.GcpExternalIp,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
@@ -991,6 +1017,7 @@ JOIN (
       AND om_related.status = 'active'
       AND om_related.role IN ('owner', 'developer')
 ) AS authorized_accounts ON a.id = authorized_accounts.account_id
+WHERE sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP
 ORDER BY sk.created_at DESC
 `
 
@@ -1022,30 +1049,26 @@ func (q *Queries) ListSshKeysByProject(ctx context.Context, arg ListSshKeysByPro
 }
 
 const listUserProjects = `-- name: ListUserProjects :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.created_at, p.updated_at, p.created_by, p.updated_by
+SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.labels, p.created_at, p.updated_at, p.created_by, p.updated_by
 FROM projects p
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = ? AND ea.reachable
+WHERE (pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = ? OR ? IS NULL)
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?
+AND (? IS NULL OR JSON_CONTAINS(p.labels, ?))
+AND (? IS NULL OR p.created_at < ? OR (p.created_at = ? AND p.id < ?))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT ?
 `
 
 type ListUserProjectsParams struct {
-	AccountID            int64         `json:"account_id"`
-	FilterOrganizationID sql.NullInt64 `json:"filter_organization_id"`
-	Limit                int32         `json:"limit"`
-	Offset               int32         `json:"offset"`
+	AccountID            int64          `json:"account_id"`
+	FilterOrganizationID sql.NullInt64  `json:"filter_organization_id"`
+	LabelSelector        sql.NullString `json:"label_selector"`
+	CursorCreatedAt      sql.NullTime   `json:"cursor_created_at"`
+	CursorID             sql.NullInt64  `json:"cursor_id"`
+	Limit                int32          `json:"limit"`
 }
 
 type ListUserProjectsRow struct {
@@ -1071,6 +1094,7 @@ type ListUserProjectsRow struct {
 	OrganizationProject       sql.NullBool                `json:"organization_project"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
@@ -1083,8 +1107,13 @@ func (q *Queries) ListUserProjects(ctx context.Context, arg ListUserProjectsPara
 		arg.AccountID,
 		arg.FilterOrganizationID,
 		arg.FilterOrganizationID,
+		arg.LabelSelector,
+		arg.LabelSelector,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -1116,6 +1145,7 @@ func (q *Queries) ListUserProjects(ctx context.Context, arg ListUserProjectsPara
 			&i.OrganizationProject,
 			&i.CreateBranchSites,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
@@ -1135,30 +1165,24 @@ func (q *Queries) ListUserProjects(ctx context.Context, arg ListUserProjectsPara
 }
 
 const listUserProjectsWithOrg = `-- name: ListUserProjectsWithOrg :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, o.name AS organization_name, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.created_at, p.updated_at, p.created_by, p.updated_by
+SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, o.name AS organization_name, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.labels, p.created_at, p.updated_at, p.created_by, p.updated_by
 FROM projects p
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = ? AND ea.reachable
+WHERE (pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = ? OR ? IS NULL)
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?
+AND (? IS NULL OR p.created_at < ? OR (p.created_at = ? AND p.id < ?))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT ?
 `
 
 type ListUserProjectsWithOrgParams struct {
 	AccountID            int64         `json:"account_id"`
 	FilterOrganizationID sql.NullInt64 `json:"filter_organization_id"`
+	CursorCreatedAt      sql.NullTime  `json:"cursor_created_at"`
+	CursorID             sql.NullInt64 `json:"cursor_id"`
 	Limit                int32         `json:"limit"`
-	Offset               int32         `json:"offset"`
 }
 
 type ListUserProjectsWithOrgRow struct {
@@ -1183,6 +1207,7 @@ type ListUserProjectsWithOrgRow struct {
 	OrganizationProject       sql.NullBool                `json:"organization_project"`
 	CreateBranchSites         sql.NullBool                `json:"create_branch_sites"`
 	Status                    NullProjectsStatus          `json:"status"`
+	Labels                    types.RawJSON               `json:"labels"`
 	CreatedAt                 sql.NullTime                `json:"created_at"`
 	UpdatedAt                 sql.NullTime                `json:"updated_at"`
 	CreatedBy                 sql.NullInt64               `json:"created_by"`
@@ -1195,8 +1220,11 @@ func (q *Queries) ListUserProjectsWithOrg(ctx context.Context, arg ListUserProje
 		arg.AccountID,
 		arg.FilterOrganizationID,
 		arg.FilterOrganizationID,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -1227,6 +1255,7 @@ func (q *Queries) ListUserProjectsWithOrg(ctx context.Context, arg ListUserProje
 			&i.OrganizationProject,
 			&i.CreateBranchSites,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
@@ -1246,34 +1275,28 @@ func (q *Queries) ListUserProjectsWithOrg(ctx context.Context, arg ListUserProje
 }
 
 const listUserSitesWithProject = `-- name: ListUserSitesWithProject :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, p.name AS project_name, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.gcp_external_ip, s.status, s.created_at, s.updated_at, s.created_by, s.updated_by
+SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, p.name AS project_name, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.gcp_external_ip, s.status, s.labels, s.created_at, s.updated_at, s.created_by, s.updated_by
 FROM sites s
 JOIN projects p ON s.project_id = p.id
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = ? AND sm.status = 'active'
 LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = ? AND ea.reachable
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = ? OR ? IS NULL)
 AND (s.project_id = ? OR ? IS NULL)
-ORDER BY s.created_at DESC
-LIMIT ? OFFSET ?
+AND (? IS NULL OR s.created_at < ? OR (s.created_at = ? AND s.id < ?))
+ORDER BY s.created_at DESC, s.id DESC
+LIMIT ?
 `
 
 type ListUserSitesWithProjectParams struct {
 	AccountID            int64         `json:"account_id"`
 	FilterOrganizationID sql.NullInt64 `json:"filter_organization_id"`
 	FilterProjectID      sql.NullInt64 `json:"filter_project_id"`
+	CursorCreatedAt      sql.NullTime  `json:"cursor_created_at"`
+	CursorID             sql.NullInt64 `json:"cursor_id"`
 	Limit                int32         `json:"limit"`
-	Offset               int32         `json:"offset"`
 }
 
 type ListUserSitesWithProjectRow struct {
@@ -1296,6 +1319,7 @@ type ListUserSitesWithProjectRow struct {
 	RolloutCmd           types.RawJSON   `json:"rollout_cmd"`
 	GcpExternalIp        sql.NullString  `json:"gcp_external_ip"`
 	Status               NullSitesStatus `json:"status"`
+	Labels               types.RawJSON   `json:"labels"`
 	CreatedAt            sql.NullTime    `json:"created_at"`
 	UpdatedAt            sql.NullTime    `json:"updated_at"`
 	CreatedBy            sql.NullInt64   `json:"created_by"`
@@ -1311,8 +1335,11 @@ func (q *Queries) ListUserSitesWithProject(ctx context.Context, arg ListUserSite
 		arg.FilterOrganizationID,
 		arg.FilterProjectID,
 		arg.FilterProjectID,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -1341,6 +1368,7 @@ func (q *Queries) ListUserSitesWithProject(ctx context.Context, arg ListUserSite
 			&i.RolloutCmd,
 			&i.GcpExternalIp,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
@@ -1359,7 +1387,7 @@ func (q *Queries) ListUserSitesWithProject(ctx context.Context, arg ListUserSite
 	return items, nil
 }
 
-const updateProject = `-- name: UpdateProject :exec
+const updateProject = `-- name: UpdateProject :execrows
 UPDATE projects SET
   ` + "`" + `name` + "`" + ` = ?,
   gcp_region = ?,
@@ -1377,9 +1405,11 @@ UPDATE projects SET
   gcp_project_number = ?,
   create_branch_sites = ?,
   ` + "`" + `status` + "`" + ` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(?)
+WHERE public_id = UUID_TO_BIN(?) AND version = ?
 `
 
 type UpdateProjectParams struct {
@@ -1399,12 +1429,16 @@ type UpdateProjectParams struct {
 	GcpProjectNumber          sql.NullString     `json:"gcp_project_number"`
 	CreateBranchSites         sql.NullBool       `json:"create_branch_sites"`
 	Status                    NullProjectsStatus `json:"status"`
+	Labels                    types.RawJSON      `json:"labels"`
 	UpdatedBy                 sql.NullInt64      `json:"updated_by"`
 	PublicID                  string             `json:"public_id"`
+	Version                   int64              `json:"version"`
 }
 
-func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) error {
-	_, err := q.db.ExecContext(ctx, updateProject,
+// Applies the change only if the project is still at the version it was read at;
+// no rows are affected when someone else changed it first
+func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProject,
 		arg.Name,
 		arg.GcpRegion,
 		arg.GcpZone,
@@ -1421,31 +1455,56 @@ func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) er
 		arg.GcpProjectNumber,
 		arg.CreateBranchSites,
 		arg.Status,
+		arg.Labels,
 		arg.UpdatedBy,
 		arg.PublicID,
+		arg.Version,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const updateProjectSecret = `-- name: UpdateProjectSecret :exec
 UPDATE project_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?
 `
 
 type UpdateProjectSecretParams struct {
-	VaultPath string        `json:"vault_path"`
-	UpdatedBy sql.NullInt64 `json:"updated_by"`
-	UpdatedAt int64         `json:"updated_at"`
-	ID        int64         `json:"id"`
+	VaultPath  string         `json:"vault_path"`
+	KeyVersion sql.NullInt32  `json:"key_version"`
+	Reference  sql.NullString `json:"reference"`
+	UpdatedBy  sql.NullInt64  `json:"updated_by"`
+	UpdatedAt  int64          `json:"updated_at"`
+	ID         int64          `json:"id"`
 }
 
 func (q *Queries) UpdateProjectSecret(ctx context.Context, arg UpdateProjectSecretParams) error {
 	_, err := q.db.ExecContext(ctx, updateProjectSecret,
 		arg.VaultPath,
+		arg.KeyVersion,
+		arg.Reference,
 		arg.UpdatedBy,
 		arg.UpdatedAt,
 		arg.ID,
 	)
 	return err
 }
+
+const updateProjectStripeSubscriptionItem = `-- name: UpdateProjectStripeSubscriptionItem :exec
+UPDATE projects SET stripe_subscription_item_id = ? WHERE id = ?
+`
+
+type UpdateProjectStripeSubscriptionItemParams struct {
+	StripeSubscriptionItemID sql.NullString `json:"stripe_subscription_item_id"`
+	ID                       int64          `json:"id"`
+}
+
+// Records the machine's subscription item when billing was put back after a
+// plan change that couldn't be saved; the project itself didn't change
+func (q *Queries) UpdateProjectStripeSubscriptionItem(ctx context.Context, arg UpdateProjectStripeSubscriptionItemParams) error {
+	_, err := q.db.ExecContext(ctx, updateProjectStripeSubscriptionItem, arg.StripeSubscriptionItemID, arg.ID)
+	return err
+}
@@ -7,29 +7,97 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 )
 
 type Querier interface {
+	AcceptMemberInvitation(ctx context.Context, arg AcceptMemberInvitationParams) (int64, error)
+	AcceptOrganizationOwnershipTransfer(ctx context.Context, id int64) (int64, error)
+	ActivatePrivateServiceConnectEndpoint(ctx context.Context, arg ActivatePrivateServiceConnectEndpointParams) error
+	ActivateSiteCdnConfig(ctx context.Context, arg ActivateSiteCdnConfigParams) error
+	ActivateSiteDatabase(ctx context.Context, arg ActivateSiteDatabaseParams) error
+	ActivateSiteStaticEgressIp(ctx context.Context, arg ActivateSiteStaticEgressIpParams) error
+	// Adds to a counter metric for the day.
+	AddProjectUsage(ctx context.Context, arg AddProjectUsageParams) error
+	AddSiteRateLimitRejections(ctx context.Context, arg AddSiteRateLimitRejectionsParams) error
+	AddStatusPageSite(ctx context.Context, arg AddStatusPageSiteParams) error
 	AppendEventIDsToRun(ctx context.Context, arg AppendEventIDsToRunParams) error
 	ApproveRelationship(ctx context.Context, arg ApproveRelationshipParams) (sql.Result, error)
+	// The role lasts for the requested duration from approval
+	ApproveSiteElevation(ctx context.Context, arg ApproveSiteElevationParams) (sql.Result, error)
+	CancelOrganizationOwnershipTransfer(ctx context.Context, id int64) (int64, error)
+	// Replaces open transfers when a new one starts or the organization is handed over
+	CancelPendingOrganizationOwnershipTransfers(ctx context.Context, organizationID int64) error
+	// Stops the event router retrying a failed site reconciliation; 0 rows means
+	// no retry was pending
+	CancelReconciliationRetry(ctx context.Context, runID string) (int64, error)
+	// Cancels a run that hasn't finished; 0 rows means it already finished
+	CancelReconciliationRun(ctx context.Context, arg CancelReconciliationRunParams) (int64, error)
+	CleanupExpiredPasswordResetTokens(ctx context.Context) error
 	CleanupExpiredVerificationTokens(ctx context.Context) error
+	ClearEffectiveAccessStale(ctx context.Context, accountID int64) error
+	ClearOrganizationPaymentFailed(ctx context.Context, id int64) error
 	ClearStaleLocks(ctx context.Context) (sql.Result, error)
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CompleteOrganizationExport(ctx context.Context, arg CompleteOrganizationExportParams) error
+	CompleteSiteCachePurge(ctx context.Context, arg CompleteSiteCachePurgeParams) error
+	// The organizations an account reaches through memberships and approved
+	// relationship chains, and those it can read through a project or site
+	ComputeEffectiveOrganizationAccess(ctx context.Context, accountID int64) ([]ComputeEffectiveOrganizationAccessRow, error)
+	CountAccountAPIKeys(ctx context.Context, accountID int64) (int64, error)
+	// Pending and running exports, so an organization can't queue several at once
+	CountActiveOrganizationExports(ctx context.Context, organizationID int64) (int64, error)
+	CountEventSinks(ctx context.Context, organizationID int64) (int64, error)
+	CountEventsByStatus(ctx context.Context) ([]CountEventsByStatusRow, error)
+	CountNotificationChannels(ctx context.Context, organizationID int64) (int64, error)
+	// Pending requests and unexpired roles an account has on a site
+	CountOpenSiteElevations(ctx context.Context, arg CountOpenSiteElevationsParams) (int64, error)
+	CountOrganizationFirewallRules(ctx context.Context, organizationID sql.NullInt64) (int64, error)
 	CountOrganizationProjects(ctx context.Context, organizationID int64) (int64, error)
 	CountOrganizationSecrets(ctx context.Context, organizationID int64) (int64, error)
+	CountProjectFirewallRules(ctx context.Context, projectID sql.NullInt64) (int64, error)
 	CountProjectSecrets(ctx context.Context, projectID int64) (int64, error)
+	CountProjectSites(ctx context.Context, projectID int64) (int64, error)
+	CountSecretAccessByPrincipalSince(ctx context.Context, arg CountSecretAccessByPrincipalSinceParams) (int64, error)
+	CountSecretAccessSince(ctx context.Context, arg CountSecretAccessSinceParams) (int64, error)
+	CountSiteConfigVars(ctx context.Context, siteID int64) (int64, error)
+	CountSiteDatabases(ctx context.Context, siteID int64) (int64, error)
+	CountSiteFirewallRules(ctx context.Context, siteID sql.NullInt64) (int64, error)
+	CountSiteRateLimitRules(ctx context.Context, siteID int64) (int64, error)
+	CountSiteRedirects(ctx context.Context, siteID int64) (int64, error)
 	CountSiteSecrets(ctx context.Context, siteID int64) (int64, error)
+	CountSiteWafRuleExclusions(ctx context.Context, siteID int64) (int64, error)
+	CountStuckEvents(ctx context.Context, processingAt sql.NullTime) (int64, error)
+	CountUnreadAccountNotifications(ctx context.Context, accountID int64) (int64, error)
 	CountUserOrganizations(ctx context.Context, accountID int64) (int64, error)
 	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) error
 	CreateAccount(ctx context.Context, arg CreateAccountParams) error
 	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error
+	CreateControllerRelease(ctx context.Context, arg CreateControllerReleaseParams) error
 	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error
+	CreateDeviceAuthorization(ctx context.Context, arg CreateDeviceAuthorizationParams) error
 	CreateDomain(ctx context.Context, arg CreateDomainParams) error
+	CreateEmailSend(ctx context.Context, arg CreateEmailSendParams) error
 	CreateEmailVerificationToken(ctx context.Context, arg CreateEmailVerificationTokenParams) error
+	// Short-lived key for a browser terminal session; it stops being served to VMs at expires_at
+	CreateEphemeralSshKey(ctx context.Context, arg CreateEphemeralSshKeyParams) error
+	CreateEventSink(ctx context.Context, arg CreateEventSinkParams) error
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) error
 	CreateMachineType(ctx context.Context, arg CreateMachineTypeParams) error
+	CreateMemberInvitation(ctx context.Context, arg CreateMemberInvitationParams) error
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) error
+	CreateNotificationChannel(ctx context.Context, arg CreateNotificationChannelParams) error
 	CreateOnboardingSession(ctx context.Context, arg CreateOnboardingSessionParams) (sql.Result, error)
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error
+	CreateOrganizationDataKey(ctx context.Context, arg CreateOrganizationDataKeyParams) error
+	CreateOrganizationExport(ctx context.Context, arg CreateOrganizationExportParams) error
 	CreateOrganizationFirewallRule(ctx context.Context, arg CreateOrganizationFirewallRuleParams) error
+	CreateOrganizationJoinDomain(ctx context.Context, arg CreateOrganizationJoinDomainParams) error
+	CreateOrganizationJoinRequest(ctx context.Context, arg CreateOrganizationJoinRequestParams) error
 	CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) error
+	CreateOrganizationOwnershipTransfer(ctx context.Context, arg CreateOrganizationOwnershipTransferParams) error
+	CreateOrganizationPolicy(ctx context.Context, arg CreateOrganizationPolicyParams) error
 	// =============================================================================
 	// EVENT QUEUE
 	// =============================================================================
@@ -38,6 +106,8 @@ type Querier interface {
 	// ORGANIZATION SETTINGS
 	// ============================================================================
 	CreateOrganizationSetting(ctx context.Context, arg CreateOrganizationSettingParams) error
+	CreatePolicyViolation(ctx context.Context, arg CreatePolicyViolationParams) error
+	CreatePrivateServiceConnectEndpoint(ctx context.Context, arg CreatePrivateServiceConnectEndpointParams) error
 	CreateProject(ctx context.Context, arg CreateProjectParams) error
 	CreateProjectFirewallRule(ctx context.Context, arg CreateProjectFirewallRuleParams) error
 	CreateProjectMember(ctx context.Context, arg CreateProjectMemberParams) error
@@ -54,9 +124,22 @@ type Querier interface {
 	// Reconciliation run queries (supports both terraform and VM reconciliation)
 	CreateReconciliationRun(ctx context.Context, arg CreateReconciliationRunParams) (sql.Result, error)
 	CreateRelationship(ctx context.Context, arg CreateRelationshipParams) (sql.Result, error)
+	CreateSecretAccessLog(ctx context.Context, arg CreateSecretAccessLogParams) error
 	CreateSite(ctx context.Context, arg CreateSiteParams) error
+	CreateSiteAddon(ctx context.Context, arg CreateSiteAddonParams) error
+	CreateSiteCachePurge(ctx context.Context, arg CreateSiteCachePurgeParams) error
+	CreateSiteCdnConfig(ctx context.Context, arg CreateSiteCdnConfigParams) error
+	CreateSiteClientCertificate(ctx context.Context, arg CreateSiteClientCertificateParams) error
+	// The revision number is unique per site, so of two concurrent changes
+	// numbered alike, the second fails instead of overwriting the first's history
+	CreateSiteConfigVarRevision(ctx context.Context, arg CreateSiteConfigVarRevisionParams) error
+	CreateSiteDatabase(ctx context.Context, arg CreateSiteDatabaseParams) error
+	CreateSiteElevation(ctx context.Context, arg CreateSiteElevationParams) error
 	CreateSiteFirewallRule(ctx context.Context, arg CreateSiteFirewallRuleParams) error
 	CreateSiteMember(ctx context.Context, arg CreateSiteMemberParams) error
+	CreateSiteProbe(ctx context.Context, arg CreateSiteProbeParams) error
+	CreateSiteRateLimitRule(ctx context.Context, arg CreateSiteRateLimitRuleParams) error
+	CreateSiteRedirect(ctx context.Context, arg CreateSiteRedirectParams) error
 	// =============================================================================
 	// RELATIONSHIPS
 	// =============================================================================
@@ -65,21 +148,52 @@ type Querier interface {
 	// SITE SETTINGS
 	// ============================================================================
 	CreateSiteSetting(ctx context.Context, arg CreateSiteSettingParams) error
+	CreateSiteStaticEgressIp(ctx context.Context, arg CreateSiteStaticEgressIpParams) error
+	CreateSiteWafBlock(ctx context.Context, arg CreateSiteWafBlockParams) error
+	CreateSiteWafRuleExclusion(ctx context.Context, arg CreateSiteWafRuleExclusionParams) error
 	CreateSshAccess(ctx context.Context, arg CreateSshAccessParams) error
 	CreateSshKey(ctx context.Context, arg CreateSshKeyParams) (sql.Result, error)
+	CreateStatusPage(ctx context.Context, arg CreateStatusPageParams) error
+	CreateStatusPageUpdate(ctx context.Context, arg CreateStatusPageUpdateParams) error
 	CreateStripeSubscription(ctx context.Context, arg CreateStripeSubscriptionParams) (sql.Result, error)
+	CreateTerraformStateBackup(ctx context.Context, arg CreateTerraformStateBackupParams) error
+	CreateUsageReport(ctx context.Context, arg CreateUsageReportParams) error
+	// Approve or deny a pending request. Only the first decision counts.
+	DecideDeviceAuthorization(ctx context.Context, arg DecideDeviceAuthorizationParams) (int64, error)
+	DecideOrganizationJoinRequest(ctx context.Context, arg DecideOrganizationJoinRequestParams) (int64, error)
 	DeleteAPIKey(ctx context.Context, publicID string) error
 	DeleteAccount(ctx context.Context, publicID string) error
 	DeleteDeployment(ctx context.Context, id string) error
 	DeleteDomain(ctx context.Context, id int64) error
+	DeleteEffectiveOrganizationAccess(ctx context.Context, accountID int64) error
 	DeleteEmailVerificationToken(ctx context.Context, email string) error
+	DeleteEventSink(ctx context.Context, arg DeleteEventSinkParams) (int64, error)
+	DeleteExpiredDeviceAuthorizations(ctx context.Context) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (sql.Result, error)
 	DeleteExpiredOnboardingSessions(ctx context.Context) error
+	// Deletes a membership only if it's still expired, so one extended since it was listed stays
+	DeleteExpiredOrganizationMember(ctx context.Context, arg DeleteExpiredOrganizationMemberParams) (int64, error)
+	// Deletes a membership only if it's still expired, so one extended since it was listed stays
+	DeleteExpiredProjectMember(ctx context.Context, arg DeleteExpiredProjectMemberParams) (int64, error)
+	// Deletes a membership only if it's still expired, so one extended since it was listed stays
+	DeleteExpiredSiteMember(ctx context.Context, arg DeleteExpiredSiteMemberParams) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, id int64) error
+	DeleteNotificationChannel(ctx context.Context, arg DeleteNotificationChannelParams) (int64, error)
 	DeleteOrganization(ctx context.Context, publicID string) error
+	DeleteOrganizationBranding(ctx context.Context, organizationID int64) error
+	DeleteOrganizationEncryptionKey(ctx context.Context, arg DeleteOrganizationEncryptionKeyParams) (int64, error)
 	DeleteOrganizationFirewallRule(ctx context.Context, id int64) error
 	DeleteOrganizationFirewallRuleByPublicID(ctx context.Context, uuidTOBIN string) error
+	DeleteOrganizationJoinDomains(ctx context.Context, organizationID int64) error
 	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	DeleteOrganizationPolicy(ctx context.Context, publicID string) error
+	DeleteOrganizationQuota(ctx context.Context, arg DeleteOrganizationQuotaParams) error
 	DeleteOrganizationSecret(ctx context.Context, arg DeleteOrganizationSecretParams) error
 	DeleteOrganizationSetting(ctx context.Context, arg DeleteOrganizationSettingParams) error
+	// Purges can't run once the CDN is gone, and there's nothing left to invalidate
+	DeletePasswordResetToken(ctx context.Context, accountID int64) error
+	DeletePendingSiteCachePurges(ctx context.Context, siteID int64) error
+	DeletePrivateServiceConnectEndpoint(ctx context.Context, id int64) error
 	DeleteProject(ctx context.Context, publicID string) error
 	DeleteProjectFirewallRule(ctx context.Context, id int64) error
 	DeleteProjectFirewallRuleByPublicID(ctx context.Context, uuidTOBIN string) error
@@ -87,34 +201,83 @@ type Querier interface {
 	DeleteProjectSecret(ctx context.Context, arg DeleteProjectSecretParams) error
 	DeleteProjectSetting(ctx context.Context, arg DeleteProjectSettingParams) error
 	DeleteSite(ctx context.Context, publicID string) error
+	// Puts the site back on its default protection
+	DeleteSiteAccessProtection(ctx context.Context, siteID int64) error
+	DeleteSiteAddon(ctx context.Context, id int64) error
+	DeleteSiteCdnConfig(ctx context.Context, id int64) error
+	DeleteSiteConfigVar(ctx context.Context, arg DeleteSiteConfigVarParams) error
+	DeleteSiteControllerConfig(ctx context.Context, siteID sql.NullInt64) (int64, error)
 	DeleteSiteFirewallRule(ctx context.Context, id int64) error
 	DeleteSiteFirewallRuleByPublicID(ctx context.Context, uuidTOBIN string) error
+	DeleteSiteMaintenanceWindow(ctx context.Context, siteID int64) (int64, error)
 	DeleteSiteMember(ctx context.Context, arg DeleteSiteMemberParams) error
+	DeleteSiteProbesBefore(ctx context.Context, probedAt sql.NullTime) (int64, error)
+	DeleteSiteRateLimitRule(ctx context.Context, id int64) error
+	DeleteSiteRedirect(ctx context.Context, id int64) error
 	DeleteSiteSecret(ctx context.Context, arg DeleteSiteSecretParams) error
 	DeleteSiteSetting(ctx context.Context, arg DeleteSiteSettingParams) error
+	DeleteSiteStaticEgressIp(ctx context.Context, id int64) error
+	DeleteSiteWafBlocksBefore(ctx context.Context, arg DeleteSiteWafBlocksBeforeParams) error
+	DeleteSiteWafRuleExclusion(ctx context.Context, id int64) error
 	DeleteSshAccess(ctx context.Context, arg DeleteSshAccessParams) error
 	DeleteSshKey(ctx context.Context, publicID string) error
+	DeleteStatusPage(ctx context.Context, id int64) error
+	DeleteStatusPageSites(ctx context.Context, statusPageID int64) error
+	DeleteStatusPageUpdate(ctx context.Context, arg DeleteStatusPageUpdateParams) (int64, error)
 	DeleteStripeSubscription(ctx context.Context, stripeSubscriptionID string) error
 	// EVENT QUEUE
+	// Writes to the outbox in the caller's transaction; the event-router relays it into event_queue
 	EnqueueEvent(ctx context.Context, arg EnqueueEventParams) error
+	// Revokes a relationship only if it's still expired, so one extended since it was listed stays
+	ExpireRelationship(ctx context.Context, arg ExpireRelationshipParams) (int64, error)
+	// Expires a role only if it's still approved and past its expiry
+	ExpireSiteElevation(ctx context.Context, arg ExpireSiteElevationParams) (int64, error)
+	FailOrganizationExport(ctx context.Context, arg FailOrganizationExportParams) error
+	FailSiteDatabase(ctx context.Context, arg FailSiteDatabaseParams) error
+	// Records how a migration run ended; runs that aren't migrations match no rows
+	FinishTerraformStateMigration(ctx context.Context, arg FinishTerraformStateMigrationParams) (int64, error)
 	GetAPIKeyByID(ctx context.Context, id int64) (GetAPIKeyByIDRow, error)
 	GetAPIKeyByUUID(ctx context.Context, publicID string) (GetAPIKeyByUUIDRow, error)
 	GetAccount(ctx context.Context, publicID string) (GetAccountRow, error)
+	GetAccountAvatar(ctx context.Context, publicID string) (sql.NullString, error)
 	GetAccountByEmail(ctx context.Context, email string) (GetAccountByEmailRow, error)
 	GetAccountByID(ctx context.Context, id int64) (GetAccountByIDRow, error)
 	GetAccountByVaultEntityID(ctx context.Context, vaultEntityID sql.NullString) (GetAccountByVaultEntityIDRow, error)
+	GetAccountPreferences(ctx context.Context, accountID int64) (AccountPreference, error)
+	GetAccountProfile(ctx context.Context, id int64) (GetAccountProfileRow, error)
 	GetActiveAPIKeyByUUID(ctx context.Context, publicID string) (GetActiveAPIKeyByUUIDRow, error)
+	// The newest data key is the one new secrets are sealed with
+	GetActiveOrganizationDataKey(ctx context.Context, organizationID int64) (GetActiveOrganizationDataKeyRow, error)
+	// When an account's unexpired auditor grant ends
+	GetActivePlatformAuditor(ctx context.Context, accountID int64) (time.Time, error)
+	// The highest unexpired role an account was elevated to on a site
+	GetActiveSiteElevationRole(ctx context.Context, arg GetActiveSiteElevationRoleParams) (SiteElevationsRole, error)
+	GetControllerRelease(ctx context.Context, version string) (GetControllerReleaseRow, error)
 	GetDeployment(ctx context.Context, id string) (Deployment, error)
+	GetDeviceAuthorizationByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (GetDeviceAuthorizationByDeviceCodeHashRow, error)
+	GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (GetDeviceAuthorizationByUserCodeRow, error)
 	// =============================================================================
 	// SITE MEMBERS
 	// =============================================================================
 	GetDomain(ctx context.Context, id int64) (Domain, error)
 	GetDomainByName(ctx context.Context, domain string) (Domain, error)
+	// How an account reaches an organization, if at all
+	GetEffectiveOrganizationAccess(ctx context.Context, arg GetEffectiveOrganizationAccessParams) (GetEffectiveOrganizationAccessRow, error)
 	GetEmailVerificationToken(ctx context.Context, arg GetEmailVerificationTokenParams) (EmailVerificationToken, error)
 	GetEmailVerificationTokenByEmail(ctx context.Context, email string) (EmailVerificationToken, error)
+	GetEventSink(ctx context.Context, arg GetEventSinkParams) (GetEventSinkRow, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLatestAccountNotificationID(ctx context.Context, accountID int64) (int64, error)
+	GetLatestSiteConfigVarRevision(ctx context.Context, siteID int64) (int64, error)
 	GetLatestSiteDeployment(ctx context.Context, siteID string) (Deployment, error)
+	GetLatestSiteProbe(ctx context.Context, siteID int64) (GetLatestSiteProbeRow, error)
 	GetMachineType(ctx context.Context, machineType string) (MachineType, error)
 	GetMachineTypeByStripePriceID(ctx context.Context, stripePriceID string) (MachineType, error)
+	GetMemberInvitationByTokenHash(ctx context.Context, tokenHash string) (GetMemberInvitationByTokenHashRow, error)
+	GetNotificationChannel(ctx context.Context, arg GetNotificationChannelParams) (GetNotificationChannelRow, error)
+	// The oldest event the event-router hasn't relayed yet
+	GetOldestOutboxEvent(ctx context.Context) (GetOldestOutboxEventRow, error)
+	GetOldestPendingEvent(ctx context.Context) (GetOldestPendingEventRow, error)
 	GetOnboardingSession(ctx context.Context, publicID string) (GetOnboardingSessionRow, error)
 	GetOnboardingSessionByAccountID(ctx context.Context, accountID int64) (GetOnboardingSessionByAccountIDRow, error)
 	// =============================================================================
@@ -122,9 +285,18 @@ type Querier interface {
 	// =============================================================================
 	GetOnboardingSessionByStripeCheckoutID(ctx context.Context, stripeCheckoutSessionID sql.NullString) (GetOnboardingSessionByStripeCheckoutIDRow, error)
 	GetOrganization(ctx context.Context, publicID string) (GetOrganizationRow, error)
+	// One of the keys ListOrganizationAPIKeys lists.
+	GetOrganizationAPIKey(ctx context.Context, arg GetOrganizationAPIKeyParams) (GetOrganizationAPIKeyRow, error)
+	GetOrganizationAllowedCidrs(ctx context.Context, id int64) (json.RawMessage, error)
+	GetOrganizationBillingState(ctx context.Context, id int64) (GetOrganizationBillingStateRow, error)
+	GetOrganizationBranding(ctx context.Context, organizationID int64) (GetOrganizationBrandingRow, error)
 	GetOrganizationByGCPProjectID(ctx context.Context, gcpProjectID sql.NullString) (GetOrganizationByGCPProjectIDRow, error)
 	GetOrganizationByID(ctx context.Context, id int64) (GetOrganizationByIDRow, error)
+	GetOrganizationDataResidency(ctx context.Context, id int64) (NullOrganizationsDataResidency, error)
+	GetOrganizationExport(ctx context.Context, publicID string) (GetOrganizationExportRow, error)
 	GetOrganizationFirewallRuleByPublicID(ctx context.Context, uuidTOBIN string) (GetOrganizationFirewallRuleByPublicIDRow, error)
+	GetOrganizationJoinRequest(ctx context.Context, arg GetOrganizationJoinRequestParams) (GetOrganizationJoinRequestRow, error)
+	GetOrganizationLogo(ctx context.Context, organizationID int64) (GetOrganizationLogoRow, error)
 	// =============================================================================
 	// ACCOUNTS
 	// =============================================================================
@@ -133,18 +305,38 @@ type Querier interface {
 	// PROJECT SECRETS
 	// =============================================================================
 	GetOrganizationMemberByAccountAndOrganization(ctx context.Context, arg GetOrganizationMemberByAccountAndOrganizationParams) (OrganizationMember, error)
+	GetOrganizationOwnershipTransfer(ctx context.Context, publicID string) (GetOrganizationOwnershipTransferRow, error)
+	GetOrganizationPaymentFailure(ctx context.Context, id int64) (GetOrganizationPaymentFailureRow, error)
+	GetOrganizationPolicy(ctx context.Context, publicID string) (GetOrganizationPolicyRow, error)
 	GetOrganizationProjectByOrganizationID(ctx context.Context, organizationID int64) (GetOrganizationProjectByOrganizationIDRow, error)
+	GetOrganizationQuota(ctx context.Context, arg GetOrganizationQuotaParams) (OrganizationQuota, error)
 	GetOrganizationSecretByID(ctx context.Context, id int64) (GetOrganizationSecretByIDRow, error)
 	GetOrganizationSecretByName(ctx context.Context, arg GetOrganizationSecretByNameParams) (GetOrganizationSecretByNameRow, error)
 	GetOrganizationSecretByPublicID(ctx context.Context, publicID string) (GetOrganizationSecretByPublicIDRow, error)
 	GetOrganizationSetting(ctx context.Context, arg GetOrganizationSettingParams) (GetOrganizationSettingRow, error)
 	GetOrganizationSettingByPublicID(ctx context.Context, publicID string) (GetOrganizationSettingByPublicIDRow, error)
+	// A site by public ID, only when it belongs to one of the organization's projects
+	GetOrganizationSite(ctx context.Context, arg GetOrganizationSiteParams) (GetOrganizationSiteRow, error)
+	GetOrganizationSiteIncident(ctx context.Context, arg GetOrganizationSiteIncidentParams) (GetOrganizationSiteIncidentRow, error)
+	// Where the organization's tfstate bucket is: its data residency's
+	// multi-region when it has one, otherwise its location
+	GetOrganizationStateLocation(ctx context.Context, id int64) (string, error)
+	// The member to hand an organization to when an owner leaves: another person
+	// (not a platform service account), preferring owners, then the longest-standing developer
+	GetOrganizationSuccessor(ctx context.Context, arg GetOrganizationSuccessorParams) (GetOrganizationSuccessorRow, error)
 	GetOrganizationsByAccountID(ctx context.Context, arg GetOrganizationsByAccountIDParams) ([]int64, error)
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (GetPasswordResetTokenRow, error)
 	GetPendingEvents(ctx context.Context, limit int32) ([]GetPendingEventsRow, error)
+	// The organization's open transfer; expired transfers are left pending and skipped here
+	GetPendingOrganizationOwnershipTransfer(ctx context.Context, organizationID int64) (GetPendingOrganizationOwnershipTransferRow, error)
 	GetPendingReconciliationRunByOrg(ctx context.Context, organizationID sql.NullInt64) (Reconciliation, error)
 	GetPendingReconciliationRunByProject(ctx context.Context, projectID sql.NullInt64) (Reconciliation, error)
 	GetPendingReconciliationRunByResource(ctx context.Context, arg GetPendingReconciliationRunByResourceParams) (Reconciliation, error)
 	GetPendingReconciliationRunBySite(ctx context.Context, siteID sql.NullInt64) (Reconciliation, error)
+	GetPlatformOrganization(ctx context.Context, id int64) (GetPlatformOrganizationRow, error)
+	GetPlatformReconciliationRun(ctx context.Context, runID string) (GetPlatformReconciliationRunRow, error)
+	GetPrivateServiceConnectEndpoint(ctx context.Context, publicID string) (GetPrivateServiceConnectEndpointRow, error)
+	GetPrivateServiceConnectEndpointByTarget(ctx context.Context, arg GetPrivateServiceConnectEndpointByTargetParams) (GetPrivateServiceConnectEndpointByTargetRow, error)
 	GetProject(ctx context.Context, publicID string) (GetProjectRow, error)
 	GetProjectByGCPProjectID(ctx context.Context, gcpProjectID sql.NullString) (GetProjectByGCPProjectIDRow, error)
 	GetProjectByID(ctx context.Context, id int64) (GetProjectByIDRow, error)
@@ -167,18 +359,33 @@ type Querier interface {
 	GetReconciliationResults(ctx context.Context, runID string) ([]ReconciliationResult, error)
 	GetReconciliationResultsBySite(ctx context.Context, arg GetReconciliationResultsBySiteParams) ([]ReconciliationResult, error)
 	GetReconciliationRunByID(ctx context.Context, runID string) (Reconciliation, error)
+	GetRegion(ctx context.Context, code string) (Region, error)
+	// Where a region is, active or not, for finding the API region nearest a site.
+	GetRegionLocation(ctx context.Context, code string) (GetRegionLocationRow, error)
 	GetRelationship(ctx context.Context, publicID string) (GetRelationshipRow, error)
+	GetRelationshipBetween(ctx context.Context, arg GetRelationshipBetweenParams) (GetRelationshipBetweenRow, error)
 	GetRunningReconciliations(ctx context.Context) ([]GetRunningReconciliationsRow, error)
 	// =============================================================================
 	// PROJECT MEMBERS
 	// =============================================================================
 	GetSite(ctx context.Context, publicID string) (GetSiteRow, error)
+	GetSiteAccessGateSecret(ctx context.Context, id int64) (sql.NullString, error)
+	GetSiteAccessProtection(ctx context.Context, siteID int64) (GetSiteAccessProtectionRow, error)
+	GetSiteAddon(ctx context.Context, publicID string) (GetSiteAddonRow, error)
 	GetSiteByID(ctx context.Context, id int64) (GetSiteByIDRow, error)
 	// =============================================================================
 	// SITES
 	// =============================================================================
 	GetSiteByProjectAndName(ctx context.Context, arg GetSiteByProjectAndNameParams) (GetSiteByProjectAndNameRow, error)
 	GetSiteByShortUUID(ctx context.Context, shortUuid string) (GetSiteByShortUUIDRow, error)
+	GetSiteCachePurge(ctx context.Context, publicID string) (GetSiteCachePurgeRow, error)
+	GetSiteCdnConfig(ctx context.Context, siteID int64) (GetSiteCdnConfigRow, error)
+	// What a site's controller last reported, for its status
+	GetSiteCheckIn(ctx context.Context, id int64) (GetSiteCheckInRow, error)
+	GetSiteClientCertificate(ctx context.Context, serialNumber string) (SiteClientCertificate, error)
+	GetSiteConfigVar(ctx context.Context, arg GetSiteConfigVarParams) (SiteConfigVar, error)
+	GetSiteDatabase(ctx context.Context, publicID string) (GetSiteDatabaseRow, error)
+	GetSiteElevation(ctx context.Context, publicID string) (GetSiteElevationRow, error)
 	// Fetches all firewall rules that should be applied to a site VM
 	// Includes rules from site, project, and org levels
 	GetSiteFirewallForVM(ctx context.Context, arg GetSiteFirewallForVMParams) ([]GetSiteFirewallForVMRow, error)
@@ -186,16 +393,25 @@ type Querier interface {
 	// ORGANIZATION FIREWALL RULES
 	// =============================================================================
 	GetSiteFirewallRuleByPublicID(ctx context.Context, uuidTOBIN string) (GetSiteFirewallRuleByPublicIDRow, error)
+	GetSiteHealth(ctx context.Context, siteID int64) (SiteHealth, error)
+	GetSiteHealthCheckPath(ctx context.Context, id int64) (string, error)
 	GetSiteIDsByOrganization(ctx context.Context, organizationID int64) ([]int64, error)
 	GetSiteIDsByProject(ctx context.Context, projectID int64) ([]int64, error)
 	GetSiteIDsBySite(ctx context.Context, id int64) ([]int64, error)
+	GetSiteMaintenanceWindow(ctx context.Context, siteID int64) (GetSiteMaintenanceWindowRow, error)
 	GetSiteMember(ctx context.Context, arg GetSiteMemberParams) (GetSiteMemberRow, error)
 	GetSiteMemberByAccountAndSite(ctx context.Context, arg GetSiteMemberByAccountAndSiteParams) (SiteMember, error)
+	GetSiteRateLimitRule(ctx context.Context, publicID string) (GetSiteRateLimitRuleRow, error)
+	GetSiteRedirect(ctx context.Context, publicID string) (GetSiteRedirectRow, error)
+	GetSiteRedirectBySource(ctx context.Context, arg GetSiteRedirectBySourceParams) (GetSiteRedirectBySourceRow, error)
+	// The API region a site is pinned to and where its project runs.
+	GetSiteRegion(ctx context.Context, id int64) (GetSiteRegionRow, error)
 	// =============================================================================
 	// MEMBERSHIP QUERIES FOR AUTHORIZATION
 	// =============================================================================
 	// Fetches all SSH keys that should be provisioned to a site VM
-	// Includes keys from site members, project members, org members, and relationship members
+	// Includes keys from site members, project members, org members, relationship members
+	// and members elevated on the site
 	GetSiteSSHKeysForVM(ctx context.Context, arg GetSiteSSHKeysForVMParams) ([]GetSiteSSHKeysForVMRow, error)
 	GetSiteSecretByID(ctx context.Context, id int64) (GetSiteSecretByIDRow, error)
 	GetSiteSecretByName(ctx context.Context, arg GetSiteSecretByNameParams) (GetSiteSecretByNameRow, error)
@@ -205,16 +421,34 @@ type Querier interface {
 	GetSiteSecretsForVM(ctx context.Context, arg GetSiteSecretsForVMParams) ([]GetSiteSecretsForVMRow, error)
 	GetSiteSetting(ctx context.Context, arg GetSiteSettingParams) (GetSiteSettingRow, error)
 	GetSiteSettingByPublicID(ctx context.Context, publicID string) (GetSiteSettingByPublicIDRow, error)
-	GetSshAccess(ctx context.Context, arg GetSshAccessParams) (SshAccess, error)
+	// Fetches the SSH keys of accounts granted SSH access to a site, which lapses
+	// with their site membership
+	GetSiteSshAccessKeysForVM(ctx context.Context, siteID int64) ([]GetSiteSshAccessKeysForVMRow, error)
+	GetSiteStaticEgressIp(ctx context.Context, siteID int64) (GetSiteStaticEgressIpRow, error)
+	GetSiteTlsPolicy(ctx context.Context, siteID int64) (GetSiteTlsPolicyRow, error)
+	GetSiteWafConfig(ctx context.Context, siteID int64) (GetSiteWafConfigRow, error)
+	GetSiteWafRuleExclusion(ctx context.Context, publicID string) (GetSiteWafRuleExclusionRow, error)
+	GetSiteWafRuleExclusionByRule(ctx context.Context, arg GetSiteWafRuleExclusionByRuleParams) (GetSiteWafRuleExclusionByRuleRow, error)
+	GetSshAccess(ctx context.Context, arg GetSshAccessParams) (GetSshAccessRow, error)
 	GetSshKey(ctx context.Context, publicID string) (GetSshKeyRow, error)
 	GetStaleReconciliationRuns(ctx context.Context) ([]Reconciliation, error)
+	GetStatusPageByOrganization(ctx context.Context, organizationID int64) (GetStatusPageByOrganizationRow, error)
+	GetStatusPageBySlug(ctx context.Context, slug string) (GetStatusPageBySlugRow, error)
 	GetStorageConfig(ctx context.Context) (StorageConfig, error)
 	GetStripeSubscription(ctx context.Context, publicID string) (GetStripeSubscriptionRow, error)
 	// =============================================================================
+	// VM RECONCILIATION ADMIN API
+	// =============================================================================
+	GetStripeSubscriptionByCustomerID(ctx context.Context, stripeCustomerID string) (GetStripeSubscriptionByCustomerIDRow, error)
+	// =============================================================================
 	// ONBOARDING
 	// =============================================================================
 	GetStripeSubscriptionByOrganizationID(ctx context.Context, organizationID int64) (GetStripeSubscriptionByOrganizationIDRow, error)
 	GetStripeSubscriptionByStripeID(ctx context.Context, stripeSubscriptionID string) (GetStripeSubscriptionByStripeIDRow, error)
+	GetTerraformStateBackup(ctx context.Context, arg GetTerraformStateBackupParams) (GetTerraformStateBackupRow, error)
+	GetTerraformStateLayout(ctx context.Context, organizationID int64) (TerraformStateLayout, error)
+	// Grants an account auditor access, replacing any grant it had
+	GrantPlatformAuditor(ctx context.Context, arg GrantPlatformAuditorParams) error
 	HasUserProjectAccessInOrganization(ctx context.Context, arg HasUserProjectAccessInOrganizationParams) (bool, error)
 	HasUserRelationshipAccessToOrganization(ctx context.Context, arg HasUserRelationshipAccessToOrganizationParams) (bool, error)
 	HasUserSiteAccessInOrganization(ctx context.Context, arg HasUserSiteAccessInOrganizationParams) (bool, error)
@@ -223,11 +457,28 @@ type Querier interface {
 	// =============================================================================
 	HasUserSiteAccessInProject(ctx context.Context, arg HasUserSiteAccessInProjectParams) (bool, error)
 	IncrementFailedLoginAttempts(ctx context.Context, id int64) error
+	// Only the first caller's secret sticks; everyone re-reads it afterwards
+	InitSiteAccessGateSecret(ctx context.Context, arg InitSiteAccessGateSecretParams) error
+	// Creates a site's policy with the defaults so a probe can be recorded
+	InitSiteTlsPolicy(ctx context.Context, siteID int64) error
+	InsertEffectiveOrganizationAccess(ctx context.Context, arg InsertEffectiveOrganizationAccessParams) error
+	// Whether an account's effective access needs refreshing before it's read
+	IsEffectiveAccessStale(ctx context.Context, accountID int64) (bool, error)
+	// Per-project most recent value of a gauge metric.
+	LatestOrganizationProjectUsage(ctx context.Context, arg LatestOrganizationProjectUsageParams) ([]LatestOrganizationProjectUsageRow, error)
 	// =============================================================================
 	// API KEYS
 	// =============================================================================
 	ListAPIKeysByAccount(ctx context.Context, arg ListAPIKeysByAccountParams) ([]ListAPIKeysByAccountRow, error)
+	ListAccountNotifications(ctx context.Context, arg ListAccountNotificationsParams) ([]ListAccountNotificationsRow, error)
+	// Notifications created since the given ID, oldest first, for live streams
+	ListAccountNotificationsAfter(ctx context.Context, arg ListAccountNotificationsAfterParams) ([]ListAccountNotificationsAfterRow, error)
+	// Every policy of the organizations an account is a member of, directly or
+	// through one of their projects or sites, for checking changes to the account
+	ListAccountOrganizationPolicies(ctx context.Context, accountID int64) ([]ListAccountOrganizationPoliciesRow, error)
 	ListAccountOrganizations(ctx context.Context, arg ListAccountOrganizationsParams) ([]ListAccountOrganizationsRow, error)
+	// Organizations the account is an active owner of
+	ListAccountOwnedOrganizations(ctx context.Context, accountID int64) ([]ListAccountOwnedOrganizationsRow, error)
 	ListAccountProjects(ctx context.Context, arg ListAccountProjectsParams) ([]ListAccountProjectsRow, error)
 	// =============================================================================
 	// MACHINE TYPES
@@ -239,40 +490,179 @@ type Querier interface {
 	ListAccountSshAccess(ctx context.Context, arg ListAccountSshAccessParams) ([]SshAccess, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]ListAccountsRow, error)
 	ListAllMachineTypes(ctx context.Context) ([]MachineType, error)
+	// Every policy of an organization, for checking a change against them
+	ListAllOrganizationPolicies(ctx context.Context, organizationID int64) ([]ListAllOrganizationPoliciesRow, error)
 	ListAllOrganizations(ctx context.Context) ([]ListAllOrganizationsRow, error)
+	ListAllSiteConfigVars(ctx context.Context, siteID int64) ([]ListAllSiteConfigVarsRow, error)
+	// Every redirect of a site, most specific first: host rules before host-less
+	// ones, and longer paths before the prefixes they fall under
+	ListAllSiteRedirects(ctx context.Context, siteID int64) ([]ListAllSiteRedirectsRow, error)
 	// Get all approved relationships for a source org where the account has access to the target org
 	ListApprovedRelatedOrganizationsForAccount(ctx context.Context, arg ListApprovedRelatedOrganizationsForAccountParams) ([]ListApprovedRelatedOrganizationsForAccountRow, error)
+	// Audit events, newest first, for the dashboard's activity pages. Each scope includes the
+	// resources beneath it: an organization's projects and sites, a project's sites. Scopes and
+	// filters left NULL match everything; event_pattern is a LIKE pattern. entity_name and
+	// entity_public_id are empty once the entity is deleted.
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]ListAuditEventsRow, error)
+	// The fleet-wide document followed by the site's own, if either exists
+	ListControllerConfigs(ctx context.Context, siteID sql.NullInt64) ([]ListControllerConfigsRow, error)
+	// Newest first, with how many sites reported running each on check-in
+	ListControllerReleases(ctx context.Context, limit int32) ([]ListControllerReleasesRow, error)
+	ListDeadLetterEvents(ctx context.Context, limit int32) ([]ListDeadLetterEventsRow, error)
+	// Enabled PagerDuty and Opsgenie channels of an organization subscribed to downtime alerts
+	ListEscalationChannels(ctx context.Context, organizationID int64) ([]ListEscalationChannelsRow, error)
+	ListEventSinks(ctx context.Context, arg ListEventSinksParams) ([]ListEventSinksRow, error)
+	// Memberships past their expiry, oldest first
+	ListExpiredOrganizationMembers(ctx context.Context, arg ListExpiredOrganizationMembersParams) ([]ListExpiredOrganizationMembersRow, error)
+	// Memberships past their expiry, oldest first
+	ListExpiredProjectMembers(ctx context.Context, arg ListExpiredProjectMembersParams) ([]ListExpiredProjectMembersRow, error)
+	// Pending or approved relationships past their expiry, oldest first
+	ListExpiredRelationships(ctx context.Context, arg ListExpiredRelationshipsParams) ([]ListExpiredRelationshipsRow, error)
+	// Approved roles past their expiry, oldest first
+	ListExpiredSiteElevations(ctx context.Context, arg ListExpiredSiteElevationsParams) ([]ListExpiredSiteElevationsRow, error)
+	// Memberships past their expiry, oldest first
+	ListExpiredSiteMembers(ctx context.Context, arg ListExpiredSiteMembersParams) ([]ListExpiredSiteMembersRow, error)
+	// Site VMs, stalest check-in first. A VM's OS is outdated when the image it
+	// runs isn't the site's configured one. Deleted sites aren't part of the fleet.
+	ListFleetSites(ctx context.Context, arg ListFleetSitesParams) ([]ListFleetSitesRow, error)
+	// Organizations claiming an email domain that the account isn't a member of,
+	// with the account's join request to each, if it made one
+	ListJoinableOrganizations(ctx context.Context, arg ListJoinableOrganizationsParams) ([]ListJoinableOrganizationsRow, error)
 	ListMachineTypes(ctx context.Context) ([]MachineType, error)
+	ListMeteredPrices(ctx context.Context) ([]ListMeteredPricesRow, error)
+	ListNotificationChannels(ctx context.Context, arg ListNotificationChannelsParams) ([]ListNotificationChannelsRow, error)
+	// Working API keys held by anyone who is a member of the organization, or of one
+	// of its projects or sites, so owners can see who can reach its resources.
+	ListOrganizationAPIKeys(ctx context.Context, arg ListOrganizationAPIKeysParams) ([]ListOrganizationAPIKeysRow, error)
+	// Per-day organization totals of a metric since the given date.
+	ListOrganizationDailyUsage(ctx context.Context, arg ListOrganizationDailyUsageParams) ([]ListOrganizationDailyUsageRow, error)
+	ListOrganizationEncryptionKeys(ctx context.Context, organizationID int64) ([]OrganizationEncryptionKey, error)
+	ListOrganizationExports(ctx context.Context, arg ListOrganizationExportsParams) ([]ListOrganizationExportsRow, error)
 	ListOrganizationFirewallRules(ctx context.Context, organizationID sql.NullInt64) ([]ListOrganizationFirewallRulesRow, error)
+	// The GCP projects of an organization's live projects, whose Cloud SQL service
+	// agents encrypt sites' databases with the key for the project's region
+	ListOrganizationGcpProjects(ctx context.Context, organizationID int64) ([]ListOrganizationGcpProjectsRow, error)
+	ListOrganizationJoinDomains(ctx context.Context, organizationID int64) ([]ListOrganizationJoinDomainsRow, error)
+	// Join requests waiting for an administrator, oldest first
+	ListOrganizationJoinRequests(ctx context.Context, organizationID int64) ([]ListOrganizationJoinRequestsRow, error)
 	ListOrganizationMembers(ctx context.Context, arg ListOrganizationMembersParams) ([]ListOrganizationMembersRow, error)
+	ListOrganizationPolicies(ctx context.Context, arg ListOrganizationPoliciesParams) ([]ListOrganizationPoliciesRow, error)
+	// The regions an organization's live projects run in, with their country grouping.
+	ListOrganizationProjectRegions(ctx context.Context, organizationID int64) ([]ListOrganizationProjectRegionsRow, error)
+	// Billable configuration of every project in an organization with machine pricing.
+	ListOrganizationProjectUsage(ctx context.Context, organizationID int64) ([]ListOrganizationProjectUsageRow, error)
 	ListOrganizationProjects(ctx context.Context, arg ListOrganizationProjectsParams) ([]ListOrganizationProjectsRow, error)
+	ListOrganizationQuotas(ctx context.Context, organizationID int64) ([]OrganizationQuota, error)
 	// =============================================================================
 	// PROJECT FIREWALL RULES
 	// =============================================================================
 	ListOrganizationRelationships(ctx context.Context, arg ListOrganizationRelationshipsParams) ([]ListOrganizationRelationshipsRow, error)
+	// Every value an organization keeps in its Vault, with the data key version
+	// it's sealed with, for re-wrapping after a rotation
+	ListOrganizationSealedSecrets(ctx context.Context, organizationID int64) ([]ListOrganizationSealedSecretsRow, error)
 	ListOrganizationSecrets(ctx context.Context, arg ListOrganizationSecretsParams) ([]ListOrganizationSecretsRow, error)
 	ListOrganizationSettings(ctx context.Context, arg ListOrganizationSettingsParams) ([]ListOrganizationSettingsRow, error)
+	ListOrganizationSitePublicIDs(ctx context.Context, organizationID int64) ([]string, error)
+	// Every project and site that gets a workspace of its own once the organization is isolated
+	ListOrganizationStateWorkspaces(ctx context.Context, organizationID int64) ([]ListOrganizationStateWorkspacesRow, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]ListOrganizationsRow, error)
+	ListOrganizationsByBillingState(ctx context.Context, arg ListOrganizationsByBillingStateParams) ([]ListOrganizationsByBillingStateRow, error)
+	// Resolves an organization name typed by an operator; names aren't unique.
+	ListOrganizationsByName(ctx context.Context, name string) ([]ListOrganizationsByNameRow, error)
+	ListPendingOrganizationExports(ctx context.Context, limit int32) ([]ListPendingOrganizationExportsRow, error)
+	ListPendingSiteCachePurges(ctx context.Context, siteID int64) ([]ListPendingSiteCachePurgesRow, error)
+	// Unexpired auditor grants, soonest to expire first
+	ListPlatformAuditors(ctx context.Context) ([]ListPlatformAuditorsRow, error)
+	// =============================================================================
+	// ADMIN CONSOLE
+	// =============================================================================
+	ListPlatformOrganizations(ctx context.Context, arg ListPlatformOrganizationsParams) ([]ListPlatformOrganizationsRow, error)
+	// Runs newest first, with the public IDs of their scope; a site or project run
+	// is matched to its organization through the site and project
+	ListPlatformReconciliationRuns(ctx context.Context, arg ListPlatformReconciliationRunsParams) ([]ListPlatformReconciliationRunsRow, error)
+	ListPolicyViolations(ctx context.Context, arg ListPolicyViolationsParams) ([]ListPolicyViolationsRow, error)
+	ListPrivateServiceConnectEndpoints(ctx context.Context, organizationID int64) ([]ListPrivateServiceConnectEndpointsRow, error)
+	// Active sites reachable from outside: their first domain, or their external IP
+	ListProbeTargets(ctx context.Context) ([]ListProbeTargetsRow, error)
 	ListProjectFirewallRules(ctx context.Context, projectID sql.NullInt64) ([]ListProjectFirewallRulesRow, error)
 	ListProjectMembers(ctx context.Context, arg ListProjectMembersParams) ([]ListProjectMembersRow, error)
 	ListProjectSecrets(ctx context.Context, arg ListProjectSecretsParams) ([]ListProjectSecretsRow, error)
 	ListProjectSettings(ctx context.Context, arg ListProjectSettingsParams) ([]ListProjectSettingsRow, error)
 	ListProjectSites(ctx context.Context, arg ListProjectSitesParams) ([]ListProjectSitesRow, error)
 	ListProjects(ctx context.Context, arg ListProjectsParams) ([]ListProjectsRow, error)
+	// Resolves a project name typed by an operator, optionally within one organization.
+	ListProjectsByName(ctx context.Context, arg ListProjectsByNameParams) ([]ListProjectsByNameRow, error)
+	ListRecentSiteWafBlocks(ctx context.Context, arg ListRecentSiteWafBlocksParams) ([]SiteWafBlock, error)
+	// Open incidents whose site recovered from the incident's cause or is no longer active:
+	// missed check-ins recover with a check-in since the cutoff, failed probes with a probe that was up,
+	// and degraded health once the score is back above the threshold
+	ListRecoveredSiteIncidents(ctx context.Context, cutoff sql.NullTime) ([]ListRecoveredSiteIncidentsRow, error)
+	// Machine series offered by every active region.
+	ListRegionMachineSeries(ctx context.Context) ([]ListRegionMachineSeriesRow, error)
+	ListRegions(ctx context.Context) ([]Region, error)
+	// Relationships an organization is either side of, with both organizations' names
+	ListRelationshipsForOrganization(ctx context.Context, arg ListRelationshipsForOrganizationParams) ([]ListRelationshipsForOrganizationRow, error)
+	ListSecretAccessLogs(ctx context.Context, arg ListSecretAccessLogsParams) ([]ListSecretAccessLogsRow, error)
+	// The keys ReassignServiceAccountAPIKeys moves
+	ListServiceAccountAPIKeyPublicIDs(ctx context.Context, arg ListServiceAccountAPIKeyPublicIDsParams) ([]string, error)
+	ListSiteAddons(ctx context.Context, siteID int64) ([]ListSiteAddonsRow, error)
+	ListSiteCdnDomains(ctx context.Context, siteID int64) ([]string, error)
+	ListSiteConfigVarRevisions(ctx context.Context, arg ListSiteConfigVarRevisionsParams) ([]ListSiteConfigVarRevisionsRow, error)
+	// The changes after one revision up to and including another, oldest first
+	ListSiteConfigVarRevisionsBetween(ctx context.Context, arg ListSiteConfigVarRevisionsBetweenParams) ([]ListSiteConfigVarRevisionsBetweenRow, error)
+	ListSiteConfigVars(ctx context.Context, arg ListSiteConfigVarsParams) ([]SiteConfigVar, error)
+	ListSiteDatabases(ctx context.Context, siteID int64) ([]ListSiteDatabasesRow, error)
+	// Reconciliations waiting for the site's maintenance window
+	ListSiteDeferredReconciliations(ctx context.Context, siteID int64) ([]ListSiteDeferredReconciliationsRow, error)
 	ListSiteDeployments(ctx context.Context, arg ListSiteDeploymentsParams) ([]Deployment, error)
 	ListSiteDomains(ctx context.Context, arg ListSiteDomainsParams) ([]Domain, error)
+	ListSiteElevations(ctx context.Context, arg ListSiteElevationsParams) ([]ListSiteElevationsRow, error)
 	ListSiteFirewallRules(ctx context.Context, siteID sql.NullInt64) ([]ListSiteFirewallRulesRow, error)
+	// What each active site's health score is computed from: its last check-in and
+	// container counts, its probes since the cutoff, its latest deployment, when its
+	// score dropped below the incident threshold and whether it has an open incident
+	ListSiteHealthInputs(ctx context.Context, probesSince sql.NullTime) ([]ListSiteHealthInputsRow, error)
+	// Settings that apply to a site, from its organization's up to its own, in the
+	// order they override each other
+	ListSiteInheritedSettings(ctx context.Context, arg ListSiteInheritedSettingsParams) ([]ListSiteInheritedSettingsRow, error)
 	ListSiteMembers(ctx context.Context, arg ListSiteMembersParams) ([]ListSiteMembersRow, error)
+	// Accounts that can deploy a site, directly or through its project or organization
+	ListSiteNotificationRecipients(ctx context.Context, arg ListSiteNotificationRecipientsParams) ([]int64, error)
+	// Probe counts per bucket of bucket_seconds since a time, for uptime graphs
+	ListSiteProbeBuckets(ctx context.Context, arg ListSiteProbeBucketsParams) ([]ListSiteProbeBucketsRow, error)
+	// Rules with the requests they rejected since a date
+	ListSiteRateLimitRules(ctx context.Context, arg ListSiteRateLimitRulesParams) ([]ListSiteRateLimitRulesRow, error)
+	ListSiteReconciliationRuns(ctx context.Context, arg ListSiteReconciliationRunsParams) ([]Reconciliation, error)
+	ListSiteRedirects(ctx context.Context, arg ListSiteRedirectsParams) ([]ListSiteRedirectsRow, error)
 	ListSiteSecrets(ctx context.Context, arg ListSiteSecretsParams) ([]ListSiteSecretsRow, error)
 	ListSiteSettings(ctx context.Context, arg ListSiteSettingsParams) ([]ListSiteSettingsRow, error)
 	// =============================================================================
 	// Ssh ACCESS
 	// =============================================================================
 	ListSiteSshAccess(ctx context.Context, arg ListSiteSshAccessParams) ([]ListSiteSshAccessRow, error)
+	ListSiteWafRuleExclusions(ctx context.Context, siteID int64) ([]ListSiteWafRuleExclusionsRow, error)
 	ListSites(ctx context.Context, arg ListSitesParams) ([]ListSitesRow, error)
+	// Resolves a site name typed by an operator, optionally within one organization
+	// or project. Names are only unique within a project.
+	ListSitesByName(ctx context.Context, arg ListSitesByNameParams) ([]ListSitesByNameRow, error)
+	// Active sites with at least min_probes probes since the cutoff, none of them up, and no open incident
+	ListSitesFailingProbes(ctx context.Context, arg ListSitesFailingProbesParams) ([]ListSitesFailingProbesRow, error)
+	// Active sites whose controller has not checked in since the cutoff and have no open incident
+	ListSitesMissingCheckIn(ctx context.Context, cutoff sql.NullTime) ([]ListSitesMissingCheckInRow, error)
 	ListSshKeysByAccount(ctx context.Context, publicID string) ([]ListSshKeysByAccountRow, error)
 	ListSshKeysByProject(ctx context.Context, arg ListSshKeysByProjectParams) ([]string, error)
 	ListSshKeysBySite(ctx context.Context, arg ListSshKeysBySiteParams) ([]string, error)
+	// Accounts marked stale, longest waiting first
+	ListStaleEffectiveAccessAccounts(ctx context.Context, limit int32) ([]int64, error)
+	// Listed sites in display order with the cause of their open incident, if any
+	ListStatusPageSites(ctx context.Context, statusPageID int64) ([]ListStatusPageSitesRow, error)
+	// Updates posted since a time, newest first. CONCAT_WS skips NULLs, so updates without an incident get ''
+	ListStatusPageUpdates(ctx context.Context, arg ListStatusPageUpdatesParams) ([]ListStatusPageUpdatesRow, error)
+	// Newest first
+	ListTerraformStateBackups(ctx context.Context, arg ListTerraformStateBackupsParams) ([]ListTerraformStateBackupsRow, error)
+	ListUnreadAccountNotifications(ctx context.Context, arg ListUnreadAccountNotificationsParams) ([]ListUnreadAccountNotificationsRow, error)
+	// Per-organization totals of a metric on a single day.
+	ListUsageTotalsForDate(ctx context.Context, arg ListUsageTotalsForDateParams) ([]ListUsageTotalsForDateRow, error)
 	ListUserFirewallRules(ctx context.Context, arg ListUserFirewallRulesParams) ([]ListUserFirewallRulesRow, error)
 	ListUserMemberships(ctx context.Context, arg ListUserMembershipsParams) ([]ListUserMembershipsRow, error)
 	ListUserOrganizations(ctx context.Context, arg ListUserOrganizationsParams) ([]ListUserOrganizationsRow, error)
@@ -283,50 +673,192 @@ type Querier interface {
 	// USER SETTINGS (Cross-scope query for dashboard)
 	// ============================================================================
 	ListUserSettings(ctx context.Context, arg ListUserSettingsParams) ([]ListUserSettingsRow, error)
+	// Current status, open incident, latest deployment and latest reconciliation of each site
+	// the account can access, for the dashboard's live status stream. The latest reconciliation
+	// includes organization and project runs, which reconcile every site beneath them.
+	ListUserSiteLiveStatus(ctx context.Context, arg ListUserSiteLiveStatusParams) ([]ListUserSiteLiveStatusRow, error)
 	ListUserSites(ctx context.Context, arg ListUserSitesParams) ([]ListUserSitesRow, error)
 	ListUserSitesWithProject(ctx context.Context, arg ListUserSitesWithProjectParams) ([]ListUserSitesWithProjectRow, error)
+	// Locks an account's stale marker for the refresh transaction; membership and
+	// relationship changes that mark it again wait until the refresh commits
+	LockEffectiveAccessStale(ctx context.Context, accountID int64) (int64, error)
+	// Matches the IDs operators get from elsewhere: GCP project and folder IDs and
+	// numbers, account emails and GitHub workflow run IDs.
+	LookupResourceByExternalID(ctx context.Context, arg LookupResourceByExternalIDParams) ([]LookupResourceByExternalIDRow, error)
+	// Matches a UUID against every table with a public ID, plus the string IDs of
+	// deployments, reconciliation runs and events.
+	LookupResourceByPublicID(ctx context.Context, arg LookupResourceByPublicIDParams) ([]LookupResourceByPublicIDRow, error)
+	MarkAllNotificationsRead(ctx context.Context, accountID int64) (int64, error)
 	MarkEventCollapsed(ctx context.Context, arg MarkEventCollapsedParams) error
 	MarkEventDeadLetter(ctx context.Context, eventID string) error
 	MarkEventExecuted(ctx context.Context, arg MarkEventExecutedParams) error
 	MarkEventSent(ctx context.Context, id int64) error
 	MarkEventSentOrStatus(ctx context.Context, eventID string) error
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error)
+	// The endpoint is left out of the organization's terraform vars from here on,
+	// and removed once the terraform runner reports its forwarding rule is gone
+	MarkPrivateServiceConnectEndpointDeleting(ctx context.Context, id int64) error
+	// Records that a runner job was started for a pending terraform run; 0 rows
+	// means the run already moved on, e.g. the runner reported it started
+	MarkReconciliationRunTriggered(ctx context.Context, runID string) (int64, error)
+	// The CDN is left out of the site's terraform vars from here on, and the row
+	// removed once the terraform runner reports the load balancer is gone
+	MarkSiteCdnConfigDisabling(ctx context.Context, id int64) error
+	// The address is left out of the site's terraform vars from here on, and the
+	// row removed once the terraform runner reports the reservation is gone
+	MarkSiteStaticEgressIpReleasing(ctx context.Context, id int64) error
+	// Opens an incident unless the site already has one open; 0 rows means another sweep won
+	OpenSiteIncident(ctx context.Context, arg OpenSiteIncidentParams) (int64, error)
+	// Queues a reconciliation behind the site's maintenance window, adding the
+	// events to one of the same type already waiting
+	QueueSiteReconciliation(ctx context.Context, arg QueueSiteReconciliationParams) error
+	// Moves the keys of an organization's platform service accounts created by one account to another
+	ReassignServiceAccountAPIKeys(ctx context.Context, arg ReassignServiceAccountAPIKeysParams) (int64, error)
+	RecordNotificationChannelDelivery(ctx context.Context, arg RecordNotificationChannelDeliveryParams) error
+	RecordSiteTlsProbe(ctx context.Context, arg RecordSiteTlsProbeParams) error
+	// The device code can be exchanged for an API key once.
+	RedeemDeviceAuthorization(ctx context.Context, id int64) (int64, error)
+	RegionOffersMachineSeries(ctx context.Context, arg RegionOffersMachineSeriesParams) (bool, error)
 	RejectRelationship(ctx context.Context, arg RejectRelationshipParams) (sql.Result, error)
+	RejectSiteElevation(ctx context.Context, arg RejectSiteElevationParams) (sql.Result, error)
+	ReportSiteAddonHealth(ctx context.Context, arg ReportSiteAddonHealthParams) error
+	RequestRelationship(ctx context.Context, arg RequestRelationshipParams) error
+	// Exports left running by an instance that stopped mid-build are built again
+	RequeueStaleOrganizationExports(ctx context.Context, startedAt sql.NullTime) (int64, error)
+	// Reopens a rejected or revoked relationship, which keeps its ID
+	RerequestRelationship(ctx context.Context, arg RerequestRelationshipParams) (sql.Result, error)
 	ResetFailedLoginAttempts(ctx context.Context, id int64) error
+	ResolveSiteIncident(ctx context.Context, id int64) (int64, error)
+	// Sites stay suspended while either the billing or an operator suspension holds.
+	ResumeOrganizationSites(ctx context.Context, id int64) (int64, error)
+	// Re-inviting an email replaces its earlier invitation to the same resource
+	RevokePendingMemberInvitations(ctx context.Context, arg RevokePendingMemberInvitationsParams) error
+	// Ends an account's unexpired auditor grant
+	RevokePlatformAuditor(ctx context.Context, accountID int64) (int64, error)
+	RevokeRelationship(ctx context.Context, arg RevokeRelationshipParams) (sql.Result, error)
+	// Revokes a site's certificates other than the one just issued
+	RevokeSiteClientCertificates(ctx context.Context, arg RevokeSiteClientCertificatesParams) (int64, error)
+	RevokeSiteElevation(ctx context.Context, arg RevokeSiteElevationParams) (sql.Result, error)
+	RotateSiteDatabasePassword(ctx context.Context, id int64) error
+	// Has the event router retry a failed site reconciliation on its next pass,
+	// whatever it already did with it
+	ScheduleReconciliationRetry(ctx context.Context, runID string) (int64, error)
+	// Matches organizations, projects, sites, members and secret names the account can
+	// access. Secret values are never read. Members match on email or name and link to the
+	// resource they belong to.
+	SearchUserResources(ctx context.Context, arg SearchUserResourcesParams) ([]SearchUserResourcesRow, error)
+	SetOnboardingSessionDiscount(ctx context.Context, arg SetOnboardingSessionDiscountParams) error
+	SetOrganizationBillingState(ctx context.Context, arg SetOrganizationBillingStateParams) error
+	// Replaces the organization's key for a location along with the result of checking it
+	SetOrganizationEncryptionKey(ctx context.Context, arg SetOrganizationEncryptionKeyParams) error
+	SetOrganizationMemberExpiry(ctx context.Context, arg SetOrganizationMemberExpiryParams) error
+	SetOrganizationPaymentFailed(ctx context.Context, arg SetOrganizationPaymentFailedParams) error
+	SetProjectMemberExpiry(ctx context.Context, arg SetProjectMemberExpiryParams) error
+	SetRelationshipExpiry(ctx context.Context, arg SetRelationshipExpiryParams) (sql.Result, error)
+	// Pins a site to an API region unless it already has one.
+	SetSiteAPIRegion(ctx context.Context, arg SetSiteAPIRegionParams) error
+	SetSiteMemberExpiry(ctx context.Context, arg SetSiteMemberExpiryParams) error
+	// Claims a pending export so only one API instance builds it
+	StartOrganizationExport(ctx context.Context, id int64) (int64, error)
+	// Marks a monolithic organization as migrating; 0 rows means it's already migrating or isolated
+	StartTerraformStateMigration(ctx context.Context, arg StartTerraformStateMigrationParams) (int64, error)
+	// Per-project totals of a counter metric since the given date.
+	SumOrganizationProjectUsage(ctx context.Context, arg SumOrganizationProjectUsageParams) ([]SumOrganizationProjectUsageRow, error)
+	// Total data disk usage last reported by a project's sites.
+	SumProjectSiteDiskUsage(ctx context.Context, projectID int64) (int64, error)
+	// Matches per rule since a time, the most frequent first
+	SummarizeSiteWafBlocks(ctx context.Context, arg SummarizeSiteWafBlocksParams) ([]SummarizeSiteWafBlocksRow, error)
+	SuspendOrganization(ctx context.Context, arg SuspendOrganizationParams) error
+	SuspendOrganizationSites(ctx context.Context, organizationID int64) (int64, error)
+	TouchDeviceAuthorization(ctx context.Context, id int64) error
+	UnsuspendOrganization(ctx context.Context, id int64) (int64, error)
 	UpdateAPIKeyActive(ctx context.Context, arg UpdateAPIKeyActiveParams) error
 	UpdateAPIKeyLastUsed(ctx context.Context, publicID string) error
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) error
+	UpdateAccountAvatar(ctx context.Context, arg UpdateAccountAvatarParams) error
 	UpdateAccountOnboarding(ctx context.Context, arg UpdateAccountOnboardingParams) error
+	UpdateAccountProfile(ctx context.Context, arg UpdateAccountProfileParams) error
 	UpdateDeployment(ctx context.Context, arg UpdateDeploymentParams) error
+	UpdateEventSink(ctx context.Context, arg UpdateEventSinkParams) error
 	UpdateMachineType(ctx context.Context, arg UpdateMachineTypeParams) error
+	UpdateNotificationChannel(ctx context.Context, arg UpdateNotificationChannelParams) error
 	UpdateOnboardingSession(ctx context.Context, arg UpdateOnboardingSessionParams) error
-	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) error
+	// Applies the change only if the organization is still at the version it was read at;
+	// no rows are affected when someone else changed it first
+	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (int64, error)
+	UpdateOrganizationAllowedCidrs(ctx context.Context, arg UpdateOrganizationAllowedCidrsParams) error
+	UpdateOrganizationDataResidency(ctx context.Context, arg UpdateOrganizationDataResidencyParams) error
+	UpdateOrganizationEncryptionKeyStatus(ctx context.Context, arg UpdateOrganizationEncryptionKeyStatusParams) error
 	UpdateOrganizationMember(ctx context.Context, arg UpdateOrganizationMemberParams) error
 	// Updates organization member status (e.g., provisioning → active)
 	UpdateOrganizationMemberStatus(ctx context.Context, arg UpdateOrganizationMemberStatusParams) error
+	UpdateOrganizationPolicy(ctx context.Context, arg UpdateOrganizationPolicyParams) error
 	UpdateOrganizationSecret(ctx context.Context, arg UpdateOrganizationSecretParams) error
+	UpdateOrganizationSecretKeyVersion(ctx context.Context, arg UpdateOrganizationSecretKeyVersionParams) error
 	UpdateOrganizationSetting(ctx context.Context, arg UpdateOrganizationSettingParams) error
-	UpdateProject(ctx context.Context, arg UpdateProjectParams) error
+	// Applies the change only if the project is still at the version it was read at;
+	// no rows are affected when someone else changed it first
+	UpdateProject(ctx context.Context, arg UpdateProjectParams) (int64, error)
 	UpdateProjectMember(ctx context.Context, arg UpdateProjectMemberParams) error
 	// Updates project member status (e.g., provisioning → active)
 	UpdateProjectMemberStatus(ctx context.Context, arg UpdateProjectMemberStatusParams) error
 	UpdateProjectSecret(ctx context.Context, arg UpdateProjectSecretParams) error
+	UpdateProjectSecretKeyVersion(ctx context.Context, arg UpdateProjectSecretKeyVersionParams) error
 	UpdateProjectSetting(ctx context.Context, arg UpdateProjectSettingParams) error
+	// Records the machine's subscription item when billing was put back after a
+	// plan change that couldn't be saved; the project itself didn't change
+	UpdateProjectStripeSubscriptionItem(ctx context.Context, arg UpdateProjectStripeSubscriptionItemParams) error
 	UpdateReconciliationRunCompleted(ctx context.Context, runID string) error
 	UpdateReconciliationRunFailed(ctx context.Context, arg UpdateReconciliationRunFailedParams) error
 	UpdateReconciliationRunStarted(ctx context.Context, runID string) error
 	UpdateReconciliationRunStatus(ctx context.Context, arg UpdateReconciliationRunStatusParams) error
 	UpdateReconciliationRunTriggered(ctx context.Context, runID string) error
-	UpdateSite(ctx context.Context, arg UpdateSiteParams) error
+	UpdateRelationshipMaxRole(ctx context.Context, arg UpdateRelationshipMaxRoleParams) (sql.Result, error)
+	// Applies the change only if the site is still at the version it was read at;
+	// no rows are affected when someone else changed it first
+	UpdateSite(ctx context.Context, arg UpdateSiteParams) (int64, error)
+	UpdateSiteCdnCachePolicy(ctx context.Context, arg UpdateSiteCdnCachePolicyParams) error
 	// Updates the site's check-in timestamp (called by VM controller)
 	UpdateSiteCheckIn(ctx context.Context, id int64) error
+	// Records the container counts a site's controller reported on check-in
+	UpdateSiteContainers(ctx context.Context, arg UpdateSiteContainersParams) error
+	// Records the release a site's controller reported on check-in
+	UpdateSiteControllerVersion(ctx context.Context, arg UpdateSiteControllerVersionParams) error
+	UpdateSiteDiskUsage(ctx context.Context, arg UpdateSiteDiskUsageParams) error
+	UpdateSiteHealthCheckPath(ctx context.Context, arg UpdateSiteHealthCheckPathParams) error
 	UpdateSiteMember(ctx context.Context, arg UpdateSiteMemberParams) error
 	// Updates site member status (e.g., provisioning → active)
 	UpdateSiteMemberStatus(ctx context.Context, arg UpdateSiteMemberStatusParams) error
+	// Records the OS image and reboot state a site's controller reported on check-in
+	UpdateSiteOsStatus(ctx context.Context, arg UpdateSiteOsStatusParams) error
+	UpdateSiteRedirect(ctx context.Context, arg UpdateSiteRedirectParams) error
 	UpdateSiteSecret(ctx context.Context, arg UpdateSiteSecretParams) error
+	UpdateSiteSecretKeyVersion(ctx context.Context, arg UpdateSiteSecretKeyVersionParams) error
 	UpdateSiteSetting(ctx context.Context, arg UpdateSiteSettingParams) error
+	UpdateSshAccessSftpOnly(ctx context.Context, arg UpdateSshAccessSftpOnlyParams) error
 	UpdateSshKey(ctx context.Context, arg UpdateSshKeyParams) (sql.Result, error)
+	UpdateStatusPage(ctx context.Context, arg UpdateStatusPageParams) error
 	UpdateStripeSubscription(ctx context.Context, arg UpdateStripeSubscriptionParams) error
 	UpgradeReconciliationRunScope(ctx context.Context, arg UpgradeReconciliationRunScopeParams) error
+	UpsertAccountPreferences(ctx context.Context, arg UpsertAccountPreferencesParams) error
+	UpsertControllerConfig(ctx context.Context, arg UpsertControllerConfigParams) error
+	// Takes over a deleted secret of the same name, which still holds the name
+	UpsertManagedSiteSecret(ctx context.Context, arg UpsertManagedSiteSecretParams) error
+	UpsertOrganizationBrandingColors(ctx context.Context, arg UpsertOrganizationBrandingColorsParams) error
+	UpsertOrganizationLogo(ctx context.Context, arg UpsertOrganizationLogoParams) error
+	UpsertOrganizationQuota(ctx context.Context, arg UpsertOrganizationQuotaParams) error
+	UpsertPasswordResetToken(ctx context.Context, arg UpsertPasswordResetTokenParams) error
+	UpsertProjectUsage(ctx context.Context, arg UpsertProjectUsageParams) error
+	UpsertSiteAccessProtection(ctx context.Context, arg UpsertSiteAccessProtectionParams) error
+	UpsertSiteConfigVar(ctx context.Context, arg UpsertSiteConfigVarParams) error
+	UpsertSiteHealth(ctx context.Context, arg UpsertSiteHealthParams) error
+	UpsertSiteMaintenanceWindow(ctx context.Context, arg UpsertSiteMaintenanceWindowParams) error
+	UpsertSiteTlsCertificate(ctx context.Context, arg UpsertSiteTlsCertificateParams) error
+	// Changing the policy makes the last probe stale
+	UpsertSiteTlsSettings(ctx context.Context, arg UpsertSiteTlsSettingsParams) error
+	UpsertSiteWafConfig(ctx context.Context, arg UpsertSiteWafConfigParams) error
+	UpsertStorageConfig(ctx context.Context, arg UpsertStorageConfigParams) error
+	UsageReportExists(ctx context.Context, arg UsageReportExistsParams) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -140,7 +140,7 @@ func (q *Queries) CreateReconciliationRun(ctx context.Context, arg CreateReconci
 }
 
 const getPendingReconciliationRunByOrg = `-- name: GetPendingReconciliationRunByOrg :one
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE organization_id = ? AND status IN ('pending', 'running')
 LIMIT 1
 `
@@ -163,6 +163,9 @@ func (q *Queries) GetPendingReconciliationRunByOrg(ctx context.Context, organiza
 		&i.LastEventAt,
 		&i.Status,
 		&i.ErrorMessage,
+		&i.Attempt,
+		&i.RetryState,
+		&i.NextRetryAt,
 		&i.CreatedAt,
 		&i.TriggeredAt,
 		&i.StartedAt,
@@ -172,7 +175,7 @@ func (q *Queries) GetPendingReconciliationRunByOrg(ctx context.Context, organiza
 }
 
 const getPendingReconciliationRunByProject = `-- name: GetPendingReconciliationRunByProject :one
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE project_id = ? AND status IN ('pending', 'running')
 LIMIT 1
 `
@@ -195,6 +198,9 @@ func (q *Queries) GetPendingReconciliationRunByProject(ctx context.Context, proj
 		&i.LastEventAt,
 		&i.Status,
 		&i.ErrorMessage,
+		&i.Attempt,
+		&i.RetryState,
+		&i.NextRetryAt,
 		&i.CreatedAt,
 		&i.TriggeredAt,
 		&i.StartedAt,
@@ -204,7 +210,7 @@ func (q *Queries) GetPendingReconciliationRunByProject(ctx context.Context, proj
 }
 
 const getPendingReconciliationRunByResource = `-- name: GetPendingReconciliationRunByResource :one
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE organization_id = COALESCE(?, organization_id)
   AND project_id = COALESCE(?, project_id)
   AND site_id = COALESCE(?, site_id)
@@ -236,6 +242,9 @@ func (q *Queries) GetPendingReconciliationRunByResource(ctx context.Context, arg
 		&i.LastEventAt,
 		&i.Status,
 		&i.ErrorMessage,
+		&i.Attempt,
+		&i.RetryState,
+		&i.NextRetryAt,
 		&i.CreatedAt,
 		&i.TriggeredAt,
 		&i.StartedAt,
@@ -245,7 +254,7 @@ func (q *Queries) GetPendingReconciliationRunByResource(ctx context.Context, arg
 }
 
 const getPendingReconciliationRunBySite = `-- name: GetPendingReconciliationRunBySite :one
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE site_id = ? AND status IN ('pending', 'running')
 LIMIT 1
 `
@@ -268,6 +277,9 @@ func (q *Queries) GetPendingReconciliationRunBySite(ctx context.Context, siteID
 		&i.LastEventAt,
 		&i.Status,
 		&i.ErrorMessage,
+		&i.Attempt,
+		&i.RetryState,
+		&i.NextRetryAt,
 		&i.CreatedAt,
 		&i.TriggeredAt,
 		&i.StartedAt,
@@ -364,7 +376,7 @@ func (q *Queries) GetReconciliationResultsBySite(ctx context.Context, arg GetRec
 }
 
 const getReconciliationRunByID = `-- name: GetReconciliationRunByID :one
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE run_id = ?
 LIMIT 1
 `
@@ -387,6 +399,9 @@ func (q *Queries) GetReconciliationRunByID(ctx context.Context, runID string) (R
 		&i.LastEventAt,
 		&i.Status,
 		&i.ErrorMessage,
+		&i.Attempt,
+		&i.RetryState,
+		&i.NextRetryAt,
 		&i.CreatedAt,
 		&i.TriggeredAt,
 		&i.StartedAt,
@@ -536,8 +551,68 @@ func (q *Queries) GetSiteIDsBySite(ctx context.Context, id int64) ([]int64, erro
 	return items, nil
 }
 
+const listSiteReconciliationRuns = `-- name: ListSiteReconciliationRuns :many
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
+WHERE site_id = ?
+  AND run_type = 'reconciliation'
+  AND reconciliation_type IN (?, 'general')
+ORDER BY created_at DESC, id DESC
+LIMIT ?
+`
+
+type ListSiteReconciliationRunsParams struct {
+	SiteID             sql.NullInt64                         `json:"site_id"`
+	ReconciliationType NullReconciliationsReconciliationType `json:"reconciliation_type"`
+	Limit              int32                                 `json:"limit"`
+}
+
+func (q *Queries) ListSiteReconciliationRuns(ctx context.Context, arg ListSiteReconciliationRunsParams) ([]Reconciliation, error) {
+	rows, err := q.db.QueryContext(ctx, listSiteReconciliationRuns, arg.SiteID, arg.ReconciliationType, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reconciliation{}
+	for rows.Next() {
+		var i Reconciliation
+		if err := rows.Scan(
+			&i.ID,
+			&i.RunID,
+			&i.OrganizationID,
+			&i.ProjectID,
+			&i.SiteID,
+			&i.RunType,
+			&i.ReconciliationType,
+			&i.Modules,
+			&i.TargetSiteIds,
+			&i.EventIds,
+			&i.FirstEventAt,
+			&i.LastEventAt,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.Attempt,
+			&i.RetryState,
+			&i.NextRetryAt,
+			&i.CreatedAt,
+			&i.TriggeredAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStaleReconciliationRuns = `-- name: GetStaleReconciliationRuns :many
-SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, created_at, triggered_at, started_at, completed_at FROM reconciliations
+SELECT id, run_id, organization_id, project_id, site_id, run_type, reconciliation_type, modules, target_site_ids, event_ids, first_event_at, last_event_at, status, error_message, attempt, retry_state, next_retry_at, created_at, triggered_at, started_at, completed_at FROM reconciliations
 WHERE status = 'running'
   AND started_at < NOW() - INTERVAL 30 MINUTE
 `
@@ -566,6 +641,9 @@ func (q *Queries) GetStaleReconciliationRuns(ctx context.Context) ([]Reconciliat
 			&i.LastEventAt,
 			&i.Status,
 			&i.ErrorMessage,
+			&i.Attempt,
+			&i.RetryState,
+			&i.NextRetryAt,
 			&i.CreatedAt,
 			&i.TriggeredAt,
 			&i.StartedAt,
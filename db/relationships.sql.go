@@ -45,9 +45,31 @@ func (q *Queries) CreateRelationship(ctx context.Context, arg CreateRelationship
 	return q.db.ExecContext(ctx, createRelationship, arg.SourceOrganizationID, arg.TargetOrganizationID, arg.RelationshipType)
 }
 
+const expireRelationship = `-- name: ExpireRelationship :execrows
+UPDATE relationships SET
+  ` + "`" + `status` + "`" + ` = 'revoked',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = NULL
+WHERE id = ? AND ` + "`" + `status` + "`" + ` IN ('pending', 'approved') AND expires_at <= ?
+`
+
+type ExpireRelationshipParams struct {
+	ID  int64        `json:"id"`
+	Now sql.NullTime `json:"now"`
+}
+
+// Revokes a relationship only if it's still expired, so one extended since it was listed stays
+func (q *Queries) ExpireRelationship(ctx context.Context, arg ExpireRelationshipParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireRelationship, arg.ID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getRelationship = `-- name: GetRelationship :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, source_organization_id, target_organization_id,
-       relationship_type, ` + "`" + `status` + "`" + `, created_at, resolved_at, resolved_by
+       relationship_type, max_role, ` + "`" + `status` + "`" + `, expires_at, created_at, requested_by, resolved_at, resolved_by
 FROM relationships WHERE public_id = UUID_TO_BIN(?)
 `
 
@@ -57,8 +79,11 @@ type GetRelationshipRow struct {
 	SourceOrganizationID int64                         `json:"source_organization_id"`
 	TargetOrganizationID int64                         `json:"target_organization_id"`
 	RelationshipType     RelationshipsRelationshipType `json:"relationship_type"`
+	MaxRole              RelationshipsMaxRole          `json:"max_role"`
 	Status               RelationshipsStatus           `json:"status"`
+	ExpiresAt            sql.NullTime                  `json:"expires_at"`
 	CreatedAt            sql.NullTime                  `json:"created_at"`
+	RequestedBy          sql.NullInt64                 `json:"requested_by"`
 	ResolvedAt           sql.NullTime                  `json:"resolved_at"`
 	ResolvedBy           sql.NullInt64                 `json:"resolved_by"`
 }
@@ -72,14 +97,205 @@ func (q *Queries) GetRelationship(ctx context.Context, publicID string) (GetRela
 		&i.SourceOrganizationID,
 		&i.TargetOrganizationID,
 		&i.RelationshipType,
+		&i.MaxRole,
 		&i.Status,
+		&i.ExpiresAt,
 		&i.CreatedAt,
+		&i.RequestedBy,
 		&i.ResolvedAt,
 		&i.ResolvedBy,
 	)
 	return i, err
 }
 
+const getRelationshipBetween = `-- name: GetRelationshipBetween :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, source_organization_id, target_organization_id,
+       relationship_type, max_role, ` + "`" + `status` + "`" + `, expires_at, created_at, requested_by, resolved_at, resolved_by
+FROM relationships
+WHERE source_organization_id = ? AND target_organization_id = ? AND relationship_type = ?
+`
+
+type GetRelationshipBetweenParams struct {
+	SourceOrganizationID int64                         `json:"source_organization_id"`
+	TargetOrganizationID int64                         `json:"target_organization_id"`
+	RelationshipType     RelationshipsRelationshipType `json:"relationship_type"`
+}
+
+type GetRelationshipBetweenRow struct {
+	ID                   int64                         `json:"id"`
+	PublicID             string                        `json:"public_id"`
+	SourceOrganizationID int64                         `json:"source_organization_id"`
+	TargetOrganizationID int64                         `json:"target_organization_id"`
+	RelationshipType     RelationshipsRelationshipType `json:"relationship_type"`
+	MaxRole              RelationshipsMaxRole          `json:"max_role"`
+	Status               RelationshipsStatus           `json:"status"`
+	ExpiresAt            sql.NullTime                  `json:"expires_at"`
+	CreatedAt            sql.NullTime                  `json:"created_at"`
+	RequestedBy          sql.NullInt64                 `json:"requested_by"`
+	ResolvedAt           sql.NullTime                  `json:"resolved_at"`
+	ResolvedBy           sql.NullInt64                 `json:"resolved_by"`
+}
+
+func (q *Queries) GetRelationshipBetween(ctx context.Context, arg GetRelationshipBetweenParams) (GetRelationshipBetweenRow, error) {
+	row := q.db.QueryRowContext(ctx, getRelationshipBetween, arg.SourceOrganizationID, arg.TargetOrganizationID, arg.RelationshipType)
+	var i GetRelationshipBetweenRow
+	err := row.Scan(
+		&i.ID,
+		&i.PublicID,
+		&i.SourceOrganizationID,
+		&i.TargetOrganizationID,
+		&i.RelationshipType,
+		&i.MaxRole,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.RequestedBy,
+		&i.ResolvedAt,
+		&i.ResolvedBy,
+	)
+	return i, err
+}
+
+const listExpiredRelationships = `-- name: ListExpiredRelationships :many
+SELECT r.id, BIN_TO_UUID(r.public_id) AS public_id, r.source_organization_id, r.target_organization_id,
+       r.max_role, r.expires_at,
+       BIN_TO_UUID(source_org.public_id) AS source_organization_public_id,
+       BIN_TO_UUID(target_org.public_id) AS target_organization_public_id
+FROM relationships r
+JOIN organizations source_org ON source_org.id = r.source_organization_id
+JOIN organizations target_org ON target_org.id = r.target_organization_id
+WHERE r.` + "`" + `status` + "`" + ` IN ('pending', 'approved') AND r.expires_at <= ?
+ORDER BY r.expires_at
+LIMIT ?
+`
+
+type ListExpiredRelationshipsParams struct {
+	Now   sql.NullTime `json:"now"`
+	Limit int32        `json:"limit"`
+}
+
+type ListExpiredRelationshipsRow struct {
+	ID                         int64                `json:"id"`
+	PublicID                   string               `json:"public_id"`
+	SourceOrganizationID       int64                `json:"source_organization_id"`
+	TargetOrganizationID       int64                `json:"target_organization_id"`
+	MaxRole                    RelationshipsMaxRole `json:"max_role"`
+	ExpiresAt                  sql.NullTime         `json:"expires_at"`
+	SourceOrganizationPublicID string               `json:"source_organization_public_id"`
+	TargetOrganizationPublicID string               `json:"target_organization_public_id"`
+}
+
+// Pending or approved relationships past their expiry, oldest first
+func (q *Queries) ListExpiredRelationships(ctx context.Context, arg ListExpiredRelationshipsParams) ([]ListExpiredRelationshipsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredRelationships, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListExpiredRelationshipsRow{}
+	for rows.Next() {
+		var i ListExpiredRelationshipsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PublicID,
+			&i.SourceOrganizationID,
+			&i.TargetOrganizationID,
+			&i.MaxRole,
+			&i.ExpiresAt,
+			&i.SourceOrganizationPublicID,
+			&i.TargetOrganizationPublicID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRelationshipsForOrganization = `-- name: ListRelationshipsForOrganization :many
+SELECT BIN_TO_UUID(r.public_id) AS public_id, r.relationship_type, r.max_role, r.` + "`" + `status` + "`" + `,
+       r.expires_at, r.created_at, r.resolved_at,
+       BIN_TO_UUID(source_org.public_id) AS source_organization_public_id, source_org.name AS source_organization_name,
+       BIN_TO_UUID(target_org.public_id) AS target_organization_public_id, target_org.name AS target_organization_name,
+       requester.email AS requested_by_email
+FROM relationships r
+JOIN organizations source_org ON source_org.id = r.source_organization_id
+JOIN organizations target_org ON target_org.id = r.target_organization_id
+LEFT JOIN accounts requester ON requester.id = r.requested_by
+WHERE r.source_organization_id = ? OR r.target_organization_id = ?
+ORDER BY r.created_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListRelationshipsForOrganizationParams struct {
+	OrganizationID int64 `json:"organization_id"`
+	Limit          int32 `json:"limit"`
+	Offset         int32 `json:"offset"`
+}
+
+type ListRelationshipsForOrganizationRow struct {
+	PublicID                   string                        `json:"public_id"`
+	RelationshipType           RelationshipsRelationshipType `json:"relationship_type"`
+	MaxRole                    RelationshipsMaxRole          `json:"max_role"`
+	Status                     RelationshipsStatus           `json:"status"`
+	ExpiresAt                  sql.NullTime                  `json:"expires_at"`
+	CreatedAt                  sql.NullTime                  `json:"created_at"`
+	ResolvedAt                 sql.NullTime                  `json:"resolved_at"`
+	SourceOrganizationPublicID string                        `json:"source_organization_public_id"`
+	SourceOrganizationName     string                        `json:"source_organization_name"`
+	TargetOrganizationPublicID string                        `json:"target_organization_public_id"`
+	TargetOrganizationName     string                        `json:"target_organization_name"`
+	RequestedByEmail           sql.NullString                `json:"requested_by_email"`
+}
+
+// Relationships an organization is either side of, with both organizations' names
+func (q *Queries) ListRelationshipsForOrganization(ctx context.Context, arg ListRelationshipsForOrganizationParams) ([]ListRelationshipsForOrganizationRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRelationshipsForOrganization,
+		arg.OrganizationID,
+		arg.OrganizationID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRelationshipsForOrganizationRow{}
+	for rows.Next() {
+		var i ListRelationshipsForOrganizationRow
+		if err := rows.Scan(
+			&i.PublicID,
+			&i.RelationshipType,
+			&i.MaxRole,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+			&i.SourceOrganizationPublicID,
+			&i.SourceOrganizationName,
+			&i.TargetOrganizationPublicID,
+			&i.TargetOrganizationName,
+			&i.RequestedByEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const rejectRelationship = `-- name: RejectRelationship :execresult
 UPDATE relationships SET
   ` + "`" + `status` + "`" + ` = 'rejected',
@@ -96,3 +312,98 @@ type RejectRelationshipParams struct {
 func (q *Queries) RejectRelationship(ctx context.Context, arg RejectRelationshipParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, rejectRelationship, arg.ResolvedBy, arg.PublicID)
 }
+
+const requestRelationship = `-- name: RequestRelationship :exec
+INSERT INTO relationships (
+  public_id, source_organization_id, target_organization_id, relationship_type, max_role, ` + "`" + `status` + "`" + `, created_at, requested_by
+) VALUES (
+  UUID_TO_BIN(?), ?, ?, 'access', ?, 'pending', CURRENT_TIMESTAMP, ?
+)
+`
+
+type RequestRelationshipParams struct {
+	PublicID             string               `json:"public_id"`
+	SourceOrganizationID int64                `json:"source_organization_id"`
+	TargetOrganizationID int64                `json:"target_organization_id"`
+	MaxRole              RelationshipsMaxRole `json:"max_role"`
+	RequestedBy          sql.NullInt64        `json:"requested_by"`
+}
+
+func (q *Queries) RequestRelationship(ctx context.Context, arg RequestRelationshipParams) error {
+	_, err := q.db.ExecContext(ctx, requestRelationship,
+		arg.PublicID,
+		arg.SourceOrganizationID,
+		arg.TargetOrganizationID,
+		arg.MaxRole,
+		arg.RequestedBy,
+	)
+	return err
+}
+
+const rerequestRelationship = `-- name: RerequestRelationship :execresult
+UPDATE relationships SET
+  ` + "`" + `status` + "`" + ` = 'pending',
+  max_role = ?,
+  created_at = CURRENT_TIMESTAMP,
+  requested_by = ?,
+  expires_at = NULL,
+  resolved_at = NULL,
+  resolved_by = NULL
+WHERE id = ? AND ` + "`" + `status` + "`" + ` IN ('rejected', 'revoked')
+`
+
+type RerequestRelationshipParams struct {
+	MaxRole     RelationshipsMaxRole `json:"max_role"`
+	RequestedBy sql.NullInt64        `json:"requested_by"`
+	ID          int64                `json:"id"`
+}
+
+// Reopens a rejected or revoked relationship, which keeps its ID
+func (q *Queries) RerequestRelationship(ctx context.Context, arg RerequestRelationshipParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, rerequestRelationship, arg.MaxRole, arg.RequestedBy, arg.ID)
+}
+
+const revokeRelationship = `-- name: RevokeRelationship :execresult
+UPDATE relationships SET
+  ` + "`" + `status` + "`" + ` = 'revoked',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = ?
+WHERE public_id = UUID_TO_BIN(?) AND ` + "`" + `status` + "`" + ` IN ('pending', 'approved')
+`
+
+type RevokeRelationshipParams struct {
+	ResolvedBy sql.NullInt64 `json:"resolved_by"`
+	PublicID   string        `json:"public_id"`
+}
+
+func (q *Queries) RevokeRelationship(ctx context.Context, arg RevokeRelationshipParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, revokeRelationship, arg.ResolvedBy, arg.PublicID)
+}
+
+const setRelationshipExpiry = `-- name: SetRelationshipExpiry :execresult
+UPDATE relationships SET expires_at = ?
+WHERE public_id = UUID_TO_BIN(?) AND ` + "`" + `status` + "`" + ` IN ('pending', 'approved')
+`
+
+type SetRelationshipExpiryParams struct {
+	ExpiresAt sql.NullTime `json:"expires_at"`
+	PublicID  string       `json:"public_id"`
+}
+
+func (q *Queries) SetRelationshipExpiry(ctx context.Context, arg SetRelationshipExpiryParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, setRelationshipExpiry, arg.ExpiresAt, arg.PublicID)
+}
+
+const updateRelationshipMaxRole = `-- name: UpdateRelationshipMaxRole :execresult
+UPDATE relationships SET max_role = ?
+WHERE public_id = UUID_TO_BIN(?) AND ` + "`" + `status` + "`" + ` IN ('pending', 'approved')
+`
+
+type UpdateRelationshipMaxRoleParams struct {
+	MaxRole  RelationshipsMaxRole `json:"max_role"`
+	PublicID string               `json:"public_id"`
+}
+
+func (q *Queries) UpdateRelationshipMaxRole(ctx context.Context, arg UpdateRelationshipMaxRoleParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateRelationshipMaxRole, arg.MaxRole, arg.PublicID)
+}
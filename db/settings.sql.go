@@ -8,13 +8,15 @@ package db
 import (
 	"context"
 	"database/sql"
+
+	"github.com/libops/api/db/types"
 )
 
 const createOrganizationSetting = `-- name: CreateOrganizationSetting :exec
 
 INSERT INTO organization_settings (
-    public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+    public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateOrganizationSettingParams struct {
@@ -22,6 +24,8 @@ type CreateOrganizationSettingParams struct {
 	OrganizationID int64                          `json:"organization_id"`
 	SettingKey     string                         `json:"setting_key"`
 	SettingValue   string                         `json:"setting_value"`
+	ValueType      OrganizationSettingsValueType  `json:"value_type"`
+	AllowedValues  types.RawJSON                  `json:"allowed_values"`
 	Editable       sql.NullBool                   `json:"editable"`
 	Description    sql.NullString                 `json:"description"`
 	Status         NullOrganizationSettingsStatus `json:"status"`
@@ -38,6 +42,8 @@ func (q *Queries) CreateOrganizationSetting(ctx context.Context, arg CreateOrgan
 		arg.OrganizationID,
 		arg.SettingKey,
 		arg.SettingValue,
+		arg.ValueType,
+		arg.AllowedValues,
 		arg.Editable,
 		arg.Description,
 		arg.Status,
@@ -50,20 +56,22 @@ func (q *Queries) CreateOrganizationSetting(ctx context.Context, arg CreateOrgan
 const createProjectSetting = `-- name: CreateProjectSetting :exec
 
 INSERT INTO project_settings (
-    public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+    public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateProjectSettingParams struct {
-	PublicID     string                    `json:"public_id"`
-	ProjectID    int64                     `json:"project_id"`
-	SettingKey   string                    `json:"setting_key"`
-	SettingValue string                    `json:"setting_value"`
-	Editable     sql.NullBool              `json:"editable"`
-	Description  sql.NullString            `json:"description"`
-	Status       NullProjectSettingsStatus `json:"status"`
-	CreatedBy    sql.NullInt64             `json:"created_by"`
-	UpdatedBy    sql.NullInt64             `json:"updated_by"`
+	PublicID      string                    `json:"public_id"`
+	ProjectID     int64                     `json:"project_id"`
+	SettingKey    string                    `json:"setting_key"`
+	SettingValue  string                    `json:"setting_value"`
+	ValueType     ProjectSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON             `json:"allowed_values"`
+	Editable      sql.NullBool              `json:"editable"`
+	Description   sql.NullString            `json:"description"`
+	Status        NullProjectSettingsStatus `json:"status"`
+	CreatedBy     sql.NullInt64             `json:"created_by"`
+	UpdatedBy     sql.NullInt64             `json:"updated_by"`
 }
 
 // ============================================================================
@@ -75,6 +83,8 @@ func (q *Queries) CreateProjectSetting(ctx context.Context, arg CreateProjectSet
 		arg.ProjectID,
 		arg.SettingKey,
 		arg.SettingValue,
+		arg.ValueType,
+		arg.AllowedValues,
 		arg.Editable,
 		arg.Description,
 		arg.Status,
@@ -87,20 +97,22 @@ func (q *Queries) CreateProjectSetting(ctx context.Context, arg CreateProjectSet
 const createSiteSetting = `-- name: CreateSiteSetting :exec
 
 INSERT INTO site_settings (
-    public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+    public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateSiteSettingParams struct {
-	PublicID     string                 `json:"public_id"`
-	SiteID       int64                  `json:"site_id"`
-	SettingKey   string                 `json:"setting_key"`
-	SettingValue string                 `json:"setting_value"`
-	Editable     sql.NullBool           `json:"editable"`
-	Description  sql.NullString         `json:"description"`
-	Status       NullSiteSettingsStatus `json:"status"`
-	CreatedBy    sql.NullInt64          `json:"created_by"`
-	UpdatedBy    sql.NullInt64          `json:"updated_by"`
+	PublicID      string                 `json:"public_id"`
+	SiteID        int64                  `json:"site_id"`
+	SettingKey    string                 `json:"setting_key"`
+	SettingValue  string                 `json:"setting_value"`
+	ValueType     SiteSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON          `json:"allowed_values"`
+	Editable      sql.NullBool           `json:"editable"`
+	Description   sql.NullString         `json:"description"`
+	Status        NullSiteSettingsStatus `json:"status"`
+	CreatedBy     sql.NullInt64          `json:"created_by"`
+	UpdatedBy     sql.NullInt64          `json:"updated_by"`
 }
 
 // ============================================================================
@@ -112,6 +124,8 @@ func (q *Queries) CreateSiteSetting(ctx context.Context, arg CreateSiteSettingPa
 		arg.SiteID,
 		arg.SettingKey,
 		arg.SettingValue,
+		arg.ValueType,
+		arg.AllowedValues,
 		arg.Editable,
 		arg.Description,
 		arg.Status,
@@ -170,7 +184,7 @@ func (q *Queries) DeleteSiteSetting(ctx context.Context, arg DeleteSiteSettingPa
 }
 
 const getOrganizationSetting = `-- name: GetOrganizationSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE organization_id = ? AND setting_key = ? AND status != 'deleted'
 `
@@ -186,6 +200,8 @@ type GetOrganizationSettingRow struct {
 	OrganizationID int64                          `json:"organization_id"`
 	SettingKey     string                         `json:"setting_key"`
 	SettingValue   string                         `json:"setting_value"`
+	ValueType      OrganizationSettingsValueType  `json:"value_type"`
+	AllowedValues  types.RawJSON                  `json:"allowed_values"`
 	Editable       sql.NullBool                   `json:"editable"`
 	Description    sql.NullString                 `json:"description"`
 	Status         NullOrganizationSettingsStatus `json:"status"`
@@ -204,6 +220,8 @@ func (q *Queries) GetOrganizationSetting(ctx context.Context, arg GetOrganizatio
 		&i.OrganizationID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -216,7 +234,7 @@ func (q *Queries) GetOrganizationSetting(ctx context.Context, arg GetOrganizatio
 }
 
 const getOrganizationSettingByPublicID = `-- name: GetOrganizationSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
@@ -227,6 +245,8 @@ type GetOrganizationSettingByPublicIDRow struct {
 	OrganizationID int64                          `json:"organization_id"`
 	SettingKey     string                         `json:"setting_key"`
 	SettingValue   string                         `json:"setting_value"`
+	ValueType      OrganizationSettingsValueType  `json:"value_type"`
+	AllowedValues  types.RawJSON                  `json:"allowed_values"`
 	Editable       sql.NullBool                   `json:"editable"`
 	Description    sql.NullString                 `json:"description"`
 	Status         NullOrganizationSettingsStatus `json:"status"`
@@ -245,6 +265,8 @@ func (q *Queries) GetOrganizationSettingByPublicID(ctx context.Context, publicID
 		&i.OrganizationID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -257,7 +279,7 @@ func (q *Queries) GetOrganizationSettingByPublicID(ctx context.Context, publicID
 }
 
 const getProjectSetting = `-- name: GetProjectSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE project_id = ? AND setting_key = ? AND status != 'deleted'
 `
@@ -268,18 +290,20 @@ type GetProjectSettingParams struct {
 }
 
 type GetProjectSettingRow struct {
-	ID           int64                     `json:"id"`
-	PublicID     string                    `json:"public_id"`
-	ProjectID    int64                     `json:"project_id"`
-	SettingKey   string                    `json:"setting_key"`
-	SettingValue string                    `json:"setting_value"`
-	Editable     sql.NullBool              `json:"editable"`
-	Description  sql.NullString            `json:"description"`
-	Status       NullProjectSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime              `json:"created_at"`
-	UpdatedAt    sql.NullTime              `json:"updated_at"`
-	CreatedBy    sql.NullInt64             `json:"created_by"`
-	UpdatedBy    sql.NullInt64             `json:"updated_by"`
+	ID            int64                     `json:"id"`
+	PublicID      string                    `json:"public_id"`
+	ProjectID     int64                     `json:"project_id"`
+	SettingKey    string                    `json:"setting_key"`
+	SettingValue  string                    `json:"setting_value"`
+	ValueType     ProjectSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON             `json:"allowed_values"`
+	Editable      sql.NullBool              `json:"editable"`
+	Description   sql.NullString            `json:"description"`
+	Status        NullProjectSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime              `json:"created_at"`
+	UpdatedAt     sql.NullTime              `json:"updated_at"`
+	CreatedBy     sql.NullInt64             `json:"created_by"`
+	UpdatedBy     sql.NullInt64             `json:"updated_by"`
 }
 
 func (q *Queries) GetProjectSetting(ctx context.Context, arg GetProjectSettingParams) (GetProjectSettingRow, error) {
@@ -291,6 +315,8 @@ func (q *Queries) GetProjectSetting(ctx context.Context, arg GetProjectSettingPa
 		&i.ProjectID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -303,24 +329,26 @@ func (q *Queries) GetProjectSetting(ctx context.Context, arg GetProjectSettingPa
 }
 
 const getProjectSettingByPublicID = `-- name: GetProjectSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
 
 type GetProjectSettingByPublicIDRow struct {
-	ID           int64                     `json:"id"`
-	PublicID     string                    `json:"public_id"`
-	ProjectID    int64                     `json:"project_id"`
-	SettingKey   string                    `json:"setting_key"`
-	SettingValue string                    `json:"setting_value"`
-	Editable     sql.NullBool              `json:"editable"`
-	Description  sql.NullString            `json:"description"`
-	Status       NullProjectSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime              `json:"created_at"`
-	UpdatedAt    sql.NullTime              `json:"updated_at"`
-	CreatedBy    sql.NullInt64             `json:"created_by"`
-	UpdatedBy    sql.NullInt64             `json:"updated_by"`
+	ID            int64                     `json:"id"`
+	PublicID      string                    `json:"public_id"`
+	ProjectID     int64                     `json:"project_id"`
+	SettingKey    string                    `json:"setting_key"`
+	SettingValue  string                    `json:"setting_value"`
+	ValueType     ProjectSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON             `json:"allowed_values"`
+	Editable      sql.NullBool              `json:"editable"`
+	Description   sql.NullString            `json:"description"`
+	Status        NullProjectSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime              `json:"created_at"`
+	UpdatedAt     sql.NullTime              `json:"updated_at"`
+	CreatedBy     sql.NullInt64             `json:"created_by"`
+	UpdatedBy     sql.NullInt64             `json:"updated_by"`
 }
 
 func (q *Queries) GetProjectSettingByPublicID(ctx context.Context, publicID string) (GetProjectSettingByPublicIDRow, error) {
@@ -332,6 +360,8 @@ func (q *Queries) GetProjectSettingByPublicID(ctx context.Context, publicID stri
 		&i.ProjectID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -344,7 +374,7 @@ func (q *Queries) GetProjectSettingByPublicID(ctx context.Context, publicID stri
 }
 
 const getSiteSetting = `-- name: GetSiteSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE site_id = ? AND setting_key = ? AND status != 'deleted'
 `
@@ -355,18 +385,20 @@ type GetSiteSettingParams struct {
 }
 
 type GetSiteSettingRow struct {
-	ID           int64                  `json:"id"`
-	PublicID     string                 `json:"public_id"`
-	SiteID       int64                  `json:"site_id"`
-	SettingKey   string                 `json:"setting_key"`
-	SettingValue string                 `json:"setting_value"`
-	Editable     sql.NullBool           `json:"editable"`
-	Description  sql.NullString         `json:"description"`
-	Status       NullSiteSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime           `json:"created_at"`
-	UpdatedAt    sql.NullTime           `json:"updated_at"`
-	CreatedBy    sql.NullInt64          `json:"created_by"`
-	UpdatedBy    sql.NullInt64          `json:"updated_by"`
+	ID            int64                  `json:"id"`
+	PublicID      string                 `json:"public_id"`
+	SiteID        int64                  `json:"site_id"`
+	SettingKey    string                 `json:"setting_key"`
+	SettingValue  string                 `json:"setting_value"`
+	ValueType     SiteSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON          `json:"allowed_values"`
+	Editable      sql.NullBool           `json:"editable"`
+	Description   sql.NullString         `json:"description"`
+	Status        NullSiteSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime           `json:"created_at"`
+	UpdatedAt     sql.NullTime           `json:"updated_at"`
+	CreatedBy     sql.NullInt64          `json:"created_by"`
+	UpdatedBy     sql.NullInt64          `json:"updated_by"`
 }
 
 func (q *Queries) GetSiteSetting(ctx context.Context, arg GetSiteSettingParams) (GetSiteSettingRow, error) {
@@ -378,6 +410,8 @@ func (q *Queries) GetSiteSetting(ctx context.Context, arg GetSiteSettingParams)
 		&i.SiteID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -390,24 +424,26 @@ func (q *Queries) GetSiteSetting(ctx context.Context, arg GetSiteSettingParams)
 }
 
 const getSiteSettingByPublicID = `-- name: GetSiteSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
 
 type GetSiteSettingByPublicIDRow struct {
-	ID           int64                  `json:"id"`
-	PublicID     string                 `json:"public_id"`
-	SiteID       int64                  `json:"site_id"`
-	SettingKey   string                 `json:"setting_key"`
-	SettingValue string                 `json:"setting_value"`
-	Editable     sql.NullBool           `json:"editable"`
-	Description  sql.NullString         `json:"description"`
-	Status       NullSiteSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime           `json:"created_at"`
-	UpdatedAt    sql.NullTime           `json:"updated_at"`
-	CreatedBy    sql.NullInt64          `json:"created_by"`
-	UpdatedBy    sql.NullInt64          `json:"updated_by"`
+	ID            int64                  `json:"id"`
+	PublicID      string                 `json:"public_id"`
+	SiteID        int64                  `json:"site_id"`
+	SettingKey    string                 `json:"setting_key"`
+	SettingValue  string                 `json:"setting_value"`
+	ValueType     SiteSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON          `json:"allowed_values"`
+	Editable      sql.NullBool           `json:"editable"`
+	Description   sql.NullString         `json:"description"`
+	Status        NullSiteSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime           `json:"created_at"`
+	UpdatedAt     sql.NullTime           `json:"updated_at"`
+	CreatedBy     sql.NullInt64          `json:"created_by"`
+	UpdatedBy     sql.NullInt64          `json:"updated_by"`
 }
 
 func (q *Queries) GetSiteSettingByPublicID(ctx context.Context, publicID string) (GetSiteSettingByPublicIDRow, error) {
@@ -419,6 +455,8 @@ func (q *Queries) GetSiteSettingByPublicID(ctx context.Context, publicID string)
 		&i.SiteID,
 		&i.SettingKey,
 		&i.SettingValue,
+		&i.ValueType,
+		&i.AllowedValues,
 		&i.Editable,
 		&i.Description,
 		&i.Status,
@@ -431,7 +469,7 @@ func (q *Queries) GetSiteSettingByPublicID(ctx context.Context, publicID string)
 }
 
 const listOrganizationSettings = `-- name: ListOrganizationSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE organization_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -450,6 +488,8 @@ type ListOrganizationSettingsRow struct {
 	OrganizationID int64                          `json:"organization_id"`
 	SettingKey     string                         `json:"setting_key"`
 	SettingValue   string                         `json:"setting_value"`
+	ValueType      OrganizationSettingsValueType  `json:"value_type"`
+	AllowedValues  types.RawJSON                  `json:"allowed_values"`
 	Editable       sql.NullBool                   `json:"editable"`
 	Description    sql.NullString                 `json:"description"`
 	Status         NullOrganizationSettingsStatus `json:"status"`
@@ -474,6 +514,8 @@ func (q *Queries) ListOrganizationSettings(ctx context.Context, arg ListOrganiza
 			&i.OrganizationID,
 			&i.SettingKey,
 			&i.SettingValue,
+			&i.ValueType,
+			&i.AllowedValues,
 			&i.Editable,
 			&i.Description,
 			&i.Status,
@@ -496,7 +538,7 @@ func (q *Queries) ListOrganizationSettings(ctx context.Context, arg ListOrganiza
 }
 
 const listProjectSettings = `-- name: ListProjectSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE project_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -510,18 +552,20 @@ type ListProjectSettingsParams struct {
 }
 
 type ListProjectSettingsRow struct {
-	ID           int64                     `json:"id"`
-	PublicID     string                    `json:"public_id"`
-	ProjectID    int64                     `json:"project_id"`
-	SettingKey   string                    `json:"setting_key"`
-	SettingValue string                    `json:"setting_value"`
-	Editable     sql.NullBool              `json:"editable"`
-	Description  sql.NullString            `json:"description"`
-	Status       NullProjectSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime              `json:"created_at"`
-	UpdatedAt    sql.NullTime              `json:"updated_at"`
-	CreatedBy    sql.NullInt64             `json:"created_by"`
-	UpdatedBy    sql.NullInt64             `json:"updated_by"`
+	ID            int64                     `json:"id"`
+	PublicID      string                    `json:"public_id"`
+	ProjectID     int64                     `json:"project_id"`
+	SettingKey    string                    `json:"setting_key"`
+	SettingValue  string                    `json:"setting_value"`
+	ValueType     ProjectSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON             `json:"allowed_values"`
+	Editable      sql.NullBool              `json:"editable"`
+	Description   sql.NullString            `json:"description"`
+	Status        NullProjectSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime              `json:"created_at"`
+	UpdatedAt     sql.NullTime              `json:"updated_at"`
+	CreatedBy     sql.NullInt64             `json:"created_by"`
+	UpdatedBy     sql.NullInt64             `json:"updated_by"`
 }
 
 func (q *Queries) ListProjectSettings(ctx context.Context, arg ListProjectSettingsParams) ([]ListProjectSettingsRow, error) {
@@ -539,6 +583,8 @@ func (q *Queries) ListProjectSettings(ctx context.Context, arg ListProjectSettin
 			&i.ProjectID,
 			&i.SettingKey,
 			&i.SettingValue,
+			&i.ValueType,
+			&i.AllowedValues,
 			&i.Editable,
 			&i.Description,
 			&i.Status,
@@ -560,8 +606,66 @@ func (q *Queries) ListProjectSettings(ctx context.Context, arg ListProjectSettin
 	return items, nil
 }
 
+const listSiteInheritedSettings = `-- name: ListSiteInheritedSettings :many
+SELECT setting_key, setting_value FROM (
+    SELECT os.setting_key, os.setting_value, 1 AS precedence
+    FROM organization_settings os
+    JOIN projects p ON p.organization_id = os.organization_id
+    JOIN sites s ON s.project_id = p.id
+    WHERE s.id = ? AND os.status != 'deleted'
+
+    UNION ALL
+
+    SELECT ps.setting_key, ps.setting_value, 2 AS precedence
+    FROM project_settings ps
+    JOIN sites s ON s.project_id = ps.project_id
+    WHERE s.id = ? AND ps.status != 'deleted'
+
+    UNION ALL
+
+    SELECT ss.setting_key, ss.setting_value, 3 AS precedence
+    FROM site_settings ss
+    WHERE ss.site_id = ? AND ss.status != 'deleted'
+) AS inherited
+ORDER BY precedence ASC, setting_key ASC
+`
+
+type ListSiteInheritedSettingsParams struct {
+	SiteID int64 `json:"site_id"`
+}
+
+type ListSiteInheritedSettingsRow struct {
+	SettingKey   string `json:"setting_key"`
+	SettingValue string `json:"setting_value"`
+}
+
+// Settings that apply to a site, from its organization's up to its own, in the
+// order they override each other
+func (q *Queries) ListSiteInheritedSettings(ctx context.Context, arg ListSiteInheritedSettingsParams) ([]ListSiteInheritedSettingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSiteInheritedSettings, arg.SiteID, arg.SiteID, arg.SiteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSiteInheritedSettingsRow{}
+	for rows.Next() {
+		var i ListSiteInheritedSettingsRow
+		if err := rows.Scan(&i.SettingKey, &i.SettingValue); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSiteSettings = `-- name: ListSiteSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE site_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -575,18 +679,20 @@ type ListSiteSettingsParams struct {
 }
 
 type ListSiteSettingsRow struct {
-	ID           int64                  `json:"id"`
-	PublicID     string                 `json:"public_id"`
-	SiteID       int64                  `json:"site_id"`
-	SettingKey   string                 `json:"setting_key"`
-	SettingValue string                 `json:"setting_value"`
-	Editable     sql.NullBool           `json:"editable"`
-	Description  sql.NullString         `json:"description"`
-	Status       NullSiteSettingsStatus `json:"status"`
-	CreatedAt    sql.NullTime           `json:"created_at"`
-	UpdatedAt    sql.NullTime           `json:"updated_at"`
-	CreatedBy    sql.NullInt64          `json:"created_by"`
-	UpdatedBy    sql.NullInt64          `json:"updated_by"`
+	ID            int64                  `json:"id"`
+	PublicID      string                 `json:"public_id"`
+	SiteID        int64                  `json:"site_id"`
+	SettingKey    string                 `json:"setting_key"`
+	SettingValue  string                 `json:"setting_value"`
+	ValueType     SiteSettingsValueType  `json:"value_type"`
+	AllowedValues types.RawJSON          `json:"allowed_values"`
+	Editable      sql.NullBool           `json:"editable"`
+	Description   sql.NullString         `json:"description"`
+	Status        NullSiteSettingsStatus `json:"status"`
+	CreatedAt     sql.NullTime           `json:"created_at"`
+	UpdatedAt     sql.NullTime           `json:"updated_at"`
+	CreatedBy     sql.NullInt64          `json:"created_by"`
+	UpdatedBy     sql.NullInt64          `json:"updated_by"`
 }
 
 func (q *Queries) ListSiteSettings(ctx context.Context, arg ListSiteSettingsParams) ([]ListSiteSettingsRow, error) {
@@ -604,6 +710,8 @@ func (q *Queries) ListSiteSettings(ctx context.Context, arg ListSiteSettingsPara
 			&i.SiteID,
 			&i.SettingKey,
 			&i.SettingValue,
+			&i.ValueType,
+			&i.AllowedValues,
 			&i.Editable,
 			&i.Description,
 			&i.Status,
@@ -627,13 +735,8 @@ func (q *Queries) ListSiteSettings(ctx context.Context, arg ListSiteSettingsPara
 
 const listUserSettings = `-- name: ListUserSettings :many
 
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = ? AND reachable
 )
 SELECT id, public_id, setting_key, setting_value, editable, description, status, created_at, updated_at, parent_type, parent_name, parent_public_id FROM (
     SELECT
@@ -42,8 +42,8 @@ func (q *Queries) CreateDomain(ctx context.Context, arg CreateDomainParams) erro
 
 const createSite = `-- name: CreateSite :exec
 INSERT INTO sites (
-  public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(UUID_V7()), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
+  public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, labels, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(UUID_V7()), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateSiteParams struct {
@@ -64,6 +64,7 @@ type CreateSiteParams struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
 	UpdatedBy        sql.NullInt64   `json:"updated_by"`
 }
@@ -87,6 +88,7 @@ func (q *Queries) CreateSite(ctx context.Context, arg CreateSiteParams) error {
 		arg.IsProduction,
 		arg.GcpExternalIp,
 		arg.Status,
+		arg.Labels,
 		arg.CreatedBy,
 		arg.UpdatedBy,
 	)
@@ -124,20 +126,22 @@ const createSiteSecret = `-- name: CreateSiteSecret :execresult
 
 
 INSERT INTO site_secrets (
-    public_id, site_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?)
+    public_id, site_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateSiteSecretParams struct {
-	PublicID  string                `json:"public_id"`
-	SiteID    int64                 `json:"site_id"`
-	Name      string                `json:"name"`
-	VaultPath string                `json:"vault_path"`
-	Status    NullSiteSecretsStatus `json:"status"`
-	CreatedAt int64                 `json:"created_at"`
-	UpdatedAt int64                 `json:"updated_at"`
-	CreatedBy sql.NullInt64         `json:"created_by"`
-	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	PublicID   string                `json:"public_id"`
+	SiteID     int64                 `json:"site_id"`
+	Name       string                `json:"name"`
+	VaultPath  string                `json:"vault_path"`
+	Status     NullSiteSecretsStatus `json:"status"`
+	CreatedAt  int64                 `json:"created_at"`
+	UpdatedAt  int64                 `json:"updated_at"`
+	CreatedBy  sql.NullInt64         `json:"created_by"`
+	UpdatedBy  sql.NullInt64         `json:"updated_by"`
+	KeyVersion sql.NullInt32         `json:"key_version"`
+	Reference  sql.NullString        `json:"reference"`
 }
 
 // =============================================================================
@@ -154,6 +158,8 @@ func (q *Queries) CreateSiteSecret(ctx context.Context, arg CreateSiteSecretPara
 		arg.UpdatedAt,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.KeyVersion,
+		arg.Reference,
 	)
 }
 
@@ -252,8 +258,8 @@ func (q *Queries) GetDomainByName(ctx context.Context, domain string) (Domain, e
 const getSite = `-- name: GetSite :one
 
 
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM sites WHERE public_id = UUID_TO_BIN(?)
 `
 
@@ -277,10 +283,12 @@ type GetSiteRow struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedAt        sql.NullTime    `json:"created_at"`
 	UpdatedAt        sql.NullTime    `json:"updated_at"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
 	UpdatedBy        sql.NullInt64   `json:"updated_by"`
+	Version          int64           `json:"version"`
 }
 
 // =============================================================================
@@ -309,16 +317,18 @@ func (q *Queries) GetSite(ctx context.Context, publicID string) (GetSiteRow, err
 		&i.IsProduction,
 		&i.GcpExternalIp,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getSiteByID = `-- name: GetSiteByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, labels,
        created_at, updated_at, created_by, updated_by
 FROM sites WHERE id = ?
 `
@@ -343,6 +353,7 @@ type GetSiteByIDRow struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedAt        sql.NullTime    `json:"created_at"`
 	UpdatedAt        sql.NullTime    `json:"updated_at"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
@@ -372,6 +383,7 @@ func (q *Queries) GetSiteByID(ctx context.Context, id int64) (GetSiteByIDRow, er
 		&i.IsProduction,
 		&i.GcpExternalIp,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -381,7 +393,7 @@ func (q *Queries) GetSiteByID(ctx context.Context, id int64) (GetSiteByIDRow, er
 }
 
 const getSiteByShortUUID = `-- name: GetSiteByShortUUID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, ` + "`" + `name` + "`" + `, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, ` + "`" + `status` + "`" + `, labels,
        created_at, updated_at, created_by, updated_by
 FROM sites WHERE HEX(public_id) LIKE CONCAT(UPPER(?), '%') LIMIT 1
 `
@@ -406,6 +418,7 @@ type GetSiteByShortUUIDRow struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	CreatedAt        sql.NullTime    `json:"created_at"`
 	UpdatedAt        sql.NullTime    `json:"updated_at"`
 	CreatedBy        sql.NullInt64   `json:"created_by"`
@@ -435,6 +448,7 @@ func (q *Queries) GetSiteByShortUUID(ctx context.Context, shortUuid string) (Get
 		&i.IsProduction,
 		&i.GcpExternalIp,
 		&i.Status,
+		&i.Labels,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -548,8 +562,8 @@ FROM ssh_keys sk
 JOIN accounts a ON sk.account_id = a.id
 WHERE sk.account_id IN (
     -- Site members (owner/developer with active status)
-    SELECT account_id FROM site_members
-    WHERE site_id = ? AND role IN ('owner', 'developer') AND status = 'active'
+    SELECT sm.account_id FROM site_members sm
+    WHERE sm.site_id = ? AND sm.role IN ('owner', 'developer') AND sm.status = 'active'
 
     UNION
 
@@ -573,16 +587,24 @@ WHERE sk.account_id IN (
     JOIN relationships r ON r.source_organization_id = om.organization_id
     JOIN projects p ON p.organization_id = r.target_organization_id
     JOIN sites s ON s.project_id = p.id
-    WHERE s.id = ? AND r.status = 'approved'
+    WHERE s.id = ? AND r.status = 'approved' AND r.max_role IN ('owner', 'developer')
       AND om.role IN ('owner', 'developer') AND om.status = 'active'
+
+    UNION
+
+    -- Members elevated to owner/developer on the site until their role lapses
+    SELECT se.account_id FROM site_elevations se
+    WHERE se.site_id = ? AND se.` + "`" + `status` + "`" + ` = 'approved' AND se.expires_at > CURRENT_TIMESTAMP
 )
+AND (sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP)
 `
 
 type GetSiteSSHKeysForVMParams struct {
-	SiteID int64 `json:"site_id"`
-	ID     int64 `json:"id"`
-	ID_2   int64 `json:"id_2"`
-	ID_3   int64 `json:"id_3"`
+	SiteID   int64 `json:"site_id"`
+	ID       int64 `json:"id"`
+	ID_2     int64 `json:"id_2"`
+	ID_3     int64 `json:"id_3"`
+	SiteID_2 int64 `json:"site_id_2"`
 }
 
 type GetSiteSSHKeysForVMRow struct {
@@ -599,13 +621,15 @@ type GetSiteSSHKeysForVMRow struct {
 // MEMBERSHIP QUERIES FOR AUTHORIZATION
 // =============================================================================
 // Fetches all SSH keys that should be provisioned to a site VM
-// Includes keys from site members, project members, org members, and relationship members
+// Includes keys from site members, project members, org members, relationship members
+// and members elevated on the site
 func (q *Queries) GetSiteSSHKeysForVM(ctx context.Context, arg GetSiteSSHKeysForVMParams) ([]GetSiteSSHKeysForVMRow, error) {
 	rows, err := q.db.QueryContext(ctx, getSiteSSHKeysForVM,
 		arg.SiteID,
 		arg.ID,
 		arg.ID_2,
 		arg.ID_3,
+		arg.SiteID_2,
 	)
 	if err != nil {
 		return nil, err
@@ -637,7 +661,7 @@ func (q *Queries) GetSiteSSHKeysForVM(ctx context.Context, arg GetSiteSSHKeysFor
 }
 
 const getSiteSecretByID = `-- name: GetSiteSecretByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
        created_at, updated_at, created_by, updated_by
 FROM site_secrets WHERE id = ? AND status != 'deleted'
 `
@@ -649,6 +673,7 @@ type GetSiteSecretByIDRow struct {
 	Name      string                `json:"name"`
 	VaultPath string                `json:"vault_path"`
 	Status    NullSiteSecretsStatus `json:"status"`
+	Managed   bool                  `json:"managed"`
 	CreatedAt int64                 `json:"created_at"`
 	UpdatedAt int64                 `json:"updated_at"`
 	CreatedBy sql.NullInt64         `json:"created_by"`
@@ -665,6 +690,7 @@ func (q *Queries) GetSiteSecretByID(ctx context.Context, id int64) (GetSiteSecre
 		&i.Name,
 		&i.VaultPath,
 		&i.Status,
+		&i.Managed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -674,7 +700,7 @@ func (q *Queries) GetSiteSecretByID(ctx context.Context, id int64) (GetSiteSecre
 }
 
 const getSiteSecretByName = `-- name: GetSiteSecretByName :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
        created_at, updated_at, created_by, updated_by
 FROM site_secrets
 WHERE site_id = ? AND name = ? AND status != 'deleted'
@@ -692,6 +718,7 @@ type GetSiteSecretByNameRow struct {
 	Name      string                `json:"name"`
 	VaultPath string                `json:"vault_path"`
 	Status    NullSiteSecretsStatus `json:"status"`
+	Managed   bool                  `json:"managed"`
 	CreatedAt int64                 `json:"created_at"`
 	UpdatedAt int64                 `json:"updated_at"`
 	CreatedBy sql.NullInt64         `json:"created_by"`
@@ -708,6 +735,7 @@ func (q *Queries) GetSiteSecretByName(ctx context.Context, arg GetSiteSecretByNa
 		&i.Name,
 		&i.VaultPath,
 		&i.Status,
+		&i.Managed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -717,22 +745,25 @@ func (q *Queries) GetSiteSecretByName(ctx context.Context, arg GetSiteSecretByNa
 }
 
 const getSiteSecretByPublicID = `-- name: GetSiteSecretByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM site_secrets WHERE public_id = UUID_TO_BIN(?) AND status != 'deleted'
 `
 
 type GetSiteSecretByPublicIDRow struct {
-	ID        int64                 `json:"id"`
-	PublicID  string                `json:"public_id"`
-	SiteID    int64                 `json:"site_id"`
-	Name      string                `json:"name"`
-	VaultPath string                `json:"vault_path"`
-	Status    NullSiteSecretsStatus `json:"status"`
-	CreatedAt int64                 `json:"created_at"`
-	UpdatedAt int64                 `json:"updated_at"`
-	CreatedBy sql.NullInt64         `json:"created_by"`
-	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	ID         int64                 `json:"id"`
+	PublicID   string                `json:"public_id"`
+	SiteID     int64                 `json:"site_id"`
+	Name       string                `json:"name"`
+	VaultPath  string                `json:"vault_path"`
+	Status     NullSiteSecretsStatus `json:"status"`
+	Managed    bool                  `json:"managed"`
+	CreatedAt  int64                 `json:"created_at"`
+	UpdatedAt  int64                 `json:"updated_at"`
+	CreatedBy  sql.NullInt64         `json:"created_by"`
+	UpdatedBy  sql.NullInt64         `json:"updated_by"`
+	KeyVersion sql.NullInt32         `json:"key_version"`
+	Reference  sql.NullString        `json:"reference"`
 }
 
 func (q *Queries) GetSiteSecretByPublicID(ctx context.Context, publicID string) (GetSiteSecretByPublicIDRow, error) {
@@ -745,25 +776,28 @@ func (q *Queries) GetSiteSecretByPublicID(ctx context.Context, publicID string)
 		&i.Name,
 		&i.VaultPath,
 		&i.Status,
+		&i.Managed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
 		&i.UpdatedBy,
+		&i.KeyVersion,
+		&i.Reference,
 	)
 	return i, err
 }
 
 const getSiteSecretsForVM = `-- name: GetSiteSecretsForVM :many
-SELECT DISTINCT ss.name as ` + "`" + `key` + "`" + `, ss.vault_path as value
+SELECT DISTINCT ss.name as ` + "`" + `key` + "`" + `, ss.vault_path as value, ss.reference
 FROM site_secrets ss
 WHERE ss.site_id = ?
 UNION
-SELECT DISTINCT ps.name as ` + "`" + `key` + "`" + `, ps.vault_path as value
+SELECT DISTINCT ps.name as ` + "`" + `key` + "`" + `, ps.vault_path as value, ps.reference
 FROM project_secrets ps
 JOIN sites s ON s.project_id = ps.project_id
 WHERE s.id = ?
 UNION
-SELECT DISTINCT os.name as ` + "`" + `key` + "`" + `, os.vault_path as value
+SELECT DISTINCT os.name as ` + "`" + `key` + "`" + `, os.vault_path as value, os.reference
 FROM organization_secrets os
 JOIN projects p ON p.organization_id = os.organization_id
 JOIN sites st ON st.project_id = p.id
@@ -777,8 +811,9 @@ type GetSiteSecretsForVMParams struct {
 }
 
 type GetSiteSecretsForVMRow struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key       string         `json:"key"`
+	Value     string         `json:"value"`
+	Reference sql.NullString `json:"reference"`
 }
 
 // Fetches all secrets that should be provisioned to a site VM
@@ -792,7 +827,59 @@ func (q *Queries) GetSiteSecretsForVM(ctx context.Context, arg GetSiteSecretsFor
 	items := []GetSiteSecretsForVMRow{}
 	for rows.Next() {
 		var i GetSiteSecretsForVMRow
-		if err := rows.Scan(&i.Key, &i.Value); err != nil {
+		if err := rows.Scan(&i.Key, &i.Value, &i.Reference); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSiteSshAccessKeysForVM = `-- name: GetSiteSshAccessKeysForVM :many
+SELECT sk.public_key, sk.name, sk.fingerprint, a.github_username,
+       BIN_TO_UUID(a.public_id) AS account_public_id, sa.sftp_only
+FROM ssh_access sa
+JOIN site_members sm ON sm.site_id = sa.site_id AND sm.account_id = sa.account_id AND sm.status = 'active'
+JOIN accounts a ON a.id = sa.account_id
+JOIN ssh_keys sk ON sk.account_id = sa.account_id
+WHERE sa.site_id = ?
+  AND (sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP)
+`
+
+type GetSiteSshAccessKeysForVMRow struct {
+	PublicKey       string         `json:"public_key"`
+	Name            sql.NullString `json:"name"`
+	Fingerprint     sql.NullString `json:"fingerprint"`
+	GithubUsername  sql.NullString `json:"github_username"`
+	AccountPublicID string         `json:"account_public_id"`
+	SftpOnly        bool           `json:"sftp_only"`
+}
+
+// Fetches the SSH keys of accounts granted SSH access to a site, which lapses
+// with their site membership
+func (q *Queries) GetSiteSshAccessKeysForVM(ctx context.Context, siteID int64) ([]GetSiteSshAccessKeysForVMRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSiteSshAccessKeysForVM, siteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSiteSshAccessKeysForVMRow{}
+	for rows.Next() {
+		var i GetSiteSshAccessKeysForVMRow
+		if err := rows.Scan(
+			&i.PublicKey,
+			&i.Name,
+			&i.Fingerprint,
+			&i.GithubUsername,
+			&i.AccountPublicID,
+			&i.SftpOnly,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -904,8 +991,8 @@ func (q *Queries) ListSiteFirewallRules(ctx context.Context, siteID sql.NullInt6
 }
 
 const listSiteSecrets = `-- name: ListSiteSecrets :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
+       created_at, updated_at, created_by, updated_by, reference
 FROM site_secrets
 WHERE site_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -925,10 +1012,12 @@ type ListSiteSecretsRow struct {
 	Name      string                `json:"name"`
 	VaultPath string                `json:"vault_path"`
 	Status    NullSiteSecretsStatus `json:"status"`
+	Managed   bool                  `json:"managed"`
 	CreatedAt int64                 `json:"created_at"`
 	UpdatedAt int64                 `json:"updated_at"`
 	CreatedBy sql.NullInt64         `json:"created_by"`
 	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	Reference sql.NullString        `json:"reference"`
 }
 
 func (q *Queries) ListSiteSecrets(ctx context.Context, arg ListSiteSecretsParams) ([]ListSiteSecretsRow, error) {
@@ -947,10 +1036,12 @@ func (q *Queries) ListSiteSecrets(ctx context.Context, arg ListSiteSecretsParams
 			&i.Name,
 			&i.VaultPath,
 			&i.Status,
+			&i.Managed,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.Reference,
 		); err != nil {
 			return nil, err
 		}
@@ -968,8 +1059,8 @@ func (q *Queries) ListSiteSecrets(ctx context.Context, arg ListSiteSecretsParams
 const listSiteSshAccess = `-- name: ListSiteSshAccess :many
 
 
-SELECT sa.id, sa.account_id, sa.site_id, sa.created_at, sa.updated_at,
-       a.email, a.` + "`" + `name` + "`" + `, a.github_username
+SELECT sa.id, sa.account_id, sa.site_id, sa.sftp_only, sa.created_at, sa.updated_at,
+       a.email, a.` + "`" + `name` + "`" + `, a.github_username, BIN_TO_UUID(a.public_id) AS account_public_id
 FROM ssh_access sa
 JOIN accounts a ON sa.account_id = a.id
 WHERE sa.site_id = ?
@@ -984,14 +1075,16 @@ type ListSiteSshAccessParams struct {
 }
 
 type ListSiteSshAccessRow struct {
-	ID             int64          `json:"id"`
-	AccountID      int64          `json:"account_id"`
-	SiteID         int64          `json:"site_id"`
-	CreatedAt      sql.NullTime   `json:"created_at"`
-	UpdatedAt      sql.NullTime   `json:"updated_at"`
-	Email          string         `json:"email"`
-	Name           sql.NullString `json:"name"`
-	GithubUsername sql.NullString `json:"github_username"`
+	ID              int64          `json:"id"`
+	AccountID       int64          `json:"account_id"`
+	SiteID          int64          `json:"site_id"`
+	SftpOnly        bool           `json:"sftp_only"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	Email           string         `json:"email"`
+	Name            sql.NullString `json:"name"`
+	GithubUsername  sql.NullString `json:"github_username"`
+	AccountPublicID string         `json:"account_public_id"`
 }
 
 // =============================================================================
@@ -1010,11 +1103,13 @@ func (q *Queries) ListSiteSshAccess(ctx context.Context, arg ListSiteSshAccessPa
 			&i.ID,
 			&i.AccountID,
 			&i.SiteID,
+			&i.SftpOnly,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Email,
 			&i.Name,
 			&i.GithubUsername,
+			&i.AccountPublicID,
 		); err != nil {
 			return nil, err
 		}
@@ -1145,6 +1240,7 @@ JOIN (
       AND om_related.status = 'active'
       AND om_related.role IN ('owner', 'developer')
 ) AS authorized_accounts ON a.id = authorized_accounts.account_id
+WHERE sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP
 ORDER BY sk.created_at DESC
 `
 
@@ -1180,35 +1276,125 @@ func (q *Queries) ListSshKeysBySite(ctx context.Context, arg ListSshKeysBySitePa
 	return items, nil
 }
 
-const listUserSites = `-- name: ListUserSites :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+const listUserSiteLiveStatus = `-- name: ListUserSiteLiveStatus :many
+SELECT DISTINCT BIN_TO_UUID(s.public_id) AS public_id, s.status,
+       i.cause AS incident_cause,
+       d.id AS deployment_id, d.status AS deployment_status, d.github_run_url AS deployment_url, d.error_message AS deployment_error,
+       rc.run_id AS reconciliation_run_id, rc.status AS reconciliation_status, rc.error_message AS reconciliation_error
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = ? AND sm.status = 'active'
+LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = ? AND ea.reachable
+LEFT JOIN site_incidents i ON i.open_site_id = s.id
+LEFT JOIN deployments d ON d.id = (
+    SELECT d2.id FROM deployments d2
+    WHERE d2.site_id = BIN_TO_UUID(s.public_id)
+    ORDER BY d2.created_at DESC
+    LIMIT 1
 )
-SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.overlay_volumes, s.os, s.is_production, s.gcp_external_ip, s.status, s.created_at, s.updated_at, s.created_by, s.updated_by
+LEFT JOIN reconciliations rc ON rc.id = (
+    SELECT r2.id FROM reconciliations r2
+    WHERE r2.site_id = s.id
+       OR (r2.site_id IS NULL AND r2.project_id = s.project_id)
+       OR (r2.site_id IS NULL AND r2.project_id IS NULL AND r2.organization_id = p.organization_id)
+    ORDER BY r2.id DESC
+    LIMIT 1
+)
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
+AND (s.public_id = UUID_TO_BIN(?) OR ? IS NULL)
+ORDER BY public_id
+LIMIT 500
+`
+
+type ListUserSiteLiveStatusParams struct {
+	AccountID    int64          `json:"account_id"`
+	FilterSiteID sql.NullString `json:"filter_site_id"`
+}
+
+type ListUserSiteLiveStatusRow struct {
+	PublicID             string                    `json:"public_id"`
+	Status               NullSitesStatus           `json:"status"`
+	IncidentCause        NullSiteIncidentsCause    `json:"incident_cause"`
+	DeploymentID         sql.NullString            `json:"deployment_id"`
+	DeploymentStatus     NullDeploymentsStatus     `json:"deployment_status"`
+	DeploymentUrl        sql.NullString            `json:"deployment_url"`
+	DeploymentError      sql.NullString            `json:"deployment_error"`
+	ReconciliationRunID  sql.NullString            `json:"reconciliation_run_id"`
+	ReconciliationStatus NullReconciliationsStatus `json:"reconciliation_status"`
+	ReconciliationError  sql.NullString            `json:"reconciliation_error"`
+}
+
+// Current status, open incident, latest deployment and latest reconciliation of each site
+// the account can access, for the dashboard's live status stream. The latest reconciliation
+// includes organization and project runs, which reconcile every site beneath them.
+func (q *Queries) ListUserSiteLiveStatus(ctx context.Context, arg ListUserSiteLiveStatusParams) ([]ListUserSiteLiveStatusRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserSiteLiveStatus,
+		arg.AccountID,
+		arg.AccountID,
+		arg.AccountID,
+		arg.FilterSiteID,
+		arg.FilterSiteID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserSiteLiveStatusRow{}
+	for rows.Next() {
+		var i ListUserSiteLiveStatusRow
+		if err := rows.Scan(
+			&i.PublicID,
+			&i.Status,
+			&i.IncidentCause,
+			&i.DeploymentID,
+			&i.DeploymentStatus,
+			&i.DeploymentUrl,
+			&i.DeploymentError,
+			&i.ReconciliationRunID,
+			&i.ReconciliationStatus,
+			&i.ReconciliationError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSites = `-- name: ListUserSites :many
+SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.overlay_volumes, s.os, s.is_production, s.gcp_external_ip, s.status, s.labels, s.created_at, s.updated_at, s.created_by, s.updated_by,
+       h.score AS health_score, h.degraded_since AS health_degraded_since, h.computed_at AS health_computed_at
 FROM sites s
 JOIN projects p ON s.project_id = p.id
 JOIN organizations o ON p.organization_id = o.id
+LEFT JOIN site_health h ON h.site_id = s.id
 LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = ? AND sm.status = 'active'
 LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = ? AND ea.reachable
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = ? OR ? IS NULL)
 AND (s.project_id = ? OR ? IS NULL)
-ORDER BY s.created_at DESC
-LIMIT ? OFFSET ?
+AND (? IS NULL OR JSON_CONTAINS(s.labels, ?))
+AND (? IS NULL OR s.created_at < ? OR (s.created_at = ? AND s.id < ?))
+ORDER BY s.created_at DESC, s.id DESC
+LIMIT ?
 `
 
 type ListUserSitesParams struct {
-	AccountID            int64         `json:"account_id"`
-	FilterOrganizationID sql.NullInt64 `json:"filter_organization_id"`
-	FilterProjectID      sql.NullInt64 `json:"filter_project_id"`
-	Limit                int32         `json:"limit"`
-	Offset               int32         `json:"offset"`
+	AccountID            int64          `json:"account_id"`
+	FilterOrganizationID sql.NullInt64  `json:"filter_organization_id"`
+	FilterProjectID      sql.NullInt64  `json:"filter_project_id"`
+	LabelSelector        sql.NullString `json:"label_selector"`
+	CursorCreatedAt      sql.NullTime   `json:"cursor_created_at"`
+	CursorID             sql.NullInt64  `json:"cursor_id"`
+	Limit                int32          `json:"limit"`
 }
 
 type ListUserSitesRow struct {
@@ -1233,10 +1419,14 @@ type ListUserSitesRow struct {
 	IsProduction         sql.NullBool    `json:"is_production"`
 	GcpExternalIp        sql.NullString  `json:"gcp_external_ip"`
 	Status               NullSitesStatus `json:"status"`
+	Labels               types.RawJSON   `json:"labels"`
 	CreatedAt            sql.NullTime    `json:"created_at"`
 	UpdatedAt            sql.NullTime    `json:"updated_at"`
 	CreatedBy            sql.NullInt64   `json:"created_by"`
 	UpdatedBy            sql.NullInt64   `json:"updated_by"`
+	HealthScore          sql.NullInt32   `json:"health_score"`
+	HealthDegradedSince  sql.NullTime    `json:"health_degraded_since"`
+	HealthComputedAt     sql.NullTime    `json:"health_computed_at"`
 }
 
 func (q *Queries) ListUserSites(ctx context.Context, arg ListUserSitesParams) ([]ListUserSitesRow, error) {
@@ -1248,8 +1438,13 @@ func (q *Queries) ListUserSites(ctx context.Context, arg ListUserSitesParams) ([
 		arg.FilterOrganizationID,
 		arg.FilterProjectID,
 		arg.FilterProjectID,
+		arg.LabelSelector,
+		arg.LabelSelector,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -1280,10 +1475,14 @@ func (q *Queries) ListUserSites(ctx context.Context, arg ListUserSitesParams) ([
 			&i.IsProduction,
 			&i.GcpExternalIp,
 			&i.Status,
+			&i.Labels,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.HealthScore,
+			&i.HealthDegradedSince,
+			&i.HealthComputedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1298,7 +1497,7 @@ func (q *Queries) ListUserSites(ctx context.Context, arg ListUserSitesParams) ([
 	return items, nil
 }
 
-const updateSite = `-- name: UpdateSite :exec
+const updateSite = `-- name: UpdateSite :execrows
 UPDATE sites SET
   ` + "`" + `name` + "`" + ` = ?,
   github_repository = ?,
@@ -1316,9 +1515,11 @@ UPDATE sites SET
   is_production = ?,
   gcp_external_ip = ?,
   ` + "`" + `status` + "`" + ` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(?)
+WHERE public_id = UUID_TO_BIN(?) AND version = ?
 `
 
 type UpdateSiteParams struct {
@@ -1338,12 +1539,16 @@ type UpdateSiteParams struct {
 	IsProduction     sql.NullBool    `json:"is_production"`
 	GcpExternalIp    sql.NullString  `json:"gcp_external_ip"`
 	Status           NullSitesStatus `json:"status"`
+	Labels           types.RawJSON   `json:"labels"`
 	UpdatedBy        sql.NullInt64   `json:"updated_by"`
 	PublicID         string          `json:"public_id"`
+	Version          int64           `json:"version"`
 }
 
-func (q *Queries) UpdateSite(ctx context.Context, arg UpdateSiteParams) error {
-	_, err := q.db.ExecContext(ctx, updateSite,
+// Applies the change only if the site is still at the version it was read at;
+// no rows are affected when someone else changed it first
+func (q *Queries) UpdateSite(ctx context.Context, arg UpdateSiteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateSite,
 		arg.Name,
 		arg.GithubRepository,
 		arg.GithubRef,
@@ -1360,10 +1565,15 @@ func (q *Queries) UpdateSite(ctx context.Context, arg UpdateSiteParams) error {
 		arg.IsProduction,
 		arg.GcpExternalIp,
 		arg.Status,
+		arg.Labels,
 		arg.UpdatedBy,
 		arg.PublicID,
+		arg.Version,
 	)
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const updateSiteCheckIn = `-- name: UpdateSiteCheckIn :exec
@@ -1378,20 +1588,24 @@ func (q *Queries) UpdateSiteCheckIn(ctx context.Context, id int64) error {
 
 const updateSiteSecret = `-- name: UpdateSiteSecret :exec
 UPDATE site_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?
 `
 
 type UpdateSiteSecretParams struct {
-	VaultPath string        `json:"vault_path"`
-	UpdatedBy sql.NullInt64 `json:"updated_by"`
-	UpdatedAt int64         `json:"updated_at"`
-	ID        int64         `json:"id"`
+	VaultPath  string         `json:"vault_path"`
+	KeyVersion sql.NullInt32  `json:"key_version"`
+	Reference  sql.NullString `json:"reference"`
+	UpdatedBy  sql.NullInt64  `json:"updated_by"`
+	UpdatedAt  int64          `json:"updated_at"`
+	ID         int64          `json:"id"`
 }
 
 func (q *Queries) UpdateSiteSecret(ctx context.Context, arg UpdateSiteSecretParams) error {
 	_, err := q.db.ExecContext(ctx, updateSiteSecret,
 		arg.VaultPath,
+		arg.KeyVersion,
+		arg.Reference,
 		arg.UpdatedBy,
 		arg.UpdatedAt,
 		arg.ID,
@@ -10,15 +10,48 @@ import (
 	"database/sql"
 )
 
+const createEphemeralSshKey = `-- name: CreateEphemeralSshKey :exec
+INSERT INTO ssh_keys (
+  public_id, account_id, public_key, ` + "`" + `name` + "`" + `, fingerprint, expires_at, created_at, updated_at
+) VALUES (
+  UUID_TO_BIN(?), ?,
+  ?, ?, ?, ?,
+  CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+)
+`
+
+type CreateEphemeralSshKeyParams struct {
+	PublicID    string         `json:"public_id"`
+	AccountID   int64          `json:"account_id"`
+	PublicKey   string         `json:"public_key"`
+	Name        sql.NullString `json:"name"`
+	Fingerprint sql.NullString `json:"fingerprint"`
+	ExpiresAt   sql.NullTime   `json:"expires_at"`
+}
+
+// Short-lived key for a browser terminal session; it stops being served to VMs at expires_at
+func (q *Queries) CreateEphemeralSshKey(ctx context.Context, arg CreateEphemeralSshKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createEphemeralSshKey,
+		arg.PublicID,
+		arg.AccountID,
+		arg.PublicKey,
+		arg.Name,
+		arg.Fingerprint,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const createSshAccess = `-- name: CreateSshAccess :exec
 INSERT INTO ssh_access (
-  account_id, site_id, created_at, updated_at, created_by, updated_by
-) VALUES (?, ?, NOW(), NOW(), ?, ?)
+  account_id, site_id, sftp_only, created_at, updated_at, created_by, updated_by
+) VALUES (?, ?, ?, NOW(), NOW(), ?, ?)
 `
 
 type CreateSshAccessParams struct {
 	AccountID int64         `json:"account_id"`
 	SiteID    int64         `json:"site_id"`
+	SftpOnly  bool          `json:"sftp_only"`
 	CreatedBy sql.NullInt64 `json:"created_by"`
 	UpdatedBy sql.NullInt64 `json:"updated_by"`
 }
@@ -27,6 +60,7 @@ func (q *Queries) CreateSshAccess(ctx context.Context, arg CreateSshAccessParams
 	_, err := q.db.ExecContext(ctx, createSshAccess,
 		arg.AccountID,
 		arg.SiteID,
+		arg.SftpOnly,
 		arg.CreatedBy,
 		arg.UpdatedBy,
 	)
@@ -86,7 +120,7 @@ func (q *Queries) DeleteSshKey(ctx context.Context, publicID string) error {
 }
 
 const getSshAccess = `-- name: GetSshAccess :one
-SELECT id, account_id, site_id, created_at, updated_at, created_by, updated_by
+SELECT id, account_id, site_id, sftp_only, created_at, updated_at, created_by, updated_by
 FROM ssh_access WHERE account_id = ? AND site_id = ?
 `
 
@@ -95,13 +129,25 @@ type GetSshAccessParams struct {
 	SiteID    int64 `json:"site_id"`
 }
 
-func (q *Queries) GetSshAccess(ctx context.Context, arg GetSshAccessParams) (SshAccess, error) {
+type GetSshAccessRow struct {
+	ID        int64         `json:"id"`
+	AccountID int64         `json:"account_id"`
+	SiteID    int64         `json:"site_id"`
+	SftpOnly  bool          `json:"sftp_only"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+	CreatedBy sql.NullInt64 `json:"created_by"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+}
+
+func (q *Queries) GetSshAccess(ctx context.Context, arg GetSshAccessParams) (GetSshAccessRow, error) {
 	row := q.db.QueryRowContext(ctx, getSshAccess, arg.AccountID, arg.SiteID)
-	var i SshAccess
+	var i GetSshAccessRow
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.SiteID,
+		&i.SftpOnly,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.CreatedBy,
@@ -147,6 +193,28 @@ func (q *Queries) GetSshKey(ctx context.Context, publicID string) (GetSshKeyRow,
 	return i, err
 }
 
+const updateSshAccessSftpOnly = `-- name: UpdateSshAccessSftpOnly :exec
+UPDATE ssh_access SET sftp_only = ?, updated_by = ?
+WHERE account_id = ? AND site_id = ?
+`
+
+type UpdateSshAccessSftpOnlyParams struct {
+	SftpOnly  bool          `json:"sftp_only"`
+	UpdatedBy sql.NullInt64 `json:"updated_by"`
+	AccountID int64         `json:"account_id"`
+	SiteID    int64         `json:"site_id"`
+}
+
+func (q *Queries) UpdateSshAccessSftpOnly(ctx context.Context, arg UpdateSshAccessSftpOnlyParams) error {
+	_, err := q.db.ExecContext(ctx, updateSshAccessSftpOnly,
+		arg.SftpOnly,
+		arg.UpdatedBy,
+		arg.AccountID,
+		arg.SiteID,
+	)
+	return err
+}
+
 const updateSshKey = `-- name: UpdateSshKey :execresult
 UPDATE ssh_keys SET
   ` + "`" + `name` + "`" + ` = ?,
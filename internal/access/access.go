@@ -0,0 +1,271 @@
+// Package access keeps the materialized effective_organization_access table
+// current. Triggers mark an account stale whenever one of its memberships or a
+// relationship it reaches through changes; the Querier refreshes a stale account
+// before any listing or authorization read of its access, and Sweep drains the
+// rest in the background, so reads never resolve relationship chains themselves.
+package access
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+)
+
+// sweepBatch caps how many stale accounts are refreshed per sweep.
+const sweepBatch = 500
+
+var refreshes = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "libops_effective_access_refreshes_total",
+		Help: "Total number of effective access refreshes by what asked for them",
+	},
+	[]string{"trigger"}, // read, sweep
+)
+
+// Querier wraps a db.Querier and makes sure an account's effective access is
+// current before the reads that depend on it. Everything else passes through.
+type Querier struct {
+	db.Querier
+	// primary runs the refresh transactions
+	primary *sql.DB
+}
+
+var _ db.Querier = (*Querier)(nil)
+
+// NewQuerier creates a Querier that refreshes stale accounts on primary.
+func NewQuerier(next db.Querier, primary *sql.DB) *Querier {
+	return &Querier{Querier: next, primary: primary}
+}
+
+// GetDB exposes the wrapped DBTX so raw-SQL callers keep working.
+func (q *Querier) GetDB() db.DBTX {
+	if p, ok := q.Querier.(db.DBProvider); ok {
+		return p.GetDB()
+	}
+	return nil
+}
+
+// Refresh recomputes an account's effective access if it's marked stale and
+// reports whether it did. The stale marker stays locked until the new rows are
+// committed, so a change made meanwhile marks the account again afterwards
+// rather than being lost. Inside a request's transaction the refresh joins it,
+// since that transaction may already hold the stale marker's lock.
+func (q *Querier) Refresh(ctx context.Context, accountID int64) (bool, error) {
+	if database.InTx(ctx) {
+		return refresh(ctx, q.Querier, accountID)
+	}
+
+	tx, err := q.primary.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin effective access refresh: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ok, err := refresh(ctx, db.New(tx), accountID)
+	if !ok || err != nil {
+		return ok, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit effective access refresh: %w", err)
+	}
+	return true, nil
+}
+
+// refresh recomputes an account's effective access with qtx, which must run in
+// a transaction.
+func refresh(ctx context.Context, qtx db.Querier, accountID int64) (bool, error) {
+	if _, err := qtx.LockEffectiveAccessStale(ctx, accountID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Someone else refreshed it first
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to lock stale effective access: %w", err)
+	}
+
+	rows, err := qtx.ComputeEffectiveOrganizationAccess(ctx, accountID)
+	if err != nil {
+		return false, fmt.Errorf("failed to compute effective access: %w", err)
+	}
+	if err := qtx.DeleteEffectiveOrganizationAccess(ctx, accountID); err != nil {
+		return false, fmt.Errorf("failed to clear effective access: %w", err)
+	}
+	for _, row := range rows {
+		if err := qtx.InsertEffectiveOrganizationAccess(ctx, db.InsertEffectiveOrganizationAccessParams{
+			AccountID:      accountID,
+			OrganizationID: row.OrganizationID,
+			Reachable:      row.Reachable != 0,
+			InheritedRead:  row.InheritedRead != 0,
+		}); err != nil {
+			return false, fmt.Errorf("failed to store effective access: %w", err)
+		}
+	}
+	if err := qtx.ClearEffectiveAccessStale(ctx, accountID); err != nil {
+		return false, fmt.Errorf("failed to clear stale effective access: %w", err)
+	}
+	return true, nil
+}
+
+// fresh refreshes the account if it's stale. The returned context sends reads
+// to the primary after a refresh, since a replica won't have the new rows yet.
+func (q *Querier) fresh(ctx context.Context, accountID int64) (context.Context, error) {
+	stale, err := q.Querier.IsEffectiveAccessStale(ctx, accountID)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to check effective access: %w", err)
+	}
+	if !stale {
+		return ctx, nil
+	}
+
+	refreshes.WithLabelValues("read").Inc()
+	if _, err := q.Refresh(ctx, accountID); err != nil {
+		slog.Error("Failed to refresh effective access", "error", err, "account_id", accountID)
+		return ctx, err
+	}
+	return database.WithPrimary(ctx), nil
+}
+
+// Sweep refreshes up to a batch of stale accounts, longest waiting first, and
+// returns how many it refreshed.
+func (q *Querier) Sweep(ctx context.Context) (int, error) {
+	accountIDs, err := q.Querier.ListStaleEffectiveAccessAccounts(ctx, sweepBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stale effective access: %w", err)
+	}
+
+	refreshed := 0
+	for _, accountID := range accountIDs {
+		ok, err := q.Refresh(ctx, accountID)
+		if err != nil {
+			slog.Error("Failed to refresh effective access", "error", err, "account_id", accountID)
+			continue
+		}
+		if ok {
+			refreshes.WithLabelValues("sweep").Inc()
+			refreshed++
+		}
+	}
+	return refreshed, nil
+}
+
+// The reads below join effective_organization_access, so each refreshes the
+// account first.
+
+func (q *Querier) GetEffectiveOrganizationAccess(ctx context.Context, arg db.GetEffectiveOrganizationAccessParams) (db.GetEffectiveOrganizationAccessRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return db.GetEffectiveOrganizationAccessRow{}, err
+	}
+	return q.Querier.GetEffectiveOrganizationAccess(ctx, arg)
+}
+
+func (q *Querier) HasUserRelationshipAccessToOrganization(ctx context.Context, arg db.HasUserRelationshipAccessToOrganizationParams) (bool, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return false, err
+	}
+	return q.Querier.HasUserRelationshipAccessToOrganization(ctx, arg)
+}
+
+func (q *Querier) ListOrganizations(ctx context.Context, arg db.ListOrganizationsParams) ([]db.ListOrganizationsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListOrganizations(ctx, arg)
+}
+
+func (q *Querier) ListUserFirewallRules(ctx context.Context, arg db.ListUserFirewallRulesParams) ([]db.ListUserFirewallRulesRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserFirewallRules(ctx, arg)
+}
+
+func (q *Querier) ListUserMemberships(ctx context.Context, arg db.ListUserMembershipsParams) ([]db.ListUserMembershipsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserMemberships(ctx, arg)
+}
+
+func (q *Querier) ListUserOrganizations(ctx context.Context, arg db.ListUserOrganizationsParams) ([]db.ListUserOrganizationsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserOrganizations(ctx, arg)
+}
+
+func (q *Querier) ListUserProjects(ctx context.Context, arg db.ListUserProjectsParams) ([]db.ListUserProjectsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserProjects(ctx, arg)
+}
+
+func (q *Querier) ListUserProjectsWithOrg(ctx context.Context, arg db.ListUserProjectsWithOrgParams) ([]db.ListUserProjectsWithOrgRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserProjectsWithOrg(ctx, arg)
+}
+
+func (q *Querier) ListUserSecrets(ctx context.Context, arg db.ListUserSecretsParams) ([]db.ListUserSecretsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserSecrets(ctx, arg)
+}
+
+func (q *Querier) ListUserSettings(ctx context.Context, arg db.ListUserSettingsParams) ([]db.ListUserSettingsRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserSettings(ctx, arg)
+}
+
+func (q *Querier) ListUserSiteLiveStatus(ctx context.Context, arg db.ListUserSiteLiveStatusParams) ([]db.ListUserSiteLiveStatusRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserSiteLiveStatus(ctx, arg)
+}
+
+func (q *Querier) ListUserSites(ctx context.Context, arg db.ListUserSitesParams) ([]db.ListUserSitesRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserSites(ctx, arg)
+}
+
+func (q *Querier) ListUserSitesWithProject(ctx context.Context, arg db.ListUserSitesWithProjectParams) ([]db.ListUserSitesWithProjectRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.ListUserSitesWithProject(ctx, arg)
+}
+
+func (q *Querier) SearchUserResources(ctx context.Context, arg db.SearchUserResourcesParams) ([]db.SearchUserResourcesRow, error) {
+	ctx, err := q.fresh(ctx, arg.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return q.Querier.SearchUserResources(ctx, arg)
+}
@@ -0,0 +1,103 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+)
+
+// newRouted creates a Querier over a router with mocked primary and replica
+// pools.
+func newRouted(t *testing.T) (*Querier, sqlmock.Sqlmock, sqlmock.Sqlmock) {
+	t.Helper()
+	primary, primaryMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = primary.Close() })
+	replica, replicaMock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = replica.Close() })
+
+	return NewQuerier(db.New(database.NewRouter(primary, replica)), primary), primaryMock, replicaMock
+}
+
+// expectRefresh expects the refresh transaction for account 7, which reaches
+// organization 1 and inherits read on organization 2.
+func expectRefresh(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM effective_access_stale WHERE account_id = \\? FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(7))
+	mock.ExpectQuery("WITH RECURSIVE reach").
+		WithArgs(7, 7, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"organization_id", "reachable", "inherited_read"}).
+			AddRow(1, 1, 0).
+			AddRow(2, 0, 1))
+	mock.ExpectExec("DELETE FROM effective_organization_access").WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("INSERT INTO effective_organization_access").WithArgs(7, 1, true, false).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO effective_organization_access").WithArgs(7, 2, false, true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM effective_access_stale").WithArgs(7).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+// TestQuerierRefreshesStaleAccounts tests that a stale account is refreshed
+// before a listing and the listing then reads from the primary, while a fresh
+// account's listing goes straight to the replica.
+func TestQuerierRefreshesStaleAccounts(t *testing.T) {
+	q, primary, replica := newRouted(t)
+	columns := []string{"id", "public_id", "name", "role", "created_at"}
+	list := func() []db.ListUserOrganizationsRow {
+		rows, err := q.ListUserOrganizations(context.Background(), db.ListUserOrganizationsParams{AccountID: 7, Limit: 10})
+		require.NoError(t, err)
+		return rows
+	}
+
+	replica.ExpectQuery("FROM effective_access_stale").WithArgs(7).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	expectRefresh(primary)
+	primary.ExpectQuery("FROM effective_organization_access ea").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "org-1", "Library", "owner", nil))
+	assert.Len(t, list(), 1)
+
+	replica.ExpectQuery("FROM effective_access_stale").WithArgs(7).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	replica.ExpectQuery("FROM effective_organization_access ea").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "org-1", "Library", "owner", nil))
+	assert.Len(t, list(), 1)
+
+	assert.NoError(t, primary.ExpectationsWereMet())
+	assert.NoError(t, replica.ExpectationsWereMet())
+}
+
+// TestRefreshSkipsRefreshedAccounts tests that an account someone else
+// refreshed first is left alone.
+func TestRefreshSkipsRefreshedAccounts(t *testing.T) {
+	q, primary, _ := newRouted(t)
+
+	primary.ExpectBegin()
+	primary.ExpectQuery("FOR UPDATE").WithArgs(7).WillReturnRows(sqlmock.NewRows([]string{"account_id"}))
+	primary.ExpectRollback()
+
+	refreshed, err := q.Refresh(context.Background(), 7)
+	require.NoError(t, err)
+	assert.False(t, refreshed)
+	assert.NoError(t, primary.ExpectationsWereMet())
+}
+
+// TestSweep tests that a sweep refreshes the stale accounts it lists.
+func TestSweep(t *testing.T) {
+	q, primary, replica := newRouted(t)
+
+	replica.ExpectQuery("FROM effective_access_stale").WithArgs(sweepBatch).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}).AddRow(7))
+	expectRefresh(primary)
+
+	refreshed, err := q.Sweep(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshed)
+	assert.NoError(t, primary.ExpectationsWereMet())
+	assert.NoError(t, replica.ExpectationsWereMet())
+}
@@ -0,0 +1,143 @@
+package access
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+)
+
+// benchMembers is the size of the organization the benchmarks list from.
+const benchMembers = 10000
+
+// recursiveUserSites is ListUserSitesWithProject as it was before effective
+// access was materialized, resolving relationship chains on every call.
+const recursiveUserSites = `WITH RECURSIVE user_orgs AS (
+    SELECT organization_id FROM organization_members WHERE organization_members.account_id = ? AND organization_members.status = 'active'
+    UNION DISTINCT
+    SELECT r.target_organization_id
+    FROM relationships r
+    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
+    WHERE r.status = 'approved'
+)
+SELECT DISTINCT s.id
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = ? AND sm.status = 'active'
+LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = ? AND pm.status = 'active'
+LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+ORDER BY s.created_at DESC
+LIMIT 50`
+
+// BenchmarkListUserSites compares listing a member's sites from the
+// materialized table against the recursive query, in an organization with
+// benchMembers members that reaches two more through relationships. It needs
+// a disposable MariaDB in DATABASE_URL, e.g.
+// root:password@tcp(localhost:3306)/bench?parseTime=true&multiStatements=true
+func BenchmarkListUserSites(b *testing.B) {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		b.Skip("DATABASE_URL not set, skipping effective access benchmark")
+	}
+	pool, err := sql.Open("mysql", dbURL)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() { _ = pool.Close() }()
+	if err := database.Migrate(pool); err != nil {
+		b.Fatal(err)
+	}
+
+	ctx := context.Background()
+	accountID := seedBenchOrganization(b, pool)
+	q := NewQuerier(db.New(pool), pool)
+	if _, err := q.Refresh(ctx, accountID); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("materialized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := q.ListUserSitesWithProject(ctx, db.ListUserSitesWithProjectParams{AccountID: accountID, Limit: 50}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("recursive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			rows, err := pool.QueryContext(ctx, recursiveUserSites, accountID, accountID, accountID)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_ = rows.Close()
+		}
+	})
+}
+
+// seedBenchOrganization creates an organization with benchMembers members and
+// a chain of two related organizations with projects and sites, and returns
+// one member's account ID.
+func seedBenchOrganization(b *testing.B, pool *sql.DB) int64 {
+	b.Helper()
+	run := fmt.Sprintf("bench-%d", os.Getpid())
+	exec := func(query string, args ...any) sql.Result {
+		result, err := pool.Exec(query, args...)
+		if err != nil {
+			b.Fatalf("seeding: %v", err)
+		}
+		return result
+	}
+	id := func(result sql.Result) int64 {
+		id, err := result.LastInsertId()
+		if err != nil {
+			b.Fatal(err)
+		}
+		return id
+	}
+
+	var orgIDs []int64
+	for i := range 3 {
+		orgIDs = append(orgIDs, id(exec(`INSERT INTO organizations (public_id, name, gcp_org_id, gcp_billing_account, gcp_parent)
+			VALUES (UUID_TO_BIN(UUID()), ?, '0', '0', 'folders/0')`, fmt.Sprintf("%s-%d", run, i))))
+	}
+	for i := 1; i < len(orgIDs); i++ {
+		exec(`INSERT INTO relationships (public_id, source_organization_id, target_organization_id, relationship_type, status)
+			VALUES (UUID_TO_BIN(UUID()), ?, ?, 'access', 'approved')`, orgIDs[i-1], orgIDs[i])
+	}
+	for _, orgID := range orgIDs {
+		for p := range 20 {
+			projectID := id(exec(`INSERT INTO projects (public_id, organization_id, name) VALUES (UUID_TO_BIN(UUID()), ?, ?)`,
+				orgID, fmt.Sprintf("%s-%d-%d", run, orgID, p)))
+			for s := range 10 {
+				exec(`INSERT INTO sites (public_id, project_id, name, github_repository) VALUES (UUID_TO_BIN(UUID()), ?, ?, 'libops/bench')`,
+					projectID, fmt.Sprintf("site-%d", s))
+			}
+		}
+	}
+
+	const batch = 1000
+	for start := 0; start < benchMembers; start += batch {
+		values := make([]string, 0, batch)
+		args := make([]any, 0, batch)
+		for i := start; i < start+batch; i++ {
+			values = append(values, "(UUID_TO_BIN(UUID()), ?)")
+			args = append(args, fmt.Sprintf("%s-%d@example.edu", run, i))
+		}
+		exec(`INSERT INTO accounts (public_id, email) VALUES `+strings.Join(values, ", "), args...)
+	}
+	exec(`INSERT INTO organization_members (public_id, organization_id, account_id, role, status)
+		SELECT UUID_TO_BIN(UUID()), ?, id, 'developer', 'active' FROM accounts WHERE email LIKE ?`, orgIDs[0], run+"-%")
+
+	var accountID int64
+	if err := pool.QueryRow(`SELECT id FROM accounts WHERE email = ?`, run+"-0@example.edu").Scan(&accountID); err != nil {
+		b.Fatal(err)
+	}
+	return accountID
+}
@@ -0,0 +1,148 @@
+package apiversion
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Unary serves an older version's method from a newer version's
+// implementation, so a breaking change is written once and the old surface
+// stays a thin shim until its sunset. upgrade turns the old request into the
+// new one and downgrade turns the new response back; headers pass through
+// both ways, and errors from the implementation are returned unchanged.
+//
+//	func (s *v1Sites) ListSites(ctx context.Context, req *connect.Request[libopsv1.ListSitesRequest]) (*connect.Response[libopsv1.ListSitesResponse], error) {
+//		return apiversion.Unary(s.v2.ListSites,
+//			apiversion.ByName[*libopsv1.ListSitesRequest, libopsv2.ListSitesRequest](nil),
+//			apiversion.ByName[*libopsv2.ListSitesResponse, libopsv1.ListSitesResponse](nil),
+//		)(ctx, req)
+//	}
+func Unary[OldReq, OldRes, NewReq, NewRes any](
+	impl func(context.Context, *connect.Request[NewReq]) (*connect.Response[NewRes], error),
+	upgrade func(*OldReq) (*NewReq, error),
+	downgrade func(*NewRes) (*OldRes, error),
+) func(context.Context, *connect.Request[OldReq]) (*connect.Response[OldRes], error) {
+	return func(ctx context.Context, req *connect.Request[OldReq]) (*connect.Response[OldRes], error) {
+		msg, err := upgrade(req.Msg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		newReq := connect.NewRequest(msg)
+		for k, v := range req.Header() {
+			newReq.Header()[k] = v
+		}
+
+		res, err := impl(ctx, newReq)
+		if err != nil {
+			return nil, err
+		}
+		out, err := downgrade(res.Msg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		oldRes := connect.NewResponse(out)
+		for k, v := range res.Header() {
+			oldRes.Header()[k] = v
+		}
+		for k, v := range res.Trailer() {
+			oldRes.Trailer()[k] = v
+		}
+		return oldRes, nil
+	}
+}
+
+// ByName returns an upgrade or downgrade function for Unary that converts
+// messages with Convert. renames maps top-level field names of From to their
+// names in To.
+func ByName[From proto.Message, To any, PTo interface {
+	*To
+	proto.Message
+}](renames map[string]string) func(From) (PTo, error) {
+	return func(src From) (PTo, error) {
+		dst := PTo(new(To))
+		if err := Convert(src, dst, renames); err != nil {
+			return nil, err
+		}
+		return dst, nil
+	}
+}
+
+// Convert copies the fields src and dst have in common into dst, matching
+// them by name after applying renames (src name to dst name) at the top
+// level. Nested messages are converted the same way, so a message that moved
+// between packages converts as long as its fields line up. Fields dst doesn't
+// have are dropped; a field whose kind or cardinality changed is an error,
+// since that's a change a shim has to handle by hand.
+func Convert(src, dst proto.Message, renames map[string]string) error {
+	return convert(src.ProtoReflect(), dst.ProtoReflect(), renames)
+}
+
+func convert(src, dst protoreflect.Message, renames map[string]string) error {
+	fields := dst.Descriptor().Fields()
+	var err error
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if to, ok := renames[name]; ok {
+			name = to
+		}
+		dfd := fields.ByName(protoreflect.Name(name))
+		if dfd == nil {
+			return true
+		}
+		err = convertField(fd, v, dst, dfd)
+		return err == nil
+	})
+	return err
+}
+
+func convertField(fd protoreflect.FieldDescriptor, v protoreflect.Value, dst protoreflect.Message, dfd protoreflect.FieldDescriptor) error {
+	if fd.Cardinality() != dfd.Cardinality() || fd.IsMap() != dfd.IsMap() || fd.Kind() != dfd.Kind() {
+		return fmt.Errorf("%s can't be converted to %s", fd.FullName(), dfd.FullName())
+	}
+
+	switch {
+	case fd.IsMap():
+		if fd.MapKey().Kind() != dfd.MapKey().Kind() || fd.MapValue().Kind() != dfd.MapValue().Kind() {
+			return fmt.Errorf("%s can't be converted to %s", fd.FullName(), dfd.FullName())
+		}
+		out := dst.Mutable(dfd).Map()
+		var err error
+		v.Map().Range(func(k protoreflect.MapKey, item protoreflect.Value) bool {
+			if fd.MapValue().Message() == nil {
+				out.Set(k, item)
+				return true
+			}
+			elem := out.NewValue()
+			if err = convert(item.Message(), elem.Message(), nil); err != nil {
+				return false
+			}
+			out.Set(k, elem)
+			return true
+		})
+		return err
+	case fd.IsList():
+		out := dst.Mutable(dfd).List()
+		in := v.List()
+		for i := 0; i < in.Len(); i++ {
+			if fd.Message() == nil {
+				out.Append(in.Get(i))
+				continue
+			}
+			elem := out.NewElement()
+			if err := convert(in.Get(i).Message(), elem.Message(), nil); err != nil {
+				return err
+			}
+			out.Append(elem)
+		}
+		return nil
+	case fd.Message() != nil:
+		return convert(v.Message(), dst.Mutable(dfd).Message(), nil)
+	default:
+		dst.Set(dfd, v)
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package apiversion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	adminv1 "github.com/libops/api/proto/libops/v1/admin"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("same-named fields", func(t *testing.T) {
+		src := &libopsv1.ListSitesRequest{PageSize: 10, PageToken: "next"}
+		dst := &libopsv1.ListProjectsRequest{}
+		require.NoError(t, Convert(src, dst, nil))
+		assert.Equal(t, int32(10), dst.PageSize)
+		assert.Equal(t, "next", dst.PageToken)
+	})
+
+	t.Run("renames", func(t *testing.T) {
+		src := &libopsv1.GetSiteRequest{SiteId: "site-1"}
+		dst := &libopsv1.GetProjectRequest{}
+		require.NoError(t, Convert(src, dst, map[string]string{"site_id": "project_id"}))
+		assert.Equal(t, "site-1", dst.ProjectId)
+	})
+
+	t.Run("nested messages, lists and maps", func(t *testing.T) {
+		src := &libopsv1.ListOrganizationsResponse{
+			Organizations: []*commonv1.FolderConfig{{OrganizationId: "org-1", Labels: map[string]string{"env": "prod"}}},
+			NextPageToken: "next",
+		}
+		dst := &libopsv1.ListOrganizationsResponse{}
+		require.NoError(t, Convert(src, dst, nil))
+		require.Len(t, dst.Organizations, 1)
+		assert.Equal(t, "org-1", dst.Organizations[0].OrganizationId)
+		assert.Equal(t, "next", dst.NextPageToken)
+
+		update := &libopsv1.UpdateSiteRequest{SiteId: "site-1", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"github_ref"}}}
+		out := &libopsv1.UpdateSiteRequest{}
+		require.NoError(t, Convert(update, out, nil))
+		assert.Equal(t, []string{"github_ref"}, out.UpdateMask.GetPaths())
+
+		folder := &adminv1.AdminFolderConfig{Config: &commonv1.FolderConfig{OrganizationId: "org-1", Labels: map[string]string{"env": "prod"}}}
+		copied := &adminv1.AdminFolderConfig{}
+		require.NoError(t, Convert(folder, copied, nil))
+		assert.Equal(t, "prod", copied.Config.Labels["env"])
+	})
+
+	t.Run("kind changes are errors", func(t *testing.T) {
+		src := &libopsv1.GetSiteRequest{SiteId: "site-1"}
+		dst := &libopsv1.ListSitesRequest{}
+		err := Convert(src, dst, map[string]string{"site_id": "page_size"})
+		assert.Error(t, err)
+	})
+}
+
+func TestUnary(t *testing.T) {
+	newer := func(ctx context.Context, req *connect.Request[libopsv1.GetProjectRequest]) (*connect.Response[libopsv1.GetProjectResponse], error) {
+		if req.Msg.ProjectId == "missing" {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("no such project"))
+		}
+		assert.Equal(t, "Bearer key", req.Header().Get("Authorization"))
+		res := connect.NewResponse(&libopsv1.GetProjectResponse{})
+		res.Header().Set("X-Test", "yes")
+		return res, nil
+	}
+	older := Unary(newer,
+		ByName[*libopsv1.GetSiteRequest, libopsv1.GetProjectRequest](map[string]string{"site_id": "project_id"}),
+		ByName[*libopsv1.GetProjectResponse, libopsv1.GetSiteResponse](nil),
+	)
+
+	req := connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: "proj-1"})
+	req.Header().Set("Authorization", "Bearer key")
+	res, err := older(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, res.Msg)
+	assert.Equal(t, "yes", res.Header().Get("X-Test"))
+
+	_, err = older(context.Background(), connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,208 @@
+// Package apiversion mounts the API's Connect services by proto package
+// version and tells clients where each version is in its lifecycle.
+//
+// A version is a proto package (libops.v1, libops.v1beta, libops.v2), and
+// every procedure already names its package (/libops.v1.SiteService/GetSite),
+// so old and new versions of a service are mounted side by side on the same
+// mux. Responses from a deprecated version carry Deprecation (RFC 9745),
+// Sunset (RFC 8594) and successor Link headers; once its sunset date passes,
+// the version is refused. GET /versions lists what the server mounts, so a
+// client built against several versions negotiates by picking the newest one
+// it knows that isn't sunset.
+package apiversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Header names set on every response from a versioned service.
+const (
+	HeaderVersion     = "Libops-Api-Version"
+	HeaderDeprecation = "Deprecation"
+	HeaderSunset      = "Sunset"
+	HeaderLink        = "Link"
+)
+
+// Stage is where a version is in its lifecycle.
+type Stage string
+
+const (
+	Beta       Stage = "beta"
+	Stable     Stage = "stable"
+	Deprecated Stage = "deprecated"
+)
+
+// Version describes one proto package of the API.
+type Version struct {
+	Package protoreflect.FullName
+	Stage   Stage
+	// Deprecated is when the version was (or will be) deprecated. Setting it
+	// makes the version's stage deprecated from that time on.
+	Deprecated time.Time
+	// Sunset is when the version stops being served.
+	Sunset time.Time
+	// Successor is the package clients should move to.
+	Successor protoreflect.FullName
+	// DocsURL documents the version and, once deprecated, how to migrate.
+	DocsURL string
+}
+
+// Registry holds the API versions and the services mounted for each.
+type Registry struct {
+	versions []Version
+	now      func() time.Time
+
+	mu       sync.RWMutex
+	services map[protoreflect.FullName][]string
+}
+
+// NewRegistry creates a registry of the given versions.
+func NewRegistry(versions ...Version) *Registry {
+	return &Registry{
+		versions: versions,
+		services: map[protoreflect.FullName][]string{},
+		now:      time.Now,
+	}
+}
+
+// Mount records a Connect service handler under its package's version and
+// wraps it with the version's headers. It takes and returns the pattern and
+// handler pair the generated New*Handler functions return, so it slots into
+// mux.Handle:
+//
+//	mux.Handle(versions.Mount(libopsv1connect.NewSiteServiceHandler(svc, opts...)))
+//
+// Mount panics if the service's package isn't a registered version, the same
+// way http.ServeMux panics on a bad pattern.
+func (reg *Registry) Mount(path string, handler http.Handler) (string, http.Handler) {
+	service := protoreflect.FullName(strings.Trim(path, "/"))
+	pkg := service.Parent()
+	if _, ok := reg.version(pkg); !ok {
+		panic(fmt.Sprintf("apiversion: %s is in %s, which isn't a registered API version", service, pkg))
+	}
+
+	reg.mu.Lock()
+	reg.services[pkg] = append(reg.services[pkg], string(service))
+	reg.mu.Unlock()
+
+	errorWriter := connect.NewErrorWriter()
+	return path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, _ := reg.version(pkg)
+		now := reg.now()
+		setHeaders(w.Header(), v)
+		if !v.Sunset.IsZero() && !now.Before(v.Sunset) {
+			err := fmt.Errorf("%s was sunset on %s", v.Package, v.Sunset.UTC().Format(time.DateOnly))
+			if v.Successor != "" {
+				err = fmt.Errorf("%w; use %s", err, v.Successor)
+			}
+			_ = errorWriter.Write(w, r, connect.NewError(connect.CodeUnimplemented, err))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Services returns the fully-qualified names of every mounted service.
+func (reg *Registry) Services() []string {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	var services []string
+	for _, v := range reg.versions {
+		services = append(services, reg.services[v.Package]...)
+	}
+	return services
+}
+
+func (reg *Registry) version(pkg protoreflect.FullName) (Version, bool) {
+	for _, v := range reg.versions {
+		if v.Package == pkg {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// stage is the version's stage at the given time.
+func (v Version) stage(now time.Time) Stage {
+	if !v.Deprecated.IsZero() && !now.Before(v.Deprecated) {
+		return Deprecated
+	}
+	return v.Stage
+}
+
+// setHeaders announces the version and, if it's being deprecated, when and
+// what replaces it. Deprecation is sent ahead of the date too; RFC 9745 allows
+// a future date so clients get warning before it takes effect.
+func setHeaders(h http.Header, v Version) {
+	h.Set(HeaderVersion, string(v.Package))
+	if v.Deprecated.IsZero() {
+		return
+	}
+	h.Set(HeaderDeprecation, fmt.Sprintf("@%d", v.Deprecated.Unix()))
+	if !v.Sunset.IsZero() {
+		h.Set(HeaderSunset, v.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if v.DocsURL != "" {
+		h.Add(HeaderLink, fmt.Sprintf(`<%s>; rel="deprecation"; type="text/html"`, v.DocsURL))
+	}
+	if v.Successor != "" {
+		h.Add(HeaderLink, fmt.Sprintf(`</versions#%s>; rel="successor-version"`, v.Successor))
+	}
+}
+
+// versionInfo is one entry of the GET /versions response.
+type versionInfo struct {
+	Package    string     `json:"package"`
+	Stage      Stage      `json:"stage"`
+	Deprecated *time.Time `json:"deprecated,omitempty"`
+	Sunset     *time.Time `json:"sunset,omitempty"`
+	Successor  string     `json:"successor,omitempty"`
+	DocsURL    string     `json:"docsUrl,omitempty"`
+	Services   []string   `json:"services"`
+}
+
+// ServeHTTP lists the registered versions, in the order they were registered,
+// with their stage and the services mounted for each.
+func (reg *Registry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	now := reg.now()
+	reg.mu.RLock()
+	out := make([]versionInfo, 0, len(reg.versions))
+	for _, v := range reg.versions {
+		info := versionInfo{
+			Package:   string(v.Package),
+			Stage:     v.stage(now),
+			Successor: string(v.Successor),
+			DocsURL:   v.DocsURL,
+			Services:  slices.Clone(reg.services[v.Package]),
+		}
+		if info.Services == nil {
+			info.Services = []string{}
+		}
+		if !v.Deprecated.IsZero() {
+			info.Deprecated = &v.Deprecated
+		}
+		if !v.Sunset.IsZero() {
+			info.Sunset = &v.Sunset
+		}
+		out = append(out, info)
+	}
+	reg.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"versions": out})
+}
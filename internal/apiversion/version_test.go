@@ -0,0 +1,106 @@
+package apiversion
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+type testSites struct {
+	libopsv1connect.UnimplementedSiteServiceHandler
+}
+
+func (s *testSites) GetSite(ctx context.Context, req *connect.Request[libopsv1.GetSiteRequest]) (*connect.Response[libopsv1.GetSiteResponse], error) {
+	return connect.NewResponse(&libopsv1.GetSiteResponse{}), nil
+}
+
+func newTestServer(t *testing.T, v Version, now time.Time) (*Registry, libopsv1connect.SiteServiceClient, *httptest.Server) {
+	t.Helper()
+	reg := NewRegistry(v)
+	reg.now = func() time.Time { return now }
+	mux := http.NewServeMux()
+	mux.Handle(reg.Mount(libopsv1connect.NewSiteServiceHandler(&testSites{})))
+	mux.Handle("/versions", reg)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return reg, libopsv1connect.NewSiteServiceClient(srv.Client(), srv.URL), srv
+}
+
+func TestMountStable(t *testing.T) {
+	_, client, _ := newTestServer(t, Version{Package: "libops.v1", Stage: Stable}, time.Now())
+
+	resp, err := client.GetSite(context.Background(), connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: "site-1"}))
+	require.NoError(t, err)
+	assert.Equal(t, "libops.v1", resp.Header().Get(HeaderVersion))
+	assert.Empty(t, resp.Header().Get(HeaderDeprecation))
+	assert.Empty(t, resp.Header().Get(HeaderSunset))
+}
+
+func TestMountDeprecated(t *testing.T) {
+	deprecated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	v := Version{
+		Package:    "libops.v1",
+		Stage:      Stable,
+		Deprecated: deprecated,
+		Sunset:     sunset,
+		Successor:  "libops.v2",
+		DocsURL:    "https://docs.example/migrate-v2",
+	}
+
+	t.Run("before sunset", func(t *testing.T) {
+		_, client, _ := newTestServer(t, v, deprecated.AddDate(0, 1, 0))
+		resp, err := client.GetSite(context.Background(), connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: "site-1"}))
+		require.NoError(t, err)
+		assert.Equal(t, "@1767225600", resp.Header().Get(HeaderDeprecation))
+		assert.Equal(t, "Wed, 01 Jul 2026 00:00:00 GMT", resp.Header().Get(HeaderSunset))
+		assert.ElementsMatch(t, []string{
+			`<https://docs.example/migrate-v2>; rel="deprecation"; type="text/html"`,
+			`</versions#libops.v2>; rel="successor-version"`,
+		}, resp.Header().Values(HeaderLink))
+	})
+
+	t.Run("after sunset", func(t *testing.T) {
+		_, client, _ := newTestServer(t, v, sunset)
+		_, err := client.GetSite(context.Background(), connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: "site-1"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+		assert.Contains(t, err.Error(), "use libops.v2")
+	})
+}
+
+func TestMountUnregisteredVersion(t *testing.T) {
+	reg := NewRegistry(Version{Package: "libops.v2", Stage: Beta})
+	assert.Panics(t, func() {
+		reg.Mount(libopsv1connect.NewSiteServiceHandler(&testSites{}))
+	})
+}
+
+func TestVersions(t *testing.T) {
+	deprecated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	reg, _, srv := newTestServer(t, Version{Package: "libops.v1", Stage: Stable, Deprecated: deprecated}, deprecated)
+	assert.Equal(t, []string{"libops.v1.SiteService"}, reg.Services())
+
+	resp, err := http.Get(srv.URL + "/versions")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	var out struct {
+		Versions []versionInfo `json:"versions"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	require.Len(t, out.Versions, 1)
+	assert.Equal(t, "libops.v1", out.Versions[0].Package)
+	assert.Equal(t, Deprecated, out.Versions[0].Stage)
+	assert.Equal(t, []string{"libops.v1.SiteService"}, out.Versions[0].Services)
+	assert.Nil(t, out.Versions[0].Sunset)
+}
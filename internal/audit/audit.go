@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
 )
 
 // Event represents an audit event type.
@@ -20,6 +21,7 @@ const (
 	UserLoginFailure     Event = "user.login.failure"
 	APIKeyCreate         Event = "apikey.create"
 	APIKeyDelete         Event = "apikey.delete"
+	DeviceLoginApprove   Event = "user.device_login.approve"
 	OrganizationCreate   Event = "organization.create"
 	OrganizationUpdate   Event = "organization.update"
 	OrganizationDelete   Event = "organization.delete"
@@ -62,6 +64,10 @@ const (
 	SiteSecretDeleteSuccess Event = "site.secret.delete.success"
 	SiteSecretDeleteFailed  Event = "site.secret.delete.failed"
 
+	// Config Var Events.
+	SiteConfigVarSet    Event = "site.config_var.set"
+	SiteConfigVarDelete Event = "site.config_var.delete"
+
 	// Member Events.
 	MemberAddSuccess    Event = "member.add.success"
 	MemberAddFailure    Event = "member.add.failure"
@@ -70,11 +76,143 @@ const (
 	MemberRemoveSuccess Event = "member.remove.success"
 	MemberRemoveFailure Event = "member.remove.failure"
 
+	// SSH Access Events.
+	SiteSshAccessGrant  Event = "site.ssh_access.grant"
+	SiteSshAccessUpdate Event = "site.ssh_access.update"
+	SiteSshAccessRevoke Event = "site.ssh_access.revoke"
+
+	// Elevation Events.
+	SiteElevationRequest Event = "site.elevation.request"
+	SiteElevationApprove Event = "site.elevation.approve"
+	SiteElevationReject  Event = "site.elevation.reject"
+	SiteElevationRevoke  Event = "site.elevation.revoke"
+	SiteElevationExpire  Event = "site.elevation.expire"
+
 	// Firewall Events.
 	FirewallRuleCreateSuccess Event = "firewall.rule.create.success"
 	FirewallRuleCreateFailure Event = "firewall.rule.create.failure"
 	FirewallRuleDeleteSuccess Event = "firewall.rule.delete.success"
 	FirewallRuleDeleteFailure Event = "firewall.rule.delete.failure"
+	RateLimitRuleCreate       Event = "firewall.rate_limit.create"
+	RateLimitRuleDelete       Event = "firewall.rate_limit.delete"
+
+	// Domain Events.
+	SiteDomainAdd    Event = "site.domain.add"
+	SiteDomainRemove Event = "site.domain.remove"
+
+	// Egress Events.
+	StaticEgressIpRequest Event = "site.static_egress_ip.request"
+	StaticEgressIpRelease Event = "site.static_egress_ip.release"
+
+	// CDN Events.
+	SiteCdnEnable  Event = "site.cdn.enable"
+	SiteCdnUpdate  Event = "site.cdn.update"
+	SiteCdnDisable Event = "site.cdn.disable"
+	SiteCachePurge Event = "site.cdn.purge"
+
+	// Redirect Events.
+	SiteRedirectCreate Event = "site.redirect.create"
+	SiteRedirectUpdate Event = "site.redirect.update"
+	SiteRedirectDelete Event = "site.redirect.delete"
+
+	// Access Protection Events.
+	SiteAccessProtectionUpdate Event = "site.access_protection.update"
+	SiteAccessProtectionReset  Event = "site.access_protection.reset"
+	SiteAccessGateSignIn       Event = "site.access_gate.sign_in"
+
+	// WAF Events.
+	SiteWafUpdate          Event = "site.waf.update"
+	SiteWafExclusionCreate Event = "site.waf.exclusion.create"
+	SiteWafExclusionDelete Event = "site.waf.exclusion.delete"
+
+	// TLS Policy Events.
+	SiteTlsPolicyUpdate      Event = "site.tls_policy.update"
+	SiteTlsCertificateUpload Event = "site.tls_policy.certificate.upload"
+	SiteTlsCertificateDelete Event = "site.tls_policy.certificate.delete"
+
+	// Maintenance Window Events.
+	SiteMaintenanceWindowUpdate Event = "site.maintenance_window.update"
+	SiteMaintenanceWindowDelete Event = "site.maintenance_window.delete"
+
+	// Database Events.
+	SiteDatabaseCreate        Event = "site.database.create"
+	SiteDatabasePasswordReset Event = "site.database.password_reset"
+
+	// Add-on Events.
+	SiteAddonAttach Event = "site.addon.attach"
+	SiteAddonDetach Event = "site.addon.detach"
+
+	// Terminal Events.
+	TerminalSessionStart   Event = "terminal.session.start"
+	TerminalSessionEnd     Event = "terminal.session.end"
+	TerminalSessionFailure Event = "terminal.session.failure"
+
+	// Export Events.
+	OrganizationExportRequest Event = "organization.export.request"
+
+	// Ownership Events.
+	OwnershipTransferInitiate Event = "organization.ownership.transfer.initiate"
+	OwnershipTransferAccept   Event = "organization.ownership.transfer.accept"
+	OwnershipTransferCancel   Event = "organization.ownership.transfer.cancel"
+
+	// Relationship Events.
+	RelationshipRequest Event = "organization.relationship.request"
+	RelationshipApprove Event = "organization.relationship.approve"
+	RelationshipReject  Event = "organization.relationship.reject"
+	RelationshipUpdate  Event = "organization.relationship.update"
+	RelationshipRevoke  Event = "organization.relationship.revoke"
+	RelationshipExpire  Event = "organization.relationship.expire"
+
+	// Policy Events.
+	PolicyCreate    Event = "organization.policy.create"
+	PolicyUpdate    Event = "organization.policy.update"
+	PolicyDelete    Event = "organization.policy.delete"
+	PolicyViolation Event = "organization.policy.violation"
+
+	// IP Allowlist Events.
+	IPAllowlistUpdate Event = "organization.ip_allowlist.update"
+
+	// Organization API Key Events.
+	MemberAPIKeyRevoke Event = "organization.api_key.revoke"
+
+	// Self-Service Signup Events.
+	JoinPolicyUpdate   Event = "organization.join_policy.update"
+	SignupJoin         Event = "organization.signup.join"
+	SignupRequest      Event = "organization.signup.request"
+	JoinRequestApprove Event = "organization.join_request.approve"
+	JoinRequestDeny    Event = "organization.join_request.deny"
+
+	// Secret Access Events.
+	SecretAccessAnomaly Event = "organization.secret.access_anomaly"
+
+	// Membership Events.
+	MemberExpire Event = "member.expire"
+
+	// Private Network Events.
+	PrivateEndpointCreate Event = "organization.private_endpoint.create"
+	PrivateEndpointDelete Event = "organization.private_endpoint.delete"
+
+	// Encryption Key Events.
+	EncryptionKeySet    Event = "organization.encryption_key.set"
+	EncryptionKeyDelete Event = "organization.encryption_key.delete"
+
+	// Platform Auditor Events.
+	PlatformAuditorGrant  Event = "account.platform_auditor.grant"
+	PlatformAuditorRevoke Event = "account.platform_auditor.revoke"
+
+	// Platform Operator Events.
+	OrganizationSuspend     Event = "organization.suspend"
+	OrganizationUnsuspend   Event = "organization.unsuspend"
+	ReconciliationForce     Event = "reconciliation.force"
+	ReconciliationRetry     Event = "reconciliation.retry"
+	ReconciliationCancel    Event = "reconciliation.cancel"
+	ReconciliationFleet     Event = "reconciliation.fleet"
+	ControllerConfigUpdate  Event = "controller_config.update"
+	ControllerConfigDelete  Event = "controller_config.delete"
+	ControllerReleaseCreate Event = "controller_release.create"
+	TerraformStateBackup    Event = "terraform_state.backup"
+	TerraformStateRestore   Event = "terraform_state.restore"
+	TerraformStateMigrate   Event = "terraform_state.migrate"
 )
 
 // EntityType represents the type of entity being audited.
@@ -136,7 +274,8 @@ func (l *Logger) Log(ctx context.Context, accountID, entityID int64, entityType
 		"data", data,
 	)
 
-	err = l.q.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+	// Failures are audited by handlers that then roll their request back
+	err = l.q.CreateAuditEvent(database.WithoutTx(ctx), db.CreateAuditEventParams{
 		AccountID:  accountID,
 		EntityID:   entityID,
 		EntityType: db.AuditEntityType(entityType),
@@ -9,6 +9,7 @@ import (
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/libops/api/internal/idempotency"
 	optionsv1 "github.com/libops/api/proto/libops/v1/options"
 )
 
@@ -40,6 +41,11 @@ func (i *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return resp, err
 		}
 
+		// Replayed idempotent responses were audited on the original request
+		if idempotency.IsReplay(resp) {
+			return resp, nil
+		}
+
 		auditInfo := i.getAuditInfo(req.Spec().Procedure)
 		if auditInfo == nil {
 			return resp, nil
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -44,7 +45,8 @@ func NewAPIKeyManager(vaultClient *vault.Client, querier db.Querier, auditLogger
 // CreateAPIKey creates a new API key for an account.
 // It returns the key secret value (which is only shown once) and the API key's metadata.
 // The 'scopes' parameter is a required list of OAuth scope strings (e.g., ["read:organization", "write:site"]).
-func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, accountUUID, name, description string, scopes []string, expiresAt *time.Time, createdBy int64) (string, *db.GetAPIKeyByUUIDRow, error) {
+// The 'allowedCIDRs' parameter restricts the addresses the key can be used from; empty allows any.
+func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, accountUUID, name, description string, scopes, allowedCIDRs []string, expiresAt *time.Time, createdBy int64) (string, *db.GetAPIKeyByUUIDRow, error) {
 	keyUUID := uuid.New()
 
 	// Generate a random secret component (64 bytes = 512 bits of entropy)
@@ -70,6 +72,18 @@ func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, acc
 		scopesJSON = scopesBytes
 	}
 
+	var allowedCIDRsJSON types.RawJSON
+	if len(allowedCIDRs) > 0 {
+		prefixes, err := ParseCIDRs(allowedCIDRs)
+		if err != nil {
+			return "", nil, err
+		}
+		allowedCIDRsJSON, err = json.Marshal(FormatCIDRs(prefixes))
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to marshal allowed CIDRs: %w", err)
+		}
+	}
+
 	// The UNIQUE constraint on api_key_uuid provides atomic collision detection
 	// If a duplicate UUID is generated (extremely unlikely), the INSERT will fail
 	err = akm.db.CreateAPIKey(ctx, db.CreateAPIKeyParams{
@@ -80,9 +94,10 @@ func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, acc
 			String: description,
 			Valid:  description != "",
 		},
-		Scopes:    scopesJSON,
-		ExpiresAt: expiresAtSQL,
-		Active:    true,
+		Scopes:       scopesJSON,
+		AllowedCidrs: allowedCIDRsJSON,
+		ExpiresAt:    expiresAtSQL,
+		Active:       true,
 		CreatedBy: sql.NullInt64{
 			Int64: createdBy,
 			Valid: createdBy > 0,
@@ -95,7 +110,7 @@ func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, acc
 				"uuid", keyUUID)
 			// Retry with a new UUID - recursive call with depth limit would be better
 			// but for UUID v4 collisions, a single retry is sufficient
-			return akm.CreateAPIKey(ctx, accountID, accountUUID, name, description, scopes, expiresAt, createdBy)
+			return akm.CreateAPIKey(ctx, accountID, accountUUID, name, description, scopes, allowedCIDRs, expiresAt, createdBy)
 		}
 		return "", nil, fmt.Errorf("failed to create API key in database: %w", err)
 	}
@@ -114,9 +129,13 @@ func (akm *APIKeyManager) CreateAPIKey(ctx context.Context, accountID int64, acc
 		return "", nil, fmt.Errorf("failed to fetch created API key: %w", err)
 	}
 
-	akm.auditLogger.Log(ctx, accountID, keyMeta.ID, audit.APIKeyEntityType, audit.APIKeyCreate, map[string]any{
+	details := map[string]any{
 		"name": name,
-	})
+	}
+	if len(allowedCIDRs) > 0 {
+		details["allowed_cidrs"] = allowedCIDRs
+	}
+	akm.auditLogger.Log(ctx, accountID, keyMeta.ID, audit.APIKeyEntityType, audit.APIKeyCreate, details)
 
 	return secretValue, &keyMeta, nil
 }
@@ -179,15 +198,22 @@ func (akm *APIKeyManager) ValidateAPIKey(ctx context.Context, secretValue string
 		scopes = parsedScopes
 	}
 
+	allowedCIDRs, err := parseStoredCIDRs(keyMeta.AllowedCidrs)
+	if err != nil {
+		slog.Error("ValidateAPIKey: failed to parse API key allowed CIDRs", "error", err)
+		return nil, fmt.Errorf("invalid API key")
+	}
+
 	return &APIKeyInfo{
-		KeyUUID:     keyUUID,
-		AccountID:   account.ID,
-		AccountUUID: accountUUID,
-		Email:       account.Email,
-		Name:        account.Name.String,
-		EntityID:    account.VaultEntityID.String,
-		KeyName:     keyMeta.Name,
-		Scopes:      scopes,
+		KeyUUID:      keyUUID,
+		AccountID:    account.ID,
+		AccountUUID:  accountUUID,
+		Email:        account.Email,
+		Name:         account.Name.String,
+		EntityID:     account.VaultEntityID.String,
+		KeyName:      keyMeta.Name,
+		Scopes:       scopes,
+		AllowedCIDRs: allowedCIDRs,
 	}, nil
 }
 
@@ -201,6 +227,8 @@ type APIKeyInfo struct {
 	EntityID    string
 	KeyName     string
 	Scopes      []Scope // Permission scopes granted to this API key
+	// Addresses the key can be used from; empty means anywhere
+	AllowedCIDRs []netip.Prefix
 }
 
 // ListAPIKeys lists all API keys for an account.
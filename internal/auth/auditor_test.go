@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// organizationStub answers organization reads and changes, checking read access
+// again the way services do
+type organizationStub struct {
+	libopsv1connect.UnimplementedOrganizationServiceHandler
+}
+
+func (organizationStub) GetOrganization(ctx context.Context, req *connect.Request[libopsv1.GetOrganizationRequest]) (*connect.Response[libopsv1.GetOrganizationResponse], error) {
+	authorizer, err := GetAuthorizer(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	userInfo, _ := GetUserFromContext(ctx)
+	if err := authorizer.CheckOrganizationAccess(ctx, userInfo, uuid.MustParse(req.Msg.OrganizationId), PermissionRead); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&libopsv1.GetOrganizationResponse{}), nil
+}
+
+func (organizationStub) DeleteOrganization(context.Context, *connect.Request[libopsv1.DeleteOrganizationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// adminAccountStub answers admin account reads and changes
+type adminAccountStub struct {
+	libopsv1connect.UnimplementedAdminAccountServiceHandler
+}
+
+func (adminAccountStub) ListPlatformAuditors(context.Context, *connect.Request[libopsv1.ListPlatformAuditorsRequest]) (*connect.Response[libopsv1.ListPlatformAuditorsResponse], error) {
+	return connect.NewResponse(&libopsv1.ListPlatformAuditorsResponse{}), nil
+}
+
+func (adminAccountStub) DeleteAccount(context.Context, *connect.Request[libopsv1.DeleteAccountRequest]) (*connect.Response[emptypb.Empty], error) {
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// TestPlatformAuditor tests that an account with an unexpired auditor grant can
+// read organizations it isn't a member of and system endpoints, and can't
+// change anything, even where its memberships would let it.
+func TestPlatformAuditor(t *testing.T) {
+	const auditorID, ownerID = 10, 20
+	orgID := uuid.NewString()
+	grants := map[int64]bool{auditorID: true}
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			if arg.AccountID != ownerID {
+				return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleOwner}, nil
+		},
+		GetActivePlatformAuditorFunc: func(ctx context.Context, accountID int64) (time.Time, error) {
+			if !grants[accountID] {
+				return time.Time{}, sql.ErrNoRows
+			}
+			return time.Now().Add(time.Hour), nil
+		},
+	}
+
+	authorizer := NewAuthorizer(mock)
+	auditLogger := audit.New(mock)
+	var caller *UserInfo
+	setUser := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(context.WithValue(ctx, UserContextKey, caller), req)
+		}
+	})
+	opts := connect.WithInterceptors(setUser, NewScopeAuthzInterceptor(authorizer, auditLogger), NewRBACAuthzInterceptor(authorizer, auditLogger))
+	mux := http.NewServeMux()
+	mux.Handle(libopsv1connect.NewOrganizationServiceHandler(organizationStub{}, opts))
+	mux.Handle(libopsv1connect.NewAdminAccountServiceHandler(adminAccountStub{}, opts))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	organizations := libopsv1connect.NewOrganizationServiceClient(server.Client(), server.URL)
+	accounts := libopsv1connect.NewAdminAccountServiceClient(server.Client(), server.URL)
+
+	getOrganization := func(id string) error {
+		_, err := organizations.GetOrganization(context.Background(), connect.NewRequest(&libopsv1.GetOrganizationRequest{OrganizationId: id}))
+		return err
+	}
+	deleteOrganization := func() error {
+		_, err := organizations.DeleteOrganization(context.Background(), connect.NewRequest(&libopsv1.DeleteOrganizationRequest{OrganizationId: orgID}))
+		return err
+	}
+	listAuditors := func() error {
+		_, err := accounts.ListPlatformAuditors(context.Background(), connect.NewRequest(&libopsv1.ListPlatformAuditorsRequest{}))
+		return err
+	}
+	deleteAccount := func() error {
+		_, err := accounts.DeleteAccount(context.Background(), connect.NewRequest(&libopsv1.DeleteAccountRequest{AccountId: uuid.NewString()}))
+		return err
+	}
+
+	caller = &UserInfo{AccountID: auditorID}
+	assert.NoError(t, getOrganization(orgID), "auditors read organizations they aren't members of")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(getOrganization(uuid.NewString())))
+	assert.NoError(t, listAuditors(), "auditors read system endpoints")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(deleteOrganization()))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(deleteAccount()))
+
+	caller = &UserInfo{AccountID: auditorID, Scopes: []Scope{{Resource: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ}}}
+	assert.NoError(t, getOrganization(orgID))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(listAuditors()), "scoped keys need read:platform for system endpoints")
+
+	// An owner who is also an auditor can't change anything while the grant lasts
+	grants[ownerID] = true
+	caller = &UserInfo{AccountID: ownerID}
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(deleteOrganization()))
+
+	grants[ownerID] = false
+	assert.NoError(t, deleteOrganization())
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(listAuditors()))
+
+	grants[auditorID] = false
+	caller = &UserInfo{AccountID: auditorID}
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(getOrganization(orgID)), "expired grants give no access")
+}
@@ -158,4 +158,19 @@ func TestCheckSiteAccess_Hierarchical(t *testing.T) {
 		err := authorizer.CheckSiteAccess(context.Background(), userInfo, sitePublicID, PermissionRead)
 		assert.NoError(t, err)
 	})
+	t.Run("ProjectMember_InheritsOrganizationRead", func(t *testing.T) {
+		mockDB.GetOrganizationMemberFunc = func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+		}
+		mockDB.GetEffectiveOrganizationAccessFunc = func(ctx context.Context, arg db.GetEffectiveOrganizationAccessParams) (db.GetEffectiveOrganizationAccessRow, error) {
+			if arg.OrganizationID == orgID && arg.AccountID == accountID {
+				return db.GetEffectiveOrganizationAccessRow{InheritedRead: true}, nil
+			}
+			return db.GetEffectiveOrganizationAccessRow{}, sql.ErrNoRows
+		}
+		defer func() { mockDB.GetEffectiveOrganizationAccessFunc = nil }()
+
+		assert.NoError(t, authorizer.CheckOrganizationAccess(context.Background(), userInfo, orgPublicID, PermissionRead))
+		assert.Error(t, authorizer.CheckOrganizationAccess(context.Background(), userInfo, orgPublicID, PermissionWrite))
+	})
 }
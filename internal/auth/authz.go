@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
+	"github.com/cedar-policy/cedar-go/types"
 	"github.com/google/uuid"
 
 	"github.com/libops/api/db"
@@ -44,6 +46,8 @@ const (
 type Authorizer struct {
 	db          db.Querier
 	cedarEngine *CedarEngine
+	// pdp decides membership checks in place of the cedar policies when set
+	pdp PolicyDecisionPoint
 }
 
 // NewAuthorizer creates a new authorizer.
@@ -107,28 +111,45 @@ func (a *Authorizer) GetAccountID(ctx context.Context, userInfo *UserInfo) (int6
 
 // CheckOrganizationAccess checks if user has access to a organization (by public_id UUID).
 func (a *Authorizer) CheckOrganizationAccess(ctx context.Context, userInfo *UserInfo, organizationPublicID uuid.UUID, required Permission) error {
+	return a.checkOrganizationAccess(ctx, userInfo, organizationPublicID, required, nil)
+}
+
+// checkOrganizationAccess is CheckOrganizationAccess, recording each step in
+// trace when it's set.
+func (a *Authorizer) checkOrganizationAccess(ctx context.Context, userInfo *UserInfo, organizationPublicID uuid.UUID, required Permission, trace *AccessExplanation) error {
 	organization, err := a.db.GetOrganization(ctx, organizationPublicID.String())
 	if err != nil {
+		trace.add("resource", "not found", "organization "+organizationPublicID.String())
 		return fmt.Errorf("organization not found: %w", err)
 	}
 
+	// Platform auditors can read every organization
+	if required == PermissionRead && hasAuditorRead(ctx) {
+		trace.add("platform auditor", "allowed", "auditors can read every organization")
+		return nil
+	}
+
 	// Platform service accounts have admin access to their own organization only
 	if a.IsPlatformServiceAccount(ctx, userInfo) {
 		saOrganizationID, err := a.GetServiceAccountOrganizationID(ctx, userInfo)
 		if err != nil {
+			trace.add("service account", "denied", err.Error())
 			return fmt.Errorf("failed to get service account organization: %w", err)
 		}
 
 		if saOrganizationID != organization.ID {
+			trace.add("service account", "denied", "service accounts can only access their own organization")
 			return fmt.Errorf("access denied: service account can only access its own organization")
 		}
 
 		// Platform service accounts have owner-level access to their organization
+		trace.add("service account", "allowed", "service accounts own their own organization")
 		return nil
 	}
 
 	accountID, err := a.GetAccountID(ctx, userInfo)
 	if err != nil {
+		trace.add("principal", "denied", err.Error())
 		return fmt.Errorf("unauthorized: %w", err)
 	}
 
@@ -142,90 +163,74 @@ func (a *Authorizer) CheckOrganizationAccess(ctx context.Context, userInfo *User
 		AccountID:      accountID,
 	})
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(organization.ID), string(member.Role))
+		builder.AddRole(ResourceOrganization, fmt.Sprint(organization.ID), string(member.Role), RoleSourceMembership)
+		trace.add("organization membership", "found", "role "+string(member.Role))
+	} else {
+		trace.add("organization membership", "none", "")
 	}
 
 	// 2. Relationship Access
-	relationships, err := a.db.ListOrganizationRelationships(ctx, db.ListOrganizationRelationshipsParams{
-		SourceOrganizationID: organization.ID,
-		TargetOrganizationID: organization.ID,
-	})
-	if err == nil {
-		for _, rel := range relationships {
-			if rel.Status == "approved" && rel.TargetOrganizationID == organization.ID {
-				sourceMember, err := a.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
-					OrganizationID: rel.SourceOrganizationID,
-					AccountID:      accountID,
-				})
-				if err == nil {
-					builder.AddResource(TypeOrganization, fmt.Sprint(rel.SourceOrganizationID), nil)
-					builder.AddUserRole(fmt.Sprint(rel.SourceOrganizationID), string(sourceMember.Role))
-
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organization.ID), "owner")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organization.ID), "developer")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organization.ID), "viewer")
-				}
-			}
-		}
-	}
+	a.addRelationshipRoles(ctx, builder, accountID, organization.ID, trace)
 
 	// 3. Upwards Inheritance (Read Access Only)
 	if required == PermissionRead {
-		hasProjectAccess, _ := a.db.HasUserProjectAccessInOrganization(ctx, db.HasUserProjectAccessInOrganizationParams{
-			TargetOrganizationID: organization.ID,
-			AccountID:            accountID,
-			OrganizationID:       organization.ID,
+		effective, err := a.db.GetEffectiveOrganizationAccess(ctx, db.GetEffectiveOrganizationAccessParams{
+			AccountID:      accountID,
+			OrganizationID: organization.ID,
 		})
-		if hasProjectAccess {
-			builder.AddSyntheticUserRole(fmt.Sprint(organization.ID), "viewer")
+		if err == nil && effective.InheritedRead {
+			builder.AddRole(ResourceOrganization, fmt.Sprint(organization.ID), "viewer", RoleSourceInherited)
+			trace.add("inherited read", "found", "viewer through access to a project or site in the organization")
 		} else {
-			hasSiteAccess, _ := a.db.HasUserSiteAccessInOrganization(ctx, db.HasUserSiteAccessInOrganizationParams{
-				TargetOrganizationID: organization.ID,
-				AccountID:            accountID,
-				OrganizationID:       organization.ID,
-			})
-			if hasSiteAccess {
-				builder.AddSyntheticUserRole(fmt.Sprint(organization.ID), "viewer")
-			}
+			trace.add("inherited read", "none", "no project or site access in the organization")
 		}
 	}
 
 	// Evaluate Policy
-	ok, err := a.cedarEngine.Authorize(builder.UserUID, PermissionToAction(required), orgUID, builder.Build())
-	if err != nil {
-		return fmt.Errorf("authorization error: %w", err)
-	}
-	if !ok {
-		return fmt.Errorf("access denied")
-	}
-
-	return nil
+	return a.decide(ctx, userInfo, builder, ResourceOrganization, organization.PublicID, required, orgUID, trace)
 }
 
 // CheckProjectAccess checks if user has access to a project (by public_id UUID).
 func (a *Authorizer) CheckProjectAccess(ctx context.Context, userInfo *UserInfo, projectPublicID uuid.UUID, required Permission) error {
+	return a.checkProjectAccess(ctx, userInfo, projectPublicID, required, nil)
+}
+
+// checkProjectAccess is CheckProjectAccess, recording each step in trace when
+// it's set.
+func (a *Authorizer) checkProjectAccess(ctx context.Context, userInfo *UserInfo, projectPublicID uuid.UUID, required Permission, trace *AccessExplanation) error {
 	project, err := a.db.GetProject(ctx, projectPublicID.String())
 	if err != nil {
+		trace.add("resource", "not found", "project "+projectPublicID.String())
 		return fmt.Errorf("project not found: %w", err)
 	}
 
+	// Platform auditors can read every project
+	if required == PermissionRead && hasAuditorRead(ctx) {
+		trace.add("platform auditor", "allowed", "auditors can read every project")
+		return nil
+	}
+
 	// Platform service accounts can access projects in their organization
 	if a.IsPlatformServiceAccount(ctx, userInfo) {
 		saOrganizationID, err := a.GetServiceAccountOrganizationID(ctx, userInfo)
 		if err != nil {
+			trace.add("service account", "denied", err.Error())
 			return fmt.Errorf("failed to get service account organization: %w", err)
 		}
 
 		if saOrganizationID != project.OrganizationID {
+			trace.add("service account", "denied", "service accounts can only access projects in their own organization")
 			return fmt.Errorf("access denied: service account can only access projects in its own organization")
 		}
 
 		// Platform service accounts have owner-level access
+		trace.add("service account", "allowed", "service accounts own the projects in their organization")
 		return nil
 	}
 
 	accountID, err := a.GetAccountID(ctx, userInfo)
 	if err != nil {
+		trace.add("principal", "denied", err.Error())
 		return fmt.Errorf("unauthorized: %w", err)
 	}
 
@@ -244,7 +249,10 @@ func (a *Authorizer) CheckProjectAccess(ctx context.Context, userInfo *UserInfo,
 		AccountID: accountID,
 	})
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(project.ID), string(projectMember.Role))
+		builder.AddRole(ResourceProject, fmt.Sprint(project.ID), string(projectMember.Role), RoleSourceMembership)
+		trace.add("project membership", "found", "role "+string(projectMember.Role))
+	} else {
+		trace.add("project membership", "none", "")
 	}
 
 	// 2. Organization Membership (Downwards)
@@ -253,32 +261,14 @@ func (a *Authorizer) CheckProjectAccess(ctx context.Context, userInfo *UserInfo,
 		AccountID:      accountID,
 	})
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(project.OrganizationID), string(orgMember.Role))
+		builder.AddRole(ResourceOrganization, fmt.Sprint(project.OrganizationID), string(orgMember.Role), RoleSourceMembership)
+		trace.add("organization membership", "found", "role "+string(orgMember.Role)+", inherited by the project")
+	} else {
+		trace.add("organization membership", "none", "")
 	}
 
 	// 3. Relationship Access (via Org)
-	relationships, err := a.db.ListOrganizationRelationships(ctx, db.ListOrganizationRelationshipsParams{
-		SourceOrganizationID: project.OrganizationID,
-		TargetOrganizationID: project.OrganizationID,
-	})
-	if err == nil {
-		for _, rel := range relationships {
-			if rel.Status == "approved" && rel.TargetOrganizationID == project.OrganizationID {
-				sourceMember, err := a.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
-					OrganizationID: rel.SourceOrganizationID,
-					AccountID:      accountID,
-				})
-				if err == nil {
-					builder.AddResource(TypeOrganization, fmt.Sprint(rel.SourceOrganizationID), nil)
-					builder.AddUserRole(fmt.Sprint(rel.SourceOrganizationID), string(sourceMember.Role))
-
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "owner")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "developer")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "viewer")
-				}
-			}
-		}
-	}
+	a.addRelationshipRoles(ctx, builder, accountID, project.OrganizationID, trace)
 
 	// 4. Upwards Inheritance from Site (Read Only)
 	if required == PermissionRead {
@@ -288,51 +278,64 @@ func (a *Authorizer) CheckProjectAccess(ctx context.Context, userInfo *UserInfo,
 			ProjectID: project.ID,
 		})
 		if hasSiteAccess {
-			builder.AddSyntheticUserRole(fmt.Sprint(project.ID), "viewer")
+			builder.AddRole(ResourceProject, fmt.Sprint(project.ID), "viewer", RoleSourceInherited)
+			trace.add("inherited read", "found", "viewer through access to a site in the project")
+		} else {
+			trace.add("inherited read", "none", "no site access in the project")
 		}
 	}
 
 	// Evaluate Policy
-	ok, err := a.cedarEngine.Authorize(builder.UserUID, PermissionToAction(required), projUID, builder.Build())
-	if err != nil {
-		return fmt.Errorf("authorization error: %w", err)
-	}
-	if !ok {
-		return fmt.Errorf("access denied")
-	}
-
-	return nil
+	return a.decide(ctx, userInfo, builder, ResourceProject, project.PublicID, required, projUID, trace)
 }
 
 // CheckSiteAccess checks if user has access to a site (by public_id UUID).
 func (a *Authorizer) CheckSiteAccess(ctx context.Context, userInfo *UserInfo, sitePublicID uuid.UUID, required Permission) error {
+	return a.checkSiteAccess(ctx, userInfo, sitePublicID, required, nil)
+}
+
+// checkSiteAccess is CheckSiteAccess, recording each step in trace when it's
+// set.
+func (a *Authorizer) checkSiteAccess(ctx context.Context, userInfo *UserInfo, sitePublicID uuid.UUID, required Permission, trace *AccessExplanation) error {
 	site, err := a.db.GetSite(ctx, sitePublicID.String())
 	if err != nil {
+		trace.add("resource", "not found", "site "+sitePublicID.String())
 		return fmt.Errorf("site not found: %w", err)
 	}
 
 	project, err := a.db.GetProjectByID(ctx, site.ProjectID)
 	if err != nil {
+		trace.add("resource", "not found", "the site's project")
 		return fmt.Errorf("project not found: %w", err)
 	}
 
+	// Platform auditors can read every site
+	if required == PermissionRead && hasAuditorRead(ctx) {
+		trace.add("platform auditor", "allowed", "auditors can read every site")
+		return nil
+	}
+
 	// Platform service accounts can access sites in their organization
 	if a.IsPlatformServiceAccount(ctx, userInfo) {
 		saOrganizationID, err := a.GetServiceAccountOrganizationID(ctx, userInfo)
 		if err != nil {
+			trace.add("service account", "denied", err.Error())
 			return fmt.Errorf("failed to get service account organization: %w", err)
 		}
 
 		if saOrganizationID != project.OrganizationID {
+			trace.add("service account", "denied", "service accounts can only access sites in their own organization")
 			return fmt.Errorf("access denied: service account can only access sites in its own organization")
 		}
 
 		// Platform service accounts have owner-level access
+		trace.add("service account", "allowed", "service accounts own the sites in their organization")
 		return nil
 	}
 
 	accountID, err := a.GetAccountID(ctx, userInfo)
 	if err != nil {
+		trace.add("principal", "denied", err.Error())
 		return fmt.Errorf("unauthorized: %w", err)
 	}
 
@@ -356,8 +359,13 @@ func (a *Authorizer) CheckSiteAccess(ctx context.Context, userInfo *UserInfo, si
 		SiteID:    site.ID,
 		AccountID: accountID,
 	})
+	hasAccess := false
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(site.ID), string(siteMember.Role))
+		builder.AddRole(ResourceSite, fmt.Sprint(site.ID), string(siteMember.Role), RoleSourceMembership)
+		hasAccess = true
+		trace.add("site membership", "found", "role "+string(siteMember.Role))
+	} else {
+		trace.add("site membership", "none", "")
 	}
 
 	// 2. Project Membership (Downwards)
@@ -366,7 +374,11 @@ func (a *Authorizer) CheckSiteAccess(ctx context.Context, userInfo *UserInfo, si
 		AccountID: accountID,
 	})
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(site.ProjectID), string(projectMember.Role))
+		builder.AddRole(ResourceProject, fmt.Sprint(site.ProjectID), string(projectMember.Role), RoleSourceMembership)
+		hasAccess = true
+		trace.add("project membership", "found", "role "+string(projectMember.Role)+", inherited by the site")
+	} else {
+		trace.add("project membership", "none", "")
 	}
 
 	// 3. Organization Membership (Downwards)
@@ -375,45 +387,122 @@ func (a *Authorizer) CheckSiteAccess(ctx context.Context, userInfo *UserInfo, si
 		AccountID:      accountID,
 	})
 	if err == nil {
-		builder.AddUserRole(fmt.Sprint(project.OrganizationID), string(orgMember.Role))
+		builder.AddRole(ResourceOrganization, fmt.Sprint(project.OrganizationID), string(orgMember.Role), RoleSourceMembership)
+		hasAccess = true
+		trace.add("organization membership", "found", "role "+string(orgMember.Role)+", inherited by the site")
+	} else {
+		trace.add("organization membership", "none", "")
 	}
 
 	// 4. Relationship Access (via Org)
+	if a.addRelationshipRoles(ctx, builder, accountID, project.OrganizationID, trace) {
+		hasAccess = true
+	}
+
+	// 5. Elevated Access, which lapses with the account's other access to the site
+	if hasAccess {
+		elevatedRole, err := a.db.GetActiveSiteElevationRole(ctx, db.GetActiveSiteElevationRoleParams{
+			SiteID:    site.ID,
+			AccountID: accountID,
+		})
+		if err == nil {
+			builder.AddRole(ResourceSite, fmt.Sprint(site.ID), string(elevatedRole), RoleSourceElevation)
+			trace.add("site elevation", "found", "role "+string(elevatedRole)+" until the elevation expires")
+		} else {
+			trace.add("site elevation", "none", "")
+		}
+	}
+
+	// Evaluate Policy
+	return a.decide(ctx, userInfo, builder, ResourceSite, site.PublicID, required, siteUID, trace)
+}
+
+// addRelationshipRoles gives the account its role in the source organization of
+// each approved relationship into organizationID, capped at the relationship's
+// max role. It reports whether any relationship applied.
+func (a *Authorizer) addRelationshipRoles(ctx context.Context, builder *GraphBuilder, accountID, organizationID int64, trace *AccessExplanation) bool {
 	relationships, err := a.db.ListOrganizationRelationships(ctx, db.ListOrganizationRelationshipsParams{
-		SourceOrganizationID: project.OrganizationID,
-		TargetOrganizationID: project.OrganizationID,
+		SourceOrganizationID: organizationID,
+		TargetOrganizationID: organizationID,
 	})
-	if err == nil {
-		for _, rel := range relationships {
-			if rel.Status == "approved" && rel.TargetOrganizationID == project.OrganizationID {
-				sourceMember, err := a.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
-					OrganizationID: rel.SourceOrganizationID,
-					AccountID:      accountID,
-				})
-				if err == nil {
-					builder.AddResource(TypeOrganization, fmt.Sprint(rel.SourceOrganizationID), nil)
-					builder.AddUserRole(fmt.Sprint(rel.SourceOrganizationID), string(sourceMember.Role))
-
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "owner")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "developer")
-					builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(project.OrganizationID), "viewer")
-				}
-			}
+	if err != nil {
+		trace.add("relationships", "error", err.Error())
+		return false
+	}
+
+	found := false
+	for _, rel := range relationships {
+		if rel.Status != "approved" || rel.TargetOrganizationID != organizationID {
+			continue
 		}
+		sourceMember, err := a.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+			OrganizationID: rel.SourceOrganizationID,
+			AccountID:      accountID,
+		})
+		if err != nil {
+			continue
+		}
+
+		role := RelationshipRole(sourceMember.Role, rel.MaxRole)
+		builder.AddResource(TypeOrganization, fmt.Sprint(rel.SourceOrganizationID), nil)
+		builder.AddRole(ResourceOrganization, fmt.Sprint(rel.SourceOrganizationID), string(role), RoleSourceRelationship)
+
+		builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organizationID), "owner")
+		builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organizationID), "developer")
+		builder.AddHierarchyLink(fmt.Sprint(rel.SourceOrganizationID), fmt.Sprint(organizationID), "viewer")
+		trace.add("relationship", "found", fmt.Sprintf("relationship %s: role %s in the source organization, capped at %s, gives %s", rel.PublicID, sourceMember.Role, rel.MaxRole, role))
+		found = true
+	}
+	if !found {
+		trace.add("relationships", "none", "no approved relationship from an organization the account belongs to")
 	}
+	return found
+}
 
-	// Evaluate Policy
-	ok, err := a.cedarEngine.Authorize(builder.UserUID, PermissionToAction(required), siteUID, builder.Build())
+// decide evaluates the policies against the roles gathered in builder, then
+// asks the policy decision point when one is configured. The decision point
+// is only asked when the account holds a role on the resource or one of its
+// parents, the resources listings can find, so it can't grant access to
+// something that would never be listed.
+func (a *Authorizer) decide(ctx context.Context, userInfo *UserInfo, builder *GraphBuilder, resourceType ResourceType, resourcePublicID string, required Permission, resource types.EntityUID, trace *AccessExplanation) error {
+	ok, policies, err := a.cedarEngine.Authorize(builder.UserUID, PermissionToAction(required), resource, builder.Build())
 	if err != nil {
+		trace.add("policy", "error", err.Error())
 		return fmt.Errorf("authorization error: %w", err)
 	}
+	if trace != nil {
+		trace.Policies = policies
+	}
+	if a.pdp != nil {
+		if len(builder.roles) == 0 {
+			trace.add("policy decision point", "denied", "the account holds no role on the resource, so the policy decision point isn't asked")
+			return fmt.Errorf("access denied")
+		}
+		return a.decideExternally(ctx, userInfo, builder, resourceType, resourcePublicID, required, ok, trace)
+	}
 	if !ok {
+		trace.add("policy", "denied", fmt.Sprintf("no policy grants %s to the roles found", required))
 		return fmt.Errorf("access denied")
 	}
 
+	trace.add("policy", "allowed", "permitted by "+strings.Join(policies, ", "))
 	return nil
 }
 
+// IsSiteOwner reports whether the authenticated caller has owner access to a
+// site (by public_id UUID).
+func (a *Authorizer) IsSiteOwner(ctx context.Context, sitePublicID string) bool {
+	userInfo, ok := GetUserFromContext(ctx)
+	if !ok {
+		return false
+	}
+	id, err := uuid.Parse(sitePublicID)
+	if err != nil {
+		return false
+	}
+	return a.CheckSiteAccess(ctx, userInfo, id, PermissionOwner) == nil
+}
+
 // CheckAccountAccess checks if user can access an account (by public_id UUID)
 // Users can always read/write their own account.
 func (a *Authorizer) CheckAccountAccess(ctx context.Context, userInfo *UserInfo, targetAccountPublicID uuid.UUID, required Permission) error {
@@ -443,3 +532,16 @@ func (a *Authorizer) RequireAuthentication(ctx context.Context) (*UserInfo, erro
 	}
 	return userInfo, nil
 }
+
+// relationshipRoleRanks orders organization roles from least to most access.
+var relationshipRoleRanks = map[string]int{"read": 1, "developer": 2, "owner": 3}
+
+// RelationshipRole is the role a member of a relationship's source
+// organization gets in its target organization: their own role, capped at the
+// relationship's max role.
+func RelationshipRole(memberRole db.OrganizationMembersRole, maxRole db.RelationshipsMaxRole) db.OrganizationMembersRole {
+	if relationshipRoleRanks[string(memberRole)] > relationshipRoleRanks[string(maxRole)] {
+		return db.OrganizationMembersRole(maxRole)
+	}
+	return memberRole
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -82,6 +83,29 @@ func (i *RBACAuthzInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFu
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("resource not found"))
 		}
 
+		// Organizations restricted to a set of networks can only be reached from them
+		if err := i.checkNetwork(ctx, req, scopeRule, userInfo); err != nil {
+			ip, _ := ClientIP(req.Peer().Addr, req.Header())
+			if !errors.Is(err, errNetworkNotAllowed) {
+				slog.Error("Organization network check failed",
+					"account_id", userInfo.AccountID,
+					"procedure", req.Spec().Procedure,
+					"error", err)
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("internal server error"))
+			}
+			slog.Warn("Organization reached from outside its allowed networks",
+				"account_id", userInfo.AccountID,
+				"email", userInfo.Email,
+				"source_ip", ip,
+				"procedure", req.Spec().Procedure)
+			i.auditLogger.Log(ctx, userInfo.AccountID, 0, resourceTypeToEntityType(scopeRule.Resource), audit.AuthorizationFailure, map[string]any{
+				"error":     "organization reached from outside its allowed networks",
+				"source_ip": ip.String(),
+				"procedure": req.Spec().Procedure,
+			})
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this organization can't be accessed from this network"))
+		}
+
 		slog.Debug("RBAC check passed",
 			"email", userInfo.Email,
 			"procedure", req.Spec().Procedure)
@@ -176,29 +200,23 @@ func toCamelCase(s string) string {
 	return strings.Join(parts, "")
 }
 
-// checkMembership checks if the user is a member of the resource specified in the request.
-func (i *RBACAuthzInterceptor) checkMembership(ctx context.Context, req connect.AnyRequest, scopeRule *optionsv1.ScopeRule, userInfo *UserInfo) error {
-	// No membership check needed if neither field is specified
-	if scopeRule.ResourceIdField == "" && scopeRule.ParentResourceIdField == "" {
-		return nil
-	}
-
+// requestResource reads the resource a request targets, from the scope
+// rule's parent resource field for create operations or its resource field
+// otherwise. It returns false when the request doesn't name one.
+func requestResource(ctx context.Context, scopeRule *optionsv1.ScopeRule) (optionsv1.ResourceType, uuid.UUID, bool, error) {
 	bodyBytes, ok := GetRequestMessageAsJSON(ctx)
 	if !ok {
-		// No request body, skip membership check
-		return nil
+		// No request body
+		return 0, uuid.Nil, false, nil
 	}
 
 	var body map[string]any
 	if err := json.Unmarshal(bodyBytes, &body); err != nil {
-		return fmt.Errorf("failed to parse request body: %w", err)
+		return 0, uuid.Nil, false, fmt.Errorf("failed to parse request body: %w", err)
 	}
 
-	slog.Info("RBAC checkMembership", "body", string(bodyBytes))
-
 	var resourceIDStr string
 	var resourceType optionsv1.ResourceType
-	var permission Permission
 
 	if scopeRule.ParentResourceIdField != "" {
 		// For create operations, check parent resource
@@ -215,23 +233,34 @@ func (i *RBACAuthzInterceptor) checkMembership(ctx context.Context, req connect.
 	}
 
 	if resourceIDStr == "" {
-		// No resource ID in request, skip membership check
-		return nil
+		// No resource ID in request
+		return 0, uuid.Nil, false, nil
 	}
 
 	resourceID, err := uuid.Parse(resourceIDStr)
 	if err != nil {
-		return fmt.Errorf("invalid resource ID format: %w", err)
+		return 0, uuid.Nil, false, fmt.Errorf("invalid resource ID format: %w", err)
 	}
+	return resourceType, resourceID, true, nil
+}
 
-	switch scopeRule.Level {
-	case optionsv1.AccessLevel_ACCESS_LEVEL_READ:
-		permission = PermissionRead
-	case optionsv1.AccessLevel_ACCESS_LEVEL_WRITE:
-		permission = PermissionWrite
-	case optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN:
-		permission = PermissionOwner
-	default:
+// checkMembership checks if the user is a member of the resource specified in the request.
+func (i *RBACAuthzInterceptor) checkMembership(ctx context.Context, req connect.AnyRequest, scopeRule *optionsv1.ScopeRule, userInfo *UserInfo) error {
+	// No membership check needed if neither field is specified
+	if scopeRule.ResourceIdField == "" && scopeRule.ParentResourceIdField == "" {
+		return nil
+	}
+
+	resourceType, resourceID, ok, err := requestResource(ctx, scopeRule)
+	if err != nil || !ok {
+		// No resource ID in request, skip membership check
+		return err
+	}
+
+	permission := levelPermission(scopeRule.Level)
+	// Platform auditors can read any resource on read-only procedures, whatever
+	// level they're declared at
+	if hasAuditorRead(ctx) {
 		permission = PermissionRead
 	}
 
@@ -253,3 +282,31 @@ func (i *RBACAuthzInterceptor) checkMembership(ctx context.Context, req connect.
 		return nil
 	}
 }
+
+// levelPermission is the membership permission an access level needs.
+func levelPermission(level optionsv1.AccessLevel) Permission {
+	switch level {
+	case optionsv1.AccessLevel_ACCESS_LEVEL_WRITE:
+		return PermissionWrite
+	case optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN:
+		return PermissionOwner
+	default:
+		return PermissionRead
+	}
+}
+
+// checkNetwork checks the caller's address against the allowlist of the
+// organization the requested resource belongs to.
+func (i *RBACAuthzInterceptor) checkNetwork(ctx context.Context, req connect.AnyRequest, scopeRule *optionsv1.ScopeRule, userInfo *UserInfo) error {
+	if scopeRule.ResourceIdField == "" && scopeRule.ParentResourceIdField == "" {
+		return nil
+	}
+
+	resourceType, resourceID, ok, err := requestResource(ctx, scopeRule)
+	if err != nil || !ok {
+		return err
+	}
+
+	ip, known := ClientIP(req.Peer().Addr, req.Header())
+	return i.authorizer.CheckOrganizationNetwork(ctx, userInfo, resourceType, resourceID, ip, known)
+}
@@ -44,6 +44,23 @@ func (i *ScopeAuthzInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryF
 			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 		}
 
+		// API keys restricted to a set of networks can't be used from anywhere else
+		if len(userInfo.AllowedCIDRs) > 0 {
+			ip, known := ClientIP(req.Peer().Addr, req.Header())
+			if !known || !IPAllowed(userInfo.AllowedCIDRs, ip) {
+				slog.Warn("API key used from outside its allowed networks",
+					"account_id", userInfo.AccountID,
+					"source_ip", ip,
+					"procedure", req.Spec().Procedure)
+				i.auditLogger.Log(ctx, userInfo.AccountID, 0, audit.AccountEntityType, audit.AuthorizationFailure, map[string]any{
+					"error":     "API key used from outside its allowed networks",
+					"source_ip": ip.String(),
+					"procedure": req.Spec().Procedure,
+				})
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this API key can't be used from this network"))
+			}
+		}
+
 		// Extract scope rule from method annotations
 		scopeRule, err := i.extractScopeRule(req.Spec().Procedure)
 		if err != nil {
@@ -66,6 +83,29 @@ func (i *ScopeAuthzInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryF
 			return next(ctx, req)
 		}
 
+		// Platform auditors can read everything and change nothing outside their own account
+		if scopeRule.Resource != optionsv1.ResourceType_RESOURCE_TYPE_ACCOUNT && i.authorizer.IsPlatformAuditor(ctx, userInfo) {
+			if !readOnlyProcedure(req.Spec().Procedure) {
+				slog.Warn("Change denied for platform auditor",
+					"email", userInfo.Email,
+					"account_id", userInfo.AccountID,
+					"procedure", req.Spec().Procedure)
+				i.auditLogger.Log(ctx, userInfo.AccountID, 0, resourceTypeToEntityType(scopeRule.Resource), audit.AuthorizationFailure, map[string]any{
+					"error":     "platform auditors have read-only access",
+					"procedure": req.Spec().Procedure,
+				})
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("platform auditors have read-only access"))
+			}
+
+			ctx = withAuditorRead(ctx)
+			if scopeRule.Resource == optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM && auditorScopeAllows(userInfo.Scopes) {
+				slog.Debug("System read granted to platform auditor",
+					"email", userInfo.Email,
+					"procedure", req.Spec().Procedure)
+				return next(ctx, req)
+			}
+		}
+
 		if scopeRule.Resource == optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM {
 			if len(userInfo.Scopes) > 0 && HasScope(userInfo.Scopes, scopeRule) {
 				slog.Debug("System access granted via scope",
@@ -50,6 +50,9 @@ type GraphBuilder struct {
 	UserUID       types.EntityUID
 	resourceAttrs map[types.EntityUID]types.RecordMap
 	entityParents map[types.EntityUID][]types.EntityUID
+	// roles are the roles added with AddRole, for external policy decision
+	// points
+	roles []DecisionRole
 }
 
 // NewGraphBuilder creates a new graph builder for a user.
@@ -137,23 +140,31 @@ func (b *GraphBuilder) AddHierarchyLink(parentResID, childResID, roleName string
 
 // AddUserRole adds the user to a specific role.
 func (b *GraphBuilder) AddUserRole(resourceID, roleName string) {
-	// Normalize role names from DB
-	normalizedRole := roleName
-	switch roleName {
-	case "read":
-		normalizedRole = "viewer"
-	case "admin":
-		normalizedRole = "owner"
-	}
-
 	roleUID := types.EntityUID{
 		Type: TypeRole,
-		ID:   types.String(fmt.Sprintf("%s:%s", resourceID, normalizedRole)),
+		ID:   types.String(fmt.Sprintf("%s:%s", resourceID, normalizeRole(roleName))),
 	}
 
 	b.addParent(b.UserUID, roleUID)
 }
 
+// AddRole adds the user to a role and records how they got it.
+func (b *GraphBuilder) AddRole(resourceType ResourceType, resourceID, roleName, source string) {
+	b.AddUserRole(resourceID, roleName)
+	b.roles = append(b.roles, DecisionRole{Resource: resourceType, Role: normalizeRole(roleName), Source: source})
+}
+
+// normalizeRole maps role names from the DB to the policy roles.
+func normalizeRole(roleName string) string {
+	switch roleName {
+	case "read":
+		return "viewer"
+	case "admin":
+		return "owner"
+	}
+	return roleName
+}
+
 // AddSyntheticUserRole adds a user to a role directly.
 func (b *GraphBuilder) AddSyntheticUserRole(resourceID, roleName string) {
 	b.AddUserRole(resourceID, roleName)
@@ -198,8 +209,9 @@ func (b *GraphBuilder) Build() types.EntityMap {
 	return entities
 }
 
-// Authorize performs the authorization check.
-func (e *CedarEngine) Authorize(principal, action, resource types.EntityUID, entities types.EntityMap) (bool, error) {
+// Authorize performs the authorization check. It also returns the @id of each
+// policy that permitted the action; when none did, the action is denied.
+func (e *CedarEngine) Authorize(principal, action, resource types.EntityUID, entities types.EntityMap) (bool, []string, error) {
 	req := types.Request{
 		Principal: principal,
 		Action:    action,
@@ -207,8 +219,22 @@ func (e *CedarEngine) Authorize(principal, action, resource types.EntityUID, ent
 		Context:   types.NewRecord(nil),
 	}
 
-	decision, _ := e.policySet.IsAuthorized(entities, req) //nolint:staticcheck // Will upgrade when newer cedar-go version is available
-	return decision == types.Allow, nil
+	decision, diagnostic := e.policySet.IsAuthorized(entities, req) //nolint:staticcheck // Will upgrade when newer cedar-go version is available
+	policies := make([]string, 0, len(diagnostic.Reasons))
+	for _, reason := range diagnostic.Reasons {
+		policies = append(policies, e.policyName(reason.PolicyID))
+	}
+	return decision == types.Allow, policies, nil
+}
+
+// policyName is a policy's @id annotation, or the ID cedar assigned it.
+func (e *CedarEngine) policyName(id types.PolicyID) string {
+	if policy := e.policySet.Get(id); policy != nil {
+		if name, ok := policy.Annotations()["id"]; ok {
+			return string(name)
+		}
+	}
+	return string(id)
 }
 
 // Helper to convert Permission to Action UID
@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/controllerca"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// Controller mTLS modes.
+const (
+	ClientCertModeOff      = "off"
+	ClientCertModeOptional = "optional"
+	ClientCertModeRequired = "required"
+)
+
+// clientCertHeader carries the client certificate verified by the load
+// balancer terminating TLS, as ":<base64 DER>:" (RFC 9440).
+const clientCertHeader = "Client-Cert"
+
+const clientCertKey contextKey = "client_cert"
+
+// ClientCertAuth authenticates site VM controllers by the client certificates
+// the LibOps controller CA issued them, on top of their service account
+// tokens. A controller presenting a certificate can only act for the site it
+// was issued to, and in required mode controller calls without one are
+// refused, apart from requesting a certificate.
+type ClientCertAuth struct {
+	ca            *controllerca.CA
+	queries       db.Querier
+	required      bool
+	headerTrusted bool
+}
+
+// NewClientCertAuth creates controller client certificate authentication.
+// headerTrusted accepts certificates forwarded in the Client-Cert header, for
+// deployments behind a load balancer that verifies them.
+func NewClientCertAuth(ca *controllerca.CA, queries db.Querier, mode string, headerTrusted bool) *ClientCertAuth {
+	return &ClientCertAuth{
+		ca:            ca,
+		queries:       queries,
+		required:      mode == ClientCertModeRequired,
+		headerTrusted: headerTrusted,
+	}
+}
+
+// GetClientCertFromContext returns the identity proven by the request's
+// client certificate, if it presented one.
+func GetClientCertFromContext(ctx context.Context) (*controllerca.Identity, bool) {
+	identity, ok := ctx.Value(clientCertKey).(*controllerca.Identity)
+	return identity, ok
+}
+
+// Middleware verifies the client certificate a request presents against the
+// controller CA. Requests without one pass through; the interceptor decides
+// whether they needed one.
+func (a *ClientCertAuth) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cert, err := a.clientCertificate(r)
+		if err != nil {
+			slog.Warn("client certificate auth failed", "error", err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if cert == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		identity, err := a.ca.Verify(cert)
+		if err != nil {
+			slog.Warn("client certificate auth failed", "serial_number", cert.SerialNumber.Text(16), "error", err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientCertKey, identity)))
+	})
+}
+
+// clientCertificate returns the leaf certificate presented on the connection,
+// or forwarded by a trusted load balancer
+func (a *ClientCertAuth) clientCertificate(r *http.Request) (*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates[0], nil
+	}
+	if !a.headerTrusted {
+		return nil, nil
+	}
+
+	value := strings.TrimSpace(r.Header.Get(clientCertHeader))
+	if value == "" {
+		return nil, nil
+	}
+	if len(value) < 2 || value[0] != ':' || value[len(value)-1] != ':' {
+		return nil, errors.New("malformed Client-Cert header")
+	}
+	encoded := value[1 : len(value)-1]
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("malformed Client-Cert header: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// WrapUnary checks controller calls against the client certificate they
+// presented.
+func (a *ClientCertAuth) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if !isControllerProcedure(procedure) {
+			return next(ctx, req)
+		}
+
+		identity, ok := GetClientCertFromContext(ctx)
+		if !ok {
+			// A controller needs its token alone to get its first certificate
+			if a.required && procedure != libopsv1connect.AdminSiteServiceIssueSiteClientCertificateProcedure {
+				slog.Warn("controller call without a client certificate", "procedure", procedure)
+				return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("client certificate required"))
+			}
+			return next(ctx, req)
+		}
+
+		if msg, ok := req.Any().(interface{ GetSiteId() string }); ok && msg.GetSiteId() != identity.SitePublicID {
+			slog.Warn("client certificate used for another site",
+				"certificate_site_id", identity.SitePublicID,
+				"site_id", msg.GetSiteId(),
+				"procedure", procedure)
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("client certificate was issued to another site"))
+		}
+
+		if err := a.checkRevoked(ctx, identity); err != nil {
+			slog.Warn("client certificate rejected",
+				"site_id", identity.SitePublicID,
+				"serial_number", identity.SerialNumber,
+				"error", err)
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps client streaming RPCs.
+func (a *ClientCertAuth) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler wraps server streaming RPCs.
+func (a *ClientCertAuth) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// checkRevoked rejects certificates the API didn't record issuing, and ones a
+// newer certificate for the site replaced
+func (a *ClientCertAuth) checkRevoked(ctx context.Context, identity *controllerca.Identity) error {
+	cert, err := a.queries.GetSiteClientCertificate(ctx, identity.SerialNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.New("unknown client certificate")
+	}
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	if cert.RevokedAt.Valid {
+		return errors.New("client certificate revoked")
+	}
+	return nil
+}
+
+// isControllerProcedure reports whether a procedure is called by site VM
+// controllers: every SiteAgentService method, and the AdminSiteService
+// methods without a required scope, which operators can't call with their
+// own credentials.
+func isControllerProcedure(procedure string) bool {
+	if strings.HasPrefix(procedure, "/"+libopsv1connect.SiteAgentServiceName+"/") {
+		return true
+	}
+	if !strings.HasPrefix(procedure, "/"+libopsv1connect.AdminSiteServiceName+"/") {
+		return false
+	}
+
+	name := strings.ReplaceAll(strings.TrimPrefix(procedure, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return false
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+	opts, ok := md.Options().(*descriptorpb.MethodOptions)
+	return !ok || !proto.HasExtension(opts, optionsv1.E_RequiredScope)
+}
@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/controllerca"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// checkInStub answers check-ins, standing in for the admin site service
+type checkInStub struct {
+	libopsv1connect.UnimplementedAdminSiteServiceHandler
+}
+
+func (checkInStub) SiteCheckIn(context.Context, *connect.Request[libopsv1.SiteCheckInRequest]) (*connect.Response[libopsv1.SiteCheckInResponse], error) {
+	return connect.NewResponse(&libopsv1.SiteCheckInResponse{Success: true}), nil
+}
+
+func (checkInStub) IssueSiteClientCertificate(context.Context, *connect.Request[libopsv1.IssueSiteClientCertificateRequest]) (*connect.Response[libopsv1.IssueSiteClientCertificateResponse], error) {
+	return connect.NewResponse(&libopsv1.IssueSiteClientCertificateResponse{}), nil
+}
+
+// newControllerCA creates a self-signed controller CA
+func newControllerCA(t *testing.T) *controllerca.CA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "libops controller CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	ca, err := controllerca.New(
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	)
+	require.NoError(t, err)
+	return ca
+}
+
+// issueClientCert issues a certificate for a site and returns it as a
+// Client-Cert header value
+func issueClientCert(t *testing.T, ca *controllerca.CA, siteID string) (string, *controllerca.Issued) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	require.NoError(t, err)
+	issued, err := ca.Issue(siteID, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})))
+	require.NoError(t, err)
+
+	block, _ := pem.Decode([]byte(issued.CertificatePEM))
+	return ":" + base64.StdEncoding.EncodeToString(block.Bytes) + ":", issued
+}
+
+// TestClientCertAuth tests that controllers presenting a certificate can only
+// check in for its site while it isn't revoked, and that required mode only
+// lets controllers without one request a certificate.
+func TestClientCertAuth(t *testing.T) {
+	ca := newControllerCA(t)
+	siteID := uuid.NewString()
+	header, issued := issueClientCert(t, ca, siteID)
+	revoked := map[string]bool{}
+	mock := &testutils.MockQuerier{
+		GetSiteClientCertificateFunc: func(ctx context.Context, serialNumber string) (db.SiteClientCertificate, error) {
+			if serialNumber != issued.SerialNumber {
+				return db.SiteClientCertificate{}, sql.ErrNoRows
+			}
+			return db.SiteClientCertificate{
+				SerialNumber: serialNumber,
+				RevokedAt:    sql.NullTime{Time: time.Now(), Valid: revoked[serialNumber]},
+			}, nil
+		},
+	}
+
+	newClient := func(mode string) libopsv1connect.AdminSiteServiceClient {
+		clientCertAuth := NewClientCertAuth(ca, mock, mode, true)
+		_, handler := libopsv1connect.NewAdminSiteServiceHandler(checkInStub{}, connect.WithInterceptors(clientCertAuth))
+		server := httptest.NewServer(clientCertAuth.Middleware(handler))
+		t.Cleanup(server.Close)
+		return libopsv1connect.NewAdminSiteServiceClient(server.Client(), server.URL)
+	}
+	checkIn := func(client libopsv1connect.AdminSiteServiceClient, siteID, header string) error {
+		req := connect.NewRequest(&libopsv1.SiteCheckInRequest{SiteId: siteID})
+		if header != "" {
+			req.Header().Set(clientCertHeader, header)
+		}
+		_, err := client.SiteCheckIn(context.Background(), req)
+		return err
+	}
+
+	optional := newClient(ClientCertModeOptional)
+	assert.NoError(t, checkIn(optional, siteID, ""), "optional mode accepts controllers without a certificate")
+	assert.NoError(t, checkIn(optional, siteID, header))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(checkIn(optional, uuid.NewString(), header)))
+
+	otherHeader, _ := issueClientCert(t, newControllerCA(t), siteID)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(checkIn(optional, siteID, otherHeader)), "certificates from another CA")
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(checkIn(optional, siteID, "not a certificate")))
+
+	required := newClient(ClientCertModeRequired)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(checkIn(required, siteID, "")))
+	_, err := required.IssueSiteClientCertificate(context.Background(), connect.NewRequest(&libopsv1.IssueSiteClientCertificateRequest{SiteId: siteID}))
+	assert.NoError(t, err, "controllers can request their first certificate without one")
+
+	revoked[issued.SerialNumber] = true
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(checkIn(required, siteID, header)))
+}
+
+// TestClientCertHeaderUntrusted tests that a forwarded certificate is ignored
+// unless the load balancer forwarding it is trusted.
+func TestClientCertHeaderUntrusted(t *testing.T) {
+	ca := newControllerCA(t)
+	header, _ := issueClientCert(t, ca, uuid.NewString())
+
+	var presented bool
+	handler := NewClientCertAuth(ca, &testutils.MockQuerier{}, ClientCertModeOptional, false).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, presented = GetClientCertFromContext(r.Context())
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set(clientCertHeader, header)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.False(t, presented)
+}
@@ -6,9 +6,11 @@ import (
 	"database/sql"
 	"encoding/base64"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/email"
 )
 
 // EmailVerificationToken represents a pending email verification.
@@ -27,9 +29,9 @@ type EmailVerifier struct {
 	apiBaseURL  string
 }
 
-// EmailSender interface for sending emails.
+// EmailSender sends templated emails.
 type EmailSender interface {
-	SendEmail(to, subject, body string) error
+	Send(ctx context.Context, req email.Request) error
 }
 
 // NewEmailVerifier creates a new email verification handler.
@@ -120,46 +122,42 @@ func (v *EmailVerifier) DeleteToken(ctx context.Context, email string) error {
 }
 
 // SendVerificationEmail sends a verification email to the user.
-func (v *EmailVerifier) SendVerificationEmail(email, token string) error {
-	verificationURL := fmt.Sprintf("%s/auth/verify?email=%s&token=%s", v.apiBaseURL, email, token)
-
-	subject := "Verify your libops account"
-	body := fmt.Sprintf(`
-Hello,
-
-Thank you for signing up for libops!
-
-Please verify your email address by clicking the link below:
-
-%s
-
-This link will expire in 24 hours.
-
-If you did not create an account, please ignore this email.
-
-Best regards,
-The libops Team
-`, verificationURL)
-
-	if v.emailSender == nil {
-		// For development/testing - just log the verification URL
-		fmt.Printf("=== EMAIL VERIFICATION ===\n")
-		fmt.Printf("To: %s\n", email)
-		fmt.Printf("Subject: %s\n", subject)
-		fmt.Printf("Verification URL: %s\n", verificationURL)
-		fmt.Printf("========================\n")
-		return nil
-	}
+func (v *EmailVerifier) SendVerificationEmail(ctx context.Context, address, token string) error {
+	verificationURL := fmt.Sprintf("%s/auth/verify?email=%s&token=%s", v.apiBaseURL, url.QueryEscape(address), url.QueryEscape(token))
+
+	return v.emailSender.Send(ctx, email.Request{
+		Template: email.TemplateVerification,
+		To:       address,
+		Data: email.VerificationData{
+			VerifyURL: verificationURL,
+			ExpiresIn: "24 hours",
+		},
+	})
+}
 
-	return v.emailSender.SendEmail(email, subject, body)
+// SendPasswordResetEmail sends a password reset link to the user.
+func (v *EmailVerifier) SendPasswordResetEmail(ctx context.Context, address, token string) error {
+	resetURL := fmt.Sprintf("%s/reset-password?token=%s", v.apiBaseURL, url.QueryEscape(token))
+
+	return v.emailSender.Send(ctx, email.Request{
+		Template: email.TemplatePasswordReset,
+		To:       address,
+		Data: email.PasswordResetData{
+			ResetURL:  resetURL,
+			ExpiresIn: "1 hour",
+		},
+	})
 }
 
-// CleanupExpiredTokens removes expired verification tokens
+// CleanupExpiredTokens removes expired verification and password reset tokens
 // This should be called periodically (e.g., via a cron job).
 func (v *EmailVerifier) CleanupExpiredTokens(ctx context.Context) error {
 	err := v.db.CleanupExpiredVerificationTokens(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to cleanup expired tokens: %w", err)
 	}
+	if err := v.db.CleanupExpiredPasswordResetTokens(ctx); err != nil {
+		return fmt.Errorf("failed to cleanup expired password reset tokens: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// AccessQuestion asks whether an account, optionally through one of its API
+// keys and from a given address, can act on an organization, project or site.
+type AccessQuestion struct {
+	Principal  *UserInfo
+	Resource   optionsv1.ResourceType
+	ResourceID uuid.UUID
+	Level      optionsv1.AccessLevel
+	// APIKeyID is the public ID of the key the request would use; empty
+	// means a session with no scope restrictions.
+	APIKeyID string
+	// SourceIP is where the request would come from; the zero value skips
+	// the network checks.
+	SourceIP netip.Addr
+}
+
+// AccessStep is one check made while deciding a request.
+type AccessStep struct {
+	Check   string
+	Outcome string
+	Detail  string
+}
+
+// AccessExplanation is the decision path for an AccessQuestion, in the order
+// the interceptors and membership checks would take it.
+type AccessExplanation struct {
+	Allowed bool
+	Steps   []AccessStep
+	// Policies are the cedar policies that permitted the action.
+	Policies []string
+	// Reason is why the request was denied.
+	Reason string
+}
+
+// add records a step. It's a no-op on a nil explanation so the checks can
+// trace unconditionally.
+func (e *AccessExplanation) add(check, outcome, detail string) {
+	if e == nil {
+		return
+	}
+	e.Steps = append(e.Steps, AccessStep{Check: check, Outcome: outcome, Detail: detail})
+}
+
+// deny records the step that decided the request and why.
+func (e *AccessExplanation) deny(check, reason string) *AccessExplanation {
+	e.add(check, "denied", reason)
+	e.Reason = reason
+	return e
+}
+
+// ExplainAccess walks through the scope, auditor, membership, relationship,
+// policy and network checks a request would go through and reports each
+// outcome, so support can see why a request was denied without reading code.
+// It only returns an error when the question can't be answered.
+func (a *Authorizer) ExplainAccess(ctx context.Context, q AccessQuestion) (*AccessExplanation, error) {
+	if q.Principal == nil || q.Principal.AccountID == 0 {
+		return nil, fmt.Errorf("principal is required")
+	}
+	switch q.Resource {
+	case optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, optionsv1.ResourceType_RESOURCE_TYPE_PROJECT, optionsv1.ResourceType_RESOURCE_TYPE_SITE:
+	default:
+		return nil, fmt.Errorf("only organization, project and site access can be explained")
+	}
+
+	// Answer for the principal, not for whoever is asking
+	ctx = context.WithValue(ctx, auditorReadKey, false)
+	principal := *q.Principal
+	principal.Scopes, principal.AllowedCIDRs = nil, nil
+	explanation := &AccessExplanation{}
+	required := &optionsv1.ScopeRule{Resource: q.Resource, Level: q.Level}
+	requiredScope := Scope{Resource: q.Resource, Level: q.Level}.String()
+
+	if q.APIKeyID != "" {
+		key, err := a.db.GetActiveAPIKeyByUUID(ctx, q.APIKeyID)
+		if err != nil || key.AccountID != principal.AccountID {
+			return explanation.deny("api key", "the API key isn't an active key of this account"), nil
+		}
+		var scopeStrings []string
+		if len(key.Scopes) > 0 {
+			if err := json.Unmarshal(key.Scopes, &scopeStrings); err != nil {
+				return nil, fmt.Errorf("invalid API key scopes: %w", err)
+			}
+		}
+		if principal.Scopes, err = ParseScopes(scopeStrings); err != nil {
+			return nil, err
+		}
+		if principal.AllowedCIDRs, err = parseStoredCIDRs(key.AllowedCidrs); err != nil {
+			return nil, err
+		}
+		explanation.add("api key", "found", fmt.Sprintf("key %q with scopes [%s]", key.Name, strings.Join(scopeStrings, ", ")))
+
+		if len(principal.AllowedCIDRs) > 0 && q.SourceIP.IsValid() {
+			if !IPAllowed(principal.AllowedCIDRs, q.SourceIP) {
+				return explanation.deny("api key network", fmt.Sprintf("%s is outside the key's allowed networks", q.SourceIP)), nil
+			}
+			explanation.add("api key network", "allowed", fmt.Sprintf("%s is inside the key's allowed networks", q.SourceIP))
+		}
+	}
+
+	if a.IsPlatformAuditor(ctx, &principal) {
+		if q.Level != optionsv1.AccessLevel_ACCESS_LEVEL_READ {
+			return explanation.deny("platform auditor", "platform auditors have read-only access"), nil
+		}
+		ctx = withAuditorRead(ctx)
+		explanation.add("platform auditor", "found", "the account holds an unexpired auditor grant")
+	}
+
+	switch {
+	case len(principal.Scopes) == 0:
+		explanation.add("scope", "skipped", "no scope restrictions")
+	case HasScope(principal.Scopes, required):
+		explanation.add("scope", "allowed", "the key has "+requiredScope)
+	default:
+		return explanation.deny("scope", "the key lacks "+requiredScope), nil
+	}
+
+	permission := levelPermission(q.Level)
+	var err error
+	switch q.Resource {
+	case optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION:
+		err = a.checkOrganizationAccess(ctx, &principal, q.ResourceID, permission, explanation)
+	case optionsv1.ResourceType_RESOURCE_TYPE_PROJECT:
+		err = a.checkProjectAccess(ctx, &principal, q.ResourceID, permission, explanation)
+	case optionsv1.ResourceType_RESOURCE_TYPE_SITE:
+		err = a.checkSiteAccess(ctx, &principal, q.ResourceID, permission, explanation)
+	}
+	if err != nil {
+		explanation.Reason = err.Error()
+		return explanation, nil
+	}
+
+	if q.SourceIP.IsValid() {
+		if err := a.CheckOrganizationNetwork(ctx, &principal, q.Resource, q.ResourceID, q.SourceIP, true); err != nil {
+			return explanation.deny("organization network", err.Error()), nil
+		}
+		explanation.add("organization network", "allowed", fmt.Sprintf("%s is allowed by the organization", q.SourceIP))
+	}
+
+	explanation.Allowed = true
+	return explanation, nil
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// TestExplainAccess tests that an explanation names the membership that
+// counted and the check that denied a request.
+func TestExplainAccess(t *testing.T) {
+	const accountID, auditorID = 10, 20
+	siteID := uuid.New()
+	keyID := uuid.NewString()
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			if publicID != siteID.String() {
+				return db.GetSiteRow{}, sql.ErrNoRows
+			}
+			return db.GetSiteRow{ID: 3, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: 2, OrganizationID: 1}, nil
+		},
+		GetProjectMemberFunc: func(ctx context.Context, arg db.GetProjectMemberParams) (db.GetProjectMemberRow, error) {
+			if arg.AccountID != accountID {
+				return db.GetProjectMemberRow{}, sql.ErrNoRows
+			}
+			return db.GetProjectMemberRow{Role: db.ProjectMembersRoleDeveloper}, nil
+		},
+		GetActivePlatformAuditorFunc: func(ctx context.Context, id int64) (time.Time, error) {
+			if id != auditorID {
+				return time.Time{}, sql.ErrNoRows
+			}
+			return time.Now().Add(time.Hour), nil
+		},
+		GetActiveAPIKeyByUUIDFunc: func(ctx context.Context, publicID string) (db.GetActiveAPIKeyByUUIDRow, error) {
+			return db.GetActiveAPIKeyByUUIDRow{AccountID: accountID, Name: "deploys", Scopes: json.RawMessage(`["site:read"]`)}, nil
+		},
+		GetOrganizationAllowedCidrsFunc: func(ctx context.Context, id int64) (json.RawMessage, error) {
+			return json.RawMessage(`["10.0.0.0/8"]`), nil
+		},
+	}
+	authorizer := NewAuthorizer(mock)
+	explain := func(q AccessQuestion) *AccessExplanation {
+		q.Resource = optionsv1.ResourceType_RESOURCE_TYPE_SITE
+		if q.ResourceID == uuid.Nil {
+			q.ResourceID = siteID
+		}
+		explanation, err := authorizer.ExplainAccess(context.Background(), q)
+		require.NoError(t, err)
+		return explanation
+	}
+	developer := &UserInfo{AccountID: accountID}
+
+	explanation := explain(AccessQuestion{Principal: developer, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE})
+	assert.True(t, explanation.Allowed)
+	assert.Equal(t, []string{"developer_role"}, explanation.Policies)
+	assert.Contains(t, explanation.Steps, AccessStep{Check: "project membership", Outcome: "found", Detail: "role developer, inherited by the site"})
+
+	explanation = explain(AccessQuestion{Principal: developer, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN})
+	assert.False(t, explanation.Allowed)
+	assert.Empty(t, explanation.Policies)
+	assert.Equal(t, AccessStep{Check: "policy", Outcome: "denied", Detail: "no policy grants owner to the roles found"}, explanation.Steps[len(explanation.Steps)-1])
+
+	explanation = explain(AccessQuestion{Principal: developer, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE, APIKeyID: keyID})
+	assert.False(t, explanation.Allowed)
+	assert.Equal(t, "the key lacks site:write", explanation.Reason)
+
+	explanation = explain(AccessQuestion{Principal: developer, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ, SourceIP: netip.MustParseAddr("203.0.113.9")})
+	assert.False(t, explanation.Allowed)
+	assert.Equal(t, "organization network", explanation.Steps[len(explanation.Steps)-1].Check)
+	assert.True(t, explain(AccessQuestion{Principal: developer, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ, SourceIP: netip.MustParseAddr("10.1.2.3")}).Allowed)
+
+	auditor := &UserInfo{AccountID: auditorID}
+	assert.True(t, explain(AccessQuestion{Principal: auditor, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ}).Allowed)
+	assert.Equal(t, "platform auditors have read-only access", explain(AccessQuestion{Principal: auditor, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE}).Reason)
+
+	explanation = explain(AccessQuestion{Principal: developer, ResourceID: uuid.New(), Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ})
+	assert.False(t, explanation.Allowed)
+	assert.Equal(t, "not found", explanation.Steps[len(explanation.Steps)-1].Outcome)
+
+	_, err := authorizer.ExplainAccess(context.Background(), AccessQuestion{Principal: developer, Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM})
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// MaxAllowedCIDRs caps how many CIDRs an API key or organization can list.
+const MaxAllowedCIDRs = 50
+
+// ParseCIDRs parses an allowlist, masking each prefix to its network address.
+func ParseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	if len(cidrs) > MaxAllowedCIDRs {
+		return nil, fmt.Errorf("at most %d CIDRs can be allowed", MaxAllowedCIDRs)
+	}
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", cidr)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// FormatCIDRs converts an allowlist back to strings.
+func FormatCIDRs(prefixes []netip.Prefix) []string {
+	cidrs := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		cidrs = append(cidrs, prefix.String())
+	}
+	return cidrs
+}
+
+// parseStoredCIDRs reads an allowlist stored as a JSON array.
+func parseStoredCIDRs(raw []byte) ([]netip.Prefix, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var cidrs []string
+	if err := json.Unmarshal(raw, &cidrs); err != nil {
+		return nil, fmt.Errorf("invalid allowed CIDRs: %w", err)
+	}
+	return ParseCIDRs(cidrs)
+}
+
+// IPAllowed reports whether ip is in the allowlist. An empty allowlist
+// allows every address.
+func IPAllowed(prefixes []netip.Prefix, ip netip.Addr) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	ip = ip.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address a request came from. The peer is trusted to
+// report the client in X-Forwarded-For only when it's on a private network,
+// like the load balancer, and entries appended by other private proxies are
+// skipped from the right so a client can't spoof its address by sending the
+// header itself. It returns false when the address can't be determined.
+func ClientIP(peerAddr string, header http.Header) (netip.Addr, bool) {
+	peer, ok := parseAddr(peerAddr)
+	if !ok {
+		return netip.Addr{}, false
+	}
+	if !trustedProxy(peer) {
+		return peer, true
+	}
+
+	var hops []string
+	for _, value := range header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	client := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, ok := parseAddr(strings.TrimSpace(hops[i]))
+		if !ok {
+			return netip.Addr{}, false
+		}
+		client = hop
+		if !trustedProxy(hop) {
+			break
+		}
+	}
+	return client, true
+}
+
+// parseAddr parses an address with or without a port.
+func parseAddr(s string) (netip.Addr, bool) {
+	if addrPort, err := netip.ParseAddrPort(s); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+func trustedProxy(addr netip.Addr) bool {
+	return addr.IsPrivate() || addr.IsLoopback()
+}
+
+// CheckOrganizationNetwork checks ip against the allowlist of the
+// organization that owns a resource. Platform service accounts run inside
+// the platform and aren't restricted.
+func (a *Authorizer) CheckOrganizationNetwork(ctx context.Context, userInfo *UserInfo, resourceType optionsv1.ResourceType, resourceID uuid.UUID, ip netip.Addr, known bool) error {
+	if a.IsPlatformServiceAccount(ctx, userInfo) {
+		return nil
+	}
+
+	var organizationID int64
+	switch resourceType {
+	case optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION:
+		organization, err := a.db.GetOrganization(ctx, resourceID.String())
+		if err != nil {
+			return fmt.Errorf("organization not found: %w", err)
+		}
+		organizationID = organization.ID
+	case optionsv1.ResourceType_RESOURCE_TYPE_PROJECT:
+		project, err := a.db.GetProject(ctx, resourceID.String())
+		if err != nil {
+			return fmt.Errorf("project not found: %w", err)
+		}
+		organizationID = project.OrganizationID
+	case optionsv1.ResourceType_RESOURCE_TYPE_SITE:
+		site, err := a.db.GetSite(ctx, resourceID.String())
+		if err != nil {
+			return fmt.Errorf("site not found: %w", err)
+		}
+		project, err := a.db.GetProjectByID(ctx, site.ProjectID)
+		if err != nil {
+			return fmt.Errorf("project not found: %w", err)
+		}
+		organizationID = project.OrganizationID
+	default:
+		return nil
+	}
+
+	raw, err := a.db.GetOrganizationAllowedCidrs(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization allowed CIDRs: %w", err)
+	}
+	prefixes, err := parseStoredCIDRs(raw)
+	if err != nil {
+		return err
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	if !known || !IPAllowed(prefixes, ip) {
+		return errNetworkNotAllowed
+	}
+	return nil
+}
+
+// errNetworkNotAllowed is returned when a request comes from outside an
+// allowlist.
+var errNetworkNotAllowed = errors.New("access from this network is not allowed")
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+func TestParseCIDRs(t *testing.T) {
+	prefixes, err := ParseCIDRs([]string{" 192.0.2.17/24", "2001:db8::1/32"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.0/24", "2001:db8::/32"}, FormatCIDRs(prefixes), "prefixes are masked")
+
+	_, err = ParseCIDRs([]string{"192.0.2.1"})
+	assert.Error(t, err, "a bare address isn't a CIDR")
+
+	_, err = ParseCIDRs(make([]string, MaxAllowedCIDRs+1))
+	assert.Error(t, err)
+}
+
+func TestClientIP(t *testing.T) {
+	xff := func(values ...string) http.Header {
+		header := http.Header{}
+		for _, v := range values {
+			header.Add("X-Forwarded-For", v)
+		}
+		return header
+	}
+
+	tests := []struct {
+		name   string
+		peer   string
+		header http.Header
+		want   string
+		known  bool
+	}{
+		{"public peer", "198.51.100.7:443", nil, "198.51.100.7", true},
+		{"public peer ignores forwarded header", "198.51.100.7:443", xff("192.0.2.1"), "198.51.100.7", true},
+		{"proxy without header", "10.0.0.2:8080", nil, "10.0.0.2", true},
+		{"proxy reports client", "10.0.0.2:8080", xff("192.0.2.1"), "192.0.2.1", true},
+		{"spoofed entry is skipped", "10.0.0.2:8080", xff("203.0.113.9, 192.0.2.1"), "192.0.2.1", true},
+		{"internal hops are skipped", "10.0.0.2:8080", xff("192.0.2.1", "10.0.0.3"), "192.0.2.1", true},
+		{"all internal", "127.0.0.1:8080", xff("10.1.2.3"), "10.1.2.3", true},
+		{"malformed entry", "10.0.0.2:8080", xff("nonsense"), "", false},
+		{"no peer", "", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, known := ClientIP(tt.peer, tt.header)
+			assert.Equal(t, tt.known, known)
+			if tt.known {
+				assert.Equal(t, tt.want, ip.String())
+			}
+		})
+	}
+}
+
+func TestIPAllowed(t *testing.T) {
+	prefixes, err := ParseCIDRs([]string{"192.0.2.0/24"})
+	require.NoError(t, err)
+
+	assert.True(t, IPAllowed(nil, netip.MustParseAddr("203.0.113.1")), "an empty allowlist allows any address")
+	assert.True(t, IPAllowed(prefixes, netip.MustParseAddr("192.0.2.200")))
+	assert.True(t, IPAllowed(prefixes, netip.MustParseAddr("::ffff:192.0.2.200")))
+	assert.False(t, IPAllowed(prefixes, netip.MustParseAddr("203.0.113.1")))
+}
+
+func TestCheckOrganizationNetwork(t *testing.T) {
+	orgPublicID, sitePublicID := uuid.New(), uuid.New()
+	allowed := json.RawMessage(`["192.0.2.0/24"]`)
+	mockDB := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 3, ProjectID: 2, PublicID: publicID}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetOrganizationAllowedCidrsFunc: func(ctx context.Context, id int64) (json.RawMessage, error) {
+			return allowed, nil
+		},
+	}
+	authorizer := NewAuthorizer(mockDB)
+	user := &UserInfo{AccountID: 5, Email: "user@example.edu"}
+	ctx := context.Background()
+	campus, home := netip.MustParseAddr("192.0.2.10"), netip.MustParseAddr("203.0.113.1")
+
+	assert.NoError(t, authorizer.CheckOrganizationNetwork(ctx, user, optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, orgPublicID, campus, true))
+	assert.ErrorIs(t, authorizer.CheckOrganizationNetwork(ctx, user, optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, orgPublicID, home, true), errNetworkNotAllowed)
+	assert.ErrorIs(t, authorizer.CheckOrganizationNetwork(ctx, user, optionsv1.ResourceType_RESOURCE_TYPE_SITE, sitePublicID, home, true), errNetworkNotAllowed, "sites follow their organization")
+	assert.ErrorIs(t, authorizer.CheckOrganizationNetwork(ctx, user, optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, orgPublicID, netip.Addr{}, false), errNetworkNotAllowed, "unknown addresses are denied")
+
+	allowed = json.RawMessage(`[]`)
+	assert.NoError(t, authorizer.CheckOrganizationNetwork(ctx, user, optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, orgPublicID, home, true), "no allowlist allows any address")
+}
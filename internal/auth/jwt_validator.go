@@ -137,11 +137,12 @@ func (v *VaultJWTValidator) Middleware(next http.Handler) http.Handler {
 				} else {
 					// Map APIKeyInfo to UserInfo
 					userInfo := &UserInfo{
-						EntityID:  apiKeyInfo.EntityID,
-						Email:     apiKeyInfo.Email,
-						Name:      apiKeyInfo.Name,
-						AccountID: apiKeyInfo.AccountID,
-						Scopes:    apiKeyInfo.Scopes,
+						EntityID:     apiKeyInfo.EntityID,
+						Email:        apiKeyInfo.Email,
+						Name:         apiKeyInfo.Name,
+						AccountID:    apiKeyInfo.AccountID,
+						Scopes:       apiKeyInfo.Scopes,
+						AllowedCIDRs: apiKeyInfo.AllowedCIDRs,
 					}
 					ctx = context.WithValue(ctx, UserContextKey, userInfo)
 				}
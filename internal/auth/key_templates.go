@@ -0,0 +1,53 @@
+package auth
+
+// KeyTemplate is a vetted set of scopes for a common use of an API key, so keys
+// for CI or audits don't end up with every permission because the scope syntax
+// was in the way
+type KeyTemplate struct {
+	ID          string
+	Name        string
+	Description string
+	Scopes      []string // In ParseScope's resource:level format
+}
+
+// keyTemplates are offered least privileged first
+var keyTemplates = []KeyTemplate{
+	{
+		ID:          "auditor",
+		Name:        "Read-only auditor",
+		Description: "Read organizations, projects and sites without changing anything",
+		Scopes:      []string{"organization:read", "project:read", "site:read"},
+	},
+	{
+		ID:          "deploy",
+		Name:        "Deploy only",
+		Description: "Deploy and roll back sites and check their status, e.g. from CI",
+		Scopes:      []string{"site:read", "site:write"},
+	},
+	{
+		ID:          "infrastructure",
+		Name:        "Infrastructure as code",
+		Description: "Create and change projects and sites, e.g. with Terraform, without deleting them or managing members",
+		Scopes:      []string{"organization:read", "organization:write", "project:read", "project:write", "site:read", "site:write"},
+	},
+}
+
+// KeyTemplates returns the API key templates, least privileged first
+func KeyTemplates() []KeyTemplate {
+	templates := make([]KeyTemplate, len(keyTemplates))
+	for i, template := range keyTemplates {
+		template.Scopes = append([]string(nil), template.Scopes...)
+		templates[i] = template
+	}
+	return templates
+}
+
+// LookupKeyTemplate returns the API key template with an ID
+func LookupKeyTemplate(id string) (KeyTemplate, bool) {
+	for _, template := range KeyTemplates() {
+		if template.ID == id {
+			return template, true
+		}
+	}
+	return KeyTemplate{}, false
+}
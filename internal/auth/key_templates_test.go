@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeyTemplates tests that every template's scopes parse and that none of them
+// grants admin access.
+func TestKeyTemplates(t *testing.T) {
+	for _, template := range KeyTemplates() {
+		scopes, err := ParseScopes(template.Scopes)
+		require.NoError(t, err, template.ID)
+		assert.NotEmpty(t, scopes, "a template without scopes would make an unrestricted key")
+		for _, scope := range template.Scopes {
+			assert.NotContains(t, scope, ":admin", template.ID)
+		}
+
+		found, ok := LookupKeyTemplate(template.ID)
+		assert.True(t, ok)
+		assert.Equal(t, template, found)
+	}
+
+	_, ok := LookupKeyTemplate("")
+	assert.False(t, ok)
+
+	templates := KeyTemplates()
+	templates[0].Scopes[0] = "system:admin"
+	assert.NotEqual(t, "system:admin", KeyTemplates()[0].Scopes[0], "callers get a copy")
+}
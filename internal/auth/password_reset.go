@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/validation"
+)
+
+// passwordResetTTL is how long a password reset link works.
+const passwordResetTTL = time.Hour
+
+// errInvalidResetToken is returned for unknown, used and expired reset tokens alike.
+var errInvalidResetToken = errors.New("invalid or expired password reset link")
+
+// RequestPasswordReset emails a password reset link to a userpass account,
+// replacing any link sent before. Nothing is sent for unknown emails or
+// accounts that sign in another way, and no error says so, so callers can't
+// tell which accounts exist.
+func (c *UserpassClient) RequestPasswordReset(ctx context.Context, email string) error {
+	account, err := c.db.GetAccountByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Info("Password reset requested for non-existent email", "email", email)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+	if account.AuthMethod != db.AccountsAuthMethodUserpass {
+		slog.Info("Password reset requested for account without a password", "email", email, "auth_method", account.AuthMethod)
+		return nil
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := base64.URLEncoding.EncodeToString(tokenBytes)
+
+	err = c.db.UpsertPasswordResetToken(ctx, db.UpsertPasswordResetTokenParams{
+		AccountID: account.ID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store password reset token: %w", err)
+	}
+
+	return c.emailVerifier.SendPasswordResetEmail(ctx, email, token)
+}
+
+// ResetPassword sets a new Vault userpass password for the account a reset
+// link was sent to. The link stops working once it's used.
+func (c *UserpassClient) ResetPassword(ctx context.Context, token, password string) error {
+	if err := validatePasswordComplexity(password); err != nil {
+		return err
+	}
+	reset, err := c.db.GetPasswordResetToken(ctx, hashResetToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return errInvalidResetToken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get password reset token: %w", err)
+	}
+
+	vaultUsername := strings.ReplaceAll(reset.Email, "@", "_")
+	passwordPath := fmt.Sprintf("auth/%s/users/%s/password", c.vaultMountPoint, vaultUsername)
+	if _, err := c.vaultClient.GetAPIClient().Logical().WriteWithContext(ctx, passwordPath, map[string]any{"password": password}); err != nil {
+		return fmt.Errorf("failed to update vault password: %w", err)
+	}
+
+	if err := c.db.DeletePasswordResetToken(ctx, reset.AccountID); err != nil {
+		slog.Warn("Failed to delete password reset token", "account_id", reset.AccountID, "err", err)
+	}
+	// Lockouts from failed attempts with the forgotten password no longer apply
+	if err := c.db.ResetFailedLoginAttempts(ctx, reset.AccountID); err != nil {
+		slog.Warn("Failed to reset failed login attempts", "account_id", reset.AccountID, "err", err)
+	}
+
+	slog.Info("Password reset", "account_id", reset.AccountID)
+	return nil
+}
+
+// HandleRequestPasswordReset handles password reset requests.
+func (c *UserpassClient) HandleRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form data", http.StatusBadRequest)
+		return
+	}
+
+	email := r.FormValue("email")
+	if err := validation.Email(email); err != nil {
+		respondRecoveryError(w, r, "/forgot-password", err.Error())
+		return
+	}
+
+	if err := c.RequestPasswordReset(r.Context(), email); err != nil {
+		// Still return success to prevent email enumeration
+		slog.Error("Failed to request password reset", "err", err, "email", email)
+	}
+
+	respondRecovery(w, r, "/forgot-password", "If a password account exists for this email, a reset link has been sent.")
+}
+
+// HandleResetPassword handles setting a new password from a reset link.
+func (c *UserpassClient) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Error parsing form data", http.StatusBadRequest)
+		return
+	}
+
+	token := r.FormValue("token")
+	password := r.FormValue("password")
+	page := "/reset-password?token=" + url.QueryEscape(token)
+	if token == "" || password == "" {
+		respondRecoveryError(w, r, page, "Token and password are required")
+		return
+	}
+
+	if err := c.ResetPassword(r.Context(), token, password); err != nil {
+		message := err.Error()
+		if !errors.Is(err, errInvalidResetToken) && validatePasswordComplexity(password) == nil {
+			slog.Error("Password reset failed", "err", err)
+			message = "Password reset failed"
+		}
+		respondRecoveryError(w, r, page, message)
+		return
+	}
+
+	respondRecovery(w, r, "/login", "Your password has been reset. You can now sign in.")
+}
+
+// wantsHTML reports whether a request came from a browser form rather than
+// an API client.
+func wantsHTML(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// respondRecovery answers an account recovery request: browsers go back to
+// page with the message shown, API clients get it as JSON.
+func respondRecovery(w http.ResponseWriter, r *http.Request, page, message string) {
+	if wantsHTML(r) {
+		http.Redirect(w, r, withQuery(page, "message", message), http.StatusSeeOther)
+		return
+	}
+	writeRecoveryJSON(w, http.StatusOK, map[string]string{"message": message})
+}
+
+// respondRecoveryError is respondRecovery for a request that failed.
+func respondRecoveryError(w http.ResponseWriter, r *http.Request, page, message string) {
+	if wantsHTML(r) {
+		http.Redirect(w, r, withQuery(page, "error", message), http.StatusSeeOther)
+		return
+	}
+	writeRecoveryJSON(w, http.StatusBadRequest, map[string]string{"error": message})
+}
+
+func writeRecoveryJSON(w http.ResponseWriter, status int, body map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("Failed to write response", "err", err)
+	}
+}
+
+// withQuery adds a query parameter to a path that may already have some.
+func withQuery(path, key, value string) string {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + key + "=" + url.QueryEscape(value)
+}
+
+// hashResetToken is how reset tokens are stored, so a database leak doesn't
+// leak working links.
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/email"
+	"github.com/libops/api/internal/testutils"
+	"github.com/libops/api/internal/vault"
+)
+
+// recordingSender records the emails it's asked to send.
+type recordingSender struct {
+	sent []email.Request
+}
+
+func (s *recordingSender) Send(ctx context.Context, req email.Request) error {
+	s.sent = append(s.sent, req)
+	return nil
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	var stored []db.UpsertPasswordResetTokenParams
+	mock := &testutils.MockQuerier{
+		GetAccountByEmailFunc: func(ctx context.Context, address string) (db.GetAccountByEmailRow, error) {
+			switch address {
+			case "art@vandelay.com":
+				return db.GetAccountByEmailRow{ID: 3, Email: address, AuthMethod: db.AccountsAuthMethodUserpass}, nil
+			case "kramer@vandelay.com":
+				return db.GetAccountByEmailRow{ID: 7, Email: address, AuthMethod: db.AccountsAuthMethodGoogle}, nil
+			}
+			return db.GetAccountByEmailRow{}, sql.ErrNoRows
+		},
+		UpsertPasswordResetTokenFunc: func(ctx context.Context, arg db.UpsertPasswordResetTokenParams) error {
+			stored = append(stored, arg)
+			return nil
+		},
+	}
+	sender := &recordingSender{}
+	client := NewUserpassClient(nil, "userpass", mock, NewEmailVerifier(mock, sender, "https://api.libops.io"))
+
+	require.NoError(t, client.RequestPasswordReset(ctx, "art@vandelay.com"))
+	require.Len(t, sender.sent, 1)
+	require.Len(t, stored, 1)
+	assert.Equal(t, email.TemplatePasswordReset, sender.sent[0].Template)
+	assert.Equal(t, int64(3), stored[0].AccountID)
+
+	link, err := url.Parse(sender.sent[0].Data.(email.PasswordResetData).ResetURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/reset-password", link.Path)
+	token := link.Query().Get("token")
+	assert.Equal(t, hashResetToken(token), stored[0].TokenHash, "only the token's hash is stored")
+	assert.NotEqual(t, token, stored[0].TokenHash)
+
+	// Unknown emails and accounts without a password get nothing, without an error
+	require.NoError(t, client.RequestPasswordReset(ctx, "nobody@vandelay.com"))
+	require.NoError(t, client.RequestPasswordReset(ctx, "kramer@vandelay.com"))
+	assert.Len(t, sender.sent, 1)
+}
+
+func TestResetPassword(t *testing.T) {
+	ctx := context.Background()
+	var written map[string]any
+	var writtenPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writtenPath = r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&written))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	vaultClient, err := vault.NewClientFromAddr(server.URL)
+	require.NoError(t, err)
+
+	var deleted []int64
+	mock := &testutils.MockQuerier{
+		GetPasswordResetTokenFunc: func(ctx context.Context, tokenHash string) (db.GetPasswordResetTokenRow, error) {
+			if tokenHash != hashResetToken("valid-token") {
+				return db.GetPasswordResetTokenRow{}, sql.ErrNoRows
+			}
+			return db.GetPasswordResetTokenRow{AccountID: 3, Email: "art@vandelay.com"}, nil
+		},
+		DeletePasswordResetTokenFunc: func(ctx context.Context, accountID int64) error {
+			deleted = append(deleted, accountID)
+			return nil
+		},
+	}
+	client := NewUserpassClient(vaultClient, "userpass", mock, NewEmailVerifier(mock, &recordingSender{}, "https://api.libops.io"))
+
+	assert.ErrorIs(t, client.ResetPassword(ctx, "other-token", "N3w-Password!"), errInvalidResetToken)
+	assert.Error(t, client.ResetPassword(ctx, "valid-token", "weak"), "the new password must be complex enough")
+	assert.Empty(t, writtenPath)
+
+	require.NoError(t, client.ResetPassword(ctx, "valid-token", "N3w-Password!"))
+	assert.Equal(t, "/v1/auth/userpass/users/art_vandelay.com/password", writtenPath)
+	assert.Equal(t, "N3w-Password!", written["password"])
+	assert.Equal(t, []int64{3}, deleted, "the link stops working")
+}
+
+func TestHandleRequestPasswordResetResponses(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetAccountByEmailFunc: func(ctx context.Context, address string) (db.GetAccountByEmailRow, error) {
+			return db.GetAccountByEmailRow{}, sql.ErrNoRows
+		},
+	}
+	client := NewUserpassClient(nil, "userpass", mock, NewEmailVerifier(mock, &recordingSender{}, "https://api.libops.io"))
+
+	form := url.Values{"email": {"nobody@vandelay.com"}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/auth/userpass/password-reset", strings.NewReader(form))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	w := httptest.NewRecorder()
+	client.HandleRequestPasswordReset(w, req)
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.True(t, strings.HasPrefix(w.Header().Get("Location"), "/forgot-password?message="), "browsers go back to the form")
+
+	req = httptest.NewRequest(http.MethodPost, "/auth/userpass/password-reset", strings.NewReader(form))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	client.HandleRequestPasswordReset(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "If a password account exists for this email")
+}
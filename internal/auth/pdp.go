@@ -0,0 +1,318 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Deployments that need their own delegation rules can hand organization,
+// project and site decisions to an external policy decision point instead of
+// the embedded cedar policies. The memberships, relationships and elevations
+// are still looked up here and sent as input, along with the built-in
+// decision, so a policy only has to encode what differs. Platform service
+// accounts and auditors are decided before the policy decision point is asked,
+// and accounts without any role on a resource are denied without asking it.
+// Listings go through it too, see PolicyFilteredQuerier.
+
+// How an account got a role, in DecisionRole.Source.
+const (
+	RoleSourceMembership   = "membership"
+	RoleSourceRelationship = "relationship"
+	RoleSourceInherited    = "inherited"
+	RoleSourceElevation    = "elevation"
+)
+
+// DecisionInput is what a policy decision point is asked to decide.
+type DecisionInput struct {
+	Principal DecisionPrincipal `json:"principal"`
+	// Action is read, write or owner
+	Action   string           `json:"action"`
+	Resource DecisionResource `json:"resource"`
+	// Roles are the roles the principal holds on the resource and its
+	// parents. Role is owner, developer or viewer.
+	Roles []DecisionRole `json:"roles"`
+	// Allowed is what the built-in policies decided
+	Allowed bool `json:"allowed"`
+}
+
+// DecisionPrincipal is the account asking.
+type DecisionPrincipal struct {
+	AccountID int64  `json:"account_id"`
+	Email     string `json:"email"`
+}
+
+// DecisionResource is the organization, project or site being accessed.
+type DecisionResource struct {
+	Type ResourceType `json:"type"`
+	ID   string       `json:"id"` // public ID
+}
+
+// DecisionRole is a role the principal holds and how it got it.
+type DecisionRole struct {
+	Resource ResourceType `json:"resource"`
+	Role     string       `json:"role"`
+	Source   string       `json:"source"`
+}
+
+// PolicyDecisionPoint decides access in place of the built-in policies.
+type PolicyDecisionPoint interface {
+	Decide(ctx context.Context, input DecisionInput) (bool, error)
+}
+
+// BatchPolicyDecisionPoint is a PolicyDecisionPoint that can decide several
+// inputs in one request. Listings use it to ask about a page at once.
+type BatchPolicyDecisionPoint interface {
+	PolicyDecisionPoint
+	// DecideAll returns one decision per input, in order
+	DecideAll(ctx context.Context, inputs []DecisionInput) ([]bool, error)
+}
+
+// SetPolicyDecisionPoint hands organization, project and site decisions to
+// pdp. Requests are denied when it can't answer.
+func (a *Authorizer) SetPolicyDecisionPoint(pdp PolicyDecisionPoint) {
+	a.pdp = pdp
+}
+
+// decideExternally asks the policy decision point, failing closed.
+func (a *Authorizer) decideExternally(ctx context.Context, userInfo *UserInfo, builder *GraphBuilder, resourceType ResourceType, resourcePublicID string, required Permission, allowed bool, trace *AccessExplanation) error {
+	input := DecisionInput{
+		Principal: DecisionPrincipal{AccountID: userInfo.AccountID, Email: userInfo.Email},
+		Action:    string(PermissionToAction(required).ID),
+		Resource:  DecisionResource{Type: resourceType, ID: resourcePublicID},
+		Roles:     builder.roles,
+		Allowed:   allowed,
+	}
+	if input.Roles == nil {
+		input.Roles = []DecisionRole{}
+	}
+	if deferred, found := ctx.Value(deferredDecisionKey{}).(*DecisionInput); found {
+		*deferred = input
+		return errDecisionDeferred
+	}
+
+	ok, err := a.pdp.Decide(ctx, input)
+	if err != nil {
+		slog.Error("Policy decision point failed, denying access",
+			"error", err,
+			"account_id", userInfo.AccountID,
+			"resource_type", resourceType,
+			"resource_id", resourcePublicID)
+		trace.add("policy decision point", "error", err.Error())
+		return fmt.Errorf("authorization error: %w", err)
+	}
+	if !ok {
+		trace.add("policy decision point", "denied", fmt.Sprintf("the policy decision point doesn't grant %s (built-in policies: %t)", required, allowed))
+		return fmt.Errorf("access denied")
+	}
+
+	trace.add("policy decision point", "allowed", fmt.Sprintf("the policy decision point grants %s (built-in policies: %t)", required, allowed))
+	return nil
+}
+
+// errDecisionDeferred is returned by the access checks instead of asking the
+// policy decision point when the context carries a deferredDecisionKey.
+var errDecisionDeferred = errors.New("decision deferred")
+
+// deferredDecisionKey holds the *DecisionInput an access check fills in when
+// it would ask the policy decision point, so listings can collect a page of
+// inputs and ask about them together.
+type deferredDecisionKey struct{}
+
+// decideAll asks the policy decision point about inputs, in one request when
+// it can batch them. Every input is denied when it can't answer.
+func (a *Authorizer) decideAll(ctx context.Context, inputs []DecisionInput) []bool {
+	decisions := make([]bool, len(inputs))
+	if len(inputs) == 0 {
+		return decisions
+	}
+
+	var err error
+	if batch, ok := a.pdp.(BatchPolicyDecisionPoint); ok {
+		var allowed []bool
+		allowed, err = batch.DecideAll(ctx, inputs)
+		if err == nil && len(allowed) != len(inputs) {
+			err = fmt.Errorf("got %d decisions for %d inputs", len(allowed), len(inputs))
+		}
+		if err == nil {
+			copy(decisions, allowed)
+		}
+	} else {
+		for i, input := range inputs {
+			if decisions[i], err = a.pdp.Decide(ctx, input); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		slog.Error("Policy decision point failed, denying access", "error", err, "decisions", len(inputs))
+		return make([]bool, len(inputs))
+	}
+	return decisions
+}
+
+// OPAClient asks an Open Policy Agent server, or anything serving its data
+// API, e.g. POST http://opa:8181/v1/data/libops/authz/allow. The rule must
+// evaluate to a boolean or to an object with an allow boolean; an undefined
+// rule denies. When url is under /v1/data/, batches are evaluated in one
+// request to the server's query API.
+type OPAClient struct {
+	url    string
+	client *http.Client
+	// queryURL and rule evaluate a batch through /v1/query, empty when url
+	// isn't a data API path
+	queryURL string
+	rule     string
+}
+
+var _ BatchPolicyDecisionPoint = (*OPAClient)(nil)
+
+// NewOPAClient creates a client for the rule at url.
+func NewOPAClient(url string, timeout time.Duration) *OPAClient {
+	c := &OPAClient{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+	c.queryURL, c.rule = opaBatchQuery(url)
+	return c
+}
+
+// opaBatchQuery returns the query API URL and the rule reference for the data
+// API rule at ruleURL, e.g. http://opa:8181/v1/query and
+// data["libops"]["authz"]["allow"] for http://opa:8181/v1/data/libops/authz/allow.
+func opaBatchQuery(ruleURL string) (string, string) {
+	u, err := url.Parse(ruleURL)
+	if err != nil {
+		return "", ""
+	}
+	base, path, ok := strings.Cut(u.Path, "/v1/data/")
+	if !ok || path == "" {
+		return "", ""
+	}
+
+	var rule strings.Builder
+	rule.WriteString("data")
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		rule.WriteString("[" + strconv.Quote(segment) + "]")
+	}
+	u.Path = base + "/v1/query"
+	u.RawQuery = ""
+	return u.String(), rule.String()
+}
+
+// Decide evaluates the rule with input.
+func (c *OPAClient) Decide(ctx context.Context, input DecisionInput) (bool, error) {
+	body, err := json.Marshal(map[string]any{"input": input})
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var decision struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := c.do(req, &decision); err != nil {
+		return false, err
+	}
+	return opaAllows(decision.Result)
+}
+
+// DecideAll evaluates the rule once per input in a single query, falling back
+// to a request per input when the rule isn't under /v1/data/.
+func (c *OPAClient) DecideAll(ctx context.Context, inputs []DecisionInput) ([]bool, error) {
+	decisions := make([]bool, len(inputs))
+	if c.queryURL == "" {
+		for i, input := range inputs {
+			ok, err := c.Decide(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+			decisions[i] = ok
+		}
+		return decisions, nil
+	}
+
+	// Inputs the rule is undefined for are left out of decisions, and denied
+	query := "decisions := {i: d | some i; x := input.inputs[i]; d := " + c.rule + " with input as x}"
+	body, err := json.Marshal(map[string]any{"query": query, "input": map[string]any{"inputs": inputs}})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.queryURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var response struct {
+		Result []struct {
+			Decisions map[string]json.RawMessage `json:"decisions"`
+		} `json:"result"`
+	}
+	if err := c.do(req, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Result) == 0 {
+		return decisions, nil
+	}
+	for key, result := range response.Result[0].Decisions {
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(inputs) {
+			return nil, fmt.Errorf("invalid OPA response: unexpected decision %q", key)
+		}
+		if decisions[i], err = opaAllows(result); err != nil {
+			return nil, err
+		}
+	}
+	return decisions, nil
+}
+
+// do sends req and decodes the response into v.
+func (c *OPAClient) do(req *http.Request, v any) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to query OPA: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("OPA returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid OPA response: %w", err)
+	}
+	return nil
+}
+
+// opaAllows reads a rule's result, a boolean or an object with an allow
+// boolean. An undefined result denies.
+func opaAllows(result json.RawMessage) (bool, error) {
+	if len(result) == 0 {
+		return false, nil
+	}
+
+	var allow bool
+	if err := json.Unmarshal(result, &allow); err == nil {
+		return allow, nil
+	}
+	var decision struct {
+		Allow bool `json:"allow"`
+	}
+	if err := json.Unmarshal(result, &decision); err != nil {
+		return false, fmt.Errorf("OPA result must be a boolean or an object with allow: %s", result)
+	}
+	return decision.Allow, nil
+}
@@ -0,0 +1,318 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+)
+
+// Listings are read from effective_organization_access and the membership
+// tables, which only know the built-in roles. When a policy decision point is
+// configured, PolicyFilteredQuerier drops the rows it wouldn't let the account
+// read, so a listing never shows what a Get of the same resource refuses. The
+// decision point is only asked about resources the account holds a role on
+// (see Authorizer.decide), which are the ones listings can find, so it can't
+// grant access a listing would miss either.
+//
+// Each page's resources are decided together, in one request when the
+// decision point can batch them. Keyset-paginated listings fetch the rows
+// after a page's last one until the page is full again, so a page is only
+// short when the listing ends and the cursor taken from its last row doesn't
+// skip anything. Offset-paginated and unpaginated listings can come back
+// shorter than their limit.
+
+// PolicyFilteredQuerier wraps a db.Querier and filters the account listings
+// through the authorizer's policy decision point. Everything else passes
+// through.
+type PolicyFilteredQuerier struct {
+	db.Querier
+	authorizer *Authorizer
+}
+
+var _ db.Querier = (*PolicyFilteredQuerier)(nil)
+
+// NewPolicyFilteredQuerier filters next's listings through authorizer, which
+// must not read through the returned querier.
+func NewPolicyFilteredQuerier(next db.Querier, authorizer *Authorizer) *PolicyFilteredQuerier {
+	return &PolicyFilteredQuerier{Querier: next, authorizer: authorizer}
+}
+
+// GetDB exposes the wrapped DBTX so raw-SQL callers keep working.
+func (q *PolicyFilteredQuerier) GetDB() db.DBTX {
+	if p, ok := q.Querier.(db.DBProvider); ok {
+		return p.GetDB()
+	}
+	return nil
+}
+
+// readable reports whether an account may read resources, deciding each one
+// once per listing. Listings are run for the caller, so its UserInfo is used
+// when it's the account being listed.
+type readable struct {
+	ctx        context.Context
+	authorizer *Authorizer
+	user       *UserInfo
+	decided    map[string]bool
+}
+
+func (q *PolicyFilteredQuerier) readable(ctx context.Context, accountID int64) *readable {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil || user.AccountID != accountID {
+		user = &UserInfo{AccountID: accountID}
+	}
+	return &readable{ctx: ctx, authorizer: q.authorizer, user: user, decided: map[string]bool{}}
+}
+
+// resourceRef is a row's organization, project or site. Other parent types
+// aren't access controlled here and are allowed.
+type resourceRef struct {
+	resourceType ResourceType
+	publicID     string
+}
+
+func (ref resourceRef) key() string {
+	return string(ref.resourceType) + "/" + ref.publicID
+}
+
+// decide settles the resources that haven't been decided yet. The access
+// checks run with the decision deferred, and whatever they'd have asked the
+// policy decision point is asked in one go.
+func (r *readable) decide(resources []resourceRef) {
+	var pending []string
+	var inputs []DecisionInput
+	for _, ref := range resources {
+		key := ref.key()
+		if _, found := r.decided[key]; found {
+			continue
+		}
+		id, err := uuid.Parse(ref.publicID)
+		if err != nil {
+			r.decided[key] = false
+			continue
+		}
+
+		var input DecisionInput
+		ctx := context.WithValue(r.ctx, deferredDecisionKey{}, &input)
+		switch ref.resourceType {
+		case ResourceOrganization:
+			err = r.authorizer.CheckOrganizationAccess(ctx, r.user, id, PermissionRead)
+		case ResourceProject:
+			err = r.authorizer.CheckProjectAccess(ctx, r.user, id, PermissionRead)
+		case ResourceSite:
+			err = r.authorizer.CheckSiteAccess(ctx, r.user, id, PermissionRead)
+		}
+		r.decided[key] = err == nil
+		if errors.Is(err, errDecisionDeferred) {
+			pending = append(pending, key)
+			inputs = append(inputs, input)
+		}
+	}
+
+	for i, ok := range r.authorizer.decideAll(r.ctx, inputs) {
+		r.decided[pending[i]] = ok
+	}
+}
+
+// filterReadable keeps the rows whose resource the account can read.
+func filterReadable[T any](r *readable, rows []T, resource func(T) (ResourceType, string)) []T {
+	refs := make([]resourceRef, len(rows))
+	for i, row := range rows {
+		refs[i].resourceType, refs[i].publicID = resource(row)
+	}
+	r.decide(refs)
+
+	kept := rows[:0]
+	for i, row := range rows {
+		if r.decided[refs[i].key()] {
+			kept = append(kept, row)
+		}
+	}
+	return kept
+}
+
+// fillPage filters a keyset-paginated listing, calling list again for the
+// rows after the last one it returned until limit rows are kept or the
+// listing runs out. after is nil for the first call. Without a limit, the
+// first call's rows are all that's filtered.
+func fillPage[T any](r *readable, limit int32, list func(after *T) ([]T, error), resource func(T) (ResourceType, string)) ([]T, error) {
+	var page []T
+	var after *T
+	for {
+		rows, err := list(after)
+		if err != nil {
+			return nil, err
+		}
+		fetched := len(rows)
+		if fetched > 0 {
+			last := rows[fetched-1]
+			after = &last
+		}
+
+		page = append(page, filterReadable(r, rows, resource)...)
+		if limit <= 0 || fetched < int(limit) || len(page) >= int(limit) {
+			break
+		}
+	}
+	if limit > 0 && len(page) > int(limit) {
+		page = page[:limit]
+	}
+	return page, nil
+}
+
+// cursorAfter is the keyset cursor for the rows after one, matching the page
+// tokens the services hand out.
+func cursorAfter(createdAt sql.NullTime, id int64) (sql.NullTime, sql.NullInt64) {
+	if !createdAt.Valid {
+		createdAt = sql.NullTime{Time: time.Unix(0, 0).UTC(), Valid: true}
+	}
+	return createdAt, sql.NullInt64{Int64: id, Valid: true}
+}
+
+func (q *PolicyFilteredQuerier) ListOrganizations(ctx context.Context, arg db.ListOrganizationsParams) ([]db.ListOrganizationsRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListOrganizations(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListOrganizationsRow) ([]db.ListOrganizationsRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListOrganizations(ctx, arg)
+	}, func(row db.ListOrganizationsRow) (ResourceType, string) {
+		return ResourceOrganization, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserOrganizations(ctx context.Context, arg db.ListUserOrganizationsParams) ([]db.ListUserOrganizationsRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListUserOrganizations(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListUserOrganizationsRow) ([]db.ListUserOrganizationsRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListUserOrganizations(ctx, arg)
+	}, func(row db.ListUserOrganizationsRow) (ResourceType, string) {
+		return ResourceOrganization, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserProjects(ctx context.Context, arg db.ListUserProjectsParams) ([]db.ListUserProjectsRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListUserProjects(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListUserProjectsRow) ([]db.ListUserProjectsRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListUserProjects(ctx, arg)
+	}, func(row db.ListUserProjectsRow) (ResourceType, string) {
+		return ResourceProject, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserProjectsWithOrg(ctx context.Context, arg db.ListUserProjectsWithOrgParams) ([]db.ListUserProjectsWithOrgRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListUserProjectsWithOrg(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListUserProjectsWithOrgRow) ([]db.ListUserProjectsWithOrgRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListUserProjectsWithOrg(ctx, arg)
+	}, func(row db.ListUserProjectsWithOrgRow) (ResourceType, string) {
+		return ResourceProject, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserSites(ctx context.Context, arg db.ListUserSitesParams) ([]db.ListUserSitesRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListUserSites(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListUserSitesRow) ([]db.ListUserSitesRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListUserSites(ctx, arg)
+	}, func(row db.ListUserSitesRow) (ResourceType, string) {
+		return ResourceSite, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserSitesWithProject(ctx context.Context, arg db.ListUserSitesWithProjectParams) ([]db.ListUserSitesWithProjectRow, error) {
+	if q.authorizer.pdp == nil {
+		return q.Querier.ListUserSitesWithProject(ctx, arg)
+	}
+	return fillPage(q.readable(ctx, arg.AccountID), arg.Limit, func(after *db.ListUserSitesWithProjectRow) ([]db.ListUserSitesWithProjectRow, error) {
+		if after != nil {
+			arg.CursorCreatedAt, arg.CursorID = cursorAfter(after.CreatedAt, after.ID)
+		}
+		return q.Querier.ListUserSitesWithProject(ctx, arg)
+	}, func(row db.ListUserSitesWithProjectRow) (ResourceType, string) {
+		return ResourceSite, row.PublicID
+	})
+}
+
+func (q *PolicyFilteredQuerier) ListUserSiteLiveStatus(ctx context.Context, arg db.ListUserSiteLiveStatusParams) ([]db.ListUserSiteLiveStatusRow, error) {
+	rows, err := q.Querier.ListUserSiteLiveStatus(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.ListUserSiteLiveStatusRow) (ResourceType, string) {
+		return ResourceSite, row.PublicID
+	}), nil
+}
+
+func (q *PolicyFilteredQuerier) SearchUserResources(ctx context.Context, arg db.SearchUserResourcesParams) ([]db.SearchUserResourcesRow, error) {
+	rows, err := q.Querier.SearchUserResources(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.SearchUserResourcesRow) (ResourceType, string) {
+		return ResourceType(row.ParentType), row.ParentPublicID
+	}), nil
+}
+
+func (q *PolicyFilteredQuerier) ListUserSecrets(ctx context.Context, arg db.ListUserSecretsParams) ([]db.ListUserSecretsRow, error) {
+	rows, err := q.Querier.ListUserSecrets(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.ListUserSecretsRow) (ResourceType, string) {
+		return ResourceType(row.ParentType), row.ParentPublicID
+	}), nil
+}
+
+func (q *PolicyFilteredQuerier) ListUserSettings(ctx context.Context, arg db.ListUserSettingsParams) ([]db.ListUserSettingsRow, error) {
+	rows, err := q.Querier.ListUserSettings(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.ListUserSettingsRow) (ResourceType, string) {
+		return ResourceType(row.ParentType), row.ParentPublicID
+	}), nil
+}
+
+func (q *PolicyFilteredQuerier) ListUserFirewallRules(ctx context.Context, arg db.ListUserFirewallRulesParams) ([]db.ListUserFirewallRulesRow, error) {
+	rows, err := q.Querier.ListUserFirewallRules(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.ListUserFirewallRulesRow) (ResourceType, string) {
+		return ResourceType(row.ParentType), row.ParentPublicID
+	}), nil
+}
+
+func (q *PolicyFilteredQuerier) ListUserMemberships(ctx context.Context, arg db.ListUserMembershipsParams) ([]db.ListUserMembershipsRow, error) {
+	rows, err := q.Querier.ListUserMemberships(ctx, arg)
+	if err != nil || q.authorizer.pdp == nil {
+		return rows, err
+	}
+	return filterReadable(q.readable(ctx, arg.AccountID), rows, func(row db.ListUserMembershipsRow) (ResourceType, string) {
+		return ResourceType(row.ParentType), row.ParentPublicID
+	}), nil
+}
@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestOPADecisions tests that an OPA rule decides membership checks with the
+// roles found as input, and that access is denied when OPA can't answer.
+func TestOPADecisions(t *testing.T) {
+	projectID := uuid.New()
+	var inputs []DecisionInput
+	result := `true`
+	status := http.StatusOK
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input DecisionInput `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		inputs = append(inputs, body.Input)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"result": ` + result + `}`))
+	}))
+	t.Cleanup(opa.Close)
+
+	mock := &testutils.MockQuerier{
+		GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+			return db.GetProjectRow{ID: 2, PublicID: publicID, OrganizationID: 1}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			if arg.OrganizationID != 1 {
+				return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+	}
+	authorizer := NewAuthorizer(mock)
+	authorizer.SetPolicyDecisionPoint(NewOPAClient(opa.URL, time.Second))
+	user := &UserInfo{AccountID: 10, Email: "jerry@example.edu"}
+
+	// A consortium rule can grant more than the built-in policies
+	require.NoError(t, authorizer.CheckProjectAccess(context.Background(), user, projectID, PermissionWrite))
+	require.Len(t, inputs, 1)
+	assert.Equal(t, DecisionInput{
+		Principal: DecisionPrincipal{AccountID: 10, Email: "jerry@example.edu"},
+		Action:    "write",
+		Resource:  DecisionResource{Type: ResourceProject, ID: projectID.String()},
+		Roles:     []DecisionRole{{Resource: ResourceOrganization, Role: "viewer", Source: RoleSourceMembership}},
+		Allowed:   false,
+	}, inputs[0])
+
+	result = `{"allow": false}`
+	assert.Error(t, authorizer.CheckProjectAccess(context.Background(), user, projectID, PermissionRead), "and less")
+
+	result = `null`
+	status = http.StatusInternalServerError
+	assert.Error(t, authorizer.CheckProjectAccess(context.Background(), user, projectID, PermissionRead), "errors deny")
+
+	status = http.StatusOK
+	opa.Close()
+	assert.Error(t, authorizer.CheckProjectAccess(context.Background(), user, projectID, PermissionRead), "an unreachable OPA denies")
+}
+
+// TestOPAClientUndefinedRule tests that a rule with no result denies.
+func TestOPAClientUndefinedRule(t *testing.T) {
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(opa.Close)
+
+	allowed, err := NewOPAClient(opa.URL, time.Second).Decide(context.Background(), DecisionInput{})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+// TestOPAOnlyAskedWithARole tests that an account without any role on a
+// resource is denied without asking OPA, since listings would never find it.
+func TestOPAOnlyAskedWithARole(t *testing.T) {
+	asked := 0
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		asked++
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	t.Cleanup(opa.Close)
+
+	mock := &testutils.MockQuerier{
+		GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+			return db.GetProjectRow{ID: 2, PublicID: publicID, OrganizationID: 1}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+		},
+		GetProjectMemberFunc: func(ctx context.Context, arg db.GetProjectMemberParams) (db.GetProjectMemberRow, error) {
+			return db.GetProjectMemberRow{}, sql.ErrNoRows
+		},
+	}
+	authorizer := NewAuthorizer(mock)
+	authorizer.SetPolicyDecisionPoint(NewOPAClient(opa.URL, time.Second))
+
+	err := authorizer.CheckProjectAccess(context.Background(), &UserInfo{AccountID: 10}, uuid.New(), PermissionRead)
+	assert.Error(t, err)
+	assert.Zero(t, asked)
+}
+
+// TestPolicyFilteredListings tests that listings drop what OPA doesn't let the
+// account read, and pass through without a policy decision point.
+func TestPolicyFilteredListings(t *testing.T) {
+	allowedProject, deniedProject := uuid.New(), uuid.New()
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input DecisionInput `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		allow := body.Input.Resource.ID != deniedProject.String()
+		_ = json.NewEncoder(w).Encode(map[string]bool{"result": allow})
+	}))
+	t.Cleanup(opa.Close)
+
+	mock := &testutils.MockQuerier{
+		GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+			return db.GetProjectRow{ID: 2, PublicID: publicID, OrganizationID: 1}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+		ListUserProjectsFunc: func(ctx context.Context, arg db.ListUserProjectsParams) ([]db.ListUserProjectsRow, error) {
+			return []db.ListUserProjectsRow{{PublicID: allowedProject.String()}, {PublicID: deniedProject.String()}}, nil
+		},
+		ListUserSecretsFunc: func(ctx context.Context, arg db.ListUserSecretsParams) ([]db.ListUserSecretsRow, error) {
+			return []db.ListUserSecretsRow{
+				{Name: "A", ParentType: "project", ParentPublicID: allowedProject.String()},
+				{Name: "B", ParentType: "project", ParentPublicID: deniedProject.String()},
+			}, nil
+		},
+	}
+	authorizer := NewAuthorizer(mock)
+	ctx := context.WithValue(context.Background(), UserContextKey, &UserInfo{AccountID: 10, Email: "jerry@example.edu"})
+
+	unfiltered, err := NewPolicyFilteredQuerier(mock, authorizer).ListUserProjects(ctx, db.ListUserProjectsParams{AccountID: 10})
+	require.NoError(t, err)
+	assert.Len(t, unfiltered, 2, "the built-in policies already agree with listings")
+
+	authorizer.SetPolicyDecisionPoint(NewOPAClient(opa.URL, time.Second))
+	queries := NewPolicyFilteredQuerier(mock, authorizer)
+
+	projects, err := queries.ListUserProjects(ctx, db.ListUserProjectsParams{AccountID: 10})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, allowedProject.String(), projects[0].PublicID)
+	assert.Error(t, authorizer.CheckProjectAccess(ctx, &UserInfo{AccountID: 10}, deniedProject, PermissionRead), "and Get agrees")
+
+	secrets, err := queries.ListUserSecrets(ctx, db.ListUserSecretsParams{AccountID: 10})
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "A", secrets[0].Name)
+}
+
+// TestPolicyFilteredPages tests that a keyset listing asks OPA about each page
+// in one query, fetches on until the page is full, and that paging with the
+// cursor of each page's last row lists every readable project once.
+func TestPolicyFilteredPages(t *testing.T) {
+	projects := make([]db.ListUserProjectsRow, 10)
+	denied := map[string]bool{}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range projects {
+		projects[i] = db.ListUserProjectsRow{
+			ID:        int64(len(projects) - i),
+			PublicID:  uuid.NewString(),
+			CreatedAt: sql.NullTime{Time: start.Add(-time.Duration(i) * time.Hour), Valid: true},
+		}
+		denied[projects[i].PublicID] = i%3 == 1
+	}
+
+	queries := 0
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/query" {
+			t.Errorf("asked %s, want one query per page", r.URL.Path)
+			return
+		}
+		queries++
+		var body struct {
+			Query string `json:"query"`
+			Input struct {
+				Inputs []DecisionInput `json:"inputs"`
+			} `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Contains(t, body.Query, `data["libops"]["authz"]["allow"] with input as`)
+		decisions := map[string]any{}
+		for i, input := range body.Input.Inputs {
+			if !denied[input.Resource.ID] {
+				decisions[fmt.Sprint(i)] = map[string]bool{"allow": true}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": []any{map[string]any{"decisions": decisions}}})
+	}))
+	t.Cleanup(opa.Close)
+
+	fetches := 0
+	mock := &testutils.MockQuerier{
+		GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+			return db.GetProjectRow{ID: 2, PublicID: publicID, OrganizationID: 1}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+		ListUserProjectsFunc: func(ctx context.Context, arg db.ListUserProjectsParams) ([]db.ListUserProjectsRow, error) {
+			fetches++
+			var rows []db.ListUserProjectsRow
+			for _, project := range projects {
+				after := !arg.CursorCreatedAt.Valid || project.CreatedAt.Time.Before(arg.CursorCreatedAt.Time) ||
+					(project.CreatedAt.Time.Equal(arg.CursorCreatedAt.Time) && project.ID < arg.CursorID.Int64)
+				if after && len(rows) < int(arg.Limit) {
+					rows = append(rows, project)
+				}
+			}
+			return rows, nil
+		},
+	}
+	authorizer := NewAuthorizer(mock)
+	authorizer.SetPolicyDecisionPoint(NewOPAClient(opa.URL+"/v1/data/libops/authz/allow", time.Second))
+	filtered := NewPolicyFilteredQuerier(mock, authorizer)
+	ctx := context.WithValue(context.Background(), UserContextKey, &UserInfo{AccountID: 10})
+
+	var listed, pages []int
+	arg := db.ListUserProjectsParams{AccountID: 10, Limit: 3}
+	for {
+		fetches, queries = 0, 0
+		page, err := filtered.ListUserProjects(ctx, arg)
+		require.NoError(t, err)
+		assert.Equal(t, fetches, queries, "one OPA query per fetch")
+		pages = append(pages, len(page))
+		for _, project := range page {
+			assert.False(t, denied[project.PublicID])
+			listed = append(listed, int(project.ID))
+		}
+		if len(page) < int(arg.Limit) {
+			break
+		}
+		last := page[len(page)-1]
+		arg.CursorCreatedAt, arg.CursorID = last.CreatedAt, sql.NullInt64{Int64: last.ID, Valid: true}
+	}
+
+	assert.Equal(t, []int{10, 8, 7, 5, 4, 2, 1}, listed)
+	assert.Equal(t, []int{3, 3, 1}, pages, "pages are only short at the end")
+}
+
+func TestOPABatchQuery(t *testing.T) {
+	queryURL, rule := opaBatchQuery("http://opa:8181/v1/data/libops/authz/allow")
+	assert.Equal(t, "http://opa:8181/v1/query", queryURL)
+	assert.Equal(t, `data["libops"]["authz"]["allow"]`, rule)
+
+	queryURL, rule = opaBatchQuery("http://policies.internal/decide")
+	assert.Empty(t, queryURL, "only the data API can be batched")
+	assert.Empty(t, rule)
+}
@@ -129,7 +129,7 @@ func (m *ReconciliationGSAMiddleware) validateOrganizationGSA(ctx context.Contex
 
 	var orgID int64
 	var publicID, orgGCPProjectID string
-	err := m.queries.(*db.Queries).GetDB().QueryRowContext(ctx, query, resourceIDShort+"%").Scan(&orgID, &publicID, &orgGCPProjectID)
+	err := m.queries.(db.DBProvider).GetDB().QueryRowContext(ctx, query, resourceIDShort+"%").Scan(&orgID, &publicID, &orgGCPProjectID)
 	if err != nil {
 		return nil, fmt.Errorf("organization not found for ID prefix %s: %w", resourceIDShort, err)
 	}
@@ -161,7 +161,7 @@ func (m *ReconciliationGSAMiddleware) validateProjectGSA(ctx context.Context, re
 
 	var projectID, organizationID int64
 	var publicID, projGCPProjectID string
-	err := m.queries.(*db.Queries).GetDB().QueryRowContext(ctx, query, resourceIDShort+"%").Scan(&projectID, &publicID, &projGCPProjectID, &organizationID)
+	err := m.queries.(db.DBProvider).GetDB().QueryRowContext(ctx, query, resourceIDShort+"%").Scan(&projectID, &publicID, &projGCPProjectID, &organizationID)
 	if err != nil {
 		return nil, fmt.Errorf("project not found for ID prefix %s: %w", resourceIDShort, err)
 	}
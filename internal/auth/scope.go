@@ -62,6 +62,20 @@ func ScopesToStrings(scopes []Scope) []string {
 	return strs
 }
 
+// GrantsWrite reports whether an API key with these scopes can make changes.
+// A key without scopes can do anything its holder can.
+func GrantsWrite(scopes []Scope) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		if scope.Level != optionsv1.AccessLevel_ACCESS_LEVEL_READ {
+			return true
+		}
+	}
+	return false
+}
+
 func HasScope(userScopes []Scope, required *optionsv1.ScopeRule) bool {
 	// No requirement means access is granted
 	if required == nil {
@@ -216,8 +230,16 @@ func MapOAuthScopesToStructured(oauthScopes []string) []Scope {
 		"write:firewall":  {Resource: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE},
 		"delete:firewall": {Resource: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN},
 
+		// Billing scopes
+		"read:billing":  {Resource: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ},
+		"write:billing": {Resource: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN},
+
 		// System scope
 		"admin:system": {Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN},
+
+		// Admin console scopes, deliberately separate from admin:system
+		"read:platform":  {Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ},
+		"write:platform": {Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE},
 	}
 
 	scopes := make([]Scope, 0, len(oauthScopes))
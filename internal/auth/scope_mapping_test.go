@@ -56,6 +56,19 @@ func TestMapOAuthScopesToStructured_Comprehensive(t *testing.T) {
 				{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SITE, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN},
 			},
 		},
+		{
+			name: "Admin console scopes are separate from admin:system",
+			input: []string{
+				"read:platform",
+				"write:platform",
+				"admin:system",
+			},
+			expected: []Scope{
+				{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ},
+				{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE},
+				{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SYSTEM, Level: optionsv1.AccessLevel_ACCESS_LEVEL_ADMIN},
+			},
+		},
 	}
 
 	for _, tt := range tests {
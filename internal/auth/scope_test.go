@@ -132,3 +132,12 @@ func TestHasScope(t *testing.T) {
 		})
 	}
 }
+
+func TestGrantsWrite(t *testing.T) {
+	read := Scope{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SITE, Level: optionsv1.AccessLevel_ACCESS_LEVEL_READ}
+	write := Scope{Resource: optionsv1.ResourceType_RESOURCE_TYPE_SITE, Level: optionsv1.AccessLevel_ACCESS_LEVEL_WRITE}
+
+	assert.True(t, GrantsWrite(nil), "keys without scopes can do anything their holder can")
+	assert.False(t, GrantsWrite([]Scope{read}))
+	assert.True(t, GrantsWrite([]Scope{read, write}))
+}
@@ -1,6 +1,9 @@
 package auth
 
-import "context"
+import (
+	"context"
+	"net/netip"
+)
 
 // ContextKey is the type for context keys.
 type ContextKey string
@@ -18,6 +21,9 @@ type UserInfo struct {
 	AccountID int64
 	Metadata  map[string]string
 	Scopes    []Scope
+	// AllowedCIDRs restricts where an API key can be used from; empty
+	// means anywhere.
+	AllowedCIDRs []netip.Prefix
 }
 
 // GetUserFromContext extracts user info from request context.
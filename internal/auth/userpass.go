@@ -4,6 +4,7 @@ package auth
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -276,7 +277,7 @@ func (c *UserpassClient) HandleRegister(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := c.emailVerifier.SendVerificationEmail(email, token.Token); err != nil {
+	if err := c.emailVerifier.SendVerificationEmail(r.Context(), email, token.Token); err != nil {
 		slog.Error("Failed to send verification email", "err", err)
 		http.Redirect(w, r, "/login?register=true&error=Internal Server Error", http.StatusSeeOther)
 		return
@@ -297,7 +298,9 @@ func (c *UserpassClient) HandleVerifyEmail(w http.ResponseWriter, r *http.Reques
 
 	if err := c.VerifyEmail(r.Context(), email, token); err != nil {
 		slog.Error("Verification failed", "err", err)
-		http.Error(w, "Verification failed", http.StatusBadRequest)
+		// The link may have expired, so offer to send a new one
+		page := "/verify-email?email=" + url.QueryEscape(email)
+		http.Redirect(w, r, withQuery(page, "error", "This verification link is invalid or has expired."), http.StatusSeeOther)
 		return
 	}
 
@@ -306,6 +309,49 @@ func (c *UserpassClient) HandleVerifyEmail(w http.ResponseWriter, r *http.Reques
 	http.Redirect(w, r, "/login?verified=true", http.StatusSeeOther)
 }
 
+// ResendVerification emails a verification link to an unverified account,
+// creating a new token when the last one has expired. Nothing is sent for
+// unknown or verified accounts, and no error says so.
+func (c *UserpassClient) ResendVerification(ctx context.Context, email string) error {
+	account, err := c.db.GetAccountByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Info("Resend attempted for non-existent email", "email", email)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+	if account.Verified {
+		slog.Info("Resend attempted for already verified account", "email", email)
+		return nil
+	}
+
+	token := ""
+	pending, err := c.db.GetEmailVerificationTokenByEmail(ctx, email)
+	switch {
+	case err == nil:
+		token = pending.Token
+	case errors.Is(err, sql.ErrNoRows):
+		// The expired token still holds the email's row until it's cleaned up
+		if err := c.emailVerifier.DeleteToken(ctx, email); err != nil {
+			return err
+		}
+		created, err := c.emailVerifier.CreateVerificationToken(ctx, email, "")
+		if err != nil {
+			return err
+		}
+		token = created.Token
+	default:
+		return fmt.Errorf("failed to check verification token: %w", err)
+	}
+
+	if err := c.emailVerifier.SendVerificationEmail(ctx, email, token); err != nil {
+		return err
+	}
+	slog.Info("Verification email resent", "email", email)
+	return nil
+}
+
 // HandleResendVerification resends the verification email for unverified accounts.
 func (c *UserpassClient) HandleResendVerification(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -320,72 +366,24 @@ func (c *UserpassClient) HandleResendVerification(w http.ResponseWriter, r *http
 
 	email := r.FormValue("email")
 	if email == "" {
-		http.Error(w, "Email is required", http.StatusBadRequest)
+		respondRecoveryError(w, r, "/verify-email", "Email is required")
 		return
 	}
 
 	if err := validation.Email(email); err != nil {
 		slog.Warn("Invalid email format", "email", email, "err", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		respondRecoveryError(w, r, "/verify-email", err.Error())
 		return
 	}
 
-	ctx := r.Context()
-
-	token, err := c.db.GetEmailVerificationTokenByEmail(ctx, email)
-
-	// For security, always return the same success message
-	// This prevents attackers from enumerating valid email addresses
-	successMessage := `{"message": "If an unverified account exists for this email, a verification link has been sent."}`
-
-	if err == sql.ErrNoRows {
-		account, err := c.db.GetAccountByEmail(ctx, email)
-		if err == nil && account.Verified {
-			// Account already verified - don't reveal this
-			slog.Info("Resend attempted for already verified account", "email", email)
-		} else if err == nil && !account.Verified {
-			// This is an edge case - account may be in invalid state
-			slog.Warn("Unverified account found without pending token", "email", email)
-		} else {
-			slog.Info("Resend attempted for non-existent email", "email", email)
-		}
-
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		if _, err := fmt.Fprint(w, successMessage); err != nil {
-			slog.Error("Failed to write response", "err", err)
-		}
-		return
-	}
-
-	if err != nil {
-		slog.Error("Failed to check verification token", "err", err)
-		// Even on error, return success message for security
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		if _, err := fmt.Fprint(w, successMessage); err != nil {
-			slog.Error("Failed to write response", "err", err)
-		}
-		return
-	}
-
-	if err := c.emailVerifier.SendVerificationEmail(email, token.Token); err != nil {
-		slog.Error("Failed to send verification email", "err", err, "email", email)
+	if err := c.ResendVerification(r.Context(), email); err != nil {
 		// Still return success to prevent email enumeration
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		if _, err := fmt.Fprint(w, successMessage); err != nil {
-			slog.Error("Failed to write response", "err", err)
-		}
-		return
+		slog.Error("Failed to resend verification email", "err", err, "email", email)
 	}
 
-	slog.Info("Verification email resent", "email", email)
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if _, err := fmt.Fprint(w, successMessage); err != nil {
-		slog.Error("Failed to write response", "err", err)
-	}
+	// For security, always return the same success message
+	// This prevents attackers from enumerating valid email addresses
+	respondRecovery(w, r, "/verify-email", "If an unverified account exists for this email, a verification link has been sent.")
 }
 
 // validatePasswordComplexity checks if a password meets the complexity requirements.
@@ -0,0 +1,187 @@
+// Package avatar stores the pictures accounts are shown with in member lists and
+// the dashboard. Avatars are kept in Cloud Storage and handed out through short
+// lived signed URLs, so the bucket itself stays private.
+package avatar
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/export"
+)
+
+const (
+	// MaxSize caps an uploaded avatar
+	MaxSize = 1 << 20
+
+	// URLTTL is how long a signed avatar URL works
+	URLTTL = time.Hour
+)
+
+// ErrDisabled is returned when no avatar bucket is configured
+var ErrDisabled = errors.New("avatar uploads are not enabled")
+
+// contentTypes are the image types an avatar may be, by file extension. SVG is
+// left out since it can carry scripts.
+var contentTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Store uploads avatars to a bucket and signs URLs to them
+type Store struct {
+	db      db.Querier
+	storage export.Storage
+	bucket  string
+}
+
+// NewStore creates a store keeping avatars in bucket. Without storage or a bucket
+// uploads fail with ErrDisabled.
+func NewStore(querier db.Querier, storage export.Storage, bucket string) *Store {
+	return &Store{db: querier, storage: storage, bucket: bucket}
+}
+
+// Enabled reports whether avatars can be uploaded
+func (s *Store) Enabled() bool {
+	return s != nil && s.storage != nil && s.bucket != ""
+}
+
+// ContentType checks an uploaded avatar's size and format and returns its content type.
+func ContentType(image []byte) (string, error) {
+	if len(image) == 0 {
+		return "", fmt.Errorf("avatar is empty")
+	}
+	if len(image) > MaxSize {
+		return "", fmt.Errorf("avatar must be at most %d KiB", MaxSize>>10)
+	}
+	contentType := http.DetectContentType(image)
+	if _, ok := contentTypes[contentType]; !ok {
+		return "", fmt.Errorf("avatar must be a PNG, JPEG, GIF or WebP image")
+	}
+	return contentType, nil
+}
+
+// ObjectName is where an avatar is stored: under the account's public ID and named
+// after its content, so every upload gets a new name and URLs never go stale.
+func ObjectName(accountPublicID string, image []byte, contentType string) string {
+	sum := sha256.Sum256(image)
+	return accountPublicID + "/" + hex.EncodeToString(sum[:8]) + contentTypes[contentType]
+}
+
+// Path is where the dashboard loads an account's avatar from, or "" when it has
+// none. The version changes with every upload so browsers don't show a stale one.
+func Path(accountPublicID string, object sql.NullString) string {
+	if !object.Valid || object.String == "" {
+		return ""
+	}
+	return fmt.Sprintf("/accounts/%s/avatar?v=%s", accountPublicID, path.Base(object.String))
+}
+
+// Upload stores an avatar and points the account at it, removing the one it
+// replaces. It returns the new object's name.
+func (s *Store) Upload(ctx context.Context, accountID int64, accountPublicID string, previous sql.NullString, image []byte) (string, error) {
+	if !s.Enabled() {
+		return "", ErrDisabled
+	}
+	contentType, err := ContentType(image)
+	if err != nil {
+		return "", err
+	}
+
+	object := ObjectName(accountPublicID, image, contentType)
+	if err := s.storage.Upload(ctx, s.bucket, object, contentType, bytes.NewReader(image)); err != nil {
+		return "", err
+	}
+	if err := s.db.UpdateAccountAvatar(ctx, db.UpdateAccountAvatarParams{
+		AvatarObject: sql.NullString{String: object, Valid: true},
+		ID:           accountID,
+	}); err != nil {
+		return "", fmt.Errorf("save avatar: %w", err)
+	}
+
+	if previous.Valid && previous.String != object {
+		s.remove(ctx, previous.String)
+	}
+	return object, nil
+}
+
+// Delete removes an account's avatar
+func (s *Store) Delete(ctx context.Context, accountID int64, previous sql.NullString) error {
+	if err := s.db.UpdateAccountAvatar(ctx, db.UpdateAccountAvatarParams{ID: accountID}); err != nil {
+		return fmt.Errorf("remove avatar: %w", err)
+	}
+	if previous.Valid && s.Enabled() {
+		s.remove(ctx, previous.String)
+	}
+	return nil
+}
+
+// remove deletes a replaced object. The account no longer points at it, so a
+// failure only leaves an orphan in the bucket.
+func (s *Store) remove(ctx context.Context, object string) {
+	if err := s.storage.Delete(ctx, s.bucket, object); err != nil {
+		slog.Warn("Failed to delete replaced avatar", "object", object, "err", err)
+	}
+}
+
+// URL returns a signed URL to an avatar object, or "" when there is none
+func (s *Store) URL(ctx context.Context, object sql.NullString) (string, error) {
+	if !object.Valid || object.String == "" || !s.Enabled() {
+		return "", nil
+	}
+	return s.storage.SignedURL(ctx, s.bucket, object.String, URLTTL)
+}
+
+// HandleAvatar redirects signed-in users to a signed URL of an account's avatar.
+// The redirect is cached for a little less than the URL works, which is safe since
+// the path changes with every upload.
+func (s *Store) HandleAvatar(w http.ResponseWriter, r *http.Request) {
+	if userInfo, ok := auth.GetUserFromContext(r.Context()); !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	accountID := r.PathValue("id")
+	if _, err := uuid.Parse(accountID); err != nil || !s.Enabled() {
+		http.NotFound(w, r)
+		return
+	}
+
+	object, err := s.db.GetAccountAvatar(r.Context(), accountID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !object.Valid) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get avatar", "account_id", accountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	link, err := s.URL(r.Context(), object)
+	if err != nil {
+		slog.Error("Failed to sign avatar URL", "account_id", accountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	maxAge := int((URLTTL - 5*time.Minute).Seconds())
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(maxAge))
+	http.Redirect(w, r, link, http.StatusFound)
+}
@@ -0,0 +1,138 @@
+package avatar
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/testutils"
+)
+
+// png is the smallest header http.DetectContentType recognizes as a PNG
+var png = []byte("\x89PNG\r\n\x1a\n")
+
+// memoryStorage keeps objects in memory
+type memoryStorage struct {
+	objects map[string][]byte
+}
+
+// Compile-time check.
+var _ export.Storage = (*memoryStorage)(nil)
+
+func (m *memoryStorage) Upload(ctx context.Context, bucket, name, contentType string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.objects[bucket+"/"+name] = data
+	return nil
+}
+
+func (m *memoryStorage) Delete(ctx context.Context, bucket, name string) error {
+	delete(m.objects, bucket+"/"+name)
+	return nil
+}
+
+func (m *memoryStorage) Latest(ctx context.Context, bucket, prefix string) (*export.Object, error) {
+	return nil, nil
+}
+
+func (m *memoryStorage) SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error) {
+	return "https://storage.googleapis.com/" + bucket + "/" + name + "?ttl=" + ttl.String(), nil
+}
+
+func TestContentType(t *testing.T) {
+	contentType, err := ContentType(png)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	_, err = ContentType(nil)
+	assert.Error(t, err)
+	_, err = ContentType([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	assert.Error(t, err, "SVGs can carry scripts")
+	_, err = ContentType(append(png, make([]byte, MaxSize)...))
+	assert.Error(t, err)
+}
+
+func TestPath(t *testing.T) {
+	assert.Empty(t, Path("account", sql.NullString{}))
+	assert.Equal(t, "/accounts/account/avatar?v=0123.png", Path("account", sql.NullString{String: "account/0123.png", Valid: true}))
+}
+
+func TestUpload(t *testing.T) {
+	ctx := context.Background()
+	storage := &memoryStorage{objects: map[string][]byte{"avatars/account/old.png": png}}
+	var saved []db.UpdateAccountAvatarParams
+	mock := &testutils.MockQuerier{
+		UpdateAccountAvatarFunc: func(ctx context.Context, arg db.UpdateAccountAvatarParams) error {
+			saved = append(saved, arg)
+			return nil
+		},
+	}
+
+	_, err := NewStore(mock, nil, "").Upload(ctx, 1, "account", sql.NullString{}, png)
+	assert.ErrorIs(t, err, ErrDisabled)
+
+	store := NewStore(mock, storage, "avatars")
+	object, err := store.Upload(ctx, 1, "account", sql.NullString{String: "account/old.png", Valid: true}, png)
+	require.NoError(t, err)
+	assert.Equal(t, ObjectName("account", png, "image/png"), object)
+	assert.Contains(t, storage.objects, "avatars/"+object)
+	assert.NotContains(t, storage.objects, "avatars/account/old.png", "the replaced avatar is removed")
+	require.Len(t, saved, 1)
+	assert.Equal(t, object, saved[0].AvatarObject.String)
+
+	again, err := store.Upload(ctx, 1, "account", sql.NullString{String: object, Valid: true}, png)
+	require.NoError(t, err)
+	assert.Equal(t, object, again, "the same image keeps its name")
+	assert.Contains(t, storage.objects, "avatars/"+object, "re-uploading an avatar doesn't remove it")
+
+	require.NoError(t, store.Delete(ctx, 1, sql.NullString{String: object, Valid: true}))
+	assert.Empty(t, storage.objects)
+	assert.False(t, saved[len(saved)-1].AvatarObject.Valid)
+}
+
+func TestHandleAvatar(t *testing.T) {
+	accountID := uuid.NewString()
+	mock := &testutils.MockQuerier{
+		GetAccountAvatarFunc: func(ctx context.Context, publicID string) (sql.NullString, error) {
+			if publicID != accountID {
+				return sql.NullString{}, sql.ErrNoRows
+			}
+			return sql.NullString{String: accountID + "/0123.png", Valid: true}, nil
+		},
+	}
+	store := NewStore(mock, &memoryStorage{}, "avatars")
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /accounts/{id}/avatar", store.HandleAvatar)
+
+	get := func(id string, signedIn bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/accounts/"+id+"/avatar", nil)
+		if signedIn {
+			req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 1}))
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, get(accountID, false).Code)
+	assert.Equal(t, http.StatusNotFound, get(uuid.NewString(), true).Code)
+	assert.Equal(t, http.StatusNotFound, get("not-a-uuid", true).Code)
+
+	rec := get(accountID, true)
+	require.Equal(t, http.StatusFound, rec.Code)
+	assert.Equal(t, "https://storage.googleapis.com/avatars/"+accountID+"/0123.png?ttl=1h0m0s", rec.Header().Get("Location"))
+	assert.Equal(t, "private, max-age=3300", rec.Header().Get("Cache-Control"))
+}
@@ -0,0 +1,280 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/email"
+	"github.com/libops/api/internal/events"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// Reasons recorded on billing state transitions
+const (
+	ReasonPaymentFailed       = "invoice.payment_failed"
+	ReasonInvoicePaid         = "invoice.paid"
+	ReasonSubscriptionUpdated = "customer.subscription.updated"
+	ReasonSubscriptionDeleted = "customer.subscription.deleted"
+	ReasonGracePeriodExpired  = "grace_period_expired"
+)
+
+// billingStateOrder ranks dunning states so escalations never move an organization backwards.
+// Only a successful payment returns an organization to active.
+var billingStateOrder = map[db.OrganizationsBillingState]int{
+	db.OrganizationsBillingStateActive:               0,
+	db.OrganizationsBillingStatePastDue:              1,
+	db.OrganizationsBillingStateSuspended:            2,
+	db.OrganizationsBillingStateScheduledForDeletion: 3,
+}
+
+// GracePeriods controls how long an organization stays in each dunning state
+// before the sweep advances it to the next one.
+type GracePeriods struct {
+	PastDue   time.Duration // past_due -> suspended
+	Suspended time.Duration // suspended -> scheduled_for_deletion
+}
+
+// Next returns the state an organization advances to once the grace period for
+// its current state has expired, and false when no transition is due.
+func (g GracePeriods) Next(state db.OrganizationsBillingState, changedAt, now time.Time) (db.OrganizationsBillingState, bool) {
+	deadline := g.Deadline(state, changedAt)
+	if deadline.IsZero() || now.Before(deadline) {
+		return state, false
+	}
+	switch state {
+	case db.OrganizationsBillingStatePastDue:
+		return db.OrganizationsBillingStateSuspended, true
+	case db.OrganizationsBillingStateSuspended:
+		return db.OrganizationsBillingStateScheduledForDeletion, true
+	}
+	return state, false
+}
+
+// Deadline returns when the grace period for the current state ends.
+// It is zero for states that never advance on their own.
+func (g GracePeriods) Deadline(state db.OrganizationsBillingState, changedAt time.Time) time.Time {
+	period, ok := g.period(state)
+	if !ok || changedAt.IsZero() {
+		return time.Time{}
+	}
+	return changedAt.Add(period)
+}
+
+// period returns the grace period for a state, and false for states that never advance on their own.
+func (g GracePeriods) period(state db.OrganizationsBillingState) (time.Duration, bool) {
+	switch state {
+	case db.OrganizationsBillingStatePastDue:
+		return g.PastDue, true
+	case db.OrganizationsBillingStateSuspended:
+		return g.Suspended, true
+	}
+	return 0, false
+}
+
+// Dunning drives the organization billing-state machine:
+// active -> past_due -> suspended -> scheduled_for_deletion.
+// Stripe webhooks move organizations into past_due and back to active, and
+// Sweep advances organizations whose grace period has expired. Suspending an
+// organization suspends its active sites and asks the control plane to
+// reconcile them so the site controllers stop the application.
+type Dunning struct {
+	db      db.Querier
+	emitter *events.Emitter
+	grace   GracePeriods
+
+	mailer     Mailer
+	billingURL string
+}
+
+// Mailer sends templated emails
+type Mailer interface {
+	Send(ctx context.Context, req email.Request) error
+}
+
+// NewDunning creates a dunning state machine
+func NewDunning(querier db.Querier, emitter *events.Emitter, grace GracePeriods) *Dunning {
+	return &Dunning{
+		db:      querier,
+		emitter: emitter,
+		grace:   grace,
+	}
+}
+
+// SetMailer enables billing-issue emails to organization owners on every
+// escalation. billingURL is the dashboard billing page the emails link to.
+func (d *Dunning) SetMailer(mailer Mailer, billingURL string) {
+	d.mailer = mailer
+	d.billingURL = billingURL
+}
+
+// Transition moves an organization to the given billing state. Escalations
+// that would move the organization backwards (e.g. a payment failure on an
+// already suspended organization) are ignored.
+func (d *Dunning) Transition(ctx context.Context, organizationID int64, to db.OrganizationsBillingState, reason string) error {
+	current, err := d.db.GetOrganizationBillingState(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("failed to get billing state: %w", err)
+	}
+	from := current.BillingState
+	if from == to {
+		return nil
+	}
+	if to != db.OrganizationsBillingStateActive && billingStateOrder[to] < billingStateOrder[from] {
+		slog.Debug("Ignoring billing state downgrade",
+			"organization_id", organizationID,
+			"state", from,
+			"requested_state", to,
+			"reason", reason)
+		return nil
+	}
+
+	err = d.db.SetOrganizationBillingState(ctx, db.SetOrganizationBillingStateParams{
+		BillingState: to,
+		ID:           organizationID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set billing state: %w", err)
+	}
+
+	wasSuspended := billingStateOrder[from] >= billingStateOrder[db.OrganizationsBillingStateSuspended]
+	isSuspended := billingStateOrder[to] >= billingStateOrder[db.OrganizationsBillingStateSuspended]
+
+	var sites int64
+	switch {
+	case isSuspended && !wasSuspended:
+		sites, err = d.db.SuspendOrganizationSites(ctx, organizationID)
+		if err != nil {
+			return fmt.Errorf("failed to suspend sites: %w", err)
+		}
+	case wasSuspended && !isSuspended:
+		sites, err = d.db.ResumeOrganizationSites(ctx, organizationID)
+		if err != nil {
+			return fmt.Errorf("failed to resume sites: %w", err)
+		}
+	}
+
+	slog.Info("Organization billing state changed",
+		"organization_id", organizationID,
+		"from", from,
+		"to", to,
+		"reason", reason,
+		"sites_changed", sites)
+
+	d.emit(ctx, organizationID, from, to, reason, wasSuspended != isSuspended)
+	if to != db.OrganizationsBillingStateActive {
+		d.notifyOwners(ctx, organizationID, to)
+	}
+	return nil
+}
+
+// Sweep advances every organization whose grace period has expired and
+// returns the number of organizations that changed state.
+func (d *Dunning) Sweep(ctx context.Context, now time.Time) (int, error) {
+	advanced := 0
+	for _, state := range []db.OrganizationsBillingState{
+		db.OrganizationsBillingStatePastDue,
+		db.OrganizationsBillingStateSuspended,
+	} {
+		period, _ := d.grace.period(state)
+		orgs, err := d.db.ListOrganizationsByBillingState(ctx, db.ListOrganizationsByBillingStateParams{
+			BillingState:          state,
+			BillingStateChangedAt: sql.NullTime{Time: now.Add(-period), Valid: true},
+		})
+		if err != nil {
+			return advanced, fmt.Errorf("failed to list %s organizations: %w", state, err)
+		}
+
+		for _, org := range orgs {
+			next, ok := d.grace.Next(org.BillingState, org.BillingStateChangedAt.Time, now)
+			if !ok {
+				continue
+			}
+			if err := d.Transition(ctx, org.ID, next, ReasonGracePeriodExpired); err != nil {
+				slog.Error("Failed to advance billing state", "error", err, "organization_id", org.PublicID, "state", next)
+				continue
+			}
+			advanced++
+		}
+	}
+	return advanced, nil
+}
+
+// emit sends a billing notification for the transition and, when sites were
+// suspended or resumed, an organization update so the control plane reconciles them.
+func (d *Dunning) emit(ctx context.Context, organizationID int64, from, to db.OrganizationsBillingState, reason string, sitesChanged bool) {
+	if d.emitter == nil {
+		return
+	}
+	org, err := d.db.GetOrganizationByID(ctx, organizationID)
+	if err != nil {
+		slog.Error("Failed to get organization for billing state event", "error", err, "organization_id", organizationID)
+		return
+	}
+
+	eventData := &commonv1.BillingStateChangedEvent{
+		OrganizationId: org.PublicID,
+		PreviousState:  string(from),
+		State:          string(to),
+		Reason:         reason,
+	}
+	if deadline := d.grace.Deadline(to, time.Now()); !deadline.IsZero() {
+		eventData.Deadline = deadline.Unix()
+	}
+
+	if err := d.emitter.SendScopedProtoEvent(ctx, events.EventTypeBillingStateChanged, org.PublicID, &org.PublicID, nil, nil, eventData); err != nil {
+		slog.Error("Failed to emit billing state changed event", "error", err, "organization_id", org.PublicID)
+	}
+	if sitesChanged {
+		if err := d.emitter.SendScopedProtoEvent(ctx, events.EventTypeOrganizationUpdated, org.PublicID, &org.PublicID, nil, nil, eventData); err != nil {
+			slog.Error("Failed to emit organization updated event", "error", err, "organization_id", org.PublicID)
+		}
+	}
+}
+
+// notifyOwners emails the organization's active owners about a billing escalation
+func (d *Dunning) notifyOwners(ctx context.Context, organizationID int64, state db.OrganizationsBillingState) {
+	if d.mailer == nil {
+		return
+	}
+	org, err := d.db.GetOrganizationByID(ctx, organizationID)
+	if err != nil {
+		slog.Error("Failed to get organization for billing email", "error", err, "organization_id", organizationID)
+		return
+	}
+	members, err := d.db.ListOrganizationMembers(ctx, db.ListOrganizationMembersParams{
+		OrganizationID: organizationID,
+		Limit:          100,
+	})
+	if err != nil {
+		slog.Error("Failed to list owners for billing email", "error", err, "organization_id", org.PublicID)
+		return
+	}
+
+	data := email.BillingIssueData{
+		OrganizationName: org.Name,
+		State:            string(state),
+		BillingURL:       d.billingURL,
+	}
+	if deadline := d.grace.Deadline(state, time.Now()); !deadline.IsZero() {
+		data.Deadline = deadline.Format("January 2, 2006")
+	}
+
+	for _, member := range members {
+		if member.Role != db.OrganizationMembersRoleOwner || member.Status.OrganizationMembersStatus != db.OrganizationMembersStatusActive {
+			continue
+		}
+		err := d.mailer.Send(ctx, email.Request{
+			Template:       email.TemplateBillingIssue,
+			To:             member.Email,
+			Data:           data,
+			OrganizationID: organizationID,
+		})
+		if err != nil {
+			slog.Error("Failed to send billing email", "error", err, "organization_id", org.PublicID, "state", state)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/email"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestGracePeriodsNext tests when the sweep advances each dunning state.
+func TestGracePeriodsNext(t *testing.T) {
+	grace := GracePeriods{PastDue: 14 * 24 * time.Hour, Suspended: 30 * 24 * time.Hour}
+	changedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		state  db.OrganizationsBillingState
+		now    time.Time
+		want   db.OrganizationsBillingState
+		wantOK bool
+	}{
+		{"active never advances", db.OrganizationsBillingStateActive, changedAt.AddDate(1, 0, 0), db.OrganizationsBillingStateActive, false},
+		{"past due within grace", db.OrganizationsBillingStatePastDue, changedAt.AddDate(0, 0, 13), db.OrganizationsBillingStatePastDue, false},
+		{"past due after grace", db.OrganizationsBillingStatePastDue, changedAt.AddDate(0, 0, 14), db.OrganizationsBillingStateSuspended, true},
+		{"suspended within grace", db.OrganizationsBillingStateSuspended, changedAt.AddDate(0, 0, 29), db.OrganizationsBillingStateSuspended, false},
+		{"suspended after grace", db.OrganizationsBillingStateSuspended, changedAt.AddDate(0, 0, 30), db.OrganizationsBillingStateScheduledForDeletion, true},
+		{"scheduled for deletion is terminal", db.OrganizationsBillingStateScheduledForDeletion, changedAt.AddDate(1, 0, 0), db.OrganizationsBillingStateScheduledForDeletion, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := grace.Next(tt.state, changedAt, tt.now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestDunningTransition tests state changes and the site suspension side effects.
+func TestDunningTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        db.OrganizationsBillingState
+		to          db.OrganizationsBillingState
+		wantState   db.OrganizationsBillingState
+		wantSuspend bool
+		wantResume  bool
+	}{
+		{"payment failure marks past due", db.OrganizationsBillingStateActive, db.OrganizationsBillingStatePastDue, db.OrganizationsBillingStatePastDue, false, false},
+		{"suspension stops sites", db.OrganizationsBillingStatePastDue, db.OrganizationsBillingStateSuspended, db.OrganizationsBillingStateSuspended, true, false},
+		{"payment failure never downgrades suspension", db.OrganizationsBillingStateSuspended, db.OrganizationsBillingStatePastDue, "", false, false},
+		{"deletion keeps sites suspended", db.OrganizationsBillingStateSuspended, db.OrganizationsBillingStateScheduledForDeletion, db.OrganizationsBillingStateScheduledForDeletion, false, false},
+		{"payment resumes suspended sites", db.OrganizationsBillingStateScheduledForDeletion, db.OrganizationsBillingStateActive, db.OrganizationsBillingStateActive, false, true},
+		{"payment clears past due", db.OrganizationsBillingStatePastDue, db.OrganizationsBillingStateActive, db.OrganizationsBillingStateActive, false, false},
+		{"same state is a no-op", db.OrganizationsBillingStateActive, db.OrganizationsBillingStateActive, "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotState db.OrganizationsBillingState
+			suspended, resumed := false, false
+			mock := &testutils.MockQuerier{
+				GetOrganizationBillingStateFunc: func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+					return db.GetOrganizationBillingStateRow{BillingState: tt.from}, nil
+				},
+				SetOrganizationBillingStateFunc: func(ctx context.Context, arg db.SetOrganizationBillingStateParams) error {
+					gotState = arg.BillingState
+					return nil
+				},
+				SuspendOrganizationSitesFunc: func(ctx context.Context, organizationID int64) (int64, error) {
+					suspended = true
+					return 2, nil
+				},
+				ResumeOrganizationSitesFunc: func(ctx context.Context, organizationID int64) (int64, error) {
+					resumed = true
+					return 2, nil
+				},
+			}
+
+			d := NewDunning(mock, nil, GracePeriods{})
+			err := d.Transition(context.Background(), 7, tt.to, ReasonGracePeriodExpired)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantState, gotState)
+			assert.Equal(t, tt.wantSuspend, suspended)
+			assert.Equal(t, tt.wantResume, resumed)
+		})
+	}
+}
+
+// TestDunningSweep tests that the sweep advances organizations whose grace period expired.
+func TestDunningSweep(t *testing.T) {
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	grace := GracePeriods{PastDue: 14 * 24 * time.Hour, Suspended: 30 * 24 * time.Hour}
+
+	var cutoffs []time.Time
+	transitions := map[int64]db.OrganizationsBillingState{}
+	mock := &testutils.MockQuerier{
+		ListOrganizationsByBillingStateFunc: func(ctx context.Context, arg db.ListOrganizationsByBillingStateParams) ([]db.ListOrganizationsByBillingStateRow, error) {
+			cutoffs = append(cutoffs, arg.BillingStateChangedAt.Time)
+			if arg.BillingState != db.OrganizationsBillingStatePastDue {
+				return nil, nil
+			}
+			return []db.ListOrganizationsByBillingStateRow{
+				{ID: 1, BillingState: db.OrganizationsBillingStatePastDue, BillingStateChangedAt: sql.NullTime{Time: now.AddDate(0, 0, -20), Valid: true}},
+			}, nil
+		},
+		GetOrganizationBillingStateFunc: func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+			return db.GetOrganizationBillingStateRow{BillingState: db.OrganizationsBillingStatePastDue}, nil
+		},
+		SetOrganizationBillingStateFunc: func(ctx context.Context, arg db.SetOrganizationBillingStateParams) error {
+			transitions[arg.ID] = arg.BillingState
+			return nil
+		},
+	}
+
+	advanced, err := NewDunning(mock, nil, grace).Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, advanced)
+	assert.Equal(t, db.OrganizationsBillingStateSuspended, transitions[1])
+	assert.Equal(t, []time.Time{now.Add(-grace.PastDue), now.Add(-grace.Suspended)}, cutoffs)
+}
+
+type fakeMailer struct {
+	sent []email.Request
+}
+
+func (m *fakeMailer) Send(ctx context.Context, req email.Request) error {
+	m.sent = append(m.sent, req)
+	return nil
+}
+
+// TestDunningNotifiesOwners tests that escalations email active owners only.
+func TestDunningNotifiesOwners(t *testing.T) {
+	active := db.NullOrganizationMembersStatus{OrganizationMembersStatus: db.OrganizationMembersStatusActive, Valid: true}
+	mock := &testutils.MockQuerier{
+		GetOrganizationBillingStateFunc: func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+			return db.GetOrganizationBillingStateRow{BillingState: db.OrganizationsBillingStateActive}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, Name: "Acme"}, nil
+		},
+		ListOrganizationMembersFunc: func(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error) {
+			return []db.ListOrganizationMembersRow{
+				{Email: "owner@example.com", Role: db.OrganizationMembersRoleOwner, Status: active},
+				{Email: "dev@example.com", Role: db.OrganizationMembersRoleDeveloper, Status: active},
+				{Email: "provisioning@example.com", Role: db.OrganizationMembersRoleOwner, Status: db.NullOrganizationMembersStatus{OrganizationMembersStatus: db.OrganizationMembersStatusProvisioning, Valid: true}},
+			}, nil
+		},
+	}
+
+	mailer := &fakeMailer{}
+	d := NewDunning(mock, nil, GracePeriods{PastDue: 14 * 24 * time.Hour})
+	d.SetMailer(mailer, "https://dash.libops.io/billing")
+
+	assert.NoError(t, d.Transition(context.Background(), 7, db.OrganizationsBillingStatePastDue, ReasonPaymentFailed))
+	if assert.Len(t, mailer.sent, 1) {
+		req := mailer.sent[0]
+		assert.Equal(t, email.TemplateBillingIssue, req.Template)
+		assert.Equal(t, "owner@example.com", req.To)
+		data := req.Data.(email.BillingIssueData)
+		assert.Equal(t, "Acme", data.OrganizationName)
+		assert.Equal(t, "past_due", data.State)
+		assert.NotEmpty(t, data.Deadline)
+		assert.Equal(t, "https://dash.libops.io/billing", data.BillingURL)
+	}
+}
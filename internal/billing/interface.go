@@ -1,6 +1,9 @@
 package billing
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Manager defines the interface for billing operations used across the application
 // This interface is implemented by both StripeManager (production) and NoOpBillingManager (testing/dev)
@@ -15,11 +18,26 @@ type Manager interface {
 
 	// Onboarding operations
 	GetMachineTypePriceID(ctx context.Context, machineType string) (string, error)
-	CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool) (*CheckoutSessionResult, error)
+	CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool, promoCode string) (*CheckoutSessionResult, error)
+
+	// Usage and billing summary operations
+	ListSubscriptionItems(ctx context.Context, organizationID int64) ([]SubscriptionItem, error)
+
+	// Self-service payment operations
+	CreateBillingPortalSession(ctx context.Context, organizationID int64, returnURL string) (string, error)
+	ListPaymentMethods(ctx context.Context, organizationID int64) ([]PaymentMethod, error)
+	SetDefaultPaymentMethod(ctx context.Context, organizationID int64, paymentMethodID string) (*PaymentMethod, error)
+	ListInvoices(ctx context.Context, organizationID int64, limit int64, startingAfter string) ([]Invoice, bool, error)
+	GetInvoice(ctx context.Context, organizationID int64, invoiceID string) (*Invoice, error)
+	UpdateBillingContact(ctx context.Context, organizationID int64, email string) error
+
+	// Metered usage operations
+	ReportMeteredUsage(ctx context.Context, now time.Time) (int, error)
 }
 
 // CheckoutSessionResult contains the checkout session ID and URL
 type CheckoutSessionResult struct {
 	SessionID string
-	URL       string // Empty URL means skip redirect (for NoOp billing)
+	URL       string    // Empty URL means skip redirect (for NoOp billing)
+	Discount  *Discount // Discount applied by the promo code, nil when none was given
 }
@@ -0,0 +1,115 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/invoice"
+)
+
+// ErrInvoiceNotFound is returned when an invoice does not belong to the organization's customer
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+// Invoice is a Stripe invoice issued to an organization's customer
+type Invoice struct {
+	ID                 string
+	Number             string
+	Status             string
+	AmountDue          int64
+	AmountPaid         int64
+	Currency           string
+	Created            int64
+	PeriodStart        int64
+	PeriodEnd          int64
+	DueDate            int64
+	PaidAt             int64
+	HostedInvoiceURL   string
+	InvoicePDF         string
+	AttemptCount       int64
+	NextPaymentAttempt int64
+}
+
+// ListInvoices returns one page of the organization's invoices, newest first.
+// startingAfter is the ID of the last invoice on the previous page.
+func (sm *StripeManager) ListInvoices(ctx context.Context, organizationID int64, limit int64, startingAfter string) ([]Invoice, bool, error) {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	params := &stripe.InvoiceListParams{
+		Customer: stripe.String(sub.StripeCustomerID),
+	}
+	params.Limit = stripe.Int64(limit)
+	params.Single = true
+	if startingAfter != "" {
+		params.StartingAfter = stripe.String(startingAfter)
+	}
+	params.Context = ctx
+
+	var invoices []Invoice
+	iter := invoice.List(params)
+	for iter.Next() {
+		invoices = append(invoices, toInvoice(iter.Invoice()))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, false, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	hasMore := false
+	if list := iter.InvoiceList(); list != nil {
+		hasMore = list.HasMore
+	}
+	return invoices, hasMore, nil
+}
+
+// GetInvoice returns a single invoice, ensuring it was issued to the organization's customer
+func (sm *StripeManager) GetInvoice(ctx context.Context, organizationID int64, invoiceID string) (*Invoice, error) {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	params := &stripe.InvoiceParams{}
+	params.Context = ctx
+	inv, err := invoice.Get(invoiceID, params)
+	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.HTTPStatusCode == 404 {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("failed to get invoice: %w", err)
+	}
+	// Never expose another customer's invoice
+	if inv.Customer == nil || inv.Customer.ID != sub.StripeCustomerID {
+		return nil, ErrInvoiceNotFound
+	}
+
+	result := toInvoice(inv)
+	return &result, nil
+}
+
+func toInvoice(inv *stripe.Invoice) Invoice {
+	result := Invoice{
+		ID:                 inv.ID,
+		Number:             inv.Number,
+		Status:             string(inv.Status),
+		AmountDue:          inv.AmountDue,
+		AmountPaid:         inv.AmountPaid,
+		Currency:           string(inv.Currency),
+		Created:            inv.Created,
+		PeriodStart:        inv.PeriodStart,
+		PeriodEnd:          inv.PeriodEnd,
+		DueDate:            inv.DueDate,
+		HostedInvoiceURL:   inv.HostedInvoiceURL,
+		InvoicePDF:         inv.InvoicePDF,
+		AttemptCount:       inv.AttemptCount,
+		NextPaymentAttempt: inv.NextPaymentAttempt,
+	}
+	if inv.StatusTransitions != nil {
+		result.PaidAt = inv.StatusTransitions.PaidAt
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/billing/meterevent"
+	"github.com/stripe/stripe-go/v84/subscriptionitem"
+)
+
+// MetricDiskUsedBytes is a gauge of data disk usage across a project's sites.
+const MetricDiskUsedBytes = "disk_used_bytes"
+
+// meteredUsageLookbackDays is how many completed days each report run revisits,
+// so usage from a missed run is still pushed to Stripe.
+const meteredUsageLookbackDays = 3
+
+// IsGaugeMetric reports whether a metric is a point-in-time measurement.
+// Gauges are billed on their latest daily value; counters on the period's total.
+func IsGaugeMetric(metric string) bool {
+	return metric == MetricDiskUsedBytes || metric == MetricBackupStorageBytes
+}
+
+// UsageUnits converts a raw byte quantity into billed units, rounding partial units up
+func UsageUnits(quantity, unitBytes int64) int64 {
+	if quantity <= 0 {
+		return 0
+	}
+	if unitBytes <= 0 {
+		return quantity
+	}
+	return (quantity + unitBytes - 1) / unitBytes
+}
+
+// UsageEstimate is the metered charge accrued for one price in a billing period
+type UsageEstimate struct {
+	Quantity       int64 // raw bytes: the period total for counters, the latest value for gauges
+	Units          int64
+	EstimatedCents int64 // charge for usage so far
+	ProjectedUnits int64
+	ProjectedCents int64 // charge if the current rate continues until the period ends
+}
+
+// EstimateUsage prices an organization's daily usage totals for the billing period
+// the same way ReportMeteredUsage bills them: each day is rounded to whole units,
+// counters are summed, and gauges use their most recent day.
+func EstimateUsage(price db.ListMeteredPricesRow, daily []db.ListOrganizationDailyUsageRow, periodStart, periodEnd, now time.Time) UsageEstimate {
+	var estimate UsageEstimate
+	if len(daily) == 0 {
+		return estimate
+	}
+
+	if IsGaugeMetric(price.Metric) {
+		latest := daily[len(daily)-1]
+		estimate.Quantity = latest.Total
+		estimate.Units = UsageUnits(latest.Total, price.UnitBytes)
+		estimate.ProjectedUnits = estimate.Units
+	} else {
+		for _, day := range daily {
+			estimate.Quantity += day.Total
+			estimate.Units += UsageUnits(day.Total, price.UnitBytes)
+		}
+		estimate.ProjectedUnits = estimate.Units
+		elapsed := now.Sub(periodStart)
+		total := periodEnd.Sub(periodStart)
+		if elapsed > 0 && total > elapsed {
+			estimate.ProjectedUnits = int64(float64(estimate.Units) * float64(total) / float64(elapsed))
+		}
+	}
+
+	estimate.EstimatedCents = meteredAmount(price, estimate.Units)
+	estimate.ProjectedCents = meteredAmount(price, estimate.ProjectedUnits)
+	return estimate
+}
+
+// meteredAmount prices units beyond the price's included allowance
+func meteredAmount(price db.ListMeteredPricesRow, units int64) int64 {
+	billable := units - price.IncludedUnits
+	if billable <= 0 {
+		return 0
+	}
+	return billable * int64(price.UnitPriceCents)
+}
+
+// ReportMeteredUsage pushes each organization's daily usage of every metered price to
+// Stripe as billing meter events. Only completed days are reported, each at most once,
+// and the run is safe to repeat. It returns the number of usage reports sent.
+func (sm *StripeManager) ReportMeteredUsage(ctx context.Context, now time.Time) (int, error) {
+	prices, err := sm.db.ListMeteredPrices(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list metered prices: %w", err)
+	}
+
+	today := now.UTC().Truncate(24 * time.Hour)
+	reported := 0
+	for _, price := range prices {
+		for i := meteredUsageLookbackDays; i >= 1; i-- {
+			day := today.AddDate(0, 0, -i)
+			totals, err := sm.db.ListUsageTotalsForDate(ctx, db.ListUsageTotalsForDateParams{
+				Metric:    price.Metric,
+				UsageDate: day,
+			})
+			if err != nil {
+				return reported, fmt.Errorf("failed to list %s usage: %w", price.Metric, err)
+			}
+
+			for _, total := range totals {
+				sent, err := sm.reportDailyUsage(ctx, price, total.OrganizationID, day, total.Total)
+				if err != nil {
+					slog.Error("Failed to report metered usage",
+						"error", err,
+						"organization_id", total.OrganizationID,
+						"metric", price.Metric,
+						"usage_date", day.Format(time.DateOnly))
+					continue
+				}
+				if sent {
+					reported++
+				}
+			}
+		}
+	}
+	return reported, nil
+}
+
+// reportDailyUsage sends one organization's usage of a metric for a day to Stripe
+// and records it so the day is never billed twice
+func (sm *StripeManager) reportDailyUsage(ctx context.Context, price db.ListMeteredPricesRow, organizationID int64, day time.Time, quantity int64) (bool, error) {
+	units := UsageUnits(quantity, price.UnitBytes)
+	if units == 0 {
+		return false, nil
+	}
+
+	reported, err := sm.db.UsageReportExists(ctx, db.UsageReportExistsParams{
+		OrganizationID: organizationID,
+		Metric:         price.Metric,
+		UsageDate:      day,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check usage report: %w", err)
+	}
+	if reported {
+		return false, nil
+	}
+
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub.Status == db.StripeSubscriptionsStatusCanceled || sub.Status == db.StripeSubscriptionsStatusIncompleteExpired {
+		return false, nil
+	}
+
+	itemID, err := sm.ensureMeteredSubscriptionItem(ctx, sub.StripeSubscriptionID, price.StripePriceID)
+	if err != nil {
+		return false, err
+	}
+
+	// Stripe deduplicates meter events by identifier, so a retry after a failed
+	// insert below cannot bill the day twice
+	identifier := fmt.Sprintf("usage-%d-%s-%s", organizationID, price.Metric, day.Format(time.DateOnly))
+	params := &stripe.BillingMeterEventParams{
+		EventName:  stripe.String(price.StripeMeterEventName),
+		Identifier: stripe.String(identifier),
+		Payload: map[string]string{
+			"stripe_customer_id": sub.StripeCustomerID,
+			"value":              strconv.FormatInt(units, 10),
+		},
+		Timestamp: stripe.Int64(day.Add(24*time.Hour - time.Second).Unix()),
+	}
+	params.Context = ctx
+	if _, err := meterevent.New(params); err != nil {
+		return false, fmt.Errorf("failed to create meter event: %w", err)
+	}
+
+	err = sm.db.CreateUsageReport(ctx, db.CreateUsageReportParams{
+		OrganizationID:           organizationID,
+		Metric:                   price.Metric,
+		UsageDate:                day,
+		Quantity:                 quantity,
+		Units:                    units,
+		StripeSubscriptionItemID: sql.NullString{String: itemID, Valid: itemID != ""},
+		StripeIdentifier:         identifier,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record usage report: %w", err)
+	}
+
+	slog.Info("Reported metered usage",
+		"organization_id", organizationID,
+		"metric", price.Metric,
+		"usage_date", day.Format(time.DateOnly),
+		"units", units)
+	return true, nil
+}
+
+// ensureMeteredSubscriptionItem returns the subscription's item for a metered price,
+// adding it when the subscription predates the price
+func (sm *StripeManager) ensureMeteredSubscriptionItem(ctx context.Context, subscriptionID, priceID string) (string, error) {
+	params := &stripe.SubscriptionItemListParams{
+		Subscription: stripe.String(subscriptionID),
+	}
+	params.Context = ctx
+
+	iter := subscriptionitem.List(params)
+	for iter.Next() {
+		item := iter.SubscriptionItem()
+		if item.Price != nil && item.Price.ID == priceID {
+			return item.ID, nil
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return "", fmt.Errorf("failed to list subscription items: %w", err)
+	}
+
+	// Metered items carry no quantity; Stripe bills them from meter events
+	itemParams := &stripe.SubscriptionItemParams{
+		Subscription: stripe.String(subscriptionID),
+		Price:        stripe.String(priceID),
+		Metadata: map[string]string{
+			"type": "metered",
+		},
+	}
+	itemParams.Context = ctx
+	item, err := subscriptionitem.New(itemParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to add metered subscription item: %w", err)
+	}
+	return item.ID, nil
+}
@@ -0,0 +1,56 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+)
+
+// TestUsageUnits tests rounding raw bytes up to whole billed units.
+func TestUsageUnits(t *testing.T) {
+	assert.Equal(t, int64(0), UsageUnits(0, 1e9))
+	assert.Equal(t, int64(1), UsageUnits(1, 1e9))
+	assert.Equal(t, int64(1), UsageUnits(1e9, 1e9))
+	assert.Equal(t, int64(2), UsageUnits(1e9+1, 1e9))
+	assert.Equal(t, int64(42), UsageUnits(42, 0))
+}
+
+// TestEstimateUsage tests pricing counters and gauges for a billing period.
+func TestEstimateUsage(t *testing.T) {
+	periodStart := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 0, 30)
+	now := periodStart.AddDate(0, 0, 10)
+	daily := []db.ListOrganizationDailyUsageRow{
+		{UsageDate: periodStart, Total: 3e9},
+		{UsageDate: periodStart.AddDate(0, 0, 1), Total: 5e9 + 1},
+	}
+
+	t.Run("counter sums daily units and projects the period", func(t *testing.T) {
+		price := db.ListMeteredPricesRow{Metric: MetricEgressBytes, UnitBytes: 1e9, UnitPriceCents: 10, IncludedUnits: 2}
+		got := EstimateUsage(price, daily, periodStart, periodEnd, now)
+		assert.Equal(t, int64(8e9+1), got.Quantity)
+		assert.Equal(t, int64(9), got.Units)
+		assert.Equal(t, int64(70), got.EstimatedCents)
+		assert.Equal(t, int64(27), got.ProjectedUnits)
+		assert.Equal(t, int64(250), got.ProjectedCents)
+	})
+
+	t.Run("gauge bills the latest day", func(t *testing.T) {
+		price := db.ListMeteredPricesRow{Metric: MetricDiskUsedBytes, UnitBytes: 1e9, UnitPriceCents: 5}
+		got := EstimateUsage(price, daily, periodStart, periodEnd, now)
+		assert.Equal(t, int64(5e9+1), got.Quantity)
+		assert.Equal(t, int64(6), got.Units)
+		assert.Equal(t, int64(30), got.EstimatedCents)
+		assert.Equal(t, got.EstimatedCents, got.ProjectedCents)
+	})
+
+	t.Run("included units are free", func(t *testing.T) {
+		price := db.ListMeteredPricesRow{Metric: MetricEgressBytes, UnitBytes: 1e9, UnitPriceCents: 10, IncludedUnits: 100}
+		got := EstimateUsage(price, daily, periodStart, periodEnd, now)
+		assert.Equal(t, int64(0), got.EstimatedCents)
+		assert.Equal(t, int64(0), got.ProjectedCents)
+	})
+}
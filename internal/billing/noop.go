@@ -3,6 +3,7 @@ package billing
 import (
 	"context"
 	"log/slog"
+	"time"
 )
 
 // NoOpBillingManager is a no-op billing manager for testing/development
@@ -51,10 +52,51 @@ func (n *NoOpBillingManager) GetMachineTypePriceID(ctx context.Context, machineT
 }
 
 // CreateCheckoutSession returns a fake checkout session (skips Stripe redirect)
-func (n *NoOpBillingManager) CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool) (*CheckoutSessionResult, error) {
+// Promo codes are ignored since nothing is charged
+func (n *NoOpBillingManager) CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool, promoCode string) (*CheckoutSessionResult, error) {
 	// Return empty checkout result - no URL means no redirect needed
 	return &CheckoutSessionResult{
 		SessionID: "noop_checkout_session",
 		URL:       "", // Empty URL signals to skip Stripe redirect
 	}, nil
 }
+
+// ListSubscriptionItems returns no items (there is no Stripe subscription)
+func (n *NoOpBillingManager) ListSubscriptionItems(ctx context.Context, organizationID int64) ([]SubscriptionItem, error) {
+	return nil, nil
+}
+
+// CreateBillingPortalSession returns ErrBillingDisabled (there is no Stripe customer)
+func (n *NoOpBillingManager) CreateBillingPortalSession(ctx context.Context, organizationID int64, returnURL string) (string, error) {
+	return "", ErrBillingDisabled
+}
+
+// ListPaymentMethods returns no payment methods
+func (n *NoOpBillingManager) ListPaymentMethods(ctx context.Context, organizationID int64) ([]PaymentMethod, error) {
+	return nil, nil
+}
+
+// SetDefaultPaymentMethod returns ErrBillingDisabled (there is no Stripe customer)
+func (n *NoOpBillingManager) SetDefaultPaymentMethod(ctx context.Context, organizationID int64, paymentMethodID string) (*PaymentMethod, error) {
+	return nil, ErrBillingDisabled
+}
+
+// ListInvoices returns no invoices
+func (n *NoOpBillingManager) ListInvoices(ctx context.Context, organizationID int64, limit int64, startingAfter string) ([]Invoice, bool, error) {
+	return nil, false, nil
+}
+
+// GetInvoice returns ErrInvoiceNotFound (no invoices are issued without Stripe)
+func (n *NoOpBillingManager) GetInvoice(ctx context.Context, organizationID int64, invoiceID string) (*Invoice, error) {
+	return nil, ErrInvoiceNotFound
+}
+
+// UpdateBillingContact does nothing (there is no Stripe customer)
+func (n *NoOpBillingManager) UpdateBillingContact(ctx context.Context, organizationID int64, email string) error {
+	return nil
+}
+
+// ReportMeteredUsage reports nothing (usage is never billed without Stripe)
+func (n *NoOpBillingManager) ReportMeteredUsage(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,188 @@
+package billing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stripe/stripe-go/v84"
+	portalsession "github.com/stripe/stripe-go/v84/billingportal/session"
+	"github.com/stripe/stripe-go/v84/customer"
+	"github.com/stripe/stripe-go/v84/paymentmethod"
+	"github.com/stripe/stripe-go/v84/subscription"
+)
+
+var (
+	// ErrBillingDisabled is returned for self-service billing operations when Stripe billing is disabled
+	ErrBillingDisabled = errors.New("billing is disabled")
+	// ErrPaymentMethodNotFound is returned when a payment method is not attached to the organization's customer
+	ErrPaymentMethodNotFound = errors.New("payment method not found")
+)
+
+// PaymentMethod is a card attached to an organization's Stripe customer
+type PaymentMethod struct {
+	ID        string
+	Brand     string
+	Last4     string
+	ExpMonth  int64
+	ExpYear   int64
+	IsDefault bool
+}
+
+// CreateBillingPortalSession creates a Stripe Billing Portal session for the organization's customer
+// and returns its short-lived URL
+func (sm *StripeManager) CreateBillingPortalSession(ctx context.Context, organizationID int64, returnURL string) (string, error) {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	params := &stripe.BillingPortalSessionParams{
+		Customer:  stripe.String(sub.StripeCustomerID),
+		ReturnURL: stripe.String(returnURL),
+	}
+	params.Context = ctx
+
+	s, err := portalsession.New(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to create billing portal session: %w", err)
+	}
+	return s.URL, nil
+}
+
+// ListPaymentMethods returns the cards attached to the organization's customer.
+// The default is the subscription's payment method, falling back to the customer's invoice default.
+func (sm *StripeManager) ListPaymentMethods(ctx context.Context, organizationID int64) ([]PaymentMethod, error) {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	defaultID, err := sm.defaultPaymentMethodID(ctx, sub.StripeSubscriptionID, sub.StripeCustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &stripe.PaymentMethodListParams{
+		Customer: stripe.String(sub.StripeCustomerID),
+		Type:     stripe.String(string(stripe.PaymentMethodTypeCard)),
+	}
+	params.Context = ctx
+
+	var methods []PaymentMethod
+	iter := paymentmethod.List(params)
+	for iter.Next() {
+		pm := toPaymentMethod(iter.PaymentMethod())
+		pm.IsDefault = pm.ID == defaultID
+		methods = append(methods, pm)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list payment methods: %w", err)
+	}
+
+	return methods, nil
+}
+
+// SetDefaultPaymentMethod makes a payment method already attached to the organization's customer
+// the default for both future invoices and the active subscription
+func (sm *StripeManager) SetDefaultPaymentMethod(ctx context.Context, organizationID int64, paymentMethodID string) (*PaymentMethod, error) {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	getParams := &stripe.PaymentMethodParams{}
+	getParams.Context = ctx
+	pm, err := paymentmethod.Get(paymentMethodID, getParams)
+	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.HTTPStatusCode == 404 {
+			return nil, ErrPaymentMethodNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment method: %w", err)
+	}
+	// Never let one organization select another customer's card
+	if pm.Customer == nil || pm.Customer.ID != sub.StripeCustomerID {
+		return nil, ErrPaymentMethodNotFound
+	}
+
+	customerParams := &stripe.CustomerParams{
+		InvoiceSettings: &stripe.CustomerInvoiceSettingsParams{
+			DefaultPaymentMethod: stripe.String(paymentMethodID),
+		},
+	}
+	customerParams.Context = ctx
+	if _, err := customer.Update(sub.StripeCustomerID, customerParams); err != nil {
+		return nil, fmt.Errorf("failed to update customer default payment method: %w", err)
+	}
+
+	// Checkout stores the card on the subscription itself, which takes precedence
+	// over the customer default, so update both.
+	subParams := &stripe.SubscriptionParams{
+		DefaultPaymentMethod: stripe.String(paymentMethodID),
+	}
+	subParams.Context = ctx
+	if _, err := subscription.Update(sub.StripeSubscriptionID, subParams); err != nil {
+		return nil, fmt.Errorf("failed to update subscription default payment method: %w", err)
+	}
+
+	result := toPaymentMethod(pm)
+	result.IsDefault = true
+	return &result, nil
+}
+
+// UpdateBillingContact sets the email Stripe sends the organization's invoices and receipts to.
+// Organizations without a subscription have no customer and are skipped.
+func (sm *StripeManager) UpdateBillingContact(ctx context.Context, organizationID int64, email string) error {
+	sub, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	params := &stripe.CustomerParams{
+		Email: stripe.String(email),
+	}
+	params.Context = ctx
+	if _, err := customer.Update(sub.StripeCustomerID, params); err != nil {
+		return fmt.Errorf("failed to update customer email: %w", err)
+	}
+	return nil
+}
+
+func (sm *StripeManager) defaultPaymentMethodID(ctx context.Context, subscriptionID, customerID string) (string, error) {
+	subParams := &stripe.SubscriptionParams{}
+	subParams.Context = ctx
+	s, err := subscription.Get(subscriptionID, subParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if s.DefaultPaymentMethod != nil && s.DefaultPaymentMethod.ID != "" {
+		return s.DefaultPaymentMethod.ID, nil
+	}
+
+	customerParams := &stripe.CustomerParams{}
+	customerParams.Context = ctx
+	c, err := customer.Get(customerID, customerParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to get customer: %w", err)
+	}
+	if c.InvoiceSettings != nil && c.InvoiceSettings.DefaultPaymentMethod != nil {
+		return c.InvoiceSettings.DefaultPaymentMethod.ID, nil
+	}
+	return "", nil
+}
+
+func toPaymentMethod(pm *stripe.PaymentMethod) PaymentMethod {
+	result := PaymentMethod{ID: pm.ID}
+	if pm.Card != nil {
+		result.Brand = string(pm.Card.Brand)
+		result.Last4 = pm.Card.Last4
+		result.ExpMonth = pm.Card.ExpMonth
+		result.ExpYear = pm.Card.ExpYear
+	}
+	return result
+}
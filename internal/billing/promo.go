@@ -0,0 +1,119 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/promotioncode"
+)
+
+// ErrInvalidPromoCode is returned when a promo code does not exist, has expired or is no longer redeemable
+var ErrInvalidPromoCode = errors.New("invalid or expired promo code")
+
+// Discount is the discount a promotion code grants at checkout
+type Discount struct {
+	PromoCode        string // customer-facing code as entered
+	PromotionCodeID  string
+	CouponID         string
+	Name             string
+	PercentOff       float64
+	AmountOffCents   int64
+	Currency         string
+	Duration         string // once, repeating or forever
+	DurationInMonths int64
+}
+
+// Description summarizes the discount for display, e.g. "20% off for 3 months"
+func (d *Discount) Description() string {
+	if d == nil {
+		return ""
+	}
+
+	var amount string
+	switch {
+	case d.PercentOff > 0:
+		amount = strconv.FormatFloat(d.PercentOff, 'f', -1, 64) + "% off"
+	case d.AmountOffCents > 0:
+		amount = fmt.Sprintf("%s %s off", formatCents(d.AmountOffCents), strings.ToUpper(d.Currency))
+	default:
+		return d.Name
+	}
+
+	switch stripe.CouponDuration(d.Duration) {
+	case stripe.CouponDurationForever:
+		return amount + " forever"
+	case stripe.CouponDurationRepeating:
+		if d.DurationInMonths == 1 {
+			return amount + " for 1 month"
+		}
+		return fmt.Sprintf("%s for %d months", amount, d.DurationInMonths)
+	default:
+		return amount + " on the first invoice"
+	}
+}
+
+// formatCents renders an amount in the currency's minor unit as a decimal string
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// ValidatePromoCode looks up an active promotion code in Stripe and returns the discount it grants.
+// Codes are matched case-insensitively; ErrInvalidPromoCode is returned when no redeemable code matches.
+func (sm *StripeManager) ValidatePromoCode(ctx context.Context, code string) (*Discount, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, ErrInvalidPromoCode
+	}
+
+	params := &stripe.PromotionCodeListParams{
+		Code:   stripe.String(code),
+		Active: stripe.Bool(true),
+	}
+	params.AddExpand("data.promotion.coupon")
+	params.Context = ctx
+
+	now := time.Now().Unix()
+	iter := promotioncode.List(params)
+	for iter.Next() {
+		promo := iter.PromotionCode()
+		// Codes restricted to a customer can't be redeemed by a new signup
+		if promo.Customer != nil || promo.CustomerAccount != "" {
+			continue
+		}
+		if promo.ExpiresAt != 0 && promo.ExpiresAt <= now {
+			continue
+		}
+		if promo.MaxRedemptions != 0 && promo.TimesRedeemed >= promo.MaxRedemptions {
+			continue
+		}
+		if promo.Promotion == nil || promo.Promotion.Coupon == nil || !promo.Promotion.Coupon.Valid {
+			continue
+		}
+		return toDiscount(promo), nil
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to look up promo code: %w", err)
+	}
+
+	return nil, ErrInvalidPromoCode
+}
+
+func toDiscount(promo *stripe.PromotionCode) *Discount {
+	c := promo.Promotion.Coupon
+	return &Discount{
+		PromoCode:        promo.Code,
+		PromotionCodeID:  promo.ID,
+		CouponID:         c.ID,
+		Name:             c.Name,
+		PercentOff:       c.PercentOff,
+		AmountOffCents:   c.AmountOff,
+		Currency:         string(c.Currency),
+		Duration:         string(c.Duration),
+		DurationInMonths: c.DurationInMonths,
+	}
+}
@@ -0,0 +1,36 @@
+package billing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiscountDescription tests the checkout summary text for each coupon shape.
+func TestDiscountDescription(t *testing.T) {
+	tests := []struct {
+		name     string
+		discount *Discount
+		want     string
+	}{
+		{"no discount", nil, ""},
+		{"percent forever", &Discount{PercentOff: 20, Duration: "forever"}, "20% off forever"},
+		{"fractional percent once", &Discount{PercentOff: 12.5, Duration: "once"}, "12.5% off on the first invoice"},
+		{"amount repeating", &Discount{AmountOffCents: 5000, Currency: "usd", Duration: "repeating", DurationInMonths: 3}, "50.00 USD off for 3 months"},
+		{"single month", &Discount{PercentOff: 100, Duration: "repeating", DurationInMonths: 1}, "100% off for 1 month"},
+		{"falls back to coupon name", &Discount{Name: "Conference special"}, "Conference special"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.discount.Description())
+		})
+	}
+}
+
+// TestValidatePromoCodeRequiresCode tests that blank codes are rejected without calling Stripe.
+func TestValidatePromoCodeRequiresCode(t *testing.T) {
+	_, err := NewStripeManager(nil).ValidatePromoCode(context.Background(), "  ")
+	assert.ErrorIs(t, err, ErrInvalidPromoCode)
+}
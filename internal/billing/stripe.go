@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/events"
 	"github.com/stripe/stripe-go/v84"
 	"github.com/stripe/stripe-go/v84/checkout/session"
 	"github.com/stripe/stripe-go/v84/subscriptionitem"
@@ -19,6 +20,8 @@ const (
 // StripeManager handles Stripe subscription operations
 type StripeManager struct {
 	db              db.Querier
+	emitter         *events.Emitter
+	dunning         *Dunning
 	webhookSecret   string
 	stripeKey       string
 	stripeSecretKey string
@@ -31,10 +34,13 @@ func NewStripeManager(querier db.Querier) *StripeManager {
 	}
 }
 
-// NewStripeManagerWithWebhook creates a new Stripe manager with webhook support
-func NewStripeManagerWithWebhook(querier db.Querier, webhookSecret, stripeKey string) *StripeManager {
+// NewStripeManagerWithWebhook creates a new Stripe manager with webhook support.
+// Payment webhooks drive the given dunning state machine.
+func NewStripeManagerWithWebhook(querier db.Querier, dunning *Dunning, webhookSecret, stripeKey string) *StripeManager {
 	return &StripeManager{
 		db:              querier,
+		emitter:         events.NewEmitter(querier, events.EventSourceLibOpsAPI),
+		dunning:         dunning,
 		webhookSecret:   webhookSecret,
 		stripeKey:       stripeKey,
 		stripeSecretKey: stripeKey,
@@ -201,7 +207,8 @@ func (sm *StripeManager) RemoveProjectFromSubscription(ctx context.Context, mach
 // CreateCheckoutSession creates a Stripe checkout session for the onboarding flow
 // It queries the database for machine pricing and storage configuration
 // If withTrial is true, a 7-day trial is added to the subscription
-func (sm *StripeManager) CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool) (*CheckoutSessionResult, error) {
+// If promoCode is set, it is validated against Stripe and applied as a discount
+func (sm *StripeManager) CreateCheckoutSession(ctx context.Context, accountEmail, sessionID, machineType string, diskSizeGB int, baseURL string, withTrial bool, promoCode string) (*CheckoutSessionResult, error) {
 	// Validate machine type and get price ID from database
 	if err := sm.ValidateMachineType(ctx, machineType); err != nil {
 		return nil, fmt.Errorf("invalid machine type: %w", err)
@@ -222,6 +229,15 @@ func (sm *StripeManager) CreateCheckoutSession(ctx context.Context, accountEmail
 		return nil, fmt.Errorf("failed to get storage config: %w", err)
 	}
 
+	// Validate the promo code before creating anything in Stripe
+	var discount *Discount
+	if promoCode != "" {
+		discount, err = sm.ValidatePromoCode(ctx, promoCode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	diskPriceID := storageConfig.StripePriceID
 	minDiskGB := int64(storageConfig.MinSizeGb)
 	maxDiskGB := int64(storageConfig.MaxSizeGb)
@@ -256,6 +272,12 @@ func (sm *StripeManager) CreateCheckoutSession(ctx context.Context, accountEmail
 		}
 	}
 
+	if discount != nil {
+		params.Discounts = []*stripe.CheckoutSessionDiscountParams{
+			{PromotionCode: stripe.String(discount.PromotionCodeID)},
+		}
+	}
+
 	s, err := session.New(params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create checkout session: %w", err)
@@ -264,6 +286,7 @@ func (sm *StripeManager) CreateCheckoutSession(ctx context.Context, accountEmail
 	return &CheckoutSessionResult{
 		SessionID: s.ID,
 		URL:       s.URL,
+		Discount:  discount,
 	}, nil
 }
 
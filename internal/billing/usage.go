@@ -0,0 +1,77 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stripe/stripe-go/v84"
+	"github.com/stripe/stripe-go/v84/subscriptionitem"
+)
+
+// Metered usage metrics recorded in usage_records.
+const (
+	// MetricBackupStorageBytes is a gauge of backup storage held for a project.
+	MetricBackupStorageBytes = "backup_storage_bytes"
+	// MetricEgressBytes is a counter of network egress for a project.
+	MetricEgressBytes = "egress_bytes"
+)
+
+// SubscriptionItem is a line item on an organization's Stripe subscription
+type SubscriptionItem struct {
+	ItemID      string
+	PriceID     string
+	Description string
+	Quantity    int64
+	UnitAmount  int64
+	Currency    string
+	Interval    string
+	ProjectName string
+}
+
+// ListSubscriptionItems returns the live line items on an organization's Stripe subscription
+func (sm *StripeManager) ListSubscriptionItems(ctx context.Context, organizationID int64) ([]SubscriptionItem, error) {
+	subscription, err := sm.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	params := &stripe.SubscriptionItemListParams{
+		Subscription: stripe.String(subscription.StripeSubscriptionID),
+	}
+	params.Context = ctx
+	params.AddExpand("data.price.product")
+
+	var items []SubscriptionItem
+	iter := subscriptionitem.List(params)
+	for iter.Next() {
+		items = append(items, toSubscriptionItem(iter.SubscriptionItem()))
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list subscription items: %w", err)
+	}
+
+	return items, nil
+}
+
+func toSubscriptionItem(item *stripe.SubscriptionItem) SubscriptionItem {
+	result := SubscriptionItem{
+		ItemID:      item.ID,
+		Quantity:    item.Quantity,
+		ProjectName: item.Metadata["project_name"],
+	}
+	if item.Price == nil {
+		return result
+	}
+
+	result.PriceID = item.Price.ID
+	result.UnitAmount = item.Price.UnitAmount
+	result.Currency = string(item.Price.Currency)
+	result.Description = item.Price.Nickname
+	if item.Price.Product != nil && item.Price.Product.Name != "" {
+		result.Description = item.Price.Product.Name
+	}
+	if item.Price.Recurring != nil {
+		result.Interval = string(item.Price.Recurring.Interval)
+	}
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/events"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
 	"github.com/stripe/stripe-go/v84"
 	"github.com/stripe/stripe-go/v84/subscriptionitem"
 	"github.com/stripe/stripe-go/v84/webhook"
@@ -65,13 +68,47 @@ func (sm *StripeManager) HandleStripeWebhook(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-	case "customer.subscription.updated":
-		slog.Info("Received customer.subscription.updated event")
-		// Handle subscription updates if needed
+	case "invoice.payment_failed":
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			slog.Error("Failed to parse invoice", "error", err)
+			http.Error(w, "Failed to parse event", http.StatusBadRequest)
+			return
+		}
+
+		if err := sm.handleInvoicePaymentFailed(ctx, &inv); err != nil {
+			slog.Error("Failed to handle invoice.payment_failed", "error", err, "invoice_id", inv.ID)
+			http.Error(w, "Failed to process webhook", http.StatusInternalServerError)
+			return
+		}
+
+	case "invoice.paid":
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			slog.Error("Failed to parse invoice", "error", err)
+			http.Error(w, "Failed to parse event", http.StatusBadRequest)
+			return
+		}
+
+		if err := sm.handleInvoicePaid(ctx, &inv); err != nil {
+			slog.Error("Failed to handle invoice.paid", "error", err, "invoice_id", inv.ID)
+			http.Error(w, "Failed to process webhook", http.StatusInternalServerError)
+			return
+		}
+
+	case "customer.subscription.updated", "customer.subscription.deleted":
+		var sub stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
+			slog.Error("Failed to parse subscription", "error", err)
+			http.Error(w, "Failed to parse event", http.StatusBadRequest)
+			return
+		}
 
-	case "customer.subscription.deleted":
-		slog.Info("Received customer.subscription.deleted event")
-		// Handle subscription cancellation if needed
+		if err := sm.handleSubscriptionChanged(ctx, string(event.Type), &sub); err != nil {
+			slog.Error("Failed to handle subscription change", "error", err, "type", event.Type, "subscription_id", sub.ID)
+			http.Error(w, "Failed to process webhook", http.StatusInternalServerError)
+			return
+		}
 
 	default:
 		slog.Warn("Unhandled webhook event type", "type", event.Type)
@@ -270,3 +307,143 @@ func (sm *StripeManager) findMachineSubscriptionItemByMachineType(ctx context.Co
 
 	return "", fmt.Errorf("machine subscription item not found for machine type: %s", machineType)
 }
+
+// handleInvoicePaymentFailed flags the organization as having a failed payment and
+// emits a notification event so owners can update their payment method
+func (sm *StripeManager) handleInvoicePaymentFailed(ctx context.Context, inv *stripe.Invoice) error {
+	sub, ok, err := sm.subscriptionForInvoice(ctx, inv)
+	if err != nil || !ok {
+		return err
+	}
+
+	err = sm.db.SetOrganizationPaymentFailed(ctx, db.SetOrganizationPaymentFailedParams{
+		PaymentFailedAt:        sql.NullTime{Time: time.Now(), Valid: true},
+		PaymentFailedInvoiceID: sql.NullString{String: inv.ID, Valid: true},
+		ID:                     sub.OrganizationID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to flag organization payment failure: %w", err)
+	}
+
+	slog.Warn("Invoice payment failed",
+		"organization_id", sub.OrganizationID,
+		"invoice_id", inv.ID,
+		"attempt_count", inv.AttemptCount,
+		"next_payment_attempt", inv.NextPaymentAttempt)
+
+	if err := sm.transitionBillingState(ctx, sub.OrganizationID, db.OrganizationsBillingStatePastDue, ReasonPaymentFailed); err != nil {
+		return err
+	}
+
+	if sm.emitter == nil {
+		return nil
+	}
+	org, err := sm.db.GetOrganizationByID(ctx, sub.OrganizationID)
+	if err != nil {
+		slog.Error("Failed to get organization for payment failed event", "error", err, "organization_id", sub.OrganizationID)
+		return nil
+	}
+	eventData := &commonv1.PaymentFailedEvent{
+		OrganizationId:     org.PublicID,
+		InvoiceId:          inv.ID,
+		AmountDue:          inv.AmountDue,
+		Currency:           string(inv.Currency),
+		AttemptCount:       inv.AttemptCount,
+		NextPaymentAttempt: inv.NextPaymentAttempt,
+		HostedInvoiceUrl:   inv.HostedInvoiceURL,
+	}
+	if err := sm.emitter.SendScopedProtoEvent(ctx, events.EventTypeBillingPaymentFailed, inv.ID, &org.PublicID, nil, nil, eventData); err != nil {
+		slog.Error("Failed to emit payment failed event", "error", err, "organization_id", org.PublicID)
+	}
+
+	return nil
+}
+
+// handleInvoicePaid clears the organization's payment failure flag once an invoice is paid
+func (sm *StripeManager) handleInvoicePaid(ctx context.Context, inv *stripe.Invoice) error {
+	sub, ok, err := sm.subscriptionForInvoice(ctx, inv)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := sm.db.ClearOrganizationPaymentFailed(ctx, sub.OrganizationID); err != nil {
+		return fmt.Errorf("failed to clear organization payment failure: %w", err)
+	}
+	return sm.transitionBillingState(ctx, sub.OrganizationID, db.OrganizationsBillingStateActive, ReasonInvoicePaid)
+}
+
+// handleSubscriptionChanged maps Stripe subscription status changes onto the dunning lifecycle
+func (sm *StripeManager) handleSubscriptionChanged(ctx context.Context, eventType string, subscription *stripe.Subscription) error {
+	if subscription.Customer == nil || subscription.Customer.ID == "" {
+		slog.Warn("Subscription has no customer", "subscription_id", subscription.ID)
+		return nil
+	}
+
+	sub, err := sm.db.GetStripeSubscriptionByCustomerID(ctx, subscription.Customer.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Warn("No subscription found for customer", "subscription_id", subscription.ID, "customer_id", subscription.Customer.ID)
+			return nil
+		}
+		return fmt.Errorf("failed to get subscription by customer: %w", err)
+	}
+
+	state, ok := billingStateForSubscription(eventType, subscription.Status)
+	if !ok {
+		slog.Info("Subscription status does not affect billing state",
+			"subscription_id", subscription.ID,
+			"status", subscription.Status)
+		return nil
+	}
+
+	reason := ReasonSubscriptionUpdated
+	if eventType == "customer.subscription.deleted" {
+		reason = ReasonSubscriptionDeleted
+	}
+	return sm.transitionBillingState(ctx, sub.OrganizationID, state, reason)
+}
+
+// billingStateForSubscription returns the dunning state implied by a subscription event.
+// A deleted subscription suspends the organization immediately.
+func billingStateForSubscription(eventType string, status stripe.SubscriptionStatus) (db.OrganizationsBillingState, bool) {
+	if eventType == "customer.subscription.deleted" {
+		return db.OrganizationsBillingStateSuspended, true
+	}
+	switch status {
+	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
+		return db.OrganizationsBillingStateActive, true
+	case stripe.SubscriptionStatusPastDue:
+		return db.OrganizationsBillingStatePastDue, true
+	case stripe.SubscriptionStatusUnpaid, stripe.SubscriptionStatusCanceled:
+		return db.OrganizationsBillingStateSuspended, true
+	}
+	return "", false
+}
+
+// transitionBillingState moves the organization through the dunning lifecycle when
+// the manager was created with a dunning state machine
+func (sm *StripeManager) transitionBillingState(ctx context.Context, organizationID int64, state db.OrganizationsBillingState, reason string) error {
+	if sm.dunning == nil {
+		return nil
+	}
+	return sm.dunning.Transition(ctx, organizationID, state, reason)
+}
+
+// subscriptionForInvoice finds the subscription record for an invoice's customer.
+// Invoices for customers without a subscription record are not ours and are ignored.
+func (sm *StripeManager) subscriptionForInvoice(ctx context.Context, inv *stripe.Invoice) (db.GetStripeSubscriptionByCustomerIDRow, bool, error) {
+	if inv.Customer == nil || inv.Customer.ID == "" {
+		slog.Warn("Invoice has no customer", "invoice_id", inv.ID)
+		return db.GetStripeSubscriptionByCustomerIDRow{}, false, nil
+	}
+
+	sub, err := sm.db.GetStripeSubscriptionByCustomerID(ctx, inv.Customer.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Warn("No subscription found for invoice customer", "invoice_id", inv.ID, "customer_id", inv.Customer.ID)
+			return db.GetStripeSubscriptionByCustomerIDRow{}, false, nil
+		}
+		return db.GetStripeSubscriptionByCustomerIDRow{}, false, fmt.Errorf("failed to get subscription by customer: %w", err)
+	}
+	return sub, true, nil
+}
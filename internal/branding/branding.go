@@ -0,0 +1,108 @@
+// Package branding holds the logo and colors an organization's dashboard pages and
+// notification emails are shown with, so consortiums can white-label libops for
+// their member libraries.
+package branding
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/libops/api/db"
+)
+
+const (
+	// DefaultPrimaryColor is the color of buttons and headers without branding
+	DefaultPrimaryColor = "#7f1d1d"
+	// DefaultAccentColor is the color of links and highlights without branding
+	DefaultAccentColor = "#10b981"
+
+	// MaxLogoSize caps an uploaded logo, which is stored in the database
+	MaxLogoSize = 256 << 10
+)
+
+// colorPattern matches a lowercase six digit hex color
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// logoContentTypes are the image types a logo may be. SVG is left out since it can
+// carry scripts and mail clients rarely show it.
+var logoContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+}
+
+// Branding is how an organization's pages and emails look. Colors are always set,
+// to the defaults when the organization didn't choose its own.
+type Branding struct {
+	PrimaryColor string
+	AccentColor  string
+	LogoURL      string // Empty when the organization has no logo
+	Custom       bool   // Whether the organization changed anything
+}
+
+// Default returns the libops branding.
+func Default() Branding {
+	return Branding{PrimaryColor: DefaultPrimaryColor, AccentColor: DefaultAccentColor}
+}
+
+// NormalizeColor trims and lowercases a hex color so equal colors are stored alike.
+func NormalizeColor(color string) string {
+	return strings.ToLower(strings.TrimSpace(color))
+}
+
+// ValidateColor checks that a normalized color is a six digit hex color, or empty
+// for the default.
+func ValidateColor(field, color string) error {
+	if color != "" && !colorPattern.MatchString(color) {
+		return fmt.Errorf("%s must be a hex color such as #1d4ed8", field)
+	}
+	return nil
+}
+
+// LogoContentType checks an uploaded logo's size and format and returns its content type.
+func LogoContentType(logo []byte) (string, error) {
+	if len(logo) > MaxLogoSize {
+		return "", fmt.Errorf("logo must be at most %d KiB", MaxLogoSize>>10)
+	}
+	contentType := http.DetectContentType(logo)
+	if !logoContentTypes[contentType] {
+		return "", fmt.Errorf("logo must be a PNG or JPEG image")
+	}
+	return contentType, nil
+}
+
+// LogoPath is where an organization's logo is served. The version changes with
+// every upload so browsers and mail clients don't show a stale logo.
+func LogoPath(organizationPublicID string, version int64) string {
+	return fmt.Sprintf("/organizations/%s/logo?v=%d", organizationPublicID, version)
+}
+
+// Load returns an organization's branding with its logo URL under baseURL, or
+// relative to the site when baseURL is empty.
+func Load(ctx context.Context, querier db.Querier, organizationID int64, organizationPublicID, baseURL string) (Branding, error) {
+	brand := Default()
+
+	row, err := querier.GetOrganizationBranding(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return brand, nil
+	}
+	if err != nil {
+		return brand, fmt.Errorf("failed to get organization branding: %w", err)
+	}
+
+	if row.PrimaryColor != "" {
+		brand.PrimaryColor = row.PrimaryColor
+	}
+	if row.AccentColor != "" {
+		brand.AccentColor = row.AccentColor
+	}
+	if row.HasLogo {
+		brand.LogoURL = strings.TrimSuffix(baseURL, "/") + LogoPath(organizationPublicID, row.UpdatedAt.Time.Unix())
+	}
+	brand.Custom = row.PrimaryColor != "" || row.AccentColor != "" || row.HasLogo
+	return brand, nil
+}
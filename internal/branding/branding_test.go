@@ -0,0 +1,67 @@
+package branding
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+func TestValidateColor(t *testing.T) {
+	for _, color := range []string{"", "#1d4ed8", NormalizeColor(" #1D4ED8 ")} {
+		assert.NoError(t, ValidateColor("primary_color", color), color)
+	}
+	for _, color := range []string{"red", "#fff", "#1D4ED8", "1d4ed8", "#1d4ed8;}"} {
+		assert.Error(t, ValidateColor("primary_color", color), color)
+	}
+}
+
+func TestLogoContentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	contentType, err := LogoContentType(png)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	contentType, err = LogoContentType([]byte("\xff\xd8\xff\xe0\x00\x10JFIF"))
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", contentType)
+
+	_, err = LogoContentType([]byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`))
+	assert.Error(t, err)
+	_, err = LogoContentType(append(png, make([]byte, MaxLogoSize)...))
+	assert.ErrorContains(t, err, "256 KiB")
+}
+
+// TestLoad tests that unset colors fall back to the defaults and that the logo URL
+// changes with every upload.
+func TestLoad(t *testing.T) {
+	const orgID = "0194d3a0-0000-7000-8000-000000000001"
+	mock := &testutils.MockQuerier{}
+
+	brand, err := Load(context.Background(), mock, 7, orgID, "")
+	require.NoError(t, err)
+	assert.Equal(t, Default(), brand, "organizations without a row keep the libops look")
+
+	mock.GetOrganizationBrandingFunc = func(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error) {
+		return db.GetOrganizationBrandingRow{
+			OrganizationID: organizationID,
+			AccentColor:    "#22c55e",
+			HasLogo:        true,
+			UpdatedAt:      sql.NullTime{Time: time.Unix(1767000000, 0), Valid: true},
+		}, nil
+	}
+	brand, err = Load(context.Background(), mock, 7, orgID, "https://dash.libops.io/")
+	require.NoError(t, err)
+	assert.Equal(t, Branding{
+		PrimaryColor: DefaultPrimaryColor,
+		AccentColor:  "#22c55e",
+		LogoURL:      "https://dash.libops.io/organizations/" + orgID + "/logo?v=1767000000",
+		Custom:       true,
+	}, brand)
+}
@@ -0,0 +1,421 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/store"
+)
+
+// DefaultTTL bounds staleness for writes that bypass the Querier (raw SQL,
+// other services sharing the database).
+const DefaultTTL = 30 * time.Second
+
+var cacheRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "libops_cache_requests_total",
+		Help: "Total number of cached lookups by entity and result",
+	},
+	[]string{"entity", "result"}, // hit, miss
+)
+
+// Querier wraps a db.Querier and serves hot single-row lookups (accounts,
+// organizations, sites and API keys) from a Store. Every query that writes
+// those tables invalidates the entries it may change; everything else passes
+// through.
+type Querier struct {
+	db.Querier
+	store Store
+	ttl   time.Duration
+}
+
+var _ db.Querier = (*Querier)(nil)
+
+// NewQuerier creates a caching Querier. A non-positive ttl uses DefaultTTL.
+func NewQuerier(next db.Querier, store Store, ttl time.Duration) *Querier {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Querier{Querier: next, store: store, ttl: ttl}
+}
+
+// GetDB exposes the wrapped DBTX so raw-SQL callers keep working.
+func (q *Querier) GetDB() db.DBTX {
+	if p, ok := q.Querier.(db.DBProvider); ok {
+		return p.GetDB()
+	}
+	return nil
+}
+
+func accountIDKey(id int64) string              { return fmt.Sprintf("account:id:%d", id) }
+func accountPublicIDKey(publicID string) string { return "account:uuid:" + publicID }
+func organizationKey(publicID string) string    { return "organization:" + publicID }
+func siteKey(publicID string) string            { return "site:" + publicID }
+func apiKeyKey(publicID string) string          { return "apikey:" + publicID }
+func activeAPIKeyKey(publicID string) string    { return "apikey:active:" + publicID }
+
+// cached implements read-through caching for a single lookup. Lookups inside a
+// transaction skip the cache, since what they'd store may never commit.
+// Lookups that need the primary's current row skip reading it, and refresh the
+// entry with what they load.
+func cached[T any](ctx context.Context, q *Querier, entity, key string, load func() (T, error)) (T, error) {
+	if database.InTx(ctx) {
+		return load()
+	}
+	if raw, ok := q.store.Get(ctx, key); ok && !database.UsesPrimary(ctx) {
+		var v T
+		if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&v); err == nil {
+			cacheRequests.WithLabelValues(entity, "hit").Inc()
+			return v, nil
+		}
+		q.store.Delete(ctx, key)
+	}
+	cacheRequests.WithLabelValues(entity, "miss").Inc()
+
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		slog.Warn("cache: failed to encode entry", "key", key, "err", err)
+		return v, nil
+	}
+	q.store.Set(ctx, key, buf.Bytes(), q.ttl)
+	return v, nil
+}
+
+// GetAccount returns an account by public ID, from cache when possible.
+func (q *Querier) GetAccount(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+	return cached(ctx, q, "account", accountPublicIDKey(publicID), func() (db.GetAccountRow, error) {
+		return q.Querier.GetAccount(ctx, publicID)
+	})
+}
+
+// GetAccountByID returns an account by internal ID, from cache when possible.
+func (q *Querier) GetAccountByID(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+	return cached(ctx, q, "account", accountIDKey(id), func() (db.GetAccountByIDRow, error) {
+		return q.Querier.GetAccountByID(ctx, id)
+	})
+}
+
+// GetOrganization returns an organization by public ID, from cache when possible.
+func (q *Querier) GetOrganization(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+	return cached(ctx, q, "organization", organizationKey(publicID), func() (db.GetOrganizationRow, error) {
+		return q.Querier.GetOrganization(ctx, publicID)
+	})
+}
+
+// GetSite returns a site by public ID, from cache when possible.
+func (q *Querier) GetSite(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+	return cached(ctx, q, "site", siteKey(publicID), func() (db.GetSiteRow, error) {
+		return q.Querier.GetSite(ctx, publicID)
+	})
+}
+
+// GetAPIKeyByUUID returns an API key by public ID, from cache when possible.
+// API keys are only cached in a shared Store, since a revocation made on one
+// instance can't reach another's.
+func (q *Querier) GetAPIKeyByUUID(ctx context.Context, publicID string) (db.GetAPIKeyByUUIDRow, error) {
+	if !q.store.Shared() {
+		return q.Querier.GetAPIKeyByUUID(ctx, publicID)
+	}
+	return cached(ctx, q, "api_key", apiKeyKey(publicID), func() (db.GetAPIKeyByUUIDRow, error) {
+		return q.Querier.GetAPIKeyByUUID(ctx, publicID)
+	})
+}
+
+// GetActiveAPIKeyByUUID returns an active API key by public ID, from cache when
+// possible. A cached key that has since passed its expiry is re-checked
+// against the database. Like GetAPIKeyByUUID, it only caches in a shared Store.
+func (q *Querier) GetActiveAPIKeyByUUID(ctx context.Context, publicID string) (db.GetActiveAPIKeyByUUIDRow, error) {
+	if !q.store.Shared() {
+		return q.Querier.GetActiveAPIKeyByUUID(ctx, publicID)
+	}
+	key := activeAPIKeyKey(publicID)
+	row, err := cached(ctx, q, "api_key", key, func() (db.GetActiveAPIKeyByUUIDRow, error) {
+		return q.Querier.GetActiveAPIKeyByUUID(ctx, publicID)
+	})
+	if err == nil && row.ExpiresAt.Valid && !row.ExpiresAt.Time.After(time.Now()) {
+		q.store.Delete(ctx, key)
+		return q.Querier.GetActiveAPIKeyByUUID(ctx, publicID)
+	}
+	return row, err
+}
+
+// UpdateAccount updates an account and invalidates its cache entries.
+func (q *Querier) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) error {
+	defer q.invalidate(ctx, q.accountKeysByPublicID(ctx, arg.PublicID)...)
+	return q.Querier.UpdateAccount(ctx, arg)
+}
+
+// UpdateAccountOnboarding updates onboarding state and invalidates the account's cache entries.
+func (q *Querier) UpdateAccountOnboarding(ctx context.Context, arg db.UpdateAccountOnboardingParams) error {
+	defer q.invalidate(ctx, q.accountKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateAccountOnboarding(ctx, arg)
+}
+
+// DeleteAccount deletes an account and invalidates its cache entries.
+func (q *Querier) DeleteAccount(ctx context.Context, publicID string) error {
+	// Resolve the internal ID before the row disappears.
+	keys := q.accountKeysByPublicID(ctx, publicID)
+	defer q.invalidate(ctx, keys...)
+	return q.Querier.DeleteAccount(ctx, publicID)
+}
+
+// UpdateOrganization updates an organization and invalidates its cache entry.
+func (q *Querier) UpdateOrganization(ctx context.Context, arg db.UpdateOrganizationParams) (int64, error) {
+	defer q.invalidate(ctx, organizationKey(arg.PublicID))
+	return q.Querier.UpdateOrganization(ctx, arg)
+}
+
+// DeleteOrganization deletes an organization and invalidates its cache entry.
+func (q *Querier) DeleteOrganization(ctx context.Context, publicID string) error {
+	defer q.invalidate(ctx, organizationKey(publicID))
+	return q.Querier.DeleteOrganization(ctx, publicID)
+}
+
+// UpdateSite updates a site and invalidates its cache entry.
+func (q *Querier) UpdateSite(ctx context.Context, arg db.UpdateSiteParams) (int64, error) {
+	defer q.invalidate(ctx, siteKey(arg.PublicID))
+	return q.Querier.UpdateSite(ctx, arg)
+}
+
+// DeleteSite deletes a site and invalidates its cache entry.
+func (q *Querier) DeleteSite(ctx context.Context, publicID string) error {
+	defer q.invalidate(ctx, siteKey(publicID))
+	return q.Querier.DeleteSite(ctx, publicID)
+}
+
+// UpdateAPIKeyActive activates or revokes an API key and invalidates its cache entries.
+func (q *Querier) UpdateAPIKeyActive(ctx context.Context, arg db.UpdateAPIKeyActiveParams) error {
+	defer q.invalidate(ctx, apiKeyKey(arg.PublicID), activeAPIKeyKey(arg.PublicID))
+	return q.Querier.UpdateAPIKeyActive(ctx, arg)
+}
+
+// DeleteAPIKey deletes an API key and invalidates its cache entries.
+func (q *Querier) DeleteAPIKey(ctx context.Context, publicID string) error {
+	defer q.invalidate(ctx, apiKeyKey(publicID), activeAPIKeyKey(publicID))
+	return q.Querier.DeleteAPIKey(ctx, publicID)
+}
+
+// UpdateAccountProfile updates an account's profile and invalidates its cache entries.
+func (q *Querier) UpdateAccountProfile(ctx context.Context, arg db.UpdateAccountProfileParams) error {
+	defer q.invalidate(ctx, q.accountKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateAccountProfile(ctx, arg)
+}
+
+// UpdateAccountAvatar updates an account's avatar and invalidates its cache entries.
+func (q *Querier) UpdateAccountAvatar(ctx context.Context, arg db.UpdateAccountAvatarParams) error {
+	defer q.invalidate(ctx, q.accountKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateAccountAvatar(ctx, arg)
+}
+
+// IncrementFailedLoginAttempts records a failed login and invalidates the account's cache entries.
+func (q *Querier) IncrementFailedLoginAttempts(ctx context.Context, id int64) error {
+	defer q.invalidate(ctx, q.accountKeysByID(ctx, id)...)
+	return q.Querier.IncrementFailedLoginAttempts(ctx, id)
+}
+
+// ResetFailedLoginAttempts clears failed logins and invalidates the account's cache entries.
+func (q *Querier) ResetFailedLoginAttempts(ctx context.Context, id int64) error {
+	defer q.invalidate(ctx, q.accountKeysByID(ctx, id)...)
+	return q.Querier.ResetFailedLoginAttempts(ctx, id)
+}
+
+// SetOrganizationPaymentFailed flags a failed payment and invalidates the organization's cache entry.
+func (q *Querier) SetOrganizationPaymentFailed(ctx context.Context, arg db.SetOrganizationPaymentFailedParams) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, arg.ID)...)
+	return q.Querier.SetOrganizationPaymentFailed(ctx, arg)
+}
+
+// ClearOrganizationPaymentFailed clears a failed payment and invalidates the organization's cache entry.
+func (q *Querier) ClearOrganizationPaymentFailed(ctx context.Context, id int64) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, id)...)
+	return q.Querier.ClearOrganizationPaymentFailed(ctx, id)
+}
+
+// SetOrganizationBillingState updates billing state and invalidates the organization's cache entry.
+func (q *Querier) SetOrganizationBillingState(ctx context.Context, arg db.SetOrganizationBillingStateParams) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, arg.ID)...)
+	return q.Querier.SetOrganizationBillingState(ctx, arg)
+}
+
+// UpdateOrganizationAllowedCidrs updates allowed CIDRs and invalidates the organization's cache entry.
+func (q *Querier) UpdateOrganizationAllowedCidrs(ctx context.Context, arg db.UpdateOrganizationAllowedCidrsParams) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateOrganizationAllowedCidrs(ctx, arg)
+}
+
+// UpdateOrganizationDataResidency updates data residency and invalidates the organization's cache entry.
+func (q *Querier) UpdateOrganizationDataResidency(ctx context.Context, arg db.UpdateOrganizationDataResidencyParams) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateOrganizationDataResidency(ctx, arg)
+}
+
+// SuspendOrganization suspends an organization and invalidates its cache entry.
+func (q *Querier) SuspendOrganization(ctx context.Context, arg db.SuspendOrganizationParams) error {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, arg.ID)...)
+	return q.Querier.SuspendOrganization(ctx, arg)
+}
+
+// UnsuspendOrganization lifts an organization's suspension and invalidates its cache entry.
+func (q *Querier) UnsuspendOrganization(ctx context.Context, id int64) (int64, error) {
+	defer q.invalidate(ctx, q.organizationKeysByID(ctx, id)...)
+	return q.Querier.UnsuspendOrganization(ctx, id)
+}
+
+// SuspendOrganizationSites suspends an organization's sites and invalidates their cache entries.
+func (q *Querier) SuspendOrganizationSites(ctx context.Context, organizationID int64) (int64, error) {
+	defer q.invalidate(ctx, q.organizationSiteKeys(ctx, organizationID)...)
+	return q.Querier.SuspendOrganizationSites(ctx, organizationID)
+}
+
+// ResumeOrganizationSites resumes an organization's sites and invalidates their cache entries.
+func (q *Querier) ResumeOrganizationSites(ctx context.Context, id int64) (int64, error) {
+	defer q.invalidate(ctx, q.organizationSiteKeys(ctx, id)...)
+	return q.Querier.ResumeOrganizationSites(ctx, id)
+}
+
+// InitSiteAccessGateSecret sets a site's access gate secret and invalidates its cache entry.
+func (q *Querier) InitSiteAccessGateSecret(ctx context.Context, arg db.InitSiteAccessGateSecretParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.InitSiteAccessGateSecret(ctx, arg)
+}
+
+// UpdateSiteControllerVersion records a site's controller version and invalidates its cache entry.
+func (q *Querier) UpdateSiteControllerVersion(ctx context.Context, arg db.UpdateSiteControllerVersionParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateSiteControllerVersion(ctx, arg)
+}
+
+// UpdateSiteOsStatus records a site's OS status and invalidates its cache entry.
+func (q *Querier) UpdateSiteOsStatus(ctx context.Context, arg db.UpdateSiteOsStatusParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateSiteOsStatus(ctx, arg)
+}
+
+// SetSiteAPIRegion sets a site's API region and invalidates its cache entry.
+func (q *Querier) SetSiteAPIRegion(ctx context.Context, arg db.SetSiteAPIRegionParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.SetSiteAPIRegion(ctx, arg)
+}
+
+// UpdateSiteContainers records a site's containers and invalidates its cache entry.
+func (q *Querier) UpdateSiteContainers(ctx context.Context, arg db.UpdateSiteContainersParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateSiteContainers(ctx, arg)
+}
+
+// UpdateSiteHealthCheckPath sets a site's health check path and invalidates its cache entry.
+func (q *Querier) UpdateSiteHealthCheckPath(ctx context.Context, arg db.UpdateSiteHealthCheckPathParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateSiteHealthCheckPath(ctx, arg)
+}
+
+// UpdateSiteCheckIn records a site's check-in and invalidates its cache entry.
+func (q *Querier) UpdateSiteCheckIn(ctx context.Context, id int64) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, id)...)
+	return q.Querier.UpdateSiteCheckIn(ctx, id)
+}
+
+// UpdateSiteDiskUsage records a site's disk usage and invalidates its cache entry.
+func (q *Querier) UpdateSiteDiskUsage(ctx context.Context, arg db.UpdateSiteDiskUsageParams) error {
+	defer q.invalidate(ctx, q.siteKeysByID(ctx, arg.ID)...)
+	return q.Querier.UpdateSiteDiskUsage(ctx, arg)
+}
+
+// UpdateAPIKeyLastUsed records an API key's use and invalidates its by-UUID
+// cache entry. The active-key entry only backs authentication, which doesn't
+// read last_used_at, so it's kept rather than dropped on every request.
+func (q *Querier) UpdateAPIKeyLastUsed(ctx context.Context, publicID string) error {
+	defer q.invalidate(ctx, apiKeyKey(publicID))
+	return q.Querier.UpdateAPIKeyLastUsed(ctx, publicID)
+}
+
+// ReassignServiceAccountAPIKeys moves service account keys to a new creator
+// and invalidates their cache entries.
+func (q *Querier) ReassignServiceAccountAPIKeys(ctx context.Context, arg db.ReassignServiceAccountAPIKeysParams) (int64, error) {
+	// Resolve the keys before they stop matching.
+	var keys []string
+	publicIDs, err := q.Querier.ListServiceAccountAPIKeyPublicIDs(ctx, db.ListServiceAccountAPIKeyPublicIDsParams{
+		OrganizationID: arg.OrganizationID,
+		CreatedBy:      arg.FromAccountID,
+	})
+	if err == nil {
+		for _, publicID := range publicIDs {
+			keys = append(keys, apiKeyKey(publicID), activeAPIKeyKey(publicID))
+		}
+	}
+	defer q.invalidate(ctx, keys...)
+	return q.Querier.ReassignServiceAccountAPIKeys(ctx, arg)
+}
+
+// invalidate drops keys once the write that changed them can be seen: at once
+// outside a transaction, or after it commits, so a lookup made meanwhile
+// outside it can't cache the old row again.
+func (q *Querier) invalidate(ctx context.Context, keys ...string) {
+	store.AfterCommit(ctx, func(ctx context.Context) {
+		q.store.Delete(ctx, keys...)
+	})
+}
+
+// accountKeysByPublicID returns both cache keys for an account. The by-ID key
+// is resolved from the database since most writes only carry the public ID.
+func (q *Querier) accountKeysByPublicID(ctx context.Context, publicID string) []string {
+	keys := []string{accountPublicIDKey(publicID)}
+	if account, err := q.Querier.GetAccount(ctx, publicID); err == nil {
+		keys = append(keys, accountIDKey(account.ID))
+	}
+	return keys
+}
+
+// accountKeysByID returns both cache keys for an account given its internal ID.
+func (q *Querier) accountKeysByID(ctx context.Context, id int64) []string {
+	keys := []string{accountIDKey(id)}
+	if account, err := q.Querier.GetAccountByID(ctx, id); err == nil {
+		keys = append(keys, accountPublicIDKey(account.PublicID))
+	}
+	return keys
+}
+
+// organizationKeysByID returns the cache key for an organization given its internal ID.
+func (q *Querier) organizationKeysByID(ctx context.Context, id int64) []string {
+	organization, err := q.Querier.GetOrganizationByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return []string{organizationKey(organization.PublicID)}
+}
+
+// siteKeysByID returns the cache key for a site given its internal ID.
+func (q *Querier) siteKeysByID(ctx context.Context, id int64) []string {
+	site, err := q.Querier.GetSiteByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return []string{siteKey(site.PublicID)}
+}
+
+// organizationSiteKeys returns the cache keys for every site in an organization.
+func (q *Querier) organizationSiteKeys(ctx context.Context, organizationID int64) []string {
+	publicIDs, err := q.Querier.ListOrganizationSitePublicIDs(ctx, organizationID)
+	if err != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(publicIDs))
+	for _, publicID := range publicIDs {
+		keys = append(keys, siteKey(publicID))
+	}
+	return keys
+}
@@ -0,0 +1,289 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestQuerier_CachesOrganizationUntilUpdate verifies read-through caching and invalidation on update.
+func TestQuerier_CachesOrganizationUntilUpdate(t *testing.T) {
+	calls := 0
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			calls++
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID, Name: "acme"}, nil
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+	ctx := context.Background()
+
+	for range 3 {
+		org, err := q.GetOrganization(ctx, "org-1")
+		if err != nil {
+			t.Fatalf("GetOrganization() error = %v", err)
+		}
+		if org.Name != "acme" {
+			t.Errorf("GetOrganization() name = %q, want acme", org.Name)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 database call, got %d", calls)
+	}
+
+	if _, err := q.UpdateOrganization(ctx, db.UpdateOrganizationParams{PublicID: "org-1"}); err != nil {
+		t.Fatalf("UpdateOrganization() error = %v", err)
+	}
+	if _, err := q.GetOrganization(ctx, "org-1"); err != nil {
+		t.Fatalf("GetOrganization() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected cache invalidation to force a reload, got %d calls", calls)
+	}
+}
+
+// TestQuerier_DoesNotCacheErrors verifies that failed lookups are not cached.
+func TestQuerier_DoesNotCacheErrors(t *testing.T) {
+	calls := 0
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			calls++
+			return db.GetSiteRow{}, sql.ErrNoRows
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+
+	for range 2 {
+		if _, err := q.GetSite(context.Background(), "missing"); err != sql.ErrNoRows {
+			t.Fatalf("GetSite() error = %v, want sql.ErrNoRows", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected errors to bypass the cache, got %d calls", calls)
+	}
+}
+
+// TestQuerier_AccountInvalidation verifies both account keys are dropped on update.
+func TestQuerier_AccountInvalidation(t *testing.T) {
+	row := db.GetAccountRow{ID: 7, PublicID: "acct-7", Email: "old@example.com"}
+	mock := &testutils.MockQuerier{
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			return row, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: row.ID, PublicID: row.PublicID, Email: row.Email}, nil
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+	ctx := context.Background()
+
+	if _, err := q.GetAccountByID(ctx, 7); err != nil {
+		t.Fatalf("GetAccountByID() error = %v", err)
+	}
+
+	row.Email = "new@example.com"
+	if err := q.UpdateAccount(ctx, db.UpdateAccountParams{PublicID: "acct-7", Email: row.Email}); err != nil {
+		t.Fatalf("UpdateAccount() error = %v", err)
+	}
+
+	account, err := q.GetAccountByID(ctx, 7)
+	if err != nil {
+		t.Fatalf("GetAccountByID() error = %v", err)
+	}
+	if account.Email != "new@example.com" {
+		t.Errorf("GetAccountByID() returned stale email %q", account.Email)
+	}
+}
+
+// TestLRU_EvictionAndExpiry verifies capacity eviction and TTL expiry.
+func TestLRU_EvictionAndExpiry(t *testing.T) {
+	now := time.Now()
+	c := NewLRU(2)
+	c.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	c.Set(ctx, "a", []byte("1"), time.Minute)
+	c.Set(ctx, "b", []byte("2"), time.Minute)
+	c.Get(ctx, "a") // a is now most recently used
+	c.Set(ctx, "c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get(ctx, "b"); ok {
+		t.Error("expected least recently used entry b to be evicted")
+	}
+	if _, ok := c.Get(ctx, "a"); !ok {
+		t.Error("expected entry a to be retained")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get(ctx, "c"); ok {
+		t.Error("expected entry c to expire")
+	}
+}
+
+// TestQuerier_InvalidatesAfterCommit verifies that a write inside a
+// transaction drops its entries once the transaction commits, so a lookup
+// made before then can't leave the old row cached.
+func TestQuerier_InvalidatesAfterCommit(t *testing.T) {
+	calls := 0
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			calls++
+			return db.GetSiteRow{ID: 1, PublicID: publicID}, nil
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+	primary, sqlMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	defer primary.Close()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectCommit()
+
+	err = store.New(primary).InTx(context.Background(), func(ctx context.Context) error {
+		if _, err := q.UpdateSite(ctx, db.UpdateSiteParams{PublicID: "site-1"}); err != nil {
+			return err
+		}
+		// A concurrent request outside the transaction still sees the old row
+		_, err := q.GetSite(context.Background(), "site-1")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("InTx() error = %v", err)
+	}
+
+	if _, err := q.GetSite(context.Background(), "site-1"); err != nil {
+		t.Fatalf("GetSite() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the commit to drop the entry cached before it, got %d calls", calls)
+	}
+}
+
+// TestQuerier_PrimaryReadsBypassCache verifies that a lookup needing the
+// primary's current row skips the cached entry and refreshes it.
+func TestQuerier_PrimaryReadsBypassCache(t *testing.T) {
+	name := "acme"
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID, Name: name}, nil
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+	ctx := context.Background()
+
+	if _, err := q.GetOrganization(ctx, "org-1"); err != nil {
+		t.Fatalf("GetOrganization() error = %v", err)
+	}
+	// Changed by a write the cache didn't see
+	name = "acme-renamed"
+
+	for _, ctx := range []context.Context{database.WithPrimary(ctx), ctx} {
+		org, err := q.GetOrganization(ctx, "org-1")
+		if err != nil {
+			t.Fatalf("GetOrganization() error = %v", err)
+		}
+		if org.Name != "acme-renamed" {
+			t.Errorf("GetOrganization() name = %q, want acme-renamed", org.Name)
+		}
+	}
+}
+
+// sharedLRU is an LRU standing in for a Store shared by every instance.
+type sharedLRU struct{ *LRU }
+
+func (sharedLRU) Shared() bool { return true }
+
+// TestQuerier_CachesAPIKeysOnlyInSharedStore verifies a per-instance cache
+// never serves an API key another instance may have revoked.
+func TestQuerier_CachesAPIKeysOnlyInSharedStore(t *testing.T) {
+	tests := []struct {
+		name      string
+		store     Store
+		wantCalls int
+	}{
+		{name: "per-instance", store: NewLRU(10), wantCalls: 3},
+		{name: "shared", store: sharedLRU{NewLRU(10)}, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			mock := &testutils.MockQuerier{
+				GetActiveAPIKeyByUUIDFunc: func(ctx context.Context, publicID string) (db.GetActiveAPIKeyByUUIDRow, error) {
+					calls++
+					return db.GetActiveAPIKeyByUUIDRow{ID: 1, PublicID: publicID}, nil
+				},
+			}
+			q := NewQuerier(mock, tt.store, time.Minute)
+
+			for range 3 {
+				if _, err := q.GetActiveAPIKeyByUUID(context.Background(), "key-1"); err != nil {
+					t.Fatalf("GetActiveAPIKeyByUUID() error = %v", err)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d database calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+// TestQuerier_InvalidatesFromByIDWriters verifies writers keyed by internal ID
+// drop the entries of the rows they change.
+func TestQuerier_InvalidatesFromByIDWriters(t *testing.T) {
+	orgCalls, siteCalls := 0, 0
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			orgCalls++
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: "org-1"}, nil
+		},
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			siteCalls++
+			return db.GetSiteRow{PublicID: publicID}, nil
+		},
+		ListOrganizationSitePublicIDsFunc: func(ctx context.Context, organizationID int64) ([]string, error) {
+			return []string{"site-1", "site-2"}, nil
+		},
+	}
+	q := NewQuerier(mock, NewLRU(10), time.Minute)
+	ctx := context.Background()
+
+	load := func() {
+		t.Helper()
+		if _, err := q.GetOrganization(ctx, "org-1"); err != nil {
+			t.Fatalf("GetOrganization() error = %v", err)
+		}
+		for _, publicID := range []string{"site-1", "site-2"} {
+			if _, err := q.GetSite(ctx, publicID); err != nil {
+				t.Fatalf("GetSite() error = %v", err)
+			}
+		}
+	}
+
+	load()
+	if err := q.SetOrganizationBillingState(ctx, db.SetOrganizationBillingStateParams{ID: 1}); err != nil {
+		t.Fatalf("SetOrganizationBillingState() error = %v", err)
+	}
+	if _, err := q.SuspendOrganizationSites(ctx, 1); err != nil {
+		t.Fatalf("SuspendOrganizationSites() error = %v", err)
+	}
+	load()
+
+	if orgCalls != 2 {
+		t.Errorf("expected the organization to be reloaded, got %d calls", orgCalls)
+	}
+	if siteCalls != 4 {
+		t.Errorf("expected both sites to be reloaded, got %d calls", siteCalls)
+	}
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces all keys written by the API in a shared Redis.
+const keyPrefix = "libops:api:"
+
+// Redis is a Store backed by a Redis server, shared by all API instances.
+// Redis errors are logged and treated as cache misses so an outage only
+// costs latency, never correctness.
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis connects to the Redis server at url (redis://[user:pass@]host:port/db).
+func NewRedis(ctx context.Context, url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to ping redis: %w", err)
+	}
+
+	return &Redis{client: client}, nil
+}
+
+// Get returns a cached value if present.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool) {
+	value, err := r.client.Get(ctx, keyPrefix+key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.Warn("cache: redis get failed", "key", key, "err", err)
+		}
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores a value with the given TTL.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	if err := r.client.Set(ctx, keyPrefix+key, value, ttl).Err(); err != nil {
+		slog.Warn("cache: redis set failed", "key", key, "err", err)
+	}
+}
+
+// Delete removes the given keys.
+func (r *Redis) Delete(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = keyPrefix + key
+	}
+	if err := r.client.Del(ctx, prefixed...).Err(); err != nil {
+		slog.Warn("cache: redis delete failed", "keys", keys, "err", err)
+	}
+}
+
+// Ping checks connectivity to the Redis server.
+func (r *Redis) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+// Shared is true: all instances read and invalidate the same server.
+func (r *Redis) Shared() bool {
+	return true
+}
+
+// Close closes the underlying client.
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"maps"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -17,8 +21,44 @@ type Config struct {
 	APIBaseURL  string
 	DashBaseUrl string
 
+	// StatusPageDomain serves organization status pages as status.<slug>.<domain>.
+	// When empty, status pages are only served under the dashboard at /status/<slug>.
+	StatusPageDomain string
+
 	DatabaseURL string
 
+	// MigrateOnStart applies pending migrations when the server starts. When
+	// off, migrations run as a release step with `migrate up` and the server
+	// refuses to start on a schema that's behind.
+	MigrateOnStart bool
+
+	// DatabaseReplicaURL is an optional read replica DSN. When set, read-only
+	// queries are routed to the replica and writes go to DatabaseURL.
+	DatabaseReplicaURL string
+
+	// DatabaseReplicaMaxLag is how far behind the primary the replica may fall
+	// before reads go back to the primary. Zero trusts the replica regardless.
+	DatabaseReplicaMaxLag time.Duration
+
+	// Region is the GCP region this deployment of the API runs in, e.g.
+	// us-central1. RegionEndpoints maps every region the API runs in to its
+	// public URL; controllers are moved to the one nearest their site. Empty
+	// for a single-region deployment.
+	Region          string
+	RegionEndpoints map[string]string
+
+	// Database connection pool tuning
+	DatabaseMaxOpenConns    int
+	DatabaseMaxIdleConns    int
+	DatabaseConnMaxLifetime time.Duration
+	DatabaseConnMaxIdleTime time.Duration
+
+	// Cache Configuration for hot lookups (accounts, organizations, sites, API keys)
+	CacheBackend string // "none", "memory" or "redis"
+	CacheTTL     time.Duration
+	CacheSize    int
+	RedisURL     string
+
 	GCPProjectID  string
 	EventsTopicID string
 
@@ -49,26 +89,109 @@ type Config struct {
 	StripeWebhookSecret string
 	DisableBilling      bool // When true, uses NoOp billing manager instead of Stripe
 
+	// Dunning grace periods: how long an organization stays past_due before its
+	// sites are suspended, and suspended before it is scheduled for deletion
+	BillingPastDueGracePeriod   time.Duration
+	BillingSuspendedGracePeriod time.Duration
+
+	// SiteDowntimeThreshold is how long a site can go without a controller check-in
+	// before a downtime incident is opened. Zero disables downtime monitoring.
+	SiteDowntimeThreshold time.Duration
+
+	// SiteProbeInterval is how often each site's health URL is requested from
+	// outside its VM. Zero disables uptime probes.
+	SiteProbeInterval time.Duration
+
+	// A site whose health score stays below SiteHealthThreshold (0-100) for
+	// SiteHealthSustain gets a degraded incident. Zero disables them; scores are
+	// still computed while downtime monitoring is enabled.
+	SiteHealthThreshold int
+	SiteHealthSustain   time.Duration
+
+	// Organization exports are uploaded to ExportBucket and kept for ExportRetention,
+	// at most 7 days since backup links in a bundle are signed URLs. Download URLs
+	// are signed as ExportSignerServiceAccount. Each site's latest backup is looked
+	// up in BackupBucket under its public ID. Exports are disabled without a bucket.
+	ExportBucket               string
+	ExportRetention            time.Duration
+	ExportSignerServiceAccount string
+	BackupBucket               string
+
+	// TerraformStateManagement lets platform operators back up and restore the
+	// Terraform state in organizations' tfstate buckets. The API's identity
+	// needs object admin on those buckets.
+	TerraformStateManagement bool
+
+	// CustomerManagedKeys lets organizations encrypt their tfstate buckets and
+	// Cloud SQL data with their own Cloud KMS keys. The API's identity needs
+	// roles/cloudkms.viewer on the keys, which organizations grant.
+	CustomerManagedKeys bool
+
+	// Account avatars are uploaded to AvatarBucket and shown through URLs signed as
+	// ExportSignerServiceAccount. Avatar uploads are disabled without a bucket.
+	AvatarBucket string
+
+	// Email delivery: EmailProvider is "log" (development), "smtp", "sendgrid" or "ses".
+	// SES is used through its SMTP interface with SMTPUsername/SMTPPassword as SES SMTP credentials.
+	EmailProvider  string
+	EmailFrom      string
+	SMTPHost       string
+	SMTPPort       string
+	SMTPUsername   string
+	SMTPPassword   string
+	SendGridAPIKey string
+	SESRegion      string
+
 	// Organization defaults
 	GcpOrgID           string
 	GcpBillingAccount  string
 	GcpParent          string
 	RootOrganizationID int64
+
+	// Site VM controllers can authenticate with client certificates issued by
+	// the LibOps controller CA, on top of their service account tokens.
+	// ControllerMTLSMode is "off", "optional" (certificates are checked when
+	// presented) or "required" (controller calls must present one). The load
+	// balancer terminating TLS forwards the certificate in the Client-Cert
+	// header, which is only trusted when ClientCertHeaderTrusted is set.
+	ControllerCACert        string
+	ControllerCAKey         string
+	ControllerMTLSMode      string
+	ClientCertHeaderTrusted bool
+
+	// AuthzEngine decides organization, project and site access: "cedar" (the
+	// built-in policies) or "opa", which asks the Open Policy Agent data API at
+	// AuthzOPAURL, e.g. http://opa:8181/v1/data/libops/authz/allow, serving a
+	// deployment's own policy bundle. Requests are denied when it can't answer
+	// within AuthzOPATimeout.
+	AuthzEngine     string
+	AuthzOPAURL     string
+	AuthzOPATimeout time.Duration
+
+	// settings are the variables Load resolved, for the config check
+	settings []Setting
 }
 
 // Load loads configuration from environment variables and Vault secrets.
 // Priority: 1) Environment variables, 2) Vault secrets at /vault/secrets
 // Waits up to 120 seconds for required variables to appear in Vault.
 func Load() (*Config, error) {
-	loader := NewVaultLoader()
-
-	databasePasswordFile := os.Getenv("MARIADB_PASSWORD_FILE")
-	if databasePasswordFile == "" {
-		return nil, fmt.Errorf("MARIADB_PASSWORD_FILE is required")
+	cfg, err := load(NewVaultLoader())
+	if err != nil {
+		return nil, err
 	}
-	databasePassword, err := os.ReadFile(databasePasswordFile)
-	if err != nil || string(databasePassword) == "" {
-		return nil, fmt.Errorf("failed to read %s: %w", databasePasswordFile, err)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// load resolves the configuration without validating it
+func load(loader *VaultLoader) (*Config, error) {
+	loader.record("MARIADB_PASSWORD_FILE", os.Getenv("MARIADB_PASSWORD_FILE"), SourceEnv)
+	databaseURL, err := LoadDatabaseURL()
+	if err != nil {
+		return nil, err
 	}
 
 	oidcClientId, err := loader.LoadEnv("OIDC_CLIENT_ID", true)
@@ -101,7 +224,26 @@ func Load() (*Config, error) {
 		APIBaseURL:  baseUrl,
 		DashBaseUrl: dashBaseUrl,
 
-		DatabaseURL: fmt.Sprintf("libops:%s@tcp(mariadb:3306)/libops?parseTime=true", strings.TrimSpace(string(databasePassword))),
+		StatusPageDomain: loader.LoadEnvWithDefault("STATUS_PAGE_DOMAIN", "libops.site"),
+
+		DatabaseURL:        databaseURL,
+		MigrateOnStart:     loader.LoadEnvWithDefault("MIGRATE_ON_START", "true") == "true",
+		DatabaseReplicaURL: loader.LoadEnvWithDefault("DATABASE_REPLICA_URL", ""),
+
+		DatabaseReplicaMaxLag: parseDurationWithDefault(loader.LoadEnvWithDefault("DB_REPLICA_MAX_LAG", "10s"), 10*time.Second),
+
+		Region:          loader.LoadEnvWithDefault("API_REGION", ""),
+		RegionEndpoints: parseRegionEndpoints(loader.LoadEnvWithDefault("API_REGION_ENDPOINTS", "")),
+
+		DatabaseMaxOpenConns:    int(parseIntWithDefault(loader.LoadEnvWithDefault("DB_MAX_OPEN_CONNS", "25"), 25)),
+		DatabaseMaxIdleConns:    int(parseIntWithDefault(loader.LoadEnvWithDefault("DB_MAX_IDLE_CONNS", "25"), 25)),
+		DatabaseConnMaxLifetime: parseDurationWithDefault(loader.LoadEnvWithDefault("DB_CONN_MAX_LIFETIME", "5m"), 5*time.Minute),
+		DatabaseConnMaxIdleTime: parseDurationWithDefault(loader.LoadEnvWithDefault("DB_CONN_MAX_IDLE_TIME", "1m"), time.Minute),
+
+		CacheBackend: loader.LoadEnvWithDefault("CACHE_BACKEND", "none"),
+		CacheTTL:     parseDurationWithDefault(loader.LoadEnvWithDefault("CACHE_TTL", "30s"), 30*time.Second),
+		CacheSize:    int(parseIntWithDefault(loader.LoadEnvWithDefault("CACHE_SIZE", "10000"), 10000)),
+		RedisURL:     loader.LoadEnvWithDefault("REDIS_URL", ""),
 
 		GCPProjectID:  loader.LoadEnvWithDefault("GCP_PROJECT_ID", ""),
 		EventsTopicID: loader.LoadEnvWithDefault("EVENTS_TOPIC_ID", ""),
@@ -131,25 +273,214 @@ func Load() (*Config, error) {
 		StripeWebhookSecret: loader.LoadEnvWithDefault("STRIPE_WEBHOOK_SECRET", ""),
 		DisableBilling:      loader.LoadEnvWithDefault("DISABLE_BILLING", "false") == "true",
 
+		BillingPastDueGracePeriod:   parseDaysWithDefault(loader.LoadEnvWithDefault("BILLING_PAST_DUE_GRACE_DAYS", "14"), 14),
+		BillingSuspendedGracePeriod: parseDaysWithDefault(loader.LoadEnvWithDefault("BILLING_SUSPENDED_GRACE_DAYS", "30"), 30),
+
+		SiteDowntimeThreshold: parseDurationWithDefault(loader.LoadEnvWithDefault("SITE_DOWNTIME_THRESHOLD", "10m"), 10*time.Minute),
+		SiteProbeInterval:     parseDurationWithDefault(loader.LoadEnvWithDefault("SITE_PROBE_INTERVAL", "1m"), time.Minute),
+		SiteHealthThreshold:   int(parseIntWithDefault(loader.LoadEnvWithDefault("SITE_HEALTH_THRESHOLD", "50"), 50)),
+		SiteHealthSustain:     parseDurationWithDefault(loader.LoadEnvWithDefault("SITE_HEALTH_SUSTAIN", "15m"), 15*time.Minute),
+
+		ExportBucket:               loader.LoadEnvWithDefault("EXPORT_BUCKET", ""),
+		ExportRetention:            parseDaysWithDefault(loader.LoadEnvWithDefault("EXPORT_RETENTION_DAYS", "7"), 7),
+		ExportSignerServiceAccount: loader.LoadEnvWithDefault("EXPORT_SIGNER_SERVICE_ACCOUNT", ""),
+		BackupBucket:               loader.LoadEnvWithDefault("BACKUP_BUCKET", ""),
+		AvatarBucket:               loader.LoadEnvWithDefault("AVATAR_BUCKET", ""),
+
+		TerraformStateManagement: loader.LoadEnvWithDefault("TERRAFORM_STATE_MANAGEMENT", "false") == "true",
+		CustomerManagedKeys:      loader.LoadEnvWithDefault("CUSTOMER_MANAGED_KEYS", "false") == "true",
+
+		EmailProvider:  loader.LoadEnvWithDefault("EMAIL_PROVIDER", "log"),
+		EmailFrom:      loader.LoadEnvWithDefault("EMAIL_FROM", "libops <noreply@libops.io>"),
+		SMTPHost:       loader.LoadEnvWithDefault("SMTP_HOST", ""),
+		SMTPPort:       loader.LoadEnvWithDefault("SMTP_PORT", "587"),
+		SMTPUsername:   loader.LoadEnvWithDefault("SMTP_USERNAME", ""),
+		SMTPPassword:   loader.LoadEnvWithDefault("SMTP_PASSWORD", ""),
+		SendGridAPIKey: loader.LoadEnvWithDefault("SENDGRID_API_KEY", ""),
+		SESRegion:      loader.LoadEnvWithDefault("SES_REGION", ""),
+
 		// Organization defaults
 		GcpOrgID:           loader.LoadEnvWithDefault("LIBOPS_GCP_ORG_ID", ""),
 		GcpBillingAccount:  loader.LoadEnvWithDefault("LIBOPS_GCP_BILLING_ACCOUNT", ""),
 		GcpParent:          loader.LoadEnvWithDefault("LIBOPS_GCP_PARENT", ""),
 		RootOrganizationID: parseIntWithDefault(loader.LoadEnvWithDefault("LIBOPS_ROOT_ORG", "1"), 1),
-	}
 
-	if err := cfg.Validate(); err != nil {
-		return nil, err
+		ControllerCACert:        loader.LoadEnvWithDefault("CONTROLLER_CA_CERT", ""),
+		ControllerCAKey:         loader.LoadEnvWithDefault("CONTROLLER_CA_KEY", ""),
+		ControllerMTLSMode:      loader.LoadEnvWithDefault("CONTROLLER_MTLS_MODE", "off"),
+		ClientCertHeaderTrusted: loader.LoadEnvWithDefault("CLIENT_CERT_HEADER_TRUSTED", "false") == "true",
+
+		AuthzEngine:     loader.LoadEnvWithDefault("AUTHZ_ENGINE", "cedar"),
+		AuthzOPAURL:     loader.LoadEnvWithDefault("AUTHZ_OPA_URL", ""),
+		AuthzOPATimeout: parseDurationWithDefault(loader.LoadEnvWithDefault("AUTHZ_OPA_TIMEOUT", "2s"), 2*time.Second),
 	}
+	cfg.settings = loader.Settings()
 
 	return cfg, nil
 }
 
+// LoadDatabaseURL builds the primary database DSN from the password in
+// MARIADB_PASSWORD_FILE. It's all the migrate command needs of the config.
+func LoadDatabaseURL() (string, error) {
+	databasePasswordFile := os.Getenv("MARIADB_PASSWORD_FILE")
+	if databasePasswordFile == "" {
+		return "", fmt.Errorf("MARIADB_PASSWORD_FILE is required")
+	}
+	databasePassword, err := os.ReadFile(databasePasswordFile)
+	if err != nil || string(databasePassword) == "" {
+		return "", fmt.Errorf("failed to read %s: %w", databasePasswordFile, err)
+	}
+	return fmt.Sprintf("libops:%s@tcp(mariadb:3306)/libops?parseTime=true", strings.TrimSpace(string(databasePassword))), nil
+}
+
 // Validate checks that required configuration is present.
 func (cfg *Config) Validate() error {
 	if cfg.DatabaseURL == "" {
 		return fmt.Errorf("DATABASE_URL is required")
 	}
+	if cfg.Port != "" {
+		if err := checkPort("PORT", cfg.Port); err != nil {
+			return err
+		}
+	}
+	for _, setting := range []struct{ name, value string }{
+		{"API_BASE_URL", cfg.APIBaseURL},
+		{"DASH_BASE_URL", cfg.DashBaseUrl},
+		{"VAULT_ADDR", cfg.VaultAddr},
+		{"OIDC_REDIRECT_URL", cfg.OIDCRedirectURL},
+		{"GOOGLE_CALLBACK_URL", cfg.GoogleCallbackURL},
+		{"GITHUB_CALLBACK_URL", cfg.GitHubCallbackURL},
+	} {
+		if setting.value == "" {
+			continue
+		}
+		if err := checkURL(setting.name, setting.value); err != nil {
+			return err
+		}
+	}
+	if len(cfg.RegionEndpoints) > 0 {
+		if _, ok := cfg.RegionEndpoints[cfg.Region]; !ok {
+			return fmt.Errorf("API_REGION must be one of the regions in API_REGION_ENDPOINTS")
+		}
+		for _, region := range slices.Sorted(maps.Keys(cfg.RegionEndpoints)) {
+			if err := checkURL("API_REGION_ENDPOINTS["+region+"]", cfg.RegionEndpoints[region]); err != nil {
+				return err
+			}
+		}
+	}
+	if cfg.DatabaseReplicaMaxLag < 0 {
+		return fmt.Errorf("DB_REPLICA_MAX_LAG must not be negative")
+	}
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		if err := checkURL("ALLOWED_ORIGINS", origin); err != nil {
+			return err
+		}
+	}
+	if cfg.DatabaseMaxOpenConns < 0 || cfg.DatabaseMaxIdleConns < 0 {
+		return fmt.Errorf("DB_MAX_OPEN_CONNS and DB_MAX_IDLE_CONNS must not be negative")
+	}
+	if cfg.DatabaseMaxOpenConns > 0 && cfg.DatabaseMaxIdleConns > cfg.DatabaseMaxOpenConns {
+		return fmt.Errorf("DB_MAX_IDLE_CONNS (%d) must not exceed DB_MAX_OPEN_CONNS (%d)", cfg.DatabaseMaxIdleConns, cfg.DatabaseMaxOpenConns)
+	}
+	switch cfg.CacheBackend {
+	case "", "none", "memory":
+	case "redis":
+		if cfg.RedisURL == "" {
+			return fmt.Errorf("REDIS_URL is required when CACHE_BACKEND=redis")
+		}
+		if u, err := url.Parse(cfg.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") || u.Host == "" {
+			return fmt.Errorf("REDIS_URL must be a redis:// or rediss:// URL")
+		}
+	default:
+		return fmt.Errorf("unsupported CACHE_BACKEND %q (expected none, memory or redis)", cfg.CacheBackend)
+	}
+	if cfg.BillingPastDueGracePeriod < 0 || cfg.BillingSuspendedGracePeriod < 0 {
+		return fmt.Errorf("BILLING_PAST_DUE_GRACE_DAYS and BILLING_SUSPENDED_GRACE_DAYS must not be negative")
+	}
+	if cfg.SiteDowntimeThreshold < 0 {
+		return fmt.Errorf("SITE_DOWNTIME_THRESHOLD must not be negative")
+	}
+	if cfg.SiteProbeInterval < 0 {
+		return fmt.Errorf("SITE_PROBE_INTERVAL must not be negative")
+	}
+	if cfg.SiteHealthThreshold < 0 || cfg.SiteHealthThreshold > 100 {
+		return fmt.Errorf("SITE_HEALTH_THRESHOLD must be between 0 and 100")
+	}
+	if cfg.SiteHealthSustain < 0 {
+		return fmt.Errorf("SITE_HEALTH_SUSTAIN must not be negative")
+	}
+	if cfg.ExportBucket != "" {
+		if cfg.ExportSignerServiceAccount == "" {
+			return fmt.Errorf("EXPORT_SIGNER_SERVICE_ACCOUNT is required when EXPORT_BUCKET is set")
+		}
+		if cfg.ExportRetention <= 0 || cfg.ExportRetention > 7*24*time.Hour {
+			return fmt.Errorf("EXPORT_RETENTION_DAYS must be between 1 and 7")
+		}
+	}
+	if cfg.AvatarBucket != "" && cfg.ExportSignerServiceAccount == "" {
+		return fmt.Errorf("EXPORT_SIGNER_SERVICE_ACCOUNT is required when AVATAR_BUCKET is set")
+	}
+	switch cfg.EmailProvider {
+	case "", "log":
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return fmt.Errorf("SMTP_HOST is required when EMAIL_PROVIDER=smtp")
+		}
+		if err := checkPort("SMTP_PORT", cfg.SMTPPort); err != nil {
+			return err
+		}
+	case "sendgrid":
+		if cfg.SendGridAPIKey == "" {
+			return fmt.Errorf("SENDGRID_API_KEY is required when EMAIL_PROVIDER=sendgrid")
+		}
+	case "ses":
+		if cfg.SESRegion == "" || cfg.SMTPUsername == "" || cfg.SMTPPassword == "" {
+			return fmt.Errorf("SES_REGION, SMTP_USERNAME and SMTP_PASSWORD are required when EMAIL_PROVIDER=ses")
+		}
+	default:
+		return fmt.Errorf("unsupported EMAIL_PROVIDER %q (expected log, smtp, sendgrid or ses)", cfg.EmailProvider)
+	}
+	switch cfg.ControllerMTLSMode {
+	case "", "off":
+	case "optional", "required":
+		if cfg.ControllerCACert == "" || cfg.ControllerCAKey == "" {
+			return fmt.Errorf("CONTROLLER_CA_CERT and CONTROLLER_CA_KEY are required when CONTROLLER_MTLS_MODE=%s", cfg.ControllerMTLSMode)
+		}
+	default:
+		return fmt.Errorf("unsupported CONTROLLER_MTLS_MODE %q (expected off, optional or required)", cfg.ControllerMTLSMode)
+	}
+	switch cfg.AuthzEngine {
+	case "", "cedar":
+	case "opa":
+		if cfg.AuthzOPAURL == "" {
+			return fmt.Errorf("AUTHZ_OPA_URL is required when AUTHZ_ENGINE=opa")
+		}
+		if err := checkURL("AUTHZ_OPA_URL", cfg.AuthzOPAURL); err != nil {
+			return err
+		}
+		if cfg.AuthzOPATimeout <= 0 {
+			return fmt.Errorf("AUTHZ_OPA_TIMEOUT must be positive")
+		}
+	default:
+		return fmt.Errorf("unsupported AUTHZ_ENGINE %q (expected cedar or opa)", cfg.AuthzEngine)
+	}
+	if !cfg.DisableBilling {
+		if !strings.HasPrefix(cfg.StripeSecretKey, "sk_") && !strings.HasPrefix(cfg.StripeSecretKey, "rk_") {
+			return fmt.Errorf("STRIPE_SECRET_KEY must be a Stripe secret or restricted key unless DISABLE_BILLING=true")
+		}
+		if !strings.HasPrefix(cfg.StripeWebhookSecret, "whsec_") {
+			return fmt.Errorf("STRIPE_WEBHOOK_SECRET must be a Stripe webhook signing secret unless DISABLE_BILLING=true")
+		}
+	}
+	if (cfg.GoogleClientID == "") != (cfg.GoogleClientSecret == "") {
+		return fmt.Errorf("GOOGLE_CLIENT_ID and GOOGLE_CLIENT_SECRET must be set together")
+	}
+	if (cfg.GitHubClientID == "") != (cfg.GitHubClientSecret == "") {
+		return fmt.Errorf("GITHUB_CLIENT_ID and GITHUB_CLIENT_SECRET must be set together")
+	}
 	if cfg.OIDCClientSecret == "" {
 		return fmt.Errorf("OIDC_CLIENT_SECRET is required")
 	}
@@ -159,6 +490,24 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// checkURL checks that a setting is an absolute http or https URL
+func checkURL(name, value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s must be an http or https URL, got %q", name, value)
+	}
+	return nil
+}
+
+// checkPort checks that a setting is a TCP port number
+func checkPort(name, value string) error {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("%s must be a port between 1 and 65535, got %q", name, value)
+	}
+	return nil
+}
+
 // getEnv retrieves an environment variable with a fallback default value.
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -195,6 +544,22 @@ func parseAllowedOrigins(originsEnv string) []string {
 	}
 }
 
+// parseRegionEndpoints parses API_REGION_ENDPOINTS, a comma-separated list of
+// region=URL pairs (e.g. "us-central1=https://api.libops.io,europe-west1=https://eu.api.libops.io").
+// Entries without a region or URL are skipped.
+func parseRegionEndpoints(endpointsEnv string) map[string]string {
+	endpoints := map[string]string{}
+	for _, entry := range strings.Split(endpointsEnv, ",") {
+		region, endpoint, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		region, endpoint = strings.TrimSpace(region), strings.TrimSpace(endpoint)
+		if !ok || region == "" || endpoint == "" {
+			continue
+		}
+		endpoints[region] = strings.TrimSuffix(endpoint, "/")
+	}
+	return endpoints
+}
+
 // parseIntWithDefault parses a string to int64, returning defaultValue on error.
 func parseIntWithDefault(s string, defaultValue int64) int64 {
 	var result int64
@@ -204,3 +569,17 @@ func parseIntWithDefault(s string, defaultValue int64) int64 {
 	}
 	return result
 }
+
+// parseDurationWithDefault parses a Go duration string (e.g. "5m"), returning defaultValue on error.
+func parseDurationWithDefault(s string, defaultValue time.Duration) time.Duration {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return defaultValue
+	}
+	return d
+}
+
+// parseDaysWithDefault parses a whole number of days, returning defaultDays on error.
+func parseDaysWithDefault(s string, defaultDays int64) time.Duration {
+	return time.Duration(parseIntWithDefault(s, defaultDays)) * 24 * time.Hour
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"maps"
 	"os"
 	"path/filepath"
 	"testing"
@@ -17,6 +18,9 @@ func TestLoad_OidcClientFromVault(t *testing.T) {
 		t.Errorf("failed to write MARIADB_PASSWORD_FILE: %v", err)
 	}
 
+	_ = os.Setenv("DISABLE_BILLING", "true")
+	defer func() { _ = os.Unsetenv("DISABLE_BILLING") }()
+
 	// Set up vault secrets directory
 	tmpDir := t.TempDir()
 	_ = os.Setenv("VAULT_SECRETS_DIR", tmpDir)
@@ -73,9 +77,102 @@ func TestValidate(t *testing.T) {
 				OIDCClientSecret: "test-secret",
 				VaultToken:       "test-token",
 				Port:             "8080",
+				DisableBilling:   true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid config with billing",
+			config: &Config{
+				DatabaseURL:         "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:    "test-secret",
+				VaultToken:          "test-token",
+				Port:                "8080",
+				APIBaseURL:          "https://api.libops.io",
+				VaultAddr:           "http://vault:8200",
+				AllowedOrigins:      []string{"https://dash.libops.io", "http://localhost:8080"},
+				StripeSecretKey:     "sk_test_123",
+				StripeWebhookSecret: "whsec_123",
 			},
 			wantErr: false,
 		},
+		{
+			name: "billing without a Stripe key",
+			config: &Config{
+				DatabaseURL:         "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:    "test-secret",
+				VaultToken:          "test-token",
+				StripeWebhookSecret: "whsec_123",
+			},
+			wantErr: true,
+		},
+		{
+			name: "billing with a publishable key",
+			config: &Config{
+				DatabaseURL:         "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:    "test-secret",
+				VaultToken:          "test-token",
+				StripeSecretKey:     "pk_test_123",
+				StripeWebhookSecret: "whsec_123",
+			},
+			wantErr: true,
+		},
+		{
+			name: "port out of range",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				Port:             "80800",
+			},
+			wantErr: true,
+		},
+		{
+			name: "base URL without a scheme",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				APIBaseURL:       "api.libops.io",
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed allowed origin",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				AllowedOrigins:   []string{"dash.libops.io"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "google client ID without a secret",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				GoogleClientID:   "google-client",
+			},
+			wantErr: true,
+		},
+		{
+			name: "redis URL with the wrong scheme",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				CacheBackend:     "redis",
+				RedisURL:         "http://redis:6379",
+			},
+			wantErr: true,
+		},
 		{
 			name: "missing database URL",
 			config: &Config{
@@ -103,6 +200,171 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "idle connections exceed open connections",
+			config: &Config{
+				DatabaseURL:          "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:     "test-secret",
+				VaultToken:           "test-token",
+				DatabaseMaxOpenConns: 10,
+				DatabaseMaxIdleConns: 20,
+			},
+			wantErr: true,
+		},
+		{
+			name: "redis cache without url",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				CacheBackend:     "redis",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown cache backend",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				CacheBackend:     "memcached",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative dunning grace period",
+			config: &Config{
+				DatabaseURL:               "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:          "test-secret",
+				VaultToken:                "test-token",
+				BillingPastDueGracePeriod: -24 * time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "export bucket without signer",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				ExportBucket:     "libops-exports",
+				ExportRetention:  7 * 24 * time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "export retention longer than signed URLs last",
+			config: &Config{
+				DatabaseURL:                "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:           "test-secret",
+				VaultToken:                 "test-token",
+				ExportBucket:               "libops-exports",
+				ExportSignerServiceAccount: "exports@libops.iam.gserviceaccount.com",
+				ExportRetention:            14 * 24 * time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "smtp email provider without host",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				EmailProvider:    "smtp",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown email provider",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				EmailProvider:    "mailgun",
+			},
+			wantErr: true,
+		},
+		{
+			name: "controller mtls without a CA",
+			config: &Config{
+				DatabaseURL:        "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:   "test-secret",
+				VaultToken:         "test-token",
+				ControllerMTLSMode: "required",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown controller mtls mode",
+			config: &Config{
+				DatabaseURL:        "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret:   "test-secret",
+				VaultToken:         "test-token",
+				ControllerMTLSMode: "strict",
+			},
+			wantErr: true,
+		},
+		{
+			name: "opa authorization",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				AuthzEngine:      "opa",
+				AuthzOPAURL:      "http://opa:8181/v1/data/libops/authz/allow",
+				AuthzOPATimeout:  2 * time.Second,
+			},
+			wantErr: false,
+		},
+		{
+			name: "opa authorization without a URL",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				AuthzEngine:      "opa",
+				AuthzOPATimeout:  2 * time.Second,
+			},
+			wantErr: true,
+		},
+		{
+			name: "multi-region",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				Region:           "us-central1",
+				RegionEndpoints:  map[string]string{"us-central1": "https://api.libops.io", "europe-west3": "https://eu.api.libops.io"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "region without an endpoint",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				Region:           "asia-east1",
+				RegionEndpoints:  map[string]string{"us-central1": "https://api.libops.io", "europe-west3": "https://eu.api.libops.io"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "region endpoint without a scheme",
+			config: &Config{
+				DatabaseURL:      "user:pass@tcp(localhost:3306)/dbname",
+				OIDCClientSecret: "test-secret",
+				VaultToken:       "test-token",
+				DisableBilling:   true,
+				Region:           "us-central1",
+				RegionEndpoints:  map[string]string{"us-central1": "https://api.libops.io", "europe-west3": "eu.api.libops.io"},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -154,3 +416,38 @@ func TestGetEnv(t *testing.T) {
 		})
 	}
 }
+
+// TestParseDurationWithDefault tests duration parsing for pool tuning variables.
+func TestParseDurationWithDefault(t *testing.T) {
+	if got := parseDurationWithDefault("90s", time.Minute); got != 90*time.Second {
+		t.Errorf("parseDurationWithDefault(90s) = %v, want 90s", got)
+	}
+	if got := parseDurationWithDefault("not-a-duration", time.Minute); got != time.Minute {
+		t.Errorf("parseDurationWithDefault(invalid) = %v, want 1m", got)
+	}
+}
+
+// TestParseDaysWithDefault tests grace period parsing for the dunning lifecycle.
+func TestParseDaysWithDefault(t *testing.T) {
+	if got := parseDaysWithDefault("3", 14); got != 72*time.Hour {
+		t.Errorf("parseDaysWithDefault(3) = %v, want 72h", got)
+	}
+	if got := parseDaysWithDefault("soon", 14); got != 14*24*time.Hour {
+		t.Errorf("parseDaysWithDefault(invalid) = %v, want 336h", got)
+	}
+}
+
+// TestParseRegionEndpoints tests parsing of API_REGION_ENDPOINTS.
+func TestParseRegionEndpoints(t *testing.T) {
+	got := parseRegionEndpoints(" us-central1=https://api.libops.io/ , europe-west3=https://eu.api.libops.io,broken,=https://x.io")
+	want := map[string]string{
+		"us-central1":  "https://api.libops.io",
+		"europe-west3": "https://eu.api.libops.io",
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("parseRegionEndpoints() = %v, want %v", got, want)
+	}
+	if got := parseRegionEndpoints(""); len(got) != 0 {
+		t.Errorf("parseRegionEndpoints(empty) = %v, want none", got)
+	}
+}
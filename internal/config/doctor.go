@@ -0,0 +1,203 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// stripeAPIURL is where the Stripe probe sends its request
+var stripeAPIURL = "https://api.stripe.com"
+
+// probeTimeout bounds each connectivity probe
+const probeTimeout = 5 * time.Second
+
+// doctorSecretTimeout is how long the config check waits for required
+// secrets; there's no point waiting as long as a starting server does
+const doctorSecretTimeout = 5 * time.Second
+
+// secretSuffixes mark variables whose values are never printed
+var secretSuffixes = []string{"_SECRET", "_TOKEN", "_PASSWORD", "_KEY"}
+
+// dsnPassword matches the password in a user:password@tcp(host) DSN
+var dsnPassword = regexp.MustCompile(`^([^:@/]+):[^@]*@`)
+
+// ProbeResult is the outcome of checking a dependency with the configured
+// address and credentials
+type ProbeResult struct {
+	Name   string
+	Detail string
+	Err    error
+	// Misconfigured is set when the dependency answered and rejected the
+	// configuration, as opposed to not answering at all
+	Misconfigured bool
+}
+
+// Settings returns the variables Load resolved and where each came from, with
+// secrets redacted
+func (cfg *Config) Settings() []Setting {
+	settings := make([]Setting, len(cfg.settings))
+	for i, setting := range cfg.settings {
+		settings[i] = redact(setting)
+	}
+	return settings
+}
+
+// redact hides a setting's value when it's a secret or holds credentials
+func redact(setting Setting) Setting {
+	if setting.Value == "" {
+		return setting
+	}
+	for _, suffix := range secretSuffixes {
+		if strings.HasSuffix(setting.Key, suffix) {
+			setting.Value = "<redacted>"
+			return setting
+		}
+	}
+	if u, err := url.Parse(setting.Value); err == nil && u.User != nil {
+		setting.Value = u.Redacted()
+	} else if dsnPassword.MatchString(setting.Value) {
+		setting.Value = dsnPassword.ReplaceAllString(setting.Value, "$1:xxxxx@")
+	}
+	return setting
+}
+
+// Probe checks that Vault accepts the configured token and, unless billing is
+// disabled, that Stripe accepts the configured key
+func (cfg *Config) Probe(ctx context.Context) []ProbeResult {
+	client := &http.Client{Timeout: probeTimeout}
+	results := []ProbeResult{probeVault(ctx, client, cfg.VaultAddr, cfg.VaultToken)}
+	if !cfg.DisableBilling {
+		results = append(results, probeStripe(ctx, client, cfg.StripeSecretKey))
+	}
+	return results
+}
+
+// Preflight runs the probes before the server starts. A rejected credential
+// stops it, since it won't fix itself; an unreachable dependency only warns,
+// since it may just be starting too.
+func (cfg *Config) Preflight(ctx context.Context) error {
+	var errs []error
+	for _, result := range cfg.Probe(ctx) {
+		switch {
+		case result.Err == nil:
+			slog.Info("Config probe passed", "probe", result.Name, "detail", result.Detail)
+		case result.Misconfigured:
+			slog.Error("Config probe failed", "probe", result.Name, "err", result.Err)
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
+		default:
+			slog.Warn("Config probe could not reach its dependency", "probe", result.Name, "err", result.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func probeVault(ctx context.Context, client *http.Client, addr, token string) ProbeResult {
+	result := ProbeResult{Name: "vault", Detail: addr}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(addr, "/")+"/v1/auth/token/lookup-self", nil)
+	if err != nil {
+		result.Err, result.Misconfigured = err, true
+		return result
+	}
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Err = fmt.Errorf("unreachable: %w", err)
+		return result
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		result.Detail = addr + ", token accepted"
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnauthorized:
+		result.Err, result.Misconfigured = errors.New("VAULT_TOKEN was rejected"), true
+	case resp.StatusCode == http.StatusServiceUnavailable:
+		result.Err = errors.New("vault is sealed or not initialized")
+	default:
+		result.Err = fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return result
+}
+
+func probeStripe(ctx context.Context, client *http.Client, key string) ProbeResult {
+	result := ProbeResult{Name: "stripe", Detail: stripeAPIURL}
+	// Prices are what the API reads first, so a restricted key must allow them
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stripeAPIURL+"/v1/prices?limit=1", nil)
+	if err != nil {
+		result.Err, result.Misconfigured = err, true
+		return result
+	}
+	req.SetBasicAuth(key, "")
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Err = fmt.Errorf("unreachable: %w", err)
+		return result
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		mode := "live"
+		if strings.Contains(key, "_test_") {
+			mode = "test"
+		}
+		result.Detail = stripeAPIURL + ", " + mode + " key accepted"
+	case http.StatusUnauthorized:
+		result.Err, result.Misconfigured = errors.New("STRIPE_SECRET_KEY was rejected"), true
+	case http.StatusForbidden:
+		result.Err, result.Misconfigured = errors.New("STRIPE_SECRET_KEY can't read prices"), true
+	default:
+		result.Err = fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return result
+}
+
+// Doctor resolves the configuration the way the server would, prints every
+// setting with its source, validates it and runs the probes. It returns an
+// error when the server wouldn't start or would start misconfigured.
+func Doctor(ctx context.Context, w io.Writer) error {
+	loader := NewVaultLoader()
+	loader.timeout = doctorSecretTimeout
+
+	cfg, loadErr := load(loader)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "SETTING\tVALUE\tSOURCE")
+	for _, setting := range loader.Settings() {
+		setting = redact(setting)
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", setting.Key, setting.Value, setting.Source)
+	}
+	_ = tw.Flush()
+	_, _ = fmt.Fprintln(w)
+
+	if loadErr != nil {
+		_, _ = fmt.Fprintf(w, "✗ load: %v\n", loadErr)
+		return loadErr
+	}
+
+	var errs []error
+	if err := cfg.Validate(); err != nil {
+		_, _ = fmt.Fprintf(w, "✗ validation: %v\n", err)
+		errs = append(errs, err)
+	} else {
+		_, _ = fmt.Fprintln(w, "✓ validation")
+	}
+	for _, result := range cfg.Probe(ctx) {
+		if result.Err != nil {
+			_, _ = fmt.Fprintf(w, "✗ %s: %v\n", result.Name, result.Err)
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "✓ %s: %s\n", result.Name, result.Detail)
+	}
+	return errors.Join(errs...)
+}
@@ -18,6 +18,8 @@ func TestReloader_ConfigUpdate(t *testing.T) {
 		t.Fatalf("failed to write MARIADB_PASSWORD_FILE: %v", err)
 	}
 	defer func() { _ = os.Remove(dbPath) }()
+	_ = os.Setenv("DISABLE_BILLING", "true")
+	defer func() { _ = os.Unsetenv("DISABLE_BILLING") }()
 
 	// Set up vault secrets directory
 	tmpDir := t.TempDir()
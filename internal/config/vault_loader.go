@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 )
@@ -31,11 +32,28 @@ type realTime struct{}
 func (realTime) Now() time.Time                         { return time.Now() }
 func (realTime) After(d time.Duration) <-chan time.Time { return time.After(d) }
 
+// Sources a setting can be resolved from
+const (
+	SourceEnv     = "env"
+	SourceVault   = "vault"
+	SourceDefault = "default"
+)
+
+// Setting is a configuration variable as it was resolved
+type Setting struct {
+	Key    string
+	Value  string
+	Source string
+}
+
 // VaultLoader loads environment variables from Vault secret mount
 type VaultLoader struct {
 	secretsDir string
 	timeout    time.Duration
 	timeSource timeSource
+
+	// settings records what every loaded variable resolved to, in load order
+	settings []Setting
 }
 
 // NewVaultLoader creates a new vault loader
@@ -59,6 +77,7 @@ func (v *VaultLoader) LoadEnv(key string, required bool) (string, error) {
 	// Check if already set in environment
 	if value := os.Getenv(key); value != "" {
 		slog.Debug("Using environment variable", "key", key)
+		v.record(key, value, SourceEnv)
 		return value, nil
 	}
 
@@ -66,6 +85,7 @@ func (v *VaultLoader) LoadEnv(key string, required bool) (string, error) {
 	if !required {
 		if value, err := v.readSecretFile(secretPath); err == nil && value != "" {
 			slog.Debug("Loaded optional variable from Vault", "key", key)
+			v.record(key, value, SourceVault)
 			return value, nil
 		}
 		slog.Debug("Optional variable not found", "key", key)
@@ -74,7 +94,29 @@ func (v *VaultLoader) LoadEnv(key string, required bool) (string, error) {
 
 	// Required variable - wait with timeout
 	slog.Info("Waiting for required variable", "key", key, "timeout", v.timeout)
-	return v.waitForSecret(key, secretPath)
+	value, err := v.waitForSecret(key, secretPath)
+	if err != nil {
+		return "", err
+	}
+	v.record(key, value, SourceVault)
+	return value, nil
+}
+
+// record keeps the latest value a variable resolved to
+func (v *VaultLoader) record(key, value, source string) {
+	for i := range v.settings {
+		if v.settings[i].Key == key {
+			v.settings[i] = Setting{Key: key, Value: value, Source: source}
+			return
+		}
+	}
+	v.settings = append(v.settings, Setting{Key: key, Value: value, Source: source})
+}
+
+// Settings returns every variable loaded so far with where it came from.
+// Values are not redacted.
+func (v *VaultLoader) Settings() []Setting {
+	return slices.Clone(v.settings)
 }
 
 // readSecretFile reads a secret from the filesystem
@@ -133,6 +175,7 @@ func (v *VaultLoader) MustLoadEnv(key string) string {
 func (v *VaultLoader) LoadEnvWithDefault(key, defaultValue string) string {
 	value, err := v.LoadEnv(key, false)
 	if err != nil || value == "" {
+		v.record(key, defaultValue, SourceDefault)
 		return defaultValue
 	}
 	return value
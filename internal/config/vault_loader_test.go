@@ -211,3 +211,33 @@ func TestVaultLoader_MustLoadEnv_Panic(t *testing.T) {
 		loader.MustLoadEnv("NONEXISTENT_REQUIRED_VAR")
 	})
 }
+
+func TestVaultLoader_Settings(t *testing.T) {
+	os.Setenv("TEST_VAR_SOURCE_ENV", "from-env")
+	defer os.Unsetenv("TEST_VAR_SOURCE_ENV")
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "TEST_VAR_SOURCE_VAULT"), []byte("from-vault\n"), 0600); err != nil {
+		t.Fatalf("failed to write secret: %v", err)
+	}
+	loader := &VaultLoader{secretsDir: tmpDir, timeout: time.Second, timeSource: realTime{}}
+
+	loader.LoadEnvWithDefault("TEST_VAR_SOURCE_ENV", "default")
+	loader.LoadEnvWithDefault("TEST_VAR_SOURCE_VAULT", "default")
+	loader.LoadEnvWithDefault("TEST_VAR_SOURCE_DEFAULT", "default")
+	loader.LoadEnvWithDefault("TEST_VAR_SOURCE_ENV", "default")
+
+	want := []Setting{
+		{Key: "TEST_VAR_SOURCE_ENV", Value: "from-env", Source: SourceEnv},
+		{Key: "TEST_VAR_SOURCE_VAULT", Value: "from-vault", Source: SourceVault},
+		{Key: "TEST_VAR_SOURCE_DEFAULT", Value: "default", Source: SourceDefault},
+	}
+	got := loader.Settings()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d settings, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("setting %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,229 @@
+// Package controllerca is the LibOps certificate authority for site VM
+// controllers. It signs a short-lived client certificate for each site from a
+// CSR its controller generates, so the private key never leaves the VM, and
+// verifies the certificates controllers present to the API.
+package controllerca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CertificateLifetime is how long a controller's client certificate is valid
+	CertificateLifetime = 30 * 24 * time.Hour
+	// clockSkew backdates certificates so a VM clock slightly behind accepts them
+	clockSkew = 5 * time.Minute
+	// minRSABits is the smallest RSA key a certificate is issued for
+	minRSABits = 2048
+
+	// siteURIPrefix starts the URI SAN naming a certificate's site
+	siteURIPrefix = "spiffe://libops.io/site/"
+)
+
+// Issued is a client certificate signed for a site's controller
+type Issued struct {
+	CertificatePEM string
+	SerialNumber   string // Hex
+	Fingerprint    string // Hex SHA-256 of the DER certificate
+	NotBefore      time.Time
+	NotAfter       time.Time
+}
+
+// RenewAfter is when the controller should replace the certificate: after two
+// thirds of its lifetime, leaving a third to retry a failed renewal
+func (i *Issued) RenewAfter() time.Time {
+	return i.NotBefore.Add(i.NotAfter.Sub(i.NotBefore) * 2 / 3)
+}
+
+// Identity is what a verified client certificate proves
+type Identity struct {
+	SitePublicID string
+	SerialNumber string // Hex
+}
+
+// CA signs and verifies controller client certificates
+type CA struct {
+	cert    *x509.Certificate
+	certPEM string
+	key     crypto.Signer
+	roots   *x509.CertPool
+	now     func() time.Time
+}
+
+// New loads the CA from its PEM certificate and private key
+func New(certPEM, keyPEM string) (*CA, error) {
+	certBlock, _ := pem.Decode([]byte(certPEM))
+	if certBlock == nil || certBlock.Type != "CERTIFICATE" {
+		return nil, errors.New("controller CA certificate is not a PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid controller CA certificate: %w", err)
+	}
+	if !cert.IsCA {
+		return nil, errors.New("controller CA certificate is not a CA")
+	}
+
+	keyBlock, _ := pem.Decode([]byte(keyPEM))
+	if keyBlock == nil {
+		return nil, errors.New("controller CA key is not PEM")
+	}
+	key, err := parsePrivateKey(keyBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !publicKeysEqual(key.Public(), cert.PublicKey) {
+		return nil, errors.New("controller CA key doesn't match its certificate")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	return &CA{
+		cert:    cert,
+		certPEM: strings.TrimSpace(certPEM) + "\n",
+		key:     key,
+		roots:   roots,
+		now:     time.Now,
+	}, nil
+}
+
+// CertificatePEM is the CA certificate controllers verify the chain with
+func (ca *CA) CertificatePEM() string {
+	return ca.certPEM
+}
+
+// Issue signs a client certificate for a site's controller from its PEM CSR.
+// The certificate names the site; whatever the CSR asks for is ignored apart
+// from its public key.
+func (ca *CA) Issue(sitePublicID string, csrPEM string) (*Issued, error) {
+	if _, err := uuid.Parse(sitePublicID); err != nil {
+		return nil, fmt.Errorf("invalid site ID: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("csr is not a PEM certificate request")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid csr: %w", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid csr signature: %w", err)
+	}
+	if err := checkPublicKey(csr.PublicKey); err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	siteURI, _ := url.Parse(siteURIPrefix + sitePublicID)
+	now := ca.now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: sitePublicID, Organization: []string{"libops site controller"}},
+		URIs:         []*url.URL{siteURI},
+		NotBefore:    now.Add(-clockSkew),
+		NotAfter:     now.Add(CertificateLifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate: %w", err)
+	}
+
+	fingerprint := sha256.Sum256(der)
+	return &Issued{
+		CertificatePEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		SerialNumber:   serial.Text(16),
+		Fingerprint:    hex.EncodeToString(fingerprint[:]),
+		NotBefore:      template.NotBefore,
+		NotAfter:       template.NotAfter,
+	}, nil
+}
+
+// Verify checks that a client certificate was issued by the CA for client
+// authentication, and returns the site it was issued to
+func (ca *CA) Verify(cert *x509.Certificate) (*Identity, error) {
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:       ca.roots,
+		CurrentTime: ca.now(),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("client certificate not issued by the controller CA: %w", err)
+	}
+
+	for _, u := range cert.URIs {
+		if sitePublicID, ok := strings.CutPrefix(u.String(), siteURIPrefix); ok {
+			if _, err := uuid.Parse(sitePublicID); err == nil {
+				return &Identity{SitePublicID: sitePublicID, SerialNumber: cert.SerialNumber.Text(16)}, nil
+			}
+		}
+	}
+	return nil, errors.New("client certificate doesn't name a site")
+}
+
+// checkPublicKey only accepts keys strong enough for a client certificate
+func checkPublicKey(key any) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().BitSize < 256 {
+			return errors.New("csr key must be on P-256 or a larger curve")
+		}
+	case ed25519.PublicKey:
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return fmt.Errorf("csr RSA key must be at least %d bits", minRSABits)
+		}
+	default:
+		return fmt.Errorf("unsupported csr key type %T", key)
+	}
+	return nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	var key any
+	var err error
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid controller CA key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported controller CA key type %T", key)
+	}
+	return signer, nil
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	equal, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && equal.Equal(b)
+}
@@ -0,0 +1,114 @@
+package controllerca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCA creates a self-signed CA for tests.
+func newTestCA(t *testing.T) *CA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "libops controller CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	ca, err := New(
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	)
+	require.NoError(t, err)
+	return ca
+}
+
+// newCSR creates a PEM CSR for a new P-256 key.
+func newCSR(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "someone else"},
+	}, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func parseCertificate(t *testing.T, certPEM string) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode([]byte(certPEM))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+// TestIssueVerify tests that an issued certificate names the site, whatever
+// the CSR asks for, and verifies until it expires.
+func TestIssueVerify(t *testing.T) {
+	ca := newTestCA(t)
+	siteID := uuid.NewString()
+
+	issued, err := ca.Issue(siteID, newCSR(t))
+	require.NoError(t, err)
+	assert.Equal(t, CertificateLifetime+clockSkew, issued.NotAfter.Sub(issued.NotBefore))
+	assert.True(t, issued.RenewAfter().After(issued.NotBefore) && issued.RenewAfter().Before(issued.NotAfter))
+
+	cert := parseCertificate(t, issued.CertificatePEM)
+	assert.Equal(t, siteID, cert.Subject.CommonName)
+
+	identity, err := ca.Verify(cert)
+	require.NoError(t, err)
+	assert.Equal(t, siteID, identity.SitePublicID)
+	assert.Equal(t, issued.SerialNumber, identity.SerialNumber)
+
+	ca.now = func() time.Time { return issued.NotAfter.Add(time.Minute) }
+	_, err = ca.Verify(cert)
+	assert.Error(t, err, "expired certificates don't verify")
+}
+
+// TestVerifyOtherCA tests that certificates from another CA are rejected.
+func TestVerifyOtherCA(t *testing.T) {
+	issued, err := newTestCA(t).Issue(uuid.NewString(), newCSR(t))
+	require.NoError(t, err)
+
+	_, err = newTestCA(t).Verify(parseCertificate(t, issued.CertificatePEM))
+	assert.Error(t, err)
+}
+
+// TestIssueInvalid tests that malformed CSRs and site IDs are rejected.
+func TestIssueInvalid(t *testing.T) {
+	ca := newTestCA(t)
+
+	_, err := ca.Issue("not-a-uuid", newCSR(t))
+	assert.Error(t, err)
+	_, err = ca.Issue(uuid.NewString(), "not a csr")
+	assert.Error(t, err)
+
+	csr := newCSR(t)
+	block, _ := pem.Decode([]byte(csr))
+	block.Bytes[len(block.Bytes)-1] ^= 0xff
+	_, err = ca.Issue(uuid.NewString(), string(pem.EncodeToMemory(block)))
+	assert.Error(t, err, "a CSR with a broken signature doesn't prove possession of the key")
+}
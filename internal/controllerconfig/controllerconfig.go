@@ -0,0 +1,186 @@
+// Package controllerconfig holds the documents site VM controllers tune
+// themselves with on check-in: one for the whole fleet, and optional per-site
+// overrides operators use to try a change on a few sites first.
+package controllerconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// Bounds on the tunables, so a typo can't stall or flood a fleet
+const (
+	minRateLimit                = 1
+	maxRateLimit                = 1000
+	minReconcileIntervalMinutes = 5
+	maxReconcileIntervalMinutes = 24 * 60
+	minCheckInIntervalSeconds   = 15
+	maxCheckInIntervalSeconds   = 60 * 60
+	maxFeatureFlags             = 50
+)
+
+// flagName matches feature flag names. Flags aren't checked against a list, so
+// a new controller flag can be rolled out before the API knows about it.
+var flagName = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// Validate checks that a document's tunables are within bounds.
+func Validate(c *libopsv1.ControllerConfig) error {
+	if c == nil {
+		return nil
+	}
+	checks := []struct {
+		name     string
+		value    *int32
+		min, max int32
+	}{
+		{"rate_limit_rps", c.RateLimitRps, minRateLimit, maxRateLimit},
+		{"rate_limit_burst", c.RateLimitBurst, minRateLimit, maxRateLimit},
+		{"reconcile_interval_minutes", c.ReconcileIntervalMinutes, minReconcileIntervalMinutes, maxReconcileIntervalMinutes},
+		{"checkin_interval_seconds", c.CheckinIntervalSeconds, minCheckInIntervalSeconds, maxCheckInIntervalSeconds},
+	}
+	for _, check := range checks {
+		if check.value != nil && (*check.value < check.min || *check.value > check.max) {
+			return fmt.Errorf("%s must be between %d and %d", check.name, check.min, check.max)
+		}
+	}
+
+	if c.ControllerVersion != nil && !releaseVersion.MatchString(*c.ControllerVersion) {
+		return fmt.Errorf("invalid controller_version %q: use a semantic version like v1.4.0", *c.ControllerVersion)
+	}
+
+	if len(c.FeatureFlags) > maxFeatureFlags {
+		return fmt.Errorf("at most %d feature flags can be set", maxFeatureFlags)
+	}
+	for name := range c.FeatureFlags {
+		if !flagName.MatchString(name) {
+			return fmt.Errorf("invalid feature flag %q: use lowercase letters, digits and underscores", name)
+		}
+	}
+	return nil
+}
+
+// UpsertParams converts a document into the row stored for the fleet, when
+// siteID is invalid, or for a site.
+func UpsertParams(siteID sql.NullInt64, c *libopsv1.ControllerConfig, updatedBy int64) (db.UpsertControllerConfigParams, error) {
+	flags := c.GetFeatureFlags()
+	if flags == nil {
+		flags = map[string]bool{}
+	}
+	encoded, err := json.Marshal(flags)
+	if err != nil {
+		return db.UpsertControllerConfigParams{}, fmt.Errorf("failed to encode feature flags: %w", err)
+	}
+	return db.UpsertControllerConfigParams{
+		SiteID:                   siteID,
+		RateLimitRps:             nullInt32(c.GetRateLimitRps(), c.RateLimitRps != nil),
+		RateLimitBurst:           nullInt32(c.GetRateLimitBurst(), c.RateLimitBurst != nil),
+		ReconcileIntervalMinutes: nullInt32(c.GetReconcileIntervalMinutes(), c.ReconcileIntervalMinutes != nil),
+		CheckinIntervalSeconds:   nullInt32(c.GetCheckinIntervalSeconds(), c.CheckinIntervalSeconds != nil),
+		ControllerVersion:        sql.NullString{String: c.GetControllerVersion(), Valid: c.ControllerVersion != nil},
+		FeatureFlags:             encoded,
+		UpdatedBy:                sql.NullInt64{Int64: updatedBy, Valid: updatedBy != 0},
+	}, nil
+}
+
+// FromRow converts a stored document to its proto.
+func FromRow(row db.ListControllerConfigsRow) (*libopsv1.ControllerConfig, error) {
+	c := &libopsv1.ControllerConfig{
+		RateLimitRps:             int32Ptr(row.RateLimitRps),
+		RateLimitBurst:           int32Ptr(row.RateLimitBurst),
+		ReconcileIntervalMinutes: int32Ptr(row.ReconcileIntervalMinutes),
+		CheckinIntervalSeconds:   int32Ptr(row.CheckinIntervalSeconds),
+	}
+	if row.ControllerVersion.Valid {
+		c.ControllerVersion = proto.String(row.ControllerVersion.String)
+	}
+	if len(row.FeatureFlags) > 0 {
+		if err := json.Unmarshal(row.FeatureFlags, &c.FeatureFlags); err != nil {
+			return nil, fmt.Errorf("invalid feature flags in controller config %d: %w", row.ID, err)
+		}
+	}
+	return c, nil
+}
+
+// Merge returns base with every field override sets replaced. Feature flags
+// are merged flag by flag.
+func Merge(base, override *libopsv1.ControllerConfig) *libopsv1.ControllerConfig {
+	merged := &libopsv1.ControllerConfig{}
+	if base != nil {
+		merged = proto.Clone(base).(*libopsv1.ControllerConfig)
+	}
+	if override == nil {
+		return merged
+	}
+	if override.RateLimitRps != nil {
+		merged.RateLimitRps = proto.Int32(*override.RateLimitRps)
+	}
+	if override.RateLimitBurst != nil {
+		merged.RateLimitBurst = proto.Int32(*override.RateLimitBurst)
+	}
+	if override.ReconcileIntervalMinutes != nil {
+		merged.ReconcileIntervalMinutes = proto.Int32(*override.ReconcileIntervalMinutes)
+	}
+	if override.CheckinIntervalSeconds != nil {
+		merged.CheckinIntervalSeconds = proto.Int32(*override.CheckinIntervalSeconds)
+	}
+	if override.ControllerVersion != nil {
+		merged.ControllerVersion = proto.String(*override.ControllerVersion)
+	}
+	if len(override.FeatureFlags) > 0 {
+		if merged.FeatureFlags == nil {
+			merged.FeatureFlags = map[string]bool{}
+		}
+		maps.Copy(merged.FeatureFlags, override.FeatureFlags)
+	}
+	return merged
+}
+
+// Effective returns the document a site's controller applies: the site's
+// override on top of the fleet's. It's never nil, so a controller reverts to
+// its own settings once the documents are removed. Version changes whenever
+// any tunable does.
+func Effective(ctx context.Context, querier db.Querier, siteID int64) (*libopsv1.ControllerConfig, error) {
+	rows, err := querier.ListControllerConfigs(ctx, sql.NullInt64{Int64: siteID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list controller configs: %w", err)
+	}
+
+	effective := &libopsv1.ControllerConfig{}
+	for _, row := range rows {
+		c, err := FromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		effective = Merge(effective, c)
+	}
+
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(effective)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode controller config: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	effective.Version = hex.EncodeToString(sum[:8])
+	return effective, nil
+}
+
+func nullInt32(v int32, valid bool) sql.NullInt32 {
+	return sql.NullInt32{Int32: v, Valid: valid}
+}
+
+func int32Ptr(v sql.NullInt32) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return proto.Int32(v.Int32)
+}
@@ -0,0 +1,87 @@
+package controllerconfig
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func TestValidate(t *testing.T) {
+	valid := &libopsv1.ControllerConfig{
+		RateLimitRps:             proto.Int32(20),
+		ReconcileIntervalMinutes: proto.Int32(60),
+		FeatureFlags:             map[string]bool{"nftables": true},
+		ControllerVersion:        proto.String("v1.4.0"),
+	}
+	assert.NoError(t, Validate(valid))
+	assert.NoError(t, Validate(nil))
+
+	invalid := []*libopsv1.ControllerConfig{
+		{RateLimitRps: proto.Int32(0)},
+		{RateLimitBurst: proto.Int32(1001)},
+		{ReconcileIntervalMinutes: proto.Int32(1)},
+		{CheckinIntervalSeconds: proto.Int32(5)},
+		{FeatureFlags: map[string]bool{"NFTables": true}},
+		{FeatureFlags: map[string]bool{"": true}},
+		{ControllerVersion: proto.String("latest")},
+	}
+	for _, c := range invalid {
+		assert.Error(t, Validate(c), "%v", c)
+	}
+}
+
+// TestEffective tests that a site's override replaces the fleet's fields and
+// flags one by one, and that the version follows the merged document.
+func TestEffective(t *testing.T) {
+	fleet := db.ListControllerConfigsRow{
+		ID:                       1,
+		RateLimitRps:             sql.NullInt32{Int32: 10, Valid: true},
+		ReconcileIntervalMinutes: sql.NullInt32{Int32: 720, Valid: true},
+		FeatureFlags:             json.RawMessage(`{"nftables": false, "tls_probe": true}`),
+	}
+	site := db.ListControllerConfigsRow{
+		ID:           2,
+		SiteID:       sql.NullInt64{Int64: 5, Valid: true},
+		RateLimitRps: sql.NullInt32{Int32: 50, Valid: true},
+		FeatureFlags: json.RawMessage(`{"nftables": true}`),
+	}
+	rows := []db.ListControllerConfigsRow{fleet, site}
+	mock := &testutils.MockQuerier{
+		ListControllerConfigsFunc: func(ctx context.Context, siteID sql.NullInt64) ([]db.ListControllerConfigsRow, error) {
+			assert.Equal(t, int64(5), siteID.Int64)
+			return rows, nil
+		},
+	}
+
+	effective, err := Effective(context.Background(), mock, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int32(50), effective.GetRateLimitRps())
+	assert.Equal(t, int32(720), effective.GetReconcileIntervalMinutes())
+	assert.Nil(t, effective.CheckinIntervalSeconds, "unset everywhere, so the controller keeps its own")
+	assert.Equal(t, map[string]bool{"nftables": true, "tls_probe": true}, effective.FeatureFlags)
+	require.NotEmpty(t, effective.Version)
+
+	again, err := Effective(context.Background(), mock, 5)
+	require.NoError(t, err)
+	assert.Equal(t, effective.Version, again.Version)
+
+	rows = []db.ListControllerConfigsRow{fleet}
+	fleetOnly, err := Effective(context.Background(), mock, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), fleetOnly.GetRateLimitRps())
+	assert.NotEqual(t, effective.Version, fleetOnly.Version)
+
+	rows = nil
+	none, err := Effective(context.Background(), mock, 5)
+	require.NoError(t, err)
+	assert.NotNil(t, none, "controllers need an empty document to revert to their own settings")
+}
@@ -0,0 +1,88 @@
+package controllerconfig
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/libops/api/db"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ed25519SignatureSize is the length of a decoded release signature
+const ed25519SignatureSize = 64
+
+// releaseVersion matches controller release versions
+var releaseVersion = regexp.MustCompile(`^v?[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?$`)
+
+// ValidateRelease checks that a release can be installed by controllers. The
+// signature can only be verified with the release key controllers hold, so
+// here it's only checked to be well formed.
+func ValidateRelease(r *libopsv1.ControllerRelease) error {
+	if r == nil {
+		return fmt.Errorf("release is required")
+	}
+	if len(r.Version) > 64 || !releaseVersion.MatchString(r.Version) {
+		return fmt.Errorf("invalid version %q: use a semantic version like v1.4.0", r.Version)
+	}
+
+	u, err := url.Parse(r.DownloadUrl)
+	if err != nil || u.Scheme != "https" || u.Host == "" || len(r.DownloadUrl) > 2048 {
+		return fmt.Errorf("download_url must be an https URL")
+	}
+
+	if digest, err := hex.DecodeString(r.Sha256); err != nil || len(digest) != 32 {
+		return fmt.Errorf("sha256 must be a hex SHA-256 digest")
+	}
+
+	if signature, err := base64.StdEncoding.DecodeString(r.Signature); err != nil || len(signature) != ed25519SignatureSize {
+		return fmt.Errorf("signature must be a base64 ed25519 signature")
+	}
+	return nil
+}
+
+// Release returns the release a controller config names, or nil if it names
+// none. A version without a published release is an error, since controllers
+// can't update to it.
+func Release(ctx context.Context, querier db.Querier, c *libopsv1.ControllerConfig) (*libopsv1.ControllerRelease, error) {
+	if c.GetControllerVersion() == "" {
+		return nil, nil
+	}
+	row, err := querier.GetControllerRelease(ctx, c.GetControllerVersion())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("controller release %s is not published", c.GetControllerVersion())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get controller release: %w", err)
+	}
+	return ReleaseFromRow(db.ListControllerReleasesRow{
+		ID:          row.ID,
+		Version:     row.Version,
+		DownloadUrl: row.DownloadUrl,
+		Sha256:      row.Sha256,
+		Signature:   row.Signature,
+		Notes:       row.Notes,
+		CreatedAt:   row.CreatedAt,
+	}), nil
+}
+
+// ReleaseFromRow converts a stored release to its proto.
+func ReleaseFromRow(row db.ListControllerReleasesRow) *libopsv1.ControllerRelease {
+	r := &libopsv1.ControllerRelease{
+		Version:     row.Version,
+		DownloadUrl: row.DownloadUrl,
+		Sha256:      row.Sha256,
+		Signature:   row.Signature,
+		Notes:       row.Notes.String,
+		SiteCount:   row.SiteCount,
+	}
+	if row.CreatedAt.Valid {
+		r.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return r
+}
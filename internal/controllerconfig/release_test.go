@@ -0,0 +1,39 @@
+package controllerconfig
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestValidateRelease tests that a release needs a semantic version, an https
+// download and a well-formed digest and signature.
+func TestValidateRelease(t *testing.T) {
+	valid := func() *libopsv1.ControllerRelease {
+		return &libopsv1.ControllerRelease{
+			Version:     "v1.4.0",
+			DownloadUrl: "https://releases.libops.io/controller/v1.4.0/libops-controller",
+			Sha256:      strings.Repeat("ab", 32),
+			Signature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+		}
+	}
+	assert.NoError(t, ValidateRelease(valid()))
+	assert.Error(t, ValidateRelease(nil))
+
+	invalid := []func(r *libopsv1.ControllerRelease){
+		func(r *libopsv1.ControllerRelease) { r.Version = "latest" },
+		func(r *libopsv1.ControllerRelease) { r.DownloadUrl = "http://releases.libops.io/controller" },
+		func(r *libopsv1.ControllerRelease) { r.Sha256 = "abc" },
+		func(r *libopsv1.ControllerRelease) { r.Signature = base64.StdEncoding.EncodeToString(make([]byte, 32)) },
+		func(r *libopsv1.ControllerRelease) { r.Signature = "not base64!" },
+	}
+	for i, mutate := range invalid {
+		r := valid()
+		mutate(r)
+		assert.Error(t, ValidateRelease(r), "case %d", i)
+	}
+}
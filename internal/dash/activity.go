@@ -0,0 +1,435 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+const (
+	// activityExportLimit caps the entries in a CSV export
+	activityExportLimit = 10000
+
+	// recentActivityLimit is the number of entries shown on detail pages
+	recentActivityLimit = 10
+
+	// maxActivityPage keeps offsets in range; older entries are in the CSV export
+	maxActivityPage = 1000
+)
+
+// activityCategories are the activity filters, each matching audit event names with a LIKE pattern
+var activityCategories = []struct {
+	ActivityCategory
+	pattern string
+}{
+	{ActivityCategory{Value: "members", Label: "Members"}, "member.%"},
+	{ActivityCategory{Value: "secrets", Label: "Secrets"}, "%.secret.%"},
+	{ActivityCategory{Value: "firewall", Label: "Firewall"}, "firewall.%"},
+	{ActivityCategory{Value: "deployments", Label: "Deployments"}, "deployment.%"},
+	{ActivityCategory{Value: "api_keys", Label: "API keys"}, "apikey.%"},
+	{ActivityCategory{Value: "ssh_keys", Label: "SSH keys"}, "sshkey.%"},
+	{ActivityCategory{Value: "logins", Label: "Sign-ins"}, "user.login.%"},
+}
+
+// avatarColors are the actor avatar colors; activity.html maps each to its classes
+var avatarColors = []string{"red", "amber", "green", "blue", "indigo", "pink"}
+
+// activityScope is whose activity a page shows
+type activityScope struct {
+	title      string
+	parentName string
+	parentLink string
+	path       string
+	params     db.ListAuditEventsParams
+}
+
+// HandleOrganizationActivity shows an organization's activity, including its projects and sites
+func (h *Handler) HandleOrganizationActivity(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	orgID := r.PathValue("id")
+	if !h.canUserPerformOnOrganization(r.Context(), userInfo, orgID, auth.PermissionRead) {
+		http.Error(w, "Organization not found", http.StatusNotFound)
+		return
+	}
+	org, err := h.db.GetOrganization(r.Context(), orgID)
+	if err != nil {
+		slog.Error("Failed to get organization", "org_id", orgID, "err", err)
+		http.Error(w, "Organization not found", http.StatusNotFound)
+		return
+	}
+
+	h.renderActivity(w, r, userInfo, "organizations", activityScope{
+		title:      "Activity",
+		parentName: org.Name,
+		parentLink: "/organizations/" + org.PublicID,
+		path:       "/organizations/" + org.PublicID + "/activity",
+		params:     db.ListAuditEventsParams{OrganizationID: sql.NullInt64{Int64: org.ID, Valid: true}},
+	})
+}
+
+// HandleProjectActivity shows a project's activity, including its sites
+func (h *Handler) HandleProjectActivity(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	projectID := r.PathValue("id")
+	if !h.canUserPerformOnProject(r.Context(), userInfo, projectID, auth.PermissionRead) {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+	project, err := h.db.GetProject(r.Context(), projectID)
+	if err != nil {
+		slog.Error("Failed to get project", "project_id", projectID, "err", err)
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	h.renderActivity(w, r, userInfo, "projects", activityScope{
+		title:      "Activity",
+		parentName: project.Name,
+		parentLink: "/projects/" + project.PublicID,
+		path:       "/projects/" + project.PublicID + "/activity",
+		params:     db.ListAuditEventsParams{ProjectID: sql.NullInt64{Int64: project.ID, Valid: true}},
+	})
+}
+
+// HandleSiteActivity shows a site's activity
+func (h *Handler) HandleSiteActivity(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	siteID := r.PathValue("id")
+	if !h.canUserPerformOnSite(r.Context(), userInfo, siteID, auth.PermissionRead) {
+		http.Error(w, "Site not found", http.StatusNotFound)
+		return
+	}
+	site, err := h.db.GetSite(r.Context(), siteID)
+	if err != nil {
+		slog.Error("Failed to get site", "site_id", siteID, "err", err)
+		http.Error(w, "Site not found", http.StatusNotFound)
+		return
+	}
+
+	h.renderActivity(w, r, userInfo, "sites", activityScope{
+		title:      "Activity",
+		parentName: site.Name,
+		parentLink: "/sites/" + site.PublicID,
+		path:       "/sites/" + site.PublicID + "/activity",
+		params:     db.ListAuditEventsParams{SiteID: sql.NullInt64{Int64: site.ID, Valid: true}},
+	})
+}
+
+// HandleMyActivity shows everything the signed-in user did
+func (h *Handler) HandleMyActivity(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	h.renderActivity(w, r, userInfo, "activity", activityScope{
+		title:  "My Activity",
+		path:   "/activity",
+		params: db.ListAuditEventsParams{AccountID: sql.NullInt64{Int64: userInfo.AccountID, Valid: true}},
+	})
+}
+
+// renderActivity applies the request's filters to the scope and renders the page, or
+// exports the matching entries with ?format=csv or ?format=json
+func (h *Handler) renderActivity(w http.ResponseWriter, r *http.Request, userInfo *auth.UserInfo, activePage string, scope activityScope) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	params := scope.params
+
+	category := query.Get("category")
+	for _, c := range activityCategories {
+		if c.Value == category {
+			params.EventPattern = sql.NullString{String: c.pattern, Valid: true}
+		}
+	}
+	if !params.EventPattern.Valid {
+		category = ""
+	}
+
+	// Only other people's activity can be filtered by actor
+	actor := ""
+	if !params.AccountID.Valid {
+		actor = strings.TrimSpace(query.Get("actor"))
+		if actor != "" {
+			params.ActorEmail = sql.NullString{String: actor, Valid: true}
+		}
+	}
+
+	days, _ := strconv.Atoi(query.Get("days"))
+	if days < 0 {
+		days = 0
+	}
+	if days > 0 {
+		params.Since = sql.NullTime{Time: time.Now().AddDate(0, 0, -days), Valid: true}
+	}
+
+	if format := query.Get("format"); format == "csv" || format == "json" {
+		params.Limit = activityExportLimit
+		rows, err := h.db.ListAuditEvents(ctx, params)
+		if err != nil {
+			slog.Error("Failed to export activity", "path", scope.path, "err", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		writeExport(w, r, activityExport(rows))
+		return
+	}
+
+	// Entries per page and their timestamps follow the account's preferences
+	prefs := h.preferences(ctx, userInfo.AccountID)
+	page, _ := strconv.Atoi(query.Get("page"))
+	page = max(1, min(page, maxActivityPage))
+	params.Limit = int32(prefs.pageSize + 1)
+	params.Offset = int32((page - 1) * prefs.pageSize)
+	rows, err := h.db.ListAuditEvents(ctx, params)
+	if err != nil {
+		slog.Error("Failed to list activity", "path", scope.path, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	data := ActivityPageData{
+		Email:      account.Email,
+		Name:       account.Name.String,
+		ActivePage: activePage,
+		Title:      scope.title,
+		ParentName: scope.parentName,
+		ParentLink: scope.parentLink,
+		Path:       scope.path,
+		Category:   category,
+		Actor:      actor,
+		Days:       days,
+		ShowActor:  !params.AccountID.Valid,
+		Page:       page,
+	}
+	for _, c := range activityCategories {
+		data.Categories = append(data.Categories, c.ActivityCategory)
+	}
+
+	more := len(rows) > prefs.pageSize
+	if more {
+		rows = rows[:prefs.pageSize]
+	}
+	for _, row := range rows {
+		data.Entries = append(data.Entries, auditLogEntry(row, prefs.location))
+	}
+
+	filters := url.Values{}
+	if category != "" {
+		filters.Set("category", category)
+	}
+	if actor != "" {
+		filters.Set("actor", actor)
+	}
+	if days > 0 {
+		filters.Set("days", strconv.Itoa(days))
+	}
+	if page > 1 {
+		data.PrevURL = activityURL(scope.path, filters, "page", strconv.Itoa(page-1))
+	}
+	if more {
+		data.NextURL = activityURL(scope.path, filters, "page", strconv.Itoa(page+1))
+	}
+	data.ExportURL = activityURL(scope.path, filters, "format", "csv")
+	data.ExportJSONURL = activityURL(scope.path, filters, "format", "json")
+
+	RenderActivity(w, data)
+}
+
+// recentActivity returns the latest entries in a scope for a detail page, with
+// timestamps in loc
+func (h *Handler) recentActivity(ctx context.Context, params db.ListAuditEventsParams, loc *time.Location) []AuditLogEntry {
+	params.Limit = recentActivityLimit
+	rows, err := h.db.ListAuditEvents(ctx, params)
+	if err != nil {
+		slog.Error("Failed to list recent activity", "err", err)
+		return []AuditLogEntry{}
+	}
+	entries := make([]AuditLogEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, auditLogEntry(row, loc))
+	}
+	return entries
+}
+
+func activityURL(path string, filters url.Values, key, value string) string {
+	values := url.Values{}
+	for k, v := range filters {
+		values[k] = v
+	}
+	values.Set(key, value)
+	return path + "?" + values.Encode()
+}
+
+func auditLogEntry(row db.ListAuditEventsRow, loc *time.Location) AuditLogEntry {
+	entry := AuditLogEntry{
+		Action:      activityAction(row.EventName),
+		Description: activityDescription(row),
+		ActorEmail:  row.ActorEmail.String,
+		Actor:       row.ActorName.String,
+		SourceIP:    auditSourceIP(row.EventData),
+	}
+	if entry.Actor == "" {
+		entry.Actor = entry.ActorEmail
+	}
+	if entry.Actor == "" {
+		entry.Actor = "Deleted account"
+	}
+	entry.Initials = initials(entry.Actor)
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.ToLower(entry.ActorEmail)))
+	entry.AvatarColor = avatarColors[hash.Sum32()%uint32(len(avatarColors))]
+
+	if row.CreatedAt.Valid {
+		entry.Timestamp = row.CreatedAt.Time.In(loc).Format("Jan 2, 2006 15:04 MST")
+	}
+	if row.EntityPublicID != "" {
+		switch row.EntityType {
+		case db.AuditEntityTypeOrganizations, db.AuditEntityTypeProjects, db.AuditEntityTypeSites:
+			entry.EntityLink = "/" + string(row.EntityType) + "/" + row.EntityPublicID
+		}
+	}
+	return entry
+}
+
+// activityAction turns an audit event name into a sentence, e.g.
+// "organization.secret.create.success" into "Organization secret created"
+func activityAction(event string) string {
+	words := strings.Split(strings.TrimSuffix(event, ".success"), ".")
+	if len(words) == 1 {
+		words = append(words, "succeeded")
+	}
+
+	verb := words[len(words)-1]
+	if past, ok := activityVerbs[verb]; ok {
+		verb = past
+	}
+
+	subject := make([]string, 0, len(words)-1)
+	for _, word := range words[:len(words)-1] {
+		subject = append(subject, activityWord(word))
+	}
+	sentence := strings.Join(append(subject, verb), " ")
+	return strings.ToUpper(sentence[:1]) + sentence[1:]
+}
+
+var activityVerbs = map[string]string{
+	"create":  "created",
+	"update":  "updated",
+	"delete":  "deleted",
+	"add":     "added",
+	"remove":  "removed",
+	"failure": "failed",
+	"failed":  "failed",
+}
+
+func activityWord(word string) string {
+	switch word {
+	case "apikey":
+		return "API key"
+	case "sshkey":
+		return "SSH key"
+	}
+	return word
+}
+
+// activityDescription names the affected resource, e.g. "Site catalog"
+func activityDescription(row db.ListAuditEventsRow) string {
+	kind := map[db.AuditEntityType]string{
+		db.AuditEntityTypeAccounts:      "Account",
+		db.AuditEntityTypeOrganizations: "Organization",
+		db.AuditEntityTypeProjects:      "Project",
+		db.AuditEntityTypeSites:         "Site",
+		db.AuditEntityTypeSshKeys:       "SSH key",
+		db.AuditEntityTypeApiKeys:       "API key",
+	}[row.EntityType]
+	if row.EntityName != "" {
+		return kind + " " + row.EntityName
+	}
+	if row.EntityType == db.AuditEntityTypeOrganizations || row.EntityType == db.AuditEntityTypeProjects || row.EntityType == db.AuditEntityTypeSites {
+		return kind + " (deleted)"
+	}
+	return kind
+}
+
+// auditSourceIP reads the source IP the audit logger adds to every event's data
+func auditSourceIP(data []byte) string {
+	var fields struct {
+		SourceIP string `json:"source_ip"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil || fields.SourceIP == "unknown" {
+		return ""
+	}
+	return fields.SourceIP
+}
+
+func initials(name string) string {
+	var out []rune
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '@' || r == '.'
+	}) {
+		out = append(out, unicode.ToUpper([]rune(word)[0]))
+		if len(out) == 2 {
+			break
+		}
+	}
+	if len(out) == 0 {
+		return "?"
+	}
+	return string(out)
+}
+
+func activityExport(rows []db.ListAuditEventsRow) exportTable {
+	table := exportTable{
+		name:    "activity",
+		columns: []string{"time", "actor_email", "actor_name", "event", "entity_type", "entity_id", "entity_name", "source_ip", "data"},
+	}
+	for _, row := range rows {
+		table.rows = append(table.rows, []string{
+			exportTime(row.CreatedAt),
+			row.ActorEmail.String,
+			row.ActorName.String,
+			row.EventName,
+			string(row.EntityType),
+			row.EntityPublicID,
+			row.EntityName,
+			auditSourceIP(row.EventData),
+			string(row.EventData),
+		})
+	}
+	return table
+}
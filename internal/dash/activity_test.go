@@ -0,0 +1,76 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestActivityAction tests that audit event names read as sentences.
+func TestActivityAction(t *testing.T) {
+	tests := map[string]string{
+		"organization.secret.create.success": "Organization secret created",
+		"member.remove.success":              "Member removed",
+		"firewall.rule.delete.success":       "Firewall rule deleted",
+		"apikey.create":                      "API key created",
+		"user.login.failure":                 "User login failed",
+		"deployment.success":                 "Deployment succeeded",
+	}
+	for event, want := range tests {
+		assert.Equal(t, want, activityAction(event), event)
+	}
+}
+
+// TestInitials tests avatar initials from names and email addresses.
+func TestInitials(t *testing.T) {
+	assert.Equal(t, "AL", initials("Ada Lovelace"))
+	assert.Equal(t, "AE", initials("ada@example.org"))
+	assert.Equal(t, "É", initials("élodie"))
+	assert.Equal(t, "?", initials(""))
+}
+
+// TestMyActivityExport tests that the CSV export is limited to the signed-in account and
+// neutralizes names that spreadsheets would read as formulas.
+func TestMyActivityExport(t *testing.T) {
+	var got db.ListAuditEventsParams
+	mock := &testutils.MockQuerier{
+		ListAuditEventsFunc: func(ctx context.Context, arg db.ListAuditEventsParams) ([]db.ListAuditEventsRow, error) {
+			got = arg
+			return []db.ListAuditEventsRow{{
+				ActorEmail:     sql.NullString{String: "ada@example.org", Valid: true},
+				EntityType:     db.AuditEntityTypeSites,
+				EntityName:     "=HYPERLINK(\"x\")",
+				EntityPublicID: "site-1",
+				EventName:      "site.update",
+				EventData:      []byte(`{"source_ip":"192.0.2.1"}`),
+				CreatedAt:      sql.NullTime{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true},
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/activity?format=csv&category=members&actor=someone@example.org&days=7", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	rec := httptest.NewRecorder()
+	NewHandler(mock, nil).HandleMyActivity(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, sql.NullInt64{Int64: 5, Valid: true}, got.AccountID)
+	assert.Equal(t, "member.%", got.EventPattern.String)
+	assert.False(t, got.ActorEmail.Valid, "my activity ignores the actor filter")
+	assert.True(t, got.Since.Valid)
+
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `2026-01-02T03:04:05Z,ada@example.org,,site.update,sites,site-1,"'=HYPERLINK(""x"")",192.0.2.1,"{""source_ip"":""192.0.2.1""}"`, lines[1])
+}
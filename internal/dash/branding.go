@@ -0,0 +1,70 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/internal/branding"
+)
+
+// HandleOrganizationLogo serves an organization's logo. Logos are public since
+// notification emails link to them, and are cached for a long time because the
+// URL changes with every upload.
+func (h *Handler) HandleOrganizationLogo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgID := r.PathValue("id")
+	if _, err := uuid.Parse(orgID); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	org, err := h.db.GetOrganization(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get organization for logo", "org_id", orgID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	logo, err := h.db.GetOrganizationLogo(ctx, org.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get organization logo", "org_id", orgID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", logo.LogoContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(logo.Logo.String)))
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if _, err := w.Write([]byte(logo.Logo.String)); err != nil {
+		slog.Debug("Failed to write organization logo", "org_id", orgID, "err", err)
+	}
+}
+
+// branding returns the branding of the organization a page belongs to, or nil
+// when the organization kept the libops look or it can't be loaded
+func (h *Handler) branding(ctx context.Context, organizationID int64, organizationPublicID string) *branding.Branding {
+	brand, err := branding.Load(ctx, h.db, organizationID, organizationPublicID, "")
+	if err != nil {
+		slog.Error("Failed to load organization branding", "org_id", organizationPublicID, "err", err)
+		return nil
+	}
+	if !brand.Custom {
+		return nil
+	}
+	return &brand
+}
@@ -0,0 +1,59 @@
+package dash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+const (
+	// siteConfigVarLimit is as many config vars as a site can have
+	siteConfigVarLimit = 500
+	// siteConfigVarHistoryLimit is how many changes the site detail page lists
+	siteConfigVarHistoryLimit = 10
+)
+
+// siteConfigVars loads the site's config vars and their latest changes for
+// the site detail page. Change times are shown in loc.
+func (h *Handler) siteConfigVars(ctx context.Context, siteID int64, canEdit bool, loc *time.Location) SiteConfigVars {
+	configVars := SiteConfigVars{CanEdit: canEdit}
+
+	rows, err := h.db.ListSiteConfigVars(ctx, db.ListSiteConfigVarsParams{SiteID: siteID, Limit: siteConfigVarLimit})
+	if err != nil {
+		slog.Error("Failed to list site config vars", "site_id", siteID, "err", err)
+	}
+	for _, row := range rows {
+		configVars.Vars = append(configVars.Vars, ConfigVar{Name: row.Name, Value: row.Value, Revision: row.Revision})
+	}
+
+	revisions, err := h.db.ListSiteConfigVarRevisions(ctx, db.ListSiteConfigVarRevisionsParams{SiteID: siteID, Limit: siteConfigVarHistoryLimit})
+	if err != nil {
+		slog.Error("Failed to list site config var revisions", "site_id", siteID, "err", err)
+	}
+	for _, row := range revisions {
+		change := ConfigVarChange{
+			Revision:      row.Revision,
+			Name:          row.Name,
+			Change:        "changed",
+			PreviousValue: row.PreviousValue.String,
+			Value:         row.Value.String,
+			ChangedBy:     row.CreatedByEmail.String,
+		}
+		switch {
+		case !row.PreviousValue.Valid:
+			change.Change = "added"
+		case !row.Value.Valid:
+			change.Change = "removed"
+		}
+		if row.CreatedAt.Valid {
+			change.ChangedAt = row.CreatedAt.Time.In(loc).Format("2006-01-02 15:04 MST")
+		}
+		configVars.History = append(configVars.History, change)
+	}
+	if len(revisions) > 0 {
+		configVars.Revision = revisions[0].Revision
+	}
+	return configVars
+}
@@ -0,0 +1,85 @@
+package dash
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+// siteDeploymentLimit is how many deployments the site detail page lists
+const siteDeploymentLimit = 20
+
+// siteDeployments lists the site's latest deployments for the site detail page.
+// Successful deployments other than the newest one can be rolled back to. Start
+// times are shown in loc.
+func (h *Handler) siteDeployments(ctx context.Context, sitePublicID string, canDeploy bool, loc *time.Location) []Deployment {
+	rows, err := h.db.ListSiteDeployments(ctx, db.ListSiteDeploymentsParams{
+		SiteID: sitePublicID,
+		Limit:  siteDeploymentLimit,
+		Offset: 0,
+	})
+	if err != nil {
+		slog.Error("Failed to list site deployments", "site_id", sitePublicID, "err", err)
+		return nil
+	}
+
+	deployments := make([]Deployment, 0, len(rows))
+	seenSuccess := false
+	for _, row := range rows {
+		deployment := Deployment{
+			ID:          row.ID,
+			Ref:         row.GitRef.String,
+			Commit:      shortSHA(row.CommitSha.String),
+			Message:     firstLine(row.CommitMessage.String),
+			Status:      string(row.Status),
+			RunURL:      row.GithubRunUrl.String,
+			Error:       row.ErrorMessage.String,
+			RollbackOf:  row.RollbackOf.String,
+			StartedAt:   "-",
+			Duration:    deploymentDuration(row, time.Now()),
+			CanRollback: canDeploy && row.Status == db.DeploymentsStatusSuccess && seenSuccess,
+		}
+		if row.StartedAt > 0 {
+			deployment.StartedAt = time.Unix(row.StartedAt, 0).In(loc).Format("2006-01-02 15:04 MST")
+		}
+		if row.Status == db.DeploymentsStatusSuccess {
+			seenSuccess = true
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments
+}
+
+// deploymentDuration formats how long a deployment took, or has taken so far
+func deploymentDuration(row db.Deployment, now time.Time) string {
+	if row.StartedAt == 0 {
+		return "-"
+	}
+	end := now
+	if row.CompletedAt.Valid {
+		end = time.Unix(row.CompletedAt.Int64, 0)
+	}
+	d := end.Sub(time.Unix(row.StartedAt, 0)).Round(time.Second)
+	if d < 0 {
+		return "-"
+	}
+	if !row.CompletedAt.Valid {
+		return d.String() + " so far"
+	}
+	return d.String()
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return strings.TrimSpace(line)
+}
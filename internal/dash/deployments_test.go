@@ -0,0 +1,51 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestSiteDeployments tests that only successful deployments older than the current
+// one are offered for rollback.
+func TestSiteDeployments(t *testing.T) {
+	started := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC).Unix()
+	mock := &testutils.MockQuerier{
+		ListSiteDeploymentsFunc: func(ctx context.Context, arg db.ListSiteDeploymentsParams) ([]db.Deployment, error) {
+			assert.Equal(t, "site-1", arg.SiteID)
+			return []db.Deployment{
+				{ID: "d4", Status: db.DeploymentsStatusFailed, StartedAt: started, CompletedAt: sql.NullInt64{Int64: started + 30, Valid: true}},
+				{ID: "d3", Status: db.DeploymentsStatusSuccess, StartedAt: started, CompletedAt: sql.NullInt64{Int64: started + 95, Valid: true},
+					CommitSha: sql.NullString{String: "0123456789abcdef", Valid: true}, CommitMessage: sql.NullString{String: "Fix search\n\nLonger body", Valid: true}},
+				{ID: "d2", Status: db.DeploymentsStatusSuccess, StartedAt: started},
+				{ID: "d1", Status: db.DeploymentsStatusPending},
+			}, nil
+		},
+	}
+
+	deployments := NewHandler(mock, nil).siteDeployments(context.Background(), "site-1", true, time.UTC)
+	require.Len(t, deployments, 4)
+	assert.False(t, deployments[0].CanRollback, "failed deployments can't be rolled back to")
+	assert.False(t, deployments[1].CanRollback, "the newest successful deployment is what's running")
+	assert.True(t, deployments[2].CanRollback)
+	assert.Equal(t, "01234567", deployments[1].Commit)
+	assert.Equal(t, "Fix search", deployments[1].Message)
+	assert.Equal(t, "1m35s", deployments[1].Duration)
+	assert.Equal(t, "2026-03-01 12:00 UTC", deployments[1].StartedAt)
+	assert.Equal(t, "-", deployments[3].Duration)
+
+	readOnly := NewHandler(mock, nil).siteDeployments(context.Background(), "site-1", false, time.UTC)
+	assert.False(t, readOnly[2].CanRollback)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	local := NewHandler(mock, nil).siteDeployments(context.Background(), "site-1", true, tokyo)
+	assert.Equal(t, "2026-03-01 21:00 JST", local[1].StartedAt, "start times follow the account's time zone")
+}
@@ -0,0 +1,51 @@
+package dash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/avatar"
+)
+
+// siteElevationLimit is how many elevation requests the site detail page lists
+const siteElevationLimit = 10
+
+// siteElevations loads the site's latest elevation requests for the site
+// detail page. Owners approve them; anyone else on the site can request one.
+// Times are shown in loc.
+func (h *Handler) siteElevations(ctx context.Context, siteID, accountID int64, canApprove bool, loc *time.Location) SiteElevations {
+	elevations := SiteElevations{CanRequest: !canApprove, CanApprove: canApprove}
+
+	rows, err := h.db.ListSiteElevations(ctx, db.ListSiteElevationsParams{SiteID: siteID, Limit: siteElevationLimit})
+	if err != nil {
+		slog.Error("Failed to list site elevations", "site_id", siteID, "err", err)
+	}
+	for _, row := range rows {
+		elevation := Elevation{
+			ID:              row.PublicID,
+			Email:           row.Email,
+			Name:            row.UserName.String,
+			AvatarURL:       avatar.Path(row.AccountPublicID, row.AvatarObject),
+			Role:            string(row.Role),
+			Reason:          row.Reason,
+			DurationMinutes: row.DurationMinutes,
+			Status:          string(row.Status),
+			ResolvedBy:      row.ResolvedByEmail.String,
+			Own:             row.AccountID == accountID,
+		}
+		if row.CreatedAt.Valid {
+			elevation.RequestedAt = row.CreatedAt.Time.In(loc).Format("2006-01-02 15:04 MST")
+		}
+		if row.ExpiresAt.Valid {
+			elevation.ExpiresAt = row.ExpiresAt.Time.In(loc).Format("2006-01-02 15:04 MST")
+		}
+		// An approved role past its expiry is about to be swept
+		if row.Status == db.SiteElevationsStatusApproved && row.ExpiresAt.Valid && !row.ExpiresAt.Time.After(time.Now()) {
+			elevation.Status = string(db.SiteElevationsStatusExpired)
+		}
+		elevations.Requests = append(elevations.Requests, elevation)
+	}
+	return elevations
+}
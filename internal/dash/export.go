@@ -0,0 +1,215 @@
+package dash
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+// exportLimit caps the rows in a resource list export
+const exportLimit = 5000
+
+// exportTable is a report of named columns, written as CSV or JSON
+type exportTable struct {
+	name    string // File name prefix, e.g. "members"
+	columns []string
+	rows    [][]string
+}
+
+// HandleExportMembers exports the memberships of every resource the user can access
+func (h *Handler) HandleExportMembers(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	members, err := h.db.ListUserMemberships(r.Context(), db.ListUserMembershipsParams{
+		AccountID: userInfo.AccountID,
+		Limit:     exportLimit,
+		Offset:    0,
+	})
+	if err != nil {
+		slog.Error("Failed to export memberships", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	table := exportTable{
+		name:    "members",
+		columns: []string{"id", "email", "name", "role", "status", "resource_type", "resource_id", "resource_name", "created_at"},
+	}
+	for _, m := range members {
+		table.rows = append(table.rows, []string{
+			m.PublicID, m.Email, m.UserName.String, string(m.Role), string(m.Status.OrganizationMembersStatus),
+			m.ParentType, m.ParentPublicID, m.ParentName, exportTime(m.CreatedAt),
+		})
+	}
+	writeExport(w, r, table)
+}
+
+// HandleExportFirewall exports the firewall rules of every resource the user can access
+func (h *Handler) HandleExportFirewall(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	rules, err := h.db.ListUserFirewallRules(r.Context(), db.ListUserFirewallRulesParams{
+		AccountID: userInfo.AccountID,
+		Limit:     exportLimit,
+		Offset:    0,
+	})
+	if err != nil {
+		slog.Error("Failed to export firewall rules", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	table := exportTable{
+		name:    "firewall-rules",
+		columns: []string{"id", "name", "cidr", "rule_type", "status", "resource_type", "resource_id", "resource_name", "created_at"},
+	}
+	for _, rule := range rules {
+		table.rows = append(table.rows, []string{
+			rule.PublicID, rule.Name, rule.Cidr, string(rule.RuleType), string(rule.Status.OrganizationFirewallRulesStatus),
+			rule.ParentType, rule.ParentPublicID, rule.ParentName, exportTime(rule.CreatedAt),
+		})
+	}
+	writeExport(w, r, table)
+}
+
+// HandleExportSecrets exports secret metadata; values are never included
+func (h *Handler) HandleExportSecrets(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	secrets, err := h.db.ListUserSecrets(r.Context(), db.ListUserSecretsParams{
+		AccountID: userInfo.AccountID,
+		Limit:     exportLimit,
+		Offset:    0,
+	})
+	if err != nil {
+		slog.Error("Failed to export secrets", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	table := exportTable{
+		name:    "secrets",
+		columns: []string{"id", "name", "status", "resource_type", "resource_id", "resource_name", "created_at", "updated_at"},
+	}
+	for _, secret := range secrets {
+		table.rows = append(table.rows, []string{
+			secret.PublicID, secret.Name, string(secret.Status.OrganizationSecretsStatus),
+			secret.ParentType, secret.ParentPublicID, secret.ParentName,
+			exportUnix(secret.CreatedAt), exportUnix(secret.UpdatedAt),
+		})
+	}
+	writeExport(w, r, table)
+}
+
+// HandleExportSites exports every site the user can access
+func (h *Handler) HandleExportSites(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sites, err := h.db.ListUserSitesWithProject(r.Context(), db.ListUserSitesWithProjectParams{
+		AccountID: userInfo.AccountID,
+		Limit:     exportLimit,
+	})
+	if err != nil {
+		slog.Error("Failed to export sites", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	table := exportTable{
+		name:    "sites",
+		columns: []string{"id", "name", "status", "project_id", "project_name", "organization_id", "github_repository", "github_ref", "external_ip", "created_at"},
+	}
+	for _, site := range sites {
+		table.rows = append(table.rows, []string{
+			site.PublicID, site.Name, string(site.Status.SitesStatus), site.ProjectPublicID, site.ProjectName,
+			site.OrganizationPublicID, site.GithubRepository, site.GithubRef, site.GcpExternalIp.String, exportTime(site.CreatedAt),
+		})
+	}
+	writeExport(w, r, table)
+}
+
+// writeExport writes the table as a download: JSON, an array of objects keyed by
+// column, with ?format=json and CSV otherwise
+func writeExport(w http.ResponseWriter, r *http.Request, table exportTable) {
+	filename := fmt.Sprintf("%s-%s", table.name, time.Now().UTC().Format("2006-01-02"))
+
+	if r.URL.Query().Get("format") == "json" {
+		records := make([]map[string]string, 0, len(table.rows))
+		for _, row := range table.rows {
+			record := make(map[string]string, len(table.columns))
+			for i, column := range table.columns {
+				record[column] = row[i]
+			}
+			records = append(records, record)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		if err := json.NewEncoder(w).Encode(records); err != nil {
+			slog.Error("Failed to write export", "name", table.name, "err", err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, filename))
+	out := csv.NewWriter(w)
+	_ = out.Write(table.columns)
+	for _, row := range table.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = csvSafe(cell)
+		}
+		_ = out.Write(cells)
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		slog.Error("Failed to write export", "name", table.name, "err", err)
+	}
+}
+
+// csvSafe keeps user-chosen values from being read as formulas when the export is
+// opened in a spreadsheet
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func exportTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+func exportUnix(seconds int64) string {
+	if seconds == 0 {
+		return ""
+	}
+	return time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,69 @@
+package dash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestExportSecretsJSON tests that secrets export as metadata records keyed by column.
+func TestExportSecretsJSON(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		ListUserSecretsFunc: func(ctx context.Context, arg db.ListUserSecretsParams) ([]db.ListUserSecretsRow, error) {
+			assert.Equal(t, int64(5), arg.AccountID)
+			assert.Equal(t, int32(exportLimit), arg.Limit)
+			return []db.ListUserSecretsRow{{
+				PublicID:       "secret-1",
+				Name:           "DB_PASSWORD",
+				Status:         db.NullOrganizationSecretsStatus{OrganizationSecretsStatus: db.OrganizationSecretsStatusActive, Valid: true},
+				CreatedAt:      1767225600,
+				ParentType:     "site",
+				ParentName:     "catalog",
+				ParentPublicID: "site-1",
+			}}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/secrets/export?format=json", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	rec := httptest.NewRecorder()
+	NewHandler(mock, nil).HandleExportSecrets(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), `filename="secrets-`)
+
+	var records []map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &records))
+	assert.Equal(t, []map[string]string{{
+		"id":            "secret-1",
+		"name":          "DB_PASSWORD",
+		"status":        "active",
+		"resource_type": "site",
+		"resource_id":   "site-1",
+		"resource_name": "catalog",
+		"created_at":    "2026-01-01T00:00:00Z",
+		"updated_at":    "",
+	}}, records)
+}
+
+// TestWriteExportCSV tests the CSV header and that formula-like cells are neutralized.
+func TestWriteExportCSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeExport(rec, httptest.NewRequest(http.MethodGet, "/sites/export", nil), exportTable{
+		name:    "sites",
+		columns: []string{"id", "name"},
+		rows:    [][]string{{"site-1", "+catalog"}},
+	})
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "id,name\nsite-1,'+catalog\n", rec.Body.String())
+}
@@ -0,0 +1,185 @@
+package dash
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/fleet"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	// fleetExportLimit caps the VMs in a fleet export
+	fleetExportLimit = 5000
+
+	// maxFleetPage keeps offsets in range; the rest are in the export
+	maxFleetPage = 1000
+)
+
+// fleetStaleOptions are the check-in age filters, in minutes
+var fleetStaleOptions = []FleetOption{
+	{Value: "15", Label: "15 minutes"},
+	{Value: "60", Label: "1 hour"},
+	{Value: "1440", Label: "1 day"},
+}
+
+// fleetDiskOptions are the disk pressure filters, in percent used
+var fleetDiskOptions = []FleetOption{
+	{Value: "70", Label: "70% full"},
+	{Value: "85", Label: "85% full"},
+	{Value: "95", Label: "95% full"},
+}
+
+// HandleOrganizationFleet shows an organization owner the health of its site VMs,
+// or exports them with ?format=csv or ?format=json
+func (h *Handler) HandleOrganizationFleet(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	ctx := r.Context()
+	orgID := r.PathValue("id")
+	if !h.canUserPerformOnOrganization(ctx, userInfo, orgID, auth.PermissionOwner) {
+		http.Error(w, "Organization not found", http.StatusNotFound)
+		return
+	}
+	org, err := h.db.GetOrganization(ctx, orgID)
+	if err != nil {
+		slog.Error("Failed to get organization", "org_id", orgID, "err", err)
+		http.Error(w, "Organization not found", http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := &libopsv1.FleetFilter{OrganizationId: org.PublicID}
+	filters := url.Values{}
+	if status := query.Get("status"); status != "" {
+		filter.Status = &status
+		filters.Set("status", status)
+	}
+	if patch := query.Get("os_patch_status"); patch != "" {
+		filter.OsPatchStatus = &patch
+		filters.Set("os_patch_status", patch)
+	}
+	if stale, _ := strconv.Atoi(query.Get("stale")); stale > 0 {
+		filter.StaleMinutes = int32(stale)
+		filters.Set("stale", strconv.Itoa(stale))
+	}
+	if disk, _ := strconv.Atoi(query.Get("disk")); disk > 0 {
+		filter.MinDiskUsedPercent = int32(disk)
+		filters.Set("disk", strconv.Itoa(disk))
+	}
+	if query.Get("pending") == "1" {
+		filter.PendingReconciliations = true
+		filters.Set("pending", "1")
+	}
+	params, err := fleet.Params(filter, time.Now(), fleetExportLimit, 0)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	path := "/organizations/" + org.PublicID + "/fleet"
+	if format := query.Get("format"); format == "csv" || format == "json" {
+		rows, err := h.db.ListFleetSites(ctx, params)
+		if err != nil {
+			slog.Error("Failed to export fleet", "org_id", orgID, "err", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		table := exportTable{name: "fleet", columns: fleet.Columns}
+		for _, row := range rows {
+			table.rows = append(table.rows, fleet.Row(fleet.ToProto(row)))
+		}
+		writeExport(w, r, table)
+		return
+	}
+
+	prefs := h.preferences(ctx, userInfo.AccountID)
+	page, _ := strconv.Atoi(query.Get("page"))
+	page = max(1, min(page, maxFleetPage))
+	params.Limit = int32(prefs.pageSize + 1)
+	params.Offset = int32((page - 1) * prefs.pageSize)
+	rows, err := h.db.ListFleetSites(ctx, params)
+	if err != nil {
+		slog.Error("Failed to list fleet", "org_id", orgID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	data := FleetPageData{
+		Email:            account.Email,
+		Name:             account.Name.String,
+		ActivePage:       "organizations",
+		OrganizationID:   org.PublicID,
+		OrganizationName: org.Name,
+		Path:             path,
+		Status:           filter.GetStatus(),
+		OsPatchStatus:    filter.GetOsPatchStatus(),
+		Stale:            filters.Get("stale"),
+		Disk:             filters.Get("disk"),
+		Pending:          filter.PendingReconciliations,
+		StaleOptions:     fleetStaleOptions,
+		DiskOptions:      fleetDiskOptions,
+		PatchStatuses:    fleet.PatchStatuses,
+		Page:             page,
+	}
+
+	more := len(rows) > prefs.pageSize
+	if more {
+		rows = rows[:prefs.pageSize]
+	}
+	for _, row := range rows {
+		data.Sites = append(data.Sites, fleetEntry(fleet.ToProto(row), prefs.location))
+	}
+
+	if page > 1 {
+		data.PrevURL = activityURL(path, filters, "page", strconv.Itoa(page-1))
+	}
+	if more {
+		data.NextURL = activityURL(path, filters, "page", strconv.Itoa(page+1))
+	}
+	data.ExportURL = activityURL(path, filters, "format", "csv")
+	data.ExportJSONURL = activityURL(path, filters, "format", "json")
+
+	RenderFleet(w, data)
+}
+
+// fleetEntry formats a VM for the fleet page, with its last check-in in loc
+func fleetEntry(site *libopsv1.FleetSite, loc *time.Location) FleetEntry {
+	entry := FleetEntry{
+		SiteID:                 site.SiteId,
+		SiteName:               site.SiteName,
+		ProjectName:            site.ProjectName,
+		Status:                 site.Status,
+		ExternalIP:             site.ExternalIp,
+		LastCheckIn:            "Never",
+		ControllerVersion:      site.ControllerVersion,
+		PendingReconciliations: site.PendingReconciliations,
+		OsVersion:              site.OsVersion,
+		OsPatchStatus:          site.OsPatchStatus,
+		DiskUsed:               "-",
+	}
+	if site.LastCheckinAt > 0 {
+		entry.LastCheckIn = time.Unix(site.LastCheckinAt, 0).In(loc).Format("Jan 2, 2006 15:04 MST")
+	}
+	if site.DiskSizeBytes > 0 {
+		entry.DiskUsed = fmt.Sprintf("%.0f%%", site.DiskUsedPercent)
+		entry.DiskPressure = site.DiskUsedPercent >= 85
+	}
+	return entry
+}
@@ -3,6 +3,7 @@ package dash
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
@@ -10,6 +11,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/i18n"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/organization"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
 )
 
 // Handler provides HTTP handlers for dashboard pages
@@ -79,7 +84,8 @@ func (h *Handler) canUserPerformOnSite(ctx context.Context, userInfo *auth.UserI
 
 // HandleLoginPage handles requests to the login page
 func (h *Handler) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
-	data := LoginPageData{}
+	// Nobody is signed in yet, so only the browser's language is known
+	data := LoginPageData{Locale: i18n.Negotiate("", r.Header.Get("Accept-Language"))}
 
 	// Check for query parameters
 	if verified := r.URL.Query().Get("verified"); verified == "true" {
@@ -106,6 +112,38 @@ func (h *Handler) HandleLoginPage(w http.ResponseWriter, r *http.Request) {
 	RenderLoginPage(w, data)
 }
 
+// HandleVerifyEmailPage shows the form that resends a verification link
+func (h *Handler) HandleVerifyEmailPage(w http.ResponseWriter, r *http.Request) {
+	RenderAccountRecoveryPage(w, accountRecoveryPageData(r, "verify_email"))
+}
+
+// HandleForgotPasswordPage shows the form that emails a password reset link
+func (h *Handler) HandleForgotPasswordPage(w http.ResponseWriter, r *http.Request) {
+	RenderAccountRecoveryPage(w, accountRecoveryPageData(r, "forgot_password"))
+}
+
+// HandleResetPasswordPage shows the form a password reset link opens
+func (h *Handler) HandleResetPasswordPage(w http.ResponseWriter, r *http.Request) {
+	data := accountRecoveryPageData(r, "reset_password")
+	if data.Token == "" {
+		http.Redirect(w, r, "/forgot-password", http.StatusSeeOther)
+		return
+	}
+	RenderAccountRecoveryPage(w, data)
+}
+
+func accountRecoveryPageData(r *http.Request, mode string) AccountRecoveryPageData {
+	query := r.URL.Query()
+	return AccountRecoveryPageData{
+		Mode:    mode,
+		Email:   query.Get("email"),
+		Token:   query.Get("token"),
+		Message: query.Get("message"),
+		Error:   query.Get("error"),
+		Locale:  i18n.Negotiate("", r.Header.Get("Accept-Language")),
+	}
+}
+
 // HandleDashboard handles requests to the main dashboard page
 func (h *Handler) HandleDashboard(w http.ResponseWriter, r *http.Request) {
 	// Get user from context (set by auth middleware)
@@ -130,7 +168,6 @@ func (h *Handler) HandleDashboard(w http.ResponseWriter, r *http.Request) {
 	dbOrgs, err := h.db.ListUserOrganizations(ctx, db.ListUserOrganizationsParams{
 		AccountID: account.ID,
 		Limit:     100,
-		Offset:    0,
 	})
 	if err != nil {
 		slog.Error("Failed to list organizations for dashboard", "account_id", account.ID, "err", err)
@@ -187,7 +224,6 @@ func (h *Handler) HandleOrganizations(w http.ResponseWriter, r *http.Request) {
 	dbOrgs, err := h.db.ListUserOrganizations(ctx, db.ListUserOrganizationsParams{
 		AccountID: account.ID,
 		Limit:     100,
-		Offset:    0,
 	})
 	if err != nil {
 		slog.Error("Failed to list organizations", "account_id", account.ID, "err", err)
@@ -244,7 +280,6 @@ func (h *Handler) HandleProjects(w http.ResponseWriter, r *http.Request) {
 		AccountID:            account.ID,
 		FilterOrganizationID: sql.NullInt64{},
 		Limit:                100,
-		Offset:               0,
 	})
 	if err != nil {
 		slog.Error("Failed to list projects", "account_id", account.ID, "err", err)
@@ -305,7 +340,6 @@ func (h *Handler) HandleSites(w http.ResponseWriter, r *http.Request) {
 		FilterOrganizationID: sql.NullInt64{},
 		FilterProjectID:      sql.NullInt64{},
 		Limit:                100,
-		Offset:               0,
 	})
 	if err != nil {
 		slog.Error("Failed to list sites", "err", err)
@@ -470,68 +504,6 @@ func (h *Handler) HandleFirewall(w http.ResponseWriter, r *http.Request) {
 	RenderFirewall(w, data)
 }
 
-// HandleMembers handles requests to the members page
-func (h *Handler) HandleMembers(w http.ResponseWriter, r *http.Request) {
-	userInfo, ok := auth.GetUserFromContext(r.Context())
-	if !ok || userInfo == nil {
-		http.Redirect(w, r, "/login", http.StatusSeeOther)
-		return
-	}
-
-	ctx := context.Background()
-	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
-	if err != nil {
-		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-		return
-	}
-
-	name := ""
-	if account.Name.Valid {
-		name = account.Name.String
-	}
-
-	// Fetch memberships for the user
-	dbMemberships, err := h.db.ListUserMemberships(ctx, db.ListUserMembershipsParams{
-		AccountID: account.ID,
-		Limit:     100,
-		Offset:    0,
-	})
-	if err != nil {
-		slog.Error("Failed to list user memberships", "account_id", account.ID, "err", err)
-		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-		return
-	}
-
-	items := make([]ResourceItem, 0, len(dbMemberships))
-	for _, member := range dbMemberships {
-		createdAt := ""
-		if member.CreatedAt.Valid {
-			createdAt = member.CreatedAt.Time.Format("2006-01-02")
-		}
-		items = append(items, ResourceItem{
-			ID:          member.PublicID, // This is the public_id of the membership record
-			Name:        member.Email,    // The email of the member
-			Description: member.UserName.String + " (" + string(member.Role) + ")",
-			Status:      string(member.Status.OrganizationMembersStatus),
-			CreatedAt:   createdAt,
-			ParentName:  member.ParentName,
-			ParentID:    member.ParentPublicID,
-			ParentType:  member.ParentType,
-		})
-	}
-
-	data := ResourcePageData{
-		Email:        account.Email,
-		Name:         name,
-		ActivePage:   "members",
-		ResourceName: "Members",
-		Items:        items,
-	}
-
-	RenderMembers(w, data)
-}
-
 // HandleOrganizationDetail handles requests to individual organization detail pages
 func (h *Handler) HandleOrganizationDetail(w http.ResponseWriter, r *http.Request) {
 	userInfo, ok := auth.GetUserFromContext(r.Context())
@@ -597,6 +569,7 @@ func (h *Handler) HandleOrganizationDetail(w http.ResponseWriter, r *http.Reques
 		slog.Error("Failed to list memberships", "org_id", orgID, "err", err)
 	}
 
+	scope := h.newMemberRoleScope(r.Context(), userInfo)
 	members := make([]Member, 0)
 	for _, membership := range dbMemberships {
 		// Only include memberships for this specific organization
@@ -604,22 +577,7 @@ func (h *Handler) HandleOrganizationDetail(w http.ResponseWriter, r *http.Reques
 			continue
 		}
 
-		// Check if user can edit/delete this membership
-		canEdit := h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-		canDelete := h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-
-		members = append(members, Member{
-			MemberID:   membership.AccountPublicID, // Use account_id for API endpoints
-			Email:      membership.Email,
-			Role:       string(membership.Role),
-			ParentName: membership.ParentName,
-			ParentID:   membership.ParentPublicID,
-			ParentType: membership.ParentType,
-			Permissions: ResourcePermissions{
-				CanEdit:   canEdit,
-				CanDelete: canDelete,
-			},
-		})
+		members = append(members, scope.member(membership))
 	}
 
 	// Get firewall rules with inheritance (includes relationships)
@@ -739,8 +697,10 @@ func (h *Handler) HandleOrganizationDetail(w http.ResponseWriter, r *http.Reques
 		})
 	}
 
-	// TODO: Get audit log entries
-	auditLog := []AuditLogEntry{}
+	channels := h.organizationNotificationChannels(r.Context(), userInfo, org.ID, org.PublicID)
+	encryptionKeys := h.organizationEncryptionKeys(ctx, userInfo, org.ID, org.PublicID)
+
+	auditLog := h.recentActivity(ctx, db.ListAuditEventsParams{OrganizationID: sql.NullInt64{Int64: org.ID, Valid: true}}, h.preferences(ctx, userInfo.AccountID).location)
 
 	data := OrganizationDetailData{
 		Email:      account.Email,
@@ -750,18 +710,114 @@ func (h *Handler) HandleOrganizationDetail(w http.ResponseWriter, r *http.Reques
 			ID:          org.PublicID,
 			Name:        org.Name,
 			Description: "",
+			Labels:      service.FromJSONLabels(org.Labels),
 		},
-		Projects:      projects,
-		Members:       members,
-		FirewallRules: firewallRules,
-		Secrets:       secrets,
-		Settings:      settings,
-		AuditLog:      auditLog,
+		Projects:       projects,
+		Members:        members,
+		MemberRoles:    scope.rolesFor("organization", org.PublicID),
+		FirewallRules:  firewallRules,
+		Secrets:        secrets,
+		Settings:       settings,
+		Channels:       channels,
+		EncryptionKeys: encryptionKeys,
+		AuditLog:       auditLog,
+		Branding:       h.branding(ctx, org.ID, org.PublicID),
+		CanViewFleet:   h.canUserPerformOnOrganization(ctx, userInfo, org.PublicID, auth.PermissionOwner),
+		CanCheckKeys:   h.canUserPerformOnOrganization(ctx, userInfo, org.PublicID, auth.PermissionWrite),
+		CanManageKeys:  h.canUserPerformOnOrganization(ctx, userInfo, org.PublicID, auth.PermissionOwner),
 	}
 
 	RenderOrganizationDetail(w, data)
 }
 
+// organizationEncryptionKeys lists an organization's customer-managed keys with
+// how each was last found
+func (h *Handler) organizationEncryptionKeys(ctx context.Context, userInfo *auth.UserInfo, orgID int64, orgPublicID string) []EncryptionKey {
+	rows, err := h.db.ListOrganizationEncryptionKeys(ctx, orgID)
+	if err != nil {
+		slog.Error("Failed to list encryption keys", "org_id", orgPublicID, "err", err)
+	}
+
+	location := h.preferences(ctx, userInfo.AccountID).location
+	keys := make([]EncryptionKey, 0, len(rows))
+	for _, row := range rows {
+		checkedAt := ""
+		if row.CheckedAt.Valid {
+			checkedAt = row.CheckedAt.Time.In(location).Format("2006-01-02 15:04")
+		}
+		keys = append(keys, EncryptionKey{
+			Location:      row.Location,
+			KmsKeyName:    row.KmsKeyName,
+			Status:        string(row.Status),
+			StatusMessage: row.StatusMessage.String,
+			CheckedAt:     checkedAt,
+		})
+	}
+	return keys
+}
+
+// notificationChannelKindLabels names channel kinds on the organization page
+var notificationChannelKindLabels = map[libopsv1.NotificationChannelKind]string{
+	libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK: "Slack webhook",
+	libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_APP:     "Slack app",
+	libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK: "Teams webhook",
+	libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_PAGERDUTY:     "PagerDuty",
+	libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_OPSGENIE:      "Opsgenie",
+}
+
+// alertCategoryLabels names alert categories on the organization page
+var alertCategoryLabels = map[libopsv1.AlertCategory]string{
+	libopsv1.AlertCategory_ALERT_CATEGORY_DEPLOYMENTS:             "Deployments",
+	libopsv1.AlertCategory_ALERT_CATEGORY_RECONCILIATION_FAILURES: "Reconciliation failures",
+	libopsv1.AlertCategory_ALERT_CATEGORY_BILLING:                 "Billing",
+	libopsv1.AlertCategory_ALERT_CATEGORY_SECURITY:                "Security",
+	libopsv1.AlertCategory_ALERT_CATEGORY_DOWNTIME:                "Downtime",
+}
+
+// organizationNotificationChannels lists an organization's alert channels with their credentials redacted
+func (h *Handler) organizationNotificationChannels(ctx context.Context, userInfo *auth.UserInfo, orgID int64, orgPublicID string) []NotificationChannel {
+	dbChannels, err := h.db.ListNotificationChannels(ctx, db.ListNotificationChannelsParams{
+		OrganizationID: orgID,
+		Limit:          100,
+		Offset:         0,
+	})
+	if err != nil {
+		slog.Error("Failed to list notification channels", "org_id", orgPublicID, "err", err)
+	}
+
+	canEdit := h.canUserPerformOnOrganization(ctx, userInfo, orgPublicID, auth.PermissionWrite)
+	channels := make([]NotificationChannel, 0, len(dbChannels))
+	for _, row := range dbChannels {
+		channel := organization.NotificationChannelToProto(db.GetNotificationChannelRow(row), orgPublicID)
+
+		categories := make([]string, 0, len(channel.Categories))
+		for _, category := range channel.Categories {
+			categories = append(categories, alertCategoryLabels[category])
+		}
+
+		lastDeliveryAt := ""
+		if channel.LastDeliveryAt != 0 {
+			lastDeliveryAt = time.Unix(channel.LastDeliveryAt, 0).Format("2006-01-02 15:04")
+		}
+
+		channels = append(channels, NotificationChannel{
+			ID:             channel.ChannelId,
+			Name:           channel.Name,
+			Kind:           notificationChannelKindLabels[channel.Kind],
+			Destination:    channel.Destination,
+			Categories:     categories,
+			Enabled:        channel.Enabled,
+			LastDeliveryAt: lastDeliveryAt,
+			LastError:      channel.LastError,
+			Permissions: ResourcePermissions{
+				CanEdit:   canEdit,
+				CanDelete: canEdit,
+			},
+		})
+	}
+	return channels
+}
+
 // HandleProjectDetail handles requests to individual project detail pages
 func (h *Handler) HandleProjectDetail(w http.ResponseWriter, r *http.Request) {
 	userInfo, ok := auth.GetUserFromContext(r.Context())
@@ -851,6 +907,7 @@ func (h *Handler) HandleProjectDetail(w http.ResponseWriter, r *http.Request) {
 		slog.Error("Failed to list memberships", "project_id", projectID, "err", err)
 	}
 
+	scope := h.newMemberRoleScope(r.Context(), userInfo)
 	members := make([]Member, 0)
 	for _, membership := range dbMemberships {
 		// Include memberships for this project OR its parent organization
@@ -858,28 +915,7 @@ func (h *Handler) HandleProjectDetail(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
-		// Check permissions - use project or org depending on where the membership is
-		var canEdit, canDelete bool
-		if membership.ParentType == "project" {
-			canEdit = h.canUserPerformOnProject(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-			canDelete = h.canUserPerformOnProject(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-		} else {
-			canEdit = h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-			canDelete = h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-		}
-
-		members = append(members, Member{
-			MemberID:   membership.AccountPublicID, // Use account_id for API endpoints
-			Email:      membership.Email,
-			Role:       string(membership.Role),
-			ParentName: membership.ParentName,
-			ParentID:   membership.ParentPublicID,
-			ParentType: membership.ParentType,
-			Permissions: ResourcePermissions{
-				CanEdit:   canEdit,
-				CanDelete: canDelete,
-			},
-		})
+		members = append(members, scope.member(membership))
 	}
 
 	// Get firewall rules with inheritance (includes org + project rules)
@@ -1023,8 +1059,7 @@ func (h *Handler) HandleProjectDetail(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	// TODO: Get audit log entries
-	auditLog := []AuditLogEntry{}
+	auditLog := h.recentActivity(ctx, db.ListAuditEventsParams{ProjectID: sql.NullInt64{Int64: project.ID, Valid: true}}, h.preferences(ctx, userInfo.AccountID).location)
 
 	projectStatus := ""
 	if project.Status.Valid {
@@ -1044,13 +1079,16 @@ func (h *Handler) HandleProjectDetail(w http.ResponseWriter, r *http.Request) {
 			ParentID:    orgPublicID,
 			ParentName:  org.Name,
 			ParentType:  "organization",
+			Labels:      service.FromJSONLabels(project.Labels),
 		},
 		Sites:         sites,
 		Members:       members,
+		MemberRoles:   scope.rolesFor("project", project.PublicID),
 		FirewallRules: firewallRules,
 		Secrets:       secrets,
 		Settings:      settings,
 		AuditLog:      auditLog,
+		Branding:      h.branding(ctx, org.ID, orgPublicID),
 	}
 
 	RenderProjectDetail(w, data)
@@ -1120,6 +1158,7 @@ func (h *Handler) HandleSiteDetail(w http.ResponseWriter, r *http.Request) {
 		slog.Error("Failed to list memberships", "site_id", siteID, "err", err)
 	}
 
+	scope := h.newMemberRoleScope(r.Context(), userInfo)
 	members := make([]Member, 0)
 	for _, membership := range dbMemberships {
 		// Include memberships for this site OR its parent project OR parent organization
@@ -1127,33 +1166,9 @@ func (h *Handler) HandleSiteDetail(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
-		// Check permissions based on where the membership is
-		var canEdit, canDelete bool
-		switch membership.ParentType {
-		case "site":
-			canEdit = h.canUserPerformOnSite(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-			canDelete = h.canUserPerformOnSite(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-		case "project":
-			canEdit = h.canUserPerformOnProject(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-			canDelete = h.canUserPerformOnProject(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-		case "organization":
-			canEdit = h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionWrite)
-			canDelete = h.canUserPerformOnOrganization(r.Context(), userInfo, membership.ParentPublicID, auth.PermissionOwner)
-		}
-
-		members = append(members, Member{
-			MemberID:   membership.AccountPublicID, // Use account_id for API endpoints
-			Email:      membership.Email,
-			Role:       string(membership.Role),
-			ParentName: membership.ParentName,
-			ParentID:   membership.ParentPublicID,
-			ParentType: membership.ParentType,
-			Permissions: ResourcePermissions{
-				CanEdit:   canEdit,
-				CanDelete: canDelete,
-			},
-		})
+		members = append(members, scope.member(membership))
 	}
+	h.setSshAccess(ctx, site.ID, members)
 
 	// Get firewall rules with inheritance (includes org + project + site rules)
 	dbRules, err := h.db.ListUserFirewallRules(ctx, db.ListUserFirewallRulesParams{
@@ -1308,8 +1323,8 @@ func (h *Handler) HandleSiteDetail(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	// TODO: Get audit log entries
-	auditLog := []AuditLogEntry{}
+	prefs := h.preferences(ctx, userInfo.AccountID)
+	auditLog := h.recentActivity(ctx, db.ListAuditEventsParams{SiteID: sql.NullInt64{Int64: site.ID, Valid: true}}, prefs.location)
 
 	status := ""
 	if site.Status.Valid {
@@ -1320,6 +1335,35 @@ func (h *Handler) HandleSiteDetail(w http.ResponseWriter, r *http.Request) {
 		createdAt = site.CreatedAt.Time.Format("2006-01-02")
 	}
 
+	var staticEgressIP *StaticEgressIP
+	egress, err := h.db.GetSiteStaticEgressIp(ctx, site.ID)
+	switch {
+	case err == nil:
+		staticEgressIP = &StaticEgressIP{IPAddress: egress.IpAddress.String, Status: string(egress.Status)}
+	case !errors.Is(err, sql.ErrNoRows):
+		slog.Error("Failed to get static egress IP", "site_id", siteID, "err", err)
+	}
+
+	var cdn *SiteCDN
+	cdnConfig, err := h.db.GetSiteCdnConfig(ctx, site.ID)
+	switch {
+	case err == nil:
+		cdn = &SiteCDN{
+			IPAddress:  cdnConfig.IpAddress.String,
+			Status:     string(cdnConfig.Status),
+			CacheMode:  string(cdnConfig.CacheMode),
+			DefaultTTL: cdnConfig.DefaultTtlSeconds,
+		}
+		if purges, err := h.db.ListPendingSiteCachePurges(ctx, site.ID); err == nil {
+			cdn.PendingPurges = len(purges)
+		} else {
+			slog.Error("Failed to list pending cache purges", "site_id", siteID, "err", err)
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		slog.Error("Failed to get site CDN", "site_id", siteID, "err", err)
+	}
+
+	canWrite := h.canUserPerformOnSite(r.Context(), userInfo, site.PublicID, auth.PermissionWrite)
 	data := SiteDetailData{
 		Email:          account.Email,
 		Name:           name,
@@ -1333,12 +1377,26 @@ func (h *Handler) HandleSiteDetail(w http.ResponseWriter, r *http.Request) {
 			Status:      status,
 			CreatedAt:   createdAt,
 			ParentID:    projectPublicID,
+			Labels:      service.FromJSONLabels(site.Labels),
 		},
-		Members:       members,
-		FirewallRules: firewallRules,
-		Secrets:       secrets,
-		Settings:      settings,
-		AuditLog:      auditLog,
+		Members:         members,
+		MemberRoles:     scope.rolesFor("site", site.PublicID),
+		FirewallRules:   firewallRules,
+		Secrets:         secrets,
+		ConfigVars:      h.siteConfigVars(ctx, site.ID, canWrite, prefs.location),
+		Elevations:      h.siteElevations(ctx, site.ID, account.ID, h.canUserPerformOnSite(r.Context(), userInfo, site.PublicID, auth.PermissionOwner), prefs.location),
+		Settings:        settings,
+		AuditLog:        auditLog,
+		Uptime:          h.siteUptime(ctx, site.ID, site.PublicID, canWrite, prefs.location),
+		StaticEgressIP:  staticEgressIP,
+		CDN:             cdn,
+		Deployments:     h.siteDeployments(ctx, site.PublicID, canWrite, prefs.location),
+		Reconciliations: h.siteReconciliations(ctx, site.ID, prefs.location),
+		GitRef:          site.GithubRef,
+		CanDeploy:       canWrite,
+		CanUseTerminal:  canWrite && site.GcpExternalIp.Valid && site.GcpExternalIp.String != "",
+		Locale:          i18n.Negotiate(prefs.locale, r.Header.Get("Accept-Language")),
+		Branding:        h.branding(ctx, org.ID, orgPublicID),
 	}
 
 	RenderSiteDetail(w, data)
@@ -1369,6 +1427,7 @@ func (h *Handler) HandleAPIKeys(w http.ResponseWriter, r *http.Request) {
 		Email:      account.Email,
 		Name:       name,
 		ActivePage: "api-keys",
+		Templates:  auth.KeyTemplates(),
 	}
 
 	RenderAPIKeys(w, data)
@@ -1405,6 +1464,132 @@ func (h *Handler) HandleSSHKeys(w http.ResponseWriter, r *http.Request) {
 	RenderSSHKeys(w, data)
 }
 
+// HandleBilling handles requests to the billing page.
+// Only organizations the user owns are listed, since billing RPCs require admin access.
+func (h *Handler) HandleBilling(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	ctx := context.Background()
+	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	dbOrgs, err := h.db.ListUserOrganizations(ctx, db.ListUserOrganizationsParams{
+		AccountID: account.ID,
+		Limit:     100,
+	})
+	if err != nil {
+		slog.Error("Failed to list organizations for billing", "account_id", account.ID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	organizations := make([]Organization, 0, len(dbOrgs))
+	for _, org := range dbOrgs {
+		if !h.canUserPerformOnOrganization(r.Context(), userInfo, org.PublicID, auth.PermissionOwner) {
+			continue
+		}
+		organizations = append(organizations, Organization{
+			ID:   org.PublicID,
+			Name: org.Name,
+			Role: string(org.Role),
+		})
+	}
+
+	selected := r.URL.Query().Get("organization_id")
+	found := false
+	for _, org := range organizations {
+		if org.ID == selected {
+			found = true
+			break
+		}
+	}
+	if !found && len(organizations) > 0 {
+		selected = organizations[0].ID
+	}
+
+	name := ""
+	if account.Name.Valid {
+		name = account.Name.String
+	}
+
+	RenderBilling(w, BillingPageData{
+		Email:                  account.Email,
+		Name:                   name,
+		Organizations:          organizations,
+		SelectedOrganizationID: selected,
+	})
+}
+
+// HandleNewSite handles requests to the "add another site" wizard
+func (h *Handler) HandleNewSite(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	ctx := context.Background()
+	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	dbOrgs, err := h.db.ListUserOrganizations(ctx, db.ListUserOrganizationsParams{
+		AccountID: account.ID,
+		Limit:     100,
+	})
+	if err != nil {
+		slog.Error("Failed to list organizations for new site", "account_id", account.ID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	// The wizard only offers the projects the user can write to in the selected organization
+	organizations := make([]Organization, 0, len(dbOrgs))
+	for _, org := range dbOrgs {
+		organizations = append(organizations, Organization{
+			ID:   org.PublicID,
+			Name: org.Name,
+			Role: string(org.Role),
+		})
+	}
+
+	selected := r.URL.Query().Get("organization_id")
+	found := false
+	for _, org := range organizations {
+		if org.ID == selected {
+			found = true
+			break
+		}
+	}
+	if !found && len(organizations) > 0 {
+		selected = organizations[0].ID
+	}
+
+	name := ""
+	if account.Name.Valid {
+		name = account.Name.String
+	}
+
+	RenderNewSite(w, NewSitePageData{
+		Email:                  account.Email,
+		Name:                   name,
+		Organizations:          organizations,
+		SelectedOrganizationID: selected,
+		SelectedProjectID:      r.URL.Query().Get("project_id"),
+	})
+}
+
 // HandleSettings handles requests to the settings page
 func (h *Handler) HandleSettings(w http.ResponseWriter, r *http.Request) {
 	userInfo, ok := auth.GetUserFromContext(r.Context())
@@ -0,0 +1,212 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
+)
+
+// memberRoles are the roles a member can hold, most privileged first
+var memberRoles = []string{"owner", "developer", "read"}
+
+// memberRoleScope works out which roles the signed-in user may hand out on each
+// organization, project or site. Managing members takes owner access, which owners of
+// a parent resource inherit, so the answer is either every role or none. Answers are
+// cached because a page checks the same parent for each of its members.
+type memberRoleScope struct {
+	h        *Handler
+	ctx      context.Context
+	userInfo *auth.UserInfo
+	cache    map[string][]string
+}
+
+func (h *Handler) newMemberRoleScope(ctx context.Context, userInfo *auth.UserInfo) *memberRoleScope {
+	return &memberRoleScope{h: h, ctx: ctx, userInfo: userInfo, cache: map[string][]string{}}
+}
+
+// rolesFor returns the roles the user may assign on the resource, nil when they can't
+// manage its members
+func (s *memberRoleScope) rolesFor(resourceType, resourceID string) []string {
+	key := resourceType + ":" + resourceID
+	if roles, ok := s.cache[key]; ok {
+		return roles
+	}
+
+	var owner bool
+	switch resourceType {
+	case "organization":
+		owner = s.h.canUserPerformOnOrganization(s.ctx, s.userInfo, resourceID, auth.PermissionOwner)
+	case "project":
+		owner = s.h.canUserPerformOnProject(s.ctx, s.userInfo, resourceID, auth.PermissionOwner)
+	case "site":
+		owner = s.h.canUserPerformOnSite(s.ctx, s.userInfo, resourceID, auth.PermissionOwner)
+	}
+
+	var roles []string
+	if owner {
+		roles = memberRoles
+	}
+	s.cache[key] = roles
+	return roles
+}
+
+// member converts a membership row, offering role changes and removal only when the
+// user can manage members of the membership's resource
+func (s *memberRoleScope) member(membership db.ListUserMembershipsRow) Member {
+	roles := s.rolesFor(membership.ParentType, membership.ParentPublicID)
+	status := "active"
+	if membership.Status.Valid {
+		status = string(membership.Status.OrganizationMembersStatus)
+	}
+	return Member{
+		MemberID:   membership.AccountPublicID, // Use account_id for API endpoints
+		Email:      membership.Email,
+		Name:       membership.UserName.String,
+		AvatarURL:  avatar.Path(membership.AccountPublicID, membership.AvatarObject),
+		Role:       string(membership.Role),
+		Status:     status,
+		ParentName: membership.ParentName,
+		ParentID:   membership.ParentPublicID,
+		ParentType: membership.ParentType,
+		Roles:      roles,
+		Permissions: ResourcePermissions{
+			CanEdit:   len(roles) > 0,
+			CanDelete: len(roles) > 0,
+		},
+	}
+}
+
+// setSshAccess records the SSH access members have to a site. Roles above
+// read come with a shell; read members only have the access they're granted.
+func (h *Handler) setSshAccess(ctx context.Context, siteID int64, members []Member) {
+	grants, err := h.db.ListSiteSshAccess(ctx, db.ListSiteSshAccessParams{SiteID: siteID, Limit: 1000})
+	if err != nil {
+		slog.Error("Failed to list SSH access", "site_id", siteID, "err", err)
+	}
+	sftpOnly := make(map[string]bool, len(grants))
+	for _, grant := range grants {
+		sftpOnly[grant.AccountPublicID] = grant.SftpOnly
+	}
+
+	for i := range members {
+		member := &members[i]
+		granted, ok := sftpOnly[member.MemberID]
+		switch {
+		case member.Role != string(db.SiteMembersRoleRead):
+			member.SshAccess = "shell"
+		case member.ParentType != "site" || !ok:
+			member.SshAccess = "none"
+		case granted:
+			member.SshAccess = "sftp"
+		default:
+			member.SshAccess = "shell"
+		}
+	}
+}
+
+// HandleMembers handles requests to the members page
+func (h *Handler) HandleMembers(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	ctx := context.Background()
+	account, err := h.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	name := ""
+	if account.Name.Valid {
+		name = account.Name.String
+	}
+
+	// Fetch memberships for the user
+	dbMemberships, err := h.db.ListUserMemberships(ctx, db.ListUserMembershipsParams{
+		AccountID: account.ID,
+		Limit:     100,
+		Offset:    0,
+	})
+	if err != nil {
+		slog.Error("Failed to list user memberships", "account_id", account.ID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	scope := h.newMemberRoleScope(r.Context(), userInfo)
+	members := make([]Member, 0, len(dbMemberships))
+	for _, membership := range dbMemberships {
+		members = append(members, scope.member(membership))
+	}
+
+	targets := h.memberTargets(ctx, account.ID, scope)
+	data := MembersPageData{
+		Email:   account.Email,
+		Name:    name,
+		Members: members,
+		Targets: targets,
+	}
+	if len(targets) > 0 {
+		data.MemberRoles = memberRoles
+	}
+
+	RenderMembers(w, data)
+}
+
+// memberTargets lists the organizations, projects and sites the user can add members to
+func (h *Handler) memberTargets(ctx context.Context, accountID int64, scope *memberRoleScope) []MemberTarget {
+	targets := make([]MemberTarget, 0)
+
+	orgs, err := h.db.ListUserOrganizations(ctx, db.ListUserOrganizationsParams{
+		AccountID: accountID,
+		Limit:     100,
+	})
+	if err != nil {
+		slog.Error("Failed to list organizations", "account_id", accountID, "err", err)
+	}
+	for _, org := range orgs {
+		if len(scope.rolesFor("organization", org.PublicID)) > 0 {
+			targets = append(targets, MemberTarget{Type: "organization", ID: org.PublicID, Name: org.Name})
+		}
+	}
+
+	projects, err := h.db.ListUserProjectsWithOrg(ctx, db.ListUserProjectsWithOrgParams{
+		AccountID:            accountID,
+		FilterOrganizationID: sql.NullInt64{},
+		Limit:                100,
+	})
+	if err != nil {
+		slog.Error("Failed to list projects", "account_id", accountID, "err", err)
+	}
+	for _, project := range projects {
+		if len(scope.rolesFor("project", project.PublicID)) > 0 {
+			targets = append(targets, MemberTarget{Type: "project", ID: project.PublicID, Name: project.Name})
+		}
+	}
+
+	sites, err := h.db.ListUserSitesWithProject(ctx, db.ListUserSitesWithProjectParams{
+		AccountID:            accountID,
+		FilterOrganizationID: sql.NullInt64{},
+		FilterProjectID:      sql.NullInt64{},
+		Limit:                100,
+	})
+	if err != nil {
+		slog.Error("Failed to list sites", "account_id", accountID, "err", err)
+	}
+	for _, site := range sites {
+		if len(scope.rolesFor("site", site.PublicID)) > 0 {
+			targets = append(targets, MemberTarget{Type: "site", ID: site.PublicID, Name: site.Name})
+		}
+	}
+
+	return targets
+}
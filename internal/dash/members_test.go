@@ -0,0 +1,65 @@
+package dash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestMemberRoleScope tests that role pickers are only offered on organizations the
+// user owns, and that each organization is checked once per page.
+func TestMemberRoleScope(t *testing.T) {
+	const ownedOrg = "0194d3a0-0000-7000-8000-000000000001"
+	const readOrg = "0194d3a0-0000-7000-8000-000000000002"
+
+	lookups := 0
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			lookups++
+			if publicID == ownedOrg {
+				return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+			}
+			return db.GetOrganizationRow{ID: 2, PublicID: publicID}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			if arg.OrganizationID == 1 {
+				return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleOwner}, nil
+			}
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+	}
+	scope := NewHandler(mock, nil).newMemberRoleScope(context.Background(), &auth.UserInfo{AccountID: 5})
+
+	owned := scope.member(db.ListUserMembershipsRow{
+		AccountPublicID: "account-1",
+		Email:           "ada@example.org",
+		Role:            db.OrganizationMembersRoleDeveloper,
+		Status:          db.NullOrganizationMembersStatus{OrganizationMembersStatus: db.OrganizationMembersStatusProvisioning, Valid: true},
+		ParentType:      "organization",
+		ParentPublicID:  ownedOrg,
+	})
+	assert.Equal(t, []string{"owner", "developer", "read"}, owned.Roles)
+	assert.Equal(t, "provisioning", owned.Status)
+	assert.True(t, owned.Permissions.CanEdit)
+	assert.True(t, owned.Permissions.CanDelete)
+
+	readOnly := scope.member(db.ListUserMembershipsRow{
+		AccountPublicID: "account-2",
+		Role:            db.OrganizationMembersRoleRead,
+		ParentType:      "organization",
+		ParentPublicID:  readOrg,
+	})
+	assert.Empty(t, readOnly.Roles)
+	assert.Equal(t, "active", readOnly.Status)
+	assert.False(t, readOnly.Permissions.CanEdit)
+	assert.False(t, readOnly.Permissions.CanDelete)
+
+	assert.Equal(t, []string{"owner", "developer", "read"}, scope.rolesFor("organization", ownedOrg))
+	assert.Empty(t, scope.rolesFor("organization", readOrg))
+	assert.Equal(t, 2, lookups, "answers are cached per organization")
+}
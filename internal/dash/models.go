@@ -1,5 +1,10 @@
 package dash
 
+import (
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/branding"
+)
+
 // LoginPageData holds data for the login page template.
 type LoginPageData struct {
 	Message       string
@@ -7,6 +12,19 @@ type LoginPageData struct {
 	Verified      bool
 	RedirectURI   string
 	State         string
+	Locale        string // Language the page is rendered in, see internal/i18n
+	IsDevelopment bool
+}
+
+// AccountRecoveryPageData holds data for the email verification and password
+// reset pages.
+type AccountRecoveryPageData struct {
+	Mode          string // verify_email, forgot_password or reset_password
+	Email         string
+	Token         string // Password reset token from the emailed link
+	Message       string
+	Error         string
+	Locale        string
 	IsDevelopment bool
 }
 
@@ -33,6 +51,7 @@ type Organization struct {
 	Name        string
 	Description string
 	Role        string
+	Labels      map[string]string
 }
 
 // ResourcePageData holds data for resource pages (organizations, projects, sites, etc.)
@@ -42,6 +61,7 @@ type ResourcePageData struct {
 	ActivePage    string
 	ResourceName  string // e.g., "Organizations", "Projects"
 	Items         []ResourceItem
+	ExportPath    string // Download path for the list as CSV or JSON, empty when it cannot be exported
 	IsDevelopment bool
 }
 
@@ -56,22 +76,30 @@ type ResourceItem struct {
 	ParentName  string
 	ParentID    string
 	ParentType  string // "organization", "project", or "site"
+	Labels      map[string]string
 	Permissions ResourcePermissions
 }
 
 // OrganizationDetailData holds data for the organization detail page
 type OrganizationDetailData struct {
-	Email         string
-	Name          string
-	ActivePage    string
-	Organization  Organization
-	Projects      []ResourceItem
-	Members       []Member
-	FirewallRules []ResourceItem
-	Secrets       []ResourceItem
-	Settings      []Setting
-	AuditLog      []AuditLogEntry
-	IsDevelopment bool
+	Email          string
+	Name           string
+	ActivePage     string
+	Organization   Organization
+	Projects       []ResourceItem
+	Members        []Member
+	MemberRoles    []string // Roles the user may assign here, empty when they can't manage members
+	FirewallRules  []ResourceItem
+	Secrets        []ResourceItem
+	Settings       []Setting
+	Channels       []NotificationChannel
+	EncryptionKeys []EncryptionKey
+	AuditLog       []AuditLogEntry
+	Branding       *branding.Branding // Nil keeps the libops look
+	CanViewFleet   bool               // Owners can see the health of the organization's site VMs
+	CanCheckKeys   bool               // Developers can check the encryption keys again
+	CanManageKeys  bool               // Owners set and remove encryption keys
+	IsDevelopment  bool
 }
 
 // ProjectDetailData holds data for the project detail page
@@ -82,40 +110,223 @@ type ProjectDetailData struct {
 	Project       ResourceItem
 	Sites         []ResourceItem
 	Members       []Member
+	MemberRoles   []string // Roles the user may assign here, empty when they can't manage members
 	FirewallRules []ResourceItem
 	Secrets       []ResourceItem
 	Settings      []Setting
 	AuditLog      []AuditLogEntry
+	Branding      *branding.Branding // The organization's, nil keeps the libops look
 	IsDevelopment bool
 }
 
 // SiteDetailData holds data for the site detail page
 type SiteDetailData struct {
-	Email          string
-	Name           string
-	ActivePage     string
-	Site           ResourceItem
-	OrganizationID string
-	ProjectID      string
-	Members        []Member
-	FirewallRules  []ResourceItem
-	Secrets        []ResourceItem
-	Settings       []Setting
-	AuditLog       []AuditLogEntry
-	IsDevelopment  bool
+	Email           string
+	Name            string
+	ActivePage      string
+	Site            ResourceItem
+	OrganizationID  string
+	ProjectID       string
+	Members         []Member
+	MemberRoles     []string // Roles the user may assign here, empty when they can't manage members
+	FirewallRules   []ResourceItem
+	Secrets         []ResourceItem
+	ConfigVars      SiteConfigVars
+	Elevations      SiteElevations
+	Settings        []Setting
+	AuditLog        []AuditLogEntry
+	Uptime          *SiteUptime
+	StaticEgressIP  *StaticEgressIP // Nil when the site's outbound address is ephemeral
+	CDN             *SiteCDN        // Nil when the site has no CDN
+	Deployments     []Deployment
+	Reconciliations []ReconciliationTimeline // Nil when they couldn't be loaded
+	GitRef          string                   // Ref the site deploys by default
+	CanDeploy       bool
+	CanUseTerminal  bool               // Developers can open a shell once the site has a VM
+	Locale          string             // Language the page is rendered in, see internal/i18n
+	Branding        *branding.Branding // The organization's, nil keeps the libops look
+	IsDevelopment   bool
+}
+
+// SiteConfigVars are a site's plaintext environment variables and their
+// latest changes
+type SiteConfigVars struct {
+	Vars     []ConfigVar
+	History  []ConfigVarChange // Newest first
+	Revision int64             // The site's latest revision, 0 before its first change
+	CanEdit  bool
+}
+
+// ConfigVar is one of a site's plaintext environment variables
+type ConfigVar struct {
+	Name     string
+	Value    string
+	Revision int64 // The revision that last set it
+}
+
+// ConfigVarChange is one revision of a site's config vars
+type ConfigVarChange struct {
+	Revision      int64
+	Name          string
+	Change        string // "added", "changed", or "removed"
+	PreviousValue string
+	Value         string
+	ChangedBy     string // Email, empty once the account is gone
+	ChangedAt     string
+}
+
+// SiteElevations are the latest requests for just-in-time elevated access
+// to a site
+type SiteElevations struct {
+	Requests   []Elevation // Newest first
+	CanRequest bool        // Anyone but an owner can ask for more access
+	CanApprove bool        // Owners approve, reject and revoke
+}
+
+// Elevation is one request for a higher role on a site for a limited time
+type Elevation struct {
+	ID              string
+	Email           string
+	Name            string
+	AvatarURL       string // Empty without an avatar
+	Role            string // "owner" or "developer"
+	Reason          string
+	DurationMinutes int32
+	Status          string // "pending", "approved", "rejected", "revoked", or "expired"
+	ResolvedBy      string // Email of who approved, rejected or revoked it
+	RequestedAt     string
+	ExpiresAt       string // Empty until approved
+	Own             bool   // The viewer requested it, so they can't approve it
+}
+
+// StaticEgressIP is the reserved address a site's outbound traffic leaves from
+type StaticEgressIP struct {
+	IPAddress string // Empty until a reconciliation reserves it
+	Status    string // "provisioning", "active", or "releasing"
+}
+
+// SiteCDN is the Cloud CDN load balancer in front of a site
+type SiteCDN struct {
+	IPAddress     string // Empty until a reconciliation builds the load balancer
+	Status        string // "provisioning", "active", or "disabling"
+	CacheMode     string
+	DefaultTTL    int32
+	PendingPurges int
+}
+
+// Deployment is one row of a site's deployment history
+type Deployment struct {
+	ID          string
+	Ref         string
+	Commit      string // Short commit SHA, empty when unknown
+	Message     string // First line of the commit message
+	Status      string // "pending", "in_progress", "success", or "failed"
+	RunURL      string
+	Error       string
+	RollbackOf  string // Deployment this one rolled back to
+	StartedAt   string
+	Duration    string
+	CanRollback bool
+}
+
+// ReconciliationTimeline is a site's latest VM reconciliations of one type
+type ReconciliationTimeline struct {
+	Type string // "ssh_keys", "secrets", or "firewall"
+	Runs []ReconciliationRun
+}
+
+// ReconciliationRun is one attempt to apply changes to a site's VM
+type ReconciliationRun struct {
+	Status    string // "pending", "running", "succeeded", or "failed"
+	Full      bool   // The run applied every type
+	Error     string
+	StartedAt string
+	Duration  string
+	Attempt   int32  // 1 for the first try, counting up with each automatic retry
+	RetryAt   string // When a failed run is retried; empty unless one is scheduled
+	GaveUp    bool   // The run failed on its last attempt and won't be retried
+}
+
+// SiteUptime holds a site's probe results over the last day
+type SiteUptime struct {
+	Percent           string
+	Checks            int32
+	AverageResponseMs int32
+	HealthCheckPath   string
+	LastProbe         string
+	LastProbeUp       bool
+	Bars              []UptimeBar
+	CanEdit           bool
+}
+
+// UptimeBar is one hour of the uptime graph
+type UptimeBar struct {
+	State string // "up", "degraded", "down" or "none"
+	Title string // Tooltip
+}
+
+// StatusPageData holds data for an organization's public status page
+type StatusPageData struct {
+	Title        string
+	Overall      string // Summary across all listed sites
+	OverallState string // "up", "degraded" or "down"
+	Private      bool
+	Sites        []StatusPageSite
+	Updates      []StatusPageUpdate
+}
+
+// StatusPageSite is one site listed on a status page with its last 30 days of uptime
+type StatusPageSite struct {
+	Name    string
+	Up      bool
+	Since   string // When the current incident opened, empty while up
+	Percent string
+	Bars    []UptimeBar
+}
+
+// StatusPageUpdate is an incident update posted to a status page
+type StatusPageUpdate struct {
+	Status   string
+	Title    string
+	Message  string
+	PostedAt string
 }
 
 // Member represents a member with their role
 type Member struct {
 	MemberID    string
 	Email       string
+	Name        string
+	AvatarURL   string // Empty without an avatar
 	Role        string
+	Status      string // "active" or "provisioning" while SSH access is set up
 	ParentName  string
 	ParentID    string
-	ParentType  string // "organization", "project", or "site"
+	ParentType  string   // "organization", "project", or "site"
+	Roles       []string // Roles the user may change this member to, empty when they can't manage it
+	SshAccess   string   // "shell", "sftp" or "none" on the site detail page
 	Permissions ResourcePermissions
 }
 
+// MemberTarget is an organization, project or site the user can add members to
+type MemberTarget struct {
+	Type string // "organization", "project", or "site"
+	ID   string
+	Name string
+}
+
+// MembersPageData holds data for the members page
+type MembersPageData struct {
+	Email         string
+	Name          string
+	ActivePage    string
+	Members       []Member
+	MemberRoles   []string // Roles the user may assign, empty when they can't add members anywhere
+	Targets       []MemberTarget
+	ExportPath    string
+	IsDevelopment bool
+}
+
 // ResourcePermissions holds permission information for a resource
 type ResourcePermissions struct {
 	CanEdit   bool
@@ -135,11 +346,117 @@ type Setting struct {
 	Permissions ResourcePermissions
 }
 
+// NotificationChannel represents a Slack or Teams channel that receives an organization's alerts
+type NotificationChannel struct {
+	ID             string
+	Name           string
+	Kind           string // e.g., "Slack webhook"
+	Destination    string // Redacted webhook URL or Slack channel
+	Categories     []string
+	Enabled        bool
+	LastDeliveryAt string
+	LastError      string
+	Permissions    ResourcePermissions
+}
+
+// EncryptionKey is a customer-managed key on the organization page
+type EncryptionKey struct {
+	Location      string
+	KmsKeyName    string
+	Status        string // pending, healthy or unhealthy
+	StatusMessage string
+	CheckedAt     string
+}
+
 // AuditLogEntry represents an audit log entry
 type AuditLogEntry struct {
 	Action      string
 	Description string
 	Timestamp   string
+	Actor       string // Actor's name, or email without one
+	ActorEmail  string
+	Initials    string // Shown in the actor's avatar
+	AvatarColor string // e.g. "blue"; the template picks the classes
+	EntityLink  string // Dashboard path of the affected resource, empty once deleted
+	SourceIP    string
+}
+
+// ActivityPageData holds data for an activity page: an organization's, project's or
+// site's audit log, or the signed-in user's own activity
+type ActivityPageData struct {
+	Email         string
+	Name          string
+	ActivePage    string
+	Title         string
+	ParentName    string // Resource the log belongs to, empty for "my activity"
+	ParentLink    string
+	Path          string // The page's path, for filter and pagination links
+	Entries       []AuditLogEntry
+	Categories    []ActivityCategory
+	Category      string
+	Actor         string
+	Days          int
+	ShowActor     bool
+	Page          int
+	PrevURL       string
+	NextURL       string
+	ExportURL     string
+	ExportJSONURL string
+	IsDevelopment bool
+}
+
+// ActivityCategory is an activity filter option
+type ActivityCategory struct {
+	Value string
+	Label string
+}
+
+// FleetPageData holds data for an organization's fleet page: the health of its
+// site VMs as their controllers last reported it
+type FleetPageData struct {
+	Email            string
+	Name             string
+	ActivePage       string
+	OrganizationID   string
+	OrganizationName string
+	Path             string // The page's path, for filter and pagination links
+	Sites            []FleetEntry
+	Status           string
+	OsPatchStatus    string
+	Stale            string // Minutes without a check-in
+	Disk             string // Minimum percent of the data disk used
+	Pending          bool
+	StaleOptions     []FleetOption
+	DiskOptions      []FleetOption
+	PatchStatuses    []string
+	Page             int
+	PrevURL          string
+	NextURL          string
+	ExportURL        string
+	ExportJSONURL    string
+	IsDevelopment    bool
+}
+
+// FleetEntry is a site VM on the fleet page
+type FleetEntry struct {
+	SiteID                 string
+	SiteName               string
+	ProjectName            string
+	Status                 string
+	ExternalIP             string
+	LastCheckIn            string // "Never" until the controller checks in
+	ControllerVersion      string
+	PendingReconciliations int64
+	OsVersion              string
+	OsPatchStatus          string // e.g. "reboot_required"; the template picks the badge
+	DiskUsed               string // e.g. "72%", "-" when the disk size isn't known
+	DiskPressure           bool
+}
+
+// FleetOption is a fleet filter option
+type FleetOption struct {
+	Value string
+	Label string
 }
 
 // APIKeysPageData holds data for the API keys page
@@ -147,6 +464,7 @@ type APIKeysPageData struct {
 	Email         string
 	Name          string
 	ActivePage    string
+	Templates     []auth.KeyTemplate // Offered in the create modal, least privileged first
 	IsDevelopment bool
 }
 
@@ -158,3 +476,24 @@ type SSHKeysPageData struct {
 	ActivePage    string
 	IsDevelopment bool
 }
+
+// BillingPageData holds data for the billing page
+type BillingPageData struct {
+	Email                  string
+	Name                   string
+	ActivePage             string
+	Organizations          []Organization // Organizations the user can manage billing for
+	SelectedOrganizationID string
+	IsDevelopment          bool
+}
+
+// NewSitePageData holds data for the "add another site" wizard
+type NewSitePageData struct {
+	Email                  string
+	Name                   string
+	ActivePage             string
+	Organizations          []Organization // Organizations the user can add sites to
+	SelectedOrganizationID string
+	SelectedProjectID      string
+	IsDevelopment          bool
+}
@@ -0,0 +1,47 @@
+package dash
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestNewSiteSelectsOrganization tests that the add-site wizard opens on the requested
+// organization, and on the user's first one when they aren't a member of it.
+func TestNewSiteSelectsOrganization(t *testing.T) {
+	require.NoError(t, InitTemplates("../../web/templates"))
+	mock := &testutils.MockQuerier{
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, Email: "ada@example.org"}, nil
+		},
+		ListUserOrganizationsFunc: func(ctx context.Context, arg db.ListUserOrganizationsParams) ([]db.ListUserOrganizationsRow, error) {
+			return []db.ListUserOrganizationsRow{
+				{PublicID: "org-1", Name: "Acme", Role: db.OrganizationMembersRoleOwner},
+				{PublicID: "org-2", Name: "Globex", Role: db.OrganizationMembersRoleDeveloper},
+			}, nil
+		},
+	}
+
+	tests := map[string]string{
+		"/sites/new?organization_id=org-2": "org-2",
+		"/sites/new?organization_id=org-9": "org-1",
+		"/sites/new":                       "org-1",
+	}
+	for target, want := range tests {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+		rec := httptest.NewRecorder()
+		NewHandler(mock, nil).HandleNewSite(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, target)
+		assert.Contains(t, rec.Body.String(), "const ORGANIZATION_ID = '"+want+"';", target)
+	}
+}
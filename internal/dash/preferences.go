@@ -0,0 +1,38 @@
+package dash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/internal/service/account"
+)
+
+// displayPreferences are the parts of an account's preferences that change how
+// pages are rendered on the server
+type displayPreferences struct {
+	location *time.Location
+	pageSize int
+	locale   string // Empty follows the browser, see i18n.Negotiate
+}
+
+// preferences loads how the account wants timestamps, tables and text shown,
+// falling back to UTC, the default page size and the browser's language when they
+// can't be loaded
+func (h *Handler) preferences(ctx context.Context, accountID int64) displayPreferences {
+	prefs := displayPreferences{location: time.UTC, pageSize: account.DefaultPageSize}
+
+	saved, err := account.LoadPreferences(ctx, h.db, accountID)
+	if err != nil {
+		slog.Error("Failed to load account preferences", "account_id", accountID, "err", err)
+		return prefs
+	}
+	if loc, err := time.LoadLocation(saved.Timezone); err == nil {
+		prefs.location = loc
+	}
+	if saved.PageSize > 0 {
+		prefs.pageSize = int(saved.PageSize)
+	}
+	prefs.locale = saved.Locale
+	return prefs
+}
@@ -0,0 +1,82 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+// siteReconciliationLimit is how many runs of each type the site detail page lists
+const siteReconciliationLimit = 5
+
+// siteReconciliations lists the site's latest VM reconciliations of each type for
+// the site detail page, so users can see whether a change reached the VM and
+// whether a failed one will be retried. Full reconciliations appear under every
+// type. Times are shown in loc.
+func (h *Handler) siteReconciliations(ctx context.Context, siteID int64, loc *time.Location) []ReconciliationTimeline {
+	types := []db.ReconciliationsReconciliationType{
+		db.ReconciliationsReconciliationTypeSshKeys,
+		db.ReconciliationsReconciliationTypeSecrets,
+		db.ReconciliationsReconciliationTypeFirewall,
+	}
+
+	timelines := make([]ReconciliationTimeline, 0, len(types))
+	for _, t := range types {
+		rows, err := h.db.ListSiteReconciliationRuns(ctx, db.ListSiteReconciliationRunsParams{
+			SiteID:             sql.NullInt64{Int64: siteID, Valid: true},
+			ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: t, Valid: true},
+			Limit:              siteReconciliationLimit,
+		})
+		if err != nil {
+			slog.Error("Failed to list site reconciliations", "site_id", siteID, "type", t, "err", err)
+			return nil
+		}
+
+		timeline := ReconciliationTimeline{Type: string(t)}
+		for _, row := range rows {
+			run := ReconciliationRun{
+				Status:    "pending",
+				Full:      row.ReconciliationType.ReconciliationsReconciliationType == db.ReconciliationsReconciliationTypeGeneral,
+				Error:     row.ErrorMessage.String,
+				StartedAt: "-",
+				Duration:  "-",
+				Attempt:   max(row.Attempt, 1),
+			}
+			switch row.Status.ReconciliationsStatus {
+			case db.ReconciliationsStatusCompleted:
+				run.Status = "succeeded"
+			case db.ReconciliationsStatusFailed:
+				run.Status = "failed"
+				switch row.RetryState.ReconciliationsRetryState {
+				case db.ReconciliationsRetryStateScheduled:
+					if row.NextRetryAt.Valid {
+						run.RetryAt = row.NextRetryAt.Time.In(loc).Format("15:04 MST")
+					}
+				case db.ReconciliationsRetryStateExhausted:
+					run.GaveUp = true
+				}
+			case db.ReconciliationsStatusTriggered, db.ReconciliationsStatusRunning:
+				run.Status = "running"
+			}
+
+			started := row.StartedAt
+			if !started.Valid {
+				started = row.CreatedAt
+			}
+			if started.Valid {
+				run.StartedAt = started.Time.In(loc).Format("2006-01-02 15:04 MST")
+				if row.CompletedAt.Valid {
+					if d := row.CompletedAt.Time.Sub(started.Time).Round(time.Second); d >= 0 {
+						run.Duration = d.String()
+					}
+				}
+			}
+			timeline.Runs = append(timeline.Runs, run)
+		}
+		timelines = append(timelines, timeline)
+	}
+	return timelines
+}
@@ -0,0 +1,63 @@
+package dash
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestSiteReconciliations tests that each type lists its runs with their
+// outcome, duration and error.
+func TestSiteReconciliations(t *testing.T) {
+	started := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock := &testutils.MockQuerier{
+		ListSiteReconciliationRunsFunc: func(ctx context.Context, arg db.ListSiteReconciliationRunsParams) ([]db.Reconciliation, error) {
+			assert.Equal(t, int64(7), arg.SiteID.Int64)
+			if arg.ReconciliationType.ReconciliationsReconciliationType != db.ReconciliationsReconciliationTypeFirewall {
+				return nil, nil
+			}
+			return []db.Reconciliation{
+				{
+					ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: db.ReconciliationsReconciliationTypeFirewall, Valid: true},
+					Status:             db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+					ErrorMessage:       sql.NullString{String: "site controller returned status 500", Valid: true},
+					StartedAt:          sql.NullTime{Time: started, Valid: true},
+					CompletedAt:        sql.NullTime{Time: started.Add(95 * time.Second), Valid: true},
+					Attempt:            3,
+					RetryState:         db.NullReconciliationsRetryState{ReconciliationsRetryState: db.ReconciliationsRetryStateScheduled, Valid: true},
+					NextRetryAt:        sql.NullTime{Time: started.Add(3 * time.Minute), Valid: true},
+				},
+				{
+					ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: db.ReconciliationsReconciliationTypeGeneral, Valid: true},
+					Status:             db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusRunning, Valid: true},
+				},
+			}, nil
+		},
+	}
+
+	timelines := NewHandler(mock, nil).siteReconciliations(context.Background(), 7, time.UTC)
+	require.Len(t, timelines, 3)
+	assert.Equal(t, "ssh_keys", timelines[0].Type)
+	assert.Empty(t, timelines[0].Runs)
+
+	firewall := timelines[2]
+	assert.Equal(t, "firewall", firewall.Type)
+	require.Len(t, firewall.Runs, 2)
+	assert.Equal(t, "failed", firewall.Runs[0].Status)
+	assert.Equal(t, "2026-03-01 12:00 UTC", firewall.Runs[0].StartedAt)
+	assert.Equal(t, "1m35s", firewall.Runs[0].Duration)
+	assert.Equal(t, "site controller returned status 500", firewall.Runs[0].Error)
+	assert.Equal(t, int32(3), firewall.Runs[0].Attempt)
+	assert.Equal(t, "12:03 UTC", firewall.Runs[0].RetryAt)
+	assert.False(t, firewall.Runs[0].GaveUp)
+	assert.Equal(t, "running", firewall.Runs[1].Status)
+	assert.True(t, firewall.Runs[1].Full)
+	assert.Equal(t, "-", firewall.Runs[1].StartedAt)
+}
@@ -10,6 +10,12 @@ func RenderLoginPage(w http.ResponseWriter, data LoginPageData) {
 	RenderTemplate(w, "login.html", data)
 }
 
+// RenderAccountRecoveryPage renders the email verification and password reset pages
+func RenderAccountRecoveryPage(w http.ResponseWriter, data AccountRecoveryPageData) {
+	data.IsDevelopment = IsDevelopment()
+	RenderTemplate(w, "account_recovery.html", data)
+}
+
 // RenderDashboard renders the dashboard page
 func RenderDashboard(w http.ResponseWriter, data DashboardPageData) {
 	// Set ActivePage for sidebar highlighting
@@ -37,6 +43,7 @@ func RenderProjects(w http.ResponseWriter, data ResourcePageData) {
 // RenderSites renders the sites page
 func RenderSites(w http.ResponseWriter, data ResourcePageData) {
 	data.ActivePage = "sites"
+	data.ExportPath = "/sites/export"
 	data.IsDevelopment = IsDevelopment()
 	RenderTemplate(w, "resources.html", data)
 }
@@ -44,6 +51,7 @@ func RenderSites(w http.ResponseWriter, data ResourcePageData) {
 // RenderSecrets renders the secrets page
 func RenderSecrets(w http.ResponseWriter, data ResourcePageData) {
 	data.ActivePage = "secrets"
+	data.ExportPath = "/secrets/export"
 	data.IsDevelopment = IsDevelopment()
 	RenderTemplate(w, "resources.html", data)
 }
@@ -51,15 +59,17 @@ func RenderSecrets(w http.ResponseWriter, data ResourcePageData) {
 // RenderFirewall renders the firewall page
 func RenderFirewall(w http.ResponseWriter, data ResourcePageData) {
 	data.ActivePage = "firewall"
+	data.ExportPath = "/firewall/export"
 	data.IsDevelopment = IsDevelopment()
 	RenderTemplate(w, "resources.html", data)
 }
 
 // RenderMembers renders the members page
-func RenderMembers(w http.ResponseWriter, data ResourcePageData) {
+func RenderMembers(w http.ResponseWriter, data MembersPageData) {
 	data.ActivePage = "members"
+	data.ExportPath = "/members/export"
 	data.IsDevelopment = IsDevelopment()
-	RenderTemplate(w, "resources.html", data)
+	RenderTemplate(w, "members.html", data)
 }
 
 // RenderSettings renders the settings page
@@ -97,9 +107,40 @@ func RenderAPIKeys(w http.ResponseWriter, data APIKeysPageData) {
 	RenderTemplate(w, "api_keys.html", data)
 }
 
+// RenderBilling renders the billing page
+func RenderBilling(w http.ResponseWriter, data BillingPageData) {
+	data.ActivePage = "billing"
+	data.IsDevelopment = IsDevelopment()
+	RenderTemplate(w, "billing.html", data)
+}
+
 // RenderSSHKeys renders the SSH keys page
 func RenderSSHKeys(w http.ResponseWriter, data SSHKeysPageData) {
 	data.ActivePage = "ssh-keys"
 	data.IsDevelopment = IsDevelopment()
 	RenderTemplate(w, "ssh_keys.html", data)
 }
+
+// RenderNewSite renders the "add another site" wizard
+func RenderNewSite(w http.ResponseWriter, data NewSitePageData) {
+	data.ActivePage = "sites"
+	data.IsDevelopment = IsDevelopment()
+	RenderTemplate(w, "new_site.html", data)
+}
+
+// RenderStatusPage renders an organization's status page
+func RenderStatusPage(w http.ResponseWriter, data StatusPageData) {
+	RenderTemplate(w, "status_page.html", data)
+}
+
+// RenderActivity renders an activity page
+func RenderActivity(w http.ResponseWriter, data ActivityPageData) {
+	data.IsDevelopment = IsDevelopment()
+	RenderTemplate(w, "activity.html", data)
+}
+
+// RenderFleet renders an organization's fleet page
+func RenderFleet(w http.ResponseWriter, data FleetPageData) {
+	data.IsDevelopment = IsDevelopment()
+	RenderTemplate(w, "fleet.html", data)
+}
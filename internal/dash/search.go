@@ -0,0 +1,95 @@
+package dash
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+const (
+	// searchMinLength is the shortest query worth matching
+	searchMinLength = 2
+	// searchMaxLength bounds the query so it can't be used to build huge patterns
+	searchMaxLength = 100
+	// searchLimit caps the results returned across every resource kind
+	searchLimit = 25
+)
+
+// SearchResult is one match in the dashboard search box
+type SearchResult struct {
+	Kind   string `json:"kind"` // organization, project, site, member or secret
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"` // Where the match lives, e.g. the site's project
+	Link   string `json:"link"`
+}
+
+// SearchResponse is the body returned by GET /search
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+}
+
+// HandleSearch matches the query against the names of organizations, projects, sites
+// and secrets, and the email and name of members, limited to what the user can access.
+// Secret values are never searched or returned.
+func (h *Handler) HandleSearch(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	resp := SearchResponse{Query: query, Results: []SearchResult{}}
+	if utf8.RuneCountInString(query) < searchMinLength {
+		writeSearch(w, resp)
+		return
+	}
+	if utf8.RuneCountInString(query) > searchMaxLength {
+		http.Error(w, "Search query is too long", http.StatusBadRequest)
+		return
+	}
+
+	rows, err := h.db.SearchUserResources(r.Context(), db.SearchUserResourcesParams{
+		AccountID: userInfo.AccountID,
+		Pattern:   "%" + escapeLike(query) + "%",
+		Limit:     searchLimit,
+	})
+	if err != nil {
+		slog.Error("Failed to search resources", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	for _, row := range rows {
+		resp.Results = append(resp.Results, SearchResult{
+			Kind:   row.Kind,
+			ID:     row.PublicID,
+			Name:   row.Name,
+			Detail: row.Detail,
+			Link:   "/" + row.ParentType + "s/" + row.ParentPublicID,
+		})
+	}
+	writeSearch(w, resp)
+}
+
+func writeSearch(w http.ResponseWriter, resp SearchResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.Error("Failed to write search results", "err", err)
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the LIKE wildcards so the query matches literally
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
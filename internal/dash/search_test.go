@@ -0,0 +1,58 @@
+package dash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestHandleSearch tests that the query is matched literally and that results link to
+// the resource, or for members and secrets, the resource they belong to.
+func TestHandleSearch(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		SearchUserResourcesFunc: func(ctx context.Context, arg db.SearchUserResourcesParams) ([]db.SearchUserResourcesRow, error) {
+			assert.Equal(t, int64(5), arg.AccountID)
+			assert.Equal(t, `%100\%\_ok%`, arg.Pattern)
+			assert.Equal(t, int32(searchLimit), arg.Limit)
+			return []db.SearchUserResourcesRow{
+				{Kind: "site", PublicID: "site-1", Name: "catalog", Detail: "library", ParentType: "site", ParentPublicID: "site-1"},
+				{Kind: "secret", PublicID: "secret-1", Name: "DB_PASSWORD", Detail: "library", ParentType: "project", ParentPublicID: "project-1"},
+			}, nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/search?q=+100%25_ok+", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	rec := httptest.NewRecorder()
+	NewHandler(mock, nil).HandleSearch(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp SearchResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "100%_ok", resp.Query)
+	assert.Equal(t, []SearchResult{
+		{Kind: "site", ID: "site-1", Name: "catalog", Detail: "library", Link: "/sites/site-1"},
+		{Kind: "secret", ID: "secret-1", Name: "DB_PASSWORD", Detail: "library", Link: "/projects/project-1"},
+	}, resp.Results)
+}
+
+// TestHandleSearchShortQuery tests that a query below the minimum length returns no
+// results without querying the database.
+func TestHandleSearchShortQuery(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/search?q=a", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	rec := httptest.NewRecorder()
+	NewHandler(&testutils.MockQuerier{}, nil).HandleSearch(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"query":"a","results":[]}`, rec.Body.String())
+}
@@ -0,0 +1,133 @@
+package dash
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/uptime"
+)
+
+const (
+	// statusPageUptimeHours is the uptime window shown per site, one bar per day
+	statusPageUptimeHours = 30 * 24
+
+	// statusPageUpdateDays is how far back posted updates are shown
+	statusPageUpdateDays = 14
+)
+
+// HandleStatusPage renders an organization's status page. Public pages are
+// shown to anyone; private pages only to members of the organization, and
+// look like missing pages to everyone else.
+func (h *Handler) HandleStatusPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := r.PathValue("slug")
+
+	page, err := h.db.GetStatusPageBySlug(ctx, slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("Failed to get status page", "slug", slug, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if !page.IsPublic {
+		userInfo, ok := auth.GetUserFromContext(ctx)
+		if !ok || !h.canUserPerformOnOrganization(ctx, userInfo, page.OrganizationPublicID, auth.PermissionRead) {
+			http.NotFound(w, r)
+			return
+		}
+	}
+
+	now := time.Now()
+	rows, err := h.db.ListStatusPageSites(ctx, page.ID)
+	if err != nil {
+		slog.Error("Failed to list status page sites", "slug", slug, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	data := StatusPageData{
+		Title:   page.Title,
+		Private: !page.IsPublic,
+		Sites:   make([]StatusPageSite, 0, len(rows)),
+	}
+	down := 0
+	for _, row := range rows {
+		site := statusPageSite(row)
+		if !site.Up {
+			down++
+		}
+		summary, err := uptime.Summarize(ctx, h.db, row.SiteID, row.SitePublicID, statusPageUptimeHours, now)
+		if err != nil {
+			slog.Error("Failed to summarize site uptime", "site_id", row.SitePublicID, "err", err)
+		} else {
+			site.Percent = fmt.Sprintf("%.2f%%", summary.UptimePercent)
+			for _, bucket := range summary.Buckets {
+				site.Bars = append(site.Bars, uptimeBar(bucket, time.UTC))
+			}
+		}
+		data.Sites = append(data.Sites, site)
+	}
+
+	switch {
+	case down == 0:
+		data.Overall, data.OverallState = "All systems operational", "up"
+	case down == len(rows):
+		data.Overall, data.OverallState = "Major outage", "down"
+	default:
+		data.Overall, data.OverallState = "Partial outage", "degraded"
+	}
+
+	updates, err := h.db.ListStatusPageUpdates(ctx, db.ListStatusPageUpdatesParams{
+		StatusPageID: page.ID,
+		Since:        sql.NullTime{Time: now.AddDate(0, 0, -statusPageUpdateDays), Valid: true},
+		Limit:        20,
+		Offset:       0,
+	})
+	if err != nil {
+		slog.Error("Failed to list status page updates", "slug", slug, "err", err)
+	}
+	for _, update := range updates {
+		postedAt := ""
+		if update.CreatedAt.Valid {
+			postedAt = update.CreatedAt.Time.UTC().Format("Jan 2, 15:04 MST")
+		}
+		data.Updates = append(data.Updates, StatusPageUpdate{
+			Status:   string(update.Status),
+			Title:    update.Title,
+			Message:  update.Message,
+			PostedAt: postedAt,
+		})
+	}
+
+	if page.IsPublic {
+		w.Header().Set("Cache-Control", "public, max-age=60")
+	} else {
+		w.Header().Set("Cache-Control", "private, no-store")
+	}
+	RenderStatusPage(w, data)
+}
+
+// statusPageSite is a listed site's current state; it is down while it has an open incident
+func statusPageSite(row db.ListStatusPageSitesRow) StatusPageSite {
+	site := StatusPageSite{
+		Name: row.SiteName,
+		Up:   !row.IncidentCause.Valid,
+	}
+	if row.DisplayName != "" {
+		site.Name = row.DisplayName
+	}
+	if row.IncidentOpenedAt.Valid {
+		site.Since = row.IncidentOpenedAt.Time.UTC().Format("Jan 2, 15:04 MST")
+	}
+	return site
+}
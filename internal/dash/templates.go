@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+
+	"github.com/libops/api/internal/i18n"
 )
 
 var pageTemplates map[string]*template.Template
@@ -21,8 +24,11 @@ func InitTemplates(templatesDir string) error {
 	funcMap := template.FuncMap{
 		"lower":       strings.ToLower,
 		"upper":       strings.ToUpper,
+		"join":        strings.Join,
 		"title":       titleCaser.String,
 		"singularize": singularize,
+		"initial":     initial,
+		"t":           i18n.T,
 	}
 
 	// 1. Parse shared templates
@@ -30,6 +36,7 @@ func InitTemplates(templatesDir string) error {
 		filepath.Join(templatesDir, "base.html"),
 		filepath.Join(templatesDir, "sidebar.html"),
 		filepath.Join(templatesDir, "banner.html"),
+		filepath.Join(templatesDir, "avatar.html"),
 	}
 
 	baseTmpl, err := template.New("base").Funcs(funcMap).ParseFiles(sharedFiles...)
@@ -46,7 +53,7 @@ func InitTemplates(templatesDir string) error {
 	for _, file := range files {
 		name := filepath.Base(file)
 		// Skip shared files
-		if name == "base.html" || name == "sidebar.html" || name == "banner.html" {
+		if name == "base.html" || name == "sidebar.html" || name == "banner.html" || name == "avatar.html" {
 			continue
 		}
 
@@ -88,6 +95,16 @@ func RenderTemplate(w http.ResponseWriter, name string, data any) {
 	}
 }
 
+// initial returns the uppercased first letter of a name or email, shown in place
+// of a missing avatar
+func initial(name string) string {
+	r, _ := utf8.DecodeRuneInString(name)
+	if r == utf8.RuneError {
+		return "?"
+	}
+	return strings.ToUpper(string(r))
+}
+
 // singularize converts plural resource names to singular
 func singularize(str string) string {
 	singular := map[string]string{
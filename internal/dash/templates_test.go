@@ -0,0 +1,13 @@
+package dash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestInitTemplates tests that every page template parses, since one that
+// doesn't keeps the whole dashboard from starting.
+func TestInitTemplates(t *testing.T) {
+	require.NoError(t, InitTemplates("../../web/templates"))
+}
@@ -0,0 +1,65 @@
+package dash
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/internal/uptime"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// siteUptime summarizes the last day of probes for the site detail page.
+// It returns nil when the summary cannot be loaded so the page still renders.
+// Times are shown in loc.
+func (h *Handler) siteUptime(ctx context.Context, siteID int64, sitePublicID string, canEdit bool, loc *time.Location) *SiteUptime {
+	summary, err := uptime.Summarize(ctx, h.db, siteID, sitePublicID, 24, time.Now())
+	if err != nil {
+		slog.Error("Failed to summarize site uptime", "site_id", sitePublicID, "err", err)
+		return nil
+	}
+
+	data := &SiteUptime{
+		Percent:           fmt.Sprintf("%.2f%%", summary.UptimePercent),
+		Checks:            summary.Checks,
+		AverageResponseMs: summary.AverageResponseMs,
+		HealthCheckPath:   summary.HealthCheckPath,
+		CanEdit:           canEdit,
+	}
+	if summary.Checks == 0 {
+		data.Percent = "No data"
+	}
+
+	if probe := summary.LastProbe; probe != nil {
+		data.LastProbeUp = probe.Up
+		when := time.Unix(probe.ProbedAt, 0).In(loc).Format("2006-01-02 15:04 MST")
+		switch {
+		case probe.Up:
+			data.LastProbe = fmt.Sprintf("Up, HTTP %d in %dms at %s", probe.StatusCode, probe.ResponseMs, when)
+		case probe.StatusCode > 0:
+			data.LastProbe = fmt.Sprintf("Down, HTTP %d at %s", probe.StatusCode, when)
+		default:
+			data.LastProbe = fmt.Sprintf("Down, %s at %s", probe.Error, when)
+		}
+	}
+
+	for _, bucket := range summary.Buckets {
+		data.Bars = append(data.Bars, uptimeBar(bucket, loc))
+	}
+	return data
+}
+
+func uptimeBar(bucket *libopsv1.UptimeBucket, loc *time.Location) UptimeBar {
+	start := time.Unix(bucket.StartTime, 0).In(loc).Format("Jan 2 15:04")
+	switch {
+	case bucket.Checks == 0:
+		return UptimeBar{State: "none", Title: start + ": no data"}
+	case bucket.Failures == 0:
+		return UptimeBar{State: "up", Title: fmt.Sprintf("%s: %d checks up, %dms average", start, bucket.Checks, bucket.AverageResponseMs)}
+	case bucket.Failures == bucket.Checks:
+		return UptimeBar{State: "down", Title: fmt.Sprintf("%s: all %d checks failed", start, bucket.Checks)}
+	default:
+		return UptimeBar{State: "degraded", Title: fmt.Sprintf("%s: %d of %d checks failed", start, bucket.Failures, bucket.Checks)}
+	}
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Pool roles used as metric labels.
+const (
+	RolePrimary = "primary"
+	RoleReplica = "replica"
+)
+
+var dbQueriesRouted = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "libops_db_queries_routed_total",
+		Help: "Total number of database statements by the pool they were routed to",
+	},
+	[]string{"role"}, // primary, replica
+)
+
+var dbReplicaLag = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "libops_db_replica_lag_seconds",
+		Help: "How far the read replica is behind the primary; -1 when it can't be measured",
+	},
+)
+
+// RegisterPoolMetrics exposes sql.DBStats for a pool (open/idle/in-use
+// connections, wait counts and durations) under the given role label.
+func RegisterPoolMetrics(pool *sql.DB, role string) error {
+	collector := collectors.NewDBStatsCollector(pool, "libops_"+role)
+	if err := prometheus.Register(collector); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -1,9 +1,18 @@
 package database
 
 import (
+	"cmp"
+	"context"
+	"crypto/sha256"
 	"database/sql"
 	"embed"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
@@ -13,30 +22,294 @@ import (
 //go:embed migrations/*.sql
 var migrationsFS embed.FS
 
-// Migrate applies any pending database migrations.
-func Migrate(db *sql.DB) error {
+// checksumTable records the checksum of every up migration when it's applied,
+// so an edit to a migration that already ran is caught instead of silently
+// leaving environments with different schemas.
+const checksumTable = "schema_migration_checksums"
+
+var upMigrationName = regexp.MustCompile(`^(\d+)_.+\.up\.sql$`)
+
+// Migration is an up migration embedded in the binary
+type Migration struct {
+	Version  uint
+	Name     string
+	Checksum string
+}
+
+// MigrationStatus compares the database's schema with the embedded migrations
+type MigrationStatus struct {
+	// Version is the last applied migration, 0 when none ran
+	Version uint
+	// Dirty is set when a migration failed part way and needs fixing by hand
+	Dirty bool
+	// Latest is the newest embedded migration
+	Latest uint
+	// Pending are the embedded migrations newer than Version
+	Pending []Migration
+	// Modified are applied migrations whose file changed since they ran
+	Modified []Migration
+	// Unknown is set when the database is ahead of this binary
+	Unknown bool
+}
+
+// Check returns an error when the schema isn't the one this binary expects
+func (s MigrationStatus) Check() error {
+	switch {
+	case s.Dirty:
+		return fmt.Errorf("database schema is dirty at version %d; fix it by hand and force the version", s.Version)
+	case s.Unknown:
+		return fmt.Errorf("database schema version %d is newer than the latest migration %d", s.Version, s.Latest)
+	case len(s.Pending) > 0:
+		return fmt.Errorf("database schema is behind: version %d, %d pending migrations up to %d", s.Version, len(s.Pending), s.Latest)
+	case len(s.Modified) > 0:
+		return fmt.Errorf("%d applied migrations changed since they ran, starting with %d_%s", len(s.Modified), s.Modified[0].Version, s.Modified[0].Name)
+	}
+	return nil
+}
+
+// Migrations lists the embedded up migrations in order
+func Migrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("reading migrations: %w", err)
+	}
+	var migrations []Migration
+	for _, entry := range entries {
+		match := upMigrationName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing migration %s: %w", entry.Name(), err)
+		}
+		content, err := migrationsFS.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("reading migration %s: %w", entry.Name(), err)
+		}
+		sum := sha256.Sum256(content)
+		migrations = append(migrations, Migration{
+			Version:  uint(version),
+			Name:     entry.Name()[len(match[1])+1 : len(entry.Name())-len(".up.sql")],
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return cmp.Compare(a.Version, b.Version) })
+	return migrations, nil
+}
+
+// newMigrator creates a migrator on a connection of its own. Closing a driver
+// made with mysql.WithInstance closes the whole pool, which the server goes on
+// using, so only that connection is closed with the migrator.
+func newMigrator(ctx context.Context, db *sql.DB) (*migrate.Migrate, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening migration connection: %w", err)
+	}
+
 	// Create MySQL driver instance
-	driver, err := mysql.WithInstance(db, &mysql.Config{})
+	driver, err := mysql.WithConnection(ctx, conn, &mysql.Config{})
 	if err != nil {
-		return fmt.Errorf("creating migration driver: %w", err)
+		_ = conn.Close()
+		return nil, fmt.Errorf("creating migration driver: %w", err)
 	}
 
 	// Create migration source from embedded files
 	migrations, err := iofs.New(migrationsFS, "migrations")
 	if err != nil {
-		return fmt.Errorf("creating migration source: %w", err)
+		_ = driver.Close()
+		return nil, fmt.Errorf("creating migration source: %w", err)
 	}
 
 	// Create migrator
 	m, err := migrate.NewWithInstance("iofs", migrations, "mysql", driver)
 	if err != nil {
-		return fmt.Errorf("creating migrator: %w", err)
+		_ = driver.Close()
+		return nil, fmt.Errorf("creating migrator: %w", err)
+	}
+	return m, nil
+}
+
+// Migrate applies any pending database migrations.
+func Migrate(db *sql.DB) error {
+	ctx := context.Background()
+	// Migrations that ran before must still match before more are applied
+	status, err := Status(ctx, db)
+	if err != nil {
+		return err
+	}
+	if len(status.Modified) > 0 {
+		return status.Check()
 	}
 
+	m, err := newMigrator(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
 	// Run migrations
 	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
 		return fmt.Errorf("running migrations: %w", err)
 	}
 
+	return recordChecksums(ctx, db)
+}
+
+// MigrateDown rolls back the last steps applied migrations
+func MigrateDown(db *sql.DB, steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("steps must be at least 1")
+	}
+	m, err := newMigrator(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Steps(-steps); err != nil {
+		return fmt.Errorf("rolling back migrations: %w", err)
+	}
+	return recordChecksums(context.Background(), db)
+}
+
+// Status reports the applied version against the embedded migrations and
+// verifies the checksums of the ones that ran
+func Status(ctx context.Context, db *sql.DB) (MigrationStatus, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+	m, err := newMigrator(ctx, db)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+	defer m.Close()
+
+	var status MigrationStatus
+	version, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return MigrationStatus{}, fmt.Errorf("reading schema version: %w", err)
+	}
+	status.Version, status.Dirty = version, dirty
+
+	checksums, err := appliedChecksums(ctx, db)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+	return compareMigrations(status, migrations, checksums), nil
+}
+
+// compareMigrations fills in a status from the embedded migrations and the
+// checksums recorded when they were applied
+func compareMigrations(status MigrationStatus, migrations []Migration, checksums map[uint]string) MigrationStatus {
+	if len(migrations) > 0 {
+		status.Latest = migrations[len(migrations)-1].Version
+	}
+	status.Unknown = status.Version > status.Latest
+	for _, migration := range migrations {
+		if migration.Version > status.Version {
+			status.Pending = append(status.Pending, migration)
+			continue
+		}
+		// Migrations applied before checksums were recorded can't be verified
+		if recorded, ok := checksums[migration.Version]; ok && recorded != migration.Checksum {
+			status.Modified = append(status.Modified, migration)
+		}
+	}
+	return status
+}
+
+// CheckSchema returns an error unless every embedded migration is applied,
+// unchanged, and the schema is clean
+func CheckSchema(ctx context.Context, db *sql.DB) error {
+	status, err := Status(ctx, db)
+	if err != nil {
+		return err
+	}
+	return status.Check()
+}
+
+func ensureChecksumTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+checksumTable+` (
+		version BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+		checksum CHAR(64) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", checksumTable, err)
+	}
+	return nil
+}
+
+func appliedChecksums(ctx context.Context, db *sql.DB) (map[uint]string, error) {
+	if err := ensureChecksumTable(ctx, db); err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, `SELECT version, checksum FROM `+checksumTable)
+	if err != nil {
+		return nil, fmt.Errorf("reading migration checksums: %w", err)
+	}
+	defer rows.Close()
+
+	checksums := map[uint]string{}
+	for rows.Next() {
+		var version uint
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, fmt.Errorf("reading migration checksums: %w", err)
+		}
+		checksums[version] = checksum
+	}
+	return checksums, rows.Err()
+}
+
+// recordChecksums records the checksum of every applied migration that has
+// none and forgets the ones that were rolled back
+func recordChecksums(ctx context.Context, db *sql.DB) error {
+	migrations, err := Migrations()
+	if err != nil {
+		return err
+	}
+	checksums, err := appliedChecksums(ctx, db)
+	if err != nil {
+		return err
+	}
+	var version uint
+	err = db.QueryRowContext(ctx, `SELECT version FROM schema_migrations LIMIT 1`).Scan(&version)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("reading schema version: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `DELETE FROM `+checksumTable+` WHERE version > ?`, version); err != nil {
+		return fmt.Errorf("removing rolled back checksums: %w", err)
+	}
+	for _, migration := range migrations {
+		if migration.Version > version {
+			break
+		}
+		if _, ok := checksums[migration.Version]; ok {
+			continue
+		}
+		_, err := db.ExecContext(ctx, `INSERT IGNORE INTO `+checksumTable+` (version, checksum) VALUES (?, ?)`, migration.Version, migration.Checksum)
+		if err != nil {
+			return fmt.Errorf("recording checksum of %d: %w", migration.Version, err)
+		}
+	}
 	return nil
 }
+
+// ForceVersion marks the schema as being at version and clean without running
+// anything, after a failed migration was repaired by hand
+func ForceVersion(db *sql.DB, version int) error {
+	m, err := newMigrator(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Force(version); err != nil {
+		return fmt.Errorf("forcing version %d: %w", version, err)
+	}
+	return recordChecksums(context.Background(), db)
+}
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrations tests that every embedded up migration is listed in order
+// with a down migration next to it.
+func TestMigrations(t *testing.T) {
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, m := range migrations {
+		assert.Len(t, m.Checksum, 64)
+		assert.NotEmpty(t, m.Name)
+		if i > 0 {
+			assert.Greater(t, m.Version, migrations[i-1].Version, "%d_%s", m.Version, m.Name)
+		}
+		_, err := migrationsFS.ReadFile(fmt.Sprintf("migrations/%d_%s.down.sql", m.Version, m.Name))
+		assert.NoError(t, err, "%d_%s has no down migration", m.Version, m.Name)
+	}
+}
+
+// TestCompareMigrations tests how the applied version and recorded checksums
+// are compared with the embedded migrations.
+func TestCompareMigrations(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "init", Checksum: "a"},
+		{Version: 2, Name: "accounts", Checksum: "b"},
+		{Version: 3, Name: "sites", Checksum: "c"},
+	}
+
+	tests := []struct {
+		name      string
+		status    MigrationStatus
+		checksums map[uint]string
+		pending   int
+		modified  int
+		wantErr   string
+	}{
+		{
+			name:      "current",
+			status:    MigrationStatus{Version: 3},
+			checksums: map[uint]string{1: "a", 2: "b", 3: "c"},
+		},
+		{
+			name:    "empty database",
+			status:  MigrationStatus{},
+			pending: 3,
+			wantErr: "behind",
+		},
+		{
+			name:      "behind",
+			status:    MigrationStatus{Version: 2},
+			checksums: map[uint]string{1: "a", 2: "b"},
+			pending:   1,
+			wantErr:   "1 pending",
+		},
+		{
+			name:      "modified after it ran",
+			status:    MigrationStatus{Version: 3},
+			checksums: map[uint]string{1: "a", 2: "edited", 3: "c"},
+			modified:  1,
+			wantErr:   "2_accounts",
+		},
+		{
+			name:   "applied before checksums were recorded",
+			status: MigrationStatus{Version: 3},
+		},
+		{
+			name:      "dirty",
+			status:    MigrationStatus{Version: 3, Dirty: true},
+			checksums: map[uint]string{1: "a", 2: "b", 3: "c"},
+			wantErr:   "dirty",
+		},
+		{
+			name:    "ahead of the binary",
+			status:  MigrationStatus{Version: 4},
+			wantErr: "newer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := compareMigrations(tt.status, migrations, tt.checksums)
+			assert.Equal(t, uint(3), status.Latest)
+			assert.Len(t, status.Pending, tt.pending)
+			assert.Len(t, status.Modified, tt.modified)
+
+			err := status.Check()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// TestMigrateKeepsPool tests that migrating and checking the schema leave the
+// caller's pool open, since the server goes on serving from it. It needs a
+// disposable MariaDB in DATABASE_URL, e.g.
+// root:password@tcp(localhost:3306)/test?parseTime=true&multiStatements=true
+func TestMigrateKeepsPool(t *testing.T) {
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("DATABASE_URL not set, skipping migration test")
+	}
+	pool, err := sql.Open("mysql", dbURL)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pool.Close() })
+
+	ctx := context.Background()
+	require.NoError(t, Migrate(pool))
+	require.NoError(t, CheckSchema(ctx, pool))
+
+	status, err := Status(ctx, pool)
+	require.NoError(t, err)
+	assert.Empty(t, status.Pending)
+
+	var one int
+	require.NoError(t, pool.QueryRowContext(ctx, "SELECT 1").Scan(&one), "the pool is still open")
+	assert.Equal(t, 1, one)
+}
@@ -0,0 +1,3 @@
+ALTER TABLE sites DROP COLUMN labels;
+ALTER TABLE projects DROP COLUMN labels;
+ALTER TABLE organizations DROP COLUMN labels;
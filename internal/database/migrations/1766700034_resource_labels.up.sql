@@ -0,0 +1,5 @@
+-- Free-form key/value labels used for grouping and filtering resources
+-- (e.g. {"env": "prod", "department": "library"}).
+ALTER TABLE organizations ADD COLUMN labels JSON NULL AFTER `status`;
+ALTER TABLE projects ADD COLUMN labels JSON NULL AFTER `status`;
+ALTER TABLE sites ADD COLUMN labels JSON NULL AFTER `status`;
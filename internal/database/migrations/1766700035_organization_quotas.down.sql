@@ -0,0 +1,7 @@
+INSERT INTO organization_settings (public_id, organization_id, setting_key, setting_value, editable, description, status)
+SELECT UUID_TO_BIN(UUID()), organization_id, 'max_projects', CAST(quota_limit AS CHAR), FALSE,
+       'Maximum number of projects allowed in this organization', 'active'
+FROM organization_quotas
+WHERE resource = 'projects';
+
+DROP TABLE IF EXISTS organization_quotas;
@@ -0,0 +1,28 @@
+-- Per-organization quota overrides. Organizations without a row for a
+-- resource use the default limit for their billing plan.
+CREATE TABLE IF NOT EXISTS organization_quotas (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    resource VARCHAR(64) NOT NULL,
+    quota_limit BIGINT NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    created_by BIGINT NULL,
+    updated_by BIGINT NULL,
+
+    UNIQUE KEY unique_org_resource (organization_id, resource),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- The max_projects organization setting is superseded by the projects quota.
+-- Carry forward any value that was changed from the old default of 10.
+INSERT INTO organization_quotas (organization_id, resource, quota_limit, created_by, updated_by)
+SELECT organization_id, 'projects', CAST(setting_value AS SIGNED), updated_by, updated_by
+FROM organization_settings
+WHERE setting_key = 'max_projects'
+  AND setting_value REGEXP '^[0-9]+$'
+  AND setting_value <> '10';
+
+DELETE FROM organization_settings WHERE setting_key = 'max_projects';
@@ -0,0 +1,18 @@
+-- Daily metered usage per project. Gauges (e.g. backup storage) store the
+-- day's most recent measurement; counters (e.g. egress) store the day's total.
+CREATE TABLE IF NOT EXISTS usage_records (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+    metric VARCHAR(64) NOT NULL COMMENT 'backup_storage_bytes or egress_bytes',
+    usage_date DATE NOT NULL,
+    quantity BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    UNIQUE KEY unique_project_metric_date (project_id, metric, usage_date),
+    INDEX idx_organization_metric_date (organization_id, metric, usage_date),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
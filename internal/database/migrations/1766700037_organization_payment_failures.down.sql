@@ -0,0 +1,2 @@
+ALTER TABLE organizations DROP COLUMN payment_failed_invoice_id;
+ALTER TABLE organizations DROP COLUMN payment_failed_at;
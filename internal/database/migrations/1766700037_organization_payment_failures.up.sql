@@ -0,0 +1,4 @@
+-- Set when Stripe fails to collect an invoice and cleared once an invoice is paid.
+ALTER TABLE organizations
+    ADD COLUMN payment_failed_at TIMESTAMP NULL AFTER labels,
+    ADD COLUMN payment_failed_invoice_id VARCHAR(255) NULL AFTER payment_failed_at;
@@ -0,0 +1,3 @@
+ALTER TABLE organizations DROP INDEX idx_organizations_billing_state;
+ALTER TABLE organizations DROP COLUMN billing_state_changed_at;
+ALTER TABLE organizations DROP COLUMN billing_state;
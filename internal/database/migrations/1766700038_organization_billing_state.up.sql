@@ -0,0 +1,6 @@
+-- Dunning lifecycle: active -> past_due -> suspended -> scheduled_for_deletion.
+-- billing_state_changed_at anchors the grace period for the next transition.
+ALTER TABLE organizations
+    ADD COLUMN billing_state ENUM('active', 'past_due', 'suspended', 'scheduled_for_deletion') NOT NULL DEFAULT 'active' AFTER payment_failed_invoice_id,
+    ADD COLUMN billing_state_changed_at TIMESTAMP NULL AFTER billing_state,
+    ADD INDEX idx_organizations_billing_state (billing_state, billing_state_changed_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS usage_reports;
+DROP TABLE IF EXISTS metered_prices;
+ALTER TABLE sites DROP COLUMN disk_used_bytes;
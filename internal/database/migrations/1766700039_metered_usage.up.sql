@@ -0,0 +1,43 @@
+-- Latest data disk usage reported by each site's VM controller on check-in.
+ALTER TABLE sites
+    ADD COLUMN disk_used_bytes BIGINT NULL AFTER checkin_at;
+
+-- Stripe metered prices for usage_records metrics. Usage is pushed to Stripe
+-- as billing meter events named stripe_meter_event_name.
+CREATE TABLE IF NOT EXISTS metered_prices (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+
+    metric VARCHAR(64) NOT NULL UNIQUE COMMENT 'usage_records metric, e.g. egress_bytes',
+
+    -- Stripe pricing
+    stripe_price_id VARCHAR(255) NOT NULL COMMENT 'Stripe metered price ID',
+    stripe_meter_event_name VARCHAR(255) NOT NULL COMMENT 'Stripe billing meter event name',
+    unit_bytes BIGINT NOT NULL DEFAULT 1000000000 COMMENT 'Bytes per billed unit',
+    unit_price_cents INT NOT NULL COMMENT 'Price per billed unit in cents (for previews)',
+    included_units BIGINT NOT NULL DEFAULT 0 COMMENT 'Free units per billing period',
+
+    -- Status
+    active BOOLEAN DEFAULT TRUE,
+
+    -- Timestamps
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- One row per organization, metric and day pushed to Stripe, so the daily
+-- report job never double-bills a day.
+CREATE TABLE IF NOT EXISTS usage_reports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    metric VARCHAR(64) NOT NULL,
+    usage_date DATE NOT NULL,
+    quantity BIGINT NOT NULL COMMENT 'Raw metric quantity for the day',
+    units BIGINT NOT NULL COMMENT 'Billed units sent to Stripe',
+    stripe_subscription_item_id VARCHAR(255) NULL,
+    stripe_identifier VARCHAR(255) NOT NULL COMMENT 'Meter event identifier (idempotency key)',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY unique_org_metric_date (organization_id, metric, usage_date),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+ALTER TABLE onboarding_sessions
+    DROP COLUMN discount_description,
+    DROP COLUMN promo_code;
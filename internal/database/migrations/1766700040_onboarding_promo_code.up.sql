@@ -0,0 +1,4 @@
+-- Promotion code entered during onboarding and the discount it grants, shown in the checkout summary.
+ALTER TABLE onboarding_sessions
+    ADD COLUMN promo_code VARCHAR(255) NULL AFTER disk_size_gb,
+    ADD COLUMN discount_description VARCHAR(255) NULL AFTER promo_code;
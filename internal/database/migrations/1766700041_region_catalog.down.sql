@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS region_machine_series;
+DROP TABLE IF EXISTS regions;
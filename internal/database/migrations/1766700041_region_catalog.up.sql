@@ -0,0 +1,88 @@
+-- Catalog of GCP regions offered to customers, replacing the list hardcoded in
+-- the onboarding package. relative_price and the coordinates are display hints:
+-- billing always uses the machine type's Stripe price.
+CREATE TABLE IF NOT EXISTS regions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+
+    code VARCHAR(64) NOT NULL UNIQUE COMMENT 'GCP region code (e.g., us-central1)',
+    display_name VARCHAR(255) NOT NULL COMMENT 'Human-readable location (e.g., Iowa)',
+    country VARCHAR(16) NOT NULL COMMENT 'Country/area grouping code (e.g., us, eu)',
+    country_display_name VARCHAR(255) NOT NULL,
+
+    -- Hints
+    latitude DOUBLE NOT NULL COMMENT 'Approximate location, for picking the nearest region',
+    longitude DOUBLE NOT NULL,
+    relative_price DOUBLE NOT NULL DEFAULT 1.00 COMMENT 'GCP compute cost relative to the cheapest region',
+
+    -- Status
+    active BOOLEAN DEFAULT TRUE COMMENT 'Whether this region is offered for new projects',
+    sort_order INT NOT NULL DEFAULT 0,
+
+    -- Timestamps
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_country (country),
+    INDEX idx_active (active)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Machine series (the machine type prefix, e.g. n4 for n4-standard-2) each
+-- region offers. A machine type is available in a region when its series is listed.
+CREATE TABLE IF NOT EXISTS region_machine_series (
+    region_id BIGINT NOT NULL,
+    series VARCHAR(32) NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (region_id, series),
+    FOREIGN KEY (region_id) REFERENCES regions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO regions (code, display_name, country, country_display_name, latitude, longitude, relative_price, sort_order) VALUES
+    ('us-central1', 'Iowa', 'us', 'United States', 41.26, -95.86, 1.00, 100),
+    ('us-east1', 'South Carolina', 'us', 'United States', 33.20, -80.01, 1.00, 101),
+    ('us-east4', 'Northern Virginia', 'us', 'United States', 39.04, -77.49, 1.13, 102),
+    ('us-east5', 'Columbus, Ohio', 'us', 'United States', 39.96, -83.00, 1.00, 103),
+    ('us-south1', 'Dallas, Texas', 'us', 'United States', 32.78, -96.80, 1.18, 104),
+    ('us-west1', 'Oregon', 'us', 'United States', 45.60, -121.18, 1.00, 105),
+    ('us-west2', 'Los Angeles', 'us', 'United States', 34.05, -118.24, 1.20, 106),
+    ('us-west3', 'Salt Lake City', 'us', 'United States', 40.76, -111.89, 1.20, 107),
+    ('us-west4', 'Las Vegas', 'us', 'United States', 36.17, -115.14, 1.13, 108),
+    ('northamerica-northeast1', 'Montreal', 'ca', 'Canada', 45.50, -73.57, 1.11, 200),
+    ('northamerica-northeast2', 'Toronto', 'ca', 'Canada', 43.65, -79.38, 1.11, 201),
+    ('europe-west1', 'Belgium', 'eu', 'Europe', 50.45, 3.82, 1.10, 300),
+    ('europe-west2', 'London, UK', 'eu', 'Europe', 51.51, -0.13, 1.29, 301),
+    ('europe-west3', 'Frankfurt, Germany', 'eu', 'Europe', 50.11, 8.68, 1.29, 302),
+    ('europe-west4', 'Netherlands', 'eu', 'Europe', 53.44, 6.84, 1.10, 303),
+    ('europe-west6', 'Zurich, Switzerland', 'eu', 'Europe', 47.38, 8.54, 1.40, 304),
+    ('europe-west8', 'Milan, Italy', 'eu', 'Europe', 45.46, 9.19, 1.17, 305),
+    ('europe-west9', 'Paris, France', 'eu', 'Europe', 48.86, 2.35, 1.17, 306),
+    ('europe-west10', 'Berlin, Germany', 'eu', 'Europe', 52.52, 13.40, 1.29, 307),
+    ('europe-west12', 'Turin, Italy', 'eu', 'Europe', 45.07, 7.69, 1.17, 308),
+    ('europe-north1', 'Finland', 'eu', 'Europe', 60.57, 27.19, 1.10, 309),
+    ('europe-central2', 'Warsaw, Poland', 'eu', 'Europe', 52.23, 21.01, 1.29, 310),
+    ('europe-southwest1', 'Madrid, Spain', 'eu', 'Europe', 40.42, -3.70, 1.17, 311),
+    ('asia-east1', 'Taiwan', 'asia', 'Asia Pacific', 24.05, 120.52, 1.16, 400),
+    ('asia-east2', 'Hong Kong', 'asia', 'Asia Pacific', 22.32, 114.17, 1.40, 401),
+    ('asia-northeast1', 'Tokyo, Japan', 'asia', 'Asia Pacific', 35.68, 139.69, 1.29, 402),
+    ('asia-northeast2', 'Osaka, Japan', 'asia', 'Asia Pacific', 34.69, 135.50, 1.29, 403),
+    ('asia-northeast3', 'Seoul, South Korea', 'asia', 'Asia Pacific', 37.57, 126.98, 1.29, 404),
+    ('asia-south1', 'Mumbai, India', 'asia', 'Asia Pacific', 19.08, 72.88, 1.20, 405),
+    ('asia-south2', 'Delhi, India', 'asia', 'Asia Pacific', 28.70, 77.10, 1.20, 406),
+    ('asia-southeast1', 'Singapore', 'asia', 'Asia Pacific', 1.35, 103.82, 1.23, 407),
+    ('asia-southeast2', 'Jakarta, Indonesia', 'asia', 'Asia Pacific', -6.21, 106.85, 1.31, 408),
+    ('australia-southeast1', 'Sydney', 'au', 'Australia', -33.87, 151.21, 1.42, 500),
+    ('australia-southeast2', 'Melbourne', 'au', 'Australia', -37.81, 144.96, 1.42, 501),
+    ('southamerica-east1', 'Sao Paulo, Brazil', 'sa', 'South America', -23.55, -46.63, 1.59, 600),
+    ('southamerica-west1', 'Santiago, Chile', 'sa', 'South America', -33.45, -70.67, 1.42, 601);
+
+-- e2 is offered everywhere; n4 is not yet available in every region
+INSERT INTO region_machine_series (region_id, series)
+SELECT id, 'e2' FROM regions;
+
+INSERT INTO region_machine_series (region_id, series)
+SELECT id, 'n4' FROM regions
+WHERE code NOT IN (
+    'us-west3', 'northamerica-northeast2', 'europe-west10', 'europe-west12', 'asia-east2',
+    'asia-south2', 'asia-southeast2', 'australia-southeast2', 'southamerica-west1'
+);
@@ -0,0 +1,20 @@
+-- Audit trail of every transactional email the API attempts to send.
+CREATE TABLE IF NOT EXISTS email_sends (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+
+    template VARCHAR(64) NOT NULL COMMENT 'Message template, e.g. verification',
+    locale VARCHAR(16) NOT NULL COMMENT 'Locale the message was rendered in',
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+
+    -- Delivery
+    provider VARCHAR(32) NOT NULL COMMENT 'Provider that handled the send: log, smtp, sendgrid or ses',
+    provider_message_id VARCHAR(255) NULL,
+    status ENUM('sent', 'failed') NOT NULL,
+    error TEXT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_recipient (recipient),
+    INDEX idx_template_created (template, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,17 @@
+-- In-app notifications shown in the dashboard's notification center.
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    account_id BIGINT NOT NULL,
+    type VARCHAR(64) NOT NULL COMMENT 'deploy_finished, member_added or certificate_expiring',
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    link VARCHAR(512) NOT NULL DEFAULT '' COMMENT 'Dashboard path the notification opens',
+
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_account_read (account_id, read_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,25 @@
+-- Slack and Microsoft Teams channels that receive an organization's alerts.
+CREATE TABLE IF NOT EXISTS notification_channels (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    organization_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind ENUM('slack_webhook', 'slack_app', 'teams_webhook') NOT NULL,
+    webhook_url VARCHAR(1024) NOT NULL DEFAULT '' COMMENT 'Incoming webhook URL for slack_webhook and teams_webhook',
+    slack_bot_token VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Bot token for slack_app',
+    slack_channel VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Channel ID or name for slack_app',
+    categories JSON NOT NULL COMMENT 'Subscribed alert categories: deployments, reconciliation_failures, billing, security',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    last_delivery_at TIMESTAMP NULL,
+    last_error TEXT NULL COMMENT 'Error from the most recent delivery, NULL when it succeeded',
+
+    created_by BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_organization_enabled (organization_id, enabled),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS site_incidents;
+DELETE FROM notification_channels WHERE kind IN ('pagerduty', 'opsgenie');
+ALTER TABLE notification_channels DROP COLUMN integration_key;
+ALTER TABLE notification_channels MODIFY COLUMN kind ENUM('slack_webhook', 'slack_app', 'teams_webhook') NOT NULL;
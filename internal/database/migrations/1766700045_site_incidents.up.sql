@@ -0,0 +1,26 @@
+-- PagerDuty and Opsgenie escalation: downtime channels and the incidents they are paged for.
+ALTER TABLE notification_channels
+    MODIFY COLUMN kind ENUM('slack_webhook', 'slack_app', 'teams_webhook', 'pagerduty', 'opsgenie') NOT NULL,
+    ADD COLUMN integration_key VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'PagerDuty routing key or Opsgenie API key' AFTER slack_channel;
+
+-- A site incident is open while the site is considered down. open_site_id is the
+-- site ID while the incident is open and NULL once resolved, so each site has at
+-- most one open incident even with several API instances sweeping.
+CREATE TABLE IF NOT EXISTS site_incidents (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    organization_id BIGINT NOT NULL,
+    site_id BIGINT NOT NULL,
+    open_site_id BIGINT NULL UNIQUE,
+    cause ENUM('checkin_missed') NOT NULL,
+    status ENUM('open', 'resolved') NOT NULL DEFAULT 'open',
+
+    opened_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP NULL,
+
+    INDEX idx_site_opened (site_id, opened_at),
+    INDEX idx_status (status),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
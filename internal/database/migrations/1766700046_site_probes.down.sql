@@ -0,0 +1,4 @@
+DELETE FROM site_incidents WHERE cause = 'probe_failed';
+ALTER TABLE site_incidents MODIFY COLUMN cause ENUM('checkin_missed') NOT NULL;
+DROP TABLE IF EXISTS site_probes;
+ALTER TABLE sites DROP COLUMN health_check_path;
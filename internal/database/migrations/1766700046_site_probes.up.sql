@@ -0,0 +1,22 @@
+-- External HTTP uptime probes of each site's health URL.
+ALTER TABLE sites
+    ADD COLUMN health_check_path VARCHAR(255) NOT NULL DEFAULT '/' COMMENT 'Path requested by uptime probes' AFTER port;
+
+CREATE TABLE IF NOT EXISTS site_probes (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+    probed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    up BOOLEAN NOT NULL,
+    status_code INT NOT NULL DEFAULT 0 COMMENT 'HTTP status, 0 when no response was received',
+    response_time_ms INT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NOT NULL DEFAULT '',
+
+    INDEX idx_site_probed (site_id, probed_at),
+    INDEX idx_probed (probed_at),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Failing probes open downtime incidents too: check-ins miss nginx-level outages.
+ALTER TABLE site_incidents
+    MODIFY COLUMN cause ENUM('checkin_missed', 'probe_failed') NOT NULL;
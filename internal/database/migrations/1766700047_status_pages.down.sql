@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS status_page_updates;
+DROP TABLE IF EXISTS status_page_sites;
+DROP TABLE IF EXISTS status_pages;
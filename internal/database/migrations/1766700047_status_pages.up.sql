@@ -0,0 +1,45 @@
+-- Status pages: one per organization, listing chosen sites with their uptime and posted incident updates.
+CREATE TABLE IF NOT EXISTS status_pages (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    organization_id BIGINT NOT NULL UNIQUE,
+    slug VARCHAR(63) NOT NULL UNIQUE COMMENT 'DNS label, served as status.<slug>.<status page domain>',
+    title VARCHAR(255) NOT NULL,
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS status_page_sites (
+    status_page_id BIGINT NOT NULL,
+    site_id BIGINT NOT NULL,
+    display_name VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Shown instead of the site name when set',
+    position INT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (status_page_id, site_id),
+    FOREIGN KEY (status_page_id) REFERENCES status_pages(id) ON DELETE CASCADE,
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS status_page_updates (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    status_page_id BIGINT NOT NULL,
+    site_incident_id BIGINT NULL,
+    status ENUM('investigating', 'identified', 'monitoring', 'resolved') NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_page_created (status_page_id, created_at),
+    FOREIGN KEY (status_page_id) REFERENCES status_pages(id) ON DELETE CASCADE,
+    FOREIGN KEY (site_incident_id) REFERENCES site_incidents(id) ON DELETE SET NULL,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
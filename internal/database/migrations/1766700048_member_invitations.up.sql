@@ -0,0 +1,23 @@
+-- Member invitations: a pending organization, project or site membership for an email
+-- address with no account yet, accepted through an emailed link.
+CREATE TABLE IF NOT EXISTS member_invitations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    token_hash CHAR(64) NOT NULL UNIQUE COMMENT 'SHA-256 of the token in the accept link',
+
+    email VARCHAR(255) NOT NULL,
+    resource_type ENUM('organization', 'project', 'site') NOT NULL,
+    resource_id BIGINT NOT NULL COMMENT 'organizations.id, projects.id or sites.id',
+    role ENUM('owner', 'developer', 'read') NOT NULL DEFAULT 'read',
+    status ENUM('pending', 'accepted', 'revoked') NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+
+    accepted_by BIGINT NULL,
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_resource_email (resource_type, resource_id, email),
+    FOREIGN KEY (accepted_by) REFERENCES accounts(id) ON DELETE SET NULL,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+ALTER TABLE deployments
+    DROP INDEX idx_deployments_site_started,
+    DROP COLUMN created_by,
+    DROP COLUMN rollback_of,
+    DROP COLUMN commit_message,
+    DROP COLUMN commit_sha,
+    DROP COLUMN git_ref;
@@ -0,0 +1,9 @@
+-- What each deployment deployed and who asked for it, for the dashboard's deployment history.
+-- rollback_of points at the earlier deployment whose commit a rollback redeployed.
+ALTER TABLE deployments
+    ADD COLUMN git_ref VARCHAR(255) NULL COMMENT 'Branch, tag or commit requested' AFTER `status`,
+    ADD COLUMN commit_sha VARCHAR(40) NULL AFTER git_ref,
+    ADD COLUMN commit_message TEXT NULL AFTER commit_sha,
+    ADD COLUMN rollback_of VARCHAR(255) NULL AFTER commit_message,
+    ADD COLUMN created_by BIGINT NULL AFTER created_at,
+    ADD INDEX idx_deployments_site_started (site_id, started_at);
@@ -0,0 +1,3 @@
+ALTER TABLE ssh_keys
+    DROP INDEX idx_ssh_keys_expires,
+    DROP COLUMN expires_at;
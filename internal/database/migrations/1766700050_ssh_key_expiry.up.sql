@@ -0,0 +1,5 @@
+-- Short-lived keys the API provisions for browser terminal sessions.
+-- Expired keys are no longer served to site VMs and are hidden from key listings.
+ALTER TABLE ssh_keys
+    ADD COLUMN expires_at TIMESTAMP NULL COMMENT 'NULL for keys users added themselves',
+    ADD INDEX idx_ssh_keys_expires (expires_at);
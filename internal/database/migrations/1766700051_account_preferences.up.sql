@@ -0,0 +1,14 @@
+-- Dashboard preferences of each account. Accounts without a row use the defaults.
+CREATE TABLE IF NOT EXISTS account_preferences (
+    account_id BIGINT PRIMARY KEY,
+    theme ENUM('system', 'light', 'dark') NOT NULL DEFAULT 'system',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' COMMENT 'IANA time zone dashboard timestamps are shown in',
+    default_organization_id BIGINT NULL COMMENT 'Organization preselected in dashboard forms',
+    page_size INT NOT NULL DEFAULT 50 COMMENT 'Rows per page in dashboard tables',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (default_organization_id) REFERENCES organizations(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
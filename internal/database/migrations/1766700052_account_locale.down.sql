@@ -0,0 +1 @@
+ALTER TABLE account_preferences DROP COLUMN locale;
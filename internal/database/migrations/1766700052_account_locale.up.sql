@@ -0,0 +1,3 @@
+-- Language the dashboard is shown in. Empty follows the browser's Accept-Language header.
+ALTER TABLE account_preferences
+    ADD COLUMN locale VARCHAR(16) NOT NULL DEFAULT '' COMMENT 'Dashboard language, empty to follow the browser';
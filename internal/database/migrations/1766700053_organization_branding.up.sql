@@ -0,0 +1,14 @@
+-- Logo and colors an organization's dashboard pages and notification emails are
+-- shown with. Organizations without a row use the libops defaults.
+CREATE TABLE IF NOT EXISTS organization_branding (
+    organization_id BIGINT PRIMARY KEY,
+    primary_color CHAR(7) NOT NULL DEFAULT '' COMMENT 'Hex color of buttons and headers, empty for the default',
+    accent_color CHAR(7) NOT NULL DEFAULT '' COMMENT 'Hex color of links and highlights, empty for the default',
+    logo MEDIUMBLOB NULL COMMENT 'PNG or JPEG image, at most 256 KiB',
+    logo_content_type VARCHAR(32) NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
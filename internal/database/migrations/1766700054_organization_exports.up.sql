@@ -0,0 +1,27 @@
+-- Organization exports: an offboarding bundle of an organization's configuration,
+-- built in the background and uploaded to the export bucket for download.
+CREATE TABLE IF NOT EXISTS organization_exports (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+
+    include_secrets BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Whether secret values are read from Vault into the bundle',
+    status ENUM('pending', 'running', 'succeeded', 'failed') NOT NULL DEFAULT 'pending',
+    error TEXT NULL,
+
+    -- Bundle, once built
+    object_name VARCHAR(255) NULL COMMENT 'Object in the export bucket',
+    size_bytes BIGINT NULL,
+    sha256 CHAR(64) NULL,
+    expires_at TIMESTAMP NULL COMMENT 'When the bundle is deleted from the bucket',
+
+    started_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_organization_created (organization_id, created_at),
+    INDEX idx_status_created (status, created_at),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,24 @@
+-- Organization ownership transfers: an owner hands an organization to another
+-- member, who becomes an owner once they accept before expires_at.
+CREATE TABLE IF NOT EXISTS organization_ownership_transfers (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+
+    from_account_id BIGINT NOT NULL COMMENT 'Owner handing the organization over, demoted to developer on acceptance',
+    to_account_id BIGINT NOT NULL COMMENT 'Member who becomes an owner on acceptance',
+    status ENUM('pending', 'accepted', 'cancelled') NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+
+    accepted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_organization_status (organization_id, status),
+    INDEX idx_to_account_status (to_account_id, status),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,19 @@
+-- Device authorizations: the OAuth 2.0 device flow (RFC 8628) the libops CLI signs in
+-- with. The CLI shows a user code, the user approves it in the dashboard and the CLI
+-- exchanges its device code for an API key.
+CREATE TABLE IF NOT EXISTS device_authorizations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    device_code_hash CHAR(64) NOT NULL UNIQUE COMMENT 'SHA-256 of the device code the CLI polls with',
+    user_code CHAR(9) NOT NULL UNIQUE COMMENT 'Code the user types in, e.g. WDJB-MJHT',
+    client_name VARCHAR(255) NOT NULL COMMENT 'Shown on the approval page and used to name the API key',
+
+    status ENUM('pending', 'approved', 'denied', 'redeemed') NOT NULL DEFAULT 'pending',
+    account_id BIGINT NULL COMMENT 'Account that approved or denied the request',
+    expires_at TIMESTAMP NOT NULL,
+    last_polled_at TIMESTAMP NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP NULL,
+
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+ALTER TABLE organizations
+    DROP COLUMN suspended_by,
+    DROP COLUMN suspended_reason,
+    DROP COLUMN suspended_at;
@@ -0,0 +1,6 @@
+-- Operators suspend abusive organizations from the admin console. This is separate
+-- from the billing suspension in billing_state: sites only resume once neither applies.
+ALTER TABLE organizations
+    ADD COLUMN suspended_at TIMESTAMP NULL COMMENT 'When an operator suspended the organization',
+    ADD COLUMN suspended_reason VARCHAR(500) NULL COMMENT 'Why an operator suspended the organization',
+    ADD COLUMN suspended_by BIGINT NULL COMMENT 'Account of the operator who suspended the organization';
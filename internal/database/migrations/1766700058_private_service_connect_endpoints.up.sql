@@ -0,0 +1,23 @@
+-- Private Service Connect endpoints: addresses in an organization's network
+-- that reach a LibOps service privately. The API records which endpoints an
+-- organization should have; the terraform runner creates the forwarding rules
+-- and reports the address each one got, so nothing has to guess it.
+CREATE TABLE IF NOT EXISTS private_service_connect_endpoints (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+
+    target ENUM('api', 'orchestrator', 'smtp_relay') NOT NULL COMMENT 'LibOps service the endpoint connects to',
+    requested_ip_address VARCHAR(45) NULL COMMENT 'Address to reserve in the organization subnet; terraform picks one when NULL',
+    ip_address VARCHAR(45) NULL COMMENT 'Address reported by the terraform runner once the forwarding rule exists',
+    forwarding_rule VARCHAR(255) NULL,
+    status ENUM('provisioning', 'active', 'deleting') NOT NULL DEFAULT 'provisioning',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY uniq_organization_target (organization_id, target),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS site_static_egress_ips;
@@ -0,0 +1,17 @@
+-- Static egress IPs: a reserved external address a site's outbound traffic
+-- leaves from, so vendors that allowlist by IP can be given one that won't
+-- change. The terraform runner reserves it and reports the address back.
+CREATE TABLE IF NOT EXISTS site_static_egress_ips (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    ip_address VARCHAR(45) NULL COMMENT 'Address reported by the terraform runner once it is reserved',
+    status ENUM('provisioning', 'active', 'releasing') NOT NULL DEFAULT 'provisioning',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS site_cache_purges;
+DROP TABLE IF EXISTS site_cdn_configs;
@@ -0,0 +1,37 @@
+-- Site CDN: a managed Cloud CDN load balancer in front of a site. The API
+-- records the cache policy; the terraform runner builds the load balancer and
+-- reports the address the site's domains should point at.
+CREATE TABLE IF NOT EXISTS site_cdn_configs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    cache_mode ENUM('cache_all_static', 'use_origin_headers', 'force_cache_all') NOT NULL DEFAULT 'cache_all_static',
+    default_ttl_seconds INT NOT NULL DEFAULT 3600 COMMENT 'TTL for responses without cache headers of their own',
+    ip_address VARCHAR(45) NULL COMMENT 'Load balancer address reported by the terraform runner',
+    status ENUM('provisioning', 'active', 'disabling') NOT NULL DEFAULT 'provisioning',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Cache purges waiting for the site's next reconciliation to invalidate them
+CREATE TABLE IF NOT EXISTS site_cache_purges (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    path VARCHAR(1024) NOT NULL DEFAULT '/*' COMMENT 'Path to invalidate; a trailing * matches a prefix',
+    status ENUM('pending', 'completed') NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL,
+    created_by BIGINT NULL,
+
+    INDEX idx_site_status (site_id, status),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
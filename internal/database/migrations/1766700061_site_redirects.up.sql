@@ -0,0 +1,21 @@
+-- Site redirects: rules the site's controller renders into its reverse proxy,
+-- so URLs can move without changing the application.
+CREATE TABLE IF NOT EXISTS site_redirects (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    from_host VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Host to match; empty matches every host',
+    from_path VARCHAR(1024) NOT NULL COMMENT 'Path to match; a trailing * matches a prefix',
+    to_url VARCHAR(2048) NOT NULL COMMENT 'Path or absolute URL; a trailing * is replaced by the matched suffix',
+    status_code SMALLINT NOT NULL DEFAULT 301,
+    preserve_query BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY uniq_site_source (site_id, from_host, from_path(512)),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
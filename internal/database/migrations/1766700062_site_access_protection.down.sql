@@ -0,0 +1,2 @@
+ALTER TABLE sites DROP COLUMN access_gate_secret;
+DROP TABLE IF EXISTS site_access_protections;
@@ -0,0 +1,22 @@
+-- Site access protection: keeps a site behind HTTP basic auth or a members
+-- only gate. The controller renders it into the site's nginx. Sites without a
+-- row fall back to a default: members only unless the site is production.
+CREATE TABLE IF NOT EXISTS site_access_protections (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    mode ENUM('none', 'basic_auth', 'members_only') NOT NULL,
+    basic_auth_username VARCHAR(255) NULL,
+    basic_auth_password_hash VARCHAR(255) NULL COMMENT 'bcrypt hash, written to the htpasswd file nginx reads',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Key a site's members only gate signs its tickets and cookies with. It is
+-- kept apart from the setting so switching modes doesn't log everyone out.
+ALTER TABLE sites ADD COLUMN access_gate_secret VARCHAR(64) NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS site_waf_blocks;
+DROP TABLE IF EXISTS site_waf_rule_exclusions;
+DROP TABLE IF EXISTS site_waf_configs;
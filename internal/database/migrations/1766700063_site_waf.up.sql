@@ -0,0 +1,53 @@
+-- Site WAF: the OWASP core rule set run by ModSecurity in the site's nginx.
+-- The controller renders the config and reports the requests it matched on
+-- check-in. Sites without a row have no WAF.
+CREATE TABLE IF NOT EXISTS site_waf_configs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    mode ENUM('detection_only', 'blocking') NOT NULL DEFAULT 'blocking',
+    paranoia_level TINYINT NOT NULL DEFAULT 1 COMMENT 'OWASP CRS paranoia level, 1 to 4',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Core rule set rules a site turns off, everywhere or under a path
+CREATE TABLE IF NOT EXISTS site_waf_rule_exclusions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    rule_id INT NOT NULL,
+    path_prefix VARCHAR(512) NOT NULL DEFAULT '' COMMENT 'Empty excludes the rule everywhere',
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY unique_site_rule_path (site_id, rule_id, path_prefix),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Requests the WAF matched, as reported by the controller; kept for 30 days
+CREATE TABLE IF NOT EXISTS site_waf_blocks (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+
+    rule_id INT NOT NULL,
+    message VARCHAR(255) NOT NULL DEFAULT '',
+    method VARCHAR(16) NOT NULL DEFAULT '',
+    uri VARCHAR(1024) NOT NULL DEFAULT '',
+    client_ip VARCHAR(45) NOT NULL DEFAULT '',
+    blocked BOOLEAN NOT NULL COMMENT 'False when the WAF only detected the request',
+    occurred_at TIMESTAMP NOT NULL,
+
+    INDEX idx_site_occurred (site_id, occurred_at),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
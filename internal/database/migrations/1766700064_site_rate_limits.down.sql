@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS site_rate_limit_rejections;
+DROP TABLE IF EXISTS site_rate_limit_rules;
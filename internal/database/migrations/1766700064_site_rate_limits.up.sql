@@ -0,0 +1,30 @@
+-- Per-site HTTP rate limits, rendered into nginx limit_req zones by the
+-- site's controller
+CREATE TABLE IF NOT EXISTS site_rate_limit_rules (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    name VARCHAR(255) NOT NULL,
+    path_prefix VARCHAR(512) NOT NULL DEFAULT '' COMMENT 'Empty limits every request',
+    requests INT NOT NULL COMMENT 'Requests each client IP may make per window',
+    rate_window ENUM('second', 'minute') NOT NULL,
+    burst INT NOT NULL DEFAULT 0 COMMENT 'Requests over the rate let through before rejecting',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_site (site_id),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Requests each rule rejected per day, as reported by the controller
+CREATE TABLE IF NOT EXISTS site_rate_limit_rejections (
+    rule_id BIGINT NOT NULL,
+    rejected_date DATE NOT NULL,
+    rejected BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (rule_id, rejected_date),
+    FOREIGN KEY (rule_id) REFERENCES site_rate_limit_rules(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS site_tls_policies;
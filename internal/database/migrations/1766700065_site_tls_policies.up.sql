@@ -0,0 +1,31 @@
+-- Per-site TLS policy, enforced by the site's controller on its proxy and
+-- checked with a probe after each reconciliation. Sites without a row get
+-- the defaults: TLS 1.2 and up, OCSP stapling, no HSTS and ACME certificates.
+CREATE TABLE IF NOT EXISTS site_tls_policies (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    min_version ENUM('1.2', '1.3') NOT NULL DEFAULT '1.2',
+    hsts_max_age_seconds INT NOT NULL DEFAULT 0 COMMENT '0 sends no HSTS header',
+    hsts_include_subdomains BOOLEAN NOT NULL DEFAULT FALSE,
+    hsts_preload BOOLEAN NOT NULL DEFAULT FALSE,
+    ocsp_stapling BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- A custom certificate replaces the ACME one; its private key is kept in
+    -- the organization's Vault
+    certificate_source ENUM('acme', 'custom') NOT NULL DEFAULT 'acme',
+    certificate_pem MEDIUMTEXT NULL,
+    private_key_vault_path VARCHAR(255) NULL,
+    certificate_not_after TIMESTAMP NULL,
+
+    probe_status ENUM('pending', 'passed', 'failed') NOT NULL DEFAULT 'pending',
+    probe_message VARCHAR(1024) NOT NULL DEFAULT '',
+    probed_at TIMESTAMP NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+ALTER TABLE site_secrets DROP COLUMN managed;
+DROP TABLE IF EXISTS site_databases;
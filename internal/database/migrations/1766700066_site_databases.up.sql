@@ -0,0 +1,29 @@
+-- Site databases: MySQL databases libops manages for a site, either on a MySQL
+-- server the site's controller runs next to the site or on a Cloud SQL
+-- instance terraform builds for it. Their credentials reach the site as
+-- managed site secrets.
+CREATE TABLE IF NOT EXISTS site_databases (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    name VARCHAR(32) NOT NULL COMMENT 'Database name, also the name of its user',
+    tier ENUM('colocated', 'cloud_sql') NOT NULL DEFAULT 'colocated',
+    status ENUM('provisioning', 'active', 'failed') NOT NULL DEFAULT 'provisioning',
+    status_message VARCHAR(1024) NULL COMMENT 'Why provisioning failed',
+    host VARCHAR(255) NULL COMMENT 'Address the site reaches the database at, reported once it is provisioned',
+    port INT NOT NULL DEFAULT 3306,
+
+    password_rotated_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY unique_site_database_name (site_id, name),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Managed secrets are written by libops, like a database's credentials, and
+-- can't be changed or deleted through the secrets API
+ALTER TABLE site_secrets ADD COLUMN managed BOOLEAN NOT NULL DEFAULT FALSE AFTER status;
@@ -0,0 +1,24 @@
+-- Site add-ons: services libops runs for a site next to its compose stack,
+-- like Solr for an Islandora site. The site's controller runs them and reports
+-- their health; their addresses and credentials reach the site as managed
+-- site secrets.
+CREATE TABLE IF NOT EXISTS site_addons (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+
+    kind ENUM('solr', 'memcached', 'redis', 'matomo') NOT NULL,
+    status ENUM('provisioning', 'healthy', 'unhealthy') NOT NULL DEFAULT 'provisioning',
+    status_message VARCHAR(1024) NULL COMMENT 'Why the add-on is unhealthy',
+    host VARCHAR(255) NULL COMMENT 'Address the site reaches the add-on at, reported once it runs',
+    port INT NULL,
+    checked_at TIMESTAMP NULL COMMENT 'When the controller last reported the add-on''s health',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY unique_site_addon_kind (site_id, kind),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+ALTER TABLE ssh_access DROP COLUMN sftp_only;
@@ -0,0 +1,3 @@
+-- SFTP-only SSH access: the account gets a chrooted, shell-less login to the
+-- site's files directory instead of a shell on the site's VM
+ALTER TABLE ssh_access ADD COLUMN sftp_only BOOLEAN NOT NULL DEFAULT FALSE AFTER site_id;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS site_config_var_revisions;
+DROP TABLE IF EXISTS site_config_vars;
@@ -0,0 +1,37 @@
+-- Site config vars: plaintext environment variables, such as feature flags or
+-- PHP memory limits, delivered to the site alongside its secrets.
+CREATE TABLE IF NOT EXISTS site_config_vars (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+
+    name VARCHAR(255) NOT NULL,
+    value TEXT NOT NULL,
+    revision BIGINT NOT NULL COMMENT 'Revision that last set the value',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    UNIQUE KEY uniq_site_name (site_id, name),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Every change to a site's config vars, numbered per site, so any two
+-- revisions can be diffed.
+CREATE TABLE IF NOT EXISTS site_config_var_revisions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+    revision BIGINT NOT NULL,
+
+    name VARCHAR(255) NOT NULL,
+    previous_value TEXT NULL COMMENT 'NULL when the change added the var',
+    value TEXT NULL COMMENT 'NULL when the change removed the var',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY uniq_site_revision (site_id, revision),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+ALTER TABLE site_settings DROP COLUMN allowed_values, DROP COLUMN value_type;
+ALTER TABLE project_settings DROP COLUMN allowed_values, DROP COLUMN value_type;
+ALTER TABLE organization_settings DROP COLUMN allowed_values, DROP COLUMN value_type;
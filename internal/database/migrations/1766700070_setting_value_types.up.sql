@@ -0,0 +1,13 @@
+-- Typed settings: a setting's value is checked against its type, and enum
+-- settings against the values they allow, whenever it is written
+ALTER TABLE organization_settings
+    ADD COLUMN value_type ENUM('string', 'int', 'bool', 'enum') NOT NULL DEFAULT 'string' AFTER setting_value,
+    ADD COLUMN allowed_values JSON DEFAULT NULL COMMENT 'Values an enum setting allows' AFTER value_type;
+
+ALTER TABLE project_settings
+    ADD COLUMN value_type ENUM('string', 'int', 'bool', 'enum') NOT NULL DEFAULT 'string' AFTER setting_value,
+    ADD COLUMN allowed_values JSON DEFAULT NULL COMMENT 'Values an enum setting allows' AFTER value_type;
+
+ALTER TABLE site_settings
+    ADD COLUMN value_type ENUM('string', 'int', 'bool', 'enum') NOT NULL DEFAULT 'string' AFTER setting_value,
+    ADD COLUMN allowed_values JSON DEFAULT NULL COMMENT 'Values an enum setting allows' AFTER value_type;
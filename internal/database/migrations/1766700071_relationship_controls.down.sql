@@ -0,0 +1,5 @@
+DELETE FROM relationships WHERE status = 'revoked';
+ALTER TABLE relationships
+    MODIFY COLUMN status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    DROP COLUMN requested_by,
+    DROP COLUMN max_role;
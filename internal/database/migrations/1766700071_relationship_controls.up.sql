@@ -0,0 +1,7 @@
+-- Relationship controls: the organization granting access caps the role the
+-- other organization's members get in it, and either side can revoke access.
+-- Existing relationships keep the full access they had.
+ALTER TABLE relationships
+    ADD COLUMN max_role ENUM('owner', 'developer', 'read') NOT NULL DEFAULT 'owner' COMMENT 'Highest role source members get in the target organization' AFTER relationship_type,
+    ADD COLUMN requested_by BIGINT NULL COMMENT 'Account ID who requested the relationship' AFTER created_at,
+    MODIFY COLUMN status ENUM('pending', 'approved', 'rejected', 'revoked') NOT NULL DEFAULT 'pending';
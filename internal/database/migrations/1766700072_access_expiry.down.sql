@@ -0,0 +1,15 @@
+ALTER TABLE relationships
+    DROP INDEX idx_relationships_expires,
+    DROP COLUMN expires_at;
+
+ALTER TABLE site_members
+    DROP INDEX idx_site_members_expires,
+    DROP COLUMN expires_at;
+
+ALTER TABLE project_members
+    DROP INDEX idx_project_members_expires,
+    DROP COLUMN expires_at;
+
+ALTER TABLE organization_members
+    DROP INDEX idx_organization_members_expires,
+    DROP COLUMN expires_at;
@@ -0,0 +1,17 @@
+-- Time-bounded access: memberships and relationships past expires_at are
+-- removed or revoked by the API's expiry sweep. NULL never expires.
+ALTER TABLE organization_members
+    ADD COLUMN expires_at TIMESTAMP NULL AFTER status,
+    ADD INDEX idx_organization_members_expires (expires_at);
+
+ALTER TABLE project_members
+    ADD COLUMN expires_at TIMESTAMP NULL AFTER status,
+    ADD INDEX idx_project_members_expires (expires_at);
+
+ALTER TABLE site_members
+    ADD COLUMN expires_at TIMESTAMP NULL AFTER status,
+    ADD INDEX idx_site_members_expires (expires_at);
+
+ALTER TABLE relationships
+    ADD COLUMN expires_at TIMESTAMP NULL AFTER `status`,
+    ADD INDEX idx_relationships_expires (expires_at);
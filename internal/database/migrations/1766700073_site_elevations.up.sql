@@ -0,0 +1,26 @@
+-- Just-in-time elevated access: a site member requests a higher role on the
+-- site for a limited time, such as during a production incident, and a site
+-- owner approves it. The elevated role lapses at expires_at.
+CREATE TABLE IF NOT EXISTS site_elevations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    site_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+
+    role ENUM('owner', 'developer') NOT NULL,
+    reason VARCHAR(1000) NOT NULL,
+    duration_minutes INT NOT NULL COMMENT 'How long the role lasts once approved',
+    `status` ENUM('pending', 'approved', 'rejected', 'revoked', 'expired') NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP NULL,
+    resolved_by BIGINT NULL COMMENT 'Account ID who approved, rejected or revoked it',
+    expires_at TIMESTAMP NULL COMMENT 'Set on approval',
+
+    INDEX idx_site_elevations_site (site_id, `status`),
+    INDEX idx_site_elevations_account (account_id, site_id, `status`),
+    INDEX idx_site_elevations_expires (`status`, expires_at),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (resolved_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
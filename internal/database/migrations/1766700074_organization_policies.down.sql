@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS policy_violations;
+DROP TABLE IF EXISTS organization_policies;
@@ -0,0 +1,44 @@
+-- Organization guardrails: rules the API checks before a change runs, such as
+-- keeping SSH closed to the internet. An enforced policy rejects the change;
+-- an audited one lets it through. Either way a violation is recorded.
+CREATE TABLE IF NOT EXISTS organization_policies (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+
+    `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window') NOT NULL,
+    enforcement ENUM('enforce', 'audit') NOT NULL DEFAULT 'enforce',
+    config JSON DEFAULT NULL COMMENT 'Rule settings, such as the days and hours of a deploy window',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+    updated_by BIGINT NULL,
+
+    UNIQUE KEY uk_organization_policies_rule (organization_id, `rule`),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Changes that broke a policy. Violations outlive the policy they broke.
+CREATE TABLE IF NOT EXISTS policy_violations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+    policy_id BIGINT NULL,
+    account_id BIGINT NULL COMMENT 'Account that made the change',
+
+    `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window') NOT NULL,
+    procedure_name VARCHAR(255) NOT NULL,
+    resource_id VARCHAR(36) NOT NULL COMMENT 'Public ID of the organization, project or site being changed',
+    message VARCHAR(1000) NOT NULL,
+    blocked BOOLEAN NOT NULL COMMENT 'Whether the change was rejected',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_policy_violations_organization (organization_id, created_at),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (policy_id) REFERENCES organization_policies(id) ON DELETE SET NULL,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
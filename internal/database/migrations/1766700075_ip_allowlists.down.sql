@@ -0,0 +1,5 @@
+ALTER TABLE organizations
+    DROP COLUMN allowed_cidrs;
+
+ALTER TABLE api_keys
+    DROP COLUMN allowed_cidrs;
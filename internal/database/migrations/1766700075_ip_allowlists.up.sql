@@ -0,0 +1,8 @@
+-- IP allowlists keep stolen credentials from being used off-network. An API key
+-- only authenticates from its allowed CIDRs, and an organization's allowed CIDRs
+-- apply to everyone reaching it. NULL or an empty list allows any address.
+ALTER TABLE api_keys
+    ADD COLUMN allowed_cidrs JSON DEFAULT NULL COMMENT 'CIDRs the key can be used from';
+
+ALTER TABLE organizations
+    ADD COLUMN allowed_cidrs JSON DEFAULT NULL COMMENT 'CIDRs the organization can be reached from';
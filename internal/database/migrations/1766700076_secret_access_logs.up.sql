@@ -0,0 +1,24 @@
+-- Every read of a secret's value through the API: who read which secret, from
+-- where. Reads that look unusual, like a new principal or a burst of reads,
+-- are flagged with why and reported to the organization's owners.
+CREATE TABLE IF NOT EXISTS secret_access_logs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+    site_id BIGINT NULL COMMENT 'Site the secret was read for',
+    account_id BIGINT NULL COMMENT 'Account that read the secret',
+
+    principal VARCHAR(255) NOT NULL COMMENT 'Email of the user or service account that read the secret',
+    secret_name VARCHAR(255) NOT NULL,
+    source_ip VARCHAR(45) NOT NULL DEFAULT '',
+    procedure_name VARCHAR(255) NOT NULL,
+    anomaly VARCHAR(500) NULL COMMENT 'Why the read was flagged as unusual',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_secret_access_logs_organization (organization_id, created_at),
+    INDEX idx_secret_access_logs_principal (organization_id, principal, created_at),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE SET NULL,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,10 @@
+ALTER TABLE site_secrets
+    DROP COLUMN key_version;
+
+ALTER TABLE project_secrets
+    DROP COLUMN key_version;
+
+ALTER TABLE organization_secrets
+    DROP COLUMN key_version;
+
+DROP TABLE IF EXISTS organization_data_keys;
@@ -0,0 +1,28 @@
+-- Secrets are envelope encrypted before they're written to an organization's
+-- Vault: each value is sealed with the organization's data key, and the data
+-- key is kept here wrapped by a key encryption key in the Vault's transit
+-- engine. Rotating adds a new version; the newest is the one in use.
+CREATE TABLE IF NOT EXISTS organization_data_keys (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    key_version INT NOT NULL,
+    wrapped_key TEXT NOT NULL COMMENT 'Data key encrypted by the key encryption key',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY unique_organization_data_key_version (organization_id, key_version),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- The data key version each secret is sealed with. NULL for secrets written
+-- before envelope encryption, which are stored as plain text until rotated.
+ALTER TABLE organization_secrets
+    ADD COLUMN key_version INT NULL COMMENT 'Data key version the value is sealed with';
+
+ALTER TABLE project_secrets
+    ADD COLUMN key_version INT NULL COMMENT 'Data key version the value is sealed with';
+
+ALTER TABLE site_secrets
+    ADD COLUMN key_version INT NULL COMMENT 'Data key version the value is sealed with';
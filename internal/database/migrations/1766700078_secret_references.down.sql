@@ -0,0 +1,5 @@
+ALTER TABLE site_secrets DROP COLUMN reference;
+
+ALTER TABLE project_secrets DROP COLUMN reference;
+
+ALTER TABLE organization_secrets DROP COLUMN reference;
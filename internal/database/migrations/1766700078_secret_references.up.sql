@@ -0,0 +1,12 @@
+-- A secret can reference a value kept in the customer's own secret store,
+-- like gcp-sm://project/name/version or vault://path, instead of holding it.
+-- Nothing is written to the organization's Vault for a reference: the site's
+-- controller resolves it at reconcile time with the VM's workload identity.
+ALTER TABLE organization_secrets
+    ADD COLUMN reference VARCHAR(1024) NULL COMMENT 'External secret reference, resolved by the site controller';
+
+ALTER TABLE project_secrets
+    ADD COLUMN reference VARCHAR(1024) NULL COMMENT 'External secret reference, resolved by the site controller';
+
+ALTER TABLE site_secrets
+    ADD COLUMN reference VARCHAR(1024) NULL COMMENT 'External secret reference, resolved by the site controller';
@@ -0,0 +1,15 @@
+-- A pending password reset for a userpass account. Only a SHA-256 hash of the
+-- emailed token is kept, and an account has at most one outstanding reset.
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+
+    UNIQUE KEY unique_password_reset_account (account_id),
+    UNIQUE KEY unique_password_reset_token (token_hash),
+    INDEX idx_expires_at (expires_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS organization_join_requests;
+DROP TABLE IF EXISTS organization_join_domains;
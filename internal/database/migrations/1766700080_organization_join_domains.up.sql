@@ -0,0 +1,40 @@
+-- Self-service signup: an organization can claim email domains so people
+-- signing up with an address there join it on their own, straight away or
+-- once an administrator approves their join request.
+CREATE TABLE IF NOT EXISTS organization_join_domains (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT NOT NULL,
+    domain VARCHAR(255) NOT NULL COMMENT 'Lowercase email domain, e.g. mylibrary.edu',
+    `role` ENUM('developer', 'read') NOT NULL DEFAULT 'read',
+    approval_required BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'Create a join request instead of a membership',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY unique_organization_domain (organization_id, domain),
+    INDEX idx_domain (domain),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- An account asking to join an organization through a domain that needs an
+-- administrator's approval. An account asks each organization at most once,
+-- so a denied request isn't raised again by signing in.
+CREATE TABLE IF NOT EXISTS organization_join_requests (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    `role` ENUM('developer', 'read') NOT NULL DEFAULT 'read',
+    status ENUM('pending', 'approved', 'denied') NOT NULL DEFAULT 'pending',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP NULL,
+    decided_by BIGINT NULL,
+
+    UNIQUE KEY unique_organization_account (organization_id, account_id),
+    INDEX idx_organization_status (organization_id, status),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (decided_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+-- Profile details shown instead of bare email addresses. The time zone lives in
+-- account_preferences.
+ALTER TABLE accounts
+    ADD COLUMN phone VARCHAR(32) NULL COMMENT 'Contact phone number in E.164 format' AFTER `name`,
+    ADD COLUMN avatar_object VARCHAR(255) NULL COMMENT 'Cloud Storage object of the avatar, named after its content' AFTER phone;
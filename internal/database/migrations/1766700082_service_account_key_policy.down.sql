@@ -0,0 +1,6 @@
+DELETE FROM policy_violations WHERE `rule` = 'service_account_write_keys';
+DELETE FROM organization_policies WHERE `rule` = 'service_account_write_keys';
+ALTER TABLE policy_violations
+    MODIFY COLUMN `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window') NOT NULL;
+ALTER TABLE organization_policies
+    MODIFY COLUMN `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window') NOT NULL;
@@ -0,0 +1,6 @@
+-- Policy keeping write access by API key to service accounts, so a leaked
+-- personal key can only read an organization's resources.
+ALTER TABLE organization_policies
+    MODIFY COLUMN `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window', 'service_account_write_keys') NOT NULL;
+ALTER TABLE policy_violations
+    MODIFY COLUMN `rule` ENUM('production_secrets_owner_only', 'no_public_ssh', 'production_deploy_window', 'service_account_write_keys') NOT NULL;
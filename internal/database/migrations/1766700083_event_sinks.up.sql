@@ -0,0 +1,26 @@
+-- Customer-owned Pub/Sub topics and Cloud Storage buckets an organization's events are
+-- published to. The event router writes as the organization's platform service account,
+-- which the customer grants publish access on their topic or bucket.
+CREATE TABLE IF NOT EXISTS event_sinks (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+
+    organization_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind ENUM('pubsub_topic', 'storage_bucket') NOT NULL,
+    destination VARCHAR(255) NOT NULL COMMENT 'projects/PROJECT/topics/TOPIC for pubsub_topic, the bucket name for storage_bucket',
+    event_types JSON NOT NULL COMMENT 'Event type prefixes to publish, e.g. io.libops.deployment.; empty publishes every event',
+    signing_secret VARCHAR(255) NOT NULL COMMENT 'HMAC-SHA256 key events are signed with',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    last_delivery_at TIMESTAMP NULL,
+    last_error TEXT NULL COMMENT 'Error from the most recent delivery, NULL when it succeeded',
+
+    created_by BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    INDEX idx_organization_enabled (organization_id, enabled),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
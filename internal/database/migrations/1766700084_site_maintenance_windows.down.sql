@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS deferred_reconciliations;
+DROP TABLE IF EXISTS site_maintenance_windows;
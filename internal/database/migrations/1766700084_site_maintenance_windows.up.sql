@@ -0,0 +1,33 @@
+-- Per-site maintenance windows: the event router holds back non-urgent
+-- secrets and firewall reconciliations of a site until its window opens, so
+-- VMs aren't changed during busy hours. Sites without a row reconcile at once.
+CREATE TABLE IF NOT EXISTS site_maintenance_windows (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL UNIQUE,
+
+    days JSON NOT NULL COMMENT 'Lowercase weekdays the window opens on; empty for every day',
+    start_hour INT NOT NULL COMMENT 'First hour of the window, 0-23',
+    end_hour INT NOT NULL COMMENT 'Hour the window closes, 1-24',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Reconciliations the event router deferred to a site's maintenance window.
+-- Reconciliations apply a site's current state, so one row per site and
+-- request type covers every change made before the window opens.
+CREATE TABLE IF NOT EXISTS deferred_reconciliations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+    request_type ENUM('secrets', 'firewall') NOT NULL,
+    event_ids JSON NOT NULL COMMENT 'Events the reconciliation was deferred for',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT 'When the first change was deferred',
+
+    UNIQUE KEY uk_deferred_reconciliations_site_type (site_id, request_type),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+ALTER TABLE reconciliations
+    DROP INDEX idx_reconciliations_site_type_created;
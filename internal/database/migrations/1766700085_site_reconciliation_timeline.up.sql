@@ -0,0 +1,5 @@
+-- The site proxy records each VM reconciliation it forwards to a site's
+-- controller, and the site reconciliation timeline lists a site's latest
+-- runs of each type.
+ALTER TABLE reconciliations
+    ADD INDEX idx_reconciliations_site_type_created (site_id, reconciliation_type, created_at);
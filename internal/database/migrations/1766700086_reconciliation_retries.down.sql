@@ -0,0 +1,5 @@
+ALTER TABLE reconciliations
+    DROP INDEX idx_reconciliations_retry,
+    DROP COLUMN next_retry_at,
+    DROP COLUMN retry_state,
+    DROP COLUMN attempt;
@@ -0,0 +1,12 @@
+-- Failed VM reconciliations are retried by the event router with exponential
+-- backoff. attempt counts the site proxy's calls for the same changes, and
+-- retry_state tracks what the event router did with a failed run:
+--   scheduled  - it will be retried at next_retry_at
+--   retried    - a retry was published
+--   superseded - a later reconciliation of the same type ran instead
+--   exhausted  - it failed on the last attempt and is dead-lettered
+ALTER TABLE reconciliations
+    ADD COLUMN attempt INT NOT NULL DEFAULT 1 AFTER error_message,
+    ADD COLUMN retry_state ENUM('scheduled', 'retried', 'superseded', 'exhausted') NULL AFTER attempt,
+    ADD COLUMN next_retry_at TIMESTAMP NULL AFTER retry_state,
+    ADD INDEX idx_reconciliations_retry (status, retry_state, next_retry_at);
@@ -0,0 +1,24 @@
+-- Controller config documents: site VM controllers fetch their tunables on
+-- check-in and apply them without a restart, so operators can tune the fleet
+-- without redeploying controllers. The row without a site is the fleet-wide
+-- document; a site's row overrides it field by field, and NULL fields keep
+-- what the controller was started with.
+CREATE TABLE IF NOT EXISTS controller_configs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NULL COMMENT 'NULL for the fleet-wide document',
+    scope_key BIGINT AS (COALESCE(site_id, 0)) STORED,
+
+    rate_limit_rps INT NULL COMMENT 'Reconcile requests per second the controller accepts',
+    rate_limit_burst INT NULL,
+    reconcile_interval_minutes INT NULL COMMENT 'Between periodic full reconciliations',
+    checkin_interval_seconds INT NULL,
+    feature_flags JSON NOT NULL COMMENT 'Flag name to enabled, e.g. {"nftables": true}',
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    updated_by BIGINT NULL,
+
+    UNIQUE KEY uk_controller_configs_scope (scope_key),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+ALTER TABLE sites
+    DROP INDEX idx_sites_controller_version,
+    DROP COLUMN controller_version;
+
+ALTER TABLE controller_configs DROP COLUMN controller_version;
+
+DROP TABLE IF EXISTS controller_releases;
@@ -0,0 +1,25 @@
+-- Controller releases: signed builds of the site VM controller. A controller
+-- config names the release its sites should run; controllers download it on
+-- check-in, verify the signature and swap themselves in, rolling back when
+-- the new build fails its health check.
+CREATE TABLE IF NOT EXISTS controller_releases (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    version VARCHAR(64) NOT NULL,
+    download_url VARCHAR(2048) NOT NULL COMMENT 'HTTPS URL of the linux/amd64 binary',
+    sha256 CHAR(64) NOT NULL COMMENT 'Hex SHA-256 digest of the binary',
+    signature VARCHAR(255) NOT NULL COMMENT 'Base64 ed25519 signature of the binary',
+    notes TEXT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    UNIQUE KEY uk_controller_releases_version (version),
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE controller_configs
+    ADD COLUMN controller_version VARCHAR(64) NULL COMMENT 'Release the controllers should run' AFTER checkin_interval_seconds;
+
+ALTER TABLE sites
+    ADD COLUMN controller_version VARCHAR(64) NULL COMMENT 'Release the controller reported on its last check-in',
+    ADD INDEX idx_sites_controller_version (controller_version);
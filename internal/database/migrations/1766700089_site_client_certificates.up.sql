@@ -0,0 +1,19 @@
+-- Site client certificates: short-lived certificates the LibOps controller CA
+-- signs for a site's controller, which it presents to the API over mutual TLS
+-- alongside its GCP token. Issuing a certificate revokes the site's earlier
+-- ones, so only the newest is accepted once the controller has rotated.
+CREATE TABLE IF NOT EXISTS site_client_certificates (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    site_id BIGINT NOT NULL,
+    serial_number VARCHAR(40) NOT NULL COMMENT 'Hex certificate serial number',
+    fingerprint CHAR(64) NOT NULL COMMENT 'Hex SHA-256 of the DER certificate',
+    not_before TIMESTAMP NOT NULL,
+    not_after TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uk_site_client_certificates_serial (serial_number),
+    INDEX idx_site_client_certificates_site (site_id, revoked_at),
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+ALTER TABLE sites
+    DROP COLUMN os_reboot_required,
+    DROP COLUMN os_version;
@@ -0,0 +1,6 @@
+-- OS patch state reported by each site's VM controller on check-in, for the
+-- fleet overview. The VM is behind on patches when the image it runs isn't
+-- the site's configured os image.
+ALTER TABLE sites
+    ADD COLUMN os_version VARCHAR(255) NULL COMMENT 'OS image the controller reported on its last check-in',
+    ADD COLUMN os_reboot_required BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'An installed OS update waits for a reboot';
@@ -0,0 +1,6 @@
+DELETE FROM site_incidents WHERE cause = 'health_degraded';
+ALTER TABLE site_incidents MODIFY COLUMN cause ENUM('checkin_missed', 'probe_failed') NOT NULL;
+DROP TABLE IF EXISTS site_health;
+ALTER TABLE sites
+    DROP COLUMN containers_unhealthy,
+    DROP COLUMN containers_running;
@@ -0,0 +1,26 @@
+-- Site health scores: what controllers report about their containers on check-in,
+-- and the score the downtime monitor computes for each site.
+ALTER TABLE sites
+    ADD COLUMN containers_running INT NULL COMMENT 'Containers the controller saw running at its last check-in' AFTER disk_used_bytes,
+    ADD COLUMN containers_unhealthy INT NULL COMMENT 'Running containers whose health check is failing' AFTER containers_running;
+
+-- One row per active site, recomputed by every sweep. Component scores are 0-100
+-- and NULL when there was nothing to score them from; the overall score weighs
+-- the known components. degraded_since is when the score dropped below the
+-- incident threshold, NULL while it is above it.
+CREATE TABLE IF NOT EXISTS site_health (
+    site_id BIGINT PRIMARY KEY,
+    score INT NOT NULL,
+    checkin_score INT NULL,
+    container_score INT NULL,
+    probe_score INT NULL,
+    deployment_score INT NULL,
+    degraded_since TIMESTAMP NULL,
+    computed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (site_id) REFERENCES sites(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- A sustained low health score opens an incident too
+ALTER TABLE site_incidents
+    MODIFY COLUMN cause ENUM('checkin_missed', 'probe_failed', 'health_degraded') NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS terraform_state_backups;
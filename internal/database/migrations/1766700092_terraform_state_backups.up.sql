@@ -0,0 +1,21 @@
+-- Terraform state backups: copies of an organization's state object that
+-- operators take before risky operations, and that are taken automatically
+-- before a state restore. The copies live next to the state in the
+-- organization's tfstate bucket; this table records who took them and why.
+CREATE TABLE IF NOT EXISTS terraform_state_backups (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    public_id BINARY(16) NOT NULL UNIQUE,
+    organization_id BIGINT NOT NULL,
+    object VARCHAR(1024) NOT NULL COMMENT 'Backup object in the tfstate bucket',
+    generation BIGINT NOT NULL COMMENT 'GCS generation of the backup object',
+    source_generation BIGINT NOT NULL COMMENT 'Generation of the state object that was copied',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    reason VARCHAR(500) NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+
+    INDEX idx_terraform_state_backups_org (organization_id, created_at),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,12 @@
+-- Terraform state layouts: organizations start with one monolithic state that
+-- runs target modules in. An operator migrates an organization to isolated
+-- workspaces, where each project and site has a state prefix of its own in the
+-- organization's tfstate bucket. Organizations without a row are monolithic.
+CREATE TABLE IF NOT EXISTS terraform_state_layouts (
+    organization_id BIGINT PRIMARY KEY,
+    layout ENUM('monolithic', 'migrating', 'isolated') NOT NULL DEFAULT 'monolithic',
+    migration_run_id VARCHAR(255) NULL COMMENT 'Terraform run moving the state into workspaces',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
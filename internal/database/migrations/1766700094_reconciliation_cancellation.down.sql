@@ -0,0 +1,4 @@
+UPDATE reconciliations SET status = 'failed' WHERE status = 'cancelled';
+
+ALTER TABLE reconciliations
+    MODIFY COLUMN status ENUM('pending', 'triggered', 'running', 'completed', 'failed') DEFAULT 'pending';
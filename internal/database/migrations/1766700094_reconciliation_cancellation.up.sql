@@ -0,0 +1,5 @@
+-- Operators cancel terraform runs that haven't finished. A cancelled run keeps
+-- the reason in error_message, and the runner's later status reports leave it
+-- cancelled.
+ALTER TABLE reconciliations
+    MODIFY COLUMN status ENUM('pending', 'triggered', 'running', 'completed', 'failed', 'cancelled') DEFAULT 'pending';
@@ -0,0 +1,6 @@
+-- The API runs in several regions. api_region is the region whose deployment
+-- serves a site's controller, set to the nearest one on the site's first
+-- check-in and kept after, so a site's traffic and data stay in its region as
+-- regions are added. NULL until the site checks in with regions configured.
+ALTER TABLE sites
+    ADD COLUMN api_region VARCHAR(32) NULL COMMENT 'API region serving the site''s controller' AFTER containers_unhealthy;
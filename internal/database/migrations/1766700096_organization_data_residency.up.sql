@@ -0,0 +1,4 @@
+-- Data residency keeps an organization's projects, and the backups and state
+-- written for them, inside one geography. NULL allows any region.
+ALTER TABLE organizations
+    ADD COLUMN data_residency ENUM('eu', 'us') NULL DEFAULT NULL COMMENT 'Geography the organization''s data must stay in; NULL for anywhere';
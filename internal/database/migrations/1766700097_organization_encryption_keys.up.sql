@@ -0,0 +1,25 @@
+-- Customer-managed encryption keys: Cloud KMS keys an organization supplies to
+-- encrypt its tfstate bucket and its sites' Cloud SQL instances and backups.
+-- Google only lets a key encrypt data in its own location, so an organization
+-- has at most one key per location. A key only works once the organization's
+-- service agents may use it, so the API checks the key's IAM policy and
+-- records what it found.
+CREATE TABLE IF NOT EXISTS organization_encryption_keys (
+    organization_id BIGINT NOT NULL,
+    location VARCHAR(64) NOT NULL COMMENT 'Location of the key, e.g. us-central1 or eu; it encrypts data there',
+
+    kms_key_name VARCHAR(512) NOT NULL COMMENT 'projects/*/locations/*/keyRings/*/cryptoKeys/*',
+    status ENUM('pending', 'healthy', 'unhealthy') NOT NULL DEFAULT 'pending' COMMENT 'Result of the last check; pending while nothing in the location uses the key',
+    status_message VARCHAR(1024) NULL COMMENT 'Why the last check failed',
+    checked_at TIMESTAMP NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    created_by BIGINT NULL,
+    updated_by BIGINT NULL,
+
+    PRIMARY KEY (organization_id, location),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL,
+    FOREIGN KEY (updated_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,16 @@
+-- Platform auditors: accounts granted read-only access to everything on the
+-- platform, such as security reviewers. An auditor can read any organization,
+-- project and site and the platform's admin views, and can't change anything
+-- outside their own account while the grant lasts. Grants always expire.
+CREATE TABLE IF NOT EXISTS platform_auditors (
+    account_id BIGINT NOT NULL PRIMARY KEY,
+    reason VARCHAR(1000) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    created_by BIGINT NULL COMMENT 'Account ID of the admin who granted it',
+
+    INDEX idx_platform_auditors_expires (expires_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES accounts(id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
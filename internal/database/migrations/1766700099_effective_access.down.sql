@@ -0,0 +1,17 @@
+DROP TRIGGER IF EXISTS sites_effective_access_delete;
+DROP TRIGGER IF EXISTS projects_effective_access_delete;
+DROP TRIGGER IF EXISTS organizations_effective_access_delete;
+DROP TRIGGER IF EXISTS relationships_effective_access_delete;
+DROP TRIGGER IF EXISTS relationships_effective_access_update;
+DROP TRIGGER IF EXISTS relationships_effective_access_insert;
+DROP TRIGGER IF EXISTS site_members_effective_access_delete;
+DROP TRIGGER IF EXISTS site_members_effective_access_update;
+DROP TRIGGER IF EXISTS site_members_effective_access_insert;
+DROP TRIGGER IF EXISTS project_members_effective_access_delete;
+DROP TRIGGER IF EXISTS project_members_effective_access_update;
+DROP TRIGGER IF EXISTS project_members_effective_access_insert;
+DROP TRIGGER IF EXISTS organization_members_effective_access_delete;
+DROP TRIGGER IF EXISTS organization_members_effective_access_update;
+DROP TRIGGER IF EXISTS organization_members_effective_access_insert;
+DROP TABLE IF EXISTS effective_access_stale;
+DROP TABLE IF EXISTS effective_organization_access;
@@ -0,0 +1,120 @@
+-- Effective access: which organizations each account reaches, materialized so
+-- listings and authorization don't resolve memberships and relationship chains
+-- with recursive joins on every request.
+--
+-- reachable: an active organization membership, or an approved relationship
+-- chain from one, which gives access to everything in the organization.
+-- inherited_read: an active project or site membership in the organization, or
+-- in one with an approved relationship to it, which gives read on the
+-- organization itself.
+CREATE TABLE IF NOT EXISTS effective_organization_access (
+    account_id BIGINT NOT NULL,
+    organization_id BIGINT NOT NULL,
+    reachable BOOLEAN NOT NULL DEFAULT FALSE,
+    inherited_read BOOLEAN NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (account_id, organization_id),
+    INDEX idx_effective_organization_access_organization (organization_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Accounts whose rows above are out of date. The triggers below mark accounts
+-- when a membership or relationship changes; the API refreshes a marked account
+-- before reading its access and a background sweep drains the rest. Deletes
+-- that cascade from a parent fire no triggers, so the parents mark instead.
+CREATE TABLE IF NOT EXISTS effective_access_stale (
+    account_id BIGINT NOT NULL PRIMARY KEY,
+    marked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_effective_access_stale_marked (marked_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Every existing account starts out stale and is materialized by the sweep
+INSERT INTO effective_access_stale (account_id) SELECT id FROM accounts;
+
+CREATE TRIGGER organization_members_effective_access_insert AFTER INSERT ON organization_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (NEW.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER organization_members_effective_access_update AFTER UPDATE ON organization_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT m.account_id FROM (SELECT OLD.account_id AS account_id UNION SELECT NEW.account_id) m
+    WHERE NOT (OLD.status <=> NEW.status) OR OLD.account_id <> NEW.account_id OR OLD.organization_id <> NEW.organization_id
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER organization_members_effective_access_delete AFTER DELETE ON organization_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (OLD.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER project_members_effective_access_insert AFTER INSERT ON project_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (NEW.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER project_members_effective_access_update AFTER UPDATE ON project_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT m.account_id FROM (SELECT OLD.account_id AS account_id UNION SELECT NEW.account_id) m
+    WHERE NOT (OLD.status <=> NEW.status) OR OLD.account_id <> NEW.account_id OR OLD.project_id <> NEW.project_id
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER project_members_effective_access_delete AFTER DELETE ON project_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (OLD.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER site_members_effective_access_insert AFTER INSERT ON site_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (NEW.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER site_members_effective_access_update AFTER UPDATE ON site_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT m.account_id FROM (SELECT OLD.account_id AS account_id UNION SELECT NEW.account_id) m
+    WHERE NOT (OLD.status <=> NEW.status) OR OLD.account_id <> NEW.account_id OR OLD.site_id <> NEW.site_id
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER site_members_effective_access_delete AFTER DELETE ON site_members FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id) VALUES (OLD.account_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+-- A relationship changes what everyone who reaches, or has a project or site
+-- in, its source organization can access; all of them have a row for it
+CREATE TRIGGER relationships_effective_access_insert AFTER INSERT ON relationships FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT DISTINCT ea.account_id FROM effective_organization_access ea
+    WHERE ea.organization_id = NEW.source_organization_id AND NEW.status = 'approved'
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER relationships_effective_access_update AFTER UPDATE ON relationships FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT DISTINCT ea.account_id FROM effective_organization_access ea
+    WHERE ea.organization_id IN (OLD.source_organization_id, NEW.source_organization_id)
+      AND (NOT (OLD.status <=> NEW.status) OR OLD.source_organization_id <> NEW.source_organization_id OR OLD.target_organization_id <> NEW.target_organization_id)
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER relationships_effective_access_delete AFTER DELETE ON relationships FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT DISTINCT ea.account_id FROM effective_organization_access ea
+    WHERE ea.organization_id = OLD.source_organization_id AND OLD.status = 'approved'
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+-- Deleting an organization cascades to its members, projects and
+-- relationships, which reach further through organizations the deleted one had
+-- relationships with; everyone affected has a row for it
+CREATE TRIGGER organizations_effective_access_delete BEFORE DELETE ON organizations FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT ea.account_id FROM effective_organization_access ea WHERE ea.organization_id = OLD.id
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER projects_effective_access_delete BEFORE DELETE ON projects FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT m.account_id FROM (
+        SELECT pm.account_id FROM project_members pm WHERE pm.project_id = OLD.id
+        UNION
+        SELECT sm.account_id FROM site_members sm JOIN sites s ON sm.site_id = s.id WHERE s.project_id = OLD.id
+    ) m
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
+
+CREATE TRIGGER sites_effective_access_delete BEFORE DELETE ON sites FOR EACH ROW
+    INSERT INTO effective_access_stale (account_id)
+    SELECT sm.account_id FROM site_members sm WHERE sm.site_id = OLD.id
+    ON DUPLICATE KEY UPDATE marked_at = CURRENT_TIMESTAMP;
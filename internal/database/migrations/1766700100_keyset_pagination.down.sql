@@ -0,0 +1,3 @@
+ALTER TABLE sites DROP INDEX idx_sites_created_id;
+ALTER TABLE projects DROP INDEX idx_projects_created_id;
+ALTER TABLE organizations DROP INDEX idx_organizations_created_id;
@@ -0,0 +1,5 @@
+-- User listings page by (created_at, id) cursors, newest first, instead of
+-- OFFSET; these indexes let each page seek straight to its cursor.
+ALTER TABLE organizations ADD INDEX idx_organizations_created_id (created_at, id);
+ALTER TABLE projects ADD INDEX idx_projects_created_id (created_at, id);
+ALTER TABLE sites ADD INDEX idx_sites_created_id (created_at, id);
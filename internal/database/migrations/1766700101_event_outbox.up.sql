@@ -0,0 +1,17 @@
+-- The transactional outbox. The API writes the events a change emits here in
+-- the same transaction as the change, so a crash can't commit one without the
+-- other. The event-router relays committed rows into event_queue, oldest
+-- first, and deletes them; the table only holds events not yet relayed.
+CREATE TABLE IF NOT EXISTS event_outbox (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id VARCHAR(255) NOT NULL UNIQUE,
+    event_type VARCHAR(255) NOT NULL,
+    event_source VARCHAR(255) NOT NULL,
+    event_subject VARCHAR(255),
+    event_data BLOB NOT NULL,
+    content_type VARCHAR(100) NOT NULL DEFAULT 'application/protobuf',
+    organization_id BIGINT NULL,
+    project_id BIGINT NULL,
+    site_id BIGINT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
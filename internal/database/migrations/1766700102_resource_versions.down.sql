@@ -0,0 +1,3 @@
+ALTER TABLE sites DROP COLUMN version;
+ALTER TABLE projects DROP COLUMN version;
+ALTER TABLE organizations DROP COLUMN version;
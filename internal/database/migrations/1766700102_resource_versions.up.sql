@@ -0,0 +1,6 @@
+-- Each configuration change bumps the resource's version, which the API hands
+-- out as its etag. Updates only apply to the version they were read at, so two
+-- people editing the same resource can't silently overwrite each other.
+ALTER TABLE organizations ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE projects ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE sites ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
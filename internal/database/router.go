@@ -0,0 +1,234 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type primaryKey struct{}
+
+// WithPrimary returns a context that forces all queries to the primary,
+// for read-after-write paths that cannot tolerate replica lag. Caches are
+// bypassed for it too.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+type sessionKey struct{}
+
+// session records whether a request has written to the primary.
+type session struct {
+	wrote atomic.Bool
+}
+
+// WithSession returns a context that reads its own writes: once a write is
+// made through a Router with it, or a context derived from it, its reads go to
+// the primary, since the replica may not have the write yet.
+func WithSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, &session{})
+}
+
+// UsesPrimary reports whether ctx forces reads to the primary, because it
+// came from WithPrimary or its session has written.
+func UsesPrimary(ctx context.Context) bool {
+	if s, _ := ctx.Value(sessionKey{}).(*session); s != nil && s.wrote.Load() {
+		return true
+	}
+	v, _ := ctx.Value(primaryKey{}).(bool)
+	return v
+}
+
+// Router implements db.DBTX and splits traffic between a primary and an
+// optional read replica. Read-only SELECT statements go to the replica;
+// writes, locking reads and prepared statements always go to the primary.
+// Queries made with a context from WithTx run in that transaction instead,
+// and reads made with a context from WithSession go to the primary once it
+// has written.
+//
+// Replicas in other regions can fall well behind, so CheckReplicaLag sends
+// reads back to the primary while the replica lags more than allowed.
+type Router struct {
+	primary *sql.DB
+	replica *sql.DB
+	// lagging is set while the replica is too far behind to serve reads
+	lagging atomic.Bool
+}
+
+// NewRouter creates a Router. A nil replica routes everything to the primary.
+func NewRouter(primary, replica *sql.DB) *Router {
+	return &Router{primary: primary, replica: replica}
+}
+
+// Primary returns the primary connection pool.
+func (r *Router) Primary() *sql.DB {
+	return r.primary
+}
+
+// Replica returns the replica connection pool, or nil when none is configured.
+func (r *Router) Replica() *sql.DB {
+	return r.replica
+}
+
+// ExecContext always runs on the primary, sending the later reads of ctx's
+// session there too.
+func (r *Router) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	dbQueriesRouted.WithLabelValues(RolePrimary).Inc()
+	if s, _ := ctx.Value(sessionKey{}).(*session); s != nil {
+		s.wrote.Store(true)
+	}
+	if tx := txFrom(ctx); tx != nil {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return r.primary.ExecContext(ctx, query, args...)
+}
+
+// PrepareContext always runs on the primary since the statement may be used for writes.
+func (r *Router) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	dbQueriesRouted.WithLabelValues(RolePrimary).Inc()
+	if tx := txFrom(ctx); tx != nil {
+		return tx.PrepareContext(ctx, query)
+	}
+	return r.primary.PrepareContext(ctx, query)
+}
+
+// QueryContext runs read-only queries on the replica and everything else on the primary.
+func (r *Router) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx := txFrom(ctx); tx != nil {
+		dbQueriesRouted.WithLabelValues(RolePrimary).Inc()
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return r.reader(ctx, query).QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs read-only queries on the replica and everything else on the primary.
+func (r *Router) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx := txFrom(ctx); tx != nil {
+		dbQueriesRouted.WithLabelValues(RolePrimary).Inc()
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return r.reader(ctx, query).QueryRowContext(ctx, query, args...)
+}
+
+func (r *Router) reader(ctx context.Context, query string) *sql.DB {
+	if r.replica == nil || r.lagging.Load() || UsesPrimary(ctx) || !isReadOnly(query) {
+		dbQueriesRouted.WithLabelValues(RolePrimary).Inc()
+		return r.primary
+	}
+	dbQueriesRouted.WithLabelValues(RoleReplica).Inc()
+	return r.replica
+}
+
+// CheckReplicaLag measures how far the replica is behind its primary and
+// routes reads to the primary while that's more than maxLag, or while the
+// replica isn't replicating or its status can't be read. It returns the
+// measured lag.
+func (r *Router) CheckReplicaLag(ctx context.Context, maxLag time.Duration) (time.Duration, error) {
+	if r.replica == nil {
+		return 0, nil
+	}
+	lag, err := replicaLag(ctx, r.replica)
+	if err != nil {
+		r.lagging.Store(true)
+		dbReplicaLag.Set(-1)
+		return 0, err
+	}
+	dbReplicaLag.Set(lag.Seconds())
+	r.lagging.Store(lag > maxLag)
+	return lag, nil
+}
+
+// ReplicaLagging reports whether reads are held on the primary because the
+// replica is behind.
+func (r *Router) ReplicaLagging() bool {
+	return r.lagging.Load()
+}
+
+// replicaLag reads the replication delay from SHOW REPLICA STATUS, whose
+// column is Seconds_Behind_Master on MariaDB and Seconds_Behind_Source on MySQL
+func replicaLag(ctx context.Context, replica *sql.DB) (time.Duration, error) {
+	rows, err := replica.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		return 0, fmt.Errorf("reading replica status: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, fmt.Errorf("reading replica status: %w", err)
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, fmt.Errorf("reading replica status: %w", err)
+		}
+		return 0, errors.New("the replica database is not replicating")
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, fmt.Errorf("reading replica status: %w", err)
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Master" && column != "Seconds_Behind_Source" {
+			continue
+		}
+		// NULL means the replication threads are stopped
+		if !values[i].Valid {
+			return 0, errors.New("replication on the replica database is stopped")
+		}
+		seconds, err := strconv.ParseInt(values[i].String, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing replica lag %q: %w", values[i].String, err)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return 0, errors.New("replica status has no lag column")
+}
+
+// isReadOnly reports whether a statement is a plain SELECT that is safe to
+// serve from a replica. sqlc prefixes every query with a "-- name:" comment,
+// so leading comments are skipped before inspecting the statement.
+func isReadOnly(query string) bool {
+	q := stripLeadingComments(query)
+	if len(q) < 6 || !strings.EqualFold(q[:6], "SELECT") {
+		return false
+	}
+
+	upper := strings.ToUpper(q)
+	for _, marker := range []string{"FOR UPDATE", "LOCK IN SHARE MODE", "FOR SHARE", "LAST_INSERT_ID(", "GET_LOCK(", "RELEASE_LOCK("} {
+		if strings.Contains(upper, marker) {
+			return false
+		}
+	}
+	return true
+}
+
+func stripLeadingComments(query string) string {
+	q := strings.TrimSpace(query)
+	for {
+		switch {
+		case strings.HasPrefix(q, "--"), strings.HasPrefix(q, "#"):
+			idx := strings.IndexByte(q, '\n')
+			if idx < 0 {
+				return ""
+			}
+			q = strings.TrimSpace(q[idx+1:])
+		case strings.HasPrefix(q, "/*"):
+			idx := strings.Index(q, "*/")
+			if idx < 0 {
+				return ""
+			}
+			q = strings.TrimSpace(q[idx+2:])
+		default:
+			return q
+		}
+	}
+}
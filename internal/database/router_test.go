@@ -0,0 +1,283 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestIsReadOnly verifies which statements are eligible for the read replica.
+func TestIsReadOnly(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  bool
+	}{
+		{"sqlc select", "-- name: GetAccount :one\nSELECT id FROM accounts WHERE id = ?", true},
+		{"lowercase select", "select 1", true},
+		{"block comment", "/* dashboard */ SELECT COUNT(*) FROM sites", true},
+		{"insert", "-- name: CreateAccount :exec\nINSERT INTO accounts (email) VALUES (?)", false},
+		{"update", "UPDATE sites SET status = 'active'", false},
+		{"delete", "DELETE FROM sites WHERE id = ?", false},
+		{"locking read", "SELECT id FROM sites WHERE id = ? FOR UPDATE", false},
+		{"share lock", "SELECT id FROM sites WHERE id = ? LOCK IN SHARE MODE", false},
+		{"session bound", "SELECT LAST_INSERT_ID()", false},
+		{"comment only", "-- nothing here", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isReadOnly(tt.query); got != tt.want {
+				t.Errorf("isReadOnly(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRouter_RoutesReadsToReplica verifies reads hit the replica and writes hit the primary.
+func TestRouter_RoutesReadsToReplica(t *testing.T) {
+	primary, primaryMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create primary mock: %v", err)
+	}
+	defer func() { _ = primary.Close() }()
+
+	replica, replicaMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create replica mock: %v", err)
+	}
+	defer func() { _ = replica.Close() }()
+
+	router := NewRouter(primary, replica)
+	ctx := context.Background()
+
+	replicaMock.ExpectQuery("SELECT id FROM sites").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	primaryMock.ExpectExec("UPDATE sites").WillReturnResult(sqlmock.NewResult(0, 1))
+	primaryMock.ExpectQuery("SELECT id FROM sites").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	var id int
+	if err := router.QueryRowContext(ctx, "SELECT id FROM sites").Scan(&id); err != nil {
+		t.Fatalf("replica read failed: %v", err)
+	}
+	if _, err := router.ExecContext(ctx, "UPDATE sites SET status = 'active'"); err != nil {
+		t.Fatalf("primary write failed: %v", err)
+	}
+	if err := router.QueryRowContext(WithPrimary(ctx), "SELECT id FROM sites").Scan(&id); err != nil {
+		t.Fatalf("forced primary read failed: %v", err)
+	}
+
+	if err := replicaMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("replica expectations: %v", err)
+	}
+	if err := primaryMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("primary expectations: %v", err)
+	}
+}
+
+// TestRouter_WithTx verifies queries with a transaction's context run in it,
+// including reads, and go back to the pools once it ends.
+func TestRouter_WithTx(t *testing.T) {
+	primary, primaryMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create primary mock: %v", err)
+	}
+	defer func() { _ = primary.Close() }()
+
+	replica, replicaMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create replica mock: %v", err)
+	}
+	defer func() { _ = replica.Close() }()
+
+	router := NewRouter(primary, replica)
+	primaryMock.ExpectBegin()
+	primaryMock.ExpectExec("UPDATE sites").WillReturnResult(sqlmock.NewResult(0, 1))
+	primaryMock.ExpectQuery("SELECT id FROM sites").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	primaryMock.ExpectCommit()
+	replicaMock.ExpectQuery("SELECT id FROM sites").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	tx, err := primary.Begin()
+	if err != nil {
+		t.Fatalf("begin failed: %v", err)
+	}
+	ctx, end := WithTx(context.Background(), tx)
+	if !InTx(ctx) {
+		t.Fatal("InTx() = false in a transaction")
+	}
+	if _, err := router.ExecContext(ctx, "UPDATE sites SET status = 'active'"); err != nil {
+		t.Fatalf("write in transaction failed: %v", err)
+	}
+	var id int
+	if err := router.QueryRowContext(ctx, "SELECT id FROM sites").Scan(&id); err != nil {
+		t.Fatalf("read in transaction failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	end()
+
+	if InTx(ctx) {
+		t.Fatal("InTx() = true after the transaction ended")
+	}
+	if err := router.QueryRowContext(ctx, "SELECT id FROM sites").Scan(&id); err != nil {
+		t.Fatalf("read after transaction failed: %v", err)
+	}
+
+	if err := primaryMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("primary expectations: %v", err)
+	}
+	if err := replicaMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("replica expectations: %v", err)
+	}
+}
+
+// TestRouter_NoReplica verifies that all traffic goes to the primary when no replica is configured.
+func TestRouter_NoReplica(t *testing.T) {
+	primary, primaryMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create primary mock: %v", err)
+	}
+	defer func() { _ = primary.Close() }()
+
+	router := NewRouter(primary, nil)
+	primaryMock.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+
+	rows, err := router.QueryContext(context.Background(), "SELECT 1")
+	if err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+	_ = rows.Close()
+
+	if err := primaryMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("primary expectations: %v", err)
+	}
+}
+
+// TestRouter_ReadsOwnWrites verifies a session's reads move to the primary once
+// it has written, while other requests keep reading from the replica.
+func TestRouter_ReadsOwnWrites(t *testing.T) {
+	primary, primaryMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create primary mock: %v", err)
+	}
+	defer func() { _ = primary.Close() }()
+
+	replica, replicaMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create replica mock: %v", err)
+	}
+	defer func() { _ = replica.Close() }()
+
+	router := NewRouter(primary, replica)
+	session := WithSession(context.Background())
+	other := WithSession(context.Background())
+
+	replicaMock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	primaryMock.ExpectExec("INSERT INTO site_redirects").WillReturnResult(sqlmock.NewResult(1, 1))
+	primaryMock.ExpectQuery("SELECT id FROM site_redirects").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	replicaMock.ExpectQuery("SELECT id FROM site_redirects").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	var n int
+	if err := router.QueryRowContext(session, "SELECT COUNT(*) FROM site_redirects").Scan(&n); err != nil {
+		t.Fatalf("read before write failed: %v", err)
+	}
+	if _, err := router.ExecContext(session, "INSERT INTO site_redirects (source) VALUES (?)", "/old"); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if err := router.QueryRowContext(context.WithoutCancel(session), "SELECT id FROM site_redirects").Scan(&n); err != nil {
+		t.Fatalf("read back failed: %v", err)
+	}
+	if err := router.QueryRowContext(other, "SELECT id FROM site_redirects").Scan(&n); err != nil {
+		t.Fatalf("other session's read failed: %v", err)
+	}
+
+	if err := replicaMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("replica expectations: %v", err)
+	}
+	if err := primaryMock.ExpectationsWereMet(); err != nil {
+		t.Errorf("primary expectations: %v", err)
+	}
+}
+
+// TestRouter_ReplicaLag verifies reads move to the primary while the replica lags.
+func TestRouter_ReplicaLag(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        *sqlmock.Rows
+		wantLag     time.Duration
+		wantErr     bool
+		wantLagging bool
+	}{
+		{
+			name:    "caught up",
+			rows:    sqlmock.NewRows([]string{"Slave_IO_State", "Seconds_Behind_Master"}).AddRow("Waiting", "2"),
+			wantLag: 2 * time.Second,
+		},
+		{
+			name:        "behind",
+			rows:        sqlmock.NewRows([]string{"Seconds_Behind_Source"}).AddRow("30"),
+			wantLag:     30 * time.Second,
+			wantLagging: true,
+		},
+		{
+			name:        "stopped",
+			rows:        sqlmock.NewRows([]string{"Seconds_Behind_Master"}).AddRow(nil),
+			wantErr:     true,
+			wantLagging: true,
+		},
+		{
+			name:        "not replicating",
+			rows:        sqlmock.NewRows([]string{"Seconds_Behind_Master"}),
+			wantErr:     true,
+			wantLagging: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, primaryMock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create primary mock: %v", err)
+			}
+			defer func() { _ = primary.Close() }()
+			replica, replicaMock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create replica mock: %v", err)
+			}
+			defer func() { _ = replica.Close() }()
+
+			router := NewRouter(primary, replica)
+			replicaMock.ExpectQuery("SHOW REPLICA STATUS").WillReturnRows(tt.rows)
+			lag, err := router.CheckReplicaLag(context.Background(), 10*time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckReplicaLag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if lag != tt.wantLag {
+				t.Errorf("lag = %v, want %v", lag, tt.wantLag)
+			}
+			if router.ReplicaLagging() != tt.wantLagging {
+				t.Errorf("ReplicaLagging() = %v, want %v", router.ReplicaLagging(), tt.wantLagging)
+			}
+
+			// Reads follow the lag state
+			target := replicaMock
+			if tt.wantLagging {
+				target = primaryMock
+			}
+			target.ExpectQuery("SELECT 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+			var one int
+			if err := router.QueryRowContext(context.Background(), "SELECT 1").Scan(&one); err != nil {
+				t.Fatalf("read failed: %v", err)
+			}
+			if err := replicaMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("replica expectations: %v", err)
+			}
+			if err := primaryMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("primary expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+)
+
+type txKey struct{}
+
+// boundTx is a transaction carried by a context until it ends.
+type boundTx struct {
+	tx    *sql.Tx
+	ended atomic.Bool
+}
+
+// WithTx returns a context whose queries through a Router run in tx, and a
+// function to call once tx is committed or rolled back. After that, queries
+// made with the context go back to the connection pools.
+func WithTx(ctx context.Context, tx *sql.Tx) (context.Context, func()) {
+	bound := &boundTx{tx: tx}
+	return context.WithValue(ctx, txKey{}, bound), func() { bound.ended.Store(true) }
+}
+
+// WithoutTx returns a context whose queries run outside any transaction ctx
+// carries, for writes that must stay even if it rolls back.
+func WithoutTx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, txKey{}, (*boundTx)(nil))
+}
+
+// InTx reports whether queries made with ctx run in a transaction.
+func InTx(ctx context.Context) bool {
+	return txFrom(ctx) != nil
+}
+
+func txFrom(ctx context.Context) *sql.Tx {
+	bound, _ := ctx.Value(txKey{}).(*boundTx)
+	if bound == nil || bound.ended.Load() {
+		return nil
+	}
+	return bound.tx
+}
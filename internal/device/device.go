@@ -0,0 +1,284 @@
+// Package device implements the OAuth 2.0 device authorization grant (RFC 8628)
+// the libops CLI signs in with. The CLI asks for a device code and shows the
+// user a short user code; the user approves it in the dashboard while signed in,
+// and the CLI exchanges its device code for an API key.
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+)
+
+const (
+	// codeTTL is how long the user has to approve a request.
+	codeTTL = 15 * time.Minute
+	// PollInterval is how often the CLI may ask whether the request was approved.
+	PollInterval = 5 * time.Second
+	// keyTTL is how long the API key issued to the CLI lasts.
+	keyTTL = 90 * 24 * time.Hour
+	// userCodeAlphabet has no vowels, so codes don't spell words, and nothing that
+	// is easily confused with a digit.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+)
+
+var (
+	// ErrNotFound is returned for unknown, redeemed or mistyped codes.
+	ErrNotFound = errors.New("device code not found")
+	// ErrExpired is returned once a request is older than codeTTL.
+	ErrExpired = errors.New("device code expired")
+	// ErrDecided is returned when approving or denying a request twice.
+	ErrDecided = errors.New("request was already approved or denied")
+	// ErrPending is returned while the user hasn't approved the request yet.
+	ErrPending = errors.New("authorization pending")
+	// ErrSlowDown is returned when the CLI polls more often than PollInterval.
+	ErrSlowDown = errors.New("polling too often")
+	// ErrDenied is returned when the user denied the request.
+	ErrDenied = errors.New("authorization denied")
+)
+
+// KeyIssuer creates the API key handed to the CLI. It's implemented by
+// auth.APIKeyManager.
+type KeyIssuer interface {
+	CreateAPIKey(ctx context.Context, accountID int64, accountUUID, name, description string, scopes, allowedCIDRs []string, expiresAt *time.Time, createdBy int64) (string, *db.GetAPIKeyByUUIDRow, error)
+}
+
+// Authorizer issues and redeems device codes.
+type Authorizer struct {
+	db          db.Querier
+	keys        KeyIssuer
+	auditLogger *audit.Logger
+	baseURL     string
+	now         func() time.Time
+}
+
+// NewAuthorizer creates an Authorizer. baseURL is the dashboard's URL, where
+// users approve requests.
+func NewAuthorizer(querier db.Querier, keys KeyIssuer, auditLogger *audit.Logger, baseURL string) *Authorizer {
+	return &Authorizer{
+		db:          querier,
+		keys:        keys,
+		auditLogger: auditLogger,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		now:         time.Now,
+	}
+}
+
+// Code is a started device authorization.
+type Code struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresAt               time.Time
+}
+
+// Request is a pending device authorization, as shown on the approval page.
+type Request struct {
+	ID         int64
+	UserCode   string
+	ClientName string
+	ExpiresAt  time.Time
+}
+
+// Start creates a device authorization for a client, e.g. "libops on laptop".
+func (a *Authorizer) Start(ctx context.Context, clientName string) (*Code, error) {
+	clientName = strings.TrimSpace(clientName)
+	if clientName == "" {
+		clientName = "libops CLI"
+	}
+	if len(clientName) > 100 {
+		clientName = clientName[:100]
+	}
+
+	deviceCode, deviceCodeHash, err := newDeviceCode()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := a.now().Add(codeTTL).UTC().Truncate(time.Second)
+
+	// User codes are short, so retry the rare collision with a live one
+	for range 3 {
+		userCode, err := newUserCode()
+		if err != nil {
+			return nil, err
+		}
+		err = a.db.CreateDeviceAuthorization(ctx, db.CreateDeviceAuthorizationParams{
+			DeviceCodeHash: deviceCodeHash,
+			UserCode:       userCode,
+			ClientName:     clientName,
+			ExpiresAt:      expiresAt,
+		})
+		if isDuplicateKey(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create device authorization: %w", err)
+		}
+		return &Code{
+			DeviceCode:              deviceCode,
+			UserCode:                userCode,
+			VerificationURI:         a.baseURL + "/auth/device",
+			VerificationURIComplete: a.baseURL + "/auth/device?user_code=" + userCode,
+			ExpiresAt:               expiresAt,
+		}, nil
+	}
+	return nil, errors.New("create device authorization: could not pick a unique user code")
+}
+
+// Lookup finds the pending request for a user code, in any case and with or
+// without the dash.
+func (a *Authorizer) Lookup(ctx context.Context, userCode string) (*Request, error) {
+	row, err := a.db.GetDeviceAuthorizationByUserCode(ctx, NormalizeUserCode(userCode))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get device authorization: %w", err)
+	}
+	if !a.now().Before(row.ExpiresAt) {
+		return nil, ErrExpired
+	}
+	if row.Status != db.DeviceAuthorizationsStatusPending {
+		return nil, ErrDecided
+	}
+	return &Request{ID: row.ID, UserCode: row.UserCode, ClientName: row.ClientName, ExpiresAt: row.ExpiresAt}, nil
+}
+
+// Decide approves or denies a pending request on behalf of the signed-in account.
+func (a *Authorizer) Decide(ctx context.Context, userCode string, accountID int64, approve bool) error {
+	req, err := a.Lookup(ctx, userCode)
+	if err != nil {
+		return err
+	}
+
+	status := db.DeviceAuthorizationsStatusDenied
+	if approve {
+		status = db.DeviceAuthorizationsStatusApproved
+	}
+	decided, err := a.db.DecideDeviceAuthorization(ctx, db.DecideDeviceAuthorizationParams{
+		Status:    status,
+		AccountID: sql.NullInt64{Int64: accountID, Valid: true},
+		ID:        req.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("decide device authorization: %w", err)
+	}
+	if decided == 0 {
+		return ErrDecided
+	}
+
+	if approve {
+		a.auditLogger.Log(ctx, accountID, accountID, audit.AccountEntityType, audit.DeviceLoginApprove, map[string]any{
+			"client_name": req.ClientName,
+		})
+	}
+	return nil
+}
+
+// Redeem exchanges an approved device code for an API key, once. It returns
+// ErrPending, ErrSlowDown, ErrDenied, ErrExpired or ErrNotFound while there's
+// no key to hand out.
+func (a *Authorizer) Redeem(ctx context.Context, deviceCode string) (string, time.Time, error) {
+	row, err := a.db.GetDeviceAuthorizationByDeviceCodeHash(ctx, hashCode(deviceCode))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", time.Time{}, ErrNotFound
+	}
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("get device authorization: %w", err)
+	}
+
+	now := a.now()
+	if !now.Before(row.ExpiresAt) {
+		return "", time.Time{}, ErrExpired
+	}
+	if row.Status == db.DeviceAuthorizationsStatusPending {
+		tooSoon := row.LastPolledAt.Valid && now.Sub(row.LastPolledAt.Time) < PollInterval
+		if err := a.db.TouchDeviceAuthorization(ctx, row.ID); err != nil {
+			return "", time.Time{}, fmt.Errorf("touch device authorization: %w", err)
+		}
+		if tooSoon {
+			return "", time.Time{}, ErrSlowDown
+		}
+		return "", time.Time{}, ErrPending
+	}
+	switch row.Status {
+	case db.DeviceAuthorizationsStatusDenied:
+		return "", time.Time{}, ErrDenied
+	case db.DeviceAuthorizationsStatusRedeemed:
+		return "", time.Time{}, ErrNotFound
+	}
+
+	// Only one poll may turn an approval into a key
+	redeemed, err := a.db.RedeemDeviceAuthorization(ctx, row.ID)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("redeem device authorization: %w", err)
+	}
+	if redeemed == 0 {
+		return "", time.Time{}, ErrNotFound
+	}
+
+	account, err := a.db.GetAccountByID(ctx, row.AccountID.Int64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("get account: %w", err)
+	}
+	expiresAt := now.Add(keyTTL).UTC().Truncate(time.Second)
+	apiKey, _, err := a.keys.CreateAPIKey(ctx, account.ID, account.PublicID,
+		row.ClientName, "Created by libops login", nil, nil, &expiresAt, account.ID)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("create API key: %w", err)
+	}
+	return apiKey, expiresAt, nil
+}
+
+// NormalizeUserCode uppercases a user code and puts the dash back, so codes
+// can be typed in any case and with or without it.
+func NormalizeUserCode(userCode string) string {
+	code := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(userCode))
+	if len(code) != 8 {
+		return code
+	}
+	return code[:4] + "-" + code[4:]
+}
+
+func newDeviceCode() (code, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate device code: %w", err)
+	}
+	code = base64.RawURLEncoding.EncodeToString(b)
+	return code, hashCode(code), nil
+}
+
+func newUserCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate user code: %w", err)
+	}
+	for i := range b {
+		b[i] = userCodeAlphabet[int(b[i])%len(userCodeAlphabet)]
+	}
+	return string(b[:4]) + "-" + string(b[4:]), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -0,0 +1,168 @@
+package device
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/testutils"
+)
+
+type fakeKeys struct {
+	names     []string
+	expiresAt *time.Time
+}
+
+func (k *fakeKeys) CreateAPIKey(ctx context.Context, accountID int64, accountUUID, name, description string, scopes, allowedCIDRs []string, expiresAt *time.Time, createdBy int64) (string, *db.GetAPIKeyByUUIDRow, error) {
+	k.names = append(k.names, name)
+	k.expiresAt = expiresAt
+	return "libops_" + accountUUID, &db.GetAPIKeyByUUIDRow{}, nil
+}
+
+// fakeStore keeps a single device authorization row behind the mock querier.
+func fakeStore(t *testing.T, now func() time.Time) (*testutils.MockQuerier, *db.DeviceAuthorization) {
+	row := &db.DeviceAuthorization{}
+	mock := &testutils.MockQuerier{
+		CreateDeviceAuthorizationFunc: func(ctx context.Context, arg db.CreateDeviceAuthorizationParams) error {
+			*row = db.DeviceAuthorization{
+				ID:             1,
+				DeviceCodeHash: arg.DeviceCodeHash,
+				UserCode:       arg.UserCode,
+				ClientName:     arg.ClientName,
+				Status:         db.DeviceAuthorizationsStatusPending,
+				ExpiresAt:      arg.ExpiresAt,
+			}
+			return nil
+		},
+		GetDeviceAuthorizationByUserCodeFunc: func(ctx context.Context, userCode string) (db.GetDeviceAuthorizationByUserCodeRow, error) {
+			if userCode != row.UserCode {
+				return db.GetDeviceAuthorizationByUserCodeRow{}, sql.ErrNoRows
+			}
+			return db.GetDeviceAuthorizationByUserCodeRow{
+				ID: row.ID, UserCode: row.UserCode, ClientName: row.ClientName, Status: row.Status,
+				AccountID: row.AccountID, ExpiresAt: row.ExpiresAt, LastPolledAt: row.LastPolledAt,
+			}, nil
+		},
+		GetDeviceAuthorizationByDeviceCodeHashFunc: func(ctx context.Context, hash string) (db.GetDeviceAuthorizationByDeviceCodeHashRow, error) {
+			if hash != row.DeviceCodeHash {
+				return db.GetDeviceAuthorizationByDeviceCodeHashRow{}, sql.ErrNoRows
+			}
+			return db.GetDeviceAuthorizationByDeviceCodeHashRow{
+				ID: row.ID, UserCode: row.UserCode, ClientName: row.ClientName, Status: row.Status,
+				AccountID: row.AccountID, ExpiresAt: row.ExpiresAt, LastPolledAt: row.LastPolledAt,
+			}, nil
+		},
+		TouchDeviceAuthorizationFunc: func(ctx context.Context, id int64) error {
+			row.LastPolledAt = sql.NullTime{Time: now(), Valid: true}
+			return nil
+		},
+		DecideDeviceAuthorizationFunc: func(ctx context.Context, arg db.DecideDeviceAuthorizationParams) (int64, error) {
+			if row.Status != db.DeviceAuthorizationsStatusPending {
+				return 0, nil
+			}
+			row.Status, row.AccountID = arg.Status, arg.AccountID
+			return 1, nil
+		},
+		RedeemDeviceAuthorizationFunc: func(ctx context.Context, id int64) (int64, error) {
+			if row.Status != db.DeviceAuthorizationsStatusApproved {
+				return 0, nil
+			}
+			row.Status = db.DeviceAuthorizationsStatusRedeemed
+			return 1, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			require.Equal(t, int64(7), id)
+			return db.GetAccountByIDRow{ID: 7, PublicID: "account-7"}, nil
+		},
+	}
+	return mock, row
+}
+
+// TestDeviceFlow tests that the CLI gets an API key once, and only after the
+// user approved its code.
+func TestDeviceFlow(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+	mock, row := fakeStore(t, clock)
+	keys := &fakeKeys{}
+	a := NewAuthorizer(mock, keys, audit.New(mock), "https://dash.libops.io/")
+	a.now = clock
+	ctx := context.Background()
+
+	code, err := a.Start(ctx, "libops on laptop")
+	require.NoError(t, err)
+	assert.Regexp(t, `^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`, code.UserCode)
+	assert.Equal(t, "https://dash.libops.io/auth/device", code.VerificationURI)
+	assert.Equal(t, "https://dash.libops.io/auth/device?user_code="+code.UserCode, code.VerificationURIComplete)
+	assert.Equal(t, now.Add(codeTTL), code.ExpiresAt)
+	assert.NotEqual(t, code.DeviceCode, row.DeviceCodeHash, "only the hash is stored")
+
+	_, _, err = a.Redeem(ctx, code.DeviceCode)
+	assert.ErrorIs(t, err, ErrPending)
+	now = now.Add(time.Second)
+	_, _, err = a.Redeem(ctx, code.DeviceCode)
+	assert.ErrorIs(t, err, ErrSlowDown)
+	now = now.Add(PollInterval)
+
+	// Users may type the code in lowercase and without the dash
+	req, err := a.Lookup(ctx, strings.ToLower(strings.ReplaceAll(code.UserCode, "-", "")))
+	require.NoError(t, err)
+	assert.Equal(t, "libops on laptop", req.ClientName)
+
+	require.NoError(t, a.Decide(ctx, code.UserCode, 7, true))
+	assert.ErrorIs(t, a.Decide(ctx, code.UserCode, 7, false), ErrDecided)
+
+	apiKey, expiresAt, err := a.Redeem(ctx, code.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "libops_account-7", apiKey)
+	assert.Equal(t, now.Add(keyTTL), expiresAt)
+	assert.Equal(t, []string{"libops on laptop"}, keys.names)
+	assert.Equal(t, expiresAt, *keys.expiresAt)
+
+	_, _, err = a.Redeem(ctx, code.DeviceCode)
+	assert.ErrorIs(t, err, ErrNotFound, "a device code is redeemed once")
+	assert.Len(t, keys.names, 1)
+}
+
+// TestDeviceFlowDeniedAndExpired tests that denied and expired requests never
+// turn into API keys.
+func TestDeviceFlowDeniedAndExpired(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+	mock, _ := fakeStore(t, clock)
+	keys := &fakeKeys{}
+	a := NewAuthorizer(mock, keys, audit.New(mock), "https://dash.libops.io")
+	a.now = clock
+	ctx := context.Background()
+
+	code, err := a.Start(ctx, "")
+	require.NoError(t, err)
+	require.NoError(t, a.Decide(ctx, code.UserCode, 7, false))
+	_, _, err = a.Redeem(ctx, code.DeviceCode)
+	assert.ErrorIs(t, err, ErrDenied)
+
+	code, err = a.Start(ctx, "")
+	require.NoError(t, err)
+	now = now.Add(codeTTL)
+	_, err = a.Lookup(ctx, code.UserCode)
+	assert.ErrorIs(t, err, ErrExpired)
+	_, _, err = a.Redeem(ctx, code.DeviceCode)
+	assert.ErrorIs(t, err, ErrExpired)
+
+	_, _, err = a.Redeem(ctx, "made-up")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Empty(t, keys.names)
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	assert.Equal(t, "WDJB-MJHT", NormalizeUserCode("wdjb-mjht"))
+	assert.Equal(t, "WDJB-MJHT", NormalizeUserCode(" wdjbmjht "))
+	assert.Equal(t, "WDJ", NormalizeUserCode("wdj"))
+}
@@ -0,0 +1,172 @@
+package device
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/dash"
+	"github.com/libops/api/internal/i18n"
+	accountsvc "github.com/libops/api/internal/service/account"
+)
+
+// grantType is the grant_type the CLI polls the token endpoint with.
+const grantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// codeResponse is the device authorization response (RFC 8628 section 3.2).
+type codeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// tokenResponse is a successful token response. The access token is an API key.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// errorResponse is an OAuth 2.0 error response.
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// HandleCode starts a device authorization.
+// POST /auth/device/code
+func (a *Authorizer) HandleCode(w http.ResponseWriter, r *http.Request) {
+	code, err := a.Start(r.Context(), r.FormValue("client_name"))
+	if err != nil {
+		slog.Error("Failed to start device authorization", "err", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, codeResponse{
+		DeviceCode:              code.DeviceCode,
+		UserCode:                code.UserCode,
+		VerificationURI:         code.VerificationURI,
+		VerificationURIComplete: code.VerificationURIComplete,
+		ExpiresIn:               int(time.Until(code.ExpiresAt).Seconds()),
+		Interval:                int(PollInterval.Seconds()),
+	})
+}
+
+// HandleToken exchanges an approved device code for an API key. Until then it
+// answers with the RFC 8628 polling errors.
+// POST /auth/device/token
+func (a *Authorizer) HandleToken(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("grant_type") != grantType {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "unsupported_grant_type"})
+		return
+	}
+
+	apiKey, expiresAt, err := a.Redeem(r.Context(), r.FormValue("device_code"))
+	switch {
+	case errors.Is(err, ErrPending):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "authorization_pending"})
+	case errors.Is(err, ErrSlowDown):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "slow_down"})
+	case errors.Is(err, ErrDenied):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "access_denied", ErrorDescription: "The request was denied."})
+	case errors.Is(err, ErrExpired):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "expired_token", ErrorDescription: "The code expired. Run libops login again."})
+	case errors.Is(err, ErrNotFound):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid_grant"})
+	case err != nil:
+		slog.Error("Failed to redeem device authorization", "err", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "server_error"})
+	default:
+		writeJSON(w, http.StatusOK, tokenResponse{
+			AccessToken: apiKey,
+			TokenType:   "Bearer",
+			ExpiresIn:   int(time.Until(expiresAt).Seconds()),
+		})
+	}
+}
+
+// HandlePage shows the page where signed-in users enter a user code and
+// approve or deny the request.
+// GET /auth/device
+func (a *Authorizer) HandlePage(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	data := a.pageData(r, userInfo)
+	if userCode := r.URL.Query().Get("user_code"); userCode != "" {
+		data["UserCode"] = NormalizeUserCode(userCode)
+		req, err := a.Lookup(r.Context(), userCode)
+		if err != nil {
+			data["Error"] = a.errorMessage(data["Locale"].(string), err)
+		} else {
+			data["Request"] = req
+		}
+	}
+	dash.RenderTemplate(w, "device.html", data)
+}
+
+// HandleDecision approves or denies a request. The session cookie is SameSite
+// Lax, so other sites can't submit this form for the user.
+// POST /auth/device
+func (a *Authorizer) HandleDecision(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	data := a.pageData(r, userInfo)
+	locale := data["Locale"].(string)
+	approve := r.FormValue("action") == "approve"
+	data["UserCode"] = NormalizeUserCode(r.FormValue("user_code"))
+	if err := a.Decide(r.Context(), r.FormValue("user_code"), userInfo.AccountID, approve); err != nil {
+		data["Error"] = a.errorMessage(locale, err)
+	} else if approve {
+		data["Done"] = i18n.T(locale, "device.approved")
+	} else {
+		data["Done"] = i18n.T(locale, "device.denied")
+	}
+	dash.RenderTemplate(w, "device.html", data)
+}
+
+func (a *Authorizer) pageData(r *http.Request, userInfo *auth.UserInfo) map[string]any {
+	prefs, err := accountsvc.LoadPreferences(r.Context(), a.db, userInfo.AccountID)
+	if err != nil {
+		slog.Warn("Failed to load account preferences", "account_id", userInfo.AccountID, "err", err)
+	}
+	return map[string]any{
+		"Email":  userInfo.Email,
+		"Locale": i18n.Negotiate(prefs.Locale, r.Header.Get("Accept-Language")),
+	}
+}
+
+func (a *Authorizer) errorMessage(locale string, err error) string {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return i18n.T(locale, "device.not_found")
+	case errors.Is(err, ErrExpired):
+		return i18n.T(locale, "device.expired")
+	case errors.Is(err, ErrDecided):
+		return i18n.T(locale, "device.decided")
+	default:
+		slog.Error("Failed to handle device authorization", "err", err)
+		return i18n.T(locale, "device.failed")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
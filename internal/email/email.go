@@ -0,0 +1,200 @@
+// Package email sends templated transactional email through a pluggable
+// provider (SMTP, SendGrid, SES, or the log for development) and records
+// every attempt in the email_sends audit table.
+package email
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/branding"
+)
+
+// Message templates
+const (
+	TemplateVerification  = "verification"
+	TemplatePasswordReset = "password_reset"
+	TemplateInvitation    = "invitation"
+	TemplateDeployFailed  = "deploy_failed"
+	TemplateBackupFailed  = "backup_failed"
+	TemplateBillingIssue  = "billing_issue"
+)
+
+// Message is a rendered email ready for delivery.
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Provider delivers rendered messages.
+type Provider interface {
+	// Name identifies the provider in the send audit.
+	Name() string
+	// Send delivers the message and returns the provider's message ID, if any.
+	Send(ctx context.Context, msg Message) (string, error)
+}
+
+// Request asks for a templated message to be sent to one recipient.
+type Request struct {
+	Template string
+	To       string
+	// Locale is a BCP 47 tag such as "pt-BR". Messages fall back to the base
+	// language and then DefaultLocale when no translation exists.
+	Locale string
+	// Data is the template's data, e.g. VerificationData for TemplateVerification.
+	Data any
+	// OrganizationID is the internal ID of the organization the message is about.
+	// When set, the message carries that organization's logo and colors.
+	OrganizationID int64
+}
+
+// VerificationData is the data for TemplateVerification.
+type VerificationData struct {
+	VerifyURL string
+	ExpiresIn string // e.g. "24 hours"
+}
+
+// PasswordResetData is the data for TemplatePasswordReset.
+type PasswordResetData struct {
+	ResetURL  string
+	ExpiresIn string // e.g. "1 hour"
+}
+
+// InvitationData is the data for TemplateInvitation.
+type InvitationData struct {
+	InviterName  string
+	ResourceType string // organization, project or site
+	ResourceName string
+	Role         string
+	AcceptURL    string
+}
+
+// DeployFailedData is the data for TemplateDeployFailed.
+type DeployFailedData struct {
+	SiteName    string
+	ProjectName string
+	Ref         string
+	Error       string
+	DetailsURL  string
+}
+
+// BackupFailedData is the data for TemplateBackupFailed.
+type BackupFailedData struct {
+	SiteName    string
+	ProjectName string
+	Error       string
+	DetailsURL  string
+}
+
+// BillingIssueData is the data for TemplateBillingIssue.
+type BillingIssueData struct {
+	OrganizationName string
+	State            string // past_due, suspended or scheduled_for_deletion
+	Deadline         string // when the next escalation happens, empty if none
+	BillingURL       string
+}
+
+// Sender renders and delivers templated messages.
+type Sender struct {
+	db        db.Querier
+	provider  Provider
+	templates *Templates
+	from      string
+	baseURL   string
+}
+
+// NewSender creates a sender that delivers the built-in templates from the given
+// address. Organization logos are linked from the dashboard at baseURL.
+func NewSender(querier db.Querier, provider Provider, from, baseURL string) *Sender {
+	return &Sender{
+		db:        querier,
+		provider:  provider,
+		templates: DefaultTemplates(),
+		from:      from,
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Send renders the request's template and delivers it. Every delivery attempt
+// is recorded in the send audit, whether or not the provider accepted it.
+func (s *Sender) Send(ctx context.Context, req Request) error {
+	brand := DefaultBrand()
+	if req.OrganizationID != 0 {
+		brand = s.organizationBrand(ctx, req.OrganizationID)
+	}
+
+	rendered, err := s.templates.RenderBranded(req.Template, req.Locale, req.Data, brand)
+	if err != nil {
+		return err
+	}
+
+	msg := Message{
+		From:    s.from,
+		To:      req.To,
+		Subject: rendered.Subject,
+		Text:    rendered.Text,
+		HTML:    rendered.HTML,
+	}
+	messageID, sendErr := s.provider.Send(ctx, msg)
+
+	status := db.EmailSendsStatusSent
+	var errText sql.NullString
+	if sendErr != nil {
+		status = db.EmailSendsStatusFailed
+		errText = sql.NullString{String: sendErr.Error(), Valid: true}
+	}
+	err = s.db.CreateEmailSend(ctx, db.CreateEmailSendParams{
+		Template:          req.Template,
+		Locale:            rendered.Locale,
+		Recipient:         req.To,
+		Subject:           msg.Subject,
+		Provider:          s.provider.Name(),
+		ProviderMessageID: sql.NullString{String: messageID, Valid: messageID != ""},
+		Status:            status,
+		Error:             errText,
+	})
+	if err != nil {
+		// The message may already be delivered, so a missing audit row isn't a send failure
+		slog.Error("Failed to record email send", "error", err, "template", req.Template)
+	}
+
+	if sendErr != nil {
+		return fmt.Errorf("failed to send %s email: %w", req.Template, sendErr)
+	}
+	return nil
+}
+
+// organizationBrand returns an organization's brand, or the libops one when it
+// has no branding or it can't be loaded, since a plain message beats none.
+func (s *Sender) organizationBrand(ctx context.Context, organizationID int64) Brand {
+	brand := DefaultBrand()
+
+	org, err := s.db.GetOrganizationByID(ctx, organizationID)
+	if err != nil {
+		slog.Error("Failed to get organization for email branding", "error", err, "organization_id", organizationID)
+		return brand
+	}
+	custom, err := branding.Load(ctx, s.db, org.ID, org.PublicID, s.baseURL)
+	if err != nil {
+		slog.Error("Failed to load email branding", "error", err, "organization_id", org.PublicID)
+		return brand
+	}
+	if !custom.Custom {
+		return brand
+	}
+
+	return Brand{
+		Name:         org.Name,
+		LogoURL:      custom.LogoURL,
+		PrimaryColor: custom.PrimaryColor,
+		AccentColor:  custom.AccentColor,
+		Custom:       true,
+	}
+}
@@ -0,0 +1,199 @@
+package email
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+type fakeProvider struct {
+	sent []Message
+	err  error
+}
+
+func (p *fakeProvider) Name() string { return "fake" }
+
+func (p *fakeProvider) Send(ctx context.Context, msg Message) (string, error) {
+	p.sent = append(p.sent, msg)
+	if p.err != nil {
+		return "", p.err
+	}
+	return "msg-1", nil
+}
+
+// TestDefaultTemplatesRender tests that every built-in template renders.
+func TestDefaultTemplatesRender(t *testing.T) {
+	tests := []struct {
+		template string
+		data     any
+		want     string
+	}{
+		{TemplateVerification, VerificationData{VerifyURL: "https://api.libops.io/auth/verify?token=abc", ExpiresIn: "24 hours"}, "https://api.libops.io/auth/verify?token=abc"},
+		{TemplatePasswordReset, PasswordResetData{ResetURL: "https://api.libops.io/reset-password?token=abc", ExpiresIn: "1 hour"}, "https://api.libops.io/reset-password?token=abc"},
+		{TemplateInvitation, InvitationData{InviterName: "Ada", ResourceType: "project", ResourceName: "Library", Role: "developer", AcceptURL: "https://dash.libops.io/invite"}, "Library"},
+		{TemplateDeployFailed, DeployFailedData{SiteName: "catalog", ProjectName: "Library", Ref: "heads/main", Error: "exit 1", DetailsURL: "https://dash.libops.io/sites/1"}, "catalog"},
+		{TemplateBackupFailed, BackupFailedData{SiteName: "catalog", ProjectName: "Library", DetailsURL: "https://dash.libops.io/sites/1"}, "catalog"},
+		{TemplateBillingIssue, BillingIssueData{OrganizationName: "Acme", State: "past_due", Deadline: "May 1, 2026", BillingURL: "https://dash.libops.io/billing"}, "May 1, 2026"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			rendered, err := DefaultTemplates().Render(tt.template, "", tt.data)
+			require.NoError(t, err)
+			assert.Equal(t, DefaultLocale, rendered.Locale)
+			assert.NotEmpty(t, rendered.Subject)
+			assert.NotContains(t, rendered.Subject, "\n")
+			assert.Contains(t, rendered.Text, tt.want)
+			assert.Contains(t, rendered.HTML, "<html>")
+		})
+	}
+}
+
+// TestRenderEscapesHTML tests that template data is escaped in the HTML part only.
+func TestRenderEscapesHTML(t *testing.T) {
+	rendered, err := DefaultTemplates().Render(TemplateBillingIssue, "", BillingIssueData{
+		OrganizationName: "<Acme & Co>",
+		State:            "suspended",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, rendered.Subject, "<Acme & Co> sites are suspended")
+	assert.Contains(t, rendered.HTML, "&lt;Acme &amp; Co&gt;")
+	assert.NotContains(t, rendered.HTML, "<Acme")
+}
+
+// TestRenderLocaleFallback tests the region, language and default locale fallback chain.
+func TestRenderLocaleFallback(t *testing.T) {
+	fsys := fstest.MapFS{
+		"layout.tmpl":     {Data: []byte(`{{template "body" .}}`)},
+		"en/hello.tmpl":   {Data: []byte(`{{define "subject"}}Hello{{end}}{{define "text"}}Hello{{end}}{{define "body"}}Hello{{end}}`)},
+		"pt/hello.tmpl":   {Data: []byte(`{{define "subject"}}Olá{{end}}{{define "text"}}Olá{{end}}{{define "body"}}Olá{{end}}`)},
+		"en/missing.tmpl": {Data: []byte(`{{define "subject"}}x{{end}}{{define "text"}}x{{end}}{{define "body"}}x{{end}}`)},
+	}
+	templates, err := LoadTemplates(fsys)
+	require.NoError(t, err)
+
+	tests := []struct {
+		locale, wantLocale, wantSubject string
+	}{
+		{"pt-BR", "pt", "Olá"},
+		{"pt_BR", "pt", "Olá"},
+		{"pt", "pt", "Olá"},
+		{"fr-CA", "en", "Hello"},
+		{"", "en", "Hello"},
+	}
+	for _, tt := range tests {
+		rendered, err := templates.Render("hello", tt.locale, nil)
+		require.NoError(t, err, tt.locale)
+		assert.Equal(t, tt.wantLocale, rendered.Locale, tt.locale)
+		assert.Equal(t, tt.wantSubject, rendered.Subject, tt.locale)
+	}
+
+	_, err = templates.Render("unknown", "en", nil)
+	assert.Error(t, err)
+}
+
+// TestLoadTemplatesRequiresParts tests that message files must define every part.
+func TestLoadTemplatesRequiresParts(t *testing.T) {
+	_, err := LoadTemplates(fstest.MapFS{
+		"layout.tmpl":   {Data: []byte(`{{template "body" .}}`)},
+		"en/hello.tmpl": {Data: []byte(`{{define "subject"}}Hello{{end}}{{define "body"}}Hello{{end}}`)},
+	})
+	assert.ErrorContains(t, err, `"text"`)
+}
+
+// TestSenderAudit tests that successful and failed sends are both recorded.
+func TestSenderAudit(t *testing.T) {
+	tests := []struct {
+		name        string
+		providerErr error
+		wantStatus  db.EmailSendsStatus
+		wantErr     bool
+	}{
+		{"sent", nil, db.EmailSendsStatusSent, false},
+		{"failed", errors.New("connection refused"), db.EmailSendsStatusFailed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded []db.CreateEmailSendParams
+			mock := &testutils.MockQuerier{
+				CreateEmailSendFunc: func(ctx context.Context, arg db.CreateEmailSendParams) error {
+					recorded = append(recorded, arg)
+					return nil
+				},
+			}
+			provider := &fakeProvider{err: tt.providerErr}
+			sender := NewSender(mock, provider, "libops <noreply@libops.io>", "https://dash.libops.io")
+
+			err := sender.Send(context.Background(), Request{
+				Template: TemplateVerification,
+				To:       "ada@example.com",
+				Locale:   "de-DE",
+				Data:     VerificationData{VerifyURL: "https://example.com/verify", ExpiresIn: "24 hours"},
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			require.Len(t, provider.sent, 1)
+			assert.Equal(t, "libops <noreply@libops.io>", provider.sent[0].From)
+			assert.Equal(t, "ada@example.com", provider.sent[0].To)
+
+			require.Len(t, recorded, 1)
+			assert.Equal(t, TemplateVerification, recorded[0].Template)
+			assert.Equal(t, "en", recorded[0].Locale)
+			assert.Equal(t, "fake", recorded[0].Provider)
+			assert.Equal(t, tt.wantStatus, recorded[0].Status)
+			assert.Equal(t, tt.providerErr != nil, recorded[0].Error.Valid)
+			assert.Equal(t, tt.providerErr == nil, recorded[0].ProviderMessageID.Valid)
+			assert.True(t, strings.HasPrefix(recorded[0].Subject, "Verify"))
+		})
+	}
+}
+
+// TestSenderBranding tests that messages about a branded organization carry its
+// logo and colors, and that other messages keep the libops look.
+func TestSenderBranding(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: "0194d3a0-0000-7000-8000-000000000001", Name: "Library Consortium"}, nil
+		},
+		GetOrganizationBrandingFunc: func(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error) {
+			if organizationID != 7 {
+				return db.GetOrganizationBrandingRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationBrandingRow{
+				OrganizationID: 7,
+				PrimaryColor:   "#1d4ed8",
+				HasLogo:        true,
+				UpdatedAt:      sql.NullTime{Time: time.Unix(1767000000, 0), Valid: true},
+			}, nil
+		},
+	}
+	provider := &fakeProvider{}
+	sender := NewSender(mock, provider, "libops <noreply@libops.io>", "https://dash.libops.io/")
+	data := BillingIssueData{OrganizationName: "Library Consortium", State: "past_due", BillingURL: "https://dash.libops.io/billing"}
+
+	require.NoError(t, sender.Send(context.Background(), Request{Template: TemplateBillingIssue, To: "ada@example.com", Data: data, OrganizationID: 7}))
+	require.NoError(t, sender.Send(context.Background(), Request{Template: TemplateBillingIssue, To: "ada@example.com", Data: data, OrganizationID: 8}))
+	require.Len(t, provider.sent, 2)
+
+	branded := provider.sent[0].HTML
+	assert.Contains(t, branded, `src="https://dash.libops.io/organizations/0194d3a0-0000-7000-8000-000000000001/logo?v=1767000000"`)
+	assert.Contains(t, branded, "background:#1d4ed8")
+	assert.Contains(t, branded, "3px solid #10b981", "unset colors keep the default")
+	assert.Contains(t, branded, "Sent on behalf of Library Consortium.")
+
+	plain := provider.sent[1].HTML
+	assert.Contains(t, plain, "background:#7f1d1d")
+	assert.Contains(t, plain, ">libops</td>")
+	assert.NotContains(t, plain, "on behalf of")
+}
@@ -0,0 +1,257 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/libops/api/internal/config"
+)
+
+// NewProvider creates the provider selected by EMAIL_PROVIDER.
+func NewProvider(cfg *config.Config) (Provider, error) {
+	switch cfg.EmailProvider {
+	case "", "log":
+		return LogProvider{}, nil
+	case "smtp":
+		return NewSMTPProvider(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword), nil
+	case "ses":
+		return NewSESProvider(cfg.SESRegion, cfg.SMTPUsername, cfg.SMTPPassword), nil
+	case "sendgrid":
+		return NewSendGridProvider(cfg.SendGridAPIKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported email provider %q", cfg.EmailProvider)
+	}
+}
+
+// LogProvider writes messages to the log instead of delivering them, for development.
+type LogProvider struct{}
+
+// Name implements Provider.
+func (LogProvider) Name() string { return "log" }
+
+// Send implements Provider.
+func (LogProvider) Send(ctx context.Context, msg Message) (string, error) {
+	slog.Info("Email not delivered (log provider)",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Text)
+	return "", nil
+}
+
+// SMTPProvider delivers messages through an SMTP relay, upgrading to TLS with
+// STARTTLS when the server offers it.
+type SMTPProvider struct {
+	name     string
+	host     string
+	port     string
+	username string
+	password string
+
+	// sendMail is smtp.SendMail, replaced in tests
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPProvider creates an SMTP provider. Authentication is skipped when username is empty.
+func NewSMTPProvider(host, port, username, password string) *SMTPProvider {
+	if port == "" {
+		port = "587"
+	}
+	return &SMTPProvider{
+		name:     "smtp",
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		sendMail: smtp.SendMail,
+	}
+}
+
+// NewSESProvider creates a provider for Amazon SES through its SMTP interface,
+// using SES SMTP credentials.
+func NewSESProvider(region, username, password string) *SMTPProvider {
+	p := NewSMTPProvider(fmt.Sprintf("email-smtp.%s.amazonaws.com", region), "587", username, password)
+	p.name = "ses"
+	return p
+}
+
+// Name implements Provider.
+func (p *SMTPProvider) Name() string { return p.name }
+
+// Send implements Provider.
+func (p *SMTPProvider) Send(ctx context.Context, msg Message) (string, error) {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return "", fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	messageID, data, err := buildMIME(msg, from, to, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	var auth smtp.Auth
+	if p.username != "" {
+		auth = smtp.PlainAuth("", p.username, p.password, p.host)
+	}
+	if err := p.sendMail(net.JoinHostPort(p.host, p.port), auth, from.Address, []string{to.Address}, data); err != nil {
+		return "", err
+	}
+	return messageID, nil
+}
+
+// buildMIME encodes a message as multipart/alternative with text and HTML parts.
+func buildMIME(msg Message, from, to *mail.Address, now time.Time) (string, []byte, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, fmt.Errorf("failed to generate message id: %w", err)
+	}
+	domain := from.Address[strings.LastIndex(from.Address, "@")+1:]
+	messageID := fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain)
+
+	var buf bytes.Buffer
+	body := multipart.NewWriter(&buf)
+
+	var header bytes.Buffer
+	fmt.Fprintf(&header, "From: %s\r\n", from.String())
+	fmt.Fprintf(&header, "To: %s\r\n", to.String())
+	fmt.Fprintf(&header, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&header, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&header, "Message-ID: %s\r\n", messageID)
+	header.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&header, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", body.Boundary())
+
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		if part.content == "" {
+			continue
+		}
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return "", nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return "", nil, err
+		}
+	}
+	if err := body.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return messageID, append(header.Bytes(), buf.Bytes()...), nil
+}
+
+// sendGridAPIURL is the SendGrid v3 mail send endpoint.
+const sendGridAPIURL = "https://api.sendgrid.com/v3/mail/send"
+
+// SendGridProvider delivers messages through the SendGrid v3 API.
+type SendGridProvider struct {
+	apiKey string
+	url    string
+	client *http.Client
+}
+
+// NewSendGridProvider creates a SendGrid provider.
+func NewSendGridProvider(apiKey string) *SendGridProvider {
+	return &SendGridProvider{
+		apiKey: apiKey,
+		url:    sendGridAPIURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name implements Provider.
+func (p *SendGridProvider) Name() string { return "sendgrid" }
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+}
+
+// Send implements Provider.
+func (p *SendGridProvider) Send(ctx context.Context, msg Message) (string, error) {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return "", fmt.Errorf("invalid from address: %w", err)
+	}
+
+	payload := sendGridRequest{
+		Personalizations: []sendGridPersonalization{{To: []sendGridAddress{{Email: msg.To}}}},
+		From:             sendGridAddress{Email: from.Address, Name: from.Name},
+		Subject:          msg.Subject,
+	}
+	// SendGrid requires text/plain to come before text/html
+	if msg.Text != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/plain", Value: msg.Text})
+	}
+	if msg.HTML != "" {
+		payload.Content = append(payload.Content, sendGridContent{Type: "text/html", Value: msg.HTML})
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode sendgrid request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sendgrid request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("sendgrid returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return resp.Header.Get("X-Message-Id"), nil
+}
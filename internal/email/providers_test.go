@@ -0,0 +1,121 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/internal/config"
+)
+
+var testMessage = Message{
+	From:    "libops <noreply@libops.io>",
+	To:      "ada@example.com",
+	Subject: "Vérifiez votre compte",
+	Text:    "Hello\n",
+	HTML:    "<p>Hello</p>",
+}
+
+// TestNewProvider tests provider selection from configuration.
+func TestNewProvider(t *testing.T) {
+	for provider, want := range map[string]string{"": "log", "log": "log", "smtp": "smtp", "ses": "ses", "sendgrid": "sendgrid"} {
+		p, err := NewProvider(&config.Config{EmailProvider: provider, SESRegion: "us-east-1"})
+		require.NoError(t, err, provider)
+		assert.Equal(t, want, p.Name())
+	}
+
+	_, err := NewProvider(&config.Config{EmailProvider: "mailgun"})
+	assert.Error(t, err)
+
+	ses, _ := NewProvider(&config.Config{EmailProvider: "ses", SESRegion: "eu-west-1"})
+	assert.Equal(t, "email-smtp.eu-west-1.amazonaws.com", ses.(*SMTPProvider).host)
+}
+
+// TestSMTPProviderSend tests envelope addresses and the multipart/alternative encoding.
+func TestSMTPProviderSend(t *testing.T) {
+	p := NewSMTPProvider("smtp.example.com", "", "user", "pass")
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotData []byte
+	p.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotData = addr, from, to, msg
+		assert.NotNil(t, a)
+		return nil
+	}
+
+	messageID, err := p.Send(context.Background(), testMessage)
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "noreply@libops.io", gotFrom)
+	assert.Equal(t, []string{"ada@example.com"}, gotTo)
+	assert.True(t, strings.HasSuffix(messageID, "@libops.io>"))
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(gotData)))
+	require.NoError(t, err)
+	assert.Equal(t, messageID, msg.Header.Get("Message-ID"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, testMessage.Subject, subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var types []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		types = append(types, part.Header.Get("Content-Type"))
+	}
+	assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, types)
+}
+
+// TestSendGridProviderSend tests the v3 API request and error handling.
+func TestSendGridProviderSend(t *testing.T) {
+	var payload sendGridRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer sg-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		if payload.Subject == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"bad"}]}`))
+			return
+		}
+		w.Header().Set("X-Message-Id", "sg-123")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	p := NewSendGridProvider("sg-key")
+	p.url = server.URL
+
+	messageID, err := p.Send(context.Background(), testMessage)
+	require.NoError(t, err)
+	assert.Equal(t, "sg-123", messageID)
+	assert.Equal(t, "noreply@libops.io", payload.From.Email)
+	assert.Equal(t, "libops", payload.From.Name)
+	assert.Equal(t, "ada@example.com", payload.Personalizations[0].To[0].Email)
+	require.Len(t, payload.Content, 2)
+	assert.Equal(t, "text/plain", payload.Content[0].Type)
+
+	failing := testMessage
+	failing.Subject = "fail"
+	_, err = p.Send(context.Background(), failing)
+	assert.ErrorContains(t, err, "400")
+}
@@ -0,0 +1,173 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+
+	"github.com/libops/api/internal/branding"
+)
+
+// DefaultLocale is the locale every message template must exist in.
+const DefaultLocale = "en"
+
+// layoutFile wraps every HTML body; message files live at <locale>/<template>.tmpl
+// and define the "subject", "text" and "body" templates.
+const layoutFile = "layout.tmpl"
+
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	defaultTemplates     *Templates
+	defaultTemplatesOnce sync.Once
+)
+
+// DefaultTemplates returns the built-in templates. They are parsed once and
+// panic if invalid, since they ship with the binary.
+func DefaultTemplates() *Templates {
+	defaultTemplatesOnce.Do(func() {
+		sub, err := fs.Sub(templateFS, "templates")
+		if err != nil {
+			panic(err)
+		}
+		defaultTemplates, err = LoadTemplates(sub)
+		if err != nil {
+			panic(err)
+		}
+	})
+	return defaultTemplates
+}
+
+// Templates holds the parsed message templates for every locale.
+type Templates struct {
+	text map[string]*texttemplate.Template // keyed by locale/template
+	html map[string]*htmltemplate.Template
+}
+
+// Brand is how the HTML part looks. The layout and message bodies read it with
+// the "brand" template function.
+type Brand struct {
+	Name         string // Shown in the header when there's no logo
+	LogoURL      string
+	PrimaryColor string // Header and buttons
+	AccentColor  string // Header rule of branded messages
+	Custom       bool   // Whether an organization's branding replaced the libops one
+}
+
+// DefaultBrand is the libops look.
+func DefaultBrand() Brand {
+	return Brand{Name: "libops", PrimaryColor: branding.DefaultPrimaryColor, AccentColor: branding.DefaultAccentColor}
+}
+
+// Rendered is a message rendered for one recipient.
+type Rendered struct {
+	Locale  string // locale the message was rendered in after fallback
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// LoadTemplates parses the layout and every <locale>/<template>.tmpl file in fsys.
+func LoadTemplates(fsys fs.FS) (*Templates, error) {
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Templates{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+	for _, file := range files {
+		key := strings.TrimSuffix(file, ".tmpl")
+
+		// The text parts don't use the brand, but the file's HTML body does
+		text, err := texttemplate.New(path.Base(file)).Funcs(texttemplate.FuncMap(brandFuncs(DefaultBrand()))).ParseFS(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		html, err := htmltemplate.New(layoutFile).Funcs(brandFuncs(DefaultBrand())).ParseFS(fsys, layoutFile, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		for _, name := range []string{"subject", "text", "body"} {
+			if text.Lookup(name) == nil {
+				return nil, fmt.Errorf("%s does not define %q", file, name)
+			}
+		}
+
+		t.text[key] = text
+		t.html[key] = html
+	}
+	return t, nil
+}
+
+// Render renders a template in the closest available locale with the libops look.
+func (t *Templates) Render(name, locale string, data any) (*Rendered, error) {
+	return t.RenderBranded(name, locale, data, DefaultBrand())
+}
+
+// RenderBranded renders a template in the closest available locale with brand's
+// logo and colors.
+func (t *Templates) RenderBranded(name, locale string, data any, brand Brand) (*Rendered, error) {
+	for _, candidate := range localeChain(locale) {
+		key := candidate + "/" + name
+		text, ok := t.text[key]
+		if !ok {
+			continue
+		}
+
+		var subject, body, html bytes.Buffer
+		if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+			return nil, fmt.Errorf("failed to render %s subject: %w", key, err)
+		}
+		if err := text.ExecuteTemplate(&body, "text", data); err != nil {
+			return nil, fmt.Errorf("failed to render %s text: %w", key, err)
+		}
+		// The parsed templates are never executed, so they can be cloned for each brand
+		branded, err := t.html[key].Clone()
+		if err != nil {
+			return nil, fmt.Errorf("failed to clone %s html: %w", key, err)
+		}
+		if err := branded.Funcs(brandFuncs(brand)).ExecuteTemplate(&html, layoutFile, data); err != nil {
+			return nil, fmt.Errorf("failed to render %s html: %w", key, err)
+		}
+
+		return &Rendered{
+			Locale: candidate,
+			// Subjects become a header, so they must stay on one line
+			Subject: strings.Join(strings.Fields(subject.String()), " "),
+			Text:    strings.TrimSpace(body.String()) + "\n",
+			HTML:    html.String(),
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown email template %q", name)
+}
+
+func brandFuncs(brand Brand) htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{"brand": func() Brand { return brand }}
+}
+
+// localeChain returns the locales to try for a requested locale, most specific
+// first: "pt-BR" yields pt-BR, pt, then DefaultLocale.
+func localeChain(locale string) []string {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	var chain []string
+	if locale != "" {
+		chain = append(chain, locale)
+		if base, _, found := strings.Cut(locale, "-"); found {
+			chain = append(chain, strings.ToLower(base))
+		}
+	}
+	if len(chain) == 0 || chain[len(chain)-1] != DefaultLocale {
+		chain = append(chain, DefaultLocale)
+	}
+	return chain
+}
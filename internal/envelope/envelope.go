@@ -0,0 +1,216 @@
+// Package envelope encrypts secrets before they reach an organization's Vault.
+//
+// Each organization has a data key that seals secret values with AES-256-GCM.
+// The data key itself is only ever stored wrapped by a key encryption key held
+// in a KMS, Vault's transit engine by default, so reading the Vault's storage
+// alone doesn't reveal a secret. Every sealed record carries its wrapped data
+// key, so records sealed before a rotation can still be opened after it.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/libops/api/db"
+)
+
+// Algorithm marks a Vault record as sealed by this package.
+const Algorithm = "aes256-gcm-envelope"
+
+// KeyWrapper wraps and unwraps data keys with a key encryption key kept in a
+// KMS, like Vault's transit engine or Cloud KMS.
+type KeyWrapper interface {
+	// GenerateDataKey returns a new data key in plain text and wrapped.
+	GenerateDataKey(ctx context.Context) ([]byte, string, error)
+	// UnwrapKey decrypts a data key wrapped by any version of the key
+	// encryption key.
+	UnwrapKey(ctx context.Context, wrapped string) ([]byte, error)
+	// RotateKey adds a new version of the key encryption key.
+	RotateKey(ctx context.Context) error
+}
+
+// SecretStore is where sealed records are kept, an organization's Vault.
+type SecretStore interface {
+	WriteSecret(ctx context.Context, path string, data map[string]any) error
+	ReadSecret(ctx context.Context, path string) (map[string]any, error)
+	DeleteSecret(ctx context.Context, path string) error
+}
+
+// Store seals secrets with an organization's active data key as they're
+// written and opens them as they're read. Records written before envelope
+// encryption are read back as they are.
+type Store struct {
+	inner   SecretStore
+	keys    KeyWrapper
+	version int32
+	wrapped string
+	aead    cipher.AEAD
+
+	// unwrapped caches data keys of earlier versions by their wrapped form
+	unwrapped map[string]cipher.AEAD
+}
+
+// Open returns an organization's store, sealing with its active data key.
+// The organization's first data key is created when it has none yet.
+func Open(ctx context.Context, querier db.Querier, inner SecretStore, keys KeyWrapper, organizationID int64) (*Store, error) {
+	active, err := querier.GetActiveOrganizationDataKey(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		store, createErr := create(ctx, querier, inner, keys, organizationID, 1, sql.NullInt64{})
+		if createErr == nil {
+			return store, nil
+		}
+		// Another request may have created it first
+		active, err = querier.GetActiveOrganizationDataKey(ctx, organizationID)
+		if err != nil {
+			return nil, createErr
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data key: %w", err)
+	}
+	key, err := keys.UnwrapKey(ctx, active.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	return newStore(inner, keys, active.KeyVersion, active.WrappedKey, key)
+}
+
+// create generates and records a new data key version, returning a store
+// that seals with it.
+func create(ctx context.Context, querier db.Querier, inner SecretStore, keys KeyWrapper, organizationID int64, version int32, createdBy sql.NullInt64) (*Store, error) {
+	key, wrapped, err := keys.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = querier.CreateOrganizationDataKey(ctx, db.CreateOrganizationDataKeyParams{
+		OrganizationID: organizationID,
+		KeyVersion:     version,
+		WrappedKey:     wrapped,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record data key: %w", err)
+	}
+	return newStore(inner, keys, version, wrapped, key)
+}
+
+func newStore(inner SecretStore, keys KeyWrapper, version int32, wrapped string, key []byte) (*Store, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		inner:     inner,
+		keys:      keys,
+		version:   version,
+		wrapped:   wrapped,
+		aead:      aead,
+		unwrapped: map[string]cipher.AEAD{},
+	}, nil
+}
+
+// KeyVersion is the version of the data key new secrets are sealed with.
+func (s *Store) KeyVersion() int32 {
+	return s.version
+}
+
+// WriteSecret seals data with the active data key and writes it to path.
+func (s *Store) WriteSecret(ctx context.Context, path string, data map[string]any) error {
+	record, err := s.seal(path, data)
+	if err != nil {
+		return err
+	}
+	return s.inner.WriteSecret(ctx, path, record)
+}
+
+// ReadSecret reads the record at path and opens it.
+func (s *Store) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	record, err := s.inner.ReadSecret(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return s.open(ctx, path, record)
+}
+
+// DeleteSecret deletes the record at path.
+func (s *Store) DeleteSecret(ctx context.Context, path string) error {
+	return s.inner.DeleteSecret(ctx, path)
+}
+
+// seal encrypts data, binding it to path so a record can't be moved to
+// another secret's path.
+func (s *Store) seal(path string, data map[string]any) (map[string]any, error) {
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal secret: %w", err)
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext := s.aead.Seal(nonce, nonce, plaintext, []byte(path))
+	return map[string]any{
+		"encryption":  Algorithm,
+		"key_version": s.version,
+		"wrapped_key": s.wrapped,
+		"ciphertext":  base64.StdEncoding.EncodeToString(ciphertext),
+	}, nil
+}
+
+// open decrypts a sealed record, unwrapping the data key it was sealed with
+// if that isn't the active one.
+func (s *Store) open(ctx context.Context, path string, record map[string]any) (map[string]any, error) {
+	if record["encryption"] != Algorithm {
+		return record, nil
+	}
+	wrapped, _ := record["wrapped_key"].(string)
+	encoded, _ := record["ciphertext"].(string)
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || wrapped == "" {
+		return nil, fmt.Errorf("malformed sealed secret at %s", path)
+	}
+
+	aead := s.aead
+	if wrapped != s.wrapped {
+		aead = s.unwrapped[wrapped]
+		if aead == nil {
+			key, err := s.keys.UnwrapKey(ctx, wrapped)
+			if err != nil {
+				return nil, err
+			}
+			if aead, err = newAEAD(key); err != nil {
+				return nil, err
+			}
+			s.unwrapped[wrapped] = aead
+		}
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed sealed secret at %s", path)
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sealed secret at %s: %w", path, err)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(plaintext, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal secret at %s: %w", path, err)
+	}
+	return data, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
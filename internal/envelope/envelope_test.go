@@ -0,0 +1,173 @@
+package envelope
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// fakeKMS wraps data keys by reference, like a transit engine that keeps
+// every version of its key.
+type fakeKMS struct {
+	version int
+	keys    map[string][]byte
+}
+
+func (k *fakeKMS) GenerateDataKey(ctx context.Context) ([]byte, string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, "", err
+	}
+	wrapped := fmt.Sprintf("vault:v%d:%s", k.version, hex.EncodeToString(key[:4]))
+	k.keys[wrapped] = key
+	return key, wrapped, nil
+}
+
+func (k *fakeKMS) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	key, ok := k.keys[wrapped]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %s", wrapped)
+	}
+	return key, nil
+}
+
+func (k *fakeKMS) RotateKey(ctx context.Context) error {
+	k.version++
+	return nil
+}
+
+// fakeVault is an in-memory KV store.
+type fakeVault map[string]map[string]any
+
+func (v fakeVault) WriteSecret(ctx context.Context, path string, data map[string]any) error {
+	v[path] = data
+	return nil
+}
+
+func (v fakeVault) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	data, ok := v[path]
+	if !ok {
+		return nil, fmt.Errorf("no secret found at %s", path)
+	}
+	return data, nil
+}
+
+func (v fakeVault) DeleteSecret(ctx context.Context, path string) error {
+	delete(v, path)
+	return nil
+}
+
+// dataKeys keeps an organization's data keys in memory.
+func dataKeys(mock *testutils.MockQuerier) *[]db.CreateOrganizationDataKeyParams {
+	var keys []db.CreateOrganizationDataKeyParams
+	mock.CreateOrganizationDataKeyFunc = func(ctx context.Context, arg db.CreateOrganizationDataKeyParams) error {
+		keys = append(keys, arg)
+		return nil
+	}
+	mock.GetActiveOrganizationDataKeyFunc = func(ctx context.Context, organizationID int64) (db.GetActiveOrganizationDataKeyRow, error) {
+		if len(keys) == 0 {
+			return db.GetActiveOrganizationDataKeyRow{}, sql.ErrNoRows
+		}
+		latest := keys[len(keys)-1]
+		return db.GetActiveOrganizationDataKeyRow{OrganizationID: organizationID, KeyVersion: latest.KeyVersion, WrappedKey: latest.WrappedKey}, nil
+	}
+	return &keys
+}
+
+func TestStoreSealsSecrets(t *testing.T) {
+	ctx := context.Background()
+	kms := &fakeKMS{version: 1, keys: map[string][]byte{}}
+	vault := fakeVault{"secret-organization/LEGACY": {"value": "plain"}}
+	mock := &testutils.MockQuerier{}
+	keys := dataKeys(mock)
+
+	store, err := Open(ctx, mock, vault, kms, 1)
+	require.NoError(t, err)
+	require.Len(t, *keys, 1, "the first data key is created on demand")
+	assert.Equal(t, int32(1), store.KeyVersion())
+
+	require.NoError(t, store.WriteSecret(ctx, "secret-organization/API_TOKEN", map[string]any{"value": "hunter2"}))
+	record := vault["secret-organization/API_TOKEN"]
+	assert.Equal(t, Algorithm, record["encryption"])
+	assert.NotContains(t, fmt.Sprint(record), "hunter2", "Vault only sees ciphertext")
+
+	data, err := store.ReadSecret(ctx, "secret-organization/API_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", data["value"])
+
+	data, err = store.ReadSecret(ctx, "secret-organization/LEGACY")
+	require.NoError(t, err)
+	assert.Equal(t, "plain", data["value"], "secrets from before envelope encryption read as they are")
+
+	vault["secret-organization/OTHER"] = record
+	_, err = store.ReadSecret(ctx, "secret-organization/OTHER")
+	assert.Error(t, err, "a sealed record is bound to its path")
+
+	reopened, err := Open(ctx, mock, vault, kms, 1)
+	require.NoError(t, err)
+	assert.Len(t, *keys, 1, "later stores use the existing data key")
+	data, err = reopened.ReadSecret(ctx, "secret-organization/API_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", data["value"])
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	kms := &fakeKMS{version: 1, keys: map[string][]byte{}}
+	vault := fakeVault{"secret-site/site/LEGACY": {"value": "plain"}}
+	mock := &testutils.MockQuerier{}
+	keys := dataKeys(mock)
+
+	store, err := Open(ctx, mock, vault, kms, 1)
+	require.NoError(t, err)
+	require.NoError(t, store.WriteSecret(ctx, "secret-organization/API_TOKEN", map[string]any{"value": "hunter2"}))
+	before := vault["secret-organization/API_TOKEN"]["wrapped_key"]
+
+	var versions []db.UpdateOrganizationSecretKeyVersionParams
+	var siteVersions []db.UpdateSiteSecretKeyVersionParams
+	mock.ListOrganizationSealedSecretsFunc = func(ctx context.Context, organizationID int64) ([]db.ListOrganizationSealedSecretsRow, error) {
+		return []db.ListOrganizationSealedSecretsRow{
+			{Kind: "organization", ID: 10, VaultPath: "secret-organization/API_TOKEN", KeyVersion: sql.NullInt32{Int32: 1, Valid: true}},
+			{Kind: "site", ID: 20, VaultPath: "secret-site/site/LEGACY"},
+			{Kind: "site", ID: 21, VaultPath: "secret-site/site/MISSING"},
+		}, nil
+	}
+	mock.UpdateOrganizationSecretKeyVersionFunc = func(ctx context.Context, arg db.UpdateOrganizationSecretKeyVersionParams) error {
+		versions = append(versions, arg)
+		return nil
+	}
+	mock.UpdateSiteSecretKeyVersionFunc = func(ctx context.Context, arg db.UpdateSiteSecretKeyVersionParams) error {
+		siteVersions = append(siteVersions, arg)
+		return nil
+	}
+
+	rotation, err := Rotate(ctx, mock, vault, kms, 1, 42)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), rotation.KeyVersion)
+	assert.Equal(t, 2, rotation.Rewrapped)
+	assert.Equal(t, []string{"secret-site/site/MISSING"}, rotation.Failed)
+	assert.Equal(t, 2, kms.version, "the key encryption key is rotated")
+	require.Len(t, *keys, 2)
+	assert.Equal(t, int64(42), (*keys)[1].CreatedBy.Int64)
+
+	assert.NotEqual(t, before, vault["secret-organization/API_TOKEN"]["wrapped_key"], "secrets are re-wrapped")
+	assert.Equal(t, Algorithm, vault["secret-site/site/LEGACY"]["encryption"], "plain text secrets are sealed")
+	assert.Equal(t, []db.UpdateOrganizationSecretKeyVersionParams{{KeyVersion: sql.NullInt32{Int32: 2, Valid: true}, ID: 10}}, versions)
+	assert.Equal(t, []db.UpdateSiteSecretKeyVersionParams{{KeyVersion: sql.NullInt32{Int32: 2, Valid: true}, ID: 20}}, siteVersions)
+
+	rotated, err := Open(ctx, mock, vault, kms, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), rotated.KeyVersion())
+	data, err := rotated.ReadSecret(ctx, "secret-organization/API_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", data["value"])
+}
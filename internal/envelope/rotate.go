@@ -0,0 +1,80 @@
+package envelope
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/libops/api/db"
+)
+
+// Rotation is the outcome of rotating an organization's keys.
+type Rotation struct {
+	KeyVersion int32
+	Rewrapped  int
+	// Failed lists the Vault paths that couldn't be re-sealed. They can
+	// still be read with their earlier data key, and the next rotation
+	// tries them again.
+	Failed []string
+}
+
+// Rotate rotates the key encryption key, creates a new data key wrapped by
+// it and re-seals every secret the organization keeps in its Vault with the
+// new data key. Records written before envelope encryption are sealed for
+// the first time.
+func Rotate(ctx context.Context, querier db.Querier, inner SecretStore, keys KeyWrapper, organizationID int64, accountID int64) (Rotation, error) {
+	previous, err := Open(ctx, querier, inner, keys, organizationID)
+	if err != nil {
+		return Rotation{}, err
+	}
+	if err := keys.RotateKey(ctx); err != nil {
+		return Rotation{}, err
+	}
+	next, err := create(ctx, querier, inner, keys, organizationID, previous.version+1,
+		sql.NullInt64{Int64: accountID, Valid: accountID != 0})
+	if err != nil {
+		return Rotation{}, err
+	}
+	// Records sealed with the previous key open without unwrapping it again
+	next.unwrapped[previous.wrapped] = previous.aead
+
+	secrets, err := querier.ListOrganizationSealedSecrets(ctx, organizationID)
+	if err != nil {
+		return Rotation{}, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	rotation := Rotation{KeyVersion: next.version}
+	for _, secret := range secrets {
+		if err := rewrap(ctx, querier, next, secret); err != nil {
+			slog.Error("Failed to re-seal secret", "organization_id", organizationID, "path", secret.VaultPath, "err", err)
+			rotation.Failed = append(rotation.Failed, secret.VaultPath)
+			continue
+		}
+		rotation.Rewrapped++
+	}
+	return rotation, nil
+}
+
+// rewrap re-seals one secret with the store's data key and records the
+// version it's now sealed with.
+func rewrap(ctx context.Context, querier db.Querier, store *Store, secret db.ListOrganizationSealedSecretsRow) error {
+	data, err := store.ReadSecret(ctx, secret.VaultPath)
+	if err != nil {
+		return err
+	}
+	if err := store.WriteSecret(ctx, secret.VaultPath, data); err != nil {
+		return err
+	}
+
+	version := sql.NullInt32{Int32: store.version, Valid: true}
+	switch secret.Kind {
+	case "organization":
+		return querier.UpdateOrganizationSecretKeyVersion(ctx, db.UpdateOrganizationSecretKeyVersionParams{KeyVersion: version, ID: secret.ID})
+	case "project":
+		return querier.UpdateProjectSecretKeyVersion(ctx, db.UpdateProjectSecretKeyVersionParams{KeyVersion: version, ID: secret.ID})
+	case "site":
+		return querier.UpdateSiteSecretKeyVersion(ctx, db.UpdateSiteSecretKeyVersionParams{KeyVersion: version, ID: secret.ID})
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/store"
 )
 
 // Emitter writes events to the database queue for processing by the orchestrator.
@@ -41,8 +42,8 @@ func NewEmitter(querier db.Querier, source string) *Emitter {
 //   - Source: the emitter's configured source
 //   - Time: current timestamp
 //   - Type: the provided eventType
-//   - DataContentType: "application/protobuf"
-//   - Data: the marshalled protobuf message
+//   - DataContentType: EnvelopeContentType
+//   - Data: an eventsv1.Envelope wrapping the protobuf message
 func (e *Emitter) SendProtoEvent(ctx context.Context, eventType string, data proto.Message) error {
 	return e.SendProtoEventWithSubject(ctx, eventType, "", data)
 }
@@ -57,8 +58,25 @@ func (e *Emitter) SendProtoEventWithSubject(ctx context.Context, eventType, subj
 
 // SendScopedProtoEvent emits an event with optional organization, project, and site IDs.
 // IDs can be provided as public UUID strings, which will be resolved to internal int64 IDs.
+//
+// Inside a transaction, an event that can't be queued rolls the transaction
+// back, so a change never commits without the events it emits even when the
+// caller only logs the error.
 func (e *Emitter) SendScopedProtoEvent(ctx context.Context, eventType, subject string, orgID, projectID, siteID *string, data proto.Message) error {
-	protoData, err := proto.Marshal(data)
+	err := e.sendScopedProtoEvent(ctx, eventType, subject, orgID, projectID, siteID, data)
+	if err != nil {
+		store.Fail(ctx, err)
+	}
+	return err
+}
+
+func (e *Emitter) sendScopedProtoEvent(ctx context.Context, eventType, subject string, orgID, projectID, siteID *string, data proto.Message) error {
+	envelope, err := NewEnvelope(eventType, data)
+	if err != nil {
+		return err
+	}
+	envelope.Urgent = IsUrgent(ctx)
+	protoData, err := proto.Marshal(envelope)
 	if err != nil {
 		return fmt.Errorf("failed to marshal proto data: %w", err)
 	}
@@ -143,7 +161,7 @@ func (e *Emitter) enqueueEvent(ctx context.Context, eventID, eventType, subject
 		EventSource:    e.source,
 		EventSubject:   subjectSQL,
 		EventData:      data,
-		ContentType:    "application/protobuf",
+		ContentType:    EnvelopeContentType,
 		OrganizationID: toNullInt64(orgID),
 		ProjectID:      toNullInt64(projectID),
 		SiteID:         toNullInt64(siteID),
@@ -4,98 +4,149 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/internal/idempotency"
+	"github.com/libops/api/internal/store"
 )
 
+// HeaderUrgent is the request header that makes a change reach sites at once,
+// even outside their maintenance windows.
+const HeaderUrgent = "Libops-Urgent"
+
+type urgentKey struct{}
+
+// WithUrgent marks the events emitted with ctx as urgent.
+func WithUrgent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, urgentKey{}, true)
+}
+
+// IsUrgent reports whether events emitted with ctx are urgent.
+func IsUrgent(ctx context.Context) bool {
+	urgent, _ := ctx.Value(urgentKey{}).(bool)
+	return urgent
+}
+
 // EventInterceptor creates a Connect interceptor that emits events for CUD operations.
 type EventInterceptor struct {
 	emitter *Emitter
+	// store runs a change and the event it emits in one transaction; without
+	// one the event is written after the change commits
+	store *store.Store
 }
 
 // NewEventInterceptor creates a new event interceptor.
-func NewEventInterceptor(emitter *Emitter) *EventInterceptor {
+func NewEventInterceptor(emitter *Emitter, store *store.Store) *EventInterceptor {
 	return &EventInterceptor{
 		emitter: emitter,
+		store:   store,
 	}
 }
 
 // WrapUnary wraps unary RPCs with event emission.
 func (i *EventInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		resp, err := next(ctx, req)
-
-		// Only emit events for successful operations
-		if err != nil {
-			return resp, err
+		// Services emitting their own events get the flag through the context too
+		if urgent, _ := strconv.ParseBool(req.Header().Get(HeaderUrgent)); urgent {
+			ctx = WithUrgent(ctx)
 		}
 
 		eventType := i.getEventType(req.Spec().Procedure)
-		if eventType == "" {
-			return resp, nil
-		}
-
-		// Skip delete events - services should emit these manually BEFORE deletion
-		// to ensure parent IDs can be looked up from the database
-		if strings.Contains(eventType, ".deleted.") || strings.Contains(eventType, ".removed.") {
+		if i.store == nil {
+			if eventType == "" {
+				return next(ctx, req)
+			}
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+			if err := i.emit(ctx, eventType, req, resp); err != nil {
+				slog.Error("failed to emit event", "error", err, "event_type", eventType)
+			}
 			return resp, nil
 		}
 
-		// Skip SSH key events - these are account-scoped, not org/project/site scoped
-		// TODO: Implement account-level reconciliation for SSH keys
-		if strings.Contains(eventType, ".ssh_key.") {
-			return resp, nil
+		// Reads have nothing to commit
+		if req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects {
+			return next(ctx, req)
 		}
 
-		subject := i.extractSubject(req.Any(), resp.Any())
-		// If we can't extract a subject, we still emit the event, but without a subject
-		// or maybe we log a warning. For now, let's log a warning but proceed.
-		if subject == "" {
-			slog.Warn("failed to extract subject for event",
-				"procedure", req.Spec().Procedure,
-				"event_type", eventType)
+		// The handler's writes, the events it and the interceptors inside this
+		// one emit, and this one's event, if the procedure has one, all commit
+		// together or not at all, so a crash can't leave sites reconciled
+		// against a change that was lost or miss one that was made
+		var resp connect.AnyResponse
+		var handlerErr, emitErr error
+		err := i.store.InTx(ctx, func(ctx context.Context) error {
+			resp, handlerErr = next(ctx, req)
+			if handlerErr != nil || eventType == "" {
+				return handlerErr
+			}
+			emitErr = i.emit(ctx, eventType, req, resp)
+			return emitErr
+		})
+		switch {
+		case handlerErr != nil:
+			return resp, handlerErr
+		case emitErr != nil:
+			slog.Error("failed to emit event, rolled back the change", "error", emitErr, "event_type", eventType)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record the change"))
+		case err != nil:
+			slog.Error("failed to commit change", "error", err, "procedure", req.Spec().Procedure)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save the change"))
 		}
+		return resp, nil
+	}
+}
 
-		// Emit the event asynchronously to not block the response
-		// Note: The Emitter writes to the DB, so it is synchronous with the request transaction if we shared the transaction,
-		// but here we are likely using the pool. It should be fast enough.
-		// If strict transactional integrity is needed (event only if commit), we'd need to inject the tx from context.
-		// For now, we assume the operation is already committed (since we are in the return path of interceptor).
+// emit writes the event for a successful operation, if it has one.
+func (i *EventInterceptor) emit(ctx context.Context, eventType string, req connect.AnyRequest, resp connect.AnyResponse) error {
+	// Replayed idempotent responses already emitted their event
+	if idempotency.IsReplay(resp) {
+		return nil
+	}
 
-		_, ok := resp.Any().(proto.Message)
-		if !ok {
-			slog.Error("response is not a proto message", "procedure", req.Spec().Procedure)
-			return resp, nil
-		}
+	if !emitsFor(eventType) {
+		return nil
+	}
 
-		// We emit the RESPONSE as the data for the event, as it usually contains the full resource.
-		// For DELETE operations, the response is usually empty, so we emit the REQUEST instead.
-		var payload proto.Message
-		if strings.Contains(eventType, ".deleted.") || strings.Contains(eventType, ".removed.") {
-			if reqMsg, ok := req.Any().(proto.Message); ok {
-				payload = reqMsg
-			}
-		} else {
-			if respMsg, ok := resp.Any().(proto.Message); ok {
-				payload = respMsg
-			}
-		}
+	subject := i.extractSubject(req.Any(), resp.Any())
+	// If we can't extract a subject, we still emit the event, but without a subject
+	// or maybe we log a warning. For now, let's log a warning but proceed.
+	if subject == "" {
+		slog.Warn("failed to extract subject for event",
+			"procedure", req.Spec().Procedure,
+			"event_type", eventType)
+	}
 
-		if payload == nil {
-			slog.Error("failed to determine payload for event", "procedure", req.Spec().Procedure)
-			return resp, nil
-		}
+	// We emit the RESPONSE as the data for the event, as it usually contains the full resource.
+	payload, ok := resp.Any().(proto.Message)
+	if !ok || payload == nil {
+		slog.Error("response is not a proto message", "procedure", req.Spec().Procedure)
+		return nil
+	}
 
-		orgID, projectID, siteID := i.extractContextIDs(req.Any(), resp.Any())
+	orgID, projectID, siteID := i.extractContextIDs(req.Any(), resp.Any())
 
-		if err := i.emitter.SendScopedProtoEvent(ctx, eventType, subject, orgID, projectID, siteID, payload); err != nil {
-			slog.Error("failed to emit event", "error", err, "event_type", eventType)
-		}
+	return i.emitter.SendScopedProtoEvent(ctx, eventType, subject, orgID, projectID, siteID, payload)
+}
 
-		return resp, nil
+// emitsFor reports whether the interceptor emits eventType itself, rather than
+// leaving it to the service.
+func emitsFor(eventType string) bool {
+	// Skip delete events - services should emit these manually BEFORE deletion
+	// to ensure parent IDs can be looked up from the database
+	if strings.Contains(eventType, ".deleted.") || strings.Contains(eventType, ".removed.") {
+		return false
 	}
+
+	// Skip SSH key events - these are account-scoped, not org/project/site scoped
+	// TODO: Implement account-level reconciliation for SSH keys
+	return !strings.Contains(eventType, ".ssh_key.")
 }
 
 // WrapStreamingClient wraps client streaming RPCs.
@@ -262,6 +313,8 @@ func (i *EventInterceptor) getEventType(procedure string) string {
 		return EventTypeProjectCreated
 	case strings.HasSuffix(procedure, "AdminProjectService/UpdateProject") || strings.HasSuffix(procedure, "ProjectService/UpdateProject"):
 		return EventTypeProjectUpdated
+	case strings.HasSuffix(procedure, "ProjectService/ChangePlan"):
+		return EventTypeProjectUpdated
 	case strings.HasSuffix(procedure, "AdminProjectService/DeleteProject") || strings.HasSuffix(procedure, "ProjectService/DeleteProject"):
 		return EventTypeProjectDeleted
 
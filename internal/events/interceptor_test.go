@@ -0,0 +1,188 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/store"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// newTestClient serves handler as SiteService/UpdateSite behind an
+// EventInterceptor whose change and events share one mocked primary.
+func newTestClient(t *testing.T, handler func(context.Context, *database.Router, *Emitter) error) (*connect.Client[libopsv1.UpdateSiteRequest, libopsv1.UpdateSiteResponse], sqlmock.Sqlmock) {
+	return serve[libopsv1.UpdateSiteRequest](t, "libops.v1.SiteService.UpdateSite",
+		func(ctx context.Context, router *database.Router, emitter *Emitter) (*libopsv1.UpdateSiteResponse, error) {
+			if err := handler(ctx, router, emitter); err != nil {
+				return nil, err
+			}
+			return &libopsv1.UpdateSiteResponse{Site: &commonv1.SiteConfig{SiteName: "staging"}}, nil
+		})
+}
+
+// serve serves handler as method behind an EventInterceptor whose change and
+// events share one mocked primary. The handler writes through the router and
+// emits through the emitter it's given so its writes land in the
+// interceptor's transaction.
+func serve[Req, Res any](t *testing.T, method protoreflect.FullName, handler func(context.Context, *database.Router, *Emitter) (*Res, error)) (*connect.Client[Req, Res], sqlmock.Sqlmock) {
+	t.Helper()
+	primary, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = primary.Close() })
+
+	router := database.NewRouter(primary, nil)
+	emitter := NewEmitter(db.New(router), "test")
+	interceptor := NewEventInterceptor(emitter, store.New(primary))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(method)
+	require.NoError(t, err)
+	procedure := fmt.Sprintf("/%s/%s", desc.Parent().FullName(), desc.Name())
+	// As the generated handlers do
+	idempotency := connect.IdempotencyLevel(desc.Options().(*descriptorpb.MethodOptions).GetIdempotencyLevel())
+
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(ctx context.Context, req *connect.Request[Req]) (*connect.Response[Res], error) {
+			resp, err := handler(ctx, router, emitter)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(resp), nil
+		},
+		connect.WithSchema(desc),
+		connect.WithIdempotency(idempotency),
+		connect.WithInterceptors(interceptor),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return connect.NewClient[Req, Res](server.Client(), server.URL+procedure), mock
+}
+
+func updateSite(ctx context.Context, router *database.Router, _ *Emitter) error {
+	_, err := router.ExecContext(ctx, "UPDATE sites SET name = ?", "staging")
+	return err
+}
+
+// TestEventInterceptor_CommitsChangeWithEvent tests that the handler's write and
+// its event commit in one transaction.
+func TestEventInterceptor_CommitsChangeWithEvent(t *testing.T) {
+	client, mock := newTestClient(t, updateSite)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sites").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO event_outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&libopsv1.UpdateSiteRequest{}))
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestEventInterceptor_HandlerErrorRollsBack tests that a failed handler's
+// writes are rolled back and no event is written.
+func TestEventInterceptor_HandlerErrorRollsBack(t *testing.T) {
+	client, mock := newTestClient(t, func(ctx context.Context, router *database.Router, emitter *Emitter) error {
+		if err := updateSite(ctx, router, emitter); err != nil {
+			return err
+		}
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("site is locked"))
+	})
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sites").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&libopsv1.UpdateSiteRequest{}))
+
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestEventInterceptor_EventErrorRollsBack tests that a change whose event
+// can't be written is rolled back rather than saved without it.
+func TestEventInterceptor_EventErrorRollsBack(t *testing.T) {
+	client, mock := newTestClient(t, updateSite)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sites").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO event_outbox").WillReturnError(errors.New("disk full"))
+	mock.ExpectRollback()
+
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&libopsv1.UpdateSiteRequest{}))
+
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestEventInterceptor_UnmappedProcedureCommitsWithItsEvents tests that a
+// procedure with no event of its own still commits its writes together with
+// the events its handler emits, and rolls them back if one can't be written.
+func TestEventInterceptor_UnmappedProcedureCommitsWithItsEvents(t *testing.T) {
+	createRedirect := func(ctx context.Context, router *database.Router, emitter *Emitter) (*libopsv1.CreateRedirectResponse, error) {
+		if _, err := router.ExecContext(ctx, "INSERT INTO site_redirects (source) VALUES (?)", "/old"); err != nil {
+			return nil, err
+		}
+		// Like the site services, carry on when the event fails
+		_ = emitter.SendProtoEvent(ctx, EventTypeSiteRedirectCreated, &libopsv1.CreateRedirectResponse{})
+		return &libopsv1.CreateRedirectResponse{}, nil
+	}
+
+	for _, tt := range []struct {
+		name     string
+		eventErr error
+		wantCode connect.Code
+	}{
+		{name: "commits"},
+		{name: "event fails", eventErr: errors.New("disk full"), wantCode: connect.CodeInternal},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := serve[libopsv1.CreateRedirectRequest](t, "libops.v1.RedirectService.CreateRedirect", createRedirect)
+			mock.ExpectBegin()
+			mock.ExpectExec("INSERT INTO site_redirects").WillReturnResult(sqlmock.NewResult(1, 1))
+			if tt.eventErr != nil {
+				mock.ExpectExec("INSERT INTO event_outbox").WillReturnError(tt.eventErr)
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec("INSERT INTO event_outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			}
+
+			_, err := client.CallUnary(context.Background(), connect.NewRequest(&libopsv1.CreateRedirectRequest{}))
+
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestEventInterceptor_ReadsSkipTransaction tests that procedures without side
+// effects don't open a transaction.
+func TestEventInterceptor_ReadsSkipTransaction(t *testing.T) {
+	client, mock := serve[libopsv1.ListRedirectsRequest](t, "libops.v1.RedirectService.ListRedirects",
+		func(ctx context.Context, router *database.Router, _ *Emitter) (*libopsv1.ListRedirectsResponse, error) {
+			assert.False(t, database.InTx(ctx))
+			return &libopsv1.ListRedirectsResponse{}, nil
+		})
+
+	_, err := client.CallUnary(context.Background(), connect.NewRequest(&libopsv1.ListRedirectsRequest{}))
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// EnvelopeContentType is the content type of queued events: an eventsv1.Envelope
+const EnvelopeContentType = "application/protobuf; proto=libops.v1.events.Envelope"
+
+// registry maps CloudEvents types to the eventsv1.EventType values declaring them
+var registry = registeredEventTypes()
+
+func registeredEventTypes() map[string]eventsv1.EventType {
+	types := make(map[string]eventsv1.EventType)
+	values := eventsv1.EventType(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		name := proto.GetExtension(value.Options(), optionsv1.E_CloudeventType).(string)
+		if name == "" {
+			continue
+		}
+		types[name] = eventsv1.EventType(value.Number())
+	}
+	return types
+}
+
+// LookupEventType returns the registered eventsv1.EventType for a CloudEvents type
+func LookupEventType(eventType string) (eventsv1.EventType, bool) {
+	t, ok := registry[eventType]
+	return t, ok
+}
+
+// payloadTypes returns the full names of the messages an event type's envelope data may hold
+func payloadTypes(t eventsv1.EventType) []string {
+	value := t.Descriptor().Values().ByNumber(t.Number())
+	if value == nil {
+		return nil
+	}
+	return proto.GetExtension(value.Options(), optionsv1.E_PayloadType).([]string)
+}
+
+// NewEnvelope wraps an event's payload in the current schema version's envelope.
+// It fails for event types missing from the eventsv1.EventType registry, and for
+// payloads not registered for the event type, which the event router would reject.
+func NewEnvelope(eventType string, data proto.Message) (*eventsv1.Envelope, error) {
+	t, ok := LookupEventType(eventType)
+	if !ok {
+		return nil, fmt.Errorf("event type %q is not registered in libops.v1.events.EventType", eventType)
+	}
+
+	envelope := &eventsv1.Envelope{
+		SchemaVersion: eventsv1.SchemaVersion_SCHEMA_VERSION_1,
+		Type:          t,
+	}
+	if data != nil {
+		name := string(data.ProtoReflect().Descriptor().FullName())
+		if !slices.Contains(payloadTypes(t), name) {
+			return nil, fmt.Errorf("%s is not a registered payload type of event type %q", name, eventType)
+		}
+		payload, err := anypb.New(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wrap event data: %w", err)
+		}
+		envelope.Data = payload
+	}
+	return envelope, nil
+}
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	_ "github.com/libops/api/proto/libops/v1/admin"
+	_ "github.com/libops/api/proto/libops/v1/common"
+	eventsv1 "github.com/libops/api/proto/libops/v1/events"
+)
+
+// TestEventTypesRegistered tests that every EventType constant in types.go is declared in
+// libops.v1.events.EventType, so no producer emits an event the event router rejects.
+func TestEventTypesRegistered(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "types.go", nil, 0)
+	require.NoError(t, err)
+
+	constants := 0
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				if !strings.HasPrefix(name.Name, "EventType") {
+					continue
+				}
+				eventType, err := strconv.Unquote(value.Values[i].(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				_, ok := LookupEventType(eventType)
+				assert.True(t, ok, "%s (%s) is not registered", name.Name, eventType)
+				constants++
+			}
+		}
+	}
+	assert.Len(t, registry, constants, "every registered event type has a constant")
+}
+
+// TestPayloadTypesResolve tests that every registered payload type names a known message.
+func TestPayloadTypesResolve(t *testing.T) {
+	values := eventsv1.EventType(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		eventType := eventsv1.EventType(values.Get(i).Number())
+		for _, name := range payloadTypes(eventType) {
+			_, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+			assert.NoError(t, err, "%s payload type %s", eventType, name)
+		}
+	}
+}
+
+// TestInterceptorPayloadsRegistered tests that the response of every RPC the event
+// interceptor emits an event for is a registered payload type of that event.
+func TestInterceptorPayloadsRegistered(t *testing.T) {
+	interceptor := &EventInterceptor{}
+	methods := 0
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		for i := 0; i < file.Services().Len(); i++ {
+			service := file.Services().Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				eventType := interceptor.getEventType("/" + string(service.FullName()) + "/" + string(method.Name()))
+				if eventType == "" || !emitsFor(eventType) {
+					continue
+				}
+				registered, ok := LookupEventType(eventType)
+				require.True(t, ok, "%s is not registered", eventType)
+				assert.Contains(t, payloadTypes(registered), string(method.Output().FullName()),
+					"%s emits %s", method.FullName(), eventType)
+				methods++
+			}
+		}
+		return true
+	})
+	assert.NotZero(t, methods)
+}
+
+// TestNewEnvelope tests that payloads round trip through the envelope, and unregistered
+// event types and payloads not registered for the event type are refused.
+func TestNewEnvelope(t *testing.T) {
+	envelope, err := NewEnvelope(EventTypeSiteElevationRequested, &libopsv1.Elevation{ElevationId: "d8e8fca2"})
+	require.NoError(t, err)
+	assert.Equal(t, eventsv1.SchemaVersion_SCHEMA_VERSION_1, envelope.SchemaVersion)
+	assert.Equal(t, eventsv1.EventType_EVENT_TYPE_SITE_ELEVATION_REQUESTED, envelope.Type)
+
+	payload, err := envelope.Data.UnmarshalNew()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&libopsv1.Elevation{ElevationId: "d8e8fca2"}, payload))
+
+	envelope, err = NewEnvelope(EventTypeSiteDeleted, nil)
+	require.NoError(t, err)
+	assert.Nil(t, envelope.Data, "events may carry only a subject")
+
+	_, err = NewEnvelope(EventTypeSiteElevationRequested, &libopsv1.DeploySiteRequest{SiteId: "d8e8fca2"})
+	assert.Error(t, err, "payload not registered for the event type")
+
+	_, err = NewEnvelope("io.libops.site.renamed.v1", nil)
+	assert.Error(t, err)
+}
@@ -40,6 +40,9 @@ const (
 	EventTypeOrganizationSecretCreated       = "io.libops.organization.secret.created.v1"
 	EventTypeOrganizationSecretUpdated       = "io.libops.organization.secret.updated.v1"
 	EventTypeOrganizationSecretDeleted       = "io.libops.organization.secret.deleted.v1"
+	EventTypeOrganizationSettingCreated      = "io.libops.organization.setting.created.v1"
+	EventTypeOrganizationSettingUpdated      = "io.libops.organization.setting.updated.v1"
+	EventTypeOrganizationSettingDeleted      = "io.libops.organization.setting.deleted.v1"
 
 	// Project Child Events
 	EventTypeProjectMemberAdded         = "io.libops.project.member.added.v1"
@@ -50,6 +53,9 @@ const (
 	EventTypeProjectSecretCreated       = "io.libops.project.secret.created.v1"
 	EventTypeProjectSecretUpdated       = "io.libops.project.secret.updated.v1"
 	EventTypeProjectSecretDeleted       = "io.libops.project.secret.deleted.v1"
+	EventTypeProjectSettingCreated      = "io.libops.project.setting.created.v1"
+	EventTypeProjectSettingUpdated      = "io.libops.project.setting.updated.v1"
+	EventTypeProjectSettingDeleted      = "io.libops.project.setting.deleted.v1"
 
 	// Site Child Events
 	EventTypeSiteMemberAdded         = "io.libops.site.member.added.v1"
@@ -60,9 +66,65 @@ const (
 	EventTypeSiteSecretCreated       = "io.libops.site.secret.created.v1"
 	EventTypeSiteSecretUpdated       = "io.libops.site.secret.updated.v1"
 	EventTypeSiteSecretDeleted       = "io.libops.site.secret.deleted.v1"
+	EventTypeSiteRedirectCreated     = "io.libops.site.redirect.created.v1"
+	EventTypeSiteRedirectUpdated     = "io.libops.site.redirect.updated.v1"
+	EventTypeSiteRedirectDeleted     = "io.libops.site.redirect.deleted.v1"
+	EventTypeSiteAccessUpdated       = "io.libops.site.access_protection.updated.v1"
+	EventTypeSiteWafUpdated          = "io.libops.site.waf.updated.v1"
+	EventTypeSiteRateLimitAdded      = "io.libops.site.rate_limit_rule.added.v1"
+	EventTypeSiteRateLimitRemoved    = "io.libops.site.rate_limit_rule.removed.v1"
+	EventTypeSiteTlsUpdated          = "io.libops.site.tls_policy.updated.v1"
+	EventTypeSiteAddonAttached       = "io.libops.site.addon.attached.v1"
+	EventTypeSiteAddonDetached       = "io.libops.site.addon.detached.v1"
+	EventTypeSiteSshAccessGranted    = "io.libops.site.ssh_access.granted.v1"
+	EventTypeSiteSshAccessUpdated    = "io.libops.site.ssh_access.updated.v1"
+	EventTypeSiteSshAccessRevoked    = "io.libops.site.ssh_access.revoked.v1"
+	EventTypeSiteElevationRequested  = "io.libops.site.elevation.requested.v1"
+	EventTypeSiteElevationApproved   = "io.libops.site.elevation.approved.v1"
+	EventTypeSiteElevationRejected   = "io.libops.site.elevation.rejected.v1"
+	EventTypeSiteElevationRevoked    = "io.libops.site.elevation.revoked.v1"
+	EventTypeSiteElevationExpired    = "io.libops.site.elevation.expired.v1"
+	EventTypeSiteConfigVarSet        = "io.libops.site.config_var.set.v1"
+	EventTypeSiteConfigVarDeleted    = "io.libops.site.config_var.deleted.v1"
+	EventTypeSiteSettingCreated      = "io.libops.site.setting.created.v1"
+	EventTypeSiteSettingUpdated      = "io.libops.site.setting.updated.v1"
+	EventTypeSiteSettingDeleted      = "io.libops.site.setting.deleted.v1"
+
+	// Billing events. These notify account owners and never trigger reconciliation.
+	EventTypeBillingPaymentFailed = "io.libops.billing.payment_failed.v1"
+	EventTypeBillingStateChanged  = "io.libops.billing.state_changed.v1"
+
+	// Deployment and reconciliation outcome events. These alert notification channels
+	// and never trigger reconciliation.
+	EventTypeDeploymentSucceeded  = "io.libops.deployment.succeeded.v1"
+	EventTypeDeploymentFailed     = "io.libops.deployment.failed.v1"
+	EventTypeReconciliationFailed = "io.libops.reconciliation.failed.v1"
+
+	// Notification channel events.
+	EventTypeNotificationChannelTest = "io.libops.notification_channel.test.v1"
+
+	// Event sink events. These only reach the sink being tested.
+	EventTypeEventSinkTest = "io.libops.event_sink.test.v1"
+
+	// Site downtime incident events. These alert notification channels
+	// and never trigger reconciliation.
+	EventTypeIncidentOpened   = "io.libops.incident.opened.v1"
+	EventTypeIncidentResolved = "io.libops.incident.resolved.v1"
 
 	// Relationship events.
 	EventTypeRelationshipCreated  = "io.libops.relationship.created.v1"
 	EventTypeRelationshipApproved = "io.libops.relationship.approved.v1"
 	EventTypeRelationshipRejected = "io.libops.relationship.rejected.v1"
+	EventTypeRelationshipUpdated  = "io.libops.relationship.updated.v1"
+	EventTypeRelationshipRevoked  = "io.libops.relationship.revoked.v1"
+
+	// Organization policy events. Violations alert the organization's
+	// security channels; none of them trigger reconciliation.
+	EventTypePolicyCreated  = "io.libops.organization.policy.created.v1"
+	EventTypePolicyUpdated  = "io.libops.organization.policy.updated.v1"
+	EventTypePolicyDeleted  = "io.libops.organization.policy.deleted.v1"
+	EventTypePolicyViolated = "io.libops.organization.policy.violated.v1"
+
+	// Secret access events. Anomalies alert the organization's owners.
+	EventTypeSecretAccessAnomaly = "io.libops.organization.secret.access_anomaly.v1"
 )
@@ -0,0 +1,241 @@
+// Package expiry ends time-bounded access: it removes organization, project and
+// site memberships, revokes organization relationships and lapses elevated
+// site roles once they pass their expires_at, and emits the events that
+// reconcile SSH access on the affected site VMs, so a contractor's access
+// doesn't outlast their engagement.
+package expiry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// sweepBatch caps how many grants of each kind are ended per sweep.
+const sweepBatch = 100
+
+// Expirer ends memberships, relationships and elevations past their expiry.
+type Expirer struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// NewExpirer creates an expirer.
+func NewExpirer(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *Expirer {
+	return &Expirer{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// Sweep ends every membership, relationship and elevation that expired by now and
+// returns how many it ended. A grant extended after it was listed is kept.
+func (e *Expirer) Sweep(ctx context.Context, now time.Time) (int, error) {
+	expired := 0
+	for _, sweep := range []func(context.Context, sql.NullTime) (int, error){
+		e.sweepOrganizationMembers,
+		e.sweepProjectMembers,
+		e.sweepSiteMembers,
+		e.sweepRelationships,
+		e.sweepSiteElevations,
+	} {
+		n, err := sweep(ctx, sql.NullTime{Time: now, Valid: true})
+		expired += n
+		if err != nil {
+			return expired, err
+		}
+	}
+	return expired, nil
+}
+
+func (e *Expirer) sweepOrganizationMembers(ctx context.Context, now sql.NullTime) (int, error) {
+	members, err := e.db.ListExpiredOrganizationMembers(ctx, db.ListExpiredOrganizationMembersParams{Now: now, Limit: sweepBatch})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired organization members: %w", err)
+	}
+
+	expired := 0
+	for _, member := range members {
+		deleted, err := e.db.DeleteExpiredOrganizationMember(ctx, db.DeleteExpiredOrganizationMemberParams{ID: member.ID, Now: now})
+		if err != nil {
+			slog.Error("Failed to remove expired organization member", "error", err, "organization_id", member.OrganizationPublicID, "account_id", member.AccountPublicID)
+			continue
+		}
+		if deleted == 0 {
+			continue
+		}
+		expired++
+
+		e.audit(ctx, member.AccountID, member.OrganizationID, audit.OrganizationEntityType, string(member.Role), member.ExpiresAt)
+		e.emit(ctx, events.EventTypeOrganizationMemberRemoved, member.AccountPublicID, &member.OrganizationPublicID, nil, nil, &libopsv1.DeleteOrganizationMemberRequest{
+			OrganizationId: member.OrganizationPublicID,
+			AccountId:      member.AccountPublicID,
+		})
+	}
+	return expired, nil
+}
+
+func (e *Expirer) sweepProjectMembers(ctx context.Context, now sql.NullTime) (int, error) {
+	members, err := e.db.ListExpiredProjectMembers(ctx, db.ListExpiredProjectMembersParams{Now: now, Limit: sweepBatch})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired project members: %w", err)
+	}
+
+	expired := 0
+	for _, member := range members {
+		deleted, err := e.db.DeleteExpiredProjectMember(ctx, db.DeleteExpiredProjectMemberParams{ID: member.ID, Now: now})
+		if err != nil {
+			slog.Error("Failed to remove expired project member", "error", err, "project_id", member.ProjectPublicID, "account_id", member.AccountPublicID)
+			continue
+		}
+		if deleted == 0 {
+			continue
+		}
+		expired++
+
+		e.audit(ctx, member.AccountID, member.ProjectID, audit.ProjectEntityType, string(member.Role), member.ExpiresAt)
+		e.emit(ctx, events.EventTypeProjectMemberRemoved, member.AccountPublicID, &member.OrganizationPublicID, &member.ProjectPublicID, nil, &libopsv1.DeleteProjectMemberRequest{
+			ProjectId: member.ProjectPublicID,
+			AccountId: member.AccountPublicID,
+		})
+	}
+	return expired, nil
+}
+
+func (e *Expirer) sweepSiteMembers(ctx context.Context, now sql.NullTime) (int, error) {
+	members, err := e.db.ListExpiredSiteMembers(ctx, db.ListExpiredSiteMembersParams{Now: now, Limit: sweepBatch})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired site members: %w", err)
+	}
+
+	expired := 0
+	for _, member := range members {
+		deleted, err := e.db.DeleteExpiredSiteMember(ctx, db.DeleteExpiredSiteMemberParams{ID: member.ID, Now: now})
+		if err != nil {
+			slog.Error("Failed to remove expired site member", "error", err, "site_id", member.SitePublicID, "account_id", member.AccountPublicID)
+			continue
+		}
+		if deleted == 0 {
+			continue
+		}
+		expired++
+
+		e.audit(ctx, member.AccountID, member.SiteID, audit.SiteEntityType, string(member.Role), member.ExpiresAt)
+		e.emit(ctx, events.EventTypeSiteMemberRemoved, member.AccountPublicID, &member.OrganizationPublicID, &member.ProjectPublicID, &member.SitePublicID, &libopsv1.DeleteSiteMemberRequest{
+			SiteId:    member.SitePublicID,
+			AccountId: member.AccountPublicID,
+		})
+	}
+	return expired, nil
+}
+
+func (e *Expirer) sweepRelationships(ctx context.Context, now sql.NullTime) (int, error) {
+	relationships, err := e.db.ListExpiredRelationships(ctx, db.ListExpiredRelationshipsParams{Now: now, Limit: sweepBatch})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired relationships: %w", err)
+	}
+
+	expired := 0
+	for _, rel := range relationships {
+		revoked, err := e.db.ExpireRelationship(ctx, db.ExpireRelationshipParams{ID: rel.ID, Now: now})
+		if err != nil {
+			slog.Error("Failed to revoke expired relationship", "error", err, "relationship_id", rel.PublicID)
+			continue
+		}
+		if revoked == 0 {
+			continue
+		}
+		expired++
+
+		details := map[string]any{
+			"relationship_id":        rel.PublicID,
+			"source_organization_id": rel.SourceOrganizationPublicID,
+			"target_organization_id": rel.TargetOrganizationPublicID,
+			"expires_at":             rel.ExpiresAt.Time.Unix(),
+		}
+		if e.auditLogger != nil {
+			e.auditLogger.Log(ctx, 0, rel.SourceOrganizationID, audit.OrganizationEntityType, audit.RelationshipExpire, details)
+			e.auditLogger.Log(ctx, 0, rel.TargetOrganizationID, audit.OrganizationEntityType, audit.RelationshipExpire, details)
+		}
+		e.emit(ctx, events.EventTypeRelationshipRevoked, rel.PublicID, &rel.TargetOrganizationPublicID, nil, nil, &libopsv1.Relationship{
+			RelationshipId:       rel.PublicID,
+			SourceOrganizationId: rel.SourceOrganizationPublicID,
+			TargetOrganizationId: rel.TargetOrganizationPublicID,
+			MaxRole:              string(rel.MaxRole),
+			Status:               libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_REVOKED,
+			ExpiresAt:            rel.ExpiresAt.Time.Unix(),
+			ResolvedAt:           now.Time.Unix(),
+		})
+	}
+	return expired, nil
+}
+
+func (e *Expirer) sweepSiteElevations(ctx context.Context, now sql.NullTime) (int, error) {
+	elevations, err := e.db.ListExpiredSiteElevations(ctx, db.ListExpiredSiteElevationsParams{Now: now, Limit: sweepBatch})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired site elevations: %w", err)
+	}
+
+	expired := 0
+	for _, elevation := range elevations {
+		lapsed, err := e.db.ExpireSiteElevation(ctx, db.ExpireSiteElevationParams{ID: elevation.ID, Now: now})
+		if err != nil {
+			slog.Error("Failed to expire site elevation", "error", err, "elevation_id", elevation.PublicID)
+			continue
+		}
+		if lapsed == 0 {
+			continue
+		}
+		expired++
+
+		if e.auditLogger != nil {
+			e.auditLogger.Log(ctx, elevation.AccountID, elevation.SiteID, audit.SiteEntityType, audit.SiteElevationExpire, map[string]any{
+				"elevation_id": elevation.PublicID,
+				"account_id":   elevation.AccountPublicID,
+				"role":         string(elevation.Role),
+				"expires_at":   elevation.ExpiresAt.Time.Unix(),
+			})
+		}
+		e.emit(ctx, events.EventTypeSiteElevationExpired, elevation.PublicID, &elevation.OrganizationPublicID, &elevation.ProjectPublicID, &elevation.SitePublicID, &libopsv1.Elevation{
+			ElevationId: elevation.PublicID,
+			SiteId:      elevation.SitePublicID,
+			AccountId:   elevation.AccountPublicID,
+			Role:        string(elevation.Role),
+			Status:      libopsv1.ElevationStatus_ELEVATION_STATUS_EXPIRED,
+			ExpiresAt:   elevation.ExpiresAt.Time.Unix(),
+		})
+	}
+	return expired, nil
+}
+
+// audit records an expired membership against its organization, project or
+// site, attributed to the account whose access ended.
+func (e *Expirer) audit(ctx context.Context, accountID, entityID int64, entityType audit.EntityType, role string, expiresAt sql.NullTime) {
+	if e.auditLogger == nil {
+		return
+	}
+	e.auditLogger.Log(ctx, accountID, entityID, entityType, audit.MemberExpire, map[string]any{
+		"role":       role,
+		"expires_at": expiresAt.Time.Unix(),
+	})
+}
+
+// emit sends the event that reconciles the SSH access of the sites in scope.
+func (e *Expirer) emit(ctx context.Context, eventType, subject string, orgID, projectID, siteID *string, data proto.Message) {
+	if e.emitter == nil {
+		return
+	}
+	if err := e.emitter.SendScopedProtoEvent(ctx, eventType, subject, orgID, projectID, siteID, data); err != nil {
+		slog.Error("Failed to emit access expiry event", "error", err, "event_type", eventType, "subject", subject)
+	}
+}
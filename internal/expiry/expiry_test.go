@@ -0,0 +1,120 @@
+package expiry
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestSweep tests that expired memberships, relationships and elevations are ended once,
+// and that a grant extended after it was listed is kept.
+func TestSweep(t *testing.T) {
+	now := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+	expiredAt := sql.NullTime{Time: now.Add(-time.Minute), Valid: true}
+
+	var orgDeletes, siteDeletes []int64
+	var expiredRels, expiredElevations []int64
+	var audits []db.CreateAuditEventParams
+	var queued []string
+	mock := &testutils.MockQuerier{
+		ListExpiredOrganizationMembersFunc: func(ctx context.Context, arg db.ListExpiredOrganizationMembersParams) ([]db.ListExpiredOrganizationMembersRow, error) {
+			assert.Equal(t, now, arg.Now.Time)
+			return []db.ListExpiredOrganizationMembersRow{
+				{ID: 1, OrganizationID: 7, AccountID: 30, Role: db.OrganizationMembersRoleDeveloper, ExpiresAt: expiredAt, OrganizationPublicID: "org-7", AccountPublicID: "acct-30"},
+				// Extended by an owner after the sweep listed it
+				{ID: 2, OrganizationID: 7, AccountID: 31, Role: db.OrganizationMembersRoleRead, ExpiresAt: expiredAt, OrganizationPublicID: "org-7", AccountPublicID: "acct-31"},
+			}, nil
+		},
+		DeleteExpiredOrganizationMemberFunc: func(ctx context.Context, arg db.DeleteExpiredOrganizationMemberParams) (int64, error) {
+			if arg.ID == 2 {
+				return 0, nil
+			}
+			orgDeletes = append(orgDeletes, arg.ID)
+			return 1, nil
+		},
+		ListExpiredSiteMembersFunc: func(ctx context.Context, arg db.ListExpiredSiteMembersParams) ([]db.ListExpiredSiteMembersRow, error) {
+			return []db.ListExpiredSiteMembersRow{
+				{ID: 5, SiteID: 9, AccountID: 32, Role: db.SiteMembersRoleDeveloper, ExpiresAt: expiredAt, OrganizationPublicID: "org-7", ProjectPublicID: "proj-8", SitePublicID: "site-9", AccountPublicID: "acct-32"},
+			}, nil
+		},
+		DeleteExpiredSiteMemberFunc: func(ctx context.Context, arg db.DeleteExpiredSiteMemberParams) (int64, error) {
+			siteDeletes = append(siteDeletes, arg.ID)
+			return 1, nil
+		},
+		ListExpiredRelationshipsFunc: func(ctx context.Context, arg db.ListExpiredRelationshipsParams) ([]db.ListExpiredRelationshipsRow, error) {
+			return []db.ListExpiredRelationshipsRow{
+				{ID: 3, PublicID: "rel-3", SourceOrganizationID: 6, TargetOrganizationID: 7, MaxRole: db.RelationshipsMaxRoleDeveloper, ExpiresAt: expiredAt, SourceOrganizationPublicID: "org-6", TargetOrganizationPublicID: "org-7"},
+			}, nil
+		},
+		ExpireRelationshipFunc: func(ctx context.Context, arg db.ExpireRelationshipParams) (int64, error) {
+			expiredRels = append(expiredRels, arg.ID)
+			return 1, nil
+		},
+		ListExpiredSiteElevationsFunc: func(ctx context.Context, arg db.ListExpiredSiteElevationsParams) ([]db.ListExpiredSiteElevationsRow, error) {
+			return []db.ListExpiredSiteElevationsRow{
+				{ID: 4, PublicID: "elev-4", SiteID: 9, AccountID: 33, Role: db.SiteElevationsRoleOwner, ExpiresAt: expiredAt, OrganizationPublicID: "org-7", ProjectPublicID: "proj-8", SitePublicID: "site-9", AccountPublicID: "acct-33"},
+			}, nil
+		},
+		ExpireSiteElevationFunc: func(ctx context.Context, arg db.ExpireSiteElevationParams) (int64, error) {
+			expiredElevations = append(expiredElevations, arg.ID)
+			return 1, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audits = append(audits, arg)
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg.EventType)
+			return nil
+		},
+	}
+
+	expirer := NewExpirer(mock, events.NewEmitter(mock, events.EventSourceLibOpsAPI), audit.New(mock))
+	expired, err := expirer.Sweep(context.Background(), now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, expired)
+	assert.Equal(t, []int64{1}, orgDeletes)
+	assert.Equal(t, []int64{5}, siteDeletes)
+	assert.Equal(t, []int64{3}, expiredRels)
+	assert.Equal(t, []int64{4}, expiredElevations)
+	assert.Equal(t, []string{
+		events.EventTypeOrganizationMemberRemoved,
+		events.EventTypeSiteMemberRemoved,
+		events.EventTypeRelationshipRevoked,
+		events.EventTypeSiteElevationExpired,
+	}, queued)
+
+	// The relationship is audited on both organizations
+	require.Len(t, audits, 5)
+	assert.Equal(t, string(audit.MemberExpire), audits[0].EventName)
+	assert.Equal(t, int64(30), audits[0].AccountID)
+	assert.Equal(t, int64(7), audits[0].EntityID)
+	assert.Equal(t, db.AuditEntityType(audit.SiteEntityType), audits[1].EntityType)
+	assert.Equal(t, string(audit.RelationshipExpire), audits[2].EventName)
+	assert.Equal(t, int64(6), audits[2].EntityID)
+	assert.Equal(t, int64(7), audits[3].EntityID)
+	assert.Equal(t, string(audit.SiteElevationExpire), audits[4].EventName)
+	assert.Equal(t, int64(33), audits[4].AccountID)
+}
+
+// TestSweepListError tests that a failed listing stops the sweep.
+func TestSweepListError(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		ListExpiredOrganizationMembersFunc: func(ctx context.Context, arg db.ListExpiredOrganizationMembersParams) ([]db.ListExpiredOrganizationMembersRow, error) {
+			return nil, sql.ErrConnDone
+		},
+	}
+
+	_, err := NewExpirer(mock, nil, nil).Sweep(context.Background(), time.Now())
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+}
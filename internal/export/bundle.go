@@ -0,0 +1,535 @@
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+)
+
+// FormatVersion is bumped whenever the layout of a bundle changes incompatibly
+const FormatVersion = 1
+
+// listPageSize is how many rows are read per query while building a bundle
+const listPageSize = 500
+
+// Manifest is manifest.json at the root of a bundle
+type Manifest struct {
+	FormatVersion  int       `json:"format_version"`
+	OrganizationID string    `json:"organization_id"`
+	Organization   string    `json:"organization"`
+	CreatedAt      time.Time `json:"created_at"`
+	IncludeSecrets bool      `json:"include_secrets"`
+	Projects       int       `json:"projects"`
+	Sites          int       `json:"sites"`
+	Backups        int       `json:"backups"`
+	Files          []string  `json:"files"`
+}
+
+// Organization is organization.json
+type Organization struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Settings      []Setting      `json:"settings"`
+	FirewallRules []FirewallRule `json:"firewall_rules"`
+	Members       []Member       `json:"members"`
+	Secrets       []Secret       `json:"secrets"`
+}
+
+// Project is projects/<id>/project.json
+type Project struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Region          string         `json:"region,omitempty"`
+	Zone            string         `json:"zone,omitempty"`
+	MachineType     string         `json:"machine_type,omitempty"`
+	DiskSizeGB      int32          `json:"disk_size_gb,omitempty"`
+	DiskType        string         `json:"disk_type,omitempty"`
+	OS              string         `json:"os,omitempty"`
+	PromoteStrategy string         `json:"promote_strategy,omitempty"`
+	Labels          rawJSON        `json:"labels,omitempty"`
+	Settings        []Setting      `json:"settings"`
+	FirewallRules   []FirewallRule `json:"firewall_rules"`
+	Members         []Member       `json:"members"`
+	Secrets         []Secret       `json:"secrets"`
+}
+
+// Site is projects/<project id>/sites/<id>/site.json: everything needed to run
+// the site elsewhere, apart from its data, which is in the backups
+type Site struct {
+	ID               string         `json:"id"`
+	ProjectID        string         `json:"project_id"`
+	Name             string         `json:"name"`
+	GithubRepository string         `json:"github_repository"`
+	GithubRef        string         `json:"github_ref"`
+	ComposePath      string         `json:"compose_path,omitempty"`
+	ComposeFile      string         `json:"compose_file,omitempty"`
+	Port             int32          `json:"port,omitempty"`
+	ApplicationType  string         `json:"application_type,omitempty"`
+	OS               string         `json:"os,omitempty"`
+	IsProduction     bool           `json:"is_production"`
+	ExternalIP       string         `json:"external_ip,omitempty"`
+	UpCmd            rawJSON        `json:"up_cmd,omitempty"`
+	InitCmd          rawJSON        `json:"init_cmd,omitempty"`
+	RolloutCmd       rawJSON        `json:"rollout_cmd,omitempty"`
+	OverlayVolumes   rawJSON        `json:"overlay_volumes,omitempty"`
+	Labels           rawJSON        `json:"labels,omitempty"`
+	Domains          []string       `json:"domains"`
+	Settings         []Setting      `json:"settings"`
+	FirewallRules    []FirewallRule `json:"firewall_rules"`
+	Members          []Member       `json:"members"`
+	Secrets          []Secret       `json:"secrets"`
+}
+
+// Setting is a configuration setting of an organization, project or site
+type Setting struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// FirewallRule is an allow or deny rule
+type FirewallRule struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	CIDR string `json:"cidr"`
+}
+
+// Member is an account with a role on an organization, project or site
+type Member struct {
+	Email          string `json:"email"`
+	Name           string `json:"name,omitempty"`
+	GithubUsername string `json:"github_username,omitempty"`
+	Role           string `json:"role"`
+}
+
+// Secret is a secret's name, with its value when the export includes secrets,
+// or the external secret it references
+type Secret struct {
+	Name      string `json:"name"`
+	Value     string `json:"value,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// Backup is an entry of backups.json: a site's latest backup and a signed link
+// to download it, valid as long as the bundle is kept
+type Backup struct {
+	SiteID    string    `json:"site_id"`
+	Object    string    `json:"object"`
+	SizeBytes int64     `json:"size_bytes"`
+	UpdatedAt time.Time `json:"updated_at"`
+	URL       string    `json:"url"`
+}
+
+// rawJSON keeps stored JSON columns as they are, and drops them when empty
+type rawJSON = json.RawMessage
+
+func toRawJSON(value types.RawJSON) rawJSON {
+	if len(value) == 0 || string(value) == "null" {
+		return nil
+	}
+	return rawJSON(value)
+}
+
+// SecretReader reads secret values from an organization's Vault.
+// *vault.Client is a SecretReader.
+type SecretReader interface {
+	ReadSecret(ctx context.Context, path string) (map[string]any, error)
+}
+
+// Backups finds each site's latest backup
+type Backups interface {
+	// LatestBackup returns a site's newest backup with a link valid for ttl,
+	// or nil when the site has none
+	LatestBackup(ctx context.Context, sitePublicID string, ttl time.Duration) (*Backup, error)
+}
+
+// builder writes one organization's bundle
+type builder struct {
+	db      db.Querier
+	secrets SecretReader // nil unless the export includes secrets
+	backups Backups      // nil when backups aren't exported
+	linkTTL time.Duration
+
+	tar   *tar.Writer
+	now   time.Time
+	files []string
+}
+
+// Build writes an organization's bundle to w as a .tar.gz. Secret values are only
+// read when secrets is set, and backups are only linked when backups is set.
+func Build(
+	ctx context.Context,
+	w io.Writer,
+	querier db.Querier,
+	organization db.GetOrganizationByIDRow,
+	secrets SecretReader,
+	backups Backups,
+	linkTTL time.Duration,
+	now time.Time,
+) error {
+	gz := gzip.NewWriter(w)
+	b := &builder{
+		db:      querier,
+		secrets: secrets,
+		backups: backups,
+		linkTTL: linkTTL,
+		tar:     tar.NewWriter(gz),
+		now:     now,
+	}
+
+	manifest, err := b.build(ctx, organization)
+	if err != nil {
+		return err
+	}
+	manifest.Files = append(b.files, "manifest.json")
+	if err := b.writeJSON("manifest.json", manifest); err != nil {
+		return err
+	}
+
+	if err := b.tar.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	return nil
+}
+
+func (b *builder) build(ctx context.Context, organization db.GetOrganizationByIDRow) (*Manifest, error) {
+	manifest := &Manifest{
+		FormatVersion:  FormatVersion,
+		OrganizationID: organization.PublicID,
+		Organization:   organization.Name,
+		CreatedAt:      b.now.UTC(),
+		IncludeSecrets: b.secrets != nil,
+	}
+
+	doc, err := b.organization(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.writeJSON("organization.json", doc); err != nil {
+		return nil, err
+	}
+
+	projects, err := listAll(func(limit, offset int32) ([]db.ListOrganizationProjectsRow, error) {
+		return b.db.ListOrganizationProjects(ctx, db.ListOrganizationProjectsParams{OrganizationID: organization.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	backups := []Backup{}
+	for _, project := range projects {
+		doc, err := b.project(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.writeJSON(fmt.Sprintf("projects/%s/project.json", project.PublicID), doc); err != nil {
+			return nil, err
+		}
+		manifest.Projects++
+
+		sites, err := listAll(func(limit, offset int32) ([]db.ListProjectSitesRow, error) {
+			return b.db.ListProjectSites(ctx, db.ListProjectSitesParams{ProjectID: project.ID, Limit: limit, Offset: offset})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sites of project %s: %w", project.PublicID, err)
+		}
+		for _, site := range sites {
+			doc, err := b.site(ctx, project.PublicID, site)
+			if err != nil {
+				return nil, err
+			}
+			if err := b.writeJSON(fmt.Sprintf("projects/%s/sites/%s/site.json", project.PublicID, site.PublicID), doc); err != nil {
+				return nil, err
+			}
+			manifest.Sites++
+
+			if b.backups == nil {
+				continue
+			}
+			backup, err := b.backups.LatestBackup(ctx, site.PublicID, b.linkTTL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find the latest backup of site %s: %w", site.PublicID, err)
+			}
+			if backup != nil {
+				backups = append(backups, *backup)
+			}
+		}
+	}
+
+	if err := b.writeJSON("backups.json", backups); err != nil {
+		return nil, err
+	}
+	manifest.Backups = len(backups)
+	return manifest, nil
+}
+
+func (b *builder) organization(ctx context.Context, organization db.GetOrganizationByIDRow) (*Organization, error) {
+	doc := &Organization{ID: organization.PublicID, Name: organization.Name}
+
+	settings, err := listAll(func(limit, offset int32) ([]db.ListOrganizationSettingsRow, error) {
+		return b.db.ListOrganizationSettings(ctx, db.ListOrganizationSettingsParams{OrganizationID: organization.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization settings: %w", err)
+	}
+	doc.Settings = make([]Setting, 0, len(settings))
+	for _, s := range settings {
+		doc.Settings = append(doc.Settings, Setting{Key: s.SettingKey, Value: s.SettingValue, Description: s.Description.String})
+	}
+
+	rules, err := b.db.ListOrganizationFirewallRules(ctx, sql.NullInt64{Int64: organization.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization firewall rules: %w", err)
+	}
+	doc.FirewallRules = make([]FirewallRule, 0, len(rules))
+	for _, r := range rules {
+		doc.FirewallRules = append(doc.FirewallRules, FirewallRule{Name: r.Name, Type: string(r.RuleType), CIDR: r.Cidr})
+	}
+
+	members, err := listAll(func(limit, offset int32) ([]db.ListOrganizationMembersRow, error) {
+		return b.db.ListOrganizationMembers(ctx, db.ListOrganizationMembersParams{OrganizationID: organization.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	doc.Members = make([]Member, 0, len(members))
+	for _, m := range members {
+		doc.Members = append(doc.Members, Member{Email: m.Email, Name: m.Name.String, GithubUsername: m.GithubUsername.String, Role: string(m.Role)})
+	}
+
+	secrets, err := listAll(func(limit, offset int32) ([]db.ListOrganizationSecretsRow, error) {
+		return b.db.ListOrganizationSecrets(ctx, db.ListOrganizationSecretsParams{OrganizationID: organization.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization secrets: %w", err)
+	}
+	doc.Secrets = make([]Secret, 0, len(secrets))
+	for _, s := range secrets {
+		secret, err := b.secret(ctx, s.Name, s.VaultPath, s.Reference)
+		if err != nil {
+			return nil, err
+		}
+		doc.Secrets = append(doc.Secrets, secret)
+	}
+
+	return doc, nil
+}
+
+func (b *builder) project(ctx context.Context, project db.ListOrganizationProjectsRow) (*Project, error) {
+	doc := &Project{
+		ID:              project.PublicID,
+		Name:            project.Name,
+		Region:          project.GcpRegion.String,
+		Zone:            project.GcpZone.String,
+		MachineType:     project.MachineType.String,
+		DiskSizeGB:      project.DiskSizeGb.Int32,
+		DiskType:        project.DiskType.String,
+		OS:              project.Os.String,
+		PromoteStrategy: string(project.PromoteStrategy.ProjectsPromoteStrategy),
+		Labels:          toRawJSON(project.Labels),
+	}
+
+	settings, err := listAll(func(limit, offset int32) ([]db.ListProjectSettingsRow, error) {
+		return b.db.ListProjectSettings(ctx, db.ListProjectSettingsParams{ProjectID: project.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings of project %s: %w", project.PublicID, err)
+	}
+	doc.Settings = make([]Setting, 0, len(settings))
+	for _, s := range settings {
+		doc.Settings = append(doc.Settings, Setting{Key: s.SettingKey, Value: s.SettingValue, Description: s.Description.String})
+	}
+
+	rules, err := b.db.ListProjectFirewallRules(ctx, sql.NullInt64{Int64: project.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall rules of project %s: %w", project.PublicID, err)
+	}
+	doc.FirewallRules = make([]FirewallRule, 0, len(rules))
+	for _, r := range rules {
+		doc.FirewallRules = append(doc.FirewallRules, FirewallRule{Name: r.Name, Type: string(r.RuleType), CIDR: r.Cidr})
+	}
+
+	members, err := listAll(func(limit, offset int32) ([]db.ListProjectMembersRow, error) {
+		return b.db.ListProjectMembers(ctx, db.ListProjectMembersParams{ProjectID: project.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of project %s: %w", project.PublicID, err)
+	}
+	doc.Members = make([]Member, 0, len(members))
+	for _, m := range members {
+		doc.Members = append(doc.Members, Member{Email: m.Email, Name: m.Name.String, GithubUsername: m.GithubUsername.String, Role: string(m.Role)})
+	}
+
+	secrets, err := listAll(func(limit, offset int32) ([]db.ListProjectSecretsRow, error) {
+		return b.db.ListProjectSecrets(ctx, db.ListProjectSecretsParams{ProjectID: project.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets of project %s: %w", project.PublicID, err)
+	}
+	doc.Secrets = make([]Secret, 0, len(secrets))
+	for _, s := range secrets {
+		secret, err := b.secret(ctx, s.Name, s.VaultPath, s.Reference)
+		if err != nil {
+			return nil, err
+		}
+		doc.Secrets = append(doc.Secrets, secret)
+	}
+
+	return doc, nil
+}
+
+func (b *builder) site(ctx context.Context, projectPublicID string, site db.ListProjectSitesRow) (*Site, error) {
+	doc := &Site{
+		ID:               site.PublicID,
+		ProjectID:        projectPublicID,
+		Name:             site.Name,
+		GithubRepository: site.GithubRepository,
+		GithubRef:        site.GithubRef,
+		ComposePath:      site.ComposePath.String,
+		ComposeFile:      site.ComposeFile.String,
+		Port:             site.Port.Int32,
+		ApplicationType:  site.ApplicationType.String,
+		OS:               site.Os.String,
+		IsProduction:     site.IsProduction.Bool,
+		ExternalIP:       site.GcpExternalIp.String,
+		UpCmd:            toRawJSON(site.UpCmd),
+		InitCmd:          toRawJSON(site.InitCmd),
+		RolloutCmd:       toRawJSON(site.RolloutCmd),
+		OverlayVolumes:   toRawJSON(site.OverlayVolumes),
+		Labels:           toRawJSON(site.Labels),
+	}
+
+	domains, err := listAll(func(limit, offset int32) ([]db.Domain, error) {
+		return b.db.ListSiteDomains(ctx, db.ListSiteDomainsParams{SiteID: site.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains of site %s: %w", site.PublicID, err)
+	}
+	doc.Domains = make([]string, 0, len(domains))
+	for _, d := range domains {
+		doc.Domains = append(doc.Domains, d.Domain)
+	}
+
+	settings, err := listAll(func(limit, offset int32) ([]db.ListSiteSettingsRow, error) {
+		return b.db.ListSiteSettings(ctx, db.ListSiteSettingsParams{SiteID: site.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings of site %s: %w", site.PublicID, err)
+	}
+	doc.Settings = make([]Setting, 0, len(settings))
+	for _, s := range settings {
+		doc.Settings = append(doc.Settings, Setting{Key: s.SettingKey, Value: s.SettingValue, Description: s.Description.String})
+	}
+
+	rules, err := b.db.ListSiteFirewallRules(ctx, sql.NullInt64{Int64: site.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall rules of site %s: %w", site.PublicID, err)
+	}
+	doc.FirewallRules = make([]FirewallRule, 0, len(rules))
+	for _, r := range rules {
+		doc.FirewallRules = append(doc.FirewallRules, FirewallRule{Name: r.Name, Type: string(r.RuleType), CIDR: r.Cidr})
+	}
+
+	members, err := listAll(func(limit, offset int32) ([]db.ListSiteMembersRow, error) {
+		return b.db.ListSiteMembers(ctx, db.ListSiteMembersParams{SiteID: site.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of site %s: %w", site.PublicID, err)
+	}
+	doc.Members = make([]Member, 0, len(members))
+	for _, m := range members {
+		doc.Members = append(doc.Members, Member{Email: m.Email, Name: m.Name.String, GithubUsername: m.GithubUsername.String, Role: string(m.Role)})
+	}
+
+	secrets, err := listAll(func(limit, offset int32) ([]db.ListSiteSecretsRow, error) {
+		return b.db.ListSiteSecrets(ctx, db.ListSiteSecretsParams{SiteID: site.ID, Limit: limit, Offset: offset})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets of site %s: %w", site.PublicID, err)
+	}
+	doc.Secrets = make([]Secret, 0, len(secrets))
+	for _, s := range secrets {
+		secret, err := b.secret(ctx, s.Name, s.VaultPath, s.Reference)
+		if err != nil {
+			return nil, err
+		}
+		doc.Secrets = append(doc.Secrets, secret)
+	}
+
+	return doc, nil
+}
+
+// secret returns a secret's name, and its value when the export includes secrets
+// or the reference when it points at an external secret
+func (b *builder) secret(ctx context.Context, name, vaultPath string, reference sql.NullString) (Secret, error) {
+	secret := Secret{Name: name}
+	// A reference has no value in the Vault to read
+	if reference.Valid {
+		secret.Reference = reference.String
+		return secret, nil
+	}
+	if b.secrets == nil {
+		return secret, nil
+	}
+	data, err := b.secrets.ReadSecret(ctx, vaultPath)
+	if err != nil {
+		return secret, fmt.Errorf("failed to read secret %s: %w", name, err)
+	}
+	// Secrets are written by the secret services as {"value": ...}
+	value, ok := data["value"].(string)
+	if !ok {
+		return secret, fmt.Errorf("secret %s has no value", name)
+	}
+	secret.Value = value
+	return secret, nil
+}
+
+// writeJSON adds an indented JSON file to the bundle
+func (b *builder) writeJSON(name string, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	data = append(data, '\n')
+
+	err = b.tar.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: b.now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	if _, err := b.tar.Write(data); err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	b.files = append(b.files, name)
+	return nil
+}
+
+// listAll reads every page of a paginated query
+func listAll[T any](list func(limit, offset int32) ([]T, error)) ([]T, error) {
+	var all []T
+	for offset := int32(0); ; offset += listPageSize {
+		page, err := list(listPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < listPageSize {
+			return all, nil
+		}
+	}
+}
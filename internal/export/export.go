@@ -0,0 +1,189 @@
+// Package export builds organization export bundles: a .tar.gz of an
+// organization's projects, site configurations, settings, firewall rules,
+// members, domains, latest backups and optionally secrets, uploaded to a bucket
+// and downloaded through a signed URL so customers can leave libops cleanly.
+package export
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/envelope"
+	"github.com/libops/api/internal/vault"
+)
+
+const (
+	// ContentType of a bundle
+	ContentType = "application/gzip"
+
+	// DownloadTTL is how long a download URL from the API is valid for
+	DownloadTTL = time.Hour
+
+	// staleAfter is how long an export can be running before it is assumed
+	// abandoned by an instance that stopped, and built again
+	staleAfter = time.Hour
+
+	// sweepBatch caps how many exports are built per sweep
+	sweepBatch = 5
+
+	maxErrorLength = 1024
+)
+
+// ObjectName is where an export's bundle is stored in the export bucket
+func ObjectName(organizationPublicID, exportPublicID string) string {
+	return fmt.Sprintf("%s/%s.tar.gz", organizationPublicID, exportPublicID)
+}
+
+// OpenSecrets returns a reader of an organization's secrets
+type OpenSecrets func(ctx context.Context, organizationID int64) (SecretReader, error)
+
+// Runner builds pending exports
+type Runner struct {
+	db          db.Querier
+	storage     Storage
+	bucket      string
+	backups     Backups // nil when backups aren't exported
+	openSecrets OpenSecrets
+	retention   time.Duration
+}
+
+// NewRunner creates a runner that uploads bundles to bucket and keeps them for
+// retention, which is also how long backup links in a bundle stay valid. The
+// bucket should have a lifecycle rule deleting objects after the retention.
+func NewRunner(querier db.Querier, storage Storage, bucket string, backups Backups, openSecrets OpenSecrets, retention time.Duration) *Runner {
+	return &Runner{
+		db:          querier,
+		storage:     storage,
+		bucket:      bucket,
+		backups:     backups,
+		openSecrets: openSecrets,
+		retention:   retention,
+	}
+}
+
+// Sweep builds pending exports and returns how many succeeded and failed
+func (r *Runner) Sweep(ctx context.Context, now time.Time) (succeeded, failed int, err error) {
+	requeued, err := r.db.RequeueStaleOrganizationExports(ctx, sql.NullTime{Time: now.Add(-staleAfter), Valid: true})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to requeue stale exports: %w", err)
+	}
+	if requeued > 0 {
+		slog.Warn("Requeued abandoned organization exports", "exports", requeued)
+	}
+
+	pending, err := r.db.ListPendingOrganizationExports(ctx, sweepBatch)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list pending exports: %w", err)
+	}
+
+	for _, export := range pending {
+		claimed, err := r.db.StartOrganizationExport(ctx, export.ID)
+		if err != nil {
+			slog.Error("Failed to start organization export", "error", err, "export_id", export.PublicID)
+			continue
+		}
+		if claimed == 0 {
+			// Another instance took it
+			continue
+		}
+
+		if err := r.run(ctx, export, now); err != nil {
+			slog.Error("Organization export failed", "error", err, "export_id", export.PublicID)
+			message := err.Error()
+			if len(message) > maxErrorLength {
+				message = message[:maxErrorLength]
+			}
+			if err := r.db.FailOrganizationExport(ctx, db.FailOrganizationExportParams{
+				ID:    export.ID,
+				Error: sql.NullString{String: message, Valid: true},
+			}); err != nil {
+				slog.Error("Failed to record export failure", "error", err, "export_id", export.PublicID)
+			}
+			failed++
+			continue
+		}
+		succeeded++
+	}
+
+	return succeeded, failed, nil
+}
+
+// run builds, uploads and records one export
+func (r *Runner) run(ctx context.Context, export db.ListPendingOrganizationExportsRow, now time.Time) error {
+	organization, err := r.db.GetOrganizationByID(ctx, export.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	var secrets SecretReader
+	if export.IncludeSecrets {
+		if secrets, err = r.openSecrets(ctx, organization.ID); err != nil {
+			return fmt.Errorf("failed to open the organization's vault: %w", err)
+		}
+	}
+
+	// Bundles hold configuration, not site data, so they are small enough to
+	// build in memory
+	var bundle bytes.Buffer
+	hash := sha256.New()
+	if err := Build(ctx, io.MultiWriter(&bundle, hash), r.db, organization, secrets, r.backups, r.retention, now); err != nil {
+		return err
+	}
+	size := int64(bundle.Len())
+
+	name := ObjectName(organization.PublicID, export.PublicID)
+	if err := r.storage.Upload(ctx, r.bucket, name, ContentType, &bundle); err != nil {
+		return err
+	}
+
+	err = r.db.CompleteOrganizationExport(ctx, db.CompleteOrganizationExportParams{
+		ID:         export.ID,
+		ObjectName: sql.NullString{String: name, Valid: true},
+		SizeBytes:  sql.NullInt64{Int64: size, Valid: true},
+		Sha256:     sql.NullString{String: hex.EncodeToString(hash.Sum(nil)), Valid: true},
+		ExpiresAt:  sql.NullTime{Time: now.Add(r.retention), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record export: %w", err)
+	}
+
+	slog.Info("Built organization export", "export_id", export.PublicID, "organization_id", organization.PublicID, "size_bytes", size)
+	return nil
+}
+
+// Compile-time check that the secret store can read secrets for an export.
+var _ SecretReader = (*envelope.Store)(nil)
+
+// VaultSecrets opens the Vault running in an organization's libops project,
+// opening sealed secrets as they're read
+func VaultSecrets(querier db.Querier) OpenSecrets {
+	return func(ctx context.Context, organizationID int64) (SecretReader, error) {
+		project, err := querier.GetOrganizationProjectByOrganizationID(ctx, organizationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get organization project: %w", err)
+		}
+
+		var projectNumber int64
+		if project.GcpProjectNumber.Valid {
+			_, _ = fmt.Sscanf(project.GcpProjectNumber.String, "%d", &projectNumber)
+		}
+		region := "us-central1"
+		if project.GcpRegion.Valid && project.GcpRegion.String != "" {
+			region = project.GcpRegion.String
+		}
+
+		client, err := vault.NewCustomerVaultClient(ctx, organizationID, projectNumber, region)
+		if err != nil {
+			return nil, err
+		}
+		return envelope.Open(ctx, querier, client, vault.NewTransit(client, vault.SecretsTransitKey), organizationID)
+	}
+}
@@ -0,0 +1,224 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// memoryStorage keeps uploads in memory and finds one backup per site
+type memoryStorage struct {
+	uploads map[string][]byte
+	backups map[string]*Object // prefix to latest object
+}
+
+func (m *memoryStorage) Upload(ctx context.Context, bucket, name, contentType string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.uploads[bucket+"/"+name] = data
+	return nil
+}
+
+func (m *memoryStorage) Delete(ctx context.Context, bucket, name string) error {
+	delete(m.uploads, bucket+"/"+name)
+	return nil
+}
+
+func (m *memoryStorage) Latest(ctx context.Context, bucket, prefix string) (*Object, error) {
+	return m.backups[prefix], nil
+}
+
+func (m *memoryStorage) SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error) {
+	return "https://storage.googleapis.com/" + bucket + "/" + name + "?signed", nil
+}
+
+// fakeSecrets reads secret values from a map
+type fakeSecrets map[string]string
+
+func (f fakeSecrets) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	value, ok := f[path]
+	if !ok {
+		return nil, errors.New("no secret")
+	}
+	return map[string]any{"value": value}, nil
+}
+
+// exportQuerier is an organization with one project and one site
+func exportQuerier(pending []db.ListPendingOrganizationExportsRow) (*testutils.MockQuerier, *[]db.CompleteOrganizationExportParams, *[]db.FailOrganizationExportParams) {
+	var completed []db.CompleteOrganizationExportParams
+	var failed []db.FailOrganizationExportParams
+	mock := &testutils.MockQuerier{
+		ListPendingOrganizationExportsFunc: func(ctx context.Context, limit int32) ([]db.ListPendingOrganizationExportsRow, error) {
+			return pending, nil
+		},
+		StartOrganizationExportFunc: func(ctx context.Context, id int64) (int64, error) {
+			return 1, nil
+		},
+		CompleteOrganizationExportFunc: func(ctx context.Context, arg db.CompleteOrganizationExportParams) error {
+			completed = append(completed, arg)
+			return nil
+		},
+		FailOrganizationExportFunc: func(ctx context.Context, arg db.FailOrganizationExportParams) error {
+			failed = append(failed, arg)
+			return nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: "org-1", Name: "Consortium"}, nil
+		},
+		ListOrganizationMembersFunc: func(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error) {
+			return []db.ListOrganizationMembersRow{{Email: "owner@example.edu", Role: db.OrganizationMembersRoleOwner}}, nil
+		},
+		ListOrganizationSecretsFunc: func(ctx context.Context, arg db.ListOrganizationSecretsParams) ([]db.ListOrganizationSecretsRow, error) {
+			return []db.ListOrganizationSecretsRow{{Name: "SMTP_PASSWORD", VaultPath: "secret-organization/SMTP_PASSWORD"}}, nil
+		},
+		ListOrganizationProjectsFunc: func(ctx context.Context, arg db.ListOrganizationProjectsParams) ([]db.ListOrganizationProjectsRow, error) {
+			return []db.ListOrganizationProjectsRow{{ID: 2, PublicID: "project-1", Name: "Repository"}}, nil
+		},
+		ListProjectSitesFunc: func(ctx context.Context, arg db.ListProjectSitesParams) ([]db.ListProjectSitesRow, error) {
+			return []db.ListProjectSitesRow{{
+				ID:               3,
+				PublicID:         "site-1",
+				Name:             "production",
+				GithubRepository: "https://github.com/example/islandora",
+				GithubRef:        "heads/main",
+				Port:             sql.NullInt32{Int32: 80, Valid: true},
+				UpCmd:            []byte(`["docker compose up -d"]`),
+			}}, nil
+		},
+		ListSiteDomainsFunc: func(ctx context.Context, arg db.ListSiteDomainsParams) ([]db.Domain, error) {
+			return []db.Domain{{Domain: "digital.example.edu"}}, nil
+		},
+		ListSiteFirewallRulesFunc: func(ctx context.Context, siteID sql.NullInt64) ([]db.ListSiteFirewallRulesRow, error) {
+			return []db.ListSiteFirewallRulesRow{{Name: "campus", RuleType: db.SiteFirewallRulesRuleTypeHttpsAllowed, Cidr: "10.0.0.0/8"}}, nil
+		},
+	}
+	return mock, &completed, &failed
+}
+
+// readBundle returns the JSON files of a .tar.gz bundle
+func readBundle(t *testing.T, data []byte) map[string]json.RawMessage {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	files := map[string]json.RawMessage{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = content
+	}
+}
+
+// TestRunnerSweep tests that a pending export is built with its secrets and
+// backups, uploaded and recorded.
+func TestRunnerSweep(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock, completed, failed := exportQuerier([]db.ListPendingOrganizationExportsRow{
+		{ID: 9, PublicID: "export-1", OrganizationID: 1, IncludeSecrets: true},
+	})
+	storage := &memoryStorage{
+		uploads: map[string][]byte{},
+		backups: map[string]*Object{"site-1/": {Name: "site-1/2026-02-28.tar.gz", SizeBytes: 1 << 30, UpdatedAt: now.Add(-time.Hour)}},
+	}
+	secrets := fakeSecrets{"secret-organization/SMTP_PASSWORD": "hunter2"}
+	runner := NewRunner(mock, storage, "libops-exports", NewBucketBackups(storage, "libops-backups"),
+		func(ctx context.Context, organizationID int64) (SecretReader, error) { return secrets, nil }, 7*24*time.Hour)
+
+	succeeded, failures, err := runner.Sweep(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, succeeded)
+	assert.Zero(t, failures)
+	assert.Empty(t, *failed)
+
+	require.Len(t, *completed, 1)
+	record := (*completed)[0]
+	assert.Equal(t, "org-1/export-1.tar.gz", record.ObjectName.String)
+	assert.Equal(t, now.Add(7*24*time.Hour), record.ExpiresAt.Time)
+	bundle := storage.uploads["libops-exports/org-1/export-1.tar.gz"]
+	require.NotEmpty(t, bundle)
+	assert.Equal(t, int64(len(bundle)), record.SizeBytes.Int64)
+	assert.Len(t, record.Sha256.String, 64)
+
+	files := readBundle(t, bundle)
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(files["manifest.json"], &manifest))
+	assert.Equal(t, FormatVersion, manifest.FormatVersion)
+	assert.True(t, manifest.IncludeSecrets)
+	assert.Equal(t, 1, manifest.Projects)
+	assert.Equal(t, 1, manifest.Sites)
+	assert.Equal(t, 1, manifest.Backups)
+	assert.ElementsMatch(t, []string{
+		"organization.json",
+		"projects/project-1/project.json",
+		"projects/project-1/sites/site-1/site.json",
+		"backups.json",
+		"manifest.json",
+	}, manifest.Files)
+
+	var organization Organization
+	require.NoError(t, json.Unmarshal(files["organization.json"], &organization))
+	assert.Equal(t, []Secret{{Name: "SMTP_PASSWORD", Value: "hunter2"}}, organization.Secrets)
+	assert.Equal(t, []Member{{Email: "owner@example.edu", Role: "owner"}}, organization.Members)
+
+	var site Site
+	require.NoError(t, json.Unmarshal(files["projects/project-1/sites/site-1/site.json"], &site))
+	assert.Equal(t, "project-1", site.ProjectID)
+	assert.Equal(t, []string{"digital.example.edu"}, site.Domains)
+	assert.Equal(t, []FirewallRule{{Name: "campus", Type: "https_allowed", CIDR: "10.0.0.0/8"}}, site.FirewallRules)
+	assert.JSONEq(t, `["docker compose up -d"]`, string(site.UpCmd))
+	assert.Nil(t, site.InitCmd, "empty JSON columns are left out")
+
+	var backups []Backup
+	require.NoError(t, json.Unmarshal(files["backups.json"], &backups))
+	require.Len(t, backups, 1)
+	assert.Equal(t, "site-1", backups[0].SiteID)
+	assert.Equal(t, "https://storage.googleapis.com/libops-backups/site-1/2026-02-28.tar.gz?signed", backups[0].URL)
+}
+
+// TestRunnerSweepWithoutSecrets tests that secret values are only exported on
+// request, and that a failed export is recorded.
+func TestRunnerSweepWithoutSecrets(t *testing.T) {
+	mock, completed, failed := exportQuerier([]db.ListPendingOrganizationExportsRow{
+		{ID: 9, PublicID: "export-1", OrganizationID: 1},
+		{ID: 10, PublicID: "export-2", OrganizationID: 1, IncludeSecrets: true},
+	})
+	storage := &memoryStorage{uploads: map[string][]byte{}}
+	runner := NewRunner(mock, storage, "libops-exports", nil,
+		func(ctx context.Context, organizationID int64) (SecretReader, error) { return fakeSecrets{}, nil }, 24*time.Hour)
+
+	succeeded, failures, err := runner.Sweep(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, 1, failures)
+
+	require.Len(t, *completed, 1)
+	files := readBundle(t, storage.uploads["libops-exports/org-1/export-1.tar.gz"])
+	var organization Organization
+	require.NoError(t, json.Unmarshal(files["organization.json"], &organization))
+	assert.Equal(t, []Secret{{Name: "SMTP_PASSWORD"}}, organization.Secrets)
+	assert.JSONEq(t, `[]`, string(files["backups.json"]))
+
+	require.Len(t, *failed, 1)
+	assert.Equal(t, int64(10), (*failed)[0].ID)
+	assert.Contains(t, (*failed)[0].Error.String, "SMTP_PASSWORD")
+}
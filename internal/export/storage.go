@@ -0,0 +1,228 @@
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+	storage "google.golang.org/api/storage/v1"
+)
+
+// MaxLinkTTL is the longest a V4 signed URL can be valid for
+const MaxLinkTTL = 7 * 24 * time.Hour
+
+// Object is a stored object
+type Object struct {
+	Name      string
+	SizeBytes int64
+	UpdatedAt time.Time
+}
+
+// Storage is where bundles and avatars are uploaded to and backups are found
+type Storage interface {
+	// Upload writes an object
+	Upload(ctx context.Context, bucket, name, contentType string, r io.Reader) error
+	// Delete removes an object, succeeding when it doesn't exist
+	Delete(ctx context.Context, bucket, name string) error
+	// Latest returns the most recently updated object under prefix, or nil when there is none
+	Latest(ctx context.Context, bucket, prefix string) (*Object, error)
+	// SignedURL returns a URL anyone can download an object with until ttl passes
+	SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error)
+}
+
+// GCS stores bundles in Cloud Storage. Download URLs are V4 signed URLs signed
+// by a service account through the IAM Credentials API, so no key file is needed;
+// the API's identity needs roles/iam.serviceAccountTokenCreator on signer.
+type GCS struct {
+	objects *storage.Service
+	iam     *iamcredentials.Service
+	signer  string
+}
+
+// Compile-time check.
+var _ Storage = (*GCS)(nil)
+
+// NewGCS creates Cloud Storage storage using application default credentials
+func NewGCS(ctx context.Context, signer string) (*GCS, error) {
+	objects, err := storage.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage client: %w", err)
+	}
+	iam, err := iamcredentials.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IAM credentials client: %w", err)
+	}
+	return &GCS{objects: objects, iam: iam, signer: signer}, nil
+}
+
+// Upload writes an object
+func (g *GCS) Upload(ctx context.Context, bucket, name, contentType string, r io.Reader) error {
+	_, err := g.objects.Objects.Insert(bucket, &storage.Object{Name: name, ContentType: contentType}).
+		Media(r).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to upload gs://%s/%s: %w", bucket, name, err)
+	}
+	return nil
+}
+
+// Delete removes an object
+func (g *GCS) Delete(ctx context.Context, bucket, name string) error {
+	err := g.objects.Objects.Delete(bucket, name).Context(ctx).Do()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete gs://%s/%s: %w", bucket, name, err)
+	}
+	return nil
+}
+
+// Latest returns the most recently updated object under prefix
+func (g *GCS) Latest(ctx context.Context, bucket, prefix string) (*Object, error) {
+	var latest *Object
+	err := g.objects.Objects.List(bucket).Prefix(prefix).Pages(ctx, func(page *storage.Objects) error {
+		for _, item := range page.Items {
+			updated, err := time.Parse(time.RFC3339, item.Updated)
+			if err != nil {
+				return fmt.Errorf("object %s has an invalid update time: %w", item.Name, err)
+			}
+			if latest == nil || updated.After(latest.UpdatedAt) {
+				latest = &Object{Name: item.Name, SizeBytes: int64(item.Size), UpdatedAt: updated}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gs://%s/%s: %w", bucket, prefix, err)
+	}
+	return latest, nil
+}
+
+// SignedURL returns a V4 signed download URL
+func (g *GCS) SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error) {
+	return signedURL(bucket, name, g.signer, ttl, time.Now(), func(payload []byte) ([]byte, error) {
+		resp, err := g.iam.Projects.ServiceAccounts.SignBlob("projects/-/serviceAccounts/"+g.signer, &iamcredentials.SignBlobRequest{
+			Payload: base64.StdEncoding.EncodeToString(payload),
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign URL: %w", err)
+		}
+		return base64.StdEncoding.DecodeString(resp.SignedBlob)
+	})
+}
+
+// signedURL builds a V4 signed GET URL, see
+// https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+func signedURL(bucket, name, signer string, ttl time.Duration, now time.Time, sign func([]byte) ([]byte, error)) (string, error) {
+	if ttl <= 0 || ttl > MaxLinkTTL {
+		return "", fmt.Errorf("signed URLs must expire within %s", MaxLinkTTL)
+	}
+
+	now = now.UTC()
+	timestamp := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/auto/storage/goog4_request"
+
+	path := "/" + bucket + "/" + escapeObjectName(name)
+	query := url.Values{
+		"X-Goog-Algorithm":     {"GOOG4-RSA-SHA256"},
+		"X-Goog-Credential":    {signer + "/" + scope},
+		"X-Goog-Date":          {timestamp},
+		"X-Goog-Expires":       {fmt.Sprint(int64(ttl.Seconds()))},
+		"X-Goog-SignedHeaders": {"host"},
+	}
+	// Encode sorts by key, as the canonical request requires
+	canonicalQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+
+	canonicalRequest := strings.Join([]string{
+		"GET",
+		path,
+		canonicalQuery,
+		"host:storage.googleapis.com",
+		"",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"GOOG4-RSA-SHA256",
+		timestamp,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	signature, err := sign([]byte(stringToSign))
+	if err != nil {
+		return "", err
+	}
+
+	return "https://storage.googleapis.com" + path + "?" + canonicalQuery + "&X-Goog-Signature=" + hex.EncodeToString(signature), nil
+}
+
+// escapeObjectName percent-encodes an object name for a canonical request: every
+// byte but unreserved characters and the slashes between segments
+func escapeObjectName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// BucketBackups finds site backups in a bucket, where each site's backups are
+// stored under its public ID, e.g. <site id>/2026-01-02T03:04:05Z.tar.gz
+type BucketBackups struct {
+	storage Storage
+	bucket  string
+}
+
+// Compile-time check.
+var _ Backups = (*BucketBackups)(nil)
+
+// NewBucketBackups finds site backups in bucket
+func NewBucketBackups(storage Storage, bucket string) *BucketBackups {
+	return &BucketBackups{storage: storage, bucket: bucket}
+}
+
+// Latest returns a site's newest backup object, or nil when it has none
+func (b *BucketBackups) Latest(ctx context.Context, sitePublicID string) (*Object, error) {
+	return b.storage.Latest(ctx, b.bucket, sitePublicID+"/")
+}
+
+// LatestBackup returns a site's newest backup with a signed download URL
+func (b *BucketBackups) LatestBackup(ctx context.Context, sitePublicID string, ttl time.Duration) (*Backup, error) {
+	object, err := b.Latest(ctx, sitePublicID)
+	if err != nil || object == nil {
+		return nil, err
+	}
+	link, err := b.storage.SignedURL(ctx, b.bucket, object.Name, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &Backup{
+		SiteID:    sitePublicID,
+		Object:    object.Name,
+		SizeBytes: object.SizeBytes,
+		UpdatedAt: object.UpdatedAt,
+		URL:       link,
+	}, nil
+}
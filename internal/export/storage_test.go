@@ -0,0 +1,42 @@
+package export
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignedURL tests the V4 signed URL layout and what is signed.
+func TestSignedURL(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	var signed string
+	link, err := signedURL("libops-backups", "site-1/2026-03-01T00:00:00Z.tar.gz", "exports@libops.iam.gserviceaccount.com", time.Hour, now,
+		func(payload []byte) ([]byte, error) {
+			signed = string(payload)
+			return []byte{0xab, 0xcd}, nil
+		})
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, "storage.googleapis.com", parsed.Host)
+	assert.Equal(t, "/libops-backups/site-1/2026-03-01T00%3A00%3A00Z.tar.gz", parsed.EscapedPath(), "object names are strictly escaped")
+	query := parsed.Query()
+	assert.Equal(t, "GOOG4-RSA-SHA256", query.Get("X-Goog-Algorithm"))
+	assert.Equal(t, "exports@libops.iam.gserviceaccount.com/20260301/auto/storage/goog4_request", query.Get("X-Goog-Credential"))
+	assert.Equal(t, "20260301T123000Z", query.Get("X-Goog-Date"))
+	assert.Equal(t, "3600", query.Get("X-Goog-Expires"))
+	assert.Equal(t, "abcd", query.Get("X-Goog-Signature"))
+
+	lines := strings.Split(signed, "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"GOOG4-RSA-SHA256", "20260301T123000Z", "20260301/auto/storage/goog4_request"}, lines[:3])
+	assert.Len(t, lines[3], 64, "the canonical request hash")
+
+	_, err = signedURL("libops-backups", "object", "exports@libops.iam.gserviceaccount.com", 8*24*time.Hour, now, nil)
+	assert.Error(t, err, "V4 URLs expire within 7 days")
+}
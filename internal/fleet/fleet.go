@@ -0,0 +1,177 @@
+// Package fleet reports on site VMs as their controllers last checked in:
+// the controller release each runs, reconciliations waiting on it, whether its
+// OS is patched and how full its data disk is.
+package fleet
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// OS patch statuses, as computed by ListFleetSites
+const (
+	PatchCurrent        = "current"
+	PatchOutdated       = "outdated"
+	PatchRebootRequired = "reboot_required"
+	PatchUnknown        = "unknown"
+)
+
+// PatchStatuses are the OS patch statuses a VM can be filtered by
+var PatchStatuses = []string{PatchCurrent, PatchOutdated, PatchRebootRequired, PatchUnknown}
+
+// siteStatuses are the site statuses a VM can be filtered by; deleted sites
+// aren't part of the fleet
+var siteStatuses = []db.SitesStatus{
+	db.SitesStatusActive,
+	db.SitesStatusProvisioning,
+	db.SitesStatusFailed,
+	db.SitesStatusSuspended,
+}
+
+const gib = 1 << 30
+
+// Columns are the CSV export's header row
+var Columns = []string{
+	"site_id", "site_name", "status", "organization_id", "organization_name", "project_id", "project_name",
+	"external_ip", "last_checkin_at", "controller_version", "pending_reconciliations",
+	"os_image", "os_version", "os_patch_status", "disk_used_bytes", "disk_size_bytes", "disk_used_percent",
+}
+
+// Params builds the ListFleetSites parameters for a filter. A VM is stale when
+// it hasn't checked in for filter.StaleMinutes as of now.
+func Params(filter *libopsv1.FleetFilter, now time.Time, limit, offset int32) (db.ListFleetSitesParams, error) {
+	params := db.ListFleetSitesParams{Limit: limit, Offset: offset}
+	if filter == nil {
+		return params, nil
+	}
+
+	if filter.OrganizationId != "" {
+		if _, err := uuid.Parse(filter.OrganizationId); err != nil {
+			return params, fmt.Errorf("invalid organization_id")
+		}
+		params.OrganizationID = sql.NullString{String: filter.OrganizationId, Valid: true}
+	}
+	if filter.Status != nil {
+		status := db.SitesStatus(filter.GetStatus())
+		if !slices.Contains(siteStatuses, status) {
+			return params, fmt.Errorf("unknown status %q", filter.GetStatus())
+		}
+		params.Status = db.NullSitesStatus{SitesStatus: status, Valid: true}
+	}
+	if filter.ControllerVersion != nil {
+		params.ControllerVersion = sql.NullString{String: filter.GetControllerVersion(), Valid: true}
+	}
+	if filter.StaleMinutes < 0 {
+		return params, fmt.Errorf("stale_minutes must not be negative")
+	}
+	if filter.StaleMinutes > 0 {
+		params.CheckinBefore = sql.NullTime{Time: now.Add(-time.Duration(filter.StaleMinutes) * time.Minute), Valid: true}
+	}
+	if filter.MinDiskUsedPercent < 0 || filter.MinDiskUsedPercent > 100 {
+		return params, fmt.Errorf("min_disk_used_percent must be between 0 and 100")
+	}
+	if filter.MinDiskUsedPercent > 0 {
+		params.MinDiskUsedPercent = sql.NullInt32{Int32: filter.MinDiskUsedPercent, Valid: true}
+	}
+	if filter.OsPatchStatus != nil {
+		if !slices.Contains(PatchStatuses, filter.GetOsPatchStatus()) {
+			return params, fmt.Errorf("unknown os_patch_status %q", filter.GetOsPatchStatus())
+		}
+		params.OsPatchStatus = sql.NullString{String: filter.GetOsPatchStatus(), Valid: true}
+	}
+	if filter.LabelSelector != nil {
+		selector, err := service.ParseLabelSelector(filter.LabelSelector)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return params, errors.New(connectErr.Message())
+			}
+			return params, err
+		}
+		params.LabelSelector = selector
+	}
+	params.PendingOnly = filter.PendingReconciliations
+	return params, nil
+}
+
+// ToProto converts a ListFleetSites row.
+func ToProto(row db.ListFleetSitesRow) *libopsv1.FleetSite {
+	site := &libopsv1.FleetSite{
+		SiteId:                 row.PublicID,
+		SiteName:               row.Name,
+		Status:                 string(row.Status.SitesStatus),
+		ProjectId:              row.ProjectPublicID,
+		ProjectName:            row.ProjectName,
+		OrganizationId:         row.OrganizationPublicID,
+		OrganizationName:       row.OrganizationName,
+		ExternalIp:             row.GcpExternalIp.String,
+		ControllerVersion:      row.ControllerVersion.String,
+		PendingReconciliations: row.PendingReconciliations,
+		OsImage:                row.Os.String,
+		OsVersion:              row.OsVersion.String,
+		OsPatchStatus:          row.OsPatchStatus,
+		DiskUsedBytes:          row.DiskUsedBytes.Int64,
+	}
+	if row.CheckinAt.Valid {
+		site.LastCheckinAt = row.CheckinAt.Time.Unix()
+	}
+	if row.DiskSizeGb.Valid && row.DiskSizeGb.Int32 > 0 {
+		site.DiskSizeBytes = int64(row.DiskSizeGb.Int32) * gib
+		site.DiskUsedPercent = float64(site.DiskUsedBytes) * 100 / float64(site.DiskSizeBytes)
+	}
+	return site
+}
+
+// Row returns a VM's cells in Columns order.
+func Row(site *libopsv1.FleetSite) []string {
+	lastCheckin := ""
+	if site.LastCheckinAt > 0 {
+		lastCheckin = time.Unix(site.LastCheckinAt, 0).UTC().Format(time.RFC3339)
+	}
+	diskSize, diskUsedPercent := "", ""
+	if site.DiskSizeBytes > 0 {
+		diskSize = strconv.FormatInt(site.DiskSizeBytes, 10)
+		diskUsedPercent = strconv.FormatFloat(site.DiskUsedPercent, 'f', 1, 64)
+	}
+	return []string{
+		site.SiteId, site.SiteName, site.Status, site.OrganizationId, site.OrganizationName, site.ProjectId, site.ProjectName,
+		site.ExternalIp, lastCheckin, site.ControllerVersion, strconv.FormatInt(site.PendingReconciliations, 10),
+		site.OsImage, site.OsVersion, site.OsPatchStatus, strconv.FormatInt(site.DiskUsedBytes, 10), diskSize, diskUsedPercent,
+	}
+}
+
+// WriteCSV writes the VMs with a header row. Cells that would be read as
+// formulas when the file is opened in a spreadsheet are quoted.
+func WriteCSV(w io.Writer, sites []*libopsv1.FleetSite) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(Columns); err != nil {
+		return err
+	}
+	for _, site := range sites {
+		cells := Row(site)
+		for i, cell := range cells {
+			if cell != "" && strings.ContainsRune("=+-@", rune(cell[0])) {
+				cells[i] = "'" + cell
+			}
+		}
+		if err := out.Write(cells); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
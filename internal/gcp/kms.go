@@ -0,0 +1,139 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// EncrypterDecrypterRole is the role a service agent needs on a customer-managed
+// key to encrypt and decrypt with it.
+const EncrypterDecrypterRole = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+
+// kmsKeyNamePattern matches a Cloud KMS key's resource name, capturing its location.
+var kmsKeyNamePattern = regexp.MustCompile(`^projects/[a-z][a-z0-9-]{4,28}[a-z0-9]/locations/([a-z0-9-]+)/keyRings/[A-Za-z0-9_-]{1,63}/cryptoKeys/[A-Za-z0-9_-]{1,63}$`)
+
+// KMSKeyLocation validates a Cloud KMS key's resource name and returns its location.
+// Keys in the global location can't encrypt Cloud Storage or Cloud SQL data.
+func KMSKeyLocation(name string) (string, error) {
+	match := kmsKeyNamePattern.FindStringSubmatch(name)
+	if match == nil {
+		return "", fmt.Errorf("key name must look like projects/PROJECT/locations/LOCATION/keyRings/KEY_RING/cryptoKeys/KEY")
+	}
+	if match[1] == "global" {
+		return "", fmt.Errorf("keys in the global location can't encrypt Cloud Storage or Cloud SQL data")
+	}
+	return match[1], nil
+}
+
+// StorageServiceAgent returns the account Cloud Storage encrypts a project's
+// buckets as.
+func StorageServiceAgent(projectNumber string) string {
+	return fmt.Sprintf("service-%s@gs-project-accounts.iam.gserviceaccount.com", projectNumber)
+}
+
+// CloudSQLServiceAgent returns the account Cloud SQL encrypts a project's
+// instances and backups as.
+func CloudSQLServiceAgent(projectNumber string) string {
+	return fmt.Sprintf("service-%s@gcp-sa-cloud-sql.iam.gserviceaccount.com", projectNumber)
+}
+
+// KMSKey is what's known about a Cloud KMS key
+type KMSKey struct {
+	Purpose      string   // ENCRYPT_DECRYPT for keys that can encrypt data
+	PrimaryState string   // State of the version new data is encrypted with, ENABLED when it can be used
+	Users        []string // Accounts granted EncrypterDecrypterRole on the key, without conditions
+}
+
+// ErrKMSKeyInaccessible is returned when a key doesn't exist or the API can't read it
+var ErrKMSKeyInaccessible = errors.New("key not found or not readable by the LibOps API; grant it roles/cloudkms.viewer on the key")
+
+// KMSKeys reads customer-managed keys.
+type KMSKeys interface {
+	// Key reads a key and who can encrypt with it
+	Key(ctx context.Context, name string) (*KMSKey, error)
+}
+
+// KMS reads keys from Cloud KMS. The API's identity needs roles/cloudkms.viewer
+// on the keys, which organizations grant when they supply one.
+type KMS struct {
+	keys *cloudkms.Service
+}
+
+// Compile-time check.
+var _ KMSKeys = (*KMS)(nil)
+
+// NewKMS creates a Cloud KMS client using application default credentials
+func NewKMS(ctx context.Context) (*KMS, error) {
+	keys, err := cloudkms.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud KMS client: %w", err)
+	}
+	return &KMS{keys: keys}, nil
+}
+
+// Key reads a key and who can encrypt with it
+func (k *KMS) Key(ctx context.Context, name string) (*KMSKey, error) {
+	cryptoKeys := k.keys.Projects.Locations.KeyRings.CryptoKeys
+	cryptoKey, err := cryptoKeys.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, kmsError(name, err)
+	}
+	policy, err := cryptoKeys.GetIamPolicy(name).Context(ctx).Do()
+	if err != nil {
+		return nil, kmsError(name, err)
+	}
+
+	key := &KMSKey{Purpose: cryptoKey.Purpose}
+	if cryptoKey.Primary != nil {
+		key.PrimaryState = cryptoKey.Primary.State
+	}
+	for _, binding := range policy.Bindings {
+		if binding.Role != EncrypterDecrypterRole || binding.Condition != nil {
+			continue
+		}
+		key.Users = append(key.Users, binding.Members...)
+	}
+	return key, nil
+}
+
+func kmsError(name string, err error) error {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && (apiErr.Code == http.StatusNotFound || apiErr.Code == http.StatusForbidden) {
+		return ErrKMSKeyInaccessible
+	}
+	return fmt.Errorf("failed to read %s: %w", name, err)
+}
+
+// CheckKMSKey checks that a key can encrypt data and that every agent may use
+// it, describing the first problem it finds.
+func CheckKMSKey(ctx context.Context, keys KMSKeys, name string, agents []string) error {
+	key, err := keys.Key(ctx, name)
+	if err != nil {
+		return err
+	}
+	if key.Purpose != "ENCRYPT_DECRYPT" {
+		return fmt.Errorf("key purpose is %s; it must be ENCRYPT_DECRYPT", key.Purpose)
+	}
+	if key.PrimaryState != "ENABLED" {
+		return fmt.Errorf("key's primary version is %s; it must be ENABLED", strings.ToLower(key.PrimaryState))
+	}
+
+	var missing []string
+	for _, agent := range agents {
+		if !slices.Contains(key.Users, "serviceAccount:"+agent) {
+			missing = append(missing, agent)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("grant %s on the key to %s", EncrypterDecrypterRole, strings.Join(missing, ", "))
+	}
+	return nil
+}
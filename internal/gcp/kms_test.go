@@ -0,0 +1,62 @@
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKMSKeyLocation tests key name validation.
+func TestKMSKeyLocation(t *testing.T) {
+	location, err := KMSKeyLocation("projects/campus-kms/locations/europe-west1/keyRings/libops/cryptoKeys/backups")
+	require.NoError(t, err)
+	assert.Equal(t, "europe-west1", location)
+
+	for _, name := range []string{
+		"",
+		"campus-kms/europe-west1/libops/backups",
+		"projects/campus-kms/locations/europe-west1/keyRings/libops",
+		"projects/campus-kms/locations/europe-west1/keyRings/libops/cryptoKeys/backups/cryptoKeyVersions/1",
+		"projects/campus-kms/locations/global/keyRings/libops/cryptoKeys/backups",
+	} {
+		_, err := KMSKeyLocation(name)
+		assert.Error(t, err, name)
+	}
+}
+
+type fakeKMSKeys map[string]*KMSKey
+
+func (f fakeKMSKeys) Key(ctx context.Context, name string) (*KMSKey, error) {
+	key, ok := f[name]
+	if !ok {
+		return nil, ErrKMSKeyInaccessible
+	}
+	return key, nil
+}
+
+// TestCheckKMSKey tests that a key has to be enabled and usable by every agent.
+func TestCheckKMSKey(t *testing.T) {
+	storage := StorageServiceAgent("111")
+	cloudSQL := CloudSQLServiceAgent("222")
+	keys := fakeKMSKeys{
+		"granted":  {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "ENABLED", Users: []string{"serviceAccount:" + storage, "serviceAccount:" + cloudSQL}},
+		"partly":   {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "ENABLED", Users: []string{"serviceAccount:" + storage}},
+		"disabled": {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "DISABLED", Users: []string{"serviceAccount:" + storage, "serviceAccount:" + cloudSQL}},
+		"signing":  {Purpose: "ASYMMETRIC_SIGN"},
+	}
+	agents := []string{storage, cloudSQL}
+
+	assert.NoError(t, CheckKMSKey(context.Background(), keys, "granted", agents))
+	assert.NoError(t, CheckKMSKey(context.Background(), keys, "partly", nil), "nothing needs it yet")
+
+	err := CheckKMSKey(context.Background(), keys, "partly", agents)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), cloudSQL)
+	assert.NotContains(t, err.Error(), storage)
+
+	assert.ErrorContains(t, CheckKMSKey(context.Background(), keys, "disabled", agents), "disabled")
+	assert.ErrorContains(t, CheckKMSKey(context.Background(), keys, "signing", agents), "ENCRYPT_DECRYPT")
+	assert.ErrorIs(t, CheckKMSKey(context.Background(), keys, "missing", agents), ErrKMSKeyInaccessible)
+}
@@ -0,0 +1,201 @@
+// Package health implements liveness and readiness probes with per-dependency checks.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+
+	"github.com/libops/api/db"
+)
+
+// DefaultTimeout bounds how long a single readiness check may take.
+const DefaultTimeout = 3 * time.Second
+
+// maxEventQueueLag is how old the oldest pending event may be before the
+// event publisher is reported as unhealthy.
+const maxEventQueueLag = 15 * time.Minute
+
+// Check is a single dependency probe.
+type Check struct {
+	// Name identifies the dependency in the readiness response (e.g. "database").
+	Name string
+	// Critical checks fail readiness; non-critical checks only report degraded.
+	Critical bool
+	// Fn returns nil when the dependency is healthy.
+	Fn func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of a single check.
+type CheckResult struct {
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is the JSON body returned by the readiness endpoint.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// Status values used in reports.
+const (
+	StatusOK       = "ok"
+	StatusDegraded = "degraded"
+	StatusFail     = "fail"
+)
+
+// Handler serves /healthz and /readyz.
+type Handler struct {
+	checks  []Check
+	timeout time.Duration
+}
+
+// NewHandler creates a health handler for the given checks.
+func NewHandler(checks ...Check) *Handler {
+	return &Handler{checks: checks, timeout: DefaultTimeout}
+}
+
+// Liveness reports that the process is running. It never touches
+// dependencies so a database outage does not cause Cloud Run to restart
+// otherwise healthy instances.
+func (h *Handler) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+}
+
+// Readiness runs every check concurrently and returns 503 if any critical
+// dependency is unavailable.
+func (h *Handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := h.Run(r.Context())
+
+	code := http.StatusOK
+	if report.Status == StatusFail {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, report)
+}
+
+// Run executes all checks and aggregates the results.
+func (h *Handler) Run(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(h.checks))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func(check Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Fn(checkCtx)
+			result := CheckResult{
+				Status:    StatusOK,
+				Critical:  check.Critical,
+				LatencyMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				result.Status = StatusFail
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[check.Name] = result
+			if err != nil {
+				if check.Critical {
+					report.Status = StatusFail
+				} else if report.Status == StatusOK {
+					report.Status = StatusDegraded
+				}
+			}
+		}(check)
+	}
+	wg.Wait()
+
+	return report
+}
+
+// DatabaseCheck pings a connection pool.
+func DatabaseCheck(name string, pool *sql.DB) Check {
+	return Check{
+		Name:     name,
+		Critical: true,
+		Fn: func(ctx context.Context) error {
+			return pool.PingContext(ctx)
+		},
+	}
+}
+
+// VaultCheck verifies Vault is reachable, initialized and unsealed.
+func VaultCheck(client *vaultapi.Client) Check {
+	return Check{
+		Name:     "vault",
+		Critical: true,
+		Fn: func(ctx context.Context) error {
+			resp, err := client.Sys().HealthWithContext(ctx)
+			if err != nil {
+				return err
+			}
+			if !resp.Initialized {
+				return fmt.Errorf("vault is not initialized")
+			}
+			if resp.Sealed {
+				return fmt.Errorf("vault is sealed")
+			}
+			return nil
+		},
+	}
+}
+
+// EventQueueCheck reports whether events the API emits are being relayed from
+// the event_outbox and then picked up and published to Pub/Sub by the
+// orchestrator. A stuck relay or publisher degrades readiness but does not fail
+// it, since the API keeps accepting writes and the backlog drains once they
+// resume.
+func EventQueueCheck(queries db.Querier) Check {
+	return Check{
+		Name:     "event_publisher",
+		Critical: false,
+		Fn: func(ctx context.Context) error {
+			unrelayed, err := queries.GetOldestOutboxEvent(ctx)
+			if err == nil {
+				if lag := time.Since(unrelayed.CreatedAt); lag > maxEventQueueLag {
+					return fmt.Errorf("oldest unrelayed event is %s old", lag.Round(time.Second))
+				}
+			} else if !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to read event outbox: %w", err)
+			}
+
+			pending, err := queries.GetPendingEvents(ctx, 1)
+			if err != nil {
+				return fmt.Errorf("failed to read event queue: %w", err)
+			}
+			if len(pending) == 0 {
+				return nil
+			}
+			if lag := time.Since(pending[0].CreatedAt); lag > maxEventQueueLag {
+				return fmt.Errorf("oldest pending event is %s old", lag.Round(time.Second))
+			}
+			return nil
+		},
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
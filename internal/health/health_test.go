@@ -0,0 +1,41 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestRun_AggregatesStatus verifies how critical and non-critical failures affect the overall status.
+func TestRun_AggregatesStatus(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("down") }
+
+	tests := []struct {
+		name   string
+		checks []Check
+		want   string
+	}{
+		{"no checks", nil, StatusOK},
+		{"all healthy", []Check{{Name: "database", Critical: true, Fn: ok}, {Name: "event_publisher", Fn: ok}}, StatusOK},
+		{"non-critical failure", []Check{{Name: "database", Critical: true, Fn: ok}, {Name: "event_publisher", Fn: fail}}, StatusDegraded},
+		{"critical failure", []Check{{Name: "database", Critical: true, Fn: fail}, {Name: "event_publisher", Fn: fail}}, StatusFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewHandler(tt.checks...).Run(context.Background())
+			if report.Status != tt.want {
+				t.Errorf("Run() status = %q, want %q", report.Status, tt.want)
+			}
+			if len(report.Checks) != len(tt.checks) {
+				t.Errorf("Run() returned %d results, want %d", len(report.Checks), len(tt.checks))
+			}
+			for _, check := range tt.checks {
+				if check.Fn(context.Background()) != nil && report.Checks[check.Name].Error == "" {
+					t.Errorf("expected error message for failing check %q", check.Name)
+				}
+			}
+		})
+	}
+}
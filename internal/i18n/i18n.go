@@ -0,0 +1,120 @@
+// Package i18n translates the dashboard's templates. Each supported locale has a
+// message catalog in locales/<locale>.json mapping message IDs to text; messages
+// with arguments use indexed fmt verbs such as %[1]d so translations can reorder them.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Default is the locale used when nothing better matches, and the fallback for
+// messages missing from another catalog.
+const Default = "en"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+var (
+	catalogs  = map[string]map[string]string{}
+	supported []string
+	matcher   language.Matcher
+)
+
+func init() {
+	files, err := localeFS.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to read catalogs: %v", err))
+	}
+	for _, file := range files {
+		data, err := localeFS.ReadFile(path.Join("locales", file.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", file.Name(), err))
+		}
+		messages := map[string]string{}
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: invalid catalog %s: %v", file.Name(), err))
+		}
+		catalogs[strings.TrimSuffix(file.Name(), ".json")] = messages
+	}
+
+	// The matcher falls back to its first tag, so the default goes first
+	supported = append(supported, Default)
+	for locale := range catalogs {
+		if locale != Default {
+			supported = append(supported, locale)
+		}
+	}
+	slices.Sort(supported[1:])
+
+	tags := make([]language.Tag, 0, len(supported))
+	for _, locale := range supported {
+		tags = append(tags, language.Make(locale))
+	}
+	matcher = language.NewMatcher(tags)
+}
+
+// Supported returns the locales that have a catalog, the default first.
+func Supported() []string {
+	return slices.Clone(supported)
+}
+
+// IsSupported reports whether locale has a catalog.
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Negotiate picks the locale to render a page in: the account's preferred locale
+// when it has one, otherwise the closest match to the browser's Accept-Language
+// header, otherwise the default.
+func Negotiate(preferred, acceptLanguage string) string {
+	if IsSupported(preferred) {
+		return preferred
+	}
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	return supported[index]
+}
+
+// T returns the message with the given ID in locale, formatted with args. Messages
+// missing from the locale's catalog fall back to the default locale, then to the ID.
+func T(locale, id string, args ...any) string {
+	message, ok := catalogs[locale][id]
+	if !ok {
+		message, ok = catalogs[Default][id]
+	}
+	if !ok {
+		return id
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// Messages returns the messages in locale whose IDs start with one of prefixes,
+// for pages that render text in the browser.
+func Messages(locale string, prefixes ...string) map[string]string {
+	messages := map[string]string{}
+	for id := range catalogs[Default] {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(id, prefix) {
+				messages[id] = T(locale, id)
+			}
+		}
+	}
+	return messages
+}
@@ -0,0 +1,60 @@
+package i18n
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var verbPattern = regexp.MustCompile(`%\[\d+\][a-z]`)
+
+// TestCatalogs tests that every catalog translates every message with the same
+// arguments as the default catalog.
+func TestCatalogs(t *testing.T) {
+	for locale, messages := range catalogs {
+		for id, message := range catalogs[Default] {
+			translated, ok := messages[id]
+			if !assert.True(t, ok, "%s is missing %s", locale, id) {
+				continue
+			}
+			assert.ElementsMatch(t, verbPattern.FindAllString(message, -1), verbPattern.FindAllString(translated, -1),
+				"%s: %s has different arguments", locale, id)
+		}
+		for id := range messages {
+			_, ok := catalogs[Default][id]
+			assert.True(t, ok, "%s has %s, which isn't in the default catalog", locale, id)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name, preferred, acceptLanguage, want string
+	}{
+		{"account preference wins", "fr", "es-ES,es;q=0.9", "fr"},
+		{"unsupported preference", "xx", "es-MX,en;q=0.5", "es"},
+		{"regional variant", "", "fr-CA", "fr"},
+		{"weights", "", "de;q=0.9,fr;q=0.8,en;q=0.1", "fr"},
+		{"no match", "", "ja", Default},
+		{"no header", "", "", Default},
+		{"malformed header", "", ";;;", Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Negotiate(tt.preferred, tt.acceptLanguage))
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "Étape 2 sur 7", T("fr", "onboarding.progress", 2, 7))
+	assert.Equal(t, "Step 2 of 7", T("xx", "onboarding.progress", 2, 7), "unknown locales use the default catalog")
+	assert.Equal(t, "missing.id", T("es", "missing.id"))
+	assert.Equal(t, Default, Supported()[0])
+
+	messages := Messages("es", "onboarding.", "common.")
+	assert.Equal(t, "Paso %[1]d de %[2]d", messages["onboarding.progress"])
+	assert.Equal(t, "Continuar", messages["common.continue"])
+	assert.NotContains(t, messages, "login.sign_in")
+}
@@ -0,0 +1,263 @@
+// Package idempotency makes mutating RPCs safe to retry by replaying the
+// original response for requests that carry a previously seen Idempotency-Key.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+)
+
+const (
+	// HeaderKey is the request header clients use to supply an idempotency key.
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses served from a stored result.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	// TTL is how long a key and its response are retained.
+	TTL = 24 * time.Hour
+
+	// maxKeyLength matches the idempotency_keys.idempotency_key column.
+	maxKeyLength = 255
+
+	// pendingTimeout is how long an in-flight key blocks retries before it is
+	// considered abandoned (e.g. the instance died mid-request).
+	pendingTimeout = 2 * time.Minute
+)
+
+// AccountIDExtractor extracts the authenticated account ID from context.
+// Injected to avoid import cycles with the auth package.
+type AccountIDExtractor func(ctx context.Context) (int64, bool)
+
+// Interceptor stores the result of Create* RPCs keyed by account, procedure
+// and Idempotency-Key, and replays it for retries within TTL.
+type Interceptor struct {
+	db                 db.Querier
+	accountIDExtractor AccountIDExtractor
+	now                func() time.Time
+}
+
+// NewInterceptor creates a new idempotency interceptor.
+func NewInterceptor(querier db.Querier, accountIDExtractor AccountIDExtractor) *Interceptor {
+	return &Interceptor{
+		db:                 querier,
+		accountIDExtractor: accountIDExtractor,
+		now:                time.Now,
+	}
+}
+
+// IsReplay reports whether a response was served from a stored result, so
+// side-effecting interceptors (audit, events) can skip duplicate work.
+func IsReplay(resp connect.AnyResponse) bool {
+	return resp != nil && resp.Header().Get(HeaderReplayed) == "true"
+}
+
+// WrapUnary wraps unary RPCs with idempotency handling.
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		key := strings.TrimSpace(req.Header().Get(HeaderKey))
+		if key == "" || !appliesTo(req.Spec().Procedure) {
+			return next(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s must be at most %d characters", HeaderKey, maxKeyLength))
+		}
+
+		accountID, ok := i.accountIDExtractor(ctx)
+		if !ok {
+			// Keys are scoped per account; without one there is nothing safe to replay.
+			return next(ctx, req)
+		}
+
+		msg, ok := req.Any().(proto.Message)
+		if !ok {
+			return next(ctx, req)
+		}
+		hash, err := requestHash(msg)
+		if err != nil {
+			slog.Error("idempotency: failed to hash request", "procedure", req.Spec().Procedure, "err", err)
+			return next(ctx, req)
+		}
+
+		// Key bookkeeping must not be served from a lagging read replica.
+		primaryCtx := database.WithPrimary(ctx)
+		params := db.GetIdempotencyKeyParams{
+			AccountID:      accountID,
+			ProcedureName:  req.Spec().Procedure,
+			IdempotencyKey: key,
+		}
+
+		recordID, replay, err := i.begin(primaryCtx, req, params, hash)
+		if err != nil {
+			return nil, err
+		}
+		if replay != nil {
+			return replay, nil
+		}
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			// Failed requests are not recorded so the client can retry with the same key.
+			if delErr := i.db.DeleteIdempotencyKey(primaryCtx, recordID); delErr != nil {
+				slog.Error("idempotency: failed to release key", "procedure", req.Spec().Procedure, "err", delErr)
+			}
+			return resp, err
+		}
+
+		i.complete(primaryCtx, req.Spec().Procedure, recordID, resp)
+		return resp, nil
+	}
+}
+
+// WrapStreamingClient wraps client streaming RPCs.
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler wraps server streaming RPCs.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// begin claims the key for this request. It returns the claimed record ID, or
+// a replayed response if the key already completed with an identical request.
+func (i *Interceptor) begin(ctx context.Context, req connect.AnyRequest, params db.GetIdempotencyKeyParams, hash string) (int64, connect.AnyResponse, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		err := i.db.CreateIdempotencyKey(ctx, db.CreateIdempotencyKeyParams{
+			AccountID:      params.AccountID,
+			ProcedureName:  params.ProcedureName,
+			IdempotencyKey: params.IdempotencyKey,
+			RequestHash:    hash,
+			ExpiresAt:      i.now().Add(TTL),
+		})
+		if err != nil && !isDuplicateKeyError(err) {
+			slog.Error("idempotency: failed to store key", "procedure", params.ProcedureName, "err", err)
+			return 0, nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+		}
+
+		record, getErr := i.db.GetIdempotencyKey(ctx, params)
+		if getErr != nil {
+			if errors.Is(getErr, sql.ErrNoRows) && attempt == 0 {
+				continue
+			}
+			slog.Error("idempotency: failed to load key", "procedure", params.ProcedureName, "err", getErr)
+			return 0, nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+		}
+
+		if err == nil {
+			return record.ID, nil, nil
+		}
+
+		// The key already exists.
+		if i.isStale(record) {
+			if delErr := i.db.DeleteIdempotencyKey(ctx, record.ID); delErr != nil {
+				slog.Error("idempotency: failed to remove stale key", "procedure", params.ProcedureName, "err", delErr)
+			}
+			continue
+		}
+		if record.RequestHash != hash {
+			return 0, nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("%s has already been used with a different request", HeaderKey))
+		}
+		if record.Status != db.IdempotencyKeysStatusCompleted {
+			return 0, nil, connect.NewError(connect.CodeAborted,
+				fmt.Errorf("a request with this %s is still in progress", HeaderKey))
+		}
+
+		replay, replayErr := replayResponse(req, record)
+		if replayErr != nil {
+			slog.Error("idempotency: failed to replay response", "procedure", params.ProcedureName, "err", replayErr)
+			return 0, nil, connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+		}
+		return 0, replay, nil
+	}
+
+	return 0, nil, connect.NewError(connect.CodeAborted, fmt.Errorf("a request with this %s is still in progress", HeaderKey))
+}
+
+// complete stores the successful response for future replays.
+func (i *Interceptor) complete(ctx context.Context, procedure string, recordID int64, resp connect.AnyResponse) {
+	msg, ok := resp.Any().(proto.Message)
+	if !ok {
+		return
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		slog.Error("idempotency: failed to marshal response", "procedure", procedure, "err", err)
+		return
+	}
+	if err := i.db.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		ResponseBody: sql.NullString{String: string(body), Valid: true},
+		ID:           recordID,
+	}); err != nil {
+		slog.Error("idempotency: failed to store response", "procedure", procedure, "err", err)
+	}
+}
+
+// isStale reports whether an existing key can be discarded and reclaimed.
+func (i *Interceptor) isStale(record db.IdempotencyKey) bool {
+	now := i.now()
+	if now.After(record.ExpiresAt) {
+		return true
+	}
+	return record.Status == db.IdempotencyKeysStatusPending &&
+		record.CreatedAt.Valid && now.Sub(record.CreatedAt.Time) > pendingTimeout
+}
+
+// replayResponse rebuilds the stored response using the method's output
+// descriptor. The handler only needs a proto.Message to serialize, so a
+// dynamic message is sufficient and avoids a per-RPC type registry.
+func replayResponse(req connect.AnyRequest, record db.IdempotencyKey) (connect.AnyResponse, error) {
+	method, ok := req.Spec().Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("procedure %s has no schema", req.Spec().Procedure)
+	}
+
+	msg := dynamicpb.NewMessage(method.Output())
+	if err := proto.Unmarshal([]byte(record.ResponseBody.String), msg); err != nil {
+		return nil, err
+	}
+
+	resp := connect.NewResponse(msg)
+	resp.Header().Set(HeaderReplayed, "true")
+	return resp, nil
+}
+
+// appliesTo reports whether a procedure accepts idempotency keys.
+func appliesTo(procedure string) bool {
+	return strings.HasPrefix(path.Base(procedure), "Create")
+}
+
+func requestHash(msg proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// isDuplicateKeyError checks if an error is a MySQL duplicate key error (1062).
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1062
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const createSshKeyProcedure = "/libops.v1.SshKeyService/CreateSshKey"
+
+// keyStore is an in-memory stand-in for the idempotency_keys table.
+type keyStore struct {
+	testutils.MockQuerier
+	mu      sync.Mutex
+	nextID  int64
+	records map[db.GetIdempotencyKeyParams]db.IdempotencyKey
+}
+
+func newKeyStore() *keyStore {
+	return &keyStore{records: make(map[db.GetIdempotencyKeyParams]db.IdempotencyKey)}
+}
+
+func (s *keyStore) CreateIdempotencyKey(ctx context.Context, arg db.CreateIdempotencyKeyParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := db.GetIdempotencyKeyParams{AccountID: arg.AccountID, ProcedureName: arg.ProcedureName, IdempotencyKey: arg.IdempotencyKey}
+	if _, ok := s.records[k]; ok {
+		return &mysql.MySQLError{Number: 1062}
+	}
+	s.nextID++
+	s.records[k] = db.IdempotencyKey{
+		ID:             s.nextID,
+		AccountID:      arg.AccountID,
+		ProcedureName:  arg.ProcedureName,
+		IdempotencyKey: arg.IdempotencyKey,
+		RequestHash:    arg.RequestHash,
+		Status:         db.IdempotencyKeysStatusPending,
+		ExpiresAt:      arg.ExpiresAt,
+	}
+	return nil
+}
+
+func (s *keyStore) GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[arg]
+	if !ok {
+		return db.IdempotencyKey{}, sql.ErrNoRows
+	}
+	return record, nil
+}
+
+func (s *keyStore) CompleteIdempotencyKey(ctx context.Context, arg db.CompleteIdempotencyKeyParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, record := range s.records {
+		if record.ID == arg.ID {
+			record.Status = db.IdempotencyKeysStatusCompleted
+			record.ResponseBody = arg.ResponseBody
+			s.records[k] = record
+		}
+	}
+	return nil
+}
+
+func (s *keyStore) DeleteIdempotencyKey(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, record := range s.records {
+		if record.ID == id {
+			delete(s.records, k)
+		}
+	}
+	return nil
+}
+
+func newTestClient(t *testing.T, store db.Querier, handler func(context.Context, *connect.Request[libopsv1.CreateSshKeyRequest]) (*connect.Response[libopsv1.CreateSshKeyResponse], error)) *connect.Client[libopsv1.CreateSshKeyRequest, libopsv1.CreateSshKeyResponse] {
+	t.Helper()
+
+	interceptor := NewInterceptor(store, func(ctx context.Context) (int64, bool) { return 42, true })
+	method := libopsv1.File_libops_v1_organization_api_proto.Services().ByName("SshKeyService").Methods().ByName("CreateSshKey")
+
+	mux := http.NewServeMux()
+	mux.Handle(createSshKeyProcedure, connect.NewUnaryHandler(createSshKeyProcedure, handler,
+		connect.WithSchema(method),
+		connect.WithInterceptors(interceptor),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return connect.NewClient[libopsv1.CreateSshKeyRequest, libopsv1.CreateSshKeyResponse](server.Client(), server.URL+createSshKeyProcedure)
+}
+
+func newRequest(key, publicKey string) *connect.Request[libopsv1.CreateSshKeyRequest] {
+	req := connect.NewRequest(&libopsv1.CreateSshKeyRequest{AccountId: "acct", PublicKey: publicKey})
+	req.Header().Set(HeaderKey, key)
+	return req
+}
+
+// TestInterceptor_ReplaysOriginalResponse verifies a retried key returns the stored response without re-running the handler.
+func TestInterceptor_ReplaysOriginalResponse(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, newKeyStore(), func(ctx context.Context, req *connect.Request[libopsv1.CreateSshKeyRequest]) (*connect.Response[libopsv1.CreateSshKeyResponse], error) {
+		calls++
+		return connect.NewResponse(&libopsv1.CreateSshKeyResponse{
+			SshKey: &libopsv1.SshKey{KeyId: "key-1", PublicKey: req.Msg.PublicKey},
+		}), nil
+	})
+	ctx := context.Background()
+
+	first, err := client.CallUnary(ctx, newRequest("retry-1", "ssh-ed25519 AAAA"))
+	if err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	second, err := client.CallUnary(ctx, newRequest("retry-1", "ssh-ed25519 AAAA"))
+	if err != nil {
+		t.Fatalf("replayed call failed: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected handler to run once, ran %d times", calls)
+	}
+	if !proto.Equal(first.Msg, second.Msg) {
+		t.Errorf("replayed response %v differs from original %v", second.Msg, first.Msg)
+	}
+	if second.Header().Get(HeaderReplayed) != "true" {
+		t.Errorf("expected %s header on replayed response", HeaderReplayed)
+	}
+}
+
+// TestInterceptor_RejectsKeyReuseWithDifferentRequest verifies a key cannot be reused for a different payload.
+func TestInterceptor_RejectsKeyReuseWithDifferentRequest(t *testing.T) {
+	client := newTestClient(t, newKeyStore(), func(ctx context.Context, req *connect.Request[libopsv1.CreateSshKeyRequest]) (*connect.Response[libopsv1.CreateSshKeyResponse], error) {
+		return connect.NewResponse(&libopsv1.CreateSshKeyResponse{}), nil
+	})
+	ctx := context.Background()
+
+	if _, err := client.CallUnary(ctx, newRequest("retry-2", "ssh-ed25519 AAAA")); err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	_, err := client.CallUnary(ctx, newRequest("retry-2", "ssh-ed25519 BBBB"))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// TestInterceptor_FailedRequestReleasesKey verifies errors are not stored so the client can retry.
+func TestInterceptor_FailedRequestReleasesKey(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, newKeyStore(), func(ctx context.Context, req *connect.Request[libopsv1.CreateSshKeyRequest]) (*connect.Response[libopsv1.CreateSshKeyResponse], error) {
+		calls++
+		if calls == 1 {
+			return nil, connect.NewError(connect.CodeUnavailable, nil)
+		}
+		return connect.NewResponse(&libopsv1.CreateSshKeyResponse{}), nil
+	})
+	ctx := context.Background()
+
+	if _, err := client.CallUnary(ctx, newRequest("retry-3", "ssh-ed25519 AAAA")); err == nil {
+		t.Fatal("expected first call to fail")
+	}
+	if _, err := client.CallUnary(ctx, newRequest("retry-3", "ssh-ed25519 AAAA")); err != nil {
+		t.Fatalf("retry after failure failed: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected handler to run twice, ran %d times", calls)
+	}
+}
@@ -0,0 +1,209 @@
+package incident
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+)
+
+// opsgenieMessageLimit is the longest alert message Opsgenie accepts
+const opsgenieMessageLimit = 130
+
+// Page is an incident as sent to PagerDuty and Opsgenie
+type Page struct {
+	IncidentID string // PagerDuty dedup key and Opsgenie alias, so resolves match triggers
+	SiteName   string
+	Summary    string
+	Link       string // Dashboard URL, empty when no base URL is configured
+	Test       bool   // Test pages are low priority and resolved right away
+}
+
+// Escalator delivers pages to an organization's PagerDuty and Opsgenie channels
+type Escalator struct {
+	db           db.Querier
+	client       *http.Client
+	pagerDutyURL string
+	opsgenieURL  string
+}
+
+// NewEscalator creates an escalator using the public PagerDuty and Opsgenie APIs
+func NewEscalator(querier db.Querier) *Escalator {
+	return &Escalator{
+		db:           querier,
+		client:       &http.Client{Timeout: 10 * time.Second},
+		pagerDutyURL: "https://events.pagerduty.com/v2/enqueue",
+		opsgenieURL:  "https://api.opsgenie.com/v2/alerts",
+	}
+}
+
+// Trigger opens the page on every escalation channel of the organization
+func (e *Escalator) Trigger(ctx context.Context, organizationID int64, page Page) {
+	e.deliver(ctx, organizationID, page, true)
+}
+
+// Resolve closes the page on every escalation channel of the organization
+func (e *Escalator) Resolve(ctx context.Context, organizationID int64, page Page) {
+	e.deliver(ctx, organizationID, page, false)
+}
+
+// Test sends a low-priority page to one channel and resolves it right away.
+// The outcome is recorded on the channel like any other delivery.
+func (e *Escalator) Test(ctx context.Context, channelID int64, kind db.NotificationChannelsKind, integrationKey, organizationName string) error {
+	page := Page{
+		IncidentID: uuid.New().String(),
+		SiteName:   organizationName,
+		Summary:    fmt.Sprintf("Test page from LibOps for %s", organizationName),
+		Test:       true,
+	}
+	err := e.send(ctx, kind, integrationKey, page, true)
+	if err == nil {
+		err = e.send(ctx, kind, integrationKey, page, false)
+	}
+	e.record(ctx, channelID, err)
+	return err
+}
+
+func (e *Escalator) deliver(ctx context.Context, organizationID int64, page Page, trigger bool) {
+	channels, err := e.db.ListEscalationChannels(ctx, organizationID)
+	if err != nil {
+		slog.Error("Failed to list escalation channels", "error", err, "organization_id", organizationID)
+		return
+	}
+	for _, channel := range channels {
+		err := e.send(ctx, channel.Kind, channel.IntegrationKey, page, trigger)
+		if err != nil {
+			slog.Warn("Failed to deliver page",
+				"incident_id", page.IncidentID,
+				"channel_id", channel.PublicID,
+				"kind", channel.Kind,
+				"error", err)
+		}
+		e.record(ctx, channel.ID, err)
+	}
+}
+
+func (e *Escalator) record(ctx context.Context, channelID int64, deliveryErr error) {
+	lastError := sql.NullString{}
+	if deliveryErr != nil {
+		lastError = sql.NullString{String: deliveryErr.Error(), Valid: true}
+	}
+	err := e.db.RecordNotificationChannelDelivery(ctx, db.RecordNotificationChannelDeliveryParams{
+		LastError: lastError,
+		ID:        channelID,
+	})
+	if err != nil {
+		slog.Error("Failed to record page delivery", "error", err, "channel_id", channelID)
+	}
+}
+
+func (e *Escalator) send(ctx context.Context, kind db.NotificationChannelsKind, integrationKey string, page Page, trigger bool) error {
+	switch kind {
+	case db.NotificationChannelsKindPagerduty:
+		return e.sendPagerDuty(ctx, integrationKey, page, trigger)
+	case db.NotificationChannelsKindOpsgenie:
+		return e.sendOpsgenie(ctx, integrationKey, page, trigger)
+	default:
+		return fmt.Errorf("unsupported escalation channel kind %q", kind)
+	}
+}
+
+// sendPagerDuty enqueues a PagerDuty Events API v2 trigger or resolve event
+func (e *Escalator) sendPagerDuty(ctx context.Context, routingKey string, page Page, trigger bool) error {
+	event := map[string]any{
+		"routing_key":  routingKey,
+		"event_action": "resolve",
+		"dedup_key":    page.IncidentID,
+	}
+	if trigger {
+		severity := "critical"
+		if page.Test {
+			severity = "info"
+		}
+		event["event_action"] = "trigger"
+		event["payload"] = map[string]any{
+			"summary":   page.Summary,
+			"source":    page.SiteName,
+			"severity":  severity,
+			"component": "site",
+		}
+		if page.Link != "" {
+			event["links"] = []map[string]string{{"href": page.Link, "text": "Open in LibOps"}}
+		}
+	}
+	return e.post(ctx, e.pagerDutyURL, "", event)
+}
+
+// sendOpsgenie creates an Opsgenie alert, or closes it by alias
+func (e *Escalator) sendOpsgenie(ctx context.Context, apiKey string, page Page, trigger bool) error {
+	if !trigger {
+		endpoint := e.opsgenieURL + "/" + url.PathEscape(page.IncidentID) + "/close?identifierType=alias"
+		return e.post(ctx, endpoint, apiKey, map[string]any{"source": "LibOps", "note": page.Summary})
+	}
+
+	priority := "P1"
+	if page.Test {
+		priority = "P5"
+	}
+	message := page.Summary
+	if len(message) > opsgenieMessageLimit {
+		message = message[:opsgenieMessageLimit-1] + "…"
+	}
+	alert := map[string]any{
+		"message":     message,
+		"alias":       page.IncidentID,
+		"description": page.Summary,
+		"priority":    priority,
+		"source":      "LibOps",
+		"entity":      page.SiteName,
+	}
+	if page.Link != "" {
+		alert["details"] = map[string]string{"link": page.Link}
+	}
+	return e.post(ctx, e.opsgenieURL, apiKey, alert)
+}
+
+// post sends a JSON payload; a non-empty apiKey is sent as an Opsgenie GenieKey
+func (e *Escalator) post(ctx context.Context, endpoint, apiKey string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode page: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("Authorization", "GenieKey "+apiKey)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("integration returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+// healthProbeWindow is how far back probes count towards a site's health score
+const healthProbeWindow = time.Hour
+
+// Component weights of the health score. Components without data are left out
+// and the rest are weighted up to 100.
+const (
+	checkinWeight    = 30
+	containerWeight  = 25
+	probeWeight      = 30
+	deploymentWeight = 15
+)
+
+// Health is a site's computed health score and the components it came from,
+// each 0-100. Components are unset when the site has no data for them.
+type Health struct {
+	Score      int32
+	Checkin    sql.NullInt32
+	Container  sql.NullInt32
+	Probe      sql.NullInt32
+	Deployment sql.NullInt32
+}
+
+// ScoreHealth combines a site's check-in freshness, container health, recent
+// probes and latest deployment into a health score. A check-in within
+// checkinThreshold scores 100 and decays to 0 at four times the threshold. It
+// reports false when the site has no data to score.
+func ScoreHealth(site db.ListSiteHealthInputsRow, now time.Time, checkinThreshold time.Duration) (Health, bool) {
+	var h Health
+	if site.CheckinAt.Valid && checkinThreshold > 0 {
+		age := now.Sub(site.CheckinAt.Time)
+		switch {
+		case age <= checkinThreshold:
+			h.Checkin = score(100)
+		case age >= 4*checkinThreshold:
+			h.Checkin = score(0)
+		default:
+			h.Checkin = score(100 - int32(100*(age-checkinThreshold)/(3*checkinThreshold)))
+		}
+	}
+	if site.ContainersRunning.Valid {
+		if site.ContainersRunning.Int32 <= 0 {
+			h.Container = score(0)
+		} else {
+			healthy := max(site.ContainersRunning.Int32-site.ContainersUnhealthy.Int32, 0)
+			h.Container = score(100 * healthy / site.ContainersRunning.Int32)
+		}
+	}
+	if site.Probes > 0 {
+		h.Probe = score(int32(100 * site.ProbesUp / site.Probes))
+	}
+	if site.DeploymentStatus.Valid {
+		if site.DeploymentStatus.DeploymentsStatus == db.DeploymentsStatusFailed {
+			h.Deployment = score(0)
+		} else {
+			h.Deployment = score(100)
+		}
+	}
+
+	var total, weights int32
+	for _, c := range []struct {
+		score  sql.NullInt32
+		weight int32
+	}{
+		{h.Checkin, checkinWeight},
+		{h.Container, containerWeight},
+		{h.Probe, probeWeight},
+		{h.Deployment, deploymentWeight},
+	} {
+		if c.score.Valid {
+			total += c.score.Int32 * c.weight
+			weights += c.weight
+		}
+	}
+	if weights == 0 {
+		return h, false
+	}
+	h.Score = total / weights
+	return h, true
+}
+
+func score(s int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: s, Valid: true}
+}
+
+// sweepHealth scores every active site and records when each dropped below the
+// health threshold. Sites degraded for longer than the sustain period without
+// an open incident get a degraded incident. It returns how many it opened.
+func (m *Monitor) sweepHealth(ctx context.Context, now time.Time) (int, error) {
+	sites, err := m.db.ListSiteHealthInputs(ctx, sql.NullTime{Time: now.Add(-healthProbeWindow), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list site health inputs: %w", err)
+	}
+
+	opened := 0
+	for _, site := range sites {
+		health, ok := ScoreHealth(site, now, m.threshold)
+		if !ok {
+			continue
+		}
+
+		var degradedSince sql.NullTime
+		if m.healthThreshold > 0 && health.Score < m.healthThreshold {
+			degradedSince = site.DegradedSince
+			if !degradedSince.Valid {
+				degradedSince = sql.NullTime{Time: now, Valid: true}
+			}
+		}
+		err := m.db.UpsertSiteHealth(ctx, db.UpsertSiteHealthParams{
+			SiteID:          site.ID,
+			Score:           health.Score,
+			CheckinScore:    health.Checkin,
+			ContainerScore:  health.Container,
+			ProbeScore:      health.Probe,
+			DeploymentScore: health.Deployment,
+			DegradedSince:   degradedSince,
+			ComputedAt:      now,
+		})
+		if err != nil {
+			slog.Error("Failed to record site health", "error", err, "site_id", site.PublicID)
+			continue
+		}
+
+		if !degradedSince.Valid || site.OpenIncident || now.Sub(degradedSince.Time) < m.healthSustain {
+			continue
+		}
+		summary := fmt.Sprintf("%s is degraded: health score %d since %s", site.Name, health.Score, degradedSince.Time.UTC().Format(time.RFC3339))
+		if m.open(ctx, now, site.ID, site.PublicID, site.Name, site.OrganizationID, db.SiteIncidentsCauseHealthDegraded, summary) {
+			opened++
+		}
+	}
+	return opened, nil
+}
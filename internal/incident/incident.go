@@ -0,0 +1,206 @@
+// Package incident opens downtime incidents for sites that stop checking in,
+// fail their health probes or keep a low health score, escalates them to an
+// organization's PagerDuty and Opsgenie channels, and resolves them once the
+// site recovers.
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/events"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// minFailedProbes is how many consecutive failed probes open an incident, so a
+// single dropped request does not page anyone
+const minFailedProbes = 2
+
+// Monitor detects site downtime from controller check-ins and external probes.
+// A site is down once it has not checked in for longer than the threshold, or
+// every probe of its health URL within the threshold failed. It recovers with
+// its next check-in or successful probe respectively. Each sweep also scores
+// every site's health, and a site whose score stays below the health threshold
+// for the sustain period is degraded until its score recovers. Incidents are
+// recorded in site_incidents, escalated through the Escalator and emitted as
+// events so Slack and Teams channels hear too.
+type Monitor struct {
+	db              db.Querier
+	escalator       *Escalator
+	emitter         *events.Emitter
+	threshold       time.Duration
+	baseURL         string
+	healthThreshold int32
+	healthSustain   time.Duration
+}
+
+// NewMonitor creates a downtime monitor. baseURL is the dashboard URL pages link to.
+func NewMonitor(querier db.Querier, escalator *Escalator, emitter *events.Emitter, threshold time.Duration, baseURL string) *Monitor {
+	return &Monitor{
+		db:        querier,
+		escalator: escalator,
+		emitter:   emitter,
+		threshold: threshold,
+		baseURL:   baseURL,
+	}
+}
+
+// SetHealthThreshold opens degraded incidents for sites whose health score stays
+// below threshold for sustain. A zero threshold only records scores.
+func (m *Monitor) SetHealthThreshold(threshold int32, sustain time.Duration) {
+	m.healthThreshold = threshold
+	m.healthSustain = sustain
+}
+
+// Sweep opens incidents for sites that missed their check-ins, failed their
+// probes or stayed degraded, and resolves incidents for sites that recovered. It returns how many incidents it
+// opened and resolved.
+func (m *Monitor) Sweep(ctx context.Context, now time.Time) (opened, resolved int, err error) {
+	cutoff := sql.NullTime{Time: now.Add(-m.threshold), Valid: true}
+
+	// Resolve first so a site that recovered and went down again between sweeps gets a new incident
+	recovered, err := m.db.ListRecoveredSiteIncidents(ctx, cutoff)
+	if err != nil {
+		return opened, resolved, fmt.Errorf("failed to list recovered incidents: %w", err)
+	}
+	for _, incident := range recovered {
+		// Another API instance may have resolved it first
+		rows, err := m.db.ResolveSiteIncident(ctx, incident.ID)
+		if err != nil {
+			slog.Error("Failed to resolve site incident", "error", err, "incident_id", incident.PublicID)
+			continue
+		}
+		if rows == 0 {
+			continue
+		}
+		resolved++
+
+		page := Page{
+			IncidentID: incident.PublicID,
+			SiteName:   incident.SiteName,
+			Summary:    fmt.Sprintf("%s is back up", incident.SiteName),
+			Link:       m.siteLink(incident.SitePublicID),
+		}
+		m.escalator.Resolve(ctx, incident.OrganizationID, page)
+		m.emit(ctx, events.EventTypeIncidentResolved, incident.SitePublicID, &libopsv1.SiteIncident{
+			IncidentId: incident.PublicID,
+			SiteId:     incident.SitePublicID,
+			SiteName:   incident.SiteName,
+			Cause:      causeToProto(incident.Cause),
+			Status:     libopsv1.SiteIncidentStatus_SITE_INCIDENT_STATUS_RESOLVED,
+			OpenedAt:   incident.OpenedAt.Time.Unix(),
+			ResolvedAt: now.Unix(),
+		})
+		slog.Info("Site incident resolved", "incident_id", incident.PublicID, "site_id", incident.SitePublicID)
+	}
+
+	sites, err := m.db.ListSitesMissingCheckIn(ctx, cutoff)
+	if err != nil {
+		return opened, resolved, fmt.Errorf("failed to list sites missing check-ins: %w", err)
+	}
+	for _, site := range sites {
+		summary := fmt.Sprintf("%s is down: no controller check-in since %s", site.Name, site.CheckinAt.Time.UTC().Format(time.RFC3339))
+		if m.open(ctx, now, site.ID, site.PublicID, site.Name, site.OrganizationID, db.SiteIncidentsCauseCheckinMissed, summary) {
+			opened++
+		}
+	}
+
+	// A site can keep checking in while nginx or the application is down, so
+	// failing external probes open an incident as well
+	failing, err := m.db.ListSitesFailingProbes(ctx, db.ListSitesFailingProbesParams{
+		Cutoff:    cutoff,
+		MinProbes: minFailedProbes,
+	})
+	if err != nil {
+		return opened, resolved, fmt.Errorf("failed to list sites failing probes: %w", err)
+	}
+	for _, site := range failing {
+		summary := fmt.Sprintf("%s is down: %d failed health checks", site.Name, site.FailedProbes)
+		if m.open(ctx, now, site.ID, site.PublicID, site.Name, site.OrganizationID, db.SiteIncidentsCauseProbeFailed, summary) {
+			opened++
+		}
+	}
+
+	// Scored last so a site already down for another cause keeps that incident
+	degraded, err := m.sweepHealth(ctx, now)
+	opened += degraded
+	if err != nil {
+		return opened, resolved, err
+	}
+
+	return opened, resolved, nil
+}
+
+// open records an incident for the site and pages it. It reports false when
+// the incident could not be opened or another API instance opened it first.
+func (m *Monitor) open(ctx context.Context, now time.Time, siteID int64, sitePublicID, siteName string, organizationID int64, cause db.SiteIncidentsCause, summary string) bool {
+	incidentID := uuid.New().String()
+	// INSERT IGNORE on open_site_id: no rows means another API instance opened it first
+	rows, err := m.db.OpenSiteIncident(ctx, db.OpenSiteIncidentParams{
+		PublicID:       incidentID,
+		OrganizationID: organizationID,
+		SiteID:         siteID,
+		OpenSiteID:     sql.NullInt64{Int64: siteID, Valid: true},
+		Cause:          cause,
+	})
+	if err != nil {
+		slog.Error("Failed to open site incident", "error", err, "site_id", sitePublicID)
+		return false
+	}
+	if rows == 0 {
+		return false
+	}
+
+	page := Page{
+		IncidentID: incidentID,
+		SiteName:   siteName,
+		Summary:    summary,
+		Link:       m.siteLink(sitePublicID),
+	}
+	m.escalator.Trigger(ctx, organizationID, page)
+	m.emit(ctx, events.EventTypeIncidentOpened, sitePublicID, &libopsv1.SiteIncident{
+		IncidentId: incidentID,
+		SiteId:     sitePublicID,
+		SiteName:   siteName,
+		Cause:      causeToProto(cause),
+		Status:     libopsv1.SiteIncidentStatus_SITE_INCIDENT_STATUS_OPEN,
+		OpenedAt:   now.Unix(),
+	})
+	slog.Warn("Site incident opened", "incident_id", incidentID, "site_id", sitePublicID, "cause", cause)
+	return true
+}
+
+func (m *Monitor) emit(ctx context.Context, eventType, sitePublicID string, incident *libopsv1.SiteIncident) {
+	if m.emitter == nil {
+		return
+	}
+	if err := m.emitter.SendScopedProtoEvent(ctx, eventType, incident.IncidentId, nil, nil, &sitePublicID, incident); err != nil {
+		slog.Error("Failed to emit incident event", "error", err, "event_type", eventType, "incident_id", incident.IncidentId)
+	}
+}
+
+func (m *Monitor) siteLink(sitePublicID string) string {
+	if m.baseURL == "" {
+		return ""
+	}
+	return m.baseURL + "/sites/" + sitePublicID
+}
+
+func causeToProto(cause db.SiteIncidentsCause) libopsv1.SiteIncidentCause {
+	switch cause {
+	case db.SiteIncidentsCauseCheckinMissed:
+		return libopsv1.SiteIncidentCause_SITE_INCIDENT_CAUSE_CHECKIN_MISSED
+	case db.SiteIncidentsCauseProbeFailed:
+		return libopsv1.SiteIncidentCause_SITE_INCIDENT_CAUSE_PROBE_FAILED
+	case db.SiteIncidentsCauseHealthDegraded:
+		return libopsv1.SiteIncidentCause_SITE_INCIDENT_CAUSE_HEALTH_DEGRADED
+	default:
+		return libopsv1.SiteIncidentCause_SITE_INCIDENT_CAUSE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,346 @@
+package incident
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+type capturedRequest struct {
+	Path          string
+	Query         string
+	Authorization string
+	Body          map[string]any
+}
+
+// captureServer records every request it receives and answers with status.
+func captureServer(t *testing.T, status int) (*httptest.Server, func() []capturedRequest) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []capturedRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		requests = append(requests, capturedRequest{
+			Path:          r.URL.Path,
+			Query:         r.URL.RawQuery,
+			Authorization: r.Header.Get("Authorization"),
+			Body:          body,
+		})
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, func() []capturedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]capturedRequest(nil), requests...)
+	}
+}
+
+func testEscalator(mock *testutils.MockQuerier, pagerDuty, opsgenie *httptest.Server) *Escalator {
+	e := NewEscalator(mock)
+	e.pagerDutyURL = pagerDuty.URL + "/v2/enqueue"
+	e.opsgenieURL = opsgenie.URL + "/v2/alerts"
+	return e
+}
+
+// TestSweepOpensIncidents tests that sites past the check-in threshold are paged once.
+func TestSweepOpensIncidents(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pagerDuty, pagerDutyRequests := captureServer(t, http.StatusAccepted)
+	opsgenie, opsgenieRequests := captureServer(t, http.StatusAccepted)
+
+	var cutoff time.Time
+	var opened []db.OpenSiteIncidentParams
+	recorded := map[int64]sql.NullString{}
+	mock := &testutils.MockQuerier{
+		ListSitesMissingCheckInFunc: func(ctx context.Context, c sql.NullTime) ([]db.ListSitesMissingCheckInRow, error) {
+			cutoff = c.Time
+			return []db.ListSitesMissingCheckInRow{
+				{ID: 3, PublicID: "site-3", Name: "production", OrganizationID: 7, CheckinAt: sql.NullTime{Time: now.Add(-20 * time.Minute), Valid: true}},
+				{ID: 4, PublicID: "site-4", Name: "staging", OrganizationID: 7, CheckinAt: sql.NullTime{Time: now.Add(-15 * time.Minute), Valid: true}},
+			}, nil
+		},
+		OpenSiteIncidentFunc: func(ctx context.Context, arg db.OpenSiteIncidentParams) (int64, error) {
+			// Another API instance already opened the staging incident
+			if arg.SiteID == 4 {
+				return 0, nil
+			}
+			opened = append(opened, arg)
+			return 1, nil
+		},
+		ListEscalationChannelsFunc: func(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error) {
+			return []db.ListEscalationChannelsRow{
+				{ID: 11, Kind: db.NotificationChannelsKindPagerduty, IntegrationKey: "routing-key"},
+				{ID: 12, Kind: db.NotificationChannelsKindOpsgenie, IntegrationKey: "genie-key"},
+			}, nil
+		},
+		RecordNotificationChannelDeliveryFunc: func(ctx context.Context, arg db.RecordNotificationChannelDeliveryParams) error {
+			recorded[arg.ID] = arg.LastError
+			return nil
+		},
+	}
+
+	monitor := NewMonitor(mock, testEscalator(mock, pagerDuty, opsgenie), nil, 10*time.Minute, "https://dash.example.com")
+	gotOpened, gotResolved, err := monitor.Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, gotOpened)
+	assert.Equal(t, 0, gotResolved)
+	assert.Equal(t, now.Add(-10*time.Minute), cutoff)
+
+	if assert.Len(t, opened, 1) {
+		assert.Equal(t, int64(3), opened[0].SiteID)
+		assert.Equal(t, sql.NullInt64{Int64: 3, Valid: true}, opened[0].OpenSiteID)
+		assert.Equal(t, db.SiteIncidentsCauseCheckinMissed, opened[0].Cause)
+	}
+
+	pd := pagerDutyRequests()
+	if assert.Len(t, pd, 1) {
+		assert.Equal(t, "trigger", pd[0].Body["event_action"])
+		assert.Equal(t, "routing-key", pd[0].Body["routing_key"])
+		assert.Equal(t, opened[0].PublicID, pd[0].Body["dedup_key"])
+		payload := pd[0].Body["payload"].(map[string]any)
+		assert.Equal(t, "critical", payload["severity"])
+		assert.Equal(t, "production", payload["source"])
+	}
+
+	og := opsgenieRequests()
+	if assert.Len(t, og, 1) {
+		assert.Equal(t, "/v2/alerts", og[0].Path)
+		assert.Equal(t, "GenieKey genie-key", og[0].Authorization)
+		assert.Equal(t, opened[0].PublicID, og[0].Body["alias"])
+		assert.Equal(t, "P1", og[0].Body["priority"])
+		assert.Equal(t, map[string]any{"link": "https://dash.example.com/sites/site-3"}, og[0].Body["details"])
+	}
+
+	assert.Equal(t, map[int64]sql.NullString{11: {}, 12: {}}, recorded)
+}
+
+// TestSweepResolvesIncidents tests that recovered sites resolve their incident by dedup key and alias.
+func TestSweepResolvesIncidents(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pagerDuty, pagerDutyRequests := captureServer(t, http.StatusAccepted)
+	opsgenie, opsgenieRequests := captureServer(t, http.StatusAccepted)
+
+	var resolvedIDs []int64
+	mock := &testutils.MockQuerier{
+		ListRecoveredSiteIncidentsFunc: func(ctx context.Context, c sql.NullTime) ([]db.ListRecoveredSiteIncidentsRow, error) {
+			return []db.ListRecoveredSiteIncidentsRow{
+				{ID: 21, PublicID: "incident-21", OrganizationID: 7, SiteID: 3, SitePublicID: "site-3", SiteName: "production", Cause: db.SiteIncidentsCauseCheckinMissed},
+			}, nil
+		},
+		ResolveSiteIncidentFunc: func(ctx context.Context, id int64) (int64, error) {
+			resolvedIDs = append(resolvedIDs, id)
+			return 1, nil
+		},
+		ListEscalationChannelsFunc: func(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error) {
+			return []db.ListEscalationChannelsRow{
+				{ID: 11, Kind: db.NotificationChannelsKindPagerduty, IntegrationKey: "routing-key"},
+				{ID: 12, Kind: db.NotificationChannelsKindOpsgenie, IntegrationKey: "genie-key"},
+			}, nil
+		},
+	}
+
+	monitor := NewMonitor(mock, testEscalator(mock, pagerDuty, opsgenie), nil, 10*time.Minute, "")
+	gotOpened, gotResolved, err := monitor.Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, gotOpened)
+	assert.Equal(t, 1, gotResolved)
+	assert.Equal(t, []int64{21}, resolvedIDs)
+
+	pd := pagerDutyRequests()
+	if assert.Len(t, pd, 1) {
+		assert.Equal(t, "resolve", pd[0].Body["event_action"])
+		assert.Equal(t, "incident-21", pd[0].Body["dedup_key"])
+		assert.Nil(t, pd[0].Body["payload"])
+	}
+
+	og := opsgenieRequests()
+	if assert.Len(t, og, 1) {
+		assert.Equal(t, "/v2/alerts/incident-21/close", og[0].Path)
+		assert.Equal(t, "identifierType=alias", og[0].Query)
+	}
+}
+
+// TestEscalatorTestRecordsFailure tests that a rejected test page is recorded on the channel.
+func TestEscalatorTestRecordsFailure(t *testing.T) {
+	pagerDuty, pagerDutyRequests := captureServer(t, http.StatusBadRequest)
+	opsgenie, _ := captureServer(t, http.StatusAccepted)
+
+	var recorded sql.NullString
+	mock := &testutils.MockQuerier{
+		RecordNotificationChannelDeliveryFunc: func(ctx context.Context, arg db.RecordNotificationChannelDeliveryParams) error {
+			recorded = arg.LastError
+			return nil
+		},
+	}
+
+	err := testEscalator(mock, pagerDuty, opsgenie).Test(context.Background(), 11, db.NotificationChannelsKindPagerduty, "routing-key", "Acme")
+	assert.Error(t, err)
+	assert.True(t, recorded.Valid)
+	assert.Contains(t, recorded.String, "400")
+
+	// The failed trigger is not followed by a resolve
+	pd := pagerDutyRequests()
+	if assert.Len(t, pd, 1) {
+		assert.Equal(t, "info", pd[0].Body["payload"].(map[string]any)["severity"])
+	}
+}
+
+// TestSweepOpensProbeIncidents tests that sites failing every health probe are paged even while checking in.
+func TestSweepOpensProbeIncidents(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pagerDuty, pagerDutyRequests := captureServer(t, http.StatusAccepted)
+	opsgenie, _ := captureServer(t, http.StatusAccepted)
+
+	var failingParams db.ListSitesFailingProbesParams
+	var opened []db.OpenSiteIncidentParams
+	mock := &testutils.MockQuerier{
+		ListSitesFailingProbesFunc: func(ctx context.Context, arg db.ListSitesFailingProbesParams) ([]db.ListSitesFailingProbesRow, error) {
+			failingParams = arg
+			return []db.ListSitesFailingProbesRow{
+				{ID: 5, PublicID: "site-5", Name: "catalog", OrganizationID: 7, FailedProbes: 9},
+			}, nil
+		},
+		OpenSiteIncidentFunc: func(ctx context.Context, arg db.OpenSiteIncidentParams) (int64, error) {
+			opened = append(opened, arg)
+			return 1, nil
+		},
+		ListEscalationChannelsFunc: func(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error) {
+			return []db.ListEscalationChannelsRow{
+				{ID: 11, Kind: db.NotificationChannelsKindPagerduty, IntegrationKey: "routing-key"},
+			}, nil
+		},
+	}
+
+	monitor := NewMonitor(mock, testEscalator(mock, pagerDuty, opsgenie), nil, 10*time.Minute, "")
+	gotOpened, _, err := monitor.Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, gotOpened)
+	assert.Equal(t, now.Add(-10*time.Minute), failingParams.Cutoff.Time)
+	assert.Equal(t, int64(minFailedProbes), failingParams.MinProbes)
+
+	if assert.Len(t, opened, 1) {
+		assert.Equal(t, int64(5), opened[0].SiteID)
+		assert.Equal(t, db.SiteIncidentsCauseProbeFailed, opened[0].Cause)
+	}
+
+	pd := pagerDutyRequests()
+	if assert.Len(t, pd, 1) {
+		payload := pd[0].Body["payload"].(map[string]any)
+		assert.Equal(t, "catalog is down: 9 failed health checks", payload["summary"])
+	}
+}
+
+// TestScoreHealth tests that only components with data are weighted into the score.
+func TestScoreHealth(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	health, ok := ScoreHealth(db.ListSiteHealthInputsRow{
+		CheckinAt:           sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+		ContainersRunning:   sql.NullInt32{Int32: 4, Valid: true},
+		ContainersUnhealthy: sql.NullInt32{Int32: 1, Valid: true},
+		Probes:              10,
+		ProbesUp:            5,
+		DeploymentStatus:    db.NullDeploymentsStatus{DeploymentsStatus: db.DeploymentsStatusFailed, Valid: true},
+	}, now, 10*time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, sql.NullInt32{Int32: 100, Valid: true}, health.Checkin)
+	assert.Equal(t, sql.NullInt32{Int32: 75, Valid: true}, health.Container)
+	assert.Equal(t, sql.NullInt32{Int32: 50, Valid: true}, health.Probe)
+	assert.Equal(t, sql.NullInt32{Int32: 0, Valid: true}, health.Deployment)
+	assert.Equal(t, int32((100*30+75*25+50*30)/100), health.Score)
+
+	// A check-in halfway to four times the threshold with nothing else to go on
+	health, ok = ScoreHealth(db.ListSiteHealthInputsRow{
+		CheckinAt: sql.NullTime{Time: now.Add(-25 * time.Minute), Valid: true},
+	}, now, 10*time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, int32(50), health.Score)
+	assert.False(t, health.Probe.Valid)
+
+	_, ok = ScoreHealth(db.ListSiteHealthInputsRow{}, now, 10*time.Minute)
+	assert.False(t, ok, "a site without data has no score")
+}
+
+// TestSweepOpensDegradedIncidents tests that a low health score opens an
+// incident only once it has lasted the sustain period.
+func TestSweepOpensDegradedIncidents(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pagerDuty, pagerDutyRequests := captureServer(t, http.StatusAccepted)
+	opsgenie, _ := captureServer(t, http.StatusAccepted)
+
+	unhealthy := func(id int64, name string) db.ListSiteHealthInputsRow {
+		return db.ListSiteHealthInputsRow{
+			ID: id, PublicID: name, Name: name, OrganizationID: 7,
+			CheckinAt:         sql.NullTime{Time: now, Valid: true},
+			ContainersRunning: sql.NullInt32{Int32: 2, Valid: true}, ContainersUnhealthy: sql.NullInt32{Int32: 2, Valid: true},
+			Probes: 6,
+		}
+	}
+	newlyDegraded := unhealthy(1, "fresh")
+	sustained := unhealthy(2, "sustained")
+	sustained.DegradedSince = sql.NullTime{Time: now.Add(-20 * time.Minute), Valid: true}
+	alreadyOpen := unhealthy(3, "paged")
+	alreadyOpen.DegradedSince = sustained.DegradedSince
+	alreadyOpen.OpenIncident = true
+	healthy := db.ListSiteHealthInputsRow{ID: 4, PublicID: "healthy", CheckinAt: sql.NullTime{Time: now, Valid: true},
+		DegradedSince: sql.NullTime{Time: now.Add(-time.Hour), Valid: true}}
+
+	var probesSince time.Time
+	upserts := map[int64]db.UpsertSiteHealthParams{}
+	var opened []db.OpenSiteIncidentParams
+	mock := &testutils.MockQuerier{
+		ListSiteHealthInputsFunc: func(ctx context.Context, since sql.NullTime) ([]db.ListSiteHealthInputsRow, error) {
+			probesSince = since.Time
+			return []db.ListSiteHealthInputsRow{newlyDegraded, sustained, alreadyOpen, healthy}, nil
+		},
+		UpsertSiteHealthFunc: func(ctx context.Context, arg db.UpsertSiteHealthParams) error {
+			upserts[arg.SiteID] = arg
+			return nil
+		},
+		OpenSiteIncidentFunc: func(ctx context.Context, arg db.OpenSiteIncidentParams) (int64, error) {
+			opened = append(opened, arg)
+			return 1, nil
+		},
+		ListEscalationChannelsFunc: func(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error) {
+			return []db.ListEscalationChannelsRow{
+				{ID: 11, Kind: db.NotificationChannelsKindPagerduty, IntegrationKey: "routing-key"},
+			}, nil
+		},
+	}
+
+	monitor := NewMonitor(mock, testEscalator(mock, pagerDuty, opsgenie), nil, 10*time.Minute, "")
+	monitor.SetHealthThreshold(50, 15*time.Minute)
+	gotOpened, _, err := monitor.Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, gotOpened)
+	assert.Equal(t, now.Add(-healthProbeWindow), probesSince)
+
+	assert.Equal(t, now, upserts[1].DegradedSince.Time, "a newly degraded site starts its sustain period")
+	assert.Equal(t, sustained.DegradedSince, upserts[2].DegradedSince)
+	assert.False(t, upserts[4].DegradedSince.Valid, "a healthy site is no longer degraded")
+	assert.Equal(t, int32(100), upserts[4].Score)
+
+	if assert.Len(t, opened, 1) {
+		assert.Equal(t, int64(2), opened[0].SiteID)
+		assert.Equal(t, db.SiteIncidentsCauseHealthDegraded, opened[0].Cause)
+	}
+	pd := pagerDutyRequests()
+	if assert.Len(t, pd, 1) {
+		payload := pd[0].Body["payload"].(map[string]any)
+		assert.Contains(t, payload["summary"], "sustained is degraded: health score 35")
+	}
+}
@@ -0,0 +1,59 @@
+package invite
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+// HandleAccept accepts the invitation in the emailed link for the signed-in account
+// and redirects to the resource. People who aren't signed in are sent to log in or
+// sign up first, then open the link again.
+func (i *Inviter) HandleAccept(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+
+	account, err := i.db.GetAccountByID(r.Context(), userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	accepted, err := i.Accept(r.Context(), r.PathValue("token"), account.ID, account.Email)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, "This invitation link is not valid.", http.StatusNotFound)
+		return
+	case errors.Is(err, ErrNotPending):
+		http.Error(w, "This invitation has expired or was already used. Ask for a new invitation.", http.StatusGone)
+		return
+	case errors.Is(err, ErrWrongAccount):
+		http.Error(w, "This invitation was sent to a different email address. Sign in with that address to accept it.", http.StatusForbidden)
+		return
+	case err != nil:
+		slog.Error("Failed to accept invitation", "account_id", account.ID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	// Joining through an invitation replaces creating an organization during onboarding
+	if !account.OnboardingCompleted {
+		if err := i.db.UpdateAccountOnboarding(r.Context(), db.UpdateAccountOnboardingParams{
+			OnboardingCompleted: true,
+			OnboardingSessionID: account.OnboardingSessionID,
+			ID:                  account.ID,
+		}); err != nil {
+			slog.Error("Failed to complete onboarding after accepting invitation", "account_id", account.ID, "err", err)
+		}
+	}
+
+	slog.Info("Invitation accepted", "account_id", account.ID, "resource_type", accepted.ResourceType, "link", accepted.Link)
+	http.Redirect(w, r, accepted.Link, http.StatusSeeOther)
+}
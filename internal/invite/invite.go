@@ -0,0 +1,276 @@
+// Package invite emails membership invitations to people without a LibOps account
+// and turns an accepted invitation into an organization, project or site membership.
+package invite
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/email"
+)
+
+// invitationTTL is how long an emailed accept link stays valid
+const invitationTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrNotFound is returned for an unknown accept token
+	ErrNotFound = errors.New("invitation not found")
+	// ErrNotPending is returned when the invitation was already accepted, replaced or has expired
+	ErrNotPending = errors.New("invitation is no longer valid")
+	// ErrWrongAccount is returned when the signed-in account's email differs from the invited one
+	ErrWrongAccount = errors.New("invitation was sent to a different email address")
+)
+
+// Mailer sends templated emails
+type Mailer interface {
+	Send(ctx context.Context, req email.Request) error
+}
+
+// Inviter creates, emails and accepts member invitations
+type Inviter struct {
+	db      db.Querier
+	mailer  Mailer
+	baseURL string
+}
+
+// NewInviter creates an inviter whose accept links point at the dashboard's baseURL
+func NewInviter(querier db.Querier, mailer Mailer, baseURL string) *Inviter {
+	return &Inviter{
+		db:      querier,
+		mailer:  mailer,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Request describes who to invite to which resource
+type Request struct {
+	Email        string
+	ResourceType string // organization, project or site
+	ResourceID   int64
+	ResourceName string
+	Role         string
+}
+
+// Invitation is a pending invitation that was just sent
+type Invitation struct {
+	PublicID  string
+	Email     string
+	Role      string
+	ExpiresAt time.Time
+}
+
+// Invite records an invitation, replacing any earlier one for the same email and
+// resource, and emails the accept link. The invitation is kept even when the email
+// can't be delivered; sending again issues a new link.
+func (i *Inviter) Invite(ctx context.Context, req Request) (*Invitation, error) {
+	token, tokenHash, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var invitedBy sql.NullInt64
+	inviterName := "A LibOps user"
+	if userInfo, ok := auth.GetUserFromContext(ctx); ok && userInfo != nil {
+		invitedBy = sql.NullInt64{Int64: userInfo.AccountID, Valid: true}
+		switch {
+		case userInfo.Name != "":
+			inviterName = userInfo.Name
+		case userInfo.Email != "":
+			inviterName = userInfo.Email
+		}
+	}
+
+	resourceType := db.MemberInvitationsResourceType(req.ResourceType)
+	if err := i.db.RevokePendingMemberInvitations(ctx, db.RevokePendingMemberInvitationsParams{
+		ResourceType: resourceType,
+		ResourceID:   req.ResourceID,
+		Email:        req.Email,
+	}); err != nil {
+		return nil, fmt.Errorf("revoke earlier invitations: %w", err)
+	}
+
+	invitation := &Invitation{
+		PublicID:  uuid.New().String(),
+		Email:     req.Email,
+		Role:      req.Role,
+		ExpiresAt: time.Now().Add(invitationTTL).UTC().Truncate(time.Second),
+	}
+	if err := i.db.CreateMemberInvitation(ctx, db.CreateMemberInvitationParams{
+		PublicID:     invitation.PublicID,
+		TokenHash:    tokenHash,
+		Email:        req.Email,
+		ResourceType: resourceType,
+		ResourceID:   req.ResourceID,
+		Role:         db.MemberInvitationsRole(req.Role),
+		ExpiresAt:    invitation.ExpiresAt,
+		CreatedBy:    invitedBy,
+	}); err != nil {
+		return nil, fmt.Errorf("create invitation: %w", err)
+	}
+
+	if i.mailer != nil {
+		err := i.mailer.Send(ctx, email.Request{
+			Template: email.TemplateInvitation,
+			To:       req.Email,
+			Data: email.InvitationData{
+				InviterName:  inviterName,
+				ResourceType: req.ResourceType,
+				ResourceName: req.ResourceName,
+				Role:         req.Role,
+				AcceptURL:    i.baseURL + "/invitations/" + token,
+			},
+			OrganizationID: i.organizationID(ctx, resourceType, req.ResourceID),
+		})
+		if err != nil {
+			slog.Error("Failed to send invitation email", "err", err, "invitation_id", invitation.PublicID)
+		}
+	}
+
+	return invitation, nil
+}
+
+// Accepted is the membership created from an accepted invitation
+type Accepted struct {
+	ResourceType string
+	ResourceName string
+	Link         string // Dashboard path of the resource
+}
+
+// Accept adds the account as a member of the invited resource. The account's email
+// must be the invited one. An account that is already a member keeps its current role.
+func (i *Inviter) Accept(ctx context.Context, token string, accountID int64, accountEmail string) (*Accepted, error) {
+	invitation, err := i.db.GetMemberInvitationByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get invitation: %w", err)
+	}
+	if invitation.Status != db.MemberInvitationsStatusPending || time.Now().After(invitation.ExpiresAt) || invitation.ResourcePublicID == "" {
+		return nil, ErrNotPending
+	}
+	if !strings.EqualFold(invitation.Email, accountEmail) {
+		return nil, ErrWrongAccount
+	}
+
+	if err := i.addMember(ctx, invitation, accountID); err != nil && !isDuplicate(err) {
+		return nil, fmt.Errorf("add member: %w", err)
+	}
+
+	accepted, err := i.db.AcceptMemberInvitation(ctx, db.AcceptMemberInvitationParams{
+		AcceptedBy: sql.NullInt64{Int64: accountID, Valid: true},
+		ID:         invitation.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("accept invitation: %w", err)
+	}
+	if accepted == 0 {
+		return nil, ErrNotPending
+	}
+
+	resourceType := string(invitation.ResourceType)
+	return &Accepted{
+		ResourceType: resourceType,
+		ResourceName: invitation.ResourceName,
+		Link:         "/" + resourceType + "s/" + invitation.ResourcePublicID,
+	}, nil
+}
+
+// addMember creates the membership the same way the member services do: owners and
+// developers start out provisioning until their SSH keys reach the sites.
+func (i *Inviter) addMember(ctx context.Context, invitation db.GetMemberInvitationByTokenHashRow, accountID int64) error {
+	role := string(invitation.Role)
+	createdBy := sql.NullInt64{Int64: accountID, Valid: true}
+
+	switch invitation.ResourceType {
+	case db.MemberInvitationsResourceTypeOrganization:
+		status := db.OrganizationMembersStatusActive
+		if role == "owner" || role == "developer" {
+			status = db.OrganizationMembersStatusProvisioning
+		}
+		return i.db.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			OrganizationID: invitation.ResourceID,
+			AccountID:      accountID,
+			Role:           db.OrganizationMembersRole(role),
+			Status:         db.NullOrganizationMembersStatus{OrganizationMembersStatus: status, Valid: true},
+			CreatedBy:      createdBy,
+			UpdatedBy:      createdBy,
+		})
+	case db.MemberInvitationsResourceTypeProject:
+		return i.db.CreateProjectMember(ctx, db.CreateProjectMemberParams{
+			ProjectID: invitation.ResourceID,
+			AccountID: accountID,
+			Role:      db.ProjectMembersRole(role),
+			CreatedBy: createdBy,
+			UpdatedBy: createdBy,
+		})
+	case db.MemberInvitationsResourceTypeSite:
+		return i.db.CreateSiteMember(ctx, db.CreateSiteMemberParams{
+			SiteID:    invitation.ResourceID,
+			AccountID: accountID,
+			Role:      db.SiteMembersRole(role),
+			CreatedBy: createdBy,
+			UpdatedBy: createdBy,
+		})
+	}
+	return fmt.Errorf("unknown resource type %q", invitation.ResourceType)
+}
+
+// organizationID returns the organization an invitation's resource belongs to,
+// so the email carries its branding, or 0 when it can't be found
+func (i *Inviter) organizationID(ctx context.Context, resourceType db.MemberInvitationsResourceType, resourceID int64) int64 {
+	switch resourceType {
+	case db.MemberInvitationsResourceTypeOrganization:
+		return resourceID
+	case db.MemberInvitationsResourceTypeSite:
+		site, err := i.db.GetSiteByID(ctx, resourceID)
+		if err != nil {
+			slog.Error("Failed to get site for invitation branding", "err", err, "site_id", resourceID)
+			return 0
+		}
+		resourceID = site.ProjectID
+		fallthrough
+	case db.MemberInvitationsResourceTypeProject:
+		project, err := i.db.GetProjectByID(ctx, resourceID)
+		if err != nil {
+			slog.Error("Failed to get project for invitation branding", "err", err, "project_id", resourceID)
+			return 0
+		}
+		return project.OrganizationID
+	}
+	return 0
+}
+
+// newToken returns a random accept token and the hash stored in its place
+func newToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func isDuplicate(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
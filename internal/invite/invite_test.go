@@ -0,0 +1,118 @@
+package invite
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/email"
+	"github.com/libops/api/internal/testutils"
+)
+
+type fakeMailer struct {
+	sent []email.Request
+}
+
+func (m *fakeMailer) Send(ctx context.Context, req email.Request) error {
+	m.sent = append(m.sent, req)
+	return nil
+}
+
+// TestInviteAndAccept tests that the emailed link accepts the stored invitation and
+// adds the invited account as a member.
+func TestInviteAndAccept(t *testing.T) {
+	var stored db.CreateMemberInvitationParams
+	var added db.CreateProjectMemberParams
+	mock := &testutils.MockQuerier{
+		CreateMemberInvitationFunc: func(ctx context.Context, arg db.CreateMemberInvitationParams) error {
+			stored = arg
+			return nil
+		},
+		GetMemberInvitationByTokenHashFunc: func(ctx context.Context, tokenHash string) (db.GetMemberInvitationByTokenHashRow, error) {
+			require.Equal(t, stored.TokenHash, tokenHash)
+			return db.GetMemberInvitationByTokenHashRow{
+				ID:               9,
+				Email:            stored.Email,
+				ResourceType:     stored.ResourceType,
+				ResourceID:       stored.ResourceID,
+				Role:             stored.Role,
+				Status:           db.MemberInvitationsStatusPending,
+				ExpiresAt:        stored.ExpiresAt,
+				ResourcePublicID: "project-1",
+				ResourceName:     "library",
+			}, nil
+		},
+		CreateProjectMemberFunc: func(ctx context.Context, arg db.CreateProjectMemberParams) error {
+			added = arg
+			return nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 2}, nil
+		},
+		AcceptMemberInvitationFunc: func(ctx context.Context, arg db.AcceptMemberInvitationParams) (int64, error) {
+			assert.Equal(t, int64(9), arg.ID)
+			return 1, nil
+		},
+	}
+	mailer := &fakeMailer{}
+	inviter := NewInviter(mock, mailer, "https://dash.example.org/")
+
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 5, Name: "Ada"})
+	invitation, err := inviter.Invite(ctx, Request{
+		Email:        "grace@example.org",
+		ResourceType: "project",
+		ResourceID:   3,
+		ResourceName: "library",
+		Role:         "developer",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "grace@example.org", invitation.Email)
+	assert.WithinDuration(t, time.Now().Add(invitationTTL), invitation.ExpiresAt, time.Minute)
+	assert.Equal(t, int64(5), stored.CreatedBy.Int64)
+
+	require.Len(t, mailer.sent, 1)
+	data := mailer.sent[0].Data.(email.InvitationData)
+	assert.Equal(t, "Ada", data.InviterName)
+	assert.Equal(t, int64(2), mailer.sent[0].OrganizationID, "the email carries the project's organization branding")
+	require.True(t, strings.HasPrefix(data.AcceptURL, "https://dash.example.org/invitations/"))
+	token := strings.TrimPrefix(data.AcceptURL, "https://dash.example.org/invitations/")
+	assert.NotEqual(t, token, stored.TokenHash, "only the token's hash is stored")
+
+	_, err = inviter.Accept(context.Background(), token, 7, "someone@example.org")
+	assert.ErrorIs(t, err, ErrWrongAccount)
+
+	accepted, err := inviter.Accept(context.Background(), token, 7, "Grace@Example.org")
+	require.NoError(t, err)
+	assert.Equal(t, "/projects/project-1", accepted.Link)
+	assert.Equal(t, db.CreateProjectMemberParams{
+		ProjectID: 3,
+		AccountID: 7,
+		Role:      db.ProjectMembersRoleDeveloper,
+		CreatedBy: added.CreatedBy,
+		UpdatedBy: added.UpdatedBy,
+	}, added)
+}
+
+// TestAcceptExpired tests that an expired invitation can't be accepted.
+func TestAcceptExpired(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetMemberInvitationByTokenHashFunc: func(ctx context.Context, tokenHash string) (db.GetMemberInvitationByTokenHashRow, error) {
+			return db.GetMemberInvitationByTokenHashRow{
+				Email:            "grace@example.org",
+				ResourceType:     db.MemberInvitationsResourceTypeSite,
+				Status:           db.MemberInvitationsStatusPending,
+				ExpiresAt:        time.Now().Add(-time.Hour),
+				ResourcePublicID: "site-1",
+			}, nil
+		},
+	}
+
+	_, err := NewInviter(mock, nil, "").Accept(context.Background(), "token", 7, "grace@example.org")
+	assert.ErrorIs(t, err, ErrNotPending)
+}
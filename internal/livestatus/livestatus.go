@@ -0,0 +1,215 @@
+// Package livestatus streams site status changes, deployment progress and
+// reconciliation results to the dashboard so pages update without a refresh.
+package livestatus
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+// defaultPollInterval is how often a stream checks the database for changes.
+// Deployments and reconciliations are updated by other services, so there is
+// nothing in this process to wake the stream sooner.
+const defaultPollInterval = 5 * time.Second
+
+// SiteEvent is a site's provisioning status and open incident, if any
+type SiteEvent struct {
+	SiteID   string `json:"siteId"`
+	Status   string `json:"status"`
+	Incident string `json:"incident,omitempty"` // e.g. "checkin_missed", empty while up
+}
+
+// DeploymentEvent is the progress of a site's latest deployment
+type DeploymentEvent struct {
+	SiteID       string `json:"siteId"`
+	DeploymentID string `json:"deploymentId"`
+	Status       string `json:"status"`
+	URL          string `json:"url,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ReconciliationEvent is the state of the latest reconciliation run covering a site
+type ReconciliationEvent struct {
+	SiteID string `json:"siteId"`
+	RunID  string `json:"runId"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// siteState is everything streamed about one site
+type siteState struct {
+	site           SiteEvent
+	deployment     *DeploymentEvent
+	reconciliation *ReconciliationEvent
+}
+
+// Streamer serves the dashboard's live status stream
+type Streamer struct {
+	db           db.Querier
+	pollInterval time.Duration
+}
+
+// NewStreamer creates a new live status streamer.
+func NewStreamer(querier db.Querier) *Streamer {
+	return &Streamer{
+		db:           querier,
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// HandleStream streams the state of the sites the authenticated user can
+// access as server-sent events:
+//
+//	event: site            data: a SiteEvent
+//	event: deployment      data: a DeploymentEvent
+//	event: reconciliation  data: a ReconciliationEvent
+//
+// Every site's current state is sent on connect, then each part again
+// whenever it changes. The optional site query parameter limits the stream
+// to one site, for its detail page.
+func (s *Streamer) HandleStream(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	ctx := r.Context()
+
+	params := db.ListUserSiteLiveStatusParams{AccountID: userInfo.AccountID}
+	if siteID := r.URL.Query().Get("site"); siteID != "" {
+		if _, err := uuid.Parse(siteID); err != nil {
+			http.Error(w, "Invalid site ID", http.StatusBadRequest)
+			return
+		}
+		params.FilterSiteID = sql.NullString{String: siteID, Valid: true}
+	}
+
+	// The stream outlives the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.Debug("Live status stream keeps the server write timeout", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	last := map[string]siteState{}
+	for {
+		sent, err := s.sendChanges(ctx, w, params, last)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Warn("Live status stream closed", "error", err, "account_id", userInfo.AccountID)
+			}
+			return
+		}
+		if !sent {
+			// Keep idle connections open through proxies
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendChanges writes the parts of each site's state that differ from last and
+// records what was sent. It reports whether any event was written.
+func (s *Streamer) sendChanges(ctx context.Context, w io.Writer, params db.ListUserSiteLiveStatusParams, last map[string]siteState) (bool, error) {
+	rows, err := s.db.ListUserSiteLiveStatus(ctx, params)
+	if err != nil {
+		return false, fmt.Errorf("failed to list site status: %w", err)
+	}
+
+	sent := false
+	for _, row := range rows {
+		current := stateFromRow(row)
+		previous, seen := last[row.PublicID]
+
+		if !seen || current.site != previous.site {
+			if err := writeEvent(w, "site", current.site); err != nil {
+				return sent, err
+			}
+			sent = true
+		}
+		if current.deployment != nil && (previous.deployment == nil || *current.deployment != *previous.deployment) {
+			if err := writeEvent(w, "deployment", current.deployment); err != nil {
+				return sent, err
+			}
+			sent = true
+		}
+		if current.reconciliation != nil && (previous.reconciliation == nil || *current.reconciliation != *previous.reconciliation) {
+			if err := writeEvent(w, "reconciliation", current.reconciliation); err != nil {
+				return sent, err
+			}
+			sent = true
+		}
+		last[row.PublicID] = current
+	}
+	return sent, nil
+}
+
+func stateFromRow(row db.ListUserSiteLiveStatusRow) siteState {
+	state := siteState{
+		site: SiteEvent{
+			SiteID: row.PublicID,
+			Status: string(db.SitesStatusUnspecified),
+		},
+	}
+	if row.Status.Valid {
+		state.site.Status = string(row.Status.SitesStatus)
+	}
+	if row.IncidentCause.Valid {
+		state.site.Incident = string(row.IncidentCause.SiteIncidentsCause)
+	}
+	if row.DeploymentID.Valid {
+		state.deployment = &DeploymentEvent{
+			SiteID:       row.PublicID,
+			DeploymentID: row.DeploymentID.String,
+			Status:       string(row.DeploymentStatus.DeploymentsStatus),
+			URL:          row.DeploymentUrl.String,
+			Error:        row.DeploymentError.String,
+		}
+	}
+	if row.ReconciliationRunID.Valid {
+		state.reconciliation = &ReconciliationEvent{
+			SiteID: row.PublicID,
+			RunID:  row.ReconciliationRunID.String,
+			Status: string(row.ReconciliationStatus.ReconciliationsStatus),
+			Error:  row.ReconciliationError.String,
+		}
+	}
+	return state
+}
+
+func writeEvent(w io.Writer, event string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event, err)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
@@ -0,0 +1,76 @@
+package livestatus
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestSendChanges tests that the first pass sends every site's state and later passes only what changed.
+func TestSendChanges(t *testing.T) {
+	row := db.ListUserSiteLiveStatusRow{
+		PublicID:         "site-1",
+		Status:           db.NullSitesStatus{SitesStatus: db.SitesStatusActive, Valid: true},
+		DeploymentID:     sql.NullString{String: "deploy-1", Valid: true},
+		DeploymentStatus: db.NullDeploymentsStatus{DeploymentsStatus: db.DeploymentsStatusInProgress, Valid: true},
+	}
+	mock := &testutils.MockQuerier{
+		ListUserSiteLiveStatusFunc: func(ctx context.Context, arg db.ListUserSiteLiveStatusParams) ([]db.ListUserSiteLiveStatusRow, error) {
+			assert.Equal(t, int64(5), arg.AccountID)
+			return []db.ListUserSiteLiveStatusRow{row}, nil
+		},
+	}
+	s := NewStreamer(mock)
+	params := db.ListUserSiteLiveStatusParams{AccountID: 5}
+	last := map[string]siteState{}
+
+	var out bytes.Buffer
+	sent, err := s.sendChanges(context.Background(), &out, params, last)
+	require.NoError(t, err)
+	assert.True(t, sent)
+	assert.Equal(t, "event: site\ndata: {\"siteId\":\"site-1\",\"status\":\"active\"}\n\n"+
+		"event: deployment\ndata: {\"siteId\":\"site-1\",\"deploymentId\":\"deploy-1\",\"status\":\"in_progress\"}\n\n", out.String())
+
+	out.Reset()
+	sent, err = s.sendChanges(context.Background(), &out, params, last)
+	require.NoError(t, err)
+	assert.False(t, sent)
+	assert.Empty(t, out.String())
+
+	row.DeploymentStatus.DeploymentsStatus = db.DeploymentsStatusSuccess
+	row.ReconciliationRunID = sql.NullString{String: "run-1", Valid: true}
+	row.ReconciliationStatus = db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true}
+	row.ReconciliationError = sql.NullString{String: "terraform apply failed", Valid: true}
+	out.Reset()
+	sent, err = s.sendChanges(context.Background(), &out, params, last)
+	require.NoError(t, err)
+	assert.True(t, sent)
+	assert.Equal(t, "event: deployment\ndata: {\"siteId\":\"site-1\",\"deploymentId\":\"deploy-1\",\"status\":\"success\"}\n\n"+
+		"event: reconciliation\ndata: {\"siteId\":\"site-1\",\"runId\":\"run-1\",\"status\":\"failed\",\"error\":\"terraform apply failed\"}\n\n", out.String())
+}
+
+// TestHandleStreamRequiresAuthentication tests that anonymous requests are rejected.
+func TestHandleStreamRequiresAuthentication(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewStreamer(&testutils.MockQuerier{}).HandleStream(rec, httptest.NewRequest(http.MethodGet, "/events/stream", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+// TestHandleStreamRejectsInvalidSite tests that the site filter must be a UUID.
+func TestHandleStreamRejectsInvalidSite(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/events/stream?site=nope", nil)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	rec := httptest.NewRecorder()
+	NewStreamer(&testutils.MockQuerier{}).HandleStream(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
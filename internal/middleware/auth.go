@@ -132,11 +132,12 @@ func (v *JWTValidator) Middleware(next http.Handler) http.Handler {
 			}
 
 			userInfo := &auth.UserInfo{
-				EntityID:  apiKeyInfo.EntityID,
-				Email:     apiKeyInfo.Email,
-				Name:      apiKeyInfo.Name,
-				AccountID: apiKeyInfo.AccountID,
-				Scopes:    apiKeyInfo.Scopes,
+				EntityID:     apiKeyInfo.EntityID,
+				Email:        apiKeyInfo.Email,
+				Name:         apiKeyInfo.Name,
+				AccountID:    apiKeyInfo.AccountID,
+				Scopes:       apiKeyInfo.Scopes,
+				AllowedCIDRs: apiKeyInfo.AllowedCIDRs,
 				Metadata: map[string]string{
 					"auth_type":    "api_key",
 					"key_uuid":     apiKeyInfo.KeyUUID,
@@ -248,9 +249,11 @@ func isPublicEndpoint(path string) bool {
 	publicPrefixes := []string{
 		"/static/",
 		"/health",
+		"/readyz",
 		"/version",
 		"/openapi",
 		"/auth/token",
+		"/auth/device/", // the CLI's device code and token endpoints, not the approval page
 		"/auth/register/",
 		"/auth/userpass/",
 		"/auth/verify",
@@ -1,14 +1,18 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/rs/cors"
 
+	"github.com/libops/api/internal/database"
 	"github.com/libops/api/internal/logging"
 )
 
@@ -39,10 +43,28 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return rw.ResponseWriter.Write(b)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, so
+// streaming handlers can flush and extend their write deadline.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// Hijack hands the connection to websocket handlers, which upgrade through a
+// type assertion rather than http.ResponseController.
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	rw.statusCode = http.StatusSwitchingProtocols
+	rw.written = true
+	return hijacker.Hijack()
+}
+
 // AccessLogger logs HTTP requests with method, path, status, and duration.
 func AccessLogger(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/health" {
+		if r.URL.Path == "/health" || r.URL.Path == "/healthz" || r.URL.Path == "/readyz" {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -88,6 +110,14 @@ func RequestIDMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// ReadYourWritesMiddleware gives each request a database session, so its reads
+// after a write go to the primary rather than a replica that may lag behind.
+func ReadYourWritesMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(database.WithSession(r.Context())))
+	})
+}
+
 // SecurityHeadersMiddleware adds security headers to responses.
 func SecurityHeadersMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -103,6 +133,7 @@ func CSRFMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Skip check for authentication endpoints that don't require a token
 		if r.URL.Path == "/auth/token" ||
+			strings.HasPrefix(r.URL.Path, "/auth/device/") ||
 			strings.HasPrefix(r.URL.Path, "/auth/register/") ||
 			strings.HasPrefix(r.URL.Path, "/auth/userpass/") ||
 			r.URL.Path == "/auth/login" ||
@@ -134,6 +165,7 @@ func CorsMiddleware(handler http.Handler, allowedOrigins []string) http.Handler
 			http.MethodGet,
 			http.MethodPost,
 			http.MethodPut,
+			http.MethodPatch,
 			http.MethodDelete,
 			http.MethodOptions,
 		},
@@ -143,10 +175,17 @@ func CorsMiddleware(handler http.Handler, allowedOrigins []string) http.Handler
 			"Content-Type",
 			"Connect-Protocol-Version",
 			"Connect-Timeout-Ms",
+			"Idempotency-Key",
+			"Libops-Urgent",
 		},
 		ExposedHeaders: []string{
 			"Connect-Protocol-Version",
 			"Connect-Timeout-Ms",
+			"Idempotent-Replayed",
+			"Libops-Api-Version",
+			"Deprecation",
+			"Sunset",
+			"Link",
 		},
 		MaxAge: 7200,
 	})
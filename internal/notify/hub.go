@@ -0,0 +1,53 @@
+package notify
+
+import "sync"
+
+// Hub wakes the notification streams open on this API instance when one of
+// their account's notifications changes. Streams also poll the database, so
+// changes made through other instances arrive on the next poll.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan struct{}]struct{}
+}
+
+// NewHub creates an empty hub.
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[int64]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe returns a channel that receives a value after the account's
+// notifications change, and a function that ends the subscription
+func (h *Hub) Subscribe(accountID int64) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.subscribers[accountID] == nil {
+		h.subscribers[accountID] = make(map[chan struct{}]struct{})
+	}
+	h.subscribers[accountID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subscribers[accountID], ch)
+		if len(h.subscribers[accountID]) == 0 {
+			delete(h.subscribers, accountID)
+		}
+	}
+}
+
+// Publish wakes the account's subscribers. It never blocks: a subscriber that
+// has not yet handled its previous wake-up is already due to re-read.
+func (h *Hub) Publish(accountID int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers[accountID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Package notify records in-app notifications for accounts and streams them
+// to the dashboard's notification center.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/store"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// Notification types
+const (
+	TypeDeployFinished      = "deploy_finished"
+	TypeMemberAdded         = "member_added"
+	TypeCertificateExpiring = "certificate_expiring"
+	TypeOwnershipTransfer   = "ownership_transfer"
+)
+
+// Notification is a message for one account's notification center
+type Notification struct {
+	Type  string
+	Title string
+	Body  string
+	Link  string // dashboard path, e.g. /sites/{id}
+}
+
+// Notifier records notifications and wakes the account's open streams
+type Notifier struct {
+	db           db.Querier
+	hub          *Hub
+	pollInterval time.Duration
+}
+
+// NewNotifier creates a new notifier.
+func NewNotifier(querier db.Querier) *Notifier {
+	return &Notifier{
+		db:           querier,
+		hub:          NewHub(),
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Hub returns the hub that wakes this instance's notification streams
+func (n *Notifier) Hub() *Hub {
+	return n.hub
+}
+
+// Notify records a notification for an account
+func (n *Notifier) Notify(ctx context.Context, accountID int64, notification Notification) error {
+	err := n.db.CreateNotification(ctx, db.CreateNotificationParams{
+		PublicID:  uuid.New().String(),
+		AccountID: accountID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Link:      notification.Link,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	// Subscribers fetch it as soon as they hear of it
+	store.AfterCommit(ctx, func(context.Context) {
+		n.hub.Publish(accountID)
+	})
+	return nil
+}
+
+// MemberAdded tells an account that the authenticated user added it to an
+// organization, project or site. Adding yourself is not notified.
+func (n *Notifier) MemberAdded(ctx context.Context, accountID int64, resourceType, resourceName, role, link string) {
+	actor := "Someone"
+	if userInfo, ok := auth.GetUserFromContext(ctx); ok {
+		if userInfo.AccountID == accountID {
+			return
+		}
+		switch {
+		case userInfo.Name != "":
+			actor = userInfo.Name
+		case userInfo.Email != "":
+			actor = userInfo.Email
+		}
+	}
+
+	err := n.Notify(ctx, accountID, Notification{
+		Type:  TypeMemberAdded,
+		Title: fmt.Sprintf("You were added to the %s %s", resourceType, resourceName),
+		Body:  fmt.Sprintf("%s added you as %s.", actor, withArticle(role)),
+		Link:  link,
+	})
+	if err != nil {
+		slog.Error("Failed to notify added member", "error", err, "account_id", accountID, "resource_type", resourceType)
+	}
+}
+
+// DeployFinished tells everyone who can deploy a site that a deployment finished
+func (n *Notifier) DeployFinished(ctx context.Context, siteID int64, siteName, sitePublicID string, failed bool, errorMessage string) {
+	notification := Notification{
+		Type:  TypeDeployFinished,
+		Title: fmt.Sprintf("Deployment of %s succeeded", siteName),
+		Body:  fmt.Sprintf("The latest changes to %s are live.", siteName),
+		Link:  "/sites/" + sitePublicID,
+	}
+	if failed {
+		notification.Title = fmt.Sprintf("Deployment of %s failed", siteName)
+		notification.Body = "The deployment did not complete."
+		if errorMessage != "" {
+			notification.Body = truncate(errorMessage, 500)
+		}
+	}
+	n.notifySite(ctx, siteID, notification)
+}
+
+// CertificateExpiring tells everyone who can deploy a site that a domain's
+// TLS certificate is about to expire
+func (n *Notifier) CertificateExpiring(ctx context.Context, siteID int64, siteName, sitePublicID, domain string, expiresAt time.Time) {
+	n.notifySite(ctx, siteID, Notification{
+		Type:  TypeCertificateExpiring,
+		Title: fmt.Sprintf("Certificate for %s expires soon", domain),
+		Body:  fmt.Sprintf("The TLS certificate for %s on %s expires on %s.", domain, siteName, expiresAt.UTC().Format("January 2, 2006")),
+		Link:  "/sites/" + sitePublicID,
+	})
+}
+
+func (n *Notifier) notifySite(ctx context.Context, siteID int64, notification Notification) {
+	recipients, err := n.db.ListSiteNotificationRecipients(ctx, db.ListSiteNotificationRecipientsParams{SiteID: siteID})
+	if err != nil {
+		slog.Error("Failed to list notification recipients", "error", err, "site_id", siteID, "type", notification.Type)
+		return
+	}
+	for _, accountID := range recipients {
+		if err := n.Notify(ctx, accountID, notification); err != nil {
+			slog.Error("Failed to notify site member", "error", err, "account_id", accountID, "type", notification.Type)
+		}
+	}
+}
+
+// ToProto converts a stored notification to its API representation
+func ToProto(row db.ListAccountNotificationsRow) *libopsv1.Notification {
+	notification := &libopsv1.Notification{
+		NotificationId: row.PublicID,
+		Type:           row.Type,
+		Title:          row.Title,
+		Body:           row.Body,
+		Link:           row.Link,
+		Read:           row.ReadAt.Valid,
+	}
+	if row.CreatedAt.Valid {
+		notification.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return notification
+}
+
+func withArticle(role string) string {
+	if role == "" {
+		return "a member"
+	}
+	switch role[0] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return "an " + role
+	}
+	return "a " + role
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,173 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestMemberAdded tests the added member's notification and that adding yourself is skipped.
+func TestMemberAdded(t *testing.T) {
+	var created []db.CreateNotificationParams
+	mock := &testutils.MockQuerier{
+		CreateNotificationFunc: func(ctx context.Context, arg db.CreateNotificationParams) error {
+			created = append(created, arg)
+			return nil
+		},
+	}
+	n := NewNotifier(mock)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1, Name: "Ada"})
+
+	n.MemberAdded(ctx, 2, "project", "Library", "owner", "/projects/abc")
+	n.MemberAdded(ctx, 1, "project", "Library", "owner", "/projects/abc")
+
+	require.Len(t, created, 1)
+	assert.Equal(t, int64(2), created[0].AccountID)
+	assert.Equal(t, TypeMemberAdded, created[0].Type)
+	assert.Equal(t, "You were added to the project Library", created[0].Title)
+	assert.Equal(t, "Ada added you as an owner.", created[0].Body)
+	assert.Equal(t, "/projects/abc", created[0].Link)
+	assert.NotEmpty(t, created[0].PublicID)
+}
+
+// TestDeployFinished tests that every account that can deploy the site is notified.
+func TestDeployFinished(t *testing.T) {
+	var created []db.CreateNotificationParams
+	mock := &testutils.MockQuerier{
+		ListSiteNotificationRecipientsFunc: func(ctx context.Context, arg db.ListSiteNotificationRecipientsParams) ([]int64, error) {
+			assert.Equal(t, int64(9), arg.SiteID)
+			return []int64{3, 4}, nil
+		},
+		CreateNotificationFunc: func(ctx context.Context, arg db.CreateNotificationParams) error {
+			created = append(created, arg)
+			return nil
+		},
+	}
+
+	NewNotifier(mock).DeployFinished(context.Background(), 9, "catalog", "site-uuid", true, "terraform apply failed")
+
+	require.Len(t, created, 2)
+	for i, accountID := range []int64{3, 4} {
+		assert.Equal(t, accountID, created[i].AccountID)
+		assert.Equal(t, TypeDeployFinished, created[i].Type)
+		assert.Equal(t, "Deployment of catalog failed", created[i].Title)
+		assert.Equal(t, "terraform apply failed", created[i].Body)
+		assert.Equal(t, "/sites/site-uuid", created[i].Link)
+	}
+}
+
+// TestHub tests that publishing wakes only the account's subscribers and never blocks.
+func TestHub(t *testing.T) {
+	hub := NewHub()
+	wake, unsubscribe := hub.Subscribe(1)
+	other, unsubscribeOther := hub.Subscribe(2)
+	defer unsubscribeOther()
+
+	hub.Publish(1)
+	hub.Publish(1)
+	assert.Len(t, wake, 1)
+	assert.Len(t, other, 0)
+
+	unsubscribe()
+	<-wake
+	hub.Publish(1)
+	assert.Len(t, wake, 0)
+}
+
+// TestHandleStream tests the initial unread count and live delivery of new notifications.
+func TestHandleStream(t *testing.T) {
+	var mu sync.Mutex
+	unread := int64(2)
+	var pending []db.ListAccountNotificationsAfterRow
+	mock := &testutils.MockQuerier{
+		GetLatestAccountNotificationIDFunc: func(ctx context.Context, accountID int64) (int64, error) {
+			return 10, nil
+		},
+		CountUnreadAccountNotificationsFunc: func(ctx context.Context, accountID int64) (int64, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return unread, nil
+		},
+		ListAccountNotificationsAfterFunc: func(ctx context.Context, arg db.ListAccountNotificationsAfterParams) ([]db.ListAccountNotificationsAfterRow, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			var rows []db.ListAccountNotificationsAfterRow
+			for _, row := range pending {
+				if row.ID > arg.AfterID {
+					rows = append(rows, row)
+				}
+			}
+			return rows, nil
+		},
+	}
+	n := NewNotifier(mock)
+	n.pollInterval = time.Hour
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5})
+		n.HandleStream(w, r.WithContext(ctx))
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := bufio.NewReader(resp.Body)
+	assert.Equal(t, `event: unread|data: {"unreadCount":"2"}`, readEvent(t, events))
+
+	mu.Lock()
+	unread = 3
+	pending = append(pending, db.ListAccountNotificationsAfterRow{ID: 11, PublicID: "n-11", Type: TypeMemberAdded, Title: "Hello"})
+	mu.Unlock()
+	n.Hub().Publish(5)
+
+	notification := readEvent(t, events)
+	data, ok := strings.CutPrefix(notification, "event: notification|data: ")
+	require.True(t, ok, notification)
+	var got libopsv1.Notification
+	require.NoError(t, protojson.Unmarshal([]byte(data), &got))
+	assert.Equal(t, "n-11", got.NotificationId)
+	assert.Equal(t, "Hello", got.Title)
+	assert.Equal(t, `event: unread|data: {"unreadCount":"3"}`, readEvent(t, events))
+}
+
+// TestHandleStreamRequiresAuthentication tests that anonymous requests are rejected.
+func TestHandleStreamRequiresAuthentication(t *testing.T) {
+	rec := httptest.NewRecorder()
+	NewNotifier(&testutils.MockQuerier{}).HandleStream(rec, httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+// readEvent reads the next server-sent event, skipping comments, as "|"-joined lines
+func readEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, ":"):
+		case line == "" && len(lines) > 0:
+			return strings.Join(lines, "|")
+		case line != "":
+			lines = append(lines, line)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+)
+
+// defaultPollInterval is how often a stream checks the database for
+// notifications recorded by other API instances and sends a keepalive.
+const defaultPollInterval = 15 * time.Second
+
+// streamBatchSize caps the notifications sent per wake-up; the rest follow on the next one.
+const streamBatchSize = 50
+
+// HandleStream streams the authenticated user's new notifications and unread
+// count as server-sent events:
+//
+//	event: unread        data: {"unreadCount":"3"}
+//	event: notification  data: a Notification in protojson form
+//
+// The unread count is sent on connect and whenever it changes. Notifications
+// created before the stream opened are not replayed; clients list them with
+// NotificationService.ListNotifications.
+func (n *Notifier) HandleStream(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	accountID := userInfo.AccountID
+	ctx := r.Context()
+
+	lastID, err := n.db.GetLatestAccountNotificationID(ctx, accountID)
+	if err != nil {
+		slog.Error("Failed to start notification stream", "error", err, "account_id", accountID)
+		http.Error(w, "Failed to load notifications", http.StatusInternalServerError)
+		return
+	}
+
+	// The stream outlives the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		slog.Debug("Notification stream keeps the server write timeout", "error", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	wake, unsubscribe := n.hub.Subscribe(accountID)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+
+	unread := int64(-1)
+	for {
+		sent, err := n.sendUpdates(ctx, w, accountID, &lastID, &unread)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Warn("Notification stream closed", "error", err, "account_id", accountID)
+			}
+			return
+		}
+		if !sent {
+			// Keep idle connections open through proxies
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendUpdates writes notifications newer than lastID and the unread count when it
+// differs from the last one sent. It reports whether any event was written.
+func (n *Notifier) sendUpdates(ctx context.Context, w io.Writer, accountID int64, lastID, unread *int64) (bool, error) {
+	sent := false
+
+	rows, err := n.db.ListAccountNotificationsAfter(ctx, db.ListAccountNotificationsAfterParams{
+		AccountID: accountID,
+		AfterID:   *lastID,
+		Limit:     streamBatchSize,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	for _, row := range rows {
+		data, err := protojson.Marshal(ToProto(db.ListAccountNotificationsRow(row)))
+		if err != nil {
+			return sent, fmt.Errorf("failed to encode notification: %w", err)
+		}
+		if err := writeEvent(w, "notification", data); err != nil {
+			return sent, err
+		}
+		*lastID = row.ID
+		sent = true
+	}
+
+	count, err := n.db.CountUnreadAccountNotifications(ctx, accountID)
+	if err != nil {
+		return sent, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	if count != *unread {
+		if err := writeEvent(w, "unread", fmt.Appendf(nil, `{"unreadCount":"%d"}`, count)); err != nil {
+			return sent, err
+		}
+		*unread = count
+		sent = true
+	}
+	return sent, nil
+}
+
+func writeEvent(w io.Writer, event string, data []byte) error {
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
@@ -1,5 +1,7 @@
 package onboard
 
+import "errors"
+
 const (
 	// TemplateOJS is the URL for the Open Journal Systems template
 	TemplateOJS = "https://github.com/libops/ojs"
@@ -10,3 +12,23 @@ const (
 	// TemplateIsleSiteRepo is the repository identifier for creating new repos from template
 	TemplateIsleSiteRepo = "libops/isle-site-template"
 )
+
+// ResolveRepoURL maps a repository picker option to the GitHub repository URL a site deploys.
+// "new-from-template" resolves to an empty URL: the user creates the repository and sets it later.
+func ResolveRepoURL(option, customURL string) (string, error) {
+	switch option {
+	case "ojs":
+		return TemplateOJS, nil
+	case "isle-site-template":
+		return TemplateIsleSite, nil
+	case "custom":
+		if customURL == "" {
+			return "", errors.New("custom URL is required")
+		}
+		return customURL, nil
+	case "new-from-template":
+		return "", nil
+	default:
+		return "", errors.New("invalid repository option")
+	}
+}
@@ -3,17 +3,26 @@ package onboard
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
 	"net"
 	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
 	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/config"
 	"github.com/libops/api/internal/dash"
+	"github.com/libops/api/internal/i18n"
+	accountsvc "github.com/libops/api/internal/service/account"
+	"github.com/libops/api/internal/service/catalog"
 	"github.com/libops/api/internal/service/organization"
+	"github.com/libops/api/internal/signup"
 	"github.com/stripe/stripe-go/v84"
 	"github.com/stripe/stripe-go/v84/webhook"
 )
@@ -28,6 +37,7 @@ type Handler struct {
 	baseURL          string
 	sessionMgr       *SessionManager
 	billingMgr       billing.Manager
+	joiner           *signup.Joiner
 	disableBilling   bool
 }
 
@@ -46,6 +56,7 @@ func NewHandler(querier db.Querier, stripeKey, stripeWebhookKey, baseURL string)
 		baseURL:          baseURL,
 		sessionMgr:       NewSessionManager(querier),
 		billingMgr:       billing.NewStripeManager(querier),
+		joiner:           signup.NewJoiner(querier, audit.New(querier)),
 		disableBilling:   false,
 	}
 }
@@ -70,10 +81,16 @@ func NewHandlerWithConfig(querier db.Querier, cfg *config.Config, stripeKey, str
 		baseURL:          baseURL,
 		sessionMgr:       NewSessionManager(querier),
 		billingMgr:       billingMgr,
+		joiner:           signup.NewJoiner(querier, audit.New(querier)),
 		disableBilling:   disableBilling,
 	}
 }
 
+// BillingManager returns the billing manager used for checkout, so post-onboarding flows bill the same way
+func (h *Handler) BillingManager() billing.Manager {
+	return h.billingMgr
+}
+
 // RenderOnboarding renders the onboarding page
 func (h *Handler) RenderOnboarding(w http.ResponseWriter, r *http.Request) {
 	// Get user from context
@@ -91,9 +108,37 @@ func (h *Handler) RenderOnboarding(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Staff of an organization claiming their email domain join it instead of
+	// creating their own
+	var pending []string
+	if !account.OnboardingCompleted && account.Verified {
+		result, err := h.joiner.SignUp(r.Context(), account.ID)
+		switch {
+		case err != nil:
+			slog.Warn("Failed to sign up through email domain", "account_id", account.ID, "error", err)
+		case len(result.Joined) > 0:
+			http.Redirect(w, r, "/organizations/"+result.Joined[0].PublicID, http.StatusSeeOther)
+			return
+		default:
+			for _, o := range result.Pending {
+				pending = append(pending, o.Name)
+			}
+		}
+	}
+
+	// The account may have picked a language already; otherwise follow the browser
+	prefs, err := accountsvc.LoadPreferences(r.Context(), h.db, userInfo.AccountID)
+	if err != nil {
+		slog.Warn("Failed to load account preferences", "account_id", userInfo.AccountID, "error", err)
+	}
+	locale := i18n.Negotiate(prefs.Locale, r.Header.Get("Accept-Language"))
+
 	// Render the onboarding template
 	dash.RenderTemplate(w, "onboarding.html", map[string]any{
-		"Email": account.Email,
+		"Email":                account.Email,
+		"Locale":               locale,
+		"Messages":             i18n.Messages(locale, "onboarding.", "common."),
+		"PendingOrganizations": strings.Join(pending, ", "),
 	})
 }
 
@@ -234,8 +279,13 @@ func (h *Handler) HandleStep2(w http.ResponseWriter, r *http.Request) {
 
 	// Create Stripe checkout session using billing manager
 	// First-time onboarding always gets a 7-day trial
-	checkoutResult, err := h.billingMgr.CreateCheckoutSession(r.Context(), account.Email, session.PublicID, req.MachineType, req.DiskSizeGB, h.baseURL, true)
+	promoCode := strings.TrimSpace(req.PromoCode)
+	checkoutResult, err := h.billingMgr.CreateCheckoutSession(r.Context(), account.Email, session.PublicID, req.MachineType, req.DiskSizeGB, h.baseURL, true, promoCode)
 	if err != nil {
+		if errors.Is(err, billing.ErrInvalidPromoCode) {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid or expired promo code"})
+			return
+		}
 		slog.Error("Failed to create checkout session", "error", err)
 		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to create checkout session"})
 		return
@@ -285,10 +335,29 @@ func (h *Handler) HandleStep2(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Record the discount so the session summary reflects it; a resubmitted step 2 without a code clears it
+	var appliedCode, discountDescription string
+	if checkoutResult.Discount != nil {
+		appliedCode = checkoutResult.Discount.PromoCode
+		discountDescription = checkoutResult.Discount.Description()
+	}
+	err = h.db.SetOnboardingSessionDiscount(r.Context(), db.SetOnboardingSessionDiscountParams{
+		PromoCode:           sql.NullString{String: appliedCode, Valid: appliedCode != ""},
+		DiscountDescription: sql.NullString{String: discountDescription, Valid: discountDescription != ""},
+		ID:                  session.ID,
+	})
+	if err != nil {
+		slog.Error("Failed to record promo code", "error", err)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to update session"})
+		return
+	}
+
 	writeJSON(w, http.StatusOK, StripeCheckoutResponse{
 		CheckoutURL: checkoutResult.URL,
 		SkipBilling: h.disableBilling,
 		NextStep:    nextStep,
+		PromoCode:   appliedCode,
+		Discount:    discountDescription,
 	})
 }
 
@@ -386,7 +455,12 @@ func (h *Handler) HandleStep5(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Validate region for country
-	if !ValidateRegion(req.Country, req.Region) {
+	if err := catalog.ValidateRegion(r.Context(), h.db, req.Country, req.Region); err != nil {
+		if connect.CodeOf(err) == connect.CodeInternal {
+			slog.Error("Failed to validate region", "error", err)
+			writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to validate region"})
+			return
+		}
 		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid region for selected country"})
 		return
 	}
@@ -398,6 +472,19 @@ func (h *Handler) HandleStep5(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The project is created with the machine type chosen at checkout
+	if session.MachineType.Valid {
+		if err := catalog.CheckMachineTypeAvailable(r.Context(), h.db, req.Region, session.MachineType.String); err != nil {
+			if connect.CodeOf(err) == connect.CodeInternal {
+				slog.Error("Failed to check machine type availability", "error", err)
+				writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to validate region"})
+				return
+			}
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Your machine type is not available in this region"})
+			return
+		}
+	}
+
 	err = h.db.UpdateOnboardingSession(r.Context(), db.UpdateOnboardingSessionParams{
 		OrgName:                 session.OrgName,
 		OrgUuid:                 getOrgPublicID(session.OrganizationPublicID),
@@ -458,23 +545,9 @@ func (h *Handler) HandleStep6(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var repoURL string
-	switch req.RepoOption {
-	case "ojs":
-		repoURL = TemplateOJS
-	case "isle-site-template":
-		repoURL = TemplateIsleSite
-	case "custom":
-		if req.CustomURL == "" {
-			writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Custom URL is required"})
-			return
-		}
-		repoURL = req.CustomURL
-	case "new-from-template":
-		// User will create repo manually and come back
-		repoURL = ""
-	default:
-		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid repository option"})
+	repoURL, err := ResolveRepoURL(req.RepoOption, req.CustomURL)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -646,20 +719,6 @@ func (h *Handler) HandleGetClientIP(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]string{"ip": ip})
 }
 
-// HandleGetRegions returns available regions for a country
-func (h *Handler) HandleGetRegions(w http.ResponseWriter, r *http.Request) {
-	country := r.URL.Query().Get("country")
-	if country == "" {
-		// Return all mappings
-		writeJSON(w, http.StatusOK, GetRegionMappings())
-		return
-	}
-
-	// Return regions for specific country
-	regions := GetRegionsByCountry(country)
-	writeJSON(w, http.StatusOK, regions)
-}
-
 // getClientIP extracts the client IP from the request
 func getClientIP(r *http.Request) string {
 	// Try X-Forwarded-For header first
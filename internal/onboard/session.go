@@ -150,6 +150,12 @@ func ToResponse(session *db.GetOnboardingSessionByAccountIDRow) OnboardingSessio
 		diskSize := int(session.DiskSizeGb.Int32)
 		resp.DiskSizeGB = &diskSize
 	}
+	if session.PromoCode.Valid {
+		resp.PromoCode = &session.PromoCode.String
+	}
+	if session.DiscountDescription.Valid {
+		resp.Discount = &session.DiscountDescription.String
+	}
 	if session.ProjectName.Valid {
 		resp.ProjectName = &session.ProjectName.String
 	}
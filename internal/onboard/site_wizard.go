@@ -0,0 +1,373 @@
+package onboard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/service/catalog"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// ProjectCreator creates and deletes projects; new projects are added to the organization's subscription
+type ProjectCreator interface {
+	CreateProject(ctx context.Context, req *connect.Request[libopsv1.CreateProjectRequest]) (*connect.Response[libopsv1.CreateProjectResponse], error)
+	DeleteProject(ctx context.Context, req *connect.Request[libopsv1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// SiteCreator creates sites in an existing project
+type SiteCreator interface {
+	CreateSite(ctx context.Context, req *connect.Request[libopsv1.CreateSiteRequest]) (*connect.Response[libopsv1.CreateSiteResponse], error)
+}
+
+// SiteWizard provides the API behind the dashboard's "add another site" wizard.
+// It reuses the onboarding building blocks (machine types, regions, repository
+// picker and billing) to add a site to an existing project, or to a new project
+// that is added to the organization's subscription.
+type SiteWizard struct {
+	db         db.Querier
+	billingMgr billing.Manager
+	projects   ProjectCreator
+	sites      SiteCreator
+}
+
+// NewSiteWizard creates the site wizard API
+func NewSiteWizard(querier db.Querier, billingMgr billing.Manager, projects ProjectCreator, sites SiteCreator) *SiteWizard {
+	return &SiteWizard{
+		db:         querier,
+		billingMgr: billingMgr,
+		projects:   projects,
+		sites:      sites,
+	}
+}
+
+// HandleOptions returns the choices for the wizard: the organization's projects the user
+// can add sites to, plus the machine types and disk limits for a new project
+func (sw *SiteWizard) HandleOptions(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	orgPublicID, err := uuid.Parse(r.URL.Query().Get("organization_id"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid organization"})
+		return
+	}
+
+	authorizer := auth.NewAuthorizer(sw.db)
+	if authorizer.CheckOrganizationAccess(r.Context(), userInfo, orgPublicID, auth.PermissionRead) != nil {
+		writeJSON(w, http.StatusForbidden, ErrorResponse{Error: "Access denied"})
+		return
+	}
+
+	org, err := sw.db.GetOrganization(r.Context(), orgPublicID.String())
+	if err != nil {
+		slog.Error("Failed to get organization for site wizard", "error", err, "organization_id", orgPublicID)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to get organization"})
+		return
+	}
+
+	dbProjects, err := sw.db.ListOrganizationProjects(r.Context(), db.ListOrganizationProjectsParams{
+		OrganizationID: org.ID,
+		Limit:          100,
+		Offset:         0,
+	})
+	if err != nil {
+		slog.Error("Failed to list projects for site wizard", "error", err, "organization_id", orgPublicID)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to list projects"})
+		return
+	}
+
+	resp := SiteWizardOptionsResponse{
+		CanCreateProject: authorizer.CheckOrganizationAccess(r.Context(), userInfo, orgPublicID, auth.PermissionWrite) == nil,
+		Projects:         []WizardProject{},
+		MachineTypes:     []WizardMachineType{},
+	}
+	for _, p := range dbProjects {
+		// The organization's own infrastructure project doesn't host sites
+		if p.OrganizationProject.Bool {
+			continue
+		}
+		projectID, err := uuid.Parse(p.PublicID)
+		if err != nil || authorizer.CheckProjectAccess(r.Context(), userInfo, projectID, auth.PermissionWrite) != nil {
+			continue
+		}
+		resp.Projects = append(resp.Projects, WizardProject{
+			ID:          p.PublicID,
+			Name:        p.Name,
+			Region:      p.GcpRegion.String,
+			MachineType: p.MachineType.String,
+			DiskSizeGB:  int(p.DiskSizeGb.Int32),
+		})
+	}
+
+	machineTypes, err := sw.db.ListMachineTypes(r.Context())
+	if err != nil {
+		slog.Error("Failed to list machine types for site wizard", "error", err)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to list machine types"})
+		return
+	}
+	for _, mt := range machineTypes {
+		resp.MachineTypes = append(resp.MachineTypes, WizardMachineType{
+			MachineType:       mt.MachineType,
+			DisplayName:       mt.DisplayName,
+			VCPU:              int(mt.Vcpu),
+			MemoryGiB:         int(mt.MemoryGib),
+			MonthlyPriceCents: int(mt.MonthlyPriceCents),
+		})
+	}
+
+	storage, err := sw.db.GetStorageConfig(r.Context())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("Failed to get storage config for site wizard", "error", err)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to get storage options"})
+		return
+	}
+	resp.MinDiskGB = int(storage.MinSizeGb)
+	resp.MaxDiskGB = int(storage.MaxSizeGb)
+	resp.DiskPricePerGBCents = int(storage.PricePerGbCents)
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// HandleCreate creates a site from the wizard. When no existing project is chosen a
+// new project is created first, which adds its machine and disk to the organization's
+// subscription; that project is removed again if the site can't be created.
+func (sw *SiteWizard) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req NewSiteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid request"})
+		return
+	}
+
+	siteConfig, err := sw.validate(ctx, &req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	orgPublicID := uuid.MustParse(req.OrganizationID)
+	authorizer := auth.NewAuthorizer(sw.db)
+	if req.ProjectID != "" {
+		if authorizer.CheckProjectAccess(ctx, userInfo, uuid.MustParse(req.ProjectID), auth.PermissionWrite) != nil {
+			writeJSON(w, http.StatusForbidden, ErrorResponse{Error: "Access denied"})
+			return
+		}
+	} else if authorizer.CheckOrganizationAccess(ctx, userInfo, orgPublicID, auth.PermissionWrite) != nil {
+		writeJSON(w, http.StatusForbidden, ErrorResponse{Error: "Access denied"})
+		return
+	}
+
+	org, err := sw.db.GetOrganization(ctx, orgPublicID.String())
+	if err != nil {
+		slog.Error("Failed to get organization for site wizard", "error", err, "organization_id", req.OrganizationID)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to get organization"})
+		return
+	}
+
+	// A new project must be in a region the organization's data residency allows
+	if req.ProjectID == "" {
+		if err := catalog.CheckResidency(ctx, sw.db, org.ID, req.Region); err != nil {
+			if connect.CodeOf(err) == connect.CodeInternal {
+				slog.Error("Failed to check data residency for site wizard", "error", err, "organization_id", req.OrganizationID)
+			}
+			writeJSON(w, httpStatusForError(err), ErrorResponse{Error: connectMessage(err)})
+			return
+		}
+	}
+
+	if req.ProjectID != "" {
+		project, err := sw.db.GetProject(ctx, req.ProjectID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				writeJSON(w, http.StatusNotFound, ErrorResponse{Error: "Project not found"})
+				return
+			}
+			slog.Error("Failed to get project for site wizard", "error", err, "project_id", req.ProjectID)
+			writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to get project"})
+			return
+		}
+		if project.OrganizationID != org.ID {
+			writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Project does not belong to the organization"})
+			return
+		}
+	}
+
+	// Organizations behind on payment can't add billable resources
+	billingState, err := sw.db.GetOrganizationBillingState(ctx, org.ID)
+	if err != nil {
+		slog.Error("Failed to get billing state for site wizard", "error", err, "organization_id", req.OrganizationID)
+		writeJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "Failed to get organization"})
+		return
+	}
+	if billingState.BillingState != db.OrganizationsBillingStateActive {
+		writeJSON(w, http.StatusPaymentRequired, ErrorResponse{Error: "Update your payment method before adding sites"})
+		return
+	}
+
+	projectCreated := false
+	if req.ProjectID == "" {
+		projectResp, err := sw.projects.CreateProject(ctx, connect.NewRequest(&libopsv1.CreateProjectRequest{
+			OrganizationId: req.OrganizationID,
+			Project: &commonv1.ProjectConfig{
+				ProjectName: req.ProjectName,
+				Region:      req.Region,
+				Zone:        req.Region + "-a",
+				MachineType: req.MachineType,
+				DiskSizeGb:  int32(req.DiskSizeGB),
+			},
+		}))
+		if err != nil {
+			slog.Error("Failed to create project from site wizard", "error", err, "organization_id", req.OrganizationID)
+			writeJSON(w, httpStatusForError(err), ErrorResponse{Error: "Failed to create project: " + connectMessage(err)})
+			return
+		}
+		req.ProjectID = projectResp.Msg.Project.ProjectId
+		projectCreated = true
+	}
+
+	siteResp, err := sw.sites.CreateSite(ctx, connect.NewRequest(&libopsv1.CreateSiteRequest{
+		OrganizationId: req.OrganizationID,
+		ProjectId:      req.ProjectID,
+		Site:           siteConfig,
+	}))
+	if err != nil {
+		slog.Error("Failed to create site from site wizard", "error", err, "project_id", req.ProjectID)
+		if projectCreated {
+			sw.rollbackProject(ctx, req.ProjectID)
+		}
+		writeJSON(w, httpStatusForError(err), ErrorResponse{Error: "Failed to create site: " + connectMessage(err)})
+		return
+	}
+
+	slog.Info("Site created from wizard",
+		"account_id", userInfo.AccountID,
+		"organization_id", req.OrganizationID,
+		"project_id", req.ProjectID,
+		"site_id", siteResp.Msg.Site.SiteId,
+		"project_created", projectCreated)
+
+	writeJSON(w, http.StatusOK, NewSiteResponse{
+		OrganizationID: req.OrganizationID,
+		ProjectID:      req.ProjectID,
+		SiteID:         siteResp.Msg.Site.SiteId,
+		ProjectCreated: projectCreated,
+	})
+}
+
+// validate checks the wizard input and builds the site configuration, using the
+// same defaults as onboarding
+func (sw *SiteWizard) validate(ctx context.Context, req *NewSiteRequest) (*commonv1.SiteConfig, error) {
+	if _, err := uuid.Parse(req.OrganizationID); err != nil {
+		return nil, errors.New("invalid organization")
+	}
+
+	if req.ProjectID != "" {
+		if _, err := uuid.Parse(req.ProjectID); err != nil {
+			return nil, errors.New("invalid project")
+		}
+	} else {
+		req.ProjectName = strings.TrimSpace(req.ProjectName)
+		if req.ProjectName == "" {
+			return nil, errors.New("project name is required")
+		}
+		if err := sw.billingMgr.ValidateMachineType(ctx, req.MachineType); err != nil {
+			return nil, errors.New("invalid machine type")
+		}
+		if err := sw.billingMgr.ValidateDiskSize(ctx, req.DiskSizeGB); err != nil {
+			return nil, err
+		}
+		if err := catalog.ValidateRegion(ctx, sw.db, req.Country, req.Region); err != nil {
+			return nil, errors.New("invalid region for selected country")
+		}
+		if err := catalog.CheckMachineTypeAvailable(ctx, sw.db, req.Region, req.MachineType); err != nil {
+			return nil, errors.New("machine type is not available in the selected region")
+		}
+	}
+
+	req.SiteName = strings.TrimSpace(req.SiteName)
+	if req.SiteName == "" {
+		return nil, errors.New("site name is required")
+	}
+	if req.Port == 0 {
+		req.Port = 80
+	}
+	if req.Port < 1 || req.Port > 65535 {
+		return nil, errors.New("port must be between 1 and 65535")
+	}
+	repoURL, err := ResolveRepoURL(req.RepoOption, req.CustomURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commonv1.SiteConfig{
+		SiteName:         req.SiteName,
+		GithubRepository: repoURL,
+		GithubRef:        "heads/main",
+		ComposePath:      "/mnt/disks/data/compose",
+		ComposeFile:      "docker-compose.yml",
+		Port:             int32(req.Port),
+		ApplicationType:  "generic",
+		UpCmd:            []string{"docker compose up --remove-orphans -d"},
+		InitCmd:          []string{},
+		RolloutCmd:       []string{"docker compose pull", "docker compose up --remove-orphans -d"},
+	}, nil
+}
+
+// rollbackProject deletes a project the wizard created, which removes it from the subscription
+func (sw *SiteWizard) rollbackProject(ctx context.Context, projectID string) {
+	_, err := sw.projects.DeleteProject(ctx, connect.NewRequest(&libopsv1.DeleteProjectRequest{
+		ProjectId: projectID,
+	}))
+	if err != nil {
+		slog.Error("Failed to roll back project created by site wizard", "error", err, "project_id", projectID)
+	}
+}
+
+// httpStatusForError maps a service error onto the closest HTTP status
+func httpStatusForError(err error) int {
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition, connect.CodeOutOfRange:
+		return http.StatusBadRequest
+	case connect.CodeAlreadyExists:
+		return http.StatusConflict
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeResourceExhausted:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// connectMessage returns the user-facing part of a service error, hiding internal details
+func connectMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && httpStatusForError(err) != http.StatusInternalServerError {
+		return connectErr.Message()
+	}
+	return "please try again"
+}
@@ -0,0 +1,418 @@
+package onboard
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+const (
+	wizardOrg          = "0194d3a0-0000-7000-8000-000000000001"
+	wizardProject      = "0194d3a0-0000-7000-8000-000000000002"
+	wizardOtherProject = "0194d3a0-0000-7000-8000-000000000003"
+	wizardNewProject   = "0194d3a0-0000-7000-8000-000000000004"
+)
+
+// wizardBilling accepts the e2-medium machine type and disks of 10 to 100 GB
+type wizardBilling struct {
+	billing.Manager
+}
+
+func (wizardBilling) ValidateMachineType(ctx context.Context, machineType string) error {
+	if machineType != "e2-medium" {
+		return errors.New("unknown machine type")
+	}
+	return nil
+}
+
+func (wizardBilling) ValidateDiskSize(ctx context.Context, diskSizeGB int) error {
+	if diskSizeGB < 10 || diskSizeGB > 100 {
+		return errors.New("disk size must be between 10 and 100 GB")
+	}
+	return nil
+}
+
+// wizardServices records the projects and sites the wizard creates
+type wizardServices struct {
+	projects        []*libopsv1.CreateProjectRequest
+	deletedProjects []string
+	sites           []*libopsv1.CreateSiteRequest
+	siteErr         error
+}
+
+func (s *wizardServices) CreateProject(ctx context.Context, req *connect.Request[libopsv1.CreateProjectRequest]) (*connect.Response[libopsv1.CreateProjectResponse], error) {
+	s.projects = append(s.projects, req.Msg)
+	return connect.NewResponse(&libopsv1.CreateProjectResponse{
+		Project: &commonv1.ProjectConfig{ProjectId: wizardNewProject},
+	}), nil
+}
+
+func (s *wizardServices) DeleteProject(ctx context.Context, req *connect.Request[libopsv1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error) {
+	s.deletedProjects = append(s.deletedProjects, req.Msg.ProjectId)
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *wizardServices) CreateSite(ctx context.Context, req *connect.Request[libopsv1.CreateSiteRequest]) (*connect.Response[libopsv1.CreateSiteResponse], error) {
+	if s.siteErr != nil {
+		return nil, s.siteErr
+	}
+	s.sites = append(s.sites, req.Msg)
+	return connect.NewResponse(&libopsv1.CreateSiteResponse{
+		Site: &commonv1.SiteConfig{SiteId: "site-1"},
+	}), nil
+}
+
+// newWizardQuerier serves an organization the caller holds role in, with one project
+// of its own, one of another organization and us-east1 offering every machine type
+func newWizardQuerier(role db.OrganizationMembersRole) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{AccountID: arg.AccountID, Role: role}, nil
+		},
+		GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+			switch publicID {
+			case wizardProject:
+				return db.GetProjectRow{ID: 10, PublicID: publicID, OrganizationID: 1}, nil
+			case wizardOtherProject:
+				return db.GetProjectRow{ID: 11, PublicID: publicID, OrganizationID: 2}, nil
+			}
+			return db.GetProjectRow{}, sql.ErrNoRows
+		},
+		GetProjectMemberFunc: func(ctx context.Context, arg db.GetProjectMemberParams) (db.GetProjectMemberRow, error) {
+			return db.GetProjectMemberRow{}, sql.ErrNoRows
+		},
+		GetRegionFunc: func(ctx context.Context, code string) (db.Region, error) {
+			if code != "us-east1" {
+				return db.Region{}, sql.ErrNoRows
+			}
+			return db.Region{Code: code, Country: "us"}, nil
+		},
+		RegionOffersMachineSeriesFunc: func(ctx context.Context, arg db.RegionOffersMachineSeriesParams) (bool, error) {
+			return true, nil
+		},
+		GetOrganizationBillingStateFunc: func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+			return db.GetOrganizationBillingStateRow{BillingState: db.OrganizationsBillingStateActive}, nil
+		},
+	}
+}
+
+func wizardRequest(t *testing.T, method, target string, body any) *http.Request {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	r := httptest.NewRequest(method, target, &buf)
+	return r.WithContext(context.WithValue(r.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+}
+
+// existingProjectSite is a valid request adding a site to wizardProject
+func existingProjectSite() NewSiteRequest {
+	return NewSiteRequest{
+		OrganizationID: wizardOrg,
+		ProjectID:      wizardProject,
+		SiteName:       " blog ",
+		RepoOption:     "ojs",
+	}
+}
+
+// newProjectSite is a valid request adding a site to a new project
+func newProjectSite() NewSiteRequest {
+	return NewSiteRequest{
+		OrganizationID: wizardOrg,
+		ProjectName:    " Journals ",
+		MachineType:    "e2-medium",
+		DiskSizeGB:     20,
+		Country:        "us",
+		Region:         "us-east1",
+		SiteName:       "journal",
+		RepoOption:     "custom",
+		CustomURL:      "https://github.com/example/journal",
+		Port:           8080,
+	}
+}
+
+// TestSiteWizardOptions tests that the wizard offers the organization's projects the
+// user can write to, except its infrastructure project, with the catalog for a new one.
+func TestSiteWizardOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		role          db.OrganizationMembersRole
+		wantProjects  []string
+		wantCanCreate bool
+	}{
+		{name: "owner", role: db.OrganizationMembersRoleOwner, wantProjects: []string{wizardProject}, wantCanCreate: true},
+		{name: "read only", role: db.OrganizationMembersRoleRead},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newWizardQuerier(tt.role)
+			mock.ListOrganizationProjectsFunc = func(ctx context.Context, arg db.ListOrganizationProjectsParams) ([]db.ListOrganizationProjectsRow, error) {
+				return []db.ListOrganizationProjectsRow{
+					{PublicID: wizardProject, Name: "Websites", GcpRegion: sql.NullString{String: "us-east1", Valid: true}},
+					{PublicID: wizardOtherProject, Name: "Infrastructure", OrganizationProject: sql.NullBool{Bool: true, Valid: true}},
+				}, nil
+			}
+			mock.ListMachineTypesFunc = func(ctx context.Context) ([]db.MachineType, error) {
+				return []db.MachineType{{MachineType: "e2-medium", DisplayName: "Medium", Vcpu: 2, MemoryGib: 4, MonthlyPriceCents: 2500}}, nil
+			}
+			mock.GetStorageConfigFunc = func(ctx context.Context) (db.StorageConfig, error) {
+				return db.StorageConfig{MinSizeGb: 10, MaxSizeGb: 100, PricePerGbCents: 10}, nil
+			}
+			wizard := NewSiteWizard(mock, wizardBilling{}, &wizardServices{}, &wizardServices{})
+
+			w := httptest.NewRecorder()
+			wizard.HandleOptions(w, wizardRequest(t, http.MethodGet, "/api/sites/new/options?organization_id="+wizardOrg, nil))
+
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+			var resp SiteWizardOptionsResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			projects := []string{}
+			for _, p := range resp.Projects {
+				projects = append(projects, p.ID)
+			}
+			assert.ElementsMatch(t, tt.wantProjects, projects)
+			assert.Equal(t, tt.wantCanCreate, resp.CanCreateProject)
+			require.Len(t, resp.MachineTypes, 1)
+			assert.Equal(t, "e2-medium", resp.MachineTypes[0].MachineType)
+			assert.Equal(t, 10, resp.MinDiskGB)
+			assert.Equal(t, 100, resp.MaxDiskGB)
+		})
+	}
+
+	t.Run("invalid organization", func(t *testing.T) {
+		wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, &wizardServices{}, &wizardServices{})
+		w := httptest.NewRecorder()
+		wizard.HandleOptions(w, wizardRequest(t, http.MethodGet, "/api/sites/new/options?organization_id=acme", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+// TestSiteWizardCreateValidation tests that each step's input is checked before
+// anything is created.
+func TestSiteWizardCreateValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		edit    func(req *NewSiteRequest)
+		wantErr string
+	}{
+		{name: "organization", edit: func(req *NewSiteRequest) { req.OrganizationID = "acme" }, wantErr: "invalid organization"},
+		{name: "existing project", edit: func(req *NewSiteRequest) { req.ProjectID = "websites" }, wantErr: "invalid project"},
+		{name: "project name", edit: func(req *NewSiteRequest) { req.ProjectID, req.ProjectName = "", "  " }, wantErr: "project name is required"},
+		{name: "machine type", edit: func(req *NewSiteRequest) { req.ProjectID, req.MachineType = "", "n2-huge" }, wantErr: "invalid machine type"},
+		{name: "disk size", edit: func(req *NewSiteRequest) { req.ProjectID, req.DiskSizeGB = "", 500 }, wantErr: "disk size must be between 10 and 100 GB"},
+		{name: "region", edit: func(req *NewSiteRequest) { req.ProjectID, req.Region = "", "europe-west1" }, wantErr: "invalid region for selected country"},
+		{name: "region outside country", edit: func(req *NewSiteRequest) { req.ProjectID, req.Country = "", "eu" }, wantErr: "invalid region for selected country"},
+		{name: "site name", edit: func(req *NewSiteRequest) { req.SiteName = " " }, wantErr: "site name is required"},
+		{name: "port", edit: func(req *NewSiteRequest) { req.Port = 70000 }, wantErr: "port must be between 1 and 65535"},
+		{name: "repository option", edit: func(req *NewSiteRequest) { req.RepoOption = "svn" }, wantErr: "invalid repository option"},
+		{name: "custom repository", edit: func(req *NewSiteRequest) { req.RepoOption, req.CustomURL = "custom", "" }, wantErr: "custom URL is required"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newProjectSite()
+			req.ProjectID = wizardProject
+			tt.edit(&req)
+			services := &wizardServices{}
+			wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, services, services)
+
+			w := httptest.NewRecorder()
+			wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", req))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var resp ErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.Equal(t, tt.wantErr, resp.Error)
+			assert.Empty(t, services.projects)
+			assert.Empty(t, services.sites)
+		})
+	}
+
+	t.Run("machine type unavailable in region", func(t *testing.T) {
+		mock := newWizardQuerier(db.OrganizationMembersRoleOwner)
+		mock.RegionOffersMachineSeriesFunc = func(ctx context.Context, arg db.RegionOffersMachineSeriesParams) (bool, error) {
+			return false, nil
+		}
+		services := &wizardServices{}
+		wizard := NewSiteWizard(mock, wizardBilling{}, services, services)
+
+		w := httptest.NewRecorder()
+		wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", newProjectSite()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "machine type is not available in the selected region")
+		assert.Empty(t, services.projects)
+	})
+}
+
+// TestSiteWizardCreateInExistingProject tests that a site added to an existing project
+// gets onboarding's defaults and leaves the subscription alone.
+func TestSiteWizardCreateInExistingProject(t *testing.T) {
+	services := &wizardServices{}
+	wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, services, services)
+
+	w := httptest.NewRecorder()
+	wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", existingProjectSite()))
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var resp NewSiteResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, NewSiteResponse{OrganizationID: wizardOrg, ProjectID: wizardProject, SiteID: "site-1"}, resp)
+
+	assert.Empty(t, services.projects, "no project is added to the subscription")
+	require.Len(t, services.sites, 1)
+	site := services.sites[0]
+	assert.Equal(t, wizardProject, site.ProjectId)
+	assert.Equal(t, "blog", site.Site.SiteName)
+	assert.Equal(t, TemplateOJS, site.Site.GithubRepository)
+	assert.Equal(t, int32(80), site.Site.Port, "port defaults to 80")
+	assert.Equal(t, "heads/main", site.Site.GithubRef)
+}
+
+// TestSiteWizardCreateRefused tests the checks made once the input is valid: access,
+// project ownership, data residency and billing state.
+func TestSiteWizardCreateRefused(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		role       db.OrganizationMembersRole
+		req        func() NewSiteRequest
+		edit       func(mock *testutils.MockQuerier)
+		wantStatus int
+	}{
+		{
+			name:       "read only member",
+			role:       db.OrganizationMembersRoleRead,
+			req:        existingProjectSite,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "read only member creating a project",
+			role:       db.OrganizationMembersRoleRead,
+			req:        newProjectSite,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "project of another organization",
+			req: func() NewSiteRequest {
+				req := existingProjectSite()
+				req.ProjectID = wizardOtherProject
+				return req
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "region outside data residency",
+			req:  newProjectSite,
+			edit: func(mock *testutils.MockQuerier) {
+				mock.GetOrganizationDataResidencyFunc = func(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error) {
+					return db.NullOrganizationsDataResidency{OrganizationsDataResidency: db.OrganizationsDataResidencyEu, Valid: true}, nil
+				}
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "payment past due",
+			req:  newProjectSite,
+			edit: func(mock *testutils.MockQuerier) {
+				mock.GetOrganizationBillingStateFunc = func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+					return db.GetOrganizationBillingStateRow{BillingState: db.OrganizationsBillingStatePastDue}, nil
+				}
+			},
+			wantStatus: http.StatusPaymentRequired,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			role := tt.role
+			if role == "" {
+				role = db.OrganizationMembersRoleOwner
+			}
+			mock := newWizardQuerier(role)
+			if tt.edit != nil {
+				tt.edit(mock)
+			}
+			services := &wizardServices{}
+			wizard := NewSiteWizard(mock, wizardBilling{}, services, services)
+
+			w := httptest.NewRecorder()
+			wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", tt.req()))
+
+			assert.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			assert.Empty(t, services.projects)
+			assert.Empty(t, services.sites)
+		})
+	}
+}
+
+// TestSiteWizardCreateWithNewProject tests that a new project is created from the
+// wizard's project step before the site, and removed again if the site fails.
+func TestSiteWizardCreateWithNewProject(t *testing.T) {
+	t.Run("creates", func(t *testing.T) {
+		services := &wizardServices{}
+		wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, services, services)
+
+		w := httptest.NewRecorder()
+		wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", newProjectSite()))
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var resp NewSiteResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		assert.Equal(t, NewSiteResponse{OrganizationID: wizardOrg, ProjectID: wizardNewProject, SiteID: "site-1", ProjectCreated: true}, resp)
+
+		require.Len(t, services.projects, 1)
+		project := services.projects[0].Project
+		assert.Equal(t, wizardOrg, services.projects[0].OrganizationId)
+		assert.Equal(t, "Journals", project.ProjectName)
+		assert.Equal(t, "us-east1", project.Region)
+		assert.Equal(t, "us-east1-a", project.Zone)
+		assert.Equal(t, "e2-medium", project.MachineType)
+		assert.Equal(t, int32(20), project.DiskSizeGb)
+
+		require.Len(t, services.sites, 1)
+		assert.Equal(t, wizardNewProject, services.sites[0].ProjectId)
+		assert.Equal(t, "https://github.com/example/journal", services.sites[0].Site.GithubRepository)
+		assert.Equal(t, int32(8080), services.sites[0].Site.Port)
+		assert.Empty(t, services.deletedProjects)
+	})
+
+	t.Run("site fails", func(t *testing.T) {
+		services := &wizardServices{siteErr: connect.NewError(connect.CodeAlreadyExists, errors.New("site journal already exists"))}
+		wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, services, services)
+
+		w := httptest.NewRecorder()
+		wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", newProjectSite()))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "site journal already exists")
+		assert.Equal(t, []string{wizardNewProject}, services.deletedProjects, "the new project is rolled back")
+	})
+
+	t.Run("site fails in an existing project", func(t *testing.T) {
+		services := &wizardServices{siteErr: connect.NewError(connect.CodeInternal, errors.New("database is down"))}
+		wizard := NewSiteWizard(newWizardQuerier(db.OrganizationMembersRoleOwner), wizardBilling{}, services, services)
+
+		w := httptest.NewRecorder()
+		wizard.HandleCreate(w, wizardRequest(t, http.MethodPost, "/api/sites/new", existingProjectSite()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotContains(t, w.Body.String(), "database is down", "internal errors are hidden")
+		assert.Empty(t, services.deletedProjects)
+	})
+}
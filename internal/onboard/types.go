@@ -10,6 +10,7 @@ type Step1Request struct {
 type Step2Request struct {
 	MachineType string `json:"machine_type"`
 	DiskSizeGB  int    `json:"disk_size_gb"`
+	PromoCode   string `json:"promo_code,omitempty"`
 }
 
 // StripeCheckoutResponse contains the Stripe checkout URL and billing skip info
@@ -17,6 +18,8 @@ type StripeCheckoutResponse struct {
 	CheckoutURL string `json:"checkout_url"`           // Empty if billing is disabled
 	SkipBilling bool   `json:"skip_billing,omitempty"` // True when DISABLE_BILLING is set
 	NextStep    int32  `json:"next_step,omitempty"`    // Next step number (3 for Stripe, 4 to skip)
+	PromoCode   string `json:"promo_code,omitempty"`   // Promo code applied to the checkout
+	Discount    string `json:"discount,omitempty"`     // Human-readable discount, e.g. "20% off for 3 months"
 }
 
 // Step4Request contains the project name from step 4
@@ -51,6 +54,8 @@ type OnboardingSessionResponse struct {
 	OrganizationPublicID *string `json:"organization_public_id,omitempty"`
 	MachineType          *string `json:"machine_type,omitempty"`
 	DiskSizeGB           *int    `json:"disk_size_gb,omitempty"`
+	PromoCode            *string `json:"promo_code,omitempty"`
+	Discount             *string `json:"discount,omitempty"`
 	ProjectName          *string `json:"project_name,omitempty"`
 	GCPCountry           *string `json:"gcp_country,omitempty"`
 	GCPRegion            *string `json:"gcp_region,omitempty"`
@@ -63,6 +68,59 @@ type OnboardingSessionResponse struct {
 	StripeCheckoutURL    *string `json:"stripe_checkout_url,omitempty"`
 }
 
+// NewSiteRequest is submitted by the "add another site" wizard.
+// ProjectID selects an existing project; when empty a new project is created
+// from the project fields and added to the organization's subscription.
+type NewSiteRequest struct {
+	OrganizationID string `json:"organization_id"`
+	ProjectID      string `json:"project_id,omitempty"`
+	ProjectName    string `json:"project_name,omitempty"`
+	MachineType    string `json:"machine_type,omitempty"`
+	DiskSizeGB     int    `json:"disk_size_gb,omitempty"`
+	Country        string `json:"country,omitempty"`
+	Region         string `json:"region,omitempty"`
+	SiteName       string `json:"site_name"`
+	RepoOption     string `json:"repo_option"` // "ojs", "isle-site-template", "custom", "new-from-template"
+	CustomURL      string `json:"custom_url,omitempty"`
+	Port           int    `json:"port"` // Default 80
+}
+
+// NewSiteResponse identifies the site created by the wizard
+type NewSiteResponse struct {
+	OrganizationID string `json:"organization_id"`
+	ProjectID      string `json:"project_id"`
+	SiteID         string `json:"site_id"`
+	ProjectCreated bool   `json:"project_created"`
+}
+
+// SiteWizardOptionsResponse lists the choices offered by the site wizard for an organization
+type SiteWizardOptionsResponse struct {
+	CanCreateProject    bool                `json:"can_create_project"`
+	Projects            []WizardProject     `json:"projects"`
+	MachineTypes        []WizardMachineType `json:"machine_types"`
+	MinDiskGB           int                 `json:"min_disk_gb"`
+	MaxDiskGB           int                 `json:"max_disk_gb"`
+	DiskPricePerGBCents int                 `json:"disk_price_per_gb_cents"`
+}
+
+// WizardProject is an existing project a site can be added to without changing the subscription
+type WizardProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Region      string `json:"region,omitempty"`
+	MachineType string `json:"machine_type,omitempty"`
+	DiskSizeGB  int    `json:"disk_size_gb,omitempty"`
+}
+
+// WizardMachineType is a machine type available for a new project
+type WizardMachineType struct {
+	MachineType       string `json:"machine_type"`
+	DisplayName       string `json:"display_name"`
+	VCPU              int    `json:"vcpu"`
+	MemoryGiB         int    `json:"memory_gib"`
+	MonthlyPriceCents int    `json:"monthly_price_cents"`
+}
+
 // ErrorResponse is a standard error response
 type ErrorResponse struct {
 	Error string `json:"error"`
@@ -0,0 +1,131 @@
+// Package ownership hands organizations from one owner to another: it moves the
+// resources an owner holds for an organization to the member taking over, and
+// picks successors when an owner's account is removed so no organization is left
+// without an owner.
+package ownership
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/billing"
+)
+
+// ErrNoSuccessor is returned when an owner leaves an organization that has no
+// other owner or developer to take it over
+var ErrNoSuccessor = errors.New("organization has no other owner or developer to take it over")
+
+// Reassigner moves organization-critical resources between accounts
+type Reassigner struct {
+	db      db.Querier
+	billing billing.Manager
+}
+
+// NewReassigner creates a new reassigner.
+func NewReassigner(querier db.Querier, billingManager billing.Manager) *Reassigner {
+	return &Reassigner{
+		db:      querier,
+		billing: billingManager,
+	}
+}
+
+// Reassign moves what fromAccountID holds for an organization to toAccountID: the
+// API keys it created for the organization's platform service accounts, and the
+// billing contact Stripe sends invoices to. A failed billing update is logged
+// rather than returned, since the membership change it follows has been made.
+func (r *Reassigner) Reassign(ctx context.Context, organizationID, fromAccountID, toAccountID int64, toEmail string) error {
+	keys, err := r.db.ReassignServiceAccountAPIKeys(ctx, db.ReassignServiceAccountAPIKeysParams{
+		ToAccountID:    sql.NullInt64{Int64: toAccountID, Valid: true},
+		OrganizationID: organizationID,
+		FromAccountID:  sql.NullInt64{Int64: fromAccountID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reassign service account API keys: %w", err)
+	}
+	if keys > 0 {
+		slog.Info("Reassigned service account API keys",
+			"organization_id", organizationID,
+			"from_account_id", fromAccountID,
+			"to_account_id", toAccountID,
+			"keys", keys)
+	}
+
+	if r.billing != nil {
+		if err := r.billing.UpdateBillingContact(ctx, organizationID, toEmail); err != nil {
+			slog.Error("Failed to update billing contact", "error", err, "organization_id", organizationID)
+		}
+	}
+	return nil
+}
+
+// Handover is an organization an owner is leaving and the member taking it over
+type Handover struct {
+	OrganizationID   int64
+	OrganizationName string
+	Successor        db.GetOrganizationSuccessorRow
+}
+
+// PlanHandovers finds a successor for every organization the account owns. It
+// fails with ErrNoSuccessor before anything has changed, so the account can't be
+// removed until its organizations are transferred or deleted.
+func (r *Reassigner) PlanHandovers(ctx context.Context, accountID int64) ([]Handover, error) {
+	owned, err := r.db.ListAccountOwnedOrganizations(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list owned organizations: %w", err)
+	}
+
+	handovers := make([]Handover, 0, len(owned))
+	for _, organization := range owned {
+		successor, err := r.db.GetOrganizationSuccessor(ctx, db.GetOrganizationSuccessorParams{
+			OrganizationID: organization.ID,
+			AccountID:      accountID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrNoSuccessor, organization.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find successor for organization %s: %w", organization.PublicID, err)
+		}
+		handovers = append(handovers, Handover{
+			OrganizationID:   organization.ID,
+			OrganizationName: organization.Name,
+			Successor:        successor,
+		})
+	}
+	return handovers, nil
+}
+
+// HandOver makes each successor an owner, reassigns the leaving account's
+// resources to them and cancels the organization's open ownership transfers.
+func (r *Reassigner) HandOver(ctx context.Context, accountID int64, handovers []Handover) error {
+	for _, handover := range handovers {
+		if handover.Successor.Role != db.OrganizationMembersRoleOwner {
+			err := r.db.UpdateOrganizationMember(ctx, db.UpdateOrganizationMemberParams{
+				Role:           db.OrganizationMembersRoleOwner,
+				OrganizationID: handover.OrganizationID,
+				AccountID:      handover.Successor.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to promote successor of organization %s: %w", handover.OrganizationName, err)
+			}
+		}
+
+		if err := r.db.CancelPendingOrganizationOwnershipTransfers(ctx, handover.OrganizationID); err != nil {
+			return fmt.Errorf("failed to cancel ownership transfers of organization %s: %w", handover.OrganizationName, err)
+		}
+
+		if err := r.Reassign(ctx, handover.OrganizationID, accountID, handover.Successor.ID, handover.Successor.Email); err != nil {
+			return err
+		}
+
+		slog.Info("Handed over organization",
+			"organization_id", handover.OrganizationID,
+			"from_account_id", accountID,
+			"to_account_id", handover.Successor.ID)
+	}
+	return nil
+}
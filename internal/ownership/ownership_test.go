@@ -0,0 +1,92 @@
+package ownership
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/testutils"
+)
+
+// contactBilling records billing contact updates
+type contactBilling struct {
+	billing.Manager
+	contacts map[int64]string
+}
+
+func (c *contactBilling) UpdateBillingContact(ctx context.Context, organizationID int64, email string) error {
+	c.contacts[organizationID] = email
+	return nil
+}
+
+// TestHandOver tests that a leaving owner's organizations go to the next owner or
+// developer along with their service account keys and billing contact.
+func TestHandOver(t *testing.T) {
+	var promoted []db.UpdateOrganizationMemberParams
+	var reassigned []db.ReassignServiceAccountAPIKeysParams
+	var cancelled []int64
+	mock := &testutils.MockQuerier{
+		ListAccountOwnedOrganizationsFunc: func(ctx context.Context, accountID int64) ([]db.ListAccountOwnedOrganizationsRow, error) {
+			return []db.ListAccountOwnedOrganizationsRow{
+				{ID: 1, PublicID: "org-1", Name: "Library"},
+				{ID: 2, PublicID: "org-2", Name: "Archives"},
+			}, nil
+		},
+		GetOrganizationSuccessorFunc: func(ctx context.Context, arg db.GetOrganizationSuccessorParams) (db.GetOrganizationSuccessorRow, error) {
+			if arg.OrganizationID == 1 {
+				return db.GetOrganizationSuccessorRow{ID: 20, Email: "dev@example.edu", Role: db.OrganizationMembersRoleDeveloper}, nil
+			}
+			return db.GetOrganizationSuccessorRow{ID: 30, Email: "owner@example.edu", Role: db.OrganizationMembersRoleOwner}, nil
+		},
+		UpdateOrganizationMemberFunc: func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+			promoted = append(promoted, arg)
+			return nil
+		},
+		CancelPendingOrganizationOwnershipTransfersFunc: func(ctx context.Context, organizationID int64) error {
+			cancelled = append(cancelled, organizationID)
+			return nil
+		},
+		ReassignServiceAccountAPIKeysFunc: func(ctx context.Context, arg db.ReassignServiceAccountAPIKeysParams) (int64, error) {
+			reassigned = append(reassigned, arg)
+			return 1, nil
+		},
+	}
+	billingMgr := &contactBilling{contacts: map[int64]string{}}
+	reassigner := NewReassigner(mock, billingMgr)
+
+	handovers, err := reassigner.PlanHandovers(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, handovers, 2)
+	require.NoError(t, reassigner.HandOver(context.Background(), 10, handovers))
+
+	assert.Equal(t, []db.UpdateOrganizationMemberParams{{
+		Role:           db.OrganizationMembersRoleOwner,
+		OrganizationID: 1,
+		AccountID:      20,
+	}}, promoted, "only the developer is promoted")
+	assert.Equal(t, []int64{1, 2}, cancelled)
+	assert.Equal(t, []db.ReassignServiceAccountAPIKeysParams{
+		{ToAccountID: sql.NullInt64{Int64: 20, Valid: true}, OrganizationID: 1, FromAccountID: sql.NullInt64{Int64: 10, Valid: true}},
+		{ToAccountID: sql.NullInt64{Int64: 30, Valid: true}, OrganizationID: 2, FromAccountID: sql.NullInt64{Int64: 10, Valid: true}},
+	}, reassigned)
+	assert.Equal(t, map[int64]string{1: "dev@example.edu", 2: "owner@example.edu"}, billingMgr.contacts)
+}
+
+// TestPlanHandoversWithoutSuccessor tests that an organization with nobody left
+// to own it blocks the handover.
+func TestPlanHandoversWithoutSuccessor(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		ListAccountOwnedOrganizationsFunc: func(ctx context.Context, accountID int64) ([]db.ListAccountOwnedOrganizationsRow, error) {
+			return []db.ListAccountOwnedOrganizationsRow{{ID: 1, PublicID: "org-1", Name: "Library"}}, nil
+		},
+	}
+
+	_, err := NewReassigner(mock, nil).PlanHandovers(context.Background(), 10)
+	assert.ErrorIs(t, err, ErrNoSuccessor)
+	assert.ErrorContains(t, err, "Library")
+}
@@ -0,0 +1,350 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// AccountIDExtractor extracts the authenticated account ID from context.
+// Injected to avoid import cycles with the auth package.
+type AccountIDExtractor func(ctx context.Context) (int64, bool)
+
+// SiteOwnerChecker reports whether the caller owns a site, by public ID.
+type SiteOwnerChecker func(ctx context.Context, sitePublicID string) bool
+
+// Interceptor checks changes against the policies of the organization they
+// belong to. Enforced policies reject a change that breaks them; audited
+// ones let it through. Each violation is recorded, audited and emitted so
+// owners are notified.
+type Interceptor struct {
+	db                 db.Querier
+	emitter            *events.Emitter
+	auditLogger        *audit.Logger
+	accountIDExtractor AccountIDExtractor
+	isSiteOwner        SiteOwnerChecker
+	now                func() time.Time
+}
+
+// NewInterceptor creates a new policy interceptor.
+func NewInterceptor(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger, accountIDExtractor AccountIDExtractor, isSiteOwner SiteOwnerChecker) *Interceptor {
+	return &Interceptor{
+		db:                 querier,
+		emitter:            emitter,
+		auditLogger:        auditLogger,
+		accountIDExtractor: accountIDExtractor,
+		isSiteOwner:        isSiteOwner,
+		now:                time.Now,
+	}
+}
+
+// WrapUnary checks unary RPCs that policies apply to.
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if msg, ok := req.Any().(*libopsv1.CreateApiKeyRequest); ok {
+			if err := i.checkAPIKey(ctx, req.Spec().Procedure, msg); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+
+		change, ok, err := i.describe(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return next(ctx, req)
+		}
+		if err := i.check(ctx, req.Spec().Procedure, change); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps client streaming RPCs.
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler wraps server streaming RPCs.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// describe resolves the change a request makes. It returns false for
+// requests no policy applies to, and for resources that don't exist, which
+// the handler reports.
+func (i *Interceptor) describe(ctx context.Context, req connect.AnyRequest) (Change, bool, error) {
+	switch msg := req.Any().(type) {
+	case *libopsv1.CreateSiteSecretRequest:
+		return i.siteChange(ctx, secretChange, msg.SiteId)
+	case *libopsv1.UpdateSiteSecretRequest:
+		return i.siteChange(ctx, secretChange, msg.SiteId)
+	case *libopsv1.DeleteSiteSecretRequest:
+		return i.siteChange(ctx, secretChange, msg.SiteId)
+	case *libopsv1.DeploySiteRequest:
+		return i.siteChange(ctx, deployChange, msg.SiteId)
+	case *libopsv1.CreateSiteFirewallRuleRequest:
+		change, ok, err := i.siteChange(ctx, firewallRuleChange, msg.SiteId)
+		change.ruleType, change.cidr = msg.RuleType, msg.Cidr
+		return change, ok, err
+	case *libopsv1.CreateProjectFirewallRuleRequest:
+		project, err := i.db.GetProject(ctx, msg.ProjectId)
+		if err != nil {
+			return Change{}, false, lookupError(err, "project")
+		}
+		organization, err := i.db.GetOrganizationByID(ctx, project.OrganizationID)
+		if err != nil {
+			return Change{}, false, lookupError(err, "organization")
+		}
+		return Change{
+			kind:           firewallRuleChange,
+			resourceID:     project.PublicID,
+			organizationID: organization.PublicID,
+			ruleType:       msg.RuleType,
+			cidr:           msg.Cidr,
+			at:             i.now(),
+		}, true, nil
+	case *libopsv1.CreateOrganizationFirewallRuleRequest:
+		return Change{
+			kind:           firewallRuleChange,
+			resourceID:     msg.OrganizationId,
+			organizationID: msg.OrganizationId,
+			ruleType:       msg.RuleType,
+			cidr:           msg.Cidr,
+			at:             i.now(),
+		}, msg.OrganizationId != "", nil
+	default:
+		return Change{}, false, nil
+	}
+}
+
+func (i *Interceptor) siteChange(ctx context.Context, kind changeKind, sitePublicID string) (Change, bool, error) {
+	site, err := i.db.GetSite(ctx, sitePublicID)
+	if err != nil {
+		return Change{}, false, lookupError(err, "site")
+	}
+	project, err := i.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return Change{}, false, lookupError(err, "project")
+	}
+	organization, err := i.db.GetOrganizationByID(ctx, project.OrganizationID)
+	if err != nil {
+		return Change{}, false, lookupError(err, "organization")
+	}
+	change := Change{
+		kind:           kind,
+		resourceID:     site.PublicID,
+		organizationID: organization.PublicID,
+		siteID:         site.PublicID,
+		production:     site.IsProduction.Valid && site.IsProduction.Bool,
+		at:             i.now(),
+	}
+	if kind == secretChange && change.production && i.isSiteOwner != nil {
+		change.siteOwner = i.isSiteOwner(ctx, site.PublicID)
+	}
+	return change, true, nil
+}
+
+// check evaluates the change against the organization's policies, records
+// any violations and rejects the change if an enforced policy was broken.
+func (i *Interceptor) check(ctx context.Context, procedure string, change Change) error {
+	organization, err := i.db.GetOrganization(ctx, change.organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		slog.Error("policy: failed to get organization", "organization_id", change.organizationID, "err", err)
+		return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+	}
+
+	policies, err := i.db.ListAllOrganizationPolicies(ctx, organization.ID)
+	if err != nil {
+		slog.Error("policy: failed to list policies", "organization_id", change.organizationID, "err", err)
+		return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+	}
+
+	return blockedError(i.evaluate(ctx, organization.ID, policies, procedure, change))
+}
+
+// checkAPIKey checks a new API key against the policies of every organization
+// the caller is a member of, since the key can reach all of them.
+func (i *Interceptor) checkAPIKey(ctx context.Context, procedure string, msg *libopsv1.CreateApiKeyRequest) error {
+	accountID, ok := i.accountIDExtractor(ctx)
+	if !ok {
+		return nil
+	}
+	policies, err := i.db.ListAccountOrganizationPolicies(ctx, accountID)
+	if err != nil {
+		slog.Error("policy: failed to list account policies", "account_id", accountID, "err", err)
+		return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	// Unknown templates and malformed scopes are the handler's to report
+	writeAccess, err := keyWriteAccess(msg)
+	if err != nil {
+		return nil
+	}
+	account, err := i.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return lookupError(err, "account")
+	}
+
+	// Policies come ordered by organization
+	var blocked []string
+	for start := 0; start < len(policies); {
+		end := start
+		var organizationPolicies []db.ListAllOrganizationPoliciesRow
+		for ; end < len(policies) && policies[end].OrganizationID == policies[start].OrganizationID; end++ {
+			p := policies[end]
+			organizationPolicies = append(organizationPolicies, db.ListAllOrganizationPoliciesRow{
+				ID:             p.ID,
+				PublicID:       p.PublicID,
+				OrganizationID: p.OrganizationID,
+				Rule:           p.Rule,
+				Enforcement:    p.Enforcement,
+				Config:         p.Config,
+			})
+		}
+		change := Change{
+			kind:           apiKeyChange,
+			resourceID:     policies[start].OrganizationPublicID,
+			organizationID: policies[start].OrganizationPublicID,
+			writeAccess:    writeAccess,
+			serviceAccount: account.AuthMethod == db.AccountsAuthMethodGcloud,
+			at:             i.now(),
+		}
+		for _, message := range i.evaluate(ctx, policies[start].OrganizationID, organizationPolicies, procedure, change) {
+			if !slices.Contains(blocked, message) {
+				blocked = append(blocked, message)
+			}
+		}
+		start = end
+	}
+	return blockedError(blocked)
+}
+
+// keyWriteAccess reports whether a key created by a request could make
+// changes. A key without scopes can do anything its holder can.
+func keyWriteAccess(msg *libopsv1.CreateApiKeyRequest) (bool, error) {
+	scopes := msg.Scopes
+	if msg.Template != "" {
+		template, ok := auth.LookupKeyTemplate(msg.Template)
+		if !ok {
+			return false, fmt.Errorf("unknown template: %s", msg.Template)
+		}
+		scopes = template.Scopes
+	}
+	parsed, err := auth.ParseScopes(scopes)
+	if err != nil {
+		return false, err
+	}
+	return auth.GrantsWrite(parsed), nil
+}
+
+// evaluate checks a change against an organization's policies, records any
+// violations and returns why enforced policies reject the change.
+func (i *Interceptor) evaluate(ctx context.Context, organizationID int64, policies []db.ListAllOrganizationPoliciesRow, procedure string, change Change) []string {
+	var blocked []string
+	for _, p := range policies {
+		message := Evaluate(p.Rule, p.Config, change)
+		if message == "" {
+			continue
+		}
+		enforced := p.Enforcement == db.OrganizationPoliciesEnforcementEnforce
+		i.record(ctx, organizationID, p, procedure, change, message, enforced)
+		if enforced {
+			blocked = append(blocked, message)
+		}
+	}
+	return blocked
+}
+
+// blockedError rejects a change enforced policies blocked, if any did.
+func blockedError(blocked []string) error {
+	if len(blocked) == 0 {
+		return nil
+	}
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("blocked by organization policy: %s", strings.Join(blocked, "; ")))
+}
+
+// record stores, audits and emits a violation. Failures are logged rather
+// than returned so they never decide whether the change goes ahead.
+func (i *Interceptor) record(ctx context.Context, organizationID int64, p db.ListAllOrganizationPoliciesRow, procedure string, change Change, message string, blocked bool) {
+	accountID, hasAccount := i.accountIDExtractor(ctx)
+	violationID := uuid.New().String()
+	err := i.db.CreatePolicyViolation(ctx, db.CreatePolicyViolationParams{
+		PublicID:       violationID,
+		OrganizationID: organizationID,
+		PolicyID:       sql.NullInt64{Int64: p.ID, Valid: true},
+		AccountID:      sql.NullInt64{Int64: accountID, Valid: hasAccount},
+		Rule:           db.PolicyViolationsRule(p.Rule),
+		ProcedureName:  procedure,
+		ResourceID:     change.resourceID,
+		Message:        message,
+		Blocked:        blocked,
+	})
+	if err != nil {
+		slog.Error("policy: failed to record violation", "policy_id", p.PublicID, "procedure", procedure, "err", err)
+	}
+
+	if i.auditLogger != nil && hasAccount {
+		i.auditLogger.Log(ctx, accountID, organizationID, audit.OrganizationEntityType, audit.PolicyViolation, map[string]any{
+			"violation_id": violationID,
+			"policy_id":    p.PublicID,
+			"rule":         string(p.Rule),
+			"procedure":    procedure,
+			"resource_id":  change.resourceID,
+			"message":      message,
+			"blocked":      blocked,
+		})
+	}
+
+	if i.emitter != nil {
+		violation := &libopsv1.PolicyViolation{
+			ViolationId: violationID,
+			PolicyId:    p.PublicID,
+			Rule:        RuleToProto(p.Rule),
+			Procedure:   procedure,
+			ResourceId:  change.resourceID,
+			Message:     message,
+			Blocked:     blocked,
+			CreatedAt:   change.at.Unix(),
+		}
+		var siteID *string
+		if change.siteID != "" {
+			siteID = &change.siteID
+		}
+		if err := i.emitter.SendScopedProtoEvent(ctx, events.EventTypePolicyViolated, violationID, &change.organizationID, nil, siteID, violation); err != nil {
+			slog.Error("policy: failed to emit violation event", "policy_id", p.PublicID, "err", err)
+		}
+	}
+}
+
+// lookupError passes requests for missing resources on to the handler, which
+// reports them, and fails the rest.
+func lookupError(err error, resource string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	slog.Error("policy: failed to get "+resource, "err", err)
+	return connect.NewError(connect.CodeInternal, errors.New("internal server error"))
+}
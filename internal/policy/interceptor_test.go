@@ -0,0 +1,204 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	testOrganizationID = "00000000-0000-0000-0000-000000000001"
+	testProductionSite = "00000000-0000-0000-0000-000000000010"
+	testStagingSite    = "00000000-0000-0000-0000-000000000020"
+)
+
+// newTestInterceptor checks changes to one organization, with a production
+// and a staging site, against the given policies. Recorded violations are
+// appended to violations.
+func newTestInterceptor(policies []db.ListAllOrganizationPoliciesRow, violations *[]db.CreatePolicyViolationParams, owner bool) *Interceptor {
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			switch publicID {
+			case testProductionSite:
+				return db.GetSiteRow{ID: 10, PublicID: publicID, ProjectID: 5, IsProduction: sql.NullBool{Bool: true, Valid: true}}, nil
+			case testStagingSite:
+				return db.GetSiteRow{ID: 20, PublicID: publicID, ProjectID: 5}, nil
+			}
+			return db.GetSiteRow{}, sql.ErrNoRows
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: testOrganizationID}, nil
+		},
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		ListAllOrganizationPoliciesFunc: func(ctx context.Context, organizationID int64) ([]db.ListAllOrganizationPoliciesRow, error) {
+			return policies, nil
+		},
+		CreatePolicyViolationFunc: func(ctx context.Context, arg db.CreatePolicyViolationParams) error {
+			*violations = append(*violations, arg)
+			return nil
+		},
+	}
+	i := NewInterceptor(mock, nil, nil,
+		func(ctx context.Context) (int64, bool) { return 42, true },
+		func(ctx context.Context, sitePublicID string) bool { return owner },
+	)
+	// A Wednesday evening, outside working hours
+	i.now = func() time.Time { return time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC) }
+	return i
+}
+
+func call(i *Interceptor, msg any) (bool, error) {
+	ran := false
+	next := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ran = true
+		return nil, nil
+	})
+	var err error
+	switch m := msg.(type) {
+	case *libopsv1.CreateSiteSecretRequest:
+		_, err = next(context.Background(), connect.NewRequest(m))
+	case *libopsv1.DeploySiteRequest:
+		_, err = next(context.Background(), connect.NewRequest(m))
+	case *libopsv1.CreateOrganizationFirewallRuleRequest:
+		_, err = next(context.Background(), connect.NewRequest(m))
+	case *libopsv1.CreateApiKeyRequest:
+		_, err = next(context.Background(), connect.NewRequest(m))
+	}
+	return ran, err
+}
+
+func TestInterceptor_EnforcedPolicyBlocksChange(t *testing.T) {
+	var violations []db.CreatePolicyViolationParams
+	i := newTestInterceptor([]db.ListAllOrganizationPoliciesRow{
+		{ID: 1, PublicID: "policy-1", Rule: db.OrganizationPoliciesRuleNoPublicSsh, Enforcement: db.OrganizationPoliciesEnforcementEnforce},
+	}, &violations, false)
+
+	ran, err := call(i, &libopsv1.CreateOrganizationFirewallRuleRequest{
+		OrganizationId: testOrganizationID,
+		RuleType:       libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED,
+		Cidr:           "0.0.0.0/0",
+	})
+	assert.False(t, ran)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.Len(t, violations, 1)
+	assert.True(t, violations[0].Blocked)
+	assert.Equal(t, testOrganizationID, violations[0].ResourceID)
+
+	ran, err = call(i, &libopsv1.CreateOrganizationFirewallRuleRequest{
+		OrganizationId: testOrganizationID,
+		RuleType:       libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED,
+		Cidr:           "203.0.113.0/24",
+	})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 1)
+}
+
+func TestInterceptor_AuditedPolicyRecordsChange(t *testing.T) {
+	var violations []db.CreatePolicyViolationParams
+	i := newTestInterceptor([]db.ListAllOrganizationPoliciesRow{{
+		ID:          1,
+		PublicID:    "policy-1",
+		Rule:        db.OrganizationPoliciesRuleProductionDeployWindow,
+		Enforcement: db.OrganizationPoliciesEnforcementAudit,
+		Config:      types.RawJSON(`{"start_hour":9,"end_hour":17}`),
+	}}, &violations, false)
+
+	ran, err := call(i, &libopsv1.DeploySiteRequest{SiteId: testProductionSite})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.False(t, violations[0].Blocked)
+	assert.Equal(t, testProductionSite, violations[0].ResourceID)
+
+	ran, err = call(i, &libopsv1.DeploySiteRequest{SiteId: testStagingSite})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 1, "staging sites deploy any time")
+}
+
+func TestInterceptor_ProductionSecretsOwnerOnly(t *testing.T) {
+	policies := []db.ListAllOrganizationPoliciesRow{
+		{ID: 1, PublicID: "policy-1", Rule: db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, Enforcement: db.OrganizationPoliciesEnforcementEnforce},
+	}
+
+	var violations []db.CreatePolicyViolationParams
+	developer := newTestInterceptor(policies, &violations, false)
+	ran, err := call(developer, &libopsv1.CreateSiteSecretRequest{SiteId: testProductionSite})
+	assert.False(t, ran)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	ran, err = call(developer, &libopsv1.CreateSiteSecretRequest{SiteId: testStagingSite})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+
+	owner := newTestInterceptor(policies, &violations, true)
+	ran, err = call(owner, &libopsv1.CreateSiteSecretRequest{SiteId: testProductionSite})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 1)
+}
+
+func TestInterceptor_MissingSitePassesThrough(t *testing.T) {
+	var violations []db.CreatePolicyViolationParams
+	i := newTestInterceptor([]db.ListAllOrganizationPoliciesRow{
+		{ID: 1, PublicID: "policy-1", Rule: db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, Enforcement: db.OrganizationPoliciesEnforcementEnforce},
+	}, &violations, false)
+
+	ran, err := call(i, &libopsv1.CreateSiteSecretRequest{SiteId: "00000000-0000-0000-0000-000000000099"})
+	assert.True(t, ran, "the handler reports the missing site")
+	assert.NoError(t, err)
+}
+
+func TestInterceptor_ServiceAccountWriteKeys(t *testing.T) {
+	var violations []db.CreatePolicyViolationParams
+	i := newTestInterceptor(nil, &violations, false)
+	authMethod := db.AccountsAuthMethodGoogle
+	mock := i.db.(*testutils.MockQuerier)
+	mock.ListAccountOrganizationPoliciesFunc = func(ctx context.Context, accountID int64) ([]db.ListAccountOrganizationPoliciesRow, error) {
+		return []db.ListAccountOrganizationPoliciesRow{
+			{ID: 1, PublicID: "policy-1", OrganizationID: 1, OrganizationPublicID: testOrganizationID, Rule: db.OrganizationPoliciesRuleServiceAccountWriteKeys, Enforcement: db.OrganizationPoliciesEnforcementEnforce},
+			{ID: 2, PublicID: "policy-2", OrganizationID: 2, OrganizationPublicID: "00000000-0000-0000-0000-000000000002", Rule: db.OrganizationPoliciesRuleServiceAccountWriteKeys, Enforcement: db.OrganizationPoliciesEnforcementAudit},
+		}, nil
+	}
+	mock.GetAccountByIDFunc = func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+		return db.GetAccountByIDRow{ID: id, AuthMethod: authMethod}, nil
+	}
+
+	ran, err := call(i, &libopsv1.CreateApiKeyRequest{Name: "ci", Template: "deploy"})
+	assert.False(t, ran)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	require.Len(t, violations, 2, "each organization records the violation")
+	assert.True(t, violations[0].Blocked)
+	assert.False(t, violations[1].Blocked)
+
+	ran, err = call(i, &libopsv1.CreateApiKeyRequest{Name: "everything"})
+	assert.False(t, ran, "keys without scopes can make changes")
+	assert.Error(t, err)
+
+	violations = nil
+	ran, err = call(i, &libopsv1.CreateApiKeyRequest{Name: "audit", Template: "auditor"})
+	assert.True(t, ran)
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	authMethod = db.AccountsAuthMethodGcloud
+	ran, err = call(i, &libopsv1.CreateApiKeyRequest{Name: "ci", Scopes: []string{"site:write"}})
+	assert.True(t, ran, "service accounts can hold write keys")
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
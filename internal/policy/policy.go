@@ -0,0 +1,210 @@
+// Package policy checks changes against an organization's guardrails before
+// they run, such as keeping SSH closed to the internet or only deploying
+// production sites during working hours.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// changeKind is the sort of change a policy can apply to.
+type changeKind int
+
+const (
+	secretChange changeKind = iota
+	firewallRuleChange
+	deployChange
+	apiKeyChange
+)
+
+// Change is a mutation being checked, resolved to the resources it touches.
+type Change struct {
+	kind           changeKind
+	resourceID     string // Public ID of the organization, project or site changed
+	organizationID string
+	siteID         string // Set for site changes
+	production     bool   // Whether the site changed is a production site
+	siteOwner      bool   // Whether the caller owns the site changed
+	ruleType       libopsv1.FirewallRuleType
+	cidr           string
+	writeAccess    bool // Whether the API key created can make changes
+	serviceAccount bool // Whether the caller is a service account
+	at             time.Time
+}
+
+// weekdays are the days a deploy window allows when it doesn't list any.
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+
+// DeployWindow is when production sites can deploy. It's stored as the
+// policy's config.
+type DeployWindow struct {
+	Days      []string `json:"days,omitempty"`
+	StartHour int32    `json:"start_hour"`
+	EndHour   int32    `json:"end_hour"`
+	Timezone  string   `json:"timezone,omitempty"`
+}
+
+// Validate checks the window's days, hours and time zone.
+func (w DeployWindow) Validate() error {
+	for _, day := range w.Days {
+		if _, ok := parseWeekday(day); !ok {
+			return fmt.Errorf("invalid day %q: use lowercase weekday names such as monday", day)
+		}
+	}
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return fmt.Errorf("start_hour must be between 0 and 23")
+	}
+	if w.EndHour < 0 || w.EndHour > 24 {
+		return fmt.Errorf("end_hour must be between 1 and 24, or 0 for 24")
+	}
+	if w.end() <= w.StartHour {
+		return fmt.Errorf("end_hour must be after start_hour")
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", w.Timezone)
+	}
+	return nil
+}
+
+// Contains reports whether deploys are allowed at t.
+func (w DeployWindow) Contains(t time.Time) bool {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return false
+	}
+	local := t.In(loc)
+	allowed := false
+	for _, day := range w.days() {
+		if weekday, ok := parseWeekday(day); ok && weekday == local.Weekday() {
+			allowed = true
+		}
+	}
+	hour := int32(local.Hour())
+	return allowed && hour >= w.StartHour && hour < w.end()
+}
+
+// String describes the window, e.g. "monday-friday 09:00-17:00 America/New_York".
+func (w DeployWindow) String() string {
+	days := strings.Join(w.days(), ", ")
+	if len(w.Days) == 0 {
+		days = "monday-friday"
+	}
+	zone := w.Timezone
+	if zone == "" {
+		zone = "UTC"
+	}
+	return fmt.Sprintf("%s %02d:00-%02d:00 %s", days, w.StartHour, w.end(), zone)
+}
+
+func (w DeployWindow) days() []string {
+	if len(w.Days) == 0 {
+		return weekdays
+	}
+	return w.Days
+}
+
+func (w DeployWindow) end() int32 {
+	if w.EndHour == 0 {
+		return 24
+	}
+	return w.EndHour
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == day {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// RuleToProto converts a stored rule to its API value.
+func RuleToProto(rule db.OrganizationPoliciesRule) libopsv1.PolicyRule {
+	switch rule {
+	case db.OrganizationPoliciesRuleProductionSecretsOwnerOnly:
+		return libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY
+	case db.OrganizationPoliciesRuleNoPublicSsh:
+		return libopsv1.PolicyRule_POLICY_RULE_NO_PUBLIC_SSH
+	case db.OrganizationPoliciesRuleProductionDeployWindow:
+		return libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW
+	case db.OrganizationPoliciesRuleServiceAccountWriteKeys:
+		return libopsv1.PolicyRule_POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS
+	default:
+		return libopsv1.PolicyRule_POLICY_RULE_UNSPECIFIED
+	}
+}
+
+// RuleFromProto converts an API rule to its stored value.
+func RuleFromProto(rule libopsv1.PolicyRule) (db.OrganizationPoliciesRule, bool) {
+	switch rule {
+	case libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY:
+		return db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, true
+	case libopsv1.PolicyRule_POLICY_RULE_NO_PUBLIC_SSH:
+		return db.OrganizationPoliciesRuleNoPublicSsh, true
+	case libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW:
+		return db.OrganizationPoliciesRuleProductionDeployWindow, true
+	case libopsv1.PolicyRule_POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS:
+		return db.OrganizationPoliciesRuleServiceAccountWriteKeys, true
+	default:
+		return "", false
+	}
+}
+
+// ParseDeployWindow reads a policy's stored deploy window.
+func ParseDeployWindow(config types.RawJSON) (DeployWindow, error) {
+	var window DeployWindow
+	if len(config) == 0 {
+		return window, fmt.Errorf("policy has no deploy window")
+	}
+	if err := json.Unmarshal(config, &window); err != nil {
+		return window, fmt.Errorf("invalid deploy window: %w", err)
+	}
+	return window, nil
+}
+
+// Evaluate checks a change against a policy's rule. It returns why the
+// change breaks the rule, or "" when it doesn't.
+func Evaluate(rule db.OrganizationPoliciesRule, config types.RawJSON, change Change) string {
+	switch rule {
+	case db.OrganizationPoliciesRuleProductionSecretsOwnerOnly:
+		if change.kind == secretChange && change.production && !change.siteOwner {
+			return "only site owners can change a production site's secrets"
+		}
+	case db.OrganizationPoliciesRuleNoPublicSsh:
+		if change.kind == firewallRuleChange && change.ruleType == libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED && allowsEveryAddress(change.cidr) {
+			return fmt.Sprintf("SSH can't be allowed from %s", change.cidr)
+		}
+	case db.OrganizationPoliciesRuleProductionDeployWindow:
+		if change.kind != deployChange || !change.production {
+			return ""
+		}
+		window, err := ParseDeployWindow(config)
+		if err != nil {
+			return fmt.Sprintf("production sites can't deploy: %v", err)
+		}
+		if !window.Contains(change.at) {
+			return fmt.Sprintf("production sites only deploy %s", window)
+		}
+	case db.OrganizationPoliciesRuleServiceAccountWriteKeys:
+		if change.kind == apiKeyChange && change.writeAccess && !change.serviceAccount {
+			return "only service accounts can create API keys with write access; use read scopes or the auditor template"
+		}
+	}
+	return ""
+}
+
+// allowsEveryAddress reports whether a CIDR matches every IPv4 or IPv6
+// address, such as 0.0.0.0/0 or ::/0.
+func allowsEveryAddress(cidr string) bool {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	return err == nil && prefix.Bits() == 0
+}
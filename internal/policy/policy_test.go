@@ -0,0 +1,68 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func TestDeployWindow(t *testing.T) {
+	window := DeployWindow{StartHour: 9, EndHour: 17, Timezone: "America/New_York"}
+	assert.NoError(t, window.Validate())
+	assert.Equal(t, "monday-friday 09:00-17:00 America/New_York", window.String())
+
+	// 2026-03-04 is a Wednesday; New York is UTC-5 until March 8
+	assert.True(t, window.Contains(time.Date(2026, 3, 4, 14, 0, 0, 0, time.UTC)))
+	assert.False(t, window.Contains(time.Date(2026, 3, 4, 13, 59, 0, 0, time.UTC)), "08:59 in New York")
+	assert.False(t, window.Contains(time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)), "17:00 in New York")
+	assert.False(t, window.Contains(time.Date(2026, 3, 7, 15, 0, 0, 0, time.UTC)), "Saturday")
+
+	weekend := DeployWindow{Days: []string{"saturday", "sunday"}}
+	assert.NoError(t, weekend.Validate(), "an end hour of 0 means the end of the day")
+	assert.True(t, weekend.Contains(time.Date(2026, 3, 7, 23, 30, 0, 0, time.UTC)))
+	assert.Equal(t, "saturday, sunday 00:00-24:00 UTC", weekend.String())
+
+	assert.Error(t, DeployWindow{Days: []string{"Monday"}, EndHour: 17}.Validate())
+	assert.Error(t, DeployWindow{StartHour: 17, EndHour: 9}.Validate())
+	assert.Error(t, DeployWindow{StartHour: 9, EndHour: 25}.Validate())
+	assert.Error(t, DeployWindow{EndHour: 17, Timezone: "Mars/Olympus"}.Validate())
+}
+
+func TestEvaluate(t *testing.T) {
+	window := types.RawJSON(`{"start_hour":9,"end_hour":17}`)
+	wednesdayNoon := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	wednesdayNight := time.Date(2026, 3, 4, 22, 0, 0, 0, time.UTC)
+	ssh := libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED
+
+	tests := []struct {
+		name     string
+		rule     db.OrganizationPoliciesRule
+		change   Change
+		violates bool
+	}{
+		{"owner changes production secret", db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, Change{kind: secretChange, production: true, siteOwner: true}, false},
+		{"developer changes production secret", db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, Change{kind: secretChange, production: true}, true},
+		{"developer changes staging secret", db.OrganizationPoliciesRuleProductionSecretsOwnerOnly, Change{kind: secretChange}, false},
+		{"SSH from everywhere", db.OrganizationPoliciesRuleNoPublicSsh, Change{kind: firewallRuleChange, ruleType: ssh, cidr: "0.0.0.0/0"}, true},
+		{"SSH from every IPv6 address", db.OrganizationPoliciesRuleNoPublicSsh, Change{kind: firewallRuleChange, ruleType: ssh, cidr: "::/0"}, true},
+		{"SSH from an office", db.OrganizationPoliciesRuleNoPublicSsh, Change{kind: firewallRuleChange, ruleType: ssh, cidr: "203.0.113.0/24"}, false},
+		{"HTTPS from everywhere", db.OrganizationPoliciesRuleNoPublicSsh, Change{kind: firewallRuleChange, ruleType: libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_HTTPS_ALLOWED, cidr: "0.0.0.0/0"}, false},
+		{"production deploy in window", db.OrganizationPoliciesRuleProductionDeployWindow, Change{kind: deployChange, production: true, at: wednesdayNoon}, false},
+		{"production deploy out of window", db.OrganizationPoliciesRuleProductionDeployWindow, Change{kind: deployChange, production: true, at: wednesdayNight}, true},
+		{"staging deploy out of window", db.OrganizationPoliciesRuleProductionDeployWindow, Change{kind: deployChange, at: wednesdayNight}, false},
+		{"person creates write key", db.OrganizationPoliciesRuleServiceAccountWriteKeys, Change{kind: apiKeyChange, writeAccess: true}, true},
+		{"person creates read key", db.OrganizationPoliciesRuleServiceAccountWriteKeys, Change{kind: apiKeyChange}, false},
+		{"service account creates write key", db.OrganizationPoliciesRuleServiceAccountWriteKeys, Change{kind: apiKeyChange, writeAccess: true, serviceAccount: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := Evaluate(tt.rule, window, tt.change)
+			assert.Equal(t, tt.violates, message != "", message)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Package quota enforces per-organization resource limits.
+//
+// Every organization gets the default limits of its billing plan. Admins can
+// override individual limits per organization; overrides are stored in the
+// organization_quotas table and always take precedence over plan defaults.
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+)
+
+// Resource identifies a quota-limited resource.
+type Resource string
+
+const (
+	// Projects limits the number of projects in an organization.
+	Projects Resource = "projects"
+	// SitesPerProject limits the number of sites in each project.
+	SitesPerProject Resource = "sites_per_project"
+	// SecretsPerSite limits the number of secrets stored on each site.
+	SecretsPerSite Resource = "secrets_per_site"
+	// FirewallRules limits the number of firewall rules on each organization, project, or site.
+	FirewallRules Resource = "firewall_rules"
+	// APIKeysPerAccount limits the number of active API keys an account can hold.
+	APIKeysPerAccount Resource = "api_keys_per_account"
+)
+
+// Resources lists every quota-limited resource in display order.
+var Resources = []Resource{Projects, SitesPerProject, SecretsPerSite, FirewallRules, APIKeysPerAccount}
+
+// Scope describes what a limit is counted against.
+func (r Resource) Scope() string {
+	switch r {
+	case Projects:
+		return "organization"
+	case SitesPerProject:
+		return "project"
+	case SecretsPerSite:
+		return "site"
+	case FirewallRules:
+		return "resource"
+	case APIKeysPerAccount:
+		return "account"
+	}
+	return ""
+}
+
+// ParseResource validates a resource name.
+func ParseResource(name string) (Resource, error) {
+	for _, r := range Resources {
+		if string(r) == name {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("unknown quota resource %q", name)
+}
+
+// Plan is the billing plan an organization's default limits come from.
+type Plan string
+
+const (
+	// PlanTrial applies while the organization's subscription is trialing.
+	PlanTrial Plan = "trial"
+	// PlanStandard applies to every other organization.
+	PlanStandard Plan = "standard"
+)
+
+// DefaultLimits holds the per-plan default limit for each resource.
+var DefaultLimits = map[Plan]map[Resource]int64{
+	PlanTrial: {
+		Projects:          1,
+		SitesPerProject:   3,
+		SecretsPerSite:    25,
+		FirewallRules:     10,
+		APIKeysPerAccount: 10,
+	},
+	PlanStandard: {
+		Projects:          10,
+		SitesPerProject:   10,
+		SecretsPerSite:    100,
+		FirewallRules:     50,
+		APIKeysPerAccount: 25,
+	},
+}
+
+// Limit is the effective limit for a resource.
+type Limit struct {
+	Resource   Resource
+	Limit      int64
+	Overridden bool
+}
+
+// Enforcer resolves effective limits and rejects requests that would exceed them.
+type Enforcer struct {
+	db db.Querier
+}
+
+// NewEnforcer creates a new quota enforcer.
+func NewEnforcer(querier db.Querier) *Enforcer {
+	return &Enforcer{db: querier}
+}
+
+// PlanForOrganization returns the billing plan of an organization.
+// Organizations without a subscription (e.g. billing disabled) are on the standard plan.
+func (e *Enforcer) PlanForOrganization(ctx context.Context, organizationID int64) (Plan, error) {
+	subscription, err := e.db.GetStripeSubscriptionByOrganizationID(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return PlanStandard, nil
+		}
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get subscription: %w", err))
+	}
+	if subscription.Status == db.StripeSubscriptionsStatusTrialing {
+		return PlanTrial, nil
+	}
+	return PlanStandard, nil
+}
+
+// Limits returns the organization's plan and the effective limit for every resource.
+func (e *Enforcer) Limits(ctx context.Context, organizationID int64) (Plan, []Limit, error) {
+	plan, err := e.PlanForOrganization(ctx, organizationID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	overrides, err := e.db.ListOrganizationQuotas(ctx, organizationID)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list quota overrides: %w", err))
+	}
+	overridden := make(map[Resource]int64, len(overrides))
+	for _, o := range overrides {
+		overridden[Resource(o.Resource)] = o.QuotaLimit
+	}
+
+	limits := make([]Limit, 0, len(Resources))
+	for _, r := range Resources {
+		limit := Limit{Resource: r, Limit: DefaultLimits[plan][r]}
+		if value, ok := overridden[r]; ok {
+			limit.Limit = value
+			limit.Overridden = true
+		}
+		limits = append(limits, limit)
+	}
+	return plan, limits, nil
+}
+
+// Limit returns the effective limit for a single resource.
+func (e *Enforcer) Limit(ctx context.Context, organizationID int64, resource Resource) (int64, error) {
+	override, err := e.db.GetOrganizationQuota(ctx, db.GetOrganizationQuotaParams{
+		OrganizationID: organizationID,
+		Resource:       string(resource),
+	})
+	if err == nil {
+		return override.QuotaLimit, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get quota override: %w", err))
+	}
+
+	plan, err := e.PlanForOrganization(ctx, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return DefaultLimits[plan][resource], nil
+}
+
+// Check returns RESOURCE_EXHAUSTED if creating one more resource would exceed the limit.
+// used is the current count of the resource within its scope.
+func (e *Enforcer) Check(ctx context.Context, organizationID int64, resource Resource, used int64) error {
+	limit, err := e.Limit(ctx, organizationID, resource)
+	if err != nil {
+		return err
+	}
+	return exhausted(resource, used, limit)
+}
+
+// CheckAccount enforces an account-scoped quota. Accounts are not tied to a
+// single organization, so the standard plan default applies.
+func (e *Enforcer) CheckAccount(resource Resource, used int64) error {
+	return exhausted(resource, used, DefaultLimits[PlanStandard][resource])
+}
+
+func exhausted(resource Resource, used, limit int64) error {
+	if used < limit {
+		return nil
+	}
+	return connect.NewError(
+		connect.CodeResourceExhausted,
+		fmt.Errorf("%s quota exceeded: limit is %d", resource, limit),
+	)
+}
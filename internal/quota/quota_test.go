@@ -0,0 +1,112 @@
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+func TestEnforcer_Check(t *testing.T) {
+	trialing := func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error) {
+		return db.GetStripeSubscriptionByOrganizationIDRow{Status: db.StripeSubscriptionsStatusTrialing}, nil
+	}
+	override := func(limit int64) func(context.Context, db.GetOrganizationQuotaParams) (db.OrganizationQuota, error) {
+		return func(ctx context.Context, arg db.GetOrganizationQuotaParams) (db.OrganizationQuota, error) {
+			return db.OrganizationQuota{OrganizationID: arg.OrganizationID, Resource: arg.Resource, QuotaLimit: limit}, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		mock      *testutils.MockQuerier
+		resource  Resource
+		used      int64
+		exhausted bool
+	}{
+		{
+			name:     "standard default below limit",
+			mock:     &testutils.MockQuerier{},
+			resource: Projects,
+			used:     9,
+		},
+		{
+			name:      "standard default at limit",
+			mock:      &testutils.MockQuerier{},
+			resource:  Projects,
+			used:      10,
+			exhausted: true,
+		},
+		{
+			name:      "trial default",
+			mock:      &testutils.MockQuerier{GetStripeSubscriptionByOrganizationIDFunc: trialing},
+			resource:  Projects,
+			used:      1,
+			exhausted: true,
+		},
+		{
+			name: "override takes precedence over trial default",
+			mock: &testutils.MockQuerier{
+				GetStripeSubscriptionByOrganizationIDFunc: trialing,
+				GetOrganizationQuotaFunc:                  override(5),
+			},
+			resource: Projects,
+			used:     4,
+		},
+		{
+			name:      "override of zero blocks creation",
+			mock:      &testutils.MockQuerier{GetOrganizationQuotaFunc: override(0)},
+			resource:  FirewallRules,
+			used:      0,
+			exhausted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewEnforcer(tt.mock).Check(context.Background(), 1, tt.resource, tt.used)
+			if !tt.exhausted {
+				if err != nil {
+					t.Fatalf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeResourceExhausted {
+				t.Fatalf("Check() error = %v, want ResourceExhausted", err)
+			}
+		})
+	}
+}
+
+func TestEnforcer_LimitPropagatesDatabaseErrors(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetOrganizationQuotaFunc: func(ctx context.Context, arg db.GetOrganizationQuotaParams) (db.OrganizationQuota, error) {
+			return db.OrganizationQuota{}, sql.ErrConnDone
+		},
+	}
+	_, err := NewEnforcer(mock).Limit(context.Background(), 1, Projects)
+	if connect.CodeOf(err) != connect.CodeInternal {
+		t.Errorf("Limit() error = %v, want Internal", err)
+	}
+}
+
+func TestParseResource(t *testing.T) {
+	for _, r := range Resources {
+		got, err := ParseResource(string(r))
+		if err != nil || got != r {
+			t.Errorf("ParseResource(%q) = %q, %v", r, got, err)
+		}
+		if r.Scope() == "" {
+			t.Errorf("%q has no scope", r)
+		}
+	}
+	if _, err := ParseResource("widgets"); err == nil {
+		t.Error("ParseResource(widgets) should fail")
+	}
+}
@@ -0,0 +1,145 @@
+// Package region maps sites to the API region that serves them when the API
+// runs in more than one. A site is served by the region nearest its project,
+// picked on its first check-in and pinned after, so adding a region doesn't
+// move sites (and the data their controllers send) out of the one they're in.
+package region
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/libops/api/db"
+)
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371.0
+
+// Resolver finds the API region and endpoint for a site
+type Resolver struct {
+	queries   db.Querier
+	local     string
+	endpoints map[string]string
+
+	// locations are the API regions' coordinates, loaded on first use
+	mu        sync.Mutex
+	locations map[string]location
+}
+
+type location struct {
+	latitude, longitude float64
+}
+
+// NewResolver creates a resolver for the API regions in endpoints, of which
+// local is the one this deployment runs in. Without endpoints the API runs
+// in a single region and the resolver does nothing.
+func NewResolver(queries db.Querier, local string, endpoints map[string]string) *Resolver {
+	return &Resolver{queries: queries, local: local, endpoints: endpoints}
+}
+
+// Enabled reports whether the API runs in more than one region
+func (r *Resolver) Enabled() bool {
+	return r != nil && len(r.endpoints) > 1
+}
+
+// Local returns the region this deployment runs in
+func (r *Resolver) Local() string {
+	return r.local
+}
+
+// SiteEndpoint returns the region that serves a site and its API endpoint,
+// pinning the site to the nearest region if it has none. It returns empty
+// strings when the API runs in a single region.
+func (r *Resolver) SiteEndpoint(ctx context.Context, siteID int64) (string, string, error) {
+	if !r.Enabled() {
+		return "", "", nil
+	}
+	site, err := r.queries.GetSiteRegion(ctx, siteID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get site region: %w", err)
+	}
+
+	if site.ApiRegion.Valid {
+		endpoint, ok := r.endpoints[site.ApiRegion.String]
+		if !ok {
+			// A pinned region is never swapped for another behind the
+			// site's back; it keeps talking to whichever region it reached
+			slog.Warn("site is pinned to an API region that isn't configured", "site_id", siteID, "api_region", site.ApiRegion.String)
+			return site.ApiRegion.String, "", nil
+		}
+		return site.ApiRegion.String, endpoint, nil
+	}
+
+	region := r.local
+	if site.Latitude.Valid && site.Longitude.Valid {
+		if region, err = r.nearest(ctx, location{site.Latitude.Float64, site.Longitude.Float64}); err != nil {
+			return "", "", err
+		}
+	}
+	err = r.queries.SetSiteAPIRegion(ctx, db.SetSiteAPIRegionParams{
+		ApiRegion: sql.NullString{String: region, Valid: true},
+		ID:        siteID,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to pin site region: %w", err)
+	}
+	slog.Info("pinned site to API region", "site_id", siteID, "api_region", region, "gcp_region", site.GcpRegion.String)
+	return region, r.endpoints[region], nil
+}
+
+// nearest returns the API region closest to a site; regions the catalog
+// doesn't know the location of are never picked
+func (r *Resolver) nearest(ctx context.Context, site location) (string, error) {
+	locations, err := r.regionLocations(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	best, bestDistance := r.local, math.Inf(1)
+	for _, name := range slices.Sorted(maps.Keys(locations)) {
+		if d := distanceKm(site, locations[name]); d < bestDistance {
+			best, bestDistance = name, d
+		}
+	}
+	return best, nil
+}
+
+// regionLocations looks up where the API regions are the first time it's
+// needed; a failed lookup is tried again on the next call
+func (r *Resolver) regionLocations(ctx context.Context) (map[string]location, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.locations != nil {
+		return r.locations, nil
+	}
+
+	locations := map[string]location{}
+	for _, name := range slices.Sorted(maps.Keys(r.endpoints)) {
+		loc, err := r.queries.GetRegionLocation(ctx, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.Warn("API region is not in the region catalog and won't be picked as nearest", "api_region", name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get location of region %s: %w", name, err)
+		}
+		locations[name] = location{loc.Latitude, loc.Longitude}
+	}
+	r.locations = locations
+	return locations, nil
+}
+
+// distanceKm is the great-circle distance between two points
+func distanceKm(a, b location) float64 {
+	lat1, lat2 := a.latitude*math.Pi/180, b.latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.longitude - a.longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,125 @@
+package region
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+var endpoints = map[string]string{
+	"us-central1":  "https://us.api.libops.io",
+	"europe-west3": "https://eu.api.libops.io",
+}
+
+// catalog is where the API regions are
+var catalog = map[string]db.GetRegionLocationRow{
+	"us-central1":  {Latitude: 41.26, Longitude: -95.86},
+	"europe-west3": {Latitude: 50.11, Longitude: 8.68},
+}
+
+func newQuerier(site db.GetSiteRegionRow, pinned *string) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetSiteRegionFunc: func(ctx context.Context, id int64) (db.GetSiteRegionRow, error) {
+			return site, nil
+		},
+		GetRegionLocationFunc: func(ctx context.Context, code string) (db.GetRegionLocationRow, error) {
+			loc, ok := catalog[code]
+			if !ok {
+				return db.GetRegionLocationRow{}, sql.ErrNoRows
+			}
+			return loc, nil
+		},
+		SetSiteAPIRegionFunc: func(ctx context.Context, arg db.SetSiteAPIRegionParams) error {
+			*pinned = arg.ApiRegion.String
+			return nil
+		},
+	}
+}
+
+// TestSiteEndpoint tests which region serves a site and that unpinned sites
+// are pinned.
+func TestSiteEndpoint(t *testing.T) {
+	tests := []struct {
+		name         string
+		site         db.GetSiteRegionRow
+		wantRegion   string
+		wantEndpoint string
+		wantPinned   string
+	}{
+		{
+			name:         "already pinned",
+			site:         db.GetSiteRegionRow{ApiRegion: sql.NullString{String: "us-central1", Valid: true}},
+			wantRegion:   "us-central1",
+			wantEndpoint: "https://us.api.libops.io",
+		},
+		{
+			name:       "pinned to a region that's gone",
+			site:       db.GetSiteRegionRow{ApiRegion: sql.NullString{String: "asia-east1", Valid: true}},
+			wantRegion: "asia-east1",
+		},
+		{
+			name: "nearest region",
+			site: db.GetSiteRegionRow{
+				GcpRegion: sql.NullString{String: "europe-west1", Valid: true},
+				Latitude:  sql.NullFloat64{Float64: 50.45, Valid: true},
+				Longitude: sql.NullFloat64{Float64: 3.82, Valid: true},
+			},
+			wantRegion:   "europe-west3",
+			wantEndpoint: "https://eu.api.libops.io",
+			wantPinned:   "europe-west3",
+		},
+		{
+			name:         "no location",
+			site:         db.GetSiteRegionRow{},
+			wantRegion:   "us-central1",
+			wantEndpoint: "https://us.api.libops.io",
+			wantPinned:   "us-central1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pinned string
+			resolver := NewResolver(newQuerier(tt.site, &pinned), "us-central1", endpoints)
+
+			region, endpoint, err := resolver.SiteEndpoint(context.Background(), 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRegion, region)
+			assert.Equal(t, tt.wantEndpoint, endpoint)
+			assert.Equal(t, tt.wantPinned, pinned)
+		})
+	}
+}
+
+// TestSiteEndpoint_SingleRegion tests that nothing is looked up or pinned
+// when the API runs in one region.
+func TestSiteEndpoint_SingleRegion(t *testing.T) {
+	resolver := NewResolver(&testutils.MockQuerier{
+		GetSiteRegionFunc: func(ctx context.Context, id int64) (db.GetSiteRegionRow, error) {
+			t.Fatal("site region looked up")
+			return db.GetSiteRegionRow{}, nil
+		},
+	}, "us-central1", map[string]string{"us-central1": "https://api.libops.io"})
+
+	region, endpoint, err := resolver.SiteEndpoint(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Empty(t, region)
+	assert.Empty(t, endpoint)
+
+	var none *Resolver
+	assert.False(t, none.Enabled())
+}
+
+// TestDistanceKm tests the great-circle distance against a known one.
+func TestDistanceKm(t *testing.T) {
+	london := location{51.5074, -0.1278}
+	paris := location{48.8566, 2.3522}
+	assert.InDelta(t, 343.5, distanceKm(london, paris), 1)
+	assert.Zero(t, distanceKm(paris, paris))
+}
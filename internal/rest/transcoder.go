@@ -0,0 +1,330 @@
+// Package rest serves the public libops.v1 services as plain REST+JSON. Each
+// method's google.api.http rule is mapped onto its Connect endpoint, so REST
+// calls run through the same handlers, interceptors and scope checks.
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxBodyBytes bounds REST request bodies, which are buffered to be rewritten.
+const maxBodyBytes = 4 << 20
+
+// route is one google.api.http binding.
+type route struct {
+	method    string
+	segments  []string // literal segments, or "{field}" for path variables
+	verb      string   // custom method suffix, e.g. "deploy" in /v1/sites/{site_id}:deploy
+	body      string   // "*" or "" (no body)
+	procedure string   // Connect procedure, e.g. /libops.v1.SiteService/GetSite
+	input     protoreflect.MessageDescriptor
+}
+
+// Transcoder rewrites REST requests into Connect unary JSON requests and hands
+// them to the handler serving the Connect services.
+type Transcoder struct {
+	next   http.Handler
+	routes []route
+}
+
+// New builds a transcoder for every method in the package's services that has
+// a google.api.http rule. Methods without one stay Connect-only.
+func New(next http.Handler, files *protoregistry.Files, pkg protoreflect.FullName) (*Transcoder, error) {
+	t := &Transcoder{next: next}
+	var err error
+	files.RangeFilesByPackage(pkg, func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				if err = t.add(methods.Get(j)); err != nil {
+					return false
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Transcoder) add(md protoreflect.MethodDescriptor) error {
+	rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if rule == nil {
+		return nil
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return fmt.Errorf("%s: google.api.http on a streaming method", md.FullName())
+	}
+
+	var method, template string
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		method, template = http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		method, template = http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		method, template = http.MethodPut, p.Put
+	case *annotations.HttpRule_Patch:
+		method, template = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Delete:
+		method, template = http.MethodDelete, p.Delete
+	default:
+		return fmt.Errorf("%s: unsupported google.api.http pattern", md.FullName())
+	}
+	if rule.GetBody() != "" && rule.GetBody() != "*" {
+		return fmt.Errorf("%s: only body \"*\" is supported, got %q", md.FullName(), rule.GetBody())
+	}
+	if len(rule.GetAdditionalBindings()) > 0 {
+		return fmt.Errorf("%s: additional_bindings aren't supported", md.FullName())
+	}
+
+	segments, verb := splitPath(template)
+	for _, seg := range segments {
+		name, ok := variable(seg)
+		if !ok {
+			continue
+		}
+		if md.Input().Fields().ByName(protoreflect.Name(name)) == nil {
+			return fmt.Errorf("%s: path variable %q isn't a field of %s", md.FullName(), name, md.Input().FullName())
+		}
+	}
+
+	t.routes = append(t.routes, route{
+		method:    method,
+		segments:  segments,
+		verb:      verb,
+		body:      rule.GetBody(),
+		procedure: fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name()),
+		input:     md.Input(),
+	})
+	return nil
+}
+
+// ServeHTTP finds the route for the request, builds the method's JSON request
+// from the path, query and body, and serves it as a Connect call.
+func (t *Transcoder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt, vars, allowed := t.match(r.Method, r.URL.EscapedPath())
+	if rt == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeError(w, http.StatusMethodNotAllowed, connect.CodeUnimplemented, r.Method+" isn't allowed on "+r.URL.Path)
+			return
+		}
+		writeError(w, http.StatusNotFound, connect.CodeNotFound, "no REST route for "+r.URL.Path)
+		return
+	}
+
+	body, err := rt.request(w, r, vars)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, connect.CodeResourceExhausted, err.Error())
+			return
+		}
+		writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, err.Error())
+		return
+	}
+
+	req := r.Clone(r.Context())
+	req.Method = http.MethodPost
+	req.URL.Path, req.URL.RawPath, req.URL.RawQuery = rt.procedure, "", ""
+	req.RequestURI = rt.procedure
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = nil
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+	req.Header.Del("Content-Encoding")
+	t.next.ServeHTTP(w, req)
+}
+
+// match returns the most specific route for the path, or the methods the path
+// does allow when only the method is wrong.
+func (t *Transcoder) match(method, path string) (*route, map[string]string, []string) {
+	segments, verb := splitPath(path)
+	var best *route
+	var bestVars map[string]string
+	bestLiterals := -1
+	var allowed []string
+	for i := range t.routes {
+		rt := &t.routes[i]
+		vars, literals, ok := rt.matches(segments, verb)
+		if !ok {
+			continue
+		}
+		if rt.method != method {
+			if !slices.Contains(allowed, rt.method) {
+				allowed = append(allowed, rt.method)
+			}
+			continue
+		}
+		if literals > bestLiterals {
+			best, bestVars, bestLiterals = rt, vars, literals
+		}
+	}
+	if best != nil {
+		return best, bestVars, nil
+	}
+	return nil, nil, allowed
+}
+
+func (rt *route) matches(segments []string, verb string) (map[string]string, int, bool) {
+	if len(segments) != len(rt.segments) || verb != rt.verb {
+		return nil, 0, false
+	}
+	vars := map[string]string{}
+	literals := 0
+	for i, seg := range rt.segments {
+		if name, ok := variable(seg); ok {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, 0, false
+			}
+			vars[name] = value
+			continue
+		}
+		if seg != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return vars, literals, true
+}
+
+// request builds the method's request message as protojson. Path variables
+// take precedence over the body; query parameters fill in the remaining fields.
+func (rt *route) request(w http.ResponseWriter, r *http.Request, vars map[string]string) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if rt.body == "*" {
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+				return nil, errors.New("request body must be a JSON object")
+			}
+		}
+	}
+
+	for key, values := range r.URL.Query() {
+		fd := field(rt.input, key)
+		if fd == nil || rt.body == "*" {
+			return nil, fmt.Errorf("unknown query parameter %q", key)
+		}
+		if err := set(fields, fd, values); err != nil {
+			return nil, err
+		}
+	}
+	for name, value := range vars {
+		if err := set(fields, rt.input.Fields().ByName(protoreflect.Name(name)), []string{value}); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// field looks a query parameter up by its proto or JSON name.
+func field(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+// set stores string values from the path or query as the field's JSON value.
+func set(fields map[string]json.RawMessage, fd protoreflect.FieldDescriptor, values []string) error {
+	if fd.IsMap() {
+		return fmt.Errorf("%s can't be set from the URL", fd.Name())
+	}
+	var value json.RawMessage
+	if fd.IsList() {
+		items := make([]json.RawMessage, 0, len(values))
+		for _, v := range values {
+			item, err := scalar(fd, v)
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+		value, _ = json.Marshal(items)
+	} else {
+		var err error
+		if value, err = scalar(fd, values[len(values)-1]); err != nil {
+			return err
+		}
+	}
+	delete(fields, string(fd.Name()))
+	fields[fd.JSONName()] = value
+	return nil
+}
+
+// scalar encodes one URL value the way protojson expects it. protojson takes
+// integers, floats, enums and well-known message types as strings, so only
+// booleans need converting.
+func scalar(fd protoreflect.FieldDescriptor, v string) (json.RawMessage, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", fd.Name())
+		}
+		return json.RawMessage(strconv.FormatBool(b)), nil
+	case protoreflect.EnumKind:
+		if _, err := strconv.ParseInt(v, 10, 32); err == nil {
+			return json.RawMessage(v), nil
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName().Parent() != "google.protobuf" {
+			return nil, fmt.Errorf("%s can't be set from the URL", fd.Name())
+		}
+	}
+	return json.Marshal(v)
+}
+
+// splitPath splits a path or template into segments and its custom verb.
+func splitPath(path string) ([]string, string) {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	last := segments[len(segments)-1]
+	verb := ""
+	if i := strings.LastIndex(last, ":"); i >= 0 {
+		segments[len(segments)-1], verb = last[:i], last[i+1:]
+	}
+	return segments, verb
+}
+
+// variable returns the field name of a "{field}" template segment.
+func variable(segment string) (string, bool) {
+	if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+		return "", false
+	}
+	return segment[1 : len(segment)-1], true
+}
+
+// writeError writes errors in the same JSON shape as Connect's own errors.
+func writeError(w http.ResponseWriter, status int, code connect.Code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"code": code.String(), "message": message})
+}
@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+type testSites struct {
+	libopsv1connect.UnimplementedSiteServiceHandler
+	list   *libopsv1.ListSitesRequest
+	update *libopsv1.UpdateSiteRequest
+}
+
+func (s *testSites) GetSite(ctx context.Context, req *connect.Request[libopsv1.GetSiteRequest]) (*connect.Response[libopsv1.GetSiteResponse], error) {
+	if req.Msg.SiteId != "site 1" {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	return connect.NewResponse(&libopsv1.GetSiteResponse{}), nil
+}
+
+func (s *testSites) ListSites(ctx context.Context, req *connect.Request[libopsv1.ListSitesRequest]) (*connect.Response[libopsv1.ListSitesResponse], error) {
+	s.list = req.Msg
+	return connect.NewResponse(&libopsv1.ListSitesResponse{NextPageToken: "next"}), nil
+}
+
+func (s *testSites) UpdateSite(ctx context.Context, req *connect.Request[libopsv1.UpdateSiteRequest]) (*connect.Response[libopsv1.UpdateSiteResponse], error) {
+	s.update = req.Msg
+	return connect.NewResponse(&libopsv1.UpdateSiteResponse{}), nil
+}
+
+type testOperations struct {
+	libopsv1connect.UnimplementedSiteOperationsServiceHandler
+	deploy        *libopsv1.DeploySiteRequest
+	authorization string
+}
+
+func (s *testOperations) DeploySite(ctx context.Context, req *connect.Request[libopsv1.DeploySiteRequest]) (*connect.Response[libopsv1.DeploySiteResponse], error) {
+	s.deploy = req.Msg
+	s.authorization = req.Header().Get("Authorization")
+	return connect.NewResponse(&libopsv1.DeploySiteResponse{DeploymentId: "dep-1"}), nil
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *testSites, *testOperations) {
+	t.Helper()
+	sites, ops := &testSites{}, &testOperations{}
+	mux := http.NewServeMux()
+	mux.Handle(libopsv1connect.NewSiteServiceHandler(sites))
+	mux.Handle(libopsv1connect.NewSiteOperationsServiceHandler(ops))
+	transcoder, err := New(mux, protoregistry.GlobalFiles, "libops.v1")
+	require.NoError(t, err)
+	mux.Handle("/v1/", transcoder)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, sites, ops
+}
+
+func do(t *testing.T, method, url, body string) (*http.Response, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer libops_key")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	var out map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	return resp, out
+}
+
+func TestNewCoversAnnotatedMethods(t *testing.T) {
+	transcoder, err := New(http.NotFoundHandler(), protoregistry.GlobalFiles, "libops.v1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, transcoder.routes)
+
+	seen := map[string]bool{}
+	for _, rt := range transcoder.routes {
+		assert.False(t, strings.HasPrefix(rt.procedure, "/libops.v1.Admin"), "admin methods stay Connect-only: %s", rt.procedure)
+		key := rt.method + " " + strings.Join(rt.segments, "/") + ":" + rt.verb
+		assert.False(t, seen[key], "duplicate binding %s", key)
+		seen[key] = true
+	}
+}
+
+func TestTranscode(t *testing.T) {
+	srv, sites, ops := newTestServer(t)
+
+	t.Run("path variables", func(t *testing.T) {
+		resp, _ := do(t, http.MethodGet, srv.URL+"/v1/sites/site%201", "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("query parameters", func(t *testing.T) {
+		resp, out := do(t, http.MethodGet, srv.URL+"/v1/sites?organizationId=org-1&page_size=5&labelSelector=env%3Dprod", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "next", out["nextPageToken"])
+		assert.Equal(t, "org-1", sites.list.GetOrganizationId())
+		assert.Equal(t, int32(5), sites.list.PageSize)
+		assert.Equal(t, "env=prod", sites.list.GetLabelSelector())
+	})
+
+	t.Run("custom method with body", func(t *testing.T) {
+		resp, out := do(t, http.MethodPost, srv.URL+"/v1/sites/site-1:deploy", `{"gitRef":"heads/main"}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "dep-1", out["deploymentId"])
+		assert.Equal(t, "site-1", ops.deploy.SiteId)
+		assert.Equal(t, "heads/main", ops.deploy.GetGitRef())
+		assert.Equal(t, "Bearer libops_key", ops.authorization)
+	})
+
+	t.Run("path variables win over the body", func(t *testing.T) {
+		resp, _ := do(t, http.MethodPatch, srv.URL+"/v1/sites/site-1", `{"site_id":"site-2","updateMask":"githubRef"}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "site-1", sites.update.SiteId)
+		assert.Equal(t, []string{"github_ref"}, sites.update.UpdateMask.GetPaths())
+	})
+
+	t.Run("connect errors keep their status", func(t *testing.T) {
+		resp, out := do(t, http.MethodGet, srv.URL+"/v1/sites/site-2", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "not_found", out["code"])
+	})
+
+	t.Run("unknown query parameter", func(t *testing.T) {
+		resp, out := do(t, http.MethodGet, srv.URL+"/v1/sites?colour=blue", "")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		assert.Equal(t, "invalid_argument", out["code"])
+	})
+
+	t.Run("body must be an object", func(t *testing.T) {
+		resp, _ := do(t, http.MethodPost, srv.URL+"/v1/sites/site-1:deploy", `null`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		resp, _ := do(t, http.MethodPut, srv.URL+"/v1/sites/site-1", "")
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Allow"), http.MethodGet)
+		assert.Contains(t, resp.Header.Get("Allow"), http.MethodPatch)
+	})
+
+	t.Run("no route", func(t *testing.T) {
+		resp, _ := do(t, http.MethodGet, srv.URL+"/v1/nothing/here", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -14,22 +14,49 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/apiversion"
 	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
 	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/config"
+	"github.com/libops/api/internal/controllerca"
 	"github.com/libops/api/internal/dash"
+	"github.com/libops/api/internal/device"
 	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/health"
+	"github.com/libops/api/internal/idempotency"
+	"github.com/libops/api/internal/incident"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/livestatus"
 	"github.com/libops/api/internal/middleware"
+	"github.com/libops/api/internal/notify"
 	"github.com/libops/api/internal/onboard"
+	"github.com/libops/api/internal/ownership"
+	"github.com/libops/api/internal/policy"
 	"github.com/libops/api/internal/reconciler"
+	"github.com/libops/api/internal/region"
+	"github.com/libops/api/internal/rest"
+	"github.com/libops/api/internal/secretaccess"
 	"github.com/libops/api/internal/service/account"
+	"github.com/libops/api/internal/service/catalog"
+	"github.com/libops/api/internal/service/notification"
 	"github.com/libops/api/internal/service/organization"
+	"github.com/libops/api/internal/service/platform"
 	"github.com/libops/api/internal/service/project"
 	"github.com/libops/api/internal/service/reconciliation"
 	"github.com/libops/api/internal/service/site"
+	"github.com/libops/api/internal/siteaccess"
+	"github.com/libops/api/internal/statuspage"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/terminal"
+	"github.com/libops/api/internal/tfstate"
+	"github.com/libops/api/internal/validation"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
 )
 
@@ -38,6 +65,8 @@ type Dependencies struct {
 	Config            *config.Config
 	Queries           db.Querier
 	Emitter           *events.Emitter
+	Store             *store.Store // nil writes events after the change commits
+	Dunning           *billing.Dunning
 	Authorizer        *auth.Authorizer
 	JWTValidator      auth.JWTValidator
 	LibopsTokenIssuer *auth.LibopsTokenIssuer
@@ -47,6 +76,15 @@ type Dependencies struct {
 	SessionManager    *auth.SessionManager
 	AllowedOrigins    []string
 	ConnectionManager *reconciler.ConnectionManager
+	Health            *health.Handler
+	Escalator         *incident.Escalator
+	Inviter           *invite.Inviter
+	ExportStorage     export.Storage   // nil when organization exports are disabled
+	Avatars           *avatar.Store    // Refuses uploads when no avatar bucket is configured
+	Billing           billing.Manager  // nil for no-op billing
+	ControllerCA      *controllerca.CA // nil when controller mTLS is off
+	TerraformStates   tfstate.Store    // nil when terraform state management is disabled
+	KMSKeys           gcp.KMSKeys      // nil when customer-managed keys are disabled
 }
 
 // New creates a new HTTP handler with all routes configured.
@@ -58,28 +96,43 @@ func New(deps *Dependencies) http.Handler {
 	// These per-route limiters add stricter limits where needed
 	authLimiter := NewRateLimiter(rate.Limit(20), 50) // 20 rps, burst 50 (auth endpoints)
 
-	accountService := account.NewAccountService(deps.Queries, deps.APIKeyManager)
-	adminAccountService := account.NewAdminAccountService(deps.Queries, deps.Emitter)
+	notifier := notify.NewNotifier(deps.Queries)
+
+	billingMgr := deps.Billing
+	if billingMgr == nil {
+		billingMgr = billing.NewNoOpBillingManager()
+	}
+	reassigner := ownership.NewReassigner(deps.Queries, billingMgr)
+	auditLogger := audit.New(deps.Queries)
+
+	accountService := account.NewAccountService(deps.Queries, deps.APIKeyManager, deps.Avatars)
+	adminAccountService := account.NewAdminAccountService(deps.Queries, deps.Emitter, reassigner, auditLogger)
 
 	organizationService := organization.NewOrganizationService(deps.Queries, deps.Config)
 	adminOrganizationService := organization.NewAdminOrganizationService(deps.Queries)
-	memberService := organization.NewMemberService(deps.Queries, deps.ConnectionManager)
+	memberService := organization.NewMemberService(deps.Queries, deps.ConnectionManager, notifier, deps.Inviter)
 	firewallService := organization.NewFirewallService(deps.Queries)
 	sshKeyService := organization.NewSshKeyService(deps.Queries)
 
 	projectService := project.NewProjectServiceWithConfig(deps.Queries, deps.Config.DisableBilling)
 	adminProjectService := project.NewAdminProjectServiceWithConfig(deps.Queries, deps.Config.DisableBilling)
-	projectMemberService := project.NewProjectMemberService(deps.Queries, deps.ConnectionManager)
+	projectMemberService := project.NewProjectMemberService(deps.Queries, deps.ConnectionManager, notifier, deps.Inviter)
 	projectFirewallService := project.NewProjectFirewallService(deps.Queries)
 
 	siteService := site.NewSiteService(deps.Queries)
-	adminSiteService := site.NewAdminSiteService(deps.Queries)
-	siteMemberService := site.NewSiteMemberService(deps.Queries, deps.ConnectionManager)
-	siteFirewallService := site.NewSiteFirewallService(deps.Queries)
-	siteOpsService := site.NewSiteOperationsService(deps.Queries)
+	adminSiteService := site.NewAdminSiteServiceWithConfig(deps.Queries, deps.Config.DashBaseUrl, deps.ControllerCA)
+	if len(deps.Config.RegionEndpoints) > 1 {
+		adminSiteService.SetRegions(region.NewResolver(deps.Queries, deps.Config.Region, deps.Config.RegionEndpoints))
+	}
+	siteMemberService := site.NewSiteMemberService(deps.Queries, deps.ConnectionManager, notifier, deps.Inviter)
+	siteOpsService := site.NewSiteOperationsService(deps.Queries, site.NewGitHubCommits())
+	if deps.ExportStorage != nil && deps.Config.BackupBucket != "" {
+		siteOpsService.SetBackups(export.NewBucketBackups(deps.ExportStorage, deps.Config.BackupBucket))
+	}
+	uptimeService := site.NewUptimeService(deps.Queries)
 
 	// TODO: Use separate control-plane querier when available
-	adminReconciliationService := reconciliation.NewAdminReconciliationService(deps.Queries, deps.Queries)
+	adminReconciliationService := reconciliation.NewAdminReconciliationService(deps.Queries, deps.Queries, notifier, deps.Emitter)
 
 	var interceptors []connect.Interceptor
 
@@ -90,24 +143,80 @@ func New(deps *Dependencies) http.Handler {
 		interceptors = append(interceptors, otelInterceptor)
 	}
 
-	auditLogger := audit.New(deps.Queries)
-
 	organizationSecretService := organization.NewOrganizationSecretService(deps.Queries, auditLogger)
 	projectSecretService := project.NewProjectSecretService(deps.Queries, auditLogger)
 	siteSecretService := site.NewSiteSecretService(deps.Queries, auditLogger)
+	siteDomainService := site.NewSiteDomainService(deps.Queries, auditLogger)
+	siteEgressService := site.NewSiteEgressService(deps.Queries, deps.Emitter, auditLogger)
+	siteCdnService := site.NewSiteCdnService(deps.Queries, deps.Emitter, auditLogger)
+	redirectService := site.NewRedirectService(deps.Queries, deps.Emitter, auditLogger)
+	accessProtectionService := site.NewSiteAccessProtectionService(deps.Queries, deps.Emitter, auditLogger)
+	wafService := site.NewWafService(deps.Queries, deps.Emitter, auditLogger)
+	tlsPolicyService := site.NewTlsPolicyService(deps.Queries, deps.Emitter, auditLogger)
+	maintenanceWindowService := site.NewMaintenanceWindowService(deps.Queries, auditLogger)
+	siteReconciliationService := site.NewSiteReconciliationService(deps.Queries)
+	databaseService := site.NewDatabaseService(deps.Queries, deps.Emitter, auditLogger)
+	addonService := site.NewAddonService(deps.Queries, deps.Emitter, auditLogger)
+	sshAccessService := site.NewSshAccessService(deps.Queries, deps.Emitter, auditLogger)
+	elevationService := site.NewElevationService(deps.Queries, deps.Emitter, auditLogger)
+	configVarService := site.NewSiteConfigVarService(deps.Queries, deps.Emitter, auditLogger)
+	siteFirewallService := site.NewSiteFirewallService(deps.Queries, deps.Emitter, auditLogger)
+
+	organizationSettingService := organization.NewOrganizationSettingService(deps.Queries, deps.Emitter)
+	projectSettingService := project.NewProjectSettingService(deps.Queries, deps.Emitter)
+	siteSettingService := site.NewSiteSettingService(deps.Queries, deps.Emitter)
+	escalator := deps.Escalator
+	if escalator == nil {
+		escalator = incident.NewEscalator(deps.Queries)
+	}
+	notificationChannelService := organization.NewNotificationChannelService(deps.Queries, deps.Emitter, escalator)
+	eventSinkService := organization.NewEventSinkService(deps.Queries, deps.Emitter)
+	statusPageService := organization.NewStatusPageService(deps.Queries, deps.Config.StatusPageDomain, deps.Config.DashBaseUrl)
+	brandingService := organization.NewBrandingService(deps.Queries, deps.Config.DashBaseUrl)
+	exportService := organization.NewExportService(deps.Queries, deps.ExportStorage, deps.Config.ExportBucket, auditLogger)
+	ownershipService := organization.NewOwnershipService(deps.Queries, reassigner, notifier, auditLogger)
+	platformAdminService := platform.NewAdminService(deps.Queries, deps.Emitter, auditLogger)
+	if deps.TerraformStates != nil {
+		platformAdminService.SetStateStore(deps.TerraformStates)
+	}
+	fleetService := platform.NewFleetService(deps.Queries)
+	privateNetworkService := organization.NewPrivateNetworkService(deps.Queries, deps.Emitter, auditLogger)
+	relationshipService := organization.NewRelationshipService(deps.Queries, deps.Emitter, auditLogger)
+	policyService := organization.NewPolicyService(deps.Queries, deps.Emitter, auditLogger)
+	ipAllowlistService := organization.NewIPAllowlistService(deps.Queries, auditLogger)
+	encryptionKeyService := organization.NewEncryptionKeyService(deps.Queries, deps.KMSKeys, deps.Emitter, auditLogger)
+	var keyRevoker organization.KeyRevoker
+	if deps.APIKeyManager != nil {
+		keyRevoker = deps.APIKeyManager
+	}
+	apiKeyService := organization.NewAPIKeyService(deps.Queries, keyRevoker, auditLogger)
+	joinPolicyService := organization.NewJoinPolicyService(deps.Queries, auditLogger)
+	signupService := account.NewSignupService(deps.Queries, auditLogger)
 
-	organizationSettingService := organization.NewOrganizationSettingService(deps.Queries)
-	projectSettingService := project.NewProjectSettingService(deps.Queries)
-	siteSettingService := site.NewSiteSettingService(deps.Queries)
+	catalogService := catalog.NewCatalogService(deps.Queries)
+	notificationService := notification.NewNotificationService(deps.Queries, notifier.Hub())
 
 	auditInterceptor := audit.NewAuditInterceptor(auditLogger, auth.ExtractAccountIDFromContext)
 	interceptors = append(interceptors, auditInterceptor)
 
-	eventInterceptor := events.NewEventInterceptor(deps.Emitter)
+	eventInterceptor := events.NewEventInterceptor(deps.Emitter, deps.Store)
 	interceptors = append(interceptors, eventInterceptor)
 
 	accountLookupRateLimiter := NewRateLimiter(10, 20)
 
+	// Reject malformed IDs, names, CIDRs and field masks before authorization
+	// so handlers and authz never see them.
+	validationInterceptor := validation.NewInterceptor()
+	interceptors = append(interceptors, validationInterceptor)
+
+	// Hold controllers presenting a client certificate to the site it was
+	// issued to, and require one in required mode
+	var clientCertAuth *auth.ClientCertAuth
+	if deps.ControllerCA != nil {
+		clientCertAuth = auth.NewClientCertAuth(deps.ControllerCA, deps.Queries, deps.Config.ControllerMTLSMode, deps.Config.ClientCertHeaderTrusted)
+		interceptors = append(interceptors, clientCertAuth)
+	}
+
 	if deps.Authorizer != nil {
 		// First interceptor: Check if scope matches exactly (for API keys)
 		scopeAuthzInterceptor := auth.NewScopeAuthzInterceptor(deps.Authorizer, auditLogger)
@@ -116,12 +225,33 @@ func New(deps *Dependencies) http.Handler {
 		// Second interceptor: Check RBAC based on hierarchical permissions
 		rbacAuthzInterceptor := auth.NewRBACAuthzInterceptor(deps.Authorizer, auditLogger)
 		interceptors = append(interceptors, rbacAuthzInterceptor)
+
+		// Third interceptor: Check changes against the organization's policies,
+		// once the caller is known to be allowed to make them
+		policyInterceptor := policy.NewInterceptor(deps.Queries, deps.Emitter, auditLogger, auth.ExtractAccountIDFromContext, deps.Authorizer.IsSiteOwner)
+		interceptors = append(interceptors, policyInterceptor)
 	}
 
+	// Log every secret value handed out, once the handler has returned it,
+	// and alert owners when the reads look unusual
+	secretAccessInterceptor := secretaccess.NewInterceptor(deps.Queries, deps.Emitter, auditLogger)
+	interceptors = append(interceptors, secretAccessInterceptor)
+
+	// Innermost: replay stored Create* results for retried Idempotency-Keys.
+	// Runs after authorization so a key can never bypass permission checks.
+	idempotencyInterceptor := idempotency.NewInterceptor(deps.Queries, auth.ExtractAccountIDFromContext)
+	interceptors = append(interceptors, idempotencyInterceptor)
+
 	var handlerOptions []connect.HandlerOption
 	handlerOptions = append(handlerOptions, connect.WithInterceptors(interceptors...))
 
-	registerConnectServices(mux, handlerOptions, accountLookupRateLimiter,
+	// Each proto package is an API version; a libops.v2 is mounted next to
+	// libops.v1 here, and v1 marked deprecated with its sunset date.
+	versions := apiversion.NewRegistry(
+		apiversion.Version{Package: "libops.v1", Stage: apiversion.Stable},
+	)
+
+	registerConnectServices(mux, versions, handlerOptions, accountLookupRateLimiter,
 		organizationService,
 		adminOrganizationService,
 		projectService,
@@ -144,11 +274,50 @@ func New(deps *Dependencies) http.Handler {
 		organizationSettingService,
 		projectSettingService,
 		siteSettingService,
+		notificationChannelService,
+		eventSinkService,
+		catalogService,
+		notificationService,
+		uptimeService,
+		statusPageService,
+		brandingService,
+		exportService,
+		ownershipService,
+		siteDomainService,
+		siteEgressService,
+		siteCdnService,
+		redirectService,
+		accessProtectionService,
+		wafService,
+		tlsPolicyService,
+		maintenanceWindowService,
+		siteReconciliationService,
+		databaseService,
+		addonService,
+		sshAccessService,
+		elevationService,
+		configVarService,
+		platformAdminService,
+		fleetService,
+		privateNetworkService,
+		relationshipService,
+		policyService,
+		ipAllowlistService,
+		encryptionKeyService,
+		apiKeyService,
+		joinPolicyService,
+		signupService,
 	)
 
-	registerReflection(mux)
+	registerReflection(mux, versions)
+	registerRESTRoutes(mux)
+	mux.Handle("/versions", versions)
 
-	registerUtilityRoutes(mux)
+	healthHandler := deps.Health
+	if healthHandler == nil {
+		healthHandler = health.NewHandler()
+	}
+	registerUtilityRoutes(mux, healthHandler)
 
 	// Register WebSocket endpoint for VM agents
 	if deps.ConnectionManager != nil {
@@ -157,24 +326,53 @@ func New(deps *Dependencies) http.Handler {
 	}
 
 	// Register controller routes for SSH keys endpoints (GSA-authenticated)
-	registerControllerRoutes(mux, deps.Queries, adminSiteService, adminProjectService, adminReconciliationService, handlerOptions)
+	registerControllerRoutes(mux, versions, deps.Queries, adminSiteService, adminProjectService, adminReconciliationService, handlerOptions)
 
 	// Register onboarding routes and middleware
 	onboardHandler := onboard.NewHandlerWithConfig(deps.Queries, deps.Config, deps.Config.StripeSecretKey, deps.Config.StripeWebhookSecret, deps.Config.DashBaseUrl, deps.Config.DisableBilling)
 	onboardMiddleware := onboard.NewMiddleware(deps.Queries)
 
 	// Create billing manager for webhook handling
-	stripeMgr := billing.NewStripeManagerWithWebhook(deps.Queries, deps.Config.StripeWebhookSecret, deps.Config.StripeSecretKey)
+	stripeMgr := billing.NewStripeManagerWithWebhook(deps.Queries, deps.Dunning, deps.Config.StripeWebhookSecret, deps.Config.StripeSecretKey)
 
 	registerOnboardingRoutes(mux, onboardHandler, stripeMgr)
 
 	// Register dashboard routes
 	dashHandler := dash.NewHandler(deps.Queries, deps.SessionManager)
 	registerDashboardRoutes(mux, dashHandler, onboardMiddleware)
+	registerAvatarRoutes(mux, deps.Avatars)
+
+	// Register the "add another site" wizard API
+	siteWizard := onboard.NewSiteWizard(deps.Queries, onboardHandler.BillingManager(), projectService, siteService)
+	registerSiteWizardRoutes(mux, siteWizard, onboardMiddleware)
+
+	// Register the dashboard's live notification stream
+	registerNotificationRoutes(mux, notifier, onboardMiddleware)
+
+	// Register the dashboard's live resource status stream
+	registerLiveStatusRoutes(mux, livestatus.NewStreamer(deps.Queries), onboardMiddleware)
+
+	// Register the site detail page's browser terminal
+	var terminalKeys terminal.KeyReconciler
+	if deps.ConnectionManager != nil {
+		terminalKeys = deps.ConnectionManager
+	}
+	registerTerminalRoutes(mux, terminal.NewProxy(deps.Queries, terminalKeys, auditLogger), onboardMiddleware)
+
+	// Register the sign-in gate in front of members only sites
+	registerSiteAccessRoutes(mux, siteaccess.NewGate(deps.Queries, auditLogger), onboardMiddleware)
+
+	// Register the accept link emailed with member invitations
+	if deps.Inviter != nil {
+		registerInvitationRoutes(mux, deps.Inviter)
+	}
 
 	if deps.AuthHandler != nil {
 		registerAuthRoutes(mux, deps.AuthHandler)
 	}
+	if deps.APIKeyManager != nil {
+		registerDeviceRoutes(mux, device.NewAuthorizer(deps.Queries, deps.APIKeyManager, auditLogger, deps.Config.DashBaseUrl), authLimiter)
+	}
 	if deps.LibopsTokenIssuer != nil {
 		// Token endpoint
 		mux.Handle("POST /auth/token", authLimiter.LimitByIP(http.HandlerFunc(deps.LibopsTokenIssuer.HandleToken)))
@@ -189,6 +387,9 @@ func New(deps *Dependencies) http.Handler {
 
 	var handler http.Handler = mux
 
+	// Serve status pages on their own status.<slug>.<domain> hosts
+	handler = statuspage.HostMiddleware(handler, deps.Config.StatusPageDomain)
+
 	// Apply request ID middleware first
 	handler = middleware.RequestIDMiddleware(handler)
 
@@ -204,11 +405,19 @@ func New(deps *Dependencies) http.Handler {
 	// Apply CSRF protection
 	handler = middleware.CSRFMiddleware(handler)
 
+	// Verify controller client certificates, once the token is validated
+	if clientCertAuth != nil {
+		handler = clientCertAuth.Middleware(handler)
+	}
+
 	// Validate JWT or API Key
 	if deps.JWTValidator != nil {
 		handler = deps.JWTValidator.Middleware(handler)
 	}
 
+	// Send a request's reads to the primary once it has written
+	handler = middleware.ReadYourWritesMiddleware(handler)
+
 	// Log all HTTP requests with status codes
 	handler = middleware.AccessLogger(handler)
 
@@ -227,6 +436,7 @@ func New(deps *Dependencies) http.Handler {
 // registerConnectServices registers all ConnectRPC service handlers.
 func registerConnectServices(
 	mux *http.ServeMux,
+	versions *apiversion.Registry,
 	opts []connect.HandlerOption,
 	accountLookupRateLimiter *RateLimiter,
 	organizationService *organization.OrganizationService,
@@ -251,75 +461,138 @@ func registerConnectServices(
 	organizationSettingService *organization.OrganizationSettingService,
 	projectSettingService *project.ProjectSettingService,
 	siteSettingService *site.SiteSettingService,
+	notificationChannelService *organization.NotificationChannelService,
+	eventSinkService *organization.EventSinkService,
+	catalogService *catalog.CatalogService,
+	notificationService *notification.NotificationService,
+	uptimeService *site.UptimeService,
+	statusPageService *organization.StatusPageService,
+	brandingService *organization.BrandingService,
+	exportService *organization.ExportService,
+	ownershipService *organization.OwnershipService,
+	siteDomainService *site.SiteDomainService,
+	siteEgressService *site.SiteEgressService,
+	siteCdnService *site.SiteCdnService,
+	redirectService *site.RedirectService,
+	accessProtectionService *site.SiteAccessProtectionService,
+	wafService *site.WafService,
+	tlsPolicyService *site.TlsPolicyService,
+	maintenanceWindowService *site.MaintenanceWindowService,
+	siteReconciliationService *site.SiteReconciliationService,
+	databaseService *site.DatabaseService,
+	addonService *site.AddonService,
+	sshAccessService *site.SshAccessService,
+	elevationService *site.ElevationService,
+	configVarService *site.SiteConfigVarService,
+	platformAdminService *platform.AdminService,
+	fleetService *platform.FleetService,
+	privateNetworkService *organization.PrivateNetworkService,
+	relationshipService *organization.RelationshipService,
+	policyService *organization.PolicyService,
+	ipAllowlistService *organization.IPAllowlistService,
+	encryptionKeyService *organization.EncryptionKeyService,
+	apiKeyService *organization.APIKeyService,
+	joinPolicyService *organization.JoinPolicyService,
+	signupService *account.SignupService,
 ) {
-	mux.Handle(libopsv1connect.NewOrganizationServiceHandler(organizationService, opts...))
-	mux.Handle(libopsv1connect.NewProjectServiceHandler(projectService, opts...))
-	mux.Handle(libopsv1connect.NewSiteServiceHandler(siteService, opts...))
+	mux.Handle(versions.Mount(libopsv1connect.NewOrganizationServiceHandler(organizationService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewProjectServiceHandler(projectService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteServiceHandler(siteService, opts...)))
 
 	// Register AccountService with rate limiting by authenticated user
 	accountServicePath, accountServiceHandler := libopsv1connect.NewAccountServiceHandler(accountService, opts...)
-	mux.Handle(accountServicePath, accountLookupRateLimiter.LimitByUser(accountServiceHandler))
-
-	mux.Handle(libopsv1connect.NewAdminOrganizationServiceHandler(adminOrganizationService, opts...))
-	mux.Handle(libopsv1connect.NewAdminProjectServiceHandler(adminProjectService, opts...))
-	mux.Handle(libopsv1connect.NewAdminSiteServiceHandler(adminSiteService, opts...))
-	mux.Handle(libopsv1connect.NewAdminAccountServiceHandler(adminAccountService, opts...))
-
-	mux.Handle(libopsv1connect.NewMemberServiceHandler(memberService, opts...))
-	mux.Handle(libopsv1connect.NewProjectMemberServiceHandler(projectMemberService, opts...))
-	mux.Handle(libopsv1connect.NewSiteMemberServiceHandler(siteMemberService, opts...))
-	mux.Handle(libopsv1connect.NewSiteOperationsServiceHandler(siteOpsService, opts...))
-	mux.Handle(libopsv1connect.NewSshKeyServiceHandler(sshKeyService, opts...))
-	mux.Handle(libopsv1connect.NewFirewallServiceHandler(firewallService, opts...))
-	mux.Handle(libopsv1connect.NewProjectFirewallServiceHandler(projectFirewallService, opts...))
-	mux.Handle(libopsv1connect.NewSiteFirewallServiceHandler(siteFirewallService, opts...))
-
-	mux.Handle(libopsv1connect.NewOrganizationSecretServiceHandler(organizationSecretService, opts...))
-	mux.Handle(libopsv1connect.NewProjectSecretServiceHandler(projectSecretService, opts...))
-	mux.Handle(libopsv1connect.NewSiteSecretServiceHandler(siteSecretService, opts...))
-
-	mux.Handle(libopsv1connect.NewOrganizationSettingServiceHandler(organizationSettingService, opts...))
-	mux.Handle(libopsv1connect.NewProjectSettingServiceHandler(projectSettingService, opts...))
-	mux.Handle(libopsv1connect.NewSiteSettingServiceHandler(siteSettingService, opts...))
+	mux.Handle(versions.Mount(accountServicePath, accountLookupRateLimiter.LimitByUser(accountServiceHandler)))
+
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminOrganizationServiceHandler(adminOrganizationService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminProjectServiceHandler(adminProjectService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminSiteServiceHandler(adminSiteService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminAccountServiceHandler(adminAccountService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminServiceHandler(platformAdminService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewFleetServiceHandler(fleetService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewPrivateNetworkServiceHandler(privateNetworkService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewRelationshipServiceHandler(relationshipService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewPolicyServiceHandler(policyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewIpAllowlistServiceHandler(ipAllowlistService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewEncryptionKeyServiceHandler(encryptionKeyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewOrganizationApiKeyServiceHandler(apiKeyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewJoinPolicyServiceHandler(joinPolicyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSignupServiceHandler(signupService, opts...)))
+
+	mux.Handle(versions.Mount(libopsv1connect.NewMemberServiceHandler(memberService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewProjectMemberServiceHandler(projectMemberService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteMemberServiceHandler(siteMemberService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteOperationsServiceHandler(siteOpsService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewUptimeServiceHandler(uptimeService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSshKeyServiceHandler(sshKeyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewFirewallServiceHandler(firewallService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewProjectFirewallServiceHandler(projectFirewallService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteFirewallServiceHandler(siteFirewallService, opts...)))
+
+	mux.Handle(versions.Mount(libopsv1connect.NewOrganizationSecretServiceHandler(organizationSecretService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewProjectSecretServiceHandler(projectSecretService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteSecretServiceHandler(siteSecretService, opts...)))
+
+	mux.Handle(versions.Mount(libopsv1connect.NewOrganizationSettingServiceHandler(organizationSettingService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewProjectSettingServiceHandler(projectSettingService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteSettingServiceHandler(siteSettingService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewNotificationChannelServiceHandler(notificationChannelService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewEventSinkServiceHandler(eventSinkService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewStatusPageServiceHandler(statusPageService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewBrandingServiceHandler(brandingService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewExportServiceHandler(exportService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewOwnershipServiceHandler(ownershipService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteDomainServiceHandler(siteDomainService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteEgressServiceHandler(siteEgressService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteCdnServiceHandler(siteCdnService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewRedirectServiceHandler(redirectService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteAccessProtectionServiceHandler(accessProtectionService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewWafServiceHandler(wafService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewTlsPolicyServiceHandler(tlsPolicyService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewMaintenanceWindowServiceHandler(maintenanceWindowService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteReconciliationServiceHandler(siteReconciliationService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewDatabaseServiceHandler(databaseService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewAddonServiceHandler(addonService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSshAccessServiceHandler(sshAccessService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewElevationServiceHandler(elevationService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteConfigVarServiceHandler(configVarService, opts...)))
+
+	mux.Handle(versions.Mount(libopsv1connect.NewCatalogServiceHandler(catalogService, opts...)))
+	mux.Handle(versions.Mount(libopsv1connect.NewNotificationServiceHandler(notificationService, opts...)))
 }
 
-// registerReflection adds gRPC reflection endpoints.
-func registerReflection(mux *http.ServeMux) {
-	reflector := grpcreflect.NewStaticReflector(
-		"libops.v1.OrganizationService",
-		"libops.v1.ProjectService",
-		"libops.v1.SiteService",
-		"libops.v1.AccountService",
-		"libops.v1.AdminOrganizationService",
-		"libops.v1.AdminProjectService",
-		"libops.v1.AdminSiteService",
-		"libops.v1.AdminAccountService",
-		"libops.v1.MemberService",
-		"libops.v1.ProjectMemberService",
-		"libops.v1.SiteMemberService",
-		"libops.v1.SiteOperationsService",
-		"libops.v1.SshKeyService",
-		"libops.v1.FirewallService",
-		"libops.v1.ProjectFirewallService",
-		"libops.v1.SiteFirewallService",
-		"libops.v1.OrganizationSecretService",
-		"libops.v1.ProjectSecretService",
-		"libops.v1.SiteSecretService",
-	)
+// registerReflection adds gRPC reflection endpoints for every mounted service.
+func registerReflection(mux *http.ServeMux, versions *apiversion.Registry) {
+	reflector := grpcreflect.NewStaticReflector(versions.Services()...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 }
 
+// registerRESTRoutes serves the public services' google.api.http bindings
+// under /v1/ by transcoding them to the Connect handlers on the same mux.
+func registerRESTRoutes(mux *http.ServeMux) {
+	transcoder, err := rest.New(mux, protoregistry.GlobalFiles, "libops.v1")
+	if err != nil {
+		slog.Error("Failed to create REST transcoder", "err", err)
+		return
+	}
+	mux.Handle("/v1/", transcoder)
+}
+
 // registerUtilityRoutes adds health, version, and documentation routes.
-func registerUtilityRoutes(mux *http.ServeMux) {
+func registerUtilityRoutes(mux *http.ServeMux, healthHandler *health.Handler) {
 	// Health check
 	mux.HandleFunc("/health", handleHealth)
 
+	// Liveness and readiness probes with per-dependency status
+	mux.HandleFunc("GET /healthz", healthHandler.Liveness)
+	mux.HandleFunc("GET /readyz", healthHandler.Readiness)
+
 	mux.HandleFunc("/robots.txt", handleRobotsTxt)
 	mux.HandleFunc("/version", handleVersion)
 	mux.Handle("/metrics", promhttp.Handler())
 
 	mux.HandleFunc("/openapi.yaml", handlePublicOpenAPISpec)
+	mux.HandleFunc("/openapi.json", handleRESTOpenAPISpec)
 
 	// Static files
 	staticDir := os.Getenv("STATIC_DIR")
@@ -331,13 +604,19 @@ func registerUtilityRoutes(mux *http.ServeMux) {
 
 // registerDashboardRoutes adds dashboard and UI endpoints.
 func registerDashboardRoutes(mux *http.ServeMux, dashHandler *dash.Handler, onboardMW *onboard.Middleware) {
-	// Public route (no onboarding required)
+	// Public routes (no onboarding required)
 	mux.HandleFunc("/login", dashHandler.HandleLoginPage)
+	mux.HandleFunc("GET /verify-email", dashHandler.HandleVerifyEmailPage)
+	mux.HandleFunc("GET /forgot-password", dashHandler.HandleForgotPasswordPage)
+	mux.HandleFunc("GET /reset-password", dashHandler.HandleResetPasswordPage)
+	mux.HandleFunc("GET /status/{slug}", dashHandler.HandleStatusPage)
+	mux.HandleFunc("GET /organizations/{id}/logo", dashHandler.HandleOrganizationLogo)
 
 	// Protected routes (require onboarding completion)
 	mux.Handle("/dashboard", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleDashboard)))
 	mux.Handle("/api-keys", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleAPIKeys)))
 	mux.Handle("/ssh-keys", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleSSHKeys)))
+	mux.Handle("/billing", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleBilling)))
 	mux.Handle("/organizations", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleOrganizations)))
 	mux.Handle("/projects", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleProjects)))
 	mux.Handle("/sites", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleSites)))
@@ -349,7 +628,72 @@ func registerDashboardRoutes(mux *http.ServeMux, dashHandler *dash.Handler, onbo
 	// Detail pages for individual resources (require onboarding completion)
 	mux.Handle("GET /organizations/{id}", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleOrganizationDetail)))
 	mux.Handle("GET /projects/{id}", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleProjectDetail)))
+	mux.Handle("GET /sites/new", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleNewSite)))
 	mux.Handle("GET /sites/{id}", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleSiteDetail)))
+
+	// CSV and JSON exports of the resource lists
+	mux.Handle("GET /members/export", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleExportMembers)))
+	mux.Handle("GET /firewall/export", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleExportFirewall)))
+	mux.Handle("GET /secrets/export", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleExportSecrets)))
+	mux.Handle("GET /sites/export", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleExportSites)))
+
+	// Search box across every resource the user can access
+	mux.Handle("GET /search", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleSearch)))
+
+	// Activity feeds from the audit log
+	mux.Handle("GET /activity", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleMyActivity)))
+	mux.Handle("GET /organizations/{id}/activity", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleOrganizationActivity)))
+	mux.Handle("GET /organizations/{id}/fleet", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleOrganizationFleet)))
+	mux.Handle("GET /projects/{id}/activity", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleProjectActivity)))
+	mux.Handle("GET /sites/{id}/activity", onboardMW.RequireOnboardingComplete(http.HandlerFunc(dashHandler.HandleSiteActivity)))
+}
+
+// registerTerminalRoutes adds the websocket behind the site detail page's browser terminal.
+func registerTerminalRoutes(mux *http.ServeMux, proxy *terminal.Proxy, onboardMW *onboard.Middleware) {
+	mux.Handle("GET /sites/{id}/terminal", onboardMW.RequireOnboardingComplete(http.HandlerFunc(proxy.HandleTerminal)))
+}
+
+// registerSiteAccessRoutes adds the page members only sites send visitors to for signing in.
+func registerSiteAccessRoutes(mux *http.ServeMux, gate *siteaccess.Gate, onboardMW *onboard.Middleware) {
+	mux.Handle("GET /sites/{id}/access", onboardMW.RequireOnboardingComplete(http.HandlerFunc(gate.HandleAccess)))
+}
+
+// registerSiteWizardRoutes adds the API behind the dashboard's "add another site" wizard.
+func registerSiteWizardRoutes(mux *http.ServeMux, wizard *onboard.SiteWizard, onboardMW *onboard.Middleware) {
+	mux.Handle("GET /api/sites/new/options", onboardMW.RequireOnboardingComplete(http.HandlerFunc(wizard.HandleOptions)))
+	mux.Handle("POST /api/sites/new", onboardMW.RequireOnboardingComplete(http.HandlerFunc(wizard.HandleCreate)))
+}
+
+// registerNotificationRoutes adds the server-sent event stream behind the dashboard's notification bell.
+func registerNotificationRoutes(mux *http.ServeMux, notifier *notify.Notifier, onboardMW *onboard.Middleware) {
+	mux.Handle("GET /api/notifications/stream", onboardMW.RequireOnboardingComplete(http.HandlerFunc(notifier.HandleStream)))
+}
+
+// registerLiveStatusRoutes adds the server-sent event stream that patches site, deployment
+// and reconciliation status into dashboard pages.
+func registerLiveStatusRoutes(mux *http.ServeMux, streamer *livestatus.Streamer, onboardMW *onboard.Middleware) {
+	mux.Handle("GET /events/stream", onboardMW.RequireOnboardingComplete(http.HandlerFunc(streamer.HandleStream)))
+}
+
+// registerAvatarRoutes adds the link avatars in member lists load from. Images only
+// need a signed-in user, so it doesn't require onboarding.
+func registerAvatarRoutes(mux *http.ServeMux, avatars *avatar.Store) {
+	mux.HandleFunc("GET /accounts/{id}/avatar", avatars.HandleAvatar)
+}
+
+// registerInvitationRoutes adds the link that accepts an emailed member invitation. It
+// doesn't require onboarding: invited people join an existing organization instead.
+func registerInvitationRoutes(mux *http.ServeMux, inviter *invite.Inviter) {
+	mux.HandleFunc("GET /invitations/{token}", inviter.HandleAccept)
+}
+
+// registerDeviceRoutes adds the device flow the libops CLI signs in with: the CLI's
+// code and token endpoints, and the page where users approve its requests.
+func registerDeviceRoutes(mux *http.ServeMux, authorizer *device.Authorizer, authLimiter *RateLimiter) {
+	mux.Handle("POST /auth/device/code", authLimiter.LimitByIP(http.HandlerFunc(authorizer.HandleCode)))
+	mux.Handle("POST /auth/device/token", authLimiter.LimitByIP(http.HandlerFunc(authorizer.HandleToken)))
+	mux.HandleFunc("GET /auth/device", authorizer.HandlePage)
+	mux.Handle("POST /auth/device", authLimiter.LimitByIP(http.HandlerFunc(authorizer.HandleDecision)))
 }
 
 // registerOnboardingRoutes adds onboarding endpoints.
@@ -370,7 +714,6 @@ func registerOnboardingRoutes(mux *http.ServeMux, handler *onboard.Handler, stri
 
 	// Utility endpoints
 	mux.HandleFunc("GET /api/onboarding/client-ip", handler.HandleGetClientIP)
-	mux.HandleFunc("GET /api/onboarding/regions", handler.HandleGetRegions)
 
 	// Stripe webhook (no authentication required, verified by signature)
 	mux.HandleFunc("POST /webhooks/stripe", stripeMgr.HandleStripeWebhook)
@@ -397,10 +740,13 @@ func registerUserpassRoutes(mux *http.ServeMux, userpassClient *auth.UserpassCli
 	// Userpass registration and verification
 	mux.HandleFunc("POST /auth/userpass/register", userpassClient.HandleRegister)
 	mux.Handle("POST /auth/userpass/resend-verification", authLimiter.LimitByIP(http.HandlerFunc(userpassClient.HandleResendVerification)))
+	// Password reset
+	mux.Handle("POST /auth/userpass/password-reset", authLimiter.LimitByIP(http.HandlerFunc(userpassClient.HandleRequestPasswordReset)))
+	mux.Handle("POST /auth/userpass/password-reset/confirm", authLimiter.LimitByIP(http.HandlerFunc(userpassClient.HandleResetPassword)))
 }
 
 // registerControllerRoutes adds controller reconciliation endpoints.
-func registerControllerRoutes(mux *http.ServeMux, queries db.Querier, adminSiteService *site.AdminSiteService, adminProjectService *project.AdminProjectService, adminReconciliationService *reconciliation.AdminReconciliationService, opts []connect.HandlerOption) {
+func registerControllerRoutes(mux *http.ServeMux, versions *apiversion.Registry, queries db.Querier, adminSiteService *site.AdminSiteService, adminProjectService *project.AdminProjectService, adminReconciliationService *reconciliation.AdminReconciliationService, opts []connect.HandlerOption) {
 	// Site VM GSA middleware (for VM → API calls)
 	siteGSAAuth := auth.NewGSAMiddleware(queries)
 
@@ -411,9 +757,22 @@ func registerControllerRoutes(mux *http.ServeMux, queries db.Querier, adminSiteS
 	mux.Handle("GET /v1/projects/{projectId}/ssh-keys", siteGSAAuth.Middleware(http.HandlerFunc(adminProjectService.HandleProjectSshKeys)))
 	mux.Handle("GET /v1/sites/{siteId}/ssh-keys", siteGSAAuth.Middleware(http.HandlerFunc(adminSiteService.HandleSiteSshKeys)))
 
+	// Site VM controllers call SiteAgentService
+	siteAgentService := site.NewSiteAgentService(adminSiteService)
+	mux.Handle(versions.Mount(libopsv1connect.NewSiteAgentServiceHandler(siteAgentService, opts...)))
+
+	// Deprecated REST paths of controllers built before SiteAgentService -
+	// protected by site GSA authentication
+	mux.Handle("POST /admin/sites/{siteId}/checkin", siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacyCheckIn)))
+	mux.Handle("GET /admin/sites/{siteId}/secrets", siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacySecrets)))
+	mux.Handle("GET /admin/sites/{siteId}/firewall", siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacyFirewall)))
+	mux.Handle("GET /admin/sites/{siteId}/deployment", siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacyDeployment)))
+	mux.Handle("POST /admin/sites/{siteId}/reconciliation/status", siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacyReconciliationStatus)))
+	mux.Handle("POST /admin/deployments/{deploymentId}/status", siteAgentService.WithDeploymentSite(siteGSAAuth.Middleware(http.HandlerFunc(siteAgentService.HandleLegacyDeploymentStatus))))
+
 	// Register admin reconciliation service endpoints
 	// TODO: Apply reconciliation GSA middleware to these endpoints
-	mux.Handle(libopsv1connect.NewAdminReconciliationServiceHandler(adminReconciliationService, opts...))
+	mux.Handle(versions.Mount(libopsv1connect.NewAdminReconciliationServiceHandler(adminReconciliationService, opts...)))
 
 	// Note: New admin API endpoints (GetSiteSSHKeys, GetSiteSecrets, GetSiteFirewall, SiteCheckIn,
 	// GetReconciliationRun, UpdateReconciliationStatus, GenerateTerraformVars) are registered
@@ -450,3 +809,9 @@ func handlePublicOpenAPISpec(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/yaml")
 	http.ServeFile(w, r, "./openapi/openapi.yaml")
 }
+
+// handleRESTOpenAPISpec serves the OpenAPI document for the REST mapping of the public services.
+func handleRESTOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	http.ServeFile(w, r, "./openapi/openapi.json")
+}
@@ -1,8 +1,11 @@
 package router
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -10,6 +13,7 @@ import (
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/config"
 	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/health"
 	"github.com/libops/api/internal/middleware"
 )
 
@@ -75,6 +79,53 @@ func TestHealthEndpoint(t *testing.T) {
 	}
 }
 
+// TestReadinessEndpoint tests that /readyz reports dependency status as JSON and fails on a critical outage.
+func TestReadinessEndpoint(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create mock DB: %v", err)
+	}
+	defer func() { _ = mockDB.Close() }()
+
+	queries := db.New(mockDB)
+	emitter := events.NewEmitter(queries, events.EventSourceLibOpsAPI)
+
+	deps := &Dependencies{
+		Config:         &config.Config{},
+		Queries:        queries,
+		Emitter:        emitter,
+		AllowedOrigins: []string{"*"},
+		Health: health.NewHandler(health.Check{
+			Name:     "database",
+			Critical: true,
+			Fn: func(ctx context.Context) error {
+				return errors.New("connection refused")
+			},
+		}),
+	}
+
+	handler := New(deps)
+
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status 503, got %d", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), `"database"`) {
+		t.Errorf("Expected per-dependency status in body, got %q", w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected liveness status 200, got %d", w.Code)
+	}
+}
+
 // TestVersionEndpoint tests the /version endpoint to ensure it returns HTTP 200 OK and the correct Content-Type.
 func TestVersionEndpoint(t *testing.T) {
 	mockDB, _, err := sqlmock.New()
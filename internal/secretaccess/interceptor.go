@@ -0,0 +1,246 @@
+// Package secretaccess records every read of a secret's value and alerts an
+// organization's owners when the reads look unusual.
+package secretaccess
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	// HistoryWindow is how far back a principal's reads make it a familiar
+	// reader of an organization's secrets.
+	HistoryWindow = 30 * 24 * time.Hour
+
+	// VolumeWindow and VolumeThreshold bound how many secrets one principal
+	// can read before it's flagged as a high volume of reads.
+	VolumeWindow    = time.Hour
+	VolumeThreshold = 200
+)
+
+// Interceptor logs the secrets each successful value-returning RPC read:
+// who read them, which ones and from where. A read by a principal that
+// hasn't read the organization's secrets before, or one that pushes a
+// principal over the volume threshold, is flagged, audited and emitted so
+// owners are notified.
+type Interceptor struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+	now         func() time.Time
+}
+
+// NewInterceptor creates a new secret access interceptor.
+func NewInterceptor(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *Interceptor {
+	return &Interceptor{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+		now:         time.Now,
+	}
+}
+
+// access is a batch of secrets one request read from a site.
+type access struct {
+	sitePublicID string
+	secretNames  []string
+}
+
+// WrapUnary records the secrets read by unary RPCs that return their values.
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if a, ok := describe(req, resp); ok {
+			i.record(ctx, req, a)
+		}
+		return resp, nil
+	}
+}
+
+// WrapStreamingClient wraps client streaming RPCs.
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler wraps server streaming RPCs.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// describe lists the secrets a response handed out. It returns false for
+// RPCs that don't return secret values, and for responses with none.
+func describe(req connect.AnyRequest, resp connect.AnyResponse) (access, bool) {
+	var a access
+	switch msg := resp.Any().(type) {
+	case *libopsv1.GetSiteSecretsResponse:
+		r, ok := req.Any().(*libopsv1.GetSiteSecretsRequest)
+		if !ok {
+			return access{}, false
+		}
+		a.sitePublicID = r.SiteId
+		for _, secret := range msg.Secrets {
+			a.secretNames = append(a.secretNames, secret.Key)
+		}
+	case *libopsv1.GetSiteDatabasesResponse:
+		r, ok := req.Any().(*libopsv1.GetSiteDatabasesRequest)
+		if !ok {
+			return access{}, false
+		}
+		a.sitePublicID = r.SiteId
+		for _, database := range msg.Databases {
+			a.secretNames = append(a.secretNames, service.SiteDatabaseSecretNames(database.Name).Password)
+		}
+	default:
+		return access{}, false
+	}
+	return a, len(a.secretNames) > 0
+}
+
+// record logs a batch of reads, flagging it when it's unusual. Failures are
+// logged rather than returned: the secrets were already read.
+func (i *Interceptor) record(ctx context.Context, req connect.AnyRequest, a access) {
+	procedure := req.Spec().Procedure
+	site, err := i.db.GetSite(ctx, a.sitePublicID)
+	if err != nil {
+		slog.Error("secretaccess: failed to get site", "site_id", a.sitePublicID, "err", err)
+		return
+	}
+	project, err := i.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		slog.Error("secretaccess: failed to get project", "site_id", a.sitePublicID, "err", err)
+		return
+	}
+	organization, err := i.db.GetOrganizationByID(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("secretaccess: failed to get organization", "site_id", a.sitePublicID, "err", err)
+		return
+	}
+
+	var accountID int64
+	principal := "unknown"
+	if userInfo, ok := auth.GetUserFromContext(ctx); ok {
+		accountID = userInfo.AccountID
+		principal = userInfo.Email
+	}
+	var sourceIP string
+	if ip, known := auth.ClientIP(req.Peer().Addr, req.Header()); known {
+		sourceIP = ip.String()
+	}
+
+	now := i.now()
+	reason, err := i.anomaly(ctx, organization.ID, principal, len(a.secretNames), now)
+	if err != nil {
+		slog.Error("secretaccess: failed to check for anomalies", "organization_id", organization.PublicID, "err", err)
+	}
+
+	for _, name := range a.secretNames {
+		err := i.db.CreateSecretAccessLog(ctx, db.CreateSecretAccessLogParams{
+			PublicID:       uuid.New().String(),
+			OrganizationID: organization.ID,
+			SiteID:         sql.NullInt64{Int64: site.ID, Valid: true},
+			AccountID:      sql.NullInt64{Int64: accountID, Valid: accountID != 0},
+			Principal:      principal,
+			SecretName:     name,
+			SourceIp:       sourceIP,
+			ProcedureName:  procedure,
+			Anomaly:        sql.NullString{String: reason, Valid: reason != ""},
+		})
+		if err != nil {
+			slog.Error("secretaccess: failed to record read", "site_id", site.PublicID, "secret", name, "err", err)
+		}
+	}
+	if reason == "" {
+		return
+	}
+
+	slog.Warn("Unusual secret access",
+		"organization_id", organization.PublicID,
+		"site_id", site.PublicID,
+		"principal", principal,
+		"source_ip", sourceIP,
+		"reason", reason)
+
+	if i.auditLogger != nil && accountID != 0 {
+		i.auditLogger.Log(ctx, accountID, organization.ID, audit.OrganizationEntityType, audit.SecretAccessAnomaly, map[string]any{
+			"site_id":      site.PublicID,
+			"principal":    principal,
+			"source_ip":    sourceIP,
+			"procedure":    procedure,
+			"reason":       reason,
+			"secret_names": a.secretNames,
+		})
+	}
+
+	if i.emitter != nil {
+		alertID := uuid.New().String()
+		alert := &libopsv1.SecretAccessAnomaly{
+			OrganizationId: organization.PublicID,
+			SiteId:         site.PublicID,
+			Principal:      principal,
+			SourceIp:       sourceIP,
+			Reason:         reason,
+			SecretNames:    a.secretNames,
+			CreatedAt:      now.Unix(),
+		}
+		if err := i.emitter.SendScopedProtoEvent(ctx, events.EventTypeSecretAccessAnomaly, alertID, &organization.PublicID, nil, &site.PublicID, alert); err != nil {
+			slog.Error("secretaccess: failed to emit anomaly event", "organization_id", organization.PublicID, "err", err)
+		}
+	}
+}
+
+// anomaly explains why reading count secrets now would be unusual for the
+// principal, or returns "" when it wouldn't. The first reader of an
+// organization's secrets isn't flagged, there's nothing to compare it to.
+func (i *Interceptor) anomaly(ctx context.Context, organizationID int64, principal string, count int, now time.Time) (string, error) {
+	recent, err := i.db.CountSecretAccessByPrincipalSince(ctx, db.CountSecretAccessByPrincipalSinceParams{
+		OrganizationID: organizationID,
+		Principal:      principal,
+		Since:          now.Add(-VolumeWindow),
+	})
+	if err != nil {
+		return "", err
+	}
+	// Only the batch that crosses the threshold alerts, not every one after it
+	if recent < VolumeThreshold && recent+int64(count) >= VolumeThreshold {
+		return fmt.Sprintf("%s read %d secrets in the last hour", principal, recent+int64(count)), nil
+	}
+
+	history, err := i.db.CountSecretAccessByPrincipalSince(ctx, db.CountSecretAccessByPrincipalSinceParams{
+		OrganizationID: organizationID,
+		Principal:      principal,
+		Since:          now.Add(-HistoryWindow),
+	})
+	if err != nil {
+		return "", err
+	}
+	if history > 0 {
+		return "", nil
+	}
+	others, err := i.db.CountSecretAccessSince(ctx, db.CountSecretAccessSinceParams{
+		OrganizationID: organizationID,
+		Since:          now.Add(-HistoryWindow),
+	})
+	if err != nil {
+		return "", err
+	}
+	if others > 0 {
+		return fmt.Sprintf("%s hasn't read this organization's secrets in the last 30 days", principal), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,136 @@
+package secretaccess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const testSiteID = "00000000-0000-0000-0000-000000000010"
+
+var testNow = time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+// reads counts a principal's earlier reads, in the last hour and the last
+// 30 days, and everyone's in the last 30 days.
+type reads struct {
+	lastHour, principal, organization int64
+}
+
+func newTestInterceptor(history reads, logged *[]db.CreateSecretAccessLogParams) *Interceptor {
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 10, PublicID: publicID, ProjectID: 5}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: "00000000-0000-0000-0000-000000000001"}, nil
+		},
+		CountSecretAccessByPrincipalSinceFunc: func(ctx context.Context, arg db.CountSecretAccessByPrincipalSinceParams) (int64, error) {
+			if arg.Since.Equal(testNow.Add(-VolumeWindow)) {
+				return history.lastHour, nil
+			}
+			return history.principal, nil
+		},
+		CountSecretAccessSinceFunc: func(ctx context.Context, arg db.CountSecretAccessSinceParams) (int64, error) {
+			return history.organization, nil
+		},
+		CreateSecretAccessLogFunc: func(ctx context.Context, arg db.CreateSecretAccessLogParams) error {
+			*logged = append(*logged, arg)
+			return nil
+		},
+	}
+	i := NewInterceptor(mock, nil, nil)
+	i.now = func() time.Time { return testNow }
+	return i
+}
+
+func readSecrets(t *testing.T, i *Interceptor, err error, keys ...string) {
+	t.Helper()
+	next := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err != nil {
+			return nil, err
+		}
+		resp := &libopsv1.GetSiteSecretsResponse{}
+		for _, key := range keys {
+			resp.Secrets = append(resp.Secrets, &libopsv1.Secret{Key: key, Value: "secret/" + key})
+		}
+		return connect.NewResponse(resp), nil
+	})
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 7, Email: "vm@example.iam.gserviceaccount.com"})
+	_, gotErr := next(ctx, connect.NewRequest(&libopsv1.GetSiteSecretsRequest{SiteId: testSiteID}))
+	assert.Equal(t, err, gotErr)
+}
+
+func TestInterceptorLogsReads(t *testing.T) {
+	var logged []db.CreateSecretAccessLogParams
+	i := newTestInterceptor(reads{}, &logged)
+
+	readSecrets(t, i, nil, "DATABASE_URL", "API_TOKEN")
+	require.Len(t, logged, 2)
+	assert.Equal(t, "DATABASE_URL", logged[0].SecretName)
+	assert.Equal(t, "vm@example.iam.gserviceaccount.com", logged[0].Principal)
+	assert.Equal(t, int64(7), logged[0].AccountID.Int64)
+	assert.Equal(t, int64(10), logged[0].SiteID.Int64)
+	assert.False(t, logged[0].Anomaly.Valid, "the organization's first reader isn't unusual")
+
+	logged = nil
+	readSecrets(t, i, errors.New("boom"), "DATABASE_URL")
+	assert.Empty(t, logged, "failed reads handed nothing out")
+}
+
+func TestInterceptorLogsDatabasePasswords(t *testing.T) {
+	var logged []db.CreateSecretAccessLogParams
+	i := newTestInterceptor(reads{}, &logged)
+
+	next := i.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&libopsv1.GetSiteDatabasesResponse{
+			Databases: []*libopsv1.ColocatedDatabase{{Name: "drupal", Password: "hunter2"}},
+		}), nil
+	})
+	_, err := next(context.Background(), connect.NewRequest(&libopsv1.GetSiteDatabasesRequest{SiteId: testSiteID}))
+	require.NoError(t, err)
+	require.Len(t, logged, 1)
+	assert.Equal(t, "DB_DRUPAL_PASSWORD", logged[0].SecretName)
+}
+
+func TestInterceptorAnomalies(t *testing.T) {
+	tests := []struct {
+		name    string
+		history reads
+		count   int
+		unusual bool
+	}{
+		{"familiar principal", reads{lastHour: 3, principal: 40, organization: 90}, 5, false},
+		{"unfamiliar principal", reads{organization: 90}, 5, true},
+		{"crossing the volume threshold", reads{lastHour: VolumeThreshold - 2, principal: 400, organization: 900}, 5, true},
+		{"already over the volume threshold", reads{lastHour: VolumeThreshold + 10, principal: 400, organization: 900}, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logged []db.CreateSecretAccessLogParams
+			i := newTestInterceptor(tt.history, &logged)
+
+			keys := make([]string, tt.count)
+			for n := range keys {
+				keys[n] = "SECRET"
+			}
+			readSecrets(t, i, nil, keys...)
+			require.Len(t, logged, tt.count)
+			for _, l := range logged {
+				assert.Equal(t, tt.unusual, l.Anomaly.Valid, l.Anomaly.String)
+			}
+		})
+	}
+}
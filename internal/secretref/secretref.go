@@ -0,0 +1,122 @@
+// Package secretref parses references to secrets kept in a customer's own
+// secret store. A secret defined as a reference holds no value in libops: the
+// site's controller resolves it at reconcile time with the VM's workload
+// identity, so golden secrets never leave the customer's store.
+package secretref
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	// SchemeGCPSecretManager references a Secret Manager secret version:
+	// gcp-sm://PROJECT/NAME[/VERSION], the latest version when omitted.
+	SchemeGCPSecretManager = "gcp-sm"
+	// SchemeVault references a field of a secret in the customer's Vault:
+	// vault://HOST[:PORT]/PATH[#FIELD][?role=ROLE], read with a token from
+	// Vault's GCP auth method. FIELD defaults to "value" and ROLE to "libops".
+	SchemeVault = "vault"
+
+	// MaxLength is the longest reference that can be stored.
+	MaxLength = 1024
+)
+
+var (
+	gcpProjectPattern = regexp.MustCompile(`^([a-z][a-z0-9-]{4,28}[a-z0-9]|[0-9]{1,20})$`)
+	gcpSecretPattern  = regexp.MustCompile(`^[A-Za-z0-9_-]{1,255}$`)
+	gcpVersionPattern = regexp.MustCompile(`^(latest|[1-9][0-9]*)$`)
+	vaultPathPattern  = regexp.MustCompile(`^[A-Za-z0-9_./-]+$`)
+)
+
+// Reference is a parsed secret reference.
+type Reference struct {
+	Scheme string
+
+	// Set for gcp-sm references
+	Project string
+	Name    string
+	Version string
+
+	// Set for vault references
+	Address string // https://HOST[:PORT]
+	Path    string
+	Field   string
+	Role    string
+}
+
+// Parse validates a reference and returns its parts.
+func Parse(ref string) (Reference, error) {
+	if ref == "" {
+		return Reference{}, fmt.Errorf("reference is required")
+	}
+	if len(ref) > MaxLength {
+		return Reference{}, fmt.Errorf("reference too long (max %d characters)", MaxLength)
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return Reference{}, fmt.Errorf("invalid reference: %w", err)
+	}
+	if u.User != nil {
+		return Reference{}, fmt.Errorf("reference must not contain credentials")
+	}
+
+	switch u.Scheme {
+	case SchemeGCPSecretManager:
+		return parseGCP(u)
+	case SchemeVault:
+		return parseVault(u)
+	default:
+		return Reference{}, fmt.Errorf("unsupported reference scheme %q, expected %s:// or %s://", u.Scheme, SchemeGCPSecretManager, SchemeVault)
+	}
+}
+
+func parseGCP(u *url.URL) (Reference, error) {
+	if u.RawQuery != "" || u.Fragment != "" {
+		return Reference{}, fmt.Errorf("gcp-sm reference takes no query or fragment")
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 1 || len(parts) > 2 || parts[0] == "" {
+		return Reference{}, fmt.Errorf("gcp-sm reference must look like gcp-sm://PROJECT/NAME[/VERSION]")
+	}
+	r := Reference{Scheme: SchemeGCPSecretManager, Project: u.Host, Name: parts[0], Version: "latest"}
+	if len(parts) == 2 {
+		r.Version = parts[1]
+	}
+	if !gcpProjectPattern.MatchString(r.Project) {
+		return Reference{}, fmt.Errorf("invalid Google Cloud project %q", r.Project)
+	}
+	if !gcpSecretPattern.MatchString(r.Name) {
+		return Reference{}, fmt.Errorf("invalid Secret Manager secret name %q", r.Name)
+	}
+	if !gcpVersionPattern.MatchString(r.Version) {
+		return Reference{}, fmt.Errorf("invalid Secret Manager version %q, expected a number or latest", r.Version)
+	}
+	return r, nil
+}
+
+func parseVault(u *url.URL) (Reference, error) {
+	if u.Host == "" {
+		return Reference{}, fmt.Errorf("vault reference must look like vault://HOST/PATH[#FIELD]")
+	}
+	path := strings.Trim(u.Path, "/")
+	if path == "" || !vaultPathPattern.MatchString(path) || strings.Contains(path, "..") {
+		return Reference{}, fmt.Errorf("invalid Vault path %q", path)
+	}
+	r := Reference{
+		Scheme:  SchemeVault,
+		Address: "https://" + u.Host,
+		Path:    path,
+		Field:   u.Fragment,
+		Role:    u.Query().Get("role"),
+	}
+	if r.Field == "" {
+		r.Field = "value"
+	}
+	if r.Role == "" {
+		r.Role = "libops"
+	}
+	return r, nil
+}
@@ -0,0 +1,69 @@
+package secretref
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		ref  string
+		want Reference
+	}{
+		{
+			name: "secret manager version",
+			ref:  "gcp-sm://my-project/db-password/3",
+			want: Reference{Scheme: SchemeGCPSecretManager, Project: "my-project", Name: "db-password", Version: "3"},
+		},
+		{
+			name: "secret manager latest",
+			ref:  "gcp-sm://123456789/API_TOKEN",
+			want: Reference{Scheme: SchemeGCPSecretManager, Project: "123456789", Name: "API_TOKEN", Version: "latest"},
+		},
+		{
+			name: "vault defaults",
+			ref:  "vault://vault.example.com/secret/data/app",
+			want: Reference{Scheme: SchemeVault, Address: "https://vault.example.com", Path: "secret/data/app", Field: "value", Role: "libops"},
+		},
+		{
+			name: "vault field and role",
+			ref:  "vault://vault.example.com:8200/kv/app?role=drupal#DATABASE_URL",
+			want: Reference{Scheme: SchemeVault, Address: "https://vault.example.com:8200", Path: "kv/app", Field: "DATABASE_URL", Role: "drupal"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, ref := range []string{
+		"",
+		"hunter2",
+		"s3://bucket/key",
+		"gcp-sm://my-project",
+		"gcp-sm://my-project/db-password/3/extra",
+		"gcp-sm://my-project/db-password/first",
+		"gcp-sm://My_Project/db-password",
+		"gcp-sm://my-project/db password",
+		"gcp-sm://my-project/db-password?version=3",
+		"vault:///secret/data/app",
+		"vault://vault.example.com/",
+		"vault://vault.example.com/secret/../sys/raw",
+		"vault://token@vault.example.com/secret/data/app",
+		"gcp-sm://my-project/" + strings.Repeat("a", MaxLength),
+	} {
+		t.Run(ref, func(t *testing.T) {
+			_, err := Parse(ref)
+			assert.Error(t, err)
+		})
+	}
+}
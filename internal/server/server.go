@@ -9,16 +9,32 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/access"
 	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/cache"
 	"github.com/libops/api/internal/config"
+	"github.com/libops/api/internal/controllerca"
 	"github.com/libops/api/internal/dash"
 	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/email"
 	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/expiry"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/health"
+	"github.com/libops/api/internal/incident"
+	"github.com/libops/api/internal/invite"
 	"github.com/libops/api/internal/router"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/tfstate"
+	"github.com/libops/api/internal/uptime"
 	"github.com/libops/api/internal/vault"
 )
 
@@ -28,10 +44,27 @@ type Server struct {
 	reloader      *config.Reloader
 	httpServer    *http.Server
 	dbPool        *sql.DB
+	replicaPool   *sql.DB
+	dbRouter      *database.Router
+	lagTicker     *time.Ticker
+	cacheStore    cache.Store
+	queries       db.Querier
 	emailVerifier *auth.EmailVerifier
+	dunning       *billing.Dunning
+	billingMgr    billing.Manager
 	vaultClient   *vault.Client
 	cleanupTicker *time.Ticker
 	cleanupDone   chan bool
+	monitor       *incident.Monitor
+	monitorTicker *time.Ticker
+	prober        *uptime.Prober
+	proberTicker  *time.Ticker
+	exporter      *export.Runner
+	exportTicker  *time.Ticker
+	expirer       *expiry.Expirer
+	expiryTicker  *time.Ticker
+	access        *access.Querier
+	accessTicker  *time.Ticker
 }
 
 // findTemplatesDir searches for the templates directory starting from the current directory
@@ -88,32 +121,124 @@ func New(reloader *config.Reloader) (*Server, error) {
 		return nil, fmt.Errorf("failed to load templates: %w", err)
 	}
 
-	dbPool, err := database.NewPool(cfg.DatabaseURL, database.DefaultConfig())
+	poolConfig := databasePoolConfig(cfg)
+	dbPool, err := database.NewPool(cfg.DatabaseURL, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database pool: %w", err)
 	}
-	slog.Info("Database connection pool established")
+	if err := database.RegisterPoolMetrics(dbPool, database.RolePrimary); err != nil {
+		slog.Warn("Failed to register database pool metrics", "role", database.RolePrimary, "err", err)
+	}
+	slog.Info("Database connection pool established",
+		"max_open", poolConfig.MaxOpenConns,
+		"max_idle", poolConfig.MaxIdleConns)
 
-	// Run database migrations (same in dev and prod)
-	slog.Info("Running database migrations")
-	if err := database.Migrate(dbPool); err != nil {
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	var replicaPool *sql.DB
+	if cfg.DatabaseReplicaURL != "" {
+		replicaPool, err = database.NewPool(cfg.DatabaseReplicaURL, poolConfig)
+		if err != nil {
+			_ = dbPool.Close()
+			return nil, fmt.Errorf("failed to create database replica pool: %w", err)
+		}
+		if err := database.RegisterPoolMetrics(replicaPool, database.RoleReplica); err != nil {
+			slog.Warn("Failed to register database pool metrics", "role", database.RoleReplica, "err", err)
+		}
+		slog.Info("Database read replica pool established; routing read-only queries to replica")
 	}
-	slog.Info("Database migrations completed successfully")
 
-	queries := db.New(dbPool)
+	if cfg.MigrateOnStart {
+		slog.Info("Running database migrations")
+		if err := database.Migrate(dbPool); err != nil {
+			return nil, fmt.Errorf("failed to run migrations: %w", err)
+		}
+		slog.Info("Database migrations completed successfully")
+	}
+	// Serving on a schema the code doesn't expect fails in ways that are
+	// much harder to see than refusing to start
+	if err := database.CheckSchema(context.Background(), dbPool); err != nil {
+		return nil, fmt.Errorf("database schema check failed (run `migrate up`): %w", err)
+	}
+
+	dbRouter := database.NewRouter(dbPool, replicaPool)
+	var queries db.Querier = db.New(dbRouter)
 
-	jwtValidator, libopsTokenIssuer, apiKeyManager, authHandler, authorizer, emailVerifier, userpassClient, sessionManager, vaultClient, err := setupAuth(cfg, queries)
+	cacheStore, err := setupCache(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup cache: %w", err)
+	}
+	if cacheStore != nil {
+		queries = cache.NewQuerier(queries, cacheStore, cfg.CacheTTL)
+	}
+	effectiveAccess := access.NewQuerier(queries, dbPool)
+	queries = effectiveAccess
+
+	mailer, err := setupEmail(cfg, queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup email: %w", err)
+	}
+
+	jwtValidator, libopsTokenIssuer, apiKeyManager, authHandler, authorizer, emailVerifier, userpassClient, sessionManager, vaultClient, err := setupAuth(cfg, queries, mailer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup auth: %w", err)
 	}
+	if cfg.AuthzEngine == "opa" {
+		// Listings answer from the built-in roles; filter them through the same
+		// policy as Get. The authorizer keeps reading the unfiltered queries.
+		queries = auth.NewPolicyFilteredQuerier(queries, authorizer)
+	}
 
 	emitter := setupEvents(queries)
+	dunning := billing.NewDunning(queries, emitter, billing.GracePeriods{
+		PastDue:   cfg.BillingPastDueGracePeriod,
+		Suspended: cfg.BillingSuspendedGracePeriod,
+	})
+	dunning.SetMailer(mailer, strings.TrimSuffix(cfg.DashBaseUrl, "/")+"/billing")
+
+	var billingMgr billing.Manager = billing.NewNoOpBillingManager()
+	if !cfg.DisableBilling {
+		billingMgr = billing.NewStripeManager(queries)
+	}
+
+	escalator := incident.NewEscalator(queries)
+	var monitor *incident.Monitor
+	if cfg.SiteDowntimeThreshold > 0 {
+		monitor = incident.NewMonitor(queries, escalator, emitter, cfg.SiteDowntimeThreshold, strings.TrimSuffix(cfg.DashBaseUrl, "/"))
+		monitor.SetHealthThreshold(int32(cfg.SiteHealthThreshold), cfg.SiteHealthSustain)
+	}
+
+	var prober *uptime.Prober
+	if cfg.SiteProbeInterval > 0 {
+		prober = uptime.NewProber(queries)
+	}
+
+	exportStorage, exporter, err := setupExports(context.Background(), cfg, queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup exports: %w", err)
+	}
+	avatars, err := setupAvatars(context.Background(), cfg, queries, exportStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup avatars: %w", err)
+	}
+	controllerCA, err := setupControllerCA(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup controller CA: %w", err)
+	}
+	terraformStates, err := setupTerraformStates(context.Background(), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup terraform state management: %w", err)
+	}
+
+	kmsKeys, err := setupKMSKeys(context.Background(), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup customer-managed keys: %w", err)
+	}
 
 	routerDeps := &router.Dependencies{
 		Config:            cfg,
 		Queries:           queries,
 		Emitter:           emitter,
+		Store:             store.New(dbPool),
+		Dunning:           dunning,
 		Authorizer:        authorizer,
 		JWTValidator:      jwtValidator,
 		LibopsTokenIssuer: libopsTokenIssuer,
@@ -122,6 +247,15 @@ func New(reloader *config.Reloader) (*Server, error) {
 		UserpassClient:    userpassClient,
 		SessionManager:    sessionManager,
 		AllowedOrigins:    cfg.AllowedOrigins,
+		Health:            setupHealth(dbPool, replicaPool, vaultClient, cacheStore, queries),
+		Escalator:         escalator,
+		Inviter:           invite.NewInviter(queries, mailer, cfg.DashBaseUrl),
+		ExportStorage:     exportStorage,
+		Avatars:           avatars,
+		Billing:           billingMgr,
+		ControllerCA:      controllerCA,
+		TerraformStates:   terraformStates,
+		KMSKeys:           kmsKeys,
 	}
 	handler := router.New(routerDeps)
 
@@ -138,9 +272,20 @@ func New(reloader *config.Reloader) (*Server, error) {
 		reloader:      reloader,
 		httpServer:    httpServer,
 		dbPool:        dbPool,
+		replicaPool:   replicaPool,
+		dbRouter:      dbRouter,
+		cacheStore:    cacheStore,
+		queries:       queries,
 		emailVerifier: emailVerifier,
+		dunning:       dunning,
+		billingMgr:    billingMgr,
 		vaultClient:   vaultClient,
 		cleanupDone:   make(chan bool),
+		monitor:       monitor,
+		prober:        prober,
+		exporter:      exporter,
+		expirer:       expiry.NewExpirer(queries, emitter, audit.New(queries)),
+		access:        effectiveAccess,
 	}
 
 	// Register callback to update Vault token when config changes
@@ -159,26 +304,170 @@ func (s *Server) Start() error {
 		return fmt.Errorf("failed to start config reloader: %w", err)
 	}
 
-	if s.emailVerifier != nil {
-		s.cleanupTicker = time.NewTicker(1 * time.Hour)
-		go func() {
-			for {
-				select {
-				case <-s.cleanupTicker.C:
-					ctx := context.Background()
+	s.cleanupTicker = time.NewTicker(1 * time.Hour)
+	go func() {
+		for {
+			select {
+			case <-s.cleanupTicker.C:
+				ctx := context.Background()
+				if s.emailVerifier != nil {
 					if err := s.emailVerifier.CleanupExpiredTokens(ctx); err != nil {
 						slog.Error("failed to cleanup expired verification tokens", "err", err)
 					} else {
 						slog.Debug("cleaned up expired verification tokens")
 					}
+				}
+				if err := s.queries.DeleteExpiredDeviceAuthorizations(ctx); err != nil {
+					slog.Error("failed to cleanup expired device authorizations", "err", err)
+				}
+				if _, err := s.queries.DeleteExpiredIdempotencyKeys(ctx); err != nil {
+					slog.Error("failed to cleanup expired idempotency keys", "err", err)
+				} else {
+					slog.Debug("cleaned up expired idempotency keys")
+				}
+				if deleted, err := s.queries.DeleteSiteProbesBefore(ctx, sql.NullTime{Time: time.Now().Add(-uptime.Retention), Valid: true}); err != nil {
+					slog.Error("failed to cleanup old site probes", "err", err)
+				} else if deleted > 0 {
+					slog.Debug("cleaned up old site probes", "deleted", deleted)
+				}
+				if advanced, err := s.dunning.Sweep(ctx, time.Now()); err != nil {
+					slog.Error("failed to advance billing states", "err", err)
+				} else if advanced > 0 {
+					slog.Info("advanced billing states past their grace period", "organizations", advanced)
+				}
+				// Each completed day is reported once, so hourly runs push usage daily
+				if reported, err := s.billingMgr.ReportMeteredUsage(ctx, time.Now()); err != nil {
+					slog.Error("failed to report metered usage", "err", err)
+				} else if reported > 0 {
+					slog.Info("reported metered usage to Stripe", "reports", reported)
+				}
+			case <-s.cleanupDone:
+				return
+			}
+		}
+	}()
+	slog.Info("Cleanup job started (runs every 1 hour)")
+
+	if s.monitor != nil {
+		s.monitorTicker = time.NewTicker(1 * time.Minute)
+		go func() {
+			for {
+				select {
+				case <-s.monitorTicker.C:
+					opened, resolved, err := s.monitor.Sweep(context.Background(), time.Now())
+					if err != nil {
+						slog.Error("failed to sweep site downtime", "err", err)
+					} else if opened > 0 || resolved > 0 {
+						slog.Info("swept site downtime", "opened", opened, "resolved", resolved)
+					}
 				case <-s.cleanupDone:
 					return
 				}
 			}
 		}()
-		slog.Info("Email verification cleanup job started (runs every 1 hour)")
+		slog.Info("Downtime monitor started (runs every 1 minute)", "threshold", s.config.SiteDowntimeThreshold, "health_threshold", s.config.SiteHealthThreshold)
 	}
 
+	if s.prober != nil {
+		s.proberTicker = time.NewTicker(s.config.SiteProbeInterval)
+		go func() {
+			for {
+				select {
+				case <-s.proberTicker.C:
+					probed, down, err := s.prober.Sweep(context.Background(), time.Now())
+					if err != nil {
+						slog.Error("failed to probe sites", "err", err)
+					} else if down > 0 {
+						slog.Info("probed sites", "probed", probed, "down", down)
+					}
+				case <-s.cleanupDone:
+					return
+				}
+			}
+		}()
+		slog.Info("Uptime prober started", "interval", s.config.SiteProbeInterval)
+	}
+
+	if s.exporter != nil {
+		s.exportTicker = time.NewTicker(1 * time.Minute)
+		go func() {
+			for {
+				select {
+				case <-s.exportTicker.C:
+					succeeded, failed, err := s.exporter.Sweep(context.Background(), time.Now())
+					if err != nil {
+						slog.Error("failed to build organization exports", "err", err)
+					} else if succeeded > 0 || failed > 0 {
+						slog.Info("built organization exports", "succeeded", succeeded, "failed", failed)
+					}
+				case <-s.cleanupDone:
+					return
+				}
+			}
+		}()
+		slog.Info("Export runner started (runs every 1 minute)", "bucket", s.config.ExportBucket)
+	}
+
+	if s.replicaPool != nil && s.config.DatabaseReplicaMaxLag > 0 {
+		s.lagTicker = time.NewTicker(5 * time.Second)
+		go func() {
+			for {
+				select {
+				case <-s.lagTicker.C:
+					wasLagging := s.dbRouter.ReplicaLagging()
+					lag, err := s.dbRouter.CheckReplicaLag(context.Background(), s.config.DatabaseReplicaMaxLag)
+					switch {
+					case err != nil && !wasLagging:
+						slog.Warn("Routing reads to the primary: replica lag can't be measured", "err", err)
+					case err == nil && s.dbRouter.ReplicaLagging() && !wasLagging:
+						slog.Warn("Routing reads to the primary: replica is behind", "lag", lag, "max_lag", s.config.DatabaseReplicaMaxLag)
+					case err == nil && !s.dbRouter.ReplicaLagging() && wasLagging:
+						slog.Info("Routing reads to the replica again", "lag", lag)
+					}
+				case <-s.cleanupDone:
+					return
+				}
+			}
+		}()
+		slog.Info("Replica lag check started (runs every 5 seconds)", "max_lag", s.config.DatabaseReplicaMaxLag)
+	}
+
+	s.expiryTicker = time.NewTicker(1 * time.Minute)
+	go func() {
+		for {
+			select {
+			case <-s.expiryTicker.C:
+				expired, err := s.expirer.Sweep(context.Background(), time.Now())
+				if err != nil {
+					slog.Error("failed to expire access grants", "err", err)
+				} else if expired > 0 {
+					slog.Info("expired access grants", "grants", expired)
+				}
+			case <-s.cleanupDone:
+				return
+			}
+		}
+	}()
+	slog.Info("Access expiry sweep started (runs every 1 minute)")
+
+	s.accessTicker = time.NewTicker(10 * time.Second)
+	go func() {
+		for {
+			select {
+			case <-s.accessTicker.C:
+				refreshed, err := s.access.Sweep(context.Background())
+				if err != nil {
+					slog.Error("failed to refresh effective access", "err", err)
+				} else if refreshed > 0 {
+					slog.Debug("refreshed effective access", "accounts", refreshed)
+				}
+			case <-s.cleanupDone:
+				return
+			}
+		}
+	}()
+	slog.Info("Effective access refresh started (runs every 10 seconds)")
+
 	slog.Info("Starting LibOps API v1 (ConnectRPC)", "addr", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
@@ -194,10 +483,40 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		slog.Info("Config reloader stopped")
 	}
 
+	if s.monitorTicker != nil {
+		s.monitorTicker.Stop()
+		slog.Info("Stopped downtime monitor")
+	}
+
+	if s.proberTicker != nil {
+		s.proberTicker.Stop()
+		slog.Info("Stopped uptime prober")
+	}
+
+	if s.exportTicker != nil {
+		s.exportTicker.Stop()
+		slog.Info("Stopped export runner")
+	}
+
+	if s.lagTicker != nil {
+		s.lagTicker.Stop()
+		slog.Info("Stopped replica lag check")
+	}
+
+	if s.expiryTicker != nil {
+		s.expiryTicker.Stop()
+		slog.Info("Stopped access expiry sweep")
+	}
+
+	if s.accessTicker != nil {
+		s.accessTicker.Stop()
+		slog.Info("Stopped effective access refresh")
+	}
+
 	if s.cleanupTicker != nil {
 		s.cleanupTicker.Stop()
 		close(s.cleanupDone)
-		slog.Info("Stopped email verification cleanup job")
+		slog.Info("Stopped cleanup job")
 	}
 
 	if err := s.httpServer.Shutdown(ctx); err != nil {
@@ -205,6 +524,18 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		return fmt.Errorf("could not stop server gracefully: %w", err)
 	}
 
+	if s.cacheStore != nil {
+		if err := s.cacheStore.Close(); err != nil {
+			slog.Error("Error closing cache", "error", err)
+		}
+	}
+
+	if s.replicaPool != nil {
+		if err := s.replicaPool.Close(); err != nil {
+			slog.Error("Error closing database replica", "error", err)
+		}
+	}
+
 	if err := s.dbPool.Close(); err != nil {
 		return fmt.Errorf("error closing database: %w", err)
 	}
@@ -213,8 +544,65 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// databasePoolConfig builds the connection pool settings from configuration,
+// falling back to database defaults for unset values.
+func databasePoolConfig(cfg *config.Config) *database.Config {
+	poolConfig := database.DefaultConfig()
+	if cfg.DatabaseMaxOpenConns > 0 {
+		poolConfig.MaxOpenConns = cfg.DatabaseMaxOpenConns
+	}
+	if cfg.DatabaseMaxIdleConns > 0 {
+		poolConfig.MaxIdleConns = cfg.DatabaseMaxIdleConns
+	}
+	if cfg.DatabaseConnMaxLifetime > 0 {
+		poolConfig.ConnMaxLifetime = cfg.DatabaseConnMaxLifetime
+	}
+	if cfg.DatabaseConnMaxIdleTime > 0 {
+		poolConfig.ConnMaxIdleTime = cfg.DatabaseConnMaxIdleTime
+	}
+	return poolConfig
+}
+
+// setupCache initializes the configured cache store for hot lookups.
+// Returns nil when caching is disabled.
+func setupCache(cfg *config.Config) (cache.Store, error) {
+	switch cfg.CacheBackend {
+	case "memory":
+		slog.Info("In-process cache enabled for hot lookups", "size", cfg.CacheSize, "ttl", cfg.CacheTTL)
+		return cache.NewLRU(cfg.CacheSize), nil
+	case "redis":
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		store, err := cache.NewRedis(ctx, cfg.RedisURL)
+		if err != nil {
+			return nil, err
+		}
+		slog.Info("Redis cache enabled for hot lookups", "ttl", cfg.CacheTTL)
+		return store, nil
+	default:
+		return nil, nil
+	}
+}
+
+// setupHealth assembles readiness checks for the API's dependencies.
+func setupHealth(dbPool, replicaPool *sql.DB, vaultClient *vault.Client, cacheStore cache.Store, queries db.Querier) *health.Handler {
+	checks := []health.Check{
+		health.DatabaseCheck("database", dbPool),
+		health.VaultCheck(vaultClient.GetAPIClient()),
+		health.EventQueueCheck(queries),
+	}
+	if replicaPool != nil {
+		// Replica reads do not fall back to the primary, so an unreachable replica fails readiness.
+		checks = append(checks, health.DatabaseCheck("database_replica", replicaPool))
+	}
+	if pinger, ok := cacheStore.(interface{ Ping(context.Context) error }); ok {
+		checks = append(checks, health.Check{Name: "cache", Critical: false, Fn: pinger.Ping})
+	}
+	return health.NewHandler(checks...)
+}
+
 // setupAuth initializes authentication components.
-func setupAuth(cfg *config.Config, queries db.Querier) (
+func setupAuth(cfg *config.Config, queries db.Querier, mailer *email.Sender) (
 	*auth.VaultJWTValidator,
 	*auth.LibopsTokenIssuer,
 	*auth.APIKeyManager,
@@ -256,11 +644,15 @@ func setupAuth(cfg *config.Config, queries db.Querier) (
 
 	jwtValidator.SetAPIKeyManager(apiKeyManager)
 
-	emailVerifier := auth.NewEmailVerifier(queries, nil, cfg.APIBaseURL) // nil = no email sender (dev mode)
+	emailVerifier := auth.NewEmailVerifier(queries, mailer, cfg.APIBaseURL)
 
 	userpassClient := auth.NewUserpassClient(vaultClient, "userpass", queries, emailVerifier)
 
 	authorizer := auth.NewAuthorizer(queries)
+	if cfg.AuthzEngine == "opa" {
+		authorizer.SetPolicyDecisionPoint(auth.NewOPAClient(cfg.AuthzOPAURL, cfg.AuthzOPATimeout))
+		slog.Info("Authorization decisions delegated to OPA", "url", cfg.AuthzOPAURL)
+	}
 
 	// Initialize Goth OAuth manager (if configured)
 	var gothManager *auth.GothOAuthManager
@@ -305,6 +697,107 @@ func setupAuth(cfg *config.Config, queries db.Querier) (
 	return jwtValidator, libopsTokenIssuer, apiKeyManager, authHandler, authorizer, emailVerifier, userpassClient, sessionManager, vaultClient, nil
 }
 
+// setupEmail creates the transactional email sender for the configured provider.
+func setupEmail(cfg *config.Config, queries db.Querier) (*email.Sender, error) {
+	provider, err := email.NewProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Email sender configured", "provider", provider.Name())
+	return email.NewSender(queries, provider, cfg.EmailFrom, cfg.DashBaseUrl), nil
+}
+
+// setupExports creates the storage and runner of organization exports, both nil
+// when no export bucket is configured.
+func setupExports(ctx context.Context, cfg *config.Config, queries db.Querier) (export.Storage, *export.Runner, error) {
+	if cfg.ExportBucket == "" {
+		slog.Info("Organization exports disabled (no EXPORT_BUCKET)")
+		return nil, nil, nil
+	}
+
+	storage, err := export.NewGCS(ctx, cfg.ExportSignerServiceAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var backups export.Backups
+	if cfg.BackupBucket != "" {
+		backups = export.NewBucketBackups(storage, cfg.BackupBucket)
+	}
+
+	slog.Info("Organization exports configured", "bucket", cfg.ExportBucket, "backups", cfg.BackupBucket != "")
+	return storage, export.NewRunner(queries, storage, cfg.ExportBucket, backups, export.VaultSecrets(queries), cfg.ExportRetention), nil
+}
+
+// setupAvatars creates the store of account avatars, sharing the exports' Cloud
+// Storage client when there is one. Uploads are refused when no avatar bucket is
+// configured.
+func setupAvatars(ctx context.Context, cfg *config.Config, queries db.Querier, storage export.Storage) (*avatar.Store, error) {
+	if cfg.AvatarBucket == "" {
+		slog.Info("Avatar uploads disabled (no AVATAR_BUCKET)")
+		return avatar.NewStore(queries, nil, ""), nil
+	}
+
+	if storage == nil {
+		gcs, err := export.NewGCS(ctx, cfg.ExportSignerServiceAccount)
+		if err != nil {
+			return nil, err
+		}
+		storage = gcs
+	}
+
+	slog.Info("Avatar uploads configured", "bucket", cfg.AvatarBucket)
+	return avatar.NewStore(queries, storage, cfg.AvatarBucket), nil
+}
+
+// setupControllerCA loads the CA that issues site controllers their client
+// certificates, nil when controller mTLS is off.
+func setupControllerCA(cfg *config.Config) (*controllerca.CA, error) {
+	if cfg.ControllerMTLSMode == "" || cfg.ControllerMTLSMode == auth.ClientCertModeOff {
+		slog.Info("Controller mTLS disabled")
+		return nil, nil
+	}
+
+	ca, err := controllerca.New(cfg.ControllerCACert, cfg.ControllerCAKey)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Controller mTLS configured", "mode", cfg.ControllerMTLSMode, "client_cert_header_trusted", cfg.ClientCertHeaderTrusted)
+	return ca, nil
+}
+
+// setupTerraformStates creates the store of organizations' Terraform state,
+// nil when terraform state management is disabled.
+func setupTerraformStates(ctx context.Context, cfg *config.Config) (tfstate.Store, error) {
+	if !cfg.TerraformStateManagement {
+		slog.Info("Terraform state management disabled")
+		return nil, nil
+	}
+
+	store, err := tfstate.NewGCS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Terraform state management configured")
+	return store, nil
+}
+
+// setupKMSKeys creates the reader of organizations' Cloud KMS keys, nil when
+// customer-managed keys are disabled.
+func setupKMSKeys(ctx context.Context, cfg *config.Config) (gcp.KMSKeys, error) {
+	if !cfg.CustomerManagedKeys {
+		slog.Info("Customer-managed encryption keys disabled")
+		return nil, nil
+	}
+
+	keys, err := gcp.NewKMS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Customer-managed encryption keys configured")
+	return keys, nil
+}
+
 // setupEvents initializes event emitter.
 // Events are written to the event_queue table and processed by the orchestrator.
 func setupEvents(queries db.Querier) *events.Emitter {
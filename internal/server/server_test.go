@@ -66,7 +66,7 @@ func TestSetupAuth(t *testing.T) {
 	}
 
 	// This will fail to connect to Vault, but we're testing the structure
-	_, _, _, _, _, _, _, _, err := setupAuth(cfg, nil)
+	_, _, _, _, _, _, _, _, _, err := setupAuth(cfg, nil, nil)
 
 	// We expect an error because we don't have a real Vault
 	if err == nil {
@@ -4,6 +4,7 @@ package account
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -13,7 +14,9 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/ownership"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
@@ -22,18 +25,23 @@ import (
 
 // AdminAccountService implements the admin account service with full access.
 type AdminAccountService struct {
-	repo    *Repository
-	emitter *events.Emitter
+	repo        *Repository
+	emitter     *events.Emitter
+	reassigner  *ownership.Reassigner
+	auditLogger *audit.Logger
 }
 
 // Compile-time check.
 var _ libopsv1connect.AdminAccountServiceHandler = (*AdminAccountService)(nil)
 
-// NewAdminAccountService creates a new admin account service.
-func NewAdminAccountService(querier db.Querier, emitter *events.Emitter) *AdminAccountService {
+// NewAdminAccountService creates a new admin account service. Deleting an account
+// hands the organizations it owns to a successor through reassigner.
+func NewAdminAccountService(querier db.Querier, emitter *events.Emitter, reassigner *ownership.Reassigner, auditLogger *audit.Logger) *AdminAccountService {
 	return &AdminAccountService{
-		repo:    NewRepository(querier),
-		emitter: emitter,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		reassigner:  reassigner,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -239,7 +247,9 @@ func (s *AdminAccountService) UpdateAccount(
 	}), nil
 }
 
-// DeleteAccount deletes an account.
+// DeleteAccount deletes an account. Organizations it owns are first handed to
+// another owner or developer; the account can't be deleted while it is the only
+// one left to own an organization.
 func (s *AdminAccountService) DeleteAccount(
 	ctx context.Context,
 	req *connect.Request[libopsv1.DeleteAccountRequest],
@@ -255,6 +265,25 @@ func (s *AdminAccountService) DeleteAccount(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
 	}
 
+	account, err := s.repo.GetAccountByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "account")
+	}
+
+	handovers, err := s.reassigner.PlanHandovers(ctx, account.ID)
+	if errors.Is(err, ownership.ErrNoSuccessor) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("%w; transfer or delete the organization first", err))
+	}
+	if err != nil {
+		slog.Error("Failed to plan organization handovers", "error", err, "account_id", accountID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := s.reassigner.HandOver(ctx, account.ID, handovers); err != nil {
+		slog.Error("Failed to hand over organizations", "error", err, "account_id", accountID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to hand over organizations"))
+	}
+
 	err = s.repo.DeleteAccount(ctx, publicID)
 	if err != nil {
 		return nil, service.HandleDatabaseError(err, "account")
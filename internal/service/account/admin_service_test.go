@@ -0,0 +1,65 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/ownership"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestDeleteAccountHandsOverOrganizations tests that an owner's organizations are
+// handed over before the account is deleted, and that an owner nobody can take
+// over from isn't deleted.
+func TestDeleteAccountHandsOverOrganizations(t *testing.T) {
+	accountID := uuid.NewString()
+	var deleted []string
+	var promoted []db.UpdateOrganizationMemberParams
+	successor := true
+	mock := &testutils.MockQuerier{
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			return db.GetAccountRow{ID: 10, PublicID: publicID}, nil
+		},
+		ListAccountOwnedOrganizationsFunc: func(ctx context.Context, accountID int64) ([]db.ListAccountOwnedOrganizationsRow, error) {
+			return []db.ListAccountOwnedOrganizationsRow{{ID: 1, Name: "Library"}}, nil
+		},
+		GetOrganizationSuccessorFunc: func(ctx context.Context, arg db.GetOrganizationSuccessorParams) (db.GetOrganizationSuccessorRow, error) {
+			if !successor {
+				return db.GetOrganizationSuccessorRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationSuccessorRow{ID: 20, Role: db.OrganizationMembersRoleDeveloper}, nil
+		},
+		UpdateOrganizationMemberFunc: func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+			promoted = append(promoted, arg)
+			return nil
+		},
+		DeleteAccountFunc: func(ctx context.Context, publicID string) error {
+			deleted = append(deleted, publicID)
+			return nil
+		},
+	}
+	svc := NewAdminAccountService(mock, nil, ownership.NewReassigner(mock, nil), audit.New(mock))
+	deleteAccount := func() error {
+		_, err := svc.DeleteAccount(context.Background(), connect.NewRequest(&libopsv1.DeleteAccountRequest{AccountId: accountID}))
+		return err
+	}
+
+	successor = false
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(deleteAccount()))
+	assert.Empty(t, deleted)
+
+	successor = true
+	require.NoError(t, deleteAccount())
+	assert.Equal(t, []string{accountID}, deleted)
+	require.Len(t, promoted, 1)
+	assert.Equal(t, int64(20), promoted[0].AccountID)
+}
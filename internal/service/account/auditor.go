@@ -0,0 +1,166 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	// maxAuditorGrant bounds how long an auditor grant can last, so read access
+	// to every organization is never left standing.
+	maxAuditorGrant = 90 * 24 * time.Hour
+
+	// maxAuditorReasonLength matches the platform_auditors.reason column.
+	maxAuditorReasonLength = 1000
+)
+
+// ListPlatformAuditors lists accounts with an unexpired auditor grant.
+func (s *AdminAccountService) ListPlatformAuditors(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPlatformAuditorsRequest],
+) (*connect.Response[libopsv1.ListPlatformAuditorsResponse], error) {
+	rows, err := s.repo.db.ListPlatformAuditors(ctx)
+	if err != nil {
+		slog.Error("Failed to list platform auditors", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	auditors := make([]*libopsv1.PlatformAuditor, 0, len(rows))
+	for _, row := range rows {
+		auditors = append(auditors, &libopsv1.PlatformAuditor{
+			AccountId: row.AccountPublicID,
+			Email:     row.Email,
+			Reason:    row.Reason,
+			ExpiresAt: row.ExpiresAt.Unix(),
+			CreatedAt: row.CreatedAt.Time.Unix(),
+			CreatedBy: row.CreatedByPublicID.String,
+		})
+	}
+	return connect.NewResponse(&libopsv1.ListPlatformAuditorsResponse{Auditors: auditors}), nil
+}
+
+// GrantPlatformAuditor grants an account read-only access to the whole
+// platform until the requested expiry.
+func (s *AdminAccountService) GrantPlatformAuditor(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GrantPlatformAuditorRequest],
+) (*connect.Response[libopsv1.GrantPlatformAuditorResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	account, err := s.auditorAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if account.ID == userInfo.AccountID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("you can't grant yourself auditor access"))
+	}
+
+	reason := strings.TrimSpace(req.Msg.Reason)
+	if reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is required"))
+	}
+	if len(reason) > maxAuditorReasonLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason must be at most %d characters", maxAuditorReasonLength))
+	}
+
+	now := time.Now()
+	expiresAt := time.Unix(req.Msg.ExpiresAt, 0)
+	if !expiresAt.After(now) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at must be in the future"))
+	}
+	if expiresAt.Sub(now) > maxAuditorGrant {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("auditor access can be granted for at most %d days", int(maxAuditorGrant.Hours()/24)))
+	}
+
+	if err := s.repo.db.GrantPlatformAuditor(ctx, db.GrantPlatformAuditorParams{
+		AccountID: account.ID,
+		Reason:    reason,
+		ExpiresAt: expiresAt.UTC(),
+		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	}); err != nil {
+		slog.Error("Failed to grant platform auditor", "error", err, "account_id", account.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, account.ID, audit.AccountEntityType, audit.PlatformAuditorGrant, map[string]any{
+		"account_id": account.PublicID,
+		"reason":     reason,
+		"expires_at": expiresAt.Unix(),
+	})
+
+	var createdBy string
+	if creator, err := s.repo.db.GetAccountByID(ctx, userInfo.AccountID); err == nil {
+		createdBy = creator.PublicID
+	}
+	return connect.NewResponse(&libopsv1.GrantPlatformAuditorResponse{
+		Auditor: &libopsv1.PlatformAuditor{
+			AccountId: account.PublicID,
+			Email:     account.Email,
+			Reason:    reason,
+			ExpiresAt: expiresAt.Unix(),
+			CreatedAt: now.Unix(),
+			CreatedBy: createdBy,
+		},
+	}), nil
+}
+
+// RevokePlatformAuditor ends an account's auditor grant early.
+func (s *AdminAccountService) RevokePlatformAuditor(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RevokePlatformAuditorRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	account, err := s.auditorAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.repo.db.RevokePlatformAuditor(ctx, account.ID)
+	if err != nil {
+		slog.Error("Failed to revoke platform auditor", "error", err, "account_id", account.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error"))
+	}
+	if revoked == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account has no auditor access"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, account.ID, audit.AccountEntityType, audit.PlatformAuditorRevoke, map[string]any{
+		"account_id": account.PublicID,
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// auditorAccount looks up the account a grant is for.
+func (s *AdminAccountService) auditorAccount(ctx context.Context, accountID string) (db.GetAccountRow, error) {
+	if err := validation.UUID(accountID); err != nil {
+		return db.GetAccountRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	account, err := s.repo.GetAccountByPublicID(ctx, uuid.MustParse(accountID))
+	if err != nil {
+		return db.GetAccountRow{}, service.HandleDatabaseError(err, "account")
+	}
+	return account, nil
+}
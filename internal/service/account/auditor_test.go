@@ -0,0 +1,83 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/ownership"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestGrantPlatformAuditor tests that auditor grants need a reason and a
+// bounded expiry, and can be revoked once.
+func TestGrantPlatformAuditor(t *testing.T) {
+	auditorID := uuid.NewString()
+	grants := map[int64]db.GrantPlatformAuditorParams{}
+	mock := &testutils.MockQuerier{
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			if publicID == auditorID {
+				return db.GetAccountRow{ID: 10, PublicID: publicID, Email: "reviewer@example.edu"}, nil
+			}
+			return db.GetAccountRow{ID: 1, PublicID: publicID}, nil
+		},
+		GrantPlatformAuditorFunc: func(ctx context.Context, arg db.GrantPlatformAuditorParams) error {
+			grants[arg.AccountID] = arg
+			return nil
+		},
+		RevokePlatformAuditorFunc: func(ctx context.Context, accountID int64) (int64, error) {
+			if _, ok := grants[accountID]; !ok {
+				return 0, nil
+			}
+			delete(grants, accountID)
+			return 1, nil
+		},
+	}
+	svc := NewAdminAccountService(mock, nil, ownership.NewReassigner(mock, nil), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+
+	grant := func(accountID, reason string, expiresAt time.Time) (*libopsv1.PlatformAuditor, error) {
+		resp, err := svc.GrantPlatformAuditor(ctx, connect.NewRequest(&libopsv1.GrantPlatformAuditorRequest{
+			AccountId: accountID,
+			Reason:    reason,
+			ExpiresAt: expiresAt.Unix(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Auditor, nil
+	}
+	revoke := func() error {
+		_, err := svc.RevokePlatformAuditor(ctx, connect.NewRequest(&libopsv1.RevokePlatformAuditorRequest{AccountId: auditorID}))
+		return err
+	}
+
+	week := time.Now().Add(7 * 24 * time.Hour)
+	_, err := grant(auditorID, " ", week)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = grant(auditorID, "Annual security review", time.Now().Add(-time.Minute))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = grant(auditorID, "Annual security review", time.Now().Add(91*24*time.Hour))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = grant(uuid.NewString(), "Annual security review", week)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "admins can't grant themselves auditor access")
+	assert.Empty(t, grants)
+
+	auditor, err := grant(auditorID, "Annual security review", week)
+	require.NoError(t, err)
+	assert.Equal(t, "reviewer@example.edu", auditor.Email)
+	assert.Equal(t, week.Unix(), auditor.ExpiresAt)
+	assert.Equal(t, "Annual security review", grants[10].Reason)
+
+	require.NoError(t, revoke())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(revoke()))
+}
@@ -0,0 +1,199 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	// Time zones are validated and applied without relying on the image's zoneinfo
+	_ "time/tzdata"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/i18n"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// Preferences of accounts that never saved any.
+const (
+	DefaultTheme    = db.AccountPreferencesThemeSystem
+	DefaultTimezone = "UTC"
+	DefaultPageSize = 50
+
+	minPageSize = 10
+	maxPageSize = 100
+)
+
+// LoadPreferences returns the account's saved dashboard preferences, or the
+// defaults when it has none.
+func LoadPreferences(ctx context.Context, querier db.Querier, accountID int64) (db.AccountPreference, error) {
+	prefs, err := querier.GetAccountPreferences(ctx, accountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.AccountPreference{
+			AccountID: accountID,
+			Theme:     DefaultTheme,
+			Timezone:  DefaultTimezone,
+			PageSize:  DefaultPageSize,
+		}, nil
+	}
+	return prefs, err
+}
+
+// GetAccountPreferences returns the authenticated user's dashboard preferences.
+func (s *AccountService) GetAccountPreferences(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetAccountPreferencesRequest],
+) (*connect.Response[libopsv1.GetAccountPreferencesResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	prefs, err := LoadPreferences(ctx, s.repo.db, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get preferences: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.GetAccountPreferencesResponse{
+		Preferences: s.toPreferencesProto(ctx, prefs),
+	}), nil
+}
+
+// UpdateAccountPreferences updates the authenticated user's dashboard preferences.
+func (s *AccountService) UpdateAccountPreferences(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateAccountPreferencesRequest],
+) (*connect.Response[libopsv1.UpdateAccountPreferencesResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	prefs, err := LoadPreferences(ctx, s.repo.db, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get preferences: %w", err))
+	}
+
+	msg := req.Msg
+	if shouldUpdateField(msg.UpdateMask, "theme") && msg.Theme != nil {
+		theme := db.AccountPreferencesTheme(*msg.Theme)
+		switch theme {
+		case db.AccountPreferencesThemeSystem, db.AccountPreferencesThemeLight, db.AccountPreferencesThemeDark:
+			prefs.Theme = theme
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("theme must be system, light or dark"))
+		}
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "timezone") && msg.Timezone != nil {
+		if err := validateTimezone(*msg.Timezone); err != nil {
+			return nil, err
+		}
+		prefs.Timezone = *msg.Timezone
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "page_size") && msg.PageSize != nil {
+		if *msg.PageSize < minPageSize || *msg.PageSize > maxPageSize {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("page size must be between %d and %d", minPageSize, maxPageSize))
+		}
+		prefs.PageSize = *msg.PageSize
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "locale") && msg.Locale != nil {
+		if *msg.Locale != "" && !i18n.IsSupported(*msg.Locale) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("locale must be one of %s, or empty to follow the browser", strings.Join(i18n.Supported(), ", ")))
+		}
+		prefs.Locale = *msg.Locale
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "default_organization_id") && msg.DefaultOrganizationId != nil {
+		prefs.DefaultOrganizationID = sql.NullInt64{}
+		if *msg.DefaultOrganizationId != "" {
+			orgID, err := s.accessibleOrganization(ctx, userInfo, *msg.DefaultOrganizationId)
+			if err != nil {
+				return nil, err
+			}
+			prefs.DefaultOrganizationID = sql.NullInt64{Int64: orgID, Valid: true}
+		}
+	}
+
+	if err := s.savePreferences(ctx, userInfo.AccountID, prefs); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateAccountPreferencesResponse{
+		Preferences: s.toPreferencesProto(ctx, prefs),
+	}), nil
+}
+
+// validateTimezone checks that a time zone is an IANA zone. "Local" would be the
+// server's zone, which means nothing to the user.
+func validateTimezone(timezone string) error {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" || timezone == "Local" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown time zone %q", timezone))
+	}
+	return nil
+}
+
+// savePreferences stores an account's preferences
+func (s *AccountService) savePreferences(ctx context.Context, accountID int64, prefs db.AccountPreference) error {
+	err := s.repo.db.UpsertAccountPreferences(ctx, db.UpsertAccountPreferencesParams{
+		AccountID:             accountID,
+		Theme:                 prefs.Theme,
+		Timezone:              prefs.Timezone,
+		DefaultOrganizationID: prefs.DefaultOrganizationID,
+		PageSize:              prefs.PageSize,
+		Locale:                prefs.Locale,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save preferences: %w", err))
+	}
+	return nil
+}
+
+// accessibleOrganization returns the internal ID of an organization the user can
+// read. Organizations they can't see are reported as not found.
+func (s *AccountService) accessibleOrganization(ctx context.Context, userInfo *auth.UserInfo, organizationID string) (int64, error) {
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization ID"))
+	}
+
+	authorizer, err := auth.GetAuthorizer(ctx)
+	if err != nil {
+		authorizer = auth.NewAuthorizer(s.repo.db)
+	}
+	if err := authorizer.CheckOrganizationAccess(ctx, userInfo, publicID, auth.PermissionRead); err != nil {
+		return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+	}
+
+	org, err := s.repo.db.GetOrganization(ctx, publicID.String())
+	if err != nil {
+		return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+	}
+	return org.ID, nil
+}
+
+func (s *AccountService) toPreferencesProto(ctx context.Context, prefs db.AccountPreference) *libopsv1.AccountPreferences {
+	result := &libopsv1.AccountPreferences{
+		Theme:    string(prefs.Theme),
+		Timezone: prefs.Timezone,
+		PageSize: prefs.PageSize,
+		Locale:   prefs.Locale,
+	}
+	if prefs.DefaultOrganizationID.Valid {
+		// A deleted organization clears the column, so a failed lookup only
+		// leaves the default unset
+		if org, err := s.repo.db.GetOrganizationByID(ctx, prefs.DefaultOrganizationID.Int64); err == nil {
+			result.DefaultOrganizationId = org.PublicID
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestAccountPreferences tests that preferences start from the defaults, that only
+// masked fields change, and that invalid values are rejected.
+func TestAccountPreferences(t *testing.T) {
+	const orgID = "0194d3a0-0000-7000-8000-000000000001"
+
+	var saved *db.UpsertAccountPreferencesParams
+	mock := &testutils.MockQuerier{
+		GetAccountPreferencesFunc: func(ctx context.Context, accountID int64) (db.AccountPreference, error) {
+			if saved == nil {
+				return db.AccountPreference{}, sql.ErrNoRows
+			}
+			return db.AccountPreference{
+				AccountID:             saved.AccountID,
+				Theme:                 saved.Theme,
+				Timezone:              saved.Timezone,
+				DefaultOrganizationID: saved.DefaultOrganizationID,
+				PageSize:              saved.PageSize,
+				Locale:                saved.Locale,
+			}, nil
+		},
+		UpsertAccountPreferencesFunc: func(ctx context.Context, arg db.UpsertAccountPreferencesParams) error {
+			saved = &arg
+			return nil
+		},
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 9, PublicID: publicID}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: orgID}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+	}
+	svc := NewAccountService(mock, nil, nil)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+
+	got, err := svc.GetAccountPreferences(ctx, connect.NewRequest(&libopsv1.GetAccountPreferencesRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, "system", got.Msg.Preferences.Theme)
+	assert.Equal(t, "UTC", got.Msg.Preferences.Timezone)
+	assert.Equal(t, int32(50), got.Msg.Preferences.PageSize)
+	assert.Empty(t, got.Msg.Preferences.Locale, "the browser's language is used by default")
+
+	dark, zone, size, org := "dark", "America/New_York", int32(25), orgID
+	updated, err := svc.UpdateAccountPreferences(ctx, connect.NewRequest(&libopsv1.UpdateAccountPreferencesRequest{
+		Theme:                 &dark,
+		Timezone:              &zone,
+		PageSize:              &size,
+		DefaultOrganizationId: &org,
+		UpdateMask:            &fieldmaskpb.FieldMask{Paths: []string{"theme", "default_organization_id"}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "dark", updated.Msg.Preferences.Theme)
+	assert.Equal(t, "UTC", updated.Msg.Preferences.Timezone, "fields outside the mask are kept")
+	assert.Equal(t, int32(50), updated.Msg.Preferences.PageSize)
+	assert.Equal(t, orgID, updated.Msg.Preferences.DefaultOrganizationId)
+	assert.Equal(t, int64(9), saved.DefaultOrganizationID.Int64)
+
+	updated, err = svc.UpdateAccountPreferences(ctx, connect.NewRequest(&libopsv1.UpdateAccountPreferencesRequest{Timezone: &zone, PageSize: &size, Locale: ptr("fr")}))
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", updated.Msg.Preferences.Timezone)
+	assert.Equal(t, int32(25), updated.Msg.Preferences.PageSize)
+	assert.Equal(t, "fr", updated.Msg.Preferences.Locale)
+	assert.Equal(t, "dark", updated.Msg.Preferences.Theme, "unset fields are kept")
+
+	invalid := []*libopsv1.UpdateAccountPreferencesRequest{
+		{Theme: ptr("sepia")},
+		{Timezone: ptr("Mars/Olympus_Mons")},
+		{Timezone: ptr("Local")},
+		{PageSize: ptr(int32(5000))},
+		{DefaultOrganizationId: ptr("not-a-uuid")},
+		{Locale: ptr("tlh")},
+	}
+	for _, req := range invalid {
+		_, err := svc.UpdateAccountPreferences(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+
+	_, err = svc.GetAccountPreferences(context.Background(), connect.NewRequest(&libopsv1.GetAccountPreferencesRequest{}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
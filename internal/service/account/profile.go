@@ -0,0 +1,198 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// maxNameLength caps a display name, counted in characters
+const maxNameLength = 100
+
+// phonePattern matches an E.164 phone number
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// GetAccountProfile returns the authenticated user's profile.
+func (s *AccountService) GetAccountProfile(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetAccountProfileRequest],
+) (*connect.Response[libopsv1.GetAccountProfileResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	profile, err := s.profile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.GetAccountProfileResponse{Profile: profile}), nil
+}
+
+// UpdateAccountProfile updates the authenticated user's name, time zone or phone
+// number. The time zone is the one saved with their preferences.
+func (s *AccountService) UpdateAccountProfile(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateAccountProfileRequest],
+) (*connect.Response[libopsv1.UpdateAccountProfileResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	account, err := s.repo.db.GetAccountProfile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	msg := req.Msg
+	name, phone := account.Name, account.Phone
+	if shouldUpdateField(msg.UpdateMask, "name") && msg.Name != nil {
+		trimmed := strings.TrimSpace(*msg.Name)
+		if utf8.RuneCountInString(trimmed) > maxNameLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most %d characters", maxNameLength))
+		}
+		name = sql.NullString{String: trimmed, Valid: trimmed != ""}
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "phone") && msg.Phone != nil {
+		// Spaces, dashes and parentheses are how people write numbers, not part of them
+		normalized := strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "").Replace(*msg.Phone)
+		if normalized != "" && !phonePattern.MatchString(normalized) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("phone must be in international format, e.g. +15551234567"))
+		}
+		phone = sql.NullString{String: normalized, Valid: normalized != ""}
+	}
+
+	if shouldUpdateField(msg.UpdateMask, "timezone") && msg.Timezone != nil {
+		if err := validateTimezone(*msg.Timezone); err != nil {
+			return nil, err
+		}
+		prefs, err := LoadPreferences(ctx, s.repo.db, userInfo.AccountID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get preferences: %w", err))
+		}
+		prefs.Timezone = *msg.Timezone
+		if err := s.savePreferences(ctx, userInfo.AccountID, prefs); err != nil {
+			return nil, err
+		}
+	}
+
+	if name != account.Name || phone != account.Phone {
+		if err := s.repo.db.UpdateAccountProfile(ctx, db.UpdateAccountProfileParams{
+			Name:  name,
+			Phone: phone,
+			ID:    account.ID,
+		}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	profile, err := s.profile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.UpdateAccountProfileResponse{Profile: profile}), nil
+}
+
+// UploadAccountAvatar replaces the authenticated user's avatar.
+func (s *AccountService) UploadAccountAvatar(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UploadAccountAvatarRequest],
+) (*connect.Response[libopsv1.UploadAccountAvatarResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if !s.avatars.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, avatar.ErrDisabled)
+	}
+	if _, err := avatar.ContentType(req.Msg.Image); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	account, err := s.repo.db.GetAccountProfile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if _, err := s.avatars.Upload(ctx, account.ID, account.PublicID, account.AvatarObject, req.Msg.Image); err != nil {
+		slog.Error("Failed to upload avatar", "account_id", account.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to upload avatar"))
+	}
+
+	profile, err := s.profile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.UploadAccountAvatarResponse{Profile: profile}), nil
+}
+
+// DeleteAccountAvatar removes the authenticated user's avatar.
+func (s *AccountService) DeleteAccountAvatar(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteAccountAvatarRequest],
+) (*connect.Response[libopsv1.DeleteAccountAvatarResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	account, err := s.repo.db.GetAccountProfile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if account.AvatarObject.Valid {
+		if err := s.avatars.Delete(ctx, account.ID, account.AvatarObject); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	profile, err := s.profile(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.DeleteAccountAvatarResponse{Profile: profile}), nil
+}
+
+// profile loads an account's profile with a freshly signed avatar URL. An avatar
+// that can't be signed is left out rather than failing the request.
+func (s *AccountService) profile(ctx context.Context, accountID int64) (*libopsv1.AccountProfile, error) {
+	account, err := s.repo.db.GetAccountProfile(ctx, accountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	prefs, err := LoadPreferences(ctx, s.repo.db, accountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get preferences: %w", err))
+	}
+
+	avatarURL, err := s.avatars.URL(ctx, account.AvatarObject)
+	if err != nil {
+		slog.Error("Failed to sign avatar URL", "account_id", account.PublicID, "err", err)
+	}
+
+	return &libopsv1.AccountProfile{
+		AccountId: account.PublicID,
+		Email:     account.Email,
+		Name:      account.Name.String,
+		AvatarUrl: avatarURL,
+		Timezone:  prefs.Timezone,
+		Phone:     account.Phone.String,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// avatarStorage accepts uploads and signs URLs without Cloud Storage
+type avatarStorage struct {
+	export.Storage
+	uploaded []string
+}
+
+func (s *avatarStorage) Upload(ctx context.Context, bucket, name, contentType string, r io.Reader) error {
+	s.uploaded = append(s.uploaded, name)
+	return nil
+}
+
+func (s *avatarStorage) Delete(ctx context.Context, bucket, name string) error {
+	return nil
+}
+
+func (s *avatarStorage) SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error) {
+	return "https://storage.googleapis.com/" + bucket + "/" + name + "?signed", nil
+}
+
+// TestAccountProfile tests that masked fields change, that the time zone is saved
+// with the preferences, and that invalid values are rejected.
+func TestAccountProfile(t *testing.T) {
+	account := db.GetAccountProfileRow{ID: 1, PublicID: "0194d3a0-0000-7000-8000-000000000001", Email: "art@vandelay.com"}
+	var prefs *db.UpsertAccountPreferencesParams
+	mock := &testutils.MockQuerier{
+		GetAccountProfileFunc: func(ctx context.Context, id int64) (db.GetAccountProfileRow, error) {
+			return account, nil
+		},
+		UpdateAccountProfileFunc: func(ctx context.Context, arg db.UpdateAccountProfileParams) error {
+			account.Name, account.Phone = arg.Name, arg.Phone
+			return nil
+		},
+		UpdateAccountAvatarFunc: func(ctx context.Context, arg db.UpdateAccountAvatarParams) error {
+			account.AvatarObject = arg.AvatarObject
+			return nil
+		},
+		GetAccountPreferencesFunc: func(ctx context.Context, accountID int64) (db.AccountPreference, error) {
+			if prefs == nil {
+				return db.AccountPreference{}, sql.ErrNoRows
+			}
+			return db.AccountPreference{AccountID: accountID, Theme: prefs.Theme, Timezone: prefs.Timezone, PageSize: prefs.PageSize}, nil
+		},
+		UpsertAccountPreferencesFunc: func(ctx context.Context, arg db.UpsertAccountPreferencesParams) error {
+			prefs = &arg
+			return nil
+		},
+	}
+	storage := &avatarStorage{}
+	svc := NewAccountService(mock, nil, avatar.NewStore(mock, storage, "avatars"))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+
+	got, err := svc.GetAccountProfile(ctx, connect.NewRequest(&libopsv1.GetAccountProfileRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, "art@vandelay.com", got.Msg.Profile.Email)
+	assert.Equal(t, "UTC", got.Msg.Profile.Timezone)
+	assert.Empty(t, got.Msg.Profile.AvatarUrl)
+
+	updated, err := svc.UpdateAccountProfile(ctx, connect.NewRequest(&libopsv1.UpdateAccountProfileRequest{
+		Name:       ptr("  Art Vandelay "),
+		Phone:      ptr("+1 (555) 123-4567"),
+		Timezone:   ptr("America/New_York"),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "phone"}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Art Vandelay", updated.Msg.Profile.Name)
+	assert.Equal(t, "+15551234567", updated.Msg.Profile.Phone)
+	assert.Equal(t, "UTC", updated.Msg.Profile.Timezone, "fields outside the mask are kept")
+	assert.Nil(t, prefs)
+
+	updated, err = svc.UpdateAccountProfile(ctx, connect.NewRequest(&libopsv1.UpdateAccountProfileRequest{Timezone: ptr("America/New_York"), Phone: ptr("")}))
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", updated.Msg.Profile.Timezone)
+	assert.Equal(t, "America/New_York", prefs.Timezone, "the time zone is the preference")
+	assert.Empty(t, updated.Msg.Profile.Phone, "an empty phone number clears it")
+	assert.Equal(t, "Art Vandelay", updated.Msg.Profile.Name)
+
+	invalid := []*libopsv1.UpdateAccountProfileRequest{
+		{Name: ptr(string(make([]rune, maxNameLength+1)))},
+		{Phone: ptr("555-1234")},
+		{Timezone: ptr("Local")},
+	}
+	for _, req := range invalid {
+		_, err := svc.UpdateAccountProfile(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+
+	_, err = svc.UploadAccountAvatar(ctx, connect.NewRequest(&libopsv1.UploadAccountAvatarRequest{Image: []byte("not an image")}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	uploaded, err := svc.UploadAccountAvatar(ctx, connect.NewRequest(&libopsv1.UploadAccountAvatarRequest{Image: []byte("\x89PNG\r\n\x1a\n")}))
+	require.NoError(t, err)
+	require.Len(t, storage.uploaded, 1)
+	assert.Equal(t, "https://storage.googleapis.com/avatars/"+storage.uploaded[0]+"?signed", uploaded.Msg.Profile.AvatarUrl)
+
+	deleted, err := svc.DeleteAccountAvatar(ctx, connect.NewRequest(&libopsv1.DeleteAccountAvatarRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, deleted.Msg.Profile.AvatarUrl)
+
+	_, err = NewAccountService(mock, nil, avatar.NewStore(mock, nil, "")).UploadAccountAvatar(ctx,
+		connect.NewRequest(&libopsv1.UploadAccountAvatarRequest{Image: []byte("\x89PNG\r\n\x1a\n")}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err), "uploads need an avatar bucket")
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/avatar"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
@@ -19,16 +21,19 @@ import (
 type AccountService struct {
 	repo          *Repository
 	apiKeyManager *auth.APIKeyManager
+	avatars       *avatar.Store
 }
 
 // Compile-time check.
 var _ libopsv1connect.AccountServiceHandler = (*AccountService)(nil)
 
-// NewAccountService creates a new organization account service.
-func NewAccountService(querier db.Querier, apiKeyManager *auth.APIKeyManager) *AccountService {
+// NewAccountService creates a new organization account service. Avatar uploads
+// fail when avatars is nil.
+func NewAccountService(querier db.Querier, apiKeyManager *auth.APIKeyManager, avatars *avatar.Store) *AccountService {
 	return &AccountService{
 		repo:          NewRepository(querier),
 		apiKeyManager: apiKeyManager,
+		avatars:       avatars,
 	}
 }
 
@@ -105,20 +110,47 @@ func (s *AccountService) CreateApiKey(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 255 characters or less"))
 	}
 
+	// A template stands in for scopes, so the two can't be mixed
+	scopes := req.Msg.Scopes
+	if req.Msg.Template != "" {
+		if len(scopes) > 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("template and scopes cannot both be set"))
+		}
+		template, ok := auth.LookupKeyTemplate(req.Msg.Template)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown template: %s", req.Msg.Template))
+		}
+		scopes = template.Scopes
+	}
+
 	// Validate scopes if provided
-	if len(req.Msg.Scopes) > 0 {
-		_, err := auth.ParseScopes(req.Msg.Scopes)
+	if len(scopes) > 0 {
+		_, err := auth.ParseScopes(scopes)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid scope: %w", err))
 		}
 	}
 
+	// Normalize the networks the key is restricted to, if any
+	allowedCIDRs, err := auth.ParseCIDRs(req.Msg.AllowedCidrs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Get account UUID from database
 	account, err := s.repo.db.GetAccountByID(ctx, accountID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account: %w", err))
 	}
 
+	keyCount, err := s.repo.db.CountAccountAPIKeys(ctx, accountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count API keys: %w", err))
+	}
+	if err := s.repo.quotas.CheckAccount(quota.APIKeysPerAccount, keyCount); err != nil {
+		return nil, err
+	}
+
 	// Use APIKeyManager to create the key (handles both database and Vault storage)
 	apiKey, keyMeta, err := s.apiKeyManager.CreateAPIKey(
 		ctx,
@@ -126,7 +158,8 @@ func (s *AccountService) CreateApiKey(
 		account.PublicID,
 		req.Msg.Name,
 		req.Msg.Description,
-		req.Msg.Scopes,
+		scopes,
+		auth.FormatCIDRs(allowedCIDRs),
 		nil, // expiresAt
 		accountID,
 	)
@@ -140,12 +173,13 @@ func (s *AccountService) CreateApiKey(
 	}
 
 	return connect.NewResponse(&libopsv1.CreateApiKeyResponse{
-		ApiKeyId:    keyMeta.PublicID,
-		ApiKey:      apiKey,
-		Name:        req.Msg.Name,
-		Description: req.Msg.Description,
-		Scopes:      req.Msg.Scopes,
-		CreatedAt:   createdAt,
+		ApiKeyId:     keyMeta.PublicID,
+		ApiKey:       apiKey,
+		Name:         req.Msg.Name,
+		Description:  req.Msg.Description,
+		Scopes:       scopes,
+		AllowedCidrs: auth.FormatCIDRs(allowedCIDRs),
+		CreatedAt:    createdAt,
 	}), nil
 }
 
@@ -198,13 +232,14 @@ func (s *AccountService) ListApiKeys(
 		}
 
 		apiKeys[i] = &libopsv1.ApiKeyMetadata{
-			ApiKeyId:    key.PublicID,
-			Name:        key.Name,
-			Description: key.Description.String,
-			Scopes:      unmarshalScopes(key.Scopes),
-			Active:      key.Active,
-			CreatedAt:   createdAt,
-			LastUsedAt:  lastUsedAt,
+			ApiKeyId:     key.PublicID,
+			Name:         key.Name,
+			Description:  key.Description.String,
+			Scopes:       unmarshalScopes(key.Scopes),
+			AllowedCidrs: unmarshalScopes(key.AllowedCidrs),
+			Active:       key.Active,
+			CreatedAt:    createdAt,
+			LastUsedAt:   lastUsedAt,
 		}
 	}
 
@@ -219,6 +254,25 @@ func (s *AccountService) ListApiKeys(
 	}), nil
 }
 
+// ListApiKeyTemplates lists the templates API keys can be created from.
+func (s *AccountService) ListApiKeyTemplates(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListApiKeyTemplatesRequest],
+) (*connect.Response[libopsv1.ListApiKeyTemplatesResponse], error) {
+	templates := auth.KeyTemplates()
+	resp := make([]*libopsv1.ApiKeyTemplate, len(templates))
+	for i, template := range templates {
+		resp[i] = &libopsv1.ApiKeyTemplate{
+			TemplateId:  template.ID,
+			Name:        template.Name,
+			Description: template.Description,
+			Scopes:      template.Scopes,
+		}
+	}
+
+	return connect.NewResponse(&libopsv1.ListApiKeyTemplatesResponse{Templates: resp}), nil
+}
+
 // RevokeApiKey revokes an API key for the authenticated user.
 func (s *AccountService) RevokeApiKey(
 	ctx context.Context,
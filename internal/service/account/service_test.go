@@ -80,7 +80,7 @@ func TestGetAccountByEmail(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			svc := NewAccountService(tt.setupMock(), nil)
+			svc := NewAccountService(tt.setupMock(), nil, nil)
 			req := connect.NewRequest(&libopsv1.GetAccountByEmailRequest{Email: tt.email})
 
 			resp, err := svc.GetAccountByEmail(tt.setupContext(), req)
@@ -96,3 +96,22 @@ func TestGetAccountByEmail(t *testing.T) {
 		})
 	}
 }
+
+// TestCreateApiKeyTemplate tests that a template can't be unknown or mixed with scopes.
+func TestCreateApiKeyTemplate(t *testing.T) {
+	svc := NewAccountService(&testutils.MockQuerier{}, nil, nil)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+
+	invalid := []*libopsv1.CreateApiKeyRequest{
+		{Name: "ci", Template: "deploy", Scopes: []string{"site:admin"}},
+		{Name: "ci", Template: "everything"},
+	}
+	for _, req := range invalid {
+		_, err := svc.CreateApiKey(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+
+	templates, err := svc.ListApiKeyTemplates(ctx, connect.NewRequest(&libopsv1.ListApiKeyTemplatesRequest{}))
+	assert.NoError(t, err)
+	assert.Len(t, templates.Msg.Templates, len(auth.KeyTemplates()))
+}
@@ -12,17 +12,20 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/quota"
 )
 
 // Repository contains shared business logic for account operations.
 type Repository struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // NewRepository creates a new account repository.
 func NewRepository(querier db.Querier) *Repository {
 	return &Repository{
-		db: querier,
+		db:     querier,
+		quotas: quota.NewEnforcer(querier),
 	}
 }
 
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/signup"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// SignupService implements the SignupService API.
+type SignupService struct {
+	joiner *signup.Joiner
+}
+
+// Compile-time check.
+var _ libopsv1connect.SignupServiceHandler = (*SignupService)(nil)
+
+// NewSignupService creates a new SignupService instance.
+func NewSignupService(querier db.Querier, auditLogger *audit.Logger) *SignupService {
+	return &SignupService{
+		joiner: signup.NewJoiner(querier, auditLogger),
+	}
+}
+
+// Signup joins the authenticated user to the organizations claiming their email domain.
+func (s *SignupService) Signup(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SignupRequest],
+) (*connect.Response[libopsv1.SignupResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || userInfo == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	result, err := s.joiner.SignUp(ctx, userInfo.AccountID)
+	if err != nil {
+		if errors.Is(err, signup.ErrUnverified) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		slog.Error("Failed to sign up", "account_id", userInfo.AccountID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sign up"))
+	}
+
+	return connect.NewResponse(&libopsv1.SignupResponse{
+		Joined:  signupOrganizationsToProto(result.Joined),
+		Pending: signupOrganizationsToProto(result.Pending),
+	}), nil
+}
+
+func signupOrganizationsToProto(organizations []signup.Organization) []*libopsv1.SignupOrganization {
+	out := make([]*libopsv1.SignupOrganization, 0, len(organizations))
+	for _, o := range organizations {
+		out = append(out, &libopsv1.SignupOrganization{
+			OrganizationId: o.PublicID,
+			Name:           o.Name,
+			Role:           o.Role,
+		})
+	}
+	return out
+}
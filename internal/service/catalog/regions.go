@@ -0,0 +1,88 @@
+// Package catalog serves the provisioning catalog: the GCP regions offered to
+// customers and the machine types available in each.
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+)
+
+// MachineSeries returns the series of a machine type, e.g. "n4" for "n4-standard-2".
+func MachineSeries(machineType string) string {
+	series, _, _ := strings.Cut(machineType, "-")
+	return series
+}
+
+// ValidateRegion checks that a region is offered for new projects and, when
+// country is set, that it belongs to that country grouping.
+func ValidateRegion(ctx context.Context, querier db.Querier, country, regionCode string) error {
+	region, err := querier.GetRegion(ctx, regionCode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region %q is not available", regionCode))
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up region: %w", err))
+	}
+	if country != "" && region.Country != country {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region %q is not in %q", regionCode, country))
+	}
+	return nil
+}
+
+// CheckMachineTypeAvailable checks that a machine type can be provisioned in a region.
+func CheckMachineTypeAvailable(ctx context.Context, querier db.Querier, regionCode, machineType string) error {
+	offered, err := querier.RegionOffersMachineSeries(ctx, db.RegionOffersMachineSeriesParams{
+		Code:   regionCode,
+		Series: MachineSeries(machineType),
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check machine type availability: %w", err))
+	}
+	if !offered {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("machine type %q is not available in %s", machineType, regionCode))
+	}
+	return nil
+}
+
+// CheckResidency checks that a region is inside an organization's data
+// residency. A residency names a country grouping of the catalog ("eu" or
+// "us") and allows only its regions.
+func CheckResidency(ctx context.Context, querier db.Querier, organizationID int64, regionCode string) error {
+	residency, err := querier.GetOrganizationDataResidency(ctx, organizationID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up data residency: %w", err))
+	}
+	if !residency.Valid {
+		return nil
+	}
+	region, err := querier.GetRegion(ctx, regionCode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region %q is not available", regionCode))
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up region: %w", err))
+	}
+	if !ResidencyAllows(residency, region.Country) {
+		return ResidencyError(residency, regionCode)
+	}
+	return nil
+}
+
+// ResidencyAllows reports whether a data residency allows regions of a
+// country grouping; no residency allows all of them.
+func ResidencyAllows(residency db.NullOrganizationsDataResidency, country string) bool {
+	return !residency.Valid || string(residency.OrganizationsDataResidency) == country
+}
+
+// ResidencyError is the error for a region outside a data residency
+func ResidencyError(residency db.NullOrganizationsDataResidency, regionCode string) error {
+	return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region %q is outside the organization's %s data residency",
+		regionCode, strings.ToUpper(string(residency.OrganizationsDataResidency))))
+}
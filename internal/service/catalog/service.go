@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// CatalogService implements the LibOps CatalogService API.
+type CatalogService struct {
+	db db.Querier
+}
+
+// Compile-time check to ensure CatalogService implements the interface.
+var _ libopsv1connect.CatalogServiceHandler = (*CatalogService)(nil)
+
+// NewCatalogService creates a new CatalogService instance.
+func NewCatalogService(querier db.Querier) *CatalogService {
+	return &CatalogService{
+		db: querier,
+	}
+}
+
+// ListRegions lists the regions offered for new projects grouped by country,
+// in catalog order, with the machine types each one can provision.
+func (s *CatalogService) ListRegions(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListRegionsRequest],
+) (*connect.Response[libopsv1.ListRegionsResponse], error) {
+	var residency db.NullOrganizationsDataResidency
+	if req.Msg.OrganizationId != "" {
+		var err error
+		if residency, err = s.organizationResidency(ctx, req.Msg.OrganizationId); err != nil {
+			return nil, err
+		}
+	}
+
+	regions, err := s.db.ListRegions(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list regions: %w", err))
+	}
+
+	offered, err := s.db.ListRegionMachineSeries(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list region machine series: %w", err))
+	}
+	seriesByRegion := make(map[string]map[string]bool)
+	for _, row := range offered {
+		if seriesByRegion[row.RegionCode] == nil {
+			seriesByRegion[row.RegionCode] = make(map[string]bool)
+		}
+		seriesByRegion[row.RegionCode][row.Series] = true
+	}
+
+	machineTypes, err := s.db.ListMachineTypes(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list machine types: %w", err))
+	}
+	if req.Msg.MachineType != "" && !hasMachineType(machineTypes, req.Msg.MachineType) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown machine type %q", req.Msg.MachineType))
+	}
+
+	var countries []*libopsv1.RegionCountry
+	byCode := make(map[string]*libopsv1.RegionCountry)
+	for _, region := range regions {
+		if req.Msg.Country != "" && region.Country != req.Msg.Country {
+			continue
+		}
+		if !ResidencyAllows(residency, region.Country) {
+			continue
+		}
+
+		series := seriesByRegion[region.Code]
+		if req.Msg.MachineType != "" && !series[MachineSeries(req.Msg.MachineType)] {
+			continue
+		}
+
+		var available []*libopsv1.RegionMachineType
+		for _, mt := range machineTypes {
+			if series[MachineSeries(mt.MachineType)] {
+				available = append(available, &libopsv1.RegionMachineType{
+					MachineType:       mt.MachineType,
+					DisplayName:       mt.DisplayName,
+					Vcpu:              mt.Vcpu,
+					MemoryGib:         mt.MemoryGib,
+					MonthlyPriceCents: mt.MonthlyPriceCents,
+				})
+			}
+		}
+
+		country, ok := byCode[region.Country]
+		if !ok {
+			country = &libopsv1.RegionCountry{
+				Code:        region.Country,
+				DisplayName: region.CountryDisplayName,
+			}
+			byCode[region.Country] = country
+			countries = append(countries, country)
+		}
+		country.Regions = append(country.Regions, &libopsv1.Region{
+			Code:          region.Code,
+			DisplayName:   region.DisplayName,
+			Country:       region.Country,
+			Latitude:      region.Latitude,
+			Longitude:     region.Longitude,
+			RelativePrice: region.RelativePrice,
+			MachineTypes:  available,
+		})
+	}
+
+	return connect.NewResponse(&libopsv1.ListRegionsResponse{
+		Countries: countries,
+	}), nil
+}
+
+// organizationResidency looks up the data residency of an organization the
+// caller can read
+func (s *CatalogService) organizationResidency(ctx context.Context, organizationID string) (db.NullOrganizationsDataResidency, error) {
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if err := auth.NewAuthorizer(s.db).CheckOrganizationAccess(ctx, userInfo, publicID, auth.PermissionRead); err != nil {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	organization, err := s.db.GetOrganization(ctx, publicID.String())
+	if err != nil {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+	}
+	residency, err := s.db.GetOrganizationDataResidency(ctx, organization.ID)
+	if err != nil {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get data residency: %w", err))
+	}
+	return residency, nil
+}
+
+func hasMachineType(machineTypes []db.MachineType, machineType string) bool {
+	for _, mt := range machineTypes {
+		if mt.MachineType == machineType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func catalogMock() *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		ListRegionsFunc: func(ctx context.Context) ([]db.Region, error) {
+			return []db.Region{
+				{Code: "us-central1", DisplayName: "Iowa", Country: "us", CountryDisplayName: "United States", RelativePrice: 1},
+				{Code: "us-west3", DisplayName: "Salt Lake City", Country: "us", CountryDisplayName: "United States", RelativePrice: 1.2},
+				{Code: "europe-west1", DisplayName: "Belgium", Country: "eu", CountryDisplayName: "Europe", RelativePrice: 1.1},
+			}, nil
+		},
+		ListRegionMachineSeriesFunc: func(ctx context.Context) ([]db.ListRegionMachineSeriesRow, error) {
+			return []db.ListRegionMachineSeriesRow{
+				{RegionCode: "europe-west1", Series: "e2"},
+				{RegionCode: "europe-west1", Series: "n4"},
+				{RegionCode: "us-central1", Series: "e2"},
+				{RegionCode: "us-central1", Series: "n4"},
+				{RegionCode: "us-west3", Series: "e2"},
+			}, nil
+		},
+		ListMachineTypesFunc: func(ctx context.Context) ([]db.MachineType, error) {
+			return []db.MachineType{
+				{MachineType: "e2-medium", DisplayName: "Small", Vcpu: 1, MemoryGib: 4, MonthlyPriceCents: 12500},
+				{MachineType: "n4-standard-2", DisplayName: "Medium", Vcpu: 2, MemoryGib: 8, MonthlyPriceCents: 25000},
+			}, nil
+		},
+	}
+}
+
+// TestListRegions tests grouping, filtering and machine type availability.
+func TestListRegions(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *libopsv1.ListRegionsRequest
+		wantCode  connect.Code
+		want      map[string][]string // country -> region codes
+		wantTypes map[string]int      // region -> available machine types
+	}{
+		{
+			name:      "groups all regions by country in catalog order",
+			req:       &libopsv1.ListRegionsRequest{},
+			want:      map[string][]string{"us": {"us-central1", "us-west3"}, "eu": {"europe-west1"}},
+			wantTypes: map[string]int{"us-central1": 2, "us-west3": 1, "europe-west1": 2},
+		},
+		{
+			name: "filters by country",
+			req:  &libopsv1.ListRegionsRequest{Country: "eu"},
+			want: map[string][]string{"eu": {"europe-west1"}},
+		},
+		{
+			name: "filters by machine type availability",
+			req:  &libopsv1.ListRegionsRequest{Country: "us", MachineType: "n4-standard-2"},
+			want: map[string][]string{"us": {"us-central1"}},
+		},
+		{
+			name:     "rejects unknown machine type",
+			req:      &libopsv1.ListRegionsRequest{MachineType: "m3-ultramem-32"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewCatalogService(catalogMock())
+			resp, err := svc.ListRegions(context.Background(), connect.NewRequest(tt.req))
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+
+			got := map[string][]string{}
+			types := map[string]int{}
+			for _, country := range resp.Msg.Countries {
+				for _, region := range country.Regions {
+					got[country.Code] = append(got[country.Code], region.Code)
+					types[region.Code] = len(region.MachineTypes)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+			for region, n := range tt.wantTypes {
+				assert.Equal(t, n, types[region], region)
+			}
+		})
+	}
+}
+
+// TestMachineSeries tests extracting the series from a machine type.
+func TestMachineSeries(t *testing.T) {
+	assert.Equal(t, "n4", MachineSeries("n4-standard-2"))
+	assert.Equal(t, "e2", MachineSeries("e2-medium"))
+	assert.Equal(t, "custom", MachineSeries("custom"))
+}
+
+// TestValidateRegion tests catalog lookups for onboarding and project creation.
+func TestValidateRegion(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetRegionFunc: func(ctx context.Context, code string) (db.Region, error) {
+			if code == "us-central1" {
+				return db.Region{Code: code, Country: "us"}, nil
+			}
+			return db.Region{}, sql.ErrNoRows
+		},
+	}
+
+	assert.NoError(t, ValidateRegion(context.Background(), mock, "", "us-central1"))
+	assert.NoError(t, ValidateRegion(context.Background(), mock, "us", "us-central1"))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(ValidateRegion(context.Background(), mock, "eu", "us-central1")))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(ValidateRegion(context.Background(), mock, "", "mars-north1")))
+}
+
+// TestListRegions_DataResidency tests that an organization's data residency
+// limits the regions listed for it.
+func TestListRegions_DataResidency(t *testing.T) {
+	const orgID = "0b9f3e1c-2d4a-4f6b-8c7d-9e0f1a2b3c4d"
+	mock := catalogMock()
+	mock.GetOrganizationFunc = func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+		return db.GetOrganizationRow{ID: 7, PublicID: publicID}, nil
+	}
+	mock.GetOrganizationMemberFunc = func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+		if arg.AccountID == 5 {
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		}
+		return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+	}
+	mock.GetOrganizationDataResidencyFunc = func(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error) {
+		return db.NullOrganizationsDataResidency{OrganizationsDataResidency: db.OrganizationsDataResidencyEu, Valid: true}, nil
+	}
+	svc := NewCatalogService(mock)
+
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 5})
+	resp, err := svc.ListRegions(ctx, connect.NewRequest(&libopsv1.ListRegionsRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Countries, 1)
+	assert.Equal(t, "eu", resp.Msg.Countries[0].Code)
+
+	outsider := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 6})
+	_, err = svc.ListRegions(outsider, connect.NewRequest(&libopsv1.ListRegionsRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = svc.ListRegions(ctx, connect.NewRequest(&libopsv1.ListRegionsRequest{OrganizationId: "not-a-uuid"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestCheckResidency tests which regions an organization's data residency allows.
+func TestCheckResidency(t *testing.T) {
+	residencies := map[int64]db.NullOrganizationsDataResidency{
+		1: {},
+		2: {OrganizationsDataResidency: db.OrganizationsDataResidencyEu, Valid: true},
+		3: {OrganizationsDataResidency: db.OrganizationsDataResidencyUs, Valid: true},
+	}
+	mock := &testutils.MockQuerier{
+		GetOrganizationDataResidencyFunc: func(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error) {
+			return residencies[id], nil
+		},
+		GetRegionFunc: func(ctx context.Context, code string) (db.Region, error) {
+			switch code {
+			case "us-central1":
+				return db.Region{Code: code, Country: "us"}, nil
+			case "europe-west1":
+				return db.Region{Code: code, Country: "eu"}, nil
+			}
+			return db.Region{}, sql.ErrNoRows
+		},
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, CheckResidency(ctx, mock, 1, "us-central1"))
+	assert.NoError(t, CheckResidency(ctx, mock, 2, "europe-west1"))
+	assert.NoError(t, CheckResidency(ctx, mock, 3, "us-central1"))
+
+	err := CheckResidency(ctx, mock, 2, "us-central1")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Contains(t, err.Error(), "EU data residency")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(CheckResidency(ctx, mock, 3, "europe-west1")))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(CheckResidency(ctx, mock, 2, "mars-north1")))
+}
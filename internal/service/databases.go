@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/libops/api/db"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// DatabaseSecretNames are the managed site secrets holding a database's
+// credentials.
+type DatabaseSecretNames struct {
+	User     string
+	Password string
+	Host     string
+	Port     string
+}
+
+// SiteDatabaseSecretNames returns the names of the managed site secrets
+// holding the credentials of the database called name.
+func SiteDatabaseSecretNames(name string) DatabaseSecretNames {
+	prefix := "DB_" + strings.ToUpper(name) + "_"
+	return DatabaseSecretNames{
+		User:     prefix + "USER",
+		Password: prefix + "PASSWORD",
+		Host:     prefix + "HOST",
+		Port:     prefix + "PORT",
+	}
+}
+
+// SiteDatabaseToProto converts a site database row to proto.
+func SiteDatabaseToProto(sitePublicID string, row db.GetSiteDatabaseRow) *libopsv1.SiteDatabase {
+	secrets := SiteDatabaseSecretNames(row.Name)
+	database := &libopsv1.SiteDatabase{
+		DatabaseId:         row.PublicID,
+		SiteId:             sitePublicID,
+		Name:               row.Name,
+		StatusMessage:      FromNullString(row.StatusMessage),
+		Host:               FromNullString(row.Host),
+		Port:               row.Port,
+		UserSecretName:     secrets.User,
+		PasswordSecretName: secrets.Password,
+		HostSecretName:     secrets.Host,
+		PortSecretName:     secrets.Port,
+	}
+	switch row.Tier {
+	case db.SiteDatabasesTierColocated:
+		database.Tier = libopsv1.DatabaseTier_DATABASE_TIER_COLOCATED
+	case db.SiteDatabasesTierCloudSql:
+		database.Tier = libopsv1.DatabaseTier_DATABASE_TIER_CLOUD_SQL
+	}
+	switch row.Status {
+	case db.SiteDatabasesStatusProvisioning:
+		database.Status = libopsv1.DatabaseStatus_DATABASE_STATUS_PROVISIONING
+	case db.SiteDatabasesStatusActive:
+		database.Status = libopsv1.DatabaseStatus_DATABASE_STATUS_ACTIVE
+	case db.SiteDatabasesStatusFailed:
+		database.Status = libopsv1.DatabaseStatus_DATABASE_STATUS_FAILED
+	}
+	if row.PasswordRotatedAt.Valid {
+		database.PasswordRotatedAt = row.PasswordRotatedAt.Time.Unix()
+	}
+	if row.CreatedAt.Valid {
+		database.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return database
+}
+
+// ActivateSiteDatabase records where a site reaches a database that was just
+// provisioned, writing the address to the database's host and port secrets.
+// It reports whether the database wasn't active or has moved, in which case
+// the site needs its secrets again.
+func ActivateSiteDatabase(ctx context.Context, querier db.Querier, store SecretStore, sitePublicID string, row db.GetSiteDatabaseRow, host string, port int32) (bool, error) {
+	if row.Status == db.SiteDatabasesStatusActive && FromNullString(row.Host) == host && row.Port == port {
+		return false, nil
+	}
+
+	secrets := SiteDatabaseSecretNames(row.Name)
+	if err := WriteManagedSiteSecret(ctx, querier, store, sitePublicID, row.SiteID, secrets.Host, host); err != nil {
+		return false, err
+	}
+	if err := WriteManagedSiteSecret(ctx, querier, store, sitePublicID, row.SiteID, secrets.Port, strconv.Itoa(int(port))); err != nil {
+		return false, err
+	}
+	err := querier.ActivateSiteDatabase(ctx, db.ActivateSiteDatabaseParams{
+		Host: sql.NullString{String: host, Valid: true},
+		Port: port,
+		ID:   row.ID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+	return true, nil
+}
@@ -81,3 +81,19 @@ func UnauthorizedError() error {
 func PermissionDeniedError() error {
 	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied"))
 }
+
+// ExpectRow turns an update that matched no rows into sql.ErrNoRows, for
+// updates guarded by the row's current state.
+func ExpectRow(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/internal/database"
+)
+
+// HeaderIfMatch carries the etag an update expects, for REST clients that
+// don't send it in the resource itself.
+const HeaderIfMatch = "If-Match"
+
+// HeaderETag carries a resource's current etag on Get and Update responses.
+const HeaderETag = "ETag"
+
+// ETag formats a resource's version as the etag clients send back on update.
+func ETag(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
+
+// ETagContext returns a context for reading the row whose version a response
+// carries as its etag. It's read from the primary, bypassing the cache, so a
+// client that fetches a resource again after a precondition failure gets the
+// version its retry has to match.
+func ETagContext(ctx context.Context) context.Context {
+	return database.WithPrimary(ctx)
+}
+
+// SetETag sets the ETag response header to a resource's version.
+func SetETag(header http.Header, version int64) {
+	header.Set(HeaderETag, strconv.Quote(ETag(version)))
+}
+
+// CheckETag enforces an update's precondition against the version the resource
+// was read at. The expected etag is the one in the request's resource, or else
+// its If-Match header; an update with neither is unconditional.
+func CheckETag(header http.Header, etag string, version int64, resource string) error {
+	current := ETag(version)
+	if etag != "" {
+		if etag != current {
+			return ETagMismatchError(resource)
+		}
+		return nil
+	}
+
+	ifMatch := header.Get(HeaderIfMatch)
+	if ifMatch == "" {
+		return nil
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return nil
+		}
+		if strings.Trim(strings.TrimPrefix(tag, "W/"), `"`) == current {
+			return nil
+		}
+	}
+	return ETagMismatchError(resource)
+}
+
+// ETagMismatchError reports that a resource changed after the caller read it.
+func ETagMismatchError(resource string) error {
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("the %s was changed since it was read; fetch it again and reapply your changes", resource))
+}
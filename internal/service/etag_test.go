@@ -0,0 +1,52 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckETag tests update preconditions from the resource's etag and from If-Match.
+func TestCheckETag(t *testing.T) {
+	tests := []struct {
+		name    string
+		etag    string
+		ifMatch string
+		wantErr bool
+	}{
+		{name: "unconditional"},
+		{name: "matching etag", etag: "3"},
+		{name: "stale etag", etag: "2", wantErr: true},
+		{name: "etag wins over If-Match", etag: "3", ifMatch: `"2"`},
+		{name: "quoted If-Match", ifMatch: `"3"`},
+		{name: "weak If-Match", ifMatch: `W/"3"`},
+		{name: "If-Match list", ifMatch: `"1", "3"`},
+		{name: "If-Match any", ifMatch: "*"},
+		{name: "stale If-Match", ifMatch: `"2"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.ifMatch != "" {
+				header.Set(HeaderIfMatch, tt.ifMatch)
+			}
+
+			err := CheckETag(header, tt.etag, 3, "site")
+			if tt.wantErr {
+				assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestSetETag tests that the ETag header is quoted.
+func TestSetETag(t *testing.T) {
+	header := http.Header{}
+	SetETag(header, 7)
+	assert.Equal(t, `"7"`, header.Get(HeaderETag))
+}
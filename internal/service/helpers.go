@@ -4,11 +4,14 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -17,6 +20,9 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 )
 
@@ -48,7 +54,8 @@ func CheckRelationshipAccess(ctx context.Context, querier db.Querier, accountID
 				if !checkWrite {
 					return nil
 				}
-				if sourceMember.Role == db.OrganizationMembersRoleOwner || sourceMember.Role == db.OrganizationMembersRoleDeveloper {
+				role := auth.RelationshipRole(sourceMember.Role, rel.MaxRole)
+				if role == db.OrganizationMembersRoleOwner || role == db.OrganizationMembersRoleDeveloper {
 					return nil
 				}
 			}
@@ -183,6 +190,31 @@ func IsValidMemberRole(role string) bool {
 	return role == "owner" || role == "developer" || role == "read"
 }
 
+// MemberUpdatePaths are the fields an organization, project or site member
+// update can change.
+var MemberUpdatePaths = []string{"role", "expires_at"}
+
+// ParseExpiry converts a request's expires_at Unix timestamp to its column value.
+// 0 means access doesn't expire; any other time must be in the future.
+func ParseExpiry(expiresAt int64) (sql.NullTime, error) {
+	if expiresAt == 0 {
+		return sql.NullTime{}, nil
+	}
+	t := time.Unix(expiresAt, 0)
+	if !t.After(time.Now()) {
+		return sql.NullTime{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at must be in the future"))
+	}
+	return sql.NullTime{Time: t, Valid: true}, nil
+}
+
+// ExpiryToProto converts an expires_at column to a Unix timestamp, 0 when access doesn't expire.
+func ExpiryToProto(expiresAt sql.NullTime) int64 {
+	if !expiresAt.Valid {
+		return 0
+	}
+	return expiresAt.Time.Unix()
+}
+
 // SQL helpers to convert between nullable types.
 // ToNullString converts a string to a sql.NullString, setting Valid to false if the string is empty.
 func ToNullString(s string) sql.NullString {
@@ -245,6 +277,14 @@ func FromNullStringPtr(ns sql.NullString) *string {
 	return nil
 }
 
+// Convert sql.NullInt32 to optional proto field (*int32).
+func FromNullInt32Ptr(ni sql.NullInt32) *int32 {
+	if ni.Valid {
+		return &ni.Int32
+	}
+	return nil
+}
+
 // Convert proto optional field (*string) to string for ToNullString.
 func PtrToString(ptr *string) string {
 	if ptr != nil {
@@ -281,6 +321,58 @@ func FromJSONStringArray(raw types.RawJSON) []string {
 	return res
 }
 
+// LabelsToJSON converts a labels map to types.RawJSON for storage.
+// It returns nil (SQL NULL) when there are no labels.
+func LabelsToJSON(labels map[string]string) types.RawJSON {
+	if len(labels) == 0 {
+		return nil
+	}
+	return ToJSON(labels)
+}
+
+// FromJSONLabels converts stored labels back to a map.
+// It returns an empty map if input is nil or unmarshalling fails.
+func FromJSONLabels(raw types.RawJSON) map[string]string {
+	labels := map[string]string{}
+	if raw == nil {
+		return labels
+	}
+	if err := json.Unmarshal(raw, &labels); err != nil {
+		slog.Error("failed to unmarshal JSON to labels", "error", err)
+		return map[string]string{}
+	}
+	return labels
+}
+
+// ParseLabelSelector parses a selector such as "env=prod,department=library"
+// into the JSON object matched against stored labels with JSON_CONTAINS.
+// An empty selector returns an invalid NullString so no filter is applied.
+func ParseLabelSelector(selector *string) (sql.NullString, error) {
+	if selector == nil || strings.TrimSpace(*selector) == "" {
+		return sql.NullString{}, nil
+	}
+
+	labels := make(map[string]string)
+	for _, term := range strings.Split(*selector, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(term), "=")
+		if !found {
+			return sql.NullString{}, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("invalid label_selector term %q (expected key=value)", term))
+		}
+		labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	if err := validation.Labels(labels); err != nil {
+		return sql.NullString{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	b, err := json.Marshal(labels)
+	if err != nil {
+		return sql.NullString{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode label_selector"))
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
 // ==============================================================================
 // UUID Parsing Helper
 // ==============================================================================
@@ -345,6 +437,75 @@ func MakePaginationResult(resultCount int, params PaginationParams) PaginationRe
 	return PaginationResult{NextPageToken: ""}
 }
 
+// CursorPagination holds validated keyset pagination parameters for the
+// ListUser* queries. Those list newest first by created_at, then id descending,
+// and a page picks up strictly after the (CreatedAt, ID) of the previous page's
+// last row, so rows created or deleted between requests never shift a page or
+// repeat one. An empty cursor starts from the newest row.
+type CursorPagination struct {
+	Limit     int32
+	CreatedAt sql.NullTime
+	ID        sql.NullInt64
+}
+
+// ParseCursorPagination validates and normalizes keyset pagination parameters
+// from API requests, with the same page size defaults and limits as
+// ParsePagination.
+func ParseCursorPagination(pageSize int32, pageToken string) (CursorPagination, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	params := CursorPagination{Limit: pageSize}
+	if pageToken == "" {
+		return params, nil
+	}
+
+	invalid := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return CursorPagination{}, invalid
+	}
+	nanos, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return CursorPagination{}, invalid
+	}
+	createdAt, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return CursorPagination{}, invalid
+	}
+	rowID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || rowID <= 0 {
+		return CursorPagination{}, invalid
+	}
+
+	params.CreatedAt = sql.NullTime{Time: time.Unix(0, createdAt).UTC(), Valid: true}
+	params.ID = sql.NullInt64{Int64: rowID, Valid: true}
+	return params, nil
+}
+
+// GenerateCursorPageToken encodes the created_at and id of a page's last row as
+// the token for the page after it.
+func GenerateCursorPageToken(createdAt sql.NullTime, id int64) string {
+	var nanos int64
+	if createdAt.Valid {
+		nanos = createdAt.Time.UnixNano()
+	}
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d.%d", nanos, id))
+}
+
+// MakeCursorPaginationResult generates a pagination result from the last row
+// of a page. Like MakePaginationResult, a full page means there may be more.
+func MakeCursorPaginationResult(resultCount int, params CursorPagination, lastCreatedAt sql.NullTime, lastID int64) PaginationResult {
+	if resultCount > 0 && resultCount == int(params.Limit) {
+		return PaginationResult{NextPageToken: GenerateCursorPageToken(lastCreatedAt, lastID)}
+	}
+	return PaginationResult{NextPageToken: ""}
+}
+
 // ==============================================================================
 // Entity Lookup Helpers
 // ==============================================================================
@@ -404,6 +565,44 @@ func ShouldUpdateField(mask *fieldmaskpb.FieldMask, fieldPath string) bool {
 	return false
 }
 
+// UpdateMask is the set of fields an update applies, from a validated field mask.
+type UpdateMask map[string]bool
+
+// NewUpdateMask validates an update's field mask against the paths the update
+// can change. An empty mask or "*" selects every one of them, and a path
+// naming a message, or ending in ".*", selects those under it. Any other path
+// is rejected rather than ignored, so a misspelled field fails the update
+// instead of silently leaving the field as it was.
+func NewUpdateMask(mask *fieldmaskpb.FieldMask, updatable ...string) (UpdateMask, error) {
+	selected := make(UpdateMask, len(updatable))
+	if len(mask.GetPaths()) == 0 {
+		for _, field := range updatable {
+			selected[field] = true
+		}
+		return selected, nil
+	}
+
+	for _, path := range mask.GetPaths() {
+		prefix := strings.TrimSuffix(path, ".*")
+		matched := false
+		for _, field := range updatable {
+			if path == "*" || field == prefix || strings.HasPrefix(field, prefix+".") {
+				selected[field] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid update_mask path: %q", path))
+		}
+	}
+	return selected, nil
+}
+
+// Has reports whether the update applies the field at path.
+func (m UpdateMask) Has(path string) bool {
+	return m[path]
+}
+
 // ==============================================================================
 // Additional Entity Lookup Helpers
 // ==============================================================================
@@ -515,6 +714,33 @@ func DbSiteStatusToProto(status db.NullSitesStatus) commonv1.Status {
 	return DbStatusToProto(string(status.SitesStatus))
 }
 
+// DbDataResidencyToProto converts NullOrganizationsDataResidency to proto DataResidency.
+func DbDataResidencyToProto(residency db.NullOrganizationsDataResidency) commonv1.DataResidency {
+	if !residency.Valid {
+		return commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED
+	}
+	switch residency.OrganizationsDataResidency {
+	case db.OrganizationsDataResidencyEu:
+		return commonv1.DataResidency_DATA_RESIDENCY_EU
+	case db.OrganizationsDataResidencyUs:
+		return commonv1.DataResidency_DATA_RESIDENCY_US
+	default:
+		return commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED
+	}
+}
+
+// ProtoDataResidencyToDb converts proto DataResidency to NullOrganizationsDataResidency.
+func ProtoDataResidencyToDb(residency commonv1.DataResidency) db.NullOrganizationsDataResidency {
+	switch residency {
+	case commonv1.DataResidency_DATA_RESIDENCY_EU:
+		return db.NullOrganizationsDataResidency{OrganizationsDataResidency: db.OrganizationsDataResidencyEu, Valid: true}
+	case commonv1.DataResidency_DATA_RESIDENCY_US:
+		return db.NullOrganizationsDataResidency{OrganizationsDataResidency: db.OrganizationsDataResidencyUs, Valid: true}
+	default:
+		return db.NullOrganizationsDataResidency{}
+	}
+}
+
 // DbPromoteStrategyToProto converts NullProjectsPromoteStrategy to proto PromoteStrategy.
 func DbPromoteStrategyToProto(strategy db.NullProjectsPromoteStrategy) commonv1.PromoteStrategy {
 	if !strategy.Valid {
@@ -631,3 +857,165 @@ func DbSiteMemberStatusToProto(status db.NullSiteMembersStatus) commonv1.Status
 	}
 	return DbStatusToProto(string(status.SiteMembersStatus))
 }
+
+// ProtoPrivateServiceConnectTargetToDB converts a proto endpoint target to the
+// database enum, reporting false for an unspecified target.
+func ProtoPrivateServiceConnectTargetToDB(target libopsv1.PrivateServiceConnectTarget) (db.PrivateServiceConnectEndpointsTarget, bool) {
+	switch target {
+	case libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API:
+		return db.PrivateServiceConnectEndpointsTargetApi, true
+	case libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR:
+		return db.PrivateServiceConnectEndpointsTargetOrchestrator, true
+	case libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY:
+		return db.PrivateServiceConnectEndpointsTargetSmtpRelay, true
+	default:
+		return "", false
+	}
+}
+
+// DbPrivateServiceConnectTargetToProto converts a database endpoint target to proto.
+func DbPrivateServiceConnectTargetToProto(target db.PrivateServiceConnectEndpointsTarget) libopsv1.PrivateServiceConnectTarget {
+	switch target {
+	case db.PrivateServiceConnectEndpointsTargetApi:
+		return libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API
+	case db.PrivateServiceConnectEndpointsTargetOrchestrator:
+		return libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR
+	case db.PrivateServiceConnectEndpointsTargetSmtpRelay:
+		return libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY
+	default:
+		return libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED
+	}
+}
+
+// PrivateServiceConnectEndpointToProto converts an endpoint row to proto.
+func PrivateServiceConnectEndpointToProto(row db.GetPrivateServiceConnectEndpointRow, organizationPublicID string) *libopsv1.PrivateServiceConnectEndpoint {
+	endpoint := &libopsv1.PrivateServiceConnectEndpoint{
+		EndpointId:         row.PublicID,
+		OrganizationId:     organizationPublicID,
+		Target:             DbPrivateServiceConnectTargetToProto(row.Target),
+		RequestedIpAddress: FromNullString(row.RequestedIpAddress),
+		IpAddress:          FromNullString(row.IpAddress),
+		ForwardingRule:     FromNullString(row.ForwardingRule),
+	}
+	switch row.Status {
+	case db.PrivateServiceConnectEndpointsStatusProvisioning:
+		endpoint.Status = libopsv1.PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING
+	case db.PrivateServiceConnectEndpointsStatusActive:
+		endpoint.Status = libopsv1.PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE
+	case db.PrivateServiceConnectEndpointsStatusDeleting:
+		endpoint.Status = libopsv1.PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING
+	}
+	if row.CreatedAt.Valid {
+		endpoint.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.UpdatedAt.Valid {
+		endpoint.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return endpoint
+}
+
+// StaticEgressIpToProto converts a site's static egress IP row to proto.
+func StaticEgressIpToProto(row db.GetSiteStaticEgressIpRow) *commonv1.StaticEgressIp {
+	egress := &commonv1.StaticEgressIp{
+		IpAddress: FromNullString(row.IpAddress),
+	}
+	switch row.Status {
+	case db.SiteStaticEgressIpsStatusProvisioning:
+		egress.Status = commonv1.StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_PROVISIONING
+	case db.SiteStaticEgressIpsStatusActive:
+		egress.Status = commonv1.StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_ACTIVE
+	case db.SiteStaticEgressIpsStatusReleasing:
+		egress.Status = commonv1.StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_RELEASING
+	}
+	if row.CreatedAt.Valid {
+		egress.RequestedAt = row.CreatedAt.Time.Unix()
+	}
+	return egress
+}
+
+// SiteHealthToProto converts a site's computed health score to proto.
+func SiteHealthToProto(row db.SiteHealth) *commonv1.SiteHealth {
+	health := &commonv1.SiteHealth{
+		Score:           row.Score,
+		CheckinScore:    FromNullInt32Ptr(row.CheckinScore),
+		ContainerScore:  FromNullInt32Ptr(row.ContainerScore),
+		ProbeScore:      FromNullInt32Ptr(row.ProbeScore),
+		DeploymentScore: FromNullInt32Ptr(row.DeploymentScore),
+		ComputedAt:      row.ComputedAt.Unix(),
+	}
+	if row.DegradedSince.Valid {
+		health.DegradedSince = row.DegradedSince.Time.Unix()
+	}
+	return health
+}
+
+// ProtoCdnCacheModeToDB converts a proto CDN cache mode to its database value.
+func ProtoCdnCacheModeToDB(mode commonv1.CdnCacheMode) (db.SiteCdnConfigsCacheMode, bool) {
+	switch mode {
+	case commonv1.CdnCacheMode_CDN_CACHE_MODE_CACHE_ALL_STATIC:
+		return db.SiteCdnConfigsCacheModeCacheAllStatic, true
+	case commonv1.CdnCacheMode_CDN_CACHE_MODE_USE_ORIGIN_HEADERS:
+		return db.SiteCdnConfigsCacheModeUseOriginHeaders, true
+	case commonv1.CdnCacheMode_CDN_CACHE_MODE_FORCE_CACHE_ALL:
+		return db.SiteCdnConfigsCacheModeForceCacheAll, true
+	default:
+		return "", false
+	}
+}
+
+// DbCdnCacheModeToProto converts a database CDN cache mode to proto.
+func DbCdnCacheModeToProto(mode db.SiteCdnConfigsCacheMode) commonv1.CdnCacheMode {
+	switch mode {
+	case db.SiteCdnConfigsCacheModeCacheAllStatic:
+		return commonv1.CdnCacheMode_CDN_CACHE_MODE_CACHE_ALL_STATIC
+	case db.SiteCdnConfigsCacheModeUseOriginHeaders:
+		return commonv1.CdnCacheMode_CDN_CACHE_MODE_USE_ORIGIN_HEADERS
+	case db.SiteCdnConfigsCacheModeForceCacheAll:
+		return commonv1.CdnCacheMode_CDN_CACHE_MODE_FORCE_CACHE_ALL
+	default:
+		return commonv1.CdnCacheMode_CDN_CACHE_MODE_UNSPECIFIED
+	}
+}
+
+// SiteCdnToProto converts a site's CDN config row to proto.
+func SiteCdnToProto(row db.GetSiteCdnConfigRow, pendingPurges int) *commonv1.SiteCdn {
+	cdn := &commonv1.SiteCdn{
+		CacheMode:         DbCdnCacheModeToProto(row.CacheMode),
+		DefaultTtlSeconds: row.DefaultTtlSeconds,
+		IpAddress:         FromNullString(row.IpAddress),
+		PendingPurges:     int32(pendingPurges),
+	}
+	switch row.Status {
+	case db.SiteCdnConfigsStatusProvisioning:
+		cdn.Status = commonv1.SiteCdnStatus_SITE_CDN_STATUS_PROVISIONING
+	case db.SiteCdnConfigsStatusActive:
+		cdn.Status = commonv1.SiteCdnStatus_SITE_CDN_STATUS_ACTIVE
+	case db.SiteCdnConfigsStatusDisabling:
+		cdn.Status = commonv1.SiteCdnStatus_SITE_CDN_STATUS_DISABLING
+	}
+	if row.CreatedAt.Valid {
+		cdn.EnabledAt = row.CreatedAt.Time.Unix()
+	}
+	return cdn
+}
+
+// CachePurgeToProto converts a cache purge row to proto.
+func CachePurgeToProto(row db.GetSiteCachePurgeRow) *libopsv1.CachePurge {
+	purge := &libopsv1.CachePurge{
+		PurgeId: row.PublicID,
+		Path:    row.Path,
+	}
+	switch row.Status {
+	case db.SiteCachePurgesStatusPending:
+		purge.Status = libopsv1.CachePurgeStatus_CACHE_PURGE_STATUS_PENDING
+	case db.SiteCachePurgesStatusCompleted:
+		purge.Status = libopsv1.CachePurgeStatus_CACHE_PURGE_STATUS_COMPLETED
+	}
+	if row.CreatedAt.Valid {
+		purge.RequestedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.CompletedAt.Valid {
+		purge.CompletedAt = row.CompletedAt.Time.Unix()
+	}
+	return purge
+}
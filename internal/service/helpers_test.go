@@ -1,12 +1,19 @@
 package service
 
 import (
+	"context"
 	"database/sql"
+	"slices"
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
 )
 
 // TestPointerHelpers tests the pointer conversion helper functions.
@@ -302,6 +309,52 @@ func TestNullStringConversions(t *testing.T) {
 	})
 }
 
+// TestLabelConversions tests label storage conversions and selector parsing.
+func TestLabelConversions(t *testing.T) {
+	t.Run("LabelsToJSON with no labels returns nil", func(t *testing.T) {
+		assert.Nil(t, LabelsToJSON(nil))
+		assert.Nil(t, LabelsToJSON(map[string]string{}))
+	})
+
+	t.Run("labels round trip", func(t *testing.T) {
+		labels := map[string]string{"env": "prod", "department": "library"}
+
+		result := FromJSONLabels(LabelsToJSON(labels))
+
+		assert.Equal(t, labels, result)
+	})
+
+	t.Run("FromJSONLabels with nil returns empty map", func(t *testing.T) {
+		assert.Empty(t, FromJSONLabels(nil))
+	})
+
+	t.Run("ParseLabelSelector with empty selector applies no filter", func(t *testing.T) {
+		empty := " "
+
+		result, err := ParseLabelSelector(&empty)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+	})
+
+	t.Run("ParseLabelSelector builds JSON object", func(t *testing.T) {
+		selector := "env=prod, department=library"
+
+		result, err := ParseLabelSelector(&selector)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.JSONEq(t, `{"env":"prod","department":"library"}`, result.String)
+	})
+
+	t.Run("ParseLabelSelector rejects malformed terms", func(t *testing.T) {
+		for _, selector := range []string{"env", "env=prod,", "Env=prod"} {
+			_, err := ParseLabelSelector(&selector)
+			assert.Error(t, err, selector)
+		}
+	})
+}
+
 // TestNullInt64Conversions tests SQL null int64 conversions.
 func TestNullInt64Conversions(t *testing.T) {
 	t.Run("ToNullInt64 with non-zero value", func(t *testing.T) {
@@ -534,3 +587,181 @@ func TestMakePaginationResult(t *testing.T) {
 		})
 	}
 }
+
+// TestParseCursorPagination tests keyset page token round trips and validation.
+func TestParseCursorPagination(t *testing.T) {
+	createdAt := sql.NullTime{Time: time.Date(2026, 3, 14, 15, 9, 26, 535897932, time.UTC), Valid: true}
+
+	t.Run("empty token starts from the newest row", func(t *testing.T) {
+		params, err := ParseCursorPagination(0, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(DefaultPageSize), params.Limit)
+		assert.False(t, params.CreatedAt.Valid)
+		assert.False(t, params.ID.Valid)
+	})
+
+	t.Run("caps page size at maximum", func(t *testing.T) {
+		params, err := ParseCursorPagination(500, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(MaxPageSize), params.Limit)
+	})
+
+	t.Run("token round trips the last row", func(t *testing.T) {
+		params, err := ParseCursorPagination(20, GenerateCursorPageToken(createdAt, 42))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(20), params.Limit)
+		assert.True(t, params.CreatedAt.Time.Equal(createdAt.Time))
+		assert.Equal(t, sql.NullInt64{Int64: 42, Valid: true}, params.ID)
+	})
+
+	for name, token := range map[string]string{
+		"offset token": GeneratePageToken(40),
+		"not base64":   "invalid!!!",
+		"missing id":   "MTIz",
+		"non-numeric":  "YS5i",
+		"non-positive": GenerateCursorPageToken(createdAt, 0),
+	} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			_, err := ParseCursorPagination(10, token)
+
+			assert.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		})
+	}
+}
+
+// TestMakeCursorPaginationResult tests that the next token continues after the
+// last row of a full page.
+func TestMakeCursorPaginationResult(t *testing.T) {
+	createdAt := sql.NullTime{Time: time.Unix(1700000000, 0), Valid: true}
+	params := CursorPagination{Limit: 2}
+
+	assert.Empty(t, MakeCursorPaginationResult(1, params, createdAt, 7).NextPageToken)
+	assert.Empty(t, MakeCursorPaginationResult(0, CursorPagination{}, sql.NullTime{}, 0).NextPageToken)
+
+	result := MakeCursorPaginationResult(2, params, createdAt, 7)
+	next, err := ParseCursorPagination(2, result.NextPageToken)
+	assert.NoError(t, err)
+	assert.True(t, next.CreatedAt.Time.Equal(createdAt.Time))
+	assert.Equal(t, int64(7), next.ID.Int64)
+}
+
+// TestParseExpiry tests parsing membership and relationship expiries.
+func TestParseExpiry(t *testing.T) {
+	t.Run("zero means no expiry", func(t *testing.T) {
+		expiresAt, err := ParseExpiry(0)
+
+		assert.NoError(t, err)
+		assert.False(t, expiresAt.Valid)
+		assert.Equal(t, int64(0), ExpiryToProto(expiresAt))
+	})
+
+	t.Run("future expiry round trips", func(t *testing.T) {
+		future := time.Now().Add(24 * time.Hour).Unix()
+
+		expiresAt, err := ParseExpiry(future)
+
+		assert.NoError(t, err)
+		assert.True(t, expiresAt.Valid)
+		assert.Equal(t, future, ExpiryToProto(expiresAt))
+	})
+
+	t.Run("past expiry is rejected", func(t *testing.T) {
+		_, err := ParseExpiry(time.Now().Add(-time.Hour).Unix())
+
+		assert.Error(t, err)
+	})
+}
+
+// TestNewUpdateMask tests field mask validation and wildcard expansion.
+func TestNewUpdateMask(t *testing.T) {
+	updatable := []string{"site.site_name", "site.labels", "site.config.port", "gcp_external_ip"}
+
+	tests := []struct {
+		name    string
+		paths   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty mask selects all", want: updatable},
+		{name: "exact paths", paths: []string{"site.labels", "gcp_external_ip"}, want: []string{"site.labels", "gcp_external_ip"}},
+		{name: "star selects all", paths: []string{"*"}, want: updatable},
+		{name: "wildcard selects fields under it", paths: []string{"site.*"}, want: []string{"site.site_name", "site.labels", "site.config.port"}},
+		{name: "nested wildcard", paths: []string{"site.config.*"}, want: []string{"site.config.port"}},
+		{name: "message path selects fields under it", paths: []string{"site.config"}, want: []string{"site.config.port"}},
+		{name: "unknown path", paths: []string{"site.name"}, wantErr: true},
+		{name: "unknown path alongside known ones", paths: []string{"site.labels", "site.status"}, wantErr: true},
+		{name: "partial segment", paths: []string{"site.lab"}, wantErr: true},
+		{name: "wildcard over nothing", paths: []string{"project.*"}, wantErr: true},
+		{name: "wildcard mid-segment", paths: []string{"site*"}, wantErr: true},
+		{name: "empty path", paths: []string{""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mask *fieldmaskpb.FieldMask
+			if tt.paths != nil {
+				mask = &fieldmaskpb.FieldMask{Paths: tt.paths}
+			}
+
+			got, err := NewUpdateMask(mask, updatable...)
+			if tt.wantErr {
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				return
+			}
+			assert.NoError(t, err)
+			for _, path := range updatable {
+				assert.Equal(t, slices.Contains(tt.want, path), got.Has(path), path)
+			}
+		})
+	}
+}
+
+// TestNewUpdateMask_ThroughValidation tests that the masks NewUpdateMask
+// accepts reach it past the validation interceptor.
+func TestNewUpdateMask_ThroughValidation(t *testing.T) {
+	updatable := []string{"site.config.site_name", "site.config.port", "site.github_team_id"}
+
+	tests := []struct {
+		name     string
+		paths    []string
+		want     []string
+		wantCode connect.Code
+	}{
+		{name: "exact path", paths: []string{"site.config.port"}, want: []string{"site.config.port"}},
+		{name: "star", paths: []string{"*"}, want: updatable},
+		{name: "wildcard", paths: []string{"site.*"}, want: updatable},
+		{name: "nested wildcard", paths: []string{"site.config.*"}, want: []string{"site.config.site_name", "site.config.port"}},
+		{name: "unknown field", paths: []string{"site.status.*"}, wantCode: connect.CodeInvalidArgument},
+		{name: "known but not updatable", paths: []string{"site_name"}, wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got UpdateMask
+			handler := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				var err error
+				got, err = NewUpdateMask(req.Any().(*libopsv1.AdminUpdateSiteRequest).UpdateMask, updatable...)
+				return nil, err
+			}
+
+			req := connect.NewRequest(&libopsv1.AdminUpdateSiteRequest{
+				OrganizationId: "0b9a7f2e-3c1d-4e5f-8a6b-7c8d9e0f1a2b",
+				ProjectId:      "0b9a7f2e-3c1d-4e5f-8a6b-7c8d9e0f1a2b",
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			_, err := validation.NewInterceptor().WrapUnary(handler)(context.Background(), req)
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			assert.NoError(t, err)
+			for _, path := range updatable {
+				assert.Equal(t, slices.Contains(tt.want, path), got.Has(path), path)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ResolveMemberAccountID returns the public ID of the account a create-member request
+// adds: account_id when set, otherwise the account registered with email. It returns
+// an empty ID when no account uses the email, so the caller can invite them instead.
+func ResolveMemberAccountID(ctx context.Context, querier db.Querier, accountID, email string) (string, error) {
+	if accountID != "" || email == "" {
+		if err := validation.UUID(accountID); err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return accountID, nil
+	}
+
+	if err := validation.Email(email); err != nil {
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	account, err := querier.GetAccountByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return account.PublicID, nil
+}
+
+// InviteMember emails an invitation to someone who has no account yet. Without an
+// inviter, invitations are disabled and the request fails as an unknown account.
+func InviteMember(ctx context.Context, inviter *invite.Inviter, req invite.Request) (*libopsv1.MemberInvitation, error) {
+	if inviter == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+	}
+
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	invitation, err := inviter.Invite(ctx, req)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to invite member: %w", err))
+	}
+	return &libopsv1.MemberInvitation{
+		InvitationId: invitation.PublicID,
+		Email:        invitation.Email,
+		Role:         invitation.Role,
+		ExpiresAt:    invitation.ExpiresAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/testutils"
+)
+
+// TestResolveMemberAccountID tests adding members by account ID or by email.
+func TestResolveMemberAccountID(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetAccountByEmailFunc: func(ctx context.Context, email string) (db.GetAccountByEmailRow, error) {
+			if email == "ada@example.org" {
+				return db.GetAccountByEmailRow{PublicID: "0194d3a0-0000-7000-8000-000000000001"}, nil
+			}
+			return db.GetAccountByEmailRow{}, sql.ErrNoRows
+		},
+	}
+	ctx := context.Background()
+
+	id, err := ResolveMemberAccountID(ctx, mock, "0194d3a0-0000-7000-8000-000000000002", "ada@example.org")
+	require.NoError(t, err)
+	assert.Equal(t, "0194d3a0-0000-7000-8000-000000000002", id, "account_id wins over email")
+
+	id, err = ResolveMemberAccountID(ctx, mock, "", "ada@example.org")
+	require.NoError(t, err)
+	assert.Equal(t, "0194d3a0-0000-7000-8000-000000000001", id)
+
+	id, err = ResolveMemberAccountID(ctx, mock, "", "grace@example.org")
+	require.NoError(t, err)
+	assert.Empty(t, id, "no account uses the email")
+
+	_, err = ResolveMemberAccountID(ctx, mock, "", "not an email")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = ResolveMemberAccountID(ctx, mock, "", "")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestInviteMemberDisabled tests that without an inviter an unknown email is reported
+// as a missing account.
+func TestInviteMemberDisabled(t *testing.T) {
+	_, err := InviteMember(context.Background(), nil, invite.Request{Email: "grace@example.org"})
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,170 @@
+// Package notification serves the authenticated user's in-app notification center.
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/notify"
+	"github.com/libops/api/internal/service"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// NotificationService implements the LibOps NotificationService API.
+type NotificationService struct {
+	db  db.Querier
+	hub *notify.Hub
+}
+
+// Compile-time check to ensure NotificationService implements the interface.
+var _ libopsv1connect.NotificationServiceHandler = (*NotificationService)(nil)
+
+// NewNotificationService creates a new NotificationService instance.
+// The hub wakes the user's open notification streams after notifications are read.
+func NewNotificationService(querier db.Querier, hub *notify.Hub) *NotificationService {
+	return &NotificationService{
+		db:  querier,
+		hub: hub,
+	}
+}
+
+// ListNotifications lists the authenticated user's notifications, newest first.
+func (s *NotificationService) ListNotifications(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListNotificationsRequest],
+) (*connect.Response[libopsv1.ListNotificationsResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []db.ListAccountNotificationsRow
+	if req.Msg.UnreadOnly {
+		unread, err := s.db.ListUnreadAccountNotifications(ctx, db.ListUnreadAccountNotificationsParams{
+			AccountID: userInfo.AccountID,
+			Limit:     pagination.Limit,
+			Offset:    pagination.Offset,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list notifications: %w", err))
+		}
+		for _, row := range unread {
+			rows = append(rows, db.ListAccountNotificationsRow(row))
+		}
+	} else {
+		rows, err = s.db.ListAccountNotifications(ctx, db.ListAccountNotificationsParams{
+			AccountID: userInfo.AccountID,
+			Limit:     pagination.Limit,
+			Offset:    pagination.Offset,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list notifications: %w", err))
+		}
+	}
+
+	unreadCount, err := s.db.CountUnreadAccountNotifications(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count unread notifications: %w", err))
+	}
+
+	notifications := make([]*libopsv1.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, notify.ToProto(row))
+	}
+
+	return connect.NewResponse(&libopsv1.ListNotificationsResponse{
+		Notifications: notifications,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+		UnreadCount:   unreadCount,
+	}), nil
+}
+
+// MarkNotificationsRead marks the given notifications, or all of them, as read.
+// Notifications that are already read or belong to another account are skipped.
+func (s *NotificationService) MarkNotificationsRead(
+	ctx context.Context,
+	req *connect.Request[libopsv1.MarkNotificationsReadRequest],
+) (*connect.Response[libopsv1.MarkNotificationsReadResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	accountID := userInfo.AccountID
+
+	if !req.Msg.All {
+		if len(req.Msg.NotificationIds) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("notification_ids or all is required"))
+		}
+		if len(req.Msg.NotificationIds) > service.MaxPageSize {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d notification_ids can be marked at once", service.MaxPageSize))
+		}
+		for _, id := range req.Msg.NotificationIds {
+			if _, err := uuid.Parse(id); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid notification_id %q", id))
+			}
+		}
+	}
+
+	changed := int64(0)
+	if req.Msg.All {
+		n, err := s.db.MarkAllNotificationsRead(ctx, accountID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to mark notifications read: %w", err))
+		}
+		changed = n
+	} else {
+		for _, id := range req.Msg.NotificationIds {
+			n, err := s.db.MarkNotificationRead(ctx, db.MarkNotificationReadParams{
+				AccountID: accountID,
+				PublicID:  id,
+			})
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to mark notification read: %w", err))
+			}
+			changed += n
+		}
+	}
+	if changed > 0 && s.hub != nil {
+		s.hub.Publish(accountID)
+	}
+
+	unreadCount, err := s.db.CountUnreadAccountNotifications(ctx, accountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count unread notifications: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.MarkNotificationsReadResponse{
+		UnreadCount: unreadCount,
+	}), nil
+}
+
+// GetUnreadNotificationCount counts the authenticated user's unread notifications.
+func (s *NotificationService) GetUnreadNotificationCount(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetUnreadNotificationCountRequest],
+) (*connect.Response[libopsv1.GetUnreadNotificationCountResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	unreadCount, err := s.db.CountUnreadAccountNotifications(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count unread notifications: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.GetUnreadNotificationCountResponse{
+		UnreadCount: unreadCount,
+	}), nil
+}
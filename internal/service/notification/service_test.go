@@ -0,0 +1,132 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/notify"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func userContext(accountID int64) context.Context {
+	return context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: accountID})
+}
+
+// TestListNotifications tests listing all or only unread notifications for the caller.
+func TestListNotifications(t *testing.T) {
+	created := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	var listed, listedUnread bool
+	mock := &testutils.MockQuerier{
+		ListAccountNotificationsFunc: func(ctx context.Context, arg db.ListAccountNotificationsParams) ([]db.ListAccountNotificationsRow, error) {
+			listed = true
+			assert.Equal(t, int64(7), arg.AccountID)
+			return []db.ListAccountNotificationsRow{
+				{PublicID: "n-2", Type: notify.TypeMemberAdded, Title: "Added", Link: "/projects/p", CreatedAt: sql.NullTime{Time: created, Valid: true}},
+				{PublicID: "n-1", Type: notify.TypeDeployFinished, Title: "Deployed", ReadAt: sql.NullTime{Time: created, Valid: true}},
+			}, nil
+		},
+		ListUnreadAccountNotificationsFunc: func(ctx context.Context, arg db.ListUnreadAccountNotificationsParams) ([]db.ListUnreadAccountNotificationsRow, error) {
+			listedUnread = true
+			assert.Equal(t, int64(7), arg.AccountID)
+			return []db.ListUnreadAccountNotificationsRow{{PublicID: "n-2"}}, nil
+		},
+		CountUnreadAccountNotificationsFunc: func(ctx context.Context, accountID int64) (int64, error) {
+			return 1, nil
+		},
+	}
+	svc := NewNotificationService(mock, notify.NewHub())
+
+	resp, err := svc.ListNotifications(userContext(7), connect.NewRequest(&libopsv1.ListNotificationsRequest{}))
+	require.NoError(t, err)
+	assert.True(t, listed)
+	assert.Equal(t, int64(1), resp.Msg.UnreadCount)
+	require.Len(t, resp.Msg.Notifications, 2)
+	assert.Equal(t, "n-2", resp.Msg.Notifications[0].NotificationId)
+	assert.False(t, resp.Msg.Notifications[0].Read)
+	assert.Equal(t, created.Unix(), resp.Msg.Notifications[0].CreatedAt)
+	assert.True(t, resp.Msg.Notifications[1].Read)
+	assert.Empty(t, resp.Msg.NextPageToken)
+
+	resp, err = svc.ListNotifications(userContext(7), connect.NewRequest(&libopsv1.ListNotificationsRequest{UnreadOnly: true}))
+	require.NoError(t, err)
+	assert.True(t, listedUnread)
+	require.Len(t, resp.Msg.Notifications, 1)
+
+	_, err = svc.ListNotifications(context.Background(), connect.NewRequest(&libopsv1.ListNotificationsRequest{}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+// TestMarkNotificationsRead tests marking notifications read and waking the caller's streams.
+func TestMarkNotificationsRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *libopsv1.MarkNotificationsReadRequest
+		wantCode    connect.Code
+		wantMarked  []string
+		wantMarkAll bool
+	}{
+		{
+			name:       "marks listed notifications",
+			req:        &libopsv1.MarkNotificationsReadRequest{NotificationIds: []string{"7a1f3a4e-6f0b-4c55-9d3a-1d2f4b6c8e01"}},
+			wantMarked: []string{"7a1f3a4e-6f0b-4c55-9d3a-1d2f4b6c8e01"},
+		},
+		{
+			name:        "marks all notifications",
+			req:         &libopsv1.MarkNotificationsReadRequest{All: true},
+			wantMarkAll: true,
+		},
+		{
+			name:     "requires ids or all",
+			req:      &libopsv1.MarkNotificationsReadRequest{},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "rejects malformed ids",
+			req:      &libopsv1.MarkNotificationsReadRequest{NotificationIds: []string{"not-a-uuid"}},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var marked []string
+			markedAll := false
+			mock := &testutils.MockQuerier{
+				MarkNotificationReadFunc: func(ctx context.Context, arg db.MarkNotificationReadParams) (int64, error) {
+					assert.Equal(t, int64(7), arg.AccountID)
+					marked = append(marked, arg.PublicID)
+					return 1, nil
+				},
+				MarkAllNotificationsReadFunc: func(ctx context.Context, accountID int64) (int64, error) {
+					assert.Equal(t, int64(7), accountID)
+					markedAll = true
+					return 3, nil
+				},
+			}
+			hub := notify.NewHub()
+			wake, unsubscribe := hub.Subscribe(7)
+			defer unsubscribe()
+
+			resp, err := NewNotificationService(mock, hub).MarkNotificationsRead(userContext(7), connect.NewRequest(tt.req))
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				assert.Len(t, wake, 0)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), resp.Msg.UnreadCount)
+			assert.Equal(t, tt.wantMarked, marked)
+			assert.Equal(t, tt.wantMarkAll, markedAll)
+			assert.Len(t, wake, 1)
+		})
+	}
+}
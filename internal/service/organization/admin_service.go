@@ -12,6 +12,7 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/envelope"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
@@ -23,6 +24,9 @@ import (
 // AdminOrganizationService implements the admin-level organization API.
 type AdminOrganizationService struct {
 	repo *Repository
+	// rotateSecrets rotates an organization's keys and re-wraps its secrets
+	rotateSecrets func(ctx context.Context, organizationID, accountID int64) (envelope.Rotation, error)
+	vault         *vaultOrchestrator
 }
 
 // Compile-time check.
@@ -32,6 +36,10 @@ var _ libopsv1connect.AdminOrganizationServiceHandler = (*AdminOrganizationServi
 func NewAdminOrganizationService(querier db.Querier) *AdminOrganizationService {
 	return &AdminOrganizationService{
 		repo: NewRepository(querier),
+		rotateSecrets: func(ctx context.Context, organizationID, accountID int64) (envelope.Rotation, error) {
+			return service.RotateOrganizationSecrets(ctx, querier, organizationID, accountID)
+		},
+		vault: newVaultOrchestrator(querier),
 	}
 }
 
@@ -49,7 +57,11 @@ func (s *AdminOrganizationService) GetOrganization(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
 	}
-	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	organization, err := s.repo.GetOrganizationByPublicID(service.ETagContext(ctx), publicID)
+	if err != nil {
+		return nil, err
+	}
+	residency, err := s.repo.GetDataResidency(ctx, organization.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -59,14 +71,19 @@ func (s *AdminOrganizationService) GetOrganization(
 			OrganizationId:   organization.PublicID,
 			OrganizationName: organization.Name,
 			Status:           DbOrganizationStatusToProto(organization.Status),
+			Labels:           service.FromJSONLabels(organization.Labels),
+			DataResidency:    service.DbDataResidencyToProto(residency),
+			Etag:             service.ETag(organization.Version),
 		},
 		GcpParent:   organization.GcpParent,
 		GcpFolderId: service.FromNullStringPtr(organization.GcpFolderID),
 	}
 
-	return connect.NewResponse(&libopsv1.AdminGetOrganizationResponse{
+	resp := connect.NewResponse(&libopsv1.AdminGetOrganizationResponse{
 		Folder: folder,
-	}), nil
+	})
+	service.SetETag(resp.Header(), organization.Version)
+	return resp, nil
 }
 
 // CreateOrganization creates a new organization (admin - can set all fields).
@@ -87,6 +104,9 @@ func (s *AdminOrganizationService) CreateOrganization(
 	if err := validation.OrganizationName(folder.Config.OrganizationName); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	if err := validation.Labels(folder.Config.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
 	userInfo, ok := auth.GetUserFromContext(ctx)
 	if !ok {
@@ -107,6 +127,7 @@ func (s *AdminOrganizationService) CreateOrganization(
 		GcpParent:         folder.GcpParent,
 		GcpFolderID:       toNullString(ptrToString(folder.GcpFolderId)),
 		Status:            db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusProvisioning, Valid: true},
+		Labels:            service.LabelsToJSON(folder.Config.Labels),
 		CreatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -115,6 +136,11 @@ func (s *AdminOrganizationService) CreateOrganization(
 	if err != nil {
 		return nil, err
 	}
+	created, err := s.repo.GetOrganizationByPublicID(ctx, uuid.MustParse(newID))
+	if err != nil {
+		return nil, err
+	}
+	s.vault.provisionOrganization(ctx, created.ID, newID)
 
 	return connect.NewResponse(&libopsv1.AdminCreateOrganizationResponse{
 		OrganizationId: newID,
@@ -122,6 +148,14 @@ func (s *AdminOrganizationService) CreateOrganization(
 	}), nil
 }
 
+// adminOrganizationUpdatePaths are the fields the admin UpdateOrganization can change.
+var adminOrganizationUpdatePaths = []string{
+	"folder.config.organization_name",
+	"folder.gcp_parent",
+	"folder.gcp_folder_id",
+	"folder.config.labels",
+}
+
 // UpdateOrganization updates organization metadata (admin - can update all fields).
 func (s *AdminOrganizationService) UpdateOrganization(
 	ctx context.Context,
@@ -136,6 +170,11 @@ func (s *AdminOrganizationService) UpdateOrganization(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder configuration is required"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, adminOrganizationUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	if folder.Config != nil && folder.Config.OrganizationName != "" {
 		if err := validation.StringLength("organization_name", folder.Config.OrganizationName, 1, 255); err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -158,6 +197,9 @@ func (s *AdminOrganizationService) UpdateOrganization(
 	if err != nil {
 		return nil, err
 	}
+	if err := service.CheckETag(req.Header(), folder.Config.GetEtag(), existing.Version, "organization"); err != nil {
+		return nil, err
+	}
 
 	// Apply field mask - admin can update all fields
 	name := existing.Name
@@ -165,17 +207,24 @@ func (s *AdminOrganizationService) UpdateOrganization(
 	gcpBillingAccount := existing.GcpBillingAccount
 	gcpParent := existing.GcpParent
 	gcpFolderID := existing.GcpFolderID
+	labels := existing.Labels
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "folder.config.organization_name") {
+	if mask.Has("folder.config.organization_name") && folder.Config != nil {
 		name = folder.Config.OrganizationName
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "folder.gcp_parent") {
+	if mask.Has("folder.gcp_parent") {
 		gcpOrgID = folder.GcpParent
 		gcpParent = folder.GcpParent
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "folder.gcp_folder_id") {
+	if mask.Has("folder.gcp_folder_id") {
 		gcpFolderID = toNullString(ptrToString(folder.GcpFolderId))
 	}
+	if mask.Has("folder.config.labels") && folder.Config != nil {
+		if err := validation.Labels(folder.Config.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(folder.Config.Labels)
+	}
 
 	params := db.UpdateOrganizationParams{
 		Name:              name,
@@ -184,18 +233,27 @@ func (s *AdminOrganizationService) UpdateOrganization(
 		GcpParent:         gcpParent,
 		GcpFolderID:       gcpFolderID,
 		Status:            db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusActive, Valid: true},
+		Labels:            labels,
 		UpdatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:          publicID.String(),
+		Version:           existing.Version,
 	}
 
 	err = s.repo.UpdateOrganization(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	// Updating an organization activates it, by which time its Vault is running
+	s.vault.provisionOrganization(ctx, existing.ID, organizationID)
 
-	return connect.NewResponse(&libopsv1.AdminUpdateOrganizationResponse{
+	if folder.Config != nil {
+		folder.Config.Etag = service.ETag(existing.Version + 1)
+	}
+	resp := connect.NewResponse(&libopsv1.AdminUpdateOrganizationResponse{
 		Folder: folder,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteOrganization deletes a organization (must have no projects).
@@ -212,7 +270,7 @@ func (s *AdminOrganizationService) DeleteOrganization(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
 	}
-	err = s.repo.DeleteOrganization(ctx, publicID)
+	err = s.vault.deleteOrganization(ctx, s.repo, publicID)
 	if err != nil {
 		return nil, err
 	}
@@ -225,22 +283,15 @@ func (s *AdminOrganizationService) ListOrganizations(
 	ctx context.Context,
 	req *connect.Request[libopsv1.AdminListOrganizationsRequest],
 ) (*connect.Response[libopsv1.AdminListOrganizationsResponse], error) {
-	pageSize := req.Msg.PageSize
-	if pageSize <= 0 {
-		pageSize = 50
-	}
-	if pageSize > 100 {
-		pageSize = 100
-	}
-
-	offset, err := service.ParsePageToken(req.Msg.PageToken)
+	pagination, err := service.ParseCursorPagination(req.Msg.PageSize, req.Msg.PageToken)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		return nil, err
 	}
 
 	params := db.ListOrganizationsParams{
-		Limit:  pageSize,
-		Offset: int32(offset),
+		CursorCreatedAt: pagination.CreatedAt,
+		CursorID:        pagination.ID,
+		Limit:           pagination.Limit,
 	}
 
 	organizations, err := s.repo.ListOrganizations(ctx, params)
@@ -255,6 +306,7 @@ func (s *AdminOrganizationService) ListOrganizations(
 				OrganizationId:   organization.PublicID,
 				OrganizationName: organization.Name,
 				Status:           DbOrganizationStatusToProto(organization.Status),
+				Labels:           service.FromJSONLabels(organization.Labels),
 			},
 			GcpParent:   organization.GcpParent,
 			GcpFolderId: service.FromNullStringPtr(organization.GcpFolderID),
@@ -262,8 +314,9 @@ func (s *AdminOrganizationService) ListOrganizations(
 	}
 
 	nextPageToken := ""
-	if len(organizations) == int(pageSize) {
-		nextPageToken = service.GeneratePageToken(offset + int(pageSize))
+	if n := len(organizations); n > 0 {
+		last := organizations[n-1]
+		nextPageToken = service.MakeCursorPaginationResult(n, pagination, last.CreatedAt, last.ID).NextPageToken
 	}
 
 	return connect.NewResponse(&libopsv1.AdminListOrganizationsResponse{
@@ -0,0 +1,175 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// KeyRevoker revokes API keys. It's implemented by auth.APIKeyManager.
+type KeyRevoker interface {
+	DeactivateAPIKey(ctx context.Context, keyUUID string) error
+}
+
+// APIKeyService implements the OrganizationApiKeyService API.
+type APIKeyService struct {
+	db          db.Querier
+	keys        KeyRevoker
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.OrganizationApiKeyServiceHandler = (*APIKeyService)(nil)
+
+// NewAPIKeyService creates a new APIKeyService instance. Without a revoker,
+// keys can be listed but not revoked.
+func NewAPIKeyService(querier db.Querier, keys KeyRevoker, auditLogger *audit.Logger) *APIKeyService {
+	return &APIKeyService{
+		db:          querier,
+		keys:        keys,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListOrganizationApiKeys lists the working API keys of the organization's
+// members, newest first.
+func (s *APIKeyService) ListOrganizationApiKeys(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListOrganizationApiKeysRequest],
+) (*connect.Response[libopsv1.ListOrganizationApiKeysResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListOrganizationAPIKeys(ctx, db.ListOrganizationAPIKeysParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list organization API keys", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	keys := make([]*libopsv1.OrganizationApiKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, organizationAPIKeyToProto(db.GetOrganizationAPIKeyRow(row)))
+	}
+
+	return connect.NewResponse(&libopsv1.ListOrganizationApiKeysResponse{
+		ApiKeys:       keys,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// RevokeMemberKey revokes one of the organization's members' API keys. Keys
+// of people outside the organization are NotFound.
+func (s *APIKeyService) RevokeMemberKey(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RevokeMemberKeyRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.ApiKeyId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid api_key_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if s.keys == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("API keys can't be revoked on this server"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.db.GetOrganizationAPIKey(ctx, db.GetOrganizationAPIKeyParams{
+		PublicID:       req.Msg.ApiKeyId,
+		OrganizationID: organization.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("API key not found"))
+	}
+	if err != nil {
+		slog.Error("Failed to get organization API key", "error", err, "api_key_id", req.Msg.ApiKeyId, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.keys.DeactivateAPIKey(ctx, key.PublicID); err != nil {
+		slog.Error("Failed to revoke API key", "error", err, "api_key_id", key.PublicID, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke API key: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.MemberAPIKeyRevoke, map[string]any{
+		"api_key_id": key.PublicID,
+		"name":       key.Name,
+		"account_id": key.AccountPublicID,
+	})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// organizationAPIKeyToProto converts a member's key. Stored scopes that don't
+// parse are treated as granting write access.
+func organizationAPIKeyToProto(row db.GetOrganizationAPIKeyRow) *libopsv1.OrganizationApiKey {
+	var scopes, cidrs []string
+	if err := json.Unmarshal(row.Scopes, &scopes); err != nil {
+		slog.Error("Failed to parse API key scopes", "error", err, "api_key_id", row.PublicID)
+	}
+	if err := json.Unmarshal(row.AllowedCidrs, &cidrs); err != nil {
+		slog.Error("Failed to parse API key networks", "error", err, "api_key_id", row.PublicID)
+	}
+	parsed, err := auth.ParseScopes(scopes)
+
+	key := &libopsv1.OrganizationApiKey{
+		ApiKeyId:       row.PublicID,
+		Name:           row.Name,
+		Description:    row.Description.String,
+		Scopes:         scopes,
+		AllowedCidrs:   cidrs,
+		WriteAccess:    err != nil || auth.GrantsWrite(parsed),
+		AccountId:      row.AccountPublicID,
+		Email:          row.Email,
+		AccountName:    row.UserName.String,
+		ServiceAccount: row.AuthMethod == db.AccountsAuthMethodGcloud,
+	}
+	if row.CreatedAt.Valid {
+		key.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.LastUsedAt.Valid {
+		key.LastUsedAt = row.LastUsedAt.Time.Unix()
+	}
+	if row.ExpiresAt.Valid {
+		key.ExpiresAt = row.ExpiresAt.Time.Unix()
+	}
+	return key
+}
@@ -0,0 +1,106 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// revokedKeys records the keys it's asked to revoke
+type revokedKeys []string
+
+func (r *revokedKeys) DeactivateAPIKey(ctx context.Context, keyUUID string) error {
+	*r = append(*r, keyUUID)
+	return nil
+}
+
+func TestOrganizationAPIKeys(t *testing.T) {
+	orgID := uuid.NewString()
+	deployKey := db.GetOrganizationAPIKeyRow{
+		PublicID:        uuid.NewString(),
+		Name:            "ci",
+		Scopes:          json.RawMessage(`["site:read","site:write"]`),
+		AllowedCidrs:    json.RawMessage(`[]`),
+		AccountPublicID: uuid.NewString(),
+		Email:           "deploy@vandelay.com",
+		AuthMethod:      db.AccountsAuthMethodGcloud,
+	}
+	auditorKey := db.GetOrganizationAPIKeyRow{
+		PublicID:        uuid.NewString(),
+		Name:            "auditor",
+		Scopes:          json.RawMessage(`["organization:read"]`),
+		AllowedCidrs:    json.RawMessage(`["192.0.2.0/24"]`),
+		AccountPublicID: uuid.NewString(),
+		Email:           "art@vandelay.com",
+		AuthMethod:      db.AccountsAuthMethodGoogle,
+	}
+	personalKey := auditorKey
+	personalKey.PublicID, personalKey.Scopes = uuid.NewString(), json.RawMessage(`[]`)
+
+	var audited []db.CreateAuditEventParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		ListOrganizationAPIKeysFunc: func(ctx context.Context, arg db.ListOrganizationAPIKeysParams) ([]db.ListOrganizationAPIKeysRow, error) {
+			return []db.ListOrganizationAPIKeysRow{
+				db.ListOrganizationAPIKeysRow(deployKey),
+				db.ListOrganizationAPIKeysRow(auditorKey),
+				db.ListOrganizationAPIKeysRow(personalKey),
+			}, nil
+		},
+		GetOrganizationAPIKeyFunc: func(ctx context.Context, arg db.GetOrganizationAPIKeyParams) (db.GetOrganizationAPIKeyRow, error) {
+			if arg.OrganizationID == 1 && arg.PublicID == personalKey.PublicID {
+				return personalKey, nil
+			}
+			return db.GetOrganizationAPIKeyRow{}, sql.ErrNoRows
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg)
+			return nil
+		},
+	}
+	var revoked revokedKeys
+	svc := NewAPIKeyService(mock, &revoked, audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+
+	listed, err := svc.ListOrganizationApiKeys(ctx, connect.NewRequest(&libopsv1.ListOrganizationApiKeysRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.ApiKeys, 3)
+	deploy, auditor, personal := listed.Msg.ApiKeys[0], listed.Msg.ApiKeys[1], listed.Msg.ApiKeys[2]
+	assert.True(t, deploy.WriteAccess)
+	assert.True(t, deploy.ServiceAccount)
+	assert.False(t, auditor.WriteAccess)
+	assert.Equal(t, []string{"192.0.2.0/24"}, auditor.AllowedCidrs)
+	assert.True(t, personal.WriteAccess, "keys without scopes can do anything their holder can")
+	assert.False(t, personal.ServiceAccount)
+
+	_, err = svc.RevokeMemberKey(ctx, connect.NewRequest(&libopsv1.RevokeMemberKeyRequest{OrganizationId: orgID, ApiKeyId: uuid.NewString()}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "keys of people outside the organization")
+	assert.Empty(t, revoked)
+
+	_, err = svc.RevokeMemberKey(ctx, connect.NewRequest(&libopsv1.RevokeMemberKeyRequest{OrganizationId: orgID, ApiKeyId: personalKey.PublicID}))
+	require.NoError(t, err)
+	assert.Equal(t, revokedKeys{personalKey.PublicID}, revoked)
+	require.Len(t, audited, 1)
+	assert.Equal(t, string(audit.MemberAPIKeyRevoke), audited[0].EventName)
+
+	_, err = NewAPIKeyService(mock, nil, audit.New(mock)).RevokeMemberKey(ctx,
+		connect.NewRequest(&libopsv1.RevokeMemberKeyRequest{OrganizationId: orgID, ApiKeyId: personalKey.PublicID}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
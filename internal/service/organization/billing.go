@@ -0,0 +1,228 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// CreateBillingPortalSession returns a Stripe Billing Portal URL where owners can
+// change cards and download invoices. The portal returns to the dashboard Billing page.
+func (s *OrganizationService) CreateBillingPortalSession(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateBillingPortalSessionRequest],
+) (*connect.Response[libopsv1.CreateBillingPortalSessionResponse], error) {
+	organization, err := s.getBilledOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	returnURL := fmt.Sprintf("%s/billing?organization_id=%s",
+		strings.TrimRight(s.config.DashBaseUrl, "/"), url.QueryEscape(organization.PublicID))
+
+	portalURL, err := s.billingManager.CreateBillingPortalSession(ctx, organization.ID, returnURL)
+	if err != nil {
+		return nil, billingError("create billing portal session", req.Msg.OrganizationId, err)
+	}
+
+	return connect.NewResponse(&libopsv1.CreateBillingPortalSessionResponse{
+		Url: portalURL,
+	}), nil
+}
+
+// ListPaymentMethods lists the cards attached to the organization's Stripe customer.
+func (s *OrganizationService) ListPaymentMethods(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPaymentMethodsRequest],
+) (*connect.Response[libopsv1.ListPaymentMethodsResponse], error) {
+	organization, err := s.getBilledOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := s.billingManager.ListPaymentMethods(ctx, organization.ID)
+	if err != nil {
+		return nil, billingError("list payment methods", req.Msg.OrganizationId, err)
+	}
+
+	paymentMethods := make([]*commonv1.PaymentMethod, 0, len(methods))
+	for _, pm := range methods {
+		paymentMethods = append(paymentMethods, toProtoPaymentMethod(pm))
+	}
+
+	return connect.NewResponse(&libopsv1.ListPaymentMethodsResponse{
+		PaymentMethods: paymentMethods,
+	}), nil
+}
+
+// SetDefaultPaymentMethod sets the card charged for the organization's subscription.
+func (s *OrganizationService) SetDefaultPaymentMethod(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SetDefaultPaymentMethodRequest],
+) (*connect.Response[libopsv1.SetDefaultPaymentMethodResponse], error) {
+	if err := validation.RequiredString("payment_method_id", req.Msg.PaymentMethodId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := s.getBilledOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pm, err := s.billingManager.SetDefaultPaymentMethod(ctx, organization.ID, req.Msg.PaymentMethodId)
+	if err != nil {
+		return nil, billingError("set default payment method", req.Msg.OrganizationId, err)
+	}
+
+	slog.Info("Default payment method updated",
+		"organization_id", req.Msg.OrganizationId,
+		"payment_method_id", pm.ID)
+
+	return connect.NewResponse(&libopsv1.SetDefaultPaymentMethodResponse{
+		PaymentMethod: toProtoPaymentMethod(*pm),
+	}), nil
+}
+
+// ListInvoices lists the organization's Stripe invoices, newest first.
+// The page token is the ID of the last invoice on the previous page.
+func (s *OrganizationService) ListInvoices(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListInvoicesRequest],
+) (*connect.Response[libopsv1.ListInvoicesResponse], error) {
+	organization, err := s.getBilledOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.Msg.PageSize
+	if pageSize <= 0 {
+		pageSize = service.DefaultPageSize
+	}
+	if pageSize > service.MaxPageSize {
+		pageSize = service.MaxPageSize
+	}
+
+	invoices, hasMore, err := s.billingManager.ListInvoices(ctx, organization.ID, int64(pageSize), req.Msg.PageToken)
+	if err != nil {
+		return nil, billingError("list invoices", req.Msg.OrganizationId, err)
+	}
+
+	resp := &libopsv1.ListInvoicesResponse{
+		Invoices: make([]*commonv1.Invoice, 0, len(invoices)),
+	}
+	for _, inv := range invoices {
+		resp.Invoices = append(resp.Invoices, toProtoInvoice(inv))
+	}
+	if hasMore && len(invoices) > 0 {
+		resp.NextPageToken = invoices[len(invoices)-1].ID
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetInvoice returns a single invoice with its PDF and hosted payment links.
+func (s *OrganizationService) GetInvoice(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetInvoiceRequest],
+) (*connect.Response[libopsv1.GetInvoiceResponse], error) {
+	if err := validation.RequiredString("invoice_id", req.Msg.InvoiceId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := s.getBilledOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := s.billingManager.GetInvoice(ctx, organization.ID, req.Msg.InvoiceId)
+	if err != nil {
+		return nil, billingError("get invoice", req.Msg.OrganizationId, err)
+	}
+
+	return connect.NewResponse(&libopsv1.GetInvoiceResponse{
+		Invoice: toProtoInvoice(*inv),
+	}), nil
+}
+
+// getBilledOrganization resolves an organization and ensures it has a Stripe subscription.
+func (s *OrganizationService) getBilledOrganization(ctx context.Context, organizationID string) (db.GetOrganizationRow, error) {
+	if err := validation.UUID(organizationID); err != nil {
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return db.GetOrganizationRow{}, err
+	}
+
+	if _, err := s.repo.db.GetStripeSubscriptionByOrganizationID(ctx, organization.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.GetOrganizationRow{}, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization has no billing subscription"))
+		}
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get subscription: %w", err))
+	}
+
+	return organization, nil
+}
+
+// billingError maps billing manager errors to connect errors.
+func billingError(operation, organizationID string, err error) error {
+	switch {
+	case errors.Is(err, billing.ErrBillingDisabled):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, billing.ErrPaymentMethodNotFound), errors.Is(err, billing.ErrInvoiceNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	slog.Error("Billing operation failed", "operation", operation, "error", err, "organization_id", organizationID)
+	return connect.NewError(connect.CodeUnavailable, fmt.Errorf("billing provider unavailable"))
+}
+
+func toProtoPaymentMethod(pm billing.PaymentMethod) *commonv1.PaymentMethod {
+	return &commonv1.PaymentMethod{
+		PaymentMethodId: pm.ID,
+		Brand:           pm.Brand,
+		Last4:           pm.Last4,
+		ExpMonth:        int32(pm.ExpMonth),
+		ExpYear:         int32(pm.ExpYear),
+		IsDefault:       pm.IsDefault,
+	}
+}
+
+func toProtoInvoice(inv billing.Invoice) *commonv1.Invoice {
+	return &commonv1.Invoice{
+		InvoiceId:          inv.ID,
+		Number:             inv.Number,
+		Status:             inv.Status,
+		AmountDue:          inv.AmountDue,
+		AmountPaid:         inv.AmountPaid,
+		Currency:           inv.Currency,
+		Created:            inv.Created,
+		PeriodStart:        inv.PeriodStart,
+		PeriodEnd:          inv.PeriodEnd,
+		DueDate:            inv.DueDate,
+		PaidAt:             inv.PaidAt,
+		HostedInvoiceUrl:   inv.HostedInvoiceURL,
+		InvoicePdfUrl:      inv.InvoicePDF,
+		AttemptCount:       inv.AttemptCount,
+		NextPaymentAttempt: inv.NextPaymentAttempt,
+	}
+}
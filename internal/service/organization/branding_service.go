@@ -0,0 +1,171 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/branding"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// BrandingService implements the BrandingService API.
+type BrandingService struct {
+	db          db.Querier
+	dashBaseURL string
+}
+
+// Compile-time check.
+var _ libopsv1connect.BrandingServiceHandler = (*BrandingService)(nil)
+
+// NewBrandingService creates a new BrandingService instance.
+// Logos are served by the dashboard at dashBaseURL.
+func NewBrandingService(querier db.Querier, dashBaseURL string) *BrandingService {
+	return &BrandingService{
+		db:          querier,
+		dashBaseURL: strings.TrimSuffix(dashBaseURL, "/"),
+	}
+}
+
+// GetOrganizationBranding gets an organization's colors and logo URL.
+func (s *BrandingService) GetOrganizationBranding(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetOrganizationBrandingRequest],
+) (*connect.Response[libopsv1.GetOrganizationBrandingResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.getBranding(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetOrganizationBrandingResponse{
+		Branding: s.brandingToProto(row, organization.PublicID),
+	}), nil
+}
+
+// UpdateOrganizationBranding changes an organization's colors or logo.
+func (s *BrandingService) UpdateOrganizationBranding(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateOrganizationBrandingRequest],
+) (*connect.Response[libopsv1.UpdateOrganizationBrandingResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	updatePrimary := ShouldUpdateField(msg.UpdateMask, "primary_color") && msg.PrimaryColor != nil
+	updateAccent := ShouldUpdateField(msg.UpdateMask, "accent_color") && msg.AccentColor != nil
+	updateLogo := ShouldUpdateField(msg.UpdateMask, "logo") && msg.Logo != nil
+
+	var primary, accent, contentType string
+	if updatePrimary {
+		primary = branding.NormalizeColor(*msg.PrimaryColor)
+		if err := branding.ValidateColor("primary_color", primary); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if updateAccent {
+		accent = branding.NormalizeColor(*msg.AccentColor)
+		if err := branding.ValidateColor("accent_color", accent); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if updateLogo && len(msg.Logo) > 0 {
+		var err error
+		if contentType, err = branding.LogoContentType(msg.Logo); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatePrimary || updateAccent {
+		current, err := s.getBranding(ctx, organization.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !updatePrimary {
+			primary = current.PrimaryColor
+		}
+		if !updateAccent {
+			accent = current.AccentColor
+		}
+		err = s.db.UpsertOrganizationBrandingColors(ctx, db.UpsertOrganizationBrandingColorsParams{
+			OrganizationID: organization.ID,
+			PrimaryColor:   primary,
+			AccentColor:    accent,
+		})
+		if err != nil {
+			slog.Error("Failed to save organization colors", "error", err, "organization_id", organization.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	if updateLogo {
+		err := s.db.UpsertOrganizationLogo(ctx, db.UpsertOrganizationLogoParams{
+			OrganizationID:  organization.ID,
+			Logo:            sql.NullString{String: string(msg.Logo), Valid: len(msg.Logo) > 0},
+			LogoContentType: contentType,
+		})
+		if err != nil {
+			slog.Error("Failed to save organization logo", "error", err, "organization_id", organization.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	row, err := s.getBranding(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateOrganizationBrandingResponse{
+		Branding: s.brandingToProto(row, organization.PublicID),
+	}), nil
+}
+
+// getBranding returns the organization's saved branding, empty when it has none.
+func (s *BrandingService) getBranding(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error) {
+	row, err := s.db.GetOrganizationBranding(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.GetOrganizationBrandingRow{OrganizationID: organizationID}, nil
+	}
+	if err != nil {
+		return row, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get branding: %w", err))
+	}
+	return row, nil
+}
+
+func (s *BrandingService) brandingToProto(row db.GetOrganizationBrandingRow, organizationPublicID string) *libopsv1.OrganizationBranding {
+	protoBranding := &libopsv1.OrganizationBranding{
+		OrganizationId: organizationPublicID,
+		PrimaryColor:   row.PrimaryColor,
+		AccentColor:    row.AccentColor,
+	}
+	if row.UpdatedAt.Valid {
+		protoBranding.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	if row.HasLogo {
+		protoBranding.LogoUrl = s.dashBaseURL + branding.LogoPath(organizationPublicID, protoBranding.UpdatedAt)
+	}
+	return protoBranding
+}
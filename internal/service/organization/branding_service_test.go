@@ -0,0 +1,107 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// pngHeader is enough of a PNG for content sniffing
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// TestUpdateOrganizationBranding tests that masked colors and the logo are saved
+// and that invalid colors and images are rejected.
+func TestUpdateOrganizationBranding(t *testing.T) {
+	orgID := uuid.NewString()
+	updatedAt := time.Unix(1767000000, 0)
+
+	saved := db.GetOrganizationBrandingRow{OrganizationID: 7, AccentColor: "#22c55e"}
+	var logo sql.NullString
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID, Name: "Consortium"}, nil
+		},
+		GetOrganizationBrandingFunc: func(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error) {
+			row := saved
+			row.HasLogo = logo.Valid
+			row.UpdatedAt = sql.NullTime{Time: updatedAt, Valid: true}
+			return row, nil
+		},
+		UpsertOrganizationBrandingColorsFunc: func(ctx context.Context, arg db.UpsertOrganizationBrandingColorsParams) error {
+			saved.PrimaryColor, saved.AccentColor = arg.PrimaryColor, arg.AccentColor
+			return nil
+		},
+		UpsertOrganizationLogoFunc: func(ctx context.Context, arg db.UpsertOrganizationLogoParams) error {
+			logo = arg.Logo
+			saved.LogoContentType = arg.LogoContentType
+			return nil
+		},
+	}
+	svc := NewBrandingService(mock, "https://dash.libops.io/")
+
+	primary, accent := " #1D4ED8", "#000000"
+	resp, err := svc.UpdateOrganizationBranding(context.Background(), connect.NewRequest(&libopsv1.UpdateOrganizationBrandingRequest{
+		OrganizationId: orgID,
+		PrimaryColor:   &primary,
+		AccentColor:    &accent,
+		Logo:           pngHeader,
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"primary_color", "logo"}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "#1d4ed8", resp.Msg.Branding.PrimaryColor, "colors are normalized")
+	assert.Equal(t, "#22c55e", resp.Msg.Branding.AccentColor, "fields outside the mask are kept")
+	assert.Equal(t, "image/png", saved.LogoContentType)
+	assert.Equal(t, "https://dash.libops.io/organizations/"+orgID+"/logo?v=1767000000", resp.Msg.Branding.LogoUrl)
+
+	resp, err = svc.UpdateOrganizationBranding(context.Background(), connect.NewRequest(&libopsv1.UpdateOrganizationBrandingRequest{
+		OrganizationId: orgID,
+		PrimaryColor:   new(string),
+		Logo:           []byte{},
+	}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.Branding.PrimaryColor, "an empty color restores the default")
+	assert.Empty(t, resp.Msg.Branding.LogoUrl, "an empty logo removes it")
+	assert.False(t, logo.Valid)
+
+	red, short := "red", "#12345"
+	invalid := []*libopsv1.UpdateOrganizationBrandingRequest{
+		{OrganizationId: orgID, PrimaryColor: &red},
+		{OrganizationId: orgID, AccentColor: &short},
+		{OrganizationId: orgID, Logo: []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>")},
+		{OrganizationId: orgID, Logo: append(pngHeader, make([]byte, 256<<10)...)},
+		{OrganizationId: "acme"},
+	}
+	for _, req := range invalid {
+		_, err := svc.UpdateOrganizationBranding(context.Background(), connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+}
+
+// TestGetOrganizationBrandingDefaults tests that an organization without branding gets empty colors.
+func TestGetOrganizationBrandingDefaults(t *testing.T) {
+	orgID := uuid.NewString()
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID}, nil
+		},
+	}
+
+	resp, err := NewBrandingService(mock, "https://dash.libops.io").GetOrganizationBranding(context.Background(),
+		connect.NewRequest(&libopsv1.GetOrganizationBrandingRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Equal(t, orgID, resp.Msg.Branding.OrganizationId)
+	assert.Empty(t, resp.Msg.Branding.PrimaryColor)
+	assert.Empty(t, resp.Msg.Branding.LogoUrl)
+	assert.Zero(t, resp.Msg.Branding.UpdatedAt)
+}
@@ -0,0 +1,348 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"sort"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// locationPattern matches a Cloud KMS location, e.g. us-central1 or eu
+var locationPattern = regexp.MustCompile(`^[a-z0-9-]{2,64}$`)
+
+// errKeysDisabled is returned when the platform can't check keys
+var errKeysDisabled = errors.New("customer-managed encryption keys are not enabled on this platform")
+
+// EncryptionKeyService implements the EncryptionKeyService API.
+type EncryptionKeyService struct {
+	db          db.Querier
+	keys        gcp.KMSKeys // nil when customer-managed keys are disabled
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.EncryptionKeyServiceHandler = (*EncryptionKeyService)(nil)
+
+// NewEncryptionKeyService creates a new EncryptionKeyService instance. Keys can
+// be listed and removed but not set or checked when keys is nil.
+func NewEncryptionKeyService(querier db.Querier, keys gcp.KMSKeys, emitter *events.Emitter, auditLogger *audit.Logger) *EncryptionKeyService {
+	return &EncryptionKeyService{
+		db:          querier,
+		keys:        keys,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListEncryptionKeys lists the organization's keys and the locations that have none.
+func (s *EncryptionKeyService) ListEncryptionKeys(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListEncryptionKeysRequest],
+) (*connect.Response[libopsv1.ListEncryptionKeysResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	agents, err := s.serviceAgents(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListOrganizationEncryptionKeys(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to list encryption keys", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	keys := make([]*libopsv1.EncryptionKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, EncryptionKeyToProto(row, organization.PublicID, agents[row.Location]))
+		delete(agents, row.Location)
+	}
+	googleManaged := make([]string, 0, len(agents))
+	for location := range agents {
+		googleManaged = append(googleManaged, location)
+	}
+	sort.Strings(googleManaged)
+
+	return connect.NewResponse(&libopsv1.ListEncryptionKeysResponse{Keys: keys, GoogleManagedLocations: googleManaged}), nil
+}
+
+// SetEncryptionKey checks a key and, when it's usable, makes it the
+// organization's key for its location.
+func (s *EncryptionKeyService) SetEncryptionKey(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SetEncryptionKeyRequest],
+) (*connect.Response[libopsv1.SetEncryptionKeyResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	location, err := gcp.KMSKeyLocation(req.Msg.KmsKeyName)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid kms_key_name: %w", err))
+	}
+	if s.keys == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errKeysDisabled)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := s.serviceAgents(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	status, problem := s.check(ctx, req.Msg.KmsKeyName, agents[location])
+	if status == db.OrganizationEncryptionKeysStatusUnhealthy {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("key can't be used: %s", problem))
+	}
+
+	err = s.db.SetOrganizationEncryptionKey(ctx, db.SetOrganizationEncryptionKeyParams{
+		OrganizationID: organization.ID,
+		Location:       location,
+		KmsKeyName:     req.Msg.KmsKeyName,
+		Status:         status,
+		AccountID:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "encryption key")
+	}
+
+	key, err := s.key(ctx, organization, location, agents[location])
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.EncryptionKeySet, map[string]any{
+		"location":     location,
+		"kms_key_name": req.Msg.KmsKeyName,
+	})
+	s.reconcile(ctx, organization.PublicID, key)
+
+	return connect.NewResponse(&libopsv1.SetEncryptionKeyResponse{Key: key}), nil
+}
+
+// CheckEncryptionKeys checks every key again and records what it found.
+func (s *EncryptionKeyService) CheckEncryptionKeys(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CheckEncryptionKeysRequest],
+) (*connect.Response[libopsv1.CheckEncryptionKeysResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if s.keys == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errKeysDisabled)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := s.serviceAgents(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.ListOrganizationEncryptionKeys(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	keys := make([]*libopsv1.EncryptionKey, 0, len(rows))
+	for _, row := range rows {
+		status, problem := s.check(ctx, row.KmsKeyName, agents[row.Location])
+		err := s.db.UpdateOrganizationEncryptionKeyStatus(ctx, db.UpdateOrganizationEncryptionKeyStatusParams{
+			Status:         status,
+			StatusMessage:  service.ToNullString(problem),
+			OrganizationID: organization.ID,
+			Location:       row.Location,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+
+		key, err := s.key(ctx, organization, row.Location, agents[row.Location])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return connect.NewResponse(&libopsv1.CheckEncryptionKeysResponse{Keys: keys}), nil
+}
+
+// DeleteEncryptionKey removes the organization's key for a location and queues
+// its reconciliation.
+func (s *EncryptionKeyService) DeleteEncryptionKey(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteEncryptionKeyRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if !locationPattern.MatchString(req.Msg.Location) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid location"))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteOrganizationEncryptionKey(ctx, db.DeleteOrganizationEncryptionKeyParams{
+		OrganizationID: organization.ID,
+		Location:       req.Msg.Location,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the organization has no key in %s", req.Msg.Location))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.EncryptionKeyDelete, map[string]any{
+		"location": req.Msg.Location,
+	})
+	s.reconcile(ctx, organization.PublicID, &libopsv1.EncryptionKey{OrganizationId: organization.PublicID, Location: req.Msg.Location})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// serviceAgents maps every location the organization's data is in to the
+// service agents that encrypt it there. A location's list is empty until the
+// GCP project holding the data exists.
+func (s *EncryptionKeyService) serviceAgents(ctx context.Context, organization db.GetOrganizationRow) (map[string][]string, error) {
+	agents := map[string][]string{}
+
+	stateLocation, err := s.db.GetOrganizationStateLocation(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if stateLocation != string(db.OrganizationsLocationUnspecified) {
+		agents[stateLocation] = nil
+		if organization.GcpProjectNumber.String != "" {
+			agents[stateLocation] = append(agents[stateLocation], gcp.StorageServiceAgent(organization.GcpProjectNumber.String))
+		}
+	}
+
+	regions, err := s.db.ListOrganizationProjectRegions(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, region := range regions {
+		if _, ok := agents[region.GcpRegion.String]; !ok {
+			agents[region.GcpRegion.String] = nil
+		}
+	}
+
+	projects, err := s.db.ListOrganizationGcpProjects(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, project := range projects {
+		if !project.GcpRegion.Valid {
+			continue
+		}
+		agent := gcp.CloudSQLServiceAgent(project.GcpProjectNumber.String)
+		if !slices.Contains(agents[project.GcpRegion.String], agent) {
+			agents[project.GcpRegion.String] = append(agents[project.GcpRegion.String], agent)
+		}
+	}
+	return agents, nil
+}
+
+// check reads a key and reports whether every agent can use it. A usable key
+// nothing needs yet is pending.
+func (s *EncryptionKeyService) check(ctx context.Context, name string, agents []string) (db.OrganizationEncryptionKeysStatus, string) {
+	if err := gcp.CheckKMSKey(ctx, s.keys, name, agents); err != nil {
+		if !errors.Is(err, gcp.ErrKMSKeyInaccessible) {
+			slog.Warn("Encryption key check failed", "kms_key_name", name, "error", err)
+		}
+		return db.OrganizationEncryptionKeysStatusUnhealthy, err.Error()
+	}
+	if len(agents) == 0 {
+		return db.OrganizationEncryptionKeysStatusPending, ""
+	}
+	return db.OrganizationEncryptionKeysStatusHealthy, ""
+}
+
+// key reads back one of the organization's keys.
+func (s *EncryptionKeyService) key(ctx context.Context, organization db.GetOrganizationRow, location string, agents []string) (*libopsv1.EncryptionKey, error) {
+	rows, err := s.db.ListOrganizationEncryptionKeys(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, row := range rows {
+		if row.Location == location {
+			return EncryptionKeyToProto(row, organization.PublicID, agents), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("encryption key in %s not found after saving it", location))
+}
+
+// reconcile queues the organization's terraform, which applies its keys.
+func (s *EncryptionKeyService) reconcile(ctx context.Context, organizationID string, key *libopsv1.EncryptionKey) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeOrganizationUpdated, organizationID, &organizationID, nil, nil, key); err != nil {
+		slog.Error("Failed to emit organization updated event", "error", err, "organization_id", organizationID)
+	}
+}
+
+// EncryptionKeyToProto converts an organization's key to its API form.
+func EncryptionKeyToProto(row db.OrganizationEncryptionKey, organizationID string, agents []string) *libopsv1.EncryptionKey {
+	key := &libopsv1.EncryptionKey{
+		OrganizationId: organizationID,
+		Location:       row.Location,
+		KmsKeyName:     row.KmsKeyName,
+		Status:         encryptionKeyStatuses[row.Status],
+		StatusMessage:  row.StatusMessage.String,
+		ServiceAgents:  agents,
+	}
+	if row.CheckedAt.Valid {
+		key.CheckedAt = row.CheckedAt.Time.Unix()
+	}
+	if row.UpdatedAt.Valid {
+		key.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return key
+}
+
+var encryptionKeyStatuses = map[db.OrganizationEncryptionKeysStatus]libopsv1.EncryptionKeyStatus{
+	db.OrganizationEncryptionKeysStatusPending:   libopsv1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_PENDING,
+	db.OrganizationEncryptionKeysStatusHealthy:   libopsv1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_HEALTHY,
+	db.OrganizationEncryptionKeysStatusUnhealthy: libopsv1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNHEALTHY,
+}
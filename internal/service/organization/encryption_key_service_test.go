@@ -0,0 +1,156 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+type fakeKMSKeys map[string]*gcp.KMSKey
+
+func (f fakeKMSKeys) Key(ctx context.Context, name string) (*gcp.KMSKey, error) {
+	key, ok := f[name]
+	if !ok {
+		return nil, gcp.ErrKMSKeyInaccessible
+	}
+	return key, nil
+}
+
+const (
+	stateKey    = "projects/campus-kms/locations/us/keyRings/libops/cryptoKeys/state"
+	databaseKey = "projects/campus-kms/locations/us-central1/keyRings/libops/cryptoKeys/databases"
+	unusedKey   = "projects/campus-kms/locations/europe-west1/keyRings/libops/cryptoKeys/databases"
+)
+
+// newEncryptionKeyMock is an organization with its state bucket in the US and
+// one project in us-central1, keeping its keys in stored.
+func newEncryptionKeyMock(orgID string, stored map[string]db.OrganizationEncryptionKey) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID, GcpProjectNumber: sql.NullString{String: "111", Valid: true}}, nil
+		},
+		GetOrganizationStateLocationFunc: func(ctx context.Context, id int64) (string, error) {
+			return "us", nil
+		},
+		ListOrganizationProjectRegionsFunc: func(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectRegionsRow, error) {
+			return []db.ListOrganizationProjectRegionsRow{{GcpRegion: sql.NullString{String: "us-central1", Valid: true}}}, nil
+		},
+		ListOrganizationGcpProjectsFunc: func(ctx context.Context, organizationID int64) ([]db.ListOrganizationGcpProjectsRow, error) {
+			return []db.ListOrganizationGcpProjectsRow{{
+				GcpProjectNumber: sql.NullString{String: "222", Valid: true},
+				GcpRegion:        sql.NullString{String: "us-central1", Valid: true},
+			}}, nil
+		},
+		ListOrganizationEncryptionKeysFunc: func(ctx context.Context, organizationID int64) ([]db.OrganizationEncryptionKey, error) {
+			var keys []db.OrganizationEncryptionKey
+			for _, key := range stored {
+				keys = append(keys, key)
+			}
+			return keys, nil
+		},
+		SetOrganizationEncryptionKeyFunc: func(ctx context.Context, arg db.SetOrganizationEncryptionKeyParams) error {
+			stored[arg.Location] = db.OrganizationEncryptionKey{
+				OrganizationID: arg.OrganizationID,
+				Location:       arg.Location,
+				KmsKeyName:     arg.KmsKeyName,
+				Status:         arg.Status,
+				StatusMessage:  arg.StatusMessage,
+			}
+			return nil
+		},
+		UpdateOrganizationEncryptionKeyStatusFunc: func(ctx context.Context, arg db.UpdateOrganizationEncryptionKeyStatusParams) error {
+			key := stored[arg.Location]
+			key.Status = arg.Status
+			key.StatusMessage = arg.StatusMessage
+			stored[arg.Location] = key
+			return nil
+		},
+	}
+}
+
+// TestSetEncryptionKey tests that a key is only taken once every service agent
+// in its location can use it.
+func TestSetEncryptionKey(t *testing.T) {
+	orgID := uuid.NewString()
+	stored := map[string]db.OrganizationEncryptionKey{}
+	mock := newEncryptionKeyMock(orgID, stored)
+	keys := fakeKMSKeys{
+		stateKey:    {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "ENABLED", Users: []string{"serviceAccount:" + gcp.StorageServiceAgent("111")}},
+		databaseKey: {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "ENABLED"},
+		unusedKey:   {Purpose: "ENCRYPT_DECRYPT", PrimaryState: "ENABLED"},
+	}
+	svc := NewEncryptionKeyService(mock, keys, nil, audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+
+	set := func(name string) (*libopsv1.EncryptionKey, error) {
+		resp, err := svc.SetEncryptionKey(ctx, connect.NewRequest(&libopsv1.SetEncryptionKeyRequest{OrganizationId: orgID, KmsKeyName: name}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Key, nil
+	}
+
+	_, err := set("projects/campus-kms/locations/global/keyRings/libops/cryptoKeys/state")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = set(databaseKey)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "the project's Cloud SQL agent has no grant")
+	assert.Contains(t, err.Error(), gcp.CloudSQLServiceAgent("222"))
+	assert.Empty(t, stored)
+
+	key, err := set(stateKey)
+	require.NoError(t, err)
+	assert.Equal(t, "us", key.Location)
+	assert.Equal(t, libopsv1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_HEALTHY, key.Status)
+	assert.Equal(t, []string{gcp.StorageServiceAgent("111")}, key.ServiceAgents)
+
+	key, err = set(unusedKey)
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_PENDING, key.Status, "nothing is in europe-west1")
+
+	list, err := svc.ListEncryptionKeys(ctx, connect.NewRequest(&libopsv1.ListEncryptionKeysRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Len(t, list.Msg.Keys, 2)
+	assert.Equal(t, []string{"us-central1"}, list.Msg.GoogleManagedLocations)
+
+	// Revoking the storage agent's grant shows up on the next check
+	keys[stateKey].Users = nil
+	checked, err := svc.CheckEncryptionKeys(ctx, connect.NewRequest(&libopsv1.CheckEncryptionKeysRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	require.Len(t, checked.Msg.Keys, 2)
+	assert.Equal(t, db.OrganizationEncryptionKeysStatusUnhealthy, stored["us"].Status)
+	assert.Contains(t, stored["us"].StatusMessage.String, gcp.StorageServiceAgent("111"))
+	assert.Equal(t, db.OrganizationEncryptionKeysStatusPending, stored["europe-west1"].Status)
+}
+
+// TestSetEncryptionKey_Disabled tests that keys can't be set when the platform
+// can't check them.
+func TestSetEncryptionKey_Disabled(t *testing.T) {
+	orgID := uuid.NewString()
+	mock := newEncryptionKeyMock(orgID, map[string]db.OrganizationEncryptionKey{})
+	svc := NewEncryptionKeyService(mock, nil, nil, audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+
+	_, err := svc.SetEncryptionKey(ctx, connect.NewRequest(&libopsv1.SetEncryptionKeyRequest{OrganizationId: orgID, KmsKeyName: stateKey}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	list, err := svc.ListEncryptionKeys(ctx, connect.NewRequest(&libopsv1.ListEncryptionKeysRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Empty(t, list.Msg.Keys)
+	assert.Equal(t, []string{"us", "us-central1"}, list.Msg.GoogleManagedLocations)
+}
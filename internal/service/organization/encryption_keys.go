@@ -0,0 +1,56 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// RotateEncryptionKey rotates the keys an organization's secrets are sealed
+// with and re-wraps every stored secret with the new data key (admin only).
+// Secrets that fail to re-wrap stay readable on their earlier key and are
+// reported so the rotation can be run again.
+func (s *AdminOrganizationService) RotateEncryptionKey(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RotateEncryptionKeyRequest],
+) (*connect.Response[libopsv1.RotateEncryptionKeyResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, uuid.MustParse(req.Msg.OrganizationId))
+	if err != nil {
+		return nil, err
+	}
+
+	rotation, err := s.rotateSecrets(ctx, organization.ID, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to rotate encryption key", "organization_id", organization.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate encryption key"))
+	}
+
+	slog.Info("Organization encryption key rotated",
+		"organization_id", organization.PublicID,
+		"key_version", rotation.KeyVersion,
+		"rewrapped_secrets", rotation.Rewrapped,
+		"failed_secrets", len(rotation.Failed),
+		"account_id", userInfo.AccountID)
+
+	return connect.NewResponse(&libopsv1.RotateEncryptionKeyResponse{
+		KeyVersion:       rotation.KeyVersion,
+		RewrappedSecrets: int32(rotation.Rewrapped),
+		FailedPaths:      rotation.Failed,
+	}), nil
+}
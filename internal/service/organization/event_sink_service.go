@@ -0,0 +1,436 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// maxEventSinks caps how many event sinks an organization can add.
+const maxEventSinks = 10
+
+// maxEventSinkTypes caps how many event type prefixes a sink can filter on.
+const maxEventSinkTypes = 50
+
+// eventSinkSecretBytes is the size of a sink's HMAC signing key.
+const eventSinkSecretBytes = 32
+
+var (
+	// pubsubTopicName matches a full Pub/Sub topic resource name.
+	pubsubTopicName = regexp.MustCompile(`^projects/[a-z][a-z0-9-]{4,28}[a-z0-9]/topics/[A-Za-z][A-Za-z0-9._~+%-]{2,254}$`)
+	// storageBucketName matches a Cloud Storage bucket name without dots.
+	storageBucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`)
+	// eventTypePrefix matches an event type or a prefix of one, e.g. io.libops.deployment.
+	eventTypePrefix = regexp.MustCompile(`^io\.libops\.[a-z0-9_.]*$`)
+)
+
+// EventSinkService implements the EventSinkService API.
+type EventSinkService struct {
+	db      db.Querier
+	emitter *events.Emitter
+}
+
+// Compile-time check.
+var _ libopsv1connect.EventSinkServiceHandler = (*EventSinkService)(nil)
+
+// NewEventSinkService creates a new EventSinkService instance.
+func NewEventSinkService(querier db.Querier, emitter *events.Emitter) *EventSinkService {
+	return &EventSinkService{
+		db:      querier,
+		emitter: emitter,
+	}
+}
+
+// ListEventSinks lists an organization's event sinks.
+func (s *EventSinkService) ListEventSinks(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListEventSinksRequest],
+) (*connect.Response[libopsv1.ListEventSinksResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListEventSinks(ctx, db.ListEventSinksParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list event sinks", "error", err, "organization_id", organization.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	sinks := make([]*libopsv1.EventSink, 0, len(rows))
+	for _, row := range rows {
+		sinks = append(sinks, EventSinkToProto(db.GetEventSinkRow(row), organization))
+	}
+
+	return connect.NewResponse(&libopsv1.ListEventSinksResponse{
+		Sinks:         sinks,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// CreateEventSink adds a Pub/Sub topic or Cloud Storage bucket to an organization and returns
+// the secret its events are signed with.
+func (s *EventSinkService) CreateEventSink(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateEventSinkRequest],
+) (*connect.Response[libopsv1.CreateEventSinkResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.StringLength("name", msg.Name, 1, 255); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	kind, err := eventSinkKindToDB(msg.Kind)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateEventSinkDestination(kind, msg.Destination); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	eventTypes, err := eventTypesToJSON(msg.EventTypes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountEventSinks(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count event sinks: %w", err))
+	}
+	if count >= maxEventSinks {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("organization already has the maximum of %d event sinks", maxEventSinks))
+	}
+
+	secret, err := eventSinkSecret()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	params := db.CreateEventSinkParams{
+		PublicID:       uuid.New().String(),
+		OrganizationID: organization.ID,
+		Name:           msg.Name,
+		Kind:           kind,
+		Destination:    msg.Destination,
+		EventTypes:     eventTypes,
+		SigningSecret:  secret,
+		Enabled:        true,
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	}
+	if err := s.db.CreateEventSink(ctx, params); err != nil {
+		slog.Error("Failed to create event sink", "error", err, "organization_id", organization.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	sink, err := s.getSink(ctx, organization.ID, params.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.CreateEventSinkResponse{
+		Sink:          EventSinkToProto(sink, organization),
+		SigningSecret: secret,
+	}), nil
+}
+
+// UpdateEventSink updates an event sink's destination, event types or enabled state.
+// The kind and signing secret never change; replace the sink to change them.
+func (s *EventSinkService) UpdateEventSink(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateEventSinkRequest],
+) (*connect.Response[libopsv1.UpdateEventSinkResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(msg.SinkId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid sink_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := s.getSink(ctx, organization.ID, msg.SinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateEventSinkParams{
+		ID:          sink.ID,
+		Name:        sink.Name,
+		Destination: sink.Destination,
+		EventTypes:  sink.EventTypes,
+		Enabled:     sink.Enabled,
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "name") && msg.Name != nil {
+		if err := validation.StringLength("name", *msg.Name, 1, 255); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Name = *msg.Name
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "destination") && msg.Destination != nil {
+		if err := validateEventSinkDestination(sink.Kind, *msg.Destination); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Destination = *msg.Destination
+	}
+	// An empty list only goes back to publishing every event when the mask names it explicitly
+	if (msg.UpdateMask != nil && service.ShouldUpdateField(msg.UpdateMask, "event_types")) || len(msg.EventTypes) > 0 {
+		eventTypes, err := eventTypesToJSON(msg.EventTypes)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.EventTypes = eventTypes
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "enabled") && msg.Enabled != nil {
+		params.Enabled = *msg.Enabled
+	}
+
+	if err := s.db.UpdateEventSink(ctx, params); err != nil {
+		slog.Error("Failed to update event sink", "error", err, "sink_id", msg.SinkId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	sink, err = s.getSink(ctx, organization.ID, msg.SinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateEventSinkResponse{
+		Sink: EventSinkToProto(sink, organization),
+	}), nil
+}
+
+// DeleteEventSink removes an event sink from an organization.
+func (s *EventSinkService) DeleteEventSink(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteEventSinkRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.SinkId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid sink_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteEventSink(ctx, db.DeleteEventSinkParams{
+		OrganizationID: organization.ID,
+		PublicID:       req.Msg.SinkId,
+	})
+	if err != nil {
+		slog.Error("Failed to delete event sink", "error", err, "sink_id", req.Msg.SinkId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("event sink not found"))
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// TestEventSink queues a test event that the event router publishes to the sink, whatever
+// event types the sink filters on. The outcome is recorded on the sink.
+func (s *EventSinkService) TestEventSink(
+	ctx context.Context,
+	req *connect.Request[libopsv1.TestEventSinkRequest],
+) (*connect.Response[libopsv1.TestEventSinkResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.SinkId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid sink_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	sink, err := s.getSink(ctx, organization.ID, req.Msg.SinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoSink := EventSinkToProto(sink, organization)
+	if s.emitter == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("event delivery is not configured"))
+	}
+	// The sink ID is the subject so the event router only publishes to this sink
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeEventSinkTest, sink.PublicID, &organization.PublicID, nil, nil, protoSink); err != nil {
+		slog.Error("Failed to queue event sink test", "error", err, "sink_id", sink.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to queue test event: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.TestEventSinkResponse{
+		Sink: protoSink,
+	}), nil
+}
+
+func (s *EventSinkService) getSink(ctx context.Context, organizationID int64, sinkID string) (db.GetEventSinkRow, error) {
+	sink, err := s.db.GetEventSink(ctx, db.GetEventSinkParams{
+		OrganizationID: organizationID,
+		PublicID:       sinkID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return sink, connect.NewError(connect.CodeNotFound, fmt.Errorf("event sink not found"))
+	}
+	if err != nil {
+		return sink, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get event sink: %w", err))
+	}
+	return sink, nil
+}
+
+// validateEventSinkDestination checks a destination is a topic or bucket name of the sink's kind.
+// Which topics and buckets can actually be written is up to the customer's IAM grants to the
+// organization's platform service account.
+func validateEventSinkDestination(kind db.EventSinksKind, destination string) error {
+	switch kind {
+	case db.EventSinksKindPubsubTopic:
+		if !pubsubTopicName.MatchString(destination) || strings.Contains(destination, "/topics/goog") {
+			return fmt.Errorf("destination must be a Pub/Sub topic, e.g. projects/my-project/topics/libops-events")
+		}
+		return nil
+	case db.EventSinksKindStorageBucket:
+		if !storageBucketName.MatchString(destination) || strings.HasPrefix(destination, "goog") {
+			return fmt.Errorf("destination must be a Cloud Storage bucket name, e.g. my-libops-events")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported event sink kind %q", kind)
+	}
+}
+
+// eventTypesToJSON converts event type prefixes to the stored JSON array, dropping duplicates.
+func eventTypesToJSON(eventTypes []string) (json.RawMessage, error) {
+	if len(eventTypes) > maxEventSinkTypes {
+		return nil, fmt.Errorf("at most %d event types can be set", maxEventSinkTypes)
+	}
+	prefixes := make([]string, 0, len(eventTypes))
+	seen := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if len(eventType) > 255 || !eventTypePrefix.MatchString(eventType) {
+			return nil, fmt.Errorf("event type %q must be an io.libops. event type or prefix", eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			prefixes = append(prefixes, eventType)
+		}
+	}
+	return json.Marshal(prefixes)
+}
+
+func eventTypesFromJSON(raw json.RawMessage) []string {
+	var eventTypes []string
+	if err := json.Unmarshal(raw, &eventTypes); err != nil {
+		return nil
+	}
+	return eventTypes
+}
+
+// eventSinkSecret generates a sink's signing key, hex encoded so customers can paste it anywhere.
+func eventSinkSecret() (string, error) {
+	b := make([]byte, eventSinkSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate signing secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func eventSinkKindToDB(kind libopsv1.EventSinkKind) (db.EventSinksKind, error) {
+	switch kind {
+	case libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC:
+		return db.EventSinksKindPubsubTopic, nil
+	case libopsv1.EventSinkKind_EVENT_SINK_KIND_STORAGE_BUCKET:
+		return db.EventSinksKindStorageBucket, nil
+	default:
+		return "", fmt.Errorf("kind is required")
+	}
+}
+
+func eventSinkKindToProto(kind db.EventSinksKind) libopsv1.EventSinkKind {
+	switch kind {
+	case db.EventSinksKindPubsubTopic:
+		return libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC
+	case db.EventSinksKindStorageBucket:
+		return libopsv1.EventSinkKind_EVENT_SINK_KIND_STORAGE_BUCKET
+	default:
+		return libopsv1.EventSinkKind_EVENT_SINK_KIND_UNSPECIFIED
+	}
+}
+
+// EventSinkToProto converts a sink row without its signing secret. The publisher principal is
+// empty until the organization's GCP project has been provisioned.
+func EventSinkToProto(sink db.GetEventSinkRow, organization db.GetOrganizationRow) *libopsv1.EventSink {
+	pb := &libopsv1.EventSink{
+		SinkId:         sink.PublicID,
+		OrganizationId: organization.PublicID,
+		Name:           sink.Name,
+		Kind:           eventSinkKindToProto(sink.Kind),
+		Destination:    sink.Destination,
+		EventTypes:     eventTypesFromJSON(sink.EventTypes),
+		Enabled:        sink.Enabled,
+		LastError:      sink.LastError.String,
+	}
+	if organization.GcpProjectID.Valid && organization.GcpProjectID.String != "" {
+		pb.PublisherPrincipal = gcp.GetPlatformServiceAccountEmail(organization.GcpProjectID.String)
+	}
+	if sink.LastDeliveryAt.Valid {
+		pb.LastDeliveryAt = sink.LastDeliveryAt.Time.Unix()
+	}
+	if sink.CreatedAt.Valid {
+		pb.CreatedAt = sink.CreatedAt.Time.Unix()
+	}
+	return pb
+}
@@ -0,0 +1,108 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestEventSinks tests that sinks are created with a signing secret returned only once,
+// that destinations and event types are validated, and that updates keep the kind.
+func TestEventSinks(t *testing.T) {
+	orgID := uuid.NewString()
+	var stored *db.GetEventSinkRow
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID, GcpProjectID: sql.NullString{String: "libops-vandelay", Valid: true}}, nil
+		},
+		CreateEventSinkFunc: func(ctx context.Context, arg db.CreateEventSinkParams) error {
+			stored = &db.GetEventSinkRow{
+				ID:             7,
+				PublicID:       arg.PublicID,
+				OrganizationID: arg.OrganizationID,
+				Name:           arg.Name,
+				Kind:           arg.Kind,
+				Destination:    arg.Destination,
+				EventTypes:     arg.EventTypes,
+				SigningSecret:  arg.SigningSecret,
+				Enabled:        arg.Enabled,
+			}
+			return nil
+		},
+		GetEventSinkFunc: func(ctx context.Context, arg db.GetEventSinkParams) (db.GetEventSinkRow, error) {
+			if stored == nil || arg.PublicID != stored.PublicID {
+				return db.GetEventSinkRow{}, sql.ErrNoRows
+			}
+			return *stored, nil
+		},
+		UpdateEventSinkFunc: func(ctx context.Context, arg db.UpdateEventSinkParams) error {
+			stored.Name, stored.Destination, stored.EventTypes, stored.Enabled = arg.Name, arg.Destination, arg.EventTypes, arg.Enabled
+			return nil
+		},
+	}
+	svc := NewEventSinkService(mock, nil)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+
+	invalid := []*libopsv1.CreateEventSinkRequest{
+		{Kind: libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC, Destination: "libops-events"},
+		{Kind: libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC, Destination: "projects/vandelay/topics/goog-events"},
+		{Kind: libopsv1.EventSinkKind_EVENT_SINK_KIND_STORAGE_BUCKET, Destination: "projects/vandelay-prod/topics/libops"},
+		{Kind: libopsv1.EventSinkKind_EVENT_SINK_KIND_STORAGE_BUCKET, Destination: "vandelay-events", EventTypes: []string{"com.example."}},
+		{Destination: "vandelay-events"},
+	}
+	for _, req := range invalid {
+		req.OrganizationId, req.Name = orgID, "Automation"
+		_, err := svc.CreateEventSink(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+
+	created, err := svc.CreateEventSink(ctx, connect.NewRequest(&libopsv1.CreateEventSinkRequest{
+		OrganizationId: orgID,
+		Name:           "Automation",
+		Kind:           libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC,
+		Destination:    "projects/vandelay-prod/topics/libops-events",
+		EventTypes:     []string{"io.libops.deployment.", "io.libops.deployment."},
+	}))
+	require.NoError(t, err)
+	assert.Len(t, created.Msg.SigningSecret, 2*eventSinkSecretBytes)
+	assert.Equal(t, created.Msg.SigningSecret, stored.SigningSecret)
+	sink := created.Msg.Sink
+	assert.Equal(t, []string{"io.libops.deployment."}, sink.EventTypes, "duplicates are dropped")
+	assert.Equal(t, "libops-platform@libops-vandelay.iam.gserviceaccount.com", sink.PublisherPrincipal)
+
+	updated, err := svc.UpdateEventSink(ctx, connect.NewRequest(&libopsv1.UpdateEventSinkRequest{
+		OrganizationId: orgID,
+		SinkId:         sink.SinkId,
+		Enabled:        proto.Bool(false),
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"enabled", "event_types"}},
+	}))
+	require.NoError(t, err)
+	assert.False(t, updated.Msg.Sink.Enabled)
+	assert.Empty(t, updated.Msg.Sink.EventTypes, "a masked empty list publishes every event")
+	assert.Equal(t, libopsv1.EventSinkKind_EVENT_SINK_KIND_PUBSUB_TOPIC, updated.Msg.Sink.Kind)
+
+	_, err = svc.UpdateEventSink(ctx, connect.NewRequest(&libopsv1.UpdateEventSinkRequest{
+		OrganizationId: orgID,
+		SinkId:         sink.SinkId,
+		Destination:    proto.String("vandelay-events"),
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a topic sink can't point at a bucket")
+
+	_, err = svc.TestEventSink(ctx, connect.NewRequest(&libopsv1.TestEventSinkRequest{OrganizationId: orgID, SinkId: sink.SinkId}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err), "tests need the event emitter")
+}
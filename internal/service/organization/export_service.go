@@ -0,0 +1,230 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// ExportService implements the ExportService API.
+type ExportService struct {
+	db          db.Querier
+	storage     export.Storage
+	bucket      string
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.ExportServiceHandler = (*ExportService)(nil)
+
+// NewExportService creates a new ExportService instance. Bundles are built by an
+// export.Runner into bucket; storage is nil when exports aren't configured.
+func NewExportService(querier db.Querier, storage export.Storage, bucket string, auditLogger *audit.Logger) *ExportService {
+	return &ExportService{
+		db:          querier,
+		storage:     storage,
+		bucket:      bucket,
+		auditLogger: auditLogger,
+	}
+}
+
+// ExportOrganization queues an export of an organization.
+func (s *ExportService) ExportOrganization(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ExportOrganizationRequest],
+) (*connect.Response[libopsv1.ExportOrganizationResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if s.storage == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("organization exports are not enabled"))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.db.CountActiveOrganizationExports(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to count organization exports", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if active > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("an export of this organization is already in progress"))
+	}
+
+	exportID := uuid.New().String()
+	err = s.db.CreateOrganizationExport(ctx, db.CreateOrganizationExportParams{
+		PublicID:       exportID,
+		OrganizationID: organization.ID,
+		IncludeSecrets: msg.IncludeSecrets,
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		slog.Error("Failed to create organization export", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationExportRequest, map[string]any{
+		"export_id":       exportID,
+		"include_secrets": msg.IncludeSecrets,
+	})
+
+	row, err := s.getExport(ctx, organization.ID, exportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.ExportOrganizationResponse{
+		Export: exportToProto(row, organization.PublicID),
+	}), nil
+}
+
+// GetOrganizationExport gets an export with a download URL once it succeeded.
+func (s *ExportService) GetOrganizationExport(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetOrganizationExportRequest],
+) (*connect.Response[libopsv1.GetOrganizationExportResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.ExportId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid export_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.getExport(ctx, organization.ID, req.Msg.ExportId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoExport := exportToProto(row, organization.PublicID)
+	if protoExport.Status == libopsv1.ExportStatus_EXPORT_STATUS_SUCCEEDED && s.storage != nil &&
+		row.ObjectName.Valid && row.ExpiresAt.Valid && row.ExpiresAt.Time.After(time.Now()) {
+		protoExport.DownloadUrl, err = s.storage.SignedURL(ctx, s.bucket, row.ObjectName.String, export.DownloadTTL)
+		if err != nil {
+			slog.Error("Failed to sign export download URL", "error", err, "export_id", row.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create download URL"))
+		}
+	}
+
+	return connect.NewResponse(&libopsv1.GetOrganizationExportResponse{
+		Export: protoExport,
+	}), nil
+}
+
+// ListOrganizationExports lists an organization's exports, newest first.
+func (s *ExportService) ListOrganizationExports(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListOrganizationExportsRequest],
+) (*connect.Response[libopsv1.ListOrganizationExportsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListOrganizationExports(ctx, db.ListOrganizationExportsParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list organization exports", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	exports := make([]*libopsv1.OrganizationExport, 0, len(rows))
+	for _, row := range rows {
+		exports = append(exports, exportToProto(db.GetOrganizationExportRow(row), organization.PublicID))
+	}
+
+	return connect.NewResponse(&libopsv1.ListOrganizationExportsResponse{
+		Exports:       exports,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// getExport returns an organization's export, NotFound when it belongs to another organization.
+func (s *ExportService) getExport(ctx context.Context, organizationID int64, exportID string) (db.GetOrganizationExportRow, error) {
+	row, err := s.db.GetOrganizationExport(ctx, exportID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && row.OrganizationID != organizationID) {
+		return row, connect.NewError(connect.CodeNotFound, fmt.Errorf("export not found"))
+	}
+	if err != nil {
+		return row, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get export: %w", err))
+	}
+	return row, nil
+}
+
+func exportToProto(row db.GetOrganizationExportRow, organizationPublicID string) *libopsv1.OrganizationExport {
+	protoExport := &libopsv1.OrganizationExport{
+		ExportId:       row.PublicID,
+		OrganizationId: organizationPublicID,
+		Status:         exportStatusToProto(row.Status),
+		IncludeSecrets: row.IncludeSecrets,
+		Error:          row.Error.String,
+		SizeBytes:      row.SizeBytes.Int64,
+		Sha256:         row.Sha256.String,
+	}
+	if row.ExpiresAt.Valid {
+		protoExport.ExpiresAt = row.ExpiresAt.Time.Unix()
+	}
+	if row.CreatedAt.Valid {
+		protoExport.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.CompletedAt.Valid {
+		protoExport.CompletedAt = row.CompletedAt.Time.Unix()
+	}
+	return protoExport
+}
+
+func exportStatusToProto(status db.OrganizationExportsStatus) libopsv1.ExportStatus {
+	switch status {
+	case db.OrganizationExportsStatusPending:
+		return libopsv1.ExportStatus_EXPORT_STATUS_PENDING
+	case db.OrganizationExportsStatusRunning:
+		return libopsv1.ExportStatus_EXPORT_STATUS_RUNNING
+	case db.OrganizationExportsStatusSucceeded:
+		return libopsv1.ExportStatus_EXPORT_STATUS_SUCCEEDED
+	case db.OrganizationExportsStatusFailed:
+		return libopsv1.ExportStatus_EXPORT_STATUS_FAILED
+	default:
+		return libopsv1.ExportStatus_EXPORT_STATUS_UNSPECIFIED
+	}
+}
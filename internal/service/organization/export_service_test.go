@@ -0,0 +1,132 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// signingStorage signs URLs without Cloud Storage
+type signingStorage struct {
+	export.Storage
+}
+
+func (signingStorage) SignedURL(ctx context.Context, bucket, name string, ttl time.Duration) (string, error) {
+	return "https://storage.googleapis.com/" + bucket + "/" + name + "?ttl=" + ttl.String(), nil
+}
+
+// TestExportOrganization tests that an export is queued and audited, and that a
+// second export is refused while the first is in progress.
+func TestExportOrganization(t *testing.T) {
+	orgID := uuid.NewString()
+	var created []db.CreateOrganizationExportParams
+	var audited []db.CreateAuditEventParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID}, nil
+		},
+		CountActiveOrganizationExportsFunc: func(ctx context.Context, organizationID int64) (int64, error) {
+			return int64(len(created)), nil
+		},
+		CreateOrganizationExportFunc: func(ctx context.Context, arg db.CreateOrganizationExportParams) error {
+			created = append(created, arg)
+			return nil
+		},
+		GetOrganizationExportFunc: func(ctx context.Context, publicID string) (db.GetOrganizationExportRow, error) {
+			return db.GetOrganizationExportRow{
+				PublicID:       publicID,
+				OrganizationID: 7,
+				IncludeSecrets: created[0].IncludeSecrets,
+				Status:         db.OrganizationExportsStatusPending,
+			}, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg)
+			return nil
+		},
+	}
+	svc := NewExportService(mock, signingStorage{}, "libops-exports", audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	resp, err := svc.ExportOrganization(ctx, connect.NewRequest(&libopsv1.ExportOrganizationRequest{
+		OrganizationId: orgID,
+		IncludeSecrets: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, created[0].PublicID, resp.Msg.Export.ExportId)
+	assert.Equal(t, int64(3), created[0].CreatedBy.Int64)
+	assert.True(t, resp.Msg.Export.IncludeSecrets)
+	assert.Equal(t, libopsv1.ExportStatus_EXPORT_STATUS_PENDING, resp.Msg.Export.Status)
+	require.Len(t, audited, 1)
+	assert.Equal(t, string(audit.OrganizationExportRequest), audited[0].EventName)
+
+	_, err = svc.ExportOrganization(ctx, connect.NewRequest(&libopsv1.ExportOrganizationRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = NewExportService(mock, nil, "", audit.New(mock)).ExportOrganization(ctx,
+		connect.NewRequest(&libopsv1.ExportOrganizationRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err), "exports need a bucket")
+}
+
+// TestGetOrganizationExport tests that only unexpired, succeeded exports of the
+// organization get a download URL.
+func TestGetOrganizationExport(t *testing.T) {
+	orgID, exportID := uuid.NewString(), uuid.NewString()
+	row := db.GetOrganizationExportRow{
+		PublicID:       exportID,
+		OrganizationID: 7,
+		Status:         db.OrganizationExportsStatusSucceeded,
+		ObjectName:     sql.NullString{String: orgID + "/" + exportID + ".tar.gz", Valid: true},
+		SizeBytes:      sql.NullInt64{Int64: 2048, Valid: true},
+		Sha256:         sql.NullString{String: "ab12", Valid: true},
+		ExpiresAt:      sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID}, nil
+		},
+		GetOrganizationExportFunc: func(ctx context.Context, publicID string) (db.GetOrganizationExportRow, error) {
+			return row, nil
+		},
+	}
+	svc := NewExportService(mock, signingStorage{}, "libops-exports", audit.New(mock))
+	get := func() (*libopsv1.OrganizationExport, error) {
+		resp, err := svc.GetOrganizationExport(context.Background(), connect.NewRequest(&libopsv1.GetOrganizationExportRequest{
+			OrganizationId: orgID,
+			ExportId:       exportID,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Export, nil
+	}
+
+	got, err := get()
+	require.NoError(t, err)
+	assert.Equal(t, "https://storage.googleapis.com/libops-exports/"+orgID+"/"+exportID+".tar.gz?ttl=1h0m0s", got.DownloadUrl)
+	assert.Equal(t, int64(2048), got.SizeBytes)
+	assert.Equal(t, "ab12", got.Sha256)
+
+	row.ExpiresAt.Time = time.Now().Add(-time.Minute)
+	got, err = get()
+	require.NoError(t, err)
+	assert.Empty(t, got.DownloadUrl, "expired bundles are deleted")
+
+	row.OrganizationID = 8
+	_, err = get()
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "exports of other organizations are hidden")
+}
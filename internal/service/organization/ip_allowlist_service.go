@@ -0,0 +1,140 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// IPAllowlistService implements the IpAllowlistService API.
+type IPAllowlistService struct {
+	db          db.Querier
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.IpAllowlistServiceHandler = (*IPAllowlistService)(nil)
+
+// NewIPAllowlistService creates a new IPAllowlistService instance.
+func NewIPAllowlistService(querier db.Querier, auditLogger *audit.Logger) *IPAllowlistService {
+	return &IPAllowlistService{
+		db:          querier,
+		auditLogger: auditLogger,
+	}
+}
+
+// GetIpAllowlist returns the networks an organization can be reached from.
+func (s *IPAllowlistService) GetIpAllowlist(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetIpAllowlistRequest],
+) (*connect.Response[libopsv1.GetIpAllowlistResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := s.db.GetOrganizationAllowedCidrs(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to get IP allowlist", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	var cidrs []string
+	if err := json.Unmarshal(raw, &cidrs); err != nil {
+		slog.Error("Failed to parse IP allowlist", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid IP allowlist: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.GetIpAllowlistResponse{
+		Allowlist: allowlistToProto(organization.PublicID, cidrs, req),
+	}), nil
+}
+
+// UpdateIpAllowlist replaces the networks an organization can be reached
+// from. The caller's own address has to stay allowed so an owner can't lock
+// everyone, themselves included, out of the organization.
+func (s *IPAllowlistService) UpdateIpAllowlist(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateIpAllowlistRequest],
+) (*connect.Response[libopsv1.UpdateIpAllowlistResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	prefixes, err := auth.ParseCIDRs(req.Msg.Cidrs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(prefixes) > 0 {
+		ip, known := auth.ClientIP(req.Peer().Addr, req.Header())
+		if !known {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("your current address couldn't be determined"))
+		}
+		if !auth.IPAllowed(prefixes, ip) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the allowlist must include your current address %s", ip))
+		}
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty list clears the allowlist, allowing any address
+	cidrs := auth.FormatCIDRs(prefixes)
+	var allowedCidrs []byte
+	if len(cidrs) > 0 {
+		allowedCidrs, err = json.Marshal(cidrs)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to marshal CIDRs: %w", err))
+		}
+	}
+
+	err = s.db.UpdateOrganizationAllowedCidrs(ctx, db.UpdateOrganizationAllowedCidrsParams{
+		AllowedCidrs: allowedCidrs,
+		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		ID:           organization.ID,
+	})
+	if err != nil {
+		slog.Error("Failed to update IP allowlist", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.IPAllowlistUpdate, map[string]any{
+		"cidrs": cidrs,
+	})
+
+	return connect.NewResponse(&libopsv1.UpdateIpAllowlistResponse{
+		Allowlist: allowlistToProto(organization.PublicID, cidrs, req),
+	}), nil
+}
+
+func allowlistToProto(organizationID string, cidrs []string, req connect.AnyRequest) *libopsv1.IpAllowlist {
+	allowlist := &libopsv1.IpAllowlist{
+		OrganizationId: organizationID,
+		Cidrs:          cidrs,
+	}
+	if ip, known := auth.ClientIP(req.Peer().Addr, req.Header()); known {
+		allowlist.ClientIp = ip.String()
+	}
+	return allowlist
+}
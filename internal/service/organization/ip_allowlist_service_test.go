@@ -0,0 +1,97 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+func TestIPAllowlist(t *testing.T) {
+	orgID := uuid.NewString()
+	stored := json.RawMessage("[]")
+	var audited []db.CreateAuditEventParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationAllowedCidrsFunc: func(ctx context.Context, id int64) (json.RawMessage, error) {
+			return stored, nil
+		},
+		UpdateOrganizationAllowedCidrsFunc: func(ctx context.Context, arg db.UpdateOrganizationAllowedCidrsParams) error {
+			stored = json.RawMessage("[]")
+			if arg.AllowedCidrs != nil {
+				stored = json.RawMessage(arg.AllowedCidrs)
+			}
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg)
+			return nil
+		},
+	}
+
+	// The test server is reached over loopback, so it trusts X-Forwarded-For
+	// like it would the load balancer's.
+	path, handler := libopsv1connect.NewIpAllowlistServiceHandler(NewIPAllowlistService(mock, audit.New(mock)))
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := libopsv1connect.NewIpAllowlistServiceClient(server.Client(), server.URL)
+
+	ctx := context.Background()
+	update := func(from string, cidrs ...string) (*libopsv1.IpAllowlist, error) {
+		req := connect.NewRequest(&libopsv1.UpdateIpAllowlistRequest{OrganizationId: orgID, Cidrs: cidrs})
+		req.Header().Set("X-Forwarded-For", from)
+		resp, err := client.UpdateIpAllowlist(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Allowlist, nil
+	}
+
+	_, err := update("192.0.2.10", "not-a-cidr")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = update("203.0.113.1", "192.0.2.0/24")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "owners can't lock themselves out")
+
+	allowlist, err := update("192.0.2.10", "192.0.2.99/24", "2001:db8::/32")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.0/24", "2001:db8::/32"}, allowlist.Cidrs)
+	assert.Equal(t, "192.0.2.10", allowlist.ClientIp)
+
+	req := connect.NewRequest(&libopsv1.GetIpAllowlistRequest{OrganizationId: orgID})
+	req.Header().Set("X-Forwarded-For", "192.0.2.10")
+	got, err := client.GetIpAllowlist(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.0/24", "2001:db8::/32"}, got.Msg.Allowlist.Cidrs)
+
+	allowlist, err = update("203.0.113.1")
+	require.NoError(t, err, "clearing the allowlist works from anywhere")
+	assert.Empty(t, allowlist.Cidrs)
+	assert.JSONEq(t, "[]", string(stored))
+
+	require.Len(t, audited, 2)
+	assert.Equal(t, string(audit.IPAllowlistUpdate), audited[0].EventName)
+}
@@ -0,0 +1,334 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/signup"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// maxJoinDomains caps the email domains an organization claims
+const maxJoinDomains = 10
+
+// freeEmailDomains can't be claimed: anyone can get an address there, so
+// claiming one would let strangers into the organization.
+var freeEmailDomains = []string{
+	"aol.com", "gmail.com", "gmx.com", "googlemail.com", "hotmail.com", "icloud.com",
+	"live.com", "mail.com", "me.com", "msn.com", "outlook.com", "proton.me",
+	"protonmail.com", "yahoo.com", "yandex.com",
+}
+
+// JoinPolicyService implements the JoinPolicyService API.
+type JoinPolicyService struct {
+	db          db.Querier
+	joiner      *signup.Joiner
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.JoinPolicyServiceHandler = (*JoinPolicyService)(nil)
+
+// NewJoinPolicyService creates a new JoinPolicyService instance.
+func NewJoinPolicyService(querier db.Querier, auditLogger *audit.Logger) *JoinPolicyService {
+	return &JoinPolicyService{
+		db:          querier,
+		joiner:      signup.NewJoiner(querier, auditLogger),
+		auditLogger: auditLogger,
+	}
+}
+
+// GetJoinPolicy returns the email domains an organization claims.
+func (s *JoinPolicyService) GetJoinPolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetJoinPolicyRequest],
+) (*connect.Response[libopsv1.GetJoinPolicyResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.policy(ctx, organization.ID, organization.PublicID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.GetJoinPolicyResponse{Policy: policy}), nil
+}
+
+// UpdateJoinPolicy replaces the email domains an organization claims. A
+// domain can only be newly claimed by someone with an address there, so an
+// organization can't sign up another institution's staff.
+func (s *JoinPolicyService) UpdateJoinPolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateJoinPolicyRequest],
+) (*connect.Response[libopsv1.UpdateJoinPolicyResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	domains, err := ValidateJoinDomains(req.Msg.Domains)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.ListOrganizationJoinDomains(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to get join policy", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	account, err := s.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	callerDomain := signup.EmailDomain(account.Email)
+	for _, d := range domains {
+		claimed := slices.ContainsFunc(existing, func(e db.ListOrganizationJoinDomainsRow) bool { return e.Domain == d.Domain })
+		if !claimed && d.Domain != callerDomain {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you can only claim your own email domain %s", callerDomain))
+		}
+	}
+
+	if err := s.db.DeleteOrganizationJoinDomains(ctx, organization.ID); err != nil {
+		slog.Error("Failed to clear join policy", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, d := range domains {
+		err := s.db.CreateOrganizationJoinDomain(ctx, db.CreateOrganizationJoinDomainParams{
+			OrganizationID:   organization.ID,
+			Domain:           d.Domain,
+			Role:             db.OrganizationJoinDomainsRole(d.Role),
+			ApprovalRequired: d.ApprovalRequired,
+			CreatedBy:        sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		})
+		if err != nil {
+			slog.Error("Failed to update join policy", "error", err, "organization_id", organization.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	claims := make([]map[string]any, 0, len(domains))
+	for _, d := range domains {
+		claims = append(claims, map[string]any{"domain": d.Domain, "role": d.Role, "approval_required": d.ApprovalRequired})
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.JoinPolicyUpdate, map[string]any{
+		"domains": claims,
+	})
+
+	return connect.NewResponse(&libopsv1.UpdateJoinPolicyResponse{
+		Policy: &libopsv1.JoinPolicy{
+			OrganizationId: organization.PublicID,
+			Domains:        domains,
+		},
+	}), nil
+}
+
+// ListJoinRequests lists the join requests waiting for an administrator.
+func (s *JoinPolicyService) ListJoinRequests(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListJoinRequestsRequest],
+) (*connect.Response[libopsv1.ListJoinRequestsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.db.ListOrganizationJoinRequests(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to list join requests", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	joinRequests := make([]*libopsv1.JoinRequest, 0, len(requests))
+	for _, r := range requests {
+		joinRequests = append(joinRequests, &libopsv1.JoinRequest{
+			JoinRequestId:  r.PublicID,
+			OrganizationId: organization.PublicID,
+			AccountId:      r.AccountPublicID,
+			Email:          r.Email,
+			Name:           r.Name.String,
+			Role:           string(r.Role),
+			Status:         string(r.Status),
+			CreatedAt:      r.CreatedAt.Time.Unix(),
+		})
+	}
+	return connect.NewResponse(&libopsv1.ListJoinRequestsResponse{JoinRequests: joinRequests}), nil
+}
+
+// ApproveJoinRequest adds the account behind a join request as a member.
+func (s *JoinPolicyService) ApproveJoinRequest(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ApproveJoinRequestRequest],
+) (*connect.Response[libopsv1.ApproveJoinRequestResponse], error) {
+	joinRequest, err := s.decide(ctx, req.Msg.OrganizationId, req.Msg.JoinRequestId, s.joiner.Approve)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ApproveJoinRequestResponse{JoinRequest: joinRequest}), nil
+}
+
+// DenyJoinRequest turns down a join request.
+func (s *JoinPolicyService) DenyJoinRequest(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DenyJoinRequestRequest],
+) (*connect.Response[libopsv1.DenyJoinRequestResponse], error) {
+	joinRequest, err := s.decide(ctx, req.Msg.OrganizationId, req.Msg.JoinRequestId, s.joiner.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.DenyJoinRequestResponse{JoinRequest: joinRequest}), nil
+}
+
+// decide approves or denies a join request with decision.
+func (s *JoinPolicyService) decide(
+	ctx context.Context,
+	organizationID, joinRequestID string,
+	decision func(context.Context, db.GetOrganizationJoinRequestRow, int64) error,
+) (*libopsv1.JoinRequest, error) {
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(joinRequestID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := s.db.GetOrganizationJoinRequest(ctx, db.GetOrganizationJoinRequestParams{
+		PublicID:       joinRequestID,
+		OrganizationID: organization.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("join request not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := decision(ctx, request, userInfo.AccountID); err != nil {
+		if errors.Is(err, signup.ErrNotPending) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		slog.Error("Failed to decide join request", "error", err, "join_request_id", request.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decide join request"))
+	}
+
+	decided, err := s.db.GetOrganizationJoinRequest(ctx, db.GetOrganizationJoinRequestParams{
+		PublicID:       joinRequestID,
+		OrganizationID: organization.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return joinRequestToProto(organization.PublicID, decided), nil
+}
+
+func (s *JoinPolicyService) policy(ctx context.Context, organizationID int64, organizationPublicID string) (*libopsv1.JoinPolicy, error) {
+	rows, err := s.db.ListOrganizationJoinDomains(ctx, organizationID)
+	if err != nil {
+		slog.Error("Failed to get join policy", "error", err, "organization_id", organizationPublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	policy := &libopsv1.JoinPolicy{OrganizationId: organizationPublicID}
+	for _, row := range rows {
+		policy.Domains = append(policy.Domains, &libopsv1.JoinDomain{
+			Domain:           row.Domain,
+			Role:             string(row.Role),
+			ApprovalRequired: row.ApprovalRequired,
+		})
+	}
+	return policy, nil
+}
+
+func joinRequestToProto(organizationID string, request db.GetOrganizationJoinRequestRow) *libopsv1.JoinRequest {
+	return &libopsv1.JoinRequest{
+		JoinRequestId:  request.PublicID,
+		OrganizationId: organizationID,
+		AccountId:      request.AccountPublicID,
+		Email:          request.Email,
+		Name:           request.Name.String,
+		Role:           string(request.Role),
+		Status:         string(request.Status),
+		CreatedAt:      request.CreatedAt.Time.Unix(),
+	}
+}
+
+// ValidateJoinDomains checks the email domains an organization claims and
+// returns them normalized: lowercase, without a leading "@", and defaulting
+// to the read role.
+func ValidateJoinDomains(domains []*libopsv1.JoinDomain) ([]*libopsv1.JoinDomain, error) {
+	if len(domains) > maxJoinDomains {
+		return nil, fmt.Errorf("too many domains (max %d)", maxJoinDomains)
+	}
+
+	normalized := make([]*libopsv1.JoinDomain, 0, len(domains))
+	seen := make(map[string]bool, len(domains))
+	for _, d := range domains {
+		if d == nil {
+			return nil, fmt.Errorf("domain is required")
+		}
+		domain := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d.Domain), "@"))
+		if err := validation.Hostname(domain); err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Domain, err)
+		}
+		if slices.Contains(freeEmailDomains, domain) {
+			return nil, fmt.Errorf("%s is a public email provider and can't be claimed", domain)
+		}
+		if seen[domain] {
+			return nil, fmt.Errorf("%s is listed twice", domain)
+		}
+		seen[domain] = true
+
+		role := d.Role
+		if role == "" {
+			role = string(db.OrganizationJoinDomainsRoleRead)
+		}
+		if role != string(db.OrganizationJoinDomainsRoleRead) && role != string(db.OrganizationJoinDomainsRoleDeveloper) {
+			return nil, fmt.Errorf("role for %s must be read or developer", domain)
+		}
+
+		normalized = append(normalized, &libopsv1.JoinDomain{
+			Domain:           domain,
+			Role:             role,
+			ApprovalRequired: d.ApprovalRequired,
+		})
+	}
+	return normalized, nil
+}
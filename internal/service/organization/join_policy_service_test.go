@@ -0,0 +1,164 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+func TestValidateJoinDomains(t *testing.T) {
+	domains, err := ValidateJoinDomains([]*libopsv1.JoinDomain{
+		{Domain: " @MyLibrary.edu "},
+		{Domain: "lib.example.org", Role: "developer", ApprovalRequired: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mylibrary.edu", domains[0].Domain)
+	assert.Equal(t, "read", domains[0].Role, "new members can read by default")
+	assert.Equal(t, "developer", domains[1].Role)
+	assert.True(t, domains[1].ApprovalRequired)
+
+	tests := map[string][]*libopsv1.JoinDomain{
+		"invalid domain":        {{Domain: "not a domain"}},
+		"public email provider": {{Domain: "gmail.com"}},
+		"owner role":            {{Domain: "mylibrary.edu", Role: "owner"}},
+		"duplicate":             {{Domain: "mylibrary.edu"}, {Domain: "MYLIBRARY.EDU"}},
+		"missing":               {nil},
+	}
+	for name, domains := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ValidateJoinDomains(domains)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJoinPolicy(t *testing.T) {
+	orgID := uuid.NewString()
+	var stored []db.ListOrganizationJoinDomainsRow
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			if publicID != orgID {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, Email: "admin@mylibrary.edu"}, nil
+		},
+		ListOrganizationJoinDomainsFunc: func(ctx context.Context, organizationID int64) ([]db.ListOrganizationJoinDomainsRow, error) {
+			return stored, nil
+		},
+		DeleteOrganizationJoinDomainsFunc: func(ctx context.Context, organizationID int64) error {
+			stored = nil
+			return nil
+		},
+		CreateOrganizationJoinDomainFunc: func(ctx context.Context, arg db.CreateOrganizationJoinDomainParams) error {
+			stored = append(stored, db.ListOrganizationJoinDomainsRow{Domain: arg.Domain, Role: arg.Role, ApprovalRequired: arg.ApprovalRequired})
+			return nil
+		},
+	}
+
+	path, handler := libopsv1connect.NewJoinPolicyServiceHandler(NewJoinPolicyService(mock, audit.New(mock)))
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := libopsv1connect.NewJoinPolicyServiceClient(server.Client(), server.URL)
+
+	ctx := context.Background()
+	update := func(domains ...*libopsv1.JoinDomain) (*libopsv1.JoinPolicy, error) {
+		resp, err := client.UpdateJoinPolicy(ctx, connect.NewRequest(&libopsv1.UpdateJoinPolicyRequest{OrganizationId: orgID, Domains: domains}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Policy, nil
+	}
+
+	_, err := update(&libopsv1.JoinDomain{Domain: "otherlibrary.edu"})
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "another institution's domain can't be claimed")
+
+	policy, err := update(&libopsv1.JoinDomain{Domain: "mylibrary.edu", ApprovalRequired: true})
+	require.NoError(t, err)
+	require.Len(t, policy.Domains, 1)
+	assert.Equal(t, "read", policy.Domains[0].Role)
+
+	// A domain already claimed stays claimable by administrators at other domains
+	stored = append(stored, db.ListOrganizationJoinDomainsRow{Domain: "otherlibrary.edu", Role: db.OrganizationJoinDomainsRoleRead})
+	_, err = update(&libopsv1.JoinDomain{Domain: "otherlibrary.edu", Role: "developer"})
+	require.NoError(t, err)
+
+	got, err := client.GetJoinPolicy(ctx, connect.NewRequest(&libopsv1.GetJoinPolicyRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	require.Len(t, got.Msg.Policy.Domains, 1)
+	assert.Equal(t, "otherlibrary.edu", got.Msg.Policy.Domains[0].Domain)
+	assert.Equal(t, "developer", got.Msg.Policy.Domains[0].Role)
+
+	policy, err = update()
+	require.NoError(t, err)
+	assert.Empty(t, policy.Domains, "an empty list turns signup off")
+}
+
+func TestJoinRequestDecisions(t *testing.T) {
+	orgID := uuid.NewString()
+	requestID := uuid.NewString()
+	status := db.OrganizationJoinRequestsStatusPending
+	var members []db.CreateOrganizationMemberParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationJoinRequestFunc: func(ctx context.Context, arg db.GetOrganizationJoinRequestParams) (db.GetOrganizationJoinRequestRow, error) {
+			if arg.PublicID != requestID || arg.OrganizationID != 1 {
+				return db.GetOrganizationJoinRequestRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationJoinRequestRow{
+				ID:             5,
+				PublicID:       requestID,
+				OrganizationID: 1,
+				AccountID:      7,
+				Email:          "staff@mylibrary.edu",
+				Role:           db.OrganizationJoinRequestsRoleRead,
+				Status:         status,
+			}, nil
+		},
+		DecideOrganizationJoinRequestFunc: func(ctx context.Context, arg db.DecideOrganizationJoinRequestParams) (int64, error) {
+			status = arg.Status
+			return 1, nil
+		},
+		CreateOrganizationMemberFunc: func(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+			members = append(members, arg)
+			return nil
+		},
+	}
+	service := NewJoinPolicyService(mock, audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+
+	_, err := service.ApproveJoinRequest(ctx, connect.NewRequest(&libopsv1.ApproveJoinRequestRequest{OrganizationId: orgID, JoinRequestId: uuid.NewString()}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	resp, err := service.ApproveJoinRequest(ctx, connect.NewRequest(&libopsv1.ApproveJoinRequestRequest{OrganizationId: orgID, JoinRequestId: requestID}))
+	require.NoError(t, err)
+	assert.Equal(t, "approved", resp.Msg.JoinRequest.Status)
+	require.Len(t, members, 1)
+	assert.Equal(t, int64(7), members[0].AccountID)
+
+	_, err = service.DenyJoinRequest(ctx, connect.NewRequest(&libopsv1.DenyJoinRequestRequest{OrganizationId: orgID, JoinRequestId: requestID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "decided requests stay decided")
+}
@@ -12,6 +12,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/notify"
 	"github.com/libops/api/internal/reconciler"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
@@ -23,16 +25,20 @@ import (
 type MemberService struct {
 	db          db.Querier
 	connManager *reconciler.ConnectionManager
+	notifier    *notify.Notifier
+	inviter     *invite.Inviter
 }
 
 // Compile-time check.
 var _ libopsv1connect.MemberServiceHandler = (*MemberService)(nil)
 
 // NewMemberService creates a new MemberService instance with DI.
-func NewMemberService(querier db.Querier, connManager *reconciler.ConnectionManager) *MemberService {
+func NewMemberService(querier db.Querier, connManager *reconciler.ConnectionManager, notifier *notify.Notifier, inviter *invite.Inviter) *MemberService {
 	return &MemberService{
 		db:          querier,
 		connManager: connManager,
+		notifier:    notifier,
+		inviter:     inviter,
 	}
 }
 
@@ -95,6 +101,7 @@ func (s *MemberService) ListOrganizationMembers(
 			Role:           string(member.Role),
 			GithubUsername: service.FromNullStringPtr(member.GithubUsername),
 			Status:         service.DbOrganizationMemberStatusToProto(member.Status),
+			ExpiresAt:      service.ExpiryToProto(member.ExpiresAt),
 		})
 	}
 
@@ -114,53 +121,317 @@ func (s *MemberService) CreateOrganizationMember(
 	ctx context.Context,
 	req *connect.Request[libopsv1.CreateOrganizationMemberRequest],
 ) (*connect.Response[libopsv1.CreateOrganizationMemberResponse], error) {
-	organizationID := req.Msg.OrganizationId
-	accountID := req.Msg.AccountId
-	role := req.Msg.Role
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := validation.UUID(organizationID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	resp, err := s.addOrganizationMember(ctx, organization, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Member != nil && hasSiteAccess(resp.Member.Role) {
+		s.reconcileOrganizationSSHKeys(ctx, organization, "addition")
 	}
 
-	if err := validation.UUID(accountID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateOrganizationMember updates a organization member's role.
+func (s *MemberService) UpdateOrganizationMember(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateOrganizationMemberRequest],
+) (*connect.Response[libopsv1.UpdateOrganizationMemberResponse], error) {
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
 	}
 
-	if !service.IsValidMemberRole(role) {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role: %s", role))
+	member, err := s.updateOrganizationMember(ctx, organization, req.Msg)
+	if err != nil {
+		return nil, err
 	}
 
-	organizationPublicID, err := uuid.Parse(organizationID)
+	return connect.NewResponse(&libopsv1.UpdateOrganizationMemberResponse{
+		Member: member,
+	}), nil
+}
+
+// DeleteOrganizationMember deletes a member from a organization.
+func (s *MemberService) DeleteOrganizationMember(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteOrganizationMemberRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+		return nil, err
 	}
 
-	accountPublicID, err := uuid.Parse(accountID)
+	role, err := s.removeOrganizationMember(ctx, organization, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	// Removing an owner or developer revokes their SSH access
+	if hasSiteAccess(string(role)) {
+		s.reconcileOrganizationSSHKeys(ctx, organization, "removal")
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// maxBulkMembers caps the entries in one bulk membership request.
+const maxBulkMembers = 500
+
+// BulkCreateMembers adds many people to a organization. Entries are added one
+// at a time and a failed entry doesn't stop the rest.
+func (s *MemberService) BulkCreateMembers(
+	ctx context.Context,
+	req *connect.Request[libopsv1.BulkCreateMembersRequest],
+) (*connect.Response[libopsv1.BulkCreateMembersResponse], error) {
+	if err := checkBulkSize(len(req.Msg.Members)); err != nil {
+		return nil, err
+	}
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &libopsv1.BulkCreateMembersResponse{}
+	reconcile := false
+	for i, entry := range req.Msg.Members {
+		result := &libopsv1.BulkMemberResult{Index: int32(i), AccountId: entry.AccountId, Email: entry.Email}
+		added, err := s.addOrganizationMember(ctx, organization, &libopsv1.CreateOrganizationMemberRequest{
+			OrganizationId: req.Msg.OrganizationId,
+			AccountId:      entry.AccountId,
+			Role:           entry.Role,
+			Email:          entry.Email,
+			ExpiresAt:      entry.ExpiresAt,
+		})
+		if err != nil {
+			setBulkError(result, err)
+			resp.Failed++
+		} else {
+			result.Member = added.Member
+			result.Invitation = added.Invitation
+			if added.Member != nil {
+				result.AccountId = added.Member.AccountId
+				reconcile = reconcile || hasSiteAccess(added.Member.Role)
+			}
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	if reconcile {
+		s.reconcileOrganizationSSHKeys(ctx, organization, "addition")
+	}
+	slog.Info("bulk added organization members",
+		"organization_id", organization.PublicID,
+		"succeeded", resp.Succeeded,
+		"failed", resp.Failed)
+
+	return connect.NewResponse(resp), nil
+}
+
+// BulkUpdateMemberRoles changes the role of many organization members, each
+// independently of the others.
+func (s *MemberService) BulkUpdateMemberRoles(
+	ctx context.Context,
+	req *connect.Request[libopsv1.BulkUpdateMemberRolesRequest],
+) (*connect.Response[libopsv1.BulkUpdateMemberRolesResponse], error) {
+	if err := checkBulkSize(len(req.Msg.Members)); err != nil {
+		return nil, err
+	}
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
+		return nil, err
+	}
+
+	resp := &libopsv1.BulkUpdateMemberRolesResponse{}
+	for i, entry := range req.Msg.Members {
+		result := &libopsv1.BulkMemberResult{Index: int32(i), AccountId: entry.AccountId}
+		member, err := s.updateOrganizationMember(ctx, organization, &libopsv1.UpdateOrganizationMemberRequest{
+			OrganizationId: req.Msg.OrganizationId,
+			AccountId:      entry.AccountId,
+			Role:           entry.Role,
+		})
+		if err != nil {
+			setBulkError(result, err)
+			resp.Failed++
+		} else {
+			result.Member = member
+			result.Email = member.Email
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	slog.Info("bulk updated organization member roles",
+		"organization_id", organization.PublicID,
+		"succeeded", resp.Succeeded,
+		"failed", resp.Failed)
+
+	return connect.NewResponse(resp), nil
+}
+
+// BulkRemoveMembers removes many members from a organization, each
+// independently of the others.
+func (s *MemberService) BulkRemoveMembers(
+	ctx context.Context,
+	req *connect.Request[libopsv1.BulkRemoveMembersRequest],
+) (*connect.Response[libopsv1.BulkRemoveMembersResponse], error) {
+	if err := checkBulkSize(len(req.Msg.AccountIds)); err != nil {
+		return nil, err
+	}
+	organization, err := s.getOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &libopsv1.BulkRemoveMembersResponse{}
+	reconcile := false
+	for i, accountID := range req.Msg.AccountIds {
+		result := &libopsv1.BulkMemberResult{Index: int32(i), AccountId: accountID}
+		role, err := s.removeOrganizationMember(ctx, organization, accountID)
+		if err != nil {
+			setBulkError(result, err)
+			resp.Failed++
+		} else {
+			reconcile = reconcile || hasSiteAccess(string(role))
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	// Removing owners or developers revokes their SSH access
+	if reconcile {
+		s.reconcileOrganizationSSHKeys(ctx, organization, "removal")
+	}
+	slog.Info("bulk removed organization members",
+		"organization_id", organization.PublicID,
+		"succeeded", resp.Succeeded,
+		"failed", resp.Failed)
+
+	return connect.NewResponse(resp), nil
+}
+
+// checkBulkSize rejects empty and oversized bulk membership requests.
+func checkBulkSize(n int) error {
+	if n == 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one member is required"))
+	}
+	if n > maxBulkMembers {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d members can be changed at once, got %d", maxBulkMembers, n))
+	}
+	return nil
+}
+
+// setBulkError records why a bulk membership entry failed.
+func setBulkError(result *libopsv1.BulkMemberResult, err error) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		result.ErrorCode = connectErr.Code().String()
+		result.Error = connectErr.Message()
+		return
+	}
+	result.ErrorCode = connect.CodeInternal.String()
+	result.Error = err.Error()
+}
+
+// getOrganization looks up the organization a member request is for.
+func (s *MemberService) getOrganization(ctx context.Context, organizationID string) (db.GetOrganizationRow, error) {
+	if err := validation.UUID(organizationID); err != nil {
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organizationPublicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
 	}
 
 	organization, err := s.db.GetOrganization(ctx, organizationPublicID.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+			return db.GetOrganizationRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
 		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return organization, nil
+}
+
+// getAccount looks up the account a member request names.
+func (s *MemberService) getAccount(ctx context.Context, accountID string) (db.GetAccountRow, error) {
+	if err := validation.UUID(accountID); err != nil {
+		return db.GetAccountRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	accountPublicID, err := uuid.Parse(accountID)
+	if err != nil {
+		return db.GetAccountRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
 	}
 
 	account, err := s.db.GetAccount(ctx, accountPublicID.String())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+			return db.GetAccountRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
 		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return db.GetAccountRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return account, nil
+}
+
+// addOrganizationMember adds someone to an organization, or invites them if
+// they don't have an account yet. The caller reconciles SSH keys on the
+// organization's sites for owners and developers.
+func (s *MemberService) addOrganizationMember(ctx context.Context, organization db.GetOrganizationRow, req *libopsv1.CreateOrganizationMemberRequest) (*libopsv1.CreateOrganizationMemberResponse, error) {
+	role := req.Role
+
+	accountID, err := service.ResolveMemberAccountID(ctx, s.db, req.AccountId, req.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if !service.IsValidMemberRole(role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role: %s", role))
+	}
+
+	expiresAt, err := service.ParseExpiry(req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMemberExpiry(role, expiresAt); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid && accountID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at can only be set once they have an account"))
+	}
+
+	if accountID == "" {
+		invitation, err := service.InviteMember(ctx, s.inviter, invite.Request{
+			Email:        req.Email,
+			ResourceType: "organization",
+			ResourceID:   organization.ID,
+			ResourceName: organization.Name,
+			Role:         role,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &libopsv1.CreateOrganizationMemberResponse{
+			Invitation: invitation,
+		}, nil
+	}
+
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Determine initial status based on role
 	// Owner/developer roles require reconciliation (SSH keys, secrets, firewall)
 	// so they start in 'provisioning' state and will be set to 'active' after reconciliation
 	status := db.OrganizationMembersStatusActive
-	if role == "owner" || role == "developer" {
+	if hasSiteAccess(role) {
 		status = db.OrganizationMembersStatusProvisioning
 	}
 
@@ -178,108 +449,50 @@ func (s *MemberService) CreateOrganizationMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	// Trigger reconciliation via WebSocket if owner/developer role
-	if s.connManager != nil && (role == "owner" || role == "developer") {
-		// Get all projects in this organization
-		projects, err := s.db.ListOrganizationProjects(ctx, db.ListOrganizationProjectsParams{
+	if expiresAt.Valid {
+		err = s.db.SetOrganizationMemberExpiry(ctx, db.SetOrganizationMemberExpiryParams{
+			ExpiresAt:      expiresAt,
 			OrganizationID: organization.ID,
-			Limit:          1000, // Max projects per org
-			Offset:         0,
+			AccountID:      account.ID,
 		})
 		if err != nil {
-			slog.Warn("failed to get organization projects for reconciliation",
-				"organization_id", organizationID,
-				"error", err)
-		} else {
-			// Get all sites across all projects
-			for _, project := range projects {
-				sites, err := s.db.ListProjectSites(ctx, db.ListProjectSitesParams{
-					ProjectID: project.ID,
-					Limit:     1000, // Max sites per project
-					Offset:    0,
-				})
-				if err != nil {
-					slog.Warn("failed to get project sites for reconciliation",
-						"project_id", project.PublicID,
-						"error", err)
-					continue
-				}
-
-				// Trigger SSH key reconciliation for each connected site
-				for _, site := range sites {
-					if err := s.connManager.TriggerReconciliation(site.ID, "ssh_keys"); err != nil {
-						slog.Debug("site not connected, skipping reconciliation",
-							"site_id", site.PublicID,
-							"error", err)
-					} else {
-						slog.Info("triggered ssh_keys reconciliation for org member addition",
-							"site_id", site.PublicID,
-							"organization_id", organizationID)
-					}
-				}
-			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 		}
 	}
 
-	member := &libopsv1.MemberDetail{
-		AccountId:      accountID,
-		Email:          account.Email,
-		Name:           service.FromNullString(account.Name),
-		Role:           role,
-		Status:         service.DbStatusToProto(string(status)),
-		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+	if s.notifier != nil {
+		s.notifier.MemberAdded(ctx, account.ID, "organization", organization.Name, role, "/organizations/"+organization.PublicID)
 	}
 
-	return connect.NewResponse(&libopsv1.CreateOrganizationMemberResponse{
-		Member: member,
-	}), nil
+	return &libopsv1.CreateOrganizationMemberResponse{
+		Member: &libopsv1.MemberDetail{
+			AccountId:      accountID,
+			Email:          account.Email,
+			Name:           service.FromNullString(account.Name),
+			Role:           role,
+			Status:         service.DbStatusToProto(string(status)),
+			GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+			ExpiresAt:      service.ExpiryToProto(expiresAt),
+		},
+	}, nil
 }
 
-// UpdateOrganizationMember updates a organization member's role.
-func (s *MemberService) UpdateOrganizationMember(
-	ctx context.Context,
-	req *connect.Request[libopsv1.UpdateOrganizationMemberRequest],
-) (*connect.Response[libopsv1.UpdateOrganizationMemberResponse], error) {
-	organizationID := req.Msg.OrganizationId
-	accountID := req.Msg.AccountId
-	role := req.Msg.Role
-
-	if err := validation.UUID(organizationID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	if err := validation.UUID(accountID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	if !service.IsValidMemberRole(role) {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role: %s", role))
-	}
-
-	organizationPublicID, err := uuid.Parse(organizationID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
-	}
+// updateOrganizationMember changes a member's role and expiry.
+func (s *MemberService) updateOrganizationMember(ctx context.Context, organization db.GetOrganizationRow, req *libopsv1.UpdateOrganizationMemberRequest) (*libopsv1.MemberDetail, error) {
+	accountID := req.AccountId
+	role := req.Role
 
-	accountPublicID, err := uuid.Parse(accountID)
+	mask, err := service.NewUpdateMask(req.UpdateMask, service.MemberUpdatePaths...)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
+		return nil, err
 	}
-
-	organization, err := s.db.GetOrganization(ctx, organizationPublicID.String())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	if mask.Has("role") && !service.IsValidMemberRole(role) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid role: %s", role))
 	}
 
-	account, err := s.db.GetAccount(ctx, accountPublicID.String())
+	account, err := s.getAccount(ctx, accountID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return nil, err
 	}
 
 	existingMember, err := s.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
@@ -294,10 +507,22 @@ func (s *MemberService) UpdateOrganizationMember(
 	}
 
 	memberRole := existingMember.Role
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "role") {
+	if mask.Has("role") {
 		memberRole = db.OrganizationMembersRole(role)
 	}
 
+	expiresAt := existingMember.ExpiresAt
+	updateExpiry := req.ExpiresAt != nil && mask.Has("expires_at")
+	if updateExpiry {
+		expiresAt, err = service.ParseExpiry(req.GetExpiresAt())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := checkMemberExpiry(string(memberRole), expiresAt); err != nil {
+		return nil, err
+	}
+
 	params := db.UpdateOrganizationMemberParams{
 		Role:           memberRole,
 		UpdatedBy:      sql.NullInt64{Valid: false},
@@ -310,59 +535,33 @@ func (s *MemberService) UpdateOrganizationMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	member := &libopsv1.MemberDetail{
+	if updateExpiry {
+		err = s.db.SetOrganizationMemberExpiry(ctx, db.SetOrganizationMemberExpiryParams{
+			ExpiresAt:      expiresAt,
+			OrganizationID: organization.ID,
+			AccountID:      account.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	return &libopsv1.MemberDetail{
 		AccountId:      accountID,
 		Email:          account.Email,
 		Name:           service.FromNullString(account.Name),
-		Role:           role,
+		Role:           string(memberRole),
 		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
-	}
-
-	return connect.NewResponse(&libopsv1.UpdateOrganizationMemberResponse{
-		Member: member,
-	}), nil
+		ExpiresAt:      service.ExpiryToProto(expiresAt),
+	}, nil
 }
 
-// DeleteOrganizationMember deletes a member from a organization.
-func (s *MemberService) DeleteOrganizationMember(
-	ctx context.Context,
-	req *connect.Request[libopsv1.DeleteOrganizationMemberRequest],
-) (*connect.Response[emptypb.Empty], error) {
-	organizationID := req.Msg.OrganizationId
-	accountID := req.Msg.AccountId
-
-	if err := validation.UUID(organizationID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	if err := validation.UUID(accountID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
-	}
-
-	organizationPublicID, err := uuid.Parse(organizationID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
-	}
-
-	accountPublicID, err := uuid.Parse(accountID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
-	}
-
-	organization, err := s.db.GetOrganization(ctx, organizationPublicID.String())
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
-	}
-
-	account, err := s.db.GetAccount(ctx, accountPublicID.String())
+// removeOrganizationMember removes a member and returns the role they had, so
+// the caller can reconcile SSH keys if it gave them site access.
+func (s *MemberService) removeOrganizationMember(ctx context.Context, organization db.GetOrganizationRow, accountID string) (db.OrganizationMembersRole, error) {
+	account, err := s.getAccount(ctx, accountID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return "", err
 	}
 
 	// Get member role before deletion for reconciliation check
@@ -372,9 +571,9 @@ func (s *MemberService) DeleteOrganizationMember(
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
+			return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
 		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
 	params := db.DeleteOrganizationMemberParams{
@@ -384,41 +583,72 @@ func (s *MemberService) DeleteOrganizationMember(
 
 	err = s.db.DeleteOrganizationMember(ctx, params)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
-	// Trigger reconciliation via WebSocket if owner/developer role was removed
-	memberRole := string(existingMember.Role)
-	if s.connManager != nil && (memberRole == "owner" || memberRole == "developer") {
-		// Get all projects in this organization
-		projects, err := s.db.ListOrganizationProjects(ctx, db.ListOrganizationProjectsParams{
-			OrganizationID: organization.ID,
-			Limit:          1000,
-			Offset:         0,
+	return existingMember.Role, nil
+}
+
+// hasSiteAccess reports whether a role gets SSH access to the organization's
+// sites, which needs reconciling when it's granted or revoked.
+func hasSiteAccess(role string) bool {
+	return role == string(db.OrganizationMembersRoleOwner) || role == string(db.OrganizationMembersRoleDeveloper)
+}
+
+// reconcileOrganizationSSHKeys triggers SSH key reconciliation on every
+// connected site in the organization after a membership change.
+func (s *MemberService) reconcileOrganizationSSHKeys(ctx context.Context, organization db.GetOrganizationRow, change string) {
+	if s.connManager == nil {
+		return
+	}
+
+	// Get all projects in this organization
+	projects, err := s.db.ListOrganizationProjects(ctx, db.ListOrganizationProjectsParams{
+		OrganizationID: organization.ID,
+		Limit:          1000, // Max projects per org
+		Offset:         0,
+	})
+	if err != nil {
+		slog.Warn("failed to get organization projects for reconciliation",
+			"organization_id", organization.PublicID,
+			"error", err)
+		return
+	}
+
+	// Get all sites across all projects
+	for _, project := range projects {
+		sites, err := s.db.ListProjectSites(ctx, db.ListProjectSitesParams{
+			ProjectID: project.ID,
+			Limit:     1000, // Max sites per project
+			Offset:    0,
 		})
-		if err == nil {
-			// Get all sites across all projects
-			for _, project := range projects {
-				sites, err := s.db.ListProjectSites(ctx, db.ListProjectSitesParams{
-					ProjectID: project.ID,
-					Limit:     1000,
-					Offset:    0,
-				})
-				if err != nil {
-					continue
-				}
-
-				// Trigger SSH key reconciliation for each connected site
-				for _, site := range sites {
-					if err := s.connManager.TriggerReconciliation(site.ID, "ssh_keys"); err == nil {
-						slog.Info("triggered ssh_keys reconciliation for org member removal",
-							"site_id", site.PublicID,
-							"organization_id", organizationID)
-					}
-				}
+		if err != nil {
+			slog.Warn("failed to get project sites for reconciliation",
+				"project_id", project.PublicID,
+				"error", err)
+			continue
+		}
+
+		// Trigger SSH key reconciliation for each connected site
+		for _, site := range sites {
+			if err := s.connManager.TriggerReconciliation(site.ID, "ssh_keys"); err != nil {
+				slog.Debug("site not connected, skipping reconciliation",
+					"site_id", site.PublicID,
+					"error", err)
+			} else {
+				slog.Info("triggered ssh_keys reconciliation for org member "+change,
+					"site_id", site.PublicID,
+					"organization_id", organization.PublicID)
 			}
 		}
 	}
+}
 
-	return connect.NewResponse(&emptypb.Empty{}), nil
+// checkMemberExpiry keeps owners from expiring, since removing the last one
+// would leave the organization without an owner.
+func checkMemberExpiry(role string, expiresAt sql.NullTime) error {
+	if expiresAt.Valid && role == string(db.OrganizationMembersRoleOwner) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("owners can't have an expiry"))
+	}
+	return nil
 }
@@ -0,0 +1,194 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// bulkMembersMock knows one organization and the given accounts, each a read
+// member of it.
+func bulkMembersMock(accounts ...string) (*testutils.MockQuerier, *[]db.CreateOrganizationMemberParams) {
+	ids := make(map[string]int64, len(accounts))
+	for i, publicID := range accounts {
+		ids[publicID] = int64(i + 1)
+	}
+
+	var created []db.CreateOrganizationMemberParams
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 10, PublicID: publicID, Name: "Consortium"}, nil
+		},
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			id, ok := ids[publicID]
+			if !ok {
+				return db.GetAccountRow{}, sql.ErrNoRows
+			}
+			return db.GetAccountRow{ID: id, PublicID: publicID, Email: publicID[:8] + "@example.edu"}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{AccountID: arg.AccountID, Role: db.OrganizationMembersRoleRead}, nil
+		},
+		CreateOrganizationMemberFunc: func(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+			created = append(created, arg)
+			return nil
+		},
+	}, &created
+}
+
+// TestBulkCreateMembers tests that each entry gets its own result and a bad
+// entry doesn't stop the rest.
+func TestBulkCreateMembers(t *testing.T) {
+	alice, bob := uuid.NewString(), uuid.NewString()
+	mockDB, created := bulkMembersMock(alice, bob)
+	svc := NewMemberService(mockDB, nil, nil, nil)
+
+	resp, err := svc.BulkCreateMembers(context.Background(), connect.NewRequest(&libopsv1.BulkCreateMembersRequest{
+		OrganizationId: uuid.NewString(),
+		Members: []*libopsv1.BulkMemberEntry{
+			{AccountId: alice, Role: "developer"},
+			{AccountId: uuid.NewString(), Role: "read"},
+			{AccountId: bob, Role: "admin"},
+			{AccountId: bob, Role: "read"},
+		},
+	}))
+	require.NoError(t, err)
+
+	results := resp.Msg.Results
+	require.Len(t, results, 4)
+	assert.Equal(t, int32(2), resp.Msg.Succeeded)
+	assert.Equal(t, int32(2), resp.Msg.Failed)
+
+	assert.Empty(t, results[0].ErrorCode)
+	assert.Equal(t, "developer", results[0].Member.Role)
+	assert.Equal(t, connect.CodeNotFound.String(), results[1].ErrorCode)
+	assert.Equal(t, "account not found", results[1].Error)
+	assert.Equal(t, int32(2), results[2].Index)
+	assert.Equal(t, connect.CodeInvalidArgument.String(), results[2].ErrorCode)
+	assert.Empty(t, results[3].ErrorCode)
+
+	require.Len(t, *created, 2)
+	assert.Equal(t, db.OrganizationMembersStatusProvisioning, (*created)[0].Status.OrganizationMembersStatus)
+	assert.Equal(t, db.OrganizationMembersStatusActive, (*created)[1].Status.OrganizationMembersStatus)
+}
+
+// TestBulkUpdateMemberRoles tests per-entry role changes.
+func TestBulkUpdateMemberRoles(t *testing.T) {
+	alice := uuid.NewString()
+	mockDB, _ := bulkMembersMock(alice)
+	var updated []db.UpdateOrganizationMemberParams
+	mockDB.UpdateOrganizationMemberFunc = func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+		updated = append(updated, arg)
+		return nil
+	}
+	svc := NewMemberService(mockDB, nil, nil, nil)
+
+	resp, err := svc.BulkUpdateMemberRoles(context.Background(), connect.NewRequest(&libopsv1.BulkUpdateMemberRolesRequest{
+		OrganizationId: uuid.NewString(),
+		Members: []*libopsv1.BulkMemberRole{
+			{AccountId: alice, Role: "owner"},
+			{AccountId: "not-a-uuid", Role: "read"},
+		},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), resp.Msg.Succeeded)
+	assert.Equal(t, int32(1), resp.Msg.Failed)
+	assert.Equal(t, "owner", resp.Msg.Results[0].Member.Role)
+	assert.Equal(t, connect.CodeInvalidArgument.String(), resp.Msg.Results[1].ErrorCode)
+	require.Len(t, updated, 1)
+	assert.Equal(t, db.OrganizationMembersRoleOwner, updated[0].Role)
+}
+
+// TestBulkRemoveMembers tests that members who aren't there fail on their own.
+func TestBulkRemoveMembers(t *testing.T) {
+	alice, bob := uuid.NewString(), uuid.NewString()
+	mockDB, _ := bulkMembersMock(alice, bob)
+	mockDB.GetOrganizationMemberFunc = func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+		if arg.AccountID == 2 {
+			return db.GetOrganizationMemberRow{}, sql.ErrNoRows
+		}
+		return db.GetOrganizationMemberRow{AccountID: arg.AccountID, Role: db.OrganizationMembersRoleDeveloper}, nil
+	}
+	svc := NewMemberService(mockDB, nil, nil, nil)
+
+	resp, err := svc.BulkRemoveMembers(context.Background(), connect.NewRequest(&libopsv1.BulkRemoveMembersRequest{
+		OrganizationId: uuid.NewString(),
+		AccountIds:     []string{alice, bob},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), resp.Msg.Succeeded)
+	assert.Empty(t, resp.Msg.Results[0].ErrorCode)
+	assert.Equal(t, connect.CodeNotFound.String(), resp.Msg.Results[1].ErrorCode)
+	assert.Equal(t, "member not found", resp.Msg.Results[1].Error)
+}
+
+// TestBulkMembersSize tests that empty and oversized requests are rejected whole.
+func TestBulkMembersSize(t *testing.T) {
+	svc := NewMemberService(&testutils.MockQuerier{}, nil, nil, nil)
+
+	_, err := svc.BulkRemoveMembers(context.Background(), connect.NewRequest(&libopsv1.BulkRemoveMembersRequest{
+		OrganizationId: uuid.NewString(),
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.BulkRemoveMembers(context.Background(), connect.NewRequest(&libopsv1.BulkRemoveMembersRequest{
+		OrganizationId: uuid.NewString(),
+		AccountIds:     make([]string, maxBulkMembers+1),
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestUpdateMemberMask tests that a member update leaves out what its mask does,
+// and rejects paths a member update can't change.
+func TestUpdateMemberMask(t *testing.T) {
+	alice := uuid.NewString()
+	mockDB, _ := bulkMembersMock(alice)
+	var updated []db.UpdateOrganizationMemberParams
+	mockDB.UpdateOrganizationMemberFunc = func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+		updated = append(updated, arg)
+		return nil
+	}
+	var expiries []db.SetOrganizationMemberExpiryParams
+	mockDB.SetOrganizationMemberExpiryFunc = func(ctx context.Context, arg db.SetOrganizationMemberExpiryParams) error {
+		expiries = append(expiries, arg)
+		return nil
+	}
+	svc := NewMemberService(mockDB, nil, nil, nil)
+	expiresAt := time.Now().Add(24 * time.Hour).Unix()
+
+	resp, err := svc.UpdateOrganizationMember(context.Background(), connect.NewRequest(&libopsv1.UpdateOrganizationMemberRequest{
+		OrganizationId: uuid.NewString(),
+		AccountId:      alice,
+		ExpiresAt:      &expiresAt,
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"expires_at"}},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, "read", resp.Msg.Member.Role)
+	require.Len(t, updated, 1)
+	assert.Equal(t, db.OrganizationMembersRoleRead, updated[0].Role)
+	require.Len(t, expiries, 1)
+	assert.Equal(t, expiresAt, expiries[0].ExpiresAt.Time.Unix())
+
+	_, err = svc.UpdateOrganizationMember(context.Background(), connect.NewRequest(&libopsv1.UpdateOrganizationMemberRequest{
+		OrganizationId: uuid.NewString(),
+		AccountId:      alice,
+		Role:           "developer",
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"roles"}},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Len(t, updated, 1)
+}
@@ -0,0 +1,554 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/incident"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// pagerDutyRoutingKey matches a PagerDuty Events API v2 integration (routing) key.
+var pagerDutyRoutingKey = regexp.MustCompile(`^[A-Za-z0-9]{32}$`)
+
+// maxNotificationChannels caps how many notification channels an organization can add.
+const maxNotificationChannels = 20
+
+// alertCategoryNames maps alert categories to the names stored in notification_channels.categories.
+// The control-plane event router matches events against these names.
+var alertCategoryNames = map[libopsv1.AlertCategory]string{
+	libopsv1.AlertCategory_ALERT_CATEGORY_DEPLOYMENTS:             "deployments",
+	libopsv1.AlertCategory_ALERT_CATEGORY_RECONCILIATION_FAILURES: "reconciliation_failures",
+	libopsv1.AlertCategory_ALERT_CATEGORY_BILLING:                 "billing",
+	libopsv1.AlertCategory_ALERT_CATEGORY_SECURITY:                "security",
+	libopsv1.AlertCategory_ALERT_CATEGORY_DOWNTIME:                "downtime",
+}
+
+// NotificationChannelService implements the NotificationChannelService API.
+type NotificationChannelService struct {
+	db        db.Querier
+	emitter   *events.Emitter
+	escalator *incident.Escalator
+}
+
+// Compile-time check.
+var _ libopsv1connect.NotificationChannelServiceHandler = (*NotificationChannelService)(nil)
+
+// NewNotificationChannelService creates a new NotificationChannelService instance.
+func NewNotificationChannelService(querier db.Querier, emitter *events.Emitter, escalator *incident.Escalator) *NotificationChannelService {
+	return &NotificationChannelService{
+		db:        querier,
+		emitter:   emitter,
+		escalator: escalator,
+	}
+}
+
+// ListNotificationChannels lists an organization's notification channels.
+func (s *NotificationChannelService) ListNotificationChannels(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListNotificationChannelsRequest],
+) (*connect.Response[libopsv1.ListNotificationChannelsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListNotificationChannels(ctx, db.ListNotificationChannelsParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list notification channels", "error", err, "organization_id", organization.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	channels := make([]*libopsv1.NotificationChannel, 0, len(rows))
+	for _, row := range rows {
+		channels = append(channels, NotificationChannelToProto(db.GetNotificationChannelRow(row), organization.PublicID))
+	}
+
+	return connect.NewResponse(&libopsv1.ListNotificationChannelsResponse{
+		Channels:      channels,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// CreateNotificationChannel adds a Slack, Teams, PagerDuty or Opsgenie channel to an organization.
+func (s *NotificationChannelService) CreateNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateNotificationChannelRequest],
+) (*connect.Response[libopsv1.CreateNotificationChannelResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.StringLength("name", msg.Name, 1, 255); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	kind, err := notificationChannelKindToDB(msg.Kind)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateNotificationChannelTarget(kind, msg.WebhookUrl, msg.SlackBotToken, msg.SlackChannel, msg.IntegrationKey); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	categories, err := alertCategoriesToJSON(msg.Categories)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateNotificationChannelCategories(kind, categories); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountNotificationChannels(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count notification channels: %w", err))
+	}
+	if count >= maxNotificationChannels {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("organization already has the maximum of %d notification channels", maxNotificationChannels))
+	}
+
+	params := db.CreateNotificationChannelParams{
+		PublicID:       uuid.New().String(),
+		OrganizationID: organization.ID,
+		Name:           msg.Name,
+		Kind:           kind,
+		Categories:     categories,
+		Enabled:        true,
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	}
+	// Only keep the credentials the channel kind uses
+	switch kind {
+	case db.NotificationChannelsKindSlackApp:
+		params.SlackBotToken = msg.SlackBotToken
+		params.SlackChannel = msg.SlackChannel
+	case db.NotificationChannelsKindPagerduty, db.NotificationChannelsKindOpsgenie:
+		params.IntegrationKey = msg.IntegrationKey
+	default:
+		params.WebhookUrl = msg.WebhookUrl
+	}
+
+	if err := s.db.CreateNotificationChannel(ctx, params); err != nil {
+		slog.Error("Failed to create notification channel", "error", err, "organization_id", organization.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	channel, err := s.getChannel(ctx, organization.ID, params.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.CreateNotificationChannelResponse{
+		Channel: NotificationChannelToProto(channel, organization.PublicID),
+	}), nil
+}
+
+// UpdateNotificationChannel updates a notification channel's destination, categories or enabled state.
+func (s *NotificationChannelService) UpdateNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateNotificationChannelRequest],
+) (*connect.Response[libopsv1.UpdateNotificationChannelResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(msg.ChannelId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid channel_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.getChannel(ctx, organization.ID, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateNotificationChannelParams{
+		ID:             channel.ID,
+		Name:           channel.Name,
+		WebhookUrl:     channel.WebhookUrl,
+		SlackBotToken:  channel.SlackBotToken,
+		SlackChannel:   channel.SlackChannel,
+		IntegrationKey: channel.IntegrationKey,
+		Categories:     channel.Categories,
+		Enabled:        channel.Enabled,
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "name") && msg.Name != nil {
+		if err := validation.StringLength("name", *msg.Name, 1, 255); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Name = *msg.Name
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "webhook_url") && msg.WebhookUrl != nil {
+		params.WebhookUrl = *msg.WebhookUrl
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "slack_bot_token") && msg.SlackBotToken != nil {
+		params.SlackBotToken = *msg.SlackBotToken
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "slack_channel") && msg.SlackChannel != nil {
+		params.SlackChannel = *msg.SlackChannel
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "integration_key") && msg.IntegrationKey != nil {
+		params.IntegrationKey = *msg.IntegrationKey
+	}
+	// An empty category list only clears subscriptions when the mask names it explicitly
+	if (msg.UpdateMask != nil && service.ShouldUpdateField(msg.UpdateMask, "categories")) || len(msg.Categories) > 0 {
+		categories, err := alertCategoriesToJSON(msg.Categories)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		params.Categories = categories
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "enabled") && msg.Enabled != nil {
+		params.Enabled = *msg.Enabled
+	}
+
+	if err := validateNotificationChannelTarget(channel.Kind, params.WebhookUrl, params.SlackBotToken, params.SlackChannel, params.IntegrationKey); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validateNotificationChannelCategories(channel.Kind, params.Categories); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.db.UpdateNotificationChannel(ctx, params); err != nil {
+		slog.Error("Failed to update notification channel", "error", err, "channel_id", msg.ChannelId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	channel, err = s.getChannel(ctx, organization.ID, msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateNotificationChannelResponse{
+		Channel: NotificationChannelToProto(channel, organization.PublicID),
+	}), nil
+}
+
+// DeleteNotificationChannel removes a notification channel from an organization.
+func (s *NotificationChannelService) DeleteNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteNotificationChannelRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.ChannelId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid channel_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteNotificationChannel(ctx, db.DeleteNotificationChannelParams{
+		OrganizationID: organization.ID,
+		PublicID:       req.Msg.ChannelId,
+	})
+	if err != nil {
+		slog.Error("Failed to delete notification channel", "error", err, "channel_id", req.Msg.ChannelId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification channel not found"))
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// TestNotificationChannel queues a test alert that the event router delivers to the channel.
+// PagerDuty and Opsgenie channels are paged directly with a low-priority page that is resolved
+// right away; the response carries the outcome in the channel's last error.
+func (s *NotificationChannelService) TestNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[libopsv1.TestNotificationChannelRequest],
+) (*connect.Response[libopsv1.TestNotificationChannelResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.ChannelId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid channel_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.getChannel(ctx, organization.ID, req.Msg.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if isEscalationKind(channel.Kind) {
+		if s.escalator == nil {
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("incident escalation is not configured"))
+		}
+		// Delivery failures are recorded on the channel and returned with it
+		_ = s.escalator.Test(ctx, channel.ID, channel.Kind, channel.IntegrationKey, organization.Name)
+		channel, err = s.getChannel(ctx, organization.ID, req.Msg.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&libopsv1.TestNotificationChannelResponse{
+			Channel: NotificationChannelToProto(channel, organization.PublicID),
+		}), nil
+	}
+
+	protoChannel := NotificationChannelToProto(channel, organization.PublicID)
+	if s.emitter == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("event delivery is not configured"))
+	}
+	// The channel ID is the subject so the event router only delivers to this channel
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeNotificationChannelTest, channel.PublicID, &organization.PublicID, nil, nil, protoChannel); err != nil {
+		slog.Error("Failed to queue notification channel test", "error", err, "channel_id", channel.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to queue test alert: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.TestNotificationChannelResponse{
+		Channel: protoChannel,
+	}), nil
+}
+
+func (s *NotificationChannelService) getChannel(ctx context.Context, organizationID int64, channelID string) (db.GetNotificationChannelRow, error) {
+	channel, err := s.db.GetNotificationChannel(ctx, db.GetNotificationChannelParams{
+		OrganizationID: organizationID,
+		PublicID:       channelID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return channel, connect.NewError(connect.CodeNotFound, fmt.Errorf("notification channel not found"))
+	}
+	if err != nil {
+		return channel, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get notification channel: %w", err))
+	}
+	return channel, nil
+}
+
+// validateNotificationChannelTarget checks a channel has the credentials its kind needs.
+// Webhook hosts are restricted to Slack and Microsoft so the event router only posts to them.
+func validateNotificationChannelTarget(kind db.NotificationChannelsKind, webhookURL, botToken, slackChannel, integrationKey string) error {
+	switch kind {
+	case db.NotificationChannelsKindPagerduty:
+		if !pagerDutyRoutingKey.MatchString(integrationKey) {
+			return fmt.Errorf("integration_key must be a 32 character PagerDuty Events API v2 routing key")
+		}
+		return nil
+	case db.NotificationChannelsKindOpsgenie:
+		if err := validation.UUID(integrationKey); err != nil {
+			return fmt.Errorf("integration_key must be an Opsgenie API integration key")
+		}
+		return nil
+	case db.NotificationChannelsKindSlackApp:
+		if !strings.HasPrefix(botToken, "xoxb-") {
+			return fmt.Errorf("slack_bot_token must be a Slack bot token (xoxb-...)")
+		}
+		if err := validation.StringLength("slack_channel", slackChannel, 1, 255); err != nil {
+			return err
+		}
+		return nil
+	case db.NotificationChannelsKindSlackWebhook:
+		return validateWebhookURL(webhookURL, "hooks.slack.com")
+	case db.NotificationChannelsKindTeamsWebhook:
+		return validateWebhookURL(webhookURL, ".webhook.office.com", ".logic.azure.com", ".powerplatform.com")
+	default:
+		return fmt.Errorf("unsupported notification channel kind %q", kind)
+	}
+}
+
+// validateNotificationChannelCategories restricts PagerDuty and Opsgenie channels to downtime alerts.
+// Downtime pages are resolved on recovery; other alerts would open incidents nobody closes.
+func validateNotificationChannelCategories(kind db.NotificationChannelsKind, categories json.RawMessage) error {
+	if !isEscalationKind(kind) {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(categories, &names); err != nil || len(names) != 1 || names[0] != "downtime" {
+		return fmt.Errorf("%s channels only support the downtime alert category", kind)
+	}
+	return nil
+}
+
+// isEscalationKind reports whether a channel kind opens incidents rather than posting messages.
+func isEscalationKind(kind db.NotificationChannelsKind) bool {
+	return kind == db.NotificationChannelsKindPagerduty || kind == db.NotificationChannelsKindOpsgenie
+}
+
+// validateWebhookURL requires an https URL whose host is, or ends with, one of hosts.
+func validateWebhookURL(webhookURL string, hosts ...string) error {
+	if webhookURL == "" {
+		return fmt.Errorf("webhook_url is required")
+	}
+	if len(webhookURL) > 1024 {
+		return fmt.Errorf("webhook_url must be at most 1024 characters")
+	}
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return fmt.Errorf("webhook_url must be an https URL")
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range hosts {
+		if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("webhook_url host %q is not a supported webhook host", host)
+}
+
+func notificationChannelKindToDB(kind libopsv1.NotificationChannelKind) (db.NotificationChannelsKind, error) {
+	switch kind {
+	case libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK:
+		return db.NotificationChannelsKindSlackWebhook, nil
+	case libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_APP:
+		return db.NotificationChannelsKindSlackApp, nil
+	case libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK:
+		return db.NotificationChannelsKindTeamsWebhook, nil
+	case libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_PAGERDUTY:
+		return db.NotificationChannelsKindPagerduty, nil
+	case libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_OPSGENIE:
+		return db.NotificationChannelsKindOpsgenie, nil
+	default:
+		return "", fmt.Errorf("kind is required")
+	}
+}
+
+func notificationChannelKindToProto(kind db.NotificationChannelsKind) libopsv1.NotificationChannelKind {
+	switch kind {
+	case db.NotificationChannelsKindSlackWebhook:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK
+	case db.NotificationChannelsKindSlackApp:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_APP
+	case db.NotificationChannelsKindTeamsWebhook:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK
+	case db.NotificationChannelsKindPagerduty:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_PAGERDUTY
+	case db.NotificationChannelsKindOpsgenie:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_OPSGENIE
+	default:
+		return libopsv1.NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_UNSPECIFIED
+	}
+}
+
+// alertCategoriesToJSON converts categories to the stored JSON array, dropping duplicates.
+func alertCategoriesToJSON(categories []libopsv1.AlertCategory) (json.RawMessage, error) {
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("at least one alert category is required")
+	}
+	names := make([]string, 0, len(categories))
+	seen := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		name, ok := alertCategoryNames[category]
+		if !ok {
+			return nil, fmt.Errorf("unsupported alert category %s", category)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return json.Marshal(names)
+}
+
+func alertCategoriesFromJSON(raw json.RawMessage) []libopsv1.AlertCategory {
+	var names []string
+	if err := json.Unmarshal(raw, &names); err != nil {
+		return nil
+	}
+	categories := make([]libopsv1.AlertCategory, 0, len(names))
+	for _, name := range names {
+		for category, categoryName := range alertCategoryNames {
+			if categoryName == name {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	return categories
+}
+
+// NotificationChannelToProto converts a channel row, redacting its credentials.
+func NotificationChannelToProto(channel db.GetNotificationChannelRow, organizationID string) *libopsv1.NotificationChannel {
+	pb := &libopsv1.NotificationChannel{
+		ChannelId:      channel.PublicID,
+		OrganizationId: organizationID,
+		Name:           channel.Name,
+		Kind:           notificationChannelKindToProto(channel.Kind),
+		Destination:    redactedDestination(channel),
+		Categories:     alertCategoriesFromJSON(channel.Categories),
+		Enabled:        channel.Enabled,
+		LastError:      channel.LastError.String,
+	}
+	if channel.LastDeliveryAt.Valid {
+		pb.LastDeliveryAt = channel.LastDeliveryAt.Time.Unix()
+	}
+	if channel.CreatedAt.Valid {
+		pb.CreatedAt = channel.CreatedAt.Time.Unix()
+	}
+	return pb
+}
+
+// redactedDestination describes where a channel posts without exposing its webhook secret.
+func redactedDestination(channel db.GetNotificationChannelRow) string {
+	switch channel.Kind {
+	case db.NotificationChannelsKindSlackApp:
+		return channel.SlackChannel
+	case db.NotificationChannelsKindPagerduty:
+		return "PagerDuty …" + lastChars(channel.IntegrationKey, 4)
+	case db.NotificationChannelsKindOpsgenie:
+		return "Opsgenie …" + lastChars(channel.IntegrationKey, 4)
+	}
+	u, err := url.Parse(channel.WebhookUrl)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Host + "/…" + lastChars(channel.WebhookUrl, 4)
+}
+
+func lastChars(s string, n int) string {
+	if len(s) > n {
+		return s[len(s)-n:]
+	}
+	return s
+}
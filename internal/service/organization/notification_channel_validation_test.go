@@ -0,0 +1,155 @@
+package organization
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/libops/api/db"
+)
+
+// TestValidateNotificationChannelTarget tests that channels only post to Slack and Microsoft hosts.
+func TestValidateNotificationChannelTarget(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         db.NotificationChannelsKind
+		webhookURL   string
+		botToken     string
+		slackChannel string
+		integration  string
+		wantError    bool
+	}{
+		{
+			name:       "valid slack webhook",
+			kind:       db.NotificationChannelsKindSlackWebhook,
+			webhookURL: "https://hooks.slack.com/services/T000/B000/XXXX",
+		},
+		{
+			name:       "slack webhook on another host",
+			kind:       db.NotificationChannelsKindSlackWebhook,
+			webhookURL: "https://hooks.slack.com.example.com/services/T000",
+			wantError:  true,
+		},
+		{
+			name:       "slack webhook over http",
+			kind:       db.NotificationChannelsKindSlackWebhook,
+			webhookURL: "http://hooks.slack.com/services/T000",
+			wantError:  true,
+		},
+		{
+			name:      "missing webhook url",
+			kind:      db.NotificationChannelsKindSlackWebhook,
+			wantError: true,
+		},
+		{
+			name:       "valid teams webhook",
+			kind:       db.NotificationChannelsKindTeamsWebhook,
+			webhookURL: "https://acme.webhook.office.com/webhookb2/abc",
+		},
+		{
+			name:       "valid power automate webhook",
+			kind:       db.NotificationChannelsKindTeamsWebhook,
+			webhookURL: "https://prod-00.westus.logic.azure.com/workflows/abc",
+		},
+		{
+			name:       "teams webhook on internal host",
+			kind:       db.NotificationChannelsKindTeamsWebhook,
+			webhookURL: "https://169.254.169.254/latest",
+			wantError:  true,
+		},
+		{
+			name:         "valid slack app",
+			kind:         db.NotificationChannelsKindSlackApp,
+			botToken:     "xoxb-123-456",
+			slackChannel: "#ops",
+		},
+		{
+			name:         "slack app with user token",
+			kind:         db.NotificationChannelsKindSlackApp,
+			botToken:     "xoxp-123-456",
+			slackChannel: "#ops",
+			wantError:    true,
+		},
+		{
+			name:      "slack app without channel",
+			kind:      db.NotificationChannelsKindSlackApp,
+			botToken:  "xoxb-123-456",
+			wantError: true,
+		},
+		{
+			name:        "valid pagerduty routing key",
+			kind:        db.NotificationChannelsKindPagerduty,
+			integration: "0123456789abcdef0123456789ABCDEF",
+		},
+		{
+			name:        "short pagerduty routing key",
+			kind:        db.NotificationChannelsKindPagerduty,
+			integration: "0123456789abcdef",
+			wantError:   true,
+		},
+		{
+			name:        "valid opsgenie api key",
+			kind:        db.NotificationChannelsKindOpsgenie,
+			integration: "5f0c3a4e-8b1d-4c2a-9e7f-1a2b3c4d5e6f",
+		},
+		{
+			name:        "opsgenie key that is not a uuid",
+			kind:        db.NotificationChannelsKindOpsgenie,
+			integration: "not-a-key",
+			wantError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNotificationChannelTarget(tt.kind, tt.webhookURL, tt.botToken, tt.slackChannel, tt.integration)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateNotificationChannelTarget() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestRedactedDestination tests that webhook secrets never appear in a channel's destination.
+func TestRedactedDestination(t *testing.T) {
+	webhook := db.GetNotificationChannelRow{
+		Kind:       db.NotificationChannelsKindSlackWebhook,
+		WebhookUrl: "https://hooks.slack.com/services/T000/B000/secret9f2c",
+	}
+	if got, want := redactedDestination(webhook), "hooks.slack.com/…9f2c"; got != want {
+		t.Errorf("redactedDestination() = %q, want %q", got, want)
+	}
+
+	app := db.GetNotificationChannelRow{
+		Kind:          db.NotificationChannelsKindSlackApp,
+		SlackBotToken: "xoxb-123-456",
+		SlackChannel:  "#ops",
+	}
+	if got, want := redactedDestination(app), "#ops"; got != want {
+		t.Errorf("redactedDestination() = %q, want %q", got, want)
+	}
+}
+
+// TestValidateNotificationChannelCategories tests that escalation channels only take downtime alerts.
+func TestValidateNotificationChannelCategories(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       db.NotificationChannelsKind
+		categories string
+		wantError  bool
+	}{
+		{"pagerduty downtime", db.NotificationChannelsKindPagerduty, `["downtime"]`, false},
+		{"opsgenie downtime", db.NotificationChannelsKindOpsgenie, `["downtime"]`, false},
+		{"pagerduty billing", db.NotificationChannelsKindPagerduty, `["billing"]`, true},
+		{"opsgenie downtime and security", db.NotificationChannelsKindOpsgenie, `["downtime", "security"]`, true},
+		{"slack any categories", db.NotificationChannelsKindSlackWebhook, `["billing", "downtime"]`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNotificationChannelCategories(tt.kind, json.RawMessage(tt.categories))
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateNotificationChannelCategories() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
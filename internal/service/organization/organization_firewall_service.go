@@ -11,6 +11,7 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
@@ -19,7 +20,8 @@ import (
 
 // FirewallService implements the LibOps FirewallService API.
 type FirewallService struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // Compile-time check.
@@ -28,7 +30,8 @@ var _ libopsv1connect.FirewallServiceHandler = (*FirewallService)(nil)
 // NewFirewallService creates a new FirewallService instance.
 func NewFirewallService(querier db.Querier) *FirewallService {
 	return &FirewallService{
-		db: querier,
+		db:     querier,
+		quotas: quota.NewEnforcer(querier),
 	}
 }
 
@@ -122,6 +125,14 @@ func (s *FirewallService) CreateOrganizationFirewallRule(
 		return nil, err
 	}
 
+	ruleCount, err := s.db.CountOrganizationFirewallRules(ctx, sql.NullInt64{Int64: organization.ID, Valid: true})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count firewall rules: %w", err))
+	}
+	if err := s.quotas.Check(ctx, organization.ID, quota.FirewallRules, ruleCount); err != nil {
+		return nil, err
+	}
+
 	params := db.CreateOrganizationFirewallRuleParams{
 		OrganizationID: sql.NullInt64{Int64: organization.ID, Valid: true},
 		RuleType:       db.OrganizationFirewallRulesRuleType(ConvertProtoFirewallRuleTypeToString(req.Msg.RuleType)),
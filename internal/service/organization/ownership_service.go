@@ -0,0 +1,368 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/notify"
+	"github.com/libops/api/internal/ownership"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// ownershipTransferTTL is how long the new owner has to accept a transfer
+const ownershipTransferTTL = 7 * 24 * time.Hour
+
+// OwnershipService implements the OwnershipService API.
+type OwnershipService struct {
+	db          db.Querier
+	reassigner  *ownership.Reassigner
+	notifier    *notify.Notifier
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.OwnershipServiceHandler = (*OwnershipService)(nil)
+
+// NewOwnershipService creates a new OwnershipService instance.
+func NewOwnershipService(querier db.Querier, reassigner *ownership.Reassigner, notifier *notify.Notifier, auditLogger *audit.Logger) *OwnershipService {
+	return &OwnershipService{
+		db:          querier,
+		reassigner:  reassigner,
+		notifier:    notifier,
+		auditLogger: auditLogger,
+	}
+}
+
+// TransferOrganizationOwnership offers an organization to one of its members.
+func (s *OwnershipService) TransferOrganizationOwnership(
+	ctx context.Context,
+	req *connect.Request[libopsv1.TransferOrganizationOwnershipRequest],
+) (*connect.Response[libopsv1.TransferOrganizationOwnershipResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(msg.NewOwnerId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid new_owner_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := s.db.GetAccount(ctx, msg.NewOwnerId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if newOwner.ID == userInfo.AccountID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("you can't transfer an organization to yourself"))
+	}
+	if newOwner.AuthMethod == db.AccountsAuthMethodGcloud {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organizations can't be transferred to service accounts"))
+	}
+
+	_, err = s.db.GetOrganizationMemberByAccountAndOrganization(ctx, db.GetOrganizationMemberByAccountAndOrganizationParams{
+		AccountID:      newOwner.ID,
+		OrganizationID: organization.ID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the new owner must be an active member of the organization"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.db.CancelPendingOrganizationOwnershipTransfers(ctx, organization.ID); err != nil {
+		slog.Error("Failed to cancel ownership transfers", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	transferID := uuid.New().String()
+	err = s.db.CreateOrganizationOwnershipTransfer(ctx, db.CreateOrganizationOwnershipTransferParams{
+		PublicID:       transferID,
+		OrganizationID: organization.ID,
+		FromAccountID:  userInfo.AccountID,
+		ToAccountID:    newOwner.ID,
+		ExpiresAt:      time.Now().Add(ownershipTransferTTL),
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		slog.Error("Failed to create ownership transfer", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OwnershipTransferInitiate, map[string]any{
+		"transfer_id": transferID,
+		"to_account":  newOwner.PublicID,
+	})
+
+	transfer, err := s.getTransfer(ctx, organization.ID, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, newOwner.ID, notify.Notification{
+		Title: fmt.Sprintf("%s wants you to own %s", transfer.FromEmail, organization.Name),
+		Body:  "Accept the transfer on the organization page to become its owner.",
+		Link:  "/organizations/" + organization.PublicID,
+	})
+
+	return connect.NewResponse(&libopsv1.TransferOrganizationOwnershipResponse{
+		Transfer: transferToProto(transfer, organization.PublicID),
+	}), nil
+}
+
+// GetOrganizationOwnershipTransfer gets the organization's open transfer, if any.
+func (s *OwnershipService) GetOrganizationOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetOrganizationOwnershipTransferRequest],
+) (*connect.Response[libopsv1.GetOrganizationOwnershipTransferResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.db.GetPendingOrganizationOwnershipTransfer(ctx, organization.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewResponse(&libopsv1.GetOrganizationOwnershipTransferResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.GetOrganizationOwnershipTransferResponse{
+		Transfer: transferToProto(db.GetOrganizationOwnershipTransferRow(row), organization.PublicID),
+	}), nil
+}
+
+// AcceptOrganizationOwnershipTransfer makes the caller an owner of the
+// organization, demotes the previous owner to developer and reassigns the
+// previous owner's billing contact and service account keys.
+func (s *OwnershipService) AcceptOrganizationOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[libopsv1.AcceptOrganizationOwnershipTransferRequest],
+) (*connect.Response[libopsv1.AcceptOrganizationOwnershipTransferResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.TransferId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid transfer_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.getTransfer(ctx, organization.ID, req.Msg.TransferId)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToAccountID != userInfo.AccountID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only %s can accept this transfer", transfer.ToEmail))
+	}
+
+	accepted, err := s.db.AcceptOrganizationOwnershipTransfer(ctx, transfer.ID)
+	if err != nil {
+		slog.Error("Failed to accept ownership transfer", "error", err, "transfer_id", transfer.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if accepted == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the transfer was cancelled or has expired"))
+	}
+
+	err = s.db.UpdateOrganizationMember(ctx, db.UpdateOrganizationMemberParams{
+		Role:           db.OrganizationMembersRoleOwner,
+		UpdatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		OrganizationID: organization.ID,
+		AccountID:      transfer.ToAccountID,
+	})
+	if err != nil {
+		slog.Error("Failed to promote new owner", "error", err, "transfer_id", transfer.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// The previous owner stays on as a developer, unless they already left
+	previous, err := s.db.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		AccountID:      transfer.FromAccountID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err == nil && previous.Role == db.OrganizationMembersRoleOwner {
+		err = s.db.UpdateOrganizationMember(ctx, db.UpdateOrganizationMemberParams{
+			Role:           db.OrganizationMembersRoleDeveloper,
+			UpdatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+			OrganizationID: organization.ID,
+			AccountID:      transfer.FromAccountID,
+		})
+		if err != nil {
+			slog.Error("Failed to demote previous owner", "error", err, "transfer_id", transfer.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	if err := s.reassigner.Reassign(ctx, organization.ID, transfer.FromAccountID, transfer.ToAccountID, transfer.ToEmail); err != nil {
+		slog.Error("Failed to reassign organization resources", "error", err, "transfer_id", transfer.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to reassign organization resources"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OwnershipTransferAccept, map[string]any{
+		"transfer_id":  transfer.PublicID,
+		"from_account": transfer.FromAccountPublicID,
+	})
+
+	s.notify(ctx, transfer.FromAccountID, notify.Notification{
+		Title: fmt.Sprintf("%s now owns %s", transfer.ToEmail, organization.Name),
+		Body:  "Your ownership transfer was accepted. You remain a developer of the organization.",
+		Link:  "/organizations/" + organization.PublicID,
+	})
+
+	transfer, err = s.getTransfer(ctx, organization.ID, transfer.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.AcceptOrganizationOwnershipTransferResponse{
+		Transfer: transferToProto(transfer, organization.PublicID),
+	}), nil
+}
+
+// CancelOrganizationOwnershipTransfer cancels an open transfer.
+func (s *OwnershipService) CancelOrganizationOwnershipTransfer(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CancelOrganizationOwnershipTransferRequest],
+) (*connect.Response[libopsv1.CancelOrganizationOwnershipTransferResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.TransferId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid transfer_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.getTransfer(ctx, organization.ID, req.Msg.TransferId)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.db.CancelOrganizationOwnershipTransfer(ctx, transfer.ID)
+	if err != nil {
+		slog.Error("Failed to cancel ownership transfer", "error", err, "transfer_id", transfer.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if cancelled == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the transfer is no longer pending"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OwnershipTransferCancel, map[string]any{
+		"transfer_id": transfer.PublicID,
+	})
+
+	transfer.Status = db.OrganizationOwnershipTransfersStatusCancelled
+	return connect.NewResponse(&libopsv1.CancelOrganizationOwnershipTransferResponse{
+		Transfer: transferToProto(transfer, organization.PublicID),
+	}), nil
+}
+
+// getTransfer returns an organization's transfer, NotFound when it belongs to another organization.
+func (s *OwnershipService) getTransfer(ctx context.Context, organizationID int64, transferID string) (db.GetOrganizationOwnershipTransferRow, error) {
+	row, err := s.db.GetOrganizationOwnershipTransfer(ctx, transferID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && row.OrganizationID != organizationID) {
+		return row, connect.NewError(connect.CodeNotFound, fmt.Errorf("ownership transfer not found"))
+	}
+	if err != nil {
+		return row, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get ownership transfer: %w", err))
+	}
+	return row, nil
+}
+
+// notify records an ownership notification; failures are logged.
+func (s *OwnershipService) notify(ctx context.Context, accountID int64, notification notify.Notification) {
+	if s.notifier == nil {
+		return
+	}
+	notification.Type = notify.TypeOwnershipTransfer
+	if err := s.notifier.Notify(ctx, accountID, notification); err != nil {
+		slog.Error("Failed to notify ownership transfer", "error", err, "account_id", accountID)
+	}
+}
+
+func transferToProto(row db.GetOrganizationOwnershipTransferRow, organizationPublicID string) *libopsv1.OwnershipTransfer {
+	transfer := &libopsv1.OwnershipTransfer{
+		TransferId:     row.PublicID,
+		OrganizationId: organizationPublicID,
+		FromAccountId:  row.FromAccountPublicID,
+		FromEmail:      row.FromEmail,
+		ToAccountId:    row.ToAccountPublicID,
+		ToEmail:        row.ToEmail,
+		Status:         transferStatusToProto(row.Status, row.ExpiresAt),
+		ExpiresAt:      row.ExpiresAt.Unix(),
+	}
+	if row.CreatedAt.Valid {
+		transfer.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.AcceptedAt.Valid {
+		transfer.AcceptedAt = row.AcceptedAt.Time.Unix()
+	}
+	return transfer
+}
+
+// transferStatusToProto maps a transfer's status; pending transfers past their expiry are expired.
+func transferStatusToProto(status db.OrganizationOwnershipTransfersStatus, expiresAt time.Time) libopsv1.OwnershipTransferStatus {
+	switch status {
+	case db.OrganizationOwnershipTransfersStatusPending:
+		if !expiresAt.After(time.Now()) {
+			return libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_EXPIRED
+		}
+		return libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_PENDING
+	case db.OrganizationOwnershipTransfersStatusAccepted:
+		return libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_ACCEPTED
+	case db.OrganizationOwnershipTransfersStatusCancelled:
+		return libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_CANCELLED
+	default:
+		return libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED
+	}
+}
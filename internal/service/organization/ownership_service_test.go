@@ -0,0 +1,168 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/notify"
+	"github.com/libops/api/internal/ownership"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ownershipQuerier is an organization owned by account 3 with developer 4, and
+// the transfers created in it
+func ownershipQuerier(orgID string, transfers map[string]*db.GetOrganizationOwnershipTransferRow) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID, Name: "Library"}, nil
+		},
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			return db.GetAccountRow{ID: 4, PublicID: publicID, Email: "dev@example.edu", AuthMethod: db.AccountsAuthMethodGoogle}, nil
+		},
+		CreateOrganizationOwnershipTransferFunc: func(ctx context.Context, arg db.CreateOrganizationOwnershipTransferParams) error {
+			transfers[arg.PublicID] = &db.GetOrganizationOwnershipTransferRow{
+				ID:             int64(len(transfers) + 1),
+				PublicID:       arg.PublicID,
+				OrganizationID: arg.OrganizationID,
+				FromAccountID:  arg.FromAccountID,
+				ToAccountID:    arg.ToAccountID,
+				Status:         db.OrganizationOwnershipTransfersStatusPending,
+				ExpiresAt:      arg.ExpiresAt,
+				FromEmail:      "owner@example.edu",
+				ToEmail:        "dev@example.edu",
+			}
+			return nil
+		},
+		GetOrganizationOwnershipTransferFunc: func(ctx context.Context, publicID string) (db.GetOrganizationOwnershipTransferRow, error) {
+			if transfer, ok := transfers[publicID]; ok {
+				return *transfer, nil
+			}
+			return db.GetOrganizationOwnershipTransferRow{}, sql.ErrNoRows
+		},
+		AcceptOrganizationOwnershipTransferFunc: func(ctx context.Context, id int64) (int64, error) {
+			for _, transfer := range transfers {
+				if transfer.ID == id && transfer.Status == db.OrganizationOwnershipTransfersStatusPending {
+					transfer.Status = db.OrganizationOwnershipTransfersStatusAccepted
+					return 1, nil
+				}
+			}
+			return 0, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{AccountID: arg.AccountID, Role: db.OrganizationMembersRoleOwner}, nil
+		},
+	}
+}
+
+// TestOwnershipTransfer tests that a transfer is offered to a member, accepted by
+// them only, and then swaps the owner and reassigns the owner's keys.
+func TestOwnershipTransfer(t *testing.T) {
+	orgID, newOwnerID := uuid.NewString(), uuid.NewString()
+	transfers := map[string]*db.GetOrganizationOwnershipTransferRow{}
+	var roles []db.UpdateOrganizationMemberParams
+	var reassigned []db.ReassignServiceAccountAPIKeysParams
+	var notified []db.CreateNotificationParams
+	var audited []string
+	mock := ownershipQuerier(orgID, transfers)
+	mock.UpdateOrganizationMemberFunc = func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+		roles = append(roles, arg)
+		return nil
+	}
+	mock.ReassignServiceAccountAPIKeysFunc = func(ctx context.Context, arg db.ReassignServiceAccountAPIKeysParams) (int64, error) {
+		reassigned = append(reassigned, arg)
+		return 2, nil
+	}
+	mock.CreateNotificationFunc = func(ctx context.Context, arg db.CreateNotificationParams) error {
+		notified = append(notified, arg)
+		return nil
+	}
+	mock.CreateAuditEventFunc = func(ctx context.Context, arg db.CreateAuditEventParams) error {
+		audited = append(audited, arg.EventName)
+		return nil
+	}
+	svc := NewOwnershipService(mock, ownership.NewReassigner(mock, nil), notify.NewNotifier(mock), audit.New(mock))
+	owner := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	newOwner := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 4})
+
+	resp, err := svc.TransferOrganizationOwnership(owner, connect.NewRequest(&libopsv1.TransferOrganizationOwnershipRequest{
+		OrganizationId: orgID,
+		NewOwnerId:     newOwnerID,
+	}))
+	require.NoError(t, err)
+	transfer := resp.Msg.Transfer
+	assert.Equal(t, libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_PENDING, transfer.Status)
+	assert.Equal(t, "dev@example.edu", transfer.ToEmail)
+	require.Len(t, notified, 1)
+	assert.Equal(t, int64(4), notified[0].AccountID)
+	assert.Equal(t, notify.TypeOwnershipTransfer, notified[0].Type)
+
+	accept := func(ctx context.Context) error {
+		_, err := svc.AcceptOrganizationOwnershipTransfer(ctx, connect.NewRequest(&libopsv1.AcceptOrganizationOwnershipTransferRequest{
+			OrganizationId: orgID,
+			TransferId:     transfer.TransferId,
+		}))
+		return err
+	}
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(accept(owner)), "only the new owner accepts")
+	require.NoError(t, accept(newOwner))
+
+	assert.Equal(t, []db.UpdateOrganizationMemberParams{
+		{Role: db.OrganizationMembersRoleOwner, UpdatedBy: sql.NullInt64{Int64: 4, Valid: true}, OrganizationID: 7, AccountID: 4},
+		{Role: db.OrganizationMembersRoleDeveloper, UpdatedBy: sql.NullInt64{Int64: 4, Valid: true}, OrganizationID: 7, AccountID: 3},
+	}, roles)
+	require.Len(t, reassigned, 1)
+	assert.Equal(t, int64(3), reassigned[0].FromAccountID.Int64)
+	assert.Equal(t, int64(4), reassigned[0].ToAccountID.Int64)
+	assert.Equal(t, []string{string(audit.OwnershipTransferInitiate), string(audit.OwnershipTransferAccept)}, audited)
+	require.Len(t, notified, 2)
+	assert.Equal(t, int64(3), notified[1].AccountID, "the previous owner hears it was accepted")
+
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(accept(newOwner)), "a transfer is accepted once")
+}
+
+// TestTransferOrganizationOwnershipValidation tests who an organization can be
+// transferred to.
+func TestTransferOrganizationOwnershipValidation(t *testing.T) {
+	orgID := uuid.NewString()
+	mock := ownershipQuerier(orgID, map[string]*db.GetOrganizationOwnershipTransferRow{})
+	svc := NewOwnershipService(mock, ownership.NewReassigner(mock, nil), nil, audit.New(mock))
+	transfer := func(accountID int64) error {
+		ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: accountID})
+		_, err := svc.TransferOrganizationOwnership(ctx, connect.NewRequest(&libopsv1.TransferOrganizationOwnershipRequest{
+			OrganizationId: orgID,
+			NewOwnerId:     uuid.NewString(),
+		}))
+		return err
+	}
+
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(transfer(4)), "not to yourself")
+
+	mock.GetOrganizationMemberByAccountAndOrganizationFunc = func(ctx context.Context, arg db.GetOrganizationMemberByAccountAndOrganizationParams) (db.OrganizationMember, error) {
+		return db.OrganizationMember{}, sql.ErrNoRows
+	}
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(transfer(3)), "only to members")
+
+	mock.GetAccountFunc = func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+		return db.GetAccountRow{ID: 5, AuthMethod: db.AccountsAuthMethodGcloud}, nil
+	}
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(transfer(3)), "not to service accounts")
+}
+
+// TestTransferStatusToProto tests that unaccepted transfers expire.
+func TestTransferStatusToProto(t *testing.T) {
+	assert.Equal(t, libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_EXPIRED,
+		transferStatusToProto(db.OrganizationOwnershipTransfersStatusPending, time.Now().Add(-time.Minute)))
+	assert.Equal(t, libopsv1.OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_ACCEPTED,
+		transferStatusToProto(db.OrganizationOwnershipTransfersStatusAccepted, time.Now().Add(-time.Minute)))
+}
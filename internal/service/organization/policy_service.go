@@ -0,0 +1,416 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/policy"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// PolicyService implements the PolicyService API.
+type PolicyService struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.PolicyServiceHandler = (*PolicyService)(nil)
+
+// NewPolicyService creates a new PolicyService instance.
+func NewPolicyService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *PolicyService {
+	return &PolicyService{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// CreatePolicy adds a policy to an organization. Policies are enforced
+// unless asked to only audit.
+func (s *PolicyService) CreatePolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreatePolicyRequest],
+) (*connect.Response[libopsv1.CreatePolicyResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rule, ok := policy.RuleFromProto(msg.Rule)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule is required"))
+	}
+	enforcement := db.OrganizationPoliciesEnforcementEnforce
+	if msg.Enforcement != libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED {
+		if enforcement, ok = enforcementFromProto(msg.Enforcement); !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid enforcement"))
+		}
+	}
+	config, err := policyConfig(rule, msg.DeployWindow)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if rule == db.OrganizationPoliciesRuleProductionDeployWindow && config == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("deploy_window is required for %s", msg.Rule))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyID := uuid.New().String()
+	err = s.db.CreateOrganizationPolicy(ctx, db.CreateOrganizationPolicyParams{
+		PublicID:       policyID,
+		OrganizationID: organization.ID,
+		Rule:           rule,
+		Enforcement:    enforcement,
+		Config:         config,
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the organization already has a %s policy", msg.Rule))
+		}
+		slog.Error("Failed to create policy", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	p, err := s.record(ctx, userInfo, organization, policyID, audit.PolicyCreate, events.EventTypePolicyCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.CreatePolicyResponse{Policy: p}), nil
+}
+
+// ListPolicies lists an organization's policies.
+func (s *PolicyService) ListPolicies(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPoliciesRequest],
+) (*connect.Response[libopsv1.ListPoliciesResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListOrganizationPolicies(ctx, db.ListOrganizationPoliciesParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list policies", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	policies := make([]*libopsv1.Policy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, policyToProto(db.GetOrganizationPolicyRow(row), organization.PublicID))
+	}
+
+	return connect.NewResponse(&libopsv1.ListPoliciesResponse{
+		Policies:      policies,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// UpdatePolicy changes how a policy is enforced, or its deploy window.
+func (s *PolicyService) UpdatePolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdatePolicyRequest],
+) (*connect.Response[libopsv1.UpdatePolicyResponse], error) {
+	msg := req.Msg
+	if msg.Enforcement == libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED && msg.DeployWindow == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("set enforcement or deploy_window"))
+	}
+
+	userInfo, organization, existing, err := s.resolve(ctx, msg.OrganizationId, msg.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	enforcement := existing.Enforcement
+	if msg.Enforcement != libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED {
+		var ok bool
+		if enforcement, ok = enforcementFromProto(msg.Enforcement); !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid enforcement"))
+		}
+	}
+	config := existing.Config
+	if msg.DeployWindow != nil {
+		if config, err = policyConfig(existing.Rule, msg.DeployWindow); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	err = s.db.UpdateOrganizationPolicy(ctx, db.UpdateOrganizationPolicyParams{
+		Enforcement: enforcement,
+		Config:      config,
+		UpdatedBy:   sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:    existing.PublicID,
+	})
+	if err != nil {
+		slog.Error("Failed to update policy", "error", err, "policy_id", existing.PublicID, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	p, err := s.record(ctx, userInfo, organization, existing.PublicID, audit.PolicyUpdate, events.EventTypePolicyUpdated)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdatePolicyResponse{Policy: p}), nil
+}
+
+// DeletePolicy removes a policy. Its violations are kept.
+func (s *PolicyService) DeletePolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeletePolicyRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, organization, existing, err := s.resolve(ctx, req.Msg.OrganizationId, req.Msg.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteOrganizationPolicy(ctx, existing.PublicID); err != nil {
+		slog.Error("Failed to delete policy", "error", err, "policy_id", existing.PublicID, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	p := policyToProto(existing, organization.PublicID)
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.PolicyDelete, map[string]any{
+		"policy_id": existing.PublicID,
+		"rule":      string(existing.Rule),
+	})
+	s.emit(ctx, events.EventTypePolicyDeleted, organization.PublicID, p)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListPolicyViolations lists changes that broke the organization's policies,
+// newest first.
+func (s *PolicyService) ListPolicyViolations(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPolicyViolationsRequest],
+) (*connect.Response[libopsv1.ListPolicyViolationsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListPolicyViolations(ctx, db.ListPolicyViolationsParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list policy violations", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	violations := make([]*libopsv1.PolicyViolation, 0, len(rows))
+	for _, row := range rows {
+		violation := &libopsv1.PolicyViolation{
+			ViolationId:  row.PublicID,
+			PolicyId:     row.PolicyPublicID,
+			Rule:         policy.RuleToProto(db.OrganizationPoliciesRule(row.Rule)),
+			AccountEmail: row.AccountEmail.String,
+			Procedure:    row.ProcedureName,
+			ResourceId:   row.ResourceID,
+			Message:      row.Message,
+			Blocked:      row.Blocked,
+		}
+		if row.CreatedAt.Valid {
+			violation.CreatedAt = row.CreatedAt.Time.Unix()
+		}
+		violations = append(violations, violation)
+	}
+
+	return connect.NewResponse(&libopsv1.ListPolicyViolationsResponse{
+		Violations:    violations,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// resolve authenticates the caller and returns one of the organization's
+// policies, NotFound when it isn't theirs.
+func (s *PolicyService) resolve(ctx context.Context, organizationID, policyID string) (*auth.UserInfo, db.GetOrganizationRow, db.GetOrganizationPolicyRow, error) {
+	var organization db.GetOrganizationRow
+	var existing db.GetOrganizationPolicyRow
+
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, organization, existing, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(policyID); err != nil {
+		return nil, organization, existing, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid policy_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, organization, existing, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, organizationID)
+	if err != nil {
+		return nil, organization, existing, err
+	}
+
+	existing, err = s.db.GetOrganizationPolicy(ctx, policyID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && existing.OrganizationID != organization.ID) {
+		return nil, organization, existing, connect.NewError(connect.CodeNotFound, fmt.Errorf("policy not found"))
+	}
+	if err != nil {
+		return nil, organization, existing, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get policy: %w", err))
+	}
+
+	return userInfo, organization, existing, nil
+}
+
+// record audits and emits a created or updated policy.
+func (s *PolicyService) record(ctx context.Context, userInfo *auth.UserInfo, organization db.GetOrganizationRow, policyID string, action audit.Event, eventType string) (*libopsv1.Policy, error) {
+	row, err := s.db.GetOrganizationPolicy(ctx, policyID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get policy: %w", err))
+	}
+	p := policyToProto(row, organization.PublicID)
+
+	details := map[string]any{
+		"policy_id":   row.PublicID,
+		"rule":        string(row.Rule),
+		"enforcement": string(row.Enforcement),
+	}
+	if window, err := policy.ParseDeployWindow(row.Config); err == nil {
+		details["deploy_window"] = window.String()
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, action, details)
+	s.emit(ctx, eventType, organization.PublicID, p)
+
+	return p, nil
+}
+
+func (s *PolicyService) emit(ctx context.Context, eventType, organizationID string, p *libopsv1.Policy) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, p.PolicyId, &organizationID, nil, nil, p); err != nil {
+		slog.Error("Failed to emit policy event", "error", err, "policy_id", p.PolicyId)
+	}
+}
+
+// policyConfig validates a deploy window and returns it as a rule's config.
+// Only the deploy window rule takes one.
+func policyConfig(rule db.OrganizationPoliciesRule, window *libopsv1.DeployWindow) (types.RawJSON, error) {
+	if window == nil {
+		return nil, nil
+	}
+	if rule != db.OrganizationPoliciesRuleProductionDeployWindow {
+		return nil, fmt.Errorf("deploy_window only applies to %s", libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW)
+	}
+	w := policy.DeployWindow{
+		Days:      window.Days,
+		StartHour: window.StartHour,
+		EndHour:   window.EndHour,
+		Timezone:  window.Timezone,
+	}
+	if err := w.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid deploy_window: %w", err)
+	}
+	config, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return types.RawJSON(config), nil
+}
+
+func policyToProto(row db.GetOrganizationPolicyRow, organizationID string) *libopsv1.Policy {
+	p := &libopsv1.Policy{
+		PolicyId:       row.PublicID,
+		OrganizationId: organizationID,
+		Rule:           policy.RuleToProto(row.Rule),
+		Enforcement:    enforcementToProto(row.Enforcement),
+	}
+	if row.Rule == db.OrganizationPoliciesRuleProductionDeployWindow {
+		if window, err := policy.ParseDeployWindow(row.Config); err == nil {
+			p.DeployWindow = &libopsv1.DeployWindow{
+				Days:      window.Days,
+				StartHour: window.StartHour,
+				EndHour:   window.EndHour,
+				Timezone:  window.Timezone,
+			}
+		}
+	}
+	if row.CreatedAt.Valid {
+		p.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.UpdatedAt.Valid {
+		p.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return p
+}
+
+func enforcementToProto(enforcement db.OrganizationPoliciesEnforcement) libopsv1.PolicyEnforcement {
+	switch enforcement {
+	case db.OrganizationPoliciesEnforcementEnforce:
+		return libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE
+	case db.OrganizationPoliciesEnforcementAudit:
+		return libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT
+	default:
+		return libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED
+	}
+}
+
+func enforcementFromProto(enforcement libopsv1.PolicyEnforcement) (db.OrganizationPoliciesEnforcement, bool) {
+	switch enforcement {
+	case libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE:
+		return db.OrganizationPoliciesEnforcementEnforce, true
+	case libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT:
+		return db.OrganizationPoliciesEnforcementAudit, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,166 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// policyQuerier stores policies in memory, keyed by public ID, keeping one
+// per rule per organization.
+func policyQuerier(orgs map[string]int64, policies map[string]*db.GetOrganizationPolicyRow) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			id, ok := orgs[publicID]
+			if !ok {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: id, PublicID: publicID}, nil
+		},
+		CreateOrganizationPolicyFunc: func(ctx context.Context, arg db.CreateOrganizationPolicyParams) error {
+			for _, p := range policies {
+				if p.OrganizationID == arg.OrganizationID && p.Rule == arg.Rule {
+					return &mysql.MySQLError{Number: 1062}
+				}
+			}
+			policies[arg.PublicID] = &db.GetOrganizationPolicyRow{
+				ID:             int64(len(policies) + 1),
+				PublicID:       arg.PublicID,
+				OrganizationID: arg.OrganizationID,
+				Rule:           arg.Rule,
+				Enforcement:    arg.Enforcement,
+				Config:         arg.Config,
+			}
+			return nil
+		},
+		GetOrganizationPolicyFunc: func(ctx context.Context, publicID string) (db.GetOrganizationPolicyRow, error) {
+			p, ok := policies[publicID]
+			if !ok {
+				return db.GetOrganizationPolicyRow{}, sql.ErrNoRows
+			}
+			return *p, nil
+		},
+		ListOrganizationPoliciesFunc: func(ctx context.Context, arg db.ListOrganizationPoliciesParams) ([]db.ListOrganizationPoliciesRow, error) {
+			var rows []db.ListOrganizationPoliciesRow
+			for _, p := range policies {
+				if p.OrganizationID == arg.OrganizationID {
+					rows = append(rows, db.ListOrganizationPoliciesRow(*p))
+				}
+			}
+			return rows, nil
+		},
+		UpdateOrganizationPolicyFunc: func(ctx context.Context, arg db.UpdateOrganizationPolicyParams) error {
+			p := policies[arg.PublicID]
+			p.Enforcement, p.Config = arg.Enforcement, arg.Config
+			return nil
+		},
+		DeleteOrganizationPolicyFunc: func(ctx context.Context, publicID string) error {
+			delete(policies, publicID)
+			return nil
+		},
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	orgID, otherID := uuid.NewString(), uuid.NewString()
+	policies := map[string]*db.GetOrganizationPolicyRow{}
+	var audited []db.CreateAuditEventParams
+	var queued []db.EnqueueEventParams
+	mock := policyQuerier(map[string]int64{orgID: 1, otherID: 2}, policies)
+	mock.CreateAuditEventFunc = func(ctx context.Context, arg db.CreateAuditEventParams) error {
+		audited = append(audited, arg)
+		return nil
+	}
+	mock.EnqueueEventFunc = func(ctx context.Context, arg db.EnqueueEventParams) error {
+		queued = append(queued, arg)
+		return nil
+	}
+	svc := NewPolicyService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 10})
+	create := func(rule libopsv1.PolicyRule, enforcement libopsv1.PolicyEnforcement, window *libopsv1.DeployWindow) (*libopsv1.Policy, error) {
+		resp, err := svc.CreatePolicy(ctx, connect.NewRequest(&libopsv1.CreatePolicyRequest{
+			OrganizationId: orgID,
+			Rule:           rule,
+			Enforcement:    enforcement,
+			DeployWindow:   window,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Policy, nil
+	}
+	window := &libopsv1.DeployWindow{StartHour: 9, EndHour: 17, Timezone: "America/New_York"}
+
+	_, err := create(libopsv1.PolicyRule_POLICY_RULE_UNSPECIFIED, 0, nil)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = create(libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW, 0, nil)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "the deploy window rule needs a window")
+	_, err = create(libopsv1.PolicyRule_POLICY_RULE_NO_PUBLIC_SSH, 0, window)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "only the deploy window rule takes a window")
+	_, err = create(libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW, 0, &libopsv1.DeployWindow{StartHour: 17, EndHour: 9})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	ssh, err := create(libopsv1.PolicyRule_POLICY_RULE_NO_PUBLIC_SSH, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE, ssh.Enforcement, "policies are enforced by default")
+	assert.Equal(t, orgID, ssh.OrganizationId)
+	_, err = create(libopsv1.PolicyRule_POLICY_RULE_NO_PUBLIC_SSH, libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT, nil)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "one policy per rule")
+
+	deploys, err := create(libopsv1.PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW, libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT, window)
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT, deploys.Enforcement)
+	assert.Equal(t, int32(9), deploys.DeployWindow.StartHour)
+	assert.Equal(t, "America/New_York", deploys.DeployWindow.Timezone)
+
+	list, err := svc.ListPolicies(ctx, connect.NewRequest(&libopsv1.ListPoliciesRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Len(t, list.Msg.Policies, 2)
+
+	_, err = svc.UpdatePolicy(ctx, connect.NewRequest(&libopsv1.UpdatePolicyRequest{
+		OrganizationId: otherID,
+		PolicyId:       deploys.PolicyId,
+		Enforcement:    libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE,
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "the policy belongs to another organization")
+	_, err = svc.UpdatePolicy(ctx, connect.NewRequest(&libopsv1.UpdatePolicyRequest{OrganizationId: orgID, PolicyId: deploys.PolicyId}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "nothing to change")
+
+	updated, err := svc.UpdatePolicy(ctx, connect.NewRequest(&libopsv1.UpdatePolicyRequest{
+		OrganizationId: orgID,
+		PolicyId:       deploys.PolicyId,
+		Enforcement:    libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE, updated.Msg.Policy.Enforcement)
+	assert.Equal(t, int32(17), updated.Msg.Policy.DeployWindow.EndHour, "the window is kept")
+
+	_, err = svc.DeletePolicy(ctx, connect.NewRequest(&libopsv1.DeletePolicyRequest{OrganizationId: orgID, PolicyId: ssh.PolicyId}))
+	require.NoError(t, err)
+	assert.NotContains(t, policies, ssh.PolicyId)
+
+	var actions []string
+	for _, a := range audited {
+		actions = append(actions, a.EventName)
+	}
+	assert.Equal(t, []string{
+		string(audit.PolicyCreate),
+		string(audit.PolicyCreate),
+		string(audit.PolicyUpdate),
+		string(audit.PolicyDelete),
+	}, actions)
+	assert.Len(t, queued, 4)
+}
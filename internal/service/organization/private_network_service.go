@@ -0,0 +1,201 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// organizationSubnet is the range terraform gives every organization network;
+// a requested endpoint address has to fall inside it
+var organizationSubnet = &net.IPNet{IP: net.IPv4(10, 128, 0, 0), Mask: net.CIDRMask(20, 32)}
+
+// PrivateNetworkService implements the PrivateNetworkService API.
+type PrivateNetworkService struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.PrivateNetworkServiceHandler = (*PrivateNetworkService)(nil)
+
+// NewPrivateNetworkService creates a new PrivateNetworkService instance.
+func NewPrivateNetworkService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *PrivateNetworkService {
+	return &PrivateNetworkService{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListPrivateServiceConnectEndpoints lists the organization's endpoints.
+func (s *PrivateNetworkService) ListPrivateServiceConnectEndpoints(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPrivateServiceConnectEndpointsRequest],
+) (*connect.Response[libopsv1.ListPrivateServiceConnectEndpointsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListPrivateServiceConnectEndpoints(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to list private service connect endpoints", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	endpoints := make([]*libopsv1.PrivateServiceConnectEndpoint, 0, len(rows))
+	for _, row := range rows {
+		endpoints = append(endpoints, service.PrivateServiceConnectEndpointToProto(db.GetPrivateServiceConnectEndpointRow(row), organization.PublicID))
+	}
+	return connect.NewResponse(&libopsv1.ListPrivateServiceConnectEndpointsResponse{Endpoints: endpoints}), nil
+}
+
+// CreatePrivateServiceConnectEndpoint records an endpoint and queues the
+// organization's reconciliation, which creates it.
+func (s *PrivateNetworkService) CreatePrivateServiceConnectEndpoint(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreatePrivateServiceConnectEndpointRequest],
+) (*connect.Response[libopsv1.CreatePrivateServiceConnectEndpointResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	target, ok := service.ProtoPrivateServiceConnectTargetToDB(req.Msg.Target)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target is required"))
+	}
+	requested := strings.TrimSpace(req.Msg.IpAddress)
+	if requested != "" {
+		ip := net.ParseIP(requested)
+		if ip == nil || !organizationSubnet.Contains(ip) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ip_address must be an address in %s", organizationSubnet))
+		}
+		requested = ip.String()
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.GetPrivateServiceConnectEndpointByTarget(ctx, db.GetPrivateServiceConnectEndpointByTargetParams{
+		OrganizationID: organization.ID,
+		Target:         target,
+	})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the organization already has a %s endpoint", target))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	endpointID := uuid.New().String()
+	err = s.db.CreatePrivateServiceConnectEndpoint(ctx, db.CreatePrivateServiceConnectEndpointParams{
+		PublicID:           endpointID,
+		OrganizationID:     organization.ID,
+		Target:             target,
+		RequestedIpAddress: service.ToNullString(requested),
+		CreatedBy:          sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "private service connect endpoint")
+	}
+
+	created, err := s.db.GetPrivateServiceConnectEndpoint(ctx, endpointID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	endpoint := service.PrivateServiceConnectEndpointToProto(created, organization.PublicID)
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.PrivateEndpointCreate, map[string]any{
+		"endpoint_id": endpointID,
+		"target":      string(target),
+	})
+	s.reconcile(ctx, organization.PublicID, endpoint)
+
+	return connect.NewResponse(&libopsv1.CreatePrivateServiceConnectEndpointResponse{Endpoint: endpoint}), nil
+}
+
+// DeletePrivateServiceConnectEndpoint marks an endpoint for removal and
+// queues the organization's reconciliation, which removes it.
+func (s *PrivateNetworkService) DeletePrivateServiceConnectEndpoint(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeletePrivateServiceConnectEndpointRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.EndpointId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid endpoint_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetPrivateServiceConnectEndpoint(ctx, req.Msg.EndpointId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && existing.OrganizationID != organization.ID) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("endpoint not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if existing.Status == db.PrivateServiceConnectEndpointsStatusDeleting {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	if err := s.db.MarkPrivateServiceConnectEndpointDeleting(ctx, existing.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	existing.Status = db.PrivateServiceConnectEndpointsStatusDeleting
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.PrivateEndpointDelete, map[string]any{
+		"endpoint_id": existing.PublicID,
+		"target":      string(existing.Target),
+	})
+	s.reconcile(ctx, organization.PublicID, service.PrivateServiceConnectEndpointToProto(existing, organization.PublicID))
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// reconcile queues the organization's terraform, which owns the forwarding rules.
+func (s *PrivateNetworkService) reconcile(ctx context.Context, organizationID string, endpoint *libopsv1.PrivateServiceConnectEndpoint) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeOrganizationUpdated, endpoint.EndpointId, &organizationID, nil, nil, endpoint); err != nil {
+		slog.Error("Failed to emit organization updated event", "error", err, "organization_id", organizationID)
+	}
+}
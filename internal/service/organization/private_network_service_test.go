@@ -0,0 +1,118 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestPrivateServiceConnectEndpoints tests that an endpoint is recorded with
+// its requested address, that an organization gets one per target, and that
+// deleting it marks it for the next reconciliation.
+func TestPrivateServiceConnectEndpoints(t *testing.T) {
+	orgID := uuid.NewString()
+	endpoints := map[string]*db.GetPrivateServiceConnectEndpointRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID, Name: "Library"}, nil
+		},
+		GetPrivateServiceConnectEndpointByTargetFunc: func(ctx context.Context, arg db.GetPrivateServiceConnectEndpointByTargetParams) (db.GetPrivateServiceConnectEndpointByTargetRow, error) {
+			for _, endpoint := range endpoints {
+				if endpoint.OrganizationID == arg.OrganizationID && endpoint.Target == arg.Target {
+					return db.GetPrivateServiceConnectEndpointByTargetRow(*endpoint), nil
+				}
+			}
+			return db.GetPrivateServiceConnectEndpointByTargetRow{}, sql.ErrNoRows
+		},
+		CreatePrivateServiceConnectEndpointFunc: func(ctx context.Context, arg db.CreatePrivateServiceConnectEndpointParams) error {
+			endpoints[arg.PublicID] = &db.GetPrivateServiceConnectEndpointRow{
+				ID:                 int64(len(endpoints) + 1),
+				PublicID:           arg.PublicID,
+				OrganizationID:     arg.OrganizationID,
+				Target:             arg.Target,
+				RequestedIpAddress: arg.RequestedIpAddress,
+				Status:             db.PrivateServiceConnectEndpointsStatusProvisioning,
+			}
+			return nil
+		},
+		GetPrivateServiceConnectEndpointFunc: func(ctx context.Context, publicID string) (db.GetPrivateServiceConnectEndpointRow, error) {
+			if endpoint, ok := endpoints[publicID]; ok {
+				return *endpoint, nil
+			}
+			return db.GetPrivateServiceConnectEndpointRow{}, sql.ErrNoRows
+		},
+		MarkPrivateServiceConnectEndpointDeletingFunc: func(ctx context.Context, id int64) error {
+			for _, endpoint := range endpoints {
+				if endpoint.ID == id {
+					endpoint.Status = db.PrivateServiceConnectEndpointsStatusDeleting
+				}
+			}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewPrivateNetworkService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	_, err := svc.CreatePrivateServiceConnectEndpoint(ctx, connect.NewRequest(&libopsv1.CreatePrivateServiceConnectEndpointRequest{
+		OrganizationId: orgID,
+		Target:         libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API,
+		IpAddress:      "192.168.1.10",
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "the address has to be in the organization's subnet")
+
+	created, err := svc.CreatePrivateServiceConnectEndpoint(ctx, connect.NewRequest(&libopsv1.CreatePrivateServiceConnectEndpointRequest{
+		OrganizationId: orgID,
+		Target:         libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API,
+		IpAddress:      " 10.128.0.50 ",
+	}))
+	require.NoError(t, err)
+	endpoint := created.Msg.Endpoint
+	assert.Equal(t, orgID, endpoint.OrganizationId)
+	assert.Equal(t, "10.128.0.50", endpoint.RequestedIpAddress)
+	assert.Empty(t, endpoint.IpAddress)
+	assert.Equal(t, libopsv1.PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING, endpoint.Status)
+
+	_, err = svc.CreatePrivateServiceConnectEndpoint(ctx, connect.NewRequest(&libopsv1.CreatePrivateServiceConnectEndpointRequest{
+		OrganizationId: orgID,
+		Target:         libopsv1.PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API,
+	}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	for range 2 {
+		_, err = svc.DeletePrivateServiceConnectEndpoint(ctx, connect.NewRequest(&libopsv1.DeletePrivateServiceConnectEndpointRequest{
+			OrganizationId: orgID,
+			EndpointId:     endpoint.EndpointId,
+		}))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, db.PrivateServiceConnectEndpointsStatusDeleting, endpoints[endpoint.EndpointId].Status)
+
+	require.Len(t, queued, 2, "deleting twice queues one reconciliation")
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeOrganizationUpdated, event.EventType)
+		assert.Equal(t, int64(7), event.OrganizationID.Int64)
+	}
+	assert.Equal(t, []string{string(audit.PrivateEndpointCreate), string(audit.PrivateEndpointDelete)}, audited)
+}
@@ -0,0 +1,157 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/quota"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// GetQuotas returns the organization's billing plan and effective quotas.
+func (s *OrganizationService) GetQuotas(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetQuotasRequest],
+) (*connect.Response[libopsv1.GetQuotasResponse], error) {
+	organizationID := req.Msg.OrganizationId
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, limits, err := s.repo.quotas.Limits(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to resolve organization quotas", "error", err, "organization_id", organizationID)
+		return nil, err
+	}
+
+	projectCount, err := s.repo.db.CountOrganizationProjects(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count organization projects: %w", err))
+	}
+
+	quotas := make([]*commonv1.Quota, 0, len(limits))
+	for _, limit := range limits {
+		q := toProtoQuota(limit)
+		if limit.Resource == quota.Projects {
+			q.Usage = &projectCount
+		}
+		quotas = append(quotas, q)
+	}
+
+	return connect.NewResponse(&libopsv1.GetQuotasResponse{
+		Plan:   string(plan),
+		Quotas: quotas,
+	}), nil
+}
+
+// SetOrganizationQuota overrides the plan default for a single resource (admin only).
+func (s *AdminOrganizationService) SetOrganizationQuota(
+	ctx context.Context,
+	req *connect.Request[libopsv1.AdminSetOrganizationQuotaRequest],
+) (*connect.Response[libopsv1.AdminSetOrganizationQuotaResponse], error) {
+	organization, resource, err := s.resolveQuotaTarget(ctx, req.Msg.OrganizationId, req.Msg.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if resource == quota.APIKeysPerAccount {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is account-scoped and cannot be overridden per organization", resource))
+	}
+	if req.Msg.Limit < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must not be negative"))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	err = s.repo.db.UpsertOrganizationQuota(ctx, db.UpsertOrganizationQuotaParams{
+		OrganizationID: organization.ID,
+		Resource:       string(resource),
+		QuotaLimit:     req.Msg.Limit,
+		UpdatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set quota: %w", err))
+	}
+
+	slog.Info("Organization quota overridden",
+		"organization_id", req.Msg.OrganizationId,
+		"resource", resource,
+		"limit", req.Msg.Limit,
+		"account_id", userInfo.AccountID)
+
+	return connect.NewResponse(&libopsv1.AdminSetOrganizationQuotaResponse{
+		Quota: toProtoQuota(quota.Limit{Resource: resource, Limit: req.Msg.Limit, Overridden: true}),
+	}), nil
+}
+
+// DeleteOrganizationQuota removes a quota override so the plan default applies again (admin only).
+func (s *AdminOrganizationService) DeleteOrganizationQuota(
+	ctx context.Context,
+	req *connect.Request[libopsv1.AdminDeleteOrganizationQuotaRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	organization, resource, err := s.resolveQuotaTarget(ctx, req.Msg.OrganizationId, req.Msg.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.db.DeleteOrganizationQuota(ctx, db.DeleteOrganizationQuotaParams{
+		OrganizationID: organization.ID,
+		Resource:       string(resource),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete quota: %w", err))
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *AdminOrganizationService) resolveQuotaTarget(ctx context.Context, organizationID, resourceName string) (db.GetOrganizationRow, quota.Resource, error) {
+	if err := validation.UUID(organizationID); err != nil {
+		return db.GetOrganizationRow{}, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resource, err := quota.ParseResource(resourceName)
+	if err != nil {
+		return db.GetOrganizationRow{}, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return db.GetOrganizationRow{}, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return db.GetOrganizationRow{}, "", err
+	}
+	return organization, resource, nil
+}
+
+func toProtoQuota(limit quota.Limit) *commonv1.Quota {
+	return &commonv1.Quota{
+		Resource:   string(limit.Resource),
+		Scope:      limit.Resource.Scope(),
+		Limit:      limit.Limit,
+		Overridden: limit.Overridden,
+	}
+}
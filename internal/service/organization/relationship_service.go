@@ -0,0 +1,496 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// RelationshipService implements the RelationshipService API.
+type RelationshipService struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check.
+var _ libopsv1connect.RelationshipServiceHandler = (*RelationshipService)(nil)
+
+// NewRelationshipService creates a new RelationshipService instance.
+func NewRelationshipService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *RelationshipService {
+	return &RelationshipService{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// RequestRelationship asks another organization for access for the
+// organization's members. A rejected or revoked relationship is reopened.
+func (s *RelationshipService) RequestRelationship(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RequestRelationshipRequest],
+) (*connect.Response[libopsv1.RequestRelationshipResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(msg.TargetOrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid target_organization_id: %w", err))
+	}
+	if msg.OrganizationId == msg.TargetOrganizationId {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("an organization can't have a relationship with itself"))
+	}
+	maxRole, err := parseRelationshipRole(msg.MaxRole)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	source, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	target, err := service.GetOrganizationByPublicID(ctx, s.db, msg.TargetOrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOwner(ctx, userInfo, source.ID); err != nil {
+		return nil, err
+	}
+
+	requestedBy := sql.NullInt64{Int64: userInfo.AccountID, Valid: true}
+	existing, err := s.db.GetRelationshipBetween(ctx, db.GetRelationshipBetweenParams{
+		SourceOrganizationID: source.ID,
+		TargetOrganizationID: target.ID,
+		RelationshipType:     db.RelationshipsRelationshipTypeAccess,
+	})
+	relationshipID := existing.PublicID
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		relationshipID = uuid.New().String()
+		err = s.db.RequestRelationship(ctx, db.RequestRelationshipParams{
+			PublicID:             relationshipID,
+			SourceOrganizationID: source.ID,
+			TargetOrganizationID: target.ID,
+			MaxRole:              maxRole,
+			RequestedBy:          requestedBy,
+		})
+	case err != nil:
+	case existing.Status == db.RelationshipsStatusPending || existing.Status == db.RelationshipsStatusApproved:
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s already has a %s relationship with %s", source.Name, existing.Status, target.Name))
+	default:
+		err = service.ExpectRow(s.db.RerequestRelationship(ctx, db.RerequestRelationshipParams{
+			MaxRole:     maxRole,
+			RequestedBy: requestedBy,
+			ID:          existing.ID,
+		}))
+	}
+	if err != nil {
+		slog.Error("Failed to request relationship", "error", err, "source_organization_id", source.PublicID, "target_organization_id", target.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	relationship, err := s.record(ctx, userInfo, relationshipID, audit.RelationshipRequest, events.EventTypeRelationshipCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.RequestRelationshipResponse{Relationship: relationship}), nil
+}
+
+// ListRelationships lists the relationships the organization is either side of.
+func (s *RelationshipService) ListRelationships(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListRelationshipsRequest],
+) (*connect.Response[libopsv1.ListRelationshipsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListRelationshipsForOrganization(ctx, db.ListRelationshipsForOrganizationParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list relationships", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	relationships := make([]*libopsv1.Relationship, 0, len(rows))
+	for _, row := range rows {
+		relationship := &libopsv1.Relationship{
+			RelationshipId:         row.PublicID,
+			SourceOrganizationId:   row.SourceOrganizationPublicID,
+			SourceOrganizationName: row.SourceOrganizationName,
+			TargetOrganizationId:   row.TargetOrganizationPublicID,
+			TargetOrganizationName: row.TargetOrganizationName,
+			MaxRole:                string(row.MaxRole),
+			Status:                 relationshipStatusToProto(row.Status),
+			RequestedBy:            row.RequestedByEmail.String,
+			ExpiresAt:              service.ExpiryToProto(row.ExpiresAt),
+		}
+		if row.CreatedAt.Valid {
+			relationship.CreatedAt = row.CreatedAt.Time.Unix()
+		}
+		if row.ResolvedAt.Valid {
+			relationship.ResolvedAt = row.ResolvedAt.Time.Unix()
+		}
+		relationships = append(relationships, relationship)
+	}
+
+	return connect.NewResponse(&libopsv1.ListRelationshipsResponse{
+		Relationships: relationships,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// ApproveRelationship grants the source organization's members access to the
+// organization, optionally with a lower max role than they asked for and
+// until a set time.
+func (s *RelationshipService) ApproveRelationship(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ApproveRelationshipRequest],
+) (*connect.Response[libopsv1.ApproveRelationshipResponse], error) {
+	expiresAt, err := service.ParseExpiry(req.Msg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo, organization, rel, err := s.resolve(ctx, req.Msg.OrganizationId, req.Msg.RelationshipId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.MaxRole != "" {
+		maxRole, err := parseRelationshipRole(req.Msg.MaxRole)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if auth.RelationshipRole(db.OrganizationMembersRole(maxRole), rel.MaxRole) != db.OrganizationMembersRole(maxRole) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_role can't be higher than the requested %s", rel.MaxRole))
+		}
+		if maxRole != rel.MaxRole {
+			err = service.ExpectRow(s.db.UpdateRelationshipMaxRole(ctx, db.UpdateRelationshipMaxRoleParams{MaxRole: maxRole, PublicID: rel.PublicID}))
+			if err != nil {
+				return nil, s.updateError(err, rel, organization)
+			}
+		}
+	}
+
+	if expiresAt.Valid {
+		err = service.ExpectRow(s.db.SetRelationshipExpiry(ctx, db.SetRelationshipExpiryParams{ExpiresAt: expiresAt, PublicID: rel.PublicID}))
+		if err != nil {
+			return nil, s.updateError(err, rel, organization)
+		}
+	}
+
+	err = service.ExpectRow(s.db.ApproveRelationship(ctx, db.ApproveRelationshipParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   rel.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, rel, organization)
+	}
+
+	relationship, err := s.record(ctx, userInfo, rel.PublicID, audit.RelationshipApprove, events.EventTypeRelationshipApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.ApproveRelationshipResponse{Relationship: relationship}), nil
+}
+
+// RejectRelationship turns down a request for access to the organization.
+func (s *RelationshipService) RejectRelationship(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RejectRelationshipRequest],
+) (*connect.Response[libopsv1.RejectRelationshipResponse], error) {
+	userInfo, organization, rel, err := s.resolve(ctx, req.Msg.OrganizationId, req.Msg.RelationshipId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.ExpectRow(s.db.RejectRelationship(ctx, db.RejectRelationshipParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   rel.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, rel, organization)
+	}
+
+	relationship, err := s.record(ctx, userInfo, rel.PublicID, audit.RelationshipReject, events.EventTypeRelationshipRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.RejectRelationshipResponse{Relationship: relationship}), nil
+}
+
+// UpdateRelationship changes the max role or expiry of a pending or approved relationship.
+func (s *RelationshipService) UpdateRelationship(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateRelationshipRequest],
+) (*connect.Response[libopsv1.UpdateRelationshipResponse], error) {
+	if req.Msg.MaxRole == "" && req.Msg.ExpiresAt == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("set max_role or expires_at"))
+	}
+	var maxRole db.RelationshipsMaxRole
+	if req.Msg.MaxRole != "" {
+		var err error
+		if maxRole, err = parseRelationshipRole(req.Msg.MaxRole); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	expiresAt, err := service.ParseExpiry(req.Msg.GetExpiresAt())
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo, organization, rel, err := s.resolve(ctx, req.Msg.OrganizationId, req.Msg.RelationshipId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxRole != "" {
+		err = service.ExpectRow(s.db.UpdateRelationshipMaxRole(ctx, db.UpdateRelationshipMaxRoleParams{MaxRole: maxRole, PublicID: rel.PublicID}))
+		if err != nil {
+			return nil, s.updateError(err, rel, organization)
+		}
+	}
+	if req.Msg.ExpiresAt != nil {
+		err = service.ExpectRow(s.db.SetRelationshipExpiry(ctx, db.SetRelationshipExpiryParams{ExpiresAt: expiresAt, PublicID: rel.PublicID}))
+		if err != nil {
+			return nil, s.updateError(err, rel, organization)
+		}
+	}
+
+	relationship, err := s.record(ctx, userInfo, rel.PublicID, audit.RelationshipUpdate, events.EventTypeRelationshipUpdated)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateRelationshipResponse{Relationship: relationship}), nil
+}
+
+// RevokeRelationship ends a relationship, or withdraws a request, from either side.
+func (s *RelationshipService) RevokeRelationship(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RevokeRelationshipRequest],
+) (*connect.Response[libopsv1.RevokeRelationshipResponse], error) {
+	userInfo, organization, rel, err := s.resolve(ctx, req.Msg.OrganizationId, req.Msg.RelationshipId, false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.ExpectRow(s.db.RevokeRelationship(ctx, db.RevokeRelationshipParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   rel.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, rel, organization)
+	}
+
+	relationship, err := s.record(ctx, userInfo, rel.PublicID, audit.RelationshipRevoke, events.EventTypeRelationshipRevoked)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.RevokeRelationshipResponse{Relationship: relationship}), nil
+}
+
+// resolve authenticates the caller and returns a relationship the
+// organization is part of, NotFound when it isn't. targetOnly limits the
+// call to the organization the relationship grants access to.
+func (s *RelationshipService) resolve(ctx context.Context, organizationID, relationshipID string, targetOnly bool) (*auth.UserInfo, db.GetOrganizationRow, db.GetRelationshipRow, error) {
+	var organization db.GetOrganizationRow
+	var rel db.GetRelationshipRow
+
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, organization, rel, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(relationshipID); err != nil {
+		return nil, organization, rel, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid relationship_id: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, organization, rel, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, organizationID)
+	if err != nil {
+		return nil, organization, rel, err
+	}
+
+	rel, err = s.db.GetRelationship(ctx, relationshipID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && rel.SourceOrganizationID != organization.ID && rel.TargetOrganizationID != organization.ID) {
+		return nil, organization, rel, connect.NewError(connect.CodeNotFound, fmt.Errorf("relationship not found"))
+	}
+	if err != nil {
+		return nil, organization, rel, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get relationship: %w", err))
+	}
+	if targetOnly && rel.TargetOrganizationID != organization.ID {
+		return nil, organization, rel, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the organization being given access can do this"))
+	}
+
+	if err := s.requireOwner(ctx, userInfo, organization.ID); err != nil {
+		return nil, organization, rel, err
+	}
+
+	return userInfo, organization, rel, nil
+}
+
+// requireOwner checks the caller owns the organization themselves. Access an
+// organization gets through a relationship doesn't carry over to managing
+// that organization's relationships, so access can't be passed along.
+func (s *RelationshipService) requireOwner(ctx context.Context, userInfo *auth.UserInfo, organizationID int64) error {
+	if gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil
+	}
+	member, err := s.db.GetOrganizationMemberByAccountAndOrganization(ctx, db.GetOrganizationMemberByAccountAndOrganizationParams{
+		AccountID:      userInfo.AccountID,
+		OrganizationID: organizationID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err != nil || member.Role != db.OrganizationMembersRoleOwner {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only owners of the organization can manage its relationships"))
+	}
+	return nil
+}
+
+// updateError maps a failed status change, where no rows means the
+// relationship had already moved on.
+func (s *RelationshipService) updateError(err error, rel db.GetRelationshipRow, organization db.GetOrganizationRow) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the relationship is %s", rel.Status))
+	}
+	slog.Error("Failed to update relationship", "error", err, "relationship_id", rel.PublicID, "organization_id", organization.PublicID)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+}
+
+// record audits a change on both organizations and tells the target
+// organization's sites, whose SSH access may change, about it.
+func (s *RelationshipService) record(ctx context.Context, userInfo *auth.UserInfo, relationshipID string, action audit.Event, eventType string) (*libopsv1.Relationship, error) {
+	rel, err := s.db.GetRelationship(ctx, relationshipID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get relationship: %w", err))
+	}
+	relationship, err := s.toProto(ctx, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]any{
+		"relationship_id":        rel.PublicID,
+		"source_organization_id": relationship.SourceOrganizationId,
+		"target_organization_id": relationship.TargetOrganizationId,
+		"max_role":               relationship.MaxRole,
+	}
+	if relationship.ExpiresAt != 0 {
+		details["expires_at"] = relationship.ExpiresAt
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, rel.SourceOrganizationID, audit.OrganizationEntityType, action, details)
+	s.auditLogger.Log(ctx, userInfo.AccountID, rel.TargetOrganizationID, audit.OrganizationEntityType, action, details)
+
+	if s.emitter != nil {
+		if err := s.emitter.SendScopedProtoEvent(ctx, eventType, rel.PublicID, &relationship.TargetOrganizationId, nil, nil, relationship); err != nil {
+			slog.Error("Failed to emit relationship event", "error", err, "relationship_id", rel.PublicID)
+		}
+	}
+
+	return relationship, nil
+}
+
+func (s *RelationshipService) toProto(ctx context.Context, rel db.GetRelationshipRow) (*libopsv1.Relationship, error) {
+	source, err := s.db.GetOrganizationByID(ctx, rel.SourceOrganizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+	}
+	target, err := s.db.GetOrganizationByID(ctx, rel.TargetOrganizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+	}
+
+	relationship := &libopsv1.Relationship{
+		RelationshipId:         rel.PublicID,
+		SourceOrganizationId:   source.PublicID,
+		SourceOrganizationName: source.Name,
+		TargetOrganizationId:   target.PublicID,
+		TargetOrganizationName: target.Name,
+		MaxRole:                string(rel.MaxRole),
+		Status:                 relationshipStatusToProto(rel.Status),
+		ExpiresAt:              service.ExpiryToProto(rel.ExpiresAt),
+	}
+	if rel.RequestedBy.Valid {
+		requester, err := s.db.GetAccountByID(ctx, rel.RequestedBy.Int64)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account: %w", err))
+		}
+		relationship.RequestedBy = requester.Email
+	}
+	if rel.CreatedAt.Valid {
+		relationship.CreatedAt = rel.CreatedAt.Time.Unix()
+	}
+	if rel.ResolvedAt.Valid {
+		relationship.ResolvedAt = rel.ResolvedAt.Time.Unix()
+	}
+	return relationship, nil
+}
+
+func parseRelationshipRole(role string) (db.RelationshipsMaxRole, error) {
+	switch maxRole := db.RelationshipsMaxRole(role); maxRole {
+	case db.RelationshipsMaxRoleOwner, db.RelationshipsMaxRoleDeveloper, db.RelationshipsMaxRoleRead:
+		return maxRole, nil
+	default:
+		return "", fmt.Errorf("max_role must be owner, developer or read")
+	}
+}
+
+func relationshipStatusToProto(status db.RelationshipsStatus) libopsv1.RelationshipStatus {
+	switch status {
+	case db.RelationshipsStatusPending:
+		return libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_PENDING
+	case db.RelationshipsStatusApproved:
+		return libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_APPROVED
+	case db.RelationshipsStatusRejected:
+		return libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_REJECTED
+	case db.RelationshipsStatusRevoked:
+		return libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_REVOKED
+	default:
+		return libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,234 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// relationshipQuerier is a vendor organization owned by account 10 with
+// developer 11, a library organization owned by account 20, an unrelated
+// organization, and the relationships between them.
+func relationshipQuerier(orgs map[string]int64, rels map[string]*db.GetRelationshipRow) *testutils.MockQuerier {
+	members := map[[2]int64]db.OrganizationMembersRole{
+		{10, 1}: db.OrganizationMembersRoleOwner,
+		{11, 1}: db.OrganizationMembersRoleDeveloper,
+		{20, 2}: db.OrganizationMembersRoleOwner,
+	}
+	publicIDs := map[int64]string{}
+	for publicID, id := range orgs {
+		publicIDs[id] = publicID
+	}
+	// transition moves a relationship on when it's in one of the given states
+	transition := func(publicID string, to db.RelationshipsStatus, from ...db.RelationshipsStatus) (sql.Result, error) {
+		rel, ok := rels[publicID]
+		if !ok {
+			return driver.RowsAffected(0), nil
+		}
+		for _, status := range from {
+			if rel.Status == status {
+				rel.Status = to
+				return driver.RowsAffected(1), nil
+			}
+		}
+		return driver.RowsAffected(0), nil
+	}
+
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			id, ok := orgs[publicID]
+			if !ok {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: id, PublicID: publicID}, nil
+		},
+		GetOrganizationByIDFunc: func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error) {
+			return db.GetOrganizationByIDRow{ID: id, PublicID: publicIDs[id]}, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, Email: "owner@vendor.example.com"}, nil
+		},
+		GetOrganizationMemberByAccountAndOrganizationFunc: func(ctx context.Context, arg db.GetOrganizationMemberByAccountAndOrganizationParams) (db.OrganizationMember, error) {
+			role, ok := members[[2]int64{arg.AccountID, arg.OrganizationID}]
+			if !ok {
+				return db.OrganizationMember{}, sql.ErrNoRows
+			}
+			return db.OrganizationMember{AccountID: arg.AccountID, OrganizationID: arg.OrganizationID, Role: role}, nil
+		},
+		GetRelationshipFunc: func(ctx context.Context, publicID string) (db.GetRelationshipRow, error) {
+			rel, ok := rels[publicID]
+			if !ok {
+				return db.GetRelationshipRow{}, sql.ErrNoRows
+			}
+			return *rel, nil
+		},
+		GetRelationshipBetweenFunc: func(ctx context.Context, arg db.GetRelationshipBetweenParams) (db.GetRelationshipBetweenRow, error) {
+			for _, rel := range rels {
+				if rel.SourceOrganizationID == arg.SourceOrganizationID && rel.TargetOrganizationID == arg.TargetOrganizationID {
+					return db.GetRelationshipBetweenRow(*rel), nil
+				}
+			}
+			return db.GetRelationshipBetweenRow{}, sql.ErrNoRows
+		},
+		RequestRelationshipFunc: func(ctx context.Context, arg db.RequestRelationshipParams) error {
+			rels[arg.PublicID] = &db.GetRelationshipRow{
+				ID:                   int64(len(rels) + 1),
+				PublicID:             arg.PublicID,
+				SourceOrganizationID: arg.SourceOrganizationID,
+				TargetOrganizationID: arg.TargetOrganizationID,
+				RelationshipType:     db.RelationshipsRelationshipTypeAccess,
+				MaxRole:              arg.MaxRole,
+				Status:               db.RelationshipsStatusPending,
+				RequestedBy:          arg.RequestedBy,
+			}
+			return nil
+		},
+		RerequestRelationshipFunc: func(ctx context.Context, arg db.RerequestRelationshipParams) (sql.Result, error) {
+			for _, rel := range rels {
+				if rel.ID == arg.ID {
+					rel.MaxRole = arg.MaxRole
+					return transition(rel.PublicID, db.RelationshipsStatusPending, db.RelationshipsStatusRejected, db.RelationshipsStatusRevoked)
+				}
+			}
+			return driver.RowsAffected(0), nil
+		},
+		ApproveRelationshipFunc: func(ctx context.Context, arg db.ApproveRelationshipParams) (sql.Result, error) {
+			return transition(arg.PublicID, db.RelationshipsStatusApproved, db.RelationshipsStatusPending)
+		},
+		RejectRelationshipFunc: func(ctx context.Context, arg db.RejectRelationshipParams) (sql.Result, error) {
+			return transition(arg.PublicID, db.RelationshipsStatusRejected, db.RelationshipsStatusPending)
+		},
+		RevokeRelationshipFunc: func(ctx context.Context, arg db.RevokeRelationshipParams) (sql.Result, error) {
+			return transition(arg.PublicID, db.RelationshipsStatusRevoked, db.RelationshipsStatusPending, db.RelationshipsStatusApproved)
+		},
+		UpdateRelationshipMaxRoleFunc: func(ctx context.Context, arg db.UpdateRelationshipMaxRoleParams) (sql.Result, error) {
+			rel, ok := rels[arg.PublicID]
+			if !ok || (rel.Status != db.RelationshipsStatusPending && rel.Status != db.RelationshipsStatusApproved) {
+				return driver.RowsAffected(0), nil
+			}
+			rel.MaxRole = arg.MaxRole
+			return driver.RowsAffected(1), nil
+		},
+	}
+}
+
+// TestRelationships tests that a vendor's owners can ask for access to a
+// library, that only the library's owners can approve it and cap the role,
+// and that either side can revoke it and the vendor ask again.
+func TestRelationships(t *testing.T) {
+	vendorID, libraryID, otherID := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	orgs := map[string]int64{vendorID: 1, libraryID: 2, otherID: 3}
+	rels := map[string]*db.GetRelationshipRow{}
+	var audited []db.CreateAuditEventParams
+	var queued []db.EnqueueEventParams
+	mock := relationshipQuerier(orgs, rels)
+	mock.CreateAuditEventFunc = func(ctx context.Context, arg db.CreateAuditEventParams) error {
+		audited = append(audited, arg)
+		return nil
+	}
+	mock.EnqueueEventFunc = func(ctx context.Context, arg db.EnqueueEventParams) error {
+		queued = append(queued, arg)
+		return nil
+	}
+	svc := NewRelationshipService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	as := func(accountID int64) context.Context {
+		return context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: accountID})
+	}
+	vendorOwner, vendorDev, libraryOwner := as(10), as(11), as(20)
+	request := func(ctx context.Context, source, target, maxRole string) (*libopsv1.Relationship, error) {
+		resp, err := svc.RequestRelationship(ctx, connect.NewRequest(&libopsv1.RequestRelationshipRequest{
+			OrganizationId:       source,
+			TargetOrganizationId: target,
+			MaxRole:              maxRole,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Relationship, nil
+	}
+	approve := func(ctx context.Context, orgID, relationshipID, maxRole string) (*libopsv1.Relationship, error) {
+		resp, err := svc.ApproveRelationship(ctx, connect.NewRequest(&libopsv1.ApproveRelationshipRequest{
+			OrganizationId: orgID,
+			RelationshipId: relationshipID,
+			MaxRole:        maxRole,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Relationship, nil
+	}
+
+	_, err := request(vendorOwner, vendorID, vendorID, "read")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "an organization can't delegate to itself")
+	_, err = request(vendorOwner, vendorID, libraryID, "admin")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = request(vendorDev, vendorID, libraryID, "developer")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "only owners ask for access")
+
+	rel, err := request(vendorOwner, vendorID, libraryID, "developer")
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, rel.Status)
+	assert.Equal(t, vendorID, rel.SourceOrganizationId)
+	assert.Equal(t, libraryID, rel.TargetOrganizationId)
+	assert.Equal(t, "owner@vendor.example.com", rel.RequestedBy)
+	_, err = request(vendorOwner, vendorID, libraryID, "read")
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = approve(vendorOwner, vendorID, rel.RelationshipId, "")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "the vendor can't approve its own request")
+	_, err = approve(libraryOwner, otherID, rel.RelationshipId, "")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "the relationship belongs to other organizations")
+	_, err = approve(libraryOwner, libraryID, rel.RelationshipId, "owner")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "more than the vendor asked for")
+
+	rel, err = approve(libraryOwner, libraryID, rel.RelationshipId, "read")
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_APPROVED, rel.Status)
+	assert.Equal(t, "read", rel.MaxRole)
+	_, err = approve(libraryOwner, libraryID, rel.RelationshipId, "")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "already approved")
+
+	_, err = svc.RejectRelationship(libraryOwner, connect.NewRequest(&libopsv1.RejectRelationshipRequest{OrganizationId: libraryID, RelationshipId: rel.RelationshipId}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "only pending requests can be rejected")
+
+	revoked, err := svc.RevokeRelationship(vendorOwner, connect.NewRequest(&libopsv1.RevokeRelationshipRequest{OrganizationId: vendorID, RelationshipId: rel.RelationshipId}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_REVOKED, revoked.Msg.Relationship.Status)
+	_, err = svc.UpdateRelationship(libraryOwner, connect.NewRequest(&libopsv1.UpdateRelationshipRequest{OrganizationId: libraryID, RelationshipId: rel.RelationshipId, MaxRole: "owner"}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "revoked relationships can't be changed")
+
+	again, err := request(vendorOwner, vendorID, libraryID, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, rel.RelationshipId, again.RelationshipId, "asking again reopens the relationship")
+	assert.Equal(t, libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_PENDING, again.Status)
+	assert.Equal(t, "owner", again.MaxRole)
+
+	rejected, err := svc.RejectRelationship(libraryOwner, connect.NewRequest(&libopsv1.RejectRelationshipRequest{OrganizationId: libraryID, RelationshipId: rel.RelationshipId}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.RelationshipStatus_RELATIONSHIP_STATUS_REJECTED, rejected.Msg.Relationship.Status)
+
+	require.Len(t, audited, 10, "each change is audited on both organizations")
+	assert.Equal(t, int64(1), audited[0].EntityID)
+	assert.Equal(t, int64(2), audited[1].EntityID)
+	assert.Equal(t, string(audit.RelationshipRevoke), audited[4].EventName)
+
+	require.Len(t, queued, 5)
+	assert.Equal(t, events.EventTypeRelationshipCreated, queued[0].EventType)
+	assert.Equal(t, events.EventTypeRelationshipApproved, queued[1].EventType)
+	assert.Equal(t, events.EventTypeRelationshipRevoked, queued[2].EventType)
+	assert.Equal(t, events.EventTypeRelationshipCreated, queued[3].EventType)
+	assert.Equal(t, events.EventTypeRelationshipRejected, queued[4].EventType)
+}
@@ -0,0 +1,61 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/catalog"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// GetDataResidency returns the geography an organization's data must stay in.
+func (r *Repository) GetDataResidency(ctx context.Context, organizationID int64) (db.NullOrganizationsDataResidency, error) {
+	residency, err := r.db.GetOrganizationDataResidency(ctx, organizationID)
+	if err != nil {
+		return db.NullOrganizationsDataResidency{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get data residency: %w", err))
+	}
+	return residency, nil
+}
+
+// setDataResidency changes an organization's data residency. A residency
+// can't be set while one of the organization's projects runs outside it,
+// since moving a project between regions means rebuilding it.
+func (r *Repository) setDataResidency(ctx context.Context, organizationID, accountID int64, residency commonv1.DataResidency) error {
+	if _, ok := commonv1.DataResidency_name[int32(residency)]; !ok {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown data residency %d", residency))
+	}
+	target := service.ProtoDataResidencyToDb(residency)
+	current, err := r.GetDataResidency(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if current == target {
+		return nil
+	}
+
+	regions, err := r.db.ListOrganizationProjectRegions(ctx, organizationID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list project regions: %w", err))
+	}
+	for _, region := range regions {
+		if !catalog.ResidencyAllows(target, region.Country.String) {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("a project runs in %s, outside the requested data residency", region.GcpRegion.String))
+		}
+	}
+
+	err = r.db.UpdateOrganizationDataResidency(ctx, db.UpdateOrganizationDataResidencyParams{
+		DataResidency: target,
+		UpdatedBy:     sql.NullInt64{Int64: accountID, Valid: true},
+		ID:            organizationID,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update data residency: %w", err))
+	}
+	return nil
+}
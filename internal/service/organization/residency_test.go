@@ -0,0 +1,80 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// TestSetDataResidency tests that a residency is only set when every project
+// already runs inside it.
+func TestSetDataResidency(t *testing.T) {
+	tests := []struct {
+		name      string
+		regions   []db.ListOrganizationProjectRegionsRow
+		residency commonv1.DataResidency
+		wantCode  connect.Code
+		wantSaved bool
+	}{
+		{
+			name:      "projects inside",
+			regions:   []db.ListOrganizationProjectRegionsRow{{GcpRegion: sql.NullString{String: "europe-west1", Valid: true}, Country: sql.NullString{String: "eu", Valid: true}}},
+			residency: commonv1.DataResidency_DATA_RESIDENCY_EU,
+			wantSaved: true,
+		},
+		{
+			name:      "project outside",
+			regions:   []db.ListOrganizationProjectRegionsRow{{GcpRegion: sql.NullString{String: "us-central1", Valid: true}, Country: sql.NullString{String: "us", Valid: true}}},
+			residency: commonv1.DataResidency_DATA_RESIDENCY_EU,
+			wantCode:  connect.CodeFailedPrecondition,
+		},
+		{
+			name:      "cleared",
+			regions:   []db.ListOrganizationProjectRegionsRow{{GcpRegion: sql.NullString{String: "us-central1", Valid: true}, Country: sql.NullString{String: "us", Valid: true}}},
+			residency: commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED,
+			wantSaved: true,
+		},
+		{
+			name:      "unknown",
+			residency: commonv1.DataResidency(9),
+			wantCode:  connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *db.UpdateOrganizationDataResidencyParams
+			mock := &testutils.MockQuerier{
+				GetOrganizationDataResidencyFunc: func(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error) {
+					return db.NullOrganizationsDataResidency{OrganizationsDataResidency: db.OrganizationsDataResidencyUs, Valid: true}, nil
+				},
+				ListOrganizationProjectRegionsFunc: func(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectRegionsRow, error) {
+					return tt.regions, nil
+				},
+				UpdateOrganizationDataResidencyFunc: func(ctx context.Context, arg db.UpdateOrganizationDataResidencyParams) error {
+					saved = &arg
+					return nil
+				},
+			}
+
+			err := NewRepository(mock).setDataResidency(context.Background(), 1, 5, tt.residency)
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				assert.Nil(t, saved)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, saved)
+			assert.Equal(t, int64(1), saved.ID)
+			assert.Equal(t, tt.residency != commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED, saved.DataResidency.Valid)
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/envelope"
+	"github.com/libops/api/internal/secretref"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
 	"github.com/libops/api/internal/vault"
@@ -42,34 +44,6 @@ func NewOrganizationSecretService(querier db.Querier, auditLogger *audit.Logger)
 	}
 }
 
-// GetOrganizationVaultClient returns or creates a Vault client for the organization.
-func (s *OrganizationSecretService) GetOrganizationVaultClient(ctx context.Context, organizationID int64) (*vault.Client, error) {
-	// Get organization's libops project (where vault server runs)
-	project, err := s.db.GetOrganizationProjectByOrganizationID(ctx, organizationID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get organization project: %w", err)
-	}
-
-	// Parse project number from string
-	var projectNumber int64
-	if project.GcpProjectNumber.Valid {
-		_, _ = fmt.Sscanf(project.GcpProjectNumber.String, "%d", &projectNumber)
-	}
-
-	// Get region from project
-	region := "us-central1" // default
-	if project.GcpRegion.Valid && project.GcpRegion.String != "" {
-		region = project.GcpRegion.String
-	}
-
-	client, err := vault.NewCustomerVaultClient(ctx, organizationID, projectNumber, region)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create customer vault client: %w", err)
-	}
-
-	return client, nil
-}
-
 // authorizeOrganizationSecretRead checks if user can read organization secrets.
 func (s *OrganizationSecretService) authorizeOrganizationSecretRead(ctx context.Context, organizationID int64) (*auth.UserInfo, error) {
 	userInfo, ok := auth.GetUserFromContext(ctx)
@@ -111,6 +85,102 @@ func ValidateSecretName(name string) error {
 	return nil
 }
 
+// ValidateSecretValue validates a secret's value, or the reference to an
+// external secret set instead of one.
+func ValidateSecretValue(value, reference string) error {
+	if reference != "" {
+		if value != "" {
+			return fmt.Errorf("set either value or reference, not both")
+		}
+		_, err := secretref.Parse(reference)
+		return err
+	}
+	if err := validation.RequiredString("value", value); err != nil {
+		return err
+	}
+	if len(value) > 65536 {
+		return fmt.Errorf("value too long (max 64KB)")
+	}
+	return nil
+}
+
+// SecretUpdatePaths are the fields an organization, project or site secret
+// update can change.
+var SecretUpdatePaths = []string{"value", "reference"}
+
+// SecretChange is how an organization, project or site secret update saves
+// the secret's row and audits a failure, for ApplySecretChange.
+type SecretChange struct {
+	OrganizationID int64  // whose Vault holds the value
+	VaultPath      string // where the value is stored
+	HadReference   bool   // whether the secret pointed at an external one
+	// Save writes the secret's row with the reference it now points at, or the
+	// key version its new value was sealed with.
+	Save func(reference sql.NullString, keyVersion sql.NullInt32) error
+	// Failed audits an update that failed, with what went wrong.
+	Failed func(data map[string]any)
+}
+
+// ApplySecretChange points a secret at reference if one is given, deleting the
+// stored value it replaces, or else stores value, replacing any reference.
+// With neither, the secret is left as it was.
+func ApplySecretChange(ctx context.Context, querier db.Querier, change SecretChange, value, reference string) error {
+	switch {
+	case reference != "":
+		if err := ValidateSecretValue(value, reference); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := change.Save(sql.NullString{String: reference, Valid: true}, sql.NullInt32{}); err != nil {
+			slog.Error("failed to update secret record", "err", err)
+			change.Failed(map[string]any{
+				"reference": reference,
+				"error":     "database_update_failed",
+				"error_msg": err.Error(),
+			})
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
+		}
+
+		// The stored value it replaces is no longer needed
+		if !change.HadReference {
+			if vaultClient, err := service.OrganizationSecretStore(ctx, querier, change.OrganizationID); err != nil {
+				slog.Warn("failed to get vault client", "err", err)
+			} else {
+				service.DeleteSecret(ctx, vaultClient, change.VaultPath)
+			}
+		}
+	case value != "":
+		if len(value) > 65536 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
+		}
+
+		vaultClient, err := service.OrganizationSecretStore(ctx, querier, change.OrganizationID)
+		if err != nil {
+			slog.Error("failed to get vault client", "err", err)
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+		}
+		if err := service.WriteSecret(ctx, vaultClient, change.VaultPath, map[string]any{"value": value}); err != nil {
+			slog.Error("failed to update secret in vault", "err", err)
+			change.Failed(map[string]any{
+				"vault_path": change.VaultPath,
+				"error":      "vault_write_failed",
+				"error_msg":  err.Error(),
+			})
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
+		}
+
+		if err := change.Save(sql.NullString{}, sql.NullInt32{Int32: vaultClient.KeyVersion(), Valid: true}); err != nil {
+			slog.Error("failed to update secret record", "err", err)
+			change.Failed(map[string]any{
+				"vault_path": change.VaultPath,
+				"error":      "database_update_failed",
+				"error_msg":  err.Error(),
+			})
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
+		}
+	}
+	return nil
+}
+
 // CreateOrganizationSecret creates a new organization-level secret.
 func (s *OrganizationSecretService) CreateOrganizationSecret(
 	ctx context.Context,
@@ -120,13 +190,9 @@ func (s *OrganizationSecretService) CreateOrganizationSecret(
 	if err := ValidateSecretName(req.Msg.Name); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	// Validate value
-	if err := validation.RequiredString("value", req.Msg.Value); err != nil {
+	if err := ValidateSecretValue(req.Msg.Value, req.Msg.Reference); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	if len(req.Msg.Value) > 65536 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-	}
 
 	organizationUUID, err := uuid.Parse(req.Msg.OrganizationId)
 	if err != nil {
@@ -164,25 +230,31 @@ func (s *OrganizationSecretService) CreateOrganizationSecret(
 	// 5. Build Vault path
 	vaultPath := vault.BuildOrganizationSecretPath(req.Msg.Name)
 
-	// 6. Write to organization's Vault
-	vaultClient, err := s.GetOrganizationVaultClient(ctx, organization.ID)
-	if err != nil {
-		slog.Error("failed to get vault client", "err", err, "organization_id", organization.ID)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-	}
+	// 6. Write to organization's Vault, unless the value is kept in an
+	// external store the controller resolves it from
+	var vaultClient *envelope.Store
+	var keyVersion sql.NullInt32
+	if req.Msg.Reference == "" {
+		vaultClient, err = service.OrganizationSecretStore(ctx, s.db, organization.ID)
+		if err != nil {
+			slog.Error("failed to get vault client", "err", err, "organization_id", organization.ID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+		}
 
-	err = vaultClient.WriteSecret(ctx, vaultPath, map[string]any{
-		"value": req.Msg.Value,
-	})
-	if err != nil {
-		slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
-		s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationSecretCreateFailed, map[string]any{
-			"secret_name": req.Msg.Name,
-			"vault_path":  vaultPath,
-			"error":       "vault_write_failed",
-			"error_msg":   err.Error(),
+		err = service.WriteSecret(ctx, vaultClient, vaultPath, map[string]any{
+			"value": req.Msg.Value,
 		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		if err != nil {
+			slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
+			s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationSecretCreateFailed, map[string]any{
+				"secret_name": req.Msg.Name,
+				"vault_path":  vaultPath,
+				"error":       "vault_write_failed",
+				"error_msg":   err.Error(),
+			})
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		}
+		keyVersion = sql.NullInt32{Int32: vaultClient.KeyVersion(), Valid: true}
 	}
 
 	// 7. Create database record
@@ -198,10 +270,10 @@ func (s *OrganizationSecretService) CreateOrganizationSecret(
 		UpdatedAt:      now,
 		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 		UpdatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		KeyVersion:     keyVersion,
+		Reference:      sql.NullString{String: req.Msg.Reference, Valid: req.Msg.Reference != ""},
 	})
 	if err != nil {
-		// Rollback: delete from Vault
-		_ = vaultClient.DeleteSecret(ctx, vaultPath)
 		slog.Error("failed to create secret record", "err", err)
 		s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationSecretCreateFailed, map[string]any{
 			"secret_name": req.Msg.Name,
@@ -227,6 +299,7 @@ func (s *OrganizationSecretService) CreateOrganizationSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  vaultPath,
+		"reference":   req.Msg.Reference,
 	})
 
 	// 9. Return response
@@ -236,6 +309,8 @@ func (s *OrganizationSecretService) CreateOrganizationSecret(
 			OrganizationId: organizationUUID.String(),
 			Name:           secret.Name,
 			Status:         dbStatusToProto(secret.Status),
+			KeyVersion:     keyVersion.Int32,
+			Reference:      req.Msg.Reference,
 		},
 	}), nil
 }
@@ -290,6 +365,8 @@ func (s *OrganizationSecretService) GetOrganizationSecret(
 			OrganizationId: organizationUUID.String(),
 			Name:           secret.Name,
 			Status:         dbStatusToProto(secret.Status),
+			KeyVersion:     secret.KeyVersion.Int32,
+			Reference:      secret.Reference.String,
 		},
 	}), nil
 }
@@ -345,6 +422,7 @@ func (s *OrganizationSecretService) ListOrganizationSecrets(
 			OrganizationId: organizationUUID.String(),
 			Name:           secret.Name,
 			Status:         dbStatusToProto(secret.Status),
+			Reference:      secret.Reference.String,
 		}
 	}
 
@@ -369,6 +447,11 @@ func (s *OrganizationSecretService) UpdateOrganizationSecret(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid secret_id"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, SecretUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get organization
 	organization, err := s.db.GetOrganization(ctx, organizationUUID.String())
 	if err != nil {
@@ -398,53 +481,35 @@ func (s *OrganizationSecretService) UpdateOrganizationSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to organization"))
 	}
 
-	// Update value if provided
-	if req.Msg.Value != nil && *req.Msg.Value != "" {
-		if len(*req.Msg.Value) > 65536 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-		}
-
-		// Write to Vault
-		vaultClient, err := s.GetOrganizationVaultClient(ctx, organization.ID)
-		if err != nil {
-			slog.Error("failed to get vault client", "err", err)
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-		}
-
-		err = vaultClient.WriteSecret(ctx, secret.VaultPath, map[string]any{
-			"value": *req.Msg.Value,
-		})
-		if err != nil {
-			slog.Error("failed to update secret in vault", "err", err)
-			s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.OrganizationEntityType, audit.OrganizationSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "vault_write_failed",
-				"error_msg":   err.Error(),
-			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
-
-		// Update database timestamp
-		now := time.Now().Unix()
-		err = s.db.UpdateOrganizationSecret(ctx, db.UpdateOrganizationSecretParams{
-			VaultPath: secret.VaultPath,
-			UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-			UpdatedAt: now,
-			ID:        secret.ID,
-		})
-		if err != nil {
-			slog.Error("failed to update secret record", "err", err)
-			s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.OrganizationEntityType, audit.OrganizationSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "database_update_failed",
-				"error_msg":   err.Error(),
+	value, reference := "", ""
+	if mask.Has("value") {
+		value = req.Msg.GetValue()
+	}
+	if mask.Has("reference") {
+		reference = req.Msg.GetReference()
+	}
+	err = ApplySecretChange(ctx, s.db, SecretChange{
+		OrganizationID: organization.ID,
+		VaultPath:      secret.VaultPath,
+		HadReference:   secret.Reference.Valid,
+		Save: func(reference sql.NullString, keyVersion sql.NullInt32) error {
+			return s.db.UpdateOrganizationSecret(ctx, db.UpdateOrganizationSecretParams{
+				VaultPath:  secret.VaultPath,
+				Reference:  reference,
+				KeyVersion: keyVersion,
+				UpdatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+				UpdatedAt:  time.Now().Unix(),
+				ID:         secret.ID,
 			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
+		},
+		Failed: func(data map[string]any) {
+			data["secret_id"] = secret.PublicID
+			data["secret_name"] = secret.Name
+			s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.OrganizationEntityType, audit.OrganizationSecretUpdateFailed, data)
+		},
+	}, value, reference)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get updated secret
@@ -458,6 +523,7 @@ func (s *OrganizationSecretService) UpdateOrganizationSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  secret.VaultPath,
+		"reference":   secret.Reference.String,
 	})
 
 	return connect.NewResponse(&libopsv1.UpdateOrganizationSecretResponse{
@@ -466,6 +532,8 @@ func (s *OrganizationSecretService) UpdateOrganizationSecret(
 			OrganizationId: organizationUUID.String(),
 			Name:           secret.Name,
 			Status:         dbStatusToProto(secret.Status),
+			KeyVersion:     secret.KeyVersion.Int32,
+			Reference:      secret.Reference.String,
 		},
 	}), nil
 }
@@ -514,27 +582,12 @@ func (s *OrganizationSecretService) DeleteOrganizationSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to organization"))
 	}
 
-	// Delete from Vault
-	vaultClient, err := s.GetOrganizationVaultClient(ctx, organization.ID)
+	vaultClient, err := service.OrganizationSecretStore(ctx, s.db, organization.ID)
 	if err != nil {
 		slog.Error("failed to get vault client", "err", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
 	}
 
-	err = vaultClient.DeleteSecret(ctx, secret.VaultPath)
-	if err != nil {
-		slog.Error("failed to delete secret from vault", "err", err)
-		// Audit log for vault failure
-		s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationSecretDeleteFailed, map[string]any{
-			"secret_id":   secret.PublicID,
-			"secret_name": secret.Name,
-			"vault_path":  secret.VaultPath,
-			"error":       "vault_delete_failed",
-			"error_msg":   err.Error(),
-		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
-	}
-
 	// Mark as deleted in database
 	now := time.Now().Unix()
 	err = s.db.DeleteOrganizationSecret(ctx, db.DeleteOrganizationSecretParams{
@@ -554,6 +607,7 @@ func (s *OrganizationSecretService) DeleteOrganizationSecret(
 		})
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
 	}
+	service.DeleteSecret(ctx, vaultClient, secret.VaultPath)
 
 	// Audit log for success
 	s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.OrganizationEntityType, audit.OrganizationSecretDeleteSuccess, map[string]any{
@@ -565,6 +619,59 @@ func (s *OrganizationSecretService) DeleteOrganizationSecret(
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// ListSecretAccessLogs lists who read the values of an organization's
+// secrets, newest first.
+func (s *OrganizationSecretService) ListSecretAccessLogs(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListSecretAccessLogsRequest],
+) (*connect.Response[libopsv1.ListSecretAccessLogsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSecretAccessLogs(ctx, db.ListSecretAccessLogsParams{
+		OrganizationID: organization.ID,
+		Limit:          pagination.Limit,
+		Offset:         pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list secret access logs", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	accessLogs := make([]*libopsv1.SecretAccessLog, 0, len(rows))
+	for _, row := range rows {
+		accessLog := &libopsv1.SecretAccessLog{
+			AccessLogId: row.PublicID,
+			SiteId:      row.SitePublicID,
+			SecretName:  row.SecretName,
+			Principal:   row.Principal,
+			SourceIp:    row.SourceIp,
+			Procedure:   row.ProcedureName,
+			Anomaly:     row.Anomaly.String,
+		}
+		if row.CreatedAt.Valid {
+			accessLog.CreatedAt = row.CreatedAt.Time.Unix()
+		}
+		accessLogs = append(accessLogs, accessLog)
+	}
+
+	return connect.NewResponse(&libopsv1.ListSecretAccessLogsResponse{
+		AccessLogs:    accessLogs,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
 // dbStatusToProto converts database status to proto status.
 func dbStatusToProto(status db.NullOrganizationSecretsStatus) commonv1.Status {
 	if !status.Valid {
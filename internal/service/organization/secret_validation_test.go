@@ -1,7 +1,13 @@
 package organization
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"testing"
+
+	"connectrpc.com/connect"
 )
 
 // TestValidateSecretName tests the ValidateSecretName function for correct validation of secret names.
@@ -82,3 +88,98 @@ func TestValidateSecretName(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateSecretValue tests that a secret has either a value or a valid reference.
+func TestValidateSecretValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		reference string
+		wantError bool
+	}{
+		{name: "value", value: "hunter2"},
+		{name: "reference", reference: "gcp-sm://my-project/db-password/3"},
+		{name: "neither", wantError: true},
+		{name: "both", value: "hunter2", reference: "gcp-sm://my-project/db-password", wantError: true},
+		{name: "invalid reference", reference: "s3://bucket/key", wantError: true},
+		{name: "value too long", value: strings.Repeat("x", 65537), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSecretValue(tt.value, tt.reference)
+			if (err != nil) != tt.wantError {
+				t.Errorf("ValidateSecretValue(%q, %q) error = %v, wantError %v", tt.value, tt.reference, err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestApplySecretChange tests what a secret update saves and audits on the
+// paths that don't reach Vault.
+func TestApplySecretChange(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		reference     string
+		saveErr       error
+		wantCode      connect.Code
+		wantSave      bool
+		wantReference sql.NullString
+		wantFailure   string
+	}{
+		{
+			name:          "reference",
+			reference:     "gcp-sm://my-project/db-password/3",
+			wantSave:      true,
+			wantReference: sql.NullString{String: "gcp-sm://my-project/db-password/3", Valid: true},
+		},
+		{name: "invalid reference", reference: "s3://bucket/key", wantCode: connect.CodeInvalidArgument},
+		{
+			name:        "reference save fails",
+			reference:   "gcp-sm://my-project/db-password",
+			saveErr:     errors.New("connection reset"),
+			wantCode:    connect.CodeInternal,
+			wantSave:    true,
+			wantFailure: "database_update_failed",
+		},
+		{name: "value too long", value: strings.Repeat("x", 65537), wantCode: connect.CodeInvalidArgument},
+		{name: "neither"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := false
+			var savedReference sql.NullString
+			var failure map[string]any
+			change := SecretChange{
+				OrganizationID: 1,
+				VaultPath:      "secret/org/1/db-password",
+				HadReference:   true,
+				Save: func(reference sql.NullString, keyVersion sql.NullInt32) error {
+					saved = true
+					savedReference = reference
+					if keyVersion.Valid {
+						t.Errorf("Save() key version = %v, want none for a reference", keyVersion)
+					}
+					return tt.saveErr
+				},
+				Failed: func(data map[string]any) { failure = data },
+			}
+
+			err := ApplySecretChange(context.Background(), nil, change, tt.value, tt.reference)
+			if tt.wantCode == 0 && err != nil || tt.wantCode != 0 && connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("ApplySecretChange() error = %v, want code %v", err, tt.wantCode)
+			}
+			if saved != tt.wantSave {
+				t.Errorf("ApplySecretChange() saved = %v, want %v", saved, tt.wantSave)
+			}
+			if tt.wantSave && tt.saveErr == nil && savedReference != tt.wantReference {
+				t.Errorf("Save() reference = %v, want %v", savedReference, tt.wantReference)
+			}
+			if got, _ := failure["error"].(string); got != tt.wantFailure {
+				t.Errorf("Failed() error = %q, want %q", got, tt.wantFailure)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/config"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
@@ -21,10 +22,22 @@ import (
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
 )
 
+// BillingManager defines the billing operations used by the organization service.
+type BillingManager interface {
+	ListSubscriptionItems(ctx context.Context, organizationID int64) ([]billing.SubscriptionItem, error)
+	CreateBillingPortalSession(ctx context.Context, organizationID int64, returnURL string) (string, error)
+	ListPaymentMethods(ctx context.Context, organizationID int64) ([]billing.PaymentMethod, error)
+	SetDefaultPaymentMethod(ctx context.Context, organizationID int64, paymentMethodID string) (*billing.PaymentMethod, error)
+	ListInvoices(ctx context.Context, organizationID int64, limit int64, startingAfter string) ([]billing.Invoice, bool, error)
+	GetInvoice(ctx context.Context, organizationID int64, invoiceID string) (*billing.Invoice, error)
+}
+
 // OrganizationService implements the organization-facing organization API.
 type OrganizationService struct {
-	repo   *Repository
-	config *config.Config
+	repo           *Repository
+	config         *config.Config
+	billingManager BillingManager
+	vault          *vaultOrchestrator
 }
 
 // Compile-time check.
@@ -32,9 +45,23 @@ var _ libopsv1connect.OrganizationServiceHandler = (*OrganizationService)(nil)
 
 // NewOrganizationService creates a new organization-facing organization service.
 func NewOrganizationService(querier db.Querier, cfg *config.Config) *OrganizationService {
+	var billingMgr BillingManager
+	if cfg.DisableBilling {
+		billingMgr = billing.NewNoOpBillingManager()
+	} else {
+		billingMgr = billing.NewStripeManager(querier)
+	}
+
+	return NewOrganizationServiceWithBilling(querier, cfg, billingMgr)
+}
+
+// NewOrganizationServiceWithBilling creates a new organization service with a custom billing manager (for testing).
+func NewOrganizationServiceWithBilling(querier db.Querier, cfg *config.Config, billingMgr BillingManager) *OrganizationService {
 	return &OrganizationService{
-		repo:   NewRepository(querier),
-		config: cfg,
+		repo:           NewRepository(querier),
+		config:         cfg,
+		billingManager: billingMgr,
+		vault:          newVaultOrchestrator(querier),
 	}
 }
 
@@ -55,21 +82,30 @@ func (s *OrganizationService) GetOrganization(
 	}
 
 	// Membership check is handled automatically by scope interceptor via proto annotation
-	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	organization, err := s.repo.GetOrganizationByPublicID(service.ETagContext(ctx), publicID)
 	if err != nil {
 		slog.Error("Failed to get organization by public ID", "error", err, "organization_id", organizationID)
 		return nil, err
 	}
+	residency, err := s.repo.GetDataResidency(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	folder := &commonv1.FolderConfig{
 		OrganizationId:   organization.PublicID,
 		OrganizationName: organization.Name,
 		Status:           service.DbOrganizationStatusToProto(organization.Status),
+		Labels:           service.FromJSONLabels(organization.Labels),
+		DataResidency:    service.DbDataResidencyToProto(residency),
+		Etag:             service.ETag(organization.Version),
 	}
 
-	return connect.NewResponse(&libopsv1.GetOrganizationResponse{
+	resp := connect.NewResponse(&libopsv1.GetOrganizationResponse{
 		Folder: folder,
-	}), nil
+	})
+	service.SetETag(resp.Header(), organization.Version)
+	return resp, nil
 }
 
 // CreateOrganization creates a new organization.
@@ -86,6 +122,9 @@ func (s *OrganizationService) CreateOrganization(
 	if err := validation.OrganizationName(folder.OrganizationName); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	if err := validation.Labels(folder.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
 	userInfo, ok := auth.GetUserFromContext(ctx)
 	if !ok {
@@ -102,13 +141,14 @@ func (s *OrganizationService) CreateOrganization(
 	newID := uuid.New().String()
 
 	// Use the shared repository method that creates org, adds owner, and creates relationship
-	_, err := s.repo.CreateOrganizationWithOwner(
+	organizationID, err := s.repo.CreateOrganizationWithOwner(
 		ctx,
 		newID,
 		folder.OrganizationName,
 		s.config.GcpOrgID,
 		s.config.GcpBillingAccount,
 		s.config.GcpParent,
+		folder.Labels,
 		accountID,
 		s.config.RootOrganizationID,
 	)
@@ -116,6 +156,13 @@ func (s *OrganizationService) CreateOrganization(
 		slog.Error("Failed to create organization", "error", err, "organization_name", folder.OrganizationName, "account_id", accountID)
 		return nil, err
 	}
+	if folder.DataResidency != commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED {
+		if err := s.repo.setDataResidency(ctx, organizationID, accountID, folder.DataResidency); err != nil {
+			slog.Error("Failed to set data residency", "error", err, "organization_id", newID)
+			return nil, err
+		}
+	}
+	s.vault.provisionOrganization(ctx, organizationID, newID)
 
 	return connect.NewResponse(&libopsv1.CreateOrganizationResponse{
 		OrganizationId: newID,
@@ -123,6 +170,13 @@ func (s *OrganizationService) CreateOrganization(
 	}), nil
 }
 
+// organizationUpdatePaths are the fields UpdateOrganization can change.
+var organizationUpdatePaths = []string{
+	"folder.organization_name",
+	"folder.labels",
+	"folder.data_residency",
+}
+
 // UpdateOrganization updates organization metadata (organization-editable fields only).
 func (s *OrganizationService) UpdateOrganization(
 	ctx context.Context,
@@ -137,6 +191,11 @@ func (s *OrganizationService) UpdateOrganization(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder configuration is required"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, organizationUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	publicID, err := uuid.Parse(organizationID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
@@ -154,12 +213,28 @@ func (s *OrganizationService) UpdateOrganization(
 		slog.Error("Failed to get organization by public ID for update", "error", err, "organization_id", organizationID)
 		return nil, err
 	}
+	if err := service.CheckETag(req.Header(), folder.Etag, existing.Version, "organization"); err != nil {
+		return nil, err
+	}
 
-	// Apply field mask - organizations can only update name
+	// Apply field mask - organizations can only update name, labels and data residency
 	name := existing.Name
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "folder.organization_name") {
+	if mask.Has("folder.organization_name") {
 		name = folder.OrganizationName
 	}
+	labels := existing.Labels
+	if mask.Has("folder.labels") {
+		if err := validation.Labels(folder.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(folder.Labels)
+	}
+
+	if mask.Has("folder.data_residency") {
+		if err := s.repo.setDataResidency(ctx, existing.ID, accountID, folder.DataResidency); err != nil {
+			return nil, err
+		}
+	}
 
 	// Preserve all admin fields
 	params := db.UpdateOrganizationParams{
@@ -169,8 +244,10 @@ func (s *OrganizationService) UpdateOrganization(
 		GcpParent:         existing.GcpParent,
 		GcpFolderID:       existing.GcpFolderID,
 		Status:            existing.Status,
+		Labels:            labels,
 		UpdatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:          publicID.String(),
+		Version:           existing.Version,
 	}
 
 	err = s.repo.UpdateOrganization(ctx, params)
@@ -179,9 +256,12 @@ func (s *OrganizationService) UpdateOrganization(
 		return nil, err
 	}
 
-	return connect.NewResponse(&libopsv1.UpdateOrganizationResponse{
+	folder.Etag = service.ETag(existing.Version + 1)
+	resp := connect.NewResponse(&libopsv1.UpdateOrganizationResponse{
 		Folder: folder,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteOrganization deletes a organization (must have no projects).
@@ -199,7 +279,7 @@ func (s *OrganizationService) DeleteOrganization(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
 	}
 
-	err = s.repo.DeleteOrganization(ctx, publicID)
+	err = s.vault.deleteOrganization(ctx, s.repo, publicID)
 	if err != nil {
 		slog.Error("Failed to delete organization", "error", err, "organization_id", organizationID)
 		return nil, err
@@ -218,15 +298,16 @@ func (s *OrganizationService) ListOrganizations(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	pagination, err := service.ParseCursorPagination(req.Msg.PageSize, req.Msg.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	organizations, err := s.repo.ListOrganizations(ctx, db.ListOrganizationsParams{
-		AccountID: userInfo.AccountID,
-		Limit:     pagination.Limit,
-		Offset:    pagination.Offset,
+		AccountID:       userInfo.AccountID,
+		CursorCreatedAt: pagination.CreatedAt,
+		CursorID:        pagination.ID,
+		Limit:           pagination.Limit,
 	})
 	if err != nil {
 		slog.Error("Failed to list organizations", "error", err, "account_id", userInfo.AccountID)
@@ -239,10 +320,15 @@ func (s *OrganizationService) ListOrganizations(
 			OrganizationId:   organization.PublicID,
 			OrganizationName: organization.Name,
 			Status:           service.DbOrganizationStatusToProto(organization.Status),
+			Labels:           service.FromJSONLabels(organization.Labels),
 		})
 	}
 
-	paginationResult := service.MakePaginationResult(len(organizations), pagination)
+	paginationResult := service.PaginationResult{}
+	if n := len(organizations); n > 0 {
+		last := organizations[n-1]
+		paginationResult = service.MakeCursorPaginationResult(n, pagination, last.CreatedAt, last.ID)
+	}
 
 	return connect.NewResponse(&libopsv1.ListOrganizationsResponse{
 		Organizations: protoOrganizations,
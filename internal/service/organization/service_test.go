@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/config"
 	"github.com/libops/api/internal/testutils"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
@@ -246,3 +248,325 @@ func TestCreateOrganization(t *testing.T) {
 		})
 	}
 }
+
+type fakeBillingManager struct {
+	billing.NoOpBillingManager
+	items []billing.SubscriptionItem
+	err   error
+}
+
+func (f *fakeBillingManager) ListSubscriptionItems(ctx context.Context, organizationID int64) ([]billing.SubscriptionItem, error) {
+	return f.items, f.err
+}
+
+// TestGetOrganizationUsage tests aggregation of project usage, metering, and Stripe items.
+func TestGetOrganizationUsage(t *testing.T) {
+	orgID := uuid.New()
+	periodStart := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	newMock := func(withSubscription bool) *testutils.MockQuerier {
+		return &testutils.MockQuerier{
+			GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+				return db.GetOrganizationRow{ID: 7, PublicID: publicID}, nil
+			},
+			GetStripeSubscriptionByOrganizationIDFunc: func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error) {
+				if !withSubscription {
+					return db.GetStripeSubscriptionByOrganizationIDRow{}, sql.ErrNoRows
+				}
+				return db.GetStripeSubscriptionByOrganizationIDRow{
+					Status:             db.StripeSubscriptionsStatusActive,
+					CurrentPeriodStart: sql.NullTime{Time: periodStart, Valid: true},
+				}, nil
+			},
+			ListOrganizationProjectUsageFunc: func(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectUsageRow, error) {
+				return []db.ListOrganizationProjectUsageRow{
+					{ID: 1, PublicID: "p1", Name: "one", MachineType: sql.NullString{String: "e2-medium", Valid: true}, DiskSizeGb: sql.NullInt32{Int32: 20, Valid: true}},
+					{ID: 2, PublicID: "p2", Name: "two", DiskSizeGb: sql.NullInt32{Int32: 50, Valid: true}},
+				}, nil
+			},
+			SumOrganizationProjectUsageFunc: func(ctx context.Context, arg db.SumOrganizationProjectUsageParams) ([]db.SumOrganizationProjectUsageRow, error) {
+				if withSubscription {
+					assert.Equal(t, periodStart, arg.UsageDate)
+				}
+				return []db.SumOrganizationProjectUsageRow{{ProjectID: 2, Total: 300}}, nil
+			},
+			LatestOrganizationProjectUsageFunc: func(ctx context.Context, arg db.LatestOrganizationProjectUsageParams) ([]db.LatestOrganizationProjectUsageRow, error) {
+				return []db.LatestOrganizationProjectUsageRow{{ProjectID: 1, Quantity: 1000}}, nil
+			},
+			GetOrganizationPaymentFailureFunc: func(ctx context.Context, id int64) (db.GetOrganizationPaymentFailureRow, error) {
+				return db.GetOrganizationPaymentFailureRow{
+					PaymentFailedAt:        sql.NullTime{Time: periodStart, Valid: true},
+					PaymentFailedInvoiceID: sql.NullString{String: "in_1", Valid: true},
+				}, nil
+			},
+			GetOrganizationBillingStateFunc: func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+				return db.GetOrganizationBillingStateRow{
+					BillingState:          db.OrganizationsBillingStatePastDue,
+					BillingStateChangedAt: sql.NullTime{Time: periodStart, Valid: true},
+				}, nil
+			},
+		}
+	}
+
+	t.Run("with subscription", func(t *testing.T) {
+		cfg := testConfig()
+		cfg.BillingPastDueGracePeriod = 14 * 24 * time.Hour
+		svc := NewOrganizationServiceWithBilling(newMock(true), cfg, &fakeBillingManager{
+			items: []billing.SubscriptionItem{{ItemID: "si_1", Description: "e2-medium", Quantity: 1, UnitAmount: 2500}},
+		})
+		resp, err := svc.GetOrganizationUsage(context.Background(), connect.NewRequest(&libopsv1.GetOrganizationUsageRequest{
+			OrganizationId: orgID.String(),
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, "active", resp.Msg.Subscription.GetStatus())
+		assert.Equal(t, periodStart.Unix(), resp.Msg.Subscription.GetPaymentFailedAt())
+		assert.Equal(t, "in_1", resp.Msg.Subscription.GetPaymentFailedInvoiceId())
+		assert.Equal(t, "past_due", resp.Msg.Subscription.GetBillingState())
+		assert.Equal(t, periodStart.Unix(), resp.Msg.Subscription.GetBillingStateChangedAt())
+		assert.Equal(t, periodStart.Add(14*24*time.Hour).Unix(), resp.Msg.Subscription.GetBillingStateDeadline())
+		assert.Equal(t, int64(70), resp.Msg.TotalDiskGb)
+		assert.Equal(t, int64(1000), resp.Msg.BackupStorageBytes)
+		assert.Equal(t, int64(300), resp.Msg.EgressBytes)
+		assert.Len(t, resp.Msg.Projects, 2)
+		assert.Equal(t, int64(1000), resp.Msg.Projects[0].BackupStorageBytes)
+		assert.Len(t, resp.Msg.Items, 1)
+	})
+
+	t.Run("without subscription skips Stripe", func(t *testing.T) {
+		svc := NewOrganizationServiceWithBilling(newMock(false), testConfig(), &fakeBillingManager{err: fmt.Errorf("stripe down")})
+		resp, err := svc.GetOrganizationUsage(context.Background(), connect.NewRequest(&libopsv1.GetOrganizationUsageRequest{
+			OrganizationId: orgID.String(),
+		}))
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Msg.Subscription)
+		assert.Empty(t, resp.Msg.Items)
+	})
+
+	t.Run("Stripe failure is unavailable", func(t *testing.T) {
+		svc := NewOrganizationServiceWithBilling(newMock(true), testConfig(), &fakeBillingManager{err: fmt.Errorf("stripe down")})
+		_, err := svc.GetOrganizationUsage(context.Background(), connect.NewRequest(&libopsv1.GetOrganizationUsageRequest{
+			OrganizationId: orgID.String(),
+		}))
+		assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	})
+}
+
+// TestPreviewUsage tests pricing metered usage for the current billing period.
+func TestPreviewUsage(t *testing.T) {
+	orgID := uuid.New()
+	periodStart := time.Now().UTC().AddDate(0, 0, -10).Truncate(24 * time.Hour)
+	periodEnd := periodStart.AddDate(0, 0, 30)
+
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: publicID}, nil
+		},
+		GetStripeSubscriptionByOrganizationIDFunc: func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error) {
+			return db.GetStripeSubscriptionByOrganizationIDRow{
+				Status:             db.StripeSubscriptionsStatusActive,
+				CurrentPeriodStart: sql.NullTime{Time: periodStart, Valid: true},
+				CurrentPeriodEnd:   sql.NullTime{Time: periodEnd, Valid: true},
+			}, nil
+		},
+		ListMeteredPricesFunc: func(ctx context.Context) ([]db.ListMeteredPricesRow, error) {
+			return []db.ListMeteredPricesRow{
+				{Metric: billing.MetricEgressBytes, UnitBytes: 1e9, UnitPriceCents: 10, IncludedUnits: 1},
+				{Metric: billing.MetricDiskUsedBytes, UnitBytes: 1e9, UnitPriceCents: 5},
+			}, nil
+		},
+		ListOrganizationDailyUsageFunc: func(ctx context.Context, arg db.ListOrganizationDailyUsageParams) ([]db.ListOrganizationDailyUsageRow, error) {
+			assert.Equal(t, int64(7), arg.OrganizationID)
+			assert.Equal(t, periodStart, arg.UsageDate)
+			if arg.Metric == billing.MetricEgressBytes {
+				return []db.ListOrganizationDailyUsageRow{{UsageDate: periodStart, Total: 3e9}}, nil
+			}
+			return []db.ListOrganizationDailyUsageRow{{UsageDate: periodStart, Total: 2e9}}, nil
+		},
+	}
+
+	svc := NewOrganizationServiceWithBilling(mock, testConfig(), &fakeBillingManager{})
+	resp, err := svc.PreviewUsage(context.Background(), connect.NewRequest(&libopsv1.PreviewUsageRequest{
+		OrganizationId: orgID.String(),
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, periodStart.Unix(), resp.Msg.PeriodStart)
+	assert.Equal(t, periodEnd.Unix(), resp.Msg.PeriodEnd)
+	assert.Len(t, resp.Msg.Usage, 2)
+	assert.Equal(t, int64(3), resp.Msg.Usage[0].Units)
+	assert.Equal(t, int64(20), resp.Msg.Usage[0].EstimatedAmountCents)
+	assert.Equal(t, int64(10), resp.Msg.Usage[1].EstimatedAmountCents)
+	assert.Equal(t, int64(30), resp.Msg.EstimatedAmountCents)
+	assert.GreaterOrEqual(t, resp.Msg.ProjectedAmountCents, resp.Msg.EstimatedAmountCents)
+
+	_, err = svc.PreviewUsage(context.Background(), connect.NewRequest(&libopsv1.PreviewUsageRequest{OrganizationId: "bad"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestSetDefaultPaymentMethod tests subscription checks and billing error mapping.
+func TestSetDefaultPaymentMethod(t *testing.T) {
+	orgID := uuid.New()
+
+	tests := []struct {
+		name            string
+		paymentMethodID string
+		hasSubscription bool
+		billingErr      error
+		wantCode        connect.Code
+	}{
+		{name: "success", paymentMethodID: "pm_1", hasSubscription: true},
+		{name: "missing payment method id", hasSubscription: true, wantCode: connect.CodeInvalidArgument},
+		{name: "no subscription", paymentMethodID: "pm_1", wantCode: connect.CodeFailedPrecondition},
+		{name: "foreign payment method", paymentMethodID: "pm_other", hasSubscription: true, billingErr: billing.ErrPaymentMethodNotFound, wantCode: connect.CodeNotFound},
+		{name: "billing disabled", paymentMethodID: "pm_1", hasSubscription: true, billingErr: billing.ErrBillingDisabled, wantCode: connect.CodeFailedPrecondition},
+		{name: "stripe failure", paymentMethodID: "pm_1", hasSubscription: true, billingErr: fmt.Errorf("stripe down"), wantCode: connect.CodeUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &testutils.MockQuerier{
+				GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+					return db.GetOrganizationRow{ID: 7, PublicID: publicID}, nil
+				},
+				GetStripeSubscriptionByOrganizationIDFunc: func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error) {
+					if !tt.hasSubscription {
+						return db.GetStripeSubscriptionByOrganizationIDRow{}, sql.ErrNoRows
+					}
+					return db.GetStripeSubscriptionByOrganizationIDRow{StripeCustomerID: "cus_1"}, nil
+				},
+			}
+			svc := NewOrganizationServiceWithBilling(mockDB, testConfig(), &paymentMethodBillingManager{err: tt.billingErr})
+
+			resp, err := svc.SetDefaultPaymentMethod(context.Background(), connect.NewRequest(&libopsv1.SetDefaultPaymentMethodRequest{
+				OrganizationId:  orgID.String(),
+				PaymentMethodId: tt.paymentMethodID,
+			}))
+
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.paymentMethodID, resp.Msg.PaymentMethod.PaymentMethodId)
+			assert.True(t, resp.Msg.PaymentMethod.IsDefault)
+		})
+	}
+}
+
+type paymentMethodBillingManager struct {
+	billing.NoOpBillingManager
+	err error
+}
+
+func (p *paymentMethodBillingManager) SetDefaultPaymentMethod(ctx context.Context, organizationID int64, paymentMethodID string) (*billing.PaymentMethod, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &billing.PaymentMethod{ID: paymentMethodID, Brand: "visa", Last4: "4242", IsDefault: true}, nil
+}
+
+// TestListInvoices tests page size clamping and cursor-based page tokens.
+func TestListInvoices(t *testing.T) {
+	orgID := uuid.New()
+
+	tests := []struct {
+		name          string
+		pageSize      int32
+		pageToken     string
+		hasMore       bool
+		wantLimit     int64
+		wantNextToken string
+	}{
+		{name: "default page size", wantLimit: 50},
+		{name: "clamped page size", pageSize: 500, wantLimit: 100},
+		{name: "more pages", pageSize: 2, pageToken: "in_0", hasMore: true, wantLimit: 2, wantNextToken: "in_2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := &testutils.MockQuerier{
+				GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+					return db.GetOrganizationRow{ID: 7, PublicID: publicID}, nil
+				},
+				GetStripeSubscriptionByOrganizationIDFunc: func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error) {
+					return db.GetStripeSubscriptionByOrganizationIDRow{StripeCustomerID: "cus_1"}, nil
+				},
+			}
+			billingMgr := &invoiceBillingManager{
+				invoices: []billing.Invoice{{ID: "in_1", Status: "paid"}, {ID: "in_2", Status: "open"}},
+				hasMore:  tt.hasMore,
+			}
+			svc := NewOrganizationServiceWithBilling(mockDB, testConfig(), billingMgr)
+
+			resp, err := svc.ListInvoices(context.Background(), connect.NewRequest(&libopsv1.ListInvoicesRequest{
+				OrganizationId: orgID.String(),
+				PageSize:       tt.pageSize,
+				PageToken:      tt.pageToken,
+			}))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, billingMgr.limit)
+			assert.Equal(t, tt.pageToken, billingMgr.startingAfter)
+			assert.Len(t, resp.Msg.Invoices, 2)
+			assert.Equal(t, tt.wantNextToken, resp.Msg.NextPageToken)
+		})
+	}
+}
+
+type invoiceBillingManager struct {
+	billing.NoOpBillingManager
+	invoices      []billing.Invoice
+	hasMore       bool
+	limit         int64
+	startingAfter string
+}
+
+func (i *invoiceBillingManager) ListInvoices(ctx context.Context, organizationID int64, limit int64, startingAfter string) ([]billing.Invoice, bool, error) {
+	i.limit = limit
+	i.startingAfter = startingAfter
+	return i.invoices, i.hasMore, nil
+}
+
+// TestListOrganizationsKeysetPagination tests that ListOrganizations hands the
+// page token's cursor to the query and continues from the last row it returned.
+func TestListOrganizationsKeysetPagination(t *testing.T) {
+	newest := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	var got []db.ListOrganizationsParams
+	mockDB := &testutils.MockQuerier{
+		ListOrganizationsFunc: func(ctx context.Context, arg db.ListOrganizationsParams) ([]db.ListOrganizationsRow, error) {
+			got = append(got, arg)
+			if !arg.CursorID.Valid {
+				return []db.ListOrganizationsRow{
+					{ID: 9, PublicID: uuid.NewString(), Name: "newest", CreatedAt: sql.NullTime{Time: newest, Valid: true}},
+					{ID: 4, PublicID: uuid.NewString(), Name: "older", CreatedAt: sql.NullTime{Time: newest.Add(-time.Hour), Valid: true}},
+				}, nil
+			}
+			return []db.ListOrganizationsRow{
+				{ID: 2, PublicID: uuid.NewString(), Name: "oldest", CreatedAt: sql.NullTime{Time: newest.Add(-2 * time.Hour), Valid: true}},
+			}, nil
+		},
+	}
+	svc := NewOrganizationService(mockDB, testConfig())
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	first, err := svc.ListOrganizations(ctx, connect.NewRequest(&libopsv1.ListOrganizationsRequest{PageSize: 2}))
+	assert.NoError(t, err)
+	assert.Len(t, first.Msg.Organizations, 2)
+	assert.NotEmpty(t, first.Msg.NextPageToken)
+
+	second, err := svc.ListOrganizations(ctx, connect.NewRequest(&libopsv1.ListOrganizationsRequest{
+		PageSize:  2,
+		PageToken: first.Msg.NextPageToken,
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, second.Msg.Organizations, 1)
+	assert.Empty(t, second.Msg.NextPageToken)
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, int64(3), got[1].AccountID)
+	assert.Equal(t, int32(2), got[1].Limit)
+	assert.Equal(t, int64(4), got[1].CursorID.Int64)
+	assert.True(t, got[1].CursorCreatedAt.Time.Equal(newest.Add(-time.Hour)))
+
+	_, err = svc.ListOrganizations(ctx, connect.NewRequest(&libopsv1.ListOrganizationsRequest{PageToken: "50"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,120 @@
+package organization
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	// maxSettingValue caps a setting's value, which site VMs get verbatim
+	// when the setting is an environment setting
+	maxSettingValue = 4096
+	// maxSettingAllowedValues caps the values an enum setting allows
+	maxSettingAllowedValues = 50
+)
+
+// ValidateSettingKey validates a setting's key.
+func ValidateSettingKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("setting key is required")
+	}
+	if len(key) > 255 {
+		return fmt.Errorf("setting key too long (max 255 characters)")
+	}
+	return nil
+}
+
+// ValidateSettingType checks that a setting's type and allowed values agree:
+// enum settings need allowed values and no other type takes any.
+func ValidateSettingType(valueType libopsv1.SettingValueType, allowed []string) error {
+	if _, ok := libopsv1.SettingValueType_name[int32(valueType)]; !ok {
+		return fmt.Errorf("unknown value_type %d", valueType)
+	}
+	if valueType != libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM {
+		if len(allowed) > 0 {
+			return fmt.Errorf("allowed_values are only allowed on enum settings")
+		}
+		return nil
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("enum settings need allowed_values")
+	}
+	if len(allowed) > maxSettingAllowedValues {
+		return fmt.Errorf("too many allowed_values (max %d)", maxSettingAllowedValues)
+	}
+	for i, v := range allowed {
+		if v == "" {
+			return fmt.Errorf("allowed_values can't be empty")
+		}
+		if slices.Contains(allowed[:i], v) {
+			return fmt.Errorf("allowed_values has %q twice", v)
+		}
+	}
+	return nil
+}
+
+// ValidateSettingValue checks a setting's value against its type. Values of
+// environment settings must fit on one line of the VM's environment file.
+func ValidateSettingValue(key, value string, valueType libopsv1.SettingValueType, allowed []string) error {
+	if len(value) > maxSettingValue {
+		return fmt.Errorf("value too long (max %d characters)", maxSettingValue)
+	}
+	if IsEnvironmentSetting(key) && strings.ContainsAny(value, "\r\n\x00") {
+		return fmt.Errorf("environment settings must be a single line")
+	}
+
+	switch valueType {
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_INT:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%s must be an integer", key)
+		}
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_BOOL:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%s must be true or false", key)
+		}
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM:
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("%s must be one of %s", key, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// IsEnvironmentSetting reports whether a setting is delivered to site VMs as
+// an environment variable, which is the case for any setting named like one.
+// Changing one reconciles the sites it applies to.
+func IsEnvironmentSetting(key string) bool {
+	return secretNameRegex.MatchString(key)
+}
+
+// SettingValueTypeToDB converts a setting's value type to its column value.
+func SettingValueTypeToDB(valueType libopsv1.SettingValueType) string {
+	switch valueType {
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_INT:
+		return "int"
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_BOOL:
+		return "bool"
+	case libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM:
+		return "enum"
+	default:
+		return "string"
+	}
+}
+
+// SettingValueTypeFromDB converts a setting's value_type column to its proto type.
+func SettingValueTypeFromDB(valueType string) libopsv1.SettingValueType {
+	switch valueType {
+	case "int":
+		return libopsv1.SettingValueType_SETTING_VALUE_TYPE_INT
+	case "bool":
+		return libopsv1.SettingValueType_SETTING_VALUE_TYPE_BOOL
+	case "enum":
+		return libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM
+	default:
+		return libopsv1.SettingValueType_SETTING_VALUE_TYPE_STRING
+	}
+}
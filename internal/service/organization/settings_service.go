@@ -11,7 +11,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
@@ -19,16 +23,18 @@ import (
 
 // OrganizationSettingService implements the OrganizationSettingService API.
 type OrganizationSettingService struct {
-	db db.Querier
+	db      db.Querier
+	emitter *events.Emitter
 }
 
 // Compile-time check to ensure OrganizationSettingService implements the interface.
 var _ libopsv1connect.OrganizationSettingServiceHandler = (*OrganizationSettingService)(nil)
 
 // NewOrganizationSettingService creates a new OrganizationSettingService instance.
-func NewOrganizationSettingService(querier db.Querier) *OrganizationSettingService {
+func NewOrganizationSettingService(querier db.Querier, emitter *events.Emitter) *OrganizationSettingService {
 	return &OrganizationSettingService{
-		db: querier,
+		db:      querier,
+		emitter: emitter,
 	}
 }
 
@@ -43,8 +49,14 @@ func (s *OrganizationSettingService) CreateOrganizationSetting(
 	editable := req.Msg.Editable
 	description := req.Msg.Description
 
-	if key == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("setting key is required"))
+	if err := ValidateSettingKey(key); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := ValidateSettingType(req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := ValidateSettingValue(key, value, req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	userInfo, ok := auth.GetUserFromContext(ctx)
@@ -52,6 +64,12 @@ func (s *OrganizationSettingService) CreateOrganizationSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
+	// Settings users can't edit are set by libops, so a user can't lock
+	// themselves out of one
+	if !editable && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only libops can create settings that can't be edited"))
+	}
+
 	// Parse organization UUID
 	orgUUID, err := uuid.Parse(organizationID)
 	if err != nil {
@@ -67,6 +85,22 @@ func (s *OrganizationSettingService) CreateOrganizationSetting(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
 	}
 
+	_, err = s.db.GetOrganizationSetting(ctx, db.GetOrganizationSettingParams{
+		OrganizationID: org.ID,
+		SettingKey:     key,
+	})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("setting %s already exists", key))
+	}
+	if err != sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+
+	var allowedValues types.RawJSON
+	if req.Msg.ValueType == libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM {
+		allowedValues = service.ToJSON(req.Msg.AllowedValues)
+	}
+
 	// Create setting
 	settingPublicID := uuid.New().String()
 	err = s.db.CreateOrganizationSetting(ctx, db.CreateOrganizationSettingParams{
@@ -74,6 +108,8 @@ func (s *OrganizationSettingService) CreateOrganizationSetting(
 		OrganizationID: org.ID,
 		SettingKey:     key,
 		SettingValue:   value,
+		ValueType:      db.OrganizationSettingsValueType(SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues:  allowedValues,
 		Editable:       sql.NullBool{Bool: editable, Valid: true},
 		Description:    sql.NullString{String: description, Valid: description != ""},
 		Status:         db.NullOrganizationSettingsStatus{OrganizationSettingsStatus: db.OrganizationSettingsStatusActive, Valid: true},
@@ -94,7 +130,11 @@ func (s *OrganizationSettingService) CreateOrganizationSetting(
 		Editable:       editable,
 		Description:    description,
 		Status:         commonv1.Status_STATUS_ACTIVE,
+		ValueType:      SettingValueTypeFromDB(SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues:  req.Msg.AllowedValues,
+		Environment:    IsEnvironmentSetting(key),
 	}
+	s.reconcile(ctx, events.EventTypeOrganizationSettingCreated, setting)
 
 	return connect.NewResponse(&libopsv1.CreateOrganizationSettingResponse{
 		Setting: setting,
@@ -106,36 +146,13 @@ func (s *OrganizationSettingService) GetOrganizationSetting(
 	ctx context.Context,
 	req *connect.Request[libopsv1.GetOrganizationSettingRequest],
 ) (*connect.Response[libopsv1.GetOrganizationSettingResponse], error) {
-	settingID := req.Msg.SettingId
-
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.OrganizationId, req.Msg.SettingId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
-	// Get setting
-	dbSetting, err := s.db.GetOrganizationSettingByPublicID(ctx, settingUUID.String())
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
-	}
-
-	// Convert to proto
-	setting := &libopsv1.OrganizationSetting{
-		SettingId:      dbSetting.PublicID,
-		OrganizationId: fmt.Sprintf("%d", dbSetting.OrganizationID),
-		Key:            dbSetting.SettingKey,
-		Value:          dbSetting.SettingValue,
-		Editable:       dbSetting.Editable.Bool,
-		Description:    dbSetting.Description.String,
-		Status:         convertSettingStatus(dbSetting.Status.OrganizationSettingsStatus),
+		return nil, err
 	}
 
 	return connect.NewResponse(&libopsv1.GetOrganizationSettingResponse{
-		Setting: setting,
+		Setting: organizationSettingToProto(req.Msg.OrganizationId, dbSetting),
 	}), nil
 }
 
@@ -179,15 +196,7 @@ func (s *OrganizationSettingService) ListOrganizationSettings(
 	// Convert to proto
 	settings := make([]*libopsv1.OrganizationSetting, 0, len(dbSettings))
 	for _, dbSetting := range dbSettings {
-		settings = append(settings, &libopsv1.OrganizationSetting{
-			SettingId:      dbSetting.PublicID,
-			OrganizationId: organizationID,
-			Key:            dbSetting.SettingKey,
-			Value:          dbSetting.SettingValue,
-			Editable:       dbSetting.Editable.Bool,
-			Description:    dbSetting.Description.String,
-			Status:         convertSettingStatus(dbSetting.Status.OrganizationSettingsStatus),
-		})
+		settings = append(settings, organizationSettingToProto(organizationID, db.GetOrganizationSettingByPublicIDRow(dbSetting)))
 	}
 
 	return connect.NewResponse(&libopsv1.ListOrganizationSettingsResponse{
@@ -213,29 +222,25 @@ func (s *OrganizationSettingService) UpdateOrganizationSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
 	// Get existing setting
-	dbSetting, err := s.db.GetOrganizationSettingByPublicID(ctx, settingUUID.String())
+	dbSetting, err := s.setting(ctx, req.Msg.OrganizationId, settingID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+		return nil, err
 	}
 
 	// Check if setting is editable
-	if !dbSetting.Editable.Bool {
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be modified"))
 	}
 
+	valueType := SettingValueTypeFromDB(string(dbSetting.ValueType))
+	if err := ValidateSettingValue(dbSetting.SettingKey, *value, valueType, service.FromJSONStringArray(dbSetting.AllowedValues)); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Update setting
 	err = s.db.UpdateOrganizationSetting(ctx, db.UpdateOrganizationSettingParams{
-		PublicID:     settingUUID.String(),
+		PublicID:     dbSetting.PublicID,
 		SettingValue: *value,
 		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
@@ -245,14 +250,11 @@ func (s *OrganizationSettingService) UpdateOrganizationSetting(
 	}
 
 	// Return updated setting
-	setting := &libopsv1.OrganizationSetting{
-		SettingId:      settingID,
-		OrganizationId: req.Msg.OrganizationId,
-		Key:            dbSetting.SettingKey,
-		Value:          *value,
-		Editable:       dbSetting.Editable.Bool,
-		Description:    dbSetting.Description.String,
-		Status:         convertSettingStatus(dbSetting.Status.OrganizationSettingsStatus),
+	changed := dbSetting.SettingValue != *value
+	dbSetting.SettingValue = *value
+	setting := organizationSettingToProto(req.Msg.OrganizationId, dbSetting)
+	if changed {
+		s.reconcile(ctx, events.EventTypeOrganizationSettingUpdated, setting)
 	}
 
 	return connect.NewResponse(&libopsv1.UpdateOrganizationSettingResponse{
@@ -272,25 +274,85 @@ func (s *OrganizationSettingService) DeleteOrganizationSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.OrganizationId, settingID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+		return nil, err
+	}
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be deleted"))
 	}
 
 	// Delete setting (soft delete)
 	err = s.db.DeleteOrganizationSetting(ctx, db.DeleteOrganizationSettingParams{
-		PublicID:  settingUUID.String(),
+		PublicID:  dbSetting.PublicID,
 		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
 	if err != nil {
 		slog.Error("Failed to delete organization setting", "error", err, "setting_id", settingID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete setting: %w", err))
 	}
+	s.reconcile(ctx, events.EventTypeOrganizationSettingDeleted, organizationSettingToProto(req.Msg.OrganizationId, dbSetting))
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// setting gets one of an organization's settings. Settings of other
+// organizations are reported as not found.
+func (s *OrganizationSettingService) setting(ctx context.Context, organizationID, settingID string) (db.GetOrganizationSettingByPublicIDRow, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id: %w", err))
+	}
+	settingUUID, err := uuid.Parse(settingID)
+	if err != nil {
+		return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+	}
+
+	org, err := s.db.GetOrganization(ctx, orgUUID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization not found"))
+		}
+		return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+	}
+
+	dbSetting, err := s.db.GetOrganizationSettingByPublicID(ctx, settingUUID.String())
+	if err == sql.ErrNoRows || (err == nil && dbSetting.OrganizationID != org.ID) {
+		return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
+	}
+	if err != nil {
+		return db.GetOrganizationSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+	return dbSetting, nil
+}
+
+// reconcile queues the organization's sites when an environment setting, which
+// their VMs get, changes.
+func (s *OrganizationSettingService) reconcile(ctx context.Context, eventType string, setting *libopsv1.OrganizationSetting) {
+	if s.emitter == nil || !setting.Environment {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, setting.SettingId, &setting.OrganizationId, nil, nil, setting); err != nil {
+		slog.Error("Failed to emit organization setting event", "error", err, "organization_id", setting.OrganizationId, "event_type", eventType)
+	}
+}
+
+// organizationSettingToProto converts a database setting to its proto.
+func organizationSettingToProto(organizationID string, dbSetting db.GetOrganizationSettingByPublicIDRow) *libopsv1.OrganizationSetting {
+	return &libopsv1.OrganizationSetting{
+		SettingId:      dbSetting.PublicID,
+		OrganizationId: organizationID,
+		Key:            dbSetting.SettingKey,
+		Value:          dbSetting.SettingValue,
+		Editable:       dbSetting.Editable.Bool,
+		Description:    dbSetting.Description.String,
+		Status:         convertSettingStatus(dbSetting.Status.OrganizationSettingsStatus),
+		ValueType:      SettingValueTypeFromDB(string(dbSetting.ValueType)),
+		AllowedValues:  service.FromJSONStringArray(dbSetting.AllowedValues),
+		Environment:    IsEnvironmentSetting(dbSetting.SettingKey),
+	}
+}
+
 // convertSettingStatus converts database status to proto status.
 func convertSettingStatus(status db.OrganizationSettingsStatus) commonv1.Status {
 	switch status {
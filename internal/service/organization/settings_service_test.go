@@ -0,0 +1,170 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestOrganizationSettings tests that settings are checked against their
+// type, that settings users can't edit stay that way, that settings of other
+// organizations can't be reached, and that only environment settings
+// reconcile the organization's sites.
+func TestOrganizationSettings(t *testing.T) {
+	orgID := uuid.NewString()
+	otherOrgID := uuid.NewString()
+	orgs := map[string]int64{orgID: 1, otherOrgID: 2}
+	settings := map[string]db.GetOrganizationSettingByPublicIDRow{}
+	var queued []db.EnqueueEventParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			id, ok := orgs[publicID]
+			if !ok {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: id, PublicID: publicID}, nil
+		},
+		GetOrganizationSettingFunc: func(ctx context.Context, arg db.GetOrganizationSettingParams) (db.GetOrganizationSettingRow, error) {
+			for _, setting := range settings {
+				if setting.OrganizationID == arg.OrganizationID && setting.SettingKey == arg.SettingKey {
+					return db.GetOrganizationSettingRow(setting), nil
+				}
+			}
+			return db.GetOrganizationSettingRow{}, sql.ErrNoRows
+		},
+		GetOrganizationSettingByPublicIDFunc: func(ctx context.Context, publicID string) (db.GetOrganizationSettingByPublicIDRow, error) {
+			setting, ok := settings[publicID]
+			if !ok {
+				return db.GetOrganizationSettingByPublicIDRow{}, sql.ErrNoRows
+			}
+			return setting, nil
+		},
+		CreateOrganizationSettingFunc: func(ctx context.Context, arg db.CreateOrganizationSettingParams) error {
+			settings[arg.PublicID] = db.GetOrganizationSettingByPublicIDRow{
+				PublicID:       arg.PublicID,
+				OrganizationID: arg.OrganizationID,
+				SettingKey:     arg.SettingKey,
+				SettingValue:   arg.SettingValue,
+				ValueType:      arg.ValueType,
+				AllowedValues:  arg.AllowedValues,
+				Editable:       arg.Editable,
+				Status:         arg.Status,
+			}
+			return nil
+		},
+		UpdateOrganizationSettingFunc: func(ctx context.Context, arg db.UpdateOrganizationSettingParams) error {
+			setting := settings[arg.PublicID]
+			setting.SettingValue = arg.SettingValue
+			settings[arg.PublicID] = setting
+			return nil
+		},
+		DeleteOrganizationSettingFunc: func(ctx context.Context, arg db.DeleteOrganizationSettingParams) error {
+			delete(settings, arg.PublicID)
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+	}
+	svc := NewOrganizationSettingService(mock, events.NewEmitter(mock, "test"))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3, Email: "owner@example.com"})
+	create := func(msg *libopsv1.CreateOrganizationSettingRequest) (*libopsv1.OrganizationSetting, error) {
+		msg.OrganizationId = orgID
+		resp, err := svc.CreateOrganizationSetting(ctx, connect.NewRequest(msg))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Setting, nil
+	}
+	update := func(setting *libopsv1.OrganizationSetting, value string) (*libopsv1.OrganizationSetting, error) {
+		resp, err := svc.UpdateOrganizationSetting(ctx, connect.NewRequest(&libopsv1.UpdateOrganizationSettingRequest{
+			OrganizationId: orgID,
+			SettingId:      setting.SettingId,
+			Value:          &value,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Setting, nil
+	}
+
+	_, err := create(&libopsv1.CreateOrganizationSettingRequest{Editable: true, Key: "PHP_MEMORY_LIMIT", Value: "lots", ValueType: libopsv1.SettingValueType_SETTING_VALUE_TYPE_INT})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "not an integer")
+	_, err = create(&libopsv1.CreateOrganizationSettingRequest{Editable: true, Key: "php_op_mode", Value: "fpm", ValueType: libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "enums need allowed values")
+	_, err = create(&libopsv1.CreateOrganizationSettingRequest{Editable: true, Key: "maintenance", Value: "true", AllowedValues: []string{"true"}})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "only enums take allowed values")
+	_, err = create(&libopsv1.CreateOrganizationSettingRequest{Key: "LOG_LEVEL", Value: "debug"})
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "users can't create settings they can't edit")
+
+	mode, err := create(&libopsv1.CreateOrganizationSettingRequest{
+		Key:           "php_op_mode",
+		Value:         "fpm",
+		Editable:      true,
+		ValueType:     libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM,
+		AllowedValues: []string{"fpm", "mod_php"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fpm", "mod_php"}, mode.AllowedValues)
+	assert.False(t, mode.Environment)
+	_, err = create(&libopsv1.CreateOrganizationSettingRequest{Editable: true, Key: "php_op_mode", Value: "fpm"})
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = update(mode, "cgi")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "not an allowed value")
+	mode, err = update(mode, "mod_php")
+	require.NoError(t, err)
+	assert.Equal(t, "mod_php", mode.Value)
+	assert.Empty(t, queued, "php_op_mode isn't delivered to site VMs")
+
+	debug, err := create(&libopsv1.CreateOrganizationSettingRequest{Editable: true, Key: "APP_DEBUG", Value: "false", ValueType: libopsv1.SettingValueType_SETTING_VALUE_TYPE_BOOL})
+	require.NoError(t, err)
+	assert.True(t, debug.Environment)
+	assert.Equal(t, libopsv1.SettingValueType_SETTING_VALUE_TYPE_BOOL, debug.ValueType)
+	_, err = update(debug, "yes")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = update(debug, "false")
+	require.NoError(t, err)
+	_, err = update(debug, "true")
+	require.NoError(t, err)
+
+	_, err = svc.GetOrganizationSetting(ctx, connect.NewRequest(&libopsv1.GetOrganizationSettingRequest{OrganizationId: otherOrgID, SettingId: debug.SettingId}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "the setting belongs to another organization")
+	_, err = svc.DeleteOrganizationSetting(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationSettingRequest{OrganizationId: otherOrgID, SettingId: debug.SettingId}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "the setting belongs to another organization")
+
+	lockedID := uuid.NewString()
+	settings[lockedID] = db.GetOrganizationSettingByPublicIDRow{
+		PublicID:       lockedID,
+		OrganizationID: 1,
+		SettingKey:     "PLATFORM_TIER",
+		SettingValue:   "standard",
+		ValueType:      db.OrganizationSettingsValueTypeString,
+		Editable:       sql.NullBool{Bool: false, Valid: true},
+	}
+	locked := &libopsv1.OrganizationSetting{SettingId: lockedID}
+	_, err = update(locked, "premium")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = svc.DeleteOrganizationSetting(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationSettingRequest{OrganizationId: orgID, SettingId: lockedID}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = svc.DeleteOrganizationSetting(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationSettingRequest{OrganizationId: orgID, SettingId: debug.SettingId}))
+	require.NoError(t, err)
+
+	require.Len(t, queued, 3, "APP_DEBUG was created, changed once and deleted")
+	assert.Equal(t, events.EventTypeOrganizationSettingCreated, queued[0].EventType)
+	assert.Equal(t, events.EventTypeOrganizationSettingUpdated, queued[1].EventType)
+	assert.Equal(t, events.EventTypeOrganizationSettingDeleted, queued[2].EventType)
+}
@@ -5,25 +5,26 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"log/slog"
 
 	"connectrpc.com/connect"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 )
 
 // Repository encapsulates shared organization business logic.
 type Repository struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // NewRepository creates a new organization repository.
 func NewRepository(querier db.Querier) *Repository {
-	return &Repository{db: querier}
+	return &Repository{db: querier, quotas: quota.NewEnforcer(querier)}
 }
 
 // GetOrganizationByPublicID retrieves a organization by public ID.
@@ -102,6 +103,7 @@ func (r *Repository) CreateOrganizationWithOwner(
 	gcpOrgID string,
 	gcpBillingAccount string,
 	gcpParent string,
+	labels map[string]string,
 	accountID int64,
 	rootOrgID int64, // 0 means no root org relationship
 ) (int64, error) {
@@ -114,6 +116,7 @@ func (r *Repository) CreateOrganizationWithOwner(
 		GcpParent:         gcpParent,
 		GcpFolderID:       sql.NullString{Valid: false},
 		Status:            db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusProvisioning, Valid: true},
+		Labels:            service.LabelsToJSON(labels),
 		CreatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:         sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -154,49 +157,18 @@ func (r *Repository) CreateOrganizationWithOwner(
 		}
 	}
 
-	// Create default organization settings
-	defaultSettings := []struct {
-		key         string
-		value       string
-		editable    bool
-		description string
-	}{
-		{
-			key:         "max_projects",
-			value:       "10",
-			editable:    false,
-			description: "Maximum number of projects allowed in this organization",
-		},
-	}
-
-	for _, setting := range defaultSettings {
-		settingPublicID := uuid.New().String()
-		err := r.db.CreateOrganizationSetting(ctx, db.CreateOrganizationSettingParams{
-			PublicID:       settingPublicID,
-			OrganizationID: createdOrg.ID,
-			SettingKey:     setting.key,
-			SettingValue:   setting.value,
-			Editable:       sql.NullBool{Bool: setting.editable, Valid: true},
-			Description:    sql.NullString{String: setting.description, Valid: true},
-			Status:         db.NullOrganizationSettingsStatus{OrganizationSettingsStatus: db.OrganizationSettingsStatusActive, Valid: true},
-			CreatedBy:      sql.NullInt64{Int64: accountID, Valid: true},
-			UpdatedBy:      sql.NullInt64{Int64: accountID, Valid: true},
-		})
-		if err != nil {
-			// Log but don't fail org creation if setting creation fails
-			slog.Warn("Failed to create default organization setting", "setting", setting.key, "error", err)
-		}
-	}
-
 	return createdOrg.ID, nil
 }
 
-// UpdateOrganization updates a organization.
+// UpdateOrganization updates a organization at the version in params.
 func (r *Repository) UpdateOrganization(ctx context.Context, params db.UpdateOrganizationParams) error {
-	err := r.db.UpdateOrganization(ctx, params)
+	updated, err := r.db.UpdateOrganization(ctx, params)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	if updated == 0 {
+		return service.ETagMismatchError("organization")
+	}
 	return nil
 }
 
@@ -0,0 +1,506 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/statuspage"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// maxStatusPageSites caps how many sites a status page lists.
+	maxStatusPageSites = 50
+
+	// maxStatusPageUpdateMessage caps the length of an incident update's message.
+	maxStatusPageUpdateMessage = 5000
+)
+
+// StatusPageService implements the StatusPageService API.
+type StatusPageService struct {
+	db          db.Querier
+	domain      string
+	dashBaseURL string
+}
+
+// Compile-time check.
+var _ libopsv1connect.StatusPageServiceHandler = (*StatusPageService)(nil)
+
+// NewStatusPageService creates a new StatusPageService instance.
+// Pages are served under domain, or under the dashboard when domain is empty.
+func NewStatusPageService(querier db.Querier, domain, dashBaseURL string) *StatusPageService {
+	return &StatusPageService{
+		db:          querier,
+		domain:      domain,
+		dashBaseURL: dashBaseURL,
+	}
+}
+
+// GetStatusPage gets an organization's status page and the sites it lists.
+func (s *StatusPageService) GetStatusPage(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetStatusPageRequest],
+) (*connect.Response[libopsv1.GetStatusPageResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPage, err := s.statusPageToProto(ctx, page, organization.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetStatusPageResponse{StatusPage: protoPage}), nil
+}
+
+// UpdateStatusPage creates the organization's status page, or updates its slug, title and visibility.
+func (s *StatusPageService) UpdateStatusPage(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateStatusPageRequest],
+) (*connect.Response[libopsv1.UpdateStatusPageResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	slug := strings.ToLower(strings.TrimSpace(msg.Slug))
+	if err := statuspage.ValidateSlug(slug); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	title := strings.TrimSpace(msg.Title)
+	if err := validation.StringLength("title", title, 1, 255); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetStatusPageByOrganization(ctx, organization.ID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = s.db.CreateStatusPage(ctx, db.CreateStatusPageParams{
+			PublicID:       uuid.New().String(),
+			OrganizationID: organization.ID,
+			Slug:           slug,
+			Title:          title,
+			IsPublic:       msg.Public,
+		})
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get status page: %w", err))
+	default:
+		err = s.db.UpdateStatusPage(ctx, db.UpdateStatusPageParams{
+			Slug:     slug,
+			Title:    title,
+			IsPublic: msg.Public,
+			ID:       existing.ID,
+		})
+	}
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("slug %q is already taken", slug))
+		}
+		slog.Error("Failed to save status page", "error", err, "organization_id", organization.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPage, err := s.statusPageToProto(ctx, page, organization.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateStatusPageResponse{StatusPage: protoPage}), nil
+}
+
+// DeleteStatusPage deletes the organization's status page and its updates.
+func (s *StatusPageService) DeleteStatusPage(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteStatusPageRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteStatusPage(ctx, page.ID); err != nil {
+		slog.Error("Failed to delete status page", "error", err, "status_page_id", page.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// SetStatusPageSites replaces the sites listed on the status page.
+func (s *StatusPageService) SetStatusPageSites(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SetStatusPageSitesRequest],
+) (*connect.Response[libopsv1.SetStatusPageSitesResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(msg.Sites) > maxStatusPageSites {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a status page can list at most %d sites", maxStatusPageSites))
+	}
+	seen := make(map[string]bool, len(msg.Sites))
+	for _, site := range msg.Sites {
+		if err := validation.UUID(site.SiteId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id: %w", err))
+		}
+		if err := validation.StringLength("display_name", site.DisplayName, 0, 255); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if seen[site.SiteId] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("site %s is listed more than once", site.SiteId))
+		}
+		seen[site.SiteId] = true
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve every site before changing anything so a bad ID leaves the page as it was
+	siteIDs := make([]int64, len(msg.Sites))
+	for i, site := range msg.Sites {
+		row, err := s.db.GetOrganizationSite(ctx, db.GetOrganizationSiteParams{
+			PublicID:       site.SiteId,
+			OrganizationID: organization.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site %s not found in this organization", site.SiteId))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get site: %w", err))
+		}
+		siteIDs[i] = row.ID
+	}
+
+	if err := s.db.DeleteStatusPageSites(ctx, page.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to clear status page sites: %w", err))
+	}
+	for i, site := range msg.Sites {
+		err := s.db.AddStatusPageSite(ctx, db.AddStatusPageSiteParams{
+			StatusPageID: page.ID,
+			SiteID:       siteIDs[i],
+			DisplayName:  strings.TrimSpace(site.DisplayName),
+			Position:     int32(i),
+		})
+		if err != nil {
+			slog.Error("Failed to add status page site", "error", err, "status_page_id", page.PublicID, "site_id", site.SiteId)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add site: %w", err))
+		}
+	}
+
+	protoPage, err := s.statusPageToProto(ctx, page, organization.PublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.SetStatusPageSitesResponse{StatusPage: protoPage}), nil
+}
+
+// PostStatusPageUpdate posts an incident update to the status page.
+func (s *StatusPageService) PostStatusPageUpdate(
+	ctx context.Context,
+	req *connect.Request[libopsv1.PostStatusPageUpdateRequest],
+) (*connect.Response[libopsv1.PostStatusPageUpdateResponse], error) {
+	msg := req.Msg
+
+	if err := validation.UUID(msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	status, err := statusPageUpdateStatusToDB(msg.Status)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	title := strings.TrimSpace(msg.Title)
+	if err := validation.StringLength("title", title, 1, 255); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	message := strings.TrimSpace(msg.Message)
+	if err := validation.StringLength("message", message, 1, maxStatusPageUpdateMessage); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if msg.IncidentId != "" {
+		if err := validation.UUID(msg.IncidentId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid incident_id: %w", err))
+		}
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	incidentID := sql.NullInt64{}
+	if msg.IncidentId != "" {
+		incident, err := s.db.GetOrganizationSiteIncident(ctx, db.GetOrganizationSiteIncidentParams{
+			PublicID:       msg.IncidentId,
+			OrganizationID: organization.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("incident not found"))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get incident: %w", err))
+		}
+		incidentID = sql.NullInt64{Int64: incident.ID, Valid: true}
+	}
+
+	updateID := uuid.New().String()
+	err = s.db.CreateStatusPageUpdate(ctx, db.CreateStatusPageUpdateParams{
+		PublicID:       updateID,
+		StatusPageID:   page.ID,
+		SiteIncidentID: incidentID,
+		Status:         status,
+		Title:          title,
+		Message:        message,
+		CreatedBy:      sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		slog.Error("Failed to post status page update", "error", err, "status_page_id", page.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.PostStatusPageUpdateResponse{
+		Update: &libopsv1.StatusPageUpdate{
+			UpdateId:   updateID,
+			Status:     msg.Status,
+			Title:      title,
+			Message:    message,
+			IncidentId: msg.IncidentId,
+			CreatedAt:  time.Now().Unix(),
+		},
+	}), nil
+}
+
+// ListStatusPageUpdates lists the updates posted to the status page, newest first.
+func (s *StatusPageService) ListStatusPageUpdates(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListStatusPageUpdatesRequest],
+) (*connect.Response[libopsv1.ListStatusPageUpdatesResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListStatusPageUpdates(ctx, db.ListStatusPageUpdatesParams{
+		StatusPageID: page.ID,
+		Since:        sql.NullTime{Time: time.Unix(0, 0), Valid: true},
+		Limit:        pagination.Limit,
+		Offset:       pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list status page updates", "error", err, "status_page_id", page.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	updates := make([]*libopsv1.StatusPageUpdate, 0, len(rows))
+	for _, row := range rows {
+		updates = append(updates, StatusPageUpdateToProto(row))
+	}
+
+	return connect.NewResponse(&libopsv1.ListStatusPageUpdatesResponse{
+		Updates:       updates,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// DeleteStatusPageUpdate removes an update posted by mistake.
+func (s *StatusPageService) DeleteStatusPageUpdate(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteStatusPageUpdateRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.UUID(req.Msg.UpdateId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid update_id: %w", err))
+	}
+
+	organization, err := service.GetOrganizationByPublicID(ctx, s.db, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.getStatusPage(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteStatusPageUpdate(ctx, db.DeleteStatusPageUpdateParams{
+		PublicID:     req.Msg.UpdateId,
+		StatusPageID: page.ID,
+	})
+	if err != nil {
+		slog.Error("Failed to delete status page update", "error", err, "update_id", req.Msg.UpdateId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("status page update not found"))
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *StatusPageService) getStatusPage(ctx context.Context, organizationID int64) (db.GetStatusPageByOrganizationRow, error) {
+	page, err := s.db.GetStatusPageByOrganization(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return page, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization has no status page"))
+	}
+	if err != nil {
+		return page, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get status page: %w", err))
+	}
+	return page, nil
+}
+
+func (s *StatusPageService) statusPageToProto(ctx context.Context, page db.GetStatusPageByOrganizationRow, organizationPublicID string) (*libopsv1.StatusPage, error) {
+	rows, err := s.db.ListStatusPageSites(ctx, page.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list status page sites: %w", err))
+	}
+
+	sites := make([]*libopsv1.StatusPageSite, 0, len(rows))
+	for _, row := range rows {
+		sites = append(sites, &libopsv1.StatusPageSite{
+			SiteId:      row.SitePublicID,
+			SiteName:    row.SiteName,
+			DisplayName: row.DisplayName,
+		})
+	}
+
+	protoPage := &libopsv1.StatusPage{
+		StatusPageId:   page.PublicID,
+		OrganizationId: organizationPublicID,
+		Slug:           page.Slug,
+		Title:          page.Title,
+		Public:         page.IsPublic,
+		Url:            statuspage.URL(s.domain, s.dashBaseURL, page.Slug),
+		Sites:          sites,
+	}
+	if page.CreatedAt.Valid {
+		protoPage.CreatedAt = page.CreatedAt.Time.Unix()
+	}
+	if page.UpdatedAt.Valid {
+		protoPage.UpdatedAt = page.UpdatedAt.Time.Unix()
+	}
+	return protoPage, nil
+}
+
+// StatusPageUpdateToProto converts a status page update row to its API message.
+func StatusPageUpdateToProto(row db.ListStatusPageUpdatesRow) *libopsv1.StatusPageUpdate {
+	update := &libopsv1.StatusPageUpdate{
+		UpdateId:   row.PublicID,
+		Status:     statusPageUpdateStatusToProto(row.Status),
+		Title:      row.Title,
+		Message:    row.Message,
+		IncidentId: row.IncidentPublicID,
+	}
+	if row.CreatedAt.Valid {
+		update.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return update
+}
+
+func statusPageUpdateStatusToDB(status libopsv1.StatusPageUpdateStatus) (db.StatusPageUpdatesStatus, error) {
+	switch status {
+	case libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_INVESTIGATING:
+		return db.StatusPageUpdatesStatusInvestigating, nil
+	case libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_IDENTIFIED:
+		return db.StatusPageUpdatesStatusIdentified, nil
+	case libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_MONITORING:
+		return db.StatusPageUpdatesStatusMonitoring, nil
+	case libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_RESOLVED:
+		return db.StatusPageUpdatesStatusResolved, nil
+	default:
+		return "", fmt.Errorf("status is required")
+	}
+}
+
+func statusPageUpdateStatusToProto(status db.StatusPageUpdatesStatus) libopsv1.StatusPageUpdateStatus {
+	switch status {
+	case db.StatusPageUpdatesStatusInvestigating:
+		return libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_INVESTIGATING
+	case db.StatusPageUpdatesStatusIdentified:
+		return libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_IDENTIFIED
+	case db.StatusPageUpdatesStatusMonitoring:
+		return libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_MONITORING
+	case db.StatusPageUpdatesStatusResolved:
+		return libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_RESOLVED
+	default:
+		return libopsv1.StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED
+	}
+}
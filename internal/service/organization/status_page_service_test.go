@@ -0,0 +1,112 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func statusPageMock(orgID string) *testutils.MockQuerier {
+	return &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID, Name: "Acme"}, nil
+		},
+		GetStatusPageByOrganizationFunc: func(ctx context.Context, organizationID int64) (db.GetStatusPageByOrganizationRow, error) {
+			return db.GetStatusPageByOrganizationRow{ID: 3, PublicID: uuid.NewString(), OrganizationID: organizationID, Slug: "acme", Title: "Acme"}, nil
+		},
+	}
+}
+
+// TestUpdateStatusPageSlugTaken tests that a slug used by another organization is reported as taken.
+func TestUpdateStatusPageSlugTaken(t *testing.T) {
+	orgID := uuid.NewString()
+	mock := statusPageMock(orgID)
+	mock.GetStatusPageByOrganizationFunc = func(ctx context.Context, organizationID int64) (db.GetStatusPageByOrganizationRow, error) {
+		return db.GetStatusPageByOrganizationRow{}, sql.ErrNoRows
+	}
+	mock.CreateStatusPageFunc = func(ctx context.Context, arg db.CreateStatusPageParams) error {
+		return &mysql.MySQLError{Number: 1062}
+	}
+
+	svc := NewStatusPageService(mock, "libops.site", "https://dash.libops.io")
+	_, err := svc.UpdateStatusPage(context.Background(), connect.NewRequest(&libopsv1.UpdateStatusPageRequest{
+		OrganizationId: orgID,
+		Slug:           "Acme",
+		Title:          "Acme Library",
+		Public:         true,
+	}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+}
+
+// TestSetStatusPageSitesRejectsOtherOrganizations tests that a site outside the organization leaves the page unchanged.
+func TestSetStatusPageSitesRejectsOtherOrganizations(t *testing.T) {
+	orgID := uuid.NewString()
+	ownSite := uuid.NewString()
+	otherSite := uuid.NewString()
+
+	cleared := false
+	mock := statusPageMock(orgID)
+	mock.GetOrganizationSiteFunc = func(ctx context.Context, arg db.GetOrganizationSiteParams) (db.GetOrganizationSiteRow, error) {
+		if arg.PublicID == ownSite && arg.OrganizationID == 7 {
+			return db.GetOrganizationSiteRow{ID: 11, PublicID: ownSite, Name: "catalog"}, nil
+		}
+		return db.GetOrganizationSiteRow{}, sql.ErrNoRows
+	}
+	mock.DeleteStatusPageSitesFunc = func(ctx context.Context, statusPageID int64) error {
+		cleared = true
+		return nil
+	}
+
+	svc := NewStatusPageService(mock, "libops.site", "")
+	_, err := svc.SetStatusPageSites(context.Background(), connect.NewRequest(&libopsv1.SetStatusPageSitesRequest{
+		OrganizationId: orgID,
+		Sites: []*libopsv1.StatusPageSite{
+			{SiteId: ownSite},
+			{SiteId: otherSite},
+		},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	assert.False(t, cleared)
+}
+
+// TestSetStatusPageSites tests that sites are stored in the order given.
+func TestSetStatusPageSites(t *testing.T) {
+	orgID := uuid.NewString()
+	first := uuid.NewString()
+	second := uuid.NewString()
+	siteIDs := map[string]int64{first: 11, second: 12}
+
+	var added []db.AddStatusPageSiteParams
+	mock := statusPageMock(orgID)
+	mock.GetOrganizationSiteFunc = func(ctx context.Context, arg db.GetOrganizationSiteParams) (db.GetOrganizationSiteRow, error) {
+		return db.GetOrganizationSiteRow{ID: siteIDs[arg.PublicID], PublicID: arg.PublicID}, nil
+	}
+	mock.AddStatusPageSiteFunc = func(ctx context.Context, arg db.AddStatusPageSiteParams) error {
+		added = append(added, arg)
+		return nil
+	}
+
+	svc := NewStatusPageService(mock, "libops.site", "")
+	resp, err := svc.SetStatusPageSites(context.Background(), connect.NewRequest(&libopsv1.SetStatusPageSitesRequest{
+		OrganizationId: orgID,
+		Sites: []*libopsv1.StatusPageSite{
+			{SiteId: second, DisplayName: " Discovery "},
+			{SiteId: first},
+		},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []db.AddStatusPageSiteParams{
+		{StatusPageID: 3, SiteID: 12, DisplayName: "Discovery", Position: 0},
+		{StatusPageID: 3, SiteID: 11, DisplayName: "", Position: 1},
+	}, added)
+	assert.Equal(t, "https://status.acme.libops.site", resp.Msg.StatusPage.Url)
+}
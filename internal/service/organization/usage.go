@@ -0,0 +1,266 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// GetOrganizationUsage returns the organization's billable resources, metered
+// usage for the current billing period, and its live Stripe subscription items.
+func (s *OrganizationService) GetOrganizationUsage(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetOrganizationUsageRequest],
+) (*connect.Response[libopsv1.GetOrganizationUsageResponse], error) {
+	organizationID := req.Msg.OrganizationId
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &libopsv1.GetOrganizationUsageResponse{}
+
+	subscription, err := s.repo.db.GetStripeSubscriptionByOrganizationID(ctx, organization.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get subscription: %w", err))
+	}
+	hasSubscription := err == nil
+	periodStart, _ := billingPeriod(subscription, hasSubscription, time.Now().UTC())
+	if hasSubscription {
+		resp.Subscription = toProtoBillingSubscription(subscription)
+		failure, err := s.repo.db.GetOrganizationPaymentFailure(ctx, organization.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get payment status: %w", err))
+		}
+		if failure.PaymentFailedAt.Valid {
+			resp.Subscription.PaymentFailedAt = failure.PaymentFailedAt.Time.Unix()
+			resp.Subscription.PaymentFailedInvoiceId = failure.PaymentFailedInvoiceID.String
+		}
+		state, err := s.repo.db.GetOrganizationBillingState(ctx, organization.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get billing state: %w", err))
+		}
+		resp.Subscription.BillingState = string(state.BillingState)
+		if state.BillingStateChangedAt.Valid {
+			resp.Subscription.BillingStateChangedAt = state.BillingStateChangedAt.Time.Unix()
+			if deadline := s.gracePeriods().Deadline(state.BillingState, state.BillingStateChangedAt.Time); !deadline.IsZero() {
+				resp.Subscription.BillingStateDeadline = deadline.Unix()
+			}
+		}
+	}
+
+	projects, err := s.repo.db.ListOrganizationProjectUsage(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list project usage: %w", err))
+	}
+
+	egress, err := s.repo.db.SumOrganizationProjectUsage(ctx, db.SumOrganizationProjectUsageParams{
+		OrganizationID: organization.ID,
+		Metric:         billing.MetricEgressBytes,
+		UsageDate:      periodStart,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sum egress usage: %w", err))
+	}
+	egressByProject := make(map[int64]int64, len(egress))
+	for _, row := range egress {
+		egressByProject[row.ProjectID] = row.Total
+	}
+
+	backups, err := s.repo.db.LatestOrganizationProjectUsage(ctx, db.LatestOrganizationProjectUsageParams{
+		OrganizationID: organization.ID,
+		Metric:         billing.MetricBackupStorageBytes,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get backup storage usage: %w", err))
+	}
+	backupsByProject := make(map[int64]int64, len(backups))
+	for _, row := range backups {
+		backupsByProject[row.ProjectID] = row.Quantity
+	}
+
+	disks, err := s.repo.db.LatestOrganizationProjectUsage(ctx, db.LatestOrganizationProjectUsageParams{
+		OrganizationID: organization.ID,
+		Metric:         billing.MetricDiskUsedBytes,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get disk usage: %w", err))
+	}
+	disksByProject := make(map[int64]int64, len(disks))
+	for _, row := range disks {
+		disksByProject[row.ProjectID] = row.Quantity
+	}
+
+	for _, p := range projects {
+		usage := &commonv1.ProjectUsage{
+			ProjectId:                p.PublicID,
+			ProjectName:              p.Name,
+			MachineType:              service.FromNullString(p.MachineType),
+			Vcpu:                     service.FromNullInt32(p.Vcpu),
+			MemoryGib:                service.FromNullInt32(p.MemoryGib),
+			DiskSizeGb:               service.FromNullInt32(p.DiskSizeGb),
+			MachineMonthlyPriceCents: int64(service.FromNullInt32(p.MonthlyPriceCents)),
+			BackupStorageBytes:       backupsByProject[p.ID],
+			EgressBytes:              egressByProject[p.ID],
+			DiskUsedBytes:            disksByProject[p.ID],
+		}
+		resp.Projects = append(resp.Projects, usage)
+		resp.TotalDiskGb += int64(usage.DiskSizeGb)
+		resp.BackupStorageBytes += usage.BackupStorageBytes
+		resp.EgressBytes += usage.EgressBytes
+		resp.DiskUsedBytes += usage.DiskUsedBytes
+	}
+
+	if hasSubscription {
+		items, err := s.billingManager.ListSubscriptionItems(ctx, organization.ID)
+		if err != nil {
+			slog.Error("Failed to list Stripe subscription items", "error", err, "organization_id", organizationID)
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("billing provider unavailable"))
+		}
+		for _, item := range items {
+			resp.Items = append(resp.Items, &commonv1.SubscriptionItem{
+				ItemId:      item.ItemID,
+				PriceId:     item.PriceID,
+				Description: item.Description,
+				Quantity:    item.Quantity,
+				UnitAmount:  item.UnitAmount,
+				Currency:    item.Currency,
+				Interval:    item.Interval,
+				ProjectName: item.ProjectName,
+			})
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// PreviewUsage prices the organization's metered usage for the current billing
+// period so customers can see usage-based charges before they are invoiced.
+func (s *OrganizationService) PreviewUsage(
+	ctx context.Context,
+	req *connect.Request[libopsv1.PreviewUsageRequest],
+) (*connect.Response[libopsv1.PreviewUsageResponse], error) {
+	organizationID := req.Msg.OrganizationId
+	if err := validation.UUID(organizationID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	publicID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
+	}
+
+	organization, err := s.repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.repo.db.GetStripeSubscriptionByOrganizationID(ctx, organization.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get subscription: %w", err))
+	}
+	now := time.Now().UTC()
+	periodStart, periodEnd := billingPeriod(subscription, err == nil, now)
+
+	prices, err := s.repo.db.ListMeteredPrices(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list metered prices: %w", err))
+	}
+
+	resp := &libopsv1.PreviewUsageResponse{
+		PeriodStart: periodStart.Unix(),
+		PeriodEnd:   periodEnd.Unix(),
+	}
+	for _, price := range prices {
+		daily, err := s.repo.db.ListOrganizationDailyUsage(ctx, db.ListOrganizationDailyUsageParams{
+			OrganizationID: organization.ID,
+			Metric:         price.Metric,
+			UsageDate:      periodStart,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list %s usage: %w", price.Metric, err))
+		}
+
+		estimate := billing.EstimateUsage(price, daily, periodStart, periodEnd, now)
+		resp.Usage = append(resp.Usage, &commonv1.MeteredUsage{
+			Metric:               price.Metric,
+			Quantity:             estimate.Quantity,
+			Units:                estimate.Units,
+			UnitBytes:            price.UnitBytes,
+			IncludedUnits:        price.IncludedUnits,
+			UnitPriceCents:       int64(price.UnitPriceCents),
+			EstimatedAmountCents: estimate.EstimatedCents,
+			ProjectedUnits:       estimate.ProjectedUnits,
+			ProjectedAmountCents: estimate.ProjectedCents,
+		})
+		resp.EstimatedAmountCents += estimate.EstimatedCents
+		resp.ProjectedAmountCents += estimate.ProjectedCents
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// billingPeriod returns the subscription's current billing period, falling back
+// to the calendar month when there is no subscription or Stripe has not reported one.
+func billingPeriod(subscription db.GetStripeSubscriptionByOrganizationIDRow, hasSubscription bool, now time.Time) (time.Time, time.Time) {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	if hasSubscription && subscription.CurrentPeriodStart.Valid {
+		start = subscription.CurrentPeriodStart.Time.UTC()
+		end = start.AddDate(0, 1, 0)
+		if subscription.CurrentPeriodEnd.Valid {
+			end = subscription.CurrentPeriodEnd.Time.UTC()
+		}
+	}
+	return start, end
+}
+
+func toProtoBillingSubscription(subscription db.GetStripeSubscriptionByOrganizationIDRow) *commonv1.BillingSubscription {
+	result := &commonv1.BillingSubscription{
+		Status:            string(subscription.Status),
+		CancelAtPeriodEnd: subscription.CancelAtPeriodEnd.Bool,
+	}
+	if subscription.CurrentPeriodStart.Valid {
+		result.CurrentPeriodStart = subscription.CurrentPeriodStart.Time.Unix()
+	}
+	if subscription.CurrentPeriodEnd.Valid {
+		result.CurrentPeriodEnd = subscription.CurrentPeriodEnd.Time.Unix()
+	}
+	if subscription.TrialEnd.Valid {
+		result.TrialEnd = subscription.TrialEnd.Time.Unix()
+	}
+	return result
+}
+
+// gracePeriods returns the configured dunning grace periods
+func (s *OrganizationService) gracePeriods() billing.GracePeriods {
+	if s.config == nil {
+		return billing.GracePeriods{}
+	}
+	return billing.GracePeriods{
+		PastDue:   s.config.BillingPastDueGracePeriod,
+		Suspended: s.config.BillingSuspendedGracePeriod,
+	}
+}
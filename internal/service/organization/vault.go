@@ -0,0 +1,76 @@
+package organization
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/vault"
+)
+
+// vaultOrchestrator sets up an organization's Vault as the organization is
+// created or activated, and tears it down as it's deleted. A new
+// organization's Vault usually isn't running yet, so failures are logged
+// rather than failing the request; the Vault is provisioned again when the
+// organization is activated and when it's first opened.
+type vaultOrchestrator struct {
+	provision   func(ctx context.Context, organizationID int64) error
+	open        func(ctx context.Context, organizationID int64) (*vault.Client, error)
+	deprovision func(ctx context.Context, client *vault.Client, organizationID int64, organizationPublicID string) error
+}
+
+func newVaultOrchestrator(querier db.Querier) *vaultOrchestrator {
+	return &vaultOrchestrator{
+		provision: func(ctx context.Context, organizationID int64) error {
+			return service.ProvisionOrganizationVault(ctx, querier, organizationID)
+		},
+		open: func(ctx context.Context, organizationID int64) (*vault.Client, error) {
+			return service.OpenOrganizationVault(ctx, querier, organizationID)
+		},
+		deprovision: service.DeprovisionOrganizationVault,
+	}
+}
+
+// provisionOrganization provisions an organization's Vault once the change
+// that created or activated it commits, reading it back from the primary.
+func (o *vaultOrchestrator) provisionOrganization(ctx context.Context, organizationID int64, organizationPublicID string) {
+	store.AfterCommit(ctx, func(ctx context.Context) {
+		if err := o.provision(database.WithPrimary(ctx), organizationID); err != nil {
+			slog.Warn("Organization vault not provisioned", "organization_id", organizationPublicID, "error", err)
+			return
+		}
+		slog.Info("Provisioned organization vault", "organization_id", organizationPublicID)
+	})
+}
+
+// deleteOrganization deletes an organization, then tears down its Vault once
+// the deletion commits. The Vault is found first, while the organization's
+// project is still recorded.
+func (o *vaultOrchestrator) deleteOrganization(ctx context.Context, repo *Repository, publicID uuid.UUID) error {
+	organization, err := repo.GetOrganizationByPublicID(ctx, publicID)
+	if err != nil {
+		return err
+	}
+	client, err := o.open(ctx, organization.ID)
+	if err != nil {
+		slog.Info("No organization vault to tear down", "organization_id", publicID.String(), "error", err)
+	}
+
+	if err := repo.DeleteOrganization(ctx, publicID); err != nil {
+		return err
+	}
+
+	if client != nil {
+		store.AfterCommit(ctx, func(ctx context.Context) {
+			if err := o.deprovision(ctx, client, organization.ID, publicID.String()); err != nil {
+				slog.Warn("Failed to tear down organization vault", "organization_id", publicID.String(), "error", err)
+			}
+		})
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	"github.com/libops/api/internal/vault"
+)
+
+// fakeVaultOrchestrator records what's provisioned and torn down.
+func fakeVaultOrchestrator(t *testing.T, provisionErr, openErr error) (*vaultOrchestrator, *[]string) {
+	t.Helper()
+	client, err := vault.NewClientFromAddr("http://vault.invalid")
+	require.NoError(t, err)
+
+	var calls []string
+	return &vaultOrchestrator{
+		provision: func(ctx context.Context, organizationID int64) error {
+			calls = append(calls, fmt.Sprintf("provision %d", organizationID))
+			return provisionErr
+		},
+		open: func(ctx context.Context, organizationID int64) (*vault.Client, error) {
+			if openErr != nil {
+				return nil, openErr
+			}
+			return client, nil
+		},
+		deprovision: func(ctx context.Context, c *vault.Client, organizationID int64, organizationPublicID string) error {
+			calls = append(calls, fmt.Sprintf("deprovision %d %s", organizationID, organizationPublicID))
+			return nil
+		},
+	}, &calls
+}
+
+func TestVaultOrchestratorProvision(t *testing.T) {
+	orchestrator, calls := fakeVaultOrchestrator(t, fmt.Errorf("vault not running yet"), nil)
+
+	// Failures are logged, not returned
+	orchestrator.provisionOrganization(context.Background(), 7, uuid.NewString())
+	assert.Equal(t, []string{"provision 7"}, *calls)
+}
+
+func TestVaultOrchestratorDeleteOrganization(t *testing.T) {
+	ctx := context.Background()
+	publicID := uuid.New()
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, id string) (db.GetOrganizationRow, error) {
+			if id != publicID.String() {
+				return db.GetOrganizationRow{}, sql.ErrNoRows
+			}
+			return db.GetOrganizationRow{ID: 7, PublicID: id}, nil
+		},
+	}
+	repo := NewRepository(mock)
+
+	t.Run("tears down the vault", func(t *testing.T) {
+		orchestrator, calls := fakeVaultOrchestrator(t, nil, nil)
+		require.NoError(t, orchestrator.deleteOrganization(ctx, repo, publicID))
+		assert.Equal(t, []string{"deprovision 7 " + publicID.String()}, *calls)
+	})
+
+	t.Run("deletes without a vault", func(t *testing.T) {
+		orchestrator, calls := fakeVaultOrchestrator(t, nil, sql.ErrNoRows)
+		require.NoError(t, orchestrator.deleteOrganization(ctx, repo, publicID))
+		assert.Empty(t, *calls)
+	})
+
+	t.Run("unknown organization", func(t *testing.T) {
+		orchestrator, calls := fakeVaultOrchestrator(t, nil, nil)
+		err := orchestrator.deleteOrganization(ctx, repo, uuid.New())
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+		assert.Empty(t, *calls)
+	})
+}
@@ -0,0 +1,94 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// ExplainAccess reports every check a request from the account would go
+// through and which one decided it, without making the request.
+func (s *AdminService) ExplainAccess(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ExplainAccessRequest],
+) (*connect.Response[libopsv1.ExplainAccessResponse], error) {
+	authorizer, err := auth.GetAuthorizer(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	principal, err := s.accessPrincipal(ctx, strings.TrimSpace(req.Msg.Account))
+	if err != nil {
+		return nil, err
+	}
+	resourceID, err := uuid.Parse(req.Msg.ResourceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource_id must be a UUID"))
+	}
+	if req.Msg.AccessLevel == optionsv1.AccessLevel_ACCESS_LEVEL_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("access_level is required"))
+	}
+	question := auth.AccessQuestion{
+		Principal:  principal,
+		Resource:   req.Msg.ResourceType,
+		ResourceID: resourceID,
+		Level:      req.Msg.AccessLevel,
+		APIKeyID:   strings.TrimSpace(req.Msg.ApiKeyId),
+	}
+	if req.Msg.SourceIp != "" {
+		if question.SourceIP, err = netip.ParseAddr(strings.TrimSpace(req.Msg.SourceIp)); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("source_ip must be an IP address"))
+		}
+	}
+
+	explanation, err := authorizer.ExplainAccess(ctx, question)
+	if err != nil {
+		slog.Error("Failed to explain access", "error", err, "account", req.Msg.Account, "resource_id", req.Msg.ResourceId)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resp := &libopsv1.ExplainAccessResponse{
+		Allowed:            explanation.Allowed,
+		Steps:              make([]*libopsv1.AccessCheckStep, 0, len(explanation.Steps)),
+		PermittingPolicies: explanation.Policies,
+		Reason:             explanation.Reason,
+	}
+	for _, step := range explanation.Steps {
+		resp.Steps = append(resp.Steps, &libopsv1.AccessCheckStep{
+			Check:   step.Check,
+			Outcome: step.Outcome,
+			Detail:  step.Detail,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// accessPrincipal looks up the account an explanation is for, by public ID or
+// email.
+func (s *AdminService) accessPrincipal(ctx context.Context, account string) (*auth.UserInfo, error) {
+	if account == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account is required"))
+	}
+	if _, err := uuid.Parse(account); err == nil {
+		row, err := s.db.GetAccount(ctx, account)
+		if err != nil {
+			return nil, service.HandleDatabaseError(err, "account")
+		}
+		return &auth.UserInfo{AccountID: row.ID, Email: row.Email}, nil
+	}
+	row, err := s.db.GetAccountByEmail(ctx, account)
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "account")
+	}
+	return &auth.UserInfo{AccountID: row.ID, Email: row.Email}, nil
+}
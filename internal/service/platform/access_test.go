@@ -0,0 +1,70 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	optionsv1 "github.com/libops/api/proto/libops/v1/options"
+)
+
+// TestExplainAccess tests that an account can be named by email and that the
+// explanation reports the decision path.
+func TestExplainAccess(t *testing.T) {
+	orgID := uuid.NewString()
+	mock := &testutils.MockQuerier{
+		GetAccountByEmailFunc: func(ctx context.Context, email string) (db.GetAccountByEmailRow, error) {
+			if email != "jerry@example.edu" {
+				return db.GetAccountByEmailRow{}, sql.ErrNoRows
+			}
+			return db.GetAccountByEmailRow{ID: 10, Email: email}, nil
+		},
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 1, PublicID: publicID}, nil
+		},
+		GetOrganizationMemberFunc: func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error) {
+			return db.GetOrganizationMemberRow{Role: db.OrganizationMembersRoleRead}, nil
+		},
+	}
+	svc := NewAdminService(mock, nil, audit.New(mock))
+	ctx := auth.WithAuthorizer(operatorContext(), auth.NewAuthorizer(mock))
+
+	explain := func(account string, level optionsv1.AccessLevel) (*libopsv1.ExplainAccessResponse, error) {
+		resp, err := svc.ExplainAccess(ctx, connect.NewRequest(&libopsv1.ExplainAccessRequest{
+			Account:      account,
+			ResourceType: optionsv1.ResourceType_RESOURCE_TYPE_ORGANIZATION,
+			ResourceId:   orgID,
+			AccessLevel:  level,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	resp, err := explain("jerry@example.edu", optionsv1.AccessLevel_ACCESS_LEVEL_READ)
+	require.NoError(t, err)
+	assert.True(t, resp.Allowed)
+	assert.Equal(t, []string{"viewer_role"}, resp.PermittingPolicies)
+
+	resp, err = explain("jerry@example.edu", optionsv1.AccessLevel_ACCESS_LEVEL_WRITE)
+	require.NoError(t, err)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, "access denied", resp.Reason)
+	assert.Equal(t, "denied", resp.Steps[len(resp.Steps)-1].Outcome)
+
+	_, err = explain("newman@example.edu", optionsv1.AccessLevel_ACCESS_LEVEL_READ)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = explain("jerry@example.edu", optionsv1.AccessLevel_ACCESS_LEVEL_UNSPECIFIED)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
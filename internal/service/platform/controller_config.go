@@ -0,0 +1,166 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/controllerconfig"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// GetControllerConfig returns the fleet-wide controller config, or a site's
+// override along with what its controller applies.
+func (s *AdminService) GetControllerConfig(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetControllerConfigRequest],
+) (*connect.Response[libopsv1.GetControllerConfigResponse], error) {
+	siteID, err := s.controllerConfigScope(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListControllerConfigs(ctx, siteID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resp := &libopsv1.GetControllerConfigResponse{Config: &libopsv1.ControllerConfig{}}
+	for _, row := range rows {
+		if row.SiteID != siteID {
+			continue
+		}
+		config, err := controllerconfig.FromRow(row)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		resp.Config = config
+	}
+
+	if siteID.Valid {
+		resp.Effective, err = controllerconfig.Effective(ctx, s.db, siteID.Int64)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateControllerConfig replaces the fleet-wide controller config or a site's
+// override. Controllers pick it up on their next check-in.
+func (s *AdminService) UpdateControllerConfig(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateControllerConfigRequest],
+) (*connect.Response[libopsv1.UpdateControllerConfigResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	config := req.Msg.Config
+	if config == nil {
+		config = &libopsv1.ControllerConfig{}
+	}
+	// The version identifies merged documents, so it isn't stored
+	config.Version = ""
+	if err := controllerconfig.Validate(config); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Controllers can only update to a published release
+	if config.ControllerVersion != nil {
+		_, err := s.db.GetControllerRelease(ctx, config.GetControllerVersion())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("controller release %s is not published", config.GetControllerVersion()))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	siteID, err := s.controllerConfigScope(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := controllerconfig.UpsertParams(siteID, config, userInfo.AccountID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.db.UpsertControllerConfig(ctx, params); err != nil {
+		slog.Error("Failed to update controller config", "error", err, "site_id", req.Msg.SiteId)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	entityID, entityType := controllerConfigAuditEntity(siteID, userInfo.AccountID)
+	s.auditLogger.Log(ctx, userInfo.AccountID, entityID, entityType, audit.ControllerConfigUpdate, map[string]any{
+		"site_id": req.Msg.SiteId,
+		"config":  protojson.Format(config),
+	})
+
+	return connect.NewResponse(&libopsv1.UpdateControllerConfigResponse{Config: config}), nil
+}
+
+// DeleteControllerConfig removes a site's override, so its controller follows
+// the fleet-wide config again.
+func (s *AdminService) DeleteControllerConfig(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteControllerConfigRequest],
+) (*connect.Response[libopsv1.DeleteControllerConfigResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if req.Msg.SiteId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("site_id is required"))
+	}
+
+	siteID, err := s.controllerConfigScope(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteSiteControllerConfig(ctx, siteID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site has no controller config override"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, siteID.Int64, audit.SiteEntityType, audit.ControllerConfigDelete, nil)
+
+	return connect.NewResponse(&libopsv1.DeleteControllerConfigResponse{}), nil
+}
+
+// controllerConfigScope resolves a request's site to the scope its controller
+// config is stored under: invalid for the fleet, or the site's ID.
+func (s *AdminService) controllerConfigScope(ctx context.Context, sitePublicID string) (sql.NullInt64, error) {
+	if sitePublicID == "" {
+		return sql.NullInt64{}, nil
+	}
+	if err := validation.UUID(sitePublicID); err != nil {
+		return sql.NullInt64{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := service.GetSiteByPublicID(ctx, s.db, sitePublicID)
+	if err != nil {
+		return sql.NullInt64{}, err
+	}
+	return sql.NullInt64{Int64: site.ID, Valid: true}, nil
+}
+
+// controllerConfigAuditEntity is what a controller config change is audited
+// against: the site for an override, or the operator for the fleet's config.
+func controllerConfigAuditEntity(siteID sql.NullInt64, accountID int64) (int64, audit.EntityType) {
+	if siteID.Valid {
+		return siteID.Int64, audit.SiteEntityType
+	}
+	return accountID, audit.AccountEntityType
+}
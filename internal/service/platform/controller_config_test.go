@@ -0,0 +1,114 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestControllerConfig tests that the fleet's config and a site's override are
+// stored separately, validated, and merged into what the site's controller applies.
+func TestControllerConfig(t *testing.T) {
+	siteID := uuid.NewString()
+	stored := map[int64]db.ListControllerConfigsRow{} // By site ID, 0 for the fleet
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 9, PublicID: publicID}, nil
+		},
+		UpsertControllerConfigFunc: func(ctx context.Context, arg db.UpsertControllerConfigParams) error {
+			stored[arg.SiteID.Int64] = db.ListControllerConfigsRow{
+				SiteID:                   arg.SiteID,
+				RateLimitRps:             arg.RateLimitRps,
+				RateLimitBurst:           arg.RateLimitBurst,
+				ReconcileIntervalMinutes: arg.ReconcileIntervalMinutes,
+				CheckinIntervalSeconds:   arg.CheckinIntervalSeconds,
+				FeatureFlags:             json.RawMessage(arg.FeatureFlags),
+			}
+			return nil
+		},
+		ListControllerConfigsFunc: func(ctx context.Context, siteID sql.NullInt64) ([]db.ListControllerConfigsRow, error) {
+			var rows []db.ListControllerConfigsRow
+			if row, ok := stored[0]; ok {
+				rows = append(rows, row)
+			}
+			if row, ok := stored[siteID.Int64]; ok && siteID.Valid {
+				rows = append(rows, row)
+			}
+			return rows, nil
+		},
+		DeleteSiteControllerConfigFunc: func(ctx context.Context, siteID sql.NullInt64) (int64, error) {
+			if _, ok := stored[siteID.Int64]; !ok {
+				return 0, nil
+			}
+			delete(stored, siteID.Int64)
+			return 1, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+
+	_, err := svc.UpdateControllerConfig(ctx, connect.NewRequest(&libopsv1.UpdateControllerConfigRequest{
+		Config: &libopsv1.ControllerConfig{CheckinIntervalSeconds: proto.Int32(1)},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.UpdateControllerConfig(ctx, connect.NewRequest(&libopsv1.UpdateControllerConfigRequest{
+		Config: &libopsv1.ControllerConfig{
+			RateLimitRps:             proto.Int32(10),
+			ReconcileIntervalMinutes: proto.Int32(360),
+		},
+	}))
+	require.NoError(t, err)
+	_, err = svc.UpdateControllerConfig(ctx, connect.NewRequest(&libopsv1.UpdateControllerConfigRequest{
+		SiteId: siteID,
+		Config: &libopsv1.ControllerConfig{
+			RateLimitRps: proto.Int32(25),
+			FeatureFlags: map[string]bool{"nftables": true},
+		},
+	}))
+	require.NoError(t, err)
+
+	fleet, err := svc.GetControllerConfig(ctx, connect.NewRequest(&libopsv1.GetControllerConfigRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), fleet.Msg.Config.GetRateLimitRps())
+	assert.Nil(t, fleet.Msg.Effective, "the fleet has no effective config of its own")
+
+	site, err := svc.GetControllerConfig(ctx, connect.NewRequest(&libopsv1.GetControllerConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Nil(t, site.Msg.Config.ReconcileIntervalMinutes, "the override only stores what it sets")
+	assert.Equal(t, int32(25), site.Msg.Effective.GetRateLimitRps())
+	assert.Equal(t, int32(360), site.Msg.Effective.GetReconcileIntervalMinutes())
+	assert.True(t, site.Msg.Effective.FeatureFlags["nftables"])
+	assert.NotEmpty(t, site.Msg.Effective.Version)
+
+	_, err = svc.DeleteControllerConfig(ctx, connect.NewRequest(&libopsv1.DeleteControllerConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	_, err = svc.DeleteControllerConfig(ctx, connect.NewRequest(&libopsv1.DeleteControllerConfigRequest{SiteId: siteID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = svc.DeleteControllerConfig(ctx, connect.NewRequest(&libopsv1.DeleteControllerConfigRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "the fleet's config can't be deleted")
+
+	assert.Equal(t, []string{
+		string(audit.ControllerConfigUpdate),
+		string(audit.ControllerConfigUpdate),
+		string(audit.ControllerConfigDelete),
+	}, audited)
+}
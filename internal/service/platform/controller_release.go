@@ -0,0 +1,87 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/controllerconfig"
+	"github.com/libops/api/internal/service"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// Page sizes for listing controller releases
+const (
+	defaultControllerReleasePageSize = 50
+	maxControllerReleasePageSize     = 200
+)
+
+// ListControllerReleases returns published controller releases, newest first,
+// with how many sites run each.
+func (s *AdminService) ListControllerReleases(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListControllerReleasesRequest],
+) (*connect.Response[libopsv1.ListControllerReleasesResponse], error) {
+	pageSize := req.Msg.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultControllerReleasePageSize
+	}
+	pageSize = min(pageSize, maxControllerReleasePageSize)
+
+	rows, err := s.db.ListControllerReleases(ctx, pageSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListControllerReleasesResponse{}
+	for _, row := range rows {
+		resp.Releases = append(resp.Releases, controllerconfig.ReleaseFromRow(row))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CreateControllerRelease publishes a signed controller release. Nothing
+// installs it until a controller config names its version.
+func (s *AdminService) CreateControllerRelease(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateControllerReleaseRequest],
+) (*connect.Response[libopsv1.CreateControllerReleaseResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	release := req.Msg.Release
+	if err := controllerconfig.ValidateRelease(release); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err := s.db.CreateControllerRelease(ctx, db.CreateControllerReleaseParams{
+		Version:     release.Version,
+		DownloadUrl: release.DownloadUrl,
+		Sha256:      release.Sha256,
+		Signature:   release.Signature,
+		Notes:       sql.NullString{String: release.Notes, Valid: release.Notes != ""},
+		CreatedBy:   sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		slog.Error("Failed to create controller release", "error", err, "version", release.Version)
+		return nil, service.HandleDatabaseError(err, "controller release")
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, userInfo.AccountID, audit.AccountEntityType, audit.ControllerReleaseCreate, map[string]any{
+		"version": release.Version,
+		"sha256":  release.Sha256,
+	})
+
+	created, err := controllerconfig.Release(ctx, s.db, &libopsv1.ControllerConfig{ControllerVersion: &release.Version})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&libopsv1.CreateControllerReleaseResponse{Release: created}), nil
+}
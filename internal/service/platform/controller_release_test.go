@@ -0,0 +1,89 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestControllerRelease tests that releases are validated before they're
+// published and that a controller config can only name a published release.
+func TestControllerRelease(t *testing.T) {
+	releases := map[string]db.CreateControllerReleaseParams{}
+	var audited []string
+	mock := &testutils.MockQuerier{
+		CreateControllerReleaseFunc: func(ctx context.Context, arg db.CreateControllerReleaseParams) error {
+			releases[arg.Version] = arg
+			return nil
+		},
+		GetControllerReleaseFunc: func(ctx context.Context, version string) (db.GetControllerReleaseRow, error) {
+			r, ok := releases[version]
+			if !ok {
+				return db.GetControllerReleaseRow{}, sql.ErrNoRows
+			}
+			return db.GetControllerReleaseRow{Version: r.Version, DownloadUrl: r.DownloadUrl, Sha256: r.Sha256, Signature: r.Signature}, nil
+		},
+		ListControllerReleasesFunc: func(ctx context.Context, limit int32) ([]db.ListControllerReleasesRow, error) {
+			assert.Equal(t, int32(defaultControllerReleasePageSize), limit)
+			var rows []db.ListControllerReleasesRow
+			for _, r := range releases {
+				rows = append(rows, db.ListControllerReleasesRow{Version: r.Version, SiteCount: 3})
+			}
+			return rows, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+
+	release := &libopsv1.ControllerRelease{
+		Version:     "v1.4.0",
+		DownloadUrl: "https://releases.libops.io/controller/v1.4.0/libops-controller",
+		Sha256:      strings.Repeat("ab", 32),
+		Signature:   base64.StdEncoding.EncodeToString(make([]byte, 64)),
+	}
+	unsigned := proto.Clone(release).(*libopsv1.ControllerRelease)
+	unsigned.Signature = ""
+	_, err := svc.CreateControllerRelease(ctx, connect.NewRequest(&libopsv1.CreateControllerReleaseRequest{Release: unsigned}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.UpdateControllerConfig(ctx, connect.NewRequest(&libopsv1.UpdateControllerConfigRequest{
+		Config: &libopsv1.ControllerConfig{ControllerVersion: proto.String("v1.4.0")},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "the release isn't published yet")
+
+	created, err := svc.CreateControllerRelease(ctx, connect.NewRequest(&libopsv1.CreateControllerReleaseRequest{Release: release}))
+	require.NoError(t, err)
+	assert.Equal(t, release.Sha256, created.Msg.Release.Sha256)
+
+	_, err = svc.UpdateControllerConfig(ctx, connect.NewRequest(&libopsv1.UpdateControllerConfigRequest{
+		Config: &libopsv1.ControllerConfig{ControllerVersion: proto.String("v1.4.0")},
+	}))
+	require.NoError(t, err)
+
+	listed, err := svc.ListControllerReleases(ctx, connect.NewRequest(&libopsv1.ListControllerReleasesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Releases, 1)
+	assert.Equal(t, int64(3), listed.Msg.Releases[0].SiteCount)
+
+	assert.Equal(t, []string{
+		string(audit.ControllerReleaseCreate),
+		string(audit.ControllerConfigUpdate),
+	}, audited)
+}
@@ -0,0 +1,117 @@
+package platform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/fleet"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// fleetExportLimit caps the VMs in a fleet export
+const fleetExportLimit = 5000
+
+// FleetService implements the FleetService API.
+type FleetService struct {
+	db  db.Querier
+	now func() time.Time
+}
+
+// Compile-time check.
+var _ libopsv1connect.FleetServiceHandler = (*FleetService)(nil)
+
+// NewFleetService creates a new FleetService instance.
+func NewFleetService(querier db.Querier) *FleetService {
+	return &FleetService{db: querier, now: time.Now}
+}
+
+// ListFleetSites lists site VMs across the platform, stalest check-in first.
+func (s *FleetService) ListFleetSites(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListFleetSitesRequest],
+) (*connect.Response[libopsv1.ListFleetSitesResponse], error) {
+	sites, nextPageToken, err := s.list(ctx, req.Msg.Filter, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ListFleetSitesResponse{Sites: sites, NextPageToken: nextPageToken}), nil
+}
+
+// ListOrganizationFleetSites lists an organization's site VMs, stalest check-in first.
+func (s *FleetService) ListOrganizationFleetSites(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListOrganizationFleetSitesRequest],
+) (*connect.Response[libopsv1.ListFleetSitesResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	filter := &libopsv1.FleetFilter{}
+	if req.Msg.Filter != nil {
+		filter = req.Msg.Filter
+	}
+	filter.OrganizationId = req.Msg.OrganizationId
+
+	sites, nextPageToken, err := s.list(ctx, filter, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ListFleetSitesResponse{Sites: sites, NextPageToken: nextPageToken}), nil
+}
+
+// ExportFleetSites exports the site VMs matching a filter as CSV.
+func (s *FleetService) ExportFleetSites(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ExportFleetSitesRequest],
+) (*connect.Response[libopsv1.ExportFleetSitesResponse], error) {
+	params, err := fleet.Params(req.Msg.Filter, s.now(), fleetExportLimit, 0)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rows, err := s.db.ListFleetSites(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	sites := make([]*libopsv1.FleetSite, 0, len(rows))
+	for _, row := range rows {
+		sites = append(sites, fleet.ToProto(row))
+	}
+	var buf bytes.Buffer
+	if err := fleet.WriteCSV(&buf, sites); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write export: %w", err))
+	}
+	return connect.NewResponse(&libopsv1.ExportFleetSitesResponse{
+		Filename: fmt.Sprintf("fleet-%s.csv", s.now().UTC().Format("2006-01-02")),
+		Csv:      buf.Bytes(),
+	}), nil
+}
+
+// list returns a page of the VMs matching a filter
+func (s *FleetService) list(ctx context.Context, filter *libopsv1.FleetFilter, pageSize int32, pageToken string) ([]*libopsv1.FleetSite, string, error) {
+	pagination, err := service.ParsePagination(pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	params, err := fleet.Params(filter, s.now(), pagination.Limit, pagination.Offset)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rows, err := s.db.ListFleetSites(ctx, params)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	sites := make([]*libopsv1.FleetSite, 0, len(rows))
+	for _, row := range rows {
+		sites = append(sites, fleet.ToProto(row))
+	}
+	return sites, service.MakePaginationResult(len(rows), pagination).NextPageToken, nil
+}
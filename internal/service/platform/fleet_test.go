@@ -0,0 +1,102 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestFleetService tests that fleet filters become query parameters, that the
+// organization view can't be widened by its filter and that exports are CSV.
+func TestFleetService(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	var params db.ListFleetSitesParams
+	mock := &testutils.MockQuerier{
+		ListFleetSitesFunc: func(ctx context.Context, arg db.ListFleetSitesParams) ([]db.ListFleetSitesRow, error) {
+			params = arg
+			return []db.ListFleetSitesRow{{
+				PublicID:               "site-1",
+				Name:                   "=cmd",
+				Status:                 db.NullSitesStatus{SitesStatus: db.SitesStatusActive, Valid: true},
+				CheckinAt:              sql.NullTime{Time: now.Add(-2 * time.Hour), Valid: true},
+				ControllerVersion:      sql.NullString{String: "v1.4.0", Valid: true},
+				Os:                     sql.NullString{String: "cos-125-19216-104-74", Valid: true},
+				OsVersion:              sql.NullString{String: "cos-121-18867-90-38", Valid: true},
+				OsPatchStatus:          "outdated",
+				DiskUsedBytes:          sql.NullInt64{Int64: 15 << 30, Valid: true},
+				DiskSizeGb:             sql.NullInt32{Int32: 20, Valid: true},
+				PendingReconciliations: 2,
+			}}, nil
+		},
+	}
+	svc := NewFleetService(mock)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	resp, err := svc.ListFleetSites(ctx, connect.NewRequest(&libopsv1.ListFleetSitesRequest{
+		Filter: &libopsv1.FleetFilter{
+			Status:                 proto.String("active"),
+			StaleMinutes:           60,
+			MinDiskUsedPercent:     70,
+			OsPatchStatus:          proto.String("outdated"),
+			PendingReconciliations: true,
+		},
+		PageSize: 1,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Sites, 1)
+	site := resp.Msg.Sites[0]
+	assert.Equal(t, int64(20<<30), site.DiskSizeBytes)
+	assert.InDelta(t, 75.0, site.DiskUsedPercent, 0.01)
+	assert.Equal(t, now.Add(-2*time.Hour).Unix(), site.LastCheckinAt)
+	assert.NotEmpty(t, resp.Msg.NextPageToken, "a full page has a next page")
+
+	assert.Equal(t, db.SitesStatusActive, params.Status.SitesStatus)
+	assert.Equal(t, now.Add(-time.Hour), params.CheckinBefore.Time)
+	assert.Equal(t, int32(70), params.MinDiskUsedPercent.Int32)
+	assert.Equal(t, "outdated", params.OsPatchStatus.String)
+	assert.True(t, params.PendingOnly)
+	assert.False(t, params.OrganizationID.Valid)
+
+	for name, filter := range map[string]*libopsv1.FleetFilter{
+		"deleted status":   {Status: proto.String("deleted")},
+		"patch status":     {OsPatchStatus: proto.String("patched")},
+		"disk percent":     {MinDiskUsedPercent: 101},
+		"stale minutes":    {StaleMinutes: -5},
+		"organization ids": {OrganizationId: "not-a-uuid"},
+	} {
+		_, err := svc.ListFleetSites(ctx, connect.NewRequest(&libopsv1.ListFleetSitesRequest{Filter: filter}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	orgID := uuid.NewString()
+	_, err = svc.ListOrganizationFleetSites(ctx, connect.NewRequest(&libopsv1.ListOrganizationFleetSitesRequest{
+		OrganizationId: orgID,
+		Filter:         &libopsv1.FleetFilter{OrganizationId: uuid.NewString()},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, orgID, params.OrganizationID.String, "the filter can't name another organization")
+
+	export, err := svc.ExportFleetSites(ctx, connect.NewRequest(&libopsv1.ExportFleetSitesRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, "fleet-2026-03-01.csv", export.Msg.Filename)
+	records, err := csv.NewReader(strings.NewReader(string(export.Msg.Csv))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "site_id", records[0][0])
+	assert.Equal(t, "'=cmd", records[1][1], "formulas are quoted")
+	assert.Equal(t, "75.0", records[1][len(records[1])-1])
+}
@@ -0,0 +1,496 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/fleet"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/tfstate"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// siteLogWindow widens a site reconciliation's log query around the run, since
+// the control plane logs the request before the run starts and the outcome
+// after it finishes
+const siteLogWindow = time.Minute
+
+// fleetReconcileBatchLimit caps the sites named in one ReconcileFleet request
+const fleetReconcileBatchLimit = 500
+
+// ListReconciliationRuns lists terraform runs and site reconciliations, newest first.
+func (s *AdminService) ListReconciliationRuns(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListReconciliationRunsRequest],
+) (*connect.Response[libopsv1.ListReconciliationRunsResponse], error) {
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.ListPlatformReconciliationRunsParams{
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	}
+	if req.Msg.RunType != nil {
+		runType := db.ReconciliationsRunType(req.Msg.GetRunType())
+		switch runType {
+		case db.ReconciliationsRunTypeTerraform, db.ReconciliationsRunTypeReconciliation:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown run_type %q", runType))
+		}
+		params.RunType = db.NullReconciliationsRunType{ReconciliationsRunType: runType, Valid: true}
+	}
+	if req.Msg.Status != nil {
+		status := db.ReconciliationsStatus(req.Msg.GetStatus())
+		switch status {
+		case db.ReconciliationsStatusPending, db.ReconciliationsStatusTriggered, db.ReconciliationsStatusRunning,
+			db.ReconciliationsStatusCompleted, db.ReconciliationsStatusFailed, db.ReconciliationsStatusCancelled:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown status %q", status))
+		}
+		params.Status = db.NullReconciliationsStatus{ReconciliationsStatus: status, Valid: true}
+	}
+	if req.Msg.OrganizationId != nil {
+		organization, err := s.lookupOrganization(ctx, req.Msg.GetOrganizationId())
+		if err != nil {
+			return nil, err
+		}
+		params.OrganizationID = sql.NullInt64{Int64: organization.ID, Valid: true}
+	}
+	if req.Msg.SiteId != nil {
+		if err := validation.UUID(req.Msg.GetSiteId()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		site, err := service.GetSiteByPublicID(ctx, s.db, req.Msg.GetSiteId())
+		if err != nil {
+			return nil, err
+		}
+		params.SiteID = sql.NullInt64{Int64: site.ID, Valid: true}
+	}
+
+	rows, err := s.db.ListPlatformReconciliationRuns(ctx, params)
+	if err != nil {
+		slog.Error("Failed to list reconciliation runs", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	runs := make([]*libopsv1.PlatformReconciliationRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, reconciliationRunToProto(db.GetPlatformReconciliationRunRow(row)))
+	}
+
+	return connect.NewResponse(&libopsv1.ListReconciliationRunsResponse{
+		Runs:          runs,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// DescribeReconciliationRun returns a run, what it did to each module or site,
+// and how to find its logs. The terraform runner tags every log entry with its
+// run ID; site reconciliations are found by site and time in the control
+// plane's logs.
+func (s *AdminService) DescribeReconciliationRun(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DescribeReconciliationRunRequest],
+) (*connect.Response[libopsv1.DescribeReconciliationRunResponse], error) {
+	run, err := s.getReconciliationRun(ctx, req.Msg.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.GetReconciliationResults(ctx, run.RunID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	sitePublicIDs := map[int64]string{}
+	results := make([]*libopsv1.ReconciliationRunResult, 0, len(rows))
+	for _, row := range rows {
+		result := &libopsv1.ReconciliationRunResult{
+			ResultType:   string(row.ResultType),
+			ModuleType:   service.FromNullString(row.ModuleType),
+			Status:       string(row.Status),
+			Output:       service.FromNullString(row.Output),
+			ErrorMessage: service.FromNullString(row.ErrorMessage),
+			StartedAt:    row.StartedAt.Unix(),
+			CompletedAt:  row.CompletedAt.Unix(),
+		}
+		if row.SiteID.Valid {
+			if _, ok := sitePublicIDs[row.SiteID.Int64]; !ok {
+				if site, err := s.db.GetSiteByID(ctx, row.SiteID.Int64); err == nil {
+					sitePublicIDs[row.SiteID.Int64] = site.PublicID
+				}
+			}
+			result.SiteId = sitePublicIDs[row.SiteID.Int64]
+		}
+		results = append(results, result)
+	}
+
+	resp := &libopsv1.DescribeReconciliationRunResponse{
+		Run:     reconciliationRunToProto(run),
+		Results: results,
+	}
+	switch {
+	case run.RunType == db.ReconciliationsRunTypeTerraform:
+		resp.LogFilter = fmt.Sprintf(`resource.type="cloud_run_job" AND jsonPayload.run_id=%q`, run.RunID)
+		resp.LogProject = run.OrganizationGcpProjectID
+	case run.SitePublicID != "" && run.CreatedAt.Valid:
+		from := run.CreatedAt.Time.Add(-siteLogWindow)
+		until := s.now()
+		if run.CompletedAt.Valid {
+			until = run.CompletedAt.Time.Add(siteLogWindow)
+		}
+		resp.LogFilter = fmt.Sprintf(`jsonPayload.site_public_id=%q AND timestamp>=%q AND timestamp<=%q`,
+			run.SitePublicID, from.UTC().Format(time.RFC3339), until.UTC().Format(time.RFC3339))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// RetryReconciliationRun runs a failed or cancelled run again. A terraform run
+// is copied into a new pending run, which the caller triggers the
+// organization's runner job for; a site reconciliation is scheduled for the
+// event router to publish again.
+func (s *AdminService) RetryReconciliationRun(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RetryReconciliationRunRequest],
+) (*connect.Response[libopsv1.RetryReconciliationRunResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	run, err := s.getReconciliationRun(ctx, req.Msg.RunId)
+	if err != nil {
+		return nil, err
+	}
+	status := run.Status.ReconciliationsStatus
+	if status != db.ReconciliationsStatusFailed && status != db.ReconciliationsStatusCancelled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only failed or cancelled runs can be retried; run is %s", status))
+	}
+
+	retryRunID := run.RunID
+	switch run.RunType {
+	case db.ReconciliationsRunTypeTerraform:
+		var modules []string
+		if len(run.Modules) > 0 {
+			if err := json.Unmarshal(run.Modules, &modules); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid modules in run %s: %w", run.RunID, err))
+			}
+		}
+		if slices.Contains(modules, tfstate.MigrationModule) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("state migrations aren't retried; start a new one with MigrateStateWorkspaces"))
+		}
+
+		now := s.now()
+		retryRunID = fmt.Sprintf("retry-%s-%s", now.Format("20060102-150405"), uuid.NewString()[:8])
+		targetSiteIDs := run.TargetSiteIds
+		if len(targetSiteIDs) == 0 {
+			targetSiteIDs = []byte("[]")
+		}
+		_, err = s.db.CreateReconciliationRun(ctx, db.CreateReconciliationRunParams{
+			RunID:              retryRunID,
+			OrganizationID:     run.OrganizationID,
+			ProjectID:          run.ProjectID,
+			SiteID:             run.SiteID,
+			RunType:            run.RunType,
+			ReconciliationType: run.ReconciliationType,
+			Modules:            run.Modules,
+			TargetSiteIds:      targetSiteIDs,
+			EventIds:           run.EventIds,
+			FirstEventAt:       now,
+			LastEventAt:        now,
+		})
+		if err != nil {
+			slog.Error("Failed to create retry run", "error", err, "run_id", run.RunID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	default:
+		scheduled, err := s.db.ScheduleReconciliationRetry(ctx, run.RunID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if scheduled == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("run %s can't be retried", run.RunID))
+		}
+	}
+
+	entityID, entityType := reconciliationRunAuditEntity(run, userInfo.AccountID)
+	s.auditLogger.Log(ctx, userInfo.AccountID, entityID, entityType, audit.ReconciliationRetry, map[string]any{
+		"run_id":       run.RunID,
+		"retry_run_id": retryRunID,
+		"reason":       reason,
+	})
+
+	retried, err := s.getReconciliationRun(ctx, retryRunID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.RetryReconciliationRunResponse{Run: reconciliationRunToProto(retried)}), nil
+}
+
+// MarkReconciliationRunTriggered records that the runner job was started for a
+// pending terraform run. A run that already moved on, because the runner
+// reported it started or it was cancelled, is returned as it is.
+func (s *AdminService) MarkReconciliationRunTriggered(
+	ctx context.Context,
+	req *connect.Request[libopsv1.MarkReconciliationRunTriggeredRequest],
+) (*connect.Response[libopsv1.MarkReconciliationRunTriggeredResponse], error) {
+	run, err := s.getReconciliationRun(ctx, req.Msg.RunId)
+	if err != nil {
+		return nil, err
+	}
+	if run.RunType != db.ReconciliationsRunTypeTerraform {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only terraform runs are started by a runner job; run is a %s run", run.RunType))
+	}
+
+	triggered, err := s.db.MarkReconciliationRunTriggered(ctx, run.RunID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if triggered > 0 {
+		if run, err = s.getReconciliationRun(ctx, run.RunID); err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&libopsv1.MarkReconciliationRunTriggeredResponse{Run: reconciliationRunToProto(run)}), nil
+}
+
+// CancelReconciliationRun cancels a terraform run that hasn't finished, or
+// stops the event router retrying a failed site reconciliation.
+func (s *AdminService) CancelReconciliationRun(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CancelReconciliationRunRequest],
+) (*connect.Response[libopsv1.CancelReconciliationRunResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	run, err := s.getReconciliationRun(ctx, req.Msg.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelled int64
+	switch run.Status.ReconciliationsStatus {
+	case db.ReconciliationsStatusPending, db.ReconciliationsStatusTriggered, db.ReconciliationsStatusRunning:
+		if run.Status.ReconciliationsStatus == db.ReconciliationsStatusRunning && isStateMigration(run) {
+			// Workspaces may already be split off; let the runner finish or fail
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a running state migration can't be cancelled"))
+		}
+		cancelled, err = s.db.CancelReconciliationRun(ctx, db.CancelReconciliationRunParams{
+			ErrorMessage: sql.NullString{String: "Cancelled: " + reason, Valid: true},
+			RunID:        run.RunID,
+		})
+		if err == nil && cancelled > 0 {
+			// A cancelled migration leaves the organization monolithic
+			_, err = s.db.FinishTerraformStateMigration(ctx, db.FinishTerraformStateMigrationParams{
+				Layout: db.TerraformStateLayoutsLayoutMonolithic,
+				RunID:  sql.NullString{String: run.RunID, Valid: true},
+			})
+		}
+	case db.ReconciliationsStatusFailed:
+		cancelled, err = s.db.CancelReconciliationRetry(ctx, run.RunID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if cancelled == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("run %s has finished and has no retry pending", run.RunID))
+	}
+
+	entityID, entityType := reconciliationRunAuditEntity(run, userInfo.AccountID)
+	s.auditLogger.Log(ctx, userInfo.AccountID, entityID, entityType, audit.ReconciliationCancel, map[string]any{
+		"run_id": run.RunID,
+		"status": string(run.Status.ReconciliationsStatus),
+		"reason": reason,
+	})
+
+	run, err = s.getReconciliationRun(ctx, run.RunID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.CancelReconciliationRunResponse{Run: reconciliationRunToProto(run)}), nil
+}
+
+// ReconcileFleet queues a secrets or firewall reconciliation for every site
+// matching a fleet filter, or for a batch of sites. The queue is the one the
+// event router defers reconciliations to, so each site waits for its
+// maintenance window; one site failing to queue doesn't stop the rest.
+func (s *AdminService) ReconcileFleet(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReconcileFleetRequest],
+) (*connect.Response[libopsv1.ReconcileFleetResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	requestType := db.DeferredReconciliationsRequestType(req.Msg.ReconciliationType)
+	switch requestType {
+	case db.DeferredReconciliationsRequestTypeSecrets, db.DeferredReconciliationsRequestTypeFirewall:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reconciliation_type must be secrets or firewall"))
+	}
+
+	type target struct {
+		id       int64
+		publicID string
+	}
+	var targets []target
+	resp := &libopsv1.ReconcileFleetResponse{SiteIds: []string{}}
+	if len(req.Msg.SiteIds) > 0 {
+		if len(req.Msg.SiteIds) > fleetReconcileBatchLimit {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d site_ids per request", fleetReconcileBatchLimit))
+		}
+		for _, siteID := range req.Msg.SiteIds {
+			if err := validation.UUID(siteID); err != nil {
+				resp.Failures = append(resp.Failures, &libopsv1.FleetReconcileFailure{SiteId: siteID, Error: err.Error()})
+				continue
+			}
+			site, err := service.GetSiteByPublicID(ctx, s.db, siteID)
+			if err != nil {
+				message := err.Error()
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					message = connectErr.Message()
+				}
+				resp.Failures = append(resp.Failures, &libopsv1.FleetReconcileFailure{SiteId: siteID, Error: message})
+				continue
+			}
+			targets = append(targets, target{id: site.ID, publicID: site.PublicID})
+		}
+	} else {
+		params, err := fleet.Params(req.Msg.Filter, s.now(), fleetExportLimit+1, 0)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		rows, err := s.db.ListFleetSites(ctx, params)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if len(rows) > fleetExportLimit {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("more than %d sites match; narrow the filter", fleetExportLimit))
+		}
+		for _, row := range rows {
+			targets = append(targets, target{id: row.ID, publicID: row.PublicID})
+		}
+	}
+
+	if req.Msg.DryRun {
+		for _, t := range targets {
+			resp.SiteIds = append(resp.SiteIds, t.publicID)
+		}
+		return connect.NewResponse(resp), nil
+	}
+
+	// A fresh event ID per request keeps controllers from skipping a rollout
+	// as a repeat of an earlier one
+	eventID := "fleet-reconcile-" + uuid.NewString()
+	eventIDs, _ := json.Marshal([]string{eventID})
+	for _, t := range targets {
+		err := s.db.QueueSiteReconciliation(ctx, db.QueueSiteReconciliationParams{
+			SiteID:      t.id,
+			RequestType: requestType,
+			EventIds:    eventIDs,
+		})
+		if err != nil {
+			slog.Error("Failed to queue site reconciliation", "error", err, "site_public_id", t.publicID)
+			resp.Failures = append(resp.Failures, &libopsv1.FleetReconcileFailure{SiteId: t.publicID, Error: "failed to queue reconciliation"})
+			continue
+		}
+		resp.SiteIds = append(resp.SiteIds, t.publicID)
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, userInfo.AccountID, audit.AccountEntityType, audit.ReconciliationFleet, map[string]any{
+		"event_id":            eventID,
+		"reconciliation_type": string(requestType),
+		"queued":              len(resp.SiteIds),
+		"failed":              len(resp.Failures),
+		"reason":              reason,
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *AdminService) getReconciliationRun(ctx context.Context, runID string) (db.GetPlatformReconciliationRunRow, error) {
+	if runID == "" {
+		return db.GetPlatformReconciliationRunRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("run_id is required"))
+	}
+	run, err := s.db.GetPlatformReconciliationRun(ctx, runID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.GetPlatformReconciliationRunRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("reconciliation run not found: %s", runID))
+	}
+	if err != nil {
+		return db.GetPlatformReconciliationRunRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return run, nil
+}
+
+func isStateMigration(run db.GetPlatformReconciliationRunRow) bool {
+	var modules []string
+	_ = json.Unmarshal(run.Modules, &modules)
+	return slices.Contains(modules, tfstate.MigrationModule)
+}
+
+// reconciliationRunAuditEntity is the most specific resource a run applies to,
+// or the operator for runs outside any organization
+func reconciliationRunAuditEntity(run db.GetPlatformReconciliationRunRow, accountID int64) (int64, audit.EntityType) {
+	switch {
+	case run.SiteID.Valid:
+		return run.SiteID.Int64, audit.SiteEntityType
+	case run.ProjectID.Valid:
+		return run.ProjectID.Int64, audit.ProjectEntityType
+	case run.OrganizationID.Valid:
+		return run.OrganizationID.Int64, audit.OrganizationEntityType
+	}
+	return accountID, audit.AccountEntityType
+}
+
+func reconciliationRunToProto(row db.GetPlatformReconciliationRunRow) *libopsv1.PlatformReconciliationRun {
+	run := &libopsv1.PlatformReconciliationRun{
+		RunId:              row.RunID,
+		RunType:            string(row.RunType),
+		ReconciliationType: string(row.ReconciliationType.ReconciliationsReconciliationType),
+		Status:             string(row.Status.ReconciliationsStatus),
+		ErrorMessage:       service.FromNullString(row.ErrorMessage),
+		OrganizationId:     row.OrganizationPublicID,
+		ProjectId:          row.ProjectPublicID,
+		SiteId:             row.SitePublicID,
+		Attempt:            row.Attempt,
+		RetryState:         string(row.RetryState.ReconciliationsRetryState),
+		NextRetryAt:        unix(row.NextRetryAt),
+		CreatedAt:          unix(row.CreatedAt),
+		TriggeredAt:        unix(row.TriggeredAt),
+		StartedAt:          unix(row.StartedAt),
+		CompletedAt:        unix(row.CompletedAt),
+		GcpProjectId:       row.OrganizationGcpProjectID,
+	}
+	if len(row.Modules) > 0 {
+		_ = json.Unmarshal(row.Modules, &run.Modules)
+	}
+	return run
+}
@@ -0,0 +1,314 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// reconciliationRunsMock keeps runs by ID and applies the retry, trigger and
+// cancel queries to them
+func reconciliationRunsMock(runs map[string]*db.GetPlatformReconciliationRunRow) *testutils.MockQuerier {
+	status := func(s db.ReconciliationsStatus) db.NullReconciliationsStatus {
+		return db.NullReconciliationsStatus{ReconciliationsStatus: s, Valid: true}
+	}
+	return &testutils.MockQuerier{
+		GetPlatformReconciliationRunFunc: func(ctx context.Context, runID string) (db.GetPlatformReconciliationRunRow, error) {
+			run, ok := runs[runID]
+			if !ok {
+				return db.GetPlatformReconciliationRunRow{}, sql.ErrNoRows
+			}
+			return *run, nil
+		},
+		CreateReconciliationRunFunc: func(ctx context.Context, arg db.CreateReconciliationRunParams) (sql.Result, error) {
+			runs[arg.RunID] = &db.GetPlatformReconciliationRunRow{
+				RunID:          arg.RunID,
+				OrganizationID: arg.OrganizationID,
+				RunType:        arg.RunType,
+				Modules:        arg.Modules,
+				Status:         status(db.ReconciliationsStatusPending),
+			}
+			return nil, nil
+		},
+		ScheduleReconciliationRetryFunc: func(ctx context.Context, runID string) (int64, error) {
+			run := runs[runID]
+			if run.Status.ReconciliationsStatus != db.ReconciliationsStatusFailed {
+				return 0, nil
+			}
+			run.RetryState = db.NullReconciliationsRetryState{ReconciliationsRetryState: db.ReconciliationsRetryStateScheduled, Valid: true}
+			return 1, nil
+		},
+		MarkReconciliationRunTriggeredFunc: func(ctx context.Context, runID string) (int64, error) {
+			run := runs[runID]
+			if run.Status.ReconciliationsStatus != db.ReconciliationsStatusPending {
+				return 0, nil
+			}
+			run.Status = status(db.ReconciliationsStatusTriggered)
+			return 1, nil
+		},
+		CancelReconciliationRunFunc: func(ctx context.Context, arg db.CancelReconciliationRunParams) (int64, error) {
+			run := runs[arg.RunID]
+			run.Status = status(db.ReconciliationsStatusCancelled)
+			run.ErrorMessage = arg.ErrorMessage
+			return 1, nil
+		},
+		CancelReconciliationRetryFunc: func(ctx context.Context, runID string) (int64, error) {
+			run := runs[runID]
+			if run.RetryState.ReconciliationsRetryState == db.ReconciliationsRetryStateExhausted {
+				return 0, nil
+			}
+			run.RetryState = db.NullReconciliationsRetryState{ReconciliationsRetryState: db.ReconciliationsRetryStateExhausted, Valid: true}
+			return 1, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			return nil
+		},
+	}
+}
+
+// TestRetryReconciliationRun tests that terraform runs are copied and site reconciliations rescheduled.
+func TestRetryReconciliationRun(t *testing.T) {
+	runs := map[string]*db.GetPlatformReconciliationRunRow{
+		"tf-failed": {
+			RunID:          "tf-failed",
+			OrganizationID: sql.NullInt64{Int64: 7, Valid: true},
+			RunType:        db.ReconciliationsRunTypeTerraform,
+			Modules:        types.RawJSON(`["organization"]`),
+			Status:         db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+		"tf-running": {
+			RunID:   "tf-running",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusRunning, Valid: true},
+		},
+		"migration": {
+			RunID:   "migration",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Modules: types.RawJSON(`["state_migration"]`),
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+		"vm-failed": {
+			RunID:   "vm-failed",
+			RunType: db.ReconciliationsRunTypeReconciliation,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+	}
+	mock := reconciliationRunsMock(runs)
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+	retry := func(runID, reason string) (*libopsv1.PlatformReconciliationRun, error) {
+		resp, err := svc.RetryReconciliationRun(ctx, connect.NewRequest(&libopsv1.RetryReconciliationRunRequest{RunId: runID, Reason: reason}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Run, nil
+	}
+
+	retried, err := retry("tf-failed", "transient quota error")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(retried.RunId, "retry-"), retried.RunId)
+	assert.Equal(t, "pending", retried.Status)
+	assert.Equal(t, []string{"organization"}, retried.Modules)
+	assert.Equal(t, int64(7), runs[retried.RunId].OrganizationID.Int64)
+
+	scheduled, err := retry("vm-failed", "site was rebooting")
+	require.NoError(t, err)
+	assert.Equal(t, "vm-failed", scheduled.RunId)
+	assert.Equal(t, "scheduled", scheduled.RetryState)
+
+	_, err = retry("tf-running", "impatient")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = retry("migration", "try again")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "migrations start over")
+	_, err = retry("missing", "typo")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = retry("tf-failed", "")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestMarkReconciliationRunTriggered tests that a retried terraform run is
+// marked triggered once its runner job starts, without undoing the runner's
+// own status reports.
+func TestMarkReconciliationRunTriggered(t *testing.T) {
+	runs := map[string]*db.GetPlatformReconciliationRunRow{
+		"tf-failed": {
+			RunID:   "tf-failed",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Modules: types.RawJSON(`["site"]`),
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+		"tf-running": {
+			RunID:   "tf-running",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusRunning, Valid: true},
+		},
+		"vm-failed": {
+			RunID:   "vm-failed",
+			RunType: db.ReconciliationsRunTypeReconciliation,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+	}
+	mock := reconciliationRunsMock(runs)
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+	mark := func(runID string) (*libopsv1.PlatformReconciliationRun, error) {
+		resp, err := svc.MarkReconciliationRunTriggered(ctx, connect.NewRequest(&libopsv1.MarkReconciliationRunTriggeredRequest{RunId: runID}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Run, nil
+	}
+
+	retried, err := svc.RetryReconciliationRun(ctx, connect.NewRequest(&libopsv1.RetryReconciliationRunRequest{RunId: "tf-failed", Reason: "transient quota error"}))
+	require.NoError(t, err)
+	triggered, err := mark(retried.Msg.Run.RunId)
+	require.NoError(t, err)
+	assert.Equal(t, "triggered", triggered.Status)
+
+	running, err := mark("tf-running")
+	require.NoError(t, err)
+	assert.Equal(t, "running", running.Status, "the runner already reported in")
+
+	_, err = mark("vm-failed")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = mark("missing")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+// TestCancelReconciliationRun tests that unfinished terraform runs are cancelled and failed reconciliations stop retrying.
+func TestCancelReconciliationRun(t *testing.T) {
+	runs := map[string]*db.GetPlatformReconciliationRunRow{
+		"tf-pending": {
+			RunID:   "tf-pending",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Modules: types.RawJSON(`["site"]`),
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusPending, Valid: true},
+		},
+		"tf-completed": {
+			RunID:   "tf-completed",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusCompleted, Valid: true},
+		},
+		"migration": {
+			RunID:   "migration",
+			RunType: db.ReconciliationsRunTypeTerraform,
+			Modules: types.RawJSON(`["state_migration"]`),
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusRunning, Valid: true},
+		},
+		"vm-failed": {
+			RunID:   "vm-failed",
+			RunType: db.ReconciliationsRunTypeReconciliation,
+			Status:  db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+		},
+	}
+	mock := reconciliationRunsMock(runs)
+	var finished []db.FinishTerraformStateMigrationParams
+	mock.FinishTerraformStateMigrationFunc = func(ctx context.Context, arg db.FinishTerraformStateMigrationParams) (int64, error) {
+		finished = append(finished, arg)
+		return 0, nil
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+	cancel := func(runID string) (*libopsv1.PlatformReconciliationRun, error) {
+		resp, err := svc.CancelReconciliationRun(ctx, connect.NewRequest(&libopsv1.CancelReconciliationRunRequest{RunId: runID, Reason: "wrong site"}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Run, nil
+	}
+
+	cancelled, err := cancel("tf-pending")
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", cancelled.Status)
+	assert.Equal(t, "Cancelled: wrong site", cancelled.ErrorMessage)
+	require.Len(t, finished, 1, "a cancelled migration leaves the organization monolithic")
+	assert.Equal(t, db.TerraformStateLayoutsLayoutMonolithic, finished[0].Layout)
+
+	stopped, err := cancel("vm-failed")
+	require.NoError(t, err)
+	assert.Equal(t, "exhausted", stopped.RetryState)
+	_, err = cancel("vm-failed")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "no retry left to cancel")
+
+	_, err = cancel("tf-completed")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = cancel("migration")
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a running migration finishes")
+}
+
+// TestReconcileFleet tests that matching sites are queued, or only listed on a dry run.
+func TestReconcileFleet(t *testing.T) {
+	siteIDs := []string{uuid.NewString(), uuid.NewString()}
+	var filtered db.ListFleetSitesParams
+	var queued []db.QueueSiteReconciliationParams
+	mock := &testutils.MockQuerier{
+		ListFleetSitesFunc: func(ctx context.Context, arg db.ListFleetSitesParams) ([]db.ListFleetSitesRow, error) {
+			filtered = arg
+			return []db.ListFleetSitesRow{{ID: 1, PublicID: siteIDs[0]}, {ID: 2, PublicID: siteIDs[1]}}, nil
+		},
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			if publicID != siteIDs[1] {
+				return db.GetSiteRow{}, sql.ErrNoRows
+			}
+			return db.GetSiteRow{ID: 2, PublicID: publicID}, nil
+		},
+		QueueSiteReconciliationFunc: func(ctx context.Context, arg db.QueueSiteReconciliationParams) error {
+			if arg.SiteID == 1 {
+				return sql.ErrConnDone
+			}
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+	version, selector := "v1.4.0", "env=prod"
+	filter := &libopsv1.FleetFilter{ControllerVersion: &version, LabelSelector: &selector}
+
+	dryRun, err := svc.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{
+		Filter: filter, ReconciliationType: "secrets", Reason: "rotate database passwords", DryRun: true,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, siteIDs, dryRun.Msg.SiteIds)
+	assert.Equal(t, "v1.4.0", filtered.ControllerVersion.String)
+	assert.JSONEq(t, `{"env":"prod"}`, filtered.LabelSelector.String)
+	assert.Empty(t, queued)
+
+	resp, err := svc.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{
+		Filter: filter, ReconciliationType: "secrets", Reason: "rotate database passwords",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{siteIDs[1]}, resp.Msg.SiteIds)
+	require.Len(t, resp.Msg.Failures, 1, "one site failing doesn't stop the rest")
+	assert.Equal(t, siteIDs[0], resp.Msg.Failures[0].SiteId)
+	require.Len(t, queued, 1)
+	assert.Equal(t, db.DeferredReconciliationsRequestTypeSecrets, queued[0].RequestType)
+	assert.Contains(t, string(queued[0].EventIds), "fleet-reconcile-")
+
+	batch, err := svc.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{
+		SiteIds: siteIDs, ReconciliationType: "firewall", Reason: "new office range",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{siteIDs[1]}, batch.Msg.SiteIds)
+	require.Len(t, batch.Msg.Failures, 1)
+	assert.Equal(t, siteIDs[0], batch.Msg.Failures[0].SiteId)
+
+	_, err = svc.ReconcileFleet(ctx, connect.NewRequest(&libopsv1.ReconcileFleetRequest{ReconciliationType: "deployment", Reason: "no"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,447 @@
+// Package platform implements the admin console API used by platform operators.
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/tfstate"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// stuckEventThreshold is how long an event can sit in processing before
+	// it's reported as stuck
+	stuckEventThreshold = 15 * time.Minute
+
+	defaultDeadLetterLimit = 20
+	maxDeadLetterLimit     = 100
+
+	// maxReasonLength matches organizations.suspended_reason
+	maxReasonLength = 500
+)
+
+// AdminService implements the AdminService API.
+type AdminService struct {
+	db          db.Querier
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+	states      tfstate.Store // nil when terraform state management is disabled
+	now         func() time.Time
+}
+
+// Compile-time check.
+var _ libopsv1connect.AdminServiceHandler = (*AdminService)(nil)
+
+// NewAdminService creates a new AdminService instance.
+func NewAdminService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *AdminService {
+	return &AdminService{
+		db:          querier,
+		emitter:     emitter,
+		auditLogger: auditLogger,
+		now:         time.Now,
+	}
+}
+
+// ListPlatformOrganizations lists every organization, newest first.
+func (s *AdminService) ListPlatformOrganizations(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListPlatformOrganizationsRequest],
+) (*connect.Response[libopsv1.ListPlatformOrganizationsResponse], error) {
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.ListPlatformOrganizationsParams{
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	}
+	if req.Msg.BillingState != nil {
+		state := db.OrganizationsBillingState(req.Msg.GetBillingState())
+		switch state {
+		case db.OrganizationsBillingStateActive, db.OrganizationsBillingStatePastDue,
+			db.OrganizationsBillingStateSuspended, db.OrganizationsBillingStateScheduledForDeletion:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown billing_state %q", state))
+		}
+		params.BillingState = db.NullOrganizationsBillingState{OrganizationsBillingState: state, Valid: true}
+	}
+	if req.Msg.Status != nil {
+		params.Status = db.NullOrganizationsStatus{OrganizationsStatus: service.ProtoStatusToOrganizationDB(req.Msg.GetStatus()), Valid: true}
+	}
+
+	rows, err := s.db.ListPlatformOrganizations(ctx, params)
+	if err != nil {
+		slog.Error("Failed to list platform organizations", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	organizations := make([]*libopsv1.PlatformOrganization, 0, len(rows))
+	for _, row := range rows {
+		organizations = append(organizations, platformOrganizationToProto(db.GetPlatformOrganizationRow(row)))
+	}
+
+	return connect.NewResponse(&libopsv1.ListPlatformOrganizationsResponse{
+		Organizations: organizations,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// ForceReconciliation queues the resource's update event, which the control
+// plane turns into a reconciliation run like any other change.
+func (s *AdminService) ForceReconciliation(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ForceReconciliationRequest],
+) (*connect.Response[libopsv1.ForceReconciliationResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	var (
+		eventType             string
+		orgID, projID, siteID *string
+		entityID              int64
+		entityType            audit.EntityType
+	)
+	switch resource := req.Msg.Resource.(type) {
+	case *libopsv1.ForceReconciliationRequest_OrganizationId:
+		organization, err := s.lookupOrganization(ctx, resource.OrganizationId)
+		if err != nil {
+			return nil, err
+		}
+		eventType, orgID = events.EventTypeOrganizationUpdated, &organization.PublicID
+		entityID, entityType = organization.ID, audit.OrganizationEntityType
+	case *libopsv1.ForceReconciliationRequest_ProjectId:
+		if err := validation.UUID(resource.ProjectId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		project, err := service.GetProjectByPublicID(ctx, s.db, resource.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		eventType, projID = events.EventTypeProjectUpdated, &project.PublicID
+		entityID, entityType = project.ID, audit.ProjectEntityType
+	case *libopsv1.ForceReconciliationRequest_SiteId:
+		if err := validation.UUID(resource.SiteId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		site, err := service.GetSiteByPublicID(ctx, s.db, resource.SiteId)
+		if err != nil {
+			return nil, err
+		}
+		eventType, siteID = events.EventTypeSiteUpdated, &site.PublicID
+		entityID, entityType = site.ID, audit.SiteEntityType
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("one of organization_id, project_id or site_id is required"))
+	}
+
+	subject := *firstNonNil(siteID, projID, orgID)
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, subject, orgID, projID, siteID, req.Msg); err != nil {
+		slog.Error("Failed to queue forced reconciliation", "error", err, "resource_id", subject)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to queue reconciliation: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, entityID, entityType, audit.ReconciliationForce, map[string]any{
+		"event_type": eventType,
+		"reason":     req.Msg.Reason,
+	})
+
+	return connect.NewResponse(&libopsv1.ForceReconciliationResponse{EventType: eventType}), nil
+}
+
+// SuspendOrganization suspends an organization for abuse and stops its sites.
+// The suspension is separate from billing's: lifting one doesn't lift the other.
+func (s *AdminService) SuspendOrganization(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SuspendOrganizationRequest],
+) (*connect.Response[libopsv1.SuspendOrganizationResponse], error) {
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SuspendOrganization(ctx, db.SuspendOrganizationParams{
+		SuspendedReason: sql.NullString{String: reason, Valid: true},
+		SuspendedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		ID:              organization.ID,
+	})
+	if err != nil {
+		slog.Error("Failed to suspend organization", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	suspended, err := s.db.SuspendOrganizationSites(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to suspend organization sites", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationSuspend, map[string]any{
+		"reason":          reason,
+		"sites_suspended": suspended,
+	})
+	s.emitOrganizationUpdated(ctx, organization.PublicID, req.Msg)
+
+	updated, err := s.getPlatformOrganization(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.SuspendOrganizationResponse{
+		Organization:   updated,
+		SitesSuspended: suspended,
+	}), nil
+}
+
+// UnsuspendOrganization lifts an operator suspension. Sites resume unless
+// billing is holding the organization too.
+func (s *AdminService) UnsuspendOrganization(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UnsuspendOrganizationRequest],
+) (*connect.Response[libopsv1.UnsuspendOrganizationResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	lifted, err := s.db.UnsuspendOrganization(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to unsuspend organization", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if lifted == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization isn't suspended"))
+	}
+	resumed, err := s.db.ResumeOrganizationSites(ctx, organization.ID)
+	if err != nil {
+		slog.Error("Failed to resume organization sites", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.OrganizationUnsuspend, map[string]any{
+		"reason":        req.Msg.Reason,
+		"sites_resumed": resumed,
+	})
+	s.emitOrganizationUpdated(ctx, organization.PublicID, req.Msg)
+
+	updated, err := s.getPlatformOrganization(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.UnsuspendOrganizationResponse{
+		Organization: updated,
+		SitesResumed: resumed,
+	}), nil
+}
+
+// GetEventQueueHealth summarizes the event queue.
+func (s *AdminService) GetEventQueueHealth(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetEventQueueHealthRequest],
+) (*connect.Response[libopsv1.GetEventQueueHealthResponse], error) {
+	limit := req.Msg.DeadLetterLimit
+	if limit <= 0 {
+		limit = defaultDeadLetterLimit
+	}
+	if limit > maxDeadLetterLimit {
+		limit = maxDeadLetterLimit
+	}
+	now := s.now()
+
+	counts, err := s.db.CountEventsByStatus(ctx)
+	if err != nil {
+		slog.Error("Failed to count events", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resp := &libopsv1.GetEventQueueHealthResponse{Counts: make(map[string]int64, len(counts))}
+	for _, c := range counts {
+		resp.Counts[string(c.Status)] = c.EventCount
+	}
+
+	oldest, err := s.db.GetOldestPendingEvent(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		slog.Error("Failed to get oldest pending event", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	default:
+		resp.OldestPendingEventId = oldest.EventID
+		resp.OldestPendingAgeSeconds = max(int64(now.Sub(oldest.CreatedAt).Seconds()), 0)
+	}
+
+	resp.StuckEvents, err = s.db.CountStuckEvents(ctx, sql.NullTime{Time: now.Add(-stuckEventThreshold), Valid: true})
+	if err != nil {
+		slog.Error("Failed to count stuck events", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	deadLetters, err := s.db.ListDeadLetterEvents(ctx, limit)
+	if err != nil {
+		slog.Error("Failed to list dead-lettered events", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, e := range deadLetters {
+		event := &libopsv1.DeadLetterEvent{
+			EventId:    e.EventID,
+			EventType:  e.EventType,
+			RetryCount: e.RetryCount,
+			LastError:  service.FromNullString(e.LastError),
+			CreatedAt:  e.CreatedAt.Unix(),
+		}
+		if e.LastRetryAt.Valid {
+			event.LastRetryAt = e.LastRetryAt.Time.Unix()
+		}
+		resp.DeadLetters = append(resp.DeadLetters, event)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// LookupResource finds everything an ID could belong to. UUIDs are matched
+// against public IDs and the string IDs the control plane generates; anything
+// is matched against GCP IDs, account emails and GitHub run IDs.
+func (s *AdminService) LookupResource(
+	ctx context.Context,
+	req *connect.Request[libopsv1.LookupResourceRequest],
+) (*connect.Response[libopsv1.LookupResourceResponse], error) {
+	id := strings.TrimSpace(req.Msg.Id)
+	if id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	matches := []*libopsv1.ResourceMatch{}
+	if parsed, err := uuid.Parse(id); err == nil {
+		rows, err := s.db.LookupResourceByPublicID(ctx, db.LookupResourceByPublicIDParams{ID: parsed.String()})
+		if err != nil {
+			slog.Error("Failed to look up resource by public ID", "error", err, "id", id)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		for _, row := range rows {
+			matches = append(matches, resourceMatchToProto(db.LookupResourceByExternalIDRow(row)))
+		}
+	}
+
+	rows, err := s.db.LookupResourceByExternalID(ctx, db.LookupResourceByExternalIDParams{
+		ID:    sql.NullString{String: id, Valid: true},
+		Email: id,
+	})
+	if err != nil {
+		slog.Error("Failed to look up resource by external ID", "error", err, "id", id)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, row := range rows {
+		matches = append(matches, resourceMatchToProto(row))
+	}
+
+	return connect.NewResponse(&libopsv1.LookupResourceResponse{Matches: matches}), nil
+}
+
+func (s *AdminService) lookupOrganization(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+	if err := validation.UUID(publicID); err != nil {
+		return db.GetOrganizationRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return service.GetOrganizationByPublicID(ctx, s.db, publicID)
+}
+
+func (s *AdminService) getPlatformOrganization(ctx context.Context, id int64) (*libopsv1.PlatformOrganization, error) {
+	row, err := s.db.GetPlatformOrganization(ctx, id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return platformOrganizationToProto(row), nil
+}
+
+// emitOrganizationUpdated queues a reconciliation so the control plane stops
+// or starts the organization's sites.
+func (s *AdminService) emitOrganizationUpdated(ctx context.Context, publicID string, data proto.Message) {
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeOrganizationUpdated, publicID, &publicID, nil, nil, data); err != nil {
+		slog.Error("Failed to emit organization updated event", "error", err, "organization_id", publicID)
+	}
+}
+
+func validateReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is required"))
+	}
+	if len(reason) > maxReasonLength {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason must be at most %d characters", maxReasonLength))
+	}
+	return reason, nil
+}
+
+func firstNonNil(ids ...*string) *string {
+	for _, id := range ids {
+		if id != nil {
+			return id
+		}
+	}
+	return nil
+}
+
+func platformOrganizationToProto(row db.GetPlatformOrganizationRow) *libopsv1.PlatformOrganization {
+	return &libopsv1.PlatformOrganization{
+		OrganizationId:        row.PublicID,
+		OrganizationName:      row.Name,
+		Status:                service.DbOrganizationStatusToProto(row.Status),
+		BillingState:          string(row.BillingState),
+		BillingStateChangedAt: unix(row.BillingStateChangedAt),
+		SubscriptionStatus:    row.SubscriptionStatus,
+		PaymentFailedAt:       unix(row.PaymentFailedAt),
+		ProjectCount:          row.ProjectCount,
+		SiteCount:             row.SiteCount,
+		CreatedAt:             unix(row.CreatedAt),
+		SuspendedAt:           unix(row.SuspendedAt),
+		SuspendedReason:       service.FromNullString(row.SuspendedReason),
+	}
+}
+
+func resourceMatchToProto(row db.LookupResourceByExternalIDRow) *libopsv1.ResourceMatch {
+	return &libopsv1.ResourceMatch{
+		ResourceType:   row.ResourceType,
+		ResourceId:     row.ResourceID,
+		Name:           row.Name,
+		MatchedField:   row.MatchedField,
+		OrganizationId: row.OrganizationID,
+		ProjectId:      row.ProjectID,
+		SiteId:         row.SiteID,
+		Status:         row.Status,
+	}
+}
+
+func unix(t sql.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Unix()
+}
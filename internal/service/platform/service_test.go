@@ -0,0 +1,211 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func operatorContext() context.Context {
+	return context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+}
+
+// TestSuspendOrganization tests that a suspension stops the organization's
+// sites, queues a reconciliation and is audited, and that lifting it resumes them.
+func TestSuspendOrganization(t *testing.T) {
+	orgID := uuid.NewString()
+	org := db.GetPlatformOrganizationRow{ID: 7, PublicID: orgID, Name: "Library", BillingState: db.OrganizationsBillingStateActive}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID, Name: "Library"}, nil
+		},
+		SuspendOrganizationFunc: func(ctx context.Context, arg db.SuspendOrganizationParams) error {
+			assert.Equal(t, int64(1), arg.SuspendedBy.Int64)
+			org.Status = db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusSuspended, Valid: true}
+			org.SuspendedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			org.SuspendedReason = arg.SuspendedReason
+			return nil
+		},
+		UnsuspendOrganizationFunc: func(ctx context.Context, id int64) (int64, error) {
+			if !org.SuspendedAt.Valid {
+				return 0, nil
+			}
+			org.Status = db.NullOrganizationsStatus{OrganizationsStatus: db.OrganizationsStatusActive, Valid: true}
+			org.SuspendedAt, org.SuspendedReason = sql.NullTime{}, sql.NullString{}
+			return 1, nil
+		},
+		SuspendOrganizationSitesFunc: func(ctx context.Context, organizationID int64) (int64, error) {
+			return 3, nil
+		},
+		ResumeOrganizationSitesFunc: func(ctx context.Context, id int64) (int64, error) {
+			return 3, nil
+		},
+		GetPlatformOrganizationFunc: func(ctx context.Context, id int64) (db.GetPlatformOrganizationRow, error) {
+			return org, nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+
+	_, err := svc.SuspendOrganization(ctx, connect.NewRequest(&libopsv1.SuspendOrganizationRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a reason is required")
+
+	suspended, err := svc.SuspendOrganization(ctx, connect.NewRequest(&libopsv1.SuspendOrganizationRequest{
+		OrganizationId: orgID,
+		Reason:         "crypto mining",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), suspended.Msg.SitesSuspended)
+	assert.Equal(t, "crypto mining", suspended.Msg.Organization.SuspendedReason)
+	assert.NotZero(t, suspended.Msg.Organization.SuspendedAt)
+
+	resumed, err := svc.UnsuspendOrganization(ctx, connect.NewRequest(&libopsv1.UnsuspendOrganizationRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resumed.Msg.SitesResumed)
+	assert.Zero(t, resumed.Msg.Organization.SuspendedAt)
+
+	_, err = svc.UnsuspendOrganization(ctx, connect.NewRequest(&libopsv1.UnsuspendOrganizationRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	require.Len(t, queued, 2)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeOrganizationUpdated, event.EventType)
+		assert.Equal(t, int64(7), event.OrganizationID.Int64)
+	}
+	assert.Equal(t, []string{string(audit.OrganizationSuspend), string(audit.OrganizationUnsuspend)}, audited)
+}
+
+// TestForceReconciliation tests that the most specific resource's update event is queued.
+func TestForceReconciliation(t *testing.T) {
+	siteID := uuid.NewString()
+	var queued []db.EnqueueEventParams
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 9, PublicID: publicID, ProjectID: 5}, nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+
+	resp, err := svc.ForceReconciliation(operatorContext(), connect.NewRequest(&libopsv1.ForceReconciliationRequest{
+		Resource: &libopsv1.ForceReconciliationRequest_SiteId{SiteId: siteID},
+		Reason:   "stuck deploy",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, events.EventTypeSiteUpdated, resp.Msg.EventType)
+	require.Len(t, queued, 1)
+	assert.Equal(t, siteID, queued[0].EventSubject.String)
+	assert.Equal(t, int64(9), queued[0].SiteID.Int64)
+
+	_, err = svc.ForceReconciliation(operatorContext(), connect.NewRequest(&libopsv1.ForceReconciliationRequest{Reason: "nothing"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestGetEventQueueHealth tests the queue summary.
+func TestGetEventQueueHealth(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var stuckCutoff time.Time
+	var deadLetterLimit int32
+	mock := &testutils.MockQuerier{
+		CountEventsByStatusFunc: func(ctx context.Context) ([]db.CountEventsByStatusRow, error) {
+			return []db.CountEventsByStatusRow{
+				{Status: db.EventQueueStatusPending, EventCount: 4},
+				{Status: db.EventQueueStatusDeadLetter, EventCount: 1},
+			}, nil
+		},
+		GetOldestPendingEventFunc: func(ctx context.Context) (db.GetOldestPendingEventRow, error) {
+			return db.GetOldestPendingEventRow{EventID: "evt-1", CreatedAt: now.Add(-90 * time.Second)}, nil
+		},
+		CountStuckEventsFunc: func(ctx context.Context, processingAt sql.NullTime) (int64, error) {
+			stuckCutoff = processingAt.Time
+			return 2, nil
+		},
+		ListDeadLetterEventsFunc: func(ctx context.Context, limit int32) ([]db.ListDeadLetterEventsRow, error) {
+			deadLetterLimit = limit
+			return []db.ListDeadLetterEventsRow{{
+				EventID:    "evt-0",
+				EventType:  events.EventTypeSiteUpdated,
+				RetryCount: 5,
+				LastError:  sql.NullString{String: "timeout", Valid: true},
+				CreatedAt:  now.Add(-time.Hour),
+			}}, nil
+		},
+	}
+	svc := NewAdminService(mock, nil, audit.New(mock))
+	svc.now = func() time.Time { return now }
+
+	resp, err := svc.GetEventQueueHealth(operatorContext(), connect.NewRequest(&libopsv1.GetEventQueueHealthRequest{DeadLetterLimit: 500}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"pending": 4, "dead_letter": 1}, resp.Msg.Counts)
+	assert.Equal(t, "evt-1", resp.Msg.OldestPendingEventId)
+	assert.Equal(t, int64(90), resp.Msg.OldestPendingAgeSeconds)
+	assert.Equal(t, int64(2), resp.Msg.StuckEvents)
+	assert.Equal(t, now.Add(-stuckEventThreshold), stuckCutoff)
+	assert.Equal(t, int32(maxDeadLetterLimit), deadLetterLimit)
+	require.Len(t, resp.Msg.DeadLetters, 1)
+	assert.Equal(t, "timeout", resp.Msg.DeadLetters[0].LastError)
+	assert.Zero(t, resp.Msg.DeadLetters[0].LastRetryAt)
+}
+
+// TestLookupResource tests that only UUIDs are matched against public IDs.
+func TestLookupResource(t *testing.T) {
+	var byPublicID int
+	mock := &testutils.MockQuerier{
+		LookupResourceByPublicIDFunc: func(ctx context.Context, arg db.LookupResourceByPublicIDParams) ([]db.LookupResourceByPublicIDRow, error) {
+			byPublicID++
+			return []db.LookupResourceByPublicIDRow{{ResourceType: "site", ResourceID: arg.ID, MatchedField: "public_id"}}, nil
+		},
+		LookupResourceByExternalIDFunc: func(ctx context.Context, arg db.LookupResourceByExternalIDParams) ([]db.LookupResourceByExternalIDRow, error) {
+			if arg.Email == "ops@example.edu" {
+				return []db.LookupResourceByExternalIDRow{{ResourceType: "account", MatchedField: "email"}}, nil
+			}
+			return nil, nil
+		},
+	}
+	svc := NewAdminService(mock, nil, audit.New(mock))
+	lookup := func(id string) []*libopsv1.ResourceMatch {
+		resp, err := svc.LookupResource(operatorContext(), connect.NewRequest(&libopsv1.LookupResourceRequest{Id: id}))
+		require.NoError(t, err)
+		return resp.Msg.Matches
+	}
+
+	siteID := uuid.NewString()
+	matches := lookup(siteID)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "site", matches[0].ResourceType)
+	assert.Equal(t, siteID, matches[0].ResourceId)
+
+	matches = lookup(" ops@example.edu ")
+	require.Len(t, matches, 1)
+	assert.Equal(t, "email", matches[0].MatchedField)
+	assert.Equal(t, 1, byPublicID, "non-UUIDs skip the public ID lookup")
+
+	_, err := svc.LookupResource(operatorContext(), connect.NewRequest(&libopsv1.LookupResourceRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
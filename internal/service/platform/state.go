@@ -0,0 +1,458 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/tfstate"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// stateBackupListLimit is how many of an organization's state backups are listed
+const stateBackupListLimit = 50
+
+// SetStateStore enables the Terraform state RPCs, which return
+// FailedPrecondition without a store.
+func (s *AdminService) SetStateStore(store tfstate.Store) {
+	s.states = store
+}
+
+// ListStateVersions lists the kept versions of an organization's Terraform
+// state and the backups taken of it.
+func (s *AdminService) ListStateVersions(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListStateVersionsRequest],
+) (*connect.Response[libopsv1.ListStateVersionsResponse], error) {
+	if s.states == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("terraform state management is not enabled"))
+	}
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := s.statePrefix(ctx, organization, req.Msg.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	bucket := tfstate.BucketName(organization.PublicID)
+
+	versions, err := s.states.Versions(ctx, bucket, tfstate.StateObjectAt(prefix))
+	if err != nil {
+		slog.Error("Failed to list terraform state versions", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	lock, err := s.states.Live(ctx, bucket, tfstate.LockObjectAt(prefix))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	backups, err := s.db.ListTerraformStateBackups(ctx, db.ListTerraformStateBackupsParams{
+		OrganizationID: organization.ID,
+		Limit:          stateBackupListLimit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	layout, err := tfstate.Layout(ctx, s.db, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListStateVersionsResponse{Bucket: bucket, Locked: lock != nil, Layout: stateLayoutToProto(layout)}
+	for _, version := range versions {
+		resp.Versions = append(resp.Versions, stateVersionToProto(version))
+	}
+	for _, backup := range backups {
+		resp.Backups = append(resp.Backups, stateBackupToProto(db.GetTerraformStateBackupRow(backup)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CopyStateBackup copies an organization's current Terraform state to a backup.
+func (s *AdminService) CopyStateBackup(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CopyStateBackupRequest],
+) (*connect.Response[libopsv1.CopyStateBackupResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if s.states == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("terraform state management is not enabled"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := s.statePrefix(ctx, organization, req.Msg.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := s.states.Live(ctx, tfstate.BucketName(organization.PublicID), tfstate.StateObjectAt(prefix))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if live == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no terraform state at %s", prefix))
+	}
+	backup, err := s.backupState(ctx, organization, prefix, *live, reason, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.TerraformStateBackup, map[string]any{
+		"backup_id":         backup.BackupId,
+		"workspace":         prefix,
+		"source_generation": backup.SourceGeneration,
+		"reason":            reason,
+	})
+	return connect.NewResponse(&libopsv1.CopyStateBackupResponse{Backup: backup}), nil
+}
+
+// RestoreState makes a kept state version or backup the organization's
+// current state. The copy only succeeds while the state is still the
+// generation that was backed up, so a run finishing in between isn't lost.
+func (s *AdminService) RestoreState(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RestoreStateRequest],
+) (*connect.Response[libopsv1.RestoreStateResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if s.states == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("terraform state management is not enabled"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := s.statePrefix(ctx, organization, req.Msg.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	bucket := tfstate.BucketName(organization.PublicID)
+	stateObject := tfstate.StateObjectAt(prefix)
+
+	lock, err := s.states.Live(ctx, bucket, tfstate.LockObjectAt(prefix))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if lock != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the state is locked by a running terraform operation"))
+	}
+
+	var (
+		object     string
+		generation int64
+		source     = map[string]any{"workspace": prefix}
+	)
+	switch from := req.Msg.Source.(type) {
+	case *libopsv1.RestoreStateRequest_Generation:
+		versions, err := s.states.Versions(ctx, bucket, stateObject)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		}
+		found := false
+		for _, version := range versions {
+			found = found || version.Generation == from.Generation
+		}
+		if !found {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("state version %d not found", from.Generation))
+		}
+		object, generation = stateObject, from.Generation
+		source["generation"] = from.Generation
+	case *libopsv1.RestoreStateRequest_BackupId:
+		if _, err := uuid.Parse(from.BackupId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid backup_id"))
+		}
+		backup, err := s.db.GetTerraformStateBackup(ctx, db.GetTerraformStateBackupParams{
+			PublicID:       from.BackupId,
+			OrganizationID: organization.ID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("state backup not found"))
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		object, generation = backup.Object, backup.Generation
+		source["backup_id"] = backup.PublicID
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("generation or backup_id is required"))
+	}
+
+	live, err := s.states.Live(ctx, bucket, stateObject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	var previous *libopsv1.TerraformStateBackup
+	var ifGeneration int64
+	if live != nil {
+		if object == stateObject && generation == live.Generation {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("state version %d is already the current state", generation))
+		}
+		previous, err = s.backupState(ctx, organization, prefix, *live, truncateReason("Before restore: "+reason), userInfo.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		ifGeneration = live.Generation
+	}
+
+	restored, err := s.states.Copy(ctx, bucket, object, generation, stateObject, ifGeneration)
+	if errors.Is(err, tfstate.ErrConflict) {
+		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("the state changed during the restore, try again"))
+	}
+	if err != nil {
+		slog.Error("Failed to restore terraform state", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	source["restored_generation"] = restored.Generation
+	source["reason"] = reason
+	if previous != nil {
+		source["previous_backup_id"] = previous.BackupId
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.TerraformStateRestore, source)
+	slog.Warn("Terraform state restored", "organization_id", organization.PublicID, "generation", restored.Generation, "account_id", userInfo.AccountID)
+
+	return connect.NewResponse(&libopsv1.RestoreStateResponse{
+		State:    stateVersionToProto(*restored),
+		Previous: previous,
+	}), nil
+}
+
+// MigrateStateWorkspaces starts splitting a monolithic organization's state
+// into a workspace per project and site. Other terraform runs for the
+// organization fail until the migration run finishes; a failed migration
+// leaves the organization monolithic.
+func (s *AdminService) MigrateStateWorkspaces(
+	ctx context.Context,
+	req *connect.Request[libopsv1.MigrateStateWorkspacesRequest],
+) (*connect.Response[libopsv1.MigrateStateWorkspacesResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if s.states == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("terraform state management is not enabled"))
+	}
+	reason, err := validateReason(req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := s.lookupOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := tfstate.Layout(ctx, s.db, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if layout != db.TerraformStateLayoutsLayoutMonolithic {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization state is already %s", layout))
+	}
+
+	bucket := tfstate.BucketName(organization.PublicID)
+	lock, err := s.states.Live(ctx, bucket, tfstate.LockObject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if lock != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the state is locked by a running terraform operation"))
+	}
+	live, err := s.states.Live(ctx, bucket, tfstate.StateObject)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if live == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization has no terraform state"))
+	}
+	backup, err := s.backupState(ctx, organization, tfstate.OrganizationPrefix, *live, truncateReason("Before workspace migration: "+reason), userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	runID := fmt.Sprintf("state-migration-%s-%s", now.Format("20060102-150405"), uuid.NewString()[:8])
+	started, err := s.db.StartTerraformStateMigration(ctx, db.StartTerraformStateMigrationParams{
+		OrganizationID: organization.ID,
+		RunID:          sql.NullString{String: runID, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if started == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization state is already being migrated"))
+	}
+
+	modulesJSON, _ := json.Marshal([]string{tfstate.MigrationModule})
+	eventIDsJSON, _ := json.Marshal([]string{"state-migration"})
+	_, err = s.db.CreateReconciliationRun(ctx, db.CreateReconciliationRunParams{
+		RunID:              runID,
+		OrganizationID:     sql.NullInt64{Int64: organization.ID, Valid: true},
+		RunType:            db.ReconciliationsRunTypeTerraform,
+		ReconciliationType: db.NullReconciliationsReconciliationType{},
+		Modules:            types.RawJSON(modulesJSON),
+		TargetSiteIds:      types.RawJSON("[]"),
+		EventIds:           eventIDsJSON,
+		FirstEventAt:       now,
+		LastEventAt:        now,
+	})
+	if err != nil {
+		slog.Error("Failed to queue terraform state migration", "error", err, "organization_id", organization.PublicID)
+		if _, err := s.db.FinishTerraformStateMigration(ctx, db.FinishTerraformStateMigrationParams{
+			Layout: db.TerraformStateLayoutsLayoutMonolithic,
+			RunID:  sql.NullString{String: runID, Valid: true},
+		}); err != nil {
+			slog.Error("Failed to reset terraform state layout", "error", err, "organization_id", organization.PublicID)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, organization.ID, audit.OrganizationEntityType, audit.TerraformStateMigrate, map[string]any{
+		"run_id":    runID,
+		"backup_id": backup.BackupId,
+		"reason":    reason,
+	})
+	return connect.NewResponse(&libopsv1.MigrateStateWorkspacesResponse{RunId: runID, Backup: backup}), nil
+}
+
+// backupState copies a generation of the state under prefix to a new backup
+// object and records it.
+func (s *AdminService) backupState(ctx context.Context, organization db.GetOrganizationRow, prefix string, live tfstate.Version, reason string, accountID int64) (*libopsv1.TerraformStateBackup, error) {
+	bucket := tfstate.BucketName(organization.PublicID)
+	publicID := uuid.NewString()
+	object := tfstate.BackupObject(prefix, publicID, s.now())
+
+	copied, err := s.states.Copy(ctx, bucket, tfstate.StateObjectAt(prefix), live.Generation, object, 0)
+	if err != nil {
+		slog.Error("Failed to back up terraform state", "error", err, "organization_id", organization.PublicID)
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	row := db.GetTerraformStateBackupRow{
+		PublicID:         publicID,
+		OrganizationID:   organization.ID,
+		Object:           object,
+		Generation:       copied.Generation,
+		SourceGeneration: live.Generation,
+		SizeBytes:        copied.SizeBytes,
+		Reason:           reason,
+		CreatedAt:        sql.NullTime{Time: s.now(), Valid: true},
+	}
+	err = s.db.CreateTerraformStateBackup(ctx, db.CreateTerraformStateBackupParams{
+		PublicID:         row.PublicID,
+		OrganizationID:   row.OrganizationID,
+		Object:           row.Object,
+		Generation:       row.Generation,
+		SourceGeneration: row.SourceGeneration,
+		SizeBytes:        row.SizeBytes,
+		Reason:           row.Reason,
+		CreatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return stateBackupToProto(row), nil
+}
+
+// statePrefix resolves a request's workspace to its backend prefix: the
+// organization's own state, or one of its projects' or sites' workspaces.
+func (s *AdminService) statePrefix(ctx context.Context, organization db.GetOrganizationRow, workspace string) (string, error) {
+	if workspace == "" {
+		return tfstate.OrganizationPrefix, nil
+	}
+	kind, publicID, _ := strings.Cut(workspace, "/")
+	switch kind {
+	case "projects":
+		project, err := service.GetProjectByPublicID(ctx, s.db, publicID)
+		if err != nil {
+			return "", err
+		}
+		if project.OrganizationID != organization.ID {
+			return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return tfstate.ProjectPrefix(project.PublicID), nil
+	case "sites":
+		site, err := service.GetSiteByPublicID(ctx, s.db, publicID)
+		if err != nil {
+			return "", err
+		}
+		project, err := s.db.GetProjectByID(ctx, site.ProjectID)
+		if err != nil {
+			return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if project.OrganizationID != organization.ID {
+			return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return tfstate.SitePrefix(site.PublicID), nil
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("workspace must be projects/<project ID> or sites/<site ID>"))
+	}
+}
+
+// truncateReason keeps a prefixed reason within terraform_state_backups.reason
+func truncateReason(reason string) string {
+	if len(reason) > maxReasonLength {
+		return strings.ToValidUTF8(reason[:maxReasonLength], "")
+	}
+	return reason
+}
+
+func stateLayoutToProto(layout db.TerraformStateLayoutsLayout) libopsv1.TerraformStateLayout {
+	switch layout {
+	case db.TerraformStateLayoutsLayoutMonolithic:
+		return libopsv1.TerraformStateLayout_TERRAFORM_STATE_LAYOUT_MONOLITHIC
+	case db.TerraformStateLayoutsLayoutMigrating:
+		return libopsv1.TerraformStateLayout_TERRAFORM_STATE_LAYOUT_MIGRATING
+	case db.TerraformStateLayoutsLayoutIsolated:
+		return libopsv1.TerraformStateLayout_TERRAFORM_STATE_LAYOUT_ISOLATED
+	default:
+		return libopsv1.TerraformStateLayout_TERRAFORM_STATE_LAYOUT_UNSPECIFIED
+	}
+}
+
+func stateVersionToProto(version tfstate.Version) *libopsv1.TerraformStateVersion {
+	return &libopsv1.TerraformStateVersion{
+		Generation: version.Generation,
+		SizeBytes:  version.SizeBytes,
+		UpdatedAt:  version.UpdatedAt.Unix(),
+		Live:       version.Live,
+	}
+}
+
+func stateBackupToProto(row db.GetTerraformStateBackupRow) *libopsv1.TerraformStateBackup {
+	backup := &libopsv1.TerraformStateBackup{
+		BackupId:         row.PublicID,
+		Object:           row.Object,
+		SourceGeneration: row.SourceGeneration,
+		SizeBytes:        row.SizeBytes,
+		Reason:           row.Reason,
+	}
+	if row.CreatedAt.Valid {
+		backup.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return backup
+}
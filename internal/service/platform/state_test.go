@@ -0,0 +1,236 @@
+package platform
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	"github.com/libops/api/internal/tfstate"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// fakeStates keeps each object's generations, oldest first
+type fakeStates struct {
+	objects    map[string][]tfstate.Version
+	generation int64
+}
+
+func (f *fakeStates) Versions(ctx context.Context, bucket, object string) ([]tfstate.Version, error) {
+	var versions []tfstate.Version
+	for i := len(f.objects[object]) - 1; i >= 0; i-- {
+		versions = append(versions, f.objects[object][i])
+	}
+	return versions, nil
+}
+
+func (f *fakeStates) Live(ctx context.Context, bucket, object string) (*tfstate.Version, error) {
+	for _, version := range f.objects[object] {
+		if version.Live {
+			return &version, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeStates) Copy(ctx context.Context, bucket, src string, generation int64, dst string, ifGeneration int64) (*tfstate.Version, error) {
+	found := false
+	for _, version := range f.objects[src] {
+		found = found || version.Generation == generation
+	}
+	if !found {
+		return nil, sql.ErrNoRows
+	}
+	live, _ := f.Live(ctx, bucket, dst)
+	if (live == nil && ifGeneration != 0) || (live != nil && live.Generation != ifGeneration) {
+		return nil, tfstate.ErrConflict
+	}
+	for i := range f.objects[dst] {
+		f.objects[dst][i].Live = false
+	}
+	f.generation++
+	version := tfstate.Version{Generation: f.generation, SizeBytes: 100, UpdatedAt: time.Now(), Live: true}
+	f.objects[dst] = append(f.objects[dst], version)
+	return &version, nil
+}
+
+// TestRestoreState tests that backups copy the current state, and that a
+// restore is refused while the state is locked, backs the current state up
+// first and makes the chosen version current.
+func TestRestoreState(t *testing.T) {
+	orgID := uuid.NewString()
+	backups := map[string]db.CreateTerraformStateBackupParams{}
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID}, nil
+		},
+		CreateTerraformStateBackupFunc: func(ctx context.Context, arg db.CreateTerraformStateBackupParams) error {
+			backups[arg.PublicID] = arg
+			return nil
+		},
+		GetTerraformStateBackupFunc: func(ctx context.Context, arg db.GetTerraformStateBackupParams) (db.GetTerraformStateBackupRow, error) {
+			b, ok := backups[arg.PublicID]
+			if !ok || b.OrganizationID != arg.OrganizationID {
+				return db.GetTerraformStateBackupRow{}, sql.ErrNoRows
+			}
+			return db.GetTerraformStateBackupRow{PublicID: b.PublicID, Object: b.Object, Generation: b.Generation, SourceGeneration: b.SourceGeneration}, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := operatorContext()
+
+	_, err := svc.ListStateVersions(ctx, connect.NewRequest(&libopsv1.ListStateVersionsRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "no store configured")
+
+	states := &fakeStates{generation: 2, objects: map[string][]tfstate.Version{
+		tfstate.StateObject: {{Generation: 1}, {Generation: 2, Live: true}},
+	}}
+	svc.SetStateStore(states)
+
+	listed, err := svc.ListStateVersions(ctx, connect.NewRequest(&libopsv1.ListStateVersionsRequest{OrganizationId: orgID}))
+	require.NoError(t, err)
+	assert.Equal(t, tfstate.BucketName(orgID), listed.Msg.Bucket)
+	require.Len(t, listed.Msg.Versions, 2)
+	assert.Equal(t, int64(2), listed.Msg.Versions[0].Generation)
+	assert.True(t, listed.Msg.Versions[0].Live)
+	assert.False(t, listed.Msg.Locked)
+
+	_, err = svc.CopyStateBackup(ctx, connect.NewRequest(&libopsv1.CopyStateBackupRequest{OrganizationId: orgID}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a reason is required")
+
+	backup, err := svc.CopyStateBackup(ctx, connect.NewRequest(&libopsv1.CopyStateBackupRequest{OrganizationId: orgID, Reason: "before import"}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), backup.Msg.Backup.SourceGeneration)
+	assert.True(t, strings.HasPrefix(backup.Msg.Backup.Object, "terraform/backups/"))
+	assert.Equal(t, "before import", backups[backup.Msg.Backup.BackupId].Reason)
+
+	states.objects[tfstate.LockObject] = []tfstate.Version{{Generation: 99, Live: true}}
+	_, err = svc.RestoreState(ctx, connect.NewRequest(&libopsv1.RestoreStateRequest{
+		OrganizationId: orgID,
+		Source:         &libopsv1.RestoreStateRequest_Generation{Generation: 1},
+		Reason:         "corrupted state",
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a run holds the lock")
+	delete(states.objects, tfstate.LockObject)
+
+	_, err = svc.RestoreState(ctx, connect.NewRequest(&libopsv1.RestoreStateRequest{
+		OrganizationId: orgID,
+		Source:         &libopsv1.RestoreStateRequest_Generation{Generation: 2},
+		Reason:         "corrupted state",
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "already the current state")
+
+	restored, err := svc.RestoreState(ctx, connect.NewRequest(&libopsv1.RestoreStateRequest{
+		OrganizationId: orgID,
+		Source:         &libopsv1.RestoreStateRequest_Generation{Generation: 1},
+		Reason:         "corrupted state",
+	}))
+	require.NoError(t, err)
+	assert.True(t, restored.Msg.State.Live)
+	require.NotNil(t, restored.Msg.Previous)
+	assert.Equal(t, int64(2), restored.Msg.Previous.SourceGeneration)
+	assert.Equal(t, "Before restore: corrupted state", backups[restored.Msg.Previous.BackupId].Reason)
+	live, _ := states.Live(ctx, "", tfstate.StateObject)
+	assert.Equal(t, restored.Msg.State.Generation, live.Generation)
+
+	fromBackup, err := svc.RestoreState(ctx, connect.NewRequest(&libopsv1.RestoreStateRequest{
+		OrganizationId: orgID,
+		Source:         &libopsv1.RestoreStateRequest_BackupId{BackupId: backup.Msg.Backup.BackupId},
+		Reason:         "undo",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, restored.Msg.State.Generation, fromBackup.Msg.Previous.SourceGeneration)
+
+	_, err = svc.RestoreState(ctx, connect.NewRequest(&libopsv1.RestoreStateRequest{
+		OrganizationId: orgID,
+		Source:         &libopsv1.RestoreStateRequest_BackupId{BackupId: uuid.NewString()},
+		Reason:         "undo",
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	assert.Equal(t, []string{
+		string(audit.TerraformStateBackup),
+		string(audit.TerraformStateRestore),
+		string(audit.TerraformStateRestore),
+	}, audited)
+}
+
+// TestMigrateStateWorkspaces tests that a migration backs up the organization's
+// state and queues a migration run, and is refused once the organization has
+// left the monolithic layout or when queueing the run fails.
+func TestMigrateStateWorkspaces(t *testing.T) {
+	orgID := uuid.NewString()
+	layout := db.TerraformStateLayout{OrganizationID: 7, Layout: db.TerraformStateLayoutsLayoutMonolithic}
+	var runs []db.CreateReconciliationRunParams
+	var finished []db.FinishTerraformStateMigrationParams
+	mock := &testutils.MockQuerier{
+		GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+			return db.GetOrganizationRow{ID: 7, PublicID: orgID}, nil
+		},
+		GetTerraformStateLayoutFunc: func(ctx context.Context, organizationID int64) (db.TerraformStateLayout, error) {
+			return layout, nil
+		},
+		StartTerraformStateMigrationFunc: func(ctx context.Context, arg db.StartTerraformStateMigrationParams) (int64, error) {
+			if layout.Layout != db.TerraformStateLayoutsLayoutMonolithic {
+				return 0, nil
+			}
+			layout.Layout = db.TerraformStateLayoutsLayoutMigrating
+			layout.MigrationRunID = arg.RunID
+			return 1, nil
+		},
+		FinishTerraformStateMigrationFunc: func(ctx context.Context, arg db.FinishTerraformStateMigrationParams) (int64, error) {
+			finished = append(finished, arg)
+			layout.Layout = arg.Layout
+			return 1, nil
+		},
+		CreateReconciliationRunFunc: func(ctx context.Context, arg db.CreateReconciliationRunParams) (sql.Result, error) {
+			if len(runs) > 0 {
+				return nil, sql.ErrConnDone
+			}
+			runs = append(runs, arg)
+			return nil, nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			return nil
+		},
+	}
+	svc := NewAdminService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	states := &fakeStates{generation: 2, objects: map[string][]tfstate.Version{
+		tfstate.StateObject: {{Generation: 2, Live: true}},
+	}}
+	svc.SetStateStore(states)
+	ctx := operatorContext()
+
+	migrated, err := svc.MigrateStateWorkspaces(ctx, connect.NewRequest(&libopsv1.MigrateStateWorkspacesRequest{OrganizationId: orgID, Reason: "isolate sites"}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), migrated.Msg.Backup.SourceGeneration)
+	require.Len(t, runs, 1)
+	assert.Equal(t, migrated.Msg.RunId, runs[0].RunID)
+	assert.JSONEq(t, `["state_migration"]`, string(runs[0].Modules))
+	assert.Equal(t, db.TerraformStateLayoutsLayoutMigrating, layout.Layout)
+
+	_, err = svc.MigrateStateWorkspaces(ctx, connect.NewRequest(&libopsv1.MigrateStateWorkspacesRequest{OrganizationId: orgID, Reason: "again"}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "already migrating")
+
+	layout.Layout = db.TerraformStateLayoutsLayoutMonolithic
+	_, err = svc.MigrateStateWorkspaces(ctx, connect.NewRequest(&libopsv1.MigrateStateWorkspacesRequest{OrganizationId: orgID, Reason: "retry"}))
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.Len(t, finished, 1)
+	assert.Equal(t, db.TerraformStateLayoutsLayoutMonolithic, finished[0].Layout, "a run that couldn't be queued leaves the organization monolithic")
+	assert.Equal(t, db.TerraformStateLayoutsLayoutMonolithic, layout.Layout)
+}
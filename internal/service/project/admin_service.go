@@ -16,6 +16,7 @@ import (
 	"github.com/libops/api/internal/auth"
 	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/catalog"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	adminv1 "github.com/libops/api/proto/libops/v1/admin"
@@ -71,7 +72,7 @@ func (s *AdminProjectService) GetProject(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
 	}
 
-	project, err := s.repo.GetProjectWithOrganizationByPublicID(ctx, publicID)
+	project, err := s.repo.GetProjectWithOrganizationByPublicID(service.ETagContext(ctx), publicID)
 	if err != nil {
 		return nil, err
 	}
@@ -90,15 +91,19 @@ func (s *AdminProjectService) GetProject(
 			DiskType:          service.FromNullString(project.DiskType),
 			Promote:           service.DbPromoteStrategyToProto(project.PromoteStrategy),
 			Status:            DbProjectStatusToProto(project.Status),
+			Labels:            service.FromJSONLabels(project.Labels),
+			Etag:              service.ETag(project.Version),
 		},
 		BillingAccount:   project.GcpBillingAccount,
 		GcpProjectId:     service.FromNullStringPtr(project.GcpProjectID),
 		GcpProjectNumber: service.FromNullStringPtr(project.GcpProjectNumber),
 	}
 
-	return connect.NewResponse(&libopsv1.AdminGetProjectResponse{
+	resp := connect.NewResponse(&libopsv1.AdminGetProjectResponse{
 		Project: protoProject,
-	}), nil
+	})
+	service.SetETag(resp.Header(), project.Version)
+	return resp, nil
 }
 
 // CreateProject creates a new project (admin - can set all fields).
@@ -137,6 +142,10 @@ func (s *AdminProjectService) CreateProject(
 		}
 	}
 
+	if err := validation.Labels(project.Config.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Validate zone matches region
 	if project.Config.Region != "" && project.Config.Zone != "" {
 		if err := validation.GCPZoneMatchesRegion(project.Config.Region, project.Config.Zone); err != nil {
@@ -161,6 +170,13 @@ func (s *AdminProjectService) CreateProject(
 		return nil, err
 	}
 
+	// Admins can't place a project outside the organization's data residency either
+	if project.Config.Region != "" {
+		if err := catalog.CheckResidency(ctx, s.repo.db, organization.ID, project.Config.Region); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate machine type and disk size
 	machineType := project.Config.MachineType
 	if machineType == "" {
@@ -237,6 +253,7 @@ func (s *AdminProjectService) CreateProject(
 		GcpProjectNumber:          service.ToNullString(service.PtrToString(project.GcpProjectNumber)),
 		CreateBranchSites:         sql.NullBool{Bool: project.Config.CreateBranchSites, Valid: true},
 		Status:                    db.NullProjectsStatus{ProjectsStatus: db.ProjectsStatusProvisioning, Valid: true},
+		Labels:                    service.LabelsToJSON(project.Config.Labels),
 		CreatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -264,6 +281,17 @@ func (s *AdminProjectService) CreateProject(
 	}), nil
 }
 
+// adminProjectUpdatePaths are the fields the admin UpdateProject can change.
+var adminProjectUpdatePaths = []string{
+	"project.config.project_name",
+	"project.config.machine_type",
+	"project.config.disk_size_gb",
+	"project.config.create_branch_sites",
+	"project.gcp_project_id",
+	"project.gcp_project_number",
+	"project.config.labels",
+}
+
 // UpdateProject updates project configuration (admin - can update all fields).
 func (s *AdminProjectService) UpdateProject(
 	ctx context.Context,
@@ -280,6 +308,22 @@ func (s *AdminProjectService) UpdateProject(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project configuration is required"))
 	}
 
+	// Region and zone cannot be updated after project creation. They aren't
+	// updatable paths, so wildcards and an empty mask leave them out, but
+	// naming one is refused rather than reported as an unknown path.
+	for _, path := range req.Msg.UpdateMask.GetPaths() {
+		switch path {
+		case "project.config.region":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region cannot be updated after project creation"))
+		case "project.config.zone":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("zone cannot be updated after project creation"))
+		}
+	}
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, adminProjectUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	if project.Config.ProjectName != "" {
 		if err := validation.StringLength("project_name", project.Config.ProjectName, 1, 255); err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -314,6 +358,9 @@ func (s *AdminProjectService) UpdateProject(
 	if err != nil {
 		return nil, err
 	}
+	if err := service.CheckETag(req.Header(), project.Config.Etag, existing.Version, "project"); err != nil {
+		return nil, err
+	}
 
 	name := existing.Name
 	gcpRegion := existing.GcpRegion
@@ -323,32 +370,32 @@ func (s *AdminProjectService) UpdateProject(
 	gcpProjectID := existing.GcpProjectID
 	gcpProjectNumber := existing.GcpProjectNumber
 	createBranchSites := existing.CreateBranchSites
+	labels := existing.Labels
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.project_name") {
+	if mask.Has("project.config.project_name") {
 		name = project.Config.ProjectName
 	}
-	// Region and zone cannot be updated after project creation
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.region") {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region cannot be updated after project creation"))
-	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.zone") {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("zone cannot be updated after project creation"))
-	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.machine_type") {
+	if mask.Has("project.config.machine_type") {
 		machineType = service.ToNullString(project.Config.MachineType)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.disk_size_gb") {
+	if mask.Has("project.config.disk_size_gb") {
 		diskSizeGb = service.ToNullInt32(project.Config.DiskSizeGb)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.config.create_branch_sites") {
+	if mask.Has("project.config.create_branch_sites") {
 		createBranchSites = sql.NullBool{Bool: project.Config.CreateBranchSites, Valid: true}
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.gcp_project_id") {
+	if mask.Has("project.gcp_project_id") {
 		gcpProjectID = service.ToNullString(service.PtrToString(project.GcpProjectId))
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.gcp_project_number") {
+	if mask.Has("project.gcp_project_number") {
 		gcpProjectNumber = service.ToNullString(service.PtrToString(project.GcpProjectNumber))
 	}
+	if mask.Has("project.config.labels") {
+		if err := validation.Labels(project.Config.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(project.Config.Labels)
+	}
 
 	params := db.UpdateProjectParams{
 		Name:                      name,
@@ -364,8 +411,10 @@ func (s *AdminProjectService) UpdateProject(
 		GcpProjectNumber:          gcpProjectNumber,
 		CreateBranchSites:         createBranchSites,
 		Status:                    db.NullProjectsStatus{ProjectsStatus: db.ProjectsStatusActive, Valid: true},
+		Labels:                    labels,
 		UpdatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:                  publicID.String(),
+		Version:                   existing.Version,
 	}
 
 	err = s.repo.UpdateProject(ctx, params)
@@ -373,9 +422,12 @@ func (s *AdminProjectService) UpdateProject(
 		return nil, err
 	}
 
-	return connect.NewResponse(&libopsv1.AdminUpdateProjectResponse{
+	project.Config.Etag = service.ETag(existing.Version + 1)
+	resp := connect.NewResponse(&libopsv1.AdminUpdateProjectResponse{
 		Project: project,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteProject deletes a project (must have no sites).
@@ -499,6 +551,7 @@ func (s *AdminProjectService) ListProjects(
 				DiskType:          service.FromNullString(project.DiskType),
 				Promote:           commonv1.PromoteStrategy_PROMOTE_STRATEGY_GITHUB_TAG,
 				Status:            DbProjectStatusToProto(project.Status),
+				Labels:            service.FromJSONLabels(project.Labels),
 			},
 			BillingAccount:   "",
 			GcpProjectId:     service.FromNullStringPtr(project.GcpProjectID),
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/service/organization"
 	"github.com/libops/api/internal/validation"
@@ -19,7 +20,8 @@ import (
 
 // ProjectFirewallService implements the LibOps ProjectFirewallService API.
 type ProjectFirewallService struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // Compile-time check.
@@ -28,7 +30,8 @@ var _ libopsv1connect.ProjectFirewallServiceHandler = (*ProjectFirewallService)(
 // NewProjectFirewallService creates a new ProjectFirewallService instance.
 func NewProjectFirewallService(querier db.Querier) *ProjectFirewallService {
 	return &ProjectFirewallService{
-		db: querier,
+		db:     querier,
+		quotas: quota.NewEnforcer(querier),
 	}
 }
 
@@ -101,6 +104,14 @@ func (s *ProjectFirewallService) CreateProjectFirewallRule(
 		return nil, err
 	}
 
+	ruleCount, err := s.db.CountProjectFirewallRules(ctx, sql.NullInt64{Int64: project.ID, Valid: true})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count firewall rules: %w", err))
+	}
+	if err := s.quotas.Check(ctx, project.OrganizationID, quota.FirewallRules, ruleCount); err != nil {
+		return nil, err
+	}
+
 	params := db.CreateProjectFirewallRuleParams{
 		ProjectID: sql.NullInt64{Int64: project.ID, Valid: true},
 		Name:      req.Msg.Name,
@@ -12,6 +12,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/notify"
 	"github.com/libops/api/internal/reconciler"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
@@ -23,16 +25,20 @@ import (
 type ProjectMemberService struct {
 	db          db.Querier
 	connManager *reconciler.ConnectionManager
+	notifier    *notify.Notifier
+	inviter     *invite.Inviter
 }
 
 // Compile-time check.
 var _ libopsv1connect.ProjectMemberServiceHandler = (*ProjectMemberService)(nil)
 
 // NewProjectMemberService creates a new ProjectMemberService instance.
-func NewProjectMemberService(querier db.Querier, connManager *reconciler.ConnectionManager) *ProjectMemberService {
+func NewProjectMemberService(querier db.Querier, connManager *reconciler.ConnectionManager, notifier *notify.Notifier, inviter *invite.Inviter) *ProjectMemberService {
 	return &ProjectMemberService{
 		db:          querier,
 		connManager: connManager,
+		notifier:    notifier,
+		inviter:     inviter,
 	}
 }
 
@@ -72,6 +78,7 @@ func (s *ProjectMemberService) ListProjectMembers(
 			Role:           string(member.Role),
 			GithubUsername: service.FromNullStringPtr(member.GithubUsername),
 			Status:         service.DbProjectMemberStatusToProto(member.Status),
+			ExpiresAt:      service.ExpiryToProto(member.ExpiresAt),
 		})
 	}
 
@@ -87,25 +94,49 @@ func (s *ProjectMemberService) CreateProjectMember(
 	req *connect.Request[libopsv1.CreateProjectMemberRequest],
 ) (*connect.Response[libopsv1.CreateProjectMemberResponse], error) {
 	projectID := req.Msg.ProjectId
-	accountID := req.Msg.AccountId
 
 	if err := validation.UUID(projectID); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := validation.UUID(accountID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	accountID, err := service.ResolveMemberAccountID(ctx, s.db, req.Msg.AccountId, req.Msg.Email)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := validation.RequiredString("role", req.Msg.Role); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	expiresAt, err := service.ParseExpiry(req.Msg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid && accountID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at can only be set once they have an account"))
+	}
+
 	project, err := service.GetProjectByPublicID(ctx, s.db, projectID)
 	if err != nil {
 		return nil, err
 	}
 
+	if accountID == "" {
+		invitation, err := service.InviteMember(ctx, s.inviter, invite.Request{
+			Email:        req.Msg.Email,
+			ResourceType: "project",
+			ResourceID:   project.ID,
+			ResourceName: project.Name,
+			Role:         req.Msg.Role,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&libopsv1.CreateProjectMemberResponse{
+			Invitation: invitation,
+		}), nil
+	}
+
 	accountUUID, err := uuid.Parse(accountID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
@@ -139,6 +170,17 @@ func (s *ProjectMemberService) CreateProjectMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	if expiresAt.Valid {
+		err = s.db.SetProjectMemberExpiry(ctx, db.SetProjectMemberExpiryParams{
+			ExpiresAt: expiresAt,
+			ProjectID: project.ID,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
 	// Trigger reconciliation via WebSocket if owner/developer role
 	if s.connManager != nil && (req.Msg.Role == "owner" || req.Msg.Role == "developer") {
 		// Get all sites in this project
@@ -167,6 +209,10 @@ func (s *ProjectMemberService) CreateProjectMember(
 		}
 	}
 
+	if s.notifier != nil {
+		s.notifier.MemberAdded(ctx, account.ID, "project", project.Name, req.Msg.Role, "/projects/"+projectID)
+	}
+
 	member := &libopsv1.MemberDetail{
 		AccountId:      accountID,
 		Email:          account.Email,
@@ -174,6 +220,7 @@ func (s *ProjectMemberService) CreateProjectMember(
 		Role:           req.Msg.Role,
 		Status:         service.DbStatusToProto(string(status)),
 		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+		ExpiresAt:      service.ExpiryToProto(expiresAt),
 	}
 
 	return connect.NewResponse(&libopsv1.CreateProjectMemberResponse{
@@ -197,8 +244,14 @@ func (s *ProjectMemberService) UpdateProjectMember(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := validation.RequiredString("role", req.Msg.Role); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, service.MemberUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+	if mask.Has("role") {
+		if err := validation.RequiredString("role", req.Msg.Role); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 	}
 
 	project, err := service.GetProjectByPublicID(ctx, s.db, projectID)
@@ -219,10 +272,35 @@ func (s *ProjectMemberService) UpdateProjectMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	existing, err := s.db.GetProjectMember(ctx, db.GetProjectMemberParams{
+		ProjectID: project.ID,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	role := existing.Role
+	if mask.Has("role") {
+		role = db.ProjectMembersRole(req.Msg.Role)
+	}
+
+	expiresAt := existing.ExpiresAt
+	updateExpiry := req.Msg.ExpiresAt != nil && mask.Has("expires_at")
+	if updateExpiry {
+		expiresAt, err = service.ParseExpiry(req.Msg.GetExpiresAt())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	params := db.UpdateProjectMemberParams{
 		ProjectID: project.ID,
 		AccountID: account.ID,
-		Role:      db.ProjectMembersRole(req.Msg.Role),
+		Role:      role,
 	}
 
 	err = s.db.UpdateProjectMember(ctx, params)
@@ -230,12 +308,24 @@ func (s *ProjectMemberService) UpdateProjectMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	if updateExpiry {
+		err = s.db.SetProjectMemberExpiry(ctx, db.SetProjectMemberExpiryParams{
+			ExpiresAt: expiresAt,
+			ProjectID: project.ID,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
 	member := &libopsv1.MemberDetail{
 		AccountId:      accountID,
 		Email:          account.Email,
 		Name:           service.FromNullString(account.Name),
-		Role:           req.Msg.Role,
+		Role:           string(role),
 		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+		ExpiresAt:      service.ExpiryToProto(expiresAt),
 	}
 
 	return connect.NewResponse(&libopsv1.UpdateProjectMemberResponse{
@@ -0,0 +1,262 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/catalog"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// defaultDiskSizeGB matches the projects.disk_size_gb column default.
+const defaultDiskSizeGB = 20
+
+// resizeModules are the terraform modules that consume a project's machine type and disk size.
+var resizeModules = []string{"project", "site"}
+
+// ChangePlan upgrades or downgrades a project's machine type and/or disk size.
+// Stripe prorates the change, the project's sites inherit the new plan, and a
+// terraform reconciliation run is queued to resize them.
+func (s *ProjectService) ChangePlan(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ChangePlanRequest],
+) (*connect.Response[libopsv1.ChangePlanResponse], error) {
+	projectID := req.Msg.ProjectId
+
+	if err := validation.UUID(projectID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.MachineType == "" && req.Msg.DiskSizeGb == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("machine_type or disk_size_gb is required"))
+	}
+	if req.Msg.DiskSizeGb < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("disk_size_gb must be positive"))
+	}
+
+	publicID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
+	}
+
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	existing, err := s.repo.GetProjectByPublicID(ctx, publicID)
+	if err != nil {
+		slog.Error("Failed to get project by public ID for plan change", "error", err, "project_id", projectID)
+		return nil, err
+	}
+
+	oldMachineType := existing.MachineType.String
+	oldDiskSize := int32(defaultDiskSizeGB)
+	if existing.DiskSizeGb.Valid {
+		oldDiskSize = existing.DiskSizeGb.Int32
+	}
+
+	newMachineType := oldMachineType
+	if req.Msg.MachineType != "" {
+		newMachineType = req.Msg.MachineType
+	}
+	newDiskSize := oldDiskSize
+	if req.Msg.DiskSizeGb > 0 {
+		newDiskSize = req.Msg.DiskSizeGb
+	}
+
+	machineTypeChanged := newMachineType != oldMachineType
+	diskSizeChanged := newDiskSize != oldDiskSize
+	if !machineTypeChanged && !diskSizeChanged {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("project is already on the requested plan"))
+	}
+
+	if machineTypeChanged {
+		if err := s.billingManager.ValidateMachineType(ctx, newMachineType); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if existing.GcpRegion.Valid {
+			if err := catalog.CheckMachineTypeAvailable(ctx, s.repo.db, existing.GcpRegion.String, newMachineType); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if diskSizeChanged {
+		// Persistent disks can grow in place but never shrink
+		if newDiskSize < oldDiskSize {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("disk size cannot be reduced from %d GB to %d GB", oldDiskSize, newDiskSize))
+		}
+		if err := s.billingManager.ValidateDiskSize(ctx, int(newDiskSize)); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	stripeSubItemID := existing.StripeSubscriptionItemID
+	diskBilled, machineBilled := false, false
+
+	// undoBilling puts the subscription back on the old plan when the change
+	// fails after Stripe was updated, so the customer isn't billed for a
+	// resize that never happens. Swapping the machine back creates another
+	// subscription item, which is recorded outside the request's transaction
+	// since that rolls back. It only undoes once.
+	undoBilling := func() {
+		if machineBilled {
+			itemID, err := s.billingManager.UpdateProjectMachine(ctx, stripeSubItemID.String, oldMachineType, existing.Name, existing.OrganizationID)
+			if err != nil {
+				slog.Error("Failed to roll back machine type in Stripe", "error", err, "project_id", projectID)
+			} else if err := s.repo.db.UpdateProjectStripeSubscriptionItem(database.WithoutTx(ctx), db.UpdateProjectStripeSubscriptionItemParams{
+				StripeSubscriptionItemID: sql.NullString{String: itemID, Valid: true},
+				ID:                       existing.ID,
+			}); err != nil {
+				slog.Error("Failed to record rolled back machine subscription item", "error", err, "project_id", projectID, "stripe_item_id", itemID)
+			}
+		}
+		if diskBilled {
+			if err := s.billingManager.UpdateProjectDiskSize(ctx, existing.OrganizationID, int(newDiskSize), int(oldDiskSize)); err != nil {
+				slog.Error("Failed to roll back disk size in Stripe", "error", err, "project_id", projectID)
+			}
+		}
+		diskBilled, machineBilled = false, false
+	}
+
+	if diskSizeChanged {
+		err := s.billingManager.UpdateProjectDiskSize(ctx, existing.OrganizationID, int(oldDiskSize), int(newDiskSize))
+		if err != nil {
+			slog.Error("Failed to update disk size in Stripe", "error", err, "project_id", projectID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update billing: %w", err))
+		}
+		diskBilled = true
+	}
+
+	if machineTypeChanged && existing.StripeSubscriptionItemID.Valid && existing.StripeSubscriptionItemID.String != "" {
+		newItemID, err := s.billingManager.UpdateProjectMachine(
+			ctx,
+			existing.StripeSubscriptionItemID.String,
+			newMachineType,
+			existing.Name,
+			existing.OrganizationID,
+		)
+		if err != nil {
+			slog.Error("Failed to update machine type in Stripe", "error", err, "project_id", projectID)
+			undoBilling()
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update billing: %w", err))
+		}
+		stripeSubItemID = sql.NullString{String: newItemID, Valid: true}
+		machineBilled = true
+	}
+	// The change can still be rolled back after this returns, when its event
+	// can't be recorded or the transaction doesn't commit
+	store.OnRollback(ctx, func(context.Context) { undoBilling() })
+
+	// Sites inherit machine type and disk size from their project
+	params := db.UpdateProjectParams{
+		Name:                      existing.Name,
+		GcpRegion:                 existing.GcpRegion,
+		GcpZone:                   existing.GcpZone,
+		MachineType:               sql.NullString{String: newMachineType, Valid: newMachineType != ""},
+		DiskSizeGb:                sql.NullInt32{Int32: newDiskSize, Valid: true},
+		Os:                        existing.Os,
+		DiskType:                  existing.DiskType,
+		StripeSubscriptionItemID:  stripeSubItemID,
+		MonitoringEnabled:         existing.MonitoringEnabled,
+		MonitoringLogLevel:        existing.MonitoringLogLevel,
+		MonitoringMetricsEnabled:  existing.MonitoringMetricsEnabled,
+		MonitoringHealthCheckPath: existing.MonitoringHealthCheckPath,
+		GcpProjectID:              existing.GcpProjectID,
+		GcpProjectNumber:          existing.GcpProjectNumber,
+		CreateBranchSites:         existing.CreateBranchSites,
+		Status:                    existing.Status,
+		Labels:                    existing.Labels,
+		UpdatedBy:                 sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:                  publicID.String(),
+		Version:                   existing.Version,
+	}
+	if err := s.repo.UpdateProject(ctx, params); err != nil {
+		slog.Error("Failed to update project plan in DB", "error", err, "project_id", projectID)
+		undoBilling()
+		return nil, err
+	}
+
+	runID, err := s.createResizeRun(ctx, existing)
+	if err != nil {
+		// The request's transaction rolls the plan back with this error
+		slog.Error("Failed to create resize reconciliation run", "error", err, "project_id", projectID)
+		undoBilling()
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to schedule resize: %w", err))
+	}
+
+	slog.Info("Project plan changed",
+		"project_id", projectID,
+		"old_machine_type", oldMachineType,
+		"new_machine_type", newMachineType,
+		"old_disk_gb", oldDiskSize,
+		"new_disk_gb", newDiskSize,
+		"run_id", runID,
+		"account_id", userInfo.AccountID)
+
+	return connect.NewResponse(&libopsv1.ChangePlanResponse{
+		Project: &commonv1.ProjectConfig{
+			OrganizationId:    fmt.Sprintf("%d", existing.OrganizationID),
+			ProjectId:         existing.PublicID,
+			ProjectName:       existing.Name,
+			CreateBranchSites: existing.CreateBranchSites.Bool,
+			Region:            service.FromNullString(existing.GcpRegion),
+			Zone:              service.FromNullString(existing.GcpZone),
+			MachineType:       newMachineType,
+			DiskSizeGb:        newDiskSize,
+			Os:                service.FromNullString(existing.Os),
+			DiskType:          service.FromNullString(existing.DiskType),
+			Promote:           service.DbPromoteStrategyToProto(existing.PromoteStrategy),
+			Status:            DbProjectStatusToProto(existing.Status),
+			Labels:            service.FromJSONLabels(existing.Labels),
+		},
+		RunId: runID,
+	}), nil
+}
+
+// createResizeRun queues a project-scoped terraform run that applies the new plan to the project's sites.
+func (s *ProjectService) createResizeRun(ctx context.Context, project db.GetProjectRow) (string, error) {
+	runID := fmt.Sprintf("plan-%s-%s", time.Now().Format("20060102-150405"), uuid.New().String()[:8])
+
+	modulesJSON, err := json.Marshal(resizeModules)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal modules: %w", err)
+	}
+	eventIDsJSON, err := json.Marshal([]string{"plan-change"})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal event IDs: %w", err)
+	}
+
+	now := time.Now()
+	_, err = s.repo.db.CreateReconciliationRun(ctx, db.CreateReconciliationRunParams{
+		RunID:              runID,
+		OrganizationID:     sql.NullInt64{Int64: project.OrganizationID, Valid: true},
+		ProjectID:          sql.NullInt64{Int64: project.ID, Valid: true},
+		RunType:            db.ReconciliationsRunTypeTerraform,
+		ReconciliationType: db.NullReconciliationsReconciliationType{},
+		Modules:            types.RawJSON(modulesJSON),
+		TargetSiteIds:      types.RawJSON("[]"),
+		EventIds:           eventIDsJSON,
+		FirstEventAt:       now,
+		LastEventAt:        now,
+	})
+	if err != nil {
+		return "", err
+	}
+	return runID, nil
+}
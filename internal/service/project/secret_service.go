@@ -14,9 +14,9 @@ import (
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/envelope"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/service/organization"
-	"github.com/libops/api/internal/validation"
 	"github.com/libops/api/internal/vault"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
@@ -40,31 +40,6 @@ func NewProjectSecretService(querier db.Querier, auditLogger *audit.Logger) *Pro
 	}
 }
 
-// GetProjectVaultClient returns or creates a Vault client for the project's organization.
-func (s *ProjectSecretService) GetProjectVaultClient(ctx context.Context, organizationID int64) (*vault.Client, error) {
-	project, err := s.db.GetOrganizationProjectByOrganizationID(ctx, organizationID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get organization project: %w", err)
-	}
-
-	var projectNumber int64
-	if project.GcpProjectNumber.Valid {
-		_, _ = fmt.Sscanf(project.GcpProjectNumber.String, "%d", &projectNumber)
-	}
-
-	region := "us-central1" // default
-	if project.GcpRegion.Valid && project.GcpRegion.String != "" {
-		region = project.GcpRegion.String
-	}
-
-	client, err := vault.NewCustomerVaultClient(ctx, organizationID, projectNumber, region)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create customer vault client: %w", err)
-	}
-
-	return client, nil
-}
-
 // authorizeProjectSecretRead checks if user can read project secrets
 // Requires: any project membership OR any organization membership.
 func (s *ProjectSecretService) authorizeProjectSecretRead(ctx context.Context, projectID, organizationID int64) (*auth.UserInfo, error) {
@@ -107,12 +82,9 @@ func (s *ProjectSecretService) CreateProjectSecret(
 	if err := organization.ValidateSecretName(req.Msg.Name); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	if err := validation.RequiredString("value", req.Msg.Value); err != nil {
+	if err := organization.ValidateSecretValue(req.Msg.Value, req.Msg.Reference); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	if len(req.Msg.Value) > 65536 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-	}
 
 	projectUUID, err := uuid.Parse(req.Msg.ProjectId)
 	if err != nil {
@@ -147,43 +119,50 @@ func (s *ProjectSecretService) CreateProjectSecret(
 
 	vaultPath := vault.BuildProjectSecretPath(projectUUID.String(), req.Msg.Name)
 
-	vaultClient, err := s.GetProjectVaultClient(ctx, project.OrganizationID)
-	if err != nil {
-		slog.Error("failed to get vault client", "err", err, "organization_id", project.OrganizationID)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-	}
+	// Write to the organization's Vault, unless the value is kept in an
+	// external store the controller resolves it from
+	var vaultClient *envelope.Store
+	var keyVersion sql.NullInt32
+	if req.Msg.Reference == "" {
+		vaultClient, err = service.OrganizationSecretStore(ctx, s.db, project.OrganizationID)
+		if err != nil {
+			slog.Error("failed to get vault client", "err", err, "organization_id", project.OrganizationID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+		}
 
-	err = vaultClient.WriteSecret(ctx, vaultPath, map[string]any{
-		"value": req.Msg.Value,
-	})
-	if err != nil {
-		slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
-		// Audit log for vault failure
-		s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretCreateFailed, map[string]any{
-			"secret_name": req.Msg.Name,
-			"vault_path":  vaultPath,
-			"error":       "vault_write_failed",
-			"error_msg":   err.Error(),
+		err = service.WriteSecret(ctx, vaultClient, vaultPath, map[string]any{
+			"value": req.Msg.Value,
 		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		if err != nil {
+			slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
+			// Audit log for vault failure
+			s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretCreateFailed, map[string]any{
+				"secret_name": req.Msg.Name,
+				"vault_path":  vaultPath,
+				"error":       "vault_write_failed",
+				"error_msg":   err.Error(),
+			})
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		}
+		keyVersion = sql.NullInt32{Int32: vaultClient.KeyVersion(), Valid: true}
 	}
 
 	secretUUID := uuid.New()
 	now := time.Now().Unix()
 	_, err = s.db.CreateProjectSecret(ctx, db.CreateProjectSecretParams{
-		PublicID:  secretUUID.String(),
-		ProjectID: project.ID,
-		Name:      req.Msg.Name,
-		VaultPath: vaultPath,
-		Status:    db.NullProjectSecretsStatus{ProjectSecretsStatus: db.ProjectSecretsStatusActive, Valid: true},
-		CreatedAt: now,
-		UpdatedAt: now,
-		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   secretUUID.String(),
+		ProjectID:  project.ID,
+		Name:       req.Msg.Name,
+		VaultPath:  vaultPath,
+		Status:     db.NullProjectSecretsStatus{ProjectSecretsStatus: db.ProjectSecretsStatusActive, Valid: true},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		CreatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		KeyVersion: keyVersion,
+		Reference:  sql.NullString{String: req.Msg.Reference, Valid: req.Msg.Reference != ""},
 	})
 	if err != nil {
-		// Rollback: delete from Vault
-		_ = vaultClient.DeleteSecret(ctx, vaultPath)
 		slog.Error("failed to create secret record", "err", err)
 		// Audit log for database failure
 		s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretCreateFailed, map[string]any{
@@ -209,14 +188,17 @@ func (s *ProjectSecretService) CreateProjectSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  secret.VaultPath,
+		"reference":   req.Msg.Reference,
 	})
 
 	return connect.NewResponse(&libopsv1.CreateProjectSecretResponse{
 		Secret: &libopsv1.ProjectSecret{
-			SecretId:  secret.PublicID,
-			ProjectId: projectUUID.String(),
-			Name:      secret.Name,
-			Status:    dbProjectStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			ProjectId:  projectUUID.String(),
+			Name:       secret.Name,
+			Status:     dbProjectStatusToProto(secret.Status),
+			KeyVersion: keyVersion.Int32,
+			Reference:  req.Msg.Reference,
 		},
 	}), nil
 }
@@ -263,10 +245,12 @@ func (s *ProjectSecretService) GetProjectSecret(
 
 	return connect.NewResponse(&libopsv1.GetProjectSecretResponse{
 		Secret: &libopsv1.ProjectSecret{
-			SecretId:  secret.PublicID,
-			ProjectId: projectUUID.String(),
-			Name:      secret.Name,
-			Status:    dbProjectStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			ProjectId:  projectUUID.String(),
+			Name:       secret.Name,
+			Status:     dbProjectStatusToProto(secret.Status),
+			KeyVersion: secret.KeyVersion.Int32,
+			Reference:  secret.Reference.String,
 		},
 	}), nil
 }
@@ -317,6 +301,7 @@ func (s *ProjectSecretService) ListProjectSecrets(
 			ProjectId: projectUUID.String(),
 			Name:      secret.Name,
 			Status:    dbProjectStatusToProto(secret.Status),
+			Reference: secret.Reference.String,
 		}
 	}
 
@@ -341,6 +326,11 @@ func (s *ProjectSecretService) UpdateProjectSecret(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid secret_id"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, organization.SecretUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get user info (authorization already done by scope interceptor)
 	userInfo, ok := auth.GetUserFromContext(ctx)
 	if !ok || userInfo == nil {
@@ -367,52 +357,35 @@ func (s *ProjectSecretService) UpdateProjectSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to project"))
 	}
 
-	if req.Msg.Value != nil && *req.Msg.Value != "" {
-		if len(*req.Msg.Value) > 65536 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-		}
-
-		vaultClient, err := s.GetProjectVaultClient(ctx, project.OrganizationID)
-		if err != nil {
-			slog.Error("failed to get vault client", "err", err)
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-		}
-
-		err = vaultClient.WriteSecret(ctx, secret.VaultPath, map[string]any{
-			"value": *req.Msg.Value,
-		})
-		if err != nil {
-			slog.Error("failed to update secret in vault", "err", err)
-			// Audit log for vault failure
-			s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "vault_write_failed",
-				"error_msg":   err.Error(),
+	value, reference := "", ""
+	if mask.Has("value") {
+		value = req.Msg.GetValue()
+	}
+	if mask.Has("reference") {
+		reference = req.Msg.GetReference()
+	}
+	err = organization.ApplySecretChange(ctx, s.db, organization.SecretChange{
+		OrganizationID: project.OrganizationID,
+		VaultPath:      secret.VaultPath,
+		HadReference:   secret.Reference.Valid,
+		Save: func(reference sql.NullString, keyVersion sql.NullInt32) error {
+			return s.db.UpdateProjectSecret(ctx, db.UpdateProjectSecretParams{
+				VaultPath:  secret.VaultPath,
+				Reference:  reference,
+				KeyVersion: keyVersion,
+				UpdatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+				UpdatedAt:  time.Now().Unix(),
+				ID:         secret.ID,
 			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
-
-		now := time.Now().Unix()
-		err = s.db.UpdateProjectSecret(ctx, db.UpdateProjectSecretParams{
-			VaultPath: secret.VaultPath,
-			UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-			UpdatedAt: now,
-			ID:        secret.ID,
-		})
-		if err != nil {
-			slog.Error("failed to update secret record", "err", err)
-			// Audit log for database failure
-			s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "database_update_failed",
-				"error_msg":   err.Error(),
-			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
+		},
+		Failed: func(data map[string]any) {
+			data["secret_id"] = secret.PublicID
+			data["secret_name"] = secret.Name
+			s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretUpdateFailed, data)
+		},
+	}, value, reference)
+	if err != nil {
+		return nil, err
 	}
 
 	secret, err = s.db.GetProjectSecretByPublicID(ctx, secretUUID.String())
@@ -425,14 +398,17 @@ func (s *ProjectSecretService) UpdateProjectSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  secret.VaultPath,
+		"reference":   secret.Reference.String,
 	})
 
 	return connect.NewResponse(&libopsv1.UpdateProjectSecretResponse{
 		Secret: &libopsv1.ProjectSecret{
-			SecretId:  secret.PublicID,
-			ProjectId: projectUUID.String(),
-			Name:      secret.Name,
-			Status:    dbProjectStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			ProjectId:  projectUUID.String(),
+			Name:       secret.Name,
+			Status:     dbProjectStatusToProto(secret.Status),
+			KeyVersion: secret.KeyVersion.Int32,
+			Reference:  secret.Reference.String,
 		},
 	}), nil
 }
@@ -478,26 +454,12 @@ func (s *ProjectSecretService) DeleteProjectSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to project"))
 	}
 
-	vaultClient, err := s.GetProjectVaultClient(ctx, project.OrganizationID)
+	vaultClient, err := service.OrganizationSecretStore(ctx, s.db, project.OrganizationID)
 	if err != nil {
 		slog.Error("failed to get vault client", "err", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
 	}
 
-	err = vaultClient.DeleteSecret(ctx, secret.VaultPath)
-	if err != nil {
-		slog.Error("failed to delete secret from vault", "err", err)
-		// Audit log for vault failure
-		s.auditLogger.Log(ctx, userInfo.AccountID, project.ID, audit.ProjectEntityType, audit.ProjectSecretDeleteFailed, map[string]any{
-			"secret_id":   secret.PublicID,
-			"secret_name": secret.Name,
-			"vault_path":  secret.VaultPath,
-			"error":       "vault_delete_failed",
-			"error_msg":   err.Error(),
-		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
-	}
-
 	now := time.Now().Unix()
 	err = s.db.DeleteProjectSecret(ctx, db.DeleteProjectSecretParams{
 		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
@@ -516,6 +478,7 @@ func (s *ProjectSecretService) DeleteProjectSecret(
 		})
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
 	}
+	service.DeleteSecret(ctx, vaultClient, secret.VaultPath)
 
 	// Audit log for success
 	s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.ProjectEntityType, audit.ProjectSecretDeleteSuccess, map[string]any{
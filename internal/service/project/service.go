@@ -14,6 +14,7 @@ import (
 	"github.com/libops/api/internal/auth"
 	"github.com/libops/api/internal/billing"
 	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/catalog"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
@@ -78,7 +79,7 @@ func (s *ProjectService) GetProject(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
 	}
 
-	project, err := s.repo.GetProjectByPublicID(ctx, publicID)
+	project, err := s.repo.GetProjectByPublicID(service.ETagContext(ctx), publicID)
 	if err != nil {
 		slog.Error("Failed to get project by public ID", "error", err, "project_id", projectID)
 		return nil, err
@@ -97,11 +98,15 @@ func (s *ProjectService) GetProject(
 		DiskType:          service.FromNullString(project.DiskType),
 		Promote:           service.DbPromoteStrategyToProto(project.PromoteStrategy),
 		Status:            DbProjectStatusToProto(project.Status),
+		Labels:            service.FromJSONLabels(project.Labels),
+		Etag:              service.ETag(project.Version),
 	}
 
-	return connect.NewResponse(&libopsv1.GetProjectResponse{
+	resp := connect.NewResponse(&libopsv1.GetProjectResponse{
 		Project: protoProject,
-	}), nil
+	})
+	service.SetETag(resp.Header(), project.Version)
+	return resp, nil
 }
 
 // CreateProject creates a new project (organization).
@@ -129,6 +134,10 @@ func (s *ProjectService) CreateProject(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	if err := validation.Labels(project.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Validate zone matches region
 	if project.Region != "" && project.Zone != "" {
 		if err := validation.GCPZoneMatchesRegion(project.Region, project.Zone); err != nil {
@@ -136,6 +145,12 @@ func (s *ProjectService) CreateProject(
 		}
 	}
 
+	if project.Region != "" {
+		if err := catalog.ValidateRegion(ctx, s.repo.db, "", project.Region); err != nil {
+			return nil, err
+		}
+	}
+
 	organizationPublicID, err := uuid.Parse(organizationID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid organization_id format: %w", err))
@@ -153,6 +168,12 @@ func (s *ProjectService) CreateProject(
 		return nil, err
 	}
 
+	if project.Region != "" {
+		if err := catalog.CheckResidency(ctx, s.repo.db, organization.ID, project.Region); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate project limit for this organization
 	if err := s.repo.ValidateProjectLimit(ctx, organization.ID); err != nil {
 		return nil, err
@@ -163,6 +184,11 @@ func (s *ProjectService) CreateProject(
 	if machineType == "" {
 		machineType = "e2-medium" // Default
 	}
+	if project.Region != "" {
+		if err := catalog.CheckMachineTypeAvailable(ctx, s.repo.db, project.Region, machineType); err != nil {
+			return nil, err
+		}
+	}
 
 	diskSizeGB := project.DiskSizeGb
 	if diskSizeGB == 0 {
@@ -252,6 +278,7 @@ func (s *ProjectService) CreateProject(
 		GcpProjectNumber:          sql.NullString{Valid: false}, // Set by orchestration
 		CreateBranchSites:         sql.NullBool{Bool: project.CreateBranchSites, Valid: true},
 		Status:                    db.NullProjectsStatus{ProjectsStatus: db.ProjectsStatusProvisioning, Valid: true},
+		Labels:                    service.LabelsToJSON(project.Labels),
 		CreatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -279,6 +306,17 @@ func (s *ProjectService) CreateProject(
 	}), nil
 }
 
+// projectUpdatePaths are the fields UpdateProject can change.
+var projectUpdatePaths = []string{
+	"project.project_name",
+	"project.os",
+	"project.disk_type",
+	"project.machine_type",
+	"project.disk_size_gb",
+	"project.create_branch_sites",
+	"project.labels",
+}
+
 // UpdateProject updates project configuration (organization-editable fields only).
 func (s *ProjectService) UpdateProject(
 	ctx context.Context,
@@ -295,6 +333,22 @@ func (s *ProjectService) UpdateProject(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
+	// Region and zone cannot be updated after project creation. They aren't
+	// updatable paths, so wildcards and an empty mask leave them out, but
+	// naming one is refused rather than reported as an unknown path.
+	for _, path := range req.Msg.UpdateMask.GetPaths() {
+		switch path {
+		case "project.region":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region cannot be updated after project creation"))
+		case "project.zone":
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("zone cannot be updated after project creation"))
+		}
+	}
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, projectUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	publicID, err := uuid.Parse(projectID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
@@ -312,6 +366,10 @@ func (s *ProjectService) UpdateProject(
 		slog.Error("Failed to get project by public ID for update", "error", err, "project_id", projectID)
 		return nil, err
 	}
+	// Before billing changes, which a conflict would leave behind
+	if err := service.CheckETag(req.Header(), project.Etag, existing.Version, "project"); err != nil {
+		return nil, err
+	}
 
 	name := existing.Name
 	gcpRegion := existing.GcpRegion
@@ -321,35 +379,34 @@ func (s *ProjectService) UpdateProject(
 	osImage := existing.Os
 	diskType := existing.DiskType
 	createBranchSites := existing.CreateBranchSites
+	labels := existing.Labels
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.project_name") {
+	if mask.Has("project.project_name") {
 		name = project.ProjectName
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.os") {
+	if mask.Has("project.os") {
 		osImage = sql.NullString{String: project.Os, Valid: true}
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.disk_type") {
+	if mask.Has("project.disk_type") {
 		diskType = sql.NullString{String: project.DiskType, Valid: true}
 	}
-	// Region and zone cannot be updated after project creation
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.region") {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("region cannot be updated after project creation"))
-	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.zone") {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("zone cannot be updated after project creation"))
-	}
 	// Track changes for billing updates
 	machineTypeChanged := false
 	diskSizeChanged := false
 	var newMachineItemID string
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.machine_type") {
+	if mask.Has("project.machine_type") {
 		newMachineType := project.MachineType
 		if newMachineType != "" && newMachineType != existing.MachineType.String {
 			// Validate new machine type
 			if err := s.billingManager.ValidateMachineType(ctx, newMachineType); err != nil {
 				return nil, connect.NewError(connect.CodeInvalidArgument, err)
 			}
+			if existing.GcpRegion.Valid {
+				if err := catalog.CheckMachineTypeAvailable(ctx, s.repo.db, existing.GcpRegion.String, newMachineType); err != nil {
+					return nil, err
+				}
+			}
 
 			// Update machine type in Stripe
 			if existing.StripeSubscriptionItemID.Valid && existing.StripeSubscriptionItemID.String != "" {
@@ -372,7 +429,7 @@ func (s *ProjectService) UpdateProject(
 		}
 	}
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.disk_size_gb") {
+	if mask.Has("project.disk_size_gb") {
 		newDiskSize := project.DiskSizeGb
 		oldDiskSize := int32(20) // Default
 		if existing.DiskSizeGb.Valid {
@@ -400,9 +457,15 @@ func (s *ProjectService) UpdateProject(
 			diskSizeGb = sql.NullInt32{Int32: newDiskSize, Valid: true}
 		}
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "project.create_branch_sites") {
+	if mask.Has("project.create_branch_sites") {
 		createBranchSites = sql.NullBool{Bool: project.CreateBranchSites, Valid: true}
 	}
+	if mask.Has("project.labels") {
+		if err := validation.Labels(project.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(project.Labels)
+	}
 
 	// Update Stripe subscription item ID if machine type changed
 	stripeSubItemID := existing.StripeSubscriptionItemID
@@ -428,8 +491,10 @@ func (s *ProjectService) UpdateProject(
 		GcpProjectNumber:          existing.GcpProjectNumber,
 		CreateBranchSites:         createBranchSites,
 		Status:                    db.NullProjectsStatus{ProjectsStatus: db.ProjectsStatusActive, Valid: true},
+		Labels:                    labels,
 		UpdatedBy:                 sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:                  publicID.String(),
+		Version:                   existing.Version,
 	}
 
 	if machineTypeChanged || diskSizeChanged {
@@ -445,9 +510,12 @@ func (s *ProjectService) UpdateProject(
 		return nil, err
 	}
 
-	return connect.NewResponse(&libopsv1.UpdateProjectResponse{
+	project.Etag = service.ETag(existing.Version + 1)
+	resp := connect.NewResponse(&libopsv1.UpdateProjectResponse{
 		Project: project,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteProject deletes a project (must have no sites).
@@ -537,24 +605,23 @@ func (s *ProjectService) ListProjects(
 		filterOrgID = sql.NullInt64{Int64: org.ID, Valid: true}
 	}
 
-	pageSize := req.Msg.PageSize
-	if pageSize <= 0 {
-		pageSize = 50
-	}
-	if pageSize > 100 {
-		pageSize = 100
+	pagination, err := service.ParseCursorPagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	offset, err := service.ParsePageToken(req.Msg.PageToken)
+	labelSelector, err := service.ParseLabelSelector(req.Msg.LabelSelector)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		return nil, err
 	}
 
 	params := db.ListUserProjectsParams{
 		AccountID:            accountID,
 		FilterOrganizationID: filterOrgID,
-		Limit:                pageSize,
-		Offset:               int32(offset),
+		LabelSelector:        labelSelector,
+		CursorCreatedAt:      pagination.CreatedAt,
+		CursorID:             pagination.ID,
+		Limit:                pagination.Limit,
 	}
 
 	projects, err := s.repo.ListUserProjects(ctx, params)
@@ -577,12 +644,14 @@ func (s *ProjectService) ListProjects(
 			Os:                service.FromNullString(project.Os),
 			DiskType:          service.FromNullString(project.DiskType),
 			Promote:           commonv1.PromoteStrategy_PROMOTE_STRATEGY_GITHUB_TAG,
+			Labels:            service.FromJSONLabels(project.Labels),
 		})
 	}
 
 	nextPageToken := ""
-	if len(projects) == int(pageSize) {
-		nextPageToken = service.GeneratePageToken(offset + int(pageSize))
+	if n := len(projects); n > 0 {
+		last := projects[n-1]
+		nextPageToken = service.MakeCursorPaginationResult(n, pagination, last.CreatedAt, last.ID).NextPageToken
 	}
 
 	return connect.NewResponse(&libopsv1.ListProjectsResponse{
@@ -9,11 +9,13 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
 	"github.com/libops/api/internal/testutils"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
+	adminv1 "github.com/libops/api/proto/libops/v1/admin"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 )
 
@@ -132,6 +134,12 @@ func TestCreateProject(t *testing.T) {
 							PublicID: orgID.String(),
 						}, nil
 					},
+					GetRegionFunc: func(ctx context.Context, code string) (db.Region, error) {
+						return db.Region{Code: code, Country: "us"}, nil
+					},
+					RegionOffersMachineSeriesFunc: func(ctx context.Context, arg db.RegionOffersMachineSeriesParams) (bool, error) {
+						return arg.Code == "us-central1" && arg.Series == "e2", nil
+					},
 					GetMachineTypeFunc: func(ctx context.Context, machineType string) (db.MachineType, error) {
 						return db.MachineType{
 							ID:                1,
@@ -283,6 +291,42 @@ func TestCreateProject(t *testing.T) {
 			wantErr:  true,
 			wantCode: connect.CodeInvalidArgument,
 		},
+		{
+			name:           "returns error for region outside the catalog",
+			organizationID: orgID.String(),
+			projectConfig: &commonv1.ProjectConfig{
+				ProjectName: "test-project",
+				Region:      "mars-north1",
+			},
+			setupMock: func(t *testing.T) *testutils.MockQuerier {
+				t.Helper()
+				return &testutils.MockQuerier{}
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:           "returns error when machine type is not offered in region",
+			organizationID: orgID.String(),
+			projectConfig: &commonv1.ProjectConfig{
+				ProjectName: "test-project",
+				Region:      "us-west3",
+				MachineType: "n4-standard-2",
+			},
+			setupMock: func(t *testing.T) *testutils.MockQuerier {
+				t.Helper()
+				return &testutils.MockQuerier{
+					GetOrganizationFunc: func(ctx context.Context, publicID string) (db.GetOrganizationRow, error) {
+						return db.GetOrganizationRow{ID: orgInternalID, PublicID: orgID.String()}, nil
+					},
+					GetRegionFunc: func(ctx context.Context, code string) (db.Region, error) {
+						return db.Region{Code: code, Country: "us"}, nil
+					},
+				}
+			},
+			wantErr:  true,
+			wantCode: connect.CodeInvalidArgument,
+		},
 		{
 			name:           "returns error when organization not found",
 			organizationID: orgID.String(),
@@ -374,3 +418,255 @@ func TestCreateProject(t *testing.T) {
 		})
 	}
 }
+
+// planBillingManager records plan-change billing calls and can fail the machine swap.
+type planBillingManager struct {
+	mockBillingManager
+	machineErr   error
+	diskChanges  [][2]int
+	machineSwaps []string
+}
+
+func (m *planBillingManager) UpdateProjectMachine(ctx context.Context, oldMachineItemID, newMachineType, projectName string, organizationID int64) (string, error) {
+	m.machineSwaps = append(m.machineSwaps, oldMachineItemID+" -> "+newMachineType)
+	if m.machineErr != nil {
+		return "", m.machineErr
+	}
+	if len(m.machineSwaps) > 1 {
+		return "si_restored_machine", nil
+	}
+	return "si_new_machine", nil
+}
+
+func (m *planBillingManager) UpdateProjectDiskSize(ctx context.Context, organizationID int64, oldDiskSizeGB, newDiskSizeGB int) error {
+	m.diskChanges = append(m.diskChanges, [2]int{oldDiskSizeGB, newDiskSizeGB})
+	return nil
+}
+
+// TestChangePlan tests the ChangePlan method of the ProjectService.
+func TestChangePlan(t *testing.T) {
+	projID := uuid.New()
+
+	tests := []struct {
+		name        string
+		machineType string
+		diskSizeGb  int32
+		machineErr  error
+		conflict    bool
+		wantCode    connect.Code
+		wantDisk    [][2]int
+		wantSwaps   []string
+		wantRestore string
+		wantRun     bool
+	}{
+		{
+			name:        "upgrade machine and disk",
+			machineType: "e2-standard-4",
+			diskSizeGb:  50,
+			wantDisk:    [][2]int{{20, 50}},
+			wantRun:     true,
+		},
+		{
+			name:        "machine only",
+			machineType: "e2-standard-4",
+			wantRun:     true,
+		},
+		{
+			name:     "nothing requested",
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:        "same plan",
+			machineType: "e2-medium",
+			diskSizeGb:  20,
+			wantCode:    connect.CodeFailedPrecondition,
+		},
+		{
+			name:       "disk cannot shrink",
+			diskSizeGb: 10,
+			wantCode:   connect.CodeFailedPrecondition,
+		},
+		{
+			name:        "machine swap failure rolls back disk",
+			machineType: "e2-standard-4",
+			diskSizeGb:  50,
+			machineErr:  fmt.Errorf("stripe down"),
+			wantCode:    connect.CodeInternal,
+			wantDisk:    [][2]int{{20, 50}, {50, 20}},
+			wantSwaps:   []string{"si_old_machine -> e2-standard-4"},
+		},
+		{
+			name:        "conflicting save rolls back billing",
+			machineType: "e2-standard-4",
+			diskSizeGb:  50,
+			conflict:    true,
+			wantCode:    connect.CodeFailedPrecondition,
+			wantDisk:    [][2]int{{20, 50}, {50, 20}},
+			wantSwaps:   []string{"si_old_machine -> e2-standard-4", "si_new_machine -> e2-medium"},
+			wantRestore: "si_restored_machine",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *db.UpdateProjectParams
+			var run *db.CreateReconciliationRunParams
+			var restored string
+			mockDB := &testutils.MockQuerier{
+				GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+					return db.GetProjectRow{
+						ID:                       7,
+						PublicID:                 projID.String(),
+						OrganizationID:           3,
+						Name:                     "Test Project",
+						MachineType:              sql.NullString{String: "e2-medium", Valid: true},
+						DiskSizeGb:               sql.NullInt32{Int32: 20, Valid: true},
+						StripeSubscriptionItemID: sql.NullString{String: "si_old_machine", Valid: true},
+						Status:                   db.NullProjectsStatus{ProjectsStatus: db.ProjectsStatusActive, Valid: true},
+					}, nil
+				},
+				UpdateProjectFunc: func(ctx context.Context, arg db.UpdateProjectParams) (int64, error) {
+					if tt.conflict {
+						return 0, nil
+					}
+					updated = &arg
+					return 1, nil
+				},
+				UpdateProjectStripeSubscriptionItemFunc: func(ctx context.Context, arg db.UpdateProjectStripeSubscriptionItemParams) error {
+					restored = arg.StripeSubscriptionItemID.String
+					return nil
+				},
+				CreateReconciliationRunFunc: func(ctx context.Context, arg db.CreateReconciliationRunParams) (sql.Result, error) {
+					run = &arg
+					return nil, nil
+				},
+			}
+			billingMgr := &planBillingManager{machineErr: tt.machineErr}
+			svc := NewProjectServiceWithBilling(mockDB, billingMgr)
+
+			ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{
+				AccountID: 1,
+				Email:     "test@example.com",
+			})
+			resp, err := svc.ChangePlan(ctx, connect.NewRequest(&libopsv1.ChangePlanRequest{
+				ProjectId:   projID.String(),
+				MachineType: tt.machineType,
+				DiskSizeGb:  tt.diskSizeGb,
+			}))
+
+			assert.Equal(t, tt.wantDisk, billingMgr.diskChanges)
+			if tt.wantSwaps != nil {
+				assert.Equal(t, tt.wantSwaps, billingMgr.machineSwaps)
+			}
+			assert.Equal(t, tt.wantRestore, restored)
+			if tt.wantCode != 0 {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				assert.Nil(t, updated)
+				assert.Nil(t, run)
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, updated) {
+				assert.Equal(t, "si_new_machine", updated.StripeSubscriptionItemID.String)
+				assert.Equal(t, resp.Msg.Project.MachineType, updated.MachineType.String)
+				assert.Equal(t, resp.Msg.Project.DiskSizeGb, updated.DiskSizeGb.Int32)
+			}
+			if tt.wantRun && assert.NotNil(t, run) {
+				assert.Equal(t, resp.Msg.RunId, run.RunID)
+				assert.Equal(t, db.ReconciliationsRunTypeTerraform, run.RunType)
+				assert.Equal(t, int64(7), run.ProjectID.Int64)
+				assert.JSONEq(t, `["project","site"]`, string(run.Modules))
+			}
+		})
+	}
+}
+
+// TestUpdateProjectMask tests that an empty mask and wildcards update the
+// updatable fields without touching region and zone, which are refused only
+// when named.
+func TestUpdateProjectMask(t *testing.T) {
+	projID := uuid.New()
+	tests := []struct {
+		name     string
+		paths    []string
+		admin    bool
+		wantCode connect.Code
+	}{
+		{name: "empty mask"},
+		{name: "wildcard", paths: []string{"*"}},
+		{name: "project wildcard", paths: []string{"project.*"}},
+		{name: "region", paths: []string{"project.project_name", "project.region"}, wantCode: connect.CodeInvalidArgument},
+		{name: "zone", paths: []string{"project.zone"}, wantCode: connect.CodeInvalidArgument},
+		{name: "admin empty mask", admin: true},
+		{name: "admin wildcard", paths: []string{"*"}, admin: true},
+		{name: "admin project wildcard", paths: []string{"project.*"}, admin: true},
+		{name: "admin config wildcard", paths: []string{"project.config.*"}, admin: true},
+		{name: "admin region", paths: []string{"project.config.region"}, admin: true, wantCode: connect.CodeInvalidArgument},
+		{name: "admin zone", paths: []string{"project.config.zone"}, admin: true, wantCode: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *db.UpdateProjectParams
+			mockDB := &testutils.MockQuerier{
+				GetProjectFunc: func(ctx context.Context, publicID string) (db.GetProjectRow, error) {
+					return db.GetProjectRow{
+						ID:             7,
+						PublicID:       projID.String(),
+						OrganizationID: 3,
+						Name:           "Test Project",
+						GcpRegion:      sql.NullString{String: "us-east1", Valid: true},
+						GcpZone:        sql.NullString{String: "us-east1-b", Valid: true},
+						MachineType:    sql.NullString{String: "e2-medium", Valid: true},
+						DiskSizeGb:     sql.NullInt32{Int32: 20, Valid: true},
+					}, nil
+				},
+				UpdateProjectFunc: func(ctx context.Context, arg db.UpdateProjectParams) (int64, error) {
+					updated = &arg
+					return 1, nil
+				},
+			}
+			ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 1})
+			config := &commonv1.ProjectConfig{
+				ProjectName: "Renamed",
+				Region:      "europe-west1",
+				Zone:        "europe-west1-b",
+				MachineType: "e2-medium",
+				DiskSizeGb:  20,
+			}
+			var mask *fieldmaskpb.FieldMask
+			if tt.paths != nil {
+				mask = &fieldmaskpb.FieldMask{Paths: tt.paths}
+			}
+
+			var err error
+			if tt.admin {
+				_, err = NewAdminProjectServiceWithBilling(mockDB, &mockBillingManager{}).UpdateProject(ctx, connect.NewRequest(&libopsv1.AdminUpdateProjectRequest{
+					ProjectId:  projID.String(),
+					Project:    &adminv1.AdminProjectConfig{Config: config},
+					UpdateMask: mask,
+				}))
+			} else {
+				_, err = NewProjectServiceWithBilling(mockDB, &mockBillingManager{}).UpdateProject(ctx, connect.NewRequest(&libopsv1.UpdateProjectRequest{
+					ProjectId:  projID.String(),
+					Project:    config,
+					UpdateMask: mask,
+				}))
+			}
+
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				assert.Nil(t, updated)
+				return
+			}
+			assert.NoError(t, err)
+			if assert.NotNil(t, updated) {
+				assert.Equal(t, "Renamed", updated.Name)
+				assert.Equal(t, "us-east1", updated.GcpRegion.String, "region is kept")
+				assert.Equal(t, "us-east1-b", updated.GcpZone.String, "zone is kept")
+			}
+		})
+	}
+}
@@ -11,7 +11,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/organization"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
@@ -19,16 +24,18 @@ import (
 
 // ProjectSettingService implements the ProjectSettingService API.
 type ProjectSettingService struct {
-	db db.Querier
+	db      db.Querier
+	emitter *events.Emitter
 }
 
 // Compile-time check to ensure ProjectSettingService implements the interface.
 var _ libopsv1connect.ProjectSettingServiceHandler = (*ProjectSettingService)(nil)
 
 // NewProjectSettingService creates a new ProjectSettingService instance.
-func NewProjectSettingService(querier db.Querier) *ProjectSettingService {
+func NewProjectSettingService(querier db.Querier, emitter *events.Emitter) *ProjectSettingService {
 	return &ProjectSettingService{
-		db: querier,
+		db:      querier,
+		emitter: emitter,
 	}
 }
 
@@ -43,8 +50,14 @@ func (s *ProjectSettingService) CreateProjectSetting(
 	editable := req.Msg.Editable
 	description := req.Msg.Description
 
-	if key == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("setting key is required"))
+	if err := organization.ValidateSettingKey(key); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := organization.ValidateSettingType(req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := organization.ValidateSettingValue(key, value, req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	userInfo, ok := auth.GetUserFromContext(ctx)
@@ -52,6 +65,12 @@ func (s *ProjectSettingService) CreateProjectSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
+	// Settings users can't edit are set by libops, so a user can't lock
+	// themselves out of one
+	if !editable && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only libops can create settings that can't be edited"))
+	}
+
 	// Parse project UUID
 	projectUUID, err := uuid.Parse(projectID)
 	if err != nil {
@@ -67,18 +86,36 @@ func (s *ProjectSettingService) CreateProjectSetting(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get project: %w", err))
 	}
 
+	_, err = s.db.GetProjectSetting(ctx, db.GetProjectSettingParams{
+		ProjectID:  project.ID,
+		SettingKey: key,
+	})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("setting %s already exists", key))
+	}
+	if err != sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+
+	var allowedValues types.RawJSON
+	if req.Msg.ValueType == libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM {
+		allowedValues = service.ToJSON(req.Msg.AllowedValues)
+	}
+
 	// Create setting
 	settingPublicID := uuid.New().String()
 	err = s.db.CreateProjectSetting(ctx, db.CreateProjectSettingParams{
-		PublicID:     settingPublicID,
-		ProjectID:    project.ID,
-		SettingKey:   key,
-		SettingValue: value,
-		Editable:     sql.NullBool{Bool: editable, Valid: true},
-		Description:  sql.NullString{String: description, Valid: description != ""},
-		Status:       db.NullProjectSettingsStatus{ProjectSettingsStatus: db.ProjectSettingsStatusActive, Valid: true},
-		CreatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:      settingPublicID,
+		ProjectID:     project.ID,
+		SettingKey:    key,
+		SettingValue:  value,
+		ValueType:     db.ProjectSettingsValueType(organization.SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues: allowedValues,
+		Editable:      sql.NullBool{Bool: editable, Valid: true},
+		Description:   sql.NullString{String: description, Valid: description != ""},
+		Status:        db.NullProjectSettingsStatus{ProjectSettingsStatus: db.ProjectSettingsStatusActive, Valid: true},
+		CreatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
 	if err != nil {
 		slog.Error("Failed to create project setting", "error", err, "project_id", projectID, "key", key)
@@ -87,14 +124,18 @@ func (s *ProjectSettingService) CreateProjectSetting(
 
 	// Return created setting
 	setting := &libopsv1.ProjectSetting{
-		SettingId:   settingPublicID,
-		ProjectId:   projectID,
-		Key:         key,
-		Value:       value,
-		Editable:    editable,
-		Description: description,
-		Status:      commonv1.Status_STATUS_ACTIVE,
+		SettingId:     settingPublicID,
+		ProjectId:     projectID,
+		Key:           key,
+		Value:         value,
+		Editable:      editable,
+		Description:   description,
+		Status:        commonv1.Status_STATUS_ACTIVE,
+		ValueType:     organization.SettingValueTypeFromDB(organization.SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues: req.Msg.AllowedValues,
+		Environment:   organization.IsEnvironmentSetting(key),
 	}
+	s.reconcile(ctx, events.EventTypeProjectSettingCreated, setting)
 
 	return connect.NewResponse(&libopsv1.CreateProjectSettingResponse{
 		Setting: setting,
@@ -106,36 +147,13 @@ func (s *ProjectSettingService) GetProjectSetting(
 	ctx context.Context,
 	req *connect.Request[libopsv1.GetProjectSettingRequest],
 ) (*connect.Response[libopsv1.GetProjectSettingResponse], error) {
-	settingID := req.Msg.SettingId
-
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.ProjectId, req.Msg.SettingId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
-	// Get setting
-	dbSetting, err := s.db.GetProjectSettingByPublicID(ctx, settingUUID.String())
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
-	}
-
-	// Convert to proto
-	setting := &libopsv1.ProjectSetting{
-		SettingId:   dbSetting.PublicID,
-		ProjectId:   fmt.Sprintf("%d", dbSetting.ProjectID),
-		Key:         dbSetting.SettingKey,
-		Value:       dbSetting.SettingValue,
-		Editable:    dbSetting.Editable.Bool,
-		Description: dbSetting.Description.String,
-		Status:      convertProjectSettingStatus(dbSetting.Status.ProjectSettingsStatus),
+		return nil, err
 	}
 
 	return connect.NewResponse(&libopsv1.GetProjectSettingResponse{
-		Setting: setting,
+		Setting: projectSettingToProto(req.Msg.ProjectId, dbSetting),
 	}), nil
 }
 
@@ -179,15 +197,7 @@ func (s *ProjectSettingService) ListProjectSettings(
 	// Convert to proto
 	settings := make([]*libopsv1.ProjectSetting, 0, len(dbSettings))
 	for _, dbSetting := range dbSettings {
-		settings = append(settings, &libopsv1.ProjectSetting{
-			SettingId:   dbSetting.PublicID,
-			ProjectId:   projectID,
-			Key:         dbSetting.SettingKey,
-			Value:       dbSetting.SettingValue,
-			Editable:    dbSetting.Editable.Bool,
-			Description: dbSetting.Description.String,
-			Status:      convertProjectSettingStatus(dbSetting.Status.ProjectSettingsStatus),
-		})
+		settings = append(settings, projectSettingToProto(projectID, db.GetProjectSettingByPublicIDRow(dbSetting)))
 	}
 
 	return connect.NewResponse(&libopsv1.ListProjectSettingsResponse{
@@ -213,29 +223,25 @@ func (s *ProjectSettingService) UpdateProjectSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
 	// Get existing setting
-	dbSetting, err := s.db.GetProjectSettingByPublicID(ctx, settingUUID.String())
+	dbSetting, err := s.setting(ctx, req.Msg.ProjectId, settingID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+		return nil, err
 	}
 
 	// Check if setting is editable
-	if !dbSetting.Editable.Bool {
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be modified"))
 	}
 
+	valueType := organization.SettingValueTypeFromDB(string(dbSetting.ValueType))
+	if err := organization.ValidateSettingValue(dbSetting.SettingKey, *value, valueType, service.FromJSONStringArray(dbSetting.AllowedValues)); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Update setting
 	err = s.db.UpdateProjectSetting(ctx, db.UpdateProjectSettingParams{
-		PublicID:     settingUUID.String(),
+		PublicID:     dbSetting.PublicID,
 		SettingValue: *value,
 		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
@@ -245,14 +251,11 @@ func (s *ProjectSettingService) UpdateProjectSetting(
 	}
 
 	// Return updated setting
-	setting := &libopsv1.ProjectSetting{
-		SettingId:   settingID,
-		ProjectId:   req.Msg.ProjectId,
-		Key:         dbSetting.SettingKey,
-		Value:       *value,
-		Editable:    dbSetting.Editable.Bool,
-		Description: dbSetting.Description.String,
-		Status:      convertProjectSettingStatus(dbSetting.Status.ProjectSettingsStatus),
+	changed := dbSetting.SettingValue != *value
+	dbSetting.SettingValue = *value
+	setting := projectSettingToProto(req.Msg.ProjectId, dbSetting)
+	if changed {
+		s.reconcile(ctx, events.EventTypeProjectSettingUpdated, setting)
 	}
 
 	return connect.NewResponse(&libopsv1.UpdateProjectSettingResponse{
@@ -260,7 +263,7 @@ func (s *ProjectSettingService) UpdateProjectSetting(
 	}), nil
 }
 
-// DeleteProjectSetting deletes a project setting.
+// DeleteProjectSetting deletes an project setting.
 func (s *ProjectSettingService) DeleteProjectSetting(
 	ctx context.Context,
 	req *connect.Request[libopsv1.DeleteProjectSettingRequest],
@@ -272,25 +275,85 @@ func (s *ProjectSettingService) DeleteProjectSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.ProjectId, settingID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+		return nil, err
+	}
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be deleted"))
 	}
 
 	// Delete setting (soft delete)
 	err = s.db.DeleteProjectSetting(ctx, db.DeleteProjectSettingParams{
-		PublicID:  settingUUID.String(),
+		PublicID:  dbSetting.PublicID,
 		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
 	if err != nil {
 		slog.Error("Failed to delete project setting", "error", err, "setting_id", settingID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete setting: %w", err))
 	}
+	s.reconcile(ctx, events.EventTypeProjectSettingDeleted, projectSettingToProto(req.Msg.ProjectId, dbSetting))
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// setting gets one of a project's settings. Settings of other
+// projects are reported as not found.
+func (s *ProjectSettingService) setting(ctx context.Context, projectID, settingID string) (db.GetProjectSettingByPublicIDRow, error) {
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id: %w", err))
+	}
+	settingUUID, err := uuid.Parse(settingID)
+	if err != nil {
+		return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+	}
+
+	project, err := s.db.GetProject(ctx, projectUUID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("project not found"))
+		}
+		return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get project: %w", err))
+	}
+
+	dbSetting, err := s.db.GetProjectSettingByPublicID(ctx, settingUUID.String())
+	if err == sql.ErrNoRows || (err == nil && dbSetting.ProjectID != project.ID) {
+		return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
+	}
+	if err != nil {
+		return db.GetProjectSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+	return dbSetting, nil
+}
+
+// reconcile queues the project's sites when an environment setting, which
+// their VMs get, changes.
+func (s *ProjectSettingService) reconcile(ctx context.Context, eventType string, setting *libopsv1.ProjectSetting) {
+	if s.emitter == nil || !setting.Environment {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, setting.SettingId, nil, &setting.ProjectId, nil, setting); err != nil {
+		slog.Error("Failed to emit project setting event", "error", err, "project_id", setting.ProjectId, "event_type", eventType)
+	}
+}
+
+// projectSettingToProto converts a database setting to its proto.
+func projectSettingToProto(projectID string, dbSetting db.GetProjectSettingByPublicIDRow) *libopsv1.ProjectSetting {
+	return &libopsv1.ProjectSetting{
+		SettingId:     dbSetting.PublicID,
+		ProjectId:     projectID,
+		Key:           dbSetting.SettingKey,
+		Value:         dbSetting.SettingValue,
+		Editable:      dbSetting.Editable.Bool,
+		Description:   dbSetting.Description.String,
+		Status:        convertProjectSettingStatus(dbSetting.Status.ProjectSettingsStatus),
+		ValueType:     organization.SettingValueTypeFromDB(string(dbSetting.ValueType)),
+		AllowedValues: service.FromJSONStringArray(dbSetting.AllowedValues),
+		Environment:   organization.IsEnvironmentSetting(dbSetting.SettingKey),
+	}
+}
+
 // convertProjectSettingStatus converts database status to proto status.
 func convertProjectSettingStatus(status db.ProjectSettingsStatus) commonv1.Status {
 	switch status {
@@ -9,21 +9,22 @@ import (
 	"connectrpc.com/connect"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 )
 
 // Repository encapsulates shared project business logic.
 type Repository struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // NewRepository creates a new project repository.
 func NewRepository(querier db.Querier) *Repository {
-	return &Repository{db: querier}
+	return &Repository{db: querier, quotas: quota.NewEnforcer(querier)}
 }
 
 // GetProjectByPublicID retrieves a project by public ID.
@@ -74,12 +75,15 @@ func (r *Repository) CreateProject(ctx context.Context, params db.CreateProjectP
 	return nil
 }
 
-// UpdateProject updates a project.
+// UpdateProject updates a project at the version in params.
 func (r *Repository) UpdateProject(ctx context.Context, params db.UpdateProjectParams) error {
-	err := r.db.UpdateProject(ctx, params)
+	updated, err := r.db.UpdateProject(ctx, params)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	if updated == 0 {
+		return service.ETagMismatchError("project")
+	}
 	return nil
 }
 
@@ -158,20 +162,6 @@ func FromNullStringPtr(ns sql.NullString) *string {
 	return nil
 }
 
-// ShouldUpdateField checks if a field should be updated based on the field mask.
-// If the field mask is nil or empty, all fields should be updated; otherwise, only fields present in the mask should be updated.
-func ShouldUpdateField(mask *fieldmaskpb.FieldMask, field string) bool {
-	if mask == nil {
-		return true
-	}
-	for _, path := range mask.Paths {
-		if path == field {
-			return true
-		}
-	}
-	return false
-}
-
 // Status conversion helpers.
 func DbProjectStatusToProto(status db.NullProjectsStatus) commonv1.Status {
 	return service.DbProjectStatusToProto(status)
@@ -4,12 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"connectrpc.com/connect"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/notify"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
 )
@@ -19,16 +23,20 @@ import (
 type AdminReconciliationService struct {
 	mainQuerier    db.Querier // Main API database
 	controlQuerier db.Querier // Control-plane database
+	notifier       *notify.Notifier
+	emitter        *events.Emitter
 }
 
 // Compile-time check.
 var _ libopsv1connect.AdminReconciliationServiceHandler = (*AdminReconciliationService)(nil)
 
 // NewAdminReconciliationService creates a new admin reconciliation service.
-func NewAdminReconciliationService(mainQuerier db.Querier, controlQuerier db.Querier) *AdminReconciliationService {
+func NewAdminReconciliationService(mainQuerier db.Querier, controlQuerier db.Querier, notifier *notify.Notifier, emitter *events.Emitter) *AdminReconciliationService {
 	return &AdminReconciliationService{
 		mainQuerier:    mainQuerier,
 		controlQuerier: controlQuerier,
+		notifier:       notifier,
+		emitter:        emitter,
 	}
 }
 
@@ -53,7 +61,7 @@ func (s *AdminReconciliationService) GetReconciliationRun(
 	var orgID, projID, siteID *int64
 	var reconciliationType *string
 
-	rows, err := s.controlQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, runID)
+	rows, err := s.controlQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, runID)
 	if err != nil {
 		slog.Error("failed to query reconciliation run", "run_id", runID, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query run: %w", err))
@@ -80,6 +88,9 @@ func (s *AdminReconciliationService) GetReconciliationRun(
 		slog.Error("failed to scan reconciliation run", "run_id", runID, "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to scan run: %w", err))
 	}
+	if run.Status == "cancelled" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("reconciliation run %s was cancelled", runID))
+	}
 
 	// Parse JSON fields
 	if modulesJSON != nil {
@@ -114,6 +125,11 @@ func (s *AdminReconciliationService) GetReconciliationRun(
 		run.SiteId = siteID
 	}
 
+	run.Workspaces, err = s.runWorkspaces(ctx, &run)
+	if err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&run), nil
 }
 
@@ -133,15 +149,19 @@ func (s *AdminReconciliationService) UpdateReconciliationStatus(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("run_id and status are required"))
 	}
 
-	// Update control-plane database
+	// Update control-plane database. A failure report clears the run's retry state, so the
+	// event router schedules a retry of a failed site reconciliation or gives up on it. A
+	// cancelled run keeps its status; the runner may still be reporting on it.
 	query := `UPDATE reconciliations
 	          SET status = ?,
 	              started_at = CASE WHEN ? = 'running' AND started_at IS NULL THEN CURRENT_TIMESTAMP ELSE started_at END,
 	              completed_at = CASE WHEN ? IN ('completed', 'failed') THEN CURRENT_TIMESTAMP ELSE completed_at END,
-	              error_message = ?
-	          WHERE run_id = ?`
+	              error_message = ?,
+	              retry_state = CASE WHEN ? = 'failed' THEN NULL ELSE retry_state END,
+	              next_retry_at = CASE WHEN ? = 'failed' THEN NULL ELSE next_retry_at END
+	          WHERE run_id = ? AND status != 'cancelled'`
 
-	_, err := s.controlQuerier.(*db.Queries).GetDB().ExecContext(ctx, query, status, status, status, errorMsg, runID)
+	result, err := s.controlQuerier.(db.DBProvider).GetDB().ExecContext(ctx, query, status, status, status, errorMsg, status, status, runID)
 	if err != nil {
 		slog.Error("failed to update reconciliation status",
 			"run_id", runID,
@@ -149,16 +169,96 @@ func (s *AdminReconciliationService) UpdateReconciliationStatus(
 			"error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update status: %w", err))
 	}
+	if updated, err := result.RowsAffected(); err == nil && updated == 0 {
+		slog.Info("ignoring status for cancelled or unknown reconciliation run",
+			"run_id", runID,
+			"status", status)
+		return connect.NewResponse(&libopsv1.UpdateReconciliationStatusResponse{
+			Success: true,
+		}), nil
+	}
 
 	slog.Info("reconciliation status updated",
 		"run_id", runID,
 		"status", status)
 
+	if status == "completed" || status == "failed" {
+		s.finishStateMigration(ctx, runID, status)
+		s.reportRunFinished(ctx, runID, status, errorMsg)
+	}
+
 	return connect.NewResponse(&libopsv1.UpdateReconciliationStatusResponse{
 		Success: true,
 	}), nil
 }
 
+// reportRunFinished alerts users about a finished run. Terraform runs for a site are deployments:
+// the site's deployers get an in-app notification and the organization's notification channels
+// get a deployment alert. Other runs only alert notification channels, and only when they fail.
+func (s *AdminReconciliationService) reportRunFinished(ctx context.Context, runID, status, errorMsg string) {
+	var runType string
+	var orgID, projectID, siteID sql.NullInt64
+	query := `SELECT run_type, organization_id, project_id, site_id FROM reconciliations WHERE run_id = ?`
+	err := s.controlQuerier.(db.DBProvider).GetDB().QueryRowContext(ctx, query, runID).Scan(&runType, &orgID, &projectID, &siteID)
+	if err != nil {
+		slog.Error("failed to load finished reconciliation run", "run_id", runID, "error", err)
+		return
+	}
+
+	failed := status == "failed"
+	event := &libopsv1.ReconciliationFinishedEvent{
+		RunId:        runID,
+		RunType:      runType,
+		Status:       status,
+		ErrorMessage: errorMsg,
+	}
+
+	eventType := ""
+	if runType == "terraform" && siteID.Valid {
+		site, err := s.mainQuerier.GetSiteByID(ctx, siteID.Int64)
+		if err != nil {
+			slog.Error("failed to get site for finished deployment", "run_id", runID, "site_id", siteID.Int64, "error", err)
+			return
+		}
+		if s.notifier != nil {
+			s.notifier.DeployFinished(ctx, site.ID, site.Name, site.PublicID, failed, errorMsg)
+		}
+		event.SiteId = site.PublicID
+		eventType = events.EventTypeDeploymentSucceeded
+		if failed {
+			eventType = events.EventTypeDeploymentFailed
+		}
+	} else if failed {
+		eventType = events.EventTypeReconciliationFailed
+	}
+
+	if s.emitter == nil || eventType == "" {
+		return
+	}
+	if event.SiteId == "" && projectID.Valid {
+		if project, err := s.mainQuerier.GetProjectByID(ctx, projectID.Int64); err == nil {
+			event.ProjectId = project.PublicID
+		}
+	}
+	if event.SiteId == "" && event.ProjectId == "" && orgID.Valid {
+		if organization, err := s.mainQuerier.GetOrganizationByID(ctx, orgID.Int64); err == nil {
+			event.OrganizationId = organization.PublicID
+		}
+	}
+
+	// The emitter fills in the parent organization and project from the most specific ID
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, runID, nonEmpty(event.OrganizationId), nonEmpty(event.ProjectId), nonEmpty(event.SiteId), event); err != nil {
+		slog.Error("failed to emit reconciliation finished event", "run_id", runID, "event_type", eventType, "error", err)
+	}
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 // GenerateTerraformVars generates terraform variables JSON from database state.
 func (s *AdminReconciliationService) GenerateTerraformVars(
 	ctx context.Context,
@@ -175,7 +275,8 @@ func (s *AdminReconciliationService) GenerateTerraformVars(
 		"sites":         make(map[string]interface{}),
 	}
 
-	// Determine scope and query accordingly
+	// Determine scope and query accordingly. An isolated workspace holds only the
+	// scope's own resources; its parents and children have workspaces of their own.
 	if siteID != nil {
 		// Site scope - query just this site
 		if err := s.addSiteToTfvars(ctx, *siteID, tfvars); err != nil {
@@ -186,19 +287,23 @@ func (s *AdminReconciliationService) GenerateTerraformVars(
 		if err := s.addProjectToTfvars(ctx, *projectID, tfvars); err != nil {
 			return nil, err
 		}
-		if err := s.addProjectSitesToTfvars(ctx, *projectID, tfvars); err != nil {
-			return nil, err
+		if !req.Msg.Isolated {
+			if err := s.addProjectSitesToTfvars(ctx, *projectID, tfvars); err != nil {
+				return nil, err
+			}
 		}
 	} else if orgID != nil {
 		// Organization scope - query this org, all its projects, and all sites
 		if err := s.addOrganizationToTfvars(ctx, *orgID, tfvars); err != nil {
 			return nil, err
 		}
-		if err := s.addOrganizationProjectsToTfvars(ctx, *orgID, tfvars); err != nil {
-			return nil, err
-		}
-		if err := s.addOrganizationSitesToTfvars(ctx, *orgID, tfvars); err != nil {
-			return nil, err
+		if !req.Msg.Isolated {
+			if err := s.addOrganizationProjectsToTfvars(ctx, *orgID, tfvars); err != nil {
+				return nil, err
+			}
+			if err := s.addOrganizationSitesToTfvars(ctx, *orgID, tfvars); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -223,17 +328,27 @@ func (s *AdminReconciliationService) GenerateTerraformVars(
 
 // addOrganizationToTfvars adds a single organization to the tfvars structure
 func (s *AdminReconciliationService) addOrganizationToTfvars(ctx context.Context, orgID int64, tfvars map[string]interface{}) error {
-	query := `SELECT BIN_TO_UUID(public_id) AS public_id, name, gcp_org_id, gcp_billing_account, gcp_parent, location
+	query := `SELECT BIN_TO_UUID(public_id) AS public_id, name, gcp_org_id, gcp_billing_account, gcp_parent, location, data_residency
 	          FROM organizations WHERE id = ?`
 
 	var publicID, name, gcpOrgID, gcpBillingAccount, gcpParent, location string
-	err := s.mainQuerier.(*db.Queries).GetDB().QueryRowContext(ctx, query, orgID).Scan(
-		&publicID, &name, &gcpOrgID, &gcpBillingAccount, &gcpParent, &location)
+	var dataResidency sql.NullString
+	err := s.mainQuerier.(db.DBProvider).GetDB().QueryRowContext(ctx, query, orgID).Scan(
+		&publicID, &name, &gcpOrgID, &gcpBillingAccount, &gcpParent, &location, &dataResidency)
 	if err != nil {
 		slog.Error("failed to query organization", "org_id", orgID, "error", err)
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query organization: %w", err))
 	}
 
+	pscEndpoints, err := s.privateServiceConnectTfvars(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	kmsKeys, err := s.encryptionKeysTfvars(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
 	orgs := tfvars["organizations"].(map[string]interface{})
 	orgs[publicID] = map[string]interface{}{
 		"name":                name,
@@ -241,6 +356,9 @@ func (s *AdminReconciliationService) addOrganizationToTfvars(ctx context.Context
 		"gcp_billing_account": gcpBillingAccount,
 		"gcp_parent":          gcpParent,
 		"location":            location,
+		"data_residency":      dataResidency.String,
+		"kms_keys":            kmsKeys,
+		"psc_endpoints":       pscEndpoints,
 	}
 
 	return nil
@@ -259,7 +377,7 @@ func (s *AdminReconciliationService) addProjectToTfvars(ctx context.Context, pro
 	var gcpBillingAccount string
 	var diskSize int32
 
-	err := s.mainQuerier.(*db.Queries).GetDB().QueryRowContext(ctx, query, projectID).Scan(
+	err := s.mainQuerier.(db.DBProvider).GetDB().QueryRowContext(ctx, query, projectID).Scan(
 		&publicID, &name, &orgPublicID, &gcpFolderID, &githubRepo, &gcpBillingAccount, &machineType, &diskSize)
 	if err != nil {
 		slog.Error("failed to query project", "project_id", projectID, "error", err)
@@ -289,16 +407,19 @@ func (s *AdminReconciliationService) addProjectToTfvars(ctx context.Context, pro
 func (s *AdminReconciliationService) addSiteToTfvars(ctx context.Context, siteID int64, tfvars map[string]interface{}) error {
 	query := `SELECT BIN_TO_UUID(s.public_id) AS public_id, s.name, BIN_TO_UUID(p.public_id) AS project_id,
 	                 p.gcp_project_id, p.gcp_project_number, s.github_ref, s.github_repository,
-	                 p.machine_type, p.disk_size_gb, p.gcp_zone
+	                 p.machine_type, p.disk_size_gb, p.gcp_zone, o.data_residency, o.id
 	          FROM sites s
 	          JOIN projects p ON s.project_id = p.id
+	          JOIN organizations o ON p.organization_id = o.id
 	          WHERE s.id = ?`
 
 	var publicID, name, projectPublicID, gcpProjectID, gcpProjectNumber, githubRef, githubRepo, machineType, zone string
 	var diskSize int32
+	var dataResidency sql.NullString
+	var orgID int64
 
-	err := s.mainQuerier.(*db.Queries).GetDB().QueryRowContext(ctx, query, siteID).Scan(
-		&publicID, &name, &projectPublicID, &gcpProjectID, &gcpProjectNumber, &githubRef, &githubRepo, &machineType, &diskSize, &zone)
+	err := s.mainQuerier.(db.DBProvider).GetDB().QueryRowContext(ctx, query, siteID).Scan(
+		&publicID, &name, &projectPublicID, &gcpProjectID, &gcpProjectNumber, &githubRef, &githubRepo, &machineType, &diskSize, &zone, &dataResidency, &orgID)
 	if err != nil {
 		slog.Error("failed to query site", "site_id", siteID, "error", err)
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query site: %w", err))
@@ -322,22 +443,58 @@ func (s *AdminReconciliationService) addSiteToTfvars(ctx context.Context, siteID
 		return err
 	}
 
-	sites := tfvars["sites"].(map[string]interface{})
-	sites[publicID] = map[string]interface{}{
-		"name":               name,
-		"project_id":         projectPublicID,
-		"gcp_project_id":     gcpProjectID,
-		"gcp_project_number": gcpProjectNumber,
-		"github_ref":         githubRef,
-		"github_repo":        githubRepo,
-		"machine_type":       machineType,
-		"disk_size":          diskSize,
-		"zone":               zone,
-		"firewall_rules":     firewallRules,
-		"members":            members,
-		"secrets":            secrets,
+	// Reserve the site's egress address unless it's being released
+	staticEgressIP := false
+	egress, err := s.mainQuerier.GetSiteStaticEgressIp(ctx, siteID)
+	switch {
+	case err == nil:
+		staticEgressIP = egress.Status != db.SiteStaticEgressIpsStatusReleasing
+	case !errors.Is(err, sql.ErrNoRows):
+		slog.Error("failed to query static egress ip", "site_id", siteID, "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query static egress ip: %w", err))
+	}
+
+	cdn, err := s.siteCdnTfvars(ctx, siteID)
+	if err != nil {
+		return err
+	}
+
+	cloudSqlDatabases, err := s.siteCloudSqlTfvars(ctx, publicID, siteID)
+	if err != nil {
+		return err
 	}
 
+	kmsKeys, err := s.encryptionKeysTfvars(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	sites := tfvars["sites"].(map[string]interface{})
+	siteData := map[string]interface{}{
+		"name":                name,
+		"project_id":          projectPublicID,
+		"gcp_project_id":      gcpProjectID,
+		"gcp_project_number":  gcpProjectNumber,
+		"github_ref":          githubRef,
+		"github_repo":         githubRepo,
+		"machine_type":        machineType,
+		"disk_size":           diskSize,
+		"zone":                zone,
+		"firewall_rules":      firewallRules,
+		"members":             members,
+		"secrets":             secrets,
+		"static_egress_ip":    staticEgressIP,
+		"cdn":                 cdn,
+		"cloud_sql_databases": cloudSqlDatabases,
+		"kms_keys":            kmsKeys,
+	}
+	// Backups stay in the organization's data residency, as a multi-region
+	// location ("EU" or "US") rather than the site's region
+	if dataResidency.Valid {
+		siteData["backup_location"] = strings.ToUpper(dataResidency.String)
+	}
+	sites[publicID] = siteData
+
 	return nil
 }
 
@@ -345,7 +502,7 @@ func (s *AdminReconciliationService) addSiteToTfvars(ctx context.Context, siteID
 func (s *AdminReconciliationService) addOrganizationProjectsToTfvars(ctx context.Context, orgID int64, tfvars map[string]interface{}) error {
 	query := `SELECT id FROM projects WHERE organization_id = ? AND status != 'deleted'`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, orgID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, orgID)
 	if err != nil {
 		slog.Error("failed to query organization projects", "org_id", orgID, "error", err)
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query projects: %w", err))
@@ -369,7 +526,7 @@ func (s *AdminReconciliationService) addOrganizationProjectsToTfvars(ctx context
 func (s *AdminReconciliationService) addProjectSitesToTfvars(ctx context.Context, projectID int64, tfvars map[string]interface{}) error {
 	query := `SELECT id FROM sites WHERE project_id = ? AND status != 'deleted'`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, projectID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, projectID)
 	if err != nil {
 		slog.Error("failed to query project sites", "project_id", projectID, "error", err)
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query sites: %w", err))
@@ -395,7 +552,7 @@ func (s *AdminReconciliationService) addOrganizationSitesToTfvars(ctx context.Co
 	          JOIN projects p ON s.project_id = p.id
 	          WHERE p.organization_id = ? AND s.status != 'deleted'`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, orgID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, orgID)
 	if err != nil {
 		slog.Error("failed to query organization sites", "org_id", orgID, "error", err)
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query sites: %w", err))
@@ -440,7 +597,7 @@ func (s *AdminReconciliationService) getSiteFirewallRules(ctx context.Context, s
 		) AS all_rules
 		ORDER BY priority, name`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, siteID, siteID, siteID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, siteID, siteID, siteID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query firewall rules: %w", err))
 	}
@@ -492,7 +649,7 @@ func (s *AdminReconciliationService) getSiteMembers(ctx context.Context, siteID
 		LEFT JOIN organization_members om ON all_members.account_id = om.account_id AND om.organization_id = (SELECT p.organization_id FROM sites s JOIN projects p ON s.project_id = p.id WHERE s.id = ?)
 		JOIN accounts a ON all_members.account_id = a.id`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, siteID, siteID, siteID, siteID, siteID, siteID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, siteID, siteID, siteID, siteID, siteID, siteID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query members: %w", err))
 	}
@@ -542,7 +699,7 @@ func (s *AdminReconciliationService) getSiteSecrets(ctx context.Context, siteID
 		) AS all_secrets
 		ORDER BY priority, name`
 
-	rows, err := s.mainQuerier.(*db.Queries).GetDB().QueryContext(ctx, query, siteID, siteID, siteID)
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query, siteID, siteID, siteID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query secrets: %w", err))
 	}
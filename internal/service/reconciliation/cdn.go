@@ -0,0 +1,126 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ReportSiteCdn records the CDN load balancer the terraform runner built for
+// a site and the cache purges it ran. A reported address activates the
+// site's CDN; no address removes one that was being disabled.
+func (s *AdminReconciliationService) ReportSiteCdn(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteCdnRequest],
+) (*connect.Response[libopsv1.ReportSiteCdnResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.IpAddress != "" {
+		if err := validation.IPAddress(req.Msg.IpAddress); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	for _, purgeID := range req.Msg.PurgedIds {
+		if err := validation.UUID(purgeID); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("purged_ids: %w", err))
+		}
+	}
+
+	site, err := service.GetSiteByPublicID(ctx, s.mainQuerier, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, purgeID := range req.Msg.PurgedIds {
+		err := s.mainQuerier.CompleteSiteCachePurge(ctx, db.CompleteSiteCachePurgeParams{
+			PublicID: purgeID,
+			SiteID:   site.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	cdn, err := s.mainQuerier.GetSiteCdnConfig(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewResponse(&libopsv1.ReportSiteCdnResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	switch {
+	case cdn.Status == db.SiteCdnConfigsStatusDisabling && req.Msg.IpAddress == "":
+		if err := s.mainQuerier.DeleteSiteCdnConfig(ctx, cdn.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		slog.Info("site cdn disabled", "site_id", site.PublicID, "ip_address", cdn.IpAddress.String)
+		return connect.NewResponse(&libopsv1.ReportSiteCdnResponse{}), nil
+	case cdn.Status != db.SiteCdnConfigsStatusDisabling && req.Msg.IpAddress != "":
+		err := s.mainQuerier.ActivateSiteCdnConfig(ctx, db.ActivateSiteCdnConfigParams{
+			IpAddress: sql.NullString{String: req.Msg.IpAddress, Valid: true},
+			ID:        cdn.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		cdn.Status = db.SiteCdnConfigsStatusActive
+		cdn.IpAddress = sql.NullString{String: req.Msg.IpAddress, Valid: true}
+	}
+
+	pending, err := s.mainQuerier.ListPendingSiteCachePurges(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.ReportSiteCdnResponse{
+		Cdn: service.SiteCdnToProto(cdn, len(pending)),
+	}), nil
+}
+
+// siteCdnTfvars is the site's cdn terraform variable: the cache policy, the
+// domains the load balancer's certificate covers, and the purges to run on
+// this apply. It's nil when the site has no CDN or it's being disabled.
+func (s *AdminReconciliationService) siteCdnTfvars(ctx context.Context, siteID int64) (map[string]interface{}, error) {
+	cdn, err := s.mainQuerier.GetSiteCdnConfig(ctx, siteID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("failed to query site cdn", "site_id", siteID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query site cdn: %w", err))
+	}
+	if cdn.Status == db.SiteCdnConfigsStatusDisabling {
+		return nil, nil
+	}
+
+	domains, err := s.mainQuerier.ListSiteCdnDomains(ctx, siteID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query site domains: %w", err))
+	}
+	pending, err := s.mainQuerier.ListPendingSiteCachePurges(ctx, siteID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query cache purges: %w", err))
+	}
+	purges := make(map[string]interface{}, len(pending))
+	for _, purge := range pending {
+		purges[purge.PublicID] = purge.Path
+	}
+
+	return map[string]interface{}{
+		"cache_mode":  string(cdn.CacheMode),
+		"default_ttl": cdn.DefaultTtlSeconds,
+		"domains":     domains,
+		"purges":      purges,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/envelope"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	"github.com/libops/api/internal/vault"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// cloudSqlPort is where a site's Cloud SQL instance accepts connections.
+const cloudSqlPort = 3306
+
+// ReportSiteDatabaseInstance records the Cloud SQL instance the terraform
+// runner built for a site's databases, activating them at its address. The
+// site reconciles its secrets again when that changes their host.
+func (s *AdminReconciliationService) ReportSiteDatabaseInstance(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteDatabaseInstanceRequest],
+) (*connect.Response[libopsv1.ReportSiteDatabaseInstanceResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.IpAddress != "" {
+		if err := validation.IPAddress(req.Msg.IpAddress); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	site, err := service.GetSiteByPublicID(ctx, s.mainQuerier, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.mainQuerier.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	changed := false
+	var store service.SecretStore
+	for _, row := range rows {
+		if row.Tier != db.SiteDatabasesTierCloudSql || req.Msg.IpAddress == "" {
+			continue
+		}
+		if store == nil {
+			store, err = s.organizationVault(ctx, site.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		activated, err := service.ActivateSiteDatabase(ctx, s.mainQuerier, store, site.PublicID, db.GetSiteDatabaseRow(row), req.Msg.IpAddress, cloudSqlPort)
+		if err != nil {
+			slog.Error("failed to activate site database", "site_id", site.PublicID, "database", row.Name, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to activate database %s", row.Name))
+		}
+		changed = changed || activated
+	}
+
+	rows, err = s.mainQuerier.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resp := &libopsv1.ReportSiteDatabaseInstanceResponse{}
+	for _, row := range rows {
+		resp.Databases = append(resp.Databases, service.SiteDatabaseToProto(site.PublicID, db.GetSiteDatabaseRow(row)))
+	}
+
+	if changed && s.emitter != nil {
+		if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeSiteSecretUpdated, site.PublicID, nil, nil, &site.PublicID, resp); err != nil {
+			slog.Error("failed to emit site secret updated event", "error", err, "site_id", site.PublicID)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// siteCloudSqlTfvars is the site's cloud_sql_databases terraform variable:
+// the password of each database on the site's Cloud SQL instance, keyed by
+// its name, which its user shares. It's empty when the site has none, and
+// terraform removes the instance.
+func (s *AdminReconciliationService) siteCloudSqlTfvars(ctx context.Context, sitePublicID string, siteID int64) (map[string]interface{}, error) {
+	rows, err := s.mainQuerier.ListSiteDatabases(ctx, siteID)
+	if err != nil {
+		slog.Error("failed to query site databases", "site_id", siteID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query site databases: %w", err))
+	}
+
+	databases := map[string]interface{}{}
+	var store *envelope.Store
+	for _, row := range rows {
+		if row.Tier != db.SiteDatabasesTierCloudSql {
+			continue
+		}
+		if store == nil {
+			site, err := service.GetSiteByPublicID(ctx, s.mainQuerier, sitePublicID)
+			if err != nil {
+				return nil, err
+			}
+			store, err = s.organizationVault(ctx, site.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		path := vault.BuildSiteSecretPath(sitePublicID, service.SiteDatabaseSecretNames(row.Name).Password)
+		secret, err := store.ReadSecret(ctx, path)
+		if err != nil {
+			slog.Error("failed to read database password", "site_id", sitePublicID, "database", row.Name, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read database password: %w", err))
+		}
+		password, _ := secret["value"].(string)
+		if password == "" {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database %s has no password", row.Name))
+		}
+		databases[row.Name] = map[string]interface{}{
+			"password": password,
+		}
+	}
+	return databases, nil
+}
+
+// organizationVault opens the Vault of the organization a project belongs to.
+func (s *AdminReconciliationService) organizationVault(ctx context.Context, projectID int64) (*envelope.Store, error) {
+	project, err := s.mainQuerier.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	client, err := service.OrganizationSecretStore(ctx, s.mainQuerier, project.OrganizationID)
+	if err != nil {
+		slog.Error("failed to open organization vault", "organization_id", project.OrganizationID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+	}
+	return client, nil
+}
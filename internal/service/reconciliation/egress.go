@@ -0,0 +1,69 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ReportSiteStaticEgressIp records the egress address the terraform runner
+// reserved for a site. A reported address activates the site's static egress
+// IP; no address removes one that was being released.
+func (s *AdminReconciliationService) ReportSiteStaticEgressIp(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteStaticEgressIpRequest],
+) (*connect.Response[libopsv1.ReportSiteStaticEgressIpResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.IpAddress != "" {
+		if err := validation.IPAddress(req.Msg.IpAddress); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	site, err := service.GetSiteByPublicID(ctx, s.mainQuerier, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	egress, err := s.mainQuerier.GetSiteStaticEgressIp(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewResponse(&libopsv1.ReportSiteStaticEgressIpResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	switch {
+	case egress.Status == db.SiteStaticEgressIpsStatusReleasing && req.Msg.IpAddress == "":
+		if err := s.mainQuerier.DeleteSiteStaticEgressIp(ctx, egress.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		slog.Info("static egress ip released", "site_id", site.PublicID, "ip_address", egress.IpAddress.String)
+		return connect.NewResponse(&libopsv1.ReportSiteStaticEgressIpResponse{}), nil
+	case egress.Status != db.SiteStaticEgressIpsStatusReleasing && req.Msg.IpAddress != "":
+		err := s.mainQuerier.ActivateSiteStaticEgressIp(ctx, db.ActivateSiteStaticEgressIpParams{
+			IpAddress: sql.NullString{String: req.Msg.IpAddress, Valid: true},
+			ID:        egress.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		egress.Status = db.SiteStaticEgressIpsStatusActive
+		egress.IpAddress = sql.NullString{String: req.Msg.IpAddress, Valid: true}
+	}
+
+	return connect.NewResponse(&libopsv1.ReportSiteStaticEgressIpResponse{
+		StaticEgressIp: service.StaticEgressIpToProto(egress),
+	}), nil
+}
@@ -0,0 +1,26 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+)
+
+// encryptionKeysTfvars maps each location the organization has a
+// customer-managed key for to the key. The organization and site modules each
+// pick the one in their data's location.
+func (s *AdminReconciliationService) encryptionKeysTfvars(ctx context.Context, orgID int64) (map[string]interface{}, error) {
+	rows, err := s.mainQuerier.ListOrganizationEncryptionKeys(ctx, orgID)
+	if err != nil {
+		slog.Error("failed to query encryption keys", "org_id", orgID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query encryption keys: %w", err))
+	}
+
+	keys := make(map[string]interface{}, len(rows))
+	for _, row := range rows {
+		keys[row.Location] = row.KmsKeyName
+	}
+	return keys, nil
+}
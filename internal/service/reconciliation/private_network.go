@@ -0,0 +1,166 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// ResolvePrivateServiceConnectEndpoint finds the active endpoint a terraform
+// runner or site controller should use to reach a LibOps service.
+func (s *AdminReconciliationService) ResolvePrivateServiceConnectEndpoint(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ResolvePrivateServiceConnectEndpointRequest],
+) (*connect.Response[libopsv1.ResolvePrivateServiceConnectEndpointResponse], error) {
+	target, ok := service.ProtoPrivateServiceConnectTargetToDB(req.Msg.Target)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target is required"))
+	}
+
+	var orgID int64
+	var orgPublicID string
+	switch {
+	case req.Msg.OrganizationId != "":
+		if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		organization, err := service.GetOrganizationByPublicID(ctx, s.mainQuerier, req.Msg.OrganizationId)
+		if err != nil {
+			return nil, err
+		}
+		orgID, orgPublicID = organization.ID, organization.PublicID
+	case req.Msg.SiteId != "":
+		if err := validation.UUID(req.Msg.SiteId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		site, err := service.GetSiteByPublicID(ctx, s.mainQuerier, req.Msg.SiteId)
+		if err != nil {
+			return nil, err
+		}
+		project, err := s.mainQuerier.GetProjectByID(ctx, site.ProjectID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get project: %w", err))
+		}
+		organization, err := s.mainQuerier.GetOrganizationByID(ctx, project.OrganizationID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get organization: %w", err))
+		}
+		orgID, orgPublicID = organization.ID, organization.PublicID
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization_id or site_id is required"))
+	}
+
+	row, err := s.mainQuerier.GetPrivateServiceConnectEndpointByTarget(ctx, db.GetPrivateServiceConnectEndpointByTargetParams{
+		OrganizationID: orgID,
+		Target:         target,
+	})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && row.Status != db.PrivateServiceConnectEndpointsStatusActive) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("organization %s has no active %s endpoint", orgPublicID, target))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.ResolvePrivateServiceConnectEndpointResponse{
+		Endpoint: service.PrivateServiceConnectEndpointToProto(db.GetPrivateServiceConnectEndpointRow(row), orgPublicID),
+	}), nil
+}
+
+// ReportPrivateServiceConnectEndpoints records what the terraform runner
+// applied for an organization: endpoints it created become active with the
+// address they got, and endpoints marked for deletion that are no longer in
+// the state are removed.
+func (s *AdminReconciliationService) ReportPrivateServiceConnectEndpoints(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportPrivateServiceConnectEndpointsRequest],
+) (*connect.Response[libopsv1.ReportPrivateServiceConnectEndpointsResponse], error) {
+	if err := validation.UUID(req.Msg.OrganizationId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	organization, err := service.GetOrganizationByPublicID(ctx, s.mainQuerier, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[db.PrivateServiceConnectEndpointsTarget]*libopsv1.AppliedPrivateServiceConnectEndpoint, len(req.Msg.Endpoints))
+	for _, endpoint := range req.Msg.Endpoints {
+		target, ok := service.ProtoPrivateServiceConnectTargetToDB(endpoint.Target)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("endpoint target is required"))
+		}
+		if err := validation.IPAddress(endpoint.IpAddress); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s endpoint: %w", target, err))
+		}
+		applied[target] = endpoint
+	}
+
+	rows, err := s.mainQuerier.ListPrivateServiceConnectEndpoints(ctx, organization.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ReportPrivateServiceConnectEndpointsResponse{}
+	for _, row := range rows {
+		endpoint := db.GetPrivateServiceConnectEndpointRow(row)
+		reported, inState := applied[endpoint.Target]
+		switch {
+		case endpoint.Status == db.PrivateServiceConnectEndpointsStatusDeleting && !inState:
+			if err := s.mainQuerier.DeletePrivateServiceConnectEndpoint(ctx, endpoint.ID); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			slog.Info("private service connect endpoint removed", "organization_id", organization.PublicID, "target", endpoint.Target)
+			continue
+		case endpoint.Status != db.PrivateServiceConnectEndpointsStatusDeleting && inState:
+			err := s.mainQuerier.ActivatePrivateServiceConnectEndpoint(ctx, db.ActivatePrivateServiceConnectEndpointParams{
+				IpAddress:      sql.NullString{String: reported.IpAddress, Valid: true},
+				ForwardingRule: service.ToNullString(reported.ForwardingRule),
+				ID:             endpoint.ID,
+			})
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			endpoint.Status = db.PrivateServiceConnectEndpointsStatusActive
+			endpoint.IpAddress = sql.NullString{String: reported.IpAddress, Valid: true}
+			endpoint.ForwardingRule = service.ToNullString(reported.ForwardingRule)
+		}
+		resp.Endpoints = append(resp.Endpoints, service.PrivateServiceConnectEndpointToProto(endpoint, organization.PublicID))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// privateServiceConnectTfvars is the organization's psc_endpoints terraform
+// variable, keyed by target. An endpoint keeps the address it was given, so a
+// later apply doesn't move it.
+func (s *AdminReconciliationService) privateServiceConnectTfvars(ctx context.Context, orgID int64) (map[string]interface{}, error) {
+	rows, err := s.mainQuerier.ListPrivateServiceConnectEndpoints(ctx, orgID)
+	if err != nil {
+		slog.Error("failed to query private service connect endpoints", "org_id", orgID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query private service connect endpoints: %w", err))
+	}
+
+	endpoints := make(map[string]interface{}, len(rows))
+	for _, row := range rows {
+		if row.Status == db.PrivateServiceConnectEndpointsStatusDeleting {
+			continue
+		}
+		var ip interface{}
+		switch {
+		case row.RequestedIpAddress.Valid:
+			ip = row.RequestedIpAddress.String
+		case row.IpAddress.Valid:
+			ip = row.IpAddress.String
+		}
+		endpoints[string(row.Target)] = map[string]interface{}{"ip_address": ip}
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,253 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/tfstate"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// runWorkspaces lists the terraform workspaces a run plans and applies, in order.
+// A monolithic organization has one workspace targeted at the run's modules; an
+// isolated organization has one per organization, project and site the run touches.
+// A migration run lists the workspaces the monolithic state is split into.
+func (s *AdminReconciliationService) runWorkspaces(ctx context.Context, run *libopsv1.GetReconciliationRunResponse) ([]*libopsv1.TerraformWorkspace, error) {
+	if run.RunType != "terraform" {
+		return nil, nil
+	}
+	orgID, err := s.runOrganizationID(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if orgID == 0 {
+		return nil, nil
+	}
+
+	layout := db.TerraformStateLayoutsLayoutMonolithic
+	row, err := s.mainQuerier.GetTerraformStateLayout(ctx, orgID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err == nil {
+		layout = row.Layout
+	}
+
+	migration := slices.Contains(run.Modules, tfstate.MigrationModule)
+	switch {
+	case migration && (layout != db.TerraformStateLayoutsLayoutMigrating || row.MigrationRunID.String != run.RunId):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("run %s is not the organization's state migration", run.RunId))
+	case migration:
+		return s.migrationWorkspaces(ctx, orgID)
+	case layout == db.TerraformStateLayoutsLayoutMigrating:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("organization state is being migrated by run %s", row.MigrationRunID.String))
+	case layout == db.TerraformStateLayoutsLayoutIsolated:
+		return s.isolatedWorkspaces(ctx, orgID, run)
+	default:
+		return s.monolithicWorkspaces(ctx, orgID, run)
+	}
+}
+
+// runOrganizationID is the organization whose state bucket a run uses, or 0 for runs
+// outside any organization.
+func (s *AdminReconciliationService) runOrganizationID(ctx context.Context, run *libopsv1.GetReconciliationRunResponse) (int64, error) {
+	switch {
+	case run.OrganizationId != nil:
+		return *run.OrganizationId, nil
+	case run.ProjectId != nil:
+		project, err := s.mainQuerier.GetProjectByID(ctx, *run.ProjectId)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		return project.OrganizationID, nil
+	case run.SiteId != nil:
+		site, err := s.mainQuerier.GetSiteByID(ctx, *run.SiteId)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		project, err := s.mainQuerier.GetProjectByID(ctx, site.ProjectID)
+		if err != nil {
+			return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		return project.OrganizationID, nil
+	}
+	return 0, nil
+}
+
+// migrationWorkspaces is a workspace for every project and site in the organization,
+// with the module address its resources move from in the monolithic state.
+func (s *AdminReconciliationService) migrationWorkspaces(ctx context.Context, orgID int64) ([]*libopsv1.TerraformWorkspace, error) {
+	rows, err := s.mainQuerier.ListOrganizationStateWorkspaces(ctx, orgID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	workspaces := make([]*libopsv1.TerraformWorkspace, 0, len(rows))
+	for _, row := range rows {
+		switch row.Kind {
+		case "project":
+			workspaces = append(workspaces, projectWorkspace(orgID, row.ID, row.PublicID))
+		case "site":
+			workspaces = append(workspaces, siteWorkspace(orgID, row.ID, row.PublicID))
+		}
+	}
+	return workspaces, nil
+}
+
+// monolithicWorkspaces is the organization's single state, targeted at the run's modules.
+func (s *AdminReconciliationService) monolithicWorkspaces(ctx context.Context, orgID int64, run *libopsv1.GetReconciliationRunResponse) ([]*libopsv1.TerraformWorkspace, error) {
+	workspace := &libopsv1.TerraformWorkspace{
+		StatePrefix:    tfstate.OrganizationPrefix,
+		OrganizationId: run.OrganizationId,
+		ProjectId:      run.ProjectId,
+		SiteId:         run.SiteId,
+	}
+	for _, module := range run.Modules {
+		switch module {
+		case "organization":
+			organization, err := s.mainQuerier.GetOrganizationByID(ctx, orgID)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			workspace.Targets = append(workspace.Targets, moduleAddress("organizations", organization.PublicID))
+		case "project":
+			if run.ProjectId == nil {
+				continue
+			}
+			project, err := s.mainQuerier.GetProjectByID(ctx, *run.ProjectId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			workspace.Targets = append(workspace.Targets, moduleAddress("projects", project.PublicID))
+		case "site":
+			sites, err := s.runSites(ctx, orgID, run)
+			if err != nil {
+				return nil, err
+			}
+			for _, site := range sites {
+				workspace.Targets = append(workspace.Targets, site.Address)
+			}
+		}
+	}
+	return []*libopsv1.TerraformWorkspace{workspace}, nil
+}
+
+// isolatedWorkspaces is a workspace per organization, project and site the run's
+// modules touch, each applied without targets.
+func (s *AdminReconciliationService) isolatedWorkspaces(ctx context.Context, orgID int64, run *libopsv1.GetReconciliationRunResponse) ([]*libopsv1.TerraformWorkspace, error) {
+	var workspaces []*libopsv1.TerraformWorkspace
+	if slices.Contains(run.Modules, "organization") {
+		workspaces = append(workspaces, &libopsv1.TerraformWorkspace{
+			StatePrefix:    tfstate.OrganizationPrefix,
+			OrganizationId: &orgID,
+			Isolated:       true,
+		})
+	}
+	if slices.Contains(run.Modules, "project") && run.ProjectId != nil {
+		project, err := s.mainQuerier.GetProjectByID(ctx, *run.ProjectId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		workspaces = append(workspaces, projectWorkspace(orgID, project.ID, project.PublicID))
+	}
+	if slices.Contains(run.Modules, "site") {
+		sites, err := s.runSites(ctx, orgID, run)
+		if err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, sites...)
+	}
+	return workspaces, nil
+}
+
+// runSites is a workspace for each site a run touches: its own site, else every
+// site in its project, else every site in the organization.
+func (s *AdminReconciliationService) runSites(ctx context.Context, orgID int64, run *libopsv1.GetReconciliationRunResponse) ([]*libopsv1.TerraformWorkspace, error) {
+	if run.SiteId != nil {
+		site, err := s.mainQuerier.GetSiteByID(ctx, *run.SiteId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		return []*libopsv1.TerraformWorkspace{siteWorkspace(orgID, site.ID, site.PublicID)}, nil
+	}
+
+	query := `SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id FROM sites s
+	          JOIN projects p ON s.project_id = p.id
+	          WHERE p.organization_id = ? AND s.status != 'deleted'`
+	args := []any{orgID}
+	if run.ProjectId != nil {
+		query += ` AND s.project_id = ?`
+		args = append(args, *run.ProjectId)
+	}
+	rows, err := s.mainQuerier.(db.DBProvider).GetDB().QueryContext(ctx, query+` ORDER BY s.id`, args...)
+	if err != nil {
+		slog.Error("failed to query run sites", "run_id", run.RunId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query sites: %w", err))
+	}
+	defer rows.Close()
+
+	var workspaces []*libopsv1.TerraformWorkspace
+	for rows.Next() {
+		var siteID int64
+		var publicID string
+		if err := rows.Scan(&siteID, &publicID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to scan site: %w", err))
+		}
+		workspaces = append(workspaces, siteWorkspace(orgID, siteID, publicID))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to query sites: %w", err))
+	}
+	return workspaces, nil
+}
+
+func projectWorkspace(orgID, projectID int64, publicID string) *libopsv1.TerraformWorkspace {
+	return &libopsv1.TerraformWorkspace{
+		StatePrefix:    tfstate.ProjectPrefix(publicID),
+		OrganizationId: &orgID,
+		ProjectId:      &projectID,
+		Isolated:       true,
+		Address:        moduleAddress("projects", publicID),
+	}
+}
+
+func siteWorkspace(orgID, siteID int64, publicID string) *libopsv1.TerraformWorkspace {
+	return &libopsv1.TerraformWorkspace{
+		StatePrefix:    tfstate.SitePrefix(publicID),
+		OrganizationId: &orgID,
+		SiteId:         &siteID,
+		Isolated:       true,
+		Address:        moduleAddress("sites", publicID),
+	}
+}
+
+// moduleAddress is the address of one for_each instance of a root module.
+func moduleAddress(module, key string) string {
+	return fmt.Sprintf("module.%s[%q]", module, key)
+}
+
+// finishStateMigration records the organization's layout once its migration run
+// ends. Runs that aren't migrations leave every layout alone.
+func (s *AdminReconciliationService) finishStateMigration(ctx context.Context, runID, status string) {
+	layout := db.TerraformStateLayoutsLayoutIsolated
+	if status == "failed" {
+		layout = db.TerraformStateLayoutsLayoutMonolithic
+	}
+	finished, err := s.mainQuerier.FinishTerraformStateMigration(ctx, db.FinishTerraformStateMigrationParams{
+		Layout: layout,
+		RunID:  sql.NullString{String: runID, Valid: true},
+	})
+	if err != nil {
+		slog.Error("failed to record terraform state layout", "run_id", runID, "error", err)
+		return
+	}
+	if finished > 0 {
+		slog.Info("terraform state migration finished", "run_id", runID, "layout", layout)
+	}
+}
@@ -0,0 +1,242 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// minBasicAuthPassword keeps shared staging passwords from being guessable.
+	minBasicAuthPassword = 12
+	// maxBasicAuthPassword is the most bcrypt hashes.
+	maxBasicAuthPassword = 72
+)
+
+// SiteAccessProtectionService implements the SiteAccessProtectionService API.
+type SiteAccessProtectionService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SiteAccessProtectionService implements the interface.
+var _ libopsv1connect.SiteAccessProtectionServiceHandler = (*SiteAccessProtectionService)(nil)
+
+// NewSiteAccessProtectionService creates a new SiteAccessProtectionService instance.
+func NewSiteAccessProtectionService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SiteAccessProtectionService {
+	return &SiteAccessProtectionService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// GetSiteAccessProtection returns a site's access protection, or its default
+// when it hasn't been set.
+func (s *SiteAccessProtectionService) GetSiteAccessProtection(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteAccessProtectionRequest],
+) (*connect.Response[libopsv1.GetSiteAccessProtectionResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	protection, err := s.repo.GetAccessProtection(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteAccessProtectionResponse{
+		AccessProtection: accessProtectionToProto(site.PublicID, protection),
+	}), nil
+}
+
+// SetSiteAccessProtection sets a site's access protection and queues the
+// site's reconciliation, which puts it in front of the site.
+func (s *SiteAccessProtectionService) SetSiteAccessProtection(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SetSiteAccessProtectionRequest],
+) (*connect.Response[libopsv1.SetSiteAccessProtectionResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	mode, err := protoSiteAccessModeToDB(req.Msg.Mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpsertSiteAccessProtectionParams{
+		SiteID:    site.ID,
+		Mode:      mode,
+		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	}
+	username := strings.TrimSpace(req.Msg.BasicAuthUsername)
+	if mode == db.SiteAccessProtectionsModeBasicAuth {
+		hash, err := s.basicAuthPasswordHash(ctx, site.ID, username, req.Msg.BasicAuthPassword)
+		if err != nil {
+			return nil, err
+		}
+		params.BasicAuthUsername = sql.NullString{String: username, Valid: true}
+		params.BasicAuthPasswordHash = sql.NullString{String: hash, Valid: true}
+	} else if username != "" || req.Msg.BasicAuthPassword != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic auth credentials are only used with SITE_ACCESS_MODE_BASIC_AUTH"))
+	}
+
+	if err := s.db.UpsertSiteAccessProtection(ctx, params); err != nil {
+		return nil, service.HandleDatabaseError(err, "access protection")
+	}
+
+	protection, err := s.repo.GetAccessProtection(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteAccessProtectionUpdate, map[string]any{
+		"mode":                string(mode),
+		"basic_auth_username": username,
+		"password_changed":    req.Msg.BasicAuthPassword != "",
+	})
+
+	// The response carries no password, unlike the request
+	resp := &libopsv1.SetSiteAccessProtectionResponse{AccessProtection: accessProtectionToProto(site.PublicID, protection)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteAccessUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// ResetSiteAccessProtection puts a site back on its default access protection
+// and queues the site's reconciliation.
+func (s *SiteAccessProtectionService) ResetSiteAccessProtection(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ResetSiteAccessProtectionRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteSiteAccessProtection(ctx, site.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteAccessProtectionReset, nil)
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteAccessUpdated, site.PublicID, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// basicAuthPasswordHash hashes a new basic auth password, or returns the
+// current hash when no password is given and the username hasn't changed.
+func (s *SiteAccessProtectionService) basicAuthPasswordHash(ctx context.Context, siteID int64, username, password string) (string, error) {
+	switch {
+	case username == "":
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic_auth_username is required"))
+	case len(username) > 64:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic_auth_username is longer than 64 characters"))
+	case strings.ContainsAny(username, ": \t\r\n"):
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic_auth_username can't contain colons or whitespace"))
+	}
+
+	if password == "" {
+		current, err := s.db.GetSiteAccessProtection(ctx, siteID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if err == nil && current.Mode == db.SiteAccessProtectionsModeBasicAuth && current.BasicAuthUsername.String == username {
+			return current.BasicAuthPasswordHash.String, nil
+		}
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic_auth_password is required"))
+	}
+
+	if len(password) < minBasicAuthPassword || len(password) > maxBasicAuthPassword {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("basic_auth_password must be %d to %d characters", minBasicAuthPassword, maxBasicAuthPassword))
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to hash password: %w", err))
+	}
+	return string(hash), nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteAccessProtectionService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// accessProtection is a site's access protection, set or defaulted.
+type accessProtection struct {
+	db.GetSiteAccessProtectionRow
+	isDefault bool
+}
+
+func protoSiteAccessModeToDB(mode libopsv1.SiteAccessMode) (db.SiteAccessProtectionsMode, error) {
+	switch mode {
+	case libopsv1.SiteAccessMode_SITE_ACCESS_MODE_NONE:
+		return db.SiteAccessProtectionsModeNone, nil
+	case libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH:
+		return db.SiteAccessProtectionsModeBasicAuth, nil
+	case libopsv1.SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY:
+		return db.SiteAccessProtectionsModeMembersOnly, nil
+	}
+	return "", fmt.Errorf("mode is required")
+}
+
+func dbSiteAccessModeToProto(mode db.SiteAccessProtectionsMode) libopsv1.SiteAccessMode {
+	switch mode {
+	case db.SiteAccessProtectionsModeNone:
+		return libopsv1.SiteAccessMode_SITE_ACCESS_MODE_NONE
+	case db.SiteAccessProtectionsModeBasicAuth:
+		return libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH
+	case db.SiteAccessProtectionsModeMembersOnly:
+		return libopsv1.SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY
+	}
+	return libopsv1.SiteAccessMode_SITE_ACCESS_MODE_UNSPECIFIED
+}
+
+func accessProtectionToProto(sitePublicID string, protection accessProtection) *libopsv1.SiteAccessProtection {
+	pb := &libopsv1.SiteAccessProtection{
+		SiteId:            sitePublicID,
+		Mode:              dbSiteAccessModeToProto(protection.Mode),
+		BasicAuthUsername: protection.BasicAuthUsername.String,
+		IsDefault:         protection.isDefault,
+	}
+	if protection.UpdatedAt.Valid {
+		pb.UpdatedAt = protection.UpdatedAt.Time.Unix()
+	}
+	return pb
+}
@@ -0,0 +1,161 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteAccessProtection tests that sites default to members only unless
+// they're production, that basic auth passwords are kept across updates that
+// don't change the username, and what the controller gets for each mode.
+func TestSiteAccessProtection(t *testing.T) {
+	siteID := uuid.NewString()
+	production := false
+	var protection *db.GetSiteAccessProtectionRow
+	gateSecret := sql.NullString{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2, IsProduction: sql.NullBool{Bool: production, Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteAccessProtectionFunc: func(ctx context.Context, id int64) (db.GetSiteAccessProtectionRow, error) {
+			if protection == nil {
+				return db.GetSiteAccessProtectionRow{}, sql.ErrNoRows
+			}
+			return *protection, nil
+		},
+		UpsertSiteAccessProtectionFunc: func(ctx context.Context, arg db.UpsertSiteAccessProtectionParams) error {
+			protection = &db.GetSiteAccessProtectionRow{
+				SiteID:                arg.SiteID,
+				Mode:                  arg.Mode,
+				BasicAuthUsername:     arg.BasicAuthUsername,
+				BasicAuthPasswordHash: arg.BasicAuthPasswordHash,
+			}
+			return nil
+		},
+		DeleteSiteAccessProtectionFunc: func(ctx context.Context, id int64) error {
+			protection = nil
+			return nil
+		},
+		GetSiteAccessGateSecretFunc: func(ctx context.Context, id int64) (sql.NullString, error) {
+			return gateSecret, nil
+		},
+		InitSiteAccessGateSecretFunc: func(ctx context.Context, arg db.InitSiteAccessGateSecretParams) error {
+			gateSecret = arg.AccessGateSecret
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteAccessProtectionService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	admin := NewAdminSiteServiceWithConfig(mock, "https://dash.libops.io/", nil)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	get := func() *libopsv1.SiteAccessProtection {
+		resp, err := svc.GetSiteAccessProtection(ctx, connect.NewRequest(&libopsv1.GetSiteAccessProtectionRequest{SiteId: siteID}))
+		require.NoError(t, err)
+		return resp.Msg.AccessProtection
+	}
+	set := func(req *libopsv1.SetSiteAccessProtectionRequest) error {
+		req.SiteId = siteID
+		_, err := svc.SetSiteAccessProtection(ctx, connect.NewRequest(req))
+		return err
+	}
+	proxyAccess := func() *libopsv1.SiteProxyAccess {
+		resp, err := admin.GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+		require.NoError(t, err)
+		return resp.Msg.Access
+	}
+
+	defaulted := get()
+	assert.Equal(t, libopsv1.SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY, defaulted.Mode)
+	assert.True(t, defaulted.IsDefault)
+	production = true
+	assert.Equal(t, libopsv1.SiteAccessMode_SITE_ACCESS_MODE_NONE, get().Mode, "production sites are public by default")
+	production = false
+
+	gated := proxyAccess()
+	assert.Equal(t, libopsv1.SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY, gated.Mode)
+	assert.Len(t, gated.GateSecret, 64)
+	assert.Equal(t, "https://dash.libops.io/sites/"+siteID+"/access", gated.GateUrl)
+
+	for name, req := range map[string]*libopsv1.SetSiteAccessProtectionRequest{
+		"no mode":              {},
+		"no username":          {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH, BasicAuthPassword: "correct horse battery"},
+		"no password":          {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH, BasicAuthUsername: "staff"},
+		"short password":       {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH, BasicAuthUsername: "staff", BasicAuthPassword: "hunter2"},
+		"colon in username":    {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH, BasicAuthUsername: "st:aff", BasicAuthPassword: "correct horse battery"},
+		"credentials for none": {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_NONE, BasicAuthUsername: "staff"},
+		"credentials for gate": {Mode: libopsv1.SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY, BasicAuthPassword: "correct horse battery"},
+		"unknown mode":         {Mode: libopsv1.SiteAccessMode(9)},
+	} {
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(set(req)), name)
+	}
+
+	require.NoError(t, set(&libopsv1.SetSiteAccessProtectionRequest{
+		Mode:              libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH,
+		BasicAuthUsername: "staff",
+		BasicAuthPassword: "correct horse battery",
+	}))
+	hash := protection.BasicAuthPasswordHash.String
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct horse battery")))
+
+	require.NoError(t, set(&libopsv1.SetSiteAccessProtectionRequest{
+		Mode:              libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH,
+		BasicAuthUsername: "staff",
+	}), "the password is kept for the same username")
+	assert.Equal(t, hash, protection.BasicAuthPasswordHash.String)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(set(&libopsv1.SetSiteAccessProtectionRequest{
+		Mode:              libopsv1.SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH,
+		BasicAuthUsername: "editors",
+	})), "a new username needs a new password")
+
+	basic := proxyAccess()
+	assert.Equal(t, "staff", basic.BasicAuthUsername)
+	assert.Equal(t, hash, basic.BasicAuthPasswordHash)
+	assert.Empty(t, basic.GateSecret, "only the gate needs the secret")
+
+	current := get()
+	assert.False(t, current.IsDefault)
+	assert.Equal(t, "staff", current.BasicAuthUsername)
+
+	_, err := svc.ResetSiteAccessProtection(ctx, connect.NewRequest(&libopsv1.ResetSiteAccessProtectionRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.True(t, get().IsDefault)
+	assert.Equal(t, gated.GateSecret, proxyAccess().GateSecret, "the gate keeps its secret across changes")
+
+	require.Len(t, queued, 3)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteAccessUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+		assert.NotContains(t, string(event.EventData), "correct horse battery")
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteAccessProtectionUpdate),
+		string(audit.SiteAccessProtectionUpdate),
+		string(audit.SiteAccessProtectionReset),
+	}, audited)
+}
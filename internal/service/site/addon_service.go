@@ -0,0 +1,341 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// addonKinds maps the API's add-on kinds to the database's.
+var addonKinds = map[libopsv1.AddonKind]db.SiteAddonsKind{
+	libopsv1.AddonKind_ADDON_KIND_SOLR:      db.SiteAddonsKindSolr,
+	libopsv1.AddonKind_ADDON_KIND_MEMCACHED: db.SiteAddonsKindMemcached,
+	libopsv1.AddonKind_ADDON_KIND_REDIS:     db.SiteAddonsKindRedis,
+	libopsv1.AddonKind_ADDON_KIND_MATOMO:    db.SiteAddonsKindMatomo,
+}
+
+// addonPasswordSecrets names the secret holding the password of each kind of
+// add-on that has one. Matomo's is its own database's, which the site doesn't
+// need but which has to outlive the VM.
+var addonPasswordSecrets = map[db.SiteAddonsKind]string{
+	db.SiteAddonsKindRedis:  "REDIS_PASSWORD",
+	db.SiteAddonsKindMatomo: "MATOMO_DATABASE_PASSWORD",
+}
+
+// addonSecretNames are the managed site secrets holding an add-on's address
+// and credentials.
+type addonSecretNames struct {
+	Host     string
+	Port     string
+	Password string // Empty for add-ons without credentials
+}
+
+// addonSecrets returns the names of the managed site secrets of the add-on
+// of the given kind.
+func addonSecrets(kind db.SiteAddonsKind) addonSecretNames {
+	prefix := strings.ToUpper(string(kind)) + "_"
+	return addonSecretNames{
+		Host:     prefix + "HOST",
+		Port:     prefix + "PORT",
+		Password: addonPasswordSecrets[kind],
+	}
+}
+
+// names lists the secrets, leaving out a password the add-on doesn't have.
+func (n addonSecretNames) names() []string {
+	names := []string{n.Host, n.Port}
+	if n.Password != "" {
+		names = append(names, n.Password)
+	}
+	return names
+}
+
+// addonToProto converts a site add-on row to proto.
+func addonToProto(sitePublicID string, row db.GetSiteAddonRow) *libopsv1.SiteAddon {
+	addon := &libopsv1.SiteAddon{
+		AddonId:       row.PublicID,
+		SiteId:        sitePublicID,
+		StatusMessage: service.FromNullString(row.StatusMessage),
+		Host:          service.FromNullString(row.Host),
+		Port:          row.Port.Int32,
+		SecretNames:   addonSecrets(row.Kind).names(),
+	}
+	for kind, dbKind := range addonKinds {
+		if dbKind == row.Kind {
+			addon.Kind = kind
+		}
+	}
+	switch row.Status {
+	case db.SiteAddonsStatusProvisioning:
+		addon.Status = libopsv1.AddonStatus_ADDON_STATUS_PROVISIONING
+	case db.SiteAddonsStatusHealthy:
+		addon.Status = libopsv1.AddonStatus_ADDON_STATUS_HEALTHY
+	case db.SiteAddonsStatusUnhealthy:
+		addon.Status = libopsv1.AddonStatus_ADDON_STATUS_UNHEALTHY
+	}
+	if row.CheckedAt.Valid {
+		addon.CheckedAt = row.CheckedAt.Time.Unix()
+	}
+	if row.CreatedAt.Valid {
+		addon.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return addon
+}
+
+// listAddons returns a site's add-ons as protos.
+func listAddons(ctx context.Context, querier db.Querier, site db.GetSiteRow) ([]*libopsv1.SiteAddon, error) {
+	rows, err := querier.ListSiteAddons(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	addons := make([]*libopsv1.SiteAddon, 0, len(rows))
+	for _, row := range rows {
+		addons = append(addons, addonToProto(site.PublicID, db.GetSiteAddonRow(row)))
+	}
+	return addons, nil
+}
+
+// AddonService implements the AddonService API.
+type AddonService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+	vault       func(ctx context.Context, organizationID int64) (secretStore, error)
+}
+
+// Compile-time check to ensure AddonService implements the interface.
+var _ libopsv1connect.AddonServiceHandler = (*AddonService)(nil)
+
+// NewAddonService creates a new AddonService instance.
+func NewAddonService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *AddonService {
+	return &AddonService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+		vault:       organizationSecretStores(querier),
+	}
+}
+
+// AttachAddon records an add-on for a site, writes its password secret when
+// it has one and queues the site's reconciliation, which starts it.
+func (s *AddonService) AttachAddon(
+	ctx context.Context,
+	req *connect.Request[libopsv1.AttachAddonRequest],
+) (*connect.Response[libopsv1.AttachAddonResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	kind, ok := addonKinds[req.Msg.Kind]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown add-on kind %d", req.Msg.Kind))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.ListSiteAddons(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, addon := range existing {
+		if addon.Kind == kind {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already has %s", kind))
+		}
+	}
+
+	// The address and credentials are managed secrets; a secret of the
+	// site's own can't be taken over
+	secrets := addonSecrets(kind)
+	for _, name := range secrets.names() {
+		_, err := s.db.GetSiteSecretByName(ctx, db.GetSiteSecretByNameParams{SiteID: site.ID, Name: name})
+		if err == nil {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already has a secret called %s", name))
+		}
+		if err != sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	if secrets.Password != "" {
+		store, err := s.siteVault(ctx, site)
+		if err != nil {
+			return nil, err
+		}
+		password, err := databasePassword()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err := service.WriteManagedSiteSecret(ctx, s.db, store, site.PublicID, site.ID, secrets.Password, password); err != nil {
+			slog.Error("failed to write add-on secret", "error", err, "site_id", site.PublicID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write the add-on's credentials"))
+		}
+	}
+
+	addonID := uuid.NewString()
+	err = s.db.CreateSiteAddon(ctx, db.CreateSiteAddonParams{
+		PublicID:  addonID,
+		SiteID:    site.ID,
+		Kind:      kind,
+		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "add-on")
+	}
+	addon, err := s.db.GetSiteAddon(ctx, addonID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteAddonAttach, map[string]any{
+		"addon_id": addonID,
+		"kind":     addon.Kind,
+	})
+
+	resp := &libopsv1.AttachAddonResponse{Addon: addonToProto(site.PublicID, addon)}
+	s.reconcile(ctx, events.EventTypeSiteAddonAttached, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// ListAddons lists a site's add-ons.
+func (s *AddonService) ListAddons(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListAddonsRequest],
+) (*connect.Response[libopsv1.ListAddonsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	addons, err := listAddons(ctx, s.db, site)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ListAddonsResponse{Addons: addons}), nil
+}
+
+// DetachAddon deletes an add-on and its managed secrets and queues the site's
+// reconciliation, which stops it.
+func (s *AddonService) DetachAddon(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DetachAddonRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if err := validation.UUID(req.Msg.AddonId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	addon, err := s.db.GetSiteAddon(ctx, req.Msg.AddonId)
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "add-on")
+	}
+	if addon.SiteID != site.ID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("add-on not found"))
+	}
+
+	var store secretStore
+	for _, name := range addonSecrets(addon.Kind).names() {
+		secret, err := s.db.GetSiteSecretByName(ctx, db.GetSiteSecretByNameParams{SiteID: site.ID, Name: name})
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		if !secret.Managed {
+			continue
+		}
+		if store == nil {
+			store, err = s.siteVault(ctx, site)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = s.db.DeleteSiteSecret(ctx, db.DeleteSiteSecretParams{
+			UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+			UpdatedAt: time.Now().Unix(),
+			ID:        secret.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		service.DeleteSecret(ctx, store, secret.VaultPath)
+	}
+
+	if err := s.db.DeleteSiteAddon(ctx, addon.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteAddonDetach, map[string]any{
+		"addon_id": addon.PublicID,
+		"kind":     addon.Kind,
+	})
+
+	s.reconcile(ctx, events.EventTypeSiteAddonDetached, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// site looks up the site a request targets.
+func (s *AddonService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// siteVault opens the Vault of the site's organization.
+func (s *AddonService) siteVault(ctx context.Context, site db.GetSiteRow) (secretStore, error) {
+	project, err := s.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	store, err := s.vault(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("failed to get vault client", "error", err, "organization_id", project.OrganizationID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+	}
+	return store, nil
+}
+
+// reconcile queues the site's reconciliation, in which its controller starts
+// or stops the add-on and the site picks up its secrets.
+func (s *AddonService) reconcile(ctx context.Context, eventType, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit add-on event", "error", err, "site_id", siteID)
+	}
+}
@@ -0,0 +1,209 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	"github.com/libops/api/internal/vault"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteAddons tests that add-ons are validated, that their credentials and
+// addresses are written as managed secrets, that the controller gets their
+// passwords and its health reports reach site status, and that detaching one
+// deletes its secrets.
+func TestSiteAddons(t *testing.T) {
+	siteID := uuid.NewString()
+	var addons []db.GetSiteAddonRow
+	secrets := map[string]db.GetSiteSecretByNameRow{
+		"MEMCACHED_HOST": {ID: 40, SiteID: 5, Name: "MEMCACHED_HOST"},
+	}
+	var deletedSecrets []int64
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		ListSiteAddonsFunc: func(ctx context.Context, id int64) ([]db.ListSiteAddonsRow, error) {
+			rows := make([]db.ListSiteAddonsRow, 0, len(addons))
+			for _, addon := range addons {
+				if addon.ID != 0 {
+					rows = append(rows, db.ListSiteAddonsRow(addon))
+				}
+			}
+			return rows, nil
+		},
+		GetSiteAddonFunc: func(ctx context.Context, publicID string) (db.GetSiteAddonRow, error) {
+			for _, addon := range addons {
+				if addon.ID != 0 && addon.PublicID == publicID {
+					return addon, nil
+				}
+			}
+			return db.GetSiteAddonRow{}, sql.ErrNoRows
+		},
+		CreateSiteAddonFunc: func(ctx context.Context, arg db.CreateSiteAddonParams) error {
+			addons = append(addons, db.GetSiteAddonRow{
+				ID:        int64(len(addons) + 1),
+				PublicID:  arg.PublicID,
+				SiteID:    arg.SiteID,
+				Kind:      arg.Kind,
+				Status:    db.SiteAddonsStatusProvisioning,
+				CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			})
+			return nil
+		},
+		ReportSiteAddonHealthFunc: func(ctx context.Context, arg db.ReportSiteAddonHealthParams) error {
+			addons[arg.ID-1].Status = arg.Status
+			addons[arg.ID-1].StatusMessage = arg.StatusMessage
+			addons[arg.ID-1].Host = arg.Host
+			addons[arg.ID-1].Port = arg.Port
+			addons[arg.ID-1].CheckedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			return nil
+		},
+		DeleteSiteAddonFunc: func(ctx context.Context, id int64) error {
+			addons[id-1] = db.GetSiteAddonRow{}
+			return nil
+		},
+		GetSiteSecretByNameFunc: func(ctx context.Context, arg db.GetSiteSecretByNameParams) (db.GetSiteSecretByNameRow, error) {
+			if secret, ok := secrets[arg.Name]; ok {
+				return secret, nil
+			}
+			return db.GetSiteSecretByNameRow{}, sql.ErrNoRows
+		},
+		UpsertManagedSiteSecretFunc: func(ctx context.Context, arg db.UpsertManagedSiteSecretParams) error {
+			secrets[arg.Name] = db.GetSiteSecretByNameRow{ID: int64(100 + len(secrets)), SiteID: arg.SiteID, Name: arg.Name, VaultPath: arg.VaultPath, Managed: true}
+			return nil
+		},
+		DeleteSiteSecretFunc: func(ctx context.Context, arg db.DeleteSiteSecretParams) error {
+			deletedSecrets = append(deletedSecrets, arg.ID)
+			for name, secret := range secrets {
+				if secret.ID == arg.ID {
+					delete(secrets, name)
+				}
+			}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	store := memorySecretStore{}
+	vaultFor := func(ctx context.Context, organizationID int64) (secretStore, error) {
+		return store, nil
+	}
+	svc := NewAddonService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	svc.vault = vaultFor
+	admin := NewAdminSiteService(mock)
+	admin.vault = vaultFor
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	secretValue := func(name string) string {
+		value, _ := store[vault.BuildSiteSecretPath(siteID, name)]["value"].(string)
+		return value
+	}
+
+	_, err := svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "no kind")
+	_, err = svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID, Kind: libopsv1.AddonKind(9)}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "unknown kind")
+	_, err = svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID, Kind: libopsv1.AddonKind_ADDON_KIND_MEMCACHED}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "the site has a secret of the same name")
+
+	solr, err := svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID, Kind: libopsv1.AddonKind_ADDON_KIND_SOLR}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.AddonStatus_ADDON_STATUS_PROVISIONING, solr.Msg.Addon.Status)
+	assert.Equal(t, []string{"SOLR_HOST", "SOLR_PORT"}, solr.Msg.Addon.SecretNames)
+	assert.Empty(t, store, "Solr has no credentials")
+
+	_, err = svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID, Kind: libopsv1.AddonKind_ADDON_KIND_SOLR}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "one of each kind")
+
+	redis, err := svc.AttachAddon(ctx, connect.NewRequest(&libopsv1.AttachAddonRequest{SiteId: siteID, Kind: libopsv1.AddonKind_ADDON_KIND_REDIS}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"REDIS_HOST", "REDIS_PORT", "REDIS_PASSWORD"}, redis.Msg.Addon.SecretNames)
+	password := secretValue("REDIS_PASSWORD")
+	assert.Len(t, password, 2*databasePasswordBytes)
+	assert.True(t, secrets["REDIS_PASSWORD"].Managed)
+
+	specs, err := admin.GetSiteAddons(ctx, connect.NewRequest(&libopsv1.GetSiteAddonsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, specs.Msg.Addons, 2)
+	assert.Equal(t, "solr", specs.Msg.Addons[0].Kind)
+	assert.Empty(t, specs.Msg.Addons[0].Password)
+	assert.Equal(t, "redis", specs.Msg.Addons[1].Kind)
+	assert.Equal(t, password, specs.Msg.Addons[1].Password)
+
+	_, err = admin.ReportSiteAddons(ctx, connect.NewRequest(&libopsv1.ReportSiteAddonsRequest{
+		SiteId: siteID,
+		Addons: []*libopsv1.ReportedAddon{{Kind: "solr", Host: "solr", Port: 8983}},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "host isn't an address")
+
+	reported, err := admin.ReportSiteAddons(ctx, connect.NewRequest(&libopsv1.ReportSiteAddonsRequest{
+		SiteId: siteID,
+		Addons: []*libopsv1.ReportedAddon{
+			{Kind: "solr", Host: "172.17.0.1", Port: 8983},
+			{Kind: "redis", Host: "172.17.0.1", Port: 6379, Error: "not accepting connections on 172.17.0.1:6379"},
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, reported.Msg.Addons, 2)
+	assert.Equal(t, libopsv1.AddonStatus_ADDON_STATUS_HEALTHY, reported.Msg.Addons[0].Status)
+	assert.Greater(t, reported.Msg.Addons[0].CheckedAt, int64(0))
+	assert.Equal(t, libopsv1.AddonStatus_ADDON_STATUS_UNHEALTHY, reported.Msg.Addons[1].Status)
+	assert.Equal(t, "not accepting connections on 172.17.0.1:6379", reported.Msg.Addons[1].StatusMessage)
+	assert.Equal(t, "172.17.0.1", secretValue("SOLR_HOST"))
+	assert.Equal(t, "8983", secretValue("SOLR_PORT"))
+	assert.Empty(t, secretValue("REDIS_HOST"), "unhealthy add-ons don't get an address")
+
+	status, err := NewSiteOperationsService(mock, nil).GetSiteStatus(ctx, connect.NewRequest(&libopsv1.GetSiteStatusRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, status.Msg.Status.Addons, 2)
+	assert.Equal(t, libopsv1.AddonKind_ADDON_KIND_SOLR, status.Msg.Status.Addons[0].Kind)
+	assert.Equal(t, libopsv1.AddonStatus_ADDON_STATUS_HEALTHY, status.Msg.Status.Addons[0].Status)
+	assert.Equal(t, libopsv1.AddonStatus_ADDON_STATUS_UNHEALTHY, status.Msg.Status.Addons[1].Status)
+
+	_, err = svc.DetachAddon(ctx, connect.NewRequest(&libopsv1.DetachAddonRequest{SiteId: siteID, AddonId: uuid.NewString()}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.DetachAddon(ctx, connect.NewRequest(&libopsv1.DetachAddonRequest{SiteId: siteID, AddonId: solr.Msg.Addon.AddonId}))
+	require.NoError(t, err)
+	assert.Len(t, deletedSecrets, 2)
+	assert.Empty(t, secretValue("SOLR_HOST"))
+	assert.NotContains(t, secrets, "SOLR_PORT")
+
+	listed, err := svc.ListAddons(ctx, connect.NewRequest(&libopsv1.ListAddonsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.Addons, 1)
+	assert.Equal(t, libopsv1.AddonKind_ADDON_KIND_REDIS, listed.Msg.Addons[0].Kind)
+
+	require.Len(t, queued, 3)
+	assert.Equal(t, events.EventTypeSiteAddonAttached, queued[0].EventType)
+	assert.Equal(t, events.EventTypeSiteAddonAttached, queued[1].EventType)
+	assert.Equal(t, events.EventTypeSiteAddonDetached, queued[2].EventType)
+	assert.Equal(t, []string{
+		string(audit.SiteAddonAttach),
+		string(audit.SiteAddonAttach),
+		string(audit.SiteAddonDetach),
+	}, audited)
+}
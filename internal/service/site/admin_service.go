@@ -6,7 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -14,7 +18,15 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/billing"
+	"github.com/libops/api/internal/controllerca"
+	"github.com/libops/api/internal/controllerconfig"
+	"github.com/libops/api/internal/region"
 	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/organization"
+	"github.com/libops/api/internal/siteaccess"
+	"github.com/libops/api/internal/validation"
+	"github.com/libops/api/internal/vault"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	adminv1 "github.com/libops/api/proto/libops/v1/admin"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
@@ -23,7 +35,13 @@ import (
 
 // AdminSiteService implements the admin-level site API.
 type AdminSiteService struct {
-	repo *Repository
+	repo        *Repository
+	dashBaseURL string
+	vault       func(ctx context.Context, organizationID int64) (secretStore, error)
+	// controllerCA issues controller client certificates; nil when mTLS is off
+	controllerCA *controllerca.CA
+	// regions point controllers at their site's API region; nil in a single region
+	regions *region.Resolver
 }
 
 // Compile-time check.
@@ -31,8 +49,18 @@ var _ libopsv1connect.AdminSiteServiceHandler = (*AdminSiteService)(nil)
 
 // NewAdminSiteService creates a new admin site service.
 func NewAdminSiteService(querier db.Querier) *AdminSiteService {
+	return NewAdminSiteServiceWithConfig(querier, "", nil)
+}
+
+// NewAdminSiteServiceWithConfig creates a new admin site service that sends
+// visitors of members only sites to the dashboard at dashBaseURL to sign in,
+// and issues controllers client certificates from controllerCA when set.
+func NewAdminSiteServiceWithConfig(querier db.Querier, dashBaseURL string, controllerCA *controllerca.CA) *AdminSiteService {
 	return &AdminSiteService{
-		repo: NewRepository(querier),
+		repo:         NewRepository(querier),
+		dashBaseURL:  dashBaseURL,
+		vault:        organizationSecretStores(querier),
+		controllerCA: controllerCA,
 	}
 }
 
@@ -110,6 +138,7 @@ func (s *AdminSiteService) ListSites(
 				Os:               service.FromNullString(site.Os),
 				IsProduction:     site.IsProduction.Bool,
 				Status:           service.DbSiteStatusToProto(site.Status),
+				Labels:           service.FromJSONLabels(site.Labels),
 			},
 			GcpInstanceName: nil,
 			GcpExternalIp:   service.FromNullStringPtr(site.GcpExternalIp),
@@ -151,7 +180,7 @@ func (s *AdminSiteService) GetSite(
 		return nil, err
 	}
 
-	site, err := s.repo.GetSiteByProjectAndName(ctx, project.ID, siteName)
+	site, err := s.repo.GetSiteByProjectAndName(service.ETagContext(ctx), project.ID, siteName)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +204,8 @@ func (s *AdminSiteService) GetSite(
 			Os:               service.FromNullString(site.Os),
 			IsProduction:     site.IsProduction.Bool,
 			Status:           service.DbSiteStatusToProto(site.Status),
+			Labels:           service.FromJSONLabels(site.Labels),
+			Etag:             service.ETag(site.Version),
 		},
 		GcpInstanceName: nil,
 		GcpExternalIp:   service.FromNullStringPtr(site.GcpExternalIp),
@@ -182,9 +213,11 @@ func (s *AdminSiteService) GetSite(
 		GithubTeamId:    service.FromNullStringPtr(site.GithubTeamID),
 	}
 
-	return connect.NewResponse(&libopsv1.AdminGetSiteResponse{
+	resp := connect.NewResponse(&libopsv1.AdminGetSiteResponse{
 		Site: protoSite,
-	}), nil
+	})
+	service.SetETag(resp.Header(), site.Version)
+	return resp, nil
 }
 
 // CreateSite creates a new site (admin - can set all fields).
@@ -203,6 +236,10 @@ func (s *AdminSiteService) CreateSite(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("site with site_name is required"))
 	}
 
+	if err := validation.Labels(site.Config.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	projectPublicID, err := uuid.Parse(projectID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
@@ -235,6 +272,7 @@ func (s *AdminSiteService) CreateSite(
 		GcpExternalIp:    service.ToNullString(service.PtrToString(site.GcpExternalIp)),
 		GithubTeamID:     service.ToNullString(service.PtrToString(site.GithubTeamId)),
 		Status:           db.NullSitesStatus{SitesStatus: db.SitesStatusProvisioning, Valid: true},
+		Labels:           service.LabelsToJSON(site.Config.Labels),
 		CreatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -249,6 +287,23 @@ func (s *AdminSiteService) CreateSite(
 	}), nil
 }
 
+// adminSiteUpdatePaths are the fields the admin UpdateSite can change.
+var adminSiteUpdatePaths = []string{
+	"site.config.site_name",
+	"site.config.github_repository",
+	"site.config.github_ref",
+	"site.config.compose_path",
+	"site.config.compose_file",
+	"site.config.port",
+	"site.config.application_type",
+	"site.config.up_cmd",
+	"site.config.init_cmd",
+	"site.config.rollout_cmd",
+	"site.gcp_external_ip",
+	"site.github_team_id",
+	"site.config.labels",
+}
+
 // UpdateSite updates site configuration (admin - can update all fields).
 func (s *AdminSiteService) UpdateSite(
 	ctx context.Context,
@@ -266,6 +321,11 @@ func (s *AdminSiteService) UpdateSite(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("site is required"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, adminSiteUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	projectPublicID, err := uuid.Parse(projectID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project_id format: %w", err))
@@ -287,6 +347,9 @@ func (s *AdminSiteService) UpdateSite(
 	if err != nil {
 		return nil, err
 	}
+	if err := service.CheckETag(req.Header(), site.Config.Etag, existing.Version, "site"); err != nil {
+		return nil, err
+	}
 
 	name := existing.Name
 	githubRepository := existing.GithubRepository
@@ -300,43 +363,50 @@ func (s *AdminSiteService) UpdateSite(
 	rolloutCmd := existing.RolloutCmd
 	gcpExternalIp := existing.GcpExternalIp
 	githubTeamID := existing.GithubTeamID
+	labels := existing.Labels
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.site_name") {
+	if mask.Has("site.config.site_name") {
 		name = site.Config.SiteName
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.github_repository") {
+	if mask.Has("site.config.github_repository") {
 		githubRepository = site.Config.GithubRepository
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.github_ref") {
+	if mask.Has("site.config.github_ref") {
 		githubRef = site.Config.GithubRef
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.compose_path") {
+	if mask.Has("site.config.compose_path") {
 		composePath = service.ToNullString(site.Config.ComposePath)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.compose_file") {
+	if mask.Has("site.config.compose_file") {
 		composeFile = service.ToNullString(site.Config.ComposeFile)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.port") {
+	if mask.Has("site.config.port") {
 		port = service.ToNullInt32(site.Config.Port)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.application_type") {
+	if mask.Has("site.config.application_type") {
 		applicationType = service.ToNullString(site.Config.ApplicationType)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.up_cmd") {
+	if mask.Has("site.config.up_cmd") {
 		upCmd = service.ToJSON(site.Config.UpCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.init_cmd") {
+	if mask.Has("site.config.init_cmd") {
 		initCmd = service.ToJSON(site.Config.InitCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.config.rollout_cmd") {
+	if mask.Has("site.config.rollout_cmd") {
 		rolloutCmd = service.ToJSON(site.Config.RolloutCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.gcp_external_ip") {
+	if mask.Has("site.gcp_external_ip") {
 		gcpExternalIp = service.ToNullString(service.PtrToString(site.GcpExternalIp))
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.github_team_id") {
+	if mask.Has("site.github_team_id") {
 		githubTeamID = service.ToNullString(service.PtrToString(site.GithubTeamId))
 	}
+	if mask.Has("site.config.labels") {
+		if err := validation.Labels(site.Config.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(site.Config.Labels)
+	}
 
 	siteUUID, err := uuid.Parse(existing.PublicID)
 	if err != nil {
@@ -357,8 +427,10 @@ func (s *AdminSiteService) UpdateSite(
 		RolloutCmd:       rolloutCmd,
 		GcpExternalIp:    gcpExternalIp,
 		Status:           db.NullSitesStatus{SitesStatus: db.SitesStatusActive, Valid: true},
+		Labels:           labels,
 		UpdatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:         siteUUID.String(),
+		Version:          existing.Version,
 	}
 
 	err = s.repo.UpdateSite(ctx, params)
@@ -366,9 +438,12 @@ func (s *AdminSiteService) UpdateSite(
 		return nil, err
 	}
 
-	return connect.NewResponse(&libopsv1.AdminUpdateSiteResponse{
+	site.Config.Etag = service.ETag(existing.Version + 1)
+	resp := connect.NewResponse(&libopsv1.AdminUpdateSiteResponse{
 		Site: site,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteSite deletes a site.
@@ -436,12 +511,13 @@ func (s *AdminSiteService) GetSiteSSHKeys(
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site not found: %w", err))
 	}
 
-	// Query SSH keys with inheritance (site → project → org → relationships)
+	// Query SSH keys with inheritance (site → project → org → relationships) and elevations
 	keys, err := s.repo.db.GetSiteSSHKeysForVM(ctx, db.GetSiteSSHKeysForVMParams{
-		SiteID: site.ID,
-		ID:     site.ID,
-		ID_2:   site.ID,
-		ID_3:   site.ID,
+		SiteID:   site.ID,
+		ID:       site.ID,
+		ID_2:     site.ID,
+		ID_3:     site.ID,
+		SiteID_2: site.ID,
 	})
 	if err != nil {
 		slog.Error("failed to fetch site SSH keys", "site_id", siteID, "error", err)
@@ -450,12 +526,36 @@ func (s *AdminSiteService) GetSiteSSHKeys(
 
 	// Convert to proto format
 	protoKeys := make([]*libopsv1.SSHKey, 0, len(keys))
+	members := make(map[string]bool, len(keys))
 	for _, key := range keys {
+		members[key.AccountPublicID] = true
 		protoKeys = append(protoKeys, &libopsv1.SSHKey{
 			PublicKey:      key.PublicKey,
 			Name:           service.FromNullString(key.Name),
 			Fingerprint:    service.FromNullString(key.Fingerprint),
 			GithubUsername: service.FromNullString(key.GithubUsername),
+			AccountId:      key.AccountPublicID,
+		})
+	}
+
+	// Accounts granted SSH access get it as granted, unless their role
+	// already gives them a shell
+	granted, err := s.repo.db.GetSiteSshAccessKeysForVM(ctx, site.ID)
+	if err != nil {
+		slog.Error("failed to fetch site SSH access keys", "site_id", siteID, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch SSH keys: %w", err))
+	}
+	for _, key := range granted {
+		if members[key.AccountPublicID] {
+			continue
+		}
+		protoKeys = append(protoKeys, &libopsv1.SSHKey{
+			PublicKey:      key.PublicKey,
+			Name:           service.FromNullString(key.Name),
+			Fingerprint:    service.FromNullString(key.Fingerprint),
+			GithubUsername: service.FromNullString(key.GithubUsername),
+			AccountId:      key.AccountPublicID,
+			SftpOnly:       key.SftpOnly,
 		})
 	}
 
@@ -496,9 +596,17 @@ func (s *AdminSiteService) GetSiteSecrets(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch secrets: %w", err))
 	}
 
-	// Convert to proto format
+	// Convert to proto format. References to external secrets are handed to
+	// the controller to resolve with the VM's own identity.
 	protoSecrets := make([]*libopsv1.Secret, 0, len(secrets))
 	for _, secret := range secrets {
+		if secret.Reference.Valid {
+			protoSecrets = append(protoSecrets, &libopsv1.Secret{
+				Key:       secret.Key,
+				Reference: secret.Reference.String,
+			})
+			continue
+		}
 		protoSecrets = append(protoSecrets, &libopsv1.Secret{
 			Key:   secret.Key,
 			Value: secret.Value,
@@ -582,7 +690,13 @@ func (s *AdminSiteService) GetSiteFirewall(
 	}), nil
 }
 
+// SetRegions lets check-ins tell controllers which API region serves their site.
+func (s *AdminSiteService) SetRegions(regions *region.Resolver) {
+	s.regions = regions
+}
+
 // SiteCheckIn updates the site's check-in timestamp (called by VM controller).
+// The response carries the site status so the controller can stop a suspended site.
 func (s *AdminSiteService) SiteCheckIn(
 	ctx context.Context,
 	req *connect.Request[libopsv1.SiteCheckInRequest],
@@ -610,14 +724,137 @@ func (s *AdminSiteService) SiteCheckIn(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update check-in: %w", err))
 	}
 
+	if req.Msg.EgressBytes > 0 || req.Msg.DiskUsedBytes > 0 {
+		// Usage is best-effort: a metering failure must not mark the site offline
+		if err := s.recordCheckInUsage(ctx, site, req.Msg); err != nil {
+			slog.Error("failed to record site usage", "site_id", siteID, "error", err)
+		}
+	}
+
+	if len(req.Msg.WafBlocks) > 0 {
+		// Like usage, the WAF report must not fail the check-in
+		if err := s.repo.recordWafBlocks(ctx, site.ID, req.Msg.WafBlocks); err != nil {
+			slog.Error("failed to record WAF blocks", "site_id", siteID, "error", err)
+		}
+	}
+
+	if len(req.Msg.RateLimitRejections) > 0 {
+		if err := s.repo.recordRateLimitRejections(ctx, site.ID, req.Msg.RateLimitRejections); err != nil {
+			slog.Error("failed to record rate limit rejections", "site_id", siteID, "error", err)
+		}
+	}
+
+	if req.Msg.ControllerVersion != "" {
+		err := s.repo.db.UpdateSiteControllerVersion(ctx, db.UpdateSiteControllerVersionParams{
+			ControllerVersion: sql.NullString{String: req.Msg.ControllerVersion, Valid: true},
+			ID:                site.ID,
+		})
+		if err != nil {
+			slog.Error("failed to record controller version", "site_id", siteID, "error", err)
+		}
+	}
+
+	if req.Msg.OsVersion != "" {
+		err := s.repo.db.UpdateSiteOsStatus(ctx, db.UpdateSiteOsStatusParams{
+			OsVersion:        sql.NullString{String: req.Msg.OsVersion, Valid: true},
+			OsRebootRequired: req.Msg.OsRebootRequired,
+			ID:               site.ID,
+		})
+		if err != nil {
+			slog.Error("failed to record OS status", "site_id", siteID, "error", err)
+		}
+	}
+
+	if req.Msg.ContainersRunning != nil {
+		err := s.repo.db.UpdateSiteContainers(ctx, db.UpdateSiteContainersParams{
+			ContainersRunning:   sql.NullInt32{Int32: req.Msg.GetContainersRunning(), Valid: true},
+			ContainersUnhealthy: sql.NullInt32{Int32: req.Msg.GetContainersUnhealthy(), Valid: true},
+			ID:                  site.ID,
+		})
+		if err != nil {
+			slog.Error("failed to record container health", "site_id", siteID, "error", err)
+		}
+	}
+
+	// Without its config the controller keeps what it last applied, so a
+	// failure here must not fail the check-in either
+	controllerConfig, err := controllerconfig.Effective(ctx, s.repo.db, site.ID)
+	if err != nil {
+		slog.Error("failed to get controller config", "site_id", siteID, "error", err)
+	}
+	// Likewise, the controller keeps running its release until it gets this
+	controllerRelease, err := controllerconfig.Release(ctx, s.repo.db, controllerConfig)
+	if err != nil {
+		slog.Error("failed to get controller release", "site_id", siteID, "error", err)
+	}
+
+	// Without a region the controller keeps the endpoint it has
+	apiRegion, apiURL, err := s.regions.SiteEndpoint(ctx, site.ID)
+	if err != nil {
+		slog.Error("failed to resolve site API region", "site_id", siteID, "error", err)
+	}
+
 	slog.Info("site checked in successfully", "site_id", siteID)
 
 	return connect.NewResponse(&libopsv1.SiteCheckInResponse{
-		Success: true,
-		Message: "Check-in successful",
+		Success:           true,
+		Message:           "Check-in successful",
+		Status:            string(site.Status.SitesStatus),
+		ControllerConfig:  controllerConfig,
+		ControllerRelease: controllerRelease,
+		ApiRegion:         apiRegion,
+		ApiUrl:            apiURL,
 	}), nil
 }
 
+// recordCheckInUsage adds the egress reported by a site's controller to its project's
+// daily counter and refreshes the project's disk usage gauge.
+func (s *AdminSiteService) recordCheckInUsage(ctx context.Context, site db.GetSiteRow, msg *libopsv1.SiteCheckInRequest) error {
+	project, err := s.repo.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	if msg.EgressBytes > 0 {
+		err := s.repo.db.AddProjectUsage(ctx, db.AddProjectUsageParams{
+			OrganizationID: project.OrganizationID,
+			ProjectID:      project.ID,
+			Metric:         billing.MetricEgressBytes,
+			UsageDate:      today,
+			Quantity:       msg.EgressBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add egress usage: %w", err)
+		}
+	}
+
+	if msg.DiskUsedBytes > 0 {
+		err := s.repo.db.UpdateSiteDiskUsage(ctx, db.UpdateSiteDiskUsageParams{
+			DiskUsedBytes: sql.NullInt64{Int64: msg.DiskUsedBytes, Valid: true},
+			ID:            site.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update site disk usage: %w", err)
+		}
+		total, err := s.repo.db.SumProjectSiteDiskUsage(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("failed to sum project disk usage: %w", err)
+		}
+		err = s.repo.db.UpsertProjectUsage(ctx, db.UpsertProjectUsageParams{
+			OrganizationID: project.OrganizationID,
+			ProjectID:      project.ID,
+			Metric:         billing.MetricDiskUsedBytes,
+			UsageDate:      today,
+			Quantity:       total,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record disk usage: %w", err)
+		}
+	}
+	return nil
+}
+
 // SshKeysResponse is the JSON response format for SSH keys.
 type SshKeysResponse struct {
 	SshKeys []string `json:"ssh_keys"`
@@ -655,6 +892,168 @@ func (s *AdminSiteService) GetBlob(
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("get blob not yet implemented"))
 }
 
+// GetSiteProxyConfig returns what the controller renders into a site's
+// reverse proxy: its redirects, most specific first, its access protection,
+// its WAF, its rate limits and its TLS policy.
+func (s *AdminSiteService) GetSiteProxyConfig(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteProxyConfigRequest],
+) (*connect.Response[libopsv1.GetSiteProxyConfigResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	redirects, err := s.repo.db.ListAllSiteRedirects(ctx, site.ID)
+	if err != nil {
+		slog.Error("Failed to list site redirects", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.GetSiteProxyConfigResponse{
+		Redirects: make([]*libopsv1.Redirect, 0, len(redirects)),
+	}
+	for _, redirect := range redirects {
+		resp.Redirects = append(resp.Redirects, redirectToProto(site.PublicID, db.GetSiteRedirectRow(redirect)))
+	}
+
+	protection, err := s.repo.GetAccessProtection(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	resp.Access = &libopsv1.SiteProxyAccess{
+		Mode:                  dbSiteAccessModeToProto(protection.Mode),
+		BasicAuthUsername:     protection.BasicAuthUsername.String,
+		BasicAuthPasswordHash: protection.BasicAuthPasswordHash.String,
+	}
+	if protection.Mode == db.SiteAccessProtectionsModeMembersOnly {
+		secret, err := siteaccess.GateSecret(ctx, s.repo.db, site.ID)
+		if err != nil {
+			slog.Error("Failed to get site gate secret", "site_id", site.PublicID, "err", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		resp.Access.GateSecret = secret
+		resp.Access.GateUrl = siteaccess.GateURL(s.dashBaseURL, site.PublicID)
+	}
+
+	waf, err := s.repo.GetWaf(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	resp.Waf = &libopsv1.SiteProxyWaf{
+		Enabled:       waf.Enabled,
+		Mode:          dbWafModeToProto(waf.Mode),
+		ParanoiaLevel: int32(waf.ParanoiaLevel),
+		Exclusions:    wafRuleExclusionsToProto(waf.exclusions),
+	}
+
+	rateLimits, err := s.repo.ListRateLimitRules(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp.RateLimits = rateLimitRulesToProto(site.PublicID, rateLimits)
+
+	resp.Tls, err = s.proxyTls(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// proxyTls returns a site's TLS policy as the controller renders it, with
+// the private key of its custom certificate read from the organization's Vault.
+func (s *AdminSiteService) proxyTls(ctx context.Context, site db.GetSiteRow) (*libopsv1.SiteProxyTls, error) {
+	policy, err := s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := s.repo.db.ListSiteCdnDomains(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	tls := &libopsv1.SiteProxyTls{
+		MinVersion:            dbTlsVersionToProto(policy.MinVersion),
+		HstsMaxAgeSeconds:     policy.HstsMaxAgeSeconds,
+		HstsIncludeSubdomains: policy.HstsIncludeSubdomains,
+		HstsPreload:           policy.HstsPreload,
+		OcspStapling:          policy.OcspStapling,
+		Domains:               domains,
+	}
+	if policy.CertificateSource != db.SiteTlsPoliciesCertificateSourceCustom {
+		return tls, nil
+	}
+
+	// Without its key the site can't serve the certificate, and falling back
+	// to the ACME one would quietly undo the upload
+	project, err := s.repo.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	store, err := s.vault(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("Failed to open organization vault", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read TLS private key"))
+	}
+	secret, err := store.ReadSecret(ctx, policy.PrivateKeyVaultPath.String)
+	if err != nil {
+		slog.Error("Failed to read TLS private key from vault", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read TLS private key"))
+	}
+	key, _ := secret[tlsPrivateKeyField].(string)
+	if key == "" {
+		slog.Error("TLS private key missing from vault", "site_id", site.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read TLS private key"))
+	}
+	tls.CertificatePem = policy.CertificatePem.String
+	tls.PrivateKeyPem = key
+	return tls, nil
+}
+
+// ReportSiteTlsProbe records whether a site passed the probe its controller
+// ran after applying the site's TLS policy.
+func (s *AdminSiteService) ReportSiteTlsProbe(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteTlsProbeRequest],
+) (*connect.Response[libopsv1.ReportSiteTlsProbeResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	status := db.SiteTlsPoliciesProbeStatusFailed
+	message := truncate(req.Msg.Message, 1024)
+	if req.Msg.Passed {
+		status = db.SiteTlsPoliciesProbeStatusPassed
+		message = ""
+	}
+
+	// Sites with the default policy are probed too, and need a row to record it
+	if err := s.repo.db.InitSiteTlsPolicy(ctx, site.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	err = s.repo.db.RecordSiteTlsProbe(ctx, db.RecordSiteTlsProbeParams{
+		ProbeStatus:  status,
+		ProbeMessage: message,
+		SiteID:       site.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if !req.Msg.Passed {
+		slog.Warn("site failed its TLS probe", "site_id", site.PublicID, "message", message)
+	}
+
+	return connect.NewResponse(&libopsv1.ReportSiteTlsProbeResponse{Success: true}), nil
+}
+
 // HandleSiteSshKeys returns SSH keys for all owners and developers of a site.
 // This is a plain HTTP handler for VM reconciliation services.
 func (s *AdminSiteService) HandleSiteSshKeys(w http.ResponseWriter, r *http.Request) {
@@ -685,3 +1084,327 @@ func (s *AdminSiteService) HandleSiteSshKeys(w http.ResponseWriter, r *http.Requ
 		return
 	}
 }
+
+// GetSiteDatabases returns the co-located databases a site VM's controller
+// runs on its MySQL server, with their users' passwords.
+func (s *AdminSiteService) GetSiteDatabases(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteDatabasesRequest],
+) (*connect.Response[libopsv1.GetSiteDatabasesResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.db.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.GetSiteDatabasesResponse{}
+	var store secretStore
+	for _, row := range rows {
+		if row.Tier != db.SiteDatabasesTierColocated {
+			continue
+		}
+		if store == nil {
+			store, err = s.siteVault(ctx, site)
+			if err != nil {
+				return nil, err
+			}
+		}
+		path := vault.BuildSiteSecretPath(site.PublicID, service.SiteDatabaseSecretNames(row.Name).Password)
+		secret, err := store.ReadSecret(ctx, path)
+		if err != nil {
+			slog.Error("Failed to read database password from vault", "site_id", site.PublicID, "database", row.Name, "err", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read database password"))
+		}
+		password, _ := secret["value"].(string)
+		if password == "" {
+			slog.Error("Database password missing from vault", "site_id", site.PublicID, "database", row.Name)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read database password"))
+		}
+		resp.Databases = append(resp.Databases, &libopsv1.ColocatedDatabase{
+			Name:     row.Name,
+			Password: password,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ReportSiteDatabases records which of a site's co-located databases its
+// controller provisioned, writing the server's address to the secrets of
+// the ones it did.
+func (s *AdminSiteService) ReportSiteDatabases(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteDatabasesRequest],
+) (*connect.Response[libopsv1.ReportSiteDatabasesResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := validation.IPAddress(req.Msg.Host); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("host: %w", err))
+	}
+	if req.Msg.Port < 1 || req.Msg.Port > 65535 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("port must be between 1 and 65535"))
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	reported := make(map[string]string, len(req.Msg.Databases))
+	for _, database := range req.Msg.Databases {
+		reported[database.Name] = database.Error
+	}
+
+	rows, err := s.repo.db.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	var store secretStore
+	for _, row := range rows {
+		failure, ok := reported[row.Name]
+		if !ok || row.Tier != db.SiteDatabasesTierColocated {
+			continue
+		}
+		if failure != "" {
+			err := s.repo.db.FailSiteDatabase(ctx, db.FailSiteDatabaseParams{
+				StatusMessage: sql.NullString{String: truncate(failure, 1024), Valid: true},
+				ID:            row.ID,
+			})
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+			}
+			slog.Warn("site database failed to provision", "site_id", site.PublicID, "database", row.Name, "error", failure)
+			continue
+		}
+
+		if store == nil {
+			store, err = s.siteVault(ctx, site)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if _, err := service.ActivateSiteDatabase(ctx, s.repo.db, store, site.PublicID, db.GetSiteDatabaseRow(row), req.Msg.Host, req.Msg.Port); err != nil {
+			slog.Error("Failed to activate site database", "site_id", site.PublicID, "database", row.Name, "err", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to activate database %s", row.Name))
+		}
+	}
+
+	rows, err = s.repo.db.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	resp := &libopsv1.ReportSiteDatabasesResponse{}
+	for _, row := range rows {
+		resp.Databases = append(resp.Databases, service.SiteDatabaseToProto(site.PublicID, db.GetSiteDatabaseRow(row)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetSiteConfigVars returns a site's config vars, which its VM controller
+// writes next to its secrets (called by VM controller with GSA auth).
+func (s *AdminSiteService) GetSiteConfigVars(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteConfigVarsRequest],
+) (*connect.Response[libopsv1.GetSiteConfigVarsResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.repo.db.ListSiteInheritedSettings(ctx, db.ListSiteInheritedSettingsParams{SiteID: site.ID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	rows, err := s.repo.db.ListAllSiteConfigVars(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	revision, err := s.repo.db.GetLatestSiteConfigVarRevision(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	// Environment settings come in the order they override each other, from
+	// the organization's to the site's, and the site's config vars override
+	// them all
+	vars := make(map[string]string, len(settings)+len(rows))
+	for _, setting := range settings {
+		if organization.IsEnvironmentSetting(setting.SettingKey) {
+			vars[setting.SettingKey] = setting.SettingValue
+		}
+	}
+	for _, row := range rows {
+		vars[row.Name] = row.Value
+	}
+
+	resp := &libopsv1.GetSiteConfigVarsResponse{
+		ConfigVars: make([]*libopsv1.Secret, 0, len(vars)),
+		Revision:   revision,
+	}
+	for _, name := range slices.Sorted(maps.Keys(vars)) {
+		resp.ConfigVars = append(resp.ConfigVars, &libopsv1.Secret{Key: name, Value: vars[name]})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetSiteAddons returns the add-ons a site VM's controller runs, with the
+// passwords of the ones that have credentials.
+func (s *AdminSiteService) GetSiteAddons(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteAddonsRequest],
+) (*connect.Response[libopsv1.GetSiteAddonsResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.db.ListSiteAddons(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.GetSiteAddonsResponse{}
+	var store secretStore
+	for _, row := range rows {
+		spec := &libopsv1.AddonSpec{Kind: string(row.Kind)}
+		if name := addonSecrets(row.Kind).Password; name != "" {
+			if store == nil {
+				store, err = s.siteVault(ctx, site)
+				if err != nil {
+					return nil, err
+				}
+			}
+			secret, err := store.ReadSecret(ctx, vault.BuildSiteSecretPath(site.PublicID, name))
+			if err != nil {
+				slog.Error("Failed to read add-on password from vault", "site_id", site.PublicID, "addon", row.Kind, "err", err)
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read add-on password"))
+			}
+			spec.Password, _ = secret["value"].(string)
+			if spec.Password == "" {
+				slog.Error("Add-on password missing from vault", "site_id", site.PublicID, "addon", row.Kind)
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read add-on password"))
+			}
+		}
+		resp.Addons = append(resp.Addons, spec)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ReportSiteAddons records the health of a site's add-ons, writing where the
+// site reaches each healthy one to its host and port secrets when that's new.
+func (s *AdminSiteService) ReportSiteAddons(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportSiteAddonsRequest],
+) (*connect.Response[libopsv1.ReportSiteAddonsResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	reported := make(map[db.SiteAddonsKind]*libopsv1.ReportedAddon, len(req.Msg.Addons))
+	for _, addon := range req.Msg.Addons {
+		if addon.Error != "" && addon.Host == "" {
+			reported[db.SiteAddonsKind(addon.Kind)] = addon
+			continue
+		}
+		if err := validation.IPAddress(addon.Host); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s host: %w", addon.Kind, err))
+		}
+		if addon.Port < 1 || addon.Port > 65535 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s port must be between 1 and 65535", addon.Kind))
+		}
+		reported[db.SiteAddonsKind(addon.Kind)] = addon
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.db.ListSiteAddons(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	var store secretStore
+	for _, row := range rows {
+		addon, ok := reported[row.Kind]
+		if !ok {
+			continue
+		}
+		health := db.ReportSiteAddonHealthParams{
+			Status: db.SiteAddonsStatusHealthy,
+			Host:   row.Host,
+			Port:   row.Port,
+			ID:     row.ID,
+		}
+		if addon.Host != "" {
+			health.Host = sql.NullString{String: addon.Host, Valid: true}
+			health.Port = sql.NullInt32{Int32: addon.Port, Valid: true}
+		}
+		if addon.Error != "" {
+			health.Status = db.SiteAddonsStatusUnhealthy
+			health.StatusMessage = sql.NullString{String: truncate(addon.Error, 1024), Valid: true}
+			if row.Status != db.SiteAddonsStatusUnhealthy {
+				slog.Warn("site add-on is unhealthy", "site_id", site.PublicID, "addon", row.Kind, "error", addon.Error)
+			}
+		}
+
+		// The site learns where an add-on is the first time it's healthy
+		// there; the controller's reconciliation of secrets comes next
+		moved := health.Host != row.Host || health.Port != row.Port
+		if health.Status == db.SiteAddonsStatusHealthy && (moved || row.Status == db.SiteAddonsStatusProvisioning) {
+			if store == nil {
+				store, err = s.siteVault(ctx, site)
+				if err != nil {
+					return nil, err
+				}
+			}
+			secrets := addonSecrets(row.Kind)
+			err := service.WriteManagedSiteSecret(ctx, s.repo.db, store, site.PublicID, site.ID, secrets.Host, health.Host.String)
+			if err == nil {
+				err = service.WriteManagedSiteSecret(ctx, s.repo.db, store, site.PublicID, site.ID, secrets.Port, strconv.Itoa(int(health.Port.Int32)))
+			}
+			if err != nil {
+				slog.Error("Failed to write site add-on address", "site_id", site.PublicID, "addon", row.Kind, "err", err)
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write the address of %s", row.Kind))
+			}
+		}
+
+		if err := s.repo.db.ReportSiteAddonHealth(ctx, health); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	addons, err := listAddons(ctx, s.repo.db, site)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ReportSiteAddonsResponse{Addons: addons}), nil
+}
+
+// siteVault opens the Vault of the site's organization.
+func (s *AdminSiteService) siteVault(ctx context.Context, site db.GetSiteRow) (secretStore, error) {
+	project, err := s.repo.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	store, err := s.vault(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("Failed to open organization vault", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+	}
+	return store, nil
+}
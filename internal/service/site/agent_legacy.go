@@ -0,0 +1,180 @@
+package site
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/internal/apiversion"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// The handlers below serve the REST paths controllers called before
+// SiteAgentService existed. Each one decodes the old request, calls the
+// service and writes the response in the shape the old controllers read.
+// They're deprecated and go once every site runs a controller built against
+// SiteAgentService.
+
+// legacyDeprecated is when the REST paths were replaced by SiteAgentService
+var legacyDeprecated = time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+// legacyErrors writes service errors with the HTTP status Connect maps their code to
+var legacyErrors = connect.NewErrorWriter()
+
+// legacyDeploymentResponse is the deployment as the old controllers read it
+type legacyDeploymentResponse struct {
+	DeploymentID   string `json:"deployment_id"`
+	GitHubRepo     string `json:"github_repo"`
+	GitHubRef      string `json:"github_ref"`
+	DeploymentPath string `json:"deployment_path,omitempty"`
+	ComposeFile    string `json:"compose_file,omitempty"`
+	CommitSHA      string `json:"commit_sha,omitempty"`
+	CommitMessage  string `json:"commit_message,omitempty"`
+}
+
+// HandleLegacyCheckIn serves POST /admin/sites/{siteId}/checkin.
+func (s *SiteAgentService) HandleLegacyCheckIn(w http.ResponseWriter, r *http.Request) {
+	msg := &libopsv1.SiteCheckInRequest{}
+	if !decodeLegacyRequest(w, r, msg) {
+		return
+	}
+	msg.SiteId = r.PathValue("siteId")
+	resp, err := s.CheckIn(r.Context(), connect.NewRequest(msg))
+	writeLegacyResponse(w, r, resp, err)
+}
+
+// HandleLegacySecrets serves GET /admin/sites/{siteId}/secrets.
+func (s *SiteAgentService) HandleLegacySecrets(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.GetSecrets(r.Context(), connect.NewRequest(&libopsv1.GetSiteSecretsRequest{SiteId: r.PathValue("siteId")}))
+	writeLegacyResponse(w, r, resp, err)
+}
+
+// HandleLegacyFirewall serves GET /admin/sites/{siteId}/firewall.
+func (s *SiteAgentService) HandleLegacyFirewall(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.GetFirewall(r.Context(), connect.NewRequest(&libopsv1.GetSiteFirewallRequest{SiteId: r.PathValue("siteId")}))
+	writeLegacyResponse(w, r, resp, err)
+}
+
+// HandleLegacyDeployment serves GET /admin/sites/{siteId}/deployment.
+func (s *SiteAgentService) HandleLegacyDeployment(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.GetDeployment(r.Context(), connect.NewRequest(&libopsv1.GetAgentDeploymentRequest{SiteId: r.PathValue("siteId")}))
+	if err != nil {
+		writeLegacyResponse[libopsv1.GetAgentDeploymentResponse](w, r, nil, err)
+		return
+	}
+
+	deployment := resp.Msg.Deployment
+	setLegacyHeaders(w)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(legacyDeploymentResponse{
+		DeploymentID:   deployment.DeploymentId,
+		GitHubRepo:     deployment.GithubRepo,
+		GitHubRef:      deployment.GitRef,
+		DeploymentPath: deployment.ComposePath,
+		ComposeFile:    deployment.ComposeFile,
+		CommitSHA:      deployment.CommitSha,
+		CommitMessage:  deployment.CommitMessage,
+	}); err != nil {
+		slog.Error("failed to encode response", "error", err)
+	}
+}
+
+// HandleLegacyDeploymentStatus serves POST /admin/deployments/{deploymentId}/status.
+// The route must be wrapped in WithDeploymentSite so the site is known.
+func (s *SiteAgentService) HandleLegacyDeploymentStatus(w http.ResponseWriter, r *http.Request) {
+	msg := &libopsv1.ReportAgentDeploymentStatusRequest{}
+	if !decodeLegacyRequest(w, r, msg) {
+		return
+	}
+	msg.SiteId = r.PathValue("siteId")
+	msg.DeploymentId = r.PathValue("deploymentId")
+	resp, err := s.ReportDeploymentStatus(r.Context(), connect.NewRequest(msg))
+	writeLegacyResponse(w, r, resp, err)
+}
+
+// HandleLegacyReconciliationStatus serves POST /admin/sites/{siteId}/reconciliation/status.
+func (s *SiteAgentService) HandleLegacyReconciliationStatus(w http.ResponseWriter, r *http.Request) {
+	msg := &libopsv1.ReportAgentReconciliationStatusRequest{}
+	if !decodeLegacyRequest(w, r, msg) {
+		return
+	}
+	msg.SiteId = r.PathValue("siteId")
+	resp, err := s.ReportReconciliationStatus(r.Context(), connect.NewRequest(msg))
+	writeLegacyResponse(w, r, resp, err)
+}
+
+// WithDeploymentSite sets the siteId path value of deployment routes to the
+// deployment's site, so the site GSA middleware wrapped by it can check the
+// caller is that site's VM.
+func (s *SiteAgentService) WithDeploymentSite(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deployment, err := s.admin.repo.db.GetDeployment(r.Context(), r.PathValue("deploymentId"))
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "deployment not found", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			slog.Error("failed to get deployment", "deployment_id", r.PathValue("deploymentId"), "error", err)
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+		r.SetPathValue("siteId", deployment.SiteID)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// decodeLegacyRequest decodes a JSON body into msg, accepting the snake_case
+// field names the old controllers send and ignoring fields the API drops
+func decodeLegacyRequest(w http.ResponseWriter, r *http.Request, msg proto.Message) bool {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return false
+	}
+	if len(body) == 0 {
+		return true
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeLegacyResponse writes a service response as JSON, or its error
+func writeLegacyResponse[T any](w http.ResponseWriter, r *http.Request, resp *connect.Response[T], err error) {
+	setLegacyHeaders(w)
+	if err != nil {
+		_ = legacyErrors.Write(w, r, err)
+		return
+	}
+	msg, ok := any(resp.Msg).(proto.Message)
+	if !ok {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	body, err := protojson.Marshal(msg)
+	if err != nil {
+		slog.Error("failed to encode response", "error", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// setLegacyHeaders marks a response as coming from a deprecated path (RFC 9745)
+// and points at its replacement
+func setLegacyHeaders(w http.ResponseWriter) {
+	w.Header().Set(apiversion.HeaderDeprecation, fmt.Sprintf("@%d", legacyDeprecated.Unix()))
+	w.Header().Set(apiversion.HeaderLink, `</libops.v1.SiteAgentService>; rel="successor-version"`)
+}
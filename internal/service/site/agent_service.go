@@ -0,0 +1,191 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// reconciliationTypes are the changes a controller reports applying
+var reconciliationTypes = []string{"ssh_keys", "secrets", "firewall", "deployment"}
+
+// SiteAgentService implements the API site VM controllers call. Reads and
+// check-ins are shared with the admin site service; deployments and their
+// status reports were only reachable over the deprecated REST paths before.
+type SiteAgentService struct {
+	admin *AdminSiteService
+}
+
+// Compile-time check.
+var _ libopsv1connect.SiteAgentServiceHandler = (*SiteAgentService)(nil)
+
+// NewSiteAgentService creates a site agent service backed by the admin site service.
+func NewSiteAgentService(admin *AdminSiteService) *SiteAgentService {
+	return &SiteAgentService{admin: admin}
+}
+
+// GetSSHKeys returns the SSH keys of the site's members.
+func (s *SiteAgentService) GetSSHKeys(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteSSHKeysRequest],
+) (*connect.Response[libopsv1.GetSiteSSHKeysResponse], error) {
+	return s.admin.GetSiteSSHKeys(ctx, req)
+}
+
+// GetSecrets returns the site's secrets.
+func (s *SiteAgentService) GetSecrets(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteSecretsRequest],
+) (*connect.Response[libopsv1.GetSiteSecretsResponse], error) {
+	return s.admin.GetSiteSecrets(ctx, req)
+}
+
+// GetFirewall returns the site's firewall rules.
+func (s *SiteAgentService) GetFirewall(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteFirewallRequest],
+) (*connect.Response[libopsv1.GetSiteFirewallResponse], error) {
+	return s.admin.GetSiteFirewall(ctx, req)
+}
+
+// CheckIn records that the site's VM is up.
+func (s *SiteAgentService) CheckIn(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SiteCheckInRequest],
+) (*connect.Response[libopsv1.SiteCheckInResponse], error) {
+	return s.admin.SiteCheckIn(ctx, req)
+}
+
+// GetDeployment returns the site's latest deployment with the repository and
+// compose file the controller deploys it from.
+func (s *SiteAgentService) GetDeployment(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetAgentDeploymentRequest],
+) (*connect.Response[libopsv1.GetAgentDeploymentResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.admin.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId))
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := s.admin.repo.db.GetLatestSiteDeployment(ctx, site.PublicID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site has no deployments"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	gitRef := site.GithubRef
+	if deployment.GitRef.Valid {
+		gitRef = deployment.GitRef.String
+	}
+	return connect.NewResponse(&libopsv1.GetAgentDeploymentResponse{
+		Deployment: &libopsv1.AgentDeployment{
+			DeploymentId:  deployment.ID,
+			GithubRepo:    site.GithubRepository,
+			GitRef:        gitRef,
+			CommitSha:     deployment.CommitSha.String,
+			CommitMessage: deployment.CommitMessage.String,
+			ComposePath:   site.ComposePath.String,
+			ComposeFile:   site.ComposeFile.String,
+		},
+	}), nil
+}
+
+// ReportDeploymentStatus records how the controller's run of one of the
+// site's deployments went.
+func (s *SiteAgentService) ReportDeploymentStatus(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportAgentDeploymentStatusRequest],
+) (*connect.Response[libopsv1.ReportAgentDeploymentStatusResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	status := db.DeploymentsStatus(req.Msg.Status)
+	switch status {
+	case db.DeploymentsStatusInProgress, db.DeploymentsStatusSuccess, db.DeploymentsStatusFailed:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("status must be in_progress, success or failed"))
+	}
+
+	deployment, err := s.admin.repo.db.GetDeployment(ctx, req.Msg.DeploymentId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && deployment.SiteID != req.Msg.SiteId) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	params := db.UpdateDeploymentParams{
+		ID:           deployment.ID,
+		Status:       status,
+		GithubRunID:  deployment.GithubRunID,
+		GithubRunUrl: deployment.GithubRunUrl,
+		CompletedAt:  deployment.CompletedAt,
+		ErrorMessage: deployment.ErrorMessage,
+	}
+	if status != db.DeploymentsStatusInProgress {
+		params.CompletedAt = sql.NullInt64{Int64: time.Now().Unix(), Valid: true}
+	}
+	if req.Msg.Error != "" {
+		params.ErrorMessage = sql.NullString{String: req.Msg.Error, Valid: true}
+	}
+	if err := s.admin.repo.db.UpdateDeployment(ctx, params); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.Info("controller reported deployment status",
+		"site_id", req.Msg.SiteId,
+		"deployment_id", deployment.ID,
+		"status", status)
+	return connect.NewResponse(&libopsv1.ReportAgentDeploymentStatusResponse{}), nil
+}
+
+// ReportReconciliationStatus records how the controller applied a type of
+// change to the VM. Reconciliation runs are completed by the orchestrator
+// that requested them, so the report is only logged.
+func (s *SiteAgentService) ReportReconciliationStatus(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReportAgentReconciliationStatusRequest],
+) (*connect.Response[libopsv1.ReportAgentReconciliationStatusResponse], error) {
+	if err := validation.UUID(req.Msg.SiteId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if !slices.Contains(reconciliationTypes, req.Msg.Type) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown reconciliation type %q", req.Msg.Type))
+	}
+	if req.Msg.Status != "active" && req.Msg.Status != "failed" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("status must be active or failed"))
+	}
+	if _, err := s.admin.repo.GetSiteByPublicID(ctx, uuid.MustParse(req.Msg.SiteId)); err != nil {
+		return nil, err
+	}
+
+	attrs := []any{
+		"site_id", req.Msg.SiteId,
+		"type", req.Msg.Type,
+		"status", req.Msg.Status,
+		"resources", len(req.Msg.ResourceIds),
+	}
+	if req.Msg.Status == "failed" {
+		slog.Warn("controller reconciliation failed", append(attrs, "error", req.Msg.Error)...)
+	} else {
+		slog.Info("controller reconciliation applied", attrs...)
+	}
+	return connect.NewResponse(&libopsv1.ReportAgentReconciliationStatusResponse{}), nil
+}
@@ -0,0 +1,155 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// newAgentTestQuerier returns a querier with one site and one of its deployments
+func newAgentTestQuerier(siteID string, updated *db.UpdateDeploymentParams) *testutils.MockQuerier {
+	deployment := db.Deployment{
+		ID:        "deploy-1",
+		SiteID:    siteID,
+		Status:    db.DeploymentsStatusPending,
+		GitRef:    sql.NullString{String: "v1.2.0", Valid: true},
+		CommitSha: sql.NullString{String: "abc123", Valid: true},
+	}
+	return &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			if publicID != siteID {
+				return db.GetSiteRow{}, sql.ErrNoRows
+			}
+			return db.GetSiteRow{
+				ID:               5,
+				PublicID:         publicID,
+				GithubRepository: "libops/example",
+				GithubRef:        "main",
+				ComposeFile:      sql.NullString{String: "compose.prod.yml", Valid: true},
+			}, nil
+		},
+		GetLatestSiteDeploymentFunc: func(ctx context.Context, id string) (db.Deployment, error) {
+			if id != siteID {
+				return db.Deployment{}, sql.ErrNoRows
+			}
+			return deployment, nil
+		},
+		GetDeploymentFunc: func(ctx context.Context, id string) (db.Deployment, error) {
+			if id != deployment.ID {
+				return db.Deployment{}, sql.ErrNoRows
+			}
+			return deployment, nil
+		},
+		UpdateDeploymentFunc: func(ctx context.Context, arg db.UpdateDeploymentParams) error {
+			*updated = arg
+			return nil
+		},
+	}
+}
+
+// TestSiteAgentDeployment tests that controllers get their site's latest
+// deployment and can only report the status of their own site's deployments.
+func TestSiteAgentDeployment(t *testing.T) {
+	siteID := uuid.NewString()
+	var updated db.UpdateDeploymentParams
+	svc := NewSiteAgentService(NewAdminSiteService(newAgentTestQuerier(siteID, &updated)))
+	ctx := context.Background()
+
+	resp, err := svc.GetDeployment(ctx, connect.NewRequest(&libopsv1.GetAgentDeploymentRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, "deploy-1", resp.Msg.Deployment.DeploymentId)
+	assert.Equal(t, "libops/example", resp.Msg.Deployment.GithubRepo)
+	assert.Equal(t, "v1.2.0", resp.Msg.Deployment.GitRef, "the deployment's ref wins over the site's")
+	assert.Equal(t, "compose.prod.yml", resp.Msg.Deployment.ComposeFile)
+
+	report := func(siteID, status string) error {
+		_, err := svc.ReportDeploymentStatus(ctx, connect.NewRequest(&libopsv1.ReportAgentDeploymentStatusRequest{
+			SiteId:       siteID,
+			DeploymentId: "deploy-1",
+			Status:       status,
+			Error:        "compose up failed",
+		}))
+		return err
+	}
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(report(siteID, "pending")))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(report(uuid.NewString(), "failed")), "another site's deployment")
+
+	require.NoError(t, report(siteID, "in_progress"))
+	assert.False(t, updated.CompletedAt.Valid)
+
+	require.NoError(t, report(siteID, "failed"))
+	assert.Equal(t, db.DeploymentsStatusFailed, updated.Status)
+	assert.True(t, updated.CompletedAt.Valid)
+	assert.Equal(t, "compose up failed", updated.ErrorMessage.String)
+}
+
+// TestSiteAgentReconciliationStatus tests that reconciliation reports are validated.
+func TestSiteAgentReconciliationStatus(t *testing.T) {
+	siteID := uuid.NewString()
+	svc := NewSiteAgentService(NewAdminSiteService(newAgentTestQuerier(siteID, &db.UpdateDeploymentParams{})))
+
+	tests := []struct {
+		name    string
+		req     *libopsv1.ReportAgentReconciliationStatusRequest
+		errCode connect.Code
+	}{
+		{"applied", &libopsv1.ReportAgentReconciliationStatusRequest{SiteId: siteID, Type: "ssh_keys", Status: "active"}, 0},
+		{"failed", &libopsv1.ReportAgentReconciliationStatusRequest{SiteId: siteID, Type: "firewall", Status: "failed", Error: "iptables"}, 0},
+		{"unknown type", &libopsv1.ReportAgentReconciliationStatusRequest{SiteId: siteID, Type: "dns", Status: "active"}, connect.CodeInvalidArgument},
+		{"unknown status", &libopsv1.ReportAgentReconciliationStatusRequest{SiteId: siteID, Type: "secrets", Status: "done"}, connect.CodeInvalidArgument},
+		{"unknown site", &libopsv1.ReportAgentReconciliationStatusRequest{SiteId: uuid.NewString(), Type: "secrets", Status: "active"}, connect.CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ReportReconciliationStatus(context.Background(), connect.NewRequest(tt.req))
+			if tt.errCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.errCode, connect.CodeOf(err))
+		})
+	}
+}
+
+// TestSiteAgentLegacyRoutes tests that the deprecated REST paths answer in the
+// shape controllers built before SiteAgentService read.
+func TestSiteAgentLegacyRoutes(t *testing.T) {
+	siteID := uuid.NewString()
+	var updated db.UpdateDeploymentParams
+	svc := NewSiteAgentService(NewAdminSiteService(newAgentTestQuerier(siteID, &updated)))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/sites/{siteId}/deployment", svc.HandleLegacyDeployment)
+	mux.Handle("POST /admin/deployments/{deploymentId}/status", svc.WithDeploymentSite(http.HandlerFunc(svc.HandleLegacyDeploymentStatus)))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/sites/"+siteID+"/deployment", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Deprecation"))
+	var deployment map[string]string
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&deployment))
+	assert.Equal(t, "deploy-1", deployment["deployment_id"])
+	assert.Equal(t, "v1.2.0", deployment["github_ref"])
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/deployments/deploy-1/status", strings.NewReader(`{"status":"success","error":""}`)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, db.DeploymentsStatusSuccess, updated.Status)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/admin/deployments/missing/status", strings.NewReader(`{"status":"success"}`)))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,309 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// defaultCdnTTLSeconds is used for responses without cache headers when
+	// the request doesn't set one.
+	defaultCdnTTLSeconds = 3600
+	// maxCdnTTLSeconds is the longest TTL Cloud CDN accepts (one year).
+	maxCdnTTLSeconds = 31536000
+	// maxCachePurgePaths caps how many paths one purge request may invalidate.
+	maxCachePurgePaths = 20
+)
+
+// SiteCdnService implements the SiteCdnService API.
+type SiteCdnService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SiteCdnService implements the interface.
+var _ libopsv1connect.SiteCdnServiceHandler = (*SiteCdnService)(nil)
+
+// NewSiteCdnService creates a new SiteCdnService instance.
+func NewSiteCdnService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SiteCdnService {
+	return &SiteCdnService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// GetSiteCdn returns a site's CDN.
+func (s *SiteCdnService) GetSiteCdn(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteCdnRequest],
+) (*connect.Response[libopsv1.GetSiteCdnResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	cdn, err := s.repo.GetCdn(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+	if cdn == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the site has no CDN"))
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteCdnResponse{Cdn: cdn}), nil
+}
+
+// EnableSiteCdn records a site's CDN cache policy and queues the site's
+// reconciliation, which builds the load balancer or applies the new policy.
+func (s *SiteCdnService) EnableSiteCdn(
+	ctx context.Context,
+	req *connect.Request[libopsv1.EnableSiteCdnRequest],
+) (*connect.Response[libopsv1.EnableSiteCdnResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	cacheMode := db.SiteCdnConfigsCacheModeCacheAllStatic
+	if req.Msg.CacheMode != commonv1.CdnCacheMode_CDN_CACHE_MODE_UNSPECIFIED {
+		mode, ok := service.ProtoCdnCacheModeToDB(req.Msg.CacheMode)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown cache_mode %d", req.Msg.CacheMode))
+		}
+		cacheMode = mode
+	}
+	ttl := req.Msg.DefaultTtlSeconds
+	if ttl == 0 {
+		ttl = defaultCdnTTLSeconds
+	}
+	if ttl < 0 || ttl > maxCdnTTLSeconds {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("default_ttl_seconds must be between 0 and %d", maxCdnTTLSeconds))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteCdnConfig(ctx, site.ID)
+	switch {
+	case err == nil:
+		// Re-enabling before the load balancer is gone would race its removal
+		if existing.Status == db.SiteCdnConfigsStatusDisabling {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the site's CDN is being disabled; enable it again once that finishes"))
+		}
+		err = s.db.UpdateSiteCdnCachePolicy(ctx, db.UpdateSiteCdnCachePolicyParams{
+			CacheMode:         cacheMode,
+			DefaultTtlSeconds: ttl,
+			ID:                existing.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteCdnUpdate, map[string]any{
+			"cache_mode":          cacheMode,
+			"default_ttl_seconds": ttl,
+		})
+	case errors.Is(err, sql.ErrNoRows):
+		err = s.db.CreateSiteCdnConfig(ctx, db.CreateSiteCdnConfigParams{
+			SiteID:            site.ID,
+			CacheMode:         cacheMode,
+			DefaultTtlSeconds: ttl,
+			CreatedBy:         sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		})
+		if err != nil {
+			return nil, service.HandleDatabaseError(err, "CDN")
+		}
+		s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteCdnEnable, map[string]any{
+			"cache_mode":          cacheMode,
+			"default_ttl_seconds": ttl,
+		})
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	cdn, err := s.repo.GetCdn(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &libopsv1.EnableSiteCdnResponse{Cdn: cdn}
+	s.reconcile(ctx, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DisableSiteCdn marks a site's CDN for removal and queues the site's
+// reconciliation, which removes the load balancer. Purges that haven't run
+// are dropped with it.
+func (s *SiteCdnService) DisableSiteCdn(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DisableSiteCdnRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteCdnConfig(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the site has no CDN"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if existing.Status == db.SiteCdnConfigsStatusDisabling {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	if err := s.db.MarkSiteCdnConfigDisabling(ctx, existing.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if err := s.db.DeletePendingSiteCachePurges(ctx, site.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteCdnDisable, map[string]any{
+		"ip_address": service.FromNullString(existing.IpAddress),
+	})
+	s.reconcile(ctx, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// PurgeSiteCache queues cache invalidations for a site's CDN and the site's
+// reconciliation, which runs them.
+func (s *SiteCdnService) PurgeSiteCache(
+	ctx context.Context,
+	req *connect.Request[libopsv1.PurgeSiteCacheRequest],
+) (*connect.Response[libopsv1.PurgeSiteCacheResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	paths, err := cachePurgePaths(req.Msg.Paths)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteCdnConfig(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the site has no CDN"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if existing.Status == db.SiteCdnConfigsStatusDisabling {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the site's CDN is being disabled"))
+	}
+
+	resp := &libopsv1.PurgeSiteCacheResponse{}
+	for _, path := range paths {
+		purgeID := uuid.NewString()
+		err := s.db.CreateSiteCachePurge(ctx, db.CreateSiteCachePurgeParams{
+			PublicID:  purgeID,
+			SiteID:    site.ID,
+			Path:      path,
+			CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		purge, err := s.db.GetSiteCachePurge(ctx, purgeID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+		resp.Purges = append(resp.Purges, service.CachePurgeToProto(purge))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteCachePurge, map[string]any{
+		"paths": paths,
+	})
+	s.reconcile(ctx, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// cachePurgePaths validates the paths of a purge request, defaulting to the
+// whole site and dropping duplicates.
+func cachePurgePaths(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return []string{"/*"}, nil
+	}
+	if len(requested) > maxCachePurgePaths {
+		return nil, fmt.Errorf("at most %d paths can be purged at once", maxCachePurgePaths)
+	}
+
+	seen := make(map[string]bool, len(requested))
+	paths := make([]string, 0, len(requested))
+	for _, path := range requested {
+		path = strings.TrimSpace(path)
+		switch {
+		case !strings.HasPrefix(path, "/"):
+			return nil, fmt.Errorf("path %q must start with /", path)
+		case len(path) > 1024:
+			return nil, fmt.Errorf("path %q is longer than 1024 characters", path)
+		case strings.ContainsAny(path, " \t\r\n?#"):
+			return nil, fmt.Errorf("path %q can't contain whitespace, a query or a fragment", path)
+		case strings.Contains(strings.TrimSuffix(path, "*"), "*"):
+			return nil, fmt.Errorf("path %q can only have a * at the end", path)
+		}
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteCdnService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// reconcile queues the site's terraform, which owns the load balancer.
+func (s *SiteCdnService) reconcile(ctx context.Context, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeSiteUpdated, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit site updated event", "error", err, "site_id", siteID)
+	}
+}
@@ -0,0 +1,157 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// TestSiteCdn tests enabling a site's CDN and changing its policy, that purges
+// queue until the CDN is disabled, and that the CDN shows in the site status.
+func TestSiteCdn(t *testing.T) {
+	siteID := uuid.NewString()
+	var cdn *db.GetSiteCdnConfigRow
+	purges := map[string]db.GetSiteCachePurgeRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteCdnConfigFunc: func(ctx context.Context, id int64) (db.GetSiteCdnConfigRow, error) {
+			if cdn == nil {
+				return db.GetSiteCdnConfigRow{}, sql.ErrNoRows
+			}
+			return *cdn, nil
+		},
+		CreateSiteCdnConfigFunc: func(ctx context.Context, arg db.CreateSiteCdnConfigParams) error {
+			cdn = &db.GetSiteCdnConfigRow{
+				ID:                1,
+				SiteID:            arg.SiteID,
+				CacheMode:         arg.CacheMode,
+				DefaultTtlSeconds: arg.DefaultTtlSeconds,
+				Status:            db.SiteCdnConfigsStatusProvisioning,
+			}
+			return nil
+		},
+		UpdateSiteCdnCachePolicyFunc: func(ctx context.Context, arg db.UpdateSiteCdnCachePolicyParams) error {
+			cdn.CacheMode = arg.CacheMode
+			cdn.DefaultTtlSeconds = arg.DefaultTtlSeconds
+			return nil
+		},
+		MarkSiteCdnConfigDisablingFunc: func(ctx context.Context, id int64) error {
+			cdn.Status = db.SiteCdnConfigsStatusDisabling
+			return nil
+		},
+		CreateSiteCachePurgeFunc: func(ctx context.Context, arg db.CreateSiteCachePurgeParams) error {
+			purges[arg.PublicID] = db.GetSiteCachePurgeRow{PublicID: arg.PublicID, SiteID: arg.SiteID, Path: arg.Path, Status: db.SiteCachePurgesStatusPending}
+			return nil
+		},
+		GetSiteCachePurgeFunc: func(ctx context.Context, publicID string) (db.GetSiteCachePurgeRow, error) {
+			return purges[publicID], nil
+		},
+		ListPendingSiteCachePurgesFunc: func(ctx context.Context, id int64) ([]db.ListPendingSiteCachePurgesRow, error) {
+			var pending []db.ListPendingSiteCachePurgesRow
+			for _, purge := range purges {
+				pending = append(pending, db.ListPendingSiteCachePurgesRow(purge))
+			}
+			return pending, nil
+		},
+		DeletePendingSiteCachePurgesFunc: func(ctx context.Context, id int64) error {
+			clear(purges)
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteCdnService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	purge := func(paths ...string) (*connect.Response[libopsv1.PurgeSiteCacheResponse], error) {
+		return svc.PurgeSiteCache(ctx, connect.NewRequest(&libopsv1.PurgeSiteCacheRequest{SiteId: siteID, Paths: paths}))
+	}
+	disable := func() error {
+		_, err := svc.DisableSiteCdn(ctx, connect.NewRequest(&libopsv1.DisableSiteCdnRequest{SiteId: siteID}))
+		return err
+	}
+
+	_, err := purge()
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "there's nothing to purge without a CDN")
+
+	_, err = svc.EnableSiteCdn(ctx, connect.NewRequest(&libopsv1.EnableSiteCdnRequest{SiteId: siteID, DefaultTtlSeconds: -1}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	enabled, err := svc.EnableSiteCdn(ctx, connect.NewRequest(&libopsv1.EnableSiteCdnRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, commonv1.CdnCacheMode_CDN_CACHE_MODE_CACHE_ALL_STATIC, enabled.Msg.Cdn.CacheMode)
+	assert.Equal(t, int32(3600), enabled.Msg.Cdn.DefaultTtlSeconds)
+	assert.Equal(t, commonv1.SiteCdnStatus_SITE_CDN_STATUS_PROVISIONING, enabled.Msg.Cdn.Status)
+
+	updated, err := svc.EnableSiteCdn(ctx, connect.NewRequest(&libopsv1.EnableSiteCdnRequest{
+		SiteId:            siteID,
+		CacheMode:         commonv1.CdnCacheMode_CDN_CACHE_MODE_USE_ORIGIN_HEADERS,
+		DefaultTtlSeconds: 60,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, commonv1.CdnCacheMode_CDN_CACHE_MODE_USE_ORIGIN_HEADERS, updated.Msg.Cdn.CacheMode)
+
+	_, err = purge("images/*")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "paths start with /")
+	_, err = purge("/*/images")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a wildcard only matches a prefix")
+
+	purged, err := purge(" /images/* ", "/images/*", "/index.html")
+	require.NoError(t, err)
+	require.Len(t, purged.Msg.Purges, 2, "duplicate paths are purged once")
+	assert.Equal(t, "/images/*", purged.Msg.Purges[0].Path)
+	assert.Equal(t, libopsv1.CachePurgeStatus_CACHE_PURGE_STATUS_PENDING, purged.Msg.Purges[0].Status)
+
+	// The runner reports the load balancer
+	cdn.Status = db.SiteCdnConfigsStatusActive
+	cdn.IpAddress = sql.NullString{String: "34.120.0.1", Valid: true}
+	status, err := NewSiteOperationsService(mock, nil).GetSiteStatus(ctx, connect.NewRequest(&libopsv1.GetSiteStatusRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, "34.120.0.1", status.Msg.Status.Cdn.IpAddress)
+	assert.Equal(t, int32(2), status.Msg.Status.Cdn.PendingPurges)
+
+	require.NoError(t, disable())
+	require.NoError(t, disable())
+	assert.Empty(t, purges, "purges that haven't run are dropped with the CDN")
+	_, err = purge()
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = svc.EnableSiteCdn(ctx, connect.NewRequest(&libopsv1.EnableSiteCdnRequest{SiteId: siteID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a pending disable can't be re-enabled")
+
+	require.Len(t, queued, 4)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteCdnEnable),
+		string(audit.SiteCdnUpdate),
+		string(audit.SiteCachePurge),
+		string(audit.SiteCdnDisable),
+	}, audited)
+}
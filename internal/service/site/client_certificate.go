@@ -0,0 +1,74 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// IssueSiteClientCertificate signs a client certificate for a site's
+// controller from its CSR (called by VM controller with GSA auth). The site's
+// earlier certificates are revoked once the new one is recorded, so a key
+// leaked from a rebuilt VM stops working at its next rotation.
+func (s *AdminSiteService) IssueSiteClientCertificate(
+	ctx context.Context,
+	req *connect.Request[libopsv1.IssueSiteClientCertificateRequest],
+) (*connect.Response[libopsv1.IssueSiteClientCertificateResponse], error) {
+	if s.controllerCA == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("controller client certificates are not enabled"))
+	}
+	if req.Msg.CsrPem == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("csr_pem is required"))
+	}
+
+	sitePublicID, err := uuid.Parse(req.Msg.SiteId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id format: %w", err))
+	}
+	site, err := s.repo.GetSiteByPublicID(ctx, sitePublicID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site not found: %w", err))
+	}
+
+	issued, err := s.controllerCA.Issue(sitePublicID.String(), req.Msg.CsrPem)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = s.repo.db.CreateSiteClientCertificate(ctx, db.CreateSiteClientCertificateParams{
+		SiteID:       site.ID,
+		SerialNumber: issued.SerialNumber,
+		Fingerprint:  issued.Fingerprint,
+		NotBefore:    issued.NotBefore,
+		NotAfter:     issued.NotAfter,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	revoked, err := s.repo.db.RevokeSiteClientCertificates(ctx, db.RevokeSiteClientCertificatesParams{
+		SiteID:       site.ID,
+		SerialNumber: issued.SerialNumber,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	slog.Info("issued site client certificate",
+		"site_id", req.Msg.SiteId,
+		"serial_number", issued.SerialNumber,
+		"not_after", issued.NotAfter,
+		"revoked", revoked)
+
+	return connect.NewResponse(&libopsv1.IssueSiteClientCertificateResponse{
+		CertificatePem:   issued.CertificatePEM,
+		CaCertificatePem: s.controllerCA.CertificatePEM(),
+		NotAfter:         issued.NotAfter.Unix(),
+		RenewAfter:       issued.RenewAfter().Unix(),
+	}), nil
+}
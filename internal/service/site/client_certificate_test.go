@@ -0,0 +1,106 @@
+package site
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/controllerca"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestIssueSiteClientCertificate tests that a controller's CSR is signed for
+// its site, recorded, and replaces the site's earlier certificates.
+func TestIssueSiteClientCertificate(t *testing.T) {
+	siteID := uuid.NewString()
+	var created db.CreateSiteClientCertificateParams
+	var revoked db.RevokeSiteClientCertificatesParams
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		CreateSiteClientCertificateFunc: func(ctx context.Context, arg db.CreateSiteClientCertificateParams) error {
+			created = arg
+			return nil
+		},
+		RevokeSiteClientCertificatesFunc: func(ctx context.Context, arg db.RevokeSiteClientCertificatesParams) (int64, error) {
+			revoked = arg
+			return 1, nil
+		},
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	require.NoError(t, err)
+	req := &libopsv1.IssueSiteClientCertificateRequest{
+		SiteId: siteID,
+		CsrPem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	}
+
+	_, err = NewAdminSiteService(mock).IssueSiteClientCertificate(context.Background(), connect.NewRequest(req))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "mTLS is off without a CA")
+
+	ca := newTestControllerCA(t)
+	svc := NewAdminSiteServiceWithConfig(mock, "", ca)
+	resp, err := svc.IssueSiteClientCertificate(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	assert.Equal(t, ca.CertificatePEM(), resp.Msg.CaCertificatePem)
+	assert.Less(t, resp.Msg.RenewAfter, resp.Msg.NotAfter)
+
+	block, _ := pem.Decode([]byte(resp.Msg.CertificatePem))
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	identity, err := ca.Verify(cert)
+	require.NoError(t, err)
+	assert.Equal(t, siteID, identity.SitePublicID)
+
+	assert.Equal(t, int64(5), created.SiteID)
+	assert.Equal(t, identity.SerialNumber, created.SerialNumber)
+	assert.Equal(t, db.RevokeSiteClientCertificatesParams{SiteID: 5, SerialNumber: identity.SerialNumber}, revoked)
+
+	req.CsrPem = "not a csr"
+	_, err = svc.IssueSiteClientCertificate(context.Background(), connect.NewRequest(req))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// newTestControllerCA creates a self-signed controller CA
+func newTestControllerCA(t *testing.T) *controllerca.CA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "libops controller CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	ca, err := controllerca.New(
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	)
+	require.NoError(t, err)
+	return ca
+}
@@ -0,0 +1,72 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CommitResolver looks up the commit a git ref of a site's repository points at, so
+// deployments can show what they deployed.
+type CommitResolver interface {
+	ResolveCommit(ctx context.Context, repository, ref string) (sha, message string, err error)
+}
+
+// GitHubCommits resolves refs of public GitHub repositories through the GitHub API.
+type GitHubCommits struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewGitHubCommits creates a resolver for github.com repositories.
+func NewGitHubCommits() *GitHubCommits {
+	return &GitHubCommits{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		baseURL: "https://api.github.com",
+	}
+}
+
+// ResolveCommit returns the SHA and message of the commit ref points at. Refs use the
+// site format (heads/{branch}, tags/{tag}) or are a plain branch, tag or commit. The
+// "release" ref follows the latest release, which isn't known until deploy time, so
+// it resolves to nothing.
+func (g *GitHubCommits) ResolveCommit(ctx context.Context, repository, ref string) (string, string, error) {
+	ref = strings.TrimPrefix(strings.TrimPrefix(ref, "heads/"), "tags/")
+	if ref == "" || ref == "release" {
+		return "", "", nil
+	}
+
+	endpoint := fmt.Sprintf("%s/repos/%s/commits/%s", g.baseURL, repository, url.PathEscape(ref))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("create request: %w", err)
+	}
+	// Set User-Agent header as required by GitHub API
+	req.Header.Set("User-Agent", "libops-api")
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("get commit: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("get commit: unexpected status %d", resp.StatusCode)
+	}
+
+	var commit struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message string `json:"message"`
+		} `json:"commit"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&commit); err != nil {
+		return "", "", fmt.Errorf("decode commit: %w", err)
+	}
+	return commit.SHA, commit.Commit.Message, nil
+}
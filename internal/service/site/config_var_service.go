@@ -0,0 +1,420 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/organization"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// maxSiteConfigVars caps how many config vars a site's environment carries.
+	maxSiteConfigVars = 500
+	// maxConfigVarValue caps a config var's value. Anything larger is more
+	// likely a file than a flag or limit.
+	maxConfigVarValue = 4096
+)
+
+// SiteConfigVarService implements the SiteConfigVarService API.
+type SiteConfigVarService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SiteConfigVarService implements the interface.
+var _ libopsv1connect.SiteConfigVarServiceHandler = (*SiteConfigVarService)(nil)
+
+// NewSiteConfigVarService creates a new SiteConfigVarService instance.
+func NewSiteConfigVarService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SiteConfigVarService {
+	return &SiteConfigVarService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListConfigVars lists a site's config vars by name.
+func (s *SiteConfigVarService) ListConfigVars(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListConfigVarsRequest],
+) (*connect.Response[libopsv1.ListConfigVarsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteConfigVars(ctx, db.ListSiteConfigVarsParams{
+		SiteID: site.ID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list site config vars", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	revision, err := s.db.GetLatestSiteConfigVarRevision(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListConfigVarsResponse{
+		ConfigVars:    make([]*libopsv1.ConfigVar, 0, len(rows)),
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+		Revision:      revision,
+	}
+	for _, row := range rows {
+		resp.ConfigVars = append(resp.ConfigVars, configVarToProto(site.PublicID, row))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// SetConfigVar adds or changes a config var, recording the change as the
+// site's next revision, and queues the site's reconciliation. Setting a var
+// to the value it has changes nothing.
+func (s *SiteConfigVarService) SetConfigVar(
+	ctx context.Context,
+	req *connect.Request[libopsv1.SetConfigVarRequest],
+) (*connect.Response[libopsv1.SetConfigVarResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	name, value := req.Msg.Name, req.Msg.Value
+	if err := organization.ValidateSecretName(name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(value) > maxConfigVarValue {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max %d bytes)", maxConfigVarValue))
+	}
+	if strings.ContainsAny(value, "\r\n\x00") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value must be a single line"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteConfigVar(ctx, db.GetSiteConfigVarParams{SiteID: site.ID, Name: name})
+	switch {
+	case err == nil:
+		if existing.Value == value {
+			return connect.NewResponse(&libopsv1.SetConfigVarResponse{ConfigVar: configVarToProto(site.PublicID, existing)}), nil
+		}
+	case errors.Is(err, sql.ErrNoRows):
+		if err := s.checkNameFree(ctx, site.ID, name); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	previous := sql.NullString{String: existing.Value, Valid: err == nil}
+	revision, err := s.record(ctx, site.ID, name, previous, sql.NullString{String: value, Valid: true}, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpsertSiteConfigVar(ctx, db.UpsertSiteConfigVarParams{
+		SiteID:    site.ID,
+		Name:      name,
+		Value:     value,
+		Revision:  revision,
+		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "config var")
+	}
+
+	updated, err := s.db.GetSiteConfigVar(ctx, db.GetSiteConfigVarParams{SiteID: site.ID, Name: name})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteConfigVarSet, map[string]any{
+		"name":           name,
+		"value":          value,
+		"previous_value": service.FromNullStringPtr(previous),
+		"revision":       revision,
+	})
+
+	resp := &libopsv1.SetConfigVarResponse{ConfigVar: configVarToProto(site.PublicID, updated)}
+	s.reconcile(ctx, events.EventTypeSiteConfigVarSet, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteConfigVar removes a config var, recording the removal as the site's
+// next revision, and queues the site's reconciliation.
+func (s *SiteConfigVarService) DeleteConfigVar(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteConfigVarRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteConfigVar(ctx, db.GetSiteConfigVarParams{SiteID: site.ID, Name: req.Msg.Name})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "config var")
+	}
+
+	revision, err := s.record(ctx, site.ID, existing.Name, sql.NullString{String: existing.Value, Valid: true}, sql.NullString{}, userInfo.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.DeleteSiteConfigVar(ctx, db.DeleteSiteConfigVarParams{SiteID: site.ID, Name: existing.Name}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteConfigVarDelete, map[string]any{
+		"name":           existing.Name,
+		"previous_value": existing.Value,
+		"revision":       revision,
+	})
+	s.reconcile(ctx, events.EventTypeSiteConfigVarDeleted, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// ListConfigVarRevisions lists the changes to a site's config vars, newest
+// first.
+func (s *SiteConfigVarService) ListConfigVarRevisions(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListConfigVarRevisionsRequest],
+) (*connect.Response[libopsv1.ListConfigVarRevisionsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteConfigVarRevisions(ctx, db.ListSiteConfigVarRevisionsParams{
+		SiteID: site.ID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list site config var revisions", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListConfigVarRevisionsResponse{
+		Revisions:     make([]*libopsv1.ConfigVarRevision, 0, len(rows)),
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}
+	for _, row := range rows {
+		revision := &libopsv1.ConfigVarRevision{
+			Revision:      row.Revision,
+			Name:          row.Name,
+			ChangeType:    configVarChangeType(row.PreviousValue, row.Value),
+			PreviousValue: row.PreviousValue.String,
+			Value:         row.Value.String,
+			ChangedBy:     row.CreatedByEmail.String,
+		}
+		if row.CreatedAt.Valid {
+			revision.CreatedAt = row.CreatedAt.Time.Unix()
+		}
+		resp.Revisions = append(resp.Revisions, revision)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// DiffConfigVars compares a site's config vars at two revisions. Each var
+// the revisions between them touched is compared from its value before the
+// first of those changes to its value after the last, so a var changed and
+// changed back doesn't show up.
+func (s *SiteConfigVarService) DiffConfigVars(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DiffConfigVarsRequest],
+) (*connect.Response[libopsv1.DiffConfigVarsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.db.GetLatestSiteConfigVarRevision(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	from, to := req.Msg.FromRevision, req.Msg.ToRevision
+	if to == 0 {
+		to = latest
+	}
+	if from < 0 || to > latest {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("revisions must be between 0 and %d", latest))
+	}
+	if from > to {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from_revision must not be after to_revision"))
+	}
+
+	rows, err := s.db.ListSiteConfigVarRevisionsBetween(ctx, db.ListSiteConfigVarRevisionsBetweenParams{
+		SiteID:       site.ID,
+		FromRevision: from,
+		ToRevision:   to,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	type span struct{ from, to sql.NullString }
+	spans := make(map[string]*span)
+	for _, row := range rows {
+		if _, ok := spans[row.Name]; !ok {
+			spans[row.Name] = &span{from: row.PreviousValue}
+		}
+		spans[row.Name].to = row.Value
+	}
+
+	resp := &libopsv1.DiffConfigVarsResponse{FromRevision: from, ToRevision: to}
+	for name, span := range spans {
+		if span.from == span.to {
+			continue
+		}
+		resp.Diffs = append(resp.Diffs, &libopsv1.ConfigVarDiff{
+			Name:       name,
+			ChangeType: configVarChangeType(span.from, span.to),
+			FromValue:  span.from.String,
+			ToValue:    span.to.String,
+		})
+	}
+	sort.Slice(resp.Diffs, func(i, j int) bool { return resp.Diffs[i].Name < resp.Diffs[j].Name })
+
+	return connect.NewResponse(resp), nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteConfigVarService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// checkNameFree makes sure a site can take another config var by a name: it
+// has room for one, and no site secret of the same name to clash with in the
+// site's environment.
+func (s *SiteConfigVarService) checkNameFree(ctx context.Context, siteID int64, name string) error {
+	count, err := s.db.CountSiteConfigVars(ctx, siteID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if count >= maxSiteConfigVars {
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("a site can have at most %d config vars", maxSiteConfigVars))
+	}
+
+	_, err = s.db.GetSiteSecretByName(ctx, db.GetSiteSecretByNameParams{SiteID: siteID, Name: name})
+	if err == nil {
+		return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site has a secret named %s", name))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return nil
+}
+
+// record records a change to one of a site's config vars as its next
+// revision. A concurrent change can take the same number first, in which case
+// this one is aborted rather than recorded out of order.
+func (s *SiteConfigVarService) record(ctx context.Context, siteID int64, name string, previous, value sql.NullString, accountID int64) (int64, error) {
+	latest, err := s.db.GetLatestSiteConfigVarRevision(ctx, siteID)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	revision := latest + 1
+	err = s.db.CreateSiteConfigVarRevision(ctx, db.CreateSiteConfigVarRevisionParams{
+		SiteID:        siteID,
+		Revision:      revision,
+		Name:          name,
+		PreviousValue: previous,
+		Value:         value,
+		CreatedBy:     sql.NullInt64{Int64: accountID, Valid: true},
+	})
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return 0, connect.NewError(connect.CodeAborted, fmt.Errorf("the site's config vars changed at the same time, try again"))
+	}
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return revision, nil
+}
+
+// reconcile queues the site's secrets reconciliation, in which its controller
+// rewrites its config vars.
+func (s *SiteConfigVarService) reconcile(ctx context.Context, eventType, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit config var event", "error", err, "site_id", siteID)
+	}
+}
+
+// configVarChangeType works out how a change took a config var from one
+// value to another, where an invalid value means the var wasn't set.
+func configVarChangeType(from, to sql.NullString) libopsv1.ConfigVarChangeType {
+	switch {
+	case !from.Valid:
+		return libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_ADDED
+	case !to.Valid:
+		return libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_REMOVED
+	default:
+		return libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_CHANGED
+	}
+}
+
+// configVarToProto converts a site config var row to proto.
+func configVarToProto(sitePublicID string, row db.SiteConfigVar) *libopsv1.ConfigVar {
+	configVar := &libopsv1.ConfigVar{
+		SiteId:   sitePublicID,
+		Name:     row.Name,
+		Value:    row.Value,
+		Revision: row.Revision,
+	}
+	if row.UpdatedAt.Valid {
+		configVar.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return configVar
+}
@@ -0,0 +1,235 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteConfigVars tests that config vars are validated, that every change
+// is recorded as the site's next revision, that revisions can be listed and
+// diffed, and that the controller gets the current vars over the environment
+// settings the site inherits.
+func TestSiteConfigVars(t *testing.T) {
+	siteID := uuid.NewString()
+	vars := map[string]db.SiteConfigVar{}
+	var revisions []db.CreateSiteConfigVarRevisionParams
+	var queued []db.EnqueueEventParams
+	var audited []string
+	staleRevision := false
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetSiteSecretByNameFunc: func(ctx context.Context, arg db.GetSiteSecretByNameParams) (db.GetSiteSecretByNameRow, error) {
+			if arg.Name == "DB_PASSWORD" {
+				return db.GetSiteSecretByNameRow{ID: 1, SiteID: arg.SiteID, Name: arg.Name}, nil
+			}
+			return db.GetSiteSecretByNameRow{}, sql.ErrNoRows
+		},
+		GetSiteConfigVarFunc: func(ctx context.Context, arg db.GetSiteConfigVarParams) (db.SiteConfigVar, error) {
+			if v, ok := vars[arg.Name]; ok {
+				return v, nil
+			}
+			return db.SiteConfigVar{}, sql.ErrNoRows
+		},
+		ListSiteConfigVarsFunc: func(ctx context.Context, arg db.ListSiteConfigVarsParams) ([]db.SiteConfigVar, error) {
+			rows := make([]db.SiteConfigVar, 0, len(vars))
+			for _, v := range vars {
+				rows = append(rows, v)
+			}
+			sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+			return rows, nil
+		},
+		ListAllSiteConfigVarsFunc: func(ctx context.Context, id int64) ([]db.ListAllSiteConfigVarsRow, error) {
+			var rows []db.ListAllSiteConfigVarsRow
+			for _, v := range vars {
+				rows = append(rows, db.ListAllSiteConfigVarsRow{Name: v.Name, Value: v.Value})
+			}
+			sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+			return rows, nil
+		},
+		CountSiteConfigVarsFunc: func(ctx context.Context, id int64) (int64, error) {
+			return int64(len(vars)), nil
+		},
+		UpsertSiteConfigVarFunc: func(ctx context.Context, arg db.UpsertSiteConfigVarParams) error {
+			vars[arg.Name] = db.SiteConfigVar{
+				SiteID:    arg.SiteID,
+				Name:      arg.Name,
+				Value:     arg.Value,
+				Revision:  arg.Revision,
+				UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			}
+			return nil
+		},
+		DeleteSiteConfigVarFunc: func(ctx context.Context, arg db.DeleteSiteConfigVarParams) error {
+			delete(vars, arg.Name)
+			return nil
+		},
+		GetLatestSiteConfigVarRevisionFunc: func(ctx context.Context, id int64) (int64, error) {
+			if staleRevision {
+				return int64(len(revisions) - 1), nil
+			}
+			return int64(len(revisions)), nil
+		},
+		CreateSiteConfigVarRevisionFunc: func(ctx context.Context, arg db.CreateSiteConfigVarRevisionParams) error {
+			if arg.Revision <= int64(len(revisions)) {
+				return &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+			}
+			revisions = append(revisions, arg)
+			return nil
+		},
+		ListSiteConfigVarRevisionsFunc: func(ctx context.Context, arg db.ListSiteConfigVarRevisionsParams) ([]db.ListSiteConfigVarRevisionsRow, error) {
+			var rows []db.ListSiteConfigVarRevisionsRow
+			for i := len(revisions) - 1; i >= 0; i-- {
+				r := revisions[i]
+				rows = append(rows, db.ListSiteConfigVarRevisionsRow{
+					Revision:       r.Revision,
+					Name:           r.Name,
+					PreviousValue:  r.PreviousValue,
+					Value:          r.Value,
+					CreatedByEmail: sql.NullString{String: "editor@example.com", Valid: true},
+				})
+			}
+			return rows, nil
+		},
+		ListSiteConfigVarRevisionsBetweenFunc: func(ctx context.Context, arg db.ListSiteConfigVarRevisionsBetweenParams) ([]db.ListSiteConfigVarRevisionsBetweenRow, error) {
+			var rows []db.ListSiteConfigVarRevisionsBetweenRow
+			for _, r := range revisions {
+				if r.Revision > arg.FromRevision && r.Revision <= arg.ToRevision {
+					rows = append(rows, db.ListSiteConfigVarRevisionsBetweenRow{Revision: r.Revision, Name: r.Name, PreviousValue: r.PreviousValue, Value: r.Value})
+				}
+			}
+			return rows, nil
+		},
+		ListSiteInheritedSettingsFunc: func(ctx context.Context, arg db.ListSiteInheritedSettingsParams) ([]db.ListSiteInheritedSettingsRow, error) {
+			return []db.ListSiteInheritedSettingsRow{
+				{SettingKey: "APP_ENV", SettingValue: "production"},
+				{SettingKey: "php_op_mode", SettingValue: "fpm"},
+				{SettingKey: "PHP_MEMORY_LIMIT", SettingValue: "128M"},
+				{SettingKey: "APP_ENV", SettingValue: "staging"},
+			}, nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteConfigVarService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	admin := NewAdminSiteService(mock)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	set := func(name, value string) (*connect.Response[libopsv1.SetConfigVarResponse], error) {
+		return svc.SetConfigVar(ctx, connect.NewRequest(&libopsv1.SetConfigVarRequest{SiteId: siteID, Name: name, Value: value}))
+	}
+
+	_, err := set("php_memory_limit", "256M")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "lowercase name")
+	_, err = set("MOTD", "line one\nline two")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "multiline value")
+	_, err = set("DB_PASSWORD", "hunter2")
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "the site has a secret of the same name")
+
+	added, err := set("PHP_MEMORY_LIMIT", "256M")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), added.Msg.ConfigVar.Revision)
+
+	unchanged, err := set("PHP_MEMORY_LIMIT", "256M")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), unchanged.Msg.ConfigVar.Revision, "setting the same value records nothing")
+
+	changed, err := set("PHP_MEMORY_LIMIT", "512M")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), changed.Msg.ConfigVar.Revision)
+	_, err = set("FEATURE_SEARCH", "on")
+	require.NoError(t, err)
+	_, err = set("FEATURE_SEARCH", "off")
+	require.NoError(t, err)
+	_, err = set("FEATURE_SEARCH", "on")
+	require.NoError(t, err)
+
+	staleRevision = true
+	_, err = set("FEATURE_SEARCH", "off")
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err), "a concurrent change took the revision")
+	staleRevision = false
+
+	_, err = svc.DeleteConfigVar(ctx, connect.NewRequest(&libopsv1.DeleteConfigVarRequest{SiteId: siteID, Name: "FEATURE_SEARCH"}))
+	require.NoError(t, err)
+	_, err = svc.DeleteConfigVar(ctx, connect.NewRequest(&libopsv1.DeleteConfigVarRequest{SiteId: siteID, Name: "FEATURE_SEARCH"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	listed, err := svc.ListConfigVars(ctx, connect.NewRequest(&libopsv1.ListConfigVarsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, listed.Msg.ConfigVars, 1)
+	assert.Equal(t, "512M", listed.Msg.ConfigVars[0].Value)
+	assert.Equal(t, int64(6), listed.Msg.Revision)
+
+	history, err := svc.ListConfigVarRevisions(ctx, connect.NewRequest(&libopsv1.ListConfigVarRevisionsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, history.Msg.Revisions, 6)
+	assert.Equal(t, libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_REMOVED, history.Msg.Revisions[0].ChangeType)
+	assert.Equal(t, "on", history.Msg.Revisions[0].PreviousValue)
+	assert.Equal(t, libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_CHANGED, history.Msg.Revisions[4].ChangeType)
+	assert.Equal(t, "256M", history.Msg.Revisions[4].PreviousValue)
+	assert.Equal(t, "512M", history.Msg.Revisions[4].Value)
+	assert.Equal(t, libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_ADDED, history.Msg.Revisions[5].ChangeType)
+	assert.Equal(t, "editor@example.com", history.Msg.Revisions[5].ChangedBy)
+
+	diff, err := svc.DiffConfigVars(ctx, connect.NewRequest(&libopsv1.DiffConfigVarsRequest{SiteId: siteID, FromRevision: 1}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), diff.Msg.ToRevision)
+	require.Len(t, diff.Msg.Diffs, 1, "FEATURE_SEARCH was added and removed in between")
+	assert.Equal(t, "PHP_MEMORY_LIMIT", diff.Msg.Diffs[0].Name)
+	assert.Equal(t, libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_CHANGED, diff.Msg.Diffs[0].ChangeType)
+	assert.Equal(t, "256M", diff.Msg.Diffs[0].FromValue)
+	assert.Equal(t, "512M", diff.Msg.Diffs[0].ToValue)
+
+	diff, err = svc.DiffConfigVars(ctx, connect.NewRequest(&libopsv1.DiffConfigVarsRequest{SiteId: siteID, FromRevision: 3, ToRevision: 5}))
+	require.NoError(t, err)
+	assert.Empty(t, diff.Msg.Diffs, "FEATURE_SEARCH was changed and changed back")
+
+	diff, err = svc.DiffConfigVars(ctx, connect.NewRequest(&libopsv1.DiffConfigVarsRequest{SiteId: siteID, FromRevision: 0, ToRevision: 3}))
+	require.NoError(t, err)
+	require.Len(t, diff.Msg.Diffs, 2)
+	assert.Equal(t, "FEATURE_SEARCH", diff.Msg.Diffs[0].Name)
+	assert.Equal(t, libopsv1.ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_ADDED, diff.Msg.Diffs[0].ChangeType)
+	assert.Equal(t, "512M", diff.Msg.Diffs[1].ToValue)
+
+	_, err = svc.DiffConfigVars(ctx, connect.NewRequest(&libopsv1.DiffConfigVarsRequest{SiteId: siteID, FromRevision: 4, ToRevision: 2}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = svc.DiffConfigVars(ctx, connect.NewRequest(&libopsv1.DiffConfigVarsRequest{SiteId: siteID, ToRevision: 7}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "no such revision yet")
+
+	delivered, err := admin.GetSiteConfigVars(ctx, connect.NewRequest(&libopsv1.GetSiteConfigVarsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, delivered.Msg.ConfigVars, 2, "php_op_mode isn't an environment setting")
+	assert.Equal(t, "APP_ENV", delivered.Msg.ConfigVars[0].Key)
+	assert.Equal(t, "staging", delivered.Msg.ConfigVars[0].Value, "the site's setting overrides the organization's")
+	assert.Equal(t, "PHP_MEMORY_LIMIT", delivered.Msg.ConfigVars[1].Key)
+	assert.Equal(t, "512M", delivered.Msg.ConfigVars[1].Value, "config vars override settings")
+	assert.Equal(t, int64(6), delivered.Msg.Revision)
+
+	require.Len(t, queued, 6)
+	assert.Equal(t, events.EventTypeSiteConfigVarSet, queued[0].EventType)
+	assert.Equal(t, events.EventTypeSiteConfigVarDeleted, queued[5].EventType)
+	require.Len(t, audited, 6)
+	assert.Equal(t, string(audit.SiteConfigVarDelete), audited[5])
+}
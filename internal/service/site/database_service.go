@@ -0,0 +1,298 @@
+package site
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// maxDatabasesPerSite caps how many databases one site may have.
+	maxDatabasesPerSite = 5
+	// databasePasswordBytes is how much randomness a database password has.
+	databasePasswordBytes = 24
+	// mysqlPort is the port a database listens on until it reports another.
+	mysqlPort = 3306
+)
+
+// databaseNamePattern matches names that are valid, unquoted, as both a MySQL
+// database and user name.
+var databaseNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// reservedDatabaseNames are MySQL's own schemas and users.
+var reservedDatabaseNames = map[string]bool{
+	"mysql":              true,
+	"sys":                true,
+	"information_schema": true,
+	"performance_schema": true,
+	"root":               true,
+}
+
+// DatabaseService implements the DatabaseService API.
+type DatabaseService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+	vault       func(ctx context.Context, organizationID int64) (secretStore, error)
+}
+
+// Compile-time check to ensure DatabaseService implements the interface.
+var _ libopsv1connect.DatabaseServiceHandler = (*DatabaseService)(nil)
+
+// NewDatabaseService creates a new DatabaseService instance.
+func NewDatabaseService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *DatabaseService {
+	return &DatabaseService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+		vault:       organizationSecretStores(querier),
+	}
+}
+
+// CreateDatabase records a database for a site, writes its user and password
+// secrets and queues the site's reconciliation, which provisions it.
+func (s *DatabaseService) CreateDatabase(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateDatabaseRequest],
+) (*connect.Response[libopsv1.CreateDatabaseResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	tier := db.SiteDatabasesTierColocated
+	switch req.Msg.Tier {
+	case libopsv1.DatabaseTier_DATABASE_TIER_UNSPECIFIED, libopsv1.DatabaseTier_DATABASE_TIER_COLOCATED:
+	case libopsv1.DatabaseTier_DATABASE_TIER_CLOUD_SQL:
+		tier = db.SiteDatabasesTierCloudSql
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown tier %d", req.Msg.Tier))
+	}
+	if !databaseNamePattern.MatchString(req.Msg.Name) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1 to 32 lowercase letters, digits and underscores, starting with a letter"))
+	}
+	if reservedDatabaseNames[req.Msg.Name] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name %q is reserved", req.Msg.Name))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if len(existing) >= maxDatabasesPerSite {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("a site can have at most %d databases", maxDatabasesPerSite))
+	}
+	for _, database := range existing {
+		if database.Name == req.Msg.Name {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already has a database called %s", req.Msg.Name))
+		}
+	}
+
+	// The credentials are managed secrets; a secret of the site's own can't
+	// be taken over
+	secrets := service.SiteDatabaseSecretNames(req.Msg.Name)
+	for _, name := range []string{secrets.User, secrets.Password, secrets.Host, secrets.Port} {
+		_, err := s.db.GetSiteSecretByName(ctx, db.GetSiteSecretByNameParams{SiteID: site.ID, Name: name})
+		if err == nil {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already has a secret called %s", name))
+		}
+		if err != sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
+	store, err := s.siteVault(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	password, err := databasePassword()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := service.WriteManagedSiteSecret(ctx, s.db, store, site.PublicID, site.ID, secrets.User, req.Msg.Name); err != nil {
+		slog.Error("failed to write database secret", "error", err, "site_id", site.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write the database's credentials"))
+	}
+	if err := service.WriteManagedSiteSecret(ctx, s.db, store, site.PublicID, site.ID, secrets.Password, password); err != nil {
+		slog.Error("failed to write database secret", "error", err, "site_id", site.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write the database's credentials"))
+	}
+
+	databaseID := uuid.NewString()
+	err = s.db.CreateSiteDatabase(ctx, db.CreateSiteDatabaseParams{
+		PublicID:  databaseID,
+		SiteID:    site.ID,
+		Name:      req.Msg.Name,
+		Tier:      tier,
+		Port:      mysqlPort,
+		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "database")
+	}
+	database, err := s.db.GetSiteDatabase(ctx, databaseID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteDatabaseCreate, map[string]any{
+		"database_id": databaseID,
+		"name":        database.Name,
+		"tier":        database.Tier,
+	})
+
+	resp := &libopsv1.CreateDatabaseResponse{Database: service.SiteDatabaseToProto(site.PublicID, database)}
+	s.reconcile(ctx, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// ListDatabases lists a site's databases.
+func (s *DatabaseService) ListDatabases(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListDatabasesRequest],
+) (*connect.Response[libopsv1.ListDatabasesResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteDatabases(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListDatabasesResponse{}
+	for _, row := range rows {
+		resp.Databases = append(resp.Databases, service.SiteDatabaseToProto(site.PublicID, db.GetSiteDatabaseRow(row)))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ResetDatabasePassword writes a new password to a database's password
+// secret and queues the site's reconciliation, which sets it on the
+// database's user.
+func (s *DatabaseService) ResetDatabasePassword(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ResetDatabasePasswordRequest],
+) (*connect.Response[libopsv1.ResetDatabasePasswordResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if err := validation.UUID(req.Msg.DatabaseId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	database, err := s.db.GetSiteDatabase(ctx, req.Msg.DatabaseId)
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "database")
+	}
+	if database.SiteID != site.ID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("database not found"))
+	}
+
+	store, err := s.siteVault(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	password, err := databasePassword()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	secrets := service.SiteDatabaseSecretNames(database.Name)
+	if err := service.WriteManagedSiteSecret(ctx, s.db, store, site.PublicID, site.ID, secrets.Password, password); err != nil {
+		slog.Error("failed to write database secret", "error", err, "site_id", site.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write the database's password"))
+	}
+	if err := s.db.RotateSiteDatabasePassword(ctx, database.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	database, err = s.db.GetSiteDatabase(ctx, req.Msg.DatabaseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteDatabasePasswordReset, map[string]any{
+		"database_id": database.PublicID,
+		"name":        database.Name,
+	})
+
+	resp := &libopsv1.ResetDatabasePasswordResponse{Database: service.SiteDatabaseToProto(site.PublicID, database)}
+	s.reconcile(ctx, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// databasePassword generates a password for a database or an add-on. It's
+// hex, so it needs no quoting in SQL, shell, YAML or env files.
+func databasePassword() (string, error) {
+	b := make([]byte, databasePasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// site looks up the site a request targets.
+func (s *DatabaseService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// siteVault opens the Vault of the site's organization.
+func (s *DatabaseService) siteVault(ctx context.Context, site db.GetSiteRow) (secretStore, error) {
+	project, err := s.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	store, err := s.vault(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("failed to get vault client", "error", err, "organization_id", project.OrganizationID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+	}
+	return store, nil
+}
+
+// reconcile queues the site's reconciliation: terraform builds Cloud SQL
+// databases and the site's controller the co-located ones, and the site
+// picks up their secrets.
+func (s *DatabaseService) reconcile(ctx context.Context, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeSiteUpdated, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit site updated event", "error", err, "site_id", siteID)
+	}
+}
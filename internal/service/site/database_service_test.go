@@ -0,0 +1,253 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	"github.com/libops/api/internal/vault"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteDatabases tests that databases are validated and limited, that
+// their credentials are written as managed secrets the secrets API can't
+// change, that the controller gets the co-located ones' passwords, and that
+// its reports activate them.
+func TestSiteDatabases(t *testing.T) {
+	siteID := uuid.NewString()
+	var databases []db.GetSiteDatabaseRow
+	secrets := map[string]db.GetSiteSecretByNameRow{
+		"DB_TAKEN_PASSWORD": {ID: 40, SiteID: 5, Name: "DB_TAKEN_PASSWORD"},
+	}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		ListSiteDatabasesFunc: func(ctx context.Context, id int64) ([]db.ListSiteDatabasesRow, error) {
+			rows := make([]db.ListSiteDatabasesRow, 0, len(databases))
+			for _, database := range databases {
+				rows = append(rows, db.ListSiteDatabasesRow(database))
+			}
+			return rows, nil
+		},
+		GetSiteDatabaseFunc: func(ctx context.Context, publicID string) (db.GetSiteDatabaseRow, error) {
+			for _, database := range databases {
+				if database.PublicID == publicID {
+					return database, nil
+				}
+			}
+			return db.GetSiteDatabaseRow{}, sql.ErrNoRows
+		},
+		CreateSiteDatabaseFunc: func(ctx context.Context, arg db.CreateSiteDatabaseParams) error {
+			databases = append(databases, db.GetSiteDatabaseRow{
+				ID:                int64(len(databases) + 1),
+				PublicID:          arg.PublicID,
+				SiteID:            arg.SiteID,
+				Name:              arg.Name,
+				Tier:              arg.Tier,
+				Status:            db.SiteDatabasesStatusProvisioning,
+				Port:              arg.Port,
+				PasswordRotatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+				CreatedAt:         sql.NullTime{Time: time.Now(), Valid: true},
+			})
+			return nil
+		},
+		RotateSiteDatabasePasswordFunc: func(ctx context.Context, id int64) error {
+			databases[id-1].PasswordRotatedAt = sql.NullTime{Time: time.Now().Add(time.Second), Valid: true}
+			return nil
+		},
+		ActivateSiteDatabaseFunc: func(ctx context.Context, arg db.ActivateSiteDatabaseParams) error {
+			databases[arg.ID-1].Status = db.SiteDatabasesStatusActive
+			databases[arg.ID-1].Host = arg.Host
+			databases[arg.ID-1].Port = arg.Port
+			return nil
+		},
+		FailSiteDatabaseFunc: func(ctx context.Context, arg db.FailSiteDatabaseParams) error {
+			databases[arg.ID-1].Status = db.SiteDatabasesStatusFailed
+			databases[arg.ID-1].StatusMessage = arg.StatusMessage
+			return nil
+		},
+		GetSiteSecretByNameFunc: func(ctx context.Context, arg db.GetSiteSecretByNameParams) (db.GetSiteSecretByNameRow, error) {
+			if secret, ok := secrets[arg.Name]; ok {
+				return secret, nil
+			}
+			return db.GetSiteSecretByNameRow{}, sql.ErrNoRows
+		},
+		UpsertManagedSiteSecretFunc: func(ctx context.Context, arg db.UpsertManagedSiteSecretParams) error {
+			secrets[arg.Name] = db.GetSiteSecretByNameRow{SiteID: arg.SiteID, Name: arg.Name, VaultPath: arg.VaultPath, Managed: true}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	store := memorySecretStore{}
+	vaultFor := func(ctx context.Context, organizationID int64) (secretStore, error) {
+		return store, nil
+	}
+	svc := NewDatabaseService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	svc.vault = vaultFor
+	admin := NewAdminSiteService(mock)
+	admin.vault = vaultFor
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	secretValue := func(name string) string {
+		value, _ := store[vault.BuildSiteSecretPath(siteID, name)]["value"].(string)
+		return value
+	}
+
+	for name, req := range map[string]*libopsv1.CreateDatabaseRequest{
+		"empty name":      {},
+		"uppercase name":  {Name: "Wordpress"},
+		"leading digit":   {Name: "1site"},
+		"name too long":   {Name: "a23456789012345678901234567890123"},
+		"reserved name":   {Name: "mysql"},
+		"unknown tier":    {Name: "wordpress", Tier: libopsv1.DatabaseTier(9)},
+		"taken secret":    {Name: "taken"},
+		"punctuated name": {Name: "word-press"},
+	} {
+		req.SiteId = siteID
+		_, err := svc.CreateDatabase(ctx, connect.NewRequest(req))
+		code := connect.CodeInvalidArgument
+		if name == "taken secret" {
+			code = connect.CodeAlreadyExists
+		}
+		assert.Equal(t, code, connect.CodeOf(err), name)
+	}
+	assert.Empty(t, store, "rejected databases get no credentials")
+
+	created, err := svc.CreateDatabase(ctx, connect.NewRequest(&libopsv1.CreateDatabaseRequest{SiteId: siteID, Name: "wordpress"}))
+	require.NoError(t, err)
+	wordpress := created.Msg.Database
+	assert.Equal(t, libopsv1.DatabaseTier_DATABASE_TIER_COLOCATED, wordpress.Tier)
+	assert.Equal(t, libopsv1.DatabaseStatus_DATABASE_STATUS_PROVISIONING, wordpress.Status)
+	assert.Equal(t, "DB_WORDPRESS_PASSWORD", wordpress.PasswordSecretName)
+	assert.Equal(t, "DB_WORDPRESS_HOST", wordpress.HostSecretName)
+	assert.Equal(t, "wordpress", secretValue("DB_WORDPRESS_USER"))
+	password := secretValue("DB_WORDPRESS_PASSWORD")
+	assert.Len(t, password, 2*databasePasswordBytes)
+	assert.True(t, secrets["DB_WORDPRESS_PASSWORD"].Managed)
+
+	_, err = svc.CreateDatabase(ctx, connect.NewRequest(&libopsv1.CreateDatabaseRequest{SiteId: siteID, Name: "wordpress"}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "duplicate name")
+
+	analytics, err := svc.CreateDatabase(ctx, connect.NewRequest(&libopsv1.CreateDatabaseRequest{
+		SiteId: siteID,
+		Name:   "analytics",
+		Tier:   libopsv1.DatabaseTier_DATABASE_TIER_CLOUD_SQL,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.DatabaseTier_DATABASE_TIER_CLOUD_SQL, analytics.Msg.Database.Tier)
+
+	for _, name := range []string{"one", "two", "three"} {
+		_, err := svc.CreateDatabase(ctx, connect.NewRequest(&libopsv1.CreateDatabaseRequest{SiteId: siteID, Name: name}))
+		require.NoError(t, err)
+	}
+	_, err = svc.CreateDatabase(ctx, connect.NewRequest(&libopsv1.CreateDatabaseRequest{SiteId: siteID, Name: "four"}))
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err), "over the limit")
+
+	listed, err := svc.ListDatabases(ctx, connect.NewRequest(&libopsv1.ListDatabasesRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Len(t, listed.Msg.Databases, maxDatabasesPerSite)
+
+	colocated, err := admin.GetSiteDatabases(ctx, connect.NewRequest(&libopsv1.GetSiteDatabasesRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, colocated.Msg.Databases, 4, "the controller doesn't get Cloud SQL databases")
+	assert.Equal(t, "wordpress", colocated.Msg.Databases[0].Name)
+	assert.Equal(t, password, colocated.Msg.Databases[0].Password)
+
+	reset, err := svc.ResetDatabasePassword(ctx, connect.NewRequest(&libopsv1.ResetDatabasePasswordRequest{
+		SiteId:     siteID,
+		DatabaseId: wordpress.DatabaseId,
+	}))
+	require.NoError(t, err)
+	assert.Greater(t, reset.Msg.Database.PasswordRotatedAt, int64(0))
+	assert.NotEqual(t, password, secretValue("DB_WORDPRESS_PASSWORD"))
+
+	_, err = svc.ResetDatabasePassword(ctx, connect.NewRequest(&libopsv1.ResetDatabasePasswordRequest{
+		SiteId:     siteID,
+		DatabaseId: uuid.NewString(),
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = admin.ReportSiteDatabases(ctx, connect.NewRequest(&libopsv1.ReportSiteDatabasesRequest{SiteId: siteID, Host: "mysql", Port: 3307}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "host isn't an address")
+
+	reported, err := admin.ReportSiteDatabases(ctx, connect.NewRequest(&libopsv1.ReportSiteDatabasesRequest{
+		SiteId: siteID,
+		Host:   "172.17.0.1",
+		Port:   3307,
+		Databases: []*libopsv1.ReportedDatabase{
+			{Name: "wordpress"},
+			{Name: "one", Error: "access denied"},
+			{Name: "analytics"},
+		},
+	}))
+	require.NoError(t, err)
+	byName := map[string]*libopsv1.SiteDatabase{}
+	for _, database := range reported.Msg.Databases {
+		byName[database.Name] = database
+	}
+	assert.Equal(t, libopsv1.DatabaseStatus_DATABASE_STATUS_ACTIVE, byName["wordpress"].Status)
+	assert.Equal(t, "172.17.0.1", byName["wordpress"].Host)
+	assert.Equal(t, libopsv1.DatabaseStatus_DATABASE_STATUS_FAILED, byName["one"].Status)
+	assert.Equal(t, "access denied", byName["one"].StatusMessage)
+	assert.Equal(t, libopsv1.DatabaseStatus_DATABASE_STATUS_PROVISIONING, byName["analytics"].Status, "Cloud SQL databases are reported by terraform")
+	assert.Equal(t, "172.17.0.1", secretValue("DB_WORDPRESS_HOST"))
+	assert.Equal(t, "3307", secretValue("DB_WORDPRESS_PORT"))
+
+	// The credentials can't be changed or deleted through the secrets API
+	secretsSvc := NewSiteSecretService(&testutils.MockQuerier{
+		GetSiteFunc:        mock.GetSiteFunc,
+		GetProjectByIDFunc: mock.GetProjectByIDFunc,
+		GetSiteSecretByPublicIDFunc: func(ctx context.Context, publicID string) (db.GetSiteSecretByPublicIDRow, error) {
+			return db.GetSiteSecretByPublicIDRow{ID: 41, PublicID: publicID, SiteID: 5, Name: "DB_WORDPRESS_PASSWORD", Managed: true}, nil
+		},
+	}, audit.New(mock))
+	value := "hunter2"
+	_, err = secretsSvc.UpdateSiteSecret(ctx, connect.NewRequest(&libopsv1.UpdateSiteSecretRequest{
+		SiteId:   siteID,
+		SecretId: uuid.NewString(),
+		Value:    &value,
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = secretsSvc.DeleteSiteSecret(ctx, connect.NewRequest(&libopsv1.DeleteSiteSecretRequest{
+		SiteId:   siteID,
+		SecretId: uuid.NewString(),
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	require.Len(t, queued, 6)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteDatabaseCreate),
+		string(audit.SiteDatabaseCreate),
+		string(audit.SiteDatabaseCreate),
+		string(audit.SiteDatabaseCreate),
+		string(audit.SiteDatabaseCreate),
+		string(audit.SiteDatabasePasswordReset),
+	}, audited)
+}
@@ -0,0 +1,180 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// SiteDomainService implements the SiteDomainService API.
+type SiteDomainService struct {
+	db          db.Querier
+	repo        *Repository
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SiteDomainService implements the interface.
+var _ libopsv1connect.SiteDomainServiceHandler = (*SiteDomainService)(nil)
+
+// NewSiteDomainService creates a new SiteDomainService instance.
+func NewSiteDomainService(querier db.Querier, auditLogger *audit.Logger) *SiteDomainService {
+	return &SiteDomainService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		auditLogger: auditLogger,
+	}
+}
+
+// ListSiteDomains lists a site's domains, newest first.
+func (s *SiteDomainService) ListSiteDomains(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListSiteDomainsRequest],
+) (*connect.Response[libopsv1.ListSiteDomainsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := s.db.ListSiteDomains(ctx, db.ListSiteDomainsParams{
+		SiteID: site.ID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list site domains", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListSiteDomainsResponse{
+		Domains:       make([]*libopsv1.SiteDomain, 0, len(domains)),
+		NextPageToken: service.MakePaginationResult(len(domains), pagination).NextPageToken,
+	}
+	for _, d := range domains {
+		resp.Domains = append(resp.Domains, domainToProto(site.PublicID, d))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// CreateSiteDomain adds a domain to a site.
+func (s *SiteDomainService) CreateSiteDomain(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateSiteDomainRequest],
+) (*connect.Response[libopsv1.CreateSiteDomainResponse], error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Msg.Domain))
+	if err := validation.Hostname(domain); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Domains are unique across sites; don't disclose which site holds one.
+	_, err = s.db.GetDomainByName(ctx, domain)
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("domain %s is already in use", domain))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	err = s.db.CreateDomain(ctx, db.CreateDomainParams{SiteID: site.ID, Domain: domain})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "domain")
+	}
+
+	created, err := s.db.GetDomainByName(ctx, domain)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.audit(ctx, site.ID, audit.SiteDomainAdd, domain)
+
+	return connect.NewResponse(&libopsv1.CreateSiteDomainResponse{
+		Domain: domainToProto(site.PublicID, created),
+	}), nil
+}
+
+// DeleteSiteDomain removes a domain from a site.
+func (s *SiteDomainService) DeleteSiteDomain(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteSiteDomainRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Msg.Domain))
+	if domain == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("domain is required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetDomainByName(ctx, domain)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && existing.SiteID != site.ID) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("domain %s not found on this site", domain))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.db.DeleteDomain(ctx, existing.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.audit(ctx, site.ID, audit.SiteDomainRemove, domain)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteDomainService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// audit records a domain change by the current user.
+func (s *SiteDomainService) audit(ctx context.Context, siteID int64, event audit.Event, domain string) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok || s.auditLogger == nil {
+		return
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, siteID, audit.SiteEntityType, event, map[string]any{
+		"domain": domain,
+	})
+}
+
+func domainToProto(sitePublicID string, d db.Domain) *libopsv1.SiteDomain {
+	domain := &libopsv1.SiteDomain{
+		SiteId: sitePublicID,
+		Domain: d.Domain,
+	}
+	if d.CreatedAt.Valid {
+		domain.CreatedAt = d.CreatedAt.Time.Unix()
+	}
+	return domain
+}
@@ -0,0 +1,74 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteDomains tests that a domain is added to one site only and removed
+// only from the site that holds it.
+func TestSiteDomains(t *testing.T) {
+	siteID := uuid.NewString()
+	domains := map[string]db.Domain{}
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID}, nil
+		},
+		GetDomainByNameFunc: func(ctx context.Context, domain string) (db.Domain, error) {
+			if d, ok := domains[domain]; ok {
+				return d, nil
+			}
+			return db.Domain{}, sql.ErrNoRows
+		},
+		CreateDomainFunc: func(ctx context.Context, arg db.CreateDomainParams) error {
+			domains[arg.Domain] = db.Domain{ID: int64(len(domains) + 1), SiteID: arg.SiteID, Domain: arg.Domain}
+			return nil
+		},
+		DeleteDomainFunc: func(ctx context.Context, id int64) error {
+			for name, d := range domains {
+				if d.ID == id {
+					delete(domains, name)
+				}
+			}
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteDomainService(mock, audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	create := func(domain string) error {
+		_, err := svc.CreateSiteDomain(ctx, connect.NewRequest(&libopsv1.CreateSiteDomainRequest{SiteId: siteID, Domain: domain}))
+		return err
+	}
+	remove := func(domain string) error {
+		_, err := svc.DeleteSiteDomain(ctx, connect.NewRequest(&libopsv1.DeleteSiteDomainRequest{SiteId: siteID, Domain: domain}))
+		return err
+	}
+
+	require.NoError(t, create(" Digital.Example.edu "))
+	assert.Contains(t, domains, "digital.example.edu", "domains are stored lowercase")
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(create("digital.example.edu")))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(create("*.example.edu")))
+
+	domains["other.example.edu"] = db.Domain{ID: 99, SiteID: 6, Domain: "other.example.edu"}
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(remove("other.example.edu")), "not another site's domain")
+	require.NoError(t, remove("digital.example.edu"))
+	assert.NotContains(t, domains, "digital.example.edu")
+	assert.Equal(t, []string{string(audit.SiteDomainAdd), string(audit.SiteDomainRemove)}, audited)
+}
@@ -0,0 +1,151 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// SiteEgressService implements the SiteEgressService API.
+type SiteEgressService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SiteEgressService implements the interface.
+var _ libopsv1connect.SiteEgressServiceHandler = (*SiteEgressService)(nil)
+
+// NewSiteEgressService creates a new SiteEgressService instance.
+func NewSiteEgressService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SiteEgressService {
+	return &SiteEgressService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// RequestStaticEgressIp records a site's static egress IP and queues the
+// site's reconciliation, which reserves it.
+func (s *SiteEgressService) RequestStaticEgressIp(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RequestStaticEgressIpRequest],
+) (*connect.Response[libopsv1.RequestStaticEgressIpResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteStaticEgressIp(ctx, site.ID)
+	if err == nil {
+		// A release that hasn't been applied yet can't be taken back; the
+		// address may already be gone
+		if existing.Status == db.SiteStaticEgressIpsStatusReleasing {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the site's static egress IP is being released; request a new one once that finishes"))
+		}
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already has a static egress IP"))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	err = s.db.CreateSiteStaticEgressIp(ctx, db.CreateSiteStaticEgressIpParams{
+		SiteID:    site.ID,
+		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "static egress IP")
+	}
+
+	created, err := s.db.GetSiteStaticEgressIp(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.StaticEgressIpRequest, nil)
+
+	resp := &libopsv1.RequestStaticEgressIpResponse{StaticEgressIp: service.StaticEgressIpToProto(created)}
+	s.reconcile(ctx, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// ReleaseStaticEgressIp marks a site's static egress IP for release and
+// queues the site's reconciliation, which releases it.
+func (s *SiteEgressService) ReleaseStaticEgressIp(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ReleaseStaticEgressIpRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetSiteStaticEgressIp(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the site has no static egress IP"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if existing.Status == db.SiteStaticEgressIpsStatusReleasing {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	if err := s.db.MarkSiteStaticEgressIpReleasing(ctx, existing.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.StaticEgressIpRelease, map[string]any{
+		"ip_address": service.FromNullString(existing.IpAddress),
+	})
+	s.reconcile(ctx, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteEgressService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// reconcile queues the site's terraform, which owns the address reservation.
+func (s *SiteEgressService) reconcile(ctx context.Context, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, events.EventTypeSiteUpdated, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit site updated event", "error", err, "site_id", siteID)
+	}
+}
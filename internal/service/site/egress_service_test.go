@@ -0,0 +1,98 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// TestStaticEgressIp tests that a site gets one static egress IP, that it
+// shows on the site once reserved, and that releasing it queues a
+// reconciliation only once.
+func TestStaticEgressIp(t *testing.T) {
+	siteID := uuid.NewString()
+	var egress *db.GetSiteStaticEgressIpRow
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteStaticEgressIpFunc: func(ctx context.Context, id int64) (db.GetSiteStaticEgressIpRow, error) {
+			if egress == nil {
+				return db.GetSiteStaticEgressIpRow{}, sql.ErrNoRows
+			}
+			return *egress, nil
+		},
+		CreateSiteStaticEgressIpFunc: func(ctx context.Context, arg db.CreateSiteStaticEgressIpParams) error {
+			egress = &db.GetSiteStaticEgressIpRow{ID: 1, SiteID: arg.SiteID, Status: db.SiteStaticEgressIpsStatusProvisioning}
+			return nil
+		},
+		MarkSiteStaticEgressIpReleasingFunc: func(ctx context.Context, id int64) error {
+			egress.Status = db.SiteStaticEgressIpsStatusReleasing
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteEgressService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	request := func() (*connect.Response[libopsv1.RequestStaticEgressIpResponse], error) {
+		return svc.RequestStaticEgressIp(ctx, connect.NewRequest(&libopsv1.RequestStaticEgressIpRequest{SiteId: siteID}))
+	}
+	release := func() error {
+		_, err := svc.ReleaseStaticEgressIp(ctx, connect.NewRequest(&libopsv1.ReleaseStaticEgressIpRequest{SiteId: siteID}))
+		return err
+	}
+
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(release()))
+
+	requested, err := request()
+	require.NoError(t, err)
+	assert.Equal(t, commonv1.StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_PROVISIONING, requested.Msg.StaticEgressIp.Status)
+	assert.Empty(t, requested.Msg.StaticEgressIp.IpAddress)
+
+	_, err = request()
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	// The runner reports the reservation
+	egress.Status = db.SiteStaticEgressIpsStatusActive
+	egress.IpAddress = sql.NullString{String: "34.1.2.3", Valid: true}
+	site, err := NewSiteService(mock).GetSite(ctx, connect.NewRequest(&libopsv1.GetSiteRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, "34.1.2.3", site.Msg.Site.StaticEgressIp.IpAddress)
+
+	require.NoError(t, release())
+	require.NoError(t, release())
+	_, err = request()
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "a pending release can't be re-requested")
+
+	require.Len(t, queued, 2)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{string(audit.StaticEgressIpRequest), string(audit.StaticEgressIpRelease)}, audited)
+}
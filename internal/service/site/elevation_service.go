@@ -0,0 +1,354 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// defaultElevationMinutes is how long an elevated role lasts when the
+	// request doesn't say.
+	defaultElevationMinutes = 60
+	// maxElevationMinutes caps an elevated role at a day; longer access is a
+	// membership.
+	maxElevationMinutes = 24 * 60
+	// maxElevationReasonLength matches the site_elevations.reason column.
+	maxElevationReasonLength = 1000
+)
+
+// ElevationService implements the ElevationService API.
+type ElevationService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure ElevationService implements the interface.
+var _ libopsv1connect.ElevationServiceHandler = (*ElevationService)(nil)
+
+// NewElevationService creates a new ElevationService instance.
+func NewElevationService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *ElevationService {
+	return &ElevationService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// RequestElevation asks the site's owners for a higher role on the site for
+// a limited time. An account has at most one open request or elevated role
+// on a site at a time.
+func (s *ElevationService) RequestElevation(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RequestElevationRequest],
+) (*connect.Response[libopsv1.RequestElevationResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	role, err := parseElevationRole(req.Msg.Role)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	reason := strings.TrimSpace(req.Msg.Reason)
+	if reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is required"))
+	}
+	if len(reason) > maxElevationReasonLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason must be at most %d characters", maxElevationReasonLength))
+	}
+	duration := req.Msg.DurationMinutes
+	if duration == 0 {
+		duration = defaultElevationMinutes
+	}
+	if duration < 1 || duration > maxElevationMinutes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration_minutes must be between 1 and %d", maxElevationMinutes))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	open, err := s.db.CountOpenSiteElevations(ctx, db.CountOpenSiteElevationsParams{SiteID: site.ID, AccountID: userInfo.AccountID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if open > 0 {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("you already have an open elevation request or elevated role on the site"))
+	}
+
+	publicID := uuid.NewString()
+	err = s.db.CreateSiteElevation(ctx, db.CreateSiteElevationParams{
+		PublicID:        publicID,
+		SiteID:          site.ID,
+		AccountID:       userInfo.AccountID,
+		Role:            role,
+		Reason:          reason,
+		DurationMinutes: duration,
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "elevation request")
+	}
+
+	elevation, err := s.record(ctx, userInfo, site, publicID, audit.SiteElevationRequest, events.EventTypeSiteElevationRequested)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.RequestElevationResponse{Elevation: elevation}), nil
+}
+
+// ListElevations lists the site's elevation requests, newest first.
+func (s *ElevationService) ListElevations(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListElevationsRequest],
+) (*connect.Response[libopsv1.ListElevationsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteElevations(ctx, db.ListSiteElevationsParams{
+		SiteID: site.ID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list elevations", "error", err, "site_id", site.PublicID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	elevations := make([]*libopsv1.Elevation, 0, len(rows))
+	for _, row := range rows {
+		elevations = append(elevations, elevationToProto(site, db.GetSiteElevationRow(row)))
+	}
+
+	return connect.NewResponse(&libopsv1.ListElevationsResponse{
+		Elevations:    elevations,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// ApproveElevation gives the requester the role for the requested duration,
+// starting now. Nobody can approve their own request.
+func (s *ElevationService) ApproveElevation(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ApproveElevationRequest],
+) (*connect.Response[libopsv1.ApproveElevationResponse], error) {
+	userInfo, site, elevation, err := s.resolve(ctx, req.Msg.SiteId, req.Msg.ElevationId)
+	if err != nil {
+		return nil, err
+	}
+	if elevation.AccountID == userInfo.AccountID {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you can't approve your own elevation request"))
+	}
+
+	err = service.ExpectRow(s.db.ApproveSiteElevation(ctx, db.ApproveSiteElevationParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   elevation.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, elevation)
+	}
+
+	approved, err := s.record(ctx, userInfo, site, elevation.PublicID, audit.SiteElevationApprove, events.EventTypeSiteElevationApproved)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.ApproveElevationResponse{Elevation: approved}), nil
+}
+
+// RejectElevation rejects a pending request.
+func (s *ElevationService) RejectElevation(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RejectElevationRequest],
+) (*connect.Response[libopsv1.RejectElevationResponse], error) {
+	userInfo, site, elevation, err := s.resolve(ctx, req.Msg.SiteId, req.Msg.ElevationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.ExpectRow(s.db.RejectSiteElevation(ctx, db.RejectSiteElevationParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   elevation.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, elevation)
+	}
+
+	rejected, err := s.record(ctx, userInfo, site, elevation.PublicID, audit.SiteElevationReject, events.EventTypeSiteElevationRejected)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.RejectElevationResponse{Elevation: rejected}), nil
+}
+
+// RevokeElevation ends an elevated role before it lapses, or withdraws a
+// pending request.
+func (s *ElevationService) RevokeElevation(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RevokeElevationRequest],
+) (*connect.Response[libopsv1.RevokeElevationResponse], error) {
+	userInfo, site, elevation, err := s.resolve(ctx, req.Msg.SiteId, req.Msg.ElevationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.ExpectRow(s.db.RevokeSiteElevation(ctx, db.RevokeSiteElevationParams{
+		ResolvedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   elevation.PublicID,
+	}))
+	if err != nil {
+		return nil, s.updateError(err, elevation)
+	}
+
+	revoked, err := s.record(ctx, userInfo, site, elevation.PublicID, audit.SiteElevationRevoke, events.EventTypeSiteElevationRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&libopsv1.RevokeElevationResponse{Elevation: revoked}), nil
+}
+
+// site looks up the site a request targets.
+func (s *ElevationService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// resolve looks up the caller, the site and one of the site's elevations.
+func (s *ElevationService) resolve(ctx context.Context, siteID, elevationID string) (*auth.UserInfo, db.GetSiteRow, db.GetSiteElevationRow, error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, db.GetSiteRow{}, db.GetSiteElevationRow{}, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	if err := validation.UUID(elevationID); err != nil {
+		return nil, db.GetSiteRow{}, db.GetSiteElevationRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("elevation_id: %w", err))
+	}
+
+	site, err := s.site(ctx, siteID)
+	if err != nil {
+		return nil, db.GetSiteRow{}, db.GetSiteElevationRow{}, err
+	}
+
+	elevation, err := s.db.GetSiteElevation(ctx, elevationID)
+	if err == nil && elevation.SiteID != site.ID {
+		err = sql.ErrNoRows
+	}
+	if err != nil {
+		return nil, db.GetSiteRow{}, db.GetSiteElevationRow{}, service.HandleDatabaseError(err, "elevation")
+	}
+	return userInfo, site, elevation, nil
+}
+
+// updateError reports a failed status change; a request no longer in a state
+// the change applies to was resolved or lapsed in the meantime.
+func (s *ElevationService) updateError(err error, elevation db.GetSiteElevationRow) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the elevation is %s", elevation.Status))
+	}
+	slog.Error("Failed to update elevation", "error", err, "elevation_id", elevation.PublicID)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+}
+
+// record audits a change on the site and tells its owners, and its
+// controller when the account's SSH access changes, about it.
+func (s *ElevationService) record(ctx context.Context, userInfo *auth.UserInfo, site db.GetSiteRow, elevationID string, action audit.Event, eventType string) (*libopsv1.Elevation, error) {
+	row, err := s.db.GetSiteElevation(ctx, elevationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get elevation: %w", err))
+	}
+	elevation := elevationToProto(site, row)
+
+	details := map[string]any{
+		"elevation_id":     elevation.ElevationId,
+		"account_id":       elevation.AccountId,
+		"role":             elevation.Role,
+		"reason":           elevation.Reason,
+		"duration_minutes": elevation.DurationMinutes,
+	}
+	if elevation.ExpiresAt != 0 {
+		details["expires_at"] = elevation.ExpiresAt
+	}
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, action, details)
+
+	if s.emitter != nil {
+		if err := s.emitter.SendScopedProtoEvent(ctx, eventType, elevation.ElevationId, nil, nil, &site.PublicID, elevation); err != nil {
+			slog.Error("Failed to emit elevation event", "error", err, "elevation_id", elevation.ElevationId)
+		}
+	}
+	return elevation, nil
+}
+
+func elevationToProto(site db.GetSiteRow, row db.GetSiteElevationRow) *libopsv1.Elevation {
+	elevation := &libopsv1.Elevation{
+		ElevationId:     row.PublicID,
+		SiteId:          site.PublicID,
+		AccountId:       row.AccountPublicID,
+		Email:           row.Email,
+		Role:            string(row.Role),
+		Reason:          row.Reason,
+		DurationMinutes: row.DurationMinutes,
+		Status:          elevationStatusToProto(row.Status),
+		ResolvedBy:      row.ResolvedByEmail.String,
+		ExpiresAt:       service.ExpiryToProto(row.ExpiresAt),
+	}
+	if row.CreatedAt.Valid {
+		elevation.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.ResolvedAt.Valid {
+		elevation.ResolvedAt = row.ResolvedAt.Time.Unix()
+	}
+	return elevation
+}
+
+func elevationStatusToProto(status db.SiteElevationsStatus) libopsv1.ElevationStatus {
+	switch status {
+	case db.SiteElevationsStatusPending:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_PENDING
+	case db.SiteElevationsStatusApproved:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_APPROVED
+	case db.SiteElevationsStatusRejected:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_REJECTED
+	case db.SiteElevationsStatusRevoked:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_REVOKED
+	case db.SiteElevationsStatusExpired:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_EXPIRED
+	default:
+		return libopsv1.ElevationStatus_ELEVATION_STATUS_UNSPECIFIED
+	}
+}
+
+func parseElevationRole(role string) (db.SiteElevationsRole, error) {
+	switch elevated := db.SiteElevationsRole(role); elevated {
+	case db.SiteElevationsRoleOwner, db.SiteElevationsRoleDeveloper:
+		return elevated, nil
+	default:
+		return "", fmt.Errorf("role must be owner or developer")
+	}
+}
@@ -0,0 +1,157 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestElevations tests that a member can request one elevated role at a
+// time, that nobody approves their own request, that an approved role gets
+// an expiry and can be revoked, and that resolved requests can't change.
+func TestElevations(t *testing.T) {
+	siteID := uuid.NewString()
+	elevations := map[string]*db.GetSiteElevationRow{}
+	// transition moves an elevation on when it's in one of the given states
+	transition := func(publicID string, resolvedBy sql.NullInt64, to db.SiteElevationsStatus, from ...db.SiteElevationsStatus) (sql.Result, error) {
+		elevation, ok := elevations[publicID]
+		if !ok {
+			return driver.RowsAffected(0), nil
+		}
+		for _, status := range from {
+			if elevation.Status == status {
+				elevation.Status = to
+				elevation.ResolvedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				elevation.ResolvedByEmail = sql.NullString{String: "owner@example.com", Valid: resolvedBy.Valid}
+				return driver.RowsAffected(1), nil
+			}
+		}
+		return driver.RowsAffected(0), nil
+	}
+	var queued []string
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		CountOpenSiteElevationsFunc: func(ctx context.Context, arg db.CountOpenSiteElevationsParams) (int64, error) {
+			var open int64
+			for _, elevation := range elevations {
+				if elevation.AccountID == arg.AccountID && (elevation.Status == db.SiteElevationsStatusPending || elevation.Status == db.SiteElevationsStatusApproved) {
+					open++
+				}
+			}
+			return open, nil
+		},
+		CreateSiteElevationFunc: func(ctx context.Context, arg db.CreateSiteElevationParams) error {
+			elevations[arg.PublicID] = &db.GetSiteElevationRow{
+				PublicID:        arg.PublicID,
+				SiteID:          arg.SiteID,
+				AccountID:       arg.AccountID,
+				Role:            arg.Role,
+				Reason:          arg.Reason,
+				DurationMinutes: arg.DurationMinutes,
+				Status:          db.SiteElevationsStatusPending,
+				CreatedAt:       sql.NullTime{Time: time.Now(), Valid: true},
+				AccountPublicID: "requester",
+				Email:           "developer@example.com",
+			}
+			return nil
+		},
+		GetSiteElevationFunc: func(ctx context.Context, publicID string) (db.GetSiteElevationRow, error) {
+			elevation, ok := elevations[publicID]
+			if !ok {
+				return db.GetSiteElevationRow{}, sql.ErrNoRows
+			}
+			return *elevation, nil
+		},
+		ApproveSiteElevationFunc: func(ctx context.Context, arg db.ApproveSiteElevationParams) (sql.Result, error) {
+			result, err := transition(arg.PublicID, arg.ResolvedBy, db.SiteElevationsStatusApproved, db.SiteElevationsStatusPending)
+			if elevation, ok := elevations[arg.PublicID]; ok && elevation.Status == db.SiteElevationsStatusApproved {
+				elevation.ExpiresAt = sql.NullTime{Time: time.Now().Add(time.Duration(elevation.DurationMinutes) * time.Minute), Valid: true}
+			}
+			return result, err
+		},
+		RejectSiteElevationFunc: func(ctx context.Context, arg db.RejectSiteElevationParams) (sql.Result, error) {
+			return transition(arg.PublicID, arg.ResolvedBy, db.SiteElevationsStatusRejected, db.SiteElevationsStatusPending)
+		},
+		RevokeSiteElevationFunc: func(ctx context.Context, arg db.RevokeSiteElevationParams) (sql.Result, error) {
+			return transition(arg.PublicID, arg.ResolvedBy, db.SiteElevationsStatusRevoked, db.SiteElevationsStatusPending, db.SiteElevationsStatusApproved)
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg.EventType)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewElevationService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	developerCtx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 11})
+	ownerCtx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	_, err := svc.RequestElevation(developerCtx, connect.NewRequest(&libopsv1.RequestElevationRequest{SiteId: siteID, Role: "read", Reason: "incident"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "read isn't an elevation")
+	_, err = svc.RequestElevation(developerCtx, connect.NewRequest(&libopsv1.RequestElevationRequest{SiteId: siteID, Role: "owner", Reason: "  "}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "a reason is required")
+	_, err = svc.RequestElevation(developerCtx, connect.NewRequest(&libopsv1.RequestElevationRequest{SiteId: siteID, Role: "owner", Reason: "incident", DurationMinutes: maxElevationMinutes + 1}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "longer than a day")
+
+	requested, err := svc.RequestElevation(developerCtx, connect.NewRequest(&libopsv1.RequestElevationRequest{SiteId: siteID, Role: "owner", Reason: "incident"}))
+	require.NoError(t, err)
+	elevationID := requested.Msg.Elevation.ElevationId
+	assert.Equal(t, libopsv1.ElevationStatus_ELEVATION_STATUS_PENDING, requested.Msg.Elevation.Status)
+	assert.Equal(t, int32(defaultElevationMinutes), requested.Msg.Elevation.DurationMinutes)
+	assert.Zero(t, requested.Msg.Elevation.ExpiresAt)
+
+	_, err = svc.RequestElevation(developerCtx, connect.NewRequest(&libopsv1.RequestElevationRequest{SiteId: siteID, Role: "developer", Reason: "again"}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err), "one open request at a time")
+
+	_, err = svc.ApproveElevation(developerCtx, connect.NewRequest(&libopsv1.ApproveElevationRequest{SiteId: siteID, ElevationId: elevationID}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "nobody approves their own request")
+	_, err = svc.ApproveElevation(ownerCtx, connect.NewRequest(&libopsv1.ApproveElevationRequest{SiteId: siteID, ElevationId: uuid.NewString()}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	approved, err := svc.ApproveElevation(ownerCtx, connect.NewRequest(&libopsv1.ApproveElevationRequest{SiteId: siteID, ElevationId: elevationID}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.ElevationStatus_ELEVATION_STATUS_APPROVED, approved.Msg.Elevation.Status)
+	assert.Greater(t, approved.Msg.Elevation.ExpiresAt, time.Now().Unix())
+	assert.Equal(t, "owner@example.com", approved.Msg.Elevation.ResolvedBy)
+
+	_, err = svc.RejectElevation(ownerCtx, connect.NewRequest(&libopsv1.RejectElevationRequest{SiteId: siteID, ElevationId: elevationID}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "already approved")
+
+	revoked, err := svc.RevokeElevation(ownerCtx, connect.NewRequest(&libopsv1.RevokeElevationRequest{SiteId: siteID, ElevationId: elevationID}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.ElevationStatus_ELEVATION_STATUS_REVOKED, revoked.Msg.Elevation.Status)
+
+	listed, err := svc.ListElevations(ownerCtx, connect.NewRequest(&libopsv1.ListElevationsRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Empty(t, listed.Msg.Elevations, "the mock lists nothing")
+
+	assert.Equal(t, []string{
+		events.EventTypeSiteElevationRequested,
+		events.EventTypeSiteElevationApproved,
+		events.EventTypeSiteElevationRevoked,
+	}, queued)
+	assert.Equal(t, []string{
+		string(audit.SiteElevationRequest),
+		string(audit.SiteElevationApprove),
+		string(audit.SiteElevationRevoke),
+	}, audited)
+}
@@ -10,6 +10,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/service/organization"
 	"github.com/libops/api/internal/validation"
@@ -19,16 +22,20 @@ import (
 
 // SiteFirewallService implements the LibOps SiteFirewallService API.
 type SiteFirewallService struct {
-	repo *Repository
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
 }
 
 // Compile-time check.
 var _ libopsv1connect.SiteFirewallServiceHandler = (*SiteFirewallService)(nil)
 
 // NewSiteFirewallService creates a new SiteFirewallService instance.
-func NewSiteFirewallService(querier db.Querier) *SiteFirewallService {
+func NewSiteFirewallService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SiteFirewallService {
 	return &SiteFirewallService{
-		repo: NewRepository(querier),
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
 	}
 }
 
@@ -109,6 +116,18 @@ func (s *SiteFirewallService) CreateSiteFirewallRule(
 		return nil, err
 	}
 
+	project, err := s.repo.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get project: %w", err))
+	}
+	ruleCount, err := s.repo.db.CountSiteFirewallRules(ctx, sql.NullInt64{Int64: site.ID, Valid: true})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count firewall rules: %w", err))
+	}
+	if err := s.repo.quotas.Check(ctx, project.OrganizationID, quota.FirewallRules, ruleCount); err != nil {
+		return nil, err
+	}
+
 	params := db.CreateSiteFirewallRuleParams{
 		SiteID:   sql.NullInt64{Int64: site.ID, Valid: true},
 		Name:     req.Msg.Name,
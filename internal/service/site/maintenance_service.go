@@ -0,0 +1,286 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// MaintenanceWindowService implements the MaintenanceWindowService API. The
+// event router reads the windows and holds back secrets and firewall
+// reconciliations until they open.
+type MaintenanceWindowService struct {
+	db          db.Querier
+	repo        *Repository
+	auditLogger *audit.Logger
+	now         func() time.Time
+}
+
+// Compile-time check to ensure MaintenanceWindowService implements the interface.
+var _ libopsv1connect.MaintenanceWindowServiceHandler = (*MaintenanceWindowService)(nil)
+
+// NewMaintenanceWindowService creates a new MaintenanceWindowService instance.
+func NewMaintenanceWindowService(querier db.Querier, auditLogger *audit.Logger) *MaintenanceWindowService {
+	return &MaintenanceWindowService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		auditLogger: auditLogger,
+		now:         time.Now,
+	}
+}
+
+// GetSiteMaintenanceWindow returns a site's maintenance window and the
+// reconciliations waiting for it.
+func (s *MaintenanceWindowService) GetSiteMaintenanceWindow(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteMaintenanceWindowRequest],
+) (*connect.Response[libopsv1.GetSiteMaintenanceWindowResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := s.window(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteMaintenanceWindowResponse{Window: window}), nil
+}
+
+// UpdateSiteMaintenanceWindow sets a site's maintenance window. Changes
+// already waiting for the old window wait for the new one.
+func (s *MaintenanceWindowService) UpdateSiteMaintenanceWindow(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateSiteMaintenanceWindowRequest],
+) (*connect.Response[libopsv1.UpdateSiteMaintenanceWindowResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	timezone := req.Msg.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	w := maintenanceWindow{
+		Days:      req.Msg.Days,
+		StartHour: req.Msg.StartHour,
+		EndHour:   req.Msg.EndHour,
+		Timezone:  timezone,
+	}
+	if err := w.validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	days := w.Days
+	if days == nil {
+		days = []string{}
+	}
+	daysJSON, err := json.Marshal(days)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode days: %w", err))
+	}
+
+	err = s.db.UpsertSiteMaintenanceWindow(ctx, db.UpsertSiteMaintenanceWindowParams{
+		SiteID:    site.ID,
+		Days:      daysJSON,
+		StartHour: w.StartHour,
+		EndHour:   w.end(),
+		Timezone:  timezone,
+		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "maintenance window")
+	}
+
+	window, err := s.window(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteMaintenanceWindowUpdate, map[string]any{
+		"days":       days,
+		"start_hour": w.StartHour,
+		"end_hour":   w.end(),
+		"timezone":   timezone,
+	})
+
+	return connect.NewResponse(&libopsv1.UpdateSiteMaintenanceWindowResponse{Window: window}), nil
+}
+
+// DeleteSiteMaintenanceWindow removes a site's maintenance window. The event
+// router releases the reconciliations waiting for it on its next poll.
+func (s *MaintenanceWindowService) DeleteSiteMaintenanceWindow(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteSiteMaintenanceWindowRequest],
+) (*connect.Response[libopsv1.DeleteSiteMaintenanceWindowResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.db.DeleteSiteMaintenanceWindow(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if deleted == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site has no maintenance window"))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteMaintenanceWindowDelete, nil)
+
+	return connect.NewResponse(&libopsv1.DeleteSiteMaintenanceWindowResponse{}), nil
+}
+
+func (s *MaintenanceWindowService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// window returns a site's maintenance window, disabled if it has none.
+func (s *MaintenanceWindowService) window(ctx context.Context, site db.GetSiteRow) (*libopsv1.SiteMaintenanceWindow, error) {
+	pb := &libopsv1.SiteMaintenanceWindow{SiteId: site.PublicID}
+
+	deferred, err := s.db.ListSiteDeferredReconciliations(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, d := range deferred {
+		reconciliation := &libopsv1.DeferredReconciliation{RequestType: string(d.RequestType)}
+		if d.CreatedAt.Valid {
+			reconciliation.DeferredAt = d.CreatedAt.Time.Unix()
+		}
+		pb.Deferred = append(pb.Deferred, reconciliation)
+	}
+
+	row, err := s.db.GetSiteMaintenanceWindow(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pb, nil
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	w := maintenanceWindow{StartHour: row.StartHour, EndHour: row.EndHour, Timezone: row.Timezone}
+	if err := json.Unmarshal(row.Days, &w.Days); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("invalid stored days: %w", err))
+	}
+
+	pb.Enabled = true
+	pb.Days = w.Days
+	pb.StartHour = w.StartHour
+	pb.EndHour = w.EndHour
+	pb.Timezone = w.Timezone
+	if next := w.nextOpen(s.now()); !next.IsZero() {
+		pb.NextOpenAt = next.Unix()
+	}
+	if row.UpdatedAt.Valid {
+		pb.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return pb, nil
+}
+
+// maintenanceWindow is the hours on some weekdays a site's VM may be changed.
+// The event router's copy decides when deferred reconciliations run.
+type maintenanceWindow struct {
+	Days      []string // Lowercase weekdays; empty for every day
+	StartHour int32
+	EndHour   int32 // 0 means 24
+	Timezone  string
+}
+
+// validate checks the window's days, hours and time zone.
+func (w maintenanceWindow) validate() error {
+	for _, day := range w.Days {
+		if _, ok := parseWeekday(day); !ok {
+			return fmt.Errorf("invalid day %q: use lowercase weekday names such as saturday", day)
+		}
+	}
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return fmt.Errorf("start_hour must be between 0 and 23")
+	}
+	if w.EndHour < 0 || w.EndHour > 24 {
+		return fmt.Errorf("end_hour must be between 1 and 24, or 0 for 24")
+	}
+	if w.end() <= w.StartHour {
+		return fmt.Errorf("end_hour must be after start_hour")
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil || w.Timezone == "Local" {
+		return fmt.Errorf("invalid timezone %q", w.Timezone)
+	}
+	return nil
+}
+
+// nextOpen returns when the window next opens after t, or the zero time while
+// it's open.
+func (w maintenanceWindow) nextOpen(t time.Time) time.Time {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.Time{}
+	}
+	local := t.In(loc)
+	for i := range 8 {
+		day := local.AddDate(0, 0, i)
+		if !w.opensOn(day.Weekday()) {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), int(w.StartHour), 0, 0, 0, loc)
+		end := time.Date(day.Year(), day.Month(), day.Day(), int(w.end()), 0, 0, 0, loc)
+		if i == 0 && !local.Before(start) && local.Before(end) {
+			return time.Time{}
+		}
+		if start.After(local) {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+func (w maintenanceWindow) opensOn(weekday time.Weekday) bool {
+	return len(w.Days) == 0 || slices.Contains(w.Days, strings.ToLower(weekday.String()))
+}
+
+func (w maintenanceWindow) end() int32 {
+	if w.EndHour == 0 {
+		return 24
+	}
+	return w.EndHour
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == day {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,99 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteMaintenanceWindow tests that windows are validated, report when they
+// next open and the reconciliations waiting for them, and can be removed.
+func TestSiteMaintenanceWindow(t *testing.T) {
+	siteID := uuid.NewString()
+	var stored *db.GetSiteMaintenanceWindowRow
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID}, nil
+		},
+		GetSiteMaintenanceWindowFunc: func(ctx context.Context, id int64) (db.GetSiteMaintenanceWindowRow, error) {
+			if stored == nil {
+				return db.GetSiteMaintenanceWindowRow{}, sql.ErrNoRows
+			}
+			return *stored, nil
+		},
+		UpsertSiteMaintenanceWindowFunc: func(ctx context.Context, arg db.UpsertSiteMaintenanceWindowParams) error {
+			stored = &db.GetSiteMaintenanceWindowRow{SiteID: arg.SiteID, Days: arg.Days, StartHour: arg.StartHour, EndHour: arg.EndHour, Timezone: arg.Timezone}
+			return nil
+		},
+		DeleteSiteMaintenanceWindowFunc: func(ctx context.Context, id int64) (int64, error) {
+			if stored == nil {
+				return 0, nil
+			}
+			stored = nil
+			return 1, nil
+		},
+		ListSiteDeferredReconciliationsFunc: func(ctx context.Context, id int64) ([]db.ListSiteDeferredReconciliationsRow, error) {
+			return []db.ListSiteDeferredReconciliationsRow{{RequestType: db.DeferredReconciliationsRequestTypeSecrets}}, nil
+		},
+	}
+	svc := NewMaintenanceWindowService(mock, audit.New(mock))
+	// A Wednesday afternoon in London
+	svc.now = func() time.Time { return time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC) }
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	got, err := svc.GetSiteMaintenanceWindow(ctx, connect.NewRequest(&libopsv1.GetSiteMaintenanceWindowRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.False(t, got.Msg.Window.Enabled, "sites have no window until one is set")
+	assert.Len(t, got.Msg.Window.Deferred, 1)
+
+	invalid := []*libopsv1.UpdateSiteMaintenanceWindowRequest{
+		{Days: []string{"Saturday"}, StartHour: 2, EndHour: 6},
+		{StartHour: 24},
+		{StartHour: 6, EndHour: 2},
+		{StartHour: 2, EndHour: 6, Timezone: "Mars/Olympus_Mons"},
+	}
+	for _, req := range invalid {
+		req.SiteId = siteID
+		_, err := svc.UpdateSiteMaintenanceWindow(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "%v", req)
+	}
+
+	updated, err := svc.UpdateSiteMaintenanceWindow(ctx, connect.NewRequest(&libopsv1.UpdateSiteMaintenanceWindowRequest{
+		SiteId:    siteID,
+		Days:      []string{"saturday", "sunday"},
+		StartHour: 2,
+		EndHour:   6,
+		Timezone:  "Europe/London",
+	}))
+	require.NoError(t, err)
+	window := updated.Msg.Window
+	assert.True(t, window.Enabled)
+	assert.Equal(t, []string{"saturday", "sunday"}, window.Days)
+	assert.Equal(t, time.Date(2026, 3, 14, 2, 0, 0, 0, time.UTC).Unix(), window.NextOpenAt, "opens on Saturday")
+
+	updated, err = svc.UpdateSiteMaintenanceWindow(ctx, connect.NewRequest(&libopsv1.UpdateSiteMaintenanceWindowRequest{
+		SiteId:    siteID,
+		StartHour: 15,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(24), updated.Msg.Window.EndHour)
+	assert.Equal(t, "UTC", updated.Msg.Window.Timezone)
+	assert.Zero(t, updated.Msg.Window.NextOpenAt, "the window is open")
+
+	_, err = svc.DeleteSiteMaintenanceWindow(ctx, connect.NewRequest(&libopsv1.DeleteSiteMaintenanceWindowRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	_, err = svc.DeleteSiteMaintenanceWindow(ctx, connect.NewRequest(&libopsv1.DeleteSiteMaintenanceWindowRequest{SiteId: siteID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -12,6 +12,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/invite"
+	"github.com/libops/api/internal/notify"
 	"github.com/libops/api/internal/reconciler"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
@@ -23,16 +25,20 @@ import (
 type SiteMemberService struct {
 	db          db.Querier
 	connManager *reconciler.ConnectionManager
+	notifier    *notify.Notifier
+	inviter     *invite.Inviter
 }
 
 // Compile-time check.
 var _ libopsv1connect.SiteMemberServiceHandler = (*SiteMemberService)(nil)
 
 // NewSiteMemberService creates a new SiteMemberService instance.
-func NewSiteMemberService(querier db.Querier, connManager *reconciler.ConnectionManager) *SiteMemberService {
+func NewSiteMemberService(querier db.Querier, connManager *reconciler.ConnectionManager, notifier *notify.Notifier, inviter *invite.Inviter) *SiteMemberService {
 	return &SiteMemberService{
 		db:          querier,
 		connManager: connManager,
+		notifier:    notifier,
+		inviter:     inviter,
 	}
 }
 
@@ -71,6 +77,7 @@ func (s *SiteMemberService) ListSiteMembers(
 			Role:           string(member.Role),
 			GithubUsername: service.FromNullStringPtr(member.GithubUsername),
 			Status:         service.DbSiteMemberStatusToProto(member.Status),
+			ExpiresAt:      service.ExpiryToProto(member.ExpiresAt),
 		})
 	}
 
@@ -86,25 +93,49 @@ func (s *SiteMemberService) CreateSiteMember(
 	req *connect.Request[libopsv1.CreateSiteMemberRequest],
 ) (*connect.Response[libopsv1.CreateSiteMemberResponse], error) {
 	siteID := req.Msg.SiteId
-	accountID := req.Msg.AccountId
 
 	if err := validation.UUID(siteID); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := validation.UUID(accountID); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	accountID, err := service.ResolveMemberAccountID(ctx, s.db, req.Msg.AccountId, req.Msg.Email)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := validation.RequiredString("role", req.Msg.Role); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	expiresAt, err := service.ParseExpiry(req.Msg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid && accountID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_at can only be set once they have an account"))
+	}
+
 	site, err := service.GetSiteByPublicID(ctx, s.db, siteID)
 	if err != nil {
 		return nil, err
 	}
 
+	if accountID == "" {
+		invitation, err := service.InviteMember(ctx, s.inviter, invite.Request{
+			Email:        req.Msg.Email,
+			ResourceType: "site",
+			ResourceID:   site.ID,
+			ResourceName: site.Name,
+			Role:         req.Msg.Role,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&libopsv1.CreateSiteMemberResponse{
+			Invitation: invitation,
+		}), nil
+	}
+
 	accountUUID, err := uuid.Parse(accountID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account_id format: %w", err))
@@ -138,6 +169,17 @@ func (s *SiteMemberService) CreateSiteMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	if expiresAt.Valid {
+		err = s.db.SetSiteMemberExpiry(ctx, db.SetSiteMemberExpiryParams{
+			ExpiresAt: expiresAt,
+			SiteID:    site.ID,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
 	// Trigger reconciliation via WebSocket if owner/developer role
 	if s.connManager != nil && (req.Msg.Role == "owner" || req.Msg.Role == "developer") {
 		if err := s.connManager.TriggerReconciliation(site.ID, "ssh_keys"); err != nil {
@@ -150,6 +192,10 @@ func (s *SiteMemberService) CreateSiteMember(
 		}
 	}
 
+	if s.notifier != nil {
+		s.notifier.MemberAdded(ctx, account.ID, "site", site.Name, req.Msg.Role, "/sites/"+siteID)
+	}
+
 	member := &libopsv1.MemberDetail{
 		AccountId:      accountID,
 		Email:          account.Email,
@@ -157,6 +203,7 @@ func (s *SiteMemberService) CreateSiteMember(
 		Role:           req.Msg.Role,
 		Status:         service.DbStatusToProto(string(status)),
 		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+		ExpiresAt:      service.ExpiryToProto(expiresAt),
 	}
 
 	return connect.NewResponse(&libopsv1.CreateSiteMemberResponse{
@@ -180,8 +227,14 @@ func (s *SiteMemberService) UpdateSiteMember(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := validation.RequiredString("role", req.Msg.Role); err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, service.MemberUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+	if mask.Has("role") {
+		if err := validation.RequiredString("role", req.Msg.Role); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 	}
 
 	site, err := service.GetSiteByPublicID(ctx, s.db, siteID)
@@ -202,10 +255,35 @@ func (s *SiteMemberService) UpdateSiteMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	existing, err := s.db.GetSiteMember(ctx, db.GetSiteMemberParams{
+		SiteID:    site.ID,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	role := existing.Role
+	if mask.Has("role") {
+		role = db.SiteMembersRole(req.Msg.Role)
+	}
+
+	expiresAt := existing.ExpiresAt
+	updateExpiry := req.Msg.ExpiresAt != nil && mask.Has("expires_at")
+	if updateExpiry {
+		expiresAt, err = service.ParseExpiry(req.Msg.GetExpiresAt())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	params := db.UpdateSiteMemberParams{
 		SiteID:    site.ID,
 		AccountID: account.ID,
-		Role:      db.SiteMembersRole(req.Msg.Role),
+		Role:      role,
 	}
 
 	err = s.db.UpdateSiteMember(ctx, params)
@@ -213,12 +291,24 @@ func (s *SiteMemberService) UpdateSiteMember(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	if updateExpiry {
+		err = s.db.SetSiteMemberExpiry(ctx, db.SetSiteMemberExpiryParams{
+			ExpiresAt: expiresAt,
+			SiteID:    site.ID,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+	}
+
 	member := &libopsv1.MemberDetail{
 		AccountId:      accountID,
 		Email:          account.Email,
 		Name:           service.FromNullString(account.Name),
-		Role:           req.Msg.Role,
+		Role:           string(role),
 		GithubUsername: service.FromNullStringPtr(account.GithubUsername),
+		ExpiresAt:      service.ExpiryToProto(expiresAt),
 	}
 
 	return connect.NewResponse(&libopsv1.UpdateSiteMemberResponse{
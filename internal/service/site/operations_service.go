@@ -4,31 +4,49 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
 )
 
 // SiteOperationsService implements the LibOps SiteOperationsService API.
 type SiteOperationsService struct {
-	db db.Querier
+	db      db.Querier
+	commits CommitResolver
+	backups BackupFinder // nil when backups aren't tracked
+	resolve func(ctx context.Context, host string) ([]string, error)
+	now     func() time.Time
 }
 
 // Compile-time check.
 var _ libopsv1connect.SiteOperationsServiceHandler = (*SiteOperationsService)(nil)
 
 // NewSiteOperationsService creates a new SiteOperationsService instance with DI.
-func NewSiteOperationsService(querier db.Querier) *SiteOperationsService {
+// commits may be nil, in which case deployments don't record the commit they deploy.
+func NewSiteOperationsService(querier db.Querier, commits CommitResolver) *SiteOperationsService {
 	return &SiteOperationsService{
-		db: querier,
+		db:      querier,
+		commits: commits,
+		resolve: lookupHost,
+		now:     time.Now,
 	}
 }
 
+// SetBackups lets site statuses report each site's newest backup.
+func (s *SiteOperationsService) SetBackups(backups BackupFinder) {
+	s.backups = backups
+}
+
 // DeploySite triggers a deployment for a site.
 func (s *SiteOperationsService) DeploySite(
 	ctx context.Context,
@@ -45,7 +63,7 @@ func (s *SiteOperationsService) DeploySite(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id format: %w", err))
 	}
 
-	_, err = s.db.GetSite(ctx, siteID)
+	site, err := s.db.GetSite(ctx, siteID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site not found"))
@@ -53,26 +71,36 @@ func (s *SiteOperationsService) DeploySite(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get site: %w", err))
 	}
 
-	deploymentID := uuid.New().String()
+	ref := site.GithubRef
+	if req.Msg.GitRef != nil {
+		ref = strings.TrimSpace(*req.Msg.GitRef)
+		if ref == "" || len(ref) > 255 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("git_ref must be 1 to 255 characters"))
+		}
+	}
 
-	err = s.db.CreateDeployment(ctx, db.CreateDeploymentParams{
-		ID:           deploymentID,
-		SiteID:       siteID,
-		Status:       "pending",
-		GithubRunID:  sql.NullString{Valid: false},
-		GithubRunUrl: sql.NullString{Valid: false},
-		StartedAt:    0,
-		CompletedAt:  sql.NullInt64{Valid: false},
-		ErrorMessage: sql.NullString{Valid: false},
-	})
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create deployment: %w", err))
+	params := db.CreateDeploymentParams{
+		ID:     uuid.New().String(),
+		SiteID: siteID,
+		GitRef: sql.NullString{String: ref, Valid: true},
+	}
+	if s.commits != nil {
+		sha, message, err := s.commits.ResolveCommit(ctx, site.GithubRepository, ref)
+		if err != nil {
+			// The deployment still runs; the history just won't show the commit
+			slog.Warn("Failed to resolve deployment commit", "site_id", siteID, "git_ref", ref, "err", err)
+		}
+		params.CommitSha = sql.NullString{String: sha, Valid: sha != ""}
+		params.CommitMessage = sql.NullString{String: message, Valid: message != ""}
 	}
 
-	// TODO: Trigger GitHub Actions workflow via API
+	deployment, err := s.createDeployment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&libopsv1.DeploySiteResponse{
-		DeploymentId: deploymentID,
+		DeploymentId: deployment.DeploymentId,
 		Status: &libopsv1.SiteStatus{
 			SiteId: siteID,
 			Status: "deploying",
@@ -80,7 +108,137 @@ func (s *SiteOperationsService) DeploySite(
 	}), nil
 }
 
-// GetSiteStatus retrieves the current status of a site.
+// ListSiteDeployments lists a site's deployments, newest first.
+func (s *SiteOperationsService) ListSiteDeployments(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListSiteDeploymentsRequest],
+) (*connect.Response[libopsv1.ListSiteDeploymentsResponse], error) {
+	siteID := req.Msg.SiteId
+
+	if err := validation.UUID(siteID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteDeployments(ctx, db.ListSiteDeploymentsParams{
+		SiteID: siteID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list deployments: %w", err))
+	}
+
+	deployments := make([]*libopsv1.Deployment, 0, len(rows))
+	for _, row := range rows {
+		deployments = append(deployments, toDeploymentProto(row))
+	}
+
+	return connect.NewResponse(&libopsv1.ListSiteDeploymentsResponse{
+		Deployments:   deployments,
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}), nil
+}
+
+// RollbackSite redeploys the commit an earlier successful deployment of the site deployed.
+func (s *SiteOperationsService) RollbackSite(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RollbackSiteRequest],
+) (*connect.Response[libopsv1.RollbackSiteResponse], error) {
+	siteID := req.Msg.SiteId
+
+	if err := validation.UUID(siteID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.DeploymentId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("deployment_id is required"))
+	}
+
+	target, err := s.db.GetDeployment(ctx, req.Msg.DeploymentId)
+	if err != nil || target.SiteID != siteID {
+		if err == nil || err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("deployment not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get deployment: %w", err))
+	}
+	if target.Status != db.DeploymentsStatusSuccess {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only successful deployments can be rolled back to"))
+	}
+
+	// Deploy the exact commit when it is known, since a branch may have moved on since
+	ref := target.GitRef
+	if target.CommitSha.Valid {
+		ref = target.CommitSha
+	}
+	if !ref.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("deployment does not record what it deployed"))
+	}
+
+	deployment, err := s.createDeployment(ctx, db.CreateDeploymentParams{
+		ID:            uuid.New().String(),
+		SiteID:        siteID,
+		GitRef:        ref,
+		CommitSha:     target.CommitSha,
+		CommitMessage: target.CommitMessage,
+		RollbackOf:    sql.NullString{String: target.ID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.RollbackSiteResponse{
+		Deployment: deployment,
+	}), nil
+}
+
+// createDeployment records a pending deployment started by the caller.
+func (s *SiteOperationsService) createDeployment(ctx context.Context, params db.CreateDeploymentParams) (*libopsv1.Deployment, error) {
+	params.Status = db.DeploymentsStatusPending
+	params.StartedAt = time.Now().Unix()
+	if userInfo, ok := auth.GetUserFromContext(ctx); ok && userInfo != nil {
+		params.CreatedBy = sql.NullInt64{Int64: userInfo.AccountID, Valid: true}
+	}
+
+	if err := s.db.CreateDeployment(ctx, params); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create deployment: %w", err))
+	}
+
+	// TODO: Trigger GitHub Actions workflow via API
+
+	return toDeploymentProto(db.Deployment{
+		ID:            params.ID,
+		SiteID:        params.SiteID,
+		Status:        params.Status,
+		GitRef:        params.GitRef,
+		CommitSha:     params.CommitSha,
+		CommitMessage: params.CommitMessage,
+		RollbackOf:    params.RollbackOf,
+		StartedAt:     params.StartedAt,
+	}), nil
+}
+
+func toDeploymentProto(d db.Deployment) *libopsv1.Deployment {
+	return &libopsv1.Deployment{
+		DeploymentId:  d.ID,
+		SiteId:        d.SiteID,
+		Status:        string(d.Status),
+		GitRef:        d.GitRef.String,
+		CommitSha:     d.CommitSha.String,
+		CommitMessage: d.CommitMessage.String,
+		RunUrl:        d.GithubRunUrl.String,
+		ErrorMessage:  d.ErrorMessage.String,
+		StartedAt:     d.StartedAt,
+		CompletedAt:   d.CompletedAt.Int64,
+		RollbackOf:    d.RollbackOf.String,
+	}
+}
+
+// GetSiteStatus retrieves the current status of a site, with the state of each
+// of its components.
 func (s *SiteOperationsService) GetSiteStatus(
 	ctx context.Context,
 	req *connect.Request[libopsv1.GetSiteStatusRequest],
@@ -104,9 +262,6 @@ func (s *SiteOperationsService) GetSiteStatus(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get site: %w", err))
 	}
 
-	// TODO: Get real-time status from GCE instance
-	// For now, return status from database
-
 	status := &libopsv1.SiteStatus{
 		SiteId: siteID,
 		Status: string(site.Status.SitesStatus),
@@ -123,6 +278,23 @@ func (s *SiteOperationsService) GetSiteStatus(
 		}
 	}
 
+	cdn, err := NewRepository(s.db).GetCdn(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+	status.Cdn = cdn
+
+	addons, err := listAddons(ctx, s.db, site)
+	if err != nil {
+		return nil, err
+	}
+	status.Addons = addons
+
+	status.Components, err = s.siteComponents(ctx, site, cdn)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
 	return connect.NewResponse(&libopsv1.GetSiteStatusResponse{
 		Status: status,
 	}), nil
@@ -0,0 +1,206 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/export"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const testSiteID = "0194d3a0-0000-7000-8000-000000000001"
+
+type fakeCommits struct{}
+
+func (fakeCommits) ResolveCommit(ctx context.Context, repository, ref string) (string, string, error) {
+	return "abc123", "Fix the catalog search", nil
+}
+
+// TestDeploySite tests that a deployment records the requested ref and its commit,
+// falling back to the site's configured ref.
+func TestDeploySite(t *testing.T) {
+	var created []db.CreateDeploymentParams
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{PublicID: publicID, GithubRepository: "libops/catalog", GithubRef: "heads/main"}, nil
+		},
+		CreateDeploymentFunc: func(ctx context.Context, arg db.CreateDeploymentParams) error {
+			created = append(created, arg)
+			return nil
+		},
+	}
+	svc := NewSiteOperationsService(mock, fakeCommits{})
+
+	_, err := svc.DeploySite(context.Background(), connect.NewRequest(&libopsv1.DeploySiteRequest{SiteId: testSiteID}))
+	require.NoError(t, err)
+	ref := "tags/v2.1.0"
+	_, err = svc.DeploySite(context.Background(), connect.NewRequest(&libopsv1.DeploySiteRequest{SiteId: testSiteID, GitRef: &ref}))
+	require.NoError(t, err)
+
+	require.Len(t, created, 2)
+	assert.Equal(t, "heads/main", created[0].GitRef.String)
+	assert.Equal(t, "tags/v2.1.0", created[1].GitRef.String)
+	assert.Equal(t, "abc123", created[1].CommitSha.String)
+	assert.Equal(t, "Fix the catalog search", created[1].CommitMessage.String)
+	assert.Equal(t, db.DeploymentsStatusPending, created[1].Status)
+	assert.NotZero(t, created[1].StartedAt)
+
+	blank := " "
+	_, err = svc.DeploySite(context.Background(), connect.NewRequest(&libopsv1.DeploySiteRequest{SiteId: testSiteID, GitRef: &blank}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+// TestRollbackSite tests that a rollback redeploys the exact commit of an earlier
+// successful deployment of the same site.
+func TestRollbackSite(t *testing.T) {
+	deployments := map[string]db.Deployment{
+		"good": {
+			ID:            "good",
+			SiteID:        testSiteID,
+			Status:        db.DeploymentsStatusSuccess,
+			GitRef:        sql.NullString{String: "heads/main", Valid: true},
+			CommitSha:     sql.NullString{String: "abc123", Valid: true},
+			CommitMessage: sql.NullString{String: "Fix the catalog search", Valid: true},
+		},
+		"broken": {ID: "broken", SiteID: testSiteID, Status: db.DeploymentsStatusFailed},
+		"other":  {ID: "other", SiteID: "0194d3a0-0000-7000-8000-000000000002", Status: db.DeploymentsStatusSuccess},
+	}
+	var created db.CreateDeploymentParams
+	mock := &testutils.MockQuerier{
+		GetDeploymentFunc: func(ctx context.Context, id string) (db.Deployment, error) {
+			if d, ok := deployments[id]; ok {
+				return d, nil
+			}
+			return db.Deployment{}, sql.ErrNoRows
+		},
+		CreateDeploymentFunc: func(ctx context.Context, arg db.CreateDeploymentParams) error {
+			created = arg
+			return nil
+		},
+	}
+	svc := NewSiteOperationsService(mock, nil)
+
+	resp, err := svc.RollbackSite(context.Background(), connect.NewRequest(&libopsv1.RollbackSiteRequest{SiteId: testSiteID, DeploymentId: "good"}))
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", created.GitRef.String, "the commit is deployed, not the branch")
+	assert.Equal(t, "good", created.RollbackOf.String)
+	assert.Equal(t, "Fix the catalog search", resp.Msg.Deployment.CommitMessage)
+	assert.Equal(t, "good", resp.Msg.Deployment.RollbackOf)
+
+	_, err = svc.RollbackSite(context.Background(), connect.NewRequest(&libopsv1.RollbackSiteRequest{SiteId: testSiteID, DeploymentId: "broken"}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	_, err = svc.RollbackSite(context.Background(), connect.NewRequest(&libopsv1.RollbackSiteRequest{SiteId: testSiteID, DeploymentId: "other"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+type fakeBackups struct{ latest *export.Object }
+
+func (b fakeBackups) Latest(ctx context.Context, sitePublicID string) (*export.Object, error) {
+	return b.latest, nil
+}
+
+// TestGetSiteStatusComponents tests that each component is judged from what was
+// last reported about it.
+func TestGetSiteStatusComponents(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{
+				ID:            5,
+				PublicID:      publicID,
+				Status:        db.NullSitesStatus{SitesStatus: db.SitesStatusActive, Valid: true},
+				GcpExternalIp: sql.NullString{String: "34.1.2.3", Valid: true},
+			}, nil
+		},
+		GetSiteCheckInFunc: func(ctx context.Context, id int64) (db.GetSiteCheckInRow, error) {
+			return db.GetSiteCheckInRow{
+				CheckinAt:           sql.NullTime{Time: now.Add(-3 * time.Hour), Valid: true},
+				ControllerVersion:   sql.NullString{String: "v1.4.0", Valid: true},
+				ContainersRunning:   sql.NullInt32{Int32: 3, Valid: true},
+				ContainersUnhealthy: sql.NullInt32{Int32: 1, Valid: true},
+			}, nil
+		},
+		GetSiteTlsPolicyFunc: func(ctx context.Context, siteID int64) (db.GetSiteTlsPolicyRow, error) {
+			return db.GetSiteTlsPolicyRow{
+				CertificateNotAfter: sql.NullTime{Time: now.Add(5 * 24 * time.Hour), Valid: true},
+				ProbeStatus:         db.SiteTlsPoliciesProbeStatusPassed,
+			}, nil
+		},
+		ListSiteDomainsFunc: func(ctx context.Context, arg db.ListSiteDomainsParams) ([]db.Domain, error) {
+			return []db.Domain{{Domain: "catalog.example.edu"}, {Domain: "old.example.edu"}}, nil
+		},
+		GetLatestSiteDeploymentFunc: func(ctx context.Context, siteID string) (db.Deployment, error) {
+			return db.Deployment{
+				ID:           "d1",
+				Status:       db.DeploymentsStatusFailed,
+				CommitSha:    sql.NullString{String: "abc1234def", Valid: true},
+				CompletedAt:  sql.NullInt64{Int64: now.Unix(), Valid: true},
+				ErrorMessage: sql.NullString{String: "compose up failed", Valid: true},
+			}, nil
+		},
+	}
+	svc := NewSiteOperationsService(mock, nil)
+	svc.now = func() time.Time { return now }
+	svc.resolve = func(ctx context.Context, host string) ([]string, error) {
+		if host == "old.example.edu" {
+			return []string{"10.0.0.1"}, nil
+		}
+		return []string{"34.1.2.3"}, nil
+	}
+	svc.SetBackups(fakeBackups{latest: &export.Object{UpdatedAt: now.Add(-72 * time.Hour)}})
+
+	resp, err := svc.GetSiteStatus(context.Background(), connect.NewRequest(&libopsv1.GetSiteStatusRequest{SiteId: testSiteID}))
+	require.NoError(t, err)
+	states := map[string]libopsv1.SiteComponentState{}
+	messages := map[string]string{}
+	for _, c := range resp.Msg.Status.Components {
+		states[c.Component] = c.State
+		messages[c.Component] = c.Message
+	}
+	assert.Equal(t, map[string]libopsv1.SiteComponentState{
+		"vm":         libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK,
+		"controller": libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR,
+		"containers": libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING,
+		"tls":        libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING,
+		"dns":        libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING,
+		"deployment": libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR,
+		"backup":     libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING,
+	}, states)
+	assert.Equal(t, "No check-in for 3 hours running v1.4.0", messages["controller"])
+	assert.Equal(t, "1 of 3 running containers failing their health check", messages["containers"])
+	assert.Equal(t, "Certificate expires in 5 days, on 2026-05-06", messages["tls"])
+	assert.Equal(t, "Not pointing at 34.1.2.3: old.example.edu (10.0.0.1)", messages["dns"])
+	assert.Equal(t, "Deploying abc1234 failed: compose up failed", messages["deployment"])
+	assert.Equal(t, "Last backed up 3 days ago", messages["backup"])
+	assert.Equal(t, now.Add(-3*time.Hour).Unix(), resp.Msg.Status.Components[1].ObservedAt)
+}
+
+// TestGitHubCommitsResolve tests that site refs are resolved by branch or tag name.
+func TestGitHubCommitsResolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/libops/catalog/commits/main", r.URL.Path)
+		_, _ = w.Write([]byte(`{"sha":"abc123","commit":{"message":"Fix the catalog search"}}`))
+	}))
+	defer server.Close()
+
+	commits := &GitHubCommits{client: server.Client(), baseURL: server.URL}
+	sha, message, err := commits.ResolveCommit(context.Background(), "libops/catalog", "heads/main")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", sha)
+	assert.Equal(t, "Fix the catalog search", message)
+
+	sha, _, err = commits.ResolveCommit(context.Background(), "libops/catalog", "release")
+	require.NoError(t, err)
+	assert.Empty(t, sha, "the latest release isn't resolved ahead of time")
+}
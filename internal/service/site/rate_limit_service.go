@@ -0,0 +1,281 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+const (
+	// maxSiteRateLimitRules caps the limit_req zones a site's proxy carries.
+	maxSiteRateLimitRules = 20
+	// maxRateLimitRequests and maxRateLimitBurst bound a rate limit rule.
+	maxRateLimitRequests = 100000
+	maxRateLimitBurst    = 10000
+	// rateLimitReportDays is how far back a rule's rejected requests are counted.
+	rateLimitReportDays = 7
+)
+
+// ListSiteRateLimitRules lists a site's HTTP rate limits, most specific path
+// first, with the requests each rejected over the last 7 days.
+func (s *SiteFirewallService) ListSiteRateLimitRules(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListSiteRateLimitRulesRequest],
+) (*connect.Response[libopsv1.ListSiteRateLimitRulesResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.repo.ListRateLimitRules(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.ListSiteRateLimitRulesResponse{
+		Rules: rateLimitRulesToProto(site.PublicID, rules),
+	}), nil
+}
+
+// CreateSiteRateLimitRule adds an HTTP rate limit to a site and queues the
+// site's reconciliation, which renders it into the proxy.
+func (s *SiteFirewallService) CreateSiteRateLimitRule(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateSiteRateLimitRuleRequest],
+) (*connect.Response[libopsv1.CreateSiteRateLimitRuleResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	pathPrefix := strings.TrimSpace(req.Msg.PathPrefix)
+	if err := validation.StringLength("name", name, 1, 255); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	switch {
+	case pathPrefix != "" && !strings.HasPrefix(pathPrefix, "/"):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix must start with /"))
+	case strings.ContainsAny(pathPrefix, redirectUnsafeChars):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix contains characters that aren't allowed"))
+	case len(pathPrefix) > 512:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix is longer than 512 characters"))
+	case req.Msg.Requests < 1 || req.Msg.Requests > maxRateLimitRequests:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requests must be 1 to %d", maxRateLimitRequests))
+	case req.Msg.Burst < 0 || req.Msg.Burst > maxRateLimitBurst:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("burst must be 0 to %d", maxRateLimitBurst))
+	}
+	window, err := protoRateLimitWindowToDB(req.Msg.Window)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.db.CountSiteRateLimitRules(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if count >= maxSiteRateLimitRules {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("a site can have at most %d rate limit rules", maxSiteRateLimitRules))
+	}
+
+	ruleID := uuid.NewString()
+	err = s.repo.db.CreateSiteRateLimitRule(ctx, db.CreateSiteRateLimitRuleParams{
+		PublicID:   ruleID,
+		SiteID:     site.ID,
+		Name:       name,
+		PathPrefix: pathPrefix,
+		Requests:   req.Msg.Requests,
+		RateWindow: window,
+		Burst:      req.Msg.Burst,
+		CreatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	rule, err := s.repo.db.GetSiteRateLimitRule(ctx, ruleID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.RateLimitRuleCreate, map[string]any{
+		"rule_id":     ruleID,
+		"name":        name,
+		"path_prefix": pathPrefix,
+		"requests":    req.Msg.Requests,
+		"window":      string(window),
+		"burst":       req.Msg.Burst,
+	})
+
+	resp := &libopsv1.CreateSiteRateLimitRuleResponse{
+		Rule: rateLimitRuleToProto(site.PublicID, db.ListSiteRateLimitRulesRow{
+			ID:         rule.ID,
+			PublicID:   rule.PublicID,
+			SiteID:     rule.SiteID,
+			Name:       rule.Name,
+			PathPrefix: rule.PathPrefix,
+			Requests:   rule.Requests,
+			RateWindow: rule.RateWindow,
+			Burst:      rule.Burst,
+			CreatedAt:  rule.CreatedAt,
+		}),
+	}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteRateLimitAdded, ruleID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteSiteRateLimitRule removes an HTTP rate limit from a site and queues
+// the site's reconciliation.
+func (s *SiteFirewallService) DeleteSiteRateLimitRule(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteSiteRateLimitRuleRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.UUID(req.Msg.RuleId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rule_id: %w", err))
+	}
+
+	rule, err := s.repo.db.GetSiteRateLimitRule(ctx, req.Msg.RuleId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && rule.SiteID != site.ID) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("rate limit rule not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.repo.db.DeleteSiteRateLimitRule(ctx, rule.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.RateLimitRuleDelete, map[string]any{
+		"rule_id": rule.PublicID,
+		"name":    rule.Name,
+	})
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteRateLimitRemoved, rule.PublicID, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// site looks up the site a request targets.
+func (s *SiteFirewallService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// ListRateLimitRules retrieves a site's rate limits, most specific path first,
+// with the requests each rejected over the last 7 days.
+func (r *Repository) ListRateLimitRules(ctx context.Context, siteID int64) ([]db.ListSiteRateLimitRulesRow, error) {
+	rules, err := r.db.ListSiteRateLimitRules(ctx, db.ListSiteRateLimitRulesParams{
+		Since:  time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -(rateLimitReportDays - 1)),
+		SiteID: siteID,
+	})
+	if err != nil {
+		slog.Error("Failed to list site rate limit rules", "site_id", siteID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return rules, nil
+}
+
+// recordRateLimitRejections adds the rejections a site's controller reported
+// to each rule's daily counter. Rules deleted since the controller last
+// reconciled, or belonging to another site, are skipped.
+func (r *Repository) recordRateLimitRejections(ctx context.Context, siteID int64, reports []*libopsv1.RateLimitRejections) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for _, report := range reports {
+		if report.Rejected <= 0 || validation.UUID(report.RuleId) != nil {
+			continue
+		}
+		rule, err := r.db.GetSiteRateLimitRule(ctx, report.RuleId)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && rule.SiteID != siteID) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get rate limit rule: %w", err)
+		}
+		err = r.db.AddSiteRateLimitRejections(ctx, db.AddSiteRateLimitRejectionsParams{
+			RuleID:       rule.ID,
+			RejectedDate: today,
+			Rejected:     report.Rejected,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add rate limit rejections: %w", err)
+		}
+	}
+	return nil
+}
+
+func protoRateLimitWindowToDB(window libopsv1.RateLimitWindow) (db.SiteRateLimitRulesRateWindow, error) {
+	switch window {
+	case libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_SECOND:
+		return db.SiteRateLimitRulesRateWindowSecond, nil
+	case libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_MINUTE:
+		return db.SiteRateLimitRulesRateWindowMinute, nil
+	}
+	return "", fmt.Errorf("window is required")
+}
+
+func dbRateLimitWindowToProto(window db.SiteRateLimitRulesRateWindow) libopsv1.RateLimitWindow {
+	switch window {
+	case db.SiteRateLimitRulesRateWindowSecond:
+		return libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_SECOND
+	case db.SiteRateLimitRulesRateWindowMinute:
+		return libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_MINUTE
+	}
+	return libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_UNSPECIFIED
+}
+
+func rateLimitRulesToProto(sitePublicID string, rows []db.ListSiteRateLimitRulesRow) []*libopsv1.SiteRateLimitRule {
+	rules := make([]*libopsv1.SiteRateLimitRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, rateLimitRuleToProto(sitePublicID, row))
+	}
+	return rules
+}
+
+func rateLimitRuleToProto(sitePublicID string, row db.ListSiteRateLimitRulesRow) *libopsv1.SiteRateLimitRule {
+	pb := &libopsv1.SiteRateLimitRule{
+		RuleId:           row.PublicID,
+		SiteId:           sitePublicID,
+		Name:             row.Name,
+		PathPrefix:       row.PathPrefix,
+		Requests:         row.Requests,
+		Window:           dbRateLimitWindowToProto(row.RateWindow),
+		Burst:            row.Burst,
+		RejectedRequests: row.Rejected,
+	}
+	if row.CreatedAt.Valid {
+		pb.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return pb
+}
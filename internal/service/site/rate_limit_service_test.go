@@ -0,0 +1,188 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteRateLimitRules tests that rate limits are validated and scoped to
+// their site, that the controller gets them through the site's proxy config,
+// and that the rejections it reports on check-in are counted per rule.
+func TestSiteRateLimitRules(t *testing.T) {
+	siteID := uuid.NewString()
+	rules := map[string]*db.ListSiteRateLimitRulesRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2, IsProduction: sql.NullBool{Bool: true, Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		CreateSiteRateLimitRuleFunc: func(ctx context.Context, arg db.CreateSiteRateLimitRuleParams) error {
+			rules[arg.PublicID] = &db.ListSiteRateLimitRulesRow{
+				ID:         int64(len(rules) + 1),
+				PublicID:   arg.PublicID,
+				SiteID:     arg.SiteID,
+				Name:       arg.Name,
+				PathPrefix: arg.PathPrefix,
+				Requests:   arg.Requests,
+				RateWindow: arg.RateWindow,
+				Burst:      arg.Burst,
+			}
+			return nil
+		},
+		GetSiteRateLimitRuleFunc: func(ctx context.Context, publicID string) (db.GetSiteRateLimitRuleRow, error) {
+			rule, ok := rules[publicID]
+			if !ok {
+				return db.GetSiteRateLimitRuleRow{}, sql.ErrNoRows
+			}
+			return db.GetSiteRateLimitRuleRow{
+				ID:         rule.ID,
+				PublicID:   rule.PublicID,
+				SiteID:     rule.SiteID,
+				Name:       rule.Name,
+				PathPrefix: rule.PathPrefix,
+				Requests:   rule.Requests,
+				RateWindow: rule.RateWindow,
+				Burst:      rule.Burst,
+			}, nil
+		},
+		ListSiteRateLimitRulesFunc: func(ctx context.Context, arg db.ListSiteRateLimitRulesParams) ([]db.ListSiteRateLimitRulesRow, error) {
+			var all []db.ListSiteRateLimitRulesRow
+			for _, rule := range rules {
+				all = append(all, *rule)
+			}
+			return all, nil
+		},
+		CountSiteRateLimitRulesFunc: func(ctx context.Context, id int64) (int64, error) {
+			return int64(len(rules)), nil
+		},
+		DeleteSiteRateLimitRuleFunc: func(ctx context.Context, id int64) error {
+			for publicID, rule := range rules {
+				if rule.ID == id {
+					delete(rules, publicID)
+				}
+			}
+			return nil
+		},
+		AddSiteRateLimitRejectionsFunc: func(ctx context.Context, arg db.AddSiteRateLimitRejectionsParams) error {
+			for _, rule := range rules {
+				if rule.ID == arg.RuleID {
+					rule.Rejected += arg.Rejected
+				}
+			}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSiteFirewallService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	admin := NewAdminSiteService(mock)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	create := func(req *libopsv1.CreateSiteRateLimitRuleRequest) (*connect.Response[libopsv1.CreateSiteRateLimitRuleResponse], error) {
+		req.SiteId = siteID
+		return svc.CreateSiteRateLimitRule(ctx, connect.NewRequest(req))
+	}
+	second := libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_SECOND
+
+	for name, req := range map[string]*libopsv1.CreateSiteRateLimitRuleRequest{
+		"no name":          {Requests: 10, Window: second},
+		"no requests":      {Name: "all", Window: second},
+		"too many":         {Name: "all", Requests: maxRateLimitRequests + 1, Window: second},
+		"negative burst":   {Name: "all", Requests: 10, Window: second, Burst: -1},
+		"no window":        {Name: "all", Requests: 10},
+		"relative path":    {Name: "login", PathPrefix: "user/login", Requests: 10, Window: second},
+		"nginx in a path":  {Name: "login", PathPrefix: "/user\"; allow all", Requests: 10, Window: second},
+		"variable in path": {Name: "login", PathPrefix: "/$uri", Requests: 10, Window: second},
+	} {
+		_, err := create(req)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	login, err := create(&libopsv1.CreateSiteRateLimitRuleRequest{
+		Name:       "Login",
+		PathPrefix: "/user/login",
+		Requests:   5,
+		Window:     libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_MINUTE,
+		Burst:      2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.RateLimitWindow_RATE_LIMIT_WINDOW_MINUTE, login.Msg.Rule.Window)
+	_, err = create(&libopsv1.CreateSiteRateLimitRuleRequest{Name: "Everything", Requests: 20, Window: second})
+	require.NoError(t, err)
+
+	proxy, err := admin.GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Len(t, proxy.Msg.RateLimits, 2)
+
+	loginID := login.Msg.Rule.RuleId
+	otherSite := uuid.NewString()
+	rules[otherSite] = &db.ListSiteRateLimitRulesRow{ID: 99, PublicID: otherSite, SiteID: 6}
+	_, err = admin.SiteCheckIn(ctx, connect.NewRequest(&libopsv1.SiteCheckInRequest{
+		SiteId: siteID,
+		RateLimitRejections: []*libopsv1.RateLimitRejections{
+			{RuleId: loginID, Rejected: 40},
+			{RuleId: otherSite, Rejected: 7},
+			{RuleId: uuid.NewString(), Rejected: 3},
+			{RuleId: "not-a-rule", Rejected: 3},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), rules[loginID].Rejected)
+	assert.Zero(t, rules[otherSite].Rejected, "another site's rule isn't counted")
+
+	_, err = svc.DeleteSiteRateLimitRule(ctx, connect.NewRequest(&libopsv1.DeleteSiteRateLimitRuleRequest{SiteId: siteID, RuleId: otherSite}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "another site's rule")
+	delete(rules, otherSite)
+
+	listed, err := svc.ListSiteRateLimitRules(ctx, connect.NewRequest(&libopsv1.ListSiteRateLimitRulesRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	for _, rule := range listed.Msg.Rules {
+		if rule.RuleId == loginID {
+			assert.Equal(t, int64(40), rule.RejectedRequests)
+		}
+	}
+
+	_, err = svc.DeleteSiteRateLimitRule(ctx, connect.NewRequest(&libopsv1.DeleteSiteRateLimitRuleRequest{SiteId: siteID, RuleId: loginID}))
+	require.NoError(t, err)
+	assert.NotContains(t, rules, loginID)
+
+	require.Len(t, queued, 3)
+	assert.Equal(t, events.EventTypeSiteRateLimitRemoved, queued[2].EventType)
+	for _, event := range queued {
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.RateLimitRuleCreate),
+		string(audit.RateLimitRuleCreate),
+		string(audit.RateLimitRuleDelete),
+	}, audited)
+
+	for i := len(rules); i < maxSiteRateLimitRules; i++ {
+		_, err = create(&libopsv1.CreateSiteRateLimitRuleRequest{Name: "filler", Requests: 1, Window: second})
+		require.NoError(t, err)
+	}
+	_, err = create(&libopsv1.CreateSiteRateLimitRuleRequest{Name: "one too many", Requests: 1, Window: second})
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
@@ -0,0 +1,171 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	defaultTimelineLimit = 10
+	maxTimelineLimit     = 50
+)
+
+// timelineTypes are the VM reconciliation types the site proxy records, in the
+// order the timeline lists them. Deployments follow them.
+var timelineTypes = []db.ReconciliationsReconciliationType{
+	db.ReconciliationsReconciliationTypeSshKeys,
+	db.ReconciliationsReconciliationTypeSecrets,
+	db.ReconciliationsReconciliationTypeFirewall,
+}
+
+// SiteReconciliationService implements the SiteReconciliationService API.
+type SiteReconciliationService struct {
+	db   db.Querier
+	repo *Repository
+}
+
+// Compile-time check to ensure SiteReconciliationService implements the interface.
+var _ libopsv1connect.SiteReconciliationServiceHandler = (*SiteReconciliationService)(nil)
+
+// NewSiteReconciliationService creates a new SiteReconciliationService instance.
+func NewSiteReconciliationService(querier db.Querier) *SiteReconciliationService {
+	return &SiteReconciliationService{
+		db:   querier,
+		repo: NewRepository(querier),
+	}
+}
+
+// GetSiteReconciliationTimeline returns a site's latest VM reconciliations of
+// each type and its latest deployments, newest first.
+func (s *SiteReconciliationService) GetSiteReconciliationTimeline(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteReconciliationTimelineRequest],
+) (*connect.Response[libopsv1.GetSiteReconciliationTimelineResponse], error) {
+	siteID := req.Msg.SiteId
+	if err := validation.UUID(siteID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := req.Msg.Limit
+	if limit < 0 || limit > maxTimelineLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must be between 1 and %d", maxTimelineLimit))
+	}
+	if limit == 0 {
+		limit = defaultTimelineLimit
+	}
+
+	site, err := s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+	if err != nil {
+		return nil, err
+	}
+
+	var timelines []*libopsv1.ReconciliationTimeline
+	for _, t := range timelineTypes {
+		rows, err := s.db.ListSiteReconciliationRuns(ctx, db.ListSiteReconciliationRunsParams{
+			SiteID:             sql.NullInt64{Int64: site.ID, Valid: true},
+			ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: t, Valid: true},
+			Limit:              limit,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+		}
+
+		timeline := &libopsv1.ReconciliationTimeline{Type: string(t)}
+		for _, row := range rows {
+			timeline.Runs = append(timeline.Runs, toReconciliationRunProto(row))
+		}
+		timelines = append(timelines, timeline)
+	}
+
+	deployments, err := s.db.ListSiteDeployments(ctx, db.ListSiteDeploymentsParams{
+		SiteID: site.PublicID,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list deployments: %w", err))
+	}
+	timeline := &libopsv1.ReconciliationTimeline{Type: "deployment"}
+	for _, d := range deployments {
+		timeline.Runs = append(timeline.Runs, deploymentRunProto(d))
+	}
+	timelines = append(timelines, timeline)
+
+	return connect.NewResponse(&libopsv1.GetSiteReconciliationTimelineResponse{Timelines: timelines}), nil
+}
+
+func toReconciliationRunProto(r db.Reconciliation) *libopsv1.ReconciliationRun {
+	run := &libopsv1.ReconciliationRun{
+		RunId:        r.RunID,
+		ErrorMessage: r.ErrorMessage.String,
+		Full:         r.ReconciliationType.ReconciliationsReconciliationType == db.ReconciliationsReconciliationTypeGeneral,
+		Attempt:      max(r.Attempt, 1),
+	}
+
+	switch r.Status.ReconciliationsStatus {
+	case db.ReconciliationsStatusCompleted:
+		run.Status = "succeeded"
+	case db.ReconciliationsStatusFailed:
+		run.Status = "failed"
+		switch r.RetryState.ReconciliationsRetryState {
+		case db.ReconciliationsRetryStateScheduled:
+			if r.NextRetryAt.Valid {
+				run.NextRetryAt = r.NextRetryAt.Time.Unix()
+			}
+		case db.ReconciliationsRetryStateExhausted:
+			run.RetriesExhausted = true
+		}
+	case db.ReconciliationsStatusTriggered, db.ReconciliationsStatusRunning:
+		run.Status = "running"
+	default:
+		run.Status = "pending"
+	}
+
+	started := r.StartedAt
+	if !started.Valid {
+		started = r.CreatedAt
+	}
+	if started.Valid {
+		run.StartedAt = started.Time.Unix()
+	}
+	if r.CompletedAt.Valid {
+		run.CompletedAt = r.CompletedAt.Time.Unix()
+		if started.Valid {
+			run.DurationSeconds = int64(r.CompletedAt.Time.Sub(started.Time).Seconds())
+		}
+	}
+	return run
+}
+
+func deploymentRunProto(d db.Deployment) *libopsv1.ReconciliationRun {
+	run := &libopsv1.ReconciliationRun{
+		RunId:        d.ID,
+		StartedAt:    d.StartedAt,
+		ErrorMessage: d.ErrorMessage.String,
+	}
+
+	switch d.Status {
+	case db.DeploymentsStatusSuccess:
+		run.Status = "succeeded"
+	case db.DeploymentsStatusFailed:
+		run.Status = "failed"
+	case db.DeploymentsStatusInProgress:
+		run.Status = "running"
+	default:
+		run.Status = "pending"
+	}
+
+	if d.CompletedAt.Valid {
+		run.CompletedAt = d.CompletedAt.Int64
+		run.DurationSeconds = d.CompletedAt.Int64 - d.StartedAt
+	}
+	return run
+}
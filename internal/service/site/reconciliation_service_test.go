@@ -0,0 +1,100 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteReconciliationTimeline tests that each type lists its own and full
+// runs with their durations and errors, followed by the site's deployments.
+func TestSiteReconciliationTimeline(t *testing.T) {
+	siteID := uuid.NewString()
+	started := time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC)
+	var limits []int32
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID}, nil
+		},
+		ListSiteReconciliationRunsFunc: func(ctx context.Context, arg db.ListSiteReconciliationRunsParams) ([]db.Reconciliation, error) {
+			assert.Equal(t, int64(5), arg.SiteID.Int64)
+			limits = append(limits, arg.Limit)
+			if arg.ReconciliationType.ReconciliationsReconciliationType != db.ReconciliationsReconciliationTypeFirewall {
+				return nil, nil
+			}
+			return []db.Reconciliation{
+				{
+					RunID:              "run-2",
+					ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: db.ReconciliationsReconciliationTypeFirewall, Valid: true},
+					Status:             db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusFailed, Valid: true},
+					ErrorMessage:       sql.NullString{String: "site controller returned status 500", Valid: true},
+					Attempt:            2,
+					RetryState:         db.NullReconciliationsRetryState{ReconciliationsRetryState: db.ReconciliationsRetryStateScheduled, Valid: true},
+					NextRetryAt:        sql.NullTime{Time: started.Add(time.Minute), Valid: true},
+					StartedAt:          sql.NullTime{Time: started, Valid: true},
+					CompletedAt:        sql.NullTime{Time: started.Add(12 * time.Second), Valid: true},
+				},
+				{
+					RunID:              "run-1",
+					ReconciliationType: db.NullReconciliationsReconciliationType{ReconciliationsReconciliationType: db.ReconciliationsReconciliationTypeGeneral, Valid: true},
+					Status:             db.NullReconciliationsStatus{ReconciliationsStatus: db.ReconciliationsStatusCompleted, Valid: true},
+					CreatedAt:          sql.NullTime{Time: started.Add(-time.Hour), Valid: true},
+				},
+			}, nil
+		},
+		ListSiteDeploymentsFunc: func(ctx context.Context, arg db.ListSiteDeploymentsParams) ([]db.Deployment, error) {
+			assert.Equal(t, siteID, arg.SiteID)
+			return []db.Deployment{{
+				ID:          "dep-1",
+				Status:      db.DeploymentsStatusSuccess,
+				StartedAt:   started.Unix(),
+				CompletedAt: sql.NullInt64{Int64: started.Unix() + 90, Valid: true},
+			}}, nil
+		},
+	}
+	svc := NewSiteReconciliationService(mock)
+
+	_, err := svc.GetSiteReconciliationTimeline(context.Background(), connect.NewRequest(&libopsv1.GetSiteReconciliationTimelineRequest{SiteId: siteID, Limit: 51}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	resp, err := svc.GetSiteReconciliationTimeline(context.Background(), connect.NewRequest(&libopsv1.GetSiteReconciliationTimelineRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, []int32{10, 10, 10}, limits)
+
+	timelines := resp.Msg.Timelines
+	require.Len(t, timelines, 4)
+	for i, want := range []string{"ssh_keys", "secrets", "firewall", "deployment"} {
+		assert.Equal(t, want, timelines[i].Type)
+	}
+	assert.Empty(t, timelines[0].Runs)
+
+	firewall := timelines[2].Runs
+	require.Len(t, firewall, 2)
+	assert.Equal(t, "failed", firewall[0].Status)
+	assert.Equal(t, int64(12), firewall[0].DurationSeconds)
+	assert.Equal(t, "site controller returned status 500", firewall[0].ErrorMessage)
+	assert.False(t, firewall[0].Full)
+	assert.Equal(t, int32(2), firewall[0].Attempt)
+	assert.Equal(t, started.Add(time.Minute).Unix(), firewall[0].NextRetryAt)
+	assert.False(t, firewall[0].RetriesExhausted)
+	assert.Equal(t, "succeeded", firewall[1].Status)
+	assert.True(t, firewall[1].Full)
+	assert.Equal(t, int32(1), firewall[1].Attempt, "runs recorded before retries count as a first attempt")
+	assert.Equal(t, started.Add(-time.Hour).Unix(), firewall[1].StartedAt, "runs without a start time use when they were recorded")
+	assert.Zero(t, firewall[1].DurationSeconds)
+
+	deployments := timelines[3].Runs
+	require.Len(t, deployments, 1)
+	assert.Equal(t, "succeeded", deployments[0].Status)
+	assert.Equal(t, int64(90), deployments[0].DurationSeconds)
+}
@@ -0,0 +1,392 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// maxSiteRedirects caps how many redirects a site's proxy config carries.
+	maxSiteRedirects = 500
+	// redirectUnsafeChars can't appear in a redirect's paths or target: they
+	// would need escaping in the proxy config the controller renders.
+	redirectUnsafeChars = " \t\r\n\"'\\{};$`<>"
+)
+
+// redirectStatusCodes are the status codes a redirect can answer with.
+var redirectStatusCodes = map[int32]bool{301: true, 302: true, 307: true, 308: true}
+
+// RedirectService implements the RedirectService API.
+type RedirectService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure RedirectService implements the interface.
+var _ libopsv1connect.RedirectServiceHandler = (*RedirectService)(nil)
+
+// NewRedirectService creates a new RedirectService instance.
+func NewRedirectService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *RedirectService {
+	return &RedirectService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListRedirects lists a site's redirects by host and path.
+func (s *RedirectService) ListRedirects(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListRedirectsRequest],
+) (*connect.Response[libopsv1.ListRedirectsResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := service.ParsePagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteRedirects(ctx, db.ListSiteRedirectsParams{
+		SiteID: site.ID,
+		Limit:  pagination.Limit,
+		Offset: pagination.Offset,
+	})
+	if err != nil {
+		slog.Error("Failed to list site redirects", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListRedirectsResponse{
+		Redirects:     make([]*libopsv1.Redirect, 0, len(rows)),
+		NextPageToken: service.MakePaginationResult(len(rows), pagination).NextPageToken,
+	}
+	for _, row := range rows {
+		resp.Redirects = append(resp.Redirects, redirectToProto(site.PublicID, db.GetSiteRedirectRow(row)))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// CreateRedirect adds a redirect to a site and queues the site's
+// reconciliation, which renders it into the proxy.
+func (s *RedirectService) CreateRedirect(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateRedirectRequest],
+) (*connect.Response[libopsv1.CreateRedirectResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	rule := redirectRule{
+		host:          strings.ToLower(strings.TrimSpace(req.Msg.FromHost)),
+		path:          strings.TrimSpace(req.Msg.FromPath),
+		to:            strings.TrimSpace(req.Msg.To),
+		statusCode:    req.Msg.StatusCode,
+		preserveQuery: req.Msg.PreserveQuery == nil || *req.Msg.PreserveQuery,
+	}
+	if rule.statusCode == 0 {
+		rule.statusCode = 301
+	}
+	if err := rule.validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountSiteRedirects(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if count >= maxSiteRedirects {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("a site can have at most %d redirects", maxSiteRedirects))
+	}
+	if err := s.checkSourceFree(ctx, site.ID, rule, 0); err != nil {
+		return nil, err
+	}
+
+	redirectID := uuid.NewString()
+	err = s.db.CreateSiteRedirect(ctx, db.CreateSiteRedirectParams{
+		PublicID:      redirectID,
+		SiteID:        site.ID,
+		FromHost:      rule.host,
+		FromPath:      rule.path,
+		ToUrl:         rule.to,
+		StatusCode:    int16(rule.statusCode),
+		PreserveQuery: rule.preserveQuery,
+		CreatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "redirect")
+	}
+
+	created, err := s.db.GetSiteRedirect(ctx, redirectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteRedirectCreate, rule.auditData(redirectID))
+
+	resp := &libopsv1.CreateRedirectResponse{Redirect: redirectToProto(site.PublicID, created)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteRedirectCreated, redirectID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateRedirect changes a redirect and queues the site's reconciliation.
+func (s *RedirectService) UpdateRedirect(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateRedirectRequest],
+) (*connect.Response[libopsv1.UpdateRedirectResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, existing, err := s.redirect(ctx, req.Msg.SiteId, req.Msg.RedirectId)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	rule := redirectRule{
+		host:          existing.FromHost,
+		path:          existing.FromPath,
+		to:            existing.ToUrl,
+		statusCode:    int32(existing.StatusCode),
+		preserveQuery: existing.PreserveQuery,
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "from_host") && msg.FromHost != nil {
+		rule.host = strings.ToLower(strings.TrimSpace(*msg.FromHost))
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "from_path") && msg.FromPath != nil {
+		rule.path = strings.TrimSpace(*msg.FromPath)
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "to") && msg.To != nil {
+		rule.to = strings.TrimSpace(*msg.To)
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "status_code") && msg.StatusCode != nil {
+		rule.statusCode = *msg.StatusCode
+	}
+	if service.ShouldUpdateField(msg.UpdateMask, "preserve_query") && msg.PreserveQuery != nil {
+		rule.preserveQuery = *msg.PreserveQuery
+	}
+	if err := rule.validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkSourceFree(ctx, site.ID, rule, existing.ID); err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpdateSiteRedirect(ctx, db.UpdateSiteRedirectParams{
+		FromHost:      rule.host,
+		FromPath:      rule.path,
+		ToUrl:         rule.to,
+		StatusCode:    int16(rule.statusCode),
+		PreserveQuery: rule.preserveQuery,
+		ID:            existing.ID,
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "redirect")
+	}
+
+	updated, err := s.db.GetSiteRedirect(ctx, existing.PublicID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteRedirectUpdate, rule.auditData(existing.PublicID))
+
+	resp := &libopsv1.UpdateRedirectResponse{Redirect: redirectToProto(site.PublicID, updated)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteRedirectUpdated, existing.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteRedirect removes a redirect and queues the site's reconciliation.
+func (s *RedirectService) DeleteRedirect(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteRedirectRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, existing, err := s.redirect(ctx, req.Msg.SiteId, req.Msg.RedirectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.DeleteSiteRedirect(ctx, existing.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteRedirectDelete, map[string]any{
+		"redirect_id": existing.PublicID,
+		"from_host":   existing.FromHost,
+		"from_path":   existing.FromPath,
+	})
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteRedirectDeleted, existing.PublicID, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// redirectRule is a redirect's matching and target, validated together since
+// the target's wildcard depends on the path's.
+type redirectRule struct {
+	host          string
+	path          string
+	to            string
+	statusCode    int32
+	preserveQuery bool
+}
+
+func (r redirectRule) validate() error {
+	if r.host != "" {
+		if err := validation.Hostname(r.host); err != nil {
+			return fmt.Errorf("from_host: %w", err)
+		}
+	}
+
+	switch {
+	case !strings.HasPrefix(r.path, "/"):
+		return fmt.Errorf("from_path must start with /")
+	case len(r.path) > 1024:
+		return fmt.Errorf("from_path is longer than 1024 characters")
+	case strings.ContainsAny(r.path, redirectUnsafeChars+"?#"):
+		return fmt.Errorf("from_path can't contain whitespace, quotes, a query or any of {};$`<>")
+	case strings.Contains(strings.TrimSuffix(r.path, "*"), "*"):
+		return fmt.Errorf("from_path can only have a * at the end")
+	}
+
+	switch {
+	case r.to == "":
+		return fmt.Errorf("to is required")
+	case len(r.to) > 2048:
+		return fmt.Errorf("to is longer than 2048 characters")
+	case strings.ContainsAny(r.to, redirectUnsafeChars):
+		return fmt.Errorf("to can't contain whitespace, quotes or any of {};$`<>")
+	case strings.Contains(strings.TrimSuffix(r.to, "*"), "*"):
+		return fmt.Errorf("to can only have a * at the end")
+	case strings.HasSuffix(r.to, "*") && !strings.HasSuffix(r.path, "*"):
+		return fmt.Errorf("to can only end in * when from_path does")
+	}
+	if !strings.HasPrefix(r.to, "/") {
+		target, err := url.Parse(r.to)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return fmt.Errorf("to must be a path or an http(s) URL")
+		}
+	} else if strings.HasPrefix(r.to, "//") {
+		return fmt.Errorf("to must be a path or an http(s) URL")
+	}
+	if r.to == r.path {
+		return fmt.Errorf("to can't be the path it redirects from")
+	}
+
+	if !redirectStatusCodes[r.statusCode] {
+		return fmt.Errorf("status_code must be 301, 302, 307 or 308")
+	}
+	return nil
+}
+
+func (r redirectRule) auditData(redirectID string) map[string]any {
+	return map[string]any{
+		"redirect_id": redirectID,
+		"from_host":   r.host,
+		"from_path":   r.path,
+		"to":          r.to,
+		"status_code": r.statusCode,
+	}
+}
+
+// checkSourceFree makes sure no other redirect of the site matches the same
+// host and path; exceptID is the redirect being updated.
+func (s *RedirectService) checkSourceFree(ctx context.Context, siteID int64, rule redirectRule, exceptID int64) error {
+	other, err := s.db.GetSiteRedirectBySource(ctx, db.GetSiteRedirectBySourceParams{
+		SiteID:   siteID,
+		FromHost: rule.host,
+		FromPath: rule.path,
+	})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && other.ID == exceptID) {
+		return nil
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the site already redirects %s%s", rule.host, rule.path))
+}
+
+// site looks up the site a request targets.
+func (s *RedirectService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// redirect looks up a redirect and the site it belongs to.
+func (s *RedirectService) redirect(ctx context.Context, siteID, redirectID string) (db.GetSiteRow, db.GetSiteRedirectRow, error) {
+	if err := validation.UUID(redirectID); err != nil {
+		return db.GetSiteRow{}, db.GetSiteRedirectRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	site, err := s.site(ctx, siteID)
+	if err != nil {
+		return db.GetSiteRow{}, db.GetSiteRedirectRow{}, err
+	}
+
+	redirect, err := s.db.GetSiteRedirect(ctx, redirectID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && redirect.SiteID != site.ID) {
+		return db.GetSiteRow{}, db.GetSiteRedirectRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("redirect not found"))
+	}
+	if err != nil {
+		return db.GetSiteRow{}, db.GetSiteRedirectRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return site, redirect, nil
+}
+
+func redirectToProto(sitePublicID string, row db.GetSiteRedirectRow) *libopsv1.Redirect {
+	redirect := &libopsv1.Redirect{
+		RedirectId:    row.PublicID,
+		SiteId:        sitePublicID,
+		FromHost:      row.FromHost,
+		FromPath:      row.FromPath,
+		To:            row.ToUrl,
+		StatusCode:    int32(row.StatusCode),
+		PreserveQuery: row.PreserveQuery,
+	}
+	if row.CreatedAt.Valid {
+		redirect.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	if row.UpdatedAt.Valid {
+		redirect.UpdatedAt = row.UpdatedAt.Time.Unix()
+	}
+	return redirect
+}
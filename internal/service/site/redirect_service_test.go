@@ -0,0 +1,181 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestRedirects tests that redirects are validated, that a site can't
+// redirect the same source twice, and that the controller gets them through
+// the site's proxy config.
+func TestRedirects(t *testing.T) {
+	siteID := uuid.NewString()
+	redirects := map[string]*db.GetSiteRedirectRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2, IsProduction: sql.NullBool{Bool: true, Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		CreateSiteRedirectFunc: func(ctx context.Context, arg db.CreateSiteRedirectParams) error {
+			redirects[arg.PublicID] = &db.GetSiteRedirectRow{
+				ID:            int64(len(redirects) + 1),
+				PublicID:      arg.PublicID,
+				SiteID:        arg.SiteID,
+				FromHost:      arg.FromHost,
+				FromPath:      arg.FromPath,
+				ToUrl:         arg.ToUrl,
+				StatusCode:    arg.StatusCode,
+				PreserveQuery: arg.PreserveQuery,
+			}
+			return nil
+		},
+		GetSiteRedirectFunc: func(ctx context.Context, publicID string) (db.GetSiteRedirectRow, error) {
+			if redirect, ok := redirects[publicID]; ok {
+				return *redirect, nil
+			}
+			return db.GetSiteRedirectRow{}, sql.ErrNoRows
+		},
+		GetSiteRedirectBySourceFunc: func(ctx context.Context, arg db.GetSiteRedirectBySourceParams) (db.GetSiteRedirectBySourceRow, error) {
+			for _, redirect := range redirects {
+				if redirect.FromHost == arg.FromHost && redirect.FromPath == arg.FromPath {
+					return db.GetSiteRedirectBySourceRow(*redirect), nil
+				}
+			}
+			return db.GetSiteRedirectBySourceRow{}, sql.ErrNoRows
+		},
+		UpdateSiteRedirectFunc: func(ctx context.Context, arg db.UpdateSiteRedirectParams) error {
+			for _, redirect := range redirects {
+				if redirect.ID == arg.ID {
+					redirect.FromHost = arg.FromHost
+					redirect.FromPath = arg.FromPath
+					redirect.ToUrl = arg.ToUrl
+					redirect.StatusCode = arg.StatusCode
+					redirect.PreserveQuery = arg.PreserveQuery
+				}
+			}
+			return nil
+		},
+		ListAllSiteRedirectsFunc: func(ctx context.Context, id int64) ([]db.ListAllSiteRedirectsRow, error) {
+			var all []db.ListAllSiteRedirectsRow
+			for _, redirect := range redirects {
+				all = append(all, db.ListAllSiteRedirectsRow(*redirect))
+			}
+			return all, nil
+		},
+		DeleteSiteRedirectFunc: func(ctx context.Context, id int64) error {
+			for publicID, redirect := range redirects {
+				if redirect.ID == id {
+					delete(redirects, publicID)
+				}
+			}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewRedirectService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	create := func(req *libopsv1.CreateRedirectRequest) (*connect.Response[libopsv1.CreateRedirectResponse], error) {
+		req.SiteId = siteID
+		return svc.CreateRedirect(ctx, connect.NewRequest(req))
+	}
+
+	for name, req := range map[string]*libopsv1.CreateRedirectRequest{
+		"relative path":        {FromPath: "old", To: "/new"},
+		"nginx variable":       {FromPath: "/old", To: "/$host"},
+		"inner wildcard":       {FromPath: "/old/*/page", To: "/new"},
+		"unmatched wildcard":   {FromPath: "/old", To: "/new/*"},
+		"redirect to itself":   {FromPath: "/old", To: "/old"},
+		"scheme-relative URL":  {FromPath: "/old", To: "//example.com/new"},
+		"non-http target":      {FromPath: "/old", To: "ftp://example.com/new"},
+		"unsupported status":   {FromPath: "/old", To: "/new", StatusCode: 303},
+		"hostname with a path": {FromHost: "example.com/x", FromPath: "/old", To: "/new"},
+	} {
+		_, err := create(req)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	created, err := create(&libopsv1.CreateRedirectRequest{FromPath: "/blog/*", To: "https://blog.example.com/*"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(301), created.Msg.Redirect.StatusCode)
+	assert.True(t, created.Msg.Redirect.PreserveQuery)
+	assert.Equal(t, siteID, created.Msg.Redirect.SiteId)
+
+	_, err = create(&libopsv1.CreateRedirectRequest{FromPath: "/blog/*", To: "/news/*"})
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	hosted, err := create(&libopsv1.CreateRedirectRequest{
+		FromHost:      "Old.Example.com",
+		FromPath:      "/blog/*",
+		To:            "/news",
+		StatusCode:    302,
+		PreserveQuery: proto.Bool(false),
+	})
+	require.NoError(t, err, "the same path on another host is a different source")
+	assert.Equal(t, "old.example.com", hosted.Msg.Redirect.FromHost)
+
+	redirectID := created.Msg.Redirect.RedirectId
+	updated, err := svc.UpdateRedirect(ctx, connect.NewRequest(&libopsv1.UpdateRedirectRequest{
+		SiteId:     siteID,
+		RedirectId: redirectID,
+		StatusCode: proto.Int32(308),
+		To:         proto.String("/ignored"),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status_code"}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(308), updated.Msg.Redirect.StatusCode)
+	assert.Equal(t, "https://blog.example.com/*", updated.Msg.Redirect.To, "fields outside the mask are left alone")
+
+	_, err = svc.UpdateRedirect(ctx, connect.NewRequest(&libopsv1.UpdateRedirectRequest{
+		SiteId:     siteID,
+		RedirectId: redirectID,
+		FromHost:   proto.String("old.example.com"),
+	}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	config, err := NewAdminSiteService(mock).GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Len(t, config.Msg.Redirects, 2)
+
+	_, err = svc.DeleteRedirect(ctx, connect.NewRequest(&libopsv1.DeleteRedirectRequest{SiteId: siteID, RedirectId: redirectID}))
+	require.NoError(t, err)
+	_, err = svc.DeleteRedirect(ctx, connect.NewRequest(&libopsv1.DeleteRedirectRequest{SiteId: siteID, RedirectId: redirectID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	require.Len(t, queued, 4)
+	assert.Equal(t, events.EventTypeSiteRedirectDeleted, queued[3].EventType)
+	for _, event := range queued {
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteRedirectCreate),
+		string(audit.SiteRedirectCreate),
+		string(audit.SiteRedirectUpdate),
+		string(audit.SiteRedirectDelete),
+	}, audited)
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/audit"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/envelope"
+	"github.com/libops/api/internal/quota"
+	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/service/organization"
-	"github.com/libops/api/internal/validation"
 	"github.com/libops/api/internal/vault"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
@@ -25,6 +27,7 @@ import (
 // SiteSecretService implements the SiteSecretService API.
 type SiteSecretService struct {
 	db          db.Querier
+	quotas      *quota.Enforcer
 	auditLogger *audit.Logger
 }
 
@@ -35,38 +38,11 @@ var _ libopsv1connect.SiteSecretServiceHandler = (*SiteSecretService)(nil)
 func NewSiteSecretService(querier db.Querier, auditLogger *audit.Logger) *SiteSecretService {
 	return &SiteSecretService{
 		db:          querier,
+		quotas:      quota.NewEnforcer(querier),
 		auditLogger: auditLogger,
 	}
 }
 
-// GetSiteVaultClient returns or creates a Vault client for the site's organization.
-func (s *SiteSecretService) GetSiteVaultClient(ctx context.Context, organizationID int64) (*vault.Client, error) {
-	// Get organization's libops project (where vault server runs)
-	project, err := s.db.GetOrganizationProjectByOrganizationID(ctx, organizationID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get organization project: %w", err)
-	}
-
-	// Parse project number from string
-	var projectNumber int64
-	if project.GcpProjectNumber.Valid {
-		_, _ = fmt.Sscanf(project.GcpProjectNumber.String, "%d", &projectNumber)
-	}
-
-	// Get region from project
-	region := "us-central1" // default
-	if project.GcpRegion.Valid && project.GcpRegion.String != "" {
-		region = project.GcpRegion.String
-	}
-
-	client, err := vault.NewCustomerVaultClient(ctx, organizationID, projectNumber, region)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create customer vault client: %w", err)
-	}
-
-	return client, nil
-}
-
 // CreateSiteSecret creates a new site-level secret.
 func (s *SiteSecretService) CreateSiteSecret(
 	ctx context.Context,
@@ -76,13 +52,9 @@ func (s *SiteSecretService) CreateSiteSecret(
 	if err := organization.ValidateSecretName(req.Msg.Name); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	// Validate value
-	if err := validation.RequiredString("value", req.Msg.Value); err != nil {
+	if err := organization.ValidateSecretValue(req.Msg.Value, req.Msg.Reference); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	if len(req.Msg.Value) > 65536 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-	}
 
 	siteUUID, err := uuid.Parse(req.Msg.SiteId)
 	if err != nil {
@@ -123,48 +95,62 @@ func (s *SiteSecretService) CreateSiteSecret(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
 
+	secretCount, err := s.db.CountSiteSecrets(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count site secrets: %w", err))
+	}
+	if err := s.quotas.Check(ctx, project.OrganizationID, quota.SecretsPerSite, secretCount); err != nil {
+		return nil, err
+	}
+
 	// 6. Build Vault path (uses site public ID)
 	vaultPath := vault.BuildSiteSecretPath(siteUUID.String(), req.Msg.Name)
 
-	// 7. Write to organization's Vault
-	vaultClient, err := s.GetSiteVaultClient(ctx, project.OrganizationID)
-	if err != nil {
-		slog.Error("failed to get vault client", "err", err, "organization_id", project.OrganizationID)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-	}
+	// 7. Write to organization's Vault, unless the value is kept in an
+	// external store the controller resolves it from
+	var vaultClient *envelope.Store
+	var keyVersion sql.NullInt32
+	if req.Msg.Reference == "" {
+		vaultClient, err = service.OrganizationSecretStore(ctx, s.db, project.OrganizationID)
+		if err != nil {
+			slog.Error("failed to get vault client", "err", err, "organization_id", project.OrganizationID)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+		}
 
-	err = vaultClient.WriteSecret(ctx, vaultPath, map[string]any{
-		"value": req.Msg.Value,
-	})
-	if err != nil {
-		slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
-		// Audit log for vault failure
-		s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretCreateFailed, map[string]any{
-			"secret_name": req.Msg.Name,
-			"vault_path":  vaultPath,
-			"error":       "vault_write_failed",
-			"error_msg":   err.Error(),
+		err = service.WriteSecret(ctx, vaultClient, vaultPath, map[string]any{
+			"value": req.Msg.Value,
 		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		if err != nil {
+			slog.Error("failed to write secret to vault", "err", err, "path", vaultPath)
+			// Audit log for vault failure
+			s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretCreateFailed, map[string]any{
+				"secret_name": req.Msg.Name,
+				"vault_path":  vaultPath,
+				"error":       "vault_write_failed",
+				"error_msg":   err.Error(),
+			})
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write secret"))
+		}
+		keyVersion = sql.NullInt32{Int32: vaultClient.KeyVersion(), Valid: true}
 	}
 
 	// 8. Create database record
 	secretUUID := uuid.New()
 	now := time.Now().Unix()
 	_, err = s.db.CreateSiteSecret(ctx, db.CreateSiteSecretParams{
-		PublicID:  secretUUID.String(),
-		SiteID:    site.ID,
-		Name:      req.Msg.Name,
-		VaultPath: vaultPath,
-		Status:    db.NullSiteSecretsStatus{SiteSecretsStatus: db.SiteSecretsStatusActive, Valid: true},
-		CreatedAt: now,
-		UpdatedAt: now,
-		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:   secretUUID.String(),
+		SiteID:     site.ID,
+		Name:       req.Msg.Name,
+		VaultPath:  vaultPath,
+		Status:     db.NullSiteSecretsStatus{SiteSecretsStatus: db.SiteSecretsStatusActive, Valid: true},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		CreatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		KeyVersion: keyVersion,
+		Reference:  sql.NullString{String: req.Msg.Reference, Valid: req.Msg.Reference != ""},
 	})
 	if err != nil {
-		// Rollback: delete from Vault
-		_ = vaultClient.DeleteSecret(ctx, vaultPath)
 		slog.Error("failed to create secret record", "err", err)
 		// Audit log for database failure
 		s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretCreateFailed, map[string]any{
@@ -191,15 +177,19 @@ func (s *SiteSecretService) CreateSiteSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  secret.VaultPath,
+		"reference":   req.Msg.Reference,
 	})
 
 	// 10. Return response
 	return connect.NewResponse(&libopsv1.CreateSiteSecretResponse{
 		Secret: &libopsv1.SiteSecret{
-			SecretId: secret.PublicID,
-			SiteId:   siteUUID.String(),
-			Name:     secret.Name,
-			Status:   dbSiteStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			SiteId:     siteUUID.String(),
+			Name:       secret.Name,
+			Status:     dbSiteStatusToProto(secret.Status),
+			Managed:    secret.Managed,
+			KeyVersion: keyVersion.Int32,
+			Reference:  req.Msg.Reference,
 		},
 	}), nil
 }
@@ -250,10 +240,13 @@ func (s *SiteSecretService) GetSiteSecret(
 
 	return connect.NewResponse(&libopsv1.GetSiteSecretResponse{
 		Secret: &libopsv1.SiteSecret{
-			SecretId: secret.PublicID,
-			SiteId:   siteUUID.String(),
-			Name:     secret.Name,
-			Status:   dbSiteStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			SiteId:     siteUUID.String(),
+			Name:       secret.Name,
+			Status:     dbSiteStatusToProto(secret.Status),
+			Managed:    secret.Managed,
+			KeyVersion: secret.KeyVersion.Int32,
+			Reference:  secret.Reference.String,
 		},
 	}), nil
 }
@@ -305,10 +298,12 @@ func (s *SiteSecretService) ListSiteSecrets(
 	protoSecrets := make([]*libopsv1.SiteSecret, len(secrets))
 	for i, secret := range secrets {
 		protoSecrets[i] = &libopsv1.SiteSecret{
-			SecretId: secret.PublicID,
-			SiteId:   siteUUID.String(),
-			Name:     secret.Name,
-			Status:   dbSiteStatusToProto(secret.Status),
+			SecretId:  secret.PublicID,
+			SiteId:    siteUUID.String(),
+			Name:      secret.Name,
+			Status:    dbSiteStatusToProto(secret.Status),
+			Managed:   secret.Managed,
+			Reference: secret.Reference.String,
 		}
 	}
 
@@ -333,6 +328,11 @@ func (s *SiteSecretService) UpdateSiteSecret(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid secret_id"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, organization.SecretUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get site
 	site, err := s.db.GetSite(ctx, siteUUID.String())
 	if err != nil {
@@ -368,55 +368,40 @@ func (s *SiteSecretService) UpdateSiteSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to site"))
 	}
 
-	// Update value if provided
-	if req.Msg.Value != nil && *req.Msg.Value != "" {
-		if len(*req.Msg.Value) > 65536 {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("value too long (max 64KB)"))
-		}
-
-		// Write to Vault
-		vaultClient, err := s.GetSiteVaultClient(ctx, project.OrganizationID)
-		if err != nil {
-			slog.Error("failed to get vault client", "err", err)
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
-		}
-
-		err = vaultClient.WriteSecret(ctx, secret.VaultPath, map[string]any{
-			"value": *req.Msg.Value,
-		})
-		if err != nil {
-			slog.Error("failed to update secret in vault", "err", err)
-			// Audit log for vault failure
-			s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "vault_write_failed",
-				"error_msg":   err.Error(),
-			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
+	// Managed secrets, like a database's credentials, are written by libops
+	if secret.Managed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("secret %s is managed by libops and can't be updated", secret.Name))
+	}
 
-		// Update database timestamp
-		now := time.Now().Unix()
-		err = s.db.UpdateSiteSecret(ctx, db.UpdateSiteSecretParams{
-			VaultPath: secret.VaultPath,
-			UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-			UpdatedAt: now,
-			ID:        secret.ID,
-		})
-		if err != nil {
-			slog.Error("failed to update secret record", "err", err)
-			// Audit log for database failure
-			s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretUpdateFailed, map[string]any{
-				"secret_id":   secret.PublicID,
-				"secret_name": secret.Name,
-				"vault_path":  secret.VaultPath,
-				"error":       "database_update_failed",
-				"error_msg":   err.Error(),
+	value, reference := "", ""
+	if mask.Has("value") {
+		value = req.Msg.GetValue()
+	}
+	if mask.Has("reference") {
+		reference = req.Msg.GetReference()
+	}
+	err = organization.ApplySecretChange(ctx, s.db, organization.SecretChange{
+		OrganizationID: project.OrganizationID,
+		VaultPath:      secret.VaultPath,
+		HadReference:   secret.Reference.Valid,
+		Save: func(reference sql.NullString, keyVersion sql.NullInt32) error {
+			return s.db.UpdateSiteSecret(ctx, db.UpdateSiteSecretParams{
+				VaultPath:  secret.VaultPath,
+				Reference:  reference,
+				KeyVersion: keyVersion,
+				UpdatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+				UpdatedAt:  time.Now().Unix(),
+				ID:         secret.ID,
 			})
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update secret"))
-		}
+		},
+		Failed: func(data map[string]any) {
+			data["secret_id"] = secret.PublicID
+			data["secret_name"] = secret.Name
+			s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretUpdateFailed, data)
+		},
+	}, value, reference)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get updated secret
@@ -430,14 +415,18 @@ func (s *SiteSecretService) UpdateSiteSecret(
 		"secret_id":   secret.PublicID,
 		"secret_name": secret.Name,
 		"vault_path":  secret.VaultPath,
+		"reference":   secret.Reference.String,
 	})
 
 	return connect.NewResponse(&libopsv1.UpdateSiteSecretResponse{
 		Secret: &libopsv1.SiteSecret{
-			SecretId: secret.PublicID,
-			SiteId:   siteUUID.String(),
-			Name:     secret.Name,
-			Status:   dbSiteStatusToProto(secret.Status),
+			SecretId:   secret.PublicID,
+			SiteId:     siteUUID.String(),
+			Name:       secret.Name,
+			Status:     dbSiteStatusToProto(secret.Status),
+			Managed:    secret.Managed,
+			KeyVersion: secret.KeyVersion.Int32,
+			Reference:  secret.Reference.String,
 		},
 	}), nil
 }
@@ -492,25 +481,15 @@ func (s *SiteSecretService) DeleteSiteSecret(
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("secret does not belong to site"))
 	}
 
-	// Delete from Vault
-	vaultClient, err := s.GetSiteVaultClient(ctx, project.OrganizationID)
-	if err != nil {
-		slog.Error("failed to get vault client", "err", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
+	// Managed secrets, like a database's credentials, are written by libops
+	if secret.Managed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("secret %s is managed by libops and can't be deleted", secret.Name))
 	}
 
-	err = vaultClient.DeleteSecret(ctx, secret.VaultPath)
+	vaultClient, err := service.OrganizationSecretStore(ctx, s.db, project.OrganizationID)
 	if err != nil {
-		slog.Error("failed to delete secret from vault", "err", err)
-		// Audit log for vault failure
-		s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSecretDeleteFailed, map[string]any{
-			"secret_id":   secret.PublicID,
-			"secret_name": secret.Name,
-			"vault_path":  secret.VaultPath,
-			"error":       "vault_delete_failed",
-			"error_msg":   err.Error(),
-		})
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
+		slog.Error("failed to get vault client", "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access vault"))
 	}
 
 	// Mark as deleted in database
@@ -532,6 +511,7 @@ func (s *SiteSecretService) DeleteSiteSecret(
 		})
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete secret"))
 	}
+	service.DeleteSecret(ctx, vaultClient, secret.VaultPath)
 
 	// Audit log for success
 	s.auditLogger.Log(ctx, userInfo.AccountID, secret.ID, audit.SiteEntityType, audit.SiteSecretDeleteSuccess, map[string]any{
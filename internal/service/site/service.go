@@ -12,6 +12,7 @@ import (
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	"github.com/libops/api/internal/validation"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
@@ -79,25 +80,24 @@ func (s *SiteService) ListSites(
 		filterProjectID = sql.NullInt64{Int64: project.ID, Valid: true}
 	}
 
-	pageSize := req.Msg.PageSize
-	if pageSize <= 0 {
-		pageSize = 50
-	}
-	if pageSize > 100 {
-		pageSize = 100
+	pagination, err := service.ParseCursorPagination(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	offset, err := service.ParsePageToken(req.Msg.PageToken)
+	labelSelector, err := service.ParseLabelSelector(req.Msg.LabelSelector)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		return nil, err
 	}
 
 	params := db.ListUserSitesParams{
 		AccountID:            accountID,
 		FilterOrganizationID: filterOrgID,
 		FilterProjectID:      filterProjectID,
-		Limit:                pageSize,
-		Offset:               int32(offset),
+		LabelSelector:        labelSelector,
+		CursorCreatedAt:      pagination.CreatedAt,
+		CursorID:             pagination.ID,
+		Limit:                pagination.Limit,
 	}
 
 	sites, err := s.repo.ListUserSites(ctx, params)
@@ -108,7 +108,7 @@ func (s *SiteService) ListSites(
 
 	protoSites := make([]*commonv1.SiteConfig, 0, len(sites))
 	for _, site := range sites {
-		protoSites = append(protoSites, &commonv1.SiteConfig{
+		protoSite := &commonv1.SiteConfig{
 			SiteId:         site.PublicID,
 			OrganizationId: site.OrganizationPublicID,
 			ProjectId:      site.ProjectPublicID,
@@ -121,12 +121,25 @@ func (s *SiteService) ListSites(
 			Os:             service.FromNullString(site.Os),
 			IsProduction:   site.IsProduction.Bool,
 			Status:         DbSiteStatusToProto(site.Status),
-		})
+			Labels:         service.FromJSONLabels(site.Labels),
+		}
+		// The list carries the overall score; GetSite has its components
+		if site.HealthScore.Valid {
+			protoSite.Health = &commonv1.SiteHealth{
+				Score:      site.HealthScore.Int32,
+				ComputedAt: site.HealthComputedAt.Time.Unix(),
+			}
+			if site.HealthDegradedSince.Valid {
+				protoSite.Health.DegradedSince = site.HealthDegradedSince.Time.Unix()
+			}
+		}
+		protoSites = append(protoSites, protoSite)
 	}
 
 	nextPageToken := ""
-	if len(sites) == int(pageSize) {
-		nextPageToken = service.GeneratePageToken(offset + int(pageSize))
+	if n := len(sites); n > 0 {
+		last := sites[n-1]
+		nextPageToken = service.MakeCursorPaginationResult(n, pagination, last.CreatedAt, last.ID).NextPageToken
 	}
 
 	return connect.NewResponse(&libopsv1.ListSitesResponse{
@@ -151,7 +164,7 @@ func (s *SiteService) GetSite(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id format: %w", err))
 	}
 
-	site, err := s.repo.GetSiteByPublicID(ctx, siteUUID)
+	site, err := s.repo.GetSiteByPublicID(service.ETagContext(ctx), siteUUID)
 	if err != nil {
 		slog.Error("Failed to get site by public ID", "error", err, "site_id", siteID)
 		return nil, err
@@ -182,11 +195,27 @@ func (s *SiteService) GetSite(
 		Os:             service.FromNullString(site.Os),
 		IsProduction:   site.IsProduction.Bool,
 		Status:         service.DbSiteStatusToProto(site.Status),
+		Labels:         service.FromJSONLabels(site.Labels),
+		Etag:           service.ETag(site.Version),
 	}
 
-	return connect.NewResponse(&libopsv1.GetSiteResponse{
+	protoSite.StaticEgressIp, err = s.repo.GetStaticEgressIp(ctx, site.ID)
+	if err != nil {
+		slog.Error("Failed to get static egress IP", "error", err, "site_id", siteID)
+		return nil, err
+	}
+
+	protoSite.Health, err = s.repo.GetHealth(ctx, site.ID)
+	if err != nil {
+		slog.Error("Failed to get site health", "error", err, "site_id", siteID)
+		return nil, err
+	}
+
+	resp := connect.NewResponse(&libopsv1.GetSiteResponse{
 		Site: protoSite,
-	}), nil
+	})
+	service.SetETag(resp.Header(), site.Version)
+	return resp, nil
 }
 
 // CreateSite creates a new site.
@@ -210,6 +239,9 @@ func (s *SiteService) CreateSite(
 	if err := validation.SiteName(site.SiteName); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
+	if err := validation.Labels(site.Labels); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
 	projectPublicID, err := uuid.Parse(projectID)
 	if err != nil {
@@ -229,6 +261,14 @@ func (s *SiteService) CreateSite(
 		return nil, err
 	}
 
+	siteCount, err := s.repo.db.CountProjectSites(ctx, project.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count project sites: %w", err))
+	}
+	if err := s.repo.quotas.Check(ctx, project.OrganizationID, quota.SitesPerProject, siteCount); err != nil {
+		return nil, err
+	}
+
 	// Set defaults for new fields - inherit from project if not specified
 	osImage := site.Os
 	if osImage == "" {
@@ -257,6 +297,7 @@ func (s *SiteService) CreateSite(
 		GcpExternalIp:    sql.NullString{Valid: false}, // Set by orchestration
 		GithubTeamID:     sql.NullString{Valid: false}, // Set by orchestration or admin
 		Status:           db.NullSitesStatus{SitesStatus: db.SitesStatusProvisioning, Valid: true},
+		Labels:           service.LabelsToJSON(site.Labels),
 		CreatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 		UpdatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 	}
@@ -295,10 +336,24 @@ func (s *SiteService) CreateSite(
 			Os:             service.FromNullString(createdSite.Os),
 			IsProduction:   createdSite.IsProduction.Bool,
 			Status:         service.DbSiteStatusToProto(createdSite.Status),
+			Labels:         service.FromJSONLabels(createdSite.Labels),
 		},
 	}), nil
 }
 
+// siteUpdatePaths are the fields UpdateSite can change.
+var siteUpdatePaths = []string{
+	"site.site_name",
+	"site.github_ref",
+	"site.up_cmd",
+	"site.init_cmd",
+	"site.rollout_cmd",
+	"site.overlay_volumes",
+	"site.os",
+	"site.is_production",
+	"site.labels",
+}
+
 // UpdateSite updates site configuration (organization-editable fields only).
 func (s *SiteService) UpdateSite(
 	ctx context.Context,
@@ -315,6 +370,11 @@ func (s *SiteService) UpdateSite(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("site is required"))
 	}
 
+	mask, err := service.NewUpdateMask(req.Msg.UpdateMask, siteUpdatePaths...)
+	if err != nil {
+		return nil, err
+	}
+
 	siteUUID, err := uuid.Parse(siteID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id format: %w", err))
@@ -332,6 +392,9 @@ func (s *SiteService) UpdateSite(
 		slog.Error("Failed to get site by public ID for update", "error", err, "site_id", siteID)
 		return nil, err
 	}
+	if err := service.CheckETag(req.Header(), site.Etag, existing.Version, "site"); err != nil {
+		return nil, err
+	}
 
 	name := existing.Name
 	githubRepository := existing.GithubRepository
@@ -347,31 +410,38 @@ func (s *SiteService) UpdateSite(
 	overlayVolumes := existing.OverlayVolumes
 	osImage := existing.Os
 	isProduction := existing.IsProduction
+	labels := existing.Labels
 
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.site_name") {
+	if mask.Has("site.site_name") {
 		name = site.SiteName
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.github_ref") {
+	if mask.Has("site.github_ref") {
 		githubRef = site.GithubRef
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.up_cmd") {
+	if mask.Has("site.up_cmd") {
 		upCmd = service.ToJSON(site.UpCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.init_cmd") {
+	if mask.Has("site.init_cmd") {
 		initCmd = service.ToJSON(site.InitCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.rollout_cmd") {
+	if mask.Has("site.rollout_cmd") {
 		rolloutCmd = service.ToJSON(site.RolloutCmd)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.overlay_volumes") {
+	if mask.Has("site.overlay_volumes") {
 		overlayVolumes = service.ToJSON(site.OverlayVolumes)
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.os") {
+	if mask.Has("site.os") {
 		osImage = sql.NullString{String: site.Os, Valid: true}
 	}
-	if service.ShouldUpdateField(req.Msg.UpdateMask, "site.is_production") {
+	if mask.Has("site.is_production") {
 		isProduction = sql.NullBool{Bool: site.IsProduction, Valid: true}
 	}
+	if mask.Has("site.labels") {
+		if err := validation.Labels(site.Labels); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labels = service.LabelsToJSON(site.Labels)
+	}
 
 	// Preserve all GCP fields
 	params := db.UpdateSiteParams{
@@ -391,8 +461,10 @@ func (s *SiteService) UpdateSite(
 		GcpExternalIp:    gcpExternalIp,
 		GithubTeamID:     existing.GithubTeamID,
 		Status:           existing.Status,
+		Labels:           labels,
 		UpdatedBy:        sql.NullInt64{Int64: accountID, Valid: true},
 		PublicID:         siteUUID.String(),
+		Version:          existing.Version,
 	}
 
 	err = s.repo.UpdateSite(ctx, params)
@@ -401,9 +473,12 @@ func (s *SiteService) UpdateSite(
 		return nil, err
 	}
 
-	return connect.NewResponse(&libopsv1.UpdateSiteResponse{
+	site.Etag = service.ETag(existing.Version + 1)
+	resp := connect.NewResponse(&libopsv1.UpdateSiteResponse{
 		Site: site,
-	}), nil
+	})
+	service.SetETag(resp.Header(), existing.Version+1)
+	return resp, nil
 }
 
 // DeleteSite deletes a site.
@@ -9,6 +9,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/libops/api/db"
 	"github.com/libops/api/internal/auth"
@@ -293,3 +294,111 @@ func TestCreateSite(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdateSiteConflict tests that an update against a stale etag, or one that
+// loses a race to another writer, fails its precondition.
+func TestUpdateSiteConflict(t *testing.T) {
+	siteID := uuid.New()
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{
+		AccountID: 1,
+		Email:     "test@example.com",
+	})
+
+	tests := []struct {
+		name    string
+		etag    string
+		updated int64
+		updates int
+		code    connect.Code
+	}{
+		{name: "Current etag", etag: "4", updated: 1, updates: 1},
+		{name: "Stale etag", etag: "3", updated: 1, code: connect.CodeFailedPrecondition},
+		{name: "Changed after read", etag: "4", updated: 0, updates: 1, code: connect.CodeFailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates []db.UpdateSiteParams
+			svc := NewSiteService(&testutils.MockQuerier{
+				GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+					return db.GetSiteRow{ID: 1, PublicID: publicID, Name: "test-site", Version: 4}, nil
+				},
+				UpdateSiteFunc: func(ctx context.Context, arg db.UpdateSiteParams) (int64, error) {
+					updates = append(updates, arg)
+					return tt.updated, nil
+				},
+			})
+
+			resp, err := svc.UpdateSite(ctx, connect.NewRequest(&libopsv1.UpdateSiteRequest{
+				SiteId: siteID.String(),
+				Site:   &commonv1.SiteConfig{SiteName: "renamed", Etag: tt.etag},
+			}))
+
+			assert.Len(t, updates, tt.updates)
+			if tt.code != 0 {
+				assert.Equal(t, tt.code, connect.CodeOf(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(4), updates[0].Version)
+			assert.Equal(t, "5", resp.Msg.Site.Etag)
+			assert.Equal(t, `"5"`, resp.Header().Get("ETag"))
+		})
+	}
+}
+
+// TestUpdateSiteMask tests that an update changes only the fields its mask
+// names, and that a mask naming a field UpdateSite can't change is rejected.
+func TestUpdateSiteMask(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{
+		AccountID: 1,
+		Email:     "test@example.com",
+	})
+
+	tests := []struct {
+		name     string
+		paths    []string
+		wantName string
+		code     connect.Code
+	}{
+		{name: "Empty mask", wantName: "renamed"},
+		{name: "Labels only", paths: []string{"site.labels"}, wantName: "test-site"},
+		{name: "Wildcard", paths: []string{"site.*"}, wantName: "renamed"},
+		{name: "Unknown field", paths: []string{"site.name"}, code: connect.CodeInvalidArgument},
+		{name: "Field UpdateSite can't change", paths: []string{"site.github_repository"}, code: connect.CodeInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates []db.UpdateSiteParams
+			svc := NewSiteService(&testutils.MockQuerier{
+				GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+					return db.GetSiteRow{ID: 1, PublicID: publicID, Name: "test-site", Version: 1}, nil
+				},
+				UpdateSiteFunc: func(ctx context.Context, arg db.UpdateSiteParams) (int64, error) {
+					updates = append(updates, arg)
+					return 1, nil
+				},
+			})
+
+			var mask *fieldmaskpb.FieldMask
+			if tt.paths != nil {
+				mask = &fieldmaskpb.FieldMask{Paths: tt.paths}
+			}
+			_, err := svc.UpdateSite(ctx, connect.NewRequest(&libopsv1.UpdateSiteRequest{
+				SiteId:     uuid.NewString(),
+				Site:       &commonv1.SiteConfig{SiteName: "renamed", Labels: map[string]string{"env": "prod"}},
+				UpdateMask: mask,
+			}))
+
+			if tt.code != 0 {
+				assert.Equal(t, tt.code, connect.CodeOf(err))
+				assert.Empty(t, updates)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, updates[0].Name)
+			assert.Contains(t, string(updates[0].Labels), "prod")
+		})
+	}
+}
@@ -11,7 +11,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/db/types"
 	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/gcp"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/service/organization"
 	libopsv1 "github.com/libops/api/proto/libops/v1"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
 	"github.com/libops/api/proto/libops/v1/libopsv1connect"
@@ -19,16 +24,18 @@ import (
 
 // SiteSettingService implements the SiteSettingService API.
 type SiteSettingService struct {
-	db db.Querier
+	db      db.Querier
+	emitter *events.Emitter
 }
 
 // Compile-time check to ensure SiteSettingService implements the interface.
 var _ libopsv1connect.SiteSettingServiceHandler = (*SiteSettingService)(nil)
 
 // NewSiteSettingService creates a new SiteSettingService instance.
-func NewSiteSettingService(querier db.Querier) *SiteSettingService {
+func NewSiteSettingService(querier db.Querier, emitter *events.Emitter) *SiteSettingService {
 	return &SiteSettingService{
-		db: querier,
+		db:      querier,
+		emitter: emitter,
 	}
 }
 
@@ -43,8 +50,14 @@ func (s *SiteSettingService) CreateSiteSetting(
 	editable := req.Msg.Editable
 	description := req.Msg.Description
 
-	if key == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("setting key is required"))
+	if err := organization.ValidateSettingKey(key); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := organization.ValidateSettingType(req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := organization.ValidateSettingValue(key, value, req.Msg.ValueType, req.Msg.AllowedValues); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	userInfo, ok := auth.GetUserFromContext(ctx)
@@ -52,6 +65,12 @@ func (s *SiteSettingService) CreateSiteSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
+	// Settings users can't edit are set by libops, so a user can't lock
+	// themselves out of one
+	if !editable && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only libops can create settings that can't be edited"))
+	}
+
 	// Parse site UUID
 	siteUUID, err := uuid.Parse(siteID)
 	if err != nil {
@@ -67,18 +86,36 @@ func (s *SiteSettingService) CreateSiteSetting(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get site: %w", err))
 	}
 
+	_, err = s.db.GetSiteSetting(ctx, db.GetSiteSettingParams{
+		SiteID:     site.ID,
+		SettingKey: key,
+	})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("setting %s already exists", key))
+	}
+	if err != sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+
+	var allowedValues types.RawJSON
+	if req.Msg.ValueType == libopsv1.SettingValueType_SETTING_VALUE_TYPE_ENUM {
+		allowedValues = service.ToJSON(req.Msg.AllowedValues)
+	}
+
 	// Create setting
 	settingPublicID := uuid.New().String()
 	err = s.db.CreateSiteSetting(ctx, db.CreateSiteSettingParams{
-		PublicID:     settingPublicID,
-		SiteID:       site.ID,
-		SettingKey:   key,
-		SettingValue: value,
-		Editable:     sql.NullBool{Bool: editable, Valid: true},
-		Description:  sql.NullString{String: description, Valid: description != ""},
-		Status:       db.NullSiteSettingsStatus{SiteSettingsStatus: db.SiteSettingsStatusActive, Valid: true},
-		CreatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
-		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		PublicID:      settingPublicID,
+		SiteID:        site.ID,
+		SettingKey:    key,
+		SettingValue:  value,
+		ValueType:     db.SiteSettingsValueType(organization.SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues: allowedValues,
+		Editable:      sql.NullBool{Bool: editable, Valid: true},
+		Description:   sql.NullString{String: description, Valid: description != ""},
+		Status:        db.NullSiteSettingsStatus{SiteSettingsStatus: db.SiteSettingsStatusActive, Valid: true},
+		CreatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
 	if err != nil {
 		slog.Error("Failed to create site setting", "error", err, "site_id", siteID, "key", key)
@@ -87,14 +124,18 @@ func (s *SiteSettingService) CreateSiteSetting(
 
 	// Return created setting
 	setting := &libopsv1.SiteSetting{
-		SettingId:   settingPublicID,
-		SiteId:      siteID,
-		Key:         key,
-		Value:       value,
-		Editable:    editable,
-		Description: description,
-		Status:      commonv1.Status_STATUS_ACTIVE,
+		SettingId:     settingPublicID,
+		SiteId:        siteID,
+		Key:           key,
+		Value:         value,
+		Editable:      editable,
+		Description:   description,
+		Status:        commonv1.Status_STATUS_ACTIVE,
+		ValueType:     organization.SettingValueTypeFromDB(organization.SettingValueTypeToDB(req.Msg.ValueType)),
+		AllowedValues: req.Msg.AllowedValues,
+		Environment:   organization.IsEnvironmentSetting(key),
 	}
+	s.reconcile(ctx, events.EventTypeSiteSettingCreated, setting)
 
 	return connect.NewResponse(&libopsv1.CreateSiteSettingResponse{
 		Setting: setting,
@@ -106,36 +147,13 @@ func (s *SiteSettingService) GetSiteSetting(
 	ctx context.Context,
 	req *connect.Request[libopsv1.GetSiteSettingRequest],
 ) (*connect.Response[libopsv1.GetSiteSettingResponse], error) {
-	settingID := req.Msg.SettingId
-
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.SiteId, req.Msg.SettingId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
-	// Get setting
-	dbSetting, err := s.db.GetSiteSettingByPublicID(ctx, settingUUID.String())
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
-	}
-
-	// Convert to proto
-	setting := &libopsv1.SiteSetting{
-		SettingId:   dbSetting.PublicID,
-		SiteId:      fmt.Sprintf("%d", dbSetting.SiteID),
-		Key:         dbSetting.SettingKey,
-		Value:       dbSetting.SettingValue,
-		Editable:    dbSetting.Editable.Bool,
-		Description: dbSetting.Description.String,
-		Status:      convertSiteSettingStatus(dbSetting.Status.SiteSettingsStatus),
+		return nil, err
 	}
 
 	return connect.NewResponse(&libopsv1.GetSiteSettingResponse{
-		Setting: setting,
+		Setting: siteSettingToProto(req.Msg.SiteId, dbSetting),
 	}), nil
 }
 
@@ -179,15 +197,7 @@ func (s *SiteSettingService) ListSiteSettings(
 	// Convert to proto
 	settings := make([]*libopsv1.SiteSetting, 0, len(dbSettings))
 	for _, dbSetting := range dbSettings {
-		settings = append(settings, &libopsv1.SiteSetting{
-			SettingId:   dbSetting.PublicID,
-			SiteId:      siteID,
-			Key:         dbSetting.SettingKey,
-			Value:       dbSetting.SettingValue,
-			Editable:    dbSetting.Editable.Bool,
-			Description: dbSetting.Description.String,
-			Status:      convertSiteSettingStatus(dbSetting.Status.SiteSettingsStatus),
-		})
+		settings = append(settings, siteSettingToProto(siteID, db.GetSiteSettingByPublicIDRow(dbSetting)))
 	}
 
 	return connect.NewResponse(&libopsv1.ListSiteSettingsResponse{
@@ -213,29 +223,25 @@ func (s *SiteSettingService) UpdateSiteSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
-	}
-
 	// Get existing setting
-	dbSetting, err := s.db.GetSiteSettingByPublicID(ctx, settingUUID.String())
+	dbSetting, err := s.setting(ctx, req.Msg.SiteId, settingID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+		return nil, err
 	}
 
 	// Check if setting is editable
-	if !dbSetting.Editable.Bool {
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be modified"))
 	}
 
+	valueType := organization.SettingValueTypeFromDB(string(dbSetting.ValueType))
+	if err := organization.ValidateSettingValue(dbSetting.SettingKey, *value, valueType, service.FromJSONStringArray(dbSetting.AllowedValues)); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// Update setting
 	err = s.db.UpdateSiteSetting(ctx, db.UpdateSiteSettingParams{
-		PublicID:     settingUUID.String(),
+		PublicID:     dbSetting.PublicID,
 		SettingValue: *value,
 		UpdatedBy:    sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
@@ -245,14 +251,11 @@ func (s *SiteSettingService) UpdateSiteSetting(
 	}
 
 	// Return updated setting
-	setting := &libopsv1.SiteSetting{
-		SettingId:   settingID,
-		SiteId:      req.Msg.SiteId,
-		Key:         dbSetting.SettingKey,
-		Value:       *value,
-		Editable:    dbSetting.Editable.Bool,
-		Description: dbSetting.Description.String,
-		Status:      convertSiteSettingStatus(dbSetting.Status.SiteSettingsStatus),
+	changed := dbSetting.SettingValue != *value
+	dbSetting.SettingValue = *value
+	setting := siteSettingToProto(req.Msg.SiteId, dbSetting)
+	if changed {
+		s.reconcile(ctx, events.EventTypeSiteSettingUpdated, setting)
 	}
 
 	return connect.NewResponse(&libopsv1.UpdateSiteSettingResponse{
@@ -260,7 +263,7 @@ func (s *SiteSettingService) UpdateSiteSetting(
 	}), nil
 }
 
-// DeleteSiteSetting deletes a site setting.
+// DeleteSiteSetting deletes an site setting.
 func (s *SiteSettingService) DeleteSiteSetting(
 	ctx context.Context,
 	req *connect.Request[libopsv1.DeleteSiteSettingRequest],
@@ -272,25 +275,85 @@ func (s *SiteSettingService) DeleteSiteSetting(
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	// Parse setting UUID
-	settingUUID, err := uuid.Parse(settingID)
+	dbSetting, err := s.setting(ctx, req.Msg.SiteId, settingID)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+		return nil, err
+	}
+	if !dbSetting.Editable.Bool && !gcp.IsPlatformServiceAccount(userInfo.Email) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("this setting cannot be deleted"))
 	}
 
 	// Delete setting (soft delete)
 	err = s.db.DeleteSiteSetting(ctx, db.DeleteSiteSettingParams{
-		PublicID:  settingUUID.String(),
+		PublicID:  dbSetting.PublicID,
 		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
 	})
 	if err != nil {
 		slog.Error("Failed to delete site setting", "error", err, "setting_id", settingID)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete setting: %w", err))
 	}
+	s.reconcile(ctx, events.EventTypeSiteSettingDeleted, siteSettingToProto(req.Msg.SiteId, dbSetting))
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// setting gets one of a site's settings. Settings of other
+// sites are reported as not found.
+func (s *SiteSettingService) setting(ctx context.Context, siteID, settingID string) (db.GetSiteSettingByPublicIDRow, error) {
+	siteUUID, err := uuid.Parse(siteID)
+	if err != nil {
+		return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id: %w", err))
+	}
+	settingUUID, err := uuid.Parse(settingID)
+	if err != nil {
+		return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid setting_id: %w", err))
+	}
+
+	site, err := s.db.GetSite(ctx, siteUUID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("site not found"))
+		}
+		return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get site: %w", err))
+	}
+
+	dbSetting, err := s.db.GetSiteSettingByPublicID(ctx, settingUUID.String())
+	if err == sql.ErrNoRows || (err == nil && dbSetting.SiteID != site.ID) {
+		return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
+	}
+	if err != nil {
+		return db.GetSiteSettingByPublicIDRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get setting: %w", err))
+	}
+	return dbSetting, nil
+}
+
+// reconcile queues the site when an environment setting, which its VM gets,
+// changes.
+func (s *SiteSettingService) reconcile(ctx context.Context, eventType string, setting *libopsv1.SiteSetting) {
+	if s.emitter == nil || !setting.Environment {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, setting.SettingId, nil, nil, &setting.SiteId, setting); err != nil {
+		slog.Error("Failed to emit site setting event", "error", err, "site_id", setting.SiteId, "event_type", eventType)
+	}
+}
+
+// siteSettingToProto converts a database setting to its proto.
+func siteSettingToProto(siteID string, dbSetting db.GetSiteSettingByPublicIDRow) *libopsv1.SiteSetting {
+	return &libopsv1.SiteSetting{
+		SettingId:     dbSetting.PublicID,
+		SiteId:        siteID,
+		Key:           dbSetting.SettingKey,
+		Value:         dbSetting.SettingValue,
+		Editable:      dbSetting.Editable.Bool,
+		Description:   dbSetting.Description.String,
+		Status:        convertSiteSettingStatus(dbSetting.Status.SiteSettingsStatus),
+		ValueType:     organization.SettingValueTypeFromDB(string(dbSetting.ValueType)),
+		AllowedValues: service.FromJSONStringArray(dbSetting.AllowedValues),
+		Environment:   organization.IsEnvironmentSetting(dbSetting.SettingKey),
+	}
+}
+
 // convertSiteSettingStatus converts database status to proto status.
 func convertSiteSettingStatus(status db.SiteSettingsStatus) commonv1.Status {
 	switch status {
@@ -5,24 +5,40 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/libops/api/db"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/quota"
 	"github.com/libops/api/internal/service"
 	commonv1 "github.com/libops/api/proto/libops/v1/common"
+	"google.golang.org/protobuf/proto"
 )
 
+// emitSiteEvent emits eventType for a change to one of a site's resources,
+// identified by subject. The event router turns it into the site's
+// reconciliation, which re-renders its proxy.
+func emitSiteEvent(ctx context.Context, emitter *events.Emitter, eventType, subject, siteID string, msg proto.Message) {
+	if emitter == nil {
+		return
+	}
+	if err := emitter.SendScopedProtoEvent(ctx, eventType, subject, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit site event", "error", err, "event_type", eventType, "site_id", siteID, "subject", subject)
+	}
+}
+
 // Repository encapsulates shared site business logic.
 type Repository struct {
-	db db.Querier
+	db     db.Querier
+	quotas *quota.Enforcer
 }
 
 // NewRepository creates a new site repository.
 func NewRepository(querier db.Querier) *Repository {
-	return &Repository{db: querier}
+	return &Repository{db: querier, quotas: quota.NewEnforcer(querier)}
 }
 
 // GetSiteByPublicID retrieves a site by public ID.
@@ -67,12 +83,15 @@ func (r *Repository) CreateSite(ctx context.Context, params db.CreateSiteParams)
 	return nil
 }
 
-// UpdateSite updates a site.
+// UpdateSite updates a site at the version in params.
 func (r *Repository) UpdateSite(ctx context.Context, params db.UpdateSiteParams) error {
-	err := r.db.UpdateSite(ctx, params)
+	updated, err := r.db.UpdateSite(ctx, params)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
 	}
+	if updated == 0 {
+		return service.ETagMismatchError("site")
+	}
 	return nil
 }
 
@@ -155,6 +174,85 @@ func (r *Repository) GetOrganizationByID(ctx context.Context, id int64) (db.GetO
 	return org, nil
 }
 
+// GetStaticEgressIp retrieves a site's static egress IP, or nil when it has none.
+func (r *Repository) GetStaticEgressIp(ctx context.Context, siteID int64) (*commonv1.StaticEgressIp, error) {
+	egress, err := r.db.GetSiteStaticEgressIp(ctx, siteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return service.StaticEgressIpToProto(egress), nil
+}
+
+// GetHealth retrieves a site's last computed health score, or nil before its first.
+func (r *Repository) GetHealth(ctx context.Context, siteID int64) (*commonv1.SiteHealth, error) {
+	health, err := r.db.GetSiteHealth(ctx, siteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return service.SiteHealthToProto(health), nil
+}
+
+// GetCdn retrieves a site's CDN, or nil when it has none.
+func (r *Repository) GetCdn(ctx context.Context, siteID int64) (*commonv1.SiteCdn, error) {
+	cdn, err := r.db.GetSiteCdnConfig(ctx, siteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	purges, err := r.db.ListPendingSiteCachePurges(ctx, siteID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return service.SiteCdnToProto(cdn, len(purges)), nil
+}
+
+// GetAccessProtection retrieves a site's access protection. Sites that haven't
+// set one are members only unless they're production.
+func (r *Repository) GetAccessProtection(ctx context.Context, site db.GetSiteRow) (accessProtection, error) {
+	row, err := r.db.GetSiteAccessProtection(ctx, site.ID)
+	if err == nil {
+		return accessProtection{GetSiteAccessProtectionRow: row}, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return accessProtection{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	mode := db.SiteAccessProtectionsModeMembersOnly
+	if site.IsProduction.Bool {
+		mode = db.SiteAccessProtectionsModeNone
+	}
+	return accessProtection{
+		GetSiteAccessProtectionRow: db.GetSiteAccessProtectionRow{SiteID: site.ID, Mode: mode},
+		isDefault:                  true,
+	}, nil
+}
+
+// GetWaf retrieves a site's WAF config and rule exclusions. Sites that haven't
+// set one have the WAF off, set to block at paranoia level 1 once enabled.
+func (r *Repository) GetWaf(ctx context.Context, site db.GetSiteRow) (siteWaf, error) {
+	config, err := r.db.GetSiteWafConfig(ctx, site.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		config = db.GetSiteWafConfigRow{SiteID: site.ID, Mode: db.SiteWafConfigsModeBlocking, ParanoiaLevel: 1}
+	} else if err != nil {
+		return siteWaf{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	exclusions, err := r.db.ListSiteWafRuleExclusions(ctx, site.ID)
+	if err != nil {
+		return siteWaf{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	return siteWaf{GetSiteWafConfigRow: config, exclusions: exclusions}, nil
+}
+
 // Helper functions
 
 // FromNullStringPtr converts a sql.NullString to an optional pointer to a string, returning nil if not valid.
@@ -165,20 +263,6 @@ func FromNullStringPtr(ns sql.NullString) *string {
 	return nil
 }
 
-// ShouldUpdateField checks if a field should be updated based on the field mask.
-// If the field mask is nil or empty, all fields should be updated; otherwise, only fields present in the mask should be updated.
-func ShouldUpdateField(mask *fieldmaskpb.FieldMask, field string) bool {
-	if mask == nil {
-		return true
-	}
-	for _, path := range mask.Paths {
-		if path == field {
-			return true
-		}
-	}
-	return false
-}
-
 // Status conversion helpers.
 func DbSiteStatusToProto(status db.NullSitesStatus) commonv1.Status {
 	return service.DbSiteStatusToProto(status)
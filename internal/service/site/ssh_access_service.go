@@ -0,0 +1,272 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// maxSshAccessGrants caps how many grants one listing returns, far more than
+// a site has members.
+const maxSshAccessGrants = 1000
+
+// SshAccessService implements the SshAccessService API.
+type SshAccessService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure SshAccessService implements the interface.
+var _ libopsv1connect.SshAccessServiceHandler = (*SshAccessService)(nil)
+
+// NewSshAccessService creates a new SshAccessService instance.
+func NewSshAccessService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *SshAccessService {
+	return &SshAccessService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// ListSshAccess lists a site's SSH access grants.
+func (s *SshAccessService) ListSshAccess(
+	ctx context.Context,
+	req *connect.Request[libopsv1.ListSshAccessRequest],
+) (*connect.Response[libopsv1.ListSshAccessResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.ListSiteSshAccess(ctx, db.ListSiteSshAccessParams{
+		SiteID: site.ID,
+		Limit:  maxSshAccessGrants,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.ListSshAccessResponse{}
+	for _, row := range rows {
+		grant := &libopsv1.SshAccess{
+			AccountId: row.AccountPublicID,
+			SiteId:    site.PublicID,
+			Email:     row.Email,
+			Name:      service.FromNullString(row.Name),
+			SftpOnly:  row.SftpOnly,
+		}
+		if row.CreatedAt.Valid {
+			grant.CreatedAt = row.CreatedAt.Time.Unix()
+		}
+		resp.SshAccess = append(resp.SshAccess, grant)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GrantSshAccess gives a site member SSH access to the site. Owners and
+// developers already have a shell through their role.
+func (s *SshAccessService) GrantSshAccess(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GrantSshAccessRequest],
+) (*connect.Response[libopsv1.GrantSshAccessResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, account, err := s.siteAccount(ctx, req.Msg.SiteId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.db.GetSiteMemberByAccountAndSite(ctx, db.GetSiteMemberByAccountAndSiteParams{AccountID: account.ID, SiteID: site.ID})
+	if err == sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("only members of the site can be granted SSH access"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if member.Role != db.SiteMembersRoleRead {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%ss already have SSH access to the site", member.Role))
+	}
+
+	_, err = s.db.GetSshAccess(ctx, db.GetSshAccessParams{AccountID: account.ID, SiteID: site.ID})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("the account already has SSH access to the site"))
+	}
+	if err != sql.ErrNoRows {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	err = s.db.CreateSshAccess(ctx, db.CreateSshAccessParams{
+		AccountID: account.ID,
+		SiteID:    site.ID,
+		SftpOnly:  req.Msg.SftpOnly,
+		CreatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "SSH access")
+	}
+	grant, err := s.grant(ctx, site, account)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSshAccessGrant, map[string]any{
+		"account_id": account.PublicID,
+		"sftp_only":  grant.SftpOnly,
+	})
+
+	resp := &libopsv1.GrantSshAccessResponse{SshAccess: grant}
+	s.reconcile(ctx, events.EventTypeSiteSshAccessGranted, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// UpdateSshAccess changes whether a grant is SFTP only.
+func (s *SshAccessService) UpdateSshAccess(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateSshAccessRequest],
+) (*connect.Response[libopsv1.UpdateSshAccessResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, account, err := s.siteAccount(ctx, req.Msg.SiteId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.db.GetSshAccess(ctx, db.GetSshAccessParams{AccountID: account.ID, SiteID: site.ID}); err != nil {
+		return nil, service.HandleDatabaseError(err, "SSH access")
+	}
+
+	err = s.db.UpdateSshAccessSftpOnly(ctx, db.UpdateSshAccessSftpOnlyParams{
+		SftpOnly:  req.Msg.SftpOnly,
+		UpdatedBy: sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+		AccountID: account.ID,
+		SiteID:    site.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	grant, err := s.grant(ctx, site, account)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSshAccessUpdate, map[string]any{
+		"account_id": account.PublicID,
+		"sftp_only":  grant.SftpOnly,
+	})
+
+	resp := &libopsv1.UpdateSshAccessResponse{SshAccess: grant}
+	s.reconcile(ctx, events.EventTypeSiteSshAccessUpdated, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeSshAccess revokes a grant. The site's controller removes the
+// account's login from the VM.
+func (s *SshAccessService) RevokeSshAccess(
+	ctx context.Context,
+	req *connect.Request[libopsv1.RevokeSshAccessRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, account, err := s.siteAccount(ctx, req.Msg.SiteId, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.db.GetSshAccess(ctx, db.GetSshAccessParams{AccountID: account.ID, SiteID: site.ID}); err != nil {
+		return nil, service.HandleDatabaseError(err, "SSH access")
+	}
+
+	if err := s.db.DeleteSshAccess(ctx, db.DeleteSshAccessParams{AccountID: account.ID, SiteID: site.ID}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteSshAccessRevoke, map[string]any{
+		"account_id": account.PublicID,
+	})
+
+	s.reconcile(ctx, events.EventTypeSiteSshAccessRevoked, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// site looks up the site a request targets.
+func (s *SshAccessService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// siteAccount looks up the site and account a request targets.
+func (s *SshAccessService) siteAccount(ctx context.Context, siteID, accountID string) (db.GetSiteRow, db.GetAccountRow, error) {
+	if err := validation.UUID(accountID); err != nil {
+		return db.GetSiteRow{}, db.GetAccountRow{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id: %w", err))
+	}
+	site, err := s.site(ctx, siteID)
+	if err != nil {
+		return db.GetSiteRow{}, db.GetAccountRow{}, err
+	}
+	account, err := s.db.GetAccount(ctx, accountID)
+	if err != nil {
+		return db.GetSiteRow{}, db.GetAccountRow{}, service.HandleDatabaseError(err, "account")
+	}
+	return site, account, nil
+}
+
+// grant reads back an account's grant on a site.
+func (s *SshAccessService) grant(ctx context.Context, site db.GetSiteRow, account db.GetAccountRow) (*libopsv1.SshAccess, error) {
+	row, err := s.db.GetSshAccess(ctx, db.GetSshAccessParams{AccountID: account.ID, SiteID: site.ID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	grant := &libopsv1.SshAccess{
+		AccountId: account.PublicID,
+		SiteId:    site.PublicID,
+		Email:     account.Email,
+		Name:      service.FromNullString(account.Name),
+		SftpOnly:  row.SftpOnly,
+	}
+	if row.CreatedAt.Valid {
+		grant.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return grant, nil
+}
+
+// reconcile queues the site's SSH key reconciliation, in which its
+// controller sets up, changes or removes the account's login.
+func (s *SshAccessService) reconcile(ctx context.Context, eventType, siteID string, msg proto.Message) {
+	if s.emitter == nil {
+		return
+	}
+	if err := s.emitter.SendScopedProtoEvent(ctx, eventType, siteID, nil, nil, &siteID, msg); err != nil {
+		slog.Error("Failed to emit SSH access event", "error", err, "site_id", siteID)
+	}
+}
@@ -0,0 +1,153 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSshAccess tests that only read members of a site can be granted SSH
+// access, that grants can be switched to and from SFTP only, and that the
+// controller gets granted keys alongside those of members with a shell.
+func TestSshAccess(t *testing.T) {
+	siteID := uuid.NewString()
+	editor := uuid.NewString()
+	developer := uuid.NewString()
+	outsider := uuid.NewString()
+	accounts := map[string]int64{editor: 10, developer: 11, outsider: 12}
+	roles := map[int64]db.SiteMembersRole{10: db.SiteMembersRoleRead, 11: db.SiteMembersRoleDeveloper}
+	grants := map[int64]db.GetSshAccessRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetAccountFunc: func(ctx context.Context, publicID string) (db.GetAccountRow, error) {
+			id, ok := accounts[publicID]
+			if !ok {
+				return db.GetAccountRow{}, sql.ErrNoRows
+			}
+			return db.GetAccountRow{ID: id, PublicID: publicID, Email: publicID + "@example.com"}, nil
+		},
+		GetSiteMemberByAccountAndSiteFunc: func(ctx context.Context, arg db.GetSiteMemberByAccountAndSiteParams) (db.SiteMember, error) {
+			role, ok := roles[arg.AccountID]
+			if !ok {
+				return db.SiteMember{}, sql.ErrNoRows
+			}
+			return db.SiteMember{AccountID: arg.AccountID, SiteID: arg.SiteID, Role: role}, nil
+		},
+		GetSshAccessFunc: func(ctx context.Context, arg db.GetSshAccessParams) (db.GetSshAccessRow, error) {
+			grant, ok := grants[arg.AccountID]
+			if !ok {
+				return db.GetSshAccessRow{}, sql.ErrNoRows
+			}
+			return grant, nil
+		},
+		CreateSshAccessFunc: func(ctx context.Context, arg db.CreateSshAccessParams) error {
+			grants[arg.AccountID] = db.GetSshAccessRow{
+				AccountID: arg.AccountID,
+				SiteID:    arg.SiteID,
+				SftpOnly:  arg.SftpOnly,
+				CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			}
+			return nil
+		},
+		UpdateSshAccessSftpOnlyFunc: func(ctx context.Context, arg db.UpdateSshAccessSftpOnlyParams) error {
+			grant := grants[arg.AccountID]
+			grant.SftpOnly = arg.SftpOnly
+			grants[arg.AccountID] = grant
+			return nil
+		},
+		DeleteSshAccessFunc: func(ctx context.Context, arg db.DeleteSshAccessParams) error {
+			delete(grants, arg.AccountID)
+			return nil
+		},
+		GetSiteSSHKeysForVMFunc: func(ctx context.Context, arg db.GetSiteSSHKeysForVMParams) ([]db.GetSiteSSHKeysForVMRow, error) {
+			return []db.GetSiteSSHKeysForVMRow{{PublicKey: "ssh-ed25519 AAAA developer", AccountPublicID: developer}}, nil
+		},
+		GetSiteSshAccessKeysForVMFunc: func(ctx context.Context, id int64) ([]db.GetSiteSshAccessKeysForVMRow, error) {
+			var rows []db.GetSiteSshAccessKeysForVMRow
+			if grant, ok := grants[10]; ok {
+				rows = append(rows, db.GetSiteSshAccessKeysForVMRow{PublicKey: "ssh-ed25519 AAAA editor", AccountPublicID: editor, SftpOnly: grant.SftpOnly})
+			}
+			return rows, nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewSshAccessService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	admin := NewAdminSiteService(mock)
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	_, err := svc.GrantSshAccess(ctx, connect.NewRequest(&libopsv1.GrantSshAccessRequest{SiteId: siteID, AccountId: "editor"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), "account ID isn't a UUID")
+	_, err = svc.GrantSshAccess(ctx, connect.NewRequest(&libopsv1.GrantSshAccessRequest{SiteId: siteID, AccountId: outsider}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "not a member of the site")
+	_, err = svc.GrantSshAccess(ctx, connect.NewRequest(&libopsv1.GrantSshAccessRequest{SiteId: siteID, AccountId: developer}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "developers already have a shell")
+
+	granted, err := svc.GrantSshAccess(ctx, connect.NewRequest(&libopsv1.GrantSshAccessRequest{SiteId: siteID, AccountId: editor, SftpOnly: true}))
+	require.NoError(t, err)
+	assert.Equal(t, editor, granted.Msg.SshAccess.AccountId)
+	assert.True(t, granted.Msg.SshAccess.SftpOnly)
+	assert.Greater(t, granted.Msg.SshAccess.CreatedAt, int64(0))
+
+	_, err = svc.GrantSshAccess(ctx, connect.NewRequest(&libopsv1.GrantSshAccessRequest{SiteId: siteID, AccountId: editor}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	keys, err := admin.GetSiteSSHKeys(ctx, connect.NewRequest(&libopsv1.GetSiteSSHKeysRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, keys.Msg.Keys, 2)
+	assert.Equal(t, developer, keys.Msg.Keys[0].AccountId)
+	assert.False(t, keys.Msg.Keys[0].SftpOnly)
+	assert.Equal(t, editor, keys.Msg.Keys[1].AccountId)
+	assert.True(t, keys.Msg.Keys[1].SftpOnly)
+
+	updated, err := svc.UpdateSshAccess(ctx, connect.NewRequest(&libopsv1.UpdateSshAccessRequest{SiteId: siteID, AccountId: editor}))
+	require.NoError(t, err)
+	assert.False(t, updated.Msg.SshAccess.SftpOnly)
+
+	_, err = svc.UpdateSshAccess(ctx, connect.NewRequest(&libopsv1.UpdateSshAccessRequest{SiteId: siteID, AccountId: developer, SftpOnly: true}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "developers have no grant to update")
+
+	_, err = svc.RevokeSshAccess(ctx, connect.NewRequest(&libopsv1.RevokeSshAccessRequest{SiteId: siteID, AccountId: editor}))
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+
+	_, err = svc.RevokeSshAccess(ctx, connect.NewRequest(&libopsv1.RevokeSshAccessRequest{SiteId: siteID, AccountId: editor}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	keys, err = admin.GetSiteSSHKeys(ctx, connect.NewRequest(&libopsv1.GetSiteSSHKeysRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.Len(t, keys.Msg.Keys, 1, "revoked keys leave the VM")
+
+	require.Len(t, queued, 3)
+	assert.Equal(t, events.EventTypeSiteSshAccessGranted, queued[0].EventType)
+	assert.Equal(t, events.EventTypeSiteSshAccessUpdated, queued[1].EventType)
+	assert.Equal(t, events.EventTypeSiteSshAccessRevoked, queued[2].EventType)
+	assert.Equal(t, []string{
+		string(audit.SiteSshAccessGrant),
+		string(audit.SiteSshAccessUpdate),
+		string(audit.SiteSshAccessRevoke),
+	}, audited)
+}
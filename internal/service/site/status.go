@@ -0,0 +1,264 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/export"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+const (
+	// controllerStaleAfter is how long after its last check-in a controller counts as gone
+	controllerStaleAfter = 10 * time.Minute
+	// certificateExpiryWarning is how close to expiry a certificate needs attention
+	certificateExpiryWarning = 14 * 24 * time.Hour
+	// backupStaleAfter is how old the newest backup can get before it needs attention
+	backupStaleAfter = 48 * time.Hour
+	// maxStatusDomains caps the domains resolved for a status
+	maxStatusDomains = 10
+	// dnsLookupTimeout bounds resolving a site's domains
+	dnsLookupTimeout = 3 * time.Second
+)
+
+// BackupFinder finds a site's newest backup. *export.BucketBackups is one.
+type BackupFinder interface {
+	Latest(ctx context.Context, sitePublicID string) (*export.Object, error)
+}
+
+// componentStatus builds a component's status from what it was observed at
+func componentStatus(component string, state libopsv1.SiteComponentState, observedAt time.Time, format string, args ...any) *libopsv1.SiteComponentStatus {
+	status := &libopsv1.SiteComponentStatus{
+		Component: component,
+		State:     state,
+		Message:   fmt.Sprintf(format, args...),
+	}
+	if !observedAt.IsZero() {
+		status.ObservedAt = observedAt.Unix()
+	}
+	return status
+}
+
+// siteComponents reports the state of each part of a site
+func (s *SiteOperationsService) siteComponents(ctx context.Context, site db.GetSiteRow, cdn *commonv1.SiteCdn) ([]*libopsv1.SiteComponentStatus, error) {
+	now := s.now()
+
+	checkIn, err := s.db.GetSiteCheckIn(ctx, site.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get site check-in: %w", err)
+	}
+	tls, err := s.db.GetSiteTlsPolicy(ctx, site.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get TLS policy: %w", err)
+	}
+	domains, err := s.db.ListSiteDomains(ctx, db.ListSiteDomainsParams{SiteID: site.ID, Limit: maxStatusDomains})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains: %w", err)
+	}
+	deployment, err := s.db.GetLatestSiteDeployment(ctx, site.PublicID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get latest deployment: %w", err)
+	}
+
+	// Domains point at the CDN once it has an address, and at the VM otherwise
+	target := site.GcpExternalIp.String
+	if cdn != nil && cdn.IpAddress != "" {
+		target = cdn.IpAddress
+	}
+
+	return []*libopsv1.SiteComponentStatus{
+		vmStatus(site),
+		controllerStatus(checkIn, now),
+		containersStatus(checkIn),
+		tlsStatus(tls, now),
+		s.dnsStatus(ctx, domains, target, now),
+		deploymentStatus(deployment),
+		s.backupStatus(ctx, site.PublicID, now),
+	}, nil
+}
+
+func vmStatus(site db.GetSiteRow) *libopsv1.SiteComponentStatus {
+	updatedAt := site.UpdatedAt.Time
+	switch site.Status.SitesStatus {
+	case db.SitesStatusActive:
+		if site.GcpExternalIp.String == "" {
+			return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, updatedAt, "Running")
+		}
+		return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, updatedAt, "Running at %s", site.GcpExternalIp.String)
+	case db.SitesStatusProvisioning:
+		return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, updatedAt, "Being provisioned")
+	case db.SitesStatusSuspended:
+		return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, updatedAt, "Suspended")
+	case db.SitesStatusFailed:
+		return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, updatedAt, "Provisioning failed")
+	default:
+		return componentStatus("vm", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, updatedAt, "No VM status")
+	}
+}
+
+func controllerStatus(checkIn db.GetSiteCheckInRow, now time.Time) *libopsv1.SiteComponentStatus {
+	if !checkIn.CheckinAt.Valid {
+		return componentStatus("controller", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Has never checked in")
+	}
+	version := ""
+	if checkIn.ControllerVersion.String != "" {
+		version = " running " + checkIn.ControllerVersion.String
+	}
+	age := now.Sub(checkIn.CheckinAt.Time)
+	if age > controllerStaleAfter {
+		return componentStatus("controller", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, checkIn.CheckinAt.Time, "No check-in for %s%s", humanDuration(age), version)
+	}
+	return componentStatus("controller", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, checkIn.CheckinAt.Time, "Checked in %s ago%s", humanDuration(age), version)
+}
+
+func containersStatus(checkIn db.GetSiteCheckInRow) *libopsv1.SiteComponentStatus {
+	// Counts come with check-ins, so they're as old as the last one
+	if !checkIn.ContainersRunning.Valid {
+		return componentStatus("containers", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Not reported by the controller")
+	}
+	observedAt := checkIn.CheckinAt.Time
+	running, unhealthy := checkIn.ContainersRunning.Int32, checkIn.ContainersUnhealthy.Int32
+	switch {
+	case running == 0:
+		return componentStatus("containers", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, observedAt, "No containers running")
+	case unhealthy > 0:
+		return componentStatus("containers", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, observedAt, "%d of %d running containers failing their health check", unhealthy, running)
+	default:
+		return componentStatus("containers", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, observedAt, "%d containers running, none unhealthy", running)
+	}
+}
+
+// tlsStatus judges a site's certificate by its expiry and last TLS probe. tls
+// is empty when the site's policy was never set or probed.
+func tlsStatus(tls db.GetSiteTlsPolicyRow, now time.Time) *libopsv1.SiteComponentStatus {
+	if tls.ProbeStatus == db.SiteTlsPoliciesProbeStatusFailed {
+		return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, tls.ProbedAt.Time, "TLS probe failed: %s", tls.ProbeMessage)
+	}
+	if tls.CertificateNotAfter.Valid {
+		// A custom certificate's expiry is known from when it was uploaded
+		updatedAt := tls.UpdatedAt.Time
+		expiry := tls.CertificateNotAfter.Time.UTC().Format(time.DateOnly)
+		switch left := tls.CertificateNotAfter.Time.Sub(now); {
+		case left <= 0:
+			return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, updatedAt, "Certificate expired on %s", expiry)
+		case left < certificateExpiryWarning:
+			return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, updatedAt, "Certificate expires in %s, on %s", humanDuration(left), expiry)
+		default:
+			return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, updatedAt, "Certificate valid until %s", expiry)
+		}
+	}
+	if tls.ProbeStatus == db.SiteTlsPoliciesProbeStatusPassed {
+		return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, tls.ProbedAt.Time, "Managed certificate passed the last TLS probe")
+	}
+	return componentStatus("tls", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Not probed yet")
+}
+
+// dnsStatus resolves a site's domains and checks they point at target
+func (s *SiteOperationsService) dnsStatus(ctx context.Context, domains []db.Domain, target string, now time.Time) *libopsv1.SiteComponentStatus {
+	if len(domains) == 0 {
+		return componentStatus("dns", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "No domains")
+	}
+	if target == "" {
+		return componentStatus("dns", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "No address to point domains at yet")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dnsLookupTimeout)
+	defer cancel()
+	var failures, misdirected []string
+	for _, domain := range domains {
+		addrs, err := s.resolve(ctx, domain.Domain)
+		switch {
+		case err != nil:
+			failures = append(failures, domain.Domain)
+		case !slices.Contains(addrs, target):
+			misdirected = append(misdirected, fmt.Sprintf("%s (%s)", domain.Domain, strings.Join(addrs, ", ")))
+		}
+	}
+
+	switch {
+	case len(failures) > 0:
+		return componentStatus("dns", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, now, "Not resolving: %s", strings.Join(failures, ", "))
+	case len(misdirected) > 0:
+		return componentStatus("dns", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, now, "Not pointing at %s: %s", target, strings.Join(misdirected, ", "))
+	default:
+		return componentStatus("dns", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, now, "%d domains point at %s", len(domains), target)
+	}
+}
+
+// deploymentStatus reports a site's latest deployment, which is empty when it
+// has none
+func deploymentStatus(deployment db.Deployment) *libopsv1.SiteComponentStatus {
+	if deployment.ID == "" {
+		return componentStatus("deployment", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Never deployed")
+	}
+	ref := deployment.GitRef.String
+	if deployment.CommitSha.String != "" {
+		ref = deployment.CommitSha.String[:min(7, len(deployment.CommitSha.String))]
+	}
+	if ref == "" {
+		ref = "the default branch"
+	}
+
+	switch deployment.Status {
+	case db.DeploymentsStatusSuccess:
+		return componentStatus("deployment", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, time.Unix(deployment.CompletedAt.Int64, 0), "Deployed %s", ref)
+	case db.DeploymentsStatusFailed:
+		return componentStatus("deployment", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_ERROR, time.Unix(deployment.CompletedAt.Int64, 0), "Deploying %s failed: %s", ref, deployment.ErrorMessage.String)
+	default:
+		return componentStatus("deployment", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, time.Unix(deployment.StartedAt, 0), "Deploying %s", ref)
+	}
+}
+
+// backupStatus reports a site's newest backup when backups can be looked up
+func (s *SiteOperationsService) backupStatus(ctx context.Context, sitePublicID string, now time.Time) *libopsv1.SiteComponentStatus {
+	if s.backups == nil {
+		return componentStatus("backup", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Backups aren't tracked")
+	}
+	backup, err := s.backups.Latest(ctx, sitePublicID)
+	if err != nil {
+		slog.Error("Failed to find latest backup", "error", err, "site_id", sitePublicID)
+		return componentStatus("backup", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN, time.Time{}, "Couldn't look up backups")
+	}
+	if backup == nil {
+		return componentStatus("backup", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, time.Time{}, "No backups")
+	}
+	age := now.Sub(backup.UpdatedAt)
+	if age > backupStaleAfter {
+		return componentStatus("backup", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_WARNING, backup.UpdatedAt, "Last backed up %s ago", humanDuration(age))
+	}
+	return componentStatus("backup", libopsv1.SiteComponentState_SITE_COMPONENT_STATE_OK, backup.UpdatedAt, "Backed up %s ago", humanDuration(age))
+}
+
+// humanDuration rounds d to its largest unit, e.g. "3 hours"
+func humanDuration(d time.Duration) string {
+	unit := func(n int64, name string) string {
+		if n == 1 {
+			return "1 " + name
+		}
+		return fmt.Sprintf("%d %ss", n, name)
+	}
+	switch {
+	case d >= 48*time.Hour:
+		return unit(int64(d/(24*time.Hour)), "day")
+	case d >= 2*time.Hour:
+		return unit(int64(d/time.Hour), "hour")
+	case d >= time.Minute:
+		return unit(int64(d/time.Minute), "minute")
+	default:
+		return unit(int64(d/time.Second), "second")
+	}
+}
+
+// lookupHost resolves a domain with the system resolver
+func lookupHost(ctx context.Context, host string) ([]string, error) {
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
@@ -0,0 +1,451 @@
+package site
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	"github.com/libops/api/internal/vault"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// hstsPreloadMinMaxAge is the shortest HSTS max age browsers' preload
+	// lists accept: a year.
+	hstsPreloadMinMaxAge = 365 * 24 * 60 * 60
+	// maxHstsMaxAge caps the HSTS max age at two years, the longest browsers honor.
+	maxHstsMaxAge = 2 * hstsPreloadMinMaxAge
+	// maxTlsCertificateBytes caps an uploaded certificate chain.
+	maxTlsCertificateBytes = 64 * 1024
+	// maxTlsPrivateKeyBytes caps an uploaded private key.
+	maxTlsPrivateKeyBytes = 16 * 1024
+	// tlsPrivateKeyField is the field of the Vault secret holding a custom
+	// certificate's private key.
+	tlsPrivateKeyField = "private_key_pem"
+)
+
+// secretStore is the part of an organization's Vault the TLS policy keeps
+// custom certificates' private keys in.
+type secretStore interface {
+	WriteSecret(ctx context.Context, path string, data map[string]any) error
+	ReadSecret(ctx context.Context, path string) (map[string]any, error)
+	DeleteSecret(ctx context.Context, path string) error
+}
+
+// organizationSecretStores returns a function that opens an organization's
+// Vault, behind envelope encryption.
+func organizationSecretStores(querier db.Querier) func(context.Context, int64) (secretStore, error) {
+	return func(ctx context.Context, organizationID int64) (secretStore, error) {
+		store, err := service.OrganizationSecretStore(ctx, querier, organizationID)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	}
+}
+
+// TlsPolicyService implements the TlsPolicyService API.
+type TlsPolicyService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+	vault       func(ctx context.Context, organizationID int64) (secretStore, error)
+}
+
+// Compile-time check to ensure TlsPolicyService implements the interface.
+var _ libopsv1connect.TlsPolicyServiceHandler = (*TlsPolicyService)(nil)
+
+// NewTlsPolicyService creates a new TlsPolicyService instance.
+func NewTlsPolicyService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *TlsPolicyService {
+	return &TlsPolicyService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+		vault:       organizationSecretStores(querier),
+	}
+}
+
+// GetSiteTlsPolicy returns a site's TLS policy and the result of its last probe.
+func (s *TlsPolicyService) GetSiteTlsPolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteTlsPolicyRequest],
+) (*connect.Response[libopsv1.GetSiteTlsPolicyResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteTlsPolicyResponse{Policy: tlsPolicyToProto(site.PublicID, policy)}), nil
+}
+
+// UpdateSiteTlsPolicy sets a site's minimum TLS version, HSTS and OCSP
+// stapling and queues the site's reconciliation, which renders them into the
+// proxy.
+func (s *TlsPolicyService) UpdateSiteTlsPolicy(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateSiteTlsPolicyRequest],
+) (*connect.Response[libopsv1.UpdateSiteTlsPolicyResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	minVersion, err := protoTlsVersionToDB(req.Msg.MinVersion)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	maxAge := req.Msg.HstsMaxAgeSeconds
+	switch {
+	case maxAge < 0 || maxAge > maxHstsMaxAge:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("hsts_max_age_seconds must be 0 to %d", maxHstsMaxAge))
+	case maxAge == 0 && (req.Msg.HstsIncludeSubdomains || req.Msg.HstsPreload):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("HSTS options need an hsts_max_age_seconds"))
+	case req.Msg.HstsPreload && maxAge < hstsPreloadMinMaxAge:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("hsts_preload needs an hsts_max_age_seconds of at least %d", hstsPreloadMinMaxAge))
+	case req.Msg.HstsPreload && !req.Msg.HstsIncludeSubdomains:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("hsts_preload needs hsts_include_subdomains"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpsertSiteTlsSettings(ctx, db.UpsertSiteTlsSettingsParams{
+		SiteID:                site.ID,
+		MinVersion:            minVersion,
+		HstsMaxAgeSeconds:     maxAge,
+		HstsIncludeSubdomains: req.Msg.HstsIncludeSubdomains,
+		HstsPreload:           req.Msg.HstsPreload,
+		OcspStapling:          req.Msg.OcspStapling,
+		UpdatedBy:             sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "TLS policy")
+	}
+
+	policy, err := s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteTlsPolicyUpdate, map[string]any{
+		"min_version":             string(minVersion),
+		"hsts_max_age_seconds":    maxAge,
+		"hsts_include_subdomains": req.Msg.HstsIncludeSubdomains,
+		"hsts_preload":            req.Msg.HstsPreload,
+		"ocsp_stapling":           req.Msg.OcspStapling,
+	})
+
+	resp := &libopsv1.UpdateSiteTlsPolicyResponse{Policy: tlsPolicyToProto(site.PublicID, policy)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteTlsUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// UploadSiteTlsCertificate makes a site serve a custom certificate instead of
+// its ACME one. The certificate has to be valid now and cover every domain of
+// the site; its private key goes to the organization's Vault.
+func (s *TlsPolicyService) UploadSiteTlsCertificate(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UploadSiteTlsCertificateRequest],
+) (*connect.Response[libopsv1.UploadSiteTlsCertificateResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	switch {
+	case strings.TrimSpace(req.Msg.CertificatePem) == "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_pem is required"))
+	case strings.TrimSpace(req.Msg.PrivateKeyPem) == "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("private_key_pem is required"))
+	case len(req.Msg.CertificatePem) > maxTlsCertificateBytes:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_pem is larger than %d bytes", maxTlsCertificateBytes))
+	case len(req.Msg.PrivateKeyPem) > maxTlsPrivateKeyBytes:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("private_key_pem is larger than %d bytes", maxTlsPrivateKeyBytes))
+	}
+
+	pair, err := tls.X509KeyPair([]byte(req.Msg.CertificatePem), []byte(req.Msg.PrivateKeyPem))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate_pem and private_key_pem aren't a matching certificate and key: %w", err))
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid certificate: %w", err))
+	}
+	now := time.Now()
+	switch {
+	case now.After(leaf.NotAfter):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339)))
+	case now.Before(leaf.NotBefore):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate isn't valid until %s", leaf.NotBefore.UTC().Format(time.RFC3339)))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := s.db.ListSiteCdnDomains(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	for _, domain := range domains {
+		if err := leaf.VerifyHostname(domain); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("certificate doesn't cover the site's domain %s", domain))
+		}
+	}
+
+	project, err := s.db.GetProjectByID(ctx, site.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	store, err := s.vault(ctx, project.OrganizationID)
+	if err != nil {
+		slog.Error("Failed to open organization vault", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store private key"))
+	}
+	keyPath := vault.BuildSiteTLSKeyPath(site.PublicID)
+	if err := service.WriteSecret(ctx, store, keyPath, map[string]any{tlsPrivateKeyField: req.Msg.PrivateKeyPem}); err != nil {
+		slog.Error("Failed to write TLS private key to vault", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store private key"))
+	}
+
+	// Only the chain is kept, so nothing else pasted along with it reaches nginx
+	var chain strings.Builder
+	for _, der := range pair.Certificate {
+		chain.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+	err = s.db.UpsertSiteTlsCertificate(ctx, db.UpsertSiteTlsCertificateParams{
+		SiteID:              site.ID,
+		CertificateSource:   db.SiteTlsPoliciesCertificateSourceCustom,
+		CertificatePem:      sql.NullString{String: chain.String(), Valid: true},
+		PrivateKeyVaultPath: sql.NullString{String: keyPath, Valid: true},
+		CertificateNotAfter: sql.NullTime{Time: leaf.NotAfter, Valid: true},
+		UpdatedBy:           sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "TLS certificate")
+	}
+
+	policy, err := s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteTlsCertificateUpload, map[string]any{
+		"sha256_fingerprint": certificateFingerprint(pair.Certificate[0]),
+		"dns_names":          leaf.DNSNames,
+		"not_after":          leaf.NotAfter.Unix(),
+	})
+
+	resp := &libopsv1.UploadSiteTlsCertificateResponse{Policy: tlsPolicyToProto(site.PublicID, policy)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteTlsUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteSiteTlsCertificate removes a site's custom certificate, so it serves
+// its ACME one again, and queues the site's reconciliation.
+func (s *TlsPolicyService) DeleteSiteTlsCertificate(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteSiteTlsCertificateRequest],
+) (*connect.Response[libopsv1.DeleteSiteTlsCertificateResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.CertificateSource != db.SiteTlsPoliciesCertificateSourceCustom {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("site has no custom certificate"))
+	}
+
+	err = s.db.UpsertSiteTlsCertificate(ctx, db.UpsertSiteTlsCertificateParams{
+		SiteID:            site.ID,
+		CertificateSource: db.SiteTlsPoliciesCertificateSourceAcme,
+		UpdatedBy:         sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "TLS certificate")
+	}
+
+	// The key goes once the site no longer points at it; a leftover key is
+	// overwritten by the next upload
+	if policy.PrivateKeyVaultPath.Valid {
+		project, err := s.db.GetProjectByID(ctx, site.ProjectID)
+		if err == nil {
+			var store secretStore
+			store, err = s.vault(ctx, project.OrganizationID)
+			if err == nil {
+				service.DeleteSecret(ctx, store, policy.PrivateKeyVaultPath.String)
+			}
+		}
+		if err != nil {
+			slog.Error("Failed to delete TLS private key from vault", "site_id", site.PublicID, "err", err)
+		}
+	}
+
+	policy, err = s.repo.GetTlsPolicy(ctx, site.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteTlsCertificateDelete, nil)
+
+	resp := &libopsv1.DeleteSiteTlsCertificateResponse{Policy: tlsPolicyToProto(site.PublicID, policy)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteTlsUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// site looks up the site a request targets.
+func (s *TlsPolicyService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// GetTlsPolicy returns a site's TLS policy, or the default one.
+func (r *Repository) GetTlsPolicy(ctx context.Context, siteID int64) (db.GetSiteTlsPolicyRow, error) {
+	policy, err := r.db.GetSiteTlsPolicy(ctx, siteID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.GetSiteTlsPolicyRow{
+			SiteID:            siteID,
+			MinVersion:        db.SiteTlsPoliciesMinVersion12,
+			OcspStapling:      true,
+			CertificateSource: db.SiteTlsPoliciesCertificateSourceAcme,
+			ProbeStatus:       db.SiteTlsPoliciesProbeStatusPending,
+		}, nil
+	} else if err != nil {
+		return db.GetSiteTlsPolicyRow{}, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	return policy, nil
+}
+
+func protoTlsVersionToDB(version libopsv1.TlsVersion) (db.SiteTlsPoliciesMinVersion, error) {
+	switch version {
+	case libopsv1.TlsVersion_TLS_VERSION_UNSPECIFIED, libopsv1.TlsVersion_TLS_VERSION_1_2:
+		return db.SiteTlsPoliciesMinVersion12, nil
+	case libopsv1.TlsVersion_TLS_VERSION_1_3:
+		return db.SiteTlsPoliciesMinVersion13, nil
+	}
+	return "", fmt.Errorf("unknown min_version %v", version)
+}
+
+func dbTlsVersionToProto(version db.SiteTlsPoliciesMinVersion) libopsv1.TlsVersion {
+	switch version {
+	case db.SiteTlsPoliciesMinVersion12:
+		return libopsv1.TlsVersion_TLS_VERSION_1_2
+	case db.SiteTlsPoliciesMinVersion13:
+		return libopsv1.TlsVersion_TLS_VERSION_1_3
+	}
+	return libopsv1.TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+func dbTlsCertificateSourceToProto(source db.SiteTlsPoliciesCertificateSource) libopsv1.TlsCertificateSource {
+	switch source {
+	case db.SiteTlsPoliciesCertificateSourceAcme:
+		return libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_ACME
+	case db.SiteTlsPoliciesCertificateSourceCustom:
+		return libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_CUSTOM
+	}
+	return libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_UNSPECIFIED
+}
+
+func dbTlsProbeStatusToProto(status db.SiteTlsPoliciesProbeStatus) libopsv1.TlsProbeStatus {
+	switch status {
+	case db.SiteTlsPoliciesProbeStatusPending:
+		return libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_PENDING
+	case db.SiteTlsPoliciesProbeStatusPassed:
+		return libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_PASSED
+	case db.SiteTlsPoliciesProbeStatusFailed:
+		return libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_FAILED
+	}
+	return libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_UNSPECIFIED
+}
+
+func tlsPolicyToProto(sitePublicID string, policy db.GetSiteTlsPolicyRow) *libopsv1.SiteTlsPolicy {
+	pb := &libopsv1.SiteTlsPolicy{
+		SiteId:                sitePublicID,
+		MinVersion:            dbTlsVersionToProto(policy.MinVersion),
+		HstsMaxAgeSeconds:     policy.HstsMaxAgeSeconds,
+		HstsIncludeSubdomains: policy.HstsIncludeSubdomains,
+		HstsPreload:           policy.HstsPreload,
+		OcspStapling:          policy.OcspStapling,
+		CertificateSource:     dbTlsCertificateSourceToProto(policy.CertificateSource),
+		ProbeStatus:           dbTlsProbeStatusToProto(policy.ProbeStatus),
+		ProbeMessage:          policy.ProbeMessage,
+	}
+	if policy.CertificateSource == db.SiteTlsPoliciesCertificateSourceCustom {
+		pb.Certificate = tlsCertificateToProto(policy.CertificatePem.String)
+	}
+	if policy.ProbedAt.Valid {
+		pb.ProbedAt = policy.ProbedAt.Time.Unix()
+	}
+	if policy.UpdatedAt.Valid {
+		pb.UpdatedAt = policy.UpdatedAt.Time.Unix()
+	}
+	return pb
+}
+
+// tlsCertificateToProto describes the leaf of a stored certificate chain.
+func tlsCertificateToProto(chain string) *libopsv1.TlsCertificate {
+	block, _ := pem.Decode([]byte(chain))
+	if block == nil {
+		return nil
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return &libopsv1.TlsCertificate{
+		DnsNames:          leaf.DNSNames,
+		Issuer:            leaf.Issuer.String(),
+		NotBefore:         leaf.NotBefore.Unix(),
+		NotAfter:          leaf.NotAfter.Unix(),
+		Sha256Fingerprint: certificateFingerprint(block.Bytes),
+	}
+}
+
+// certificateFingerprint is the hex SHA-256 of a DER certificate.
+func certificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,274 @@
+package site
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// memorySecretStore is an organization's Vault kept in memory.
+type memorySecretStore map[string]map[string]any
+
+func (m memorySecretStore) WriteSecret(ctx context.Context, path string, data map[string]any) error {
+	m[path] = data
+	return nil
+}
+
+func (m memorySecretStore) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	data, ok := m[path]
+	if !ok {
+		return nil, fmt.Errorf("no secret found at %s", path)
+	}
+	return data, nil
+}
+
+func (m memorySecretStore) DeleteSecret(ctx context.Context, path string) error {
+	delete(m, path)
+	return nil
+}
+
+// selfSignedCertificate returns a PEM certificate for names, valid from
+// notBefore to notAfter, and its PEM private key.
+func selfSignedCertificate(t *testing.T, names []string, notBefore, notAfter time.Time) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: names[0]},
+		DNSNames:     names,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+// TestSiteTlsPolicy tests that the TLS policy defaults until it's set, that
+// HSTS and uploaded certificates are validated, that the controller gets the
+// policy and the certificate's key through the site's proxy config, and that
+// its probe results are recorded.
+func TestSiteTlsPolicy(t *testing.T) {
+	siteID := uuid.NewString()
+	var policy *db.GetSiteTlsPolicyRow
+	ensurePolicy := func(siteID int64) {
+		if policy == nil {
+			policy = &db.GetSiteTlsPolicyRow{
+				SiteID:            siteID,
+				MinVersion:        db.SiteTlsPoliciesMinVersion12,
+				OcspStapling:      true,
+				CertificateSource: db.SiteTlsPoliciesCertificateSourceAcme,
+				ProbeStatus:       db.SiteTlsPoliciesProbeStatusPending,
+			}
+		}
+		policy.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2, IsProduction: sql.NullBool{Bool: true, Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		ListSiteCdnDomainsFunc: func(ctx context.Context, id int64) ([]string, error) {
+			return []string{"example.org", "www.example.org"}, nil
+		},
+		GetSiteTlsPolicyFunc: func(ctx context.Context, id int64) (db.GetSiteTlsPolicyRow, error) {
+			if policy == nil {
+				return db.GetSiteTlsPolicyRow{}, sql.ErrNoRows
+			}
+			return *policy, nil
+		},
+		UpsertSiteTlsSettingsFunc: func(ctx context.Context, arg db.UpsertSiteTlsSettingsParams) error {
+			ensurePolicy(arg.SiteID)
+			policy.MinVersion = arg.MinVersion
+			policy.HstsMaxAgeSeconds = arg.HstsMaxAgeSeconds
+			policy.HstsIncludeSubdomains = arg.HstsIncludeSubdomains
+			policy.HstsPreload = arg.HstsPreload
+			policy.OcspStapling = arg.OcspStapling
+			policy.ProbeStatus = db.SiteTlsPoliciesProbeStatusPending
+			return nil
+		},
+		UpsertSiteTlsCertificateFunc: func(ctx context.Context, arg db.UpsertSiteTlsCertificateParams) error {
+			ensurePolicy(arg.SiteID)
+			policy.CertificateSource = arg.CertificateSource
+			policy.CertificatePem = arg.CertificatePem
+			policy.PrivateKeyVaultPath = arg.PrivateKeyVaultPath
+			policy.CertificateNotAfter = arg.CertificateNotAfter
+			policy.ProbeStatus = db.SiteTlsPoliciesProbeStatusPending
+			return nil
+		},
+		InitSiteTlsPolicyFunc: func(ctx context.Context, id int64) error {
+			if policy == nil {
+				ensurePolicy(id)
+			}
+			return nil
+		},
+		RecordSiteTlsProbeFunc: func(ctx context.Context, arg db.RecordSiteTlsProbeParams) error {
+			policy.ProbeStatus = arg.ProbeStatus
+			policy.ProbeMessage = arg.ProbeMessage
+			policy.ProbedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	store := memorySecretStore{}
+	vault := func(ctx context.Context, organizationID int64) (secretStore, error) {
+		return store, nil
+	}
+	svc := NewTlsPolicyService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	svc.vault = vault
+	admin := NewAdminSiteService(mock)
+	admin.vault = vault
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+
+	got, err := svc.GetSiteTlsPolicy(ctx, connect.NewRequest(&libopsv1.GetSiteTlsPolicyRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.TlsVersion_TLS_VERSION_1_2, got.Msg.Policy.MinVersion)
+	assert.True(t, got.Msg.Policy.OcspStapling)
+	assert.Equal(t, libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_ACME, got.Msg.Policy.CertificateSource)
+	assert.Zero(t, got.Msg.Policy.UpdatedAt, "default policy")
+
+	for name, req := range map[string]*libopsv1.UpdateSiteTlsPolicyRequest{
+		"negative max age":        {HstsMaxAgeSeconds: -1},
+		"max age too long":        {HstsMaxAgeSeconds: maxHstsMaxAge + 1},
+		"subdomains without hsts": {HstsIncludeSubdomains: true},
+		"preload too short":       {HstsMaxAgeSeconds: 86400, HstsIncludeSubdomains: true, HstsPreload: true},
+		"preload one domain":      {HstsMaxAgeSeconds: hstsPreloadMinMaxAge, HstsPreload: true},
+		"unknown version":         {MinVersion: libopsv1.TlsVersion(9)},
+	} {
+		req.SiteId = siteID
+		_, err := svc.UpdateSiteTlsPolicy(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	updated, err := svc.UpdateSiteTlsPolicy(ctx, connect.NewRequest(&libopsv1.UpdateSiteTlsPolicyRequest{
+		SiteId:                siteID,
+		MinVersion:            libopsv1.TlsVersion_TLS_VERSION_1_3,
+		HstsMaxAgeSeconds:     hstsPreloadMinMaxAge,
+		HstsIncludeSubdomains: true,
+		HstsPreload:           true,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.TlsVersion_TLS_VERSION_1_3, updated.Msg.Policy.MinVersion)
+	assert.False(t, updated.Msg.Policy.OcspStapling)
+	assert.Equal(t, libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_PENDING, updated.Msg.Policy.ProbeStatus)
+
+	now := time.Now()
+	upload := func(cert, key string) (*connect.Response[libopsv1.UploadSiteTlsCertificateResponse], error) {
+		return svc.UploadSiteTlsCertificate(ctx, connect.NewRequest(&libopsv1.UploadSiteTlsCertificateRequest{
+			SiteId:         siteID,
+			CertificatePem: cert,
+			PrivateKeyPem:  key,
+		}))
+	}
+	cert, key := selfSignedCertificate(t, []string{"example.org", "*.example.org"}, now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	_, otherKey := selfSignedCertificate(t, []string{"example.org"}, now.Add(-time.Hour), now.Add(time.Hour))
+	expired, expiredKey := selfSignedCertificate(t, []string{"example.org", "*.example.org"}, now.Add(-48*time.Hour), now.Add(-time.Hour))
+	partial, partialKey := selfSignedCertificate(t, []string{"example.org"}, now.Add(-time.Hour), now.Add(time.Hour))
+	for name, pair := range map[string][2]string{
+		"no certificate":  {"", key},
+		"no key":          {cert, ""},
+		"not pem":         {"certificate", key},
+		"mismatched key":  {cert, otherKey},
+		"expired":         {expired, expiredKey},
+		"missing domains": {partial, partialKey},
+	} {
+		_, err := upload(pair[0], pair[1])
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+	assert.Empty(t, store, "rejected keys aren't stored")
+
+	_, err = svc.DeleteSiteTlsCertificate(ctx, connect.NewRequest(&libopsv1.DeleteSiteTlsCertificateRequest{SiteId: siteID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "no custom certificate yet")
+
+	uploaded, err := upload("intermediate notes\n"+cert, key)
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_CUSTOM, uploaded.Msg.Policy.CertificateSource)
+	require.NotNil(t, uploaded.Msg.Policy.Certificate)
+	assert.Equal(t, []string{"example.org", "*.example.org"}, uploaded.Msg.Policy.Certificate.DnsNames)
+	assert.Len(t, uploaded.Msg.Policy.Certificate.Sha256Fingerprint, 64)
+	assert.Equal(t, cert, policy.CertificatePem.String, "only the chain is stored")
+	assert.NotContains(t, policy.CertificatePem.String, "PRIVATE KEY")
+
+	proxy, err := admin.GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	require.NotNil(t, proxy.Msg.Tls)
+	assert.Equal(t, libopsv1.TlsVersion_TLS_VERSION_1_3, proxy.Msg.Tls.MinVersion)
+	assert.True(t, proxy.Msg.Tls.HstsPreload)
+	assert.Equal(t, cert, proxy.Msg.Tls.CertificatePem)
+	assert.Equal(t, key, proxy.Msg.Tls.PrivateKeyPem)
+	assert.Equal(t, []string{"example.org", "www.example.org"}, proxy.Msg.Tls.Domains)
+
+	_, err = admin.ReportSiteTlsProbe(ctx, connect.NewRequest(&libopsv1.ReportSiteTlsProbeRequest{
+		SiteId:  siteID,
+		Message: "www.example.org accepted TLS 1.2",
+	}))
+	require.NoError(t, err)
+	got, err = svc.GetSiteTlsPolicy(ctx, connect.NewRequest(&libopsv1.GetSiteTlsPolicyRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.TlsProbeStatus_TLS_PROBE_STATUS_FAILED, got.Msg.Policy.ProbeStatus)
+	assert.Equal(t, "www.example.org accepted TLS 1.2", got.Msg.Policy.ProbeMessage)
+	assert.NotZero(t, got.Msg.Policy.ProbedAt)
+
+	deleted, err := svc.DeleteSiteTlsCertificate(ctx, connect.NewRequest(&libopsv1.DeleteSiteTlsCertificateRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Equal(t, libopsv1.TlsCertificateSource_TLS_CERTIFICATE_SOURCE_ACME, deleted.Msg.Policy.CertificateSource)
+	assert.Nil(t, deleted.Msg.Policy.Certificate)
+	assert.Empty(t, store, "the key is deleted with the certificate")
+
+	proxy, err = admin.GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Empty(t, proxy.Msg.Tls.CertificatePem)
+	assert.Empty(t, proxy.Msg.Tls.PrivateKeyPem)
+
+	_, err = admin.ReportSiteTlsProbe(ctx, connect.NewRequest(&libopsv1.ReportSiteTlsProbeRequest{SiteId: siteID, Passed: true}))
+	require.NoError(t, err)
+	assert.Equal(t, db.SiteTlsPoliciesProbeStatusPassed, policy.ProbeStatus)
+	assert.Empty(t, policy.ProbeMessage)
+
+	require.Len(t, queued, 3)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteTlsUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteTlsPolicyUpdate),
+		string(audit.SiteTlsCertificateUpload),
+		string(audit.SiteTlsCertificateDelete),
+	}, audited)
+}
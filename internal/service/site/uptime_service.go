@@ -0,0 +1,124 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/uptime"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	defaultUptimeWindowHours = 24
+	maxUptimeWindowHours     = 30 * 24
+	maxHealthCheckPathLength = 255
+)
+
+// UptimeService implements the UptimeService API.
+type UptimeService struct {
+	db   db.Querier
+	repo *Repository
+}
+
+// Compile-time check to ensure UptimeService implements the interface.
+var _ libopsv1connect.UptimeServiceHandler = (*UptimeService)(nil)
+
+// NewUptimeService creates a new UptimeService instance.
+func NewUptimeService(querier db.Querier) *UptimeService {
+	return &UptimeService{
+		db:   querier,
+		repo: NewRepository(querier),
+	}
+}
+
+// GetSiteUptime reports a site's probe results over a window.
+func (s *UptimeService) GetSiteUptime(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteUptimeRequest],
+) (*connect.Response[libopsv1.GetSiteUptimeResponse], error) {
+	siteUUID, err := uuid.Parse(req.Msg.SiteId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id: %w", err))
+	}
+
+	windowHours := req.Msg.WindowHours
+	if windowHours == 0 {
+		windowHours = defaultUptimeWindowHours
+	}
+	if windowHours < 1 || windowHours > maxUptimeWindowHours {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("window_hours must be between 1 and %d", maxUptimeWindowHours))
+	}
+
+	site, err := s.repo.GetSiteByPublicID(ctx, siteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := uptime.Summarize(ctx, s.db, site.ID, site.PublicID, windowHours, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteUptimeResponse{Uptime: summary}), nil
+}
+
+// UpdateSiteHealthCheck sets the path uptime probes request on a site.
+func (s *UptimeService) UpdateSiteHealthCheck(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateSiteHealthCheckRequest],
+) (*connect.Response[libopsv1.UpdateSiteHealthCheckResponse], error) {
+	siteUUID, err := uuid.Parse(req.Msg.SiteId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid site_id: %w", err))
+	}
+
+	path := strings.TrimSpace(req.Msg.HealthCheckPath)
+	if err := validateHealthCheckPath(path); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	site, err := s.repo.GetSiteByPublicID(ctx, siteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpdateSiteHealthCheckPath(ctx, db.UpdateSiteHealthCheckPathParams{
+		HealthCheckPath: path,
+		ID:              site.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update health check path: %w", err))
+	}
+
+	return connect.NewResponse(&libopsv1.UpdateSiteHealthCheckResponse{
+		SiteId:          site.PublicID,
+		HealthCheckPath: path,
+	}), nil
+}
+
+// validateHealthCheckPath accepts an absolute path with an optional query.
+// Probes always target the site's own host, so schemes and hosts are rejected.
+func validateHealthCheckPath(path string) error {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return fmt.Errorf("health_check_path must be an absolute path starting with /")
+	}
+	if len(path) > maxHealthCheckPathLength {
+		return fmt.Errorf("health_check_path must be at most %d characters", maxHealthCheckPathLength)
+	}
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid health_check_path: %w", err)
+	}
+	if parsed.Scheme != "" || parsed.Host != "" || parsed.Fragment != "" {
+		return fmt.Errorf("health_check_path must not include a scheme, host or fragment")
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package site
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidateHealthCheckPath tests that probes can only be pointed at a path on the site itself.
+func TestValidateHealthCheckPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		wantError bool
+	}{
+		{"root", "/", false},
+		{"path", "/healthz", false},
+		{"path with query", "/status?format=json", false},
+		{"empty", "", true},
+		{"relative", "healthz", true},
+		{"protocol relative", "//evil.example.com/", true},
+		{"absolute url", "https://evil.example.com/", true},
+		{"fragment", "/healthz#top", true},
+		{"too long", "/" + strings.Repeat("a", 255), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHealthCheckPath(tt.path)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateHealthCheckPath(%q) error = %v, wantError %v", tt.path, err, tt.wantError)
+			}
+		})
+	}
+}
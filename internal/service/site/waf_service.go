@@ -0,0 +1,432 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/service"
+	"github.com/libops/api/internal/validation"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+const (
+	// maxWafRuleExclusions caps how many exclusions a site's proxy config carries.
+	maxWafRuleExclusions = 200
+	// minWafRuleID and maxWafRuleID bound the OWASP core rule set's rule IDs.
+	minWafRuleID = 900000
+	maxWafRuleID = 999999
+	// maxWafReportHours is how far back a WAF report goes; older blocks are deleted.
+	maxWafReportHours = 30 * 24
+	// maxWafReportRecent caps the recent blocks in a WAF report.
+	maxWafReportRecent = 100
+	// maxWafBlocksPerCheckIn caps the blocks stored from one controller check-in.
+	maxWafBlocksPerCheckIn = 200
+)
+
+// WafService implements the WafService API.
+type WafService struct {
+	db          db.Querier
+	repo        *Repository
+	emitter     *events.Emitter
+	auditLogger *audit.Logger
+}
+
+// Compile-time check to ensure WafService implements the interface.
+var _ libopsv1connect.WafServiceHandler = (*WafService)(nil)
+
+// NewWafService creates a new WafService instance.
+func NewWafService(querier db.Querier, emitter *events.Emitter, auditLogger *audit.Logger) *WafService {
+	return &WafService{
+		db:          querier,
+		repo:        NewRepository(querier),
+		emitter:     emitter,
+		auditLogger: auditLogger,
+	}
+}
+
+// GetSiteWaf returns a site's WAF config and rule exclusions.
+func (s *WafService) GetSiteWaf(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetSiteWafRequest],
+) (*connect.Response[libopsv1.GetSiteWafResponse], error) {
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	waf, err := s.repo.GetWaf(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&libopsv1.GetSiteWafResponse{Waf: wafToProto(site.PublicID, waf)}), nil
+}
+
+// UpdateSiteWaf sets a site's WAF config and queues the site's
+// reconciliation, which renders it into the proxy.
+func (s *WafService) UpdateSiteWaf(
+	ctx context.Context,
+	req *connect.Request[libopsv1.UpdateSiteWafRequest],
+) (*connect.Response[libopsv1.UpdateSiteWafResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	mode, err := protoWafModeToDB(req.Msg.Mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	paranoiaLevel := req.Msg.ParanoiaLevel
+	if paranoiaLevel == 0 {
+		paranoiaLevel = 1
+	}
+	if paranoiaLevel < 1 || paranoiaLevel > 4 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("paranoia_level must be 1 to 4"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpsertSiteWafConfig(ctx, db.UpsertSiteWafConfigParams{
+		SiteID:        site.ID,
+		Enabled:       req.Msg.Enabled,
+		Mode:          mode,
+		ParanoiaLevel: int8(paranoiaLevel),
+		UpdatedBy:     sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "WAF config")
+	}
+
+	waf, err := s.repo.GetWaf(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteWafUpdate, map[string]any{
+		"enabled":        req.Msg.Enabled,
+		"mode":           string(mode),
+		"paranoia_level": paranoiaLevel,
+	})
+
+	resp := &libopsv1.UpdateSiteWafResponse{Waf: wafToProto(site.PublicID, waf)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteWafUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// CreateWafRuleExclusion excludes a core rule set rule from a site and queues
+// the site's reconciliation.
+func (s *WafService) CreateWafRuleExclusion(
+	ctx context.Context,
+	req *connect.Request[libopsv1.CreateWafRuleExclusionRequest],
+) (*connect.Response[libopsv1.CreateWafRuleExclusionResponse], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	ruleID := req.Msg.RuleId
+	pathPrefix := strings.TrimSpace(req.Msg.PathPrefix)
+	reason := strings.TrimSpace(req.Msg.Reason)
+	switch {
+	case ruleID < minWafRuleID || ruleID > maxWafRuleID:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule_id must be a core rule set rule, %d to %d", minWafRuleID, maxWafRuleID))
+	case pathPrefix != "" && !strings.HasPrefix(pathPrefix, "/"):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix must start with /"))
+	case strings.ContainsAny(pathPrefix, redirectUnsafeChars):
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix contains characters that aren't allowed"))
+	case len(pathPrefix) > 512:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("path_prefix is longer than 512 characters"))
+	case len(reason) > 255:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is longer than 255 characters"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.CountSiteWafRuleExclusions(ctx, site.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	if count >= maxWafRuleExclusions {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("a site can have at most %d WAF rule exclusions", maxWafRuleExclusions))
+	}
+	_, err = s.db.GetSiteWafRuleExclusionByRule(ctx, db.GetSiteWafRuleExclusionByRuleParams{
+		SiteID:     site.ID,
+		RuleID:     ruleID,
+		PathPrefix: pathPrefix,
+	})
+	if err == nil {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("rule %d is already excluded there", ruleID))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	exclusionID := uuid.NewString()
+	err = s.db.CreateSiteWafRuleExclusion(ctx, db.CreateSiteWafRuleExclusionParams{
+		PublicID:   exclusionID,
+		SiteID:     site.ID,
+		RuleID:     ruleID,
+		PathPrefix: pathPrefix,
+		Reason:     reason,
+		CreatedBy:  sql.NullInt64{Int64: userInfo.AccountID, Valid: true},
+	})
+	if err != nil {
+		return nil, service.HandleDatabaseError(err, "WAF rule exclusion")
+	}
+
+	exclusion, err := s.db.GetSiteWafRuleExclusion(ctx, exclusionID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteWafExclusionCreate, map[string]any{
+		"exclusion_id": exclusionID,
+		"rule_id":      ruleID,
+		"path_prefix":  pathPrefix,
+		"reason":       reason,
+	})
+
+	resp := &libopsv1.CreateWafRuleExclusionResponse{Exclusion: wafRuleExclusionToProto(exclusion)}
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteWafUpdated, site.PublicID, site.PublicID, resp)
+
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteWafRuleExclusion removes a rule exclusion from a site and queues the
+// site's reconciliation.
+func (s *WafService) DeleteWafRuleExclusion(
+	ctx context.Context,
+	req *connect.Request[libopsv1.DeleteWafRuleExclusionRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	userInfo, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.UUID(req.Msg.ExclusionId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	exclusion, err := s.db.GetSiteWafRuleExclusion(ctx, req.Msg.ExclusionId)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && exclusion.SiteID != site.ID) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("WAF rule exclusion not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	if err := s.db.DeleteSiteWafRuleExclusion(ctx, exclusion.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	s.auditLogger.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteWafExclusionDelete, map[string]any{
+		"exclusion_id": exclusion.PublicID,
+		"rule_id":      exclusion.RuleID,
+		"path_prefix":  exclusion.PathPrefix,
+	})
+	emitSiteEvent(ctx, s.emitter, events.EventTypeSiteWafUpdated, site.PublicID, site.PublicID, req.Msg)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// GetWafReport reports the requests the WAF matched on a site: how often each
+// rule matched and the most recent matches.
+func (s *WafService) GetWafReport(
+	ctx context.Context,
+	req *connect.Request[libopsv1.GetWafReportRequest],
+) (*connect.Response[libopsv1.GetWafReportResponse], error) {
+	hours := req.Msg.Hours
+	if hours == 0 {
+		hours = 24
+	}
+	if hours < 0 || hours > maxWafReportHours {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("hours must be 1 to %d", maxWafReportHours))
+	}
+
+	site, err := s.site(ctx, req.Msg.SiteId)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	rules, err := s.db.SummarizeSiteWafBlocks(ctx, db.SummarizeSiteWafBlocksParams{SiteID: site.ID, OccurredAt: since})
+	if err != nil {
+		slog.Error("Failed to summarize WAF blocks", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+	recent, err := s.db.ListRecentSiteWafBlocks(ctx, db.ListRecentSiteWafBlocksParams{
+		SiteID:     site.ID,
+		OccurredAt: since,
+		Limit:      maxWafReportRecent,
+	})
+	if err != nil {
+		slog.Error("Failed to list WAF blocks", "site_id", site.PublicID, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("database error: %w", err))
+	}
+
+	resp := &libopsv1.GetWafReportResponse{
+		Rules:  make([]*libopsv1.WafRuleReport, 0, len(rules)),
+		Recent: make([]*libopsv1.WafBlock, 0, len(recent)),
+	}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, &libopsv1.WafRuleReport{
+			RuleId:   rule.RuleID,
+			Matches:  rule.Matches,
+			Blocked:  rule.Blocked,
+			LastSeen: rule.LastSeen.Unix(),
+		})
+	}
+	for _, block := range recent {
+		resp.Recent = append(resp.Recent, &libopsv1.WafBlock{
+			RuleId:     block.RuleID,
+			Message:    block.Message,
+			Method:     block.Method,
+			Uri:        block.Uri,
+			ClientIp:   block.ClientIp,
+			Blocked:    block.Blocked,
+			OccurredAt: block.OccurredAt.Unix(),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// site looks up the site a request targets.
+func (s *WafService) site(ctx context.Context, siteID string) (db.GetSiteRow, error) {
+	if err := validation.UUID(siteID); err != nil {
+		return db.GetSiteRow{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return s.repo.GetSiteByPublicID(ctx, uuid.MustParse(siteID))
+}
+
+// recordWafBlocks stores the WAF blocks a site's controller reported and
+// drops the ones too old to report.
+func (r *Repository) recordWafBlocks(ctx context.Context, siteID int64, blocks []*libopsv1.WafBlock) error {
+	if len(blocks) > maxWafBlocksPerCheckIn {
+		blocks = blocks[len(blocks)-maxWafBlocksPerCheckIn:]
+	}
+	now := time.Now()
+	for _, block := range blocks {
+		occurredAt := time.Unix(block.OccurredAt, 0)
+		if block.OccurredAt <= 0 || occurredAt.After(now) {
+			occurredAt = now
+		}
+		err := r.db.CreateSiteWafBlock(ctx, db.CreateSiteWafBlockParams{
+			SiteID:     siteID,
+			RuleID:     block.RuleId,
+			Message:    truncate(block.Message, 255),
+			Method:     truncate(block.Method, 16),
+			Uri:        truncate(block.Uri, 1024),
+			ClientIp:   truncate(block.ClientIp, 45),
+			Blocked:    block.Blocked,
+			OccurredAt: occurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record WAF block: %w", err)
+		}
+	}
+
+	err := r.db.DeleteSiteWafBlocksBefore(ctx, db.DeleteSiteWafBlocksBeforeParams{
+		SiteID:     siteID,
+		OccurredAt: now.Add(-maxWafReportHours * time.Hour),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete old WAF blocks: %w", err)
+	}
+	return nil
+}
+
+// siteWaf is a site's WAF config, set or defaulted, and its rule exclusions.
+type siteWaf struct {
+	db.GetSiteWafConfigRow
+	exclusions []db.ListSiteWafRuleExclusionsRow
+}
+
+func protoWafModeToDB(mode libopsv1.WafMode) (db.SiteWafConfigsMode, error) {
+	switch mode {
+	case libopsv1.WafMode_WAF_MODE_UNSPECIFIED, libopsv1.WafMode_WAF_MODE_BLOCKING:
+		return db.SiteWafConfigsModeBlocking, nil
+	case libopsv1.WafMode_WAF_MODE_DETECTION_ONLY:
+		return db.SiteWafConfigsModeDetectionOnly, nil
+	}
+	return "", fmt.Errorf("unknown mode %v", mode)
+}
+
+func dbWafModeToProto(mode db.SiteWafConfigsMode) libopsv1.WafMode {
+	switch mode {
+	case db.SiteWafConfigsModeBlocking:
+		return libopsv1.WafMode_WAF_MODE_BLOCKING
+	case db.SiteWafConfigsModeDetectionOnly:
+		return libopsv1.WafMode_WAF_MODE_DETECTION_ONLY
+	}
+	return libopsv1.WafMode_WAF_MODE_UNSPECIFIED
+}
+
+func wafToProto(sitePublicID string, waf siteWaf) *libopsv1.SiteWaf {
+	pb := &libopsv1.SiteWaf{
+		SiteId:        sitePublicID,
+		Enabled:       waf.Enabled,
+		Mode:          dbWafModeToProto(waf.Mode),
+		ParanoiaLevel: int32(waf.ParanoiaLevel),
+		Exclusions:    wafRuleExclusionsToProto(waf.exclusions),
+	}
+	if waf.UpdatedAt.Valid {
+		pb.UpdatedAt = waf.UpdatedAt.Time.Unix()
+	}
+	return pb
+}
+
+func wafRuleExclusionsToProto(rows []db.ListSiteWafRuleExclusionsRow) []*libopsv1.WafRuleExclusion {
+	exclusions := make([]*libopsv1.WafRuleExclusion, 0, len(rows))
+	for _, row := range rows {
+		exclusions = append(exclusions, wafRuleExclusionToProto(db.GetSiteWafRuleExclusionRow(row)))
+	}
+	return exclusions
+}
+
+func wafRuleExclusionToProto(row db.GetSiteWafRuleExclusionRow) *libopsv1.WafRuleExclusion {
+	pb := &libopsv1.WafRuleExclusion{
+		ExclusionId: row.PublicID,
+		RuleId:      row.RuleID,
+		PathPrefix:  row.PathPrefix,
+		Reason:      row.Reason,
+	}
+	if row.CreatedAt.Valid {
+		pb.CreatedAt = row.CreatedAt.Time.Unix()
+	}
+	return pb
+}
+
+// truncate cuts s to at most n bytes, keeping it valid UTF-8.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
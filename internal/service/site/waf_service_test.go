@@ -0,0 +1,240 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/events"
+	"github.com/libops/api/internal/testutils"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// TestSiteWaf tests that the WAF is off until enabled, that rule exclusions
+// are validated and scoped to their site, and that the controller gets both
+// through the site's proxy config.
+func TestSiteWaf(t *testing.T) {
+	siteID := uuid.NewString()
+	var config *db.GetSiteWafConfigRow
+	exclusions := map[string]*db.GetSiteWafRuleExclusionRow{}
+	var queued []db.EnqueueEventParams
+	var audited []string
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2, IsProduction: sql.NullBool{Bool: true, Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteWafConfigFunc: func(ctx context.Context, id int64) (db.GetSiteWafConfigRow, error) {
+			if config == nil {
+				return db.GetSiteWafConfigRow{}, sql.ErrNoRows
+			}
+			return *config, nil
+		},
+		UpsertSiteWafConfigFunc: func(ctx context.Context, arg db.UpsertSiteWafConfigParams) error {
+			config = &db.GetSiteWafConfigRow{
+				SiteID:        arg.SiteID,
+				Enabled:       arg.Enabled,
+				Mode:          arg.Mode,
+				ParanoiaLevel: arg.ParanoiaLevel,
+				UpdatedAt:     sql.NullTime{Time: time.Now(), Valid: true},
+			}
+			return nil
+		},
+		CreateSiteWafRuleExclusionFunc: func(ctx context.Context, arg db.CreateSiteWafRuleExclusionParams) error {
+			exclusions[arg.PublicID] = &db.GetSiteWafRuleExclusionRow{
+				ID:         int64(len(exclusions) + 1),
+				PublicID:   arg.PublicID,
+				SiteID:     arg.SiteID,
+				RuleID:     arg.RuleID,
+				PathPrefix: arg.PathPrefix,
+				Reason:     arg.Reason,
+			}
+			return nil
+		},
+		GetSiteWafRuleExclusionFunc: func(ctx context.Context, publicID string) (db.GetSiteWafRuleExclusionRow, error) {
+			if exclusion, ok := exclusions[publicID]; ok {
+				return *exclusion, nil
+			}
+			return db.GetSiteWafRuleExclusionRow{}, sql.ErrNoRows
+		},
+		GetSiteWafRuleExclusionByRuleFunc: func(ctx context.Context, arg db.GetSiteWafRuleExclusionByRuleParams) (db.GetSiteWafRuleExclusionByRuleRow, error) {
+			for _, exclusion := range exclusions {
+				if exclusion.SiteID == arg.SiteID && exclusion.RuleID == arg.RuleID && exclusion.PathPrefix == arg.PathPrefix {
+					return db.GetSiteWafRuleExclusionByRuleRow(*exclusion), nil
+				}
+			}
+			return db.GetSiteWafRuleExclusionByRuleRow{}, sql.ErrNoRows
+		},
+		ListSiteWafRuleExclusionsFunc: func(ctx context.Context, id int64) ([]db.ListSiteWafRuleExclusionsRow, error) {
+			var all []db.ListSiteWafRuleExclusionsRow
+			for _, exclusion := range exclusions {
+				all = append(all, db.ListSiteWafRuleExclusionsRow(*exclusion))
+			}
+			return all, nil
+		},
+		DeleteSiteWafRuleExclusionFunc: func(ctx context.Context, id int64) error {
+			for publicID, exclusion := range exclusions {
+				if exclusion.ID == id {
+					delete(exclusions, publicID)
+				}
+			}
+			return nil
+		},
+		EnqueueEventFunc: func(ctx context.Context, arg db.EnqueueEventParams) error {
+			queued = append(queued, arg)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	svc := NewWafService(mock, events.NewEmitter(mock, "test"), audit.New(mock))
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &auth.UserInfo{AccountID: 3})
+	get := func() *libopsv1.SiteWaf {
+		resp, err := svc.GetSiteWaf(ctx, connect.NewRequest(&libopsv1.GetSiteWafRequest{SiteId: siteID}))
+		require.NoError(t, err)
+		return resp.Msg.Waf
+	}
+	exclude := func(req *libopsv1.CreateWafRuleExclusionRequest) (*connect.Response[libopsv1.CreateWafRuleExclusionResponse], error) {
+		req.SiteId = siteID
+		return svc.CreateWafRuleExclusion(ctx, connect.NewRequest(req))
+	}
+
+	defaulted := get()
+	assert.False(t, defaulted.Enabled)
+	assert.Equal(t, libopsv1.WafMode_WAF_MODE_BLOCKING, defaulted.Mode)
+	assert.Equal(t, int32(1), defaulted.ParanoiaLevel)
+	assert.Zero(t, defaulted.UpdatedAt)
+
+	for name, req := range map[string]*libopsv1.UpdateSiteWafRequest{
+		"paranoia too high": {Enabled: true, ParanoiaLevel: 5},
+		"negative paranoia": {Enabled: true, ParanoiaLevel: -1},
+		"unknown mode":      {Enabled: true, Mode: libopsv1.WafMode(9)},
+	} {
+		req.SiteId = siteID
+		_, err := svc.UpdateSiteWaf(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	updated, err := svc.UpdateSiteWaf(ctx, connect.NewRequest(&libopsv1.UpdateSiteWafRequest{
+		SiteId:        siteID,
+		Enabled:       true,
+		Mode:          libopsv1.WafMode_WAF_MODE_DETECTION_ONLY,
+		ParanoiaLevel: 2,
+	}))
+	require.NoError(t, err)
+	assert.True(t, updated.Msg.Waf.Enabled)
+	assert.Equal(t, libopsv1.WafMode_WAF_MODE_DETECTION_ONLY, updated.Msg.Waf.Mode)
+	assert.NotZero(t, updated.Msg.Waf.UpdatedAt)
+
+	for name, req := range map[string]*libopsv1.CreateWafRuleExclusionRequest{
+		"not a core rule":  {RuleId: 1234},
+		"relative path":    {RuleId: 942100, PathPrefix: "admin"},
+		"unsafe path":      {RuleId: 942100, PathPrefix: "/admin\"; deny all"},
+		"rule id too high": {RuleId: 1000000},
+	} {
+		_, err := exclude(req)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	created, err := exclude(&libopsv1.CreateWafRuleExclusionRequest{RuleId: 942100, PathPrefix: "/admin", Reason: "Drupal views filters"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(942100), created.Msg.Exclusion.RuleId)
+	_, err = exclude(&libopsv1.CreateWafRuleExclusionRequest{RuleId: 942100, PathPrefix: "/admin"})
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	_, err = exclude(&libopsv1.CreateWafRuleExclusionRequest{RuleId: 942100})
+	require.NoError(t, err, "excluding a rule everywhere is a different exclusion")
+	assert.Len(t, get().Exclusions, 2)
+
+	proxy, err := NewAdminSiteService(mock).GetSiteProxyConfig(ctx, connect.NewRequest(&libopsv1.GetSiteProxyConfigRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.True(t, proxy.Msg.Waf.Enabled)
+	assert.Equal(t, int32(2), proxy.Msg.Waf.ParanoiaLevel)
+	assert.Len(t, proxy.Msg.Waf.Exclusions, 2)
+
+	exclusionID := created.Msg.Exclusion.ExclusionId
+	exclusions[exclusionID].SiteID = 6
+	_, err = svc.DeleteWafRuleExclusion(ctx, connect.NewRequest(&libopsv1.DeleteWafRuleExclusionRequest{SiteId: siteID, ExclusionId: exclusionID}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "another site's exclusion")
+	exclusions[exclusionID].SiteID = 5
+	_, err = svc.DeleteWafRuleExclusion(ctx, connect.NewRequest(&libopsv1.DeleteWafRuleExclusionRequest{SiteId: siteID, ExclusionId: exclusionID}))
+	require.NoError(t, err)
+	assert.Len(t, get().Exclusions, 1)
+
+	require.Len(t, queued, 4)
+	for _, event := range queued {
+		assert.Equal(t, events.EventTypeSiteWafUpdated, event.EventType)
+		assert.Equal(t, int64(5), event.SiteID.Int64)
+	}
+	assert.Equal(t, []string{
+		string(audit.SiteWafUpdate),
+		string(audit.SiteWafExclusionCreate),
+		string(audit.SiteWafExclusionCreate),
+		string(audit.SiteWafExclusionDelete),
+	}, audited)
+}
+
+// TestWafCheckIn tests that the WAF blocks a controller reports on check-in
+// are stored, capped and pruned, and show up in the site's report.
+func TestWafCheckIn(t *testing.T) {
+	siteID := uuid.NewString()
+	var stored []db.CreateSiteWafBlockParams
+	var prunedBefore time.Time
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 5, PublicID: publicID, ProjectID: 2}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		CreateSiteWafBlockFunc: func(ctx context.Context, arg db.CreateSiteWafBlockParams) error {
+			stored = append(stored, arg)
+			return nil
+		},
+		DeleteSiteWafBlocksBeforeFunc: func(ctx context.Context, arg db.DeleteSiteWafBlocksBeforeParams) error {
+			prunedBefore = arg.OccurredAt
+			return nil
+		},
+		ListRecentSiteWafBlocksFunc: func(ctx context.Context, arg db.ListRecentSiteWafBlocksParams) ([]db.SiteWafBlock, error) {
+			assert.Equal(t, int32(maxWafReportRecent), arg.Limit)
+			var recent []db.SiteWafBlock
+			for _, block := range stored[:2] {
+				recent = append(recent, db.SiteWafBlock{SiteID: block.SiteID, RuleID: block.RuleID, Uri: block.Uri, Blocked: block.Blocked, OccurredAt: block.OccurredAt})
+			}
+			return recent, nil
+		},
+	}
+
+	blocks := make([]*libopsv1.WafBlock, 0, maxWafBlocksPerCheckIn+10)
+	for i := 0; i < maxWafBlocksPerCheckIn+10; i++ {
+		blocks = append(blocks, &libopsv1.WafBlock{RuleId: 942100, Method: "GET", Uri: "/?id=1' OR 1=1", Blocked: true, OccurredAt: time.Now().Add(time.Hour).Unix()})
+	}
+	_, err := NewAdminSiteService(mock).SiteCheckIn(context.Background(), connect.NewRequest(&libopsv1.SiteCheckInRequest{
+		SiteId:    siteID,
+		WafBlocks: blocks,
+	}))
+	require.NoError(t, err)
+	assert.Len(t, stored, maxWafBlocksPerCheckIn, "the newest blocks are kept")
+	assert.WithinDuration(t, time.Now(), stored[0].OccurredAt, 5*time.Second, "times from the future are clamped")
+	assert.WithinDuration(t, time.Now().Add(-maxWafReportHours*time.Hour), prunedBefore, 5*time.Second)
+
+	svc := NewWafService(mock, nil, audit.New(mock))
+	_, err = svc.GetWafReport(context.Background(), connect.NewRequest(&libopsv1.GetWafReportRequest{SiteId: siteID, Hours: maxWafReportHours + 1}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	report, err := svc.GetWafReport(context.Background(), connect.NewRequest(&libopsv1.GetWafReportRequest{SiteId: siteID}))
+	require.NoError(t, err)
+	assert.Len(t, report.Msg.Recent, 2)
+	assert.Equal(t, "/?id=1' OR 1=1", report.Msg.Recent[0].Uri)
+}
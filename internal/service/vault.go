@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+	"github.com/libops/api/internal/envelope"
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/vault"
+)
+
+// SecretStore is the part of an organization's Vault secrets are kept in.
+type SecretStore interface {
+	WriteSecret(ctx context.Context, path string, data map[string]any) error
+	ReadSecret(ctx context.Context, path string) (map[string]any, error)
+	DeleteSecret(ctx context.Context, path string) error
+}
+
+// WriteSecret writes data to path in secrets. The value has to be in place
+// before the change recording it commits, so if that change rolls back, what
+// path held before is put back, or path is deleted if it held nothing.
+func WriteSecret(ctx context.Context, secrets SecretStore, path string, data map[string]any) error {
+	if !database.InTx(ctx) {
+		return secrets.WriteSecret(ctx, path, data)
+	}
+
+	previous, err := secrets.ReadSecret(ctx, path)
+	if err != nil && !errors.Is(err, vault.ErrSecretNotFound) {
+		return fmt.Errorf("failed to read the secret being replaced: %w", err)
+	}
+	if err := secrets.WriteSecret(ctx, path, data); err != nil {
+		return err
+	}
+	store.OnRollback(ctx, func(ctx context.Context) {
+		var err error
+		if previous == nil {
+			err = secrets.DeleteSecret(ctx, path)
+		} else {
+			err = secrets.WriteSecret(ctx, path, previous)
+		}
+		if err != nil {
+			slog.Error("Failed to undo secret write after rollback", "path", path, "error", err)
+		}
+	})
+	return nil
+}
+
+// DeleteSecret deletes path from secrets once the change that stops recording
+// it commits, so a rollback doesn't leave a record without its value. A
+// failure leaves the value behind, unreferenced, and is only logged.
+func DeleteSecret(ctx context.Context, secrets SecretStore, path string) {
+	store.AfterCommit(ctx, func(ctx context.Context) {
+		if err := secrets.DeleteSecret(ctx, path); err != nil {
+			slog.Warn("Failed to delete secret from vault", "path", path, "error", err)
+		}
+	})
+}
+
+// provisionedVaults holds the IDs of organizations whose Vault this process
+// has provisioned, so it's checked once rather than on every request.
+var provisionedVaults sync.Map
+
+// OrganizationVaultClient creates a client for the Vault server running in an
+// organization's libops project, provisioning the Vault the first time it's
+// opened. A Vault that can't be provisioned is still returned, so anything
+// already set up in it keeps working.
+func OrganizationVaultClient(ctx context.Context, querier db.Querier, organizationID int64) (*vault.Client, error) {
+	client, err := OpenOrganizationVault(ctx, querier, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := provisionedVaults.Load(organizationID); !ok {
+		if err := provisionOrganizationVault(ctx, querier, client, organizationID); err != nil {
+			slog.Warn("Failed to provision organization vault", "organization_id", organizationID, "error", err)
+		}
+	}
+	return client, nil
+}
+
+// ProvisionOrganizationVault sets up the secrets engines, policy and group
+// an organization's Vault needs. It's safe to run again.
+func ProvisionOrganizationVault(ctx context.Context, querier db.Querier, organizationID int64) error {
+	client, err := OpenOrganizationVault(ctx, querier, organizationID)
+	if err != nil {
+		return err
+	}
+	return provisionOrganizationVault(ctx, querier, client, organizationID)
+}
+
+// DeprovisionOrganizationVault tears down an organization's Vault set up,
+// destroying its secrets. A Vault shared by every organization is left alone.
+func DeprovisionOrganizationVault(ctx context.Context, client *vault.Client, organizationID int64, organizationPublicID string) error {
+	provisionedVaults.Delete(organizationID)
+	if vault.SharedCustomerVault() {
+		return nil
+	}
+	return client.DeprovisionOrganization(ctx, organizationPublicID)
+}
+
+func provisionOrganizationVault(ctx context.Context, querier db.Querier, client *vault.Client, organizationID int64) error {
+	organization, err := querier.GetOrganizationByID(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if err := client.ProvisionOrganization(ctx, organization.PublicID); err != nil {
+		return err
+	}
+	provisionedVaults.Store(organizationID, true)
+	return nil
+}
+
+// OpenOrganizationVault creates a client for an organization's Vault without
+// provisioning it.
+func OpenOrganizationVault(ctx context.Context, querier db.Querier, organizationID int64) (*vault.Client, error) {
+	// Get organization's libops project (where vault server runs)
+	project, err := querier.GetOrganizationProjectByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization project: %w", err)
+	}
+
+	// Parse project number from string
+	var projectNumber int64
+	if project.GcpProjectNumber.Valid {
+		_, _ = fmt.Sscanf(project.GcpProjectNumber.String, "%d", &projectNumber)
+	}
+
+	// Get region from project
+	region := "us-central1" // default
+	if project.GcpRegion.Valid && project.GcpRegion.String != "" {
+		region = project.GcpRegion.String
+	}
+
+	client, err := vault.NewCustomerVaultClient(ctx, organizationID, projectNumber, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create customer vault client: %w", err)
+	}
+
+	return client, nil
+}
+
+// OrganizationSecretStore opens an organization's Vault behind envelope
+// encryption, so secret values are sealed before they're written and opened
+// as they're read.
+func OrganizationSecretStore(ctx context.Context, querier db.Querier, organizationID int64) (*envelope.Store, error) {
+	client, err := OrganizationVaultClient(ctx, querier, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	store, err := envelope.Open(ctx, querier, client, vault.NewTransit(client, vault.SecretsTransitKey), organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open secret store: %w", err)
+	}
+	return store, nil
+}
+
+// RotateOrganizationSecrets rotates the keys of an organization's secrets
+// and re-seals them all with the new data key.
+func RotateOrganizationSecrets(ctx context.Context, querier db.Querier, organizationID, accountID int64) (envelope.Rotation, error) {
+	client, err := OrganizationVaultClient(ctx, querier, organizationID)
+	if err != nil {
+		return envelope.Rotation{}, err
+	}
+	return envelope.Rotate(ctx, querier, client, vault.NewTransit(client, vault.SecretsTransitKey), organizationID, accountID)
+}
+
+// KeyVersionOf returns the data key version a store seals secrets with, NULL
+// for stores that don't seal them.
+func KeyVersionOf(store any) sql.NullInt32 {
+	if sealed, ok := store.(interface{ KeyVersion() int32 }); ok {
+		return sql.NullInt32{Int32: sealed.KeyVersion(), Valid: true}
+	}
+	return sql.NullInt32{}
+}
+
+// WriteManagedSiteSecret writes a secret libops manages for a site to the
+// site's Vault path and records it, taking over any earlier secret of the
+// same name.
+func WriteManagedSiteSecret(ctx context.Context, querier db.Querier, store SecretStore, sitePublicID string, siteID int64, name, value string) error {
+	vaultPath := vault.BuildSiteSecretPath(sitePublicID, name)
+	if err := WriteSecret(ctx, store, vaultPath, map[string]any{"value": value}); err != nil {
+		return fmt.Errorf("failed to write secret %s: %w", name, err)
+	}
+	err := querier.UpsertManagedSiteSecret(ctx, db.UpsertManagedSiteSecretParams{
+		PublicID:   uuid.NewString(),
+		SiteID:     siteID,
+		Name:       name,
+		VaultPath:  vaultPath,
+		KeyVersion: KeyVersionOf(store),
+		Now:        time.Now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record secret %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/internal/store"
+	"github.com/libops/api/internal/vault"
+)
+
+// fakeSecretStore keeps secrets in memory.
+type fakeSecretStore map[string]map[string]any
+
+func (f fakeSecretStore) WriteSecret(ctx context.Context, path string, data map[string]any) error {
+	f[path] = data
+	return nil
+}
+
+func (f fakeSecretStore) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	data, ok := f[path]
+	if !ok {
+		return nil, fmt.Errorf("%w at %s", vault.ErrSecretNotFound, path)
+	}
+	return data, nil
+}
+
+func (f fakeSecretStore) DeleteSecret(ctx context.Context, path string) error {
+	delete(f, path)
+	return nil
+}
+
+// rolledBack runs fn in a transaction on a mocked primary, which fn is
+// expected to roll back.
+func rolledBack(t *testing.T, fn func(ctx context.Context) error) {
+	t.Helper()
+	primary, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer primary.Close()
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	assert.Error(t, store.New(primary).InTx(context.Background(), fn))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestWriteSecret_UndoneOnRollback tests that a write whose change rolls back
+// puts back what the path held, or deletes a value that's new.
+func TestWriteSecret_UndoneOnRollback(t *testing.T) {
+	secrets := fakeSecretStore{"secret/db": {"value": "old"}}
+
+	rolledBack(t, func(ctx context.Context) error {
+		require.NoError(t, WriteSecret(ctx, secrets, "secret/db", map[string]any{"value": "new"}))
+		require.NoError(t, WriteSecret(ctx, secrets, "secret/api", map[string]any{"value": "new"}))
+		assert.Equal(t, "new", secrets["secret/db"]["value"])
+		return errors.New("handler failed")
+	})
+
+	assert.Equal(t, fakeSecretStore{"secret/db": {"value": "old"}}, secrets)
+}
+
+// TestDeleteSecret_AfterCommit tests that a value is only deleted once the
+// change that stops recording it commits.
+func TestDeleteSecret_AfterCommit(t *testing.T) {
+	secrets := fakeSecretStore{"secret/db": {"value": "old"}}
+
+	rolledBack(t, func(ctx context.Context) error {
+		DeleteSecret(ctx, secrets, "secret/db")
+		return errors.New("handler failed")
+	})
+	assert.Contains(t, secrets, "secret/db")
+
+	DeleteSecret(context.Background(), secrets, "secret/db")
+	assert.NotContains(t, secrets, "secret/db")
+}
@@ -0,0 +1,224 @@
+// Package signup adds people to the organizations that claim their email domain,
+// so institution staff can onboard themselves instead of waiting for an owner to
+// add them. An organization either lets them in straight away or has them ask,
+// leaving the decision to its administrators.
+package signup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+)
+
+var (
+	// ErrUnverified is returned when signing up before verifying the account's email
+	ErrUnverified = errors.New("verify your email address before signing up")
+	// ErrNotPending is returned when deciding a join request that was already decided
+	ErrNotPending = errors.New("join request was already decided")
+)
+
+// Organization is an organization someone joined or asked to join
+type Organization struct {
+	ID       int64
+	PublicID string
+	Name     string
+	Role     string
+}
+
+// Result is what signing up did
+type Result struct {
+	Joined  []Organization // Now a member
+	Pending []Organization // Waiting for an administrator's approval
+}
+
+// Joiner adds accounts to the organizations claiming their email domain
+type Joiner struct {
+	db          db.Querier
+	auditLogger *audit.Logger
+}
+
+// NewJoiner creates a joiner
+func NewJoiner(querier db.Querier, auditLogger *audit.Logger) *Joiner {
+	return &Joiner{
+		db:          querier,
+		auditLogger: auditLogger,
+	}
+}
+
+// SignUp joins an account to the organizations claiming its email domain. Joining
+// one replaces creating an organization during onboarding.
+func (j *Joiner) SignUp(ctx context.Context, accountID int64) (*Result, error) {
+	account, err := j.db.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("get account: %w", err)
+	}
+	if !account.Verified {
+		return nil, ErrUnverified
+	}
+
+	result, err := j.join(ctx, account.ID, account.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Joined) > 0 && !account.OnboardingCompleted {
+		if err := j.db.UpdateAccountOnboarding(ctx, db.UpdateAccountOnboardingParams{
+			OnboardingCompleted: true,
+			OnboardingSessionID: account.OnboardingSessionID,
+			ID:                  account.ID,
+		}); err != nil {
+			slog.Error("Failed to complete onboarding after signing up", "account_id", account.ID, "err", err)
+		}
+	}
+	return result, nil
+}
+
+// join adds an account to every organization claiming its email domain that it
+// isn't a member of yet, and asks to join those that require approval. A request
+// that was denied isn't made again.
+func (j *Joiner) join(ctx context.Context, accountID int64, email string) (*Result, error) {
+	result := &Result{}
+	domain := EmailDomain(email)
+	if domain == "" {
+		return result, nil
+	}
+
+	organizations, err := j.db.ListJoinableOrganizations(ctx, db.ListJoinableOrganizationsParams{
+		AccountID: accountID,
+		Domain:    domain,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list joinable organizations: %w", err)
+	}
+
+	for _, o := range organizations {
+		organization := Organization{
+			ID:       o.OrganizationID,
+			PublicID: o.OrganizationPublicID,
+			Name:     o.OrganizationName,
+			Role:     string(o.Role),
+		}
+
+		if !o.ApprovalRequired {
+			if err := j.addMember(ctx, o.OrganizationID, accountID, organization.Role, accountID); err != nil && !isDuplicate(err) {
+				return nil, fmt.Errorf("join organization %s: %w", o.OrganizationPublicID, err)
+			}
+			j.auditLogger.Log(ctx, accountID, o.OrganizationID, audit.OrganizationEntityType, audit.SignupJoin, map[string]any{
+				"domain": domain,
+				"role":   organization.Role,
+			})
+			result.Joined = append(result.Joined, organization)
+			continue
+		}
+
+		switch {
+		case !o.RequestStatus.Valid:
+			err := j.db.CreateOrganizationJoinRequest(ctx, db.CreateOrganizationJoinRequestParams{
+				PublicID:       uuid.New().String(),
+				OrganizationID: o.OrganizationID,
+				AccountID:      accountID,
+				Role:           db.OrganizationJoinRequestsRole(o.Role),
+			})
+			if err != nil && !isDuplicate(err) {
+				return nil, fmt.Errorf("ask to join organization %s: %w", o.OrganizationPublicID, err)
+			}
+			j.auditLogger.Log(ctx, accountID, o.OrganizationID, audit.OrganizationEntityType, audit.SignupRequest, map[string]any{
+				"domain": domain,
+				"role":   organization.Role,
+			})
+			result.Pending = append(result.Pending, organization)
+		case o.RequestStatus.OrganizationJoinRequestsStatus == db.OrganizationJoinRequestsStatusPending:
+			result.Pending = append(result.Pending, organization)
+		}
+	}
+
+	if len(result.Joined) > 0 || len(result.Pending) > 0 {
+		slog.Info("Signed up through email domain", "account_id", accountID, "domain", domain, "joined", len(result.Joined), "pending", len(result.Pending))
+	}
+	return result, nil
+}
+
+// Approve adds the account behind a pending join request as a member with the
+// role its email domain grants.
+func (j *Joiner) Approve(ctx context.Context, request db.GetOrganizationJoinRequestRow, decidedBy int64) error {
+	if request.Status != db.OrganizationJoinRequestsStatusPending {
+		return ErrNotPending
+	}
+	if err := j.addMember(ctx, request.OrganizationID, request.AccountID, string(request.Role), decidedBy); err != nil && !isDuplicate(err) {
+		return fmt.Errorf("add member: %w", err)
+	}
+	return j.decide(ctx, request, db.OrganizationJoinRequestsStatusApproved, decidedBy)
+}
+
+// Deny turns down a pending join request. The account can't ask again.
+func (j *Joiner) Deny(ctx context.Context, request db.GetOrganizationJoinRequestRow, decidedBy int64) error {
+	if request.Status != db.OrganizationJoinRequestsStatusPending {
+		return ErrNotPending
+	}
+	return j.decide(ctx, request, db.OrganizationJoinRequestsStatusDenied, decidedBy)
+}
+
+func (j *Joiner) decide(ctx context.Context, request db.GetOrganizationJoinRequestRow, status db.OrganizationJoinRequestsStatus, decidedBy int64) error {
+	decided, err := j.db.DecideOrganizationJoinRequest(ctx, db.DecideOrganizationJoinRequestParams{
+		Status:    status,
+		DecidedBy: sql.NullInt64{Int64: decidedBy, Valid: true},
+		ID:        request.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("decide join request: %w", err)
+	}
+	if decided == 0 {
+		return ErrNotPending
+	}
+
+	event := audit.JoinRequestApprove
+	if status == db.OrganizationJoinRequestsStatusDenied {
+		event = audit.JoinRequestDeny
+	}
+	j.auditLogger.Log(ctx, decidedBy, request.OrganizationID, audit.OrganizationEntityType, event, map[string]any{
+		"join_request_id": request.PublicID,
+		"account_id":      request.AccountPublicID,
+		"role":            string(request.Role),
+	})
+	return nil
+}
+
+// addMember creates the membership the same way the member services do: developers
+// start out provisioning until their SSH keys reach the sites.
+func (j *Joiner) addMember(ctx context.Context, organizationID, accountID int64, role string, createdBy int64) error {
+	status := db.OrganizationMembersStatusActive
+	if role == string(db.OrganizationMembersRoleDeveloper) {
+		status = db.OrganizationMembersStatusProvisioning
+	}
+	return j.db.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+		OrganizationID: organizationID,
+		AccountID:      accountID,
+		Role:           db.OrganizationMembersRole(role),
+		Status:         db.NullOrganizationMembersStatus{OrganizationMembersStatus: status, Valid: true},
+		CreatedBy:      sql.NullInt64{Int64: createdBy, Valid: true},
+		UpdatedBy:      sql.NullInt64{Int64: createdBy, Valid: true},
+	})
+}
+
+// EmailDomain returns the lowercase domain of an email address, or "" if it has none.
+func EmailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+func isDuplicate(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -0,0 +1,139 @@
+package signup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/testutils"
+)
+
+func TestEmailDomain(t *testing.T) {
+	assert.Equal(t, "mylibrary.edu", EmailDomain("Staff@MyLibrary.edu"))
+	assert.Equal(t, "mylibrary.edu", EmailDomain("odd@name@mylibrary.edu"))
+	assert.Empty(t, EmailDomain("not-an-email"))
+}
+
+func TestSignUp(t *testing.T) {
+	ctx := context.Background()
+	var members []db.CreateOrganizationMemberParams
+	var requests []db.CreateOrganizationJoinRequestParams
+	var onboarded []db.UpdateAccountOnboardingParams
+	verified := true
+	mock := &testutils.MockQuerier{
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, Email: "staff@MyLibrary.edu", Verified: verified}, nil
+		},
+		ListJoinableOrganizationsFunc: func(ctx context.Context, arg db.ListJoinableOrganizationsParams) ([]db.ListJoinableOrganizationsRow, error) {
+			require.Equal(t, "mylibrary.edu", arg.Domain)
+			return []db.ListJoinableOrganizationsRow{
+				{OrganizationID: 1, OrganizationPublicID: "library", OrganizationName: "My Library", Role: db.OrganizationJoinDomainsRoleRead},
+				{OrganizationID: 2, OrganizationPublicID: "archives", OrganizationName: "Archives", Role: db.OrganizationJoinDomainsRoleDeveloper, ApprovalRequired: true},
+				{OrganizationID: 3, OrganizationPublicID: "press", OrganizationName: "Press", Role: db.OrganizationJoinDomainsRoleRead, ApprovalRequired: true,
+					RequestStatus: db.NullOrganizationJoinRequestsStatus{OrganizationJoinRequestsStatus: db.OrganizationJoinRequestsStatusPending, Valid: true}},
+				{OrganizationID: 4, OrganizationPublicID: "museum", OrganizationName: "Museum", Role: db.OrganizationJoinDomainsRoleRead, ApprovalRequired: true,
+					RequestStatus: db.NullOrganizationJoinRequestsStatus{OrganizationJoinRequestsStatus: db.OrganizationJoinRequestsStatusDenied, Valid: true}},
+			}, nil
+		},
+		CreateOrganizationMemberFunc: func(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+			members = append(members, arg)
+			return nil
+		},
+		CreateOrganizationJoinRequestFunc: func(ctx context.Context, arg db.CreateOrganizationJoinRequestParams) error {
+			requests = append(requests, arg)
+			return nil
+		},
+		UpdateAccountOnboardingFunc: func(ctx context.Context, arg db.UpdateAccountOnboardingParams) error {
+			onboarded = append(onboarded, arg)
+			return nil
+		},
+	}
+	joiner := NewJoiner(mock, audit.New(mock))
+
+	result, err := joiner.SignUp(ctx, 7)
+	require.NoError(t, err)
+
+	require.Len(t, result.Joined, 1)
+	assert.Equal(t, "library", result.Joined[0].PublicID)
+	require.Len(t, members, 1)
+	assert.Equal(t, db.OrganizationMembersRoleRead, members[0].Role)
+	assert.Equal(t, db.OrganizationMembersStatusActive, members[0].Status.OrganizationMembersStatus)
+
+	require.Len(t, result.Pending, 2, "a new and an earlier request are pending, a denied one isn't")
+	assert.Equal(t, "archives", result.Pending[0].PublicID)
+	assert.Equal(t, "press", result.Pending[1].PublicID)
+	require.Len(t, requests, 1, "only the new request is made")
+	assert.Equal(t, int64(2), requests[0].OrganizationID)
+	assert.Equal(t, db.OrganizationJoinRequestsRoleDeveloper, requests[0].Role)
+
+	require.Len(t, onboarded, 1, "joining an organization completes onboarding")
+	assert.True(t, onboarded[0].OnboardingCompleted)
+
+	verified = false
+	_, err = joiner.SignUp(ctx, 7)
+	assert.ErrorIs(t, err, ErrUnverified)
+}
+
+func TestSignUpAlreadyMember(t *testing.T) {
+	mock := &testutils.MockQuerier{
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, Email: "staff@mylibrary.edu", Verified: true, OnboardingCompleted: true}, nil
+		},
+		ListJoinableOrganizationsFunc: func(ctx context.Context, arg db.ListJoinableOrganizationsParams) ([]db.ListJoinableOrganizationsRow, error) {
+			return []db.ListJoinableOrganizationsRow{{OrganizationID: 1, OrganizationPublicID: "library", Role: db.OrganizationJoinDomainsRoleRead}}, nil
+		},
+		// Joined concurrently, e.g. through an invitation
+		CreateOrganizationMemberFunc: func(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+			return &mysql.MySQLError{Number: 1062}
+		},
+	}
+
+	result, err := NewJoiner(mock, audit.New(mock)).SignUp(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Len(t, result.Joined, 1)
+}
+
+func TestDecide(t *testing.T) {
+	ctx := context.Background()
+	var members []db.CreateOrganizationMemberParams
+	var decisions []db.DecideOrganizationJoinRequestParams
+	mock := &testutils.MockQuerier{
+		CreateOrganizationMemberFunc: func(ctx context.Context, arg db.CreateOrganizationMemberParams) error {
+			members = append(members, arg)
+			return nil
+		},
+		DecideOrganizationJoinRequestFunc: func(ctx context.Context, arg db.DecideOrganizationJoinRequestParams) (int64, error) {
+			decisions = append(decisions, arg)
+			return 1, nil
+		},
+	}
+	joiner := NewJoiner(mock, audit.New(mock))
+	request := db.GetOrganizationJoinRequestRow{
+		ID:             5,
+		OrganizationID: 2,
+		AccountID:      7,
+		Role:           db.OrganizationJoinRequestsRoleDeveloper,
+		Status:         db.OrganizationJoinRequestsStatusPending,
+	}
+
+	require.NoError(t, joiner.Approve(ctx, request, 1))
+	require.Len(t, members, 1)
+	assert.Equal(t, int64(7), members[0].AccountID)
+	assert.Equal(t, db.OrganizationMembersRoleDeveloper, members[0].Role)
+	assert.Equal(t, db.OrganizationMembersStatusProvisioning, members[0].Status.OrganizationMembersStatus, "developers wait for their SSH keys")
+	assert.Equal(t, int64(1), members[0].CreatedBy.Int64)
+	assert.Equal(t, db.OrganizationJoinRequestsStatusApproved, decisions[0].Status)
+
+	require.NoError(t, joiner.Deny(ctx, request, 1))
+	assert.Len(t, members, 1)
+	assert.Equal(t, db.OrganizationJoinRequestsStatusDenied, decisions[1].Status)
+
+	request.Status = db.OrganizationJoinRequestsStatusDenied
+	assert.ErrorIs(t, joiner.Approve(ctx, request, 1), ErrNotPending)
+	assert.Len(t, members, 1)
+}
@@ -0,0 +1,232 @@
+// Package siteaccess signs people into sites behind a members only gate. The
+// site's nginx sends visitors without a session to the gate, which checks they
+// can read the site and sends them back with a short-lived ticket:
+//
+//	https://<site host>/_libops/access/callback?ticket=<ticket>&return_to=<path>
+//
+// The site's controller verifies the ticket with the site's gate secret and
+// starts a session of its own. A ticket is
+//
+//	base64url(claims JSON) "." base64url(HMAC-SHA256(secret, claims JSON))
+//
+// and the controller signs its session cookies the same way.
+package siteaccess
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+)
+
+const (
+	// ticketTTL is how long a ticket can be traded for a session on the site.
+	ticketTTL = 2 * time.Minute
+
+	// callbackPath is where the site's nginx hands tickets to its controller.
+	callbackPath = "/_libops/access/callback"
+)
+
+// Claims are what a ticket vouches for.
+type Claims struct {
+	SiteID  string `json:"site"`
+	Subject string `json:"sub"` // Account public ID
+	Expires int64  `json:"exp"` // Unix timestamp
+}
+
+// Sign encodes and signs claims with a site's gate secret.
+func Sign(secret string, claims Claims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// GateSecret returns the key a site's gate signs tickets with, creating it
+// the first time the site needs one.
+func GateSecret(ctx context.Context, querier db.Querier, siteID int64) (string, error) {
+	secret, err := querier.GetSiteAccessGateSecret(ctx, siteID)
+	if err != nil {
+		return "", err
+	}
+	if secret.Valid && secret.String != "" {
+		return secret.String, nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	err = querier.InitSiteAccessGateSecret(ctx, db.InitSiteAccessGateSecretParams{
+		AccessGateSecret: sql.NullString{String: hex.EncodeToString(key), Valid: true},
+		ID:               siteID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Another request may have set it first
+	secret, err = querier.GetSiteAccessGateSecret(ctx, siteID)
+	if err != nil {
+		return "", err
+	}
+	if !secret.Valid || secret.String == "" {
+		return "", fmt.Errorf("site %d has no gate secret", siteID)
+	}
+	return secret.String, nil
+}
+
+// GateURL is the gate page of a site on the dashboard at dashBaseURL.
+func GateURL(dashBaseURL, sitePublicID string) string {
+	return fmt.Sprintf("%s/sites/%s/access", strings.TrimSuffix(dashBaseURL, "/"), sitePublicID)
+}
+
+// Gate serves the members only gate.
+type Gate struct {
+	db    db.Querier
+	audit *audit.Logger
+	now   func() time.Time
+}
+
+// NewGate creates a members only gate.
+func NewGate(querier db.Querier, auditLogger *audit.Logger) *Gate {
+	return &Gate{
+		db:    querier,
+		audit: auditLogger,
+		now:   time.Now,
+	}
+}
+
+// HandleAccess sends a signed-in user with read access to the site in the id
+// path value back to the site with a ticket. The return_to query parameter is
+// the URL the user was after; its host has to be one of the site's domains or
+// its VM's address, so tickets can't be sent anywhere else.
+func (g *Gate) HandleAccess(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	ctx := context.WithValue(r.Context(), "http_request", r) //nolint:staticcheck // audit reads the request under this key
+
+	sitePublicID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "Invalid site ID", http.StatusBadRequest)
+		return
+	}
+
+	site, err := g.db.GetSite(ctx, sitePublicID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "Site not found", http.StatusNotFound)
+			return
+		}
+		slog.Error("Failed to get site for access gate", "site_id", sitePublicID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	authorizer, err := auth.GetAuthorizer(ctx)
+	if err != nil {
+		authorizer = auth.NewAuthorizer(g.db)
+	}
+	if err := authorizer.CheckSiteAccess(ctx, userInfo, sitePublicID, auth.PermissionRead); err != nil {
+		g.audit.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.AuthorizationFailure, map[string]any{
+			"action":  "access_gate",
+			"site_id": site.PublicID,
+		})
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	returnTo, err := url.Parse(r.URL.Query().Get("return_to"))
+	if err != nil || (returnTo.Scheme != "http" && returnTo.Scheme != "https") || returnTo.Host == "" {
+		http.Error(w, "Invalid return_to", http.StatusBadRequest)
+		return
+	}
+	if !g.siteHost(ctx, site, returnTo.Hostname()) {
+		http.Error(w, "return_to isn't on this site", http.StatusBadRequest)
+		return
+	}
+
+	account, err := g.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account for access gate", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	secret, err := GateSecret(ctx, g.db, site.ID)
+	if err != nil {
+		slog.Error("Failed to get site gate secret", "site_id", site.PublicID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	ticket, err := Sign(secret, Claims{
+		SiteID:  site.PublicID,
+		Subject: account.PublicID,
+		Expires: g.now().Add(ticketTTL).Unix(),
+	})
+	if err != nil {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	g.audit.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.SiteAccessGateSignIn, map[string]any{
+		"site_id": site.PublicID,
+		"host":    returnTo.Host,
+	})
+
+	path := returnTo.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if returnTo.RawQuery != "" {
+		path += "?" + returnTo.RawQuery
+	}
+	callback := url.URL{
+		Scheme:   returnTo.Scheme,
+		Host:     returnTo.Host,
+		Path:     callbackPath,
+		RawQuery: url.Values{"ticket": {ticket}, "return_to": {path}}.Encode(),
+	}
+	http.Redirect(w, r, callback.String(), http.StatusFound)
+}
+
+// siteHost reports whether host serves the site.
+func (g *Gate) siteHost(ctx context.Context, site db.GetSiteRow, host string) bool {
+	host = strings.ToLower(host)
+	if ip := net.ParseIP(host); ip != nil {
+		return site.GcpExternalIp.Valid && ip.Equal(net.ParseIP(site.GcpExternalIp.String))
+	}
+
+	domain, err := g.db.GetDomainByName(ctx, host)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to look up domain for access gate", "host", host, "err", err)
+		}
+		return false
+	}
+	return domain.SiteID == site.ID
+}
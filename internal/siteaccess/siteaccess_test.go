@@ -0,0 +1,145 @@
+package siteaccess
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+const (
+	testSiteID    = "0194d3a0-0000-7000-8000-000000000001"
+	testAccountID = "0194d3a0-0000-7000-8000-0000000000aa"
+)
+
+// newTestGate creates a gate for a site at staging.example.edu and 34.1.2.3,
+// with a member holding role; an empty role isn't a member.
+func newTestGate(role db.SiteMembersRole) (*Gate, *[]string) {
+	var audited []string
+	secret := sql.NullString{}
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			return db.GetSiteRow{ID: 7, ProjectID: 3, PublicID: publicID, GcpExternalIp: sql.NullString{String: "34.1.2.3", Valid: true}}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteMemberFunc: func(ctx context.Context, arg db.GetSiteMemberParams) (db.GetSiteMemberRow, error) {
+			if role == "" {
+				return db.GetSiteMemberRow{}, sql.ErrNoRows
+			}
+			return db.GetSiteMemberRow{Role: role}, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, PublicID: testAccountID}, nil
+		},
+		GetDomainByNameFunc: func(ctx context.Context, domain string) (db.Domain, error) {
+			switch domain {
+			case "staging.example.edu":
+				return db.Domain{SiteID: 7, Domain: domain}, nil
+			case "other.example.edu":
+				return db.Domain{SiteID: 8, Domain: domain}, nil
+			}
+			return db.Domain{}, sql.ErrNoRows
+		},
+		GetSiteAccessGateSecretFunc: func(ctx context.Context, id int64) (sql.NullString, error) {
+			return secret, nil
+		},
+		InitSiteAccessGateSecretFunc: func(ctx context.Context, arg db.InitSiteAccessGateSecretParams) error {
+			if !secret.Valid {
+				secret = arg.AccessGateSecret
+			}
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			audited = append(audited, arg.EventName)
+			return nil
+		},
+	}
+	return NewGate(mock, audit.New(mock)), &audited
+}
+
+func access(gate *Gate, returnTo string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/sites/"+testSiteID+"/access?"+url.Values{"return_to": {returnTo}}.Encode(), nil)
+	req.SetPathValue("id", testSiteID)
+	req = req.WithContext(context.WithValue(req.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: 5}))
+	w := httptest.NewRecorder()
+	gate.HandleAccess(w, req)
+	return w
+}
+
+// TestHandleAccess tests that members are sent back to the site they came from
+// with a ticket signed by the site's gate secret.
+func TestHandleAccess(t *testing.T) {
+	gate, audited := newTestGate(db.SiteMembersRoleRead)
+	secret, err := GateSecret(context.Background(), gate.db, 7)
+	require.NoError(t, err)
+
+	w := access(gate, "https://staging.example.edu/collections/1?page=2&sort=title")
+	require.Equal(t, http.StatusFound, w.Code)
+	callback, err := url.Parse(w.Header().Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.example.edu/_libops/access/callback", callback.Scheme+"://"+callback.Host+callback.Path)
+	assert.Equal(t, "/collections/1?page=2&sort=title", callback.Query().Get("return_to"))
+
+	payload, mac, ok := strings.Cut(callback.Query().Get("ticket"), ".")
+	require.True(t, ok)
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(payload)
+	require.NoError(t, err)
+	expected := hmac.New(sha256.New, []byte(secret))
+	expected.Write(claimsJSON)
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(expected.Sum(nil)), mac, "the ticket is signed with the site's secret")
+
+	var claims Claims
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	assert.Equal(t, testSiteID, claims.SiteID)
+	assert.Equal(t, testAccountID, claims.Subject)
+	assert.WithinDuration(t, time.Now().Add(ticketTTL), time.Unix(claims.Expires, 0), 5*time.Second)
+
+	w = access(gate, "http://34.1.2.3/")
+	assert.Equal(t, http.StatusFound, w.Code, "the site's VM address is the site too")
+
+	again, err := GateSecret(context.Background(), gate.db, 7)
+	require.NoError(t, err)
+	assert.Equal(t, secret, again, "the secret is created once")
+	assert.Equal(t, []string{string(audit.SiteAccessGateSignIn), string(audit.SiteAccessGateSignIn)}, *audited)
+}
+
+// TestHandleAccessRejected tests that tickets only go to the site's own hosts
+// and only to people who can read the site.
+func TestHandleAccessRejected(t *testing.T) {
+	gate, audited := newTestGate(db.SiteMembersRoleRead)
+	for _, returnTo := range []string{
+		"https://other.example.edu/",
+		"https://evil.example.com/",
+		"https://34.1.2.4/",
+		"/relative",
+		"javascript:alert(1)",
+	} {
+		w := access(gate, returnTo)
+		assert.Equal(t, http.StatusBadRequest, w.Code, returnTo)
+		assert.Empty(t, w.Header().Get("Location"), returnTo)
+	}
+	assert.Empty(t, *audited)
+
+	gate, audited = newTestGate("")
+	w := access(gate, "https://staging.example.edu/")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, []string{string(audit.AuthorizationFailure)}, *audited)
+}
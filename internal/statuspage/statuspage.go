@@ -0,0 +1,89 @@
+// Package statuspage serves organization status pages on their own hosts,
+// status.<slug>.<domain>, and builds the URLs they are reachable at.
+package statuspage
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// slugPattern matches a DNS label
+var slugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// reservedSlugs could be mistaken for LibOps itself
+var reservedSlugs = map[string]bool{
+	"admin":  true,
+	"api":    true,
+	"dash":   true,
+	"libops": true,
+	"status": true,
+	"www":    true,
+}
+
+// ValidateSlug checks that a slug can be used as the page's host label
+func ValidateSlug(slug string) error {
+	if !slugPattern.MatchString(slug) {
+		return fmt.Errorf("slug must be 1-63 lowercase letters, digits or hyphens, and not start or end with a hyphen")
+	}
+	if reservedSlugs[slug] {
+		return fmt.Errorf("slug %q is reserved", slug)
+	}
+	return nil
+}
+
+// URL returns where a status page is served: its own host under domain, or the
+// dashboard's /status/{slug} path when no domain is configured.
+func URL(domain, dashBaseURL, slug string) string {
+	if domain != "" {
+		return "https://status." + slug + "." + domain
+	}
+	return strings.TrimSuffix(dashBaseURL, "/") + "/status/" + slug
+}
+
+// SlugFromHost returns the slug of a status.<slug>.<domain> host
+func SlugFromHost(host, domain string) (string, bool) {
+	if domain == "" {
+		return "", false
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	label, ok := strings.CutPrefix(host, "status.")
+	if !ok {
+		return "", false
+	}
+	slug, ok := strings.CutSuffix(label, "."+strings.ToLower(domain))
+	if !ok || !slugPattern.MatchString(slug) {
+		return "", false
+	}
+	return slug, true
+}
+
+// HostMiddleware serves status pages on their own hosts. The root of a status
+// host is rewritten to /status/{slug}; only static assets are served besides it.
+func HostMiddleware(next http.Handler, domain string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug, ok := SlugFromHost(r.Host, domain)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/":
+			r2 := r.Clone(r.Context())
+			r2.URL.Path = "/status/" + slug
+			r2.URL.RawPath = ""
+			next.ServeHTTP(w, r2)
+		case strings.HasPrefix(r.URL.Path, "/static/"):
+			next.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
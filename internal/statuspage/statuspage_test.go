@@ -0,0 +1,101 @@
+package statuspage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateSlug tests that slugs are DNS labels and cannot impersonate LibOps.
+func TestValidateSlug(t *testing.T) {
+	tests := []struct {
+		slug      string
+		wantError bool
+	}{
+		{"acme", false},
+		{"acme-library", false},
+		{"a", false},
+		{"", true},
+		{"-acme", true},
+		{"acme-", true},
+		{"Acme", true},
+		{"acme.library", true},
+		{"www", true},
+		{"0123456789012345678901234567890123456789012345678901234567890123", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			err := ValidateSlug(tt.slug)
+			if (err != nil) != tt.wantError {
+				t.Errorf("ValidateSlug(%q) error = %v, wantError %v", tt.slug, err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestSlugFromHost tests that only status.<slug>.<domain> hosts map to a slug.
+func TestSlugFromHost(t *testing.T) {
+	tests := []struct {
+		host   string
+		domain string
+		want   string
+		wantOK bool
+	}{
+		{"status.acme.libops.site", "libops.site", "acme", true},
+		{"STATUS.Acme.libops.site:443", "libops.site", "acme", true},
+		{"acme.libops.site", "libops.site", "", false},
+		{"status.acme.libops.site.evil.com", "libops.site", "", false},
+		{"status.a.b.libops.site", "libops.site", "", false},
+		{"status.acme.libops.site", "", "", false},
+		{"dash.libops.io", "libops.site", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			got, ok := SlugFromHost(tt.host, tt.domain)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestHostMiddleware tests that a status host only serves its page and static assets.
+func TestHostMiddleware(t *testing.T) {
+	var gotPath string
+	handler := HostMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+	}), "libops.site")
+
+	tests := []struct {
+		host       string
+		path       string
+		wantPath   string
+		wantStatus int
+	}{
+		{"status.acme.libops.site", "/", "/status/acme", http.StatusOK},
+		{"status.acme.libops.site", "/static/css/output.css", "/static/css/output.css", http.StatusOK},
+		{"status.acme.libops.site", "/dashboard", "", http.StatusNotFound},
+		{"dash.libops.io", "/dashboard", "/dashboard", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host+tt.path, func(t *testing.T) {
+			gotPath = ""
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantPath, gotPath)
+		})
+	}
+}
+
+// TestURL tests that pages link to their own host, or the dashboard without a domain.
+func TestURL(t *testing.T) {
+	assert.Equal(t, "https://status.acme.libops.site", URL("libops.site", "https://dash.libops.io", "acme"))
+	assert.Equal(t, "https://dash.libops.io/status/acme", URL("", "https://dash.libops.io/", "acme"))
+}
@@ -0,0 +1,147 @@
+// Package store makes groups of writes atomic. A resource change and the
+// events it emits commit in one transaction, so the event-router never misses
+// a change that was made, or sees one that was rolled back.
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/libops/api/internal/database"
+)
+
+// Store runs functions in transactions on the primary database.
+type Store struct {
+	primary *sql.DB
+}
+
+// New creates a Store that starts its transactions on primary.
+func New(primary *sql.DB) *Store {
+	return &Store{primary: primary}
+}
+
+// InTx runs fn in a transaction. Queries made with the context fn is given,
+// through a querier built on a database.Router, are part of the transaction,
+// which commits when fn returns nil and rolls back otherwise, or when a write
+// made in it called Fail. Calls made inside a transaction join it rather than
+// starting their own.
+//
+// Once the transaction ends, the functions registered with AfterCommit or
+// OnRollback run with ctx, outside it.
+func (s *Store) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if database.InTx(ctx) {
+		return fn(ctx)
+	}
+
+	tx, err := s.primary.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	hooks := &txHooks{}
+	txCtx, end := database.WithTx(context.WithValue(ctx, hooksKey{}, hooks), tx)
+	committed := false
+	defer func() {
+		end()
+		if !committed {
+			// Undoes fn, including when it panics
+			_ = tx.Rollback()
+		}
+		hooks.run(ctx, committed)
+	}()
+
+	if err := fn(txCtx); err != nil {
+		return err
+	}
+	if err := hooks.failure(); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+	return nil
+}
+
+type hooksKey struct{}
+
+// txHooks is what a transaction started by InTx does once it ends.
+type txHooks struct {
+	mu          sync.Mutex
+	afterCommit []func(ctx context.Context)
+	onRollback  []func(ctx context.Context)
+	err         error
+}
+
+func (h *txHooks) failure() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
+
+// run runs the functions for how the transaction ended.
+func (h *txHooks) run(ctx context.Context, committed bool) {
+	h.mu.Lock()
+	fns := slices.Clone(h.onRollback)
+	if committed {
+		fns = slices.Clone(h.afterCommit)
+	}
+	h.mu.Unlock()
+	for _, fn := range fns {
+		fn(ctx)
+	}
+}
+
+func hooksFrom(ctx context.Context) *txHooks {
+	if !database.InTx(ctx) {
+		return nil
+	}
+	hooks, _ := ctx.Value(hooksKey{}).(*txHooks)
+	return hooks
+}
+
+// AfterCommit runs fn once the transaction ctx is part of commits, and never
+// if it rolls back. Outside a transaction fn runs at once. Use it for what
+// mustn't be seen before the change is, like cache invalidation, or can't be
+// undone, like calls to other systems.
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	hooks := hooksFrom(ctx)
+	if hooks == nil {
+		fn(ctx)
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.afterCommit = append(hooks.afterCommit, fn)
+}
+
+// OnRollback runs fn if the transaction ctx is part of rolls back, to undo a
+// change made outside it. Outside a transaction there is nothing to undo, and
+// fn never runs.
+func OnRollback(ctx context.Context, fn func(ctx context.Context)) {
+	hooks := hooksFrom(ctx)
+	if hooks == nil {
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.onRollback = append(hooks.onRollback, fn)
+}
+
+// Fail makes the transaction ctx is part of roll back with err, even if its
+// function returns nil, for writes whose callers carry on when they fail but
+// that must not commit without them. The first failure wins. Outside a
+// transaction it does nothing.
+func Fail(ctx context.Context, err error) {
+	hooks := hooksFrom(ctx)
+	if hooks == nil {
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	if hooks.err == nil {
+		hooks.err = err
+	}
+}
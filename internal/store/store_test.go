@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/database"
+)
+
+// newStore creates a Store and a querier over a router on the same mocked
+// primary.
+func newStore(t *testing.T) (*Store, db.Querier, sqlmock.Sqlmock) {
+	t.Helper()
+	primary, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = primary.Close() })
+
+	return New(primary), db.New(database.NewRouter(primary, nil)), mock
+}
+
+// TestInTxCommits tests that writes made through the querier in fn commit together.
+func TestInTxCommits(t *testing.T) {
+	s, queries, mock := newStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := s.InTx(context.Background(), func(ctx context.Context) error {
+		assert.True(t, database.InTx(ctx))
+		return queries.EnqueueEvent(ctx, db.EnqueueEventParams{EventID: "evt-1"})
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestInTxRollsBack tests that an error from fn rolls its writes back and is
+// returned as is.
+func TestInTxRollsBack(t *testing.T) {
+	s, queries, mock := newStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	failed := errors.New("handler failed")
+	err := s.InTx(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, queries.EnqueueEvent(ctx, db.EnqueueEventParams{EventID: "evt-1"}))
+		return failed
+	})
+
+	assert.ErrorIs(t, err, failed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestInTxNested tests that a nested call joins the outer transaction.
+func TestInTxNested(t *testing.T) {
+	s, _, mock := newStore(t)
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+
+	err := s.InTx(context.Background(), func(ctx context.Context) error {
+		return s.InTx(ctx, func(ctx context.Context) error { return nil })
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestInTxHooks tests that AfterCommit and OnRollback run, outside the
+// transaction, only for the outcome they were registered for.
+func TestInTxHooks(t *testing.T) {
+	for _, commit := range []bool{true, false} {
+		s, _, mock := newStore(t)
+		mock.ExpectBegin()
+		if commit {
+			mock.ExpectCommit()
+		} else {
+			mock.ExpectRollback()
+		}
+
+		var ran []string
+		record := func(name string) func(context.Context) {
+			return func(ctx context.Context) {
+				assert.False(t, database.InTx(ctx))
+				ran = append(ran, name)
+			}
+		}
+		_ = s.InTx(context.Background(), func(ctx context.Context) error {
+			AfterCommit(ctx, record("after commit"))
+			OnRollback(ctx, record("on rollback"))
+			assert.Empty(t, ran)
+			if !commit {
+				return errors.New("handler failed")
+			}
+			return nil
+		})
+
+		if commit {
+			assert.Equal(t, []string{"after commit"}, ran)
+		} else {
+			assert.Equal(t, []string{"on rollback"}, ran)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+// TestHooksOutsideTx tests that AfterCommit runs at once outside a transaction,
+// and OnRollback never does.
+func TestHooksOutsideTx(t *testing.T) {
+	var ran []string
+	AfterCommit(context.Background(), func(context.Context) { ran = append(ran, "after commit") })
+	OnRollback(context.Background(), func(context.Context) { ran = append(ran, "on rollback") })
+	Fail(context.Background(), errors.New("ignored"))
+
+	assert.Equal(t, []string{"after commit"}, ran)
+}
+
+// TestInTxFail tests that a failure recorded with Fail rolls the transaction
+// back even though fn returns nil.
+func TestInTxFail(t *testing.T) {
+	s, queries, mock := newStore(t)
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_outbox").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	failed := errors.New("event not queued")
+	err := s.InTx(context.Background(), func(ctx context.Context) error {
+		require.NoError(t, queries.EnqueueEvent(ctx, db.EnqueueEventParams{EventID: "evt-1"}))
+		Fail(ctx, failed)
+		return nil
+	})
+
+	assert.ErrorIs(t, err, failed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,436 @@
+// Package terminal serves browser terminal sessions on site VMs. The dashboard
+// opens a websocket to the API, which provisions a short-lived SSH key for the
+// user, connects to the site's VM over SSH as the user's account and relays a
+// PTY between the two.
+//
+// The browser sends JSON messages:
+//
+//	{"type": "input", "data": "ls\r"}
+//	{"type": "resize", "cols": 120, "rows": 40}
+//
+// and receives the terminal's output as binary messages.
+package terminal
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+)
+
+const (
+	// keyTTL bounds how long a terminal key is served to VMs when the session
+	// can't remove it itself, e.g. because the API restarted mid-connect.
+	keyTTL = 10 * time.Minute
+
+	// maxSessionDuration closes sessions left open in a forgotten tab.
+	maxSessionDuration = 2 * time.Hour
+
+	// defaultConnectTimeout is how long to wait for the VM to pick up the key.
+	defaultConnectTimeout = 90 * time.Second
+
+	defaultRetryInterval = 3 * time.Second
+
+	// maxMessageSize limits browser messages; pastes larger than this are dropped
+	// with the connection.
+	maxMessageSize = 64 * 1024
+
+	defaultCols = 80
+	defaultRows = 24
+)
+
+var errSessionExpired = errors.New("session reached its maximum duration")
+
+// KeyReconciler pushes SSH key changes to a site's VM.
+type KeyReconciler interface {
+	TriggerReconciliation(siteID int64, reconciliationType string) error
+}
+
+// clientMessage is a message from the browser terminal
+type clientMessage struct {
+	Type string `json:"type"` // "input" or "resize"
+	Data string `json:"data,omitempty"`
+	Cols int    `json:"cols,omitempty"`
+	Rows int    `json:"rows,omitempty"`
+}
+
+// Proxy serves browser terminal sessions.
+type Proxy struct {
+	db             db.Querier
+	keys           KeyReconciler
+	audit          *audit.Logger
+	upgrader       websocket.Upgrader
+	sshPort        string
+	dial           func(ctx context.Context, addr string, config *ssh.ClientConfig) (*ssh.Client, error)
+	connectTimeout time.Duration
+	retryInterval  time.Duration
+}
+
+// NewProxy creates a terminal proxy. keys may be nil when no VM controllers
+// connect to this API, in which case VMs only see new keys on their next
+// scheduled reconciliation.
+func NewProxy(querier db.Querier, keys KeyReconciler, auditLogger *audit.Logger) *Proxy {
+	return &Proxy{
+		db:    querier,
+		keys:  keys,
+		audit: auditLogger,
+		// The session cookie authenticates the upgrade, so only the dashboard's
+		// own origin may open one (the default origin check).
+		upgrader: websocket.Upgrader{
+			HandshakeTimeout: 10 * time.Second,
+		},
+		sshPort:        "22",
+		dial:           dialSSH,
+		connectTimeout: defaultConnectTimeout,
+		retryInterval:  defaultRetryInterval,
+	}
+}
+
+// HandleTerminal upgrades the request to a websocket and opens a shell on the
+// VM of the site in the id path value. The user needs write access to the site,
+// the same access that gets their own SSH keys onto its VM. The optional cols
+// and rows query parameters size the terminal.
+func (p *Proxy) HandleTerminal(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := auth.GetUserFromContext(r.Context())
+	if !ok || userInfo == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	ctx := context.WithValue(r.Context(), "http_request", r) //nolint:staticcheck // audit reads the request under this key
+
+	siteID := r.PathValue("id")
+	sitePublicID, err := uuid.Parse(siteID)
+	if err != nil {
+		http.Error(w, "Invalid site ID", http.StatusBadRequest)
+		return
+	}
+
+	site, err := p.db.GetSite(ctx, sitePublicID.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "Site not found", http.StatusNotFound)
+			return
+		}
+		slog.Error("Failed to get site for terminal", "site_id", siteID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	authorizer, err := auth.GetAuthorizer(ctx)
+	if err != nil {
+		authorizer = auth.NewAuthorizer(p.db)
+	}
+	if err := authorizer.CheckSiteAccess(ctx, userInfo, sitePublicID, auth.PermissionWrite); err != nil {
+		p.audit.Log(ctx, userInfo.AccountID, site.ID, audit.SiteEntityType, audit.AuthorizationFailure, map[string]any{
+			"action":  "terminal",
+			"site_id": site.PublicID,
+		})
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	if !site.GcpExternalIp.Valid || site.GcpExternalIp.String == "" {
+		http.Error(w, "Site has no running VM", http.StatusConflict)
+		return
+	}
+
+	account, err := p.db.GetAccountByID(ctx, userInfo.AccountID)
+	if err != nil {
+		slog.Error("Failed to get account for terminal", "account_id", userInfo.AccountID, "err", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	conn, err := p.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied
+		slog.Warn("Terminal websocket upgrade failed", "site_id", siteID, "err", err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxMessageSize)
+
+	cols := queryInt(r, "cols", defaultCols)
+	rows := queryInt(r, "rows", defaultRows)
+	p.serve(ctx, conn, userInfo.AccountID, account.PublicID, site, cols, rows)
+}
+
+// serve runs one terminal session and records its start and end in the audit log.
+func (p *Proxy) serve(ctx context.Context, conn *websocket.Conn, accountID int64, username string, site db.GetSiteRow, cols, rows int) {
+	out := &output{conn: conn}
+
+	client, hostKey, err := p.connect(ctx, out, accountID, username, site)
+	if err != nil {
+		slog.Warn("Terminal connection failed", "site_id", site.PublicID, "account_id", accountID, "err", err)
+		p.audit.Log(ctx, accountID, site.ID, audit.SiteEntityType, audit.TerminalSessionFailure, map[string]any{
+			"site_id": site.PublicID,
+			"error":   err.Error(),
+		})
+		out.status("Could not connect to the site's VM. Try again in a minute.")
+		closeConn(conn, websocket.CloseInternalServerErr, "connection failed")
+		return
+	}
+	defer client.Close()
+
+	started := time.Now()
+	p.audit.Log(ctx, accountID, site.ID, audit.SiteEntityType, audit.TerminalSessionStart, map[string]any{
+		"site_id":  site.PublicID,
+		"vm":       site.GcpExternalIp.String,
+		"username": username,
+		"host_key": hostKey,
+	})
+
+	bytesIn, err := relay(ctx, conn, out, client, cols, rows)
+
+	data := map[string]any{
+		"site_id":          site.PublicID,
+		"duration_seconds": int64(time.Since(started).Seconds()),
+		"bytes_in":         bytesIn,
+		"bytes_out":        out.written.Load(),
+	}
+	if err != nil {
+		data["reason"] = err.Error()
+	}
+	p.audit.Log(ctx, accountID, site.ID, audit.SiteEntityType, audit.TerminalSessionEnd, data)
+	closeConn(conn, websocket.CloseNormalClosure, "session ended")
+}
+
+// connect provisions a terminal key for the account, waits for the site's VM to
+// pick it up and opens an SSH connection with it. The key is removed again once
+// the connection is authenticated, or when connecting fails. It returns the
+// client and the VM's host key fingerprint.
+func (p *Proxy) connect(ctx context.Context, out *output, accountID int64, username string, site db.GetSiteRow) (*ssh.Client, string, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, "", fmt.Errorf("generate key: %w", err)
+	}
+	signer, err := ssh.NewSignerFromKey(private)
+	if err != nil {
+		return nil, "", fmt.Errorf("create signer: %w", err)
+	}
+
+	keyID := uuid.New().String()
+	publicKey := signer.PublicKey()
+	err = p.db.CreateEphemeralSshKey(ctx, db.CreateEphemeralSshKeyParams{
+		PublicID:    keyID,
+		AccountID:   accountID,
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))),
+		Name:        sql.NullString{String: "libops terminal", Valid: true},
+		Fingerprint: sql.NullString{String: ssh.FingerprintSHA256(publicKey), Valid: true},
+		ExpiresAt:   sql.NullTime{Time: time.Now().Add(keyTTL), Valid: true},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("create terminal key: %w", err)
+	}
+	defer p.removeKey(context.WithoutCancel(ctx), keyID, site.ID)
+	p.reconcileKeys(site.ID)
+
+	// VMs are recreated with new host keys and the API keeps no record of them,
+	// so the host key is recorded in the audit log rather than verified.
+	var hostKey string
+	config := &ssh.ClientConfig{
+		User: username,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			hostKey = ssh.FingerprintSHA256(key)
+			return nil
+		},
+		Timeout: 10 * time.Second,
+	}
+
+	addr := net.JoinHostPort(site.GcpExternalIp.String, p.sshPort)
+	out.status("Connecting to " + site.Name + "...")
+	deadline := time.Now().Add(p.connectTimeout)
+	for attempt := 1; ; attempt++ {
+		client, err := p.dial(ctx, addr, config)
+		if err == nil {
+			return client, hostKey, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, "", fmt.Errorf("connect to %s after %d attempts: %w", addr, attempt, err)
+		}
+		if attempt == 1 {
+			out.status("Waiting for the VM to pick up your access...")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		case <-time.After(p.retryInterval):
+		}
+	}
+}
+
+// removeKey deletes a terminal key and removes it from the site's VM. Keys left
+// behind expire on their own.
+func (p *Proxy) removeKey(ctx context.Context, keyID string, siteID int64) {
+	if err := p.db.DeleteSshKey(ctx, keyID); err != nil {
+		slog.Error("Failed to delete terminal key", "key_id", keyID, "err", err)
+		return
+	}
+	p.reconcileKeys(siteID)
+}
+
+func (p *Proxy) reconcileKeys(siteID int64) {
+	if p.keys == nil {
+		return
+	}
+	if err := p.keys.TriggerReconciliation(siteID, "ssh_keys"); err != nil {
+		slog.Warn("Failed to trigger SSH key reconciliation", "site_id", siteID, "err", err)
+	}
+}
+
+// relay runs a shell on the client and copies between it and the websocket until
+// either side closes. It returns how many bytes the browser sent.
+func relay(ctx context.Context, conn *websocket.Conn, out *output, client *ssh.Client, cols, rows int) (int64, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return 0, fmt.Errorf("open session: %w", err)
+	}
+	defer session.Close()
+
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	if err := session.RequestPty("xterm-256color", rows, cols, modes); err != nil {
+		return 0, fmt.Errorf("request pty: %w", err)
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return 0, fmt.Errorf("open stdin: %w", err)
+	}
+	session.Stdout = out
+	session.Stderr = out
+	if err := session.Shell(); err != nil {
+		return 0, fmt.Errorf("start shell: %w", err)
+	}
+
+	var bytesIn atomic.Int64
+	exited := make(chan error, 1)
+	go func() { exited <- session.Wait() }()
+	closed := make(chan error, 1)
+	go func() { closed <- readInput(conn, session, stdin, &bytesIn) }()
+
+	timer := time.NewTimer(maxSessionDuration)
+	defer timer.Stop()
+
+	select {
+	case err = <-exited:
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			// A non-zero exit status of the shell is the user's business
+			err = nil
+		}
+	case err = <-closed:
+	case <-timer.C:
+		out.status("This session reached its maximum duration.")
+		err = errSessionExpired
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return bytesIn.Load(), err
+}
+
+// readInput forwards the browser's keystrokes and window size changes to the
+// session until the websocket closes.
+func readInput(conn *websocket.Conn, session *ssh.Session, stdin io.Writer, bytesIn *atomic.Int64) error {
+	for {
+		var msg clientMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			return fmt.Errorf("read from browser: %w", err)
+		}
+		switch msg.Type {
+		case "input":
+			n, err := io.WriteString(stdin, msg.Data)
+			bytesIn.Add(int64(n))
+			if err != nil {
+				return fmt.Errorf("write to session: %w", err)
+			}
+		case "resize":
+			if msg.Cols > 0 && msg.Rows > 0 {
+				if err := session.WindowChange(msg.Rows, msg.Cols); err != nil {
+					slog.Debug("Terminal resize failed", "err", err)
+				}
+			}
+		}
+	}
+}
+
+// output writes terminal output to the websocket as binary messages. The
+// session's stdout and stderr are copied concurrently, so writes are serialized.
+type output struct {
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	written atomic.Int64
+}
+
+func (o *output) Write(b []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.conn.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	o.written.Add(int64(len(b)))
+	return len(b), nil
+}
+
+// status writes a line from the proxy itself, dimmed so it stands apart from
+// the VM's output.
+func (o *output) status(message string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_ = o.conn.WriteMessage(websocket.BinaryMessage, []byte("\x1b[2m"+message+"\x1b[0m\r\n"))
+}
+
+func closeConn(conn *websocket.Conn, code int, text string) {
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+}
+
+// dialSSH opens an SSH connection that gives up when ctx is done or the
+// handshake takes longer than config.Timeout.
+func dialSSH(ctx context.Context, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	dialer := net.Dialer{Timeout: config.Timeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	_ = netConn.SetDeadline(time.Now().Add(config.Timeout))
+	c, chans, reqs, err := ssh.NewClientConn(netConn, addr, config)
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	_ = netConn.SetDeadline(time.Time{})
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+func queryInt(r *http.Request, name string, fallback int) int {
+	n, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || n <= 0 || n > 1000 {
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,226 @@
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/audit"
+	"github.com/libops/api/internal/auth"
+	"github.com/libops/api/internal/testutils"
+)
+
+const (
+	testSiteID    = "0194d3a0-0000-7000-8000-000000000001"
+	testAccountID = "0194d3a0-0000-7000-8000-0000000000aa"
+)
+
+type fakeReconciler struct{}
+
+func (fakeReconciler) TriggerReconciliation(siteID int64, reconciliationType string) error {
+	return nil
+}
+
+// recorder collects what a session did to the database
+type recorder struct {
+	mu      sync.Mutex
+	key     db.CreateEphemeralSshKeyParams
+	deleted []string
+	events  []string
+}
+
+func (r *recorder) eventNames() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+// newTestProxy creates a proxy for a member with role, connecting to the VM at
+// vmAddr. Provisioned keys are sent to authKeys.
+func newTestProxy(role db.SiteMembersRole, vmAddr string, authKeys chan<- string) (*Proxy, *recorder) {
+	rec := &recorder{}
+	mock := &testutils.MockQuerier{
+		GetSiteFunc: func(ctx context.Context, publicID string) (db.GetSiteRow, error) {
+			host, _, _ := net.SplitHostPort(vmAddr)
+			site := db.GetSiteRow{ID: 7, ProjectID: 3, PublicID: publicID, Name: "catalog"}
+			site.GcpExternalIp.String, site.GcpExternalIp.Valid = host, true
+			return site, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id int64) (db.GetProjectByIDRow, error) {
+			return db.GetProjectByIDRow{ID: id, OrganizationID: 1}, nil
+		},
+		GetSiteMemberFunc: func(ctx context.Context, arg db.GetSiteMemberParams) (db.GetSiteMemberRow, error) {
+			return db.GetSiteMemberRow{Role: role}, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id int64) (db.GetAccountByIDRow, error) {
+			return db.GetAccountByIDRow{ID: id, PublicID: testAccountID}, nil
+		},
+		CreateEphemeralSshKeyFunc: func(ctx context.Context, arg db.CreateEphemeralSshKeyParams) error {
+			rec.mu.Lock()
+			rec.key = arg
+			rec.mu.Unlock()
+			authKeys <- arg.PublicKey
+			return nil
+		},
+		DeleteSshKeyFunc: func(ctx context.Context, publicID string) error {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.deleted = append(rec.deleted, publicID)
+			return nil
+		},
+		CreateAuditEventFunc: func(ctx context.Context, arg db.CreateAuditEventParams) error {
+			rec.mu.Lock()
+			defer rec.mu.Unlock()
+			rec.events = append(rec.events, arg.EventName)
+			return nil
+		},
+	}
+
+	proxy := NewProxy(mock, fakeReconciler{}, audit.New(mock))
+	_, proxy.sshPort, _ = net.SplitHostPort(vmAddr)
+	proxy.connectTimeout = 5 * time.Second
+	proxy.retryInterval = 50 * time.Millisecond
+	return proxy, rec
+}
+
+// serveVM runs an SSH server that accepts the account's provisioned key and echoes
+// the shell's input back in upper case.
+func serveVM(t *testing.T, authKeys <-chan string) string {
+	_, hostPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewSignerFromKey(hostPrivate)
+	require.NoError(t, err)
+
+	var allowed []byte
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			select {
+			case k := <-authKeys:
+				parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(k))
+				require.NoError(t, err)
+				allowed = parsed.Marshal()
+			default:
+			}
+			if conn.User() == testAccountID && bytes.Equal(key.Marshal(), allowed) {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go handleVMConn(netConn, config)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func handleVMConn(netConn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(netConn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				_ = req.Reply(req.Type == "pty-req" || req.Type == "shell" || req.Type == "window-change", nil)
+			}
+		}()
+		go func() {
+			defer channel.Close()
+			buf := make([]byte, 256)
+			for {
+				n, err := channel.Read(buf)
+				if err != nil {
+					return
+				}
+				_, _ = channel.Write(bytes.ToUpper(buf[:n]))
+			}
+		}()
+	}
+}
+
+func serveProxy(t *testing.T, proxy *Proxy, accountID int64) string {
+	mux := http.NewServeMux()
+	mux.Handle("GET /sites/{id}/terminal", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), auth.UserContextKey, &auth.UserInfo{AccountID: accountID})
+		proxy.HandleTerminal(w, r.WithContext(ctx))
+	}))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/sites/" + testSiteID + "/terminal?cols=100&rows=30"
+}
+
+// TestHandleTerminal tests that a session provisions a short-lived key, relays the
+// shell, removes the key once connected and audits the session.
+func TestHandleTerminal(t *testing.T) {
+	authKeys := make(chan string, 1)
+	vm := serveVM(t, authKeys)
+	proxy, rec := newTestProxy(db.SiteMembersRoleDeveloper, vm, authKeys)
+
+	conn, _, err := websocket.DefaultDialer.Dial(serveProxy(t, proxy, 5), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteJSON(clientMessage{Type: "input", Data: "ls -la\r"}))
+	var output strings.Builder
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for !strings.Contains(output.String(), "LS -LA") {
+		_, msg, err := conn.ReadMessage()
+		require.NoError(t, err, "output so far: %q", output.String())
+		output.Write(msg)
+	}
+	assert.Contains(t, output.String(), "Connecting to catalog")
+
+	rec.mu.Lock()
+	assert.True(t, rec.key.ExpiresAt.Valid)
+	assert.WithinDuration(t, time.Now().Add(keyTTL), rec.key.ExpiresAt.Time, time.Minute)
+	assert.Equal(t, []string{rec.key.PublicID}, rec.deleted, "the key is removed once the session is connected")
+	rec.mu.Unlock()
+
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	assert.Eventually(t, func() bool {
+		return len(rec.eventNames()) == 2
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, []string{string(audit.TerminalSessionStart), string(audit.TerminalSessionEnd)}, rec.eventNames())
+}
+
+// TestHandleTerminalForbidden tests that read-only members can't open a terminal.
+func TestHandleTerminalForbidden(t *testing.T) {
+	proxy, rec := newTestProxy(db.SiteMembersRoleRead, "127.0.0.1:22", nil)
+
+	_, resp, err := websocket.DefaultDialer.Dial(serveProxy(t, proxy, 5), nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, []string{string(audit.AuthorizationFailure)}, rec.eventNames())
+	assert.Empty(t, rec.key.PublicID, "no key is provisioned")
+}
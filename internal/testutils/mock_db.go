@@ -3,6 +3,8 @@ package testutils
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
 	"github.com/libops/api/db"
 )
@@ -17,6 +19,7 @@ type MockQuerier struct {
 	GetProjectMemberFunc                              func(ctx context.Context, arg db.GetProjectMemberParams) (db.GetProjectMemberRow, error)
 	GetProjectMemberByAccountAndProjectFunc           func(ctx context.Context, arg db.GetProjectMemberByAccountAndProjectParams) (db.ProjectMember, error)
 	GetOrganizationFunc                               func(ctx context.Context, publicID string) (db.GetOrganizationRow, error)
+	ListOrganizationMembersFunc                       func(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error)
 	GetOrganizationByIDFunc                           func(ctx context.Context, id int64) (db.GetOrganizationByIDRow, error)
 	GetOrganizationMemberFunc                         func(ctx context.Context, arg db.GetOrganizationMemberParams) (db.GetOrganizationMemberRow, error)
 	GetOrganizationMemberByAccountAndOrganizationFunc func(ctx context.Context, arg db.GetOrganizationMemberByAccountAndOrganizationParams) (db.OrganizationMember, error)
@@ -28,6 +31,10 @@ type MockQuerier struct {
 	CreateOrganizationFunc                            func(ctx context.Context, arg db.CreateOrganizationParams) error
 	CreateOrganizationMemberFunc                      func(ctx context.Context, arg db.CreateOrganizationMemberParams) error
 	CreateProjectFunc                                 func(ctx context.Context, arg db.CreateProjectParams) error
+	CreateProjectMemberFunc                           func(ctx context.Context, arg db.CreateProjectMemberParams) error
+	CreateDeploymentFunc                              func(ctx context.Context, arg db.CreateDeploymentParams) error
+	GetDeploymentFunc                                 func(ctx context.Context, id string) (db.Deployment, error)
+	ListSiteDeploymentsFunc                           func(ctx context.Context, arg db.ListSiteDeploymentsParams) ([]db.Deployment, error)
 	CreateSiteFunc                                    func(ctx context.Context, arg db.CreateSiteParams) error
 	GetSiteByProjectAndNameFunc                       func(ctx context.Context, arg db.GetSiteByProjectAndNameParams) (db.GetSiteByProjectAndNameRow, error)
 	GetSiteByShortUUIDFunc                            func(ctx context.Context, shortUUID string) (db.GetSiteByShortUUIDRow, error)
@@ -42,6 +49,382 @@ type MockQuerier struct {
 	GetStripeSubscriptionByOrganizationIDFunc         func(ctx context.Context, organizationID int64) (db.GetStripeSubscriptionByOrganizationIDRow, error)
 	GetStorageConfigFunc                              func(ctx context.Context) (db.StorageConfig, error)
 	CreateRelationshipFunc                            func(ctx context.Context, arg db.CreateRelationshipParams) (sql.Result, error)
+	GetOrganizationQuotaFunc                          func(ctx context.Context, arg db.GetOrganizationQuotaParams) (db.OrganizationQuota, error)
+	ListOrganizationProjectUsageFunc                  func(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectUsageRow, error)
+	SumOrganizationProjectUsageFunc                   func(ctx context.Context, arg db.SumOrganizationProjectUsageParams) ([]db.SumOrganizationProjectUsageRow, error)
+	LatestOrganizationProjectUsageFunc                func(ctx context.Context, arg db.LatestOrganizationProjectUsageParams) ([]db.LatestOrganizationProjectUsageRow, error)
+	UpdateProjectFunc                                 func(ctx context.Context, arg db.UpdateProjectParams) (int64, error)
+	CreateReconciliationRunFunc                       func(ctx context.Context, arg db.CreateReconciliationRunParams) (sql.Result, error)
+	GetStripeSubscriptionByCustomerIDFunc             func(ctx context.Context, stripeCustomerID string) (db.GetStripeSubscriptionByCustomerIDRow, error)
+	SetOrganizationPaymentFailedFunc                  func(ctx context.Context, arg db.SetOrganizationPaymentFailedParams) error
+	ClearOrganizationPaymentFailedFunc                func(ctx context.Context, id int64) error
+	GetOrganizationPaymentFailureFunc                 func(ctx context.Context, id int64) (db.GetOrganizationPaymentFailureRow, error)
+	GetOrganizationBillingStateFunc                   func(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error)
+	SetOrganizationBillingStateFunc                   func(ctx context.Context, arg db.SetOrganizationBillingStateParams) error
+	ListOrganizationsByBillingStateFunc               func(ctx context.Context, arg db.ListOrganizationsByBillingStateParams) ([]db.ListOrganizationsByBillingStateRow, error)
+	SuspendOrganizationSitesFunc                      func(ctx context.Context, organizationID int64) (int64, error)
+	ListOrganizationSitePublicIDsFunc                 func(ctx context.Context, organizationID int64) ([]string, error)
+	ResumeOrganizationSitesFunc                       func(ctx context.Context, organizationID int64) (int64, error)
+	AddProjectUsageFunc                               func(ctx context.Context, arg db.AddProjectUsageParams) error
+	UpdateSiteDiskUsageFunc                           func(ctx context.Context, arg db.UpdateSiteDiskUsageParams) error
+	SumProjectSiteDiskUsageFunc                       func(ctx context.Context, projectID int64) (int64, error)
+	ListOrganizationDailyUsageFunc                    func(ctx context.Context, arg db.ListOrganizationDailyUsageParams) ([]db.ListOrganizationDailyUsageRow, error)
+	ListUsageTotalsForDateFunc                        func(ctx context.Context, arg db.ListUsageTotalsForDateParams) ([]db.ListUsageTotalsForDateRow, error)
+	ListMeteredPricesFunc                             func(ctx context.Context) ([]db.ListMeteredPricesRow, error)
+	UsageReportExistsFunc                             func(ctx context.Context, arg db.UsageReportExistsParams) (bool, error)
+	CreateUsageReportFunc                             func(ctx context.Context, arg db.CreateUsageReportParams) error
+	SetOnboardingSessionDiscountFunc                  func(ctx context.Context, arg db.SetOnboardingSessionDiscountParams) error
+	GetRegionFunc                                     func(ctx context.Context, code string) (db.Region, error)
+	ListRegionMachineSeriesFunc                       func(ctx context.Context) ([]db.ListRegionMachineSeriesRow, error)
+	ListRegionsFunc                                   func(ctx context.Context) ([]db.Region, error)
+	RegionOffersMachineSeriesFunc                     func(ctx context.Context, arg db.RegionOffersMachineSeriesParams) (bool, error)
+	ListMachineTypesFunc                              func(ctx context.Context) ([]db.MachineType, error)
+	CreateEmailSendFunc                               func(ctx context.Context, arg db.CreateEmailSendParams) error
+	CreateNotificationFunc                            func(ctx context.Context, arg db.CreateNotificationParams) error
+	ListAccountNotificationsFunc                      func(ctx context.Context, arg db.ListAccountNotificationsParams) ([]db.ListAccountNotificationsRow, error)
+	ListUnreadAccountNotificationsFunc                func(ctx context.Context, arg db.ListUnreadAccountNotificationsParams) ([]db.ListUnreadAccountNotificationsRow, error)
+	ListAccountNotificationsAfterFunc                 func(ctx context.Context, arg db.ListAccountNotificationsAfterParams) ([]db.ListAccountNotificationsAfterRow, error)
+	GetLatestAccountNotificationIDFunc                func(ctx context.Context, accountID int64) (int64, error)
+	CountUnreadAccountNotificationsFunc               func(ctx context.Context, accountID int64) (int64, error)
+	MarkNotificationReadFunc                          func(ctx context.Context, arg db.MarkNotificationReadParams) (int64, error)
+	MarkAllNotificationsReadFunc                      func(ctx context.Context, accountID int64) (int64, error)
+	ListSiteNotificationRecipientsFunc                func(ctx context.Context, arg db.ListSiteNotificationRecipientsParams) ([]int64, error)
+	CreateNotificationChannelFunc                     func(ctx context.Context, arg db.CreateNotificationChannelParams) error
+	GetNotificationChannelFunc                        func(ctx context.Context, arg db.GetNotificationChannelParams) (db.GetNotificationChannelRow, error)
+	ListNotificationChannelsFunc                      func(ctx context.Context, arg db.ListNotificationChannelsParams) ([]db.ListNotificationChannelsRow, error)
+	CountNotificationChannelsFunc                     func(ctx context.Context, organizationID int64) (int64, error)
+	UpdateNotificationChannelFunc                     func(ctx context.Context, arg db.UpdateNotificationChannelParams) error
+	DeleteNotificationChannelFunc                     func(ctx context.Context, arg db.DeleteNotificationChannelParams) (int64, error)
+	ListEscalationChannelsFunc                        func(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error)
+	RecordNotificationChannelDeliveryFunc             func(ctx context.Context, arg db.RecordNotificationChannelDeliveryParams) error
+	ListSitesMissingCheckInFunc                       func(ctx context.Context, cutoff sql.NullTime) ([]db.ListSitesMissingCheckInRow, error)
+	OpenSiteIncidentFunc                              func(ctx context.Context, arg db.OpenSiteIncidentParams) (int64, error)
+	ListRecoveredSiteIncidentsFunc                    func(ctx context.Context, cutoff sql.NullTime) ([]db.ListRecoveredSiteIncidentsRow, error)
+	ResolveSiteIncidentFunc                           func(ctx context.Context, id int64) (int64, error)
+	ListProbeTargetsFunc                              func(ctx context.Context) ([]db.ListProbeTargetsRow, error)
+	CreateSiteProbeFunc                               func(ctx context.Context, arg db.CreateSiteProbeParams) error
+	GetLatestSiteProbeFunc                            func(ctx context.Context, siteID int64) (db.GetLatestSiteProbeRow, error)
+	ListSiteProbeBucketsFunc                          func(ctx context.Context, arg db.ListSiteProbeBucketsParams) ([]db.ListSiteProbeBucketsRow, error)
+	DeleteSiteProbesBeforeFunc                        func(ctx context.Context, probedAt sql.NullTime) (int64, error)
+	DeleteSiteSecretFunc                              func(ctx context.Context, arg db.DeleteSiteSecretParams) error
+	GetSiteHealthCheckPathFunc                        func(ctx context.Context, id int64) (string, error)
+	UpdateSiteHealthCheckPathFunc                     func(ctx context.Context, arg db.UpdateSiteHealthCheckPathParams) error
+	ListSitesFailingProbesFunc                        func(ctx context.Context, arg db.ListSitesFailingProbesParams) ([]db.ListSitesFailingProbesRow, error)
+	AddStatusPageSiteFunc                             func(ctx context.Context, arg db.AddStatusPageSiteParams) error
+	CreateStatusPageFunc                              func(ctx context.Context, arg db.CreateStatusPageParams) error
+	CreateStatusPageUpdateFunc                        func(ctx context.Context, arg db.CreateStatusPageUpdateParams) error
+	DeleteStatusPageFunc                              func(ctx context.Context, id int64) error
+	DeleteStatusPageSitesFunc                         func(ctx context.Context, statusPageID int64) error
+	DeleteStatusPageUpdateFunc                        func(ctx context.Context, arg db.DeleteStatusPageUpdateParams) (int64, error)
+	GetOrganizationSiteFunc                           func(ctx context.Context, arg db.GetOrganizationSiteParams) (db.GetOrganizationSiteRow, error)
+	GetOrganizationSiteIncidentFunc                   func(ctx context.Context, arg db.GetOrganizationSiteIncidentParams) (db.GetOrganizationSiteIncidentRow, error)
+	GetStatusPageByOrganizationFunc                   func(ctx context.Context, organizationID int64) (db.GetStatusPageByOrganizationRow, error)
+	GetStatusPageBySlugFunc                           func(ctx context.Context, slug string) (db.GetStatusPageBySlugRow, error)
+	ListStatusPageSitesFunc                           func(ctx context.Context, statusPageID int64) ([]db.ListStatusPageSitesRow, error)
+	ListStatusPageUpdatesFunc                         func(ctx context.Context, arg db.ListStatusPageUpdatesParams) ([]db.ListStatusPageUpdatesRow, error)
+	UpdateStatusPageFunc                              func(ctx context.Context, arg db.UpdateStatusPageParams) error
+	ListUserSiteLiveStatusFunc                        func(ctx context.Context, arg db.ListUserSiteLiveStatusParams) ([]db.ListUserSiteLiveStatusRow, error)
+	ListAuditEventsFunc                               func(ctx context.Context, arg db.ListAuditEventsParams) ([]db.ListAuditEventsRow, error)
+	SearchUserResourcesFunc                           func(ctx context.Context, arg db.SearchUserResourcesParams) ([]db.SearchUserResourcesRow, error)
+	AcceptMemberInvitationFunc                        func(ctx context.Context, arg db.AcceptMemberInvitationParams) (int64, error)
+	CreateMemberInvitationFunc                        func(ctx context.Context, arg db.CreateMemberInvitationParams) error
+	GetMemberInvitationByTokenHashFunc                func(ctx context.Context, tokenHash string) (db.GetMemberInvitationByTokenHashRow, error)
+	RevokePendingMemberInvitationsFunc                func(ctx context.Context, arg db.RevokePendingMemberInvitationsParams) error
+	CreateEphemeralSshKeyFunc                         func(ctx context.Context, arg db.CreateEphemeralSshKeyParams) error
+	DeleteSshKeyFunc                                  func(ctx context.Context, publicID string) error
+	CreateAuditEventFunc                              func(ctx context.Context, arg db.CreateAuditEventParams) error
+	EnqueueEventFunc                                  func(ctx context.Context, arg db.EnqueueEventParams) error
+	GetAccountPreferencesFunc                         func(ctx context.Context, accountID int64) (db.AccountPreference, error)
+	UpsertAccountPreferencesFunc                      func(ctx context.Context, arg db.UpsertAccountPreferencesParams) error
+	DeleteOrganizationBrandingFunc                    func(ctx context.Context, organizationID int64) error
+	GetOrganizationBrandingFunc                       func(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error)
+	GetOrganizationLogoFunc                           func(ctx context.Context, organizationID int64) (db.GetOrganizationLogoRow, error)
+	UpsertOrganizationBrandingColorsFunc              func(ctx context.Context, arg db.UpsertOrganizationBrandingColorsParams) error
+	UpsertOrganizationLogoFunc                        func(ctx context.Context, arg db.UpsertOrganizationLogoParams) error
+	CompleteOrganizationExportFunc                    func(ctx context.Context, arg db.CompleteOrganizationExportParams) error
+	CountActiveOrganizationExportsFunc                func(ctx context.Context, organizationID int64) (int64, error)
+	CreateOrganizationExportFunc                      func(ctx context.Context, arg db.CreateOrganizationExportParams) error
+	FailOrganizationExportFunc                        func(ctx context.Context, arg db.FailOrganizationExportParams) error
+	GetOrganizationExportFunc                         func(ctx context.Context, publicID string) (db.GetOrganizationExportRow, error)
+	ListOrganizationExportsFunc                       func(ctx context.Context, arg db.ListOrganizationExportsParams) ([]db.ListOrganizationExportsRow, error)
+	ListPendingOrganizationExportsFunc                func(ctx context.Context, limit int32) ([]db.ListPendingOrganizationExportsRow, error)
+	RequeueStaleOrganizationExportsFunc               func(ctx context.Context, startedAt sql.NullTime) (int64, error)
+	StartOrganizationExportFunc                       func(ctx context.Context, id int64) (int64, error)
+	ListOrganizationSecretsFunc                       func(ctx context.Context, arg db.ListOrganizationSecretsParams) ([]db.ListOrganizationSecretsRow, error)
+	ListOrganizationProjectsFunc                      func(ctx context.Context, arg db.ListOrganizationProjectsParams) ([]db.ListOrganizationProjectsRow, error)
+	ListSiteDomainsFunc                               func(ctx context.Context, arg db.ListSiteDomainsParams) ([]db.Domain, error)
+	CreateDomainFunc                                  func(ctx context.Context, arg db.CreateDomainParams) error
+	DeleteDomainFunc                                  func(ctx context.Context, id int64) error
+	GetDomainByNameFunc                               func(ctx context.Context, domain string) (db.Domain, error)
+	ListSiteFirewallRulesFunc                         func(ctx context.Context, siteID sql.NullInt64) ([]db.ListSiteFirewallRulesRow, error)
+	AcceptOrganizationOwnershipTransferFunc           func(ctx context.Context, id int64) (int64, error)
+	CancelOrganizationOwnershipTransferFunc           func(ctx context.Context, id int64) (int64, error)
+	CancelPendingOrganizationOwnershipTransfersFunc   func(ctx context.Context, organizationID int64) error
+	CreateOrganizationOwnershipTransferFunc           func(ctx context.Context, arg db.CreateOrganizationOwnershipTransferParams) error
+	GetOrganizationOwnershipTransferFunc              func(ctx context.Context, publicID string) (db.GetOrganizationOwnershipTransferRow, error)
+	GetOrganizationSuccessorFunc                      func(ctx context.Context, arg db.GetOrganizationSuccessorParams) (db.GetOrganizationSuccessorRow, error)
+	GetPendingOrganizationOwnershipTransferFunc       func(ctx context.Context, organizationID int64) (db.GetPendingOrganizationOwnershipTransferRow, error)
+	ListAccountOwnedOrganizationsFunc                 func(ctx context.Context, accountID int64) ([]db.ListAccountOwnedOrganizationsRow, error)
+	UpdateOrganizationMemberFunc                      func(ctx context.Context, arg db.UpdateOrganizationMemberParams) error
+	DeleteAccountFunc                                 func(ctx context.Context, publicID string) error
+	ReassignServiceAccountAPIKeysFunc                 func(ctx context.Context, arg db.ReassignServiceAccountAPIKeysParams) (int64, error)
+	ListServiceAccountAPIKeyPublicIDsFunc             func(ctx context.Context, arg db.ListServiceAccountAPIKeyPublicIDsParams) ([]string, error)
+	CreateDeviceAuthorizationFunc                     func(ctx context.Context, arg db.CreateDeviceAuthorizationParams) error
+	DecideDeviceAuthorizationFunc                     func(ctx context.Context, arg db.DecideDeviceAuthorizationParams) (int64, error)
+	DeleteExpiredDeviceAuthorizationsFunc             func(ctx context.Context) error
+	GetDeviceAuthorizationByDeviceCodeHashFunc        func(ctx context.Context, deviceCodeHash string) (db.GetDeviceAuthorizationByDeviceCodeHashRow, error)
+	GetDeviceAuthorizationByUserCodeFunc              func(ctx context.Context, userCode string) (db.GetDeviceAuthorizationByUserCodeRow, error)
+	RedeemDeviceAuthorizationFunc                     func(ctx context.Context, id int64) (int64, error)
+	TouchDeviceAuthorizationFunc                      func(ctx context.Context, id int64) error
+	CountEventsByStatusFunc                           func(ctx context.Context) ([]db.CountEventsByStatusRow, error)
+	CountStuckEventsFunc                              func(ctx context.Context, processingAt sql.NullTime) (int64, error)
+	GetOldestPendingEventFunc                         func(ctx context.Context) (db.GetOldestPendingEventRow, error)
+	ListDeadLetterEventsFunc                          func(ctx context.Context, limit int32) ([]db.ListDeadLetterEventsRow, error)
+	ListPlatformOrganizationsFunc                     func(ctx context.Context, arg db.ListPlatformOrganizationsParams) ([]db.ListPlatformOrganizationsRow, error)
+	LookupResourceByExternalIDFunc                    func(ctx context.Context, arg db.LookupResourceByExternalIDParams) ([]db.LookupResourceByExternalIDRow, error)
+	LookupResourceByPublicIDFunc                      func(ctx context.Context, arg db.LookupResourceByPublicIDParams) ([]db.LookupResourceByPublicIDRow, error)
+	SuspendOrganizationFunc                           func(ctx context.Context, arg db.SuspendOrganizationParams) error
+	UnsuspendOrganizationFunc                         func(ctx context.Context, id int64) (int64, error)
+	GetPlatformOrganizationFunc                       func(ctx context.Context, id int64) (db.GetPlatformOrganizationRow, error)
+	ActivatePrivateServiceConnectEndpointFunc         func(ctx context.Context, arg db.ActivatePrivateServiceConnectEndpointParams) error
+	CreatePrivateServiceConnectEndpointFunc           func(ctx context.Context, arg db.CreatePrivateServiceConnectEndpointParams) error
+	DeletePrivateServiceConnectEndpointFunc           func(ctx context.Context, id int64) error
+	GetPrivateServiceConnectEndpointFunc              func(ctx context.Context, publicID string) (db.GetPrivateServiceConnectEndpointRow, error)
+	GetPrivateServiceConnectEndpointByTargetFunc      func(ctx context.Context, arg db.GetPrivateServiceConnectEndpointByTargetParams) (db.GetPrivateServiceConnectEndpointByTargetRow, error)
+	ListPrivateServiceConnectEndpointsFunc            func(ctx context.Context, organizationID int64) ([]db.ListPrivateServiceConnectEndpointsRow, error)
+	MarkPrivateServiceConnectEndpointDeletingFunc     func(ctx context.Context, id int64) error
+	ActivateSiteStaticEgressIpFunc                    func(ctx context.Context, arg db.ActivateSiteStaticEgressIpParams) error
+	CreateSiteStaticEgressIpFunc                      func(ctx context.Context, arg db.CreateSiteStaticEgressIpParams) error
+	DeleteSiteStaticEgressIpFunc                      func(ctx context.Context, id int64) error
+	GetSiteStaticEgressIpFunc                         func(ctx context.Context, siteID int64) (db.GetSiteStaticEgressIpRow, error)
+	MarkSiteStaticEgressIpReleasingFunc               func(ctx context.Context, id int64) error
+	ActivateSiteCdnConfigFunc                         func(ctx context.Context, arg db.ActivateSiteCdnConfigParams) error
+	CompleteSiteCachePurgeFunc                        func(ctx context.Context, arg db.CompleteSiteCachePurgeParams) error
+	CreateSiteCachePurgeFunc                          func(ctx context.Context, arg db.CreateSiteCachePurgeParams) error
+	CreateSiteCdnConfigFunc                           func(ctx context.Context, arg db.CreateSiteCdnConfigParams) error
+	DeletePendingSiteCachePurgesFunc                  func(ctx context.Context, siteID int64) error
+	DeleteSiteCdnConfigFunc                           func(ctx context.Context, id int64) error
+	GetSiteCachePurgeFunc                             func(ctx context.Context, publicID string) (db.GetSiteCachePurgeRow, error)
+	GetSiteSecretByNameFunc                           func(ctx context.Context, arg db.GetSiteSecretByNameParams) (db.GetSiteSecretByNameRow, error)
+	GetSiteSecretByPublicIDFunc                       func(ctx context.Context, publicID string) (db.GetSiteSecretByPublicIDRow, error)
+	GetSiteCdnConfigFunc                              func(ctx context.Context, siteID int64) (db.GetSiteCdnConfigRow, error)
+	ListPendingSiteCachePurgesFunc                    func(ctx context.Context, siteID int64) ([]db.ListPendingSiteCachePurgesRow, error)
+	ListSiteCdnDomainsFunc                            func(ctx context.Context, siteID int64) ([]string, error)
+	MarkSiteCdnConfigDisablingFunc                    func(ctx context.Context, id int64) error
+	UpdateSiteCdnCachePolicyFunc                      func(ctx context.Context, arg db.UpdateSiteCdnCachePolicyParams) error
+	CountSiteRedirectsFunc                            func(ctx context.Context, siteID int64) (int64, error)
+	CreateSiteRedirectFunc                            func(ctx context.Context, arg db.CreateSiteRedirectParams) error
+	DeleteSiteRedirectFunc                            func(ctx context.Context, id int64) error
+	GetSiteRedirectFunc                               func(ctx context.Context, publicID string) (db.GetSiteRedirectRow, error)
+	GetSiteRedirectBySourceFunc                       func(ctx context.Context, arg db.GetSiteRedirectBySourceParams) (db.GetSiteRedirectBySourceRow, error)
+	ListAllSiteRedirectsFunc                          func(ctx context.Context, siteID int64) ([]db.ListAllSiteRedirectsRow, error)
+	ListSiteRedirectsFunc                             func(ctx context.Context, arg db.ListSiteRedirectsParams) ([]db.ListSiteRedirectsRow, error)
+	UpdateSiteRedirectFunc                            func(ctx context.Context, arg db.UpdateSiteRedirectParams) error
+	DeleteSiteAccessProtectionFunc                    func(ctx context.Context, siteID int64) error
+	GetSiteAccessGateSecretFunc                       func(ctx context.Context, id int64) (sql.NullString, error)
+	GetSiteAccessProtectionFunc                       func(ctx context.Context, siteID int64) (db.GetSiteAccessProtectionRow, error)
+	InitSiteAccessGateSecretFunc                      func(ctx context.Context, arg db.InitSiteAccessGateSecretParams) error
+	UpsertSiteAccessProtectionFunc                    func(ctx context.Context, arg db.UpsertSiteAccessProtectionParams) error
+	CountSiteWafRuleExclusionsFunc                    func(ctx context.Context, siteID int64) (int64, error)
+	CreateSiteWafBlockFunc                            func(ctx context.Context, arg db.CreateSiteWafBlockParams) error
+	CreateSiteWafRuleExclusionFunc                    func(ctx context.Context, arg db.CreateSiteWafRuleExclusionParams) error
+	DeleteSiteWafBlocksBeforeFunc                     func(ctx context.Context, arg db.DeleteSiteWafBlocksBeforeParams) error
+	DeleteSiteWafRuleExclusionFunc                    func(ctx context.Context, id int64) error
+	GetSiteWafConfigFunc                              func(ctx context.Context, siteID int64) (db.GetSiteWafConfigRow, error)
+	GetSiteWafRuleExclusionFunc                       func(ctx context.Context, publicID string) (db.GetSiteWafRuleExclusionRow, error)
+	GetSiteWafRuleExclusionByRuleFunc                 func(ctx context.Context, arg db.GetSiteWafRuleExclusionByRuleParams) (db.GetSiteWafRuleExclusionByRuleRow, error)
+	ListRecentSiteWafBlocksFunc                       func(ctx context.Context, arg db.ListRecentSiteWafBlocksParams) ([]db.SiteWafBlock, error)
+	ListSiteWafRuleExclusionsFunc                     func(ctx context.Context, siteID int64) ([]db.ListSiteWafRuleExclusionsRow, error)
+	SummarizeSiteWafBlocksFunc                        func(ctx context.Context, arg db.SummarizeSiteWafBlocksParams) ([]db.SummarizeSiteWafBlocksRow, error)
+	UpsertSiteWafConfigFunc                           func(ctx context.Context, arg db.UpsertSiteWafConfigParams) error
+	AddSiteRateLimitRejectionsFunc                    func(ctx context.Context, arg db.AddSiteRateLimitRejectionsParams) error
+	CountSiteRateLimitRulesFunc                       func(ctx context.Context, siteID int64) (int64, error)
+	CreateSiteRateLimitRuleFunc                       func(ctx context.Context, arg db.CreateSiteRateLimitRuleParams) error
+	DeleteSiteRateLimitRuleFunc                       func(ctx context.Context, id int64) error
+	GetSiteRateLimitRuleFunc                          func(ctx context.Context, publicID string) (db.GetSiteRateLimitRuleRow, error)
+	ListSiteRateLimitRulesFunc                        func(ctx context.Context, arg db.ListSiteRateLimitRulesParams) ([]db.ListSiteRateLimitRulesRow, error)
+	GetSiteTlsPolicyFunc                              func(ctx context.Context, siteID int64) (db.GetSiteTlsPolicyRow, error)
+	InitSiteTlsPolicyFunc                             func(ctx context.Context, siteID int64) error
+	RecordSiteTlsProbeFunc                            func(ctx context.Context, arg db.RecordSiteTlsProbeParams) error
+	UpsertSiteTlsCertificateFunc                      func(ctx context.Context, arg db.UpsertSiteTlsCertificateParams) error
+	UpsertSiteTlsSettingsFunc                         func(ctx context.Context, arg db.UpsertSiteTlsSettingsParams) error
+	ActivateSiteDatabaseFunc                          func(ctx context.Context, arg db.ActivateSiteDatabaseParams) error
+	CountSiteDatabasesFunc                            func(ctx context.Context, siteID int64) (int64, error)
+	CreateSiteDatabaseFunc                            func(ctx context.Context, arg db.CreateSiteDatabaseParams) error
+	FailSiteDatabaseFunc                              func(ctx context.Context, arg db.FailSiteDatabaseParams) error
+	GetSiteDatabaseFunc                               func(ctx context.Context, publicID string) (db.GetSiteDatabaseRow, error)
+	ListSiteDatabasesFunc                             func(ctx context.Context, siteID int64) ([]db.ListSiteDatabasesRow, error)
+	RotateSiteDatabasePasswordFunc                    func(ctx context.Context, id int64) error
+	UpsertManagedSiteSecretFunc                       func(ctx context.Context, arg db.UpsertManagedSiteSecretParams) error
+	CreateSiteAddonFunc                               func(ctx context.Context, arg db.CreateSiteAddonParams) error
+	DeleteSiteAddonFunc                               func(ctx context.Context, id int64) error
+	GetSiteAddonFunc                                  func(ctx context.Context, publicID string) (db.GetSiteAddonRow, error)
+	ListSiteAddonsFunc                                func(ctx context.Context, siteID int64) ([]db.ListSiteAddonsRow, error)
+	ReportSiteAddonHealthFunc                         func(ctx context.Context, arg db.ReportSiteAddonHealthParams) error
+	GetSiteSshAccessKeysForVMFunc                     func(ctx context.Context, siteID int64) ([]db.GetSiteSshAccessKeysForVMRow, error)
+	UpdateSshAccessSftpOnlyFunc                       func(ctx context.Context, arg db.UpdateSshAccessSftpOnlyParams) error
+	GetSshAccessFunc                                  func(ctx context.Context, arg db.GetSshAccessParams) (db.GetSshAccessRow, error)
+	CreateSshAccessFunc                               func(ctx context.Context, arg db.CreateSshAccessParams) error
+	DeleteSshAccessFunc                               func(ctx context.Context, arg db.DeleteSshAccessParams) error
+	ListSiteSshAccessFunc                             func(ctx context.Context, arg db.ListSiteSshAccessParams) ([]db.ListSiteSshAccessRow, error)
+	GetSiteSSHKeysForVMFunc                           func(ctx context.Context, arg db.GetSiteSSHKeysForVMParams) ([]db.GetSiteSSHKeysForVMRow, error)
+	CountSiteConfigVarsFunc                           func(ctx context.Context, siteID int64) (int64, error)
+	CreateSiteConfigVarRevisionFunc                   func(ctx context.Context, arg db.CreateSiteConfigVarRevisionParams) error
+	DeleteSiteConfigVarFunc                           func(ctx context.Context, arg db.DeleteSiteConfigVarParams) error
+	GetLatestSiteConfigVarRevisionFunc                func(ctx context.Context, siteID int64) (int64, error)
+	GetSiteConfigVarFunc                              func(ctx context.Context, arg db.GetSiteConfigVarParams) (db.SiteConfigVar, error)
+	ListAllSiteConfigVarsFunc                         func(ctx context.Context, siteID int64) ([]db.ListAllSiteConfigVarsRow, error)
+	ListSiteConfigVarRevisionsFunc                    func(ctx context.Context, arg db.ListSiteConfigVarRevisionsParams) ([]db.ListSiteConfigVarRevisionsRow, error)
+	ListSiteConfigVarRevisionsBetweenFunc             func(ctx context.Context, arg db.ListSiteConfigVarRevisionsBetweenParams) ([]db.ListSiteConfigVarRevisionsBetweenRow, error)
+	ListSiteConfigVarsFunc                            func(ctx context.Context, arg db.ListSiteConfigVarsParams) ([]db.SiteConfigVar, error)
+	UpsertSiteConfigVarFunc                           func(ctx context.Context, arg db.UpsertSiteConfigVarParams) error
+	ListSiteInheritedSettingsFunc                     func(ctx context.Context, arg db.ListSiteInheritedSettingsParams) ([]db.ListSiteInheritedSettingsRow, error)
+	GetOrganizationSettingFunc                        func(ctx context.Context, arg db.GetOrganizationSettingParams) (db.GetOrganizationSettingRow, error)
+	GetOrganizationSettingByPublicIDFunc              func(ctx context.Context, publicID string) (db.GetOrganizationSettingByPublicIDRow, error)
+	CreateOrganizationSettingFunc                     func(ctx context.Context, arg db.CreateOrganizationSettingParams) error
+	UpdateOrganizationSettingFunc                     func(ctx context.Context, arg db.UpdateOrganizationSettingParams) error
+	DeleteOrganizationSettingFunc                     func(ctx context.Context, arg db.DeleteOrganizationSettingParams) error
+	GetRelationshipBetweenFunc                        func(ctx context.Context, arg db.GetRelationshipBetweenParams) (db.GetRelationshipBetweenRow, error)
+	ListRelationshipsForOrganizationFunc              func(ctx context.Context, arg db.ListRelationshipsForOrganizationParams) ([]db.ListRelationshipsForOrganizationRow, error)
+	RequestRelationshipFunc                           func(ctx context.Context, arg db.RequestRelationshipParams) error
+	RerequestRelationshipFunc                         func(ctx context.Context, arg db.RerequestRelationshipParams) (sql.Result, error)
+	RevokeRelationshipFunc                            func(ctx context.Context, arg db.RevokeRelationshipParams) (sql.Result, error)
+	UpdateRelationshipMaxRoleFunc                     func(ctx context.Context, arg db.UpdateRelationshipMaxRoleParams) (sql.Result, error)
+	GetRelationshipFunc                               func(ctx context.Context, publicID string) (db.GetRelationshipRow, error)
+	ApproveRelationshipFunc                           func(ctx context.Context, arg db.ApproveRelationshipParams) (sql.Result, error)
+	RejectRelationshipFunc                            func(ctx context.Context, arg db.RejectRelationshipParams) (sql.Result, error)
+	DeleteExpiredOrganizationMemberFunc               func(ctx context.Context, arg db.DeleteExpiredOrganizationMemberParams) (int64, error)
+	DeleteExpiredProjectMemberFunc                    func(ctx context.Context, arg db.DeleteExpiredProjectMemberParams) (int64, error)
+	DeleteExpiredSiteMemberFunc                       func(ctx context.Context, arg db.DeleteExpiredSiteMemberParams) (int64, error)
+	ExpireRelationshipFunc                            func(ctx context.Context, arg db.ExpireRelationshipParams) (int64, error)
+	ListExpiredOrganizationMembersFunc                func(ctx context.Context, arg db.ListExpiredOrganizationMembersParams) ([]db.ListExpiredOrganizationMembersRow, error)
+	ListExpiredProjectMembersFunc                     func(ctx context.Context, arg db.ListExpiredProjectMembersParams) ([]db.ListExpiredProjectMembersRow, error)
+	ListExpiredRelationshipsFunc                      func(ctx context.Context, arg db.ListExpiredRelationshipsParams) ([]db.ListExpiredRelationshipsRow, error)
+	ListExpiredSiteMembersFunc                        func(ctx context.Context, arg db.ListExpiredSiteMembersParams) ([]db.ListExpiredSiteMembersRow, error)
+	SetOrganizationMemberExpiryFunc                   func(ctx context.Context, arg db.SetOrganizationMemberExpiryParams) error
+	SetProjectMemberExpiryFunc                        func(ctx context.Context, arg db.SetProjectMemberExpiryParams) error
+	SetRelationshipExpiryFunc                         func(ctx context.Context, arg db.SetRelationshipExpiryParams) (sql.Result, error)
+	SetSiteMemberExpiryFunc                           func(ctx context.Context, arg db.SetSiteMemberExpiryParams) error
+	ApproveSiteElevationFunc                          func(ctx context.Context, arg db.ApproveSiteElevationParams) (sql.Result, error)
+	CountOpenSiteElevationsFunc                       func(ctx context.Context, arg db.CountOpenSiteElevationsParams) (int64, error)
+	CreateSiteElevationFunc                           func(ctx context.Context, arg db.CreateSiteElevationParams) error
+	ExpireSiteElevationFunc                           func(ctx context.Context, arg db.ExpireSiteElevationParams) (int64, error)
+	GetActiveSiteElevationRoleFunc                    func(ctx context.Context, arg db.GetActiveSiteElevationRoleParams) (db.SiteElevationsRole, error)
+	GetSiteElevationFunc                              func(ctx context.Context, publicID string) (db.GetSiteElevationRow, error)
+	ListExpiredSiteElevationsFunc                     func(ctx context.Context, arg db.ListExpiredSiteElevationsParams) ([]db.ListExpiredSiteElevationsRow, error)
+	ListSiteElevationsFunc                            func(ctx context.Context, arg db.ListSiteElevationsParams) ([]db.ListSiteElevationsRow, error)
+	RejectSiteElevationFunc                           func(ctx context.Context, arg db.RejectSiteElevationParams) (sql.Result, error)
+	RevokeSiteElevationFunc                           func(ctx context.Context, arg db.RevokeSiteElevationParams) (sql.Result, error)
+	CreateOrganizationPolicyFunc                      func(ctx context.Context, arg db.CreateOrganizationPolicyParams) error
+	CreatePolicyViolationFunc                         func(ctx context.Context, arg db.CreatePolicyViolationParams) error
+	DeleteOrganizationPolicyFunc                      func(ctx context.Context, publicID string) error
+	GetOrganizationPolicyFunc                         func(ctx context.Context, publicID string) (db.GetOrganizationPolicyRow, error)
+	ListAllOrganizationPoliciesFunc                   func(ctx context.Context, organizationID int64) ([]db.ListAllOrganizationPoliciesRow, error)
+	ListOrganizationPoliciesFunc                      func(ctx context.Context, arg db.ListOrganizationPoliciesParams) ([]db.ListOrganizationPoliciesRow, error)
+	ListPolicyViolationsFunc                          func(ctx context.Context, arg db.ListPolicyViolationsParams) ([]db.ListPolicyViolationsRow, error)
+	UpdateOrganizationPolicyFunc                      func(ctx context.Context, arg db.UpdateOrganizationPolicyParams) error
+	GetOrganizationAllowedCidrsFunc                   func(ctx context.Context, id int64) (json.RawMessage, error)
+	UpdateOrganizationAllowedCidrsFunc                func(ctx context.Context, arg db.UpdateOrganizationAllowedCidrsParams) error
+	CountSecretAccessByPrincipalSinceFunc             func(ctx context.Context, arg db.CountSecretAccessByPrincipalSinceParams) (int64, error)
+	CountSecretAccessSinceFunc                        func(ctx context.Context, arg db.CountSecretAccessSinceParams) (int64, error)
+	CreateSecretAccessLogFunc                         func(ctx context.Context, arg db.CreateSecretAccessLogParams) error
+	ListSecretAccessLogsFunc                          func(ctx context.Context, arg db.ListSecretAccessLogsParams) ([]db.ListSecretAccessLogsRow, error)
+	CreateOrganizationDataKeyFunc                     func(ctx context.Context, arg db.CreateOrganizationDataKeyParams) error
+	GetActiveOrganizationDataKeyFunc                  func(ctx context.Context, organizationID int64) (db.GetActiveOrganizationDataKeyRow, error)
+	ListOrganizationSealedSecretsFunc                 func(ctx context.Context, organizationID int64) ([]db.ListOrganizationSealedSecretsRow, error)
+	UpdateOrganizationSecretKeyVersionFunc            func(ctx context.Context, arg db.UpdateOrganizationSecretKeyVersionParams) error
+	UpdateProjectSecretKeyVersionFunc                 func(ctx context.Context, arg db.UpdateProjectSecretKeyVersionParams) error
+	UpdateSiteSecretKeyVersionFunc                    func(ctx context.Context, arg db.UpdateSiteSecretKeyVersionParams) error
+	UpsertPasswordResetTokenFunc                      func(ctx context.Context, arg db.UpsertPasswordResetTokenParams) error
+	GetPasswordResetTokenFunc                         func(ctx context.Context, tokenHash string) (db.GetPasswordResetTokenRow, error)
+	DeletePasswordResetTokenFunc                      func(ctx context.Context, accountID int64) error
+	CreateOrganizationJoinDomainFunc                  func(ctx context.Context, arg db.CreateOrganizationJoinDomainParams) error
+	CreateOrganizationJoinRequestFunc                 func(ctx context.Context, arg db.CreateOrganizationJoinRequestParams) error
+	DecideOrganizationJoinRequestFunc                 func(ctx context.Context, arg db.DecideOrganizationJoinRequestParams) (int64, error)
+	DeleteOrganizationJoinDomainsFunc                 func(ctx context.Context, organizationID int64) error
+	GetOrganizationJoinRequestFunc                    func(ctx context.Context, arg db.GetOrganizationJoinRequestParams) (db.GetOrganizationJoinRequestRow, error)
+	ListJoinableOrganizationsFunc                     func(ctx context.Context, arg db.ListJoinableOrganizationsParams) ([]db.ListJoinableOrganizationsRow, error)
+	ListOrganizationJoinDomainsFunc                   func(ctx context.Context, organizationID int64) ([]db.ListOrganizationJoinDomainsRow, error)
+	ListOrganizationJoinRequestsFunc                  func(ctx context.Context, organizationID int64) ([]db.ListOrganizationJoinRequestsRow, error)
+	UpdateAccountOnboardingFunc                       func(ctx context.Context, arg db.UpdateAccountOnboardingParams) error
+	GetAccountAvatarFunc                              func(ctx context.Context, publicID string) (sql.NullString, error)
+	GetAccountProfileFunc                             func(ctx context.Context, id int64) (db.GetAccountProfileRow, error)
+	UpdateAccountAvatarFunc                           func(ctx context.Context, arg db.UpdateAccountAvatarParams) error
+	UpdateAccountProfileFunc                          func(ctx context.Context, arg db.UpdateAccountProfileParams) error
+	GetOrganizationAPIKeyFunc                         func(ctx context.Context, arg db.GetOrganizationAPIKeyParams) (db.GetOrganizationAPIKeyRow, error)
+	ListAccountOrganizationPoliciesFunc               func(ctx context.Context, accountID int64) ([]db.ListAccountOrganizationPoliciesRow, error)
+	ListOrganizationAPIKeysFunc                       func(ctx context.Context, arg db.ListOrganizationAPIKeysParams) ([]db.ListOrganizationAPIKeysRow, error)
+	CountEventSinksFunc                               func(ctx context.Context, organizationID int64) (int64, error)
+	CreateEventSinkFunc                               func(ctx context.Context, arg db.CreateEventSinkParams) error
+	DeleteEventSinkFunc                               func(ctx context.Context, arg db.DeleteEventSinkParams) (int64, error)
+	GetEventSinkFunc                                  func(ctx context.Context, arg db.GetEventSinkParams) (db.GetEventSinkRow, error)
+	ListEventSinksFunc                                func(ctx context.Context, arg db.ListEventSinksParams) ([]db.ListEventSinksRow, error)
+	UpdateEventSinkFunc                               func(ctx context.Context, arg db.UpdateEventSinkParams) error
+	UpsertSiteMaintenanceWindowFunc                   func(ctx context.Context, arg db.UpsertSiteMaintenanceWindowParams) error
+	GetSiteMaintenanceWindowFunc                      func(ctx context.Context, siteID int64) (db.GetSiteMaintenanceWindowRow, error)
+	DeleteSiteMaintenanceWindowFunc                   func(ctx context.Context, siteID int64) (int64, error)
+	ListSiteDeferredReconciliationsFunc               func(ctx context.Context, siteID int64) ([]db.ListSiteDeferredReconciliationsRow, error)
+	ListSiteReconciliationRunsFunc                    func(ctx context.Context, arg db.ListSiteReconciliationRunsParams) ([]db.Reconciliation, error)
+	ListControllerConfigsFunc                         func(ctx context.Context, siteID sql.NullInt64) ([]db.ListControllerConfigsRow, error)
+	UpsertControllerConfigFunc                        func(ctx context.Context, arg db.UpsertControllerConfigParams) error
+	DeleteSiteControllerConfigFunc                    func(ctx context.Context, siteID sql.NullInt64) (int64, error)
+	CreateControllerReleaseFunc                       func(ctx context.Context, arg db.CreateControllerReleaseParams) error
+	GetControllerReleaseFunc                          func(ctx context.Context, version string) (db.GetControllerReleaseRow, error)
+	ListControllerReleasesFunc                        func(ctx context.Context, limit int32) ([]db.ListControllerReleasesRow, error)
+	UpdateSiteControllerVersionFunc                   func(ctx context.Context, arg db.UpdateSiteControllerVersionParams) error
+	CreateSiteClientCertificateFunc                   func(ctx context.Context, arg db.CreateSiteClientCertificateParams) error
+	GetSiteClientCertificateFunc                      func(ctx context.Context, serialNumber string) (db.SiteClientCertificate, error)
+	RevokeSiteClientCertificatesFunc                  func(ctx context.Context, arg db.RevokeSiteClientCertificatesParams) (int64, error)
+	GetLatestSiteDeploymentFunc                       func(ctx context.Context, siteID string) (db.Deployment, error)
+	UpsertStorageConfigFunc                           func(ctx context.Context, arg db.UpsertStorageConfigParams) error
+	GetRegionLocationFunc                             func(ctx context.Context, code string) (db.GetRegionLocationRow, error)
+	GetSiteRegionFunc                                 func(ctx context.Context, id int64) (db.GetSiteRegionRow, error)
+	SetSiteAPIRegionFunc                              func(ctx context.Context, arg db.SetSiteAPIRegionParams) error
+	GetOrganizationDataResidencyFunc                  func(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error)
+	UpdateOrganizationDataResidencyFunc               func(ctx context.Context, arg db.UpdateOrganizationDataResidencyParams) error
+	ListOrganizationProjectRegionsFunc                func(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectRegionsRow, error)
+	ListOrganizationEncryptionKeysFunc                func(ctx context.Context, organizationID int64) ([]db.OrganizationEncryptionKey, error)
+	SetOrganizationEncryptionKeyFunc                  func(ctx context.Context, arg db.SetOrganizationEncryptionKeyParams) error
+	UpdateOrganizationEncryptionKeyStatusFunc         func(ctx context.Context, arg db.UpdateOrganizationEncryptionKeyStatusParams) error
+	DeleteOrganizationEncryptionKeyFunc               func(ctx context.Context, arg db.DeleteOrganizationEncryptionKeyParams) (int64, error)
+	ListOrganizationGcpProjectsFunc                   func(ctx context.Context, organizationID int64) ([]db.ListOrganizationGcpProjectsRow, error)
+	GetOrganizationStateLocationFunc                  func(ctx context.Context, id int64) (string, error)
+	GetActivePlatformAuditorFunc                      func(ctx context.Context, accountID int64) (time.Time, error)
+	GrantPlatformAuditorFunc                          func(ctx context.Context, arg db.GrantPlatformAuditorParams) error
+	ListPlatformAuditorsFunc                          func(ctx context.Context) ([]db.ListPlatformAuditorsRow, error)
+	RevokePlatformAuditorFunc                         func(ctx context.Context, accountID int64) (int64, error)
+	GetActiveAPIKeyByUUIDFunc                         func(ctx context.Context, publicID string) (db.GetActiveAPIKeyByUUIDRow, error)
+	GetEffectiveOrganizationAccessFunc                func(ctx context.Context, arg db.GetEffectiveOrganizationAccessParams) (db.GetEffectiveOrganizationAccessRow, error)
+	IsEffectiveAccessStaleFunc                        func(ctx context.Context, accountID int64) (bool, error)
+	ListStaleEffectiveAccessAccountsFunc              func(ctx context.Context, limit int32) ([]int64, error)
+	GetOldestOutboxEventFunc                          func(ctx context.Context) (db.GetOldestOutboxEventRow, error)
+	UpdateSiteFunc                                    func(ctx context.Context, arg db.UpdateSiteParams) (int64, error)
+	UpdateDeploymentFunc                              func(ctx context.Context, arg db.UpdateDeploymentParams) error
+	QueueSiteReconciliationFunc                       func(ctx context.Context, arg db.QueueSiteReconciliationParams) error
+	ListOrganizationsByNameFunc                       func(ctx context.Context, name string) ([]db.ListOrganizationsByNameRow, error)
+	ListProjectsByNameFunc                            func(ctx context.Context, arg db.ListProjectsByNameParams) ([]db.ListProjectsByNameRow, error)
+	ListSitesByNameFunc                               func(ctx context.Context, arg db.ListSitesByNameParams) ([]db.ListSitesByNameRow, error)
+	CancelReconciliationRetryFunc                     func(ctx context.Context, runID string) (int64, error)
+	CancelReconciliationRunFunc                       func(ctx context.Context, arg db.CancelReconciliationRunParams) (int64, error)
+	GetPlatformReconciliationRunFunc                  func(ctx context.Context, runID string) (db.GetPlatformReconciliationRunRow, error)
+	ListPlatformReconciliationRunsFunc                func(ctx context.Context, arg db.ListPlatformReconciliationRunsParams) ([]db.ListPlatformReconciliationRunsRow, error)
+	ScheduleReconciliationRetryFunc                   func(ctx context.Context, runID string) (int64, error)
+	FinishTerraformStateMigrationFunc                 func(ctx context.Context, arg db.FinishTerraformStateMigrationParams) (int64, error)
+	GetTerraformStateLayoutFunc                       func(ctx context.Context, organizationID int64) (db.TerraformStateLayout, error)
+	ListOrganizationStateWorkspacesFunc               func(ctx context.Context, organizationID int64) ([]db.ListOrganizationStateWorkspacesRow, error)
+	StartTerraformStateMigrationFunc                  func(ctx context.Context, arg db.StartTerraformStateMigrationParams) (int64, error)
+	CreateTerraformStateBackupFunc                    func(ctx context.Context, arg db.CreateTerraformStateBackupParams) error
+	GetTerraformStateBackupFunc                       func(ctx context.Context, arg db.GetTerraformStateBackupParams) (db.GetTerraformStateBackupRow, error)
+	ListTerraformStateBackupsFunc                     func(ctx context.Context, arg db.ListTerraformStateBackupsParams) ([]db.ListTerraformStateBackupsRow, error)
+	GetSiteCheckInFunc                                func(ctx context.Context, id int64) (db.GetSiteCheckInRow, error)
+	GetSiteHealthFunc                                 func(ctx context.Context, siteID int64) (db.SiteHealth, error)
+	ListSiteHealthInputsFunc                          func(ctx context.Context, probesSince sql.NullTime) ([]db.ListSiteHealthInputsRow, error)
+	UpdateSiteContainersFunc                          func(ctx context.Context, arg db.UpdateSiteContainersParams) error
+	UpsertSiteHealthFunc                              func(ctx context.Context, arg db.UpsertSiteHealthParams) error
+	ListFleetSitesFunc                                func(ctx context.Context, arg db.ListFleetSitesParams) ([]db.ListFleetSitesRow, error)
+	UpdateSiteOsStatusFunc                            func(ctx context.Context, arg db.UpdateSiteOsStatusParams) error
+	UpdateProjectStripeSubscriptionItemFunc           func(ctx context.Context, arg db.UpdateProjectStripeSubscriptionItemParams) error
+	MarkReconciliationRunTriggeredFunc                func(ctx context.Context, runID string) (int64, error)
 }
 
 func (m *MockQuerier) AppendEventIDsToRun(ctx context.Context, arg db.AppendEventIDsToRunParams) error {
@@ -61,21 +444,36 @@ func (m *MockQuerier) CountUserOrganizations(ctx context.Context, accountID int6
 }
 
 func (m *MockQuerier) CreateOrganizationSetting(ctx context.Context, arg db.CreateOrganizationSettingParams) error {
+	if m.CreateOrganizationSettingFunc != nil {
+		return m.CreateOrganizationSettingFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) GetOrganizationSetting(ctx context.Context, arg db.GetOrganizationSettingParams) (db.GetOrganizationSettingRow, error) {
+	if m.GetOrganizationSettingFunc != nil {
+		return m.GetOrganizationSettingFunc(ctx, arg)
+	}
 	return db.GetOrganizationSettingRow{}, nil
 }
 func (m *MockQuerier) GetOrganizationSettingByPublicID(ctx context.Context, publicID string) (db.GetOrganizationSettingByPublicIDRow, error) {
+	if m.GetOrganizationSettingByPublicIDFunc != nil {
+		return m.GetOrganizationSettingByPublicIDFunc(ctx, publicID)
+	}
 	return db.GetOrganizationSettingByPublicIDRow{}, nil
 }
 func (m *MockQuerier) ListOrganizationSettings(ctx context.Context, arg db.ListOrganizationSettingsParams) ([]db.ListOrganizationSettingsRow, error) {
 	return nil, nil
 }
 func (m *MockQuerier) UpdateOrganizationSetting(ctx context.Context, arg db.UpdateOrganizationSettingParams) error {
+	if m.UpdateOrganizationSettingFunc != nil {
+		return m.UpdateOrganizationSettingFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) DeleteOrganizationSetting(ctx context.Context, arg db.DeleteOrganizationSettingParams) error {
+	if m.DeleteOrganizationSettingFunc != nil {
+		return m.DeleteOrganizationSettingFunc(ctx, arg)
+	}
 	return nil
 }
 
@@ -125,6 +523,9 @@ func (m *MockQuerier) CreateReconciliationResult(ctx context.Context, arg db.Cre
 	return nil, nil
 }
 func (m *MockQuerier) CreateReconciliationRun(ctx context.Context, arg db.CreateReconciliationRunParams) (sql.Result, error) {
+	if m.CreateReconciliationRunFunc != nil {
+		return m.CreateReconciliationRunFunc(ctx, arg)
+	}
 	return nil, nil
 }
 
@@ -303,6 +704,9 @@ func (m *MockQuerier) GetAccountByEmail(ctx context.Context, email string) (db.G
 // --- Stubs for other methods ---
 
 func (m *MockQuerier) ApproveRelationship(ctx context.Context, arg db.ApproveRelationshipParams) (sql.Result, error) {
+	if m.ApproveRelationshipFunc != nil {
+		return m.ApproveRelationshipFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) CleanupExpiredVerificationTokens(ctx context.Context) error { return nil }
@@ -320,12 +724,23 @@ func (m *MockQuerier) CreateAccount(ctx context.Context, arg db.CreateAccountPar
 	return nil
 }
 func (m *MockQuerier) CreateAuditEvent(ctx context.Context, arg db.CreateAuditEventParams) error {
+	if m.CreateAuditEventFunc != nil {
+		return m.CreateAuditEventFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) CreateDeployment(ctx context.Context, arg db.CreateDeploymentParams) error {
+	if m.CreateDeploymentFunc != nil {
+		return m.CreateDeploymentFunc(ctx, arg)
+	}
+	return nil
+}
+func (m *MockQuerier) CreateDomain(ctx context.Context, arg db.CreateDomainParams) error {
+	if m.CreateDomainFunc != nil {
+		return m.CreateDomainFunc(ctx, arg)
+	}
 	return nil
 }
-func (m *MockQuerier) CreateDomain(ctx context.Context, arg db.CreateDomainParams) error { return nil }
 func (m *MockQuerier) CreateEmailVerificationToken(ctx context.Context, arg db.CreateEmailVerificationTokenParams) error {
 	return nil
 }
@@ -357,6 +772,9 @@ func (m *MockQuerier) CreateProjectFirewallRule(ctx context.Context, arg db.Crea
 	return nil
 }
 func (m *MockQuerier) CreateProjectMember(ctx context.Context, arg db.CreateProjectMemberParams) error {
+	if m.CreateProjectMemberFunc != nil {
+		return m.CreateProjectMemberFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) CreateProjectSecret(ctx context.Context, arg db.CreateProjectSecretParams) (sql.Result, error) {
@@ -384,15 +802,28 @@ func (m *MockQuerier) CreateSiteSecret(ctx context.Context, arg db.CreateSiteSec
 	return nil, nil
 }
 func (m *MockQuerier) CreateSshAccess(ctx context.Context, arg db.CreateSshAccessParams) error {
+	if m.CreateSshAccessFunc != nil {
+		return m.CreateSshAccessFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) CreateSshKey(ctx context.Context, arg db.CreateSshKeyParams) (sql.Result, error) {
 	return nil, nil
 }
-func (m *MockQuerier) DeleteAPIKey(ctx context.Context, apiKeyUuid string) error       { return nil }
-func (m *MockQuerier) DeleteAccount(ctx context.Context, publicID string) error        { return nil }
+func (m *MockQuerier) DeleteAPIKey(ctx context.Context, apiKeyUuid string) error { return nil }
+func (m *MockQuerier) DeleteAccount(ctx context.Context, publicID string) error {
+	if m.DeleteAccountFunc != nil {
+		return m.DeleteAccountFunc(ctx, publicID)
+	}
+	return nil
+}
 func (m *MockQuerier) DeleteDeployment(ctx context.Context, deploymentID string) error { return nil }
-func (m *MockQuerier) DeleteDomain(ctx context.Context, id int64) error                { return nil }
+func (m *MockQuerier) DeleteDomain(ctx context.Context, id int64) error {
+	if m.DeleteDomainFunc != nil {
+		return m.DeleteDomainFunc(ctx, id)
+	}
+	return nil
+}
 func (m *MockQuerier) DeleteEmailVerificationToken(ctx context.Context, email string) error {
 	return nil
 }
@@ -427,13 +858,29 @@ func (m *MockQuerier) DeleteSiteMember(ctx context.Context, arg db.DeleteSiteMem
 	return nil
 }
 func (m *MockQuerier) DeleteSiteSecret(ctx context.Context, arg db.DeleteSiteSecretParams) error {
+	if m.DeleteSiteSecretFunc != nil {
+		return m.DeleteSiteSecretFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) DeleteSshAccess(ctx context.Context, arg db.DeleteSshAccessParams) error {
+	if m.DeleteSshAccessFunc != nil {
+		return m.DeleteSshAccessFunc(ctx, arg)
+	}
+	return nil
+}
+func (m *MockQuerier) DeleteSshKey(ctx context.Context, publicID string) error {
+	if m.DeleteSshKeyFunc != nil {
+		return m.DeleteSshKeyFunc(ctx, publicID)
+	}
+	return nil
+}
+func (m *MockQuerier) EnqueueEvent(ctx context.Context, arg db.EnqueueEventParams) error {
+	if m.EnqueueEventFunc != nil {
+		return m.EnqueueEventFunc(ctx, arg)
+	}
 	return nil
 }
-func (m *MockQuerier) DeleteSshKey(ctx context.Context, publicID string) error           { return nil }
-func (m *MockQuerier) EnqueueEvent(ctx context.Context, arg db.EnqueueEventParams) error { return nil }
 func (m *MockQuerier) GetAPIKeyByID(ctx context.Context, id int64) (db.GetAPIKeyByIDRow, error) {
 	return db.GetAPIKeyByIDRow{}, nil
 }
@@ -444,6 +891,9 @@ func (m *MockQuerier) GetAccountByVaultEntityID(ctx context.Context, vaultEntity
 	return db.GetAccountByVaultEntityIDRow{}, nil
 }
 func (m *MockQuerier) GetActiveAPIKeyByUUID(ctx context.Context, apiKeyUuid string) (db.GetActiveAPIKeyByUUIDRow, error) {
+	if m.GetActiveAPIKeyByUUIDFunc != nil {
+		return m.GetActiveAPIKeyByUUIDFunc(ctx, apiKeyUuid)
+	}
 	return db.GetActiveAPIKeyByUUIDRow{}, nil
 }
 func (m *MockQuerier) HasUserRelationshipAccessToOrganization(ctx context.Context, arg db.HasUserRelationshipAccessToOrganizationParams) (bool, error) {
@@ -459,12 +909,18 @@ func (m *MockQuerier) HasUserSiteAccessInProject(ctx context.Context, arg db.Has
 	return false, nil
 }
 func (m *MockQuerier) GetDeployment(ctx context.Context, deploymentID string) (db.Deployment, error) {
-	return db.Deployment{}, nil
+	if m.GetDeploymentFunc != nil {
+		return m.GetDeploymentFunc(ctx, deploymentID)
+	}
+	return db.Deployment{}, sql.ErrNoRows
 }
 func (m *MockQuerier) GetDomain(ctx context.Context, id int64) (db.Domain, error) {
 	return db.Domain{}, nil
 }
 func (m *MockQuerier) GetDomainByName(ctx context.Context, domain string) (db.Domain, error) {
+	if m.GetDomainByNameFunc != nil {
+		return m.GetDomainByNameFunc(ctx, domain)
+	}
 	return db.Domain{}, nil
 }
 func (m *MockQuerier) GetEmailVerificationToken(ctx context.Context, arg db.GetEmailVerificationTokenParams) (db.EmailVerificationToken, error) {
@@ -474,6 +930,9 @@ func (m *MockQuerier) GetEmailVerificationTokenByEmail(ctx context.Context, emai
 	return db.EmailVerificationToken{}, nil
 }
 func (m *MockQuerier) GetLatestSiteDeployment(ctx context.Context, siteID string) (db.Deployment, error) {
+	if m.GetLatestSiteDeploymentFunc != nil {
+		return m.GetLatestSiteDeploymentFunc(ctx, siteID)
+	}
 	return db.Deployment{}, nil
 }
 func (m *MockQuerier) GetOrganizationMemberByAccountAndOrganization(ctx context.Context, arg db.GetOrganizationMemberByAccountAndOrganizationParams) (db.OrganizationMember, error) {
@@ -519,6 +978,9 @@ func (m *MockQuerier) GetQueueStats(ctx context.Context) (db.GetQueueStatsRow, e
 	return db.GetQueueStatsRow{}, nil
 }
 func (m *MockQuerier) GetRelationship(ctx context.Context, publicID string) (db.GetRelationshipRow, error) {
+	if m.GetRelationshipFunc != nil {
+		return m.GetRelationshipFunc(ctx, publicID)
+	}
 	return db.GetRelationshipRow{}, nil
 }
 func (m *MockQuerier) GetSiteByID(ctx context.Context, id int64) (db.GetSiteByIDRow, error) {
@@ -540,6 +1002,9 @@ func (m *MockQuerier) GetSiteFirewallForVM(ctx context.Context, arg db.GetSiteFi
 	return []db.GetSiteFirewallForVMRow{}, nil
 }
 func (m *MockQuerier) GetSiteSSHKeysForVM(ctx context.Context, arg db.GetSiteSSHKeysForVMParams) ([]db.GetSiteSSHKeysForVMRow, error) {
+	if m.GetSiteSSHKeysForVMFunc != nil {
+		return m.GetSiteSSHKeysForVMFunc(ctx, arg)
+	}
 	return []db.GetSiteSSHKeysForVMRow{}, nil
 }
 func (m *MockQuerier) GetSiteSecretsForVM(ctx context.Context, arg db.GetSiteSecretsForVMParams) ([]db.GetSiteSecretsForVMRow, error) {
@@ -555,13 +1020,22 @@ func (m *MockQuerier) GetSiteSecretByID(ctx context.Context, id int64) (db.GetSi
 	return db.GetSiteSecretByIDRow{}, nil
 }
 func (m *MockQuerier) GetSiteSecretByName(ctx context.Context, arg db.GetSiteSecretByNameParams) (db.GetSiteSecretByNameRow, error) {
+	if m.GetSiteSecretByNameFunc != nil {
+		return m.GetSiteSecretByNameFunc(ctx, arg)
+	}
 	return db.GetSiteSecretByNameRow{}, nil
 }
 func (m *MockQuerier) GetSiteSecretByPublicID(ctx context.Context, publicID string) (db.GetSiteSecretByPublicIDRow, error) {
+	if m.GetSiteSecretByPublicIDFunc != nil {
+		return m.GetSiteSecretByPublicIDFunc(ctx, publicID)
+	}
 	return db.GetSiteSecretByPublicIDRow{}, nil
 }
-func (m *MockQuerier) GetSshAccess(ctx context.Context, arg db.GetSshAccessParams) (db.SshAccess, error) {
-	return db.SshAccess{}, nil
+func (m *MockQuerier) GetSshAccess(ctx context.Context, arg db.GetSshAccessParams) (db.GetSshAccessRow, error) {
+	if m.GetSshAccessFunc != nil {
+		return m.GetSshAccessFunc(ctx, arg)
+	}
+	return db.GetSshAccessRow{}, nil
 }
 func (m *MockQuerier) GetSshKey(ctx context.Context, publicID string) (db.GetSshKeyRow, error) {
 	return db.GetSshKeyRow{}, nil
@@ -586,15 +1060,24 @@ func (m *MockQuerier) ListOrganizationFirewallRules(ctx context.Context, organiz
 	return nil, nil
 }
 func (m *MockQuerier) ListOrganizationMembers(ctx context.Context, arg db.ListOrganizationMembersParams) ([]db.ListOrganizationMembersRow, error) {
+	if m.ListOrganizationMembersFunc != nil {
+		return m.ListOrganizationMembersFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListOrganizationProjects(ctx context.Context, arg db.ListOrganizationProjectsParams) ([]db.ListOrganizationProjectsRow, error) {
+	if m.ListOrganizationProjectsFunc != nil {
+		return m.ListOrganizationProjectsFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListOrganizationRelationships(ctx context.Context, arg db.ListOrganizationRelationshipsParams) ([]db.ListOrganizationRelationshipsRow, error) {
 	return nil, nil
 }
 func (m *MockQuerier) ListOrganizationSecrets(ctx context.Context, arg db.ListOrganizationSecretsParams) ([]db.ListOrganizationSecretsRow, error) {
+	if m.ListOrganizationSecretsFunc != nil {
+		return m.ListOrganizationSecretsFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListProjectFirewallRules(ctx context.Context, projectID sql.NullInt64) ([]db.ListProjectFirewallRulesRow, error) {
@@ -616,12 +1099,21 @@ func (m *MockQuerier) ListProjects(ctx context.Context, arg db.ListProjectsParam
 	return nil, nil
 }
 func (m *MockQuerier) ListSiteDeployments(ctx context.Context, arg db.ListSiteDeploymentsParams) ([]db.Deployment, error) {
+	if m.ListSiteDeploymentsFunc != nil {
+		return m.ListSiteDeploymentsFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListSiteDomains(ctx context.Context, arg db.ListSiteDomainsParams) ([]db.Domain, error) {
+	if m.ListSiteDomainsFunc != nil {
+		return m.ListSiteDomainsFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListSiteFirewallRules(ctx context.Context, siteID sql.NullInt64) ([]db.ListSiteFirewallRulesRow, error) {
+	if m.ListSiteFirewallRulesFunc != nil {
+		return m.ListSiteFirewallRulesFunc(ctx, siteID)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListSiteMembers(ctx context.Context, arg db.ListSiteMembersParams) ([]db.ListSiteMembersRow, error) {
@@ -631,6 +1123,9 @@ func (m *MockQuerier) ListSiteSecrets(ctx context.Context, arg db.ListSiteSecret
 	return nil, nil
 }
 func (m *MockQuerier) ListSiteSshAccess(ctx context.Context, arg db.ListSiteSshAccessParams) ([]db.ListSiteSshAccessRow, error) {
+	if m.ListSiteSshAccessFunc != nil {
+		return m.ListSiteSshAccessFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ListSites(ctx context.Context, arg db.ListSitesParams) ([]db.ListSitesRow, error) {
@@ -664,6 +1159,9 @@ func (m *MockQuerier) ListUserMemberships(ctx context.Context, arg db.ListUserMe
 
 func (m *MockQuerier) MarkEventSent(ctx context.Context, id int64) error { return nil }
 func (m *MockQuerier) RejectRelationship(ctx context.Context, arg db.RejectRelationshipParams) (sql.Result, error) {
+	if m.RejectRelationshipFunc != nil {
+		return m.RejectRelationshipFunc(ctx, arg)
+	}
 	return nil, nil
 }
 func (m *MockQuerier) ResetFailedLoginAttempts(ctx context.Context, id int64) error { return nil }
@@ -675,12 +1173,18 @@ func (m *MockQuerier) UpdateAccount(ctx context.Context, arg db.UpdateAccountPar
 	return nil
 }
 func (m *MockQuerier) UpdateDeployment(ctx context.Context, arg db.UpdateDeploymentParams) error {
+	if m.UpdateDeploymentFunc != nil {
+		return m.UpdateDeploymentFunc(ctx, arg)
+	}
 	return nil
 }
-func (m *MockQuerier) UpdateOrganization(ctx context.Context, arg db.UpdateOrganizationParams) error {
-	return nil
+func (m *MockQuerier) UpdateOrganization(ctx context.Context, arg db.UpdateOrganizationParams) (int64, error) {
+	return 1, nil
 }
 func (m *MockQuerier) UpdateOrganizationMember(ctx context.Context, arg db.UpdateOrganizationMemberParams) error {
+	if m.UpdateOrganizationMemberFunc != nil {
+		return m.UpdateOrganizationMemberFunc(ctx, arg)
+	}
 	return nil
 }
 func (m *MockQuerier) UpdateOrganizationMemberStatus(ctx context.Context, arg db.UpdateOrganizationMemberStatusParams) error {
@@ -689,8 +1193,11 @@ func (m *MockQuerier) UpdateOrganizationMemberStatus(ctx context.Context, arg db
 func (m *MockQuerier) UpdateOrganizationSecret(ctx context.Context, arg db.UpdateOrganizationSecretParams) error {
 	return nil
 }
-func (m *MockQuerier) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) error {
-	return nil
+func (m *MockQuerier) UpdateProject(ctx context.Context, arg db.UpdateProjectParams) (int64, error) {
+	if m.UpdateProjectFunc != nil {
+		return m.UpdateProjectFunc(ctx, arg)
+	}
+	return 1, nil
 }
 func (m *MockQuerier) UpdateProjectMember(ctx context.Context, arg db.UpdateProjectMemberParams) error {
 	return nil
@@ -701,8 +1208,13 @@ func (m *MockQuerier) UpdateProjectMemberStatus(ctx context.Context, arg db.Upda
 func (m *MockQuerier) UpdateProjectSecret(ctx context.Context, arg db.UpdateProjectSecretParams) error {
 	return nil
 }
-func (m *MockQuerier) UpdateSite(ctx context.Context, arg db.UpdateSiteParams) error { return nil }
-func (m *MockQuerier) UpdateSiteCheckIn(ctx context.Context, id int64) error         { return nil }
+func (m *MockQuerier) UpdateSite(ctx context.Context, arg db.UpdateSiteParams) (int64, error) {
+	if m.UpdateSiteFunc != nil {
+		return m.UpdateSiteFunc(ctx, arg)
+	}
+	return 1, nil
+}
+func (m *MockQuerier) UpdateSiteCheckIn(ctx context.Context, id int64) error { return nil }
 func (m *MockQuerier) UpdateSiteMember(ctx context.Context, arg db.UpdateSiteMemberParams) error {
 	return nil
 }
@@ -810,6 +1322,9 @@ func (m *MockQuerier) DeleteStripeSubscription(ctx context.Context, stripeSubscr
 }
 
 func (m *MockQuerier) UpdateAccountOnboarding(ctx context.Context, arg db.UpdateAccountOnboardingParams) error {
+	if m.UpdateAccountOnboardingFunc != nil {
+		return m.UpdateAccountOnboardingFunc(ctx, arg)
+	}
 	return nil
 }
 
@@ -835,6 +1350,9 @@ func (m *MockQuerier) ListAllMachineTypes(ctx context.Context) ([]db.MachineType
 }
 
 func (m *MockQuerier) ListMachineTypes(ctx context.Context) ([]db.MachineType, error) {
+	if m.ListMachineTypesFunc != nil {
+		return m.ListMachineTypesFunc(ctx)
+	}
 	return nil, nil
 }
 
@@ -864,3 +1382,2461 @@ func (m *MockQuerier) GetOrganizationsByAccountID(ctx context.Context, arg db.Ge
 func (m *MockQuerier) MarkEventDeadLetter(ctx context.Context, eventID string) error {
 	return nil
 }
+
+func (m *MockQuerier) CreateIdempotencyKey(ctx context.Context, arg db.CreateIdempotencyKeyParams) error {
+	return nil
+}
+
+func (m *MockQuerier) GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	return db.IdempotencyKey{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) CompleteIdempotencyKey(ctx context.Context, arg db.CompleteIdempotencyKeyParams) error {
+	return nil
+}
+
+func (m *MockQuerier) DeleteIdempotencyKey(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (m *MockQuerier) DeleteExpiredIdempotencyKeys(ctx context.Context) (sql.Result, error) {
+	return nil, nil
+}
+
+func (m *MockQuerier) ListOrganizationQuotas(ctx context.Context, organizationID int64) ([]db.OrganizationQuota, error) {
+	return []db.OrganizationQuota{}, nil
+}
+
+func (m *MockQuerier) GetOrganizationQuota(ctx context.Context, arg db.GetOrganizationQuotaParams) (db.OrganizationQuota, error) {
+	if m.GetOrganizationQuotaFunc != nil {
+		return m.GetOrganizationQuotaFunc(ctx, arg)
+	}
+	return db.OrganizationQuota{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) UpsertOrganizationQuota(ctx context.Context, arg db.UpsertOrganizationQuotaParams) error {
+	return nil
+}
+
+func (m *MockQuerier) DeleteOrganizationQuota(ctx context.Context, arg db.DeleteOrganizationQuotaParams) error {
+	return nil
+}
+
+func (m *MockQuerier) CountProjectSites(ctx context.Context, projectID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockQuerier) CountOrganizationFirewallRules(ctx context.Context, organizationID sql.NullInt64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockQuerier) CountProjectFirewallRules(ctx context.Context, projectID sql.NullInt64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockQuerier) CountSiteFirewallRules(ctx context.Context, siteID sql.NullInt64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockQuerier) CountAccountAPIKeys(ctx context.Context, accountID int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockQuerier) ListOrganizationProjectUsage(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectUsageRow, error) {
+	if m.ListOrganizationProjectUsageFunc != nil {
+		return m.ListOrganizationProjectUsageFunc(ctx, organizationID)
+	}
+	return []db.ListOrganizationProjectUsageRow{}, nil
+}
+
+func (m *MockQuerier) UpsertProjectUsage(ctx context.Context, arg db.UpsertProjectUsageParams) error {
+	return nil
+}
+
+func (m *MockQuerier) SumOrganizationProjectUsage(ctx context.Context, arg db.SumOrganizationProjectUsageParams) ([]db.SumOrganizationProjectUsageRow, error) {
+	if m.SumOrganizationProjectUsageFunc != nil {
+		return m.SumOrganizationProjectUsageFunc(ctx, arg)
+	}
+	return []db.SumOrganizationProjectUsageRow{}, nil
+}
+
+func (m *MockQuerier) LatestOrganizationProjectUsage(ctx context.Context, arg db.LatestOrganizationProjectUsageParams) ([]db.LatestOrganizationProjectUsageRow, error) {
+	if m.LatestOrganizationProjectUsageFunc != nil {
+		return m.LatestOrganizationProjectUsageFunc(ctx, arg)
+	}
+	return []db.LatestOrganizationProjectUsageRow{}, nil
+}
+
+func (m *MockQuerier) GetStripeSubscriptionByCustomerID(ctx context.Context, stripeCustomerID string) (db.GetStripeSubscriptionByCustomerIDRow, error) {
+	if m.GetStripeSubscriptionByCustomerIDFunc != nil {
+		return m.GetStripeSubscriptionByCustomerIDFunc(ctx, stripeCustomerID)
+	}
+	return db.GetStripeSubscriptionByCustomerIDRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) SetOrganizationPaymentFailed(ctx context.Context, arg db.SetOrganizationPaymentFailedParams) error {
+	if m.SetOrganizationPaymentFailedFunc != nil {
+		return m.SetOrganizationPaymentFailedFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ClearOrganizationPaymentFailed(ctx context.Context, id int64) error {
+	if m.ClearOrganizationPaymentFailedFunc != nil {
+		return m.ClearOrganizationPaymentFailedFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationPaymentFailure(ctx context.Context, id int64) (db.GetOrganizationPaymentFailureRow, error) {
+	if m.GetOrganizationPaymentFailureFunc != nil {
+		return m.GetOrganizationPaymentFailureFunc(ctx, id)
+	}
+	return db.GetOrganizationPaymentFailureRow{}, nil
+}
+
+func (m *MockQuerier) GetOrganizationBillingState(ctx context.Context, id int64) (db.GetOrganizationBillingStateRow, error) {
+	if m.GetOrganizationBillingStateFunc != nil {
+		return m.GetOrganizationBillingStateFunc(ctx, id)
+	}
+	return db.GetOrganizationBillingStateRow{BillingState: db.OrganizationsBillingStateActive}, nil
+}
+
+func (m *MockQuerier) SetOrganizationBillingState(ctx context.Context, arg db.SetOrganizationBillingStateParams) error {
+	if m.SetOrganizationBillingStateFunc != nil {
+		return m.SetOrganizationBillingStateFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListOrganizationsByBillingState(ctx context.Context, arg db.ListOrganizationsByBillingStateParams) ([]db.ListOrganizationsByBillingStateRow, error) {
+	if m.ListOrganizationsByBillingStateFunc != nil {
+		return m.ListOrganizationsByBillingStateFunc(ctx, arg)
+	}
+	return []db.ListOrganizationsByBillingStateRow{}, nil
+}
+
+func (m *MockQuerier) SuspendOrganizationSites(ctx context.Context, organizationID int64) (int64, error) {
+	if m.SuspendOrganizationSitesFunc != nil {
+		return m.SuspendOrganizationSitesFunc(ctx, organizationID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListOrganizationSitePublicIDs(ctx context.Context, organizationID int64) ([]string, error) {
+	if m.ListOrganizationSitePublicIDsFunc != nil {
+		return m.ListOrganizationSitePublicIDsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ResumeOrganizationSites(ctx context.Context, organizationID int64) (int64, error) {
+	if m.ResumeOrganizationSitesFunc != nil {
+		return m.ResumeOrganizationSitesFunc(ctx, organizationID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) AddProjectUsage(ctx context.Context, arg db.AddProjectUsageParams) error {
+	if m.AddProjectUsageFunc != nil {
+		return m.AddProjectUsageFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateSiteDiskUsage(ctx context.Context, arg db.UpdateSiteDiskUsageParams) error {
+	if m.UpdateSiteDiskUsageFunc != nil {
+		return m.UpdateSiteDiskUsageFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) SumProjectSiteDiskUsage(ctx context.Context, projectID int64) (int64, error) {
+	if m.SumProjectSiteDiskUsageFunc != nil {
+		return m.SumProjectSiteDiskUsageFunc(ctx, projectID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListOrganizationDailyUsage(ctx context.Context, arg db.ListOrganizationDailyUsageParams) ([]db.ListOrganizationDailyUsageRow, error) {
+	if m.ListOrganizationDailyUsageFunc != nil {
+		return m.ListOrganizationDailyUsageFunc(ctx, arg)
+	}
+	return []db.ListOrganizationDailyUsageRow{}, nil
+}
+
+func (m *MockQuerier) ListUsageTotalsForDate(ctx context.Context, arg db.ListUsageTotalsForDateParams) ([]db.ListUsageTotalsForDateRow, error) {
+	if m.ListUsageTotalsForDateFunc != nil {
+		return m.ListUsageTotalsForDateFunc(ctx, arg)
+	}
+	return []db.ListUsageTotalsForDateRow{}, nil
+}
+
+func (m *MockQuerier) ListMeteredPrices(ctx context.Context) ([]db.ListMeteredPricesRow, error) {
+	if m.ListMeteredPricesFunc != nil {
+		return m.ListMeteredPricesFunc(ctx)
+	}
+	return []db.ListMeteredPricesRow{}, nil
+}
+
+func (m *MockQuerier) UsageReportExists(ctx context.Context, arg db.UsageReportExistsParams) (bool, error) {
+	if m.UsageReportExistsFunc != nil {
+		return m.UsageReportExistsFunc(ctx, arg)
+	}
+	return false, nil
+}
+
+func (m *MockQuerier) CreateUsageReport(ctx context.Context, arg db.CreateUsageReportParams) error {
+	if m.CreateUsageReportFunc != nil {
+		return m.CreateUsageReportFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) SetOnboardingSessionDiscount(ctx context.Context, arg db.SetOnboardingSessionDiscountParams) error {
+	if m.SetOnboardingSessionDiscountFunc != nil {
+		return m.SetOnboardingSessionDiscountFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetRegion(ctx context.Context, code string) (db.Region, error) {
+	if m.GetRegionFunc != nil {
+		return m.GetRegionFunc(ctx, code)
+	}
+	return db.Region{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListRegionMachineSeries(ctx context.Context) ([]db.ListRegionMachineSeriesRow, error) {
+	if m.ListRegionMachineSeriesFunc != nil {
+		return m.ListRegionMachineSeriesFunc(ctx)
+	}
+	return []db.ListRegionMachineSeriesRow{}, nil
+}
+
+func (m *MockQuerier) ListRegions(ctx context.Context) ([]db.Region, error) {
+	if m.ListRegionsFunc != nil {
+		return m.ListRegionsFunc(ctx)
+	}
+	return []db.Region{}, nil
+}
+
+func (m *MockQuerier) RegionOffersMachineSeries(ctx context.Context, arg db.RegionOffersMachineSeriesParams) (bool, error) {
+	if m.RegionOffersMachineSeriesFunc != nil {
+		return m.RegionOffersMachineSeriesFunc(ctx, arg)
+	}
+	return false, nil
+}
+
+func (m *MockQuerier) CreateEmailSend(ctx context.Context, arg db.CreateEmailSendParams) error {
+	if m.CreateEmailSendFunc != nil {
+		return m.CreateEmailSendFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) error {
+	if m.CreateNotificationFunc != nil {
+		return m.CreateNotificationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListAccountNotifications(ctx context.Context, arg db.ListAccountNotificationsParams) ([]db.ListAccountNotificationsRow, error) {
+	if m.ListAccountNotificationsFunc != nil {
+		return m.ListAccountNotificationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListUnreadAccountNotifications(ctx context.Context, arg db.ListUnreadAccountNotificationsParams) ([]db.ListUnreadAccountNotificationsRow, error) {
+	if m.ListUnreadAccountNotificationsFunc != nil {
+		return m.ListUnreadAccountNotificationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListAccountNotificationsAfter(ctx context.Context, arg db.ListAccountNotificationsAfterParams) ([]db.ListAccountNotificationsAfterRow, error) {
+	if m.ListAccountNotificationsAfterFunc != nil {
+		return m.ListAccountNotificationsAfterFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) GetLatestAccountNotificationID(ctx context.Context, accountID int64) (int64, error) {
+	if m.GetLatestAccountNotificationIDFunc != nil {
+		return m.GetLatestAccountNotificationIDFunc(ctx, accountID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CountUnreadAccountNotifications(ctx context.Context, accountID int64) (int64, error) {
+	if m.CountUnreadAccountNotificationsFunc != nil {
+		return m.CountUnreadAccountNotificationsFunc(ctx, accountID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) MarkNotificationRead(ctx context.Context, arg db.MarkNotificationReadParams) (int64, error) {
+	if m.MarkNotificationReadFunc != nil {
+		return m.MarkNotificationReadFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) MarkAllNotificationsRead(ctx context.Context, accountID int64) (int64, error) {
+	if m.MarkAllNotificationsReadFunc != nil {
+		return m.MarkAllNotificationsReadFunc(ctx, accountID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListSiteNotificationRecipients(ctx context.Context, arg db.ListSiteNotificationRecipientsParams) ([]int64, error) {
+	if m.ListSiteNotificationRecipientsFunc != nil {
+		return m.ListSiteNotificationRecipientsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CreateNotificationChannel(ctx context.Context, arg db.CreateNotificationChannelParams) error {
+	if m.CreateNotificationChannelFunc != nil {
+		return m.CreateNotificationChannelFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetNotificationChannel(ctx context.Context, arg db.GetNotificationChannelParams) (db.GetNotificationChannelRow, error) {
+	if m.GetNotificationChannelFunc != nil {
+		return m.GetNotificationChannelFunc(ctx, arg)
+	}
+	return db.GetNotificationChannelRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListNotificationChannels(ctx context.Context, arg db.ListNotificationChannelsParams) ([]db.ListNotificationChannelsRow, error) {
+	if m.ListNotificationChannelsFunc != nil {
+		return m.ListNotificationChannelsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CountNotificationChannels(ctx context.Context, organizationID int64) (int64, error) {
+	if m.CountNotificationChannelsFunc != nil {
+		return m.CountNotificationChannelsFunc(ctx, organizationID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) UpdateNotificationChannel(ctx context.Context, arg db.UpdateNotificationChannelParams) error {
+	if m.UpdateNotificationChannelFunc != nil {
+		return m.UpdateNotificationChannelFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteNotificationChannel(ctx context.Context, arg db.DeleteNotificationChannelParams) (int64, error) {
+	if m.DeleteNotificationChannelFunc != nil {
+		return m.DeleteNotificationChannelFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListEscalationChannels(ctx context.Context, organizationID int64) ([]db.ListEscalationChannelsRow, error) {
+	if m.ListEscalationChannelsFunc != nil {
+		return m.ListEscalationChannelsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RecordNotificationChannelDelivery(ctx context.Context, arg db.RecordNotificationChannelDeliveryParams) error {
+	if m.RecordNotificationChannelDeliveryFunc != nil {
+		return m.RecordNotificationChannelDeliveryFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListSitesMissingCheckIn(ctx context.Context, cutoff sql.NullTime) ([]db.ListSitesMissingCheckInRow, error) {
+	if m.ListSitesMissingCheckInFunc != nil {
+		return m.ListSitesMissingCheckInFunc(ctx, cutoff)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) OpenSiteIncident(ctx context.Context, arg db.OpenSiteIncidentParams) (int64, error) {
+	if m.OpenSiteIncidentFunc != nil {
+		return m.OpenSiteIncidentFunc(ctx, arg)
+	}
+	return 1, nil
+}
+
+func (m *MockQuerier) ListRecoveredSiteIncidents(ctx context.Context, cutoff sql.NullTime) ([]db.ListRecoveredSiteIncidentsRow, error) {
+	if m.ListRecoveredSiteIncidentsFunc != nil {
+		return m.ListRecoveredSiteIncidentsFunc(ctx, cutoff)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ResolveSiteIncident(ctx context.Context, id int64) (int64, error) {
+	if m.ResolveSiteIncidentFunc != nil {
+		return m.ResolveSiteIncidentFunc(ctx, id)
+	}
+	return 1, nil
+}
+
+func (m *MockQuerier) ListProbeTargets(ctx context.Context) ([]db.ListProbeTargetsRow, error) {
+	if m.ListProbeTargetsFunc != nil {
+		return m.ListProbeTargetsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CreateSiteProbe(ctx context.Context, arg db.CreateSiteProbeParams) error {
+	if m.CreateSiteProbeFunc != nil {
+		return m.CreateSiteProbeFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetLatestSiteProbe(ctx context.Context, siteID int64) (db.GetLatestSiteProbeRow, error) {
+	if m.GetLatestSiteProbeFunc != nil {
+		return m.GetLatestSiteProbeFunc(ctx, siteID)
+	}
+	return db.GetLatestSiteProbeRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListSiteProbeBuckets(ctx context.Context, arg db.ListSiteProbeBucketsParams) ([]db.ListSiteProbeBucketsRow, error) {
+	if m.ListSiteProbeBucketsFunc != nil {
+		return m.ListSiteProbeBucketsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) DeleteSiteProbesBefore(ctx context.Context, probedAt sql.NullTime) (int64, error) {
+	if m.DeleteSiteProbesBeforeFunc != nil {
+		return m.DeleteSiteProbesBeforeFunc(ctx, probedAt)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetSiteHealthCheckPath(ctx context.Context, id int64) (string, error) {
+	if m.GetSiteHealthCheckPathFunc != nil {
+		return m.GetSiteHealthCheckPathFunc(ctx, id)
+	}
+	return "/", nil
+}
+
+func (m *MockQuerier) UpdateSiteHealthCheckPath(ctx context.Context, arg db.UpdateSiteHealthCheckPathParams) error {
+	if m.UpdateSiteHealthCheckPathFunc != nil {
+		return m.UpdateSiteHealthCheckPathFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListSitesFailingProbes(ctx context.Context, arg db.ListSitesFailingProbesParams) ([]db.ListSitesFailingProbesRow, error) {
+	if m.ListSitesFailingProbesFunc != nil {
+		return m.ListSitesFailingProbesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) AddStatusPageSite(ctx context.Context, arg db.AddStatusPageSiteParams) error {
+	if m.AddStatusPageSiteFunc != nil {
+		return m.AddStatusPageSiteFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateStatusPage(ctx context.Context, arg db.CreateStatusPageParams) error {
+	if m.CreateStatusPageFunc != nil {
+		return m.CreateStatusPageFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateStatusPageUpdate(ctx context.Context, arg db.CreateStatusPageUpdateParams) error {
+	if m.CreateStatusPageUpdateFunc != nil {
+		return m.CreateStatusPageUpdateFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteStatusPage(ctx context.Context, id int64) error {
+	if m.DeleteStatusPageFunc != nil {
+		return m.DeleteStatusPageFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteStatusPageSites(ctx context.Context, statusPageID int64) error {
+	if m.DeleteStatusPageSitesFunc != nil {
+		return m.DeleteStatusPageSitesFunc(ctx, statusPageID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteStatusPageUpdate(ctx context.Context, arg db.DeleteStatusPageUpdateParams) (int64, error) {
+	if m.DeleteStatusPageUpdateFunc != nil {
+		return m.DeleteStatusPageUpdateFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetOrganizationSite(ctx context.Context, arg db.GetOrganizationSiteParams) (db.GetOrganizationSiteRow, error) {
+	if m.GetOrganizationSiteFunc != nil {
+		return m.GetOrganizationSiteFunc(ctx, arg)
+	}
+	return db.GetOrganizationSiteRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetOrganizationSiteIncident(ctx context.Context, arg db.GetOrganizationSiteIncidentParams) (db.GetOrganizationSiteIncidentRow, error) {
+	if m.GetOrganizationSiteIncidentFunc != nil {
+		return m.GetOrganizationSiteIncidentFunc(ctx, arg)
+	}
+	return db.GetOrganizationSiteIncidentRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetStatusPageByOrganization(ctx context.Context, organizationID int64) (db.GetStatusPageByOrganizationRow, error) {
+	if m.GetStatusPageByOrganizationFunc != nil {
+		return m.GetStatusPageByOrganizationFunc(ctx, organizationID)
+	}
+	return db.GetStatusPageByOrganizationRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetStatusPageBySlug(ctx context.Context, slug string) (db.GetStatusPageBySlugRow, error) {
+	if m.GetStatusPageBySlugFunc != nil {
+		return m.GetStatusPageBySlugFunc(ctx, slug)
+	}
+	return db.GetStatusPageBySlugRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListStatusPageSites(ctx context.Context, statusPageID int64) ([]db.ListStatusPageSitesRow, error) {
+	if m.ListStatusPageSitesFunc != nil {
+		return m.ListStatusPageSitesFunc(ctx, statusPageID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListStatusPageUpdates(ctx context.Context, arg db.ListStatusPageUpdatesParams) ([]db.ListStatusPageUpdatesRow, error) {
+	if m.ListStatusPageUpdatesFunc != nil {
+		return m.ListStatusPageUpdatesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateStatusPage(ctx context.Context, arg db.UpdateStatusPageParams) error {
+	if m.UpdateStatusPageFunc != nil {
+		return m.UpdateStatusPageFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListUserSiteLiveStatus(ctx context.Context, arg db.ListUserSiteLiveStatusParams) ([]db.ListUserSiteLiveStatusRow, error) {
+	if m.ListUserSiteLiveStatusFunc != nil {
+		return m.ListUserSiteLiveStatusFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.ListAuditEventsRow, error) {
+	if m.ListAuditEventsFunc != nil {
+		return m.ListAuditEventsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SearchUserResources(ctx context.Context, arg db.SearchUserResourcesParams) ([]db.SearchUserResourcesRow, error) {
+	if m.SearchUserResourcesFunc != nil {
+		return m.SearchUserResourcesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) AcceptMemberInvitation(ctx context.Context, arg db.AcceptMemberInvitationParams) (int64, error) {
+	if m.AcceptMemberInvitationFunc != nil {
+		return m.AcceptMemberInvitationFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateMemberInvitation(ctx context.Context, arg db.CreateMemberInvitationParams) error {
+	if m.CreateMemberInvitationFunc != nil {
+		return m.CreateMemberInvitationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetMemberInvitationByTokenHash(ctx context.Context, tokenHash string) (db.GetMemberInvitationByTokenHashRow, error) {
+	if m.GetMemberInvitationByTokenHashFunc != nil {
+		return m.GetMemberInvitationByTokenHashFunc(ctx, tokenHash)
+	}
+	return db.GetMemberInvitationByTokenHashRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) RevokePendingMemberInvitations(ctx context.Context, arg db.RevokePendingMemberInvitationsParams) error {
+	if m.RevokePendingMemberInvitationsFunc != nil {
+		return m.RevokePendingMemberInvitationsFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateEphemeralSshKey(ctx context.Context, arg db.CreateEphemeralSshKeyParams) error {
+	if m.CreateEphemeralSshKeyFunc != nil {
+		return m.CreateEphemeralSshKeyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetAccountPreferences(ctx context.Context, accountID int64) (db.AccountPreference, error) {
+	if m.GetAccountPreferencesFunc != nil {
+		return m.GetAccountPreferencesFunc(ctx, accountID)
+	}
+	return db.AccountPreference{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) UpsertAccountPreferences(ctx context.Context, arg db.UpsertAccountPreferencesParams) error {
+	if m.UpsertAccountPreferencesFunc != nil {
+		return m.UpsertAccountPreferencesFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteOrganizationBranding(ctx context.Context, organizationID int64) error {
+	if m.DeleteOrganizationBrandingFunc != nil {
+		return m.DeleteOrganizationBrandingFunc(ctx, organizationID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationBranding(ctx context.Context, organizationID int64) (db.GetOrganizationBrandingRow, error) {
+	if m.GetOrganizationBrandingFunc != nil {
+		return m.GetOrganizationBrandingFunc(ctx, organizationID)
+	}
+	return db.GetOrganizationBrandingRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetOrganizationLogo(ctx context.Context, organizationID int64) (db.GetOrganizationLogoRow, error) {
+	if m.GetOrganizationLogoFunc != nil {
+		return m.GetOrganizationLogoFunc(ctx, organizationID)
+	}
+	return db.GetOrganizationLogoRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) UpsertOrganizationBrandingColors(ctx context.Context, arg db.UpsertOrganizationBrandingColorsParams) error {
+	if m.UpsertOrganizationBrandingColorsFunc != nil {
+		return m.UpsertOrganizationBrandingColorsFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertOrganizationLogo(ctx context.Context, arg db.UpsertOrganizationLogoParams) error {
+	if m.UpsertOrganizationLogoFunc != nil {
+		return m.UpsertOrganizationLogoFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CompleteOrganizationExport(ctx context.Context, arg db.CompleteOrganizationExportParams) error {
+	if m.CompleteOrganizationExportFunc != nil {
+		return m.CompleteOrganizationExportFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountActiveOrganizationExports(ctx context.Context, organizationID int64) (int64, error) {
+	if m.CountActiveOrganizationExportsFunc != nil {
+		return m.CountActiveOrganizationExportsFunc(ctx, organizationID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateOrganizationExport(ctx context.Context, arg db.CreateOrganizationExportParams) error {
+	if m.CreateOrganizationExportFunc != nil {
+		return m.CreateOrganizationExportFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) FailOrganizationExport(ctx context.Context, arg db.FailOrganizationExportParams) error {
+	if m.FailOrganizationExportFunc != nil {
+		return m.FailOrganizationExportFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationExport(ctx context.Context, publicID string) (db.GetOrganizationExportRow, error) {
+	if m.GetOrganizationExportFunc != nil {
+		return m.GetOrganizationExportFunc(ctx, publicID)
+	}
+	return db.GetOrganizationExportRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListOrganizationExports(ctx context.Context, arg db.ListOrganizationExportsParams) ([]db.ListOrganizationExportsRow, error) {
+	if m.ListOrganizationExportsFunc != nil {
+		return m.ListOrganizationExportsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListPendingOrganizationExports(ctx context.Context, limit int32) ([]db.ListPendingOrganizationExportsRow, error) {
+	if m.ListPendingOrganizationExportsFunc != nil {
+		return m.ListPendingOrganizationExportsFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RequeueStaleOrganizationExports(ctx context.Context, startedAt sql.NullTime) (int64, error) {
+	if m.RequeueStaleOrganizationExportsFunc != nil {
+		return m.RequeueStaleOrganizationExportsFunc(ctx, startedAt)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) StartOrganizationExport(ctx context.Context, id int64) (int64, error) {
+	if m.StartOrganizationExportFunc != nil {
+		return m.StartOrganizationExportFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) AcceptOrganizationOwnershipTransfer(ctx context.Context, id int64) (int64, error) {
+	if m.AcceptOrganizationOwnershipTransferFunc != nil {
+		return m.AcceptOrganizationOwnershipTransferFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CancelOrganizationOwnershipTransfer(ctx context.Context, id int64) (int64, error) {
+	if m.CancelOrganizationOwnershipTransferFunc != nil {
+		return m.CancelOrganizationOwnershipTransferFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CancelPendingOrganizationOwnershipTransfers(ctx context.Context, organizationID int64) error {
+	if m.CancelPendingOrganizationOwnershipTransfersFunc != nil {
+		return m.CancelPendingOrganizationOwnershipTransfersFunc(ctx, organizationID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateOrganizationOwnershipTransfer(ctx context.Context, arg db.CreateOrganizationOwnershipTransferParams) error {
+	if m.CreateOrganizationOwnershipTransferFunc != nil {
+		return m.CreateOrganizationOwnershipTransferFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationOwnershipTransfer(ctx context.Context, publicID string) (db.GetOrganizationOwnershipTransferRow, error) {
+	if m.GetOrganizationOwnershipTransferFunc != nil {
+		return m.GetOrganizationOwnershipTransferFunc(ctx, publicID)
+	}
+	return db.GetOrganizationOwnershipTransferRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetOrganizationSuccessor(ctx context.Context, arg db.GetOrganizationSuccessorParams) (db.GetOrganizationSuccessorRow, error) {
+	if m.GetOrganizationSuccessorFunc != nil {
+		return m.GetOrganizationSuccessorFunc(ctx, arg)
+	}
+	return db.GetOrganizationSuccessorRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetPendingOrganizationOwnershipTransfer(ctx context.Context, organizationID int64) (db.GetPendingOrganizationOwnershipTransferRow, error) {
+	if m.GetPendingOrganizationOwnershipTransferFunc != nil {
+		return m.GetPendingOrganizationOwnershipTransferFunc(ctx, organizationID)
+	}
+	return db.GetPendingOrganizationOwnershipTransferRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListAccountOwnedOrganizations(ctx context.Context, accountID int64) ([]db.ListAccountOwnedOrganizationsRow, error) {
+	if m.ListAccountOwnedOrganizationsFunc != nil {
+		return m.ListAccountOwnedOrganizationsFunc(ctx, accountID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ReassignServiceAccountAPIKeys(ctx context.Context, arg db.ReassignServiceAccountAPIKeysParams) (int64, error) {
+	if m.ReassignServiceAccountAPIKeysFunc != nil {
+		return m.ReassignServiceAccountAPIKeysFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListServiceAccountAPIKeyPublicIDs(ctx context.Context, arg db.ListServiceAccountAPIKeyPublicIDsParams) ([]string, error) {
+	if m.ListServiceAccountAPIKeyPublicIDsFunc != nil {
+		return m.ListServiceAccountAPIKeyPublicIDsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CreateDeviceAuthorization(ctx context.Context, arg db.CreateDeviceAuthorizationParams) error {
+	if m.CreateDeviceAuthorizationFunc != nil {
+		return m.CreateDeviceAuthorizationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DecideDeviceAuthorization(ctx context.Context, arg db.DecideDeviceAuthorizationParams) (int64, error) {
+	if m.DecideDeviceAuthorizationFunc != nil {
+		return m.DecideDeviceAuthorizationFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) DeleteExpiredDeviceAuthorizations(ctx context.Context) error {
+	if m.DeleteExpiredDeviceAuthorizationsFunc != nil {
+		return m.DeleteExpiredDeviceAuthorizationsFunc(ctx)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetDeviceAuthorizationByDeviceCodeHash(ctx context.Context, deviceCodeHash string) (db.GetDeviceAuthorizationByDeviceCodeHashRow, error) {
+	if m.GetDeviceAuthorizationByDeviceCodeHashFunc != nil {
+		return m.GetDeviceAuthorizationByDeviceCodeHashFunc(ctx, deviceCodeHash)
+	}
+	return db.GetDeviceAuthorizationByDeviceCodeHashRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (db.GetDeviceAuthorizationByUserCodeRow, error) {
+	if m.GetDeviceAuthorizationByUserCodeFunc != nil {
+		return m.GetDeviceAuthorizationByUserCodeFunc(ctx, userCode)
+	}
+	return db.GetDeviceAuthorizationByUserCodeRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) RedeemDeviceAuthorization(ctx context.Context, id int64) (int64, error) {
+	if m.RedeemDeviceAuthorizationFunc != nil {
+		return m.RedeemDeviceAuthorizationFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) TouchDeviceAuthorization(ctx context.Context, id int64) error {
+	if m.TouchDeviceAuthorizationFunc != nil {
+		return m.TouchDeviceAuthorizationFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountEventsByStatus(ctx context.Context) ([]db.CountEventsByStatusRow, error) {
+	if m.CountEventsByStatusFunc != nil {
+		return m.CountEventsByStatusFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CountStuckEvents(ctx context.Context, processingAt sql.NullTime) (int64, error) {
+	if m.CountStuckEventsFunc != nil {
+		return m.CountStuckEventsFunc(ctx, processingAt)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetOldestPendingEvent(ctx context.Context) (db.GetOldestPendingEventRow, error) {
+	if m.GetOldestPendingEventFunc != nil {
+		return m.GetOldestPendingEventFunc(ctx)
+	}
+	return db.GetOldestPendingEventRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListDeadLetterEvents(ctx context.Context, limit int32) ([]db.ListDeadLetterEventsRow, error) {
+	if m.ListDeadLetterEventsFunc != nil {
+		return m.ListDeadLetterEventsFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListPlatformOrganizations(ctx context.Context, arg db.ListPlatformOrganizationsParams) ([]db.ListPlatformOrganizationsRow, error) {
+	if m.ListPlatformOrganizationsFunc != nil {
+		return m.ListPlatformOrganizationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) LookupResourceByExternalID(ctx context.Context, arg db.LookupResourceByExternalIDParams) ([]db.LookupResourceByExternalIDRow, error) {
+	if m.LookupResourceByExternalIDFunc != nil {
+		return m.LookupResourceByExternalIDFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) LookupResourceByPublicID(ctx context.Context, arg db.LookupResourceByPublicIDParams) ([]db.LookupResourceByPublicIDRow, error) {
+	if m.LookupResourceByPublicIDFunc != nil {
+		return m.LookupResourceByPublicIDFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SuspendOrganization(ctx context.Context, arg db.SuspendOrganizationParams) error {
+	if m.SuspendOrganizationFunc != nil {
+		return m.SuspendOrganizationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UnsuspendOrganization(ctx context.Context, id int64) (int64, error) {
+	if m.UnsuspendOrganizationFunc != nil {
+		return m.UnsuspendOrganizationFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetPlatformOrganization(ctx context.Context, id int64) (db.GetPlatformOrganizationRow, error) {
+	if m.GetPlatformOrganizationFunc != nil {
+		return m.GetPlatformOrganizationFunc(ctx, id)
+	}
+	return db.GetPlatformOrganizationRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ActivatePrivateServiceConnectEndpoint(ctx context.Context, arg db.ActivatePrivateServiceConnectEndpointParams) error {
+	if m.ActivatePrivateServiceConnectEndpointFunc != nil {
+		return m.ActivatePrivateServiceConnectEndpointFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreatePrivateServiceConnectEndpoint(ctx context.Context, arg db.CreatePrivateServiceConnectEndpointParams) error {
+	if m.CreatePrivateServiceConnectEndpointFunc != nil {
+		return m.CreatePrivateServiceConnectEndpointFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeletePrivateServiceConnectEndpoint(ctx context.Context, id int64) error {
+	if m.DeletePrivateServiceConnectEndpointFunc != nil {
+		return m.DeletePrivateServiceConnectEndpointFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetPrivateServiceConnectEndpoint(ctx context.Context, publicID string) (db.GetPrivateServiceConnectEndpointRow, error) {
+	if m.GetPrivateServiceConnectEndpointFunc != nil {
+		return m.GetPrivateServiceConnectEndpointFunc(ctx, publicID)
+	}
+	return db.GetPrivateServiceConnectEndpointRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetPrivateServiceConnectEndpointByTarget(ctx context.Context, arg db.GetPrivateServiceConnectEndpointByTargetParams) (db.GetPrivateServiceConnectEndpointByTargetRow, error) {
+	if m.GetPrivateServiceConnectEndpointByTargetFunc != nil {
+		return m.GetPrivateServiceConnectEndpointByTargetFunc(ctx, arg)
+	}
+	return db.GetPrivateServiceConnectEndpointByTargetRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListPrivateServiceConnectEndpoints(ctx context.Context, organizationID int64) ([]db.ListPrivateServiceConnectEndpointsRow, error) {
+	if m.ListPrivateServiceConnectEndpointsFunc != nil {
+		return m.ListPrivateServiceConnectEndpointsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) MarkPrivateServiceConnectEndpointDeleting(ctx context.Context, id int64) error {
+	if m.MarkPrivateServiceConnectEndpointDeletingFunc != nil {
+		return m.MarkPrivateServiceConnectEndpointDeletingFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ActivateSiteStaticEgressIp(ctx context.Context, arg db.ActivateSiteStaticEgressIpParams) error {
+	if m.ActivateSiteStaticEgressIpFunc != nil {
+		return m.ActivateSiteStaticEgressIpFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteStaticEgressIp(ctx context.Context, arg db.CreateSiteStaticEgressIpParams) error {
+	if m.CreateSiteStaticEgressIpFunc != nil {
+		return m.CreateSiteStaticEgressIpFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteStaticEgressIp(ctx context.Context, id int64) error {
+	if m.DeleteSiteStaticEgressIpFunc != nil {
+		return m.DeleteSiteStaticEgressIpFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteStaticEgressIp(ctx context.Context, siteID int64) (db.GetSiteStaticEgressIpRow, error) {
+	if m.GetSiteStaticEgressIpFunc != nil {
+		return m.GetSiteStaticEgressIpFunc(ctx, siteID)
+	}
+	return db.GetSiteStaticEgressIpRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) MarkSiteStaticEgressIpReleasing(ctx context.Context, id int64) error {
+	if m.MarkSiteStaticEgressIpReleasingFunc != nil {
+		return m.MarkSiteStaticEgressIpReleasingFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ActivateSiteCdnConfig(ctx context.Context, arg db.ActivateSiteCdnConfigParams) error {
+	if m.ActivateSiteCdnConfigFunc != nil {
+		return m.ActivateSiteCdnConfigFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CompleteSiteCachePurge(ctx context.Context, arg db.CompleteSiteCachePurgeParams) error {
+	if m.CompleteSiteCachePurgeFunc != nil {
+		return m.CompleteSiteCachePurgeFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteCachePurge(ctx context.Context, arg db.CreateSiteCachePurgeParams) error {
+	if m.CreateSiteCachePurgeFunc != nil {
+		return m.CreateSiteCachePurgeFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteCdnConfig(ctx context.Context, arg db.CreateSiteCdnConfigParams) error {
+	if m.CreateSiteCdnConfigFunc != nil {
+		return m.CreateSiteCdnConfigFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeletePendingSiteCachePurges(ctx context.Context, siteID int64) error {
+	if m.DeletePendingSiteCachePurgesFunc != nil {
+		return m.DeletePendingSiteCachePurgesFunc(ctx, siteID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteCdnConfig(ctx context.Context, id int64) error {
+	if m.DeleteSiteCdnConfigFunc != nil {
+		return m.DeleteSiteCdnConfigFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteCachePurge(ctx context.Context, publicID string) (db.GetSiteCachePurgeRow, error) {
+	if m.GetSiteCachePurgeFunc != nil {
+		return m.GetSiteCachePurgeFunc(ctx, publicID)
+	}
+	return db.GetSiteCachePurgeRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetSiteCdnConfig(ctx context.Context, siteID int64) (db.GetSiteCdnConfigRow, error) {
+	if m.GetSiteCdnConfigFunc != nil {
+		return m.GetSiteCdnConfigFunc(ctx, siteID)
+	}
+	return db.GetSiteCdnConfigRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListPendingSiteCachePurges(ctx context.Context, siteID int64) ([]db.ListPendingSiteCachePurgesRow, error) {
+	if m.ListPendingSiteCachePurgesFunc != nil {
+		return m.ListPendingSiteCachePurgesFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteCdnDomains(ctx context.Context, siteID int64) ([]string, error) {
+	if m.ListSiteCdnDomainsFunc != nil {
+		return m.ListSiteCdnDomainsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) MarkSiteCdnConfigDisabling(ctx context.Context, id int64) error {
+	if m.MarkSiteCdnConfigDisablingFunc != nil {
+		return m.MarkSiteCdnConfigDisablingFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateSiteCdnCachePolicy(ctx context.Context, arg db.UpdateSiteCdnCachePolicyParams) error {
+	if m.UpdateSiteCdnCachePolicyFunc != nil {
+		return m.UpdateSiteCdnCachePolicyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSiteRedirects(ctx context.Context, siteID int64) (int64, error) {
+	if m.CountSiteRedirectsFunc != nil {
+		return m.CountSiteRedirectsFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteRedirect(ctx context.Context, arg db.CreateSiteRedirectParams) error {
+	if m.CreateSiteRedirectFunc != nil {
+		return m.CreateSiteRedirectFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteRedirect(ctx context.Context, id int64) error {
+	if m.DeleteSiteRedirectFunc != nil {
+		return m.DeleteSiteRedirectFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteRedirect(ctx context.Context, publicID string) (db.GetSiteRedirectRow, error) {
+	if m.GetSiteRedirectFunc != nil {
+		return m.GetSiteRedirectFunc(ctx, publicID)
+	}
+	return db.GetSiteRedirectRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetSiteRedirectBySource(ctx context.Context, arg db.GetSiteRedirectBySourceParams) (db.GetSiteRedirectBySourceRow, error) {
+	if m.GetSiteRedirectBySourceFunc != nil {
+		return m.GetSiteRedirectBySourceFunc(ctx, arg)
+	}
+	return db.GetSiteRedirectBySourceRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListAllSiteRedirects(ctx context.Context, siteID int64) ([]db.ListAllSiteRedirectsRow, error) {
+	if m.ListAllSiteRedirectsFunc != nil {
+		return m.ListAllSiteRedirectsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteRedirects(ctx context.Context, arg db.ListSiteRedirectsParams) ([]db.ListSiteRedirectsRow, error) {
+	if m.ListSiteRedirectsFunc != nil {
+		return m.ListSiteRedirectsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateSiteRedirect(ctx context.Context, arg db.UpdateSiteRedirectParams) error {
+	if m.UpdateSiteRedirectFunc != nil {
+		return m.UpdateSiteRedirectFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteAccessProtection(ctx context.Context, siteID int64) error {
+	if m.DeleteSiteAccessProtectionFunc != nil {
+		return m.DeleteSiteAccessProtectionFunc(ctx, siteID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteAccessGateSecret(ctx context.Context, id int64) (sql.NullString, error) {
+	if m.GetSiteAccessGateSecretFunc != nil {
+		return m.GetSiteAccessGateSecretFunc(ctx, id)
+	}
+	return sql.NullString{}, nil
+}
+
+func (m *MockQuerier) GetSiteAccessProtection(ctx context.Context, siteID int64) (db.GetSiteAccessProtectionRow, error) {
+	if m.GetSiteAccessProtectionFunc != nil {
+		return m.GetSiteAccessProtectionFunc(ctx, siteID)
+	}
+	return db.GetSiteAccessProtectionRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) InitSiteAccessGateSecret(ctx context.Context, arg db.InitSiteAccessGateSecretParams) error {
+	if m.InitSiteAccessGateSecretFunc != nil {
+		return m.InitSiteAccessGateSecretFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertSiteAccessProtection(ctx context.Context, arg db.UpsertSiteAccessProtectionParams) error {
+	if m.UpsertSiteAccessProtectionFunc != nil {
+		return m.UpsertSiteAccessProtectionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSiteWafRuleExclusions(ctx context.Context, siteID int64) (int64, error) {
+	if m.CountSiteWafRuleExclusionsFunc != nil {
+		return m.CountSiteWafRuleExclusionsFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteWafBlock(ctx context.Context, arg db.CreateSiteWafBlockParams) error {
+	if m.CreateSiteWafBlockFunc != nil {
+		return m.CreateSiteWafBlockFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteWafRuleExclusion(ctx context.Context, arg db.CreateSiteWafRuleExclusionParams) error {
+	if m.CreateSiteWafRuleExclusionFunc != nil {
+		return m.CreateSiteWafRuleExclusionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteWafBlocksBefore(ctx context.Context, arg db.DeleteSiteWafBlocksBeforeParams) error {
+	if m.DeleteSiteWafBlocksBeforeFunc != nil {
+		return m.DeleteSiteWafBlocksBeforeFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteWafRuleExclusion(ctx context.Context, id int64) error {
+	if m.DeleteSiteWafRuleExclusionFunc != nil {
+		return m.DeleteSiteWafRuleExclusionFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteWafConfig(ctx context.Context, siteID int64) (db.GetSiteWafConfigRow, error) {
+	if m.GetSiteWafConfigFunc != nil {
+		return m.GetSiteWafConfigFunc(ctx, siteID)
+	}
+	return db.GetSiteWafConfigRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetSiteWafRuleExclusion(ctx context.Context, publicID string) (db.GetSiteWafRuleExclusionRow, error) {
+	if m.GetSiteWafRuleExclusionFunc != nil {
+		return m.GetSiteWafRuleExclusionFunc(ctx, publicID)
+	}
+	return db.GetSiteWafRuleExclusionRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetSiteWafRuleExclusionByRule(ctx context.Context, arg db.GetSiteWafRuleExclusionByRuleParams) (db.GetSiteWafRuleExclusionByRuleRow, error) {
+	if m.GetSiteWafRuleExclusionByRuleFunc != nil {
+		return m.GetSiteWafRuleExclusionByRuleFunc(ctx, arg)
+	}
+	return db.GetSiteWafRuleExclusionByRuleRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListRecentSiteWafBlocks(ctx context.Context, arg db.ListRecentSiteWafBlocksParams) ([]db.SiteWafBlock, error) {
+	if m.ListRecentSiteWafBlocksFunc != nil {
+		return m.ListRecentSiteWafBlocksFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteWafRuleExclusions(ctx context.Context, siteID int64) ([]db.ListSiteWafRuleExclusionsRow, error) {
+	if m.ListSiteWafRuleExclusionsFunc != nil {
+		return m.ListSiteWafRuleExclusionsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SummarizeSiteWafBlocks(ctx context.Context, arg db.SummarizeSiteWafBlocksParams) ([]db.SummarizeSiteWafBlocksRow, error) {
+	if m.SummarizeSiteWafBlocksFunc != nil {
+		return m.SummarizeSiteWafBlocksFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpsertSiteWafConfig(ctx context.Context, arg db.UpsertSiteWafConfigParams) error {
+	if m.UpsertSiteWafConfigFunc != nil {
+		return m.UpsertSiteWafConfigFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) AddSiteRateLimitRejections(ctx context.Context, arg db.AddSiteRateLimitRejectionsParams) error {
+	if m.AddSiteRateLimitRejectionsFunc != nil {
+		return m.AddSiteRateLimitRejectionsFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSiteRateLimitRules(ctx context.Context, siteID int64) (int64, error) {
+	if m.CountSiteRateLimitRulesFunc != nil {
+		return m.CountSiteRateLimitRulesFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteRateLimitRule(ctx context.Context, arg db.CreateSiteRateLimitRuleParams) error {
+	if m.CreateSiteRateLimitRuleFunc != nil {
+		return m.CreateSiteRateLimitRuleFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteRateLimitRule(ctx context.Context, id int64) error {
+	if m.DeleteSiteRateLimitRuleFunc != nil {
+		return m.DeleteSiteRateLimitRuleFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteRateLimitRule(ctx context.Context, publicID string) (db.GetSiteRateLimitRuleRow, error) {
+	if m.GetSiteRateLimitRuleFunc != nil {
+		return m.GetSiteRateLimitRuleFunc(ctx, publicID)
+	}
+	return db.GetSiteRateLimitRuleRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListSiteRateLimitRules(ctx context.Context, arg db.ListSiteRateLimitRulesParams) ([]db.ListSiteRateLimitRulesRow, error) {
+	if m.ListSiteRateLimitRulesFunc != nil {
+		return m.ListSiteRateLimitRulesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) GetSiteTlsPolicy(ctx context.Context, siteID int64) (db.GetSiteTlsPolicyRow, error) {
+	if m.GetSiteTlsPolicyFunc != nil {
+		return m.GetSiteTlsPolicyFunc(ctx, siteID)
+	}
+	return db.GetSiteTlsPolicyRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) InitSiteTlsPolicy(ctx context.Context, siteID int64) error {
+	if m.InitSiteTlsPolicyFunc != nil {
+		return m.InitSiteTlsPolicyFunc(ctx, siteID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) RecordSiteTlsProbe(ctx context.Context, arg db.RecordSiteTlsProbeParams) error {
+	if m.RecordSiteTlsProbeFunc != nil {
+		return m.RecordSiteTlsProbeFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertSiteTlsCertificate(ctx context.Context, arg db.UpsertSiteTlsCertificateParams) error {
+	if m.UpsertSiteTlsCertificateFunc != nil {
+		return m.UpsertSiteTlsCertificateFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertSiteTlsSettings(ctx context.Context, arg db.UpsertSiteTlsSettingsParams) error {
+	if m.UpsertSiteTlsSettingsFunc != nil {
+		return m.UpsertSiteTlsSettingsFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ActivateSiteDatabase(ctx context.Context, arg db.ActivateSiteDatabaseParams) error {
+	if m.ActivateSiteDatabaseFunc != nil {
+		return m.ActivateSiteDatabaseFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSiteDatabases(ctx context.Context, siteID int64) (int64, error) {
+	if m.CountSiteDatabasesFunc != nil {
+		return m.CountSiteDatabasesFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteDatabase(ctx context.Context, arg db.CreateSiteDatabaseParams) error {
+	if m.CreateSiteDatabaseFunc != nil {
+		return m.CreateSiteDatabaseFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) FailSiteDatabase(ctx context.Context, arg db.FailSiteDatabaseParams) error {
+	if m.FailSiteDatabaseFunc != nil {
+		return m.FailSiteDatabaseFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteDatabase(ctx context.Context, publicID string) (db.GetSiteDatabaseRow, error) {
+	if m.GetSiteDatabaseFunc != nil {
+		return m.GetSiteDatabaseFunc(ctx, publicID)
+	}
+	return db.GetSiteDatabaseRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListSiteDatabases(ctx context.Context, siteID int64) ([]db.ListSiteDatabasesRow, error) {
+	if m.ListSiteDatabasesFunc != nil {
+		return m.ListSiteDatabasesFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RotateSiteDatabasePassword(ctx context.Context, id int64) error {
+	if m.RotateSiteDatabasePasswordFunc != nil {
+		return m.RotateSiteDatabasePasswordFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertManagedSiteSecret(ctx context.Context, arg db.UpsertManagedSiteSecretParams) error {
+	if m.UpsertManagedSiteSecretFunc != nil {
+		return m.UpsertManagedSiteSecretFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteAddon(ctx context.Context, arg db.CreateSiteAddonParams) error {
+	if m.CreateSiteAddonFunc != nil {
+		return m.CreateSiteAddonFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteAddon(ctx context.Context, id int64) error {
+	if m.DeleteSiteAddonFunc != nil {
+		return m.DeleteSiteAddonFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteAddon(ctx context.Context, publicID string) (db.GetSiteAddonRow, error) {
+	if m.GetSiteAddonFunc != nil {
+		return m.GetSiteAddonFunc(ctx, publicID)
+	}
+	return db.GetSiteAddonRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListSiteAddons(ctx context.Context, siteID int64) ([]db.ListSiteAddonsRow, error) {
+	if m.ListSiteAddonsFunc != nil {
+		return m.ListSiteAddonsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ReportSiteAddonHealth(ctx context.Context, arg db.ReportSiteAddonHealthParams) error {
+	if m.ReportSiteAddonHealthFunc != nil {
+		return m.ReportSiteAddonHealthFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteSshAccessKeysForVM(ctx context.Context, siteID int64) ([]db.GetSiteSshAccessKeysForVMRow, error) {
+	if m.GetSiteSshAccessKeysForVMFunc != nil {
+		return m.GetSiteSshAccessKeysForVMFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateSshAccessSftpOnly(ctx context.Context, arg db.UpdateSshAccessSftpOnlyParams) error {
+	if m.UpdateSshAccessSftpOnlyFunc != nil {
+		return m.UpdateSshAccessSftpOnlyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSiteConfigVars(ctx context.Context, siteID int64) (int64, error) {
+	if m.CountSiteConfigVarsFunc != nil {
+		return m.CountSiteConfigVarsFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteConfigVarRevision(ctx context.Context, arg db.CreateSiteConfigVarRevisionParams) error {
+	if m.CreateSiteConfigVarRevisionFunc != nil {
+		return m.CreateSiteConfigVarRevisionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteConfigVar(ctx context.Context, arg db.DeleteSiteConfigVarParams) error {
+	if m.DeleteSiteConfigVarFunc != nil {
+		return m.DeleteSiteConfigVarFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetLatestSiteConfigVarRevision(ctx context.Context, siteID int64) (int64, error) {
+	if m.GetLatestSiteConfigVarRevisionFunc != nil {
+		return m.GetLatestSiteConfigVarRevisionFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetSiteConfigVar(ctx context.Context, arg db.GetSiteConfigVarParams) (db.SiteConfigVar, error) {
+	if m.GetSiteConfigVarFunc != nil {
+		return m.GetSiteConfigVarFunc(ctx, arg)
+	}
+	return db.SiteConfigVar{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListAllSiteConfigVars(ctx context.Context, siteID int64) ([]db.ListAllSiteConfigVarsRow, error) {
+	if m.ListAllSiteConfigVarsFunc != nil {
+		return m.ListAllSiteConfigVarsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteConfigVarRevisions(ctx context.Context, arg db.ListSiteConfigVarRevisionsParams) ([]db.ListSiteConfigVarRevisionsRow, error) {
+	if m.ListSiteConfigVarRevisionsFunc != nil {
+		return m.ListSiteConfigVarRevisionsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteConfigVarRevisionsBetween(ctx context.Context, arg db.ListSiteConfigVarRevisionsBetweenParams) ([]db.ListSiteConfigVarRevisionsBetweenRow, error) {
+	if m.ListSiteConfigVarRevisionsBetweenFunc != nil {
+		return m.ListSiteConfigVarRevisionsBetweenFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteConfigVars(ctx context.Context, arg db.ListSiteConfigVarsParams) ([]db.SiteConfigVar, error) {
+	if m.ListSiteConfigVarsFunc != nil {
+		return m.ListSiteConfigVarsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpsertSiteConfigVar(ctx context.Context, arg db.UpsertSiteConfigVarParams) error {
+	if m.UpsertSiteConfigVarFunc != nil {
+		return m.UpsertSiteConfigVarFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListSiteInheritedSettings(ctx context.Context, arg db.ListSiteInheritedSettingsParams) ([]db.ListSiteInheritedSettingsRow, error) {
+	if m.ListSiteInheritedSettingsFunc != nil {
+		return m.ListSiteInheritedSettingsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) GetRelationshipBetween(ctx context.Context, arg db.GetRelationshipBetweenParams) (db.GetRelationshipBetweenRow, error) {
+	if m.GetRelationshipBetweenFunc != nil {
+		return m.GetRelationshipBetweenFunc(ctx, arg)
+	}
+	return db.GetRelationshipBetweenRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListRelationshipsForOrganization(ctx context.Context, arg db.ListRelationshipsForOrganizationParams) ([]db.ListRelationshipsForOrganizationRow, error) {
+	if m.ListRelationshipsForOrganizationFunc != nil {
+		return m.ListRelationshipsForOrganizationFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RequestRelationship(ctx context.Context, arg db.RequestRelationshipParams) error {
+	if m.RequestRelationshipFunc != nil {
+		return m.RequestRelationshipFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) RerequestRelationship(ctx context.Context, arg db.RerequestRelationshipParams) (sql.Result, error) {
+	if m.RerequestRelationshipFunc != nil {
+		return m.RerequestRelationshipFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RevokeRelationship(ctx context.Context, arg db.RevokeRelationshipParams) (sql.Result, error) {
+	if m.RevokeRelationshipFunc != nil {
+		return m.RevokeRelationshipFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateRelationshipMaxRole(ctx context.Context, arg db.UpdateRelationshipMaxRoleParams) (sql.Result, error) {
+	if m.UpdateRelationshipMaxRoleFunc != nil {
+		return m.UpdateRelationshipMaxRoleFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) DeleteExpiredOrganizationMember(ctx context.Context, arg db.DeleteExpiredOrganizationMemberParams) (int64, error) {
+	if m.DeleteExpiredOrganizationMemberFunc != nil {
+		return m.DeleteExpiredOrganizationMemberFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) DeleteExpiredProjectMember(ctx context.Context, arg db.DeleteExpiredProjectMemberParams) (int64, error) {
+	if m.DeleteExpiredProjectMemberFunc != nil {
+		return m.DeleteExpiredProjectMemberFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) DeleteExpiredSiteMember(ctx context.Context, arg db.DeleteExpiredSiteMemberParams) (int64, error) {
+	if m.DeleteExpiredSiteMemberFunc != nil {
+		return m.DeleteExpiredSiteMemberFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ExpireRelationship(ctx context.Context, arg db.ExpireRelationshipParams) (int64, error) {
+	if m.ExpireRelationshipFunc != nil {
+		return m.ExpireRelationshipFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListExpiredOrganizationMembers(ctx context.Context, arg db.ListExpiredOrganizationMembersParams) ([]db.ListExpiredOrganizationMembersRow, error) {
+	if m.ListExpiredOrganizationMembersFunc != nil {
+		return m.ListExpiredOrganizationMembersFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListExpiredProjectMembers(ctx context.Context, arg db.ListExpiredProjectMembersParams) ([]db.ListExpiredProjectMembersRow, error) {
+	if m.ListExpiredProjectMembersFunc != nil {
+		return m.ListExpiredProjectMembersFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListExpiredRelationships(ctx context.Context, arg db.ListExpiredRelationshipsParams) ([]db.ListExpiredRelationshipsRow, error) {
+	if m.ListExpiredRelationshipsFunc != nil {
+		return m.ListExpiredRelationshipsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListExpiredSiteMembers(ctx context.Context, arg db.ListExpiredSiteMembersParams) ([]db.ListExpiredSiteMembersRow, error) {
+	if m.ListExpiredSiteMembersFunc != nil {
+		return m.ListExpiredSiteMembersFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SetOrganizationMemberExpiry(ctx context.Context, arg db.SetOrganizationMemberExpiryParams) error {
+	if m.SetOrganizationMemberExpiryFunc != nil {
+		return m.SetOrganizationMemberExpiryFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) SetProjectMemberExpiry(ctx context.Context, arg db.SetProjectMemberExpiryParams) error {
+	if m.SetProjectMemberExpiryFunc != nil {
+		return m.SetProjectMemberExpiryFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) SetRelationshipExpiry(ctx context.Context, arg db.SetRelationshipExpiryParams) (sql.Result, error) {
+	if m.SetRelationshipExpiryFunc != nil {
+		return m.SetRelationshipExpiryFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SetSiteMemberExpiry(ctx context.Context, arg db.SetSiteMemberExpiryParams) error {
+	if m.SetSiteMemberExpiryFunc != nil {
+		return m.SetSiteMemberExpiryFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ApproveSiteElevation(ctx context.Context, arg db.ApproveSiteElevationParams) (sql.Result, error) {
+	if m.ApproveSiteElevationFunc != nil {
+		return m.ApproveSiteElevationFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CountOpenSiteElevations(ctx context.Context, arg db.CountOpenSiteElevationsParams) (int64, error) {
+	if m.CountOpenSiteElevationsFunc != nil {
+		return m.CountOpenSiteElevationsFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSiteElevation(ctx context.Context, arg db.CreateSiteElevationParams) error {
+	if m.CreateSiteElevationFunc != nil {
+		return m.CreateSiteElevationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ExpireSiteElevation(ctx context.Context, arg db.ExpireSiteElevationParams) (int64, error) {
+	if m.ExpireSiteElevationFunc != nil {
+		return m.ExpireSiteElevationFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetActiveSiteElevationRole(ctx context.Context, arg db.GetActiveSiteElevationRoleParams) (db.SiteElevationsRole, error) {
+	if m.GetActiveSiteElevationRoleFunc != nil {
+		return m.GetActiveSiteElevationRoleFunc(ctx, arg)
+	}
+	return "", sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetSiteElevation(ctx context.Context, publicID string) (db.GetSiteElevationRow, error) {
+	if m.GetSiteElevationFunc != nil {
+		return m.GetSiteElevationFunc(ctx, publicID)
+	}
+	return db.GetSiteElevationRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListExpiredSiteElevations(ctx context.Context, arg db.ListExpiredSiteElevationsParams) ([]db.ListExpiredSiteElevationsRow, error) {
+	if m.ListExpiredSiteElevationsFunc != nil {
+		return m.ListExpiredSiteElevationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteElevations(ctx context.Context, arg db.ListSiteElevationsParams) ([]db.ListSiteElevationsRow, error) {
+	if m.ListSiteElevationsFunc != nil {
+		return m.ListSiteElevationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RejectSiteElevation(ctx context.Context, arg db.RejectSiteElevationParams) (sql.Result, error) {
+	if m.RejectSiteElevationFunc != nil {
+		return m.RejectSiteElevationFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RevokeSiteElevation(ctx context.Context, arg db.RevokeSiteElevationParams) (sql.Result, error) {
+	if m.RevokeSiteElevationFunc != nil {
+		return m.RevokeSiteElevationFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CreateOrganizationPolicy(ctx context.Context, arg db.CreateOrganizationPolicyParams) error {
+	if m.CreateOrganizationPolicyFunc != nil {
+		return m.CreateOrganizationPolicyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreatePolicyViolation(ctx context.Context, arg db.CreatePolicyViolationParams) error {
+	if m.CreatePolicyViolationFunc != nil {
+		return m.CreatePolicyViolationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteOrganizationPolicy(ctx context.Context, publicID string) error {
+	if m.DeleteOrganizationPolicyFunc != nil {
+		return m.DeleteOrganizationPolicyFunc(ctx, publicID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationPolicy(ctx context.Context, publicID string) (db.GetOrganizationPolicyRow, error) {
+	if m.GetOrganizationPolicyFunc != nil {
+		return m.GetOrganizationPolicyFunc(ctx, publicID)
+	}
+	return db.GetOrganizationPolicyRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListAllOrganizationPolicies(ctx context.Context, organizationID int64) ([]db.ListAllOrganizationPoliciesRow, error) {
+	if m.ListAllOrganizationPoliciesFunc != nil {
+		return m.ListAllOrganizationPoliciesFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListOrganizationPolicies(ctx context.Context, arg db.ListOrganizationPoliciesParams) ([]db.ListOrganizationPoliciesRow, error) {
+	if m.ListOrganizationPoliciesFunc != nil {
+		return m.ListOrganizationPoliciesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListPolicyViolations(ctx context.Context, arg db.ListPolicyViolationsParams) ([]db.ListPolicyViolationsRow, error) {
+	if m.ListPolicyViolationsFunc != nil {
+		return m.ListPolicyViolationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateOrganizationPolicy(ctx context.Context, arg db.UpdateOrganizationPolicyParams) error {
+	if m.UpdateOrganizationPolicyFunc != nil {
+		return m.UpdateOrganizationPolicyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationAllowedCidrs(ctx context.Context, id int64) (json.RawMessage, error) {
+	if m.GetOrganizationAllowedCidrsFunc != nil {
+		return m.GetOrganizationAllowedCidrsFunc(ctx, id)
+	}
+	return json.RawMessage("[]"), nil
+}
+
+func (m *MockQuerier) UpdateOrganizationAllowedCidrs(ctx context.Context, arg db.UpdateOrganizationAllowedCidrsParams) error {
+	if m.UpdateOrganizationAllowedCidrsFunc != nil {
+		return m.UpdateOrganizationAllowedCidrsFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CountSecretAccessByPrincipalSince(ctx context.Context, arg db.CountSecretAccessByPrincipalSinceParams) (int64, error) {
+	if m.CountSecretAccessByPrincipalSinceFunc != nil {
+		return m.CountSecretAccessByPrincipalSinceFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CountSecretAccessSince(ctx context.Context, arg db.CountSecretAccessSinceParams) (int64, error) {
+	if m.CountSecretAccessSinceFunc != nil {
+		return m.CountSecretAccessSinceFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateSecretAccessLog(ctx context.Context, arg db.CreateSecretAccessLogParams) error {
+	if m.CreateSecretAccessLogFunc != nil {
+		return m.CreateSecretAccessLogFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListSecretAccessLogs(ctx context.Context, arg db.ListSecretAccessLogsParams) ([]db.ListSecretAccessLogsRow, error) {
+	if m.ListSecretAccessLogsFunc != nil {
+		return m.ListSecretAccessLogsFunc(ctx, arg)
+	}
+	return []db.ListSecretAccessLogsRow{}, nil
+}
+
+func (m *MockQuerier) CreateOrganizationDataKey(ctx context.Context, arg db.CreateOrganizationDataKeyParams) error {
+	if m.CreateOrganizationDataKeyFunc != nil {
+		return m.CreateOrganizationDataKeyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetActiveOrganizationDataKey(ctx context.Context, organizationID int64) (db.GetActiveOrganizationDataKeyRow, error) {
+	if m.GetActiveOrganizationDataKeyFunc != nil {
+		return m.GetActiveOrganizationDataKeyFunc(ctx, organizationID)
+	}
+	return db.GetActiveOrganizationDataKeyRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListOrganizationSealedSecrets(ctx context.Context, organizationID int64) ([]db.ListOrganizationSealedSecretsRow, error) {
+	if m.ListOrganizationSealedSecretsFunc != nil {
+		return m.ListOrganizationSealedSecretsFunc(ctx, organizationID)
+	}
+	return []db.ListOrganizationSealedSecretsRow{}, nil
+}
+
+func (m *MockQuerier) UpdateOrganizationSecretKeyVersion(ctx context.Context, arg db.UpdateOrganizationSecretKeyVersionParams) error {
+	if m.UpdateOrganizationSecretKeyVersionFunc != nil {
+		return m.UpdateOrganizationSecretKeyVersionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateProjectSecretKeyVersion(ctx context.Context, arg db.UpdateProjectSecretKeyVersionParams) error {
+	if m.UpdateProjectSecretKeyVersionFunc != nil {
+		return m.UpdateProjectSecretKeyVersionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateSiteSecretKeyVersion(ctx context.Context, arg db.UpdateSiteSecretKeyVersionParams) error {
+	if m.UpdateSiteSecretKeyVersionFunc != nil {
+		return m.UpdateSiteSecretKeyVersionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertPasswordResetToken(ctx context.Context, arg db.UpsertPasswordResetTokenParams) error {
+	if m.UpsertPasswordResetTokenFunc != nil {
+		return m.UpsertPasswordResetTokenFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetPasswordResetToken(ctx context.Context, tokenHash string) (db.GetPasswordResetTokenRow, error) {
+	if m.GetPasswordResetTokenFunc != nil {
+		return m.GetPasswordResetTokenFunc(ctx, tokenHash)
+	}
+	return db.GetPasswordResetTokenRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) DeletePasswordResetToken(ctx context.Context, accountID int64) error {
+	if m.DeletePasswordResetTokenFunc != nil {
+		return m.DeletePasswordResetTokenFunc(ctx, accountID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CleanupExpiredPasswordResetTokens(ctx context.Context) error { return nil }
+
+func (m *MockQuerier) CreateOrganizationJoinDomain(ctx context.Context, arg db.CreateOrganizationJoinDomainParams) error {
+	if m.CreateOrganizationJoinDomainFunc != nil {
+		return m.CreateOrganizationJoinDomainFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateOrganizationJoinRequest(ctx context.Context, arg db.CreateOrganizationJoinRequestParams) error {
+	if m.CreateOrganizationJoinRequestFunc != nil {
+		return m.CreateOrganizationJoinRequestFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DecideOrganizationJoinRequest(ctx context.Context, arg db.DecideOrganizationJoinRequestParams) (int64, error) {
+	if m.DecideOrganizationJoinRequestFunc != nil {
+		return m.DecideOrganizationJoinRequestFunc(ctx, arg)
+	}
+	return 1, nil
+}
+
+func (m *MockQuerier) DeleteOrganizationJoinDomains(ctx context.Context, organizationID int64) error {
+	if m.DeleteOrganizationJoinDomainsFunc != nil {
+		return m.DeleteOrganizationJoinDomainsFunc(ctx, organizationID)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationJoinRequest(ctx context.Context, arg db.GetOrganizationJoinRequestParams) (db.GetOrganizationJoinRequestRow, error) {
+	if m.GetOrganizationJoinRequestFunc != nil {
+		return m.GetOrganizationJoinRequestFunc(ctx, arg)
+	}
+	return db.GetOrganizationJoinRequestRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListJoinableOrganizations(ctx context.Context, arg db.ListJoinableOrganizationsParams) ([]db.ListJoinableOrganizationsRow, error) {
+	if m.ListJoinableOrganizationsFunc != nil {
+		return m.ListJoinableOrganizationsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListOrganizationJoinDomains(ctx context.Context, organizationID int64) ([]db.ListOrganizationJoinDomainsRow, error) {
+	if m.ListOrganizationJoinDomainsFunc != nil {
+		return m.ListOrganizationJoinDomainsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListOrganizationJoinRequests(ctx context.Context, organizationID int64) ([]db.ListOrganizationJoinRequestsRow, error) {
+	if m.ListOrganizationJoinRequestsFunc != nil {
+		return m.ListOrganizationJoinRequestsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) GetAccountAvatar(ctx context.Context, publicID string) (sql.NullString, error) {
+	if m.GetAccountAvatarFunc != nil {
+		return m.GetAccountAvatarFunc(ctx, publicID)
+	}
+	return sql.NullString{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetAccountProfile(ctx context.Context, id int64) (db.GetAccountProfileRow, error) {
+	if m.GetAccountProfileFunc != nil {
+		return m.GetAccountProfileFunc(ctx, id)
+	}
+	return db.GetAccountProfileRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) UpdateAccountAvatar(ctx context.Context, arg db.UpdateAccountAvatarParams) error {
+	if m.UpdateAccountAvatarFunc != nil {
+		return m.UpdateAccountAvatarFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateAccountProfile(ctx context.Context, arg db.UpdateAccountProfileParams) error {
+	if m.UpdateAccountProfileFunc != nil {
+		return m.UpdateAccountProfileFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationAPIKey(ctx context.Context, arg db.GetOrganizationAPIKeyParams) (db.GetOrganizationAPIKeyRow, error) {
+	if m.GetOrganizationAPIKeyFunc != nil {
+		return m.GetOrganizationAPIKeyFunc(ctx, arg)
+	}
+	return db.GetOrganizationAPIKeyRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListOrganizationAPIKeys(ctx context.Context, arg db.ListOrganizationAPIKeysParams) ([]db.ListOrganizationAPIKeysRow, error) {
+	if m.ListOrganizationAPIKeysFunc != nil {
+		return m.ListOrganizationAPIKeysFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListAccountOrganizationPolicies(ctx context.Context, accountID int64) ([]db.ListAccountOrganizationPoliciesRow, error) {
+	if m.ListAccountOrganizationPoliciesFunc != nil {
+		return m.ListAccountOrganizationPoliciesFunc(ctx, accountID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) CountEventSinks(ctx context.Context, organizationID int64) (int64, error) {
+	if m.CountEventSinksFunc != nil {
+		return m.CountEventSinksFunc(ctx, organizationID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateEventSink(ctx context.Context, arg db.CreateEventSinkParams) error {
+	if m.CreateEventSinkFunc != nil {
+		return m.CreateEventSinkFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteEventSink(ctx context.Context, arg db.DeleteEventSinkParams) (int64, error) {
+	if m.DeleteEventSinkFunc != nil {
+		return m.DeleteEventSinkFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetEventSink(ctx context.Context, arg db.GetEventSinkParams) (db.GetEventSinkRow, error) {
+	if m.GetEventSinkFunc != nil {
+		return m.GetEventSinkFunc(ctx, arg)
+	}
+	return db.GetEventSinkRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListEventSinks(ctx context.Context, arg db.ListEventSinksParams) ([]db.ListEventSinksRow, error) {
+	if m.ListEventSinksFunc != nil {
+		return m.ListEventSinksFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateEventSink(ctx context.Context, arg db.UpdateEventSinkParams) error {
+	if m.UpdateEventSinkFunc != nil {
+		return m.UpdateEventSinkFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertSiteMaintenanceWindow(ctx context.Context, arg db.UpsertSiteMaintenanceWindowParams) error {
+	if m.UpsertSiteMaintenanceWindowFunc != nil {
+		return m.UpsertSiteMaintenanceWindowFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteMaintenanceWindow(ctx context.Context, siteID int64) (db.GetSiteMaintenanceWindowRow, error) {
+	if m.GetSiteMaintenanceWindowFunc != nil {
+		return m.GetSiteMaintenanceWindowFunc(ctx, siteID)
+	}
+	return db.GetSiteMaintenanceWindowRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) DeleteSiteMaintenanceWindow(ctx context.Context, siteID int64) (int64, error) {
+	if m.DeleteSiteMaintenanceWindowFunc != nil {
+		return m.DeleteSiteMaintenanceWindowFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListSiteDeferredReconciliations(ctx context.Context, siteID int64) ([]db.ListSiteDeferredReconciliationsRow, error) {
+	if m.ListSiteDeferredReconciliationsFunc != nil {
+		return m.ListSiteDeferredReconciliationsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSiteReconciliationRuns(ctx context.Context, arg db.ListSiteReconciliationRunsParams) ([]db.Reconciliation, error) {
+	if m.ListSiteReconciliationRunsFunc != nil {
+		return m.ListSiteReconciliationRunsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListControllerConfigs(ctx context.Context, siteID sql.NullInt64) ([]db.ListControllerConfigsRow, error) {
+	if m.ListControllerConfigsFunc != nil {
+		return m.ListControllerConfigsFunc(ctx, siteID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpsertControllerConfig(ctx context.Context, arg db.UpsertControllerConfigParams) error {
+	if m.UpsertControllerConfigFunc != nil {
+		return m.UpsertControllerConfigFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteSiteControllerConfig(ctx context.Context, siteID sql.NullInt64) (int64, error) {
+	if m.DeleteSiteControllerConfigFunc != nil {
+		return m.DeleteSiteControllerConfigFunc(ctx, siteID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CreateControllerRelease(ctx context.Context, arg db.CreateControllerReleaseParams) error {
+	if m.CreateControllerReleaseFunc != nil {
+		return m.CreateControllerReleaseFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetControllerRelease(ctx context.Context, version string) (db.GetControllerReleaseRow, error) {
+	if m.GetControllerReleaseFunc != nil {
+		return m.GetControllerReleaseFunc(ctx, version)
+	}
+	return db.GetControllerReleaseRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListControllerReleases(ctx context.Context, limit int32) ([]db.ListControllerReleasesRow, error) {
+	if m.ListControllerReleasesFunc != nil {
+		return m.ListControllerReleasesFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateSiteControllerVersion(ctx context.Context, arg db.UpdateSiteControllerVersionParams) error {
+	if m.UpdateSiteControllerVersionFunc != nil {
+		return m.UpdateSiteControllerVersionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) CreateSiteClientCertificate(ctx context.Context, arg db.CreateSiteClientCertificateParams) error {
+	if m.CreateSiteClientCertificateFunc != nil {
+		return m.CreateSiteClientCertificateFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteClientCertificate(ctx context.Context, serialNumber string) (db.SiteClientCertificate, error) {
+	if m.GetSiteClientCertificateFunc != nil {
+		return m.GetSiteClientCertificateFunc(ctx, serialNumber)
+	}
+	return db.SiteClientCertificate{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) RevokeSiteClientCertificates(ctx context.Context, arg db.RevokeSiteClientCertificatesParams) (int64, error) {
+	if m.RevokeSiteClientCertificatesFunc != nil {
+		return m.RevokeSiteClientCertificatesFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListFleetSites(ctx context.Context, arg db.ListFleetSitesParams) ([]db.ListFleetSitesRow, error) {
+	if m.ListFleetSitesFunc != nil {
+		return m.ListFleetSitesFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateSiteOsStatus(ctx context.Context, arg db.UpdateSiteOsStatusParams) error {
+	if m.UpdateSiteOsStatusFunc != nil {
+		return m.UpdateSiteOsStatusFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteHealth(ctx context.Context, siteID int64) (db.SiteHealth, error) {
+	if m.GetSiteHealthFunc != nil {
+		return m.GetSiteHealthFunc(ctx, siteID)
+	}
+	return db.SiteHealth{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListSiteHealthInputs(ctx context.Context, probesSince sql.NullTime) ([]db.ListSiteHealthInputsRow, error) {
+	if m.ListSiteHealthInputsFunc != nil {
+		return m.ListSiteHealthInputsFunc(ctx, probesSince)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) UpdateSiteContainers(ctx context.Context, arg db.UpdateSiteContainersParams) error {
+	if m.UpdateSiteContainersFunc != nil {
+		return m.UpdateSiteContainersFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertSiteHealth(ctx context.Context, arg db.UpsertSiteHealthParams) error {
+	if m.UpsertSiteHealthFunc != nil {
+		return m.UpsertSiteHealthFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetSiteCheckIn(ctx context.Context, id int64) (db.GetSiteCheckInRow, error) {
+	if m.GetSiteCheckInFunc != nil {
+		return m.GetSiteCheckInFunc(ctx, id)
+	}
+	return db.GetSiteCheckInRow{}, nil
+}
+
+func (m *MockQuerier) CreateTerraformStateBackup(ctx context.Context, arg db.CreateTerraformStateBackupParams) error {
+	if m.CreateTerraformStateBackupFunc != nil {
+		return m.CreateTerraformStateBackupFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetTerraformStateBackup(ctx context.Context, arg db.GetTerraformStateBackupParams) (db.GetTerraformStateBackupRow, error) {
+	if m.GetTerraformStateBackupFunc != nil {
+		return m.GetTerraformStateBackupFunc(ctx, arg)
+	}
+	return db.GetTerraformStateBackupRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListTerraformStateBackups(ctx context.Context, arg db.ListTerraformStateBackupsParams) ([]db.ListTerraformStateBackupsRow, error) {
+	if m.ListTerraformStateBackupsFunc != nil {
+		return m.ListTerraformStateBackupsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) FinishTerraformStateMigration(ctx context.Context, arg db.FinishTerraformStateMigrationParams) (int64, error) {
+	if m.FinishTerraformStateMigrationFunc != nil {
+		return m.FinishTerraformStateMigrationFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetTerraformStateLayout(ctx context.Context, organizationID int64) (db.TerraformStateLayout, error) {
+	if m.GetTerraformStateLayoutFunc != nil {
+		return m.GetTerraformStateLayoutFunc(ctx, organizationID)
+	}
+	return db.TerraformStateLayout{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListOrganizationStateWorkspaces(ctx context.Context, organizationID int64) ([]db.ListOrganizationStateWorkspacesRow, error) {
+	if m.ListOrganizationStateWorkspacesFunc != nil {
+		return m.ListOrganizationStateWorkspacesFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) StartTerraformStateMigration(ctx context.Context, arg db.StartTerraformStateMigrationParams) (int64, error) {
+	if m.StartTerraformStateMigrationFunc != nil {
+		return m.StartTerraformStateMigrationFunc(ctx, arg)
+	}
+	return 1, nil
+}
+
+func (m *MockQuerier) CancelReconciliationRetry(ctx context.Context, runID string) (int64, error) {
+	if m.CancelReconciliationRetryFunc != nil {
+		return m.CancelReconciliationRetryFunc(ctx, runID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) CancelReconciliationRun(ctx context.Context, arg db.CancelReconciliationRunParams) (int64, error) {
+	if m.CancelReconciliationRunFunc != nil {
+		return m.CancelReconciliationRunFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) GetPlatformReconciliationRun(ctx context.Context, runID string) (db.GetPlatformReconciliationRunRow, error) {
+	if m.GetPlatformReconciliationRunFunc != nil {
+		return m.GetPlatformReconciliationRunFunc(ctx, runID)
+	}
+	return db.GetPlatformReconciliationRunRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) ListPlatformReconciliationRuns(ctx context.Context, arg db.ListPlatformReconciliationRunsParams) ([]db.ListPlatformReconciliationRunsRow, error) {
+	if m.ListPlatformReconciliationRunsFunc != nil {
+		return m.ListPlatformReconciliationRunsFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ScheduleReconciliationRetry(ctx context.Context, runID string) (int64, error) {
+	if m.ScheduleReconciliationRetryFunc != nil {
+		return m.ScheduleReconciliationRetryFunc(ctx, runID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListOrganizationsByName(ctx context.Context, name string) ([]db.ListOrganizationsByNameRow, error) {
+	if m.ListOrganizationsByNameFunc != nil {
+		return m.ListOrganizationsByNameFunc(ctx, name)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListProjectsByName(ctx context.Context, arg db.ListProjectsByNameParams) ([]db.ListProjectsByNameRow, error) {
+	if m.ListProjectsByNameFunc != nil {
+		return m.ListProjectsByNameFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListSitesByName(ctx context.Context, arg db.ListSitesByNameParams) ([]db.ListSitesByNameRow, error) {
+	if m.ListSitesByNameFunc != nil {
+		return m.ListSitesByNameFunc(ctx, arg)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) QueueSiteReconciliation(ctx context.Context, arg db.QueueSiteReconciliationParams) error {
+	if m.QueueSiteReconciliationFunc != nil {
+		return m.QueueSiteReconciliationFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpsertStorageConfig(ctx context.Context, arg db.UpsertStorageConfigParams) error {
+	if m.UpsertStorageConfigFunc != nil {
+		return m.UpsertStorageConfigFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetRegionLocation(ctx context.Context, code string) (db.GetRegionLocationRow, error) {
+	if m.GetRegionLocationFunc != nil {
+		return m.GetRegionLocationFunc(ctx, code)
+	}
+	return db.GetRegionLocationRow{}, nil
+}
+
+func (m *MockQuerier) GetSiteRegion(ctx context.Context, id int64) (db.GetSiteRegionRow, error) {
+	if m.GetSiteRegionFunc != nil {
+		return m.GetSiteRegionFunc(ctx, id)
+	}
+	return db.GetSiteRegionRow{}, nil
+}
+
+func (m *MockQuerier) SetSiteAPIRegion(ctx context.Context, arg db.SetSiteAPIRegionParams) error {
+	if m.SetSiteAPIRegionFunc != nil {
+		return m.SetSiteAPIRegionFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) GetOrganizationDataResidency(ctx context.Context, id int64) (db.NullOrganizationsDataResidency, error) {
+	if m.GetOrganizationDataResidencyFunc != nil {
+		return m.GetOrganizationDataResidencyFunc(ctx, id)
+	}
+	return db.NullOrganizationsDataResidency{}, nil
+}
+
+func (m *MockQuerier) UpdateOrganizationDataResidency(ctx context.Context, arg db.UpdateOrganizationDataResidencyParams) error {
+	if m.UpdateOrganizationDataResidencyFunc != nil {
+		return m.UpdateOrganizationDataResidencyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListOrganizationProjectRegions(ctx context.Context, organizationID int64) ([]db.ListOrganizationProjectRegionsRow, error) {
+	if m.ListOrganizationProjectRegionsFunc != nil {
+		return m.ListOrganizationProjectRegionsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) ListOrganizationEncryptionKeys(ctx context.Context, organizationID int64) ([]db.OrganizationEncryptionKey, error) {
+	if m.ListOrganizationEncryptionKeysFunc != nil {
+		return m.ListOrganizationEncryptionKeysFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) SetOrganizationEncryptionKey(ctx context.Context, arg db.SetOrganizationEncryptionKeyParams) error {
+	if m.SetOrganizationEncryptionKeyFunc != nil {
+		return m.SetOrganizationEncryptionKeyFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) UpdateOrganizationEncryptionKeyStatus(ctx context.Context, arg db.UpdateOrganizationEncryptionKeyStatusParams) error {
+	if m.UpdateOrganizationEncryptionKeyStatusFunc != nil {
+		return m.UpdateOrganizationEncryptionKeyStatusFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) DeleteOrganizationEncryptionKey(ctx context.Context, arg db.DeleteOrganizationEncryptionKeyParams) (int64, error) {
+	if m.DeleteOrganizationEncryptionKeyFunc != nil {
+		return m.DeleteOrganizationEncryptionKeyFunc(ctx, arg)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ListOrganizationGcpProjects(ctx context.Context, organizationID int64) ([]db.ListOrganizationGcpProjectsRow, error) {
+	if m.ListOrganizationGcpProjectsFunc != nil {
+		return m.ListOrganizationGcpProjectsFunc(ctx, organizationID)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) GetOrganizationStateLocation(ctx context.Context, id int64) (string, error) {
+	if m.GetOrganizationStateLocationFunc != nil {
+		return m.GetOrganizationStateLocationFunc(ctx, id)
+	}
+	return "us", nil
+}
+
+func (m *MockQuerier) GetActivePlatformAuditor(ctx context.Context, accountID int64) (time.Time, error) {
+	if m.GetActivePlatformAuditorFunc != nil {
+		return m.GetActivePlatformAuditorFunc(ctx, accountID)
+	}
+	return time.Time{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GrantPlatformAuditor(ctx context.Context, arg db.GrantPlatformAuditorParams) error {
+	if m.GrantPlatformAuditorFunc != nil {
+		return m.GrantPlatformAuditorFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) ListPlatformAuditors(ctx context.Context) ([]db.ListPlatformAuditorsRow, error) {
+	if m.ListPlatformAuditorsFunc != nil {
+		return m.ListPlatformAuditorsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) RevokePlatformAuditor(ctx context.Context, accountID int64) (int64, error) {
+	if m.RevokePlatformAuditorFunc != nil {
+		return m.RevokePlatformAuditorFunc(ctx, accountID)
+	}
+	return 0, nil
+}
+
+func (m *MockQuerier) ClearEffectiveAccessStale(ctx context.Context, accountID int64) error {
+	return nil
+}
+
+func (m *MockQuerier) ComputeEffectiveOrganizationAccess(ctx context.Context, accountID int64) ([]db.ComputeEffectiveOrganizationAccessRow, error) {
+	return nil, nil
+}
+
+func (m *MockQuerier) DeleteEffectiveOrganizationAccess(ctx context.Context, accountID int64) error {
+	return nil
+}
+
+func (m *MockQuerier) GetEffectiveOrganizationAccess(ctx context.Context, arg db.GetEffectiveOrganizationAccessParams) (db.GetEffectiveOrganizationAccessRow, error) {
+	if m.GetEffectiveOrganizationAccessFunc != nil {
+		return m.GetEffectiveOrganizationAccessFunc(ctx, arg)
+	}
+	return db.GetEffectiveOrganizationAccessRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) InsertEffectiveOrganizationAccess(ctx context.Context, arg db.InsertEffectiveOrganizationAccessParams) error {
+	return nil
+}
+
+func (m *MockQuerier) IsEffectiveAccessStale(ctx context.Context, accountID int64) (bool, error) {
+	if m.IsEffectiveAccessStaleFunc != nil {
+		return m.IsEffectiveAccessStaleFunc(ctx, accountID)
+	}
+	return false, nil
+}
+
+func (m *MockQuerier) ListStaleEffectiveAccessAccounts(ctx context.Context, limit int32) ([]int64, error) {
+	if m.ListStaleEffectiveAccessAccountsFunc != nil {
+		return m.ListStaleEffectiveAccessAccountsFunc(ctx, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockQuerier) LockEffectiveAccessStale(ctx context.Context, accountID int64) (int64, error) {
+	return 0, sql.ErrNoRows
+}
+
+func (m *MockQuerier) GetOldestOutboxEvent(ctx context.Context) (db.GetOldestOutboxEventRow, error) {
+	if m.GetOldestOutboxEventFunc != nil {
+		return m.GetOldestOutboxEventFunc(ctx)
+	}
+	return db.GetOldestOutboxEventRow{}, sql.ErrNoRows
+}
+
+func (m *MockQuerier) UpdateProjectStripeSubscriptionItem(ctx context.Context, arg db.UpdateProjectStripeSubscriptionItemParams) error {
+	if m.UpdateProjectStripeSubscriptionItemFunc != nil {
+		return m.UpdateProjectStripeSubscriptionItemFunc(ctx, arg)
+	}
+	return nil
+}
+
+func (m *MockQuerier) MarkReconciliationRunTriggered(ctx context.Context, runID string) (int64, error) {
+	if m.MarkReconciliationRunTriggeredFunc != nil {
+		return m.MarkReconciliationRunTriggeredFunc(ctx, runID)
+	}
+	return 0, nil
+}
@@ -0,0 +1,97 @@
+package tfstate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	storage "google.golang.org/api/storage/v1"
+)
+
+// GCS is a Store backed by Cloud Storage. The API's identity needs
+// roles/storage.objectAdmin on the organizations' tfstate buckets.
+type GCS struct {
+	objects *storage.Service
+}
+
+// Compile-time check.
+var _ Store = (*GCS)(nil)
+
+// NewGCS creates a Cloud Storage store using application default credentials
+func NewGCS(ctx context.Context) (*GCS, error) {
+	objects, err := storage.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage client: %w", err)
+	}
+	return &GCS{objects: objects}, nil
+}
+
+// Versions lists every generation of an object, newest first
+func (g *GCS) Versions(ctx context.Context, bucket, object string) ([]Version, error) {
+	var versions []Version
+	err := g.objects.Objects.List(bucket).Prefix(object).Versions(true).Pages(ctx, func(page *storage.Objects) error {
+		for _, item := range page.Items {
+			if item.Name != object {
+				continue
+			}
+			version, err := toVersion(item)
+			if err != nil {
+				return err
+			}
+			versions = append(versions, *version)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of gs://%s/%s: %w", bucket, object, err)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Generation > versions[j].Generation })
+	return versions, nil
+}
+
+// Live returns an object's current generation
+func (g *GCS) Live(ctx context.Context, bucket, object string) (*Version, error) {
+	item, err := g.objects.Objects.Get(bucket, object).Context(ctx).Do()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gs://%s/%s: %w", bucket, object, err)
+	}
+	return toVersion(item)
+}
+
+// Copy copies a generation of src to dst with an ifGenerationMatch precondition
+func (g *GCS) Copy(ctx context.Context, bucket, src string, generation int64, dst string, ifGeneration int64) (*Version, error) {
+	item, err := g.objects.Objects.Copy(bucket, src, bucket, dst, &storage.Object{ContentType: "application/json"}).
+		SourceGeneration(generation).
+		IfGenerationMatch(ifGeneration).
+		Context(ctx).
+		Do()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed {
+		return nil, ErrConflict
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy gs://%s/%s#%d to %s: %w", bucket, src, generation, dst, err)
+	}
+	return toVersion(item)
+}
+
+func toVersion(item *storage.Object) (*Version, error) {
+	updated, err := time.Parse(time.RFC3339, item.Updated)
+	if err != nil {
+		return nil, fmt.Errorf("object %s has an invalid update time: %w", item.Name, err)
+	}
+	return &Version{
+		Generation: item.Generation,
+		SizeBytes:  int64(item.Size),
+		UpdatedAt:  updated,
+		Live:       item.TimeDeleted == "",
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Package tfstate reads and copies the Terraform state kept in each
+// organization's tfstate bucket. The buckets are versioned, so every state an
+// organization's runs have written is kept as a noncurrent generation until
+// the bucket's lifecycle rules delete it; operators can back the state up
+// before a risky operation and restore any kept generation or backup.
+package tfstate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+// OrganizationPrefix is the backend prefix of an organization's own state.
+// Until the organization is migrated to isolated workspaces it holds every
+// project and site as well.
+const OrganizationPrefix = "terraform/state"
+
+// StateObject and LockObject are the state and lock the terraform runner's
+// GCS backend reads and writes under OrganizationPrefix
+const (
+	StateObject = OrganizationPrefix + "/default.tfstate"
+	LockObject  = OrganizationPrefix + "/default.tflock"
+)
+
+// MigrationModule is the module a terraform run lists to split an
+// organization's monolithic state into workspaces
+const MigrationModule = "state_migration"
+
+// backupPrefix is where backups are copied to, outside the backend's prefix
+// so terraform never reads them
+const backupPrefix = "terraform/backups/"
+
+// ErrConflict is returned when a copy's generation precondition fails, i.e.
+// the destination changed since it was read
+var ErrConflict = errors.New("object generation changed")
+
+// Version is one generation of an object
+type Version struct {
+	Generation int64
+	SizeBytes  int64
+	UpdatedAt  time.Time
+	// Live is set on the object's current generation; the others are
+	// noncurrent versions kept by bucket versioning
+	Live bool
+}
+
+// Store lists and copies object generations in a versioned bucket
+type Store interface {
+	// Versions lists every kept generation of an object, newest first
+	Versions(ctx context.Context, bucket, object string) ([]Version, error)
+	// Live returns an object's current generation, or nil when it doesn't exist
+	Live(ctx context.Context, bucket, object string) (*Version, error)
+	// Copy copies generation of src to dst, only while dst's current
+	// generation is ifGeneration; 0 requires dst not to exist. It returns
+	// ErrConflict when that no longer holds.
+	Copy(ctx context.Context, bucket, src string, generation int64, dst string, ifGeneration int64) (*Version, error)
+}
+
+// BucketName is the tfstate bucket the organization module creates for an
+// organization
+func BucketName(organizationPublicID string) string {
+	prefix := organizationPublicID
+	if len(prefix) > 8 {
+		prefix = prefix[:8]
+	}
+	return fmt.Sprintf("libops-org-%s-tfstate", prefix)
+}
+
+// BackupObject is where a backup of the state under prefix is copied to,
+// grouped by workspace. Names sort by when they were taken.
+func BackupObject(prefix, backupPublicID string, takenAt time.Time) string {
+	workspace := "organization"
+	if prefix != OrganizationPrefix {
+		workspace = strings.TrimPrefix(prefix, "terraform/")
+	}
+	return fmt.Sprintf("%s%s/%s-%s.tfstate", backupPrefix, workspace, takenAt.UTC().Format("20060102T150405Z"), backupPublicID)
+}
+
+// Layout returns how an organization's state is split. Organizations are
+// monolithic until they are migrated.
+func Layout(ctx context.Context, querier db.Querier, organizationID int64) (db.TerraformStateLayoutsLayout, error) {
+	layout, err := querier.GetTerraformStateLayout(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.TerraformStateLayoutsLayoutMonolithic, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return layout.Layout, nil
+}
+
+// ProjectPrefix is the backend prefix of a project's workspace once its
+// organization is isolated
+func ProjectPrefix(projectPublicID string) string {
+	return "terraform/projects/" + projectPublicID
+}
+
+// SitePrefix is the backend prefix of a site's workspace once its organization
+// is isolated
+func SitePrefix(sitePublicID string) string {
+	return "terraform/sites/" + sitePublicID
+}
+
+// StateObjectAt is the state the GCS backend keeps under prefix for the
+// default workspace
+func StateObjectAt(prefix string) string {
+	return prefix + "/default.tfstate"
+}
+
+// LockObjectAt is the lock the GCS backend takes under prefix while a run
+// writes the state
+func LockObjectAt(prefix string) string {
+	return prefix + "/default.tflock"
+}
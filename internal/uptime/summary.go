@@ -0,0 +1,101 @@
+package uptime
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libops/api/db"
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// bucketSize picks a bucket width that keeps graphs between 24 and 120 bars
+func bucketSize(windowHours int32) time.Duration {
+	switch {
+	case windowHours <= 48:
+		return time.Hour
+	case windowHours <= 7*24:
+		return 6 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// Summarize reports a site's uptime over the last windowHours. Buckets cover
+// the whole window, oldest first, with zero checks where nothing was probed.
+func Summarize(ctx context.Context, querier db.Querier, siteID int64, sitePublicID string, windowHours int32, now time.Time) (*libopsv1.SiteUptime, error) {
+	size := bucketSize(windowHours)
+	bucketSeconds := int64(size / time.Second)
+	count := int64(time.Duration(windowHours)*time.Hour) / int64(size)
+	first := now.Unix()/bucketSeconds - count + 1
+
+	rows, err := querier.ListSiteProbeBuckets(ctx, db.ListSiteProbeBucketsParams{
+		BucketSeconds: bucketSeconds,
+		SiteID:        siteID,
+		Since:         sql.NullTime{Time: time.Unix(first*bucketSeconds, 0), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list probe buckets: %w", err)
+	}
+
+	path, err := querier.GetSiteHealthCheckPath(ctx, siteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health check path: %w", err)
+	}
+
+	summary := &libopsv1.SiteUptime{
+		SiteId:          sitePublicID,
+		HealthCheckPath: path,
+		WindowHours:     windowHours,
+		UptimePercent:   100,
+		BucketSeconds:   int32(bucketSeconds),
+		Buckets:         make([]*libopsv1.UptimeBucket, count),
+	}
+	for i := range summary.Buckets {
+		summary.Buckets[i] = &libopsv1.UptimeBucket{StartTime: (first + int64(i)) * bucketSeconds}
+	}
+
+	var upResponseTotal, upChecks int64
+	for _, row := range rows {
+		i := row.Bucket - first
+		if i < 0 || i >= count {
+			continue
+		}
+		ups := row.Checks - row.Failures
+		bucket := summary.Buckets[i]
+		bucket.Checks = int32(row.Checks)
+		bucket.Failures = int32(row.Failures)
+		if ups > 0 {
+			bucket.AverageResponseMs = int32(row.UpResponseTimeMs / ups)
+		}
+
+		summary.Checks += int32(row.Checks)
+		summary.Failures += int32(row.Failures)
+		upResponseTotal += row.UpResponseTimeMs
+		upChecks += ups
+	}
+	if summary.Checks > 0 {
+		summary.UptimePercent = float64(summary.Checks-summary.Failures) * 100 / float64(summary.Checks)
+	}
+	if upChecks > 0 {
+		summary.AverageResponseMs = int32(upResponseTotal / upChecks)
+	}
+
+	latest, err := querier.GetLatestSiteProbe(ctx, siteID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get latest probe: %w", err)
+	}
+	if err == nil {
+		summary.LastProbe = &libopsv1.UptimeProbe{
+			ProbedAt:   latest.ProbedAt.Time.Unix(),
+			Up:         latest.Up,
+			StatusCode: latest.StatusCode,
+			ResponseMs: latest.ResponseTimeMs,
+			Error:      latest.Error,
+		}
+	}
+
+	return summary, nil
+}
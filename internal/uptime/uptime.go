@@ -0,0 +1,183 @@
+// Package uptime probes each site's health URL from outside its VM and
+// summarizes the probe history into uptime and response-time figures.
+package uptime
+
+import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libops/api/db"
+)
+
+const (
+	// Retention is how long probe results are kept
+	Retention = 30 * 24 * time.Hour
+
+	// probeTimeout bounds each request; slower responses count as down
+	probeTimeout = 10 * time.Second
+
+	// probeConcurrency caps how many sites are probed at once
+	probeConcurrency = 10
+
+	maxProbeErrorLength = 255
+)
+
+// Prober requests every active site's health URL and records the outcome
+type Prober struct {
+	db     db.Querier
+	client *http.Client
+}
+
+// NewProber creates a prober
+func NewProber(querier db.Querier) *Prober {
+	return &Prober{
+		db: querier,
+		client: &http.Client{
+			Timeout: probeTimeout,
+			// A redirect means the web server answered; following it could leave the site
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+			Transport: &http.Transport{
+				// Sites probed by IP present certificates for their domains, not the IP.
+				// Probes check that the web server answers, not who it is.
+				TLSClientConfig:   &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+				DisableKeepAlives: true,
+			},
+		},
+	}
+}
+
+// Sweep probes every active site once and returns how many were probed and how many were down
+func (p *Prober) Sweep(ctx context.Context, now time.Time) (probed, down int, err error) {
+	targets, err := p.db.ListProbeTargets(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list probe targets: %w", err)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, probeConcurrency)
+	for _, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(target db.ListProbeTargetsRow) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result := p.probe(ctx, TargetURL(target))
+			err := p.db.CreateSiteProbe(ctx, db.CreateSiteProbeParams{
+				SiteID:         target.ID,
+				ProbedAt:       sql.NullTime{Time: now, Valid: true},
+				Up:             result.Up,
+				StatusCode:     result.StatusCode,
+				ResponseTimeMs: result.ResponseTimeMs,
+				Error:          result.Error,
+			})
+			if err != nil {
+				slog.Error("Failed to record site probe", "error", err, "site_id", target.PublicID)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			probed++
+			if !result.Up {
+				down++
+			}
+		}(target)
+	}
+	wg.Wait()
+
+	return probed, down, nil
+}
+
+// result is the outcome of one probe
+type result struct {
+	Up             bool
+	StatusCode     int32
+	ResponseTimeMs int32
+	Error          string
+}
+
+func (p *Prober) probe(ctx context.Context, target string) result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return result{Error: "invalid health URL"}
+	}
+	req.Header.Set("User-Agent", "LibOps-Uptime/1.0")
+
+	start := time.Now()
+	resp, err := p.client.Do(req)
+	elapsed := int32(time.Since(start).Milliseconds())
+	if err != nil {
+		return result{ResponseTimeMs: elapsed, Error: describeError(err)}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	r := result{
+		Up:             resp.StatusCode >= 200 && resp.StatusCode < 400,
+		StatusCode:     int32(resp.StatusCode),
+		ResponseTimeMs: elapsed,
+	}
+	if !r.Up {
+		r.Error = resp.Status
+	}
+	return r
+}
+
+// describeError turns a client error into a short reason without the request URL
+func describeError(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return fmt.Sprintf("no response within %s", probeTimeout)
+	}
+	message := err.Error()
+	if len(message) > maxProbeErrorLength {
+		message = message[:maxProbeErrorLength]
+	}
+	return message
+}
+
+// TargetURL returns the health URL probed for a site: its domain over HTTPS when it
+// has one, otherwise its external IP on the application port.
+func TargetURL(target db.ListProbeTargetsRow) string {
+	path := target.HealthCheckPath
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if target.Domain.Valid && target.Domain.String != "" {
+		return "https://" + target.Domain.String + path
+	}
+
+	port := int32(80)
+	if target.Port.Valid && target.Port.Int32 > 0 {
+		port = target.Port.Int32
+	}
+	scheme := "http"
+	if port == 443 {
+		scheme = "https"
+	}
+	host := target.GcpExternalIp.String
+	if port != 80 && port != 443 {
+		host = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	return scheme + "://" + host + path
+}
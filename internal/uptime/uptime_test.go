@@ -0,0 +1,230 @@
+package uptime
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/libops/api/db"
+	"github.com/libops/api/internal/testutils"
+)
+
+// probeTarget points a probe target at a test server by IP and port.
+func probeTarget(t *testing.T, id int64, srv *httptest.Server, path string) db.ListProbeTargetsRow {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	assert.NoError(t, err)
+	host, port, err := net.SplitHostPort(u.Host)
+	assert.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+	return db.ListProbeTargetsRow{
+		ID:              id,
+		PublicID:        "site-" + strconv.FormatInt(id, 10),
+		Port:            sql.NullInt32{Int32: int32(portNumber), Valid: true},
+		HealthCheckPath: path,
+		GcpExternalIp:   sql.NullString{String: host, Valid: true},
+	}
+}
+
+// TestProberSweep tests that each site is probed once and non-2xx/3xx responses count as down.
+func TestProberSweep(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/login":
+			http.Redirect(w, r, "https://idp.example.com/", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	recorded := map[int64]db.CreateSiteProbeParams{}
+	mock := &testutils.MockQuerier{
+		ListProbeTargetsFunc: func(ctx context.Context) ([]db.ListProbeTargetsRow, error) {
+			return []db.ListProbeTargetsRow{
+				probeTarget(t, 1, srv, "/healthz"),
+				probeTarget(t, 2, srv, "/login"),
+				probeTarget(t, 3, srv, "/"),
+			}, nil
+		},
+		CreateSiteProbeFunc: func(ctx context.Context, arg db.CreateSiteProbeParams) error {
+			mu.Lock()
+			defer mu.Unlock()
+			recorded[arg.SiteID] = arg
+			return nil
+		},
+	}
+
+	probed, down, err := NewProber(mock).Sweep(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, probed)
+	assert.Equal(t, 1, down)
+	assert.ElementsMatch(t, []string{"/healthz", "/login", "/"}, paths)
+
+	assert.True(t, recorded[1].Up)
+	assert.Equal(t, int32(200), recorded[1].StatusCode)
+	assert.Equal(t, sql.NullTime{Time: now, Valid: true}, recorded[1].ProbedAt)
+
+	// The redirect is not followed
+	assert.True(t, recorded[2].Up)
+	assert.Equal(t, int32(302), recorded[2].StatusCode)
+
+	assert.False(t, recorded[3].Up)
+	assert.Equal(t, int32(502), recorded[3].StatusCode)
+	assert.Equal(t, "502 Bad Gateway", recorded[3].Error)
+}
+
+// TestProberSweepUnreachable tests that connection failures are recorded without a status code.
+func TestProberSweepUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	target := probeTarget(t, 1, srv, "/")
+	srv.Close()
+
+	var recorded db.CreateSiteProbeParams
+	mock := &testutils.MockQuerier{
+		ListProbeTargetsFunc: func(ctx context.Context) ([]db.ListProbeTargetsRow, error) {
+			return []db.ListProbeTargetsRow{target}, nil
+		},
+		CreateSiteProbeFunc: func(ctx context.Context, arg db.CreateSiteProbeParams) error {
+			recorded = arg
+			return nil
+		},
+	}
+
+	probed, down, err := NewProber(mock).Sweep(context.Background(), time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, probed)
+	assert.Equal(t, 1, down)
+	assert.False(t, recorded.Up)
+	assert.Equal(t, int32(0), recorded.StatusCode)
+	assert.NotEmpty(t, recorded.Error)
+	assert.NotContains(t, recorded.Error, srv.URL)
+}
+
+// TestTargetURL tests that sites with a domain are probed over HTTPS and others by IP.
+func TestTargetURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		target db.ListProbeTargetsRow
+		want   string
+	}{
+		{
+			name: "domain",
+			target: db.ListProbeTargetsRow{
+				HealthCheckPath: "/healthz",
+				Domain:          sql.NullString{String: "www.example.edu", Valid: true},
+				GcpExternalIp:   sql.NullString{String: "203.0.113.7", Valid: true},
+				Port:            sql.NullInt32{Int32: 8080, Valid: true},
+			},
+			want: "https://www.example.edu/healthz",
+		},
+		{
+			name: "ip on port 80",
+			target: db.ListProbeTargetsRow{
+				HealthCheckPath: "/",
+				GcpExternalIp:   sql.NullString{String: "203.0.113.7", Valid: true},
+				Port:            sql.NullInt32{Int32: 80, Valid: true},
+			},
+			want: "http://203.0.113.7/",
+		},
+		{
+			name: "ip on port 443",
+			target: db.ListProbeTargetsRow{
+				HealthCheckPath: "/status?full=1",
+				GcpExternalIp:   sql.NullString{String: "203.0.113.7", Valid: true},
+				Port:            sql.NullInt32{Int32: 443, Valid: true},
+			},
+			want: "https://203.0.113.7/status?full=1",
+		},
+		{
+			name: "ip on custom port",
+			target: db.ListProbeTargetsRow{
+				HealthCheckPath: "health",
+				GcpExternalIp:   sql.NullString{String: "203.0.113.7", Valid: true},
+				Port:            sql.NullInt32{Int32: 8080, Valid: true},
+			},
+			want: "http://203.0.113.7:8080/health",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TargetURL(tt.target))
+		})
+	}
+}
+
+// TestSummarize tests that buckets cover the whole window and totals only count probed buckets.
+func TestSummarize(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 30, 0, 0, time.UTC)
+	currentHour := now.Unix() / 3600
+
+	var params db.ListSiteProbeBucketsParams
+	mock := &testutils.MockQuerier{
+		ListSiteProbeBucketsFunc: func(ctx context.Context, arg db.ListSiteProbeBucketsParams) ([]db.ListSiteProbeBucketsRow, error) {
+			params = arg
+			return []db.ListSiteProbeBucketsRow{
+				{Bucket: currentHour - 1, Checks: 60, Failures: 0, UpResponseTimeMs: 6000},
+				{Bucket: currentHour, Checks: 30, Failures: 10, UpResponseTimeMs: 4000},
+			}, nil
+		},
+		GetLatestSiteProbeFunc: func(ctx context.Context, siteID int64) (db.GetLatestSiteProbeRow, error) {
+			return db.GetLatestSiteProbeRow{
+				ProbedAt:   sql.NullTime{Time: now, Valid: true},
+				StatusCode: 503,
+				Error:      "503 Service Unavailable",
+			}, nil
+		},
+	}
+
+	summary, err := Summarize(context.Background(), mock, 3, "site-3", 24, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), params.BucketSeconds)
+	assert.Equal(t, time.Unix((currentHour-23)*3600, 0), params.Since.Time)
+
+	assert.Equal(t, "/", summary.HealthCheckPath)
+	assert.Equal(t, int32(3600), summary.BucketSeconds)
+	if assert.Len(t, summary.Buckets, 24) {
+		assert.Equal(t, (currentHour-23)*3600, summary.Buckets[0].StartTime)
+		assert.Equal(t, int32(0), summary.Buckets[0].Checks)
+		assert.Equal(t, int32(100), summary.Buckets[22].AverageResponseMs)
+		assert.Equal(t, int32(10), summary.Buckets[23].Failures)
+		assert.Equal(t, int32(200), summary.Buckets[23].AverageResponseMs)
+	}
+	assert.Equal(t, int32(90), summary.Checks)
+	assert.Equal(t, int32(10), summary.Failures)
+	assert.InDelta(t, 88.89, summary.UptimePercent, 0.01)
+	assert.Equal(t, int32(125), summary.AverageResponseMs)
+	if assert.NotNil(t, summary.LastProbe) {
+		assert.False(t, summary.LastProbe.Up)
+		assert.Equal(t, int32(503), summary.LastProbe.StatusCode)
+	}
+}
+
+// TestSummarizeWithoutProbes tests that an unprobed site reports full uptime and no last probe.
+func TestSummarizeWithoutProbes(t *testing.T) {
+	summary, err := Summarize(context.Background(), &testutils.MockQuerier{}, 3, "site-3", 7*24, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, float64(100), summary.UptimePercent)
+	assert.Equal(t, int32(6*3600), summary.BucketSeconds)
+	assert.Len(t, summary.Buckets, 28)
+	assert.Nil(t, summary.LastProbe)
+}
@@ -0,0 +1,191 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const fieldMaskFullName protoreflect.FullName = "google.protobuf.FieldMask"
+
+// fieldRules maps proto field names to the format check applied to every
+// non-empty string field with that name, at any depth of a request message.
+// Presence is left to handlers since many of these fields are optional filters.
+var fieldRules = map[protoreflect.Name]func(string) error{
+	"account_id":        UUID,
+	"api_key_id":        UUID,
+	"organization_id":   UUID,
+	"project_id":        UUID,
+	"site_id":           UUID,
+	"key_id":            UUID,
+	"rule_id":           UUID,
+	"secret_id":         UUID,
+	"setting_id":        UUID,
+	"endpoint_id":       UUID,
+	"redirect_id":       UUID,
+	"exclusion_id":      UUID,
+	"cidr":              CIDR,
+	"organization_name": OrganizationName,
+	"project_name":      ProjectName,
+	"site_name":         SiteName,
+}
+
+// Interceptor rejects malformed requests with INVALID_ARGUMENT before they
+// reach authorization or handlers. Violations are attached as a
+// google.rpc.BadRequest detail so clients can map errors back to fields.
+type Interceptor struct{}
+
+// NewInterceptor creates a new request validation interceptor.
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+// WrapUnary validates unary request messages.
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if msg, ok := req.Any().(proto.Message); ok {
+			if violations := Message(msg); len(violations) > 0 {
+				return nil, NewInvalidArgumentError(violations)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps client streaming RPCs.
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler wraps server streaming RPCs.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// Message checks a request message against fieldRules and verifies that any
+// FieldMask paths resolve to fields of the message carrying the mask.
+func Message(msg proto.Message) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	walk(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+// NewInvalidArgumentError builds an INVALID_ARGUMENT error carrying the
+// violations as a BadRequest detail.
+func NewInvalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) *connect.Error {
+	parts := make([]string, 0, len(violations))
+	for _, v := range violations {
+		parts = append(parts, fmt.Sprintf("%s: %s", v.Field, v.Description))
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request: %s", strings.Join(parts, "; ")))
+
+	detail, err := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+func walk(msg protoreflect.Message, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) {
+	// Iterate in declaration order rather than Range so violations are reported
+	// in a stable order.
+	fields := msg.Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		fd := fields.Get(idx)
+		if !msg.Has(fd) {
+			continue
+		}
+		value := msg.Get(fd)
+		path := prefix + string(fd.Name())
+
+		switch {
+		case fd.IsMap():
+			// Maps carry free-form data (labels, variables); nothing to check.
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == fieldMaskFullName:
+			checkFieldMask(msg.Descriptor(), path, value.Message(), violations)
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := value.List()
+			for item := 0; item < list.Len(); item++ {
+				walk(list.Get(item).Message(), fmt.Sprintf("%s[%d].", path, item), violations)
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			if !isWellKnown(fd.Message()) {
+				walk(value.Message(), path+".", violations)
+			}
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList():
+			rule, ok := fieldRules[fd.Name()]
+			if !ok || value.String() == "" {
+				continue
+			}
+			if err := rule(value.String()); err != nil {
+				*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+					Field:       path,
+					Description: describe(err),
+				})
+			}
+		}
+	}
+}
+
+// checkFieldMask verifies each mask path names a field reachable from parent.
+func checkFieldMask(parent protoreflect.MessageDescriptor, path string, mask protoreflect.Message, violations *[]*errdetails.BadRequest_FieldViolation) {
+	paths := mask.Get(mask.Descriptor().Fields().ByName("paths")).List()
+	for idx := 0; idx < paths.Len(); idx++ {
+		maskPath := paths.Get(idx).String()
+		if !resolvesPath(parent, maskPath) {
+			*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("%s.paths[%d]", path, idx),
+				Description: fmt.Sprintf("unknown field path %q", maskPath),
+			})
+		}
+	}
+}
+
+// resolvesPath reports whether maskPath names a field under md. A lone "*"
+// selects every field, and a trailing ".*" every field of the message it
+// follows, as the handlers' update masks accept.
+func resolvesPath(md protoreflect.MessageDescriptor, maskPath string) bool {
+	if maskPath == "*" {
+		return true
+	}
+	maskPath, wildcard := strings.CutSuffix(maskPath, ".*")
+	if maskPath == "" {
+		return false
+	}
+	segments := strings.Split(maskPath, ".")
+	for idx, segment := range segments {
+		fd := md.Fields().ByName(protoreflect.Name(segment))
+		if fd == nil {
+			return false
+		}
+		isMessage := fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap()
+		if idx == len(segments)-1 {
+			return !wildcard || isMessage
+		}
+		if !isMessage {
+			return false
+		}
+		md = fd.Message()
+	}
+	return true
+}
+
+func isWellKnown(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == "google.protobuf"
+}
+
+// describe returns the human-readable part of a validation error without the
+// generic field prefix used by the standalone validators.
+func describe(err error) string {
+	var vErr *Error
+	if errors.As(err, &vErr) {
+		return vErr.Message
+	}
+	return err.Error()
+}
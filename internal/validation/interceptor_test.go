@@ -0,0 +1,133 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	adminv1 "github.com/libops/api/proto/libops/v1/admin"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+const validUUID = "0b9a7f2e-3c1d-4e5f-8a6b-7c8d9e0f1a2b"
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        proto.Message
+		wantFields []string
+	}{
+		{
+			name: "valid update with mask",
+			msg: &libopsv1.UpdateProjectRequest{
+				OrganizationId: validUUID,
+				ProjectId:      validUUID,
+				Project:        &commonv1.ProjectConfig{ProjectName: "website"},
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"project.project_name", "project.region"}},
+			},
+		},
+		{
+			name: "nested admin mask path",
+			msg: &libopsv1.AdminUpdateOrganizationRequest{
+				OrganizationId: validUUID,
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"folder.config.organization_name"}},
+			},
+		},
+		{
+			name: "wildcard mask paths",
+			msg: &libopsv1.AdminUpdateOrganizationRequest{
+				OrganizationId: validUUID,
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"*", "folder.*", "folder.config.*"}},
+			},
+		},
+		{
+			name: "wildcard over a scalar or unknown field",
+			msg: &libopsv1.UpdateProjectRequest{
+				OrganizationId: validUUID,
+				ProjectId:      validUUID,
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"project.project_name.*", "site.*", "project*", ".*"}},
+			},
+			wantFields: []string{"update_mask.paths[0]", "update_mask.paths[1]", "update_mask.paths[2]", "update_mask.paths[3]"},
+		},
+		{
+			name: "empty optional ID is left to handlers",
+			msg:  &libopsv1.ListProjectsRequest{},
+		},
+		{
+			name:       "invalid UUIDs",
+			msg:        &libopsv1.DeleteProjectRequest{OrganizationId: "org-1", ProjectId: "not-a-uuid"},
+			wantFields: []string{"organization_id", "project_id"},
+		},
+		{
+			name:       "invalid CIDR",
+			msg:        &libopsv1.CreateOrganizationFirewallRuleRequest{OrganizationId: validUUID, Cidr: "10.0.0.0/33"},
+			wantFields: []string{"cidr"},
+		},
+		{
+			name: "invalid nested organization name",
+			msg: &libopsv1.AdminCreateOrganizationRequest{
+				Folder: &adminv1.AdminFolderConfig{Config: &commonv1.FolderConfig{OrganizationName: "acme<script>"}},
+			},
+			wantFields: []string{"folder.config.organization_name"},
+		},
+		{
+			name: "unknown mask paths",
+			msg: &libopsv1.UpdateOrganizationMemberRequest{
+				OrganizationId: validUUID,
+				AccountId:      validUUID,
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"role", "permissions", "role.name"}},
+			},
+			wantFields: []string{"update_mask.paths[1]", "update_mask.paths[2]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := Message(tt.msg)
+			if len(violations) != len(tt.wantFields) {
+				t.Fatalf("Message() = %v, want violations for %v", violations, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if violations[i].Field != field {
+					t.Errorf("violation %d field = %q, want %q", i, violations[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestInterceptor_ReturnsFieldViolations(t *testing.T) {
+	called := false
+	next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return nil, nil
+	}
+
+	req := connect.NewRequest(&libopsv1.DeleteProjectRequest{OrganizationId: validUUID, ProjectId: "bad"})
+	_, err := NewInterceptor().WrapUnary(next)(context.Background(), req)
+
+	if called {
+		t.Fatal("handler should not run for an invalid request")
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if len(connectErr.Details()) != 1 {
+		t.Fatalf("expected 1 error detail, got %d", len(connectErr.Details()))
+	}
+	value, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("failed to decode detail: %v", err)
+	}
+	badRequest, ok := value.(*errdetails.BadRequest)
+	if !ok || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "project_id" {
+		t.Errorf("unexpected detail: %v", value)
+	}
+}
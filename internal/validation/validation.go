@@ -101,6 +101,35 @@ func IPAddress(ip string) error {
 	return nil
 }
 
+// hostnameLabelRegex matches one DNS label: letters, digits and inner hyphens.
+var hostnameLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Hostname validates a fully qualified, lowercase domain name such as a site's
+// custom domain. IP addresses and wildcards are rejected.
+func Hostname(hostname string) error {
+	if hostname == "" {
+		return NewError("domain", "domain is required")
+	}
+	if len(hostname) > 253 {
+		return NewError("domain", "domain too long (max 253 characters)")
+	}
+	if net.ParseIP(hostname) != nil {
+		return NewError("domain", "domain must be a hostname, not an IP address")
+	}
+
+	labels := strings.Split(hostname, ".")
+	if len(labels) < 2 {
+		return NewError("domain", "domain must have at least two labels")
+	}
+	for _, label := range labels {
+		if !hostnameLabelRegex.MatchString(label) {
+			return NewError("domain", "invalid domain format")
+		}
+	}
+
+	return nil
+}
+
 // UUID validates a UUID string.
 func UUID(uuidStr string) error {
 	if uuidStr == "" {
@@ -394,6 +423,36 @@ func SSHPublicKey(key string) error {
 	return nil
 }
 
+// maxLabels caps how many labels a single resource may carry.
+const maxLabels = 64
+
+var (
+	labelKeyPattern   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValuePattern = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+)
+
+// Labels validates resource labels. The format mirrors GCP labels so they can
+// be propagated to cloud resources unchanged:
+//   - Keys are 1-63 characters, start with a lowercase letter, and contain only
+//     lowercase letters, digits, underscores, and hyphens
+//   - Values are 0-63 characters from the same set.
+func Labels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return NewError("labels", fmt.Sprintf("at most %d labels are allowed", maxLabels))
+	}
+
+	for key, value := range labels {
+		if !labelKeyPattern.MatchString(key) {
+			return NewError("labels", fmt.Sprintf("invalid label key %q (must start with a lowercase letter and contain only lowercase letters, digits, underscores, and hyphens, max 63 characters)", key))
+		}
+		if !labelValuePattern.MatchString(value) {
+			return NewError("labels", fmt.Sprintf("invalid value for label %q (may contain only lowercase letters, digits, underscores, and hyphens, max 63 characters)", key))
+		}
+	}
+
+	return nil
+}
+
 // GCPZoneMatchesRegion validates that a GCP zone is prefixed by the specified region.
 // For example, zone "us-central1-f" must be in region "us-central1".
 func GCPZoneMatchesRegion(region, zone string) error {
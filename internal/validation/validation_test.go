@@ -2,6 +2,8 @@ package validation
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -87,6 +89,35 @@ func TestIPAddress(t *testing.T) {
 	}
 }
 
+func TestHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		wantErr  bool
+	}{
+		{"valid domain", "example.edu", false},
+		{"valid subdomain", "digital.library.example.edu", false},
+		{"valid with hyphen", "my-site.example.org", false},
+		{"empty", "", true},
+		{"single label", "localhost", true},
+		{"uppercase", "Example.edu", true},
+		{"wildcard", "*.example.edu", true},
+		{"leading hyphen", "-site.example.edu", true},
+		{"trailing dot", "example.edu.", true},
+		{"IP address", "192.168.1.1", true},
+		{"with scheme", "https://example.edu", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Hostname(tt.hostname)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Hostname() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestUUID(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -289,6 +320,40 @@ func TestSSHPublicKey(t *testing.T) {
 	}
 }
 
+func TestLabels(t *testing.T) {
+	tooMany := make(map[string]string, maxLabels+1)
+	for i := 0; i <= maxLabels; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "v"
+	}
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"valid", map[string]string{"env": "prod", "department": "library"}, false},
+		{"empty value", map[string]string{"archived": ""}, false},
+		{"underscore and hyphen", map[string]string{"cost_center": "lib-42"}, false},
+		{"uppercase key", map[string]string{"Env": "prod"}, true},
+		{"key starts with digit", map[string]string{"1env": "prod"}, true},
+		{"empty key", map[string]string{"": "prod"}, true},
+		{"invalid value", map[string]string{"env": "Prod Site"}, true},
+		{"key too long", map[string]string{strings.Repeat("a", 64): "v"}, true},
+		{"value too long", map[string]string{"env": strings.Repeat("a", 64)}, true},
+		{"too many labels", tooMany, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Labels(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Labels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestGitHubRepoIsPublic(t *testing.T) {
 	tests := []struct {
 		name    string
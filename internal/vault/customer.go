@@ -74,3 +74,10 @@ func getCustomerVaultURL(projectNumber int64, region string) string {
 	}
 	return fmt.Sprintf("https://vault-server-%d.%s.run.app", projectNumber, region)
 }
+
+// SharedCustomerVault reports whether every organization's client reaches the
+// same Vault, as CUSTOMER_VAULT_ADDR makes them in development and tests.
+// Nothing set up for one organization can be torn down there.
+func SharedCustomerVault() bool {
+	return os.Getenv("CUSTOMER_VAULT_ADDR") != ""
+}
@@ -0,0 +1,134 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	// OrganizationPolicy is the policy in an organization's Vault that grants
+	// access to its secrets and its key encryption key.
+	OrganizationPolicy = "libops-organization"
+	// OrganizationGroup is the identity group in an organization's Vault that
+	// holds OrganizationPolicy.
+	OrganizationGroup = "libops-organization"
+)
+
+// organizationMount is a secrets engine every organization's Vault has.
+type organizationMount struct {
+	path    string
+	engine  string
+	options map[string]string
+}
+
+// organizationMounts are the secrets engines secrets are kept in: KV version
+// 1 for organization, project and site secrets, and transit for the key
+// encryption key.
+var organizationMounts = []organizationMount{
+	{path: "secret-organization", engine: "kv", options: map[string]string{"version": "1"}},
+	{path: "secret-project", engine: "kv", options: map[string]string{"version": "1"}},
+	{path: "secret-site", engine: "kv", options: map[string]string{"version": "1"}},
+	{path: "transit", engine: "transit"},
+}
+
+// organizationPolicy grants the API what it needs of an organization's Vault.
+var organizationPolicy = fmt.Sprintf(`path "secret-organization/*" {
+  capabilities = ["create", "read", "update", "delete", "list"]
+}
+
+path "secret-project/*" {
+  capabilities = ["create", "read", "update", "delete", "list"]
+}
+
+path "secret-site/*" {
+  capabilities = ["create", "read", "update", "delete", "list"]
+}
+
+path "transit/keys/%[1]s" {
+  capabilities = ["create", "read", "update"]
+}
+
+path "transit/keys/%[1]s/rotate" {
+  capabilities = ["update"]
+}
+
+path "transit/datakey/plaintext/%[1]s" {
+  capabilities = ["update"]
+}
+
+path "transit/decrypt/%[1]s" {
+  capabilities = ["update"]
+}
+`, SecretsTransitKey)
+
+// ProvisionOrganization sets up an organization's Vault: the secrets
+// engines its secrets are kept in, the policy granting access to them and
+// the identity group holding it, which the client's own entity joins.
+// Anything already in place is left as it is, so it's safe to run again.
+func (c *Client) ProvisionOrganization(ctx context.Context, organizationPublicID string) error {
+	mounts, err := retryWithBackoff(ctx, "list mounts", func() (map[string]*api.MountOutput, error) {
+		return c.client.Sys().ListMountsWithContext(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list mounts: %w", err)
+	}
+	for _, mount := range organizationMounts {
+		if _, ok := mounts[mount.path+"/"]; ok {
+			continue
+		}
+		input := &api.MountInput{
+			Type:        mount.engine,
+			Description: "libops organization " + organizationPublicID,
+			Options:     mount.options,
+		}
+		if err := c.client.Sys().MountWithContext(ctx, mount.path, input); err != nil {
+			return fmt.Errorf("failed to enable %s at %s: %w", mount.engine, mount.path, err)
+		}
+		slog.Info("Enabled organization secrets engine", "organization_id", organizationPublicID, "path", mount.path, "type", mount.engine)
+	}
+
+	if err := c.client.Sys().PutPolicyWithContext(ctx, OrganizationPolicy, organizationPolicy); err != nil {
+		return fmt.Errorf("failed to write policy %s: %w", OrganizationPolicy, err)
+	}
+
+	group := map[string]any{
+		"type":     "internal",
+		"policies": []string{OrganizationPolicy},
+		"metadata": map[string]string{"organization_id": organizationPublicID},
+	}
+	// Tokens from the CUSTOMER_VAULT_TOKEN hack have no entity to add
+	if entityID, err := c.GetEntityID(ctx); err == nil && entityID != "" {
+		group["member_entity_ids"] = []string{entityID}
+	}
+	if _, err := c.client.Logical().WriteWithContext(ctx, "identity/group/name/"+OrganizationGroup, group); err != nil {
+		return fmt.Errorf("failed to write group %s: %w", OrganizationGroup, err)
+	}
+	return nil
+}
+
+// DeprovisionOrganization removes what ProvisionOrganization set up.
+// Disabling the secrets engines destroys every secret in them.
+func (c *Client) DeprovisionOrganization(ctx context.Context, organizationPublicID string) error {
+	var errs []string
+	if _, err := c.client.Logical().DeleteWithContext(ctx, "identity/group/name/"+OrganizationGroup); err != nil {
+		errs = append(errs, fmt.Sprintf("group %s: %v", OrganizationGroup, err))
+	}
+	if err := c.client.Sys().DeletePolicyWithContext(ctx, OrganizationPolicy); err != nil {
+		errs = append(errs, fmt.Sprintf("policy %s: %v", OrganizationPolicy, err))
+	}
+	for _, mount := range organizationMounts {
+		if err := c.client.Sys().UnmountWithContext(ctx, mount.path); err != nil {
+			errs = append(errs, fmt.Sprintf("mount %s: %v", mount.path, err))
+			continue
+		}
+		slog.Info("Disabled organization secrets engine", "organization_id", organizationPublicID, "path", mount.path)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to deprovision organization vault: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// PlatformOIDCProvider is the Vault OIDC provider the API issues tokens from.
+const PlatformOIDCProvider = "libops-api"
+
+// PlatformConfig is what ProvisionPlatform needs to know about the
+// environment it's setting up.
+type PlatformConfig struct {
+	// OIDCIssuer is the issuer host of the OIDC provider, e.g. "https://vault.libops.io"
+	OIDCIssuer string
+	// OIDCRedirectURIs are the API's OIDC callback URLs
+	OIDCRedirectURIs []string
+	// APIServiceAccount is the Google service account the API's Vault agent
+	// signs in as. Google JWT sign-in is only set up when it's set.
+	APIServiceAccount string
+	// JWTAudience is the audience of the API's Google ID tokens
+	JWTAudience string
+	// Policies are written by name, replacing what's there
+	Policies map[string]string
+}
+
+// platformMounts are the API's own secrets engines: API key hashes and its
+// configuration.
+var platformMounts = []organizationMount{
+	{path: "keys", engine: "kv", options: map[string]string{"version": "1"}},
+	{path: "secret", engine: "kv", options: map[string]string{"version": "1"}},
+}
+
+// platformWrite is a configuration ProvisionPlatform writes to path.
+type platformWrite struct {
+	path string
+	data map[string]any
+}
+
+// ProvisionPlatform sets up the API's own Vault: its secrets engines, the
+// userpass and Google JWT sign-in methods, the OIDC provider accounts get
+// tokens from, the token role for entity tokens and the policies. Mounts
+// already in place are left as they are and everything else is rewritten,
+// so it's safe to run again.
+func (c *Client) ProvisionPlatform(ctx context.Context, cfg PlatformConfig) error {
+	mounts, err := retryWithBackoff(ctx, "list mounts", func() (map[string]*api.MountOutput, error) {
+		return c.client.Sys().ListMountsWithContext(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list mounts: %w", err)
+	}
+	for _, mount := range platformMounts {
+		if _, ok := mounts[mount.path+"/"]; ok {
+			continue
+		}
+		input := &api.MountInput{Type: mount.engine, Description: "libops platform", Options: mount.options}
+		if err := c.client.Sys().MountWithContext(ctx, mount.path, input); err != nil {
+			return fmt.Errorf("failed to enable %s at %s: %w", mount.engine, mount.path, err)
+		}
+		slog.Info("Enabled platform secrets engine", "path", mount.path, "type", mount.engine)
+	}
+
+	auths, err := retryWithBackoff(ctx, "list auth methods", func() (map[string]*api.AuthMount, error) {
+		return c.client.Sys().ListAuthWithContext(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list auth methods: %w", err)
+	}
+	methods := []string{"userpass"}
+	if cfg.APIServiceAccount != "" {
+		methods = append(methods, "jwt")
+	}
+	for _, method := range methods {
+		if _, ok := auths[method+"/"]; ok {
+			continue
+		}
+		if err := c.client.Sys().EnableAuthWithOptionsWithContext(ctx, method, &api.EnableAuthOptions{Type: method}); err != nil {
+			return fmt.Errorf("failed to enable %s auth: %w", method, err)
+		}
+		slog.Info("Enabled platform auth method", "path", method)
+	}
+
+	// Written in order: the client, provider and role all refer to the key
+	writes := []platformWrite{
+		{"identity/oidc/key/" + PlatformOIDCProvider, map[string]any{
+			"allowed_client_ids": "*",
+			"verification_ttl":   "2h",
+			"rotation_period":    "24h",
+			"algorithm":          "RS256",
+		}},
+		{"identity/oidc/client/" + PlatformOIDCProvider, map[string]any{
+			"redirect_uris":    cfg.OIDCRedirectURIs,
+			"key":              PlatformOIDCProvider,
+			"id_token_ttl":     "30m",
+			"access_token_ttl": "1h",
+		}},
+		{"identity/oidc/provider/" + PlatformOIDCProvider, map[string]any{
+			"allowed_client_ids": "*",
+			"issuer":             cfg.OIDCIssuer,
+		}},
+		{"identity/oidc/role/" + PlatformOIDCProvider, map[string]any{
+			"key":      PlatformOIDCProvider,
+			"template": `{"account_id": {{identity.entity.metadata.account_id}},"email": {{identity.entity.metadata.email}},"name": {{identity.entity.name}}}`,
+			"ttl":      "1h",
+		}},
+		// Lets the API create tokens for account entities
+		{"auth/token/roles/entity-token", map[string]any{
+			"allowed_policies":       []string{"default", "libops-user"},
+			"allowed_entity_aliases": "*",
+			"orphan":                 true,
+			"renewable":              true,
+			"token_type":             "service",
+		}},
+	}
+	if cfg.APIServiceAccount != "" {
+		writes = append(writes,
+			platformWrite{"auth/jwt/config", map[string]any{
+				"oidc_discovery_url": "https://accounts.google.com",
+				"bound_issuer":       "https://accounts.google.com",
+			}},
+			platformWrite{"auth/jwt/role/" + PlatformOIDCProvider, map[string]any{
+				"user_claim":      "sub",
+				"bound_audiences": []string{cfg.JWTAudience},
+				"role_type":       "jwt",
+				"policies":        []string{"api"},
+				"ttl":             "1h",
+				"bound_claims":    map[string]any{"email": []string{cfg.APIServiceAccount}},
+			}},
+		)
+	}
+	for _, w := range writes {
+		if _, err := c.client.Logical().WriteWithContext(ctx, w.path, w.data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", w.path, err)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Policies)) {
+		if err := c.client.Sys().PutPolicyWithContext(ctx, name, cfg.Policies[name]); err != nil {
+			return fmt.Errorf("failed to write policy %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// EnsureUserpassUser creates or updates a userpass user with the given
+// password and policies.
+func (c *Client) EnsureUserpassUser(ctx context.Context, mountPath, username, password string, policies []string) error {
+	path := fmt.Sprintf("auth/%s/users/%s", mountPath, username)
+	data := map[string]any{"password": password, "policies": policies}
+	if _, err := c.client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return fmt.Errorf("failed to write userpass user %s: %w", username, err)
+	}
+	return nil
+}
+
+// GetEntityIDByName returns the ID of the entity named name, or "" when there's none.
+func (c *Client) GetEntityIDByName(ctx context.Context, name string) (string, error) {
+	secret, err := c.client.Logical().ReadWithContext(ctx, "identity/entity/name/"+name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read entity %s: %w", name, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return "", nil
+	}
+	id, _ := secret.Data["id"].(string)
+	return id, nil
+}
@@ -2,9 +2,13 @@ package vault
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrSecretNotFound is returned when reading a path that holds no secret.
+var ErrSecretNotFound = errors.New("no secret found")
+
 // WriteSecret writes a secret to organization's Vault instance (write-only).
 func (c *Client) WriteSecret(ctx context.Context, path string, data map[string]any) error {
 	_, err := c.client.Logical().Write(path, data)
@@ -14,6 +18,19 @@ func (c *Client) WriteSecret(ctx context.Context, path string, data map[string]a
 	return nil
 }
 
+// ReadSecret reads a secret from organization's Vault instance. Secrets are
+// otherwise never read back through the API; this is for organization exports.
+func (c *Client) ReadSecret(ctx context.Context, path string) (map[string]any, error) {
+	secret, err := c.client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret from vault: %w", err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("%w at %s", ErrSecretNotFound, path)
+	}
+	return secret.Data, nil
+}
+
 // DeleteSecret deletes a secret from organization's Vault instance.
 func (c *Client) DeleteSecret(ctx context.Context, path string) error {
 	_, err := c.client.Logical().Delete(path)
@@ -37,3 +54,10 @@ func BuildProjectSecretPath(projectPublicID, secretName string) string {
 func BuildSiteSecretPath(sitePublicID, secretName string) string {
 	return fmt.Sprintf("secret-site/%s/%s", sitePublicID, secretName)
 }
+
+// BuildSiteTLSKeyPath creates the Vault path for the private key of a site's
+// custom TLS certificate. Site secret names are upper case, so it can't clash
+// with one.
+func BuildSiteTLSKeyPath(sitePublicID string) string {
+	return fmt.Sprintf("secret-site/%s/tls-private-key", sitePublicID)
+}
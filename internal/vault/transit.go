@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// SecretsTransitKey is the transit key in each organization's Vault that
+// wraps the organization's data keys.
+const SecretsTransitKey = "libops-secrets"
+
+// Transit wraps data keys with a key encryption key held by Vault's transit
+// engine, which never leaves Vault. Rotating it adds a version; data keys
+// wrapped by earlier versions still unwrap.
+type Transit struct {
+	client    *Client
+	mountPath string
+	keyName   string
+}
+
+// NewTransit creates a transit helper for the named key on the default
+// "transit" mount.
+func NewTransit(client *Client, keyName string) *Transit {
+	return &Transit{
+		client:    client,
+		mountPath: "transit",
+		keyName:   keyName,
+	}
+}
+
+// GenerateDataKey creates a 256-bit data key, returning it in plain text
+// and wrapped by the key encryption key. The key encryption key is created
+// the first time it's needed.
+func (t *Transit) GenerateDataKey(ctx context.Context) ([]byte, string, error) {
+	if err := t.ensureKey(ctx); err != nil {
+		return nil, "", err
+	}
+	path := fmt.Sprintf("%s/datakey/plaintext/%s", t.mountPath, t.keyName)
+	secret, err := retryWithBackoff(ctx, "generate data key", func() (*api.Secret, error) {
+		return t.client.client.Logical().WriteWithContext(ctx, path, map[string]any{"bits": 256})
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, "", fmt.Errorf("no data key returned by %s", path)
+	}
+	wrapped, _ := secret.Data["ciphertext"].(string)
+	encoded, _ := secret.Data["plaintext"].(string)
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || wrapped == "" {
+		return nil, "", fmt.Errorf("invalid data key returned by %s", path)
+	}
+	return key, wrapped, nil
+}
+
+// UnwrapKey decrypts a data key wrapped by any version of the key
+// encryption key.
+func (t *Transit) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	path := fmt.Sprintf("%s/decrypt/%s", t.mountPath, t.keyName)
+	secret, err := retryWithBackoff(ctx, "unwrap data key", func() (*api.Secret, error) {
+		return t.client.client.Logical().WriteWithContext(ctx, path, map[string]any{"ciphertext": wrapped})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("no data key returned by %s", path)
+	}
+	encoded, _ := secret.Data["plaintext"].(string)
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data key returned by %s", path)
+	}
+	return key, nil
+}
+
+// RotateKey adds a new version of the key encryption key, which wraps data
+// keys from then on.
+func (t *Transit) RotateKey(ctx context.Context) error {
+	if err := t.ensureKey(ctx); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("%s/keys/%s/rotate", t.mountPath, t.keyName)
+	if _, err := retryWithBackoff(ctx, "rotate key", func() (*api.Secret, error) {
+		return t.client.client.Logical().WriteWithContext(ctx, path, nil)
+	}); err != nil {
+		return fmt.Errorf("failed to rotate key %s: %w", t.keyName, err)
+	}
+	return nil
+}
+
+// ensureKey creates the key encryption key if it doesn't exist yet. Creating
+// a key that already exists leaves it as it is.
+func (t *Transit) ensureKey(ctx context.Context) error {
+	path := fmt.Sprintf("%s/keys/%s", t.mountPath, t.keyName)
+	if _, err := retryWithBackoff(ctx, "create key", func() (*api.Secret, error) {
+		return t.client.client.Logical().WriteWithContext(ctx, path, map[string]any{"type": "aes256-gcm96"})
+	}); err != nil {
+		return fmt.Errorf("failed to create key %s: %w", t.keyName, err)
+	}
+	return nil
+}
@@ -18,6 +18,21 @@ func main() {
 	// Set up context-aware logging as default
 	setupLogging()
 
+	if len(os.Args) > 1 && (os.Args[1] == "--check-config" || os.Args[1] == "doctor") {
+		if err := config.Doctor(context.Background(), os.Stdout); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			slog.Error("Migration failed", "err", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := run(); err != nil {
 		slog.Error("Application error", "err", err)
 		os.Exit(1)
@@ -62,6 +77,10 @@ func run() error {
 		return err
 	}
 
+	if err := cfg.Preflight(context.Background()); err != nil {
+		return err
+	}
+
 	// Create config reloader
 	loader := config.NewVaultLoader()
 	reloader, err := config.NewReloader(cfg, loader)
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/libops/api/internal/config"
+	"github.com/libops/api/internal/database"
+)
+
+const migrateUsage = `usage: migrate <command>
+
+  up            apply pending migrations
+  down [N]      roll back the last N migrations (default 1)
+  status        show the schema version and pending or modified migrations
+  verify        exit non-zero unless the schema is current and unchanged
+  force V       mark the schema clean at version V after a manual repair`
+
+// runMigrate manages the database schema without starting the server
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	dsn, err := config.LoadDatabaseURL()
+	if err != nil {
+		return err
+	}
+	db, err := database.NewPool(dsn, database.DefaultConfig())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		if err := database.Migrate(db); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("down takes a positive number of migrations, got %q", args[1])
+			}
+		}
+		if err := database.MigrateDown(db, steps); err != nil {
+			return err
+		}
+	case "force":
+		if len(args) < 2 {
+			return errors.New("force needs a version")
+		}
+		version, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if err := database.ForceVersion(db, version); err != nil {
+			return err
+		}
+	case "status", "verify":
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], migrateUsage)
+	}
+
+	status, err := database.Status(ctx, db)
+	if err != nil {
+		return err
+	}
+	printMigrationStatus(status)
+	if args[0] == "verify" {
+		return status.Check()
+	}
+	return nil
+}
+
+func printMigrationStatus(status database.MigrationStatus) {
+	state := "clean"
+	if status.Dirty {
+		state = "dirty"
+	}
+	fmt.Printf("Schema version: %d (%s)\n", status.Version, state)
+	fmt.Printf("Latest migration: %d\n", status.Latest)
+	if status.Unknown {
+		fmt.Println("The database is ahead of this binary")
+	}
+	if len(status.Pending) > 0 {
+		fmt.Printf("Pending (%d):\n", len(status.Pending))
+		for _, m := range status.Pending {
+			fmt.Printf("  %d_%s\n", m.Version, m.Name)
+		}
+	}
+	if len(status.Modified) > 0 {
+		fmt.Printf("Modified since applied (%d):\n", len(status.Modified))
+		for _, m := range status.Modified {
+			fmt.Printf("  %d_%s\n", m.Version, m.Name)
+		}
+	}
+}
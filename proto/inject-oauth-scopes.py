@@ -9,6 +9,7 @@ This script:
 4. Adds OAuth2 security scheme definitions
 """
 
+import json
 import yaml
 import re
 import sys
@@ -61,6 +62,8 @@ SCOPE_DESCRIPTIONS = {
     'write:members': 'Manage organization/project/site members',
     'delete:members': 'Remove organization/project/site members',
     'read:invoices': 'Read organization invoices',
+    'read:billing': 'Read organization billing details and payment methods',
+    'write:billing': 'Manage organization payment methods and billing portal access',
     'read:firewall': 'Read firewall rules',
     'write:firewall': 'Update firewall rules',
     'delete:firewall': 'Delete firewall rules',
@@ -76,6 +79,8 @@ SCOPE_DESCRIPTIONS = {
     'delete:project': 'Delete project',
     'manage_secrets': 'Read, write, and delete secrets',
     'admin:system': 'Full system administrative access',
+    'read:platform': 'Read the admin console: all organizations, event queue health and resource lookup',
+    'write:platform': 'Act from the admin console: force reconciliation and suspend organizations',
 }
 
 
@@ -190,9 +195,12 @@ class OpenAPIScopesInjector:
 
     def inject_scopes(self):
         """Inject OAuth scopes into the OpenAPI spec."""
-        # Read OpenAPI spec
+        # Read OpenAPI spec (YAML for the Connect document, JSON for the REST one)
         with open(self.openapi_file, 'r') as f:
-            self.spec = yaml.safe_load(f)
+            if self.openapi_file.suffix == '.json':
+                self.spec = json.load(f)
+            else:
+                self.spec = yaml.safe_load(f)
 
         # Add OAuth2 security scheme
         self._add_security_scheme()
@@ -202,7 +210,11 @@ class OpenAPIScopesInjector:
 
         # Write updated spec
         with open(self.openapi_file, 'w') as f:
-            yaml.dump(self.spec, f, default_flow_style=False, sort_keys=False)
+            if self.openapi_file.suffix == '.json':
+                json.dump(self.spec, f, indent=2)
+                f.write('\n')
+            else:
+                yaml.dump(self.spec, f, default_flow_style=False, sort_keys=False)
 
     def _add_security_scheme(self):
         """Add OAuth2 security scheme to components."""
@@ -337,11 +349,15 @@ def main():
     """Main entry point."""
     script_dir = Path(__file__).parent
     proto_dir = script_dir / "libops"
-    openapi_file = script_dir.parent / "openapi" / "openapi.yaml"
+    openapi_files = [
+        script_dir.parent / "openapi" / "openapi.yaml",
+        script_dir.parent / "openapi" / "openapi.json",
+    ]
 
-    if not openapi_file.exists():
-        print(f"Error: OpenAPI file not found: {openapi_file}", file=sys.stderr)
-        sys.exit(1)
+    for openapi_file in openapi_files:
+        if not openapi_file.exists():
+            print(f"Error: OpenAPI file not found: {openapi_file}", file=sys.stderr)
+            sys.exit(1)
 
     print("Extracting scope annotations from proto files...")
     extractor = ProtoScopeExtractor(proto_dir)
@@ -352,9 +368,10 @@ def main():
         scopes_str = f" [{', '.join(oauth_scopes)}]" if oauth_scopes else ""
         print(f"  - {method_name}: {resource}:{level}{scopes_str}")
 
-    print(f"\nInjecting OAuth scopes into {openapi_file}...")
-    injector = OpenAPIScopesInjector(openapi_file, method_scopes)
-    injector.inject_scopes()
+    for openapi_file in openapi_files:
+        print(f"\nInjecting OAuth scopes into {openapi_file}...")
+        injector = OpenAPIScopesInjector(openapi_file, method_scopes)
+        injector.inject_scopes()
 
     print("✓ OAuth scopes injected into OpenAPI spec")
     print("✓ Added OAuth2 security scheme")
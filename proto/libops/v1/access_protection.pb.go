@@ -0,0 +1,506 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/access_protection.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SiteAccessMode int32
+
+const (
+	SiteAccessMode_SITE_ACCESS_MODE_UNSPECIFIED  SiteAccessMode = 0
+	SiteAccessMode_SITE_ACCESS_MODE_NONE         SiteAccessMode = 1 // Anyone can reach the site
+	SiteAccessMode_SITE_ACCESS_MODE_BASIC_AUTH   SiteAccessMode = 2 // HTTP basic auth with one shared username and password
+	SiteAccessMode_SITE_ACCESS_MODE_MEMBERS_ONLY SiteAccessMode = 3 // Only people with read access to the site, signed in through libops
+)
+
+// Enum value maps for SiteAccessMode.
+var (
+	SiteAccessMode_name = map[int32]string{
+		0: "SITE_ACCESS_MODE_UNSPECIFIED",
+		1: "SITE_ACCESS_MODE_NONE",
+		2: "SITE_ACCESS_MODE_BASIC_AUTH",
+		3: "SITE_ACCESS_MODE_MEMBERS_ONLY",
+	}
+	SiteAccessMode_value = map[string]int32{
+		"SITE_ACCESS_MODE_UNSPECIFIED":  0,
+		"SITE_ACCESS_MODE_NONE":         1,
+		"SITE_ACCESS_MODE_BASIC_AUTH":   2,
+		"SITE_ACCESS_MODE_MEMBERS_ONLY": 3,
+	}
+)
+
+func (x SiteAccessMode) Enum() *SiteAccessMode {
+	p := new(SiteAccessMode)
+	*p = x
+	return p
+}
+
+func (x SiteAccessMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SiteAccessMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_access_protection_proto_enumTypes[0].Descriptor()
+}
+
+func (SiteAccessMode) Type() protoreflect.EnumType {
+	return &file_libops_v1_access_protection_proto_enumTypes[0]
+}
+
+func (x SiteAccessMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SiteAccessMode.Descriptor instead.
+func (SiteAccessMode) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{0}
+}
+
+type SiteAccessProtection struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SiteId            string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Mode              SiteAccessMode         `protobuf:"varint,2,opt,name=mode,proto3,enum=libops.v1.SiteAccessMode" json:"mode,omitempty"`
+	BasicAuthUsername string                 `protobuf:"bytes,3,opt,name=basic_auth_username,json=basicAuthUsername,proto3" json:"basic_auth_username,omitempty"`
+	IsDefault         bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // The site hasn't been set; mode is members only unless the site is production
+	UpdatedAt         int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp; 0 while is_default
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SiteAccessProtection) Reset() {
+	*x = SiteAccessProtection{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteAccessProtection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteAccessProtection) ProtoMessage() {}
+
+func (x *SiteAccessProtection) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteAccessProtection.ProtoReflect.Descriptor instead.
+func (*SiteAccessProtection) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteAccessProtection) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteAccessProtection) GetMode() SiteAccessMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SiteAccessMode_SITE_ACCESS_MODE_UNSPECIFIED
+}
+
+func (x *SiteAccessProtection) GetBasicAuthUsername() string {
+	if x != nil {
+		return x.BasicAuthUsername
+	}
+	return ""
+}
+
+func (x *SiteAccessProtection) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SiteAccessProtection) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetSiteAccessProtectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteAccessProtectionRequest) Reset() {
+	*x = GetSiteAccessProtectionRequest{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteAccessProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteAccessProtectionRequest) ProtoMessage() {}
+
+func (x *GetSiteAccessProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteAccessProtectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteAccessProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSiteAccessProtectionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteAccessProtectionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccessProtection *SiteAccessProtection  `protobuf:"bytes,1,opt,name=access_protection,json=accessProtection,proto3" json:"access_protection,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSiteAccessProtectionResponse) Reset() {
+	*x = GetSiteAccessProtectionResponse{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteAccessProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteAccessProtectionResponse) ProtoMessage() {}
+
+func (x *GetSiteAccessProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteAccessProtectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteAccessProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSiteAccessProtectionResponse) GetAccessProtection() *SiteAccessProtection {
+	if x != nil {
+		return x.AccessProtection
+	}
+	return nil
+}
+
+type SetSiteAccessProtectionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SiteId            string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Mode              SiteAccessMode         `protobuf:"varint,2,opt,name=mode,proto3,enum=libops.v1.SiteAccessMode" json:"mode,omitempty"`
+	BasicAuthUsername string                 `protobuf:"bytes,3,opt,name=basic_auth_username,json=basicAuthUsername,proto3" json:"basic_auth_username,omitempty"` // Required for basic auth
+	// Required when switching to basic auth or changing the username; left
+	// empty, the current password is kept. At least 12 characters.
+	BasicAuthPassword string `protobuf:"bytes,4,opt,name=basic_auth_password,json=basicAuthPassword,proto3" json:"basic_auth_password,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetSiteAccessProtectionRequest) Reset() {
+	*x = SetSiteAccessProtectionRequest{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSiteAccessProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSiteAccessProtectionRequest) ProtoMessage() {}
+
+func (x *SetSiteAccessProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSiteAccessProtectionRequest.ProtoReflect.Descriptor instead.
+func (*SetSiteAccessProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetSiteAccessProtectionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SetSiteAccessProtectionRequest) GetMode() SiteAccessMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SiteAccessMode_SITE_ACCESS_MODE_UNSPECIFIED
+}
+
+func (x *SetSiteAccessProtectionRequest) GetBasicAuthUsername() string {
+	if x != nil {
+		return x.BasicAuthUsername
+	}
+	return ""
+}
+
+func (x *SetSiteAccessProtectionRequest) GetBasicAuthPassword() string {
+	if x != nil {
+		return x.BasicAuthPassword
+	}
+	return ""
+}
+
+type SetSiteAccessProtectionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccessProtection *SiteAccessProtection  `protobuf:"bytes,1,opt,name=access_protection,json=accessProtection,proto3" json:"access_protection,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetSiteAccessProtectionResponse) Reset() {
+	*x = SetSiteAccessProtectionResponse{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSiteAccessProtectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSiteAccessProtectionResponse) ProtoMessage() {}
+
+func (x *SetSiteAccessProtectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSiteAccessProtectionResponse.ProtoReflect.Descriptor instead.
+func (*SetSiteAccessProtectionResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetSiteAccessProtectionResponse) GetAccessProtection() *SiteAccessProtection {
+	if x != nil {
+		return x.AccessProtection
+	}
+	return nil
+}
+
+type ResetSiteAccessProtectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSiteAccessProtectionRequest) Reset() {
+	*x = ResetSiteAccessProtectionRequest{}
+	mi := &file_libops_v1_access_protection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSiteAccessProtectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSiteAccessProtectionRequest) ProtoMessage() {}
+
+func (x *ResetSiteAccessProtectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_access_protection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSiteAccessProtectionRequest.ProtoReflect.Descriptor instead.
+func (*ResetSiteAccessProtectionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_access_protection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResetSiteAccessProtectionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+var File_libops_v1_access_protection_proto protoreflect.FileDescriptor
+
+const file_libops_v1_access_protection_proto_rawDesc = "" +
+	"\n" +
+	"!libops/v1/access_protection.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\"\xcc\x01\n" +
+	"\x14SiteAccessProtection\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12-\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x19.libops.v1.SiteAccessModeR\x04mode\x12.\n" +
+	"\x13basic_auth_username\x18\x03 \x01(\tR\x11basicAuthUsername\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"9\n" +
+	"\x1eGetSiteAccessProtectionRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"o\n" +
+	"\x1fGetSiteAccessProtectionResponse\x12L\n" +
+	"\x11access_protection\x18\x01 \x01(\v2\x1f.libops.v1.SiteAccessProtectionR\x10accessProtection\"\xce\x01\n" +
+	"\x1eSetSiteAccessProtectionRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12-\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x19.libops.v1.SiteAccessModeR\x04mode\x12.\n" +
+	"\x13basic_auth_username\x18\x03 \x01(\tR\x11basicAuthUsername\x124\n" +
+	"\x13basic_auth_password\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\x11basicAuthPassword\"o\n" +
+	"\x1fSetSiteAccessProtectionResponse\x12L\n" +
+	"\x11access_protection\x18\x01 \x01(\v2\x1f.libops.v1.SiteAccessProtectionR\x10accessProtection\";\n" +
+	" ResetSiteAccessProtectionRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId*\x91\x01\n" +
+	"\x0eSiteAccessMode\x12 \n" +
+	"\x1cSITE_ACCESS_MODE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SITE_ACCESS_MODE_NONE\x10\x01\x12\x1f\n" +
+	"\x1bSITE_ACCESS_MODE_BASIC_AUTH\x10\x02\x12!\n" +
+	"\x1dSITE_ACCESS_MODE_MEMBERS_ONLY\x10\x032\xd5\x04\n" +
+	"\x1bSiteAccessProtectionService\x12\xc0\x01\n" +
+	"\x17GetSiteAccessProtection\x12).libops.v1.GetSiteAccessProtectionRequest\x1a*.libops.v1.GetSiteAccessProtectionResponse\"N\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02'\x12%/v1/sites/{site_id}/access-protection\x90\x02\x01\x12\xc1\x01\n" +
+	"\x17SetSiteAccessProtection\x12).libops.v1.SetSiteAccessProtectionRequest\x1a*.libops.v1.SetSiteAccessProtectionResponse\"O\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02*:\x01*\x1a%/v1/sites/{site_id}/access-protection\x12\xae\x01\n" +
+	"\x19ResetSiteAccessProtection\x12+.libops.v1.ResetSiteAccessProtectionRequest\x1a\x16.google.protobuf.Empty\"L\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02'*%/v1/sites/{site_id}/access-protectionB\x9b\x01\n" +
+	"\rcom.libops.v1B\x15AccessProtectionProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_access_protection_proto_rawDescOnce sync.Once
+	file_libops_v1_access_protection_proto_rawDescData []byte
+)
+
+func file_libops_v1_access_protection_proto_rawDescGZIP() []byte {
+	file_libops_v1_access_protection_proto_rawDescOnce.Do(func() {
+		file_libops_v1_access_protection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_access_protection_proto_rawDesc), len(file_libops_v1_access_protection_proto_rawDesc)))
+	})
+	return file_libops_v1_access_protection_proto_rawDescData
+}
+
+var file_libops_v1_access_protection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_access_protection_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_libops_v1_access_protection_proto_goTypes = []any{
+	(SiteAccessMode)(0),                      // 0: libops.v1.SiteAccessMode
+	(*SiteAccessProtection)(nil),             // 1: libops.v1.SiteAccessProtection
+	(*GetSiteAccessProtectionRequest)(nil),   // 2: libops.v1.GetSiteAccessProtectionRequest
+	(*GetSiteAccessProtectionResponse)(nil),  // 3: libops.v1.GetSiteAccessProtectionResponse
+	(*SetSiteAccessProtectionRequest)(nil),   // 4: libops.v1.SetSiteAccessProtectionRequest
+	(*SetSiteAccessProtectionResponse)(nil),  // 5: libops.v1.SetSiteAccessProtectionResponse
+	(*ResetSiteAccessProtectionRequest)(nil), // 6: libops.v1.ResetSiteAccessProtectionRequest
+	(*emptypb.Empty)(nil),                    // 7: google.protobuf.Empty
+}
+var file_libops_v1_access_protection_proto_depIdxs = []int32{
+	0, // 0: libops.v1.SiteAccessProtection.mode:type_name -> libops.v1.SiteAccessMode
+	1, // 1: libops.v1.GetSiteAccessProtectionResponse.access_protection:type_name -> libops.v1.SiteAccessProtection
+	0, // 2: libops.v1.SetSiteAccessProtectionRequest.mode:type_name -> libops.v1.SiteAccessMode
+	1, // 3: libops.v1.SetSiteAccessProtectionResponse.access_protection:type_name -> libops.v1.SiteAccessProtection
+	2, // 4: libops.v1.SiteAccessProtectionService.GetSiteAccessProtection:input_type -> libops.v1.GetSiteAccessProtectionRequest
+	4, // 5: libops.v1.SiteAccessProtectionService.SetSiteAccessProtection:input_type -> libops.v1.SetSiteAccessProtectionRequest
+	6, // 6: libops.v1.SiteAccessProtectionService.ResetSiteAccessProtection:input_type -> libops.v1.ResetSiteAccessProtectionRequest
+	3, // 7: libops.v1.SiteAccessProtectionService.GetSiteAccessProtection:output_type -> libops.v1.GetSiteAccessProtectionResponse
+	5, // 8: libops.v1.SiteAccessProtectionService.SetSiteAccessProtection:output_type -> libops.v1.SetSiteAccessProtectionResponse
+	7, // 9: libops.v1.SiteAccessProtectionService.ResetSiteAccessProtection:output_type -> google.protobuf.Empty
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_access_protection_proto_init() }
+func file_libops_v1_access_protection_proto_init() {
+	if File_libops_v1_access_protection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_access_protection_proto_rawDesc), len(file_libops_v1_access_protection_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_access_protection_proto_goTypes,
+		DependencyIndexes: file_libops_v1_access_protection_proto_depIdxs,
+		EnumInfos:         file_libops_v1_access_protection_proto_enumTypes,
+		MessageInfos:      file_libops_v1_access_protection_proto_msgTypes,
+	}.Build()
+	File_libops_v1_access_protection_proto = out.File
+	file_libops_v1_access_protection_proto_goTypes = nil
+	file_libops_v1_access_protection_proto_depIdxs = nil
+}
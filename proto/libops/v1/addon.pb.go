@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/addon.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddonKind int32
+
+const (
+	AddonKind_ADDON_KIND_UNSPECIFIED AddonKind = 0
+	AddonKind_ADDON_KIND_SOLR        AddonKind = 1
+	AddonKind_ADDON_KIND_MEMCACHED   AddonKind = 2
+	AddonKind_ADDON_KIND_REDIS       AddonKind = 3
+	AddonKind_ADDON_KIND_MATOMO      AddonKind = 4
+)
+
+// Enum value maps for AddonKind.
+var (
+	AddonKind_name = map[int32]string{
+		0: "ADDON_KIND_UNSPECIFIED",
+		1: "ADDON_KIND_SOLR",
+		2: "ADDON_KIND_MEMCACHED",
+		3: "ADDON_KIND_REDIS",
+		4: "ADDON_KIND_MATOMO",
+	}
+	AddonKind_value = map[string]int32{
+		"ADDON_KIND_UNSPECIFIED": 0,
+		"ADDON_KIND_SOLR":        1,
+		"ADDON_KIND_MEMCACHED":   2,
+		"ADDON_KIND_REDIS":       3,
+		"ADDON_KIND_MATOMO":      4,
+	}
+)
+
+func (x AddonKind) Enum() *AddonKind {
+	p := new(AddonKind)
+	*p = x
+	return p
+}
+
+func (x AddonKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddonKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_addon_proto_enumTypes[0].Descriptor()
+}
+
+func (AddonKind) Type() protoreflect.EnumType {
+	return &file_libops_v1_addon_proto_enumTypes[0]
+}
+
+func (x AddonKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddonKind.Descriptor instead.
+func (AddonKind) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{0}
+}
+
+type AddonStatus int32
+
+const (
+	AddonStatus_ADDON_STATUS_UNSPECIFIED  AddonStatus = 0
+	AddonStatus_ADDON_STATUS_PROVISIONING AddonStatus = 1 // Not yet reported by the site's controller
+	AddonStatus_ADDON_STATUS_HEALTHY      AddonStatus = 2
+	AddonStatus_ADDON_STATUS_UNHEALTHY    AddonStatus = 3
+)
+
+// Enum value maps for AddonStatus.
+var (
+	AddonStatus_name = map[int32]string{
+		0: "ADDON_STATUS_UNSPECIFIED",
+		1: "ADDON_STATUS_PROVISIONING",
+		2: "ADDON_STATUS_HEALTHY",
+		3: "ADDON_STATUS_UNHEALTHY",
+	}
+	AddonStatus_value = map[string]int32{
+		"ADDON_STATUS_UNSPECIFIED":  0,
+		"ADDON_STATUS_PROVISIONING": 1,
+		"ADDON_STATUS_HEALTHY":      2,
+		"ADDON_STATUS_UNHEALTHY":    3,
+	}
+)
+
+func (x AddonStatus) Enum() *AddonStatus {
+	p := new(AddonStatus)
+	*p = x
+	return p
+}
+
+func (x AddonStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddonStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_addon_proto_enumTypes[1].Descriptor()
+}
+
+func (AddonStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_addon_proto_enumTypes[1]
+}
+
+func (x AddonStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddonStatus.Descriptor instead.
+func (AddonStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{1}
+}
+
+type SiteAddon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddonId       string                 `protobuf:"bytes,1,opt,name=addon_id,json=addonId,proto3" json:"addon_id,omitempty"`
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Kind          AddonKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=libops.v1.AddonKind" json:"kind,omitempty"` // A site has at most one add-on of each kind
+	Status        AddonStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.AddonStatus" json:"status,omitempty"`
+	StatusMessage string                 `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"` // Why it's unhealthy
+	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`                                        // Empty until it's reported
+	Port          int32                  `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	// Managed site secrets holding its address and credentials
+	SecretNames   []string `protobuf:"bytes,8,rep,name=secret_names,json=secretNames,proto3" json:"secret_names,omitempty"`
+	CheckedAt     int64    `protobuf:"varint,9,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`  // Unix timestamp of its last health report
+	CreatedAt     int64    `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteAddon) Reset() {
+	*x = SiteAddon{}
+	mi := &file_libops_v1_addon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteAddon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteAddon) ProtoMessage() {}
+
+func (x *SiteAddon) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteAddon.ProtoReflect.Descriptor instead.
+func (*SiteAddon) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteAddon) GetAddonId() string {
+	if x != nil {
+		return x.AddonId
+	}
+	return ""
+}
+
+func (x *SiteAddon) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteAddon) GetKind() AddonKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AddonKind_ADDON_KIND_UNSPECIFIED
+}
+
+func (x *SiteAddon) GetStatus() AddonStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddonStatus_ADDON_STATUS_UNSPECIFIED
+}
+
+func (x *SiteAddon) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *SiteAddon) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SiteAddon) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SiteAddon) GetSecretNames() []string {
+	if x != nil {
+		return x.SecretNames
+	}
+	return nil
+}
+
+func (x *SiteAddon) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *SiteAddon) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AttachAddonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Kind          AddonKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=libops.v1.AddonKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachAddonRequest) Reset() {
+	*x = AttachAddonRequest{}
+	mi := &file_libops_v1_addon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachAddonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachAddonRequest) ProtoMessage() {}
+
+func (x *AttachAddonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachAddonRequest.ProtoReflect.Descriptor instead.
+func (*AttachAddonRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttachAddonRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *AttachAddonRequest) GetKind() AddonKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AddonKind_ADDON_KIND_UNSPECIFIED
+}
+
+type AttachAddonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addon         *SiteAddon             `protobuf:"bytes,1,opt,name=addon,proto3" json:"addon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachAddonResponse) Reset() {
+	*x = AttachAddonResponse{}
+	mi := &file_libops_v1_addon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachAddonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachAddonResponse) ProtoMessage() {}
+
+func (x *AttachAddonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachAddonResponse.ProtoReflect.Descriptor instead.
+func (*AttachAddonResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttachAddonResponse) GetAddon() *SiteAddon {
+	if x != nil {
+		return x.Addon
+	}
+	return nil
+}
+
+type ListAddonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddonsRequest) Reset() {
+	*x = ListAddonsRequest{}
+	mi := &file_libops_v1_addon_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddonsRequest) ProtoMessage() {}
+
+func (x *ListAddonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddonsRequest.ProtoReflect.Descriptor instead.
+func (*ListAddonsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAddonsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListAddonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addons        []*SiteAddon           `protobuf:"bytes,1,rep,name=addons,proto3" json:"addons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddonsResponse) Reset() {
+	*x = ListAddonsResponse{}
+	mi := &file_libops_v1_addon_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddonsResponse) ProtoMessage() {}
+
+func (x *ListAddonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddonsResponse.ProtoReflect.Descriptor instead.
+func (*ListAddonsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAddonsResponse) GetAddons() []*SiteAddon {
+	if x != nil {
+		return x.Addons
+	}
+	return nil
+}
+
+type DetachAddonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AddonId       string                 `protobuf:"bytes,2,opt,name=addon_id,json=addonId,proto3" json:"addon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachAddonRequest) Reset() {
+	*x = DetachAddonRequest{}
+	mi := &file_libops_v1_addon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachAddonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachAddonRequest) ProtoMessage() {}
+
+func (x *DetachAddonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_addon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachAddonRequest.ProtoReflect.Descriptor instead.
+func (*DetachAddonRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_addon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DetachAddonRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DetachAddonRequest) GetAddonId() string {
+	if x != nil {
+		return x.AddonId
+	}
+	return ""
+}
+
+var File_libops_v1_addon_proto protoreflect.FileDescriptor
+
+const file_libops_v1_addon_proto_rawDesc = "" +
+	"\n" +
+	"\x15libops/v1/addon.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\xc9\x02\n" +
+	"\tSiteAddon\x12\x19\n" +
+	"\baddon_id\x18\x01 \x01(\tR\aaddonId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12(\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x14.libops.v1.AddonKindR\x04kind\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.libops.v1.AddonStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\x05 \x01(\tR\rstatusMessage\x12\x12\n" +
+	"\x04host\x18\x06 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\a \x01(\x05R\x04port\x12!\n" +
+	"\fsecret_names\x18\b \x03(\tR\vsecretNames\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\t \x01(\x03R\tcheckedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"W\n" +
+	"\x12AttachAddonRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.libops.v1.AddonKindR\x04kind\"A\n" +
+	"\x13AttachAddonResponse\x12*\n" +
+	"\x05addon\x18\x01 \x01(\v2\x14.libops.v1.SiteAddonR\x05addon\",\n" +
+	"\x11ListAddonsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"B\n" +
+	"\x12ListAddonsResponse\x12,\n" +
+	"\x06addons\x18\x01 \x03(\v2\x14.libops.v1.SiteAddonR\x06addons\"H\n" +
+	"\x12DetachAddonRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x19\n" +
+	"\baddon_id\x18\x02 \x01(\tR\aaddonId*\x83\x01\n" +
+	"\tAddonKind\x12\x1a\n" +
+	"\x16ADDON_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fADDON_KIND_SOLR\x10\x01\x12\x18\n" +
+	"\x14ADDON_KIND_MEMCACHED\x10\x02\x12\x14\n" +
+	"\x10ADDON_KIND_REDIS\x10\x03\x12\x15\n" +
+	"\x11ADDON_KIND_MATOMO\x10\x04*\x80\x01\n" +
+	"\vAddonStatus\x12\x1c\n" +
+	"\x18ADDON_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ADDON_STATUS_PROVISIONING\x10\x01\x12\x18\n" +
+	"\x14ADDON_STATUS_HEALTHY\x10\x02\x12\x1a\n" +
+	"\x16ADDON_STATUS_UNHEALTHY\x10\x032\xc9\x03\n" +
+	"\fAddonService\x12\x92\x01\n" +
+	"\vAttachAddon\x12\x1d.libops.v1.AttachAddonRequest\x1a\x1e.libops.v1.AttachAddonResponse\"D\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/sites/{site_id}/addons\x12\x8e\x01\n" +
+	"\n" +
+	"ListAddons\x12\x1c.libops.v1.ListAddonsRequest\x1a\x1d.libops.v1.ListAddonsResponse\"C\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/sites/{site_id}/addons\x90\x02\x01\x12\x92\x01\n" +
+	"\vDetachAddon\x12\x1d.libops.v1.DetachAddonRequest\x1a\x16.google.protobuf.Empty\"L\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02'*%/v1/sites/{site_id}/addons/{addon_id}B\x90\x01\n" +
+	"\rcom.libops.v1B\n" +
+	"AddonProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_addon_proto_rawDescOnce sync.Once
+	file_libops_v1_addon_proto_rawDescData []byte
+)
+
+func file_libops_v1_addon_proto_rawDescGZIP() []byte {
+	file_libops_v1_addon_proto_rawDescOnce.Do(func() {
+		file_libops_v1_addon_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_addon_proto_rawDesc), len(file_libops_v1_addon_proto_rawDesc)))
+	})
+	return file_libops_v1_addon_proto_rawDescData
+}
+
+var file_libops_v1_addon_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_addon_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_libops_v1_addon_proto_goTypes = []any{
+	(AddonKind)(0),              // 0: libops.v1.AddonKind
+	(AddonStatus)(0),            // 1: libops.v1.AddonStatus
+	(*SiteAddon)(nil),           // 2: libops.v1.SiteAddon
+	(*AttachAddonRequest)(nil),  // 3: libops.v1.AttachAddonRequest
+	(*AttachAddonResponse)(nil), // 4: libops.v1.AttachAddonResponse
+	(*ListAddonsRequest)(nil),   // 5: libops.v1.ListAddonsRequest
+	(*ListAddonsResponse)(nil),  // 6: libops.v1.ListAddonsResponse
+	(*DetachAddonRequest)(nil),  // 7: libops.v1.DetachAddonRequest
+	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
+}
+var file_libops_v1_addon_proto_depIdxs = []int32{
+	0, // 0: libops.v1.SiteAddon.kind:type_name -> libops.v1.AddonKind
+	1, // 1: libops.v1.SiteAddon.status:type_name -> libops.v1.AddonStatus
+	0, // 2: libops.v1.AttachAddonRequest.kind:type_name -> libops.v1.AddonKind
+	2, // 3: libops.v1.AttachAddonResponse.addon:type_name -> libops.v1.SiteAddon
+	2, // 4: libops.v1.ListAddonsResponse.addons:type_name -> libops.v1.SiteAddon
+	3, // 5: libops.v1.AddonService.AttachAddon:input_type -> libops.v1.AttachAddonRequest
+	5, // 6: libops.v1.AddonService.ListAddons:input_type -> libops.v1.ListAddonsRequest
+	7, // 7: libops.v1.AddonService.DetachAddon:input_type -> libops.v1.DetachAddonRequest
+	4, // 8: libops.v1.AddonService.AttachAddon:output_type -> libops.v1.AttachAddonResponse
+	6, // 9: libops.v1.AddonService.ListAddons:output_type -> libops.v1.ListAddonsResponse
+	8, // 10: libops.v1.AddonService.DetachAddon:output_type -> google.protobuf.Empty
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_addon_proto_init() }
+func file_libops_v1_addon_proto_init() {
+	if File_libops_v1_addon_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_addon_proto_rawDesc), len(file_libops_v1_addon_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_addon_proto_goTypes,
+		DependencyIndexes: file_libops_v1_addon_proto_depIdxs,
+		EnumInfos:         file_libops_v1_addon_proto_enumTypes,
+		MessageInfos:      file_libops_v1_addon_proto_msgTypes,
+	}.Build()
+	File_libops_v1_addon_proto = out.File
+	file_libops_v1_addon_proto_goTypes = nil
+	file_libops_v1_addon_proto_depIdxs = nil
+}
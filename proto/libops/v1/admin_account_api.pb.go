@@ -1075,6 +1075,319 @@ func (x *ListAccountRepositoriesResponse) GetNextPageToken() string {
 	return ""
 }
 
+// PlatformAuditor is an account's grant of read-only access to the whole platform
+type PlatformAuditor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                         // Why the account needs it, e.g. the review it's for
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`  // Account ID of the admin who granted it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformAuditor) Reset() {
+	*x = PlatformAuditor{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformAuditor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformAuditor) ProtoMessage() {}
+
+func (x *PlatformAuditor) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformAuditor.ProtoReflect.Descriptor instead.
+func (*PlatformAuditor) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PlatformAuditor) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlatformAuditor) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PlatformAuditor) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PlatformAuditor) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PlatformAuditor) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlatformAuditor) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ListPlatformAuditorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformAuditorsRequest) Reset() {
+	*x = ListPlatformAuditorsRequest{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformAuditorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformAuditorsRequest) ProtoMessage() {}
+
+func (x *ListPlatformAuditorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformAuditorsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformAuditorsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{18}
+}
+
+type ListPlatformAuditorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auditors      []*PlatformAuditor     `protobuf:"bytes,1,rep,name=auditors,proto3" json:"auditors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformAuditorsResponse) Reset() {
+	*x = ListPlatformAuditorsResponse{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformAuditorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformAuditorsResponse) ProtoMessage() {}
+
+func (x *ListPlatformAuditorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformAuditorsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformAuditorsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPlatformAuditorsResponse) GetAuditors() []*PlatformAuditor {
+	if x != nil {
+		return x.Auditors
+	}
+	return nil
+}
+
+type GrantPlatformAuditorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix timestamp, at most 90 days from now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPlatformAuditorRequest) Reset() {
+	*x = GrantPlatformAuditorRequest{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPlatformAuditorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPlatformAuditorRequest) ProtoMessage() {}
+
+func (x *GrantPlatformAuditorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPlatformAuditorRequest.ProtoReflect.Descriptor instead.
+func (*GrantPlatformAuditorRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GrantPlatformAuditorRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GrantPlatformAuditorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GrantPlatformAuditorRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GrantPlatformAuditorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Auditor       *PlatformAuditor       `protobuf:"bytes,1,opt,name=auditor,proto3" json:"auditor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPlatformAuditorResponse) Reset() {
+	*x = GrantPlatformAuditorResponse{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPlatformAuditorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPlatformAuditorResponse) ProtoMessage() {}
+
+func (x *GrantPlatformAuditorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPlatformAuditorResponse.ProtoReflect.Descriptor instead.
+func (*GrantPlatformAuditorResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GrantPlatformAuditorResponse) GetAuditor() *PlatformAuditor {
+	if x != nil {
+		return x.Auditor
+	}
+	return nil
+}
+
+type RevokePlatformAuditorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePlatformAuditorRequest) Reset() {
+	*x = RevokePlatformAuditorRequest{}
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePlatformAuditorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePlatformAuditorRequest) ProtoMessage() {}
+
+func (x *RevokePlatformAuditorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_account_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePlatformAuditorRequest.ProtoReflect.Descriptor instead.
+func (*RevokePlatformAuditorRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_account_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokePlatformAuditorRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
 var File_libops_v1_admin_account_api_proto protoreflect.FileDescriptor
 
 const file_libops_v1_admin_account_api_proto_rawDesc = "" +
@@ -1158,7 +1471,32 @@ const file_libops_v1_admin_account_api_proto_rawDesc = "" +
 	"project_id\x18\x04 \x01(\tR\tprojectId\"\x84\x01\n" +
 	"\x1fListAccountRepositoriesResponse\x129\n" +
 	"\frepositories\x18\x01 \x03(\v2\x15.libops.v1.RepositoryR\frepositories\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*Z\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbb\x01\n" +
+	"\x0fPlatformAuditor\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\"\x1d\n" +
+	"\x1bListPlatformAuditorsRequest\"V\n" +
+	"\x1cListPlatformAuditorsResponse\x126\n" +
+	"\bauditors\x18\x01 \x03(\v2\x1a.libops.v1.PlatformAuditorR\bauditors\"s\n" +
+	"\x1bGrantPlatformAuditorRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"T\n" +
+	"\x1cGrantPlatformAuditorResponse\x124\n" +
+	"\aauditor\x18\x01 \x01(\v2\x1a.libops.v1.PlatformAuditorR\aauditor\"=\n" +
+	"\x1cRevokePlatformAuditorRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId*Z\n" +
 	"\vAccountRole\x12\x1c\n" +
 	"\x18ACCOUNT_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ACCOUNT_ROLE_USER\x10\x01\x12\x16\n" +
@@ -1167,7 +1505,8 @@ const file_libops_v1_admin_account_api_proto_rawDesc = "" +
 	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
 	"\x18ACCOUNT_STATUS_SUSPENDED\x10\x02\x12\x1a\n" +
-	"\x16ACCOUNT_STATUS_DELETED\x10\x032\xb6\a\n" +
+	"\x16ACCOUNT_STATUS_DELETED\x10\x032\xae\n" +
+	"\n" +
 	"\x13AdminAccountService\x12d\n" +
 	"\n" +
 	"GetAccount\x12\x1c.libops.v1.GetAccountRequest\x1a\x1d.libops.v1.GetAccountResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x83\x01\n" +
@@ -1177,7 +1516,10 @@ const file_libops_v1_admin_account_api_proto_rawDesc = "" +
 	"\rDeleteAccount\x12\x1f.libops.v1.DeleteAccountRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12j\n" +
 	"\fListAccounts\x12\x1e.libops.v1.ListAccountsRequest\x1a\x1f.libops.v1.ListAccountsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x7f\n" +
 	"\x13ListAccountProjects\x12%.libops.v1.ListAccountProjectsRequest\x1a&.libops.v1.ListAccountProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x8b\x01\n" +
-	"\x17ListAccountRepositories\x12).libops.v1.ListAccountRepositoriesRequest\x1a*.libops.v1.ListAccountRepositoriesResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01B\x9a\x01\n" +
+	"\x17ListAccountRepositories\x12).libops.v1.ListAccountRepositoriesRequest\x1a*.libops.v1.ListAccountRepositoriesResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x82\x01\n" +
+	"\x14ListPlatformAuditors\x12&.libops.v1.ListPlatformAuditorsRequest\x1a'.libops.v1.ListPlatformAuditorsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x7f\n" +
+	"\x14GrantPlatformAuditor\x12&.libops.v1.GrantPlatformAuditorRequest\x1a'.libops.v1.GrantPlatformAuditorResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12p\n" +
+	"\x15RevokePlatformAuditor\x12'.libops.v1.RevokePlatformAuditorRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:systemB\x9a\x01\n" +
 	"\rcom.libops.v1B\x14AdminAccountApiProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -1194,7 +1536,7 @@ func file_libops_v1_admin_account_api_proto_rawDescGZIP() []byte {
 }
 
 var file_libops_v1_admin_account_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_libops_v1_admin_account_api_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_libops_v1_admin_account_api_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_libops_v1_admin_account_api_proto_goTypes = []any{
 	(AccountRole)(0),                        // 0: libops.v1.AccountRole
 	(AccountStatus)(0),                      // 1: libops.v1.AccountStatus
@@ -1215,43 +1557,57 @@ var file_libops_v1_admin_account_api_proto_goTypes = []any{
 	(*ListAccountRepositoriesRequest)(nil),  // 16: libops.v1.ListAccountRepositoriesRequest
 	(*Repository)(nil),                      // 17: libops.v1.Repository
 	(*ListAccountRepositoriesResponse)(nil), // 18: libops.v1.ListAccountRepositoriesResponse
-	(common.AuthMethod)(0),                  // 19: libops.v1.common.AuthMethod
-	(*fieldmaskpb.FieldMask)(nil),           // 20: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                   // 21: google.protobuf.Empty
+	(*PlatformAuditor)(nil),                 // 19: libops.v1.PlatformAuditor
+	(*ListPlatformAuditorsRequest)(nil),     // 20: libops.v1.ListPlatformAuditorsRequest
+	(*ListPlatformAuditorsResponse)(nil),    // 21: libops.v1.ListPlatformAuditorsResponse
+	(*GrantPlatformAuditorRequest)(nil),     // 22: libops.v1.GrantPlatformAuditorRequest
+	(*GrantPlatformAuditorResponse)(nil),    // 23: libops.v1.GrantPlatformAuditorResponse
+	(*RevokePlatformAuditorRequest)(nil),    // 24: libops.v1.RevokePlatformAuditorRequest
+	(common.AuthMethod)(0),                  // 25: libops.v1.common.AuthMethod
+	(*fieldmaskpb.FieldMask)(nil),           // 26: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                   // 27: google.protobuf.Empty
 }
 var file_libops_v1_admin_account_api_proto_depIdxs = []int32{
 	0,  // 0: libops.v1.Account.role:type_name -> libops.v1.AccountRole
 	1,  // 1: libops.v1.Account.status:type_name -> libops.v1.AccountStatus
-	19, // 2: libops.v1.Account.auth_method:type_name -> libops.v1.common.AuthMethod
+	25, // 2: libops.v1.Account.auth_method:type_name -> libops.v1.common.AuthMethod
 	2,  // 3: libops.v1.GetAccountResponse.account:type_name -> libops.v1.Account
 	2,  // 4: libops.v1.AdminGetAccountByEmailResponse.account:type_name -> libops.v1.Account
 	2,  // 5: libops.v1.CreateAccountResponse.account:type_name -> libops.v1.Account
-	20, // 6: libops.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26, // 6: libops.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
 	2,  // 7: libops.v1.UpdateAccountResponse.account:type_name -> libops.v1.Account
 	1,  // 8: libops.v1.ListAccountsRequest.status:type_name -> libops.v1.AccountStatus
 	2,  // 9: libops.v1.ListAccountsResponse.accounts:type_name -> libops.v1.Account
 	17, // 10: libops.v1.ListAccountRepositoriesResponse.repositories:type_name -> libops.v1.Repository
-	3,  // 11: libops.v1.AdminAccountService.GetAccount:input_type -> libops.v1.GetAccountRequest
-	5,  // 12: libops.v1.AdminAccountService.GetAccountByEmail:input_type -> libops.v1.AdminGetAccountByEmailRequest
-	7,  // 13: libops.v1.AdminAccountService.CreateAccount:input_type -> libops.v1.CreateAccountRequest
-	9,  // 14: libops.v1.AdminAccountService.UpdateAccount:input_type -> libops.v1.UpdateAccountRequest
-	11, // 15: libops.v1.AdminAccountService.DeleteAccount:input_type -> libops.v1.DeleteAccountRequest
-	12, // 16: libops.v1.AdminAccountService.ListAccounts:input_type -> libops.v1.ListAccountsRequest
-	14, // 17: libops.v1.AdminAccountService.ListAccountProjects:input_type -> libops.v1.ListAccountProjectsRequest
-	16, // 18: libops.v1.AdminAccountService.ListAccountRepositories:input_type -> libops.v1.ListAccountRepositoriesRequest
-	4,  // 19: libops.v1.AdminAccountService.GetAccount:output_type -> libops.v1.GetAccountResponse
-	6,  // 20: libops.v1.AdminAccountService.GetAccountByEmail:output_type -> libops.v1.AdminGetAccountByEmailResponse
-	8,  // 21: libops.v1.AdminAccountService.CreateAccount:output_type -> libops.v1.CreateAccountResponse
-	10, // 22: libops.v1.AdminAccountService.UpdateAccount:output_type -> libops.v1.UpdateAccountResponse
-	21, // 23: libops.v1.AdminAccountService.DeleteAccount:output_type -> google.protobuf.Empty
-	13, // 24: libops.v1.AdminAccountService.ListAccounts:output_type -> libops.v1.ListAccountsResponse
-	15, // 25: libops.v1.AdminAccountService.ListAccountProjects:output_type -> libops.v1.ListAccountProjectsResponse
-	18, // 26: libops.v1.AdminAccountService.ListAccountRepositories:output_type -> libops.v1.ListAccountRepositoriesResponse
-	19, // [19:27] is the sub-list for method output_type
-	11, // [11:19] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	19, // 11: libops.v1.ListPlatformAuditorsResponse.auditors:type_name -> libops.v1.PlatformAuditor
+	19, // 12: libops.v1.GrantPlatformAuditorResponse.auditor:type_name -> libops.v1.PlatformAuditor
+	3,  // 13: libops.v1.AdminAccountService.GetAccount:input_type -> libops.v1.GetAccountRequest
+	5,  // 14: libops.v1.AdminAccountService.GetAccountByEmail:input_type -> libops.v1.AdminGetAccountByEmailRequest
+	7,  // 15: libops.v1.AdminAccountService.CreateAccount:input_type -> libops.v1.CreateAccountRequest
+	9,  // 16: libops.v1.AdminAccountService.UpdateAccount:input_type -> libops.v1.UpdateAccountRequest
+	11, // 17: libops.v1.AdminAccountService.DeleteAccount:input_type -> libops.v1.DeleteAccountRequest
+	12, // 18: libops.v1.AdminAccountService.ListAccounts:input_type -> libops.v1.ListAccountsRequest
+	14, // 19: libops.v1.AdminAccountService.ListAccountProjects:input_type -> libops.v1.ListAccountProjectsRequest
+	16, // 20: libops.v1.AdminAccountService.ListAccountRepositories:input_type -> libops.v1.ListAccountRepositoriesRequest
+	20, // 21: libops.v1.AdminAccountService.ListPlatformAuditors:input_type -> libops.v1.ListPlatformAuditorsRequest
+	22, // 22: libops.v1.AdminAccountService.GrantPlatformAuditor:input_type -> libops.v1.GrantPlatformAuditorRequest
+	24, // 23: libops.v1.AdminAccountService.RevokePlatformAuditor:input_type -> libops.v1.RevokePlatformAuditorRequest
+	4,  // 24: libops.v1.AdminAccountService.GetAccount:output_type -> libops.v1.GetAccountResponse
+	6,  // 25: libops.v1.AdminAccountService.GetAccountByEmail:output_type -> libops.v1.AdminGetAccountByEmailResponse
+	8,  // 26: libops.v1.AdminAccountService.CreateAccount:output_type -> libops.v1.CreateAccountResponse
+	10, // 27: libops.v1.AdminAccountService.UpdateAccount:output_type -> libops.v1.UpdateAccountResponse
+	27, // 28: libops.v1.AdminAccountService.DeleteAccount:output_type -> google.protobuf.Empty
+	13, // 29: libops.v1.AdminAccountService.ListAccounts:output_type -> libops.v1.ListAccountsResponse
+	15, // 30: libops.v1.AdminAccountService.ListAccountProjects:output_type -> libops.v1.ListAccountProjectsResponse
+	18, // 31: libops.v1.AdminAccountService.ListAccountRepositories:output_type -> libops.v1.ListAccountRepositoriesResponse
+	21, // 32: libops.v1.AdminAccountService.ListPlatformAuditors:output_type -> libops.v1.ListPlatformAuditorsResponse
+	23, // 33: libops.v1.AdminAccountService.GrantPlatformAuditor:output_type -> libops.v1.GrantPlatformAuditorResponse
+	27, // 34: libops.v1.AdminAccountService.RevokePlatformAuditor:output_type -> google.protobuf.Empty
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_admin_account_api_proto_init() }
@@ -1269,7 +1625,7 @@ func file_libops_v1_admin_account_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_admin_account_api_proto_rawDesc), len(file_libops_v1_admin_account_api_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
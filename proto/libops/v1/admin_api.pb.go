@@ -8,6 +8,7 @@ package libopsv1
 
 import (
 	admin "github.com/libops/api/proto/libops/v1/admin"
+	common "github.com/libops/api/proto/libops/v1/common"
 	_ "github.com/libops/api/proto/libops/v1/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -939,10 +940,13 @@ func (x *AdminDeleteOrganizationRequest) GetOrganizationId() string {
 }
 
 type AdminListOrganizationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter        *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // e.g., "gcp_parent=folders/123"
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Continues after the last row of the previous page. Results are ordered
+	// newest first by creation time, then by ID, so rows created or deleted
+	// between requests don't shift pages.
+	PageToken     string  `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        *string `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // e.g., "gcp_parent=folders/123"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1162,6 +1166,266 @@ func (x *AdminListOrganizationProjectsResponse) GetNextPageToken() string {
 	return ""
 }
 
+type AdminSetOrganizationQuotaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Resource       string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"` // e.g., "projects"
+	Limit          int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminSetOrganizationQuotaRequest) Reset() {
+	*x = AdminSetOrganizationQuotaRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetOrganizationQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetOrganizationQuotaRequest) ProtoMessage() {}
+
+func (x *AdminSetOrganizationQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetOrganizationQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetOrganizationQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AdminSetOrganizationQuotaRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AdminSetOrganizationQuotaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AdminSetOrganizationQuotaRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminSetOrganizationQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *common.Quota          `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetOrganizationQuotaResponse) Reset() {
+	*x = AdminSetOrganizationQuotaResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetOrganizationQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetOrganizationQuotaResponse) ProtoMessage() {}
+
+func (x *AdminSetOrganizationQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetOrganizationQuotaResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetOrganizationQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AdminSetOrganizationQuotaResponse) GetQuota() *common.Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type AdminDeleteOrganizationQuotaRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Resource       string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminDeleteOrganizationQuotaRequest) Reset() {
+	*x = AdminDeleteOrganizationQuotaRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteOrganizationQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteOrganizationQuotaRequest) ProtoMessage() {}
+
+func (x *AdminDeleteOrganizationQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteOrganizationQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteOrganizationQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AdminDeleteOrganizationQuotaRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AdminDeleteOrganizationQuotaRequest) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+type RotateEncryptionKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyRequest) Reset() {
+	*x = RotateEncryptionKeyRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RotateEncryptionKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type RotateEncryptionKeyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	KeyVersion       int32                  `protobuf:"varint,1,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"` // The data key version secrets are now sealed with
+	RewrappedSecrets int32                  `protobuf:"varint,2,opt,name=rewrapped_secrets,json=rewrappedSecrets,proto3" json:"rewrapped_secrets,omitempty"`
+	FailedPaths      []string               `protobuf:"bytes,3,rep,name=failed_paths,json=failedPaths,proto3" json:"failed_paths,omitempty"` // Vault paths left on their earlier key; the next rotation retries them
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyResponse) Reset() {
+	*x = RotateEncryptionKeyResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RotateEncryptionKeyResponse) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetRewrappedSecrets() int32 {
+	if x != nil {
+		return x.RewrappedSecrets
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetFailedPaths() []string {
+	if x != nil {
+		return x.FailedPaths
+	}
+	return nil
+}
+
 type AdminGetSiteRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -1173,7 +1437,7 @@ type AdminGetSiteRequest struct {
 
 func (x *AdminGetSiteRequest) Reset() {
 	*x = AdminGetSiteRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[22]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1185,7 +1449,7 @@ func (x *AdminGetSiteRequest) String() string {
 func (*AdminGetSiteRequest) ProtoMessage() {}
 
 func (x *AdminGetSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[22]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +1462,7 @@ func (x *AdminGetSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetSiteRequest.ProtoReflect.Descriptor instead.
 func (*AdminGetSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{22}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AdminGetSiteRequest) GetOrganizationId() string {
@@ -1231,7 +1495,7 @@ type AdminGetSiteResponse struct {
 
 func (x *AdminGetSiteResponse) Reset() {
 	*x = AdminGetSiteResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[23]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1243,7 +1507,7 @@ func (x *AdminGetSiteResponse) String() string {
 func (*AdminGetSiteResponse) ProtoMessage() {}
 
 func (x *AdminGetSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[23]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1520,7 @@ func (x *AdminGetSiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetSiteResponse.ProtoReflect.Descriptor instead.
 func (*AdminGetSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{23}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AdminGetSiteResponse) GetSite() *admin.AdminSiteConfig {
@@ -1277,7 +1541,7 @@ type AdminCreateSiteRequest struct {
 
 func (x *AdminCreateSiteRequest) Reset() {
 	*x = AdminCreateSiteRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[24]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +1553,7 @@ func (x *AdminCreateSiteRequest) String() string {
 func (*AdminCreateSiteRequest) ProtoMessage() {}
 
 func (x *AdminCreateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[24]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +1566,7 @@ func (x *AdminCreateSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateSiteRequest.ProtoReflect.Descriptor instead.
 func (*AdminCreateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{24}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AdminCreateSiteRequest) GetOrganizationId() string {
@@ -1335,7 +1599,7 @@ type AdminCreateSiteResponse struct {
 
 func (x *AdminCreateSiteResponse) Reset() {
 	*x = AdminCreateSiteResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[25]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +1611,7 @@ func (x *AdminCreateSiteResponse) String() string {
 func (*AdminCreateSiteResponse) ProtoMessage() {}
 
 func (x *AdminCreateSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[25]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1624,7 @@ func (x *AdminCreateSiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateSiteResponse.ProtoReflect.Descriptor instead.
 func (*AdminCreateSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{25}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AdminCreateSiteResponse) GetSite() *admin.AdminSiteConfig {
@@ -1383,7 +1647,7 @@ type AdminUpdateSiteRequest struct {
 
 func (x *AdminUpdateSiteRequest) Reset() {
 	*x = AdminUpdateSiteRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[26]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1395,7 +1659,7 @@ func (x *AdminUpdateSiteRequest) String() string {
 func (*AdminUpdateSiteRequest) ProtoMessage() {}
 
 func (x *AdminUpdateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[26]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1672,7 @@ func (x *AdminUpdateSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateSiteRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{26}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AdminUpdateSiteRequest) GetOrganizationId() string {
@@ -1455,7 +1719,7 @@ type AdminUpdateSiteResponse struct {
 
 func (x *AdminUpdateSiteResponse) Reset() {
 	*x = AdminUpdateSiteResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[27]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1467,7 +1731,7 @@ func (x *AdminUpdateSiteResponse) String() string {
 func (*AdminUpdateSiteResponse) ProtoMessage() {}
 
 func (x *AdminUpdateSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[27]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,7 +1744,7 @@ func (x *AdminUpdateSiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateSiteResponse.ProtoReflect.Descriptor instead.
 func (*AdminUpdateSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{27}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *AdminUpdateSiteResponse) GetSite() *admin.AdminSiteConfig {
@@ -1501,7 +1765,7 @@ type AdminDeleteSiteRequest struct {
 
 func (x *AdminDeleteSiteRequest) Reset() {
 	*x = AdminDeleteSiteRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[28]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +1777,7 @@ func (x *AdminDeleteSiteRequest) String() string {
 func (*AdminDeleteSiteRequest) ProtoMessage() {}
 
 func (x *AdminDeleteSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[28]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +1790,7 @@ func (x *AdminDeleteSiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDeleteSiteRequest.ProtoReflect.Descriptor instead.
 func (*AdminDeleteSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{28}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *AdminDeleteSiteRequest) GetOrganizationId() string {
@@ -1562,7 +1826,7 @@ type AdminListSitesRequest struct {
 
 func (x *AdminListSitesRequest) Reset() {
 	*x = AdminListSitesRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[29]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1574,7 +1838,7 @@ func (x *AdminListSitesRequest) String() string {
 func (*AdminListSitesRequest) ProtoMessage() {}
 
 func (x *AdminListSitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[29]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,7 +1851,7 @@ func (x *AdminListSitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListSitesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListSitesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{29}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AdminListSitesRequest) GetOrganizationId() string {
@@ -1628,7 +1892,7 @@ type AdminListSitesResponse struct {
 
 func (x *AdminListSitesResponse) Reset() {
 	*x = AdminListSitesResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[30]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1640,7 +1904,7 @@ func (x *AdminListSitesResponse) String() string {
 func (*AdminListSitesResponse) ProtoMessage() {}
 
 func (x *AdminListSitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[30]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,7 +1917,7 @@ func (x *AdminListSitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListSitesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListSitesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{30}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AdminListSitesResponse) GetSites() []*admin.AdminSiteConfig {
@@ -1681,7 +1945,7 @@ type AdminListAllSitesRequest struct {
 
 func (x *AdminListAllSitesRequest) Reset() {
 	*x = AdminListAllSitesRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[31]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1693,7 +1957,7 @@ func (x *AdminListAllSitesRequest) String() string {
 func (*AdminListAllSitesRequest) ProtoMessage() {}
 
 func (x *AdminListAllSitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[31]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,7 +1970,7 @@ func (x *AdminListAllSitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListAllSitesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListAllSitesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{31}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AdminListAllSitesRequest) GetPageSize() int32 {
@@ -1740,7 +2004,7 @@ type AdminListAllSitesResponse struct {
 
 func (x *AdminListAllSitesResponse) Reset() {
 	*x = AdminListAllSitesResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[32]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1752,7 +2016,7 @@ func (x *AdminListAllSitesResponse) String() string {
 func (*AdminListAllSitesResponse) ProtoMessage() {}
 
 func (x *AdminListAllSitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[32]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,7 +2029,7 @@ func (x *AdminListAllSitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListAllSitesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListAllSitesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{32}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AdminListAllSitesResponse) GetSites() []*admin.AdminSiteConfig {
@@ -1791,7 +2055,7 @@ type GetSiteSSHKeysRequest struct {
 
 func (x *GetSiteSSHKeysRequest) Reset() {
 	*x = GetSiteSSHKeysRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[33]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +2067,7 @@ func (x *GetSiteSSHKeysRequest) String() string {
 func (*GetSiteSSHKeysRequest) ProtoMessage() {}
 
 func (x *GetSiteSSHKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[33]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +2080,7 @@ func (x *GetSiteSSHKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteSSHKeysRequest.ProtoReflect.Descriptor instead.
 func (*GetSiteSSHKeysRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{33}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetSiteSSHKeysRequest) GetSiteId() string {
@@ -1832,13 +2096,15 @@ type SSHKey struct {
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Fingerprint    string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 	GithubUsername string                 `protobuf:"bytes,4,opt,name=github_username,json=githubUsername,proto3" json:"github_username,omitempty"`
+	AccountId      string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Account public ID, the key owner's username on the VM
+	SftpOnly       bool                   `protobuf:"varint,6,opt,name=sftp_only,json=sftpOnly,proto3" json:"sftp_only,omitempty"`   // The owner only gets an SFTP login to the site's files
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SSHKey) Reset() {
 	*x = SSHKey{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[34]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1850,7 +2116,7 @@ func (x *SSHKey) String() string {
 func (*SSHKey) ProtoMessage() {}
 
 func (x *SSHKey) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[34]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,7 +2129,7 @@ func (x *SSHKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SSHKey.ProtoReflect.Descriptor instead.
 func (*SSHKey) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{34}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *SSHKey) GetPublicKey() string {
@@ -1894,6 +2160,20 @@ func (x *SSHKey) GetGithubUsername() string {
 	return ""
 }
 
+func (x *SSHKey) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SSHKey) GetSftpOnly() bool {
+	if x != nil {
+		return x.SftpOnly
+	}
+	return false
+}
+
 type GetSiteSSHKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Keys          []*SSHKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
@@ -1903,7 +2183,7 @@ type GetSiteSSHKeysResponse struct {
 
 func (x *GetSiteSSHKeysResponse) Reset() {
 	*x = GetSiteSSHKeysResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[35]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1915,7 +2195,7 @@ func (x *GetSiteSSHKeysResponse) String() string {
 func (*GetSiteSSHKeysResponse) ProtoMessage() {}
 
 func (x *GetSiteSSHKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[35]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1928,7 +2208,7 @@ func (x *GetSiteSSHKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteSSHKeysResponse.ProtoReflect.Descriptor instead.
 func (*GetSiteSSHKeysResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{35}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetSiteSSHKeysResponse) GetKeys() []*SSHKey {
@@ -1947,7 +2227,7 @@ type GetSiteSecretsRequest struct {
 
 func (x *GetSiteSecretsRequest) Reset() {
 	*x = GetSiteSecretsRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[36]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1959,7 +2239,7 @@ func (x *GetSiteSecretsRequest) String() string {
 func (*GetSiteSecretsRequest) ProtoMessage() {}
 
 func (x *GetSiteSecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[36]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,7 +2252,7 @@ func (x *GetSiteSecretsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteSecretsRequest.ProtoReflect.Descriptor instead.
 func (*GetSiteSecretsRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{36}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetSiteSecretsRequest) GetSiteId() string {
@@ -1986,13 +2266,14 @@ type Secret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // External secret for the controller to resolve; value is empty when set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Secret) Reset() {
 	*x = Secret{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[37]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2004,7 +2285,7 @@ func (x *Secret) String() string {
 func (*Secret) ProtoMessage() {}
 
 func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[37]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2017,7 +2298,7 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret.ProtoReflect.Descriptor instead.
 func (*Secret) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{37}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *Secret) GetKey() string {
@@ -2034,6 +2315,13 @@ func (x *Secret) GetValue() string {
 	return ""
 }
 
+func (x *Secret) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type GetSiteSecretsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
@@ -2043,7 +2331,7 @@ type GetSiteSecretsResponse struct {
 
 func (x *GetSiteSecretsResponse) Reset() {
 	*x = GetSiteSecretsResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[38]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2055,7 +2343,7 @@ func (x *GetSiteSecretsResponse) String() string {
 func (*GetSiteSecretsResponse) ProtoMessage() {}
 
 func (x *GetSiteSecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[38]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,7 +2356,7 @@ func (x *GetSiteSecretsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteSecretsResponse.ProtoReflect.Descriptor instead.
 func (*GetSiteSecretsResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{38}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetSiteSecretsResponse) GetSecrets() []*Secret {
@@ -2087,7 +2375,7 @@ type GetSiteFirewallRequest struct {
 
 func (x *GetSiteFirewallRequest) Reset() {
 	*x = GetSiteFirewallRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[39]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2099,7 +2387,7 @@ func (x *GetSiteFirewallRequest) String() string {
 func (*GetSiteFirewallRequest) ProtoMessage() {}
 
 func (x *GetSiteFirewallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[39]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2112,7 +2400,7 @@ func (x *GetSiteFirewallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteFirewallRequest.ProtoReflect.Descriptor instead.
 func (*GetSiteFirewallRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{39}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetSiteFirewallRequest) GetSiteId() string {
@@ -2134,7 +2422,7 @@ type FirewallRule struct {
 
 func (x *FirewallRule) Reset() {
 	*x = FirewallRule{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[40]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2146,7 +2434,7 @@ func (x *FirewallRule) String() string {
 func (*FirewallRule) ProtoMessage() {}
 
 func (x *FirewallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[40]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2159,7 +2447,7 @@ func (x *FirewallRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirewallRule.ProtoReflect.Descriptor instead.
 func (*FirewallRule) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{40}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FirewallRule) GetProtocol() string {
@@ -2199,7 +2487,7 @@ type GetSiteFirewallResponse struct {
 
 func (x *GetSiteFirewallResponse) Reset() {
 	*x = GetSiteFirewallResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[41]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2499,7 @@ func (x *GetSiteFirewallResponse) String() string {
 func (*GetSiteFirewallResponse) ProtoMessage() {}
 
 func (x *GetSiteFirewallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[41]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2512,7 @@ func (x *GetSiteFirewallResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSiteFirewallResponse.ProtoReflect.Descriptor instead.
 func (*GetSiteFirewallResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{41}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetSiteFirewallResponse) GetRules() []*FirewallRule {
@@ -2235,15 +2523,33 @@ func (x *GetSiteFirewallResponse) GetRules() []*FirewallRule {
 }
 
 type SiteCheckInRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	// Network egress in bytes since the controller's previous check-in
+	EgressBytes int64 `protobuf:"varint,2,opt,name=egress_bytes,json=egressBytes,proto3" json:"egress_bytes,omitempty"`
+	// Bytes currently used on the site's data disk
+	DiskUsedBytes int64 `protobuf:"varint,3,opt,name=disk_used_bytes,json=diskUsedBytes,proto3" json:"disk_used_bytes,omitempty"`
+	// Requests the WAF matched since the controller's previous check-in
+	WafBlocks []*WafBlock `protobuf:"bytes,4,rep,name=waf_blocks,json=wafBlocks,proto3" json:"waf_blocks,omitempty"`
+	// Requests each rate limit rejected since the controller's previous check-in
+	RateLimitRejections []*RateLimitRejections `protobuf:"bytes,5,rep,name=rate_limit_rejections,json=rateLimitRejections,proto3" json:"rate_limit_rejections,omitempty"`
+	// Controller release the site is running; empty for builds without a version
+	ControllerVersion string `protobuf:"bytes,6,opt,name=controller_version,json=controllerVersion,proto3" json:"controller_version,omitempty"`
+	// OS image the VM runs, e.g. "cos-125-19216-104-74"; empty when unknown
+	OsVersion string `protobuf:"bytes,7,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	// An installed OS update waits for a reboot
+	OsRebootRequired bool `protobuf:"varint,8,opt,name=os_reboot_required,json=osRebootRequired,proto3" json:"os_reboot_required,omitempty"`
+	// Containers running on the VM; unset by controllers that don't report them
+	ContainersRunning *int32 `protobuf:"varint,9,opt,name=containers_running,json=containersRunning,proto3,oneof" json:"containers_running,omitempty"`
+	// Running containers failing their health check; unset with containers_running
+	ContainersUnhealthy *int32 `protobuf:"varint,10,opt,name=containers_unhealthy,json=containersUnhealthy,proto3,oneof" json:"containers_unhealthy,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SiteCheckInRequest) Reset() {
 	*x = SiteCheckInRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[42]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +2561,7 @@ func (x *SiteCheckInRequest) String() string {
 func (*SiteCheckInRequest) ProtoMessage() {}
 
 func (x *SiteCheckInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[42]
+	mi := &file_libops_v1_admin_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2574,7 @@ func (x *SiteCheckInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SiteCheckInRequest.ProtoReflect.Descriptor instead.
 func (*SiteCheckInRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{42}
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SiteCheckInRequest) GetSiteId() string {
@@ -2278,81 +2584,92 @@ func (x *SiteCheckInRequest) GetSiteId() string {
 	return ""
 }
 
-type SiteCheckInResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SiteCheckInRequest) GetEgressBytes() int64 {
+	if x != nil {
+		return x.EgressBytes
+	}
+	return 0
 }
 
-func (x *SiteCheckInResponse) Reset() {
-	*x = SiteCheckInResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SiteCheckInRequest) GetDiskUsedBytes() int64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
 }
 
-func (x *SiteCheckInResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SiteCheckInRequest) GetWafBlocks() []*WafBlock {
+	if x != nil {
+		return x.WafBlocks
+	}
+	return nil
 }
 
-func (*SiteCheckInResponse) ProtoMessage() {}
+func (x *SiteCheckInRequest) GetRateLimitRejections() []*RateLimitRejections {
+	if x != nil {
+		return x.RateLimitRejections
+	}
+	return nil
+}
 
-func (x *SiteCheckInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[43]
+func (x *SiteCheckInRequest) GetControllerVersion() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ControllerVersion
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SiteCheckInResponse.ProtoReflect.Descriptor instead.
-func (*SiteCheckInResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{43}
+func (x *SiteCheckInRequest) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
 }
 
-func (x *SiteCheckInResponse) GetSuccess() bool {
+func (x *SiteCheckInRequest) GetOsRebootRequired() bool {
 	if x != nil {
-		return x.Success
+		return x.OsRebootRequired
 	}
 	return false
 }
 
-func (x *SiteCheckInResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *SiteCheckInRequest) GetContainersRunning() int32 {
+	if x != nil && x.ContainersRunning != nil {
+		return *x.ContainersRunning
 	}
-	return ""
+	return 0
 }
 
-type SyncManifestRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SiteId           string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                                       // Site public ID
-	CurrentStateHash *string                `protobuf:"bytes,2,opt,name=current_state_hash,json=currentStateHash,proto3,oneof" json:"current_state_hash,omitempty"` // For ETag optimization (304 Not Modified)
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *SiteCheckInRequest) GetContainersUnhealthy() int32 {
+	if x != nil && x.ContainersUnhealthy != nil {
+		return *x.ContainersUnhealthy
+	}
+	return 0
 }
 
-func (x *SyncManifestRequest) Reset() {
-	*x = SyncManifestRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[44]
+type RateLimitRejections struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Rate limit rule public ID
+	Rejected      int64                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitRejections) Reset() {
+	*x = RateLimitRejections{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncManifestRequest) String() string {
+func (x *RateLimitRejections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncManifestRequest) ProtoMessage() {}
+func (*RateLimitRejections) ProtoMessage() {}
 
-func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[44]
+func (x *RateLimitRejections) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,48 +2680,63 @@ func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncManifestRequest.ProtoReflect.Descriptor instead.
-func (*SyncManifestRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RateLimitRejections.ProtoReflect.Descriptor instead.
+func (*RateLimitRejections) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SyncManifestRequest) GetSiteId() string {
+func (x *RateLimitRejections) GetRuleId() string {
 	if x != nil {
-		return x.SiteId
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *SyncManifestRequest) GetCurrentStateHash() string {
-	if x != nil && x.CurrentStateHash != nil {
-		return *x.CurrentStateHash
+func (x *RateLimitRejections) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
 	}
-	return ""
+	return 0
 }
 
-type SyncManifestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StateHash     string                 `protobuf:"bytes,1,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"` // Current state hash for this site
-	Blobs         *StateBlobs            `protobuf:"bytes,2,opt,name=blobs,proto3" json:"blobs,omitempty"`                          // Signed URLs to GCS blobs
+type SiteCheckInResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Site status (e.g. "active", "suspended"); the controller stops the
+	// application while the site is suspended
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The controller's tunables, applied without a restart. Unset fields keep
+	// what the controller was started with.
+	ControllerConfig *ControllerConfig `protobuf:"bytes,4,opt,name=controller_config,json=controllerConfig,proto3" json:"controller_config,omitempty"`
+	// The release the controller should run, when its config names one. The
+	// controller updates itself when it runs another version.
+	ControllerRelease *ControllerRelease `protobuf:"bytes,5,opt,name=controller_release,json=controllerRelease,proto3" json:"controller_release,omitempty"`
+	// The API region serving the site, e.g. "europe-west1"; empty when the API
+	// runs in a single region
+	ApiRegion string `protobuf:"bytes,6,opt,name=api_region,json=apiRegion,proto3" json:"api_region,omitempty"`
+	// The public API endpoint of that region. A controller that discovered its
+	// API URL moves its traffic there; empty when it should stay where it is.
+	ApiUrl        string `protobuf:"bytes,7,opt,name=api_url,json=apiUrl,proto3" json:"api_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncManifestResponse) Reset() {
-	*x = SyncManifestResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[45]
+func (x *SiteCheckInResponse) Reset() {
+	*x = SiteCheckInResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncManifestResponse) String() string {
+func (x *SiteCheckInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncManifestResponse) ProtoMessage() {}
+func (*SiteCheckInResponse) ProtoMessage() {}
 
-func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[45]
+func (x *SiteCheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,49 +2747,85 @@ func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncManifestResponse.ProtoReflect.Descriptor instead.
-func (*SyncManifestResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use SiteCheckInResponse.ProtoReflect.Descriptor instead.
+func (*SiteCheckInResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SyncManifestResponse) GetStateHash() string {
+func (x *SiteCheckInResponse) GetSuccess() bool {
 	if x != nil {
-		return x.StateHash
+		return x.Success
+	}
+	return false
+}
+
+func (x *SiteCheckInResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *SyncManifestResponse) GetBlobs() *StateBlobs {
+func (x *SiteCheckInResponse) GetStatus() string {
 	if x != nil {
-		return x.Blobs
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SiteCheckInResponse) GetControllerConfig() *ControllerConfig {
+	if x != nil {
+		return x.ControllerConfig
 	}
 	return nil
 }
 
-type StateBlobs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SshKeysUrl    string                 `protobuf:"bytes,1,opt,name=ssh_keys_url,json=sshKeysUrl,proto3" json:"ssh_keys_url,omitempty"`  // Signed GCS URL to ssh-keys.json
-	SecretsUrl    string                 `protobuf:"bytes,2,opt,name=secrets_url,json=secretsUrl,proto3" json:"secrets_url,omitempty"`    // Signed GCS URL to secrets.json
-	FirewallUrl   string                 `protobuf:"bytes,3,opt,name=firewall_url,json=firewallUrl,proto3" json:"firewall_url,omitempty"` // Signed GCS URL to firewall.json
+func (x *SiteCheckInResponse) GetControllerRelease() *ControllerRelease {
+	if x != nil {
+		return x.ControllerRelease
+	}
+	return nil
+}
+
+func (x *SiteCheckInResponse) GetApiRegion() string {
+	if x != nil {
+		return x.ApiRegion
+	}
+	return ""
+}
+
+func (x *SiteCheckInResponse) GetApiUrl() string {
+	if x != nil {
+		return x.ApiUrl
+	}
+	return ""
+}
+
+type IssueSiteClientCertificateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	// PEM certificate request for a key the controller generated; only its
+	// public key is used
+	CsrPem        string `protobuf:"bytes,2,opt,name=csr_pem,json=csrPem,proto3" json:"csr_pem,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StateBlobs) Reset() {
-	*x = StateBlobs{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[46]
+func (x *IssueSiteClientCertificateRequest) Reset() {
+	*x = IssueSiteClientCertificateRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StateBlobs) String() string {
+func (x *IssueSiteClientCertificateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StateBlobs) ProtoMessage() {}
+func (*IssueSiteClientCertificateRequest) ProtoMessage() {}
 
-func (x *StateBlobs) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[46]
+func (x *IssueSiteClientCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,55 +2836,2410 @@ func (x *StateBlobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StateBlobs.ProtoReflect.Descriptor instead.
-func (*StateBlobs) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use IssueSiteClientCertificateRequest.ProtoReflect.Descriptor instead.
+func (*IssueSiteClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *StateBlobs) GetSshKeysUrl() string {
+func (x *IssueSiteClientCertificateRequest) GetSiteId() string {
 	if x != nil {
-		return x.SshKeysUrl
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *StateBlobs) GetSecretsUrl() string {
+func (x *IssueSiteClientCertificateRequest) GetCsrPem() string {
 	if x != nil {
-		return x.SecretsUrl
+		return x.CsrPem
 	}
 	return ""
 }
 
-func (x *StateBlobs) GetFirewallUrl() string {
-	if x != nil {
-		return x.FirewallUrl
-	}
+type IssueSiteClientCertificateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CertificatePem string                 `protobuf:"bytes,1,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	// The controller CA's certificate
+	CaCertificatePem string `protobuf:"bytes,2,opt,name=ca_certificate_pem,json=caCertificatePem,proto3" json:"ca_certificate_pem,omitempty"`
+	NotAfter         int64  `protobuf:"varint,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"` // Unix timestamp
+	// When the controller should request its next certificate; Unix timestamp
+	RenewAfter    int64 `protobuf:"varint,4,opt,name=renew_after,json=renewAfter,proto3" json:"renew_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSiteClientCertificateResponse) Reset() {
+	*x = IssueSiteClientCertificateResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSiteClientCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSiteClientCertificateResponse) ProtoMessage() {}
+
+func (x *IssueSiteClientCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSiteClientCertificateResponse.ProtoReflect.Descriptor instead.
+func (*IssueSiteClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IssueSiteClientCertificateResponse) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *IssueSiteClientCertificateResponse) GetCaCertificatePem() string {
+	if x != nil {
+		return x.CaCertificatePem
+	}
+	return ""
+}
+
+func (x *IssueSiteClientCertificateResponse) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+func (x *IssueSiteClientCertificateResponse) GetRenewAfter() int64 {
+	if x != nil {
+		return x.RenewAfter
+	}
+	return 0
+}
+
+// ControllerConfig is the document site VM controllers tune themselves with.
+// The fleet-wide document applies to every site, and a site's own overrides
+// it field by field.
+type ControllerConfig struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RateLimitRps             *int32                 `protobuf:"varint,1,opt,name=rate_limit_rps,json=rateLimitRps,proto3,oneof" json:"rate_limit_rps,omitempty"`                                     // Reconcile requests per second, 1-1000
+	RateLimitBurst           *int32                 `protobuf:"varint,2,opt,name=rate_limit_burst,json=rateLimitBurst,proto3,oneof" json:"rate_limit_burst,omitempty"`                               // 1-1000
+	ReconcileIntervalMinutes *int32                 `protobuf:"varint,3,opt,name=reconcile_interval_minutes,json=reconcileIntervalMinutes,proto3,oneof" json:"reconcile_interval_minutes,omitempty"` // Between periodic full reconciliations, 5-1440
+	CheckinIntervalSeconds   *int32                 `protobuf:"varint,4,opt,name=checkin_interval_seconds,json=checkinIntervalSeconds,proto3,oneof" json:"checkin_interval_seconds,omitempty"`       // 15-3600
+	// Flag name to enabled, e.g. "nftables" to apply firewall rules with
+	// nftables instead of iptables
+	FeatureFlags map[string]bool `protobuf:"bytes,5,rep,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Identifies the merged document sent on check-in; empty elsewhere
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Controller release the sites should run; must name a published release
+	ControllerVersion *string `protobuf:"bytes,7,opt,name=controller_version,json=controllerVersion,proto3,oneof" json:"controller_version,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ControllerConfig) Reset() {
+	*x = ControllerConfig{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControllerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerConfig) ProtoMessage() {}
+
+func (x *ControllerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerConfig.ProtoReflect.Descriptor instead.
+func (*ControllerConfig) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ControllerConfig) GetRateLimitRps() int32 {
+	if x != nil && x.RateLimitRps != nil {
+		return *x.RateLimitRps
+	}
+	return 0
+}
+
+func (x *ControllerConfig) GetRateLimitBurst() int32 {
+	if x != nil && x.RateLimitBurst != nil {
+		return *x.RateLimitBurst
+	}
+	return 0
+}
+
+func (x *ControllerConfig) GetReconcileIntervalMinutes() int32 {
+	if x != nil && x.ReconcileIntervalMinutes != nil {
+		return *x.ReconcileIntervalMinutes
+	}
+	return 0
+}
+
+func (x *ControllerConfig) GetCheckinIntervalSeconds() int32 {
+	if x != nil && x.CheckinIntervalSeconds != nil {
+		return *x.CheckinIntervalSeconds
+	}
+	return 0
+}
+
+func (x *ControllerConfig) GetFeatureFlags() map[string]bool {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+func (x *ControllerConfig) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ControllerConfig) GetControllerVersion() string {
+	if x != nil && x.ControllerVersion != nil {
+		return *x.ControllerVersion
+	}
+	return ""
+}
+
+// ControllerRelease is a signed build of the site VM controller. Controllers
+// only install a release whose binary matches its digest and signature.
+type ControllerRelease struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Version     string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                            // e.g. "v1.4.0"
+	DownloadUrl string                 `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"` // HTTPS URL of the linux/amd64 binary
+	Sha256      string                 `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`                              // Hex SHA-256 digest of the binary
+	Signature   string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`                        // Base64 ed25519 signature of the binary
+	Notes       string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt   int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	// Sites whose controller reported running the release on its last
+	// check-in; only set when listing releases
+	SiteCount     int64 `protobuf:"varint,7,opt,name=site_count,json=siteCount,proto3" json:"site_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControllerRelease) Reset() {
+	*x = ControllerRelease{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControllerRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerRelease) ProtoMessage() {}
+
+func (x *ControllerRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControllerRelease.ProtoReflect.Descriptor instead.
+func (*ControllerRelease) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ControllerRelease) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ControllerRelease) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ControllerRelease) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ControllerRelease) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ControllerRelease) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ControllerRelease) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ControllerRelease) GetSiteCount() int64 {
+	if x != nil {
+		return x.SiteCount
+	}
+	return 0
+}
+
+type GetSiteProxyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteProxyConfigRequest) Reset() {
+	*x = GetSiteProxyConfigRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteProxyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteProxyConfigRequest) ProtoMessage() {}
+
+func (x *GetSiteProxyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteProxyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSiteProxyConfigRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteProxyConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most specific first, the order the controller renders them in
+	Redirects []*Redirect      `protobuf:"bytes,1,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	Access    *SiteProxyAccess `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
+	Waf       *SiteProxyWaf    `protobuf:"bytes,3,opt,name=waf,proto3" json:"waf,omitempty"`
+	// Most specific path first
+	RateLimits    []*SiteRateLimitRule `protobuf:"bytes,4,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	Tls           *SiteProxyTls        `protobuf:"bytes,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteProxyConfigResponse) Reset() {
+	*x = GetSiteProxyConfigResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteProxyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteProxyConfigResponse) ProtoMessage() {}
+
+func (x *GetSiteProxyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteProxyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetSiteProxyConfigResponse) GetRedirects() []*Redirect {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+func (x *GetSiteProxyConfigResponse) GetAccess() *SiteProxyAccess {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *GetSiteProxyConfigResponse) GetWaf() *SiteProxyWaf {
+	if x != nil {
+		return x.Waf
+	}
+	return nil
+}
+
+func (x *GetSiteProxyConfigResponse) GetRateLimits() []*SiteRateLimitRule {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *GetSiteProxyConfigResponse) GetTls() *SiteProxyTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// SiteProxyAccess is a site's access protection as the controller renders it
+type SiteProxyAccess struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Mode                  SiteAccessMode         `protobuf:"varint,1,opt,name=mode,proto3,enum=libops.v1.SiteAccessMode" json:"mode,omitempty"`
+	BasicAuthUsername     string                 `protobuf:"bytes,2,opt,name=basic_auth_username,json=basicAuthUsername,proto3" json:"basic_auth_username,omitempty"`
+	BasicAuthPasswordHash string                 `protobuf:"bytes,3,opt,name=basic_auth_password_hash,json=basicAuthPasswordHash,proto3" json:"basic_auth_password_hash,omitempty"` // bcrypt, for the htpasswd file
+	// Key the members only gate's tickets are signed with; the controller signs
+	// its session cookies with it too
+	GateSecret string `protobuf:"bytes,4,opt,name=gate_secret,json=gateSecret,proto3" json:"gate_secret,omitempty"`
+	// Dashboard page that signs people in and sends them back with a ticket
+	GateUrl       string `protobuf:"bytes,5,opt,name=gate_url,json=gateUrl,proto3" json:"gate_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteProxyAccess) Reset() {
+	*x = SiteProxyAccess{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteProxyAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteProxyAccess) ProtoMessage() {}
+
+func (x *SiteProxyAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteProxyAccess.ProtoReflect.Descriptor instead.
+func (*SiteProxyAccess) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SiteProxyAccess) GetMode() SiteAccessMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SiteAccessMode_SITE_ACCESS_MODE_UNSPECIFIED
+}
+
+func (x *SiteProxyAccess) GetBasicAuthUsername() string {
+	if x != nil {
+		return x.BasicAuthUsername
+	}
+	return ""
+}
+
+func (x *SiteProxyAccess) GetBasicAuthPasswordHash() string {
+	if x != nil {
+		return x.BasicAuthPasswordHash
+	}
+	return ""
+}
+
+func (x *SiteProxyAccess) GetGateSecret() string {
+	if x != nil {
+		return x.GateSecret
+	}
+	return ""
+}
+
+func (x *SiteProxyAccess) GetGateUrl() string {
+	if x != nil {
+		return x.GateUrl
+	}
+	return ""
+}
+
+// SiteProxyWaf is a site's WAF as the controller renders it
+type SiteProxyWaf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mode          WafMode                `protobuf:"varint,2,opt,name=mode,proto3,enum=libops.v1.WafMode" json:"mode,omitempty"`
+	ParanoiaLevel int32                  `protobuf:"varint,3,opt,name=paranoia_level,json=paranoiaLevel,proto3" json:"paranoia_level,omitempty"`
+	Exclusions    []*WafRuleExclusion    `protobuf:"bytes,4,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteProxyWaf) Reset() {
+	*x = SiteProxyWaf{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteProxyWaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteProxyWaf) ProtoMessage() {}
+
+func (x *SiteProxyWaf) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteProxyWaf.ProtoReflect.Descriptor instead.
+func (*SiteProxyWaf) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SiteProxyWaf) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SiteProxyWaf) GetMode() WafMode {
+	if x != nil {
+		return x.Mode
+	}
+	return WafMode_WAF_MODE_UNSPECIFIED
+}
+
+func (x *SiteProxyWaf) GetParanoiaLevel() int32 {
+	if x != nil {
+		return x.ParanoiaLevel
+	}
+	return 0
+}
+
+func (x *SiteProxyWaf) GetExclusions() []*WafRuleExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+// SiteProxyTls is a site's TLS policy as the controller renders and probes it
+type SiteProxyTls struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	MinVersion            TlsVersion             `protobuf:"varint,1,opt,name=min_version,json=minVersion,proto3,enum=libops.v1.TlsVersion" json:"min_version,omitempty"`
+	HstsMaxAgeSeconds     int32                  `protobuf:"varint,2,opt,name=hsts_max_age_seconds,json=hstsMaxAgeSeconds,proto3" json:"hsts_max_age_seconds,omitempty"`
+	HstsIncludeSubdomains bool                   `protobuf:"varint,3,opt,name=hsts_include_subdomains,json=hstsIncludeSubdomains,proto3" json:"hsts_include_subdomains,omitempty"`
+	HstsPreload           bool                   `protobuf:"varint,4,opt,name=hsts_preload,json=hstsPreload,proto3" json:"hsts_preload,omitempty"`
+	OcspStapling          bool                   `protobuf:"varint,5,opt,name=ocsp_stapling,json=ocspStapling,proto3" json:"ocsp_stapling,omitempty"`
+	// Set with the private key when the site serves a custom certificate
+	CertificatePem string `protobuf:"bytes,6,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	PrivateKeyPem  string `protobuf:"bytes,7,opt,name=private_key_pem,json=privateKeyPem,proto3" json:"private_key_pem,omitempty"`
+	// The site's domains, which the probe connects to
+	Domains       []string `protobuf:"bytes,8,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteProxyTls) Reset() {
+	*x = SiteProxyTls{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteProxyTls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteProxyTls) ProtoMessage() {}
+
+func (x *SiteProxyTls) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteProxyTls.ProtoReflect.Descriptor instead.
+func (*SiteProxyTls) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SiteProxyTls) GetMinVersion() TlsVersion {
+	if x != nil {
+		return x.MinVersion
+	}
+	return TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+func (x *SiteProxyTls) GetHstsMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.HstsMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *SiteProxyTls) GetHstsIncludeSubdomains() bool {
+	if x != nil {
+		return x.HstsIncludeSubdomains
+	}
+	return false
+}
+
+func (x *SiteProxyTls) GetHstsPreload() bool {
+	if x != nil {
+		return x.HstsPreload
+	}
+	return false
+}
+
+func (x *SiteProxyTls) GetOcspStapling() bool {
+	if x != nil {
+		return x.OcspStapling
+	}
+	return false
+}
+
+func (x *SiteProxyTls) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *SiteProxyTls) GetPrivateKeyPem() string {
+	if x != nil {
+		return x.PrivateKeyPem
+	}
+	return ""
+}
+
+func (x *SiteProxyTls) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type SyncManifestRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SiteId           string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                                       // Site public ID
+	CurrentStateHash *string                `protobuf:"bytes,2,opt,name=current_state_hash,json=currentStateHash,proto3,oneof" json:"current_state_hash,omitempty"` // For ETag optimization (304 Not Modified)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncManifestRequest) Reset() {
+	*x = SyncManifestRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncManifestRequest) ProtoMessage() {}
+
+func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncManifestRequest.ProtoReflect.Descriptor instead.
+func (*SyncManifestRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SyncManifestRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SyncManifestRequest) GetCurrentStateHash() string {
+	if x != nil && x.CurrentStateHash != nil {
+		return *x.CurrentStateHash
+	}
+	return ""
+}
+
+type SyncManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateHash     string                 `protobuf:"bytes,1,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"` // Current state hash for this site
+	Blobs         *StateBlobs            `protobuf:"bytes,2,opt,name=blobs,proto3" json:"blobs,omitempty"`                          // Signed URLs to GCS blobs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncManifestResponse) Reset() {
+	*x = SyncManifestResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncManifestResponse) ProtoMessage() {}
+
+func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncManifestResponse.ProtoReflect.Descriptor instead.
+func (*SyncManifestResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SyncManifestResponse) GetStateHash() string {
+	if x != nil {
+		return x.StateHash
+	}
+	return ""
+}
+
+func (x *SyncManifestResponse) GetBlobs() *StateBlobs {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+type StateBlobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SshKeysUrl    string                 `protobuf:"bytes,1,opt,name=ssh_keys_url,json=sshKeysUrl,proto3" json:"ssh_keys_url,omitempty"`  // Signed GCS URL to ssh-keys.json
+	SecretsUrl    string                 `protobuf:"bytes,2,opt,name=secrets_url,json=secretsUrl,proto3" json:"secrets_url,omitempty"`    // Signed GCS URL to secrets.json
+	FirewallUrl   string                 `protobuf:"bytes,3,opt,name=firewall_url,json=firewallUrl,proto3" json:"firewall_url,omitempty"` // Signed GCS URL to firewall.json
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateBlobs) Reset() {
+	*x = StateBlobs{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateBlobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateBlobs) ProtoMessage() {}
+
+func (x *StateBlobs) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateBlobs.ProtoReflect.Descriptor instead.
+func (*StateBlobs) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StateBlobs) GetSshKeysUrl() string {
+	if x != nil {
+		return x.SshKeysUrl
+	}
+	return ""
+}
+
+func (x *StateBlobs) GetSecretsUrl() string {
+	if x != nil {
+		return x.SecretsUrl
+	}
+	return ""
+}
+
+func (x *StateBlobs) GetFirewallUrl() string {
+	if x != nil {
+		return x.FirewallUrl
+	}
+	return ""
+}
+
+type GetBlobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`       // Site public ID
+	BlobType      string                 `protobuf:"bytes,2,opt,name=blob_type,json=blobType,proto3" json:"blob_type,omitempty"` // "ssh_keys", "secrets", "firewall"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlobRequest) Reset() {
+	*x = GetBlobRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobRequest) ProtoMessage() {}
+
+func (x *GetBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobRequest.ProtoReflect.Descriptor instead.
+func (*GetBlobRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetBlobRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GetBlobRequest) GetBlobType() string {
+	if x != nil {
+		return x.BlobType
+	}
+	return ""
+}
+
+type GetBlobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // Blob content (JSON)
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // "application/json"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlobResponse) Reset() {
+	*x = GetBlobResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobResponse) ProtoMessage() {}
+
+func (x *GetBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobResponse.ProtoReflect.Descriptor instead.
+func (*GetBlobResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetBlobResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetBlobResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GetReconciliationRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationRunRequest) Reset() {
+	*x = GetReconciliationRunRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationRunRequest) ProtoMessage() {}
+
+func (x *GetReconciliationRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationRunRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationRunRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetReconciliationRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type GetReconciliationRunResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RunId              string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RunType            string                 `protobuf:"bytes,2,opt,name=run_type,json=runType,proto3" json:"run_type,omitempty"`                                        // terraform or reconciliation
+	ReconciliationType *string                `protobuf:"bytes,3,opt,name=reconciliation_type,json=reconciliationType,proto3,oneof" json:"reconciliation_type,omitempty"` // ssh_keys, secrets, firewall, general
+	Modules            []string               `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`                                                       // For terraform runs
+	TargetSiteIds      []int64                `protobuf:"varint,5,rep,packed,name=target_site_ids,json=targetSiteIds,proto3" json:"target_site_ids,omitempty"`            // For reconciliation runs
+	EventIds           []string               `protobuf:"bytes,6,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	OrganizationId     *int64                 `protobuf:"varint,7,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	ProjectId          *int64                 `protobuf:"varint,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	SiteId             *int64                 `protobuf:"varint,9,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// The terraform states the run applies, in order
+	Workspaces    []*TerraformWorkspace `protobuf:"bytes,11,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationRunResponse) Reset() {
+	*x = GetReconciliationRunResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationRunResponse) ProtoMessage() {}
+
+func (x *GetReconciliationRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationRunResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationRunResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetReconciliationRunResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *GetReconciliationRunResponse) GetRunType() string {
+	if x != nil {
+		return x.RunType
+	}
+	return ""
+}
+
+func (x *GetReconciliationRunResponse) GetReconciliationType() string {
+	if x != nil && x.ReconciliationType != nil {
+		return *x.ReconciliationType
+	}
+	return ""
+}
+
+func (x *GetReconciliationRunResponse) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *GetReconciliationRunResponse) GetTargetSiteIds() []int64 {
+	if x != nil {
+		return x.TargetSiteIds
+	}
+	return nil
+}
+
+func (x *GetReconciliationRunResponse) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *GetReconciliationRunResponse) GetOrganizationId() int64 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *GetReconciliationRunResponse) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetReconciliationRunResponse) GetSiteId() int64 {
+	if x != nil && x.SiteId != nil {
+		return *x.SiteId
+	}
+	return 0
+}
+
+func (x *GetReconciliationRunResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetReconciliationRunResponse) GetWorkspaces() []*TerraformWorkspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+// TerraformWorkspace is a terraform state in the organization's tfstate
+// bucket. Monolithic organizations keep everything in one state that runs
+// target modules in; isolated organizations give each project and site a
+// state of its own.
+type TerraformWorkspace struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StatePrefix string                 `protobuf:"bytes,1,opt,name=state_prefix,json=statePrefix,proto3" json:"state_prefix,omitempty"` // GCS backend prefix, e.g. "terraform/sites/<site public ID>"
+	// Scope to pass to GenerateTerraformVars
+	OrganizationId *int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	ProjectId      *int64 `protobuf:"varint,3,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	SiteId         *int64 `protobuf:"varint,4,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
+	// The state only holds its scope's own resource, not the projects and sites under it
+	Isolated bool     `protobuf:"varint,5,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	Targets  []string `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"` // -target addresses, only for monolithic states
+	// Module address a state migration moves into this workspace, e.g. module.sites["<site public ID>"]
+	Address       string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerraformWorkspace) Reset() {
+	*x = TerraformWorkspace{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformWorkspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformWorkspace) ProtoMessage() {}
+
+func (x *TerraformWorkspace) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformWorkspace.ProtoReflect.Descriptor instead.
+func (*TerraformWorkspace) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TerraformWorkspace) GetStatePrefix() string {
+	if x != nil {
+		return x.StatePrefix
+	}
+	return ""
+}
+
+func (x *TerraformWorkspace) GetOrganizationId() int64 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *TerraformWorkspace) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *TerraformWorkspace) GetSiteId() int64 {
+	if x != nil && x.SiteId != nil {
+		return *x.SiteId
+	}
+	return 0
+}
+
+func (x *TerraformWorkspace) GetIsolated() bool {
+	if x != nil {
+		return x.Isolated
+	}
+	return false
+}
+
+func (x *TerraformWorkspace) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *TerraformWorkspace) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type UpdateReconciliationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending, triggered, running, completed, failed
+	ErrorMessage  *string                `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReconciliationStatusRequest) Reset() {
+	*x = UpdateReconciliationStatusRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReconciliationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReconciliationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateReconciliationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReconciliationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReconciliationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateReconciliationStatusRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *UpdateReconciliationStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateReconciliationStatusRequest) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+type UpdateReconciliationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReconciliationStatusResponse) Reset() {
+	*x = UpdateReconciliationStatusResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReconciliationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReconciliationStatusResponse) ProtoMessage() {}
+
+func (x *UpdateReconciliationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReconciliationStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReconciliationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateReconciliationStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GenerateTerraformVarsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *int64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	ProjectId      *int64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	SiteId         *int64                 `protobuf:"varint,3,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
+	// Only the scope's own resource, for an isolated workspace
+	Isolated      bool `protobuf:"varint,4,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTerraformVarsRequest) Reset() {
+	*x = GenerateTerraformVarsRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTerraformVarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTerraformVarsRequest) ProtoMessage() {}
+
+func (x *GenerateTerraformVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTerraformVarsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTerraformVarsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GenerateTerraformVarsRequest) GetOrganizationId() int64 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *GenerateTerraformVarsRequest) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *GenerateTerraformVarsRequest) GetSiteId() int64 {
+	if x != nil && x.SiteId != nil {
+		return *x.SiteId
+	}
+	return 0
+}
+
+func (x *GenerateTerraformVarsRequest) GetIsolated() bool {
+	if x != nil {
+		return x.Isolated
+	}
+	return false
+}
+
+type GenerateTerraformVarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TfvarsJson    string                 `protobuf:"bytes,1,opt,name=tfvars_json,json=tfvarsJson,proto3" json:"tfvars_json,omitempty"` // JSON-encoded terraform variables
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTerraformVarsResponse) Reset() {
+	*x = GenerateTerraformVarsResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateTerraformVarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTerraformVarsResponse) ProtoMessage() {}
+
+func (x *GenerateTerraformVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTerraformVarsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTerraformVarsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GenerateTerraformVarsResponse) GetTfvarsJson() string {
+	if x != nil {
+		return x.TfvarsJson
+	}
+	return ""
+}
+
+type ResolvePrivateServiceConnectEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The organization, or a site in it; site controllers only know their site
+	OrganizationId string                      `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SiteId         string                      `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Target         PrivateServiceConnectTarget `protobuf:"varint,3,opt,name=target,proto3,enum=libops.v1.PrivateServiceConnectTarget" json:"target,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) Reset() {
+	*x = ResolvePrivateServiceConnectEndpointRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePrivateServiceConnectEndpointRequest) ProtoMessage() {}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePrivateServiceConnectEndpointRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePrivateServiceConnectEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ResolvePrivateServiceConnectEndpointRequest) GetTarget() PrivateServiceConnectTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED
+}
+
+type ResolvePrivateServiceConnectEndpointResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Endpoint      *PrivateServiceConnectEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvePrivateServiceConnectEndpointResponse) Reset() {
+	*x = ResolvePrivateServiceConnectEndpointResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvePrivateServiceConnectEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvePrivateServiceConnectEndpointResponse) ProtoMessage() {}
+
+func (x *ResolvePrivateServiceConnectEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvePrivateServiceConnectEndpointResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePrivateServiceConnectEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ResolvePrivateServiceConnectEndpointResponse) GetEndpoint() *PrivateServiceConnectEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+type AppliedPrivateServiceConnectEndpoint struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Target         PrivateServiceConnectTarget `protobuf:"varint,1,opt,name=target,proto3,enum=libops.v1.PrivateServiceConnectTarget" json:"target,omitempty"`
+	IpAddress      string                      `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	ForwardingRule string                      `protobuf:"bytes,3,opt,name=forwarding_rule,json=forwardingRule,proto3" json:"forwarding_rule,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AppliedPrivateServiceConnectEndpoint) Reset() {
+	*x = AppliedPrivateServiceConnectEndpoint{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedPrivateServiceConnectEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPrivateServiceConnectEndpoint) ProtoMessage() {}
+
+func (x *AppliedPrivateServiceConnectEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedPrivateServiceConnectEndpoint.ProtoReflect.Descriptor instead.
+func (*AppliedPrivateServiceConnectEndpoint) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AppliedPrivateServiceConnectEndpoint) GetTarget() PrivateServiceConnectTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED
+}
+
+func (x *AppliedPrivateServiceConnectEndpoint) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AppliedPrivateServiceConnectEndpoint) GetForwardingRule() string {
+	if x != nil {
+		return x.ForwardingRule
+	}
+	return ""
+}
+
+type ReportPrivateServiceConnectEndpointsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Organization public ID
+	// Every endpoint in the organization's terraform state after apply
+	Endpoints     []*AppliedPrivateServiceConnectEndpoint `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPrivateServiceConnectEndpointsRequest) Reset() {
+	*x = ReportPrivateServiceConnectEndpointsRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPrivateServiceConnectEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPrivateServiceConnectEndpointsRequest) ProtoMessage() {}
+
+func (x *ReportPrivateServiceConnectEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPrivateServiceConnectEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ReportPrivateServiceConnectEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReportPrivateServiceConnectEndpointsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ReportPrivateServiceConnectEndpointsRequest) GetEndpoints() []*AppliedPrivateServiceConnectEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ReportPrivateServiceConnectEndpointsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Endpoints     []*PrivateServiceConnectEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPrivateServiceConnectEndpointsResponse) Reset() {
+	*x = ReportPrivateServiceConnectEndpointsResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPrivateServiceConnectEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPrivateServiceConnectEndpointsResponse) ProtoMessage() {}
+
+func (x *ReportPrivateServiceConnectEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPrivateServiceConnectEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ReportPrivateServiceConnectEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReportPrivateServiceConnectEndpointsResponse) GetEndpoints() []*PrivateServiceConnectEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ReportSiteStaticEgressIpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`          // Site public ID
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // Reserved address in the site's terraform state, empty if none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteStaticEgressIpRequest) Reset() {
+	*x = ReportSiteStaticEgressIpRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteStaticEgressIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteStaticEgressIpRequest) ProtoMessage() {}
+
+func (x *ReportSiteStaticEgressIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteStaticEgressIpRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteStaticEgressIpRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReportSiteStaticEgressIpRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ReportSiteStaticEgressIpRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type ReportSiteStaticEgressIpResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The site's static egress IP after the report; unset once it's released
+	StaticEgressIp *common.StaticEgressIp `protobuf:"bytes,1,opt,name=static_egress_ip,json=staticEgressIp,proto3" json:"static_egress_ip,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportSiteStaticEgressIpResponse) Reset() {
+	*x = ReportSiteStaticEgressIpResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteStaticEgressIpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteStaticEgressIpResponse) ProtoMessage() {}
+
+func (x *ReportSiteStaticEgressIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteStaticEgressIpResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteStaticEgressIpResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReportSiteStaticEgressIpResponse) GetStaticEgressIp() *common.StaticEgressIp {
+	if x != nil {
+		return x.StaticEgressIp
+	}
+	return nil
+}
+
+type ReportSiteCdnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`          // Site public ID
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // Load balancer address in the site's terraform state, empty if none
+	PurgedIds     []string               `protobuf:"bytes,3,rep,name=purged_ids,json=purgedIds,proto3" json:"purged_ids,omitempty"` // Cache purges the apply ran
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteCdnRequest) Reset() {
+	*x = ReportSiteCdnRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteCdnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteCdnRequest) ProtoMessage() {}
+
+func (x *ReportSiteCdnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteCdnRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteCdnRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReportSiteCdnRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ReportSiteCdnRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ReportSiteCdnRequest) GetPurgedIds() []string {
+	if x != nil {
+		return x.PurgedIds
+	}
+	return nil
+}
+
+type ReportSiteCdnResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The site's CDN after the report; unset once it's disabled
+	Cdn           *common.SiteCdn `protobuf:"bytes,1,opt,name=cdn,proto3" json:"cdn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteCdnResponse) Reset() {
+	*x = ReportSiteCdnResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteCdnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteCdnResponse) ProtoMessage() {}
+
+func (x *ReportSiteCdnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteCdnResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteCdnResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReportSiteCdnResponse) GetCdn() *common.SiteCdn {
+	if x != nil {
+		return x.Cdn
+	}
+	return nil
+}
+
+type ReportSiteDatabaseInstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`          // Site public ID
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // Cloud SQL instance address in the site's terraform state, empty if none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteDatabaseInstanceRequest) Reset() {
+	*x = ReportSiteDatabaseInstanceRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteDatabaseInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteDatabaseInstanceRequest) ProtoMessage() {}
+
+func (x *ReportSiteDatabaseInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteDatabaseInstanceRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteDatabaseInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReportSiteDatabaseInstanceRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ReportSiteDatabaseInstanceRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type ReportSiteDatabaseInstanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*SiteDatabase        `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteDatabaseInstanceResponse) Reset() {
+	*x = ReportSiteDatabaseInstanceResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteDatabaseInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteDatabaseInstanceResponse) ProtoMessage() {}
+
+func (x *ReportSiteDatabaseInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteDatabaseInstanceResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteDatabaseInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ReportSiteDatabaseInstanceResponse) GetDatabases() []*SiteDatabase {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type ReportSiteTlsProbeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // What failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteTlsProbeRequest) Reset() {
+	*x = ReportSiteTlsProbeRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteTlsProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteTlsProbeRequest) ProtoMessage() {}
+
+func (x *ReportSiteTlsProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteTlsProbeRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteTlsProbeRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ReportSiteTlsProbeRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
 	return ""
 }
 
-type GetBlobRequest struct {
+func (x *ReportSiteTlsProbeRequest) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ReportSiteTlsProbeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReportSiteTlsProbeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`       // Site public ID
-	BlobType      string                 `protobuf:"bytes,2,opt,name=blob_type,json=blobType,proto3" json:"blob_type,omitempty"` // "ssh_keys", "secrets", "firewall"
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteTlsProbeResponse) Reset() {
+	*x = ReportSiteTlsProbeResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteTlsProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteTlsProbeResponse) ProtoMessage() {}
+
+func (x *ReportSiteTlsProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteTlsProbeResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteTlsProbeResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ReportSiteTlsProbeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSiteDatabasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteDatabasesRequest) Reset() {
+	*x = GetSiteDatabasesRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteDatabasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteDatabasesRequest) ProtoMessage() {}
+
+func (x *GetSiteDatabasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteDatabasesRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteDatabasesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetSiteDatabasesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+// ColocatedDatabase is a database the controller creates on the site VM's
+// MySQL server, with a user of the same name
+type ColocatedDatabase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColocatedDatabase) Reset() {
+	*x = ColocatedDatabase{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColocatedDatabase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColocatedDatabase) ProtoMessage() {}
+
+func (x *ColocatedDatabase) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColocatedDatabase.ProtoReflect.Descriptor instead.
+func (*ColocatedDatabase) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ColocatedDatabase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColocatedDatabase) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type GetSiteDatabasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*ColocatedDatabase   `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteDatabasesResponse) Reset() {
+	*x = GetSiteDatabasesResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteDatabasesResponse) ProtoMessage() {}
+
+func (x *GetSiteDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetSiteDatabasesResponse) GetDatabases() []*ColocatedDatabase {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type ReportedDatabase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // Why it couldn't be provisioned; empty once it is
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportedDatabase) Reset() {
+	*x = ReportedDatabase{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportedDatabase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportedDatabase) ProtoMessage() {}
+
+func (x *ReportedDatabase) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportedDatabase.ProtoReflect.Descriptor instead.
+func (*ReportedDatabase) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReportedDatabase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportedDatabase) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReportSiteDatabasesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	// Where the site's containers reach the MySQL server
+	Host          string              `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32               `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Databases     []*ReportedDatabase `protobuf:"bytes,4,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteDatabasesRequest) Reset() {
+	*x = ReportSiteDatabasesRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteDatabasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteDatabasesRequest) ProtoMessage() {}
+
+func (x *ReportSiteDatabasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteDatabasesRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteDatabasesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReportSiteDatabasesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ReportSiteDatabasesRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ReportSiteDatabasesRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ReportSiteDatabasesRequest) GetDatabases() []*ReportedDatabase {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type ReportSiteDatabasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*SiteDatabase        `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSiteDatabasesResponse) Reset() {
+	*x = ReportSiteDatabasesResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSiteDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSiteDatabasesResponse) ProtoMessage() {}
+
+func (x *ReportSiteDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSiteDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ReportSiteDatabasesResponse) GetDatabases() []*SiteDatabase {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type GetSiteAddonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlobRequest) Reset() {
-	*x = GetBlobRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[47]
+func (x *GetSiteAddonsRequest) Reset() {
+	*x = GetSiteAddonsRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlobRequest) String() string {
+func (x *GetSiteAddonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlobRequest) ProtoMessage() {}
+func (*GetSiteAddonsRequest) ProtoMessage() {}
 
-func (x *GetBlobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[47]
+func (x *GetSiteAddonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2527,48 +5250,42 @@ func (x *GetBlobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlobRequest.ProtoReflect.Descriptor instead.
-func (*GetBlobRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetSiteAddonsRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteAddonsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetBlobRequest) GetSiteId() string {
+func (x *GetSiteAddonsRequest) GetSiteId() string {
 	if x != nil {
 		return x.SiteId
 	}
 	return ""
 }
 
-func (x *GetBlobRequest) GetBlobType() string {
-	if x != nil {
-		return x.BlobType
-	}
-	return ""
-}
-
-type GetBlobResponse struct {
+// AddonSpec is an add-on the controller runs for the site
+type AddonSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // Blob content (JSON)
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // "application/json"
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`         // "solr", "memcached", "redis" or "matomo"
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"` // Empty for add-ons without credentials
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBlobResponse) Reset() {
-	*x = GetBlobResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[48]
+func (x *AddonSpec) Reset() {
+	*x = AddonSpec{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBlobResponse) String() string {
+func (x *AddonSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlobResponse) ProtoMessage() {}
+func (*AddonSpec) ProtoMessage() {}
 
-func (x *GetBlobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[48]
+func (x *AddonSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,47 +5296,47 @@ func (x *GetBlobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlobResponse.ProtoReflect.Descriptor instead.
-func (*GetBlobResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use AddonSpec.ProtoReflect.Descriptor instead.
+func (*AddonSpec) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetBlobResponse) GetData() []byte {
+func (x *AddonSpec) GetKind() string {
 	if x != nil {
-		return x.Data
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBlobResponse) GetContentType() string {
+func (x *AddonSpec) GetPassword() string {
 	if x != nil {
-		return x.ContentType
+		return x.Password
 	}
 	return ""
 }
 
-type GetReconciliationRunRequest struct {
+type GetSiteAddonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Addons        []*AddonSpec           `protobuf:"bytes,1,rep,name=addons,proto3" json:"addons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReconciliationRunRequest) Reset() {
-	*x = GetReconciliationRunRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[49]
+func (x *GetSiteAddonsResponse) Reset() {
+	*x = GetSiteAddonsResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReconciliationRunRequest) String() string {
+func (x *GetSiteAddonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReconciliationRunRequest) ProtoMessage() {}
+func (*GetSiteAddonsResponse) ProtoMessage() {}
 
-func (x *GetReconciliationRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[49]
+func (x *GetSiteAddonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,49 +5347,44 @@ func (x *GetReconciliationRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReconciliationRunRequest.ProtoReflect.Descriptor instead.
-func (*GetReconciliationRunRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetSiteAddonsResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteAddonsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetReconciliationRunRequest) GetRunId() string {
+func (x *GetSiteAddonsResponse) GetAddons() []*AddonSpec {
 	if x != nil {
-		return x.RunId
+		return x.Addons
 	}
-	return ""
+	return nil
 }
 
-type GetReconciliationRunResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RunId              string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	RunType            string                 `protobuf:"bytes,2,opt,name=run_type,json=runType,proto3" json:"run_type,omitempty"`                                        // terraform or reconciliation
-	ReconciliationType *string                `protobuf:"bytes,3,opt,name=reconciliation_type,json=reconciliationType,proto3,oneof" json:"reconciliation_type,omitempty"` // ssh_keys, secrets, firewall, general
-	Modules            []string               `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`                                                       // For terraform runs
-	TargetSiteIds      []int64                `protobuf:"varint,5,rep,packed,name=target_site_ids,json=targetSiteIds,proto3" json:"target_site_ids,omitempty"`            // For reconciliation runs
-	EventIds           []string               `protobuf:"bytes,6,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
-	OrganizationId     *int64                 `protobuf:"varint,7,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	ProjectId          *int64                 `protobuf:"varint,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	SiteId             *int64                 `protobuf:"varint,9,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
-	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ReportedAddon struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Where the site's containers reach it
+	Host          string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // Why it's unhealthy; empty when it's healthy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReconciliationRunResponse) Reset() {
-	*x = GetReconciliationRunResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[50]
+func (x *ReportedAddon) Reset() {
+	*x = ReportedAddon{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReconciliationRunResponse) String() string {
+func (x *ReportedAddon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReconciliationRunResponse) ProtoMessage() {}
+func (*ReportedAddon) ProtoMessage() {}
 
-func (x *GetReconciliationRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[50]
+func (x *ReportedAddon) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,105 +5395,62 @@ func (x *GetReconciliationRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReconciliationRunResponse.ProtoReflect.Descriptor instead.
-func (*GetReconciliationRunResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ReportedAddon.ProtoReflect.Descriptor instead.
+func (*ReportedAddon) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetReconciliationRunResponse) GetRunId() string {
+func (x *ReportedAddon) GetKind() string {
 	if x != nil {
-		return x.RunId
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *GetReconciliationRunResponse) GetRunType() string {
+func (x *ReportedAddon) GetHost() string {
 	if x != nil {
-		return x.RunType
-	}
-	return ""
-}
-
-func (x *GetReconciliationRunResponse) GetReconciliationType() string {
-	if x != nil && x.ReconciliationType != nil {
-		return *x.ReconciliationType
+		return x.Host
 	}
 	return ""
 }
 
-func (x *GetReconciliationRunResponse) GetModules() []string {
-	if x != nil {
-		return x.Modules
-	}
-	return nil
-}
-
-func (x *GetReconciliationRunResponse) GetTargetSiteIds() []int64 {
-	if x != nil {
-		return x.TargetSiteIds
-	}
-	return nil
-}
-
-func (x *GetReconciliationRunResponse) GetEventIds() []string {
+func (x *ReportedAddon) GetPort() int32 {
 	if x != nil {
-		return x.EventIds
-	}
-	return nil
-}
-
-func (x *GetReconciliationRunResponse) GetOrganizationId() int64 {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return 0
-}
-
-func (x *GetReconciliationRunResponse) GetProjectId() int64 {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
-	}
-	return 0
-}
-
-func (x *GetReconciliationRunResponse) GetSiteId() int64 {
-	if x != nil && x.SiteId != nil {
-		return *x.SiteId
+		return x.Port
 	}
 	return 0
 }
 
-func (x *GetReconciliationRunResponse) GetStatus() string {
+func (x *ReportedAddon) GetError() string {
 	if x != nil {
-		return x.Status
+		return x.Error
 	}
 	return ""
 }
 
-type UpdateReconciliationStatusRequest struct {
+type ReportSiteAddonsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending, triggered, running, completed, failed
-	ErrorMessage  *string                `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	Addons        []*ReportedAddon       `protobuf:"bytes,2,rep,name=addons,proto3" json:"addons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReconciliationStatusRequest) Reset() {
-	*x = UpdateReconciliationStatusRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[51]
+func (x *ReportSiteAddonsRequest) Reset() {
+	*x = ReportSiteAddonsRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReconciliationStatusRequest) String() string {
+func (x *ReportSiteAddonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReconciliationStatusRequest) ProtoMessage() {}
+func (*ReportSiteAddonsRequest) ProtoMessage() {}
 
-func (x *UpdateReconciliationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[51]
+func (x *ReportSiteAddonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2792,54 +5461,47 @@ func (x *UpdateReconciliationStatusRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReconciliationStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReconciliationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ReportSiteAddonsRequest.ProtoReflect.Descriptor instead.
+func (*ReportSiteAddonsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateReconciliationStatusRequest) GetRunId() string {
+func (x *ReportSiteAddonsRequest) GetSiteId() string {
 	if x != nil {
-		return x.RunId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *UpdateReconciliationStatusRequest) GetStatus() string {
+func (x *ReportSiteAddonsRequest) GetAddons() []*ReportedAddon {
 	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *UpdateReconciliationStatusRequest) GetErrorMessage() string {
-	if x != nil && x.ErrorMessage != nil {
-		return *x.ErrorMessage
+		return x.Addons
 	}
-	return ""
+	return nil
 }
 
-type UpdateReconciliationStatusResponse struct {
+type ReportSiteAddonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Addons        []*SiteAddon           `protobuf:"bytes,1,rep,name=addons,proto3" json:"addons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReconciliationStatusResponse) Reset() {
-	*x = UpdateReconciliationStatusResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[52]
+func (x *ReportSiteAddonsResponse) Reset() {
+	*x = ReportSiteAddonsResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReconciliationStatusResponse) String() string {
+func (x *ReportSiteAddonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReconciliationStatusResponse) ProtoMessage() {}
+func (*ReportSiteAddonsResponse) ProtoMessage() {}
 
-func (x *UpdateReconciliationStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[52]
+func (x *ReportSiteAddonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2850,42 +5512,40 @@ func (x *UpdateReconciliationStatusResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReconciliationStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReconciliationStatusResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ReportSiteAddonsResponse.ProtoReflect.Descriptor instead.
+func (*ReportSiteAddonsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *UpdateReconciliationStatusResponse) GetSuccess() bool {
+func (x *ReportSiteAddonsResponse) GetAddons() []*SiteAddon {
 	if x != nil {
-		return x.Success
+		return x.Addons
 	}
-	return false
+	return nil
 }
 
-type GenerateTerraformVarsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *int64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	ProjectId      *int64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	SiteId         *int64                 `protobuf:"varint,3,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetSiteConfigVarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTerraformVarsRequest) Reset() {
-	*x = GenerateTerraformVarsRequest{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[53]
+func (x *GetSiteConfigVarsRequest) Reset() {
+	*x = GetSiteConfigVarsRequest{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTerraformVarsRequest) String() string {
+func (x *GetSiteConfigVarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTerraformVarsRequest) ProtoMessage() {}
+func (*GetSiteConfigVarsRequest) ProtoMessage() {}
 
-func (x *GenerateTerraformVarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[53]
+func (x *GetSiteConfigVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,54 +5556,41 @@ func (x *GenerateTerraformVarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTerraformVarsRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTerraformVarsRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *GenerateTerraformVarsRequest) GetOrganizationId() int64 {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return 0
-}
-
-func (x *GenerateTerraformVarsRequest) GetProjectId() int64 {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
-	}
-	return 0
+// Deprecated: Use GetSiteConfigVarsRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteConfigVarsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GenerateTerraformVarsRequest) GetSiteId() int64 {
-	if x != nil && x.SiteId != nil {
-		return *x.SiteId
+func (x *GetSiteConfigVarsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
 	}
-	return 0
+	return ""
 }
 
-type GenerateTerraformVarsResponse struct {
+type GetSiteConfigVarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TfvarsJson    string                 `protobuf:"bytes,1,opt,name=tfvars_json,json=tfvarsJson,proto3" json:"tfvars_json,omitempty"` // JSON-encoded terraform variables
+	ConfigVars    []*Secret              `protobuf:"bytes,1,rep,name=config_vars,json=configVars,proto3" json:"config_vars,omitempty"` // Keyed by variable name, config vars overriding settings
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`                      // The site's latest config var revision
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTerraformVarsResponse) Reset() {
-	*x = GenerateTerraformVarsResponse{}
-	mi := &file_libops_v1_admin_api_proto_msgTypes[54]
+func (x *GetSiteConfigVarsResponse) Reset() {
+	*x = GetSiteConfigVarsResponse{}
+	mi := &file_libops_v1_admin_api_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTerraformVarsResponse) String() string {
+func (x *GetSiteConfigVarsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTerraformVarsResponse) ProtoMessage() {}
+func (*GetSiteConfigVarsResponse) ProtoMessage() {}
 
-func (x *GenerateTerraformVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_admin_api_proto_msgTypes[54]
+func (x *GetSiteConfigVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_api_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2954,23 +5601,30 @@ func (x *GenerateTerraformVarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTerraformVarsResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTerraformVarsResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetSiteConfigVarsResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteConfigVarsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_api_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GenerateTerraformVarsResponse) GetTfvarsJson() string {
+func (x *GetSiteConfigVarsResponse) GetConfigVars() []*Secret {
 	if x != nil {
-		return x.TfvarsJson
+		return x.ConfigVars
 	}
-	return ""
+	return nil
+}
+
+func (x *GetSiteConfigVarsResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
 }
 
 var File_libops_v1_admin_api_proto protoreflect.FileDescriptor
 
 const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\n" +
-	"\x19libops/v1/admin_api.proto\x12\tlibops.v1\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1dlibops/v1/admin/project.proto\x1a\"libops/v1/admin/organization.proto\x1a\x1alibops/v1/admin/site.proto\"`\n" +
+	"\x19libops/v1/admin_api.proto\x12\tlibops.v1\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1dlibops/v1/admin/project.proto\x1a\"libops/v1/admin/organization.proto\x1a\x1alibops/v1/admin/site.proto\x1a#libops/v1/common/organization.proto\x1a\x1blibops/v1/common/site.proto\x1a!libops/v1/access_protection.proto\x1a\x15libops/v1/addon.proto\x1a\x18libops/v1/database.proto\x1a libops/v1/organization_api.proto\x1a\x1flibops/v1/private_network.proto\x1a\x18libops/v1/redirect.proto\x1a\x13libops/v1/tls.proto\x1a\x13libops/v1/waf.proto\"`\n" +
 	"\x16AdminGetProjectRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
@@ -3048,7 +5702,23 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"%AdminListOrganizationProjectsResponse\x12\x1f\n" +
 	"\vproject_ids\x18\x01 \x03(\tR\n" +
 	"projectIds\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"z\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	" AdminSetOrganizationQuotaRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"R\n" +
+	"!AdminSetOrganizationQuotaResponse\x12-\n" +
+	"\x05quota\x18\x01 \x01(\v2\x17.libops.v1.common.QuotaR\x05quota\"j\n" +
+	"#AdminDeleteOrganizationQuotaRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\"E\n" +
+	"\x1aRotateEncryptionKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x8e\x01\n" +
+	"\x1bRotateEncryptionKeyResponse\x12\x1f\n" +
+	"\vkey_version\x18\x01 \x01(\x05R\n" +
+	"keyVersion\x12+\n" +
+	"\x11rewrapped_secrets\x18\x02 \x01(\x05R\x10rewrappedSecrets\x12!\n" +
+	"\ffailed_paths\x18\x03 \x03(\tR\vfailedPaths\"z\n" +
 	"\x13AdminGetSiteRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
@@ -3100,20 +5770,24 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\x05sites\x18\x01 \x03(\v2 .libops.v1.admin.AdminSiteConfigR\x05sites\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"0\n" +
 	"\x15GetSiteSSHKeysRequest\x12\x17\n" +
-	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\x86\x01\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\xc2\x01\n" +
 	"\x06SSHKey\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\x12'\n" +
-	"\x0fgithub_username\x18\x04 \x01(\tR\x0egithubUsername\"?\n" +
+	"\x0fgithub_username\x18\x04 \x01(\tR\x0egithubUsername\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x05 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tsftp_only\x18\x06 \x01(\bR\bsftpOnly\"?\n" +
 	"\x16GetSiteSSHKeysResponse\x12%\n" +
 	"\x04keys\x18\x01 \x03(\v2\x11.libops.v1.SSHKeyR\x04keys\"0\n" +
 	"\x15GetSiteSecretsRequest\x12\x17\n" +
-	"\asite_id\x18\x01 \x01(\tR\x06siteId\"0\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"N\n" +
 	"\x06Secret\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"E\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"E\n" +
 	"\x16GetSiteSecretsResponse\x12+\n" +
 	"\asecrets\x18\x01 \x03(\v2\x11.libops.v1.SecretR\asecrets\"1\n" +
 	"\x16GetSiteFirewallRequest\x12\x17\n" +
@@ -3124,12 +5798,103 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
 	"\x06action\x18\x04 \x01(\tR\x06action\"H\n" +
 	"\x17GetSiteFirewallResponse\x12-\n" +
-	"\x05rules\x18\x01 \x03(\v2\x17.libops.v1.FirewallRuleR\x05rules\"-\n" +
+	"\x05rules\x18\x01 \x03(\v2\x17.libops.v1.FirewallRuleR\x05rules\"\x98\x04\n" +
 	"\x12SiteCheckInRequest\x12\x17\n" +
-	"\asite_id\x18\x01 \x01(\tR\x06siteId\"I\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\fegress_bytes\x18\x02 \x01(\x03R\vegressBytes\x12&\n" +
+	"\x0fdisk_used_bytes\x18\x03 \x01(\x03R\rdiskUsedBytes\x122\n" +
+	"\n" +
+	"waf_blocks\x18\x04 \x03(\v2\x13.libops.v1.WafBlockR\twafBlocks\x12R\n" +
+	"\x15rate_limit_rejections\x18\x05 \x03(\v2\x1e.libops.v1.RateLimitRejectionsR\x13rateLimitRejections\x12-\n" +
+	"\x12controller_version\x18\x06 \x01(\tR\x11controllerVersion\x12\x1d\n" +
+	"\n" +
+	"os_version\x18\a \x01(\tR\tosVersion\x12,\n" +
+	"\x12os_reboot_required\x18\b \x01(\bR\x10osRebootRequired\x122\n" +
+	"\x12containers_running\x18\t \x01(\x05H\x00R\x11containersRunning\x88\x01\x01\x126\n" +
+	"\x14containers_unhealthy\x18\n" +
+	" \x01(\x05H\x01R\x13containersUnhealthy\x88\x01\x01B\x15\n" +
+	"\x13_containers_runningB\x17\n" +
+	"\x15_containers_unhealthy\"J\n" +
+	"\x13RateLimitRejections\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x03R\brejected\"\xb0\x02\n" +
 	"\x13SiteCheckInResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"x\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12H\n" +
+	"\x11controller_config\x18\x04 \x01(\v2\x1b.libops.v1.ControllerConfigR\x10controllerConfig\x12K\n" +
+	"\x12controller_release\x18\x05 \x01(\v2\x1c.libops.v1.ControllerReleaseR\x11controllerRelease\x12\x1d\n" +
+	"\n" +
+	"api_region\x18\x06 \x01(\tR\tapiRegion\x12\x17\n" +
+	"\aapi_url\x18\a \x01(\tR\x06apiUrl\"U\n" +
+	"!IssueSiteClientCertificateRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x17\n" +
+	"\acsr_pem\x18\x02 \x01(\tR\x06csrPem\"\xb9\x01\n" +
+	"\"IssueSiteClientCertificateResponse\x12'\n" +
+	"\x0fcertificate_pem\x18\x01 \x01(\tR\x0ecertificatePem\x12,\n" +
+	"\x12ca_certificate_pem\x18\x02 \x01(\tR\x10caCertificatePem\x12\x1b\n" +
+	"\tnot_after\x18\x03 \x01(\x03R\bnotAfter\x12\x1f\n" +
+	"\vrenew_after\x18\x04 \x01(\x03R\n" +
+	"renewAfter\"\xcc\x04\n" +
+	"\x10ControllerConfig\x12)\n" +
+	"\x0erate_limit_rps\x18\x01 \x01(\x05H\x00R\frateLimitRps\x88\x01\x01\x12-\n" +
+	"\x10rate_limit_burst\x18\x02 \x01(\x05H\x01R\x0erateLimitBurst\x88\x01\x01\x12A\n" +
+	"\x1areconcile_interval_minutes\x18\x03 \x01(\x05H\x02R\x18reconcileIntervalMinutes\x88\x01\x01\x12=\n" +
+	"\x18checkin_interval_seconds\x18\x04 \x01(\x05H\x03R\x16checkinIntervalSeconds\x88\x01\x01\x12R\n" +
+	"\rfeature_flags\x18\x05 \x03(\v2-.libops.v1.ControllerConfig.FeatureFlagsEntryR\ffeatureFlags\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x122\n" +
+	"\x12controller_version\x18\a \x01(\tH\x04R\x11controllerVersion\x88\x01\x01\x1a?\n" +
+	"\x11FeatureFlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01B\x11\n" +
+	"\x0f_rate_limit_rpsB\x13\n" +
+	"\x11_rate_limit_burstB\x1d\n" +
+	"\x1b_reconcile_interval_minutesB\x1b\n" +
+	"\x19_checkin_interval_secondsB\x15\n" +
+	"\x13_controller_version\"\xda\x01\n" +
+	"\x11ControllerRelease\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
+	"\fdownload_url\x18\x02 \x01(\tR\vdownloadUrl\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"site_count\x18\a \x01(\x03R\tsiteCount\"4\n" +
+	"\x19GetSiteProxyConfigRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\x98\x02\n" +
+	"\x1aGetSiteProxyConfigResponse\x121\n" +
+	"\tredirects\x18\x01 \x03(\v2\x13.libops.v1.RedirectR\tredirects\x122\n" +
+	"\x06access\x18\x02 \x01(\v2\x1a.libops.v1.SiteProxyAccessR\x06access\x12)\n" +
+	"\x03waf\x18\x03 \x01(\v2\x17.libops.v1.SiteProxyWafR\x03waf\x12=\n" +
+	"\vrate_limits\x18\x04 \x03(\v2\x1c.libops.v1.SiteRateLimitRuleR\n" +
+	"rateLimits\x12)\n" +
+	"\x03tls\x18\x05 \x01(\v2\x17.libops.v1.SiteProxyTlsR\x03tls\"\xe5\x01\n" +
+	"\x0fSiteProxyAccess\x12-\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x19.libops.v1.SiteAccessModeR\x04mode\x12.\n" +
+	"\x13basic_auth_username\x18\x02 \x01(\tR\x11basicAuthUsername\x127\n" +
+	"\x18basic_auth_password_hash\x18\x03 \x01(\tR\x15basicAuthPasswordHash\x12\x1f\n" +
+	"\vgate_secret\x18\x04 \x01(\tR\n" +
+	"gateSecret\x12\x19\n" +
+	"\bgate_url\x18\x05 \x01(\tR\agateUrl\"\xb4\x01\n" +
+	"\fSiteProxyWaf\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12&\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x12.libops.v1.WafModeR\x04mode\x12%\n" +
+	"\x0eparanoia_level\x18\x03 \x01(\x05R\rparanoiaLevel\x12;\n" +
+	"\n" +
+	"exclusions\x18\x04 \x03(\v2\x1b.libops.v1.WafRuleExclusionR\n" +
+	"exclusions\"\xe2\x02\n" +
+	"\fSiteProxyTls\x126\n" +
+	"\vmin_version\x18\x01 \x01(\x0e2\x15.libops.v1.TlsVersionR\n" +
+	"minVersion\x12/\n" +
+	"\x14hsts_max_age_seconds\x18\x02 \x01(\x05R\x11hstsMaxAgeSeconds\x126\n" +
+	"\x17hsts_include_subdomains\x18\x03 \x01(\bR\x15hstsIncludeSubdomains\x12!\n" +
+	"\fhsts_preload\x18\x04 \x01(\bR\vhstsPreload\x12#\n" +
+	"\rocsp_stapling\x18\x05 \x01(\bR\focspStapling\x12'\n" +
+	"\x0fcertificate_pem\x18\x06 \x01(\tR\x0ecertificatePem\x12&\n" +
+	"\x0fprivate_key_pem\x18\a \x01(\tR\rprivateKeyPem\x12\x18\n" +
+	"\adomains\x18\b \x03(\tR\adomains\"x\n" +
 	"\x13SyncManifestRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x121\n" +
 	"\x12current_state_hash\x18\x02 \x01(\tH\x00R\x10currentStateHash\x88\x01\x01B\x15\n" +
@@ -3152,7 +5917,7 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"4\n" +
 	"\x1bGetReconciliationRunRequest\x12\x15\n" +
-	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\xb4\x03\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\xf3\x03\n" +
 	"\x1cGetReconciliationRunResponse\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
 	"\brun_type\x18\x02 \x01(\tR\arunType\x124\n" +
@@ -3165,11 +5930,27 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"project_id\x18\b \x01(\x03H\x02R\tprojectId\x88\x01\x01\x12\x1c\n" +
 	"\asite_id\x18\t \x01(\x03H\x03R\x06siteId\x88\x01\x01\x12\x16\n" +
 	"\x06status\x18\n" +
-	" \x01(\tR\x06statusB\x16\n" +
+	" \x01(\tR\x06status\x12=\n" +
+	"\n" +
+	"workspaces\x18\v \x03(\v2\x1d.libops.v1.TerraformWorkspaceR\n" +
+	"workspacesB\x16\n" +
 	"\x14_reconciliation_typeB\x12\n" +
 	"\x10_organization_idB\r\n" +
 	"\v_project_idB\n" +
 	"\n" +
+	"\b_site_id\"\xa6\x02\n" +
+	"\x12TerraformWorkspace\x12!\n" +
+	"\fstate_prefix\x18\x01 \x01(\tR\vstatePrefix\x12,\n" +
+	"\x0forganization_id\x18\x02 \x01(\x03H\x00R\x0eorganizationId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\x03H\x01R\tprojectId\x88\x01\x01\x12\x1c\n" +
+	"\asite_id\x18\x04 \x01(\x03H\x02R\x06siteId\x88\x01\x01\x12\x1a\n" +
+	"\bisolated\x18\x05 \x01(\bR\bisolated\x12\x18\n" +
+	"\atargets\x18\x06 \x03(\tR\atargets\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddressB\x12\n" +
+	"\x10_organization_idB\r\n" +
+	"\v_project_idB\n" +
+	"\n" +
 	"\b_site_id\"\x8e\x01\n" +
 	"!UpdateReconciliationStatusRequest\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
@@ -3177,26 +5958,112 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\rerror_message\x18\x03 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
 	"\x0e_error_message\">\n" +
 	"\"UpdateReconciliationStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbd\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd9\x01\n" +
 	"\x1cGenerateTerraformVarsRequest\x12,\n" +
 	"\x0forganization_id\x18\x01 \x01(\x03H\x00R\x0eorganizationId\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\x03H\x01R\tprojectId\x88\x01\x01\x12\x1c\n" +
-	"\asite_id\x18\x03 \x01(\x03H\x02R\x06siteId\x88\x01\x01B\x12\n" +
+	"\asite_id\x18\x03 \x01(\x03H\x02R\x06siteId\x88\x01\x01\x12\x1a\n" +
+	"\bisolated\x18\x04 \x01(\bR\bisolatedB\x12\n" +
 	"\x10_organization_idB\r\n" +
 	"\v_project_idB\n" +
 	"\n" +
 	"\b_site_id\"@\n" +
 	"\x1dGenerateTerraformVarsResponse\x12\x1f\n" +
 	"\vtfvars_json\x18\x01 \x01(\tR\n" +
-	"tfvarsJson2\xb7\x06\n" +
+	"tfvarsJson\"\xaf\x01\n" +
+	"+ResolvePrivateServiceConnectEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12>\n" +
+	"\x06target\x18\x03 \x01(\x0e2&.libops.v1.PrivateServiceConnectTargetR\x06target\"t\n" +
+	",ResolvePrivateServiceConnectEndpointResponse\x12D\n" +
+	"\bendpoint\x18\x01 \x01(\v2(.libops.v1.PrivateServiceConnectEndpointR\bendpoint\"\xae\x01\n" +
+	"$AppliedPrivateServiceConnectEndpoint\x12>\n" +
+	"\x06target\x18\x01 \x01(\x0e2&.libops.v1.PrivateServiceConnectTargetR\x06target\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12'\n" +
+	"\x0fforwarding_rule\x18\x03 \x01(\tR\x0eforwardingRule\"\xa5\x01\n" +
+	"+ReportPrivateServiceConnectEndpointsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12M\n" +
+	"\tendpoints\x18\x02 \x03(\v2/.libops.v1.AppliedPrivateServiceConnectEndpointR\tendpoints\"v\n" +
+	",ReportPrivateServiceConnectEndpointsResponse\x12F\n" +
+	"\tendpoints\x18\x01 \x03(\v2(.libops.v1.PrivateServiceConnectEndpointR\tendpoints\"Y\n" +
+	"\x1fReportSiteStaticEgressIpRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\"n\n" +
+	" ReportSiteStaticEgressIpResponse\x12J\n" +
+	"\x10static_egress_ip\x18\x01 \x01(\v2 .libops.v1.common.StaticEgressIpR\x0estaticEgressIp\"m\n" +
+	"\x14ReportSiteCdnRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"purged_ids\x18\x03 \x03(\tR\tpurgedIds\"D\n" +
+	"\x15ReportSiteCdnResponse\x12+\n" +
+	"\x03cdn\x18\x01 \x01(\v2\x19.libops.v1.common.SiteCdnR\x03cdn\"[\n" +
+	"!ReportSiteDatabaseInstanceRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\"[\n" +
+	"\"ReportSiteDatabaseInstanceResponse\x125\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x17.libops.v1.SiteDatabaseR\tdatabases\"f\n" +
+	"\x19ReportSiteTlsProbeRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"6\n" +
+	"\x1aReportSiteTlsProbeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x17GetSiteDatabasesRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"C\n" +
+	"\x11ColocatedDatabase\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"V\n" +
+	"\x18GetSiteDatabasesResponse\x12:\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x1c.libops.v1.ColocatedDatabaseR\tdatabases\"<\n" +
+	"\x10ReportedDatabase\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x98\x01\n" +
+	"\x1aReportSiteDatabasesRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x129\n" +
+	"\tdatabases\x18\x04 \x03(\v2\x1b.libops.v1.ReportedDatabaseR\tdatabases\"T\n" +
+	"\x1bReportSiteDatabasesResponse\x125\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x17.libops.v1.SiteDatabaseR\tdatabases\"/\n" +
+	"\x14GetSiteAddonsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\";\n" +
+	"\tAddonSpec\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"E\n" +
+	"\x15GetSiteAddonsResponse\x12,\n" +
+	"\x06addons\x18\x01 \x03(\v2\x14.libops.v1.AddonSpecR\x06addons\"a\n" +
+	"\rReportedAddon\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"d\n" +
+	"\x17ReportSiteAddonsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x120\n" +
+	"\x06addons\x18\x02 \x03(\v2\x18.libops.v1.ReportedAddonR\x06addons\"H\n" +
+	"\x18ReportSiteAddonsResponse\x12,\n" +
+	"\x06addons\x18\x01 \x03(\v2\x14.libops.v1.SiteAddonR\x06addons\"3\n" +
+	"\x18GetSiteConfigVarsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"k\n" +
+	"\x19GetSiteConfigVarsResponse\x122\n" +
+	"\vconfig_vars\x18\x01 \x03(\v2\x11.libops.v1.SecretR\n" +
+	"configVars\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision2\xbc\t\n" +
 	"\x18AdminOrganizationService\x12}\n" +
 	"\x0fGetOrganization\x12&.libops.v1.AdminGetOrganizationRequest\x1a'.libops.v1.AdminGetOrganizationResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x83\x01\n" +
 	"\x12CreateOrganization\x12).libops.v1.AdminCreateOrganizationRequest\x1a*.libops.v1.AdminCreateOrganizationResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12\x83\x01\n" +
 	"\x12UpdateOrganization\x12).libops.v1.AdminUpdateOrganizationRequest\x1a*.libops.v1.AdminUpdateOrganizationResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12o\n" +
 	"\x12DeleteOrganization\x12).libops.v1.AdminDeleteOrganizationRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12\x83\x01\n" +
 	"\x11ListOrganizations\x12(.libops.v1.AdminListOrganizationsRequest\x1a).libops.v1.AdminListOrganizationsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x98\x01\n" +
-	"\x18ListOrganizationProjects\x12/.libops.v1.AdminListOrganizationProjectsRequest\x1a0.libops.v1.AdminListOrganizationProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x012\x9b\t\n" +
+	"\x18ListOrganizationProjects\x12/.libops.v1.AdminListOrganizationProjectsRequest\x1a0.libops.v1.AdminListOrganizationProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12\x89\x01\n" +
+	"\x14SetOrganizationQuota\x12+.libops.v1.AdminSetOrganizationQuotaRequest\x1a,.libops.v1.AdminSetOrganizationQuotaResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12y\n" +
+	"\x17DeleteOrganizationQuota\x12..libops.v1.AdminDeleteOrganizationQuotaRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12|\n" +
+	"\x13RotateEncryptionKey\x12%.libops.v1.RotateEncryptionKeyRequest\x1a&.libops.v1.RotateEncryptionKeyResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system2\xc2\x0f\n" +
 	"\x10AdminSiteService\x12k\n" +
 	"\tListSites\x12 .libops.v1.AdminListSitesRequest\x1a!.libops.v1.AdminListSitesResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12e\n" +
 	"\aGetSite\x12\x1e.libops.v1.AdminGetSiteRequest\x1a\x1f.libops.v1.AdminGetSiteResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12k\n" +
@@ -3209,8 +6076,16 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\fListAllSites\x12#.libops.v1.AdminListAllSitesRequest\x1a$.libops.v1.AdminListAllSitesResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12Z\n" +
 	"\x0eGetSiteSSHKeys\x12 .libops.v1.GetSiteSSHKeysRequest\x1a!.libops.v1.GetSiteSSHKeysResponse\"\x03\x90\x02\x01\x12Z\n" +
 	"\x0eGetSiteSecrets\x12 .libops.v1.GetSiteSecretsRequest\x1a!.libops.v1.GetSiteSecretsResponse\"\x03\x90\x02\x01\x12]\n" +
-	"\x0fGetSiteFirewall\x12!.libops.v1.GetSiteFirewallRequest\x1a\".libops.v1.GetSiteFirewallResponse\"\x03\x90\x02\x01\x12N\n" +
-	"\vSiteCheckIn\x12\x1d.libops.v1.SiteCheckInRequest\x1a\x1e.libops.v1.SiteCheckInResponse\"\x00\x12T\n" +
+	"\x0fGetSiteFirewall\x12!.libops.v1.GetSiteFirewallRequest\x1a\".libops.v1.GetSiteFirewallResponse\"\x03\x90\x02\x01\x12L\n" +
+	"\vSiteCheckIn\x12\x1d.libops.v1.SiteCheckInRequest\x1a\x1e.libops.v1.SiteCheckInResponse\x12y\n" +
+	"\x1aIssueSiteClientCertificate\x12,.libops.v1.IssueSiteClientCertificateRequest\x1a-.libops.v1.IssueSiteClientCertificateResponse\x12f\n" +
+	"\x12GetSiteProxyConfig\x12$.libops.v1.GetSiteProxyConfigRequest\x1a%.libops.v1.GetSiteProxyConfigResponse\"\x03\x90\x02\x01\x12a\n" +
+	"\x12ReportSiteTlsProbe\x12$.libops.v1.ReportSiteTlsProbeRequest\x1a%.libops.v1.ReportSiteTlsProbeResponse\x12`\n" +
+	"\x10GetSiteDatabases\x12\".libops.v1.GetSiteDatabasesRequest\x1a#.libops.v1.GetSiteDatabasesResponse\"\x03\x90\x02\x01\x12d\n" +
+	"\x13ReportSiteDatabases\x12%.libops.v1.ReportSiteDatabasesRequest\x1a&.libops.v1.ReportSiteDatabasesResponse\x12W\n" +
+	"\rGetSiteAddons\x12\x1f.libops.v1.GetSiteAddonsRequest\x1a .libops.v1.GetSiteAddonsResponse\"\x03\x90\x02\x01\x12[\n" +
+	"\x10ReportSiteAddons\x12\".libops.v1.ReportSiteAddonsRequest\x1a#.libops.v1.ReportSiteAddonsResponse\x12c\n" +
+	"\x11GetSiteConfigVars\x12#.libops.v1.GetSiteConfigVarsRequest\x1a$.libops.v1.GetSiteConfigVarsResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\fSyncManifest\x12\x1e.libops.v1.SyncManifestRequest\x1a\x1f.libops.v1.SyncManifestResponse\"\x03\x90\x02\x01\x12E\n" +
 	"\aGetBlob\x12\x19.libops.v1.GetBlobRequest\x1a\x1a.libops.v1.GetBlobResponse\"\x03\x90\x02\x012\xcd\x05\n" +
 	"\x13AdminProjectService\x12n\n" +
@@ -3220,11 +6095,16 @@ const file_libops_v1_admin_api_proto_rawDesc = "" +
 	"\rUpdateProject\x12$.libops.v1.AdminUpdateProjectRequest\x1a%.libops.v1.AdminUpdateProjectResponse\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12e\n" +
 	"\rDeleteProject\x12$.libops.v1.AdminDeleteProjectRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x12t\n" +
 	"\fListProjects\x12#.libops.v1.AdminListProjectsRequest\x1a$.libops.v1.AdminListProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x01\x12}\n" +
-	"\x0fListAllProjects\x12&.libops.v1.AdminListAllProjectsRequest\x1a'.libops.v1.AdminListAllProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x012\xf8\x02\n" +
+	"\x0fListAllProjects\x12&.libops.v1.AdminListAllProjectsRequest\x1a'.libops.v1.AdminListAllProjectsResponse\"\x19\x92\xb5\x18\x12\b\x01\x10\x03\"\fadmin:system\x90\x02\x012\xf3\a\n" +
 	"\x1aAdminReconciliationService\x12l\n" +
-	"\x14GetReconciliationRun\x12&.libops.v1.GetReconciliationRunRequest\x1a'.libops.v1.GetReconciliationRunResponse\"\x03\x90\x02\x01\x12{\n" +
-	"\x1aUpdateReconciliationStatus\x12,.libops.v1.UpdateReconciliationStatusRequest\x1a-.libops.v1.UpdateReconciliationStatusResponse\"\x00\x12o\n" +
-	"\x15GenerateTerraformVars\x12'.libops.v1.GenerateTerraformVarsRequest\x1a(.libops.v1.GenerateTerraformVarsResponse\"\x03\x90\x02\x01B\x93\x01\n" +
+	"\x14GetReconciliationRun\x12&.libops.v1.GetReconciliationRunRequest\x1a'.libops.v1.GetReconciliationRunResponse\"\x03\x90\x02\x01\x12y\n" +
+	"\x1aUpdateReconciliationStatus\x12,.libops.v1.UpdateReconciliationStatusRequest\x1a-.libops.v1.UpdateReconciliationStatusResponse\x12o\n" +
+	"\x15GenerateTerraformVars\x12'.libops.v1.GenerateTerraformVarsRequest\x1a(.libops.v1.GenerateTerraformVarsResponse\"\x03\x90\x02\x01\x12\x9c\x01\n" +
+	"$ResolvePrivateServiceConnectEndpoint\x126.libops.v1.ResolvePrivateServiceConnectEndpointRequest\x1a7.libops.v1.ResolvePrivateServiceConnectEndpointResponse\"\x03\x90\x02\x01\x12\x97\x01\n" +
+	"$ReportPrivateServiceConnectEndpoints\x126.libops.v1.ReportPrivateServiceConnectEndpointsRequest\x1a7.libops.v1.ReportPrivateServiceConnectEndpointsResponse\x12s\n" +
+	"\x18ReportSiteStaticEgressIp\x12*.libops.v1.ReportSiteStaticEgressIpRequest\x1a+.libops.v1.ReportSiteStaticEgressIpResponse\x12R\n" +
+	"\rReportSiteCdn\x12\x1f.libops.v1.ReportSiteCdnRequest\x1a .libops.v1.ReportSiteCdnResponse\x12y\n" +
+	"\x1aReportSiteDatabaseInstance\x12,.libops.v1.ReportSiteDatabaseInstanceRequest\x1a-.libops.v1.ReportSiteDatabaseInstanceResponseB\x93\x01\n" +
 	"\rcom.libops.v1B\rAdminApiProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -3240,156 +6120,277 @@ func file_libops_v1_admin_api_proto_rawDescGZIP() []byte {
 	return file_libops_v1_admin_api_proto_rawDescData
 }
 
-var file_libops_v1_admin_api_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_libops_v1_admin_api_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_libops_v1_admin_api_proto_goTypes = []any{
-	(*AdminGetProjectRequest)(nil),                // 0: libops.v1.AdminGetProjectRequest
-	(*AdminGetProjectResponse)(nil),               // 1: libops.v1.AdminGetProjectResponse
-	(*AdminCreateProjectRequest)(nil),             // 2: libops.v1.AdminCreateProjectRequest
-	(*AdminCreateProjectResponse)(nil),            // 3: libops.v1.AdminCreateProjectResponse
-	(*AdminUpdateProjectRequest)(nil),             // 4: libops.v1.AdminUpdateProjectRequest
-	(*AdminUpdateProjectResponse)(nil),            // 5: libops.v1.AdminUpdateProjectResponse
-	(*AdminDeleteProjectRequest)(nil),             // 6: libops.v1.AdminDeleteProjectRequest
-	(*AdminListProjectsRequest)(nil),              // 7: libops.v1.AdminListProjectsRequest
-	(*AdminListProjectsResponse)(nil),             // 8: libops.v1.AdminListProjectsResponse
-	(*AdminListAllProjectsRequest)(nil),           // 9: libops.v1.AdminListAllProjectsRequest
-	(*AdminListAllProjectsResponse)(nil),          // 10: libops.v1.AdminListAllProjectsResponse
-	(*AdminGetOrganizationRequest)(nil),           // 11: libops.v1.AdminGetOrganizationRequest
-	(*AdminGetOrganizationResponse)(nil),          // 12: libops.v1.AdminGetOrganizationResponse
-	(*AdminCreateOrganizationRequest)(nil),        // 13: libops.v1.AdminCreateOrganizationRequest
-	(*AdminCreateOrganizationResponse)(nil),       // 14: libops.v1.AdminCreateOrganizationResponse
-	(*AdminUpdateOrganizationRequest)(nil),        // 15: libops.v1.AdminUpdateOrganizationRequest
-	(*AdminUpdateOrganizationResponse)(nil),       // 16: libops.v1.AdminUpdateOrganizationResponse
-	(*AdminDeleteOrganizationRequest)(nil),        // 17: libops.v1.AdminDeleteOrganizationRequest
-	(*AdminListOrganizationsRequest)(nil),         // 18: libops.v1.AdminListOrganizationsRequest
-	(*AdminListOrganizationsResponse)(nil),        // 19: libops.v1.AdminListOrganizationsResponse
-	(*AdminListOrganizationProjectsRequest)(nil),  // 20: libops.v1.AdminListOrganizationProjectsRequest
-	(*AdminListOrganizationProjectsResponse)(nil), // 21: libops.v1.AdminListOrganizationProjectsResponse
-	(*AdminGetSiteRequest)(nil),                   // 22: libops.v1.AdminGetSiteRequest
-	(*AdminGetSiteResponse)(nil),                  // 23: libops.v1.AdminGetSiteResponse
-	(*AdminCreateSiteRequest)(nil),                // 24: libops.v1.AdminCreateSiteRequest
-	(*AdminCreateSiteResponse)(nil),               // 25: libops.v1.AdminCreateSiteResponse
-	(*AdminUpdateSiteRequest)(nil),                // 26: libops.v1.AdminUpdateSiteRequest
-	(*AdminUpdateSiteResponse)(nil),               // 27: libops.v1.AdminUpdateSiteResponse
-	(*AdminDeleteSiteRequest)(nil),                // 28: libops.v1.AdminDeleteSiteRequest
-	(*AdminListSitesRequest)(nil),                 // 29: libops.v1.AdminListSitesRequest
-	(*AdminListSitesResponse)(nil),                // 30: libops.v1.AdminListSitesResponse
-	(*AdminListAllSitesRequest)(nil),              // 31: libops.v1.AdminListAllSitesRequest
-	(*AdminListAllSitesResponse)(nil),             // 32: libops.v1.AdminListAllSitesResponse
-	(*GetSiteSSHKeysRequest)(nil),                 // 33: libops.v1.GetSiteSSHKeysRequest
-	(*SSHKey)(nil),                                // 34: libops.v1.SSHKey
-	(*GetSiteSSHKeysResponse)(nil),                // 35: libops.v1.GetSiteSSHKeysResponse
-	(*GetSiteSecretsRequest)(nil),                 // 36: libops.v1.GetSiteSecretsRequest
-	(*Secret)(nil),                                // 37: libops.v1.Secret
-	(*GetSiteSecretsResponse)(nil),                // 38: libops.v1.GetSiteSecretsResponse
-	(*GetSiteFirewallRequest)(nil),                // 39: libops.v1.GetSiteFirewallRequest
-	(*FirewallRule)(nil),                          // 40: libops.v1.FirewallRule
-	(*GetSiteFirewallResponse)(nil),               // 41: libops.v1.GetSiteFirewallResponse
-	(*SiteCheckInRequest)(nil),                    // 42: libops.v1.SiteCheckInRequest
-	(*SiteCheckInResponse)(nil),                   // 43: libops.v1.SiteCheckInResponse
-	(*SyncManifestRequest)(nil),                   // 44: libops.v1.SyncManifestRequest
-	(*SyncManifestResponse)(nil),                  // 45: libops.v1.SyncManifestResponse
-	(*StateBlobs)(nil),                            // 46: libops.v1.StateBlobs
-	(*GetBlobRequest)(nil),                        // 47: libops.v1.GetBlobRequest
-	(*GetBlobResponse)(nil),                       // 48: libops.v1.GetBlobResponse
-	(*GetReconciliationRunRequest)(nil),           // 49: libops.v1.GetReconciliationRunRequest
-	(*GetReconciliationRunResponse)(nil),          // 50: libops.v1.GetReconciliationRunResponse
-	(*UpdateReconciliationStatusRequest)(nil),     // 51: libops.v1.UpdateReconciliationStatusRequest
-	(*UpdateReconciliationStatusResponse)(nil),    // 52: libops.v1.UpdateReconciliationStatusResponse
-	(*GenerateTerraformVarsRequest)(nil),          // 53: libops.v1.GenerateTerraformVarsRequest
-	(*GenerateTerraformVarsResponse)(nil),         // 54: libops.v1.GenerateTerraformVarsResponse
-	(*admin.AdminProjectConfig)(nil),              // 55: libops.v1.admin.AdminProjectConfig
-	(*fieldmaskpb.FieldMask)(nil),                 // 56: google.protobuf.FieldMask
-	(*admin.AdminFolderConfig)(nil),               // 57: libops.v1.admin.AdminFolderConfig
-	(*admin.AdminSiteConfig)(nil),                 // 58: libops.v1.admin.AdminSiteConfig
-	(*emptypb.Empty)(nil),                         // 59: google.protobuf.Empty
+	(*AdminGetProjectRequest)(nil),                       // 0: libops.v1.AdminGetProjectRequest
+	(*AdminGetProjectResponse)(nil),                      // 1: libops.v1.AdminGetProjectResponse
+	(*AdminCreateProjectRequest)(nil),                    // 2: libops.v1.AdminCreateProjectRequest
+	(*AdminCreateProjectResponse)(nil),                   // 3: libops.v1.AdminCreateProjectResponse
+	(*AdminUpdateProjectRequest)(nil),                    // 4: libops.v1.AdminUpdateProjectRequest
+	(*AdminUpdateProjectResponse)(nil),                   // 5: libops.v1.AdminUpdateProjectResponse
+	(*AdminDeleteProjectRequest)(nil),                    // 6: libops.v1.AdminDeleteProjectRequest
+	(*AdminListProjectsRequest)(nil),                     // 7: libops.v1.AdminListProjectsRequest
+	(*AdminListProjectsResponse)(nil),                    // 8: libops.v1.AdminListProjectsResponse
+	(*AdminListAllProjectsRequest)(nil),                  // 9: libops.v1.AdminListAllProjectsRequest
+	(*AdminListAllProjectsResponse)(nil),                 // 10: libops.v1.AdminListAllProjectsResponse
+	(*AdminGetOrganizationRequest)(nil),                  // 11: libops.v1.AdminGetOrganizationRequest
+	(*AdminGetOrganizationResponse)(nil),                 // 12: libops.v1.AdminGetOrganizationResponse
+	(*AdminCreateOrganizationRequest)(nil),               // 13: libops.v1.AdminCreateOrganizationRequest
+	(*AdminCreateOrganizationResponse)(nil),              // 14: libops.v1.AdminCreateOrganizationResponse
+	(*AdminUpdateOrganizationRequest)(nil),               // 15: libops.v1.AdminUpdateOrganizationRequest
+	(*AdminUpdateOrganizationResponse)(nil),              // 16: libops.v1.AdminUpdateOrganizationResponse
+	(*AdminDeleteOrganizationRequest)(nil),               // 17: libops.v1.AdminDeleteOrganizationRequest
+	(*AdminListOrganizationsRequest)(nil),                // 18: libops.v1.AdminListOrganizationsRequest
+	(*AdminListOrganizationsResponse)(nil),               // 19: libops.v1.AdminListOrganizationsResponse
+	(*AdminListOrganizationProjectsRequest)(nil),         // 20: libops.v1.AdminListOrganizationProjectsRequest
+	(*AdminListOrganizationProjectsResponse)(nil),        // 21: libops.v1.AdminListOrganizationProjectsResponse
+	(*AdminSetOrganizationQuotaRequest)(nil),             // 22: libops.v1.AdminSetOrganizationQuotaRequest
+	(*AdminSetOrganizationQuotaResponse)(nil),            // 23: libops.v1.AdminSetOrganizationQuotaResponse
+	(*AdminDeleteOrganizationQuotaRequest)(nil),          // 24: libops.v1.AdminDeleteOrganizationQuotaRequest
+	(*RotateEncryptionKeyRequest)(nil),                   // 25: libops.v1.RotateEncryptionKeyRequest
+	(*RotateEncryptionKeyResponse)(nil),                  // 26: libops.v1.RotateEncryptionKeyResponse
+	(*AdminGetSiteRequest)(nil),                          // 27: libops.v1.AdminGetSiteRequest
+	(*AdminGetSiteResponse)(nil),                         // 28: libops.v1.AdminGetSiteResponse
+	(*AdminCreateSiteRequest)(nil),                       // 29: libops.v1.AdminCreateSiteRequest
+	(*AdminCreateSiteResponse)(nil),                      // 30: libops.v1.AdminCreateSiteResponse
+	(*AdminUpdateSiteRequest)(nil),                       // 31: libops.v1.AdminUpdateSiteRequest
+	(*AdminUpdateSiteResponse)(nil),                      // 32: libops.v1.AdminUpdateSiteResponse
+	(*AdminDeleteSiteRequest)(nil),                       // 33: libops.v1.AdminDeleteSiteRequest
+	(*AdminListSitesRequest)(nil),                        // 34: libops.v1.AdminListSitesRequest
+	(*AdminListSitesResponse)(nil),                       // 35: libops.v1.AdminListSitesResponse
+	(*AdminListAllSitesRequest)(nil),                     // 36: libops.v1.AdminListAllSitesRequest
+	(*AdminListAllSitesResponse)(nil),                    // 37: libops.v1.AdminListAllSitesResponse
+	(*GetSiteSSHKeysRequest)(nil),                        // 38: libops.v1.GetSiteSSHKeysRequest
+	(*SSHKey)(nil),                                       // 39: libops.v1.SSHKey
+	(*GetSiteSSHKeysResponse)(nil),                       // 40: libops.v1.GetSiteSSHKeysResponse
+	(*GetSiteSecretsRequest)(nil),                        // 41: libops.v1.GetSiteSecretsRequest
+	(*Secret)(nil),                                       // 42: libops.v1.Secret
+	(*GetSiteSecretsResponse)(nil),                       // 43: libops.v1.GetSiteSecretsResponse
+	(*GetSiteFirewallRequest)(nil),                       // 44: libops.v1.GetSiteFirewallRequest
+	(*FirewallRule)(nil),                                 // 45: libops.v1.FirewallRule
+	(*GetSiteFirewallResponse)(nil),                      // 46: libops.v1.GetSiteFirewallResponse
+	(*SiteCheckInRequest)(nil),                           // 47: libops.v1.SiteCheckInRequest
+	(*RateLimitRejections)(nil),                          // 48: libops.v1.RateLimitRejections
+	(*SiteCheckInResponse)(nil),                          // 49: libops.v1.SiteCheckInResponse
+	(*IssueSiteClientCertificateRequest)(nil),            // 50: libops.v1.IssueSiteClientCertificateRequest
+	(*IssueSiteClientCertificateResponse)(nil),           // 51: libops.v1.IssueSiteClientCertificateResponse
+	(*ControllerConfig)(nil),                             // 52: libops.v1.ControllerConfig
+	(*ControllerRelease)(nil),                            // 53: libops.v1.ControllerRelease
+	(*GetSiteProxyConfigRequest)(nil),                    // 54: libops.v1.GetSiteProxyConfigRequest
+	(*GetSiteProxyConfigResponse)(nil),                   // 55: libops.v1.GetSiteProxyConfigResponse
+	(*SiteProxyAccess)(nil),                              // 56: libops.v1.SiteProxyAccess
+	(*SiteProxyWaf)(nil),                                 // 57: libops.v1.SiteProxyWaf
+	(*SiteProxyTls)(nil),                                 // 58: libops.v1.SiteProxyTls
+	(*SyncManifestRequest)(nil),                          // 59: libops.v1.SyncManifestRequest
+	(*SyncManifestResponse)(nil),                         // 60: libops.v1.SyncManifestResponse
+	(*StateBlobs)(nil),                                   // 61: libops.v1.StateBlobs
+	(*GetBlobRequest)(nil),                               // 62: libops.v1.GetBlobRequest
+	(*GetBlobResponse)(nil),                              // 63: libops.v1.GetBlobResponse
+	(*GetReconciliationRunRequest)(nil),                  // 64: libops.v1.GetReconciliationRunRequest
+	(*GetReconciliationRunResponse)(nil),                 // 65: libops.v1.GetReconciliationRunResponse
+	(*TerraformWorkspace)(nil),                           // 66: libops.v1.TerraformWorkspace
+	(*UpdateReconciliationStatusRequest)(nil),            // 67: libops.v1.UpdateReconciliationStatusRequest
+	(*UpdateReconciliationStatusResponse)(nil),           // 68: libops.v1.UpdateReconciliationStatusResponse
+	(*GenerateTerraformVarsRequest)(nil),                 // 69: libops.v1.GenerateTerraformVarsRequest
+	(*GenerateTerraformVarsResponse)(nil),                // 70: libops.v1.GenerateTerraformVarsResponse
+	(*ResolvePrivateServiceConnectEndpointRequest)(nil),  // 71: libops.v1.ResolvePrivateServiceConnectEndpointRequest
+	(*ResolvePrivateServiceConnectEndpointResponse)(nil), // 72: libops.v1.ResolvePrivateServiceConnectEndpointResponse
+	(*AppliedPrivateServiceConnectEndpoint)(nil),         // 73: libops.v1.AppliedPrivateServiceConnectEndpoint
+	(*ReportPrivateServiceConnectEndpointsRequest)(nil),  // 74: libops.v1.ReportPrivateServiceConnectEndpointsRequest
+	(*ReportPrivateServiceConnectEndpointsResponse)(nil), // 75: libops.v1.ReportPrivateServiceConnectEndpointsResponse
+	(*ReportSiteStaticEgressIpRequest)(nil),              // 76: libops.v1.ReportSiteStaticEgressIpRequest
+	(*ReportSiteStaticEgressIpResponse)(nil),             // 77: libops.v1.ReportSiteStaticEgressIpResponse
+	(*ReportSiteCdnRequest)(nil),                         // 78: libops.v1.ReportSiteCdnRequest
+	(*ReportSiteCdnResponse)(nil),                        // 79: libops.v1.ReportSiteCdnResponse
+	(*ReportSiteDatabaseInstanceRequest)(nil),            // 80: libops.v1.ReportSiteDatabaseInstanceRequest
+	(*ReportSiteDatabaseInstanceResponse)(nil),           // 81: libops.v1.ReportSiteDatabaseInstanceResponse
+	(*ReportSiteTlsProbeRequest)(nil),                    // 82: libops.v1.ReportSiteTlsProbeRequest
+	(*ReportSiteTlsProbeResponse)(nil),                   // 83: libops.v1.ReportSiteTlsProbeResponse
+	(*GetSiteDatabasesRequest)(nil),                      // 84: libops.v1.GetSiteDatabasesRequest
+	(*ColocatedDatabase)(nil),                            // 85: libops.v1.ColocatedDatabase
+	(*GetSiteDatabasesResponse)(nil),                     // 86: libops.v1.GetSiteDatabasesResponse
+	(*ReportedDatabase)(nil),                             // 87: libops.v1.ReportedDatabase
+	(*ReportSiteDatabasesRequest)(nil),                   // 88: libops.v1.ReportSiteDatabasesRequest
+	(*ReportSiteDatabasesResponse)(nil),                  // 89: libops.v1.ReportSiteDatabasesResponse
+	(*GetSiteAddonsRequest)(nil),                         // 90: libops.v1.GetSiteAddonsRequest
+	(*AddonSpec)(nil),                                    // 91: libops.v1.AddonSpec
+	(*GetSiteAddonsResponse)(nil),                        // 92: libops.v1.GetSiteAddonsResponse
+	(*ReportedAddon)(nil),                                // 93: libops.v1.ReportedAddon
+	(*ReportSiteAddonsRequest)(nil),                      // 94: libops.v1.ReportSiteAddonsRequest
+	(*ReportSiteAddonsResponse)(nil),                     // 95: libops.v1.ReportSiteAddonsResponse
+	(*GetSiteConfigVarsRequest)(nil),                     // 96: libops.v1.GetSiteConfigVarsRequest
+	(*GetSiteConfigVarsResponse)(nil),                    // 97: libops.v1.GetSiteConfigVarsResponse
+	nil,                                                  // 98: libops.v1.ControllerConfig.FeatureFlagsEntry
+	(*admin.AdminProjectConfig)(nil),                     // 99: libops.v1.admin.AdminProjectConfig
+	(*fieldmaskpb.FieldMask)(nil),                        // 100: google.protobuf.FieldMask
+	(*admin.AdminFolderConfig)(nil),                      // 101: libops.v1.admin.AdminFolderConfig
+	(*common.Quota)(nil),                                 // 102: libops.v1.common.Quota
+	(*admin.AdminSiteConfig)(nil),                        // 103: libops.v1.admin.AdminSiteConfig
+	(*WafBlock)(nil),                                     // 104: libops.v1.WafBlock
+	(*Redirect)(nil),                                     // 105: libops.v1.Redirect
+	(*SiteRateLimitRule)(nil),                            // 106: libops.v1.SiteRateLimitRule
+	(SiteAccessMode)(0),                                  // 107: libops.v1.SiteAccessMode
+	(WafMode)(0),                                         // 108: libops.v1.WafMode
+	(*WafRuleExclusion)(nil),                             // 109: libops.v1.WafRuleExclusion
+	(TlsVersion)(0),                                      // 110: libops.v1.TlsVersion
+	(PrivateServiceConnectTarget)(0),                     // 111: libops.v1.PrivateServiceConnectTarget
+	(*PrivateServiceConnectEndpoint)(nil),                // 112: libops.v1.PrivateServiceConnectEndpoint
+	(*common.StaticEgressIp)(nil),                        // 113: libops.v1.common.StaticEgressIp
+	(*common.SiteCdn)(nil),                               // 114: libops.v1.common.SiteCdn
+	(*SiteDatabase)(nil),                                 // 115: libops.v1.SiteDatabase
+	(*SiteAddon)(nil),                                    // 116: libops.v1.SiteAddon
+	(*emptypb.Empty)(nil),                                // 117: google.protobuf.Empty
 }
 var file_libops_v1_admin_api_proto_depIdxs = []int32{
-	55, // 0: libops.v1.AdminGetProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
-	55, // 1: libops.v1.AdminCreateProjectRequest.project:type_name -> libops.v1.admin.AdminProjectConfig
-	55, // 2: libops.v1.AdminCreateProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
-	55, // 3: libops.v1.AdminUpdateProjectRequest.project:type_name -> libops.v1.admin.AdminProjectConfig
-	56, // 4: libops.v1.AdminUpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	55, // 5: libops.v1.AdminUpdateProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
-	55, // 6: libops.v1.AdminListProjectsResponse.projects:type_name -> libops.v1.admin.AdminProjectConfig
-	55, // 7: libops.v1.AdminListAllProjectsResponse.projects:type_name -> libops.v1.admin.AdminProjectConfig
-	57, // 8: libops.v1.AdminGetOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
-	57, // 9: libops.v1.AdminCreateOrganizationRequest.folder:type_name -> libops.v1.admin.AdminFolderConfig
-	57, // 10: libops.v1.AdminCreateOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
-	57, // 11: libops.v1.AdminUpdateOrganizationRequest.folder:type_name -> libops.v1.admin.AdminFolderConfig
-	56, // 12: libops.v1.AdminUpdateOrganizationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	57, // 13: libops.v1.AdminUpdateOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
-	57, // 14: libops.v1.AdminListOrganizationsResponse.organizations:type_name -> libops.v1.admin.AdminFolderConfig
-	58, // 15: libops.v1.AdminGetSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
-	58, // 16: libops.v1.AdminCreateSiteRequest.site:type_name -> libops.v1.admin.AdminSiteConfig
-	58, // 17: libops.v1.AdminCreateSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
-	58, // 18: libops.v1.AdminUpdateSiteRequest.site:type_name -> libops.v1.admin.AdminSiteConfig
-	56, // 19: libops.v1.AdminUpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
-	58, // 20: libops.v1.AdminUpdateSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
-	58, // 21: libops.v1.AdminListSitesResponse.sites:type_name -> libops.v1.admin.AdminSiteConfig
-	58, // 22: libops.v1.AdminListAllSitesResponse.sites:type_name -> libops.v1.admin.AdminSiteConfig
-	34, // 23: libops.v1.GetSiteSSHKeysResponse.keys:type_name -> libops.v1.SSHKey
-	37, // 24: libops.v1.GetSiteSecretsResponse.secrets:type_name -> libops.v1.Secret
-	40, // 25: libops.v1.GetSiteFirewallResponse.rules:type_name -> libops.v1.FirewallRule
-	46, // 26: libops.v1.SyncManifestResponse.blobs:type_name -> libops.v1.StateBlobs
-	11, // 27: libops.v1.AdminOrganizationService.GetOrganization:input_type -> libops.v1.AdminGetOrganizationRequest
-	13, // 28: libops.v1.AdminOrganizationService.CreateOrganization:input_type -> libops.v1.AdminCreateOrganizationRequest
-	15, // 29: libops.v1.AdminOrganizationService.UpdateOrganization:input_type -> libops.v1.AdminUpdateOrganizationRequest
-	17, // 30: libops.v1.AdminOrganizationService.DeleteOrganization:input_type -> libops.v1.AdminDeleteOrganizationRequest
-	18, // 31: libops.v1.AdminOrganizationService.ListOrganizations:input_type -> libops.v1.AdminListOrganizationsRequest
-	20, // 32: libops.v1.AdminOrganizationService.ListOrganizationProjects:input_type -> libops.v1.AdminListOrganizationProjectsRequest
-	29, // 33: libops.v1.AdminSiteService.ListSites:input_type -> libops.v1.AdminListSitesRequest
-	22, // 34: libops.v1.AdminSiteService.GetSite:input_type -> libops.v1.AdminGetSiteRequest
-	24, // 35: libops.v1.AdminSiteService.CreateSite:input_type -> libops.v1.AdminCreateSiteRequest
-	26, // 36: libops.v1.AdminSiteService.UpdateSite:input_type -> libops.v1.AdminUpdateSiteRequest
-	28, // 37: libops.v1.AdminSiteService.DeleteSite:input_type -> libops.v1.AdminDeleteSiteRequest
-	31, // 38: libops.v1.AdminSiteService.ListAllSites:input_type -> libops.v1.AdminListAllSitesRequest
-	33, // 39: libops.v1.AdminSiteService.GetSiteSSHKeys:input_type -> libops.v1.GetSiteSSHKeysRequest
-	36, // 40: libops.v1.AdminSiteService.GetSiteSecrets:input_type -> libops.v1.GetSiteSecretsRequest
-	39, // 41: libops.v1.AdminSiteService.GetSiteFirewall:input_type -> libops.v1.GetSiteFirewallRequest
-	42, // 42: libops.v1.AdminSiteService.SiteCheckIn:input_type -> libops.v1.SiteCheckInRequest
-	44, // 43: libops.v1.AdminSiteService.SyncManifest:input_type -> libops.v1.SyncManifestRequest
-	47, // 44: libops.v1.AdminSiteService.GetBlob:input_type -> libops.v1.GetBlobRequest
-	0,  // 45: libops.v1.AdminProjectService.GetProject:input_type -> libops.v1.AdminGetProjectRequest
-	2,  // 46: libops.v1.AdminProjectService.CreateProject:input_type -> libops.v1.AdminCreateProjectRequest
-	4,  // 47: libops.v1.AdminProjectService.UpdateProject:input_type -> libops.v1.AdminUpdateProjectRequest
-	6,  // 48: libops.v1.AdminProjectService.DeleteProject:input_type -> libops.v1.AdminDeleteProjectRequest
-	7,  // 49: libops.v1.AdminProjectService.ListProjects:input_type -> libops.v1.AdminListProjectsRequest
-	9,  // 50: libops.v1.AdminProjectService.ListAllProjects:input_type -> libops.v1.AdminListAllProjectsRequest
-	49, // 51: libops.v1.AdminReconciliationService.GetReconciliationRun:input_type -> libops.v1.GetReconciliationRunRequest
-	51, // 52: libops.v1.AdminReconciliationService.UpdateReconciliationStatus:input_type -> libops.v1.UpdateReconciliationStatusRequest
-	53, // 53: libops.v1.AdminReconciliationService.GenerateTerraformVars:input_type -> libops.v1.GenerateTerraformVarsRequest
-	12, // 54: libops.v1.AdminOrganizationService.GetOrganization:output_type -> libops.v1.AdminGetOrganizationResponse
-	14, // 55: libops.v1.AdminOrganizationService.CreateOrganization:output_type -> libops.v1.AdminCreateOrganizationResponse
-	16, // 56: libops.v1.AdminOrganizationService.UpdateOrganization:output_type -> libops.v1.AdminUpdateOrganizationResponse
-	59, // 57: libops.v1.AdminOrganizationService.DeleteOrganization:output_type -> google.protobuf.Empty
-	19, // 58: libops.v1.AdminOrganizationService.ListOrganizations:output_type -> libops.v1.AdminListOrganizationsResponse
-	21, // 59: libops.v1.AdminOrganizationService.ListOrganizationProjects:output_type -> libops.v1.AdminListOrganizationProjectsResponse
-	30, // 60: libops.v1.AdminSiteService.ListSites:output_type -> libops.v1.AdminListSitesResponse
-	23, // 61: libops.v1.AdminSiteService.GetSite:output_type -> libops.v1.AdminGetSiteResponse
-	25, // 62: libops.v1.AdminSiteService.CreateSite:output_type -> libops.v1.AdminCreateSiteResponse
-	27, // 63: libops.v1.AdminSiteService.UpdateSite:output_type -> libops.v1.AdminUpdateSiteResponse
-	59, // 64: libops.v1.AdminSiteService.DeleteSite:output_type -> google.protobuf.Empty
-	32, // 65: libops.v1.AdminSiteService.ListAllSites:output_type -> libops.v1.AdminListAllSitesResponse
-	35, // 66: libops.v1.AdminSiteService.GetSiteSSHKeys:output_type -> libops.v1.GetSiteSSHKeysResponse
-	38, // 67: libops.v1.AdminSiteService.GetSiteSecrets:output_type -> libops.v1.GetSiteSecretsResponse
-	41, // 68: libops.v1.AdminSiteService.GetSiteFirewall:output_type -> libops.v1.GetSiteFirewallResponse
-	43, // 69: libops.v1.AdminSiteService.SiteCheckIn:output_type -> libops.v1.SiteCheckInResponse
-	45, // 70: libops.v1.AdminSiteService.SyncManifest:output_type -> libops.v1.SyncManifestResponse
-	48, // 71: libops.v1.AdminSiteService.GetBlob:output_type -> libops.v1.GetBlobResponse
-	1,  // 72: libops.v1.AdminProjectService.GetProject:output_type -> libops.v1.AdminGetProjectResponse
-	3,  // 73: libops.v1.AdminProjectService.CreateProject:output_type -> libops.v1.AdminCreateProjectResponse
-	5,  // 74: libops.v1.AdminProjectService.UpdateProject:output_type -> libops.v1.AdminUpdateProjectResponse
-	59, // 75: libops.v1.AdminProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	8,  // 76: libops.v1.AdminProjectService.ListProjects:output_type -> libops.v1.AdminListProjectsResponse
-	10, // 77: libops.v1.AdminProjectService.ListAllProjects:output_type -> libops.v1.AdminListAllProjectsResponse
-	50, // 78: libops.v1.AdminReconciliationService.GetReconciliationRun:output_type -> libops.v1.GetReconciliationRunResponse
-	52, // 79: libops.v1.AdminReconciliationService.UpdateReconciliationStatus:output_type -> libops.v1.UpdateReconciliationStatusResponse
-	54, // 80: libops.v1.AdminReconciliationService.GenerateTerraformVars:output_type -> libops.v1.GenerateTerraformVarsResponse
-	54, // [54:81] is the sub-list for method output_type
-	27, // [27:54] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	99,  // 0: libops.v1.AdminGetProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
+	99,  // 1: libops.v1.AdminCreateProjectRequest.project:type_name -> libops.v1.admin.AdminProjectConfig
+	99,  // 2: libops.v1.AdminCreateProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
+	99,  // 3: libops.v1.AdminUpdateProjectRequest.project:type_name -> libops.v1.admin.AdminProjectConfig
+	100, // 4: libops.v1.AdminUpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	99,  // 5: libops.v1.AdminUpdateProjectResponse.project:type_name -> libops.v1.admin.AdminProjectConfig
+	99,  // 6: libops.v1.AdminListProjectsResponse.projects:type_name -> libops.v1.admin.AdminProjectConfig
+	99,  // 7: libops.v1.AdminListAllProjectsResponse.projects:type_name -> libops.v1.admin.AdminProjectConfig
+	101, // 8: libops.v1.AdminGetOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
+	101, // 9: libops.v1.AdminCreateOrganizationRequest.folder:type_name -> libops.v1.admin.AdminFolderConfig
+	101, // 10: libops.v1.AdminCreateOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
+	101, // 11: libops.v1.AdminUpdateOrganizationRequest.folder:type_name -> libops.v1.admin.AdminFolderConfig
+	100, // 12: libops.v1.AdminUpdateOrganizationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	101, // 13: libops.v1.AdminUpdateOrganizationResponse.folder:type_name -> libops.v1.admin.AdminFolderConfig
+	101, // 14: libops.v1.AdminListOrganizationsResponse.organizations:type_name -> libops.v1.admin.AdminFolderConfig
+	102, // 15: libops.v1.AdminSetOrganizationQuotaResponse.quota:type_name -> libops.v1.common.Quota
+	103, // 16: libops.v1.AdminGetSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
+	103, // 17: libops.v1.AdminCreateSiteRequest.site:type_name -> libops.v1.admin.AdminSiteConfig
+	103, // 18: libops.v1.AdminCreateSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
+	103, // 19: libops.v1.AdminUpdateSiteRequest.site:type_name -> libops.v1.admin.AdminSiteConfig
+	100, // 20: libops.v1.AdminUpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	103, // 21: libops.v1.AdminUpdateSiteResponse.site:type_name -> libops.v1.admin.AdminSiteConfig
+	103, // 22: libops.v1.AdminListSitesResponse.sites:type_name -> libops.v1.admin.AdminSiteConfig
+	103, // 23: libops.v1.AdminListAllSitesResponse.sites:type_name -> libops.v1.admin.AdminSiteConfig
+	39,  // 24: libops.v1.GetSiteSSHKeysResponse.keys:type_name -> libops.v1.SSHKey
+	42,  // 25: libops.v1.GetSiteSecretsResponse.secrets:type_name -> libops.v1.Secret
+	45,  // 26: libops.v1.GetSiteFirewallResponse.rules:type_name -> libops.v1.FirewallRule
+	104, // 27: libops.v1.SiteCheckInRequest.waf_blocks:type_name -> libops.v1.WafBlock
+	48,  // 28: libops.v1.SiteCheckInRequest.rate_limit_rejections:type_name -> libops.v1.RateLimitRejections
+	52,  // 29: libops.v1.SiteCheckInResponse.controller_config:type_name -> libops.v1.ControllerConfig
+	53,  // 30: libops.v1.SiteCheckInResponse.controller_release:type_name -> libops.v1.ControllerRelease
+	98,  // 31: libops.v1.ControllerConfig.feature_flags:type_name -> libops.v1.ControllerConfig.FeatureFlagsEntry
+	105, // 32: libops.v1.GetSiteProxyConfigResponse.redirects:type_name -> libops.v1.Redirect
+	56,  // 33: libops.v1.GetSiteProxyConfigResponse.access:type_name -> libops.v1.SiteProxyAccess
+	57,  // 34: libops.v1.GetSiteProxyConfigResponse.waf:type_name -> libops.v1.SiteProxyWaf
+	106, // 35: libops.v1.GetSiteProxyConfigResponse.rate_limits:type_name -> libops.v1.SiteRateLimitRule
+	58,  // 36: libops.v1.GetSiteProxyConfigResponse.tls:type_name -> libops.v1.SiteProxyTls
+	107, // 37: libops.v1.SiteProxyAccess.mode:type_name -> libops.v1.SiteAccessMode
+	108, // 38: libops.v1.SiteProxyWaf.mode:type_name -> libops.v1.WafMode
+	109, // 39: libops.v1.SiteProxyWaf.exclusions:type_name -> libops.v1.WafRuleExclusion
+	110, // 40: libops.v1.SiteProxyTls.min_version:type_name -> libops.v1.TlsVersion
+	61,  // 41: libops.v1.SyncManifestResponse.blobs:type_name -> libops.v1.StateBlobs
+	66,  // 42: libops.v1.GetReconciliationRunResponse.workspaces:type_name -> libops.v1.TerraformWorkspace
+	111, // 43: libops.v1.ResolvePrivateServiceConnectEndpointRequest.target:type_name -> libops.v1.PrivateServiceConnectTarget
+	112, // 44: libops.v1.ResolvePrivateServiceConnectEndpointResponse.endpoint:type_name -> libops.v1.PrivateServiceConnectEndpoint
+	111, // 45: libops.v1.AppliedPrivateServiceConnectEndpoint.target:type_name -> libops.v1.PrivateServiceConnectTarget
+	73,  // 46: libops.v1.ReportPrivateServiceConnectEndpointsRequest.endpoints:type_name -> libops.v1.AppliedPrivateServiceConnectEndpoint
+	112, // 47: libops.v1.ReportPrivateServiceConnectEndpointsResponse.endpoints:type_name -> libops.v1.PrivateServiceConnectEndpoint
+	113, // 48: libops.v1.ReportSiteStaticEgressIpResponse.static_egress_ip:type_name -> libops.v1.common.StaticEgressIp
+	114, // 49: libops.v1.ReportSiteCdnResponse.cdn:type_name -> libops.v1.common.SiteCdn
+	115, // 50: libops.v1.ReportSiteDatabaseInstanceResponse.databases:type_name -> libops.v1.SiteDatabase
+	85,  // 51: libops.v1.GetSiteDatabasesResponse.databases:type_name -> libops.v1.ColocatedDatabase
+	87,  // 52: libops.v1.ReportSiteDatabasesRequest.databases:type_name -> libops.v1.ReportedDatabase
+	115, // 53: libops.v1.ReportSiteDatabasesResponse.databases:type_name -> libops.v1.SiteDatabase
+	91,  // 54: libops.v1.GetSiteAddonsResponse.addons:type_name -> libops.v1.AddonSpec
+	93,  // 55: libops.v1.ReportSiteAddonsRequest.addons:type_name -> libops.v1.ReportedAddon
+	116, // 56: libops.v1.ReportSiteAddonsResponse.addons:type_name -> libops.v1.SiteAddon
+	42,  // 57: libops.v1.GetSiteConfigVarsResponse.config_vars:type_name -> libops.v1.Secret
+	11,  // 58: libops.v1.AdminOrganizationService.GetOrganization:input_type -> libops.v1.AdminGetOrganizationRequest
+	13,  // 59: libops.v1.AdminOrganizationService.CreateOrganization:input_type -> libops.v1.AdminCreateOrganizationRequest
+	15,  // 60: libops.v1.AdminOrganizationService.UpdateOrganization:input_type -> libops.v1.AdminUpdateOrganizationRequest
+	17,  // 61: libops.v1.AdminOrganizationService.DeleteOrganization:input_type -> libops.v1.AdminDeleteOrganizationRequest
+	18,  // 62: libops.v1.AdminOrganizationService.ListOrganizations:input_type -> libops.v1.AdminListOrganizationsRequest
+	20,  // 63: libops.v1.AdminOrganizationService.ListOrganizationProjects:input_type -> libops.v1.AdminListOrganizationProjectsRequest
+	22,  // 64: libops.v1.AdminOrganizationService.SetOrganizationQuota:input_type -> libops.v1.AdminSetOrganizationQuotaRequest
+	24,  // 65: libops.v1.AdminOrganizationService.DeleteOrganizationQuota:input_type -> libops.v1.AdminDeleteOrganizationQuotaRequest
+	25,  // 66: libops.v1.AdminOrganizationService.RotateEncryptionKey:input_type -> libops.v1.RotateEncryptionKeyRequest
+	34,  // 67: libops.v1.AdminSiteService.ListSites:input_type -> libops.v1.AdminListSitesRequest
+	27,  // 68: libops.v1.AdminSiteService.GetSite:input_type -> libops.v1.AdminGetSiteRequest
+	29,  // 69: libops.v1.AdminSiteService.CreateSite:input_type -> libops.v1.AdminCreateSiteRequest
+	31,  // 70: libops.v1.AdminSiteService.UpdateSite:input_type -> libops.v1.AdminUpdateSiteRequest
+	33,  // 71: libops.v1.AdminSiteService.DeleteSite:input_type -> libops.v1.AdminDeleteSiteRequest
+	36,  // 72: libops.v1.AdminSiteService.ListAllSites:input_type -> libops.v1.AdminListAllSitesRequest
+	38,  // 73: libops.v1.AdminSiteService.GetSiteSSHKeys:input_type -> libops.v1.GetSiteSSHKeysRequest
+	41,  // 74: libops.v1.AdminSiteService.GetSiteSecrets:input_type -> libops.v1.GetSiteSecretsRequest
+	44,  // 75: libops.v1.AdminSiteService.GetSiteFirewall:input_type -> libops.v1.GetSiteFirewallRequest
+	47,  // 76: libops.v1.AdminSiteService.SiteCheckIn:input_type -> libops.v1.SiteCheckInRequest
+	50,  // 77: libops.v1.AdminSiteService.IssueSiteClientCertificate:input_type -> libops.v1.IssueSiteClientCertificateRequest
+	54,  // 78: libops.v1.AdminSiteService.GetSiteProxyConfig:input_type -> libops.v1.GetSiteProxyConfigRequest
+	82,  // 79: libops.v1.AdminSiteService.ReportSiteTlsProbe:input_type -> libops.v1.ReportSiteTlsProbeRequest
+	84,  // 80: libops.v1.AdminSiteService.GetSiteDatabases:input_type -> libops.v1.GetSiteDatabasesRequest
+	88,  // 81: libops.v1.AdminSiteService.ReportSiteDatabases:input_type -> libops.v1.ReportSiteDatabasesRequest
+	90,  // 82: libops.v1.AdminSiteService.GetSiteAddons:input_type -> libops.v1.GetSiteAddonsRequest
+	94,  // 83: libops.v1.AdminSiteService.ReportSiteAddons:input_type -> libops.v1.ReportSiteAddonsRequest
+	96,  // 84: libops.v1.AdminSiteService.GetSiteConfigVars:input_type -> libops.v1.GetSiteConfigVarsRequest
+	59,  // 85: libops.v1.AdminSiteService.SyncManifest:input_type -> libops.v1.SyncManifestRequest
+	62,  // 86: libops.v1.AdminSiteService.GetBlob:input_type -> libops.v1.GetBlobRequest
+	0,   // 87: libops.v1.AdminProjectService.GetProject:input_type -> libops.v1.AdminGetProjectRequest
+	2,   // 88: libops.v1.AdminProjectService.CreateProject:input_type -> libops.v1.AdminCreateProjectRequest
+	4,   // 89: libops.v1.AdminProjectService.UpdateProject:input_type -> libops.v1.AdminUpdateProjectRequest
+	6,   // 90: libops.v1.AdminProjectService.DeleteProject:input_type -> libops.v1.AdminDeleteProjectRequest
+	7,   // 91: libops.v1.AdminProjectService.ListProjects:input_type -> libops.v1.AdminListProjectsRequest
+	9,   // 92: libops.v1.AdminProjectService.ListAllProjects:input_type -> libops.v1.AdminListAllProjectsRequest
+	64,  // 93: libops.v1.AdminReconciliationService.GetReconciliationRun:input_type -> libops.v1.GetReconciliationRunRequest
+	67,  // 94: libops.v1.AdminReconciliationService.UpdateReconciliationStatus:input_type -> libops.v1.UpdateReconciliationStatusRequest
+	69,  // 95: libops.v1.AdminReconciliationService.GenerateTerraformVars:input_type -> libops.v1.GenerateTerraformVarsRequest
+	71,  // 96: libops.v1.AdminReconciliationService.ResolvePrivateServiceConnectEndpoint:input_type -> libops.v1.ResolvePrivateServiceConnectEndpointRequest
+	74,  // 97: libops.v1.AdminReconciliationService.ReportPrivateServiceConnectEndpoints:input_type -> libops.v1.ReportPrivateServiceConnectEndpointsRequest
+	76,  // 98: libops.v1.AdminReconciliationService.ReportSiteStaticEgressIp:input_type -> libops.v1.ReportSiteStaticEgressIpRequest
+	78,  // 99: libops.v1.AdminReconciliationService.ReportSiteCdn:input_type -> libops.v1.ReportSiteCdnRequest
+	80,  // 100: libops.v1.AdminReconciliationService.ReportSiteDatabaseInstance:input_type -> libops.v1.ReportSiteDatabaseInstanceRequest
+	12,  // 101: libops.v1.AdminOrganizationService.GetOrganization:output_type -> libops.v1.AdminGetOrganizationResponse
+	14,  // 102: libops.v1.AdminOrganizationService.CreateOrganization:output_type -> libops.v1.AdminCreateOrganizationResponse
+	16,  // 103: libops.v1.AdminOrganizationService.UpdateOrganization:output_type -> libops.v1.AdminUpdateOrganizationResponse
+	117, // 104: libops.v1.AdminOrganizationService.DeleteOrganization:output_type -> google.protobuf.Empty
+	19,  // 105: libops.v1.AdminOrganizationService.ListOrganizations:output_type -> libops.v1.AdminListOrganizationsResponse
+	21,  // 106: libops.v1.AdminOrganizationService.ListOrganizationProjects:output_type -> libops.v1.AdminListOrganizationProjectsResponse
+	23,  // 107: libops.v1.AdminOrganizationService.SetOrganizationQuota:output_type -> libops.v1.AdminSetOrganizationQuotaResponse
+	117, // 108: libops.v1.AdminOrganizationService.DeleteOrganizationQuota:output_type -> google.protobuf.Empty
+	26,  // 109: libops.v1.AdminOrganizationService.RotateEncryptionKey:output_type -> libops.v1.RotateEncryptionKeyResponse
+	35,  // 110: libops.v1.AdminSiteService.ListSites:output_type -> libops.v1.AdminListSitesResponse
+	28,  // 111: libops.v1.AdminSiteService.GetSite:output_type -> libops.v1.AdminGetSiteResponse
+	30,  // 112: libops.v1.AdminSiteService.CreateSite:output_type -> libops.v1.AdminCreateSiteResponse
+	32,  // 113: libops.v1.AdminSiteService.UpdateSite:output_type -> libops.v1.AdminUpdateSiteResponse
+	117, // 114: libops.v1.AdminSiteService.DeleteSite:output_type -> google.protobuf.Empty
+	37,  // 115: libops.v1.AdminSiteService.ListAllSites:output_type -> libops.v1.AdminListAllSitesResponse
+	40,  // 116: libops.v1.AdminSiteService.GetSiteSSHKeys:output_type -> libops.v1.GetSiteSSHKeysResponse
+	43,  // 117: libops.v1.AdminSiteService.GetSiteSecrets:output_type -> libops.v1.GetSiteSecretsResponse
+	46,  // 118: libops.v1.AdminSiteService.GetSiteFirewall:output_type -> libops.v1.GetSiteFirewallResponse
+	49,  // 119: libops.v1.AdminSiteService.SiteCheckIn:output_type -> libops.v1.SiteCheckInResponse
+	51,  // 120: libops.v1.AdminSiteService.IssueSiteClientCertificate:output_type -> libops.v1.IssueSiteClientCertificateResponse
+	55,  // 121: libops.v1.AdminSiteService.GetSiteProxyConfig:output_type -> libops.v1.GetSiteProxyConfigResponse
+	83,  // 122: libops.v1.AdminSiteService.ReportSiteTlsProbe:output_type -> libops.v1.ReportSiteTlsProbeResponse
+	86,  // 123: libops.v1.AdminSiteService.GetSiteDatabases:output_type -> libops.v1.GetSiteDatabasesResponse
+	89,  // 124: libops.v1.AdminSiteService.ReportSiteDatabases:output_type -> libops.v1.ReportSiteDatabasesResponse
+	92,  // 125: libops.v1.AdminSiteService.GetSiteAddons:output_type -> libops.v1.GetSiteAddonsResponse
+	95,  // 126: libops.v1.AdminSiteService.ReportSiteAddons:output_type -> libops.v1.ReportSiteAddonsResponse
+	97,  // 127: libops.v1.AdminSiteService.GetSiteConfigVars:output_type -> libops.v1.GetSiteConfigVarsResponse
+	60,  // 128: libops.v1.AdminSiteService.SyncManifest:output_type -> libops.v1.SyncManifestResponse
+	63,  // 129: libops.v1.AdminSiteService.GetBlob:output_type -> libops.v1.GetBlobResponse
+	1,   // 130: libops.v1.AdminProjectService.GetProject:output_type -> libops.v1.AdminGetProjectResponse
+	3,   // 131: libops.v1.AdminProjectService.CreateProject:output_type -> libops.v1.AdminCreateProjectResponse
+	5,   // 132: libops.v1.AdminProjectService.UpdateProject:output_type -> libops.v1.AdminUpdateProjectResponse
+	117, // 133: libops.v1.AdminProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	8,   // 134: libops.v1.AdminProjectService.ListProjects:output_type -> libops.v1.AdminListProjectsResponse
+	10,  // 135: libops.v1.AdminProjectService.ListAllProjects:output_type -> libops.v1.AdminListAllProjectsResponse
+	65,  // 136: libops.v1.AdminReconciliationService.GetReconciliationRun:output_type -> libops.v1.GetReconciliationRunResponse
+	68,  // 137: libops.v1.AdminReconciliationService.UpdateReconciliationStatus:output_type -> libops.v1.UpdateReconciliationStatusResponse
+	70,  // 138: libops.v1.AdminReconciliationService.GenerateTerraformVars:output_type -> libops.v1.GenerateTerraformVarsResponse
+	72,  // 139: libops.v1.AdminReconciliationService.ResolvePrivateServiceConnectEndpoint:output_type -> libops.v1.ResolvePrivateServiceConnectEndpointResponse
+	75,  // 140: libops.v1.AdminReconciliationService.ReportPrivateServiceConnectEndpoints:output_type -> libops.v1.ReportPrivateServiceConnectEndpointsResponse
+	77,  // 141: libops.v1.AdminReconciliationService.ReportSiteStaticEgressIp:output_type -> libops.v1.ReportSiteStaticEgressIpResponse
+	79,  // 142: libops.v1.AdminReconciliationService.ReportSiteCdn:output_type -> libops.v1.ReportSiteCdnResponse
+	81,  // 143: libops.v1.AdminReconciliationService.ReportSiteDatabaseInstance:output_type -> libops.v1.ReportSiteDatabaseInstanceResponse
+	101, // [101:144] is the sub-list for method output_type
+	58,  // [58:101] is the sub-list for method input_type
+	58,  // [58:58] is the sub-list for extension type_name
+	58,  // [58:58] is the sub-list for extension extendee
+	0,   // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_admin_api_proto_init() }
@@ -3397,22 +6398,33 @@ func file_libops_v1_admin_api_proto_init() {
 	if File_libops_v1_admin_api_proto != nil {
 		return
 	}
+	file_libops_v1_access_protection_proto_init()
+	file_libops_v1_addon_proto_init()
+	file_libops_v1_database_proto_init()
+	file_libops_v1_organization_api_proto_init()
+	file_libops_v1_private_network_proto_init()
+	file_libops_v1_redirect_proto_init()
+	file_libops_v1_tls_proto_init()
+	file_libops_v1_waf_proto_init()
 	file_libops_v1_admin_api_proto_msgTypes[7].OneofWrappers = []any{}
 	file_libops_v1_admin_api_proto_msgTypes[9].OneofWrappers = []any{}
 	file_libops_v1_admin_api_proto_msgTypes[18].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[29].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[31].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[44].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[50].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[51].OneofWrappers = []any{}
-	file_libops_v1_admin_api_proto_msgTypes[53].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[34].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[36].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[47].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[52].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[59].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[65].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[66].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[67].OneofWrappers = []any{}
+	file_libops_v1_admin_api_proto_msgTypes[69].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_admin_api_proto_rawDesc), len(file_libops_v1_admin_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   55,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -0,0 +1,3932 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/admin_console.proto
+
+package libopsv1
+
+import (
+	common "github.com/libops/api/proto/libops/v1/common"
+	options "github.com/libops/api/proto/libops/v1/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TerraformStateLayout is how an organization's Terraform state is split
+type TerraformStateLayout int32
+
+const (
+	TerraformStateLayout_TERRAFORM_STATE_LAYOUT_UNSPECIFIED TerraformStateLayout = 0
+	TerraformStateLayout_TERRAFORM_STATE_LAYOUT_MONOLITHIC  TerraformStateLayout = 1 // One state; runs target modules in it
+	TerraformStateLayout_TERRAFORM_STATE_LAYOUT_MIGRATING   TerraformStateLayout = 2 // A run is moving projects and sites into workspaces
+	TerraformStateLayout_TERRAFORM_STATE_LAYOUT_ISOLATED    TerraformStateLayout = 3 // A state per project and site
+)
+
+// Enum value maps for TerraformStateLayout.
+var (
+	TerraformStateLayout_name = map[int32]string{
+		0: "TERRAFORM_STATE_LAYOUT_UNSPECIFIED",
+		1: "TERRAFORM_STATE_LAYOUT_MONOLITHIC",
+		2: "TERRAFORM_STATE_LAYOUT_MIGRATING",
+		3: "TERRAFORM_STATE_LAYOUT_ISOLATED",
+	}
+	TerraformStateLayout_value = map[string]int32{
+		"TERRAFORM_STATE_LAYOUT_UNSPECIFIED": 0,
+		"TERRAFORM_STATE_LAYOUT_MONOLITHIC":  1,
+		"TERRAFORM_STATE_LAYOUT_MIGRATING":   2,
+		"TERRAFORM_STATE_LAYOUT_ISOLATED":    3,
+	}
+)
+
+func (x TerraformStateLayout) Enum() *TerraformStateLayout {
+	p := new(TerraformStateLayout)
+	*p = x
+	return p
+}
+
+func (x TerraformStateLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TerraformStateLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_admin_console_proto_enumTypes[0].Descriptor()
+}
+
+func (TerraformStateLayout) Type() protoreflect.EnumType {
+	return &file_libops_v1_admin_console_proto_enumTypes[0]
+}
+
+func (x TerraformStateLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TerraformStateLayout.Descriptor instead.
+func (TerraformStateLayout) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{0}
+}
+
+type PlatformOrganization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Status           common.Status          `protobuf:"varint,3,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	// Dunning state: active, past_due, suspended or scheduled_for_deletion
+	BillingState          string `protobuf:"bytes,4,opt,name=billing_state,json=billingState,proto3" json:"billing_state,omitempty"`
+	BillingStateChangedAt int64  `protobuf:"varint,5,opt,name=billing_state_changed_at,json=billingStateChangedAt,proto3" json:"billing_state_changed_at,omitempty"` // Unix timestamp, 0 if never changed
+	// Stripe subscription status, empty without a subscription
+	SubscriptionStatus string `protobuf:"bytes,6,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
+	PaymentFailedAt    int64  `protobuf:"varint,7,opt,name=payment_failed_at,json=paymentFailedAt,proto3" json:"payment_failed_at,omitempty"` // Unix timestamp, 0 unless a payment is failing
+	ProjectCount       int64  `protobuf:"varint,8,opt,name=project_count,json=projectCount,proto3" json:"project_count,omitempty"`
+	SiteCount          int64  `protobuf:"varint,9,opt,name=site_count,json=siteCount,proto3" json:"site_count,omitempty"`
+	CreatedAt          int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Unix timestamp
+	SuspendedAt        int64  `protobuf:"varint,11,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"` // Unix timestamp of an operator suspension, 0 if none
+	SuspendedReason    string `protobuf:"bytes,12,opt,name=suspended_reason,json=suspendedReason,proto3" json:"suspended_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PlatformOrganization) Reset() {
+	*x = PlatformOrganization{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformOrganization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformOrganization) ProtoMessage() {}
+
+func (x *PlatformOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformOrganization.ProtoReflect.Descriptor instead.
+func (*PlatformOrganization) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlatformOrganization) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PlatformOrganization) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *PlatformOrganization) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *PlatformOrganization) GetBillingState() string {
+	if x != nil {
+		return x.BillingState
+	}
+	return ""
+}
+
+func (x *PlatformOrganization) GetBillingStateChangedAt() int64 {
+	if x != nil {
+		return x.BillingStateChangedAt
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetSubscriptionStatus() string {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return ""
+}
+
+func (x *PlatformOrganization) GetPaymentFailedAt() int64 {
+	if x != nil {
+		return x.PaymentFailedAt
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetProjectCount() int64 {
+	if x != nil {
+		return x.ProjectCount
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetSiteCount() int64 {
+	if x != nil {
+		return x.SiteCount
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetSuspendedAt() int64 {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return 0
+}
+
+func (x *PlatformOrganization) GetSuspendedReason() string {
+	if x != nil {
+		return x.SuspendedReason
+	}
+	return ""
+}
+
+type ListPlatformOrganizationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only organizations in this billing state
+	BillingState *string `protobuf:"bytes,3,opt,name=billing_state,json=billingState,proto3,oneof" json:"billing_state,omitempty"`
+	// Only organizations with this status
+	Status        *common.Status `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.common.Status,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformOrganizationsRequest) Reset() {
+	*x = ListPlatformOrganizationsRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListPlatformOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPlatformOrganizationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlatformOrganizationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlatformOrganizationsRequest) GetBillingState() string {
+	if x != nil && x.BillingState != nil {
+		return *x.BillingState
+	}
+	return ""
+}
+
+func (x *ListPlatformOrganizationsRequest) GetStatus() common.Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return common.Status(0)
+}
+
+type ListPlatformOrganizationsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Organizations []*PlatformOrganization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformOrganizationsResponse) Reset() {
+	*x = ListPlatformOrganizationsResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListPlatformOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPlatformOrganizationsResponse) GetOrganizations() []*PlatformOrganization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListPlatformOrganizationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ForceReconciliationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource to reconcile; a site reconciles just that site, a project or
+	// organization everything beneath it
+	//
+	// Types that are valid to be assigned to Resource:
+	//
+	//	*ForceReconciliationRequest_OrganizationId
+	//	*ForceReconciliationRequest_ProjectId
+	//	*ForceReconciliationRequest_SiteId
+	Resource isForceReconciliationRequest_Resource `protobuf_oneof:"resource"`
+	// Why, for the audit log
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceReconciliationRequest) Reset() {
+	*x = ForceReconciliationRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceReconciliationRequest) ProtoMessage() {}
+
+func (x *ForceReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*ForceReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForceReconciliationRequest) GetResource() isForceReconciliationRequest_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ForceReconciliationRequest) GetOrganizationId() string {
+	if x != nil {
+		if x, ok := x.Resource.(*ForceReconciliationRequest_OrganizationId); ok {
+			return x.OrganizationId
+		}
+	}
+	return ""
+}
+
+func (x *ForceReconciliationRequest) GetProjectId() string {
+	if x != nil {
+		if x, ok := x.Resource.(*ForceReconciliationRequest_ProjectId); ok {
+			return x.ProjectId
+		}
+	}
+	return ""
+}
+
+func (x *ForceReconciliationRequest) GetSiteId() string {
+	if x != nil {
+		if x, ok := x.Resource.(*ForceReconciliationRequest_SiteId); ok {
+			return x.SiteId
+		}
+	}
+	return ""
+}
+
+func (x *ForceReconciliationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type isForceReconciliationRequest_Resource interface {
+	isForceReconciliationRequest_Resource()
+}
+
+type ForceReconciliationRequest_OrganizationId struct {
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof"`
+}
+
+type ForceReconciliationRequest_ProjectId struct {
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof"`
+}
+
+type ForceReconciliationRequest_SiteId struct {
+	SiteId string `protobuf:"bytes,3,opt,name=site_id,json=siteId,proto3,oneof"`
+}
+
+func (*ForceReconciliationRequest_OrganizationId) isForceReconciliationRequest_Resource() {}
+
+func (*ForceReconciliationRequest_ProjectId) isForceReconciliationRequest_Resource() {}
+
+func (*ForceReconciliationRequest_SiteId) isForceReconciliationRequest_Resource() {}
+
+type ForceReconciliationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // The update event queued for the control plane
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceReconciliationResponse) Reset() {
+	*x = ForceReconciliationResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceReconciliationResponse) ProtoMessage() {}
+
+func (x *ForceReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*ForceReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ForceReconciliationResponse) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+type ReconcileFleetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sites to reconcile; ignored when site_ids is set
+	Filter *FleetFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// At most 500 sites, e.g. one batch of a dry run's matches
+	SiteIds            []string `protobuf:"bytes,2,rep,name=site_ids,json=siteIds,proto3" json:"site_ids,omitempty"`
+	ReconciliationType string   `protobuf:"bytes,3,opt,name=reconciliation_type,json=reconciliationType,proto3" json:"reconciliation_type,omitempty"` // "secrets" or "firewall"
+	Reason             string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	DryRun             bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only return the matching sites
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReconcileFleetRequest) Reset() {
+	*x = ReconcileFleetRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileFleetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFleetRequest) ProtoMessage() {}
+
+func (x *ReconcileFleetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFleetRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileFleetRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReconcileFleetRequest) GetFilter() *FleetFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ReconcileFleetRequest) GetSiteIds() []string {
+	if x != nil {
+		return x.SiteIds
+	}
+	return nil
+}
+
+func (x *ReconcileFleetRequest) GetReconciliationType() string {
+	if x != nil {
+		return x.ReconciliationType
+	}
+	return ""
+}
+
+func (x *ReconcileFleetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReconcileFleetRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReconcileFleetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sites queued, or that would be queued by a dry run
+	SiteIds       []string                 `protobuf:"bytes,1,rep,name=site_ids,json=siteIds,proto3" json:"site_ids,omitempty"`
+	Failures      []*FleetReconcileFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileFleetResponse) Reset() {
+	*x = ReconcileFleetResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileFleetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileFleetResponse) ProtoMessage() {}
+
+func (x *ReconcileFleetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileFleetResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileFleetResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReconcileFleetResponse) GetSiteIds() []string {
+	if x != nil {
+		return x.SiteIds
+	}
+	return nil
+}
+
+func (x *ReconcileFleetResponse) GetFailures() []*FleetReconcileFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// A site whose reconciliation couldn't be queued
+type FleetReconcileFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetReconcileFailure) Reset() {
+	*x = FleetReconcileFailure{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetReconcileFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetReconcileFailure) ProtoMessage() {}
+
+func (x *FleetReconcileFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetReconcileFailure.ProtoReflect.Descriptor instead.
+func (*FleetReconcileFailure) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FleetReconcileFailure) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *FleetReconcileFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A terraform run or site reconciliation
+type PlatformReconciliationRun struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RunId   string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RunType string                 `protobuf:"bytes,2,opt,name=run_type,json=runType,proto3" json:"run_type,omitempty"` // terraform or reconciliation
+	// ssh_keys, secrets, firewall or general; empty for terraform runs
+	ReconciliationType string   `protobuf:"bytes,3,opt,name=reconciliation_type,json=reconciliationType,proto3" json:"reconciliation_type,omitempty"`
+	Modules            []string `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"` // Terraform modules the run applies
+	// pending, triggered, running, completed, failed or cancelled
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Public IDs of the run's scope; a site run has all three
+	OrganizationId string `protobuf:"bytes,7,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SiteId         string `protobuf:"bytes,9,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Attempt        int32  `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// What the event router did with a failed site reconciliation: scheduled,
+	// retried, superseded or exhausted; empty otherwise
+	RetryState  string `protobuf:"bytes,11,opt,name=retry_state,json=retryState,proto3" json:"retry_state,omitempty"`
+	NextRetryAt int64  `protobuf:"varint,12,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"` // Unix timestamp, 0 unless a retry is scheduled
+	CreatedAt   int64  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	TriggeredAt int64  `protobuf:"varint,14,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`   // Unix timestamp, 0 until triggered
+	StartedAt   int64  `protobuf:"varint,15,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`         // Unix timestamp, 0 until started
+	CompletedAt int64  `protobuf:"varint,16,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`   // Unix timestamp, 0 until finished
+	// The organization's GCP project, where its terraform runner job runs
+	GcpProjectId  string `protobuf:"bytes,17,opt,name=gcp_project_id,json=gcpProjectId,proto3" json:"gcp_project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformReconciliationRun) Reset() {
+	*x = PlatformReconciliationRun{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformReconciliationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformReconciliationRun) ProtoMessage() {}
+
+func (x *PlatformReconciliationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformReconciliationRun.ProtoReflect.Descriptor instead.
+func (*PlatformReconciliationRun) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlatformReconciliationRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetRunType() string {
+	if x != nil {
+		return x.RunType
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetReconciliationType() string {
+	if x != nil {
+		return x.ReconciliationType
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *PlatformReconciliationRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetRetryState() string {
+	if x != nil {
+		return x.RetryState
+	}
+	return ""
+}
+
+func (x *PlatformReconciliationRun) GetNextRetryAt() int64 {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetTriggeredAt() int64 {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *PlatformReconciliationRun) GetGcpProjectId() string {
+	if x != nil {
+		return x.GcpProjectId
+	}
+	return ""
+}
+
+type ListReconciliationRunsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only terraform or reconciliation runs
+	RunType *string `protobuf:"bytes,3,opt,name=run_type,json=runType,proto3,oneof" json:"run_type,omitempty"`
+	Status  *string `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// Only runs for this organization, including its projects and sites
+	OrganizationId *string `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	// Only runs for this site
+	SiteId        *string `protobuf:"bytes,6,opt,name=site_id,json=siteId,proto3,oneof" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationRunsRequest) Reset() {
+	*x = ListReconciliationRunsRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationRunsRequest) ProtoMessage() {}
+
+func (x *ListReconciliationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListReconciliationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListReconciliationRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReconciliationRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReconciliationRunsRequest) GetRunType() string {
+	if x != nil && x.RunType != nil {
+		return *x.RunType
+	}
+	return ""
+}
+
+func (x *ListReconciliationRunsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListReconciliationRunsRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListReconciliationRunsRequest) GetSiteId() string {
+	if x != nil && x.SiteId != nil {
+		return *x.SiteId
+	}
+	return ""
+}
+
+type ListReconciliationRunsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Runs          []*PlatformReconciliationRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	NextPageToken string                       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReconciliationRunsResponse) Reset() {
+	*x = ListReconciliationRunsResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReconciliationRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReconciliationRunsResponse) ProtoMessage() {}
+
+func (x *ListReconciliationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReconciliationRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListReconciliationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListReconciliationRunsResponse) GetRuns() []*PlatformReconciliationRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListReconciliationRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DescribeReconciliationRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeReconciliationRunRequest) Reset() {
+	*x = DescribeReconciliationRunRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeReconciliationRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeReconciliationRunRequest) ProtoMessage() {}
+
+func (x *DescribeReconciliationRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeReconciliationRunRequest.ProtoReflect.Descriptor instead.
+func (*DescribeReconciliationRunRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DescribeReconciliationRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// What a run did to one module or site
+type ReconciliationRunResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultType    string                 `protobuf:"bytes,1,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"` // terraform_module or vm_reconciliation
+	ModuleType    string                 `protobuf:"bytes,2,opt,name=module_type,json=moduleType,proto3" json:"module_type,omitempty"` // For terraform: organization, project or site
+	SiteId        string                 `protobuf:"bytes,3,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`             // For site reconciliations: the site's public ID
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // success or failed
+	Output        string                 `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`       // Unix timestamp
+	CompletedAt   int64                  `protobuf:"varint,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationRunResult) Reset() {
+	*x = ReconciliationRunResult{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRunResult) ProtoMessage() {}
+
+func (x *ReconciliationRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRunResult.ProtoReflect.Descriptor instead.
+func (*ReconciliationRunResult) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReconciliationRunResult) GetResultType() string {
+	if x != nil {
+		return x.ResultType
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetModuleType() string {
+	if x != nil {
+		return x.ModuleType
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ReconciliationRunResult) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ReconciliationRunResult) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type DescribeReconciliationRunResponse struct {
+	state   protoimpl.MessageState     `protogen:"open.v1"`
+	Run     *PlatformReconciliationRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Results []*ReconciliationRunResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// Cloud Logging filter matching the run's log entries
+	LogFilter string `protobuf:"bytes,3,opt,name=log_filter,json=logFilter,proto3" json:"log_filter,omitempty"`
+	// Project whose logs the filter applies to: the organization's project for
+	// terraform runs, empty for site reconciliations logged by the control plane
+	LogProject    string `protobuf:"bytes,4,opt,name=log_project,json=logProject,proto3" json:"log_project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeReconciliationRunResponse) Reset() {
+	*x = DescribeReconciliationRunResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeReconciliationRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeReconciliationRunResponse) ProtoMessage() {}
+
+func (x *DescribeReconciliationRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeReconciliationRunResponse.ProtoReflect.Descriptor instead.
+func (*DescribeReconciliationRunResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DescribeReconciliationRunResponse) GetRun() *PlatformReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *DescribeReconciliationRunResponse) GetResults() []*ReconciliationRunResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DescribeReconciliationRunResponse) GetLogFilter() string {
+	if x != nil {
+		return x.LogFilter
+	}
+	return ""
+}
+
+func (x *DescribeReconciliationRunResponse) GetLogProject() string {
+	if x != nil {
+		return x.LogProject
+	}
+	return ""
+}
+
+type RetryReconciliationRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Why, for the audit log
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryReconciliationRunRequest) Reset() {
+	*x = RetryReconciliationRunRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryReconciliationRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryReconciliationRunRequest) ProtoMessage() {}
+
+func (x *RetryReconciliationRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryReconciliationRunRequest.ProtoReflect.Descriptor instead.
+func (*RetryReconciliationRunRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RetryReconciliationRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RetryReconciliationRunRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RetryReconciliationRunResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new run for a terraform run, the rescheduled run for a site
+	// reconciliation
+	Run           *PlatformReconciliationRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryReconciliationRunResponse) Reset() {
+	*x = RetryReconciliationRunResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryReconciliationRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryReconciliationRunResponse) ProtoMessage() {}
+
+func (x *RetryReconciliationRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryReconciliationRunResponse.ProtoReflect.Descriptor instead.
+func (*RetryReconciliationRunResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RetryReconciliationRunResponse) GetRun() *PlatformReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type MarkReconciliationRunTriggeredRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReconciliationRunTriggeredRequest) Reset() {
+	*x = MarkReconciliationRunTriggeredRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReconciliationRunTriggeredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReconciliationRunTriggeredRequest) ProtoMessage() {}
+
+func (x *MarkReconciliationRunTriggeredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReconciliationRunTriggeredRequest.ProtoReflect.Descriptor instead.
+func (*MarkReconciliationRunTriggeredRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarkReconciliationRunTriggeredRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type MarkReconciliationRunTriggeredResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Run           *PlatformReconciliationRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReconciliationRunTriggeredResponse) Reset() {
+	*x = MarkReconciliationRunTriggeredResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReconciliationRunTriggeredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReconciliationRunTriggeredResponse) ProtoMessage() {}
+
+func (x *MarkReconciliationRunTriggeredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReconciliationRunTriggeredResponse.ProtoReflect.Descriptor instead.
+func (*MarkReconciliationRunTriggeredResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarkReconciliationRunTriggeredResponse) GetRun() *PlatformReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type CancelReconciliationRunRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	RunId string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Why, kept as the run's error message and in the audit log
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReconciliationRunRequest) Reset() {
+	*x = CancelReconciliationRunRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReconciliationRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReconciliationRunRequest) ProtoMessage() {}
+
+func (x *CancelReconciliationRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReconciliationRunRequest.ProtoReflect.Descriptor instead.
+func (*CancelReconciliationRunRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelReconciliationRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *CancelReconciliationRunRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelReconciliationRunResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Run           *PlatformReconciliationRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReconciliationRunResponse) Reset() {
+	*x = CancelReconciliationRunResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReconciliationRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReconciliationRunResponse) ProtoMessage() {}
+
+func (x *CancelReconciliationRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReconciliationRunResponse.ProtoReflect.Descriptor instead.
+func (*CancelReconciliationRunResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelReconciliationRunResponse) GetRun() *PlatformReconciliationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type SuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Why, shown to operators and kept in the audit log
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationRequest) Reset() {
+	*x = SuspendOrganizationRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationRequest) ProtoMessage() {}
+
+func (x *SuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuspendOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SuspendOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendOrganizationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Organization   *PlatformOrganization  `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	SitesSuspended int64                  `protobuf:"varint,2,opt,name=sites_suspended,json=sitesSuspended,proto3" json:"sites_suspended,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuspendOrganizationResponse) Reset() {
+	*x = SuspendOrganizationResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendOrganizationResponse) ProtoMessage() {}
+
+func (x *SuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SuspendOrganizationResponse) GetOrganization() *PlatformOrganization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *SuspendOrganizationResponse) GetSitesSuspended() int64 {
+	if x != nil {
+		return x.SitesSuspended
+	}
+	return 0
+}
+
+type UnsuspendOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnsuspendOrganizationRequest) Reset() {
+	*x = UnsuspendOrganizationRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendOrganizationRequest) ProtoMessage() {}
+
+func (x *UnsuspendOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UnsuspendOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UnsuspendOrganizationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnsuspendOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *PlatformOrganization  `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	SitesResumed  int64                  `protobuf:"varint,2,opt,name=sites_resumed,json=sitesResumed,proto3" json:"sites_resumed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendOrganizationResponse) Reset() {
+	*x = UnsuspendOrganizationResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendOrganizationResponse) ProtoMessage() {}
+
+func (x *UnsuspendOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*UnsuspendOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnsuspendOrganizationResponse) GetOrganization() *PlatformOrganization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *UnsuspendOrganizationResponse) GetSitesResumed() int64 {
+	if x != nil {
+		return x.SitesResumed
+	}
+	return 0
+}
+
+type GetEventQueueHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many of the newest dead-lettered events to return (default 20, max 100)
+	DeadLetterLimit int32 `protobuf:"varint,1,opt,name=dead_letter_limit,json=deadLetterLimit,proto3" json:"dead_letter_limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEventQueueHealthRequest) Reset() {
+	*x = GetEventQueueHealthRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventQueueHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventQueueHealthRequest) ProtoMessage() {}
+
+func (x *GetEventQueueHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventQueueHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetEventQueueHealthRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetEventQueueHealthRequest) GetDeadLetterLimit() int32 {
+	if x != nil {
+		return x.DeadLetterLimit
+	}
+	return 0
+}
+
+type DeadLetterEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	RetryCount    int32                  `protobuf:"varint,3,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LastError     string                 `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	LastRetryAt   int64                  `protobuf:"varint,6,opt,name=last_retry_at,json=lastRetryAt,proto3" json:"last_retry_at,omitempty"` // Unix timestamp, 0 if never retried
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterEvent) Reset() {
+	*x = DeadLetterEvent{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterEvent) ProtoMessage() {}
+
+func (x *DeadLetterEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterEvent.ProtoReflect.Descriptor instead.
+func (*DeadLetterEvent) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeadLetterEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *DeadLetterEvent) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetterEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DeadLetterEvent) GetLastRetryAt() int64 {
+	if x != nil {
+		return x.LastRetryAt
+	}
+	return 0
+}
+
+type GetEventQueueHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of events in each status (pending, processing, sent, executed, collapsed, dead_letter)
+	Counts               map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	OldestPendingEventId string           `protobuf:"bytes,2,opt,name=oldest_pending_event_id,json=oldestPendingEventId,proto3" json:"oldest_pending_event_id,omitempty"`
+	// Seconds the oldest pending event has waited, 0 when nothing is pending
+	OldestPendingAgeSeconds int64 `protobuf:"varint,3,opt,name=oldest_pending_age_seconds,json=oldestPendingAgeSeconds,proto3" json:"oldest_pending_age_seconds,omitempty"`
+	// Events claimed for processing longer ago than the stuck threshold
+	StuckEvents   int64              `protobuf:"varint,4,opt,name=stuck_events,json=stuckEvents,proto3" json:"stuck_events,omitempty"`
+	DeadLetters   []*DeadLetterEvent `protobuf:"bytes,5,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventQueueHealthResponse) Reset() {
+	*x = GetEventQueueHealthResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventQueueHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventQueueHealthResponse) ProtoMessage() {}
+
+func (x *GetEventQueueHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventQueueHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetEventQueueHealthResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetEventQueueHealthResponse) GetCounts() map[string]int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *GetEventQueueHealthResponse) GetOldestPendingEventId() string {
+	if x != nil {
+		return x.OldestPendingEventId
+	}
+	return ""
+}
+
+func (x *GetEventQueueHealthResponse) GetOldestPendingAgeSeconds() int64 {
+	if x != nil {
+		return x.OldestPendingAgeSeconds
+	}
+	return 0
+}
+
+func (x *GetEventQueueHealthResponse) GetStuckEvents() int64 {
+	if x != nil {
+		return x.StuckEvents
+	}
+	return 0
+}
+
+func (x *GetEventQueueHealthResponse) GetDeadLetters() []*DeadLetterEvent {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type LookupResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupResourceRequest) Reset() {
+	*x = LookupResourceRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResourceRequest) ProtoMessage() {}
+
+func (x *LookupResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResourceRequest.ProtoReflect.Descriptor instead.
+func (*LookupResourceRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LookupResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResourceMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization, project, site, account, api_key, deployment, reconciliation_run or event
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   string `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The field the ID matched, e.g. public_id, gcp_project_id or email
+	MatchedField string `protobuf:"bytes,4,opt,name=matched_field,json=matchedField,proto3" json:"matched_field,omitempty"`
+	// The resource's place in the hierarchy, empty where it doesn't apply
+	OrganizationId string `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SiteId         string `protobuf:"bytes,7,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Status         string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResourceMatch) Reset() {
+	*x = ResourceMatch{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceMatch) ProtoMessage() {}
+
+func (x *ResourceMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceMatch.ProtoReflect.Descriptor instead.
+func (*ResourceMatch) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResourceMatch) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetMatchedField() string {
+	if x != nil {
+		return x.MatchedField
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ResourceMatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type LookupResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*ResourceMatch       `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupResourceResponse) Reset() {
+	*x = LookupResourceResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResourceResponse) ProtoMessage() {}
+
+func (x *LookupResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResourceResponse.ProtoReflect.Descriptor instead.
+func (*LookupResourceResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LookupResourceResponse) GetMatches() []*ResourceMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type ExplainAccessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account public ID or email
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Organization, project or site
+	ResourceType options.ResourceType `protobuf:"varint,2,opt,name=resource_type,json=resourceType,proto3,enum=libops.v1.options.ResourceType" json:"resource_type,omitempty"`
+	ResourceId   string               `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"` // UUID
+	AccessLevel  options.AccessLevel  `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=libops.v1.options.AccessLevel" json:"access_level,omitempty"`
+	// Public ID of one of the account's API keys, to check its scopes and
+	// networks; a session without scope restrictions when empty
+	ApiKeyId string `protobuf:"bytes,5,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	// Address the request would come from, to check network allowlists; skipped
+	// when empty
+	SourceIp      string `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainAccessRequest) Reset() {
+	*x = ExplainAccessRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAccessRequest) ProtoMessage() {}
+
+func (x *ExplainAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAccessRequest.ProtoReflect.Descriptor instead.
+func (*ExplainAccessRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExplainAccessRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ExplainAccessRequest) GetResourceType() options.ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return options.ResourceType(0)
+}
+
+func (x *ExplainAccessRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ExplainAccessRequest) GetAccessLevel() options.AccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return options.AccessLevel(0)
+}
+
+func (x *ExplainAccessRequest) GetApiKeyId() string {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return ""
+}
+
+func (x *ExplainAccessRequest) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+type AccessCheckStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. scope, site membership, relationship or policy
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`
+	// found, none, allowed, denied, skipped or not found
+	Outcome       string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Detail        string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessCheckStep) Reset() {
+	*x = AccessCheckStep{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessCheckStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessCheckStep) ProtoMessage() {}
+
+func (x *AccessCheckStep) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessCheckStep.ProtoReflect.Descriptor instead.
+func (*AccessCheckStep) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AccessCheckStep) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *AccessCheckStep) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AccessCheckStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type ExplainAccessResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// The checks made, in the order requests go through them
+	Steps []*AccessCheckStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// The policies that permitted the action, by @id
+	PermittingPolicies []string `protobuf:"bytes,3,rep,name=permitting_policies,json=permittingPolicies,proto3" json:"permitting_policies,omitempty"`
+	// Why the request would be denied; empty when allowed
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainAccessResponse) Reset() {
+	*x = ExplainAccessResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainAccessResponse) ProtoMessage() {}
+
+func (x *ExplainAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainAccessResponse.ProtoReflect.Descriptor instead.
+func (*ExplainAccessResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExplainAccessResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ExplainAccessResponse) GetSteps() []*AccessCheckStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ExplainAccessResponse) GetPermittingPolicies() []string {
+	if x != nil {
+		return x.PermittingPolicies
+	}
+	return nil
+}
+
+func (x *ExplainAccessResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetControllerConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Site public ID; the fleet-wide config when empty
+	SiteId        string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetControllerConfigRequest) Reset() {
+	*x = GetControllerConfigRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControllerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControllerConfigRequest) ProtoMessage() {}
+
+func (x *GetControllerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControllerConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetControllerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetControllerConfigRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetControllerConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The document stored for the fleet or the site; empty if none is set
+	Config *ControllerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// What the site's controller applies on check-in: the site's override on top
+	// of the fleet's config. Only set for a site.
+	Effective     *ControllerConfig `protobuf:"bytes,2,opt,name=effective,proto3" json:"effective,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetControllerConfigResponse) Reset() {
+	*x = GetControllerConfigResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControllerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControllerConfigResponse) ProtoMessage() {}
+
+func (x *GetControllerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControllerConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetControllerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetControllerConfigResponse) GetConfig() *ControllerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetControllerConfigResponse) GetEffective() *ControllerConfig {
+	if x != nil {
+		return x.Effective
+	}
+	return nil
+}
+
+type UpdateControllerConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Site public ID; the fleet-wide config when empty
+	SiteId string `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Unset fields inherit from the fleet, or keep the controller's own settings
+	Config        *ControllerConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateControllerConfigRequest) Reset() {
+	*x = UpdateControllerConfigRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateControllerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateControllerConfigRequest) ProtoMessage() {}
+
+func (x *UpdateControllerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateControllerConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateControllerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateControllerConfigRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateControllerConfigRequest) GetConfig() *ControllerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateControllerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ControllerConfig      `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateControllerConfigResponse) Reset() {
+	*x = UpdateControllerConfigResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateControllerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateControllerConfigResponse) ProtoMessage() {}
+
+func (x *UpdateControllerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateControllerConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateControllerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateControllerConfigResponse) GetConfig() *ControllerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DeleteControllerConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // Site public ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteControllerConfigRequest) Reset() {
+	*x = DeleteControllerConfigRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteControllerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteControllerConfigRequest) ProtoMessage() {}
+
+func (x *DeleteControllerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteControllerConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteControllerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteControllerConfigRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type DeleteControllerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteControllerConfigResponse) Reset() {
+	*x = DeleteControllerConfigResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteControllerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteControllerConfigResponse) ProtoMessage() {}
+
+func (x *DeleteControllerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteControllerConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteControllerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{38}
+}
+
+type ListControllerReleasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 50, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListControllerReleasesRequest) Reset() {
+	*x = ListControllerReleasesRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListControllerReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListControllerReleasesRequest) ProtoMessage() {}
+
+func (x *ListControllerReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListControllerReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListControllerReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListControllerReleasesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListControllerReleasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Releases      []*ControllerRelease   `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListControllerReleasesResponse) Reset() {
+	*x = ListControllerReleasesResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListControllerReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListControllerReleasesResponse) ProtoMessage() {}
+
+func (x *ListControllerReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListControllerReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListControllerReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListControllerReleasesResponse) GetReleases() []*ControllerRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+type CreateControllerReleaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// created_at and site_count are ignored
+	Release       *ControllerRelease `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateControllerReleaseRequest) Reset() {
+	*x = CreateControllerReleaseRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateControllerReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateControllerReleaseRequest) ProtoMessage() {}
+
+func (x *CreateControllerReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateControllerReleaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateControllerReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateControllerReleaseRequest) GetRelease() *ControllerRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+type CreateControllerReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Release       *ControllerRelease     `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateControllerReleaseResponse) Reset() {
+	*x = CreateControllerReleaseResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateControllerReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateControllerReleaseResponse) ProtoMessage() {}
+
+func (x *CreateControllerReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateControllerReleaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateControllerReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateControllerReleaseResponse) GetRelease() *ControllerRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+// TerraformStateVersion is one generation of a state object
+type TerraformStateVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Generation    int64                  `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"` // GCS object generation
+	SizeBytes     int64                  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	Live          bool                   `protobuf:"varint,4,opt,name=live,proto3" json:"live,omitempty"`                            // The state the next run reads
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerraformStateVersion) Reset() {
+	*x = TerraformStateVersion{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformStateVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformStateVersion) ProtoMessage() {}
+
+func (x *TerraformStateVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformStateVersion.ProtoReflect.Descriptor instead.
+func (*TerraformStateVersion) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TerraformStateVersion) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *TerraformStateVersion) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *TerraformStateVersion) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *TerraformStateVersion) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+// TerraformStateBackup is a copy of an organization's state taken by an
+// operator or before a restore
+type TerraformStateBackup struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	BackupId string                 `protobuf:"bytes,1,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+	// Object in the organization's tfstate bucket, under the backed up
+	// workspace's name, e.g. terraform/backups/sites/<site ID>/
+	Object           string `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	SourceGeneration int64  `protobuf:"varint,3,opt,name=source_generation,json=sourceGeneration,proto3" json:"source_generation,omitempty"` // State generation that was copied
+	SizeBytes        int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Reason           string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt        int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TerraformStateBackup) Reset() {
+	*x = TerraformStateBackup{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformStateBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformStateBackup) ProtoMessage() {}
+
+func (x *TerraformStateBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformStateBackup.ProtoReflect.Descriptor instead.
+func (*TerraformStateBackup) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TerraformStateBackup) GetBackupId() string {
+	if x != nil {
+		return x.BackupId
+	}
+	return ""
+}
+
+func (x *TerraformStateBackup) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *TerraformStateBackup) GetSourceGeneration() int64 {
+	if x != nil {
+		return x.SourceGeneration
+	}
+	return 0
+}
+
+func (x *TerraformStateBackup) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *TerraformStateBackup) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TerraformStateBackup) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListStateVersionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+	// workspace; empty for the organization's own state
+	Workspace     string `protobuf:"bytes,2,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStateVersionsRequest) Reset() {
+	*x = ListStateVersionsRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStateVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStateVersionsRequest) ProtoMessage() {}
+
+func (x *ListStateVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStateVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStateVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListStateVersionsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListStateVersionsRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+type ListStateVersionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Bucket        string                   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Versions      []*TerraformStateVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"` // Newest first
+	Backups       []*TerraformStateBackup  `protobuf:"bytes,3,rep,name=backups,proto3" json:"backups,omitempty"`   // Newest first, at most 50
+	Locked        bool                     `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`    // A run holds the state lock
+	Layout        TerraformStateLayout     `protobuf:"varint,5,opt,name=layout,proto3,enum=libops.v1.TerraformStateLayout" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStateVersionsResponse) Reset() {
+	*x = ListStateVersionsResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStateVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStateVersionsResponse) ProtoMessage() {}
+
+func (x *ListStateVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStateVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStateVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListStateVersionsResponse) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListStateVersionsResponse) GetVersions() []*TerraformStateVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListStateVersionsResponse) GetBackups() []*TerraformStateBackup {
+	if x != nil {
+		return x.Backups
+	}
+	return nil
+}
+
+func (x *ListStateVersionsResponse) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ListStateVersionsResponse) GetLayout() TerraformStateLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return TerraformStateLayout_TERRAFORM_STATE_LAYOUT_UNSPECIFIED
+}
+
+type CopyStateBackupRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+	// workspace; empty for the organization's own state
+	Workspace     string `protobuf:"bytes,3,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyStateBackupRequest) Reset() {
+	*x = CopyStateBackupRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyStateBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyStateBackupRequest) ProtoMessage() {}
+
+func (x *CopyStateBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyStateBackupRequest.ProtoReflect.Descriptor instead.
+func (*CopyStateBackupRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CopyStateBackupRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CopyStateBackupRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CopyStateBackupRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+type CopyStateBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Backup        *TerraformStateBackup  `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyStateBackupResponse) Reset() {
+	*x = CopyStateBackupResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyStateBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyStateBackupResponse) ProtoMessage() {}
+
+func (x *CopyStateBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyStateBackupResponse.ProtoReflect.Descriptor instead.
+func (*CopyStateBackupResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CopyStateBackupResponse) GetBackup() *TerraformStateBackup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+type RestoreStateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*RestoreStateRequest_Generation
+	//	*RestoreStateRequest_BackupId
+	Source isRestoreStateRequest_Source `protobuf_oneof:"source"`
+	Reason string                       `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+	// workspace; empty for the organization's own state
+	Workspace     string `protobuf:"bytes,5,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStateRequest) Reset() {
+	*x = RestoreStateRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStateRequest) ProtoMessage() {}
+
+func (x *RestoreStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStateRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RestoreStateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RestoreStateRequest) GetSource() isRestoreStateRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RestoreStateRequest) GetGeneration() int64 {
+	if x != nil {
+		if x, ok := x.Source.(*RestoreStateRequest_Generation); ok {
+			return x.Generation
+		}
+	}
+	return 0
+}
+
+func (x *RestoreStateRequest) GetBackupId() string {
+	if x != nil {
+		if x, ok := x.Source.(*RestoreStateRequest_BackupId); ok {
+			return x.BackupId
+		}
+	}
+	return ""
+}
+
+func (x *RestoreStateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RestoreStateRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+type isRestoreStateRequest_Source interface {
+	isRestoreStateRequest_Source()
+}
+
+type RestoreStateRequest_Generation struct {
+	Generation int64 `protobuf:"varint,2,opt,name=generation,proto3,oneof"` // A kept version of the state object
+}
+
+type RestoreStateRequest_BackupId struct {
+	BackupId string `protobuf:"bytes,3,opt,name=backup_id,json=backupId,proto3,oneof"`
+}
+
+func (*RestoreStateRequest_Generation) isRestoreStateRequest_Source() {}
+
+func (*RestoreStateRequest_BackupId) isRestoreStateRequest_Source() {}
+
+type RestoreStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State *TerraformStateVersion `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // The new current state
+	// The state that was replaced, absent when the organization had none
+	Previous      *TerraformStateBackup `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreStateResponse) Reset() {
+	*x = RestoreStateResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStateResponse) ProtoMessage() {}
+
+func (x *RestoreStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStateResponse.ProtoReflect.Descriptor instead.
+func (*RestoreStateResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RestoreStateResponse) GetState() *TerraformStateVersion {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *RestoreStateResponse) GetPrevious() *TerraformStateBackup {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+type MigrateStateWorkspacesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MigrateStateWorkspacesRequest) Reset() {
+	*x = MigrateStateWorkspacesRequest{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateStateWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateStateWorkspacesRequest) ProtoMessage() {}
+
+func (x *MigrateStateWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateStateWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*MigrateStateWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MigrateStateWorkspacesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *MigrateStateWorkspacesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MigrateStateWorkspacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // The terraform run moving the state
+	Backup        *TerraformStateBackup  `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty"`            // The monolithic state before the migration
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateStateWorkspacesResponse) Reset() {
+	*x = MigrateStateWorkspacesResponse{}
+	mi := &file_libops_v1_admin_console_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateStateWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateStateWorkspacesResponse) ProtoMessage() {}
+
+func (x *MigrateStateWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_admin_console_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateStateWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*MigrateStateWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_admin_console_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MigrateStateWorkspacesResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *MigrateStateWorkspacesResponse) GetBackup() *TerraformStateBackup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+var File_libops_v1_admin_console_proto protoreflect.FileDescriptor
+
+const file_libops_v1_admin_console_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlibops/v1/admin_console.proto\x12\tlibops.v1\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\x1a\x19libops/v1/admin_api.proto\x1a\x15libops/v1/fleet.proto\"\x8a\x04\n" +
+	"\x14PlatformOrganization\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12#\n" +
+	"\rbilling_state\x18\x04 \x01(\tR\fbillingState\x127\n" +
+	"\x18billing_state_changed_at\x18\x05 \x01(\x03R\x15billingStateChangedAt\x12/\n" +
+	"\x13subscription_status\x18\x06 \x01(\tR\x12subscriptionStatus\x12*\n" +
+	"\x11payment_failed_at\x18\a \x01(\x03R\x0fpaymentFailedAt\x12#\n" +
+	"\rproject_count\x18\b \x01(\x03R\fprojectCount\x12\x1d\n" +
+	"\n" +
+	"site_count\x18\t \x01(\x03R\tsiteCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fsuspended_at\x18\v \x01(\x03R\vsuspendedAt\x12)\n" +
+	"\x10suspended_reason\x18\f \x01(\tR\x0fsuspendedReason\"\xdc\x01\n" +
+	" ListPlatformOrganizationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\rbilling_state\x18\x03 \x01(\tH\x00R\fbillingState\x88\x01\x01\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusH\x01R\x06status\x88\x01\x01B\x10\n" +
+	"\x0e_billing_stateB\t\n" +
+	"\a_status\"\x92\x01\n" +
+	"!ListPlatformOrganizationsResponse\x12E\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1f.libops.v1.PlatformOrganizationR\rorganizations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa7\x01\n" +
+	"\x1aForceReconciliationRequest\x12)\n" +
+	"\x0forganization_id\x18\x01 \x01(\tH\x00R\x0eorganizationId\x12\x1f\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tH\x00R\tprojectId\x12\x19\n" +
+	"\asite_id\x18\x03 \x01(\tH\x00R\x06siteId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reasonB\n" +
+	"\n" +
+	"\bresource\"<\n" +
+	"\x1bForceReconciliationResponse\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\"\xc4\x01\n" +
+	"\x15ReconcileFleetRequest\x12.\n" +
+	"\x06filter\x18\x01 \x01(\v2\x16.libops.v1.FleetFilterR\x06filter\x12\x19\n" +
+	"\bsite_ids\x18\x02 \x03(\tR\asiteIds\x12/\n" +
+	"\x13reconciliation_type\x18\x03 \x01(\tR\x12reconciliationType\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"q\n" +
+	"\x16ReconcileFleetResponse\x12\x19\n" +
+	"\bsite_ids\x18\x01 \x03(\tR\asiteIds\x12<\n" +
+	"\bfailures\x18\x02 \x03(\v2 .libops.v1.FleetReconcileFailureR\bfailures\"F\n" +
+	"\x15FleetReconcileFailure\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xbf\x04\n" +
+	"\x19PlatformReconciliationRun\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\brun_type\x18\x02 \x01(\tR\arunType\x12/\n" +
+	"\x13reconciliation_type\x18\x03 \x01(\tR\x12reconciliationType\x12\x18\n" +
+	"\amodules\x18\x04 \x03(\tR\amodules\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12'\n" +
+	"\x0forganization_id\x18\a \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\b \x01(\tR\tprojectId\x12\x17\n" +
+	"\asite_id\x18\t \x01(\tR\x06siteId\x12\x18\n" +
+	"\aattempt\x18\n" +
+	" \x01(\x05R\aattempt\x12\x1f\n" +
+	"\vretry_state\x18\v \x01(\tR\n" +
+	"retryState\x12\"\n" +
+	"\rnext_retry_at\x18\f \x01(\x03R\vnextRetryAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12!\n" +
+	"\ftriggered_at\x18\x0e \x01(\x03R\vtriggeredAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x0f \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\x10 \x01(\x03R\vcompletedAt\x12$\n" +
+	"\x0egcp_project_id\x18\x11 \x01(\tR\fgcpProjectId\"\x9c\x02\n" +
+	"\x1dListReconciliationRunsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1e\n" +
+	"\brun_type\x18\x03 \x01(\tH\x00R\arunType\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x01R\x06status\x88\x01\x01\x12,\n" +
+	"\x0forganization_id\x18\x05 \x01(\tH\x02R\x0eorganizationId\x88\x01\x01\x12\x1c\n" +
+	"\asite_id\x18\x06 \x01(\tH\x03R\x06siteId\x88\x01\x01B\v\n" +
+	"\t_run_typeB\t\n" +
+	"\a_statusB\x12\n" +
+	"\x10_organization_idB\n" +
+	"\n" +
+	"\b_site_id\"\x82\x01\n" +
+	"\x1eListReconciliationRunsResponse\x128\n" +
+	"\x04runs\x18\x01 \x03(\v2$.libops.v1.PlatformReconciliationRunR\x04runs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"9\n" +
+	" DescribeReconciliationRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\x8b\x02\n" +
+	"\x17ReconciliationRunResult\x12\x1f\n" +
+	"\vresult_type\x18\x01 \x01(\tR\n" +
+	"resultType\x12\x1f\n" +
+	"\vmodule_type\x18\x02 \x01(\tR\n" +
+	"moduleType\x12\x17\n" +
+	"\asite_id\x18\x03 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06output\x18\x05 \x01(\tR\x06output\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\b \x01(\x03R\vcompletedAt\"\xd9\x01\n" +
+	"!DescribeReconciliationRunResponse\x126\n" +
+	"\x03run\x18\x01 \x01(\v2$.libops.v1.PlatformReconciliationRunR\x03run\x12<\n" +
+	"\aresults\x18\x02 \x03(\v2\".libops.v1.ReconciliationRunResultR\aresults\x12\x1d\n" +
+	"\n" +
+	"log_filter\x18\x03 \x01(\tR\tlogFilter\x12\x1f\n" +
+	"\vlog_project\x18\x04 \x01(\tR\n" +
+	"logProject\"N\n" +
+	"\x1dRetryReconciliationRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"X\n" +
+	"\x1eRetryReconciliationRunResponse\x126\n" +
+	"\x03run\x18\x01 \x01(\v2$.libops.v1.PlatformReconciliationRunR\x03run\">\n" +
+	"%MarkReconciliationRunTriggeredRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"`\n" +
+	"&MarkReconciliationRunTriggeredResponse\x126\n" +
+	"\x03run\x18\x01 \x01(\v2$.libops.v1.PlatformReconciliationRunR\x03run\"O\n" +
+	"\x1eCancelReconciliationRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"Y\n" +
+	"\x1fCancelReconciliationRunResponse\x126\n" +
+	"\x03run\x18\x01 \x01(\v2$.libops.v1.PlatformReconciliationRunR\x03run\"]\n" +
+	"\x1aSuspendOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\x1bSuspendOrganizationResponse\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.libops.v1.PlatformOrganizationR\forganization\x12'\n" +
+	"\x0fsites_suspended\x18\x02 \x01(\x03R\x0esitesSuspended\"_\n" +
+	"\x1cUnsuspendOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x89\x01\n" +
+	"\x1dUnsuspendOrganizationResponse\x12C\n" +
+	"\forganization\x18\x01 \x01(\v2\x1f.libops.v1.PlatformOrganizationR\forganization\x12#\n" +
+	"\rsites_resumed\x18\x02 \x01(\x03R\fsitesResumed\"H\n" +
+	"\x1aGetEventQueueHealthRequest\x12*\n" +
+	"\x11dead_letter_limit\x18\x01 \x01(\x05R\x0fdeadLetterLimit\"\xce\x01\n" +
+	"\x0fDeadLetterEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x1f\n" +
+	"\vretry_count\x18\x03 \x01(\x05R\n" +
+	"retryCount\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\"\n" +
+	"\rlast_retry_at\x18\x06 \x01(\x03R\vlastRetryAt\"\xfa\x02\n" +
+	"\x1bGetEventQueueHealthResponse\x12J\n" +
+	"\x06counts\x18\x01 \x03(\v22.libops.v1.GetEventQueueHealthResponse.CountsEntryR\x06counts\x125\n" +
+	"\x17oldest_pending_event_id\x18\x02 \x01(\tR\x14oldestPendingEventId\x12;\n" +
+	"\x1aoldest_pending_age_seconds\x18\x03 \x01(\x03R\x17oldestPendingAgeSeconds\x12!\n" +
+	"\fstuck_events\x18\x04 \x01(\x03R\vstuckEvents\x12=\n" +
+	"\fdead_letters\x18\x05 \x03(\v2\x1a.libops.v1.DeadLetterEventR\vdeadLetters\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"'\n" +
+	"\x15LookupResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x02\n" +
+	"\rResourceMatch\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rmatched_field\x18\x04 \x01(\tR\fmatchedField\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\x12\x17\n" +
+	"\asite_id\x18\a \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"L\n" +
+	"\x16LookupResourceResponse\x122\n" +
+	"\amatches\x18\x01 \x03(\v2\x18.libops.v1.ResourceMatchR\amatches\"\x95\x02\n" +
+	"\x14ExplainAccessRequest\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12D\n" +
+	"\rresource_type\x18\x02 \x01(\x0e2\x1f.libops.v1.options.ResourceTypeR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12A\n" +
+	"\faccess_level\x18\x04 \x01(\x0e2\x1e.libops.v1.options.AccessLevelR\vaccessLevel\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x05 \x01(\tR\bapiKeyId\x12\x1b\n" +
+	"\tsource_ip\x18\x06 \x01(\tR\bsourceIp\"Y\n" +
+	"\x0fAccessCheckStep\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\xac\x01\n" +
+	"\x15ExplainAccessResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x120\n" +
+	"\x05steps\x18\x02 \x03(\v2\x1a.libops.v1.AccessCheckStepR\x05steps\x12/\n" +
+	"\x13permitting_policies\x18\x03 \x03(\tR\x12permittingPolicies\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"5\n" +
+	"\x1aGetControllerConfigRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\x8d\x01\n" +
+	"\x1bGetControllerConfigResponse\x123\n" +
+	"\x06config\x18\x01 \x01(\v2\x1b.libops.v1.ControllerConfigR\x06config\x129\n" +
+	"\teffective\x18\x02 \x01(\v2\x1b.libops.v1.ControllerConfigR\teffective\"m\n" +
+	"\x1dUpdateControllerConfigRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x123\n" +
+	"\x06config\x18\x02 \x01(\v2\x1b.libops.v1.ControllerConfigR\x06config\"U\n" +
+	"\x1eUpdateControllerConfigResponse\x123\n" +
+	"\x06config\x18\x01 \x01(\v2\x1b.libops.v1.ControllerConfigR\x06config\"8\n" +
+	"\x1dDeleteControllerConfigRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\" \n" +
+	"\x1eDeleteControllerConfigResponse\"<\n" +
+	"\x1dListControllerReleasesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\"Z\n" +
+	"\x1eListControllerReleasesResponse\x128\n" +
+	"\breleases\x18\x01 \x03(\v2\x1c.libops.v1.ControllerReleaseR\breleases\"X\n" +
+	"\x1eCreateControllerReleaseRequest\x126\n" +
+	"\arelease\x18\x01 \x01(\v2\x1c.libops.v1.ControllerReleaseR\arelease\"Y\n" +
+	"\x1fCreateControllerReleaseResponse\x126\n" +
+	"\arelease\x18\x01 \x01(\v2\x1c.libops.v1.ControllerReleaseR\arelease\"\x89\x01\n" +
+	"\x15TerraformStateVersion\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x01 \x01(\x03R\n" +
+	"generation\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x03R\tsizeBytes\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\x12\x12\n" +
+	"\x04live\x18\x04 \x01(\bR\x04live\"\xce\x01\n" +
+	"\x14TerraformStateBackup\x12\x1b\n" +
+	"\tbackup_id\x18\x01 \x01(\tR\bbackupId\x12\x16\n" +
+	"\x06object\x18\x02 \x01(\tR\x06object\x12+\n" +
+	"\x11source_generation\x18\x03 \x01(\x03R\x10sourceGeneration\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"a\n" +
+	"\x18ListStateVersionsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1c\n" +
+	"\tworkspace\x18\x02 \x01(\tR\tworkspace\"\xfd\x01\n" +
+	"\x19ListStateVersionsResponse\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12<\n" +
+	"\bversions\x18\x02 \x03(\v2 .libops.v1.TerraformStateVersionR\bversions\x129\n" +
+	"\abackups\x18\x03 \x03(\v2\x1f.libops.v1.TerraformStateBackupR\abackups\x12\x16\n" +
+	"\x06locked\x18\x04 \x01(\bR\x06locked\x127\n" +
+	"\x06layout\x18\x05 \x01(\x0e2\x1f.libops.v1.TerraformStateLayoutR\x06layout\"w\n" +
+	"\x16CopyStateBackupRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tworkspace\x18\x03 \x01(\tR\tworkspace\"R\n" +
+	"\x17CopyStateBackupResponse\x127\n" +
+	"\x06backup\x18\x01 \x01(\v2\x1f.libops.v1.TerraformStateBackupR\x06backup\"\xbf\x01\n" +
+	"\x13RestoreStateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12 \n" +
+	"\n" +
+	"generation\x18\x02 \x01(\x03H\x00R\n" +
+	"generation\x12\x1d\n" +
+	"\tbackup_id\x18\x03 \x01(\tH\x00R\bbackupId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tworkspace\x18\x05 \x01(\tR\tworkspaceB\b\n" +
+	"\x06source\"\x8b\x01\n" +
+	"\x14RestoreStateResponse\x126\n" +
+	"\x05state\x18\x01 \x01(\v2 .libops.v1.TerraformStateVersionR\x05state\x12;\n" +
+	"\bprevious\x18\x02 \x01(\v2\x1f.libops.v1.TerraformStateBackupR\bprevious\"`\n" +
+	"\x1dMigrateStateWorkspacesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"p\n" +
+	"\x1eMigrateStateWorkspacesResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x127\n" +
+	"\x06backup\x18\x02 \x01(\v2\x1f.libops.v1.TerraformStateBackupR\x06backup*\xb0\x01\n" +
+	"\x14TerraformStateLayout\x12&\n" +
+	"\"TERRAFORM_STATE_LAYOUT_UNSPECIFIED\x10\x00\x12%\n" +
+	"!TERRAFORM_STATE_LAYOUT_MONOLITHIC\x10\x01\x12$\n" +
+	" TERRAFORM_STATE_LAYOUT_MIGRATING\x10\x02\x12#\n" +
+	"\x1fTERRAFORM_STATE_LAYOUT_ISOLATED\x10\x032\xf0\x16\n" +
+	"\fAdminService\x12\x92\x01\n" +
+	"\x19ListPlatformOrganizations\x12+.libops.v1.ListPlatformOrganizationsRequest\x1a,.libops.v1.ListPlatformOrganizationsResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12~\n" +
+	"\x13ForceReconciliation\x12%.libops.v1.ForceReconciliationRequest\x1a&.libops.v1.ForceReconciliationResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12o\n" +
+	"\x0eReconcileFleet\x12 .libops.v1.ReconcileFleetRequest\x1a!.libops.v1.ReconcileFleetResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x89\x01\n" +
+	"\x16ListReconciliationRuns\x12(.libops.v1.ListReconciliationRunsRequest\x1a).libops.v1.ListReconciliationRunsResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12\x92\x01\n" +
+	"\x19DescribeReconciliationRun\x12+.libops.v1.DescribeReconciliationRunRequest\x1a,.libops.v1.DescribeReconciliationRunResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12\x87\x01\n" +
+	"\x16RetryReconciliationRun\x12(.libops.v1.RetryReconciliationRunRequest\x1a).libops.v1.RetryReconciliationRunResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x9f\x01\n" +
+	"\x1eMarkReconciliationRunTriggered\x120.libops.v1.MarkReconciliationRunTriggeredRequest\x1a1.libops.v1.MarkReconciliationRunTriggeredResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x8a\x01\n" +
+	"\x17CancelReconciliationRun\x12).libops.v1.CancelReconciliationRunRequest\x1a*.libops.v1.CancelReconciliationRunResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12~\n" +
+	"\x13SuspendOrganization\x12%.libops.v1.SuspendOrganizationRequest\x1a&.libops.v1.SuspendOrganizationResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x84\x01\n" +
+	"\x15UnsuspendOrganization\x12'.libops.v1.UnsuspendOrganizationRequest\x1a(.libops.v1.UnsuspendOrganizationResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x80\x01\n" +
+	"\x13GetEventQueueHealth\x12%.libops.v1.GetEventQueueHealthRequest\x1a&.libops.v1.GetEventQueueHealthResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12q\n" +
+	"\x0eLookupResource\x12 .libops.v1.LookupResourceRequest\x1a!.libops.v1.LookupResourceResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12n\n" +
+	"\rExplainAccess\x12\x1f.libops.v1.ExplainAccessRequest\x1a .libops.v1.ExplainAccessResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12\x80\x01\n" +
+	"\x13GetControllerConfig\x12%.libops.v1.GetControllerConfigRequest\x1a&.libops.v1.GetControllerConfigResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12\x87\x01\n" +
+	"\x16UpdateControllerConfig\x12(.libops.v1.UpdateControllerConfigRequest\x1a).libops.v1.UpdateControllerConfigResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x87\x01\n" +
+	"\x16DeleteControllerConfig\x12(.libops.v1.DeleteControllerConfigRequest\x1a).libops.v1.DeleteControllerConfigResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x89\x01\n" +
+	"\x16ListControllerReleases\x12(.libops.v1.ListControllerReleasesRequest\x1a).libops.v1.ListControllerReleasesResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12\x8a\x01\n" +
+	"\x17CreateControllerRelease\x12).libops.v1.CreateControllerReleaseRequest\x1a*.libops.v1.CreateControllerReleaseResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12z\n" +
+	"\x11ListStateVersions\x12#.libops.v1.ListStateVersionsRequest\x1a$.libops.v1.ListStateVersionsResponse\"\x1a\x92\xb5\x18\x13\b\x01\x10\x01\"\rread:platform\x90\x02\x01\x12r\n" +
+	"\x0fCopyStateBackup\x12!.libops.v1.CopyStateBackupRequest\x1a\".libops.v1.CopyStateBackupResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12i\n" +
+	"\fRestoreState\x12\x1e.libops.v1.RestoreStateRequest\x1a\x1f.libops.v1.RestoreStateResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platform\x12\x87\x01\n" +
+	"\x16MigrateStateWorkspaces\x12(.libops.v1.MigrateStateWorkspacesRequest\x1a).libops.v1.MigrateStateWorkspacesResponse\"\x18\x92\xb5\x18\x14\b\x01\x10\x02\"\x0ewrite:platformB\x97\x01\n" +
+	"\rcom.libops.v1B\x11AdminConsoleProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_admin_console_proto_rawDescOnce sync.Once
+	file_libops_v1_admin_console_proto_rawDescData []byte
+)
+
+func file_libops_v1_admin_console_proto_rawDescGZIP() []byte {
+	file_libops_v1_admin_console_proto_rawDescOnce.Do(func() {
+		file_libops_v1_admin_console_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_admin_console_proto_rawDesc), len(file_libops_v1_admin_console_proto_rawDesc)))
+	})
+	return file_libops_v1_admin_console_proto_rawDescData
+}
+
+var file_libops_v1_admin_console_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_admin_console_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_libops_v1_admin_console_proto_goTypes = []any{
+	(TerraformStateLayout)(0),                      // 0: libops.v1.TerraformStateLayout
+	(*PlatformOrganization)(nil),                   // 1: libops.v1.PlatformOrganization
+	(*ListPlatformOrganizationsRequest)(nil),       // 2: libops.v1.ListPlatformOrganizationsRequest
+	(*ListPlatformOrganizationsResponse)(nil),      // 3: libops.v1.ListPlatformOrganizationsResponse
+	(*ForceReconciliationRequest)(nil),             // 4: libops.v1.ForceReconciliationRequest
+	(*ForceReconciliationResponse)(nil),            // 5: libops.v1.ForceReconciliationResponse
+	(*ReconcileFleetRequest)(nil),                  // 6: libops.v1.ReconcileFleetRequest
+	(*ReconcileFleetResponse)(nil),                 // 7: libops.v1.ReconcileFleetResponse
+	(*FleetReconcileFailure)(nil),                  // 8: libops.v1.FleetReconcileFailure
+	(*PlatformReconciliationRun)(nil),              // 9: libops.v1.PlatformReconciliationRun
+	(*ListReconciliationRunsRequest)(nil),          // 10: libops.v1.ListReconciliationRunsRequest
+	(*ListReconciliationRunsResponse)(nil),         // 11: libops.v1.ListReconciliationRunsResponse
+	(*DescribeReconciliationRunRequest)(nil),       // 12: libops.v1.DescribeReconciliationRunRequest
+	(*ReconciliationRunResult)(nil),                // 13: libops.v1.ReconciliationRunResult
+	(*DescribeReconciliationRunResponse)(nil),      // 14: libops.v1.DescribeReconciliationRunResponse
+	(*RetryReconciliationRunRequest)(nil),          // 15: libops.v1.RetryReconciliationRunRequest
+	(*RetryReconciliationRunResponse)(nil),         // 16: libops.v1.RetryReconciliationRunResponse
+	(*MarkReconciliationRunTriggeredRequest)(nil),  // 17: libops.v1.MarkReconciliationRunTriggeredRequest
+	(*MarkReconciliationRunTriggeredResponse)(nil), // 18: libops.v1.MarkReconciliationRunTriggeredResponse
+	(*CancelReconciliationRunRequest)(nil),         // 19: libops.v1.CancelReconciliationRunRequest
+	(*CancelReconciliationRunResponse)(nil),        // 20: libops.v1.CancelReconciliationRunResponse
+	(*SuspendOrganizationRequest)(nil),             // 21: libops.v1.SuspendOrganizationRequest
+	(*SuspendOrganizationResponse)(nil),            // 22: libops.v1.SuspendOrganizationResponse
+	(*UnsuspendOrganizationRequest)(nil),           // 23: libops.v1.UnsuspendOrganizationRequest
+	(*UnsuspendOrganizationResponse)(nil),          // 24: libops.v1.UnsuspendOrganizationResponse
+	(*GetEventQueueHealthRequest)(nil),             // 25: libops.v1.GetEventQueueHealthRequest
+	(*DeadLetterEvent)(nil),                        // 26: libops.v1.DeadLetterEvent
+	(*GetEventQueueHealthResponse)(nil),            // 27: libops.v1.GetEventQueueHealthResponse
+	(*LookupResourceRequest)(nil),                  // 28: libops.v1.LookupResourceRequest
+	(*ResourceMatch)(nil),                          // 29: libops.v1.ResourceMatch
+	(*LookupResourceResponse)(nil),                 // 30: libops.v1.LookupResourceResponse
+	(*ExplainAccessRequest)(nil),                   // 31: libops.v1.ExplainAccessRequest
+	(*AccessCheckStep)(nil),                        // 32: libops.v1.AccessCheckStep
+	(*ExplainAccessResponse)(nil),                  // 33: libops.v1.ExplainAccessResponse
+	(*GetControllerConfigRequest)(nil),             // 34: libops.v1.GetControllerConfigRequest
+	(*GetControllerConfigResponse)(nil),            // 35: libops.v1.GetControllerConfigResponse
+	(*UpdateControllerConfigRequest)(nil),          // 36: libops.v1.UpdateControllerConfigRequest
+	(*UpdateControllerConfigResponse)(nil),         // 37: libops.v1.UpdateControllerConfigResponse
+	(*DeleteControllerConfigRequest)(nil),          // 38: libops.v1.DeleteControllerConfigRequest
+	(*DeleteControllerConfigResponse)(nil),         // 39: libops.v1.DeleteControllerConfigResponse
+	(*ListControllerReleasesRequest)(nil),          // 40: libops.v1.ListControllerReleasesRequest
+	(*ListControllerReleasesResponse)(nil),         // 41: libops.v1.ListControllerReleasesResponse
+	(*CreateControllerReleaseRequest)(nil),         // 42: libops.v1.CreateControllerReleaseRequest
+	(*CreateControllerReleaseResponse)(nil),        // 43: libops.v1.CreateControllerReleaseResponse
+	(*TerraformStateVersion)(nil),                  // 44: libops.v1.TerraformStateVersion
+	(*TerraformStateBackup)(nil),                   // 45: libops.v1.TerraformStateBackup
+	(*ListStateVersionsRequest)(nil),               // 46: libops.v1.ListStateVersionsRequest
+	(*ListStateVersionsResponse)(nil),              // 47: libops.v1.ListStateVersionsResponse
+	(*CopyStateBackupRequest)(nil),                 // 48: libops.v1.CopyStateBackupRequest
+	(*CopyStateBackupResponse)(nil),                // 49: libops.v1.CopyStateBackupResponse
+	(*RestoreStateRequest)(nil),                    // 50: libops.v1.RestoreStateRequest
+	(*RestoreStateResponse)(nil),                   // 51: libops.v1.RestoreStateResponse
+	(*MigrateStateWorkspacesRequest)(nil),          // 52: libops.v1.MigrateStateWorkspacesRequest
+	(*MigrateStateWorkspacesResponse)(nil),         // 53: libops.v1.MigrateStateWorkspacesResponse
+	nil,                                            // 54: libops.v1.GetEventQueueHealthResponse.CountsEntry
+	(common.Status)(0),                             // 55: libops.v1.common.Status
+	(*FleetFilter)(nil),                            // 56: libops.v1.FleetFilter
+	(options.ResourceType)(0),                      // 57: libops.v1.options.ResourceType
+	(options.AccessLevel)(0),                       // 58: libops.v1.options.AccessLevel
+	(*ControllerConfig)(nil),                       // 59: libops.v1.ControllerConfig
+	(*ControllerRelease)(nil),                      // 60: libops.v1.ControllerRelease
+}
+var file_libops_v1_admin_console_proto_depIdxs = []int32{
+	55, // 0: libops.v1.PlatformOrganization.status:type_name -> libops.v1.common.Status
+	55, // 1: libops.v1.ListPlatformOrganizationsRequest.status:type_name -> libops.v1.common.Status
+	1,  // 2: libops.v1.ListPlatformOrganizationsResponse.organizations:type_name -> libops.v1.PlatformOrganization
+	56, // 3: libops.v1.ReconcileFleetRequest.filter:type_name -> libops.v1.FleetFilter
+	8,  // 4: libops.v1.ReconcileFleetResponse.failures:type_name -> libops.v1.FleetReconcileFailure
+	9,  // 5: libops.v1.ListReconciliationRunsResponse.runs:type_name -> libops.v1.PlatformReconciliationRun
+	9,  // 6: libops.v1.DescribeReconciliationRunResponse.run:type_name -> libops.v1.PlatformReconciliationRun
+	13, // 7: libops.v1.DescribeReconciliationRunResponse.results:type_name -> libops.v1.ReconciliationRunResult
+	9,  // 8: libops.v1.RetryReconciliationRunResponse.run:type_name -> libops.v1.PlatformReconciliationRun
+	9,  // 9: libops.v1.MarkReconciliationRunTriggeredResponse.run:type_name -> libops.v1.PlatformReconciliationRun
+	9,  // 10: libops.v1.CancelReconciliationRunResponse.run:type_name -> libops.v1.PlatformReconciliationRun
+	1,  // 11: libops.v1.SuspendOrganizationResponse.organization:type_name -> libops.v1.PlatformOrganization
+	1,  // 12: libops.v1.UnsuspendOrganizationResponse.organization:type_name -> libops.v1.PlatformOrganization
+	54, // 13: libops.v1.GetEventQueueHealthResponse.counts:type_name -> libops.v1.GetEventQueueHealthResponse.CountsEntry
+	26, // 14: libops.v1.GetEventQueueHealthResponse.dead_letters:type_name -> libops.v1.DeadLetterEvent
+	29, // 15: libops.v1.LookupResourceResponse.matches:type_name -> libops.v1.ResourceMatch
+	57, // 16: libops.v1.ExplainAccessRequest.resource_type:type_name -> libops.v1.options.ResourceType
+	58, // 17: libops.v1.ExplainAccessRequest.access_level:type_name -> libops.v1.options.AccessLevel
+	32, // 18: libops.v1.ExplainAccessResponse.steps:type_name -> libops.v1.AccessCheckStep
+	59, // 19: libops.v1.GetControllerConfigResponse.config:type_name -> libops.v1.ControllerConfig
+	59, // 20: libops.v1.GetControllerConfigResponse.effective:type_name -> libops.v1.ControllerConfig
+	59, // 21: libops.v1.UpdateControllerConfigRequest.config:type_name -> libops.v1.ControllerConfig
+	59, // 22: libops.v1.UpdateControllerConfigResponse.config:type_name -> libops.v1.ControllerConfig
+	60, // 23: libops.v1.ListControllerReleasesResponse.releases:type_name -> libops.v1.ControllerRelease
+	60, // 24: libops.v1.CreateControllerReleaseRequest.release:type_name -> libops.v1.ControllerRelease
+	60, // 25: libops.v1.CreateControllerReleaseResponse.release:type_name -> libops.v1.ControllerRelease
+	44, // 26: libops.v1.ListStateVersionsResponse.versions:type_name -> libops.v1.TerraformStateVersion
+	45, // 27: libops.v1.ListStateVersionsResponse.backups:type_name -> libops.v1.TerraformStateBackup
+	0,  // 28: libops.v1.ListStateVersionsResponse.layout:type_name -> libops.v1.TerraformStateLayout
+	45, // 29: libops.v1.CopyStateBackupResponse.backup:type_name -> libops.v1.TerraformStateBackup
+	44, // 30: libops.v1.RestoreStateResponse.state:type_name -> libops.v1.TerraformStateVersion
+	45, // 31: libops.v1.RestoreStateResponse.previous:type_name -> libops.v1.TerraformStateBackup
+	45, // 32: libops.v1.MigrateStateWorkspacesResponse.backup:type_name -> libops.v1.TerraformStateBackup
+	2,  // 33: libops.v1.AdminService.ListPlatformOrganizations:input_type -> libops.v1.ListPlatformOrganizationsRequest
+	4,  // 34: libops.v1.AdminService.ForceReconciliation:input_type -> libops.v1.ForceReconciliationRequest
+	6,  // 35: libops.v1.AdminService.ReconcileFleet:input_type -> libops.v1.ReconcileFleetRequest
+	10, // 36: libops.v1.AdminService.ListReconciliationRuns:input_type -> libops.v1.ListReconciliationRunsRequest
+	12, // 37: libops.v1.AdminService.DescribeReconciliationRun:input_type -> libops.v1.DescribeReconciliationRunRequest
+	15, // 38: libops.v1.AdminService.RetryReconciliationRun:input_type -> libops.v1.RetryReconciliationRunRequest
+	17, // 39: libops.v1.AdminService.MarkReconciliationRunTriggered:input_type -> libops.v1.MarkReconciliationRunTriggeredRequest
+	19, // 40: libops.v1.AdminService.CancelReconciliationRun:input_type -> libops.v1.CancelReconciliationRunRequest
+	21, // 41: libops.v1.AdminService.SuspendOrganization:input_type -> libops.v1.SuspendOrganizationRequest
+	23, // 42: libops.v1.AdminService.UnsuspendOrganization:input_type -> libops.v1.UnsuspendOrganizationRequest
+	25, // 43: libops.v1.AdminService.GetEventQueueHealth:input_type -> libops.v1.GetEventQueueHealthRequest
+	28, // 44: libops.v1.AdminService.LookupResource:input_type -> libops.v1.LookupResourceRequest
+	31, // 45: libops.v1.AdminService.ExplainAccess:input_type -> libops.v1.ExplainAccessRequest
+	34, // 46: libops.v1.AdminService.GetControllerConfig:input_type -> libops.v1.GetControllerConfigRequest
+	36, // 47: libops.v1.AdminService.UpdateControllerConfig:input_type -> libops.v1.UpdateControllerConfigRequest
+	38, // 48: libops.v1.AdminService.DeleteControllerConfig:input_type -> libops.v1.DeleteControllerConfigRequest
+	40, // 49: libops.v1.AdminService.ListControllerReleases:input_type -> libops.v1.ListControllerReleasesRequest
+	42, // 50: libops.v1.AdminService.CreateControllerRelease:input_type -> libops.v1.CreateControllerReleaseRequest
+	46, // 51: libops.v1.AdminService.ListStateVersions:input_type -> libops.v1.ListStateVersionsRequest
+	48, // 52: libops.v1.AdminService.CopyStateBackup:input_type -> libops.v1.CopyStateBackupRequest
+	50, // 53: libops.v1.AdminService.RestoreState:input_type -> libops.v1.RestoreStateRequest
+	52, // 54: libops.v1.AdminService.MigrateStateWorkspaces:input_type -> libops.v1.MigrateStateWorkspacesRequest
+	3,  // 55: libops.v1.AdminService.ListPlatformOrganizations:output_type -> libops.v1.ListPlatformOrganizationsResponse
+	5,  // 56: libops.v1.AdminService.ForceReconciliation:output_type -> libops.v1.ForceReconciliationResponse
+	7,  // 57: libops.v1.AdminService.ReconcileFleet:output_type -> libops.v1.ReconcileFleetResponse
+	11, // 58: libops.v1.AdminService.ListReconciliationRuns:output_type -> libops.v1.ListReconciliationRunsResponse
+	14, // 59: libops.v1.AdminService.DescribeReconciliationRun:output_type -> libops.v1.DescribeReconciliationRunResponse
+	16, // 60: libops.v1.AdminService.RetryReconciliationRun:output_type -> libops.v1.RetryReconciliationRunResponse
+	18, // 61: libops.v1.AdminService.MarkReconciliationRunTriggered:output_type -> libops.v1.MarkReconciliationRunTriggeredResponse
+	20, // 62: libops.v1.AdminService.CancelReconciliationRun:output_type -> libops.v1.CancelReconciliationRunResponse
+	22, // 63: libops.v1.AdminService.SuspendOrganization:output_type -> libops.v1.SuspendOrganizationResponse
+	24, // 64: libops.v1.AdminService.UnsuspendOrganization:output_type -> libops.v1.UnsuspendOrganizationResponse
+	27, // 65: libops.v1.AdminService.GetEventQueueHealth:output_type -> libops.v1.GetEventQueueHealthResponse
+	30, // 66: libops.v1.AdminService.LookupResource:output_type -> libops.v1.LookupResourceResponse
+	33, // 67: libops.v1.AdminService.ExplainAccess:output_type -> libops.v1.ExplainAccessResponse
+	35, // 68: libops.v1.AdminService.GetControllerConfig:output_type -> libops.v1.GetControllerConfigResponse
+	37, // 69: libops.v1.AdminService.UpdateControllerConfig:output_type -> libops.v1.UpdateControllerConfigResponse
+	39, // 70: libops.v1.AdminService.DeleteControllerConfig:output_type -> libops.v1.DeleteControllerConfigResponse
+	41, // 71: libops.v1.AdminService.ListControllerReleases:output_type -> libops.v1.ListControllerReleasesResponse
+	43, // 72: libops.v1.AdminService.CreateControllerRelease:output_type -> libops.v1.CreateControllerReleaseResponse
+	47, // 73: libops.v1.AdminService.ListStateVersions:output_type -> libops.v1.ListStateVersionsResponse
+	49, // 74: libops.v1.AdminService.CopyStateBackup:output_type -> libops.v1.CopyStateBackupResponse
+	51, // 75: libops.v1.AdminService.RestoreState:output_type -> libops.v1.RestoreStateResponse
+	53, // 76: libops.v1.AdminService.MigrateStateWorkspaces:output_type -> libops.v1.MigrateStateWorkspacesResponse
+	55, // [55:77] is the sub-list for method output_type
+	33, // [33:55] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_admin_console_proto_init() }
+func file_libops_v1_admin_console_proto_init() {
+	if File_libops_v1_admin_console_proto != nil {
+		return
+	}
+	file_libops_v1_admin_api_proto_init()
+	file_libops_v1_fleet_proto_init()
+	file_libops_v1_admin_console_proto_msgTypes[1].OneofWrappers = []any{}
+	file_libops_v1_admin_console_proto_msgTypes[3].OneofWrappers = []any{
+		(*ForceReconciliationRequest_OrganizationId)(nil),
+		(*ForceReconciliationRequest_ProjectId)(nil),
+		(*ForceReconciliationRequest_SiteId)(nil),
+	}
+	file_libops_v1_admin_console_proto_msgTypes[9].OneofWrappers = []any{}
+	file_libops_v1_admin_console_proto_msgTypes[49].OneofWrappers = []any{
+		(*RestoreStateRequest_Generation)(nil),
+		(*RestoreStateRequest_BackupId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_admin_console_proto_rawDesc), len(file_libops_v1_admin_console_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_admin_console_proto_goTypes,
+		DependencyIndexes: file_libops_v1_admin_console_proto_depIdxs,
+		EnumInfos:         file_libops_v1_admin_console_proto_enumTypes,
+		MessageInfos:      file_libops_v1_admin_console_proto_msgTypes,
+	}.Build()
+	File_libops_v1_admin_console_proto = out.File
+	file_libops_v1_admin_console_proto_goTypes = nil
+	file_libops_v1_admin_console_proto_depIdxs = nil
+}
@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/branding.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrganizationBranding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	PrimaryColor   string                 `protobuf:"bytes,2,opt,name=primary_color,json=primaryColor,proto3" json:"primary_color,omitempty"`       // Hex color of buttons and headers, e.g. "#1d4ed8"; empty for the default
+	AccentColor    string                 `protobuf:"bytes,3,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"`          // Hex color of links and highlights; empty for the default
+	LogoUrl        string                 `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                      // Public URL of the logo, empty when the organization has none
+	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // Unix timestamp, 0 when the organization was never branded
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationBranding) Reset() {
+	*x = OrganizationBranding{}
+	mi := &file_libops_v1_branding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationBranding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationBranding) ProtoMessage() {}
+
+func (x *OrganizationBranding) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_branding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationBranding.ProtoReflect.Descriptor instead.
+func (*OrganizationBranding) Descriptor() ([]byte, []int) {
+	return file_libops_v1_branding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrganizationBranding) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationBranding) GetPrimaryColor() string {
+	if x != nil {
+		return x.PrimaryColor
+	}
+	return ""
+}
+
+func (x *OrganizationBranding) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *OrganizationBranding) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *OrganizationBranding) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetOrganizationBrandingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationBrandingRequest) Reset() {
+	*x = GetOrganizationBrandingRequest{}
+	mi := &file_libops_v1_branding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationBrandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationBrandingRequest) ProtoMessage() {}
+
+func (x *GetOrganizationBrandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_branding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationBrandingRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationBrandingRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_branding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOrganizationBrandingRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationBrandingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branding      *OrganizationBranding  `protobuf:"bytes,1,opt,name=branding,proto3" json:"branding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationBrandingResponse) Reset() {
+	*x = GetOrganizationBrandingResponse{}
+	mi := &file_libops_v1_branding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationBrandingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationBrandingResponse) ProtoMessage() {}
+
+func (x *GetOrganizationBrandingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_branding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationBrandingResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationBrandingResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_branding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOrganizationBrandingResponse) GetBranding() *OrganizationBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
+type UpdateOrganizationBrandingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PrimaryColor   *string                `protobuf:"bytes,2,opt,name=primary_color,json=primaryColor,proto3,oneof" json:"primary_color,omitempty"` // Empty restores the default
+	AccentColor    *string                `protobuf:"bytes,3,opt,name=accent_color,json=accentColor,proto3,oneof" json:"accent_color,omitempty"`    // Empty restores the default
+	// PNG or JPEG image of at most 256 KiB, shown at 32 pixels high. Empty removes the logo.
+	Logo []byte `protobuf:"bytes,4,opt,name=logo,proto3,oneof" json:"logo,omitempty"`
+	// Paths: primary_color, accent_color, logo.
+	// Without a mask every set field is applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationBrandingRequest) Reset() {
+	*x = UpdateOrganizationBrandingRequest{}
+	mi := &file_libops_v1_branding_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationBrandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationBrandingRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationBrandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_branding_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationBrandingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationBrandingRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_branding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateOrganizationBrandingRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationBrandingRequest) GetPrimaryColor() string {
+	if x != nil && x.PrimaryColor != nil {
+		return *x.PrimaryColor
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationBrandingRequest) GetAccentColor() string {
+	if x != nil && x.AccentColor != nil {
+		return *x.AccentColor
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationBrandingRequest) GetLogo() []byte {
+	if x != nil {
+		return x.Logo
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationBrandingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateOrganizationBrandingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branding      *OrganizationBranding  `protobuf:"bytes,1,opt,name=branding,proto3" json:"branding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationBrandingResponse) Reset() {
+	*x = UpdateOrganizationBrandingResponse{}
+	mi := &file_libops_v1_branding_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationBrandingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationBrandingResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationBrandingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_branding_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationBrandingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationBrandingResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_branding_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateOrganizationBrandingResponse) GetBranding() *OrganizationBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
+var File_libops_v1_branding_proto protoreflect.FileDescriptor
+
+const file_libops_v1_branding_proto_rawDesc = "" +
+	"\n" +
+	"\x18libops/v1/branding.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/scope.proto\"\xc1\x01\n" +
+	"\x14OrganizationBranding\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12#\n" +
+	"\rprimary_color\x18\x02 \x01(\tR\fprimaryColor\x12!\n" +
+	"\faccent_color\x18\x03 \x01(\tR\vaccentColor\x12\x19\n" +
+	"\blogo_url\x18\x04 \x01(\tR\alogoUrl\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"I\n" +
+	"\x1eGetOrganizationBrandingRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"^\n" +
+	"\x1fGetOrganizationBrandingResponse\x12;\n" +
+	"\bbranding\x18\x01 \x01(\v2\x1f.libops.v1.OrganizationBrandingR\bbranding\"\xa0\x02\n" +
+	"!UpdateOrganizationBrandingRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12(\n" +
+	"\rprimary_color\x18\x02 \x01(\tH\x00R\fprimaryColor\x88\x01\x01\x12&\n" +
+	"\faccent_color\x18\x03 \x01(\tH\x01R\vaccentColor\x88\x01\x01\x12\x17\n" +
+	"\x04logo\x18\x04 \x01(\fH\x02R\x04logo\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\x10\n" +
+	"\x0e_primary_colorB\x0f\n" +
+	"\r_accent_colorB\a\n" +
+	"\x05_logo\"a\n" +
+	"\"UpdateOrganizationBrandingResponse\x12;\n" +
+	"\bbranding\x18\x01 \x01(\v2\x1f.libops.v1.OrganizationBrandingR\bbranding2\xcf\x03\n" +
+	"\x0fBrandingService\x12\xd7\x01\n" +
+	"\x17GetOrganizationBranding\x12).libops.v1.GetOrganizationBrandingRequest\x1a*.libops.v1.GetOrganizationBrandingResponse\"e\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_id}/branding\x90\x02\x01\x12\xe1\x01\n" +
+	"\x1aUpdateOrganizationBranding\x12,.libops.v1.UpdateOrganizationBrandingRequest\x1a-.libops.v1.UpdateOrganizationBrandingResponse\"f\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x021:\x01*2,/v1/organizations/{organization_id}/brandingB\x93\x01\n" +
+	"\rcom.libops.v1B\rBrandingProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_branding_proto_rawDescOnce sync.Once
+	file_libops_v1_branding_proto_rawDescData []byte
+)
+
+func file_libops_v1_branding_proto_rawDescGZIP() []byte {
+	file_libops_v1_branding_proto_rawDescOnce.Do(func() {
+		file_libops_v1_branding_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_branding_proto_rawDesc), len(file_libops_v1_branding_proto_rawDesc)))
+	})
+	return file_libops_v1_branding_proto_rawDescData
+}
+
+var file_libops_v1_branding_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_libops_v1_branding_proto_goTypes = []any{
+	(*OrganizationBranding)(nil),               // 0: libops.v1.OrganizationBranding
+	(*GetOrganizationBrandingRequest)(nil),     // 1: libops.v1.GetOrganizationBrandingRequest
+	(*GetOrganizationBrandingResponse)(nil),    // 2: libops.v1.GetOrganizationBrandingResponse
+	(*UpdateOrganizationBrandingRequest)(nil),  // 3: libops.v1.UpdateOrganizationBrandingRequest
+	(*UpdateOrganizationBrandingResponse)(nil), // 4: libops.v1.UpdateOrganizationBrandingResponse
+	(*fieldmaskpb.FieldMask)(nil),              // 5: google.protobuf.FieldMask
+}
+var file_libops_v1_branding_proto_depIdxs = []int32{
+	0, // 0: libops.v1.GetOrganizationBrandingResponse.branding:type_name -> libops.v1.OrganizationBranding
+	5, // 1: libops.v1.UpdateOrganizationBrandingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0, // 2: libops.v1.UpdateOrganizationBrandingResponse.branding:type_name -> libops.v1.OrganizationBranding
+	1, // 3: libops.v1.BrandingService.GetOrganizationBranding:input_type -> libops.v1.GetOrganizationBrandingRequest
+	3, // 4: libops.v1.BrandingService.UpdateOrganizationBranding:input_type -> libops.v1.UpdateOrganizationBrandingRequest
+	2, // 5: libops.v1.BrandingService.GetOrganizationBranding:output_type -> libops.v1.GetOrganizationBrandingResponse
+	4, // 6: libops.v1.BrandingService.UpdateOrganizationBranding:output_type -> libops.v1.UpdateOrganizationBrandingResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_branding_proto_init() }
+func file_libops_v1_branding_proto_init() {
+	if File_libops_v1_branding_proto != nil {
+		return
+	}
+	file_libops_v1_branding_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_branding_proto_rawDesc), len(file_libops_v1_branding_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_branding_proto_goTypes,
+		DependencyIndexes: file_libops_v1_branding_proto_depIdxs,
+		MessageInfos:      file_libops_v1_branding_proto_msgTypes,
+	}.Build()
+	File_libops_v1_branding_proto = out.File
+	file_libops_v1_branding_proto_goTypes = nil
+	file_libops_v1_branding_proto_depIdxs = nil
+}
@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/catalog.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRegionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only list regions in this country grouping (e.g., "us", "eu")
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	// Only list regions where this machine type is available (e.g., "n4-standard-2")
+	MachineType string `protobuf:"bytes,2,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	// Only list regions the organization's data residency allows
+	OrganizationId string `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_libops_v1_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRegionsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ListRegionsRequest) GetMachineType() string {
+	if x != nil {
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *ListRegionsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*RegionCountry       `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_libops_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRegionsResponse) GetCountries() []*RegionCountry {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+// RegionCountry groups the regions of a country or area
+type RegionCountry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Regions       []*Region              `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionCountry) Reset() {
+	*x = RegionCountry{}
+	mi := &file_libops_v1_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionCountry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionCountry) ProtoMessage() {}
+
+func (x *RegionCountry) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionCountry.ProtoReflect.Descriptor instead.
+func (*RegionCountry) Descriptor() ([]byte, []int) {
+	return file_libops_v1_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegionCountry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RegionCountry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RegionCountry) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type Region struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GCP region code (e.g., "us-central1")
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Country     string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	// Approximate location, so clients can suggest the nearest region
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// GCP compute cost relative to the cheapest region (1.0). Display only:
+	// projects are billed at the machine type's price in every region.
+	RelativePrice float64 `protobuf:"fixed64,6,opt,name=relative_price,json=relativePrice,proto3" json:"relative_price,omitempty"`
+	// Machine types that can be provisioned in this region
+	MachineTypes  []*RegionMachineType `protobuf:"bytes,7,rep,name=machine_types,json=machineTypes,proto3" json:"machine_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	mi := &file_libops_v1_catalog_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_catalog_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_libops_v1_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Region) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Region) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Region) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Region) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Region) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Region) GetRelativePrice() float64 {
+	if x != nil {
+		return x.RelativePrice
+	}
+	return 0
+}
+
+func (x *Region) GetMachineTypes() []*RegionMachineType {
+	if x != nil {
+		return x.MachineTypes
+	}
+	return nil
+}
+
+type RegionMachineType struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MachineType       string                 `protobuf:"bytes,1,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Vcpu              int32                  `protobuf:"varint,3,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	MemoryGib         int32                  `protobuf:"varint,4,opt,name=memory_gib,json=memoryGib,proto3" json:"memory_gib,omitempty"`
+	MonthlyPriceCents int32                  `protobuf:"varint,5,opt,name=monthly_price_cents,json=monthlyPriceCents,proto3" json:"monthly_price_cents,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RegionMachineType) Reset() {
+	*x = RegionMachineType{}
+	mi := &file_libops_v1_catalog_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionMachineType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionMachineType) ProtoMessage() {}
+
+func (x *RegionMachineType) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_catalog_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionMachineType.ProtoReflect.Descriptor instead.
+func (*RegionMachineType) Descriptor() ([]byte, []int) {
+	return file_libops_v1_catalog_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegionMachineType) GetMachineType() string {
+	if x != nil {
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *RegionMachineType) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RegionMachineType) GetVcpu() int32 {
+	if x != nil {
+		return x.Vcpu
+	}
+	return 0
+}
+
+func (x *RegionMachineType) GetMemoryGib() int32 {
+	if x != nil {
+		return x.MemoryGib
+	}
+	return 0
+}
+
+func (x *RegionMachineType) GetMonthlyPriceCents() int32 {
+	if x != nil {
+		return x.MonthlyPriceCents
+	}
+	return 0
+}
+
+var File_libops_v1_catalog_proto protoreflect.FileDescriptor
+
+const file_libops_v1_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x17libops/v1/catalog.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"z\n" +
+	"\x12ListRegionsRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12!\n" +
+	"\fmachine_type\x18\x02 \x01(\tR\vmachineType\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"M\n" +
+	"\x13ListRegionsResponse\x126\n" +
+	"\tcountries\x18\x01 \x03(\v2\x18.libops.v1.RegionCountryR\tcountries\"s\n" +
+	"\rRegionCountry\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12+\n" +
+	"\aregions\x18\x03 \x03(\v2\x11.libops.v1.RegionR\aregions\"\xfd\x01\n" +
+	"\x06Region\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12%\n" +
+	"\x0erelative_price\x18\x06 \x01(\x01R\rrelativePrice\x12A\n" +
+	"\rmachine_types\x18\a \x03(\v2\x1c.libops.v1.RegionMachineTypeR\fmachineTypes\"\xbc\x01\n" +
+	"\x11RegionMachineType\x12!\n" +
+	"\fmachine_type\x18\x01 \x01(\tR\vmachineType\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x12\n" +
+	"\x04vcpu\x18\x03 \x01(\x05R\x04vcpu\x12\x1d\n" +
+	"\n" +
+	"memory_gib\x18\x04 \x01(\x05R\tmemoryGib\x12.\n" +
+	"\x13monthly_price_cents\x18\x05 \x01(\x05R\x11monthlyPriceCents2\x8b\x01\n" +
+	"\x0eCatalogService\x12y\n" +
+	"\vListRegions\x12\x1d.libops.v1.ListRegionsRequest\x1a\x1e.libops.v1.ListRegionsResponse\"+\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:user\x82\xd3\xe4\x93\x02\r\x12\v/v1/regions\x90\x02\x01B\x92\x01\n" +
+	"\rcom.libops.v1B\fCatalogProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_catalog_proto_rawDescOnce sync.Once
+	file_libops_v1_catalog_proto_rawDescData []byte
+)
+
+func file_libops_v1_catalog_proto_rawDescGZIP() []byte {
+	file_libops_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_libops_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_catalog_proto_rawDesc), len(file_libops_v1_catalog_proto_rawDesc)))
+	})
+	return file_libops_v1_catalog_proto_rawDescData
+}
+
+var file_libops_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_libops_v1_catalog_proto_goTypes = []any{
+	(*ListRegionsRequest)(nil),  // 0: libops.v1.ListRegionsRequest
+	(*ListRegionsResponse)(nil), // 1: libops.v1.ListRegionsResponse
+	(*RegionCountry)(nil),       // 2: libops.v1.RegionCountry
+	(*Region)(nil),              // 3: libops.v1.Region
+	(*RegionMachineType)(nil),   // 4: libops.v1.RegionMachineType
+}
+var file_libops_v1_catalog_proto_depIdxs = []int32{
+	2, // 0: libops.v1.ListRegionsResponse.countries:type_name -> libops.v1.RegionCountry
+	3, // 1: libops.v1.RegionCountry.regions:type_name -> libops.v1.Region
+	4, // 2: libops.v1.Region.machine_types:type_name -> libops.v1.RegionMachineType
+	0, // 3: libops.v1.CatalogService.ListRegions:input_type -> libops.v1.ListRegionsRequest
+	1, // 4: libops.v1.CatalogService.ListRegions:output_type -> libops.v1.ListRegionsResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_catalog_proto_init() }
+func file_libops_v1_catalog_proto_init() {
+	if File_libops_v1_catalog_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_catalog_proto_rawDesc), len(file_libops_v1_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_libops_v1_catalog_proto_depIdxs,
+		MessageInfos:      file_libops_v1_catalog_proto_msgTypes,
+	}.Build()
+	File_libops_v1_catalog_proto = out.File
+	file_libops_v1_catalog_proto_goTypes = nil
+	file_libops_v1_catalog_proto_depIdxs = nil
+}
@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/cdn.proto
+
+package libopsv1
+
+import (
+	common "github.com/libops/api/proto/libops/v1/common"
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CachePurgeStatus int32
+
+const (
+	CachePurgeStatus_CACHE_PURGE_STATUS_UNSPECIFIED CachePurgeStatus = 0
+	CachePurgeStatus_CACHE_PURGE_STATUS_PENDING     CachePurgeStatus = 1 // Waiting for the site's next reconciliation
+	CachePurgeStatus_CACHE_PURGE_STATUS_COMPLETED   CachePurgeStatus = 2
+)
+
+// Enum value maps for CachePurgeStatus.
+var (
+	CachePurgeStatus_name = map[int32]string{
+		0: "CACHE_PURGE_STATUS_UNSPECIFIED",
+		1: "CACHE_PURGE_STATUS_PENDING",
+		2: "CACHE_PURGE_STATUS_COMPLETED",
+	}
+	CachePurgeStatus_value = map[string]int32{
+		"CACHE_PURGE_STATUS_UNSPECIFIED": 0,
+		"CACHE_PURGE_STATUS_PENDING":     1,
+		"CACHE_PURGE_STATUS_COMPLETED":   2,
+	}
+)
+
+func (x CachePurgeStatus) Enum() *CachePurgeStatus {
+	p := new(CachePurgeStatus)
+	*p = x
+	return p
+}
+
+func (x CachePurgeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CachePurgeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_cdn_proto_enumTypes[0].Descriptor()
+}
+
+func (CachePurgeStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_cdn_proto_enumTypes[0]
+}
+
+func (x CachePurgeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CachePurgeStatus.Descriptor instead.
+func (CachePurgeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{0}
+}
+
+// CachePurge is a request to invalidate cached content for a path
+type CachePurge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurgeId       string                 `protobuf:"bytes,1,opt,name=purge_id,json=purgeId,proto3" json:"purge_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // A trailing * matches every path with that prefix
+	Status        CachePurgeStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=libops.v1.CachePurgeStatus" json:"status,omitempty"`
+	RequestedAt   int64                  `protobuf:"varint,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // Unix timestamp
+	CompletedAt   int64                  `protobuf:"varint,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unix timestamp, 0 while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CachePurge) Reset() {
+	*x = CachePurge{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CachePurge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachePurge) ProtoMessage() {}
+
+func (x *CachePurge) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachePurge.ProtoReflect.Descriptor instead.
+func (*CachePurge) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CachePurge) GetPurgeId() string {
+	if x != nil {
+		return x.PurgeId
+	}
+	return ""
+}
+
+func (x *CachePurge) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CachePurge) GetStatus() CachePurgeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CachePurgeStatus_CACHE_PURGE_STATUS_UNSPECIFIED
+}
+
+func (x *CachePurge) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *CachePurge) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type GetSiteCdnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteCdnRequest) Reset() {
+	*x = GetSiteCdnRequest{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteCdnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteCdnRequest) ProtoMessage() {}
+
+func (x *GetSiteCdnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteCdnRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteCdnRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSiteCdnRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteCdnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cdn           *common.SiteCdn        `protobuf:"bytes,1,opt,name=cdn,proto3" json:"cdn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteCdnResponse) Reset() {
+	*x = GetSiteCdnResponse{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteCdnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteCdnResponse) ProtoMessage() {}
+
+func (x *GetSiteCdnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteCdnResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteCdnResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSiteCdnResponse) GetCdn() *common.SiteCdn {
+	if x != nil {
+		return x.Cdn
+	}
+	return nil
+}
+
+type EnableSiteCdnRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SiteId    string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	CacheMode common.CdnCacheMode    `protobuf:"varint,2,opt,name=cache_mode,json=cacheMode,proto3,enum=libops.v1.common.CdnCacheMode" json:"cache_mode,omitempty"` // Defaults to CACHE_ALL_STATIC
+	// Defaults to 3600; at most 31536000. Unused with USE_ORIGIN_HEADERS, which
+	// doesn't cache responses without cache headers
+	DefaultTtlSeconds int32 `protobuf:"varint,3,opt,name=default_ttl_seconds,json=defaultTtlSeconds,proto3" json:"default_ttl_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EnableSiteCdnRequest) Reset() {
+	*x = EnableSiteCdnRequest{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableSiteCdnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableSiteCdnRequest) ProtoMessage() {}
+
+func (x *EnableSiteCdnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableSiteCdnRequest.ProtoReflect.Descriptor instead.
+func (*EnableSiteCdnRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnableSiteCdnRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *EnableSiteCdnRequest) GetCacheMode() common.CdnCacheMode {
+	if x != nil {
+		return x.CacheMode
+	}
+	return common.CdnCacheMode(0)
+}
+
+func (x *EnableSiteCdnRequest) GetDefaultTtlSeconds() int32 {
+	if x != nil {
+		return x.DefaultTtlSeconds
+	}
+	return 0
+}
+
+type EnableSiteCdnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cdn           *common.SiteCdn        `protobuf:"bytes,1,opt,name=cdn,proto3" json:"cdn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableSiteCdnResponse) Reset() {
+	*x = EnableSiteCdnResponse{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableSiteCdnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableSiteCdnResponse) ProtoMessage() {}
+
+func (x *EnableSiteCdnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableSiteCdnResponse.ProtoReflect.Descriptor instead.
+func (*EnableSiteCdnResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnableSiteCdnResponse) GetCdn() *common.SiteCdn {
+	if x != nil {
+		return x.Cdn
+	}
+	return nil
+}
+
+type DisableSiteCdnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableSiteCdnRequest) Reset() {
+	*x = DisableSiteCdnRequest{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableSiteCdnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableSiteCdnRequest) ProtoMessage() {}
+
+func (x *DisableSiteCdnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableSiteCdnRequest.ProtoReflect.Descriptor instead.
+func (*DisableSiteCdnRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DisableSiteCdnRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type PurgeSiteCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"` // Paths starting with "/"; defaults to everything ("/*")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeSiteCacheRequest) Reset() {
+	*x = PurgeSiteCacheRequest{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeSiteCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSiteCacheRequest) ProtoMessage() {}
+
+func (x *PurgeSiteCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSiteCacheRequest.ProtoReflect.Descriptor instead.
+func (*PurgeSiteCacheRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PurgeSiteCacheRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *PurgeSiteCacheRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type PurgeSiteCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purges        []*CachePurge          `protobuf:"bytes,1,rep,name=purges,proto3" json:"purges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeSiteCacheResponse) Reset() {
+	*x = PurgeSiteCacheResponse{}
+	mi := &file_libops_v1_cdn_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeSiteCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSiteCacheResponse) ProtoMessage() {}
+
+func (x *PurgeSiteCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_cdn_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSiteCacheResponse.ProtoReflect.Descriptor instead.
+func (*PurgeSiteCacheResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_cdn_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PurgeSiteCacheResponse) GetPurges() []*CachePurge {
+	if x != nil {
+		return x.Purges
+	}
+	return nil
+}
+
+var File_libops_v1_cdn_proto protoreflect.FileDescriptor
+
+const file_libops_v1_cdn_proto_rawDesc = "" +
+	"\n" +
+	"\x13libops/v1/cdn.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1blibops/v1/common/site.proto\x1a\x1dlibops/v1/options/scope.proto\"\xb6\x01\n" +
+	"\n" +
+	"CachePurge\x12\x19\n" +
+	"\bpurge_id\x18\x01 \x01(\tR\apurgeId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.libops.v1.CachePurgeStatusR\x06status\x12!\n" +
+	"\frequested_at\x18\x04 \x01(\x03R\vrequestedAt\x12!\n" +
+	"\fcompleted_at\x18\x05 \x01(\x03R\vcompletedAt\",\n" +
+	"\x11GetSiteCdnRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"A\n" +
+	"\x12GetSiteCdnResponse\x12+\n" +
+	"\x03cdn\x18\x01 \x01(\v2\x19.libops.v1.common.SiteCdnR\x03cdn\"\x9e\x01\n" +
+	"\x14EnableSiteCdnRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12=\n" +
+	"\n" +
+	"cache_mode\x18\x02 \x01(\x0e2\x1e.libops.v1.common.CdnCacheModeR\tcacheMode\x12.\n" +
+	"\x13default_ttl_seconds\x18\x03 \x01(\x05R\x11defaultTtlSeconds\"D\n" +
+	"\x15EnableSiteCdnResponse\x12+\n" +
+	"\x03cdn\x18\x01 \x01(\v2\x19.libops.v1.common.SiteCdnR\x03cdn\"0\n" +
+	"\x15DisableSiteCdnRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"F\n" +
+	"\x15PurgeSiteCacheRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x14\n" +
+	"\x05paths\x18\x02 \x03(\tR\x05paths\"G\n" +
+	"\x16PurgeSiteCacheResponse\x12-\n" +
+	"\x06purges\x18\x01 \x03(\v2\x15.libops.v1.CachePurgeR\x06purges*x\n" +
+	"\x10CachePurgeStatus\x12\"\n" +
+	"\x1eCACHE_PURGE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aCACHE_PURGE_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cCACHE_PURGE_STATUS_COMPLETED\x10\x022\xe4\x04\n" +
+	"\x0eSiteCdnService\x12\x8b\x01\n" +
+	"\n" +
+	"GetSiteCdn\x12\x1c.libops.v1.GetSiteCdnRequest\x1a\x1d.libops.v1.GetSiteCdnResponse\"@\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/sites/{site_id}/cdn\x90\x02\x01\x12\x95\x01\n" +
+	"\rEnableSiteCdn\x12\x1f.libops.v1.EnableSiteCdnRequest\x1a .libops.v1.EnableSiteCdnResponse\"A\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/sites/{site_id}/cdn\x12\x8a\x01\n" +
+	"\x0eDisableSiteCdn\x12 .libops.v1.DisableSiteCdnRequest\x1a\x16.google.protobuf.Empty\">\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x19*\x17/v1/sites/{site_id}/cdn\x12\x9e\x01\n" +
+	"\x0ePurgeSiteCache\x12 .libops.v1.PurgeSiteCacheRequest\x1a!.libops.v1.PurgeSiteCacheResponse\"G\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sites/{site_id}/cdn:purgeB\x8e\x01\n" +
+	"\rcom.libops.v1B\bCdnProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_cdn_proto_rawDescOnce sync.Once
+	file_libops_v1_cdn_proto_rawDescData []byte
+)
+
+func file_libops_v1_cdn_proto_rawDescGZIP() []byte {
+	file_libops_v1_cdn_proto_rawDescOnce.Do(func() {
+		file_libops_v1_cdn_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_cdn_proto_rawDesc), len(file_libops_v1_cdn_proto_rawDesc)))
+	})
+	return file_libops_v1_cdn_proto_rawDescData
+}
+
+var file_libops_v1_cdn_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_cdn_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_libops_v1_cdn_proto_goTypes = []any{
+	(CachePurgeStatus)(0),          // 0: libops.v1.CachePurgeStatus
+	(*CachePurge)(nil),             // 1: libops.v1.CachePurge
+	(*GetSiteCdnRequest)(nil),      // 2: libops.v1.GetSiteCdnRequest
+	(*GetSiteCdnResponse)(nil),     // 3: libops.v1.GetSiteCdnResponse
+	(*EnableSiteCdnRequest)(nil),   // 4: libops.v1.EnableSiteCdnRequest
+	(*EnableSiteCdnResponse)(nil),  // 5: libops.v1.EnableSiteCdnResponse
+	(*DisableSiteCdnRequest)(nil),  // 6: libops.v1.DisableSiteCdnRequest
+	(*PurgeSiteCacheRequest)(nil),  // 7: libops.v1.PurgeSiteCacheRequest
+	(*PurgeSiteCacheResponse)(nil), // 8: libops.v1.PurgeSiteCacheResponse
+	(*common.SiteCdn)(nil),         // 9: libops.v1.common.SiteCdn
+	(common.CdnCacheMode)(0),       // 10: libops.v1.common.CdnCacheMode
+	(*emptypb.Empty)(nil),          // 11: google.protobuf.Empty
+}
+var file_libops_v1_cdn_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.CachePurge.status:type_name -> libops.v1.CachePurgeStatus
+	9,  // 1: libops.v1.GetSiteCdnResponse.cdn:type_name -> libops.v1.common.SiteCdn
+	10, // 2: libops.v1.EnableSiteCdnRequest.cache_mode:type_name -> libops.v1.common.CdnCacheMode
+	9,  // 3: libops.v1.EnableSiteCdnResponse.cdn:type_name -> libops.v1.common.SiteCdn
+	1,  // 4: libops.v1.PurgeSiteCacheResponse.purges:type_name -> libops.v1.CachePurge
+	2,  // 5: libops.v1.SiteCdnService.GetSiteCdn:input_type -> libops.v1.GetSiteCdnRequest
+	4,  // 6: libops.v1.SiteCdnService.EnableSiteCdn:input_type -> libops.v1.EnableSiteCdnRequest
+	6,  // 7: libops.v1.SiteCdnService.DisableSiteCdn:input_type -> libops.v1.DisableSiteCdnRequest
+	7,  // 8: libops.v1.SiteCdnService.PurgeSiteCache:input_type -> libops.v1.PurgeSiteCacheRequest
+	3,  // 9: libops.v1.SiteCdnService.GetSiteCdn:output_type -> libops.v1.GetSiteCdnResponse
+	5,  // 10: libops.v1.SiteCdnService.EnableSiteCdn:output_type -> libops.v1.EnableSiteCdnResponse
+	11, // 11: libops.v1.SiteCdnService.DisableSiteCdn:output_type -> google.protobuf.Empty
+	8,  // 12: libops.v1.SiteCdnService.PurgeSiteCache:output_type -> libops.v1.PurgeSiteCacheResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_cdn_proto_init() }
+func file_libops_v1_cdn_proto_init() {
+	if File_libops_v1_cdn_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_cdn_proto_rawDesc), len(file_libops_v1_cdn_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_cdn_proto_goTypes,
+		DependencyIndexes: file_libops_v1_cdn_proto_depIdxs,
+		EnumInfos:         file_libops_v1_cdn_proto_enumTypes,
+		MessageInfos:      file_libops_v1_cdn_proto_msgTypes,
+	}.Build()
+	File_libops_v1_cdn_proto = out.File
+	file_libops_v1_cdn_proto_goTypes = nil
+	file_libops_v1_cdn_proto_depIdxs = nil
+}
@@ -0,0 +1,1062 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/common/billing.proto
+
+package common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BillingSubscription is an organization's Stripe subscription
+type BillingSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stripe subscription status: trialing, active, past_due, canceled, ...
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Unix timestamps; 0 when unset
+	CurrentPeriodStart int64 `protobuf:"varint,2,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   int64 `protobuf:"varint,3,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	TrialEnd           int64 `protobuf:"varint,4,opt,name=trial_end,json=trialEnd,proto3" json:"trial_end,omitempty"`
+	CancelAtPeriodEnd  bool  `protobuf:"varint,5,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`
+	// Unix timestamp of the last failed invoice payment; 0 when payments are current
+	PaymentFailedAt int64 `protobuf:"varint,6,opt,name=payment_failed_at,json=paymentFailedAt,proto3" json:"payment_failed_at,omitempty"`
+	// Stripe invoice whose payment failed
+	PaymentFailedInvoiceId string `protobuf:"bytes,7,opt,name=payment_failed_invoice_id,json=paymentFailedInvoiceId,proto3" json:"payment_failed_invoice_id,omitempty"`
+	// Dunning state: active, past_due, suspended or scheduled_for_deletion
+	BillingState string `protobuf:"bytes,8,opt,name=billing_state,json=billingState,proto3" json:"billing_state,omitempty"`
+	// Unix timestamp of the last dunning state change; 0 when never changed
+	BillingStateChangedAt int64 `protobuf:"varint,9,opt,name=billing_state_changed_at,json=billingStateChangedAt,proto3" json:"billing_state_changed_at,omitempty"`
+	// Unix timestamp when the grace period for the current state ends; 0 when no transition is pending
+	BillingStateDeadline int64 `protobuf:"varint,10,opt,name=billing_state_deadline,json=billingStateDeadline,proto3" json:"billing_state_deadline,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BillingSubscription) Reset() {
+	*x = BillingSubscription{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingSubscription) ProtoMessage() {}
+
+func (x *BillingSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingSubscription.ProtoReflect.Descriptor instead.
+func (*BillingSubscription) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BillingSubscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BillingSubscription) GetCurrentPeriodStart() int64 {
+	if x != nil {
+		return x.CurrentPeriodStart
+	}
+	return 0
+}
+
+func (x *BillingSubscription) GetCurrentPeriodEnd() int64 {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return 0
+}
+
+func (x *BillingSubscription) GetTrialEnd() int64 {
+	if x != nil {
+		return x.TrialEnd
+	}
+	return 0
+}
+
+func (x *BillingSubscription) GetCancelAtPeriodEnd() bool {
+	if x != nil {
+		return x.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (x *BillingSubscription) GetPaymentFailedAt() int64 {
+	if x != nil {
+		return x.PaymentFailedAt
+	}
+	return 0
+}
+
+func (x *BillingSubscription) GetPaymentFailedInvoiceId() string {
+	if x != nil {
+		return x.PaymentFailedInvoiceId
+	}
+	return ""
+}
+
+func (x *BillingSubscription) GetBillingState() string {
+	if x != nil {
+		return x.BillingState
+	}
+	return ""
+}
+
+func (x *BillingSubscription) GetBillingStateChangedAt() int64 {
+	if x != nil {
+		return x.BillingStateChangedAt
+	}
+	return 0
+}
+
+func (x *BillingSubscription) GetBillingStateDeadline() int64 {
+	if x != nil {
+		return x.BillingStateDeadline
+	}
+	return 0
+}
+
+// SubscriptionItem is a line item on an organization's Stripe subscription
+type SubscriptionItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ItemId  string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PriceId string                 `protobuf:"bytes,2,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	// Human-readable product or price name
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity    int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Unit price in the smallest currency unit (e.g. cents)
+	UnitAmount int64  `protobuf:"varint,5,opt,name=unit_amount,json=unitAmount,proto3" json:"unit_amount,omitempty"`
+	Currency   string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Billing interval: month, year, ...
+	Interval string `protobuf:"bytes,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Project the item bills for, when the item is a machine
+	ProjectName   string `protobuf:"bytes,8,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionItem) Reset() {
+	*x = SubscriptionItem{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionItem) ProtoMessage() {}
+
+func (x *SubscriptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionItem.ProtoReflect.Descriptor instead.
+func (*SubscriptionItem) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscriptionItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetPriceId() string {
+	if x != nil {
+		return x.PriceId
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SubscriptionItem) GetUnitAmount() int64 {
+	if x != nil {
+		return x.UnitAmount
+	}
+	return 0
+}
+
+func (x *SubscriptionItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SubscriptionItem) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+// ProjectUsage is the billable footprint of a single project
+type ProjectUsage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	MachineType string                 `protobuf:"bytes,3,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	Vcpu        int32                  `protobuf:"varint,4,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	MemoryGib   int32                  `protobuf:"varint,5,opt,name=memory_gib,json=memoryGib,proto3" json:"memory_gib,omitempty"`
+	DiskSizeGb  int32                  `protobuf:"varint,6,opt,name=disk_size_gb,json=diskSizeGb,proto3" json:"disk_size_gb,omitempty"`
+	// Monthly list price of the machine in cents
+	MachineMonthlyPriceCents int64 `protobuf:"varint,7,opt,name=machine_monthly_price_cents,json=machineMonthlyPriceCents,proto3" json:"machine_monthly_price_cents,omitempty"`
+	// Most recent backup storage measurement in bytes
+	BackupStorageBytes int64 `protobuf:"varint,8,opt,name=backup_storage_bytes,json=backupStorageBytes,proto3" json:"backup_storage_bytes,omitempty"`
+	// Egress during the current billing period in bytes
+	EgressBytes int64 `protobuf:"varint,9,opt,name=egress_bytes,json=egressBytes,proto3" json:"egress_bytes,omitempty"`
+	// Most recent data disk usage across the project's sites in bytes
+	DiskUsedBytes int64 `protobuf:"varint,10,opt,name=disk_used_bytes,json=diskUsedBytes,proto3" json:"disk_used_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectUsage) Reset() {
+	*x = ProjectUsage{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectUsage) ProtoMessage() {}
+
+func (x *ProjectUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectUsage.ProtoReflect.Descriptor instead.
+func (*ProjectUsage) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProjectUsage) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectUsage) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ProjectUsage) GetMachineType() string {
+	if x != nil {
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *ProjectUsage) GetVcpu() int32 {
+	if x != nil {
+		return x.Vcpu
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetMemoryGib() int32 {
+	if x != nil {
+		return x.MemoryGib
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetDiskSizeGb() int32 {
+	if x != nil {
+		return x.DiskSizeGb
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetMachineMonthlyPriceCents() int64 {
+	if x != nil {
+		return x.MachineMonthlyPriceCents
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetBackupStorageBytes() int64 {
+	if x != nil {
+		return x.BackupStorageBytes
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetEgressBytes() int64 {
+	if x != nil {
+		return x.EgressBytes
+	}
+	return 0
+}
+
+func (x *ProjectUsage) GetDiskUsedBytes() int64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
+}
+
+// PaymentMethod is a card attached to an organization's Stripe customer
+type PaymentMethod struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	// Card brand: visa, mastercard, amex, ...
+	Brand    string `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	Last4    string `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpMonth int32  `protobuf:"varint,4,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	ExpYear  int32  `protobuf:"varint,5,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	// True when this method is charged for the organization's subscription
+	IsDefault     bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PaymentMethod) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetExpMonth() int32 {
+	if x != nil {
+		return x.ExpMonth
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetExpYear() int32 {
+	if x != nil {
+		return x.ExpYear
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// Invoice is a Stripe invoice issued to an organization
+type Invoice struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Number    string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Stripe invoice status: draft, open, paid, uncollectible, void
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Amounts in the smallest currency unit (e.g. cents)
+	AmountDue  int64  `protobuf:"varint,4,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
+	AmountPaid int64  `protobuf:"varint,5,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	Currency   string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Unix timestamps; 0 when unset
+	Created     int64 `protobuf:"varint,7,opt,name=created,proto3" json:"created,omitempty"`
+	PeriodStart int64 `protobuf:"varint,8,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   int64 `protobuf:"varint,9,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	DueDate     int64 `protobuf:"varint,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	PaidAt      int64 `protobuf:"varint,11,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// Stripe-hosted page where the invoice can be viewed and paid
+	HostedInvoiceUrl   string `protobuf:"bytes,12,opt,name=hosted_invoice_url,json=hostedInvoiceUrl,proto3" json:"hosted_invoice_url,omitempty"`
+	InvoicePdfUrl      string `protobuf:"bytes,13,opt,name=invoice_pdf_url,json=invoicePdfUrl,proto3" json:"invoice_pdf_url,omitempty"`
+	AttemptCount       int64  `protobuf:"varint,14,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	NextPaymentAttempt int64  `protobuf:"varint,15,opt,name=next_payment_attempt,json=nextPaymentAttempt,proto3" json:"next_payment_attempt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Invoice) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmountDue() int64 {
+	if x != nil {
+		return x.AmountDue
+	}
+	return 0
+}
+
+func (x *Invoice) GetAmountPaid() int64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *Invoice) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetHostedInvoiceUrl() string {
+	if x != nil {
+		return x.HostedInvoiceUrl
+	}
+	return ""
+}
+
+func (x *Invoice) GetInvoicePdfUrl() string {
+	if x != nil {
+		return x.InvoicePdfUrl
+	}
+	return ""
+}
+
+func (x *Invoice) GetAttemptCount() int64 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *Invoice) GetNextPaymentAttempt() int64 {
+	if x != nil {
+		return x.NextPaymentAttempt
+	}
+	return 0
+}
+
+// PaymentFailedEvent is emitted when Stripe fails to collect an organization's invoice
+type PaymentFailedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceId      string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	AmountDue      int64                  `protobuf:"varint,3,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AttemptCount   int64                  `protobuf:"varint,5,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	// Unix timestamp of Stripe's next automatic retry; 0 when no retry is scheduled
+	NextPaymentAttempt int64  `protobuf:"varint,6,opt,name=next_payment_attempt,json=nextPaymentAttempt,proto3" json:"next_payment_attempt,omitempty"`
+	HostedInvoiceUrl   string `protobuf:"bytes,7,opt,name=hosted_invoice_url,json=hostedInvoiceUrl,proto3" json:"hosted_invoice_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PaymentFailedEvent) Reset() {
+	*x = PaymentFailedEvent{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentFailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentFailedEvent) ProtoMessage() {}
+
+func (x *PaymentFailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentFailedEvent.ProtoReflect.Descriptor instead.
+func (*PaymentFailedEvent) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaymentFailedEvent) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PaymentFailedEvent) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *PaymentFailedEvent) GetAmountDue() int64 {
+	if x != nil {
+		return x.AmountDue
+	}
+	return 0
+}
+
+func (x *PaymentFailedEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentFailedEvent) GetAttemptCount() int64 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *PaymentFailedEvent) GetNextPaymentAttempt() int64 {
+	if x != nil {
+		return x.NextPaymentAttempt
+	}
+	return 0
+}
+
+func (x *PaymentFailedEvent) GetHostedInvoiceUrl() string {
+	if x != nil {
+		return x.HostedInvoiceUrl
+	}
+	return ""
+}
+
+// BillingStateChangedEvent is emitted when an organization moves through the dunning lifecycle.
+type BillingStateChangedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PreviousState  string                 `protobuf:"bytes,2,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Why the transition happened, e.g. invoice.payment_failed or grace_period_expired
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Unix timestamp when the grace period for the new state ends; 0 when no transition is pending
+	Deadline      int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BillingStateChangedEvent) Reset() {
+	*x = BillingStateChangedEvent{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingStateChangedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingStateChangedEvent) ProtoMessage() {}
+
+func (x *BillingStateChangedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingStateChangedEvent.ProtoReflect.Descriptor instead.
+func (*BillingStateChangedEvent) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BillingStateChangedEvent) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *BillingStateChangedEvent) GetPreviousState() string {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ""
+}
+
+func (x *BillingStateChangedEvent) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BillingStateChangedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BillingStateChangedEvent) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// MeteredUsage is a usage-based charge accrued in the current billing period.
+// Each day's usage is rounded up to whole units before it is billed.
+type MeteredUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// usage_records metric, e.g. egress_bytes or disk_used_bytes
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// Bytes: the period total for counters, the latest daily value for gauges
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Billed units (e.g. GB) and the bytes in one unit
+	Units     int64 `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	UnitBytes int64 `protobuf:"varint,4,opt,name=unit_bytes,json=unitBytes,proto3" json:"unit_bytes,omitempty"`
+	// Units included at no charge each period
+	IncludedUnits        int64 `protobuf:"varint,5,opt,name=included_units,json=includedUnits,proto3" json:"included_units,omitempty"`
+	UnitPriceCents       int64 `protobuf:"varint,6,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`
+	EstimatedAmountCents int64 `protobuf:"varint,7,opt,name=estimated_amount_cents,json=estimatedAmountCents,proto3" json:"estimated_amount_cents,omitempty"`
+	ProjectedUnits       int64 `protobuf:"varint,8,opt,name=projected_units,json=projectedUnits,proto3" json:"projected_units,omitempty"`
+	ProjectedAmountCents int64 `protobuf:"varint,9,opt,name=projected_amount_cents,json=projectedAmountCents,proto3" json:"projected_amount_cents,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MeteredUsage) Reset() {
+	*x = MeteredUsage{}
+	mi := &file_libops_v1_common_billing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeteredUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeteredUsage) ProtoMessage() {}
+
+func (x *MeteredUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_billing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeteredUsage.ProtoReflect.Descriptor instead.
+func (*MeteredUsage) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_billing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MeteredUsage) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MeteredUsage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetUnitBytes() int64 {
+	if x != nil {
+		return x.UnitBytes
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetIncludedUnits() int64 {
+	if x != nil {
+		return x.IncludedUnits
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetEstimatedAmountCents() int64 {
+	if x != nil {
+		return x.EstimatedAmountCents
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetProjectedUnits() int64 {
+	if x != nil {
+		return x.ProjectedUnits
+	}
+	return 0
+}
+
+func (x *MeteredUsage) GetProjectedAmountCents() int64 {
+	if x != nil {
+		return x.ProjectedAmountCents
+	}
+	return 0
+}
+
+var File_libops_v1_common_billing_proto protoreflect.FileDescriptor
+
+const file_libops_v1_common_billing_proto_rawDesc = "" +
+	"\n" +
+	"\x1elibops/v1/common/billing.proto\x12\x10libops.v1.common\"\xd6\x03\n" +
+	"\x13BillingSubscription\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x120\n" +
+	"\x14current_period_start\x18\x02 \x01(\x03R\x12currentPeriodStart\x12,\n" +
+	"\x12current_period_end\x18\x03 \x01(\x03R\x10currentPeriodEnd\x12\x1b\n" +
+	"\ttrial_end\x18\x04 \x01(\x03R\btrialEnd\x12/\n" +
+	"\x14cancel_at_period_end\x18\x05 \x01(\bR\x11cancelAtPeriodEnd\x12*\n" +
+	"\x11payment_failed_at\x18\x06 \x01(\x03R\x0fpaymentFailedAt\x129\n" +
+	"\x19payment_failed_invoice_id\x18\a \x01(\tR\x16paymentFailedInvoiceId\x12#\n" +
+	"\rbilling_state\x18\b \x01(\tR\fbillingState\x127\n" +
+	"\x18billing_state_changed_at\x18\t \x01(\x03R\x15billingStateChangedAt\x124\n" +
+	"\x16billing_state_deadline\x18\n" +
+	" \x01(\x03R\x14billingStateDeadline\"\x80\x02\n" +
+	"\x10SubscriptionItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x19\n" +
+	"\bprice_id\x18\x02 \x01(\tR\apriceId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vunit_amount\x18\x05 \x01(\x03R\n" +
+	"unitAmount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\binterval\x18\a \x01(\tR\binterval\x12!\n" +
+	"\fproject_name\x18\b \x01(\tR\vprojectName\"\x84\x03\n" +
+	"\fProjectUsage\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12!\n" +
+	"\fmachine_type\x18\x03 \x01(\tR\vmachineType\x12\x12\n" +
+	"\x04vcpu\x18\x04 \x01(\x05R\x04vcpu\x12\x1d\n" +
+	"\n" +
+	"memory_gib\x18\x05 \x01(\x05R\tmemoryGib\x12 \n" +
+	"\fdisk_size_gb\x18\x06 \x01(\x05R\n" +
+	"diskSizeGb\x12=\n" +
+	"\x1bmachine_monthly_price_cents\x18\a \x01(\x03R\x18machineMonthlyPriceCents\x120\n" +
+	"\x14backup_storage_bytes\x18\b \x01(\x03R\x12backupStorageBytes\x12!\n" +
+	"\fegress_bytes\x18\t \x01(\x03R\vegressBytes\x12&\n" +
+	"\x0fdisk_used_bytes\x18\n" +
+	" \x01(\x03R\rdiskUsedBytes\"\xbe\x01\n" +
+	"\rPaymentMethod\x12*\n" +
+	"\x11payment_method_id\x18\x01 \x01(\tR\x0fpaymentMethodId\x12\x14\n" +
+	"\x05brand\x18\x02 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05last4\x18\x03 \x01(\tR\x05last4\x12\x1b\n" +
+	"\texp_month\x18\x04 \x01(\x05R\bexpMonth\x12\x19\n" +
+	"\bexp_year\x18\x05 \x01(\x05R\aexpYear\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\"\xf1\x03\n" +
+	"\aInvoice\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"amount_due\x18\x04 \x01(\x03R\tamountDue\x12\x1f\n" +
+	"\vamount_paid\x18\x05 \x01(\x03R\n" +
+	"amountPaid\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x18\n" +
+	"\acreated\x18\a \x01(\x03R\acreated\x12!\n" +
+	"\fperiod_start\x18\b \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\t \x01(\x03R\tperiodEnd\x12\x19\n" +
+	"\bdue_date\x18\n" +
+	" \x01(\x03R\adueDate\x12\x17\n" +
+	"\apaid_at\x18\v \x01(\x03R\x06paidAt\x12,\n" +
+	"\x12hosted_invoice_url\x18\f \x01(\tR\x10hostedInvoiceUrl\x12&\n" +
+	"\x0finvoice_pdf_url\x18\r \x01(\tR\rinvoicePdfUrl\x12#\n" +
+	"\rattempt_count\x18\x0e \x01(\x03R\fattemptCount\x120\n" +
+	"\x14next_payment_attempt\x18\x0f \x01(\x03R\x12nextPaymentAttempt\"\x9c\x02\n" +
+	"\x12PaymentFailedEvent\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x12\x1d\n" +
+	"\n" +
+	"amount_due\x18\x03 \x01(\x03R\tamountDue\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12#\n" +
+	"\rattempt_count\x18\x05 \x01(\x03R\fattemptCount\x120\n" +
+	"\x14next_payment_attempt\x18\x06 \x01(\x03R\x12nextPaymentAttempt\x12,\n" +
+	"\x12hosted_invoice_url\x18\a \x01(\tR\x10hostedInvoiceUrl\"\xb4\x01\n" +
+	"\x18BillingStateChangedEvent\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12%\n" +
+	"\x0eprevious_state\x18\x02 \x01(\tR\rpreviousState\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bdeadline\x18\x05 \x01(\x03R\bdeadline\"\xdd\x02\n" +
+	"\fMeteredUsage\x12\x16\n" +
+	"\x06metric\x18\x01 \x01(\tR\x06metric\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x14\n" +
+	"\x05units\x18\x03 \x01(\x03R\x05units\x12\x1d\n" +
+	"\n" +
+	"unit_bytes\x18\x04 \x01(\x03R\tunitBytes\x12%\n" +
+	"\x0eincluded_units\x18\x05 \x01(\x03R\rincludedUnits\x12(\n" +
+	"\x10unit_price_cents\x18\x06 \x01(\x03R\x0eunitPriceCents\x124\n" +
+	"\x16estimated_amount_cents\x18\a \x01(\x03R\x14estimatedAmountCents\x12'\n" +
+	"\x0fprojected_units\x18\b \x01(\x03R\x0eprojectedUnits\x124\n" +
+	"\x16projected_amount_cents\x18\t \x01(\x03R\x14projectedAmountCentsB\xb4\x01\n" +
+	"\x14com.libops.v1.commonB\fBillingProtoP\x01Z,github.com/libops/api/proto/libops/v1/common\xa2\x02\x03LVC\xaa\x02\x10Libops.V1.Common\xca\x02\x10Libops\\V1\\Common\xe2\x02\x1cLibops\\V1\\Common\\GPBMetadata\xea\x02\x12Libops::V1::Commonb\x06proto3"
+
+var (
+	file_libops_v1_common_billing_proto_rawDescOnce sync.Once
+	file_libops_v1_common_billing_proto_rawDescData []byte
+)
+
+func file_libops_v1_common_billing_proto_rawDescGZIP() []byte {
+	file_libops_v1_common_billing_proto_rawDescOnce.Do(func() {
+		file_libops_v1_common_billing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_common_billing_proto_rawDesc), len(file_libops_v1_common_billing_proto_rawDesc)))
+	})
+	return file_libops_v1_common_billing_proto_rawDescData
+}
+
+var file_libops_v1_common_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_libops_v1_common_billing_proto_goTypes = []any{
+	(*BillingSubscription)(nil),      // 0: libops.v1.common.BillingSubscription
+	(*SubscriptionItem)(nil),         // 1: libops.v1.common.SubscriptionItem
+	(*ProjectUsage)(nil),             // 2: libops.v1.common.ProjectUsage
+	(*PaymentMethod)(nil),            // 3: libops.v1.common.PaymentMethod
+	(*Invoice)(nil),                  // 4: libops.v1.common.Invoice
+	(*PaymentFailedEvent)(nil),       // 5: libops.v1.common.PaymentFailedEvent
+	(*BillingStateChangedEvent)(nil), // 6: libops.v1.common.BillingStateChangedEvent
+	(*MeteredUsage)(nil),             // 7: libops.v1.common.MeteredUsage
+}
+var file_libops_v1_common_billing_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_common_billing_proto_init() }
+func file_libops_v1_common_billing_proto_init() {
+	if File_libops_v1_common_billing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_common_billing_proto_rawDesc), len(file_libops_v1_common_billing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_libops_v1_common_billing_proto_goTypes,
+		DependencyIndexes: file_libops_v1_common_billing_proto_depIdxs,
+		MessageInfos:      file_libops_v1_common_billing_proto_msgTypes,
+	}.Build()
+	File_libops_v1_common_billing_proto = out.File
+	file_libops_v1_common_billing_proto_goTypes = nil
+	file_libops_v1_common_billing_proto_depIdxs = nil
+}
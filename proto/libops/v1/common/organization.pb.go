@@ -90,6 +90,56 @@ func (Location) EnumDescriptor() ([]byte, []int) {
 	return file_libops_v1_common_organization_proto_rawDescGZIP(), []int{0}
 }
 
+// DataResidency is the geography an organization's data must stay in
+type DataResidency int32
+
+const (
+	DataResidency_DATA_RESIDENCY_UNSPECIFIED DataResidency = 0 // Any region
+	DataResidency_DATA_RESIDENCY_EU          DataResidency = 1
+	DataResidency_DATA_RESIDENCY_US          DataResidency = 2
+)
+
+// Enum value maps for DataResidency.
+var (
+	DataResidency_name = map[int32]string{
+		0: "DATA_RESIDENCY_UNSPECIFIED",
+		1: "DATA_RESIDENCY_EU",
+		2: "DATA_RESIDENCY_US",
+	}
+	DataResidency_value = map[string]int32{
+		"DATA_RESIDENCY_UNSPECIFIED": 0,
+		"DATA_RESIDENCY_EU":          1,
+		"DATA_RESIDENCY_US":          2,
+	}
+)
+
+func (x DataResidency) Enum() *DataResidency {
+	p := new(DataResidency)
+	*p = x
+	return p
+}
+
+func (x DataResidency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataResidency) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_common_organization_proto_enumTypes[1].Descriptor()
+}
+
+func (DataResidency) Type() protoreflect.EnumType {
+	return &file_libops_v1_common_organization_proto_enumTypes[1]
+}
+
+func (x DataResidency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataResidency.Descriptor instead.
+func (DataResidency) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_common_organization_proto_rawDescGZIP(), []int{1}
+}
+
 // FolderConfig is the organization-facing folder/organization configuration
 // Contains only safe, non-sensitive fields
 type FolderConfig struct {
@@ -98,8 +148,17 @@ type FolderConfig struct {
 	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
 	Status           Status                 `protobuf:"varint,3,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
 	// Organization's preferred Google Cloud location and region
-	Location      Location `protobuf:"varint,4,opt,name=location,proto3,enum=libops.v1.common.Location" json:"location,omitempty"` // Geographic location (ASIA, AU, CA, DE, EU, IN, IT, US)
-	Region        string   `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                     // Specific region (e.g., "us-central1", "europe-west1")
+	Location Location `protobuf:"varint,4,opt,name=location,proto3,enum=libops.v1.common.Location" json:"location,omitempty"` // Geographic location (ASIA, AU, CA, DE, EU, IN, IT, US)
+	Region   string   `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                     // Specific region (e.g., "us-central1", "europe-west1")
+	// Free-form key/value labels for grouping and filtering (e.g., env=prod)
+	Labels map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Keeps the organization's projects, and their backups and infrastructure
+	// state, in one geography. Projects can only be created in its regions, and
+	// it can't be set while a project runs outside them.
+	DataResidency DataResidency `protobuf:"varint,7,opt,name=data_residency,json=dataResidency,proto3,enum=libops.v1.common.DataResidency" json:"data_residency,omitempty"`
+	// Changes whenever the organization's configuration does. Send it back on update to
+	// apply the change only if nobody else changed the organization since it was read.
+	Etag          string `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,18 +228,135 @@ func (x *FolderConfig) GetRegion() string {
 	return ""
 }
 
+func (x *FolderConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *FolderConfig) GetDataResidency() DataResidency {
+	if x != nil {
+		return x.DataResidency
+	}
+	return DataResidency_DATA_RESIDENCY_UNSPECIFIED
+}
+
+func (x *FolderConfig) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Quota is the effective limit on a resource for an organization
+type Quota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resource name: projects, sites_per_project, secrets_per_site, firewall_rules, or api_keys_per_account
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// What the limit is counted against: organization, project, site, resource (each organization, project, or site), or account
+	Scope string `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	Limit int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Current usage, set only for organization-scoped quotas
+	Usage *int64 `protobuf:"varint,4,opt,name=usage,proto3,oneof" json:"usage,omitempty"`
+	// True when an admin override replaces the plan default
+	Overridden    bool `protobuf:"varint,5,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_libops_v1_common_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Quota) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *Quota) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Quota) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Quota) GetUsage() int64 {
+	if x != nil && x.Usage != nil {
+		return *x.Usage
+	}
+	return 0
+}
+
+func (x *Quota) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
 var File_libops_v1_common_organization_proto protoreflect.FileDescriptor
 
 const file_libops_v1_common_organization_proto_rawDesc = "" +
 	"\n" +
-	"#libops/v1/common/organization.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xf2\x01\n" +
+	"#libops/v1/common/organization.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xcd\x03\n" +
 	"\fFolderConfig\x123\n" +
 	"\x0forganization_id\x18\x01 \x01(\tB\n" +
 	"\xbaG\a\x9a\x02\x04uuidR\x0eorganizationId\x12+\n" +
 	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x120\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x126\n" +
 	"\blocation\x18\x04 \x01(\x0e2\x1a.libops.v1.common.LocationR\blocation\x12\x16\n" +
-	"\x06region\x18\x05 \x01(\tR\x06region*\xae\x01\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12B\n" +
+	"\x06labels\x18\x06 \x03(\v2*.libops.v1.common.FolderConfig.LabelsEntryR\x06labels\x12F\n" +
+	"\x0edata_residency\x18\a \x01(\x0e2\x1f.libops.v1.common.DataResidencyR\rdataResidency\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x01\n" +
+	"\x05Quota\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x19\n" +
+	"\x05usage\x18\x04 \x01(\x03H\x00R\x05usage\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x05 \x01(\bR\n" +
+	"overriddenB\b\n" +
+	"\x06_usage*\xae\x01\n" +
 	"\bLocation\x12\x18\n" +
 	"\x14LOCATION_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rLOCATION_ASIA\x10\x01\x12\x0f\n" +
@@ -190,7 +366,11 @@ const file_libops_v1_common_organization_proto_rawDesc = "" +
 	"\vLOCATION_EU\x10\x05\x12\x0f\n" +
 	"\vLOCATION_IN\x10\x06\x12\x0f\n" +
 	"\vLOCATION_IT\x10\a\x12\x0f\n" +
-	"\vLOCATION_US\x10\bB\xb9\x01\n" +
+	"\vLOCATION_US\x10\b*]\n" +
+	"\rDataResidency\x12\x1e\n" +
+	"\x1aDATA_RESIDENCY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DATA_RESIDENCY_EU\x10\x01\x12\x15\n" +
+	"\x11DATA_RESIDENCY_US\x10\x02B\xb9\x01\n" +
 	"\x14com.libops.v1.commonB\x11OrganizationProtoP\x01Z,github.com/libops/api/proto/libops/v1/common\xa2\x02\x03LVC\xaa\x02\x10Libops.V1.Common\xca\x02\x10Libops\\V1\\Common\xe2\x02\x1cLibops\\V1\\Common\\GPBMetadata\xea\x02\x12Libops::V1::Commonb\x06proto3"
 
 var (
@@ -205,21 +385,26 @@ func file_libops_v1_common_organization_proto_rawDescGZIP() []byte {
 	return file_libops_v1_common_organization_proto_rawDescData
 }
 
-var file_libops_v1_common_organization_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_libops_v1_common_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libops_v1_common_organization_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_common_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_libops_v1_common_organization_proto_goTypes = []any{
 	(Location)(0),        // 0: libops.v1.common.Location
-	(*FolderConfig)(nil), // 1: libops.v1.common.FolderConfig
-	(Status)(0),          // 2: libops.v1.common.Status
+	(DataResidency)(0),   // 1: libops.v1.common.DataResidency
+	(*FolderConfig)(nil), // 2: libops.v1.common.FolderConfig
+	(*Quota)(nil),        // 3: libops.v1.common.Quota
+	nil,                  // 4: libops.v1.common.FolderConfig.LabelsEntry
+	(Status)(0),          // 5: libops.v1.common.Status
 }
 var file_libops_v1_common_organization_proto_depIdxs = []int32{
-	2, // 0: libops.v1.common.FolderConfig.status:type_name -> libops.v1.common.Status
+	5, // 0: libops.v1.common.FolderConfig.status:type_name -> libops.v1.common.Status
 	0, // 1: libops.v1.common.FolderConfig.location:type_name -> libops.v1.common.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: libops.v1.common.FolderConfig.labels:type_name -> libops.v1.common.FolderConfig.LabelsEntry
+	1, // 3: libops.v1.common.FolderConfig.data_residency:type_name -> libops.v1.common.DataResidency
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_common_organization_proto_init() }
@@ -228,13 +413,14 @@ func file_libops_v1_common_organization_proto_init() {
 		return
 	}
 	file_libops_v1_common_types_proto_init()
+	file_libops_v1_common_organization_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_common_organization_proto_rawDesc), len(file_libops_v1_common_organization_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -90,7 +90,12 @@ type ProjectConfig struct {
 	// Promotion strategy
 	Promote PromoteStrategy `protobuf:"varint,11,opt,name=promote,proto3,enum=libops.v1.common.PromoteStrategy" json:"promote,omitempty"` // How to promote code to production
 	// Status
-	Status        Status `protobuf:"varint,16,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	Status Status `protobuf:"varint,16,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	// Free-form key/value labels for grouping and filtering (e.g., env=prod)
+	Labels map[string]string `protobuf:"bytes,17,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Changes whenever the project's configuration does. Send it back on update to
+	// apply the change only if nobody else changed the project since it was read.
+	Etag          string `protobuf:"bytes,18,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,11 +214,25 @@ func (x *ProjectConfig) GetStatus() Status {
 	return Status_STATUS_UNSPECIFIED
 }
 
+func (x *ProjectConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 var File_libops_v1_common_project_proto protoreflect.FileDescriptor
 
 const file_libops_v1_common_project_proto_rawDesc = "" +
 	"\n" +
-	"\x1elibops/v1/common/project.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xcf\x03\n" +
+	"\x1elibops/v1/common/project.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xe3\x04\n" +
 	"\rProjectConfig\x123\n" +
 	"\x0forganization_id\x18\x01 \x01(\tB\n" +
 	"\xbaG\a\x9a\x02\x04uuidR\x0eorganizationId\x12)\n" +
@@ -231,7 +250,12 @@ const file_libops_v1_common_project_proto_rawDesc = "" +
 	"\tdisk_type\x18\n" +
 	" \x01(\tR\bdiskType\x12;\n" +
 	"\apromote\x18\v \x01(\x0e2!.libops.v1.common.PromoteStrategyR\apromote\x120\n" +
-	"\x06status\x18\x10 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status*y\n" +
+	"\x06status\x18\x10 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12C\n" +
+	"\x06labels\x18\x11 \x03(\v2+.libops.v1.common.ProjectConfig.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04etag\x18\x12 \x01(\tR\x04etag\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*y\n" +
 	"\x0fPromoteStrategy\x12 \n" +
 	"\x1cPROMOTE_STRATEGY_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bPROMOTE_STRATEGY_GITHUB_TAG\x10\x01\x12#\n" +
@@ -251,20 +275,22 @@ func file_libops_v1_common_project_proto_rawDescGZIP() []byte {
 }
 
 var file_libops_v1_common_project_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_libops_v1_common_project_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libops_v1_common_project_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_libops_v1_common_project_proto_goTypes = []any{
 	(PromoteStrategy)(0),  // 0: libops.v1.common.PromoteStrategy
 	(*ProjectConfig)(nil), // 1: libops.v1.common.ProjectConfig
-	(Status)(0),           // 2: libops.v1.common.Status
+	nil,                   // 2: libops.v1.common.ProjectConfig.LabelsEntry
+	(Status)(0),           // 3: libops.v1.common.Status
 }
 var file_libops_v1_common_project_proto_depIdxs = []int32{
 	0, // 0: libops.v1.common.ProjectConfig.promote:type_name -> libops.v1.common.PromoteStrategy
-	2, // 1: libops.v1.common.ProjectConfig.status:type_name -> libops.v1.common.Status
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 1: libops.v1.common.ProjectConfig.status:type_name -> libops.v1.common.Status
+	2, // 2: libops.v1.common.ProjectConfig.labels:type_name -> libops.v1.common.ProjectConfig.LabelsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_common_project_proto_init() }
@@ -279,7 +305,7 @@ func file_libops_v1_common_project_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_common_project_proto_rawDesc), len(file_libops_v1_common_project_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
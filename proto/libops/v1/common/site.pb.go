@@ -22,6 +22,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StaticEgressIpStatus int32
+
+const (
+	StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_UNSPECIFIED  StaticEgressIpStatus = 0
+	StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_PROVISIONING StaticEgressIpStatus = 1 // Waiting for a reconciliation to reserve it
+	StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_ACTIVE       StaticEgressIpStatus = 2
+	StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_RELEASING    StaticEgressIpStatus = 3 // Waiting for a reconciliation to release it
+)
+
+// Enum value maps for StaticEgressIpStatus.
+var (
+	StaticEgressIpStatus_name = map[int32]string{
+		0: "STATIC_EGRESS_IP_STATUS_UNSPECIFIED",
+		1: "STATIC_EGRESS_IP_STATUS_PROVISIONING",
+		2: "STATIC_EGRESS_IP_STATUS_ACTIVE",
+		3: "STATIC_EGRESS_IP_STATUS_RELEASING",
+	}
+	StaticEgressIpStatus_value = map[string]int32{
+		"STATIC_EGRESS_IP_STATUS_UNSPECIFIED":  0,
+		"STATIC_EGRESS_IP_STATUS_PROVISIONING": 1,
+		"STATIC_EGRESS_IP_STATUS_ACTIVE":       2,
+		"STATIC_EGRESS_IP_STATUS_RELEASING":    3,
+	}
+)
+
+func (x StaticEgressIpStatus) Enum() *StaticEgressIpStatus {
+	p := new(StaticEgressIpStatus)
+	*p = x
+	return p
+}
+
+func (x StaticEgressIpStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaticEgressIpStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_common_site_proto_enumTypes[0].Descriptor()
+}
+
+func (StaticEgressIpStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_common_site_proto_enumTypes[0]
+}
+
+func (x StaticEgressIpStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaticEgressIpStatus.Descriptor instead.
+func (StaticEgressIpStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{0}
+}
+
+type CdnCacheMode int32
+
+const (
+	CdnCacheMode_CDN_CACHE_MODE_UNSPECIFIED        CdnCacheMode = 0
+	CdnCacheMode_CDN_CACHE_MODE_CACHE_ALL_STATIC   CdnCacheMode = 1 // Cache static content, and anything the origin marks cacheable
+	CdnCacheMode_CDN_CACHE_MODE_USE_ORIGIN_HEADERS CdnCacheMode = 2 // Cache only what the origin's Cache-Control allows
+	CdnCacheMode_CDN_CACHE_MODE_FORCE_CACHE_ALL    CdnCacheMode = 3 // Cache every successful response, ignoring private and no-store
+)
+
+// Enum value maps for CdnCacheMode.
+var (
+	CdnCacheMode_name = map[int32]string{
+		0: "CDN_CACHE_MODE_UNSPECIFIED",
+		1: "CDN_CACHE_MODE_CACHE_ALL_STATIC",
+		2: "CDN_CACHE_MODE_USE_ORIGIN_HEADERS",
+		3: "CDN_CACHE_MODE_FORCE_CACHE_ALL",
+	}
+	CdnCacheMode_value = map[string]int32{
+		"CDN_CACHE_MODE_UNSPECIFIED":        0,
+		"CDN_CACHE_MODE_CACHE_ALL_STATIC":   1,
+		"CDN_CACHE_MODE_USE_ORIGIN_HEADERS": 2,
+		"CDN_CACHE_MODE_FORCE_CACHE_ALL":    3,
+	}
+)
+
+func (x CdnCacheMode) Enum() *CdnCacheMode {
+	p := new(CdnCacheMode)
+	*p = x
+	return p
+}
+
+func (x CdnCacheMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CdnCacheMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_common_site_proto_enumTypes[1].Descriptor()
+}
+
+func (CdnCacheMode) Type() protoreflect.EnumType {
+	return &file_libops_v1_common_site_proto_enumTypes[1]
+}
+
+func (x CdnCacheMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CdnCacheMode.Descriptor instead.
+func (CdnCacheMode) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{1}
+}
+
+type SiteCdnStatus int32
+
+const (
+	SiteCdnStatus_SITE_CDN_STATUS_UNSPECIFIED  SiteCdnStatus = 0
+	SiteCdnStatus_SITE_CDN_STATUS_PROVISIONING SiteCdnStatus = 1 // Waiting for a reconciliation to build the load balancer
+	SiteCdnStatus_SITE_CDN_STATUS_ACTIVE       SiteCdnStatus = 2
+	SiteCdnStatus_SITE_CDN_STATUS_DISABLING    SiteCdnStatus = 3 // Waiting for a reconciliation to remove it
+)
+
+// Enum value maps for SiteCdnStatus.
+var (
+	SiteCdnStatus_name = map[int32]string{
+		0: "SITE_CDN_STATUS_UNSPECIFIED",
+		1: "SITE_CDN_STATUS_PROVISIONING",
+		2: "SITE_CDN_STATUS_ACTIVE",
+		3: "SITE_CDN_STATUS_DISABLING",
+	}
+	SiteCdnStatus_value = map[string]int32{
+		"SITE_CDN_STATUS_UNSPECIFIED":  0,
+		"SITE_CDN_STATUS_PROVISIONING": 1,
+		"SITE_CDN_STATUS_ACTIVE":       2,
+		"SITE_CDN_STATUS_DISABLING":    3,
+	}
+)
+
+func (x SiteCdnStatus) Enum() *SiteCdnStatus {
+	p := new(SiteCdnStatus)
+	*p = x
+	return p
+}
+
+func (x SiteCdnStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SiteCdnStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_common_site_proto_enumTypes[2].Descriptor()
+}
+
+func (SiteCdnStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_common_site_proto_enumTypes[2]
+}
+
+func (x SiteCdnStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SiteCdnStatus.Descriptor instead.
+func (SiteCdnStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{2}
+}
+
 // SiteConfig is the organization-facing site configuration
 // Contains only safe, non-sensitive fields
 type SiteConfig struct {
@@ -47,7 +203,17 @@ type SiteConfig struct {
 	Os           string `protobuf:"bytes,16,opt,name=os,proto3" json:"os,omitempty"`                                          // OS image (default: "cos-125-19216-104-74")
 	IsProduction bool   `protobuf:"varint,17,opt,name=is_production,json=isProduction,proto3" json:"is_production,omitempty"` // Whether this is the production instance
 	// Status (organization-visible)
-	Status        Status `protobuf:"varint,11,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	Status Status `protobuf:"varint,11,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	// Free-form key/value labels for grouping and filtering (e.g., env=prod)
+	Labels map[string]string `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Reserved address the site's outbound traffic leaves from; unset when the
+	// site doesn't have one. Output only.
+	StaticEgressIp *StaticEgressIp `protobuf:"bytes,19,opt,name=static_egress_ip,json=staticEgressIp,proto3" json:"static_egress_ip,omitempty"`
+	// Computed health; unset until the site is first scored. Output only.
+	Health *SiteHealth `protobuf:"bytes,20,opt,name=health,proto3" json:"health,omitempty"`
+	// Changes whenever the site's configuration does. Send it back on update to
+	// apply the change only if nobody else changed the site since it was read.
+	Etag          string `protobuf:"bytes,21,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -201,11 +367,281 @@ func (x *SiteConfig) GetStatus() Status {
 	return Status_STATUS_UNSPECIFIED
 }
 
+func (x *SiteConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SiteConfig) GetStaticEgressIp() *StaticEgressIp {
+	if x != nil {
+		return x.StaticEgressIp
+	}
+	return nil
+}
+
+func (x *SiteConfig) GetHealth() *SiteHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *SiteConfig) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// SiteHealth scores how well a site is running, 0-100, from its controller's
+// check-ins, its containers' health checks, its external uptime probes and its
+// latest deployment. Components without data are left out of the score.
+type SiteHealth struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Score           int32                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	CheckinScore    *int32                 `protobuf:"varint,2,opt,name=checkin_score,json=checkinScore,proto3,oneof" json:"checkin_score,omitempty"`          // How recently the site's controller checked in
+	ContainerScore  *int32                 `protobuf:"varint,3,opt,name=container_score,json=containerScore,proto3,oneof" json:"container_score,omitempty"`    // Share of running containers passing their health checks
+	ProbeScore      *int32                 `protobuf:"varint,4,opt,name=probe_score,json=probeScore,proto3,oneof" json:"probe_score,omitempty"`                // Share of recent uptime probes that were up
+	DeploymentScore *int32                 `protobuf:"varint,5,opt,name=deployment_score,json=deploymentScore,proto3,oneof" json:"deployment_score,omitempty"` // 0 when the latest deployment failed
+	DegradedSince   int64                  `protobuf:"varint,6,opt,name=degraded_since,json=degradedSince,proto3" json:"degraded_since,omitempty"`             // Unix timestamp the score dropped below the incident threshold, 0 above it
+	ComputedAt      int64                  `protobuf:"varint,7,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`                      // Unix timestamp
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SiteHealth) Reset() {
+	*x = SiteHealth{}
+	mi := &file_libops_v1_common_site_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteHealth) ProtoMessage() {}
+
+func (x *SiteHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_site_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteHealth.ProtoReflect.Descriptor instead.
+func (*SiteHealth) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SiteHealth) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetCheckinScore() int32 {
+	if x != nil && x.CheckinScore != nil {
+		return *x.CheckinScore
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetContainerScore() int32 {
+	if x != nil && x.ContainerScore != nil {
+		return *x.ContainerScore
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetProbeScore() int32 {
+	if x != nil && x.ProbeScore != nil {
+		return *x.ProbeScore
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetDeploymentScore() int32 {
+	if x != nil && x.DeploymentScore != nil {
+		return *x.DeploymentScore
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetDegradedSince() int64 {
+	if x != nil {
+		return x.DegradedSince
+	}
+	return 0
+}
+
+func (x *SiteHealth) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+// StaticEgressIp is a site's reserved outbound address, for vendors that
+// allowlist by IP
+type StaticEgressIp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // Empty until the address is reserved
+	Status        StaticEgressIpStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=libops.v1.common.StaticEgressIpStatus" json:"status,omitempty"`
+	RequestedAt   int64                  `protobuf:"varint,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaticEgressIp) Reset() {
+	*x = StaticEgressIp{}
+	mi := &file_libops_v1_common_site_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticEgressIp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticEgressIp) ProtoMessage() {}
+
+func (x *StaticEgressIp) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_site_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticEgressIp.ProtoReflect.Descriptor instead.
+func (*StaticEgressIp) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaticEgressIp) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *StaticEgressIp) GetStatus() StaticEgressIpStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StaticEgressIpStatus_STATIC_EGRESS_IP_STATUS_UNSPECIFIED
+}
+
+func (x *StaticEgressIp) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+// SiteCdn is a managed Cloud CDN load balancer in front of a site
+type SiteCdn struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CacheMode         CdnCacheMode           `protobuf:"varint,1,opt,name=cache_mode,json=cacheMode,proto3,enum=libops.v1.common.CdnCacheMode" json:"cache_mode,omitempty"`
+	DefaultTtlSeconds int32                  `protobuf:"varint,2,opt,name=default_ttl_seconds,json=defaultTtlSeconds,proto3" json:"default_ttl_seconds,omitempty"` // TTL for responses without cache headers of their own
+	IpAddress         string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`                            // Address to point the site's domains at; empty until provisioned
+	Status            SiteCdnStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.common.SiteCdnStatus" json:"status,omitempty"`
+	PendingPurges     int32                  `protobuf:"varint,5,opt,name=pending_purges,json=pendingPurges,proto3" json:"pending_purges,omitempty"` // Cache purges waiting for the next reconciliation
+	EnabledAt         int64                  `protobuf:"varint,6,opt,name=enabled_at,json=enabledAt,proto3" json:"enabled_at,omitempty"`             // Unix timestamp
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SiteCdn) Reset() {
+	*x = SiteCdn{}
+	mi := &file_libops_v1_common_site_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteCdn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteCdn) ProtoMessage() {}
+
+func (x *SiteCdn) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_common_site_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteCdn.ProtoReflect.Descriptor instead.
+func (*SiteCdn) Descriptor() ([]byte, []int) {
+	return file_libops_v1_common_site_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SiteCdn) GetCacheMode() CdnCacheMode {
+	if x != nil {
+		return x.CacheMode
+	}
+	return CdnCacheMode_CDN_CACHE_MODE_UNSPECIFIED
+}
+
+func (x *SiteCdn) GetDefaultTtlSeconds() int32 {
+	if x != nil {
+		return x.DefaultTtlSeconds
+	}
+	return 0
+}
+
+func (x *SiteCdn) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SiteCdn) GetStatus() SiteCdnStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SiteCdnStatus_SITE_CDN_STATUS_UNSPECIFIED
+}
+
+func (x *SiteCdn) GetPendingPurges() int32 {
+	if x != nil {
+		return x.PendingPurges
+	}
+	return 0
+}
+
+func (x *SiteCdn) GetEnabledAt() int64 {
+	if x != nil {
+		return x.EnabledAt
+	}
+	return 0
+}
+
 var File_libops_v1_common_site_proto protoreflect.FileDescriptor
 
 const file_libops_v1_common_site_proto_rawDesc = "" +
 	"\n" +
-	"\x1blibops/v1/common/site.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xe2\x04\n" +
+	"\x1blibops/v1/common/site.proto\x12\x10libops.v1.common\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1clibops/v1/common/types.proto\"\xf5\x06\n" +
 	"\n" +
 	"SiteConfig\x12#\n" +
 	"\asite_id\x18\x01 \x01(\tB\n" +
@@ -231,7 +667,59 @@ const file_libops_v1_common_site_proto_rawDesc = "" +
 	"\x0foverlay_volumes\x18\x0f \x03(\tR\x0eoverlayVolumes\x12\x0e\n" +
 	"\x02os\x18\x10 \x01(\tR\x02os\x12#\n" +
 	"\ris_production\x18\x11 \x01(\bR\fisProduction\x120\n" +
-	"\x06status\x18\v \x01(\x0e2\x18.libops.v1.common.StatusR\x06statusB\xb1\x01\n" +
+	"\x06status\x18\v \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12@\n" +
+	"\x06labels\x18\x12 \x03(\v2(.libops.v1.common.SiteConfig.LabelsEntryR\x06labels\x12J\n" +
+	"\x10static_egress_ip\x18\x13 \x01(\v2 .libops.v1.common.StaticEgressIpR\x0estaticEgressIp\x124\n" +
+	"\x06health\x18\x14 \x01(\v2\x1c.libops.v1.common.SiteHealthR\x06health\x12\x12\n" +
+	"\x04etag\x18\x15 \x01(\tR\x04etag\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe3\x02\n" +
+	"\n" +
+	"SiteHealth\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12(\n" +
+	"\rcheckin_score\x18\x02 \x01(\x05H\x00R\fcheckinScore\x88\x01\x01\x12,\n" +
+	"\x0fcontainer_score\x18\x03 \x01(\x05H\x01R\x0econtainerScore\x88\x01\x01\x12$\n" +
+	"\vprobe_score\x18\x04 \x01(\x05H\x02R\n" +
+	"probeScore\x88\x01\x01\x12.\n" +
+	"\x10deployment_score\x18\x05 \x01(\x05H\x03R\x0fdeploymentScore\x88\x01\x01\x12%\n" +
+	"\x0edegraded_since\x18\x06 \x01(\x03R\rdegradedSince\x12\x1f\n" +
+	"\vcomputed_at\x18\a \x01(\x03R\n" +
+	"computedAtB\x10\n" +
+	"\x0e_checkin_scoreB\x12\n" +
+	"\x10_container_scoreB\x0e\n" +
+	"\f_probe_scoreB\x13\n" +
+	"\x11_deployment_score\"\x92\x01\n" +
+	"\x0eStaticEgressIp\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x01 \x01(\tR\tipAddress\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.libops.v1.common.StaticEgressIpStatusR\x06status\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\x03R\vrequestedAt\"\x96\x02\n" +
+	"\aSiteCdn\x12=\n" +
+	"\n" +
+	"cache_mode\x18\x01 \x01(\x0e2\x1e.libops.v1.common.CdnCacheModeR\tcacheMode\x12.\n" +
+	"\x13default_ttl_seconds\x18\x02 \x01(\x05R\x11defaultTtlSeconds\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.libops.v1.common.SiteCdnStatusR\x06status\x12%\n" +
+	"\x0epending_purges\x18\x05 \x01(\x05R\rpendingPurges\x12\x1d\n" +
+	"\n" +
+	"enabled_at\x18\x06 \x01(\x03R\tenabledAt*\xb4\x01\n" +
+	"\x14StaticEgressIpStatus\x12'\n" +
+	"#STATIC_EGRESS_IP_STATUS_UNSPECIFIED\x10\x00\x12(\n" +
+	"$STATIC_EGRESS_IP_STATUS_PROVISIONING\x10\x01\x12\"\n" +
+	"\x1eSTATIC_EGRESS_IP_STATUS_ACTIVE\x10\x02\x12%\n" +
+	"!STATIC_EGRESS_IP_STATUS_RELEASING\x10\x03*\x9e\x01\n" +
+	"\fCdnCacheMode\x12\x1e\n" +
+	"\x1aCDN_CACHE_MODE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fCDN_CACHE_MODE_CACHE_ALL_STATIC\x10\x01\x12%\n" +
+	"!CDN_CACHE_MODE_USE_ORIGIN_HEADERS\x10\x02\x12\"\n" +
+	"\x1eCDN_CACHE_MODE_FORCE_CACHE_ALL\x10\x03*\x8d\x01\n" +
+	"\rSiteCdnStatus\x12\x1f\n" +
+	"\x1bSITE_CDN_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSITE_CDN_STATUS_PROVISIONING\x10\x01\x12\x1a\n" +
+	"\x16SITE_CDN_STATUS_ACTIVE\x10\x02\x12\x1d\n" +
+	"\x19SITE_CDN_STATUS_DISABLING\x10\x03B\xb1\x01\n" +
 	"\x14com.libops.v1.commonB\tSiteProtoP\x01Z,github.com/libops/api/proto/libops/v1/common\xa2\x02\x03LVC\xaa\x02\x10Libops.V1.Common\xca\x02\x10Libops\\V1\\Common\xe2\x02\x1cLibops\\V1\\Common\\GPBMetadata\xea\x02\x12Libops::V1::Commonb\x06proto3"
 
 var (
@@ -246,18 +734,32 @@ func file_libops_v1_common_site_proto_rawDescGZIP() []byte {
 	return file_libops_v1_common_site_proto_rawDescData
 }
 
-var file_libops_v1_common_site_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libops_v1_common_site_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_libops_v1_common_site_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_libops_v1_common_site_proto_goTypes = []any{
-	(*SiteConfig)(nil), // 0: libops.v1.common.SiteConfig
-	(Status)(0),        // 1: libops.v1.common.Status
+	(StaticEgressIpStatus)(0), // 0: libops.v1.common.StaticEgressIpStatus
+	(CdnCacheMode)(0),         // 1: libops.v1.common.CdnCacheMode
+	(SiteCdnStatus)(0),        // 2: libops.v1.common.SiteCdnStatus
+	(*SiteConfig)(nil),        // 3: libops.v1.common.SiteConfig
+	(*SiteHealth)(nil),        // 4: libops.v1.common.SiteHealth
+	(*StaticEgressIp)(nil),    // 5: libops.v1.common.StaticEgressIp
+	(*SiteCdn)(nil),           // 6: libops.v1.common.SiteCdn
+	nil,                       // 7: libops.v1.common.SiteConfig.LabelsEntry
+	(Status)(0),               // 8: libops.v1.common.Status
 }
 var file_libops_v1_common_site_proto_depIdxs = []int32{
-	1, // 0: libops.v1.common.SiteConfig.status:type_name -> libops.v1.common.Status
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8, // 0: libops.v1.common.SiteConfig.status:type_name -> libops.v1.common.Status
+	7, // 1: libops.v1.common.SiteConfig.labels:type_name -> libops.v1.common.SiteConfig.LabelsEntry
+	5, // 2: libops.v1.common.SiteConfig.static_egress_ip:type_name -> libops.v1.common.StaticEgressIp
+	4, // 3: libops.v1.common.SiteConfig.health:type_name -> libops.v1.common.SiteHealth
+	0, // 4: libops.v1.common.StaticEgressIp.status:type_name -> libops.v1.common.StaticEgressIpStatus
+	1, // 5: libops.v1.common.SiteCdn.cache_mode:type_name -> libops.v1.common.CdnCacheMode
+	2, // 6: libops.v1.common.SiteCdn.status:type_name -> libops.v1.common.SiteCdnStatus
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_common_site_proto_init() }
@@ -266,18 +768,20 @@ func file_libops_v1_common_site_proto_init() {
 		return
 	}
 	file_libops_v1_common_types_proto_init()
+	file_libops_v1_common_site_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_common_site_proto_rawDesc), len(file_libops_v1_common_site_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_libops_v1_common_site_proto_goTypes,
 		DependencyIndexes: file_libops_v1_common_site_proto_depIdxs,
+		EnumInfos:         file_libops_v1_common_site_proto_enumTypes,
 		MessageInfos:      file_libops_v1_common_site_proto_msgTypes,
 	}.Build()
 	File_libops_v1_common_site_proto = out.File
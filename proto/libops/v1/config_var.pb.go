@@ -0,0 +1,988 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/config_var.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigVarChangeType is how a revision or diff changed a config var
+type ConfigVarChangeType int32
+
+const (
+	ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED ConfigVarChangeType = 0
+	ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_ADDED       ConfigVarChangeType = 1
+	ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_CHANGED     ConfigVarChangeType = 2
+	ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_REMOVED     ConfigVarChangeType = 3
+)
+
+// Enum value maps for ConfigVarChangeType.
+var (
+	ConfigVarChangeType_name = map[int32]string{
+		0: "CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED",
+		1: "CONFIG_VAR_CHANGE_TYPE_ADDED",
+		2: "CONFIG_VAR_CHANGE_TYPE_CHANGED",
+		3: "CONFIG_VAR_CHANGE_TYPE_REMOVED",
+	}
+	ConfigVarChangeType_value = map[string]int32{
+		"CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED": 0,
+		"CONFIG_VAR_CHANGE_TYPE_ADDED":       1,
+		"CONFIG_VAR_CHANGE_TYPE_CHANGED":     2,
+		"CONFIG_VAR_CHANGE_TYPE_REMOVED":     3,
+	}
+)
+
+func (x ConfigVarChangeType) Enum() *ConfigVarChangeType {
+	p := new(ConfigVarChangeType)
+	*p = x
+	return p
+}
+
+func (x ConfigVarChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigVarChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_config_var_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigVarChangeType) Type() protoreflect.EnumType {
+	return &file_libops_v1_config_var_proto_enumTypes[0]
+}
+
+func (x ConfigVarChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigVarChangeType.Descriptor instead.
+func (ConfigVarChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{0}
+}
+
+// ConfigVar is a plaintext environment variable of a site
+type ConfigVar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Uppercase, starts with a letter, e.g. PHP_MEMORY_LIMIT
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Revision      int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`                    // The revision that last set it
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigVar) Reset() {
+	*x = ConfigVar{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVar) ProtoMessage() {}
+
+func (x *ConfigVar) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVar.ProtoReflect.Descriptor instead.
+func (*ConfigVar) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigVar) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ConfigVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigVar) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigVar) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ConfigVarRevision is one change to a site's config vars
+type ConfigVarRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // Numbered from 1 per site
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChangeType    ConfigVarChangeType    `protobuf:"varint,3,opt,name=change_type,json=changeType,proto3,enum=libops.v1.ConfigVarChangeType" json:"change_type,omitempty"`
+	PreviousValue string                 `protobuf:"bytes,4,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"` // Empty when the var was added
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`                                      // Empty when the var was removed
+	ChangedBy     string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`             // Email of the account that made the change
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigVarRevision) Reset() {
+	*x = ConfigVarRevision{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigVarRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVarRevision) ProtoMessage() {}
+
+func (x *ConfigVarRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVarRevision.ProtoReflect.Descriptor instead.
+func (*ConfigVarRevision) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigVarRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigVarRevision) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigVarRevision) GetChangeType() ConfigVarChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigVarRevision) GetPreviousValue() string {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return ""
+}
+
+func (x *ConfigVarRevision) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigVarRevision) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ConfigVarRevision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// ConfigVarDiff is how a config var differs between two revisions
+type ConfigVarDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChangeType    ConfigVarChangeType    `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=libops.v1.ConfigVarChangeType" json:"change_type,omitempty"`
+	FromValue     string                 `protobuf:"bytes,3,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"` // Empty when the var was added
+	ToValue       string                 `protobuf:"bytes,4,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`       // Empty when the var was removed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigVarDiff) Reset() {
+	*x = ConfigVarDiff{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigVarDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigVarDiff) ProtoMessage() {}
+
+func (x *ConfigVarDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigVarDiff.ProtoReflect.Descriptor instead.
+func (*ConfigVarDiff) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigVarDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigVarDiff) GetChangeType() ConfigVarChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ConfigVarChangeType_CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigVarDiff) GetFromValue() string {
+	if x != nil {
+		return x.FromValue
+	}
+	return ""
+}
+
+func (x *ConfigVarDiff) GetToValue() string {
+	if x != nil {
+		return x.ToValue
+	}
+	return ""
+}
+
+type ListConfigVarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigVarsRequest) Reset() {
+	*x = ListConfigVarsRequest{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigVarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigVarsRequest) ProtoMessage() {}
+
+func (x *ListConfigVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigVarsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigVarsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListConfigVarsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListConfigVarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConfigVarsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListConfigVarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigVars    []*ConfigVar           `protobuf:"bytes,1,rep,name=config_vars,json=configVars,proto3" json:"config_vars,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Revision      int64                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"` // The site's latest revision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigVarsResponse) Reset() {
+	*x = ListConfigVarsResponse{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigVarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigVarsResponse) ProtoMessage() {}
+
+func (x *ListConfigVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigVarsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigVarsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListConfigVarsResponse) GetConfigVars() []*ConfigVar {
+	if x != nil {
+		return x.ConfigVars
+	}
+	return nil
+}
+
+func (x *ListConfigVarsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListConfigVarsResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type SetConfigVarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConfigVarRequest) Reset() {
+	*x = SetConfigVarRequest{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConfigVarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigVarRequest) ProtoMessage() {}
+
+func (x *SetConfigVarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigVarRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigVarRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetConfigVarRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SetConfigVarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetConfigVarRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetConfigVarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigVar     *ConfigVar             `protobuf:"bytes,1,opt,name=config_var,json=configVar,proto3" json:"config_var,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConfigVarResponse) Reset() {
+	*x = SetConfigVarResponse{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConfigVarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigVarResponse) ProtoMessage() {}
+
+func (x *SetConfigVarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigVarResponse.ProtoReflect.Descriptor instead.
+func (*SetConfigVarResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetConfigVarResponse) GetConfigVar() *ConfigVar {
+	if x != nil {
+		return x.ConfigVar
+	}
+	return nil
+}
+
+type DeleteConfigVarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConfigVarRequest) Reset() {
+	*x = DeleteConfigVarRequest{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigVarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigVarRequest) ProtoMessage() {}
+
+func (x *DeleteConfigVarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigVarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConfigVarRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteConfigVarRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DeleteConfigVarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListConfigVarRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigVarRevisionsRequest) Reset() {
+	*x = ListConfigVarRevisionsRequest{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigVarRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigVarRevisionsRequest) ProtoMessage() {}
+
+func (x *ListConfigVarRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigVarRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigVarRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListConfigVarRevisionsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListConfigVarRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConfigVarRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListConfigVarRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ConfigVarRevision   `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigVarRevisionsResponse) Reset() {
+	*x = ListConfigVarRevisionsResponse{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigVarRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigVarRevisionsResponse) ProtoMessage() {}
+
+func (x *ListConfigVarRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigVarRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigVarRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListConfigVarRevisionsResponse) GetRevisions() []*ConfigVarRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListConfigVarRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DiffConfigVarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	FromRevision  int64                  `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"` // 0 compares against no config vars
+	ToRevision    int64                  `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`       // Defaults to the latest revision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffConfigVarsRequest) Reset() {
+	*x = DiffConfigVarsRequest{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigVarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigVarsRequest) ProtoMessage() {}
+
+func (x *DiffConfigVarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigVarsRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigVarsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiffConfigVarsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DiffConfigVarsRequest) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigVarsRequest) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type DiffConfigVarsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromRevision  int64                  `protobuf:"varint,1,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    int64                  `protobuf:"varint,2,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Diffs         []*ConfigVarDiff       `protobuf:"bytes,3,rep,name=diffs,proto3" json:"diffs,omitempty"` // By name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffConfigVarsResponse) Reset() {
+	*x = DiffConfigVarsResponse{}
+	mi := &file_libops_v1_config_var_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffConfigVarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffConfigVarsResponse) ProtoMessage() {}
+
+func (x *DiffConfigVarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_config_var_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffConfigVarsResponse.ProtoReflect.Descriptor instead.
+func (*DiffConfigVarsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_config_var_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiffConfigVarsResponse) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigVarsResponse) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffConfigVarsResponse) GetDiffs() []*ConfigVarDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+var File_libops_v1_config_var_proto protoreflect.FileDescriptor
+
+const file_libops_v1_config_var_proto_rawDesc = "" +
+	"\n" +
+	"\x1alibops/v1/config_var.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\x89\x01\n" +
+	"\tConfigVar\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\xff\x01\n" +
+	"\x11ConfigVarRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12?\n" +
+	"\vchange_type\x18\x03 \x01(\x0e2\x1e.libops.v1.ConfigVarChangeTypeR\n" +
+	"changeType\x12%\n" +
+	"\x0eprevious_value\x18\x04 \x01(\tR\rpreviousValue\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x06 \x01(\tR\tchangedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\x9e\x01\n" +
+	"\rConfigVarDiff\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12?\n" +
+	"\vchange_type\x18\x02 \x01(\x0e2\x1e.libops.v1.ConfigVarChangeTypeR\n" +
+	"changeType\x12\x1d\n" +
+	"\n" +
+	"from_value\x18\x03 \x01(\tR\tfromValue\x12\x19\n" +
+	"\bto_value\x18\x04 \x01(\tR\atoValue\"l\n" +
+	"\x15ListConfigVarsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x93\x01\n" +
+	"\x16ListConfigVarsResponse\x125\n" +
+	"\vconfig_vars\x18\x01 \x03(\v2\x14.libops.v1.ConfigVarR\n" +
+	"configVars\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\"X\n" +
+	"\x13SetConfigVarRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"K\n" +
+	"\x14SetConfigVarResponse\x123\n" +
+	"\n" +
+	"config_var\x18\x01 \x01(\v2\x14.libops.v1.ConfigVarR\tconfigVar\"E\n" +
+	"\x16DeleteConfigVarRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"t\n" +
+	"\x1dListConfigVarRevisionsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"\x1eListConfigVarRevisionsResponse\x12:\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1c.libops.v1.ConfigVarRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"v\n" +
+	"\x15DiffConfigVarsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\x03R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x03 \x01(\x03R\n" +
+	"toRevision\"\x8e\x01\n" +
+	"\x16DiffConfigVarsResponse\x12#\n" +
+	"\rfrom_revision\x18\x01 \x01(\x03R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x02 \x01(\x03R\n" +
+	"toRevision\x12.\n" +
+	"\x05diffs\x18\x03 \x03(\v2\x18.libops.v1.ConfigVarDiffR\x05diffs*\xa7\x01\n" +
+	"\x13ConfigVarChangeType\x12&\n" +
+	"\"CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCONFIG_VAR_CHANGE_TYPE_ADDED\x10\x01\x12\"\n" +
+	"\x1eCONFIG_VAR_CHANGE_TYPE_CHANGED\x10\x02\x12\"\n" +
+	"\x1eCONFIG_VAR_CHANGE_TYPE_REMOVED\x10\x032\xde\x06\n" +
+	"\x14SiteConfigVarService\x12\x9e\x01\n" +
+	"\x0eListConfigVars\x12 .libops.v1.ListConfigVarsRequest\x1a!.libops.v1.ListConfigVarsResponse\"G\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02 \x12\x1e/v1/sites/{site_id}/configVars\x90\x02\x01\x12\xa0\x01\n" +
+	"\fSetConfigVar\x12\x1e.libops.v1.SetConfigVarRequest\x1a\x1f.libops.v1.SetConfigVarResponse\"O\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02*:\x01*\x1a%/v1/sites/{site_id}/configVars/{name}\x12\x9a\x01\n" +
+	"\x0fDeleteConfigVar\x12!.libops.v1.DeleteConfigVarRequest\x1a\x16.google.protobuf.Empty\"L\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02'*%/v1/sites/{site_id}/configVars/{name}\x12\xbe\x01\n" +
+	"\x16ListConfigVarRevisions\x12(.libops.v1.ListConfigVarRevisionsRequest\x1a).libops.v1.ListConfigVarRevisionsResponse\"O\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02(\x12&/v1/sites/{site_id}/configVarRevisions\x90\x02\x01\x12\xa3\x01\n" +
+	"\x0eDiffConfigVars\x12 .libops.v1.DiffConfigVarsRequest\x1a!.libops.v1.DiffConfigVarsResponse\"L\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02%\x12#/v1/sites/{site_id}/configVars:diff\x90\x02\x01B\x94\x01\n" +
+	"\rcom.libops.v1B\x0eConfigVarProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_config_var_proto_rawDescOnce sync.Once
+	file_libops_v1_config_var_proto_rawDescData []byte
+)
+
+func file_libops_v1_config_var_proto_rawDescGZIP() []byte {
+	file_libops_v1_config_var_proto_rawDescOnce.Do(func() {
+		file_libops_v1_config_var_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_config_var_proto_rawDesc), len(file_libops_v1_config_var_proto_rawDesc)))
+	})
+	return file_libops_v1_config_var_proto_rawDescData
+}
+
+var file_libops_v1_config_var_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_config_var_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_libops_v1_config_var_proto_goTypes = []any{
+	(ConfigVarChangeType)(0),               // 0: libops.v1.ConfigVarChangeType
+	(*ConfigVar)(nil),                      // 1: libops.v1.ConfigVar
+	(*ConfigVarRevision)(nil),              // 2: libops.v1.ConfigVarRevision
+	(*ConfigVarDiff)(nil),                  // 3: libops.v1.ConfigVarDiff
+	(*ListConfigVarsRequest)(nil),          // 4: libops.v1.ListConfigVarsRequest
+	(*ListConfigVarsResponse)(nil),         // 5: libops.v1.ListConfigVarsResponse
+	(*SetConfigVarRequest)(nil),            // 6: libops.v1.SetConfigVarRequest
+	(*SetConfigVarResponse)(nil),           // 7: libops.v1.SetConfigVarResponse
+	(*DeleteConfigVarRequest)(nil),         // 8: libops.v1.DeleteConfigVarRequest
+	(*ListConfigVarRevisionsRequest)(nil),  // 9: libops.v1.ListConfigVarRevisionsRequest
+	(*ListConfigVarRevisionsResponse)(nil), // 10: libops.v1.ListConfigVarRevisionsResponse
+	(*DiffConfigVarsRequest)(nil),          // 11: libops.v1.DiffConfigVarsRequest
+	(*DiffConfigVarsResponse)(nil),         // 12: libops.v1.DiffConfigVarsResponse
+	(*emptypb.Empty)(nil),                  // 13: google.protobuf.Empty
+}
+var file_libops_v1_config_var_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.ConfigVarRevision.change_type:type_name -> libops.v1.ConfigVarChangeType
+	0,  // 1: libops.v1.ConfigVarDiff.change_type:type_name -> libops.v1.ConfigVarChangeType
+	1,  // 2: libops.v1.ListConfigVarsResponse.config_vars:type_name -> libops.v1.ConfigVar
+	1,  // 3: libops.v1.SetConfigVarResponse.config_var:type_name -> libops.v1.ConfigVar
+	2,  // 4: libops.v1.ListConfigVarRevisionsResponse.revisions:type_name -> libops.v1.ConfigVarRevision
+	3,  // 5: libops.v1.DiffConfigVarsResponse.diffs:type_name -> libops.v1.ConfigVarDiff
+	4,  // 6: libops.v1.SiteConfigVarService.ListConfigVars:input_type -> libops.v1.ListConfigVarsRequest
+	6,  // 7: libops.v1.SiteConfigVarService.SetConfigVar:input_type -> libops.v1.SetConfigVarRequest
+	8,  // 8: libops.v1.SiteConfigVarService.DeleteConfigVar:input_type -> libops.v1.DeleteConfigVarRequest
+	9,  // 9: libops.v1.SiteConfigVarService.ListConfigVarRevisions:input_type -> libops.v1.ListConfigVarRevisionsRequest
+	11, // 10: libops.v1.SiteConfigVarService.DiffConfigVars:input_type -> libops.v1.DiffConfigVarsRequest
+	5,  // 11: libops.v1.SiteConfigVarService.ListConfigVars:output_type -> libops.v1.ListConfigVarsResponse
+	7,  // 12: libops.v1.SiteConfigVarService.SetConfigVar:output_type -> libops.v1.SetConfigVarResponse
+	13, // 13: libops.v1.SiteConfigVarService.DeleteConfigVar:output_type -> google.protobuf.Empty
+	10, // 14: libops.v1.SiteConfigVarService.ListConfigVarRevisions:output_type -> libops.v1.ListConfigVarRevisionsResponse
+	12, // 15: libops.v1.SiteConfigVarService.DiffConfigVars:output_type -> libops.v1.DiffConfigVarsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_config_var_proto_init() }
+func file_libops_v1_config_var_proto_init() {
+	if File_libops_v1_config_var_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_config_var_proto_rawDesc), len(file_libops_v1_config_var_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_config_var_proto_goTypes,
+		DependencyIndexes: file_libops_v1_config_var_proto_depIdxs,
+		EnumInfos:         file_libops_v1_config_var_proto_enumTypes,
+		MessageInfos:      file_libops_v1_config_var_proto_msgTypes,
+	}.Build()
+	File_libops_v1_config_var_proto = out.File
+	file_libops_v1_config_var_proto_goTypes = nil
+	file_libops_v1_config_var_proto_depIdxs = nil
+}
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/database.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DatabaseTier int32
+
+const (
+	DatabaseTier_DATABASE_TIER_UNSPECIFIED DatabaseTier = 0
+	DatabaseTier_DATABASE_TIER_COLOCATED   DatabaseTier = 1 // On a MySQL server on the site's VM
+	DatabaseTier_DATABASE_TIER_CLOUD_SQL   DatabaseTier = 2 // On a Cloud SQL instance of the site's own
+)
+
+// Enum value maps for DatabaseTier.
+var (
+	DatabaseTier_name = map[int32]string{
+		0: "DATABASE_TIER_UNSPECIFIED",
+		1: "DATABASE_TIER_COLOCATED",
+		2: "DATABASE_TIER_CLOUD_SQL",
+	}
+	DatabaseTier_value = map[string]int32{
+		"DATABASE_TIER_UNSPECIFIED": 0,
+		"DATABASE_TIER_COLOCATED":   1,
+		"DATABASE_TIER_CLOUD_SQL":   2,
+	}
+)
+
+func (x DatabaseTier) Enum() *DatabaseTier {
+	p := new(DatabaseTier)
+	*p = x
+	return p
+}
+
+func (x DatabaseTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_database_proto_enumTypes[0].Descriptor()
+}
+
+func (DatabaseTier) Type() protoreflect.EnumType {
+	return &file_libops_v1_database_proto_enumTypes[0]
+}
+
+func (x DatabaseTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseTier.Descriptor instead.
+func (DatabaseTier) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{0}
+}
+
+type DatabaseStatus int32
+
+const (
+	DatabaseStatus_DATABASE_STATUS_UNSPECIFIED  DatabaseStatus = 0
+	DatabaseStatus_DATABASE_STATUS_PROVISIONING DatabaseStatus = 1
+	DatabaseStatus_DATABASE_STATUS_ACTIVE       DatabaseStatus = 2
+	DatabaseStatus_DATABASE_STATUS_FAILED       DatabaseStatus = 3
+)
+
+// Enum value maps for DatabaseStatus.
+var (
+	DatabaseStatus_name = map[int32]string{
+		0: "DATABASE_STATUS_UNSPECIFIED",
+		1: "DATABASE_STATUS_PROVISIONING",
+		2: "DATABASE_STATUS_ACTIVE",
+		3: "DATABASE_STATUS_FAILED",
+	}
+	DatabaseStatus_value = map[string]int32{
+		"DATABASE_STATUS_UNSPECIFIED":  0,
+		"DATABASE_STATUS_PROVISIONING": 1,
+		"DATABASE_STATUS_ACTIVE":       2,
+		"DATABASE_STATUS_FAILED":       3,
+	}
+)
+
+func (x DatabaseStatus) Enum() *DatabaseStatus {
+	p := new(DatabaseStatus)
+	*p = x
+	return p
+}
+
+func (x DatabaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DatabaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_database_proto_enumTypes[1].Descriptor()
+}
+
+func (DatabaseStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_database_proto_enumTypes[1]
+}
+
+func (x DatabaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DatabaseStatus.Descriptor instead.
+func (DatabaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{1}
+}
+
+type SiteDatabase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseId    string                 `protobuf:"bytes,1,opt,name=database_id,json=databaseId,proto3" json:"database_id,omitempty"`
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // Also the name of its user
+	Tier          DatabaseTier           `protobuf:"varint,4,opt,name=tier,proto3,enum=libops.v1.DatabaseTier" json:"tier,omitempty"`
+	Status        DatabaseStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=libops.v1.DatabaseStatus" json:"status,omitempty"`
+	StatusMessage string                 `protobuf:"bytes,6,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"` // Why provisioning failed
+	Host          string                 `protobuf:"bytes,7,opt,name=host,proto3" json:"host,omitempty"`                                        // Empty until it's provisioned
+	Port          int32                  `protobuf:"varint,8,opt,name=port,proto3" json:"port,omitempty"`
+	// Managed site secrets holding its credentials
+	UserSecretName     string `protobuf:"bytes,9,opt,name=user_secret_name,json=userSecretName,proto3" json:"user_secret_name,omitempty"`
+	PasswordSecretName string `protobuf:"bytes,10,opt,name=password_secret_name,json=passwordSecretName,proto3" json:"password_secret_name,omitempty"`
+	HostSecretName     string `protobuf:"bytes,11,opt,name=host_secret_name,json=hostSecretName,proto3" json:"host_secret_name,omitempty"`
+	PortSecretName     string `protobuf:"bytes,12,opt,name=port_secret_name,json=portSecretName,proto3" json:"port_secret_name,omitempty"`
+	PasswordRotatedAt  int64  `protobuf:"varint,13,opt,name=password_rotated_at,json=passwordRotatedAt,proto3" json:"password_rotated_at,omitempty"` // Unix timestamp
+	CreatedAt          int64  `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // Unix timestamp
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SiteDatabase) Reset() {
+	*x = SiteDatabase{}
+	mi := &file_libops_v1_database_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteDatabase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteDatabase) ProtoMessage() {}
+
+func (x *SiteDatabase) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteDatabase.ProtoReflect.Descriptor instead.
+func (*SiteDatabase) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteDatabase) GetDatabaseId() string {
+	if x != nil {
+		return x.DatabaseId
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetTier() DatabaseTier {
+	if x != nil {
+		return x.Tier
+	}
+	return DatabaseTier_DATABASE_TIER_UNSPECIFIED
+}
+
+func (x *SiteDatabase) GetStatus() DatabaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DatabaseStatus_DATABASE_STATUS_UNSPECIFIED
+}
+
+func (x *SiteDatabase) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SiteDatabase) GetUserSecretName() string {
+	if x != nil {
+		return x.UserSecretName
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetPasswordSecretName() string {
+	if x != nil {
+		return x.PasswordSecretName
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetHostSecretName() string {
+	if x != nil {
+		return x.HostSecretName
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetPortSecretName() string {
+	if x != nil {
+		return x.PortSecretName
+	}
+	return ""
+}
+
+func (x *SiteDatabase) GetPasswordRotatedAt() int64 {
+	if x != nil {
+		return x.PasswordRotatedAt
+	}
+	return 0
+}
+
+func (x *SiteDatabase) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateDatabaseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Lowercase letters, digits and underscores, starting with a letter; at
+	// most 32 characters
+	Name          string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tier          DatabaseTier `protobuf:"varint,3,opt,name=tier,proto3,enum=libops.v1.DatabaseTier" json:"tier,omitempty"` // Defaults to colocated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDatabaseRequest) Reset() {
+	*x = CreateDatabaseRequest{}
+	mi := &file_libops_v1_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatabaseRequest) ProtoMessage() {}
+
+func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDatabaseRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *CreateDatabaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDatabaseRequest) GetTier() DatabaseTier {
+	if x != nil {
+		return x.Tier
+	}
+	return DatabaseTier_DATABASE_TIER_UNSPECIFIED
+}
+
+type CreateDatabaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *SiteDatabase          `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDatabaseResponse) Reset() {
+	*x = CreateDatabaseResponse{}
+	mi := &file_libops_v1_database_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatabaseResponse) ProtoMessage() {}
+
+func (x *CreateDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDatabaseResponse) GetDatabase() *SiteDatabase {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+type ListDatabasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabasesRequest) Reset() {
+	*x = ListDatabasesRequest{}
+	mi := &file_libops_v1_database_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabasesRequest) ProtoMessage() {}
+
+func (x *ListDatabasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabasesRequest.ProtoReflect.Descriptor instead.
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDatabasesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListDatabasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Databases     []*SiteDatabase        `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDatabasesResponse) Reset() {
+	*x = ListDatabasesResponse{}
+	mi := &file_libops_v1_database_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDatabasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatabasesResponse) ProtoMessage() {}
+
+func (x *ListDatabasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatabasesResponse.ProtoReflect.Descriptor instead.
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDatabasesResponse) GetDatabases() []*SiteDatabase {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type ResetDatabasePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	DatabaseId    string                 `protobuf:"bytes,2,opt,name=database_id,json=databaseId,proto3" json:"database_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDatabasePasswordRequest) Reset() {
+	*x = ResetDatabasePasswordRequest{}
+	mi := &file_libops_v1_database_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDatabasePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDatabasePasswordRequest) ProtoMessage() {}
+
+func (x *ResetDatabasePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDatabasePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetDatabasePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResetDatabasePasswordRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ResetDatabasePasswordRequest) GetDatabaseId() string {
+	if x != nil {
+		return x.DatabaseId
+	}
+	return ""
+}
+
+type ResetDatabasePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *SiteDatabase          `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetDatabasePasswordResponse) Reset() {
+	*x = ResetDatabasePasswordResponse{}
+	mi := &file_libops_v1_database_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetDatabasePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetDatabasePasswordResponse) ProtoMessage() {}
+
+func (x *ResetDatabasePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_database_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetDatabasePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetDatabasePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_database_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResetDatabasePasswordResponse) GetDatabase() *SiteDatabase {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+var File_libops_v1_database_proto protoreflect.FileDescriptor
+
+const file_libops_v1_database_proto_rawDesc = "" +
+	"\n" +
+	"\x18libops/v1/database.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\x8a\x04\n" +
+	"\fSiteDatabase\x12\x1f\n" +
+	"\vdatabase_id\x18\x01 \x01(\tR\n" +
+	"databaseId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12+\n" +
+	"\x04tier\x18\x04 \x01(\x0e2\x17.libops.v1.DatabaseTierR\x04tier\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.libops.v1.DatabaseStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\x06 \x01(\tR\rstatusMessage\x12\x12\n" +
+	"\x04host\x18\a \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\b \x01(\x05R\x04port\x12(\n" +
+	"\x10user_secret_name\x18\t \x01(\tR\x0euserSecretName\x120\n" +
+	"\x14password_secret_name\x18\n" +
+	" \x01(\tR\x12passwordSecretName\x12(\n" +
+	"\x10host_secret_name\x18\v \x01(\tR\x0ehostSecretName\x12(\n" +
+	"\x10port_secret_name\x18\f \x01(\tR\x0eportSecretName\x12.\n" +
+	"\x13password_rotated_at\x18\r \x01(\x03R\x11passwordRotatedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\x03R\tcreatedAt\"q\n" +
+	"\x15CreateDatabaseRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
+	"\x04tier\x18\x03 \x01(\x0e2\x17.libops.v1.DatabaseTierR\x04tier\"M\n" +
+	"\x16CreateDatabaseResponse\x123\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x17.libops.v1.SiteDatabaseR\bdatabase\"/\n" +
+	"\x14ListDatabasesRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"N\n" +
+	"\x15ListDatabasesResponse\x125\n" +
+	"\tdatabases\x18\x01 \x03(\v2\x17.libops.v1.SiteDatabaseR\tdatabases\"X\n" +
+	"\x1cResetDatabasePasswordRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1f\n" +
+	"\vdatabase_id\x18\x02 \x01(\tR\n" +
+	"databaseId\"T\n" +
+	"\x1dResetDatabasePasswordResponse\x123\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x17.libops.v1.SiteDatabaseR\bdatabase*g\n" +
+	"\fDatabaseTier\x12\x1d\n" +
+	"\x19DATABASE_TIER_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DATABASE_TIER_COLOCATED\x10\x01\x12\x1b\n" +
+	"\x17DATABASE_TIER_CLOUD_SQL\x10\x02*\x8b\x01\n" +
+	"\x0eDatabaseStatus\x12\x1f\n" +
+	"\x1bDATABASE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDATABASE_STATUS_PROVISIONING\x10\x01\x12\x1a\n" +
+	"\x16DATABASE_STATUS_ACTIVE\x10\x02\x12\x1a\n" +
+	"\x16DATABASE_STATUS_FAILED\x10\x032\x9e\x04\n" +
+	"\x0fDatabaseService\x12\x9e\x01\n" +
+	"\x0eCreateDatabase\x12 .libops.v1.CreateDatabaseRequest\x1a!.libops.v1.CreateDatabaseResponse\"G\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sites/{site_id}/databases\x12\x9a\x01\n" +
+	"\rListDatabases\x12\x1f.libops.v1.ListDatabasesRequest\x1a .libops.v1.ListDatabasesResponse\"F\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/sites/{site_id}/databases\x90\x02\x01\x12\xcc\x01\n" +
+	"\x15ResetDatabasePassword\x12'.libops.v1.ResetDatabasePasswordRequest\x1a(.libops.v1.ResetDatabasePasswordResponse\"`\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02;\"9/v1/sites/{site_id}/databases/{database_id}:resetPasswordB\x93\x01\n" +
+	"\rcom.libops.v1B\rDatabaseProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_database_proto_rawDescOnce sync.Once
+	file_libops_v1_database_proto_rawDescData []byte
+)
+
+func file_libops_v1_database_proto_rawDescGZIP() []byte {
+	file_libops_v1_database_proto_rawDescOnce.Do(func() {
+		file_libops_v1_database_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_database_proto_rawDesc), len(file_libops_v1_database_proto_rawDesc)))
+	})
+	return file_libops_v1_database_proto_rawDescData
+}
+
+var file_libops_v1_database_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_database_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_libops_v1_database_proto_goTypes = []any{
+	(DatabaseTier)(0),                     // 0: libops.v1.DatabaseTier
+	(DatabaseStatus)(0),                   // 1: libops.v1.DatabaseStatus
+	(*SiteDatabase)(nil),                  // 2: libops.v1.SiteDatabase
+	(*CreateDatabaseRequest)(nil),         // 3: libops.v1.CreateDatabaseRequest
+	(*CreateDatabaseResponse)(nil),        // 4: libops.v1.CreateDatabaseResponse
+	(*ListDatabasesRequest)(nil),          // 5: libops.v1.ListDatabasesRequest
+	(*ListDatabasesResponse)(nil),         // 6: libops.v1.ListDatabasesResponse
+	(*ResetDatabasePasswordRequest)(nil),  // 7: libops.v1.ResetDatabasePasswordRequest
+	(*ResetDatabasePasswordResponse)(nil), // 8: libops.v1.ResetDatabasePasswordResponse
+}
+var file_libops_v1_database_proto_depIdxs = []int32{
+	0, // 0: libops.v1.SiteDatabase.tier:type_name -> libops.v1.DatabaseTier
+	1, // 1: libops.v1.SiteDatabase.status:type_name -> libops.v1.DatabaseStatus
+	0, // 2: libops.v1.CreateDatabaseRequest.tier:type_name -> libops.v1.DatabaseTier
+	2, // 3: libops.v1.CreateDatabaseResponse.database:type_name -> libops.v1.SiteDatabase
+	2, // 4: libops.v1.ListDatabasesResponse.databases:type_name -> libops.v1.SiteDatabase
+	2, // 5: libops.v1.ResetDatabasePasswordResponse.database:type_name -> libops.v1.SiteDatabase
+	3, // 6: libops.v1.DatabaseService.CreateDatabase:input_type -> libops.v1.CreateDatabaseRequest
+	5, // 7: libops.v1.DatabaseService.ListDatabases:input_type -> libops.v1.ListDatabasesRequest
+	7, // 8: libops.v1.DatabaseService.ResetDatabasePassword:input_type -> libops.v1.ResetDatabasePasswordRequest
+	4, // 9: libops.v1.DatabaseService.CreateDatabase:output_type -> libops.v1.CreateDatabaseResponse
+	6, // 10: libops.v1.DatabaseService.ListDatabases:output_type -> libops.v1.ListDatabasesResponse
+	8, // 11: libops.v1.DatabaseService.ResetDatabasePassword:output_type -> libops.v1.ResetDatabasePasswordResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_database_proto_init() }
+func file_libops_v1_database_proto_init() {
+	if File_libops_v1_database_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_database_proto_rawDesc), len(file_libops_v1_database_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_database_proto_goTypes,
+		DependencyIndexes: file_libops_v1_database_proto_depIdxs,
+		EnumInfos:         file_libops_v1_database_proto_enumTypes,
+		MessageInfos:      file_libops_v1_database_proto_msgTypes,
+	}.Build()
+	File_libops_v1_database_proto = out.File
+	file_libops_v1_database_proto_goTypes = nil
+	file_libops_v1_database_proto_depIdxs = nil
+}
@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/domain.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SiteDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`                         // Lowercase hostname, e.g. "digital.example.edu"
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteDomain) Reset() {
+	*x = SiteDomain{}
+	mi := &file_libops_v1_domain_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteDomain) ProtoMessage() {}
+
+func (x *SiteDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteDomain.ProtoReflect.Descriptor instead.
+func (*SiteDomain) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteDomain) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SiteDomain) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListSiteDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSiteDomainsRequest) Reset() {
+	*x = ListSiteDomainsRequest{}
+	mi := &file_libops_v1_domain_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSiteDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSiteDomainsRequest) ProtoMessage() {}
+
+func (x *ListSiteDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSiteDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListSiteDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSiteDomainsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListSiteDomainsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSiteDomainsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSiteDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []*SiteDomain          `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSiteDomainsResponse) Reset() {
+	*x = ListSiteDomainsResponse{}
+	mi := &file_libops_v1_domain_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSiteDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSiteDomainsResponse) ProtoMessage() {}
+
+func (x *ListSiteDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSiteDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListSiteDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSiteDomainsResponse) GetDomains() []*SiteDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *ListSiteDomainsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateSiteDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSiteDomainRequest) Reset() {
+	*x = CreateSiteDomainRequest{}
+	mi := &file_libops_v1_domain_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteDomainRequest) ProtoMessage() {}
+
+func (x *CreateSiteDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteDomainRequest.ProtoReflect.Descriptor instead.
+func (*CreateSiteDomainRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSiteDomainRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *CreateSiteDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CreateSiteDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *SiteDomain            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSiteDomainResponse) Reset() {
+	*x = CreateSiteDomainResponse{}
+	mi := &file_libops_v1_domain_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteDomainResponse) ProtoMessage() {}
+
+func (x *CreateSiteDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteDomainResponse.ProtoReflect.Descriptor instead.
+func (*CreateSiteDomainResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSiteDomainResponse) GetDomain() *SiteDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type DeleteSiteDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteDomainRequest) Reset() {
+	*x = DeleteSiteDomainRequest{}
+	mi := &file_libops_v1_domain_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteDomainRequest) ProtoMessage() {}
+
+func (x *DeleteSiteDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_domain_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteDomainRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteDomainRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_domain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteSiteDomainRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DeleteSiteDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+var File_libops_v1_domain_proto protoreflect.FileDescriptor
+
+const file_libops_v1_domain_proto_rawDesc = "" +
+	"\n" +
+	"\x16libops/v1/domain.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\\\n" +
+	"\n" +
+	"SiteDomain\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\tcreatedAt\"m\n" +
+	"\x16ListSiteDomainsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"r\n" +
+	"\x17ListSiteDomainsResponse\x12/\n" +
+	"\adomains\x18\x01 \x03(\v2\x15.libops.v1.SiteDomainR\adomains\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"J\n" +
+	"\x17CreateSiteDomainRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"I\n" +
+	"\x18CreateSiteDomainResponse\x12-\n" +
+	"\x06domain\x18\x01 \x01(\v2\x15.libops.v1.SiteDomainR\x06domain\"J\n" +
+	"\x17DeleteSiteDomainRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain2\xf7\x03\n" +
+	"\x11SiteDomainService\x12\x9e\x01\n" +
+	"\x0fListSiteDomains\x12!.libops.v1.ListSiteDomainsRequest\x1a\".libops.v1.ListSiteDomainsResponse\"D\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/sites/{site_id}/domains\x90\x02\x01\x12\xa2\x01\n" +
+	"\x10CreateSiteDomain\x12\".libops.v1.CreateSiteDomainRequest\x1a#.libops.v1.CreateSiteDomainResponse\"E\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/sites/{site_id}/domains\x12\x9b\x01\n" +
+	"\x10DeleteSiteDomain\x12\".libops.v1.DeleteSiteDomainRequest\x1a\x16.google.protobuf.Empty\"K\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02&*$/v1/sites/{site_id}/domains/{domain}B\x91\x01\n" +
+	"\rcom.libops.v1B\vDomainProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_domain_proto_rawDescOnce sync.Once
+	file_libops_v1_domain_proto_rawDescData []byte
+)
+
+func file_libops_v1_domain_proto_rawDescGZIP() []byte {
+	file_libops_v1_domain_proto_rawDescOnce.Do(func() {
+		file_libops_v1_domain_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_domain_proto_rawDesc), len(file_libops_v1_domain_proto_rawDesc)))
+	})
+	return file_libops_v1_domain_proto_rawDescData
+}
+
+var file_libops_v1_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_libops_v1_domain_proto_goTypes = []any{
+	(*SiteDomain)(nil),               // 0: libops.v1.SiteDomain
+	(*ListSiteDomainsRequest)(nil),   // 1: libops.v1.ListSiteDomainsRequest
+	(*ListSiteDomainsResponse)(nil),  // 2: libops.v1.ListSiteDomainsResponse
+	(*CreateSiteDomainRequest)(nil),  // 3: libops.v1.CreateSiteDomainRequest
+	(*CreateSiteDomainResponse)(nil), // 4: libops.v1.CreateSiteDomainResponse
+	(*DeleteSiteDomainRequest)(nil),  // 5: libops.v1.DeleteSiteDomainRequest
+	(*emptypb.Empty)(nil),            // 6: google.protobuf.Empty
+}
+var file_libops_v1_domain_proto_depIdxs = []int32{
+	0, // 0: libops.v1.ListSiteDomainsResponse.domains:type_name -> libops.v1.SiteDomain
+	0, // 1: libops.v1.CreateSiteDomainResponse.domain:type_name -> libops.v1.SiteDomain
+	1, // 2: libops.v1.SiteDomainService.ListSiteDomains:input_type -> libops.v1.ListSiteDomainsRequest
+	3, // 3: libops.v1.SiteDomainService.CreateSiteDomain:input_type -> libops.v1.CreateSiteDomainRequest
+	5, // 4: libops.v1.SiteDomainService.DeleteSiteDomain:input_type -> libops.v1.DeleteSiteDomainRequest
+	2, // 5: libops.v1.SiteDomainService.ListSiteDomains:output_type -> libops.v1.ListSiteDomainsResponse
+	4, // 6: libops.v1.SiteDomainService.CreateSiteDomain:output_type -> libops.v1.CreateSiteDomainResponse
+	6, // 7: libops.v1.SiteDomainService.DeleteSiteDomain:output_type -> google.protobuf.Empty
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_domain_proto_init() }
+func file_libops_v1_domain_proto_init() {
+	if File_libops_v1_domain_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_domain_proto_rawDesc), len(file_libops_v1_domain_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_domain_proto_goTypes,
+		DependencyIndexes: file_libops_v1_domain_proto_depIdxs,
+		MessageInfos:      file_libops_v1_domain_proto_msgTypes,
+	}.Build()
+	File_libops_v1_domain_proto = out.File
+	file_libops_v1_domain_proto_goTypes = nil
+	file_libops_v1_domain_proto_depIdxs = nil
+}
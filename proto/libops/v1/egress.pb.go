@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/egress.proto
+
+package libopsv1
+
+import (
+	common "github.com/libops/api/proto/libops/v1/common"
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RequestStaticEgressIpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestStaticEgressIpRequest) Reset() {
+	*x = RequestStaticEgressIpRequest{}
+	mi := &file_libops_v1_egress_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestStaticEgressIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStaticEgressIpRequest) ProtoMessage() {}
+
+func (x *RequestStaticEgressIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_egress_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStaticEgressIpRequest.ProtoReflect.Descriptor instead.
+func (*RequestStaticEgressIpRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_egress_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestStaticEgressIpRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type RequestStaticEgressIpResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StaticEgressIp *common.StaticEgressIp `protobuf:"bytes,1,opt,name=static_egress_ip,json=staticEgressIp,proto3" json:"static_egress_ip,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestStaticEgressIpResponse) Reset() {
+	*x = RequestStaticEgressIpResponse{}
+	mi := &file_libops_v1_egress_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestStaticEgressIpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStaticEgressIpResponse) ProtoMessage() {}
+
+func (x *RequestStaticEgressIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_egress_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStaticEgressIpResponse.ProtoReflect.Descriptor instead.
+func (*RequestStaticEgressIpResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_egress_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestStaticEgressIpResponse) GetStaticEgressIp() *common.StaticEgressIp {
+	if x != nil {
+		return x.StaticEgressIp
+	}
+	return nil
+}
+
+type ReleaseStaticEgressIpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStaticEgressIpRequest) Reset() {
+	*x = ReleaseStaticEgressIpRequest{}
+	mi := &file_libops_v1_egress_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStaticEgressIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStaticEgressIpRequest) ProtoMessage() {}
+
+func (x *ReleaseStaticEgressIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_egress_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStaticEgressIpRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStaticEgressIpRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_egress_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseStaticEgressIpRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+var File_libops_v1_egress_proto protoreflect.FileDescriptor
+
+const file_libops_v1_egress_proto_rawDesc = "" +
+	"\n" +
+	"\x16libops/v1/egress.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1blibops/v1/common/site.proto\x1a\x1dlibops/v1/options/scope.proto\"7\n" +
+	"\x1cRequestStaticEgressIpRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"k\n" +
+	"\x1dRequestStaticEgressIpResponse\x12J\n" +
+	"\x10static_egress_ip\x18\x01 \x01(\v2 .libops.v1.common.StaticEgressIpR\x0estaticEgressIp\"7\n" +
+	"\x1cReleaseStaticEgressIpRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId2\xf4\x02\n" +
+	"\x11SiteEgressService\x12\xb8\x01\n" +
+	"\x15RequestStaticEgressIp\x12'.libops.v1.RequestStaticEgressIpRequest\x1a(.libops.v1.RequestStaticEgressIpResponse\"L\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/sites/{site_id}/staticEgressIp\x12\xa3\x01\n" +
+	"\x15ReleaseStaticEgressIp\x12'.libops.v1.ReleaseStaticEgressIpRequest\x1a\x16.google.protobuf.Empty\"I\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02$*\"/v1/sites/{site_id}/staticEgressIpB\x91\x01\n" +
+	"\rcom.libops.v1B\vEgressProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_egress_proto_rawDescOnce sync.Once
+	file_libops_v1_egress_proto_rawDescData []byte
+)
+
+func file_libops_v1_egress_proto_rawDescGZIP() []byte {
+	file_libops_v1_egress_proto_rawDescOnce.Do(func() {
+		file_libops_v1_egress_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_egress_proto_rawDesc), len(file_libops_v1_egress_proto_rawDesc)))
+	})
+	return file_libops_v1_egress_proto_rawDescData
+}
+
+var file_libops_v1_egress_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_libops_v1_egress_proto_goTypes = []any{
+	(*RequestStaticEgressIpRequest)(nil),  // 0: libops.v1.RequestStaticEgressIpRequest
+	(*RequestStaticEgressIpResponse)(nil), // 1: libops.v1.RequestStaticEgressIpResponse
+	(*ReleaseStaticEgressIpRequest)(nil),  // 2: libops.v1.ReleaseStaticEgressIpRequest
+	(*common.StaticEgressIp)(nil),         // 3: libops.v1.common.StaticEgressIp
+	(*emptypb.Empty)(nil),                 // 4: google.protobuf.Empty
+}
+var file_libops_v1_egress_proto_depIdxs = []int32{
+	3, // 0: libops.v1.RequestStaticEgressIpResponse.static_egress_ip:type_name -> libops.v1.common.StaticEgressIp
+	0, // 1: libops.v1.SiteEgressService.RequestStaticEgressIp:input_type -> libops.v1.RequestStaticEgressIpRequest
+	2, // 2: libops.v1.SiteEgressService.ReleaseStaticEgressIp:input_type -> libops.v1.ReleaseStaticEgressIpRequest
+	1, // 3: libops.v1.SiteEgressService.RequestStaticEgressIp:output_type -> libops.v1.RequestStaticEgressIpResponse
+	4, // 4: libops.v1.SiteEgressService.ReleaseStaticEgressIp:output_type -> google.protobuf.Empty
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_egress_proto_init() }
+func file_libops_v1_egress_proto_init() {
+	if File_libops_v1_egress_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_egress_proto_rawDesc), len(file_libops_v1_egress_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_egress_proto_goTypes,
+		DependencyIndexes: file_libops_v1_egress_proto_depIdxs,
+		MessageInfos:      file_libops_v1_egress_proto_msgTypes,
+	}.Build()
+	File_libops_v1_egress_proto = out.File
+	file_libops_v1_egress_proto_goTypes = nil
+	file_libops_v1_egress_proto_depIdxs = nil
+}
@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/elevation.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ElevationStatus int32
+
+const (
+	ElevationStatus_ELEVATION_STATUS_UNSPECIFIED ElevationStatus = 0
+	ElevationStatus_ELEVATION_STATUS_PENDING     ElevationStatus = 1 // Waiting for a site owner to approve it
+	ElevationStatus_ELEVATION_STATUS_APPROVED    ElevationStatus = 2 // The account has the role until expires_at
+	ElevationStatus_ELEVATION_STATUS_REJECTED    ElevationStatus = 3
+	ElevationStatus_ELEVATION_STATUS_REVOKED     ElevationStatus = 4
+	ElevationStatus_ELEVATION_STATUS_EXPIRED     ElevationStatus = 5
+)
+
+// Enum value maps for ElevationStatus.
+var (
+	ElevationStatus_name = map[int32]string{
+		0: "ELEVATION_STATUS_UNSPECIFIED",
+		1: "ELEVATION_STATUS_PENDING",
+		2: "ELEVATION_STATUS_APPROVED",
+		3: "ELEVATION_STATUS_REJECTED",
+		4: "ELEVATION_STATUS_REVOKED",
+		5: "ELEVATION_STATUS_EXPIRED",
+	}
+	ElevationStatus_value = map[string]int32{
+		"ELEVATION_STATUS_UNSPECIFIED": 0,
+		"ELEVATION_STATUS_PENDING":     1,
+		"ELEVATION_STATUS_APPROVED":    2,
+		"ELEVATION_STATUS_REJECTED":    3,
+		"ELEVATION_STATUS_REVOKED":     4,
+		"ELEVATION_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x ElevationStatus) Enum() *ElevationStatus {
+	p := new(ElevationStatus)
+	*p = x
+	return p
+}
+
+func (x ElevationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElevationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_elevation_proto_enumTypes[0].Descriptor()
+}
+
+func (ElevationStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_elevation_proto_enumTypes[0]
+}
+
+func (x ElevationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElevationStatus.Descriptor instead.
+func (ElevationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{0}
+}
+
+type Elevation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ElevationId     string                 `protobuf:"bytes,1,opt,name=elevation_id,json=elevationId,proto3" json:"elevation_id,omitempty"` // UUID
+	SiteId          string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // UUID of the account that requested it
+	Email           string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role            string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` // "owner" or "developer"
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // How long the role lasts once approved
+	Status          ElevationStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=libops.v1.ElevationStatus" json:"status,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`     // Unix timestamp
+	ResolvedAt      int64                  `protobuf:"varint,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unix timestamp, 0 until approved, rejected or revoked
+	ResolvedBy      string                 `protobuf:"bytes,11,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`  // Email of the account that approved, rejected or revoked it
+	ExpiresAt       int64                  `protobuf:"varint,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`    // Unix timestamp the role lapses at, 0 until approved
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Elevation) Reset() {
+	*x = Elevation{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Elevation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elevation) ProtoMessage() {}
+
+func (x *Elevation) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elevation.ProtoReflect.Descriptor instead.
+func (*Elevation) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Elevation) GetElevationId() string {
+	if x != nil {
+		return x.ElevationId
+	}
+	return ""
+}
+
+func (x *Elevation) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *Elevation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Elevation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Elevation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Elevation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Elevation) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Elevation) GetStatus() ElevationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ElevationStatus_ELEVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Elevation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Elevation) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *Elevation) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Elevation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RequestElevationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SiteId          string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Role            string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                               // "owner" or "developer"
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                           // Why the role is needed, e.g. an incident link
+	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // 1 to 1440; defaults to 60
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestElevationRequest) Reset() {
+	*x = RequestElevationRequest{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationRequest) ProtoMessage() {}
+
+func (x *RequestElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationRequest.ProtoReflect.Descriptor instead.
+func (*RequestElevationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestElevationRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type RequestElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevation     *Elevation             `protobuf:"bytes,1,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestElevationResponse) Reset() {
+	*x = RequestElevationResponse{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationResponse) ProtoMessage() {}
+
+func (x *RequestElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationResponse.ProtoReflect.Descriptor instead.
+func (*RequestElevationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestElevationResponse) GetElevation() *Elevation {
+	if x != nil {
+		return x.Elevation
+	}
+	return nil
+}
+
+type ListElevationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListElevationsRequest) Reset() {
+	*x = ListElevationsRequest{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListElevationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElevationsRequest) ProtoMessage() {}
+
+func (x *ListElevationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElevationsRequest.ProtoReflect.Descriptor instead.
+func (*ListElevationsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListElevationsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListElevationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListElevationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListElevationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevations    []*Elevation           `protobuf:"bytes,1,rep,name=elevations,proto3" json:"elevations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListElevationsResponse) Reset() {
+	*x = ListElevationsResponse{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListElevationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElevationsResponse) ProtoMessage() {}
+
+func (x *ListElevationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElevationsResponse.ProtoReflect.Descriptor instead.
+func (*ListElevationsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListElevationsResponse) GetElevations() []*Elevation {
+	if x != nil {
+		return x.Elevations
+	}
+	return nil
+}
+
+func (x *ListElevationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApproveElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	ElevationId   string                 `protobuf:"bytes,2,opt,name=elevation_id,json=elevationId,proto3" json:"elevation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveElevationRequest) Reset() {
+	*x = ApproveElevationRequest{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveElevationRequest) ProtoMessage() {}
+
+func (x *ApproveElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveElevationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveElevationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveElevationRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ApproveElevationRequest) GetElevationId() string {
+	if x != nil {
+		return x.ElevationId
+	}
+	return ""
+}
+
+type ApproveElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevation     *Elevation             `protobuf:"bytes,1,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveElevationResponse) Reset() {
+	*x = ApproveElevationResponse{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveElevationResponse) ProtoMessage() {}
+
+func (x *ApproveElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveElevationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveElevationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveElevationResponse) GetElevation() *Elevation {
+	if x != nil {
+		return x.Elevation
+	}
+	return nil
+}
+
+type RejectElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	ElevationId   string                 `protobuf:"bytes,2,opt,name=elevation_id,json=elevationId,proto3" json:"elevation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectElevationRequest) Reset() {
+	*x = RejectElevationRequest{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectElevationRequest) ProtoMessage() {}
+
+func (x *RejectElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectElevationRequest.ProtoReflect.Descriptor instead.
+func (*RejectElevationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectElevationRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RejectElevationRequest) GetElevationId() string {
+	if x != nil {
+		return x.ElevationId
+	}
+	return ""
+}
+
+type RejectElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevation     *Elevation             `protobuf:"bytes,1,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectElevationResponse) Reset() {
+	*x = RejectElevationResponse{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectElevationResponse) ProtoMessage() {}
+
+func (x *RejectElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectElevationResponse.ProtoReflect.Descriptor instead.
+func (*RejectElevationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectElevationResponse) GetElevation() *Elevation {
+	if x != nil {
+		return x.Elevation
+	}
+	return nil
+}
+
+type RevokeElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	ElevationId   string                 `protobuf:"bytes,2,opt,name=elevation_id,json=elevationId,proto3" json:"elevation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeElevationRequest) Reset() {
+	*x = RevokeElevationRequest{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeElevationRequest) ProtoMessage() {}
+
+func (x *RevokeElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeElevationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeElevationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeElevationRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RevokeElevationRequest) GetElevationId() string {
+	if x != nil {
+		return x.ElevationId
+	}
+	return ""
+}
+
+type RevokeElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Elevation     *Elevation             `protobuf:"bytes,1,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeElevationResponse) Reset() {
+	*x = RevokeElevationResponse{}
+	mi := &file_libops_v1_elevation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeElevationResponse) ProtoMessage() {}
+
+func (x *RevokeElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_elevation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeElevationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeElevationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_elevation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeElevationResponse) GetElevation() *Elevation {
+	if x != nil {
+		return x.Elevation
+	}
+	return nil
+}
+
+var File_libops_v1_elevation_proto protoreflect.FileDescriptor
+
+const file_libops_v1_elevation_proto_rawDesc = "" +
+	"\n" +
+	"\x19libops/v1/elevation.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\x87\x03\n" +
+	"\tElevation\x12!\n" +
+	"\felevation_id\x18\x01 \x01(\tR\velevationId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x122\n" +
+	"\x06status\x18\b \x01(\x0e2\x1a.libops.v1.ElevationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\x03R\n" +
+	"resolvedAt\x12\x1f\n" +
+	"\vresolved_by\x18\v \x01(\tR\n" +
+	"resolvedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\x03R\texpiresAt\"\x89\x01\n" +
+	"\x17RequestElevationRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\"N\n" +
+	"\x18RequestElevationResponse\x122\n" +
+	"\televation\x18\x01 \x01(\v2\x14.libops.v1.ElevationR\televation\"l\n" +
+	"\x15ListElevationsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"v\n" +
+	"\x16ListElevationsResponse\x124\n" +
+	"\n" +
+	"elevations\x18\x01 \x03(\v2\x14.libops.v1.ElevationR\n" +
+	"elevations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"U\n" +
+	"\x17ApproveElevationRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\felevation_id\x18\x02 \x01(\tR\velevationId\"N\n" +
+	"\x18ApproveElevationResponse\x122\n" +
+	"\televation\x18\x01 \x01(\v2\x14.libops.v1.ElevationR\televation\"T\n" +
+	"\x16RejectElevationRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\felevation_id\x18\x02 \x01(\tR\velevationId\"M\n" +
+	"\x17RejectElevationResponse\x122\n" +
+	"\televation\x18\x01 \x01(\v2\x14.libops.v1.ElevationR\televation\"T\n" +
+	"\x16RevokeElevationRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\felevation_id\x18\x02 \x01(\tR\velevationId\"M\n" +
+	"\x17RevokeElevationResponse\x122\n" +
+	"\televation\x18\x01 \x01(\v2\x14.libops.v1.ElevationR\televation*\xcb\x01\n" +
+	"\x0fElevationStatus\x12 \n" +
+	"\x1cELEVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ELEVATION_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19ELEVATION_STATUS_APPROVED\x10\x02\x12\x1d\n" +
+	"\x19ELEVATION_STATUS_REJECTED\x10\x03\x12\x1c\n" +
+	"\x18ELEVATION_STATUS_REVOKED\x10\x04\x12\x1c\n" +
+	"\x18ELEVATION_STATUS_EXPIRED\x10\x052\x9b\a\n" +
+	"\x10ElevationService\x12\xa4\x01\n" +
+	"\x10RequestElevation\x12\".libops.v1.RequestElevationRequest\x1a#.libops.v1.RequestElevationResponse\"G\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/sites/{site_id}/elevations\x12\xa1\x01\n" +
+	"\x0eListElevations\x12 .libops.v1.ListElevationsRequest\x1a!.libops.v1.ListElevationsResponse\"J\x92\xb5\x18\x1d\b\x05\x10\x01\x18\x01\"\fread:members*\asite_id\x82\xd3\xe4\x93\x02 \x12\x1e/v1/sites/{site_id}/elevations\x90\x02\x01\x12\xbf\x01\n" +
+	"\x10ApproveElevation\x12\".libops.v1.ApproveElevationRequest\x1a#.libops.v1.ApproveElevationResponse\"b\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x02::\x01*\"5/v1/sites/{site_id}/elevations/{elevation_id}:approve\x12\xbb\x01\n" +
+	"\x0fRejectElevation\x12!.libops.v1.RejectElevationRequest\x1a\".libops.v1.RejectElevationResponse\"a\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x029:\x01*\"4/v1/sites/{site_id}/elevations/{elevation_id}:reject\x12\xbb\x01\n" +
+	"\x0fRevokeElevation\x12!.libops.v1.RevokeElevationRequest\x1a\".libops.v1.RevokeElevationResponse\"a\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x029:\x01*\"4/v1/sites/{site_id}/elevations/{elevation_id}:revokeB\x94\x01\n" +
+	"\rcom.libops.v1B\x0eElevationProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_elevation_proto_rawDescOnce sync.Once
+	file_libops_v1_elevation_proto_rawDescData []byte
+)
+
+func file_libops_v1_elevation_proto_rawDescGZIP() []byte {
+	file_libops_v1_elevation_proto_rawDescOnce.Do(func() {
+		file_libops_v1_elevation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_elevation_proto_rawDesc), len(file_libops_v1_elevation_proto_rawDesc)))
+	})
+	return file_libops_v1_elevation_proto_rawDescData
+}
+
+var file_libops_v1_elevation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_elevation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_libops_v1_elevation_proto_goTypes = []any{
+	(ElevationStatus)(0),             // 0: libops.v1.ElevationStatus
+	(*Elevation)(nil),                // 1: libops.v1.Elevation
+	(*RequestElevationRequest)(nil),  // 2: libops.v1.RequestElevationRequest
+	(*RequestElevationResponse)(nil), // 3: libops.v1.RequestElevationResponse
+	(*ListElevationsRequest)(nil),    // 4: libops.v1.ListElevationsRequest
+	(*ListElevationsResponse)(nil),   // 5: libops.v1.ListElevationsResponse
+	(*ApproveElevationRequest)(nil),  // 6: libops.v1.ApproveElevationRequest
+	(*ApproveElevationResponse)(nil), // 7: libops.v1.ApproveElevationResponse
+	(*RejectElevationRequest)(nil),   // 8: libops.v1.RejectElevationRequest
+	(*RejectElevationResponse)(nil),  // 9: libops.v1.RejectElevationResponse
+	(*RevokeElevationRequest)(nil),   // 10: libops.v1.RevokeElevationRequest
+	(*RevokeElevationResponse)(nil),  // 11: libops.v1.RevokeElevationResponse
+}
+var file_libops_v1_elevation_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.Elevation.status:type_name -> libops.v1.ElevationStatus
+	1,  // 1: libops.v1.RequestElevationResponse.elevation:type_name -> libops.v1.Elevation
+	1,  // 2: libops.v1.ListElevationsResponse.elevations:type_name -> libops.v1.Elevation
+	1,  // 3: libops.v1.ApproveElevationResponse.elevation:type_name -> libops.v1.Elevation
+	1,  // 4: libops.v1.RejectElevationResponse.elevation:type_name -> libops.v1.Elevation
+	1,  // 5: libops.v1.RevokeElevationResponse.elevation:type_name -> libops.v1.Elevation
+	2,  // 6: libops.v1.ElevationService.RequestElevation:input_type -> libops.v1.RequestElevationRequest
+	4,  // 7: libops.v1.ElevationService.ListElevations:input_type -> libops.v1.ListElevationsRequest
+	6,  // 8: libops.v1.ElevationService.ApproveElevation:input_type -> libops.v1.ApproveElevationRequest
+	8,  // 9: libops.v1.ElevationService.RejectElevation:input_type -> libops.v1.RejectElevationRequest
+	10, // 10: libops.v1.ElevationService.RevokeElevation:input_type -> libops.v1.RevokeElevationRequest
+	3,  // 11: libops.v1.ElevationService.RequestElevation:output_type -> libops.v1.RequestElevationResponse
+	5,  // 12: libops.v1.ElevationService.ListElevations:output_type -> libops.v1.ListElevationsResponse
+	7,  // 13: libops.v1.ElevationService.ApproveElevation:output_type -> libops.v1.ApproveElevationResponse
+	9,  // 14: libops.v1.ElevationService.RejectElevation:output_type -> libops.v1.RejectElevationResponse
+	11, // 15: libops.v1.ElevationService.RevokeElevation:output_type -> libops.v1.RevokeElevationResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_elevation_proto_init() }
+func file_libops_v1_elevation_proto_init() {
+	if File_libops_v1_elevation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_elevation_proto_rawDesc), len(file_libops_v1_elevation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_elevation_proto_goTypes,
+		DependencyIndexes: file_libops_v1_elevation_proto_depIdxs,
+		EnumInfos:         file_libops_v1_elevation_proto_enumTypes,
+		MessageInfos:      file_libops_v1_elevation_proto_msgTypes,
+	}.Build()
+	File_libops_v1_elevation_proto = out.File
+	file_libops_v1_elevation_proto_goTypes = nil
+	file_libops_v1_elevation_proto_depIdxs = nil
+}
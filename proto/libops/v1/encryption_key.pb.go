@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/encryption_key.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EncryptionKeyStatus int32
+
+const (
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED EncryptionKeyStatus = 0
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_PENDING     EncryptionKeyStatus = 1 // Nothing of the organization's is in the key's location yet
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_HEALTHY     EncryptionKeyStatus = 2 // Enabled, and every service agent that needs it can use it
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNHEALTHY   EncryptionKeyStatus = 3 // See status_message
+)
+
+// Enum value maps for EncryptionKeyStatus.
+var (
+	EncryptionKeyStatus_name = map[int32]string{
+		0: "ENCRYPTION_KEY_STATUS_UNSPECIFIED",
+		1: "ENCRYPTION_KEY_STATUS_PENDING",
+		2: "ENCRYPTION_KEY_STATUS_HEALTHY",
+		3: "ENCRYPTION_KEY_STATUS_UNHEALTHY",
+	}
+	EncryptionKeyStatus_value = map[string]int32{
+		"ENCRYPTION_KEY_STATUS_UNSPECIFIED": 0,
+		"ENCRYPTION_KEY_STATUS_PENDING":     1,
+		"ENCRYPTION_KEY_STATUS_HEALTHY":     2,
+		"ENCRYPTION_KEY_STATUS_UNHEALTHY":   3,
+	}
+)
+
+func (x EncryptionKeyStatus) Enum() *EncryptionKeyStatus {
+	p := new(EncryptionKeyStatus)
+	*p = x
+	return p
+}
+
+func (x EncryptionKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_encryption_key_proto_enumTypes[0].Descriptor()
+}
+
+func (EncryptionKeyStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_encryption_key_proto_enumTypes[0]
+}
+
+func (x EncryptionKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionKeyStatus.Descriptor instead.
+func (EncryptionKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{0}
+}
+
+// EncryptionKey is a Cloud KMS key an organization encrypts its data in one
+// location with
+type EncryptionKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"` // e.g. "us-central1" or "eu"
+	// projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{key}
+	KmsKeyName string              `protobuf:"bytes,3,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	Status     EncryptionKeyStatus `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.EncryptionKeyStatus" json:"status,omitempty"`
+	// Why the last check failed, empty when it didn't
+	StatusMessage string `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// Accounts that need roles/cloudkms.cryptoKeyEncrypterDecrypter on the key
+	ServiceAgents []string `protobuf:"bytes,6,rep,name=service_agents,json=serviceAgents,proto3" json:"service_agents,omitempty"`
+	CheckedAt     int64    `protobuf:"varint,7,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // Unix timestamp
+	UpdatedAt     int64    `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptionKey) Reset() {
+	*x = EncryptionKey{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKey) ProtoMessage() {}
+
+func (x *EncryptionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKey.ProtoReflect.Descriptor instead.
+func (*EncryptionKey) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EncryptionKey) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetStatus() EncryptionKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *EncryptionKey) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetServiceAgents() []string {
+	if x != nil {
+		return x.ServiceAgents
+	}
+	return nil
+}
+
+func (x *EncryptionKey) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *EncryptionKey) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListEncryptionKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEncryptionKeysRequest) Reset() {
+	*x = ListEncryptionKeysRequest{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEncryptionKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEncryptionKeysRequest) ProtoMessage() {}
+
+func (x *ListEncryptionKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEncryptionKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListEncryptionKeysRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListEncryptionKeysRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListEncryptionKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Keys  []*EncryptionKey       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Locations the organization's data is in that use Google-managed encryption
+	GoogleManagedLocations []string `protobuf:"bytes,2,rep,name=google_managed_locations,json=googleManagedLocations,proto3" json:"google_managed_locations,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListEncryptionKeysResponse) Reset() {
+	*x = ListEncryptionKeysResponse{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEncryptionKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEncryptionKeysResponse) ProtoMessage() {}
+
+func (x *ListEncryptionKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEncryptionKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListEncryptionKeysResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListEncryptionKeysResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ListEncryptionKeysResponse) GetGoogleManagedLocations() []string {
+	if x != nil {
+		return x.GoogleManagedLocations
+	}
+	return nil
+}
+
+type SetEncryptionKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	KmsKeyName     string                 `protobuf:"bytes,2,opt,name=kms_key_name,json=kmsKeyName,proto3" json:"kms_key_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetEncryptionKeyRequest) Reset() {
+	*x = SetEncryptionKeyRequest{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *SetEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*SetEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetEncryptionKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetEncryptionKeyRequest) GetKmsKeyName() string {
+	if x != nil {
+		return x.KmsKeyName
+	}
+	return ""
+}
+
+type SetEncryptionKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *EncryptionKey         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEncryptionKeyResponse) Reset() {
+	*x = SetEncryptionKeyResponse{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *SetEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*SetEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetEncryptionKeyResponse) GetKey() *EncryptionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type CheckEncryptionKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckEncryptionKeysRequest) Reset() {
+	*x = CheckEncryptionKeysRequest{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEncryptionKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEncryptionKeysRequest) ProtoMessage() {}
+
+func (x *CheckEncryptionKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEncryptionKeysRequest.ProtoReflect.Descriptor instead.
+func (*CheckEncryptionKeysRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckEncryptionKeysRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type CheckEncryptionKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*EncryptionKey       `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEncryptionKeysResponse) Reset() {
+	*x = CheckEncryptionKeysResponse{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEncryptionKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEncryptionKeysResponse) ProtoMessage() {}
+
+func (x *CheckEncryptionKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEncryptionKeysResponse.ProtoReflect.Descriptor instead.
+func (*CheckEncryptionKeysResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckEncryptionKeysResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DeleteEncryptionKeyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteEncryptionKeyRequest) Reset() {
+	*x = DeleteEncryptionKeyRequest{}
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *DeleteEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_encryption_key_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_encryption_key_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteEncryptionKeyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteEncryptionKeyRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+var File_libops_v1_encryption_key_proto protoreflect.FileDescriptor
+
+const file_libops_v1_encryption_key_proto_rawDesc = "" +
+	"\n" +
+	"\x1elibops/v1/encryption_key.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\xba\x02\n" +
+	"\rEncryptionKey\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
+	"\fkms_key_name\x18\x03 \x01(\tR\n" +
+	"kmsKeyName\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.libops.v1.EncryptionKeyStatusR\x06status\x12%\n" +
+	"\x0estatus_message\x18\x05 \x01(\tR\rstatusMessage\x12%\n" +
+	"\x0eservice_agents\x18\x06 \x03(\tR\rserviceAgents\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\a \x01(\x03R\tcheckedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"D\n" +
+	"\x19ListEncryptionKeysRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x84\x01\n" +
+	"\x1aListEncryptionKeysResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.libops.v1.EncryptionKeyR\x04keys\x128\n" +
+	"\x18google_managed_locations\x18\x02 \x03(\tR\x16googleManagedLocations\"d\n" +
+	"\x17SetEncryptionKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12 \n" +
+	"\fkms_key_name\x18\x02 \x01(\tR\n" +
+	"kmsKeyName\"F\n" +
+	"\x18SetEncryptionKeyResponse\x12*\n" +
+	"\x03key\x18\x01 \x01(\v2\x18.libops.v1.EncryptionKeyR\x03key\"E\n" +
+	"\x1aCheckEncryptionKeysRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"K\n" +
+	"\x1bCheckEncryptionKeysResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.libops.v1.EncryptionKeyR\x04keys\"a\n" +
+	"\x1aDeleteEncryptionKeyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation*\xa7\x01\n" +
+	"\x13EncryptionKeyStatus\x12%\n" +
+	"!ENCRYPTION_KEY_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dENCRYPTION_KEY_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dENCRYPTION_KEY_STATUS_HEALTHY\x10\x02\x12#\n" +
+	"\x1fENCRYPTION_KEY_STATUS_UNHEALTHY\x10\x032\xdb\x06\n" +
+	"\x14EncryptionKeyService\x12\xcf\x01\n" +
+	"\x12ListEncryptionKeys\x12$.libops.v1.ListEncryptionKeysRequest\x1a%.libops.v1.ListEncryptionKeysResponse\"l\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x025\x123/v1/organizations/{organization_id}/encryption-keys\x90\x02\x01\x12\xc8\x01\n" +
+	"\x10SetEncryptionKey\x12\".libops.v1.SetEncryptionKeyRequest\x1a#.libops.v1.SetEncryptionKeyResponse\"k\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x028:\x01*\x1a3/v1/organizations/{organization_id}/encryption-keys\x12\xd9\x01\n" +
+	"\x13CheckEncryptionKeys\x12%.libops.v1.CheckEncryptionKeysRequest\x1a&.libops.v1.CheckEncryptionKeysResponse\"s\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/organizations/{organization_id}/encryption-keys:check\x12\xc9\x01\n" +
+	"\x13DeleteEncryptionKey\x12%.libops.v1.DeleteEncryptionKeyRequest\x1a\x16.google.protobuf.Empty\"s\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02@*>/v1/organizations/{organization_id}/encryption-keys/{location}B\x98\x01\n" +
+	"\rcom.libops.v1B\x12EncryptionKeyProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_encryption_key_proto_rawDescOnce sync.Once
+	file_libops_v1_encryption_key_proto_rawDescData []byte
+)
+
+func file_libops_v1_encryption_key_proto_rawDescGZIP() []byte {
+	file_libops_v1_encryption_key_proto_rawDescOnce.Do(func() {
+		file_libops_v1_encryption_key_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_encryption_key_proto_rawDesc), len(file_libops_v1_encryption_key_proto_rawDesc)))
+	})
+	return file_libops_v1_encryption_key_proto_rawDescData
+}
+
+var file_libops_v1_encryption_key_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_encryption_key_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_libops_v1_encryption_key_proto_goTypes = []any{
+	(EncryptionKeyStatus)(0),            // 0: libops.v1.EncryptionKeyStatus
+	(*EncryptionKey)(nil),               // 1: libops.v1.EncryptionKey
+	(*ListEncryptionKeysRequest)(nil),   // 2: libops.v1.ListEncryptionKeysRequest
+	(*ListEncryptionKeysResponse)(nil),  // 3: libops.v1.ListEncryptionKeysResponse
+	(*SetEncryptionKeyRequest)(nil),     // 4: libops.v1.SetEncryptionKeyRequest
+	(*SetEncryptionKeyResponse)(nil),    // 5: libops.v1.SetEncryptionKeyResponse
+	(*CheckEncryptionKeysRequest)(nil),  // 6: libops.v1.CheckEncryptionKeysRequest
+	(*CheckEncryptionKeysResponse)(nil), // 7: libops.v1.CheckEncryptionKeysResponse
+	(*DeleteEncryptionKeyRequest)(nil),  // 8: libops.v1.DeleteEncryptionKeyRequest
+	(*emptypb.Empty)(nil),               // 9: google.protobuf.Empty
+}
+var file_libops_v1_encryption_key_proto_depIdxs = []int32{
+	0, // 0: libops.v1.EncryptionKey.status:type_name -> libops.v1.EncryptionKeyStatus
+	1, // 1: libops.v1.ListEncryptionKeysResponse.keys:type_name -> libops.v1.EncryptionKey
+	1, // 2: libops.v1.SetEncryptionKeyResponse.key:type_name -> libops.v1.EncryptionKey
+	1, // 3: libops.v1.CheckEncryptionKeysResponse.keys:type_name -> libops.v1.EncryptionKey
+	2, // 4: libops.v1.EncryptionKeyService.ListEncryptionKeys:input_type -> libops.v1.ListEncryptionKeysRequest
+	4, // 5: libops.v1.EncryptionKeyService.SetEncryptionKey:input_type -> libops.v1.SetEncryptionKeyRequest
+	6, // 6: libops.v1.EncryptionKeyService.CheckEncryptionKeys:input_type -> libops.v1.CheckEncryptionKeysRequest
+	8, // 7: libops.v1.EncryptionKeyService.DeleteEncryptionKey:input_type -> libops.v1.DeleteEncryptionKeyRequest
+	3, // 8: libops.v1.EncryptionKeyService.ListEncryptionKeys:output_type -> libops.v1.ListEncryptionKeysResponse
+	5, // 9: libops.v1.EncryptionKeyService.SetEncryptionKey:output_type -> libops.v1.SetEncryptionKeyResponse
+	7, // 10: libops.v1.EncryptionKeyService.CheckEncryptionKeys:output_type -> libops.v1.CheckEncryptionKeysResponse
+	9, // 11: libops.v1.EncryptionKeyService.DeleteEncryptionKey:output_type -> google.protobuf.Empty
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_encryption_key_proto_init() }
+func file_libops_v1_encryption_key_proto_init() {
+	if File_libops_v1_encryption_key_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_encryption_key_proto_rawDesc), len(file_libops_v1_encryption_key_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_encryption_key_proto_goTypes,
+		DependencyIndexes: file_libops_v1_encryption_key_proto_depIdxs,
+		EnumInfos:         file_libops_v1_encryption_key_proto_enumTypes,
+		MessageInfos:      file_libops_v1_encryption_key_proto_msgTypes,
+	}.Build()
+	File_libops_v1_encryption_key_proto = out.File
+	file_libops_v1_encryption_key_proto_goTypes = nil
+	file_libops_v1_encryption_key_proto_depIdxs = nil
+}
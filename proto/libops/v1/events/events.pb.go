@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/events/events.proto
+
+package events
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SchemaVersion is the version of the Envelope layout. Consumers reject versions
+// they don't know, so a breaking change ships as a new version both sides learn first.
+type SchemaVersion int32
+
+const (
+	SchemaVersion_SCHEMA_VERSION_UNSPECIFIED SchemaVersion = 0
+	SchemaVersion_SCHEMA_VERSION_1           SchemaVersion = 1
+)
+
+// Enum value maps for SchemaVersion.
+var (
+	SchemaVersion_name = map[int32]string{
+		0: "SCHEMA_VERSION_UNSPECIFIED",
+		1: "SCHEMA_VERSION_1",
+	}
+	SchemaVersion_value = map[string]int32{
+		"SCHEMA_VERSION_UNSPECIFIED": 0,
+		"SCHEMA_VERSION_1":           1,
+	}
+)
+
+func (x SchemaVersion) Enum() *SchemaVersion {
+	p := new(SchemaVersion)
+	*p = x
+	return p
+}
+
+func (x SchemaVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_events_events_proto_enumTypes[0].Descriptor()
+}
+
+func (SchemaVersion) Type() protoreflect.EnumType {
+	return &file_libops_v1_events_events_proto_enumTypes[0]
+}
+
+func (x SchemaVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaVersion.Descriptor instead.
+func (SchemaVersion) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_events_events_proto_rawDescGZIP(), []int{0}
+}
+
+// EventType is the registry of events LibOps emits. Each value carries the
+// CloudEvents type it is queued and published as, and the payload types its
+// envelope's data may hold; an event whose type isn't registered here, or whose
+// payload isn't registered for its type, is rejected by the event router.
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED                        EventType = 0
+	EventType_EVENT_TYPE_ACCOUNT_CREATED                    EventType = 1
+	EventType_EVENT_TYPE_ACCOUNT_UPDATED                    EventType = 2
+	EventType_EVENT_TYPE_ACCOUNT_DELETED                    EventType = 3
+	EventType_EVENT_TYPE_ORGANIZATION_CREATED               EventType = 4
+	EventType_EVENT_TYPE_ORGANIZATION_UPDATED               EventType = 5
+	EventType_EVENT_TYPE_ORGANIZATION_DELETED               EventType = 6
+	EventType_EVENT_TYPE_PROJECT_CREATED                    EventType = 7
+	EventType_EVENT_TYPE_PROJECT_UPDATED                    EventType = 8
+	EventType_EVENT_TYPE_PROJECT_DELETED                    EventType = 9
+	EventType_EVENT_TYPE_SITE_CREATED                       EventType = 10
+	EventType_EVENT_TYPE_SITE_UPDATED                       EventType = 11
+	EventType_EVENT_TYPE_SITE_DELETED                       EventType = 12
+	EventType_EVENT_TYPE_SSH_KEY_CREATED                    EventType = 13
+	EventType_EVENT_TYPE_SSH_KEY_DELETED                    EventType = 14
+	EventType_EVENT_TYPE_ORGANIZATION_MEMBER_ADDED          EventType = 15
+	EventType_EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED        EventType = 16
+	EventType_EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED        EventType = 17
+	EventType_EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED   EventType = 18
+	EventType_EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED EventType = 19
+	EventType_EVENT_TYPE_ORGANIZATION_SECRET_CREATED        EventType = 20
+	EventType_EVENT_TYPE_ORGANIZATION_SECRET_UPDATED        EventType = 21
+	EventType_EVENT_TYPE_ORGANIZATION_SECRET_DELETED        EventType = 22
+	EventType_EVENT_TYPE_ORGANIZATION_SETTING_CREATED       EventType = 23
+	EventType_EVENT_TYPE_ORGANIZATION_SETTING_UPDATED       EventType = 24
+	EventType_EVENT_TYPE_ORGANIZATION_SETTING_DELETED       EventType = 25
+	EventType_EVENT_TYPE_PROJECT_MEMBER_ADDED               EventType = 26
+	EventType_EVENT_TYPE_PROJECT_MEMBER_UPDATED             EventType = 27
+	EventType_EVENT_TYPE_PROJECT_MEMBER_REMOVED             EventType = 28
+	EventType_EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED        EventType = 29
+	EventType_EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED      EventType = 30
+	EventType_EVENT_TYPE_PROJECT_SECRET_CREATED             EventType = 31
+	EventType_EVENT_TYPE_PROJECT_SECRET_UPDATED             EventType = 32
+	EventType_EVENT_TYPE_PROJECT_SECRET_DELETED             EventType = 33
+	EventType_EVENT_TYPE_PROJECT_SETTING_CREATED            EventType = 34
+	EventType_EVENT_TYPE_PROJECT_SETTING_UPDATED            EventType = 35
+	EventType_EVENT_TYPE_PROJECT_SETTING_DELETED            EventType = 36
+	EventType_EVENT_TYPE_SITE_MEMBER_ADDED                  EventType = 37
+	EventType_EVENT_TYPE_SITE_MEMBER_UPDATED                EventType = 38
+	EventType_EVENT_TYPE_SITE_MEMBER_REMOVED                EventType = 39
+	EventType_EVENT_TYPE_SITE_FIREWALL_RULE_ADDED           EventType = 40
+	EventType_EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED         EventType = 41
+	EventType_EVENT_TYPE_SITE_SECRET_CREATED                EventType = 42
+	EventType_EVENT_TYPE_SITE_SECRET_UPDATED                EventType = 43
+	EventType_EVENT_TYPE_SITE_SECRET_DELETED                EventType = 44
+	EventType_EVENT_TYPE_SITE_REDIRECT_CREATED              EventType = 45
+	EventType_EVENT_TYPE_SITE_REDIRECT_UPDATED              EventType = 46
+	EventType_EVENT_TYPE_SITE_REDIRECT_DELETED              EventType = 47
+	EventType_EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED     EventType = 48
+	EventType_EVENT_TYPE_SITE_WAF_UPDATED                   EventType = 49
+	EventType_EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED         EventType = 50
+	EventType_EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED       EventType = 51
+	EventType_EVENT_TYPE_SITE_TLS_POLICY_UPDATED            EventType = 52
+	EventType_EVENT_TYPE_SITE_ADDON_ATTACHED                EventType = 53
+	EventType_EVENT_TYPE_SITE_ADDON_DETACHED                EventType = 54
+	EventType_EVENT_TYPE_SITE_SSH_ACCESS_GRANTED            EventType = 55
+	EventType_EVENT_TYPE_SITE_SSH_ACCESS_UPDATED            EventType = 56
+	EventType_EVENT_TYPE_SITE_SSH_ACCESS_REVOKED            EventType = 57
+	EventType_EVENT_TYPE_SITE_ELEVATION_REQUESTED           EventType = 58
+	EventType_EVENT_TYPE_SITE_ELEVATION_APPROVED            EventType = 59
+	EventType_EVENT_TYPE_SITE_ELEVATION_REJECTED            EventType = 60
+	EventType_EVENT_TYPE_SITE_ELEVATION_REVOKED             EventType = 61
+	EventType_EVENT_TYPE_SITE_ELEVATION_EXPIRED             EventType = 62
+	EventType_EVENT_TYPE_SITE_CONFIG_VAR_SET                EventType = 63
+	EventType_EVENT_TYPE_SITE_CONFIG_VAR_DELETED            EventType = 64
+	EventType_EVENT_TYPE_SITE_SETTING_CREATED               EventType = 65
+	EventType_EVENT_TYPE_SITE_SETTING_UPDATED               EventType = 66
+	EventType_EVENT_TYPE_SITE_SETTING_DELETED               EventType = 67
+	EventType_EVENT_TYPE_BILLING_PAYMENT_FAILED             EventType = 68
+	EventType_EVENT_TYPE_BILLING_STATE_CHANGED              EventType = 69
+	EventType_EVENT_TYPE_DEPLOYMENT_SUCCEEDED               EventType = 70
+	EventType_EVENT_TYPE_DEPLOYMENT_FAILED                  EventType = 71
+	EventType_EVENT_TYPE_RECONCILIATION_FAILED              EventType = 72
+	EventType_EVENT_TYPE_NOTIFICATION_CHANNEL_TEST          EventType = 73
+	EventType_EVENT_TYPE_EVENT_SINK_TEST                    EventType = 74
+	EventType_EVENT_TYPE_INCIDENT_OPENED                    EventType = 75
+	EventType_EVENT_TYPE_INCIDENT_RESOLVED                  EventType = 76
+	EventType_EVENT_TYPE_RELATIONSHIP_CREATED               EventType = 77
+	EventType_EVENT_TYPE_RELATIONSHIP_APPROVED              EventType = 78
+	EventType_EVENT_TYPE_RELATIONSHIP_REJECTED              EventType = 79
+	EventType_EVENT_TYPE_RELATIONSHIP_UPDATED               EventType = 80
+	EventType_EVENT_TYPE_RELATIONSHIP_REVOKED               EventType = 81
+	EventType_EVENT_TYPE_ORGANIZATION_POLICY_CREATED        EventType = 82
+	EventType_EVENT_TYPE_ORGANIZATION_POLICY_UPDATED        EventType = 83
+	EventType_EVENT_TYPE_ORGANIZATION_POLICY_DELETED        EventType = 84
+	EventType_EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED       EventType = 85
+	EventType_EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY EventType = 86
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_ACCOUNT_CREATED",
+		2:  "EVENT_TYPE_ACCOUNT_UPDATED",
+		3:  "EVENT_TYPE_ACCOUNT_DELETED",
+		4:  "EVENT_TYPE_ORGANIZATION_CREATED",
+		5:  "EVENT_TYPE_ORGANIZATION_UPDATED",
+		6:  "EVENT_TYPE_ORGANIZATION_DELETED",
+		7:  "EVENT_TYPE_PROJECT_CREATED",
+		8:  "EVENT_TYPE_PROJECT_UPDATED",
+		9:  "EVENT_TYPE_PROJECT_DELETED",
+		10: "EVENT_TYPE_SITE_CREATED",
+		11: "EVENT_TYPE_SITE_UPDATED",
+		12: "EVENT_TYPE_SITE_DELETED",
+		13: "EVENT_TYPE_SSH_KEY_CREATED",
+		14: "EVENT_TYPE_SSH_KEY_DELETED",
+		15: "EVENT_TYPE_ORGANIZATION_MEMBER_ADDED",
+		16: "EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED",
+		17: "EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED",
+		18: "EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED",
+		19: "EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED",
+		20: "EVENT_TYPE_ORGANIZATION_SECRET_CREATED",
+		21: "EVENT_TYPE_ORGANIZATION_SECRET_UPDATED",
+		22: "EVENT_TYPE_ORGANIZATION_SECRET_DELETED",
+		23: "EVENT_TYPE_ORGANIZATION_SETTING_CREATED",
+		24: "EVENT_TYPE_ORGANIZATION_SETTING_UPDATED",
+		25: "EVENT_TYPE_ORGANIZATION_SETTING_DELETED",
+		26: "EVENT_TYPE_PROJECT_MEMBER_ADDED",
+		27: "EVENT_TYPE_PROJECT_MEMBER_UPDATED",
+		28: "EVENT_TYPE_PROJECT_MEMBER_REMOVED",
+		29: "EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED",
+		30: "EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED",
+		31: "EVENT_TYPE_PROJECT_SECRET_CREATED",
+		32: "EVENT_TYPE_PROJECT_SECRET_UPDATED",
+		33: "EVENT_TYPE_PROJECT_SECRET_DELETED",
+		34: "EVENT_TYPE_PROJECT_SETTING_CREATED",
+		35: "EVENT_TYPE_PROJECT_SETTING_UPDATED",
+		36: "EVENT_TYPE_PROJECT_SETTING_DELETED",
+		37: "EVENT_TYPE_SITE_MEMBER_ADDED",
+		38: "EVENT_TYPE_SITE_MEMBER_UPDATED",
+		39: "EVENT_TYPE_SITE_MEMBER_REMOVED",
+		40: "EVENT_TYPE_SITE_FIREWALL_RULE_ADDED",
+		41: "EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED",
+		42: "EVENT_TYPE_SITE_SECRET_CREATED",
+		43: "EVENT_TYPE_SITE_SECRET_UPDATED",
+		44: "EVENT_TYPE_SITE_SECRET_DELETED",
+		45: "EVENT_TYPE_SITE_REDIRECT_CREATED",
+		46: "EVENT_TYPE_SITE_REDIRECT_UPDATED",
+		47: "EVENT_TYPE_SITE_REDIRECT_DELETED",
+		48: "EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED",
+		49: "EVENT_TYPE_SITE_WAF_UPDATED",
+		50: "EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED",
+		51: "EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED",
+		52: "EVENT_TYPE_SITE_TLS_POLICY_UPDATED",
+		53: "EVENT_TYPE_SITE_ADDON_ATTACHED",
+		54: "EVENT_TYPE_SITE_ADDON_DETACHED",
+		55: "EVENT_TYPE_SITE_SSH_ACCESS_GRANTED",
+		56: "EVENT_TYPE_SITE_SSH_ACCESS_UPDATED",
+		57: "EVENT_TYPE_SITE_SSH_ACCESS_REVOKED",
+		58: "EVENT_TYPE_SITE_ELEVATION_REQUESTED",
+		59: "EVENT_TYPE_SITE_ELEVATION_APPROVED",
+		60: "EVENT_TYPE_SITE_ELEVATION_REJECTED",
+		61: "EVENT_TYPE_SITE_ELEVATION_REVOKED",
+		62: "EVENT_TYPE_SITE_ELEVATION_EXPIRED",
+		63: "EVENT_TYPE_SITE_CONFIG_VAR_SET",
+		64: "EVENT_TYPE_SITE_CONFIG_VAR_DELETED",
+		65: "EVENT_TYPE_SITE_SETTING_CREATED",
+		66: "EVENT_TYPE_SITE_SETTING_UPDATED",
+		67: "EVENT_TYPE_SITE_SETTING_DELETED",
+		68: "EVENT_TYPE_BILLING_PAYMENT_FAILED",
+		69: "EVENT_TYPE_BILLING_STATE_CHANGED",
+		70: "EVENT_TYPE_DEPLOYMENT_SUCCEEDED",
+		71: "EVENT_TYPE_DEPLOYMENT_FAILED",
+		72: "EVENT_TYPE_RECONCILIATION_FAILED",
+		73: "EVENT_TYPE_NOTIFICATION_CHANNEL_TEST",
+		74: "EVENT_TYPE_EVENT_SINK_TEST",
+		75: "EVENT_TYPE_INCIDENT_OPENED",
+		76: "EVENT_TYPE_INCIDENT_RESOLVED",
+		77: "EVENT_TYPE_RELATIONSHIP_CREATED",
+		78: "EVENT_TYPE_RELATIONSHIP_APPROVED",
+		79: "EVENT_TYPE_RELATIONSHIP_REJECTED",
+		80: "EVENT_TYPE_RELATIONSHIP_UPDATED",
+		81: "EVENT_TYPE_RELATIONSHIP_REVOKED",
+		82: "EVENT_TYPE_ORGANIZATION_POLICY_CREATED",
+		83: "EVENT_TYPE_ORGANIZATION_POLICY_UPDATED",
+		84: "EVENT_TYPE_ORGANIZATION_POLICY_DELETED",
+		85: "EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED",
+		86: "EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":                        0,
+		"EVENT_TYPE_ACCOUNT_CREATED":                    1,
+		"EVENT_TYPE_ACCOUNT_UPDATED":                    2,
+		"EVENT_TYPE_ACCOUNT_DELETED":                    3,
+		"EVENT_TYPE_ORGANIZATION_CREATED":               4,
+		"EVENT_TYPE_ORGANIZATION_UPDATED":               5,
+		"EVENT_TYPE_ORGANIZATION_DELETED":               6,
+		"EVENT_TYPE_PROJECT_CREATED":                    7,
+		"EVENT_TYPE_PROJECT_UPDATED":                    8,
+		"EVENT_TYPE_PROJECT_DELETED":                    9,
+		"EVENT_TYPE_SITE_CREATED":                       10,
+		"EVENT_TYPE_SITE_UPDATED":                       11,
+		"EVENT_TYPE_SITE_DELETED":                       12,
+		"EVENT_TYPE_SSH_KEY_CREATED":                    13,
+		"EVENT_TYPE_SSH_KEY_DELETED":                    14,
+		"EVENT_TYPE_ORGANIZATION_MEMBER_ADDED":          15,
+		"EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED":        16,
+		"EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED":        17,
+		"EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED":   18,
+		"EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED": 19,
+		"EVENT_TYPE_ORGANIZATION_SECRET_CREATED":        20,
+		"EVENT_TYPE_ORGANIZATION_SECRET_UPDATED":        21,
+		"EVENT_TYPE_ORGANIZATION_SECRET_DELETED":        22,
+		"EVENT_TYPE_ORGANIZATION_SETTING_CREATED":       23,
+		"EVENT_TYPE_ORGANIZATION_SETTING_UPDATED":       24,
+		"EVENT_TYPE_ORGANIZATION_SETTING_DELETED":       25,
+		"EVENT_TYPE_PROJECT_MEMBER_ADDED":               26,
+		"EVENT_TYPE_PROJECT_MEMBER_UPDATED":             27,
+		"EVENT_TYPE_PROJECT_MEMBER_REMOVED":             28,
+		"EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED":        29,
+		"EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED":      30,
+		"EVENT_TYPE_PROJECT_SECRET_CREATED":             31,
+		"EVENT_TYPE_PROJECT_SECRET_UPDATED":             32,
+		"EVENT_TYPE_PROJECT_SECRET_DELETED":             33,
+		"EVENT_TYPE_PROJECT_SETTING_CREATED":            34,
+		"EVENT_TYPE_PROJECT_SETTING_UPDATED":            35,
+		"EVENT_TYPE_PROJECT_SETTING_DELETED":            36,
+		"EVENT_TYPE_SITE_MEMBER_ADDED":                  37,
+		"EVENT_TYPE_SITE_MEMBER_UPDATED":                38,
+		"EVENT_TYPE_SITE_MEMBER_REMOVED":                39,
+		"EVENT_TYPE_SITE_FIREWALL_RULE_ADDED":           40,
+		"EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED":         41,
+		"EVENT_TYPE_SITE_SECRET_CREATED":                42,
+		"EVENT_TYPE_SITE_SECRET_UPDATED":                43,
+		"EVENT_TYPE_SITE_SECRET_DELETED":                44,
+		"EVENT_TYPE_SITE_REDIRECT_CREATED":              45,
+		"EVENT_TYPE_SITE_REDIRECT_UPDATED":              46,
+		"EVENT_TYPE_SITE_REDIRECT_DELETED":              47,
+		"EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED":     48,
+		"EVENT_TYPE_SITE_WAF_UPDATED":                   49,
+		"EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED":         50,
+		"EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED":       51,
+		"EVENT_TYPE_SITE_TLS_POLICY_UPDATED":            52,
+		"EVENT_TYPE_SITE_ADDON_ATTACHED":                53,
+		"EVENT_TYPE_SITE_ADDON_DETACHED":                54,
+		"EVENT_TYPE_SITE_SSH_ACCESS_GRANTED":            55,
+		"EVENT_TYPE_SITE_SSH_ACCESS_UPDATED":            56,
+		"EVENT_TYPE_SITE_SSH_ACCESS_REVOKED":            57,
+		"EVENT_TYPE_SITE_ELEVATION_REQUESTED":           58,
+		"EVENT_TYPE_SITE_ELEVATION_APPROVED":            59,
+		"EVENT_TYPE_SITE_ELEVATION_REJECTED":            60,
+		"EVENT_TYPE_SITE_ELEVATION_REVOKED":             61,
+		"EVENT_TYPE_SITE_ELEVATION_EXPIRED":             62,
+		"EVENT_TYPE_SITE_CONFIG_VAR_SET":                63,
+		"EVENT_TYPE_SITE_CONFIG_VAR_DELETED":            64,
+		"EVENT_TYPE_SITE_SETTING_CREATED":               65,
+		"EVENT_TYPE_SITE_SETTING_UPDATED":               66,
+		"EVENT_TYPE_SITE_SETTING_DELETED":               67,
+		"EVENT_TYPE_BILLING_PAYMENT_FAILED":             68,
+		"EVENT_TYPE_BILLING_STATE_CHANGED":              69,
+		"EVENT_TYPE_DEPLOYMENT_SUCCEEDED":               70,
+		"EVENT_TYPE_DEPLOYMENT_FAILED":                  71,
+		"EVENT_TYPE_RECONCILIATION_FAILED":              72,
+		"EVENT_TYPE_NOTIFICATION_CHANNEL_TEST":          73,
+		"EVENT_TYPE_EVENT_SINK_TEST":                    74,
+		"EVENT_TYPE_INCIDENT_OPENED":                    75,
+		"EVENT_TYPE_INCIDENT_RESOLVED":                  76,
+		"EVENT_TYPE_RELATIONSHIP_CREATED":               77,
+		"EVENT_TYPE_RELATIONSHIP_APPROVED":              78,
+		"EVENT_TYPE_RELATIONSHIP_REJECTED":              79,
+		"EVENT_TYPE_RELATIONSHIP_UPDATED":               80,
+		"EVENT_TYPE_RELATIONSHIP_REVOKED":               81,
+		"EVENT_TYPE_ORGANIZATION_POLICY_CREATED":        82,
+		"EVENT_TYPE_ORGANIZATION_POLICY_UPDATED":        83,
+		"EVENT_TYPE_ORGANIZATION_POLICY_DELETED":        84,
+		"EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED":       85,
+		"EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY": 86,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_events_events_proto_enumTypes[1].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_libops_v1_events_events_proto_enumTypes[1]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_events_events_proto_rawDescGZIP(), []int{1}
+}
+
+// Envelope is the data of every event in event_queue and published to event sinks,
+// with content type "application/protobuf; proto=libops.v1.events.Envelope".
+// The subject and the organization, project and site an event is scoped to travel
+// beside it as event_queue columns and CloudEvents attributes.
+type Envelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion SchemaVersion          `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3,enum=libops.v1.events.SchemaVersion" json:"schema_version,omitempty"`
+	Type          EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=libops.v1.events.EventType" json:"type,omitempty"` // Must match the event's CloudEvents type
+	// The typed payload, one of the payload types registered for the event type, e.g. a
+	// libops.v1.Elevation; absent for events that only carry a subject
+	Data *anypb.Any `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Set by the "Libops-Urgent: true" request header: the change reaches sites at once,
+	// even outside their maintenance windows
+	Urgent        bool `protobuf:"varint,4,opt,name=urgent,proto3" json:"urgent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_libops_v1_events_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_events_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_libops_v1_events_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetSchemaVersion() SchemaVersion {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return SchemaVersion_SCHEMA_VERSION_UNSPECIFIED
+}
+
+func (x *Envelope) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Envelope) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Envelope) GetUrgent() bool {
+	if x != nil {
+		return x.Urgent
+	}
+	return false
+}
+
+var File_libops_v1_events_events_proto protoreflect.FileDescriptor
+
+const file_libops_v1_events_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlibops/v1/events/events.proto\x12\x10libops.v1.events\x1a\x19google/protobuf/any.proto\x1a\x1dlibops/v1/options/event.proto\"\xc5\x01\n" +
+	"\bEnvelope\x12F\n" +
+	"\x0eschema_version\x18\x01 \x01(\x0e2\x1f.libops.v1.events.SchemaVersionR\rschemaVersion\x12/\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1b.libops.v1.events.EventTypeR\x04type\x12(\n" +
+	"\x04data\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x04data\x12\x16\n" +
+	"\x06urgent\x18\x04 \x01(\bR\x06urgent*E\n" +
+	"\rSchemaVersion\x12\x1e\n" +
+	"\x1aSCHEMA_VERSION_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SCHEMA_VERSION_1\x10\x01*\x8aP\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12x\n" +
+	"\x1aEVENT_TYPE_ACCOUNT_CREATED\x10\x01\x1aX\x9a\xb5\x18\x1cio.libops.account.created.v1\xa2\xb5\x18\x11libops.v1.Account\xa2\xb5\x18\x1flibops.v1.CreateAccountResponse\x12x\n" +
+	"\x1aEVENT_TYPE_ACCOUNT_UPDATED\x10\x02\x1aX\x9a\xb5\x18\x1cio.libops.account.updated.v1\xa2\xb5\x18\x11libops.v1.Account\xa2\xb5\x18\x1flibops.v1.UpdateAccountResponse\x12b\n" +
+	"\x1aEVENT_TYPE_ACCOUNT_DELETED\x10\x03\x1aB\x9a\xb5\x18\x1cio.libops.account.deleted.v1\xa2\xb5\x18\x1elibops.v1.DeleteAccountRequest\x12\x9f\x01\n" +
+	"\x1fEVENT_TYPE_ORGANIZATION_CREATED\x10\x04\x1az\x9a\xb5\x18!io.libops.organization.created.v1\xa2\xb5\x18$libops.v1.CreateOrganizationResponse\xa2\xb5\x18)libops.v1.AdminCreateOrganizationResponse\x12\x8d\x03\n" +
+	"\x1fEVENT_TYPE_ORGANIZATION_UPDATED\x10\x05\x1a\xe7\x02\x9a\xb5\x18!io.libops.organization.updated.v1\xa2\xb5\x18$libops.v1.UpdateOrganizationResponse\xa2\xb5\x18)libops.v1.AdminUpdateOrganizationResponse\xa2\xb5\x18$libops.v1.ForceReconciliationRequest\xa2\xb5\x18$libops.v1.SuspendOrganizationRequest\xa2\xb5\x18&libops.v1.UnsuspendOrganizationRequest\xa2\xb5\x18)libops.v1.common.BillingStateChangedEvent\xa2\xb5\x18\x17libops.v1.EncryptionKey\xa2\xb5\x18'libops.v1.PrivateServiceConnectEndpoint\x12J\n" +
+	"\x1fEVENT_TYPE_ORGANIZATION_DELETED\x10\x06\x1a%\x9a\xb5\x18!io.libops.organization.deleted.v1\x12\x8b\x01\n" +
+	"\x1aEVENT_TYPE_PROJECT_CREATED\x10\a\x1ak\x9a\xb5\x18\x1cio.libops.project.created.v1\xa2\xb5\x18\x1flibops.v1.CreateProjectResponse\xa2\xb5\x18$libops.v1.AdminCreateProjectResponse\x12\xd4\x01\n" +
+	"\x1aEVENT_TYPE_PROJECT_UPDATED\x10\b\x1a\xb3\x01\x9a\xb5\x18\x1cio.libops.project.updated.v1\xa2\xb5\x18\x1flibops.v1.UpdateProjectResponse\xa2\xb5\x18$libops.v1.AdminUpdateProjectResponse\xa2\xb5\x18\x1clibops.v1.ChangePlanResponse\xa2\xb5\x18$libops.v1.ForceReconciliationRequest\x12@\n" +
+	"\x1aEVENT_TYPE_PROJECT_DELETED\x10\t\x1a \x9a\xb5\x18\x1cio.libops.project.deleted.v1\x12\x7f\n" +
+	"\x17EVENT_TYPE_SITE_CREATED\x10\n" +
+	"\x1ab\x9a\xb5\x18\x19io.libops.site.created.v1\xa2\xb5\x18\x1clibops.v1.CreateSiteResponse\xa2\xb5\x18!libops.v1.AdminCreateSiteResponse\x12\xb6\x03\n" +
+	"\x17EVENT_TYPE_SITE_UPDATED\x10\v\x1a\x98\x03\x9a\xb5\x18\x19io.libops.site.updated.v1\xa2\xb5\x18\x1clibops.v1.UpdateSiteResponse\xa2\xb5\x18!libops.v1.AdminUpdateSiteResponse\xa2\xb5\x18$libops.v1.ForceReconciliationRequest\xa2\xb5\x18 libops.v1.CreateDatabaseResponse\xa2\xb5\x18'libops.v1.ResetDatabasePasswordResponse\xa2\xb5\x18'libops.v1.RequestStaticEgressIpResponse\xa2\xb5\x18&libops.v1.ReleaseStaticEgressIpRequest\xa2\xb5\x18\x1flibops.v1.EnableSiteCdnResponse\xa2\xb5\x18\x1flibops.v1.DisableSiteCdnRequest\xa2\xb5\x18 libops.v1.PurgeSiteCacheResponse\x12:\n" +
+	"\x17EVENT_TYPE_SITE_DELETED\x10\f\x1a\x1d\x9a\xb5\x18\x19io.libops.site.deleted.v1\x12@\n" +
+	"\x1aEVENT_TYPE_SSH_KEY_CREATED\x10\r\x1a \x9a\xb5\x18\x1cio.libops.ssh_key.created.v1\x12@\n" +
+	"\x1aEVENT_TYPE_SSH_KEY_DELETED\x10\x0e\x1a \x9a\xb5\x18\x1cio.libops.ssh_key.deleted.v1\x12T\n" +
+	"$EVENT_TYPE_ORGANIZATION_MEMBER_ADDED\x10\x0f\x1a*\x9a\xb5\x18&io.libops.organization.member.added.v1\x12X\n" +
+	"&EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED\x10\x10\x1a,\x9a\xb5\x18(io.libops.organization.member.updated.v1\x12\x85\x01\n" +
+	"&EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED\x10\x11\x1aY\x9a\xb5\x18(io.libops.organization.member.removed.v1\xa2\xb5\x18)libops.v1.DeleteOrganizationMemberRequest\x12b\n" +
+	"+EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED\x10\x12\x1a1\x9a\xb5\x18-io.libops.organization.firewall_rule.added.v1\x12f\n" +
+	"-EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED\x10\x13\x1a3\x9a\xb5\x18/io.libops.organization.firewall_rule.removed.v1\x12X\n" +
+	"&EVENT_TYPE_ORGANIZATION_SECRET_CREATED\x10\x14\x1a,\x9a\xb5\x18(io.libops.organization.secret.created.v1\x12X\n" +
+	"&EVENT_TYPE_ORGANIZATION_SECRET_UPDATED\x10\x15\x1a,\x9a\xb5\x18(io.libops.organization.secret.updated.v1\x12X\n" +
+	"&EVENT_TYPE_ORGANIZATION_SECRET_DELETED\x10\x16\x1a,\x9a\xb5\x18(io.libops.organization.secret.deleted.v1\x12{\n" +
+	"'EVENT_TYPE_ORGANIZATION_SETTING_CREATED\x10\x17\x1aN\x9a\xb5\x18)io.libops.organization.setting.created.v1\xa2\xb5\x18\x1dlibops.v1.OrganizationSetting\x12{\n" +
+	"'EVENT_TYPE_ORGANIZATION_SETTING_UPDATED\x10\x18\x1aN\x9a\xb5\x18)io.libops.organization.setting.updated.v1\xa2\xb5\x18\x1dlibops.v1.OrganizationSetting\x12{\n" +
+	"'EVENT_TYPE_ORGANIZATION_SETTING_DELETED\x10\x19\x1aN\x9a\xb5\x18)io.libops.organization.setting.deleted.v1\xa2\xb5\x18\x1dlibops.v1.OrganizationSetting\x12J\n" +
+	"\x1fEVENT_TYPE_PROJECT_MEMBER_ADDED\x10\x1a\x1a%\x9a\xb5\x18!io.libops.project.member.added.v1\x12w\n" +
+	"!EVENT_TYPE_PROJECT_MEMBER_UPDATED\x10\x1b\x1aP\x9a\xb5\x18#io.libops.project.member.updated.v1\xa2\xb5\x18%libops.v1.UpdateProjectMemberResponse\x12v\n" +
+	"!EVENT_TYPE_PROJECT_MEMBER_REMOVED\x10\x1c\x1aO\x9a\xb5\x18#io.libops.project.member.removed.v1\xa2\xb5\x18$libops.v1.DeleteProjectMemberRequest\x12X\n" +
+	"&EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED\x10\x1d\x1a,\x9a\xb5\x18(io.libops.project.firewall_rule.added.v1\x12\\\n" +
+	"(EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED\x10\x1e\x1a.\x9a\xb5\x18*io.libops.project.firewall_rule.removed.v1\x12w\n" +
+	"!EVENT_TYPE_PROJECT_SECRET_CREATED\x10\x1f\x1aP\x9a\xb5\x18#io.libops.project.secret.created.v1\xa2\xb5\x18%libops.v1.CreateProjectSecretResponse\x12w\n" +
+	"!EVENT_TYPE_PROJECT_SECRET_UPDATED\x10 \x1aP\x9a\xb5\x18#io.libops.project.secret.updated.v1\xa2\xb5\x18%libops.v1.UpdateProjectSecretResponse\x12N\n" +
+	"!EVENT_TYPE_PROJECT_SECRET_DELETED\x10!\x1a'\x9a\xb5\x18#io.libops.project.secret.deleted.v1\x12l\n" +
+	"\"EVENT_TYPE_PROJECT_SETTING_CREATED\x10\"\x1aD\x9a\xb5\x18$io.libops.project.setting.created.v1\xa2\xb5\x18\x18libops.v1.ProjectSetting\x12l\n" +
+	"\"EVENT_TYPE_PROJECT_SETTING_UPDATED\x10#\x1aD\x9a\xb5\x18$io.libops.project.setting.updated.v1\xa2\xb5\x18\x18libops.v1.ProjectSetting\x12l\n" +
+	"\"EVENT_TYPE_PROJECT_SETTING_DELETED\x10$\x1aD\x9a\xb5\x18$io.libops.project.setting.deleted.v1\xa2\xb5\x18\x18libops.v1.ProjectSetting\x12D\n" +
+	"\x1cEVENT_TYPE_SITE_MEMBER_ADDED\x10%\x1a\"\x9a\xb5\x18\x1eio.libops.site.member.added.v1\x12n\n" +
+	"\x1eEVENT_TYPE_SITE_MEMBER_UPDATED\x10&\x1aJ\x9a\xb5\x18 io.libops.site.member.updated.v1\xa2\xb5\x18\"libops.v1.UpdateSiteMemberResponse\x12m\n" +
+	"\x1eEVENT_TYPE_SITE_MEMBER_REMOVED\x10'\x1aI\x9a\xb5\x18 io.libops.site.member.removed.v1\xa2\xb5\x18!libops.v1.DeleteSiteMemberRequest\x12R\n" +
+	"#EVENT_TYPE_SITE_FIREWALL_RULE_ADDED\x10(\x1a)\x9a\xb5\x18%io.libops.site.firewall_rule.added.v1\x12V\n" +
+	"%EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED\x10)\x1a+\x9a\xb5\x18'io.libops.site.firewall_rule.removed.v1\x12n\n" +
+	"\x1eEVENT_TYPE_SITE_SECRET_CREATED\x10*\x1aJ\x9a\xb5\x18 io.libops.site.secret.created.v1\xa2\xb5\x18\"libops.v1.CreateSiteSecretResponse\x12\x9e\x01\n" +
+	"\x1eEVENT_TYPE_SITE_SECRET_UPDATED\x10+\x1az\x9a\xb5\x18 io.libops.site.secret.updated.v1\xa2\xb5\x18\"libops.v1.UpdateSiteSecretResponse\xa2\xb5\x18,libops.v1.ReportSiteDatabaseInstanceResponse\x12H\n" +
+	"\x1eEVENT_TYPE_SITE_SECRET_DELETED\x10,\x1a$\x9a\xb5\x18 io.libops.site.secret.deleted.v1\x12p\n" +
+	" EVENT_TYPE_SITE_REDIRECT_CREATED\x10-\x1aJ\x9a\xb5\x18\"io.libops.site.redirect.created.v1\xa2\xb5\x18 libops.v1.CreateRedirectResponse\x12p\n" +
+	" EVENT_TYPE_SITE_REDIRECT_UPDATED\x10.\x1aJ\x9a\xb5\x18\"io.libops.site.redirect.updated.v1\xa2\xb5\x18 libops.v1.UpdateRedirectResponse\x12o\n" +
+	" EVENT_TYPE_SITE_REDIRECT_DELETED\x10/\x1aI\x9a\xb5\x18\"io.libops.site.redirect.deleted.v1\xa2\xb5\x18\x1flibops.v1.DeleteRedirectRequest\x12\xba\x01\n" +
+	")EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED\x100\x1a\x8a\x01\x9a\xb5\x18+io.libops.site.access_protection.updated.v1\xa2\xb5\x18)libops.v1.SetSiteAccessProtectionResponse\xa2\xb5\x18*libops.v1.ResetSiteAccessProtectionRequest\x12\xbd\x01\n" +
+	"\x1bEVENT_TYPE_SITE_WAF_UPDATED\x101\x1a\x9b\x01\x9a\xb5\x18\x1dio.libops.site.waf.updated.v1\xa2\xb5\x18\x1flibops.v1.UpdateSiteWafResponse\xa2\xb5\x18(libops.v1.CreateWafRuleExclusionResponse\xa2\xb5\x18'libops.v1.DeleteWafRuleExclusionRequest\x12\x83\x01\n" +
+	"%EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED\x102\x1aX\x9a\xb5\x18'io.libops.site.rate_limit_rule.added.v1\xa2\xb5\x18)libops.v1.CreateSiteRateLimitRuleResponse\x12\x86\x01\n" +
+	"'EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED\x103\x1aY\x9a\xb5\x18)io.libops.site.rate_limit_rule.removed.v1\xa2\xb5\x18(libops.v1.DeleteSiteRateLimitRuleRequest\x12\xd6\x01\n" +
+	"\"EVENT_TYPE_SITE_TLS_POLICY_UPDATED\x104\x1a\xad\x01\x9a\xb5\x18$io.libops.site.tls_policy.updated.v1\xa2\xb5\x18%libops.v1.UpdateSiteTlsPolicyResponse\xa2\xb5\x18*libops.v1.UploadSiteTlsCertificateResponse\xa2\xb5\x18*libops.v1.DeleteSiteTlsCertificateResponse\x12i\n" +
+	"\x1eEVENT_TYPE_SITE_ADDON_ATTACHED\x105\x1aE\x9a\xb5\x18 io.libops.site.addon.attached.v1\xa2\xb5\x18\x1dlibops.v1.AttachAddonResponse\x12h\n" +
+	"\x1eEVENT_TYPE_SITE_ADDON_DETACHED\x106\x1aD\x9a\xb5\x18 io.libops.site.addon.detached.v1\xa2\xb5\x18\x1clibops.v1.DetachAddonRequest\x12t\n" +
+	"\"EVENT_TYPE_SITE_SSH_ACCESS_GRANTED\x107\x1aL\x9a\xb5\x18$io.libops.site.ssh_access.granted.v1\xa2\xb5\x18 libops.v1.GrantSshAccessResponse\x12u\n" +
+	"\"EVENT_TYPE_SITE_SSH_ACCESS_UPDATED\x108\x1aM\x9a\xb5\x18$io.libops.site.ssh_access.updated.v1\xa2\xb5\x18!libops.v1.UpdateSshAccessResponse\x12t\n" +
+	"\"EVENT_TYPE_SITE_SSH_ACCESS_REVOKED\x109\x1aL\x9a\xb5\x18$io.libops.site.ssh_access.revoked.v1\xa2\xb5\x18 libops.v1.RevokeSshAccessRequest\x12i\n" +
+	"#EVENT_TYPE_SITE_ELEVATION_REQUESTED\x10:\x1a@\x9a\xb5\x18%io.libops.site.elevation.requested.v1\xa2\xb5\x18\x13libops.v1.Elevation\x12g\n" +
+	"\"EVENT_TYPE_SITE_ELEVATION_APPROVED\x10;\x1a?\x9a\xb5\x18$io.libops.site.elevation.approved.v1\xa2\xb5\x18\x13libops.v1.Elevation\x12g\n" +
+	"\"EVENT_TYPE_SITE_ELEVATION_REJECTED\x10<\x1a?\x9a\xb5\x18$io.libops.site.elevation.rejected.v1\xa2\xb5\x18\x13libops.v1.Elevation\x12e\n" +
+	"!EVENT_TYPE_SITE_ELEVATION_REVOKED\x10=\x1a>\x9a\xb5\x18#io.libops.site.elevation.revoked.v1\xa2\xb5\x18\x13libops.v1.Elevation\x12e\n" +
+	"!EVENT_TYPE_SITE_ELEVATION_EXPIRED\x10>\x1a>\x9a\xb5\x18#io.libops.site.elevation.expired.v1\xa2\xb5\x18\x13libops.v1.Elevation\x12j\n" +
+	"\x1eEVENT_TYPE_SITE_CONFIG_VAR_SET\x10?\x1aF\x9a\xb5\x18 io.libops.site.config_var.set.v1\xa2\xb5\x18\x1elibops.v1.SetConfigVarResponse\x12t\n" +
+	"\"EVENT_TYPE_SITE_CONFIG_VAR_DELETED\x10@\x1aL\x9a\xb5\x18$io.libops.site.config_var.deleted.v1\xa2\xb5\x18 libops.v1.DeleteConfigVarRequest\x12c\n" +
+	"\x1fEVENT_TYPE_SITE_SETTING_CREATED\x10A\x1a>\x9a\xb5\x18!io.libops.site.setting.created.v1\xa2\xb5\x18\x15libops.v1.SiteSetting\x12c\n" +
+	"\x1fEVENT_TYPE_SITE_SETTING_UPDATED\x10B\x1a>\x9a\xb5\x18!io.libops.site.setting.updated.v1\xa2\xb5\x18\x15libops.v1.SiteSetting\x12c\n" +
+	"\x1fEVENT_TYPE_SITE_SETTING_DELETED\x10C\x1a>\x9a\xb5\x18!io.libops.site.setting.deleted.v1\xa2\xb5\x18\x15libops.v1.SiteSetting\x12u\n" +
+	"!EVENT_TYPE_BILLING_PAYMENT_FAILED\x10D\x1aN\x9a\xb5\x18#io.libops.billing.payment_failed.v1\xa2\xb5\x18#libops.v1.common.PaymentFailedEvent\x12y\n" +
+	" EVENT_TYPE_BILLING_STATE_CHANGED\x10E\x1aS\x9a\xb5\x18\"io.libops.billing.state_changed.v1\xa2\xb5\x18)libops.v1.common.BillingStateChangedEvent\x12s\n" +
+	"\x1fEVENT_TYPE_DEPLOYMENT_SUCCEEDED\x10F\x1aN\x9a\xb5\x18!io.libops.deployment.succeeded.v1\xa2\xb5\x18%libops.v1.ReconciliationFinishedEvent\x12m\n" +
+	"\x1cEVENT_TYPE_DEPLOYMENT_FAILED\x10G\x1aK\x9a\xb5\x18\x1eio.libops.deployment.failed.v1\xa2\xb5\x18%libops.v1.ReconciliationFinishedEvent\x12u\n" +
+	" EVENT_TYPE_RECONCILIATION_FAILED\x10H\x1aO\x9a\xb5\x18\"io.libops.reconciliation.failed.v1\xa2\xb5\x18%libops.v1.ReconciliationFinishedEvent\x12u\n" +
+	"$EVENT_TYPE_NOTIFICATION_CHANNEL_TEST\x10I\x1aK\x9a\xb5\x18&io.libops.notification_channel.test.v1\xa2\xb5\x18\x1dlibops.v1.NotificationChannel\x12W\n" +
+	"\x1aEVENT_TYPE_EVENT_SINK_TEST\x10J\x1a7\x9a\xb5\x18\x1cio.libops.event_sink.test.v1\xa2\xb5\x18\x13libops.v1.EventSink\x12Z\n" +
+	"\x1aEVENT_TYPE_INCIDENT_OPENED\x10K\x1a:\x9a\xb5\x18\x1cio.libops.incident.opened.v1\xa2\xb5\x18\x16libops.v1.SiteIncident\x12^\n" +
+	"\x1cEVENT_TYPE_INCIDENT_RESOLVED\x10L\x1a<\x9a\xb5\x18\x1eio.libops.incident.resolved.v1\xa2\xb5\x18\x16libops.v1.SiteIncident\x12d\n" +
+	"\x1fEVENT_TYPE_RELATIONSHIP_CREATED\x10M\x1a?\x9a\xb5\x18!io.libops.relationship.created.v1\xa2\xb5\x18\x16libops.v1.Relationship\x12f\n" +
+	" EVENT_TYPE_RELATIONSHIP_APPROVED\x10N\x1a@\x9a\xb5\x18\"io.libops.relationship.approved.v1\xa2\xb5\x18\x16libops.v1.Relationship\x12f\n" +
+	" EVENT_TYPE_RELATIONSHIP_REJECTED\x10O\x1a@\x9a\xb5\x18\"io.libops.relationship.rejected.v1\xa2\xb5\x18\x16libops.v1.Relationship\x12d\n" +
+	"\x1fEVENT_TYPE_RELATIONSHIP_UPDATED\x10P\x1a?\x9a\xb5\x18!io.libops.relationship.updated.v1\xa2\xb5\x18\x16libops.v1.Relationship\x12d\n" +
+	"\x1fEVENT_TYPE_RELATIONSHIP_REVOKED\x10Q\x1a?\x9a\xb5\x18!io.libops.relationship.revoked.v1\xa2\xb5\x18\x16libops.v1.Relationship\x12l\n" +
+	"&EVENT_TYPE_ORGANIZATION_POLICY_CREATED\x10R\x1a@\x9a\xb5\x18(io.libops.organization.policy.created.v1\xa2\xb5\x18\x10libops.v1.Policy\x12l\n" +
+	"&EVENT_TYPE_ORGANIZATION_POLICY_UPDATED\x10S\x1a@\x9a\xb5\x18(io.libops.organization.policy.updated.v1\xa2\xb5\x18\x10libops.v1.Policy\x12l\n" +
+	"&EVENT_TYPE_ORGANIZATION_POLICY_DELETED\x10T\x1a@\x9a\xb5\x18(io.libops.organization.policy.deleted.v1\xa2\xb5\x18\x10libops.v1.Policy\x12w\n" +
+	"'EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED\x10U\x1aJ\x9a\xb5\x18)io.libops.organization.policy.violated.v1\xa2\xb5\x18\x19libops.v1.PolicyViolation\x12\x87\x01\n" +
+	"-EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY\x10V\x1aT\x9a\xb5\x18/io.libops.organization.secret.access_anomaly.v1\xa2\xb5\x18\x1dlibops.v1.SecretAccessAnomalyB\xb3\x01\n" +
+	"\x14com.libops.v1.eventsB\vEventsProtoP\x01Z,github.com/libops/api/proto/libops/v1/events\xa2\x02\x03LVE\xaa\x02\x10Libops.V1.Events\xca\x02\x10Libops\\V1\\Events\xe2\x02\x1cLibops\\V1\\Events\\GPBMetadata\xea\x02\x12Libops::V1::Eventsb\x06proto3"
+
+var (
+	file_libops_v1_events_events_proto_rawDescOnce sync.Once
+	file_libops_v1_events_events_proto_rawDescData []byte
+)
+
+func file_libops_v1_events_events_proto_rawDescGZIP() []byte {
+	file_libops_v1_events_events_proto_rawDescOnce.Do(func() {
+		file_libops_v1_events_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_events_events_proto_rawDesc), len(file_libops_v1_events_events_proto_rawDesc)))
+	})
+	return file_libops_v1_events_events_proto_rawDescData
+}
+
+var file_libops_v1_events_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_events_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libops_v1_events_events_proto_goTypes = []any{
+	(SchemaVersion)(0), // 0: libops.v1.events.SchemaVersion
+	(EventType)(0),     // 1: libops.v1.events.EventType
+	(*Envelope)(nil),   // 2: libops.v1.events.Envelope
+	(*anypb.Any)(nil),  // 3: google.protobuf.Any
+}
+var file_libops_v1_events_events_proto_depIdxs = []int32{
+	0, // 0: libops.v1.events.Envelope.schema_version:type_name -> libops.v1.events.SchemaVersion
+	1, // 1: libops.v1.events.Envelope.type:type_name -> libops.v1.events.EventType
+	3, // 2: libops.v1.events.Envelope.data:type_name -> google.protobuf.Any
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_events_events_proto_init() }
+func file_libops_v1_events_events_proto_init() {
+	if File_libops_v1_events_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_events_events_proto_rawDesc), len(file_libops_v1_events_events_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_libops_v1_events_events_proto_goTypes,
+		DependencyIndexes: file_libops_v1_events_events_proto_depIdxs,
+		EnumInfos:         file_libops_v1_events_events_proto_enumTypes,
+		MessageInfos:      file_libops_v1_events_events_proto_msgTypes,
+	}.Build()
+	File_libops_v1_events_events_proto = out.File
+	file_libops_v1_events_events_proto_goTypes = nil
+	file_libops_v1_events_events_proto_depIdxs = nil
+}
@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/export.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportStatus int32
+
+const (
+	ExportStatus_EXPORT_STATUS_UNSPECIFIED ExportStatus = 0
+	ExportStatus_EXPORT_STATUS_PENDING     ExportStatus = 1 // Waiting to be built
+	ExportStatus_EXPORT_STATUS_RUNNING     ExportStatus = 2 // Being built
+	ExportStatus_EXPORT_STATUS_SUCCEEDED   ExportStatus = 3 // Ready to download until expires_at
+	ExportStatus_EXPORT_STATUS_FAILED      ExportStatus = 4 // See error
+)
+
+// Enum value maps for ExportStatus.
+var (
+	ExportStatus_name = map[int32]string{
+		0: "EXPORT_STATUS_UNSPECIFIED",
+		1: "EXPORT_STATUS_PENDING",
+		2: "EXPORT_STATUS_RUNNING",
+		3: "EXPORT_STATUS_SUCCEEDED",
+		4: "EXPORT_STATUS_FAILED",
+	}
+	ExportStatus_value = map[string]int32{
+		"EXPORT_STATUS_UNSPECIFIED": 0,
+		"EXPORT_STATUS_PENDING":     1,
+		"EXPORT_STATUS_RUNNING":     2,
+		"EXPORT_STATUS_SUCCEEDED":   3,
+		"EXPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ExportStatus) Enum() *ExportStatus {
+	p := new(ExportStatus)
+	*p = x
+	return p
+}
+
+func (x ExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_export_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_export_proto_enumTypes[0]
+}
+
+func (x ExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatus.Descriptor instead.
+func (ExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+type OrganizationExport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExportId       string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`                   // UUID
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	Status         ExportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=libops.v1.ExportStatus" json:"status,omitempty"`
+	IncludeSecrets bool                   `protobuf:"varint,4,opt,name=include_secrets,json=includeSecrets,proto3" json:"include_secrets,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                           // Why the export failed
+	SizeBytes      int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"` // Size of the bundle
+	Sha256         string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`                         // Hex SHA-256 of the bundle, to check the download
+	// Signed URL of the .tar.gz bundle, valid for an hour. Only set by GetOrganizationExport
+	// on a succeeded export; get the export again for a fresh URL.
+	DownloadUrl   string `protobuf:"bytes,8,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	ExpiresAt     int64  `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`        // Unix timestamp after which the bundle is deleted
+	CreatedAt     int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Unix timestamp
+	CompletedAt   int64  `protobuf:"varint,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unix timestamp, 0 until the export succeeds or fails
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationExport) Reset() {
+	*x = OrganizationExport{}
+	mi := &file_libops_v1_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationExport) ProtoMessage() {}
+
+func (x *OrganizationExport) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationExport.ProtoReflect.Descriptor instead.
+func (*OrganizationExport) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrganizationExport) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *OrganizationExport) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationExport) GetStatus() ExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExportStatus_EXPORT_STATUS_UNSPECIFIED
+}
+
+func (x *OrganizationExport) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+func (x *OrganizationExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OrganizationExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *OrganizationExport) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *OrganizationExport) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *OrganizationExport) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OrganizationExport) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrganizationExport) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type ExportOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Read secret values from the organization's Vault into the bundle. Without it
+	// the bundle lists secret names only.
+	IncludeSecrets bool `protobuf:"varint,2,opt,name=include_secrets,json=includeSecrets,proto3" json:"include_secrets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportOrganizationRequest) Reset() {
+	*x = ExportOrganizationRequest{}
+	mi := &file_libops_v1_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganizationRequest) ProtoMessage() {}
+
+func (x *ExportOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ExportOrganizationRequest) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+type ExportOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *OrganizationExport    `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrganizationResponse) Reset() {
+	*x = ExportOrganizationResponse{}
+	mi := &file_libops_v1_export_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrganizationResponse) ProtoMessage() {}
+
+func (x *ExportOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportOrganizationResponse) GetExport() *OrganizationExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetOrganizationExportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ExportId       string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationExportRequest) Reset() {
+	*x = GetOrganizationExportRequest{}
+	mi := &file_libops_v1_export_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationExportRequest) ProtoMessage() {}
+
+func (x *GetOrganizationExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationExportRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationExportRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrganizationExportRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetOrganizationExportRequest) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type GetOrganizationExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *OrganizationExport    `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationExportResponse) Reset() {
+	*x = GetOrganizationExportResponse{}
+	mi := &file_libops_v1_export_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationExportResponse) ProtoMessage() {}
+
+func (x *GetOrganizationExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationExportResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationExportResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOrganizationExportResponse) GetExport() *OrganizationExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type ListOrganizationExportsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrganizationExportsRequest) Reset() {
+	*x = ListOrganizationExportsRequest{}
+	mi := &file_libops_v1_export_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationExportsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationExportsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOrganizationExportsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListOrganizationExportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrganizationExportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrganizationExportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exports       []*OrganizationExport  `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationExportsResponse) Reset() {
+	*x = ListOrganizationExportsResponse{}
+	mi := &file_libops_v1_export_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationExportsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_export_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationExportsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_export_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrganizationExportsResponse) GetExports() []*OrganizationExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+func (x *ListOrganizationExportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_libops_v1_export_proto protoreflect.FileDescriptor
+
+const file_libops_v1_export_proto_rawDesc = "" +
+	"\n" +
+	"\x16libops/v1/export.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\x85\x03\n" +
+	"\x12OrganizationExport\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.libops.v1.ExportStatusR\x06status\x12'\n" +
+	"\x0finclude_secrets\x18\x04 \x01(\bR\x0eincludeSecrets\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12!\n" +
+	"\fdownload_url\x18\b \x01(\tR\vdownloadUrl\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\v \x01(\x03R\vcompletedAt\"m\n" +
+	"\x19ExportOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0finclude_secrets\x18\x02 \x01(\bR\x0eincludeSecrets\"S\n" +
+	"\x1aExportOrganizationResponse\x125\n" +
+	"\x06export\x18\x01 \x01(\v2\x1d.libops.v1.OrganizationExportR\x06export\"d\n" +
+	"\x1cGetOrganizationExportRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"V\n" +
+	"\x1dGetOrganizationExportResponse\x125\n" +
+	"\x06export\x18\x01 \x01(\v2\x1d.libops.v1.OrganizationExportR\x06export\"\x85\x01\n" +
+	"\x1eListOrganizationExportsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1fListOrganizationExportsResponse\x127\n" +
+	"\aexports\x18\x01 \x03(\v2\x1d.libops.v1.OrganizationExportR\aexports\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*\x9a\x01\n" +
+	"\fExportStatus\x12\x1d\n" +
+	"\x19EXPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EXPORT_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15EXPORT_STATUS_RUNNING\x10\x02\x12\x1b\n" +
+	"\x17EXPORT_STATUS_SUCCEEDED\x10\x03\x12\x18\n" +
+	"\x14EXPORT_STATUS_FAILED\x10\x042\x92\x05\n" +
+	"\rExportService\x12\xc8\x01\n" +
+	"\x12ExportOrganization\x12$.libops.v1.ExportOrganizationRequest\x1a%.libops.v1.ExportOrganizationResponse\"e\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x020:\x01*\"+/v1/organizations/{organization_id}/exports\x12\xdc\x01\n" +
+	"\x15GetOrganizationExport\x12'.libops.v1.GetOrganizationExportRequest\x1a(.libops.v1.GetOrganizationExportResponse\"p\x92\xb5\x18*\b\x03\x10\x03\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x029\x127/v1/organizations/{organization_id}/exports/{export_id}\x90\x02\x01\x12\xd6\x01\n" +
+	"\x17ListOrganizationExports\x12).libops.v1.ListOrganizationExportsRequest\x1a*.libops.v1.ListOrganizationExportsResponse\"d\x92\xb5\x18*\b\x03\x10\x03\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02-\x12+/v1/organizations/{organization_id}/exports\x90\x02\x01B\x91\x01\n" +
+	"\rcom.libops.v1B\vExportProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_export_proto_rawDescOnce sync.Once
+	file_libops_v1_export_proto_rawDescData []byte
+)
+
+func file_libops_v1_export_proto_rawDescGZIP() []byte {
+	file_libops_v1_export_proto_rawDescOnce.Do(func() {
+		file_libops_v1_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_export_proto_rawDesc), len(file_libops_v1_export_proto_rawDesc)))
+	})
+	return file_libops_v1_export_proto_rawDescData
+}
+
+var file_libops_v1_export_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_libops_v1_export_proto_goTypes = []any{
+	(ExportStatus)(0),                       // 0: libops.v1.ExportStatus
+	(*OrganizationExport)(nil),              // 1: libops.v1.OrganizationExport
+	(*ExportOrganizationRequest)(nil),       // 2: libops.v1.ExportOrganizationRequest
+	(*ExportOrganizationResponse)(nil),      // 3: libops.v1.ExportOrganizationResponse
+	(*GetOrganizationExportRequest)(nil),    // 4: libops.v1.GetOrganizationExportRequest
+	(*GetOrganizationExportResponse)(nil),   // 5: libops.v1.GetOrganizationExportResponse
+	(*ListOrganizationExportsRequest)(nil),  // 6: libops.v1.ListOrganizationExportsRequest
+	(*ListOrganizationExportsResponse)(nil), // 7: libops.v1.ListOrganizationExportsResponse
+}
+var file_libops_v1_export_proto_depIdxs = []int32{
+	0, // 0: libops.v1.OrganizationExport.status:type_name -> libops.v1.ExportStatus
+	1, // 1: libops.v1.ExportOrganizationResponse.export:type_name -> libops.v1.OrganizationExport
+	1, // 2: libops.v1.GetOrganizationExportResponse.export:type_name -> libops.v1.OrganizationExport
+	1, // 3: libops.v1.ListOrganizationExportsResponse.exports:type_name -> libops.v1.OrganizationExport
+	2, // 4: libops.v1.ExportService.ExportOrganization:input_type -> libops.v1.ExportOrganizationRequest
+	4, // 5: libops.v1.ExportService.GetOrganizationExport:input_type -> libops.v1.GetOrganizationExportRequest
+	6, // 6: libops.v1.ExportService.ListOrganizationExports:input_type -> libops.v1.ListOrganizationExportsRequest
+	3, // 7: libops.v1.ExportService.ExportOrganization:output_type -> libops.v1.ExportOrganizationResponse
+	5, // 8: libops.v1.ExportService.GetOrganizationExport:output_type -> libops.v1.GetOrganizationExportResponse
+	7, // 9: libops.v1.ExportService.ListOrganizationExports:output_type -> libops.v1.ListOrganizationExportsResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_export_proto_init() }
+func file_libops_v1_export_proto_init() {
+	if File_libops_v1_export_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_export_proto_rawDesc), len(file_libops_v1_export_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_export_proto_goTypes,
+		DependencyIndexes: file_libops_v1_export_proto_depIdxs,
+		EnumInfos:         file_libops_v1_export_proto_enumTypes,
+		MessageInfos:      file_libops_v1_export_proto_msgTypes,
+	}.Build()
+	File_libops_v1_export_proto = out.File
+	file_libops_v1_export_proto_goTypes = nil
+	file_libops_v1_export_proto_depIdxs = nil
+}
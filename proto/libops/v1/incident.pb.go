@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/incident.proto
+
+package libopsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SiteIncidentCause int32
+
+const (
+	SiteIncidentCause_SITE_INCIDENT_CAUSE_UNSPECIFIED     SiteIncidentCause = 0
+	SiteIncidentCause_SITE_INCIDENT_CAUSE_CHECKIN_MISSED  SiteIncidentCause = 1 // The site's VM controller stopped checking in
+	SiteIncidentCause_SITE_INCIDENT_CAUSE_PROBE_FAILED    SiteIncidentCause = 2 // The site's health URL failed every external probe
+	SiteIncidentCause_SITE_INCIDENT_CAUSE_HEALTH_DEGRADED SiteIncidentCause = 3 // The site's health score stayed below the threshold
+)
+
+// Enum value maps for SiteIncidentCause.
+var (
+	SiteIncidentCause_name = map[int32]string{
+		0: "SITE_INCIDENT_CAUSE_UNSPECIFIED",
+		1: "SITE_INCIDENT_CAUSE_CHECKIN_MISSED",
+		2: "SITE_INCIDENT_CAUSE_PROBE_FAILED",
+		3: "SITE_INCIDENT_CAUSE_HEALTH_DEGRADED",
+	}
+	SiteIncidentCause_value = map[string]int32{
+		"SITE_INCIDENT_CAUSE_UNSPECIFIED":     0,
+		"SITE_INCIDENT_CAUSE_CHECKIN_MISSED":  1,
+		"SITE_INCIDENT_CAUSE_PROBE_FAILED":    2,
+		"SITE_INCIDENT_CAUSE_HEALTH_DEGRADED": 3,
+	}
+)
+
+func (x SiteIncidentCause) Enum() *SiteIncidentCause {
+	p := new(SiteIncidentCause)
+	*p = x
+	return p
+}
+
+func (x SiteIncidentCause) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SiteIncidentCause) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_incident_proto_enumTypes[0].Descriptor()
+}
+
+func (SiteIncidentCause) Type() protoreflect.EnumType {
+	return &file_libops_v1_incident_proto_enumTypes[0]
+}
+
+func (x SiteIncidentCause) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SiteIncidentCause.Descriptor instead.
+func (SiteIncidentCause) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_incident_proto_rawDescGZIP(), []int{0}
+}
+
+type SiteIncidentStatus int32
+
+const (
+	SiteIncidentStatus_SITE_INCIDENT_STATUS_UNSPECIFIED SiteIncidentStatus = 0
+	SiteIncidentStatus_SITE_INCIDENT_STATUS_OPEN        SiteIncidentStatus = 1
+	SiteIncidentStatus_SITE_INCIDENT_STATUS_RESOLVED    SiteIncidentStatus = 2
+)
+
+// Enum value maps for SiteIncidentStatus.
+var (
+	SiteIncidentStatus_name = map[int32]string{
+		0: "SITE_INCIDENT_STATUS_UNSPECIFIED",
+		1: "SITE_INCIDENT_STATUS_OPEN",
+		2: "SITE_INCIDENT_STATUS_RESOLVED",
+	}
+	SiteIncidentStatus_value = map[string]int32{
+		"SITE_INCIDENT_STATUS_UNSPECIFIED": 0,
+		"SITE_INCIDENT_STATUS_OPEN":        1,
+		"SITE_INCIDENT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x SiteIncidentStatus) Enum() *SiteIncidentStatus {
+	p := new(SiteIncidentStatus)
+	*p = x
+	return p
+}
+
+func (x SiteIncidentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SiteIncidentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_incident_proto_enumTypes[1].Descriptor()
+}
+
+func (SiteIncidentStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_incident_proto_enumTypes[1]
+}
+
+func (x SiteIncidentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SiteIncidentStatus.Descriptor instead.
+func (SiteIncidentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_incident_proto_rawDescGZIP(), []int{1}
+}
+
+// SiteIncident is a period during which a site was considered down.
+// It is the payload of the io.libops.incident.opened/resolved events.
+type SiteIncident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncidentId    string                 `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"` // UUID, also the PagerDuty dedup key and Opsgenie alias
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`             // UUID
+	SiteName      string                 `protobuf:"bytes,3,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	Cause         SiteIncidentCause      `protobuf:"varint,4,opt,name=cause,proto3,enum=libops.v1.SiteIncidentCause" json:"cause,omitempty"`
+	Status        SiteIncidentStatus     `protobuf:"varint,5,opt,name=status,proto3,enum=libops.v1.SiteIncidentStatus" json:"status,omitempty"`
+	OpenedAt      int64                  `protobuf:"varint,6,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`       // Unix timestamp
+	ResolvedAt    int64                  `protobuf:"varint,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unix timestamp, 0 while open
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteIncident) Reset() {
+	*x = SiteIncident{}
+	mi := &file_libops_v1_incident_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteIncident) ProtoMessage() {}
+
+func (x *SiteIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_incident_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteIncident.ProtoReflect.Descriptor instead.
+func (*SiteIncident) Descriptor() ([]byte, []int) {
+	return file_libops_v1_incident_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteIncident) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *SiteIncident) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteIncident) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *SiteIncident) GetCause() SiteIncidentCause {
+	if x != nil {
+		return x.Cause
+	}
+	return SiteIncidentCause_SITE_INCIDENT_CAUSE_UNSPECIFIED
+}
+
+func (x *SiteIncident) GetStatus() SiteIncidentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SiteIncidentStatus_SITE_INCIDENT_STATUS_UNSPECIFIED
+}
+
+func (x *SiteIncident) GetOpenedAt() int64 {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return 0
+}
+
+func (x *SiteIncident) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+var File_libops_v1_incident_proto protoreflect.FileDescriptor
+
+const file_libops_v1_incident_proto_rawDesc = "" +
+	"\n" +
+	"\x18libops/v1/incident.proto\x12\tlibops.v1\"\x8e\x02\n" +
+	"\fSiteIncident\x12\x1f\n" +
+	"\vincident_id\x18\x01 \x01(\tR\n" +
+	"incidentId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tsite_name\x18\x03 \x01(\tR\bsiteName\x122\n" +
+	"\x05cause\x18\x04 \x01(\x0e2\x1c.libops.v1.SiteIncidentCauseR\x05cause\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.libops.v1.SiteIncidentStatusR\x06status\x12\x1b\n" +
+	"\topened_at\x18\x06 \x01(\x03R\bopenedAt\x12\x1f\n" +
+	"\vresolved_at\x18\a \x01(\x03R\n" +
+	"resolvedAt*\xaf\x01\n" +
+	"\x11SiteIncidentCause\x12#\n" +
+	"\x1fSITE_INCIDENT_CAUSE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"SITE_INCIDENT_CAUSE_CHECKIN_MISSED\x10\x01\x12$\n" +
+	" SITE_INCIDENT_CAUSE_PROBE_FAILED\x10\x02\x12'\n" +
+	"#SITE_INCIDENT_CAUSE_HEALTH_DEGRADED\x10\x03*|\n" +
+	"\x12SiteIncidentStatus\x12$\n" +
+	" SITE_INCIDENT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SITE_INCIDENT_STATUS_OPEN\x10\x01\x12!\n" +
+	"\x1dSITE_INCIDENT_STATUS_RESOLVED\x10\x02B\x93\x01\n" +
+	"\rcom.libops.v1B\rIncidentProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_incident_proto_rawDescOnce sync.Once
+	file_libops_v1_incident_proto_rawDescData []byte
+)
+
+func file_libops_v1_incident_proto_rawDescGZIP() []byte {
+	file_libops_v1_incident_proto_rawDescOnce.Do(func() {
+		file_libops_v1_incident_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_incident_proto_rawDesc), len(file_libops_v1_incident_proto_rawDesc)))
+	})
+	return file_libops_v1_incident_proto_rawDescData
+}
+
+var file_libops_v1_incident_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_incident_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_libops_v1_incident_proto_goTypes = []any{
+	(SiteIncidentCause)(0),  // 0: libops.v1.SiteIncidentCause
+	(SiteIncidentStatus)(0), // 1: libops.v1.SiteIncidentStatus
+	(*SiteIncident)(nil),    // 2: libops.v1.SiteIncident
+}
+var file_libops_v1_incident_proto_depIdxs = []int32{
+	0, // 0: libops.v1.SiteIncident.cause:type_name -> libops.v1.SiteIncidentCause
+	1, // 1: libops.v1.SiteIncident.status:type_name -> libops.v1.SiteIncidentStatus
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_incident_proto_init() }
+func file_libops_v1_incident_proto_init() {
+	if File_libops_v1_incident_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_incident_proto_rawDesc), len(file_libops_v1_incident_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_libops_v1_incident_proto_goTypes,
+		DependencyIndexes: file_libops_v1_incident_proto_depIdxs,
+		EnumInfos:         file_libops_v1_incident_proto_enumTypes,
+		MessageInfos:      file_libops_v1_incident_proto_msgTypes,
+	}.Build()
+	File_libops_v1_incident_proto = out.File
+	file_libops_v1_incident_proto_goTypes = nil
+	file_libops_v1_incident_proto_depIdxs = nil
+}
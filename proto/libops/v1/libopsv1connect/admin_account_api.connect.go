@@ -58,6 +58,15 @@ const (
 	// AdminAccountServiceListAccountRepositoriesProcedure is the fully-qualified name of the
 	// AdminAccountService's ListAccountRepositories RPC.
 	AdminAccountServiceListAccountRepositoriesProcedure = "/libops.v1.AdminAccountService/ListAccountRepositories"
+	// AdminAccountServiceListPlatformAuditorsProcedure is the fully-qualified name of the
+	// AdminAccountService's ListPlatformAuditors RPC.
+	AdminAccountServiceListPlatformAuditorsProcedure = "/libops.v1.AdminAccountService/ListPlatformAuditors"
+	// AdminAccountServiceGrantPlatformAuditorProcedure is the fully-qualified name of the
+	// AdminAccountService's GrantPlatformAuditor RPC.
+	AdminAccountServiceGrantPlatformAuditorProcedure = "/libops.v1.AdminAccountService/GrantPlatformAuditor"
+	// AdminAccountServiceRevokePlatformAuditorProcedure is the fully-qualified name of the
+	// AdminAccountService's RevokePlatformAuditor RPC.
+	AdminAccountServiceRevokePlatformAuditorProcedure = "/libops.v1.AdminAccountService/RevokePlatformAuditor"
 )
 
 // AdminAccountServiceClient is a client for the libops.v1.AdminAccountService service.
@@ -78,6 +87,15 @@ type AdminAccountServiceClient interface {
 	ListAccountProjects(context.Context, *connect.Request[v1.ListAccountProjectsRequest]) (*connect.Response[v1.ListAccountProjectsResponse], error)
 	// List repositories for an account
 	ListAccountRepositories(context.Context, *connect.Request[v1.ListAccountRepositoriesRequest]) (*connect.Response[v1.ListAccountRepositoriesResponse], error)
+	// List accounts with an unexpired auditor grant, soonest to expire first
+	ListPlatformAuditors(context.Context, *connect.Request[v1.ListPlatformAuditorsRequest]) (*connect.Response[v1.ListPlatformAuditorsResponse], error)
+	// Grant an account read-only access to the whole platform until expires_at,
+	// replacing any grant it has. While the grant lasts the account can call
+	// every Get and List RPC on any organization, project or site and the admin
+	// views, and can't change anything outside its own account.
+	GrantPlatformAuditor(context.Context, *connect.Request[v1.GrantPlatformAuditorRequest]) (*connect.Response[v1.GrantPlatformAuditorResponse], error)
+	// End an account's auditor grant early
+	RevokePlatformAuditor(context.Context, *connect.Request[v1.RevokePlatformAuditorRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAdminAccountServiceClient constructs a client for the libops.v1.AdminAccountService service.
@@ -144,6 +162,25 @@ func NewAdminAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		listPlatformAuditors: connect.NewClient[v1.ListPlatformAuditorsRequest, v1.ListPlatformAuditorsResponse](
+			httpClient,
+			baseURL+AdminAccountServiceListPlatformAuditorsProcedure,
+			connect.WithSchema(adminAccountServiceMethods.ByName("ListPlatformAuditors")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		grantPlatformAuditor: connect.NewClient[v1.GrantPlatformAuditorRequest, v1.GrantPlatformAuditorResponse](
+			httpClient,
+			baseURL+AdminAccountServiceGrantPlatformAuditorProcedure,
+			connect.WithSchema(adminAccountServiceMethods.ByName("GrantPlatformAuditor")),
+			connect.WithClientOptions(opts...),
+		),
+		revokePlatformAuditor: connect.NewClient[v1.RevokePlatformAuditorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminAccountServiceRevokePlatformAuditorProcedure,
+			connect.WithSchema(adminAccountServiceMethods.ByName("RevokePlatformAuditor")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -157,6 +194,9 @@ type adminAccountServiceClient struct {
 	listAccounts            *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
 	listAccountProjects     *connect.Client[v1.ListAccountProjectsRequest, v1.ListAccountProjectsResponse]
 	listAccountRepositories *connect.Client[v1.ListAccountRepositoriesRequest, v1.ListAccountRepositoriesResponse]
+	listPlatformAuditors    *connect.Client[v1.ListPlatformAuditorsRequest, v1.ListPlatformAuditorsResponse]
+	grantPlatformAuditor    *connect.Client[v1.GrantPlatformAuditorRequest, v1.GrantPlatformAuditorResponse]
+	revokePlatformAuditor   *connect.Client[v1.RevokePlatformAuditorRequest, emptypb.Empty]
 }
 
 // GetAccount calls libops.v1.AdminAccountService.GetAccount.
@@ -199,6 +239,21 @@ func (c *adminAccountServiceClient) ListAccountRepositories(ctx context.Context,
 	return c.listAccountRepositories.CallUnary(ctx, req)
 }
 
+// ListPlatformAuditors calls libops.v1.AdminAccountService.ListPlatformAuditors.
+func (c *adminAccountServiceClient) ListPlatformAuditors(ctx context.Context, req *connect.Request[v1.ListPlatformAuditorsRequest]) (*connect.Response[v1.ListPlatformAuditorsResponse], error) {
+	return c.listPlatformAuditors.CallUnary(ctx, req)
+}
+
+// GrantPlatformAuditor calls libops.v1.AdminAccountService.GrantPlatformAuditor.
+func (c *adminAccountServiceClient) GrantPlatformAuditor(ctx context.Context, req *connect.Request[v1.GrantPlatformAuditorRequest]) (*connect.Response[v1.GrantPlatformAuditorResponse], error) {
+	return c.grantPlatformAuditor.CallUnary(ctx, req)
+}
+
+// RevokePlatformAuditor calls libops.v1.AdminAccountService.RevokePlatformAuditor.
+func (c *adminAccountServiceClient) RevokePlatformAuditor(ctx context.Context, req *connect.Request[v1.RevokePlatformAuditorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokePlatformAuditor.CallUnary(ctx, req)
+}
+
 // AdminAccountServiceHandler is an implementation of the libops.v1.AdminAccountService service.
 type AdminAccountServiceHandler interface {
 	// Get account information by ID
@@ -217,6 +272,15 @@ type AdminAccountServiceHandler interface {
 	ListAccountProjects(context.Context, *connect.Request[v1.ListAccountProjectsRequest]) (*connect.Response[v1.ListAccountProjectsResponse], error)
 	// List repositories for an account
 	ListAccountRepositories(context.Context, *connect.Request[v1.ListAccountRepositoriesRequest]) (*connect.Response[v1.ListAccountRepositoriesResponse], error)
+	// List accounts with an unexpired auditor grant, soonest to expire first
+	ListPlatformAuditors(context.Context, *connect.Request[v1.ListPlatformAuditorsRequest]) (*connect.Response[v1.ListPlatformAuditorsResponse], error)
+	// Grant an account read-only access to the whole platform until expires_at,
+	// replacing any grant it has. While the grant lasts the account can call
+	// every Get and List RPC on any organization, project or site and the admin
+	// views, and can't change anything outside its own account.
+	GrantPlatformAuditor(context.Context, *connect.Request[v1.GrantPlatformAuditorRequest]) (*connect.Response[v1.GrantPlatformAuditorResponse], error)
+	// End an account's auditor grant early
+	RevokePlatformAuditor(context.Context, *connect.Request[v1.RevokePlatformAuditorRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAdminAccountServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -279,6 +343,25 @@ func NewAdminAccountServiceHandler(svc AdminAccountServiceHandler, opts ...conne
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminAccountServiceListPlatformAuditorsHandler := connect.NewUnaryHandler(
+		AdminAccountServiceListPlatformAuditorsProcedure,
+		svc.ListPlatformAuditors,
+		connect.WithSchema(adminAccountServiceMethods.ByName("ListPlatformAuditors")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminAccountServiceGrantPlatformAuditorHandler := connect.NewUnaryHandler(
+		AdminAccountServiceGrantPlatformAuditorProcedure,
+		svc.GrantPlatformAuditor,
+		connect.WithSchema(adminAccountServiceMethods.ByName("GrantPlatformAuditor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminAccountServiceRevokePlatformAuditorHandler := connect.NewUnaryHandler(
+		AdminAccountServiceRevokePlatformAuditorProcedure,
+		svc.RevokePlatformAuditor,
+		connect.WithSchema(adminAccountServiceMethods.ByName("RevokePlatformAuditor")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.AdminAccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminAccountServiceGetAccountProcedure:
@@ -297,6 +380,12 @@ func NewAdminAccountServiceHandler(svc AdminAccountServiceHandler, opts ...conne
 			adminAccountServiceListAccountProjectsHandler.ServeHTTP(w, r)
 		case AdminAccountServiceListAccountRepositoriesProcedure:
 			adminAccountServiceListAccountRepositoriesHandler.ServeHTTP(w, r)
+		case AdminAccountServiceListPlatformAuditorsProcedure:
+			adminAccountServiceListPlatformAuditorsHandler.ServeHTTP(w, r)
+		case AdminAccountServiceGrantPlatformAuditorProcedure:
+			adminAccountServiceGrantPlatformAuditorHandler.ServeHTTP(w, r)
+		case AdminAccountServiceRevokePlatformAuditorProcedure:
+			adminAccountServiceRevokePlatformAuditorHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -337,3 +426,15 @@ func (UnimplementedAdminAccountServiceHandler) ListAccountProjects(context.Conte
 func (UnimplementedAdminAccountServiceHandler) ListAccountRepositories(context.Context, *connect.Request[v1.ListAccountRepositoriesRequest]) (*connect.Response[v1.ListAccountRepositoriesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminAccountService.ListAccountRepositories is not implemented"))
 }
+
+func (UnimplementedAdminAccountServiceHandler) ListPlatformAuditors(context.Context, *connect.Request[v1.ListPlatformAuditorsRequest]) (*connect.Response[v1.ListPlatformAuditorsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminAccountService.ListPlatformAuditors is not implemented"))
+}
+
+func (UnimplementedAdminAccountServiceHandler) GrantPlatformAuditor(context.Context, *connect.Request[v1.GrantPlatformAuditorRequest]) (*connect.Response[v1.GrantPlatformAuditorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminAccountService.GrantPlatformAuditor is not implemented"))
+}
+
+func (UnimplementedAdminAccountServiceHandler) RevokePlatformAuditor(context.Context, *connect.Request[v1.RevokePlatformAuditorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminAccountService.RevokePlatformAuditor is not implemented"))
+}
@@ -59,6 +59,15 @@ const (
 	// AdminOrganizationServiceListOrganizationProjectsProcedure is the fully-qualified name of the
 	// AdminOrganizationService's ListOrganizationProjects RPC.
 	AdminOrganizationServiceListOrganizationProjectsProcedure = "/libops.v1.AdminOrganizationService/ListOrganizationProjects"
+	// AdminOrganizationServiceSetOrganizationQuotaProcedure is the fully-qualified name of the
+	// AdminOrganizationService's SetOrganizationQuota RPC.
+	AdminOrganizationServiceSetOrganizationQuotaProcedure = "/libops.v1.AdminOrganizationService/SetOrganizationQuota"
+	// AdminOrganizationServiceDeleteOrganizationQuotaProcedure is the fully-qualified name of the
+	// AdminOrganizationService's DeleteOrganizationQuota RPC.
+	AdminOrganizationServiceDeleteOrganizationQuotaProcedure = "/libops.v1.AdminOrganizationService/DeleteOrganizationQuota"
+	// AdminOrganizationServiceRotateEncryptionKeyProcedure is the fully-qualified name of the
+	// AdminOrganizationService's RotateEncryptionKey RPC.
+	AdminOrganizationServiceRotateEncryptionKeyProcedure = "/libops.v1.AdminOrganizationService/RotateEncryptionKey"
 	// AdminSiteServiceListSitesProcedure is the fully-qualified name of the AdminSiteService's
 	// ListSites RPC.
 	AdminSiteServiceListSitesProcedure = "/libops.v1.AdminSiteService/ListSites"
@@ -89,6 +98,30 @@ const (
 	// AdminSiteServiceSiteCheckInProcedure is the fully-qualified name of the AdminSiteService's
 	// SiteCheckIn RPC.
 	AdminSiteServiceSiteCheckInProcedure = "/libops.v1.AdminSiteService/SiteCheckIn"
+	// AdminSiteServiceIssueSiteClientCertificateProcedure is the fully-qualified name of the
+	// AdminSiteService's IssueSiteClientCertificate RPC.
+	AdminSiteServiceIssueSiteClientCertificateProcedure = "/libops.v1.AdminSiteService/IssueSiteClientCertificate"
+	// AdminSiteServiceGetSiteProxyConfigProcedure is the fully-qualified name of the AdminSiteService's
+	// GetSiteProxyConfig RPC.
+	AdminSiteServiceGetSiteProxyConfigProcedure = "/libops.v1.AdminSiteService/GetSiteProxyConfig"
+	// AdminSiteServiceReportSiteTlsProbeProcedure is the fully-qualified name of the AdminSiteService's
+	// ReportSiteTlsProbe RPC.
+	AdminSiteServiceReportSiteTlsProbeProcedure = "/libops.v1.AdminSiteService/ReportSiteTlsProbe"
+	// AdminSiteServiceGetSiteDatabasesProcedure is the fully-qualified name of the AdminSiteService's
+	// GetSiteDatabases RPC.
+	AdminSiteServiceGetSiteDatabasesProcedure = "/libops.v1.AdminSiteService/GetSiteDatabases"
+	// AdminSiteServiceReportSiteDatabasesProcedure is the fully-qualified name of the
+	// AdminSiteService's ReportSiteDatabases RPC.
+	AdminSiteServiceReportSiteDatabasesProcedure = "/libops.v1.AdminSiteService/ReportSiteDatabases"
+	// AdminSiteServiceGetSiteAddonsProcedure is the fully-qualified name of the AdminSiteService's
+	// GetSiteAddons RPC.
+	AdminSiteServiceGetSiteAddonsProcedure = "/libops.v1.AdminSiteService/GetSiteAddons"
+	// AdminSiteServiceReportSiteAddonsProcedure is the fully-qualified name of the AdminSiteService's
+	// ReportSiteAddons RPC.
+	AdminSiteServiceReportSiteAddonsProcedure = "/libops.v1.AdminSiteService/ReportSiteAddons"
+	// AdminSiteServiceGetSiteConfigVarsProcedure is the fully-qualified name of the AdminSiteService's
+	// GetSiteConfigVars RPC.
+	AdminSiteServiceGetSiteConfigVarsProcedure = "/libops.v1.AdminSiteService/GetSiteConfigVars"
 	// AdminSiteServiceSyncManifestProcedure is the fully-qualified name of the AdminSiteService's
 	// SyncManifest RPC.
 	AdminSiteServiceSyncManifestProcedure = "/libops.v1.AdminSiteService/SyncManifest"
@@ -122,6 +155,21 @@ const (
 	// AdminReconciliationServiceGenerateTerraformVarsProcedure is the fully-qualified name of the
 	// AdminReconciliationService's GenerateTerraformVars RPC.
 	AdminReconciliationServiceGenerateTerraformVarsProcedure = "/libops.v1.AdminReconciliationService/GenerateTerraformVars"
+	// AdminReconciliationServiceResolvePrivateServiceConnectEndpointProcedure is the fully-qualified
+	// name of the AdminReconciliationService's ResolvePrivateServiceConnectEndpoint RPC.
+	AdminReconciliationServiceResolvePrivateServiceConnectEndpointProcedure = "/libops.v1.AdminReconciliationService/ResolvePrivateServiceConnectEndpoint"
+	// AdminReconciliationServiceReportPrivateServiceConnectEndpointsProcedure is the fully-qualified
+	// name of the AdminReconciliationService's ReportPrivateServiceConnectEndpoints RPC.
+	AdminReconciliationServiceReportPrivateServiceConnectEndpointsProcedure = "/libops.v1.AdminReconciliationService/ReportPrivateServiceConnectEndpoints"
+	// AdminReconciliationServiceReportSiteStaticEgressIpProcedure is the fully-qualified name of the
+	// AdminReconciliationService's ReportSiteStaticEgressIp RPC.
+	AdminReconciliationServiceReportSiteStaticEgressIpProcedure = "/libops.v1.AdminReconciliationService/ReportSiteStaticEgressIp"
+	// AdminReconciliationServiceReportSiteCdnProcedure is the fully-qualified name of the
+	// AdminReconciliationService's ReportSiteCdn RPC.
+	AdminReconciliationServiceReportSiteCdnProcedure = "/libops.v1.AdminReconciliationService/ReportSiteCdn"
+	// AdminReconciliationServiceReportSiteDatabaseInstanceProcedure is the fully-qualified name of the
+	// AdminReconciliationService's ReportSiteDatabaseInstance RPC.
+	AdminReconciliationServiceReportSiteDatabaseInstanceProcedure = "/libops.v1.AdminReconciliationService/ReportSiteDatabaseInstance"
 )
 
 // AdminOrganizationServiceClient is a client for the libops.v1.AdminOrganizationService service.
@@ -138,6 +186,13 @@ type AdminOrganizationServiceClient interface {
 	ListOrganizations(context.Context, *connect.Request[v1.AdminListOrganizationsRequest]) (*connect.Response[v1.AdminListOrganizationsResponse], error)
 	// List projects for a organization (admin view)
 	ListOrganizationProjects(context.Context, *connect.Request[v1.AdminListOrganizationProjectsRequest]) (*connect.Response[v1.AdminListOrganizationProjectsResponse], error)
+	// Override an organization's quota for a single resource
+	SetOrganizationQuota(context.Context, *connect.Request[v1.AdminSetOrganizationQuotaRequest]) (*connect.Response[v1.AdminSetOrganizationQuotaResponse], error)
+	// Remove a quota override, restoring the plan default
+	DeleteOrganizationQuota(context.Context, *connect.Request[v1.AdminDeleteOrganizationQuotaRequest]) (*connect.Response[emptypb.Empty], error)
+	// Rotate the keys an organization's secrets are encrypted with and re-wrap
+	// every stored secret with the new data key
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error)
 }
 
 // NewAdminOrganizationServiceClient constructs a client for the libops.v1.AdminOrganizationService
@@ -190,6 +245,24 @@ func NewAdminOrganizationServiceClient(httpClient connect.HTTPClient, baseURL st
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		setOrganizationQuota: connect.NewClient[v1.AdminSetOrganizationQuotaRequest, v1.AdminSetOrganizationQuotaResponse](
+			httpClient,
+			baseURL+AdminOrganizationServiceSetOrganizationQuotaProcedure,
+			connect.WithSchema(adminOrganizationServiceMethods.ByName("SetOrganizationQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganizationQuota: connect.NewClient[v1.AdminDeleteOrganizationQuotaRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminOrganizationServiceDeleteOrganizationQuotaProcedure,
+			connect.WithSchema(adminOrganizationServiceMethods.ByName("DeleteOrganizationQuota")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateEncryptionKey: connect.NewClient[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse](
+			httpClient,
+			baseURL+AdminOrganizationServiceRotateEncryptionKeyProcedure,
+			connect.WithSchema(adminOrganizationServiceMethods.ByName("RotateEncryptionKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -201,6 +274,9 @@ type adminOrganizationServiceClient struct {
 	deleteOrganization       *connect.Client[v1.AdminDeleteOrganizationRequest, emptypb.Empty]
 	listOrganizations        *connect.Client[v1.AdminListOrganizationsRequest, v1.AdminListOrganizationsResponse]
 	listOrganizationProjects *connect.Client[v1.AdminListOrganizationProjectsRequest, v1.AdminListOrganizationProjectsResponse]
+	setOrganizationQuota     *connect.Client[v1.AdminSetOrganizationQuotaRequest, v1.AdminSetOrganizationQuotaResponse]
+	deleteOrganizationQuota  *connect.Client[v1.AdminDeleteOrganizationQuotaRequest, emptypb.Empty]
+	rotateEncryptionKey      *connect.Client[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse]
 }
 
 // GetOrganization calls libops.v1.AdminOrganizationService.GetOrganization.
@@ -233,6 +309,21 @@ func (c *adminOrganizationServiceClient) ListOrganizationProjects(ctx context.Co
 	return c.listOrganizationProjects.CallUnary(ctx, req)
 }
 
+// SetOrganizationQuota calls libops.v1.AdminOrganizationService.SetOrganizationQuota.
+func (c *adminOrganizationServiceClient) SetOrganizationQuota(ctx context.Context, req *connect.Request[v1.AdminSetOrganizationQuotaRequest]) (*connect.Response[v1.AdminSetOrganizationQuotaResponse], error) {
+	return c.setOrganizationQuota.CallUnary(ctx, req)
+}
+
+// DeleteOrganizationQuota calls libops.v1.AdminOrganizationService.DeleteOrganizationQuota.
+func (c *adminOrganizationServiceClient) DeleteOrganizationQuota(ctx context.Context, req *connect.Request[v1.AdminDeleteOrganizationQuotaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteOrganizationQuota.CallUnary(ctx, req)
+}
+
+// RotateEncryptionKey calls libops.v1.AdminOrganizationService.RotateEncryptionKey.
+func (c *adminOrganizationServiceClient) RotateEncryptionKey(ctx context.Context, req *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	return c.rotateEncryptionKey.CallUnary(ctx, req)
+}
+
 // AdminOrganizationServiceHandler is an implementation of the libops.v1.AdminOrganizationService
 // service.
 type AdminOrganizationServiceHandler interface {
@@ -248,6 +339,13 @@ type AdminOrganizationServiceHandler interface {
 	ListOrganizations(context.Context, *connect.Request[v1.AdminListOrganizationsRequest]) (*connect.Response[v1.AdminListOrganizationsResponse], error)
 	// List projects for a organization (admin view)
 	ListOrganizationProjects(context.Context, *connect.Request[v1.AdminListOrganizationProjectsRequest]) (*connect.Response[v1.AdminListOrganizationProjectsResponse], error)
+	// Override an organization's quota for a single resource
+	SetOrganizationQuota(context.Context, *connect.Request[v1.AdminSetOrganizationQuotaRequest]) (*connect.Response[v1.AdminSetOrganizationQuotaResponse], error)
+	// Remove a quota override, restoring the plan default
+	DeleteOrganizationQuota(context.Context, *connect.Request[v1.AdminDeleteOrganizationQuotaRequest]) (*connect.Response[emptypb.Empty], error)
+	// Rotate the keys an organization's secrets are encrypted with and re-wrap
+	// every stored secret with the new data key
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error)
 }
 
 // NewAdminOrganizationServiceHandler builds an HTTP handler from the service implementation. It
@@ -296,6 +394,24 @@ func NewAdminOrganizationServiceHandler(svc AdminOrganizationServiceHandler, opt
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminOrganizationServiceSetOrganizationQuotaHandler := connect.NewUnaryHandler(
+		AdminOrganizationServiceSetOrganizationQuotaProcedure,
+		svc.SetOrganizationQuota,
+		connect.WithSchema(adminOrganizationServiceMethods.ByName("SetOrganizationQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminOrganizationServiceDeleteOrganizationQuotaHandler := connect.NewUnaryHandler(
+		AdminOrganizationServiceDeleteOrganizationQuotaProcedure,
+		svc.DeleteOrganizationQuota,
+		connect.WithSchema(adminOrganizationServiceMethods.ByName("DeleteOrganizationQuota")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminOrganizationServiceRotateEncryptionKeyHandler := connect.NewUnaryHandler(
+		AdminOrganizationServiceRotateEncryptionKeyProcedure,
+		svc.RotateEncryptionKey,
+		connect.WithSchema(adminOrganizationServiceMethods.ByName("RotateEncryptionKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.AdminOrganizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminOrganizationServiceGetOrganizationProcedure:
@@ -310,6 +426,12 @@ func NewAdminOrganizationServiceHandler(svc AdminOrganizationServiceHandler, opt
 			adminOrganizationServiceListOrganizationsHandler.ServeHTTP(w, r)
 		case AdminOrganizationServiceListOrganizationProjectsProcedure:
 			adminOrganizationServiceListOrganizationProjectsHandler.ServeHTTP(w, r)
+		case AdminOrganizationServiceSetOrganizationQuotaProcedure:
+			adminOrganizationServiceSetOrganizationQuotaHandler.ServeHTTP(w, r)
+		case AdminOrganizationServiceDeleteOrganizationQuotaProcedure:
+			adminOrganizationServiceDeleteOrganizationQuotaHandler.ServeHTTP(w, r)
+		case AdminOrganizationServiceRotateEncryptionKeyProcedure:
+			adminOrganizationServiceRotateEncryptionKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -343,6 +465,18 @@ func (UnimplementedAdminOrganizationServiceHandler) ListOrganizationProjects(con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminOrganizationService.ListOrganizationProjects is not implemented"))
 }
 
+func (UnimplementedAdminOrganizationServiceHandler) SetOrganizationQuota(context.Context, *connect.Request[v1.AdminSetOrganizationQuotaRequest]) (*connect.Response[v1.AdminSetOrganizationQuotaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminOrganizationService.SetOrganizationQuota is not implemented"))
+}
+
+func (UnimplementedAdminOrganizationServiceHandler) DeleteOrganizationQuota(context.Context, *connect.Request[v1.AdminDeleteOrganizationQuotaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminOrganizationService.DeleteOrganizationQuota is not implemented"))
+}
+
+func (UnimplementedAdminOrganizationServiceHandler) RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.Response[v1.RotateEncryptionKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminOrganizationService.RotateEncryptionKey is not implemented"))
+}
+
 // AdminSiteServiceClient is a client for the libops.v1.AdminSiteService service.
 type AdminSiteServiceClient interface {
 	// List sites (admin view)
@@ -365,6 +499,29 @@ type AdminSiteServiceClient interface {
 	GetSiteFirewall(context.Context, *connect.Request[v1.GetSiteFirewallRequest]) (*connect.Response[v1.GetSiteFirewallResponse], error)
 	// Site VM check-in (updates checkin_at timestamp)
 	SiteCheckIn(context.Context, *connect.Request[v1.SiteCheckInRequest]) (*connect.Response[v1.SiteCheckInResponse], error)
+	// Issue a client certificate from the LibOps controller CA for a site VM's
+	// controller to present over mutual TLS (called by VM controller with GSA
+	// auth). Issuing a certificate revokes the site's earlier ones.
+	IssueSiteClientCertificate(context.Context, *connect.Request[v1.IssueSiteClientCertificateRequest]) (*connect.Response[v1.IssueSiteClientCertificateResponse], error)
+	// Get the reverse proxy configuration for a site VM (called by VM controller with GSA auth)
+	GetSiteProxyConfig(context.Context, *connect.Request[v1.GetSiteProxyConfigRequest]) (*connect.Response[v1.GetSiteProxyConfigResponse], error)
+	// Record whether a site passed the TLS probe its controller runs after
+	// applying the site's proxy config
+	ReportSiteTlsProbe(context.Context, *connect.Request[v1.ReportSiteTlsProbeRequest]) (*connect.Response[v1.ReportSiteTlsProbeResponse], error)
+	// Get the databases a site VM runs on its MySQL server, with their
+	// passwords (called by VM controller with GSA auth)
+	GetSiteDatabases(context.Context, *connect.Request[v1.GetSiteDatabasesRequest]) (*connect.Response[v1.GetSiteDatabasesResponse], error)
+	// Record which of a site's co-located databases its controller provisioned
+	ReportSiteDatabases(context.Context, *connect.Request[v1.ReportSiteDatabasesRequest]) (*connect.Response[v1.ReportSiteDatabasesResponse], error)
+	// Get the add-ons a site VM runs, with their credentials (called by VM
+	// controller with GSA auth)
+	GetSiteAddons(context.Context, *connect.Request[v1.GetSiteAddonsRequest]) (*connect.Response[v1.GetSiteAddonsResponse], error)
+	// Record the health of a site's add-ons and where the site reaches them
+	ReportSiteAddons(context.Context, *connect.Request[v1.ReportSiteAddonsRequest]) (*connect.Response[v1.ReportSiteAddonsResponse], error)
+	// Get a site's config vars, the plaintext environment variables the VM
+	// controller writes next to its secrets, with the environment settings the
+	// site inherits (called by VM controller with GSA auth)
+	GetSiteConfigVars(context.Context, *connect.Request[v1.GetSiteConfigVarsRequest]) (*connect.Response[v1.GetSiteConfigVarsResponse], error)
 	// Sync site manifest - returns state hash and signed URLs to blobs (for eventual consistency)
 	// Called by site VMs every ~24h for eventual consistency
 	SyncManifest(context.Context, *connect.Request[v1.SyncManifestRequest]) (*connect.Response[v1.SyncManifestResponse], error)
@@ -449,6 +606,58 @@ func NewAdminSiteServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(adminSiteServiceMethods.ByName("SiteCheckIn")),
 			connect.WithClientOptions(opts...),
 		),
+		issueSiteClientCertificate: connect.NewClient[v1.IssueSiteClientCertificateRequest, v1.IssueSiteClientCertificateResponse](
+			httpClient,
+			baseURL+AdminSiteServiceIssueSiteClientCertificateProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("IssueSiteClientCertificate")),
+			connect.WithClientOptions(opts...),
+		),
+		getSiteProxyConfig: connect.NewClient[v1.GetSiteProxyConfigRequest, v1.GetSiteProxyConfigResponse](
+			httpClient,
+			baseURL+AdminSiteServiceGetSiteProxyConfigProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteProxyConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteTlsProbe: connect.NewClient[v1.ReportSiteTlsProbeRequest, v1.ReportSiteTlsProbeResponse](
+			httpClient,
+			baseURL+AdminSiteServiceReportSiteTlsProbeProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteTlsProbe")),
+			connect.WithClientOptions(opts...),
+		),
+		getSiteDatabases: connect.NewClient[v1.GetSiteDatabasesRequest, v1.GetSiteDatabasesResponse](
+			httpClient,
+			baseURL+AdminSiteServiceGetSiteDatabasesProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteDatabases")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteDatabases: connect.NewClient[v1.ReportSiteDatabasesRequest, v1.ReportSiteDatabasesResponse](
+			httpClient,
+			baseURL+AdminSiteServiceReportSiteDatabasesProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteDatabases")),
+			connect.WithClientOptions(opts...),
+		),
+		getSiteAddons: connect.NewClient[v1.GetSiteAddonsRequest, v1.GetSiteAddonsResponse](
+			httpClient,
+			baseURL+AdminSiteServiceGetSiteAddonsProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteAddons")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteAddons: connect.NewClient[v1.ReportSiteAddonsRequest, v1.ReportSiteAddonsResponse](
+			httpClient,
+			baseURL+AdminSiteServiceReportSiteAddonsProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteAddons")),
+			connect.WithClientOptions(opts...),
+		),
+		getSiteConfigVars: connect.NewClient[v1.GetSiteConfigVarsRequest, v1.GetSiteConfigVarsResponse](
+			httpClient,
+			baseURL+AdminSiteServiceGetSiteConfigVarsProcedure,
+			connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteConfigVars")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		syncManifest: connect.NewClient[v1.SyncManifestRequest, v1.SyncManifestResponse](
 			httpClient,
 			baseURL+AdminSiteServiceSyncManifestProcedure,
@@ -468,18 +677,26 @@ func NewAdminSiteServiceClient(httpClient connect.HTTPClient, baseURL string, op
 
 // adminSiteServiceClient implements AdminSiteServiceClient.
 type adminSiteServiceClient struct {
-	listSites       *connect.Client[v1.AdminListSitesRequest, v1.AdminListSitesResponse]
-	getSite         *connect.Client[v1.AdminGetSiteRequest, v1.AdminGetSiteResponse]
-	createSite      *connect.Client[v1.AdminCreateSiteRequest, v1.AdminCreateSiteResponse]
-	updateSite      *connect.Client[v1.AdminUpdateSiteRequest, v1.AdminUpdateSiteResponse]
-	deleteSite      *connect.Client[v1.AdminDeleteSiteRequest, emptypb.Empty]
-	listAllSites    *connect.Client[v1.AdminListAllSitesRequest, v1.AdminListAllSitesResponse]
-	getSiteSSHKeys  *connect.Client[v1.GetSiteSSHKeysRequest, v1.GetSiteSSHKeysResponse]
-	getSiteSecrets  *connect.Client[v1.GetSiteSecretsRequest, v1.GetSiteSecretsResponse]
-	getSiteFirewall *connect.Client[v1.GetSiteFirewallRequest, v1.GetSiteFirewallResponse]
-	siteCheckIn     *connect.Client[v1.SiteCheckInRequest, v1.SiteCheckInResponse]
-	syncManifest    *connect.Client[v1.SyncManifestRequest, v1.SyncManifestResponse]
-	getBlob         *connect.Client[v1.GetBlobRequest, v1.GetBlobResponse]
+	listSites                  *connect.Client[v1.AdminListSitesRequest, v1.AdminListSitesResponse]
+	getSite                    *connect.Client[v1.AdminGetSiteRequest, v1.AdminGetSiteResponse]
+	createSite                 *connect.Client[v1.AdminCreateSiteRequest, v1.AdminCreateSiteResponse]
+	updateSite                 *connect.Client[v1.AdminUpdateSiteRequest, v1.AdminUpdateSiteResponse]
+	deleteSite                 *connect.Client[v1.AdminDeleteSiteRequest, emptypb.Empty]
+	listAllSites               *connect.Client[v1.AdminListAllSitesRequest, v1.AdminListAllSitesResponse]
+	getSiteSSHKeys             *connect.Client[v1.GetSiteSSHKeysRequest, v1.GetSiteSSHKeysResponse]
+	getSiteSecrets             *connect.Client[v1.GetSiteSecretsRequest, v1.GetSiteSecretsResponse]
+	getSiteFirewall            *connect.Client[v1.GetSiteFirewallRequest, v1.GetSiteFirewallResponse]
+	siteCheckIn                *connect.Client[v1.SiteCheckInRequest, v1.SiteCheckInResponse]
+	issueSiteClientCertificate *connect.Client[v1.IssueSiteClientCertificateRequest, v1.IssueSiteClientCertificateResponse]
+	getSiteProxyConfig         *connect.Client[v1.GetSiteProxyConfigRequest, v1.GetSiteProxyConfigResponse]
+	reportSiteTlsProbe         *connect.Client[v1.ReportSiteTlsProbeRequest, v1.ReportSiteTlsProbeResponse]
+	getSiteDatabases           *connect.Client[v1.GetSiteDatabasesRequest, v1.GetSiteDatabasesResponse]
+	reportSiteDatabases        *connect.Client[v1.ReportSiteDatabasesRequest, v1.ReportSiteDatabasesResponse]
+	getSiteAddons              *connect.Client[v1.GetSiteAddonsRequest, v1.GetSiteAddonsResponse]
+	reportSiteAddons           *connect.Client[v1.ReportSiteAddonsRequest, v1.ReportSiteAddonsResponse]
+	getSiteConfigVars          *connect.Client[v1.GetSiteConfigVarsRequest, v1.GetSiteConfigVarsResponse]
+	syncManifest               *connect.Client[v1.SyncManifestRequest, v1.SyncManifestResponse]
+	getBlob                    *connect.Client[v1.GetBlobRequest, v1.GetBlobResponse]
 }
 
 // ListSites calls libops.v1.AdminSiteService.ListSites.
@@ -532,6 +749,46 @@ func (c *adminSiteServiceClient) SiteCheckIn(ctx context.Context, req *connect.R
 	return c.siteCheckIn.CallUnary(ctx, req)
 }
 
+// IssueSiteClientCertificate calls libops.v1.AdminSiteService.IssueSiteClientCertificate.
+func (c *adminSiteServiceClient) IssueSiteClientCertificate(ctx context.Context, req *connect.Request[v1.IssueSiteClientCertificateRequest]) (*connect.Response[v1.IssueSiteClientCertificateResponse], error) {
+	return c.issueSiteClientCertificate.CallUnary(ctx, req)
+}
+
+// GetSiteProxyConfig calls libops.v1.AdminSiteService.GetSiteProxyConfig.
+func (c *adminSiteServiceClient) GetSiteProxyConfig(ctx context.Context, req *connect.Request[v1.GetSiteProxyConfigRequest]) (*connect.Response[v1.GetSiteProxyConfigResponse], error) {
+	return c.getSiteProxyConfig.CallUnary(ctx, req)
+}
+
+// ReportSiteTlsProbe calls libops.v1.AdminSiteService.ReportSiteTlsProbe.
+func (c *adminSiteServiceClient) ReportSiteTlsProbe(ctx context.Context, req *connect.Request[v1.ReportSiteTlsProbeRequest]) (*connect.Response[v1.ReportSiteTlsProbeResponse], error) {
+	return c.reportSiteTlsProbe.CallUnary(ctx, req)
+}
+
+// GetSiteDatabases calls libops.v1.AdminSiteService.GetSiteDatabases.
+func (c *adminSiteServiceClient) GetSiteDatabases(ctx context.Context, req *connect.Request[v1.GetSiteDatabasesRequest]) (*connect.Response[v1.GetSiteDatabasesResponse], error) {
+	return c.getSiteDatabases.CallUnary(ctx, req)
+}
+
+// ReportSiteDatabases calls libops.v1.AdminSiteService.ReportSiteDatabases.
+func (c *adminSiteServiceClient) ReportSiteDatabases(ctx context.Context, req *connect.Request[v1.ReportSiteDatabasesRequest]) (*connect.Response[v1.ReportSiteDatabasesResponse], error) {
+	return c.reportSiteDatabases.CallUnary(ctx, req)
+}
+
+// GetSiteAddons calls libops.v1.AdminSiteService.GetSiteAddons.
+func (c *adminSiteServiceClient) GetSiteAddons(ctx context.Context, req *connect.Request[v1.GetSiteAddonsRequest]) (*connect.Response[v1.GetSiteAddonsResponse], error) {
+	return c.getSiteAddons.CallUnary(ctx, req)
+}
+
+// ReportSiteAddons calls libops.v1.AdminSiteService.ReportSiteAddons.
+func (c *adminSiteServiceClient) ReportSiteAddons(ctx context.Context, req *connect.Request[v1.ReportSiteAddonsRequest]) (*connect.Response[v1.ReportSiteAddonsResponse], error) {
+	return c.reportSiteAddons.CallUnary(ctx, req)
+}
+
+// GetSiteConfigVars calls libops.v1.AdminSiteService.GetSiteConfigVars.
+func (c *adminSiteServiceClient) GetSiteConfigVars(ctx context.Context, req *connect.Request[v1.GetSiteConfigVarsRequest]) (*connect.Response[v1.GetSiteConfigVarsResponse], error) {
+	return c.getSiteConfigVars.CallUnary(ctx, req)
+}
+
 // SyncManifest calls libops.v1.AdminSiteService.SyncManifest.
 func (c *adminSiteServiceClient) SyncManifest(ctx context.Context, req *connect.Request[v1.SyncManifestRequest]) (*connect.Response[v1.SyncManifestResponse], error) {
 	return c.syncManifest.CallUnary(ctx, req)
@@ -564,6 +821,29 @@ type AdminSiteServiceHandler interface {
 	GetSiteFirewall(context.Context, *connect.Request[v1.GetSiteFirewallRequest]) (*connect.Response[v1.GetSiteFirewallResponse], error)
 	// Site VM check-in (updates checkin_at timestamp)
 	SiteCheckIn(context.Context, *connect.Request[v1.SiteCheckInRequest]) (*connect.Response[v1.SiteCheckInResponse], error)
+	// Issue a client certificate from the LibOps controller CA for a site VM's
+	// controller to present over mutual TLS (called by VM controller with GSA
+	// auth). Issuing a certificate revokes the site's earlier ones.
+	IssueSiteClientCertificate(context.Context, *connect.Request[v1.IssueSiteClientCertificateRequest]) (*connect.Response[v1.IssueSiteClientCertificateResponse], error)
+	// Get the reverse proxy configuration for a site VM (called by VM controller with GSA auth)
+	GetSiteProxyConfig(context.Context, *connect.Request[v1.GetSiteProxyConfigRequest]) (*connect.Response[v1.GetSiteProxyConfigResponse], error)
+	// Record whether a site passed the TLS probe its controller runs after
+	// applying the site's proxy config
+	ReportSiteTlsProbe(context.Context, *connect.Request[v1.ReportSiteTlsProbeRequest]) (*connect.Response[v1.ReportSiteTlsProbeResponse], error)
+	// Get the databases a site VM runs on its MySQL server, with their
+	// passwords (called by VM controller with GSA auth)
+	GetSiteDatabases(context.Context, *connect.Request[v1.GetSiteDatabasesRequest]) (*connect.Response[v1.GetSiteDatabasesResponse], error)
+	// Record which of a site's co-located databases its controller provisioned
+	ReportSiteDatabases(context.Context, *connect.Request[v1.ReportSiteDatabasesRequest]) (*connect.Response[v1.ReportSiteDatabasesResponse], error)
+	// Get the add-ons a site VM runs, with their credentials (called by VM
+	// controller with GSA auth)
+	GetSiteAddons(context.Context, *connect.Request[v1.GetSiteAddonsRequest]) (*connect.Response[v1.GetSiteAddonsResponse], error)
+	// Record the health of a site's add-ons and where the site reaches them
+	ReportSiteAddons(context.Context, *connect.Request[v1.ReportSiteAddonsRequest]) (*connect.Response[v1.ReportSiteAddonsResponse], error)
+	// Get a site's config vars, the plaintext environment variables the VM
+	// controller writes next to its secrets, with the environment settings the
+	// site inherits (called by VM controller with GSA auth)
+	GetSiteConfigVars(context.Context, *connect.Request[v1.GetSiteConfigVarsRequest]) (*connect.Response[v1.GetSiteConfigVarsResponse], error)
 	// Sync site manifest - returns state hash and signed URLs to blobs (for eventual consistency)
 	// Called by site VMs every ~24h for eventual consistency
 	SyncManifest(context.Context, *connect.Request[v1.SyncManifestRequest]) (*connect.Response[v1.SyncManifestResponse], error)
@@ -644,6 +924,58 @@ func NewAdminSiteServiceHandler(svc AdminSiteServiceHandler, opts ...connect.Han
 		connect.WithSchema(adminSiteServiceMethods.ByName("SiteCheckIn")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminSiteServiceIssueSiteClientCertificateHandler := connect.NewUnaryHandler(
+		AdminSiteServiceIssueSiteClientCertificateProcedure,
+		svc.IssueSiteClientCertificate,
+		connect.WithSchema(adminSiteServiceMethods.ByName("IssueSiteClientCertificate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceGetSiteProxyConfigHandler := connect.NewUnaryHandler(
+		AdminSiteServiceGetSiteProxyConfigProcedure,
+		svc.GetSiteProxyConfig,
+		connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteProxyConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceReportSiteTlsProbeHandler := connect.NewUnaryHandler(
+		AdminSiteServiceReportSiteTlsProbeProcedure,
+		svc.ReportSiteTlsProbe,
+		connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteTlsProbe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceGetSiteDatabasesHandler := connect.NewUnaryHandler(
+		AdminSiteServiceGetSiteDatabasesProcedure,
+		svc.GetSiteDatabases,
+		connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteDatabases")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceReportSiteDatabasesHandler := connect.NewUnaryHandler(
+		AdminSiteServiceReportSiteDatabasesProcedure,
+		svc.ReportSiteDatabases,
+		connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteDatabases")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceGetSiteAddonsHandler := connect.NewUnaryHandler(
+		AdminSiteServiceGetSiteAddonsProcedure,
+		svc.GetSiteAddons,
+		connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteAddons")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceReportSiteAddonsHandler := connect.NewUnaryHandler(
+		AdminSiteServiceReportSiteAddonsProcedure,
+		svc.ReportSiteAddons,
+		connect.WithSchema(adminSiteServiceMethods.ByName("ReportSiteAddons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminSiteServiceGetSiteConfigVarsHandler := connect.NewUnaryHandler(
+		AdminSiteServiceGetSiteConfigVarsProcedure,
+		svc.GetSiteConfigVars,
+		connect.WithSchema(adminSiteServiceMethods.ByName("GetSiteConfigVars")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminSiteServiceSyncManifestHandler := connect.NewUnaryHandler(
 		AdminSiteServiceSyncManifestProcedure,
 		svc.SyncManifest,
@@ -680,6 +1012,22 @@ func NewAdminSiteServiceHandler(svc AdminSiteServiceHandler, opts ...connect.Han
 			adminSiteServiceGetSiteFirewallHandler.ServeHTTP(w, r)
 		case AdminSiteServiceSiteCheckInProcedure:
 			adminSiteServiceSiteCheckInHandler.ServeHTTP(w, r)
+		case AdminSiteServiceIssueSiteClientCertificateProcedure:
+			adminSiteServiceIssueSiteClientCertificateHandler.ServeHTTP(w, r)
+		case AdminSiteServiceGetSiteProxyConfigProcedure:
+			adminSiteServiceGetSiteProxyConfigHandler.ServeHTTP(w, r)
+		case AdminSiteServiceReportSiteTlsProbeProcedure:
+			adminSiteServiceReportSiteTlsProbeHandler.ServeHTTP(w, r)
+		case AdminSiteServiceGetSiteDatabasesProcedure:
+			adminSiteServiceGetSiteDatabasesHandler.ServeHTTP(w, r)
+		case AdminSiteServiceReportSiteDatabasesProcedure:
+			adminSiteServiceReportSiteDatabasesHandler.ServeHTTP(w, r)
+		case AdminSiteServiceGetSiteAddonsProcedure:
+			adminSiteServiceGetSiteAddonsHandler.ServeHTTP(w, r)
+		case AdminSiteServiceReportSiteAddonsProcedure:
+			adminSiteServiceReportSiteAddonsHandler.ServeHTTP(w, r)
+		case AdminSiteServiceGetSiteConfigVarsProcedure:
+			adminSiteServiceGetSiteConfigVarsHandler.ServeHTTP(w, r)
 		case AdminSiteServiceSyncManifestProcedure:
 			adminSiteServiceSyncManifestHandler.ServeHTTP(w, r)
 		case AdminSiteServiceGetBlobProcedure:
@@ -733,6 +1081,38 @@ func (UnimplementedAdminSiteServiceHandler) SiteCheckIn(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.SiteCheckIn is not implemented"))
 }
 
+func (UnimplementedAdminSiteServiceHandler) IssueSiteClientCertificate(context.Context, *connect.Request[v1.IssueSiteClientCertificateRequest]) (*connect.Response[v1.IssueSiteClientCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.IssueSiteClientCertificate is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) GetSiteProxyConfig(context.Context, *connect.Request[v1.GetSiteProxyConfigRequest]) (*connect.Response[v1.GetSiteProxyConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.GetSiteProxyConfig is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) ReportSiteTlsProbe(context.Context, *connect.Request[v1.ReportSiteTlsProbeRequest]) (*connect.Response[v1.ReportSiteTlsProbeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.ReportSiteTlsProbe is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) GetSiteDatabases(context.Context, *connect.Request[v1.GetSiteDatabasesRequest]) (*connect.Response[v1.GetSiteDatabasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.GetSiteDatabases is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) ReportSiteDatabases(context.Context, *connect.Request[v1.ReportSiteDatabasesRequest]) (*connect.Response[v1.ReportSiteDatabasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.ReportSiteDatabases is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) GetSiteAddons(context.Context, *connect.Request[v1.GetSiteAddonsRequest]) (*connect.Response[v1.GetSiteAddonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.GetSiteAddons is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) ReportSiteAddons(context.Context, *connect.Request[v1.ReportSiteAddonsRequest]) (*connect.Response[v1.ReportSiteAddonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.ReportSiteAddons is not implemented"))
+}
+
+func (UnimplementedAdminSiteServiceHandler) GetSiteConfigVars(context.Context, *connect.Request[v1.GetSiteConfigVarsRequest]) (*connect.Response[v1.GetSiteConfigVarsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.GetSiteConfigVars is not implemented"))
+}
+
 func (UnimplementedAdminSiteServiceHandler) SyncManifest(context.Context, *connect.Request[v1.SyncManifestRequest]) (*connect.Response[v1.SyncManifestResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminSiteService.SyncManifest is not implemented"))
 }
@@ -968,6 +1348,18 @@ type AdminReconciliationServiceClient interface {
 	UpdateReconciliationStatus(context.Context, *connect.Request[v1.UpdateReconciliationStatusRequest]) (*connect.Response[v1.UpdateReconciliationStatusResponse], error)
 	// Generate terraform variables JSON from database state
 	GenerateTerraformVars(context.Context, *connect.Request[v1.GenerateTerraformVarsRequest]) (*connect.Response[v1.GenerateTerraformVarsResponse], error)
+	// Find the active Private Service Connect endpoint for an organization or
+	// site, so terraform runners and site controllers reach the API privately
+	ResolvePrivateServiceConnectEndpoint(context.Context, *connect.Request[v1.ResolvePrivateServiceConnectEndpointRequest]) (*connect.Response[v1.ResolvePrivateServiceConnectEndpointResponse], error)
+	// Record the Private Service Connect endpoints terraform applied for an organization
+	ReportPrivateServiceConnectEndpoints(context.Context, *connect.Request[v1.ReportPrivateServiceConnectEndpointsRequest]) (*connect.Response[v1.ReportPrivateServiceConnectEndpointsResponse], error)
+	// Record the static egress IP terraform reserved for a site, or that it has none
+	ReportSiteStaticEgressIp(context.Context, *connect.Request[v1.ReportSiteStaticEgressIpRequest]) (*connect.Response[v1.ReportSiteStaticEgressIpResponse], error)
+	// Record the CDN load balancer terraform built for a site, or that it has
+	// none, and the cache purges it ran
+	ReportSiteCdn(context.Context, *connect.Request[v1.ReportSiteCdnRequest]) (*connect.Response[v1.ReportSiteCdnResponse], error)
+	// Record the Cloud SQL instance terraform built for a site's databases
+	ReportSiteDatabaseInstance(context.Context, *connect.Request[v1.ReportSiteDatabaseInstanceRequest]) (*connect.Response[v1.ReportSiteDatabaseInstanceResponse], error)
 }
 
 // NewAdminReconciliationServiceClient constructs a client for the
@@ -1001,14 +1393,50 @@ func NewAdminReconciliationServiceClient(httpClient connect.HTTPClient, baseURL
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		resolvePrivateServiceConnectEndpoint: connect.NewClient[v1.ResolvePrivateServiceConnectEndpointRequest, v1.ResolvePrivateServiceConnectEndpointResponse](
+			httpClient,
+			baseURL+AdminReconciliationServiceResolvePrivateServiceConnectEndpointProcedure,
+			connect.WithSchema(adminReconciliationServiceMethods.ByName("ResolvePrivateServiceConnectEndpoint")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reportPrivateServiceConnectEndpoints: connect.NewClient[v1.ReportPrivateServiceConnectEndpointsRequest, v1.ReportPrivateServiceConnectEndpointsResponse](
+			httpClient,
+			baseURL+AdminReconciliationServiceReportPrivateServiceConnectEndpointsProcedure,
+			connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportPrivateServiceConnectEndpoints")),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteStaticEgressIp: connect.NewClient[v1.ReportSiteStaticEgressIpRequest, v1.ReportSiteStaticEgressIpResponse](
+			httpClient,
+			baseURL+AdminReconciliationServiceReportSiteStaticEgressIpProcedure,
+			connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteStaticEgressIp")),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteCdn: connect.NewClient[v1.ReportSiteCdnRequest, v1.ReportSiteCdnResponse](
+			httpClient,
+			baseURL+AdminReconciliationServiceReportSiteCdnProcedure,
+			connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteCdn")),
+			connect.WithClientOptions(opts...),
+		),
+		reportSiteDatabaseInstance: connect.NewClient[v1.ReportSiteDatabaseInstanceRequest, v1.ReportSiteDatabaseInstanceResponse](
+			httpClient,
+			baseURL+AdminReconciliationServiceReportSiteDatabaseInstanceProcedure,
+			connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteDatabaseInstance")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminReconciliationServiceClient implements AdminReconciliationServiceClient.
 type adminReconciliationServiceClient struct {
-	getReconciliationRun       *connect.Client[v1.GetReconciliationRunRequest, v1.GetReconciliationRunResponse]
-	updateReconciliationStatus *connect.Client[v1.UpdateReconciliationStatusRequest, v1.UpdateReconciliationStatusResponse]
-	generateTerraformVars      *connect.Client[v1.GenerateTerraformVarsRequest, v1.GenerateTerraformVarsResponse]
+	getReconciliationRun                 *connect.Client[v1.GetReconciliationRunRequest, v1.GetReconciliationRunResponse]
+	updateReconciliationStatus           *connect.Client[v1.UpdateReconciliationStatusRequest, v1.UpdateReconciliationStatusResponse]
+	generateTerraformVars                *connect.Client[v1.GenerateTerraformVarsRequest, v1.GenerateTerraformVarsResponse]
+	resolvePrivateServiceConnectEndpoint *connect.Client[v1.ResolvePrivateServiceConnectEndpointRequest, v1.ResolvePrivateServiceConnectEndpointResponse]
+	reportPrivateServiceConnectEndpoints *connect.Client[v1.ReportPrivateServiceConnectEndpointsRequest, v1.ReportPrivateServiceConnectEndpointsResponse]
+	reportSiteStaticEgressIp             *connect.Client[v1.ReportSiteStaticEgressIpRequest, v1.ReportSiteStaticEgressIpResponse]
+	reportSiteCdn                        *connect.Client[v1.ReportSiteCdnRequest, v1.ReportSiteCdnResponse]
+	reportSiteDatabaseInstance           *connect.Client[v1.ReportSiteDatabaseInstanceRequest, v1.ReportSiteDatabaseInstanceResponse]
 }
 
 // GetReconciliationRun calls libops.v1.AdminReconciliationService.GetReconciliationRun.
@@ -1026,6 +1454,33 @@ func (c *adminReconciliationServiceClient) GenerateTerraformVars(ctx context.Con
 	return c.generateTerraformVars.CallUnary(ctx, req)
 }
 
+// ResolvePrivateServiceConnectEndpoint calls
+// libops.v1.AdminReconciliationService.ResolvePrivateServiceConnectEndpoint.
+func (c *adminReconciliationServiceClient) ResolvePrivateServiceConnectEndpoint(ctx context.Context, req *connect.Request[v1.ResolvePrivateServiceConnectEndpointRequest]) (*connect.Response[v1.ResolvePrivateServiceConnectEndpointResponse], error) {
+	return c.resolvePrivateServiceConnectEndpoint.CallUnary(ctx, req)
+}
+
+// ReportPrivateServiceConnectEndpoints calls
+// libops.v1.AdminReconciliationService.ReportPrivateServiceConnectEndpoints.
+func (c *adminReconciliationServiceClient) ReportPrivateServiceConnectEndpoints(ctx context.Context, req *connect.Request[v1.ReportPrivateServiceConnectEndpointsRequest]) (*connect.Response[v1.ReportPrivateServiceConnectEndpointsResponse], error) {
+	return c.reportPrivateServiceConnectEndpoints.CallUnary(ctx, req)
+}
+
+// ReportSiteStaticEgressIp calls libops.v1.AdminReconciliationService.ReportSiteStaticEgressIp.
+func (c *adminReconciliationServiceClient) ReportSiteStaticEgressIp(ctx context.Context, req *connect.Request[v1.ReportSiteStaticEgressIpRequest]) (*connect.Response[v1.ReportSiteStaticEgressIpResponse], error) {
+	return c.reportSiteStaticEgressIp.CallUnary(ctx, req)
+}
+
+// ReportSiteCdn calls libops.v1.AdminReconciliationService.ReportSiteCdn.
+func (c *adminReconciliationServiceClient) ReportSiteCdn(ctx context.Context, req *connect.Request[v1.ReportSiteCdnRequest]) (*connect.Response[v1.ReportSiteCdnResponse], error) {
+	return c.reportSiteCdn.CallUnary(ctx, req)
+}
+
+// ReportSiteDatabaseInstance calls libops.v1.AdminReconciliationService.ReportSiteDatabaseInstance.
+func (c *adminReconciliationServiceClient) ReportSiteDatabaseInstance(ctx context.Context, req *connect.Request[v1.ReportSiteDatabaseInstanceRequest]) (*connect.Response[v1.ReportSiteDatabaseInstanceResponse], error) {
+	return c.reportSiteDatabaseInstance.CallUnary(ctx, req)
+}
+
 // AdminReconciliationServiceHandler is an implementation of the
 // libops.v1.AdminReconciliationService service.
 type AdminReconciliationServiceHandler interface {
@@ -1035,6 +1490,18 @@ type AdminReconciliationServiceHandler interface {
 	UpdateReconciliationStatus(context.Context, *connect.Request[v1.UpdateReconciliationStatusRequest]) (*connect.Response[v1.UpdateReconciliationStatusResponse], error)
 	// Generate terraform variables JSON from database state
 	GenerateTerraformVars(context.Context, *connect.Request[v1.GenerateTerraformVarsRequest]) (*connect.Response[v1.GenerateTerraformVarsResponse], error)
+	// Find the active Private Service Connect endpoint for an organization or
+	// site, so terraform runners and site controllers reach the API privately
+	ResolvePrivateServiceConnectEndpoint(context.Context, *connect.Request[v1.ResolvePrivateServiceConnectEndpointRequest]) (*connect.Response[v1.ResolvePrivateServiceConnectEndpointResponse], error)
+	// Record the Private Service Connect endpoints terraform applied for an organization
+	ReportPrivateServiceConnectEndpoints(context.Context, *connect.Request[v1.ReportPrivateServiceConnectEndpointsRequest]) (*connect.Response[v1.ReportPrivateServiceConnectEndpointsResponse], error)
+	// Record the static egress IP terraform reserved for a site, or that it has none
+	ReportSiteStaticEgressIp(context.Context, *connect.Request[v1.ReportSiteStaticEgressIpRequest]) (*connect.Response[v1.ReportSiteStaticEgressIpResponse], error)
+	// Record the CDN load balancer terraform built for a site, or that it has
+	// none, and the cache purges it ran
+	ReportSiteCdn(context.Context, *connect.Request[v1.ReportSiteCdnRequest]) (*connect.Response[v1.ReportSiteCdnResponse], error)
+	// Record the Cloud SQL instance terraform built for a site's databases
+	ReportSiteDatabaseInstance(context.Context, *connect.Request[v1.ReportSiteDatabaseInstanceRequest]) (*connect.Response[v1.ReportSiteDatabaseInstanceResponse], error)
 }
 
 // NewAdminReconciliationServiceHandler builds an HTTP handler from the service implementation. It
@@ -1064,6 +1531,37 @@ func NewAdminReconciliationServiceHandler(svc AdminReconciliationServiceHandler,
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminReconciliationServiceResolvePrivateServiceConnectEndpointHandler := connect.NewUnaryHandler(
+		AdminReconciliationServiceResolvePrivateServiceConnectEndpointProcedure,
+		svc.ResolvePrivateServiceConnectEndpoint,
+		connect.WithSchema(adminReconciliationServiceMethods.ByName("ResolvePrivateServiceConnectEndpoint")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminReconciliationServiceReportPrivateServiceConnectEndpointsHandler := connect.NewUnaryHandler(
+		AdminReconciliationServiceReportPrivateServiceConnectEndpointsProcedure,
+		svc.ReportPrivateServiceConnectEndpoints,
+		connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportPrivateServiceConnectEndpoints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminReconciliationServiceReportSiteStaticEgressIpHandler := connect.NewUnaryHandler(
+		AdminReconciliationServiceReportSiteStaticEgressIpProcedure,
+		svc.ReportSiteStaticEgressIp,
+		connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteStaticEgressIp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminReconciliationServiceReportSiteCdnHandler := connect.NewUnaryHandler(
+		AdminReconciliationServiceReportSiteCdnProcedure,
+		svc.ReportSiteCdn,
+		connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteCdn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminReconciliationServiceReportSiteDatabaseInstanceHandler := connect.NewUnaryHandler(
+		AdminReconciliationServiceReportSiteDatabaseInstanceProcedure,
+		svc.ReportSiteDatabaseInstance,
+		connect.WithSchema(adminReconciliationServiceMethods.ByName("ReportSiteDatabaseInstance")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.AdminReconciliationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminReconciliationServiceGetReconciliationRunProcedure:
@@ -1072,6 +1570,16 @@ func NewAdminReconciliationServiceHandler(svc AdminReconciliationServiceHandler,
 			adminReconciliationServiceUpdateReconciliationStatusHandler.ServeHTTP(w, r)
 		case AdminReconciliationServiceGenerateTerraformVarsProcedure:
 			adminReconciliationServiceGenerateTerraformVarsHandler.ServeHTTP(w, r)
+		case AdminReconciliationServiceResolvePrivateServiceConnectEndpointProcedure:
+			adminReconciliationServiceResolvePrivateServiceConnectEndpointHandler.ServeHTTP(w, r)
+		case AdminReconciliationServiceReportPrivateServiceConnectEndpointsProcedure:
+			adminReconciliationServiceReportPrivateServiceConnectEndpointsHandler.ServeHTTP(w, r)
+		case AdminReconciliationServiceReportSiteStaticEgressIpProcedure:
+			adminReconciliationServiceReportSiteStaticEgressIpHandler.ServeHTTP(w, r)
+		case AdminReconciliationServiceReportSiteCdnProcedure:
+			adminReconciliationServiceReportSiteCdnHandler.ServeHTTP(w, r)
+		case AdminReconciliationServiceReportSiteDatabaseInstanceProcedure:
+			adminReconciliationServiceReportSiteDatabaseInstanceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1092,3 +1600,23 @@ func (UnimplementedAdminReconciliationServiceHandler) UpdateReconciliationStatus
 func (UnimplementedAdminReconciliationServiceHandler) GenerateTerraformVars(context.Context, *connect.Request[v1.GenerateTerraformVarsRequest]) (*connect.Response[v1.GenerateTerraformVarsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.GenerateTerraformVars is not implemented"))
 }
+
+func (UnimplementedAdminReconciliationServiceHandler) ResolvePrivateServiceConnectEndpoint(context.Context, *connect.Request[v1.ResolvePrivateServiceConnectEndpointRequest]) (*connect.Response[v1.ResolvePrivateServiceConnectEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.ResolvePrivateServiceConnectEndpoint is not implemented"))
+}
+
+func (UnimplementedAdminReconciliationServiceHandler) ReportPrivateServiceConnectEndpoints(context.Context, *connect.Request[v1.ReportPrivateServiceConnectEndpointsRequest]) (*connect.Response[v1.ReportPrivateServiceConnectEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.ReportPrivateServiceConnectEndpoints is not implemented"))
+}
+
+func (UnimplementedAdminReconciliationServiceHandler) ReportSiteStaticEgressIp(context.Context, *connect.Request[v1.ReportSiteStaticEgressIpRequest]) (*connect.Response[v1.ReportSiteStaticEgressIpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.ReportSiteStaticEgressIp is not implemented"))
+}
+
+func (UnimplementedAdminReconciliationServiceHandler) ReportSiteCdn(context.Context, *connect.Request[v1.ReportSiteCdnRequest]) (*connect.Response[v1.ReportSiteCdnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.ReportSiteCdn is not implemented"))
+}
+
+func (UnimplementedAdminReconciliationServiceHandler) ReportSiteDatabaseInstance(context.Context, *connect.Request[v1.ReportSiteDatabaseInstanceRequest]) (*connect.Response[v1.ReportSiteDatabaseInstanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AdminReconciliationService.ReportSiteDatabaseInstance is not implemented"))
+}
@@ -42,9 +42,30 @@ const (
 	// AccountServiceListApiKeysProcedure is the fully-qualified name of the AccountService's
 	// ListApiKeys RPC.
 	AccountServiceListApiKeysProcedure = "/libops.v1.AccountService/ListApiKeys"
+	// AccountServiceListApiKeyTemplatesProcedure is the fully-qualified name of the AccountService's
+	// ListApiKeyTemplates RPC.
+	AccountServiceListApiKeyTemplatesProcedure = "/libops.v1.AccountService/ListApiKeyTemplates"
 	// AccountServiceRevokeApiKeyProcedure is the fully-qualified name of the AccountService's
 	// RevokeApiKey RPC.
 	AccountServiceRevokeApiKeyProcedure = "/libops.v1.AccountService/RevokeApiKey"
+	// AccountServiceGetAccountPreferencesProcedure is the fully-qualified name of the AccountService's
+	// GetAccountPreferences RPC.
+	AccountServiceGetAccountPreferencesProcedure = "/libops.v1.AccountService/GetAccountPreferences"
+	// AccountServiceUpdateAccountPreferencesProcedure is the fully-qualified name of the
+	// AccountService's UpdateAccountPreferences RPC.
+	AccountServiceUpdateAccountPreferencesProcedure = "/libops.v1.AccountService/UpdateAccountPreferences"
+	// AccountServiceGetAccountProfileProcedure is the fully-qualified name of the AccountService's
+	// GetAccountProfile RPC.
+	AccountServiceGetAccountProfileProcedure = "/libops.v1.AccountService/GetAccountProfile"
+	// AccountServiceUpdateAccountProfileProcedure is the fully-qualified name of the AccountService's
+	// UpdateAccountProfile RPC.
+	AccountServiceUpdateAccountProfileProcedure = "/libops.v1.AccountService/UpdateAccountProfile"
+	// AccountServiceUploadAccountAvatarProcedure is the fully-qualified name of the AccountService's
+	// UploadAccountAvatar RPC.
+	AccountServiceUploadAccountAvatarProcedure = "/libops.v1.AccountService/UploadAccountAvatar"
+	// AccountServiceDeleteAccountAvatarProcedure is the fully-qualified name of the AccountService's
+	// DeleteAccountAvatar RPC.
+	AccountServiceDeleteAccountAvatarProcedure = "/libops.v1.AccountService/DeleteAccountAvatar"
 )
 
 // AccountServiceClient is a client for the libops.v1.AccountService service.
@@ -55,8 +76,22 @@ type AccountServiceClient interface {
 	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
 	// List API keys for the authenticated user
 	ListApiKeys(context.Context, *connect.Request[v1.ListApiKeysRequest]) (*connect.Response[v1.ListApiKeysResponse], error)
+	// List the templates API keys can be created from
+	ListApiKeyTemplates(context.Context, *connect.Request[v1.ListApiKeyTemplatesRequest]) (*connect.Response[v1.ListApiKeyTemplatesResponse], error)
 	// Revoke an API key for the authenticated user
 	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error)
+	// Get the authenticated user's dashboard preferences
+	GetAccountPreferences(context.Context, *connect.Request[v1.GetAccountPreferencesRequest]) (*connect.Response[v1.GetAccountPreferencesResponse], error)
+	// Update the authenticated user's dashboard preferences
+	UpdateAccountPreferences(context.Context, *connect.Request[v1.UpdateAccountPreferencesRequest]) (*connect.Response[v1.UpdateAccountPreferencesResponse], error)
+	// Get the authenticated user's profile: how they're shown to other members
+	GetAccountProfile(context.Context, *connect.Request[v1.GetAccountProfileRequest]) (*connect.Response[v1.GetAccountProfileResponse], error)
+	// Update the authenticated user's name, time zone or contact details
+	UpdateAccountProfile(context.Context, *connect.Request[v1.UpdateAccountProfileRequest]) (*connect.Response[v1.UpdateAccountProfileResponse], error)
+	// Replace the authenticated user's avatar
+	UploadAccountAvatar(context.Context, *connect.Request[v1.UploadAccountAvatarRequest]) (*connect.Response[v1.UploadAccountAvatarResponse], error)
+	// Remove the authenticated user's avatar
+	DeleteAccountAvatar(context.Context, *connect.Request[v1.DeleteAccountAvatarRequest]) (*connect.Response[v1.DeleteAccountAvatarResponse], error)
 }
 
 // NewAccountServiceClient constructs a client for the libops.v1.AccountService service. By default,
@@ -90,21 +125,73 @@ func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		listApiKeyTemplates: connect.NewClient[v1.ListApiKeyTemplatesRequest, v1.ListApiKeyTemplatesResponse](
+			httpClient,
+			baseURL+AccountServiceListApiKeyTemplatesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListApiKeyTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		revokeApiKey: connect.NewClient[v1.RevokeApiKeyRequest, v1.RevokeApiKeyResponse](
 			httpClient,
 			baseURL+AccountServiceRevokeApiKeyProcedure,
 			connect.WithSchema(accountServiceMethods.ByName("RevokeApiKey")),
 			connect.WithClientOptions(opts...),
 		),
+		getAccountPreferences: connect.NewClient[v1.GetAccountPreferencesRequest, v1.GetAccountPreferencesResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountPreferencesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountPreferences")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateAccountPreferences: connect.NewClient[v1.UpdateAccountPreferencesRequest, v1.UpdateAccountPreferencesResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateAccountPreferencesProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateAccountPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountProfile: connect.NewClient[v1.GetAccountProfileRequest, v1.GetAccountProfileResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountProfileProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateAccountProfile: connect.NewClient[v1.UpdateAccountProfileRequest, v1.UpdateAccountProfileResponse](
+			httpClient,
+			baseURL+AccountServiceUpdateAccountProfileProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UpdateAccountProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadAccountAvatar: connect.NewClient[v1.UploadAccountAvatarRequest, v1.UploadAccountAvatarResponse](
+			httpClient,
+			baseURL+AccountServiceUploadAccountAvatarProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("UploadAccountAvatar")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccountAvatar: connect.NewClient[v1.DeleteAccountAvatarRequest, v1.DeleteAccountAvatarResponse](
+			httpClient,
+			baseURL+AccountServiceDeleteAccountAvatarProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("DeleteAccountAvatar")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // accountServiceClient implements AccountServiceClient.
 type accountServiceClient struct {
-	getAccountByEmail *connect.Client[v1.GetAccountByEmailRequest, v1.GetAccountByEmailResponse]
-	createApiKey      *connect.Client[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse]
-	listApiKeys       *connect.Client[v1.ListApiKeysRequest, v1.ListApiKeysResponse]
-	revokeApiKey      *connect.Client[v1.RevokeApiKeyRequest, v1.RevokeApiKeyResponse]
+	getAccountByEmail        *connect.Client[v1.GetAccountByEmailRequest, v1.GetAccountByEmailResponse]
+	createApiKey             *connect.Client[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse]
+	listApiKeys              *connect.Client[v1.ListApiKeysRequest, v1.ListApiKeysResponse]
+	listApiKeyTemplates      *connect.Client[v1.ListApiKeyTemplatesRequest, v1.ListApiKeyTemplatesResponse]
+	revokeApiKey             *connect.Client[v1.RevokeApiKeyRequest, v1.RevokeApiKeyResponse]
+	getAccountPreferences    *connect.Client[v1.GetAccountPreferencesRequest, v1.GetAccountPreferencesResponse]
+	updateAccountPreferences *connect.Client[v1.UpdateAccountPreferencesRequest, v1.UpdateAccountPreferencesResponse]
+	getAccountProfile        *connect.Client[v1.GetAccountProfileRequest, v1.GetAccountProfileResponse]
+	updateAccountProfile     *connect.Client[v1.UpdateAccountProfileRequest, v1.UpdateAccountProfileResponse]
+	uploadAccountAvatar      *connect.Client[v1.UploadAccountAvatarRequest, v1.UploadAccountAvatarResponse]
+	deleteAccountAvatar      *connect.Client[v1.DeleteAccountAvatarRequest, v1.DeleteAccountAvatarResponse]
 }
 
 // GetAccountByEmail calls libops.v1.AccountService.GetAccountByEmail.
@@ -122,11 +209,46 @@ func (c *accountServiceClient) ListApiKeys(ctx context.Context, req *connect.Req
 	return c.listApiKeys.CallUnary(ctx, req)
 }
 
+// ListApiKeyTemplates calls libops.v1.AccountService.ListApiKeyTemplates.
+func (c *accountServiceClient) ListApiKeyTemplates(ctx context.Context, req *connect.Request[v1.ListApiKeyTemplatesRequest]) (*connect.Response[v1.ListApiKeyTemplatesResponse], error) {
+	return c.listApiKeyTemplates.CallUnary(ctx, req)
+}
+
 // RevokeApiKey calls libops.v1.AccountService.RevokeApiKey.
 func (c *accountServiceClient) RevokeApiKey(ctx context.Context, req *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error) {
 	return c.revokeApiKey.CallUnary(ctx, req)
 }
 
+// GetAccountPreferences calls libops.v1.AccountService.GetAccountPreferences.
+func (c *accountServiceClient) GetAccountPreferences(ctx context.Context, req *connect.Request[v1.GetAccountPreferencesRequest]) (*connect.Response[v1.GetAccountPreferencesResponse], error) {
+	return c.getAccountPreferences.CallUnary(ctx, req)
+}
+
+// UpdateAccountPreferences calls libops.v1.AccountService.UpdateAccountPreferences.
+func (c *accountServiceClient) UpdateAccountPreferences(ctx context.Context, req *connect.Request[v1.UpdateAccountPreferencesRequest]) (*connect.Response[v1.UpdateAccountPreferencesResponse], error) {
+	return c.updateAccountPreferences.CallUnary(ctx, req)
+}
+
+// GetAccountProfile calls libops.v1.AccountService.GetAccountProfile.
+func (c *accountServiceClient) GetAccountProfile(ctx context.Context, req *connect.Request[v1.GetAccountProfileRequest]) (*connect.Response[v1.GetAccountProfileResponse], error) {
+	return c.getAccountProfile.CallUnary(ctx, req)
+}
+
+// UpdateAccountProfile calls libops.v1.AccountService.UpdateAccountProfile.
+func (c *accountServiceClient) UpdateAccountProfile(ctx context.Context, req *connect.Request[v1.UpdateAccountProfileRequest]) (*connect.Response[v1.UpdateAccountProfileResponse], error) {
+	return c.updateAccountProfile.CallUnary(ctx, req)
+}
+
+// UploadAccountAvatar calls libops.v1.AccountService.UploadAccountAvatar.
+func (c *accountServiceClient) UploadAccountAvatar(ctx context.Context, req *connect.Request[v1.UploadAccountAvatarRequest]) (*connect.Response[v1.UploadAccountAvatarResponse], error) {
+	return c.uploadAccountAvatar.CallUnary(ctx, req)
+}
+
+// DeleteAccountAvatar calls libops.v1.AccountService.DeleteAccountAvatar.
+func (c *accountServiceClient) DeleteAccountAvatar(ctx context.Context, req *connect.Request[v1.DeleteAccountAvatarRequest]) (*connect.Response[v1.DeleteAccountAvatarResponse], error) {
+	return c.deleteAccountAvatar.CallUnary(ctx, req)
+}
+
 // AccountServiceHandler is an implementation of the libops.v1.AccountService service.
 type AccountServiceHandler interface {
 	// Get account information by email (for Terraform provider lookups)
@@ -135,8 +257,22 @@ type AccountServiceHandler interface {
 	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
 	// List API keys for the authenticated user
 	ListApiKeys(context.Context, *connect.Request[v1.ListApiKeysRequest]) (*connect.Response[v1.ListApiKeysResponse], error)
+	// List the templates API keys can be created from
+	ListApiKeyTemplates(context.Context, *connect.Request[v1.ListApiKeyTemplatesRequest]) (*connect.Response[v1.ListApiKeyTemplatesResponse], error)
 	// Revoke an API key for the authenticated user
 	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error)
+	// Get the authenticated user's dashboard preferences
+	GetAccountPreferences(context.Context, *connect.Request[v1.GetAccountPreferencesRequest]) (*connect.Response[v1.GetAccountPreferencesResponse], error)
+	// Update the authenticated user's dashboard preferences
+	UpdateAccountPreferences(context.Context, *connect.Request[v1.UpdateAccountPreferencesRequest]) (*connect.Response[v1.UpdateAccountPreferencesResponse], error)
+	// Get the authenticated user's profile: how they're shown to other members
+	GetAccountProfile(context.Context, *connect.Request[v1.GetAccountProfileRequest]) (*connect.Response[v1.GetAccountProfileResponse], error)
+	// Update the authenticated user's name, time zone or contact details
+	UpdateAccountProfile(context.Context, *connect.Request[v1.UpdateAccountProfileRequest]) (*connect.Response[v1.UpdateAccountProfileResponse], error)
+	// Replace the authenticated user's avatar
+	UploadAccountAvatar(context.Context, *connect.Request[v1.UploadAccountAvatarRequest]) (*connect.Response[v1.UploadAccountAvatarResponse], error)
+	// Remove the authenticated user's avatar
+	DeleteAccountAvatar(context.Context, *connect.Request[v1.DeleteAccountAvatarRequest]) (*connect.Response[v1.DeleteAccountAvatarResponse], error)
 }
 
 // NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -166,12 +302,57 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	accountServiceListApiKeyTemplatesHandler := connect.NewUnaryHandler(
+		AccountServiceListApiKeyTemplatesProcedure,
+		svc.ListApiKeyTemplates,
+		connect.WithSchema(accountServiceMethods.ByName("ListApiKeyTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	accountServiceRevokeApiKeyHandler := connect.NewUnaryHandler(
 		AccountServiceRevokeApiKeyProcedure,
 		svc.RevokeApiKey,
 		connect.WithSchema(accountServiceMethods.ByName("RevokeApiKey")),
 		connect.WithHandlerOptions(opts...),
 	)
+	accountServiceGetAccountPreferencesHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountPreferencesProcedure,
+		svc.GetAccountPreferences,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountPreferences")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUpdateAccountPreferencesHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateAccountPreferencesProcedure,
+		svc.UpdateAccountPreferences,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateAccountPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountProfileHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountProfileProcedure,
+		svc.GetAccountProfile,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUpdateAccountProfileHandler := connect.NewUnaryHandler(
+		AccountServiceUpdateAccountProfileProcedure,
+		svc.UpdateAccountProfile,
+		connect.WithSchema(accountServiceMethods.ByName("UpdateAccountProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceUploadAccountAvatarHandler := connect.NewUnaryHandler(
+		AccountServiceUploadAccountAvatarProcedure,
+		svc.UploadAccountAvatar,
+		connect.WithSchema(accountServiceMethods.ByName("UploadAccountAvatar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceDeleteAccountAvatarHandler := connect.NewUnaryHandler(
+		AccountServiceDeleteAccountAvatarProcedure,
+		svc.DeleteAccountAvatar,
+		connect.WithSchema(accountServiceMethods.ByName("DeleteAccountAvatar")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AccountServiceGetAccountByEmailProcedure:
@@ -180,8 +361,22 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 			accountServiceCreateApiKeyHandler.ServeHTTP(w, r)
 		case AccountServiceListApiKeysProcedure:
 			accountServiceListApiKeysHandler.ServeHTTP(w, r)
+		case AccountServiceListApiKeyTemplatesProcedure:
+			accountServiceListApiKeyTemplatesHandler.ServeHTTP(w, r)
 		case AccountServiceRevokeApiKeyProcedure:
 			accountServiceRevokeApiKeyHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountPreferencesProcedure:
+			accountServiceGetAccountPreferencesHandler.ServeHTTP(w, r)
+		case AccountServiceUpdateAccountPreferencesProcedure:
+			accountServiceUpdateAccountPreferencesHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountProfileProcedure:
+			accountServiceGetAccountProfileHandler.ServeHTTP(w, r)
+		case AccountServiceUpdateAccountProfileProcedure:
+			accountServiceUpdateAccountProfileHandler.ServeHTTP(w, r)
+		case AccountServiceUploadAccountAvatarProcedure:
+			accountServiceUploadAccountAvatarHandler.ServeHTTP(w, r)
+		case AccountServiceDeleteAccountAvatarProcedure:
+			accountServiceDeleteAccountAvatarHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -203,6 +398,34 @@ func (UnimplementedAccountServiceHandler) ListApiKeys(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.ListApiKeys is not implemented"))
 }
 
+func (UnimplementedAccountServiceHandler) ListApiKeyTemplates(context.Context, *connect.Request[v1.ListApiKeyTemplatesRequest]) (*connect.Response[v1.ListApiKeyTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.ListApiKeyTemplates is not implemented"))
+}
+
 func (UnimplementedAccountServiceHandler) RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.RevokeApiKey is not implemented"))
 }
+
+func (UnimplementedAccountServiceHandler) GetAccountPreferences(context.Context, *connect.Request[v1.GetAccountPreferencesRequest]) (*connect.Response[v1.GetAccountPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.GetAccountPreferences is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UpdateAccountPreferences(context.Context, *connect.Request[v1.UpdateAccountPreferencesRequest]) (*connect.Response[v1.UpdateAccountPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.UpdateAccountPreferences is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccountProfile(context.Context, *connect.Request[v1.GetAccountProfileRequest]) (*connect.Response[v1.GetAccountProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.GetAccountProfile is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UpdateAccountProfile(context.Context, *connect.Request[v1.UpdateAccountProfileRequest]) (*connect.Response[v1.UpdateAccountProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.UpdateAccountProfile is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) UploadAccountAvatar(context.Context, *connect.Request[v1.UploadAccountAvatarRequest]) (*connect.Response[v1.UploadAccountAvatarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.UploadAccountAvatar is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) DeleteAccountAvatar(context.Context, *connect.Request[v1.DeleteAccountAvatarRequest]) (*connect.Response[v1.DeleteAccountAvatarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.AccountService.DeleteAccountAvatar is not implemented"))
+}
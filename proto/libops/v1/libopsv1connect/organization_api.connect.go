@@ -72,6 +72,30 @@ const (
 	// OrganizationServiceListOrganizationProjectsProcedure is the fully-qualified name of the
 	// OrganizationService's ListOrganizationProjects RPC.
 	OrganizationServiceListOrganizationProjectsProcedure = "/libops.v1.OrganizationService/ListOrganizationProjects"
+	// OrganizationServiceGetQuotasProcedure is the fully-qualified name of the OrganizationService's
+	// GetQuotas RPC.
+	OrganizationServiceGetQuotasProcedure = "/libops.v1.OrganizationService/GetQuotas"
+	// OrganizationServiceGetOrganizationUsageProcedure is the fully-qualified name of the
+	// OrganizationService's GetOrganizationUsage RPC.
+	OrganizationServiceGetOrganizationUsageProcedure = "/libops.v1.OrganizationService/GetOrganizationUsage"
+	// OrganizationServicePreviewUsageProcedure is the fully-qualified name of the OrganizationService's
+	// PreviewUsage RPC.
+	OrganizationServicePreviewUsageProcedure = "/libops.v1.OrganizationService/PreviewUsage"
+	// OrganizationServiceCreateBillingPortalSessionProcedure is the fully-qualified name of the
+	// OrganizationService's CreateBillingPortalSession RPC.
+	OrganizationServiceCreateBillingPortalSessionProcedure = "/libops.v1.OrganizationService/CreateBillingPortalSession"
+	// OrganizationServiceListPaymentMethodsProcedure is the fully-qualified name of the
+	// OrganizationService's ListPaymentMethods RPC.
+	OrganizationServiceListPaymentMethodsProcedure = "/libops.v1.OrganizationService/ListPaymentMethods"
+	// OrganizationServiceSetDefaultPaymentMethodProcedure is the fully-qualified name of the
+	// OrganizationService's SetDefaultPaymentMethod RPC.
+	OrganizationServiceSetDefaultPaymentMethodProcedure = "/libops.v1.OrganizationService/SetDefaultPaymentMethod"
+	// OrganizationServiceListInvoicesProcedure is the fully-qualified name of the OrganizationService's
+	// ListInvoices RPC.
+	OrganizationServiceListInvoicesProcedure = "/libops.v1.OrganizationService/ListInvoices"
+	// OrganizationServiceGetInvoiceProcedure is the fully-qualified name of the OrganizationService's
+	// GetInvoice RPC.
+	OrganizationServiceGetInvoiceProcedure = "/libops.v1.OrganizationService/GetInvoice"
 	// SiteServiceListSitesProcedure is the fully-qualified name of the SiteService's ListSites RPC.
 	SiteServiceListSitesProcedure = "/libops.v1.SiteService/ListSites"
 	// SiteServiceGetSiteProcedure is the fully-qualified name of the SiteService's GetSite RPC.
@@ -91,6 +115,9 @@ const (
 	// ProjectServiceUpdateProjectProcedure is the fully-qualified name of the ProjectService's
 	// UpdateProject RPC.
 	ProjectServiceUpdateProjectProcedure = "/libops.v1.ProjectService/UpdateProject"
+	// ProjectServiceChangePlanProcedure is the fully-qualified name of the ProjectService's ChangePlan
+	// RPC.
+	ProjectServiceChangePlanProcedure = "/libops.v1.ProjectService/ChangePlan"
 	// ProjectServiceDeleteProjectProcedure is the fully-qualified name of the ProjectService's
 	// DeleteProject RPC.
 	ProjectServiceDeleteProjectProcedure = "/libops.v1.ProjectService/DeleteProject"
@@ -127,6 +154,15 @@ const (
 	// SiteFirewallServiceDeleteSiteFirewallRuleProcedure is the fully-qualified name of the
 	// SiteFirewallService's DeleteSiteFirewallRule RPC.
 	SiteFirewallServiceDeleteSiteFirewallRuleProcedure = "/libops.v1.SiteFirewallService/DeleteSiteFirewallRule"
+	// SiteFirewallServiceListSiteRateLimitRulesProcedure is the fully-qualified name of the
+	// SiteFirewallService's ListSiteRateLimitRules RPC.
+	SiteFirewallServiceListSiteRateLimitRulesProcedure = "/libops.v1.SiteFirewallService/ListSiteRateLimitRules"
+	// SiteFirewallServiceCreateSiteRateLimitRuleProcedure is the fully-qualified name of the
+	// SiteFirewallService's CreateSiteRateLimitRule RPC.
+	SiteFirewallServiceCreateSiteRateLimitRuleProcedure = "/libops.v1.SiteFirewallService/CreateSiteRateLimitRule"
+	// SiteFirewallServiceDeleteSiteRateLimitRuleProcedure is the fully-qualified name of the
+	// SiteFirewallService's DeleteSiteRateLimitRule RPC.
+	SiteFirewallServiceDeleteSiteRateLimitRuleProcedure = "/libops.v1.SiteFirewallService/DeleteSiteRateLimitRule"
 	// MemberServiceListOrganizationMembersProcedure is the fully-qualified name of the MemberService's
 	// ListOrganizationMembers RPC.
 	MemberServiceListOrganizationMembersProcedure = "/libops.v1.MemberService/ListOrganizationMembers"
@@ -139,6 +175,15 @@ const (
 	// MemberServiceDeleteOrganizationMemberProcedure is the fully-qualified name of the MemberService's
 	// DeleteOrganizationMember RPC.
 	MemberServiceDeleteOrganizationMemberProcedure = "/libops.v1.MemberService/DeleteOrganizationMember"
+	// MemberServiceBulkCreateMembersProcedure is the fully-qualified name of the MemberService's
+	// BulkCreateMembers RPC.
+	MemberServiceBulkCreateMembersProcedure = "/libops.v1.MemberService/BulkCreateMembers"
+	// MemberServiceBulkUpdateMemberRolesProcedure is the fully-qualified name of the MemberService's
+	// BulkUpdateMemberRoles RPC.
+	MemberServiceBulkUpdateMemberRolesProcedure = "/libops.v1.MemberService/BulkUpdateMemberRoles"
+	// MemberServiceBulkRemoveMembersProcedure is the fully-qualified name of the MemberService's
+	// BulkRemoveMembers RPC.
+	MemberServiceBulkRemoveMembersProcedure = "/libops.v1.MemberService/BulkRemoveMembers"
 	// ProjectMemberServiceListProjectMembersProcedure is the fully-qualified name of the
 	// ProjectMemberService's ListProjectMembers RPC.
 	ProjectMemberServiceListProjectMembersProcedure = "/libops.v1.ProjectMemberService/ListProjectMembers"
@@ -178,6 +223,12 @@ const (
 	// SiteOperationsServiceDeploySiteProcedure is the fully-qualified name of the
 	// SiteOperationsService's DeploySite RPC.
 	SiteOperationsServiceDeploySiteProcedure = "/libops.v1.SiteOperationsService/DeploySite"
+	// SiteOperationsServiceListSiteDeploymentsProcedure is the fully-qualified name of the
+	// SiteOperationsService's ListSiteDeployments RPC.
+	SiteOperationsServiceListSiteDeploymentsProcedure = "/libops.v1.SiteOperationsService/ListSiteDeployments"
+	// SiteOperationsServiceRollbackSiteProcedure is the fully-qualified name of the
+	// SiteOperationsService's RollbackSite RPC.
+	SiteOperationsServiceRollbackSiteProcedure = "/libops.v1.SiteOperationsService/RollbackSite"
 )
 
 // OrganizationServiceClient is a client for the libops.v1.OrganizationService service.
@@ -194,6 +245,22 @@ type OrganizationServiceClient interface {
 	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
 	// List projects for a organization
 	ListOrganizationProjects(context.Context, *connect.Request[v1.ListOrganizationProjectsRequest]) (*connect.Response[v1.ListOrganizationProjectsResponse], error)
+	// Get the organization's billing plan and effective quotas
+	GetQuotas(context.Context, *connect.Request[v1.GetQuotasRequest]) (*connect.Response[v1.GetQuotasResponse], error)
+	// Get current resource usage and the Stripe subscription items it is billed through
+	GetOrganizationUsage(context.Context, *connect.Request[v1.GetOrganizationUsageRequest]) (*connect.Response[v1.GetOrganizationUsageResponse], error)
+	// Preview usage-based charges (egress, disk) accrued in the current billing period
+	PreviewUsage(context.Context, *connect.Request[v1.PreviewUsageRequest]) (*connect.Response[v1.PreviewUsageResponse], error)
+	// Create a Stripe Billing Portal session for managing cards and downloading invoices
+	CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error)
+	// List the payment methods attached to the organization's Stripe customer
+	ListPaymentMethods(context.Context, *connect.Request[v1.ListPaymentMethodsRequest]) (*connect.Response[v1.ListPaymentMethodsResponse], error)
+	// Set the payment method charged for the organization's subscription
+	SetDefaultPaymentMethod(context.Context, *connect.Request[v1.SetDefaultPaymentMethodRequest]) (*connect.Response[v1.SetDefaultPaymentMethodResponse], error)
+	// List the organization's invoices, newest first
+	ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error)
+	// Get a single invoice with its PDF and hosted payment links
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error)
 }
 
 // NewOrganizationServiceClient constructs a client for the libops.v1.OrganizationService service.
@@ -246,17 +313,79 @@ func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		getQuotas: connect.NewClient[v1.GetQuotasRequest, v1.GetQuotasResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetQuotasProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetQuotas")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganizationUsage: connect.NewClient[v1.GetOrganizationUsageRequest, v1.GetOrganizationUsageResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetOrganizationUsageProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		previewUsage: connect.NewClient[v1.PreviewUsageRequest, v1.PreviewUsageResponse](
+			httpClient,
+			baseURL+OrganizationServicePreviewUsageProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("PreviewUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createBillingPortalSession: connect.NewClient[v1.CreateBillingPortalSessionRequest, v1.CreateBillingPortalSessionResponse](
+			httpClient,
+			baseURL+OrganizationServiceCreateBillingPortalSessionProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("CreateBillingPortalSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listPaymentMethods: connect.NewClient[v1.ListPaymentMethodsRequest, v1.ListPaymentMethodsResponse](
+			httpClient,
+			baseURL+OrganizationServiceListPaymentMethodsProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ListPaymentMethods")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setDefaultPaymentMethod: connect.NewClient[v1.SetDefaultPaymentMethodRequest, v1.SetDefaultPaymentMethodResponse](
+			httpClient,
+			baseURL+OrganizationServiceSetDefaultPaymentMethodProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("SetDefaultPaymentMethod")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvoices: connect.NewClient[v1.ListInvoicesRequest, v1.ListInvoicesResponse](
+			httpClient,
+			baseURL+OrganizationServiceListInvoicesProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ListInvoices")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getInvoice: connect.NewClient[v1.GetInvoiceRequest, v1.GetInvoiceResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetInvoiceProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetInvoice")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // organizationServiceClient implements OrganizationServiceClient.
 type organizationServiceClient struct {
-	getOrganization          *connect.Client[v1.GetOrganizationRequest, v1.GetOrganizationResponse]
-	createOrganization       *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
-	updateOrganization       *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
-	deleteOrganization       *connect.Client[v1.DeleteOrganizationRequest, emptypb.Empty]
-	listOrganizations        *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
-	listOrganizationProjects *connect.Client[v1.ListOrganizationProjectsRequest, v1.ListOrganizationProjectsResponse]
+	getOrganization            *connect.Client[v1.GetOrganizationRequest, v1.GetOrganizationResponse]
+	createOrganization         *connect.Client[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse]
+	updateOrganization         *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
+	deleteOrganization         *connect.Client[v1.DeleteOrganizationRequest, emptypb.Empty]
+	listOrganizations          *connect.Client[v1.ListOrganizationsRequest, v1.ListOrganizationsResponse]
+	listOrganizationProjects   *connect.Client[v1.ListOrganizationProjectsRequest, v1.ListOrganizationProjectsResponse]
+	getQuotas                  *connect.Client[v1.GetQuotasRequest, v1.GetQuotasResponse]
+	getOrganizationUsage       *connect.Client[v1.GetOrganizationUsageRequest, v1.GetOrganizationUsageResponse]
+	previewUsage               *connect.Client[v1.PreviewUsageRequest, v1.PreviewUsageResponse]
+	createBillingPortalSession *connect.Client[v1.CreateBillingPortalSessionRequest, v1.CreateBillingPortalSessionResponse]
+	listPaymentMethods         *connect.Client[v1.ListPaymentMethodsRequest, v1.ListPaymentMethodsResponse]
+	setDefaultPaymentMethod    *connect.Client[v1.SetDefaultPaymentMethodRequest, v1.SetDefaultPaymentMethodResponse]
+	listInvoices               *connect.Client[v1.ListInvoicesRequest, v1.ListInvoicesResponse]
+	getInvoice                 *connect.Client[v1.GetInvoiceRequest, v1.GetInvoiceResponse]
 }
 
 // GetOrganization calls libops.v1.OrganizationService.GetOrganization.
@@ -289,6 +418,46 @@ func (c *organizationServiceClient) ListOrganizationProjects(ctx context.Context
 	return c.listOrganizationProjects.CallUnary(ctx, req)
 }
 
+// GetQuotas calls libops.v1.OrganizationService.GetQuotas.
+func (c *organizationServiceClient) GetQuotas(ctx context.Context, req *connect.Request[v1.GetQuotasRequest]) (*connect.Response[v1.GetQuotasResponse], error) {
+	return c.getQuotas.CallUnary(ctx, req)
+}
+
+// GetOrganizationUsage calls libops.v1.OrganizationService.GetOrganizationUsage.
+func (c *organizationServiceClient) GetOrganizationUsage(ctx context.Context, req *connect.Request[v1.GetOrganizationUsageRequest]) (*connect.Response[v1.GetOrganizationUsageResponse], error) {
+	return c.getOrganizationUsage.CallUnary(ctx, req)
+}
+
+// PreviewUsage calls libops.v1.OrganizationService.PreviewUsage.
+func (c *organizationServiceClient) PreviewUsage(ctx context.Context, req *connect.Request[v1.PreviewUsageRequest]) (*connect.Response[v1.PreviewUsageResponse], error) {
+	return c.previewUsage.CallUnary(ctx, req)
+}
+
+// CreateBillingPortalSession calls libops.v1.OrganizationService.CreateBillingPortalSession.
+func (c *organizationServiceClient) CreateBillingPortalSession(ctx context.Context, req *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error) {
+	return c.createBillingPortalSession.CallUnary(ctx, req)
+}
+
+// ListPaymentMethods calls libops.v1.OrganizationService.ListPaymentMethods.
+func (c *organizationServiceClient) ListPaymentMethods(ctx context.Context, req *connect.Request[v1.ListPaymentMethodsRequest]) (*connect.Response[v1.ListPaymentMethodsResponse], error) {
+	return c.listPaymentMethods.CallUnary(ctx, req)
+}
+
+// SetDefaultPaymentMethod calls libops.v1.OrganizationService.SetDefaultPaymentMethod.
+func (c *organizationServiceClient) SetDefaultPaymentMethod(ctx context.Context, req *connect.Request[v1.SetDefaultPaymentMethodRequest]) (*connect.Response[v1.SetDefaultPaymentMethodResponse], error) {
+	return c.setDefaultPaymentMethod.CallUnary(ctx, req)
+}
+
+// ListInvoices calls libops.v1.OrganizationService.ListInvoices.
+func (c *organizationServiceClient) ListInvoices(ctx context.Context, req *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error) {
+	return c.listInvoices.CallUnary(ctx, req)
+}
+
+// GetInvoice calls libops.v1.OrganizationService.GetInvoice.
+func (c *organizationServiceClient) GetInvoice(ctx context.Context, req *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error) {
+	return c.getInvoice.CallUnary(ctx, req)
+}
+
 // OrganizationServiceHandler is an implementation of the libops.v1.OrganizationService service.
 type OrganizationServiceHandler interface {
 	// Get organization configuration (organization view)
@@ -303,6 +472,22 @@ type OrganizationServiceHandler interface {
 	ListOrganizations(context.Context, *connect.Request[v1.ListOrganizationsRequest]) (*connect.Response[v1.ListOrganizationsResponse], error)
 	// List projects for a organization
 	ListOrganizationProjects(context.Context, *connect.Request[v1.ListOrganizationProjectsRequest]) (*connect.Response[v1.ListOrganizationProjectsResponse], error)
+	// Get the organization's billing plan and effective quotas
+	GetQuotas(context.Context, *connect.Request[v1.GetQuotasRequest]) (*connect.Response[v1.GetQuotasResponse], error)
+	// Get current resource usage and the Stripe subscription items it is billed through
+	GetOrganizationUsage(context.Context, *connect.Request[v1.GetOrganizationUsageRequest]) (*connect.Response[v1.GetOrganizationUsageResponse], error)
+	// Preview usage-based charges (egress, disk) accrued in the current billing period
+	PreviewUsage(context.Context, *connect.Request[v1.PreviewUsageRequest]) (*connect.Response[v1.PreviewUsageResponse], error)
+	// Create a Stripe Billing Portal session for managing cards and downloading invoices
+	CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error)
+	// List the payment methods attached to the organization's Stripe customer
+	ListPaymentMethods(context.Context, *connect.Request[v1.ListPaymentMethodsRequest]) (*connect.Response[v1.ListPaymentMethodsResponse], error)
+	// Set the payment method charged for the organization's subscription
+	SetDefaultPaymentMethod(context.Context, *connect.Request[v1.SetDefaultPaymentMethodRequest]) (*connect.Response[v1.SetDefaultPaymentMethodResponse], error)
+	// List the organization's invoices, newest first
+	ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error)
+	// Get a single invoice with its PDF and hosted payment links
+	GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error)
 }
 
 // NewOrganizationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -351,6 +536,60 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationServiceGetQuotasHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetQuotasProcedure,
+		svc.GetQuotas,
+		connect.WithSchema(organizationServiceMethods.ByName("GetQuotas")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetOrganizationUsageHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetOrganizationUsageProcedure,
+		svc.GetOrganizationUsage,
+		connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServicePreviewUsageHandler := connect.NewUnaryHandler(
+		OrganizationServicePreviewUsageProcedure,
+		svc.PreviewUsage,
+		connect.WithSchema(organizationServiceMethods.ByName("PreviewUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceCreateBillingPortalSessionHandler := connect.NewUnaryHandler(
+		OrganizationServiceCreateBillingPortalSessionProcedure,
+		svc.CreateBillingPortalSession,
+		connect.WithSchema(organizationServiceMethods.ByName("CreateBillingPortalSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListPaymentMethodsHandler := connect.NewUnaryHandler(
+		OrganizationServiceListPaymentMethodsProcedure,
+		svc.ListPaymentMethods,
+		connect.WithSchema(organizationServiceMethods.ByName("ListPaymentMethods")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceSetDefaultPaymentMethodHandler := connect.NewUnaryHandler(
+		OrganizationServiceSetDefaultPaymentMethodProcedure,
+		svc.SetDefaultPaymentMethod,
+		connect.WithSchema(organizationServiceMethods.ByName("SetDefaultPaymentMethod")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListInvoicesHandler := connect.NewUnaryHandler(
+		OrganizationServiceListInvoicesProcedure,
+		svc.ListInvoices,
+		connect.WithSchema(organizationServiceMethods.ByName("ListInvoices")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceGetInvoiceHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetInvoiceProcedure,
+		svc.GetInvoice,
+		connect.WithSchema(organizationServiceMethods.ByName("GetInvoice")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.OrganizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrganizationServiceGetOrganizationProcedure:
@@ -365,6 +604,22 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 			organizationServiceListOrganizationsHandler.ServeHTTP(w, r)
 		case OrganizationServiceListOrganizationProjectsProcedure:
 			organizationServiceListOrganizationProjectsHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetQuotasProcedure:
+			organizationServiceGetQuotasHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetOrganizationUsageProcedure:
+			organizationServiceGetOrganizationUsageHandler.ServeHTTP(w, r)
+		case OrganizationServicePreviewUsageProcedure:
+			organizationServicePreviewUsageHandler.ServeHTTP(w, r)
+		case OrganizationServiceCreateBillingPortalSessionProcedure:
+			organizationServiceCreateBillingPortalSessionHandler.ServeHTTP(w, r)
+		case OrganizationServiceListPaymentMethodsProcedure:
+			organizationServiceListPaymentMethodsHandler.ServeHTTP(w, r)
+		case OrganizationServiceSetDefaultPaymentMethodProcedure:
+			organizationServiceSetDefaultPaymentMethodHandler.ServeHTTP(w, r)
+		case OrganizationServiceListInvoicesProcedure:
+			organizationServiceListInvoicesHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetInvoiceProcedure:
+			organizationServiceGetInvoiceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -398,6 +653,38 @@ func (UnimplementedOrganizationServiceHandler) ListOrganizationProjects(context.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.ListOrganizationProjects is not implemented"))
 }
 
+func (UnimplementedOrganizationServiceHandler) GetQuotas(context.Context, *connect.Request[v1.GetQuotasRequest]) (*connect.Response[v1.GetQuotasResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.GetQuotas is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetOrganizationUsage(context.Context, *connect.Request[v1.GetOrganizationUsageRequest]) (*connect.Response[v1.GetOrganizationUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.GetOrganizationUsage is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) PreviewUsage(context.Context, *connect.Request[v1.PreviewUsageRequest]) (*connect.Response[v1.PreviewUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.PreviewUsage is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) CreateBillingPortalSession(context.Context, *connect.Request[v1.CreateBillingPortalSessionRequest]) (*connect.Response[v1.CreateBillingPortalSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.CreateBillingPortalSession is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListPaymentMethods(context.Context, *connect.Request[v1.ListPaymentMethodsRequest]) (*connect.Response[v1.ListPaymentMethodsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.ListPaymentMethods is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) SetDefaultPaymentMethod(context.Context, *connect.Request[v1.SetDefaultPaymentMethodRequest]) (*connect.Response[v1.SetDefaultPaymentMethodResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.SetDefaultPaymentMethod is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListInvoices(context.Context, *connect.Request[v1.ListInvoicesRequest]) (*connect.Response[v1.ListInvoicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.ListInvoices is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) GetInvoice(context.Context, *connect.Request[v1.GetInvoiceRequest]) (*connect.Response[v1.GetInvoiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationService.GetInvoice is not implemented"))
+}
+
 // SiteServiceClient is a client for the libops.v1.SiteService service.
 type SiteServiceClient interface {
 	// List sites for a organization
@@ -594,6 +881,9 @@ type ProjectServiceClient interface {
 	CreateProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error)
 	// Update project configuration (organization-editable fields only)
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	// Change a project's plan (machine type and/or disk size) with prorated billing
+	// and a terraform run that resizes the project's sites
+	ChangePlan(context.Context, *connect.Request[v1.ChangePlanRequest]) (*connect.Response[v1.ChangePlanResponse], error)
 	// Delete a project (must have no sites)
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error)
 	// List projects for a organization
@@ -632,6 +922,12 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
 			connect.WithClientOptions(opts...),
 		),
+		changePlan: connect.NewClient[v1.ChangePlanRequest, v1.ChangePlanResponse](
+			httpClient,
+			baseURL+ProjectServiceChangePlanProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ChangePlan")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1.DeleteProjectRequest, emptypb.Empty](
 			httpClient,
 			baseURL+ProjectServiceDeleteProjectProcedure,
@@ -660,6 +956,7 @@ type projectServiceClient struct {
 	getProject       *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
 	createProject    *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
 	updateProject    *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	changePlan       *connect.Client[v1.ChangePlanRequest, v1.ChangePlanResponse]
 	deleteProject    *connect.Client[v1.DeleteProjectRequest, emptypb.Empty]
 	listProjects     *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
 	listProjectSites *connect.Client[v1.ListProjectSitesRequest, v1.ListProjectSitesResponse]
@@ -680,6 +977,11 @@ func (c *projectServiceClient) UpdateProject(ctx context.Context, req *connect.R
 	return c.updateProject.CallUnary(ctx, req)
 }
 
+// ChangePlan calls libops.v1.ProjectService.ChangePlan.
+func (c *projectServiceClient) ChangePlan(ctx context.Context, req *connect.Request[v1.ChangePlanRequest]) (*connect.Response[v1.ChangePlanResponse], error) {
+	return c.changePlan.CallUnary(ctx, req)
+}
+
 // DeleteProject calls libops.v1.ProjectService.DeleteProject.
 func (c *projectServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -703,6 +1005,9 @@ type ProjectServiceHandler interface {
 	CreateProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v1.CreateProjectResponse], error)
 	// Update project configuration (organization-editable fields only)
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	// Change a project's plan (machine type and/or disk size) with prorated billing
+	// and a terraform run that resizes the project's sites
+	ChangePlan(context.Context, *connect.Request[v1.ChangePlanRequest]) (*connect.Response[v1.ChangePlanResponse], error)
 	// Delete a project (must have no sites)
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error)
 	// List projects for a organization
@@ -737,6 +1042,12 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceChangePlanHandler := connect.NewUnaryHandler(
+		ProjectServiceChangePlanProcedure,
+		svc.ChangePlan,
+		connect.WithSchema(projectServiceMethods.ByName("ChangePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
 	projectServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		ProjectServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -765,6 +1076,8 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceCreateProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceUpdateProjectProcedure:
 			projectServiceUpdateProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceChangePlanProcedure:
+			projectServiceChangePlanHandler.ServeHTTP(w, r)
 		case ProjectServiceDeleteProjectProcedure:
 			projectServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceListProjectsProcedure:
@@ -792,6 +1105,10 @@ func (UnimplementedProjectServiceHandler) UpdateProject(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.ProjectService.UpdateProject is not implemented"))
 }
 
+func (UnimplementedProjectServiceHandler) ChangePlan(context.Context, *connect.Request[v1.ChangePlanRequest]) (*connect.Response[v1.ChangePlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.ProjectService.ChangePlan is not implemented"))
+}
+
 func (UnimplementedProjectServiceHandler) DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.ProjectService.DeleteProject is not implemented"))
 }
@@ -1073,6 +1390,13 @@ type SiteFirewallServiceClient interface {
 	CreateSiteFirewallRule(context.Context, *connect.Request[v1.CreateSiteFirewallRuleRequest]) (*connect.Response[v1.CreateSiteFirewallRuleResponse], error)
 	// Remove a firewall rule from a specific site
 	DeleteSiteFirewallRule(context.Context, *connect.Request[v1.DeleteSiteFirewallRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// List HTTP rate limits applied to a specific site, with the requests each
+	// rejected over the last 7 days
+	ListSiteRateLimitRules(context.Context, *connect.Request[v1.ListSiteRateLimitRulesRequest]) (*connect.Response[v1.ListSiteRateLimitRulesResponse], error)
+	// Add an HTTP rate limit to a specific site, enforced by the site's proxy
+	CreateSiteRateLimitRule(context.Context, *connect.Request[v1.CreateSiteRateLimitRuleRequest]) (*connect.Response[v1.CreateSiteRateLimitRuleResponse], error)
+	// Remove an HTTP rate limit from a specific site
+	DeleteSiteRateLimitRule(context.Context, *connect.Request[v1.DeleteSiteRateLimitRuleRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewSiteFirewallServiceClient constructs a client for the libops.v1.SiteFirewallService service.
@@ -1105,14 +1429,36 @@ func NewSiteFirewallServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(siteFirewallServiceMethods.ByName("DeleteSiteFirewallRule")),
 			connect.WithClientOptions(opts...),
 		),
+		listSiteRateLimitRules: connect.NewClient[v1.ListSiteRateLimitRulesRequest, v1.ListSiteRateLimitRulesResponse](
+			httpClient,
+			baseURL+SiteFirewallServiceListSiteRateLimitRulesProcedure,
+			connect.WithSchema(siteFirewallServiceMethods.ByName("ListSiteRateLimitRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createSiteRateLimitRule: connect.NewClient[v1.CreateSiteRateLimitRuleRequest, v1.CreateSiteRateLimitRuleResponse](
+			httpClient,
+			baseURL+SiteFirewallServiceCreateSiteRateLimitRuleProcedure,
+			connect.WithSchema(siteFirewallServiceMethods.ByName("CreateSiteRateLimitRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSiteRateLimitRule: connect.NewClient[v1.DeleteSiteRateLimitRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+SiteFirewallServiceDeleteSiteRateLimitRuleProcedure,
+			connect.WithSchema(siteFirewallServiceMethods.ByName("DeleteSiteRateLimitRule")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // siteFirewallServiceClient implements SiteFirewallServiceClient.
 type siteFirewallServiceClient struct {
-	listSiteFirewallRules  *connect.Client[v1.ListSiteFirewallRulesRequest, v1.ListSiteFirewallRulesResponse]
-	createSiteFirewallRule *connect.Client[v1.CreateSiteFirewallRuleRequest, v1.CreateSiteFirewallRuleResponse]
-	deleteSiteFirewallRule *connect.Client[v1.DeleteSiteFirewallRuleRequest, emptypb.Empty]
+	listSiteFirewallRules   *connect.Client[v1.ListSiteFirewallRulesRequest, v1.ListSiteFirewallRulesResponse]
+	createSiteFirewallRule  *connect.Client[v1.CreateSiteFirewallRuleRequest, v1.CreateSiteFirewallRuleResponse]
+	deleteSiteFirewallRule  *connect.Client[v1.DeleteSiteFirewallRuleRequest, emptypb.Empty]
+	listSiteRateLimitRules  *connect.Client[v1.ListSiteRateLimitRulesRequest, v1.ListSiteRateLimitRulesResponse]
+	createSiteRateLimitRule *connect.Client[v1.CreateSiteRateLimitRuleRequest, v1.CreateSiteRateLimitRuleResponse]
+	deleteSiteRateLimitRule *connect.Client[v1.DeleteSiteRateLimitRuleRequest, emptypb.Empty]
 }
 
 // ListSiteFirewallRules calls libops.v1.SiteFirewallService.ListSiteFirewallRules.
@@ -1130,6 +1476,21 @@ func (c *siteFirewallServiceClient) DeleteSiteFirewallRule(ctx context.Context,
 	return c.deleteSiteFirewallRule.CallUnary(ctx, req)
 }
 
+// ListSiteRateLimitRules calls libops.v1.SiteFirewallService.ListSiteRateLimitRules.
+func (c *siteFirewallServiceClient) ListSiteRateLimitRules(ctx context.Context, req *connect.Request[v1.ListSiteRateLimitRulesRequest]) (*connect.Response[v1.ListSiteRateLimitRulesResponse], error) {
+	return c.listSiteRateLimitRules.CallUnary(ctx, req)
+}
+
+// CreateSiteRateLimitRule calls libops.v1.SiteFirewallService.CreateSiteRateLimitRule.
+func (c *siteFirewallServiceClient) CreateSiteRateLimitRule(ctx context.Context, req *connect.Request[v1.CreateSiteRateLimitRuleRequest]) (*connect.Response[v1.CreateSiteRateLimitRuleResponse], error) {
+	return c.createSiteRateLimitRule.CallUnary(ctx, req)
+}
+
+// DeleteSiteRateLimitRule calls libops.v1.SiteFirewallService.DeleteSiteRateLimitRule.
+func (c *siteFirewallServiceClient) DeleteSiteRateLimitRule(ctx context.Context, req *connect.Request[v1.DeleteSiteRateLimitRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteSiteRateLimitRule.CallUnary(ctx, req)
+}
+
 // SiteFirewallServiceHandler is an implementation of the libops.v1.SiteFirewallService service.
 type SiteFirewallServiceHandler interface {
 	// List firewall rules applied to a specific site
@@ -1138,6 +1499,13 @@ type SiteFirewallServiceHandler interface {
 	CreateSiteFirewallRule(context.Context, *connect.Request[v1.CreateSiteFirewallRuleRequest]) (*connect.Response[v1.CreateSiteFirewallRuleResponse], error)
 	// Remove a firewall rule from a specific site
 	DeleteSiteFirewallRule(context.Context, *connect.Request[v1.DeleteSiteFirewallRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	// List HTTP rate limits applied to a specific site, with the requests each
+	// rejected over the last 7 days
+	ListSiteRateLimitRules(context.Context, *connect.Request[v1.ListSiteRateLimitRulesRequest]) (*connect.Response[v1.ListSiteRateLimitRulesResponse], error)
+	// Add an HTTP rate limit to a specific site, enforced by the site's proxy
+	CreateSiteRateLimitRule(context.Context, *connect.Request[v1.CreateSiteRateLimitRuleRequest]) (*connect.Response[v1.CreateSiteRateLimitRuleResponse], error)
+	// Remove an HTTP rate limit from a specific site
+	DeleteSiteRateLimitRule(context.Context, *connect.Request[v1.DeleteSiteRateLimitRuleRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewSiteFirewallServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -1166,6 +1534,25 @@ func NewSiteFirewallServiceHandler(svc SiteFirewallServiceHandler, opts ...conne
 		connect.WithSchema(siteFirewallServiceMethods.ByName("DeleteSiteFirewallRule")),
 		connect.WithHandlerOptions(opts...),
 	)
+	siteFirewallServiceListSiteRateLimitRulesHandler := connect.NewUnaryHandler(
+		SiteFirewallServiceListSiteRateLimitRulesProcedure,
+		svc.ListSiteRateLimitRules,
+		connect.WithSchema(siteFirewallServiceMethods.ByName("ListSiteRateLimitRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	siteFirewallServiceCreateSiteRateLimitRuleHandler := connect.NewUnaryHandler(
+		SiteFirewallServiceCreateSiteRateLimitRuleProcedure,
+		svc.CreateSiteRateLimitRule,
+		connect.WithSchema(siteFirewallServiceMethods.ByName("CreateSiteRateLimitRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	siteFirewallServiceDeleteSiteRateLimitRuleHandler := connect.NewUnaryHandler(
+		SiteFirewallServiceDeleteSiteRateLimitRuleProcedure,
+		svc.DeleteSiteRateLimitRule,
+		connect.WithSchema(siteFirewallServiceMethods.ByName("DeleteSiteRateLimitRule")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.SiteFirewallService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SiteFirewallServiceListSiteFirewallRulesProcedure:
@@ -1174,6 +1561,12 @@ func NewSiteFirewallServiceHandler(svc SiteFirewallServiceHandler, opts ...conne
 			siteFirewallServiceCreateSiteFirewallRuleHandler.ServeHTTP(w, r)
 		case SiteFirewallServiceDeleteSiteFirewallRuleProcedure:
 			siteFirewallServiceDeleteSiteFirewallRuleHandler.ServeHTTP(w, r)
+		case SiteFirewallServiceListSiteRateLimitRulesProcedure:
+			siteFirewallServiceListSiteRateLimitRulesHandler.ServeHTTP(w, r)
+		case SiteFirewallServiceCreateSiteRateLimitRuleProcedure:
+			siteFirewallServiceCreateSiteRateLimitRuleHandler.ServeHTTP(w, r)
+		case SiteFirewallServiceDeleteSiteRateLimitRuleProcedure:
+			siteFirewallServiceDeleteSiteRateLimitRuleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1195,6 +1588,18 @@ func (UnimplementedSiteFirewallServiceHandler) DeleteSiteFirewallRule(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteFirewallService.DeleteSiteFirewallRule is not implemented"))
 }
 
+func (UnimplementedSiteFirewallServiceHandler) ListSiteRateLimitRules(context.Context, *connect.Request[v1.ListSiteRateLimitRulesRequest]) (*connect.Response[v1.ListSiteRateLimitRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteFirewallService.ListSiteRateLimitRules is not implemented"))
+}
+
+func (UnimplementedSiteFirewallServiceHandler) CreateSiteRateLimitRule(context.Context, *connect.Request[v1.CreateSiteRateLimitRuleRequest]) (*connect.Response[v1.CreateSiteRateLimitRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteFirewallService.CreateSiteRateLimitRule is not implemented"))
+}
+
+func (UnimplementedSiteFirewallServiceHandler) DeleteSiteRateLimitRule(context.Context, *connect.Request[v1.DeleteSiteRateLimitRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteFirewallService.DeleteSiteRateLimitRule is not implemented"))
+}
+
 // MemberServiceClient is a client for the libops.v1.MemberService service.
 type MemberServiceClient interface {
 	// List members of a organization
@@ -1205,6 +1610,13 @@ type MemberServiceClient interface {
 	UpdateOrganizationMember(context.Context, *connect.Request[v1.UpdateOrganizationMemberRequest]) (*connect.Response[v1.UpdateOrganizationMemberResponse], error)
 	// Delete a member from a organization
 	DeleteOrganizationMember(context.Context, *connect.Request[v1.DeleteOrganizationMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	// Add up to 500 people to a organization at once. Each entry succeeds or
+	// fails on its own and has a result in the same position
+	BulkCreateMembers(context.Context, *connect.Request[v1.BulkCreateMembersRequest]) (*connect.Response[v1.BulkCreateMembersResponse], error)
+	// Change the role of up to 500 members at once, with a result per entry
+	BulkUpdateMemberRoles(context.Context, *connect.Request[v1.BulkUpdateMemberRolesRequest]) (*connect.Response[v1.BulkUpdateMemberRolesResponse], error)
+	// Remove up to 500 members at once, with a result per entry
+	BulkRemoveMembers(context.Context, *connect.Request[v1.BulkRemoveMembersRequest]) (*connect.Response[v1.BulkRemoveMembersResponse], error)
 }
 
 // NewMemberServiceClient constructs a client for the libops.v1.MemberService service. By default,
@@ -1243,6 +1655,24 @@ func NewMemberServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(memberServiceMethods.ByName("DeleteOrganizationMember")),
 			connect.WithClientOptions(opts...),
 		),
+		bulkCreateMembers: connect.NewClient[v1.BulkCreateMembersRequest, v1.BulkCreateMembersResponse](
+			httpClient,
+			baseURL+MemberServiceBulkCreateMembersProcedure,
+			connect.WithSchema(memberServiceMethods.ByName("BulkCreateMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkUpdateMemberRoles: connect.NewClient[v1.BulkUpdateMemberRolesRequest, v1.BulkUpdateMemberRolesResponse](
+			httpClient,
+			baseURL+MemberServiceBulkUpdateMemberRolesProcedure,
+			connect.WithSchema(memberServiceMethods.ByName("BulkUpdateMemberRoles")),
+			connect.WithClientOptions(opts...),
+		),
+		bulkRemoveMembers: connect.NewClient[v1.BulkRemoveMembersRequest, v1.BulkRemoveMembersResponse](
+			httpClient,
+			baseURL+MemberServiceBulkRemoveMembersProcedure,
+			connect.WithSchema(memberServiceMethods.ByName("BulkRemoveMembers")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -1252,6 +1682,9 @@ type memberServiceClient struct {
 	createOrganizationMember *connect.Client[v1.CreateOrganizationMemberRequest, v1.CreateOrganizationMemberResponse]
 	updateOrganizationMember *connect.Client[v1.UpdateOrganizationMemberRequest, v1.UpdateOrganizationMemberResponse]
 	deleteOrganizationMember *connect.Client[v1.DeleteOrganizationMemberRequest, emptypb.Empty]
+	bulkCreateMembers        *connect.Client[v1.BulkCreateMembersRequest, v1.BulkCreateMembersResponse]
+	bulkUpdateMemberRoles    *connect.Client[v1.BulkUpdateMemberRolesRequest, v1.BulkUpdateMemberRolesResponse]
+	bulkRemoveMembers        *connect.Client[v1.BulkRemoveMembersRequest, v1.BulkRemoveMembersResponse]
 }
 
 // ListOrganizationMembers calls libops.v1.MemberService.ListOrganizationMembers.
@@ -1274,6 +1707,21 @@ func (c *memberServiceClient) DeleteOrganizationMember(ctx context.Context, req
 	return c.deleteOrganizationMember.CallUnary(ctx, req)
 }
 
+// BulkCreateMembers calls libops.v1.MemberService.BulkCreateMembers.
+func (c *memberServiceClient) BulkCreateMembers(ctx context.Context, req *connect.Request[v1.BulkCreateMembersRequest]) (*connect.Response[v1.BulkCreateMembersResponse], error) {
+	return c.bulkCreateMembers.CallUnary(ctx, req)
+}
+
+// BulkUpdateMemberRoles calls libops.v1.MemberService.BulkUpdateMemberRoles.
+func (c *memberServiceClient) BulkUpdateMemberRoles(ctx context.Context, req *connect.Request[v1.BulkUpdateMemberRolesRequest]) (*connect.Response[v1.BulkUpdateMemberRolesResponse], error) {
+	return c.bulkUpdateMemberRoles.CallUnary(ctx, req)
+}
+
+// BulkRemoveMembers calls libops.v1.MemberService.BulkRemoveMembers.
+func (c *memberServiceClient) BulkRemoveMembers(ctx context.Context, req *connect.Request[v1.BulkRemoveMembersRequest]) (*connect.Response[v1.BulkRemoveMembersResponse], error) {
+	return c.bulkRemoveMembers.CallUnary(ctx, req)
+}
+
 // MemberServiceHandler is an implementation of the libops.v1.MemberService service.
 type MemberServiceHandler interface {
 	// List members of a organization
@@ -1284,6 +1732,13 @@ type MemberServiceHandler interface {
 	UpdateOrganizationMember(context.Context, *connect.Request[v1.UpdateOrganizationMemberRequest]) (*connect.Response[v1.UpdateOrganizationMemberResponse], error)
 	// Delete a member from a organization
 	DeleteOrganizationMember(context.Context, *connect.Request[v1.DeleteOrganizationMemberRequest]) (*connect.Response[emptypb.Empty], error)
+	// Add up to 500 people to a organization at once. Each entry succeeds or
+	// fails on its own and has a result in the same position
+	BulkCreateMembers(context.Context, *connect.Request[v1.BulkCreateMembersRequest]) (*connect.Response[v1.BulkCreateMembersResponse], error)
+	// Change the role of up to 500 members at once, with a result per entry
+	BulkUpdateMemberRoles(context.Context, *connect.Request[v1.BulkUpdateMemberRolesRequest]) (*connect.Response[v1.BulkUpdateMemberRolesResponse], error)
+	// Remove up to 500 members at once, with a result per entry
+	BulkRemoveMembers(context.Context, *connect.Request[v1.BulkRemoveMembersRequest]) (*connect.Response[v1.BulkRemoveMembersResponse], error)
 }
 
 // NewMemberServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1318,6 +1773,24 @@ func NewMemberServiceHandler(svc MemberServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(memberServiceMethods.ByName("DeleteOrganizationMember")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memberServiceBulkCreateMembersHandler := connect.NewUnaryHandler(
+		MemberServiceBulkCreateMembersProcedure,
+		svc.BulkCreateMembers,
+		connect.WithSchema(memberServiceMethods.ByName("BulkCreateMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memberServiceBulkUpdateMemberRolesHandler := connect.NewUnaryHandler(
+		MemberServiceBulkUpdateMemberRolesProcedure,
+		svc.BulkUpdateMemberRoles,
+		connect.WithSchema(memberServiceMethods.ByName("BulkUpdateMemberRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memberServiceBulkRemoveMembersHandler := connect.NewUnaryHandler(
+		MemberServiceBulkRemoveMembersProcedure,
+		svc.BulkRemoveMembers,
+		connect.WithSchema(memberServiceMethods.ByName("BulkRemoveMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.MemberService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MemberServiceListOrganizationMembersProcedure:
@@ -1328,6 +1801,12 @@ func NewMemberServiceHandler(svc MemberServiceHandler, opts ...connect.HandlerOp
 			memberServiceUpdateOrganizationMemberHandler.ServeHTTP(w, r)
 		case MemberServiceDeleteOrganizationMemberProcedure:
 			memberServiceDeleteOrganizationMemberHandler.ServeHTTP(w, r)
+		case MemberServiceBulkCreateMembersProcedure:
+			memberServiceBulkCreateMembersHandler.ServeHTTP(w, r)
+		case MemberServiceBulkUpdateMemberRolesProcedure:
+			memberServiceBulkUpdateMemberRolesHandler.ServeHTTP(w, r)
+		case MemberServiceBulkRemoveMembersProcedure:
+			memberServiceBulkRemoveMembersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1353,6 +1832,18 @@ func (UnimplementedMemberServiceHandler) DeleteOrganizationMember(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.MemberService.DeleteOrganizationMember is not implemented"))
 }
 
+func (UnimplementedMemberServiceHandler) BulkCreateMembers(context.Context, *connect.Request[v1.BulkCreateMembersRequest]) (*connect.Response[v1.BulkCreateMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.MemberService.BulkCreateMembers is not implemented"))
+}
+
+func (UnimplementedMemberServiceHandler) BulkUpdateMemberRoles(context.Context, *connect.Request[v1.BulkUpdateMemberRolesRequest]) (*connect.Response[v1.BulkUpdateMemberRolesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.MemberService.BulkUpdateMemberRoles is not implemented"))
+}
+
+func (UnimplementedMemberServiceHandler) BulkRemoveMembers(context.Context, *connect.Request[v1.BulkRemoveMembersRequest]) (*connect.Response[v1.BulkRemoveMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.MemberService.BulkRemoveMembers is not implemented"))
+}
+
 // ProjectMemberServiceClient is a client for the libops.v1.ProjectMemberService service.
 type ProjectMemberServiceClient interface {
 	// List members of a project
@@ -1805,6 +2296,10 @@ type SiteOperationsServiceClient interface {
 	GetSiteStatus(context.Context, *connect.Request[v1.GetSiteStatusRequest]) (*connect.Response[v1.GetSiteStatusResponse], error)
 	// Deploy a site
 	DeploySite(context.Context, *connect.Request[v1.DeploySiteRequest]) (*connect.Response[v1.DeploySiteResponse], error)
+	// List a site's deployments, newest first
+	ListSiteDeployments(context.Context, *connect.Request[v1.ListSiteDeploymentsRequest]) (*connect.Response[v1.ListSiteDeploymentsResponse], error)
+	// Roll a site back by redeploying the commit of an earlier successful deployment
+	RollbackSite(context.Context, *connect.Request[v1.RollbackSiteRequest]) (*connect.Response[v1.RollbackSiteResponse], error)
 }
 
 // NewSiteOperationsServiceClient constructs a client for the libops.v1.SiteOperationsService
@@ -1831,13 +2326,28 @@ func NewSiteOperationsServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(siteOperationsServiceMethods.ByName("DeploySite")),
 			connect.WithClientOptions(opts...),
 		),
+		listSiteDeployments: connect.NewClient[v1.ListSiteDeploymentsRequest, v1.ListSiteDeploymentsResponse](
+			httpClient,
+			baseURL+SiteOperationsServiceListSiteDeploymentsProcedure,
+			connect.WithSchema(siteOperationsServiceMethods.ByName("ListSiteDeployments")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackSite: connect.NewClient[v1.RollbackSiteRequest, v1.RollbackSiteResponse](
+			httpClient,
+			baseURL+SiteOperationsServiceRollbackSiteProcedure,
+			connect.WithSchema(siteOperationsServiceMethods.ByName("RollbackSite")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // siteOperationsServiceClient implements SiteOperationsServiceClient.
 type siteOperationsServiceClient struct {
-	getSiteStatus *connect.Client[v1.GetSiteStatusRequest, v1.GetSiteStatusResponse]
-	deploySite    *connect.Client[v1.DeploySiteRequest, v1.DeploySiteResponse]
+	getSiteStatus       *connect.Client[v1.GetSiteStatusRequest, v1.GetSiteStatusResponse]
+	deploySite          *connect.Client[v1.DeploySiteRequest, v1.DeploySiteResponse]
+	listSiteDeployments *connect.Client[v1.ListSiteDeploymentsRequest, v1.ListSiteDeploymentsResponse]
+	rollbackSite        *connect.Client[v1.RollbackSiteRequest, v1.RollbackSiteResponse]
 }
 
 // GetSiteStatus calls libops.v1.SiteOperationsService.GetSiteStatus.
@@ -1850,12 +2360,26 @@ func (c *siteOperationsServiceClient) DeploySite(ctx context.Context, req *conne
 	return c.deploySite.CallUnary(ctx, req)
 }
 
+// ListSiteDeployments calls libops.v1.SiteOperationsService.ListSiteDeployments.
+func (c *siteOperationsServiceClient) ListSiteDeployments(ctx context.Context, req *connect.Request[v1.ListSiteDeploymentsRequest]) (*connect.Response[v1.ListSiteDeploymentsResponse], error) {
+	return c.listSiteDeployments.CallUnary(ctx, req)
+}
+
+// RollbackSite calls libops.v1.SiteOperationsService.RollbackSite.
+func (c *siteOperationsServiceClient) RollbackSite(ctx context.Context, req *connect.Request[v1.RollbackSiteRequest]) (*connect.Response[v1.RollbackSiteResponse], error) {
+	return c.rollbackSite.CallUnary(ctx, req)
+}
+
 // SiteOperationsServiceHandler is an implementation of the libops.v1.SiteOperationsService service.
 type SiteOperationsServiceHandler interface {
 	// Get site deployment status
 	GetSiteStatus(context.Context, *connect.Request[v1.GetSiteStatusRequest]) (*connect.Response[v1.GetSiteStatusResponse], error)
 	// Deploy a site
 	DeploySite(context.Context, *connect.Request[v1.DeploySiteRequest]) (*connect.Response[v1.DeploySiteResponse], error)
+	// List a site's deployments, newest first
+	ListSiteDeployments(context.Context, *connect.Request[v1.ListSiteDeploymentsRequest]) (*connect.Response[v1.ListSiteDeploymentsResponse], error)
+	// Roll a site back by redeploying the commit of an earlier successful deployment
+	RollbackSite(context.Context, *connect.Request[v1.RollbackSiteRequest]) (*connect.Response[v1.RollbackSiteResponse], error)
 }
 
 // NewSiteOperationsServiceHandler builds an HTTP handler from the service implementation. It
@@ -1878,12 +2402,29 @@ func NewSiteOperationsServiceHandler(svc SiteOperationsServiceHandler, opts ...c
 		connect.WithSchema(siteOperationsServiceMethods.ByName("DeploySite")),
 		connect.WithHandlerOptions(opts...),
 	)
+	siteOperationsServiceListSiteDeploymentsHandler := connect.NewUnaryHandler(
+		SiteOperationsServiceListSiteDeploymentsProcedure,
+		svc.ListSiteDeployments,
+		connect.WithSchema(siteOperationsServiceMethods.ByName("ListSiteDeployments")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	siteOperationsServiceRollbackSiteHandler := connect.NewUnaryHandler(
+		SiteOperationsServiceRollbackSiteProcedure,
+		svc.RollbackSite,
+		connect.WithSchema(siteOperationsServiceMethods.ByName("RollbackSite")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.SiteOperationsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SiteOperationsServiceGetSiteStatusProcedure:
 			siteOperationsServiceGetSiteStatusHandler.ServeHTTP(w, r)
 		case SiteOperationsServiceDeploySiteProcedure:
 			siteOperationsServiceDeploySiteHandler.ServeHTTP(w, r)
+		case SiteOperationsServiceListSiteDeploymentsProcedure:
+			siteOperationsServiceListSiteDeploymentsHandler.ServeHTTP(w, r)
+		case SiteOperationsServiceRollbackSiteProcedure:
+			siteOperationsServiceRollbackSiteHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1900,3 +2441,11 @@ func (UnimplementedSiteOperationsServiceHandler) GetSiteStatus(context.Context,
 func (UnimplementedSiteOperationsServiceHandler) DeploySite(context.Context, *connect.Request[v1.DeploySiteRequest]) (*connect.Response[v1.DeploySiteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteOperationsService.DeploySite is not implemented"))
 }
+
+func (UnimplementedSiteOperationsServiceHandler) ListSiteDeployments(context.Context, *connect.Request[v1.ListSiteDeploymentsRequest]) (*connect.Response[v1.ListSiteDeploymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteOperationsService.ListSiteDeployments is not implemented"))
+}
+
+func (UnimplementedSiteOperationsServiceHandler) RollbackSite(context.Context, *connect.Request[v1.RollbackSiteRequest]) (*connect.Response[v1.RollbackSiteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.SiteOperationsService.RollbackSite is not implemented"))
+}
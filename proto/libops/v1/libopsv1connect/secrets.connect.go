@@ -54,6 +54,9 @@ const (
 	// OrganizationSecretServiceDeleteOrganizationSecretProcedure is the fully-qualified name of the
 	// OrganizationSecretService's DeleteOrganizationSecret RPC.
 	OrganizationSecretServiceDeleteOrganizationSecretProcedure = "/libops.v1.OrganizationSecretService/DeleteOrganizationSecret"
+	// OrganizationSecretServiceListSecretAccessLogsProcedure is the fully-qualified name of the
+	// OrganizationSecretService's ListSecretAccessLogs RPC.
+	OrganizationSecretServiceListSecretAccessLogsProcedure = "/libops.v1.OrganizationSecretService/ListSecretAccessLogs"
 	// ProjectSecretServiceCreateProjectSecretProcedure is the fully-qualified name of the
 	// ProjectSecretService's CreateProjectSecret RPC.
 	ProjectSecretServiceCreateProjectSecretProcedure = "/libops.v1.ProjectSecretService/CreateProjectSecret"
@@ -98,6 +101,9 @@ type OrganizationSecretServiceClient interface {
 	UpdateOrganizationSecret(context.Context, *connect.Request[v1.UpdateOrganizationSecretRequest]) (*connect.Response[v1.UpdateOrganizationSecretResponse], error)
 	// Delete an organization secret
 	DeleteOrganizationSecret(context.Context, *connect.Request[v1.DeleteOrganizationSecretRequest]) (*connect.Response[emptypb.Empty], error)
+	// List who read the values of an organization's secrets, newest first.
+	// Unusual reads carry the reason they were flagged.
+	ListSecretAccessLogs(context.Context, *connect.Request[v1.ListSecretAccessLogsRequest]) (*connect.Response[v1.ListSecretAccessLogsResponse], error)
 }
 
 // NewOrganizationSecretServiceClient constructs a client for the
@@ -143,6 +149,13 @@ func NewOrganizationSecretServiceClient(httpClient connect.HTTPClient, baseURL s
 			connect.WithSchema(organizationSecretServiceMethods.ByName("DeleteOrganizationSecret")),
 			connect.WithClientOptions(opts...),
 		),
+		listSecretAccessLogs: connect.NewClient[v1.ListSecretAccessLogsRequest, v1.ListSecretAccessLogsResponse](
+			httpClient,
+			baseURL+OrganizationSecretServiceListSecretAccessLogsProcedure,
+			connect.WithSchema(organizationSecretServiceMethods.ByName("ListSecretAccessLogs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -153,6 +166,7 @@ type organizationSecretServiceClient struct {
 	listOrganizationSecrets  *connect.Client[v1.ListOrganizationSecretsRequest, v1.ListOrganizationSecretsResponse]
 	updateOrganizationSecret *connect.Client[v1.UpdateOrganizationSecretRequest, v1.UpdateOrganizationSecretResponse]
 	deleteOrganizationSecret *connect.Client[v1.DeleteOrganizationSecretRequest, emptypb.Empty]
+	listSecretAccessLogs     *connect.Client[v1.ListSecretAccessLogsRequest, v1.ListSecretAccessLogsResponse]
 }
 
 // CreateOrganizationSecret calls libops.v1.OrganizationSecretService.CreateOrganizationSecret.
@@ -180,6 +194,11 @@ func (c *organizationSecretServiceClient) DeleteOrganizationSecret(ctx context.C
 	return c.deleteOrganizationSecret.CallUnary(ctx, req)
 }
 
+// ListSecretAccessLogs calls libops.v1.OrganizationSecretService.ListSecretAccessLogs.
+func (c *organizationSecretServiceClient) ListSecretAccessLogs(ctx context.Context, req *connect.Request[v1.ListSecretAccessLogsRequest]) (*connect.Response[v1.ListSecretAccessLogsResponse], error) {
+	return c.listSecretAccessLogs.CallUnary(ctx, req)
+}
+
 // OrganizationSecretServiceHandler is an implementation of the libops.v1.OrganizationSecretService
 // service.
 type OrganizationSecretServiceHandler interface {
@@ -193,6 +212,9 @@ type OrganizationSecretServiceHandler interface {
 	UpdateOrganizationSecret(context.Context, *connect.Request[v1.UpdateOrganizationSecretRequest]) (*connect.Response[v1.UpdateOrganizationSecretResponse], error)
 	// Delete an organization secret
 	DeleteOrganizationSecret(context.Context, *connect.Request[v1.DeleteOrganizationSecretRequest]) (*connect.Response[emptypb.Empty], error)
+	// List who read the values of an organization's secrets, newest first.
+	// Unusual reads carry the reason they were flagged.
+	ListSecretAccessLogs(context.Context, *connect.Request[v1.ListSecretAccessLogsRequest]) (*connect.Response[v1.ListSecretAccessLogsResponse], error)
 }
 
 // NewOrganizationSecretServiceHandler builds an HTTP handler from the service implementation. It
@@ -234,6 +256,13 @@ func NewOrganizationSecretServiceHandler(svc OrganizationSecretServiceHandler, o
 		connect.WithSchema(organizationSecretServiceMethods.ByName("DeleteOrganizationSecret")),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationSecretServiceListSecretAccessLogsHandler := connect.NewUnaryHandler(
+		OrganizationSecretServiceListSecretAccessLogsProcedure,
+		svc.ListSecretAccessLogs,
+		connect.WithSchema(organizationSecretServiceMethods.ByName("ListSecretAccessLogs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/libops.v1.OrganizationSecretService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrganizationSecretServiceCreateOrganizationSecretProcedure:
@@ -246,6 +275,8 @@ func NewOrganizationSecretServiceHandler(svc OrganizationSecretServiceHandler, o
 			organizationSecretServiceUpdateOrganizationSecretHandler.ServeHTTP(w, r)
 		case OrganizationSecretServiceDeleteOrganizationSecretProcedure:
 			organizationSecretServiceDeleteOrganizationSecretHandler.ServeHTTP(w, r)
+		case OrganizationSecretServiceListSecretAccessLogsProcedure:
+			organizationSecretServiceListSecretAccessLogsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -275,6 +306,10 @@ func (UnimplementedOrganizationSecretServiceHandler) DeleteOrganizationSecret(co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationSecretService.DeleteOrganizationSecret is not implemented"))
 }
 
+func (UnimplementedOrganizationSecretServiceHandler) ListSecretAccessLogs(context.Context, *connect.Request[v1.ListSecretAccessLogsRequest]) (*connect.Response[v1.ListSecretAccessLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("libops.v1.OrganizationSecretService.ListSecretAccessLogs is not implemented"))
+}
+
 // ProjectSecretServiceClient is a client for the libops.v1.ProjectSecretService service.
 type ProjectSecretServiceClient interface {
 	// Create a project secret
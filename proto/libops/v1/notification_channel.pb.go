@@ -0,0 +1,1121 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/notification_channel.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationChannelKind int32
+
+const (
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_UNSPECIFIED   NotificationChannelKind = 0
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK NotificationChannelKind = 1 // Slack incoming webhook
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_SLACK_APP     NotificationChannelKind = 2 // Slack app bot token posting to a channel
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK NotificationChannelKind = 3 // Microsoft Teams incoming webhook
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_PAGERDUTY     NotificationChannelKind = 4 // PagerDuty Events API v2 integration, downtime only
+	NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_OPSGENIE      NotificationChannelKind = 5 // Opsgenie API integration, downtime only
+)
+
+// Enum value maps for NotificationChannelKind.
+var (
+	NotificationChannelKind_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_KIND_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK",
+		2: "NOTIFICATION_CHANNEL_KIND_SLACK_APP",
+		3: "NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK",
+		4: "NOTIFICATION_CHANNEL_KIND_PAGERDUTY",
+		5: "NOTIFICATION_CHANNEL_KIND_OPSGENIE",
+	}
+	NotificationChannelKind_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_KIND_UNSPECIFIED":   0,
+		"NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK": 1,
+		"NOTIFICATION_CHANNEL_KIND_SLACK_APP":     2,
+		"NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK": 3,
+		"NOTIFICATION_CHANNEL_KIND_PAGERDUTY":     4,
+		"NOTIFICATION_CHANNEL_KIND_OPSGENIE":      5,
+	}
+)
+
+func (x NotificationChannelKind) Enum() *NotificationChannelKind {
+	p := new(NotificationChannelKind)
+	*p = x
+	return p
+}
+
+func (x NotificationChannelKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannelKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_notification_channel_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationChannelKind) Type() protoreflect.EnumType {
+	return &file_libops_v1_notification_channel_proto_enumTypes[0]
+}
+
+func (x NotificationChannelKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannelKind.Descriptor instead.
+func (NotificationChannelKind) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{0}
+}
+
+// AlertCategory groups the events a notification channel can subscribe to
+type AlertCategory int32
+
+const (
+	AlertCategory_ALERT_CATEGORY_UNSPECIFIED             AlertCategory = 0
+	AlertCategory_ALERT_CATEGORY_DEPLOYMENTS             AlertCategory = 1 // Site deployments finishing or failing
+	AlertCategory_ALERT_CATEGORY_RECONCILIATION_FAILURES AlertCategory = 2 // Failed infrastructure reconciliations
+	AlertCategory_ALERT_CATEGORY_BILLING                 AlertCategory = 3 // Failed payments and billing state changes
+	AlertCategory_ALERT_CATEGORY_SECURITY                AlertCategory = 4 // Member, firewall and secret changes
+	AlertCategory_ALERT_CATEGORY_DOWNTIME                AlertCategory = 5 // Sites going down and recovering; opens PagerDuty and Opsgenie incidents
+)
+
+// Enum value maps for AlertCategory.
+var (
+	AlertCategory_name = map[int32]string{
+		0: "ALERT_CATEGORY_UNSPECIFIED",
+		1: "ALERT_CATEGORY_DEPLOYMENTS",
+		2: "ALERT_CATEGORY_RECONCILIATION_FAILURES",
+		3: "ALERT_CATEGORY_BILLING",
+		4: "ALERT_CATEGORY_SECURITY",
+		5: "ALERT_CATEGORY_DOWNTIME",
+	}
+	AlertCategory_value = map[string]int32{
+		"ALERT_CATEGORY_UNSPECIFIED":             0,
+		"ALERT_CATEGORY_DEPLOYMENTS":             1,
+		"ALERT_CATEGORY_RECONCILIATION_FAILURES": 2,
+		"ALERT_CATEGORY_BILLING":                 3,
+		"ALERT_CATEGORY_SECURITY":                4,
+		"ALERT_CATEGORY_DOWNTIME":                5,
+	}
+)
+
+func (x AlertCategory) Enum() *AlertCategory {
+	p := new(AlertCategory)
+	*p = x
+	return p
+}
+
+func (x AlertCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_notification_channel_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertCategory) Type() protoreflect.EnumType {
+	return &file_libops_v1_notification_channel_proto_enumTypes[1]
+}
+
+func (x AlertCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertCategory.Descriptor instead.
+func (AlertCategory) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{1}
+}
+
+type NotificationChannel struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	ChannelId      string                  `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                // UUID
+	OrganizationId string                  `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	Name           string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind           NotificationChannelKind `protobuf:"varint,4,opt,name=kind,proto3,enum=libops.v1.NotificationChannelKind" json:"kind,omitempty"`
+	// Where alerts go with credentials redacted, e.g. "hooks.slack.com/…9f2c", "#ops" or "PagerDuty …1a2b"
+	Destination    string          `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	Categories     []AlertCategory `protobuf:"varint,6,rep,packed,name=categories,proto3,enum=libops.v1.AlertCategory" json:"categories,omitempty"`
+	Enabled        bool            `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastDeliveryAt int64           `protobuf:"varint,8,opt,name=last_delivery_at,json=lastDeliveryAt,proto3" json:"last_delivery_at,omitempty"` // Unix timestamp of the last delivery attempt, 0 if none
+	LastError      string          `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`                   // Error from the most recent delivery, empty when it succeeded
+	CreatedAt      int64           `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                 // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotificationChannel) Reset() {
+	*x = NotificationChannel{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannel) ProtoMessage() {}
+
+func (x *NotificationChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannel.ProtoReflect.Descriptor instead.
+func (*NotificationChannel) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetKind() NotificationChannelKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_UNSPECIFIED
+}
+
+func (x *NotificationChannel) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetCategories() []AlertCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *NotificationChannel) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationChannel) GetLastDeliveryAt() int64 {
+	if x != nil {
+		return x.LastDeliveryAt
+	}
+	return 0
+}
+
+func (x *NotificationChannel) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListNotificationChannelsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListNotificationChannelsRequest) Reset() {
+	*x = ListNotificationChannelsRequest{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationChannelsRequest) ProtoMessage() {}
+
+func (x *ListNotificationChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotificationChannelsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListNotificationChannelsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationChannelsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNotificationChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*NotificationChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationChannelsResponse) Reset() {
+	*x = ListNotificationChannelsResponse{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationChannelsResponse) ProtoMessage() {}
+
+func (x *ListNotificationChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationChannelsResponse) GetChannels() []*NotificationChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ListNotificationChannelsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateNotificationChannelRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	OrganizationId string                  `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind           NotificationChannelKind `protobuf:"varint,3,opt,name=kind,proto3,enum=libops.v1.NotificationChannelKind" json:"kind,omitempty"`
+	// Required for SLACK_WEBHOOK and TEAMS_WEBHOOK
+	WebhookUrl string `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Required for SLACK_APP
+	SlackBotToken string `protobuf:"bytes,5,opt,name=slack_bot_token,json=slackBotToken,proto3" json:"slack_bot_token,omitempty"`
+	// Channel ID or name, required for SLACK_APP
+	SlackChannel string          `protobuf:"bytes,6,opt,name=slack_channel,json=slackChannel,proto3" json:"slack_channel,omitempty"`
+	Categories   []AlertCategory `protobuf:"varint,7,rep,packed,name=categories,proto3,enum=libops.v1.AlertCategory" json:"categories,omitempty"`
+	// PagerDuty routing key or Opsgenie API key, required for PAGERDUTY and OPSGENIE
+	IntegrationKey string `protobuf:"bytes,8,opt,name=integration_key,json=integrationKey,proto3" json:"integration_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateNotificationChannelRequest) Reset() {
+	*x = CreateNotificationChannelRequest{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationChannelRequest) ProtoMessage() {}
+
+func (x *CreateNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateNotificationChannelRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateNotificationChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNotificationChannelRequest) GetKind() NotificationChannelKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationChannelKind_NOTIFICATION_CHANNEL_KIND_UNSPECIFIED
+}
+
+func (x *CreateNotificationChannelRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *CreateNotificationChannelRequest) GetSlackBotToken() string {
+	if x != nil {
+		return x.SlackBotToken
+	}
+	return ""
+}
+
+func (x *CreateNotificationChannelRequest) GetSlackChannel() string {
+	if x != nil {
+		return x.SlackChannel
+	}
+	return ""
+}
+
+func (x *CreateNotificationChannelRequest) GetCategories() []AlertCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CreateNotificationChannelRequest) GetIntegrationKey() string {
+	if x != nil {
+		return x.IntegrationKey
+	}
+	return ""
+}
+
+type CreateNotificationChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotificationChannel   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationChannelResponse) Reset() {
+	*x = CreateNotificationChannelResponse{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationChannelResponse) ProtoMessage() {}
+
+func (x *CreateNotificationChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotificationChannelResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateNotificationChannelResponse) GetChannel() *NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateNotificationChannelRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name           *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	WebhookUrl     *string                `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3,oneof" json:"webhook_url,omitempty"`
+	SlackBotToken  *string                `protobuf:"bytes,5,opt,name=slack_bot_token,json=slackBotToken,proto3,oneof" json:"slack_bot_token,omitempty"`
+	SlackChannel   *string                `protobuf:"bytes,6,opt,name=slack_channel,json=slackChannel,proto3,oneof" json:"slack_channel,omitempty"`
+	Categories     []AlertCategory        `protobuf:"varint,7,rep,packed,name=categories,proto3,enum=libops.v1.AlertCategory" json:"categories,omitempty"`
+	Enabled        *bool                  `protobuf:"varint,8,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// Paths: name, webhook_url, slack_bot_token, slack_channel, integration_key, categories, enabled.
+	// Without a mask every set field is applied, and categories only when non-empty.
+	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,9,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	IntegrationKey *string                `protobuf:"bytes,10,opt,name=integration_key,json=integrationKey,proto3,oneof" json:"integration_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationChannelRequest) Reset() {
+	*x = UpdateNotificationChannelRequest{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationChannelRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateNotificationChannelRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetWebhookUrl() string {
+	if x != nil && x.WebhookUrl != nil {
+		return *x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetSlackBotToken() string {
+	if x != nil && x.SlackBotToken != nil {
+		return *x.SlackBotToken
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetSlackChannel() string {
+	if x != nil && x.SlackChannel != nil {
+		return *x.SlackChannel
+	}
+	return ""
+}
+
+func (x *UpdateNotificationChannelRequest) GetCategories() []AlertCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateNotificationChannelRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateNotificationChannelRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateNotificationChannelRequest) GetIntegrationKey() string {
+	if x != nil && x.IntegrationKey != nil {
+		return *x.IntegrationKey
+	}
+	return ""
+}
+
+type UpdateNotificationChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotificationChannel   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationChannelResponse) Reset() {
+	*x = UpdateNotificationChannelResponse{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationChannelResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationChannelResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateNotificationChannelResponse) GetChannel() *NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type DeleteNotificationChannelRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationChannelRequest) Reset() {
+	*x = DeleteNotificationChannelRequest{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationChannelRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteNotificationChannelRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteNotificationChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type TestNotificationChannelRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TestNotificationChannelRequest) Reset() {
+	*x = TestNotificationChannelRequest{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotificationChannelRequest) ProtoMessage() {}
+
+func (x *TestNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*TestNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestNotificationChannelRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TestNotificationChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type TestNotificationChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotificationChannel   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestNotificationChannelResponse) Reset() {
+	*x = TestNotificationChannelResponse{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotificationChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotificationChannelResponse) ProtoMessage() {}
+
+func (x *TestNotificationChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotificationChannelResponse.ProtoReflect.Descriptor instead.
+func (*TestNotificationChannelResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TestNotificationChannelResponse) GetChannel() *NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// ReconciliationFinishedEvent is emitted when a reconciliation run completes or fails,
+// so the event router can alert the organization's notification channels
+type ReconciliationFinishedEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RunId          string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RunType        string                 `protobuf:"bytes,2,opt,name=run_type,json=runType,proto3" json:"run_type,omitempty"` // "terraform" or "vm_config"
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                  // "completed" or "failed"
+	ErrorMessage   string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	ProjectId      string                 `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`                // UUID, empty for organization runs
+	SiteId         string                 `protobuf:"bytes,7,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                         // UUID, empty for organization and project runs
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReconciliationFinishedEvent) Reset() {
+	*x = ReconciliationFinishedEvent{}
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationFinishedEvent) ProtoMessage() {}
+
+func (x *ReconciliationFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_notification_channel_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationFinishedEvent.ProtoReflect.Descriptor instead.
+func (*ReconciliationFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_libops_v1_notification_channel_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReconciliationFinishedEvent) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetRunType() string {
+	if x != nil {
+		return x.RunType
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ReconciliationFinishedEvent) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+var File_libops_v1_notification_channel_proto protoreflect.FileDescriptor
+
+const file_libops_v1_notification_channel_proto_rawDesc = "" +
+	"\n" +
+	"$libops/v1/notification_channel.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\"\x87\x03\n" +
+	"\x13NotificationChannel\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x126\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\".libops.v1.NotificationChannelKindR\x04kind\x12 \n" +
+	"\vdestination\x18\x05 \x01(\tR\vdestination\x128\n" +
+	"\n" +
+	"categories\x18\x06 \x03(\x0e2\x18.libops.v1.AlertCategoryR\n" +
+	"categories\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12(\n" +
+	"\x10last_delivery_at\x18\b \x01(\x03R\x0elastDeliveryAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"\x86\x01\n" +
+	"\x1fListNotificationChannelsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x86\x01\n" +
+	" ListNotificationChannelsResponse\x12:\n" +
+	"\bchannels\x18\x01 \x03(\v2\x1e.libops.v1.NotificationChannelR\bchannels\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfa\x02\n" +
+	" CreateNotificationChannelRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\".libops.v1.NotificationChannelKindR\x04kind\x12%\n" +
+	"\vwebhook_url\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\n" +
+	"webhookUrl\x12,\n" +
+	"\x0fslack_bot_token\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\rslackBotToken\x12#\n" +
+	"\rslack_channel\x18\x06 \x01(\tR\fslackChannel\x128\n" +
+	"\n" +
+	"categories\x18\a \x03(\x0e2\x18.libops.v1.AlertCategoryR\n" +
+	"categories\x12-\n" +
+	"\x0fintegration_key\x18\b \x01(\tB\x04\x88\xb5\x18\x01R\x0eintegrationKey\"]\n" +
+	"!CreateNotificationChannelResponse\x128\n" +
+	"\achannel\x18\x01 \x01(\v2\x1e.libops.v1.NotificationChannelR\achannel\"\xb5\x04\n" +
+	" UpdateNotificationChannelRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12*\n" +
+	"\vwebhook_url\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x01R\n" +
+	"webhookUrl\x88\x01\x01\x121\n" +
+	"\x0fslack_bot_token\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01H\x02R\rslackBotToken\x88\x01\x01\x12(\n" +
+	"\rslack_channel\x18\x06 \x01(\tH\x03R\fslackChannel\x88\x01\x01\x128\n" +
+	"\n" +
+	"categories\x18\a \x03(\x0e2\x18.libops.v1.AlertCategoryR\n" +
+	"categories\x12\x1d\n" +
+	"\aenabled\x18\b \x01(\bH\x04R\aenabled\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\t \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x122\n" +
+	"\x0fintegration_key\x18\n" +
+	" \x01(\tB\x04\x88\xb5\x18\x01H\x05R\x0eintegrationKey\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_webhook_urlB\x12\n" +
+	"\x10_slack_bot_tokenB\x10\n" +
+	"\x0e_slack_channelB\n" +
+	"\n" +
+	"\b_enabledB\x12\n" +
+	"\x10_integration_key\"]\n" +
+	"!UpdateNotificationChannelResponse\x128\n" +
+	"\achannel\x18\x01 \x01(\v2\x1e.libops.v1.NotificationChannelR\achannel\"j\n" +
+	" DeleteNotificationChannelRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"h\n" +
+	"\x1eTestNotificationChannelRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\"[\n" +
+	"\x1fTestNotificationChannelResponse\x128\n" +
+	"\achannel\x18\x01 \x01(\v2\x1e.libops.v1.NotificationChannelR\achannel\"\xed\x01\n" +
+	"\x1bReconciliationFinishedEvent\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x19\n" +
+	"\brun_type\x18\x02 \x01(\tR\arunType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\x12\x17\n" +
+	"\asite_id\x18\a \x01(\tR\x06siteId*\x98\x02\n" +
+	"\x17NotificationChannelKind\x12)\n" +
+	"%NOTIFICATION_CHANNEL_KIND_UNSPECIFIED\x10\x00\x12+\n" +
+	"'NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK\x10\x01\x12'\n" +
+	"#NOTIFICATION_CHANNEL_KIND_SLACK_APP\x10\x02\x12+\n" +
+	"'NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK\x10\x03\x12'\n" +
+	"#NOTIFICATION_CHANNEL_KIND_PAGERDUTY\x10\x04\x12&\n" +
+	"\"NOTIFICATION_CHANNEL_KIND_OPSGENIE\x10\x05*\xd1\x01\n" +
+	"\rAlertCategory\x12\x1e\n" +
+	"\x1aALERT_CATEGORY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aALERT_CATEGORY_DEPLOYMENTS\x10\x01\x12*\n" +
+	"&ALERT_CATEGORY_RECONCILIATION_FAILURES\x10\x02\x12\x1a\n" +
+	"\x16ALERT_CATEGORY_BILLING\x10\x03\x12\x1b\n" +
+	"\x17ALERT_CATEGORY_SECURITY\x10\x04\x12\x1b\n" +
+	"\x17ALERT_CATEGORY_DOWNTIME\x10\x052\xc9\t\n" +
+	"\x1aNotificationChannelService\x12\xe6\x01\n" +
+	"\x18ListNotificationChannels\x12*.libops.v1.ListNotificationChannelsRequest\x1a+.libops.v1.ListNotificationChannelsResponse\"q\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02:\x128/v1/organizations/{organization_id}/notificationChannels\x90\x02\x01\x12\xec\x01\n" +
+	"\x19CreateNotificationChannel\x12+.libops.v1.CreateNotificationChannelRequest\x1a,.libops.v1.CreateNotificationChannelResponse\"t\x92\xb5\x18-\b\x03\x10\x02\x18\x01\"\x12write:organization2\x0forganization_id8\x03\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/organizations/{organization_id}/notificationChannels\x12\xf7\x01\n" +
+	"\x19UpdateNotificationChannel\x12+.libops.v1.UpdateNotificationChannelRequest\x1a,.libops.v1.UpdateNotificationChannelResponse\"\x7f\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02J:\x01*2E/v1/organizations/{organization_id}/notificationChannels/{channel_id}\x12\xde\x01\n" +
+	"\x19DeleteNotificationChannel\x12+.libops.v1.DeleteNotificationChannelRequest\x1a\x16.google.protobuf.Empty\"|\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02G*E/v1/organizations/{organization_id}/notificationChannels/{channel_id}\x12\xf7\x01\n" +
+	"\x17TestNotificationChannel\x12).libops.v1.TestNotificationChannelRequest\x1a*.libops.v1.TestNotificationChannelResponse\"\x84\x01\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02O:\x01*\"J/v1/organizations/{organization_id}/notificationChannels/{channel_id}:testB\x9e\x01\n" +
+	"\rcom.libops.v1B\x18NotificationChannelProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_notification_channel_proto_rawDescOnce sync.Once
+	file_libops_v1_notification_channel_proto_rawDescData []byte
+)
+
+func file_libops_v1_notification_channel_proto_rawDescGZIP() []byte {
+	file_libops_v1_notification_channel_proto_rawDescOnce.Do(func() {
+		file_libops_v1_notification_channel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_notification_channel_proto_rawDesc), len(file_libops_v1_notification_channel_proto_rawDesc)))
+	})
+	return file_libops_v1_notification_channel_proto_rawDescData
+}
+
+var file_libops_v1_notification_channel_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_notification_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_libops_v1_notification_channel_proto_goTypes = []any{
+	(NotificationChannelKind)(0),              // 0: libops.v1.NotificationChannelKind
+	(AlertCategory)(0),                        // 1: libops.v1.AlertCategory
+	(*NotificationChannel)(nil),               // 2: libops.v1.NotificationChannel
+	(*ListNotificationChannelsRequest)(nil),   // 3: libops.v1.ListNotificationChannelsRequest
+	(*ListNotificationChannelsResponse)(nil),  // 4: libops.v1.ListNotificationChannelsResponse
+	(*CreateNotificationChannelRequest)(nil),  // 5: libops.v1.CreateNotificationChannelRequest
+	(*CreateNotificationChannelResponse)(nil), // 6: libops.v1.CreateNotificationChannelResponse
+	(*UpdateNotificationChannelRequest)(nil),  // 7: libops.v1.UpdateNotificationChannelRequest
+	(*UpdateNotificationChannelResponse)(nil), // 8: libops.v1.UpdateNotificationChannelResponse
+	(*DeleteNotificationChannelRequest)(nil),  // 9: libops.v1.DeleteNotificationChannelRequest
+	(*TestNotificationChannelRequest)(nil),    // 10: libops.v1.TestNotificationChannelRequest
+	(*TestNotificationChannelResponse)(nil),   // 11: libops.v1.TestNotificationChannelResponse
+	(*ReconciliationFinishedEvent)(nil),       // 12: libops.v1.ReconciliationFinishedEvent
+	(*fieldmaskpb.FieldMask)(nil),             // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                     // 14: google.protobuf.Empty
+}
+var file_libops_v1_notification_channel_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.NotificationChannel.kind:type_name -> libops.v1.NotificationChannelKind
+	1,  // 1: libops.v1.NotificationChannel.categories:type_name -> libops.v1.AlertCategory
+	2,  // 2: libops.v1.ListNotificationChannelsResponse.channels:type_name -> libops.v1.NotificationChannel
+	0,  // 3: libops.v1.CreateNotificationChannelRequest.kind:type_name -> libops.v1.NotificationChannelKind
+	1,  // 4: libops.v1.CreateNotificationChannelRequest.categories:type_name -> libops.v1.AlertCategory
+	2,  // 5: libops.v1.CreateNotificationChannelResponse.channel:type_name -> libops.v1.NotificationChannel
+	1,  // 6: libops.v1.UpdateNotificationChannelRequest.categories:type_name -> libops.v1.AlertCategory
+	13, // 7: libops.v1.UpdateNotificationChannelRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 8: libops.v1.UpdateNotificationChannelResponse.channel:type_name -> libops.v1.NotificationChannel
+	2,  // 9: libops.v1.TestNotificationChannelResponse.channel:type_name -> libops.v1.NotificationChannel
+	3,  // 10: libops.v1.NotificationChannelService.ListNotificationChannels:input_type -> libops.v1.ListNotificationChannelsRequest
+	5,  // 11: libops.v1.NotificationChannelService.CreateNotificationChannel:input_type -> libops.v1.CreateNotificationChannelRequest
+	7,  // 12: libops.v1.NotificationChannelService.UpdateNotificationChannel:input_type -> libops.v1.UpdateNotificationChannelRequest
+	9,  // 13: libops.v1.NotificationChannelService.DeleteNotificationChannel:input_type -> libops.v1.DeleteNotificationChannelRequest
+	10, // 14: libops.v1.NotificationChannelService.TestNotificationChannel:input_type -> libops.v1.TestNotificationChannelRequest
+	4,  // 15: libops.v1.NotificationChannelService.ListNotificationChannels:output_type -> libops.v1.ListNotificationChannelsResponse
+	6,  // 16: libops.v1.NotificationChannelService.CreateNotificationChannel:output_type -> libops.v1.CreateNotificationChannelResponse
+	8,  // 17: libops.v1.NotificationChannelService.UpdateNotificationChannel:output_type -> libops.v1.UpdateNotificationChannelResponse
+	14, // 18: libops.v1.NotificationChannelService.DeleteNotificationChannel:output_type -> google.protobuf.Empty
+	11, // 19: libops.v1.NotificationChannelService.TestNotificationChannel:output_type -> libops.v1.TestNotificationChannelResponse
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_notification_channel_proto_init() }
+func file_libops_v1_notification_channel_proto_init() {
+	if File_libops_v1_notification_channel_proto != nil {
+		return
+	}
+	file_libops_v1_notification_channel_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_notification_channel_proto_rawDesc), len(file_libops_v1_notification_channel_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_notification_channel_proto_goTypes,
+		DependencyIndexes: file_libops_v1_notification_channel_proto_depIdxs,
+		EnumInfos:         file_libops_v1_notification_channel_proto_enumTypes,
+		MessageInfos:      file_libops_v1_notification_channel_proto_msgTypes,
+	}.Build()
+	File_libops_v1_notification_channel_proto = out.File
+	file_libops_v1_notification_channel_proto_goTypes = nil
+	file_libops_v1_notification_channel_proto_depIdxs = nil
+}
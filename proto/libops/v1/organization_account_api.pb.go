@@ -9,9 +9,11 @@ package libopsv1
 import (
 	common "github.com/libops/api/proto/libops/v1/common"
 	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/descriptorpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -190,13 +192,14 @@ func (x *GetAccountByEmailResponse) GetAccount() *OrganizationAccount {
 
 type ApiKeyMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`        // UUID of the API key
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // User-friendly name
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                    // Optional description
-	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                              // Scope restrictions (empty = no restrictions)
-	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`                             // Whether the key is active
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // Unix timestamp
-	LastUsedAt    int64                  `protobuf:"varint,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Unix timestamp (0 if never used)
+	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`           // UUID of the API key
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                     // User-friendly name
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                       // Optional description
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                                 // Scope restrictions (empty = no restrictions)
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`                                // Whether the key is active
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	LastUsedAt    int64                  `protobuf:"varint,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`    // Unix timestamp (0 if never used)
+	AllowedCidrs  []string               `protobuf:"bytes,8,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"` // Networks the key can be used from (empty = anywhere)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -280,11 +283,20 @@ func (x *ApiKeyMetadata) GetLastUsedAt() int64 {
 	return 0
 }
 
+func (x *ApiKeyMetadata) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
 type CreateApiKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // User-friendly name for the key
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // Optional description
-	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`           // Optional scope restrictions (e.g., ["read:organization", "write:project"])
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // User-friendly name for the key
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                       // Optional description
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                                 // Optional scope restrictions (e.g., ["read:organization", "write:project"])
+	AllowedCidrs  []string               `protobuf:"bytes,4,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"` // Optional networks the key can be used from (e.g., ["192.0.2.0/24"])
+	Template      string                 `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`                             // Optional template whose scopes the key gets instead of scopes (e.g., "deploy")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -340,6 +352,20 @@ func (x *CreateApiKeyRequest) GetScopes() []string {
 	return nil
 }
 
+func (x *CreateApiKeyRequest) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
 type CreateApiKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKeyId      string                 `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"` // UUID of the created key
@@ -348,6 +374,7 @@ type CreateApiKeyResponse struct {
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AllowedCidrs  []string               `protobuf:"bytes,7,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -424,6 +451,162 @@ func (x *CreateApiKeyResponse) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *CreateApiKeyResponse) GetAllowedCidrs() []string {
+	if x != nil {
+		return x.AllowedCidrs
+	}
+	return nil
+}
+
+// ApiKeyTemplate is a vetted set of scopes for a common use of an API key
+type ApiKeyTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // Passed as CreateApiKeyRequest.template (e.g., "deploy")
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // Display name (e.g., "Deploy only")
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // What keys created from it can do
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                           // Scopes keys created from it get
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyTemplate) Reset() {
+	*x = ApiKeyTemplate{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyTemplate) ProtoMessage() {}
+
+func (x *ApiKeyTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyTemplate.ProtoReflect.Descriptor instead.
+func (*ApiKeyTemplate) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApiKeyTemplate) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ApiKeyTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKeyTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ApiKeyTemplate) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ListApiKeyTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyTemplatesRequest) Reset() {
+	*x = ListApiKeyTemplatesRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyTemplatesRequest) ProtoMessage() {}
+
+func (x *ListApiKeyTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeyTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{7}
+}
+
+type ListApiKeyTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ApiKeyTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // Least privileged first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeyTemplatesResponse) Reset() {
+	*x = ListApiKeyTemplatesResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeyTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeyTemplatesResponse) ProtoMessage() {}
+
+func (x *ListApiKeyTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeyTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeyTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListApiKeyTemplatesResponse) GetTemplates() []*ApiKeyTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
 type ListApiKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -434,7 +617,7 @@ type ListApiKeysRequest struct {
 
 func (x *ListApiKeysRequest) Reset() {
 	*x = ListApiKeysRequest{}
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[6]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +629,7 @@ func (x *ListApiKeysRequest) String() string {
 func (*ListApiKeysRequest) ProtoMessage() {}
 
 func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[6]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +642,7 @@ func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
 func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{6}
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListApiKeysRequest) GetPageSize() int32 {
@@ -486,7 +669,7 @@ type ListApiKeysResponse struct {
 
 func (x *ListApiKeysResponse) Reset() {
 	*x = ListApiKeysResponse{}
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[7]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -498,7 +681,7 @@ func (x *ListApiKeysResponse) String() string {
 func (*ListApiKeysResponse) ProtoMessage() {}
 
 func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[7]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,7 +694,7 @@ func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
 func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{7}
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListApiKeysResponse) GetApiKeys() []*ApiKeyMetadata {
@@ -537,7 +720,7 @@ type RevokeApiKeyRequest struct {
 
 func (x *RevokeApiKeyRequest) Reset() {
 	*x = RevokeApiKeyRequest{}
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[8]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +732,7 @@ func (x *RevokeApiKeyRequest) String() string {
 func (*RevokeApiKeyRequest) ProtoMessage() {}
 
 func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[8]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +745,7 @@ func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
 func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{8}
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RevokeApiKeyRequest) GetApiKeyId() string {
@@ -581,7 +764,7 @@ type RevokeApiKeyResponse struct {
 
 func (x *RevokeApiKeyResponse) Reset() {
 	*x = RevokeApiKeyResponse{}
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[9]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +776,7 @@ func (x *RevokeApiKeyResponse) String() string {
 func (*RevokeApiKeyResponse) ProtoMessage() {}
 
 func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_account_api_proto_msgTypes[9]
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +789,7 @@ func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
 func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{9}
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RevokeApiKeyResponse) GetSuccess() bool {
@@ -616,66 +799,878 @@ func (x *RevokeApiKeyResponse) GetSuccess() bool {
 	return false
 }
 
-var File_libops_v1_organization_account_api_proto protoreflect.FileDescriptor
+type AccountPreferences struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Theme                 string                 `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`                                                                // "system" (follow the browser), "light" or "dark"
+	Timezone              string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                          // IANA time zone timestamps are shown in, e.g. "America/New_York"
+	DefaultOrganizationId string                 `protobuf:"bytes,3,opt,name=default_organization_id,json=defaultOrganizationId,proto3" json:"default_organization_id,omitempty"` // Organization preselected in forms (UUID), empty for none
+	PageSize              int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                         // Rows per page in dashboard tables, 10 to 100
+	Locale                string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`                                                              // Dashboard language, e.g. "fr"; empty follows the browser
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
 
-const file_libops_v1_organization_account_api_proto_rawDesc = "" +
-	"\n" +
-	"(libops/v1/organization_account_api.proto\x12\tlibops.v1\x1a google/protobuf/descriptor.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xb9\x01\n" +
-	"\x13OrganizationAccount\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12=\n" +
-	"\vauth_method\x18\x04 \x01(\x0e2\x1c.libops.v1.common.AuthMethodR\n" +
-	"authMethod\x12\x1a\n" +
-	"\bverified\x18\x05 \x01(\bR\bverified\"0\n" +
-	"\x18GetAccountByEmailRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"U\n" +
-	"\x19GetAccountByEmailResponse\x128\n" +
-	"\aaccount\x18\x01 \x01(\v2\x1e.libops.v1.OrganizationAccountR\aaccount\"\xd5\x01\n" +
-	"\x0eApiKeyMetadata\x12\x1c\n" +
-	"\n" +
-	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x16\n" +
-	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12 \n" +
-	"\flast_used_at\x18\a \x01(\x03R\n" +
-	"lastUsedAt\"c\n" +
-	"\x13CreateApiKeyRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\xba\x01\n" +
-	"\x14CreateApiKeyResponse\x12\x1c\n" +
-	"\n" +
-	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
-	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"P\n" +
-	"\x12ListApiKeysRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"s\n" +
-	"\x13ListApiKeysResponse\x124\n" +
-	"\bapi_keys\x18\x01 \x03(\v2\x19.libops.v1.ApiKeyMetadataR\aapiKeys\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
-	"\x13RevokeApiKeyRequest\x12\x1c\n" +
-	"\n" +
-	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"0\n" +
-	"\x14RevokeApiKeyResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xbe\x03\n" +
-	"\x0eAccountService\x12x\n" +
-	"\x11GetAccountByEmail\x12#.libops.v1.GetAccountByEmailRequest\x1a$.libops.v1.GetAccountByEmailResponse\"\x18\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:user\x90\x02\x01\x12e\n" +
-	"\fCreateApiKey\x12\x1e.libops.v1.CreateApiKeyRequest\x1a\x1f.libops.v1.CreateApiKeyResponse\"\x14\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
-	"write:user\x12d\n" +
-	"\vListApiKeys\x12\x1d.libops.v1.ListApiKeysRequest\x1a\x1e.libops.v1.ListApiKeysResponse\"\x16\x92\xb5\x18\x0f\b\x02\x10\x01\"\tread:user\x90\x02\x01\x12e\n" +
-	"\fRevokeApiKey\x12\x1e.libops.v1.RevokeApiKeyRequest\x1a\x1f.libops.v1.RevokeApiKeyResponse\"\x14\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
-	"write:userB\xa1\x01\n" +
+func (x *AccountPreferences) Reset() {
+	*x = AccountPreferences{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountPreferences) ProtoMessage() {}
+
+func (x *AccountPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountPreferences.ProtoReflect.Descriptor instead.
+func (*AccountPreferences) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountPreferences) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *AccountPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AccountPreferences) GetDefaultOrganizationId() string {
+	if x != nil {
+		return x.DefaultOrganizationId
+	}
+	return ""
+}
+
+func (x *AccountPreferences) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AccountPreferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetAccountPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountPreferencesRequest) Reset() {
+	*x = GetAccountPreferencesRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPreferencesRequest) ProtoMessage() {}
+
+func (x *GetAccountPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{14}
+}
+
+type GetAccountPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *AccountPreferences    `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountPreferencesResponse) Reset() {
+	*x = GetAccountPreferencesResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPreferencesResponse) ProtoMessage() {}
+
+func (x *GetAccountPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAccountPreferencesResponse) GetPreferences() *AccountPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateAccountPreferencesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Theme                 *string                `protobuf:"bytes,1,opt,name=theme,proto3,oneof" json:"theme,omitempty"`
+	Timezone              *string                `protobuf:"bytes,2,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	DefaultOrganizationId *string                `protobuf:"bytes,3,opt,name=default_organization_id,json=defaultOrganizationId,proto3,oneof" json:"default_organization_id,omitempty"` // Empty clears it
+	PageSize              *int32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Paths: theme, timezone, default_organization_id, page_size, locale.
+	// Without a mask every set field is applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Locale        *string                `protobuf:"bytes,6,opt,name=locale,proto3,oneof" json:"locale,omitempty"` // Empty follows the browser
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountPreferencesRequest) Reset() {
+	*x = UpdateAccountPreferencesRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateAccountPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateAccountPreferencesRequest) GetTheme() string {
+	if x != nil && x.Theme != nil {
+		return *x.Theme
+	}
+	return ""
+}
+
+func (x *UpdateAccountPreferencesRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateAccountPreferencesRequest) GetDefaultOrganizationId() string {
+	if x != nil && x.DefaultOrganizationId != nil {
+		return *x.DefaultOrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateAccountPreferencesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *UpdateAccountPreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateAccountPreferencesRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type UpdateAccountPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *AccountPreferences    `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountPreferencesResponse) Reset() {
+	*x = UpdateAccountPreferencesResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateAccountPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateAccountPreferencesResponse) GetPreferences() *AccountPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type AccountProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Unique account identifier (UUID)
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                          // Sign-in address, not editable here
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Display name shown instead of the email address
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // Signed URL valid for an hour, empty without an avatar
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                    // Same as the time zone preference
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`                          // Contact number in E.164 format, e.g. "+15551234567"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountProfile) Reset() {
+	*x = AccountProfile{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountProfile) ProtoMessage() {}
+
+func (x *AccountProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountProfile.ProtoReflect.Descriptor instead.
+func (*AccountProfile) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountProfile) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AccountProfile) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type GetAccountProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountProfileRequest) Reset() {
+	*x = GetAccountProfileRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProfileRequest) ProtoMessage() {}
+
+func (x *GetAccountProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountProfileRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{19}
+}
+
+type GetAccountProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *AccountProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountProfileResponse) Reset() {
+	*x = GetAccountProfileResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountProfileResponse) ProtoMessage() {}
+
+func (x *GetAccountProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountProfileResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAccountProfileResponse) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateAccountProfileRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"` // At most 100 characters, empty clears it
+	Timezone *string                `protobuf:"bytes,2,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	Phone    *string                `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"` // Empty clears it
+	// Paths: name, timezone, phone.
+	// Without a mask every set field is applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountProfileRequest) Reset() {
+	*x = UpdateAccountProfileRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountProfileRequest) ProtoMessage() {}
+
+func (x *UpdateAccountProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountProfileRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateAccountProfileRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAccountProfileRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateAccountProfileRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateAccountProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateAccountProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *AccountProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountProfileResponse) Reset() {
+	*x = UpdateAccountProfileResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountProfileResponse) ProtoMessage() {}
+
+func (x *UpdateAccountProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountProfileResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateAccountProfileResponse) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UploadAccountAvatarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PNG, JPEG, GIF or WebP image of at most 1 MiB
+	Image         []byte `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAccountAvatarRequest) Reset() {
+	*x = UploadAccountAvatarRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAccountAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAccountAvatarRequest) ProtoMessage() {}
+
+func (x *UploadAccountAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAccountAvatarRequest.ProtoReflect.Descriptor instead.
+func (*UploadAccountAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadAccountAvatarRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type UploadAccountAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *AccountProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAccountAvatarResponse) Reset() {
+	*x = UploadAccountAvatarResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAccountAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAccountAvatarResponse) ProtoMessage() {}
+
+func (x *UploadAccountAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAccountAvatarResponse.ProtoReflect.Descriptor instead.
+func (*UploadAccountAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadAccountAvatarResponse) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DeleteAccountAvatarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountAvatarRequest) Reset() {
+	*x = DeleteAccountAvatarRequest{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountAvatarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountAvatarRequest) ProtoMessage() {}
+
+func (x *DeleteAccountAvatarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountAvatarRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountAvatarRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{25}
+}
+
+type DeleteAccountAvatarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *AccountProfile        `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountAvatarResponse) Reset() {
+	*x = DeleteAccountAvatarResponse{}
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountAvatarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountAvatarResponse) ProtoMessage() {}
+
+func (x *DeleteAccountAvatarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_account_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountAvatarResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountAvatarResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_account_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteAccountAvatarResponse) GetProfile() *AccountProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+var File_libops_v1_organization_account_api_proto protoreflect.FileDescriptor
+
+const file_libops_v1_organization_account_api_proto_rawDesc = "" +
+	"\n" +
+	"(libops/v1/organization_account_api.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/descriptor.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xb9\x01\n" +
+	"\x13OrganizationAccount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12=\n" +
+	"\vauth_method\x18\x04 \x01(\x0e2\x1c.libops.v1.common.AuthMethodR\n" +
+	"authMethod\x12\x1a\n" +
+	"\bverified\x18\x05 \x01(\bR\bverified\"0\n" +
+	"\x18GetAccountByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"U\n" +
+	"\x19GetAccountByEmailResponse\x128\n" +
+	"\aaccount\x18\x01 \x01(\v2\x1e.libops.v1.OrganizationAccountR\aaccount\"\xfa\x01\n" +
+	"\x0eApiKeyMetadata\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\a \x01(\x03R\n" +
+	"lastUsedAt\x12#\n" +
+	"\rallowed_cidrs\x18\b \x03(\tR\fallowedCidrs\"\xa4\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12#\n" +
+	"\rallowed_cidrs\x18\x04 \x03(\tR\fallowedCidrs\x12\x1a\n" +
+	"\btemplate\x18\x05 \x01(\tR\btemplate\"\xdf\x01\n" +
+	"\x14CreateApiKeyResponse\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12#\n" +
+	"\rallowed_cidrs\x18\a \x03(\tR\fallowedCidrs\"\x7f\n" +
+	"\x0eApiKeyTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\"\x1c\n" +
+	"\x1aListApiKeyTemplatesRequest\"V\n" +
+	"\x1bListApiKeyTemplatesResponse\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.libops.v1.ApiKeyTemplateR\ttemplates\"P\n" +
+	"\x12ListApiKeysRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"s\n" +
+	"\x13ListApiKeysResponse\x124\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x19.libops.v1.ApiKeyMetadataR\aapiKeys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"3\n" +
+	"\x13RevokeApiKeyRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\tR\bapiKeyId\"0\n" +
+	"\x14RevokeApiKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb3\x01\n" +
+	"\x12AccountPreferences\x12\x14\n" +
+	"\x05theme\x18\x01 \x01(\tR\x05theme\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x126\n" +
+	"\x17default_organization_id\x18\x03 \x01(\tR\x15defaultOrganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\"\x1e\n" +
+	"\x1cGetAccountPreferencesRequest\"`\n" +
+	"\x1dGetAccountPreferencesResponse\x12?\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1d.libops.v1.AccountPreferencesR\vpreferences\"\xe2\x02\n" +
+	"\x1fUpdateAccountPreferencesRequest\x12\x19\n" +
+	"\x05theme\x18\x01 \x01(\tH\x00R\x05theme\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x02 \x01(\tH\x01R\btimezone\x88\x01\x01\x12;\n" +
+	"\x17default_organization_id\x18\x03 \x01(\tH\x02R\x15defaultOrganizationId\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\x05H\x03R\bpageSize\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1b\n" +
+	"\x06locale\x18\x06 \x01(\tH\x04R\x06locale\x88\x01\x01B\b\n" +
+	"\x06_themeB\v\n" +
+	"\t_timezoneB\x1a\n" +
+	"\x18_default_organization_idB\f\n" +
+	"\n" +
+	"_page_sizeB\t\n" +
+	"\a_locale\"c\n" +
+	" UpdateAccountPreferencesResponse\x12?\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1d.libops.v1.AccountPreferencesR\vpreferences\"\xaa\x01\n" +
+	"\x0eAccountProfile\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\"\x1a\n" +
+	"\x18GetAccountProfileRequest\"P\n" +
+	"\x19GetAccountProfileResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.libops.v1.AccountProfileR\aprofile\"\xcf\x01\n" +
+	"\x1bUpdateAccountProfileRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\x02 \x01(\tH\x01R\btimezone\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x03 \x01(\tH\x02R\x05phone\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_timezoneB\b\n" +
+	"\x06_phone\"S\n" +
+	"\x1cUpdateAccountProfileResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.libops.v1.AccountProfileR\aprofile\"2\n" +
+	"\x1aUploadAccountAvatarRequest\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\fR\x05image\"R\n" +
+	"\x1bUploadAccountAvatarResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.libops.v1.AccountProfileR\aprofile\"\x1c\n" +
+	"\x1aDeleteAccountAvatarRequest\"R\n" +
+	"\x1bDeleteAccountAvatarResponse\x123\n" +
+	"\aprofile\x18\x01 \x01(\v2\x19.libops.v1.AccountProfileR\aprofile2\xa4\r\n" +
+	"\x0eAccountService\x12\x93\x01\n" +
+	"\x11GetAccountByEmail\x12#.libops.v1.GetAccountByEmailRequest\x1a$.libops.v1.GetAccountByEmailResponse\"3\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:user\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/accounts:lookup\x90\x02\x01\x12\x83\x01\n" +
+	"\fCreateApiKey\x12\x1e.libops.v1.CreateApiKeyRequest\x1a\x1f.libops.v1.CreateApiKeyResponse\"2\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/account/apiKeys\x12\x7f\n" +
+	"\vListApiKeys\x12\x1d.libops.v1.ListApiKeysRequest\x1a\x1e.libops.v1.ListApiKeysResponse\"1\x92\xb5\x18\x0f\b\x02\x10\x01\"\tread:user\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/account/apiKeys\x90\x02\x01\x12\x9f\x01\n" +
+	"\x13ListApiKeyTemplates\x12%.libops.v1.ListApiKeyTemplatesRequest\x1a&.libops.v1.ListApiKeyTemplatesResponse\"9\x92\xb5\x18\x0f\b\x02\x10\x01\"\tread:user\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/account/apiKeyTemplates\x90\x02\x01\x12\x8d\x01\n" +
+	"\fRevokeApiKey\x12\x1e.libops.v1.RevokeApiKeyRequest\x1a\x1f.libops.v1.RevokeApiKeyResponse\"<\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\"* /v1/account/apiKeys/{api_key_id}\x12\xa1\x01\n" +
+	"\x15GetAccountPreferences\x12'.libops.v1.GetAccountPreferencesRequest\x1a(.libops.v1.GetAccountPreferencesResponse\"5\x92\xb5\x18\x0f\b\x02\x10\x01\"\tread:user\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/account/preferences\x90\x02\x01\x12\xab\x01\n" +
+	"\x18UpdateAccountPreferences\x12*.libops.v1.UpdateAccountPreferencesRequest\x1a+.libops.v1.UpdateAccountPreferencesResponse\"6\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/account/preferences\x12\x91\x01\n" +
+	"\x11GetAccountProfile\x12#.libops.v1.GetAccountProfileRequest\x1a$.libops.v1.GetAccountProfileResponse\"1\x92\xb5\x18\x0f\b\x02\x10\x01\"\tread:user\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/account/profile\x90\x02\x01\x12\x9b\x01\n" +
+	"\x14UpdateAccountProfile\x12&.libops.v1.UpdateAccountProfileRequest\x1a'.libops.v1.UpdateAccountProfileResponse\"2\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/account/profile\x12\x9f\x01\n" +
+	"\x13UploadAccountAvatar\x12%.libops.v1.UploadAccountAvatarRequest\x1a&.libops.v1.UploadAccountAvatarResponse\"9\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/v1/account/profile/avatar\x12\x9c\x01\n" +
+	"\x13DeleteAccountAvatar\x12%.libops.v1.DeleteAccountAvatarRequest\x1a&.libops.v1.DeleteAccountAvatarResponse\"6\x92\xb5\x18\x10\b\x02\x10\x02\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/account/profile/avatarB\xa1\x01\n" +
 	"\rcom.libops.v1B\x1bOrganizationAccountApiProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -691,37 +1686,78 @@ func file_libops_v1_organization_account_api_proto_rawDescGZIP() []byte {
 	return file_libops_v1_organization_account_api_proto_rawDescData
 }
 
-var file_libops_v1_organization_account_api_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_libops_v1_organization_account_api_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_libops_v1_organization_account_api_proto_goTypes = []any{
-	(*OrganizationAccount)(nil),       // 0: libops.v1.OrganizationAccount
-	(*GetAccountByEmailRequest)(nil),  // 1: libops.v1.GetAccountByEmailRequest
-	(*GetAccountByEmailResponse)(nil), // 2: libops.v1.GetAccountByEmailResponse
-	(*ApiKeyMetadata)(nil),            // 3: libops.v1.ApiKeyMetadata
-	(*CreateApiKeyRequest)(nil),       // 4: libops.v1.CreateApiKeyRequest
-	(*CreateApiKeyResponse)(nil),      // 5: libops.v1.CreateApiKeyResponse
-	(*ListApiKeysRequest)(nil),        // 6: libops.v1.ListApiKeysRequest
-	(*ListApiKeysResponse)(nil),       // 7: libops.v1.ListApiKeysResponse
-	(*RevokeApiKeyRequest)(nil),       // 8: libops.v1.RevokeApiKeyRequest
-	(*RevokeApiKeyResponse)(nil),      // 9: libops.v1.RevokeApiKeyResponse
-	(common.AuthMethod)(0),            // 10: libops.v1.common.AuthMethod
+	(*OrganizationAccount)(nil),              // 0: libops.v1.OrganizationAccount
+	(*GetAccountByEmailRequest)(nil),         // 1: libops.v1.GetAccountByEmailRequest
+	(*GetAccountByEmailResponse)(nil),        // 2: libops.v1.GetAccountByEmailResponse
+	(*ApiKeyMetadata)(nil),                   // 3: libops.v1.ApiKeyMetadata
+	(*CreateApiKeyRequest)(nil),              // 4: libops.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),             // 5: libops.v1.CreateApiKeyResponse
+	(*ApiKeyTemplate)(nil),                   // 6: libops.v1.ApiKeyTemplate
+	(*ListApiKeyTemplatesRequest)(nil),       // 7: libops.v1.ListApiKeyTemplatesRequest
+	(*ListApiKeyTemplatesResponse)(nil),      // 8: libops.v1.ListApiKeyTemplatesResponse
+	(*ListApiKeysRequest)(nil),               // 9: libops.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),              // 10: libops.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),              // 11: libops.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),             // 12: libops.v1.RevokeApiKeyResponse
+	(*AccountPreferences)(nil),               // 13: libops.v1.AccountPreferences
+	(*GetAccountPreferencesRequest)(nil),     // 14: libops.v1.GetAccountPreferencesRequest
+	(*GetAccountPreferencesResponse)(nil),    // 15: libops.v1.GetAccountPreferencesResponse
+	(*UpdateAccountPreferencesRequest)(nil),  // 16: libops.v1.UpdateAccountPreferencesRequest
+	(*UpdateAccountPreferencesResponse)(nil), // 17: libops.v1.UpdateAccountPreferencesResponse
+	(*AccountProfile)(nil),                   // 18: libops.v1.AccountProfile
+	(*GetAccountProfileRequest)(nil),         // 19: libops.v1.GetAccountProfileRequest
+	(*GetAccountProfileResponse)(nil),        // 20: libops.v1.GetAccountProfileResponse
+	(*UpdateAccountProfileRequest)(nil),      // 21: libops.v1.UpdateAccountProfileRequest
+	(*UpdateAccountProfileResponse)(nil),     // 22: libops.v1.UpdateAccountProfileResponse
+	(*UploadAccountAvatarRequest)(nil),       // 23: libops.v1.UploadAccountAvatarRequest
+	(*UploadAccountAvatarResponse)(nil),      // 24: libops.v1.UploadAccountAvatarResponse
+	(*DeleteAccountAvatarRequest)(nil),       // 25: libops.v1.DeleteAccountAvatarRequest
+	(*DeleteAccountAvatarResponse)(nil),      // 26: libops.v1.DeleteAccountAvatarResponse
+	(common.AuthMethod)(0),                   // 27: libops.v1.common.AuthMethod
+	(*fieldmaskpb.FieldMask)(nil),            // 28: google.protobuf.FieldMask
 }
 var file_libops_v1_organization_account_api_proto_depIdxs = []int32{
-	10, // 0: libops.v1.OrganizationAccount.auth_method:type_name -> libops.v1.common.AuthMethod
+	27, // 0: libops.v1.OrganizationAccount.auth_method:type_name -> libops.v1.common.AuthMethod
 	0,  // 1: libops.v1.GetAccountByEmailResponse.account:type_name -> libops.v1.OrganizationAccount
-	3,  // 2: libops.v1.ListApiKeysResponse.api_keys:type_name -> libops.v1.ApiKeyMetadata
-	1,  // 3: libops.v1.AccountService.GetAccountByEmail:input_type -> libops.v1.GetAccountByEmailRequest
-	4,  // 4: libops.v1.AccountService.CreateApiKey:input_type -> libops.v1.CreateApiKeyRequest
-	6,  // 5: libops.v1.AccountService.ListApiKeys:input_type -> libops.v1.ListApiKeysRequest
-	8,  // 6: libops.v1.AccountService.RevokeApiKey:input_type -> libops.v1.RevokeApiKeyRequest
-	2,  // 7: libops.v1.AccountService.GetAccountByEmail:output_type -> libops.v1.GetAccountByEmailResponse
-	5,  // 8: libops.v1.AccountService.CreateApiKey:output_type -> libops.v1.CreateApiKeyResponse
-	7,  // 9: libops.v1.AccountService.ListApiKeys:output_type -> libops.v1.ListApiKeysResponse
-	9,  // 10: libops.v1.AccountService.RevokeApiKey:output_type -> libops.v1.RevokeApiKeyResponse
-	7,  // [7:11] is the sub-list for method output_type
-	3,  // [3:7] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	6,  // 2: libops.v1.ListApiKeyTemplatesResponse.templates:type_name -> libops.v1.ApiKeyTemplate
+	3,  // 3: libops.v1.ListApiKeysResponse.api_keys:type_name -> libops.v1.ApiKeyMetadata
+	13, // 4: libops.v1.GetAccountPreferencesResponse.preferences:type_name -> libops.v1.AccountPreferences
+	28, // 5: libops.v1.UpdateAccountPreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13, // 6: libops.v1.UpdateAccountPreferencesResponse.preferences:type_name -> libops.v1.AccountPreferences
+	18, // 7: libops.v1.GetAccountProfileResponse.profile:type_name -> libops.v1.AccountProfile
+	28, // 8: libops.v1.UpdateAccountProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	18, // 9: libops.v1.UpdateAccountProfileResponse.profile:type_name -> libops.v1.AccountProfile
+	18, // 10: libops.v1.UploadAccountAvatarResponse.profile:type_name -> libops.v1.AccountProfile
+	18, // 11: libops.v1.DeleteAccountAvatarResponse.profile:type_name -> libops.v1.AccountProfile
+	1,  // 12: libops.v1.AccountService.GetAccountByEmail:input_type -> libops.v1.GetAccountByEmailRequest
+	4,  // 13: libops.v1.AccountService.CreateApiKey:input_type -> libops.v1.CreateApiKeyRequest
+	9,  // 14: libops.v1.AccountService.ListApiKeys:input_type -> libops.v1.ListApiKeysRequest
+	7,  // 15: libops.v1.AccountService.ListApiKeyTemplates:input_type -> libops.v1.ListApiKeyTemplatesRequest
+	11, // 16: libops.v1.AccountService.RevokeApiKey:input_type -> libops.v1.RevokeApiKeyRequest
+	14, // 17: libops.v1.AccountService.GetAccountPreferences:input_type -> libops.v1.GetAccountPreferencesRequest
+	16, // 18: libops.v1.AccountService.UpdateAccountPreferences:input_type -> libops.v1.UpdateAccountPreferencesRequest
+	19, // 19: libops.v1.AccountService.GetAccountProfile:input_type -> libops.v1.GetAccountProfileRequest
+	21, // 20: libops.v1.AccountService.UpdateAccountProfile:input_type -> libops.v1.UpdateAccountProfileRequest
+	23, // 21: libops.v1.AccountService.UploadAccountAvatar:input_type -> libops.v1.UploadAccountAvatarRequest
+	25, // 22: libops.v1.AccountService.DeleteAccountAvatar:input_type -> libops.v1.DeleteAccountAvatarRequest
+	2,  // 23: libops.v1.AccountService.GetAccountByEmail:output_type -> libops.v1.GetAccountByEmailResponse
+	5,  // 24: libops.v1.AccountService.CreateApiKey:output_type -> libops.v1.CreateApiKeyResponse
+	10, // 25: libops.v1.AccountService.ListApiKeys:output_type -> libops.v1.ListApiKeysResponse
+	8,  // 26: libops.v1.AccountService.ListApiKeyTemplates:output_type -> libops.v1.ListApiKeyTemplatesResponse
+	12, // 27: libops.v1.AccountService.RevokeApiKey:output_type -> libops.v1.RevokeApiKeyResponse
+	15, // 28: libops.v1.AccountService.GetAccountPreferences:output_type -> libops.v1.GetAccountPreferencesResponse
+	17, // 29: libops.v1.AccountService.UpdateAccountPreferences:output_type -> libops.v1.UpdateAccountPreferencesResponse
+	20, // 30: libops.v1.AccountService.GetAccountProfile:output_type -> libops.v1.GetAccountProfileResponse
+	22, // 31: libops.v1.AccountService.UpdateAccountProfile:output_type -> libops.v1.UpdateAccountProfileResponse
+	24, // 32: libops.v1.AccountService.UploadAccountAvatar:output_type -> libops.v1.UploadAccountAvatarResponse
+	26, // 33: libops.v1.AccountService.DeleteAccountAvatar:output_type -> libops.v1.DeleteAccountAvatarResponse
+	23, // [23:34] is the sub-list for method output_type
+	12, // [12:23] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_organization_account_api_proto_init() }
@@ -729,13 +1765,15 @@ func file_libops_v1_organization_account_api_proto_init() {
 	if File_libops_v1_organization_account_api_proto != nil {
 		return
 	}
+	file_libops_v1_organization_account_api_proto_msgTypes[16].OneofWrappers = []any{}
+	file_libops_v1_organization_account_api_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_organization_account_api_proto_rawDesc), len(file_libops_v1_organization_account_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
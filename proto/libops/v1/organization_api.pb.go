@@ -9,6 +9,7 @@ package libopsv1
 import (
 	common "github.com/libops/api/proto/libops/v1/common"
 	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/descriptorpb"
@@ -78,6 +79,110 @@ func (FirewallRuleType) EnumDescriptor() ([]byte, []int) {
 	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{0}
 }
 
+type RateLimitWindow int32
+
+const (
+	RateLimitWindow_RATE_LIMIT_WINDOW_UNSPECIFIED RateLimitWindow = 0
+	RateLimitWindow_RATE_LIMIT_WINDOW_SECOND      RateLimitWindow = 1
+	RateLimitWindow_RATE_LIMIT_WINDOW_MINUTE      RateLimitWindow = 2
+)
+
+// Enum value maps for RateLimitWindow.
+var (
+	RateLimitWindow_name = map[int32]string{
+		0: "RATE_LIMIT_WINDOW_UNSPECIFIED",
+		1: "RATE_LIMIT_WINDOW_SECOND",
+		2: "RATE_LIMIT_WINDOW_MINUTE",
+	}
+	RateLimitWindow_value = map[string]int32{
+		"RATE_LIMIT_WINDOW_UNSPECIFIED": 0,
+		"RATE_LIMIT_WINDOW_SECOND":      1,
+		"RATE_LIMIT_WINDOW_MINUTE":      2,
+	}
+)
+
+func (x RateLimitWindow) Enum() *RateLimitWindow {
+	p := new(RateLimitWindow)
+	*p = x
+	return p
+}
+
+func (x RateLimitWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimitWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_organization_api_proto_enumTypes[1].Descriptor()
+}
+
+func (RateLimitWindow) Type() protoreflect.EnumType {
+	return &file_libops_v1_organization_api_proto_enumTypes[1]
+}
+
+func (x RateLimitWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateLimitWindow.Descriptor instead.
+func (RateLimitWindow) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{1}
+}
+
+type SiteComponentState int32
+
+const (
+	SiteComponentState_SITE_COMPONENT_STATE_UNSPECIFIED SiteComponentState = 0
+	SiteComponentState_SITE_COMPONENT_STATE_OK          SiteComponentState = 1
+	SiteComponentState_SITE_COMPONENT_STATE_WARNING     SiteComponentState = 2 // Working, but needs attention soon
+	SiteComponentState_SITE_COMPONENT_STATE_ERROR       SiteComponentState = 3
+	SiteComponentState_SITE_COMPONENT_STATE_UNKNOWN     SiteComponentState = 4 // Nothing reported to judge it by
+)
+
+// Enum value maps for SiteComponentState.
+var (
+	SiteComponentState_name = map[int32]string{
+		0: "SITE_COMPONENT_STATE_UNSPECIFIED",
+		1: "SITE_COMPONENT_STATE_OK",
+		2: "SITE_COMPONENT_STATE_WARNING",
+		3: "SITE_COMPONENT_STATE_ERROR",
+		4: "SITE_COMPONENT_STATE_UNKNOWN",
+	}
+	SiteComponentState_value = map[string]int32{
+		"SITE_COMPONENT_STATE_UNSPECIFIED": 0,
+		"SITE_COMPONENT_STATE_OK":          1,
+		"SITE_COMPONENT_STATE_WARNING":     2,
+		"SITE_COMPONENT_STATE_ERROR":       3,
+		"SITE_COMPONENT_STATE_UNKNOWN":     4,
+	}
+)
+
+func (x SiteComponentState) Enum() *SiteComponentState {
+	p := new(SiteComponentState)
+	*p = x
+	return p
+}
+
+func (x SiteComponentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SiteComponentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_organization_api_proto_enumTypes[2].Descriptor()
+}
+
+func (SiteComponentState) Type() protoreflect.EnumType {
+	return &file_libops_v1_organization_api_proto_enumTypes[2]
+}
+
+func (x SiteComponentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SiteComponentState.Descriptor instead.
+func (SiteComponentState) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{2}
+}
+
 type GetProjectRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -382,6 +487,129 @@ func (x *UpdateProjectResponse) GetProject() *common.ProjectConfig {
 	return nil
 }
 
+type ChangePlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// New machine type; empty keeps the current machine type
+	MachineType string `protobuf:"bytes,3,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	// New disk size in GB; 0 keeps the current size. Disks cannot shrink.
+	DiskSizeGb    int32 `protobuf:"varint,4,opt,name=disk_size_gb,json=diskSizeGb,proto3" json:"disk_size_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePlanRequest) Reset() {
+	*x = ChangePlanRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePlanRequest) ProtoMessage() {}
+
+func (x *ChangePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePlanRequest.ProtoReflect.Descriptor instead.
+func (*ChangePlanRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangePlanRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ChangePlanRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ChangePlanRequest) GetMachineType() string {
+	if x != nil {
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *ChangePlanRequest) GetDiskSizeGb() int32 {
+	if x != nil {
+		return x.DiskSizeGb
+	}
+	return 0
+}
+
+type ChangePlanResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Project *common.ProjectConfig  `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// Terraform reconciliation run that applies the resize
+	RunId         string `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePlanResponse) Reset() {
+	*x = ChangePlanResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePlanResponse) ProtoMessage() {}
+
+func (x *ChangePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePlanResponse.ProtoReflect.Descriptor instead.
+func (*ChangePlanResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangePlanResponse) GetProject() *common.ProjectConfig {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *ChangePlanResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
 type DeleteProjectRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -392,7 +620,7 @@ type DeleteProjectRequest struct {
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[6]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +632,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[6]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +645,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{6}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteProjectRequest) GetOrganizationId() string {
@@ -438,14 +666,18 @@ type ListProjectsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Continues after the last row of the previous page. Results are ordered
+	// newest first by creation time, then by ID, so rows created or deleted
+	// between requests don't shift pages.
+	PageToken     string  `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	LabelSelector *string `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // Comma-separated key=value pairs, e.g. "env=prod,department=library"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProjectsRequest) Reset() {
 	*x = ListProjectsRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[7]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +689,7 @@ func (x *ListProjectsRequest) String() string {
 func (*ListProjectsRequest) ProtoMessage() {}
 
 func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[7]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +702,7 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{7}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListProjectsRequest) GetOrganizationId() string {
@@ -494,6 +726,13 @@ func (x *ListProjectsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListProjectsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
 type ListProjectsResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Projects      []*common.ProjectConfig `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
@@ -504,7 +743,7 @@ type ListProjectsResponse struct {
 
 func (x *ListProjectsResponse) Reset() {
 	*x = ListProjectsResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[8]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +755,7 @@ func (x *ListProjectsResponse) String() string {
 func (*ListProjectsResponse) ProtoMessage() {}
 
 func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[8]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +768,7 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{8}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListProjectsResponse) GetProjects() []*common.ProjectConfig {
@@ -557,7 +796,7 @@ type ListProjectSitesRequest struct {
 
 func (x *ListProjectSitesRequest) Reset() {
 	*x = ListProjectSitesRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[9]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +808,7 @@ func (x *ListProjectSitesRequest) String() string {
 func (*ListProjectSitesRequest) ProtoMessage() {}
 
 func (x *ListProjectSitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[9]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +821,7 @@ func (x *ListProjectSitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectSitesRequest.ProtoReflect.Descriptor instead.
 func (*ListProjectSitesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{9}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListProjectSitesRequest) GetProjectId() string {
@@ -616,7 +855,7 @@ type ListProjectSitesResponse struct {
 
 func (x *ListProjectSitesResponse) Reset() {
 	*x = ListProjectSitesResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[10]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +867,7 @@ func (x *ListProjectSitesResponse) String() string {
 func (*ListProjectSitesResponse) ProtoMessage() {}
 
 func (x *ListProjectSitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[10]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +880,7 @@ func (x *ListProjectSitesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectSitesResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectSitesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{10}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListProjectSitesResponse) GetSiteNames() []string {
@@ -667,7 +906,7 @@ type GetOrganizationRequest struct {
 
 func (x *GetOrganizationRequest) Reset() {
 	*x = GetOrganizationRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[11]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +918,7 @@ func (x *GetOrganizationRequest) String() string {
 func (*GetOrganizationRequest) ProtoMessage() {}
 
 func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[11]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +931,7 @@ func (x *GetOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*GetOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{11}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetOrganizationRequest) GetOrganizationId() string {
@@ -711,7 +950,7 @@ type GetOrganizationResponse struct {
 
 func (x *GetOrganizationResponse) Reset() {
 	*x = GetOrganizationResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[12]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +962,7 @@ func (x *GetOrganizationResponse) String() string {
 func (*GetOrganizationResponse) ProtoMessage() {}
 
 func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[12]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +975,7 @@ func (x *GetOrganizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*GetOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{12}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetOrganizationResponse) GetFolder() *common.FolderConfig {
@@ -755,7 +994,7 @@ type CreateOrganizationRequest struct {
 
 func (x *CreateOrganizationRequest) Reset() {
 	*x = CreateOrganizationRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[13]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +1006,7 @@ func (x *CreateOrganizationRequest) String() string {
 func (*CreateOrganizationRequest) ProtoMessage() {}
 
 func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[13]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +1019,7 @@ func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{13}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateOrganizationRequest) GetFolder() *common.FolderConfig {
@@ -800,7 +1039,7 @@ type CreateOrganizationResponse struct {
 
 func (x *CreateOrganizationResponse) Reset() {
 	*x = CreateOrganizationResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[14]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +1051,7 @@ func (x *CreateOrganizationResponse) String() string {
 func (*CreateOrganizationResponse) ProtoMessage() {}
 
 func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[14]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1064,7 @@ func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{14}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateOrganizationResponse) GetOrganizationId() string {
@@ -853,7 +1092,7 @@ type UpdateOrganizationRequest struct {
 
 func (x *UpdateOrganizationRequest) Reset() {
 	*x = UpdateOrganizationRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[15]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +1104,7 @@ func (x *UpdateOrganizationRequest) String() string {
 func (*UpdateOrganizationRequest) ProtoMessage() {}
 
 func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[15]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1117,7 @@ func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{15}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateOrganizationRequest) GetOrganizationId() string {
@@ -911,7 +1150,7 @@ type UpdateOrganizationResponse struct {
 
 func (x *UpdateOrganizationResponse) Reset() {
 	*x = UpdateOrganizationResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[16]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -923,7 +1162,7 @@ func (x *UpdateOrganizationResponse) String() string {
 func (*UpdateOrganizationResponse) ProtoMessage() {}
 
 func (x *UpdateOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[16]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,7 +1175,7 @@ func (x *UpdateOrganizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{16}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateOrganizationResponse) GetFolder() *common.FolderConfig {
@@ -955,7 +1194,7 @@ type DeleteOrganizationRequest struct {
 
 func (x *DeleteOrganizationRequest) Reset() {
 	*x = DeleteOrganizationRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[17]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1206,7 @@ func (x *DeleteOrganizationRequest) String() string {
 func (*DeleteOrganizationRequest) ProtoMessage() {}
 
 func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[17]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1219,7 @@ func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{17}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteOrganizationRequest) GetOrganizationId() string {
@@ -991,16 +1230,19 @@ func (x *DeleteOrganizationRequest) GetOrganizationId() string {
 }
 
 type ListOrganizationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageSize int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Continues after the last row of the previous page. Results are ordered
+	// newest first by creation time, then by ID, so rows created or deleted
+	// between requests don't shift pages.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListOrganizationsRequest) Reset() {
 	*x = ListOrganizationsRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[18]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1254,7 @@ func (x *ListOrganizationsRequest) String() string {
 func (*ListOrganizationsRequest) ProtoMessage() {}
 
 func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[18]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1267,7 @@ func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
 func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{18}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListOrganizationsRequest) GetPageSize() int32 {
@@ -1052,7 +1294,7 @@ type ListOrganizationsResponse struct {
 
 func (x *ListOrganizationsResponse) Reset() {
 	*x = ListOrganizationsResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[19]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1064,7 +1306,7 @@ func (x *ListOrganizationsResponse) String() string {
 func (*ListOrganizationsResponse) ProtoMessage() {}
 
 func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[19]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1319,7 @@ func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
 func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{19}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListOrganizationsResponse) GetOrganizations() []*common.FolderConfig {
@@ -1105,7 +1347,7 @@ type ListOrganizationProjectsRequest struct {
 
 func (x *ListOrganizationProjectsRequest) Reset() {
 	*x = ListOrganizationProjectsRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[20]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1359,7 @@ func (x *ListOrganizationProjectsRequest) String() string {
 func (*ListOrganizationProjectsRequest) ProtoMessage() {}
 
 func (x *ListOrganizationProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[20]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1372,7 @@ func (x *ListOrganizationProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrganizationProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListOrganizationProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{20}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListOrganizationProjectsRequest) GetOrganizationId() string {
@@ -1164,7 +1406,7 @@ type ListOrganizationProjectsResponse struct {
 
 func (x *ListOrganizationProjectsResponse) Reset() {
 	*x = ListOrganizationProjectsResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[21]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1176,7 +1418,7 @@ func (x *ListOrganizationProjectsResponse) String() string {
 func (*ListOrganizationProjectsResponse) ProtoMessage() {}
 
 func (x *ListOrganizationProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[21]
+	mi := &file_libops_v1_organization_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1431,7 @@ func (x *ListOrganizationProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrganizationProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListOrganizationProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{21}
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListOrganizationProjectsResponse) GetProjectIds() []string {
@@ -1206,28 +1448,28 @@ func (x *ListOrganizationProjectsResponse) GetNextPageToken() string {
 	return ""
 }
 
-type GetSiteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetQuotasRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSiteRequest) Reset() {
-	*x = GetSiteRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[22]
+func (x *GetQuotasRequest) Reset() {
+	*x = GetQuotasRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSiteRequest) String() string {
+func (x *GetQuotasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSiteRequest) ProtoMessage() {}
+func (*GetQuotasRequest) ProtoMessage() {}
 
-func (x *GetSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[22]
+func (x *GetQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,40 +1480,41 @@ func (x *GetSiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSiteRequest.ProtoReflect.Descriptor instead.
-func (*GetSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetQuotasRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetSiteRequest) GetSiteId() string {
+func (x *GetQuotasRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.SiteId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-type GetSiteResponse struct {
+type GetQuotasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	Plan          string                 `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // e.g., "trial", "standard"
+	Quotas        []*common.Quota        `protobuf:"bytes,2,rep,name=quotas,proto3" json:"quotas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSiteResponse) Reset() {
-	*x = GetSiteResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[23]
+func (x *GetQuotasResponse) Reset() {
+	*x = GetQuotasResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSiteResponse) String() string {
+func (x *GetQuotasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSiteResponse) ProtoMessage() {}
+func (*GetQuotasResponse) ProtoMessage() {}
 
-func (x *GetSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[23]
+func (x *GetQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,42 +1525,47 @@ func (x *GetSiteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSiteResponse.ProtoReflect.Descriptor instead.
-func (*GetSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetQuotasResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetSiteResponse) GetSite() *common.SiteConfig {
+func (x *GetQuotasResponse) GetPlan() string {
 	if x != nil {
-		return x.Site
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *GetQuotasResponse) GetQuotas() []*common.Quota {
+	if x != nil {
+		return x.Quotas
 	}
 	return nil
 }
 
-type CreateSiteRequest struct {
+type GetOrganizationUsageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Site           *common.SiteConfig     `protobuf:"bytes,3,opt,name=site,proto3" json:"site,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateSiteRequest) Reset() {
-	*x = CreateSiteRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[24]
+func (x *GetOrganizationUsageRequest) Reset() {
+	*x = GetOrganizationUsageRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteRequest) String() string {
+func (x *GetOrganizationUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteRequest) ProtoMessage() {}
+func (*GetOrganizationUsageRequest) ProtoMessage() {}
 
-func (x *CreateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[24]
+func (x *GetOrganizationUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,54 +1576,136 @@ func (x *CreateSiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteRequest.ProtoReflect.Descriptor instead.
-func (*CreateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetOrganizationUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationUsageRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateSiteRequest) GetOrganizationId() string {
+func (x *GetOrganizationUsageRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateSiteRequest) GetProjectId() string {
+type GetOrganizationUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when billing is disabled or the organization has no subscription
+	Subscription *common.BillingSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Projects     []*common.ProjectUsage      `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	// Totals across all projects
+	TotalDiskGb        int64 `protobuf:"varint,3,opt,name=total_disk_gb,json=totalDiskGb,proto3" json:"total_disk_gb,omitempty"`
+	BackupStorageBytes int64 `protobuf:"varint,4,opt,name=backup_storage_bytes,json=backupStorageBytes,proto3" json:"backup_storage_bytes,omitempty"`
+	EgressBytes        int64 `protobuf:"varint,5,opt,name=egress_bytes,json=egressBytes,proto3" json:"egress_bytes,omitempty"`
+	// Live line items from Stripe
+	Items []*common.SubscriptionItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	// Latest data disk usage reported by the organization's sites
+	DiskUsedBytes int64 `protobuf:"varint,7,opt,name=disk_used_bytes,json=diskUsedBytes,proto3" json:"disk_used_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationUsageResponse) Reset() {
+	*x = GetOrganizationUsageResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationUsageResponse) ProtoMessage() {}
+
+func (x *GetOrganizationUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[27]
 	if x != nil {
-		return x.ProjectId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSiteRequest) GetSite() *common.SiteConfig {
+// Deprecated: Use GetOrganizationUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationUsageResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetOrganizationUsageResponse) GetSubscription() *common.BillingSubscription {
 	if x != nil {
-		return x.Site
+		return x.Subscription
 	}
 	return nil
 }
 
-type CreateSiteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrganizationUsageResponse) GetProjects() []*common.ProjectUsage {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
 }
 
-func (x *CreateSiteResponse) Reset() {
-	*x = CreateSiteResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[25]
+func (x *GetOrganizationUsageResponse) GetTotalDiskGb() int64 {
+	if x != nil {
+		return x.TotalDiskGb
+	}
+	return 0
+}
+
+func (x *GetOrganizationUsageResponse) GetBackupStorageBytes() int64 {
+	if x != nil {
+		return x.BackupStorageBytes
+	}
+	return 0
+}
+
+func (x *GetOrganizationUsageResponse) GetEgressBytes() int64 {
+	if x != nil {
+		return x.EgressBytes
+	}
+	return 0
+}
+
+func (x *GetOrganizationUsageResponse) GetItems() []*common.SubscriptionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetOrganizationUsageResponse) GetDiskUsedBytes() int64 {
+	if x != nil {
+		return x.DiskUsedBytes
+	}
+	return 0
+}
+
+type PreviewUsageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewUsageRequest) Reset() {
+	*x = PreviewUsageRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteResponse) String() string {
+func (x *PreviewUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteResponse) ProtoMessage() {}
+func (*PreviewUsageRequest) ProtoMessage() {}
 
-func (x *CreateSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[25]
+func (x *PreviewUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,42 +1716,47 @@ func (x *CreateSiteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteResponse.ProtoReflect.Descriptor instead.
-func (*CreateSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PreviewUsageRequest.ProtoReflect.Descriptor instead.
+func (*PreviewUsageRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateSiteResponse) GetSite() *common.SiteConfig {
+func (x *PreviewUsageRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Site
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type UpdateSiteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	Site          *common.SiteConfig     `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PreviewUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unix timestamps of the billing period being previewed
+	PeriodStart int64                  `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd   int64                  `protobuf:"varint,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Usage       []*common.MeteredUsage `protobuf:"bytes,3,rep,name=usage,proto3" json:"usage,omitempty"`
+	// Charges accrued so far, in cents
+	EstimatedAmountCents int64 `protobuf:"varint,4,opt,name=estimated_amount_cents,json=estimatedAmountCents,proto3" json:"estimated_amount_cents,omitempty"`
+	// Charges at the current rate through period_end, in cents
+	ProjectedAmountCents int64 `protobuf:"varint,5,opt,name=projected_amount_cents,json=projectedAmountCents,proto3" json:"projected_amount_cents,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *UpdateSiteRequest) Reset() {
-	*x = UpdateSiteRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[26]
+func (x *PreviewUsageResponse) Reset() {
+	*x = PreviewUsageResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSiteRequest) String() string {
+func (x *PreviewUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSiteRequest) ProtoMessage() {}
+func (*PreviewUsageResponse) ProtoMessage() {}
 
-func (x *UpdateSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[26]
+func (x *PreviewUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,54 +1767,68 @@ func (x *UpdateSiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSiteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PreviewUsageResponse.ProtoReflect.Descriptor instead.
+func (*PreviewUsageResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateSiteRequest) GetSiteId() string {
+func (x *PreviewUsageResponse) GetPeriodStart() int64 {
 	if x != nil {
-		return x.SiteId
+		return x.PeriodStart
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSiteRequest) GetSite() *common.SiteConfig {
+func (x *PreviewUsageResponse) GetPeriodEnd() int64 {
 	if x != nil {
-		return x.Site
+		return x.PeriodEnd
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateSiteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *PreviewUsageResponse) GetUsage() []*common.MeteredUsage {
 	if x != nil {
-		return x.UpdateMask
+		return x.Usage
 	}
 	return nil
 }
 
-type UpdateSiteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PreviewUsageResponse) GetEstimatedAmountCents() int64 {
+	if x != nil {
+		return x.EstimatedAmountCents
+	}
+	return 0
 }
 
-func (x *UpdateSiteResponse) Reset() {
-	*x = UpdateSiteResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[27]
+func (x *PreviewUsageResponse) GetProjectedAmountCents() int64 {
+	if x != nil {
+		return x.ProjectedAmountCents
+	}
+	return 0
+}
+
+type CreateBillingPortalSessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateBillingPortalSessionRequest) Reset() {
+	*x = CreateBillingPortalSessionRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSiteResponse) String() string {
+func (x *CreateBillingPortalSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSiteResponse) ProtoMessage() {}
+func (*CreateBillingPortalSessionRequest) ProtoMessage() {}
 
-func (x *UpdateSiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[27]
+func (x *CreateBillingPortalSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,40 +1839,41 @@ func (x *UpdateSiteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSiteResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateBillingPortalSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBillingPortalSessionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateSiteResponse) GetSite() *common.SiteConfig {
+func (x *CreateBillingPortalSessionRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Site
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type DeleteSiteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+type CreateBillingPortalSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Short-lived Stripe-hosted URL; the portal returns to the dashboard Billing page
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSiteRequest) Reset() {
-	*x = DeleteSiteRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[28]
+func (x *CreateBillingPortalSessionResponse) Reset() {
+	*x = CreateBillingPortalSessionResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSiteRequest) String() string {
+func (x *CreateBillingPortalSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSiteRequest) ProtoMessage() {}
+func (*CreateBillingPortalSessionResponse) ProtoMessage() {}
 
-func (x *DeleteSiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[28]
+func (x *CreateBillingPortalSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,43 +1884,40 @@ func (x *DeleteSiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSiteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateBillingPortalSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateBillingPortalSessionResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteSiteRequest) GetSiteId() string {
+func (x *CreateBillingPortalSessionResponse) GetUrl() string {
 	if x != nil {
-		return x.SiteId
+		return x.Url
 	}
 	return ""
 }
 
-type ListSitesRequest struct {
+type ListPaymentMethodsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	ProjectId      *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListSitesRequest) Reset() {
-	*x = ListSitesRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[29]
+func (x *ListPaymentMethodsRequest) Reset() {
+	*x = ListPaymentMethodsRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSitesRequest) String() string {
+func (x *ListPaymentMethodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSitesRequest) ProtoMessage() {}
+func (*ListPaymentMethodsRequest) ProtoMessage() {}
 
-func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[29]
+func (x *ListPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,23 +1928,762 @@ func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSitesRequest.ProtoReflect.Descriptor instead.
-func (*ListSitesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListSitesRequest) GetOrganizationId() string {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
+func (x *ListPaymentMethodsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListSitesRequest) GetProjectId() string {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
-	}
-	return ""
+type ListPaymentMethodsResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	PaymentMethods []*common.PaymentMethod `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPaymentMethodsResponse) Reset() {
+	*x = ListPaymentMethodsResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *ListPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPaymentMethodsResponse) GetPaymentMethods() []*common.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+type SetDefaultPaymentMethodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PaymentMethodId string                 `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetDefaultPaymentMethodRequest) Reset() {
+	*x = SetDefaultPaymentMethodRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPaymentMethodRequest) ProtoMessage() {}
+
+func (x *SetDefaultPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetDefaultPaymentMethodRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetDefaultPaymentMethodRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+type SetDefaultPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethod *common.PaymentMethod  `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultPaymentMethodResponse) Reset() {
+	*x = SetDefaultPaymentMethodResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPaymentMethodResponse) ProtoMessage() {}
+
+func (x *SetDefaultPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetDefaultPaymentMethodResponse) GetPaymentMethod() *common.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+type ListInvoicesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListInvoicesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*common.Invoice      `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*common.Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListInvoicesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetInvoiceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceId      string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetInvoiceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type GetInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *common.Invoice        `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResponse) ProtoMessage() {}
+
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetInvoiceResponse) GetInvoice() *common.Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteRequest) Reset() {
+	*x = GetSiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteRequest) ProtoMessage() {}
+
+func (x *GetSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetSiteRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteResponse) Reset() {
+	*x = GetSiteResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteResponse) ProtoMessage() {}
+
+func (x *GetSiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSiteResponse) GetSite() *common.SiteConfig {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type CreateSiteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Site           *common.SiteConfig     `protobuf:"bytes,3,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSiteRequest) Reset() {
+	*x = CreateSiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteRequest) ProtoMessage() {}
+
+func (x *CreateSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteRequest.ProtoReflect.Descriptor instead.
+func (*CreateSiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateSiteRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateSiteRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateSiteRequest) GetSite() *common.SiteConfig {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type CreateSiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSiteResponse) Reset() {
+	*x = CreateSiteResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSiteResponse) ProtoMessage() {}
+
+func (x *CreateSiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSiteResponse.ProtoReflect.Descriptor instead.
+func (*CreateSiteResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateSiteResponse) GetSite() *common.SiteConfig {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type UpdateSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Site          *common.SiteConfig     `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteRequest) Reset() {
+	*x = UpdateSiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteRequest) ProtoMessage() {}
+
+func (x *UpdateSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateSiteRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteRequest) GetSite() *common.SiteConfig {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+func (x *UpdateSiteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateSiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *common.SiteConfig     `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteResponse) Reset() {
+	*x = UpdateSiteResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteResponse) ProtoMessage() {}
+
+func (x *UpdateSiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSiteResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateSiteResponse) GetSite() *common.SiteConfig {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type DeleteSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteRequest) Reset() {
+	*x = DeleteSiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteRequest) ProtoMessage() {}
+
+func (x *DeleteSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteSiteRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListSitesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *string                `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	ProjectId      *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Continues after the last row of the previous page. Results are ordered
+	// newest first by creation time, then by ID, so rows created or deleted
+	// between requests don't shift pages.
+	PageToken     string  `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	LabelSelector *string `protobuf:"bytes,5,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // Comma-separated key=value pairs, e.g. "env=prod,department=library"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSitesRequest) Reset() {
+	*x = ListSitesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSitesRequest) ProtoMessage() {}
+
+func (x *ListSitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSitesRequest.ProtoReflect.Descriptor instead.
+func (*ListSitesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSitesRequest) GetOrganizationId() string {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListSitesRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
 }
 
 func (x *ListSitesRequest) GetPageSize() int32 {
@@ -1607,36 +2693,1479 @@ func (x *ListSitesRequest) GetPageSize() int32 {
 	return 0
 }
 
-func (x *ListSitesRequest) GetPageToken() string {
+func (x *ListSitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSitesRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+type ListSitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sites         []*common.SiteConfig   `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSitesResponse) Reset() {
+	*x = ListSitesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSitesResponse) ProtoMessage() {}
+
+func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSitesResponse.ProtoReflect.Descriptor instead.
+func (*ListSitesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListSitesResponse) GetSites() []*common.SiteConfig {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *ListSitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type OrganizationFirewallRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RuleId         string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                // Organization this rule applies to
+	RuleType       FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
+	Cidr           string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block (e.g., "203.0.113.0/24")
+	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name for the rule
+	Status         common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationFirewallRule) Reset() {
+	*x = OrganizationFirewallRule{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationFirewallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationFirewallRule) ProtoMessage() {}
+
+func (x *OrganizationFirewallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationFirewallRule.ProtoReflect.Descriptor instead.
+func (*OrganizationFirewallRule) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OrganizationFirewallRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *OrganizationFirewallRule) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationFirewallRule) GetRuleType() FirewallRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *OrganizationFirewallRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *OrganizationFirewallRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrganizationFirewallRule) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+type ProjectFirewallRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`                               // Project this rule applies to
+	RuleType      FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
+	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name
+	Status        common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectFirewallRule) Reset() {
+	*x = ProjectFirewallRule{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectFirewallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectFirewallRule) ProtoMessage() {}
+
+func (x *ProjectFirewallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectFirewallRule.ProtoReflect.Descriptor instead.
+func (*ProjectFirewallRule) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ProjectFirewallRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ProjectFirewallRule) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ProjectFirewallRule) GetRuleType() FirewallRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *ProjectFirewallRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *ProjectFirewallRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectFirewallRule) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+type SiteFirewallRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                                        // Site this rule applies to
+	RuleType      FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
+	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name
+	Status        common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteFirewallRule) Reset() {
+	*x = SiteFirewallRule{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteFirewallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteFirewallRule) ProtoMessage() {}
+
+func (x *SiteFirewallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteFirewallRule.ProtoReflect.Descriptor instead.
+func (*SiteFirewallRule) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SiteFirewallRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *SiteFirewallRule) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteFirewallRule) GetRuleType() FirewallRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *SiteFirewallRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *SiteFirewallRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteFirewallRule) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+// SiteRateLimitRule limits how many requests each client IP can make to a
+// site; requests over the limit get a 429
+type SiteRateLimitRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RuleId           string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`             // Unique rule identifier
+	SiteId           string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`             // Site this rule applies to
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                               // Human-readable name
+	PathPrefix       string                 `protobuf:"bytes,4,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // Only requests under this path count; empty limits every request
+	Requests         int32                  `protobuf:"varint,5,opt,name=requests,proto3" json:"requests,omitempty"`                      // Requests each client IP may make per window
+	Window           RateLimitWindow        `protobuf:"varint,6,opt,name=window,proto3,enum=libops.v1.RateLimitWindow" json:"window,omitempty"`
+	Burst            int32                  `protobuf:"varint,7,opt,name=burst,proto3" json:"burst,omitempty"`                                               // Requests over the rate let through before rejecting
+	RejectedRequests int64                  `protobuf:"varint,8,opt,name=rejected_requests,json=rejectedRequests,proto3" json:"rejected_requests,omitempty"` // Requests rejected over the last 7 days
+	CreatedAt        int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                      // Unix timestamp
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SiteRateLimitRule) Reset() {
+	*x = SiteRateLimitRule{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteRateLimitRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteRateLimitRule) ProtoMessage() {}
+
+func (x *SiteRateLimitRule) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteRateLimitRule.ProtoReflect.Descriptor instead.
+func (*SiteRateLimitRule) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SiteRateLimitRule) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *SiteRateLimitRule) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteRateLimitRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SiteRateLimitRule) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *SiteRateLimitRule) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *SiteRateLimitRule) GetWindow() RateLimitWindow {
+	if x != nil {
+		return x.Window
+	}
+	return RateLimitWindow_RATE_LIMIT_WINDOW_UNSPECIFIED
+}
+
+func (x *SiteRateLimitRule) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *SiteRateLimitRule) GetRejectedRequests() int64 {
+	if x != nil {
+		return x.RejectedRequests
+	}
+	return 0
+}
+
+func (x *SiteRateLimitRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type MemberDetail struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                      // Account ID of the member
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                               // Member's email
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                 // Member's display name
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                                 // "owner", "developer", "read"
+	GithubUsername *string                `protobuf:"bytes,5,opt,name=github_username,json=githubUsername,proto3,oneof" json:"github_username,omitempty"` // GitHub username
+	Status         common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`               // Member status
+	MemberId       string                 `protobuf:"bytes,7,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`                         // Member ID (public_id of the membership)
+	ExpiresAt      int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                     // Unix timestamp the membership is removed at, 0 if it doesn't expire
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemberDetail) Reset() {
+	*x = MemberDetail{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberDetail) ProtoMessage() {}
+
+func (x *MemberDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberDetail.ProtoReflect.Descriptor instead.
+func (*MemberDetail) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MemberDetail) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetGithubUsername() string {
+	if x != nil && x.GithubUsername != nil {
+		return *x.GithubUsername
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+func (x *MemberDetail) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberDetail) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// MemberInvitation is a pending membership for someone without an account. It
+// becomes a membership when they sign up with the invited email and accept it.
+type MemberInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberInvitation) Reset() {
+	*x = MemberInvitation{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberInvitation) ProtoMessage() {}
+
+func (x *MemberInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberInvitation.ProtoReflect.Descriptor instead.
+func (*MemberInvitation) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MemberInvitation) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type SshKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // UUID
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // UUID of account that owns this key
+	PublicKey     string                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // SSH public key content
+	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`                      // User-friendly name
+	Fingerprint   *string                `protobuf:"bytes,5,opt,name=fingerprint,proto3,oneof" json:"fingerprint,omitempty"`        // Key fingerprint (computed)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SshKey) Reset() {
+	*x = SshKey{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SshKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKey) ProtoMessage() {}
+
+func (x *SshKey) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKey.ProtoReflect.Descriptor instead.
+func (*SshKey) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SshKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *SshKey) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SshKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SshKey) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SshKey) GetFingerprint() string {
+	if x != nil && x.Fingerprint != nil {
+		return *x.Fingerprint
+	}
+	return ""
+}
+
+type SiteStatus struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SiteId     string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Status     string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                 // "pending", "deploying", "deployed", "failed"
+	Message    *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`                         // Status message
+	DeployedAt *string                `protobuf:"bytes,4,opt,name=deployed_at,json=deployedAt,proto3,oneof" json:"deployed_at,omitempty"` // Timestamp of last deployment
+	Cdn        *common.SiteCdn        `protobuf:"bytes,5,opt,name=cdn,proto3" json:"cdn,omitempty"`                                       // Unset when the site has no CDN
+	Addons     []*SiteAddon           `protobuf:"bytes,6,rep,name=addons,proto3" json:"addons,omitempty"`                                 // With their last reported health
+	// The VM, controller, containers, TLS certificate, DNS, last deployment and
+	// last backup, in that order
+	Components    []*SiteComponentStatus `protobuf:"bytes,7,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteStatus) Reset() {
+	*x = SiteStatus{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteStatus) ProtoMessage() {}
+
+func (x *SiteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteStatus.ProtoReflect.Descriptor instead.
+func (*SiteStatus) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SiteStatus) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetDeployedAt() string {
+	if x != nil && x.DeployedAt != nil {
+		return *x.DeployedAt
+	}
+	return ""
+}
+
+func (x *SiteStatus) GetCdn() *common.SiteCdn {
+	if x != nil {
+		return x.Cdn
+	}
+	return nil
+}
+
+func (x *SiteStatus) GetAddons() []*SiteAddon {
+	if x != nil {
+		return x.Addons
+	}
+	return nil
+}
+
+func (x *SiteStatus) GetComponents() []*SiteComponentStatus {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// SiteComponentStatus is the state of one part of a site, so support can
+// triage it without logging in to the VM
+type SiteComponentStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"` // "vm", "controller", "containers", "tls", "dns", "deployment" or "backup"
+	State         SiteComponentState     `protobuf:"varint,2,opt,name=state,proto3,enum=libops.v1.SiteComponentState" json:"state,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                          // e.g. "Certificate expires in 5 days"
+	ObservedAt    int64                  `protobuf:"varint,4,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"` // Unix timestamp of what the state is based on, 0 without anything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteComponentStatus) Reset() {
+	*x = SiteComponentStatus{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteComponentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteComponentStatus) ProtoMessage() {}
+
+func (x *SiteComponentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteComponentStatus.ProtoReflect.Descriptor instead.
+func (*SiteComponentStatus) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SiteComponentStatus) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *SiteComponentStatus) GetState() SiteComponentState {
+	if x != nil {
+		return x.State
+	}
+	return SiteComponentState_SITE_COMPONENT_STATE_UNSPECIFIED
+}
+
+func (x *SiteComponentStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SiteComponentStatus) GetObservedAt() int64 {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return 0
+}
+
+type ListOrganizationFirewallRulesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListOrganizationFirewallRulesRequest) Reset() {
+	*x = ListOrganizationFirewallRulesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationFirewallRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationFirewallRulesRequest) ProtoMessage() {}
+
+func (x *ListOrganizationFirewallRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationFirewallRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationFirewallRulesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListOrganizationFirewallRulesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListOrganizationFirewallRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrganizationFirewallRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrganizationFirewallRulesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Rules         []*OrganizationFirewallRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextPageToken string                      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationFirewallRulesResponse) Reset() {
+	*x = ListOrganizationFirewallRulesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationFirewallRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationFirewallRulesResponse) ProtoMessage() {}
+
+func (x *ListOrganizationFirewallRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationFirewallRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationFirewallRulesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListOrganizationFirewallRulesResponse) GetRules() []*OrganizationFirewallRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListOrganizationFirewallRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateOrganizationFirewallRuleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	RuleType       FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
+	Cidr           string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) Reset() {
+	*x = CreateOrganizationFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationFirewallRuleRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) GetRuleType() FirewallRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateOrganizationFirewallRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOrganizationFirewallRuleResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Rule          *OrganizationFirewallRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationFirewallRuleResponse) Reset() {
+	*x = CreateOrganizationFirewallRuleResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationFirewallRuleResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateOrganizationFirewallRuleResponse) GetRule() *OrganizationFirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteOrganizationFirewallRuleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	RuleId         string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationFirewallRuleRequest) Reset() {
+	*x = DeleteOrganizationFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationFirewallRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteOrganizationFirewallRuleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteOrganizationFirewallRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type ListProjectFirewallRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectFirewallRulesRequest) Reset() {
+	*x = ListProjectFirewallRulesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectFirewallRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectFirewallRulesRequest) ProtoMessage() {}
+
+func (x *ListProjectFirewallRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectFirewallRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectFirewallRulesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListProjectFirewallRulesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListProjectFirewallRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectFirewallRulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListProjectFirewallRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ProjectFirewallRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectFirewallRulesResponse) Reset() {
+	*x = ListProjectFirewallRulesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectFirewallRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectFirewallRulesResponse) ProtoMessage() {}
+
+func (x *ListProjectFirewallRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectFirewallRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectFirewallRulesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListProjectFirewallRulesResponse) GetRules() []*ProjectFirewallRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListProjectFirewallRulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateProjectFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	RuleType      FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
+	Cidr          string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectFirewallRuleRequest) Reset() {
+	*x = CreateProjectFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectFirewallRuleRequest) ProtoMessage() {}
+
+func (x *CreateProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateProjectFirewallRuleRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateProjectFirewallRuleRequest) GetRuleType() FirewallRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateProjectFirewallRuleRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateProjectFirewallRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProjectFirewallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ProjectFirewallRule   `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectFirewallRuleResponse) Reset() {
+	*x = CreateProjectFirewallRuleResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectFirewallRuleResponse) ProtoMessage() {}
+
+func (x *CreateProjectFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateProjectFirewallRuleResponse) GetRule() *ProjectFirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteProjectFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectFirewallRuleRequest) Reset() {
+	*x = DeleteProjectFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectFirewallRuleRequest) ProtoMessage() {}
+
+func (x *DeleteProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteProjectFirewallRuleRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteProjectFirewallRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type ListSiteFirewallRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSiteFirewallRulesRequest) Reset() {
+	*x = ListSiteFirewallRulesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSiteFirewallRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSiteFirewallRulesRequest) ProtoMessage() {}
+
+func (x *ListSiteFirewallRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSiteFirewallRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSiteFirewallRulesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListSiteFirewallRulesRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListSiteFirewallRulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSiteFirewallRulesRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListSitesResponse struct {
+type ListSiteFirewallRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sites         []*common.SiteConfig   `protobuf:"bytes,1,rep,name=sites,proto3" json:"sites,omitempty"`
+	Rules         []*SiteFirewallRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSitesResponse) Reset() {
-	*x = ListSitesResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[30]
+func (x *ListSiteFirewallRulesResponse) Reset() {
+	*x = ListSiteFirewallRulesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSitesResponse) String() string {
+func (x *ListSiteFirewallRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSitesResponse) ProtoMessage() {}
+func (*ListSiteFirewallRulesResponse) ProtoMessage() {}
 
-func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[30]
+func (x *ListSiteFirewallRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,52 +4176,50 @@ func (x *ListSitesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSitesResponse.ProtoReflect.Descriptor instead.
-func (*ListSitesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListSiteFirewallRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSiteFirewallRulesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListSitesResponse) GetSites() []*common.SiteConfig {
+func (x *ListSiteFirewallRulesResponse) GetRules() []*SiteFirewallRule {
 	if x != nil {
-		return x.Sites
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *ListSitesResponse) GetNextPageToken() string {
+func (x *ListSiteFirewallRulesResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type OrganizationFirewallRule struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RuleId         string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                // Organization this rule applies to
-	RuleType       FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
-	Cidr           string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block (e.g., "203.0.113.0/24")
-	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name for the rule
-	Status         common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateSiteFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RuleType      FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
+	Cidr          string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationFirewallRule) Reset() {
-	*x = OrganizationFirewallRule{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[31]
+func (x *CreateSiteFirewallRuleRequest) Reset() {
+	*x = CreateSiteFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationFirewallRule) String() string {
+func (x *CreateSiteFirewallRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationFirewallRule) ProtoMessage() {}
+func (*CreateSiteFirewallRuleRequest) ProtoMessage() {}
 
-func (x *OrganizationFirewallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[31]
+func (x *CreateSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,80 +4230,61 @@ func (x *OrganizationFirewallRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationFirewallRule.ProtoReflect.Descriptor instead.
-func (*OrganizationFirewallRule) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *OrganizationFirewallRule) GetRuleId() string {
-	if x != nil {
-		return x.RuleId
-	}
-	return ""
+// Deprecated: Use CreateSiteFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSiteFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *OrganizationFirewallRule) GetOrganizationId() string {
+func (x *CreateSiteFirewallRuleRequest) GetSiteId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *OrganizationFirewallRule) GetRuleType() FirewallRuleType {
+func (x *CreateSiteFirewallRuleRequest) GetRuleType() FirewallRuleType {
 	if x != nil {
 		return x.RuleType
 	}
 	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
 }
 
-func (x *OrganizationFirewallRule) GetCidr() string {
+func (x *CreateSiteFirewallRuleRequest) GetCidr() string {
 	if x != nil {
 		return x.Cidr
 	}
 	return ""
 }
 
-func (x *OrganizationFirewallRule) GetName() string {
+func (x *CreateSiteFirewallRuleRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *OrganizationFirewallRule) GetStatus() common.Status {
-	if x != nil {
-		return x.Status
-	}
-	return common.Status(0)
-}
-
-type ProjectFirewallRule struct {
+type CreateSiteFirewallRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`                               // Project this rule applies to
-	RuleType      FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
-	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name
-	Status        common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
+	Rule          *SiteFirewallRule      `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectFirewallRule) Reset() {
-	*x = ProjectFirewallRule{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[32]
+func (x *CreateSiteFirewallRuleResponse) Reset() {
+	*x = CreateSiteFirewallRuleResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectFirewallRule) String() string {
+func (x *CreateSiteFirewallRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectFirewallRule) ProtoMessage() {}
+func (*CreateSiteFirewallRuleResponse) ProtoMessage() {}
 
-func (x *ProjectFirewallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[32]
+func (x *CreateSiteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,80 +4295,92 @@ func (x *ProjectFirewallRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectFirewallRule.ProtoReflect.Descriptor instead.
-func (*ProjectFirewallRule) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CreateSiteFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSiteFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ProjectFirewallRule) GetRuleId() string {
+func (x *CreateSiteFirewallRuleResponse) GetRule() *SiteFirewallRule {
 	if x != nil {
-		return x.RuleId
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-func (x *ProjectFirewallRule) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+type DeleteSiteFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectFirewallRule) GetRuleType() FirewallRuleType {
-	if x != nil {
-		return x.RuleType
-	}
-	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+func (x *DeleteSiteFirewallRuleRequest) Reset() {
+	*x = DeleteSiteFirewallRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectFirewallRule) GetCidr() string {
+func (x *DeleteSiteFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteFirewallRuleRequest) ProtoMessage() {}
+
+func (x *DeleteSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[72]
 	if x != nil {
-		return x.Cidr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProjectFirewallRule) GetName() string {
+// Deprecated: Use DeleteSiteFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteSiteFirewallRuleRequest) GetSiteId() string {
 	if x != nil {
-		return x.Name
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *ProjectFirewallRule) GetStatus() common.Status {
+func (x *DeleteSiteFirewallRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.Status
+		return x.RuleId
 	}
-	return common.Status(0)
+	return ""
 }
 
-type SiteFirewallRule struct {
+type ListSiteRateLimitRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`                                        // Unique rule identifier
-	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                                        // Site this rule applies to
-	RuleType      FirewallRuleType       `protobuf:"varint,3,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"` // Type of rule
-	Cidr          string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`                                                          // CIDR block
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                                                          // Human-readable name
-	Status        common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`                        // Rule status
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SiteFirewallRule) Reset() {
-	*x = SiteFirewallRule{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[33]
+func (x *ListSiteRateLimitRulesRequest) Reset() {
+	*x = ListSiteRateLimitRulesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SiteFirewallRule) String() string {
+func (x *ListSiteRateLimitRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteFirewallRule) ProtoMessage() {}
+func (*ListSiteRateLimitRulesRequest) ProtoMessage() {}
 
-func (x *SiteFirewallRule) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[33]
+func (x *ListSiteRateLimitRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,81 +4391,89 @@ func (x *SiteFirewallRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteFirewallRule.ProtoReflect.Descriptor instead.
-func (*SiteFirewallRule) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListSiteRateLimitRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSiteRateLimitRulesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SiteFirewallRule) GetRuleId() string {
+func (x *ListSiteRateLimitRulesRequest) GetSiteId() string {
 	if x != nil {
-		return x.RuleId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *SiteFirewallRule) GetSiteId() string {
-	if x != nil {
-		return x.SiteId
-	}
-	return ""
+type ListSiteRateLimitRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*SiteRateLimitRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SiteFirewallRule) GetRuleType() FirewallRuleType {
-	if x != nil {
-		return x.RuleType
-	}
-	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+func (x *ListSiteRateLimitRulesResponse) Reset() {
+	*x = ListSiteRateLimitRulesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SiteFirewallRule) GetCidr() string {
+func (x *ListSiteRateLimitRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSiteRateLimitRulesResponse) ProtoMessage() {}
+
+func (x *ListSiteRateLimitRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[74]
 	if x != nil {
-		return x.Cidr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SiteFirewallRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ListSiteRateLimitRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSiteRateLimitRulesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *SiteFirewallRule) GetStatus() common.Status {
+func (x *ListSiteRateLimitRulesResponse) GetRules() []*SiteRateLimitRule {
 	if x != nil {
-		return x.Status
+		return x.Rules
 	}
-	return common.Status(0)
+	return nil
 }
 
-type MemberDetail struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                      // Account ID of the member
-	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                               // Member's email
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                 // Member's display name
-	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                                                 // "owner", "developer", "read"
-	GithubUsername *string                `protobuf:"bytes,5,opt,name=github_username,json=githubUsername,proto3,oneof" json:"github_username,omitempty"` // GitHub username
-	Status         common.Status          `protobuf:"varint,6,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`               // Member status
-	MemberId       string                 `protobuf:"bytes,7,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`                         // Member ID (public_id of the membership)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateSiteRateLimitRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PathPrefix    string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // Starts with "/"; empty limits every request
+	Requests      int32                  `protobuf:"varint,4,opt,name=requests,proto3" json:"requests,omitempty"`                      // 1 to 100000
+	Window        RateLimitWindow        `protobuf:"varint,5,opt,name=window,proto3,enum=libops.v1.RateLimitWindow" json:"window,omitempty"`
+	Burst         int32                  `protobuf:"varint,6,opt,name=burst,proto3" json:"burst,omitempty"` // 0 to 10000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberDetail) Reset() {
-	*x = MemberDetail{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[34]
+func (x *CreateSiteRateLimitRuleRequest) Reset() {
+	*x = CreateSiteRateLimitRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberDetail) String() string {
+func (x *CreateSiteRateLimitRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberDetail) ProtoMessage() {}
+func (*CreateSiteRateLimitRuleRequest) ProtoMessage() {}
 
-func (x *MemberDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[34]
+func (x *CreateSiteRateLimitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,86 +4484,75 @@ func (x *MemberDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberDetail.ProtoReflect.Descriptor instead.
-func (*MemberDetail) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *MemberDetail) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
+// Deprecated: Use CreateSiteRateLimitRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateSiteRateLimitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *MemberDetail) GetEmail() string {
+func (x *CreateSiteRateLimitRuleRequest) GetSiteId() string {
 	if x != nil {
-		return x.Email
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *MemberDetail) GetName() string {
+func (x *CreateSiteRateLimitRuleRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MemberDetail) GetRole() string {
+func (x *CreateSiteRateLimitRuleRequest) GetPathPrefix() string {
 	if x != nil {
-		return x.Role
+		return x.PathPrefix
 	}
 	return ""
 }
 
-func (x *MemberDetail) GetGithubUsername() string {
-	if x != nil && x.GithubUsername != nil {
-		return *x.GithubUsername
+func (x *CreateSiteRateLimitRuleRequest) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
 	}
-	return ""
+	return 0
 }
 
-func (x *MemberDetail) GetStatus() common.Status {
+func (x *CreateSiteRateLimitRuleRequest) GetWindow() RateLimitWindow {
 	if x != nil {
-		return x.Status
+		return x.Window
 	}
-	return common.Status(0)
+	return RateLimitWindow_RATE_LIMIT_WINDOW_UNSPECIFIED
 }
 
-func (x *MemberDetail) GetMemberId() string {
+func (x *CreateSiteRateLimitRuleRequest) GetBurst() int32 {
 	if x != nil {
-		return x.MemberId
+		return x.Burst
 	}
-	return ""
+	return 0
 }
 
-type SshKey struct {
+type CreateSiteRateLimitRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // UUID
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // UUID of account that owns this key
-	PublicKey     string                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // SSH public key content
-	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`                      // User-friendly name
-	Fingerprint   *string                `protobuf:"bytes,5,opt,name=fingerprint,proto3,oneof" json:"fingerprint,omitempty"`        // Key fingerprint (computed)
+	Rule          *SiteRateLimitRule     `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SshKey) Reset() {
-	*x = SshKey{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[35]
+func (x *CreateSiteRateLimitRuleResponse) Reset() {
+	*x = CreateSiteRateLimitRuleResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SshKey) String() string {
+func (x *CreateSiteRateLimitRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SshKey) ProtoMessage() {}
+func (*CreateSiteRateLimitRuleResponse) ProtoMessage() {}
 
-func (x *SshKey) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[35]
+func (x *CreateSiteRateLimitRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,71 +4563,41 @@ func (x *SshKey) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SshKey.ProtoReflect.Descriptor instead.
-func (*SshKey) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *SshKey) GetKeyId() string {
-	if x != nil {
-		return x.KeyId
-	}
-	return ""
-}
-
-func (x *SshKey) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
+// Deprecated: Use CreateSiteRateLimitRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateSiteRateLimitRuleResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SshKey) GetPublicKey() string {
+func (x *CreateSiteRateLimitRuleResponse) GetRule() *SiteRateLimitRule {
 	if x != nil {
-		return x.PublicKey
-	}
-	return ""
-}
-
-func (x *SshKey) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *SshKey) GetFingerprint() string {
-	if x != nil && x.Fingerprint != nil {
-		return *x.Fingerprint
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type SiteStatus struct {
+type DeleteSiteRateLimitRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                                 // "pending", "deploying", "deployed", "failed"
-	Message       *string                `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`                         // Status message
-	DeployedAt    *string                `protobuf:"bytes,4,opt,name=deployed_at,json=deployedAt,proto3,oneof" json:"deployed_at,omitempty"` // Timestamp of last deployment
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SiteStatus) Reset() {
-	*x = SiteStatus{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[36]
+func (x *DeleteSiteRateLimitRuleRequest) Reset() {
+	*x = DeleteSiteRateLimitRuleRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SiteStatus) String() string {
+func (x *DeleteSiteRateLimitRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SiteStatus) ProtoMessage() {}
+func (*DeleteSiteRateLimitRuleRequest) ProtoMessage() {}
 
-func (x *SiteStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[36]
+func (x *DeleteSiteRateLimitRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,40 +4608,26 @@ func (x *SiteStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SiteStatus.ProtoReflect.Descriptor instead.
-func (*SiteStatus) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DeleteSiteRateLimitRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteRateLimitRuleRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *SiteStatus) GetSiteId() string {
+func (x *DeleteSiteRateLimitRuleRequest) GetSiteId() string {
 	if x != nil {
 		return x.SiteId
 	}
 	return ""
 }
 
-func (x *SiteStatus) GetStatus() string {
+func (x *DeleteSiteRateLimitRuleRequest) GetRuleId() string {
 	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *SiteStatus) GetMessage() string {
-	if x != nil && x.Message != nil {
-		return *x.Message
-	}
-	return ""
-}
-
-func (x *SiteStatus) GetDeployedAt() string {
-	if x != nil && x.DeployedAt != nil {
-		return *x.DeployedAt
+		return x.RuleId
 	}
 	return ""
 }
 
-type ListOrganizationFirewallRulesRequest struct {
+type ListOrganizationMembersRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -2163,21 +4636,21 @@ type ListOrganizationFirewallRulesRequest struct {
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListOrganizationFirewallRulesRequest) Reset() {
-	*x = ListOrganizationFirewallRulesRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[37]
+func (x *ListOrganizationMembersRequest) Reset() {
+	*x = ListOrganizationMembersRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationFirewallRulesRequest) String() string {
+func (x *ListOrganizationMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationFirewallRulesRequest) ProtoMessage() {}
+func (*ListOrganizationMembersRequest) ProtoMessage() {}
 
-func (x *ListOrganizationFirewallRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[37]
+func (x *ListOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2188,55 +4661,55 @@ func (x *ListOrganizationFirewallRulesRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationFirewallRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListOrganizationFirewallRulesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListOrganizationMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListOrganizationFirewallRulesRequest) GetOrganizationId() string {
+func (x *ListOrganizationMembersRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListOrganizationFirewallRulesRequest) GetPageSize() int32 {
+func (x *ListOrganizationMembersRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListOrganizationFirewallRulesRequest) GetPageToken() string {
+func (x *ListOrganizationMembersRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListOrganizationFirewallRulesResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Rules         []*OrganizationFirewallRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	NextPageToken string                      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ListOrganizationMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrganizationFirewallRulesResponse) Reset() {
-	*x = ListOrganizationFirewallRulesResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[38]
+func (x *ListOrganizationMembersResponse) Reset() {
+	*x = ListOrganizationMembersResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationFirewallRulesResponse) String() string {
+func (x *ListOrganizationMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationFirewallRulesResponse) ProtoMessage() {}
+func (*ListOrganizationMembersResponse) ProtoMessage() {}
 
-func (x *ListOrganizationFirewallRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[38]
+func (x *ListOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,50 +4720,51 @@ func (x *ListOrganizationFirewallRulesResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationFirewallRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListOrganizationFirewallRulesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListOrganizationMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListOrganizationFirewallRulesResponse) GetRules() []*OrganizationFirewallRule {
+func (x *ListOrganizationMembersResponse) GetMembers() []*MemberDetail {
 	if x != nil {
-		return x.Rules
+		return x.Members
 	}
 	return nil
 }
 
-func (x *ListOrganizationFirewallRulesResponse) GetNextPageToken() string {
+func (x *ListOrganizationMembersResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-type CreateOrganizationFirewallRuleRequest struct {
+type CreateOrganizationMemberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	RuleType       FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
-	Cidr           string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
-	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`  // Account to add
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                             // "owner", "developer", "read"
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                           // Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+	ExpiresAt      int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional Unix timestamp to remove the membership at. Not available for invitations
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) Reset() {
-	*x = CreateOrganizationFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[39]
+func (x *CreateOrganizationMemberRequest) Reset() {
+	*x = CreateOrganizationMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) String() string {
+func (x *CreateOrganizationMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationFirewallRuleRequest) ProtoMessage() {}
+func (*CreateOrganizationMemberRequest) ProtoMessage() {}
 
-func (x *CreateOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[39]
+func (x *CreateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,61 +4775,69 @@ func (x *CreateOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) GetOrganizationId() string {
+func (x *CreateOrganizationMemberRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) GetRuleType() FirewallRuleType {
+func (x *CreateOrganizationMemberRequest) GetAccountId() string {
 	if x != nil {
-		return x.RuleType
+		return x.AccountId
 	}
-	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) GetCidr() string {
+func (x *CreateOrganizationMemberRequest) GetRole() string {
 	if x != nil {
-		return x.Cidr
+		return x.Role
 	}
 	return ""
 }
 
-func (x *CreateOrganizationFirewallRuleRequest) GetName() string {
+func (x *CreateOrganizationMemberRequest) GetEmail() string {
 	if x != nil {
-		return x.Name
+		return x.Email
 	}
 	return ""
 }
 
-type CreateOrganizationFirewallRuleResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Rule          *OrganizationFirewallRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+func (x *CreateOrganizationMemberRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`         // Set when an existing account was added
+	Invitation    *MemberInvitation      `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"` // Set when an invitation was emailed instead
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrganizationFirewallRuleResponse) Reset() {
-	*x = CreateOrganizationFirewallRuleResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[40]
+func (x *CreateOrganizationMemberResponse) Reset() {
+	*x = CreateOrganizationMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationFirewallRuleResponse) String() string {
+func (x *CreateOrganizationMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationFirewallRuleResponse) ProtoMessage() {}
+func (*CreateOrganizationMemberResponse) ProtoMessage() {}
 
-func (x *CreateOrganizationFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[40]
+func (x *CreateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2366,41 +4848,51 @@ func (x *CreateOrganizationFirewallRuleResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationFirewallRuleResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CreateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CreateOrganizationFirewallRuleResponse) GetRule() *OrganizationFirewallRule {
+func (x *CreateOrganizationMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
-		return x.Rule
+		return x.Member
 	}
 	return nil
 }
 
-type DeleteOrganizationFirewallRuleRequest struct {
+func (x *CreateOrganizationMemberResponse) GetInvitation() *MemberInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type UpdateOrganizationMemberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	RuleId         string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
+	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpiresAt      *int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Unix timestamp to remove the membership at, 0 to keep it indefinitely
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteOrganizationFirewallRuleRequest) Reset() {
-	*x = DeleteOrganizationFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[41]
+func (x *UpdateOrganizationMemberRequest) Reset() {
+	*x = UpdateOrganizationMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationFirewallRuleRequest) String() string {
+func (x *UpdateOrganizationMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationFirewallRuleRequest) ProtoMessage() {}
+func (*UpdateOrganizationMemberRequest) ProtoMessage() {}
 
-func (x *DeleteOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[41]
+func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,49 +4903,68 @@ func (x *DeleteOrganizationFirewallRuleRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use UpdateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DeleteOrganizationFirewallRuleRequest) GetOrganizationId() string {
+func (x *UpdateOrganizationMemberRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *DeleteOrganizationFirewallRuleRequest) GetRuleId() string {
+func (x *UpdateOrganizationMemberRequest) GetAccountId() string {
 	if x != nil {
-		return x.RuleId
+		return x.AccountId
 	}
 	return ""
 }
 
-type ListProjectFirewallRulesRequest struct {
+func (x *UpdateOrganizationMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationMemberRequest) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+type UpdateOrganizationMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectFirewallRulesRequest) Reset() {
-	*x = ListProjectFirewallRulesRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[42]
+func (x *UpdateOrganizationMemberResponse) Reset() {
+	*x = UpdateOrganizationMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectFirewallRulesRequest) String() string {
+func (x *UpdateOrganizationMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectFirewallRulesRequest) ProtoMessage() {}
+func (*UpdateOrganizationMemberResponse) ProtoMessage() {}
 
-func (x *ListProjectFirewallRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[42]
+func (x *UpdateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,55 +4975,41 @@ func (x *ListProjectFirewallRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectFirewallRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectFirewallRulesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *ListProjectFirewallRulesRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *ListProjectFirewallRulesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use UpdateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ListProjectFirewallRulesRequest) GetPageToken() string {
+func (x *UpdateOrganizationMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
-		return x.PageToken
+		return x.Member
 	}
-	return ""
+	return nil
 }
 
-type ListProjectFirewallRulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*ProjectFirewallRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DeleteOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListProjectFirewallRulesResponse) Reset() {
-	*x = ListProjectFirewallRulesResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[43]
+func (x *DeleteOrganizationMemberRequest) Reset() {
+	*x = DeleteOrganizationMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectFirewallRulesResponse) String() string {
+func (x *DeleteOrganizationMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectFirewallRulesResponse) ProtoMessage() {}
+func (*DeleteOrganizationMemberRequest) ProtoMessage() {}
 
-func (x *ListProjectFirewallRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[43]
+func (x *DeleteOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2523,50 +5020,51 @@ func (x *ListProjectFirewallRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectFirewallRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectFirewallRulesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use DeleteOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ListProjectFirewallRulesResponse) GetRules() []*ProjectFirewallRule {
+func (x *DeleteOrganizationMemberRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Rules
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProjectFirewallRulesResponse) GetNextPageToken() string {
+func (x *DeleteOrganizationMemberRequest) GetAccountId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.AccountId
 	}
 	return ""
 }
 
-type CreateProjectFirewallRuleRequest struct {
+// BulkMemberEntry is one person to add in a BulkCreateMembersRequest
+type BulkMemberEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	RuleType      FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
-	Cidr          string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`  // Account to add
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                           // Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                             // "owner", "developer", "read"
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional Unix timestamp to remove the membership at. Not available for invitations
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectFirewallRuleRequest) Reset() {
-	*x = CreateProjectFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[44]
+func (x *BulkMemberEntry) Reset() {
+	*x = BulkMemberEntry{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectFirewallRuleRequest) String() string {
+func (x *BulkMemberEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectFirewallRuleRequest) ProtoMessage() {}
+func (*BulkMemberEntry) ProtoMessage() {}
 
-func (x *CreateProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[44]
+func (x *BulkMemberEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,106 +5075,63 @@ func (x *CreateProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use BulkMemberEntry.ProtoReflect.Descriptor instead.
+func (*BulkMemberEntry) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateProjectFirewallRuleRequest) GetProjectId() string {
+func (x *BulkMemberEntry) GetAccountId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *CreateProjectFirewallRuleRequest) GetRuleType() FirewallRuleType {
-	if x != nil {
-		return x.RuleType
-	}
-	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
-}
-
-func (x *CreateProjectFirewallRuleRequest) GetCidr() string {
+func (x *BulkMemberEntry) GetEmail() string {
 	if x != nil {
-		return x.Cidr
+		return x.Email
 	}
 	return ""
 }
 
-func (x *CreateProjectFirewallRuleRequest) GetName() string {
+func (x *BulkMemberEntry) GetRole() string {
 	if x != nil {
-		return x.Name
+		return x.Role
 	}
 	return ""
 }
 
-type CreateProjectFirewallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rule          *ProjectFirewallRule   `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateProjectFirewallRuleResponse) Reset() {
-	*x = CreateProjectFirewallRuleResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateProjectFirewallRuleResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateProjectFirewallRuleResponse) ProtoMessage() {}
-
-func (x *CreateProjectFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[45]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProjectFirewallRuleResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *CreateProjectFirewallRuleResponse) GetRule() *ProjectFirewallRule {
+func (x *BulkMemberEntry) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Rule
+		return x.ExpiresAt
 	}
-	return nil
+	return 0
 }
 
-type DeleteProjectFirewallRuleRequest struct {
+// BulkMemberRole is one role change in a BulkUpdateMemberRolesRequest
+type BulkMemberRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"` // New role
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectFirewallRuleRequest) Reset() {
-	*x = DeleteProjectFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[46]
+func (x *BulkMemberRole) Reset() {
+	*x = BulkMemberRole{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectFirewallRuleRequest) String() string {
+func (x *BulkMemberRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectFirewallRuleRequest) ProtoMessage() {}
+func (*BulkMemberRole) ProtoMessage() {}
 
-func (x *DeleteProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[46]
+func (x *BulkMemberRole) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,49 +5142,54 @@ func (x *DeleteProjectFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use BulkMemberRole.ProtoReflect.Descriptor instead.
+func (*BulkMemberRole) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteProjectFirewallRuleRequest) GetProjectId() string {
+func (x *BulkMemberRole) GetAccountId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *DeleteProjectFirewallRuleRequest) GetRuleId() string {
+func (x *BulkMemberRole) GetRole() string {
 	if x != nil {
-		return x.RuleId
+		return x.Role
 	}
 	return ""
 }
 
-type ListSiteFirewallRulesRequest struct {
+// BulkMemberResult is the outcome of one entry of a bulk membership request
+type BulkMemberResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // Position of the entry in the request
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Account the entry named, if any
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                          // Email the entry named, if any
+	Member        *MemberDetail          `protobuf:"bytes,4,opt,name=member,proto3" json:"member,omitempty"`                        // The membership as it is now, when added or updated
+	Invitation    *MemberInvitation      `protobuf:"bytes,5,opt,name=invitation,proto3" json:"invitation,omitempty"`                // Set when an invitation was emailed instead
+	ErrorCode     string                 `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // Connect error code, e.g. "not_found"; empty when the entry succeeded
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                          // Why the entry failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSiteFirewallRulesRequest) Reset() {
-	*x = ListSiteFirewallRulesRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[47]
+func (x *BulkMemberResult) Reset() {
+	*x = BulkMemberResult{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSiteFirewallRulesRequest) String() string {
+func (x *BulkMemberResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSiteFirewallRulesRequest) ProtoMessage() {}
+func (*BulkMemberResult) ProtoMessage() {}
 
-func (x *ListSiteFirewallRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[47]
+func (x *BulkMemberResult) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,55 +5200,83 @@ func (x *ListSiteFirewallRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSiteFirewallRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListSiteFirewallRulesRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use BulkMemberResult.ProtoReflect.Descriptor instead.
+func (*BulkMemberResult) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ListSiteFirewallRulesRequest) GetSiteId() string {
+func (x *BulkMemberResult) GetIndex() int32 {
 	if x != nil {
-		return x.SiteId
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkMemberResult) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *ListSiteFirewallRulesRequest) GetPageSize() int32 {
+func (x *BulkMemberResult) GetEmail() string {
 	if x != nil {
-		return x.PageSize
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSiteFirewallRulesRequest) GetPageToken() string {
+func (x *BulkMemberResult) GetMember() *MemberDetail {
 	if x != nil {
-		return x.PageToken
+		return x.Member
+	}
+	return nil
+}
+
+func (x *BulkMemberResult) GetInvitation() *MemberInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *BulkMemberResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
 	}
 	return ""
 }
 
-type ListSiteFirewallRulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*SiteFirewallRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BulkMemberResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ListSiteFirewallRulesResponse) Reset() {
-	*x = ListSiteFirewallRulesResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[48]
+type BulkCreateMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Members        []*BulkMemberEntry     `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"` // At most 500
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkCreateMembersRequest) Reset() {
+	*x = BulkCreateMembersRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSiteFirewallRulesResponse) String() string {
+func (x *BulkCreateMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSiteFirewallRulesResponse) ProtoMessage() {}
+func (*BulkCreateMembersRequest) ProtoMessage() {}
 
-func (x *ListSiteFirewallRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[48]
+func (x *BulkCreateMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,50 +5287,49 @@ func (x *ListSiteFirewallRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSiteFirewallRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListSiteFirewallRulesResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use BulkCreateMembersRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateMembersRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ListSiteFirewallRulesResponse) GetRules() []*SiteFirewallRule {
+func (x *BulkCreateMembersRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Rules
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSiteFirewallRulesResponse) GetNextPageToken() string {
+func (x *BulkCreateMembersRequest) GetMembers() []*BulkMemberEntry {
 	if x != nil {
-		return x.NextPageToken
+		return x.Members
 	}
-	return ""
+	return nil
 }
 
-type CreateSiteFirewallRuleRequest struct {
+type BulkCreateMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	RuleType      FirewallRuleType       `protobuf:"varint,2,opt,name=rule_type,json=ruleType,proto3,enum=libops.v1.FirewallRuleType" json:"rule_type,omitempty"`
-	Cidr          string                 `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Results       []*BulkMemberResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per entry, in request order
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSiteFirewallRuleRequest) Reset() {
-	*x = CreateSiteFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[49]
+func (x *BulkCreateMembersResponse) Reset() {
+	*x = BulkCreateMembersResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteFirewallRuleRequest) String() string {
+func (x *BulkCreateMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteFirewallRuleRequest) ProtoMessage() {}
+func (*BulkCreateMembersResponse) ProtoMessage() {}
 
-func (x *CreateSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[49]
+func (x *BulkCreateMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,61 +5340,55 @@ func (x *CreateSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateSiteFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *CreateSiteFirewallRuleRequest) GetSiteId() string {
-	if x != nil {
-		return x.SiteId
-	}
-	return ""
+// Deprecated: Use BulkCreateMembersResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateMembersResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CreateSiteFirewallRuleRequest) GetRuleType() FirewallRuleType {
+func (x *BulkCreateMembersResponse) GetResults() []*BulkMemberResult {
 	if x != nil {
-		return x.RuleType
+		return x.Results
 	}
-	return FirewallRuleType_FIREWALL_RULE_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateSiteFirewallRuleRequest) GetCidr() string {
+func (x *BulkCreateMembersResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.Cidr
+		return x.Succeeded
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSiteFirewallRuleRequest) GetName() string {
+func (x *BulkCreateMembersResponse) GetFailed() int32 {
 	if x != nil {
-		return x.Name
+		return x.Failed
 	}
-	return ""
+	return 0
 }
 
-type CreateSiteFirewallRuleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rule          *SiteFirewallRule      `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BulkUpdateMemberRolesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Members        []*BulkMemberRole      `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"` // At most 500
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateSiteFirewallRuleResponse) Reset() {
-	*x = CreateSiteFirewallRuleResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[50]
+func (x *BulkUpdateMemberRolesRequest) Reset() {
+	*x = BulkUpdateMemberRolesRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteFirewallRuleResponse) String() string {
+func (x *BulkUpdateMemberRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteFirewallRuleResponse) ProtoMessage() {}
+func (*BulkUpdateMemberRolesRequest) ProtoMessage() {}
 
-func (x *CreateSiteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[50]
+func (x *BulkUpdateMemberRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,41 +5399,49 @@ func (x *CreateSiteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteFirewallRuleResponse.ProtoReflect.Descriptor instead.
-func (*CreateSiteFirewallRuleResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BulkUpdateMemberRolesRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateMemberRolesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CreateSiteFirewallRuleResponse) GetRule() *SiteFirewallRule {
+func (x *BulkUpdateMemberRolesRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Rule
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *BulkUpdateMemberRolesRequest) GetMembers() []*BulkMemberRole {
+	if x != nil {
+		return x.Members
 	}
 	return nil
 }
 
-type DeleteSiteFirewallRuleRequest struct {
+type BulkUpdateMemberRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Results       []*BulkMemberResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per entry, in request order
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSiteFirewallRuleRequest) Reset() {
-	*x = DeleteSiteFirewallRuleRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[51]
+func (x *BulkUpdateMemberRolesResponse) Reset() {
+	*x = BulkUpdateMemberRolesResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSiteFirewallRuleRequest) String() string {
+func (x *BulkUpdateMemberRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSiteFirewallRuleRequest) ProtoMessage() {}
+func (*BulkUpdateMemberRolesResponse) ProtoMessage() {}
 
-func (x *DeleteSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[51]
+func (x *BulkUpdateMemberRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2963,49 +5452,55 @@ func (x *DeleteSiteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSiteFirewallRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSiteFirewallRuleRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use BulkUpdateMemberRolesResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateMemberRolesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteSiteFirewallRuleRequest) GetSiteId() string {
+func (x *BulkUpdateMemberRolesResponse) GetResults() []*BulkMemberResult {
 	if x != nil {
-		return x.SiteId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteSiteFirewallRuleRequest) GetRuleId() string {
+func (x *BulkUpdateMemberRolesResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.RuleId
+		return x.Succeeded
 	}
-	return ""
+	return 0
 }
 
-type ListOrganizationMembersRequest struct {
+func (x *BulkUpdateMemberRolesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type BulkRemoveMembersRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	AccountIds     []string               `protobuf:"bytes,2,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // At most 500
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListOrganizationMembersRequest) Reset() {
-	*x = ListOrganizationMembersRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[52]
+func (x *BulkRemoveMembersRequest) Reset() {
+	*x = BulkRemoveMembersRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationMembersRequest) String() string {
+func (x *BulkRemoveMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationMembersRequest) ProtoMessage() {}
+func (*BulkRemoveMembersRequest) ProtoMessage() {}
 
-func (x *ListOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[52]
+func (x *BulkRemoveMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3016,55 +5511,49 @@ func (x *ListOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrganizationMembersRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use BulkRemoveMembersRequest.ProtoReflect.Descriptor instead.
+func (*BulkRemoveMembersRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListOrganizationMembersRequest) GetOrganizationId() string {
+func (x *BulkRemoveMembersRequest) GetOrganizationId() string {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListOrganizationMembersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListOrganizationMembersRequest) GetPageToken() string {
+func (x *BulkRemoveMembersRequest) GetAccountIds() []string {
 	if x != nil {
-		return x.PageToken
+		return x.AccountIds
 	}
-	return ""
+	return nil
 }
 
-type ListOrganizationMembersResponse struct {
+type BulkRemoveMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Results       []*BulkMemberResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per entry, in request order
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrganizationMembersResponse) Reset() {
-	*x = ListOrganizationMembersResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[53]
+func (x *BulkRemoveMembersResponse) Reset() {
+	*x = BulkRemoveMembersResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationMembersResponse) String() string {
+func (x *BulkRemoveMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationMembersResponse) ProtoMessage() {}
+func (*BulkRemoveMembersResponse) ProtoMessage() {}
 
-func (x *ListOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[53]
+func (x *BulkRemoveMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3075,49 +5564,56 @@ func (x *ListOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrganizationMembersResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use BulkRemoveMembersResponse.ProtoReflect.Descriptor instead.
+func (*BulkRemoveMembersResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ListOrganizationMembersResponse) GetMembers() []*MemberDetail {
+func (x *BulkRemoveMembersResponse) GetResults() []*BulkMemberResult {
 	if x != nil {
-		return x.Members
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListOrganizationMembersResponse) GetNextPageToken() string {
+func (x *BulkRemoveMembersResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Succeeded
 	}
-	return ""
+	return 0
 }
 
-type CreateOrganizationMemberRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Account to add
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                            // "owner", "developer", "read"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BulkRemoveMembersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
 }
 
-func (x *CreateOrganizationMemberRequest) Reset() {
-	*x = CreateOrganizationMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[54]
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationMemberRequest) String() string {
+func (x *ListProjectMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationMemberRequest) ProtoMessage() {}
+func (*ListProjectMembersRequest) ProtoMessage() {}
 
-func (x *CreateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[54]
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3128,54 +5624,55 @@ func (x *CreateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *CreateOrganizationMemberRequest) GetOrganizationId() string {
+func (x *ListProjectMembersRequest) GetProjectId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateOrganizationMemberRequest) GetAccountId() string {
+func (x *ListProjectMembersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateOrganizationMemberRequest) GetRole() string {
+func (x *ListProjectMembersRequest) GetPageToken() string {
 	if x != nil {
-		return x.Role
+		return x.PageToken
 	}
 	return ""
 }
 
-type CreateOrganizationMemberResponse struct {
+type ListProjectMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrganizationMemberResponse) Reset() {
-	*x = CreateOrganizationMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[55]
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationMemberResponse) String() string {
+func (x *ListProjectMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationMemberResponse) ProtoMessage() {}
+func (*ListProjectMembersResponse) ProtoMessage() {}
 
-func (x *CreateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[55]
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,43 +5683,51 @@ func (x *CreateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CreateOrganizationMemberResponse) GetMember() *MemberDetail {
+func (x *ListProjectMembersResponse) GetMembers() []*MemberDetail {
 	if x != nil {
-		return x.Member
+		return x.Members
 	}
 	return nil
 }
 
-type UpdateOrganizationMemberRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
-	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListProjectMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *UpdateOrganizationMemberRequest) Reset() {
-	*x = UpdateOrganizationMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[56]
+type CreateProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`  // Account to add
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                             // "developer", "read"
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                           // Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional Unix timestamp to remove the membership at. Not available for invitations
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectMemberRequest) Reset() {
+	*x = CreateProjectMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrganizationMemberRequest) String() string {
+func (x *CreateProjectMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrganizationMemberRequest) ProtoMessage() {}
+func (*CreateProjectMemberRequest) ProtoMessage() {}
 
-func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[56]
+func (x *CreateProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3233,61 +5738,69 @@ func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use CreateProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UpdateOrganizationMemberRequest) GetOrganizationId() string {
+func (x *CreateProjectMemberRequest) GetProjectId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *UpdateOrganizationMemberRequest) GetAccountId() string {
+func (x *CreateProjectMemberRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *UpdateOrganizationMemberRequest) GetRole() string {
+func (x *CreateProjectMemberRequest) GetRole() string {
 	if x != nil {
 		return x.Role
 	}
 	return ""
 }
 
-func (x *UpdateOrganizationMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *CreateProjectMemberRequest) GetEmail() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type UpdateOrganizationMemberResponse struct {
+func (x *CreateProjectMemberRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateProjectMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`         // Set when an existing account was added
+	Invitation    *MemberInvitation      `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"` // Set when an invitation was emailed instead
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrganizationMemberResponse) Reset() {
-	*x = UpdateOrganizationMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[57]
+func (x *CreateProjectMemberResponse) Reset() {
+	*x = CreateProjectMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrganizationMemberResponse) String() string {
+func (x *CreateProjectMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrganizationMemberResponse) ProtoMessage() {}
+func (*CreateProjectMemberResponse) ProtoMessage() {}
 
-func (x *UpdateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[57]
+func (x *CreateProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,41 +5811,51 @@ func (x *UpdateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CreateProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UpdateOrganizationMemberResponse) GetMember() *MemberDetail {
+func (x *CreateProjectMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
 		return x.Member
 	}
 	return nil
 }
 
-type DeleteOrganizationMemberRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CreateProjectMemberResponse) GetInvitation() *MemberInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
 }
 
-func (x *DeleteOrganizationMemberRequest) Reset() {
-	*x = DeleteOrganizationMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[58]
+type UpdateProjectMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpiresAt     *int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Unix timestamp to remove the membership at, 0 to keep it indefinitely
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectMemberRequest) Reset() {
+	*x = UpdateProjectMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationMemberRequest) String() string {
+func (x *UpdateProjectMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationMemberRequest) ProtoMessage() {}
+func (*UpdateProjectMemberRequest) ProtoMessage() {}
 
-func (x *DeleteOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[58]
+func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3343,49 +5866,68 @@ func (x *DeleteOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UpdateProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *DeleteOrganizationMemberRequest) GetOrganizationId() string {
+func (x *UpdateProjectMemberRequest) GetProjectId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *DeleteOrganizationMemberRequest) GetAccountId() string {
+func (x *UpdateProjectMemberRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-type ListProjectMembersRequest struct {
+func (x *UpdateProjectMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateProjectMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateProjectMemberRequest) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+type UpdateProjectMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectMembersRequest) Reset() {
-	*x = ListProjectMembersRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[59]
+func (x *UpdateProjectMemberResponse) Reset() {
+	*x = UpdateProjectMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectMembersRequest) String() string {
+func (x *UpdateProjectMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectMembersRequest) ProtoMessage() {}
+func (*UpdateProjectMemberResponse) ProtoMessage() {}
 
-func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[59]
+func (x *UpdateProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3396,55 +5938,41 @@ func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *ListProjectMembersRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *ListProjectMembersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use UpdateProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *ListProjectMembersRequest) GetPageToken() string {
+func (x *UpdateProjectMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
-		return x.PageToken
+		return x.Member
 	}
-	return ""
+	return nil
 }
 
-type ListProjectMembersResponse struct {
+type DeleteProjectMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectMembersResponse) Reset() {
-	*x = ListProjectMembersResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[60]
+func (x *DeleteProjectMemberRequest) Reset() {
+	*x = DeleteProjectMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectMembersResponse) String() string {
+func (x *DeleteProjectMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectMembersResponse) ProtoMessage() {}
+func (*DeleteProjectMemberRequest) ProtoMessage() {}
 
-func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[60]
+func (x *DeleteProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3455,49 +5983,49 @@ func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DeleteProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ListProjectMembersResponse) GetMembers() []*MemberDetail {
+func (x *DeleteProjectMemberRequest) GetProjectId() string {
 	if x != nil {
-		return x.Members
+		return x.ProjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProjectMembersResponse) GetNextPageToken() string {
+func (x *DeleteProjectMemberRequest) GetAccountId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.AccountId
 	}
 	return ""
 }
 
-type CreateProjectMemberRequest struct {
+type ListSiteMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Account to add
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                            // "developer", "read"
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectMemberRequest) Reset() {
-	*x = CreateProjectMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[61]
+func (x *ListSiteMembersRequest) Reset() {
+	*x = ListSiteMembersRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectMemberRequest) String() string {
+func (x *ListSiteMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectMemberRequest) ProtoMessage() {}
-
-func (x *CreateProjectMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[61]
+func (*ListSiteMembersRequest) ProtoMessage() {}
+
+func (x *ListSiteMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,54 +6036,55 @@ func (x *CreateProjectMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectMemberRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ListSiteMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListSiteMembersRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *CreateProjectMemberRequest) GetProjectId() string {
+func (x *ListSiteMembersRequest) GetSiteId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *CreateProjectMemberRequest) GetAccountId() string {
+func (x *ListSiteMembersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateProjectMemberRequest) GetRole() string {
+func (x *ListSiteMembersRequest) GetPageToken() string {
 	if x != nil {
-		return x.Role
+		return x.PageToken
 	}
 	return ""
 }
 
-type CreateProjectMemberResponse struct {
+type ListSiteMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectMemberResponse) Reset() {
-	*x = CreateProjectMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[62]
+func (x *ListSiteMembersResponse) Reset() {
+	*x = ListSiteMembersResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectMemberResponse) String() string {
+func (x *ListSiteMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectMemberResponse) ProtoMessage() {}
+func (*ListSiteMembersResponse) ProtoMessage() {}
 
-func (x *CreateProjectMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[62]
+func (x *ListSiteMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3566,43 +6095,51 @@ func (x *CreateProjectMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectMemberResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListSiteMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListSiteMembersResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *CreateProjectMemberResponse) GetMember() *MemberDetail {
+func (x *ListSiteMembersResponse) GetMembers() []*MemberDetail {
 	if x != nil {
-		return x.Member
+		return x.Members
 	}
 	return nil
 }
 
-type UpdateProjectMemberRequest struct {
+func (x *ListSiteMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateSiteMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`  // Account to add
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                             // "developer", "read"
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                           // Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional Unix timestamp to remove the membership at. Not available for invitations
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectMemberRequest) Reset() {
-	*x = UpdateProjectMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[63]
+func (x *CreateSiteMemberRequest) Reset() {
+	*x = CreateSiteMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectMemberRequest) String() string {
+func (x *CreateSiteMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectMemberRequest) ProtoMessage() {}
+func (*CreateSiteMemberRequest) ProtoMessage() {}
 
-func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[63]
+func (x *CreateSiteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,61 +6150,69 @@ func (x *UpdateProjectMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use CreateSiteMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateSiteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdateProjectMemberRequest) GetProjectId() string {
+func (x *CreateSiteMemberRequest) GetSiteId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *UpdateProjectMemberRequest) GetAccountId() string {
+func (x *CreateSiteMemberRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *UpdateProjectMemberRequest) GetRole() string {
+func (x *CreateSiteMemberRequest) GetRole() string {
 	if x != nil {
 		return x.Role
 	}
 	return ""
 }
 
-func (x *UpdateProjectMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *CreateSiteMemberRequest) GetEmail() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type UpdateProjectMemberResponse struct {
+func (x *CreateSiteMemberRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateSiteMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`         // Set when an existing account was added
+	Invitation    *MemberInvitation      `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"` // Set when an invitation was emailed instead
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectMemberResponse) Reset() {
-	*x = UpdateProjectMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[64]
+func (x *CreateSiteMemberResponse) Reset() {
+	*x = CreateSiteMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectMemberResponse) String() string {
+func (x *CreateSiteMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectMemberResponse) ProtoMessage() {}
+func (*CreateSiteMemberResponse) ProtoMessage() {}
 
-func (x *UpdateProjectMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[64]
+func (x *CreateSiteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3678,41 +6223,51 @@ func (x *UpdateProjectMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectMemberResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use CreateSiteMemberResponse.ProtoReflect.Descriptor instead.
+func (*CreateSiteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UpdateProjectMemberResponse) GetMember() *MemberDetail {
+func (x *CreateSiteMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
 		return x.Member
 	}
 	return nil
 }
 
-type DeleteProjectMemberRequest struct {
+func (x *CreateSiteMemberResponse) GetInvitation() *MemberInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type UpdateSiteMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpiresAt     *int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Unix timestamp to remove the membership at, 0 to keep it indefinitely
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectMemberRequest) Reset() {
-	*x = DeleteProjectMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[65]
+func (x *UpdateSiteMemberRequest) Reset() {
+	*x = UpdateSiteMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectMemberRequest) String() string {
+func (x *UpdateSiteMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectMemberRequest) ProtoMessage() {}
+func (*UpdateSiteMemberRequest) ProtoMessage() {}
 
-func (x *DeleteProjectMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[65]
+func (x *UpdateSiteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,49 +6278,68 @@ func (x *DeleteProjectMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use UpdateSiteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DeleteProjectMemberRequest) GetProjectId() string {
+func (x *UpdateSiteMemberRequest) GetSiteId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *DeleteProjectMemberRequest) GetAccountId() string {
+func (x *UpdateSiteMemberRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-type ListSiteMembersRequest struct {
+func (x *UpdateSiteMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateSiteMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateSiteMemberRequest) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+type UpdateSiteMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSiteMembersRequest) Reset() {
-	*x = ListSiteMembersRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[66]
+func (x *UpdateSiteMemberResponse) Reset() {
+	*x = UpdateSiteMemberResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSiteMembersRequest) String() string {
+func (x *UpdateSiteMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSiteMembersRequest) ProtoMessage() {}
+func (*UpdateSiteMemberResponse) ProtoMessage() {}
 
-func (x *ListSiteMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[66]
+func (x *UpdateSiteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3776,55 +6350,41 @@ func (x *ListSiteMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSiteMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListSiteMembersRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *ListSiteMembersRequest) GetSiteId() string {
-	if x != nil {
-		return x.SiteId
-	}
-	return ""
-}
-
-func (x *ListSiteMembersRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use UpdateSiteMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSiteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ListSiteMembersRequest) GetPageToken() string {
+func (x *UpdateSiteMemberResponse) GetMember() *MemberDetail {
 	if x != nil {
-		return x.PageToken
+		return x.Member
 	}
-	return ""
+	return nil
 }
 
-type ListSiteMembersResponse struct {
+type DeleteSiteMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*MemberDetail        `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSiteMembersResponse) Reset() {
-	*x = ListSiteMembersResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[67]
+func (x *DeleteSiteMemberRequest) Reset() {
+	*x = DeleteSiteMemberRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSiteMembersResponse) String() string {
+func (x *DeleteSiteMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSiteMembersResponse) ProtoMessage() {}
+func (*DeleteSiteMemberRequest) ProtoMessage() {}
 
-func (x *ListSiteMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[67]
+func (x *DeleteSiteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,49 +6395,49 @@ func (x *ListSiteMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSiteMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListSiteMembersResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use DeleteSiteMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ListSiteMembersResponse) GetMembers() []*MemberDetail {
+func (x *DeleteSiteMemberRequest) GetSiteId() string {
 	if x != nil {
-		return x.Members
+		return x.SiteId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSiteMembersResponse) GetNextPageToken() string {
+func (x *DeleteSiteMemberRequest) GetAccountId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.AccountId
 	}
 	return ""
 }
 
-type CreateSiteMemberRequest struct {
+type ListSshKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Account to add
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                            // "developer", "read"
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSiteMemberRequest) Reset() {
-	*x = CreateSiteMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[68]
+func (x *ListSshKeysRequest) Reset() {
+	*x = ListSshKeysRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteMemberRequest) String() string {
+func (x *ListSshKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteMemberRequest) ProtoMessage() {}
+func (*ListSshKeysRequest) ProtoMessage() {}
 
-func (x *CreateSiteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[68]
+func (x *ListSshKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,54 +6448,55 @@ func (x *CreateSiteMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteMemberRequest.ProtoReflect.Descriptor instead.
-func (*CreateSiteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ListSshKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListSshKeysRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateSiteMemberRequest) GetSiteId() string {
+func (x *ListSshKeysRequest) GetAccountId() string {
 	if x != nil {
-		return x.SiteId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *CreateSiteMemberRequest) GetAccountId() string {
+func (x *ListSshKeysRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSiteMemberRequest) GetRole() string {
+func (x *ListSshKeysRequest) GetPageToken() string {
 	if x != nil {
-		return x.Role
+		return x.PageToken
 	}
 	return ""
 }
 
-type CreateSiteMemberResponse struct {
+type ListSshKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	SshKeys       []*SshKey              `protobuf:"bytes,1,rep,name=ssh_keys,json=sshKeys,proto3" json:"ssh_keys,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSiteMemberResponse) Reset() {
-	*x = CreateSiteMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[69]
+func (x *ListSshKeysResponse) Reset() {
+	*x = ListSshKeysResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSiteMemberResponse) String() string {
+func (x *ListSshKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSiteMemberResponse) ProtoMessage() {}
+func (*ListSshKeysResponse) ProtoMessage() {}
 
-func (x *CreateSiteMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[69]
+func (x *ListSshKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3946,43 +6507,49 @@ func (x *CreateSiteMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSiteMemberResponse.ProtoReflect.Descriptor instead.
-func (*CreateSiteMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use ListSshKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListSshKeysResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *CreateSiteMemberResponse) GetMember() *MemberDetail {
+func (x *ListSshKeysResponse) GetSshKeys() []*SshKey {
 	if x != nil {
-		return x.Member
+		return x.SshKeys
 	}
 	return nil
 }
 
-type UpdateSiteMemberRequest struct {
+func (x *ListSshKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateSshKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // New role
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSiteMemberRequest) Reset() {
-	*x = UpdateSiteMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[70]
+func (x *CreateSshKeyRequest) Reset() {
+	*x = CreateSshKeyRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSiteMemberRequest) String() string {
+func (x *CreateSshKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSiteMemberRequest) ProtoMessage() {}
+func (*CreateSshKeyRequest) ProtoMessage() {}
 
-func (x *UpdateSiteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[70]
+func (x *CreateSshKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3993,61 +6560,54 @@ func (x *UpdateSiteMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSiteMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSiteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{70}
-}
-
-func (x *UpdateSiteMemberRequest) GetSiteId() string {
-	if x != nil {
-		return x.SiteId
-	}
-	return ""
+// Deprecated: Use CreateSshKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateSshKeyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *UpdateSiteMemberRequest) GetAccountId() string {
+func (x *CreateSshKeyRequest) GetAccountId() string {
 	if x != nil {
 		return x.AccountId
 	}
 	return ""
 }
 
-func (x *UpdateSiteMemberRequest) GetRole() string {
+func (x *CreateSshKeyRequest) GetPublicKey() string {
 	if x != nil {
-		return x.Role
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *UpdateSiteMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.UpdateMask
+func (x *CreateSshKeyRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-type UpdateSiteMemberResponse struct {
+type CreateSshKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *MemberDetail          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	SshKey        *SshKey                `protobuf:"bytes,1,opt,name=ssh_key,json=sshKey,proto3" json:"ssh_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSiteMemberResponse) Reset() {
-	*x = UpdateSiteMemberResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[71]
+func (x *CreateSshKeyResponse) Reset() {
+	*x = CreateSshKeyResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSiteMemberResponse) String() string {
+func (x *CreateSshKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSiteMemberResponse) ProtoMessage() {}
+func (*CreateSshKeyResponse) ProtoMessage() {}
 
-func (x *UpdateSiteMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[71]
+func (x *CreateSshKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4058,41 +6618,41 @@ func (x *UpdateSiteMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSiteMemberResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSiteMemberResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use CreateSshKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateSshKeyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *UpdateSiteMemberResponse) GetMember() *MemberDetail {
+func (x *CreateSshKeyResponse) GetSshKey() *SshKey {
 	if x != nil {
-		return x.Member
+		return x.SshKey
 	}
 	return nil
 }
 
-type DeleteSiteMemberRequest struct {
+type DeleteSshKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSiteMemberRequest) Reset() {
-	*x = DeleteSiteMemberRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[72]
+func (x *DeleteSshKeyRequest) Reset() {
+	*x = DeleteSshKeyRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSiteMemberRequest) String() string {
+func (x *DeleteSshKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSiteMemberRequest) ProtoMessage() {}
+func (*DeleteSshKeyRequest) ProtoMessage() {}
 
-func (x *DeleteSiteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[72]
+func (x *DeleteSshKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4103,49 +6663,47 @@ func (x *DeleteSiteMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSiteMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSiteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DeleteSshKeyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSshKeyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *DeleteSiteMemberRequest) GetSiteId() string {
+func (x *DeleteSshKeyRequest) GetAccountId() string {
 	if x != nil {
-		return x.SiteId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *DeleteSiteMemberRequest) GetAccountId() string {
+func (x *DeleteSshKeyRequest) GetKeyId() string {
 	if x != nil {
-		return x.AccountId
+		return x.KeyId
 	}
 	return ""
 }
 
-type ListSshKeysRequest struct {
+type GetSiteStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSshKeysRequest) Reset() {
-	*x = ListSshKeysRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[73]
+func (x *GetSiteStatusRequest) Reset() {
+	*x = GetSiteStatusRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSshKeysRequest) String() string {
+func (x *GetSiteStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSshKeysRequest) ProtoMessage() {}
+func (*GetSiteStatusRequest) ProtoMessage() {}
 
-func (x *ListSshKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[73]
+func (x *GetSiteStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,55 +6714,85 @@ func (x *ListSshKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSshKeysRequest.ProtoReflect.Descriptor instead.
-func (*ListSshKeysRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use GetSiteStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteStatusRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListSshKeysRequest) GetAccountId() string {
+func (x *GetSiteStatusRequest) GetSiteId() string {
 	if x != nil {
-		return x.AccountId
+		return x.SiteId
 	}
 	return ""
 }
 
-func (x *ListSshKeysRequest) GetPageSize() int32 {
+type GetSiteStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *SiteStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteStatusResponse) Reset() {
+	*x = GetSiteStatusResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteStatusResponse) ProtoMessage() {}
+
+func (x *GetSiteStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[114]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListSshKeysRequest) GetPageToken() string {
+// Deprecated: Use GetSiteStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteStatusResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetSiteStatusResponse) GetStatus() *SiteStatus {
 	if x != nil {
-		return x.PageToken
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type ListSshKeysResponse struct {
+type DeploySiteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SshKeys       []*SshKey              `protobuf:"bytes,1,rep,name=ssh_keys,json=sshKeys,proto3" json:"ssh_keys,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	GitRef        *string                `protobuf:"bytes,2,opt,name=git_ref,json=gitRef,proto3,oneof" json:"git_ref,omitempty"` // Branch, tag, or commit to deploy
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSshKeysResponse) Reset() {
-	*x = ListSshKeysResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[74]
+func (x *DeploySiteRequest) Reset() {
+	*x = DeploySiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSshKeysResponse) String() string {
+func (x *DeploySiteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSshKeysResponse) ProtoMessage() {}
+func (*DeploySiteRequest) ProtoMessage() {}
 
-func (x *ListSshKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[74]
+func (x *DeploySiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4215,49 +6803,48 @@ func (x *ListSshKeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSshKeysResponse.ProtoReflect.Descriptor instead.
-func (*ListSshKeysResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use DeploySiteRequest.ProtoReflect.Descriptor instead.
+func (*DeploySiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ListSshKeysResponse) GetSshKeys() []*SshKey {
+func (x *DeploySiteRequest) GetSiteId() string {
 	if x != nil {
-		return x.SshKeys
+		return x.SiteId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSshKeysResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
+func (x *DeploySiteRequest) GetGitRef() string {
+	if x != nil && x.GitRef != nil {
+		return *x.GitRef
 	}
 	return ""
 }
 
-type CreateSshKeyRequest struct {
+type DeploySiteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	DeploymentId  string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Status        *SiteStatus            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSshKeyRequest) Reset() {
-	*x = CreateSshKeyRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[75]
+func (x *DeploySiteResponse) Reset() {
+	*x = DeploySiteResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSshKeyRequest) String() string {
+func (x *DeploySiteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSshKeyRequest) ProtoMessage() {}
+func (*DeploySiteResponse) ProtoMessage() {}
 
-func (x *CreateSshKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[75]
+func (x *DeploySiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,54 +6855,58 @@ func (x *CreateSshKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSshKeyRequest.ProtoReflect.Descriptor instead.
-func (*CreateSshKeyRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use DeploySiteResponse.ProtoReflect.Descriptor instead.
+func (*DeploySiteResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *CreateSshKeyRequest) GetAccountId() string {
+func (x *DeploySiteResponse) GetDeploymentId() string {
 	if x != nil {
-		return x.AccountId
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *CreateSshKeyRequest) GetPublicKey() string {
+func (x *DeploySiteResponse) GetStatus() *SiteStatus {
 	if x != nil {
-		return x.PublicKey
-	}
-	return ""
-}
-
-func (x *CreateSshKeyRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type CreateSshKeyResponse struct {
+// Deployment is one deploy of a site
+type Deployment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SshKey        *SshKey                `protobuf:"bytes,1,opt,name=ssh_key,json=sshKey,proto3" json:"ssh_key,omitempty"`
+	DeploymentId  string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                        // "pending", "in_progress", "success", "failed"
+	GitRef        string                 `protobuf:"bytes,4,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`          // Branch, tag, or commit that was requested
+	CommitSha     string                 `protobuf:"bytes,5,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"` // Commit the ref pointed at, when it could be resolved
+	CommitMessage string                 `protobuf:"bytes,6,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	RunUrl        string                 `protobuf:"bytes,7,opt,name=run_url,json=runUrl,proto3" json:"run_url,omitempty"` // Workflow run performing the deployment
+	ErrorMessage  string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`        // Unix seconds
+	CompletedAt   int64                  `protobuf:"varint,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"` // Unix seconds, 0 until the deployment finishes
+	RollbackOf    string                 `protobuf:"bytes,11,opt,name=rollback_of,json=rollbackOf,proto3" json:"rollback_of,omitempty"`     // Deployment whose commit this rollback redeployed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSshKeyResponse) Reset() {
-	*x = CreateSshKeyResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[76]
+func (x *Deployment) Reset() {
+	*x = Deployment{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSshKeyResponse) String() string {
+func (x *Deployment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSshKeyResponse) ProtoMessage() {}
+func (*Deployment) ProtoMessage() {}
 
-func (x *CreateSshKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[76]
+func (x *Deployment) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4326,92 +6917,112 @@ func (x *CreateSshKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSshKeyResponse.ProtoReflect.Descriptor instead.
-func (*CreateSshKeyResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *CreateSshKeyResponse) GetSshKey() *SshKey {
+func (x *Deployment) GetDeploymentId() string {
 	if x != nil {
-		return x.SshKey
+		return x.DeploymentId
 	}
-	return nil
+	return ""
 }
 
-type DeleteSshKeyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Deployment) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
 }
 
-func (x *DeleteSshKeyRequest) Reset() {
-	*x = DeleteSshKeyRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Deployment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *DeleteSshKeyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Deployment) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
 }
 
-func (*DeleteSshKeyRequest) ProtoMessage() {}
+func (x *Deployment) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
 
-func (x *DeleteSshKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[77]
+func (x *Deployment) GetCommitMessage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CommitMessage
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteSshKeyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSshKeyRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{77}
+func (x *Deployment) GetRunUrl() string {
+	if x != nil {
+		return x.RunUrl
+	}
+	return ""
 }
 
-func (x *DeleteSshKeyRequest) GetAccountId() string {
+func (x *Deployment) GetErrorMessage() string {
 	if x != nil {
-		return x.AccountId
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *DeleteSshKeyRequest) GetKeyId() string {
+func (x *Deployment) GetStartedAt() int64 {
 	if x != nil {
-		return x.KeyId
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Deployment) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *Deployment) GetRollbackOf() string {
+	if x != nil {
+		return x.RollbackOf
 	}
 	return ""
 }
 
-type GetSiteStatusRequest struct {
+type ListSiteDeploymentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSiteStatusRequest) Reset() {
-	*x = GetSiteStatusRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[78]
+func (x *ListSiteDeploymentsRequest) Reset() {
+	*x = ListSiteDeploymentsRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSiteStatusRequest) String() string {
+func (x *ListSiteDeploymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSiteStatusRequest) ProtoMessage() {}
+func (*ListSiteDeploymentsRequest) ProtoMessage() {}
 
-func (x *GetSiteStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[78]
+func (x *ListSiteDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4422,40 +7033,55 @@ func (x *GetSiteStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSiteStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetSiteStatusRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListSiteDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSiteDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetSiteStatusRequest) GetSiteId() string {
+func (x *ListSiteDeploymentsRequest) GetSiteId() string {
 	if x != nil {
 		return x.SiteId
 	}
 	return ""
 }
 
-type GetSiteStatusResponse struct {
+func (x *ListSiteDeploymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSiteDeploymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSiteDeploymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *SiteStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSiteStatusResponse) Reset() {
-	*x = GetSiteStatusResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[79]
+func (x *ListSiteDeploymentsResponse) Reset() {
+	*x = ListSiteDeploymentsResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSiteStatusResponse) String() string {
+func (x *ListSiteDeploymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSiteStatusResponse) ProtoMessage() {}
+func (*ListSiteDeploymentsResponse) ProtoMessage() {}
 
-func (x *GetSiteStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[79]
+func (x *ListSiteDeploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4466,41 +7092,48 @@ func (x *GetSiteStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSiteStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetSiteStatusResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ListSiteDeploymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListSiteDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetSiteStatusResponse) GetStatus() *SiteStatus {
+func (x *ListSiteDeploymentsResponse) GetDeployments() []*Deployment {
 	if x != nil {
-		return x.Status
+		return x.Deployments
 	}
 	return nil
 }
 
-type DeploySiteRequest struct {
+func (x *ListSiteDeploymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RollbackSiteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
-	GitRef        *string                `protobuf:"bytes,2,opt,name=git_ref,json=gitRef,proto3,oneof" json:"git_ref,omitempty"` // Branch, tag, or commit to deploy
+	DeploymentId  string                 `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"` // Earlier successful deployment to return to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeploySiteRequest) Reset() {
-	*x = DeploySiteRequest{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[80]
+func (x *RollbackSiteRequest) Reset() {
+	*x = RollbackSiteRequest{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploySiteRequest) String() string {
+func (x *RollbackSiteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploySiteRequest) ProtoMessage() {}
+func (*RollbackSiteRequest) ProtoMessage() {}
 
-func (x *DeploySiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[80]
+func (x *RollbackSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4511,48 +7144,47 @@ func (x *DeploySiteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploySiteRequest.ProtoReflect.Descriptor instead.
-func (*DeploySiteRequest) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use RollbackSiteRequest.ProtoReflect.Descriptor instead.
+func (*RollbackSiteRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *DeploySiteRequest) GetSiteId() string {
+func (x *RollbackSiteRequest) GetSiteId() string {
 	if x != nil {
 		return x.SiteId
 	}
 	return ""
 }
 
-func (x *DeploySiteRequest) GetGitRef() string {
-	if x != nil && x.GitRef != nil {
-		return *x.GitRef
+func (x *RollbackSiteRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
 	}
 	return ""
 }
 
-type DeploySiteResponse struct {
+type RollbackSiteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeploymentId  string                 `protobuf:"bytes,1,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	Status        *SiteStatus            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeploySiteResponse) Reset() {
-	*x = DeploySiteResponse{}
-	mi := &file_libops_v1_organization_api_proto_msgTypes[81]
+func (x *RollbackSiteResponse) Reset() {
+	*x = RollbackSiteResponse{}
+	mi := &file_libops_v1_organization_api_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeploySiteResponse) String() string {
+func (x *RollbackSiteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeploySiteResponse) ProtoMessage() {}
+func (*RollbackSiteResponse) ProtoMessage() {}
 
-func (x *DeploySiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_libops_v1_organization_api_proto_msgTypes[81]
+func (x *RollbackSiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_organization_api_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4563,21 +7195,14 @@ func (x *DeploySiteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeploySiteResponse.ProtoReflect.Descriptor instead.
-func (*DeploySiteResponse) Descriptor() ([]byte, []int) {
-	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *DeploySiteResponse) GetDeploymentId() string {
-	if x != nil {
-		return x.DeploymentId
-	}
-	return ""
+// Deprecated: Use RollbackSiteResponse.ProtoReflect.Descriptor instead.
+func (*RollbackSiteResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_organization_api_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DeploySiteResponse) GetStatus() *SiteStatus {
+func (x *RollbackSiteResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.Status
+		return x.Deployment
 	}
 	return nil
 }
@@ -4586,7 +7211,7 @@ var File_libops_v1_organization_api_proto protoreflect.FileDescriptor
 
 const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\n" +
-	" libops/v1/organization_api.proto\x12\tlibops.v1\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1elibops/v1/common/project.proto\x1a#libops/v1/common/organization.proto\x1a\x1blibops/v1/common/site.proto\x1a\x1clibops/v1/common/types.proto\x1a\x1dlibops/v1/options/scope.proto\"[\n" +
+	" libops/v1/organization_api.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x15libops/v1/addon.proto\x1a\x1elibops/v1/common/billing.proto\x1a\x1elibops/v1/common/project.proto\x1a#libops/v1/common/organization.proto\x1a\x1blibops/v1/common/site.proto\x1a\x1clibops/v1/common/types.proto\x1a\x1dlibops/v1/options/scope.proto\"[\n" +
 	"\x11GetProjectRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
@@ -4606,17 +7231,29 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"R\n" +
 	"\x15UpdateProjectResponse\x129\n" +
-	"\aproject\x18\x01 \x01(\v2\x1f.libops.v1.common.ProjectConfigR\aproject\"^\n" +
+	"\aproject\x18\x01 \x01(\v2\x1f.libops.v1.common.ProjectConfigR\aproject\"\xa0\x01\n" +
+	"\x11ChangePlanRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12!\n" +
+	"\fmachine_type\x18\x03 \x01(\tR\vmachineType\x12 \n" +
+	"\fdisk_size_gb\x18\x04 \x01(\x05R\n" +
+	"diskSizeGb\"f\n" +
+	"\x12ChangePlanResponse\x129\n" +
+	"\aproject\x18\x01 \x01(\v2\x1f.libops.v1.common.ProjectConfigR\aproject\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"^\n" +
 	"\x14DeleteProjectRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId\"\x93\x01\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\"\xd2\x01\n" +
 	"\x13ListProjectsRequest\x12,\n" +
 	"\x0forganization_id\x18\x01 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageTokenB\x12\n" +
-	"\x10_organization_id\"{\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12*\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tH\x01R\rlabelSelector\x88\x01\x01B\x12\n" +
+	"\x10_organization_idB\x11\n" +
+	"\x0f_label_selector\"{\n" +
 	"\x14ListProjectsResponse\x12;\n" +
 	"\bprojects\x18\x01 \x03(\v2\x1f.libops.v1.common.ProjectConfigR\bprojects\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"t\n" +
@@ -4663,7 +7300,58 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	" ListOrganizationProjectsResponse\x12\x1f\n" +
 	"\vproject_ids\x18\x01 \x03(\tR\n" +
 	"projectIds\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\")\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\";\n" +
+	"\x10GetQuotasRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"X\n" +
+	"\x11GetQuotasResponse\x12\x12\n" +
+	"\x04plan\x18\x01 \x01(\tR\x04plan\x12/\n" +
+	"\x06quotas\x18\x02 \x03(\v2\x17.libops.v1.common.QuotaR\x06quotas\"F\n" +
+	"\x1bGetOrganizationUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\x80\x03\n" +
+	"\x1cGetOrganizationUsageResponse\x12I\n" +
+	"\fsubscription\x18\x01 \x01(\v2%.libops.v1.common.BillingSubscriptionR\fsubscription\x12:\n" +
+	"\bprojects\x18\x02 \x03(\v2\x1e.libops.v1.common.ProjectUsageR\bprojects\x12\"\n" +
+	"\rtotal_disk_gb\x18\x03 \x01(\x03R\vtotalDiskGb\x120\n" +
+	"\x14backup_storage_bytes\x18\x04 \x01(\x03R\x12backupStorageBytes\x12!\n" +
+	"\fegress_bytes\x18\x05 \x01(\x03R\vegressBytes\x128\n" +
+	"\x05items\x18\x06 \x03(\v2\".libops.v1.common.SubscriptionItemR\x05items\x12&\n" +
+	"\x0fdisk_used_bytes\x18\a \x01(\x03R\rdiskUsedBytes\">\n" +
+	"\x13PreviewUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xfa\x01\n" +
+	"\x14PreviewUsageResponse\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\x03R\tperiodEnd\x124\n" +
+	"\x05usage\x18\x03 \x03(\v2\x1e.libops.v1.common.MeteredUsageR\x05usage\x124\n" +
+	"\x16estimated_amount_cents\x18\x04 \x01(\x03R\x14estimatedAmountCents\x124\n" +
+	"\x16projected_amount_cents\x18\x05 \x01(\x03R\x14projectedAmountCents\"L\n" +
+	"!CreateBillingPortalSessionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"6\n" +
+	"\"CreateBillingPortalSessionResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"D\n" +
+	"\x19ListPaymentMethodsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"f\n" +
+	"\x1aListPaymentMethodsResponse\x12H\n" +
+	"\x0fpayment_methods\x18\x01 \x03(\v2\x1f.libops.v1.common.PaymentMethodR\x0epaymentMethods\"u\n" +
+	"\x1eSetDefaultPaymentMethodRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x11payment_method_id\x18\x02 \x01(\tR\x0fpaymentMethodId\"i\n" +
+	"\x1fSetDefaultPaymentMethodResponse\x12F\n" +
+	"\x0epayment_method\x18\x01 \x01(\v2\x1f.libops.v1.common.PaymentMethodR\rpaymentMethod\"z\n" +
+	"\x13ListInvoicesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"u\n" +
+	"\x14ListInvoicesResponse\x125\n" +
+	"\binvoices\x18\x01 \x03(\v2\x19.libops.v1.common.InvoiceR\binvoices\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"[\n" +
+	"\x11GetInvoiceRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\"I\n" +
+	"\x12GetInvoiceResponse\x123\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x19.libops.v1.common.InvoiceR\ainvoice\")\n" +
 	"\x0eGetSiteRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\"C\n" +
 	"\x0fGetSiteResponse\x120\n" +
@@ -4683,16 +7371,18 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\x12UpdateSiteResponse\x120\n" +
 	"\x04site\x18\x01 \x01(\v2\x1c.libops.v1.common.SiteConfigR\x04site\",\n" +
 	"\x11DeleteSiteRequest\x12\x17\n" +
-	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\xc3\x01\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"\x82\x02\n" +
 	"\x10ListSitesRequest\x12,\n" +
 	"\x0forganization_id\x18\x01 \x01(\tH\x00R\x0eorganizationId\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tH\x01R\tprojectId\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x04 \x01(\tR\tpageTokenB\x12\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12*\n" +
+	"\x0elabel_selector\x18\x05 \x01(\tH\x02R\rlabelSelector\x88\x01\x01B\x12\n" +
 	"\x10_organization_idB\r\n" +
-	"\v_project_id\"o\n" +
+	"\v_project_idB\x11\n" +
+	"\x0f_label_selector\"o\n" +
 	"\x11ListSitesResponse\x122\n" +
 	"\x05sites\x18\x01 \x03(\v2\x1c.libops.v1.common.SiteConfigR\x05sites\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf0\x01\n" +
@@ -4717,7 +7407,19 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\trule_type\x18\x03 \x01(\x0e2\x1b.libops.v1.FirewallRuleTypeR\bruleType\x12\x12\n" +
 	"\x04cidr\x18\x04 \x01(\tR\x04cidr\x12\x12\n" +
 	"\x04name\x18\x05 \x01(\tR\x04name\x120\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\xfc\x01\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\xac\x02\n" +
+	"\x11SiteRateLimitRule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpath_prefix\x18\x04 \x01(\tR\n" +
+	"pathPrefix\x12\x1a\n" +
+	"\brequests\x18\x05 \x01(\x05R\brequests\x122\n" +
+	"\x06window\x18\x06 \x01(\x0e2\x1a.libops.v1.RateLimitWindowR\x06window\x12\x14\n" +
+	"\x05burst\x18\a \x01(\x05R\x05burst\x12+\n" +
+	"\x11rejected_requests\x18\b \x01(\x03R\x10rejectedRequests\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\x9b\x02\n" +
 	"\fMemberDetail\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
@@ -4726,8 +7428,16 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12,\n" +
 	"\x0fgithub_username\x18\x05 \x01(\tH\x00R\x0egithubUsername\x88\x01\x01\x120\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12\x1b\n" +
-	"\tmember_id\x18\a \x01(\tR\bmemberIdB\x12\n" +
-	"\x10_github_username\"\xb6\x01\n" +
+	"\tmember_id\x18\a \x01(\tR\bmemberId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAtB\x12\n" +
+	"\x10_github_username\"\x80\x01\n" +
+	"\x10MemberInvitation\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"\xb6\x01\n" +
 	"\x06SshKey\x12\x15\n" +
 	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1d\n" +
 	"\n" +
@@ -4737,17 +7447,28 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\x04name\x18\x04 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vfingerprint\x18\x05 \x01(\tH\x01R\vfingerprint\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_fingerprint\"\x9e\x01\n" +
+	"\f_fingerprint\"\xb9\x02\n" +
 	"\n" +
 	"SiteStatus\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01\x12$\n" +
 	"\vdeployed_at\x18\x04 \x01(\tH\x01R\n" +
-	"deployedAt\x88\x01\x01B\n" +
+	"deployedAt\x88\x01\x01\x12+\n" +
+	"\x03cdn\x18\x05 \x01(\v2\x19.libops.v1.common.SiteCdnR\x03cdn\x12,\n" +
+	"\x06addons\x18\x06 \x03(\v2\x14.libops.v1.SiteAddonR\x06addons\x12>\n" +
+	"\n" +
+	"components\x18\a \x03(\v2\x1e.libops.v1.SiteComponentStatusR\n" +
+	"componentsB\n" +
 	"\n" +
 	"\b_messageB\x0e\n" +
-	"\f_deployed_at\"\x8b\x01\n" +
+	"\f_deployed_at\"\xa3\x01\n" +
+	"\x13SiteComponentStatus\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x123\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1d.libops.v1.SiteComponentStateR\x05state\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vobserved_at\x18\x04 \x01(\x03R\n" +
+	"observedAt\"\x8b\x01\n" +
 	"$ListOrganizationFirewallRulesRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -4804,6 +7525,23 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\x04rule\x18\x01 \x01(\v2\x1b.libops.v1.SiteFirewallRuleR\x04rule\"Q\n" +
 	"\x1dDeleteSiteFirewallRuleRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"8\n" +
+	"\x1dListSiteRateLimitRulesRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"T\n" +
+	"\x1eListSiteRateLimitRulesResponse\x122\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1c.libops.v1.SiteRateLimitRuleR\x05rules\"\xd4\x01\n" +
+	"\x1eCreateSiteRateLimitRuleRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpath_prefix\x18\x03 \x01(\tR\n" +
+	"pathPrefix\x12\x1a\n" +
+	"\brequests\x18\x04 \x01(\x05R\brequests\x122\n" +
+	"\x06window\x18\x05 \x01(\x0e2\x1a.libops.v1.RateLimitWindowR\x06window\x12\x14\n" +
+	"\x05burst\x18\x06 \x01(\x05R\x05burst\"S\n" +
+	"\x1fCreateSiteRateLimitRuleResponse\x120\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1c.libops.v1.SiteRateLimitRuleR\x04rule\"R\n" +
+	"\x1eDeleteSiteRateLimitRuleRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x17\n" +
 	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"\x85\x01\n" +
 	"\x1eListOrganizationMembersRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
@@ -4812,27 +7550,81 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"|\n" +
 	"\x1fListOrganizationMembersResponse\x121\n" +
 	"\amembers\x18\x01 \x03(\v2\x17.libops.v1.MemberDetailR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb2\x01\n" +
 	"\x1fCreateOrganizationMemberRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"S\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"\x90\x01\n" +
 	" CreateOrganizationMemberResponse\x12/\n" +
-	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"\xba\x01\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\x12;\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2\x1b.libops.v1.MemberInvitationR\n" +
+	"invitation\"\xed\x01\n" +
 	"\x1fUpdateOrganizationMemberRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"S\n" +
+	"updateMask\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"S\n" +
 	" UpdateOrganizationMemberResponse\x12/\n" +
 	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"i\n" +
 	"\x1fDeleteOrganizationMemberRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x02 \x01(\tR\taccountId\"v\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"y\n" +
+	"\x0fBulkMemberEntry\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"C\n" +
+	"\x0eBulkMemberRole\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x80\x02\n" +
+	"\x10BulkMemberResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12/\n" +
+	"\x06member\x18\x04 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\x12;\n" +
+	"\n" +
+	"invitation\x18\x05 \x01(\v2\x1b.libops.v1.MemberInvitationR\n" +
+	"invitation\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x06 \x01(\tR\terrorCode\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"y\n" +
+	"\x18BulkCreateMembersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x124\n" +
+	"\amembers\x18\x02 \x03(\v2\x1a.libops.v1.BulkMemberEntryR\amembers\"\x88\x01\n" +
+	"\x19BulkCreateMembersResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.libops.v1.BulkMemberResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"|\n" +
+	"\x1cBulkUpdateMemberRolesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x123\n" +
+	"\amembers\x18\x02 \x03(\v2\x19.libops.v1.BulkMemberRoleR\amembers\"\x8c\x01\n" +
+	"\x1dBulkUpdateMemberRolesResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.libops.v1.BulkMemberResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"d\n" +
+	"\x18BulkRemoveMembersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vaccount_ids\x18\x02 \x03(\tR\n" +
+	"accountIds\"\x88\x01\n" +
+	"\x19BulkRemoveMembersResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.libops.v1.BulkMemberResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"v\n" +
 	"\x19ListProjectMembersRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
@@ -4841,15 +7633,21 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
 	"\x1aListProjectMembersResponse\x121\n" +
 	"\amembers\x18\x01 \x03(\v2\x17.libops.v1.MemberDetailR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"n\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa3\x01\n" +
 	"\x1aCreateProjectMemberRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"N\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"\x8b\x01\n" +
 	"\x1bCreateProjectMemberResponse\x12/\n" +
-	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"\xab\x01\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\x12;\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2\x1b.libops.v1.MemberInvitationR\n" +
+	"invitation\"\xde\x01\n" +
 	"\x1aUpdateProjectMemberRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
@@ -4857,7 +7655,10 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"N\n" +
+	"updateMask\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"N\n" +
 	"\x1bUpdateProjectMemberResponse\x12/\n" +
 	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"Z\n" +
 	"\x1aDeleteProjectMemberRequest\x12\x1d\n" +
@@ -4872,21 +7673,30 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"t\n" +
 	"\x17ListSiteMembersResponse\x121\n" +
 	"\amembers\x18\x01 \x03(\v2\x17.libops.v1.MemberDetailR\amembers\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9a\x01\n" +
 	"\x17CreateSiteMemberRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"K\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\"\x88\x01\n" +
 	"\x18CreateSiteMemberResponse\x12/\n" +
-	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"\xa2\x01\n" +
+	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\x12;\n" +
+	"\n" +
+	"invitation\x18\x02 \x01(\v2\x1b.libops.v1.MemberInvitationR\n" +
+	"invitation\"\xd5\x01\n" +
 	"\x17UpdateSiteMemberRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"K\n" +
+	"updateMask\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"K\n" +
 	"\x18UpdateSiteMemberResponse\x12/\n" +
 	"\x06member\x18\x01 \x01(\v2\x17.libops.v1.MemberDetailR\x06member\"Q\n" +
 	"\x17DeleteSiteMemberRequest\x12\x17\n" +
@@ -4926,87 +7736,150 @@ const file_libops_v1_organization_api_proto_rawDesc = "" +
 	"\b_git_ref\"h\n" +
 	"\x12DeploySiteResponse\x12#\n" +
 	"\rdeployment_id\x18\x01 \x01(\tR\fdeploymentId\x12-\n" +
-	"\x06status\x18\x02 \x01(\v2\x15.libops.v1.SiteStatusR\x06status*\xa0\x01\n" +
+	"\x06status\x18\x02 \x01(\v2\x15.libops.v1.SiteStatusR\x06status\"\xe2\x02\n" +
+	"\n" +
+	"Deployment\x12#\n" +
+	"\rdeployment_id\x18\x01 \x01(\tR\fdeploymentId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x17\n" +
+	"\agit_ref\x18\x04 \x01(\tR\x06gitRef\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\x05 \x01(\tR\tcommitSha\x12%\n" +
+	"\x0ecommit_message\x18\x06 \x01(\tR\rcommitMessage\x12\x17\n" +
+	"\arun_url\x18\a \x01(\tR\x06runUrl\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\x03R\vcompletedAt\x12\x1f\n" +
+	"\vrollback_of\x18\v \x01(\tR\n" +
+	"rollbackOf\"q\n" +
+	"\x1aListSiteDeploymentsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"\x1bListSiteDeploymentsResponse\x127\n" +
+	"\vdeployments\x18\x01 \x03(\v2\x15.libops.v1.DeploymentR\vdeployments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"S\n" +
+	"\x13RollbackSiteRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12#\n" +
+	"\rdeployment_id\x18\x02 \x01(\tR\fdeploymentId\"M\n" +
+	"\x14RollbackSiteResponse\x125\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x15.libops.v1.DeploymentR\n" +
+	"deployment*\xa0\x01\n" +
 	"\x10FirewallRuleType\x12\"\n" +
 	"\x1eFIREWALL_RULE_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
 	" FIREWALL_RULE_TYPE_HTTPS_ALLOWED\x10\x01\x12\"\n" +
 	"\x1eFIREWALL_RULE_TYPE_SSH_ALLOWED\x10\x02\x12\x1e\n" +
-	"\x1aFIREWALL_RULE_TYPE_BLOCKED\x10\x032\xea\x06\n" +
-	"\x13OrganizationService\x12\x8b\x01\n" +
-	"\x0fGetOrganization\x12!.libops.v1.GetOrganizationRequest\x1a\".libops.v1.GetOrganizationResponse\"1\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x90\x02\x01\x12\x81\x01\n" +
-	"\x12CreateOrganization\x12$.libops.v1.CreateOrganizationRequest\x1a%.libops.v1.CreateOrganizationResponse\"\x1e\x92\xb5\x18\x1a\b\x02\x10\x02\x18\x01\"\x12write:organization\x12\x92\x01\n" +
-	"\x12UpdateOrganization\x12$.libops.v1.UpdateOrganizationRequest\x1a%.libops.v1.UpdateOrganizationResponse\"/\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x12\x84\x01\n" +
-	"\x12DeleteOrganization\x12$.libops.v1.DeleteOrganizationRequest\x1a\x16.google.protobuf.Empty\"0\x92\xb5\x18,\b\x03\x10\x03\x18\x01\"\x13delete:organization*\x0forganization_id\x12\x80\x01\n" +
-	"\x11ListOrganizations\x12#.libops.v1.ListOrganizationsRequest\x1a$.libops.v1.ListOrganizationsResponse\" \x92\xb5\x18\x19\b\x02\x10\x01\x18\x01\"\x11read:organization\x90\x02\x01\x12\xa1\x01\n" +
-	"\x18ListOrganizationProjects\x12*.libops.v1.ListOrganizationProjectsRequest\x1a+.libops.v1.ListOrganizationProjectsResponse\",\x92\xb5\x18%\b\x03\x10\x01\x18\x01\"\fread:project*\x0forganization_id\x90\x02\x012\x9a\x04\n" +
-	"\vSiteService\x12`\n" +
-	"\tListSites\x12\x1b.libops.v1.ListSitesRequest\x1a\x1c.libops.v1.ListSitesResponse\"\x18\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:site\x90\x02\x01\x12c\n" +
-	"\aGetSite\x12\x19.libops.v1.GetSiteRequest\x1a\x1a.libops.v1.GetSiteResponse\"!\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x90\x02\x01\x12r\n" +
+	"\x1aFIREWALL_RULE_TYPE_BLOCKED\x10\x03*p\n" +
+	"\x0fRateLimitWindow\x12!\n" +
+	"\x1dRATE_LIMIT_WINDOW_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RATE_LIMIT_WINDOW_SECOND\x10\x01\x12\x1c\n" +
+	"\x18RATE_LIMIT_WINDOW_MINUTE\x10\x02*\xbb\x01\n" +
+	"\x12SiteComponentState\x12$\n" +
+	" SITE_COMPONENT_STATE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SITE_COMPONENT_STATE_OK\x10\x01\x12 \n" +
+	"\x1cSITE_COMPONENT_STATE_WARNING\x10\x02\x12\x1e\n" +
+	"\x1aSITE_COMPONENT_STATE_ERROR\x10\x03\x12 \n" +
+	"\x1cSITE_COMPONENT_STATE_UNKNOWN\x10\x042\xb4\x15\n" +
+	"\x13OrganizationService\x12\xb6\x01\n" +
+	"\x0fGetOrganization\x12!.libops.v1.GetOrganizationRequest\x1a\".libops.v1.GetOrganizationResponse\"\\\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02%\x12#/v1/organizations/{organization_id}\x90\x02\x01\x12\x9d\x01\n" +
+	"\x12CreateOrganization\x12$.libops.v1.CreateOrganizationRequest\x1a%.libops.v1.CreateOrganizationResponse\":\x92\xb5\x18\x1a\b\x02\x10\x02\x18\x01\"\x12write:organization\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/organizations\x12\xc0\x01\n" +
+	"\x12UpdateOrganization\x12$.libops.v1.UpdateOrganizationRequest\x1a%.libops.v1.UpdateOrganizationResponse\"]\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02(:\x01*2#/v1/organizations/{organization_id}\x12\xaf\x01\n" +
+	"\x12DeleteOrganization\x12$.libops.v1.DeleteOrganizationRequest\x1a\x16.google.protobuf.Empty\"[\x92\xb5\x18,\b\x03\x10\x03\x18\x01\"\x13delete:organization*\x0forganization_id\x82\xd3\xe4\x93\x02%*#/v1/organizations/{organization_id}\x12\x99\x01\n" +
+	"\x11ListOrganizations\x12#.libops.v1.ListOrganizationsRequest\x1a$.libops.v1.ListOrganizationsResponse\"9\x92\xb5\x18\x19\b\x02\x10\x01\x18\x01\"\x11read:organization\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/organizations\x90\x02\x01\x12\xd5\x01\n" +
+	"\x18ListOrganizationProjects\x12*.libops.v1.ListOrganizationProjectsRequest\x1a+.libops.v1.ListOrganizationProjectsResponse\"`\x92\xb5\x18%\b\x03\x10\x01\x18\x01\"\fread:project*\x0forganization_id\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_id}/projects\x90\x02\x01\x12\xab\x01\n" +
+	"\tGetQuotas\x12\x1b.libops.v1.GetQuotasRequest\x1a\x1c.libops.v1.GetQuotasResponse\"c\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02,\x12*/v1/organizations/{organization_id}/quotas\x90\x02\x01\x12\xcb\x01\n" +
+	"\x14GetOrganizationUsage\x12&.libops.v1.GetOrganizationUsageRequest\x1a'.libops.v1.GetOrganizationUsageResponse\"b\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02+\x12)/v1/organizations/{organization_id}/usage\x90\x02\x01\x12\xbb\x01\n" +
+	"\fPreviewUsage\x12\x1e.libops.v1.PreviewUsageRequest\x1a\x1f.libops.v1.PreviewUsageResponse\"j\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x023\x121/v1/organizations/{organization_id}/usage:preview\x90\x02\x01\x12\xe9\x01\n" +
+	"\x1aCreateBillingPortalSession\x12,.libops.v1.CreateBillingPortalSessionRequest\x1a-.libops.v1.CreateBillingPortalSessionResponse\"n\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:billing*\x0forganization_id\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/organizations/{organization_id}/billingPortalSessions\x12\xc9\x01\n" +
+	"\x12ListPaymentMethods\x12$.libops.v1.ListPaymentMethodsRequest\x1a%.libops.v1.ListPaymentMethodsResponse\"f\x92\xb5\x18%\b\x03\x10\x03\x18\x01\"\fread:billing*\x0forganization_id\x82\xd3\xe4\x93\x024\x122/v1/organizations/{organization_id}/paymentMethods\x90\x02\x01\x12\xf9\x01\n" +
+	"\x17SetDefaultPaymentMethod\x12).libops.v1.SetDefaultPaymentMethodRequest\x1a*.libops.v1.SetDefaultPaymentMethodResponse\"\x86\x01\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:billing*\x0forganization_id\x82\xd3\xe4\x93\x02V:\x01*\"Q/v1/organizations/{organization_id}/paymentMethods/{payment_method_id}:setDefault\x12\xb1\x01\n" +
+	"\fListInvoices\x12\x1e.libops.v1.ListInvoicesRequest\x1a\x1f.libops.v1.ListInvoicesResponse\"`\x92\xb5\x18%\b\x03\x10\x03\x18\x01\"\fread:billing*\x0forganization_id\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_id}/invoices\x90\x02\x01\x12\xb8\x01\n" +
+	"\n" +
+	"GetInvoice\x12\x1c.libops.v1.GetInvoiceRequest\x1a\x1d.libops.v1.GetInvoiceResponse\"m\x92\xb5\x18%\b\x03\x10\x03\x18\x01\"\fread:billing*\x0forganization_id\x82\xd3\xe4\x93\x02;\x129/v1/organizations/{organization_id}/invoices/{invoice_id}\x90\x02\x012\xab\x05\n" +
+	"\vSiteService\x12q\n" +
+	"\tListSites\x12\x1b.libops.v1.ListSitesRequest\x1a\x1c.libops.v1.ListSitesResponse\")\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:site\x82\xd3\xe4\x93\x02\v\x12\t/v1/sites\x90\x02\x01\x12~\n" +
+	"\aGetSite\x12\x19.libops.v1.GetSiteRequest\x1a\x1a.libops.v1.GetSiteResponse\"<\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/sites/{site_id}\x90\x02\x01\x12\x9c\x01\n" +
+	"\n" +
+	"CreateSite\x12\x1c.libops.v1.CreateSiteRequest\x1a\x1d.libops.v1.CreateSiteResponse\"Q\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\rwrite:project2\n" +
+	"project_id8\x04\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/projects/{project_id}/sites\x12\x88\x01\n" +
 	"\n" +
-	"CreateSite\x12\x1c.libops.v1.CreateSiteRequest\x1a\x1d.libops.v1.CreateSiteResponse\"'\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\rwrite:project2\n" +
-	"project_id8\x04\x12j\n" +
+	"UpdateSite\x12\x1c.libops.v1.UpdateSiteRequest\x1a\x1d.libops.v1.UpdateSiteResponse\"=\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/v1/sites/{site_id}\x12\x7f\n" +
 	"\n" +
-	"UpdateSite\x12\x1c.libops.v1.UpdateSiteRequest\x1a\x1d.libops.v1.UpdateSiteResponse\"\x1f\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
-	"write:site*\asite_id\x12d\n" +
+	"DeleteSite\x12\x1c.libops.v1.DeleteSiteRequest\x1a\x16.google.protobuf.Empty\";\x92\xb5\x18\x1c\b\x05\x10\x03\x18\x01\"\vdelete:site*\asite_id\x82\xd3\xe4\x93\x02\x15*\x13/v1/sites/{site_id}2\xf1\b\n" +
+	"\x0eProjectService\x12\x93\x01\n" +
 	"\n" +
-	"DeleteSite\x12\x1c.libops.v1.DeleteSiteRequest\x1a\x16.google.protobuf.Empty\" \x92\xb5\x18\x1c\b\x05\x10\x03\x18\x01\"\vdelete:site*\asite_id2\xf3\x05\n" +
-	"\x0eProjectService\x12r\n" +
+	"GetProject\x12\x1c.libops.v1.GetProjectRequest\x1a\x1d.libops.v1.GetProjectResponse\"H\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:project*\n" +
+	"project_id\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/projects/{project_id}\x90\x02\x01\x12\xbc\x01\n" +
+	"\rCreateProject\x12\x1f.libops.v1.CreateProjectRequest\x1a .libops.v1.CreateProjectResponse\"h\x92\xb5\x18-\b\x03\x10\x02\x18\x01\"\x12write:organization2\x0forganization_id8\x03\x82\xd3\xe4\x93\x021:\x01*\",/v1/organizations/{organization_id}/projects\x12\x9d\x01\n" +
+	"\rUpdateProject\x12\x1f.libops.v1.UpdateProjectRequest\x1a .libops.v1.UpdateProjectResponse\"I\x92\xb5\x18!\b\x04\x10\x02\x18\x01\"\rwrite:project*\n" +
+	"project_id\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/projects/{project_id}\x12\x9f\x01\n" +
 	"\n" +
-	"GetProject\x12\x1c.libops.v1.GetProjectRequest\x1a\x1d.libops.v1.GetProjectResponse\"'\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:project*\n" +
-	"project_id\x90\x02\x01\x12\x85\x01\n" +
-	"\rCreateProject\x12\x1f.libops.v1.CreateProjectRequest\x1a .libops.v1.CreateProjectResponse\"1\x92\xb5\x18-\b\x03\x10\x02\x18\x01\"\x12write:organization2\x0forganization_id8\x03\x12y\n" +
-	"\rUpdateProject\x12\x1f.libops.v1.UpdateProjectRequest\x1a .libops.v1.UpdateProjectResponse\"%\x92\xb5\x18!\b\x04\x10\x02\x18\x01\"\rwrite:project*\n" +
-	"project_id\x12p\n" +
-	"\rDeleteProject\x12\x1f.libops.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"&\x92\xb5\x18\"\b\x04\x10\x03\x18\x01\"\x0edelete:project*\n" +
-	"project_id\x12q\n" +
-	"\fListProjects\x12\x1e.libops.v1.ListProjectsRequest\x1a\x1f.libops.v1.ListProjectsResponse\" \x92\xb5\x18\x19\b\x02\x10\x01\x18\x01\"\x11read:organization\x90\x02\x01\x12\x84\x01\n" +
-	"\x10ListProjectSites\x12\".libops.v1.ListProjectSitesRequest\x1a#.libops.v1.ListProjectSitesResponse\"'\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:project*\n" +
-	"project_id\x90\x02\x012\x97\x04\n" +
-	"\x0fFirewallService\x12\xb1\x01\n" +
-	"\x1dListOrganizationFirewallRules\x12/.libops.v1.ListOrganizationFirewallRulesRequest\x1a0.libops.v1.ListOrganizationFirewallRulesResponse\"-\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread:firewall*\x0forganization_id\x90\x02\x01\x12\xb4\x01\n" +
-	"\x1eCreateOrganizationFirewallRule\x120.libops.v1.CreateOrganizationFirewallRuleRequest\x1a1.libops.v1.CreateOrganizationFirewallRuleResponse\"-\x92\xb5\x18)\b\x03\x10\x02\x18\x01\"\x0ewrite:firewall2\x0forganization_id8\x03\x12\x98\x01\n" +
-	"\x1eDeleteOrganizationFirewallRule\x120.libops.v1.DeleteOrganizationFirewallRuleRequest\x1a\x16.google.protobuf.Empty\",\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fdelete:firewall*\x0forganization_id2\xe7\x03\n" +
-	"\x16ProjectFirewallService\x12\x9d\x01\n" +
-	"\x18ListProjectFirewallRules\x12*.libops.v1.ListProjectFirewallRulesRequest\x1a+.libops.v1.ListProjectFirewallRulesResponse\"(\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread:firewall*\n" +
-	"project_id\x90\x02\x01\x12\xa0\x01\n" +
-	"\x19CreateProjectFirewallRule\x12+.libops.v1.CreateProjectFirewallRuleRequest\x1a,.libops.v1.CreateProjectFirewallRuleResponse\"(\x92\xb5\x18$\b\x04\x10\x02\x18\x01\"\x0ewrite:firewall2\n" +
-	"project_id8\x04\x12\x89\x01\n" +
-	"\x19DeleteProjectFirewallRule\x12+.libops.v1.DeleteProjectFirewallRuleRequest\x1a\x16.google.protobuf.Empty\"'\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fdelete:firewall*\n" +
-	"project_id2\xc3\x03\n" +
-	"\x13SiteFirewallService\x12\x91\x01\n" +
-	"\x15ListSiteFirewallRules\x12'.libops.v1.ListSiteFirewallRulesRequest\x1a(.libops.v1.ListSiteFirewallRulesResponse\"%\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread:firewall*\asite_id\x90\x02\x01\x12\x94\x01\n" +
-	"\x16CreateSiteFirewallRule\x12(.libops.v1.CreateSiteFirewallRuleRequest\x1a).libops.v1.CreateSiteFirewallRuleResponse\"%\x92\xb5\x18!\b\x05\x10\x02\x18\x01\"\x0ewrite:firewall2\asite_id8\x05\x12\x80\x01\n" +
-	"\x16DeleteSiteFirewallRule\x12(.libops.v1.DeleteSiteFirewallRuleRequest\x1a\x16.google.protobuf.Empty\"$\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fdelete:firewall*\asite_id2\x84\x05\n" +
-	"\rMemberService\x12\x9e\x01\n" +
-	"\x17ListOrganizationMembers\x12).libops.v1.ListOrganizationMembersRequest\x1a*.libops.v1.ListOrganizationMembersResponse\",\x92\xb5\x18%\b\x03\x10\x01\x18\x01\"\fread:members*\x0forganization_id\x90\x02\x01\x12\xa1\x01\n" +
-	"\x18CreateOrganizationMember\x12*.libops.v1.CreateOrganizationMemberRequest\x1a+.libops.v1.CreateOrganizationMemberResponse\",\x92\xb5\x18(\b\x03\x10\x03\x18\x01\"\rwrite:members2\x0forganization_id8\x03\x12\x9f\x01\n" +
-	"\x18UpdateOrganizationMember\x12*.libops.v1.UpdateOrganizationMemberRequest\x1a+.libops.v1.UpdateOrganizationMemberResponse\"*\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:members*\x0forganization_id\x12\x8b\x01\n" +
-	"\x18DeleteOrganizationMember\x12*.libops.v1.DeleteOrganizationMemberRequest\x1a\x16.google.protobuf.Empty\"+\x92\xb5\x18'\b\x03\x10\x03\x18\x01\"\x0edelete:members*\x0forganization_id2\xbf\x04\n" +
-	"\x14ProjectMemberService\x12\x8a\x01\n" +
-	"\x12ListProjectMembers\x12$.libops.v1.ListProjectMembersRequest\x1a%.libops.v1.ListProjectMembersResponse\"'\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:members*\n" +
-	"project_id\x90\x02\x01\x12\x8d\x01\n" +
-	"\x13CreateProjectMember\x12%.libops.v1.CreateProjectMemberRequest\x1a&.libops.v1.CreateProjectMemberResponse\"'\x92\xb5\x18#\b\x04\x10\x03\x18\x01\"\rwrite:members2\n" +
-	"project_id8\x04\x12\x8b\x01\n" +
-	"\x13UpdateProjectMember\x12%.libops.v1.UpdateProjectMemberRequest\x1a&.libops.v1.UpdateProjectMemberResponse\"%\x92\xb5\x18!\b\x04\x10\x03\x18\x01\"\rwrite:members*\n" +
-	"project_id\x12|\n" +
-	"\x13DeleteProjectMember\x12%.libops.v1.DeleteProjectMemberRequest\x1a\x16.google.protobuf.Empty\"&\x92\xb5\x18\"\b\x04\x10\x03\x18\x01\"\x0edelete:members*\n" +
-	"project_id2\x8d\x04\n" +
-	"\x11SiteMemberService\x12~\n" +
-	"\x0fListSiteMembers\x12!.libops.v1.ListSiteMembersRequest\x1a\".libops.v1.ListSiteMembersResponse\"$\x92\xb5\x18\x1d\b\x05\x10\x01\x18\x01\"\fread:members*\asite_id\x90\x02\x01\x12\x81\x01\n" +
-	"\x10CreateSiteMember\x12\".libops.v1.CreateSiteMemberRequest\x1a#.libops.v1.CreateSiteMemberResponse\"$\x92\xb5\x18 \b\x05\x10\x03\x18\x01\"\rwrite:members2\asite_id8\x05\x12\x7f\n" +
-	"\x10UpdateSiteMember\x12\".libops.v1.UpdateSiteMemberRequest\x1a#.libops.v1.UpdateSiteMemberResponse\"\"\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x12s\n" +
-	"\x10DeleteSiteMember\x12\".libops.v1.DeleteSiteMemberRequest\x1a\x16.google.protobuf.Empty\"#\x92\xb5\x18\x1f\b\x05\x10\x03\x18\x01\"\x0edelete:members*\asite_id2\xc0\x02\n" +
-	"\rSshKeyService\x12f\n" +
-	"\vListSshKeys\x12\x1d.libops.v1.ListSshKeysRequest\x1a\x1e.libops.v1.ListSshKeysResponse\"\x18\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:user\x90\x02\x01\x12g\n" +
-	"\fCreateSshKey\x12\x1e.libops.v1.CreateSshKeyRequest\x1a\x1f.libops.v1.CreateSshKeyResponse\"\x16\x92\xb5\x18\x12\b\x02\x10\x02\x18\x01\"\n" +
-	"write:user\x12^\n" +
-	"\fDeleteSshKey\x12\x1e.libops.v1.DeleteSshKeyRequest\x1a\x16.google.protobuf.Empty\"\x16\x92\xb5\x18\x12\b\x02\x10\x02\x18\x01\"\n" +
-	"write:user2\xfa\x01\n" +
-	"\x15SiteOperationsService\x12u\n" +
-	"\rGetSiteStatus\x12\x1f.libops.v1.GetSiteStatusRequest\x1a .libops.v1.GetSiteStatusResponse\"!\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x90\x02\x01\x12j\n" +
+	"ChangePlan\x12\x1c.libops.v1.ChangePlanRequest\x1a\x1d.libops.v1.ChangePlanResponse\"T\x92\xb5\x18!\b\x04\x10\x03\x18\x01\"\rwrite:project*\n" +
+	"project_id\x82\xd3\xe4\x93\x02):\x01*\"$/v1/projects/{project_id}:changePlan\x12\x91\x01\n" +
+	"\rDeleteProject\x12\x1f.libops.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"G\x92\xb5\x18\"\b\x04\x10\x03\x18\x01\"\x0edelete:project*\n" +
+	"project_id\x82\xd3\xe4\x93\x02\x1b*\x19/v1/projects/{project_id}\x12\x85\x01\n" +
+	"\fListProjects\x12\x1e.libops.v1.ListProjectsRequest\x1a\x1f.libops.v1.ListProjectsResponse\"4\x92\xb5\x18\x19\b\x02\x10\x01\x18\x01\"\x11read:organization\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x90\x02\x01\x12\xab\x01\n" +
+	"\x10ListProjectSites\x12\".libops.v1.ListProjectSitesRequest\x1a#.libops.v1.ListProjectSitesResponse\"N\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:project*\n" +
+	"project_id\x82\xd3\xe4\x93\x02!\x12\x1f/v1/projects/{project_id}/sites\x90\x02\x012\xcf\x05\n" +
+	"\x0fFirewallService\x12\xea\x01\n" +
+	"\x1dListOrganizationFirewallRules\x12/.libops.v1.ListOrganizationFirewallRulesRequest\x1a0.libops.v1.ListOrganizationFirewallRulesResponse\"f\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread:firewall*\x0forganization_id\x82\xd3\xe4\x93\x023\x121/v1/organizations/{organization_id}/firewallRules\x90\x02\x01\x12\xf0\x01\n" +
+	"\x1eCreateOrganizationFirewallRule\x120.libops.v1.CreateOrganizationFirewallRuleRequest\x1a1.libops.v1.CreateOrganizationFirewallRuleResponse\"i\x92\xb5\x18)\b\x03\x10\x02\x18\x01\"\x0ewrite:firewall2\x0forganization_id8\x03\x82\xd3\xe4\x93\x026:\x01*\"1/v1/organizations/{organization_id}/firewallRules\x12\xdb\x01\n" +
+	"\x1eDeleteOrganizationFirewallRule\x120.libops.v1.DeleteOrganizationFirewallRuleRequest\x1a\x16.google.protobuf.Empty\"o\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fdelete:firewall*\x0forganization_id\x82\xd3\xe4\x93\x02=*;/v1/organizations/{organization_id}/firewallRules/{rule_id}2\x81\x05\n" +
+	"\x16ProjectFirewallService\x12\xcc\x01\n" +
+	"\x18ListProjectFirewallRules\x12*.libops.v1.ListProjectFirewallRulesRequest\x1a+.libops.v1.ListProjectFirewallRulesResponse\"W\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread:firewall*\n" +
+	"project_id\x82\xd3\xe4\x93\x02)\x12'/v1/projects/{project_id}/firewallRules\x90\x02\x01\x12\xd2\x01\n" +
+	"\x19CreateProjectFirewallRule\x12+.libops.v1.CreateProjectFirewallRuleRequest\x1a,.libops.v1.CreateProjectFirewallRuleResponse\"Z\x92\xb5\x18$\b\x04\x10\x02\x18\x01\"\x0ewrite:firewall2\n" +
+	"project_id8\x04\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/projects/{project_id}/firewallRules\x12\xc2\x01\n" +
+	"\x19DeleteProjectFirewallRule\x12+.libops.v1.DeleteProjectFirewallRuleRequest\x1a\x16.google.protobuf.Empty\"`\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fdelete:firewall*\n" +
+	"project_id\x82\xd3\xe4\x93\x023*1/v1/projects/{project_id}/firewallRules/{rule_id}2\x8c\t\n" +
+	"\x13SiteFirewallService\x12\xba\x01\n" +
+	"\x15ListSiteFirewallRules\x12'.libops.v1.ListSiteFirewallRulesRequest\x1a(.libops.v1.ListSiteFirewallRulesResponse\"N\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread:firewall*\asite_id\x82\xd3\xe4\x93\x02#\x12!/v1/sites/{site_id}/firewallRules\x90\x02\x01\x12\xc0\x01\n" +
+	"\x16CreateSiteFirewallRule\x12(.libops.v1.CreateSiteFirewallRuleRequest\x1a).libops.v1.CreateSiteFirewallRuleResponse\"Q\x92\xb5\x18!\b\x05\x10\x02\x18\x01\"\x0ewrite:firewall2\asite_id8\x05\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/sites/{site_id}/firewallRules\x12\xb3\x01\n" +
+	"\x16DeleteSiteFirewallRule\x12(.libops.v1.DeleteSiteFirewallRuleRequest\x1a\x16.google.protobuf.Empty\"W\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fdelete:firewall*\asite_id\x82\xd3\xe4\x93\x02-*+/v1/sites/{site_id}/firewallRules/{rule_id}\x12\xbe\x01\n" +
+	"\x16ListSiteRateLimitRules\x12(.libops.v1.ListSiteRateLimitRulesRequest\x1a).libops.v1.ListSiteRateLimitRulesResponse\"O\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread:firewall*\asite_id\x82\xd3\xe4\x93\x02$\x12\"/v1/sites/{site_id}/rateLimitRules\x90\x02\x01\x12\xc4\x01\n" +
+	"\x17CreateSiteRateLimitRule\x12).libops.v1.CreateSiteRateLimitRuleRequest\x1a*.libops.v1.CreateSiteRateLimitRuleResponse\"R\x92\xb5\x18!\b\x05\x10\x02\x18\x01\"\x0ewrite:firewall2\asite_id8\x05\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/sites/{site_id}/rateLimitRules\x12\xb6\x01\n" +
+	"\x17DeleteSiteRateLimitRule\x12).libops.v1.DeleteSiteRateLimitRuleRequest\x1a\x16.google.protobuf.Empty\"X\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fdelete:firewall*\asite_id\x82\xd3\xe4\x93\x02.*,/v1/sites/{site_id}/rateLimitRules/{rule_id}2\xec\v\n" +
+	"\rMemberService\x12\xd1\x01\n" +
+	"\x17ListOrganizationMembers\x12).libops.v1.ListOrganizationMembersRequest\x1a*.libops.v1.ListOrganizationMembersResponse\"_\x92\xb5\x18%\b\x03\x10\x01\x18\x01\"\fread:members*\x0forganization_id\x82\xd3\xe4\x93\x02-\x12+/v1/organizations/{organization_id}/members\x90\x02\x01\x12\xd7\x01\n" +
+	"\x18CreateOrganizationMember\x12*.libops.v1.CreateOrganizationMemberRequest\x1a+.libops.v1.CreateOrganizationMemberResponse\"b\x92\xb5\x18(\b\x03\x10\x03\x18\x01\"\rwrite:members2\x0forganization_id8\x03\x82\xd3\xe4\x93\x020:\x01*\"+/v1/organizations/{organization_id}/members\x12\xe2\x01\n" +
+	"\x18UpdateOrganizationMember\x12*.libops.v1.UpdateOrganizationMemberRequest\x1a+.libops.v1.UpdateOrganizationMemberResponse\"m\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:members*\x0forganization_id\x82\xd3\xe4\x93\x02=:\x01*28/v1/organizations/{organization_id}/members/{account_id}\x12\xcb\x01\n" +
+	"\x18DeleteOrganizationMember\x12*.libops.v1.DeleteOrganizationMemberRequest\x1a\x16.google.protobuf.Empty\"k\x92\xb5\x18'\b\x03\x10\x03\x18\x01\"\x0edelete:members*\x0forganization_id\x82\xd3\xe4\x93\x02:*8/v1/organizations/{organization_id}/members/{account_id}\x12\xcb\x01\n" +
+	"\x11BulkCreateMembers\x12#.libops.v1.BulkCreateMembersRequest\x1a$.libops.v1.BulkCreateMembersResponse\"k\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:members*\x0forganization_id\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/organizations/{organization_id}/members:bulkCreate\x12\xdc\x01\n" +
+	"\x15BulkUpdateMemberRoles\x12'.libops.v1.BulkUpdateMemberRolesRequest\x1a(.libops.v1.BulkUpdateMemberRolesResponse\"p\x92\xb5\x18&\b\x03\x10\x03\x18\x01\"\rwrite:members*\x0forganization_id\x82\xd3\xe4\x93\x02@:\x01*\";/v1/organizations/{organization_id}/members:bulkUpdateRoles\x12\xcc\x01\n" +
+	"\x11BulkRemoveMembers\x12#.libops.v1.BulkRemoveMembersRequest\x1a$.libops.v1.BulkRemoveMembersResponse\"l\x92\xb5\x18'\b\x03\x10\x03\x18\x01\"\x0edelete:members*\x0forganization_id\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/organizations/{organization_id}/members:bulkRemove2\x84\x06\n" +
+	"\x14ProjectMemberService\x12\xb3\x01\n" +
+	"\x12ListProjectMembers\x12$.libops.v1.ListProjectMembersRequest\x1a%.libops.v1.ListProjectMembersResponse\"P\x92\xb5\x18 \b\x04\x10\x01\x18\x01\"\fread:members*\n" +
+	"project_id\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/members\x90\x02\x01\x12\xb9\x01\n" +
+	"\x13CreateProjectMember\x12%.libops.v1.CreateProjectMemberRequest\x1a&.libops.v1.CreateProjectMemberResponse\"S\x92\xb5\x18#\b\x04\x10\x03\x18\x01\"\rwrite:members2\n" +
+	"project_id8\x04\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/projects/{project_id}/members\x12\xc4\x01\n" +
+	"\x13UpdateProjectMember\x12%.libops.v1.UpdateProjectMemberRequest\x1a&.libops.v1.UpdateProjectMemberResponse\"^\x92\xb5\x18!\b\x04\x10\x03\x18\x01\"\rwrite:members*\n" +
+	"project_id\x82\xd3\xe4\x93\x023:\x01*2./v1/projects/{project_id}/members/{account_id}\x12\xb2\x01\n" +
+	"\x13DeleteProjectMember\x12%.libops.v1.DeleteProjectMemberRequest\x1a\x16.google.protobuf.Empty\"\\\x92\xb5\x18\"\b\x04\x10\x03\x18\x01\"\x0edelete:members*\n" +
+	"project_id\x82\xd3\xe4\x93\x020*./v1/projects/{project_id}/members/{account_id}2\xbc\x05\n" +
+	"\x11SiteMemberService\x12\xa1\x01\n" +
+	"\x0fListSiteMembers\x12!.libops.v1.ListSiteMembersRequest\x1a\".libops.v1.ListSiteMembersResponse\"G\x92\xb5\x18\x1d\b\x05\x10\x01\x18\x01\"\fread:members*\asite_id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/sites/{site_id}/members\x90\x02\x01\x12\xa7\x01\n" +
+	"\x10CreateSiteMember\x12\".libops.v1.CreateSiteMemberRequest\x1a#.libops.v1.CreateSiteMemberResponse\"J\x92\xb5\x18 \b\x05\x10\x03\x18\x01\"\rwrite:members2\asite_id8\x05\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/sites/{site_id}/members\x12\xb2\x01\n" +
+	"\x10UpdateSiteMember\x12\".libops.v1.UpdateSiteMemberRequest\x1a#.libops.v1.UpdateSiteMemberResponse\"U\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x02-:\x01*2(/v1/sites/{site_id}/members/{account_id}\x12\xa3\x01\n" +
+	"\x10DeleteSiteMember\x12\".libops.v1.DeleteSiteMemberRequest\x1a\x16.google.protobuf.Empty\"S\x92\xb5\x18\x1f\b\x05\x10\x03\x18\x01\"\x0edelete:members*\asite_id\x82\xd3\xe4\x93\x02**(/v1/sites/{site_id}/members/{account_id}2\xca\x03\n" +
+	"\rSshKeyService\x12\x8f\x01\n" +
+	"\vListSshKeys\x12\x1d.libops.v1.ListSshKeysRequest\x1a\x1e.libops.v1.ListSshKeysResponse\"A\x92\xb5\x18\x11\b\x02\x10\x01\x18\x01\"\tread:user\x82\xd3\xe4\x93\x02#\x12!/v1/accounts/{account_id}/sshKeys\x90\x02\x01\x12\x93\x01\n" +
+	"\fCreateSshKey\x12\x1e.libops.v1.CreateSshKeyRequest\x1a\x1f.libops.v1.CreateSshKeyResponse\"B\x92\xb5\x18\x12\b\x02\x10\x02\x18\x01\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/accounts/{account_id}/sshKeys\x12\x90\x01\n" +
+	"\fDeleteSshKey\x12\x1e.libops.v1.DeleteSshKeyRequest\x1a\x16.google.protobuf.Empty\"H\x92\xb5\x18\x12\b\x02\x10\x02\x18\x01\"\n" +
+	"write:user\x82\xd3\xe4\x93\x02,**/v1/accounts/{account_id}/sshKeys/{key_id}2\xaa\x05\n" +
+	"\x15SiteOperationsService\x12\x97\x01\n" +
+	"\rGetSiteStatus\x12\x1f.libops.v1.GetSiteStatusRequest\x1a .libops.v1.GetSiteStatusResponse\"C\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/sites/{site_id}/status\x90\x02\x01\x12\x8f\x01\n" +
 	"\n" +
-	"DeploySite\x12\x1c.libops.v1.DeploySiteRequest\x1a\x1d.libops.v1.DeploySiteResponse\"\x1f\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
-	"write:site*\asite_idB\x9a\x01\n" +
+	"DeploySite\x12\x1c.libops.v1.DeploySiteRequest\x1a\x1d.libops.v1.DeploySiteResponse\"D\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/sites/{site_id}:deploy\x12\xae\x01\n" +
+	"\x13ListSiteDeployments\x12%.libops.v1.ListSiteDeploymentsRequest\x1a&.libops.v1.ListSiteDeploymentsResponse\"H\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02!\x12\x1f/v1/sites/{site_id}/deployments\x90\x02\x01\x12\xb3\x01\n" +
+	"\fRollbackSite\x12\x1e.libops.v1.RollbackSiteRequest\x1a\x1f.libops.v1.RollbackSiteResponse\"b\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/sites/{site_id}/deployments/{deployment_id}:rollbackB\x9a\x01\n" +
 	"\rcom.libops.v1B\x14OrganizationApiProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -5022,244 +7895,359 @@ func file_libops_v1_organization_api_proto_rawDescGZIP() []byte {
 	return file_libops_v1_organization_api_proto_rawDescData
 }
 
-var file_libops_v1_organization_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_libops_v1_organization_api_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_libops_v1_organization_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_libops_v1_organization_api_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_libops_v1_organization_api_proto_goTypes = []any{
 	(FirewallRuleType)(0),                          // 0: libops.v1.FirewallRuleType
-	(*GetProjectRequest)(nil),                      // 1: libops.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),                     // 2: libops.v1.GetProjectResponse
-	(*CreateProjectRequest)(nil),                   // 3: libops.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),                  // 4: libops.v1.CreateProjectResponse
-	(*UpdateProjectRequest)(nil),                   // 5: libops.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),                  // 6: libops.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),                   // 7: libops.v1.DeleteProjectRequest
-	(*ListProjectsRequest)(nil),                    // 8: libops.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                   // 9: libops.v1.ListProjectsResponse
-	(*ListProjectSitesRequest)(nil),                // 10: libops.v1.ListProjectSitesRequest
-	(*ListProjectSitesResponse)(nil),               // 11: libops.v1.ListProjectSitesResponse
-	(*GetOrganizationRequest)(nil),                 // 12: libops.v1.GetOrganizationRequest
-	(*GetOrganizationResponse)(nil),                // 13: libops.v1.GetOrganizationResponse
-	(*CreateOrganizationRequest)(nil),              // 14: libops.v1.CreateOrganizationRequest
-	(*CreateOrganizationResponse)(nil),             // 15: libops.v1.CreateOrganizationResponse
-	(*UpdateOrganizationRequest)(nil),              // 16: libops.v1.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil),             // 17: libops.v1.UpdateOrganizationResponse
-	(*DeleteOrganizationRequest)(nil),              // 18: libops.v1.DeleteOrganizationRequest
-	(*ListOrganizationsRequest)(nil),               // 19: libops.v1.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),              // 20: libops.v1.ListOrganizationsResponse
-	(*ListOrganizationProjectsRequest)(nil),        // 21: libops.v1.ListOrganizationProjectsRequest
-	(*ListOrganizationProjectsResponse)(nil),       // 22: libops.v1.ListOrganizationProjectsResponse
-	(*GetSiteRequest)(nil),                         // 23: libops.v1.GetSiteRequest
-	(*GetSiteResponse)(nil),                        // 24: libops.v1.GetSiteResponse
-	(*CreateSiteRequest)(nil),                      // 25: libops.v1.CreateSiteRequest
-	(*CreateSiteResponse)(nil),                     // 26: libops.v1.CreateSiteResponse
-	(*UpdateSiteRequest)(nil),                      // 27: libops.v1.UpdateSiteRequest
-	(*UpdateSiteResponse)(nil),                     // 28: libops.v1.UpdateSiteResponse
-	(*DeleteSiteRequest)(nil),                      // 29: libops.v1.DeleteSiteRequest
-	(*ListSitesRequest)(nil),                       // 30: libops.v1.ListSitesRequest
-	(*ListSitesResponse)(nil),                      // 31: libops.v1.ListSitesResponse
-	(*OrganizationFirewallRule)(nil),               // 32: libops.v1.OrganizationFirewallRule
-	(*ProjectFirewallRule)(nil),                    // 33: libops.v1.ProjectFirewallRule
-	(*SiteFirewallRule)(nil),                       // 34: libops.v1.SiteFirewallRule
-	(*MemberDetail)(nil),                           // 35: libops.v1.MemberDetail
-	(*SshKey)(nil),                                 // 36: libops.v1.SshKey
-	(*SiteStatus)(nil),                             // 37: libops.v1.SiteStatus
-	(*ListOrganizationFirewallRulesRequest)(nil),   // 38: libops.v1.ListOrganizationFirewallRulesRequest
-	(*ListOrganizationFirewallRulesResponse)(nil),  // 39: libops.v1.ListOrganizationFirewallRulesResponse
-	(*CreateOrganizationFirewallRuleRequest)(nil),  // 40: libops.v1.CreateOrganizationFirewallRuleRequest
-	(*CreateOrganizationFirewallRuleResponse)(nil), // 41: libops.v1.CreateOrganizationFirewallRuleResponse
-	(*DeleteOrganizationFirewallRuleRequest)(nil),  // 42: libops.v1.DeleteOrganizationFirewallRuleRequest
-	(*ListProjectFirewallRulesRequest)(nil),        // 43: libops.v1.ListProjectFirewallRulesRequest
-	(*ListProjectFirewallRulesResponse)(nil),       // 44: libops.v1.ListProjectFirewallRulesResponse
-	(*CreateProjectFirewallRuleRequest)(nil),       // 45: libops.v1.CreateProjectFirewallRuleRequest
-	(*CreateProjectFirewallRuleResponse)(nil),      // 46: libops.v1.CreateProjectFirewallRuleResponse
-	(*DeleteProjectFirewallRuleRequest)(nil),       // 47: libops.v1.DeleteProjectFirewallRuleRequest
-	(*ListSiteFirewallRulesRequest)(nil),           // 48: libops.v1.ListSiteFirewallRulesRequest
-	(*ListSiteFirewallRulesResponse)(nil),          // 49: libops.v1.ListSiteFirewallRulesResponse
-	(*CreateSiteFirewallRuleRequest)(nil),          // 50: libops.v1.CreateSiteFirewallRuleRequest
-	(*CreateSiteFirewallRuleResponse)(nil),         // 51: libops.v1.CreateSiteFirewallRuleResponse
-	(*DeleteSiteFirewallRuleRequest)(nil),          // 52: libops.v1.DeleteSiteFirewallRuleRequest
-	(*ListOrganizationMembersRequest)(nil),         // 53: libops.v1.ListOrganizationMembersRequest
-	(*ListOrganizationMembersResponse)(nil),        // 54: libops.v1.ListOrganizationMembersResponse
-	(*CreateOrganizationMemberRequest)(nil),        // 55: libops.v1.CreateOrganizationMemberRequest
-	(*CreateOrganizationMemberResponse)(nil),       // 56: libops.v1.CreateOrganizationMemberResponse
-	(*UpdateOrganizationMemberRequest)(nil),        // 57: libops.v1.UpdateOrganizationMemberRequest
-	(*UpdateOrganizationMemberResponse)(nil),       // 58: libops.v1.UpdateOrganizationMemberResponse
-	(*DeleteOrganizationMemberRequest)(nil),        // 59: libops.v1.DeleteOrganizationMemberRequest
-	(*ListProjectMembersRequest)(nil),              // 60: libops.v1.ListProjectMembersRequest
-	(*ListProjectMembersResponse)(nil),             // 61: libops.v1.ListProjectMembersResponse
-	(*CreateProjectMemberRequest)(nil),             // 62: libops.v1.CreateProjectMemberRequest
-	(*CreateProjectMemberResponse)(nil),            // 63: libops.v1.CreateProjectMemberResponse
-	(*UpdateProjectMemberRequest)(nil),             // 64: libops.v1.UpdateProjectMemberRequest
-	(*UpdateProjectMemberResponse)(nil),            // 65: libops.v1.UpdateProjectMemberResponse
-	(*DeleteProjectMemberRequest)(nil),             // 66: libops.v1.DeleteProjectMemberRequest
-	(*ListSiteMembersRequest)(nil),                 // 67: libops.v1.ListSiteMembersRequest
-	(*ListSiteMembersResponse)(nil),                // 68: libops.v1.ListSiteMembersResponse
-	(*CreateSiteMemberRequest)(nil),                // 69: libops.v1.CreateSiteMemberRequest
-	(*CreateSiteMemberResponse)(nil),               // 70: libops.v1.CreateSiteMemberResponse
-	(*UpdateSiteMemberRequest)(nil),                // 71: libops.v1.UpdateSiteMemberRequest
-	(*UpdateSiteMemberResponse)(nil),               // 72: libops.v1.UpdateSiteMemberResponse
-	(*DeleteSiteMemberRequest)(nil),                // 73: libops.v1.DeleteSiteMemberRequest
-	(*ListSshKeysRequest)(nil),                     // 74: libops.v1.ListSshKeysRequest
-	(*ListSshKeysResponse)(nil),                    // 75: libops.v1.ListSshKeysResponse
-	(*CreateSshKeyRequest)(nil),                    // 76: libops.v1.CreateSshKeyRequest
-	(*CreateSshKeyResponse)(nil),                   // 77: libops.v1.CreateSshKeyResponse
-	(*DeleteSshKeyRequest)(nil),                    // 78: libops.v1.DeleteSshKeyRequest
-	(*GetSiteStatusRequest)(nil),                   // 79: libops.v1.GetSiteStatusRequest
-	(*GetSiteStatusResponse)(nil),                  // 80: libops.v1.GetSiteStatusResponse
-	(*DeploySiteRequest)(nil),                      // 81: libops.v1.DeploySiteRequest
-	(*DeploySiteResponse)(nil),                     // 82: libops.v1.DeploySiteResponse
-	(*common.ProjectConfig)(nil),                   // 83: libops.v1.common.ProjectConfig
-	(*fieldmaskpb.FieldMask)(nil),                  // 84: google.protobuf.FieldMask
-	(*common.FolderConfig)(nil),                    // 85: libops.v1.common.FolderConfig
-	(*common.SiteConfig)(nil),                      // 86: libops.v1.common.SiteConfig
-	(common.Status)(0),                             // 87: libops.v1.common.Status
-	(*emptypb.Empty)(nil),                          // 88: google.protobuf.Empty
+	(RateLimitWindow)(0),                           // 1: libops.v1.RateLimitWindow
+	(SiteComponentState)(0),                        // 2: libops.v1.SiteComponentState
+	(*GetProjectRequest)(nil),                      // 3: libops.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),                     // 4: libops.v1.GetProjectResponse
+	(*CreateProjectRequest)(nil),                   // 5: libops.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                  // 6: libops.v1.CreateProjectResponse
+	(*UpdateProjectRequest)(nil),                   // 7: libops.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),                  // 8: libops.v1.UpdateProjectResponse
+	(*ChangePlanRequest)(nil),                      // 9: libops.v1.ChangePlanRequest
+	(*ChangePlanResponse)(nil),                     // 10: libops.v1.ChangePlanResponse
+	(*DeleteProjectRequest)(nil),                   // 11: libops.v1.DeleteProjectRequest
+	(*ListProjectsRequest)(nil),                    // 12: libops.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                   // 13: libops.v1.ListProjectsResponse
+	(*ListProjectSitesRequest)(nil),                // 14: libops.v1.ListProjectSitesRequest
+	(*ListProjectSitesResponse)(nil),               // 15: libops.v1.ListProjectSitesResponse
+	(*GetOrganizationRequest)(nil),                 // 16: libops.v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),                // 17: libops.v1.GetOrganizationResponse
+	(*CreateOrganizationRequest)(nil),              // 18: libops.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),             // 19: libops.v1.CreateOrganizationResponse
+	(*UpdateOrganizationRequest)(nil),              // 20: libops.v1.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),             // 21: libops.v1.UpdateOrganizationResponse
+	(*DeleteOrganizationRequest)(nil),              // 22: libops.v1.DeleteOrganizationRequest
+	(*ListOrganizationsRequest)(nil),               // 23: libops.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),              // 24: libops.v1.ListOrganizationsResponse
+	(*ListOrganizationProjectsRequest)(nil),        // 25: libops.v1.ListOrganizationProjectsRequest
+	(*ListOrganizationProjectsResponse)(nil),       // 26: libops.v1.ListOrganizationProjectsResponse
+	(*GetQuotasRequest)(nil),                       // 27: libops.v1.GetQuotasRequest
+	(*GetQuotasResponse)(nil),                      // 28: libops.v1.GetQuotasResponse
+	(*GetOrganizationUsageRequest)(nil),            // 29: libops.v1.GetOrganizationUsageRequest
+	(*GetOrganizationUsageResponse)(nil),           // 30: libops.v1.GetOrganizationUsageResponse
+	(*PreviewUsageRequest)(nil),                    // 31: libops.v1.PreviewUsageRequest
+	(*PreviewUsageResponse)(nil),                   // 32: libops.v1.PreviewUsageResponse
+	(*CreateBillingPortalSessionRequest)(nil),      // 33: libops.v1.CreateBillingPortalSessionRequest
+	(*CreateBillingPortalSessionResponse)(nil),     // 34: libops.v1.CreateBillingPortalSessionResponse
+	(*ListPaymentMethodsRequest)(nil),              // 35: libops.v1.ListPaymentMethodsRequest
+	(*ListPaymentMethodsResponse)(nil),             // 36: libops.v1.ListPaymentMethodsResponse
+	(*SetDefaultPaymentMethodRequest)(nil),         // 37: libops.v1.SetDefaultPaymentMethodRequest
+	(*SetDefaultPaymentMethodResponse)(nil),        // 38: libops.v1.SetDefaultPaymentMethodResponse
+	(*ListInvoicesRequest)(nil),                    // 39: libops.v1.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),                   // 40: libops.v1.ListInvoicesResponse
+	(*GetInvoiceRequest)(nil),                      // 41: libops.v1.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil),                     // 42: libops.v1.GetInvoiceResponse
+	(*GetSiteRequest)(nil),                         // 43: libops.v1.GetSiteRequest
+	(*GetSiteResponse)(nil),                        // 44: libops.v1.GetSiteResponse
+	(*CreateSiteRequest)(nil),                      // 45: libops.v1.CreateSiteRequest
+	(*CreateSiteResponse)(nil),                     // 46: libops.v1.CreateSiteResponse
+	(*UpdateSiteRequest)(nil),                      // 47: libops.v1.UpdateSiteRequest
+	(*UpdateSiteResponse)(nil),                     // 48: libops.v1.UpdateSiteResponse
+	(*DeleteSiteRequest)(nil),                      // 49: libops.v1.DeleteSiteRequest
+	(*ListSitesRequest)(nil),                       // 50: libops.v1.ListSitesRequest
+	(*ListSitesResponse)(nil),                      // 51: libops.v1.ListSitesResponse
+	(*OrganizationFirewallRule)(nil),               // 52: libops.v1.OrganizationFirewallRule
+	(*ProjectFirewallRule)(nil),                    // 53: libops.v1.ProjectFirewallRule
+	(*SiteFirewallRule)(nil),                       // 54: libops.v1.SiteFirewallRule
+	(*SiteRateLimitRule)(nil),                      // 55: libops.v1.SiteRateLimitRule
+	(*MemberDetail)(nil),                           // 56: libops.v1.MemberDetail
+	(*MemberInvitation)(nil),                       // 57: libops.v1.MemberInvitation
+	(*SshKey)(nil),                                 // 58: libops.v1.SshKey
+	(*SiteStatus)(nil),                             // 59: libops.v1.SiteStatus
+	(*SiteComponentStatus)(nil),                    // 60: libops.v1.SiteComponentStatus
+	(*ListOrganizationFirewallRulesRequest)(nil),   // 61: libops.v1.ListOrganizationFirewallRulesRequest
+	(*ListOrganizationFirewallRulesResponse)(nil),  // 62: libops.v1.ListOrganizationFirewallRulesResponse
+	(*CreateOrganizationFirewallRuleRequest)(nil),  // 63: libops.v1.CreateOrganizationFirewallRuleRequest
+	(*CreateOrganizationFirewallRuleResponse)(nil), // 64: libops.v1.CreateOrganizationFirewallRuleResponse
+	(*DeleteOrganizationFirewallRuleRequest)(nil),  // 65: libops.v1.DeleteOrganizationFirewallRuleRequest
+	(*ListProjectFirewallRulesRequest)(nil),        // 66: libops.v1.ListProjectFirewallRulesRequest
+	(*ListProjectFirewallRulesResponse)(nil),       // 67: libops.v1.ListProjectFirewallRulesResponse
+	(*CreateProjectFirewallRuleRequest)(nil),       // 68: libops.v1.CreateProjectFirewallRuleRequest
+	(*CreateProjectFirewallRuleResponse)(nil),      // 69: libops.v1.CreateProjectFirewallRuleResponse
+	(*DeleteProjectFirewallRuleRequest)(nil),       // 70: libops.v1.DeleteProjectFirewallRuleRequest
+	(*ListSiteFirewallRulesRequest)(nil),           // 71: libops.v1.ListSiteFirewallRulesRequest
+	(*ListSiteFirewallRulesResponse)(nil),          // 72: libops.v1.ListSiteFirewallRulesResponse
+	(*CreateSiteFirewallRuleRequest)(nil),          // 73: libops.v1.CreateSiteFirewallRuleRequest
+	(*CreateSiteFirewallRuleResponse)(nil),         // 74: libops.v1.CreateSiteFirewallRuleResponse
+	(*DeleteSiteFirewallRuleRequest)(nil),          // 75: libops.v1.DeleteSiteFirewallRuleRequest
+	(*ListSiteRateLimitRulesRequest)(nil),          // 76: libops.v1.ListSiteRateLimitRulesRequest
+	(*ListSiteRateLimitRulesResponse)(nil),         // 77: libops.v1.ListSiteRateLimitRulesResponse
+	(*CreateSiteRateLimitRuleRequest)(nil),         // 78: libops.v1.CreateSiteRateLimitRuleRequest
+	(*CreateSiteRateLimitRuleResponse)(nil),        // 79: libops.v1.CreateSiteRateLimitRuleResponse
+	(*DeleteSiteRateLimitRuleRequest)(nil),         // 80: libops.v1.DeleteSiteRateLimitRuleRequest
+	(*ListOrganizationMembersRequest)(nil),         // 81: libops.v1.ListOrganizationMembersRequest
+	(*ListOrganizationMembersResponse)(nil),        // 82: libops.v1.ListOrganizationMembersResponse
+	(*CreateOrganizationMemberRequest)(nil),        // 83: libops.v1.CreateOrganizationMemberRequest
+	(*CreateOrganizationMemberResponse)(nil),       // 84: libops.v1.CreateOrganizationMemberResponse
+	(*UpdateOrganizationMemberRequest)(nil),        // 85: libops.v1.UpdateOrganizationMemberRequest
+	(*UpdateOrganizationMemberResponse)(nil),       // 86: libops.v1.UpdateOrganizationMemberResponse
+	(*DeleteOrganizationMemberRequest)(nil),        // 87: libops.v1.DeleteOrganizationMemberRequest
+	(*BulkMemberEntry)(nil),                        // 88: libops.v1.BulkMemberEntry
+	(*BulkMemberRole)(nil),                         // 89: libops.v1.BulkMemberRole
+	(*BulkMemberResult)(nil),                       // 90: libops.v1.BulkMemberResult
+	(*BulkCreateMembersRequest)(nil),               // 91: libops.v1.BulkCreateMembersRequest
+	(*BulkCreateMembersResponse)(nil),              // 92: libops.v1.BulkCreateMembersResponse
+	(*BulkUpdateMemberRolesRequest)(nil),           // 93: libops.v1.BulkUpdateMemberRolesRequest
+	(*BulkUpdateMemberRolesResponse)(nil),          // 94: libops.v1.BulkUpdateMemberRolesResponse
+	(*BulkRemoveMembersRequest)(nil),               // 95: libops.v1.BulkRemoveMembersRequest
+	(*BulkRemoveMembersResponse)(nil),              // 96: libops.v1.BulkRemoveMembersResponse
+	(*ListProjectMembersRequest)(nil),              // 97: libops.v1.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),             // 98: libops.v1.ListProjectMembersResponse
+	(*CreateProjectMemberRequest)(nil),             // 99: libops.v1.CreateProjectMemberRequest
+	(*CreateProjectMemberResponse)(nil),            // 100: libops.v1.CreateProjectMemberResponse
+	(*UpdateProjectMemberRequest)(nil),             // 101: libops.v1.UpdateProjectMemberRequest
+	(*UpdateProjectMemberResponse)(nil),            // 102: libops.v1.UpdateProjectMemberResponse
+	(*DeleteProjectMemberRequest)(nil),             // 103: libops.v1.DeleteProjectMemberRequest
+	(*ListSiteMembersRequest)(nil),                 // 104: libops.v1.ListSiteMembersRequest
+	(*ListSiteMembersResponse)(nil),                // 105: libops.v1.ListSiteMembersResponse
+	(*CreateSiteMemberRequest)(nil),                // 106: libops.v1.CreateSiteMemberRequest
+	(*CreateSiteMemberResponse)(nil),               // 107: libops.v1.CreateSiteMemberResponse
+	(*UpdateSiteMemberRequest)(nil),                // 108: libops.v1.UpdateSiteMemberRequest
+	(*UpdateSiteMemberResponse)(nil),               // 109: libops.v1.UpdateSiteMemberResponse
+	(*DeleteSiteMemberRequest)(nil),                // 110: libops.v1.DeleteSiteMemberRequest
+	(*ListSshKeysRequest)(nil),                     // 111: libops.v1.ListSshKeysRequest
+	(*ListSshKeysResponse)(nil),                    // 112: libops.v1.ListSshKeysResponse
+	(*CreateSshKeyRequest)(nil),                    // 113: libops.v1.CreateSshKeyRequest
+	(*CreateSshKeyResponse)(nil),                   // 114: libops.v1.CreateSshKeyResponse
+	(*DeleteSshKeyRequest)(nil),                    // 115: libops.v1.DeleteSshKeyRequest
+	(*GetSiteStatusRequest)(nil),                   // 116: libops.v1.GetSiteStatusRequest
+	(*GetSiteStatusResponse)(nil),                  // 117: libops.v1.GetSiteStatusResponse
+	(*DeploySiteRequest)(nil),                      // 118: libops.v1.DeploySiteRequest
+	(*DeploySiteResponse)(nil),                     // 119: libops.v1.DeploySiteResponse
+	(*Deployment)(nil),                             // 120: libops.v1.Deployment
+	(*ListSiteDeploymentsRequest)(nil),             // 121: libops.v1.ListSiteDeploymentsRequest
+	(*ListSiteDeploymentsResponse)(nil),            // 122: libops.v1.ListSiteDeploymentsResponse
+	(*RollbackSiteRequest)(nil),                    // 123: libops.v1.RollbackSiteRequest
+	(*RollbackSiteResponse)(nil),                   // 124: libops.v1.RollbackSiteResponse
+	(*common.ProjectConfig)(nil),                   // 125: libops.v1.common.ProjectConfig
+	(*fieldmaskpb.FieldMask)(nil),                  // 126: google.protobuf.FieldMask
+	(*common.FolderConfig)(nil),                    // 127: libops.v1.common.FolderConfig
+	(*common.Quota)(nil),                           // 128: libops.v1.common.Quota
+	(*common.BillingSubscription)(nil),             // 129: libops.v1.common.BillingSubscription
+	(*common.ProjectUsage)(nil),                    // 130: libops.v1.common.ProjectUsage
+	(*common.SubscriptionItem)(nil),                // 131: libops.v1.common.SubscriptionItem
+	(*common.MeteredUsage)(nil),                    // 132: libops.v1.common.MeteredUsage
+	(*common.PaymentMethod)(nil),                   // 133: libops.v1.common.PaymentMethod
+	(*common.Invoice)(nil),                         // 134: libops.v1.common.Invoice
+	(*common.SiteConfig)(nil),                      // 135: libops.v1.common.SiteConfig
+	(common.Status)(0),                             // 136: libops.v1.common.Status
+	(*common.SiteCdn)(nil),                         // 137: libops.v1.common.SiteCdn
+	(*SiteAddon)(nil),                              // 138: libops.v1.SiteAddon
+	(*emptypb.Empty)(nil),                          // 139: google.protobuf.Empty
 }
 var file_libops_v1_organization_api_proto_depIdxs = []int32{
-	83, // 0: libops.v1.GetProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
-	83, // 1: libops.v1.CreateProjectRequest.project:type_name -> libops.v1.common.ProjectConfig
-	83, // 2: libops.v1.CreateProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
-	83, // 3: libops.v1.UpdateProjectRequest.project:type_name -> libops.v1.common.ProjectConfig
-	84, // 4: libops.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	83, // 5: libops.v1.UpdateProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
-	83, // 6: libops.v1.ListProjectsResponse.projects:type_name -> libops.v1.common.ProjectConfig
-	85, // 7: libops.v1.GetOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
-	85, // 8: libops.v1.CreateOrganizationRequest.folder:type_name -> libops.v1.common.FolderConfig
-	85, // 9: libops.v1.CreateOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
-	85, // 10: libops.v1.UpdateOrganizationRequest.folder:type_name -> libops.v1.common.FolderConfig
-	84, // 11: libops.v1.UpdateOrganizationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	85, // 12: libops.v1.UpdateOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
-	85, // 13: libops.v1.ListOrganizationsResponse.organizations:type_name -> libops.v1.common.FolderConfig
-	86, // 14: libops.v1.GetSiteResponse.site:type_name -> libops.v1.common.SiteConfig
-	86, // 15: libops.v1.CreateSiteRequest.site:type_name -> libops.v1.common.SiteConfig
-	86, // 16: libops.v1.CreateSiteResponse.site:type_name -> libops.v1.common.SiteConfig
-	86, // 17: libops.v1.UpdateSiteRequest.site:type_name -> libops.v1.common.SiteConfig
-	84, // 18: libops.v1.UpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
-	86, // 19: libops.v1.UpdateSiteResponse.site:type_name -> libops.v1.common.SiteConfig
-	86, // 20: libops.v1.ListSitesResponse.sites:type_name -> libops.v1.common.SiteConfig
-	0,  // 21: libops.v1.OrganizationFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
-	87, // 22: libops.v1.OrganizationFirewallRule.status:type_name -> libops.v1.common.Status
-	0,  // 23: libops.v1.ProjectFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
-	87, // 24: libops.v1.ProjectFirewallRule.status:type_name -> libops.v1.common.Status
-	0,  // 25: libops.v1.SiteFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
-	87, // 26: libops.v1.SiteFirewallRule.status:type_name -> libops.v1.common.Status
-	87, // 27: libops.v1.MemberDetail.status:type_name -> libops.v1.common.Status
-	32, // 28: libops.v1.ListOrganizationFirewallRulesResponse.rules:type_name -> libops.v1.OrganizationFirewallRule
-	0,  // 29: libops.v1.CreateOrganizationFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
-	32, // 30: libops.v1.CreateOrganizationFirewallRuleResponse.rule:type_name -> libops.v1.OrganizationFirewallRule
-	33, // 31: libops.v1.ListProjectFirewallRulesResponse.rules:type_name -> libops.v1.ProjectFirewallRule
-	0,  // 32: libops.v1.CreateProjectFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
-	33, // 33: libops.v1.CreateProjectFirewallRuleResponse.rule:type_name -> libops.v1.ProjectFirewallRule
-	34, // 34: libops.v1.ListSiteFirewallRulesResponse.rules:type_name -> libops.v1.SiteFirewallRule
-	0,  // 35: libops.v1.CreateSiteFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
-	34, // 36: libops.v1.CreateSiteFirewallRuleResponse.rule:type_name -> libops.v1.SiteFirewallRule
-	35, // 37: libops.v1.ListOrganizationMembersResponse.members:type_name -> libops.v1.MemberDetail
-	35, // 38: libops.v1.CreateOrganizationMemberResponse.member:type_name -> libops.v1.MemberDetail
-	84, // 39: libops.v1.UpdateOrganizationMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 40: libops.v1.UpdateOrganizationMemberResponse.member:type_name -> libops.v1.MemberDetail
-	35, // 41: libops.v1.ListProjectMembersResponse.members:type_name -> libops.v1.MemberDetail
-	35, // 42: libops.v1.CreateProjectMemberResponse.member:type_name -> libops.v1.MemberDetail
-	84, // 43: libops.v1.UpdateProjectMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 44: libops.v1.UpdateProjectMemberResponse.member:type_name -> libops.v1.MemberDetail
-	35, // 45: libops.v1.ListSiteMembersResponse.members:type_name -> libops.v1.MemberDetail
-	35, // 46: libops.v1.CreateSiteMemberResponse.member:type_name -> libops.v1.MemberDetail
-	84, // 47: libops.v1.UpdateSiteMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
-	35, // 48: libops.v1.UpdateSiteMemberResponse.member:type_name -> libops.v1.MemberDetail
-	36, // 49: libops.v1.ListSshKeysResponse.ssh_keys:type_name -> libops.v1.SshKey
-	36, // 50: libops.v1.CreateSshKeyResponse.ssh_key:type_name -> libops.v1.SshKey
-	37, // 51: libops.v1.GetSiteStatusResponse.status:type_name -> libops.v1.SiteStatus
-	37, // 52: libops.v1.DeploySiteResponse.status:type_name -> libops.v1.SiteStatus
-	12, // 53: libops.v1.OrganizationService.GetOrganization:input_type -> libops.v1.GetOrganizationRequest
-	14, // 54: libops.v1.OrganizationService.CreateOrganization:input_type -> libops.v1.CreateOrganizationRequest
-	16, // 55: libops.v1.OrganizationService.UpdateOrganization:input_type -> libops.v1.UpdateOrganizationRequest
-	18, // 56: libops.v1.OrganizationService.DeleteOrganization:input_type -> libops.v1.DeleteOrganizationRequest
-	19, // 57: libops.v1.OrganizationService.ListOrganizations:input_type -> libops.v1.ListOrganizationsRequest
-	21, // 58: libops.v1.OrganizationService.ListOrganizationProjects:input_type -> libops.v1.ListOrganizationProjectsRequest
-	30, // 59: libops.v1.SiteService.ListSites:input_type -> libops.v1.ListSitesRequest
-	23, // 60: libops.v1.SiteService.GetSite:input_type -> libops.v1.GetSiteRequest
-	25, // 61: libops.v1.SiteService.CreateSite:input_type -> libops.v1.CreateSiteRequest
-	27, // 62: libops.v1.SiteService.UpdateSite:input_type -> libops.v1.UpdateSiteRequest
-	29, // 63: libops.v1.SiteService.DeleteSite:input_type -> libops.v1.DeleteSiteRequest
-	1,  // 64: libops.v1.ProjectService.GetProject:input_type -> libops.v1.GetProjectRequest
-	3,  // 65: libops.v1.ProjectService.CreateProject:input_type -> libops.v1.CreateProjectRequest
-	5,  // 66: libops.v1.ProjectService.UpdateProject:input_type -> libops.v1.UpdateProjectRequest
-	7,  // 67: libops.v1.ProjectService.DeleteProject:input_type -> libops.v1.DeleteProjectRequest
-	8,  // 68: libops.v1.ProjectService.ListProjects:input_type -> libops.v1.ListProjectsRequest
-	10, // 69: libops.v1.ProjectService.ListProjectSites:input_type -> libops.v1.ListProjectSitesRequest
-	38, // 70: libops.v1.FirewallService.ListOrganizationFirewallRules:input_type -> libops.v1.ListOrganizationFirewallRulesRequest
-	40, // 71: libops.v1.FirewallService.CreateOrganizationFirewallRule:input_type -> libops.v1.CreateOrganizationFirewallRuleRequest
-	42, // 72: libops.v1.FirewallService.DeleteOrganizationFirewallRule:input_type -> libops.v1.DeleteOrganizationFirewallRuleRequest
-	43, // 73: libops.v1.ProjectFirewallService.ListProjectFirewallRules:input_type -> libops.v1.ListProjectFirewallRulesRequest
-	45, // 74: libops.v1.ProjectFirewallService.CreateProjectFirewallRule:input_type -> libops.v1.CreateProjectFirewallRuleRequest
-	47, // 75: libops.v1.ProjectFirewallService.DeleteProjectFirewallRule:input_type -> libops.v1.DeleteProjectFirewallRuleRequest
-	48, // 76: libops.v1.SiteFirewallService.ListSiteFirewallRules:input_type -> libops.v1.ListSiteFirewallRulesRequest
-	50, // 77: libops.v1.SiteFirewallService.CreateSiteFirewallRule:input_type -> libops.v1.CreateSiteFirewallRuleRequest
-	52, // 78: libops.v1.SiteFirewallService.DeleteSiteFirewallRule:input_type -> libops.v1.DeleteSiteFirewallRuleRequest
-	53, // 79: libops.v1.MemberService.ListOrganizationMembers:input_type -> libops.v1.ListOrganizationMembersRequest
-	55, // 80: libops.v1.MemberService.CreateOrganizationMember:input_type -> libops.v1.CreateOrganizationMemberRequest
-	57, // 81: libops.v1.MemberService.UpdateOrganizationMember:input_type -> libops.v1.UpdateOrganizationMemberRequest
-	59, // 82: libops.v1.MemberService.DeleteOrganizationMember:input_type -> libops.v1.DeleteOrganizationMemberRequest
-	60, // 83: libops.v1.ProjectMemberService.ListProjectMembers:input_type -> libops.v1.ListProjectMembersRequest
-	62, // 84: libops.v1.ProjectMemberService.CreateProjectMember:input_type -> libops.v1.CreateProjectMemberRequest
-	64, // 85: libops.v1.ProjectMemberService.UpdateProjectMember:input_type -> libops.v1.UpdateProjectMemberRequest
-	66, // 86: libops.v1.ProjectMemberService.DeleteProjectMember:input_type -> libops.v1.DeleteProjectMemberRequest
-	67, // 87: libops.v1.SiteMemberService.ListSiteMembers:input_type -> libops.v1.ListSiteMembersRequest
-	69, // 88: libops.v1.SiteMemberService.CreateSiteMember:input_type -> libops.v1.CreateSiteMemberRequest
-	71, // 89: libops.v1.SiteMemberService.UpdateSiteMember:input_type -> libops.v1.UpdateSiteMemberRequest
-	73, // 90: libops.v1.SiteMemberService.DeleteSiteMember:input_type -> libops.v1.DeleteSiteMemberRequest
-	74, // 91: libops.v1.SshKeyService.ListSshKeys:input_type -> libops.v1.ListSshKeysRequest
-	76, // 92: libops.v1.SshKeyService.CreateSshKey:input_type -> libops.v1.CreateSshKeyRequest
-	78, // 93: libops.v1.SshKeyService.DeleteSshKey:input_type -> libops.v1.DeleteSshKeyRequest
-	79, // 94: libops.v1.SiteOperationsService.GetSiteStatus:input_type -> libops.v1.GetSiteStatusRequest
-	81, // 95: libops.v1.SiteOperationsService.DeploySite:input_type -> libops.v1.DeploySiteRequest
-	13, // 96: libops.v1.OrganizationService.GetOrganization:output_type -> libops.v1.GetOrganizationResponse
-	15, // 97: libops.v1.OrganizationService.CreateOrganization:output_type -> libops.v1.CreateOrganizationResponse
-	17, // 98: libops.v1.OrganizationService.UpdateOrganization:output_type -> libops.v1.UpdateOrganizationResponse
-	88, // 99: libops.v1.OrganizationService.DeleteOrganization:output_type -> google.protobuf.Empty
-	20, // 100: libops.v1.OrganizationService.ListOrganizations:output_type -> libops.v1.ListOrganizationsResponse
-	22, // 101: libops.v1.OrganizationService.ListOrganizationProjects:output_type -> libops.v1.ListOrganizationProjectsResponse
-	31, // 102: libops.v1.SiteService.ListSites:output_type -> libops.v1.ListSitesResponse
-	24, // 103: libops.v1.SiteService.GetSite:output_type -> libops.v1.GetSiteResponse
-	26, // 104: libops.v1.SiteService.CreateSite:output_type -> libops.v1.CreateSiteResponse
-	28, // 105: libops.v1.SiteService.UpdateSite:output_type -> libops.v1.UpdateSiteResponse
-	88, // 106: libops.v1.SiteService.DeleteSite:output_type -> google.protobuf.Empty
-	2,  // 107: libops.v1.ProjectService.GetProject:output_type -> libops.v1.GetProjectResponse
-	4,  // 108: libops.v1.ProjectService.CreateProject:output_type -> libops.v1.CreateProjectResponse
-	6,  // 109: libops.v1.ProjectService.UpdateProject:output_type -> libops.v1.UpdateProjectResponse
-	88, // 110: libops.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	9,  // 111: libops.v1.ProjectService.ListProjects:output_type -> libops.v1.ListProjectsResponse
-	11, // 112: libops.v1.ProjectService.ListProjectSites:output_type -> libops.v1.ListProjectSitesResponse
-	39, // 113: libops.v1.FirewallService.ListOrganizationFirewallRules:output_type -> libops.v1.ListOrganizationFirewallRulesResponse
-	41, // 114: libops.v1.FirewallService.CreateOrganizationFirewallRule:output_type -> libops.v1.CreateOrganizationFirewallRuleResponse
-	88, // 115: libops.v1.FirewallService.DeleteOrganizationFirewallRule:output_type -> google.protobuf.Empty
-	44, // 116: libops.v1.ProjectFirewallService.ListProjectFirewallRules:output_type -> libops.v1.ListProjectFirewallRulesResponse
-	46, // 117: libops.v1.ProjectFirewallService.CreateProjectFirewallRule:output_type -> libops.v1.CreateProjectFirewallRuleResponse
-	88, // 118: libops.v1.ProjectFirewallService.DeleteProjectFirewallRule:output_type -> google.protobuf.Empty
-	49, // 119: libops.v1.SiteFirewallService.ListSiteFirewallRules:output_type -> libops.v1.ListSiteFirewallRulesResponse
-	51, // 120: libops.v1.SiteFirewallService.CreateSiteFirewallRule:output_type -> libops.v1.CreateSiteFirewallRuleResponse
-	88, // 121: libops.v1.SiteFirewallService.DeleteSiteFirewallRule:output_type -> google.protobuf.Empty
-	54, // 122: libops.v1.MemberService.ListOrganizationMembers:output_type -> libops.v1.ListOrganizationMembersResponse
-	56, // 123: libops.v1.MemberService.CreateOrganizationMember:output_type -> libops.v1.CreateOrganizationMemberResponse
-	58, // 124: libops.v1.MemberService.UpdateOrganizationMember:output_type -> libops.v1.UpdateOrganizationMemberResponse
-	88, // 125: libops.v1.MemberService.DeleteOrganizationMember:output_type -> google.protobuf.Empty
-	61, // 126: libops.v1.ProjectMemberService.ListProjectMembers:output_type -> libops.v1.ListProjectMembersResponse
-	63, // 127: libops.v1.ProjectMemberService.CreateProjectMember:output_type -> libops.v1.CreateProjectMemberResponse
-	65, // 128: libops.v1.ProjectMemberService.UpdateProjectMember:output_type -> libops.v1.UpdateProjectMemberResponse
-	88, // 129: libops.v1.ProjectMemberService.DeleteProjectMember:output_type -> google.protobuf.Empty
-	68, // 130: libops.v1.SiteMemberService.ListSiteMembers:output_type -> libops.v1.ListSiteMembersResponse
-	70, // 131: libops.v1.SiteMemberService.CreateSiteMember:output_type -> libops.v1.CreateSiteMemberResponse
-	72, // 132: libops.v1.SiteMemberService.UpdateSiteMember:output_type -> libops.v1.UpdateSiteMemberResponse
-	88, // 133: libops.v1.SiteMemberService.DeleteSiteMember:output_type -> google.protobuf.Empty
-	75, // 134: libops.v1.SshKeyService.ListSshKeys:output_type -> libops.v1.ListSshKeysResponse
-	77, // 135: libops.v1.SshKeyService.CreateSshKey:output_type -> libops.v1.CreateSshKeyResponse
-	88, // 136: libops.v1.SshKeyService.DeleteSshKey:output_type -> google.protobuf.Empty
-	80, // 137: libops.v1.SiteOperationsService.GetSiteStatus:output_type -> libops.v1.GetSiteStatusResponse
-	82, // 138: libops.v1.SiteOperationsService.DeploySite:output_type -> libops.v1.DeploySiteResponse
-	96, // [96:139] is the sub-list for method output_type
-	53, // [53:96] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	125, // 0: libops.v1.GetProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
+	125, // 1: libops.v1.CreateProjectRequest.project:type_name -> libops.v1.common.ProjectConfig
+	125, // 2: libops.v1.CreateProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
+	125, // 3: libops.v1.UpdateProjectRequest.project:type_name -> libops.v1.common.ProjectConfig
+	126, // 4: libops.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	125, // 5: libops.v1.UpdateProjectResponse.project:type_name -> libops.v1.common.ProjectConfig
+	125, // 6: libops.v1.ChangePlanResponse.project:type_name -> libops.v1.common.ProjectConfig
+	125, // 7: libops.v1.ListProjectsResponse.projects:type_name -> libops.v1.common.ProjectConfig
+	127, // 8: libops.v1.GetOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
+	127, // 9: libops.v1.CreateOrganizationRequest.folder:type_name -> libops.v1.common.FolderConfig
+	127, // 10: libops.v1.CreateOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
+	127, // 11: libops.v1.UpdateOrganizationRequest.folder:type_name -> libops.v1.common.FolderConfig
+	126, // 12: libops.v1.UpdateOrganizationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	127, // 13: libops.v1.UpdateOrganizationResponse.folder:type_name -> libops.v1.common.FolderConfig
+	127, // 14: libops.v1.ListOrganizationsResponse.organizations:type_name -> libops.v1.common.FolderConfig
+	128, // 15: libops.v1.GetQuotasResponse.quotas:type_name -> libops.v1.common.Quota
+	129, // 16: libops.v1.GetOrganizationUsageResponse.subscription:type_name -> libops.v1.common.BillingSubscription
+	130, // 17: libops.v1.GetOrganizationUsageResponse.projects:type_name -> libops.v1.common.ProjectUsage
+	131, // 18: libops.v1.GetOrganizationUsageResponse.items:type_name -> libops.v1.common.SubscriptionItem
+	132, // 19: libops.v1.PreviewUsageResponse.usage:type_name -> libops.v1.common.MeteredUsage
+	133, // 20: libops.v1.ListPaymentMethodsResponse.payment_methods:type_name -> libops.v1.common.PaymentMethod
+	133, // 21: libops.v1.SetDefaultPaymentMethodResponse.payment_method:type_name -> libops.v1.common.PaymentMethod
+	134, // 22: libops.v1.ListInvoicesResponse.invoices:type_name -> libops.v1.common.Invoice
+	134, // 23: libops.v1.GetInvoiceResponse.invoice:type_name -> libops.v1.common.Invoice
+	135, // 24: libops.v1.GetSiteResponse.site:type_name -> libops.v1.common.SiteConfig
+	135, // 25: libops.v1.CreateSiteRequest.site:type_name -> libops.v1.common.SiteConfig
+	135, // 26: libops.v1.CreateSiteResponse.site:type_name -> libops.v1.common.SiteConfig
+	135, // 27: libops.v1.UpdateSiteRequest.site:type_name -> libops.v1.common.SiteConfig
+	126, // 28: libops.v1.UpdateSiteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	135, // 29: libops.v1.UpdateSiteResponse.site:type_name -> libops.v1.common.SiteConfig
+	135, // 30: libops.v1.ListSitesResponse.sites:type_name -> libops.v1.common.SiteConfig
+	0,   // 31: libops.v1.OrganizationFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
+	136, // 32: libops.v1.OrganizationFirewallRule.status:type_name -> libops.v1.common.Status
+	0,   // 33: libops.v1.ProjectFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
+	136, // 34: libops.v1.ProjectFirewallRule.status:type_name -> libops.v1.common.Status
+	0,   // 35: libops.v1.SiteFirewallRule.rule_type:type_name -> libops.v1.FirewallRuleType
+	136, // 36: libops.v1.SiteFirewallRule.status:type_name -> libops.v1.common.Status
+	1,   // 37: libops.v1.SiteRateLimitRule.window:type_name -> libops.v1.RateLimitWindow
+	136, // 38: libops.v1.MemberDetail.status:type_name -> libops.v1.common.Status
+	137, // 39: libops.v1.SiteStatus.cdn:type_name -> libops.v1.common.SiteCdn
+	138, // 40: libops.v1.SiteStatus.addons:type_name -> libops.v1.SiteAddon
+	60,  // 41: libops.v1.SiteStatus.components:type_name -> libops.v1.SiteComponentStatus
+	2,   // 42: libops.v1.SiteComponentStatus.state:type_name -> libops.v1.SiteComponentState
+	52,  // 43: libops.v1.ListOrganizationFirewallRulesResponse.rules:type_name -> libops.v1.OrganizationFirewallRule
+	0,   // 44: libops.v1.CreateOrganizationFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
+	52,  // 45: libops.v1.CreateOrganizationFirewallRuleResponse.rule:type_name -> libops.v1.OrganizationFirewallRule
+	53,  // 46: libops.v1.ListProjectFirewallRulesResponse.rules:type_name -> libops.v1.ProjectFirewallRule
+	0,   // 47: libops.v1.CreateProjectFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
+	53,  // 48: libops.v1.CreateProjectFirewallRuleResponse.rule:type_name -> libops.v1.ProjectFirewallRule
+	54,  // 49: libops.v1.ListSiteFirewallRulesResponse.rules:type_name -> libops.v1.SiteFirewallRule
+	0,   // 50: libops.v1.CreateSiteFirewallRuleRequest.rule_type:type_name -> libops.v1.FirewallRuleType
+	54,  // 51: libops.v1.CreateSiteFirewallRuleResponse.rule:type_name -> libops.v1.SiteFirewallRule
+	55,  // 52: libops.v1.ListSiteRateLimitRulesResponse.rules:type_name -> libops.v1.SiteRateLimitRule
+	1,   // 53: libops.v1.CreateSiteRateLimitRuleRequest.window:type_name -> libops.v1.RateLimitWindow
+	55,  // 54: libops.v1.CreateSiteRateLimitRuleResponse.rule:type_name -> libops.v1.SiteRateLimitRule
+	56,  // 55: libops.v1.ListOrganizationMembersResponse.members:type_name -> libops.v1.MemberDetail
+	56,  // 56: libops.v1.CreateOrganizationMemberResponse.member:type_name -> libops.v1.MemberDetail
+	57,  // 57: libops.v1.CreateOrganizationMemberResponse.invitation:type_name -> libops.v1.MemberInvitation
+	126, // 58: libops.v1.UpdateOrganizationMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	56,  // 59: libops.v1.UpdateOrganizationMemberResponse.member:type_name -> libops.v1.MemberDetail
+	56,  // 60: libops.v1.BulkMemberResult.member:type_name -> libops.v1.MemberDetail
+	57,  // 61: libops.v1.BulkMemberResult.invitation:type_name -> libops.v1.MemberInvitation
+	88,  // 62: libops.v1.BulkCreateMembersRequest.members:type_name -> libops.v1.BulkMemberEntry
+	90,  // 63: libops.v1.BulkCreateMembersResponse.results:type_name -> libops.v1.BulkMemberResult
+	89,  // 64: libops.v1.BulkUpdateMemberRolesRequest.members:type_name -> libops.v1.BulkMemberRole
+	90,  // 65: libops.v1.BulkUpdateMemberRolesResponse.results:type_name -> libops.v1.BulkMemberResult
+	90,  // 66: libops.v1.BulkRemoveMembersResponse.results:type_name -> libops.v1.BulkMemberResult
+	56,  // 67: libops.v1.ListProjectMembersResponse.members:type_name -> libops.v1.MemberDetail
+	56,  // 68: libops.v1.CreateProjectMemberResponse.member:type_name -> libops.v1.MemberDetail
+	57,  // 69: libops.v1.CreateProjectMemberResponse.invitation:type_name -> libops.v1.MemberInvitation
+	126, // 70: libops.v1.UpdateProjectMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	56,  // 71: libops.v1.UpdateProjectMemberResponse.member:type_name -> libops.v1.MemberDetail
+	56,  // 72: libops.v1.ListSiteMembersResponse.members:type_name -> libops.v1.MemberDetail
+	56,  // 73: libops.v1.CreateSiteMemberResponse.member:type_name -> libops.v1.MemberDetail
+	57,  // 74: libops.v1.CreateSiteMemberResponse.invitation:type_name -> libops.v1.MemberInvitation
+	126, // 75: libops.v1.UpdateSiteMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	56,  // 76: libops.v1.UpdateSiteMemberResponse.member:type_name -> libops.v1.MemberDetail
+	58,  // 77: libops.v1.ListSshKeysResponse.ssh_keys:type_name -> libops.v1.SshKey
+	58,  // 78: libops.v1.CreateSshKeyResponse.ssh_key:type_name -> libops.v1.SshKey
+	59,  // 79: libops.v1.GetSiteStatusResponse.status:type_name -> libops.v1.SiteStatus
+	59,  // 80: libops.v1.DeploySiteResponse.status:type_name -> libops.v1.SiteStatus
+	120, // 81: libops.v1.ListSiteDeploymentsResponse.deployments:type_name -> libops.v1.Deployment
+	120, // 82: libops.v1.RollbackSiteResponse.deployment:type_name -> libops.v1.Deployment
+	16,  // 83: libops.v1.OrganizationService.GetOrganization:input_type -> libops.v1.GetOrganizationRequest
+	18,  // 84: libops.v1.OrganizationService.CreateOrganization:input_type -> libops.v1.CreateOrganizationRequest
+	20,  // 85: libops.v1.OrganizationService.UpdateOrganization:input_type -> libops.v1.UpdateOrganizationRequest
+	22,  // 86: libops.v1.OrganizationService.DeleteOrganization:input_type -> libops.v1.DeleteOrganizationRequest
+	23,  // 87: libops.v1.OrganizationService.ListOrganizations:input_type -> libops.v1.ListOrganizationsRequest
+	25,  // 88: libops.v1.OrganizationService.ListOrganizationProjects:input_type -> libops.v1.ListOrganizationProjectsRequest
+	27,  // 89: libops.v1.OrganizationService.GetQuotas:input_type -> libops.v1.GetQuotasRequest
+	29,  // 90: libops.v1.OrganizationService.GetOrganizationUsage:input_type -> libops.v1.GetOrganizationUsageRequest
+	31,  // 91: libops.v1.OrganizationService.PreviewUsage:input_type -> libops.v1.PreviewUsageRequest
+	33,  // 92: libops.v1.OrganizationService.CreateBillingPortalSession:input_type -> libops.v1.CreateBillingPortalSessionRequest
+	35,  // 93: libops.v1.OrganizationService.ListPaymentMethods:input_type -> libops.v1.ListPaymentMethodsRequest
+	37,  // 94: libops.v1.OrganizationService.SetDefaultPaymentMethod:input_type -> libops.v1.SetDefaultPaymentMethodRequest
+	39,  // 95: libops.v1.OrganizationService.ListInvoices:input_type -> libops.v1.ListInvoicesRequest
+	41,  // 96: libops.v1.OrganizationService.GetInvoice:input_type -> libops.v1.GetInvoiceRequest
+	50,  // 97: libops.v1.SiteService.ListSites:input_type -> libops.v1.ListSitesRequest
+	43,  // 98: libops.v1.SiteService.GetSite:input_type -> libops.v1.GetSiteRequest
+	45,  // 99: libops.v1.SiteService.CreateSite:input_type -> libops.v1.CreateSiteRequest
+	47,  // 100: libops.v1.SiteService.UpdateSite:input_type -> libops.v1.UpdateSiteRequest
+	49,  // 101: libops.v1.SiteService.DeleteSite:input_type -> libops.v1.DeleteSiteRequest
+	3,   // 102: libops.v1.ProjectService.GetProject:input_type -> libops.v1.GetProjectRequest
+	5,   // 103: libops.v1.ProjectService.CreateProject:input_type -> libops.v1.CreateProjectRequest
+	7,   // 104: libops.v1.ProjectService.UpdateProject:input_type -> libops.v1.UpdateProjectRequest
+	9,   // 105: libops.v1.ProjectService.ChangePlan:input_type -> libops.v1.ChangePlanRequest
+	11,  // 106: libops.v1.ProjectService.DeleteProject:input_type -> libops.v1.DeleteProjectRequest
+	12,  // 107: libops.v1.ProjectService.ListProjects:input_type -> libops.v1.ListProjectsRequest
+	14,  // 108: libops.v1.ProjectService.ListProjectSites:input_type -> libops.v1.ListProjectSitesRequest
+	61,  // 109: libops.v1.FirewallService.ListOrganizationFirewallRules:input_type -> libops.v1.ListOrganizationFirewallRulesRequest
+	63,  // 110: libops.v1.FirewallService.CreateOrganizationFirewallRule:input_type -> libops.v1.CreateOrganizationFirewallRuleRequest
+	65,  // 111: libops.v1.FirewallService.DeleteOrganizationFirewallRule:input_type -> libops.v1.DeleteOrganizationFirewallRuleRequest
+	66,  // 112: libops.v1.ProjectFirewallService.ListProjectFirewallRules:input_type -> libops.v1.ListProjectFirewallRulesRequest
+	68,  // 113: libops.v1.ProjectFirewallService.CreateProjectFirewallRule:input_type -> libops.v1.CreateProjectFirewallRuleRequest
+	70,  // 114: libops.v1.ProjectFirewallService.DeleteProjectFirewallRule:input_type -> libops.v1.DeleteProjectFirewallRuleRequest
+	71,  // 115: libops.v1.SiteFirewallService.ListSiteFirewallRules:input_type -> libops.v1.ListSiteFirewallRulesRequest
+	73,  // 116: libops.v1.SiteFirewallService.CreateSiteFirewallRule:input_type -> libops.v1.CreateSiteFirewallRuleRequest
+	75,  // 117: libops.v1.SiteFirewallService.DeleteSiteFirewallRule:input_type -> libops.v1.DeleteSiteFirewallRuleRequest
+	76,  // 118: libops.v1.SiteFirewallService.ListSiteRateLimitRules:input_type -> libops.v1.ListSiteRateLimitRulesRequest
+	78,  // 119: libops.v1.SiteFirewallService.CreateSiteRateLimitRule:input_type -> libops.v1.CreateSiteRateLimitRuleRequest
+	80,  // 120: libops.v1.SiteFirewallService.DeleteSiteRateLimitRule:input_type -> libops.v1.DeleteSiteRateLimitRuleRequest
+	81,  // 121: libops.v1.MemberService.ListOrganizationMembers:input_type -> libops.v1.ListOrganizationMembersRequest
+	83,  // 122: libops.v1.MemberService.CreateOrganizationMember:input_type -> libops.v1.CreateOrganizationMemberRequest
+	85,  // 123: libops.v1.MemberService.UpdateOrganizationMember:input_type -> libops.v1.UpdateOrganizationMemberRequest
+	87,  // 124: libops.v1.MemberService.DeleteOrganizationMember:input_type -> libops.v1.DeleteOrganizationMemberRequest
+	91,  // 125: libops.v1.MemberService.BulkCreateMembers:input_type -> libops.v1.BulkCreateMembersRequest
+	93,  // 126: libops.v1.MemberService.BulkUpdateMemberRoles:input_type -> libops.v1.BulkUpdateMemberRolesRequest
+	95,  // 127: libops.v1.MemberService.BulkRemoveMembers:input_type -> libops.v1.BulkRemoveMembersRequest
+	97,  // 128: libops.v1.ProjectMemberService.ListProjectMembers:input_type -> libops.v1.ListProjectMembersRequest
+	99,  // 129: libops.v1.ProjectMemberService.CreateProjectMember:input_type -> libops.v1.CreateProjectMemberRequest
+	101, // 130: libops.v1.ProjectMemberService.UpdateProjectMember:input_type -> libops.v1.UpdateProjectMemberRequest
+	103, // 131: libops.v1.ProjectMemberService.DeleteProjectMember:input_type -> libops.v1.DeleteProjectMemberRequest
+	104, // 132: libops.v1.SiteMemberService.ListSiteMembers:input_type -> libops.v1.ListSiteMembersRequest
+	106, // 133: libops.v1.SiteMemberService.CreateSiteMember:input_type -> libops.v1.CreateSiteMemberRequest
+	108, // 134: libops.v1.SiteMemberService.UpdateSiteMember:input_type -> libops.v1.UpdateSiteMemberRequest
+	110, // 135: libops.v1.SiteMemberService.DeleteSiteMember:input_type -> libops.v1.DeleteSiteMemberRequest
+	111, // 136: libops.v1.SshKeyService.ListSshKeys:input_type -> libops.v1.ListSshKeysRequest
+	113, // 137: libops.v1.SshKeyService.CreateSshKey:input_type -> libops.v1.CreateSshKeyRequest
+	115, // 138: libops.v1.SshKeyService.DeleteSshKey:input_type -> libops.v1.DeleteSshKeyRequest
+	116, // 139: libops.v1.SiteOperationsService.GetSiteStatus:input_type -> libops.v1.GetSiteStatusRequest
+	118, // 140: libops.v1.SiteOperationsService.DeploySite:input_type -> libops.v1.DeploySiteRequest
+	121, // 141: libops.v1.SiteOperationsService.ListSiteDeployments:input_type -> libops.v1.ListSiteDeploymentsRequest
+	123, // 142: libops.v1.SiteOperationsService.RollbackSite:input_type -> libops.v1.RollbackSiteRequest
+	17,  // 143: libops.v1.OrganizationService.GetOrganization:output_type -> libops.v1.GetOrganizationResponse
+	19,  // 144: libops.v1.OrganizationService.CreateOrganization:output_type -> libops.v1.CreateOrganizationResponse
+	21,  // 145: libops.v1.OrganizationService.UpdateOrganization:output_type -> libops.v1.UpdateOrganizationResponse
+	139, // 146: libops.v1.OrganizationService.DeleteOrganization:output_type -> google.protobuf.Empty
+	24,  // 147: libops.v1.OrganizationService.ListOrganizations:output_type -> libops.v1.ListOrganizationsResponse
+	26,  // 148: libops.v1.OrganizationService.ListOrganizationProjects:output_type -> libops.v1.ListOrganizationProjectsResponse
+	28,  // 149: libops.v1.OrganizationService.GetQuotas:output_type -> libops.v1.GetQuotasResponse
+	30,  // 150: libops.v1.OrganizationService.GetOrganizationUsage:output_type -> libops.v1.GetOrganizationUsageResponse
+	32,  // 151: libops.v1.OrganizationService.PreviewUsage:output_type -> libops.v1.PreviewUsageResponse
+	34,  // 152: libops.v1.OrganizationService.CreateBillingPortalSession:output_type -> libops.v1.CreateBillingPortalSessionResponse
+	36,  // 153: libops.v1.OrganizationService.ListPaymentMethods:output_type -> libops.v1.ListPaymentMethodsResponse
+	38,  // 154: libops.v1.OrganizationService.SetDefaultPaymentMethod:output_type -> libops.v1.SetDefaultPaymentMethodResponse
+	40,  // 155: libops.v1.OrganizationService.ListInvoices:output_type -> libops.v1.ListInvoicesResponse
+	42,  // 156: libops.v1.OrganizationService.GetInvoice:output_type -> libops.v1.GetInvoiceResponse
+	51,  // 157: libops.v1.SiteService.ListSites:output_type -> libops.v1.ListSitesResponse
+	44,  // 158: libops.v1.SiteService.GetSite:output_type -> libops.v1.GetSiteResponse
+	46,  // 159: libops.v1.SiteService.CreateSite:output_type -> libops.v1.CreateSiteResponse
+	48,  // 160: libops.v1.SiteService.UpdateSite:output_type -> libops.v1.UpdateSiteResponse
+	139, // 161: libops.v1.SiteService.DeleteSite:output_type -> google.protobuf.Empty
+	4,   // 162: libops.v1.ProjectService.GetProject:output_type -> libops.v1.GetProjectResponse
+	6,   // 163: libops.v1.ProjectService.CreateProject:output_type -> libops.v1.CreateProjectResponse
+	8,   // 164: libops.v1.ProjectService.UpdateProject:output_type -> libops.v1.UpdateProjectResponse
+	10,  // 165: libops.v1.ProjectService.ChangePlan:output_type -> libops.v1.ChangePlanResponse
+	139, // 166: libops.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	13,  // 167: libops.v1.ProjectService.ListProjects:output_type -> libops.v1.ListProjectsResponse
+	15,  // 168: libops.v1.ProjectService.ListProjectSites:output_type -> libops.v1.ListProjectSitesResponse
+	62,  // 169: libops.v1.FirewallService.ListOrganizationFirewallRules:output_type -> libops.v1.ListOrganizationFirewallRulesResponse
+	64,  // 170: libops.v1.FirewallService.CreateOrganizationFirewallRule:output_type -> libops.v1.CreateOrganizationFirewallRuleResponse
+	139, // 171: libops.v1.FirewallService.DeleteOrganizationFirewallRule:output_type -> google.protobuf.Empty
+	67,  // 172: libops.v1.ProjectFirewallService.ListProjectFirewallRules:output_type -> libops.v1.ListProjectFirewallRulesResponse
+	69,  // 173: libops.v1.ProjectFirewallService.CreateProjectFirewallRule:output_type -> libops.v1.CreateProjectFirewallRuleResponse
+	139, // 174: libops.v1.ProjectFirewallService.DeleteProjectFirewallRule:output_type -> google.protobuf.Empty
+	72,  // 175: libops.v1.SiteFirewallService.ListSiteFirewallRules:output_type -> libops.v1.ListSiteFirewallRulesResponse
+	74,  // 176: libops.v1.SiteFirewallService.CreateSiteFirewallRule:output_type -> libops.v1.CreateSiteFirewallRuleResponse
+	139, // 177: libops.v1.SiteFirewallService.DeleteSiteFirewallRule:output_type -> google.protobuf.Empty
+	77,  // 178: libops.v1.SiteFirewallService.ListSiteRateLimitRules:output_type -> libops.v1.ListSiteRateLimitRulesResponse
+	79,  // 179: libops.v1.SiteFirewallService.CreateSiteRateLimitRule:output_type -> libops.v1.CreateSiteRateLimitRuleResponse
+	139, // 180: libops.v1.SiteFirewallService.DeleteSiteRateLimitRule:output_type -> google.protobuf.Empty
+	82,  // 181: libops.v1.MemberService.ListOrganizationMembers:output_type -> libops.v1.ListOrganizationMembersResponse
+	84,  // 182: libops.v1.MemberService.CreateOrganizationMember:output_type -> libops.v1.CreateOrganizationMemberResponse
+	86,  // 183: libops.v1.MemberService.UpdateOrganizationMember:output_type -> libops.v1.UpdateOrganizationMemberResponse
+	139, // 184: libops.v1.MemberService.DeleteOrganizationMember:output_type -> google.protobuf.Empty
+	92,  // 185: libops.v1.MemberService.BulkCreateMembers:output_type -> libops.v1.BulkCreateMembersResponse
+	94,  // 186: libops.v1.MemberService.BulkUpdateMemberRoles:output_type -> libops.v1.BulkUpdateMemberRolesResponse
+	96,  // 187: libops.v1.MemberService.BulkRemoveMembers:output_type -> libops.v1.BulkRemoveMembersResponse
+	98,  // 188: libops.v1.ProjectMemberService.ListProjectMembers:output_type -> libops.v1.ListProjectMembersResponse
+	100, // 189: libops.v1.ProjectMemberService.CreateProjectMember:output_type -> libops.v1.CreateProjectMemberResponse
+	102, // 190: libops.v1.ProjectMemberService.UpdateProjectMember:output_type -> libops.v1.UpdateProjectMemberResponse
+	139, // 191: libops.v1.ProjectMemberService.DeleteProjectMember:output_type -> google.protobuf.Empty
+	105, // 192: libops.v1.SiteMemberService.ListSiteMembers:output_type -> libops.v1.ListSiteMembersResponse
+	107, // 193: libops.v1.SiteMemberService.CreateSiteMember:output_type -> libops.v1.CreateSiteMemberResponse
+	109, // 194: libops.v1.SiteMemberService.UpdateSiteMember:output_type -> libops.v1.UpdateSiteMemberResponse
+	139, // 195: libops.v1.SiteMemberService.DeleteSiteMember:output_type -> google.protobuf.Empty
+	112, // 196: libops.v1.SshKeyService.ListSshKeys:output_type -> libops.v1.ListSshKeysResponse
+	114, // 197: libops.v1.SshKeyService.CreateSshKey:output_type -> libops.v1.CreateSshKeyResponse
+	139, // 198: libops.v1.SshKeyService.DeleteSshKey:output_type -> google.protobuf.Empty
+	117, // 199: libops.v1.SiteOperationsService.GetSiteStatus:output_type -> libops.v1.GetSiteStatusResponse
+	119, // 200: libops.v1.SiteOperationsService.DeploySite:output_type -> libops.v1.DeploySiteResponse
+	122, // 201: libops.v1.SiteOperationsService.ListSiteDeployments:output_type -> libops.v1.ListSiteDeploymentsResponse
+	124, // 202: libops.v1.SiteOperationsService.RollbackSite:output_type -> libops.v1.RollbackSiteResponse
+	143, // [143:203] is the sub-list for method output_type
+	83,  // [83:143] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_organization_api_proto_init() }
@@ -5267,20 +8255,24 @@ func file_libops_v1_organization_api_proto_init() {
 	if File_libops_v1_organization_api_proto != nil {
 		return
 	}
-	file_libops_v1_organization_api_proto_msgTypes[7].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[29].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[34].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[35].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[36].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[75].OneofWrappers = []any{}
-	file_libops_v1_organization_api_proto_msgTypes[80].OneofWrappers = []any{}
+	file_libops_v1_addon_proto_init()
+	file_libops_v1_organization_api_proto_msgTypes[9].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[47].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[53].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[55].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[56].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[82].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[98].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[105].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[110].OneofWrappers = []any{}
+	file_libops_v1_organization_api_proto_msgTypes[115].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_organization_api_proto_rawDesc), len(file_libops_v1_organization_api_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   82,
+			NumEnums:      3,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   11,
 		},
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/ownership.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OwnershipTransferStatus int32
+
+const (
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED OwnershipTransferStatus = 0
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_PENDING     OwnershipTransferStatus = 1 // Waiting for the new owner to accept
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_ACCEPTED    OwnershipTransferStatus = 2
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_CANCELLED   OwnershipTransferStatus = 3 // Cancelled, or replaced by a newer transfer
+	OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_EXPIRED     OwnershipTransferStatus = 4 // Not accepted before expires_at
+)
+
+// Enum value maps for OwnershipTransferStatus.
+var (
+	OwnershipTransferStatus_name = map[int32]string{
+		0: "OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED",
+		1: "OWNERSHIP_TRANSFER_STATUS_PENDING",
+		2: "OWNERSHIP_TRANSFER_STATUS_ACCEPTED",
+		3: "OWNERSHIP_TRANSFER_STATUS_CANCELLED",
+		4: "OWNERSHIP_TRANSFER_STATUS_EXPIRED",
+	}
+	OwnershipTransferStatus_value = map[string]int32{
+		"OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED": 0,
+		"OWNERSHIP_TRANSFER_STATUS_PENDING":     1,
+		"OWNERSHIP_TRANSFER_STATUS_ACCEPTED":    2,
+		"OWNERSHIP_TRANSFER_STATUS_CANCELLED":   3,
+		"OWNERSHIP_TRANSFER_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x OwnershipTransferStatus) Enum() *OwnershipTransferStatus {
+	p := new(OwnershipTransferStatus)
+	*p = x
+	return p
+}
+
+func (x OwnershipTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OwnershipTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_ownership_proto_enumTypes[0].Descriptor()
+}
+
+func (OwnershipTransferStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_ownership_proto_enumTypes[0]
+}
+
+func (x OwnershipTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OwnershipTransferStatus.Descriptor instead.
+func (OwnershipTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{0}
+}
+
+type OwnershipTransfer struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	TransferId     string                  `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`             // UUID
+	OrganizationId string                  `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	FromAccountId  string                  `protobuf:"bytes,3,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`  // UUID of the owner handing the organization over
+	FromEmail      string                  `protobuf:"bytes,4,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	ToAccountId    string                  `protobuf:"bytes,5,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"` // UUID of the member taking it over
+	ToEmail        string                  `protobuf:"bytes,6,opt,name=to_email,json=toEmail,proto3" json:"to_email,omitempty"`
+	Status         OwnershipTransferStatus `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.OwnershipTransferStatus" json:"status,omitempty"`
+	ExpiresAt      int64                   `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`     // Unix timestamp
+	CreatedAt      int64                   `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`     // Unix timestamp
+	AcceptedAt     int64                   `protobuf:"varint,10,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"` // Unix timestamp, 0 until accepted
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OwnershipTransfer) Reset() {
+	*x = OwnershipTransfer{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransfer) ProtoMessage() {}
+
+func (x *OwnershipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OwnershipTransfer) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetToEmail() string {
+	if x != nil {
+		return x.ToEmail
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetStatus() OwnershipTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OwnershipTransferStatus_OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *OwnershipTransfer) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+type TransferOrganizationOwnershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// UUID of the account to hand the organization to. It must be an active member
+	// of the organization.
+	NewOwnerId    string `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOrganizationOwnershipRequest) Reset() {
+	*x = TransferOrganizationOwnershipRequest{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOrganizationOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOrganizationOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOrganizationOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOrganizationOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOrganizationOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferOrganizationOwnershipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TransferOrganizationOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferOrganizationOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOrganizationOwnershipResponse) Reset() {
+	*x = TransferOrganizationOwnershipResponse{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOrganizationOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOrganizationOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOrganizationOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOrganizationOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOrganizationOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferOrganizationOwnershipResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type GetOrganizationOwnershipTransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationOwnershipTransferRequest) Reset() {
+	*x = GetOrganizationOwnershipTransferRequest{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *GetOrganizationOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetOrganizationOwnershipTransferRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetOrganizationOwnershipTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"` // Unset when no transfer is open
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationOwnershipTransferResponse) Reset() {
+	*x = GetOrganizationOwnershipTransferResponse{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *GetOrganizationOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetOrganizationOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptOrganizationOwnershipTransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TransferId     string                 `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationOwnershipTransferRequest) Reset() {
+	*x = AcceptOrganizationOwnershipTransferRequest{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *AcceptOrganizationOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AcceptOrganizationOwnershipTransferRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AcceptOrganizationOwnershipTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type AcceptOrganizationOwnershipTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOrganizationOwnershipTransferResponse) Reset() {
+	*x = AcceptOrganizationOwnershipTransferResponse{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrganizationOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrganizationOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *AcceptOrganizationOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrganizationOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrganizationOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptOrganizationOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type CancelOrganizationOwnershipTransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TransferId     string                 `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelOrganizationOwnershipTransferRequest) Reset() {
+	*x = CancelOrganizationOwnershipTransferRequest{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrganizationOwnershipTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrganizationOwnershipTransferRequest) ProtoMessage() {}
+
+func (x *CancelOrganizationOwnershipTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrganizationOwnershipTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrganizationOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelOrganizationOwnershipTransferRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CancelOrganizationOwnershipTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type CancelOrganizationOwnershipTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrganizationOwnershipTransferResponse) Reset() {
+	*x = CancelOrganizationOwnershipTransferResponse{}
+	mi := &file_libops_v1_ownership_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrganizationOwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrganizationOwnershipTransferResponse) ProtoMessage() {}
+
+func (x *CancelOrganizationOwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ownership_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrganizationOwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrganizationOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ownership_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelOrganizationOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+var File_libops_v1_ownership_proto protoreflect.FileDescriptor
+
+const file_libops_v1_ownership_proto_rawDesc = "" +
+	"\n" +
+	"\x19libops/v1/ownership.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\xfe\x02\n" +
+	"\x11OwnershipTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12&\n" +
+	"\x0ffrom_account_id\x18\x03 \x01(\tR\rfromAccountId\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x04 \x01(\tR\tfromEmail\x12\"\n" +
+	"\rto_account_id\x18\x05 \x01(\tR\vtoAccountId\x12\x19\n" +
+	"\bto_email\x18\x06 \x01(\tR\atoEmail\x12:\n" +
+	"\x06status\x18\a \x01(\x0e2\".libops.v1.OwnershipTransferStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vaccepted_at\x18\n" +
+	" \x01(\x03R\n" +
+	"acceptedAt\"q\n" +
+	"$TransferOrganizationOwnershipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"a\n" +
+	"%TransferOrganizationOwnershipResponse\x128\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1c.libops.v1.OwnershipTransferR\btransfer\"R\n" +
+	"'GetOrganizationOwnershipTransferRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"d\n" +
+	"(GetOrganizationOwnershipTransferResponse\x128\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1c.libops.v1.OwnershipTransferR\btransfer\"v\n" +
+	"*AcceptOrganizationOwnershipTransferRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vtransfer_id\x18\x02 \x01(\tR\n" +
+	"transferId\"g\n" +
+	"+AcceptOrganizationOwnershipTransferResponse\x128\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1c.libops.v1.OwnershipTransferR\btransfer\"v\n" +
+	"*CancelOrganizationOwnershipTransferRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vtransfer_id\x18\x02 \x01(\tR\n" +
+	"transferId\"g\n" +
+	"+CancelOrganizationOwnershipTransferResponse\x128\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1c.libops.v1.OwnershipTransferR\btransfer*\xe3\x01\n" +
+	"\x17OwnershipTransferStatus\x12)\n" +
+	"%OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!OWNERSHIP_TRANSFER_STATUS_PENDING\x10\x01\x12&\n" +
+	"\"OWNERSHIP_TRANSFER_STATUS_ACCEPTED\x10\x02\x12'\n" +
+	"#OWNERSHIP_TRANSFER_STATUS_CANCELLED\x10\x03\x12%\n" +
+	"!OWNERSHIP_TRANSFER_STATUS_EXPIRED\x10\x042\xc5\b\n" +
+	"\x10OwnershipService\x12\xf4\x01\n" +
+	"\x1dTransferOrganizationOwnership\x12/.libops.v1.TransferOrganizationOwnershipRequest\x1a0.libops.v1.TransferOrganizationOwnershipResponse\"p\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/organizations/{organization_id}/ownershipTransfers\x12\xfb\x01\n" +
+	" GetOrganizationOwnershipTransfer\x122.libops.v1.GetOrganizationOwnershipTransferRequest\x1a3.libops.v1.GetOrganizationOwnershipTransferResponse\"n\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x027\x125/v1/organizations/{organization_id}/ownershipTransfer\x90\x02\x01\x12\x9c\x02\n" +
+	"#AcceptOrganizationOwnershipTransfer\x125.libops.v1.AcceptOrganizationOwnershipTransferRequest\x1a6.libops.v1.AcceptOrganizationOwnershipTransferResponse\"\x85\x01\x92\xb5\x18+\b\x03\x10\x01\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02P:\x01*\"K/v1/organizations/{organization_id}/ownershipTransfers/{transfer_id}:accept\x12\x9c\x02\n" +
+	"#CancelOrganizationOwnershipTransfer\x125.libops.v1.CancelOrganizationOwnershipTransferRequest\x1a6.libops.v1.CancelOrganizationOwnershipTransferResponse\"\x85\x01\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02P:\x01*\"K/v1/organizations/{organization_id}/ownershipTransfers/{transfer_id}:cancelB\x94\x01\n" +
+	"\rcom.libops.v1B\x0eOwnershipProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_ownership_proto_rawDescOnce sync.Once
+	file_libops_v1_ownership_proto_rawDescData []byte
+)
+
+func file_libops_v1_ownership_proto_rawDescGZIP() []byte {
+	file_libops_v1_ownership_proto_rawDescOnce.Do(func() {
+		file_libops_v1_ownership_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_ownership_proto_rawDesc), len(file_libops_v1_ownership_proto_rawDesc)))
+	})
+	return file_libops_v1_ownership_proto_rawDescData
+}
+
+var file_libops_v1_ownership_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_ownership_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_libops_v1_ownership_proto_goTypes = []any{
+	(OwnershipTransferStatus)(0),                        // 0: libops.v1.OwnershipTransferStatus
+	(*OwnershipTransfer)(nil),                           // 1: libops.v1.OwnershipTransfer
+	(*TransferOrganizationOwnershipRequest)(nil),        // 2: libops.v1.TransferOrganizationOwnershipRequest
+	(*TransferOrganizationOwnershipResponse)(nil),       // 3: libops.v1.TransferOrganizationOwnershipResponse
+	(*GetOrganizationOwnershipTransferRequest)(nil),     // 4: libops.v1.GetOrganizationOwnershipTransferRequest
+	(*GetOrganizationOwnershipTransferResponse)(nil),    // 5: libops.v1.GetOrganizationOwnershipTransferResponse
+	(*AcceptOrganizationOwnershipTransferRequest)(nil),  // 6: libops.v1.AcceptOrganizationOwnershipTransferRequest
+	(*AcceptOrganizationOwnershipTransferResponse)(nil), // 7: libops.v1.AcceptOrganizationOwnershipTransferResponse
+	(*CancelOrganizationOwnershipTransferRequest)(nil),  // 8: libops.v1.CancelOrganizationOwnershipTransferRequest
+	(*CancelOrganizationOwnershipTransferResponse)(nil), // 9: libops.v1.CancelOrganizationOwnershipTransferResponse
+}
+var file_libops_v1_ownership_proto_depIdxs = []int32{
+	0, // 0: libops.v1.OwnershipTransfer.status:type_name -> libops.v1.OwnershipTransferStatus
+	1, // 1: libops.v1.TransferOrganizationOwnershipResponse.transfer:type_name -> libops.v1.OwnershipTransfer
+	1, // 2: libops.v1.GetOrganizationOwnershipTransferResponse.transfer:type_name -> libops.v1.OwnershipTransfer
+	1, // 3: libops.v1.AcceptOrganizationOwnershipTransferResponse.transfer:type_name -> libops.v1.OwnershipTransfer
+	1, // 4: libops.v1.CancelOrganizationOwnershipTransferResponse.transfer:type_name -> libops.v1.OwnershipTransfer
+	2, // 5: libops.v1.OwnershipService.TransferOrganizationOwnership:input_type -> libops.v1.TransferOrganizationOwnershipRequest
+	4, // 6: libops.v1.OwnershipService.GetOrganizationOwnershipTransfer:input_type -> libops.v1.GetOrganizationOwnershipTransferRequest
+	6, // 7: libops.v1.OwnershipService.AcceptOrganizationOwnershipTransfer:input_type -> libops.v1.AcceptOrganizationOwnershipTransferRequest
+	8, // 8: libops.v1.OwnershipService.CancelOrganizationOwnershipTransfer:input_type -> libops.v1.CancelOrganizationOwnershipTransferRequest
+	3, // 9: libops.v1.OwnershipService.TransferOrganizationOwnership:output_type -> libops.v1.TransferOrganizationOwnershipResponse
+	5, // 10: libops.v1.OwnershipService.GetOrganizationOwnershipTransfer:output_type -> libops.v1.GetOrganizationOwnershipTransferResponse
+	7, // 11: libops.v1.OwnershipService.AcceptOrganizationOwnershipTransfer:output_type -> libops.v1.AcceptOrganizationOwnershipTransferResponse
+	9, // 12: libops.v1.OwnershipService.CancelOrganizationOwnershipTransfer:output_type -> libops.v1.CancelOrganizationOwnershipTransferResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_ownership_proto_init() }
+func file_libops_v1_ownership_proto_init() {
+	if File_libops_v1_ownership_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_ownership_proto_rawDesc), len(file_libops_v1_ownership_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_ownership_proto_goTypes,
+		DependencyIndexes: file_libops_v1_ownership_proto_depIdxs,
+		EnumInfos:         file_libops_v1_ownership_proto_enumTypes,
+		MessageInfos:      file_libops_v1_ownership_proto_msgTypes,
+	}.Build()
+	File_libops_v1_ownership_proto = out.File
+	file_libops_v1_ownership_proto_goTypes = nil
+	file_libops_v1_ownership_proto_depIdxs = nil
+}
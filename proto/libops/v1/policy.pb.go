@@ -0,0 +1,1081 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/policy.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PolicyRule int32
+
+const (
+	PolicyRule_POLICY_RULE_UNSPECIFIED PolicyRule = 0
+	// Only site owners can create, change or delete a production site's secrets
+	PolicyRule_POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY PolicyRule = 1
+	// Firewall rules can't allow SSH from every address (0.0.0.0/0 or ::/0)
+	PolicyRule_POLICY_RULE_NO_PUBLIC_SSH PolicyRule = 2
+	// Production sites only deploy within the policy's deploy window
+	PolicyRule_POLICY_RULE_PRODUCTION_DEPLOY_WINDOW PolicyRule = 3
+	// Only service accounts can create API keys that can make changes. Everyone
+	// else's keys are limited to read scopes.
+	PolicyRule_POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS PolicyRule = 4
+)
+
+// Enum value maps for PolicyRule.
+var (
+	PolicyRule_name = map[int32]string{
+		0: "POLICY_RULE_UNSPECIFIED",
+		1: "POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY",
+		2: "POLICY_RULE_NO_PUBLIC_SSH",
+		3: "POLICY_RULE_PRODUCTION_DEPLOY_WINDOW",
+		4: "POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS",
+	}
+	PolicyRule_value = map[string]int32{
+		"POLICY_RULE_UNSPECIFIED":                   0,
+		"POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY": 1,
+		"POLICY_RULE_NO_PUBLIC_SSH":                 2,
+		"POLICY_RULE_PRODUCTION_DEPLOY_WINDOW":      3,
+		"POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS":    4,
+	}
+)
+
+func (x PolicyRule) Enum() *PolicyRule {
+	p := new(PolicyRule)
+	*p = x
+	return p
+}
+
+func (x PolicyRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyRule) Type() protoreflect.EnumType {
+	return &file_libops_v1_policy_proto_enumTypes[0]
+}
+
+func (x PolicyRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyRule.Descriptor instead.
+func (PolicyRule) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{0}
+}
+
+type PolicyEnforcement int32
+
+const (
+	PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED PolicyEnforcement = 0
+	PolicyEnforcement_POLICY_ENFORCEMENT_ENFORCE     PolicyEnforcement = 1 // Reject changes that break the policy
+	PolicyEnforcement_POLICY_ENFORCEMENT_AUDIT       PolicyEnforcement = 2 // Let them through, but record the violation
+)
+
+// Enum value maps for PolicyEnforcement.
+var (
+	PolicyEnforcement_name = map[int32]string{
+		0: "POLICY_ENFORCEMENT_UNSPECIFIED",
+		1: "POLICY_ENFORCEMENT_ENFORCE",
+		2: "POLICY_ENFORCEMENT_AUDIT",
+	}
+	PolicyEnforcement_value = map[string]int32{
+		"POLICY_ENFORCEMENT_UNSPECIFIED": 0,
+		"POLICY_ENFORCEMENT_ENFORCE":     1,
+		"POLICY_ENFORCEMENT_AUDIT":       2,
+	}
+)
+
+func (x PolicyEnforcement) Enum() *PolicyEnforcement {
+	p := new(PolicyEnforcement)
+	*p = x
+	return p
+}
+
+func (x PolicyEnforcement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyEnforcement) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_policy_proto_enumTypes[1].Descriptor()
+}
+
+func (PolicyEnforcement) Type() protoreflect.EnumType {
+	return &file_libops_v1_policy_proto_enumTypes[1]
+}
+
+func (x PolicyEnforcement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyEnforcement.Descriptor instead.
+func (PolicyEnforcement) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{1}
+}
+
+// DeployWindow is when production sites can deploy
+type DeployWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercase weekdays, e.g. "monday"; empty means Monday to Friday
+	Days          []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	StartHour     int32    `protobuf:"varint,2,opt,name=start_hour,json=startHour,proto3" json:"start_hour,omitempty"` // First hour deploys are allowed, 0-23
+	EndHour       int32    `protobuf:"varint,3,opt,name=end_hour,json=endHour,proto3" json:"end_hour,omitempty"`       // Hour deploys stop being allowed, 1-24; 0 means 24
+	Timezone      string   `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                     // IANA time zone, e.g. "America/New_York"; defaults to UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployWindow) Reset() {
+	*x = DeployWindow{}
+	mi := &file_libops_v1_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployWindow) ProtoMessage() {}
+
+func (x *DeployWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployWindow.ProtoReflect.Descriptor instead.
+func (*DeployWindow) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeployWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *DeployWindow) GetStartHour() int32 {
+	if x != nil {
+		return x.StartHour
+	}
+	return 0
+}
+
+func (x *DeployWindow) GetEndHour() int32 {
+	if x != nil {
+		return x.EndHour
+	}
+	return 0
+}
+
+func (x *DeployWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Policy is a rule an organization's changes are checked against
+type Policy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId       string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Rule           PolicyRule             `protobuf:"varint,3,opt,name=rule,proto3,enum=libops.v1.PolicyRule" json:"rule,omitempty"`
+	Enforcement    PolicyEnforcement      `protobuf:"varint,4,opt,name=enforcement,proto3,enum=libops.v1.PolicyEnforcement" json:"enforcement,omitempty"`
+	DeployWindow   *DeployWindow          `protobuf:"bytes,5,opt,name=deploy_window,json=deployWindow,proto3" json:"deploy_window,omitempty"` // Set for POLICY_RULE_PRODUCTION_DEPLOY_WINDOW
+	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix timestamp
+	UpdatedAt      int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_libops_v1_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Policy) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *Policy) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Policy) GetRule() PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return PolicyRule_POLICY_RULE_UNSPECIFIED
+}
+
+func (x *Policy) GetEnforcement() PolicyEnforcement {
+	if x != nil {
+		return x.Enforcement
+	}
+	return PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED
+}
+
+func (x *Policy) GetDeployWindow() *DeployWindow {
+	if x != nil {
+		return x.DeployWindow
+	}
+	return nil
+}
+
+func (x *Policy) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Policy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// PolicyViolation is a change that broke a policy
+type PolicyViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViolationId   string                 `protobuf:"bytes,1,opt,name=violation_id,json=violationId,proto3" json:"violation_id,omitempty"`
+	PolicyId      string                 `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"` // Empty once the policy is deleted
+	Rule          PolicyRule             `protobuf:"varint,3,opt,name=rule,proto3,enum=libops.v1.PolicyRule" json:"rule,omitempty"`
+	AccountEmail  string                 `protobuf:"bytes,4,opt,name=account_email,json=accountEmail,proto3" json:"account_email,omitempty"` // Who made the change
+	Procedure     string                 `protobuf:"bytes,5,opt,name=procedure,proto3" json:"procedure,omitempty"`                           // The RPC, e.g. "/libops.v1.SiteSecretService/CreateSiteSecret"
+	ResourceId    string                 `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`       // UUID of the organization, project or site being changed
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Blocked       bool                   `protobuf:"varint,8,opt,name=blocked,proto3" json:"blocked,omitempty"`                      // Whether the change was rejected
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyViolation) Reset() {
+	*x = PolicyViolation{}
+	mi := &file_libops_v1_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyViolation) ProtoMessage() {}
+
+func (x *PolicyViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyViolation.ProtoReflect.Descriptor instead.
+func (*PolicyViolation) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PolicyViolation) GetViolationId() string {
+	if x != nil {
+		return x.ViolationId
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetRule() PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return PolicyRule_POLICY_RULE_UNSPECIFIED
+}
+
+func (x *PolicyViolation) GetAccountEmail() string {
+	if x != nil {
+		return x.AccountEmail
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicyViolation) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *PolicyViolation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreatePolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Rule           PolicyRule             `protobuf:"varint,2,opt,name=rule,proto3,enum=libops.v1.PolicyRule" json:"rule,omitempty"`
+	Enforcement    PolicyEnforcement      `protobuf:"varint,3,opt,name=enforcement,proto3,enum=libops.v1.PolicyEnforcement" json:"enforcement,omitempty"` // Defaults to POLICY_ENFORCEMENT_ENFORCE
+	DeployWindow   *DeployWindow          `protobuf:"bytes,4,opt,name=deploy_window,json=deployWindow,proto3" json:"deploy_window,omitempty"`             // Required for POLICY_RULE_PRODUCTION_DEPLOY_WINDOW
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePolicyRequest) Reset() {
+	*x = CreatePolicyRequest{}
+	mi := &file_libops_v1_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePolicyRequest) ProtoMessage() {}
+
+func (x *CreatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreatePolicyRequest) GetRule() PolicyRule {
+	if x != nil {
+		return x.Rule
+	}
+	return PolicyRule_POLICY_RULE_UNSPECIFIED
+}
+
+func (x *CreatePolicyRequest) GetEnforcement() PolicyEnforcement {
+	if x != nil {
+		return x.Enforcement
+	}
+	return PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED
+}
+
+func (x *CreatePolicyRequest) GetDeployWindow() *DeployWindow {
+	if x != nil {
+		return x.DeployWindow
+	}
+	return nil
+}
+
+type CreatePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePolicyResponse) Reset() {
+	*x = CreatePolicyResponse{}
+	mi := &file_libops_v1_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePolicyResponse) ProtoMessage() {}
+
+func (x *CreatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*CreatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePolicyResponse) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ListPoliciesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPoliciesRequest) Reset() {
+	*x = ListPoliciesRequest{}
+	mi := &file_libops_v1_policy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesRequest) ProtoMessage() {}
+
+func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPoliciesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListPoliciesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPoliciesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesResponse) Reset() {
+	*x = ListPoliciesResponse{}
+	mi := &file_libops_v1_policy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesResponse) ProtoMessage() {}
+
+func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ListPoliciesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdatePolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PolicyId       string                 `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Enforcement    PolicyEnforcement      `protobuf:"varint,3,opt,name=enforcement,proto3,enum=libops.v1.PolicyEnforcement" json:"enforcement,omitempty"` // Unspecified keeps the current enforcement
+	DeployWindow   *DeployWindow          `protobuf:"bytes,4,opt,name=deploy_window,json=deployWindow,proto3" json:"deploy_window,omitempty"`             // Replaces the deploy window when set
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePolicyRequest) Reset() {
+	*x = UpdatePolicyRequest{}
+	mi := &file_libops_v1_policy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdatePolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *UpdatePolicyRequest) GetEnforcement() PolicyEnforcement {
+	if x != nil {
+		return x.Enforcement
+	}
+	return PolicyEnforcement_POLICY_ENFORCEMENT_UNSPECIFIED
+}
+
+func (x *UpdatePolicyRequest) GetDeployWindow() *DeployWindow {
+	if x != nil {
+		return x.DeployWindow
+	}
+	return nil
+}
+
+type UpdatePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *Policy                `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePolicyResponse) Reset() {
+	*x = UpdatePolicyResponse{}
+	mi := &file_libops_v1_policy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePolicyResponse) ProtoMessage() {}
+
+func (x *UpdatePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePolicyResponse) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeletePolicyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PolicyId       string                 `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeletePolicyRequest) Reset() {
+	*x = DeletePolicyRequest{}
+	mi := &file_libops_v1_policy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyRequest) ProtoMessage() {}
+
+func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePolicyRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeletePolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type ListPolicyViolationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPolicyViolationsRequest) Reset() {
+	*x = ListPolicyViolationsRequest{}
+	mi := &file_libops_v1_policy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPolicyViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPolicyViolationsRequest) ProtoMessage() {}
+
+func (x *ListPolicyViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPolicyViolationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPolicyViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPolicyViolationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListPolicyViolationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPolicyViolationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPolicyViolationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Violations    []*PolicyViolation     `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPolicyViolationsResponse) Reset() {
+	*x = ListPolicyViolationsResponse{}
+	mi := &file_libops_v1_policy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPolicyViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPolicyViolationsResponse) ProtoMessage() {}
+
+func (x *ListPolicyViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_policy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPolicyViolationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPolicyViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_policy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPolicyViolationsResponse) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ListPolicyViolationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_libops_v1_policy_proto protoreflect.FileDescriptor
+
+const file_libops_v1_policy_proto_rawDesc = "" +
+	"\n" +
+	"\x16libops/v1/policy.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"x\n" +
+	"\fDeployWindow\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\tR\x04days\x12\x1d\n" +
+	"\n" +
+	"start_hour\x18\x02 \x01(\x05R\tstartHour\x12\x19\n" +
+	"\bend_hour\x18\x03 \x01(\x05R\aendHour\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\xb5\x02\n" +
+	"\x06Policy\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x04rule\x18\x03 \x01(\x0e2\x15.libops.v1.PolicyRuleR\x04rule\x12>\n" +
+	"\venforcement\x18\x04 \x01(\x0e2\x1c.libops.v1.PolicyEnforcementR\venforcement\x12<\n" +
+	"\rdeploy_window\x18\x05 \x01(\v2\x17.libops.v1.DeployWindowR\fdeployWindow\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\xb3\x02\n" +
+	"\x0fPolicyViolation\x12!\n" +
+	"\fviolation_id\x18\x01 \x01(\tR\vviolationId\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\x12)\n" +
+	"\x04rule\x18\x03 \x01(\x0e2\x15.libops.v1.PolicyRuleR\x04rule\x12#\n" +
+	"\raccount_email\x18\x04 \x01(\tR\faccountEmail\x12\x1c\n" +
+	"\tprocedure\x18\x05 \x01(\tR\tprocedure\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x18\n" +
+	"\ablocked\x18\b \x01(\bR\ablocked\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\xe7\x01\n" +
+	"\x13CreatePolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x04rule\x18\x02 \x01(\x0e2\x15.libops.v1.PolicyRuleR\x04rule\x12>\n" +
+	"\venforcement\x18\x03 \x01(\x0e2\x1c.libops.v1.PolicyEnforcementR\venforcement\x12<\n" +
+	"\rdeploy_window\x18\x04 \x01(\v2\x17.libops.v1.DeployWindowR\fdeployWindow\"A\n" +
+	"\x14CreatePolicyResponse\x12)\n" +
+	"\x06policy\x18\x01 \x01(\v2\x11.libops.v1.PolicyR\x06policy\"z\n" +
+	"\x13ListPoliciesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"m\n" +
+	"\x14ListPoliciesResponse\x12-\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x11.libops.v1.PolicyR\bpolicies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd9\x01\n" +
+	"\x13UpdatePolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\x12>\n" +
+	"\venforcement\x18\x03 \x01(\x0e2\x1c.libops.v1.PolicyEnforcementR\venforcement\x12<\n" +
+	"\rdeploy_window\x18\x04 \x01(\v2\x17.libops.v1.DeployWindowR\fdeployWindow\"A\n" +
+	"\x14UpdatePolicyResponse\x12)\n" +
+	"\x06policy\x18\x01 \x01(\v2\x11.libops.v1.PolicyR\x06policy\"[\n" +
+	"\x13DeletePolicyRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpolicy_id\x18\x02 \x01(\tR\bpolicyId\"\x82\x01\n" +
+	"\x1bListPolicyViolationsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1cListPolicyViolationsResponse\x12:\n" +
+	"\n" +
+	"violations\x18\x01 \x03(\v2\x1a.libops.v1.PolicyViolationR\n" +
+	"violations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*\xcd\x01\n" +
+	"\n" +
+	"PolicyRule\x12\x1b\n" +
+	"\x17POLICY_RULE_UNSPECIFIED\x10\x00\x12-\n" +
+	")POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY\x10\x01\x12\x1d\n" +
+	"\x19POLICY_RULE_NO_PUBLIC_SSH\x10\x02\x12(\n" +
+	"$POLICY_RULE_PRODUCTION_DEPLOY_WINDOW\x10\x03\x12*\n" +
+	"&POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS\x10\x04*u\n" +
+	"\x11PolicyEnforcement\x12\"\n" +
+	"\x1ePOLICY_ENFORCEMENT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPOLICY_ENFORCEMENT_ENFORCE\x10\x01\x12\x1c\n" +
+	"\x18POLICY_ENFORCEMENT_AUDIT\x10\x022\xd6\a\n" +
+	"\rPolicyService\x12\xb5\x01\n" +
+	"\fCreatePolicy\x12\x1e.libops.v1.CreatePolicyRequest\x1a\x1f.libops.v1.CreatePolicyResponse\"d\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x021:\x01*\",/v1/organizations/{organization_id}/policies\x12\xb6\x01\n" +
+	"\fListPolicies\x12\x1e.libops.v1.ListPoliciesRequest\x1a\x1f.libops.v1.ListPoliciesResponse\"e\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_id}/policies\x90\x02\x01\x12\xc1\x01\n" +
+	"\fUpdatePolicy\x12\x1e.libops.v1.UpdatePolicyRequest\x1a\x1f.libops.v1.UpdatePolicyResponse\"p\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02=:\x01*28/v1/organizations/{organization_id}/policies/{policy_id}\x12\xb5\x01\n" +
+	"\fDeletePolicy\x12\x1e.libops.v1.DeletePolicyRequest\x1a\x16.google.protobuf.Empty\"m\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02:*8/v1/organizations/{organization_id}/policies/{policy_id}\x12\xd7\x01\n" +
+	"\x14ListPolicyViolations\x12&.libops.v1.ListPolicyViolationsRequest\x1a'.libops.v1.ListPolicyViolationsResponse\"n\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x027\x125/v1/organizations/{organization_id}/policy-violations\x90\x02\x01B\x91\x01\n" +
+	"\rcom.libops.v1B\vPolicyProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_policy_proto_rawDescOnce sync.Once
+	file_libops_v1_policy_proto_rawDescData []byte
+)
+
+func file_libops_v1_policy_proto_rawDescGZIP() []byte {
+	file_libops_v1_policy_proto_rawDescOnce.Do(func() {
+		file_libops_v1_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_policy_proto_rawDesc), len(file_libops_v1_policy_proto_rawDesc)))
+	})
+	return file_libops_v1_policy_proto_rawDescData
+}
+
+var file_libops_v1_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_libops_v1_policy_proto_goTypes = []any{
+	(PolicyRule)(0),                      // 0: libops.v1.PolicyRule
+	(PolicyEnforcement)(0),               // 1: libops.v1.PolicyEnforcement
+	(*DeployWindow)(nil),                 // 2: libops.v1.DeployWindow
+	(*Policy)(nil),                       // 3: libops.v1.Policy
+	(*PolicyViolation)(nil),              // 4: libops.v1.PolicyViolation
+	(*CreatePolicyRequest)(nil),          // 5: libops.v1.CreatePolicyRequest
+	(*CreatePolicyResponse)(nil),         // 6: libops.v1.CreatePolicyResponse
+	(*ListPoliciesRequest)(nil),          // 7: libops.v1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),         // 8: libops.v1.ListPoliciesResponse
+	(*UpdatePolicyRequest)(nil),          // 9: libops.v1.UpdatePolicyRequest
+	(*UpdatePolicyResponse)(nil),         // 10: libops.v1.UpdatePolicyResponse
+	(*DeletePolicyRequest)(nil),          // 11: libops.v1.DeletePolicyRequest
+	(*ListPolicyViolationsRequest)(nil),  // 12: libops.v1.ListPolicyViolationsRequest
+	(*ListPolicyViolationsResponse)(nil), // 13: libops.v1.ListPolicyViolationsResponse
+	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
+}
+var file_libops_v1_policy_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.Policy.rule:type_name -> libops.v1.PolicyRule
+	1,  // 1: libops.v1.Policy.enforcement:type_name -> libops.v1.PolicyEnforcement
+	2,  // 2: libops.v1.Policy.deploy_window:type_name -> libops.v1.DeployWindow
+	0,  // 3: libops.v1.PolicyViolation.rule:type_name -> libops.v1.PolicyRule
+	0,  // 4: libops.v1.CreatePolicyRequest.rule:type_name -> libops.v1.PolicyRule
+	1,  // 5: libops.v1.CreatePolicyRequest.enforcement:type_name -> libops.v1.PolicyEnforcement
+	2,  // 6: libops.v1.CreatePolicyRequest.deploy_window:type_name -> libops.v1.DeployWindow
+	3,  // 7: libops.v1.CreatePolicyResponse.policy:type_name -> libops.v1.Policy
+	3,  // 8: libops.v1.ListPoliciesResponse.policies:type_name -> libops.v1.Policy
+	1,  // 9: libops.v1.UpdatePolicyRequest.enforcement:type_name -> libops.v1.PolicyEnforcement
+	2,  // 10: libops.v1.UpdatePolicyRequest.deploy_window:type_name -> libops.v1.DeployWindow
+	3,  // 11: libops.v1.UpdatePolicyResponse.policy:type_name -> libops.v1.Policy
+	4,  // 12: libops.v1.ListPolicyViolationsResponse.violations:type_name -> libops.v1.PolicyViolation
+	5,  // 13: libops.v1.PolicyService.CreatePolicy:input_type -> libops.v1.CreatePolicyRequest
+	7,  // 14: libops.v1.PolicyService.ListPolicies:input_type -> libops.v1.ListPoliciesRequest
+	9,  // 15: libops.v1.PolicyService.UpdatePolicy:input_type -> libops.v1.UpdatePolicyRequest
+	11, // 16: libops.v1.PolicyService.DeletePolicy:input_type -> libops.v1.DeletePolicyRequest
+	12, // 17: libops.v1.PolicyService.ListPolicyViolations:input_type -> libops.v1.ListPolicyViolationsRequest
+	6,  // 18: libops.v1.PolicyService.CreatePolicy:output_type -> libops.v1.CreatePolicyResponse
+	8,  // 19: libops.v1.PolicyService.ListPolicies:output_type -> libops.v1.ListPoliciesResponse
+	10, // 20: libops.v1.PolicyService.UpdatePolicy:output_type -> libops.v1.UpdatePolicyResponse
+	14, // 21: libops.v1.PolicyService.DeletePolicy:output_type -> google.protobuf.Empty
+	13, // 22: libops.v1.PolicyService.ListPolicyViolations:output_type -> libops.v1.ListPolicyViolationsResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_policy_proto_init() }
+func file_libops_v1_policy_proto_init() {
+	if File_libops_v1_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_policy_proto_rawDesc), len(file_libops_v1_policy_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_policy_proto_goTypes,
+		DependencyIndexes: file_libops_v1_policy_proto_depIdxs,
+		EnumInfos:         file_libops_v1_policy_proto_enumTypes,
+		MessageInfos:      file_libops_v1_policy_proto_msgTypes,
+	}.Build()
+	File_libops_v1_policy_proto = out.File
+	file_libops_v1_policy_proto_goTypes = nil
+	file_libops_v1_policy_proto_depIdxs = nil
+}
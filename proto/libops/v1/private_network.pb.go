@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/private_network.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The LibOps service an endpoint connects to
+type PrivateServiceConnectTarget int32
+
+const (
+	PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED  PrivateServiceConnectTarget = 0
+	PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_API          PrivateServiceConnectTarget = 1 // The LibOps API, used by terraform runners and site controllers
+	PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR PrivateServiceConnectTarget = 2 // The orchestrator the organization VM connects to
+	PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY   PrivateServiceConnectTarget = 3 // The relay sites' outbound mail is redirected to
+)
+
+// Enum value maps for PrivateServiceConnectTarget.
+var (
+	PrivateServiceConnectTarget_name = map[int32]string{
+		0: "PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED",
+		1: "PRIVATE_SERVICE_CONNECT_TARGET_API",
+		2: "PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR",
+		3: "PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY",
+	}
+	PrivateServiceConnectTarget_value = map[string]int32{
+		"PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED":  0,
+		"PRIVATE_SERVICE_CONNECT_TARGET_API":          1,
+		"PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR": 2,
+		"PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY":   3,
+	}
+)
+
+func (x PrivateServiceConnectTarget) Enum() *PrivateServiceConnectTarget {
+	p := new(PrivateServiceConnectTarget)
+	*p = x
+	return p
+}
+
+func (x PrivateServiceConnectTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivateServiceConnectTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_private_network_proto_enumTypes[0].Descriptor()
+}
+
+func (PrivateServiceConnectTarget) Type() protoreflect.EnumType {
+	return &file_libops_v1_private_network_proto_enumTypes[0]
+}
+
+func (x PrivateServiceConnectTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrivateServiceConnectTarget.Descriptor instead.
+func (PrivateServiceConnectTarget) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{0}
+}
+
+type PrivateServiceConnectEndpointStatus int32
+
+const (
+	PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED  PrivateServiceConnectEndpointStatus = 0
+	PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING PrivateServiceConnectEndpointStatus = 1 // Waiting for a reconciliation to create it
+	PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE       PrivateServiceConnectEndpointStatus = 2
+	PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING     PrivateServiceConnectEndpointStatus = 3 // Waiting for a reconciliation to remove it
+)
+
+// Enum value maps for PrivateServiceConnectEndpointStatus.
+var (
+	PrivateServiceConnectEndpointStatus_name = map[int32]string{
+		0: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED",
+		1: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING",
+		2: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE",
+		3: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING",
+	}
+	PrivateServiceConnectEndpointStatus_value = map[string]int32{
+		"PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED":  0,
+		"PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING": 1,
+		"PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE":       2,
+		"PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING":     3,
+	}
+)
+
+func (x PrivateServiceConnectEndpointStatus) Enum() *PrivateServiceConnectEndpointStatus {
+	p := new(PrivateServiceConnectEndpointStatus)
+	*p = x
+	return p
+}
+
+func (x PrivateServiceConnectEndpointStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivateServiceConnectEndpointStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_private_network_proto_enumTypes[1].Descriptor()
+}
+
+func (PrivateServiceConnectEndpointStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_private_network_proto_enumTypes[1]
+}
+
+func (x PrivateServiceConnectEndpointStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrivateServiceConnectEndpointStatus.Descriptor instead.
+func (PrivateServiceConnectEndpointStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{1}
+}
+
+type PrivateServiceConnectEndpoint struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	EndpointId     string                      `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`             // UUID
+	OrganizationId string                      `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	Target         PrivateServiceConnectTarget `protobuf:"varint,3,opt,name=target,proto3,enum=libops.v1.PrivateServiceConnectTarget" json:"target,omitempty"`
+	// Address asked for at creation, empty to let the reconciliation pick one
+	RequestedIpAddress string `protobuf:"bytes,4,opt,name=requested_ip_address,json=requestedIpAddress,proto3" json:"requested_ip_address,omitempty"`
+	// Address the endpoint has, empty until it's active
+	IpAddress string `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Name of the forwarding rule in the organization's GCP project
+	ForwardingRule string                              `protobuf:"bytes,6,opt,name=forwarding_rule,json=forwardingRule,proto3" json:"forwarding_rule,omitempty"`
+	Status         PrivateServiceConnectEndpointStatus `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.PrivateServiceConnectEndpointStatus" json:"status,omitempty"`
+	CreatedAt      int64                               `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt      int64                               `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PrivateServiceConnectEndpoint) Reset() {
+	*x = PrivateServiceConnectEndpoint{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateServiceConnectEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateServiceConnectEndpoint) ProtoMessage() {}
+
+func (x *PrivateServiceConnectEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateServiceConnectEndpoint.ProtoReflect.Descriptor instead.
+func (*PrivateServiceConnectEndpoint) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PrivateServiceConnectEndpoint) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *PrivateServiceConnectEndpoint) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PrivateServiceConnectEndpoint) GetTarget() PrivateServiceConnectTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED
+}
+
+func (x *PrivateServiceConnectEndpoint) GetRequestedIpAddress() string {
+	if x != nil {
+		return x.RequestedIpAddress
+	}
+	return ""
+}
+
+func (x *PrivateServiceConnectEndpoint) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *PrivateServiceConnectEndpoint) GetForwardingRule() string {
+	if x != nil {
+		return x.ForwardingRule
+	}
+	return ""
+}
+
+func (x *PrivateServiceConnectEndpoint) GetStatus() PrivateServiceConnectEndpointStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PrivateServiceConnectEndpointStatus_PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED
+}
+
+func (x *PrivateServiceConnectEndpoint) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PrivateServiceConnectEndpoint) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListPrivateServiceConnectEndpointsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPrivateServiceConnectEndpointsRequest) Reset() {
+	*x = ListPrivateServiceConnectEndpointsRequest{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrivateServiceConnectEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrivateServiceConnectEndpointsRequest) ProtoMessage() {}
+
+func (x *ListPrivateServiceConnectEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrivateServiceConnectEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListPrivateServiceConnectEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPrivateServiceConnectEndpointsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListPrivateServiceConnectEndpointsResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Endpoints     []*PrivateServiceConnectEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrivateServiceConnectEndpointsResponse) Reset() {
+	*x = ListPrivateServiceConnectEndpointsResponse{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrivateServiceConnectEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrivateServiceConnectEndpointsResponse) ProtoMessage() {}
+
+func (x *ListPrivateServiceConnectEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrivateServiceConnectEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListPrivateServiceConnectEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPrivateServiceConnectEndpointsResponse) GetEndpoints() []*PrivateServiceConnectEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type CreatePrivateServiceConnectEndpointRequest struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	OrganizationId string                      `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Target         PrivateServiceConnectTarget `protobuf:"varint,2,opt,name=target,proto3,enum=libops.v1.PrivateServiceConnectTarget" json:"target,omitempty"`
+	// Internal address to reserve in the organization's subnet (10.128.0.0/20).
+	// Leave empty to have one assigned.
+	IpAddress     string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) Reset() {
+	*x = CreatePrivateServiceConnectEndpointRequest{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivateServiceConnectEndpointRequest) ProtoMessage() {}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivateServiceConnectEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrivateServiceConnectEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) GetTarget() PrivateServiceConnectTarget {
+	if x != nil {
+		return x.Target
+	}
+	return PrivateServiceConnectTarget_PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED
+}
+
+func (x *CreatePrivateServiceConnectEndpointRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type CreatePrivateServiceConnectEndpointResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Endpoint      *PrivateServiceConnectEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrivateServiceConnectEndpointResponse) Reset() {
+	*x = CreatePrivateServiceConnectEndpointResponse{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrivateServiceConnectEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivateServiceConnectEndpointResponse) ProtoMessage() {}
+
+func (x *CreatePrivateServiceConnectEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivateServiceConnectEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreatePrivateServiceConnectEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePrivateServiceConnectEndpointResponse) GetEndpoint() *PrivateServiceConnectEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+type DeletePrivateServiceConnectEndpointRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	EndpointId     string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeletePrivateServiceConnectEndpointRequest) Reset() {
+	*x = DeletePrivateServiceConnectEndpointRequest{}
+	mi := &file_libops_v1_private_network_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePrivateServiceConnectEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrivateServiceConnectEndpointRequest) ProtoMessage() {}
+
+func (x *DeletePrivateServiceConnectEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_private_network_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrivateServiceConnectEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DeletePrivateServiceConnectEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_private_network_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePrivateServiceConnectEndpointRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeletePrivateServiceConnectEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+var File_libops_v1_private_network_proto protoreflect.FileDescriptor
+
+const file_libops_v1_private_network_proto_rawDesc = "" +
+	"\n" +
+	"\x1flibops/v1/private_network.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\xa9\x03\n" +
+	"\x1dPrivateServiceConnectEndpoint\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12>\n" +
+	"\x06target\x18\x03 \x01(\x0e2&.libops.v1.PrivateServiceConnectTargetR\x06target\x120\n" +
+	"\x14requested_ip_address\x18\x04 \x01(\tR\x12requestedIpAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12'\n" +
+	"\x0fforwarding_rule\x18\x06 \x01(\tR\x0eforwardingRule\x12F\n" +
+	"\x06status\x18\a \x01(\x0e2..libops.v1.PrivateServiceConnectEndpointStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"T\n" +
+	")ListPrivateServiceConnectEndpointsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"t\n" +
+	"*ListPrivateServiceConnectEndpointsResponse\x12F\n" +
+	"\tendpoints\x18\x01 \x03(\v2(.libops.v1.PrivateServiceConnectEndpointR\tendpoints\"\xb4\x01\n" +
+	"*CreatePrivateServiceConnectEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12>\n" +
+	"\x06target\x18\x02 \x01(\x0e2&.libops.v1.PrivateServiceConnectTargetR\x06target\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\"s\n" +
+	"+CreatePrivateServiceConnectEndpointResponse\x12D\n" +
+	"\bendpoint\x18\x01 \x01(\v2(.libops.v1.PrivateServiceConnectEndpointR\bendpoint\"v\n" +
+	"*DeletePrivateServiceConnectEndpointRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId*\xd5\x01\n" +
+	"\x1bPrivateServiceConnectTarget\x12.\n" +
+	"*PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"PRIVATE_SERVICE_CONNECT_TARGET_API\x10\x01\x12/\n" +
+	"+PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR\x10\x02\x12-\n" +
+	")PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY\x10\x03*\x82\x02\n" +
+	"#PrivateServiceConnectEndpointStatus\x127\n" +
+	"3PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED\x10\x00\x128\n" +
+	"4PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING\x10\x01\x122\n" +
+	".PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE\x10\x02\x124\n" +
+	"0PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING\x10\x032\xbe\x06\n" +
+	"\x15PrivateNetworkService\x12\x8e\x02\n" +
+	"\"ListPrivateServiceConnectEndpoints\x124.libops.v1.ListPrivateServiceConnectEndpointsRequest\x1a5.libops.v1.ListPrivateServiceConnectEndpointsResponse\"{\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x02D\x12B/v1/organizations/{organization_id}/privateServiceConnectEndpoints\x90\x02\x01\x12\x92\x02\n" +
+	"#CreatePrivateServiceConnectEndpoint\x125.libops.v1.CreatePrivateServiceConnectEndpointRequest\x1a6.libops.v1.CreatePrivateServiceConnectEndpointResponse\"|\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02G:\x01*\"B/v1/organizations/{organization_id}/privateServiceConnectEndpoints\x12\xfe\x01\n" +
+	"#DeletePrivateServiceConnectEndpoint\x125.libops.v1.DeletePrivateServiceConnectEndpointRequest\x1a\x16.google.protobuf.Empty\"\x87\x01\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02R*P/v1/organizations/{organization_id}/privateServiceConnectEndpoints/{endpoint_id}B\x99\x01\n" +
+	"\rcom.libops.v1B\x13PrivateNetworkProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_private_network_proto_rawDescOnce sync.Once
+	file_libops_v1_private_network_proto_rawDescData []byte
+)
+
+func file_libops_v1_private_network_proto_rawDescGZIP() []byte {
+	file_libops_v1_private_network_proto_rawDescOnce.Do(func() {
+		file_libops_v1_private_network_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_private_network_proto_rawDesc), len(file_libops_v1_private_network_proto_rawDesc)))
+	})
+	return file_libops_v1_private_network_proto_rawDescData
+}
+
+var file_libops_v1_private_network_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_libops_v1_private_network_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_libops_v1_private_network_proto_goTypes = []any{
+	(PrivateServiceConnectTarget)(0),                    // 0: libops.v1.PrivateServiceConnectTarget
+	(PrivateServiceConnectEndpointStatus)(0),            // 1: libops.v1.PrivateServiceConnectEndpointStatus
+	(*PrivateServiceConnectEndpoint)(nil),               // 2: libops.v1.PrivateServiceConnectEndpoint
+	(*ListPrivateServiceConnectEndpointsRequest)(nil),   // 3: libops.v1.ListPrivateServiceConnectEndpointsRequest
+	(*ListPrivateServiceConnectEndpointsResponse)(nil),  // 4: libops.v1.ListPrivateServiceConnectEndpointsResponse
+	(*CreatePrivateServiceConnectEndpointRequest)(nil),  // 5: libops.v1.CreatePrivateServiceConnectEndpointRequest
+	(*CreatePrivateServiceConnectEndpointResponse)(nil), // 6: libops.v1.CreatePrivateServiceConnectEndpointResponse
+	(*DeletePrivateServiceConnectEndpointRequest)(nil),  // 7: libops.v1.DeletePrivateServiceConnectEndpointRequest
+	(*emptypb.Empty)(nil),                               // 8: google.protobuf.Empty
+}
+var file_libops_v1_private_network_proto_depIdxs = []int32{
+	0, // 0: libops.v1.PrivateServiceConnectEndpoint.target:type_name -> libops.v1.PrivateServiceConnectTarget
+	1, // 1: libops.v1.PrivateServiceConnectEndpoint.status:type_name -> libops.v1.PrivateServiceConnectEndpointStatus
+	2, // 2: libops.v1.ListPrivateServiceConnectEndpointsResponse.endpoints:type_name -> libops.v1.PrivateServiceConnectEndpoint
+	0, // 3: libops.v1.CreatePrivateServiceConnectEndpointRequest.target:type_name -> libops.v1.PrivateServiceConnectTarget
+	2, // 4: libops.v1.CreatePrivateServiceConnectEndpointResponse.endpoint:type_name -> libops.v1.PrivateServiceConnectEndpoint
+	3, // 5: libops.v1.PrivateNetworkService.ListPrivateServiceConnectEndpoints:input_type -> libops.v1.ListPrivateServiceConnectEndpointsRequest
+	5, // 6: libops.v1.PrivateNetworkService.CreatePrivateServiceConnectEndpoint:input_type -> libops.v1.CreatePrivateServiceConnectEndpointRequest
+	7, // 7: libops.v1.PrivateNetworkService.DeletePrivateServiceConnectEndpoint:input_type -> libops.v1.DeletePrivateServiceConnectEndpointRequest
+	4, // 8: libops.v1.PrivateNetworkService.ListPrivateServiceConnectEndpoints:output_type -> libops.v1.ListPrivateServiceConnectEndpointsResponse
+	6, // 9: libops.v1.PrivateNetworkService.CreatePrivateServiceConnectEndpoint:output_type -> libops.v1.CreatePrivateServiceConnectEndpointResponse
+	8, // 10: libops.v1.PrivateNetworkService.DeletePrivateServiceConnectEndpoint:output_type -> google.protobuf.Empty
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_private_network_proto_init() }
+func file_libops_v1_private_network_proto_init() {
+	if File_libops_v1_private_network_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_private_network_proto_rawDesc), len(file_libops_v1_private_network_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_private_network_proto_goTypes,
+		DependencyIndexes: file_libops_v1_private_network_proto_depIdxs,
+		EnumInfos:         file_libops_v1_private_network_proto_enumTypes,
+		MessageInfos:      file_libops_v1_private_network_proto_msgTypes,
+	}.Build()
+	File_libops_v1_private_network_proto = out.File
+	file_libops_v1_private_network_proto_goTypes = nil
+	file_libops_v1_private_network_proto_depIdxs = nil
+}
@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/redirect.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Redirect sends requests for a host and path somewhere else
+type Redirect struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RedirectId string                 `protobuf:"bytes,1,opt,name=redirect_id,json=redirectId,proto3" json:"redirect_id,omitempty"`
+	SiteId     string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	FromHost   string                 `protobuf:"bytes,3,opt,name=from_host,json=fromHost,proto3" json:"from_host,omitempty"` // Lowercase hostname to match; empty matches every host
+	FromPath   string                 `protobuf:"bytes,4,opt,name=from_path,json=fromPath,proto3" json:"from_path,omitempty"` // Path to match, e.g. "/old"; a trailing * matches every path with that prefix
+	// Path or absolute http(s) URL to send requests to. When from_path ends in
+	// * and to does too, the part of the path the * matched replaces it.
+	To            string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	StatusCode    int32  `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`          // 301, 302, 307 or 308
+	PreserveQuery bool   `protobuf:"varint,7,opt,name=preserve_query,json=preserveQuery,proto3" json:"preserve_query,omitempty"` // Append the request's query string to the target
+	CreatedAt     int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`             // Unix timestamp
+	UpdatedAt     int64  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`             // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Redirect) Reset() {
+	*x = Redirect{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Redirect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redirect) ProtoMessage() {}
+
+func (x *Redirect) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redirect.ProtoReflect.Descriptor instead.
+func (*Redirect) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Redirect) GetRedirectId() string {
+	if x != nil {
+		return x.RedirectId
+	}
+	return ""
+}
+
+func (x *Redirect) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *Redirect) GetFromHost() string {
+	if x != nil {
+		return x.FromHost
+	}
+	return ""
+}
+
+func (x *Redirect) GetFromPath() string {
+	if x != nil {
+		return x.FromPath
+	}
+	return ""
+}
+
+func (x *Redirect) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Redirect) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Redirect) GetPreserveQuery() bool {
+	if x != nil {
+		return x.PreserveQuery
+	}
+	return false
+}
+
+func (x *Redirect) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Redirect) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListRedirectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedirectsRequest) Reset() {
+	*x = ListRedirectsRequest{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedirectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectsRequest) ProtoMessage() {}
+
+func (x *ListRedirectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectsRequest.ProtoReflect.Descriptor instead.
+func (*ListRedirectsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRedirectsRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *ListRedirectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRedirectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRedirectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redirects     []*Redirect            `protobuf:"bytes,1,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRedirectsResponse) Reset() {
+	*x = ListRedirectsResponse{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRedirectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectsResponse) ProtoMessage() {}
+
+func (x *ListRedirectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectsResponse.ProtoReflect.Descriptor instead.
+func (*ListRedirectsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRedirectsResponse) GetRedirects() []*Redirect {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+func (x *ListRedirectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateRedirectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	FromHost      string                 `protobuf:"bytes,2,opt,name=from_host,json=fromHost,proto3" json:"from_host,omitempty"`
+	FromPath      string                 `protobuf:"bytes,3,opt,name=from_path,json=fromPath,proto3" json:"from_path,omitempty"`
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`                // Defaults to 301
+	PreserveQuery *bool                  `protobuf:"varint,6,opt,name=preserve_query,json=preserveQuery,proto3,oneof" json:"preserve_query,omitempty"` // Defaults to true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedirectRequest) Reset() {
+	*x = CreateRedirectRequest{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectRequest) ProtoMessage() {}
+
+func (x *CreateRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectRequest.ProtoReflect.Descriptor instead.
+func (*CreateRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRedirectRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *CreateRedirectRequest) GetFromHost() string {
+	if x != nil {
+		return x.FromHost
+	}
+	return ""
+}
+
+func (x *CreateRedirectRequest) GetFromPath() string {
+	if x != nil {
+		return x.FromPath
+	}
+	return ""
+}
+
+func (x *CreateRedirectRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreateRedirectRequest) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateRedirectRequest) GetPreserveQuery() bool {
+	if x != nil && x.PreserveQuery != nil {
+		return *x.PreserveQuery
+	}
+	return false
+}
+
+type CreateRedirectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redirect      *Redirect              `protobuf:"bytes,1,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRedirectResponse) Reset() {
+	*x = CreateRedirectResponse{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRedirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRedirectResponse) ProtoMessage() {}
+
+func (x *CreateRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRedirectResponse.ProtoReflect.Descriptor instead.
+func (*CreateRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRedirectResponse) GetRedirect() *Redirect {
+	if x != nil {
+		return x.Redirect
+	}
+	return nil
+}
+
+type UpdateRedirectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RedirectId    string                 `protobuf:"bytes,2,opt,name=redirect_id,json=redirectId,proto3" json:"redirect_id,omitempty"`
+	FromHost      *string                `protobuf:"bytes,3,opt,name=from_host,json=fromHost,proto3,oneof" json:"from_host,omitempty"`
+	FromPath      *string                `protobuf:"bytes,4,opt,name=from_path,json=fromPath,proto3,oneof" json:"from_path,omitempty"`
+	To            *string                `protobuf:"bytes,5,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	StatusCode    *int32                 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	PreserveQuery *bool                  `protobuf:"varint,7,opt,name=preserve_query,json=preserveQuery,proto3,oneof" json:"preserve_query,omitempty"`
+	// Paths: from_host, from_path, to, status_code, preserve_query.
+	// Without a mask every set field is applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedirectRequest) Reset() {
+	*x = UpdateRedirectRequest{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRedirectRequest) ProtoMessage() {}
+
+func (x *UpdateRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRedirectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRedirectRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRequest) GetRedirectId() string {
+	if x != nil {
+		return x.RedirectId
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRequest) GetFromHost() string {
+	if x != nil && x.FromHost != nil {
+		return *x.FromHost
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRequest) GetFromPath() string {
+	if x != nil && x.FromPath != nil {
+		return *x.FromPath
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRequest) GetTo() string {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return ""
+}
+
+func (x *UpdateRedirectRequest) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *UpdateRedirectRequest) GetPreserveQuery() bool {
+	if x != nil && x.PreserveQuery != nil {
+		return *x.PreserveQuery
+	}
+	return false
+}
+
+func (x *UpdateRedirectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateRedirectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Redirect      *Redirect              `protobuf:"bytes,1,opt,name=redirect,proto3" json:"redirect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRedirectResponse) Reset() {
+	*x = UpdateRedirectResponse{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRedirectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRedirectResponse) ProtoMessage() {}
+
+func (x *UpdateRedirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRedirectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRedirectResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateRedirectResponse) GetRedirect() *Redirect {
+	if x != nil {
+		return x.Redirect
+	}
+	return nil
+}
+
+type DeleteRedirectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RedirectId    string                 `protobuf:"bytes,2,opt,name=redirect_id,json=redirectId,proto3" json:"redirect_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRedirectRequest) Reset() {
+	*x = DeleteRedirectRequest{}
+	mi := &file_libops_v1_redirect_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRedirectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRedirectRequest) ProtoMessage() {}
+
+func (x *DeleteRedirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_redirect_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRedirectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRedirectRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_redirect_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRedirectRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DeleteRedirectRequest) GetRedirectId() string {
+	if x != nil {
+		return x.RedirectId
+	}
+	return ""
+}
+
+var File_libops_v1_redirect_proto protoreflect.FileDescriptor
+
+const file_libops_v1_redirect_proto_rawDesc = "" +
+	"\n" +
+	"\x18libops/v1/redirect.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/scope.proto\"\x94\x02\n" +
+	"\bRedirect\x12\x1f\n" +
+	"\vredirect_id\x18\x01 \x01(\tR\n" +
+	"redirectId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tfrom_host\x18\x03 \x01(\tR\bfromHost\x12\x1b\n" +
+	"\tfrom_path\x18\x04 \x01(\tR\bfromPath\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x1f\n" +
+	"\vstatus_code\x18\x06 \x01(\x05R\n" +
+	"statusCode\x12%\n" +
+	"\x0epreserve_query\x18\a \x01(\bR\rpreserveQuery\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"k\n" +
+	"\x14ListRedirectsRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"r\n" +
+	"\x15ListRedirectsResponse\x121\n" +
+	"\tredirects\x18\x01 \x03(\v2\x13.libops.v1.RedirectR\tredirects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xda\x01\n" +
+	"\x15CreateRedirectRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tfrom_host\x18\x02 \x01(\tR\bfromHost\x12\x1b\n" +
+	"\tfrom_path\x18\x03 \x01(\tR\bfromPath\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12*\n" +
+	"\x0epreserve_query\x18\x06 \x01(\bH\x00R\rpreserveQuery\x88\x01\x01B\x11\n" +
+	"\x0f_preserve_query\"I\n" +
+	"\x16CreateRedirectResponse\x12/\n" +
+	"\bredirect\x18\x01 \x01(\v2\x13.libops.v1.RedirectR\bredirect\"\xff\x02\n" +
+	"\x15UpdateRedirectRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1f\n" +
+	"\vredirect_id\x18\x02 \x01(\tR\n" +
+	"redirectId\x12 \n" +
+	"\tfrom_host\x18\x03 \x01(\tH\x00R\bfromHost\x88\x01\x01\x12 \n" +
+	"\tfrom_path\x18\x04 \x01(\tH\x01R\bfromPath\x88\x01\x01\x12\x13\n" +
+	"\x02to\x18\x05 \x01(\tH\x02R\x02to\x88\x01\x01\x12$\n" +
+	"\vstatus_code\x18\x06 \x01(\x05H\x03R\n" +
+	"statusCode\x88\x01\x01\x12*\n" +
+	"\x0epreserve_query\x18\a \x01(\bH\x04R\rpreserveQuery\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\b \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\f\n" +
+	"\n" +
+	"_from_hostB\f\n" +
+	"\n" +
+	"_from_pathB\x05\n" +
+	"\x03_toB\x0e\n" +
+	"\f_status_codeB\x11\n" +
+	"\x0f_preserve_query\"I\n" +
+	"\x16UpdateRedirectResponse\x12/\n" +
+	"\bredirect\x18\x01 \x01(\v2\x13.libops.v1.RedirectR\bredirect\"Q\n" +
+	"\x15DeleteRedirectRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1f\n" +
+	"\vredirect_id\x18\x02 \x01(\tR\n" +
+	"redirectId2\x9f\x05\n" +
+	"\x0fRedirectService\x12\x9a\x01\n" +
+	"\rListRedirects\x12\x1f.libops.v1.ListRedirectsRequest\x1a .libops.v1.ListRedirectsResponse\"F\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/sites/{site_id}/redirects\x90\x02\x01\x12\x9e\x01\n" +
+	"\x0eCreateRedirect\x12 .libops.v1.CreateRedirectRequest\x1a!.libops.v1.CreateRedirectResponse\"G\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sites/{site_id}/redirects\x12\xac\x01\n" +
+	"\x0eUpdateRedirect\x12 .libops.v1.UpdateRedirectRequest\x1a!.libops.v1.UpdateRedirectResponse\"U\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x020:\x01*2+/v1/sites/{site_id}/redirects/{redirect_id}\x12\x9e\x01\n" +
+	"\x0eDeleteRedirect\x12 .libops.v1.DeleteRedirectRequest\x1a\x16.google.protobuf.Empty\"R\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02-*+/v1/sites/{site_id}/redirects/{redirect_id}B\x93\x01\n" +
+	"\rcom.libops.v1B\rRedirectProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_redirect_proto_rawDescOnce sync.Once
+	file_libops_v1_redirect_proto_rawDescData []byte
+)
+
+func file_libops_v1_redirect_proto_rawDescGZIP() []byte {
+	file_libops_v1_redirect_proto_rawDescOnce.Do(func() {
+		file_libops_v1_redirect_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_redirect_proto_rawDesc), len(file_libops_v1_redirect_proto_rawDesc)))
+	})
+	return file_libops_v1_redirect_proto_rawDescData
+}
+
+var file_libops_v1_redirect_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_libops_v1_redirect_proto_goTypes = []any{
+	(*Redirect)(nil),               // 0: libops.v1.Redirect
+	(*ListRedirectsRequest)(nil),   // 1: libops.v1.ListRedirectsRequest
+	(*ListRedirectsResponse)(nil),  // 2: libops.v1.ListRedirectsResponse
+	(*CreateRedirectRequest)(nil),  // 3: libops.v1.CreateRedirectRequest
+	(*CreateRedirectResponse)(nil), // 4: libops.v1.CreateRedirectResponse
+	(*UpdateRedirectRequest)(nil),  // 5: libops.v1.UpdateRedirectRequest
+	(*UpdateRedirectResponse)(nil), // 6: libops.v1.UpdateRedirectResponse
+	(*DeleteRedirectRequest)(nil),  // 7: libops.v1.DeleteRedirectRequest
+	(*fieldmaskpb.FieldMask)(nil),  // 8: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),          // 9: google.protobuf.Empty
+}
+var file_libops_v1_redirect_proto_depIdxs = []int32{
+	0, // 0: libops.v1.ListRedirectsResponse.redirects:type_name -> libops.v1.Redirect
+	0, // 1: libops.v1.CreateRedirectResponse.redirect:type_name -> libops.v1.Redirect
+	8, // 2: libops.v1.UpdateRedirectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0, // 3: libops.v1.UpdateRedirectResponse.redirect:type_name -> libops.v1.Redirect
+	1, // 4: libops.v1.RedirectService.ListRedirects:input_type -> libops.v1.ListRedirectsRequest
+	3, // 5: libops.v1.RedirectService.CreateRedirect:input_type -> libops.v1.CreateRedirectRequest
+	5, // 6: libops.v1.RedirectService.UpdateRedirect:input_type -> libops.v1.UpdateRedirectRequest
+	7, // 7: libops.v1.RedirectService.DeleteRedirect:input_type -> libops.v1.DeleteRedirectRequest
+	2, // 8: libops.v1.RedirectService.ListRedirects:output_type -> libops.v1.ListRedirectsResponse
+	4, // 9: libops.v1.RedirectService.CreateRedirect:output_type -> libops.v1.CreateRedirectResponse
+	6, // 10: libops.v1.RedirectService.UpdateRedirect:output_type -> libops.v1.UpdateRedirectResponse
+	9, // 11: libops.v1.RedirectService.DeleteRedirect:output_type -> google.protobuf.Empty
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_redirect_proto_init() }
+func file_libops_v1_redirect_proto_init() {
+	if File_libops_v1_redirect_proto != nil {
+		return
+	}
+	file_libops_v1_redirect_proto_msgTypes[3].OneofWrappers = []any{}
+	file_libops_v1_redirect_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_redirect_proto_rawDesc), len(file_libops_v1_redirect_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_redirect_proto_goTypes,
+		DependencyIndexes: file_libops_v1_redirect_proto_depIdxs,
+		MessageInfos:      file_libops_v1_redirect_proto_msgTypes,
+	}.Build()
+	File_libops_v1_redirect_proto = out.File
+	file_libops_v1_redirect_proto_goTypes = nil
+	file_libops_v1_redirect_proto_depIdxs = nil
+}
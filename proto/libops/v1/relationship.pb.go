@@ -0,0 +1,999 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/relationship.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RelationshipStatus int32
+
+const (
+	RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED RelationshipStatus = 0
+	RelationshipStatus_RELATIONSHIP_STATUS_PENDING     RelationshipStatus = 1 // Waiting for the target organization to approve it
+	RelationshipStatus_RELATIONSHIP_STATUS_APPROVED    RelationshipStatus = 2 // The source organization's members have access
+	RelationshipStatus_RELATIONSHIP_STATUS_REJECTED    RelationshipStatus = 3
+	RelationshipStatus_RELATIONSHIP_STATUS_REVOKED     RelationshipStatus = 4
+)
+
+// Enum value maps for RelationshipStatus.
+var (
+	RelationshipStatus_name = map[int32]string{
+		0: "RELATIONSHIP_STATUS_UNSPECIFIED",
+		1: "RELATIONSHIP_STATUS_PENDING",
+		2: "RELATIONSHIP_STATUS_APPROVED",
+		3: "RELATIONSHIP_STATUS_REJECTED",
+		4: "RELATIONSHIP_STATUS_REVOKED",
+	}
+	RelationshipStatus_value = map[string]int32{
+		"RELATIONSHIP_STATUS_UNSPECIFIED": 0,
+		"RELATIONSHIP_STATUS_PENDING":     1,
+		"RELATIONSHIP_STATUS_APPROVED":    2,
+		"RELATIONSHIP_STATUS_REJECTED":    3,
+		"RELATIONSHIP_STATUS_REVOKED":     4,
+	}
+)
+
+func (x RelationshipStatus) Enum() *RelationshipStatus {
+	p := new(RelationshipStatus)
+	*p = x
+	return p
+}
+
+func (x RelationshipStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationshipStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_relationship_proto_enumTypes[0].Descriptor()
+}
+
+func (RelationshipStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_relationship_proto_enumTypes[0]
+}
+
+func (x RelationshipStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationshipStatus.Descriptor instead.
+func (RelationshipStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{0}
+}
+
+type Relationship struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId         string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`                     // UUID
+	SourceOrganizationId   string                 `protobuf:"bytes,2,opt,name=source_organization_id,json=sourceOrganizationId,proto3" json:"source_organization_id,omitempty"` // UUID of the organization whose members get access
+	SourceOrganizationName string                 `protobuf:"bytes,3,opt,name=source_organization_name,json=sourceOrganizationName,proto3" json:"source_organization_name,omitempty"`
+	TargetOrganizationId   string                 `protobuf:"bytes,4,opt,name=target_organization_id,json=targetOrganizationId,proto3" json:"target_organization_id,omitempty"` // UUID of the organization they get access to
+	TargetOrganizationName string                 `protobuf:"bytes,5,opt,name=target_organization_name,json=targetOrganizationName,proto3" json:"target_organization_name,omitempty"`
+	MaxRole                string                 `protobuf:"bytes,6,opt,name=max_role,json=maxRole,proto3" json:"max_role,omitempty"` // "owner", "developer", "read"
+	Status                 RelationshipStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.RelationshipStatus" json:"status,omitempty"`
+	RequestedBy            string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // Email of the account that requested it
+	CreatedAt              int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // Unix timestamp
+	ResolvedAt             int64                  `protobuf:"varint,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`  // Unix timestamp, 0 until approved, rejected or revoked
+	ExpiresAt              int64                  `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`     // Unix timestamp the relationship is revoked at, 0 if it doesn't expire
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Relationship) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *Relationship) GetSourceOrganizationId() string {
+	if x != nil {
+		return x.SourceOrganizationId
+	}
+	return ""
+}
+
+func (x *Relationship) GetSourceOrganizationName() string {
+	if x != nil {
+		return x.SourceOrganizationName
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetOrganizationId() string {
+	if x != nil {
+		return x.TargetOrganizationId
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetOrganizationName() string {
+	if x != nil {
+		return x.TargetOrganizationName
+	}
+	return ""
+}
+
+func (x *Relationship) GetMaxRole() string {
+	if x != nil {
+		return x.MaxRole
+	}
+	return ""
+}
+
+func (x *Relationship) GetStatus() RelationshipStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RelationshipStatus_RELATIONSHIP_STATUS_UNSPECIFIED
+}
+
+func (x *Relationship) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Relationship) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Relationship) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *Relationship) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RequestRelationshipRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId       string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`                     // The organization requesting access
+	TargetOrganizationId string                 `protobuf:"bytes,2,opt,name=target_organization_id,json=targetOrganizationId,proto3" json:"target_organization_id,omitempty"` // The organization to get access to
+	// Highest role the organization's members get in the target organization:
+	// "owner", "developer" or "read". Members get their own role up to it.
+	MaxRole       string `protobuf:"bytes,3,opt,name=max_role,json=maxRole,proto3" json:"max_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRelationshipRequest) Reset() {
+	*x = RequestRelationshipRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRelationshipRequest) ProtoMessage() {}
+
+func (x *RequestRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*RequestRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestRelationshipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RequestRelationshipRequest) GetTargetOrganizationId() string {
+	if x != nil {
+		return x.TargetOrganizationId
+	}
+	return ""
+}
+
+func (x *RequestRelationshipRequest) GetMaxRole() string {
+	if x != nil {
+		return x.MaxRole
+	}
+	return ""
+}
+
+type RequestRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRelationshipResponse) Reset() {
+	*x = RequestRelationshipResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRelationshipResponse) ProtoMessage() {}
+
+func (x *RequestRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*RequestRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RequestRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type ListRelationshipsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRelationshipsRequest) Reset() {
+	*x = ListRelationshipsRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRelationshipsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListRelationshipsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRelationshipsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*Relationship        `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelationshipsResponse) Reset() {
+	*x = ListRelationshipsResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *ListRelationshipsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApproveRelationshipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // The target organization
+	RelationshipId string                 `protobuf:"bytes,2,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	MaxRole        string                 `protobuf:"bytes,3,opt,name=max_role,json=maxRole,proto3" json:"max_role,omitempty"`        // Optional; lowers the requested max role
+	ExpiresAt      int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional Unix timestamp to revoke the relationship at
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApproveRelationshipRequest) Reset() {
+	*x = ApproveRelationshipRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRelationshipRequest) ProtoMessage() {}
+
+func (x *ApproveRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveRelationshipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ApproveRelationshipRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *ApproveRelationshipRequest) GetMaxRole() string {
+	if x != nil {
+		return x.MaxRole
+	}
+	return ""
+}
+
+func (x *ApproveRelationshipRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ApproveRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRelationshipResponse) Reset() {
+	*x = ApproveRelationshipResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRelationshipResponse) ProtoMessage() {}
+
+func (x *ApproveRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type RejectRelationshipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // The target organization
+	RelationshipId string                 `protobuf:"bytes,2,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RejectRelationshipRequest) Reset() {
+	*x = RejectRelationshipRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRelationshipRequest) ProtoMessage() {}
+
+func (x *RejectRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*RejectRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectRelationshipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RejectRelationshipRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+type RejectRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRelationshipResponse) Reset() {
+	*x = RejectRelationshipResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRelationshipResponse) ProtoMessage() {}
+
+func (x *RejectRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*RejectRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type UpdateRelationshipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // The target organization
+	RelationshipId string                 `protobuf:"bytes,2,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	MaxRole        string                 `protobuf:"bytes,3,opt,name=max_role,json=maxRole,proto3" json:"max_role,omitempty"`              // Empty keeps the current max role
+	ExpiresAt      *int64                 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // Unix timestamp to revoke the relationship at, 0 to keep it indefinitely
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateRelationshipRequest) Reset() {
+	*x = UpdateRelationshipRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRelationshipRequest) ProtoMessage() {}
+
+func (x *UpdateRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateRelationshipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateRelationshipRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *UpdateRelationshipRequest) GetMaxRole() string {
+	if x != nil {
+		return x.MaxRole
+	}
+	return ""
+}
+
+func (x *UpdateRelationshipRequest) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+type UpdateRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRelationshipResponse) Reset() {
+	*x = UpdateRelationshipResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRelationshipResponse) ProtoMessage() {}
+
+func (x *UpdateRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type RevokeRelationshipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // Either organization
+	RelationshipId string                 `protobuf:"bytes,2,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeRelationshipRequest) Reset() {
+	*x = RevokeRelationshipRequest{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRelationshipRequest) ProtoMessage() {}
+
+func (x *RevokeRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeRelationshipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RevokeRelationshipRequest) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+type RevokeRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRelationshipResponse) Reset() {
+	*x = RevokeRelationshipResponse{}
+	mi := &file_libops_v1_relationship_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRelationshipResponse) ProtoMessage() {}
+
+func (x *RevokeRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_relationship_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_relationship_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeRelationshipResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+var File_libops_v1_relationship_proto protoreflect.FileDescriptor
+
+const file_libops_v1_relationship_proto_rawDesc = "" +
+	"\n" +
+	"\x1clibops/v1/relationship.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\xeb\x03\n" +
+	"\fRelationship\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\x124\n" +
+	"\x16source_organization_id\x18\x02 \x01(\tR\x14sourceOrganizationId\x128\n" +
+	"\x18source_organization_name\x18\x03 \x01(\tR\x16sourceOrganizationName\x124\n" +
+	"\x16target_organization_id\x18\x04 \x01(\tR\x14targetOrganizationId\x128\n" +
+	"\x18target_organization_name\x18\x05 \x01(\tR\x16targetOrganizationName\x12\x19\n" +
+	"\bmax_role\x18\x06 \x01(\tR\amaxRole\x125\n" +
+	"\x06status\x18\a \x01(\x0e2\x1d.libops.v1.RelationshipStatusR\x06status\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\x03R\n" +
+	"resolvedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\v \x01(\x03R\texpiresAt\"\x96\x01\n" +
+	"\x1aRequestRelationshipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x124\n" +
+	"\x16target_organization_id\x18\x02 \x01(\tR\x14targetOrganizationId\x12\x19\n" +
+	"\bmax_role\x18\x03 \x01(\tR\amaxRole\"Z\n" +
+	"\x1bRequestRelationshipResponse\x12;\n" +
+	"\frelationship\x18\x01 \x01(\v2\x17.libops.v1.RelationshipR\frelationship\"\x7f\n" +
+	"\x18ListRelationshipsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x19ListRelationshipsResponse\x12=\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x17.libops.v1.RelationshipR\rrelationships\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa8\x01\n" +
+	"\x1aApproveRelationshipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0frelationship_id\x18\x02 \x01(\tR\x0erelationshipId\x12\x19\n" +
+	"\bmax_role\x18\x03 \x01(\tR\amaxRole\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"Z\n" +
+	"\x1bApproveRelationshipResponse\x12;\n" +
+	"\frelationship\x18\x01 \x01(\v2\x17.libops.v1.RelationshipR\frelationship\"m\n" +
+	"\x19RejectRelationshipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0frelationship_id\x18\x02 \x01(\tR\x0erelationshipId\"Y\n" +
+	"\x1aRejectRelationshipResponse\x12;\n" +
+	"\frelationship\x18\x01 \x01(\v2\x17.libops.v1.RelationshipR\frelationship\"\xbb\x01\n" +
+	"\x19UpdateRelationshipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0frelationship_id\x18\x02 \x01(\tR\x0erelationshipId\x12\x19\n" +
+	"\bmax_role\x18\x03 \x01(\tR\amaxRole\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03H\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"Y\n" +
+	"\x1aUpdateRelationshipResponse\x12;\n" +
+	"\frelationship\x18\x01 \x01(\v2\x17.libops.v1.RelationshipR\frelationship\"m\n" +
+	"\x19RevokeRelationshipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12'\n" +
+	"\x0frelationship_id\x18\x02 \x01(\tR\x0erelationshipId\"Y\n" +
+	"\x1aRevokeRelationshipResponse\x12;\n" +
+	"\frelationship\x18\x01 \x01(\v2\x17.libops.v1.RelationshipR\frelationship*\xbf\x01\n" +
+	"\x12RelationshipStatus\x12#\n" +
+	"\x1fRELATIONSHIP_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRELATIONSHIP_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cRELATIONSHIP_STATUS_APPROVED\x10\x02\x12 \n" +
+	"\x1cRELATIONSHIP_STATUS_REJECTED\x10\x03\x12\x1f\n" +
+	"\x1bRELATIONSHIP_STATUS_REVOKED\x10\x042\xd6\n" +
+	"\n" +
+	"\x13RelationshipService\x12\xd1\x01\n" +
+	"\x13RequestRelationship\x12%.libops.v1.RequestRelationshipRequest\x1a&.libops.v1.RequestRelationshipResponse\"k\x92\xb5\x18+\b\x03\x10\x03\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x026:\x01*\"1/v1/organizations/{organization_id}/relationships\x12\xca\x01\n" +
+	"\x11ListRelationships\x12#.libops.v1.ListRelationshipsRequest\x1a$.libops.v1.ListRelationshipsResponse\"j\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x023\x121/v1/organizations/{organization_id}/relationships\x90\x02\x01\x12\xea\x01\n" +
+	"\x13ApproveRelationship\x12%.libops.v1.ApproveRelationshipRequest\x1a&.libops.v1.ApproveRelationshipResponse\"\x83\x01\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02P:\x01*\"K/v1/organizations/{organization_id}/relationships/{relationship_id}:approve\x12\xe6\x01\n" +
+	"\x12RejectRelationship\x12$.libops.v1.RejectRelationshipRequest\x1a%.libops.v1.RejectRelationshipResponse\"\x82\x01\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02O:\x01*\"J/v1/organizations/{organization_id}/relationships/{relationship_id}:reject\x12\xde\x01\n" +
+	"\x12UpdateRelationship\x12$.libops.v1.UpdateRelationshipRequest\x1a%.libops.v1.UpdateRelationshipResponse\"{\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02H:\x01*2C/v1/organizations/{organization_id}/relationships/{relationship_id}\x12\xe6\x01\n" +
+	"\x12RevokeRelationship\x12$.libops.v1.RevokeRelationshipRequest\x1a%.libops.v1.RevokeRelationshipResponse\"\x82\x01\x92\xb5\x18)\b\x03\x10\x03\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02O:\x01*\"J/v1/organizations/{organization_id}/relationships/{relationship_id}:revokeB\x97\x01\n" +
+	"\rcom.libops.v1B\x11RelationshipProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_relationship_proto_rawDescOnce sync.Once
+	file_libops_v1_relationship_proto_rawDescData []byte
+)
+
+func file_libops_v1_relationship_proto_rawDescGZIP() []byte {
+	file_libops_v1_relationship_proto_rawDescOnce.Do(func() {
+		file_libops_v1_relationship_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_relationship_proto_rawDesc), len(file_libops_v1_relationship_proto_rawDesc)))
+	})
+	return file_libops_v1_relationship_proto_rawDescData
+}
+
+var file_libops_v1_relationship_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_relationship_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_libops_v1_relationship_proto_goTypes = []any{
+	(RelationshipStatus)(0),             // 0: libops.v1.RelationshipStatus
+	(*Relationship)(nil),                // 1: libops.v1.Relationship
+	(*RequestRelationshipRequest)(nil),  // 2: libops.v1.RequestRelationshipRequest
+	(*RequestRelationshipResponse)(nil), // 3: libops.v1.RequestRelationshipResponse
+	(*ListRelationshipsRequest)(nil),    // 4: libops.v1.ListRelationshipsRequest
+	(*ListRelationshipsResponse)(nil),   // 5: libops.v1.ListRelationshipsResponse
+	(*ApproveRelationshipRequest)(nil),  // 6: libops.v1.ApproveRelationshipRequest
+	(*ApproveRelationshipResponse)(nil), // 7: libops.v1.ApproveRelationshipResponse
+	(*RejectRelationshipRequest)(nil),   // 8: libops.v1.RejectRelationshipRequest
+	(*RejectRelationshipResponse)(nil),  // 9: libops.v1.RejectRelationshipResponse
+	(*UpdateRelationshipRequest)(nil),   // 10: libops.v1.UpdateRelationshipRequest
+	(*UpdateRelationshipResponse)(nil),  // 11: libops.v1.UpdateRelationshipResponse
+	(*RevokeRelationshipRequest)(nil),   // 12: libops.v1.RevokeRelationshipRequest
+	(*RevokeRelationshipResponse)(nil),  // 13: libops.v1.RevokeRelationshipResponse
+}
+var file_libops_v1_relationship_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.Relationship.status:type_name -> libops.v1.RelationshipStatus
+	1,  // 1: libops.v1.RequestRelationshipResponse.relationship:type_name -> libops.v1.Relationship
+	1,  // 2: libops.v1.ListRelationshipsResponse.relationships:type_name -> libops.v1.Relationship
+	1,  // 3: libops.v1.ApproveRelationshipResponse.relationship:type_name -> libops.v1.Relationship
+	1,  // 4: libops.v1.RejectRelationshipResponse.relationship:type_name -> libops.v1.Relationship
+	1,  // 5: libops.v1.UpdateRelationshipResponse.relationship:type_name -> libops.v1.Relationship
+	1,  // 6: libops.v1.RevokeRelationshipResponse.relationship:type_name -> libops.v1.Relationship
+	2,  // 7: libops.v1.RelationshipService.RequestRelationship:input_type -> libops.v1.RequestRelationshipRequest
+	4,  // 8: libops.v1.RelationshipService.ListRelationships:input_type -> libops.v1.ListRelationshipsRequest
+	6,  // 9: libops.v1.RelationshipService.ApproveRelationship:input_type -> libops.v1.ApproveRelationshipRequest
+	8,  // 10: libops.v1.RelationshipService.RejectRelationship:input_type -> libops.v1.RejectRelationshipRequest
+	10, // 11: libops.v1.RelationshipService.UpdateRelationship:input_type -> libops.v1.UpdateRelationshipRequest
+	12, // 12: libops.v1.RelationshipService.RevokeRelationship:input_type -> libops.v1.RevokeRelationshipRequest
+	3,  // 13: libops.v1.RelationshipService.RequestRelationship:output_type -> libops.v1.RequestRelationshipResponse
+	5,  // 14: libops.v1.RelationshipService.ListRelationships:output_type -> libops.v1.ListRelationshipsResponse
+	7,  // 15: libops.v1.RelationshipService.ApproveRelationship:output_type -> libops.v1.ApproveRelationshipResponse
+	9,  // 16: libops.v1.RelationshipService.RejectRelationship:output_type -> libops.v1.RejectRelationshipResponse
+	11, // 17: libops.v1.RelationshipService.UpdateRelationship:output_type -> libops.v1.UpdateRelationshipResponse
+	13, // 18: libops.v1.RelationshipService.RevokeRelationship:output_type -> libops.v1.RevokeRelationshipResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_relationship_proto_init() }
+func file_libops_v1_relationship_proto_init() {
+	if File_libops_v1_relationship_proto != nil {
+		return
+	}
+	file_libops_v1_relationship_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_relationship_proto_rawDesc), len(file_libops_v1_relationship_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_relationship_proto_goTypes,
+		DependencyIndexes: file_libops_v1_relationship_proto_depIdxs,
+		EnumInfos:         file_libops_v1_relationship_proto_enumTypes,
+		MessageInfos:      file_libops_v1_relationship_proto_msgTypes,
+	}.Build()
+	File_libops_v1_relationship_proto = out.File
+	file_libops_v1_relationship_proto_goTypes = nil
+	file_libops_v1_relationship_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package libopsv1
 import (
 	common "github.com/libops/api/proto/libops/v1/common"
 	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/descriptorpb"
@@ -32,6 +33,8 @@ type OrganizationSecret struct {
 	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
 	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Environment variable name (e.g., DATABASE_URL)
 	Status         common.Status          `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	KeyVersion     int32                  `protobuf:"varint,5,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"` // Data key version the value is sealed with; 0 if stored before envelope encryption
+	Reference      string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`                      // External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -94,12 +97,28 @@ func (x *OrganizationSecret) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *OrganizationSecret) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *OrganizationSecret) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type ProjectSecret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`    // UUID
 	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // UUID
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Environment variable name
 	Status        common.Status          `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	KeyVersion    int32                  `protobuf:"varint,5,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"` // Data key version the value is sealed with; 0 if stored before envelope encryption
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`                      // External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,12 +181,29 @@ func (x *ProjectSecret) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *ProjectSecret) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *ProjectSecret) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type SiteSecret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"` // UUID
 	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`       // UUID
 	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                         // Environment variable name
 	Status        common.Status          `protobuf:"varint,4,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	Managed       bool                   `protobuf:"varint,5,opt,name=managed,proto3" json:"managed,omitempty"`                         // Written by libops, like a database's credentials; read-only
+	KeyVersion    int32                  `protobuf:"varint,6,opt,name=key_version,json=keyVersion,proto3" json:"key_version,omitempty"` // Data key version the value is sealed with; 0 if stored before envelope encryption
+	Reference     string                 `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`                      // External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -230,11 +266,33 @@ func (x *SiteSecret) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *SiteSecret) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+func (x *SiteSecret) GetKeyVersion() int32 {
+	if x != nil {
+		return x.KeyVersion
+	}
+	return 0
+}
+
+func (x *SiteSecret) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type CreateOrganizationSecretRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // e.g., "DATABASE_URL"
-	Value          string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // Secret value (redacted in audit logs)
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // e.g., "DATABASE_URL"
+	Value          string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`         // Secret value (redacted in audit logs)
+	Reference      string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -290,6 +348,13 @@ func (x *CreateOrganizationSecretRequest) GetValue() string {
 	return ""
 }
 
+func (x *CreateOrganizationSecretRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type CreateOrganizationSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *OrganizationSecret    `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -548,6 +613,7 @@ type UpdateOrganizationSecretRequest struct {
 	SecretId       string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
 	Value          *string                `protobuf:"bytes,3,opt,name=value,proto3,oneof" json:"value,omitempty"` // Updated secret value
 	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Reference      *string                `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"` // Replaces the value with an external secret reference
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -610,6 +676,13 @@ func (x *UpdateOrganizationSecretRequest) GetUpdateMask() *fieldmaskpb.FieldMask
 	return nil
 }
 
+func (x *UpdateOrganizationSecretRequest) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
 type UpdateOrganizationSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *OrganizationSecret    `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -711,6 +784,7 @@ type CreateProjectSecretRequest struct {
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -766,6 +840,13 @@ func (x *CreateProjectSecretRequest) GetValue() string {
 	return ""
 }
 
+func (x *CreateProjectSecretRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type CreateProjectSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *ProjectSecret         `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -1024,6 +1105,7 @@ type UpdateProjectSecretRequest struct {
 	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
 	Value         *string                `protobuf:"bytes,3,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Reference     *string                `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"` // Replaces the value with an external secret reference
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1086,6 +1168,13 @@ func (x *UpdateProjectSecretRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *UpdateProjectSecretRequest) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
 type UpdateProjectSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *ProjectSecret         `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -1187,6 +1276,7 @@ type CreateSiteSecretRequest struct {
 	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"` // Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1242,6 +1332,13 @@ func (x *CreateSiteSecretRequest) GetValue() string {
 	return ""
 }
 
+func (x *CreateSiteSecretRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
 type CreateSiteSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *SiteSecret            `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -1500,6 +1597,7 @@ type UpdateSiteSecretRequest struct {
 	SecretId      string                 `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
 	Value         *string                `protobuf:"bytes,3,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Reference     *string                `protobuf:"bytes,5,opt,name=reference,proto3,oneof" json:"reference,omitempty"` // Replaces the value with an external secret reference
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1562,6 +1660,13 @@ func (x *UpdateSiteSecretRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *UpdateSiteSecretRequest) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
 type UpdateSiteSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Secret        *SiteSecret            `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
@@ -1658,32 +1763,349 @@ func (x *DeleteSiteSecretRequest) GetSecretId() string {
 	return ""
 }
 
+// SecretAccessLog is one read of a secret's value
+type SecretAccessLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessLogId   string                 `protobuf:"bytes,1,opt,name=access_log_id,json=accessLogId,proto3" json:"access_log_id,omitempty"` // UUID
+	SiteId        string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`                  // UUID of the site it was read for; empty once the site is deleted
+	SecretName    string                 `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Principal     string                 `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`                   // Email of the user or service account that read it
+	SourceIp      string                 `protobuf:"bytes,5,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`     // Empty when it couldn't be determined
+	Procedure     string                 `protobuf:"bytes,6,opt,name=procedure,proto3" json:"procedure,omitempty"`                   // The RPC, e.g. "/libops.v1.AdminSiteService/GetSiteSecrets"
+	Anomaly       string                 `protobuf:"bytes,7,opt,name=anomaly,proto3" json:"anomaly,omitempty"`                       // Why the read was flagged as unusual; empty when it wasn't
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretAccessLog) Reset() {
+	*x = SecretAccessLog{}
+	mi := &file_libops_v1_secrets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretAccessLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAccessLog) ProtoMessage() {}
+
+func (x *SecretAccessLog) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_secrets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAccessLog.ProtoReflect.Descriptor instead.
+func (*SecretAccessLog) Descriptor() ([]byte, []int) {
+	return file_libops_v1_secrets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SecretAccessLog) GetAccessLogId() string {
+	if x != nil {
+		return x.AccessLogId
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetAnomaly() string {
+	if x != nil {
+		return x.Anomaly
+	}
+	return ""
+}
+
+func (x *SecretAccessLog) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// SecretAccessAnomaly is the event sent to owners when secrets are read unusually
+type SecretAccessAnomaly struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SiteId         string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Principal      string                 `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	SourceIp       string                 `protobuf:"bytes,4,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	SecretNames    []string               `protobuf:"bytes,6,rep,name=secret_names,json=secretNames,proto3" json:"secret_names,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SecretAccessAnomaly) Reset() {
+	*x = SecretAccessAnomaly{}
+	mi := &file_libops_v1_secrets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretAccessAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretAccessAnomaly) ProtoMessage() {}
+
+func (x *SecretAccessAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_secrets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretAccessAnomaly.ProtoReflect.Descriptor instead.
+func (*SecretAccessAnomaly) Descriptor() ([]byte, []int) {
+	return file_libops_v1_secrets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SecretAccessAnomaly) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SecretAccessAnomaly) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SecretAccessAnomaly) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *SecretAccessAnomaly) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *SecretAccessAnomaly) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SecretAccessAnomaly) GetSecretNames() []string {
+	if x != nil {
+		return x.SecretNames
+	}
+	return nil
+}
+
+func (x *SecretAccessAnomaly) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListSecretAccessLogsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSecretAccessLogsRequest) Reset() {
+	*x = ListSecretAccessLogsRequest{}
+	mi := &file_libops_v1_secrets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretAccessLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretAccessLogsRequest) ProtoMessage() {}
+
+func (x *ListSecretAccessLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_secrets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretAccessLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretAccessLogsRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_secrets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSecretAccessLogsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListSecretAccessLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSecretAccessLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSecretAccessLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessLogs    []*SecretAccessLog     `protobuf:"bytes,1,rep,name=access_logs,json=accessLogs,proto3" json:"access_logs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretAccessLogsResponse) Reset() {
+	*x = ListSecretAccessLogsResponse{}
+	mi := &file_libops_v1_secrets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretAccessLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretAccessLogsResponse) ProtoMessage() {}
+
+func (x *ListSecretAccessLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_secrets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretAccessLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretAccessLogsResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_secrets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSecretAccessLogsResponse) GetAccessLogs() []*SecretAccessLog {
+	if x != nil {
+		return x.AccessLogs
+	}
+	return nil
+}
+
+func (x *ListSecretAccessLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_libops_v1_secrets_proto protoreflect.FileDescriptor
 
 const file_libops_v1_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\x17libops/v1/secrets.proto\x12\tlibops.v1\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xa0\x01\n" +
+	"\x17libops/v1/secrets.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xdf\x01\n" +
 	"\x12OrganizationSecret\x12\x1b\n" +
 	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\x91\x01\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12\x1f\n" +
+	"\vkey_version\x18\x05 \x01(\x05R\n" +
+	"keyVersion\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\"\xd0\x01\n" +
 	"\rProjectSecret\x12\x1b\n" +
 	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\x88\x01\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12\x1f\n" +
+	"\vkey_version\x18\x05 \x01(\x05R\n" +
+	"keyVersion\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\"\xe1\x01\n" +
 	"\n" +
 	"SiteSecret\x12\x1b\n" +
 	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x17\n" +
 	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x120\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"z\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12\x18\n" +
+	"\amanaged\x18\x05 \x01(\bR\amanaged\x12\x1f\n" +
+	"\vkey_version\x18\x06 \x01(\x05R\n" +
+	"keyVersion\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\"\x98\x01\n" +
 	"\x1fCreateOrganizationSecretRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\"Y\n" +
+	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\"Y\n" +
 	" CreateOrganizationSecretResponse\x125\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1d.libops.v1.OrganizationSecretR\x06secret\"d\n" +
 	"\x1cGetOrganizationSecretRequest\x12'\n" +
@@ -1698,24 +2120,28 @@ const file_libops_v1_secrets_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
 	"\x1fListOrganizationSecretsResponse\x127\n" +
 	"\asecrets\x18\x01 \x03(\v2\x1d.libops.v1.OrganizationSecretR\asecrets\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xcf\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x80\x02\n" +
 	"\x1fUpdateOrganizationSecretRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
 	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12\x1f\n" +
 	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x00R\x05value\x88\x01\x01\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMaskB\b\n" +
-	"\x06_value\"Y\n" +
+	"updateMask\x12!\n" +
+	"\treference\x18\x05 \x01(\tH\x01R\treference\x88\x01\x01B\b\n" +
+	"\x06_valueB\f\n" +
+	"\n" +
+	"_reference\"Y\n" +
 	" UpdateOrganizationSecretResponse\x125\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1d.libops.v1.OrganizationSecretR\x06secret\"g\n" +
 	"\x1fDeleteOrganizationSecretRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
-	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"k\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"\x89\x01\n" +
 	"\x1aCreateProjectSecretRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\"O\n" +
+	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\"O\n" +
 	"\x1bCreateProjectSecretResponse\x120\n" +
 	"\x06secret\x18\x01 \x01(\v2\x18.libops.v1.ProjectSecretR\x06secret\"U\n" +
 	"\x17GetProjectSecretRequest\x12\x1d\n" +
@@ -1732,25 +2158,29 @@ const file_libops_v1_secrets_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"x\n" +
 	"\x1aListProjectSecretsResponse\x122\n" +
 	"\asecrets\x18\x01 \x03(\v2\x18.libops.v1.ProjectSecretR\asecrets\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc0\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf1\x01\n" +
 	"\x1aUpdateProjectSecretRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
 	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12\x1f\n" +
 	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x00R\x05value\x88\x01\x01\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMaskB\b\n" +
-	"\x06_value\"O\n" +
+	"updateMask\x12!\n" +
+	"\treference\x18\x05 \x01(\tH\x01R\treference\x88\x01\x01B\b\n" +
+	"\x06_valueB\f\n" +
+	"\n" +
+	"_reference\"O\n" +
 	"\x1bUpdateProjectSecretResponse\x120\n" +
 	"\x06secret\x18\x01 \x01(\v2\x18.libops.v1.ProjectSecretR\x06secret\"X\n" +
 	"\x1aDeleteProjectSecretRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1b\n" +
-	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"b\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"\x80\x01\n" +
 	"\x17CreateSiteSecretRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\"I\n" +
+	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\x05value\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\"I\n" +
 	"\x18CreateSiteSecretResponse\x12-\n" +
 	"\x06secret\x18\x01 \x01(\v2\x15.libops.v1.SiteSecretR\x06secret\"L\n" +
 	"\x14GetSiteSecretRequest\x12\x17\n" +
@@ -1765,42 +2195,76 @@ const file_libops_v1_secrets_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"r\n" +
 	"\x17ListSiteSecretsResponse\x12/\n" +
 	"\asecrets\x18\x01 \x03(\v2\x15.libops.v1.SiteSecretR\asecrets\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb7\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe8\x01\n" +
 	"\x17UpdateSiteSecretRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
 	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\x12\x1f\n" +
 	"\x05value\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x00R\x05value\x88\x01\x01\x12;\n" +
 	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMaskB\b\n" +
-	"\x06_value\"I\n" +
+	"updateMask\x12!\n" +
+	"\treference\x18\x05 \x01(\tH\x01R\treference\x88\x01\x01B\b\n" +
+	"\x06_valueB\f\n" +
+	"\n" +
+	"_reference\"I\n" +
 	"\x18UpdateSiteSecretResponse\x12-\n" +
 	"\x06secret\x18\x01 \x01(\v2\x15.libops.v1.SiteSecretR\x06secret\"O\n" +
 	"\x17DeleteSiteSecretRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
-	"\tsecret_id\x18\x02 \x01(\tR\bsecretId2\xb1\x06\n" +
-	"\x19OrganizationSecretService\x12\xa2\x01\n" +
-	"\x18CreateOrganizationSecret\x12*.libops.v1.CreateOrganizationSecretRequest\x1a+.libops.v1.CreateOrganizationSecretResponse\"-\x92\xb5\x18)\b\x03\x10\x02\x18\x01\"\x0emanage_secrets2\x0forganization_id8\x03\x12\x9a\x01\n" +
-	"\x15GetOrganizationSecret\x12'.libops.v1.GetOrganizationSecretRequest\x1a(.libops.v1.GetOrganizationSecretResponse\".\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x90\x02\x01\x12\xa0\x01\n" +
-	"\x17ListOrganizationSecrets\x12).libops.v1.ListOrganizationSecretsRequest\x1a*.libops.v1.ListOrganizationSecretsResponse\".\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x90\x02\x01\x12\xa0\x01\n" +
-	"\x18UpdateOrganizationSecret\x12*.libops.v1.UpdateOrganizationSecretRequest\x1a+.libops.v1.UpdateOrganizationSecretResponse\"+\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x12\x8b\x01\n" +
-	"\x18DeleteOrganizationSecret\x12*.libops.v1.DeleteOrganizationSecretRequest\x1a\x16.google.protobuf.Empty\"+\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id2\xcc\x05\n" +
-	"\x14ProjectSecretService\x12\x8e\x01\n" +
-	"\x13CreateProjectSecret\x12%.libops.v1.CreateProjectSecretRequest\x1a&.libops.v1.CreateProjectSecretResponse\"(\x92\xb5\x18$\b\x04\x10\x02\x18\x01\"\x0emanage_secrets2\n" +
-	"project_id8\x04\x12\x86\x01\n" +
-	"\x10GetProjectSecret\x12\".libops.v1.GetProjectSecretRequest\x1a#.libops.v1.GetProjectSecretResponse\")\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
-	"project_id\x90\x02\x01\x12\x8c\x01\n" +
-	"\x12ListProjectSecrets\x12$.libops.v1.ListProjectSecretsRequest\x1a%.libops.v1.ListProjectSecretsResponse\")\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
-	"project_id\x90\x02\x01\x12\x8c\x01\n" +
-	"\x13UpdateProjectSecret\x12%.libops.v1.UpdateProjectSecretRequest\x1a&.libops.v1.UpdateProjectSecretResponse\"&\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
-	"project_id\x12|\n" +
-	"\x13DeleteProjectSecret\x12%.libops.v1.DeleteProjectSecretRequest\x1a\x16.google.protobuf.Empty\"&\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
-	"project_id2\x8f\x05\n" +
-	"\x11SiteSecretService\x12\x82\x01\n" +
-	"\x10CreateSiteSecret\x12\".libops.v1.CreateSiteSecretRequest\x1a#.libops.v1.CreateSiteSecretResponse\"%\x92\xb5\x18!\b\x05\x10\x02\x18\x01\"\x0emanage_secrets2\asite_id8\x05\x12z\n" +
-	"\rGetSiteSecret\x12\x1f.libops.v1.GetSiteSecretRequest\x1a .libops.v1.GetSiteSecretResponse\"&\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x90\x02\x01\x12\x80\x01\n" +
-	"\x0fListSiteSecrets\x12!.libops.v1.ListSiteSecretsRequest\x1a\".libops.v1.ListSiteSecretsResponse\"&\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x90\x02\x01\x12\x80\x01\n" +
-	"\x10UpdateSiteSecret\x12\".libops.v1.UpdateSiteSecretRequest\x1a#.libops.v1.UpdateSiteSecretResponse\"#\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x12s\n" +
-	"\x10DeleteSiteSecret\x12\".libops.v1.DeleteSiteSecretRequest\x1a\x16.google.protobuf.Empty\"#\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_idB\x92\x01\n" +
+	"\tsecret_id\x18\x02 \x01(\tR\bsecretId\"\x81\x02\n" +
+	"\x0fSecretAccessLog\x12\"\n" +
+	"\raccess_log_id\x18\x01 \x01(\tR\vaccessLogId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x1f\n" +
+	"\vsecret_name\x18\x03 \x01(\tR\n" +
+	"secretName\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\tR\tprincipal\x12\x1b\n" +
+	"\tsource_ip\x18\x05 \x01(\tR\bsourceIp\x12\x1c\n" +
+	"\tprocedure\x18\x06 \x01(\tR\tprocedure\x12\x18\n" +
+	"\aanomaly\x18\a \x01(\tR\aanomaly\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xec\x01\n" +
+	"\x13SecretAccessAnomaly\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\tR\tprincipal\x12\x1b\n" +
+	"\tsource_ip\x18\x04 \x01(\tR\bsourceIp\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\fsecret_names\x18\x06 \x03(\tR\vsecretNames\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\x82\x01\n" +
+	"\x1bListSecretAccessLogsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x1cListSecretAccessLogsResponse\x12;\n" +
+	"\vaccess_logs\x18\x01 \x03(\v2\x1a.libops.v1.SecretAccessLogR\n" +
+	"accessLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xb0\n" +
+	"\n" +
+	"\x19OrganizationSecretService\x12\xd8\x01\n" +
+	"\x18CreateOrganizationSecret\x12*.libops.v1.CreateOrganizationSecretRequest\x1a+.libops.v1.CreateOrganizationSecretResponse\"c\x92\xb5\x18)\b\x03\x10\x02\x18\x01\"\x0emanage_secrets2\x0forganization_id8\x03\x82\xd3\xe4\x93\x020:\x01*\"+/v1/organizations/{organization_id}/secrets\x12\xd9\x01\n" +
+	"\x15GetOrganizationSecret\x12'.libops.v1.GetOrganizationSecretRequest\x1a(.libops.v1.GetOrganizationSecretResponse\"m\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x82\xd3\xe4\x93\x029\x127/v1/organizations/{organization_id}/secrets/{secret_id}\x90\x02\x01\x12\xd3\x01\n" +
+	"\x17ListOrganizationSecrets\x12).libops.v1.ListOrganizationSecretsRequest\x1a*.libops.v1.ListOrganizationSecretsResponse\"a\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x82\xd3\xe4\x93\x02-\x12+/v1/organizations/{organization_id}/secrets\x90\x02\x01\x12\xe2\x01\n" +
+	"\x18UpdateOrganizationSecret\x12*.libops.v1.UpdateOrganizationSecretRequest\x1a+.libops.v1.UpdateOrganizationSecretResponse\"m\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x82\xd3\xe4\x93\x02<:\x01*27/v1/organizations/{organization_id}/secrets/{secret_id}\x12\xca\x01\n" +
+	"\x18DeleteOrganizationSecret\x12*.libops.v1.DeleteOrganizationSecretRequest\x1a\x16.google.protobuf.Empty\"j\x92\xb5\x18'\b\x03\x10\x02\x18\x01\"\x0emanage_secrets*\x0forganization_id\x82\xd3\xe4\x93\x029*7/v1/organizations/{organization_id}/secrets/{secret_id}\x12\xd3\x01\n" +
+	"\x14ListSecretAccessLogs\x12&.libops.v1.ListSecretAccessLogsRequest\x1a'.libops.v1.ListSecretAccessLogsResponse\"j\x92\xb5\x18%\b\x03\x10\x03\"\x0emanage_secrets*\x0forganization_id\x82\xd3\xe4\x93\x028\x126/v1/organizations/{organization_id}/secret-access-logs\x90\x02\x012\xc4\a\n" +
+	"\x14ProjectSecretService\x12\xba\x01\n" +
+	"\x13CreateProjectSecret\x12%.libops.v1.CreateProjectSecretRequest\x1a&.libops.v1.CreateProjectSecretResponse\"T\x92\xb5\x18$\b\x04\x10\x02\x18\x01\"\x0emanage_secrets2\n" +
+	"project_id8\x04\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/projects/{project_id}/secrets\x12\xbb\x01\n" +
+	"\x10GetProjectSecret\x12\".libops.v1.GetProjectSecretRequest\x1a#.libops.v1.GetProjectSecretResponse\"^\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
+	"project_id\x82\xd3\xe4\x93\x02/\x12-/v1/projects/{project_id}/secrets/{secret_id}\x90\x02\x01\x12\xb5\x01\n" +
+	"\x12ListProjectSecrets\x12$.libops.v1.ListProjectSecretsRequest\x1a%.libops.v1.ListProjectSecretsResponse\"R\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
+	"project_id\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/secrets\x90\x02\x01\x12\xc4\x01\n" +
+	"\x13UpdateProjectSecret\x12%.libops.v1.UpdateProjectSecretRequest\x1a&.libops.v1.UpdateProjectSecretResponse\"^\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
+	"project_id\x82\xd3\xe4\x93\x022:\x01*2-/v1/projects/{project_id}/secrets/{secret_id}\x12\xb1\x01\n" +
+	"\x13DeleteProjectSecret\x12%.libops.v1.DeleteProjectSecretRequest\x1a\x16.google.protobuf.Empty\"[\x92\xb5\x18\"\b\x04\x10\x02\x18\x01\"\x0emanage_secrets*\n" +
+	"project_id\x82\xd3\xe4\x93\x02/*-/v1/projects/{project_id}/secrets/{secret_id}2\xea\x06\n" +
+	"\x11SiteSecretService\x12\xa8\x01\n" +
+	"\x10CreateSiteSecret\x12\".libops.v1.CreateSiteSecretRequest\x1a#.libops.v1.CreateSiteSecretResponse\"K\x92\xb5\x18!\b\x05\x10\x02\x18\x01\"\x0emanage_secrets2\asite_id8\x05\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/sites/{site_id}/secrets\x12\xa9\x01\n" +
+	"\rGetSiteSecret\x12\x1f.libops.v1.GetSiteSecretRequest\x1a .libops.v1.GetSiteSecretResponse\"U\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x82\xd3\xe4\x93\x02)\x12'/v1/sites/{site_id}/secrets/{secret_id}\x90\x02\x01\x12\xa3\x01\n" +
+	"\x0fListSiteSecrets\x12!.libops.v1.ListSiteSecretsRequest\x1a\".libops.v1.ListSiteSecretsResponse\"I\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/sites/{site_id}/secrets\x90\x02\x01\x12\xb2\x01\n" +
+	"\x10UpdateSiteSecret\x12\".libops.v1.UpdateSiteSecretRequest\x1a#.libops.v1.UpdateSiteSecretResponse\"U\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x82\xd3\xe4\x93\x02,:\x01*2'/v1/sites/{site_id}/secrets/{secret_id}\x12\xa2\x01\n" +
+	"\x10DeleteSiteSecret\x12\".libops.v1.DeleteSiteSecretRequest\x1a\x16.google.protobuf.Empty\"R\x92\xb5\x18\x1f\b\x05\x10\x02\x18\x01\"\x0emanage_secrets*\asite_id\x82\xd3\xe4\x93\x02)*'/v1/sites/{site_id}/secrets/{secret_id}B\x92\x01\n" +
 	"\rcom.libops.v1B\fSecretsProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -1816,7 +2280,7 @@ func file_libops_v1_secrets_proto_rawDescGZIP() []byte {
 	return file_libops_v1_secrets_proto_rawDescData
 }
 
-var file_libops_v1_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_libops_v1_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_libops_v1_secrets_proto_goTypes = []any{
 	(*OrganizationSecret)(nil),               // 0: libops.v1.OrganizationSecret
 	(*ProjectSecret)(nil),                    // 1: libops.v1.ProjectSecret
@@ -1848,64 +2312,71 @@ var file_libops_v1_secrets_proto_goTypes = []any{
 	(*UpdateSiteSecretRequest)(nil),          // 27: libops.v1.UpdateSiteSecretRequest
 	(*UpdateSiteSecretResponse)(nil),         // 28: libops.v1.UpdateSiteSecretResponse
 	(*DeleteSiteSecretRequest)(nil),          // 29: libops.v1.DeleteSiteSecretRequest
-	(common.Status)(0),                       // 30: libops.v1.common.Status
-	(*fieldmaskpb.FieldMask)(nil),            // 31: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                    // 32: google.protobuf.Empty
+	(*SecretAccessLog)(nil),                  // 30: libops.v1.SecretAccessLog
+	(*SecretAccessAnomaly)(nil),              // 31: libops.v1.SecretAccessAnomaly
+	(*ListSecretAccessLogsRequest)(nil),      // 32: libops.v1.ListSecretAccessLogsRequest
+	(*ListSecretAccessLogsResponse)(nil),     // 33: libops.v1.ListSecretAccessLogsResponse
+	(common.Status)(0),                       // 34: libops.v1.common.Status
+	(*fieldmaskpb.FieldMask)(nil),            // 35: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                    // 36: google.protobuf.Empty
 }
 var file_libops_v1_secrets_proto_depIdxs = []int32{
-	30, // 0: libops.v1.OrganizationSecret.status:type_name -> libops.v1.common.Status
-	30, // 1: libops.v1.ProjectSecret.status:type_name -> libops.v1.common.Status
-	30, // 2: libops.v1.SiteSecret.status:type_name -> libops.v1.common.Status
+	34, // 0: libops.v1.OrganizationSecret.status:type_name -> libops.v1.common.Status
+	34, // 1: libops.v1.ProjectSecret.status:type_name -> libops.v1.common.Status
+	34, // 2: libops.v1.SiteSecret.status:type_name -> libops.v1.common.Status
 	0,  // 3: libops.v1.CreateOrganizationSecretResponse.secret:type_name -> libops.v1.OrganizationSecret
 	0,  // 4: libops.v1.GetOrganizationSecretResponse.secret:type_name -> libops.v1.OrganizationSecret
 	0,  // 5: libops.v1.ListOrganizationSecretsResponse.secrets:type_name -> libops.v1.OrganizationSecret
-	31, // 6: libops.v1.UpdateOrganizationSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 6: libops.v1.UpdateOrganizationSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 7: libops.v1.UpdateOrganizationSecretResponse.secret:type_name -> libops.v1.OrganizationSecret
 	1,  // 8: libops.v1.CreateProjectSecretResponse.secret:type_name -> libops.v1.ProjectSecret
 	1,  // 9: libops.v1.GetProjectSecretResponse.secret:type_name -> libops.v1.ProjectSecret
 	1,  // 10: libops.v1.ListProjectSecretsResponse.secrets:type_name -> libops.v1.ProjectSecret
-	31, // 11: libops.v1.UpdateProjectSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 11: libops.v1.UpdateProjectSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
 	1,  // 12: libops.v1.UpdateProjectSecretResponse.secret:type_name -> libops.v1.ProjectSecret
 	2,  // 13: libops.v1.CreateSiteSecretResponse.secret:type_name -> libops.v1.SiteSecret
 	2,  // 14: libops.v1.GetSiteSecretResponse.secret:type_name -> libops.v1.SiteSecret
 	2,  // 15: libops.v1.ListSiteSecretsResponse.secrets:type_name -> libops.v1.SiteSecret
-	31, // 16: libops.v1.UpdateSiteSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 16: libops.v1.UpdateSiteSecretRequest.update_mask:type_name -> google.protobuf.FieldMask
 	2,  // 17: libops.v1.UpdateSiteSecretResponse.secret:type_name -> libops.v1.SiteSecret
-	3,  // 18: libops.v1.OrganizationSecretService.CreateOrganizationSecret:input_type -> libops.v1.CreateOrganizationSecretRequest
-	5,  // 19: libops.v1.OrganizationSecretService.GetOrganizationSecret:input_type -> libops.v1.GetOrganizationSecretRequest
-	7,  // 20: libops.v1.OrganizationSecretService.ListOrganizationSecrets:input_type -> libops.v1.ListOrganizationSecretsRequest
-	9,  // 21: libops.v1.OrganizationSecretService.UpdateOrganizationSecret:input_type -> libops.v1.UpdateOrganizationSecretRequest
-	11, // 22: libops.v1.OrganizationSecretService.DeleteOrganizationSecret:input_type -> libops.v1.DeleteOrganizationSecretRequest
-	12, // 23: libops.v1.ProjectSecretService.CreateProjectSecret:input_type -> libops.v1.CreateProjectSecretRequest
-	14, // 24: libops.v1.ProjectSecretService.GetProjectSecret:input_type -> libops.v1.GetProjectSecretRequest
-	16, // 25: libops.v1.ProjectSecretService.ListProjectSecrets:input_type -> libops.v1.ListProjectSecretsRequest
-	18, // 26: libops.v1.ProjectSecretService.UpdateProjectSecret:input_type -> libops.v1.UpdateProjectSecretRequest
-	20, // 27: libops.v1.ProjectSecretService.DeleteProjectSecret:input_type -> libops.v1.DeleteProjectSecretRequest
-	21, // 28: libops.v1.SiteSecretService.CreateSiteSecret:input_type -> libops.v1.CreateSiteSecretRequest
-	23, // 29: libops.v1.SiteSecretService.GetSiteSecret:input_type -> libops.v1.GetSiteSecretRequest
-	25, // 30: libops.v1.SiteSecretService.ListSiteSecrets:input_type -> libops.v1.ListSiteSecretsRequest
-	27, // 31: libops.v1.SiteSecretService.UpdateSiteSecret:input_type -> libops.v1.UpdateSiteSecretRequest
-	29, // 32: libops.v1.SiteSecretService.DeleteSiteSecret:input_type -> libops.v1.DeleteSiteSecretRequest
-	4,  // 33: libops.v1.OrganizationSecretService.CreateOrganizationSecret:output_type -> libops.v1.CreateOrganizationSecretResponse
-	6,  // 34: libops.v1.OrganizationSecretService.GetOrganizationSecret:output_type -> libops.v1.GetOrganizationSecretResponse
-	8,  // 35: libops.v1.OrganizationSecretService.ListOrganizationSecrets:output_type -> libops.v1.ListOrganizationSecretsResponse
-	10, // 36: libops.v1.OrganizationSecretService.UpdateOrganizationSecret:output_type -> libops.v1.UpdateOrganizationSecretResponse
-	32, // 37: libops.v1.OrganizationSecretService.DeleteOrganizationSecret:output_type -> google.protobuf.Empty
-	13, // 38: libops.v1.ProjectSecretService.CreateProjectSecret:output_type -> libops.v1.CreateProjectSecretResponse
-	15, // 39: libops.v1.ProjectSecretService.GetProjectSecret:output_type -> libops.v1.GetProjectSecretResponse
-	17, // 40: libops.v1.ProjectSecretService.ListProjectSecrets:output_type -> libops.v1.ListProjectSecretsResponse
-	19, // 41: libops.v1.ProjectSecretService.UpdateProjectSecret:output_type -> libops.v1.UpdateProjectSecretResponse
-	32, // 42: libops.v1.ProjectSecretService.DeleteProjectSecret:output_type -> google.protobuf.Empty
-	22, // 43: libops.v1.SiteSecretService.CreateSiteSecret:output_type -> libops.v1.CreateSiteSecretResponse
-	24, // 44: libops.v1.SiteSecretService.GetSiteSecret:output_type -> libops.v1.GetSiteSecretResponse
-	26, // 45: libops.v1.SiteSecretService.ListSiteSecrets:output_type -> libops.v1.ListSiteSecretsResponse
-	28, // 46: libops.v1.SiteSecretService.UpdateSiteSecret:output_type -> libops.v1.UpdateSiteSecretResponse
-	32, // 47: libops.v1.SiteSecretService.DeleteSiteSecret:output_type -> google.protobuf.Empty
-	33, // [33:48] is the sub-list for method output_type
-	18, // [18:33] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	30, // 18: libops.v1.ListSecretAccessLogsResponse.access_logs:type_name -> libops.v1.SecretAccessLog
+	3,  // 19: libops.v1.OrganizationSecretService.CreateOrganizationSecret:input_type -> libops.v1.CreateOrganizationSecretRequest
+	5,  // 20: libops.v1.OrganizationSecretService.GetOrganizationSecret:input_type -> libops.v1.GetOrganizationSecretRequest
+	7,  // 21: libops.v1.OrganizationSecretService.ListOrganizationSecrets:input_type -> libops.v1.ListOrganizationSecretsRequest
+	9,  // 22: libops.v1.OrganizationSecretService.UpdateOrganizationSecret:input_type -> libops.v1.UpdateOrganizationSecretRequest
+	11, // 23: libops.v1.OrganizationSecretService.DeleteOrganizationSecret:input_type -> libops.v1.DeleteOrganizationSecretRequest
+	32, // 24: libops.v1.OrganizationSecretService.ListSecretAccessLogs:input_type -> libops.v1.ListSecretAccessLogsRequest
+	12, // 25: libops.v1.ProjectSecretService.CreateProjectSecret:input_type -> libops.v1.CreateProjectSecretRequest
+	14, // 26: libops.v1.ProjectSecretService.GetProjectSecret:input_type -> libops.v1.GetProjectSecretRequest
+	16, // 27: libops.v1.ProjectSecretService.ListProjectSecrets:input_type -> libops.v1.ListProjectSecretsRequest
+	18, // 28: libops.v1.ProjectSecretService.UpdateProjectSecret:input_type -> libops.v1.UpdateProjectSecretRequest
+	20, // 29: libops.v1.ProjectSecretService.DeleteProjectSecret:input_type -> libops.v1.DeleteProjectSecretRequest
+	21, // 30: libops.v1.SiteSecretService.CreateSiteSecret:input_type -> libops.v1.CreateSiteSecretRequest
+	23, // 31: libops.v1.SiteSecretService.GetSiteSecret:input_type -> libops.v1.GetSiteSecretRequest
+	25, // 32: libops.v1.SiteSecretService.ListSiteSecrets:input_type -> libops.v1.ListSiteSecretsRequest
+	27, // 33: libops.v1.SiteSecretService.UpdateSiteSecret:input_type -> libops.v1.UpdateSiteSecretRequest
+	29, // 34: libops.v1.SiteSecretService.DeleteSiteSecret:input_type -> libops.v1.DeleteSiteSecretRequest
+	4,  // 35: libops.v1.OrganizationSecretService.CreateOrganizationSecret:output_type -> libops.v1.CreateOrganizationSecretResponse
+	6,  // 36: libops.v1.OrganizationSecretService.GetOrganizationSecret:output_type -> libops.v1.GetOrganizationSecretResponse
+	8,  // 37: libops.v1.OrganizationSecretService.ListOrganizationSecrets:output_type -> libops.v1.ListOrganizationSecretsResponse
+	10, // 38: libops.v1.OrganizationSecretService.UpdateOrganizationSecret:output_type -> libops.v1.UpdateOrganizationSecretResponse
+	36, // 39: libops.v1.OrganizationSecretService.DeleteOrganizationSecret:output_type -> google.protobuf.Empty
+	33, // 40: libops.v1.OrganizationSecretService.ListSecretAccessLogs:output_type -> libops.v1.ListSecretAccessLogsResponse
+	13, // 41: libops.v1.ProjectSecretService.CreateProjectSecret:output_type -> libops.v1.CreateProjectSecretResponse
+	15, // 42: libops.v1.ProjectSecretService.GetProjectSecret:output_type -> libops.v1.GetProjectSecretResponse
+	17, // 43: libops.v1.ProjectSecretService.ListProjectSecrets:output_type -> libops.v1.ListProjectSecretsResponse
+	19, // 44: libops.v1.ProjectSecretService.UpdateProjectSecret:output_type -> libops.v1.UpdateProjectSecretResponse
+	36, // 45: libops.v1.ProjectSecretService.DeleteProjectSecret:output_type -> google.protobuf.Empty
+	22, // 46: libops.v1.SiteSecretService.CreateSiteSecret:output_type -> libops.v1.CreateSiteSecretResponse
+	24, // 47: libops.v1.SiteSecretService.GetSiteSecret:output_type -> libops.v1.GetSiteSecretResponse
+	26, // 48: libops.v1.SiteSecretService.ListSiteSecrets:output_type -> libops.v1.ListSiteSecretsResponse
+	28, // 49: libops.v1.SiteSecretService.UpdateSiteSecret:output_type -> libops.v1.UpdateSiteSecretResponse
+	36, // 50: libops.v1.SiteSecretService.DeleteSiteSecret:output_type -> google.protobuf.Empty
+	35, // [35:51] is the sub-list for method output_type
+	19, // [19:35] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_secrets_proto_init() }
@@ -1922,7 +2393,7 @@ func file_libops_v1_secrets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_secrets_proto_rawDesc), len(file_libops_v1_secrets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
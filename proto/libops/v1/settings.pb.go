@@ -9,6 +9,7 @@ package libopsv1
 import (
 	common "github.com/libops/api/proto/libops/v1/common"
 	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -25,6 +26,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SettingValueType is the type a setting's value is checked against whenever
+// it is written
+type SettingValueType int32
+
+const (
+	SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED SettingValueType = 0 // Treated as a string
+	SettingValueType_SETTING_VALUE_TYPE_STRING      SettingValueType = 1
+	SettingValueType_SETTING_VALUE_TYPE_INT         SettingValueType = 2 // A base 10 integer
+	SettingValueType_SETTING_VALUE_TYPE_BOOL        SettingValueType = 3 // "true" or "false"
+	SettingValueType_SETTING_VALUE_TYPE_ENUM        SettingValueType = 4 // One of the setting's allowed values
+)
+
+// Enum value maps for SettingValueType.
+var (
+	SettingValueType_name = map[int32]string{
+		0: "SETTING_VALUE_TYPE_UNSPECIFIED",
+		1: "SETTING_VALUE_TYPE_STRING",
+		2: "SETTING_VALUE_TYPE_INT",
+		3: "SETTING_VALUE_TYPE_BOOL",
+		4: "SETTING_VALUE_TYPE_ENUM",
+	}
+	SettingValueType_value = map[string]int32{
+		"SETTING_VALUE_TYPE_UNSPECIFIED": 0,
+		"SETTING_VALUE_TYPE_STRING":      1,
+		"SETTING_VALUE_TYPE_INT":         2,
+		"SETTING_VALUE_TYPE_BOOL":        3,
+		"SETTING_VALUE_TYPE_ENUM":        4,
+	}
+)
+
+func (x SettingValueType) Enum() *SettingValueType {
+	p := new(SettingValueType)
+	*p = x
+	return p
+}
+
+func (x SettingValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettingValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_settings_proto_enumTypes[0].Descriptor()
+}
+
+func (SettingValueType) Type() protoreflect.EnumType {
+	return &file_libops_v1_settings_proto_enumTypes[0]
+}
+
+func (x SettingValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettingValueType.Descriptor instead.
+func (SettingValueType) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_settings_proto_rawDescGZIP(), []int{0}
+}
+
 type OrganizationSetting struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SettingId      string                 `protobuf:"bytes,1,opt,name=setting_id,json=settingId,proto3" json:"setting_id,omitempty"`                // UUID
@@ -34,6 +92,9 @@ type OrganizationSetting struct {
 	Editable       bool                   `protobuf:"varint,5,opt,name=editable,proto3" json:"editable,omitempty"`                                  // Can users modify this?
 	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                             // Optional description
 	Status         common.Status          `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	ValueType      SettingValueType       `protobuf:"varint,8,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues  []string               `protobuf:"bytes,9,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Values an enum setting allows
+	Environment    bool                   `protobuf:"varint,10,opt,name=environment,proto3" json:"environment,omitempty"`                        // Delivered to site VMs as an environment variable
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -117,6 +178,27 @@ func (x *OrganizationSetting) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *OrganizationSetting) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *OrganizationSetting) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *OrganizationSetting) GetEnvironment() bool {
+	if x != nil {
+		return x.Environment
+	}
+	return false
+}
+
 type ProjectSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SettingId     string                 `protobuf:"bytes,1,opt,name=setting_id,json=settingId,proto3" json:"setting_id,omitempty"` // UUID
@@ -126,6 +208,9 @@ type ProjectSetting struct {
 	Editable      bool                   `protobuf:"varint,5,opt,name=editable,proto3" json:"editable,omitempty"`
 	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	Status        common.Status          `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	ValueType     SettingValueType       `protobuf:"varint,8,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,9,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Values an enum setting allows
+	Environment   bool                   `protobuf:"varint,10,opt,name=environment,proto3" json:"environment,omitempty"`                        // Delivered to site VMs as an environment variable
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,6 +294,27 @@ func (x *ProjectSetting) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *ProjectSetting) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *ProjectSetting) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *ProjectSetting) GetEnvironment() bool {
+	if x != nil {
+		return x.Environment
+	}
+	return false
+}
+
 type SiteSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SettingId     string                 `protobuf:"bytes,1,opt,name=setting_id,json=settingId,proto3" json:"setting_id,omitempty"` // UUID
@@ -218,6 +324,9 @@ type SiteSetting struct {
 	Editable      bool                   `protobuf:"varint,5,opt,name=editable,proto3" json:"editable,omitempty"`
 	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	Status        common.Status          `protobuf:"varint,7,opt,name=status,proto3,enum=libops.v1.common.Status" json:"status,omitempty"`
+	ValueType     SettingValueType       `protobuf:"varint,8,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,9,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Values an enum setting allows
+	Environment   bool                   `protobuf:"varint,10,opt,name=environment,proto3" json:"environment,omitempty"`                        // Delivered to site VMs as an environment variable
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -301,6 +410,27 @@ func (x *SiteSetting) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *SiteSetting) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *SiteSetting) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *SiteSetting) GetEnvironment() bool {
+	if x != nil {
+		return x.Environment
+	}
+	return false
+}
+
 type CreateOrganizationSettingRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -308,6 +438,8 @@ type CreateOrganizationSettingRequest struct {
 	Value          string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	Editable       bool                   `protobuf:"varint,4,opt,name=editable,proto3" json:"editable,omitempty"`
 	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ValueType      SettingValueType       `protobuf:"varint,6,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues  []string               `protobuf:"bytes,7,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Required for, and only allowed on, enum settings
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -377,6 +509,20 @@ func (x *CreateOrganizationSettingRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateOrganizationSettingRequest) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateOrganizationSettingRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
 type CreateOrganizationSettingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Setting       *OrganizationSetting   `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
@@ -800,6 +946,8 @@ type CreateProjectSettingRequest struct {
 	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	Editable      bool                   `protobuf:"varint,4,opt,name=editable,proto3" json:"editable,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ValueType     SettingValueType       `protobuf:"varint,6,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,7,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Required for, and only allowed on, enum settings
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -869,6 +1017,20 @@ func (x *CreateProjectSettingRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateProjectSettingRequest) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateProjectSettingRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
 type CreateProjectSettingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Setting       *ProjectSetting        `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
@@ -1292,6 +1454,8 @@ type CreateSiteSettingRequest struct {
 	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	Editable      bool                   `protobuf:"varint,4,opt,name=editable,proto3" json:"editable,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ValueType     SettingValueType       `protobuf:"varint,6,opt,name=value_type,json=valueType,proto3,enum=libops.v1.SettingValueType" json:"value_type,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,7,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Required for, and only allowed on, enum settings
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1361,6 +1525,20 @@ func (x *CreateSiteSettingRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateSiteSettingRequest) GetValueType() SettingValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return SettingValueType_SETTING_VALUE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateSiteSettingRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
 type CreateSiteSettingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Setting       *SiteSetting           `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
@@ -1781,7 +1959,7 @@ var File_libops_v1_settings_proto protoreflect.FileDescriptor
 
 const file_libops_v1_settings_proto_rawDesc = "" +
 	"\n" +
-	"\x18libops/v1/settings.proto\x12\tlibops.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xf5\x01\n" +
+	"\x18libops/v1/settings.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\x1a\x1clibops/v1/common/types.proto\"\xfa\x02\n" +
 	"\x13OrganizationSetting\x12\x1d\n" +
 	"\n" +
 	"setting_id\x18\x01 \x01(\tR\tsettingId\x12'\n" +
@@ -1790,7 +1968,12 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x05 \x01(\bR\beditable\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x120\n" +
-	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\xe6\x01\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12:\n" +
+	"\n" +
+	"value_type\x18\b \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\t \x03(\tR\rallowedValues\x12 \n" +
+	"\venvironment\x18\n" +
+	" \x01(\bR\venvironment\"\xeb\x02\n" +
 	"\x0eProjectSetting\x12\x1d\n" +
 	"\n" +
 	"setting_id\x18\x01 \x01(\tR\tsettingId\x12\x1d\n" +
@@ -1800,7 +1983,12 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x05 \x01(\bR\beditable\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x120\n" +
-	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\xdd\x01\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12:\n" +
+	"\n" +
+	"value_type\x18\b \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\t \x03(\tR\rallowedValues\x12 \n" +
+	"\venvironment\x18\n" +
+	" \x01(\bR\venvironment\"\xe2\x02\n" +
 	"\vSiteSetting\x12\x1d\n" +
 	"\n" +
 	"setting_id\x18\x01 \x01(\tR\tsettingId\x12\x17\n" +
@@ -1809,13 +1997,21 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x05 \x01(\bR\beditable\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x120\n" +
-	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\"\xb1\x01\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.libops.v1.common.StatusR\x06status\x12:\n" +
+	"\n" +
+	"value_type\x18\b \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\t \x03(\tR\rallowedValues\x12 \n" +
+	"\venvironment\x18\n" +
+	" \x01(\bR\venvironment\"\x94\x02\n" +
 	" CreateOrganizationSettingRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x04 \x01(\bR\beditable\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"]\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"value_type\x18\x06 \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\a \x03(\tR\rallowedValues\"]\n" +
 	"!CreateOrganizationSettingResponse\x128\n" +
 	"\asetting\x18\x01 \x01(\v2\x1e.libops.v1.OrganizationSettingR\asetting\"g\n" +
 	"\x1dGetOrganizationSettingRequest\x12'\n" +
@@ -1845,14 +2041,17 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	" DeleteOrganizationSettingRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1d\n" +
 	"\n" +
-	"setting_id\x18\x02 \x01(\tR\tsettingId\"\xa2\x01\n" +
+	"setting_id\x18\x02 \x01(\tR\tsettingId\"\x85\x02\n" +
 	"\x1bCreateProjectSettingRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x04 \x01(\bR\beditable\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"S\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"value_type\x18\x06 \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\a \x03(\tR\rallowedValues\"S\n" +
 	"\x1cCreateProjectSettingResponse\x123\n" +
 	"\asetting\x18\x01 \x01(\v2\x19.libops.v1.ProjectSettingR\asetting\"X\n" +
 	"\x18GetProjectSettingRequest\x12\x1d\n" +
@@ -1886,13 +2085,16 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1d\n" +
 	"\n" +
-	"setting_id\x18\x02 \x01(\tR\tsettingId\"\x99\x01\n" +
+	"setting_id\x18\x02 \x01(\tR\tsettingId\"\xfc\x01\n" +
 	"\x18CreateSiteSettingRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1a\n" +
 	"\beditable\x18\x04 \x01(\bR\beditable\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"M\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"value_type\x18\x06 \x01(\x0e2\x1b.libops.v1.SettingValueTypeR\tvalueType\x12%\n" +
+	"\x0eallowed_values\x18\a \x03(\tR\rallowedValues\"M\n" +
 	"\x19CreateSiteSettingResponse\x120\n" +
 	"\asetting\x18\x01 \x01(\v2\x16.libops.v1.SiteSettingR\asetting\"O\n" +
 	"\x15GetSiteSettingRequest\x12\x17\n" +
@@ -1922,30 +2124,36 @@ const file_libops_v1_settings_proto_rawDesc = "" +
 	"\x18DeleteSiteSettingRequest\x12\x17\n" +
 	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
 	"\n" +
-	"setting_id\x18\x02 \x01(\tR\tsettingId2\xc1\x06\n" +
-	"\x1aOrganizationSettingService\x12\xa6\x01\n" +
-	"\x19CreateOrganizationSetting\x12+.libops.v1.CreateOrganizationSettingRequest\x1a,.libops.v1.CreateOrganizationSettingResponse\".\x92\xb5\x18*\b\x03\x10\x02\x18\x01\"\x0fmanage_settings2\x0forganization_id8\x03\x12\x9c\x01\n" +
-	"\x16GetOrganizationSetting\x12(.libops.v1.GetOrganizationSettingRequest\x1a).libops.v1.GetOrganizationSettingResponse\"-\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread_settings*\x0forganization_id\x90\x02\x01\x12\xa2\x01\n" +
-	"\x18ListOrganizationSettings\x12*.libops.v1.ListOrganizationSettingsRequest\x1a+.libops.v1.ListOrganizationSettingsResponse\"-\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread_settings*\x0forganization_id\x90\x02\x01\x12\xa4\x01\n" +
-	"\x19UpdateOrganizationSetting\x12+.libops.v1.UpdateOrganizationSettingRequest\x1a,.libops.v1.UpdateOrganizationSettingResponse\",\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fmanage_settings*\x0forganization_id\x12\x8e\x01\n" +
-	"\x19DeleteOrganizationSetting\x12+.libops.v1.DeleteOrganizationSettingRequest\x1a\x16.google.protobuf.Empty\",\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fmanage_settings*\x0forganization_id2\xdc\x05\n" +
-	"\x15ProjectSettingService\x12\x92\x01\n" +
-	"\x14CreateProjectSetting\x12&.libops.v1.CreateProjectSettingRequest\x1a'.libops.v1.CreateProjectSettingResponse\")\x92\xb5\x18%\b\x04\x10\x02\x18\x01\"\x0fmanage_settings2\n" +
-	"project_id8\x04\x12\x88\x01\n" +
-	"\x11GetProjectSetting\x12#.libops.v1.GetProjectSettingRequest\x1a$.libops.v1.GetProjectSettingResponse\"(\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread_settings*\n" +
-	"project_id\x90\x02\x01\x12\x8e\x01\n" +
-	"\x13ListProjectSettings\x12%.libops.v1.ListProjectSettingsRequest\x1a&.libops.v1.ListProjectSettingsResponse\"(\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread_settings*\n" +
-	"project_id\x90\x02\x01\x12\x90\x01\n" +
-	"\x14UpdateProjectSetting\x12&.libops.v1.UpdateProjectSettingRequest\x1a'.libops.v1.UpdateProjectSettingResponse\"'\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fmanage_settings*\n" +
-	"project_id\x12\x7f\n" +
-	"\x14DeleteProjectSetting\x12&.libops.v1.DeleteProjectSettingRequest\x1a\x16.google.protobuf.Empty\"'\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fmanage_settings*\n" +
-	"project_id2\x9f\x05\n" +
-	"\x12SiteSettingService\x12\x86\x01\n" +
-	"\x11CreateSiteSetting\x12#.libops.v1.CreateSiteSettingRequest\x1a$.libops.v1.CreateSiteSettingResponse\"&\x92\xb5\x18\"\b\x05\x10\x02\x18\x01\"\x0fmanage_settings2\asite_id8\x05\x12|\n" +
-	"\x0eGetSiteSetting\x12 .libops.v1.GetSiteSettingRequest\x1a!.libops.v1.GetSiteSettingResponse\"%\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread_settings*\asite_id\x90\x02\x01\x12\x82\x01\n" +
-	"\x10ListSiteSettings\x12\".libops.v1.ListSiteSettingsRequest\x1a#.libops.v1.ListSiteSettingsResponse\"%\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread_settings*\asite_id\x90\x02\x01\x12\x84\x01\n" +
-	"\x11UpdateSiteSetting\x12#.libops.v1.UpdateSiteSettingRequest\x1a$.libops.v1.UpdateSiteSettingResponse\"$\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fmanage_settings*\asite_id\x12v\n" +
-	"\x11DeleteSiteSetting\x12#.libops.v1.DeleteSiteSettingRequest\x1a\x16.google.protobuf.Empty\"$\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fmanage_settings*\asite_idB\x93\x01\n" +
+	"setting_id\x18\x02 \x01(\tR\tsettingId*\xab\x01\n" +
+	"\x10SettingValueType\x12\"\n" +
+	"\x1eSETTING_VALUE_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SETTING_VALUE_TYPE_STRING\x10\x01\x12\x1a\n" +
+	"\x16SETTING_VALUE_TYPE_INT\x10\x02\x12\x1b\n" +
+	"\x17SETTING_VALUE_TYPE_BOOL\x10\x03\x12\x1b\n" +
+	"\x17SETTING_VALUE_TYPE_ENUM\x10\x042\xf2\b\n" +
+	"\x1aOrganizationSettingService\x12\xdd\x01\n" +
+	"\x19CreateOrganizationSetting\x12+.libops.v1.CreateOrganizationSettingRequest\x1a,.libops.v1.CreateOrganizationSettingResponse\"e\x92\xb5\x18*\b\x03\x10\x02\x18\x01\"\x0fmanage_settings2\x0forganization_id8\x03\x82\xd3\xe4\x93\x021:\x01*\",/v1/organizations/{organization_id}/settings\x12\xdd\x01\n" +
+	"\x16GetOrganizationSetting\x12(.libops.v1.GetOrganizationSettingRequest\x1a).libops.v1.GetOrganizationSettingResponse\"n\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread_settings*\x0forganization_id\x82\xd3\xe4\x93\x02;\x129/v1/organizations/{organization_id}/settings/{setting_id}\x90\x02\x01\x12\xd6\x01\n" +
+	"\x18ListOrganizationSettings\x12*.libops.v1.ListOrganizationSettingsRequest\x1a+.libops.v1.ListOrganizationSettingsResponse\"a\x92\xb5\x18&\b\x03\x10\x01\x18\x01\"\rread_settings*\x0forganization_id\x82\xd3\xe4\x93\x02.\x12,/v1/organizations/{organization_id}/settings\x90\x02\x01\x12\xe8\x01\n" +
+	"\x19UpdateOrganizationSetting\x12+.libops.v1.UpdateOrganizationSettingRequest\x1a,.libops.v1.UpdateOrganizationSettingResponse\"p\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fmanage_settings*\x0forganization_id\x82\xd3\xe4\x93\x02>:\x01*29/v1/organizations/{organization_id}/settings/{setting_id}\x12\xcf\x01\n" +
+	"\x19DeleteOrganizationSetting\x12+.libops.v1.DeleteOrganizationSettingRequest\x1a\x16.google.protobuf.Empty\"m\x92\xb5\x18(\b\x03\x10\x02\x18\x01\"\x0fmanage_settings*\x0forganization_id\x82\xd3\xe4\x93\x02;*9/v1/organizations/{organization_id}/settings/{setting_id}2\xdc\a\n" +
+	"\x15ProjectSettingService\x12\xbf\x01\n" +
+	"\x14CreateProjectSetting\x12&.libops.v1.CreateProjectSettingRequest\x1a'.libops.v1.CreateProjectSettingResponse\"V\x92\xb5\x18%\b\x04\x10\x02\x18\x01\"\x0fmanage_settings2\n" +
+	"project_id8\x04\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/projects/{project_id}/settings\x12\xbf\x01\n" +
+	"\x11GetProjectSetting\x12#.libops.v1.GetProjectSettingRequest\x1a$.libops.v1.GetProjectSettingResponse\"_\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread_settings*\n" +
+	"project_id\x82\xd3\xe4\x93\x021\x12//v1/projects/{project_id}/settings/{setting_id}\x90\x02\x01\x12\xb8\x01\n" +
+	"\x13ListProjectSettings\x12%.libops.v1.ListProjectSettingsRequest\x1a&.libops.v1.ListProjectSettingsResponse\"R\x92\xb5\x18!\b\x04\x10\x01\x18\x01\"\rread_settings*\n" +
+	"project_id\x82\xd3\xe4\x93\x02$\x12\"/v1/projects/{project_id}/settings\x90\x02\x01\x12\xca\x01\n" +
+	"\x14UpdateProjectSetting\x12&.libops.v1.UpdateProjectSettingRequest\x1a'.libops.v1.UpdateProjectSettingResponse\"a\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fmanage_settings*\n" +
+	"project_id\x82\xd3\xe4\x93\x024:\x01*2//v1/projects/{project_id}/settings/{setting_id}\x12\xb6\x01\n" +
+	"\x14DeleteProjectSetting\x12&.libops.v1.DeleteProjectSettingRequest\x1a\x16.google.protobuf.Empty\"^\x92\xb5\x18#\b\x04\x10\x02\x18\x01\"\x0fmanage_settings*\n" +
+	"project_id\x82\xd3\xe4\x93\x021*//v1/projects/{project_id}/settings/{setting_id}2\x82\a\n" +
+	"\x12SiteSettingService\x12\xad\x01\n" +
+	"\x11CreateSiteSetting\x12#.libops.v1.CreateSiteSettingRequest\x1a$.libops.v1.CreateSiteSettingResponse\"M\x92\xb5\x18\"\b\x05\x10\x02\x18\x01\"\x0fmanage_settings2\asite_id8\x05\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/sites/{site_id}/settings\x12\xad\x01\n" +
+	"\x0eGetSiteSetting\x12 .libops.v1.GetSiteSettingRequest\x1a!.libops.v1.GetSiteSettingResponse\"V\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread_settings*\asite_id\x82\xd3\xe4\x93\x02+\x12)/v1/sites/{site_id}/settings/{setting_id}\x90\x02\x01\x12\xa6\x01\n" +
+	"\x10ListSiteSettings\x12\".libops.v1.ListSiteSettingsRequest\x1a#.libops.v1.ListSiteSettingsResponse\"I\x92\xb5\x18\x1e\b\x05\x10\x01\x18\x01\"\rread_settings*\asite_id\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/sites/{site_id}/settings\x90\x02\x01\x12\xb8\x01\n" +
+	"\x11UpdateSiteSetting\x12#.libops.v1.UpdateSiteSettingRequest\x1a$.libops.v1.UpdateSiteSettingResponse\"X\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fmanage_settings*\asite_id\x82\xd3\xe4\x93\x02.:\x01*2)/v1/sites/{site_id}/settings/{setting_id}\x12\xa7\x01\n" +
+	"\x11DeleteSiteSetting\x12#.libops.v1.DeleteSiteSettingRequest\x1a\x16.google.protobuf.Empty\"U\x92\xb5\x18 \b\x05\x10\x02\x18\x01\"\x0fmanage_settings*\asite_id\x82\xd3\xe4\x93\x02+*)/v1/sites/{site_id}/settings/{setting_id}B\x93\x01\n" +
 	"\rcom.libops.v1B\rSettingsProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
 	"Libops::V1b\x06proto3"
 
@@ -1961,96 +2169,104 @@ func file_libops_v1_settings_proto_rawDescGZIP() []byte {
 	return file_libops_v1_settings_proto_rawDescData
 }
 
+var file_libops_v1_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_libops_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_libops_v1_settings_proto_goTypes = []any{
-	(*OrganizationSetting)(nil),               // 0: libops.v1.OrganizationSetting
-	(*ProjectSetting)(nil),                    // 1: libops.v1.ProjectSetting
-	(*SiteSetting)(nil),                       // 2: libops.v1.SiteSetting
-	(*CreateOrganizationSettingRequest)(nil),  // 3: libops.v1.CreateOrganizationSettingRequest
-	(*CreateOrganizationSettingResponse)(nil), // 4: libops.v1.CreateOrganizationSettingResponse
-	(*GetOrganizationSettingRequest)(nil),     // 5: libops.v1.GetOrganizationSettingRequest
-	(*GetOrganizationSettingResponse)(nil),    // 6: libops.v1.GetOrganizationSettingResponse
-	(*ListOrganizationSettingsRequest)(nil),   // 7: libops.v1.ListOrganizationSettingsRequest
-	(*ListOrganizationSettingsResponse)(nil),  // 8: libops.v1.ListOrganizationSettingsResponse
-	(*UpdateOrganizationSettingRequest)(nil),  // 9: libops.v1.UpdateOrganizationSettingRequest
-	(*UpdateOrganizationSettingResponse)(nil), // 10: libops.v1.UpdateOrganizationSettingResponse
-	(*DeleteOrganizationSettingRequest)(nil),  // 11: libops.v1.DeleteOrganizationSettingRequest
-	(*CreateProjectSettingRequest)(nil),       // 12: libops.v1.CreateProjectSettingRequest
-	(*CreateProjectSettingResponse)(nil),      // 13: libops.v1.CreateProjectSettingResponse
-	(*GetProjectSettingRequest)(nil),          // 14: libops.v1.GetProjectSettingRequest
-	(*GetProjectSettingResponse)(nil),         // 15: libops.v1.GetProjectSettingResponse
-	(*ListProjectSettingsRequest)(nil),        // 16: libops.v1.ListProjectSettingsRequest
-	(*ListProjectSettingsResponse)(nil),       // 17: libops.v1.ListProjectSettingsResponse
-	(*UpdateProjectSettingRequest)(nil),       // 18: libops.v1.UpdateProjectSettingRequest
-	(*UpdateProjectSettingResponse)(nil),      // 19: libops.v1.UpdateProjectSettingResponse
-	(*DeleteProjectSettingRequest)(nil),       // 20: libops.v1.DeleteProjectSettingRequest
-	(*CreateSiteSettingRequest)(nil),          // 21: libops.v1.CreateSiteSettingRequest
-	(*CreateSiteSettingResponse)(nil),         // 22: libops.v1.CreateSiteSettingResponse
-	(*GetSiteSettingRequest)(nil),             // 23: libops.v1.GetSiteSettingRequest
-	(*GetSiteSettingResponse)(nil),            // 24: libops.v1.GetSiteSettingResponse
-	(*ListSiteSettingsRequest)(nil),           // 25: libops.v1.ListSiteSettingsRequest
-	(*ListSiteSettingsResponse)(nil),          // 26: libops.v1.ListSiteSettingsResponse
-	(*UpdateSiteSettingRequest)(nil),          // 27: libops.v1.UpdateSiteSettingRequest
-	(*UpdateSiteSettingResponse)(nil),         // 28: libops.v1.UpdateSiteSettingResponse
-	(*DeleteSiteSettingRequest)(nil),          // 29: libops.v1.DeleteSiteSettingRequest
-	(common.Status)(0),                        // 30: libops.v1.common.Status
-	(*fieldmaskpb.FieldMask)(nil),             // 31: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                     // 32: google.protobuf.Empty
+	(SettingValueType)(0),                     // 0: libops.v1.SettingValueType
+	(*OrganizationSetting)(nil),               // 1: libops.v1.OrganizationSetting
+	(*ProjectSetting)(nil),                    // 2: libops.v1.ProjectSetting
+	(*SiteSetting)(nil),                       // 3: libops.v1.SiteSetting
+	(*CreateOrganizationSettingRequest)(nil),  // 4: libops.v1.CreateOrganizationSettingRequest
+	(*CreateOrganizationSettingResponse)(nil), // 5: libops.v1.CreateOrganizationSettingResponse
+	(*GetOrganizationSettingRequest)(nil),     // 6: libops.v1.GetOrganizationSettingRequest
+	(*GetOrganizationSettingResponse)(nil),    // 7: libops.v1.GetOrganizationSettingResponse
+	(*ListOrganizationSettingsRequest)(nil),   // 8: libops.v1.ListOrganizationSettingsRequest
+	(*ListOrganizationSettingsResponse)(nil),  // 9: libops.v1.ListOrganizationSettingsResponse
+	(*UpdateOrganizationSettingRequest)(nil),  // 10: libops.v1.UpdateOrganizationSettingRequest
+	(*UpdateOrganizationSettingResponse)(nil), // 11: libops.v1.UpdateOrganizationSettingResponse
+	(*DeleteOrganizationSettingRequest)(nil),  // 12: libops.v1.DeleteOrganizationSettingRequest
+	(*CreateProjectSettingRequest)(nil),       // 13: libops.v1.CreateProjectSettingRequest
+	(*CreateProjectSettingResponse)(nil),      // 14: libops.v1.CreateProjectSettingResponse
+	(*GetProjectSettingRequest)(nil),          // 15: libops.v1.GetProjectSettingRequest
+	(*GetProjectSettingResponse)(nil),         // 16: libops.v1.GetProjectSettingResponse
+	(*ListProjectSettingsRequest)(nil),        // 17: libops.v1.ListProjectSettingsRequest
+	(*ListProjectSettingsResponse)(nil),       // 18: libops.v1.ListProjectSettingsResponse
+	(*UpdateProjectSettingRequest)(nil),       // 19: libops.v1.UpdateProjectSettingRequest
+	(*UpdateProjectSettingResponse)(nil),      // 20: libops.v1.UpdateProjectSettingResponse
+	(*DeleteProjectSettingRequest)(nil),       // 21: libops.v1.DeleteProjectSettingRequest
+	(*CreateSiteSettingRequest)(nil),          // 22: libops.v1.CreateSiteSettingRequest
+	(*CreateSiteSettingResponse)(nil),         // 23: libops.v1.CreateSiteSettingResponse
+	(*GetSiteSettingRequest)(nil),             // 24: libops.v1.GetSiteSettingRequest
+	(*GetSiteSettingResponse)(nil),            // 25: libops.v1.GetSiteSettingResponse
+	(*ListSiteSettingsRequest)(nil),           // 26: libops.v1.ListSiteSettingsRequest
+	(*ListSiteSettingsResponse)(nil),          // 27: libops.v1.ListSiteSettingsResponse
+	(*UpdateSiteSettingRequest)(nil),          // 28: libops.v1.UpdateSiteSettingRequest
+	(*UpdateSiteSettingResponse)(nil),         // 29: libops.v1.UpdateSiteSettingResponse
+	(*DeleteSiteSettingRequest)(nil),          // 30: libops.v1.DeleteSiteSettingRequest
+	(common.Status)(0),                        // 31: libops.v1.common.Status
+	(*fieldmaskpb.FieldMask)(nil),             // 32: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                     // 33: google.protobuf.Empty
 }
 var file_libops_v1_settings_proto_depIdxs = []int32{
-	30, // 0: libops.v1.OrganizationSetting.status:type_name -> libops.v1.common.Status
-	30, // 1: libops.v1.ProjectSetting.status:type_name -> libops.v1.common.Status
-	30, // 2: libops.v1.SiteSetting.status:type_name -> libops.v1.common.Status
-	0,  // 3: libops.v1.CreateOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
-	0,  // 4: libops.v1.GetOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
-	0,  // 5: libops.v1.ListOrganizationSettingsResponse.settings:type_name -> libops.v1.OrganizationSetting
-	31, // 6: libops.v1.UpdateOrganizationSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 7: libops.v1.UpdateOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
-	1,  // 8: libops.v1.CreateProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
-	1,  // 9: libops.v1.GetProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
-	1,  // 10: libops.v1.ListProjectSettingsResponse.settings:type_name -> libops.v1.ProjectSetting
-	31, // 11: libops.v1.UpdateProjectSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 12: libops.v1.UpdateProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
-	2,  // 13: libops.v1.CreateSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
-	2,  // 14: libops.v1.GetSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
-	2,  // 15: libops.v1.ListSiteSettingsResponse.settings:type_name -> libops.v1.SiteSetting
-	31, // 16: libops.v1.UpdateSiteSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 17: libops.v1.UpdateSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
-	3,  // 18: libops.v1.OrganizationSettingService.CreateOrganizationSetting:input_type -> libops.v1.CreateOrganizationSettingRequest
-	5,  // 19: libops.v1.OrganizationSettingService.GetOrganizationSetting:input_type -> libops.v1.GetOrganizationSettingRequest
-	7,  // 20: libops.v1.OrganizationSettingService.ListOrganizationSettings:input_type -> libops.v1.ListOrganizationSettingsRequest
-	9,  // 21: libops.v1.OrganizationSettingService.UpdateOrganizationSetting:input_type -> libops.v1.UpdateOrganizationSettingRequest
-	11, // 22: libops.v1.OrganizationSettingService.DeleteOrganizationSetting:input_type -> libops.v1.DeleteOrganizationSettingRequest
-	12, // 23: libops.v1.ProjectSettingService.CreateProjectSetting:input_type -> libops.v1.CreateProjectSettingRequest
-	14, // 24: libops.v1.ProjectSettingService.GetProjectSetting:input_type -> libops.v1.GetProjectSettingRequest
-	16, // 25: libops.v1.ProjectSettingService.ListProjectSettings:input_type -> libops.v1.ListProjectSettingsRequest
-	18, // 26: libops.v1.ProjectSettingService.UpdateProjectSetting:input_type -> libops.v1.UpdateProjectSettingRequest
-	20, // 27: libops.v1.ProjectSettingService.DeleteProjectSetting:input_type -> libops.v1.DeleteProjectSettingRequest
-	21, // 28: libops.v1.SiteSettingService.CreateSiteSetting:input_type -> libops.v1.CreateSiteSettingRequest
-	23, // 29: libops.v1.SiteSettingService.GetSiteSetting:input_type -> libops.v1.GetSiteSettingRequest
-	25, // 30: libops.v1.SiteSettingService.ListSiteSettings:input_type -> libops.v1.ListSiteSettingsRequest
-	27, // 31: libops.v1.SiteSettingService.UpdateSiteSetting:input_type -> libops.v1.UpdateSiteSettingRequest
-	29, // 32: libops.v1.SiteSettingService.DeleteSiteSetting:input_type -> libops.v1.DeleteSiteSettingRequest
-	4,  // 33: libops.v1.OrganizationSettingService.CreateOrganizationSetting:output_type -> libops.v1.CreateOrganizationSettingResponse
-	6,  // 34: libops.v1.OrganizationSettingService.GetOrganizationSetting:output_type -> libops.v1.GetOrganizationSettingResponse
-	8,  // 35: libops.v1.OrganizationSettingService.ListOrganizationSettings:output_type -> libops.v1.ListOrganizationSettingsResponse
-	10, // 36: libops.v1.OrganizationSettingService.UpdateOrganizationSetting:output_type -> libops.v1.UpdateOrganizationSettingResponse
-	32, // 37: libops.v1.OrganizationSettingService.DeleteOrganizationSetting:output_type -> google.protobuf.Empty
-	13, // 38: libops.v1.ProjectSettingService.CreateProjectSetting:output_type -> libops.v1.CreateProjectSettingResponse
-	15, // 39: libops.v1.ProjectSettingService.GetProjectSetting:output_type -> libops.v1.GetProjectSettingResponse
-	17, // 40: libops.v1.ProjectSettingService.ListProjectSettings:output_type -> libops.v1.ListProjectSettingsResponse
-	19, // 41: libops.v1.ProjectSettingService.UpdateProjectSetting:output_type -> libops.v1.UpdateProjectSettingResponse
-	32, // 42: libops.v1.ProjectSettingService.DeleteProjectSetting:output_type -> google.protobuf.Empty
-	22, // 43: libops.v1.SiteSettingService.CreateSiteSetting:output_type -> libops.v1.CreateSiteSettingResponse
-	24, // 44: libops.v1.SiteSettingService.GetSiteSetting:output_type -> libops.v1.GetSiteSettingResponse
-	26, // 45: libops.v1.SiteSettingService.ListSiteSettings:output_type -> libops.v1.ListSiteSettingsResponse
-	28, // 46: libops.v1.SiteSettingService.UpdateSiteSetting:output_type -> libops.v1.UpdateSiteSettingResponse
-	32, // 47: libops.v1.SiteSettingService.DeleteSiteSetting:output_type -> google.protobuf.Empty
-	33, // [33:48] is the sub-list for method output_type
-	18, // [18:33] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	31, // 0: libops.v1.OrganizationSetting.status:type_name -> libops.v1.common.Status
+	0,  // 1: libops.v1.OrganizationSetting.value_type:type_name -> libops.v1.SettingValueType
+	31, // 2: libops.v1.ProjectSetting.status:type_name -> libops.v1.common.Status
+	0,  // 3: libops.v1.ProjectSetting.value_type:type_name -> libops.v1.SettingValueType
+	31, // 4: libops.v1.SiteSetting.status:type_name -> libops.v1.common.Status
+	0,  // 5: libops.v1.SiteSetting.value_type:type_name -> libops.v1.SettingValueType
+	0,  // 6: libops.v1.CreateOrganizationSettingRequest.value_type:type_name -> libops.v1.SettingValueType
+	1,  // 7: libops.v1.CreateOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
+	1,  // 8: libops.v1.GetOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
+	1,  // 9: libops.v1.ListOrganizationSettingsResponse.settings:type_name -> libops.v1.OrganizationSetting
+	32, // 10: libops.v1.UpdateOrganizationSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 11: libops.v1.UpdateOrganizationSettingResponse.setting:type_name -> libops.v1.OrganizationSetting
+	0,  // 12: libops.v1.CreateProjectSettingRequest.value_type:type_name -> libops.v1.SettingValueType
+	2,  // 13: libops.v1.CreateProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
+	2,  // 14: libops.v1.GetProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
+	2,  // 15: libops.v1.ListProjectSettingsResponse.settings:type_name -> libops.v1.ProjectSetting
+	32, // 16: libops.v1.UpdateProjectSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 17: libops.v1.UpdateProjectSettingResponse.setting:type_name -> libops.v1.ProjectSetting
+	0,  // 18: libops.v1.CreateSiteSettingRequest.value_type:type_name -> libops.v1.SettingValueType
+	3,  // 19: libops.v1.CreateSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
+	3,  // 20: libops.v1.GetSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
+	3,  // 21: libops.v1.ListSiteSettingsResponse.settings:type_name -> libops.v1.SiteSetting
+	32, // 22: libops.v1.UpdateSiteSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 23: libops.v1.UpdateSiteSettingResponse.setting:type_name -> libops.v1.SiteSetting
+	4,  // 24: libops.v1.OrganizationSettingService.CreateOrganizationSetting:input_type -> libops.v1.CreateOrganizationSettingRequest
+	6,  // 25: libops.v1.OrganizationSettingService.GetOrganizationSetting:input_type -> libops.v1.GetOrganizationSettingRequest
+	8,  // 26: libops.v1.OrganizationSettingService.ListOrganizationSettings:input_type -> libops.v1.ListOrganizationSettingsRequest
+	10, // 27: libops.v1.OrganizationSettingService.UpdateOrganizationSetting:input_type -> libops.v1.UpdateOrganizationSettingRequest
+	12, // 28: libops.v1.OrganizationSettingService.DeleteOrganizationSetting:input_type -> libops.v1.DeleteOrganizationSettingRequest
+	13, // 29: libops.v1.ProjectSettingService.CreateProjectSetting:input_type -> libops.v1.CreateProjectSettingRequest
+	15, // 30: libops.v1.ProjectSettingService.GetProjectSetting:input_type -> libops.v1.GetProjectSettingRequest
+	17, // 31: libops.v1.ProjectSettingService.ListProjectSettings:input_type -> libops.v1.ListProjectSettingsRequest
+	19, // 32: libops.v1.ProjectSettingService.UpdateProjectSetting:input_type -> libops.v1.UpdateProjectSettingRequest
+	21, // 33: libops.v1.ProjectSettingService.DeleteProjectSetting:input_type -> libops.v1.DeleteProjectSettingRequest
+	22, // 34: libops.v1.SiteSettingService.CreateSiteSetting:input_type -> libops.v1.CreateSiteSettingRequest
+	24, // 35: libops.v1.SiteSettingService.GetSiteSetting:input_type -> libops.v1.GetSiteSettingRequest
+	26, // 36: libops.v1.SiteSettingService.ListSiteSettings:input_type -> libops.v1.ListSiteSettingsRequest
+	28, // 37: libops.v1.SiteSettingService.UpdateSiteSetting:input_type -> libops.v1.UpdateSiteSettingRequest
+	30, // 38: libops.v1.SiteSettingService.DeleteSiteSetting:input_type -> libops.v1.DeleteSiteSettingRequest
+	5,  // 39: libops.v1.OrganizationSettingService.CreateOrganizationSetting:output_type -> libops.v1.CreateOrganizationSettingResponse
+	7,  // 40: libops.v1.OrganizationSettingService.GetOrganizationSetting:output_type -> libops.v1.GetOrganizationSettingResponse
+	9,  // 41: libops.v1.OrganizationSettingService.ListOrganizationSettings:output_type -> libops.v1.ListOrganizationSettingsResponse
+	11, // 42: libops.v1.OrganizationSettingService.UpdateOrganizationSetting:output_type -> libops.v1.UpdateOrganizationSettingResponse
+	33, // 43: libops.v1.OrganizationSettingService.DeleteOrganizationSetting:output_type -> google.protobuf.Empty
+	14, // 44: libops.v1.ProjectSettingService.CreateProjectSetting:output_type -> libops.v1.CreateProjectSettingResponse
+	16, // 45: libops.v1.ProjectSettingService.GetProjectSetting:output_type -> libops.v1.GetProjectSettingResponse
+	18, // 46: libops.v1.ProjectSettingService.ListProjectSettings:output_type -> libops.v1.ListProjectSettingsResponse
+	20, // 47: libops.v1.ProjectSettingService.UpdateProjectSetting:output_type -> libops.v1.UpdateProjectSettingResponse
+	33, // 48: libops.v1.ProjectSettingService.DeleteProjectSetting:output_type -> google.protobuf.Empty
+	23, // 49: libops.v1.SiteSettingService.CreateSiteSetting:output_type -> libops.v1.CreateSiteSettingResponse
+	25, // 50: libops.v1.SiteSettingService.GetSiteSetting:output_type -> libops.v1.GetSiteSettingResponse
+	27, // 51: libops.v1.SiteSettingService.ListSiteSettings:output_type -> libops.v1.ListSiteSettingsResponse
+	29, // 52: libops.v1.SiteSettingService.UpdateSiteSetting:output_type -> libops.v1.UpdateSiteSettingResponse
+	33, // 53: libops.v1.SiteSettingService.DeleteSiteSetting:output_type -> google.protobuf.Empty
+	39, // [39:54] is the sub-list for method output_type
+	24, // [24:39] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_libops_v1_settings_proto_init() }
@@ -2066,13 +2282,14 @@ func file_libops_v1_settings_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_settings_proto_rawDesc), len(file_libops_v1_settings_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
 		GoTypes:           file_libops_v1_settings_proto_goTypes,
 		DependencyIndexes: file_libops_v1_settings_proto_depIdxs,
+		EnumInfos:         file_libops_v1_settings_proto_enumTypes,
 		MessageInfos:      file_libops_v1_settings_proto_msgTypes,
 	}.Build()
 	File_libops_v1_settings_proto = out.File
@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/ssh_access.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SshAccess struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	SiteId    string                 `protobuf:"bytes,2,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The account gets a chrooted, shell-less SFTP login to the site's files
+	// directory instead of a shell
+	SftpOnly      bool  `protobuf:"varint,5,opt,name=sftp_only,json=sftpOnly,proto3" json:"sftp_only,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SshAccess) Reset() {
+	*x = SshAccess{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SshAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshAccess) ProtoMessage() {}
+
+func (x *SshAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshAccess.ProtoReflect.Descriptor instead.
+func (*SshAccess) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SshAccess) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SshAccess) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SshAccess) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SshAccess) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SshAccess) GetSftpOnly() bool {
+	if x != nil {
+		return x.SftpOnly
+	}
+	return false
+}
+
+func (x *SshAccess) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListSshAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSshAccessRequest) Reset() {
+	*x = ListSshAccessRequest{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSshAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSshAccessRequest) ProtoMessage() {}
+
+func (x *ListSshAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSshAccessRequest.ProtoReflect.Descriptor instead.
+func (*ListSshAccessRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSshAccessRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type ListSshAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SshAccess     []*SshAccess           `protobuf:"bytes,1,rep,name=ssh_access,json=sshAccess,proto3" json:"ssh_access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSshAccessResponse) Reset() {
+	*x = ListSshAccessResponse{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSshAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSshAccessResponse) ProtoMessage() {}
+
+func (x *ListSshAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSshAccessResponse.ProtoReflect.Descriptor instead.
+func (*ListSshAccessResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSshAccessResponse) GetSshAccess() []*SshAccess {
+	if x != nil {
+		return x.SshAccess
+	}
+	return nil
+}
+
+type GrantSshAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Must be a member of the site
+	SftpOnly      bool                   `protobuf:"varint,3,opt,name=sftp_only,json=sftpOnly,proto3" json:"sftp_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantSshAccessRequest) Reset() {
+	*x = GrantSshAccessRequest{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantSshAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantSshAccessRequest) ProtoMessage() {}
+
+func (x *GrantSshAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantSshAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantSshAccessRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GrantSshAccessRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GrantSshAccessRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GrantSshAccessRequest) GetSftpOnly() bool {
+	if x != nil {
+		return x.SftpOnly
+	}
+	return false
+}
+
+type GrantSshAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SshAccess     *SshAccess             `protobuf:"bytes,1,opt,name=ssh_access,json=sshAccess,proto3" json:"ssh_access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantSshAccessResponse) Reset() {
+	*x = GrantSshAccessResponse{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantSshAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantSshAccessResponse) ProtoMessage() {}
+
+func (x *GrantSshAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantSshAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantSshAccessResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GrantSshAccessResponse) GetSshAccess() *SshAccess {
+	if x != nil {
+		return x.SshAccess
+	}
+	return nil
+}
+
+type UpdateSshAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	SftpOnly      bool                   `protobuf:"varint,3,opt,name=sftp_only,json=sftpOnly,proto3" json:"sftp_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSshAccessRequest) Reset() {
+	*x = UpdateSshAccessRequest{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSshAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSshAccessRequest) ProtoMessage() {}
+
+func (x *UpdateSshAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSshAccessRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSshAccessRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateSshAccessRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSshAccessRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UpdateSshAccessRequest) GetSftpOnly() bool {
+	if x != nil {
+		return x.SftpOnly
+	}
+	return false
+}
+
+type UpdateSshAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SshAccess     *SshAccess             `protobuf:"bytes,1,opt,name=ssh_access,json=sshAccess,proto3" json:"ssh_access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSshAccessResponse) Reset() {
+	*x = UpdateSshAccessResponse{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSshAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSshAccessResponse) ProtoMessage() {}
+
+func (x *UpdateSshAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSshAccessResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSshAccessResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateSshAccessResponse) GetSshAccess() *SshAccess {
+	if x != nil {
+		return x.SshAccess
+	}
+	return nil
+}
+
+type RevokeSshAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSshAccessRequest) Reset() {
+	*x = RevokeSshAccessRequest{}
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSshAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSshAccessRequest) ProtoMessage() {}
+
+func (x *RevokeSshAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_ssh_access_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSshAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSshAccessRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_ssh_access_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeSshAccessRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *RevokeSshAccessRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+var File_libops_v1_ssh_access_proto protoreflect.FileDescriptor
+
+const file_libops_v1_ssh_access_proto_rawDesc = "" +
+	"\n" +
+	"\x1alibops/v1/ssh_access.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\xa9\x01\n" +
+	"\tSshAccess\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\asite_id\x18\x02 \x01(\tR\x06siteId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tsftp_only\x18\x05 \x01(\bR\bsftpOnly\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"/\n" +
+	"\x14ListSshAccessRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"L\n" +
+	"\x15ListSshAccessResponse\x123\n" +
+	"\n" +
+	"ssh_access\x18\x01 \x03(\v2\x14.libops.v1.SshAccessR\tsshAccess\"l\n" +
+	"\x15GrantSshAccessRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tsftp_only\x18\x03 \x01(\bR\bsftpOnly\"M\n" +
+	"\x16GrantSshAccessResponse\x123\n" +
+	"\n" +
+	"ssh_access\x18\x01 \x01(\v2\x14.libops.v1.SshAccessR\tsshAccess\"m\n" +
+	"\x16UpdateSshAccessRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1b\n" +
+	"\tsftp_only\x18\x03 \x01(\bR\bsftpOnly\"N\n" +
+	"\x17UpdateSshAccessResponse\x123\n" +
+	"\n" +
+	"ssh_access\x18\x01 \x01(\v2\x14.libops.v1.SshAccessR\tsshAccess\"P\n" +
+	"\x16RevokeSshAccessRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId2\xb0\x05\n" +
+	"\x10SshAccessService\x12\x9d\x01\n" +
+	"\rListSshAccess\x12\x1f.libops.v1.ListSshAccessRequest\x1a .libops.v1.ListSshAccessResponse\"I\x92\xb5\x18\x1d\b\x05\x10\x01\x18\x01\"\fread:members*\asite_id\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/sites/{site_id}/sshAccess\x90\x02\x01\x12\xa1\x01\n" +
+	"\x0eGrantSshAccess\x12 .libops.v1.GrantSshAccessRequest\x1a!.libops.v1.GrantSshAccessResponse\"J\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sites/{site_id}/sshAccess\x12\xb1\x01\n" +
+	"\x0fUpdateSshAccess\x12!.libops.v1.UpdateSshAccessRequest\x1a\".libops.v1.UpdateSshAccessResponse\"W\x92\xb5\x18\x1e\b\x05\x10\x03\x18\x01\"\rwrite:members*\asite_id\x82\xd3\xe4\x93\x02/:\x01*2*/v1/sites/{site_id}/sshAccess/{account_id}\x12\xa3\x01\n" +
+	"\x0fRevokeSshAccess\x12!.libops.v1.RevokeSshAccessRequest\x1a\x16.google.protobuf.Empty\"U\x92\xb5\x18\x1f\b\x05\x10\x03\x18\x01\"\x0edelete:members*\asite_id\x82\xd3\xe4\x93\x02,**/v1/sites/{site_id}/sshAccess/{account_id}B\x94\x01\n" +
+	"\rcom.libops.v1B\x0eSshAccessProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_ssh_access_proto_rawDescOnce sync.Once
+	file_libops_v1_ssh_access_proto_rawDescData []byte
+)
+
+func file_libops_v1_ssh_access_proto_rawDescGZIP() []byte {
+	file_libops_v1_ssh_access_proto_rawDescOnce.Do(func() {
+		file_libops_v1_ssh_access_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_ssh_access_proto_rawDesc), len(file_libops_v1_ssh_access_proto_rawDesc)))
+	})
+	return file_libops_v1_ssh_access_proto_rawDescData
+}
+
+var file_libops_v1_ssh_access_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_libops_v1_ssh_access_proto_goTypes = []any{
+	(*SshAccess)(nil),               // 0: libops.v1.SshAccess
+	(*ListSshAccessRequest)(nil),    // 1: libops.v1.ListSshAccessRequest
+	(*ListSshAccessResponse)(nil),   // 2: libops.v1.ListSshAccessResponse
+	(*GrantSshAccessRequest)(nil),   // 3: libops.v1.GrantSshAccessRequest
+	(*GrantSshAccessResponse)(nil),  // 4: libops.v1.GrantSshAccessResponse
+	(*UpdateSshAccessRequest)(nil),  // 5: libops.v1.UpdateSshAccessRequest
+	(*UpdateSshAccessResponse)(nil), // 6: libops.v1.UpdateSshAccessResponse
+	(*RevokeSshAccessRequest)(nil),  // 7: libops.v1.RevokeSshAccessRequest
+	(*emptypb.Empty)(nil),           // 8: google.protobuf.Empty
+}
+var file_libops_v1_ssh_access_proto_depIdxs = []int32{
+	0, // 0: libops.v1.ListSshAccessResponse.ssh_access:type_name -> libops.v1.SshAccess
+	0, // 1: libops.v1.GrantSshAccessResponse.ssh_access:type_name -> libops.v1.SshAccess
+	0, // 2: libops.v1.UpdateSshAccessResponse.ssh_access:type_name -> libops.v1.SshAccess
+	1, // 3: libops.v1.SshAccessService.ListSshAccess:input_type -> libops.v1.ListSshAccessRequest
+	3, // 4: libops.v1.SshAccessService.GrantSshAccess:input_type -> libops.v1.GrantSshAccessRequest
+	5, // 5: libops.v1.SshAccessService.UpdateSshAccess:input_type -> libops.v1.UpdateSshAccessRequest
+	7, // 6: libops.v1.SshAccessService.RevokeSshAccess:input_type -> libops.v1.RevokeSshAccessRequest
+	2, // 7: libops.v1.SshAccessService.ListSshAccess:output_type -> libops.v1.ListSshAccessResponse
+	4, // 8: libops.v1.SshAccessService.GrantSshAccess:output_type -> libops.v1.GrantSshAccessResponse
+	6, // 9: libops.v1.SshAccessService.UpdateSshAccess:output_type -> libops.v1.UpdateSshAccessResponse
+	8, // 10: libops.v1.SshAccessService.RevokeSshAccess:output_type -> google.protobuf.Empty
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_ssh_access_proto_init() }
+func file_libops_v1_ssh_access_proto_init() {
+	if File_libops_v1_ssh_access_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_ssh_access_proto_rawDesc), len(file_libops_v1_ssh_access_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_ssh_access_proto_goTypes,
+		DependencyIndexes: file_libops_v1_ssh_access_proto_depIdxs,
+		MessageInfos:      file_libops_v1_ssh_access_proto_msgTypes,
+	}.Build()
+	File_libops_v1_ssh_access_proto = out.File
+	file_libops_v1_ssh_access_proto_goTypes = nil
+	file_libops_v1_ssh_access_proto_depIdxs = nil
+}
@@ -0,0 +1,1137 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/status_page.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatusPageUpdateStatus int32
+
+const (
+	StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED   StatusPageUpdateStatus = 0
+	StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_INVESTIGATING StatusPageUpdateStatus = 1
+	StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_IDENTIFIED    StatusPageUpdateStatus = 2
+	StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_MONITORING    StatusPageUpdateStatus = 3
+	StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_RESOLVED      StatusPageUpdateStatus = 4
+)
+
+// Enum value maps for StatusPageUpdateStatus.
+var (
+	StatusPageUpdateStatus_name = map[int32]string{
+		0: "STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED",
+		1: "STATUS_PAGE_UPDATE_STATUS_INVESTIGATING",
+		2: "STATUS_PAGE_UPDATE_STATUS_IDENTIFIED",
+		3: "STATUS_PAGE_UPDATE_STATUS_MONITORING",
+		4: "STATUS_PAGE_UPDATE_STATUS_RESOLVED",
+	}
+	StatusPageUpdateStatus_value = map[string]int32{
+		"STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED":   0,
+		"STATUS_PAGE_UPDATE_STATUS_INVESTIGATING": 1,
+		"STATUS_PAGE_UPDATE_STATUS_IDENTIFIED":    2,
+		"STATUS_PAGE_UPDATE_STATUS_MONITORING":    3,
+		"STATUS_PAGE_UPDATE_STATUS_RESOLVED":      4,
+	}
+)
+
+func (x StatusPageUpdateStatus) Enum() *StatusPageUpdateStatus {
+	p := new(StatusPageUpdateStatus)
+	*p = x
+	return p
+}
+
+func (x StatusPageUpdateStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusPageUpdateStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_status_page_proto_enumTypes[0].Descriptor()
+}
+
+func (StatusPageUpdateStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_status_page_proto_enumTypes[0]
+}
+
+func (x StatusPageUpdateStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusPageUpdateStatus.Descriptor instead.
+func (StatusPageUpdateStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{0}
+}
+
+type StatusPageSite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"` // UUID
+	SiteName      string                 `protobuf:"bytes,2,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // Shown on the page instead of the site name when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusPageSite) Reset() {
+	*x = StatusPageSite{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusPageSite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusPageSite) ProtoMessage() {}
+
+func (x *StatusPageSite) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusPageSite.ProtoReflect.Descriptor instead.
+func (*StatusPageSite) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatusPageSite) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *StatusPageSite) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *StatusPageSite) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type StatusPage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StatusPageId   string                 `protobuf:"bytes,1,opt,name=status_page_id,json=statusPageId,proto3" json:"status_page_id,omitempty"`     // UUID
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // UUID
+	Slug           string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                                           // DNS label the page is served under
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Public         bool                   `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"` // Private pages are only shown to organization members
+	Url            string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`        // Where the page is served
+	Sites          []*StatusPageSite      `protobuf:"bytes,7,rep,name=sites,proto3" json:"sites,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	UpdatedAt      int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatusPage) Reset() {
+	*x = StatusPage{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusPage) ProtoMessage() {}
+
+func (x *StatusPage) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusPage.ProtoReflect.Descriptor instead.
+func (*StatusPage) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusPage) GetStatusPageId() string {
+	if x != nil {
+		return x.StatusPageId
+	}
+	return ""
+}
+
+func (x *StatusPage) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *StatusPage) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *StatusPage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StatusPage) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *StatusPage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StatusPage) GetSites() []*StatusPageSite {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *StatusPage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *StatusPage) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type StatusPageUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdateId      string                 `protobuf:"bytes,1,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"` // UUID
+	Status        StatusPageUpdateStatus `protobuf:"varint,2,opt,name=status,proto3,enum=libops.v1.StatusPageUpdateStatus" json:"status,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	IncidentId    string                 `protobuf:"bytes,5,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"` // Site incident the update is about, empty when none
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`   // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusPageUpdate) Reset() {
+	*x = StatusPageUpdate{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusPageUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusPageUpdate) ProtoMessage() {}
+
+func (x *StatusPageUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusPageUpdate.ProtoReflect.Descriptor instead.
+func (*StatusPageUpdate) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatusPageUpdate) GetUpdateId() string {
+	if x != nil {
+		return x.UpdateId
+	}
+	return ""
+}
+
+func (x *StatusPageUpdate) GetStatus() StatusPageUpdateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED
+}
+
+func (x *StatusPageUpdate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *StatusPageUpdate) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StatusPageUpdate) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+func (x *StatusPageUpdate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetStatusPageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStatusPageRequest) Reset() {
+	*x = GetStatusPageRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusPageRequest) ProtoMessage() {}
+
+func (x *GetStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStatusPageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetStatusPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusPage    *StatusPage            `protobuf:"bytes,1,opt,name=status_page,json=statusPage,proto3" json:"status_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusPageResponse) Reset() {
+	*x = GetStatusPageResponse{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusPageResponse) ProtoMessage() {}
+
+func (x *GetStatusPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusPageResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusPageResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStatusPageResponse) GetStatusPage() *StatusPage {
+	if x != nil {
+		return x.StatusPage
+	}
+	return nil
+}
+
+type UpdateStatusPageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Lowercase letters, digits and hyphens, e.g. "acme" for status.acme.libops.site
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Public        bool   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatusPageRequest) Reset() {
+	*x = UpdateStatusPageRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatusPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatusPageRequest) ProtoMessage() {}
+
+func (x *UpdateStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateStatusPageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateStatusPageRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateStatusPageRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateStatusPageRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type UpdateStatusPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusPage    *StatusPage            `protobuf:"bytes,1,opt,name=status_page,json=statusPage,proto3" json:"status_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatusPageResponse) Reset() {
+	*x = UpdateStatusPageResponse{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatusPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatusPageResponse) ProtoMessage() {}
+
+func (x *UpdateStatusPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatusPageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStatusPageResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateStatusPageResponse) GetStatusPage() *StatusPage {
+	if x != nil {
+		return x.StatusPage
+	}
+	return nil
+}
+
+type DeleteStatusPageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteStatusPageRequest) Reset() {
+	*x = DeleteStatusPageRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStatusPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatusPageRequest) ProtoMessage() {}
+
+func (x *DeleteStatusPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStatusPageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatusPageRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteStatusPageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type SetStatusPageSitesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// At most 50, listed in this order. Sites must belong to the organization.
+	Sites         []*StatusPageSite `protobuf:"bytes,2,rep,name=sites,proto3" json:"sites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStatusPageSitesRequest) Reset() {
+	*x = SetStatusPageSitesRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStatusPageSitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStatusPageSitesRequest) ProtoMessage() {}
+
+func (x *SetStatusPageSitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStatusPageSitesRequest.ProtoReflect.Descriptor instead.
+func (*SetStatusPageSitesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetStatusPageSitesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetStatusPageSitesRequest) GetSites() []*StatusPageSite {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+type SetStatusPageSitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusPage    *StatusPage            `protobuf:"bytes,1,opt,name=status_page,json=statusPage,proto3" json:"status_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStatusPageSitesResponse) Reset() {
+	*x = SetStatusPageSitesResponse{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStatusPageSitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStatusPageSitesResponse) ProtoMessage() {}
+
+func (x *SetStatusPageSitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStatusPageSitesResponse.ProtoReflect.Descriptor instead.
+func (*SetStatusPageSitesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetStatusPageSitesResponse) GetStatusPage() *StatusPage {
+	if x != nil {
+		return x.StatusPage
+	}
+	return nil
+}
+
+type PostStatusPageUpdateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Status         StatusPageUpdateStatus `protobuf:"varint,2,opt,name=status,proto3,enum=libops.v1.StatusPageUpdateStatus" json:"status,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Optional site incident the update is about
+	IncidentId    string `protobuf:"bytes,5,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostStatusPageUpdateRequest) Reset() {
+	*x = PostStatusPageUpdateRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostStatusPageUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostStatusPageUpdateRequest) ProtoMessage() {}
+
+func (x *PostStatusPageUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostStatusPageUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PostStatusPageUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PostStatusPageUpdateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *PostStatusPageUpdateRequest) GetStatus() StatusPageUpdateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StatusPageUpdateStatus_STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED
+}
+
+func (x *PostStatusPageUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostStatusPageUpdateRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PostStatusPageUpdateRequest) GetIncidentId() string {
+	if x != nil {
+		return x.IncidentId
+	}
+	return ""
+}
+
+type PostStatusPageUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Update        *StatusPageUpdate      `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostStatusPageUpdateResponse) Reset() {
+	*x = PostStatusPageUpdateResponse{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostStatusPageUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostStatusPageUpdateResponse) ProtoMessage() {}
+
+func (x *PostStatusPageUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostStatusPageUpdateResponse.ProtoReflect.Descriptor instead.
+func (*PostStatusPageUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PostStatusPageUpdateResponse) GetUpdate() *StatusPageUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type ListStatusPageUpdatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PageSize       int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListStatusPageUpdatesRequest) Reset() {
+	*x = ListStatusPageUpdatesRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusPageUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusPageUpdatesRequest) ProtoMessage() {}
+
+func (x *ListStatusPageUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusPageUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusPageUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListStatusPageUpdatesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListStatusPageUpdatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStatusPageUpdatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListStatusPageUpdatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updates       []*StatusPageUpdate    `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusPageUpdatesResponse) Reset() {
+	*x = ListStatusPageUpdatesResponse{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusPageUpdatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusPageUpdatesResponse) ProtoMessage() {}
+
+func (x *ListStatusPageUpdatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusPageUpdatesResponse.ProtoReflect.Descriptor instead.
+func (*ListStatusPageUpdatesResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListStatusPageUpdatesResponse) GetUpdates() []*StatusPageUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *ListStatusPageUpdatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteStatusPageUpdateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UpdateId       string                 `protobuf:"bytes,2,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteStatusPageUpdateRequest) Reset() {
+	*x = DeleteStatusPageUpdateRequest{}
+	mi := &file_libops_v1_status_page_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStatusPageUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatusPageUpdateRequest) ProtoMessage() {}
+
+func (x *DeleteStatusPageUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_status_page_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStatusPageUpdateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatusPageUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_status_page_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteStatusPageUpdateRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DeleteStatusPageUpdateRequest) GetUpdateId() string {
+	if x != nil {
+		return x.UpdateId
+	}
+	return ""
+}
+
+var File_libops_v1_status_page_proto protoreflect.FileDescriptor
+
+const file_libops_v1_status_page_proto_rawDesc = "" +
+	"\n" +
+	"\x1blibops/v1/status_page.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"i\n" +
+	"\x0eStatusPageSite\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x1b\n" +
+	"\tsite_name\x18\x02 \x01(\tR\bsiteName\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\"\x9e\x02\n" +
+	"\n" +
+	"StatusPage\x12$\n" +
+	"\x0estatus_page_id\x18\x01 \x01(\tR\fstatusPageId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06public\x18\x05 \x01(\bR\x06public\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12/\n" +
+	"\x05sites\x18\a \x03(\v2\x19.libops.v1.StatusPageSiteR\x05sites\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"\xda\x01\n" +
+	"\x10StatusPageUpdate\x12\x1b\n" +
+	"\tupdate_id\x18\x01 \x01(\tR\bupdateId\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.libops.v1.StatusPageUpdateStatusR\x06status\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1f\n" +
+	"\vincident_id\x18\x05 \x01(\tR\n" +
+	"incidentId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"?\n" +
+	"\x14GetStatusPageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"O\n" +
+	"\x15GetStatusPageResponse\x126\n" +
+	"\vstatus_page\x18\x01 \x01(\v2\x15.libops.v1.StatusPageR\n" +
+	"statusPage\"\x84\x01\n" +
+	"\x17UpdateStatusPageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\"R\n" +
+	"\x18UpdateStatusPageResponse\x126\n" +
+	"\vstatus_page\x18\x01 \x01(\v2\x15.libops.v1.StatusPageR\n" +
+	"statusPage\"B\n" +
+	"\x17DeleteStatusPageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"u\n" +
+	"\x19SetStatusPageSitesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12/\n" +
+	"\x05sites\x18\x02 \x03(\v2\x19.libops.v1.StatusPageSiteR\x05sites\"T\n" +
+	"\x1aSetStatusPageSitesResponse\x126\n" +
+	"\vstatus_page\x18\x01 \x01(\v2\x15.libops.v1.StatusPageR\n" +
+	"statusPage\"\xd2\x01\n" +
+	"\x1bPostStatusPageUpdateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.libops.v1.StatusPageUpdateStatusR\x06status\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1f\n" +
+	"\vincident_id\x18\x05 \x01(\tR\n" +
+	"incidentId\"S\n" +
+	"\x1cPostStatusPageUpdateResponse\x123\n" +
+	"\x06update\x18\x01 \x01(\v2\x1b.libops.v1.StatusPageUpdateR\x06update\"\x83\x01\n" +
+	"\x1cListStatusPageUpdatesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"\x1dListStatusPageUpdatesResponse\x125\n" +
+	"\aupdates\x18\x01 \x03(\v2\x1b.libops.v1.StatusPageUpdateR\aupdates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"e\n" +
+	"\x1dDeleteStatusPageUpdateRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1b\n" +
+	"\tupdate_id\x18\x02 \x01(\tR\bupdateId*\xec\x01\n" +
+	"\x16StatusPageUpdateStatus\x12)\n" +
+	"%STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED\x10\x00\x12+\n" +
+	"'STATUS_PAGE_UPDATE_STATUS_INVESTIGATING\x10\x01\x12(\n" +
+	"$STATUS_PAGE_UPDATE_STATUS_IDENTIFIED\x10\x02\x12(\n" +
+	"$STATUS_PAGE_UPDATE_STATUS_MONITORING\x10\x03\x12&\n" +
+	"\"STATUS_PAGE_UPDATE_STATUS_RESOLVED\x10\x042\xb7\v\n" +
+	"\x11StatusPageService\x12\xbb\x01\n" +
+	"\rGetStatusPage\x12\x1f.libops.v1.GetStatusPageRequest\x1a .libops.v1.GetStatusPageResponse\"g\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x020\x12./v1/organizations/{organization_id}/statusPage\x90\x02\x01\x12\xc5\x01\n" +
+	"\x10UpdateStatusPage\x12\".libops.v1.UpdateStatusPageRequest\x1a#.libops.v1.UpdateStatusPageResponse\"h\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x023:\x01*2./v1/organizations/{organization_id}/statusPage\x12\xb5\x01\n" +
+	"\x10DeleteStatusPage\x12\".libops.v1.DeleteStatusPageRequest\x1a\x16.google.protobuf.Empty\"e\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x020*./v1/organizations/{organization_id}/statusPage\x12\xd1\x01\n" +
+	"\x12SetStatusPageSites\x12$.libops.v1.SetStatusPageSitesRequest\x1a%.libops.v1.SetStatusPageSitesResponse\"n\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x029:\x01*\x1a4/v1/organizations/{organization_id}/statusPage/sites\x12\xd9\x01\n" +
+	"\x14PostStatusPageUpdate\x12&.libops.v1.PostStatusPageUpdateRequest\x1a'.libops.v1.PostStatusPageUpdateResponse\"p\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02;:\x01*\"6/v1/organizations/{organization_id}/statusPage/updates\x12\xdb\x01\n" +
+	"\x15ListStatusPageUpdates\x12'.libops.v1.ListStatusPageUpdatesRequest\x1a(.libops.v1.ListStatusPageUpdatesResponse\"o\x92\xb5\x18*\b\x03\x10\x01\x18\x01\"\x11read:organization*\x0forganization_id\x82\xd3\xe4\x93\x028\x126/v1/organizations/{organization_id}/statusPage/updates\x90\x02\x01\x12\xd5\x01\n" +
+	"\x16DeleteStatusPageUpdate\x12(.libops.v1.DeleteStatusPageUpdateRequest\x1a\x16.google.protobuf.Empty\"y\x92\xb5\x18+\b\x03\x10\x02\x18\x01\"\x12write:organization*\x0forganization_id\x82\xd3\xe4\x93\x02D*B/v1/organizations/{organization_id}/statusPage/updates/{update_id}B\x95\x01\n" +
+	"\rcom.libops.v1B\x0fStatusPageProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_status_page_proto_rawDescOnce sync.Once
+	file_libops_v1_status_page_proto_rawDescData []byte
+)
+
+func file_libops_v1_status_page_proto_rawDescGZIP() []byte {
+	file_libops_v1_status_page_proto_rawDescOnce.Do(func() {
+		file_libops_v1_status_page_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_status_page_proto_rawDesc), len(file_libops_v1_status_page_proto_rawDesc)))
+	})
+	return file_libops_v1_status_page_proto_rawDescData
+}
+
+var file_libops_v1_status_page_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_status_page_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_libops_v1_status_page_proto_goTypes = []any{
+	(StatusPageUpdateStatus)(0),           // 0: libops.v1.StatusPageUpdateStatus
+	(*StatusPageSite)(nil),                // 1: libops.v1.StatusPageSite
+	(*StatusPage)(nil),                    // 2: libops.v1.StatusPage
+	(*StatusPageUpdate)(nil),              // 3: libops.v1.StatusPageUpdate
+	(*GetStatusPageRequest)(nil),          // 4: libops.v1.GetStatusPageRequest
+	(*GetStatusPageResponse)(nil),         // 5: libops.v1.GetStatusPageResponse
+	(*UpdateStatusPageRequest)(nil),       // 6: libops.v1.UpdateStatusPageRequest
+	(*UpdateStatusPageResponse)(nil),      // 7: libops.v1.UpdateStatusPageResponse
+	(*DeleteStatusPageRequest)(nil),       // 8: libops.v1.DeleteStatusPageRequest
+	(*SetStatusPageSitesRequest)(nil),     // 9: libops.v1.SetStatusPageSitesRequest
+	(*SetStatusPageSitesResponse)(nil),    // 10: libops.v1.SetStatusPageSitesResponse
+	(*PostStatusPageUpdateRequest)(nil),   // 11: libops.v1.PostStatusPageUpdateRequest
+	(*PostStatusPageUpdateResponse)(nil),  // 12: libops.v1.PostStatusPageUpdateResponse
+	(*ListStatusPageUpdatesRequest)(nil),  // 13: libops.v1.ListStatusPageUpdatesRequest
+	(*ListStatusPageUpdatesResponse)(nil), // 14: libops.v1.ListStatusPageUpdatesResponse
+	(*DeleteStatusPageUpdateRequest)(nil), // 15: libops.v1.DeleteStatusPageUpdateRequest
+	(*emptypb.Empty)(nil),                 // 16: google.protobuf.Empty
+}
+var file_libops_v1_status_page_proto_depIdxs = []int32{
+	1,  // 0: libops.v1.StatusPage.sites:type_name -> libops.v1.StatusPageSite
+	0,  // 1: libops.v1.StatusPageUpdate.status:type_name -> libops.v1.StatusPageUpdateStatus
+	2,  // 2: libops.v1.GetStatusPageResponse.status_page:type_name -> libops.v1.StatusPage
+	2,  // 3: libops.v1.UpdateStatusPageResponse.status_page:type_name -> libops.v1.StatusPage
+	1,  // 4: libops.v1.SetStatusPageSitesRequest.sites:type_name -> libops.v1.StatusPageSite
+	2,  // 5: libops.v1.SetStatusPageSitesResponse.status_page:type_name -> libops.v1.StatusPage
+	0,  // 6: libops.v1.PostStatusPageUpdateRequest.status:type_name -> libops.v1.StatusPageUpdateStatus
+	3,  // 7: libops.v1.PostStatusPageUpdateResponse.update:type_name -> libops.v1.StatusPageUpdate
+	3,  // 8: libops.v1.ListStatusPageUpdatesResponse.updates:type_name -> libops.v1.StatusPageUpdate
+	4,  // 9: libops.v1.StatusPageService.GetStatusPage:input_type -> libops.v1.GetStatusPageRequest
+	6,  // 10: libops.v1.StatusPageService.UpdateStatusPage:input_type -> libops.v1.UpdateStatusPageRequest
+	8,  // 11: libops.v1.StatusPageService.DeleteStatusPage:input_type -> libops.v1.DeleteStatusPageRequest
+	9,  // 12: libops.v1.StatusPageService.SetStatusPageSites:input_type -> libops.v1.SetStatusPageSitesRequest
+	11, // 13: libops.v1.StatusPageService.PostStatusPageUpdate:input_type -> libops.v1.PostStatusPageUpdateRequest
+	13, // 14: libops.v1.StatusPageService.ListStatusPageUpdates:input_type -> libops.v1.ListStatusPageUpdatesRequest
+	15, // 15: libops.v1.StatusPageService.DeleteStatusPageUpdate:input_type -> libops.v1.DeleteStatusPageUpdateRequest
+	5,  // 16: libops.v1.StatusPageService.GetStatusPage:output_type -> libops.v1.GetStatusPageResponse
+	7,  // 17: libops.v1.StatusPageService.UpdateStatusPage:output_type -> libops.v1.UpdateStatusPageResponse
+	16, // 18: libops.v1.StatusPageService.DeleteStatusPage:output_type -> google.protobuf.Empty
+	10, // 19: libops.v1.StatusPageService.SetStatusPageSites:output_type -> libops.v1.SetStatusPageSitesResponse
+	12, // 20: libops.v1.StatusPageService.PostStatusPageUpdate:output_type -> libops.v1.PostStatusPageUpdateResponse
+	14, // 21: libops.v1.StatusPageService.ListStatusPageUpdates:output_type -> libops.v1.ListStatusPageUpdatesResponse
+	16, // 22: libops.v1.StatusPageService.DeleteStatusPageUpdate:output_type -> google.protobuf.Empty
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_status_page_proto_init() }
+func file_libops_v1_status_page_proto_init() {
+	if File_libops_v1_status_page_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_status_page_proto_rawDesc), len(file_libops_v1_status_page_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_status_page_proto_goTypes,
+		DependencyIndexes: file_libops_v1_status_page_proto_depIdxs,
+		EnumInfos:         file_libops_v1_status_page_proto_enumTypes,
+		MessageInfos:      file_libops_v1_status_page_proto_msgTypes,
+	}.Build()
+	File_libops_v1_status_page_proto = out.File
+	file_libops_v1_status_page_proto_goTypes = nil
+	file_libops_v1_status_page_proto_depIdxs = nil
+}
@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/tls.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TlsVersion int32
+
+const (
+	TlsVersion_TLS_VERSION_UNSPECIFIED TlsVersion = 0
+	TlsVersion_TLS_VERSION_1_2         TlsVersion = 1
+	TlsVersion_TLS_VERSION_1_3         TlsVersion = 2
+)
+
+// Enum value maps for TlsVersion.
+var (
+	TlsVersion_name = map[int32]string{
+		0: "TLS_VERSION_UNSPECIFIED",
+		1: "TLS_VERSION_1_2",
+		2: "TLS_VERSION_1_3",
+	}
+	TlsVersion_value = map[string]int32{
+		"TLS_VERSION_UNSPECIFIED": 0,
+		"TLS_VERSION_1_2":         1,
+		"TLS_VERSION_1_3":         2,
+	}
+)
+
+func (x TlsVersion) Enum() *TlsVersion {
+	p := new(TlsVersion)
+	*p = x
+	return p
+}
+
+func (x TlsVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TlsVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_tls_proto_enumTypes[0].Descriptor()
+}
+
+func (TlsVersion) Type() protoreflect.EnumType {
+	return &file_libops_v1_tls_proto_enumTypes[0]
+}
+
+func (x TlsVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TlsVersion.Descriptor instead.
+func (TlsVersion) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{0}
+}
+
+type TlsCertificateSource int32
+
+const (
+	TlsCertificateSource_TLS_CERTIFICATE_SOURCE_UNSPECIFIED TlsCertificateSource = 0
+	TlsCertificateSource_TLS_CERTIFICATE_SOURCE_ACME        TlsCertificateSource = 1 // Issued and renewed automatically
+	TlsCertificateSource_TLS_CERTIFICATE_SOURCE_CUSTOM      TlsCertificateSource = 2 // Uploaded, and renewed by uploading again
+)
+
+// Enum value maps for TlsCertificateSource.
+var (
+	TlsCertificateSource_name = map[int32]string{
+		0: "TLS_CERTIFICATE_SOURCE_UNSPECIFIED",
+		1: "TLS_CERTIFICATE_SOURCE_ACME",
+		2: "TLS_CERTIFICATE_SOURCE_CUSTOM",
+	}
+	TlsCertificateSource_value = map[string]int32{
+		"TLS_CERTIFICATE_SOURCE_UNSPECIFIED": 0,
+		"TLS_CERTIFICATE_SOURCE_ACME":        1,
+		"TLS_CERTIFICATE_SOURCE_CUSTOM":      2,
+	}
+)
+
+func (x TlsCertificateSource) Enum() *TlsCertificateSource {
+	p := new(TlsCertificateSource)
+	*p = x
+	return p
+}
+
+func (x TlsCertificateSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TlsCertificateSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_tls_proto_enumTypes[1].Descriptor()
+}
+
+func (TlsCertificateSource) Type() protoreflect.EnumType {
+	return &file_libops_v1_tls_proto_enumTypes[1]
+}
+
+func (x TlsCertificateSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TlsCertificateSource.Descriptor instead.
+func (TlsCertificateSource) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{1}
+}
+
+type TlsProbeStatus int32
+
+const (
+	TlsProbeStatus_TLS_PROBE_STATUS_UNSPECIFIED TlsProbeStatus = 0
+	TlsProbeStatus_TLS_PROBE_STATUS_PENDING     TlsProbeStatus = 1 // The policy changed since the last probe
+	TlsProbeStatus_TLS_PROBE_STATUS_PASSED      TlsProbeStatus = 2
+	TlsProbeStatus_TLS_PROBE_STATUS_FAILED      TlsProbeStatus = 3
+)
+
+// Enum value maps for TlsProbeStatus.
+var (
+	TlsProbeStatus_name = map[int32]string{
+		0: "TLS_PROBE_STATUS_UNSPECIFIED",
+		1: "TLS_PROBE_STATUS_PENDING",
+		2: "TLS_PROBE_STATUS_PASSED",
+		3: "TLS_PROBE_STATUS_FAILED",
+	}
+	TlsProbeStatus_value = map[string]int32{
+		"TLS_PROBE_STATUS_UNSPECIFIED": 0,
+		"TLS_PROBE_STATUS_PENDING":     1,
+		"TLS_PROBE_STATUS_PASSED":      2,
+		"TLS_PROBE_STATUS_FAILED":      3,
+	}
+)
+
+func (x TlsProbeStatus) Enum() *TlsProbeStatus {
+	p := new(TlsProbeStatus)
+	*p = x
+	return p
+}
+
+func (x TlsProbeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TlsProbeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_tls_proto_enumTypes[2].Descriptor()
+}
+
+func (TlsProbeStatus) Type() protoreflect.EnumType {
+	return &file_libops_v1_tls_proto_enumTypes[2]
+}
+
+func (x TlsProbeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TlsProbeStatus.Descriptor instead.
+func (TlsProbeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{2}
+}
+
+type SiteTlsPolicy struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SiteId     string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	MinVersion TlsVersion             `protobuf:"varint,2,opt,name=min_version,json=minVersion,proto3,enum=libops.v1.TlsVersion" json:"min_version,omitempty"`
+	// How long browsers remember to only use HTTPS; 0 sends no HSTS header
+	HstsMaxAgeSeconds     int32 `protobuf:"varint,3,opt,name=hsts_max_age_seconds,json=hstsMaxAgeSeconds,proto3" json:"hsts_max_age_seconds,omitempty"`
+	HstsIncludeSubdomains bool  `protobuf:"varint,4,opt,name=hsts_include_subdomains,json=hstsIncludeSubdomains,proto3" json:"hsts_include_subdomains,omitempty"`
+	// Asks to be on browsers' preload lists; needs a max age of a year or more
+	// and subdomains included
+	HstsPreload       bool                 `protobuf:"varint,5,opt,name=hsts_preload,json=hstsPreload,proto3" json:"hsts_preload,omitempty"`
+	OcspStapling      bool                 `protobuf:"varint,6,opt,name=ocsp_stapling,json=ocspStapling,proto3" json:"ocsp_stapling,omitempty"`
+	CertificateSource TlsCertificateSource `protobuf:"varint,7,opt,name=certificate_source,json=certificateSource,proto3,enum=libops.v1.TlsCertificateSource" json:"certificate_source,omitempty"`
+	// The custom certificate; unset while the site serves its ACME one
+	Certificate   *TlsCertificate `protobuf:"bytes,8,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	ProbeStatus   TlsProbeStatus  `protobuf:"varint,9,opt,name=probe_status,json=probeStatus,proto3,enum=libops.v1.TlsProbeStatus" json:"probe_status,omitempty"`
+	ProbeMessage  string          `protobuf:"bytes,10,opt,name=probe_message,json=probeMessage,proto3" json:"probe_message,omitempty"` // Why the last probe failed
+	ProbedAt      int64           `protobuf:"varint,11,opt,name=probed_at,json=probedAt,proto3" json:"probed_at,omitempty"`            // Unix timestamp; 0 while the site has never been probed
+	UpdatedAt     int64           `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // Unix timestamp; 0 while the site has the default policy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteTlsPolicy) Reset() {
+	*x = SiteTlsPolicy{}
+	mi := &file_libops_v1_tls_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteTlsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteTlsPolicy) ProtoMessage() {}
+
+func (x *SiteTlsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteTlsPolicy.ProtoReflect.Descriptor instead.
+func (*SiteTlsPolicy) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteTlsPolicy) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteTlsPolicy) GetMinVersion() TlsVersion {
+	if x != nil {
+		return x.MinVersion
+	}
+	return TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+func (x *SiteTlsPolicy) GetHstsMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.HstsMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *SiteTlsPolicy) GetHstsIncludeSubdomains() bool {
+	if x != nil {
+		return x.HstsIncludeSubdomains
+	}
+	return false
+}
+
+func (x *SiteTlsPolicy) GetHstsPreload() bool {
+	if x != nil {
+		return x.HstsPreload
+	}
+	return false
+}
+
+func (x *SiteTlsPolicy) GetOcspStapling() bool {
+	if x != nil {
+		return x.OcspStapling
+	}
+	return false
+}
+
+func (x *SiteTlsPolicy) GetCertificateSource() TlsCertificateSource {
+	if x != nil {
+		return x.CertificateSource
+	}
+	return TlsCertificateSource_TLS_CERTIFICATE_SOURCE_UNSPECIFIED
+}
+
+func (x *SiteTlsPolicy) GetCertificate() *TlsCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *SiteTlsPolicy) GetProbeStatus() TlsProbeStatus {
+	if x != nil {
+		return x.ProbeStatus
+	}
+	return TlsProbeStatus_TLS_PROBE_STATUS_UNSPECIFIED
+}
+
+func (x *SiteTlsPolicy) GetProbeMessage() string {
+	if x != nil {
+		return x.ProbeMessage
+	}
+	return ""
+}
+
+func (x *SiteTlsPolicy) GetProbedAt() int64 {
+	if x != nil {
+		return x.ProbedAt
+	}
+	return 0
+}
+
+func (x *SiteTlsPolicy) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// TlsCertificate describes an uploaded certificate; its private key is never
+// returned
+type TlsCertificate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DnsNames          []string               `protobuf:"bytes,1,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	Issuer            string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotBefore         int64                  `protobuf:"varint,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`                        // Unix timestamp
+	NotAfter          int64                  `protobuf:"varint,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`                           // Unix timestamp
+	Sha256Fingerprint string                 `protobuf:"bytes,5,opt,name=sha256_fingerprint,json=sha256Fingerprint,proto3" json:"sha256_fingerprint,omitempty"` // Hex
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TlsCertificate) Reset() {
+	*x = TlsCertificate{}
+	mi := &file_libops_v1_tls_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TlsCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TlsCertificate) ProtoMessage() {}
+
+func (x *TlsCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TlsCertificate.ProtoReflect.Descriptor instead.
+func (*TlsCertificate) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TlsCertificate) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *TlsCertificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *TlsCertificate) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *TlsCertificate) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+func (x *TlsCertificate) GetSha256Fingerprint() string {
+	if x != nil {
+		return x.Sha256Fingerprint
+	}
+	return ""
+}
+
+type GetSiteTlsPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteTlsPolicyRequest) Reset() {
+	*x = GetSiteTlsPolicyRequest{}
+	mi := &file_libops_v1_tls_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteTlsPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteTlsPolicyRequest) ProtoMessage() {}
+
+func (x *GetSiteTlsPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteTlsPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteTlsPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSiteTlsPolicyRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteTlsPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SiteTlsPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteTlsPolicyResponse) Reset() {
+	*x = GetSiteTlsPolicyResponse{}
+	mi := &file_libops_v1_tls_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteTlsPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteTlsPolicyResponse) ProtoMessage() {}
+
+func (x *GetSiteTlsPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteTlsPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteTlsPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSiteTlsPolicyResponse) GetPolicy() *SiteTlsPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdateSiteTlsPolicyRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SiteId                string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	MinVersion            TlsVersion             `protobuf:"varint,2,opt,name=min_version,json=minVersion,proto3,enum=libops.v1.TlsVersion" json:"min_version,omitempty"` // Defaults to TLS 1.2
+	HstsMaxAgeSeconds     int32                  `protobuf:"varint,3,opt,name=hsts_max_age_seconds,json=hstsMaxAgeSeconds,proto3" json:"hsts_max_age_seconds,omitempty"`
+	HstsIncludeSubdomains bool                   `protobuf:"varint,4,opt,name=hsts_include_subdomains,json=hstsIncludeSubdomains,proto3" json:"hsts_include_subdomains,omitempty"`
+	HstsPreload           bool                   `protobuf:"varint,5,opt,name=hsts_preload,json=hstsPreload,proto3" json:"hsts_preload,omitempty"`
+	OcspStapling          bool                   `protobuf:"varint,6,opt,name=ocsp_stapling,json=ocspStapling,proto3" json:"ocsp_stapling,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateSiteTlsPolicyRequest) Reset() {
+	*x = UpdateSiteTlsPolicyRequest{}
+	mi := &file_libops_v1_tls_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteTlsPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteTlsPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateSiteTlsPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteTlsPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteTlsPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetMinVersion() TlsVersion {
+	if x != nil {
+		return x.MinVersion
+	}
+	return TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetHstsMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.HstsMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetHstsIncludeSubdomains() bool {
+	if x != nil {
+		return x.HstsIncludeSubdomains
+	}
+	return false
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetHstsPreload() bool {
+	if x != nil {
+		return x.HstsPreload
+	}
+	return false
+}
+
+func (x *UpdateSiteTlsPolicyRequest) GetOcspStapling() bool {
+	if x != nil {
+		return x.OcspStapling
+	}
+	return false
+}
+
+type UpdateSiteTlsPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SiteTlsPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteTlsPolicyResponse) Reset() {
+	*x = UpdateSiteTlsPolicyResponse{}
+	mi := &file_libops_v1_tls_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteTlsPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteTlsPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateSiteTlsPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteTlsPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSiteTlsPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateSiteTlsPolicyResponse) GetPolicy() *SiteTlsPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UploadSiteTlsCertificateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// PEM leaf certificate, followed by its intermediates
+	CertificatePem string `protobuf:"bytes,2,opt,name=certificate_pem,json=certificatePem,proto3" json:"certificate_pem,omitempty"`
+	PrivateKeyPem  string `protobuf:"bytes,3,opt,name=private_key_pem,json=privateKeyPem,proto3" json:"private_key_pem,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadSiteTlsCertificateRequest) Reset() {
+	*x = UploadSiteTlsCertificateRequest{}
+	mi := &file_libops_v1_tls_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSiteTlsCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSiteTlsCertificateRequest) ProtoMessage() {}
+
+func (x *UploadSiteTlsCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSiteTlsCertificateRequest.ProtoReflect.Descriptor instead.
+func (*UploadSiteTlsCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadSiteTlsCertificateRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UploadSiteTlsCertificateRequest) GetCertificatePem() string {
+	if x != nil {
+		return x.CertificatePem
+	}
+	return ""
+}
+
+func (x *UploadSiteTlsCertificateRequest) GetPrivateKeyPem() string {
+	if x != nil {
+		return x.PrivateKeyPem
+	}
+	return ""
+}
+
+type UploadSiteTlsCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SiteTlsPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadSiteTlsCertificateResponse) Reset() {
+	*x = UploadSiteTlsCertificateResponse{}
+	mi := &file_libops_v1_tls_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSiteTlsCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSiteTlsCertificateResponse) ProtoMessage() {}
+
+func (x *UploadSiteTlsCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSiteTlsCertificateResponse.ProtoReflect.Descriptor instead.
+func (*UploadSiteTlsCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UploadSiteTlsCertificateResponse) GetPolicy() *SiteTlsPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteSiteTlsCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteTlsCertificateRequest) Reset() {
+	*x = DeleteSiteTlsCertificateRequest{}
+	mi := &file_libops_v1_tls_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteTlsCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteTlsCertificateRequest) ProtoMessage() {}
+
+func (x *DeleteSiteTlsCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteTlsCertificateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSiteTlsCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteSiteTlsCertificateRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type DeleteSiteTlsCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *SiteTlsPolicy         `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSiteTlsCertificateResponse) Reset() {
+	*x = DeleteSiteTlsCertificateResponse{}
+	mi := &file_libops_v1_tls_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSiteTlsCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSiteTlsCertificateResponse) ProtoMessage() {}
+
+func (x *DeleteSiteTlsCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_tls_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSiteTlsCertificateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSiteTlsCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_tls_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSiteTlsCertificateResponse) GetPolicy() *SiteTlsPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+var File_libops_v1_tls_proto protoreflect.FileDescriptor
+
+const file_libops_v1_tls_proto_rawDesc = "" +
+	"\n" +
+	"\x13libops/v1/tls.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/audit.proto\x1a\x1dlibops/v1/options/scope.proto\"\xbd\x04\n" +
+	"\rSiteTlsPolicy\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x126\n" +
+	"\vmin_version\x18\x02 \x01(\x0e2\x15.libops.v1.TlsVersionR\n" +
+	"minVersion\x12/\n" +
+	"\x14hsts_max_age_seconds\x18\x03 \x01(\x05R\x11hstsMaxAgeSeconds\x126\n" +
+	"\x17hsts_include_subdomains\x18\x04 \x01(\bR\x15hstsIncludeSubdomains\x12!\n" +
+	"\fhsts_preload\x18\x05 \x01(\bR\vhstsPreload\x12#\n" +
+	"\rocsp_stapling\x18\x06 \x01(\bR\focspStapling\x12N\n" +
+	"\x12certificate_source\x18\a \x01(\x0e2\x1f.libops.v1.TlsCertificateSourceR\x11certificateSource\x12;\n" +
+	"\vcertificate\x18\b \x01(\v2\x19.libops.v1.TlsCertificateR\vcertificate\x12<\n" +
+	"\fprobe_status\x18\t \x01(\x0e2\x19.libops.v1.TlsProbeStatusR\vprobeStatus\x12#\n" +
+	"\rprobe_message\x18\n" +
+	" \x01(\tR\fprobeMessage\x12\x1b\n" +
+	"\tprobed_at\x18\v \x01(\x03R\bprobedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\"\xb0\x01\n" +
+	"\x0eTlsCertificate\x12\x1b\n" +
+	"\tdns_names\x18\x01 \x03(\tR\bdnsNames\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"not_before\x18\x03 \x01(\x03R\tnotBefore\x12\x1b\n" +
+	"\tnot_after\x18\x04 \x01(\x03R\bnotAfter\x12-\n" +
+	"\x12sha256_fingerprint\x18\x05 \x01(\tR\x11sha256Fingerprint\"2\n" +
+	"\x17GetSiteTlsPolicyRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"L\n" +
+	"\x18GetSiteTlsPolicyResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.libops.v1.SiteTlsPolicyR\x06policy\"\x9e\x02\n" +
+	"\x1aUpdateSiteTlsPolicyRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x126\n" +
+	"\vmin_version\x18\x02 \x01(\x0e2\x15.libops.v1.TlsVersionR\n" +
+	"minVersion\x12/\n" +
+	"\x14hsts_max_age_seconds\x18\x03 \x01(\x05R\x11hstsMaxAgeSeconds\x126\n" +
+	"\x17hsts_include_subdomains\x18\x04 \x01(\bR\x15hstsIncludeSubdomains\x12!\n" +
+	"\fhsts_preload\x18\x05 \x01(\bR\vhstsPreload\x12#\n" +
+	"\rocsp_stapling\x18\x06 \x01(\bR\focspStapling\"O\n" +
+	"\x1bUpdateSiteTlsPolicyResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.libops.v1.SiteTlsPolicyR\x06policy\"\x91\x01\n" +
+	"\x1fUploadSiteTlsCertificateRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12'\n" +
+	"\x0fcertificate_pem\x18\x02 \x01(\tR\x0ecertificatePem\x12,\n" +
+	"\x0fprivate_key_pem\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\rprivateKeyPem\"T\n" +
+	" UploadSiteTlsCertificateResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.libops.v1.SiteTlsPolicyR\x06policy\":\n" +
+	"\x1fDeleteSiteTlsCertificateRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\"T\n" +
+	" DeleteSiteTlsCertificateResponse\x120\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.libops.v1.SiteTlsPolicyR\x06policy*S\n" +
+	"\n" +
+	"TlsVersion\x12\x1b\n" +
+	"\x17TLS_VERSION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTLS_VERSION_1_2\x10\x01\x12\x13\n" +
+	"\x0fTLS_VERSION_1_3\x10\x02*\x82\x01\n" +
+	"\x14TlsCertificateSource\x12&\n" +
+	"\"TLS_CERTIFICATE_SOURCE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTLS_CERTIFICATE_SOURCE_ACME\x10\x01\x12!\n" +
+	"\x1dTLS_CERTIFICATE_SOURCE_CUSTOM\x10\x02*\x8a\x01\n" +
+	"\x0eTlsProbeStatus\x12 \n" +
+	"\x1cTLS_PROBE_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TLS_PROBE_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17TLS_PROBE_STATUS_PASSED\x10\x02\x12\x1b\n" +
+	"\x17TLS_PROBE_STATUS_FAILED\x10\x032\xe3\x05\n" +
+	"\x10TlsPolicyService\x12\x9d\x01\n" +
+	"\x10GetSiteTlsPolicy\x12\".libops.v1.GetSiteTlsPolicyRequest\x1a#.libops.v1.GetSiteTlsPolicyResponse\"@\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/sites/{site_id}/tls\x90\x02\x01\x12\xa7\x01\n" +
+	"\x13UpdateSiteTlsPolicy\x12%.libops.v1.UpdateSiteTlsPolicyRequest\x1a&.libops.v1.UpdateSiteTlsPolicyResponse\"A\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/sites/{site_id}/tls\x12\xc2\x01\n" +
+	"\x18UploadSiteTlsCertificate\x12*.libops.v1.UploadSiteTlsCertificateRequest\x1a+.libops.v1.UploadSiteTlsCertificateResponse\"M\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02(:\x01*\x1a#/v1/sites/{site_id}/tls/certificate\x12\xbf\x01\n" +
+	"\x18DeleteSiteTlsCertificate\x12*.libops.v1.DeleteSiteTlsCertificateRequest\x1a+.libops.v1.DeleteSiteTlsCertificateResponse\"J\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02%*#/v1/sites/{site_id}/tls/certificateB\x8e\x01\n" +
+	"\rcom.libops.v1B\bTlsProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_tls_proto_rawDescOnce sync.Once
+	file_libops_v1_tls_proto_rawDescData []byte
+)
+
+func file_libops_v1_tls_proto_rawDescGZIP() []byte {
+	file_libops_v1_tls_proto_rawDescOnce.Do(func() {
+		file_libops_v1_tls_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_tls_proto_rawDesc), len(file_libops_v1_tls_proto_rawDesc)))
+	})
+	return file_libops_v1_tls_proto_rawDescData
+}
+
+var file_libops_v1_tls_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_libops_v1_tls_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_libops_v1_tls_proto_goTypes = []any{
+	(TlsVersion)(0),                          // 0: libops.v1.TlsVersion
+	(TlsCertificateSource)(0),                // 1: libops.v1.TlsCertificateSource
+	(TlsProbeStatus)(0),                      // 2: libops.v1.TlsProbeStatus
+	(*SiteTlsPolicy)(nil),                    // 3: libops.v1.SiteTlsPolicy
+	(*TlsCertificate)(nil),                   // 4: libops.v1.TlsCertificate
+	(*GetSiteTlsPolicyRequest)(nil),          // 5: libops.v1.GetSiteTlsPolicyRequest
+	(*GetSiteTlsPolicyResponse)(nil),         // 6: libops.v1.GetSiteTlsPolicyResponse
+	(*UpdateSiteTlsPolicyRequest)(nil),       // 7: libops.v1.UpdateSiteTlsPolicyRequest
+	(*UpdateSiteTlsPolicyResponse)(nil),      // 8: libops.v1.UpdateSiteTlsPolicyResponse
+	(*UploadSiteTlsCertificateRequest)(nil),  // 9: libops.v1.UploadSiteTlsCertificateRequest
+	(*UploadSiteTlsCertificateResponse)(nil), // 10: libops.v1.UploadSiteTlsCertificateResponse
+	(*DeleteSiteTlsCertificateRequest)(nil),  // 11: libops.v1.DeleteSiteTlsCertificateRequest
+	(*DeleteSiteTlsCertificateResponse)(nil), // 12: libops.v1.DeleteSiteTlsCertificateResponse
+}
+var file_libops_v1_tls_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.SiteTlsPolicy.min_version:type_name -> libops.v1.TlsVersion
+	1,  // 1: libops.v1.SiteTlsPolicy.certificate_source:type_name -> libops.v1.TlsCertificateSource
+	4,  // 2: libops.v1.SiteTlsPolicy.certificate:type_name -> libops.v1.TlsCertificate
+	2,  // 3: libops.v1.SiteTlsPolicy.probe_status:type_name -> libops.v1.TlsProbeStatus
+	3,  // 4: libops.v1.GetSiteTlsPolicyResponse.policy:type_name -> libops.v1.SiteTlsPolicy
+	0,  // 5: libops.v1.UpdateSiteTlsPolicyRequest.min_version:type_name -> libops.v1.TlsVersion
+	3,  // 6: libops.v1.UpdateSiteTlsPolicyResponse.policy:type_name -> libops.v1.SiteTlsPolicy
+	3,  // 7: libops.v1.UploadSiteTlsCertificateResponse.policy:type_name -> libops.v1.SiteTlsPolicy
+	3,  // 8: libops.v1.DeleteSiteTlsCertificateResponse.policy:type_name -> libops.v1.SiteTlsPolicy
+	5,  // 9: libops.v1.TlsPolicyService.GetSiteTlsPolicy:input_type -> libops.v1.GetSiteTlsPolicyRequest
+	7,  // 10: libops.v1.TlsPolicyService.UpdateSiteTlsPolicy:input_type -> libops.v1.UpdateSiteTlsPolicyRequest
+	9,  // 11: libops.v1.TlsPolicyService.UploadSiteTlsCertificate:input_type -> libops.v1.UploadSiteTlsCertificateRequest
+	11, // 12: libops.v1.TlsPolicyService.DeleteSiteTlsCertificate:input_type -> libops.v1.DeleteSiteTlsCertificateRequest
+	6,  // 13: libops.v1.TlsPolicyService.GetSiteTlsPolicy:output_type -> libops.v1.GetSiteTlsPolicyResponse
+	8,  // 14: libops.v1.TlsPolicyService.UpdateSiteTlsPolicy:output_type -> libops.v1.UpdateSiteTlsPolicyResponse
+	10, // 15: libops.v1.TlsPolicyService.UploadSiteTlsCertificate:output_type -> libops.v1.UploadSiteTlsCertificateResponse
+	12, // 16: libops.v1.TlsPolicyService.DeleteSiteTlsCertificate:output_type -> libops.v1.DeleteSiteTlsCertificateResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_tls_proto_init() }
+func file_libops_v1_tls_proto_init() {
+	if File_libops_v1_tls_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_tls_proto_rawDesc), len(file_libops_v1_tls_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_tls_proto_goTypes,
+		DependencyIndexes: file_libops_v1_tls_proto_depIdxs,
+		EnumInfos:         file_libops_v1_tls_proto_enumTypes,
+		MessageInfos:      file_libops_v1_tls_proto_msgTypes,
+	}.Build()
+	File_libops_v1_tls_proto = out.File
+	file_libops_v1_tls_proto_goTypes = nil
+	file_libops_v1_tls_proto_depIdxs = nil
+}
@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/uptime.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UptimeBucket aggregates the probes in one slice of the uptime window
+type UptimeBucket struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartTime         int64                  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Unix timestamp
+	Checks            int32                  `protobuf:"varint,2,opt,name=checks,proto3" json:"checks,omitempty"`                        // Probes in the bucket, 0 when the site was not probed
+	Failures          int32                  `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	AverageResponseMs int32                  `protobuf:"varint,4,opt,name=average_response_ms,json=averageResponseMs,proto3" json:"average_response_ms,omitempty"` // Over successful probes, 0 when there were none
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UptimeBucket) Reset() {
+	*x = UptimeBucket{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UptimeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UptimeBucket) ProtoMessage() {}
+
+func (x *UptimeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UptimeBucket.ProtoReflect.Descriptor instead.
+func (*UptimeBucket) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UptimeBucket) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UptimeBucket) GetChecks() int32 {
+	if x != nil {
+		return x.Checks
+	}
+	return 0
+}
+
+func (x *UptimeBucket) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *UptimeBucket) GetAverageResponseMs() int32 {
+	if x != nil {
+		return x.AverageResponseMs
+	}
+	return 0
+}
+
+// UptimeProbe is the outcome of one request to a site's health URL
+type UptimeProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProbedAt      int64                  `protobuf:"varint,1,opt,name=probed_at,json=probedAt,proto3" json:"probed_at,omitempty"`       // Unix timestamp
+	Up            bool                   `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`                                   // A 2xx or 3xx response within the timeout
+	StatusCode    int32                  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // HTTP status, 0 when no response was received
+	ResponseMs    int32                  `protobuf:"varint,4,opt,name=response_ms,json=responseMs,proto3" json:"response_ms,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // Why the probe failed, empty when it was up
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UptimeProbe) Reset() {
+	*x = UptimeProbe{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UptimeProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UptimeProbe) ProtoMessage() {}
+
+func (x *UptimeProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UptimeProbe.ProtoReflect.Descriptor instead.
+func (*UptimeProbe) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UptimeProbe) GetProbedAt() int64 {
+	if x != nil {
+		return x.ProbedAt
+	}
+	return 0
+}
+
+func (x *UptimeProbe) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *UptimeProbe) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *UptimeProbe) GetResponseMs() int32 {
+	if x != nil {
+		return x.ResponseMs
+	}
+	return 0
+}
+
+func (x *UptimeProbe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SiteUptime struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SiteId            string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	HealthCheckPath   string                 `protobuf:"bytes,2,opt,name=health_check_path,json=healthCheckPath,proto3" json:"health_check_path,omitempty"`
+	WindowHours       int32                  `protobuf:"varint,3,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	UptimePercent     float64                `protobuf:"fixed64,4,opt,name=uptime_percent,json=uptimePercent,proto3" json:"uptime_percent,omitempty"` // Share of successful probes in the window, 100 when there were none
+	Checks            int32                  `protobuf:"varint,5,opt,name=checks,proto3" json:"checks,omitempty"`
+	Failures          int32                  `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	AverageResponseMs int32                  `protobuf:"varint,7,opt,name=average_response_ms,json=averageResponseMs,proto3" json:"average_response_ms,omitempty"`
+	BucketSeconds     int32                  `protobuf:"varint,8,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+	Buckets           []*UptimeBucket        `protobuf:"bytes,9,rep,name=buckets,proto3" json:"buckets,omitempty"`                       // Oldest first, covering the whole window
+	LastProbe         *UptimeProbe           `protobuf:"bytes,10,opt,name=last_probe,json=lastProbe,proto3" json:"last_probe,omitempty"` // Unset when the site was never probed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SiteUptime) Reset() {
+	*x = SiteUptime{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteUptime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteUptime) ProtoMessage() {}
+
+func (x *SiteUptime) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteUptime.ProtoReflect.Descriptor instead.
+func (*SiteUptime) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SiteUptime) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteUptime) GetHealthCheckPath() string {
+	if x != nil {
+		return x.HealthCheckPath
+	}
+	return ""
+}
+
+func (x *SiteUptime) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetUptimePercent() float64 {
+	if x != nil {
+		return x.UptimePercent
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetChecks() int32 {
+	if x != nil {
+		return x.Checks
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetFailures() int32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetAverageResponseMs() int32 {
+	if x != nil {
+		return x.AverageResponseMs
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetBucketSeconds() int32 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+func (x *SiteUptime) GetBuckets() []*UptimeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *SiteUptime) GetLastProbe() *UptimeProbe {
+	if x != nil {
+		return x.LastProbe
+	}
+	return nil
+}
+
+type GetSiteUptimeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Defaults to 24, at most 720 (30 days)
+	WindowHours   int32 `protobuf:"varint,2,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteUptimeRequest) Reset() {
+	*x = GetSiteUptimeRequest{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteUptimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteUptimeRequest) ProtoMessage() {}
+
+func (x *GetSiteUptimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteUptimeRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteUptimeRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSiteUptimeRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GetSiteUptimeRequest) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type GetSiteUptimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uptime        *SiteUptime            `protobuf:"bytes,1,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteUptimeResponse) Reset() {
+	*x = GetSiteUptimeResponse{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteUptimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteUptimeResponse) ProtoMessage() {}
+
+func (x *GetSiteUptimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteUptimeResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteUptimeResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSiteUptimeResponse) GetUptime() *SiteUptime {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+type UpdateSiteHealthCheckRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	SiteId string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	// Absolute path with an optional query, e.g. "/healthz"
+	HealthCheckPath string `protobuf:"bytes,2,opt,name=health_check_path,json=healthCheckPath,proto3" json:"health_check_path,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSiteHealthCheckRequest) Reset() {
+	*x = UpdateSiteHealthCheckRequest{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteHealthCheckRequest) ProtoMessage() {}
+
+func (x *UpdateSiteHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateSiteHealthCheckRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteHealthCheckRequest) GetHealthCheckPath() string {
+	if x != nil {
+		return x.HealthCheckPath
+	}
+	return ""
+}
+
+type UpdateSiteHealthCheckResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SiteId          string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	HealthCheckPath string                 `protobuf:"bytes,2,opt,name=health_check_path,json=healthCheckPath,proto3" json:"health_check_path,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSiteHealthCheckResponse) Reset() {
+	*x = UpdateSiteHealthCheckResponse{}
+	mi := &file_libops_v1_uptime_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteHealthCheckResponse) ProtoMessage() {}
+
+func (x *UpdateSiteHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_uptime_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSiteHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_uptime_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateSiteHealthCheckResponse) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteHealthCheckResponse) GetHealthCheckPath() string {
+	if x != nil {
+		return x.HealthCheckPath
+	}
+	return ""
+}
+
+var File_libops_v1_uptime_proto protoreflect.FileDescriptor
+
+const file_libops_v1_uptime_proto_rawDesc = "" +
+	"\n" +
+	"\x16libops/v1/uptime.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1dlibops/v1/options/scope.proto\"\x91\x01\n" +
+	"\fUptimeBucket\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\x03R\tstartTime\x12\x16\n" +
+	"\x06checks\x18\x02 \x01(\x05R\x06checks\x12\x1a\n" +
+	"\bfailures\x18\x03 \x01(\x05R\bfailures\x12.\n" +
+	"\x13average_response_ms\x18\x04 \x01(\x05R\x11averageResponseMs\"\x92\x01\n" +
+	"\vUptimeProbe\x12\x1b\n" +
+	"\tprobed_at\x18\x01 \x01(\x03R\bprobedAt\x12\x0e\n" +
+	"\x02up\x18\x02 \x01(\bR\x02up\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vresponse_ms\x18\x04 \x01(\x05R\n" +
+	"responseMs\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x90\x03\n" +
+	"\n" +
+	"SiteUptime\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12*\n" +
+	"\x11health_check_path\x18\x02 \x01(\tR\x0fhealthCheckPath\x12!\n" +
+	"\fwindow_hours\x18\x03 \x01(\x05R\vwindowHours\x12%\n" +
+	"\x0euptime_percent\x18\x04 \x01(\x01R\ruptimePercent\x12\x16\n" +
+	"\x06checks\x18\x05 \x01(\x05R\x06checks\x12\x1a\n" +
+	"\bfailures\x18\x06 \x01(\x05R\bfailures\x12.\n" +
+	"\x13average_response_ms\x18\a \x01(\x05R\x11averageResponseMs\x12%\n" +
+	"\x0ebucket_seconds\x18\b \x01(\x05R\rbucketSeconds\x121\n" +
+	"\abuckets\x18\t \x03(\v2\x17.libops.v1.UptimeBucketR\abuckets\x125\n" +
+	"\n" +
+	"last_probe\x18\n" +
+	" \x01(\v2\x16.libops.v1.UptimeProbeR\tlastProbe\"R\n" +
+	"\x14GetSiteUptimeRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\fwindow_hours\x18\x02 \x01(\x05R\vwindowHours\"F\n" +
+	"\x15GetSiteUptimeResponse\x12-\n" +
+	"\x06uptime\x18\x01 \x01(\v2\x15.libops.v1.SiteUptimeR\x06uptime\"c\n" +
+	"\x1cUpdateSiteHealthCheckRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12*\n" +
+	"\x11health_check_path\x18\x02 \x01(\tR\x0fhealthCheckPath\"d\n" +
+	"\x1dUpdateSiteHealthCheckResponse\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12*\n" +
+	"\x11health_check_path\x18\x02 \x01(\tR\x0fhealthCheckPath2\xe1\x02\n" +
+	"\rUptimeService\x12\x97\x01\n" +
+	"\rGetSiteUptime\x12\x1f.libops.v1.GetSiteUptimeRequest\x1a .libops.v1.GetSiteUptimeResponse\"C\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/sites/{site_id}/uptime\x90\x02\x01\x12\xb5\x01\n" +
+	"\x15UpdateSiteHealthCheck\x12'.libops.v1.UpdateSiteHealthCheckRequest\x1a(.libops.v1.UpdateSiteHealthCheckResponse\"I\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02$:\x01*2\x1f/v1/sites/{site_id}/healthCheckB\x91\x01\n" +
+	"\rcom.libops.v1B\vUptimeProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_uptime_proto_rawDescOnce sync.Once
+	file_libops_v1_uptime_proto_rawDescData []byte
+)
+
+func file_libops_v1_uptime_proto_rawDescGZIP() []byte {
+	file_libops_v1_uptime_proto_rawDescOnce.Do(func() {
+		file_libops_v1_uptime_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_uptime_proto_rawDesc), len(file_libops_v1_uptime_proto_rawDesc)))
+	})
+	return file_libops_v1_uptime_proto_rawDescData
+}
+
+var file_libops_v1_uptime_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_libops_v1_uptime_proto_goTypes = []any{
+	(*UptimeBucket)(nil),                  // 0: libops.v1.UptimeBucket
+	(*UptimeProbe)(nil),                   // 1: libops.v1.UptimeProbe
+	(*SiteUptime)(nil),                    // 2: libops.v1.SiteUptime
+	(*GetSiteUptimeRequest)(nil),          // 3: libops.v1.GetSiteUptimeRequest
+	(*GetSiteUptimeResponse)(nil),         // 4: libops.v1.GetSiteUptimeResponse
+	(*UpdateSiteHealthCheckRequest)(nil),  // 5: libops.v1.UpdateSiteHealthCheckRequest
+	(*UpdateSiteHealthCheckResponse)(nil), // 6: libops.v1.UpdateSiteHealthCheckResponse
+}
+var file_libops_v1_uptime_proto_depIdxs = []int32{
+	0, // 0: libops.v1.SiteUptime.buckets:type_name -> libops.v1.UptimeBucket
+	1, // 1: libops.v1.SiteUptime.last_probe:type_name -> libops.v1.UptimeProbe
+	2, // 2: libops.v1.GetSiteUptimeResponse.uptime:type_name -> libops.v1.SiteUptime
+	3, // 3: libops.v1.UptimeService.GetSiteUptime:input_type -> libops.v1.GetSiteUptimeRequest
+	5, // 4: libops.v1.UptimeService.UpdateSiteHealthCheck:input_type -> libops.v1.UpdateSiteHealthCheckRequest
+	4, // 5: libops.v1.UptimeService.GetSiteUptime:output_type -> libops.v1.GetSiteUptimeResponse
+	6, // 6: libops.v1.UptimeService.UpdateSiteHealthCheck:output_type -> libops.v1.UpdateSiteHealthCheckResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_uptime_proto_init() }
+func file_libops_v1_uptime_proto_init() {
+	if File_libops_v1_uptime_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_uptime_proto_rawDesc), len(file_libops_v1_uptime_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_uptime_proto_goTypes,
+		DependencyIndexes: file_libops_v1_uptime_proto_depIdxs,
+		MessageInfos:      file_libops_v1_uptime_proto_msgTypes,
+	}.Build()
+	File_libops_v1_uptime_proto = out.File
+	file_libops_v1_uptime_proto_goTypes = nil
+	file_libops_v1_uptime_proto_depIdxs = nil
+}
@@ -0,0 +1,1031 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: libops/v1/waf.proto
+
+package libopsv1
+
+import (
+	_ "github.com/libops/api/proto/libops/v1/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WafMode int32
+
+const (
+	WafMode_WAF_MODE_UNSPECIFIED    WafMode = 0
+	WafMode_WAF_MODE_DETECTION_ONLY WafMode = 1 // Matched requests are reported but let through
+	WafMode_WAF_MODE_BLOCKING       WafMode = 2 // Matched requests get a 403
+)
+
+// Enum value maps for WafMode.
+var (
+	WafMode_name = map[int32]string{
+		0: "WAF_MODE_UNSPECIFIED",
+		1: "WAF_MODE_DETECTION_ONLY",
+		2: "WAF_MODE_BLOCKING",
+	}
+	WafMode_value = map[string]int32{
+		"WAF_MODE_UNSPECIFIED":    0,
+		"WAF_MODE_DETECTION_ONLY": 1,
+		"WAF_MODE_BLOCKING":       2,
+	}
+)
+
+func (x WafMode) Enum() *WafMode {
+	p := new(WafMode)
+	*p = x
+	return p
+}
+
+func (x WafMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WafMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_libops_v1_waf_proto_enumTypes[0].Descriptor()
+}
+
+func (WafMode) Type() protoreflect.EnumType {
+	return &file_libops_v1_waf_proto_enumTypes[0]
+}
+
+func (x WafMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WafMode.Descriptor instead.
+func (WafMode) EnumDescriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{0}
+}
+
+type SiteWaf struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SiteId  string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mode    WafMode                `protobuf:"varint,3,opt,name=mode,proto3,enum=libops.v1.WafMode" json:"mode,omitempty"`
+	// OWASP core rule set paranoia level, 1 to 4; higher levels catch more
+	// attacks and more legitimate requests
+	ParanoiaLevel int32               `protobuf:"varint,4,opt,name=paranoia_level,json=paranoiaLevel,proto3" json:"paranoia_level,omitempty"`
+	Exclusions    []*WafRuleExclusion `protobuf:"bytes,5,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	UpdatedAt     int64               `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp; 0 while the WAF has never been enabled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SiteWaf) Reset() {
+	*x = SiteWaf{}
+	mi := &file_libops_v1_waf_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SiteWaf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SiteWaf) ProtoMessage() {}
+
+func (x *SiteWaf) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SiteWaf.ProtoReflect.Descriptor instead.
+func (*SiteWaf) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SiteWaf) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *SiteWaf) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SiteWaf) GetMode() WafMode {
+	if x != nil {
+		return x.Mode
+	}
+	return WafMode_WAF_MODE_UNSPECIFIED
+}
+
+func (x *SiteWaf) GetParanoiaLevel() int32 {
+	if x != nil {
+		return x.ParanoiaLevel
+	}
+	return 0
+}
+
+func (x *SiteWaf) GetExclusions() []*WafRuleExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *SiteWaf) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type WafRuleExclusion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExclusionId   string                 `protobuf:"bytes,1,opt,name=exclusion_id,json=exclusionId,proto3" json:"exclusion_id,omitempty"`
+	RuleId        int32                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`            // Core rule set rule ID, e.g. 942100
+	PathPrefix    string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // Empty excludes the rule everywhere
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WafRuleExclusion) Reset() {
+	*x = WafRuleExclusion{}
+	mi := &file_libops_v1_waf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WafRuleExclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WafRuleExclusion) ProtoMessage() {}
+
+func (x *WafRuleExclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WafRuleExclusion.ProtoReflect.Descriptor instead.
+func (*WafRuleExclusion) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WafRuleExclusion) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+func (x *WafRuleExclusion) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *WafRuleExclusion) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *WafRuleExclusion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WafRuleExclusion) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// WafBlock is a request the WAF matched
+type WafBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int32                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Uri           string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Blocked       bool                   `protobuf:"varint,6,opt,name=blocked,proto3" json:"blocked,omitempty"`                         // False when the WAF only detected the request
+	OccurredAt    int64                  `protobuf:"varint,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WafBlock) Reset() {
+	*x = WafBlock{}
+	mi := &file_libops_v1_waf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WafBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WafBlock) ProtoMessage() {}
+
+func (x *WafBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WafBlock.ProtoReflect.Descriptor instead.
+func (*WafBlock) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WafBlock) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *WafBlock) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WafBlock) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *WafBlock) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *WafBlock) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *WafBlock) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *WafBlock) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+// WafRuleReport is how often one rule matched
+type WafRuleReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int32                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Matches       int64                  `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	Blocked       int64                  `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`                   // Matches that were blocked rather than only detected
+	LastSeen      int64                  `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // Unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WafRuleReport) Reset() {
+	*x = WafRuleReport{}
+	mi := &file_libops_v1_waf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WafRuleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WafRuleReport) ProtoMessage() {}
+
+func (x *WafRuleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WafRuleReport.ProtoReflect.Descriptor instead.
+func (*WafRuleReport) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WafRuleReport) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *WafRuleReport) GetMatches() int64 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *WafRuleReport) GetBlocked() int64 {
+	if x != nil {
+		return x.Blocked
+	}
+	return 0
+}
+
+func (x *WafRuleReport) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type GetSiteWafRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteWafRequest) Reset() {
+	*x = GetSiteWafRequest{}
+	mi := &file_libops_v1_waf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteWafRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteWafRequest) ProtoMessage() {}
+
+func (x *GetSiteWafRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteWafRequest.ProtoReflect.Descriptor instead.
+func (*GetSiteWafRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSiteWafRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+type GetSiteWafResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waf           *SiteWaf               `protobuf:"bytes,1,opt,name=waf,proto3" json:"waf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSiteWafResponse) Reset() {
+	*x = GetSiteWafResponse{}
+	mi := &file_libops_v1_waf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSiteWafResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSiteWafResponse) ProtoMessage() {}
+
+func (x *GetSiteWafResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSiteWafResponse.ProtoReflect.Descriptor instead.
+func (*GetSiteWafResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSiteWafResponse) GetWaf() *SiteWaf {
+	if x != nil {
+		return x.Waf
+	}
+	return nil
+}
+
+type UpdateSiteWafRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Mode          WafMode                `protobuf:"varint,3,opt,name=mode,proto3,enum=libops.v1.WafMode" json:"mode,omitempty"`                 // Defaults to blocking
+	ParanoiaLevel int32                  `protobuf:"varint,4,opt,name=paranoia_level,json=paranoiaLevel,proto3" json:"paranoia_level,omitempty"` // Defaults to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteWafRequest) Reset() {
+	*x = UpdateSiteWafRequest{}
+	mi := &file_libops_v1_waf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteWafRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteWafRequest) ProtoMessage() {}
+
+func (x *UpdateSiteWafRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteWafRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSiteWafRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateSiteWafRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *UpdateSiteWafRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateSiteWafRequest) GetMode() WafMode {
+	if x != nil {
+		return x.Mode
+	}
+	return WafMode_WAF_MODE_UNSPECIFIED
+}
+
+func (x *UpdateSiteWafRequest) GetParanoiaLevel() int32 {
+	if x != nil {
+		return x.ParanoiaLevel
+	}
+	return 0
+}
+
+type UpdateSiteWafResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waf           *SiteWaf               `protobuf:"bytes,1,opt,name=waf,proto3" json:"waf,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSiteWafResponse) Reset() {
+	*x = UpdateSiteWafResponse{}
+	mi := &file_libops_v1_waf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSiteWafResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSiteWafResponse) ProtoMessage() {}
+
+func (x *UpdateSiteWafResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSiteWafResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSiteWafResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateSiteWafResponse) GetWaf() *SiteWaf {
+	if x != nil {
+		return x.Waf
+	}
+	return nil
+}
+
+type CreateWafRuleExclusionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	RuleId        int32                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	PathPrefix    string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"` // Starts with "/"; empty excludes the rule everywhere
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWafRuleExclusionRequest) Reset() {
+	*x = CreateWafRuleExclusionRequest{}
+	mi := &file_libops_v1_waf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWafRuleExclusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWafRuleExclusionRequest) ProtoMessage() {}
+
+func (x *CreateWafRuleExclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWafRuleExclusionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWafRuleExclusionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateWafRuleExclusionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *CreateWafRuleExclusionRequest) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *CreateWafRuleExclusionRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *CreateWafRuleExclusionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateWafRuleExclusionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exclusion     *WafRuleExclusion      `protobuf:"bytes,1,opt,name=exclusion,proto3" json:"exclusion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWafRuleExclusionResponse) Reset() {
+	*x = CreateWafRuleExclusionResponse{}
+	mi := &file_libops_v1_waf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWafRuleExclusionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWafRuleExclusionResponse) ProtoMessage() {}
+
+func (x *CreateWafRuleExclusionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWafRuleExclusionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWafRuleExclusionResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateWafRuleExclusionResponse) GetExclusion() *WafRuleExclusion {
+	if x != nil {
+		return x.Exclusion
+	}
+	return nil
+}
+
+type DeleteWafRuleExclusionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	ExclusionId   string                 `protobuf:"bytes,2,opt,name=exclusion_id,json=exclusionId,proto3" json:"exclusion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWafRuleExclusionRequest) Reset() {
+	*x = DeleteWafRuleExclusionRequest{}
+	mi := &file_libops_v1_waf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWafRuleExclusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWafRuleExclusionRequest) ProtoMessage() {}
+
+func (x *DeleteWafRuleExclusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWafRuleExclusionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWafRuleExclusionRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteWafRuleExclusionRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *DeleteWafRuleExclusionRequest) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+type GetWafReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SiteId        string                 `protobuf:"bytes,1,opt,name=site_id,json=siteId,proto3" json:"site_id,omitempty"`
+	Hours         int32                  `protobuf:"varint,2,opt,name=hours,proto3" json:"hours,omitempty"` // How far back to report, at most 720; defaults to 24
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWafReportRequest) Reset() {
+	*x = GetWafReportRequest{}
+	mi := &file_libops_v1_waf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWafReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWafReportRequest) ProtoMessage() {}
+
+func (x *GetWafReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWafReportRequest.ProtoReflect.Descriptor instead.
+func (*GetWafReportRequest) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetWafReportRequest) GetSiteId() string {
+	if x != nil {
+		return x.SiteId
+	}
+	return ""
+}
+
+func (x *GetWafReportRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type GetWafReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*WafRuleReport       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Recent        []*WafBlock            `protobuf:"bytes,2,rep,name=recent,proto3" json:"recent,omitempty"` // At most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWafReportResponse) Reset() {
+	*x = GetWafReportResponse{}
+	mi := &file_libops_v1_waf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWafReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWafReportResponse) ProtoMessage() {}
+
+func (x *GetWafReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_libops_v1_waf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWafReportResponse.ProtoReflect.Descriptor instead.
+func (*GetWafReportResponse) Descriptor() ([]byte, []int) {
+	return file_libops_v1_waf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetWafReportResponse) GetRules() []*WafRuleReport {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetWafReportResponse) GetRecent() []*WafBlock {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+var File_libops_v1_waf_proto protoreflect.FileDescriptor
+
+const file_libops_v1_waf_proto_rawDesc = "" +
+	"\n" +
+	"\x13libops/v1/waf.proto\x12\tlibops.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1dlibops/v1/options/scope.proto\"\xe7\x01\n" +
+	"\aSiteWaf\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12&\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x12.libops.v1.WafModeR\x04mode\x12%\n" +
+	"\x0eparanoia_level\x18\x04 \x01(\x05R\rparanoiaLevel\x12;\n" +
+	"\n" +
+	"exclusions\x18\x05 \x03(\v2\x1b.libops.v1.WafRuleExclusionR\n" +
+	"exclusions\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xa6\x01\n" +
+	"\x10WafRuleExclusion\x12!\n" +
+	"\fexclusion_id\x18\x01 \x01(\tR\vexclusionId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\x12\x1f\n" +
+	"\vpath_prefix\x18\x03 \x01(\tR\n" +
+	"pathPrefix\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\xbf\x01\n" +
+	"\bWafBlock\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x18\n" +
+	"\ablocked\x18\x06 \x01(\bR\ablocked\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\x03R\n" +
+	"occurredAt\"y\n" +
+	"\rWafRuleReport\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\x03R\amatches\x12\x18\n" +
+	"\ablocked\x18\x03 \x01(\x03R\ablocked\x12\x1b\n" +
+	"\tlast_seen\x18\x04 \x01(\x03R\blastSeen\",\n" +
+	"\x11GetSiteWafRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\":\n" +
+	"\x12GetSiteWafResponse\x12$\n" +
+	"\x03waf\x18\x01 \x01(\v2\x12.libops.v1.SiteWafR\x03waf\"\x98\x01\n" +
+	"\x14UpdateSiteWafRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12&\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x12.libops.v1.WafModeR\x04mode\x12%\n" +
+	"\x0eparanoia_level\x18\x04 \x01(\x05R\rparanoiaLevel\"=\n" +
+	"\x15UpdateSiteWafResponse\x12$\n" +
+	"\x03waf\x18\x01 \x01(\v2\x12.libops.v1.SiteWafR\x03waf\"\x8a\x01\n" +
+	"\x1dCreateWafRuleExclusionRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\x12\x1f\n" +
+	"\vpath_prefix\x18\x03 \x01(\tR\n" +
+	"pathPrefix\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"[\n" +
+	"\x1eCreateWafRuleExclusionResponse\x129\n" +
+	"\texclusion\x18\x01 \x01(\v2\x1b.libops.v1.WafRuleExclusionR\texclusion\"[\n" +
+	"\x1dDeleteWafRuleExclusionRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12!\n" +
+	"\fexclusion_id\x18\x02 \x01(\tR\vexclusionId\"D\n" +
+	"\x13GetWafReportRequest\x12\x17\n" +
+	"\asite_id\x18\x01 \x01(\tR\x06siteId\x12\x14\n" +
+	"\x05hours\x18\x02 \x01(\x05R\x05hours\"s\n" +
+	"\x14GetWafReportResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.libops.v1.WafRuleReportR\x05rules\x12+\n" +
+	"\x06recent\x18\x02 \x03(\v2\x13.libops.v1.WafBlockR\x06recent*W\n" +
+	"\aWafMode\x12\x18\n" +
+	"\x14WAF_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WAF_MODE_DETECTION_ONLY\x10\x01\x12\x15\n" +
+	"\x11WAF_MODE_BLOCKING\x10\x022\xc2\x06\n" +
+	"\n" +
+	"WafService\x12\x8b\x01\n" +
+	"\n" +
+	"GetSiteWaf\x12\x1c.libops.v1.GetSiteWafRequest\x1a\x1d.libops.v1.GetSiteWafResponse\"@\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/sites/{site_id}/waf\x90\x02\x01\x12\x95\x01\n" +
+	"\rUpdateSiteWaf\x12\x1f.libops.v1.UpdateSiteWafRequest\x1a .libops.v1.UpdateSiteWafResponse\"A\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/sites/{site_id}/waf\x12\xbb\x01\n" +
+	"\x16CreateWafRuleExclusion\x12(.libops.v1.CreateWafRuleExclusionRequest\x1a).libops.v1.CreateWafRuleExclusionResponse\"L\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/sites/{site_id}/waf/exclusions\x12\xb4\x01\n" +
+	"\x16DeleteWafRuleExclusion\x12(.libops.v1.DeleteWafRuleExclusionRequest\x1a\x16.google.protobuf.Empty\"X\x92\xb5\x18\x1b\b\x05\x10\x02\x18\x01\"\n" +
+	"write:site*\asite_id\x82\xd3\xe4\x93\x023*1/v1/sites/{site_id}/waf/exclusions/{exclusion_id}\x12\x98\x01\n" +
+	"\fGetWafReport\x12\x1e.libops.v1.GetWafReportRequest\x1a\x1f.libops.v1.GetWafReportResponse\"G\x92\xb5\x18\x1a\b\x05\x10\x01\x18\x01\"\tread:site*\asite_id\x82\xd3\xe4\x93\x02 \x12\x1e/v1/sites/{site_id}/waf/report\x90\x02\x01B\x8e\x01\n" +
+	"\rcom.libops.v1B\bWafProtoP\x01Z.github.com/libops/api/proto/libops/v1;libopsv1\xa2\x02\x03LXX\xaa\x02\tLibops.V1\xca\x02\tLibops\\V1\xe2\x02\x15Libops\\V1\\GPBMetadata\xea\x02\n" +
+	"Libops::V1b\x06proto3"
+
+var (
+	file_libops_v1_waf_proto_rawDescOnce sync.Once
+	file_libops_v1_waf_proto_rawDescData []byte
+)
+
+func file_libops_v1_waf_proto_rawDescGZIP() []byte {
+	file_libops_v1_waf_proto_rawDescOnce.Do(func() {
+		file_libops_v1_waf_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_libops_v1_waf_proto_rawDesc), len(file_libops_v1_waf_proto_rawDesc)))
+	})
+	return file_libops_v1_waf_proto_rawDescData
+}
+
+var file_libops_v1_waf_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_libops_v1_waf_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_libops_v1_waf_proto_goTypes = []any{
+	(WafMode)(0),                           // 0: libops.v1.WafMode
+	(*SiteWaf)(nil),                        // 1: libops.v1.SiteWaf
+	(*WafRuleExclusion)(nil),               // 2: libops.v1.WafRuleExclusion
+	(*WafBlock)(nil),                       // 3: libops.v1.WafBlock
+	(*WafRuleReport)(nil),                  // 4: libops.v1.WafRuleReport
+	(*GetSiteWafRequest)(nil),              // 5: libops.v1.GetSiteWafRequest
+	(*GetSiteWafResponse)(nil),             // 6: libops.v1.GetSiteWafResponse
+	(*UpdateSiteWafRequest)(nil),           // 7: libops.v1.UpdateSiteWafRequest
+	(*UpdateSiteWafResponse)(nil),          // 8: libops.v1.UpdateSiteWafResponse
+	(*CreateWafRuleExclusionRequest)(nil),  // 9: libops.v1.CreateWafRuleExclusionRequest
+	(*CreateWafRuleExclusionResponse)(nil), // 10: libops.v1.CreateWafRuleExclusionResponse
+	(*DeleteWafRuleExclusionRequest)(nil),  // 11: libops.v1.DeleteWafRuleExclusionRequest
+	(*GetWafReportRequest)(nil),            // 12: libops.v1.GetWafReportRequest
+	(*GetWafReportResponse)(nil),           // 13: libops.v1.GetWafReportResponse
+	(*emptypb.Empty)(nil),                  // 14: google.protobuf.Empty
+}
+var file_libops_v1_waf_proto_depIdxs = []int32{
+	0,  // 0: libops.v1.SiteWaf.mode:type_name -> libops.v1.WafMode
+	2,  // 1: libops.v1.SiteWaf.exclusions:type_name -> libops.v1.WafRuleExclusion
+	1,  // 2: libops.v1.GetSiteWafResponse.waf:type_name -> libops.v1.SiteWaf
+	0,  // 3: libops.v1.UpdateSiteWafRequest.mode:type_name -> libops.v1.WafMode
+	1,  // 4: libops.v1.UpdateSiteWafResponse.waf:type_name -> libops.v1.SiteWaf
+	2,  // 5: libops.v1.CreateWafRuleExclusionResponse.exclusion:type_name -> libops.v1.WafRuleExclusion
+	4,  // 6: libops.v1.GetWafReportResponse.rules:type_name -> libops.v1.WafRuleReport
+	3,  // 7: libops.v1.GetWafReportResponse.recent:type_name -> libops.v1.WafBlock
+	5,  // 8: libops.v1.WafService.GetSiteWaf:input_type -> libops.v1.GetSiteWafRequest
+	7,  // 9: libops.v1.WafService.UpdateSiteWaf:input_type -> libops.v1.UpdateSiteWafRequest
+	9,  // 10: libops.v1.WafService.CreateWafRuleExclusion:input_type -> libops.v1.CreateWafRuleExclusionRequest
+	11, // 11: libops.v1.WafService.DeleteWafRuleExclusion:input_type -> libops.v1.DeleteWafRuleExclusionRequest
+	12, // 12: libops.v1.WafService.GetWafReport:input_type -> libops.v1.GetWafReportRequest
+	6,  // 13: libops.v1.WafService.GetSiteWaf:output_type -> libops.v1.GetSiteWafResponse
+	8,  // 14: libops.v1.WafService.UpdateSiteWaf:output_type -> libops.v1.UpdateSiteWafResponse
+	10, // 15: libops.v1.WafService.CreateWafRuleExclusion:output_type -> libops.v1.CreateWafRuleExclusionResponse
+	14, // 16: libops.v1.WafService.DeleteWafRuleExclusion:output_type -> google.protobuf.Empty
+	13, // 17: libops.v1.WafService.GetWafReport:output_type -> libops.v1.GetWafReportResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_libops_v1_waf_proto_init() }
+func file_libops_v1_waf_proto_init() {
+	if File_libops_v1_waf_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_libops_v1_waf_proto_rawDesc), len(file_libops_v1_waf_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_libops_v1_waf_proto_goTypes,
+		DependencyIndexes: file_libops_v1_waf_proto_depIdxs,
+		EnumInfos:         file_libops_v1_waf_proto_enumTypes,
+		MessageInfos:      file_libops_v1_waf_proto_msgTypes,
+	}.Build()
+	File_libops_v1_waf_proto = out.File
+	file_libops_v1_waf_proto_goTypes = nil
+	file_libops_v1_waf_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/libops/api/provider/internal/provider"
+)
+
+// The acceptance tests run against a live API, normally the CI docker-compose
+// stack (see ci/run-tests.sh). They need TF_ACC, LIBOPS_ENDPOINT and
+// LIBOPS_API_KEY, and a terraform binary on the PATH.
+
+var testProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"libops": func() (tfprotov6.ProviderServer, error) { return provider.New("test"), nil },
+}
+
+func testAccPreCheck(t *testing.T) {
+	for _, env := range []string{"LIBOPS_ENDPOINT", "LIBOPS_API_KEY"} {
+		if os.Getenv(env) == "" {
+			t.Fatalf("%s must be set for acceptance tests", env)
+		}
+	}
+}
+
+const testAccConfig = `
+resource "libops_organization" "test" {
+  name   = "tf-acc-%[1]s"
+  labels = { managed-by = "terraform" }
+}
+
+resource "libops_project" "test" {
+  organization_id = libops_organization.test.id
+  name            = "tf-acc-%[1]s"
+  machine_type    = "%[2]s"
+}
+
+resource "libops_site" "test" {
+  project_id        = libops_project.test.id
+  name              = "tf-acc-%[1]s"
+  github_repository = "repo/test"
+  github_ref        = "main"
+  compose_file      = "docker-compose.yml"
+  port              = 80
+  application_type  = "generic"
+}
+
+resource "libops_secret" "test" {
+  site_id = libops_site.test.id
+  name    = "TF_ACC_SECRET"
+  value   = "%[3]s"
+}
+
+resource "libops_firewall_rule" "test" {
+  project_id = libops_project.test.id
+  type       = "https_allowed"
+  cidr       = "203.0.113.0/24"
+  name       = "tf-acc-%[1]s"
+}
+
+resource "libops_member" "test" {
+  organization_id = libops_organization.test.id
+  email           = "art.vandelay@vandelay.com"
+  role            = "%[4]s"
+}
+
+resource "libops_domain" "test" {
+  site_id = libops_site.test.id
+  domain  = "tf-acc-%[1]s.example.edu"
+}
+`
+
+// TestAccResources creates every resource, updates the updatable ones in
+// place and imports them back.
+func TestAccResources(t *testing.T) {
+	suffix := resource.UniqueId()[len(resource.UniqueIdPrefix):]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccConfig, suffix, "e2-medium", "first", "developer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("libops_organization.test", "id"),
+					resource.TestCheckResourceAttr("libops_project.test", "machine_type", "e2-medium"),
+					resource.TestCheckResourceAttrSet("libops_site.test", "id"),
+					resource.TestCheckResourceAttrSet("libops_secret.test", "id"),
+					resource.TestCheckResourceAttrSet("libops_firewall_rule.test", "id"),
+					resource.TestCheckResourceAttr("libops_member.test", "id", "art.vandelay@vandelay.com"),
+					resource.TestCheckResourceAttrSet("libops_member.test", "account_id"),
+					resource.TestCheckResourceAttr("libops_domain.test", "domain", "tf-acc-"+suffix+".example.edu"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccConfig, suffix, "e2-standard-2", "second", "read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("libops_project.test", "machine_type", "e2-standard-2"),
+					resource.TestCheckResourceAttr("libops_secret.test", "value", "second"),
+					resource.TestCheckResourceAttr("libops_member.test", "role", "read"),
+				),
+			},
+			{
+				ResourceName:      "libops_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "libops_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					project := s.RootModule().Resources["libops_project.test"].Primary
+					return project.Attributes["organization_id"] + "/" + project.ID, nil
+				},
+			},
+			{
+				ResourceName:            "libops_site.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"github_repository", "compose_path", "compose_file", "port", "application_type"},
+			},
+			{
+				ResourceName:            "libops_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					secret := s.RootModule().Resources["libops_secret.test"].Primary
+					return "site/" + secret.Attributes["site_id"] + "/" + secret.ID, nil
+				},
+			},
+			{
+				ResourceName:      "libops_firewall_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rule := s.RootModule().Resources["libops_firewall_rule.test"].Primary
+					return "project/" + rule.Attributes["project_id"] + "/" + rule.ID, nil
+				},
+			},
+			{
+				ResourceName:      "libops_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					member := s.RootModule().Resources["libops_member.test"].Primary
+					return "organization/" + member.Attributes["organization_id"] + "/" + member.ID, nil
+				},
+			},
+			{
+				ResourceName:      "libops_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
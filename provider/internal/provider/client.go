@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/libops/api/proto/libops/v1/libopsv1connect"
+)
+
+// client holds the Connect clients the resources call.
+type client struct {
+	organizations        libopsv1connect.OrganizationServiceClient
+	projects             libopsv1connect.ProjectServiceClient
+	sites                libopsv1connect.SiteServiceClient
+	organizationSecrets  libopsv1connect.OrganizationSecretServiceClient
+	projectSecrets       libopsv1connect.ProjectSecretServiceClient
+	siteSecrets          libopsv1connect.SiteSecretServiceClient
+	organizationFirewall libopsv1connect.FirewallServiceClient
+	projectFirewall      libopsv1connect.ProjectFirewallServiceClient
+	siteFirewall         libopsv1connect.SiteFirewallServiceClient
+	organizationMembers  libopsv1connect.MemberServiceClient
+	projectMembers       libopsv1connect.ProjectMemberServiceClient
+	siteMembers          libopsv1connect.SiteMemberServiceClient
+	siteDomains          libopsv1connect.SiteDomainServiceClient
+}
+
+// newClient creates clients for the API at endpoint that authenticate with an
+// API key.
+func newClient(endpoint, apiKey, userAgent string) *client {
+	endpoint = strings.TrimRight(endpoint, "/")
+	httpClient := &http.Client{Timeout: 60 * time.Second}
+	opts := []connect.ClientOption{
+		connect.WithInterceptors(&authInterceptor{apiKey: apiKey, userAgent: userAgent}),
+	}
+
+	return &client{
+		organizations:        libopsv1connect.NewOrganizationServiceClient(httpClient, endpoint, opts...),
+		projects:             libopsv1connect.NewProjectServiceClient(httpClient, endpoint, opts...),
+		sites:                libopsv1connect.NewSiteServiceClient(httpClient, endpoint, opts...),
+		organizationSecrets:  libopsv1connect.NewOrganizationSecretServiceClient(httpClient, endpoint, opts...),
+		projectSecrets:       libopsv1connect.NewProjectSecretServiceClient(httpClient, endpoint, opts...),
+		siteSecrets:          libopsv1connect.NewSiteSecretServiceClient(httpClient, endpoint, opts...),
+		organizationFirewall: libopsv1connect.NewFirewallServiceClient(httpClient, endpoint, opts...),
+		projectFirewall:      libopsv1connect.NewProjectFirewallServiceClient(httpClient, endpoint, opts...),
+		siteFirewall:         libopsv1connect.NewSiteFirewallServiceClient(httpClient, endpoint, opts...),
+		organizationMembers:  libopsv1connect.NewMemberServiceClient(httpClient, endpoint, opts...),
+		projectMembers:       libopsv1connect.NewProjectMemberServiceClient(httpClient, endpoint, opts...),
+		siteMembers:          libopsv1connect.NewSiteMemberServiceClient(httpClient, endpoint, opts...),
+		siteDomains:          libopsv1connect.NewSiteDomainServiceClient(httpClient, endpoint, opts...),
+	}
+}
+
+// authInterceptor sends the API key as a bearer token on every request.
+type authInterceptor struct {
+	apiKey    string
+	userAgent string
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set("Authorization", "Bearer "+i.apiKey)
+		req.Header().Set("User-Agent", i.userAgent)
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
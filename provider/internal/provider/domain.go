@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func domainResource() *resource {
+	return &resource{
+		typeName:    "libops_domain",
+		description: "A custom domain a site is served on. A domain can only belong to one site.",
+		attributes: []attribute{
+			{name: "id", typ: typeString, computed: true, description: "<site_id>/<domain>."},
+			{name: "site_id", typ: typeString, required: true, forceNew: true, description: "ID of the site served on the domain."},
+			{name: "domain", typ: typeString, required: true, forceNew: true, description: "Lowercase hostname, e.g. digital.example.edu."},
+		},
+		validate: func(config values) error {
+			if d, ok := config["domain"].(string); ok && d != strings.ToLower(d) {
+				return fmt.Errorf("domain must be lowercase, got %q", d)
+			}
+			return nil
+		},
+		create: createDomain,
+		read:   readDomain,
+		delete: deleteDomain,
+		importState: func(id string) (values, error) {
+			siteID, domain, ok := strings.Cut(id, "/")
+			if !ok || siteID == "" || domain == "" {
+				return nil, fmt.Errorf("expected <site_id>/<domain>, got %q", id)
+			}
+			return values{"id": id, "site_id": siteID, "domain": domain}, nil
+		},
+	}
+}
+
+func createDomain(ctx context.Context, c *client, plan values) (values, error) {
+	resp, err := c.siteDomains.CreateSiteDomain(ctx, connect.NewRequest(&libopsv1.CreateSiteDomainRequest{
+		SiteId: plan.str("site_id"),
+		Domain: plan.str("domain"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	state := plan.clone()
+	state["id"] = plan.str("site_id") + "/" + resp.Msg.Domain.Domain
+	return state, nil
+}
+
+func readDomain(ctx context.Context, c *client, state values) (values, error) {
+	pageToken := ""
+	for {
+		resp, err := c.siteDomains.ListSiteDomains(ctx, connect.NewRequest(&libopsv1.ListSiteDomainsRequest{
+			SiteId:    state.str("site_id"),
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range resp.Msg.Domains {
+			if d.Domain == state.str("domain") {
+				return state, nil
+			}
+		}
+		if resp.Msg.NextPageToken == "" {
+			return nil, errNotFound
+		}
+		pageToken = resp.Msg.NextPageToken
+	}
+}
+
+func deleteDomain(ctx context.Context, c *client, state values) error {
+	_, err := c.siteDomains.DeleteSiteDomain(ctx, connect.NewRequest(&libopsv1.DeleteSiteDomainRequest{
+		SiteId: state.str("site_id"),
+		Domain: state.str("domain"),
+	}))
+	return err
+}
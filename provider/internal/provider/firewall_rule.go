@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+// firewallRuleTypes maps the type attribute to the API's rule types.
+var firewallRuleTypes = map[string]libopsv1.FirewallRuleType{
+	"https_allowed": libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_HTTPS_ALLOWED,
+	"ssh_allowed":   libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_SSH_ALLOWED,
+	"blocked":       libopsv1.FirewallRuleType_FIREWALL_RULE_TYPE_BLOCKED,
+}
+
+func firewallRuleTypeName(t libopsv1.FirewallRuleType) string {
+	for name, ruleType := range firewallRuleTypes {
+		if ruleType == t {
+			return name
+		}
+	}
+	return strings.ToLower(strings.TrimPrefix(t.String(), "FIREWALL_RULE_TYPE_"))
+}
+
+// firewallRule is a rule at any level.
+type firewallRule struct {
+	ruleID   string
+	ruleType libopsv1.FirewallRuleType
+	cidr     string
+	name     string
+}
+
+func firewallRuleResource() *resource {
+	return &resource{
+		typeName:    "libops_firewall_rule",
+		description: "A firewall rule on an organization, a project or a site. Rules can't be changed, so any change replaces the rule.",
+		attributes: append(scopeAttributes("firewall rule"),
+			attribute{name: "id", typ: typeString, computed: true, description: "Firewall rule ID."},
+			attribute{name: "type", typ: typeString, required: true, forceNew: true, description: "One of https_allowed, ssh_allowed or blocked."},
+			attribute{name: "cidr", typ: typeString, required: true, forceNew: true, description: "IP range the rule applies to, e.g. 203.0.113.0/24."},
+			attribute{name: "name", typ: typeString, required: true, forceNew: true, description: "Rule name."},
+		),
+		validate: func(config values) error {
+			if err := validateScope(config); err != nil {
+				return err
+			}
+			if t, ok := config["type"].(string); ok {
+				if _, ok := firewallRuleTypes[t]; !ok {
+					return fmt.Errorf("type must be one of https_allowed, ssh_allowed or blocked, got %q", t)
+				}
+			}
+			return nil
+		},
+		create:      createFirewallRule,
+		read:        readFirewallRule,
+		delete:      deleteFirewallRule,
+		importState: func(id string) (values, error) { return parseScopedImportID(id, "id") },
+	}
+}
+
+func createFirewallRule(ctx context.Context, c *client, plan values) (values, error) {
+	parent, err := scopeOf(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleType := firewallRuleTypes[plan.str("type")]
+	var ruleID string
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationFirewall.CreateOrganizationFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateOrganizationFirewallRuleRequest{
+			OrganizationId: parent.id,
+			RuleType:       ruleType,
+			Cidr:           plan.str("cidr"),
+			Name:           plan.str("name"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		ruleID = resp.Msg.Rule.RuleId
+	case levelProject:
+		resp, err := c.projectFirewall.CreateProjectFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateProjectFirewallRuleRequest{
+			ProjectId: parent.id,
+			RuleType:  ruleType,
+			Cidr:      plan.str("cidr"),
+			Name:      plan.str("name"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		ruleID = resp.Msg.Rule.RuleId
+	case levelSite:
+		resp, err := c.siteFirewall.CreateSiteFirewallRule(ctx, connect.NewRequest(&libopsv1.CreateSiteFirewallRuleRequest{
+			SiteId:   parent.id,
+			RuleType: ruleType,
+			Cidr:     plan.str("cidr"),
+			Name:     plan.str("name"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		ruleID = resp.Msg.Rule.RuleId
+	}
+
+	state := plan.clone()
+	state["id"] = ruleID
+	return state, nil
+}
+
+// readFirewallRule finds the rule among its parent's rules; there's no RPC to
+// get a single rule.
+func readFirewallRule(ctx context.Context, c *client, state values) (values, error) {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := listFirewallRules(ctx, c, parent)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.ruleID == state.str("id") {
+			newState := state.clone()
+			newState["type"] = firewallRuleTypeName(rule.ruleType)
+			newState["cidr"] = rule.cidr
+			newState["name"] = rule.name
+			return newState, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func listFirewallRules(ctx context.Context, c *client, parent scope) ([]firewallRule, error) {
+	var rules []firewallRule
+	pageToken := ""
+	for {
+		var nextPageToken string
+		switch parent.level {
+		case levelOrganization:
+			resp, err := c.organizationFirewall.ListOrganizationFirewallRules(ctx, connect.NewRequest(&libopsv1.ListOrganizationFirewallRulesRequest{
+				OrganizationId: parent.id,
+				PageToken:      pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Msg.Rules {
+				rules = append(rules, firewallRule{ruleID: r.RuleId, ruleType: r.RuleType, cidr: r.Cidr, name: r.Name})
+			}
+			nextPageToken = resp.Msg.NextPageToken
+		case levelProject:
+			resp, err := c.projectFirewall.ListProjectFirewallRules(ctx, connect.NewRequest(&libopsv1.ListProjectFirewallRulesRequest{
+				ProjectId: parent.id,
+				PageToken: pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Msg.Rules {
+				rules = append(rules, firewallRule{ruleID: r.RuleId, ruleType: r.RuleType, cidr: r.Cidr, name: r.Name})
+			}
+			nextPageToken = resp.Msg.NextPageToken
+		case levelSite:
+			resp, err := c.siteFirewall.ListSiteFirewallRules(ctx, connect.NewRequest(&libopsv1.ListSiteFirewallRulesRequest{
+				SiteId:    parent.id,
+				PageToken: pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range resp.Msg.Rules {
+				rules = append(rules, firewallRule{ruleID: r.RuleId, ruleType: r.RuleType, cidr: r.Cidr, name: r.Name})
+			}
+			nextPageToken = resp.Msg.NextPageToken
+		}
+		if nextPageToken == "" {
+			return rules, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func deleteFirewallRule(ctx context.Context, c *client, state values) error {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return err
+	}
+
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationFirewall.DeleteOrganizationFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationFirewallRuleRequest{
+			OrganizationId: parent.id,
+			RuleId:         state.str("id"),
+		}))
+	case levelProject:
+		_, err = c.projectFirewall.DeleteProjectFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteProjectFirewallRuleRequest{
+			ProjectId: parent.id,
+			RuleId:    state.str("id"),
+		}))
+	case levelSite:
+		_, err = c.siteFirewall.DeleteSiteFirewallRule(ctx, connect.NewRequest(&libopsv1.DeleteSiteFirewallRuleRequest{
+			SiteId: parent.id,
+			RuleId: state.str("id"),
+		}))
+	}
+	return err
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func memberResource() *resource {
+	return &resource{
+		typeName: "libops_member",
+		description: "A member of an organization, a project or a site. Adding someone without a LibOps account " +
+			"invites them by email; the member is pending until they accept.",
+		attributes: append(scopeAttributes("member"),
+			attribute{name: "id", typ: typeString, computed: true, description: "The member's email address."},
+			attribute{name: "email", typ: typeString, required: true, forceNew: true, description: "Email address of the member or invitee."},
+			attribute{name: "role", typ: typeString, required: true, description: "Role of the member: owner, developer or read."},
+			attribute{name: "account_id", typ: typeString, computed: true, description: "Account ID of the member, empty while the invitation is pending."},
+			attribute{name: "invited", typ: typeBool, computed: true, description: "Whether the member was invited and hasn't accepted yet."},
+		),
+		validate: validateScope,
+		create:   createMember,
+		read:     readMember,
+		update:   updateMember,
+		delete:   deleteMember,
+		importState: func(id string) (values, error) {
+			state, err := parseScopedImportID(id, "email")
+			if err != nil {
+				return nil, err
+			}
+			state["id"] = state.str("email")
+			return state, nil
+		},
+	}
+}
+
+func createMember(ctx context.Context, c *client, plan values) (values, error) {
+	parent, err := scopeOf(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	email, role := plan.str("email"), plan.str("role")
+	var member *libopsv1.MemberDetail
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationMembers.CreateOrganizationMember(ctx, connect.NewRequest(&libopsv1.CreateOrganizationMemberRequest{
+			OrganizationId: parent.id,
+			Email:          email,
+			Role:           role,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		member = resp.Msg.Member
+	case levelProject:
+		resp, err := c.projectMembers.CreateProjectMember(ctx, connect.NewRequest(&libopsv1.CreateProjectMemberRequest{
+			ProjectId: parent.id,
+			Email:     email,
+			Role:      role,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		member = resp.Msg.Member
+	case levelSite:
+		resp, err := c.siteMembers.CreateSiteMember(ctx, connect.NewRequest(&libopsv1.CreateSiteMemberRequest{
+			SiteId: parent.id,
+			Email:  email,
+			Role:   role,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		member = resp.Msg.Member
+	}
+
+	state := plan.clone()
+	state["id"] = email
+	state["account_id"] = ""
+	state["invited"] = member == nil
+	if member != nil {
+		state["account_id"] = member.AccountId
+	}
+	return state, nil
+}
+
+// readMember finds the member among its parent's members. A pending invitation
+// stays in state until it turns into a member.
+func readMember(ctx context.Context, c *client, state values) (values, error) {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := findMember(ctx, c, parent, state)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		if state.bool("invited") {
+			return state, nil
+		}
+		return nil, errNotFound
+	}
+
+	newState := state.clone()
+	newState["id"] = state.str("email")
+	newState["account_id"] = member.AccountId
+	newState["role"] = member.Role
+	newState["invited"] = false
+	return newState, nil
+}
+
+func updateMember(ctx context.Context, c *client, prior, plan values) (values, error) {
+	parent, err := scopeOf(prior)
+	if err != nil {
+		return nil, err
+	}
+	accountID := prior.str("account_id")
+	if accountID == "" {
+		return nil, fmt.Errorf("%s hasn't accepted the invitation yet; change the role once they have", prior.str("email"))
+	}
+
+	role := plan.str("role")
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"role"}}
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationMembers.UpdateOrganizationMember(ctx, connect.NewRequest(&libopsv1.UpdateOrganizationMemberRequest{
+			OrganizationId: parent.id,
+			AccountId:      accountID,
+			Role:           role,
+			UpdateMask:     mask,
+		}))
+	case levelProject:
+		_, err = c.projectMembers.UpdateProjectMember(ctx, connect.NewRequest(&libopsv1.UpdateProjectMemberRequest{
+			ProjectId:  parent.id,
+			AccountId:  accountID,
+			Role:       role,
+			UpdateMask: mask,
+		}))
+	case levelSite:
+		_, err = c.siteMembers.UpdateSiteMember(ctx, connect.NewRequest(&libopsv1.UpdateSiteMemberRequest{
+			SiteId:     parent.id,
+			AccountId:  accountID,
+			Role:       role,
+			UpdateMask: mask,
+		}))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// deleteMember removes the member. An invitation that was never accepted can't
+// be withdrawn through the API and is left to expire.
+func deleteMember(ctx context.Context, c *client, state values) error {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return err
+	}
+
+	accountID := state.str("account_id")
+	if accountID == "" {
+		member, err := findMember(ctx, c, parent, state)
+		if err != nil || member == nil {
+			return err
+		}
+		accountID = member.AccountId
+	}
+
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationMembers.DeleteOrganizationMember(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationMemberRequest{
+			OrganizationId: parent.id,
+			AccountId:      accountID,
+		}))
+	case levelProject:
+		_, err = c.projectMembers.DeleteProjectMember(ctx, connect.NewRequest(&libopsv1.DeleteProjectMemberRequest{
+			ProjectId: parent.id,
+			AccountId: accountID,
+		}))
+	case levelSite:
+		_, err = c.siteMembers.DeleteSiteMember(ctx, connect.NewRequest(&libopsv1.DeleteSiteMemberRequest{
+			SiteId:    parent.id,
+			AccountId: accountID,
+		}))
+	}
+	return err
+}
+
+// findMember looks the member up by account ID, or by email while the account
+// isn't known. It returns nil when they aren't a member.
+func findMember(ctx context.Context, c *client, parent scope, state values) (*libopsv1.MemberDetail, error) {
+	pageToken := ""
+	for {
+		var members []*libopsv1.MemberDetail
+		var nextPageToken string
+		switch parent.level {
+		case levelOrganization:
+			resp, err := c.organizationMembers.ListOrganizationMembers(ctx, connect.NewRequest(&libopsv1.ListOrganizationMembersRequest{
+				OrganizationId: parent.id,
+				PageToken:      pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			members, nextPageToken = resp.Msg.Members, resp.Msg.NextPageToken
+		case levelProject:
+			resp, err := c.projectMembers.ListProjectMembers(ctx, connect.NewRequest(&libopsv1.ListProjectMembersRequest{
+				ProjectId: parent.id,
+				PageToken: pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			members, nextPageToken = resp.Msg.Members, resp.Msg.NextPageToken
+		case levelSite:
+			resp, err := c.siteMembers.ListSiteMembers(ctx, connect.NewRequest(&libopsv1.ListSiteMembersRequest{
+				SiteId:    parent.id,
+				PageToken: pageToken,
+			}))
+			if err != nil {
+				return nil, err
+			}
+			members, nextPageToken = resp.Msg.Members, resp.Msg.NextPageToken
+		}
+
+		for _, m := range members {
+			if accountID := state.str("account_id"); accountID != "" {
+				if m.AccountId == accountID {
+					return m, nil
+				}
+			} else if strings.EqualFold(m.Email, state.str("email")) {
+				return m, nil
+			}
+		}
+		if nextPageToken == "" {
+			return nil, nil
+		}
+		pageToken = nextPageToken
+	}
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+func organizationResource() *resource {
+	return &resource{
+		typeName:    "libops_organization",
+		description: "A LibOps organization. The account that owns the API key becomes its owner.",
+		attributes: []attribute{
+			{name: "id", typ: typeString, computed: true, description: "Organization ID."},
+			{name: "name", typ: typeString, required: true, description: "Organization name."},
+			{name: "labels", typ: typeStringMap, optional: true, description: "Key/value labels for grouping and filtering."},
+			{name: "data_residency", typ: typeString, optional: true, description: "Geography the organization's data must stay in: eu or us. Projects can only be created in its regions."},
+		},
+		create:      createOrganization,
+		read:        readOrganization,
+		update:      updateOrganization,
+		delete:      deleteOrganization,
+		importState: func(id string) (values, error) { return values{"id": id}, nil },
+	}
+}
+
+func createOrganization(ctx context.Context, c *client, plan values) (values, error) {
+	resp, err := c.organizations.CreateOrganization(ctx, connect.NewRequest(&libopsv1.CreateOrganizationRequest{
+		Folder: &commonv1.FolderConfig{
+			OrganizationName: plan.str("name"),
+			Labels:           plan.strMap("labels"),
+			DataResidency:    dataResidency(plan.str("data_residency")),
+		},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	state := plan.clone()
+	state["id"] = resp.Msg.OrganizationId
+	return state, nil
+}
+
+func readOrganization(ctx context.Context, c *client, state values) (values, error) {
+	resp, err := c.organizations.GetOrganization(ctx, connect.NewRequest(&libopsv1.GetOrganizationRequest{
+		OrganizationId: state.str("id"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	folder := resp.Msg.Folder
+	newState := values{"id": state.str("id"), "name": folder.OrganizationName}
+	newState.setOptional(state, "labels", folder.Labels)
+	newState.setOptional(state, "data_residency", dataResidencyName(folder.DataResidency))
+	return newState, nil
+}
+
+func updateOrganization(ctx context.Context, c *client, prior, plan values) (values, error) {
+	_, err := c.organizations.UpdateOrganization(ctx, connect.NewRequest(&libopsv1.UpdateOrganizationRequest{
+		OrganizationId: prior.str("id"),
+		Folder: &commonv1.FolderConfig{
+			OrganizationName: plan.str("name"),
+			Labels:           plan.strMap("labels"),
+			DataResidency:    dataResidency(plan.str("data_residency")),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"folder.organization_name", "folder.labels", "folder.data_residency"}},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func deleteOrganization(ctx context.Context, c *client, state values) error {
+	_, err := c.organizations.DeleteOrganization(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationRequest{
+		OrganizationId: state.str("id"),
+	}))
+	return err
+}
+
+// dataResidency converts the data_residency attribute ("eu", "us" or empty)
+// to the API's enum; anything else is sent as-is for the API to reject
+func dataResidency(value string) commonv1.DataResidency {
+	if value == "" {
+		return commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED
+	}
+	residency, ok := commonv1.DataResidency_value["DATA_RESIDENCY_"+strings.ToUpper(value)]
+	if !ok {
+		return commonv1.DataResidency(-1)
+	}
+	return commonv1.DataResidency(residency)
+}
+
+// dataResidencyName is the data_residency attribute for the API's enum
+func dataResidencyName(residency commonv1.DataResidency) string {
+	if residency == commonv1.DataResidency_DATA_RESIDENCY_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(residency.String(), "DATA_RESIDENCY_"))
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// projectMaskPaths maps the project attributes UpdateProject can change to
+// their update mask paths.
+var projectMaskPaths = map[string]string{
+	"name":                "project.project_name",
+	"region":              "project.region",
+	"zone":                "project.zone",
+	"machine_type":        "project.machine_type",
+	"disk_size_gb":        "project.disk_size_gb",
+	"os":                  "project.os",
+	"disk_type":           "project.disk_type",
+	"create_branch_sites": "project.create_branch_sites",
+	"labels":              "project.labels",
+}
+
+func projectResource() *resource {
+	return &resource{
+		typeName:    "libops_project",
+		description: "A LibOps project: the VM and Google Cloud project an organization's sites run on.",
+		attributes: []attribute{
+			{name: "id", typ: typeString, computed: true, description: "Project ID."},
+			{name: "organization_id", typ: typeString, required: true, forceNew: true, description: "ID of the organization the project belongs to."},
+			{name: "name", typ: typeString, required: true, description: "Project name."},
+			{name: "region", typ: typeString, optional: true, computed: true, description: "Google Cloud region, e.g. us-central1."},
+			{name: "zone", typ: typeString, optional: true, computed: true, description: "Google Cloud zone within the region, e.g. us-central1-f."},
+			{name: "machine_type", typ: typeString, optional: true, computed: true, description: "Machine type of the project's VM. Defaults to e2-medium."},
+			{name: "disk_size_gb", typ: typeInt, optional: true, computed: true, description: "Disk size of the project's VM in GB. Defaults to 20."},
+			{name: "os", typ: typeString, optional: true, computed: true, description: "OS image of the project's VM."},
+			{name: "disk_type", typ: typeString, optional: true, computed: true, description: "Disk type of the project's VM. Defaults to hyperdisk-balanced."},
+			{name: "create_branch_sites", typ: typeBool, optional: true, description: "Create a site for every new branch of the project's repositories."},
+			{name: "labels", typ: typeStringMap, optional: true, description: "Key/value labels for grouping and filtering."},
+		},
+		create:      createProject,
+		read:        readProject,
+		update:      updateProject,
+		delete:      deleteProject,
+		importState: importProject,
+	}
+}
+
+// importProject takes an import ID of the form <organization_id>/<project_id>.
+func importProject(id string) (values, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("expected <organization_id>/<project_id>, got %q", id)
+	}
+	return values{"organization_id": parts[0], "id": parts[1]}, nil
+}
+
+func projectConfig(plan values) *commonv1.ProjectConfig {
+	return &commonv1.ProjectConfig{
+		ProjectName:       plan.str("name"),
+		Region:            plan.str("region"),
+		Zone:              plan.str("zone"),
+		MachineType:       plan.str("machine_type"),
+		DiskSizeGb:        int32(plan.int("disk_size_gb")),
+		Os:                plan.str("os"),
+		DiskType:          plan.str("disk_type"),
+		CreateBranchSites: plan.bool("create_branch_sites"),
+		Labels:            plan.strMap("labels"),
+	}
+}
+
+func createProject(ctx context.Context, c *client, plan values) (values, error) {
+	resp, err := c.projects.CreateProject(ctx, connect.NewRequest(&libopsv1.CreateProjectRequest{
+		OrganizationId: plan.str("organization_id"),
+		Project:        projectConfig(plan),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	// The API fills in defaults for what wasn't configured.
+	state := plan.clone()
+	state["id"] = resp.Msg.Project.ProjectId
+	return readProject(ctx, c, state)
+}
+
+func readProject(ctx context.Context, c *client, state values) (values, error) {
+	resp, err := c.projects.GetProject(ctx, connect.NewRequest(&libopsv1.GetProjectRequest{
+		OrganizationId: state.str("organization_id"),
+		ProjectId:      state.str("id"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	project := resp.Msg.Project
+	newState := values{
+		"id": state.str("id"),
+		// GetProject doesn't return the organization's public ID, so it comes
+		// from the state or the import ID.
+		"organization_id": state.str("organization_id"),
+		"name":            project.ProjectName,
+		"region":          project.Region,
+		"zone":            project.Zone,
+		"machine_type":    project.MachineType,
+		"disk_size_gb":    int64(project.DiskSizeGb),
+		"os":              project.Os,
+		"disk_type":       project.DiskType,
+	}
+	newState.setOptional(state, "create_branch_sites", project.CreateBranchSites)
+	newState.setOptional(state, "labels", project.Labels)
+	return newState, nil
+}
+
+func updateProject(ctx context.Context, c *client, prior, plan values) (values, error) {
+	_, err := c.projects.UpdateProject(ctx, connect.NewRequest(&libopsv1.UpdateProjectRequest{
+		OrganizationId: prior.str("organization_id"),
+		ProjectId:      prior.str("id"),
+		Project:        projectConfig(plan),
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: updateMask(prior, plan, projectMaskPaths)},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func deleteProject(ctx context.Context, c *client, state values) error {
+	_, err := c.projects.DeleteProject(ctx, connect.NewRequest(&libopsv1.DeleteProjectRequest{
+		OrganizationId: state.str("organization_id"),
+		ProjectId:      state.str("id"),
+	}))
+	return err
+}
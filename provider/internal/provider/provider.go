@@ -0,0 +1,383 @@
+// Package provider implements the LibOps Terraform provider on the Terraform
+// plugin protocol (v6), backed by the LibOps Connect API.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Address is the provider's registry address.
+const Address = "registry.terraform.io/libops/libops"
+
+// DefaultEndpoint is the API the provider talks to unless configured otherwise.
+const DefaultEndpoint = "https://api.libops.io"
+
+var providerAttributes = []attribute{
+	{name: "endpoint", typ: typeString, optional: true, description: "LibOps API URL. Defaults to LIBOPS_ENDPOINT, then " + DefaultEndpoint + "."},
+	{name: "api_key", typ: typeString, optional: true, sensitive: true, description: "API key to authenticate with. Defaults to LIBOPS_API_KEY."},
+}
+
+// Server is the provider's protocol server.
+type Server struct {
+	version   string
+	resources map[string]*resource
+	client    *client
+}
+
+var _ tfprotov6.ProviderServer = (*Server)(nil)
+
+// New creates a provider server.
+func New(version string) *Server {
+	s := &Server{version: version, resources: map[string]*resource{}}
+	for _, r := range []*resource{
+		organizationResource(),
+		projectResource(),
+		siteResource(),
+		secretResource(),
+		firewallRuleResource(),
+		memberResource(),
+		domainResource(),
+	} {
+		s.resources[r.typeName] = r
+	}
+	return s
+}
+
+func (s *Server) resourceNames() []string {
+	names := make([]string, 0, len(s.resources))
+	for name := range s.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) GetMetadata(ctx context.Context, req *tfprotov6.GetMetadataRequest) (*tfprotov6.GetMetadataResponse, error) {
+	resp := &tfprotov6.GetMetadataResponse{
+		ServerCapabilities: &tfprotov6.ServerCapabilities{GetProviderSchemaOptional: true},
+	}
+	for _, name := range s.resourceNames() {
+		resp.Resources = append(resp.Resources, tfprotov6.ResourceMetadata{TypeName: name})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp := &tfprotov6.GetProviderSchemaResponse{
+		ServerCapabilities: &tfprotov6.ServerCapabilities{GetProviderSchemaOptional: true},
+		Provider:           schema("Manage LibOps organizations, projects and sites.", providerAttributes),
+		ResourceSchemas:    map[string]*tfprotov6.Schema{},
+		DataSourceSchemas:  map[string]*tfprotov6.Schema{},
+	}
+	for name, r := range s.resources {
+		resp.ResourceSchemas[name] = schema(r.description, r.attributes)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetResourceIdentitySchemas(ctx context.Context, req *tfprotov6.GetResourceIdentitySchemasRequest) (*tfprotov6.GetResourceIdentitySchemasResponse, error) {
+	return &tfprotov6.GetResourceIdentitySchemasResponse{}, nil
+}
+
+func (s *Server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	return &tfprotov6.ValidateProviderConfigResponse{PreparedConfig: req.Config}, nil
+}
+
+// ConfigureProvider creates the API client from the provider block, falling
+// back to the environment.
+func (s *Server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	config, err := decode(providerAttributes, req.Config)
+	if err != nil {
+		return &tfprotov6.ConfigureProviderResponse{Diagnostics: errorDiagnostics("Invalid provider configuration", err)}, nil
+	}
+
+	endpoint := firstSet(config.str("endpoint"), os.Getenv("LIBOPS_ENDPOINT"), DefaultEndpoint)
+	apiKey := firstSet(config.str("api_key"), os.Getenv("LIBOPS_API_KEY"))
+	if apiKey == "" {
+		return &tfprotov6.ConfigureProviderResponse{Diagnostics: errorDiagnostics("Missing API key",
+			fmt.Errorf("set api_key in the provider block or the LIBOPS_API_KEY environment variable"))}, nil
+	}
+
+	s.client = newClient(endpoint, apiKey, "terraform-provider-libops/"+s.version)
+	return &tfprotov6.ConfigureProviderResponse{}, nil
+}
+
+func (s *Server) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return &tfprotov6.StopProviderResponse{}, nil
+}
+
+func (s *Server) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	r, diags := s.resource(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.ValidateResourceConfigResponse{Diagnostics: diags}, nil
+	}
+	if r.validate == nil {
+		return &tfprotov6.ValidateResourceConfigResponse{}, nil
+	}
+
+	config, err := decode(r.attributes, req.Config)
+	if err != nil {
+		return &tfprotov6.ValidateResourceConfigResponse{Diagnostics: errorDiagnostics("Invalid configuration", err)}, nil
+	}
+	if err := r.validate(config); err != nil {
+		return &tfprotov6.ValidateResourceConfigResponse{Diagnostics: errorDiagnostics("Invalid configuration", err)}, nil
+	}
+	return &tfprotov6.ValidateResourceConfigResponse{}, nil
+}
+
+// UpgradeResourceState reads state written by this or an older provider.
+// Every resource is still at schema version 0, so this only drops
+// attributes that no longer exist.
+func (s *Server) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	r, diags := s.resource(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.UpgradeResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	typ := objectType(r.attributes)
+	val, err := req.RawState.UnmarshalWithOpts(typ, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		return &tfprotov6.UpgradeResourceStateResponse{Diagnostics: errorDiagnostics("Unreadable state", err)}, nil
+	}
+	dv, err := tfprotov6.NewDynamicValue(typ, val)
+	if err != nil {
+		return &tfprotov6.UpgradeResourceStateResponse{Diagnostics: errorDiagnostics("Unreadable state", err)}, nil
+	}
+	return &tfprotov6.UpgradeResourceStateResponse{UpgradedState: &dv}, nil
+}
+
+func (s *Server) UpgradeResourceIdentity(ctx context.Context, req *tfprotov6.UpgradeResourceIdentityRequest) (*tfprotov6.UpgradeResourceIdentityResponse, error) {
+	return &tfprotov6.UpgradeResourceIdentityResponse{
+		Diagnostics: errorDiagnostics("Resource identity is not supported", fmt.Errorf("%s has no identity schema", req.TypeName)),
+	}, nil
+}
+
+// ReadResource refreshes a resource, dropping it from state when it was
+// deleted outside Terraform.
+func (s *Server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	r, diags := s.configured(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: diags}, nil
+	}
+
+	state, err := decode(r.attributes, req.CurrentState)
+	if err != nil {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: errorDiagnostics("Unreadable state", err)}, nil
+	}
+	if state == nil {
+		return &tfprotov6.ReadResourceResponse{NewState: req.CurrentState}, nil
+	}
+
+	newState, err := r.read(ctx, s.client, state)
+	if isNotFound(err) {
+		newState, err = nil, nil
+	}
+	if err != nil {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: errorDiagnostics("Failed to read "+r.typeName, err)}, nil
+	}
+
+	dv, err := encode(r.attributes, newState)
+	if err != nil {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: errorDiagnostics("Failed to read "+r.typeName, err)}, nil
+	}
+	return &tfprotov6.ReadResourceResponse{NewState: dv, Private: req.Private}, nil
+}
+
+func (s *Server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	r, diags := s.resource(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	prior, err := decode(r.attributes, req.PriorState)
+	if err != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: errorDiagnostics("Unreadable state", err)}, nil
+	}
+	proposed, err := decode(r.attributes, req.ProposedNewState)
+	if err != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: errorDiagnostics("Unreadable plan", err)}, nil
+	}
+	if proposed == nil {
+		// Destroying the resource.
+		return &tfprotov6.PlanResourceChangeResponse{PlannedState: req.ProposedNewState}, nil
+	}
+	config, err := decode(r.attributes, req.Config)
+	if err != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: errorDiagnostics("Invalid configuration", err)}, nil
+	}
+
+	planned, replace := r.plan(prior, proposed, config)
+	dv, err := encode(r.attributes, planned)
+	if err != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: errorDiagnostics("Failed to plan "+r.typeName, err)}, nil
+	}
+	return &tfprotov6.PlanResourceChangeResponse{PlannedState: dv, RequiresReplace: replace}, nil
+}
+
+// ApplyResourceChange creates, updates or deletes a resource.
+func (s *Server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	r, diags := s.configured(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	prior, err := decode(r.attributes, req.PriorState)
+	if err != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: errorDiagnostics("Unreadable state", err)}, nil
+	}
+	planned, err := decode(r.attributes, req.PlannedState)
+	if err != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: errorDiagnostics("Unreadable plan", err)}, nil
+	}
+
+	var newState values
+	switch {
+	case planned == nil:
+		err = r.delete(ctx, s.client, prior)
+		if isNotFound(err) {
+			err = nil
+		}
+		if err != nil {
+			// Keep the resource in state so the delete can be retried.
+			return &tfprotov6.ApplyResourceChangeResponse{
+				NewState:    req.PriorState,
+				Diagnostics: errorDiagnostics("Failed to delete "+r.typeName, err),
+			}, nil
+		}
+	case prior == nil:
+		newState, err = r.create(ctx, s.client, planned)
+		if err != nil {
+			return &tfprotov6.ApplyResourceChangeResponse{
+				NewState:    req.PriorState,
+				Diagnostics: errorDiagnostics("Failed to create "+r.typeName, err),
+			}, nil
+		}
+	default:
+		if r.update == nil {
+			return &tfprotov6.ApplyResourceChangeResponse{
+				NewState:    req.PriorState,
+				Diagnostics: errorDiagnostics("Failed to update "+r.typeName, fmt.Errorf("%s can't be updated in place", r.typeName)),
+			}, nil
+		}
+		newState, err = r.update(ctx, s.client, prior, planned)
+		if err != nil {
+			return &tfprotov6.ApplyResourceChangeResponse{
+				NewState:    req.PriorState,
+				Diagnostics: errorDiagnostics("Failed to update "+r.typeName, err),
+			}, nil
+		}
+	}
+
+	dv, err := encode(r.attributes, newState)
+	if err != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: errorDiagnostics("Failed to save "+r.typeName, err)}, nil
+	}
+	return &tfprotov6.ApplyResourceChangeResponse{NewState: dv}, nil
+}
+
+func (s *Server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	r, diags := s.resource(req.TypeName)
+	if diags != nil {
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	state, err := r.importState(req.ID)
+	if err != nil {
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: errorDiagnostics("Invalid import ID", err)}, nil
+	}
+	dv, err := encode(r.attributes, state)
+	if err != nil {
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: errorDiagnostics("Invalid import ID", err)}, nil
+	}
+	return &tfprotov6.ImportResourceStateResponse{
+		ImportedResources: []*tfprotov6.ImportedResource{{TypeName: req.TypeName, State: dv}},
+	}, nil
+}
+
+func (s *Server) MoveResourceState(ctx context.Context, req *tfprotov6.MoveResourceStateRequest) (*tfprotov6.MoveResourceStateResponse, error) {
+	return &tfprotov6.MoveResourceStateResponse{
+		Diagnostics: errorDiagnostics("Moving resources is not supported", fmt.Errorf("%s can't be moved to %s", req.SourceTypeName, req.TargetTypeName)),
+	}, nil
+}
+
+func (s *Server) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	return &tfprotov6.ValidateDataResourceConfigResponse{Diagnostics: unsupported("data source", req.TypeName)}, nil
+}
+
+func (s *Server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{Diagnostics: unsupported("data source", req.TypeName)}, nil
+}
+
+func (s *Server) CallFunction(ctx context.Context, req *tfprotov6.CallFunctionRequest) (*tfprotov6.CallFunctionResponse, error) {
+	return &tfprotov6.CallFunctionResponse{Error: &tfprotov6.FunctionError{Text: "unknown function " + req.Name}}, nil
+}
+
+func (s *Server) GetFunctions(ctx context.Context, req *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
+	return &tfprotov6.GetFunctionsResponse{Functions: map[string]*tfprotov6.Function{}}, nil
+}
+
+func (s *Server) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov6.ValidateEphemeralResourceConfigRequest) (*tfprotov6.ValidateEphemeralResourceConfigResponse, error) {
+	return &tfprotov6.ValidateEphemeralResourceConfigResponse{Diagnostics: unsupported("ephemeral resource", req.TypeName)}, nil
+}
+
+func (s *Server) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return &tfprotov6.OpenEphemeralResourceResponse{Diagnostics: unsupported("ephemeral resource", req.TypeName)}, nil
+}
+
+func (s *Server) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	return &tfprotov6.RenewEphemeralResourceResponse{Diagnostics: unsupported("ephemeral resource", req.TypeName)}, nil
+}
+
+func (s *Server) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	return &tfprotov6.CloseEphemeralResourceResponse{Diagnostics: unsupported("ephemeral resource", req.TypeName)}, nil
+}
+
+// resource looks up a resource type.
+func (s *Server) resource(typeName string) (*resource, []*tfprotov6.Diagnostic) {
+	r, ok := s.resources[typeName]
+	if !ok {
+		return nil, unsupported("resource", typeName)
+	}
+	return r, nil
+}
+
+// configured looks up a resource type that calls the API.
+func (s *Server) configured(typeName string) (*resource, []*tfprotov6.Diagnostic) {
+	r, diags := s.resource(typeName)
+	if diags != nil {
+		return nil, diags
+	}
+	if s.client == nil {
+		return nil, errorDiagnostics("Provider not configured", fmt.Errorf("the libops provider must be configured before managing %s", typeName))
+	}
+	return r, nil
+}
+
+func errorDiagnostics(summary string, err error) []*tfprotov6.Diagnostic {
+	return []*tfprotov6.Diagnostic{{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}}
+}
+
+func unsupported(kind, typeName string) []*tfprotov6.Diagnostic {
+	return errorDiagnostics("Unsupported "+kind, fmt.Errorf("the libops provider has no %s %q", kind, typeName))
+}
+
+func firstSet(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
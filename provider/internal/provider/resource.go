@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+
+	"connectrpc.com/connect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// errNotFound is returned by a resource's read when the object is gone, so
+// Terraform drops it from state and plans to create it again.
+var errNotFound = errors.New("not found")
+
+// resource is a LibOps object managed by Terraform. The provider server turns
+// Terraform's plan and apply calls into these operations.
+type resource struct {
+	typeName    string
+	description string
+	attributes  []attribute
+
+	// validate checks a configuration beyond what the schema enforces. Values
+	// may be unknown during validation.
+	validate func(config values) error
+	// create creates the object from the planned values and returns its state.
+	create func(ctx context.Context, c *client, plan values) (values, error)
+	// read refreshes the state from the API, or returns errNotFound.
+	read func(ctx context.Context, c *client, state values) (values, error)
+	// update applies in-place changes and returns the new state. It is nil
+	// when every configurable attribute forces replacement.
+	update func(ctx context.Context, c *client, prior, plan values) (values, error)
+	delete func(ctx context.Context, c *client, state values) error
+	// importState turns an import ID into the state read refreshes.
+	importState func(id string) (values, error)
+}
+
+// plan computes the planned state and the attributes that force replacement.
+// prior is nil on create.
+func (r *resource) plan(prior, proposed, config values) (values, []*tftypes.AttributePath) {
+	planned := proposed.clone()
+
+	var replace []*tftypes.AttributePath
+	if prior != nil {
+		for _, a := range r.attributes {
+			if a.unread && prior.isNull(a.name) {
+				continue
+			}
+			if a.forceNew && !reflect.DeepEqual(prior[a.name], planned[a.name]) {
+				replace = append(replace, tftypes.NewAttributePath().WithAttributeName(a.name))
+			}
+		}
+		if len(replace) == 0 {
+			return planned, nil
+		}
+	}
+
+	// Computed attributes that aren't configured are known once the object
+	// is created.
+	for _, a := range r.attributes {
+		if a.computed && config.isNull(a.name) {
+			planned[a.name] = unknown{}
+		}
+	}
+	return planned, replace
+}
+
+// isNotFound reports whether an API error means the object no longer exists.
+func isNotFound(err error) bool {
+	return errors.Is(err, errNotFound) || connect.CodeOf(err) == connect.CodeNotFound
+}
+
+// updateMask lists the update mask paths, keyed by attribute, of the attributes
+// that changed between prior and plan.
+func updateMask(prior, plan values, paths map[string]string) []string {
+	var mask []string
+	for name, path := range paths {
+		if !reflect.DeepEqual(prior[name], plan[name]) {
+			mask = append(mask, path)
+		}
+	}
+	sort.Strings(mask)
+	return mask
+}
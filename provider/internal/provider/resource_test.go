@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestPlan tests that creates leave computed attributes unknown, updates keep
+// them, and changing a forceNew attribute replaces the resource.
+func TestPlan(t *testing.T) {
+	r := siteResource()
+	config := values{"project_id": "p1", "name": "production", "github_repository": "libops/site", "github_ref": "heads/main"}
+
+	planned, replace := r.plan(nil, config.clone(), config)
+	if replace != nil {
+		t.Errorf("create replaces %v", replace)
+	}
+	for _, name := range []string{"id", "organization_id", "os"} {
+		if !planned.isUnknown(name) {
+			t.Errorf("create plans %s = %#v, want unknown", name, planned[name])
+		}
+	}
+
+	prior := config.clone()
+	prior["id"], prior["organization_id"], prior["os"] = "s1", "o1", "cos"
+	proposed := prior.clone()
+	proposed["github_ref"] = "heads/develop"
+	planned, replace = r.plan(prior, proposed, config)
+	if replace != nil {
+		t.Errorf("changing github_ref replaces %v", replace)
+	}
+	if planned.str("id") != "s1" || planned.str("os") != "cos" {
+		t.Errorf("update plans id = %#v, os = %#v, want the prior values", planned["id"], planned["os"])
+	}
+
+	proposed = prior.clone()
+	proposed["github_repository"] = "libops/other"
+	planned, replace = r.plan(prior, proposed, config)
+	want := []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("github_repository")}
+	if !reflect.DeepEqual(replace, want) {
+		t.Errorf("changing github_repository replaces %v, want %v", replace, want)
+	}
+	if !planned.isUnknown("id") {
+		t.Errorf("replacement plans id = %#v, want unknown", planned["id"])
+	}
+}
+
+// TestPlanAdoptsUnreadAttributes tests that an imported site, whose repository
+// the API doesn't return, takes it from the configuration instead of being
+// replaced.
+func TestPlanAdoptsUnreadAttributes(t *testing.T) {
+	r := siteResource()
+	prior := values{"id": "s1", "project_id": "p1", "organization_id": "o1", "name": "production", "github_ref": "heads/main", "os": "cos"}
+	proposed := prior.clone()
+	proposed["github_repository"] = "libops/site"
+
+	if _, replace := r.plan(prior, proposed, proposed); replace != nil {
+		t.Errorf("imported site replaces %v", replace)
+	}
+	if mask := updateMask(prior, proposed, siteMaskPaths); len(mask) != 0 {
+		t.Errorf("adopting the repository updates %v", mask)
+	}
+}
+
+// TestUpdateMask tests that only changed attributes are sent.
+func TestUpdateMask(t *testing.T) {
+	prior := values{"name": "web", "machine_type": "e2-medium", "labels": map[string]string{"env": "prod"}}
+	plan := values{"name": "web", "machine_type": "e2-standard-2", "labels": map[string]string{"env": "stage"}}
+
+	got := updateMask(prior, plan, projectMaskPaths)
+	want := []string{"project.labels", "project.machine_type"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("updateMask() = %v, want %v", got, want)
+	}
+}
+
+// TestScope tests that scoped resources take exactly one parent.
+func TestScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  values
+		want    scope
+		wantErr bool
+	}{
+		{"organization", values{"organization_id": "o1"}, scope{levelOrganization, "o1"}, false},
+		{"site", values{"site_id": "s1"}, scope{levelSite, "s1"}, false},
+		{"none", values{}, scope{}, true},
+		{"two", values{"organization_id": "o1", "project_id": "p1"}, scope{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scopeOf(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scopeOf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("scopeOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// An unknown parent ID still counts as set while validating.
+	if err := validateScope(values{"project_id": unknown{}}); err != nil {
+		t.Errorf("validateScope() with an unknown project_id = %v", err)
+	}
+}
+
+// TestImportIDs tests the import ID formats.
+func TestImportIDs(t *testing.T) {
+	got, err := parseScopedImportID("project/p1/r1", "id")
+	if err != nil || !reflect.DeepEqual(got, values{"project_id": "p1", "id": "r1"}) {
+		t.Errorf("parseScopedImportID() = %#v, %v", got, err)
+	}
+	for _, id := range []string{"r1", "team/p1/r1", "site//r1"} {
+		if _, err := parseScopedImportID(id, "id"); err == nil {
+			t.Errorf("parseScopedImportID(%q) succeeded", id)
+		}
+	}
+
+	got, err = domainResource().importState("s1/digital.example.edu")
+	if err != nil || got.str("domain") != "digital.example.edu" || got.str("site_id") != "s1" {
+		t.Errorf("domain import = %#v, %v", got, err)
+	}
+	if _, err := importProject("p1"); err == nil {
+		t.Error("project import without an organization succeeded")
+	}
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// attrType is the Terraform type of an attribute. Every attribute is a
+// primitive, a list of strings or a map of strings.
+type attrType int
+
+const (
+	typeString attrType = iota
+	typeInt
+	typeBool
+	typeStringList
+	typeStringMap
+)
+
+func (t attrType) tftype() tftypes.Type {
+	switch t {
+	case typeInt:
+		return tftypes.Number
+	case typeBool:
+		return tftypes.Bool
+	case typeStringList:
+		return tftypes.List{ElementType: tftypes.String}
+	case typeStringMap:
+		return tftypes.Map{ElementType: tftypes.String}
+	default:
+		return tftypes.String
+	}
+}
+
+// attribute describes one attribute of a resource or of the provider block.
+type attribute struct {
+	name        string
+	description string
+	typ         attrType
+	required    bool
+	optional    bool
+	computed    bool
+	sensitive   bool
+	// forceNew replaces the resource when the attribute changes because the
+	// API can't update it in place.
+	forceNew bool
+	// unread marks attributes the API accepts but never returns. Reads keep
+	// the stored value, and an imported resource adopts the configured one
+	// instead of being replaced.
+	unread bool
+}
+
+// unknown is the value of an attribute that is only known after apply.
+type unknown struct{}
+
+// values holds an object's attributes as Go values: string, int64, bool,
+// []string, map[string]string, or unknown{}. A missing or nil entry is null.
+type values map[string]any
+
+func (v values) str(name string) string {
+	s, _ := v[name].(string)
+	return s
+}
+
+func (v values) int(name string) int64 {
+	i, _ := v[name].(int64)
+	return i
+}
+
+func (v values) bool(name string) bool {
+	b, _ := v[name].(bool)
+	return b
+}
+
+func (v values) list(name string) []string {
+	l, _ := v[name].([]string)
+	return l
+}
+
+func (v values) strMap(name string) map[string]string {
+	m, _ := v[name].(map[string]string)
+	return m
+}
+
+func (v values) isNull(name string) bool {
+	return v[name] == nil
+}
+
+func (v values) isUnknown(name string) bool {
+	_, ok := v[name].(unknown)
+	return ok
+}
+
+func (v values) clone() values {
+	c := make(values, len(v))
+	for k, val := range v {
+		c[k] = val
+	}
+	return c
+}
+
+// setOptional stores an optional value returned by the API, leaving the
+// attribute null when the API returns its zero value and it was never set.
+// This keeps unset optional attributes from showing a diff after every read.
+func (v values) setOptional(prior values, name string, val any) {
+	if prior.isNull(name) && isZero(val) {
+		v[name] = nil
+		return
+	}
+	v[name] = val
+}
+
+func isZero(val any) bool {
+	switch x := val.(type) {
+	case nil:
+		return true
+	case []string:
+		return len(x) == 0
+	case map[string]string:
+		return len(x) == 0
+	default:
+		return reflect.ValueOf(val).IsZero()
+	}
+}
+
+// objectType is the Terraform object type of a set of attributes.
+func objectType(attrs []attribute) tftypes.Object {
+	types := make(map[string]tftypes.Type, len(attrs))
+	for _, a := range attrs {
+		types[a.name] = a.typ.tftype()
+	}
+	return tftypes.Object{AttributeTypes: types}
+}
+
+// schema is the protocol schema of a set of attributes.
+func schema(description string, attrs []attribute) *tfprotov6.Schema {
+	block := &tfprotov6.SchemaBlock{
+		Description:     description,
+		DescriptionKind: tfprotov6.StringKindPlain,
+	}
+	for _, a := range attrs {
+		block.Attributes = append(block.Attributes, &tfprotov6.SchemaAttribute{
+			Name:            a.name,
+			Type:            a.typ.tftype(),
+			Description:     a.description,
+			DescriptionKind: tfprotov6.StringKindPlain,
+			Required:        a.required,
+			Optional:        a.optional,
+			Computed:        a.computed,
+			Sensitive:       a.sensitive,
+		})
+	}
+	return &tfprotov6.Schema{Block: block}
+}
+
+// decode reads a dynamic value into values. It returns nil for a null object.
+func decode(attrs []attribute, dv *tfprotov6.DynamicValue) (values, error) {
+	if dv == nil {
+		return nil, nil
+	}
+	val, err := dv.Unmarshal(objectType(attrs))
+	if err != nil {
+		return nil, err
+	}
+	return fromTerraform(attrs, val)
+}
+
+// encode writes values as a dynamic value. A nil values is a null object.
+func encode(attrs []attribute, v values) (*tfprotov6.DynamicValue, error) {
+	typ := objectType(attrs)
+	val, err := toTerraform(attrs, v)
+	if err != nil {
+		return nil, err
+	}
+	dv, err := tfprotov6.NewDynamicValue(typ, val)
+	if err != nil {
+		return nil, err
+	}
+	return &dv, nil
+}
+
+func fromTerraform(attrs []attribute, val tftypes.Value) (values, error) {
+	if val.IsNull() {
+		return nil, nil
+	}
+	var obj map[string]tftypes.Value
+	if err := val.As(&obj); err != nil {
+		return nil, err
+	}
+
+	v := make(values, len(attrs))
+	for _, a := range attrs {
+		av, ok := obj[a.name]
+		if !ok || av.IsNull() {
+			continue
+		}
+		if !av.IsKnown() {
+			v[a.name] = unknown{}
+			continue
+		}
+		switch a.typ {
+		case typeString:
+			var s string
+			if err := av.As(&s); err != nil {
+				return nil, fmt.Errorf("%s: %w", a.name, err)
+			}
+			v[a.name] = s
+		case typeInt:
+			n := new(big.Float)
+			if err := av.As(&n); err != nil {
+				return nil, fmt.Errorf("%s: %w", a.name, err)
+			}
+			i, _ := n.Int64()
+			v[a.name] = i
+		case typeBool:
+			var b bool
+			if err := av.As(&b); err != nil {
+				return nil, fmt.Errorf("%s: %w", a.name, err)
+			}
+			v[a.name] = b
+		case typeStringList:
+			var elems []tftypes.Value
+			if err := av.As(&elems); err != nil {
+				return nil, fmt.Errorf("%s: %w", a.name, err)
+			}
+			l := make([]string, 0, len(elems))
+			for _, e := range elems {
+				if !e.IsKnown() {
+					v[a.name] = unknown{}
+					break
+				}
+				var s string
+				if err := e.As(&s); err != nil {
+					return nil, fmt.Errorf("%s: %w", a.name, err)
+				}
+				l = append(l, s)
+			}
+			if !v.isUnknown(a.name) {
+				v[a.name] = l
+			}
+		case typeStringMap:
+			var elems map[string]tftypes.Value
+			if err := av.As(&elems); err != nil {
+				return nil, fmt.Errorf("%s: %w", a.name, err)
+			}
+			m := make(map[string]string, len(elems))
+			for k, e := range elems {
+				if !e.IsKnown() {
+					v[a.name] = unknown{}
+					break
+				}
+				var s string
+				if err := e.As(&s); err != nil {
+					return nil, fmt.Errorf("%s: %w", a.name, err)
+				}
+				m[k] = s
+			}
+			if !v.isUnknown(a.name) {
+				v[a.name] = m
+			}
+		}
+	}
+	return v, nil
+}
+
+func toTerraform(attrs []attribute, v values) (tftypes.Value, error) {
+	typ := objectType(attrs)
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	obj := make(map[string]tftypes.Value, len(attrs))
+	for _, a := range attrs {
+		t := a.typ.tftype()
+		switch val := v[a.name].(type) {
+		case nil:
+			obj[a.name] = tftypes.NewValue(t, nil)
+		case unknown:
+			obj[a.name] = tftypes.NewValue(t, tftypes.UnknownValue)
+		case string, bool:
+			obj[a.name] = tftypes.NewValue(t, val)
+		case int64:
+			obj[a.name] = tftypes.NewValue(t, new(big.Float).SetInt64(val))
+		case []string:
+			elems := make([]tftypes.Value, 0, len(val))
+			for _, s := range val {
+				elems = append(elems, tftypes.NewValue(tftypes.String, s))
+			}
+			obj[a.name] = tftypes.NewValue(t, elems)
+		case map[string]string:
+			elems := make(map[string]tftypes.Value, len(val))
+			for k, s := range val {
+				elems[k] = tftypes.NewValue(tftypes.String, s)
+			}
+			obj[a.name] = tftypes.NewValue(t, elems)
+		default:
+			return tftypes.Value{}, fmt.Errorf("%s: unsupported value %T", a.name, val)
+		}
+	}
+	return tftypes.NewValue(typ, obj), nil
+}
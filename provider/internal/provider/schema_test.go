@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestEncodeDecode tests that values survive the round trip through the
+// protocol's dynamic values, including nulls and unknowns.
+func TestEncodeDecode(t *testing.T) {
+	attrs := siteAttributes
+	v := values{
+		"id":            unknown{},
+		"project_id":    "0b5c9d2e-6f3a-4d8e-9b1c-2a7f4e6d8c0b",
+		"name":          "production",
+		"port":          int64(8080),
+		"up_cmd":        []string{"docker compose up -d"},
+		"labels":        map[string]string{"env": "prod"},
+		"is_production": true,
+	}
+
+	dv, err := encode(attrs, v)
+	if err != nil {
+		t.Fatalf("encode() error = %v", err)
+	}
+	got, err := decode(attrs, dv)
+	if err != nil {
+		t.Fatalf("decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("decode(encode(v)) = %#v, want %#v", got, v)
+	}
+
+	dv, err = encode(attrs, nil)
+	if err != nil {
+		t.Fatalf("encode(nil) error = %v", err)
+	}
+	if got, err := decode(attrs, dv); err != nil || got != nil {
+		t.Errorf("decode(encode(nil)) = %#v, %v, want nil", got, err)
+	}
+}
+
+// TestSetOptional tests that optional attributes nobody set stay null when the
+// API returns their zero value.
+func TestSetOptional(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior values
+		val   any
+		want  any
+	}{
+		{"unset and empty", values{}, []string{}, nil},
+		{"unset and false", values{}, false, nil},
+		{"unset and set by the API", values{}, map[string]string{"env": "prod"}, map[string]string{"env": "prod"}},
+		{"set and emptied", values{"labels": map[string]string{"env": "prod"}}, map[string]string{}, map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := values{}
+			v.setOptional(tt.prior, "labels", tt.val)
+			if !reflect.DeepEqual(v["labels"], tt.want) {
+				t.Errorf("setOptional() = %#v, want %#v", v["labels"], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Secrets, firewall rules and members exist on an organization, a project or
+// a site. Their resources take exactly one of the parent ID attributes.
+const (
+	levelOrganization = "organization"
+	levelProject      = "project"
+	levelSite         = "site"
+)
+
+var scopeLevels = []string{levelOrganization, levelProject, levelSite}
+
+// scope is the parent a resource belongs to.
+type scope struct {
+	level string
+	id    string
+}
+
+// scopeAttributes are the parent ID attributes of a scoped resource.
+func scopeAttributes(kind string) []attribute {
+	attrs := make([]attribute, 0, len(scopeLevels))
+	for _, level := range scopeLevels {
+		attrs = append(attrs, attribute{
+			name:        level + "_id",
+			typ:         typeString,
+			optional:    true,
+			forceNew:    true,
+			description: fmt.Sprintf("ID of the %s the %s belongs to. Set exactly one of organization_id, project_id and site_id.", level, kind),
+		})
+	}
+	return attrs
+}
+
+// validateScope checks that exactly one parent ID is configured.
+func validateScope(config values) error {
+	set := 0
+	for _, level := range scopeLevels {
+		if !config.isNull(level + "_id") {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("set exactly one of organization_id, project_id and site_id")
+	}
+	return nil
+}
+
+// scopeOf returns the parent of a planned or stored resource.
+func scopeOf(v values) (scope, error) {
+	if err := validateScope(v); err != nil {
+		return scope{}, err
+	}
+	for _, level := range scopeLevels {
+		if id := v.str(level + "_id"); id != "" {
+			return scope{level: level, id: id}, nil
+		}
+	}
+	return scope{}, fmt.Errorf("parent ID is unknown")
+}
+
+// parseScopedImportID splits an import ID of the form <level>/<parent_id>/<id>,
+// e.g. project/0b5c.../9f2e..., into the resource's state.
+func parseScopedImportID(importID, idAttribute string) (values, error) {
+	parts := strings.Split(importID, "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("expected <organization|project|site>/<parent_id>/<%s>, got %q", idAttribute, importID)
+	}
+	for _, level := range scopeLevels {
+		if parts[0] == level {
+			return values{level + "_id": parts[1], idAttribute: parts[2]}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown level %q, expected organization, project or site", parts[0])
+}
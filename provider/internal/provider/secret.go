@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+)
+
+func secretResource() *resource {
+	return &resource{
+		typeName:    "libops_secret",
+		description: "A secret stored in Vault and exposed to the sites of an organization, a project or a single site.",
+		attributes: append(scopeAttributes("secret"),
+			attribute{name: "id", typ: typeString, computed: true, description: "Secret ID."},
+			attribute{name: "name", typ: typeString, required: true, forceNew: true, description: "Secret name, e.g. DATABASE_PASSWORD."},
+			attribute{name: "value", typ: typeString, required: true, sensitive: true, description: "Secret value. The API never returns it, so changes made outside Terraform aren't detected."},
+		),
+		validate:    validateScope,
+		create:      createSecret,
+		read:        readSecret,
+		update:      updateSecret,
+		delete:      deleteSecret,
+		importState: func(id string) (values, error) { return parseScopedImportID(id, "id") },
+	}
+}
+
+func createSecret(ctx context.Context, c *client, plan values) (values, error) {
+	parent, err := scopeOf(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	var secretID string
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationSecrets.CreateOrganizationSecret(ctx, connect.NewRequest(&libopsv1.CreateOrganizationSecretRequest{
+			OrganizationId: parent.id,
+			Name:           plan.str("name"),
+			Value:          plan.str("value"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		secretID = resp.Msg.Secret.SecretId
+	case levelProject:
+		resp, err := c.projectSecrets.CreateProjectSecret(ctx, connect.NewRequest(&libopsv1.CreateProjectSecretRequest{
+			ProjectId: parent.id,
+			Name:      plan.str("name"),
+			Value:     plan.str("value"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		secretID = resp.Msg.Secret.SecretId
+	case levelSite:
+		resp, err := c.siteSecrets.CreateSiteSecret(ctx, connect.NewRequest(&libopsv1.CreateSiteSecretRequest{
+			SiteId: parent.id,
+			Name:   plan.str("name"),
+			Value:  plan.str("value"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		secretID = resp.Msg.Secret.SecretId
+	}
+
+	state := plan.clone()
+	state["id"] = secretID
+	return state, nil
+}
+
+func readSecret(ctx context.Context, c *client, state values) (values, error) {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	switch parent.level {
+	case levelOrganization:
+		resp, err := c.organizationSecrets.GetOrganizationSecret(ctx, connect.NewRequest(&libopsv1.GetOrganizationSecretRequest{
+			OrganizationId: parent.id,
+			SecretId:       state.str("id"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		name = resp.Msg.Secret.Name
+	case levelProject:
+		resp, err := c.projectSecrets.GetProjectSecret(ctx, connect.NewRequest(&libopsv1.GetProjectSecretRequest{
+			ProjectId: parent.id,
+			SecretId:  state.str("id"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		name = resp.Msg.Secret.Name
+	case levelSite:
+		resp, err := c.siteSecrets.GetSiteSecret(ctx, connect.NewRequest(&libopsv1.GetSiteSecretRequest{
+			SiteId:   parent.id,
+			SecretId: state.str("id"),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		name = resp.Msg.Secret.Name
+	}
+
+	newState := state.clone()
+	newState["name"] = name
+	return newState, nil
+}
+
+func updateSecret(ctx context.Context, c *client, prior, plan values) (values, error) {
+	parent, err := scopeOf(prior)
+	if err != nil {
+		return nil, err
+	}
+
+	value := plan.str("value")
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"value"}}
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationSecrets.UpdateOrganizationSecret(ctx, connect.NewRequest(&libopsv1.UpdateOrganizationSecretRequest{
+			OrganizationId: parent.id,
+			SecretId:       prior.str("id"),
+			Value:          &value,
+			UpdateMask:     mask,
+		}))
+	case levelProject:
+		_, err = c.projectSecrets.UpdateProjectSecret(ctx, connect.NewRequest(&libopsv1.UpdateProjectSecretRequest{
+			ProjectId:  parent.id,
+			SecretId:   prior.str("id"),
+			Value:      &value,
+			UpdateMask: mask,
+		}))
+	case levelSite:
+		_, err = c.siteSecrets.UpdateSiteSecret(ctx, connect.NewRequest(&libopsv1.UpdateSiteSecretRequest{
+			SiteId:     parent.id,
+			SecretId:   prior.str("id"),
+			Value:      &value,
+			UpdateMask: mask,
+		}))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func deleteSecret(ctx context.Context, c *client, state values) error {
+	parent, err := scopeOf(state)
+	if err != nil {
+		return err
+	}
+
+	switch parent.level {
+	case levelOrganization:
+		_, err = c.organizationSecrets.DeleteOrganizationSecret(ctx, connect.NewRequest(&libopsv1.DeleteOrganizationSecretRequest{
+			OrganizationId: parent.id,
+			SecretId:       state.str("id"),
+		}))
+	case levelProject:
+		_, err = c.projectSecrets.DeleteProjectSecret(ctx, connect.NewRequest(&libopsv1.DeleteProjectSecretRequest{
+			ProjectId: parent.id,
+			SecretId:  state.str("id"),
+		}))
+	case levelSite:
+		_, err = c.siteSecrets.DeleteSiteSecret(ctx, connect.NewRequest(&libopsv1.DeleteSiteSecretRequest{
+			SiteId:   parent.id,
+			SecretId: state.str("id"),
+		}))
+	}
+	return err
+}
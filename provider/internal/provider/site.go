@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	libopsv1 "github.com/libops/api/proto/libops/v1"
+	commonv1 "github.com/libops/api/proto/libops/v1/common"
+)
+
+// siteMaskPaths maps the site attributes UpdateSite can change to their
+// update mask paths.
+var siteMaskPaths = map[string]string{
+	"name":            "site.site_name",
+	"github_ref":      "site.github_ref",
+	"up_cmd":          "site.up_cmd",
+	"init_cmd":        "site.init_cmd",
+	"rollout_cmd":     "site.rollout_cmd",
+	"overlay_volumes": "site.overlay_volumes",
+	"os":              "site.os",
+	"is_production":   "site.is_production",
+	"labels":          "site.labels",
+}
+
+var siteAttributes = []attribute{
+	{name: "id", typ: typeString, computed: true, description: "Site ID."},
+	{name: "project_id", typ: typeString, required: true, forceNew: true, description: "ID of the project the site runs in."},
+	{name: "organization_id", typ: typeString, computed: true, description: "ID of the organization the site belongs to."},
+	{name: "name", typ: typeString, required: true, description: "Site name, e.g. production or staging."},
+	{name: "github_repository", typ: typeString, required: true, forceNew: true, unread: true, description: "GitHub repository URL."},
+	{name: "github_ref", typ: typeString, required: true, description: "Git reference to deploy: heads/<branch>, tags/<tag> or release."},
+	{name: "compose_path", typ: typeString, optional: true, forceNew: true, unread: true, description: "Directory of the docker compose file in the repository."},
+	{name: "compose_file", typ: typeString, optional: true, forceNew: true, unread: true, description: "Docker compose file name. Defaults to docker-compose.yml."},
+	{name: "port", typ: typeInt, optional: true, forceNew: true, unread: true, description: "Port the application listens on. Defaults to 80."},
+	{name: "application_type", typ: typeString, optional: true, forceNew: true, unread: true, description: "Application type. Defaults to generic."},
+	{name: "up_cmd", typ: typeStringList, optional: true, description: "Commands that start the containers."},
+	{name: "init_cmd", typ: typeStringList, optional: true, description: "Commands run when the site is first set up."},
+	{name: "rollout_cmd", typ: typeStringList, optional: true, description: "Commands run during a rollout."},
+	{name: "overlay_volumes", typ: typeStringList, optional: true, description: "Overlay volume paths."},
+	{name: "os", typ: typeString, optional: true, computed: true, description: "OS image of the site's VM."},
+	{name: "is_production", typ: typeBool, optional: true, description: "Whether this is the project's production site."},
+	{name: "labels", typ: typeStringMap, optional: true, description: "Key/value labels for grouping and filtering."},
+}
+
+func siteResource() *resource {
+	return &resource{
+		typeName:    "libops_site",
+		description: "A LibOps site: one docker compose deployment of a GitHub repository in a project.",
+		attributes:  siteAttributes,
+		create:      createSite,
+		read:        readSite,
+		update:      updateSite,
+		delete:      deleteSite,
+		importState: func(id string) (values, error) { return values{"id": id}, nil },
+	}
+}
+
+func siteConfig(plan values) *commonv1.SiteConfig {
+	return &commonv1.SiteConfig{
+		SiteName:         plan.str("name"),
+		GithubRepository: plan.str("github_repository"),
+		GithubRef:        plan.str("github_ref"),
+		ComposePath:      plan.str("compose_path"),
+		ComposeFile:      plan.str("compose_file"),
+		Port:             int32(plan.int("port")),
+		ApplicationType:  plan.str("application_type"),
+		UpCmd:            plan.list("up_cmd"),
+		InitCmd:          plan.list("init_cmd"),
+		RolloutCmd:       plan.list("rollout_cmd"),
+		OverlayVolumes:   plan.list("overlay_volumes"),
+		Os:               plan.str("os"),
+		IsProduction:     plan.bool("is_production"),
+		Labels:           plan.strMap("labels"),
+	}
+}
+
+func createSite(ctx context.Context, c *client, plan values) (values, error) {
+	resp, err := c.sites.CreateSite(ctx, connect.NewRequest(&libopsv1.CreateSiteRequest{
+		ProjectId: plan.str("project_id"),
+		Site:      siteConfig(plan),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	state := plan.clone()
+	state["id"] = resp.Msg.Site.SiteId
+	return readSite(ctx, c, state)
+}
+
+func readSite(ctx context.Context, c *client, state values) (values, error) {
+	resp, err := c.sites.GetSite(ctx, connect.NewRequest(&libopsv1.GetSiteRequest{
+		SiteId: state.str("id"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	site := resp.Msg.Site
+	newState := values{
+		"id":              state.str("id"),
+		"project_id":      site.ProjectId,
+		"organization_id": site.OrganizationId,
+		"name":            site.SiteName,
+		"github_ref":      site.GithubRef,
+		"os":              site.Os,
+	}
+	for _, a := range siteAttributes {
+		if a.unread {
+			newState[a.name] = state[a.name]
+		}
+	}
+	newState.setOptional(state, "up_cmd", site.UpCmd)
+	newState.setOptional(state, "init_cmd", site.InitCmd)
+	newState.setOptional(state, "rollout_cmd", site.RolloutCmd)
+	newState.setOptional(state, "overlay_volumes", site.OverlayVolumes)
+	newState.setOptional(state, "is_production", site.IsProduction)
+	newState.setOptional(state, "labels", site.Labels)
+	return newState, nil
+}
+
+func updateSite(ctx context.Context, c *client, prior, plan values) (values, error) {
+	// An imported site only adopting its configured repository settings has
+	// nothing to send.
+	mask := updateMask(prior, plan, siteMaskPaths)
+	if len(mask) == 0 {
+		return plan, nil
+	}
+
+	_, err := c.sites.UpdateSite(ctx, connect.NewRequest(&libopsv1.UpdateSiteRequest{
+		SiteId:     prior.str("id"),
+		Site:       siteConfig(plan),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: mask},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func deleteSite(ctx context.Context, c *client, state values) error {
+	_, err := c.sites.DeleteSite(ctx, connect.NewRequest(&libopsv1.DeleteSiteRequest{
+		SiteId: state.str("id"),
+	}))
+	return err
+}
@@ -0,0 +1,32 @@
+// Command terraform-provider-libops is the Terraform provider for the LibOps API.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+
+	"github.com/libops/api/provider/internal/provider"
+)
+
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
+func main() {
+	debug := flag.Bool("debug", false, "run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	var opts []tf6server.ServeOpt
+	if *debug {
+		opts = append(opts, tf6server.WithManagedDebug())
+	}
+
+	err := tf6server.Serve(provider.Address, func() tfprotov6.ProviderServer {
+		return provider.New(version)
+	}, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,24 @@
+-- name: UpsertSiteAccessProtection :exec
+INSERT INTO site_access_protections (site_id, mode, basic_auth_username, basic_auth_password_hash, updated_by)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    mode = VALUES(mode),
+    basic_auth_username = VALUES(basic_auth_username),
+    basic_auth_password_hash = VALUES(basic_auth_password_hash),
+    updated_by = VALUES(updated_by);
+
+-- name: GetSiteAccessProtection :one
+SELECT id, site_id, mode, basic_auth_username, basic_auth_password_hash, created_at, updated_at
+FROM site_access_protections
+WHERE site_id = ?;
+
+-- name: DeleteSiteAccessProtection :exec
+-- Puts the site back on its default protection
+DELETE FROM site_access_protections WHERE site_id = ?;
+
+-- name: GetSiteAccessGateSecret :one
+SELECT access_gate_secret FROM sites WHERE id = ?;
+
+-- name: InitSiteAccessGateSecret :exec
+-- Only the first caller's secret sticks; everyone re-reads it afterwards
+UPDATE sites SET access_gate_secret = ? WHERE id = ? AND access_gate_secret IS NULL;
@@ -73,6 +73,7 @@ LIMIT ? OFFSET ?;
 -- name: ListAPIKeysByAccount :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, `name`, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys
 WHERE account_id = ?
@@ -88,6 +89,7 @@ SELECT sk.id, BIN_TO_UUID(sk.public_id) AS public_id,
 FROM ssh_keys sk
 JOIN accounts a ON sk.account_id = a.id
 WHERE a.public_id = UUID_TO_BIN(sqlc.arg(public_id))
+  AND sk.expires_at IS NULL
 ORDER BY sk.created_at DESC;
 
 
@@ -157,6 +159,36 @@ WHERE email = ?;
 DELETE FROM email_verification_tokens
 WHERE expires_at < NOW();
 
+
+-- name: UpsertPasswordResetToken :exec
+INSERT INTO password_reset_tokens (
+    account_id,
+    token_hash,
+    expires_at
+) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    token_hash = VALUES(token_hash),
+    expires_at = VALUES(expires_at),
+    created_at = CURRENT_TIMESTAMP;
+
+
+-- name: GetPasswordResetToken :one
+SELECT t.account_id, a.email, t.expires_at
+FROM password_reset_tokens t
+JOIN accounts a ON a.id = t.account_id
+WHERE t.token_hash = ?
+  AND t.expires_at > NOW();
+
+
+-- name: DeletePasswordResetToken :exec
+DELETE FROM password_reset_tokens
+WHERE account_id = ?;
+
+
+-- name: CleanupExpiredPasswordResetTokens :exec
+DELETE FROM password_reset_tokens
+WHERE expires_at < NOW();
+
 -- =============================================================================
 -- ORGANIZATION SECRETS
 -- =============================================================================
@@ -183,10 +215,50 @@ WHERE id = ?;
 -- name: GetOnboardingSessionByAccountID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name,
        CASE WHEN organization_public_id IS NULL THEN NULL ELSE BIN_TO_UUID(organization_public_id) END AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
 FROM onboarding_sessions WHERE account_id = ? AND completed = FALSE ORDER BY created_at DESC LIMIT 1;
 
 
+
+
+-- name: GetAccountPreferences :one
+SELECT account_id, theme, timezone, default_organization_id, page_size, created_at, updated_at, locale
+FROM account_preferences WHERE account_id = ?;
+
+
+-- name: UpsertAccountPreferences :exec
+INSERT INTO account_preferences (account_id, theme, timezone, default_organization_id, page_size, locale)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  theme = VALUES(theme),
+  timezone = VALUES(timezone),
+  default_organization_id = VALUES(default_organization_id),
+  page_size = VALUES(page_size),
+  locale = VALUES(locale);
+
+
+-- name: GetAccountProfile :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, email, `name`, phone, avatar_object
+FROM accounts WHERE id = ?;
+
+
+-- name: GetAccountAvatar :one
+SELECT avatar_object FROM accounts WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+
+-- name: UpdateAccountProfile :exec
+UPDATE accounts SET
+  `name` = ?,
+  phone = ?,
+  updated_at = NOW()
+WHERE id = ?;
+
+
+-- name: UpdateAccountAvatar :exec
+UPDATE accounts SET
+  avatar_object = ?,
+  updated_at = NOW()
+WHERE id = ?;
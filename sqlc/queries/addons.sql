@@ -0,0 +1,24 @@
+-- name: CreateSiteAddon :exec
+INSERT INTO site_addons (public_id, site_id, kind, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?);
+
+-- name: GetSiteAddon :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, kind, status, status_message,
+       host, port, checked_at, created_at, updated_at
+FROM site_addons
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListSiteAddons :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, kind, status, status_message,
+       host, port, checked_at, created_at, updated_at
+FROM site_addons
+WHERE site_id = ?
+ORDER BY kind;
+
+-- name: ReportSiteAddonHealth :exec
+UPDATE site_addons
+SET status = ?, status_message = ?, host = ?, port = ?, checked_at = NOW()
+WHERE id = ?;
+
+-- name: DeleteSiteAddon :exec
+DELETE FROM site_addons WHERE id = ?;
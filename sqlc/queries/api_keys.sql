@@ -1,12 +1,13 @@
 -- name: CreateAPIKey :exec
 INSERT INTO api_keys (
-  public_id, account_id, `name`, description, scopes, created_at, expires_at, active, created_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, NOW(), ?, ?, ?);
+  public_id, account_id, `name`, description, scopes, allowed_cidrs, created_at, expires_at, active, created_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, NOW(), ?, ?, ?);
 
 
 -- name: GetAPIKeyByUUID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, `name`, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
@@ -14,6 +15,7 @@ FROM api_keys WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 -- name: GetAPIKeyByID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, `name`, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys WHERE id = ?;
 
@@ -37,12 +39,68 @@ DELETE FROM api_keys WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 -- name: GetActiveAPIKeyByUUID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, `name`, description,
        COALESCE(scopes, '[]') as scopes,
+       COALESCE(allowed_cidrs, '[]') as allowed_cidrs,
        created_at, last_used_at, expires_at, active, created_by
 FROM api_keys
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id))
   AND active = TRUE
   AND (expires_at IS NULL OR expires_at > NOW());
 
+-- name: ListOrganizationAPIKeys :many
+-- Working API keys held by anyone who is a member of the organization, or of one
+-- of its projects or sites, so owners can see who can reach its resources.
+SELECT k.id, BIN_TO_UUID(k.public_id) AS public_id, k.`name`, k.description,
+       COALESCE(k.scopes, '[]') as scopes,
+       COALESCE(k.allowed_cidrs, '[]') as allowed_cidrs,
+       k.created_at, k.last_used_at, k.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.auth_method
+FROM api_keys k
+JOIN accounts a ON k.account_id = a.id
+WHERE k.active = TRUE
+  AND (k.expires_at IS NULL OR k.expires_at > NOW())
+  AND k.account_id IN (
+    SELECT om.account_id FROM organization_members om
+    WHERE om.organization_id = sqlc.arg(organization_id) AND om.status = 'active'
+    UNION
+    SELECT pm.account_id FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE p.organization_id = sqlc.arg(organization_id) AND pm.status = 'active'
+    UNION
+    SELECT sm.account_id FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE p.organization_id = sqlc.arg(organization_id) AND sm.status = 'active'
+  )
+ORDER BY k.created_at DESC
+LIMIT ? OFFSET ?;
+
+
+-- name: GetOrganizationAPIKey :one
+-- One of the keys ListOrganizationAPIKeys lists.
+SELECT k.id, BIN_TO_UUID(k.public_id) AS public_id, k.`name`, k.description,
+       COALESCE(k.scopes, '[]') as scopes,
+       COALESCE(k.allowed_cidrs, '[]') as allowed_cidrs,
+       k.created_at, k.last_used_at, k.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.auth_method
+FROM api_keys k
+JOIN accounts a ON k.account_id = a.id
+WHERE k.public_id = UUID_TO_BIN(sqlc.arg(public_id))
+  AND k.active = TRUE
+  AND (k.expires_at IS NULL OR k.expires_at > NOW())
+  AND k.account_id IN (
+    SELECT om.account_id FROM organization_members om
+    WHERE om.organization_id = sqlc.arg(organization_id) AND om.status = 'active'
+    UNION
+    SELECT pm.account_id FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE p.organization_id = sqlc.arg(organization_id) AND pm.status = 'active'
+    UNION
+    SELECT sm.account_id FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE p.organization_id = sqlc.arg(organization_id) AND sm.status = 'active'
+  );
+
 -- =============================================================================
 -- ORGANIZATION MEMBERS
 -- =============================================================================
@@ -2,3 +2,35 @@
 INSERT INTO audit (
   account_id, entity_id, entity_type, event_name, event_data
 ) VALUES (?, ?, ?, ?, ?);
+
+-- name: ListAuditEvents :many
+-- Audit events, newest first, for the dashboard's activity pages. Each scope includes the
+-- resources beneath it: an organization's projects and sites, a project's sites. Scopes and
+-- filters left NULL match everything; event_pattern is a LIKE pattern. entity_name and
+-- entity_public_id are empty once the entity is deleted.
+SELECT a.id, a.account_id, ac.email AS actor_email, ac.name AS actor_name,
+       a.entity_type, a.entity_id,
+       CONCAT_WS('', eo.name, ep.name, es.name) AS entity_name,
+       CONCAT_WS('', BIN_TO_UUID(eo.public_id), BIN_TO_UUID(ep.public_id), BIN_TO_UUID(es.public_id)) AS entity_public_id,
+       a.event_name, a.event_data, a.created_at
+FROM audit a
+LEFT JOIN accounts ac ON ac.id = a.account_id
+LEFT JOIN organizations eo ON a.entity_type = 'organizations' AND eo.id = a.entity_id
+LEFT JOIN projects ep ON a.entity_type = 'projects' AND ep.id = a.entity_id
+LEFT JOIN sites es ON a.entity_type = 'sites' AND es.id = a.entity_id
+WHERE (sqlc.narg(organization_id) IS NULL
+    OR (a.entity_type = 'organizations' AND a.entity_id = sqlc.narg(organization_id))
+    OR (a.entity_type = 'projects' AND a.entity_id IN (SELECT p.id FROM projects p WHERE p.organization_id = sqlc.narg(organization_id)))
+    OR (a.entity_type = 'sites' AND a.entity_id IN (
+        SELECT s.id FROM sites s JOIN projects p ON p.id = s.project_id WHERE p.organization_id = sqlc.narg(organization_id)
+    )))
+  AND (sqlc.narg(project_id) IS NULL
+    OR (a.entity_type = 'projects' AND a.entity_id = sqlc.narg(project_id))
+    OR (a.entity_type = 'sites' AND a.entity_id IN (SELECT s.id FROM sites s WHERE s.project_id = sqlc.narg(project_id))))
+  AND (sqlc.narg(site_id) IS NULL OR (a.entity_type = 'sites' AND a.entity_id = sqlc.narg(site_id)))
+  AND (sqlc.narg(account_id) IS NULL OR a.account_id = sqlc.narg(account_id))
+  AND (sqlc.narg(actor_email) IS NULL OR ac.email = sqlc.narg(actor_email))
+  AND (sqlc.narg(event_pattern) IS NULL OR a.event_name LIKE sqlc.narg(event_pattern))
+  AND (sqlc.narg(since) IS NULL OR a.created_at >= sqlc.narg(since))
+ORDER BY a.id DESC
+LIMIT ? OFFSET ?;
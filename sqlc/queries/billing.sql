@@ -39,6 +39,18 @@ SELECT id, config_key, stripe_price_id, price_per_gb_cents, min_size_gb, max_siz
 FROM storage_config
 WHERE config_key = 'disk_storage' AND active = TRUE;
 
+
+-- name: UpsertStorageConfig :exec
+INSERT INTO storage_config (config_key, stripe_price_id, price_per_gb_cents, min_size_gb, max_size_gb, active)
+VALUES ('disk_storage', ?, ?, ?, ?, TRUE)
+ON DUPLICATE KEY UPDATE
+  stripe_price_id = VALUES(stripe_price_id),
+  price_per_gb_cents = VALUES(price_per_gb_cents),
+  min_size_gb = VALUES(min_size_gb),
+  max_size_gb = VALUES(max_size_gb),
+  active = TRUE,
+  updated_at = NOW();
+
 -- =============================================================================
 -- PROJECTS
 -- =============================================================================
@@ -46,7 +58,7 @@ WHERE config_key = 'disk_storage' AND active = TRUE;
 
 -- name: GetOnboardingSessionByStripeCheckoutID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name, BIN_TO_UUID(organization_public_id) AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
@@ -98,3 +110,63 @@ DELETE FROM stripe_subscriptions WHERE stripe_subscription_id = ?;
 -- =============================================================================
 
 
+
+-- name: GetStripeSubscriptionByCustomerID :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, stripe_subscription_id, stripe_customer_id, stripe_checkout_session_id,
+       status, current_period_start, current_period_end, trial_start, trial_end,
+       cancel_at_period_end, canceled_at, machine_type, disk_size_gb, created_at, updated_at
+FROM stripe_subscriptions WHERE stripe_customer_id = ?
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: SetOrganizationPaymentFailed :exec
+UPDATE organizations
+SET payment_failed_at = ?, payment_failed_invoice_id = ?
+WHERE id = ?;
+
+-- name: ClearOrganizationPaymentFailed :exec
+UPDATE organizations
+SET payment_failed_at = NULL, payment_failed_invoice_id = NULL
+WHERE id = ?;
+
+-- name: GetOrganizationPaymentFailure :one
+SELECT payment_failed_at, payment_failed_invoice_id
+FROM organizations WHERE id = ?;
+
+-- name: GetOrganizationBillingState :one
+SELECT billing_state, billing_state_changed_at
+FROM organizations WHERE id = ?;
+
+-- name: SetOrganizationBillingState :exec
+UPDATE organizations
+SET billing_state = ?, billing_state_changed_at = NOW()
+WHERE id = ?;
+
+-- name: ListOrganizationsByBillingState :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, billing_state, billing_state_changed_at
+FROM organizations
+WHERE billing_state = ? AND billing_state_changed_at < ?
+ORDER BY billing_state_changed_at;
+
+-- name: SuspendOrganizationSites :execrows
+UPDATE sites
+SET `status` = 'suspended'
+WHERE `status` = 'active'
+  AND project_id IN (SELECT id FROM projects WHERE organization_id = ?);
+
+-- name: ListOrganizationSitePublicIDs :many
+SELECT BIN_TO_UUID(s.public_id) AS public_id
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+WHERE p.organization_id = ?;
+
+-- name: ResumeOrganizationSites :execrows
+-- Sites stay suspended while either the billing or an operator suspension holds.
+UPDATE sites
+SET `status` = 'active'
+WHERE `status` = 'suspended'
+  AND project_id IN (
+    SELECT p.id FROM projects p
+    JOIN organizations o ON p.organization_id = o.id
+    WHERE o.id = ? AND o.`status` != 'suspended' AND o.billing_state IN ('active', 'past_due')
+  );
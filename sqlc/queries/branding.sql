@@ -0,0 +1,26 @@
+-- name: GetOrganizationBranding :one
+SELECT organization_id, primary_color, accent_color, logo_content_type, logo IS NOT NULL AS has_logo, updated_at
+FROM organization_branding
+WHERE organization_id = ?;
+
+-- name: GetOrganizationLogo :one
+SELECT logo, logo_content_type, updated_at
+FROM organization_branding
+WHERE organization_id = ? AND logo IS NOT NULL;
+
+-- name: UpsertOrganizationBrandingColors :exec
+INSERT INTO organization_branding (organization_id, primary_color, accent_color)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  primary_color = VALUES(primary_color),
+  accent_color = VALUES(accent_color);
+
+-- name: UpsertOrganizationLogo :exec
+INSERT INTO organization_branding (organization_id, logo, logo_content_type)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  logo = VALUES(logo),
+  logo_content_type = VALUES(logo_content_type);
+
+-- name: DeleteOrganizationBranding :exec
+DELETE FROM organization_branding WHERE organization_id = ?;
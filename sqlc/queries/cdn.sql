@@ -0,0 +1,52 @@
+-- name: CreateSiteCdnConfig :exec
+INSERT INTO site_cdn_configs (site_id, cache_mode, default_ttl_seconds, created_by) VALUES (?, ?, ?, ?);
+
+-- name: GetSiteCdnConfig :one
+SELECT id, site_id, cache_mode, default_ttl_seconds, ip_address, status, created_at, updated_at
+FROM site_cdn_configs
+WHERE site_id = ?;
+
+-- name: UpdateSiteCdnCachePolicy :exec
+UPDATE site_cdn_configs
+SET cache_mode = ?, default_ttl_seconds = ?
+WHERE id = ?;
+
+-- name: MarkSiteCdnConfigDisabling :exec
+-- The CDN is left out of the site's terraform vars from here on, and the row
+-- removed once the terraform runner reports the load balancer is gone
+UPDATE site_cdn_configs SET status = 'disabling' WHERE id = ?;
+
+-- name: ActivateSiteCdnConfig :exec
+UPDATE site_cdn_configs
+SET status = 'active', ip_address = ?
+WHERE id = ? AND status != 'disabling';
+
+-- name: DeleteSiteCdnConfig :exec
+DELETE FROM site_cdn_configs WHERE id = ?;
+
+-- name: ListSiteCdnDomains :many
+SELECT domain FROM domains WHERE site_id = ? ORDER BY domain;
+
+-- name: CreateSiteCachePurge :exec
+INSERT INTO site_cache_purges (public_id, site_id, path, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?);
+
+-- name: GetSiteCachePurge :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, path, status, created_at, completed_at
+FROM site_cache_purges
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListPendingSiteCachePurges :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, path, status, created_at, completed_at
+FROM site_cache_purges
+WHERE site_id = ? AND status = 'pending'
+ORDER BY id;
+
+-- name: CompleteSiteCachePurge :exec
+UPDATE site_cache_purges
+SET status = 'completed', completed_at = NOW()
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND site_id = ? AND status = 'pending';
+
+-- name: DeletePendingSiteCachePurges :exec
+-- Purges can't run once the CDN is gone, and there's nothing left to invalidate
+DELETE FROM site_cache_purges WHERE site_id = ? AND status = 'pending';
@@ -0,0 +1,54 @@
+-- name: GetSiteConfigVar :one
+SELECT id, site_id, name, value, revision, created_at, updated_at, updated_by
+FROM site_config_vars
+WHERE site_id = ? AND name = ?;
+
+-- name: ListSiteConfigVars :many
+SELECT id, site_id, name, value, revision, created_at, updated_at, updated_by
+FROM site_config_vars
+WHERE site_id = ?
+ORDER BY name
+LIMIT ? OFFSET ?;
+
+-- name: ListAllSiteConfigVars :many
+SELECT name, value
+FROM site_config_vars
+WHERE site_id = ?
+ORDER BY name;
+
+-- name: CountSiteConfigVars :one
+SELECT COUNT(*) FROM site_config_vars WHERE site_id = ?;
+
+-- name: UpsertSiteConfigVar :exec
+INSERT INTO site_config_vars (site_id, name, value, revision, updated_by)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE value = VALUES(value), revision = VALUES(revision), updated_by = VALUES(updated_by);
+
+-- name: DeleteSiteConfigVar :exec
+DELETE FROM site_config_vars WHERE site_id = ? AND name = ?;
+
+-- name: GetLatestSiteConfigVarRevision :one
+SELECT CAST(COALESCE(MAX(revision), 0) AS SIGNED) AS revision
+FROM site_config_var_revisions
+WHERE site_id = ?;
+
+-- name: CreateSiteConfigVarRevision :exec
+-- The revision number is unique per site, so of two concurrent changes
+-- numbered alike, the second fails instead of overwriting the first's history
+INSERT INTO site_config_var_revisions (site_id, revision, name, previous_value, value, created_by)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListSiteConfigVarRevisions :many
+SELECT r.revision, r.name, r.previous_value, r.value, r.created_at, a.email AS created_by_email
+FROM site_config_var_revisions r
+LEFT JOIN accounts a ON a.id = r.created_by
+WHERE r.site_id = ?
+ORDER BY r.revision DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListSiteConfigVarRevisionsBetween :many
+-- The changes after one revision up to and including another, oldest first
+SELECT revision, name, previous_value, value
+FROM site_config_var_revisions
+WHERE site_id = ? AND revision > sqlc.arg(from_revision) AND revision <= sqlc.arg(to_revision)
+ORDER BY revision;
@@ -0,0 +1,25 @@
+-- name: ListControllerConfigs :many
+-- The fleet-wide document followed by the site's own, if either exists
+SELECT id, site_id, rate_limit_rps, rate_limit_burst, reconcile_interval_minutes,
+       checkin_interval_seconds, controller_version, feature_flags, updated_at
+FROM controller_configs
+WHERE site_id IS NULL OR site_id = sqlc.arg(site_id)
+ORDER BY scope_key;
+
+-- name: UpsertControllerConfig :exec
+INSERT INTO controller_configs (
+    site_id, rate_limit_rps, rate_limit_burst, reconcile_interval_minutes,
+    checkin_interval_seconds, controller_version, feature_flags, updated_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    rate_limit_rps = VALUES(rate_limit_rps),
+    rate_limit_burst = VALUES(rate_limit_burst),
+    reconcile_interval_minutes = VALUES(reconcile_interval_minutes),
+    checkin_interval_seconds = VALUES(checkin_interval_seconds),
+    controller_version = VALUES(controller_version),
+    feature_flags = VALUES(feature_flags),
+    updated_by = VALUES(updated_by);
+
+-- name: DeleteSiteControllerConfig :execrows
+DELETE FROM controller_configs
+WHERE site_id = ?;
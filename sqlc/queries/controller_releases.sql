@@ -0,0 +1,21 @@
+-- name: CreateControllerRelease :exec
+INSERT INTO controller_releases (
+    version, download_url, sha256, signature, notes, created_by
+) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetControllerRelease :one
+SELECT id, version, download_url, sha256, signature, notes, created_at
+FROM controller_releases
+WHERE version = ?;
+
+-- name: ListControllerReleases :many
+-- Newest first, with how many sites reported running each on check-in
+SELECT r.id, r.version, r.download_url, r.sha256, r.signature, r.notes, r.created_at,
+       (SELECT COUNT(*) FROM sites s WHERE s.controller_version = r.version) AS site_count
+FROM controller_releases r
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT ?;
+
+-- name: UpdateSiteControllerVersion :exec
+-- Records the release a site's controller reported on check-in
+UPDATE sites SET controller_version = ? WHERE id = ?;
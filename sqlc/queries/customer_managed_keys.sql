@@ -0,0 +1,44 @@
+-- name: ListOrganizationEncryptionKeys :many
+SELECT organization_id, location, kms_key_name, status, status_message, checked_at, created_at, updated_at, created_by, updated_by
+FROM organization_encryption_keys
+WHERE organization_id = ?
+ORDER BY location;
+
+
+-- name: SetOrganizationEncryptionKey :exec
+-- Replaces the organization's key for a location along with the result of checking it
+INSERT INTO organization_encryption_keys (organization_id, location, kms_key_name, status, status_message, checked_at, created_by, updated_by)
+VALUES (sqlc.arg(organization_id), sqlc.arg(location), sqlc.arg(kms_key_name), sqlc.arg(status), sqlc.arg(status_message), NOW(), sqlc.arg(account_id), sqlc.arg(account_id))
+ON DUPLICATE KEY UPDATE
+    kms_key_name = VALUES(kms_key_name),
+    status = VALUES(status),
+    status_message = VALUES(status_message),
+    checked_at = VALUES(checked_at),
+    updated_by = VALUES(updated_by);
+
+
+-- name: UpdateOrganizationEncryptionKeyStatus :exec
+UPDATE organization_encryption_keys
+SET status = ?, status_message = ?, checked_at = NOW()
+WHERE organization_id = ? AND location = ?;
+
+
+-- name: DeleteOrganizationEncryptionKey :execrows
+DELETE FROM organization_encryption_keys WHERE organization_id = ? AND location = ?;
+
+
+-- name: ListOrganizationGcpProjects :many
+-- The GCP projects of an organization's live projects, whose Cloud SQL service
+-- agents encrypt sites' databases with the key for the project's region
+SELECT gcp_project_number, gcp_region
+FROM projects
+WHERE organization_id = ? AND status != 'deleted' AND gcp_project_number IS NOT NULL AND gcp_project_number != ''
+ORDER BY gcp_project_number;
+
+
+-- name: GetOrganizationStateLocation :one
+-- Where the organization's tfstate bucket is: its data residency's
+-- multi-region when it has one, otherwise its location
+SELECT LOWER(COALESCE(data_residency, location)) AS location
+FROM organizations
+WHERE id = ?;
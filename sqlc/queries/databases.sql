@@ -0,0 +1,44 @@
+-- name: CreateSiteDatabase :exec
+INSERT INTO site_databases (public_id, site_id, name, tier, port, password_rotated_at, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, NOW(), ?);
+
+-- name: GetSiteDatabase :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, tier, status, status_message,
+       host, port, password_rotated_at, created_at, updated_at
+FROM site_databases
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListSiteDatabases :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, tier, status, status_message,
+       host, port, password_rotated_at, created_at, updated_at
+FROM site_databases
+WHERE site_id = ?
+ORDER BY name;
+
+-- name: CountSiteDatabases :one
+SELECT COUNT(*) FROM site_databases WHERE site_id = ?;
+
+-- name: RotateSiteDatabasePassword :exec
+UPDATE site_databases SET password_rotated_at = NOW() WHERE id = ?;
+
+-- name: ActivateSiteDatabase :exec
+UPDATE site_databases
+SET status = 'active', status_message = NULL, host = ?, port = ?
+WHERE id = ?;
+
+-- name: FailSiteDatabase :exec
+UPDATE site_databases
+SET status = 'failed', status_message = ?
+WHERE id = ?;
+
+-- name: UpsertManagedSiteSecret :exec
+-- Takes over a deleted secret of the same name, which still holds the name
+INSERT INTO site_secrets (
+    public_id, site_id, name, vault_path, key_version, status, managed, created_at, updated_at
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, 'active', TRUE, sqlc.arg(now), sqlc.arg(now))
+ON DUPLICATE KEY UPDATE
+    vault_path = VALUES(vault_path),
+    key_version = VALUES(key_version),
+    status = 'active',
+    managed = TRUE,
+    updated_at = VALUES(updated_at);
@@ -1,11 +1,11 @@
 -- name: GetDeployment :one
-SELECT id, site_id, `status`, github_run_id, github_run_url, started_at, completed_at, error_message, created_at
+SELECT id, site_id, `status`, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, git_ref, commit_sha, commit_message, rollback_of, created_by
 FROM deployments WHERE id = ?;
 
 -- name: CreateDeployment :exec
 INSERT INTO deployments (
-  id, site_id, `status`, github_run_id, github_run_url, started_at, completed_at, error_message, created_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW());
+  id, site_id, `status`, git_ref, commit_sha, commit_message, rollback_of, github_run_id, github_run_url, started_at, completed_at, error_message, created_at, created_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, UNIX_TIMESTAMP(), ?);
 
 -- name: UpdateDeployment :exec
 UPDATE deployments SET
@@ -22,7 +22,7 @@ DELETE FROM deployments WHERE id = ?;
 -- name: ListSiteDeployments :many
 SELECT * FROM deployments
 WHERE site_id = ?
-ORDER BY created_at DESC
+ORDER BY created_at DESC, started_at DESC
 LIMIT ? OFFSET ?;
 
 -- name: GetLatestSiteDeployment :one
@@ -0,0 +1,35 @@
+-- name: CreateDeviceAuthorization :exec
+INSERT INTO device_authorizations (device_code_hash, user_code, client_name, expires_at)
+VALUES (?, ?, ?, ?);
+
+
+-- name: GetDeviceAuthorizationByUserCode :one
+SELECT id, user_code, client_name, status, account_id, expires_at, last_polled_at
+FROM device_authorizations
+WHERE user_code = ?;
+
+
+-- name: GetDeviceAuthorizationByDeviceCodeHash :one
+SELECT id, user_code, client_name, status, account_id, expires_at, last_polled_at
+FROM device_authorizations
+WHERE device_code_hash = ?;
+
+
+-- name: DecideDeviceAuthorization :execrows
+-- Approve or deny a pending request. Only the first decision counts.
+UPDATE device_authorizations SET status = ?, account_id = ?, decided_at = NOW()
+WHERE id = ? AND status = 'pending' AND expires_at > NOW();
+
+
+-- name: RedeemDeviceAuthorization :execrows
+-- The device code can be exchanged for an API key once.
+UPDATE device_authorizations SET status = 'redeemed'
+WHERE id = ? AND status = 'approved' AND expires_at > NOW();
+
+
+-- name: TouchDeviceAuthorization :exec
+UPDATE device_authorizations SET last_polled_at = NOW() WHERE id = ?;
+
+
+-- name: DeleteExpiredDeviceAuthorizations :exec
+DELETE FROM device_authorizations WHERE expires_at < NOW() - INTERVAL 1 DAY;
@@ -0,0 +1,77 @@
+-- name: IsEffectiveAccessStale :one
+-- Whether an account's effective access needs refreshing before it's read
+SELECT EXISTS (
+    SELECT 1 FROM effective_access_stale WHERE account_id = ?
+);
+
+
+-- name: LockEffectiveAccessStale :one
+-- Locks an account's stale marker for the refresh transaction; membership and
+-- relationship changes that mark it again wait until the refresh commits
+SELECT account_id FROM effective_access_stale WHERE account_id = ? FOR UPDATE;
+
+
+-- name: ListStaleEffectiveAccessAccounts :many
+-- Accounts marked stale, longest waiting first
+SELECT account_id FROM effective_access_stale
+ORDER BY marked_at, account_id
+LIMIT ?;
+
+
+-- name: ComputeEffectiveOrganizationAccess :many
+-- The organizations an account reaches through memberships and approved
+-- relationship chains, and those it can read through a project or site
+WITH RECURSIVE reach AS (
+    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
+    UNION DISTINCT
+    SELECT r.target_organization_id
+    FROM relationships r
+    INNER JOIN reach ON r.source_organization_id = reach.organization_id
+    WHERE r.status = 'approved'
+),
+member_orgs AS (
+    SELECT p.organization_id
+    FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    UNION DISTINCT
+    SELECT p.organization_id
+    FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
+),
+inherited AS (
+    SELECT organization_id FROM member_orgs
+    UNION DISTINCT
+    SELECT r.target_organization_id
+    FROM relationships r
+    INNER JOIN member_orgs mo ON r.source_organization_id = mo.organization_id
+    WHERE r.status = 'approved'
+)
+SELECT organization_id, CAST(MAX(reachable) AS SIGNED) AS reachable, CAST(MAX(inherited_read) AS SIGNED) AS inherited_read
+FROM (
+    SELECT organization_id, 1 AS reachable, 0 AS inherited_read FROM reach
+    UNION ALL
+    SELECT organization_id, 0, 1 FROM inherited
+) access
+GROUP BY organization_id;
+
+
+-- name: DeleteEffectiveOrganizationAccess :exec
+DELETE FROM effective_organization_access WHERE account_id = ?;
+
+
+-- name: InsertEffectiveOrganizationAccess :exec
+INSERT INTO effective_organization_access (account_id, organization_id, reachable, inherited_read)
+VALUES (?, ?, ?, ?);
+
+
+-- name: ClearEffectiveAccessStale :exec
+DELETE FROM effective_access_stale WHERE account_id = ?;
+
+
+-- name: GetEffectiveOrganizationAccess :one
+-- How an account reaches an organization, if at all
+SELECT reachable, inherited_read FROM effective_organization_access
+WHERE account_id = ? AND organization_id = ?;
@@ -0,0 +1,20 @@
+-- name: CreateSiteStaticEgressIp :exec
+INSERT INTO site_static_egress_ips (site_id, created_by) VALUES (?, ?);
+
+-- name: GetSiteStaticEgressIp :one
+SELECT id, site_id, ip_address, status, created_at, updated_at
+FROM site_static_egress_ips
+WHERE site_id = ?;
+
+-- name: MarkSiteStaticEgressIpReleasing :exec
+-- The address is left out of the site's terraform vars from here on, and the
+-- row removed once the terraform runner reports the reservation is gone
+UPDATE site_static_egress_ips SET status = 'releasing' WHERE id = ?;
+
+-- name: ActivateSiteStaticEgressIp :exec
+UPDATE site_static_egress_ips
+SET status = 'active', ip_address = ?
+WHERE id = ? AND status != 'releasing';
+
+-- name: DeleteSiteStaticEgressIp :exec
+DELETE FROM site_static_egress_ips WHERE id = ?;
@@ -0,0 +1,90 @@
+-- name: CreateSiteElevation :exec
+INSERT INTO site_elevations (public_id, site_id, account_id, `role`, reason, duration_minutes)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?);
+
+
+-- name: GetSiteElevation :one
+SELECT e.id, BIN_TO_UUID(e.public_id) AS public_id, e.site_id, e.account_id, e.`role`, e.reason,
+       e.duration_minutes, e.`status`, e.created_at, e.resolved_at, e.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.avatar_object,
+       r.email AS resolved_by_email
+FROM site_elevations e
+JOIN accounts a ON a.id = e.account_id
+LEFT JOIN accounts r ON r.id = e.resolved_by
+WHERE e.public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+
+-- name: ListSiteElevations :many
+SELECT e.id, BIN_TO_UUID(e.public_id) AS public_id, e.site_id, e.account_id, e.`role`, e.reason,
+       e.duration_minutes, e.`status`, e.created_at, e.resolved_at, e.expires_at,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name AS user_name, a.avatar_object,
+       r.email AS resolved_by_email
+FROM site_elevations e
+JOIN accounts a ON a.id = e.account_id
+LEFT JOIN accounts r ON r.id = e.resolved_by
+WHERE e.site_id = ?
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT ? OFFSET ?;
+
+
+-- name: CountOpenSiteElevations :one
+-- Pending requests and unexpired roles an account has on a site
+SELECT COUNT(*) FROM site_elevations
+WHERE site_id = ? AND account_id = ?
+  AND (`status` = 'pending' OR (`status` = 'approved' AND expires_at > CURRENT_TIMESTAMP));
+
+
+-- name: GetActiveSiteElevationRole :one
+-- The highest unexpired role an account was elevated to on a site
+SELECT `role` FROM site_elevations
+WHERE site_id = ? AND account_id = ? AND `status` = 'approved' AND expires_at > CURRENT_TIMESTAMP
+ORDER BY `role` = 'owner' DESC
+LIMIT 1;
+
+
+-- name: ApproveSiteElevation :execresult
+-- The role lasts for the requested duration from approval
+UPDATE site_elevations SET
+  `status` = 'approved',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = ?,
+  expires_at = CURRENT_TIMESTAMP + INTERVAL duration_minutes MINUTE
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` = 'pending';
+
+
+-- name: RejectSiteElevation :execresult
+UPDATE site_elevations SET
+  `status` = 'rejected',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = ?
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` = 'pending';
+
+
+-- name: RevokeSiteElevation :execresult
+UPDATE site_elevations SET
+  `status` = 'revoked',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = ?
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id))
+  AND (`status` = 'pending' OR (`status` = 'approved' AND expires_at > CURRENT_TIMESTAMP));
+
+
+-- name: ListExpiredSiteElevations :many
+-- Approved roles past their expiry, oldest first
+SELECT e.id, BIN_TO_UUID(e.public_id) AS public_id, e.site_id, e.account_id, e.`role`, e.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(p.public_id) AS project_public_id,
+       BIN_TO_UUID(s.public_id) AS site_public_id, BIN_TO_UUID(acc.public_id) AS account_public_id
+FROM site_elevations e
+JOIN sites s ON s.id = e.site_id
+JOIN projects p ON p.id = s.project_id
+JOIN organizations o ON o.id = p.organization_id
+JOIN accounts acc ON acc.id = e.account_id
+WHERE e.`status` = 'approved' AND e.expires_at <= sqlc.arg(now)
+ORDER BY e.expires_at
+LIMIT ?;
+
+
+-- name: ExpireSiteElevation :execrows
+-- Expires a role only if it's still approved and past its expiry
+UPDATE site_elevations SET `status` = 'expired'
+WHERE id = sqlc.arg(id) AND `status` = 'approved' AND expires_at <= sqlc.arg(now);
@@ -0,0 +1,3 @@
+-- name: CreateEmailSend :exec
+INSERT INTO email_sends (template, locale, recipient, subject, provider, provider_message_id, status, error)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
@@ -0,0 +1,50 @@
+-- name: CreateOrganizationDataKey :exec
+INSERT INTO organization_data_keys (organization_id, key_version, wrapped_key, created_by)
+VALUES (?, ?, ?, ?);
+
+
+-- name: GetActiveOrganizationDataKey :one
+-- The newest data key is the one new secrets are sealed with
+SELECT id, organization_id, key_version, wrapped_key, created_at
+FROM organization_data_keys
+WHERE organization_id = ?
+ORDER BY key_version DESC
+LIMIT 1;
+
+
+-- name: ListOrganizationSealedSecrets :many
+-- Every value an organization keeps in its Vault, with the data key version
+-- it's sealed with, for re-wrapping after a rotation. References to external
+-- secrets have nothing in the Vault.
+SELECT 'organization' AS kind, s.id, s.vault_path, s.key_version
+FROM organization_secrets s
+WHERE s.organization_id = sqlc.arg(organization_id) AND s.status != 'deleted' AND s.reference IS NULL
+UNION ALL
+SELECT 'project' AS kind, s.id, s.vault_path, s.key_version
+FROM project_secrets s
+JOIN projects p ON p.id = s.project_id
+WHERE p.organization_id = sqlc.arg(organization_id) AND s.status != 'deleted' AND s.reference IS NULL
+UNION ALL
+SELECT 'site' AS kind, s.id, s.vault_path, s.key_version
+FROM site_secrets s
+JOIN sites st ON st.id = s.site_id
+JOIN projects p ON p.id = st.project_id
+WHERE p.organization_id = sqlc.arg(organization_id) AND s.status != 'deleted' AND s.reference IS NULL
+UNION ALL
+SELECT 'tls' AS kind, t.id, t.private_key_vault_path AS vault_path, NULL AS key_version
+FROM site_tls_policies t
+JOIN sites st ON st.id = t.site_id
+JOIN projects p ON p.id = st.project_id
+WHERE p.organization_id = sqlc.arg(organization_id) AND t.private_key_vault_path IS NOT NULL;
+
+
+-- name: UpdateOrganizationSecretKeyVersion :exec
+UPDATE organization_secrets SET key_version = ? WHERE id = ?;
+
+
+-- name: UpdateProjectSecretKeyVersion :exec
+UPDATE project_secrets SET key_version = ? WHERE id = ?;
+
+
+-- name: UpdateSiteSecretKeyVersion :exec
+UPDATE site_secrets SET key_version = ? WHERE id = ?;
@@ -0,0 +1,32 @@
+-- name: CreateEventSink :exec
+INSERT INTO event_sinks (
+  public_id, organization_id, name, kind, destination, event_types, signing_secret, enabled, created_by
+) VALUES (
+  UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetEventSink :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, kind, destination, event_types, signing_secret,
+       enabled, last_delivery_at, last_error, created_at, updated_at
+FROM event_sinks
+WHERE organization_id = ? AND public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListEventSinks :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, kind, destination, event_types, signing_secret,
+       enabled, last_delivery_at, last_error, created_at, updated_at
+FROM event_sinks
+WHERE organization_id = ?
+ORDER BY name, id
+LIMIT ? OFFSET ?;
+
+-- name: CountEventSinks :one
+SELECT COUNT(*) FROM event_sinks WHERE organization_id = ?;
+
+-- name: UpdateEventSink :exec
+UPDATE event_sinks
+SET name = ?, destination = ?, event_types = ?, enabled = ?
+WHERE id = ?;
+
+-- name: DeleteEventSink :execrows
+DELETE FROM event_sinks
+WHERE organization_id = ? AND public_id = UUID_TO_BIN(sqlc.arg(public_id));
@@ -9,7 +9,8 @@ FROM event_queue;
 -- EVENT QUEUE
 
 -- name: EnqueueEvent :exec
-INSERT INTO event_queue (
+-- Writes to the outbox in the caller's transaction; the event-router relays it into event_queue
+INSERT INTO event_outbox (
     event_id,
     event_type,
     event_source,
@@ -22,6 +23,13 @@ INSERT INTO event_queue (
     created_at
 ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW());
 
+-- name: GetOldestOutboxEvent :one
+-- The oldest event the event-router hasn't relayed yet
+SELECT event_id, created_at
+FROM event_outbox
+ORDER BY id ASC
+LIMIT 1;
+
 -- name: GetPendingEvents :many
 SELECT id, event_id, event_type, event_source, event_subject, event_data, content_type,
         organization_id, project_id, site_id, created_at
@@ -0,0 +1,49 @@
+-- name: CreateOrganizationExport :exec
+INSERT INTO organization_exports (public_id, organization_id, include_secrets, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?);
+
+-- name: GetOrganizationExport :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, include_secrets, status, error,
+       object_name, size_bytes, sha256, expires_at, started_at, completed_at, created_at, created_by
+FROM organization_exports
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListOrganizationExports :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, include_secrets, status, error,
+       object_name, size_bytes, sha256, expires_at, started_at, completed_at, created_at, created_by
+FROM organization_exports
+WHERE organization_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountActiveOrganizationExports :one
+-- Pending and running exports, so an organization can't queue several at once
+SELECT COUNT(*) FROM organization_exports
+WHERE organization_id = ? AND status IN ('pending', 'running');
+
+-- name: ListPendingOrganizationExports :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, include_secrets, status, error,
+       object_name, size_bytes, sha256, expires_at, started_at, completed_at, created_at, created_by
+FROM organization_exports
+WHERE status = 'pending'
+ORDER BY created_at, id
+LIMIT ?;
+
+-- name: StartOrganizationExport :execrows
+-- Claims a pending export so only one API instance builds it
+UPDATE organization_exports SET status = 'running', started_at = NOW()
+WHERE id = ? AND status = 'pending';
+
+-- name: CompleteOrganizationExport :exec
+UPDATE organization_exports
+SET status = 'succeeded', object_name = ?, size_bytes = ?, sha256 = ?, expires_at = ?, completed_at = NOW()
+WHERE id = ?;
+
+-- name: FailOrganizationExport :exec
+UPDATE organization_exports SET status = 'failed', error = ?, completed_at = NOW()
+WHERE id = ?;
+
+-- name: RequeueStaleOrganizationExports :execrows
+-- Exports left running by an instance that stopped mid-build are built again
+UPDATE organization_exports SET status = 'pending', started_at = NULL
+WHERE status = 'running' AND started_at < ?;
@@ -0,0 +1,50 @@
+-- Fleet overview: every site VM with its check-in, controller, OS patch and
+-- disk state
+
+-- name: ListFleetSites :many
+-- Site VMs, stalest check-in first. A VM's OS is outdated when the image it
+-- runs isn't the site's configured one. Deleted sites aren't part of the fleet.
+SELECT
+    s.id,
+    BIN_TO_UUID(s.public_id) AS public_id,
+    s.name,
+    s.status,
+    BIN_TO_UUID(p.public_id) AS project_public_id,
+    p.name AS project_name,
+    BIN_TO_UUID(o.public_id) AS organization_public_id,
+    o.name AS organization_name,
+    s.gcp_external_ip,
+    s.checkin_at,
+    s.controller_version,
+    s.os,
+    s.os_version,
+    s.os_reboot_required,
+    s.disk_used_bytes,
+    p.disk_size_gb,
+    CAST(CASE
+        WHEN s.os_version IS NULL THEN 'unknown'
+        WHEN s.os_reboot_required THEN 'reboot_required'
+        WHEN s.os IS NOT NULL AND s.os_version != s.os THEN 'outdated'
+        ELSE 'current'
+    END AS CHAR) AS os_patch_status,
+    (SELECT COUNT(*) FROM reconciliations r
+     WHERE r.site_id = s.id AND r.status IN ('pending', 'triggered', 'running')) AS pending_reconciliations
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+JOIN organizations o ON p.organization_id = o.id
+WHERE s.status != 'deleted'
+  AND (sqlc.narg(organization_id) IS NULL OR o.public_id = UUID_TO_BIN(sqlc.narg(organization_id)))
+  AND (sqlc.narg(status) IS NULL OR s.status = sqlc.narg(status))
+  AND (sqlc.narg(controller_version) IS NULL OR s.controller_version = sqlc.narg(controller_version))
+  AND (sqlc.narg(checkin_before) IS NULL OR s.checkin_at IS NULL OR s.checkin_at < sqlc.narg(checkin_before))
+  AND (sqlc.narg(min_disk_used_percent) IS NULL
+       OR (p.disk_size_gb > 0 AND s.disk_used_bytes * 100 >= sqlc.narg(min_disk_used_percent) * p.disk_size_gb * 1073741824))
+  AND (sqlc.narg(label_selector) IS NULL OR JSON_CONTAINS(s.labels, sqlc.narg(label_selector)))
+HAVING (sqlc.narg(os_patch_status) IS NULL OR os_patch_status = sqlc.narg(os_patch_status))
+   AND (NOT sqlc.arg(pending_only) OR pending_reconciliations > 0)
+ORDER BY s.checkin_at ASC, s.id ASC
+LIMIT ? OFFSET ?;
+
+-- name: UpdateSiteOsStatus :exec
+-- Records the OS image and reboot state a site's controller reported on check-in
+UPDATE sites SET os_version = ?, os_reboot_required = ? WHERE id = ?;
@@ -0,0 +1,27 @@
+-- name: CreateIdempotencyKey :exec
+INSERT INTO idempotency_keys (
+    account_id,
+    procedure_name,
+    idempotency_key,
+    request_hash,
+    expires_at
+) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetIdempotencyKey :one
+SELECT id, account_id, procedure_name, idempotency_key, request_hash, status, response_body, created_at, expires_at
+FROM idempotency_keys
+WHERE account_id = ? AND procedure_name = ? AND idempotency_key = ?;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status = 'completed',
+    response_body = ?
+WHERE id = ?;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE id = ?;
+
+-- name: DeleteExpiredIdempotencyKeys :execresult
+DELETE FROM idempotency_keys
+WHERE expires_at < NOW();
@@ -0,0 +1,26 @@
+-- name: CreateMemberInvitation :exec
+INSERT INTO member_invitations (
+  public_id, token_hash, email, resource_type, resource_id, `role`, expires_at, created_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?);
+
+
+-- name: RevokePendingMemberInvitations :exec
+-- Re-inviting an email replaces its earlier invitation to the same resource
+UPDATE member_invitations SET status = 'revoked'
+WHERE resource_type = ? AND resource_id = ? AND email = ? AND status = 'pending';
+
+
+-- name: GetMemberInvitationByTokenHash :one
+SELECT mi.id, BIN_TO_UUID(mi.public_id) AS public_id, mi.email, mi.resource_type, mi.resource_id, mi.role, mi.status, mi.expires_at,
+       CONCAT_WS('', BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), BIN_TO_UUID(s.public_id)) AS resource_public_id,
+       CONCAT_WS('', o.name, p.name, s.name) AS resource_name
+FROM member_invitations mi
+LEFT JOIN organizations o ON mi.resource_type = 'organization' AND o.id = mi.resource_id
+LEFT JOIN projects p ON mi.resource_type = 'project' AND p.id = mi.resource_id
+LEFT JOIN sites s ON mi.resource_type = 'site' AND s.id = mi.resource_id
+WHERE mi.token_hash = ?;
+
+
+-- name: AcceptMemberInvitation :execrows
+UPDATE member_invitations SET status = 'accepted', accepted_by = ?, accepted_at = NOW()
+WHERE id = ? AND status = 'pending' AND expires_at > NOW();
@@ -0,0 +1,32 @@
+-- name: UpsertSiteMaintenanceWindow :exec
+INSERT INTO site_maintenance_windows (site_id, days, start_hour, end_hour, timezone, updated_by)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    days = VALUES(days),
+    start_hour = VALUES(start_hour),
+    end_hour = VALUES(end_hour),
+    timezone = VALUES(timezone),
+    updated_by = VALUES(updated_by);
+
+-- name: GetSiteMaintenanceWindow :one
+SELECT id, site_id, days, start_hour, end_hour, timezone, updated_at
+FROM site_maintenance_windows
+WHERE site_id = ?;
+
+-- name: DeleteSiteMaintenanceWindow :execrows
+DELETE FROM site_maintenance_windows
+WHERE site_id = ?;
+
+-- name: ListSiteDeferredReconciliations :many
+-- Reconciliations waiting for the site's maintenance window
+SELECT request_type, created_at
+FROM deferred_reconciliations
+WHERE site_id = ?
+ORDER BY request_type;
+
+-- name: QueueSiteReconciliation :exec
+-- Queues a reconciliation behind the site's maintenance window, adding the
+-- events to one of the same type already waiting
+INSERT INTO deferred_reconciliations (site_id, request_type, event_ids)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE event_ids = JSON_MERGE_PRESERVE(event_ids, VALUES(event_ids));
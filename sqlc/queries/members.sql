@@ -1,5 +1,5 @@
 -- name: GetProjectMember :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, account_id, `role`, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, account_id, `role`, expires_at, created_at, updated_at, created_by, updated_by
 FROM project_members WHERE project_id = ? AND account_id = ?;
 
 
@@ -21,8 +21,33 @@ WHERE project_id = ? AND account_id = ?;
 DELETE FROM project_members WHERE project_id = ? AND account_id = ?;
 
 
+-- name: SetProjectMemberExpiry :exec
+UPDATE project_members SET
+  expires_at = sqlc.narg(expires_at),
+  updated_at = NOW()
+WHERE project_id = sqlc.arg(project_id) AND account_id = sqlc.arg(account_id);
+
+
+-- name: ListExpiredProjectMembers :many
+-- Memberships past their expiry, oldest first
+SELECT pm.id, pm.project_id, pm.account_id, pm.`role`, pm.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(acc.public_id) AS account_public_id
+FROM project_members pm
+JOIN projects p ON p.id = pm.project_id
+JOIN organizations o ON o.id = p.organization_id
+JOIN accounts acc ON acc.id = pm.account_id
+WHERE pm.expires_at <= sqlc.arg(now)
+ORDER BY pm.expires_at
+LIMIT ?;
+
+
+-- name: DeleteExpiredProjectMember :execrows
+-- Deletes a membership only if it's still expired, so one extended since it was listed stays
+DELETE FROM project_members WHERE id = sqlc.arg(id) AND expires_at <= sqlc.arg(now);
+
+
 -- name: ListProjectMembers :many
-SELECT pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.project_id, pm.account_id, pm.`role`, pm.status, pm.created_at, pm.updated_at,
+SELECT pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.project_id, pm.account_id, pm.`role`, pm.status, pm.expires_at, pm.created_at, pm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.`name`, a.github_username
 FROM project_members pm
 JOIN accounts a ON pm.account_id = a.id
@@ -32,7 +57,7 @@ LIMIT ? OFFSET ?;
 
 
 -- name: GetSiteMember :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, account_id, `role`, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, account_id, `role`, expires_at, created_at, updated_at, created_by, updated_by
 FROM site_members WHERE site_id = ? AND account_id = ?;
 
 
@@ -54,8 +79,35 @@ WHERE site_id = ? AND account_id = ?;
 DELETE FROM site_members WHERE site_id = ? AND account_id = ?;
 
 
+-- name: SetSiteMemberExpiry :exec
+UPDATE site_members SET
+  expires_at = sqlc.narg(expires_at),
+  updated_at = NOW()
+WHERE site_id = sqlc.arg(site_id) AND account_id = sqlc.arg(account_id);
+
+
+-- name: ListExpiredSiteMembers :many
+-- Memberships past their expiry, oldest first
+SELECT sm.id, sm.site_id, sm.account_id, sm.`role`, sm.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(p.public_id) AS project_public_id,
+       BIN_TO_UUID(s.public_id) AS site_public_id, BIN_TO_UUID(acc.public_id) AS account_public_id
+FROM site_members sm
+JOIN sites s ON s.id = sm.site_id
+JOIN projects p ON p.id = s.project_id
+JOIN organizations o ON o.id = p.organization_id
+JOIN accounts acc ON acc.id = sm.account_id
+WHERE sm.expires_at <= sqlc.arg(now)
+ORDER BY sm.expires_at
+LIMIT ?;
+
+
+-- name: DeleteExpiredSiteMember :execrows
+-- Deletes a membership only if it's still expired, so one extended since it was listed stays
+DELETE FROM site_members WHERE id = sqlc.arg(id) AND expires_at <= sqlc.arg(now);
+
+
 -- name: ListSiteMembers :many
-SELECT sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.site_id, sm.account_id, sm.`role`, sm.status, sm.created_at, sm.updated_at,
+SELECT sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.site_id, sm.account_id, sm.`role`, sm.status, sm.expires_at, sm.created_at, sm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.`name`, a.github_username
 FROM site_members sm
 JOIN accounts a ON sm.account_id = a.id
@@ -65,18 +117,13 @@ LIMIT ? OFFSET ?;
 
 
 -- name: ListUserMemberships :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = sqlc.arg(account_id) AND reachable
 )
 SELECT * FROM (
     SELECT
         om.id, BIN_TO_UUID(om.public_id) AS public_id, om.status, om.created_at, om.updated_at, om.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'organization' AS parent_type,
         o.name AS parent_name,
         BIN_TO_UUID(o.public_id) AS parent_public_id
@@ -90,7 +137,7 @@ SELECT * FROM (
 
     SELECT
         pm.id, BIN_TO_UUID(pm.public_id) AS public_id, pm.status, pm.created_at, pm.updated_at, pm.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'project' AS parent_type,
         p.name AS parent_name,
         BIN_TO_UUID(p.public_id) AS parent_public_id
@@ -106,7 +153,7 @@ SELECT * FROM (
 
     SELECT
         sm.id, BIN_TO_UUID(sm.public_id) AS public_id, sm.status, sm.created_at, sm.updated_at, sm.role,
-        a.email, a.name AS user_name, BIN_TO_UUID(a.public_id) AS account_public_id,
+        a.email, a.name AS user_name, a.avatar_object, BIN_TO_UUID(a.public_id) AS account_public_id,
         'site' AS parent_type,
         s.name AS parent_name,
         BIN_TO_UUID(s.public_id) AS parent_public_id
@@ -0,0 +1,45 @@
+-- name: CreateNotificationChannel :exec
+INSERT INTO notification_channels (
+  public_id, organization_id, name, kind, webhook_url, slack_bot_token, slack_channel, integration_key, categories, enabled, created_by
+) VALUES (
+  UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetNotificationChannel :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, kind, webhook_url, slack_bot_token, slack_channel, integration_key,
+       categories, enabled, last_delivery_at, last_error, created_at, updated_at
+FROM notification_channels
+WHERE organization_id = ? AND public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListNotificationChannels :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, kind, webhook_url, slack_bot_token, slack_channel, integration_key,
+       categories, enabled, last_delivery_at, last_error, created_at, updated_at
+FROM notification_channels
+WHERE organization_id = ?
+ORDER BY name, id
+LIMIT ? OFFSET ?;
+
+-- name: CountNotificationChannels :one
+SELECT COUNT(*) FROM notification_channels WHERE organization_id = ?;
+
+-- name: UpdateNotificationChannel :exec
+UPDATE notification_channels
+SET name = ?, webhook_url = ?, slack_bot_token = ?, slack_channel = ?, integration_key = ?, categories = ?, enabled = ?
+WHERE id = ?;
+
+-- name: DeleteNotificationChannel :execrows
+DELETE FROM notification_channels
+WHERE organization_id = ? AND public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListEscalationChannels :many
+-- Enabled PagerDuty and Opsgenie channels of an organization subscribed to downtime alerts
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, kind, integration_key
+FROM notification_channels
+WHERE organization_id = ?
+  AND enabled = TRUE
+  AND kind IN ('pagerduty', 'opsgenie')
+  AND JSON_CONTAINS(categories, '"downtime"')
+ORDER BY id;
+
+-- name: RecordNotificationChannelDelivery :exec
+UPDATE notification_channels SET last_delivery_at = NOW(), last_error = ? WHERE id = ?;
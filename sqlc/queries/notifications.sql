@@ -0,0 +1,64 @@
+-- name: CreateNotification :exec
+INSERT INTO notifications (
+  public_id, account_id, type, title, body, link
+) VALUES (
+  UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?
+);
+
+-- name: ListAccountNotifications :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, type, title, body, link, read_at, created_at
+FROM notifications
+WHERE account_id = ?
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListUnreadAccountNotifications :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, type, title, body, link, read_at, created_at
+FROM notifications
+WHERE account_id = ? AND read_at IS NULL
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListAccountNotificationsAfter :many
+-- Notifications created since the given ID, oldest first, for live streams
+SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, type, title, body, link, read_at, created_at
+FROM notifications
+WHERE account_id = sqlc.arg(account_id) AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT ?;
+
+-- name: GetLatestAccountNotificationID :one
+SELECT CAST(COALESCE(MAX(id), 0) AS SIGNED) AS id
+FROM notifications
+WHERE account_id = ?;
+
+-- name: CountUnreadAccountNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE account_id = ? AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+WHERE account_id = sqlc.arg(account_id)
+  AND public_id = UUID_TO_BIN(sqlc.arg(public_id))
+  AND read_at IS NULL;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications SET read_at = CURRENT_TIMESTAMP
+WHERE account_id = ? AND read_at IS NULL;
+
+-- name: ListSiteNotificationRecipients :many
+-- Accounts that can deploy a site, directly or through its project or organization
+SELECT sm.account_id FROM site_members sm
+WHERE sm.site_id = sqlc.arg(site_id)
+  AND sm.role IN ('owner', 'developer') AND sm.status IN ('active', 'provisioning')
+UNION
+SELECT pm.account_id FROM project_members pm
+JOIN sites s ON s.project_id = pm.project_id
+WHERE s.id = sqlc.arg(site_id)
+  AND pm.role IN ('owner', 'developer') AND pm.status IN ('active', 'provisioning')
+UNION
+SELECT om.account_id FROM organization_members om
+JOIN projects p ON p.organization_id = om.organization_id
+JOIN sites s ON s.project_id = p.id
+WHERE s.id = sqlc.arg(site_id)
+  AND om.role IN ('owner', 'developer') AND om.status IN ('active', 'provisioning');
@@ -10,7 +10,7 @@ INSERT INTO onboarding_sessions (
 -- name: GetOnboardingSession :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, account_id, org_name,
        CASE WHEN organization_public_id IS NULL THEN NULL ELSE BIN_TO_UUID(organization_public_id) END AS organization_public_id,
-       machine_type, machine_price_id, disk_size_gb,
+       machine_type, machine_price_id, disk_size_gb, promo_code, discount_description,
        stripe_checkout_session_id, stripe_checkout_url, stripe_subscription_id, organization_id,
        project_name, gcp_country, gcp_region, site_name, github_repo_url, port, firewall_ip,
        current_step, completed, expires_at, created_at, updated_at
@@ -41,6 +41,14 @@ UPDATE onboarding_sessions SET
 WHERE id = ?;
 
 
+-- name: SetOnboardingSessionDiscount :exec
+UPDATE onboarding_sessions SET
+  promo_code = ?,
+  discount_description = ?,
+  updated_at = NOW()
+WHERE id = ?;
+
+
 -- name: DeleteExpiredOnboardingSessions :exec
 DELETE FROM onboarding_sessions WHERE expires_at < NOW() AND completed = FALSE;
 
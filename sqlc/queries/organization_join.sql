@@ -0,0 +1,61 @@
+-- name: ListOrganizationJoinDomains :many
+SELECT domain, `role`, approval_required
+FROM organization_join_domains
+WHERE organization_id = ?
+ORDER BY domain;
+
+
+-- name: DeleteOrganizationJoinDomains :exec
+DELETE FROM organization_join_domains WHERE organization_id = ?;
+
+
+-- name: CreateOrganizationJoinDomain :exec
+INSERT INTO organization_join_domains (
+  organization_id, domain, `role`, approval_required, created_by
+) VALUES (?, ?, ?, ?, ?);
+
+
+-- name: ListJoinableOrganizations :many
+-- Organizations claiming an email domain that the account isn't a member of,
+-- with the account's join request to each, if it made one
+SELECT d.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, o.name AS organization_name,
+       d.role, d.approval_required, r.status AS request_status
+FROM organization_join_domains d
+JOIN organizations o ON o.id = d.organization_id
+LEFT JOIN organization_join_requests r ON r.organization_id = d.organization_id AND r.account_id = sqlc.arg(account_id)
+WHERE d.domain = sqlc.arg(domain)
+  AND o.status NOT IN ('suspended', 'deleted')
+  AND NOT EXISTS (
+    SELECT 1 FROM organization_members om
+    WHERE om.organization_id = d.organization_id AND om.account_id = sqlc.arg(account_id)
+  )
+ORDER BY o.name;
+
+
+-- name: CreateOrganizationJoinRequest :exec
+INSERT INTO organization_join_requests (
+  public_id, organization_id, account_id, `role`
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?);
+
+
+-- name: ListOrganizationJoinRequests :many
+-- Join requests waiting for an administrator, oldest first
+SELECT BIN_TO_UUID(r.public_id) AS public_id, BIN_TO_UUID(a.public_id) AS account_public_id,
+       a.email, a.name, r.role, r.status, r.created_at
+FROM organization_join_requests r
+JOIN accounts a ON a.id = r.account_id
+WHERE r.organization_id = ? AND r.status = 'pending'
+ORDER BY r.created_at, r.id;
+
+
+-- name: GetOrganizationJoinRequest :one
+SELECT r.id, BIN_TO_UUID(r.public_id) AS public_id, r.organization_id, r.account_id,
+       BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.name, r.role, r.status, r.created_at
+FROM organization_join_requests r
+JOIN accounts a ON a.id = r.account_id
+WHERE r.public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND r.organization_id = sqlc.arg(organization_id);
+
+
+-- name: DecideOrganizationJoinRequest :execrows
+UPDATE organization_join_requests SET status = ?, decided_by = ?, decided_at = NOW()
+WHERE id = ? AND status = 'pending';
@@ -1,25 +1,27 @@
 -- name: GetOrganization :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by, version
 FROM organizations WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
 -- name: GetOrganizationByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations WHERE id = ?;
 
 
 -- name: GetOrganizationByGCPProjectID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations WHERE gcp_project_id = ?;
 
 
 -- name: CreateOrganization :exec
 INSERT INTO organizations (
-  public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+  public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, labels, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 
--- name: UpdateOrganization :exec
+-- name: UpdateOrganization :execrows
+-- Applies the change only if the organization is still at the version it was read at;
+-- no rows are affected when someone else changed it first
 UPDATE organizations SET
   `name` = ?,
   gcp_org_id = ?,
@@ -27,9 +29,46 @@ UPDATE organizations SET
   gcp_parent = ?,
   gcp_folder_id = ?,
   `status` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND version = ?;
+
+
+-- name: GetOrganizationAllowedCidrs :one
+SELECT COALESCE(allowed_cidrs, '[]') AS allowed_cidrs
+FROM organizations WHERE id = ?;
+
+
+-- name: UpdateOrganizationAllowedCidrs :exec
+UPDATE organizations SET
+  allowed_cidrs = ?,
+  updated_at = NOW(),
+  updated_by = ?
+WHERE id = ?;
+
+
+-- name: GetOrganizationDataResidency :one
+SELECT data_residency
+FROM organizations WHERE id = ?;
+
+
+-- name: UpdateOrganizationDataResidency :exec
+UPDATE organizations SET
+  data_residency = ?,
+  updated_at = NOW(),
+  updated_by = ?
+WHERE id = ?;
+
+
+-- name: ListOrganizationProjectRegions :many
+-- The regions an organization's live projects run in, with their country grouping.
+SELECT DISTINCT p.gcp_region, r.country
+FROM projects p
+LEFT JOIN regions r ON r.code = p.gcp_region
+WHERE p.organization_id = ? AND p.status != 'deleted' AND p.gcp_region IS NOT NULL
+ORDER BY p.gcp_region;
 
 
 -- name: DeleteOrganization :exec
@@ -37,25 +76,21 @@ DELETE FROM organizations WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
 -- name: ListAllOrganizations :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`, gcp_org_id, gcp_billing_account, gcp_parent, gcp_folder_id, `status`, labels, gcp_project_id, gcp_project_number, created_at, updated_at, created_by, updated_by
 FROM organizations
 ORDER BY created_at DESC;
 
 
 -- name: ListOrganizations :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE account_id = ? AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = ? AND reachable
 )
-SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name, o.gcp_org_id, o.gcp_billing_account, o.gcp_parent, o.location, o.region, o.gcp_folder_id, o.status, o.gcp_project_id, o.gcp_project_number, o.created_at, o.updated_at, o.created_by, o.updated_by
+SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name, o.gcp_org_id, o.gcp_billing_account, o.gcp_parent, o.location, o.region, o.gcp_folder_id, o.status, o.labels, o.gcp_project_id, o.gcp_project_number, o.created_at, o.updated_at, o.created_by, o.updated_by
 FROM organizations o
 INNER JOIN user_orgs uo ON o.id = uo.organization_id
-ORDER BY o.created_at DESC
-LIMIT ? OFFSET ?;
+WHERE (sqlc.narg(cursor_created_at) IS NULL OR o.created_at < sqlc.narg(cursor_created_at) OR (o.created_at = sqlc.narg(cursor_created_at) AND o.id < sqlc.narg(cursor_id)))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ?;
 
 -- =============================================================================
 -- ACCOUNTS
@@ -63,7 +98,7 @@ LIMIT ? OFFSET ?;
 
 
 -- name: GetOrganizationMember :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, account_id, `role`, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, account_id, `role`, expires_at, created_at, updated_at, created_by, updated_by
 FROM organization_members WHERE organization_id = ? AND account_id = ?;
 
 
@@ -85,8 +120,32 @@ WHERE organization_id = ? AND account_id = ?;
 DELETE FROM organization_members WHERE organization_id = ? AND account_id = ?;
 
 
+-- name: SetOrganizationMemberExpiry :exec
+UPDATE organization_members SET
+  expires_at = sqlc.narg(expires_at),
+  updated_at = NOW()
+WHERE organization_id = sqlc.arg(organization_id) AND account_id = sqlc.arg(account_id);
+
+
+-- name: ListExpiredOrganizationMembers :many
+-- Memberships past their expiry, oldest first
+SELECT om.id, om.organization_id, om.account_id, om.`role`, om.expires_at,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, BIN_TO_UUID(a.public_id) AS account_public_id
+FROM organization_members om
+JOIN organizations o ON o.id = om.organization_id
+JOIN accounts a ON a.id = om.account_id
+WHERE om.expires_at <= sqlc.arg(now)
+ORDER BY om.expires_at
+LIMIT ?;
+
+
+-- name: DeleteExpiredOrganizationMember :execrows
+-- Deletes a membership only if it's still expired, so one extended since it was listed stays
+DELETE FROM organization_members WHERE id = sqlc.arg(id) AND expires_at <= sqlc.arg(now);
+
+
 -- name: ListOrganizationMembers :many
-SELECT cm.id, BIN_TO_UUID(cm.public_id) AS public_id, cm.organization_id, cm.account_id, cm.`role`, cm.status, cm.created_at, cm.updated_at,
+SELECT cm.id, BIN_TO_UUID(cm.public_id) AS public_id, cm.organization_id, cm.account_id, cm.`role`, cm.status, cm.expires_at, cm.created_at, cm.updated_at,
        BIN_TO_UUID(a.public_id) AS account_public_id, a.email, a.`name`, a.github_username, a.verified, a.auth_method
 FROM organization_members cm
 JOIN accounts a ON cm.account_id = a.id
@@ -114,8 +173,8 @@ SELECT
     p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id,
     p.promote_strategy,
     p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path,
-    p.gcp_project_id, p.gcp_project_number, p.create_branch_sites, p.status,
-    p.created_at, p.updated_at, p.created_by, p.updated_by,
+    p.gcp_project_id, p.gcp_project_number, p.create_branch_sites, p.status, p.labels,
+    p.created_at, p.updated_at, p.created_by, p.updated_by, p.version,
     c.gcp_billing_account
 FROM projects p
 JOIN organizations c ON p.organization_id = c.id
@@ -135,7 +194,7 @@ LIMIT 1;
 
 
 -- name: ListOrganizationProjects :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id, promote_strategy, monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path, gcp_project_id, gcp_project_number, organization_project, create_branch_sites, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id, promote_strategy, monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path, gcp_project_id, gcp_project_number, organization_project, create_branch_sites, status, labels, created_at, updated_at, created_by, updated_by
 FROM projects
 WHERE organization_id = ?
 ORDER BY created_at DESC
@@ -174,7 +233,7 @@ ORDER BY created_at DESC;
 
 -- name: ListOrganizationRelationships :many
 SELECT r.id, BIN_TO_UUID(r.public_id) AS public_id, r.source_organization_id, r.target_organization_id,
-       r.relationship_type, r.`status`, r.created_at, r.resolved_at, r.resolved_by
+       r.relationship_type, r.max_role, r.`status`, r.created_at, r.resolved_at, r.resolved_by
 FROM relationships r
 WHERE r.source_organization_id = ? OR r.target_organization_id = ?
 ORDER BY r.created_at DESC;
@@ -202,8 +261,8 @@ ORDER BY r.created_at DESC;
 
 -- name: CreateOrganizationSecret :execresult
 INSERT INTO organization_secrets (
-    public_id, organization_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?);
+    public_id, organization_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 
 -- name: GetOrganizationSecretByID :one
@@ -214,7 +273,7 @@ FROM organization_secrets WHERE id = ? AND status != 'deleted';
 
 -- name: GetOrganizationSecretByPublicID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM organization_secrets WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 
@@ -227,7 +286,7 @@ WHERE organization_id = ? AND name = ? AND status != 'deleted';
 
 -- name: ListOrganizationSecrets :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, reference
 FROM organization_secrets
 WHERE organization_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -241,7 +300,7 @@ WHERE organization_id = ? AND status != 'deleted';
 
 -- name: UpdateOrganizationSecret :exec
 UPDATE organization_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?;
 
 
@@ -261,18 +320,9 @@ WHERE account_id = ? AND organization_id = ? AND status = 'active';
 
 
 -- name: HasUserRelationshipAccessToOrganization :one
-WITH RECURSIVE user_access AS (
-    SELECT organization_id
-    FROM organization_members
-    WHERE account_id = ? AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_access ua ON r.source_organization_id = ua.organization_id
-    WHERE r.status = 'approved'
-)
 SELECT EXISTS (
-    SELECT 1 FROM user_access WHERE organization_id = ?
+    SELECT 1 FROM effective_organization_access
+    WHERE account_id = ? AND organization_id = ? AND reachable
 );
 
 
@@ -305,21 +355,15 @@ SELECT EXISTS (
 );
 
 -- name: ListUserOrganizations :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name,
-       COALESCE(om.role, 'read') AS role
-FROM organizations o
-JOIN user_orgs uo ON o.id = uo.organization_id
+SELECT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.name,
+       COALESCE(om.role, 'read') AS role, o.created_at
+FROM effective_organization_access ea
+JOIN organizations o ON o.id = ea.organization_id
 LEFT JOIN organization_members om ON o.id = om.organization_id AND om.account_id = sqlc.arg(account_id)
-ORDER BY o.created_at DESC
-LIMIT ? OFFSET ?;
+WHERE ea.account_id = sqlc.arg(account_id) AND ea.reachable
+AND (sqlc.narg(cursor_created_at) IS NULL OR o.created_at < sqlc.narg(cursor_created_at) OR (o.created_at = sqlc.narg(cursor_created_at) AND o.id < sqlc.narg(cursor_id)))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ?;
 
 -- =============================================================================
 -- ONBOARDING
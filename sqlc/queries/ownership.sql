@@ -0,0 +1,84 @@
+-- name: CreateOrganizationOwnershipTransfer :exec
+INSERT INTO organization_ownership_transfers (public_id, organization_id, from_account_id, to_account_id, expires_at, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?);
+
+-- name: GetOrganizationOwnershipTransfer :one
+SELECT t.id, BIN_TO_UUID(t.public_id) AS public_id, t.organization_id, t.from_account_id, t.to_account_id,
+       t.status, t.expires_at, t.accepted_at, t.created_at,
+       BIN_TO_UUID(f.public_id) AS from_account_public_id, f.email AS from_email,
+       BIN_TO_UUID(a.public_id) AS to_account_public_id, a.email AS to_email
+FROM organization_ownership_transfers t
+JOIN accounts f ON f.id = t.from_account_id
+JOIN accounts a ON a.id = t.to_account_id
+WHERE t.public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: GetPendingOrganizationOwnershipTransfer :one
+-- The organization's open transfer; expired transfers are left pending and skipped here
+SELECT t.id, BIN_TO_UUID(t.public_id) AS public_id, t.organization_id, t.from_account_id, t.to_account_id,
+       t.status, t.expires_at, t.accepted_at, t.created_at,
+       BIN_TO_UUID(f.public_id) AS from_account_public_id, f.email AS from_email,
+       BIN_TO_UUID(a.public_id) AS to_account_public_id, a.email AS to_email
+FROM organization_ownership_transfers t
+JOIN accounts f ON f.id = t.from_account_id
+JOIN accounts a ON a.id = t.to_account_id
+WHERE t.organization_id = ? AND t.status = 'pending' AND t.expires_at > NOW()
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT 1;
+
+-- name: AcceptOrganizationOwnershipTransfer :execrows
+UPDATE organization_ownership_transfers
+SET status = 'accepted', accepted_at = NOW()
+WHERE id = ? AND status = 'pending' AND expires_at > NOW();
+
+-- name: CancelOrganizationOwnershipTransfer :execrows
+UPDATE organization_ownership_transfers
+SET status = 'cancelled'
+WHERE id = ? AND status = 'pending';
+
+-- name: CancelPendingOrganizationOwnershipTransfers :exec
+-- Replaces open transfers when a new one starts or the organization is handed over
+UPDATE organization_ownership_transfers
+SET status = 'cancelled'
+WHERE organization_id = ? AND status = 'pending';
+
+-- name: ListAccountOwnedOrganizations :many
+-- Organizations the account is an active owner of
+SELECT o.id, BIN_TO_UUID(o.public_id) AS public_id, o.`name`
+FROM organization_members om
+JOIN organizations o ON o.id = om.organization_id
+WHERE om.account_id = ? AND om.`role` = 'owner' AND om.status = 'active'
+ORDER BY o.id;
+
+-- name: GetOrganizationSuccessor :one
+-- The member to hand an organization to when an owner leaves: another person
+-- (not a platform service account), preferring owners, then the longest-standing developer
+SELECT a.id, BIN_TO_UUID(a.public_id) AS public_id, a.email, om.`role`
+FROM organization_members om
+JOIN accounts a ON a.id = om.account_id
+WHERE om.organization_id = sqlc.arg(organization_id)
+  AND om.account_id != sqlc.arg(account_id)
+  AND om.`role` IN ('owner', 'developer')
+  AND om.status = 'active'
+  AND a.auth_method != 'gcloud'
+ORDER BY om.`role` = 'owner' DESC, om.created_at, om.id
+LIMIT 1;
+
+-- name: ReassignServiceAccountAPIKeys :execrows
+-- Moves the keys of an organization's platform service accounts created by one account to another
+UPDATE api_keys k
+JOIN accounts a ON a.id = k.account_id
+JOIN organization_members om ON om.account_id = a.id
+SET k.created_by = sqlc.arg(to_account_id)
+WHERE om.organization_id = sqlc.arg(organization_id)
+  AND a.auth_method = 'gcloud'
+  AND k.created_by = sqlc.arg(from_account_id);
+
+-- name: ListServiceAccountAPIKeyPublicIDs :many
+-- The keys ReassignServiceAccountAPIKeys moves
+SELECT BIN_TO_UUID(k.public_id) AS public_id
+FROM api_keys k
+JOIN accounts a ON a.id = k.account_id
+JOIN organization_members om ON om.account_id = a.id
+WHERE om.organization_id = sqlc.arg(organization_id)
+  AND a.auth_method = 'gcloud'
+  AND k.created_by = sqlc.arg(created_by);
@@ -0,0 +1,205 @@
+-- =============================================================================
+-- ADMIN CONSOLE
+-- =============================================================================
+
+-- name: ListPlatformOrganizations :many
+SELECT
+    o.id,
+    BIN_TO_UUID(o.public_id) AS public_id,
+    o.name,
+    o.status,
+    o.billing_state,
+    o.billing_state_changed_at,
+    o.payment_failed_at,
+    o.suspended_at,
+    o.suspended_reason,
+    o.created_at,
+    CONCAT('', COALESCE((
+        SELECT ss.status FROM stripe_subscriptions ss
+        WHERE ss.organization_id = o.id
+        ORDER BY ss.id DESC LIMIT 1
+    ), '')) AS subscription_status,
+    (SELECT COUNT(*) FROM projects p WHERE p.organization_id = o.id AND p.status != 'deleted') AS project_count,
+    (SELECT COUNT(*) FROM sites s JOIN projects p ON s.project_id = p.id WHERE p.organization_id = o.id AND s.status != 'deleted') AS site_count
+FROM organizations o
+WHERE (sqlc.narg(billing_state) IS NULL OR o.billing_state = sqlc.narg(billing_state))
+  AND (sqlc.narg(status) IS NULL OR o.status = sqlc.narg(status))
+ORDER BY o.created_at DESC, o.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: GetPlatformOrganization :one
+SELECT
+    o.id,
+    BIN_TO_UUID(o.public_id) AS public_id,
+    o.name,
+    o.status,
+    o.billing_state,
+    o.billing_state_changed_at,
+    o.payment_failed_at,
+    o.suspended_at,
+    o.suspended_reason,
+    o.created_at,
+    CONCAT('', COALESCE((
+        SELECT ss.status FROM stripe_subscriptions ss
+        WHERE ss.organization_id = o.id
+        ORDER BY ss.id DESC LIMIT 1
+    ), '')) AS subscription_status,
+    (SELECT COUNT(*) FROM projects p WHERE p.organization_id = o.id AND p.status != 'deleted') AS project_count,
+    (SELECT COUNT(*) FROM sites s JOIN projects p ON s.project_id = p.id WHERE p.organization_id = o.id AND s.status != 'deleted') AS site_count
+FROM organizations o
+WHERE o.id = ?;
+
+-- name: SuspendOrganization :exec
+UPDATE organizations
+SET `status` = 'suspended', suspended_at = NOW(), suspended_reason = ?, suspended_by = ?
+WHERE id = ?;
+
+-- name: UnsuspendOrganization :execrows
+UPDATE organizations
+SET `status` = 'active', suspended_at = NULL, suspended_reason = NULL, suspended_by = NULL
+WHERE id = ? AND `status` = 'suspended';
+
+-- name: CountEventsByStatus :many
+SELECT `status`, COUNT(*) AS event_count
+FROM event_queue
+GROUP BY `status`;
+
+-- name: GetOldestPendingEvent :one
+SELECT event_id, event_type, created_at
+FROM event_queue
+WHERE `status` = 'pending'
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CountStuckEvents :one
+SELECT COUNT(*) FROM event_queue
+WHERE `status` = 'processing' AND processing_at < ?;
+
+-- name: ListDeadLetterEvents :many
+SELECT event_id, event_type, retry_count, last_error, created_at, last_retry_at
+FROM event_queue
+WHERE `status` = 'dead_letter'
+ORDER BY created_at DESC
+LIMIT ?;
+
+-- name: LookupResourceByPublicID :many
+-- Matches a UUID against every table with a public ID, plus the string IDs of
+-- deployments, reconciliation runs and events.
+SELECT * FROM (
+    SELECT 'organization' AS resource_type, BIN_TO_UUID(o.public_id) AS resource_id, o.name, 'public_id' AS matched_field,
+        BIN_TO_UUID(o.public_id) AS organization_id, '' AS project_id, '' AS site_id, CONCAT('', COALESCE(o.status, '')) AS status
+    FROM organizations o WHERE o.public_id = UUID_TO_BIN(sqlc.arg(id))
+
+    UNION ALL
+
+    SELECT 'project', BIN_TO_UUID(p.public_id), p.name, 'public_id',
+        BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), '', CONCAT('', COALESCE(p.status, ''))
+    FROM projects p JOIN organizations o ON p.organization_id = o.id
+    WHERE p.public_id = UUID_TO_BIN(sqlc.arg(id))
+
+    UNION ALL
+
+    SELECT 'site', BIN_TO_UUID(s.public_id), s.name, 'public_id',
+        BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), BIN_TO_UUID(s.public_id), CONCAT('', COALESCE(s.status, ''))
+    FROM sites s JOIN projects p ON s.project_id = p.id JOIN organizations o ON p.organization_id = o.id
+    WHERE s.public_id = UUID_TO_BIN(sqlc.arg(id))
+
+    UNION ALL
+
+    SELECT 'account', BIN_TO_UUID(a.public_id), a.email, 'public_id', '', '', '', ''
+    FROM accounts a WHERE a.public_id = UUID_TO_BIN(sqlc.arg(id))
+
+    UNION ALL
+
+    SELECT 'api_key', BIN_TO_UUID(k.public_id), k.name, 'public_id', '', '', '', IF(k.active, 'active', 'revoked')
+    FROM api_keys k WHERE k.public_id = UUID_TO_BIN(sqlc.arg(id))
+
+    UNION ALL
+
+    SELECT 'deployment', d.id, COALESCE(d.git_ref, ''), 'deployment_id',
+        BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), d.site_id, CONCAT('', COALESCE(d.status, ''))
+    FROM deployments d
+    JOIN sites s ON s.public_id = UUID_TO_BIN(d.site_id)
+    JOIN projects p ON s.project_id = p.id JOIN organizations o ON p.organization_id = o.id
+    WHERE d.id = sqlc.arg(id)
+
+    UNION ALL
+
+    SELECT 'reconciliation_run', r.run_id, CONCAT('', r.run_type), 'run_id',
+        COALESCE(BIN_TO_UUID(o.public_id), ''), COALESCE(BIN_TO_UUID(p.public_id), ''), COALESCE(BIN_TO_UUID(s.public_id), ''), CONCAT('', COALESCE(r.status, ''))
+    FROM reconciliations r
+    LEFT JOIN organizations o ON r.organization_id = o.id
+    LEFT JOIN projects p ON r.project_id = p.id
+    LEFT JOIN sites s ON r.site_id = s.id
+    WHERE r.run_id = sqlc.arg(id)
+
+    UNION ALL
+
+    SELECT 'event', e.event_id, e.event_type, 'event_id',
+        COALESCE(BIN_TO_UUID(o.public_id), ''), COALESCE(BIN_TO_UUID(p.public_id), ''), COALESCE(BIN_TO_UUID(s.public_id), ''), CONCAT('', COALESCE(e.status, ''))
+    FROM event_queue e
+    LEFT JOIN organizations o ON e.organization_id = o.id
+    LEFT JOIN projects p ON e.project_id = p.id
+    LEFT JOIN sites s ON e.site_id = s.id
+    WHERE e.event_id = sqlc.arg(id)
+) AS matches;
+
+-- name: LookupResourceByExternalID :many
+-- Matches the IDs operators get from elsewhere: GCP project and folder IDs and
+-- numbers, account emails and GitHub workflow run IDs.
+SELECT * FROM (
+    SELECT 'organization' AS resource_type, BIN_TO_UUID(o.public_id) AS resource_id, o.name,
+        CASE WHEN o.gcp_project_id = sqlc.arg(id) THEN 'gcp_project_id'
+             WHEN o.gcp_project_number = sqlc.arg(id) THEN 'gcp_project_number'
+             ELSE 'gcp_folder_id' END AS matched_field,
+        BIN_TO_UUID(o.public_id) AS organization_id, '' AS project_id, '' AS site_id, CONCAT('', COALESCE(o.status, '')) AS status
+    FROM organizations o
+    WHERE o.gcp_project_id = sqlc.arg(id) OR o.gcp_project_number = sqlc.arg(id) OR o.gcp_folder_id = sqlc.arg(id)
+
+    UNION ALL
+
+    SELECT 'project', BIN_TO_UUID(p.public_id), p.name,
+        IF(p.gcp_project_id = sqlc.arg(id), 'gcp_project_id', 'gcp_project_number'),
+        BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), '', CONCAT('', COALESCE(p.status, ''))
+    FROM projects p JOIN organizations o ON p.organization_id = o.id
+    WHERE p.gcp_project_id = sqlc.arg(id) OR p.gcp_project_number = sqlc.arg(id)
+
+    UNION ALL
+
+    SELECT 'account', BIN_TO_UUID(a.public_id), a.email, 'email', '', '', '', ''
+    FROM accounts a WHERE a.email = sqlc.arg(email)
+
+    UNION ALL
+
+    SELECT 'deployment', d.id, COALESCE(d.git_ref, ''), 'github_run_id',
+        BIN_TO_UUID(o.public_id), BIN_TO_UUID(p.public_id), d.site_id, CONCAT('', COALESCE(d.status, ''))
+    FROM deployments d
+    JOIN sites s ON s.public_id = UUID_TO_BIN(d.site_id)
+    JOIN projects p ON s.project_id = p.id JOIN organizations o ON p.organization_id = o.id
+    WHERE d.github_run_id = sqlc.arg(id)
+) AS matches;
+
+-- name: ListOrganizationsByName :many
+-- Resolves an organization name typed by an operator; names aren't unique.
+SELECT id, BIN_TO_UUID(public_id) AS public_id, `name`
+FROM organizations
+WHERE `name` = sqlc.arg(name) AND `status` != 'deleted'
+ORDER BY id;
+
+-- name: ListProjectsByName :many
+-- Resolves a project name typed by an operator, optionally within one organization.
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `name`
+FROM projects
+WHERE `name` = sqlc.arg(name) AND `status` != 'deleted'
+  AND (sqlc.narg(organization_id) IS NULL OR organization_id = sqlc.narg(organization_id))
+ORDER BY id;
+
+-- name: ListSitesByName :many
+-- Resolves a site name typed by an operator, optionally within one organization
+-- or project. Names are only unique within a project.
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, p.organization_id, s.name
+FROM sites s JOIN projects p ON s.project_id = p.id
+WHERE s.name = sqlc.arg(name) AND s.status != 'deleted'
+  AND (sqlc.narg(organization_id) IS NULL OR p.organization_id = sqlc.narg(organization_id))
+  AND (sqlc.narg(project_id) IS NULL OR s.project_id = sqlc.narg(project_id))
+ORDER BY s.id;
@@ -0,0 +1,32 @@
+-- name: GetActivePlatformAuditor :one
+-- When an account's unexpired auditor grant ends
+SELECT expires_at FROM platform_auditors
+WHERE account_id = ? AND expires_at > CURRENT_TIMESTAMP;
+
+
+-- name: ListPlatformAuditors :many
+-- Unexpired auditor grants, soonest to expire first
+SELECT BIN_TO_UUID(acc.public_id) AS account_public_id, acc.email, pa.reason, pa.expires_at, pa.created_at,
+       BIN_TO_UUID(creator.public_id) AS created_by_public_id
+FROM platform_auditors pa
+JOIN accounts acc ON acc.id = pa.account_id
+LEFT JOIN accounts creator ON creator.id = pa.created_by
+WHERE pa.expires_at > CURRENT_TIMESTAMP
+ORDER BY pa.expires_at;
+
+
+-- name: GrantPlatformAuditor :exec
+-- Grants an account auditor access, replacing any grant it had
+INSERT INTO platform_auditors (account_id, reason, expires_at, created_by)
+VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  reason = VALUES(reason),
+  expires_at = VALUES(expires_at),
+  created_at = CURRENT_TIMESTAMP,
+  created_by = VALUES(created_by);
+
+
+-- name: RevokePlatformAuditor :execrows
+-- Ends an account's unexpired auditor grant
+DELETE FROM platform_auditors
+WHERE account_id = ? AND expires_at > CURRENT_TIMESTAMP;
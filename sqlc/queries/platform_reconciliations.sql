@@ -0,0 +1,106 @@
+-- name: ListPlatformReconciliationRuns :many
+-- Runs newest first, with the public IDs of their scope; a site or project run
+-- is matched to its organization through the site and project
+SELECT
+    r.id,
+    r.run_id,
+    r.organization_id,
+    r.project_id,
+    r.site_id,
+    r.run_type,
+    r.reconciliation_type,
+    r.modules,
+    r.target_site_ids,
+    r.event_ids,
+    r.status,
+    r.error_message,
+    r.attempt,
+    r.retry_state,
+    r.next_retry_at,
+    r.created_at,
+    r.triggered_at,
+    r.started_at,
+    r.completed_at,
+    CONCAT('', COALESCE(BIN_TO_UUID(o.public_id), '')) AS organization_public_id,
+    CONCAT('', COALESCE(BIN_TO_UUID(p.public_id), '')) AS project_public_id,
+    CONCAT('', COALESCE(BIN_TO_UUID(s.public_id), '')) AS site_public_id,
+    CONCAT('', COALESCE(o.gcp_project_id, '')) AS organization_gcp_project_id
+FROM reconciliations r
+LEFT JOIN sites s ON s.id = r.site_id
+LEFT JOIN projects p ON p.id = COALESCE(r.project_id, s.project_id)
+LEFT JOIN organizations o ON o.id = COALESCE(r.organization_id, p.organization_id)
+WHERE (sqlc.narg(run_type) IS NULL OR r.run_type = sqlc.narg(run_type))
+  AND (sqlc.narg(status) IS NULL OR r.status = sqlc.narg(status))
+  AND (sqlc.narg(organization_id) IS NULL OR o.id = sqlc.narg(organization_id))
+  AND (sqlc.narg(site_id) IS NULL OR r.site_id = sqlc.narg(site_id))
+ORDER BY r.created_at DESC, r.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: GetPlatformReconciliationRun :one
+SELECT
+    r.id,
+    r.run_id,
+    r.organization_id,
+    r.project_id,
+    r.site_id,
+    r.run_type,
+    r.reconciliation_type,
+    r.modules,
+    r.target_site_ids,
+    r.event_ids,
+    r.status,
+    r.error_message,
+    r.attempt,
+    r.retry_state,
+    r.next_retry_at,
+    r.created_at,
+    r.triggered_at,
+    r.started_at,
+    r.completed_at,
+    CONCAT('', COALESCE(BIN_TO_UUID(o.public_id), '')) AS organization_public_id,
+    CONCAT('', COALESCE(BIN_TO_UUID(p.public_id), '')) AS project_public_id,
+    CONCAT('', COALESCE(BIN_TO_UUID(s.public_id), '')) AS site_public_id,
+    CONCAT('', COALESCE(o.gcp_project_id, '')) AS organization_gcp_project_id
+FROM reconciliations r
+LEFT JOIN sites s ON s.id = r.site_id
+LEFT JOIN projects p ON p.id = COALESCE(r.project_id, s.project_id)
+LEFT JOIN organizations o ON o.id = COALESCE(r.organization_id, p.organization_id)
+WHERE r.run_id = ?;
+
+-- name: CancelReconciliationRun :execrows
+-- Cancels a run that hasn't finished; 0 rows means it already finished
+UPDATE reconciliations
+SET status = 'cancelled',
+    completed_at = CURRENT_TIMESTAMP,
+    error_message = sqlc.arg(error_message)
+WHERE run_id = sqlc.arg(run_id) AND status IN ('pending', 'triggered', 'running');
+
+-- name: CancelReconciliationRetry :execrows
+-- Stops the event router retrying a failed site reconciliation; 0 rows means
+-- no retry was pending
+UPDATE reconciliations
+SET retry_state = 'exhausted', next_retry_at = NULL
+WHERE run_id = ?
+  AND run_type = 'reconciliation'
+  AND status = 'failed'
+  AND (retry_state IS NULL OR retry_state = 'scheduled');
+
+-- name: MarkReconciliationRunTriggered :execrows
+-- Records that a runner job was started for a pending terraform run; 0 rows
+-- means the run already moved on, e.g. the runner reported it started
+UPDATE reconciliations
+SET status = 'triggered',
+    triggered_at = CURRENT_TIMESTAMP
+WHERE run_id = ?
+  AND run_type = 'terraform'
+  AND status = 'pending';
+
+-- name: ScheduleReconciliationRetry :execrows
+-- Has the event router retry a failed site reconciliation on its next pass,
+-- whatever it already did with it
+UPDATE reconciliations
+SET retry_state = 'scheduled', next_retry_at = CURRENT_TIMESTAMP
+WHERE run_id = ?
+  AND run_type = 'reconciliation'
+  AND status = 'failed'
+  AND reconciliation_type IS NOT NULL;
@@ -0,0 +1,77 @@
+-- name: CreateOrganizationPolicy :exec
+INSERT INTO organization_policies (public_id, organization_id, `rule`, enforcement, config, created_by, updated_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?);
+
+
+-- name: GetOrganizationPolicy :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `rule`, enforcement, config, created_at, updated_at
+FROM organization_policies
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+
+-- name: ListOrganizationPolicies :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `rule`, enforcement, config, created_at, updated_at
+FROM organization_policies
+WHERE organization_id = ?
+ORDER BY created_at, id
+LIMIT ? OFFSET ?;
+
+
+-- name: ListAllOrganizationPolicies :many
+-- Every policy of an organization, for checking a change against them
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `rule`, enforcement, config, created_at, updated_at
+FROM organization_policies
+WHERE organization_id = ?
+ORDER BY id;
+
+
+-- name: ListAccountOrganizationPolicies :many
+-- Every policy of the organizations an account is a member of, directly or
+-- through one of their projects or sites, for checking changes to the account
+SELECT op.id, BIN_TO_UUID(op.public_id) AS public_id, op.organization_id,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, op.`rule`, op.enforcement, op.config
+FROM organization_policies op
+JOIN organizations o ON op.organization_id = o.id
+WHERE op.organization_id IN (
+    SELECT om.organization_id FROM organization_members om
+    WHERE om.account_id = sqlc.arg(account_id) AND om.status = 'active'
+    UNION
+    SELECT p.organization_id FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    WHERE pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    UNION
+    SELECT p.organization_id FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    WHERE sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
+)
+ORDER BY op.organization_id, op.id;
+
+
+-- name: UpdateOrganizationPolicy :exec
+UPDATE organization_policies SET
+  enforcement = ?,
+  config = ?,
+  updated_by = ?
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+
+-- name: DeleteOrganizationPolicy :exec
+DELETE FROM organization_policies
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+
+-- name: CreatePolicyViolation :exec
+INSERT INTO policy_violations (public_id, organization_id, policy_id, account_id, `rule`, procedure_name, resource_id, message, blocked)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?);
+
+
+-- name: ListPolicyViolations :many
+SELECT v.id, BIN_TO_UUID(v.public_id) AS public_id, v.`rule`, v.procedure_name, v.resource_id, v.message,
+       v.blocked, v.created_at, COALESCE(BIN_TO_UUID(p.public_id), '') AS policy_public_id, a.email AS account_email
+FROM policy_violations v
+LEFT JOIN organization_policies p ON p.id = v.policy_id
+LEFT JOIN accounts a ON a.id = v.account_id
+WHERE v.organization_id = ?
+ORDER BY v.created_at DESC, v.id DESC
+LIMIT ? OFFSET ?;
@@ -0,0 +1,35 @@
+-- name: CreatePrivateServiceConnectEndpoint :exec
+INSERT INTO private_service_connect_endpoints (public_id, organization_id, target, requested_ip_address, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?);
+
+-- name: GetPrivateServiceConnectEndpoint :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, target, requested_ip_address,
+       ip_address, forwarding_rule, status, created_at, updated_at
+FROM private_service_connect_endpoints
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: GetPrivateServiceConnectEndpointByTarget :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, target, requested_ip_address,
+       ip_address, forwarding_rule, status, created_at, updated_at
+FROM private_service_connect_endpoints
+WHERE organization_id = ? AND target = ?;
+
+-- name: ListPrivateServiceConnectEndpoints :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, target, requested_ip_address,
+       ip_address, forwarding_rule, status, created_at, updated_at
+FROM private_service_connect_endpoints
+WHERE organization_id = ?
+ORDER BY target;
+
+-- name: MarkPrivateServiceConnectEndpointDeleting :exec
+-- The endpoint is left out of the organization's terraform vars from here on,
+-- and removed once the terraform runner reports its forwarding rule is gone
+UPDATE private_service_connect_endpoints SET status = 'deleting' WHERE id = ?;
+
+-- name: ActivatePrivateServiceConnectEndpoint :exec
+UPDATE private_service_connect_endpoints
+SET status = 'active', ip_address = ?, forwarding_rule = ?
+WHERE id = ? AND status != 'deleting';
+
+-- name: DeletePrivateServiceConnectEndpoint :exec
+DELETE FROM private_service_connect_endpoints WHERE id = ?;
@@ -26,6 +26,7 @@ JOIN (
       AND om_related.status = 'active'
       AND om_related.role IN ('owner', 'developer')
 ) AS authorized_accounts ON a.id = authorized_accounts.account_id
+WHERE sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP
 ORDER BY sk.created_at DESC;
 
 
@@ -34,8 +35,8 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `name`,
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, `status`,
-       created_at, updated_at, created_by, updated_by
+       gcp_project_id, gcp_project_number, create_branch_sites, `status`, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM projects WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
@@ -44,7 +45,7 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `name`,
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, `status`,
+       gcp_project_id, gcp_project_number, create_branch_sites, `status`, labels,
        created_at, updated_at, created_by, updated_by
 FROM projects WHERE id = ?;
 
@@ -54,7 +55,7 @@ SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, `name`,
        gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
        promote_strategy,
        monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-       gcp_project_id, gcp_project_number, create_branch_sites, `status`,
+       gcp_project_id, gcp_project_number, create_branch_sites, `status`, labels,
        created_at, updated_at, created_by, updated_by
 FROM projects WHERE gcp_project_id = ?;
 
@@ -64,12 +65,14 @@ INSERT INTO projects (
   public_id, organization_id, `name`,
   gcp_region, gcp_zone, machine_type, disk_size_gb, os, disk_type, stripe_subscription_item_id,
   monitoring_enabled, monitoring_log_level, monitoring_metrics_enabled, monitoring_health_check_path,
-  gcp_project_id, gcp_project_number, create_branch_sites, `status`,
+  gcp_project_id, gcp_project_number, create_branch_sites, `status`, labels,
   created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 
--- name: UpdateProject :exec
+-- name: UpdateProject :execrows
+-- Applies the change only if the project is still at the version it was read at;
+-- no rows are affected when someone else changed it first
 UPDATE projects SET
   `name` = ?,
   gcp_region = ?,
@@ -87,9 +90,17 @@ UPDATE projects SET
   gcp_project_number = ?,
   create_branch_sites = ?,
   `status` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND version = ?;
+
+
+-- name: UpdateProjectStripeSubscriptionItem :exec
+-- Records the machine's subscription item when billing was put back after a
+-- plan change that couldn't be saved; the project itself didn't change
+UPDATE projects SET stripe_subscription_item_id = ? WHERE id = ?;
 
 
 -- name: DeleteProject :exec
@@ -104,23 +115,17 @@ LIMIT ? OFFSET ?;
 
 
 -- name: ListUserProjects :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.created_at, p.updated_at, p.created_by, p.updated_by
+SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.os, p.disk_type, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.labels, p.created_at, p.updated_at, p.created_by, p.updated_by
 FROM projects p
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = sqlc.arg(account_id) AND ea.reachable
+WHERE (pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = sqlc.narg(filter_organization_id) OR sqlc.narg(filter_organization_id) IS NULL)
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?;
+AND (sqlc.narg(label_selector) IS NULL OR JSON_CONTAINS(p.labels, sqlc.narg(label_selector)))
+AND (sqlc.narg(cursor_created_at) IS NULL OR p.created_at < sqlc.narg(cursor_created_at) OR (p.created_at = sqlc.narg(cursor_created_at) AND p.id < sqlc.narg(cursor_id)))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT ?;
 
 -- =============================================================================
 -- SITES
@@ -128,13 +133,13 @@ LIMIT ? OFFSET ?;
 
 
 -- name: GetSiteByProjectAndName :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM sites WHERE project_id = ? AND `name` = ?;
 
 
 -- name: ListProjectSites :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, status, labels, created_at, updated_at, created_by, updated_by
 FROM sites
 WHERE project_id = ?
 ORDER BY created_at DESC
@@ -142,46 +147,32 @@ LIMIT ? OFFSET ?;
 
 
 -- name: ListUserProjectsWithOrg :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, o.name AS organization_name, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.created_at, p.updated_at, p.created_by, p.updated_by
+SELECT DISTINCT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.organization_id, BIN_TO_UUID(o.public_id) AS organization_public_id, o.name AS organization_name, p.name, p.gcp_region, p.gcp_zone, p.machine_type, p.disk_size_gb, p.stripe_subscription_item_id, p.promote_strategy, p.monitoring_enabled, p.monitoring_log_level, p.monitoring_metrics_enabled, p.monitoring_health_check_path, p.gcp_project_id, p.gcp_project_number, p.organization_project, p.create_branch_sites, p.status, p.labels, p.created_at, p.updated_at, p.created_by, p.updated_by
 FROM projects p
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = sqlc.arg(account_id) AND ea.reachable
+WHERE (pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = sqlc.narg(filter_organization_id) OR sqlc.narg(filter_organization_id) IS NULL)
-ORDER BY p.created_at DESC
-LIMIT ? OFFSET ?;
+AND (sqlc.narg(cursor_created_at) IS NULL OR p.created_at < sqlc.narg(cursor_created_at) OR (p.created_at = sqlc.narg(cursor_created_at) AND p.id < sqlc.narg(cursor_id)))
+ORDER BY p.created_at DESC, p.id DESC
+LIMIT ?;
 
 
 -- name: ListUserSitesWithProject :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, p.name AS project_name, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.gcp_external_ip, s.status, s.created_at, s.updated_at, s.created_by, s.updated_by
+SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, p.name AS project_name, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.gcp_external_ip, s.status, s.labels, s.created_at, s.updated_at, s.created_by, s.updated_by
 FROM sites s
 JOIN projects p ON s.project_id = p.id
 JOIN organizations o ON p.organization_id = o.id
 LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
 LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = sqlc.arg(account_id) AND ea.reachable
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = sqlc.narg(filter_organization_id) OR sqlc.narg(filter_organization_id) IS NULL)
 AND (s.project_id = sqlc.narg(filter_project_id) OR sqlc.narg(filter_project_id) IS NULL)
-ORDER BY s.created_at DESC
-LIMIT ? OFFSET ?;
+AND (sqlc.narg(cursor_created_at) IS NULL OR s.created_at < sqlc.narg(cursor_created_at) OR (s.created_at = sqlc.narg(cursor_created_at) AND s.id < sqlc.narg(cursor_id)))
+ORDER BY s.created_at DESC, s.id DESC
+LIMIT ?;
 
 
 -- name: GetProjectFirewallRuleByPublicID :one
@@ -216,8 +207,8 @@ ORDER BY created_at DESC;
 
 -- name: CreateProjectSecret :execresult
 INSERT INTO project_secrets (
-    public_id, project_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?);
+    public_id, project_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 
 -- name: GetProjectSecretByID :one
@@ -228,7 +219,7 @@ FROM project_secrets WHERE id = ? AND status != 'deleted';
 
 -- name: GetProjectSecretByPublicID :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM project_secrets WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 
@@ -241,7 +232,7 @@ WHERE project_id = ? AND name = ? AND status != 'deleted';
 
 -- name: ListProjectSecrets :many
 SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+       created_at, updated_at, created_by, updated_by, reference
 FROM project_secrets
 WHERE project_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -255,7 +246,7 @@ WHERE project_id = ? AND status != 'deleted';
 
 -- name: UpdateProjectSecret :exec
 UPDATE project_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?;
 
 
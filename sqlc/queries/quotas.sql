@@ -0,0 +1,42 @@
+-- name: ListOrganizationQuotas :many
+SELECT id, organization_id, resource, quota_limit, created_at, updated_at, created_by, updated_by
+FROM organization_quotas
+WHERE organization_id = ?
+ORDER BY resource;
+
+-- name: GetOrganizationQuota :one
+SELECT id, organization_id, resource, quota_limit, created_at, updated_at, created_by, updated_by
+FROM organization_quotas
+WHERE organization_id = ? AND resource = ?;
+
+-- name: UpsertOrganizationQuota :exec
+INSERT INTO organization_quotas (organization_id, resource, quota_limit, created_by, updated_by)
+VALUES (sqlc.arg(organization_id), sqlc.arg(resource), sqlc.arg(quota_limit), sqlc.arg(updated_by), sqlc.arg(updated_by))
+ON DUPLICATE KEY UPDATE
+    quota_limit = VALUES(quota_limit),
+    updated_by = VALUES(updated_by),
+    updated_at = NOW();
+
+-- name: DeleteOrganizationQuota :exec
+DELETE FROM organization_quotas
+WHERE organization_id = ? AND resource = ?;
+
+-- name: CountProjectSites :one
+SELECT COUNT(*) FROM sites
+WHERE project_id = ? AND status != 'deleted';
+
+-- name: CountOrganizationFirewallRules :one
+SELECT COUNT(*) FROM organization_firewall_rules
+WHERE organization_id = ? AND status != 'deleted';
+
+-- name: CountProjectFirewallRules :one
+SELECT COUNT(*) FROM project_firewall_rules
+WHERE project_id = ? AND status != 'deleted';
+
+-- name: CountSiteFirewallRules :one
+SELECT COUNT(*) FROM site_firewall_rules
+WHERE site_id = ? AND status != 'deleted';
+
+-- name: CountAccountAPIKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE account_id = ? AND active = TRUE;
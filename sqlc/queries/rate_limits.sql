@@ -0,0 +1,40 @@
+-- name: CreateSiteRateLimitRule :exec
+INSERT INTO site_rate_limit_rules (public_id, site_id, name, path_prefix, requests, rate_window, burst, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSiteRateLimitRule :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, path_prefix, requests, rate_window, burst, created_at
+FROM site_rate_limit_rules
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: ListSiteRateLimitRules :many
+-- Rules with the requests they rejected since a date
+SELECT
+    r.id,
+    BIN_TO_UUID(r.public_id) AS public_id,
+    r.site_id,
+    r.name,
+    r.path_prefix,
+    r.requests,
+    r.rate_window,
+    r.burst,
+    r.created_at,
+    CAST(COALESCE((
+        SELECT SUM(j.rejected)
+        FROM site_rate_limit_rejections j
+        WHERE j.rule_id = r.id AND j.rejected_date >= sqlc.arg(since)
+    ), 0) AS SIGNED) AS rejected
+FROM site_rate_limit_rules r
+WHERE r.site_id = sqlc.arg(site_id)
+ORDER BY r.path_prefix DESC, r.id;
+
+-- name: CountSiteRateLimitRules :one
+SELECT COUNT(*) FROM site_rate_limit_rules WHERE site_id = ?;
+
+-- name: DeleteSiteRateLimitRule :exec
+DELETE FROM site_rate_limit_rules WHERE id = ?;
+
+-- name: AddSiteRateLimitRejections :exec
+INSERT INTO site_rate_limit_rejections (rule_id, rejected_date, rejected)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE rejected = rejected + VALUES(rejected);
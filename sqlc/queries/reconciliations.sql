@@ -44,6 +44,14 @@ SELECT * FROM reconciliations
 WHERE site_id = ? AND status IN ('pending', 'running')
 LIMIT 1;
 
+-- name: ListSiteReconciliationRuns :many
+SELECT * FROM reconciliations
+WHERE site_id = ?
+  AND run_type = 'reconciliation'
+  AND reconciliation_type IN (sqlc.arg(reconciliation_type), 'general')
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
 -- name: GetStaleReconciliationRuns :many
 SELECT * FROM reconciliations
 WHERE status = 'running'
@@ -0,0 +1,43 @@
+-- name: CreateSiteRedirect :exec
+INSERT INTO site_redirects (public_id, site_id, from_host, from_path, to_url, status_code, preserve_query, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSiteRedirect :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, from_host, from_path, to_url,
+       status_code, preserve_query, created_at, updated_at
+FROM site_redirects
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: GetSiteRedirectBySource :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, from_host, from_path, to_url,
+       status_code, preserve_query, created_at, updated_at
+FROM site_redirects
+WHERE site_id = ? AND from_host = ? AND from_path = ?;
+
+-- name: ListSiteRedirects :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, from_host, from_path, to_url,
+       status_code, preserve_query, created_at, updated_at
+FROM site_redirects
+WHERE site_id = ?
+ORDER BY from_host, from_path
+LIMIT ? OFFSET ?;
+
+-- name: ListAllSiteRedirects :many
+-- Every redirect of a site, most specific first: host rules before host-less
+-- ones, and longer paths before the prefixes they fall under
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, from_host, from_path, to_url,
+       status_code, preserve_query, created_at, updated_at
+FROM site_redirects
+WHERE site_id = ?
+ORDER BY from_host = '', LENGTH(from_path) DESC, from_path;
+
+-- name: CountSiteRedirects :one
+SELECT COUNT(*) FROM site_redirects WHERE site_id = ?;
+
+-- name: UpdateSiteRedirect :exec
+UPDATE site_redirects
+SET from_host = ?, from_path = ?, to_url = ?, status_code = ?, preserve_query = ?
+WHERE id = ?;
+
+-- name: DeleteSiteRedirect :exec
+DELETE FROM site_redirects WHERE id = ?;
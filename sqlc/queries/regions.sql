@@ -0,0 +1,44 @@
+-- name: ListRegions :many
+SELECT id, code, display_name, country, country_display_name, latitude, longitude, relative_price, active, sort_order, created_at, updated_at
+FROM regions
+WHERE active = TRUE
+ORDER BY sort_order, code;
+
+-- name: GetRegion :one
+SELECT id, code, display_name, country, country_display_name, latitude, longitude, relative_price, active, sort_order, created_at, updated_at
+FROM regions
+WHERE code = ? AND active = TRUE;
+
+-- name: GetRegionLocation :one
+-- Where a region is, active or not, for finding the API region nearest a site.
+SELECT latitude, longitude
+FROM regions
+WHERE code = ?;
+
+-- name: GetSiteRegion :one
+-- The API region a site is pinned to and where its project runs.
+SELECT s.api_region, p.gcp_region, r.latitude, r.longitude
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+LEFT JOIN regions r ON r.code = p.gcp_region
+WHERE s.id = ?;
+
+-- name: ListRegionMachineSeries :many
+-- Machine series offered by every active region.
+SELECT r.code AS region_code, s.series
+FROM region_machine_series s
+JOIN regions r ON r.id = s.region_id
+WHERE r.active = TRUE
+ORDER BY r.code, s.series;
+
+-- name: RegionOffersMachineSeries :one
+SELECT EXISTS(
+    SELECT 1
+    FROM region_machine_series s
+    JOIN regions r ON r.id = s.region_id
+    WHERE r.code = ? AND r.active = TRUE AND s.series = ?
+) AS offered;
+
+-- name: SetSiteAPIRegion :exec
+-- Pins a site to an API region unless it already has one.
+UPDATE sites SET api_region = ? WHERE id = ? AND api_region IS NULL;
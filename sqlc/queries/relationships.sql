@@ -1,9 +1,16 @@
 -- name: GetRelationship :one
 SELECT id, BIN_TO_UUID(public_id) AS public_id, source_organization_id, target_organization_id,
-       relationship_type, `status`, created_at, resolved_at, resolved_by
+       relationship_type, max_role, `status`, expires_at, created_at, requested_by, resolved_at, resolved_by
 FROM relationships WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
+-- name: GetRelationshipBetween :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, source_organization_id, target_organization_id,
+       relationship_type, max_role, `status`, expires_at, created_at, requested_by, resolved_at, resolved_by
+FROM relationships
+WHERE source_organization_id = ? AND target_organization_id = ? AND relationship_type = ?;
+
+
 -- name: CreateRelationship :execresult
 INSERT INTO relationships (
   public_id, source_organization_id, target_organization_id, relationship_type, `status`, created_at
@@ -28,3 +35,80 @@ UPDATE relationships SET
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` = 'pending';
 
 
+-- name: RequestRelationship :exec
+INSERT INTO relationships (
+  public_id, source_organization_id, target_organization_id, relationship_type, max_role, `status`, created_at, requested_by
+) VALUES (
+  UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, 'access', ?, 'pending', CURRENT_TIMESTAMP, ?
+);
+
+
+-- name: RerequestRelationship :execresult
+-- Reopens a rejected or revoked relationship, which keeps its ID
+UPDATE relationships SET
+  `status` = 'pending',
+  max_role = ?,
+  created_at = CURRENT_TIMESTAMP,
+  requested_by = ?,
+  expires_at = NULL,
+  resolved_at = NULL,
+  resolved_by = NULL
+WHERE id = ? AND `status` IN ('rejected', 'revoked');
+
+
+-- name: UpdateRelationshipMaxRole :execresult
+UPDATE relationships SET max_role = ?
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` IN ('pending', 'approved');
+
+
+-- name: SetRelationshipExpiry :execresult
+UPDATE relationships SET expires_at = sqlc.narg(expires_at)
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` IN ('pending', 'approved');
+
+
+-- name: RevokeRelationship :execresult
+UPDATE relationships SET
+  `status` = 'revoked',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = ?
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND `status` IN ('pending', 'approved');
+
+
+-- name: ListRelationshipsForOrganization :many
+-- Relationships an organization is either side of, with both organizations' names
+SELECT BIN_TO_UUID(r.public_id) AS public_id, r.relationship_type, r.max_role, r.`status`,
+       r.expires_at, r.created_at, r.resolved_at,
+       BIN_TO_UUID(source_org.public_id) AS source_organization_public_id, source_org.name AS source_organization_name,
+       BIN_TO_UUID(target_org.public_id) AS target_organization_public_id, target_org.name AS target_organization_name,
+       requester.email AS requested_by_email
+FROM relationships r
+JOIN organizations source_org ON source_org.id = r.source_organization_id
+JOIN organizations target_org ON target_org.id = r.target_organization_id
+LEFT JOIN accounts requester ON requester.id = r.requested_by
+WHERE r.source_organization_id = sqlc.arg(organization_id) OR r.target_organization_id = sqlc.arg(organization_id)
+ORDER BY r.created_at DESC
+LIMIT ? OFFSET ?;
+
+
+
+-- name: ListExpiredRelationships :many
+-- Pending or approved relationships past their expiry, oldest first
+SELECT r.id, BIN_TO_UUID(r.public_id) AS public_id, r.source_organization_id, r.target_organization_id,
+       r.max_role, r.expires_at,
+       BIN_TO_UUID(source_org.public_id) AS source_organization_public_id,
+       BIN_TO_UUID(target_org.public_id) AS target_organization_public_id
+FROM relationships r
+JOIN organizations source_org ON source_org.id = r.source_organization_id
+JOIN organizations target_org ON target_org.id = r.target_organization_id
+WHERE r.`status` IN ('pending', 'approved') AND r.expires_at <= sqlc.arg(now)
+ORDER BY r.expires_at
+LIMIT ?;
+
+
+-- name: ExpireRelationship :execrows
+-- Revokes a relationship only if it's still expired, so one extended since it was listed stays
+UPDATE relationships SET
+  `status` = 'revoked',
+  resolved_at = CURRENT_TIMESTAMP,
+  resolved_by = NULL
+WHERE id = sqlc.arg(id) AND `status` IN ('pending', 'approved') AND expires_at <= sqlc.arg(now);
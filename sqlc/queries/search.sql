@@ -0,0 +1,126 @@
+-- name: SearchUserResources :many
+-- Matches organizations, projects, sites, members and secret names the account can
+-- access. Secret values are never read. Members match on email or name and link to the
+-- resource they belong to.
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = sqlc.arg(account_id) AND reachable
+)
+SELECT * FROM (
+    SELECT
+        'project' AS kind, 2 AS rank_order,
+        BIN_TO_UUID(p.public_id) AS public_id, p.name, o.name AS detail,
+        'project' AS parent_type, BIN_TO_UUID(p.public_id) AS parent_public_id
+    FROM projects p
+    JOIN organizations o ON p.organization_id = o.id
+    LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE p.status != 'deleted' AND p.name LIKE sqlc.arg(pattern)
+    AND (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+
+    UNION ALL
+
+    SELECT
+        'organization' AS kind, 1 AS rank_order,
+        BIN_TO_UUID(o.public_id) AS public_id, o.name, '' AS detail,
+        'organization' AS parent_type, BIN_TO_UUID(o.public_id) AS parent_public_id
+    FROM organizations o
+    JOIN user_orgs uo ON o.id = uo.organization_id
+    WHERE o.status != 'deleted' AND o.name LIKE sqlc.arg(pattern)
+
+    UNION ALL
+
+    SELECT
+        'site' AS kind, 3 AS rank_order,
+        BIN_TO_UUID(s.public_id) AS public_id, s.name, p.name AS detail,
+        'site' AS parent_type, BIN_TO_UUID(s.public_id) AS parent_public_id
+    FROM sites s
+    JOIN projects p ON s.project_id = p.id
+    LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
+    LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE s.status != 'deleted' AND s.name LIKE sqlc.arg(pattern)
+    AND (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+
+    UNION ALL
+
+    SELECT
+        'member' AS kind, 4 AS rank_order,
+        BIN_TO_UUID(om.public_id) AS public_id, COALESCE(a.name, a.email) AS name, o.name AS detail,
+        'organization' AS parent_type, BIN_TO_UUID(o.public_id) AS parent_public_id
+    FROM organization_members om
+    JOIN organizations o ON om.organization_id = o.id
+    JOIN accounts a ON om.account_id = a.id
+    JOIN user_orgs uo ON o.id = uo.organization_id
+    WHERE om.status != 'deleted' AND CONCAT_WS(" ", a.email, a.name) LIKE sqlc.arg(pattern)
+
+    UNION ALL
+
+    SELECT
+        'member' AS kind, 4 AS rank_order,
+        BIN_TO_UUID(pm.public_id) AS public_id, COALESCE(a.name, a.email) AS name, p.name AS detail,
+        'project' AS parent_type, BIN_TO_UUID(p.public_id) AS parent_public_id
+    FROM project_members pm
+    JOIN projects p ON pm.project_id = p.id
+    JOIN accounts a ON pm.account_id = a.id
+    LEFT JOIN project_members pm_auth ON p.id = pm_auth.project_id AND pm_auth.account_id = sqlc.arg(account_id) AND pm_auth.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE pm.status != 'deleted' AND CONCAT_WS(" ", a.email, a.name) LIKE sqlc.arg(pattern)
+    AND (pm_auth.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+
+    UNION ALL
+
+    SELECT
+        'member' AS kind, 4 AS rank_order,
+        BIN_TO_UUID(sm.public_id) AS public_id, COALESCE(a.name, a.email) AS name, s.name AS detail,
+        'site' AS parent_type, BIN_TO_UUID(s.public_id) AS parent_public_id
+    FROM site_members sm
+    JOIN sites s ON sm.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    JOIN accounts a ON sm.account_id = a.id
+    LEFT JOIN site_members sm_auth ON s.id = sm_auth.site_id AND sm_auth.account_id = sqlc.arg(account_id) AND sm_auth.status = 'active'
+    LEFT JOIN project_members pm_auth ON p.id = pm_auth.project_id AND pm_auth.account_id = sqlc.arg(account_id) AND pm_auth.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE sm.status != 'deleted' AND CONCAT_WS(" ", a.email, a.name) LIKE sqlc.arg(pattern)
+    AND (sm_auth.id IS NOT NULL OR pm_auth.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+
+    UNION ALL
+
+    SELECT
+        'secret' AS kind, 5 AS rank_order,
+        BIN_TO_UUID(os.public_id) AS public_id, os.name, o.name AS detail,
+        'organization' AS parent_type, BIN_TO_UUID(o.public_id) AS parent_public_id
+    FROM organization_secrets os
+    JOIN organizations o ON os.organization_id = o.id
+    JOIN user_orgs uo ON o.id = uo.organization_id
+    WHERE os.status != 'deleted' AND os.name LIKE sqlc.arg(pattern)
+
+    UNION ALL
+
+    SELECT
+        'secret' AS kind, 5 AS rank_order,
+        BIN_TO_UUID(ps.public_id) AS public_id, ps.name, p.name AS detail,
+        'project' AS parent_type, BIN_TO_UUID(p.public_id) AS parent_public_id
+    FROM project_secrets ps
+    JOIN projects p ON ps.project_id = p.id
+    LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE ps.status != 'deleted' AND ps.name LIKE sqlc.arg(pattern)
+    AND (pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+
+    UNION ALL
+
+    SELECT
+        'secret' AS kind, 5 AS rank_order,
+        BIN_TO_UUID(ss.public_id) AS public_id, ss.name, s.name AS detail,
+        'site' AS parent_type, BIN_TO_UUID(s.public_id) AS parent_public_id
+    FROM site_secrets ss
+    JOIN sites s ON ss.site_id = s.id
+    JOIN projects p ON s.project_id = p.id
+    LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
+    LEFT JOIN project_members pm ON p.id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+    LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
+    WHERE ss.status != 'deleted' AND ss.name LIKE sqlc.arg(pattern)
+    AND (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+) AS results
+ORDER BY rank_order, name
+LIMIT ?;
@@ -0,0 +1,25 @@
+-- name: CreateSecretAccessLog :exec
+INSERT INTO secret_access_logs (public_id, organization_id, site_id, account_id, principal, secret_name, source_ip, procedure_name, anomaly)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?);
+
+
+-- name: CountSecretAccessSince :one
+-- Reads of an organization's secrets since a time, by anyone
+SELECT COUNT(*) FROM secret_access_logs
+WHERE organization_id = ? AND created_at >= sqlc.arg(since);
+
+
+-- name: CountSecretAccessByPrincipalSince :one
+-- Reads of an organization's secrets since a time, by one principal
+SELECT COUNT(*) FROM secret_access_logs
+WHERE organization_id = ? AND principal = ? AND created_at >= sqlc.arg(since);
+
+
+-- name: ListSecretAccessLogs :many
+SELECT l.id, BIN_TO_UUID(l.public_id) AS public_id, l.principal, l.secret_name, l.source_ip, l.procedure_name,
+       l.anomaly, l.created_at, COALESCE(BIN_TO_UUID(s.public_id), '') AS site_public_id
+FROM secret_access_logs l
+LEFT JOIN sites s ON s.id = l.site_id
+WHERE l.organization_id = ?
+ORDER BY l.created_at DESC, l.id DESC
+LIMIT ? OFFSET ?;
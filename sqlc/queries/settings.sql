@@ -4,21 +4,21 @@
 
 -- name: CreateOrganizationSetting :exec
 INSERT INTO organization_settings (
-    public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+    public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 -- name: GetOrganizationSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE organization_id = ? AND setting_key = ? AND status != 'deleted';
 
 -- name: GetOrganizationSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 -- name: ListOrganizationSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM organization_settings
 WHERE organization_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -40,21 +40,21 @@ WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 -- name: CreateProjectSetting :exec
 INSERT INTO project_settings (
-    public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+    public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 -- name: GetProjectSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE project_id = ? AND setting_key = ? AND status != 'deleted';
 
 -- name: GetProjectSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 -- name: ListProjectSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM project_settings
 WHERE project_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -76,21 +76,21 @@ WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 -- name: CreateSiteSetting :exec
 INSERT INTO site_settings (
-    public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+    public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 -- name: GetSiteSetting :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE site_id = ? AND setting_key = ? AND status != 'deleted';
 
 -- name: GetSiteSettingByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 -- name: ListSiteSettings :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, editable, description, status, created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, setting_key, setting_value, value_type, allowed_values, editable, description, status, created_at, updated_at, created_by, updated_by
 FROM site_settings
 WHERE site_id = ? AND status != 'deleted'
 ORDER BY setting_key ASC
@@ -106,18 +106,38 @@ UPDATE site_settings
 SET status = 'deleted', updated_at = NOW(), updated_by = ?
 WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
+-- name: ListSiteInheritedSettings :many
+-- Settings that apply to a site, from its organization's up to its own, in the
+-- order they override each other
+SELECT setting_key, setting_value FROM (
+    SELECT os.setting_key, os.setting_value, 1 AS precedence
+    FROM organization_settings os
+    JOIN projects p ON p.organization_id = os.organization_id
+    JOIN sites s ON s.project_id = p.id
+    WHERE s.id = sqlc.arg(site_id) AND os.status != 'deleted'
+
+    UNION ALL
+
+    SELECT ps.setting_key, ps.setting_value, 2 AS precedence
+    FROM project_settings ps
+    JOIN sites s ON s.project_id = ps.project_id
+    WHERE s.id = sqlc.arg(site_id) AND ps.status != 'deleted'
+
+    UNION ALL
+
+    SELECT ss.setting_key, ss.setting_value, 3 AS precedence
+    FROM site_settings ss
+    WHERE ss.site_id = sqlc.arg(site_id) AND ss.status != 'deleted'
+) AS inherited
+ORDER BY precedence ASC, setting_key ASC;
+
 -- ============================================================================
 -- USER SETTINGS (Cross-scope query for dashboard)
 -- ============================================================================
 
 -- name: ListUserSettings :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
+WITH user_orgs AS (
+    SELECT organization_id FROM effective_organization_access WHERE account_id = sqlc.arg(account_id) AND reachable
 )
 SELECT * FROM (
     SELECT
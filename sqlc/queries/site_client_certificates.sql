@@ -0,0 +1,15 @@
+-- name: CreateSiteClientCertificate :exec
+INSERT INTO site_client_certificates (
+    site_id, serial_number, fingerprint, not_before, not_after
+) VALUES (?, ?, ?, ?, ?);
+
+-- name: GetSiteClientCertificate :one
+SELECT id, site_id, serial_number, fingerprint, not_before, not_after, revoked_at, created_at
+FROM site_client_certificates
+WHERE serial_number = ?;
+
+-- name: RevokeSiteClientCertificates :execrows
+-- Revokes a site's certificates other than the one just issued
+UPDATE site_client_certificates
+SET revoked_at = CURRENT_TIMESTAMP
+WHERE site_id = ? AND serial_number != ? AND revoked_at IS NULL;
@@ -0,0 +1,50 @@
+-- name: ListSiteHealthInputs :many
+-- What each active site's health score is computed from: its last check-in and
+-- container counts, its probes since the cutoff, its latest deployment, when its
+-- score dropped below the incident threshold and whether it has an open incident
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.name, p.organization_id, s.checkin_at,
+       s.containers_running, s.containers_unhealthy,
+       CAST(COUNT(sp.id) AS SIGNED) AS probes,
+       CAST(COALESCE(SUM(CASE WHEN sp.up THEN 1 ELSE 0 END), 0) AS SIGNED) AS probes_up,
+       (SELECT d.status FROM deployments d
+        WHERE d.site_id = BIN_TO_UUID(s.public_id)
+        ORDER BY d.created_at DESC
+        LIMIT 1) AS deployment_status,
+       h.degraded_since,
+       EXISTS (SELECT 1 FROM site_incidents i WHERE i.open_site_id = s.id) AS open_incident
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+LEFT JOIN site_probes sp ON sp.site_id = s.id AND sp.probed_at >= sqlc.arg(probes_since)
+LEFT JOIN site_health h ON h.site_id = s.id
+WHERE s.status = 'active'
+GROUP BY s.id, s.public_id, s.name, p.organization_id, s.checkin_at, s.containers_running, s.containers_unhealthy, h.degraded_since
+ORDER BY s.id;
+
+-- name: UpsertSiteHealth :exec
+INSERT INTO site_health (site_id, score, checkin_score, container_score, probe_score, deployment_score, degraded_since, computed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    score = VALUES(score),
+    checkin_score = VALUES(checkin_score),
+    container_score = VALUES(container_score),
+    probe_score = VALUES(probe_score),
+    deployment_score = VALUES(deployment_score),
+    degraded_since = VALUES(degraded_since),
+    computed_at = VALUES(computed_at);
+
+-- name: GetSiteHealth :one
+SELECT site_id, score, checkin_score, container_score, probe_score, deployment_score, degraded_since, computed_at
+FROM site_health
+WHERE site_id = ?;
+
+-- name: UpdateSiteContainers :exec
+-- Records the container counts a site's controller reported on check-in
+UPDATE sites
+SET containers_running = ?, containers_unhealthy = ?
+WHERE id = ?;
+
+-- name: GetSiteCheckIn :one
+-- What a site's controller last reported, for its status
+SELECT checkin_at, controller_version, containers_running, containers_unhealthy
+FROM sites
+WHERE id = ?;
@@ -0,0 +1,53 @@
+-- name: ListSitesMissingCheckIn :many
+-- Active sites whose controller has not checked in since the cutoff and have no open incident
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.name, p.organization_id, s.checkin_at
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+WHERE s.status = 'active'
+  AND s.checkin_at < sqlc.arg(cutoff)
+  AND NOT EXISTS (SELECT 1 FROM site_incidents i WHERE i.open_site_id = s.id)
+ORDER BY s.id;
+
+-- name: OpenSiteIncident :execrows
+-- Opens an incident unless the site already has one open; 0 rows means another sweep won
+INSERT IGNORE INTO site_incidents (public_id, organization_id, site_id, open_site_id, cause)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?);
+
+-- name: ListRecoveredSiteIncidents :many
+-- Open incidents whose site recovered from the incident's cause or is no longer active:
+-- missed check-ins recover with a check-in since the cutoff, failed probes with a probe that was up,
+-- and degraded health once the score is back above the threshold
+SELECT i.id, BIN_TO_UUID(i.public_id) AS public_id, i.organization_id, i.site_id,
+       BIN_TO_UUID(s.public_id) AS site_public_id, s.name AS site_name, i.cause, i.opened_at
+FROM site_incidents i
+JOIN sites s ON s.id = i.site_id
+WHERE i.status = 'open'
+  AND (
+    s.status <> 'active'
+    OR (i.cause = 'checkin_missed' AND s.checkin_at >= sqlc.arg(cutoff))
+    OR (i.cause = 'probe_failed' AND EXISTS (
+      SELECT 1 FROM site_probes sp WHERE sp.site_id = i.site_id AND sp.up AND sp.probed_at > i.opened_at
+    ))
+    OR (i.cause = 'health_degraded' AND NOT EXISTS (
+      SELECT 1 FROM site_health h WHERE h.site_id = i.site_id AND h.degraded_since IS NOT NULL
+    ))
+  )
+ORDER BY i.id;
+
+-- name: ResolveSiteIncident :execrows
+UPDATE site_incidents
+SET status = 'resolved', open_site_id = NULL, resolved_at = NOW()
+WHERE id = ? AND status = 'open';
+
+-- name: ListSitesFailingProbes :many
+-- Active sites with at least min_probes probes since the cutoff, none of them up, and no open incident
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.name, p.organization_id,
+       CAST(COUNT(*) AS SIGNED) AS failed_probes
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+JOIN site_probes sp ON sp.site_id = s.id AND sp.probed_at >= sqlc.arg(cutoff)
+WHERE s.status = 'active'
+  AND NOT EXISTS (SELECT 1 FROM site_incidents i WHERE i.open_site_id = s.id)
+GROUP BY s.id, s.public_id, s.name, p.organization_id
+HAVING COUNT(*) >= CAST(sqlc.arg(min_probes) AS SIGNED) AND SUM(CASE WHEN sp.up THEN 1 ELSE 0 END) = 0
+ORDER BY s.id;
@@ -0,0 +1,39 @@
+-- name: ListProbeTargets :many
+-- Active sites reachable from outside: their first domain, or their external IP
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.port, s.health_check_path, s.gcp_external_ip, d.domain
+FROM sites s
+LEFT JOIN domains d ON d.id = (SELECT MIN(d2.id) FROM domains d2 WHERE d2.site_id = s.id)
+WHERE s.status = 'active'
+  AND ((s.gcp_external_ip IS NOT NULL AND s.gcp_external_ip <> '') OR d.id IS NOT NULL)
+ORDER BY s.id;
+
+-- name: CreateSiteProbe :exec
+INSERT INTO site_probes (site_id, probed_at, up, status_code, response_time_ms, error)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetLatestSiteProbe :one
+SELECT probed_at, up, status_code, response_time_ms, error
+FROM site_probes
+WHERE site_id = ?
+ORDER BY probed_at DESC, id DESC
+LIMIT 1;
+
+-- name: ListSiteProbeBuckets :many
+-- Probe counts per bucket of bucket_seconds since a time, for uptime graphs
+SELECT CAST(FLOOR(UNIX_TIMESTAMP(probed_at) / CAST(sqlc.arg(bucket_seconds) AS SIGNED)) AS SIGNED) AS bucket,
+       COUNT(*) AS checks,
+       CAST(SUM(CASE WHEN up THEN 0 ELSE 1 END) AS SIGNED) AS failures,
+       CAST(SUM(CASE WHEN up THEN response_time_ms ELSE 0 END) AS SIGNED) AS up_response_time_ms
+FROM site_probes
+WHERE site_id = sqlc.arg(site_id) AND probed_at >= sqlc.arg(since)
+GROUP BY bucket
+ORDER BY bucket;
+
+-- name: DeleteSiteProbesBefore :execrows
+DELETE FROM site_probes WHERE probed_at < ? LIMIT 10000;
+
+-- name: GetSiteHealthCheckPath :one
+SELECT health_check_path FROM sites WHERE id = ?;
+
+-- name: UpdateSiteHealthCheckPath :exec
+UPDATE sites SET health_check_path = ? WHERE id = ?;
@@ -35,6 +35,7 @@ JOIN (
       AND om_related.status = 'active'
       AND om_related.role IN ('owner', 'developer')
 ) AS authorized_accounts ON a.id = authorized_accounts.account_id
+WHERE sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP
 ORDER BY sk.created_at DESC;
 
 -- =============================================================================
@@ -43,30 +44,32 @@ ORDER BY sk.created_at DESC;
 
 
 -- name: GetSite :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, labels,
+       created_at, updated_at, created_by, updated_by, version
 FROM sites WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
 -- name: GetSiteByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, labels,
        created_at, updated_at, created_by, updated_by
 FROM sites WHERE id = ?;
 
 
 -- name: GetSiteByShortUUID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, labels,
        created_at, updated_at, created_by, updated_by
 FROM sites WHERE HEX(public_id) LIKE CONCAT(UPPER(sqlc.arg(short_uuid)), '%') LIMIT 1;
 
 
 -- name: CreateSite :exec
 INSERT INTO sites (
-  public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(UUID_V7()), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
+  public_id, project_id, `name`, github_repository, github_ref, github_team_id, compose_path, compose_file, port, application_type, up_cmd, init_cmd, rollout_cmd, overlay_volumes, os, is_production, gcp_external_ip, `status`, labels, created_at, updated_at, created_by, updated_by
+) VALUES (UUID_TO_BIN(UUID_V7()), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), NOW(), ?, ?);
 
 
--- name: UpdateSite :exec
+-- name: UpdateSite :execrows
+-- Applies the change only if the site is still at the version it was read at;
+-- no rows are affected when someone else changed it first
 UPDATE sites SET
   `name` = ?,
   github_repository = ?,
@@ -84,9 +87,11 @@ UPDATE sites SET
   is_production = ?,
   gcp_external_ip = ?,
   `status` = ?,
+  labels = ?,
+  version = version + 1,
   updated_at = NOW(),
   updated_by = ?
-WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND version = ?;
 
 
 -- name: DeleteSite :exec
@@ -101,26 +106,55 @@ LIMIT ? OFFSET ?;
 
 
 -- name: ListUserSites :many
-WITH RECURSIVE user_orgs AS (
-    SELECT organization_id FROM organization_members WHERE organization_members.account_id = sqlc.arg(account_id) AND organization_members.status = 'active'
-    UNION DISTINCT
-    SELECT r.target_organization_id
-    FROM relationships r
-    INNER JOIN user_orgs uo ON r.source_organization_id = uo.organization_id
-    WHERE r.status = 'approved'
-)
-SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.overlay_volumes, s.os, s.is_production, s.gcp_external_ip, s.status, s.created_at, s.updated_at, s.created_by, s.updated_by
+SELECT DISTINCT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.project_id, BIN_TO_UUID(p.public_id) AS project_public_id, BIN_TO_UUID(o.public_id) AS organization_public_id, s.name, s.github_repository, s.github_ref, s.github_team_id, s.compose_path, s.compose_file, s.port, s.application_type, s.up_cmd, s.init_cmd, s.rollout_cmd, s.overlay_volumes, s.os, s.is_production, s.gcp_external_ip, s.status, s.labels, s.created_at, s.updated_at, s.created_by, s.updated_by,
+       h.score AS health_score, h.degraded_since AS health_degraded_since, h.computed_at AS health_computed_at
 FROM sites s
 JOIN projects p ON s.project_id = p.id
 JOIN organizations o ON p.organization_id = o.id
+LEFT JOIN site_health h ON h.site_id = s.id
 LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
 LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
-LEFT JOIN user_orgs uo ON p.organization_id = uo.organization_id
-WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR uo.organization_id IS NOT NULL)
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = sqlc.arg(account_id) AND ea.reachable
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
 AND (p.organization_id = sqlc.narg(filter_organization_id) OR sqlc.narg(filter_organization_id) IS NULL)
 AND (s.project_id = sqlc.narg(filter_project_id) OR sqlc.narg(filter_project_id) IS NULL)
-ORDER BY s.created_at DESC
-LIMIT ? OFFSET ?;
+AND (sqlc.narg(label_selector) IS NULL OR JSON_CONTAINS(s.labels, sqlc.narg(label_selector)))
+AND (sqlc.narg(cursor_created_at) IS NULL OR s.created_at < sqlc.narg(cursor_created_at) OR (s.created_at = sqlc.narg(cursor_created_at) AND s.id < sqlc.narg(cursor_id)))
+ORDER BY s.created_at DESC, s.id DESC
+LIMIT ?;
+
+-- name: ListUserSiteLiveStatus :many
+-- Current status, open incident, latest deployment and latest reconciliation of each site
+-- the account can access, for the dashboard's live status stream. The latest reconciliation
+-- includes organization and project runs, which reconcile every site beneath them.
+SELECT DISTINCT BIN_TO_UUID(s.public_id) AS public_id, s.status,
+       i.cause AS incident_cause,
+       d.id AS deployment_id, d.status AS deployment_status, d.github_run_url AS deployment_url, d.error_message AS deployment_error,
+       rc.run_id AS reconciliation_run_id, rc.status AS reconciliation_status, rc.error_message AS reconciliation_error
+FROM sites s
+JOIN projects p ON s.project_id = p.id
+LEFT JOIN site_members sm ON s.id = sm.site_id AND sm.account_id = sqlc.arg(account_id) AND sm.status = 'active'
+LEFT JOIN project_members pm ON s.project_id = pm.project_id AND pm.account_id = sqlc.arg(account_id) AND pm.status = 'active'
+LEFT JOIN effective_organization_access ea ON ea.organization_id = p.organization_id AND ea.account_id = sqlc.arg(account_id) AND ea.reachable
+LEFT JOIN site_incidents i ON i.open_site_id = s.id
+LEFT JOIN deployments d ON d.id = (
+    SELECT d2.id FROM deployments d2
+    WHERE d2.site_id = BIN_TO_UUID(s.public_id)
+    ORDER BY d2.created_at DESC
+    LIMIT 1
+)
+LEFT JOIN reconciliations rc ON rc.id = (
+    SELECT r2.id FROM reconciliations r2
+    WHERE r2.site_id = s.id
+       OR (r2.site_id IS NULL AND r2.project_id = s.project_id)
+       OR (r2.site_id IS NULL AND r2.project_id IS NULL AND r2.organization_id = p.organization_id)
+    ORDER BY r2.id DESC
+    LIMIT 1
+)
+WHERE (sm.id IS NOT NULL OR pm.id IS NOT NULL OR ea.organization_id IS NOT NULL)
+AND (s.public_id = UUID_TO_BIN(sqlc.narg(filter_site_id)) OR sqlc.narg(filter_site_id) IS NULL)
+ORDER BY public_id
+LIMIT 500;
 
 -- =============================================================================
 -- SITE MEMBERS
@@ -189,8 +223,8 @@ ORDER BY created_at DESC;
 
 
 -- name: ListSiteSshAccess :many
-SELECT sa.id, sa.account_id, sa.site_id, sa.created_at, sa.updated_at,
-       a.email, a.`name`, a.github_username
+SELECT sa.id, sa.account_id, sa.site_id, sa.sftp_only, sa.created_at, sa.updated_at,
+       a.email, a.`name`, a.github_username, BIN_TO_UUID(a.public_id) AS account_public_id
 FROM ssh_access sa
 JOIN accounts a ON sa.account_id = a.id
 WHERE sa.site_id = ?
@@ -205,32 +239,32 @@ LIMIT ? OFFSET ?;
 
 -- name: CreateSiteSecret :execresult
 INSERT INTO site_secrets (
-    public_id, site_id, name, vault_path, status, created_at, updated_at, created_by, updated_by
-) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?);
+    public_id, site_id, name, vault_path, status, created_at, updated_at, created_by, updated_by, key_version, reference
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 
 -- name: GetSiteSecretByID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
        created_at, updated_at, created_by, updated_by
 FROM site_secrets WHERE id = ? AND status != 'deleted';
 
 
 -- name: GetSiteSecretByPublicID :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
+       created_at, updated_at, created_by, updated_by, key_version, reference
 FROM site_secrets WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status != 'deleted';
 
 
 -- name: GetSiteSecretByName :one
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
        created_at, updated_at, created_by, updated_by
 FROM site_secrets
 WHERE site_id = ? AND name = ? AND status != 'deleted';
 
 
 -- name: ListSiteSecrets :many
-SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status,
-       created_at, updated_at, created_by, updated_by
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, name, vault_path, status, managed,
+       created_at, updated_at, created_by, updated_by, reference
 FROM site_secrets
 WHERE site_id = ? AND status != 'deleted'
 ORDER BY name ASC
@@ -244,7 +278,7 @@ WHERE site_id = ? AND status != 'deleted';
 
 -- name: UpdateSiteSecret :exec
 UPDATE site_secrets
-SET vault_path = ?, updated_by = ?, updated_at = ?
+SET vault_path = ?, key_version = ?, reference = ?, updated_by = ?, updated_at = ?
 WHERE id = ?;
 
 
@@ -260,14 +294,15 @@ WHERE id = ?;
 
 -- name: GetSiteSSHKeysForVM :many
 -- Fetches all SSH keys that should be provisioned to a site VM
--- Includes keys from site members, project members, org members, and relationship members
+-- Includes keys from site members, project members, org members, relationship members
+-- and members elevated on the site
 SELECT DISTINCT sk.public_key, sk.name, sk.fingerprint, a.email, a.name as user_name, a.github_username, BIN_TO_UUID(a.public_id) AS account_public_id
 FROM ssh_keys sk
 JOIN accounts a ON sk.account_id = a.id
 WHERE sk.account_id IN (
     -- Site members (owner/developer with active status)
-    SELECT account_id FROM site_members
-    WHERE site_id = ? AND role IN ('owner', 'developer') AND status = 'active'
+    SELECT sm.account_id FROM site_members sm
+    WHERE sm.site_id = ? AND sm.role IN ('owner', 'developer') AND sm.status = 'active'
 
     UNION
 
@@ -291,24 +326,44 @@ WHERE sk.account_id IN (
     JOIN relationships r ON r.source_organization_id = om.organization_id
     JOIN projects p ON p.organization_id = r.target_organization_id
     JOIN sites s ON s.project_id = p.id
-    WHERE s.id = ? AND r.status = 'approved'
+    WHERE s.id = ? AND r.status = 'approved' AND r.max_role IN ('owner', 'developer')
       AND om.role IN ('owner', 'developer') AND om.status = 'active'
-);
+
+    UNION
+
+    -- Members elevated to owner/developer on the site until their role lapses
+    SELECT se.account_id FROM site_elevations se
+    WHERE se.site_id = ? AND se.`status` = 'approved' AND se.expires_at > CURRENT_TIMESTAMP
+)
+AND (sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP);
+
+
+-- name: GetSiteSshAccessKeysForVM :many
+-- Fetches the SSH keys of accounts granted SSH access to a site, which lapses
+-- with their site membership
+SELECT sk.public_key, sk.name, sk.fingerprint, a.github_username,
+       BIN_TO_UUID(a.public_id) AS account_public_id, sa.sftp_only
+FROM ssh_access sa
+JOIN site_members sm ON sm.site_id = sa.site_id AND sm.account_id = sa.account_id AND sm.status = 'active'
+JOIN accounts a ON a.id = sa.account_id
+JOIN ssh_keys sk ON sk.account_id = sa.account_id
+WHERE sa.site_id = ?
+  AND (sk.expires_at IS NULL OR sk.expires_at > CURRENT_TIMESTAMP);
 
 
 -- name: GetSiteSecretsForVM :many
 -- Fetches all secrets that should be provisioned to a site VM
 -- Includes secrets from site, project, and org levels
-SELECT DISTINCT ss.name as `key`, ss.vault_path as value
+SELECT DISTINCT ss.name as `key`, ss.vault_path as value, ss.reference
 FROM site_secrets ss
 WHERE ss.site_id = ?
 UNION
-SELECT DISTINCT ps.name as `key`, ps.vault_path as value
+SELECT DISTINCT ps.name as `key`, ps.vault_path as value, ps.reference
 FROM project_secrets ps
 JOIN sites s ON s.project_id = ps.project_id
 WHERE s.id = ?
 UNION
-SELECT DISTINCT os.name as `key`, os.vault_path as value
+SELECT DISTINCT os.name as `key`, os.vault_path as value, os.reference
 FROM organization_secrets os
 JOIN projects p ON p.organization_id = os.organization_id
 JOIN sites st ON st.project_id = p.id
@@ -19,6 +19,17 @@ INSERT INTO ssh_keys (
 );
 
 
+-- name: CreateEphemeralSshKey :exec
+-- Short-lived key for a browser terminal session; it stops being served to VMs at expires_at
+INSERT INTO ssh_keys (
+  public_id, account_id, public_key, `name`, fingerprint, expires_at, created_at, updated_at
+) VALUES (
+  UUID_TO_BIN(sqlc.arg(public_id)), sqlc.arg(account_id),
+  sqlc.arg(public_key), sqlc.arg(name), sqlc.arg(fingerprint), sqlc.arg(expires_at),
+  CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+);
+
+
 -- name: UpdateSshKey :execresult
 UPDATE ssh_keys SET
   `name` = ?,
@@ -31,14 +42,19 @@ DELETE FROM ssh_keys WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
 
 
 -- name: GetSshAccess :one
-SELECT id, account_id, site_id, created_at, updated_at, created_by, updated_by
+SELECT id, account_id, site_id, sftp_only, created_at, updated_at, created_by, updated_by
 FROM ssh_access WHERE account_id = ? AND site_id = ?;
 
 
 -- name: CreateSshAccess :exec
 INSERT INTO ssh_access (
-  account_id, site_id, created_at, updated_at, created_by, updated_by
-) VALUES (?, ?, NOW(), NOW(), ?, ?);
+  account_id, site_id, sftp_only, created_at, updated_at, created_by, updated_by
+) VALUES (?, ?, ?, NOW(), NOW(), ?, ?);
+
+
+-- name: UpdateSshAccessSftpOnly :exec
+UPDATE ssh_access SET sftp_only = ?, updated_by = ?
+WHERE account_id = ? AND site_id = ?;
 
 
 -- name: DeleteSshAccess :exec
@@ -0,0 +1,70 @@
+-- name: GetStatusPageByOrganization :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, slug, title, is_public, created_at, updated_at
+FROM status_pages
+WHERE organization_id = ?;
+
+-- name: GetStatusPageBySlug :one
+SELECT sp.id, BIN_TO_UUID(sp.public_id) AS public_id, sp.organization_id,
+       BIN_TO_UUID(o.public_id) AS organization_public_id, sp.slug, sp.title, sp.is_public
+FROM status_pages sp
+JOIN organizations o ON o.id = sp.organization_id
+WHERE sp.slug = ?;
+
+-- name: CreateStatusPage :exec
+INSERT INTO status_pages (public_id, organization_id, slug, title, is_public)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?);
+
+-- name: UpdateStatusPage :exec
+UPDATE status_pages
+SET slug = ?, title = ?, is_public = ?
+WHERE id = ?;
+
+-- name: DeleteStatusPage :exec
+DELETE FROM status_pages WHERE id = ?;
+
+-- name: ListStatusPageSites :many
+-- Listed sites in display order with the cause of their open incident, if any
+SELECT s.id AS site_id, BIN_TO_UUID(s.public_id) AS site_public_id, s.name AS site_name,
+       sps.display_name, i.cause AS incident_cause, i.opened_at AS incident_opened_at
+FROM status_page_sites sps
+JOIN sites s ON s.id = sps.site_id
+LEFT JOIN site_incidents i ON i.open_site_id = s.id
+WHERE sps.status_page_id = ?
+ORDER BY sps.position, s.name;
+
+-- name: DeleteStatusPageSites :exec
+DELETE FROM status_page_sites WHERE status_page_id = ?;
+
+-- name: AddStatusPageSite :exec
+INSERT INTO status_page_sites (status_page_id, site_id, display_name, position)
+VALUES (?, ?, ?, ?);
+
+-- name: GetOrganizationSite :one
+-- A site by public ID, only when it belongs to one of the organization's projects
+SELECT s.id, BIN_TO_UUID(s.public_id) AS public_id, s.name
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+WHERE s.public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND p.organization_id = sqlc.arg(organization_id);
+
+-- name: GetOrganizationSiteIncident :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id
+FROM site_incidents
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND organization_id = sqlc.arg(organization_id);
+
+-- name: CreateStatusPageUpdate :exec
+INSERT INTO status_page_updates (public_id, status_page_id, site_incident_id, status, title, message, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?);
+
+-- name: ListStatusPageUpdates :many
+-- Updates posted since a time, newest first. CONCAT_WS skips NULLs, so updates without an incident get ''
+SELECT BIN_TO_UUID(u.public_id) AS public_id, u.status, u.title, u.message,
+       CONCAT_WS('', BIN_TO_UUID(i.public_id)) AS incident_public_id, u.created_at
+FROM status_page_updates u
+LEFT JOIN site_incidents i ON i.id = u.site_incident_id
+WHERE u.status_page_id = ? AND u.created_at >= sqlc.arg(since)
+ORDER BY u.created_at DESC, u.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: DeleteStatusPageUpdate :execrows
+DELETE FROM status_page_updates
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND status_page_id = sqlc.arg(status_page_id);
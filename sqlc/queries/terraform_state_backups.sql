@@ -0,0 +1,19 @@
+-- name: CreateTerraformStateBackup :exec
+INSERT INTO terraform_state_backups (
+    public_id, organization_id, object, generation, source_generation, size_bytes, reason, created_by
+) VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetTerraformStateBackup :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, object, generation,
+       source_generation, size_bytes, reason, created_at
+FROM terraform_state_backups
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id)) AND organization_id = sqlc.arg(organization_id);
+
+-- name: ListTerraformStateBackups :many
+-- Newest first
+SELECT id, BIN_TO_UUID(public_id) AS public_id, organization_id, object, generation,
+       source_generation, size_bytes, reason, created_at
+FROM terraform_state_backups
+WHERE organization_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
@@ -0,0 +1,30 @@
+-- name: FinishTerraformStateMigration :execrows
+-- Records how a migration run ended; runs that aren't migrations match no rows
+UPDATE terraform_state_layouts
+SET layout = ?
+WHERE migration_run_id = sqlc.arg(run_id) AND layout = 'migrating';
+
+-- name: GetTerraformStateLayout :one
+SELECT organization_id, layout, migration_run_id, updated_at
+FROM terraform_state_layouts
+WHERE organization_id = ?;
+
+-- name: ListOrganizationStateWorkspaces :many
+-- Every project and site that gets a workspace of its own once the organization is isolated
+SELECT 'project' AS kind, p.id, BIN_TO_UUID(p.public_id) AS public_id
+FROM projects p
+WHERE p.organization_id = sqlc.arg(organization_id) AND p.status != 'deleted'
+UNION ALL
+SELECT 'site' AS kind, s.id, BIN_TO_UUID(s.public_id) AS public_id
+FROM sites s
+JOIN projects p ON p.id = s.project_id
+WHERE p.organization_id = sqlc.arg(organization_id) AND s.status != 'deleted'
+ORDER BY kind, id;
+
+-- name: StartTerraformStateMigration :execrows
+-- Marks a monolithic organization as migrating; 0 rows means it's already migrating or isolated
+INSERT INTO terraform_state_layouts (organization_id, layout, migration_run_id)
+VALUES (?, 'migrating', sqlc.arg(run_id))
+ON DUPLICATE KEY UPDATE
+    migration_run_id = IF(layout = 'monolithic', VALUES(migration_run_id), migration_run_id),
+    layout = IF(layout = 'monolithic', 'migrating', layout);
@@ -0,0 +1,41 @@
+-- name: UpsertSiteTlsSettings :exec
+-- Changing the policy makes the last probe stale
+INSERT INTO site_tls_policies (site_id, min_version, hsts_max_age_seconds, hsts_include_subdomains, hsts_preload, ocsp_stapling, updated_by)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    min_version = VALUES(min_version),
+    hsts_max_age_seconds = VALUES(hsts_max_age_seconds),
+    hsts_include_subdomains = VALUES(hsts_include_subdomains),
+    hsts_preload = VALUES(hsts_preload),
+    ocsp_stapling = VALUES(ocsp_stapling),
+    probe_status = 'pending',
+    probe_message = '',
+    updated_by = VALUES(updated_by);
+
+-- name: UpsertSiteTlsCertificate :exec
+INSERT INTO site_tls_policies (site_id, certificate_source, certificate_pem, private_key_vault_path, certificate_not_after, updated_by)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    certificate_source = VALUES(certificate_source),
+    certificate_pem = VALUES(certificate_pem),
+    private_key_vault_path = VALUES(private_key_vault_path),
+    certificate_not_after = VALUES(certificate_not_after),
+    probe_status = 'pending',
+    probe_message = '',
+    updated_by = VALUES(updated_by);
+
+-- name: GetSiteTlsPolicy :one
+SELECT id, site_id, min_version, hsts_max_age_seconds, hsts_include_subdomains, hsts_preload, ocsp_stapling,
+    certificate_source, certificate_pem, private_key_vault_path, certificate_not_after,
+    probe_status, probe_message, probed_at, updated_at
+FROM site_tls_policies
+WHERE site_id = ?;
+
+-- name: RecordSiteTlsProbe :exec
+UPDATE site_tls_policies
+SET probe_status = ?, probe_message = ?, probed_at = NOW()
+WHERE site_id = ?;
+
+-- name: InitSiteTlsPolicy :exec
+-- Creates a site's policy with the defaults so a probe can be recorded
+INSERT IGNORE INTO site_tls_policies (site_id) VALUES (?);
@@ -0,0 +1,80 @@
+-- name: ListOrganizationProjectUsage :many
+-- Billable configuration of every project in an organization with machine pricing.
+SELECT p.id, BIN_TO_UUID(p.public_id) AS public_id, p.name, p.machine_type, p.disk_size_gb,
+       p.stripe_subscription_item_id, mt.vcpu, mt.memory_gib, mt.monthly_price_cents
+FROM projects p
+LEFT JOIN machine_types mt ON mt.machine_type = p.machine_type
+WHERE p.organization_id = ? AND p.status <> 'deleted'
+ORDER BY p.name;
+
+-- name: UpsertProjectUsage :exec
+INSERT INTO usage_records (organization_id, project_id, metric, usage_date, quantity)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    quantity = VALUES(quantity),
+    updated_at = NOW();
+
+-- name: SumOrganizationProjectUsage :many
+-- Per-project totals of a counter metric since the given date.
+SELECT project_id, CAST(COALESCE(SUM(quantity), 0) AS SIGNED) AS total
+FROM usage_records
+WHERE organization_id = ? AND metric = ? AND usage_date >= ?
+GROUP BY project_id;
+
+-- name: LatestOrganizationProjectUsage :many
+-- Per-project most recent value of a gauge metric.
+SELECT u.project_id, u.quantity
+FROM usage_records u
+WHERE u.organization_id = sqlc.arg(organization_id) AND u.metric = sqlc.arg(metric)
+  AND u.usage_date = (
+      SELECT MAX(l.usage_date) FROM usage_records l
+      WHERE l.project_id = u.project_id AND l.metric = u.metric
+  );
+
+-- name: AddProjectUsage :exec
+-- Adds to a counter metric for the day.
+INSERT INTO usage_records (organization_id, project_id, metric, usage_date, quantity)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    quantity = quantity + VALUES(quantity),
+    updated_at = NOW();
+
+-- name: UpdateSiteDiskUsage :exec
+UPDATE sites SET disk_used_bytes = ? WHERE id = ?;
+
+-- name: SumProjectSiteDiskUsage :one
+-- Total data disk usage last reported by a project's sites.
+SELECT CAST(COALESCE(SUM(disk_used_bytes), 0) AS SIGNED) AS total
+FROM sites
+WHERE project_id = ? AND `status` <> 'deleted';
+
+-- name: ListOrganizationDailyUsage :many
+-- Per-day organization totals of a metric since the given date.
+SELECT usage_date, CAST(COALESCE(SUM(quantity), 0) AS SIGNED) AS total
+FROM usage_records
+WHERE organization_id = ? AND metric = ? AND usage_date >= ?
+GROUP BY usage_date
+ORDER BY usage_date;
+
+-- name: ListUsageTotalsForDate :many
+-- Per-organization totals of a metric on a single day.
+SELECT organization_id, CAST(COALESCE(SUM(quantity), 0) AS SIGNED) AS total
+FROM usage_records
+WHERE metric = ? AND usage_date = ?
+GROUP BY organization_id;
+
+-- name: ListMeteredPrices :many
+SELECT id, metric, stripe_price_id, stripe_meter_event_name, unit_bytes, unit_price_cents, included_units
+FROM metered_prices
+WHERE active = TRUE
+ORDER BY metric;
+
+-- name: UsageReportExists :one
+SELECT EXISTS(
+    SELECT 1 FROM usage_reports
+    WHERE organization_id = ? AND metric = ? AND usage_date = ?
+) AS reported;
+
+-- name: CreateUsageReport :exec
+INSERT INTO usage_reports (organization_id, metric, usage_date, quantity, units, stripe_subscription_item_id, stripe_identifier)
+VALUES (?, ?, ?, ?, ?, ?, ?);
@@ -0,0 +1,65 @@
+-- name: UpsertSiteWafConfig :exec
+INSERT INTO site_waf_configs (site_id, enabled, mode, paranoia_level, updated_by)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    enabled = VALUES(enabled),
+    mode = VALUES(mode),
+    paranoia_level = VALUES(paranoia_level),
+    updated_by = VALUES(updated_by);
+
+-- name: GetSiteWafConfig :one
+SELECT id, site_id, enabled, mode, paranoia_level, created_at, updated_at
+FROM site_waf_configs
+WHERE site_id = ?;
+
+-- name: CreateSiteWafRuleExclusion :exec
+INSERT INTO site_waf_rule_exclusions (public_id, site_id, rule_id, path_prefix, reason, created_by)
+VALUES (UUID_TO_BIN(sqlc.arg(public_id)), ?, ?, ?, ?, ?);
+
+-- name: GetSiteWafRuleExclusion :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, rule_id, path_prefix, reason, created_at
+FROM site_waf_rule_exclusions
+WHERE public_id = UUID_TO_BIN(sqlc.arg(public_id));
+
+-- name: GetSiteWafRuleExclusionByRule :one
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, rule_id, path_prefix, reason, created_at
+FROM site_waf_rule_exclusions
+WHERE site_id = ? AND rule_id = ? AND path_prefix = ?;
+
+-- name: ListSiteWafRuleExclusions :many
+SELECT id, BIN_TO_UUID(public_id) AS public_id, site_id, rule_id, path_prefix, reason, created_at
+FROM site_waf_rule_exclusions
+WHERE site_id = ?
+ORDER BY rule_id, path_prefix;
+
+-- name: CountSiteWafRuleExclusions :one
+SELECT COUNT(*) FROM site_waf_rule_exclusions WHERE site_id = ?;
+
+-- name: DeleteSiteWafRuleExclusion :exec
+DELETE FROM site_waf_rule_exclusions WHERE id = ?;
+
+-- name: CreateSiteWafBlock :exec
+INSERT INTO site_waf_blocks (site_id, rule_id, message, method, uri, client_ip, blocked, occurred_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteSiteWafBlocksBefore :exec
+DELETE FROM site_waf_blocks WHERE site_id = ? AND occurred_at < ?;
+
+-- name: SummarizeSiteWafBlocks :many
+-- Matches per rule since a time, the most frequent first
+SELECT
+    rule_id,
+    COUNT(*) AS matches,
+    CAST(SUM(blocked) AS SIGNED) AS blocked,
+    CAST(MAX(occurred_at) AS DATETIME) AS last_seen
+FROM site_waf_blocks
+WHERE site_id = ? AND occurred_at >= ?
+GROUP BY rule_id
+ORDER BY matches DESC, rule_id;
+
+-- name: ListRecentSiteWafBlocks :many
+SELECT id, site_id, rule_id, message, method, uri, client_ip, blocked, occurred_at
+FROM site_waf_blocks
+WHERE site_id = ? AND occurred_at >= ?
+ORDER BY occurred_at DESC, id DESC
+LIMIT ?;
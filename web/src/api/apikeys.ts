@@ -6,11 +6,12 @@ export async function listAPIKeys() {
   return response.apiKeys;
 }
 
-export async function createAPIKey(name: string, description?: string, scopes?: string[]) {
+export async function createAPIKey(name: string, description?: string, scopes?: string[], template?: string) {
   const response = await accountClient.createApiKey({
     name,
     description: description || "",
     scopes: scopes || [],
+    template: template || "",
   });
   return {
     apiKeyId: response.apiKeyId,
@@ -22,6 +23,11 @@ export async function createAPIKey(name: string, description?: string, scopes?:
   };
 }
 
+export async function listAPIKeyTemplates() {
+  const response = await accountClient.listApiKeyTemplates({});
+  return response.templates;
+}
+
 export async function revokeAPIKey(apiKeyId: string) {
   await accountClient.revokeApiKey({ apiKeyId });
 }
@@ -0,0 +1,34 @@
+// Billing: usage summary, payment methods, invoices, and the Stripe customer portal
+import { organizationClient } from "./client";
+
+export async function getUsage(organizationId: string) {
+  return await organizationClient.getOrganizationUsage({ organizationId });
+}
+
+// Usage-based charges (egress, disk) accrued so far this billing period
+export async function previewUsage(organizationId: string) {
+  return await organizationClient.previewUsage({ organizationId });
+}
+
+export async function listPaymentMethods(organizationId: string) {
+  const response = await organizationClient.listPaymentMethods({ organizationId });
+  return response.paymentMethods;
+}
+
+export async function setDefaultPaymentMethod(organizationId: string, paymentMethodId: string) {
+  const response = await organizationClient.setDefaultPaymentMethod({
+    organizationId,
+    paymentMethodId,
+  });
+  return response.paymentMethod;
+}
+
+export async function listInvoices(organizationId: string, pageToken = "") {
+  return await organizationClient.listInvoices({ organizationId, pageToken });
+}
+
+// Redirects the browser to a Stripe-hosted portal for changing cards and downloading invoices
+export async function openBillingPortal(organizationId: string) {
+  const response = await organizationClient.createBillingPortalSession({ organizationId });
+  window.location.href = response.url;
+}
@@ -0,0 +1,20 @@
+// Organization logos and colors
+import { brandingClient } from "./client";
+
+export async function getBranding(organizationId: string) {
+  const response = await brandingClient.getOrganizationBranding({ organizationId });
+  return response.branding;
+}
+
+export async function updateBranding(
+  organizationId: string,
+  changes: { primaryColor?: string; accentColor?: string; logo?: Uint8Array },
+) {
+  const paths: string[] = [];
+  if (changes.primaryColor !== undefined) paths.push("primary_color");
+  if (changes.accentColor !== undefined) paths.push("accent_color");
+  if (changes.logo !== undefined) paths.push("logo");
+
+  const response = await brandingClient.updateOrganizationBranding({ organizationId, ...changes, updateMask: { paths } });
+  return response.branding;
+}
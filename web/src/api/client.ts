@@ -9,10 +9,30 @@ import { SiteFirewallService } from "@proto/libops/v1/organization_api_connect";
 import { MemberService } from "@proto/libops/v1/organization_api_connect";
 import { ProjectMemberService } from "@proto/libops/v1/organization_api_connect";
 import { SiteMemberService } from "@proto/libops/v1/organization_api_connect";
+import { SiteOperationsService } from "@proto/libops/v1/organization_api_connect";
 import { SshKeyService } from "@proto/libops/v1/organization_api_connect";
 import { AccountService } from "@proto/libops/v1/organization_account_api_connect";
 import { OrganizationSecretService, ProjectSecretService, SiteSecretService } from "@proto/libops/v1/secrets_connect";
 import { OrganizationSettingService, ProjectSettingService, SiteSettingService } from "@proto/libops/v1/settings_connect";
+import { CatalogService } from "@proto/libops/v1/catalog_connect";
+import { NotificationService } from "@proto/libops/v1/notification_connect";
+import { NotificationChannelService } from "@proto/libops/v1/notification_channel_connect";
+import { EventSinkService } from "@proto/libops/v1/event_sink_connect";
+import { UptimeService } from "@proto/libops/v1/uptime_connect";
+import { BrandingService } from "@proto/libops/v1/branding_connect";
+import { ExportService } from "@proto/libops/v1/export_connect";
+import { OwnershipService } from "@proto/libops/v1/ownership_connect";
+import { SiteEgressService } from "@proto/libops/v1/egress_connect";
+import { SiteCdnService } from "@proto/libops/v1/cdn_connect";
+import { SshAccessService } from "@proto/libops/v1/ssh_access_connect";
+import { ElevationService } from "@proto/libops/v1/elevation_connect";
+import { SiteConfigVarService } from "@proto/libops/v1/config_var_connect";
+import { RelationshipService } from "@proto/libops/v1/relationship_connect";
+import { PolicyService } from "@proto/libops/v1/policy_connect";
+import { IpAllowlistService } from "@proto/libops/v1/ip_allowlist_connect";
+import { EncryptionKeyService } from "@proto/libops/v1/encryption_key_connect";
+import { OrganizationApiKeyService } from "@proto/libops/v1/organization_api_key_connect";
+import { JoinPolicyService, SignupService } from "@proto/libops/v1/signup_connect";
 import { errorInterceptor, loggingInterceptor, loadingInterceptor, retryInterceptor } from "./interceptors";
 
 // Determine if we're in development mode (defaults to production)
@@ -51,6 +71,8 @@ export const projectMemberClient = createPromiseClient(ProjectMemberService, tra
 
 export const siteMemberClient = createPromiseClient(SiteMemberService, transport);
 
+export const siteOperationsClient = createPromiseClient(SiteOperationsService, transport);
+
 export const sshKeyClient = createPromiseClient(SshKeyService, transport);
 
 export const accountClient = createPromiseClient(AccountService, transport);
@@ -66,3 +88,35 @@ export const organizationSettingClient = createPromiseClient(OrganizationSetting
 export const projectSettingClient = createPromiseClient(ProjectSettingService, transport);
 
 export const siteSettingClient = createPromiseClient(SiteSettingService, transport);
+
+export const catalogClient = createPromiseClient(CatalogService, transport);
+
+export const notificationClient = createPromiseClient(NotificationService, transport);
+
+export const notificationChannelClient = createPromiseClient(NotificationChannelService, transport);
+export const eventSinkClient = createPromiseClient(EventSinkService, transport);
+
+export const uptimeClient = createPromiseClient(UptimeService, transport);
+
+export const brandingClient = createPromiseClient(BrandingService, transport);
+
+export const exportClient = createPromiseClient(ExportService, transport);
+
+export const ownershipClient = createPromiseClient(OwnershipService, transport);
+
+export const siteEgressClient = createPromiseClient(SiteEgressService, transport);
+
+export const siteCdnClient = createPromiseClient(SiteCdnService, transport);
+
+export const sshAccessClient = createPromiseClient(SshAccessService, transport);
+export const elevationClient = createPromiseClient(ElevationService, transport);
+
+export const siteConfigVarClient = createPromiseClient(SiteConfigVarService, transport);
+
+export const relationshipClient = createPromiseClient(RelationshipService, transport);
+export const policyClient = createPromiseClient(PolicyService, transport);
+export const ipAllowlistClient = createPromiseClient(IpAllowlistService, transport);
+export const encryptionKeyClient = createPromiseClient(EncryptionKeyService, transport);
+export const organizationApiKeyClient = createPromiseClient(OrganizationApiKeyService, transport);
+export const joinPolicyClient = createPromiseClient(JoinPolicyService, transport);
+export const signupClient = createPromiseClient(SignupService, transport);
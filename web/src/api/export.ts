@@ -0,0 +1,17 @@
+// Organization export bundles for leaving libops
+import { exportClient } from "./client";
+
+export async function exportOrganization(organizationId: string, includeSecrets: boolean) {
+  const response = await exportClient.exportOrganization({ organizationId, includeSecrets });
+  return response.export;
+}
+
+export async function getExport(organizationId: string, exportId: string) {
+  const response = await exportClient.getOrganizationExport({ organizationId, exportId });
+  return response.export;
+}
+
+export async function listExports(organizationId: string) {
+  const response = await exportClient.listOrganizationExports({ organizationId, pageSize: 10 });
+  return response.exports;
+}
@@ -0,0 +1,21 @@
+// In-app notifications for the signed-in user
+import { notificationClient } from "./client";
+
+export async function listNotifications(pageToken = "", unreadOnly = false) {
+  return await notificationClient.listNotifications({ pageToken, unreadOnly, pageSize: 20 });
+}
+
+export async function markNotificationsRead(notificationIds: string[]) {
+  const response = await notificationClient.markNotificationsRead({ notificationIds });
+  return Number(response.unreadCount);
+}
+
+export async function markAllNotificationsRead() {
+  const response = await notificationClient.markNotificationsRead({ all: true });
+  return Number(response.unreadCount);
+}
+
+export async function getUnreadNotificationCount() {
+  const response = await notificationClient.getUnreadNotificationCount({});
+  return Number(response.unreadCount);
+}
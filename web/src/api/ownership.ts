@@ -0,0 +1,22 @@
+// Organization ownership transfers
+import { ownershipClient } from "./client";
+
+export async function transferOwnership(organizationId: string, newOwnerId: string) {
+  const response = await ownershipClient.transferOrganizationOwnership({ organizationId, newOwnerId });
+  return response.transfer;
+}
+
+export async function getOwnershipTransfer(organizationId: string) {
+  const response = await ownershipClient.getOrganizationOwnershipTransfer({ organizationId });
+  return response.transfer;
+}
+
+export async function acceptOwnershipTransfer(organizationId: string, transferId: string) {
+  const response = await ownershipClient.acceptOrganizationOwnershipTransfer({ organizationId, transferId });
+  return response.transfer;
+}
+
+export async function cancelOwnershipTransfer(organizationId: string, transferId: string) {
+  const response = await ownershipClient.cancelOrganizationOwnershipTransfer({ organizationId, transferId });
+  return response.transfer;
+}
@@ -0,0 +1,21 @@
+// Dashboard preferences of the signed-in user
+import { accountClient } from "./client";
+
+export async function getPreferences() {
+  const response = await accountClient.getAccountPreferences({});
+  return response.preferences;
+}
+
+export async function updatePreferences(
+  changes: { theme?: string; timezone?: string; defaultOrganizationId?: string; pageSize?: number; locale?: string },
+) {
+  const paths: string[] = [];
+  if (changes.theme !== undefined) paths.push("theme");
+  if (changes.timezone !== undefined) paths.push("timezone");
+  if (changes.defaultOrganizationId !== undefined) paths.push("default_organization_id");
+  if (changes.pageSize !== undefined) paths.push("page_size");
+  if (changes.locale !== undefined) paths.push("locale");
+
+  const response = await accountClient.updateAccountPreferences({ ...changes, updateMask: { paths } });
+  return response.preferences;
+}
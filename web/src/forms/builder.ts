@@ -1,9 +1,10 @@
 // Proto-based form builder
 
 import { getPageContext } from "@/utils/context";
-import { showLoadingModal, closeModal } from "@/utils/modal";
+import { showLoadingModal, closeModal, openModal } from "@/utils/modal";
 import { showNotification, capitalize, singularize } from "@/utils/helpers";
-import { organizationClient, projectClient } from "@/api/client";
+import { cachedPreferences } from "@/utils/preferences";
+import { organizationClient, projectClient, siteClient } from "@/api/client";
 import {
   createOrganization,
   createProject,
@@ -12,12 +13,14 @@ import {
   createMember,
   createSecret,
   createSetting,
+  createNotificationChannel,
   updateOrganization,
   updateProject,
   updateSite,
   updateMember,
   updateSecret,
   updateSetting,
+  isConflict,
 } from "@/resources/operations";
 
 interface FormField {
@@ -86,6 +89,13 @@ const formDefinitions: Record<string, FormField[]> = {
       required: false,
       placeholder: "Enter description (optional)",
     },
+    {
+      name: "labels",
+      label: "Labels",
+      type: "textarea",
+      required: false,
+      placeholder: "One key=value per line, e.g. department=library",
+    },
   ],
   project: [
     {
@@ -179,6 +189,13 @@ const formDefinitions: Record<string, FormField[]> = {
       type: "checkbox",
       required: false,
     },
+    {
+      name: "labels",
+      label: "Labels",
+      type: "textarea",
+      required: false,
+      placeholder: "One key=value per line, e.g. env=prod",
+    },
   ],
   site: [
     {
@@ -223,6 +240,13 @@ const formDefinitions: Record<string, FormField[]> = {
       required: false,
       placeholder: "80",
     },
+    {
+      name: "labels",
+      label: "Labels",
+      type: "textarea",
+      required: false,
+      placeholder: "One key=value per line, e.g. env=prod",
+    },
   ],
   firewall: [
     {
@@ -253,11 +277,11 @@ const formDefinitions: Record<string, FormField[]> = {
   ],
   member: [
     {
-      name: "account_id",
-      label: "Account ID",
-      type: "text",
+      name: "email",
+      label: "Email",
+      type: "email",
       required: true,
-      placeholder: "Enter account ID",
+      placeholder: "someone@example.org",
     },
     {
       name: "role",
@@ -310,8 +334,96 @@ const formDefinitions: Record<string, FormField[]> = {
       placeholder: "Optional description of this setting",
     },
   ],
+  channel: [
+    {
+      name: "name",
+      label: "Channel Name",
+      type: "text",
+      required: true,
+      placeholder: "e.g., #ops alerts",
+    },
+    {
+      name: "kind",
+      label: "Type",
+      type: "select",
+      required: true,
+      options: [
+        { value: "1", label: "Slack incoming webhook" },
+        { value: "2", label: "Slack app" },
+        { value: "3", label: "Microsoft Teams webhook" },
+        { value: "4", label: "PagerDuty (downtime only)" },
+        { value: "5", label: "Opsgenie (downtime only)" },
+      ],
+    },
+    {
+      name: "webhook_url",
+      label: "Webhook URL (Slack or Teams webhooks)",
+      type: "url",
+      required: false,
+      placeholder: "https://hooks.slack.com/services/...",
+    },
+    {
+      name: "slack_bot_token",
+      label: "Bot Token (Slack app)",
+      type: "password",
+      required: false,
+      placeholder: "xoxb-...",
+    },
+    {
+      name: "slack_channel",
+      label: "Channel (Slack app)",
+      type: "text",
+      required: false,
+      placeholder: "#ops or C0123456789",
+    },
+    {
+      name: "integration_key",
+      label: "Integration Key (PagerDuty routing key or Opsgenie API key)",
+      type: "password",
+      required: false,
+    },
+    {
+      name: "category_deployments",
+      label: "Deployments",
+      type: "checkbox",
+      required: false,
+    },
+    {
+      name: "category_reconciliation_failures",
+      label: "Reconciliation failures",
+      type: "checkbox",
+      required: false,
+    },
+    {
+      name: "category_billing",
+      label: "Billing",
+      type: "checkbox",
+      required: false,
+    },
+    {
+      name: "category_security",
+      label: "Security (members, firewall rules and secrets)",
+      type: "checkbox",
+      required: false,
+    },
+    {
+      name: "category_downtime",
+      label: "Downtime (sites going down and recovering)",
+      type: "checkbox",
+      required: false,
+    },
+  ],
 };
 
+// Alert category checkboxes on the channel form, in AlertCategory enum order
+const alertCategoryFields = [
+  "category_deployments",
+  "category_reconciliation_failures",
+  "category_billing",
+  "category_security",
+  "category_downtime",
+];
+
 function createFormField(field: FormField): HTMLElement {
   const div = document.createElement("div");
   div.className = "mb-4";
@@ -395,15 +507,29 @@ async function buildForm(resourceType: string, onSubmit: (data: Record<string, a
     return form;
   }
 
+  // Only offer the roles the page says the user may assign
+  if (resourceType === "member" && document.body.dataset.memberRoles) {
+    const allowedRoles = document.body.dataset.memberRoles.split(",");
+    fields = fields.map((field) =>
+      field.name === "role" && field.options
+        ? { ...field, options: field.options.filter((opt) => allowedRoles.includes(opt.value)) }
+        : field
+    );
+  }
+
   // Add dynamic organization dropdown for projects (only when no org context)
   const context = getPageContext();
   if (resourceType === "project" && !context.organizationId) {
     try {
       const orgsResponse = await organizationClient.listOrganizations({});
-      const orgOptions = orgsResponse.organizations.map((org) => ({
-        value: org.organizationId,
-        label: org.organizationName,
-      }));
+      // The account's default organization is listed first so it is preselected
+      const defaultOrg = cachedPreferences().defaultOrganizationId;
+      const orgOptions = orgsResponse.organizations
+        .map((org) => ({
+          value: org.organizationId,
+          label: org.organizationName,
+        }))
+        .sort((a, b) => Number(b.value === defaultOrg) - Number(a.value === defaultOrg));
       fields = [
         {
           name: "organization_id",
@@ -444,7 +570,25 @@ async function buildForm(resourceType: string, onSubmit: (data: Record<string, a
 
   // Add resource selector for shared resources (firewall, member, secret, setting)
   const sharedResources = ["firewall", "member", "secret", "setting"];
-  if (sharedResources.includes(resourceType) && !context.organizationId && !context.projectId && !context.siteId) {
+
+  // The members page lists the resources the user can add members to
+  const memberTargets = document.getElementById("member-targets");
+  if (resourceType === "member" && memberTargets) {
+    const targets: { Type: string; ID: string; Name: string }[] = JSON.parse(memberTargets.textContent || "[]");
+    fields = [
+      {
+        name: "resource_selector",
+        label: "Add To",
+        type: "select",
+        required: true,
+        options: targets.map((target) => ({
+          value: `${target.Type === "organization" ? "org" : target.Type}:${target.ID}`,
+          label: `${capitalize(target.Type)}: ${target.Name}`,
+        })),
+      },
+      ...fields,
+    ];
+  } else if (sharedResources.includes(resourceType) && !context.organizationId && !context.projectId && !context.siteId) {
     try {
       const orgsResponse = await organizationClient.listOrganizations({});
       const projectsResponse = await projectClient.listProjects({});
@@ -655,7 +799,7 @@ export async function openCreateModal(resourceType: string) {
             organizationId: data.organizationId || context.organizationId || undefined,
             projectId: data.projectId || context.projectId || undefined,
             siteId: data.siteId || context.siteId || undefined,
-            accountId: data.account_id,
+            email: data.email,
             role: data.role,
           });
           break;
@@ -679,6 +823,24 @@ export async function openCreateModal(resourceType: string) {
             editable: true,
           });
           break;
+        case "channel":
+          if (!context.organizationId) {
+            showNotification("error", "Organization ID not found");
+            return;
+          }
+          await createNotificationChannel({
+            organizationId: context.organizationId,
+            name: data.name,
+            kind: parseInt(data.kind),
+            webhookUrl: data.webhook_url,
+            slackBotToken: data.slack_bot_token,
+            slackChannel: data.slack_channel,
+            integrationKey: data.integration_key,
+            categories: alertCategoryFields
+              .map((name, i) => (data[name] ? i + 1 : 0))
+              .filter((category) => category > 0),
+          });
+          break;
         default:
           showNotification("error", `Unknown resource type: ${singularType}`);
       }
@@ -696,74 +858,205 @@ export async function openCreateModal(resourceType: string) {
   }, 100);
 }
 
-export function openEditModal(resourceType: string, resourceId: string) {
+// Editable is a resource as the edit form loaded it: its form values and the
+// etag to send back so the update can't overwrite someone else's change.
+interface Editable {
+  values: Record<string, string>;
+  etag: string;
+}
+
+function formatLabels(labels: { [key: string]: string } = {}): string {
+  return Object.entries(labels)
+    .map(([key, value]) => `${key}=${value}`)
+    .join("\n");
+}
+
+// loadEditable fetches the current organization, project or site. Other
+// resources aren't versioned and open with an empty form.
+async function loadEditable(singularType: string, resourceId: string): Promise<Editable | null> {
+  switch (singularType) {
+    case "organization": {
+      const { folder } = await organizationClient.getOrganization({ organizationId: resourceId });
+      return {
+        values: { name: folder?.organizationName || "", labels: formatLabels(folder?.labels) },
+        etag: folder?.etag || "",
+      };
+    }
+    case "project": {
+      const { project } = await projectClient.getProject({
+        organizationId: getPageContext().organizationId || "",
+        projectId: resourceId,
+      });
+      return {
+        values: { name: project?.projectName || "", labels: formatLabels(project?.labels) },
+        etag: project?.etag || "",
+      };
+    }
+    case "site": {
+      const { site } = await siteClient.getSite({ siteId: resourceId });
+      return {
+        values: {
+          name: site?.siteName || "",
+          github_repository: site?.githubRepository || "",
+          github_ref: site?.githubRef || "",
+          compose_path: site?.composePath || "",
+          compose_file: site?.composeFile || "",
+          port: site?.port ? String(site.port) : "",
+          labels: formatLabels(site?.labels),
+        },
+        etag: site?.etag || "",
+      };
+    }
+    default:
+      return null;
+  }
+}
+
+// saveEdit submits the edit form. Organizations, projects and sites are only
+// updated if nobody changed them since loaded was read; otherwise the user
+// decides how to resolve the conflict.
+async function saveEdit(singularType: string, resourceId: string, data: Record<string, any>, loaded: Editable | null) {
   const context = getPageContext();
-  const singularType = singularize(resourceType);
+  const etag = loaded?.etag;
 
-  showLoadingModal(`Edit ${capitalize(singularType)}`);
+  try {
+    switch (singularType) {
+      case "organization":
+        await updateOrganization(resourceId, { ...data, etag });
+        break;
+      case "project":
+        if (!context.organizationId) {
+          showNotification("error", "Organization ID not found");
+          return;
+        }
+        await updateProject(context.organizationId, resourceId, { ...data, etag });
+        break;
+      case "site":
+        await updateSite(resourceId, { ...data, etag });
+        break;
+      case "member":
+        await updateMember({
+          organizationId: context.organizationId || undefined,
+          projectId: context.projectId || undefined,
+          siteId: context.siteId || undefined,
+          accountId: resourceId,
+          role: data.role,
+        });
+        break;
+      case "secret":
+        await updateSecret({
+          organizationId: context.organizationId || undefined,
+          projectId: context.projectId || undefined,
+          siteId: context.siteId || undefined,
+          secretId: resourceId,
+          name: data.name,
+          value: data.value,
+        });
+        break;
+      case "setting":
+        await updateSetting({
+          organizationId: context.organizationId || undefined,
+          projectId: context.projectId || undefined,
+          siteId: context.siteId || undefined,
+          settingId: resourceId,
+          key: data.key,
+          value: data.value,
+          description: data.description || "",
+          editable: true,
+        });
+        break;
+      default:
+        showNotification("error", `Unknown resource type: ${singularType}`);
+    }
+  } catch (error) {
+    if (!loaded || !isConflict(error)) {
+      throw error;
+    }
+    showConflict(singularType, resourceId, data, loaded);
+  }
+}
 
-  // In a real implementation, you would fetch the current data here
-  // For now, we'll just show an empty form
-  setTimeout(() => {
-    const form = buildForm(singularType, async (data) => {
-      switch (singularType) {
-        case "organization":
-          await updateOrganization(resourceId, data);
-          break;
-        case "project":
-          if (!context.organizationId) {
-            showNotification("error", "Organization ID not found");
-            return;
-          }
-          await updateProject(context.organizationId, resourceId, data);
-          break;
-        case "site":
-          await updateSite(resourceId, data);
-          break;
-        case "member":
-          await updateMember({
-            organizationId: context.organizationId || undefined,
-            projectId: context.projectId || undefined,
-            siteId: context.siteId || undefined,
-            accountId: resourceId,
-            role: data.role,
-          });
-          break;
-        case "secret":
-          await updateSecret({
-            organizationId: context.organizationId || undefined,
-            projectId: context.projectId || undefined,
-            siteId: context.siteId || undefined,
-            secretId: resourceId,
-            name: data.name,
-            value: data.value,
-          });
-          break;
-        case "setting":
-          await updateSetting({
-            organizationId: context.organizationId || undefined,
-            projectId: context.projectId || undefined,
-            siteId: context.siteId || undefined,
-            settingId: resourceId,
-            key: data.key,
-            value: data.value,
-            description: data.description || "",
-            editable: true,
-          });
-          break;
-        default:
-          showNotification("error", `Unknown resource type: ${singularType}`);
+// showConflict asks what to do with an edit someone else's change got in ahead
+// of. Overwriting resubmits it against the latest etag; reviewing reopens the
+// form on the latest values with the fields the user changed still changed.
+function showConflict(singularType: string, resourceId: string, mine: Record<string, any>, base: Editable) {
+  const content = document.createElement("div");
+  content.className = "space-y-4";
+
+  const message = document.createElement("p");
+  message.className = "text-sm text-gray-700";
+  message.textContent = `Someone else changed this ${singularType} after you opened it. Review their changes to merge them with yours, or overwrite them with your version.`;
+  content.appendChild(message);
+
+  const buttonDiv = document.createElement("div");
+  buttonDiv.className = "flex justify-end space-x-2";
+
+  const reviewButton = document.createElement("button");
+  reviewButton.type = "button";
+  reviewButton.className = "px-4 py-2 bg-gray-300 text-gray-700 rounded-md hover:bg-gray-400";
+  reviewButton.textContent = "Review their changes";
+  reviewButton.onclick = async () => {
+    const latest = await loadEditable(singularType, resourceId);
+    if (!latest) {
+      return;
+    }
+    const merged = { ...latest.values };
+    for (const [name, value] of Object.entries(mine)) {
+      if (name in base.values && value !== base.values[name]) {
+        merged[name] = value;
       }
-    });
+    }
+    await showEditForm(singularType, resourceId, latest, merged);
+  };
 
-    const modal = document.getElementById("modal");
-    const titleElement = document.getElementById("modal-title");
-    const contentElement = document.getElementById("modal-content");
+  const overwriteButton = document.createElement("button");
+  overwriteButton.type = "button";
+  overwriteButton.className = "px-4 py-2 bg-red-900 text-white rounded-md hover:bg-red-950";
+  overwriteButton.textContent = "Overwrite with mine";
+  overwriteButton.onclick = async () => {
+    const latest = await loadEditable(singularType, resourceId);
+    if (latest) {
+      await saveEdit(singularType, resourceId, mine, latest);
+    }
+  };
 
-    if (modal && titleElement && contentElement) {
-      titleElement.textContent = `Edit ${capitalize(singularType)}`;
-      contentElement.innerHTML = "";
-      contentElement.appendChild(form);
+  buttonDiv.appendChild(reviewButton);
+  buttonDiv.appendChild(overwriteButton);
+  content.appendChild(buttonDiv);
+
+  openModal(`Edit ${capitalize(singularType)}`, content);
+}
+
+// showEditForm opens the edit form filled with values, which are the loaded
+// resource's own unless a conflict review merged in the user's edits.
+async function showEditForm(
+  singularType: string,
+  resourceId: string,
+  loaded: Editable | null,
+  values: Record<string, string> = loaded?.values || {}
+) {
+  const form = await buildForm(singularType, (data) => saveEdit(singularType, resourceId, data, loaded));
+  for (const [name, value] of Object.entries(values)) {
+    const input = form.elements.namedItem(name);
+    if (input instanceof HTMLInputElement || input instanceof HTMLTextAreaElement) {
+      input.value = value;
+    }
+  }
+
+  openModal(`Edit ${capitalize(singularType)}`, form);
+}
+
+export function openEditModal(resourceType: string, resourceId: string) {
+  const singularType = singularize(resourceType);
+
+  showLoadingModal(`Edit ${capitalize(singularType)}`);
+
+  setTimeout(async () => {
+    try {
+      await showEditForm(singularType, resourceId, await loadEditable(singularType, resourceId));
+    } catch {
+      // The error interceptor has already reported why it couldn't be loaded
+      closeModal();
     }
   }, 100);
 }
@@ -2,26 +2,95 @@
 
 import { initializeModal, closeModal } from "@/utils/modal";
 import { openCreateModal, openEditModal } from "@/forms/builder";
-import { deleteResource } from "@/resources/operations";
+import {
+  approveElevation,
+  changeMemberRole,
+  changeSshAccess,
+  compareConfigVars,
+  deleteConfigVar,
+  deleteResource,
+  deploySite,
+  disableSiteCdn,
+  editConfigVar,
+  editHealthCheckPath,
+  enableSiteCdn,
+  setNotificationChannelEnabled,
+  purgeSiteCache,
+  rejectElevation,
+  releaseStaticEgressIp,
+  removeMember,
+  requestElevation,
+  requestStaticEgressIp,
+  revokeElevation,
+  rollbackSite,
+  setConfigVar,
+  testNotificationChannel,
+  setEncryptionKey,
+  checkEncryptionKeys,
+  deleteEncryptionKey,
+} from "@/resources/operations";
 import { copyToClipboard } from "@/utils/helpers";
+import { initNotificationCenter } from "@/utils/notification-center";
+import { initLiveStatus } from "@/utils/live-status";
+import { initSearch } from "@/utils/search";
+import { toggleTerminal } from "@/utils/terminal";
+import { initPreferences, openPreferences } from "@/utils/preferences";
+import { openBranding } from "@/utils/branding";
+import { openExports } from "@/utils/export";
+import { openOwnership } from "@/utils/ownership";
 import * as apiKeys from "@/api/apikeys";
 import * as sshKeys from "@/api/sshkeys";
+import * as billing from "@/api/billing";
 
 // Initialize the application
 function init() {
   console.log("Dashboard application initialized");
   initializeModal();
+  initNotificationCenter();
+  initLiveStatus();
+  initSearch();
+  initPreferences();
 
   // Make functions available globally for inline onclick handlers
   (window as any).openCreateModal = openCreateModal;
   (window as any).openEditModal = openEditModal;
   (window as any).deleteResource = deleteResource;
+  (window as any).changeMemberRole = changeMemberRole;
+  (window as any).changeSshAccess = changeSshAccess;
+  (window as any).removeMember = removeMember;
   (window as any).copyToClipboard = copyToClipboard;
   (window as any).closeModal = closeModal;
+  (window as any).setNotificationChannelEnabled = setNotificationChannelEnabled;
+  (window as any).testNotificationChannel = testNotificationChannel;
+  (window as any).setEncryptionKey = setEncryptionKey;
+  (window as any).checkEncryptionKeys = checkEncryptionKeys;
+  (window as any).deleteEncryptionKey = deleteEncryptionKey;
+  (window as any).editHealthCheckPath = editHealthCheckPath;
+  (window as any).setConfigVar = setConfigVar;
+  (window as any).editConfigVar = editConfigVar;
+  (window as any).deleteConfigVar = deleteConfigVar;
+  (window as any).compareConfigVars = compareConfigVars;
+  (window as any).deploySite = deploySite;
+  (window as any).rollbackSite = rollbackSite;
+  (window as any).requestStaticEgressIp = requestStaticEgressIp;
+  (window as any).releaseStaticEgressIp = releaseStaticEgressIp;
+  (window as any).enableSiteCdn = enableSiteCdn;
+  (window as any).disableSiteCdn = disableSiteCdn;
+  (window as any).purgeSiteCache = purgeSiteCache;
+  (window as any).requestElevation = requestElevation;
+  (window as any).approveElevation = approveElevation;
+  (window as any).rejectElevation = rejectElevation;
+  (window as any).revokeElevation = revokeElevation;
+  (window as any).toggleTerminal = toggleTerminal;
+  (window as any).openPreferences = openPreferences;
+  (window as any).openBranding = openBranding;
+  (window as any).openExports = openExports;
+  (window as any).openOwnership = openOwnership;
 
   // API management functions
   (window as any).apiKeys = apiKeys;
   (window as any).sshKeys = sshKeys;
+  (window as any).billing = billing;
 }
 
 // Run initialization when DOM is ready
@@ -0,0 +1,59 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/access_protection.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetSiteAccessProtectionRequest, GetSiteAccessProtectionResponse, ResetSiteAccessProtectionRequest, SetSiteAccessProtectionRequest, SetSiteAccessProtectionResponse } from "./access_protection_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SiteAccessProtectionService manages who can reach a site over HTTP. The
+ * site's controller puts its nginx behind basic auth or a members only gate
+ * that signs people in through libops, so staging sites aren't publicly
+ * crawlable. Sites that aren't production are members only until set
+ * otherwise. Changes reach the site with its next reconciliation, which each
+ * change queues.
+ *
+ * @generated from service libops.v1.SiteAccessProtectionService
+ */
+export const SiteAccessProtectionService = {
+  typeName: "libops.v1.SiteAccessProtectionService",
+  methods: {
+    /**
+     * Get a site's access protection
+     *
+     * @generated from rpc libops.v1.SiteAccessProtectionService.GetSiteAccessProtection
+     */
+    getSiteAccessProtection: {
+      name: "GetSiteAccessProtection",
+      I: GetSiteAccessProtectionRequest,
+      O: GetSiteAccessProtectionResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set a site's access protection
+     *
+     * @generated from rpc libops.v1.SiteAccessProtectionService.SetSiteAccessProtection
+     */
+    setSiteAccessProtection: {
+      name: "SetSiteAccessProtection",
+      I: SetSiteAccessProtectionRequest,
+      O: SetSiteAccessProtectionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Put a site back on its default access protection
+     *
+     * @generated from rpc libops.v1.SiteAccessProtectionService.ResetSiteAccessProtection
+     */
+    resetSiteAccessProtection: {
+      name: "ResetSiteAccessProtection",
+      I: ResetSiteAccessProtectionRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,319 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/access_protection.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.SiteAccessMode
+ */
+export enum SiteAccessMode {
+  /**
+   * @generated from enum value: SITE_ACCESS_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Anyone can reach the site
+   *
+   * @generated from enum value: SITE_ACCESS_MODE_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * HTTP basic auth with one shared username and password
+   *
+   * @generated from enum value: SITE_ACCESS_MODE_BASIC_AUTH = 2;
+   */
+  BASIC_AUTH = 2,
+
+  /**
+   * Only people with read access to the site, signed in through libops
+   *
+   * @generated from enum value: SITE_ACCESS_MODE_MEMBERS_ONLY = 3;
+   */
+  MEMBERS_ONLY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SiteAccessMode)
+proto3.util.setEnumType(SiteAccessMode, "libops.v1.SiteAccessMode", [
+  { no: 0, name: "SITE_ACCESS_MODE_UNSPECIFIED" },
+  { no: 1, name: "SITE_ACCESS_MODE_NONE" },
+  { no: 2, name: "SITE_ACCESS_MODE_BASIC_AUTH" },
+  { no: 3, name: "SITE_ACCESS_MODE_MEMBERS_ONLY" },
+]);
+
+/**
+ * @generated from message libops.v1.SiteAccessProtection
+ */
+export class SiteAccessProtection extends Message<SiteAccessProtection> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.SiteAccessMode mode = 2;
+   */
+  mode = SiteAccessMode.UNSPECIFIED;
+
+  /**
+   * @generated from field: string basic_auth_username = 3;
+   */
+  basicAuthUsername = "";
+
+  /**
+   * The site hasn't been set; mode is members only unless the site is production
+   *
+   * @generated from field: bool is_default = 4;
+   */
+  isDefault = false;
+
+  /**
+   * Unix timestamp; 0 while is_default
+   *
+   * @generated from field: int64 updated_at = 5;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteAccessProtection>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteAccessProtection";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mode", kind: "enum", T: proto3.getEnumType(SiteAccessMode) },
+    { no: 3, name: "basic_auth_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "is_default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteAccessProtection {
+    return new SiteAccessProtection().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteAccessProtection {
+    return new SiteAccessProtection().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteAccessProtection {
+    return new SiteAccessProtection().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteAccessProtection | PlainMessage<SiteAccessProtection> | undefined, b: SiteAccessProtection | PlainMessage<SiteAccessProtection> | undefined): boolean {
+    return proto3.util.equals(SiteAccessProtection, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteAccessProtectionRequest
+ */
+export class GetSiteAccessProtectionRequest extends Message<GetSiteAccessProtectionRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteAccessProtectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteAccessProtectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteAccessProtectionRequest {
+    return new GetSiteAccessProtectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteAccessProtectionRequest {
+    return new GetSiteAccessProtectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteAccessProtectionRequest {
+    return new GetSiteAccessProtectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteAccessProtectionRequest | PlainMessage<GetSiteAccessProtectionRequest> | undefined, b: GetSiteAccessProtectionRequest | PlainMessage<GetSiteAccessProtectionRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteAccessProtectionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteAccessProtectionResponse
+ */
+export class GetSiteAccessProtectionResponse extends Message<GetSiteAccessProtectionResponse> {
+  /**
+   * @generated from field: libops.v1.SiteAccessProtection access_protection = 1;
+   */
+  accessProtection?: SiteAccessProtection;
+
+  constructor(data?: PartialMessage<GetSiteAccessProtectionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteAccessProtectionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_protection", kind: "message", T: SiteAccessProtection },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteAccessProtectionResponse {
+    return new GetSiteAccessProtectionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteAccessProtectionResponse {
+    return new GetSiteAccessProtectionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteAccessProtectionResponse {
+    return new GetSiteAccessProtectionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteAccessProtectionResponse | PlainMessage<GetSiteAccessProtectionResponse> | undefined, b: GetSiteAccessProtectionResponse | PlainMessage<GetSiteAccessProtectionResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteAccessProtectionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetSiteAccessProtectionRequest
+ */
+export class SetSiteAccessProtectionRequest extends Message<SetSiteAccessProtectionRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.SiteAccessMode mode = 2;
+   */
+  mode = SiteAccessMode.UNSPECIFIED;
+
+  /**
+   * Required for basic auth
+   *
+   * @generated from field: string basic_auth_username = 3;
+   */
+  basicAuthUsername = "";
+
+  /**
+   * Required when switching to basic auth or changing the username; left
+   * empty, the current password is kept. At least 12 characters.
+   *
+   * @generated from field: string basic_auth_password = 4;
+   */
+  basicAuthPassword = "";
+
+  constructor(data?: PartialMessage<SetSiteAccessProtectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetSiteAccessProtectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mode", kind: "enum", T: proto3.getEnumType(SiteAccessMode) },
+    { no: 3, name: "basic_auth_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "basic_auth_password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSiteAccessProtectionRequest {
+    return new SetSiteAccessProtectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSiteAccessProtectionRequest {
+    return new SetSiteAccessProtectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSiteAccessProtectionRequest {
+    return new SetSiteAccessProtectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSiteAccessProtectionRequest | PlainMessage<SetSiteAccessProtectionRequest> | undefined, b: SetSiteAccessProtectionRequest | PlainMessage<SetSiteAccessProtectionRequest> | undefined): boolean {
+    return proto3.util.equals(SetSiteAccessProtectionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetSiteAccessProtectionResponse
+ */
+export class SetSiteAccessProtectionResponse extends Message<SetSiteAccessProtectionResponse> {
+  /**
+   * @generated from field: libops.v1.SiteAccessProtection access_protection = 1;
+   */
+  accessProtection?: SiteAccessProtection;
+
+  constructor(data?: PartialMessage<SetSiteAccessProtectionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetSiteAccessProtectionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_protection", kind: "message", T: SiteAccessProtection },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSiteAccessProtectionResponse {
+    return new SetSiteAccessProtectionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSiteAccessProtectionResponse {
+    return new SetSiteAccessProtectionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSiteAccessProtectionResponse {
+    return new SetSiteAccessProtectionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSiteAccessProtectionResponse | PlainMessage<SetSiteAccessProtectionResponse> | undefined, b: SetSiteAccessProtectionResponse | PlainMessage<SetSiteAccessProtectionResponse> | undefined): boolean {
+    return proto3.util.equals(SetSiteAccessProtectionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResetSiteAccessProtectionRequest
+ */
+export class ResetSiteAccessProtectionRequest extends Message<ResetSiteAccessProtectionRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ResetSiteAccessProtectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResetSiteAccessProtectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetSiteAccessProtectionRequest {
+    return new ResetSiteAccessProtectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetSiteAccessProtectionRequest {
+    return new ResetSiteAccessProtectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetSiteAccessProtectionRequest {
+    return new ResetSiteAccessProtectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetSiteAccessProtectionRequest | PlainMessage<ResetSiteAccessProtectionRequest> | undefined, b: ResetSiteAccessProtectionRequest | PlainMessage<ResetSiteAccessProtectionRequest> | undefined): boolean {
+    return proto3.util.equals(ResetSiteAccessProtectionRequest, a, b);
+  }
+}
+
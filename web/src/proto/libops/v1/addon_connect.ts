@@ -0,0 +1,59 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/addon.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AttachAddonRequest, AttachAddonResponse, DetachAddonRequest, ListAddonsRequest, ListAddonsResponse } from "./addon_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * AddonService manages add-on services libops runs for a site next to its
+ * compose stack, like Solr for an Islandora site. The site's controller runs
+ * them and reports their health, which site status includes. Their addresses
+ * and credentials reach the site as managed site secrets.
+ *
+ * @generated from service libops.v1.AddonService
+ */
+export const AddonService = {
+  typeName: "libops.v1.AddonService",
+  methods: {
+    /**
+     * Attach an add-on to a site. It's started by the site's next
+     * reconciliation, which attaching it queues
+     *
+     * @generated from rpc libops.v1.AddonService.AttachAddon
+     */
+    attachAddon: {
+      name: "AttachAddon",
+      I: AttachAddonRequest,
+      O: AttachAddonResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List a site's add-ons
+     *
+     * @generated from rpc libops.v1.AddonService.ListAddons
+     */
+    listAddons: {
+      name: "ListAddons",
+      I: ListAddonsRequest,
+      O: ListAddonsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Detach an add-on from a site, deleting its secrets. The site's next
+     * reconciliation stops it; its data stays on the site's data disk
+     *
+     * @generated from rpc libops.v1.AddonService.DetachAddon
+     */
+    detachAddon: {
+      name: "DetachAddon",
+      I: DetachAddonRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
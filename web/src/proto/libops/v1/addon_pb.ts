@@ -0,0 +1,380 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/addon.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.AddonKind
+ */
+export enum AddonKind {
+  /**
+   * @generated from enum value: ADDON_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ADDON_KIND_SOLR = 1;
+   */
+  SOLR = 1,
+
+  /**
+   * @generated from enum value: ADDON_KIND_MEMCACHED = 2;
+   */
+  MEMCACHED = 2,
+
+  /**
+   * @generated from enum value: ADDON_KIND_REDIS = 3;
+   */
+  REDIS = 3,
+
+  /**
+   * @generated from enum value: ADDON_KIND_MATOMO = 4;
+   */
+  MATOMO = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AddonKind)
+proto3.util.setEnumType(AddonKind, "libops.v1.AddonKind", [
+  { no: 0, name: "ADDON_KIND_UNSPECIFIED" },
+  { no: 1, name: "ADDON_KIND_SOLR" },
+  { no: 2, name: "ADDON_KIND_MEMCACHED" },
+  { no: 3, name: "ADDON_KIND_REDIS" },
+  { no: 4, name: "ADDON_KIND_MATOMO" },
+]);
+
+/**
+ * @generated from enum libops.v1.AddonStatus
+ */
+export enum AddonStatus {
+  /**
+   * @generated from enum value: ADDON_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Not yet reported by the site's controller
+   *
+   * @generated from enum value: ADDON_STATUS_PROVISIONING = 1;
+   */
+  PROVISIONING = 1,
+
+  /**
+   * @generated from enum value: ADDON_STATUS_HEALTHY = 2;
+   */
+  HEALTHY = 2,
+
+  /**
+   * @generated from enum value: ADDON_STATUS_UNHEALTHY = 3;
+   */
+  UNHEALTHY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AddonStatus)
+proto3.util.setEnumType(AddonStatus, "libops.v1.AddonStatus", [
+  { no: 0, name: "ADDON_STATUS_UNSPECIFIED" },
+  { no: 1, name: "ADDON_STATUS_PROVISIONING" },
+  { no: 2, name: "ADDON_STATUS_HEALTHY" },
+  { no: 3, name: "ADDON_STATUS_UNHEALTHY" },
+]);
+
+/**
+ * @generated from message libops.v1.SiteAddon
+ */
+export class SiteAddon extends Message<SiteAddon> {
+  /**
+   * @generated from field: string addon_id = 1;
+   */
+  addonId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * A site has at most one add-on of each kind
+   *
+   * @generated from field: libops.v1.AddonKind kind = 3;
+   */
+  kind = AddonKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: libops.v1.AddonStatus status = 4;
+   */
+  status = AddonStatus.UNSPECIFIED;
+
+  /**
+   * Why it's unhealthy
+   *
+   * @generated from field: string status_message = 5;
+   */
+  statusMessage = "";
+
+  /**
+   * Empty until it's reported
+   *
+   * @generated from field: string host = 6;
+   */
+  host = "";
+
+  /**
+   * @generated from field: int32 port = 7;
+   */
+  port = 0;
+
+  /**
+   * Managed site secrets holding its address and credentials
+   *
+   * @generated from field: repeated string secret_names = 8;
+   */
+  secretNames: string[] = [];
+
+  /**
+   * Unix timestamp of its last health report
+   *
+   * @generated from field: int64 checked_at = 9;
+   */
+  checkedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 10;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteAddon>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteAddon";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "addon_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(AddonKind) },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(AddonStatus) },
+    { no: 5, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "secret_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "checked_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteAddon {
+    return new SiteAddon().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteAddon {
+    return new SiteAddon().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteAddon {
+    return new SiteAddon().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteAddon | PlainMessage<SiteAddon> | undefined, b: SiteAddon | PlainMessage<SiteAddon> | undefined): boolean {
+    return proto3.util.equals(SiteAddon, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AttachAddonRequest
+ */
+export class AttachAddonRequest extends Message<AttachAddonRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.AddonKind kind = 2;
+   */
+  kind = AddonKind.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<AttachAddonRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AttachAddonRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(AddonKind) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachAddonRequest {
+    return new AttachAddonRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachAddonRequest {
+    return new AttachAddonRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachAddonRequest {
+    return new AttachAddonRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AttachAddonRequest | PlainMessage<AttachAddonRequest> | undefined, b: AttachAddonRequest | PlainMessage<AttachAddonRequest> | undefined): boolean {
+    return proto3.util.equals(AttachAddonRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AttachAddonResponse
+ */
+export class AttachAddonResponse extends Message<AttachAddonResponse> {
+  /**
+   * @generated from field: libops.v1.SiteAddon addon = 1;
+   */
+  addon?: SiteAddon;
+
+  constructor(data?: PartialMessage<AttachAddonResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AttachAddonResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "addon", kind: "message", T: SiteAddon },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachAddonResponse {
+    return new AttachAddonResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachAddonResponse {
+    return new AttachAddonResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachAddonResponse {
+    return new AttachAddonResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AttachAddonResponse | PlainMessage<AttachAddonResponse> | undefined, b: AttachAddonResponse | PlainMessage<AttachAddonResponse> | undefined): boolean {
+    return proto3.util.equals(AttachAddonResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListAddonsRequest
+ */
+export class ListAddonsRequest extends Message<ListAddonsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ListAddonsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListAddonsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAddonsRequest {
+    return new ListAddonsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAddonsRequest {
+    return new ListAddonsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAddonsRequest {
+    return new ListAddonsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAddonsRequest | PlainMessage<ListAddonsRequest> | undefined, b: ListAddonsRequest | PlainMessage<ListAddonsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAddonsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListAddonsResponse
+ */
+export class ListAddonsResponse extends Message<ListAddonsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SiteAddon addons = 1;
+   */
+  addons: SiteAddon[] = [];
+
+  constructor(data?: PartialMessage<ListAddonsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListAddonsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "addons", kind: "message", T: SiteAddon, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAddonsResponse {
+    return new ListAddonsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAddonsResponse {
+    return new ListAddonsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAddonsResponse {
+    return new ListAddonsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAddonsResponse | PlainMessage<ListAddonsResponse> | undefined, b: ListAddonsResponse | PlainMessage<ListAddonsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAddonsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DetachAddonRequest
+ */
+export class DetachAddonRequest extends Message<DetachAddonRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string addon_id = 2;
+   */
+  addonId = "";
+
+  constructor(data?: PartialMessage<DetachAddonRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DetachAddonRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "addon_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DetachAddonRequest {
+    return new DetachAddonRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DetachAddonRequest {
+    return new DetachAddonRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DetachAddonRequest {
+    return new DetachAddonRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DetachAddonRequest | PlainMessage<DetachAddonRequest> | undefined, b: DetachAddonRequest | PlainMessage<DetachAddonRequest> | undefined): boolean {
+    return proto3.util.equals(DetachAddonRequest, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminGetAccountByEmailRequest, AdminGetAccountByEmailResponse, CreateAccountRequest, CreateAccountResponse, DeleteAccountRequest, GetAccountRequest, GetAccountResponse, ListAccountProjectsRequest, ListAccountProjectsResponse, ListAccountRepositoriesRequest, ListAccountRepositoriesResponse, ListAccountsRequest, ListAccountsResponse, UpdateAccountRequest, UpdateAccountResponse } from "./admin_account_api_pb.js";
+import { AdminGetAccountByEmailRequest, AdminGetAccountByEmailResponse, CreateAccountRequest, CreateAccountResponse, DeleteAccountRequest, GetAccountRequest, GetAccountResponse, GrantPlatformAuditorRequest, GrantPlatformAuditorResponse, ListAccountProjectsRequest, ListAccountProjectsResponse, ListAccountRepositoriesRequest, ListAccountRepositoriesResponse, ListAccountsRequest, ListAccountsResponse, ListPlatformAuditorsRequest, ListPlatformAuditorsResponse, RevokePlatformAuditorRequest, UpdateAccountRequest, UpdateAccountResponse } from "./admin_account_api_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 import { Empty } from "../../google/protobuf/empty_pb.js";
 
@@ -108,6 +108,43 @@ export const AdminAccountService = {
       kind: MethodKind.Unary,
       idempotency: MethodIdempotency.NoSideEffects,
     },
+    /**
+     * List accounts with an unexpired auditor grant, soonest to expire first
+     *
+     * @generated from rpc libops.v1.AdminAccountService.ListPlatformAuditors
+     */
+    listPlatformAuditors: {
+      name: "ListPlatformAuditors",
+      I: ListPlatformAuditorsRequest,
+      O: ListPlatformAuditorsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Grant an account read-only access to the whole platform until expires_at,
+     * replacing any grant it has. While the grant lasts the account can call
+     * every Get and List RPC on any organization, project or site and the admin
+     * views, and can't change anything outside its own account.
+     *
+     * @generated from rpc libops.v1.AdminAccountService.GrantPlatformAuditor
+     */
+    grantPlatformAuditor: {
+      name: "GrantPlatformAuditor",
+      I: GrantPlatformAuditorRequest,
+      O: GrantPlatformAuditorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * End an account's auditor grant early
+     *
+     * @generated from rpc libops.v1.AdminAccountService.RevokePlatformAuditor
+     */
+    revokePlatformAuditor: {
+      name: "RevokePlatformAuditor",
+      I: RevokePlatformAuditorRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { AuthMethod } from "./common/types_pb.js";
 import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
 
@@ -871,3 +871,273 @@ export class ListAccountRepositoriesResponse extends Message<ListAccountReposito
   }
 }
 
+/**
+ * PlatformAuditor is an account's grant of read-only access to the whole platform
+ *
+ * @generated from message libops.v1.PlatformAuditor
+ */
+export class PlatformAuditor extends Message<PlatformAuditor> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * Why the account needs it, e.g. the review it's for
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 expires_at = 4;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Account ID of the admin who granted it
+   *
+   * @generated from field: string created_by = 6;
+   */
+  createdBy = "";
+
+  constructor(data?: PartialMessage<PlatformAuditor>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PlatformAuditor";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "created_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlatformAuditor {
+    return new PlatformAuditor().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlatformAuditor {
+    return new PlatformAuditor().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlatformAuditor {
+    return new PlatformAuditor().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlatformAuditor | PlainMessage<PlatformAuditor> | undefined, b: PlatformAuditor | PlainMessage<PlatformAuditor> | undefined): boolean {
+    return proto3.util.equals(PlatformAuditor, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPlatformAuditorsRequest
+ */
+export class ListPlatformAuditorsRequest extends Message<ListPlatformAuditorsRequest> {
+  constructor(data?: PartialMessage<ListPlatformAuditorsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPlatformAuditorsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPlatformAuditorsRequest {
+    return new ListPlatformAuditorsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPlatformAuditorsRequest {
+    return new ListPlatformAuditorsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPlatformAuditorsRequest {
+    return new ListPlatformAuditorsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPlatformAuditorsRequest | PlainMessage<ListPlatformAuditorsRequest> | undefined, b: ListPlatformAuditorsRequest | PlainMessage<ListPlatformAuditorsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPlatformAuditorsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPlatformAuditorsResponse
+ */
+export class ListPlatformAuditorsResponse extends Message<ListPlatformAuditorsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PlatformAuditor auditors = 1;
+   */
+  auditors: PlatformAuditor[] = [];
+
+  constructor(data?: PartialMessage<ListPlatformAuditorsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPlatformAuditorsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "auditors", kind: "message", T: PlatformAuditor, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPlatformAuditorsResponse {
+    return new ListPlatformAuditorsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPlatformAuditorsResponse {
+    return new ListPlatformAuditorsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPlatformAuditorsResponse {
+    return new ListPlatformAuditorsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPlatformAuditorsResponse | PlainMessage<ListPlatformAuditorsResponse> | undefined, b: ListPlatformAuditorsResponse | PlainMessage<ListPlatformAuditorsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPlatformAuditorsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GrantPlatformAuditorRequest
+ */
+export class GrantPlatformAuditorRequest extends Message<GrantPlatformAuditorRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * Unix timestamp, at most 90 days from now
+   *
+   * @generated from field: int64 expires_at = 3;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GrantPlatformAuditorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GrantPlatformAuditorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantPlatformAuditorRequest {
+    return new GrantPlatformAuditorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantPlatformAuditorRequest {
+    return new GrantPlatformAuditorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantPlatformAuditorRequest {
+    return new GrantPlatformAuditorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantPlatformAuditorRequest | PlainMessage<GrantPlatformAuditorRequest> | undefined, b: GrantPlatformAuditorRequest | PlainMessage<GrantPlatformAuditorRequest> | undefined): boolean {
+    return proto3.util.equals(GrantPlatformAuditorRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GrantPlatformAuditorResponse
+ */
+export class GrantPlatformAuditorResponse extends Message<GrantPlatformAuditorResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformAuditor auditor = 1;
+   */
+  auditor?: PlatformAuditor;
+
+  constructor(data?: PartialMessage<GrantPlatformAuditorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GrantPlatformAuditorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "auditor", kind: "message", T: PlatformAuditor },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantPlatformAuditorResponse {
+    return new GrantPlatformAuditorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantPlatformAuditorResponse {
+    return new GrantPlatformAuditorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantPlatformAuditorResponse {
+    return new GrantPlatformAuditorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantPlatformAuditorResponse | PlainMessage<GrantPlatformAuditorResponse> | undefined, b: GrantPlatformAuditorResponse | PlainMessage<GrantPlatformAuditorResponse> | undefined): boolean {
+    return proto3.util.equals(GrantPlatformAuditorResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokePlatformAuditorRequest
+ */
+export class RevokePlatformAuditorRequest extends Message<RevokePlatformAuditorRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<RevokePlatformAuditorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokePlatformAuditorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokePlatformAuditorRequest {
+    return new RevokePlatformAuditorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokePlatformAuditorRequest {
+    return new RevokePlatformAuditorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokePlatformAuditorRequest {
+    return new RevokePlatformAuditorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokePlatformAuditorRequest | PlainMessage<RevokePlatformAuditorRequest> | undefined, b: RevokePlatformAuditorRequest | PlainMessage<RevokePlatformAuditorRequest> | undefined): boolean {
+    return proto3.util.equals(RevokePlatformAuditorRequest, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminCreateOrganizationRequest, AdminCreateOrganizationResponse, AdminCreateProjectRequest, AdminCreateProjectResponse, AdminCreateSiteRequest, AdminCreateSiteResponse, AdminDeleteOrganizationRequest, AdminDeleteProjectRequest, AdminDeleteSiteRequest, AdminGetOrganizationRequest, AdminGetOrganizationResponse, AdminGetProjectRequest, AdminGetProjectResponse, AdminGetSiteRequest, AdminGetSiteResponse, AdminListAllProjectsRequest, AdminListAllProjectsResponse, AdminListAllSitesRequest, AdminListAllSitesResponse, AdminListOrganizationProjectsRequest, AdminListOrganizationProjectsResponse, AdminListOrganizationsRequest, AdminListOrganizationsResponse, AdminListProjectsRequest, AdminListProjectsResponse, AdminListSitesRequest, AdminListSitesResponse, AdminUpdateOrganizationRequest, AdminUpdateOrganizationResponse, AdminUpdateProjectRequest, AdminUpdateProjectResponse, AdminUpdateSiteRequest, AdminUpdateSiteResponse, GenerateTerraformVarsRequest, GenerateTerraformVarsResponse, GetBlobRequest, GetBlobResponse, GetReconciliationRunRequest, GetReconciliationRunResponse, GetSiteFirewallRequest, GetSiteFirewallResponse, GetSiteSecretsRequest, GetSiteSecretsResponse, GetSiteSSHKeysRequest, GetSiteSSHKeysResponse, SiteCheckInRequest, SiteCheckInResponse, SyncManifestRequest, SyncManifestResponse, UpdateReconciliationStatusRequest, UpdateReconciliationStatusResponse } from "./admin_api_pb.js";
+import { AdminCreateOrganizationRequest, AdminCreateOrganizationResponse, AdminCreateProjectRequest, AdminCreateProjectResponse, AdminCreateSiteRequest, AdminCreateSiteResponse, AdminDeleteOrganizationQuotaRequest, AdminDeleteOrganizationRequest, AdminDeleteProjectRequest, AdminDeleteSiteRequest, AdminGetOrganizationRequest, AdminGetOrganizationResponse, AdminGetProjectRequest, AdminGetProjectResponse, AdminGetSiteRequest, AdminGetSiteResponse, AdminListAllProjectsRequest, AdminListAllProjectsResponse, AdminListAllSitesRequest, AdminListAllSitesResponse, AdminListOrganizationProjectsRequest, AdminListOrganizationProjectsResponse, AdminListOrganizationsRequest, AdminListOrganizationsResponse, AdminListProjectsRequest, AdminListProjectsResponse, AdminListSitesRequest, AdminListSitesResponse, AdminSetOrganizationQuotaRequest, AdminSetOrganizationQuotaResponse, AdminUpdateOrganizationRequest, AdminUpdateOrganizationResponse, AdminUpdateProjectRequest, AdminUpdateProjectResponse, AdminUpdateSiteRequest, AdminUpdateSiteResponse, GenerateTerraformVarsRequest, GenerateTerraformVarsResponse, GetBlobRequest, GetBlobResponse, GetReconciliationRunRequest, GetReconciliationRunResponse, GetSiteAddonsRequest, GetSiteAddonsResponse, GetSiteConfigVarsRequest, GetSiteConfigVarsResponse, GetSiteDatabasesRequest, GetSiteDatabasesResponse, GetSiteFirewallRequest, GetSiteFirewallResponse, GetSiteProxyConfigRequest, GetSiteProxyConfigResponse, GetSiteSSHKeysRequest, GetSiteSSHKeysResponse, GetSiteSecretsRequest, GetSiteSecretsResponse, IssueSiteClientCertificateRequest, IssueSiteClientCertificateResponse, ReportPrivateServiceConnectEndpointsRequest, ReportPrivateServiceConnectEndpointsResponse, ReportSiteAddonsRequest, ReportSiteAddonsResponse, ReportSiteCdnRequest, ReportSiteCdnResponse, ReportSiteDatabaseInstanceRequest, ReportSiteDatabaseInstanceResponse, ReportSiteDatabasesRequest, ReportSiteDatabasesResponse, ReportSiteStaticEgressIpRequest, ReportSiteStaticEgressIpResponse, ReportSiteTlsProbeRequest, ReportSiteTlsProbeResponse, ResolvePrivateServiceConnectEndpointRequest, ResolvePrivateServiceConnectEndpointResponse, RotateEncryptionKeyRequest, RotateEncryptionKeyResponse, SiteCheckInRequest, SiteCheckInResponse, SyncManifestRequest, SyncManifestResponse, UpdateReconciliationStatusRequest, UpdateReconciliationStatusResponse } from "./admin_api_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 import { Empty } from "../../google/protobuf/empty_pb.js";
 
@@ -84,6 +84,40 @@ export const AdminOrganizationService = {
       kind: MethodKind.Unary,
       idempotency: MethodIdempotency.NoSideEffects,
     },
+    /**
+     * Override an organization's quota for a single resource
+     *
+     * @generated from rpc libops.v1.AdminOrganizationService.SetOrganizationQuota
+     */
+    setOrganizationQuota: {
+      name: "SetOrganizationQuota",
+      I: AdminSetOrganizationQuotaRequest,
+      O: AdminSetOrganizationQuotaResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a quota override, restoring the plan default
+     *
+     * @generated from rpc libops.v1.AdminOrganizationService.DeleteOrganizationQuota
+     */
+    deleteOrganizationQuota: {
+      name: "DeleteOrganizationQuota",
+      I: AdminDeleteOrganizationQuotaRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Rotate the keys an organization's secrets are encrypted with and re-wrap
+     * every stored secret with the new data key
+     *
+     * @generated from rpc libops.v1.AdminOrganizationService.RotateEncryptionKey
+     */
+    rotateEncryptionKey: {
+      name: "RotateEncryptionKey",
+      I: RotateEncryptionKeyRequest,
+      O: RotateEncryptionKeyResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -211,6 +245,105 @@ export const AdminSiteService = {
       O: SiteCheckInResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Issue a client certificate from the LibOps controller CA for a site VM's
+     * controller to present over mutual TLS (called by VM controller with GSA
+     * auth). Issuing a certificate revokes the site's earlier ones.
+     *
+     * @generated from rpc libops.v1.AdminSiteService.IssueSiteClientCertificate
+     */
+    issueSiteClientCertificate: {
+      name: "IssueSiteClientCertificate",
+      I: IssueSiteClientCertificateRequest,
+      O: IssueSiteClientCertificateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the reverse proxy configuration for a site VM (called by VM controller with GSA auth)
+     *
+     * @generated from rpc libops.v1.AdminSiteService.GetSiteProxyConfig
+     */
+    getSiteProxyConfig: {
+      name: "GetSiteProxyConfig",
+      I: GetSiteProxyConfigRequest,
+      O: GetSiteProxyConfigResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record whether a site passed the TLS probe its controller runs after
+     * applying the site's proxy config
+     *
+     * @generated from rpc libops.v1.AdminSiteService.ReportSiteTlsProbe
+     */
+    reportSiteTlsProbe: {
+      name: "ReportSiteTlsProbe",
+      I: ReportSiteTlsProbeRequest,
+      O: ReportSiteTlsProbeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the databases a site VM runs on its MySQL server, with their
+     * passwords (called by VM controller with GSA auth)
+     *
+     * @generated from rpc libops.v1.AdminSiteService.GetSiteDatabases
+     */
+    getSiteDatabases: {
+      name: "GetSiteDatabases",
+      I: GetSiteDatabasesRequest,
+      O: GetSiteDatabasesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record which of a site's co-located databases its controller provisioned
+     *
+     * @generated from rpc libops.v1.AdminSiteService.ReportSiteDatabases
+     */
+    reportSiteDatabases: {
+      name: "ReportSiteDatabases",
+      I: ReportSiteDatabasesRequest,
+      O: ReportSiteDatabasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the add-ons a site VM runs, with their credentials (called by VM
+     * controller with GSA auth)
+     *
+     * @generated from rpc libops.v1.AdminSiteService.GetSiteAddons
+     */
+    getSiteAddons: {
+      name: "GetSiteAddons",
+      I: GetSiteAddonsRequest,
+      O: GetSiteAddonsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record the health of a site's add-ons and where the site reaches them
+     *
+     * @generated from rpc libops.v1.AdminSiteService.ReportSiteAddons
+     */
+    reportSiteAddons: {
+      name: "ReportSiteAddons",
+      I: ReportSiteAddonsRequest,
+      O: ReportSiteAddonsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get a site's config vars, the plaintext environment variables the VM
+     * controller writes next to its secrets, with the environment settings the
+     * site inherits (called by VM controller with GSA auth)
+     *
+     * @generated from rpc libops.v1.AdminSiteService.GetSiteConfigVars
+     */
+    getSiteConfigVars: {
+      name: "GetSiteConfigVars",
+      I: GetSiteConfigVarsRequest,
+      O: GetSiteConfigVarsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
     /**
      * Sync site manifest - returns state hash and signed URLs to blobs (for eventual consistency)
      * Called by site VMs every ~24h for eventual consistency
@@ -363,6 +496,64 @@ export const AdminReconciliationService = {
       kind: MethodKind.Unary,
       idempotency: MethodIdempotency.NoSideEffects,
     },
+    /**
+     * Find the active Private Service Connect endpoint for an organization or
+     * site, so terraform runners and site controllers reach the API privately
+     *
+     * @generated from rpc libops.v1.AdminReconciliationService.ResolvePrivateServiceConnectEndpoint
+     */
+    resolvePrivateServiceConnectEndpoint: {
+      name: "ResolvePrivateServiceConnectEndpoint",
+      I: ResolvePrivateServiceConnectEndpointRequest,
+      O: ResolvePrivateServiceConnectEndpointResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record the Private Service Connect endpoints terraform applied for an organization
+     *
+     * @generated from rpc libops.v1.AdminReconciliationService.ReportPrivateServiceConnectEndpoints
+     */
+    reportPrivateServiceConnectEndpoints: {
+      name: "ReportPrivateServiceConnectEndpoints",
+      I: ReportPrivateServiceConnectEndpointsRequest,
+      O: ReportPrivateServiceConnectEndpointsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Record the static egress IP terraform reserved for a site, or that it has none
+     *
+     * @generated from rpc libops.v1.AdminReconciliationService.ReportSiteStaticEgressIp
+     */
+    reportSiteStaticEgressIp: {
+      name: "ReportSiteStaticEgressIp",
+      I: ReportSiteStaticEgressIpRequest,
+      O: ReportSiteStaticEgressIpResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Record the CDN load balancer terraform built for a site, or that it has
+     * none, and the cache purges it ran
+     *
+     * @generated from rpc libops.v1.AdminReconciliationService.ReportSiteCdn
+     */
+    reportSiteCdn: {
+      name: "ReportSiteCdn",
+      I: ReportSiteCdnRequest,
+      O: ReportSiteCdnResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Record the Cloud SQL instance terraform built for a site's databases
+     *
+     * @generated from rpc libops.v1.AdminReconciliationService.ReportSiteDatabaseInstance
+     */
+    reportSiteDatabaseInstance: {
+      name: "ReportSiteDatabaseInstance",
+      I: ReportSiteDatabaseInstanceRequest,
+      O: ReportSiteDatabaseInstanceResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
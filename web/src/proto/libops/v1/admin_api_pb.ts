@@ -4,11 +4,21 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { AdminProjectConfig } from "./admin/project_pb.js";
 import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
 import { AdminFolderConfig } from "./admin/organization_pb.js";
+import { Quota } from "./common/organization_pb.js";
 import { AdminSiteConfig } from "./admin/site_pb.js";
+import { WafBlock, WafMode, WafRuleExclusion } from "./waf_pb.js";
+import { Redirect } from "./redirect_pb.js";
+import { SiteRateLimitRule } from "./organization_api_pb.js";
+import { SiteAccessMode } from "./access_protection_pb.js";
+import { TlsVersion } from "./tls_pb.js";
+import { PrivateServiceConnectEndpoint, PrivateServiceConnectTarget } from "./private_network_pb.js";
+import { SiteCdn, StaticEgressIp } from "./common/site_pb.js";
+import { SiteDatabase } from "./database_pb.js";
+import { SiteAddon } from "./addon_pb.js";
 
 /**
  * @generated from message libops.v1.AdminGetProjectRequest
@@ -778,6 +788,10 @@ export class AdminListOrganizationsRequest extends Message<AdminListOrganization
   pageSize = 0;
 
   /**
+   * Continues after the last row of the previous page. Results are ordered
+   * newest first by creation time, then by ID, so rows created or deleted
+   * between requests don't shift pages.
+   *
    * @generated from field: string page_token = 2;
    */
   pageToken = "";
@@ -954,6 +968,227 @@ export class AdminListOrganizationProjectsResponse extends Message<AdminListOrga
   }
 }
 
+/**
+ * @generated from message libops.v1.AdminSetOrganizationQuotaRequest
+ */
+export class AdminSetOrganizationQuotaRequest extends Message<AdminSetOrganizationQuotaRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * e.g., "projects"
+   *
+   * @generated from field: string resource = 2;
+   */
+  resource = "";
+
+  /**
+   * @generated from field: int64 limit = 3;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<AdminSetOrganizationQuotaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AdminSetOrganizationQuotaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminSetOrganizationQuotaRequest {
+    return new AdminSetOrganizationQuotaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminSetOrganizationQuotaRequest {
+    return new AdminSetOrganizationQuotaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminSetOrganizationQuotaRequest {
+    return new AdminSetOrganizationQuotaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdminSetOrganizationQuotaRequest | PlainMessage<AdminSetOrganizationQuotaRequest> | undefined, b: AdminSetOrganizationQuotaRequest | PlainMessage<AdminSetOrganizationQuotaRequest> | undefined): boolean {
+    return proto3.util.equals(AdminSetOrganizationQuotaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AdminSetOrganizationQuotaResponse
+ */
+export class AdminSetOrganizationQuotaResponse extends Message<AdminSetOrganizationQuotaResponse> {
+  /**
+   * @generated from field: libops.v1.common.Quota quota = 1;
+   */
+  quota?: Quota;
+
+  constructor(data?: PartialMessage<AdminSetOrganizationQuotaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AdminSetOrganizationQuotaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quota", kind: "message", T: Quota },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminSetOrganizationQuotaResponse {
+    return new AdminSetOrganizationQuotaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminSetOrganizationQuotaResponse {
+    return new AdminSetOrganizationQuotaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminSetOrganizationQuotaResponse {
+    return new AdminSetOrganizationQuotaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdminSetOrganizationQuotaResponse | PlainMessage<AdminSetOrganizationQuotaResponse> | undefined, b: AdminSetOrganizationQuotaResponse | PlainMessage<AdminSetOrganizationQuotaResponse> | undefined): boolean {
+    return proto3.util.equals(AdminSetOrganizationQuotaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AdminDeleteOrganizationQuotaRequest
+ */
+export class AdminDeleteOrganizationQuotaRequest extends Message<AdminDeleteOrganizationQuotaRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string resource = 2;
+   */
+  resource = "";
+
+  constructor(data?: PartialMessage<AdminDeleteOrganizationQuotaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AdminDeleteOrganizationQuotaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminDeleteOrganizationQuotaRequest {
+    return new AdminDeleteOrganizationQuotaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminDeleteOrganizationQuotaRequest {
+    return new AdminDeleteOrganizationQuotaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminDeleteOrganizationQuotaRequest {
+    return new AdminDeleteOrganizationQuotaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdminDeleteOrganizationQuotaRequest | PlainMessage<AdminDeleteOrganizationQuotaRequest> | undefined, b: AdminDeleteOrganizationQuotaRequest | PlainMessage<AdminDeleteOrganizationQuotaRequest> | undefined): boolean {
+    return proto3.util.equals(AdminDeleteOrganizationQuotaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RotateEncryptionKeyRequest
+ */
+export class RotateEncryptionKeyRequest extends Message<RotateEncryptionKeyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<RotateEncryptionKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RotateEncryptionKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateEncryptionKeyRequest {
+    return new RotateEncryptionKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateEncryptionKeyRequest {
+    return new RotateEncryptionKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateEncryptionKeyRequest {
+    return new RotateEncryptionKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateEncryptionKeyRequest | PlainMessage<RotateEncryptionKeyRequest> | undefined, b: RotateEncryptionKeyRequest | PlainMessage<RotateEncryptionKeyRequest> | undefined): boolean {
+    return proto3.util.equals(RotateEncryptionKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RotateEncryptionKeyResponse
+ */
+export class RotateEncryptionKeyResponse extends Message<RotateEncryptionKeyResponse> {
+  /**
+   * The data key version secrets are now sealed with
+   *
+   * @generated from field: int32 key_version = 1;
+   */
+  keyVersion = 0;
+
+  /**
+   * @generated from field: int32 rewrapped_secrets = 2;
+   */
+  rewrappedSecrets = 0;
+
+  /**
+   * Vault paths left on their earlier key; the next rotation retries them
+   *
+   * @generated from field: repeated string failed_paths = 3;
+   */
+  failedPaths: string[] = [];
+
+  constructor(data?: PartialMessage<RotateEncryptionKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RotateEncryptionKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "rewrapped_secrets", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failed_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateEncryptionKeyResponse {
+    return new RotateEncryptionKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateEncryptionKeyResponse {
+    return new RotateEncryptionKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateEncryptionKeyResponse {
+    return new RotateEncryptionKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateEncryptionKeyResponse | PlainMessage<RotateEncryptionKeyResponse> | undefined, b: RotateEncryptionKeyResponse | PlainMessage<RotateEncryptionKeyResponse> | undefined): boolean {
+    return proto3.util.equals(RotateEncryptionKeyResponse, a, b);
+  }
+}
+
 /**
  * @generated from message libops.v1.AdminGetSiteRequest
  */
@@ -1528,6 +1763,20 @@ export class SSHKey extends Message<SSHKey> {
    */
   githubUsername = "";
 
+  /**
+   * Account public ID, the key owner's username on the VM
+   *
+   * @generated from field: string account_id = 5;
+   */
+  accountId = "";
+
+  /**
+   * The owner only gets an SFTP login to the site's files
+   *
+   * @generated from field: bool sftp_only = 6;
+   */
+  sftpOnly = false;
+
   constructor(data?: PartialMessage<SSHKey>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1540,6 +1789,8 @@ export class SSHKey extends Message<SSHKey> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "github_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "sftp_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SSHKey {
@@ -1649,6 +1900,13 @@ export class Secret extends Message<Secret> {
    */
   value = "";
 
+  /**
+   * External secret for the controller to resolve; value is empty when set
+   *
+   * @generated from field: string reference = 3;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<Secret>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1659,6 +1917,7 @@ export class Secret extends Message<Secret> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Secret {
@@ -1865,6 +2124,69 @@ export class SiteCheckInRequest extends Message<SiteCheckInRequest> {
    */
   siteId = "";
 
+  /**
+   * Network egress in bytes since the controller's previous check-in
+   *
+   * @generated from field: int64 egress_bytes = 2;
+   */
+  egressBytes = protoInt64.zero;
+
+  /**
+   * Bytes currently used on the site's data disk
+   *
+   * @generated from field: int64 disk_used_bytes = 3;
+   */
+  diskUsedBytes = protoInt64.zero;
+
+  /**
+   * Requests the WAF matched since the controller's previous check-in
+   *
+   * @generated from field: repeated libops.v1.WafBlock waf_blocks = 4;
+   */
+  wafBlocks: WafBlock[] = [];
+
+  /**
+   * Requests each rate limit rejected since the controller's previous check-in
+   *
+   * @generated from field: repeated libops.v1.RateLimitRejections rate_limit_rejections = 5;
+   */
+  rateLimitRejections: RateLimitRejections[] = [];
+
+  /**
+   * Controller release the site is running; empty for builds without a version
+   *
+   * @generated from field: string controller_version = 6;
+   */
+  controllerVersion = "";
+
+  /**
+   * OS image the VM runs, e.g. "cos-125-19216-104-74"; empty when unknown
+   *
+   * @generated from field: string os_version = 7;
+   */
+  osVersion = "";
+
+  /**
+   * An installed OS update waits for a reboot
+   *
+   * @generated from field: bool os_reboot_required = 8;
+   */
+  osRebootRequired = false;
+
+  /**
+   * Containers running on the VM; unset by controllers that don't report them
+   *
+   * @generated from field: optional int32 containers_running = 9;
+   */
+  containersRunning?: number;
+
+  /**
+   * Running containers failing their health check; unset with containers_running
+   *
+   * @generated from field: optional int32 containers_unhealthy = 10;
+   */
+  containersUnhealthy?: number;
+
   constructor(data?: PartialMessage<SiteCheckInRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1874,6 +2196,15 @@ export class SiteCheckInRequest extends Message<SiteCheckInRequest> {
   static readonly typeName = "libops.v1.SiteCheckInRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "egress_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "disk_used_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "waf_blocks", kind: "message", T: WafBlock, repeated: true },
+    { no: 5, name: "rate_limit_rejections", kind: "message", T: RateLimitRejections, repeated: true },
+    { no: 6, name: "controller_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "os_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "os_reboot_required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "containers_running", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 10, name: "containers_unhealthy", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteCheckInRequest {
@@ -1893,6 +2224,51 @@ export class SiteCheckInRequest extends Message<SiteCheckInRequest> {
   }
 }
 
+/**
+ * @generated from message libops.v1.RateLimitRejections
+ */
+export class RateLimitRejections extends Message<RateLimitRejections> {
+  /**
+   * Rate limit rule public ID
+   *
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  /**
+   * @generated from field: int64 rejected = 2;
+   */
+  rejected = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RateLimitRejections>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RateLimitRejections";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rejected", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimitRejections {
+    return new RateLimitRejections().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimitRejections {
+    return new RateLimitRejections().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimitRejections {
+    return new RateLimitRejections().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RateLimitRejections | PlainMessage<RateLimitRejections> | undefined, b: RateLimitRejections | PlainMessage<RateLimitRejections> | undefined): boolean {
+    return proto3.util.equals(RateLimitRejections, a, b);
+  }
+}
+
 /**
  * @generated from message libops.v1.SiteCheckInResponse
  */
@@ -1907,6 +2283,46 @@ export class SiteCheckInResponse extends Message<SiteCheckInResponse> {
    */
   message = "";
 
+  /**
+   * Site status (e.g. "active", "suspended"); the controller stops the
+   * application while the site is suspended
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * The controller's tunables, applied without a restart. Unset fields keep
+   * what the controller was started with.
+   *
+   * @generated from field: libops.v1.ControllerConfig controller_config = 4;
+   */
+  controllerConfig?: ControllerConfig;
+
+  /**
+   * The release the controller should run, when its config names one. The
+   * controller updates itself when it runs another version.
+   *
+   * @generated from field: libops.v1.ControllerRelease controller_release = 5;
+   */
+  controllerRelease?: ControllerRelease;
+
+  /**
+   * The API region serving the site, e.g. "europe-west1"; empty when the API
+   * runs in a single region
+   *
+   * @generated from field: string api_region = 6;
+   */
+  apiRegion = "";
+
+  /**
+   * The public API endpoint of that region. A controller that discovered its
+   * API URL moves its traffic there; empty when it should stay where it is.
+   *
+   * @generated from field: string api_url = 7;
+   */
+  apiUrl = "";
+
   constructor(data?: PartialMessage<SiteCheckInResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1917,6 +2333,11 @@ export class SiteCheckInResponse extends Message<SiteCheckInResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "controller_config", kind: "message", T: ControllerConfig },
+    { no: 5, name: "controller_release", kind: "message", T: ControllerRelease },
+    { no: 6, name: "api_region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "api_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteCheckInResponse {
@@ -1937,9 +2358,9 @@ export class SiteCheckInResponse extends Message<SiteCheckInResponse> {
 }
 
 /**
- * @generated from message libops.v1.SyncManifestRequest
+ * @generated from message libops.v1.IssueSiteClientCertificateRequest
  */
-export class SyncManifestRequest extends Message<SyncManifestRequest> {
+export class IssueSiteClientCertificateRequest extends Message<IssueSiteClientCertificateRequest> {
   /**
    * Site public ID
    *
@@ -1948,147 +2369,2101 @@ export class SyncManifestRequest extends Message<SyncManifestRequest> {
   siteId = "";
 
   /**
-   * For ETag optimization (304 Not Modified)
+   * PEM certificate request for a key the controller generated; only its
+   * public key is used
    *
-   * @generated from field: optional string current_state_hash = 2;
+   * @generated from field: string csr_pem = 2;
    */
-  currentStateHash?: string;
+  csrPem = "";
 
-  constructor(data?: PartialMessage<SyncManifestRequest>) {
+  constructor(data?: PartialMessage<IssueSiteClientCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.SyncManifestRequest";
+  static readonly typeName = "libops.v1.IssueSiteClientCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "current_state_hash", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "csr_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncManifestRequest {
-    return new SyncManifestRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueSiteClientCertificateRequest {
+    return new IssueSiteClientCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncManifestRequest {
-    return new SyncManifestRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueSiteClientCertificateRequest {
+    return new IssueSiteClientCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncManifestRequest {
-    return new SyncManifestRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueSiteClientCertificateRequest {
+    return new IssueSiteClientCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SyncManifestRequest | PlainMessage<SyncManifestRequest> | undefined, b: SyncManifestRequest | PlainMessage<SyncManifestRequest> | undefined): boolean {
-    return proto3.util.equals(SyncManifestRequest, a, b);
+  static equals(a: IssueSiteClientCertificateRequest | PlainMessage<IssueSiteClientCertificateRequest> | undefined, b: IssueSiteClientCertificateRequest | PlainMessage<IssueSiteClientCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(IssueSiteClientCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.SyncManifestResponse
+ * @generated from message libops.v1.IssueSiteClientCertificateResponse
  */
-export class SyncManifestResponse extends Message<SyncManifestResponse> {
+export class IssueSiteClientCertificateResponse extends Message<IssueSiteClientCertificateResponse> {
   /**
-   * Current state hash for this site
-   *
-   * @generated from field: string state_hash = 1;
+   * @generated from field: string certificate_pem = 1;
    */
-  stateHash = "";
+  certificatePem = "";
 
   /**
-   * Signed URLs to GCS blobs
+   * The controller CA's certificate
+   *
+   * @generated from field: string ca_certificate_pem = 2;
+   */
+  caCertificatePem = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 not_after = 3;
+   */
+  notAfter = protoInt64.zero;
+
+  /**
+   * When the controller should request its next certificate; Unix timestamp
+   *
+   * @generated from field: int64 renew_after = 4;
+   */
+  renewAfter = protoInt64.zero;
+
+  constructor(data?: PartialMessage<IssueSiteClientCertificateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.IssueSiteClientCertificateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "certificate_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ca_certificate_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "not_after", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "renew_after", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IssueSiteClientCertificateResponse {
+    return new IssueSiteClientCertificateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IssueSiteClientCertificateResponse {
+    return new IssueSiteClientCertificateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IssueSiteClientCertificateResponse {
+    return new IssueSiteClientCertificateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IssueSiteClientCertificateResponse | PlainMessage<IssueSiteClientCertificateResponse> | undefined, b: IssueSiteClientCertificateResponse | PlainMessage<IssueSiteClientCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(IssueSiteClientCertificateResponse, a, b);
+  }
+}
+
+/**
+ * ControllerConfig is the document site VM controllers tune themselves with.
+ * The fleet-wide document applies to every site, and a site's own overrides
+ * it field by field.
+ *
+ * @generated from message libops.v1.ControllerConfig
+ */
+export class ControllerConfig extends Message<ControllerConfig> {
+  /**
+   * Reconcile requests per second, 1-1000
+   *
+   * @generated from field: optional int32 rate_limit_rps = 1;
+   */
+  rateLimitRps?: number;
+
+  /**
+   * 1-1000
+   *
+   * @generated from field: optional int32 rate_limit_burst = 2;
+   */
+  rateLimitBurst?: number;
+
+  /**
+   * Between periodic full reconciliations, 5-1440
+   *
+   * @generated from field: optional int32 reconcile_interval_minutes = 3;
+   */
+  reconcileIntervalMinutes?: number;
+
+  /**
+   * 15-3600
+   *
+   * @generated from field: optional int32 checkin_interval_seconds = 4;
+   */
+  checkinIntervalSeconds?: number;
+
+  /**
+   * Flag name to enabled, e.g. "nftables" to apply firewall rules with
+   * nftables instead of iptables
+   *
+   * @generated from field: map<string, bool> feature_flags = 5;
+   */
+  featureFlags: { [key: string]: boolean } = {};
+
+  /**
+   * Identifies the merged document sent on check-in; empty elsewhere
+   *
+   * @generated from field: string version = 6;
+   */
+  version = "";
+
+  /**
+   * Controller release the sites should run; must name a published release
+   *
+   * @generated from field: optional string controller_version = 7;
+   */
+  controllerVersion?: string;
+
+  constructor(data?: PartialMessage<ControllerConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ControllerConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rate_limit_rps", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 2, name: "rate_limit_burst", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "reconcile_interval_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 4, name: "checkin_interval_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "feature_flags", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 8 /* ScalarType.BOOL */} },
+    { no: 6, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "controller_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ControllerConfig {
+    return new ControllerConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ControllerConfig {
+    return new ControllerConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ControllerConfig {
+    return new ControllerConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ControllerConfig | PlainMessage<ControllerConfig> | undefined, b: ControllerConfig | PlainMessage<ControllerConfig> | undefined): boolean {
+    return proto3.util.equals(ControllerConfig, a, b);
+  }
+}
+
+/**
+ * ControllerRelease is a signed build of the site VM controller. Controllers
+ * only install a release whose binary matches its digest and signature.
+ *
+ * @generated from message libops.v1.ControllerRelease
+ */
+export class ControllerRelease extends Message<ControllerRelease> {
+  /**
+   * e.g. "v1.4.0"
+   *
+   * @generated from field: string version = 1;
+   */
+  version = "";
+
+  /**
+   * HTTPS URL of the linux/amd64 binary
+   *
+   * @generated from field: string download_url = 2;
+   */
+  downloadUrl = "";
+
+  /**
+   * Hex SHA-256 digest of the binary
+   *
+   * @generated from field: string sha256 = 3;
+   */
+  sha256 = "";
+
+  /**
+   * Base64 ed25519 signature of the binary
+   *
+   * @generated from field: string signature = 4;
+   */
+  signature = "";
+
+  /**
+   * @generated from field: string notes = 5;
+   */
+  notes = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Sites whose controller reported running the release on its last
+   * check-in; only set when listing releases
+   *
+   * @generated from field: int64 site_count = 7;
+   */
+  siteCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ControllerRelease>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ControllerRelease";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "download_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "signature", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "notes", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "site_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ControllerRelease {
+    return new ControllerRelease().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ControllerRelease {
+    return new ControllerRelease().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ControllerRelease {
+    return new ControllerRelease().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ControllerRelease | PlainMessage<ControllerRelease> | undefined, b: ControllerRelease | PlainMessage<ControllerRelease> | undefined): boolean {
+    return proto3.util.equals(ControllerRelease, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteProxyConfigRequest
+ */
+export class GetSiteProxyConfigRequest extends Message<GetSiteProxyConfigRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteProxyConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteProxyConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteProxyConfigRequest {
+    return new GetSiteProxyConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteProxyConfigRequest {
+    return new GetSiteProxyConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteProxyConfigRequest {
+    return new GetSiteProxyConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteProxyConfigRequest | PlainMessage<GetSiteProxyConfigRequest> | undefined, b: GetSiteProxyConfigRequest | PlainMessage<GetSiteProxyConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteProxyConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteProxyConfigResponse
+ */
+export class GetSiteProxyConfigResponse extends Message<GetSiteProxyConfigResponse> {
+  /**
+   * Most specific first, the order the controller renders them in
+   *
+   * @generated from field: repeated libops.v1.Redirect redirects = 1;
+   */
+  redirects: Redirect[] = [];
+
+  /**
+   * @generated from field: libops.v1.SiteProxyAccess access = 2;
+   */
+  access?: SiteProxyAccess;
+
+  /**
+   * @generated from field: libops.v1.SiteProxyWaf waf = 3;
+   */
+  waf?: SiteProxyWaf;
+
+  /**
+   * Most specific path first
+   *
+   * @generated from field: repeated libops.v1.SiteRateLimitRule rate_limits = 4;
+   */
+  rateLimits: SiteRateLimitRule[] = [];
+
+  /**
+   * @generated from field: libops.v1.SiteProxyTls tls = 5;
+   */
+  tls?: SiteProxyTls;
+
+  constructor(data?: PartialMessage<GetSiteProxyConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteProxyConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirects", kind: "message", T: Redirect, repeated: true },
+    { no: 2, name: "access", kind: "message", T: SiteProxyAccess },
+    { no: 3, name: "waf", kind: "message", T: SiteProxyWaf },
+    { no: 4, name: "rate_limits", kind: "message", T: SiteRateLimitRule, repeated: true },
+    { no: 5, name: "tls", kind: "message", T: SiteProxyTls },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteProxyConfigResponse {
+    return new GetSiteProxyConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteProxyConfigResponse {
+    return new GetSiteProxyConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteProxyConfigResponse {
+    return new GetSiteProxyConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteProxyConfigResponse | PlainMessage<GetSiteProxyConfigResponse> | undefined, b: GetSiteProxyConfigResponse | PlainMessage<GetSiteProxyConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteProxyConfigResponse, a, b);
+  }
+}
+
+/**
+ * SiteProxyAccess is a site's access protection as the controller renders it
+ *
+ * @generated from message libops.v1.SiteProxyAccess
+ */
+export class SiteProxyAccess extends Message<SiteProxyAccess> {
+  /**
+   * @generated from field: libops.v1.SiteAccessMode mode = 1;
+   */
+  mode = SiteAccessMode.UNSPECIFIED;
+
+  /**
+   * @generated from field: string basic_auth_username = 2;
+   */
+  basicAuthUsername = "";
+
+  /**
+   * bcrypt, for the htpasswd file
+   *
+   * @generated from field: string basic_auth_password_hash = 3;
+   */
+  basicAuthPasswordHash = "";
+
+  /**
+   * Key the members only gate's tickets are signed with; the controller signs
+   * its session cookies with it too
+   *
+   * @generated from field: string gate_secret = 4;
+   */
+  gateSecret = "";
+
+  /**
+   * Dashboard page that signs people in and sends them back with a ticket
+   *
+   * @generated from field: string gate_url = 5;
+   */
+  gateUrl = "";
+
+  constructor(data?: PartialMessage<SiteProxyAccess>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteProxyAccess";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "enum", T: proto3.getEnumType(SiteAccessMode) },
+    { no: 2, name: "basic_auth_username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "basic_auth_password_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "gate_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "gate_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteProxyAccess {
+    return new SiteProxyAccess().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteProxyAccess {
+    return new SiteProxyAccess().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteProxyAccess {
+    return new SiteProxyAccess().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteProxyAccess | PlainMessage<SiteProxyAccess> | undefined, b: SiteProxyAccess | PlainMessage<SiteProxyAccess> | undefined): boolean {
+    return proto3.util.equals(SiteProxyAccess, a, b);
+  }
+}
+
+/**
+ * SiteProxyWaf is a site's WAF as the controller renders it
+ *
+ * @generated from message libops.v1.SiteProxyWaf
+ */
+export class SiteProxyWaf extends Message<SiteProxyWaf> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: libops.v1.WafMode mode = 2;
+   */
+  mode = WafMode.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 paranoia_level = 3;
+   */
+  paranoiaLevel = 0;
+
+  /**
+   * @generated from field: repeated libops.v1.WafRuleExclusion exclusions = 4;
+   */
+  exclusions: WafRuleExclusion[] = [];
+
+  constructor(data?: PartialMessage<SiteProxyWaf>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteProxyWaf";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "mode", kind: "enum", T: proto3.getEnumType(WafMode) },
+    { no: 3, name: "paranoia_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "exclusions", kind: "message", T: WafRuleExclusion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteProxyWaf {
+    return new SiteProxyWaf().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteProxyWaf {
+    return new SiteProxyWaf().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteProxyWaf {
+    return new SiteProxyWaf().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteProxyWaf | PlainMessage<SiteProxyWaf> | undefined, b: SiteProxyWaf | PlainMessage<SiteProxyWaf> | undefined): boolean {
+    return proto3.util.equals(SiteProxyWaf, a, b);
+  }
+}
+
+/**
+ * SiteProxyTls is a site's TLS policy as the controller renders and probes it
+ *
+ * @generated from message libops.v1.SiteProxyTls
+ */
+export class SiteProxyTls extends Message<SiteProxyTls> {
+  /**
+   * @generated from field: libops.v1.TlsVersion min_version = 1;
+   */
+  minVersion = TlsVersion.TLS_VERSION_UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 hsts_max_age_seconds = 2;
+   */
+  hstsMaxAgeSeconds = 0;
+
+  /**
+   * @generated from field: bool hsts_include_subdomains = 3;
+   */
+  hstsIncludeSubdomains = false;
+
+  /**
+   * @generated from field: bool hsts_preload = 4;
+   */
+  hstsPreload = false;
+
+  /**
+   * @generated from field: bool ocsp_stapling = 5;
+   */
+  ocspStapling = false;
+
+  /**
+   * Set with the private key when the site serves a custom certificate
+   *
+   * @generated from field: string certificate_pem = 6;
+   */
+  certificatePem = "";
+
+  /**
+   * @generated from field: string private_key_pem = 7;
+   */
+  privateKeyPem = "";
+
+  /**
+   * The site's domains, which the probe connects to
+   *
+   * @generated from field: repeated string domains = 8;
+   */
+  domains: string[] = [];
+
+  constructor(data?: PartialMessage<SiteProxyTls>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteProxyTls";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "min_version", kind: "enum", T: proto3.getEnumType(TlsVersion) },
+    { no: 2, name: "hsts_max_age_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "hsts_include_subdomains", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "hsts_preload", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "ocsp_stapling", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "certificate_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "private_key_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteProxyTls {
+    return new SiteProxyTls().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteProxyTls {
+    return new SiteProxyTls().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteProxyTls {
+    return new SiteProxyTls().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteProxyTls | PlainMessage<SiteProxyTls> | undefined, b: SiteProxyTls | PlainMessage<SiteProxyTls> | undefined): boolean {
+    return proto3.util.equals(SiteProxyTls, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SyncManifestRequest
+ */
+export class SyncManifestRequest extends Message<SyncManifestRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * For ETag optimization (304 Not Modified)
+   *
+   * @generated from field: optional string current_state_hash = 2;
+   */
+  currentStateHash?: string;
+
+  constructor(data?: PartialMessage<SyncManifestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SyncManifestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "current_state_hash", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncManifestRequest {
+    return new SyncManifestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncManifestRequest {
+    return new SyncManifestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncManifestRequest {
+    return new SyncManifestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SyncManifestRequest | PlainMessage<SyncManifestRequest> | undefined, b: SyncManifestRequest | PlainMessage<SyncManifestRequest> | undefined): boolean {
+    return proto3.util.equals(SyncManifestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SyncManifestResponse
+ */
+export class SyncManifestResponse extends Message<SyncManifestResponse> {
+  /**
+   * Current state hash for this site
+   *
+   * @generated from field: string state_hash = 1;
+   */
+  stateHash = "";
+
+  /**
+   * Signed URLs to GCS blobs
    *
    * @generated from field: libops.v1.StateBlobs blobs = 2;
    */
-  blobs?: StateBlobs;
+  blobs?: StateBlobs;
+
+  constructor(data?: PartialMessage<SyncManifestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SyncManifestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "state_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "blobs", kind: "message", T: StateBlobs },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncManifestResponse {
+    return new SyncManifestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncManifestResponse {
+    return new SyncManifestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncManifestResponse {
+    return new SyncManifestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SyncManifestResponse | PlainMessage<SyncManifestResponse> | undefined, b: SyncManifestResponse | PlainMessage<SyncManifestResponse> | undefined): boolean {
+    return proto3.util.equals(SyncManifestResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.StateBlobs
+ */
+export class StateBlobs extends Message<StateBlobs> {
+  /**
+   * Signed GCS URL to ssh-keys.json
+   *
+   * @generated from field: string ssh_keys_url = 1;
+   */
+  sshKeysUrl = "";
+
+  /**
+   * Signed GCS URL to secrets.json
+   *
+   * @generated from field: string secrets_url = 2;
+   */
+  secretsUrl = "";
+
+  /**
+   * Signed GCS URL to firewall.json
+   *
+   * @generated from field: string firewall_url = 3;
+   */
+  firewallUrl = "";
+
+  constructor(data?: PartialMessage<StateBlobs>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.StateBlobs";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ssh_keys_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "secrets_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "firewall_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StateBlobs {
+    return new StateBlobs().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StateBlobs {
+    return new StateBlobs().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StateBlobs {
+    return new StateBlobs().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StateBlobs | PlainMessage<StateBlobs> | undefined, b: StateBlobs | PlainMessage<StateBlobs> | undefined): boolean {
+    return proto3.util.equals(StateBlobs, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetBlobRequest
+ */
+export class GetBlobRequest extends Message<GetBlobRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * "ssh_keys", "secrets", "firewall"
+   *
+   * @generated from field: string blob_type = 2;
+   */
+  blobType = "";
+
+  constructor(data?: PartialMessage<GetBlobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetBlobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "blob_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBlobRequest {
+    return new GetBlobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBlobRequest {
+    return new GetBlobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBlobRequest {
+    return new GetBlobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBlobRequest | PlainMessage<GetBlobRequest> | undefined, b: GetBlobRequest | PlainMessage<GetBlobRequest> | undefined): boolean {
+    return proto3.util.equals(GetBlobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetBlobResponse
+ */
+export class GetBlobResponse extends Message<GetBlobResponse> {
+  /**
+   * Blob content (JSON)
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * "application/json"
+   *
+   * @generated from field: string content_type = 2;
+   */
+  contentType = "";
+
+  constructor(data?: PartialMessage<GetBlobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetBlobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBlobResponse {
+    return new GetBlobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBlobResponse {
+    return new GetBlobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBlobResponse {
+    return new GetBlobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetBlobResponse | PlainMessage<GetBlobResponse> | undefined, b: GetBlobResponse | PlainMessage<GetBlobResponse> | undefined): boolean {
+    return proto3.util.equals(GetBlobResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetReconciliationRunRequest
+ */
+export class GetReconciliationRunRequest extends Message<GetReconciliationRunRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  constructor(data?: PartialMessage<GetReconciliationRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetReconciliationRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReconciliationRunRequest {
+    return new GetReconciliationRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReconciliationRunRequest {
+    return new GetReconciliationRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReconciliationRunRequest {
+    return new GetReconciliationRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReconciliationRunRequest | PlainMessage<GetReconciliationRunRequest> | undefined, b: GetReconciliationRunRequest | PlainMessage<GetReconciliationRunRequest> | undefined): boolean {
+    return proto3.util.equals(GetReconciliationRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetReconciliationRunResponse
+ */
+export class GetReconciliationRunResponse extends Message<GetReconciliationRunResponse> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * terraform or reconciliation
+   *
+   * @generated from field: string run_type = 2;
+   */
+  runType = "";
+
+  /**
+   * ssh_keys, secrets, firewall, general
+   *
+   * @generated from field: optional string reconciliation_type = 3;
+   */
+  reconciliationType?: string;
+
+  /**
+   * For terraform runs
+   *
+   * @generated from field: repeated string modules = 4;
+   */
+  modules: string[] = [];
+
+  /**
+   * For reconciliation runs
+   *
+   * @generated from field: repeated int64 target_site_ids = 5;
+   */
+  targetSiteIds: bigint[] = [];
+
+  /**
+   * @generated from field: repeated string event_ids = 6;
+   */
+  eventIds: string[] = [];
+
+  /**
+   * @generated from field: optional int64 organization_id = 7;
+   */
+  organizationId?: bigint;
+
+  /**
+   * @generated from field: optional int64 project_id = 8;
+   */
+  projectId?: bigint;
+
+  /**
+   * @generated from field: optional int64 site_id = 9;
+   */
+  siteId?: bigint;
+
+  /**
+   * @generated from field: string status = 10;
+   */
+  status = "";
+
+  /**
+   * The terraform states the run applies, in order
+   *
+   * @generated from field: repeated libops.v1.TerraformWorkspace workspaces = 11;
+   */
+  workspaces: TerraformWorkspace[] = [];
+
+  constructor(data?: PartialMessage<GetReconciliationRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetReconciliationRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reconciliation_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "target_site_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 6, name: "event_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "organization_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 8, name: "project_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 9, name: "site_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 10, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "workspaces", kind: "message", T: TerraformWorkspace, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReconciliationRunResponse {
+    return new GetReconciliationRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReconciliationRunResponse {
+    return new GetReconciliationRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReconciliationRunResponse {
+    return new GetReconciliationRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReconciliationRunResponse | PlainMessage<GetReconciliationRunResponse> | undefined, b: GetReconciliationRunResponse | PlainMessage<GetReconciliationRunResponse> | undefined): boolean {
+    return proto3.util.equals(GetReconciliationRunResponse, a, b);
+  }
+}
+
+/**
+ * TerraformWorkspace is a terraform state in the organization's tfstate
+ * bucket. Monolithic organizations keep everything in one state that runs
+ * target modules in; isolated organizations give each project and site a
+ * state of its own.
+ *
+ * @generated from message libops.v1.TerraformWorkspace
+ */
+export class TerraformWorkspace extends Message<TerraformWorkspace> {
+  /**
+   * GCS backend prefix, e.g. "terraform/sites/<site public ID>"
+   *
+   * @generated from field: string state_prefix = 1;
+   */
+  statePrefix = "";
+
+  /**
+   * Scope to pass to GenerateTerraformVars
+   *
+   * @generated from field: optional int64 organization_id = 2;
+   */
+  organizationId?: bigint;
+
+  /**
+   * @generated from field: optional int64 project_id = 3;
+   */
+  projectId?: bigint;
+
+  /**
+   * @generated from field: optional int64 site_id = 4;
+   */
+  siteId?: bigint;
+
+  /**
+   * The state only holds its scope's own resource, not the projects and sites under it
+   *
+   * @generated from field: bool isolated = 5;
+   */
+  isolated = false;
+
+  /**
+   * -target addresses, only for monolithic states
+   *
+   * @generated from field: repeated string targets = 6;
+   */
+  targets: string[] = [];
+
+  /**
+   * Module address a state migration moves into this workspace, e.g. module.sites["<site public ID>"]
+   *
+   * @generated from field: string address = 7;
+   */
+  address = "";
+
+  constructor(data?: PartialMessage<TerraformWorkspace>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TerraformWorkspace";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "state_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "project_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 4, name: "site_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 5, name: "isolated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "targets", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerraformWorkspace {
+    return new TerraformWorkspace().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerraformWorkspace {
+    return new TerraformWorkspace().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerraformWorkspace {
+    return new TerraformWorkspace().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerraformWorkspace | PlainMessage<TerraformWorkspace> | undefined, b: TerraformWorkspace | PlainMessage<TerraformWorkspace> | undefined): boolean {
+    return proto3.util.equals(TerraformWorkspace, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateReconciliationStatusRequest
+ */
+export class UpdateReconciliationStatusRequest extends Message<UpdateReconciliationStatusRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * pending, triggered, running, completed, failed
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: optional string error_message = 3;
+   */
+  errorMessage?: string;
+
+  constructor(data?: PartialMessage<UpdateReconciliationStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateReconciliationStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReconciliationStatusRequest {
+    return new UpdateReconciliationStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusRequest {
+    return new UpdateReconciliationStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusRequest {
+    return new UpdateReconciliationStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateReconciliationStatusRequest | PlainMessage<UpdateReconciliationStatusRequest> | undefined, b: UpdateReconciliationStatusRequest | PlainMessage<UpdateReconciliationStatusRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateReconciliationStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateReconciliationStatusResponse
+ */
+export class UpdateReconciliationStatusResponse extends Message<UpdateReconciliationStatusResponse> {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<UpdateReconciliationStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateReconciliationStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReconciliationStatusResponse {
+    return new UpdateReconciliationStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusResponse {
+    return new UpdateReconciliationStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusResponse {
+    return new UpdateReconciliationStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateReconciliationStatusResponse | PlainMessage<UpdateReconciliationStatusResponse> | undefined, b: UpdateReconciliationStatusResponse | PlainMessage<UpdateReconciliationStatusResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateReconciliationStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GenerateTerraformVarsRequest
+ */
+export class GenerateTerraformVarsRequest extends Message<GenerateTerraformVarsRequest> {
+  /**
+   * @generated from field: optional int64 organization_id = 1;
+   */
+  organizationId?: bigint;
+
+  /**
+   * @generated from field: optional int64 project_id = 2;
+   */
+  projectId?: bigint;
+
+  /**
+   * @generated from field: optional int64 site_id = 3;
+   */
+  siteId?: bigint;
+
+  /**
+   * Only the scope's own resource, for an isolated workspace
+   *
+   * @generated from field: bool isolated = 4;
+   */
+  isolated = false;
+
+  constructor(data?: PartialMessage<GenerateTerraformVarsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GenerateTerraformVarsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 2, name: "project_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "site_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 4, name: "isolated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTerraformVarsRequest {
+    return new GenerateTerraformVarsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTerraformVarsRequest {
+    return new GenerateTerraformVarsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTerraformVarsRequest {
+    return new GenerateTerraformVarsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateTerraformVarsRequest | PlainMessage<GenerateTerraformVarsRequest> | undefined, b: GenerateTerraformVarsRequest | PlainMessage<GenerateTerraformVarsRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateTerraformVarsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GenerateTerraformVarsResponse
+ */
+export class GenerateTerraformVarsResponse extends Message<GenerateTerraformVarsResponse> {
+  /**
+   * JSON-encoded terraform variables
+   *
+   * @generated from field: string tfvars_json = 1;
+   */
+  tfvarsJson = "";
+
+  constructor(data?: PartialMessage<GenerateTerraformVarsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GenerateTerraformVarsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tfvars_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTerraformVarsResponse {
+    return new GenerateTerraformVarsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTerraformVarsResponse {
+    return new GenerateTerraformVarsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTerraformVarsResponse {
+    return new GenerateTerraformVarsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateTerraformVarsResponse | PlainMessage<GenerateTerraformVarsResponse> | undefined, b: GenerateTerraformVarsResponse | PlainMessage<GenerateTerraformVarsResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateTerraformVarsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResolvePrivateServiceConnectEndpointRequest
+ */
+export class ResolvePrivateServiceConnectEndpointRequest extends Message<ResolvePrivateServiceConnectEndpointRequest> {
+  /**
+   * The organization, or a site in it; site controllers only know their site
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectTarget target = 3;
+   */
+  target = PrivateServiceConnectTarget.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ResolvePrivateServiceConnectEndpointRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResolvePrivateServiceConnectEndpointRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "target", kind: "enum", T: proto3.getEnumType(PrivateServiceConnectTarget) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolvePrivateServiceConnectEndpointRequest {
+    return new ResolvePrivateServiceConnectEndpointRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolvePrivateServiceConnectEndpointRequest {
+    return new ResolvePrivateServiceConnectEndpointRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolvePrivateServiceConnectEndpointRequest {
+    return new ResolvePrivateServiceConnectEndpointRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResolvePrivateServiceConnectEndpointRequest | PlainMessage<ResolvePrivateServiceConnectEndpointRequest> | undefined, b: ResolvePrivateServiceConnectEndpointRequest | PlainMessage<ResolvePrivateServiceConnectEndpointRequest> | undefined): boolean {
+    return proto3.util.equals(ResolvePrivateServiceConnectEndpointRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResolvePrivateServiceConnectEndpointResponse
+ */
+export class ResolvePrivateServiceConnectEndpointResponse extends Message<ResolvePrivateServiceConnectEndpointResponse> {
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectEndpoint endpoint = 1;
+   */
+  endpoint?: PrivateServiceConnectEndpoint;
+
+  constructor(data?: PartialMessage<ResolvePrivateServiceConnectEndpointResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResolvePrivateServiceConnectEndpointResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint", kind: "message", T: PrivateServiceConnectEndpoint },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolvePrivateServiceConnectEndpointResponse {
+    return new ResolvePrivateServiceConnectEndpointResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolvePrivateServiceConnectEndpointResponse {
+    return new ResolvePrivateServiceConnectEndpointResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolvePrivateServiceConnectEndpointResponse {
+    return new ResolvePrivateServiceConnectEndpointResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResolvePrivateServiceConnectEndpointResponse | PlainMessage<ResolvePrivateServiceConnectEndpointResponse> | undefined, b: ResolvePrivateServiceConnectEndpointResponse | PlainMessage<ResolvePrivateServiceConnectEndpointResponse> | undefined): boolean {
+    return proto3.util.equals(ResolvePrivateServiceConnectEndpointResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AppliedPrivateServiceConnectEndpoint
+ */
+export class AppliedPrivateServiceConnectEndpoint extends Message<AppliedPrivateServiceConnectEndpoint> {
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectTarget target = 1;
+   */
+  target = PrivateServiceConnectTarget.UNSPECIFIED;
+
+  /**
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress = "";
+
+  /**
+   * @generated from field: string forwarding_rule = 3;
+   */
+  forwardingRule = "";
+
+  constructor(data?: PartialMessage<AppliedPrivateServiceConnectEndpoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AppliedPrivateServiceConnectEndpoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "target", kind: "enum", T: proto3.getEnumType(PrivateServiceConnectTarget) },
+    { no: 2, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "forwarding_rule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppliedPrivateServiceConnectEndpoint {
+    return new AppliedPrivateServiceConnectEndpoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppliedPrivateServiceConnectEndpoint {
+    return new AppliedPrivateServiceConnectEndpoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppliedPrivateServiceConnectEndpoint {
+    return new AppliedPrivateServiceConnectEndpoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AppliedPrivateServiceConnectEndpoint | PlainMessage<AppliedPrivateServiceConnectEndpoint> | undefined, b: AppliedPrivateServiceConnectEndpoint | PlainMessage<AppliedPrivateServiceConnectEndpoint> | undefined): boolean {
+    return proto3.util.equals(AppliedPrivateServiceConnectEndpoint, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportPrivateServiceConnectEndpointsRequest
+ */
+export class ReportPrivateServiceConnectEndpointsRequest extends Message<ReportPrivateServiceConnectEndpointsRequest> {
+  /**
+   * Organization public ID
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Every endpoint in the organization's terraform state after apply
+   *
+   * @generated from field: repeated libops.v1.AppliedPrivateServiceConnectEndpoint endpoints = 2;
+   */
+  endpoints: AppliedPrivateServiceConnectEndpoint[] = [];
+
+  constructor(data?: PartialMessage<ReportPrivateServiceConnectEndpointsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportPrivateServiceConnectEndpointsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "endpoints", kind: "message", T: AppliedPrivateServiceConnectEndpoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportPrivateServiceConnectEndpointsRequest {
+    return new ReportPrivateServiceConnectEndpointsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportPrivateServiceConnectEndpointsRequest {
+    return new ReportPrivateServiceConnectEndpointsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportPrivateServiceConnectEndpointsRequest {
+    return new ReportPrivateServiceConnectEndpointsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportPrivateServiceConnectEndpointsRequest | PlainMessage<ReportPrivateServiceConnectEndpointsRequest> | undefined, b: ReportPrivateServiceConnectEndpointsRequest | PlainMessage<ReportPrivateServiceConnectEndpointsRequest> | undefined): boolean {
+    return proto3.util.equals(ReportPrivateServiceConnectEndpointsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportPrivateServiceConnectEndpointsResponse
+ */
+export class ReportPrivateServiceConnectEndpointsResponse extends Message<ReportPrivateServiceConnectEndpointsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PrivateServiceConnectEndpoint endpoints = 1;
+   */
+  endpoints: PrivateServiceConnectEndpoint[] = [];
+
+  constructor(data?: PartialMessage<ReportPrivateServiceConnectEndpointsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportPrivateServiceConnectEndpointsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoints", kind: "message", T: PrivateServiceConnectEndpoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportPrivateServiceConnectEndpointsResponse {
+    return new ReportPrivateServiceConnectEndpointsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportPrivateServiceConnectEndpointsResponse {
+    return new ReportPrivateServiceConnectEndpointsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportPrivateServiceConnectEndpointsResponse {
+    return new ReportPrivateServiceConnectEndpointsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportPrivateServiceConnectEndpointsResponse | PlainMessage<ReportPrivateServiceConnectEndpointsResponse> | undefined, b: ReportPrivateServiceConnectEndpointsResponse | PlainMessage<ReportPrivateServiceConnectEndpointsResponse> | undefined): boolean {
+    return proto3.util.equals(ReportPrivateServiceConnectEndpointsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteStaticEgressIpRequest
+ */
+export class ReportSiteStaticEgressIpRequest extends Message<ReportSiteStaticEgressIpRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Reserved address in the site's terraform state, empty if none
+   *
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress = "";
+
+  constructor(data?: PartialMessage<ReportSiteStaticEgressIpRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteStaticEgressIpRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteStaticEgressIpRequest {
+    return new ReportSiteStaticEgressIpRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteStaticEgressIpRequest {
+    return new ReportSiteStaticEgressIpRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteStaticEgressIpRequest {
+    return new ReportSiteStaticEgressIpRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteStaticEgressIpRequest | PlainMessage<ReportSiteStaticEgressIpRequest> | undefined, b: ReportSiteStaticEgressIpRequest | PlainMessage<ReportSiteStaticEgressIpRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteStaticEgressIpRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteStaticEgressIpResponse
+ */
+export class ReportSiteStaticEgressIpResponse extends Message<ReportSiteStaticEgressIpResponse> {
+  /**
+   * The site's static egress IP after the report; unset once it's released
+   *
+   * @generated from field: libops.v1.common.StaticEgressIp static_egress_ip = 1;
+   */
+  staticEgressIp?: StaticEgressIp;
+
+  constructor(data?: PartialMessage<ReportSiteStaticEgressIpResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteStaticEgressIpResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "static_egress_ip", kind: "message", T: StaticEgressIp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteStaticEgressIpResponse {
+    return new ReportSiteStaticEgressIpResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteStaticEgressIpResponse {
+    return new ReportSiteStaticEgressIpResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteStaticEgressIpResponse {
+    return new ReportSiteStaticEgressIpResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteStaticEgressIpResponse | PlainMessage<ReportSiteStaticEgressIpResponse> | undefined, b: ReportSiteStaticEgressIpResponse | PlainMessage<ReportSiteStaticEgressIpResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteStaticEgressIpResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteCdnRequest
+ */
+export class ReportSiteCdnRequest extends Message<ReportSiteCdnRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Load balancer address in the site's terraform state, empty if none
+   *
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress = "";
+
+  /**
+   * Cache purges the apply ran
+   *
+   * @generated from field: repeated string purged_ids = 3;
+   */
+  purgedIds: string[] = [];
+
+  constructor(data?: PartialMessage<ReportSiteCdnRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteCdnRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "purged_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteCdnRequest {
+    return new ReportSiteCdnRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteCdnRequest {
+    return new ReportSiteCdnRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteCdnRequest {
+    return new ReportSiteCdnRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteCdnRequest | PlainMessage<ReportSiteCdnRequest> | undefined, b: ReportSiteCdnRequest | PlainMessage<ReportSiteCdnRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteCdnRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteCdnResponse
+ */
+export class ReportSiteCdnResponse extends Message<ReportSiteCdnResponse> {
+  /**
+   * The site's CDN after the report; unset once it's disabled
+   *
+   * @generated from field: libops.v1.common.SiteCdn cdn = 1;
+   */
+  cdn?: SiteCdn;
+
+  constructor(data?: PartialMessage<ReportSiteCdnResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteCdnResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cdn", kind: "message", T: SiteCdn },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteCdnResponse {
+    return new ReportSiteCdnResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteCdnResponse {
+    return new ReportSiteCdnResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteCdnResponse {
+    return new ReportSiteCdnResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteCdnResponse | PlainMessage<ReportSiteCdnResponse> | undefined, b: ReportSiteCdnResponse | PlainMessage<ReportSiteCdnResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteCdnResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteDatabaseInstanceRequest
+ */
+export class ReportSiteDatabaseInstanceRequest extends Message<ReportSiteDatabaseInstanceRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
 
-  constructor(data?: PartialMessage<SyncManifestResponse>) {
+  /**
+   * Cloud SQL instance address in the site's terraform state, empty if none
+   *
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress = "";
+
+  constructor(data?: PartialMessage<ReportSiteDatabaseInstanceRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.SyncManifestResponse";
+  static readonly typeName = "libops.v1.ReportSiteDatabaseInstanceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "state_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "blobs", kind: "message", T: StateBlobs },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncManifestResponse {
-    return new SyncManifestResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteDatabaseInstanceRequest {
+    return new ReportSiteDatabaseInstanceRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncManifestResponse {
-    return new SyncManifestResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteDatabaseInstanceRequest {
+    return new ReportSiteDatabaseInstanceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteDatabaseInstanceRequest {
+    return new ReportSiteDatabaseInstanceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteDatabaseInstanceRequest | PlainMessage<ReportSiteDatabaseInstanceRequest> | undefined, b: ReportSiteDatabaseInstanceRequest | PlainMessage<ReportSiteDatabaseInstanceRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteDatabaseInstanceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteDatabaseInstanceResponse
+ */
+export class ReportSiteDatabaseInstanceResponse extends Message<ReportSiteDatabaseInstanceResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SiteDatabase databases = 1;
+   */
+  databases: SiteDatabase[] = [];
+
+  constructor(data?: PartialMessage<ReportSiteDatabaseInstanceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteDatabaseInstanceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "databases", kind: "message", T: SiteDatabase, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteDatabaseInstanceResponse {
+    return new ReportSiteDatabaseInstanceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteDatabaseInstanceResponse {
+    return new ReportSiteDatabaseInstanceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteDatabaseInstanceResponse {
+    return new ReportSiteDatabaseInstanceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteDatabaseInstanceResponse | PlainMessage<ReportSiteDatabaseInstanceResponse> | undefined, b: ReportSiteDatabaseInstanceResponse | PlainMessage<ReportSiteDatabaseInstanceResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteDatabaseInstanceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteTlsProbeRequest
+ */
+export class ReportSiteTlsProbeRequest extends Message<ReportSiteTlsProbeRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: bool passed = 2;
+   */
+  passed = false;
+
+  /**
+   * What failed
+   *
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ReportSiteTlsProbeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteTlsProbeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteTlsProbeRequest {
+    return new ReportSiteTlsProbeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteTlsProbeRequest {
+    return new ReportSiteTlsProbeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteTlsProbeRequest {
+    return new ReportSiteTlsProbeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteTlsProbeRequest | PlainMessage<ReportSiteTlsProbeRequest> | undefined, b: ReportSiteTlsProbeRequest | PlainMessage<ReportSiteTlsProbeRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteTlsProbeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteTlsProbeResponse
+ */
+export class ReportSiteTlsProbeResponse extends Message<ReportSiteTlsProbeResponse> {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<ReportSiteTlsProbeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteTlsProbeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteTlsProbeResponse {
+    return new ReportSiteTlsProbeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteTlsProbeResponse {
+    return new ReportSiteTlsProbeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteTlsProbeResponse {
+    return new ReportSiteTlsProbeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportSiteTlsProbeResponse | PlainMessage<ReportSiteTlsProbeResponse> | undefined, b: ReportSiteTlsProbeResponse | PlainMessage<ReportSiteTlsProbeResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteTlsProbeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteDatabasesRequest
+ */
+export class GetSiteDatabasesRequest extends Message<GetSiteDatabasesRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteDatabasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteDatabasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteDatabasesRequest {
+    return new GetSiteDatabasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteDatabasesRequest {
+    return new GetSiteDatabasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteDatabasesRequest {
+    return new GetSiteDatabasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteDatabasesRequest | PlainMessage<GetSiteDatabasesRequest> | undefined, b: GetSiteDatabasesRequest | PlainMessage<GetSiteDatabasesRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteDatabasesRequest, a, b);
+  }
+}
+
+/**
+ * ColocatedDatabase is a database the controller creates on the site VM's
+ * MySQL server, with a user of the same name
+ *
+ * @generated from message libops.v1.ColocatedDatabase
+ */
+export class ColocatedDatabase extends Message<ColocatedDatabase> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password = "";
+
+  constructor(data?: PartialMessage<ColocatedDatabase>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ColocatedDatabase";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColocatedDatabase {
+    return new ColocatedDatabase().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColocatedDatabase {
+    return new ColocatedDatabase().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColocatedDatabase {
+    return new ColocatedDatabase().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColocatedDatabase | PlainMessage<ColocatedDatabase> | undefined, b: ColocatedDatabase | PlainMessage<ColocatedDatabase> | undefined): boolean {
+    return proto3.util.equals(ColocatedDatabase, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteDatabasesResponse
+ */
+export class GetSiteDatabasesResponse extends Message<GetSiteDatabasesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ColocatedDatabase databases = 1;
+   */
+  databases: ColocatedDatabase[] = [];
+
+  constructor(data?: PartialMessage<GetSiteDatabasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteDatabasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "databases", kind: "message", T: ColocatedDatabase, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteDatabasesResponse {
+    return new GetSiteDatabasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteDatabasesResponse {
+    return new GetSiteDatabasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteDatabasesResponse {
+    return new GetSiteDatabasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteDatabasesResponse | PlainMessage<GetSiteDatabasesResponse> | undefined, b: GetSiteDatabasesResponse | PlainMessage<GetSiteDatabasesResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteDatabasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportedDatabase
+ */
+export class ReportedDatabase extends Message<ReportedDatabase> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Why it couldn't be provisioned; empty once it is
+   *
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ReportedDatabase>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportedDatabase";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportedDatabase {
+    return new ReportedDatabase().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportedDatabase {
+    return new ReportedDatabase().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportedDatabase {
+    return new ReportedDatabase().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportedDatabase | PlainMessage<ReportedDatabase> | undefined, b: ReportedDatabase | PlainMessage<ReportedDatabase> | undefined): boolean {
+    return proto3.util.equals(ReportedDatabase, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportSiteDatabasesRequest
+ */
+export class ReportSiteDatabasesRequest extends Message<ReportSiteDatabasesRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Where the site's containers reach the MySQL server
+   *
+   * @generated from field: string host = 2;
+   */
+  host = "";
+
+  /**
+   * @generated from field: int32 port = 3;
+   */
+  port = 0;
+
+  /**
+   * @generated from field: repeated libops.v1.ReportedDatabase databases = 4;
+   */
+  databases: ReportedDatabase[] = [];
+
+  constructor(data?: PartialMessage<ReportSiteDatabasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportSiteDatabasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "databases", kind: "message", T: ReportedDatabase, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteDatabasesRequest {
+    return new ReportSiteDatabasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteDatabasesRequest {
+    return new ReportSiteDatabasesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncManifestResponse {
-    return new SyncManifestResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteDatabasesRequest {
+    return new ReportSiteDatabasesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SyncManifestResponse | PlainMessage<SyncManifestResponse> | undefined, b: SyncManifestResponse | PlainMessage<SyncManifestResponse> | undefined): boolean {
-    return proto3.util.equals(SyncManifestResponse, a, b);
+  static equals(a: ReportSiteDatabasesRequest | PlainMessage<ReportSiteDatabasesRequest> | undefined, b: ReportSiteDatabasesRequest | PlainMessage<ReportSiteDatabasesRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteDatabasesRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.StateBlobs
+ * @generated from message libops.v1.ReportSiteDatabasesResponse
  */
-export class StateBlobs extends Message<StateBlobs> {
-  /**
-   * Signed GCS URL to ssh-keys.json
-   *
-   * @generated from field: string ssh_keys_url = 1;
-   */
-  sshKeysUrl = "";
-
-  /**
-   * Signed GCS URL to secrets.json
-   *
-   * @generated from field: string secrets_url = 2;
-   */
-  secretsUrl = "";
-
+export class ReportSiteDatabasesResponse extends Message<ReportSiteDatabasesResponse> {
   /**
-   * Signed GCS URL to firewall.json
-   *
-   * @generated from field: string firewall_url = 3;
+   * @generated from field: repeated libops.v1.SiteDatabase databases = 1;
    */
-  firewallUrl = "";
+  databases: SiteDatabase[] = [];
 
-  constructor(data?: PartialMessage<StateBlobs>) {
+  constructor(data?: PartialMessage<ReportSiteDatabasesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.StateBlobs";
+  static readonly typeName = "libops.v1.ReportSiteDatabasesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ssh_keys_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "secrets_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "firewall_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "databases", kind: "message", T: SiteDatabase, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StateBlobs {
-    return new StateBlobs().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteDatabasesResponse {
+    return new ReportSiteDatabasesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StateBlobs {
-    return new StateBlobs().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteDatabasesResponse {
+    return new ReportSiteDatabasesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StateBlobs {
-    return new StateBlobs().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteDatabasesResponse {
+    return new ReportSiteDatabasesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StateBlobs | PlainMessage<StateBlobs> | undefined, b: StateBlobs | PlainMessage<StateBlobs> | undefined): boolean {
-    return proto3.util.equals(StateBlobs, a, b);
+  static equals(a: ReportSiteDatabasesResponse | PlainMessage<ReportSiteDatabasesResponse> | undefined, b: ReportSiteDatabasesResponse | PlainMessage<ReportSiteDatabasesResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteDatabasesResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GetBlobRequest
+ * @generated from message libops.v1.GetSiteAddonsRequest
  */
-export class GetBlobRequest extends Message<GetBlobRequest> {
+export class GetSiteAddonsRequest extends Message<GetSiteAddonsRequest> {
   /**
    * Site public ID
    *
@@ -2096,398 +4471,344 @@ export class GetBlobRequest extends Message<GetBlobRequest> {
    */
   siteId = "";
 
-  /**
-   * "ssh_keys", "secrets", "firewall"
-   *
-   * @generated from field: string blob_type = 2;
-   */
-  blobType = "";
-
-  constructor(data?: PartialMessage<GetBlobRequest>) {
+  constructor(data?: PartialMessage<GetSiteAddonsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetBlobRequest";
+  static readonly typeName = "libops.v1.GetSiteAddonsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "blob_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBlobRequest {
-    return new GetBlobRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteAddonsRequest {
+    return new GetSiteAddonsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBlobRequest {
-    return new GetBlobRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteAddonsRequest {
+    return new GetSiteAddonsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBlobRequest {
-    return new GetBlobRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteAddonsRequest {
+    return new GetSiteAddonsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBlobRequest | PlainMessage<GetBlobRequest> | undefined, b: GetBlobRequest | PlainMessage<GetBlobRequest> | undefined): boolean {
-    return proto3.util.equals(GetBlobRequest, a, b);
+  static equals(a: GetSiteAddonsRequest | PlainMessage<GetSiteAddonsRequest> | undefined, b: GetSiteAddonsRequest | PlainMessage<GetSiteAddonsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteAddonsRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GetBlobResponse
+ * AddonSpec is an add-on the controller runs for the site
+ *
+ * @generated from message libops.v1.AddonSpec
  */
-export class GetBlobResponse extends Message<GetBlobResponse> {
+export class AddonSpec extends Message<AddonSpec> {
   /**
-   * Blob content (JSON)
+   * "solr", "memcached", "redis" or "matomo"
    *
-   * @generated from field: bytes data = 1;
+   * @generated from field: string kind = 1;
    */
-  data = new Uint8Array(0);
+  kind = "";
 
   /**
-   * "application/json"
+   * Empty for add-ons without credentials
    *
-   * @generated from field: string content_type = 2;
+   * @generated from field: string password = 2;
    */
-  contentType = "";
+  password = "";
 
-  constructor(data?: PartialMessage<GetBlobResponse>) {
+  constructor(data?: PartialMessage<AddonSpec>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetBlobResponse";
+  static readonly typeName = "libops.v1.AddonSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBlobResponse {
-    return new GetBlobResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddonSpec {
+    return new AddonSpec().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBlobResponse {
-    return new GetBlobResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddonSpec {
+    return new AddonSpec().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBlobResponse {
-    return new GetBlobResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddonSpec {
+    return new AddonSpec().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetBlobResponse | PlainMessage<GetBlobResponse> | undefined, b: GetBlobResponse | PlainMessage<GetBlobResponse> | undefined): boolean {
-    return proto3.util.equals(GetBlobResponse, a, b);
+  static equals(a: AddonSpec | PlainMessage<AddonSpec> | undefined, b: AddonSpec | PlainMessage<AddonSpec> | undefined): boolean {
+    return proto3.util.equals(AddonSpec, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GetReconciliationRunRequest
+ * @generated from message libops.v1.GetSiteAddonsResponse
  */
-export class GetReconciliationRunRequest extends Message<GetReconciliationRunRequest> {
+export class GetSiteAddonsResponse extends Message<GetSiteAddonsResponse> {
   /**
-   * @generated from field: string run_id = 1;
+   * @generated from field: repeated libops.v1.AddonSpec addons = 1;
    */
-  runId = "";
+  addons: AddonSpec[] = [];
 
-  constructor(data?: PartialMessage<GetReconciliationRunRequest>) {
+  constructor(data?: PartialMessage<GetSiteAddonsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetReconciliationRunRequest";
+  static readonly typeName = "libops.v1.GetSiteAddonsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "addons", kind: "message", T: AddonSpec, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReconciliationRunRequest {
-    return new GetReconciliationRunRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteAddonsResponse {
+    return new GetSiteAddonsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReconciliationRunRequest {
-    return new GetReconciliationRunRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteAddonsResponse {
+    return new GetSiteAddonsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReconciliationRunRequest {
-    return new GetReconciliationRunRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteAddonsResponse {
+    return new GetSiteAddonsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReconciliationRunRequest | PlainMessage<GetReconciliationRunRequest> | undefined, b: GetReconciliationRunRequest | PlainMessage<GetReconciliationRunRequest> | undefined): boolean {
-    return proto3.util.equals(GetReconciliationRunRequest, a, b);
+  static equals(a: GetSiteAddonsResponse | PlainMessage<GetSiteAddonsResponse> | undefined, b: GetSiteAddonsResponse | PlainMessage<GetSiteAddonsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteAddonsResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GetReconciliationRunResponse
+ * @generated from message libops.v1.ReportedAddon
  */
-export class GetReconciliationRunResponse extends Message<GetReconciliationRunResponse> {
-  /**
-   * @generated from field: string run_id = 1;
-   */
-  runId = "";
-
+export class ReportedAddon extends Message<ReportedAddon> {
   /**
-   * terraform or reconciliation
-   *
-   * @generated from field: string run_type = 2;
+   * @generated from field: string kind = 1;
    */
-  runType = "";
+  kind = "";
 
   /**
-   * ssh_keys, secrets, firewall, general
+   * Where the site's containers reach it
    *
-   * @generated from field: optional string reconciliation_type = 3;
+   * @generated from field: string host = 2;
    */
-  reconciliationType?: string;
+  host = "";
 
   /**
-   * For terraform runs
-   *
-   * @generated from field: repeated string modules = 4;
+   * @generated from field: int32 port = 3;
    */
-  modules: string[] = [];
+  port = 0;
 
   /**
-   * For reconciliation runs
+   * Why it's unhealthy; empty when it's healthy
    *
-   * @generated from field: repeated int64 target_site_ids = 5;
-   */
-  targetSiteIds: bigint[] = [];
-
-  /**
-   * @generated from field: repeated string event_ids = 6;
-   */
-  eventIds: string[] = [];
-
-  /**
-   * @generated from field: optional int64 organization_id = 7;
-   */
-  organizationId?: bigint;
-
-  /**
-   * @generated from field: optional int64 project_id = 8;
-   */
-  projectId?: bigint;
-
-  /**
-   * @generated from field: optional int64 site_id = 9;
-   */
-  siteId?: bigint;
-
-  /**
-   * @generated from field: string status = 10;
+   * @generated from field: string error = 4;
    */
-  status = "";
+  error = "";
 
-  constructor(data?: PartialMessage<GetReconciliationRunResponse>) {
+  constructor(data?: PartialMessage<ReportedAddon>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetReconciliationRunResponse";
+  static readonly typeName = "libops.v1.ReportedAddon";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "reconciliation_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 4, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 5, name: "target_site_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
-    { no: 6, name: "event_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 7, name: "organization_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
-    { no: 8, name: "project_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
-    { no: 9, name: "site_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
-    { no: 10, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReconciliationRunResponse {
-    return new GetReconciliationRunResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportedAddon {
+    return new ReportedAddon().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReconciliationRunResponse {
-    return new GetReconciliationRunResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportedAddon {
+    return new ReportedAddon().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReconciliationRunResponse {
-    return new GetReconciliationRunResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportedAddon {
+    return new ReportedAddon().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetReconciliationRunResponse | PlainMessage<GetReconciliationRunResponse> | undefined, b: GetReconciliationRunResponse | PlainMessage<GetReconciliationRunResponse> | undefined): boolean {
-    return proto3.util.equals(GetReconciliationRunResponse, a, b);
+  static equals(a: ReportedAddon | PlainMessage<ReportedAddon> | undefined, b: ReportedAddon | PlainMessage<ReportedAddon> | undefined): boolean {
+    return proto3.util.equals(ReportedAddon, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.UpdateReconciliationStatusRequest
+ * @generated from message libops.v1.ReportSiteAddonsRequest
  */
-export class UpdateReconciliationStatusRequest extends Message<UpdateReconciliationStatusRequest> {
-  /**
-   * @generated from field: string run_id = 1;
-   */
-  runId = "";
-
+export class ReportSiteAddonsRequest extends Message<ReportSiteAddonsRequest> {
   /**
-   * pending, triggered, running, completed, failed
+   * Site public ID
    *
-   * @generated from field: string status = 2;
+   * @generated from field: string site_id = 1;
    */
-  status = "";
+  siteId = "";
 
   /**
-   * @generated from field: optional string error_message = 3;
+   * @generated from field: repeated libops.v1.ReportedAddon addons = 2;
    */
-  errorMessage?: string;
+  addons: ReportedAddon[] = [];
 
-  constructor(data?: PartialMessage<UpdateReconciliationStatusRequest>) {
+  constructor(data?: PartialMessage<ReportSiteAddonsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateReconciliationStatusRequest";
+  static readonly typeName = "libops.v1.ReportSiteAddonsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "addons", kind: "message", T: ReportedAddon, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReconciliationStatusRequest {
-    return new UpdateReconciliationStatusRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteAddonsRequest {
+    return new ReportSiteAddonsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusRequest {
-    return new UpdateReconciliationStatusRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteAddonsRequest {
+    return new ReportSiteAddonsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusRequest {
-    return new UpdateReconciliationStatusRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteAddonsRequest {
+    return new ReportSiteAddonsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateReconciliationStatusRequest | PlainMessage<UpdateReconciliationStatusRequest> | undefined, b: UpdateReconciliationStatusRequest | PlainMessage<UpdateReconciliationStatusRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateReconciliationStatusRequest, a, b);
+  static equals(a: ReportSiteAddonsRequest | PlainMessage<ReportSiteAddonsRequest> | undefined, b: ReportSiteAddonsRequest | PlainMessage<ReportSiteAddonsRequest> | undefined): boolean {
+    return proto3.util.equals(ReportSiteAddonsRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.UpdateReconciliationStatusResponse
+ * @generated from message libops.v1.ReportSiteAddonsResponse
  */
-export class UpdateReconciliationStatusResponse extends Message<UpdateReconciliationStatusResponse> {
+export class ReportSiteAddonsResponse extends Message<ReportSiteAddonsResponse> {
   /**
-   * @generated from field: bool success = 1;
+   * @generated from field: repeated libops.v1.SiteAddon addons = 1;
    */
-  success = false;
+  addons: SiteAddon[] = [];
 
-  constructor(data?: PartialMessage<UpdateReconciliationStatusResponse>) {
+  constructor(data?: PartialMessage<ReportSiteAddonsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateReconciliationStatusResponse";
+  static readonly typeName = "libops.v1.ReportSiteAddonsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "addons", kind: "message", T: SiteAddon, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateReconciliationStatusResponse {
-    return new UpdateReconciliationStatusResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSiteAddonsResponse {
+    return new ReportSiteAddonsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusResponse {
-    return new UpdateReconciliationStatusResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportSiteAddonsResponse {
+    return new ReportSiteAddonsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateReconciliationStatusResponse {
-    return new UpdateReconciliationStatusResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportSiteAddonsResponse {
+    return new ReportSiteAddonsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateReconciliationStatusResponse | PlainMessage<UpdateReconciliationStatusResponse> | undefined, b: UpdateReconciliationStatusResponse | PlainMessage<UpdateReconciliationStatusResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateReconciliationStatusResponse, a, b);
+  static equals(a: ReportSiteAddonsResponse | PlainMessage<ReportSiteAddonsResponse> | undefined, b: ReportSiteAddonsResponse | PlainMessage<ReportSiteAddonsResponse> | undefined): boolean {
+    return proto3.util.equals(ReportSiteAddonsResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GenerateTerraformVarsRequest
+ * @generated from message libops.v1.GetSiteConfigVarsRequest
  */
-export class GenerateTerraformVarsRequest extends Message<GenerateTerraformVarsRequest> {
-  /**
-   * @generated from field: optional int64 organization_id = 1;
-   */
-  organizationId?: bigint;
-
-  /**
-   * @generated from field: optional int64 project_id = 2;
-   */
-  projectId?: bigint;
-
+export class GetSiteConfigVarsRequest extends Message<GetSiteConfigVarsRequest> {
   /**
-   * @generated from field: optional int64 site_id = 3;
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
    */
-  siteId?: bigint;
+  siteId = "";
 
-  constructor(data?: PartialMessage<GenerateTerraformVarsRequest>) {
+  constructor(data?: PartialMessage<GetSiteConfigVarsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GenerateTerraformVarsRequest";
+  static readonly typeName = "libops.v1.GetSiteConfigVarsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
-    { no: 2, name: "project_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
-    { no: 3, name: "site_id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTerraformVarsRequest {
-    return new GenerateTerraformVarsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteConfigVarsRequest {
+    return new GetSiteConfigVarsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTerraformVarsRequest {
-    return new GenerateTerraformVarsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteConfigVarsRequest {
+    return new GetSiteConfigVarsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTerraformVarsRequest {
-    return new GenerateTerraformVarsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteConfigVarsRequest {
+    return new GetSiteConfigVarsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateTerraformVarsRequest | PlainMessage<GenerateTerraformVarsRequest> | undefined, b: GenerateTerraformVarsRequest | PlainMessage<GenerateTerraformVarsRequest> | undefined): boolean {
-    return proto3.util.equals(GenerateTerraformVarsRequest, a, b);
+  static equals(a: GetSiteConfigVarsRequest | PlainMessage<GetSiteConfigVarsRequest> | undefined, b: GetSiteConfigVarsRequest | PlainMessage<GetSiteConfigVarsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteConfigVarsRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GenerateTerraformVarsResponse
+ * @generated from message libops.v1.GetSiteConfigVarsResponse
  */
-export class GenerateTerraformVarsResponse extends Message<GenerateTerraformVarsResponse> {
+export class GetSiteConfigVarsResponse extends Message<GetSiteConfigVarsResponse> {
   /**
-   * JSON-encoded terraform variables
+   * Keyed by variable name, config vars overriding settings
    *
-   * @generated from field: string tfvars_json = 1;
+   * @generated from field: repeated libops.v1.Secret config_vars = 1;
    */
-  tfvarsJson = "";
+  configVars: Secret[] = [];
 
-  constructor(data?: PartialMessage<GenerateTerraformVarsResponse>) {
+  /**
+   * The site's latest config var revision
+   *
+   * @generated from field: int64 revision = 2;
+   */
+  revision = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetSiteConfigVarsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GenerateTerraformVarsResponse";
+  static readonly typeName = "libops.v1.GetSiteConfigVarsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "tfvars_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "config_vars", kind: "message", T: Secret, repeated: true },
+    { no: 2, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTerraformVarsResponse {
-    return new GenerateTerraformVarsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteConfigVarsResponse {
+    return new GetSiteConfigVarsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTerraformVarsResponse {
-    return new GenerateTerraformVarsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteConfigVarsResponse {
+    return new GetSiteConfigVarsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTerraformVarsResponse {
-    return new GenerateTerraformVarsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteConfigVarsResponse {
+    return new GetSiteConfigVarsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateTerraformVarsResponse | PlainMessage<GenerateTerraformVarsResponse> | undefined, b: GenerateTerraformVarsResponse | PlainMessage<GenerateTerraformVarsResponse> | undefined): boolean {
-    return proto3.util.equals(GenerateTerraformVarsResponse, a, b);
+  static equals(a: GetSiteConfigVarsResponse | PlainMessage<GetSiteConfigVarsResponse> | undefined, b: GetSiteConfigVarsResponse | PlainMessage<GetSiteConfigVarsResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteConfigVarsResponse, a, b);
   }
 }
 
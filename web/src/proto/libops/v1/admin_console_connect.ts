@@ -0,0 +1,297 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/admin_console.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CancelReconciliationRunRequest, CancelReconciliationRunResponse, CopyStateBackupRequest, CopyStateBackupResponse, CreateControllerReleaseRequest, CreateControllerReleaseResponse, DeleteControllerConfigRequest, DeleteControllerConfigResponse, DescribeReconciliationRunRequest, DescribeReconciliationRunResponse, ExplainAccessRequest, ExplainAccessResponse, ForceReconciliationRequest, ForceReconciliationResponse, GetControllerConfigRequest, GetControllerConfigResponse, GetEventQueueHealthRequest, GetEventQueueHealthResponse, ListControllerReleasesRequest, ListControllerReleasesResponse, ListPlatformOrganizationsRequest, ListPlatformOrganizationsResponse, ListReconciliationRunsRequest, ListReconciliationRunsResponse, ListStateVersionsRequest, ListStateVersionsResponse, LookupResourceRequest, LookupResourceResponse, MarkReconciliationRunTriggeredRequest, MarkReconciliationRunTriggeredResponse, MigrateStateWorkspacesRequest, MigrateStateWorkspacesResponse, ReconcileFleetRequest, ReconcileFleetResponse, RestoreStateRequest, RestoreStateResponse, RetryReconciliationRunRequest, RetryReconciliationRunResponse, SuspendOrganizationRequest, SuspendOrganizationResponse, UnsuspendOrganizationRequest, UnsuspendOrganizationResponse, UpdateControllerConfigRequest, UpdateControllerConfigResponse } from "./admin_console_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * AdminService is the platform operators' console. It answers the questions
+ * that otherwise take direct database access: which organizations are behind
+ * on billing, how healthy the event queue is, and what an ID seen in a log or
+ * the GCP console belongs to. It has scopes of its own, read:platform and
+ * write:platform, which admin:system keys don't carry.
+ *
+ * @generated from service libops.v1.AdminService
+ */
+export const AdminService = {
+  typeName: "libops.v1.AdminService",
+  methods: {
+    /**
+     * List every organization with its billing state and size
+     *
+     * @generated from rpc libops.v1.AdminService.ListPlatformOrganizations
+     */
+    listPlatformOrganizations: {
+      name: "ListPlatformOrganizations",
+      I: ListPlatformOrganizationsRequest,
+      O: ListPlatformOrganizationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Queue a reconciliation of an organization, project or site
+     *
+     * @generated from rpc libops.v1.AdminService.ForceReconciliation
+     */
+    forceReconciliation: {
+      name: "ForceReconciliation",
+      I: ForceReconciliationRequest,
+      O: ForceReconciliationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Queue a secrets or firewall reconciliation for many sites at once. Each
+     * site's reconciliation waits for its maintenance window like any other
+     * change's; the event router publishes it on its next pass once the window
+     * is open.
+     *
+     * @generated from rpc libops.v1.AdminService.ReconcileFleet
+     */
+    reconcileFleet: {
+      name: "ReconcileFleet",
+      I: ReconcileFleetRequest,
+      O: ReconcileFleetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List terraform and site reconciliation runs, newest first
+     *
+     * @generated from rpc libops.v1.AdminService.ListReconciliationRuns
+     */
+    listReconciliationRuns: {
+      name: "ListReconciliationRuns",
+      I: ListReconciliationRunsRequest,
+      O: ListReconciliationRunsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get a run with its results and the Cloud Logging query for its logs
+     *
+     * @generated from rpc libops.v1.AdminService.DescribeReconciliationRun
+     */
+    describeReconciliationRun: {
+      name: "DescribeReconciliationRun",
+      I: DescribeReconciliationRunRequest,
+      O: DescribeReconciliationRunResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Run a failed or cancelled run again. A terraform run is copied into a new
+     * pending run for the organization's runner job to execute; a site
+     * reconciliation is published again by the event router on its next pass.
+     *
+     * @generated from rpc libops.v1.AdminService.RetryReconciliationRun
+     */
+    retryReconciliationRun: {
+      name: "RetryReconciliationRun",
+      I: RetryReconciliationRunRequest,
+      O: RetryReconciliationRunResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Record that the runner job was started for a pending terraform run, such
+     * as one RetryReconciliationRun created. A run the runner already reported
+     * on is left as it is.
+     *
+     * @generated from rpc libops.v1.AdminService.MarkReconciliationRunTriggered
+     */
+    markReconciliationRunTriggered: {
+      name: "MarkReconciliationRunTriggered",
+      I: MarkReconciliationRunTriggeredRequest,
+      O: MarkReconciliationRunTriggeredResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Cancel a terraform run that hasn't finished, or stop the event router
+     * retrying a failed site reconciliation. A runner job already executing
+     * the run isn't stopped; its status reports are ignored.
+     *
+     * @generated from rpc libops.v1.AdminService.CancelReconciliationRun
+     */
+    cancelReconciliationRun: {
+      name: "CancelReconciliationRun",
+      I: CancelReconciliationRunRequest,
+      O: CancelReconciliationRunResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Suspend an abusive organization and stop its sites
+     *
+     * @generated from rpc libops.v1.AdminService.SuspendOrganization
+     */
+    suspendOrganization: {
+      name: "SuspendOrganization",
+      I: SuspendOrganizationRequest,
+      O: SuspendOrganizationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lift an operator suspension. Sites stay suspended while billing still holds them.
+     *
+     * @generated from rpc libops.v1.AdminService.UnsuspendOrganization
+     */
+    unsuspendOrganization: {
+      name: "UnsuspendOrganization",
+      I: UnsuspendOrganizationRequest,
+      O: UnsuspendOrganizationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Summarize the event queue: backlog, age of the oldest pending event, stuck and dead-lettered events
+     *
+     * @generated from rpc libops.v1.AdminService.GetEventQueueHealth
+     */
+    getEventQueueHealth: {
+      name: "GetEventQueueHealth",
+      I: GetEventQueueHealthRequest,
+      O: GetEventQueueHealthResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Find what an ID belongs to: any public ID, deployment, reconciliation run or
+     * event ID, GCP project or folder, account email or GitHub workflow run
+     *
+     * @generated from rpc libops.v1.AdminService.LookupResource
+     */
+    lookupResource: {
+      name: "LookupResource",
+      I: LookupResourceRequest,
+      O: LookupResourceResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Explain whether an account can act on an organization, project or site:
+     * each membership, relationship, scope, auditor and network check and the
+     * policy that decided, to debug denials
+     *
+     * @generated from rpc libops.v1.AdminService.ExplainAccess
+     */
+    explainAccess: {
+      name: "ExplainAccess",
+      I: ExplainAccessRequest,
+      O: ExplainAccessResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get the fleet-wide controller config, or a site's override and the
+     * config its controller applies
+     *
+     * @generated from rpc libops.v1.AdminService.GetControllerConfig
+     */
+    getControllerConfig: {
+      name: "GetControllerConfig",
+      I: GetControllerConfigRequest,
+      O: GetControllerConfigResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Replace the fleet-wide controller config or a site's override. Controllers
+     * apply it on their next check-in.
+     *
+     * @generated from rpc libops.v1.AdminService.UpdateControllerConfig
+     */
+    updateControllerConfig: {
+      name: "UpdateControllerConfig",
+      I: UpdateControllerConfigRequest,
+      O: UpdateControllerConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a site's controller config override, so it follows the fleet again
+     *
+     * @generated from rpc libops.v1.AdminService.DeleteControllerConfig
+     */
+    deleteControllerConfig: {
+      name: "DeleteControllerConfig",
+      I: DeleteControllerConfigRequest,
+      O: DeleteControllerConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List published controller releases, newest first, with how many sites
+     * run each
+     *
+     * @generated from rpc libops.v1.AdminService.ListControllerReleases
+     */
+    listControllerReleases: {
+      name: "ListControllerReleases",
+      I: ListControllerReleasesRequest,
+      O: ListControllerReleasesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Publish a signed controller release. Sites update to it once a controller
+     * config names its version.
+     *
+     * @generated from rpc libops.v1.AdminService.CreateControllerRelease
+     */
+    createControllerRelease: {
+      name: "CreateControllerRelease",
+      I: CreateControllerReleaseRequest,
+      O: CreateControllerReleaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the kept versions of an organization's Terraform state, whether a run
+     * holds its lock, and the backups taken of it
+     *
+     * @generated from rpc libops.v1.AdminService.ListStateVersions
+     */
+    listStateVersions: {
+      name: "ListStateVersions",
+      I: ListStateVersionsRequest,
+      O: ListStateVersionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Copy an organization's current Terraform state to a backup, e.g. before a
+     * state migration or import
+     *
+     * @generated from rpc libops.v1.AdminService.CopyStateBackup
+     */
+    copyStateBackup: {
+      name: "CopyStateBackup",
+      I: CopyStateBackupRequest,
+      O: CopyStateBackupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Make a kept state version or backup the organization's current Terraform
+     * state, so its next run plans against it. The current state is backed up
+     * first, and the restore is refused while a run holds the state lock.
+     *
+     * @generated from rpc libops.v1.AdminService.RestoreState
+     */
+    restoreState: {
+      name: "RestoreState",
+      I: RestoreStateRequest,
+      O: RestoreStateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Split a monolithic organization's Terraform state into a workspace per
+     * project and site. The state is backed up and a terraform run moves each
+     * project and site into its own state; the organization is isolated once the
+     * run completes.
+     *
+     * @generated from rpc libops.v1.AdminService.MigrateStateWorkspaces
+     */
+    migrateStateWorkspaces: {
+      name: "MigrateStateWorkspaces",
+      I: MigrateStateWorkspacesRequest,
+      O: MigrateStateWorkspacesResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
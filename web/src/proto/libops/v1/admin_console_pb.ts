@@ -0,0 +1,2892 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/admin_console.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Status } from "./common/types_pb.js";
+import { FleetFilter } from "./fleet_pb.js";
+import { AccessLevel, ResourceType } from "./options/scope_pb.js";
+import { ControllerConfig, ControllerRelease } from "./admin_api_pb.js";
+
+/**
+ * TerraformStateLayout is how an organization's Terraform state is split
+ *
+ * @generated from enum libops.v1.TerraformStateLayout
+ */
+export enum TerraformStateLayout {
+  /**
+   * @generated from enum value: TERRAFORM_STATE_LAYOUT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * One state; runs target modules in it
+   *
+   * @generated from enum value: TERRAFORM_STATE_LAYOUT_MONOLITHIC = 1;
+   */
+  MONOLITHIC = 1,
+
+  /**
+   * A run is moving projects and sites into workspaces
+   *
+   * @generated from enum value: TERRAFORM_STATE_LAYOUT_MIGRATING = 2;
+   */
+  MIGRATING = 2,
+
+  /**
+   * A state per project and site
+   *
+   * @generated from enum value: TERRAFORM_STATE_LAYOUT_ISOLATED = 3;
+   */
+  ISOLATED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TerraformStateLayout)
+proto3.util.setEnumType(TerraformStateLayout, "libops.v1.TerraformStateLayout", [
+  { no: 0, name: "TERRAFORM_STATE_LAYOUT_UNSPECIFIED" },
+  { no: 1, name: "TERRAFORM_STATE_LAYOUT_MONOLITHIC" },
+  { no: 2, name: "TERRAFORM_STATE_LAYOUT_MIGRATING" },
+  { no: 3, name: "TERRAFORM_STATE_LAYOUT_ISOLATED" },
+]);
+
+/**
+ * @generated from message libops.v1.PlatformOrganization
+ */
+export class PlatformOrganization extends Message<PlatformOrganization> {
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string organization_name = 2;
+   */
+  organizationName = "";
+
+  /**
+   * @generated from field: libops.v1.common.Status status = 3;
+   */
+  status = Status.UNSPECIFIED;
+
+  /**
+   * Dunning state: active, past_due, suspended or scheduled_for_deletion
+   *
+   * @generated from field: string billing_state = 4;
+   */
+  billingState = "";
+
+  /**
+   * Unix timestamp, 0 if never changed
+   *
+   * @generated from field: int64 billing_state_changed_at = 5;
+   */
+  billingStateChangedAt = protoInt64.zero;
+
+  /**
+   * Stripe subscription status, empty without a subscription
+   *
+   * @generated from field: string subscription_status = 6;
+   */
+  subscriptionStatus = "";
+
+  /**
+   * Unix timestamp, 0 unless a payment is failing
+   *
+   * @generated from field: int64 payment_failed_at = 7;
+   */
+  paymentFailedAt = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 project_count = 8;
+   */
+  projectCount = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 site_count = 9;
+   */
+  siteCount = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 10;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp of an operator suspension, 0 if none
+   *
+   * @generated from field: int64 suspended_at = 11;
+   */
+  suspendedAt = protoInt64.zero;
+
+  /**
+   * @generated from field: string suspended_reason = 12;
+   */
+  suspendedReason = "";
+
+  constructor(data?: PartialMessage<PlatformOrganization>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PlatformOrganization";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 4, name: "billing_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "billing_state_changed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "subscription_status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "payment_failed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "project_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "site_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "suspended_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "suspended_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlatformOrganization {
+    return new PlatformOrganization().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlatformOrganization {
+    return new PlatformOrganization().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlatformOrganization {
+    return new PlatformOrganization().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlatformOrganization | PlainMessage<PlatformOrganization> | undefined, b: PlatformOrganization | PlainMessage<PlatformOrganization> | undefined): boolean {
+    return proto3.util.equals(PlatformOrganization, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPlatformOrganizationsRequest
+ */
+export class ListPlatformOrganizationsRequest extends Message<ListPlatformOrganizationsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken = "";
+
+  /**
+   * Only organizations in this billing state
+   *
+   * @generated from field: optional string billing_state = 3;
+   */
+  billingState?: string;
+
+  /**
+   * Only organizations with this status
+   *
+   * @generated from field: optional libops.v1.common.Status status = 4;
+   */
+  status?: Status;
+
+  constructor(data?: PartialMessage<ListPlatformOrganizationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPlatformOrganizationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "billing_state", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(Status), opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPlatformOrganizationsRequest {
+    return new ListPlatformOrganizationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPlatformOrganizationsRequest {
+    return new ListPlatformOrganizationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPlatformOrganizationsRequest {
+    return new ListPlatformOrganizationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPlatformOrganizationsRequest | PlainMessage<ListPlatformOrganizationsRequest> | undefined, b: ListPlatformOrganizationsRequest | PlainMessage<ListPlatformOrganizationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPlatformOrganizationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPlatformOrganizationsResponse
+ */
+export class ListPlatformOrganizationsResponse extends Message<ListPlatformOrganizationsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PlatformOrganization organizations = 1;
+   */
+  organizations: PlatformOrganization[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListPlatformOrganizationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPlatformOrganizationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organizations", kind: "message", T: PlatformOrganization, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPlatformOrganizationsResponse {
+    return new ListPlatformOrganizationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPlatformOrganizationsResponse {
+    return new ListPlatformOrganizationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPlatformOrganizationsResponse {
+    return new ListPlatformOrganizationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPlatformOrganizationsResponse | PlainMessage<ListPlatformOrganizationsResponse> | undefined, b: ListPlatformOrganizationsResponse | PlainMessage<ListPlatformOrganizationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPlatformOrganizationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ForceReconciliationRequest
+ */
+export class ForceReconciliationRequest extends Message<ForceReconciliationRequest> {
+  /**
+   * The resource to reconcile; a site reconciles just that site, a project or
+   * organization everything beneath it
+   *
+   * @generated from oneof libops.v1.ForceReconciliationRequest.resource
+   */
+  resource: {
+    /**
+     * @generated from field: string organization_id = 1;
+     */
+    value: string;
+    case: "organizationId";
+  } | {
+    /**
+     * @generated from field: string project_id = 2;
+     */
+    value: string;
+    case: "projectId";
+  } | {
+    /**
+     * @generated from field: string site_id = 3;
+     */
+    value: string;
+    case: "siteId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * Why, for the audit log
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<ForceReconciliationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ForceReconciliationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "resource" },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "resource" },
+    { no: 3, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "resource" },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForceReconciliationRequest {
+    return new ForceReconciliationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForceReconciliationRequest {
+    return new ForceReconciliationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForceReconciliationRequest {
+    return new ForceReconciliationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForceReconciliationRequest | PlainMessage<ForceReconciliationRequest> | undefined, b: ForceReconciliationRequest | PlainMessage<ForceReconciliationRequest> | undefined): boolean {
+    return proto3.util.equals(ForceReconciliationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ForceReconciliationResponse
+ */
+export class ForceReconciliationResponse extends Message<ForceReconciliationResponse> {
+  /**
+   * The update event queued for the control plane
+   *
+   * @generated from field: string event_type = 1;
+   */
+  eventType = "";
+
+  constructor(data?: PartialMessage<ForceReconciliationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ForceReconciliationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForceReconciliationResponse {
+    return new ForceReconciliationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForceReconciliationResponse {
+    return new ForceReconciliationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForceReconciliationResponse {
+    return new ForceReconciliationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForceReconciliationResponse | PlainMessage<ForceReconciliationResponse> | undefined, b: ForceReconciliationResponse | PlainMessage<ForceReconciliationResponse> | undefined): boolean {
+    return proto3.util.equals(ForceReconciliationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReconcileFleetRequest
+ */
+export class ReconcileFleetRequest extends Message<ReconcileFleetRequest> {
+  /**
+   * The sites to reconcile; ignored when site_ids is set
+   *
+   * @generated from field: libops.v1.FleetFilter filter = 1;
+   */
+  filter?: FleetFilter;
+
+  /**
+   * At most 500 sites, e.g. one batch of a dry run's matches
+   *
+   * @generated from field: repeated string site_ids = 2;
+   */
+  siteIds: string[] = [];
+
+  /**
+   * "secrets" or "firewall"
+   *
+   * @generated from field: string reconciliation_type = 3;
+   */
+  reconciliationType = "";
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * Only return the matching sites
+   *
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<ReconcileFleetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconcileFleetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: FleetFilter },
+    { no: 2, name: "site_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "reconciliation_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileFleetRequest {
+    return new ReconcileFleetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileFleetRequest {
+    return new ReconcileFleetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileFleetRequest {
+    return new ReconcileFleetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconcileFleetRequest | PlainMessage<ReconcileFleetRequest> | undefined, b: ReconcileFleetRequest | PlainMessage<ReconcileFleetRequest> | undefined): boolean {
+    return proto3.util.equals(ReconcileFleetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReconcileFleetResponse
+ */
+export class ReconcileFleetResponse extends Message<ReconcileFleetResponse> {
+  /**
+   * The sites queued, or that would be queued by a dry run
+   *
+   * @generated from field: repeated string site_ids = 1;
+   */
+  siteIds: string[] = [];
+
+  /**
+   * @generated from field: repeated libops.v1.FleetReconcileFailure failures = 2;
+   */
+  failures: FleetReconcileFailure[] = [];
+
+  constructor(data?: PartialMessage<ReconcileFleetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconcileFleetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "failures", kind: "message", T: FleetReconcileFailure, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileFleetResponse {
+    return new ReconcileFleetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileFleetResponse {
+    return new ReconcileFleetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileFleetResponse {
+    return new ReconcileFleetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconcileFleetResponse | PlainMessage<ReconcileFleetResponse> | undefined, b: ReconcileFleetResponse | PlainMessage<ReconcileFleetResponse> | undefined): boolean {
+    return proto3.util.equals(ReconcileFleetResponse, a, b);
+  }
+}
+
+/**
+ * A site whose reconciliation couldn't be queued
+ *
+ * @generated from message libops.v1.FleetReconcileFailure
+ */
+export class FleetReconcileFailure extends Message<FleetReconcileFailure> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<FleetReconcileFailure>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.FleetReconcileFailure";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FleetReconcileFailure {
+    return new FleetReconcileFailure().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FleetReconcileFailure {
+    return new FleetReconcileFailure().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FleetReconcileFailure {
+    return new FleetReconcileFailure().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FleetReconcileFailure | PlainMessage<FleetReconcileFailure> | undefined, b: FleetReconcileFailure | PlainMessage<FleetReconcileFailure> | undefined): boolean {
+    return proto3.util.equals(FleetReconcileFailure, a, b);
+  }
+}
+
+/**
+ * A terraform run or site reconciliation
+ *
+ * @generated from message libops.v1.PlatformReconciliationRun
+ */
+export class PlatformReconciliationRun extends Message<PlatformReconciliationRun> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * terraform or reconciliation
+   *
+   * @generated from field: string run_type = 2;
+   */
+  runType = "";
+
+  /**
+   * ssh_keys, secrets, firewall or general; empty for terraform runs
+   *
+   * @generated from field: string reconciliation_type = 3;
+   */
+  reconciliationType = "";
+
+  /**
+   * Terraform modules the run applies
+   *
+   * @generated from field: repeated string modules = 4;
+   */
+  modules: string[] = [];
+
+  /**
+   * pending, triggered, running, completed, failed or cancelled
+   *
+   * @generated from field: string status = 5;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage = "";
+
+  /**
+   * Public IDs of the run's scope; a site run has all three
+   *
+   * @generated from field: string organization_id = 7;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string project_id = 8;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string site_id = 9;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 attempt = 10;
+   */
+  attempt = 0;
+
+  /**
+   * What the event router did with a failed site reconciliation: scheduled,
+   * retried, superseded or exhausted; empty otherwise
+   *
+   * @generated from field: string retry_state = 11;
+   */
+  retryState = "";
+
+  /**
+   * Unix timestamp, 0 unless a retry is scheduled
+   *
+   * @generated from field: int64 next_retry_at = 12;
+   */
+  nextRetryAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 13;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until triggered
+   *
+   * @generated from field: int64 triggered_at = 14;
+   */
+  triggeredAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until started
+   *
+   * @generated from field: int64 started_at = 15;
+   */
+  startedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until finished
+   *
+   * @generated from field: int64 completed_at = 16;
+   */
+  completedAt = protoInt64.zero;
+
+  /**
+   * The organization's GCP project, where its terraform runner job runs
+   *
+   * @generated from field: string gcp_project_id = 17;
+   */
+  gcpProjectId = "";
+
+  constructor(data?: PartialMessage<PlatformReconciliationRun>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PlatformReconciliationRun";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reconciliation_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "retry_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "next_retry_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "triggered_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "started_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 17, name: "gcp_project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlatformReconciliationRun {
+    return new PlatformReconciliationRun().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlatformReconciliationRun {
+    return new PlatformReconciliationRun().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlatformReconciliationRun {
+    return new PlatformReconciliationRun().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlatformReconciliationRun | PlainMessage<PlatformReconciliationRun> | undefined, b: PlatformReconciliationRun | PlainMessage<PlatformReconciliationRun> | undefined): boolean {
+    return proto3.util.equals(PlatformReconciliationRun, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListReconciliationRunsRequest
+ */
+export class ListReconciliationRunsRequest extends Message<ListReconciliationRunsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken = "";
+
+  /**
+   * Only terraform or reconciliation runs
+   *
+   * @generated from field: optional string run_type = 3;
+   */
+  runType?: string;
+
+  /**
+   * @generated from field: optional string status = 4;
+   */
+  status?: string;
+
+  /**
+   * Only runs for this organization, including its projects and sites
+   *
+   * @generated from field: optional string organization_id = 5;
+   */
+  organizationId?: string;
+
+  /**
+   * Only runs for this site
+   *
+   * @generated from field: optional string site_id = 6;
+   */
+  siteId?: string;
+
+  constructor(data?: PartialMessage<ListReconciliationRunsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListReconciliationRunsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReconciliationRunsRequest {
+    return new ListReconciliationRunsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReconciliationRunsRequest {
+    return new ListReconciliationRunsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReconciliationRunsRequest {
+    return new ListReconciliationRunsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReconciliationRunsRequest | PlainMessage<ListReconciliationRunsRequest> | undefined, b: ListReconciliationRunsRequest | PlainMessage<ListReconciliationRunsRequest> | undefined): boolean {
+    return proto3.util.equals(ListReconciliationRunsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListReconciliationRunsResponse
+ */
+export class ListReconciliationRunsResponse extends Message<ListReconciliationRunsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PlatformReconciliationRun runs = 1;
+   */
+  runs: PlatformReconciliationRun[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListReconciliationRunsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListReconciliationRunsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "runs", kind: "message", T: PlatformReconciliationRun, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReconciliationRunsResponse {
+    return new ListReconciliationRunsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReconciliationRunsResponse {
+    return new ListReconciliationRunsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReconciliationRunsResponse {
+    return new ListReconciliationRunsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReconciliationRunsResponse | PlainMessage<ListReconciliationRunsResponse> | undefined, b: ListReconciliationRunsResponse | PlainMessage<ListReconciliationRunsResponse> | undefined): boolean {
+    return proto3.util.equals(ListReconciliationRunsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DescribeReconciliationRunRequest
+ */
+export class DescribeReconciliationRunRequest extends Message<DescribeReconciliationRunRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  constructor(data?: PartialMessage<DescribeReconciliationRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DescribeReconciliationRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DescribeReconciliationRunRequest {
+    return new DescribeReconciliationRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DescribeReconciliationRunRequest {
+    return new DescribeReconciliationRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DescribeReconciliationRunRequest {
+    return new DescribeReconciliationRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DescribeReconciliationRunRequest | PlainMessage<DescribeReconciliationRunRequest> | undefined, b: DescribeReconciliationRunRequest | PlainMessage<DescribeReconciliationRunRequest> | undefined): boolean {
+    return proto3.util.equals(DescribeReconciliationRunRequest, a, b);
+  }
+}
+
+/**
+ * What a run did to one module or site
+ *
+ * @generated from message libops.v1.ReconciliationRunResult
+ */
+export class ReconciliationRunResult extends Message<ReconciliationRunResult> {
+  /**
+   * terraform_module or vm_reconciliation
+   *
+   * @generated from field: string result_type = 1;
+   */
+  resultType = "";
+
+  /**
+   * For terraform: organization, project or site
+   *
+   * @generated from field: string module_type = 2;
+   */
+  moduleType = "";
+
+  /**
+   * For site reconciliations: the site's public ID
+   *
+   * @generated from field: string site_id = 3;
+   */
+  siteId = "";
+
+  /**
+   * success or failed
+   *
+   * @generated from field: string status = 4;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string output = 5;
+   */
+  output = "";
+
+  /**
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 started_at = 7;
+   */
+  startedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 completed_at = 8;
+   */
+  completedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReconciliationRunResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconciliationRunResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "result_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "module_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "started_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconciliationRunResult {
+    return new ReconciliationRunResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconciliationRunResult {
+    return new ReconciliationRunResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconciliationRunResult {
+    return new ReconciliationRunResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconciliationRunResult | PlainMessage<ReconciliationRunResult> | undefined, b: ReconciliationRunResult | PlainMessage<ReconciliationRunResult> | undefined): boolean {
+    return proto3.util.equals(ReconciliationRunResult, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DescribeReconciliationRunResponse
+ */
+export class DescribeReconciliationRunResponse extends Message<DescribeReconciliationRunResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformReconciliationRun run = 1;
+   */
+  run?: PlatformReconciliationRun;
+
+  /**
+   * @generated from field: repeated libops.v1.ReconciliationRunResult results = 2;
+   */
+  results: ReconciliationRunResult[] = [];
+
+  /**
+   * Cloud Logging filter matching the run's log entries
+   *
+   * @generated from field: string log_filter = 3;
+   */
+  logFilter = "";
+
+  /**
+   * Project whose logs the filter applies to: the organization's project for
+   * terraform runs, empty for site reconciliations logged by the control plane
+   *
+   * @generated from field: string log_project = 4;
+   */
+  logProject = "";
+
+  constructor(data?: PartialMessage<DescribeReconciliationRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DescribeReconciliationRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run", kind: "message", T: PlatformReconciliationRun },
+    { no: 2, name: "results", kind: "message", T: ReconciliationRunResult, repeated: true },
+    { no: 3, name: "log_filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "log_project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DescribeReconciliationRunResponse {
+    return new DescribeReconciliationRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DescribeReconciliationRunResponse {
+    return new DescribeReconciliationRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DescribeReconciliationRunResponse {
+    return new DescribeReconciliationRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DescribeReconciliationRunResponse | PlainMessage<DescribeReconciliationRunResponse> | undefined, b: DescribeReconciliationRunResponse | PlainMessage<DescribeReconciliationRunResponse> | undefined): boolean {
+    return proto3.util.equals(DescribeReconciliationRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RetryReconciliationRunRequest
+ */
+export class RetryReconciliationRunRequest extends Message<RetryReconciliationRunRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * Why, for the audit log
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<RetryReconciliationRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RetryReconciliationRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryReconciliationRunRequest {
+    return new RetryReconciliationRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryReconciliationRunRequest {
+    return new RetryReconciliationRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryReconciliationRunRequest {
+    return new RetryReconciliationRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryReconciliationRunRequest | PlainMessage<RetryReconciliationRunRequest> | undefined, b: RetryReconciliationRunRequest | PlainMessage<RetryReconciliationRunRequest> | undefined): boolean {
+    return proto3.util.equals(RetryReconciliationRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RetryReconciliationRunResponse
+ */
+export class RetryReconciliationRunResponse extends Message<RetryReconciliationRunResponse> {
+  /**
+   * The new run for a terraform run, the rescheduled run for a site
+   * reconciliation
+   *
+   * @generated from field: libops.v1.PlatformReconciliationRun run = 1;
+   */
+  run?: PlatformReconciliationRun;
+
+  constructor(data?: PartialMessage<RetryReconciliationRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RetryReconciliationRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run", kind: "message", T: PlatformReconciliationRun },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryReconciliationRunResponse {
+    return new RetryReconciliationRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryReconciliationRunResponse {
+    return new RetryReconciliationRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryReconciliationRunResponse {
+    return new RetryReconciliationRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryReconciliationRunResponse | PlainMessage<RetryReconciliationRunResponse> | undefined, b: RetryReconciliationRunResponse | PlainMessage<RetryReconciliationRunResponse> | undefined): boolean {
+    return proto3.util.equals(RetryReconciliationRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MarkReconciliationRunTriggeredRequest
+ */
+export class MarkReconciliationRunTriggeredRequest extends Message<MarkReconciliationRunTriggeredRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  constructor(data?: PartialMessage<MarkReconciliationRunTriggeredRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MarkReconciliationRunTriggeredRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkReconciliationRunTriggeredRequest {
+    return new MarkReconciliationRunTriggeredRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkReconciliationRunTriggeredRequest {
+    return new MarkReconciliationRunTriggeredRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkReconciliationRunTriggeredRequest {
+    return new MarkReconciliationRunTriggeredRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkReconciliationRunTriggeredRequest | PlainMessage<MarkReconciliationRunTriggeredRequest> | undefined, b: MarkReconciliationRunTriggeredRequest | PlainMessage<MarkReconciliationRunTriggeredRequest> | undefined): boolean {
+    return proto3.util.equals(MarkReconciliationRunTriggeredRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MarkReconciliationRunTriggeredResponse
+ */
+export class MarkReconciliationRunTriggeredResponse extends Message<MarkReconciliationRunTriggeredResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformReconciliationRun run = 1;
+   */
+  run?: PlatformReconciliationRun;
+
+  constructor(data?: PartialMessage<MarkReconciliationRunTriggeredResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MarkReconciliationRunTriggeredResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run", kind: "message", T: PlatformReconciliationRun },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkReconciliationRunTriggeredResponse {
+    return new MarkReconciliationRunTriggeredResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkReconciliationRunTriggeredResponse {
+    return new MarkReconciliationRunTriggeredResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkReconciliationRunTriggeredResponse {
+    return new MarkReconciliationRunTriggeredResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkReconciliationRunTriggeredResponse | PlainMessage<MarkReconciliationRunTriggeredResponse> | undefined, b: MarkReconciliationRunTriggeredResponse | PlainMessage<MarkReconciliationRunTriggeredResponse> | undefined): boolean {
+    return proto3.util.equals(MarkReconciliationRunTriggeredResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CancelReconciliationRunRequest
+ */
+export class CancelReconciliationRunRequest extends Message<CancelReconciliationRunRequest> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * Why, kept as the run's error message and in the audit log
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<CancelReconciliationRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CancelReconciliationRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelReconciliationRunRequest {
+    return new CancelReconciliationRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelReconciliationRunRequest {
+    return new CancelReconciliationRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelReconciliationRunRequest {
+    return new CancelReconciliationRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelReconciliationRunRequest | PlainMessage<CancelReconciliationRunRequest> | undefined, b: CancelReconciliationRunRequest | PlainMessage<CancelReconciliationRunRequest> | undefined): boolean {
+    return proto3.util.equals(CancelReconciliationRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CancelReconciliationRunResponse
+ */
+export class CancelReconciliationRunResponse extends Message<CancelReconciliationRunResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformReconciliationRun run = 1;
+   */
+  run?: PlatformReconciliationRun;
+
+  constructor(data?: PartialMessage<CancelReconciliationRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CancelReconciliationRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run", kind: "message", T: PlatformReconciliationRun },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelReconciliationRunResponse {
+    return new CancelReconciliationRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelReconciliationRunResponse {
+    return new CancelReconciliationRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelReconciliationRunResponse {
+    return new CancelReconciliationRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelReconciliationRunResponse | PlainMessage<CancelReconciliationRunResponse> | undefined, b: CancelReconciliationRunResponse | PlainMessage<CancelReconciliationRunResponse> | undefined): boolean {
+    return proto3.util.equals(CancelReconciliationRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SuspendOrganizationRequest
+ */
+export class SuspendOrganizationRequest extends Message<SuspendOrganizationRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Why, shown to operators and kept in the audit log
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<SuspendOrganizationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SuspendOrganizationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SuspendOrganizationRequest {
+    return new SuspendOrganizationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SuspendOrganizationRequest {
+    return new SuspendOrganizationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SuspendOrganizationRequest {
+    return new SuspendOrganizationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SuspendOrganizationRequest | PlainMessage<SuspendOrganizationRequest> | undefined, b: SuspendOrganizationRequest | PlainMessage<SuspendOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(SuspendOrganizationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SuspendOrganizationResponse
+ */
+export class SuspendOrganizationResponse extends Message<SuspendOrganizationResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformOrganization organization = 1;
+   */
+  organization?: PlatformOrganization;
+
+  /**
+   * @generated from field: int64 sites_suspended = 2;
+   */
+  sitesSuspended = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SuspendOrganizationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SuspendOrganizationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "message", T: PlatformOrganization },
+    { no: 2, name: "sites_suspended", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SuspendOrganizationResponse {
+    return new SuspendOrganizationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SuspendOrganizationResponse {
+    return new SuspendOrganizationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SuspendOrganizationResponse {
+    return new SuspendOrganizationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SuspendOrganizationResponse | PlainMessage<SuspendOrganizationResponse> | undefined, b: SuspendOrganizationResponse | PlainMessage<SuspendOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(SuspendOrganizationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UnsuspendOrganizationRequest
+ */
+export class UnsuspendOrganizationRequest extends Message<UnsuspendOrganizationRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<UnsuspendOrganizationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UnsuspendOrganizationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsuspendOrganizationRequest {
+    return new UnsuspendOrganizationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsuspendOrganizationRequest {
+    return new UnsuspendOrganizationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsuspendOrganizationRequest {
+    return new UnsuspendOrganizationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsuspendOrganizationRequest | PlainMessage<UnsuspendOrganizationRequest> | undefined, b: UnsuspendOrganizationRequest | PlainMessage<UnsuspendOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(UnsuspendOrganizationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UnsuspendOrganizationResponse
+ */
+export class UnsuspendOrganizationResponse extends Message<UnsuspendOrganizationResponse> {
+  /**
+   * @generated from field: libops.v1.PlatformOrganization organization = 1;
+   */
+  organization?: PlatformOrganization;
+
+  /**
+   * @generated from field: int64 sites_resumed = 2;
+   */
+  sitesResumed = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnsuspendOrganizationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UnsuspendOrganizationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "message", T: PlatformOrganization },
+    { no: 2, name: "sites_resumed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsuspendOrganizationResponse {
+    return new UnsuspendOrganizationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsuspendOrganizationResponse {
+    return new UnsuspendOrganizationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsuspendOrganizationResponse {
+    return new UnsuspendOrganizationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsuspendOrganizationResponse | PlainMessage<UnsuspendOrganizationResponse> | undefined, b: UnsuspendOrganizationResponse | PlainMessage<UnsuspendOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(UnsuspendOrganizationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetEventQueueHealthRequest
+ */
+export class GetEventQueueHealthRequest extends Message<GetEventQueueHealthRequest> {
+  /**
+   * How many of the newest dead-lettered events to return (default 20, max 100)
+   *
+   * @generated from field: int32 dead_letter_limit = 1;
+   */
+  deadLetterLimit = 0;
+
+  constructor(data?: PartialMessage<GetEventQueueHealthRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetEventQueueHealthRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dead_letter_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventQueueHealthRequest {
+    return new GetEventQueueHealthRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventQueueHealthRequest {
+    return new GetEventQueueHealthRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventQueueHealthRequest {
+    return new GetEventQueueHealthRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventQueueHealthRequest | PlainMessage<GetEventQueueHealthRequest> | undefined, b: GetEventQueueHealthRequest | PlainMessage<GetEventQueueHealthRequest> | undefined): boolean {
+    return proto3.util.equals(GetEventQueueHealthRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeadLetterEvent
+ */
+export class DeadLetterEvent extends Message<DeadLetterEvent> {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId = "";
+
+  /**
+   * @generated from field: string event_type = 2;
+   */
+  eventType = "";
+
+  /**
+   * @generated from field: int32 retry_count = 3;
+   */
+  retryCount = 0;
+
+  /**
+   * @generated from field: string last_error = 4;
+   */
+  lastError = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 if never retried
+   *
+   * @generated from field: int64 last_retry_at = 6;
+   */
+  lastRetryAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeadLetterEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeadLetterEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "event_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "retry_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "last_retry_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeadLetterEvent {
+    return new DeadLetterEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeadLetterEvent {
+    return new DeadLetterEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeadLetterEvent {
+    return new DeadLetterEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeadLetterEvent | PlainMessage<DeadLetterEvent> | undefined, b: DeadLetterEvent | PlainMessage<DeadLetterEvent> | undefined): boolean {
+    return proto3.util.equals(DeadLetterEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetEventQueueHealthResponse
+ */
+export class GetEventQueueHealthResponse extends Message<GetEventQueueHealthResponse> {
+  /**
+   * Number of events in each status (pending, processing, sent, executed, collapsed, dead_letter)
+   *
+   * @generated from field: map<string, int64> counts = 1;
+   */
+  counts: { [key: string]: bigint } = {};
+
+  /**
+   * @generated from field: string oldest_pending_event_id = 2;
+   */
+  oldestPendingEventId = "";
+
+  /**
+   * Seconds the oldest pending event has waited, 0 when nothing is pending
+   *
+   * @generated from field: int64 oldest_pending_age_seconds = 3;
+   */
+  oldestPendingAgeSeconds = protoInt64.zero;
+
+  /**
+   * Events claimed for processing longer ago than the stuck threshold
+   *
+   * @generated from field: int64 stuck_events = 4;
+   */
+  stuckEvents = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated libops.v1.DeadLetterEvent dead_letters = 5;
+   */
+  deadLetters: DeadLetterEvent[] = [];
+
+  constructor(data?: PartialMessage<GetEventQueueHealthResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetEventQueueHealthResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "counts", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 3 /* ScalarType.INT64 */} },
+    { no: 2, name: "oldest_pending_event_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "oldest_pending_age_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "stuck_events", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "dead_letters", kind: "message", T: DeadLetterEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventQueueHealthResponse {
+    return new GetEventQueueHealthResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventQueueHealthResponse {
+    return new GetEventQueueHealthResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventQueueHealthResponse {
+    return new GetEventQueueHealthResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventQueueHealthResponse | PlainMessage<GetEventQueueHealthResponse> | undefined, b: GetEventQueueHealthResponse | PlainMessage<GetEventQueueHealthResponse> | undefined): boolean {
+    return proto3.util.equals(GetEventQueueHealthResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.LookupResourceRequest
+ */
+export class LookupResourceRequest extends Message<LookupResourceRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<LookupResourceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.LookupResourceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LookupResourceRequest {
+    return new LookupResourceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LookupResourceRequest {
+    return new LookupResourceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LookupResourceRequest {
+    return new LookupResourceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LookupResourceRequest | PlainMessage<LookupResourceRequest> | undefined, b: LookupResourceRequest | PlainMessage<LookupResourceRequest> | undefined): boolean {
+    return proto3.util.equals(LookupResourceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResourceMatch
+ */
+export class ResourceMatch extends Message<ResourceMatch> {
+  /**
+   * organization, project, site, account, api_key, deployment, reconciliation_run or event
+   *
+   * @generated from field: string resource_type = 1;
+   */
+  resourceType = "";
+
+  /**
+   * @generated from field: string resource_id = 2;
+   */
+  resourceId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The field the ID matched, e.g. public_id, gcp_project_id or email
+   *
+   * @generated from field: string matched_field = 4;
+   */
+  matchedField = "";
+
+  /**
+   * The resource's place in the hierarchy, empty where it doesn't apply
+   *
+   * @generated from field: string organization_id = 5;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string project_id = 6;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string site_id = 7;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string status = 8;
+   */
+  status = "";
+
+  constructor(data?: PartialMessage<ResourceMatch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResourceMatch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "matched_field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceMatch {
+    return new ResourceMatch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceMatch {
+    return new ResourceMatch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceMatch {
+    return new ResourceMatch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceMatch | PlainMessage<ResourceMatch> | undefined, b: ResourceMatch | PlainMessage<ResourceMatch> | undefined): boolean {
+    return proto3.util.equals(ResourceMatch, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.LookupResourceResponse
+ */
+export class LookupResourceResponse extends Message<LookupResourceResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ResourceMatch matches = 1;
+   */
+  matches: ResourceMatch[] = [];
+
+  constructor(data?: PartialMessage<LookupResourceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.LookupResourceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "matches", kind: "message", T: ResourceMatch, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LookupResourceResponse {
+    return new LookupResourceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LookupResourceResponse {
+    return new LookupResourceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LookupResourceResponse {
+    return new LookupResourceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LookupResourceResponse | PlainMessage<LookupResourceResponse> | undefined, b: LookupResourceResponse | PlainMessage<LookupResourceResponse> | undefined): boolean {
+    return proto3.util.equals(LookupResourceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExplainAccessRequest
+ */
+export class ExplainAccessRequest extends Message<ExplainAccessRequest> {
+  /**
+   * Account public ID or email
+   *
+   * @generated from field: string account = 1;
+   */
+  account = "";
+
+  /**
+   * Organization, project or site
+   *
+   * @generated from field: libops.v1.options.ResourceType resource_type = 2;
+   */
+  resourceType = ResourceType.UNSPECIFIED;
+
+  /**
+   * UUID
+   *
+   * @generated from field: string resource_id = 3;
+   */
+  resourceId = "";
+
+  /**
+   * @generated from field: libops.v1.options.AccessLevel access_level = 4;
+   */
+  accessLevel = AccessLevel.UNSPECIFIED;
+
+  /**
+   * Public ID of one of the account's API keys, to check its scopes and
+   * networks; a session without scope restrictions when empty
+   *
+   * @generated from field: string api_key_id = 5;
+   */
+  apiKeyId = "";
+
+  /**
+   * Address the request would come from, to check network allowlists; skipped
+   * when empty
+   *
+   * @generated from field: string source_ip = 6;
+   */
+  sourceIp = "";
+
+  constructor(data?: PartialMessage<ExplainAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExplainAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resource_type", kind: "enum", T: proto3.getEnumType(ResourceType) },
+    { no: 3, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "access_level", kind: "enum", T: proto3.getEnumType(AccessLevel) },
+    { no: 5, name: "api_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "source_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainAccessRequest {
+    return new ExplainAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainAccessRequest {
+    return new ExplainAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainAccessRequest {
+    return new ExplainAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainAccessRequest | PlainMessage<ExplainAccessRequest> | undefined, b: ExplainAccessRequest | PlainMessage<ExplainAccessRequest> | undefined): boolean {
+    return proto3.util.equals(ExplainAccessRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AccessCheckStep
+ */
+export class AccessCheckStep extends Message<AccessCheckStep> {
+  /**
+   * e.g. scope, site membership, relationship or policy
+   *
+   * @generated from field: string check = 1;
+   */
+  check = "";
+
+  /**
+   * found, none, allowed, denied, skipped or not found
+   *
+   * @generated from field: string outcome = 2;
+   */
+  outcome = "";
+
+  /**
+   * @generated from field: string detail = 3;
+   */
+  detail = "";
+
+  constructor(data?: PartialMessage<AccessCheckStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AccessCheckStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "check", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "outcome", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "detail", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccessCheckStep {
+    return new AccessCheckStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccessCheckStep {
+    return new AccessCheckStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccessCheckStep {
+    return new AccessCheckStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccessCheckStep | PlainMessage<AccessCheckStep> | undefined, b: AccessCheckStep | PlainMessage<AccessCheckStep> | undefined): boolean {
+    return proto3.util.equals(AccessCheckStep, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExplainAccessResponse
+ */
+export class ExplainAccessResponse extends Message<ExplainAccessResponse> {
+  /**
+   * @generated from field: bool allowed = 1;
+   */
+  allowed = false;
+
+  /**
+   * The checks made, in the order requests go through them
+   *
+   * @generated from field: repeated libops.v1.AccessCheckStep steps = 2;
+   */
+  steps: AccessCheckStep[] = [];
+
+  /**
+   * The policies that permitted the action, by @id
+   *
+   * @generated from field: repeated string permitting_policies = 3;
+   */
+  permittingPolicies: string[] = [];
+
+  /**
+   * Why the request would be denied; empty when allowed
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<ExplainAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExplainAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "allowed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "steps", kind: "message", T: AccessCheckStep, repeated: true },
+    { no: 3, name: "permitting_policies", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExplainAccessResponse {
+    return new ExplainAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExplainAccessResponse {
+    return new ExplainAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExplainAccessResponse {
+    return new ExplainAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExplainAccessResponse | PlainMessage<ExplainAccessResponse> | undefined, b: ExplainAccessResponse | PlainMessage<ExplainAccessResponse> | undefined): boolean {
+    return proto3.util.equals(ExplainAccessResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetControllerConfigRequest
+ */
+export class GetControllerConfigRequest extends Message<GetControllerConfigRequest> {
+  /**
+   * Site public ID; the fleet-wide config when empty
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetControllerConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetControllerConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetControllerConfigRequest {
+    return new GetControllerConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetControllerConfigRequest {
+    return new GetControllerConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetControllerConfigRequest {
+    return new GetControllerConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetControllerConfigRequest | PlainMessage<GetControllerConfigRequest> | undefined, b: GetControllerConfigRequest | PlainMessage<GetControllerConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GetControllerConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetControllerConfigResponse
+ */
+export class GetControllerConfigResponse extends Message<GetControllerConfigResponse> {
+  /**
+   * The document stored for the fleet or the site; empty if none is set
+   *
+   * @generated from field: libops.v1.ControllerConfig config = 1;
+   */
+  config?: ControllerConfig;
+
+  /**
+   * What the site's controller applies on check-in: the site's override on top
+   * of the fleet's config. Only set for a site.
+   *
+   * @generated from field: libops.v1.ControllerConfig effective = 2;
+   */
+  effective?: ControllerConfig;
+
+  constructor(data?: PartialMessage<GetControllerConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetControllerConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: ControllerConfig },
+    { no: 2, name: "effective", kind: "message", T: ControllerConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetControllerConfigResponse {
+    return new GetControllerConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetControllerConfigResponse {
+    return new GetControllerConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetControllerConfigResponse {
+    return new GetControllerConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetControllerConfigResponse | PlainMessage<GetControllerConfigResponse> | undefined, b: GetControllerConfigResponse | PlainMessage<GetControllerConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetControllerConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateControllerConfigRequest
+ */
+export class UpdateControllerConfigRequest extends Message<UpdateControllerConfigRequest> {
+  /**
+   * Site public ID; the fleet-wide config when empty
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Unset fields inherit from the fleet, or keep the controller's own settings
+   *
+   * @generated from field: libops.v1.ControllerConfig config = 2;
+   */
+  config?: ControllerConfig;
+
+  constructor(data?: PartialMessage<UpdateControllerConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateControllerConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "config", kind: "message", T: ControllerConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateControllerConfigRequest {
+    return new UpdateControllerConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateControllerConfigRequest {
+    return new UpdateControllerConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateControllerConfigRequest {
+    return new UpdateControllerConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateControllerConfigRequest | PlainMessage<UpdateControllerConfigRequest> | undefined, b: UpdateControllerConfigRequest | PlainMessage<UpdateControllerConfigRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateControllerConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateControllerConfigResponse
+ */
+export class UpdateControllerConfigResponse extends Message<UpdateControllerConfigResponse> {
+  /**
+   * @generated from field: libops.v1.ControllerConfig config = 1;
+   */
+  config?: ControllerConfig;
+
+  constructor(data?: PartialMessage<UpdateControllerConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateControllerConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: ControllerConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateControllerConfigResponse {
+    return new UpdateControllerConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateControllerConfigResponse {
+    return new UpdateControllerConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateControllerConfigResponse {
+    return new UpdateControllerConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateControllerConfigResponse | PlainMessage<UpdateControllerConfigResponse> | undefined, b: UpdateControllerConfigResponse | PlainMessage<UpdateControllerConfigResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateControllerConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteControllerConfigRequest
+ */
+export class DeleteControllerConfigRequest extends Message<DeleteControllerConfigRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<DeleteControllerConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteControllerConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteControllerConfigRequest {
+    return new DeleteControllerConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteControllerConfigRequest {
+    return new DeleteControllerConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteControllerConfigRequest {
+    return new DeleteControllerConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteControllerConfigRequest | PlainMessage<DeleteControllerConfigRequest> | undefined, b: DeleteControllerConfigRequest | PlainMessage<DeleteControllerConfigRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteControllerConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteControllerConfigResponse
+ */
+export class DeleteControllerConfigResponse extends Message<DeleteControllerConfigResponse> {
+  constructor(data?: PartialMessage<DeleteControllerConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteControllerConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteControllerConfigResponse {
+    return new DeleteControllerConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteControllerConfigResponse {
+    return new DeleteControllerConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteControllerConfigResponse {
+    return new DeleteControllerConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteControllerConfigResponse | PlainMessage<DeleteControllerConfigResponse> | undefined, b: DeleteControllerConfigResponse | PlainMessage<DeleteControllerConfigResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteControllerConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListControllerReleasesRequest
+ */
+export class ListControllerReleasesRequest extends Message<ListControllerReleasesRequest> {
+  /**
+   * Defaults to 50, at most 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  constructor(data?: PartialMessage<ListControllerReleasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListControllerReleasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListControllerReleasesRequest {
+    return new ListControllerReleasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListControllerReleasesRequest {
+    return new ListControllerReleasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListControllerReleasesRequest {
+    return new ListControllerReleasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListControllerReleasesRequest | PlainMessage<ListControllerReleasesRequest> | undefined, b: ListControllerReleasesRequest | PlainMessage<ListControllerReleasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListControllerReleasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListControllerReleasesResponse
+ */
+export class ListControllerReleasesResponse extends Message<ListControllerReleasesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ControllerRelease releases = 1;
+   */
+  releases: ControllerRelease[] = [];
+
+  constructor(data?: PartialMessage<ListControllerReleasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListControllerReleasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "releases", kind: "message", T: ControllerRelease, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListControllerReleasesResponse {
+    return new ListControllerReleasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListControllerReleasesResponse {
+    return new ListControllerReleasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListControllerReleasesResponse {
+    return new ListControllerReleasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListControllerReleasesResponse | PlainMessage<ListControllerReleasesResponse> | undefined, b: ListControllerReleasesResponse | PlainMessage<ListControllerReleasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListControllerReleasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateControllerReleaseRequest
+ */
+export class CreateControllerReleaseRequest extends Message<CreateControllerReleaseRequest> {
+  /**
+   * created_at and site_count are ignored
+   *
+   * @generated from field: libops.v1.ControllerRelease release = 1;
+   */
+  release?: ControllerRelease;
+
+  constructor(data?: PartialMessage<CreateControllerReleaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateControllerReleaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "release", kind: "message", T: ControllerRelease },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateControllerReleaseRequest {
+    return new CreateControllerReleaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateControllerReleaseRequest {
+    return new CreateControllerReleaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateControllerReleaseRequest {
+    return new CreateControllerReleaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateControllerReleaseRequest | PlainMessage<CreateControllerReleaseRequest> | undefined, b: CreateControllerReleaseRequest | PlainMessage<CreateControllerReleaseRequest> | undefined): boolean {
+    return proto3.util.equals(CreateControllerReleaseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateControllerReleaseResponse
+ */
+export class CreateControllerReleaseResponse extends Message<CreateControllerReleaseResponse> {
+  /**
+   * @generated from field: libops.v1.ControllerRelease release = 1;
+   */
+  release?: ControllerRelease;
+
+  constructor(data?: PartialMessage<CreateControllerReleaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateControllerReleaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "release", kind: "message", T: ControllerRelease },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateControllerReleaseResponse {
+    return new CreateControllerReleaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateControllerReleaseResponse {
+    return new CreateControllerReleaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateControllerReleaseResponse {
+    return new CreateControllerReleaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateControllerReleaseResponse | PlainMessage<CreateControllerReleaseResponse> | undefined, b: CreateControllerReleaseResponse | PlainMessage<CreateControllerReleaseResponse> | undefined): boolean {
+    return proto3.util.equals(CreateControllerReleaseResponse, a, b);
+  }
+}
+
+/**
+ * TerraformStateVersion is one generation of a state object
+ *
+ * @generated from message libops.v1.TerraformStateVersion
+ */
+export class TerraformStateVersion extends Message<TerraformStateVersion> {
+  /**
+   * GCS object generation
+   *
+   * @generated from field: int64 generation = 1;
+   */
+  generation = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 3;
+   */
+  updatedAt = protoInt64.zero;
+
+  /**
+   * The state the next run reads
+   *
+   * @generated from field: bool live = 4;
+   */
+  live = false;
+
+  constructor(data?: PartialMessage<TerraformStateVersion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TerraformStateVersion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "live", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerraformStateVersion {
+    return new TerraformStateVersion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerraformStateVersion {
+    return new TerraformStateVersion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerraformStateVersion {
+    return new TerraformStateVersion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerraformStateVersion | PlainMessage<TerraformStateVersion> | undefined, b: TerraformStateVersion | PlainMessage<TerraformStateVersion> | undefined): boolean {
+    return proto3.util.equals(TerraformStateVersion, a, b);
+  }
+}
+
+/**
+ * TerraformStateBackup is a copy of an organization's state taken by an
+ * operator or before a restore
+ *
+ * @generated from message libops.v1.TerraformStateBackup
+ */
+export class TerraformStateBackup extends Message<TerraformStateBackup> {
+  /**
+   * @generated from field: string backup_id = 1;
+   */
+  backupId = "";
+
+  /**
+   * Object in the organization's tfstate bucket, under the backed up
+   * workspace's name, e.g. terraform/backups/sites/<site ID>/
+   *
+   * @generated from field: string object = 2;
+   */
+  object = "";
+
+  /**
+   * State generation that was copied
+   *
+   * @generated from field: int64 source_generation = 3;
+   */
+  sourceGeneration = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 size_bytes = 4;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TerraformStateBackup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TerraformStateBackup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "backup_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "object", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerraformStateBackup {
+    return new TerraformStateBackup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerraformStateBackup {
+    return new TerraformStateBackup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerraformStateBackup {
+    return new TerraformStateBackup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerraformStateBackup | PlainMessage<TerraformStateBackup> | undefined, b: TerraformStateBackup | PlainMessage<TerraformStateBackup> | undefined): boolean {
+    return proto3.util.equals(TerraformStateBackup, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListStateVersionsRequest
+ */
+export class ListStateVersionsRequest extends Message<ListStateVersionsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+   * workspace; empty for the organization's own state
+   *
+   * @generated from field: string workspace = 2;
+   */
+  workspace = "";
+
+  constructor(data?: PartialMessage<ListStateVersionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListStateVersionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStateVersionsRequest {
+    return new ListStateVersionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStateVersionsRequest {
+    return new ListStateVersionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStateVersionsRequest {
+    return new ListStateVersionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStateVersionsRequest | PlainMessage<ListStateVersionsRequest> | undefined, b: ListStateVersionsRequest | PlainMessage<ListStateVersionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListStateVersionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListStateVersionsResponse
+ */
+export class ListStateVersionsResponse extends Message<ListStateVersionsResponse> {
+  /**
+   * @generated from field: string bucket = 1;
+   */
+  bucket = "";
+
+  /**
+   * Newest first
+   *
+   * @generated from field: repeated libops.v1.TerraformStateVersion versions = 2;
+   */
+  versions: TerraformStateVersion[] = [];
+
+  /**
+   * Newest first, at most 50
+   *
+   * @generated from field: repeated libops.v1.TerraformStateBackup backups = 3;
+   */
+  backups: TerraformStateBackup[] = [];
+
+  /**
+   * A run holds the state lock
+   *
+   * @generated from field: bool locked = 4;
+   */
+  locked = false;
+
+  /**
+   * @generated from field: libops.v1.TerraformStateLayout layout = 5;
+   */
+  layout = TerraformStateLayout.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ListStateVersionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListStateVersionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bucket", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "versions", kind: "message", T: TerraformStateVersion, repeated: true },
+    { no: 3, name: "backups", kind: "message", T: TerraformStateBackup, repeated: true },
+    { no: 4, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "layout", kind: "enum", T: proto3.getEnumType(TerraformStateLayout) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStateVersionsResponse {
+    return new ListStateVersionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStateVersionsResponse {
+    return new ListStateVersionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStateVersionsResponse {
+    return new ListStateVersionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStateVersionsResponse | PlainMessage<ListStateVersionsResponse> | undefined, b: ListStateVersionsResponse | PlainMessage<ListStateVersionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListStateVersionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CopyStateBackupRequest
+ */
+export class CopyStateBackupRequest extends Message<CopyStateBackupRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+   * workspace; empty for the organization's own state
+   *
+   * @generated from field: string workspace = 3;
+   */
+  workspace = "";
+
+  constructor(data?: PartialMessage<CopyStateBackupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CopyStateBackupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "workspace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyStateBackupRequest {
+    return new CopyStateBackupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyStateBackupRequest {
+    return new CopyStateBackupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyStateBackupRequest {
+    return new CopyStateBackupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CopyStateBackupRequest | PlainMessage<CopyStateBackupRequest> | undefined, b: CopyStateBackupRequest | PlainMessage<CopyStateBackupRequest> | undefined): boolean {
+    return proto3.util.equals(CopyStateBackupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CopyStateBackupResponse
+ */
+export class CopyStateBackupResponse extends Message<CopyStateBackupResponse> {
+  /**
+   * @generated from field: libops.v1.TerraformStateBackup backup = 1;
+   */
+  backup?: TerraformStateBackup;
+
+  constructor(data?: PartialMessage<CopyStateBackupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CopyStateBackupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "backup", kind: "message", T: TerraformStateBackup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CopyStateBackupResponse {
+    return new CopyStateBackupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CopyStateBackupResponse {
+    return new CopyStateBackupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CopyStateBackupResponse {
+    return new CopyStateBackupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CopyStateBackupResponse | PlainMessage<CopyStateBackupResponse> | undefined, b: CopyStateBackupResponse | PlainMessage<CopyStateBackupResponse> | undefined): boolean {
+    return proto3.util.equals(CopyStateBackupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RestoreStateRequest
+ */
+export class RestoreStateRequest extends Message<RestoreStateRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from oneof libops.v1.RestoreStateRequest.source
+   */
+  source: {
+    /**
+     * A kept version of the state object
+     *
+     * @generated from field: int64 generation = 2;
+     */
+    value: bigint;
+    case: "generation";
+  } | {
+    /**
+     * @generated from field: string backup_id = 3;
+     */
+    value: string;
+    case: "backupId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * "projects/<project ID>" or "sites/<site ID>" for an isolated organization's
+   * workspace; empty for the organization's own state
+   *
+   * @generated from field: string workspace = 5;
+   */
+  workspace = "";
+
+  constructor(data?: PartialMessage<RestoreStateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RestoreStateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "generation", kind: "scalar", T: 3 /* ScalarType.INT64 */, oneof: "source" },
+    { no: 3, name: "backup_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "source" },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "workspace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreStateRequest {
+    return new RestoreStateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreStateRequest {
+    return new RestoreStateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreStateRequest {
+    return new RestoreStateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreStateRequest | PlainMessage<RestoreStateRequest> | undefined, b: RestoreStateRequest | PlainMessage<RestoreStateRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreStateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RestoreStateResponse
+ */
+export class RestoreStateResponse extends Message<RestoreStateResponse> {
+  /**
+   * The new current state
+   *
+   * @generated from field: libops.v1.TerraformStateVersion state = 1;
+   */
+  state?: TerraformStateVersion;
+
+  /**
+   * The state that was replaced, absent when the organization had none
+   *
+   * @generated from field: libops.v1.TerraformStateBackup previous = 2;
+   */
+  previous?: TerraformStateBackup;
+
+  constructor(data?: PartialMessage<RestoreStateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RestoreStateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "state", kind: "message", T: TerraformStateVersion },
+    { no: 2, name: "previous", kind: "message", T: TerraformStateBackup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreStateResponse {
+    return new RestoreStateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreStateResponse {
+    return new RestoreStateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreStateResponse {
+    return new RestoreStateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreStateResponse | PlainMessage<RestoreStateResponse> | undefined, b: RestoreStateResponse | PlainMessage<RestoreStateResponse> | undefined): boolean {
+    return proto3.util.equals(RestoreStateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MigrateStateWorkspacesRequest
+ */
+export class MigrateStateWorkspacesRequest extends Message<MigrateStateWorkspacesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<MigrateStateWorkspacesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MigrateStateWorkspacesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MigrateStateWorkspacesRequest {
+    return new MigrateStateWorkspacesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MigrateStateWorkspacesRequest {
+    return new MigrateStateWorkspacesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MigrateStateWorkspacesRequest {
+    return new MigrateStateWorkspacesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MigrateStateWorkspacesRequest | PlainMessage<MigrateStateWorkspacesRequest> | undefined, b: MigrateStateWorkspacesRequest | PlainMessage<MigrateStateWorkspacesRequest> | undefined): boolean {
+    return proto3.util.equals(MigrateStateWorkspacesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MigrateStateWorkspacesResponse
+ */
+export class MigrateStateWorkspacesResponse extends Message<MigrateStateWorkspacesResponse> {
+  /**
+   * The terraform run moving the state
+   *
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * The monolithic state before the migration
+   *
+   * @generated from field: libops.v1.TerraformStateBackup backup = 2;
+   */
+  backup?: TerraformStateBackup;
+
+  constructor(data?: PartialMessage<MigrateStateWorkspacesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MigrateStateWorkspacesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "backup", kind: "message", T: TerraformStateBackup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MigrateStateWorkspacesResponse {
+    return new MigrateStateWorkspacesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MigrateStateWorkspacesResponse {
+    return new MigrateStateWorkspacesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MigrateStateWorkspacesResponse {
+    return new MigrateStateWorkspacesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MigrateStateWorkspacesResponse | PlainMessage<MigrateStateWorkspacesResponse> | undefined, b: MigrateStateWorkspacesResponse | PlainMessage<MigrateStateWorkspacesResponse> | undefined): boolean {
+    return proto3.util.equals(MigrateStateWorkspacesResponse, a, b);
+  }
+}
+
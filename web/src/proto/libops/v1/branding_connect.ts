@@ -0,0 +1,44 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/branding.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetOrganizationBrandingRequest, GetOrganizationBrandingResponse, UpdateOrganizationBrandingRequest, UpdateOrganizationBrandingResponse } from "./branding_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * BrandingService manages the logo and colors an organization's dashboard pages
+ * and notification emails are shown with, so consortiums can white-label libops
+ * for their member libraries
+ *
+ * @generated from service libops.v1.BrandingService
+ */
+export const BrandingService = {
+  typeName: "libops.v1.BrandingService",
+  methods: {
+    /**
+     * Get an organization's branding
+     *
+     * @generated from rpc libops.v1.BrandingService.GetOrganizationBranding
+     */
+    getOrganizationBranding: {
+      name: "GetOrganizationBranding",
+      I: GetOrganizationBrandingRequest,
+      O: GetOrganizationBrandingResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Update an organization's colors or logo
+     *
+     * @generated from rpc libops.v1.BrandingService.UpdateOrganizationBranding
+     */
+    updateOrganizationBranding: {
+      name: "UpdateOrganizationBranding",
+      I: UpdateOrganizationBrandingRequest,
+      O: UpdateOrganizationBrandingResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
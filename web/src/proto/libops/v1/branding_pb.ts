@@ -0,0 +1,261 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/branding.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
+
+/**
+ * @generated from message libops.v1.OrganizationBranding
+ */
+export class OrganizationBranding extends Message<OrganizationBranding> {
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Hex color of buttons and headers, e.g. "#1d4ed8"; empty for the default
+   *
+   * @generated from field: string primary_color = 2;
+   */
+  primaryColor = "";
+
+  /**
+   * Hex color of links and highlights; empty for the default
+   *
+   * @generated from field: string accent_color = 3;
+   */
+  accentColor = "";
+
+  /**
+   * Public URL of the logo, empty when the organization has none
+   *
+   * @generated from field: string logo_url = 4;
+   */
+  logoUrl = "";
+
+  /**
+   * Unix timestamp, 0 when the organization was never branded
+   *
+   * @generated from field: int64 updated_at = 5;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<OrganizationBranding>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.OrganizationBranding";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "primary_color", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "accent_color", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "logo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationBranding {
+    return new OrganizationBranding().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationBranding {
+    return new OrganizationBranding().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationBranding {
+    return new OrganizationBranding().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationBranding | PlainMessage<OrganizationBranding> | undefined, b: OrganizationBranding | PlainMessage<OrganizationBranding> | undefined): boolean {
+    return proto3.util.equals(OrganizationBranding, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationBrandingRequest
+ */
+export class GetOrganizationBrandingRequest extends Message<GetOrganizationBrandingRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<GetOrganizationBrandingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationBrandingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationBrandingRequest {
+    return new GetOrganizationBrandingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationBrandingRequest {
+    return new GetOrganizationBrandingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationBrandingRequest {
+    return new GetOrganizationBrandingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationBrandingRequest | PlainMessage<GetOrganizationBrandingRequest> | undefined, b: GetOrganizationBrandingRequest | PlainMessage<GetOrganizationBrandingRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationBrandingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationBrandingResponse
+ */
+export class GetOrganizationBrandingResponse extends Message<GetOrganizationBrandingResponse> {
+  /**
+   * @generated from field: libops.v1.OrganizationBranding branding = 1;
+   */
+  branding?: OrganizationBranding;
+
+  constructor(data?: PartialMessage<GetOrganizationBrandingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationBrandingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "branding", kind: "message", T: OrganizationBranding },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationBrandingResponse {
+    return new GetOrganizationBrandingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationBrandingResponse {
+    return new GetOrganizationBrandingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationBrandingResponse {
+    return new GetOrganizationBrandingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationBrandingResponse | PlainMessage<GetOrganizationBrandingResponse> | undefined, b: GetOrganizationBrandingResponse | PlainMessage<GetOrganizationBrandingResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationBrandingResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateOrganizationBrandingRequest
+ */
+export class UpdateOrganizationBrandingRequest extends Message<UpdateOrganizationBrandingRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Empty restores the default
+   *
+   * @generated from field: optional string primary_color = 2;
+   */
+  primaryColor?: string;
+
+  /**
+   * Empty restores the default
+   *
+   * @generated from field: optional string accent_color = 3;
+   */
+  accentColor?: string;
+
+  /**
+   * PNG or JPEG image of at most 256 KiB, shown at 32 pixels high. Empty removes the logo.
+   *
+   * @generated from field: optional bytes logo = 4;
+   */
+  logo?: Uint8Array;
+
+  /**
+   * Paths: primary_color, accent_color, logo.
+   * Without a mask every set field is applied.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 5;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateOrganizationBrandingRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateOrganizationBrandingRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "primary_color", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "accent_color", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "logo", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 5, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationBrandingRequest {
+    return new UpdateOrganizationBrandingRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationBrandingRequest {
+    return new UpdateOrganizationBrandingRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationBrandingRequest {
+    return new UpdateOrganizationBrandingRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOrganizationBrandingRequest | PlainMessage<UpdateOrganizationBrandingRequest> | undefined, b: UpdateOrganizationBrandingRequest | PlainMessage<UpdateOrganizationBrandingRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationBrandingRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateOrganizationBrandingResponse
+ */
+export class UpdateOrganizationBrandingResponse extends Message<UpdateOrganizationBrandingResponse> {
+  /**
+   * @generated from field: libops.v1.OrganizationBranding branding = 1;
+   */
+  branding?: OrganizationBranding;
+
+  constructor(data?: PartialMessage<UpdateOrganizationBrandingResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateOrganizationBrandingResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "branding", kind: "message", T: OrganizationBranding },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationBrandingResponse {
+    return new UpdateOrganizationBrandingResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationBrandingResponse {
+    return new UpdateOrganizationBrandingResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationBrandingResponse {
+    return new UpdateOrganizationBrandingResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOrganizationBrandingResponse | PlainMessage<UpdateOrganizationBrandingResponse> | undefined, b: UpdateOrganizationBrandingResponse | PlainMessage<UpdateOrganizationBrandingResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationBrandingResponse, a, b);
+  }
+}
+
@@ -0,0 +1,32 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/catalog.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListRegionsRequest, ListRegionsResponse } from "./catalog_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * CatalogService lists what customers can provision
+ *
+ * @generated from service libops.v1.CatalogService
+ */
+export const CatalogService = {
+  typeName: "libops.v1.CatalogService",
+  methods: {
+    /**
+     * List the GCP regions offered for new projects, grouped by country,
+     * with machine type availability and latency/price hints
+     *
+     * @generated from rpc libops.v1.CatalogService.ListRegions
+     */
+    listRegions: {
+      name: "ListRegions",
+      I: ListRegionsRequest,
+      O: ListRegionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
@@ -0,0 +1,294 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/catalog.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message libops.v1.ListRegionsRequest
+ */
+export class ListRegionsRequest extends Message<ListRegionsRequest> {
+  /**
+   * Only list regions in this country grouping (e.g., "us", "eu")
+   *
+   * @generated from field: string country = 1;
+   */
+  country = "";
+
+  /**
+   * Only list regions where this machine type is available (e.g., "n4-standard-2")
+   *
+   * @generated from field: string machine_type = 2;
+   */
+  machineType = "";
+
+  /**
+   * Only list regions the organization's data residency allows
+   *
+   * @generated from field: string organization_id = 3;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<ListRegionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRegionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "country", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "machine_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRegionsRequest {
+    return new ListRegionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRegionsRequest {
+    return new ListRegionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRegionsRequest {
+    return new ListRegionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRegionsRequest | PlainMessage<ListRegionsRequest> | undefined, b: ListRegionsRequest | PlainMessage<ListRegionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRegionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListRegionsResponse
+ */
+export class ListRegionsResponse extends Message<ListRegionsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.RegionCountry countries = 1;
+   */
+  countries: RegionCountry[] = [];
+
+  constructor(data?: PartialMessage<ListRegionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRegionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "countries", kind: "message", T: RegionCountry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRegionsResponse {
+    return new ListRegionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRegionsResponse {
+    return new ListRegionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRegionsResponse {
+    return new ListRegionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRegionsResponse | PlainMessage<ListRegionsResponse> | undefined, b: ListRegionsResponse | PlainMessage<ListRegionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRegionsResponse, a, b);
+  }
+}
+
+/**
+ * RegionCountry groups the regions of a country or area
+ *
+ * @generated from message libops.v1.RegionCountry
+ */
+export class RegionCountry extends Message<RegionCountry> {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: repeated libops.v1.Region regions = 3;
+   */
+  regions: Region[] = [];
+
+  constructor(data?: PartialMessage<RegionCountry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RegionCountry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "regions", kind: "message", T: Region, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegionCountry {
+    return new RegionCountry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegionCountry {
+    return new RegionCountry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegionCountry {
+    return new RegionCountry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegionCountry | PlainMessage<RegionCountry> | undefined, b: RegionCountry | PlainMessage<RegionCountry> | undefined): boolean {
+    return proto3.util.equals(RegionCountry, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.Region
+ */
+export class Region extends Message<Region> {
+  /**
+   * GCP region code (e.g., "us-central1")
+   *
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string country = 3;
+   */
+  country = "";
+
+  /**
+   * Approximate location, so clients can suggest the nearest region
+   *
+   * @generated from field: double latitude = 4;
+   */
+  latitude = 0;
+
+  /**
+   * @generated from field: double longitude = 5;
+   */
+  longitude = 0;
+
+  /**
+   * GCP compute cost relative to the cheapest region (1.0). Display only:
+   * projects are billed at the machine type's price in every region.
+   *
+   * @generated from field: double relative_price = 6;
+   */
+  relativePrice = 0;
+
+  /**
+   * Machine types that can be provisioned in this region
+   *
+   * @generated from field: repeated libops.v1.RegionMachineType machine_types = 7;
+   */
+  machineTypes: RegionMachineType[] = [];
+
+  constructor(data?: PartialMessage<Region>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Region";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "country", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "latitude", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "longitude", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "relative_price", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "machine_types", kind: "message", T: RegionMachineType, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Region {
+    return new Region().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Region {
+    return new Region().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Region {
+    return new Region().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Region | PlainMessage<Region> | undefined, b: Region | PlainMessage<Region> | undefined): boolean {
+    return proto3.util.equals(Region, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RegionMachineType
+ */
+export class RegionMachineType extends Message<RegionMachineType> {
+  /**
+   * @generated from field: string machine_type = 1;
+   */
+  machineType = "";
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: int32 vcpu = 3;
+   */
+  vcpu = 0;
+
+  /**
+   * @generated from field: int32 memory_gib = 4;
+   */
+  memoryGib = 0;
+
+  /**
+   * @generated from field: int32 monthly_price_cents = 5;
+   */
+  monthlyPriceCents = 0;
+
+  constructor(data?: PartialMessage<RegionMachineType>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RegionMachineType";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "machine_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "vcpu", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "memory_gib", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "monthly_price_cents", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegionMachineType {
+    return new RegionMachineType().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegionMachineType {
+    return new RegionMachineType().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegionMachineType {
+    return new RegionMachineType().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegionMachineType | PlainMessage<RegionMachineType> | undefined, b: RegionMachineType | PlainMessage<RegionMachineType> | undefined): boolean {
+    return proto3.util.equals(RegionMachineType, a, b);
+  }
+}
+
@@ -0,0 +1,70 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/cdn.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DisableSiteCdnRequest, EnableSiteCdnRequest, EnableSiteCdnResponse, GetSiteCdnRequest, GetSiteCdnResponse, PurgeSiteCacheRequest, PurgeSiteCacheResponse } from "./cdn_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SiteCdnService manages a site's CDN: a Cloud CDN load balancer in front of
+ * the site that caches its responses at Google's edge. The load balancer is
+ * built by the site's next reconciliation, and its address is shown on the
+ * site once it exists; the site's domains have to point at it to be cached.
+ *
+ * @generated from service libops.v1.SiteCdnService
+ */
+export const SiteCdnService = {
+  typeName: "libops.v1.SiteCdnService",
+  methods: {
+    /**
+     * Get a site's CDN
+     *
+     * @generated from rpc libops.v1.SiteCdnService.GetSiteCdn
+     */
+    getSiteCdn: {
+      name: "GetSiteCdn",
+      I: GetSiteCdnRequest,
+      O: GetSiteCdnResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Enable a site's CDN, or change the cache policy of one that's enabled
+     *
+     * @generated from rpc libops.v1.SiteCdnService.EnableSiteCdn
+     */
+    enableSiteCdn: {
+      name: "EnableSiteCdn",
+      I: EnableSiteCdnRequest,
+      O: EnableSiteCdnResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Disable a site's CDN. Point the site's domains back at the site before
+     * this, or they stop resolving to anything once the load balancer is gone.
+     *
+     * @generated from rpc libops.v1.SiteCdnService.DisableSiteCdn
+     */
+    disableSiteCdn: {
+      name: "DisableSiteCdn",
+      I: DisableSiteCdnRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Purge cached content from a site's CDN. Purges run with the site's next
+     * reconciliation, which this queues.
+     *
+     * @generated from rpc libops.v1.SiteCdnService.PurgeSiteCache
+     */
+    purgeSiteCache: {
+      name: "PurgeSiteCache",
+      I: PurgeSiteCacheRequest,
+      O: PurgeSiteCacheResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
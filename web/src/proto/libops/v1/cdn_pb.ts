@@ -0,0 +1,390 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/cdn.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { CdnCacheMode, SiteCdn } from "./common/site_pb.js";
+
+/**
+ * @generated from enum libops.v1.CachePurgeStatus
+ */
+export enum CachePurgeStatus {
+  /**
+   * @generated from enum value: CACHE_PURGE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for the site's next reconciliation
+   *
+   * @generated from enum value: CACHE_PURGE_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: CACHE_PURGE_STATUS_COMPLETED = 2;
+   */
+  COMPLETED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CachePurgeStatus)
+proto3.util.setEnumType(CachePurgeStatus, "libops.v1.CachePurgeStatus", [
+  { no: 0, name: "CACHE_PURGE_STATUS_UNSPECIFIED" },
+  { no: 1, name: "CACHE_PURGE_STATUS_PENDING" },
+  { no: 2, name: "CACHE_PURGE_STATUS_COMPLETED" },
+]);
+
+/**
+ * CachePurge is a request to invalidate cached content for a path
+ *
+ * @generated from message libops.v1.CachePurge
+ */
+export class CachePurge extends Message<CachePurge> {
+  /**
+   * @generated from field: string purge_id = 1;
+   */
+  purgeId = "";
+
+  /**
+   * A trailing * matches every path with that prefix
+   *
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * @generated from field: libops.v1.CachePurgeStatus status = 3;
+   */
+  status = CachePurgeStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 requested_at = 4;
+   */
+  requestedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 while pending
+   *
+   * @generated from field: int64 completed_at = 5;
+   */
+  completedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CachePurge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CachePurge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "purge_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(CachePurgeStatus) },
+    { no: 4, name: "requested_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CachePurge {
+    return new CachePurge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CachePurge {
+    return new CachePurge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CachePurge {
+    return new CachePurge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CachePurge | PlainMessage<CachePurge> | undefined, b: CachePurge | PlainMessage<CachePurge> | undefined): boolean {
+    return proto3.util.equals(CachePurge, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteCdnRequest
+ */
+export class GetSiteCdnRequest extends Message<GetSiteCdnRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteCdnRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteCdnRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteCdnRequest {
+    return new GetSiteCdnRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteCdnRequest {
+    return new GetSiteCdnRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteCdnRequest {
+    return new GetSiteCdnRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteCdnRequest | PlainMessage<GetSiteCdnRequest> | undefined, b: GetSiteCdnRequest | PlainMessage<GetSiteCdnRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteCdnRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteCdnResponse
+ */
+export class GetSiteCdnResponse extends Message<GetSiteCdnResponse> {
+  /**
+   * @generated from field: libops.v1.common.SiteCdn cdn = 1;
+   */
+  cdn?: SiteCdn;
+
+  constructor(data?: PartialMessage<GetSiteCdnResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteCdnResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cdn", kind: "message", T: SiteCdn },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteCdnResponse {
+    return new GetSiteCdnResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteCdnResponse {
+    return new GetSiteCdnResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteCdnResponse {
+    return new GetSiteCdnResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteCdnResponse | PlainMessage<GetSiteCdnResponse> | undefined, b: GetSiteCdnResponse | PlainMessage<GetSiteCdnResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteCdnResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.EnableSiteCdnRequest
+ */
+export class EnableSiteCdnRequest extends Message<EnableSiteCdnRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Defaults to CACHE_ALL_STATIC
+   *
+   * @generated from field: libops.v1.common.CdnCacheMode cache_mode = 2;
+   */
+  cacheMode = CdnCacheMode.UNSPECIFIED;
+
+  /**
+   * Defaults to 3600; at most 31536000. Unused with USE_ORIGIN_HEADERS, which
+   * doesn't cache responses without cache headers
+   *
+   * @generated from field: int32 default_ttl_seconds = 3;
+   */
+  defaultTtlSeconds = 0;
+
+  constructor(data?: PartialMessage<EnableSiteCdnRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.EnableSiteCdnRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cache_mode", kind: "enum", T: proto3.getEnumType(CdnCacheMode) },
+    { no: 3, name: "default_ttl_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableSiteCdnRequest {
+    return new EnableSiteCdnRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableSiteCdnRequest {
+    return new EnableSiteCdnRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableSiteCdnRequest {
+    return new EnableSiteCdnRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnableSiteCdnRequest | PlainMessage<EnableSiteCdnRequest> | undefined, b: EnableSiteCdnRequest | PlainMessage<EnableSiteCdnRequest> | undefined): boolean {
+    return proto3.util.equals(EnableSiteCdnRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.EnableSiteCdnResponse
+ */
+export class EnableSiteCdnResponse extends Message<EnableSiteCdnResponse> {
+  /**
+   * @generated from field: libops.v1.common.SiteCdn cdn = 1;
+   */
+  cdn?: SiteCdn;
+
+  constructor(data?: PartialMessage<EnableSiteCdnResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.EnableSiteCdnResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cdn", kind: "message", T: SiteCdn },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableSiteCdnResponse {
+    return new EnableSiteCdnResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableSiteCdnResponse {
+    return new EnableSiteCdnResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableSiteCdnResponse {
+    return new EnableSiteCdnResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnableSiteCdnResponse | PlainMessage<EnableSiteCdnResponse> | undefined, b: EnableSiteCdnResponse | PlainMessage<EnableSiteCdnResponse> | undefined): boolean {
+    return proto3.util.equals(EnableSiteCdnResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DisableSiteCdnRequest
+ */
+export class DisableSiteCdnRequest extends Message<DisableSiteCdnRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<DisableSiteCdnRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DisableSiteCdnRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisableSiteCdnRequest {
+    return new DisableSiteCdnRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisableSiteCdnRequest {
+    return new DisableSiteCdnRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisableSiteCdnRequest {
+    return new DisableSiteCdnRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DisableSiteCdnRequest | PlainMessage<DisableSiteCdnRequest> | undefined, b: DisableSiteCdnRequest | PlainMessage<DisableSiteCdnRequest> | undefined): boolean {
+    return proto3.util.equals(DisableSiteCdnRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.PurgeSiteCacheRequest
+ */
+export class PurgeSiteCacheRequest extends Message<PurgeSiteCacheRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Paths starting with "/"; defaults to everything ("/*")
+   *
+   * @generated from field: repeated string paths = 2;
+   */
+  paths: string[] = [];
+
+  constructor(data?: PartialMessage<PurgeSiteCacheRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PurgeSiteCacheRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeSiteCacheRequest {
+    return new PurgeSiteCacheRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeSiteCacheRequest {
+    return new PurgeSiteCacheRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeSiteCacheRequest {
+    return new PurgeSiteCacheRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeSiteCacheRequest | PlainMessage<PurgeSiteCacheRequest> | undefined, b: PurgeSiteCacheRequest | PlainMessage<PurgeSiteCacheRequest> | undefined): boolean {
+    return proto3.util.equals(PurgeSiteCacheRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.PurgeSiteCacheResponse
+ */
+export class PurgeSiteCacheResponse extends Message<PurgeSiteCacheResponse> {
+  /**
+   * @generated from field: repeated libops.v1.CachePurge purges = 1;
+   */
+  purges: CachePurge[] = [];
+
+  constructor(data?: PartialMessage<PurgeSiteCacheResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PurgeSiteCacheResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "purges", kind: "message", T: CachePurge, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeSiteCacheResponse {
+    return new PurgeSiteCacheResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeSiteCacheResponse {
+    return new PurgeSiteCacheResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeSiteCacheResponse {
+    return new PurgeSiteCacheResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeSiteCacheResponse | PlainMessage<PurgeSiteCacheResponse> | undefined, b: PurgeSiteCacheResponse | PlainMessage<PurgeSiteCacheResponse> | undefined): boolean {
+    return proto3.util.equals(PurgeSiteCacheResponse, a, b);
+  }
+}
+
@@ -0,0 +1,749 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/common/billing.proto (package libops.v1.common, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * BillingSubscription is an organization's Stripe subscription
+ *
+ * @generated from message libops.v1.common.BillingSubscription
+ */
+export class BillingSubscription extends Message<BillingSubscription> {
+  /**
+   * Stripe subscription status: trialing, active, past_due, canceled, ...
+   *
+   * @generated from field: string status = 1;
+   */
+  status = "";
+
+  /**
+   * Unix timestamps; 0 when unset
+   *
+   * @generated from field: int64 current_period_start = 2;
+   */
+  currentPeriodStart = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 current_period_end = 3;
+   */
+  currentPeriodEnd = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 trial_end = 4;
+   */
+  trialEnd = protoInt64.zero;
+
+  /**
+   * @generated from field: bool cancel_at_period_end = 5;
+   */
+  cancelAtPeriodEnd = false;
+
+  /**
+   * Unix timestamp of the last failed invoice payment; 0 when payments are current
+   *
+   * @generated from field: int64 payment_failed_at = 6;
+   */
+  paymentFailedAt = protoInt64.zero;
+
+  /**
+   * Stripe invoice whose payment failed
+   *
+   * @generated from field: string payment_failed_invoice_id = 7;
+   */
+  paymentFailedInvoiceId = "";
+
+  /**
+   * Dunning state: active, past_due, suspended or scheduled_for_deletion
+   *
+   * @generated from field: string billing_state = 8;
+   */
+  billingState = "";
+
+  /**
+   * Unix timestamp of the last dunning state change; 0 when never changed
+   *
+   * @generated from field: int64 billing_state_changed_at = 9;
+   */
+  billingStateChangedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp when the grace period for the current state ends; 0 when no transition is pending
+   *
+   * @generated from field: int64 billing_state_deadline = 10;
+   */
+  billingStateDeadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BillingSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.BillingSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "current_period_start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "current_period_end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "trial_end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "cancel_at_period_end", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "payment_failed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "payment_failed_invoice_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "billing_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "billing_state_changed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "billing_state_deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BillingSubscription {
+    return new BillingSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BillingSubscription {
+    return new BillingSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BillingSubscription {
+    return new BillingSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BillingSubscription | PlainMessage<BillingSubscription> | undefined, b: BillingSubscription | PlainMessage<BillingSubscription> | undefined): boolean {
+    return proto3.util.equals(BillingSubscription, a, b);
+  }
+}
+
+/**
+ * SubscriptionItem is a line item on an organization's Stripe subscription
+ *
+ * @generated from message libops.v1.common.SubscriptionItem
+ */
+export class SubscriptionItem extends Message<SubscriptionItem> {
+  /**
+   * @generated from field: string item_id = 1;
+   */
+  itemId = "";
+
+  /**
+   * @generated from field: string price_id = 2;
+   */
+  priceId = "";
+
+  /**
+   * Human-readable product or price name
+   *
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * @generated from field: int64 quantity = 4;
+   */
+  quantity = protoInt64.zero;
+
+  /**
+   * Unit price in the smallest currency unit (e.g. cents)
+   *
+   * @generated from field: int64 unit_amount = 5;
+   */
+  unitAmount = protoInt64.zero;
+
+  /**
+   * @generated from field: string currency = 6;
+   */
+  currency = "";
+
+  /**
+   * Billing interval: month, year, ...
+   *
+   * @generated from field: string interval = 7;
+   */
+  interval = "";
+
+  /**
+   * Project the item bills for, when the item is a machine
+   *
+   * @generated from field: string project_name = 8;
+   */
+  projectName = "";
+
+  constructor(data?: PartialMessage<SubscriptionItem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.SubscriptionItem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "item_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "price_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "quantity", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "unit_amount", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "currency", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "interval", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionItem {
+    return new SubscriptionItem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionItem {
+    return new SubscriptionItem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionItem {
+    return new SubscriptionItem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionItem | PlainMessage<SubscriptionItem> | undefined, b: SubscriptionItem | PlainMessage<SubscriptionItem> | undefined): boolean {
+    return proto3.util.equals(SubscriptionItem, a, b);
+  }
+}
+
+/**
+ * ProjectUsage is the billable footprint of a single project
+ *
+ * @generated from message libops.v1.common.ProjectUsage
+ */
+export class ProjectUsage extends Message<ProjectUsage> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 2;
+   */
+  projectName = "";
+
+  /**
+   * @generated from field: string machine_type = 3;
+   */
+  machineType = "";
+
+  /**
+   * @generated from field: int32 vcpu = 4;
+   */
+  vcpu = 0;
+
+  /**
+   * @generated from field: int32 memory_gib = 5;
+   */
+  memoryGib = 0;
+
+  /**
+   * @generated from field: int32 disk_size_gb = 6;
+   */
+  diskSizeGb = 0;
+
+  /**
+   * Monthly list price of the machine in cents
+   *
+   * @generated from field: int64 machine_monthly_price_cents = 7;
+   */
+  machineMonthlyPriceCents = protoInt64.zero;
+
+  /**
+   * Most recent backup storage measurement in bytes
+   *
+   * @generated from field: int64 backup_storage_bytes = 8;
+   */
+  backupStorageBytes = protoInt64.zero;
+
+  /**
+   * Egress during the current billing period in bytes
+   *
+   * @generated from field: int64 egress_bytes = 9;
+   */
+  egressBytes = protoInt64.zero;
+
+  /**
+   * Most recent data disk usage across the project's sites in bytes
+   *
+   * @generated from field: int64 disk_used_bytes = 10;
+   */
+  diskUsedBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ProjectUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.ProjectUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "machine_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "vcpu", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "memory_gib", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "disk_size_gb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "machine_monthly_price_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "backup_storage_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "egress_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "disk_used_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectUsage {
+    return new ProjectUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectUsage {
+    return new ProjectUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectUsage {
+    return new ProjectUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectUsage | PlainMessage<ProjectUsage> | undefined, b: ProjectUsage | PlainMessage<ProjectUsage> | undefined): boolean {
+    return proto3.util.equals(ProjectUsage, a, b);
+  }
+}
+
+/**
+ * PaymentMethod is a card attached to an organization's Stripe customer
+ *
+ * @generated from message libops.v1.common.PaymentMethod
+ */
+export class PaymentMethod extends Message<PaymentMethod> {
+  /**
+   * @generated from field: string payment_method_id = 1;
+   */
+  paymentMethodId = "";
+
+  /**
+   * Card brand: visa, mastercard, amex, ...
+   *
+   * @generated from field: string brand = 2;
+   */
+  brand = "";
+
+  /**
+   * @generated from field: string last4 = 3;
+   */
+  last4 = "";
+
+  /**
+   * @generated from field: int32 exp_month = 4;
+   */
+  expMonth = 0;
+
+  /**
+   * @generated from field: int32 exp_year = 5;
+   */
+  expYear = 0;
+
+  /**
+   * True when this method is charged for the organization's subscription
+   *
+   * @generated from field: bool is_default = 6;
+   */
+  isDefault = false;
+
+  constructor(data?: PartialMessage<PaymentMethod>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.PaymentMethod";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "payment_method_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "brand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last4", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "exp_month", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "exp_year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "is_default", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PaymentMethod {
+    return new PaymentMethod().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PaymentMethod {
+    return new PaymentMethod().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PaymentMethod {
+    return new PaymentMethod().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PaymentMethod | PlainMessage<PaymentMethod> | undefined, b: PaymentMethod | PlainMessage<PaymentMethod> | undefined): boolean {
+    return proto3.util.equals(PaymentMethod, a, b);
+  }
+}
+
+/**
+ * Invoice is a Stripe invoice issued to an organization
+ *
+ * @generated from message libops.v1.common.Invoice
+ */
+export class Invoice extends Message<Invoice> {
+  /**
+   * @generated from field: string invoice_id = 1;
+   */
+  invoiceId = "";
+
+  /**
+   * @generated from field: string number = 2;
+   */
+  number = "";
+
+  /**
+   * Stripe invoice status: draft, open, paid, uncollectible, void
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * Amounts in the smallest currency unit (e.g. cents)
+   *
+   * @generated from field: int64 amount_due = 4;
+   */
+  amountDue = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 amount_paid = 5;
+   */
+  amountPaid = protoInt64.zero;
+
+  /**
+   * @generated from field: string currency = 6;
+   */
+  currency = "";
+
+  /**
+   * Unix timestamps; 0 when unset
+   *
+   * @generated from field: int64 created = 7;
+   */
+  created = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 period_start = 8;
+   */
+  periodStart = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 period_end = 9;
+   */
+  periodEnd = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 due_date = 10;
+   */
+  dueDate = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 paid_at = 11;
+   */
+  paidAt = protoInt64.zero;
+
+  /**
+   * Stripe-hosted page where the invoice can be viewed and paid
+   *
+   * @generated from field: string hosted_invoice_url = 12;
+   */
+  hostedInvoiceUrl = "";
+
+  /**
+   * @generated from field: string invoice_pdf_url = 13;
+   */
+  invoicePdfUrl = "";
+
+  /**
+   * @generated from field: int64 attempt_count = 14;
+   */
+  attemptCount = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 next_payment_attempt = 15;
+   */
+  nextPaymentAttempt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Invoice>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.Invoice";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invoice_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "number", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "amount_due", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "amount_paid", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "currency", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "period_start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "period_end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "due_date", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "paid_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "hosted_invoice_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "invoice_pdf_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "attempt_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "next_payment_attempt", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Invoice {
+    return new Invoice().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Invoice {
+    return new Invoice().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Invoice {
+    return new Invoice().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Invoice | PlainMessage<Invoice> | undefined, b: Invoice | PlainMessage<Invoice> | undefined): boolean {
+    return proto3.util.equals(Invoice, a, b);
+  }
+}
+
+/**
+ * PaymentFailedEvent is emitted when Stripe fails to collect an organization's invoice
+ *
+ * @generated from message libops.v1.common.PaymentFailedEvent
+ */
+export class PaymentFailedEvent extends Message<PaymentFailedEvent> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string invoice_id = 2;
+   */
+  invoiceId = "";
+
+  /**
+   * @generated from field: int64 amount_due = 3;
+   */
+  amountDue = protoInt64.zero;
+
+  /**
+   * @generated from field: string currency = 4;
+   */
+  currency = "";
+
+  /**
+   * @generated from field: int64 attempt_count = 5;
+   */
+  attemptCount = protoInt64.zero;
+
+  /**
+   * Unix timestamp of Stripe's next automatic retry; 0 when no retry is scheduled
+   *
+   * @generated from field: int64 next_payment_attempt = 6;
+   */
+  nextPaymentAttempt = protoInt64.zero;
+
+  /**
+   * @generated from field: string hosted_invoice_url = 7;
+   */
+  hostedInvoiceUrl = "";
+
+  constructor(data?: PartialMessage<PaymentFailedEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.PaymentFailedEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "invoice_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "amount_due", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "currency", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attempt_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "next_payment_attempt", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "hosted_invoice_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PaymentFailedEvent {
+    return new PaymentFailedEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PaymentFailedEvent {
+    return new PaymentFailedEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PaymentFailedEvent {
+    return new PaymentFailedEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PaymentFailedEvent | PlainMessage<PaymentFailedEvent> | undefined, b: PaymentFailedEvent | PlainMessage<PaymentFailedEvent> | undefined): boolean {
+    return proto3.util.equals(PaymentFailedEvent, a, b);
+  }
+}
+
+/**
+ * BillingStateChangedEvent is emitted when an organization moves through the dunning lifecycle.
+ *
+ * @generated from message libops.v1.common.BillingStateChangedEvent
+ */
+export class BillingStateChangedEvent extends Message<BillingStateChangedEvent> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string previous_state = 2;
+   */
+  previousState = "";
+
+  /**
+   * @generated from field: string state = 3;
+   */
+  state = "";
+
+  /**
+   * Why the transition happened, e.g. invoice.payment_failed or grace_period_expired
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * Unix timestamp when the grace period for the new state ends; 0 when no transition is pending
+   *
+   * @generated from field: int64 deadline = 5;
+   */
+  deadline = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BillingStateChangedEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.BillingStateChangedEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "previous_state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "deadline", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BillingStateChangedEvent {
+    return new BillingStateChangedEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BillingStateChangedEvent {
+    return new BillingStateChangedEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BillingStateChangedEvent {
+    return new BillingStateChangedEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BillingStateChangedEvent | PlainMessage<BillingStateChangedEvent> | undefined, b: BillingStateChangedEvent | PlainMessage<BillingStateChangedEvent> | undefined): boolean {
+    return proto3.util.equals(BillingStateChangedEvent, a, b);
+  }
+}
+
+/**
+ * MeteredUsage is a usage-based charge accrued in the current billing period.
+ * Each day's usage is rounded up to whole units before it is billed.
+ *
+ * @generated from message libops.v1.common.MeteredUsage
+ */
+export class MeteredUsage extends Message<MeteredUsage> {
+  /**
+   * usage_records metric, e.g. egress_bytes or disk_used_bytes
+   *
+   * @generated from field: string metric = 1;
+   */
+  metric = "";
+
+  /**
+   * Bytes: the period total for counters, the latest daily value for gauges
+   *
+   * @generated from field: int64 quantity = 2;
+   */
+  quantity = protoInt64.zero;
+
+  /**
+   * Billed units (e.g. GB) and the bytes in one unit
+   *
+   * @generated from field: int64 units = 3;
+   */
+  units = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 unit_bytes = 4;
+   */
+  unitBytes = protoInt64.zero;
+
+  /**
+   * Units included at no charge each period
+   *
+   * @generated from field: int64 included_units = 5;
+   */
+  includedUnits = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 unit_price_cents = 6;
+   */
+  unitPriceCents = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 estimated_amount_cents = 7;
+   */
+  estimatedAmountCents = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 projected_units = 8;
+   */
+  projectedUnits = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 projected_amount_cents = 9;
+   */
+  projectedAmountCents = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MeteredUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.MeteredUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "quantity", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "units", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "unit_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "included_units", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "unit_price_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "estimated_amount_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "projected_units", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "projected_amount_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MeteredUsage {
+    return new MeteredUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MeteredUsage {
+    return new MeteredUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MeteredUsage {
+    return new MeteredUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MeteredUsage | PlainMessage<MeteredUsage> | undefined, b: MeteredUsage | PlainMessage<MeteredUsage> | undefined): boolean {
+    return proto3.util.equals(MeteredUsage, a, b);
+  }
+}
+
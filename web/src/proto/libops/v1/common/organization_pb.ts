@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { Status } from "./types_pb.js";
 
 /**
@@ -71,6 +71,36 @@ proto3.util.setEnumType(Location, "libops.v1.common.Location", [
   { no: 8, name: "LOCATION_US" },
 ]);
 
+/**
+ * DataResidency is the geography an organization's data must stay in
+ *
+ * @generated from enum libops.v1.common.DataResidency
+ */
+export enum DataResidency {
+  /**
+   * Any region
+   *
+   * @generated from enum value: DATA_RESIDENCY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DATA_RESIDENCY_EU = 1;
+   */
+  EU = 1,
+
+  /**
+   * @generated from enum value: DATA_RESIDENCY_US = 2;
+   */
+  US = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DataResidency)
+proto3.util.setEnumType(DataResidency, "libops.v1.common.DataResidency", [
+  { no: 0, name: "DATA_RESIDENCY_UNSPECIFIED" },
+  { no: 1, name: "DATA_RESIDENCY_EU" },
+  { no: 2, name: "DATA_RESIDENCY_US" },
+]);
+
 /**
  * FolderConfig is the organization-facing folder/organization configuration
  * Contains only safe, non-sensitive fields
@@ -109,6 +139,30 @@ export class FolderConfig extends Message<FolderConfig> {
    */
   region = "";
 
+  /**
+   * Free-form key/value labels for grouping and filtering (e.g., env=prod)
+   *
+   * @generated from field: map<string, string> labels = 6;
+   */
+  labels: { [key: string]: string } = {};
+
+  /**
+   * Keeps the organization's projects, and their backups and infrastructure
+   * state, in one geography. Projects can only be created in its regions, and
+   * it can't be set while a project runs outside them.
+   *
+   * @generated from field: libops.v1.common.DataResidency data_residency = 7;
+   */
+  dataResidency = DataResidency.UNSPECIFIED;
+
+  /**
+   * Changes whenever the organization's configuration does. Send it back on update to
+   * apply the change only if nobody else changed the organization since it was read.
+   *
+   * @generated from field: string etag = 8;
+   */
+  etag = "";
+
   constructor(data?: PartialMessage<FolderConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -122,6 +176,9 @@ export class FolderConfig extends Message<FolderConfig> {
     { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
     { no: 4, name: "location", kind: "enum", T: proto3.getEnumType(Location) },
     { no: 5, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "data_residency", kind: "enum", T: proto3.getEnumType(DataResidency) },
+    { no: 8, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FolderConfig {
@@ -141,3 +198,74 @@ export class FolderConfig extends Message<FolderConfig> {
   }
 }
 
+/**
+ * Quota is the effective limit on a resource for an organization
+ *
+ * @generated from message libops.v1.common.Quota
+ */
+export class Quota extends Message<Quota> {
+  /**
+   * Resource name: projects, sites_per_project, secrets_per_site, firewall_rules, or api_keys_per_account
+   *
+   * @generated from field: string resource = 1;
+   */
+  resource = "";
+
+  /**
+   * What the limit is counted against: organization, project, site, resource (each organization, project, or site), or account
+   *
+   * @generated from field: string scope = 2;
+   */
+  scope = "";
+
+  /**
+   * @generated from field: int64 limit = 3;
+   */
+  limit = protoInt64.zero;
+
+  /**
+   * Current usage, set only for organization-scoped quotas
+   *
+   * @generated from field: optional int64 usage = 4;
+   */
+  usage?: bigint;
+
+  /**
+   * True when an admin override replaces the plan default
+   *
+   * @generated from field: bool overridden = 5;
+   */
+  overridden = false;
+
+  constructor(data?: PartialMessage<Quota>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.Quota";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scope", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "usage", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 5, name: "overridden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Quota {
+    return new Quota().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Quota {
+    return new Quota().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Quota {
+    return new Quota().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Quota | PlainMessage<Quota> | undefined, b: Quota | PlainMessage<Quota> | undefined): boolean {
+    return proto3.util.equals(Quota, a, b);
+  }
+}
+
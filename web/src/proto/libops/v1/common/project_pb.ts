@@ -126,6 +126,21 @@ export class ProjectConfig extends Message<ProjectConfig> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * Free-form key/value labels for grouping and filtering (e.g., env=prod)
+   *
+   * @generated from field: map<string, string> labels = 17;
+   */
+  labels: { [key: string]: string } = {};
+
+  /**
+   * Changes whenever the project's configuration does. Send it back on update to
+   * apply the change only if nobody else changed the project since it was read.
+   *
+   * @generated from field: string etag = 18;
+   */
+  etag = "";
+
   constructor(data?: PartialMessage<ProjectConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -146,6 +161,8 @@ export class ProjectConfig extends Message<ProjectConfig> {
     { no: 10, name: "disk_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "promote", kind: "enum", T: proto3.getEnumType(PromoteStrategy) },
     { no: 16, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 17, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 18, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectConfig {
@@ -4,9 +4,119 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { Status } from "./types_pb.js";
 
+/**
+ * @generated from enum libops.v1.common.StaticEgressIpStatus
+ */
+export enum StaticEgressIpStatus {
+  /**
+   * @generated from enum value: STATIC_EGRESS_IP_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a reconciliation to reserve it
+   *
+   * @generated from enum value: STATIC_EGRESS_IP_STATUS_PROVISIONING = 1;
+   */
+  PROVISIONING = 1,
+
+  /**
+   * @generated from enum value: STATIC_EGRESS_IP_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * Waiting for a reconciliation to release it
+   *
+   * @generated from enum value: STATIC_EGRESS_IP_STATUS_RELEASING = 3;
+   */
+  RELEASING = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(StaticEgressIpStatus)
+proto3.util.setEnumType(StaticEgressIpStatus, "libops.v1.common.StaticEgressIpStatus", [
+  { no: 0, name: "STATIC_EGRESS_IP_STATUS_UNSPECIFIED" },
+  { no: 1, name: "STATIC_EGRESS_IP_STATUS_PROVISIONING" },
+  { no: 2, name: "STATIC_EGRESS_IP_STATUS_ACTIVE" },
+  { no: 3, name: "STATIC_EGRESS_IP_STATUS_RELEASING" },
+]);
+
+/**
+ * @generated from enum libops.v1.common.CdnCacheMode
+ */
+export enum CdnCacheMode {
+  /**
+   * @generated from enum value: CDN_CACHE_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Cache static content, and anything the origin marks cacheable
+   *
+   * @generated from enum value: CDN_CACHE_MODE_CACHE_ALL_STATIC = 1;
+   */
+  CACHE_ALL_STATIC = 1,
+
+  /**
+   * Cache only what the origin's Cache-Control allows
+   *
+   * @generated from enum value: CDN_CACHE_MODE_USE_ORIGIN_HEADERS = 2;
+   */
+  USE_ORIGIN_HEADERS = 2,
+
+  /**
+   * Cache every successful response, ignoring private and no-store
+   *
+   * @generated from enum value: CDN_CACHE_MODE_FORCE_CACHE_ALL = 3;
+   */
+  FORCE_CACHE_ALL = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CdnCacheMode)
+proto3.util.setEnumType(CdnCacheMode, "libops.v1.common.CdnCacheMode", [
+  { no: 0, name: "CDN_CACHE_MODE_UNSPECIFIED" },
+  { no: 1, name: "CDN_CACHE_MODE_CACHE_ALL_STATIC" },
+  { no: 2, name: "CDN_CACHE_MODE_USE_ORIGIN_HEADERS" },
+  { no: 3, name: "CDN_CACHE_MODE_FORCE_CACHE_ALL" },
+]);
+
+/**
+ * @generated from enum libops.v1.common.SiteCdnStatus
+ */
+export enum SiteCdnStatus {
+  /**
+   * @generated from enum value: SITE_CDN_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a reconciliation to build the load balancer
+   *
+   * @generated from enum value: SITE_CDN_STATUS_PROVISIONING = 1;
+   */
+  PROVISIONING = 1,
+
+  /**
+   * @generated from enum value: SITE_CDN_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * Waiting for a reconciliation to remove it
+   *
+   * @generated from enum value: SITE_CDN_STATUS_DISABLING = 3;
+   */
+  DISABLING = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SiteCdnStatus)
+proto3.util.setEnumType(SiteCdnStatus, "libops.v1.common.SiteCdnStatus", [
+  { no: 0, name: "SITE_CDN_STATUS_UNSPECIFIED" },
+  { no: 1, name: "SITE_CDN_STATUS_PROVISIONING" },
+  { no: 2, name: "SITE_CDN_STATUS_ACTIVE" },
+  { no: 3, name: "SITE_CDN_STATUS_DISABLING" },
+]);
+
 /**
  * SiteConfig is the organization-facing site configuration
  * Contains only safe, non-sensitive fields
@@ -137,6 +247,36 @@ export class SiteConfig extends Message<SiteConfig> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * Free-form key/value labels for grouping and filtering (e.g., env=prod)
+   *
+   * @generated from field: map<string, string> labels = 18;
+   */
+  labels: { [key: string]: string } = {};
+
+  /**
+   * Reserved address the site's outbound traffic leaves from; unset when the
+   * site doesn't have one. Output only.
+   *
+   * @generated from field: libops.v1.common.StaticEgressIp static_egress_ip = 19;
+   */
+  staticEgressIp?: StaticEgressIp;
+
+  /**
+   * Computed health; unset until the site is first scored. Output only.
+   *
+   * @generated from field: libops.v1.common.SiteHealth health = 20;
+   */
+  health?: SiteHealth;
+
+  /**
+   * Changes whenever the site's configuration does. Send it back on update to
+   * apply the change only if nobody else changed the site since it was read.
+   *
+   * @generated from field: string etag = 21;
+   */
+  etag = "";
+
   constructor(data?: PartialMessage<SiteConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -162,6 +302,10 @@ export class SiteConfig extends Message<SiteConfig> {
     { no: 16, name: "os", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 17, name: "is_production", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 11, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 18, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 19, name: "static_egress_ip", kind: "message", T: StaticEgressIp },
+    { no: 20, name: "health", kind: "message", T: SiteHealth },
+    { no: 21, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteConfig {
@@ -181,3 +325,225 @@ export class SiteConfig extends Message<SiteConfig> {
   }
 }
 
+/**
+ * SiteHealth scores how well a site is running, 0-100, from its controller's
+ * check-ins, its containers' health checks, its external uptime probes and its
+ * latest deployment. Components without data are left out of the score.
+ *
+ * @generated from message libops.v1.common.SiteHealth
+ */
+export class SiteHealth extends Message<SiteHealth> {
+  /**
+   * @generated from field: int32 score = 1;
+   */
+  score = 0;
+
+  /**
+   * How recently the site's controller checked in
+   *
+   * @generated from field: optional int32 checkin_score = 2;
+   */
+  checkinScore?: number;
+
+  /**
+   * Share of running containers passing their health checks
+   *
+   * @generated from field: optional int32 container_score = 3;
+   */
+  containerScore?: number;
+
+  /**
+   * Share of recent uptime probes that were up
+   *
+   * @generated from field: optional int32 probe_score = 4;
+   */
+  probeScore?: number;
+
+  /**
+   * 0 when the latest deployment failed
+   *
+   * @generated from field: optional int32 deployment_score = 5;
+   */
+  deploymentScore?: number;
+
+  /**
+   * Unix timestamp the score dropped below the incident threshold, 0 above it
+   *
+   * @generated from field: int64 degraded_since = 6;
+   */
+  degradedSince = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 computed_at = 7;
+   */
+  computedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteHealth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.SiteHealth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "checkin_score", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "container_score", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 4, name: "probe_score", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "deployment_score", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 6, name: "degraded_since", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "computed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteHealth {
+    return new SiteHealth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteHealth {
+    return new SiteHealth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteHealth {
+    return new SiteHealth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteHealth | PlainMessage<SiteHealth> | undefined, b: SiteHealth | PlainMessage<SiteHealth> | undefined): boolean {
+    return proto3.util.equals(SiteHealth, a, b);
+  }
+}
+
+/**
+ * StaticEgressIp is a site's reserved outbound address, for vendors that
+ * allowlist by IP
+ *
+ * @generated from message libops.v1.common.StaticEgressIp
+ */
+export class StaticEgressIp extends Message<StaticEgressIp> {
+  /**
+   * Empty until the address is reserved
+   *
+   * @generated from field: string ip_address = 1;
+   */
+  ipAddress = "";
+
+  /**
+   * @generated from field: libops.v1.common.StaticEgressIpStatus status = 2;
+   */
+  status = StaticEgressIpStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 requested_at = 3;
+   */
+  requestedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StaticEgressIp>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.StaticEgressIp";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(StaticEgressIpStatus) },
+    { no: 3, name: "requested_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StaticEgressIp {
+    return new StaticEgressIp().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StaticEgressIp {
+    return new StaticEgressIp().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StaticEgressIp {
+    return new StaticEgressIp().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StaticEgressIp | PlainMessage<StaticEgressIp> | undefined, b: StaticEgressIp | PlainMessage<StaticEgressIp> | undefined): boolean {
+    return proto3.util.equals(StaticEgressIp, a, b);
+  }
+}
+
+/**
+ * SiteCdn is a managed Cloud CDN load balancer in front of a site
+ *
+ * @generated from message libops.v1.common.SiteCdn
+ */
+export class SiteCdn extends Message<SiteCdn> {
+  /**
+   * @generated from field: libops.v1.common.CdnCacheMode cache_mode = 1;
+   */
+  cacheMode = CdnCacheMode.UNSPECIFIED;
+
+  /**
+   * TTL for responses without cache headers of their own
+   *
+   * @generated from field: int32 default_ttl_seconds = 2;
+   */
+  defaultTtlSeconds = 0;
+
+  /**
+   * Address to point the site's domains at; empty until provisioned
+   *
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress = "";
+
+  /**
+   * @generated from field: libops.v1.common.SiteCdnStatus status = 4;
+   */
+  status = SiteCdnStatus.UNSPECIFIED;
+
+  /**
+   * Cache purges waiting for the next reconciliation
+   *
+   * @generated from field: int32 pending_purges = 5;
+   */
+  pendingPurges = 0;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 enabled_at = 6;
+   */
+  enabledAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteCdn>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.common.SiteCdn";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cache_mode", kind: "enum", T: proto3.getEnumType(CdnCacheMode) },
+    { no: 2, name: "default_ttl_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(SiteCdnStatus) },
+    { no: 5, name: "pending_purges", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "enabled_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteCdn {
+    return new SiteCdn().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteCdn {
+    return new SiteCdn().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteCdn {
+    return new SiteCdn().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteCdn | PlainMessage<SiteCdn> | undefined, b: SiteCdn | PlainMessage<SiteCdn> | undefined): boolean {
+    return proto3.util.equals(SiteCdn, a, b);
+  }
+}
+
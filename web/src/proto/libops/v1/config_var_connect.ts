@@ -0,0 +1,83 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/config_var.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteConfigVarRequest, DiffConfigVarsRequest, DiffConfigVarsResponse, ListConfigVarRevisionsRequest, ListConfigVarRevisionsResponse, ListConfigVarsRequest, ListConfigVarsResponse, SetConfigVarRequest, SetConfigVarResponse } from "./config_var_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SiteConfigVarService manages a site's config vars: environment variables
+ * that aren't sensitive, such as feature flags or PHP memory limits. Unlike
+ * secrets they're stored and shown in plaintext, and every change is kept as
+ * a numbered revision so any two can be diffed. The site's controller writes
+ * them next to its secrets on the next reconciliation, which each change
+ * queues.
+ *
+ * @generated from service libops.v1.SiteConfigVarService
+ */
+export const SiteConfigVarService = {
+  typeName: "libops.v1.SiteConfigVarService",
+  methods: {
+    /**
+     * List a site's config vars
+     *
+     * @generated from rpc libops.v1.SiteConfigVarService.ListConfigVars
+     */
+    listConfigVars: {
+      name: "ListConfigVars",
+      I: ListConfigVarsRequest,
+      O: ListConfigVarsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set a config var, adding it if the site doesn't have it
+     *
+     * @generated from rpc libops.v1.SiteConfigVarService.SetConfigVar
+     */
+    setConfigVar: {
+      name: "SetConfigVar",
+      I: SetConfigVarRequest,
+      O: SetConfigVarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a config var from a site
+     *
+     * @generated from rpc libops.v1.SiteConfigVarService.DeleteConfigVar
+     */
+    deleteConfigVar: {
+      name: "DeleteConfigVar",
+      I: DeleteConfigVarRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the changes to a site's config vars, newest first
+     *
+     * @generated from rpc libops.v1.SiteConfigVarService.ListConfigVarRevisions
+     */
+    listConfigVarRevisions: {
+      name: "ListConfigVarRevisions",
+      I: ListConfigVarRevisionsRequest,
+      O: ListConfigVarRevisionsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Compare a site's config vars at two revisions
+     *
+     * @generated from rpc libops.v1.SiteConfigVarService.DiffConfigVars
+     */
+    diffConfigVars: {
+      name: "DiffConfigVars",
+      I: DiffConfigVarsRequest,
+      O: DiffConfigVarsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
@@ -0,0 +1,682 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/config_var.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * ConfigVarChangeType is how a revision or diff changed a config var
+ *
+ * @generated from enum libops.v1.ConfigVarChangeType
+ */
+export enum ConfigVarChangeType {
+  /**
+   * @generated from enum value: CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CONFIG_VAR_CHANGE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: CONFIG_VAR_CHANGE_TYPE_CHANGED = 2;
+   */
+  CHANGED = 2,
+
+  /**
+   * @generated from enum value: CONFIG_VAR_CHANGE_TYPE_REMOVED = 3;
+   */
+  REMOVED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConfigVarChangeType)
+proto3.util.setEnumType(ConfigVarChangeType, "libops.v1.ConfigVarChangeType", [
+  { no: 0, name: "CONFIG_VAR_CHANGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "CONFIG_VAR_CHANGE_TYPE_ADDED" },
+  { no: 2, name: "CONFIG_VAR_CHANGE_TYPE_CHANGED" },
+  { no: 3, name: "CONFIG_VAR_CHANGE_TYPE_REMOVED" },
+]);
+
+/**
+ * ConfigVar is a plaintext environment variable of a site
+ *
+ * @generated from message libops.v1.ConfigVar
+ */
+export class ConfigVar extends Message<ConfigVar> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Uppercase, starts with a letter, e.g. PHP_MEMORY_LIMIT
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  /**
+   * The revision that last set it
+   *
+   * @generated from field: int64 revision = 4;
+   */
+  revision = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 5;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ConfigVar>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ConfigVar";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigVar {
+    return new ConfigVar().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigVar {
+    return new ConfigVar().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigVar {
+    return new ConfigVar().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigVar | PlainMessage<ConfigVar> | undefined, b: ConfigVar | PlainMessage<ConfigVar> | undefined): boolean {
+    return proto3.util.equals(ConfigVar, a, b);
+  }
+}
+
+/**
+ * ConfigVarRevision is one change to a site's config vars
+ *
+ * @generated from message libops.v1.ConfigVarRevision
+ */
+export class ConfigVarRevision extends Message<ConfigVarRevision> {
+  /**
+   * Numbered from 1 per site
+   *
+   * @generated from field: int64 revision = 1;
+   */
+  revision = protoInt64.zero;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.ConfigVarChangeType change_type = 3;
+   */
+  changeType = ConfigVarChangeType.UNSPECIFIED;
+
+  /**
+   * Empty when the var was added
+   *
+   * @generated from field: string previous_value = 4;
+   */
+  previousValue = "";
+
+  /**
+   * Empty when the var was removed
+   *
+   * @generated from field: string value = 5;
+   */
+  value = "";
+
+  /**
+   * Email of the account that made the change
+   *
+   * @generated from field: string changed_by = 6;
+   */
+  changedBy = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 7;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ConfigVarRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ConfigVarRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "change_type", kind: "enum", T: proto3.getEnumType(ConfigVarChangeType) },
+    { no: 4, name: "previous_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "changed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigVarRevision {
+    return new ConfigVarRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigVarRevision {
+    return new ConfigVarRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigVarRevision {
+    return new ConfigVarRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigVarRevision | PlainMessage<ConfigVarRevision> | undefined, b: ConfigVarRevision | PlainMessage<ConfigVarRevision> | undefined): boolean {
+    return proto3.util.equals(ConfigVarRevision, a, b);
+  }
+}
+
+/**
+ * ConfigVarDiff is how a config var differs between two revisions
+ *
+ * @generated from message libops.v1.ConfigVarDiff
+ */
+export class ConfigVarDiff extends Message<ConfigVarDiff> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.ConfigVarChangeType change_type = 2;
+   */
+  changeType = ConfigVarChangeType.UNSPECIFIED;
+
+  /**
+   * Empty when the var was added
+   *
+   * @generated from field: string from_value = 3;
+   */
+  fromValue = "";
+
+  /**
+   * Empty when the var was removed
+   *
+   * @generated from field: string to_value = 4;
+   */
+  toValue = "";
+
+  constructor(data?: PartialMessage<ConfigVarDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ConfigVarDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change_type", kind: "enum", T: proto3.getEnumType(ConfigVarChangeType) },
+    { no: 3, name: "from_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigVarDiff {
+    return new ConfigVarDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigVarDiff {
+    return new ConfigVarDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigVarDiff {
+    return new ConfigVarDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigVarDiff | PlainMessage<ConfigVarDiff> | undefined, b: ConfigVarDiff | PlainMessage<ConfigVarDiff> | undefined): boolean {
+    return proto3.util.equals(ConfigVarDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListConfigVarsRequest
+ */
+export class ListConfigVarsRequest extends Message<ListConfigVarsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListConfigVarsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListConfigVarsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigVarsRequest {
+    return new ListConfigVarsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigVarsRequest {
+    return new ListConfigVarsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigVarsRequest {
+    return new ListConfigVarsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigVarsRequest | PlainMessage<ListConfigVarsRequest> | undefined, b: ListConfigVarsRequest | PlainMessage<ListConfigVarsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConfigVarsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListConfigVarsResponse
+ */
+export class ListConfigVarsResponse extends Message<ListConfigVarsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ConfigVar config_vars = 1;
+   */
+  configVars: ConfigVar[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  /**
+   * The site's latest revision
+   *
+   * @generated from field: int64 revision = 3;
+   */
+  revision = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListConfigVarsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListConfigVarsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config_vars", kind: "message", T: ConfigVar, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigVarsResponse {
+    return new ListConfigVarsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigVarsResponse {
+    return new ListConfigVarsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigVarsResponse {
+    return new ListConfigVarsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigVarsResponse | PlainMessage<ListConfigVarsResponse> | undefined, b: ListConfigVarsResponse | PlainMessage<ListConfigVarsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConfigVarsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetConfigVarRequest
+ */
+export class SetConfigVarRequest extends Message<SetConfigVarRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<SetConfigVarRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetConfigVarRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigVarRequest {
+    return new SetConfigVarRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigVarRequest {
+    return new SetConfigVarRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigVarRequest {
+    return new SetConfigVarRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConfigVarRequest | PlainMessage<SetConfigVarRequest> | undefined, b: SetConfigVarRequest | PlainMessage<SetConfigVarRequest> | undefined): boolean {
+    return proto3.util.equals(SetConfigVarRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetConfigVarResponse
+ */
+export class SetConfigVarResponse extends Message<SetConfigVarResponse> {
+  /**
+   * @generated from field: libops.v1.ConfigVar config_var = 1;
+   */
+  configVar?: ConfigVar;
+
+  constructor(data?: PartialMessage<SetConfigVarResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetConfigVarResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config_var", kind: "message", T: ConfigVar },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigVarResponse {
+    return new SetConfigVarResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigVarResponse {
+    return new SetConfigVarResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigVarResponse {
+    return new SetConfigVarResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConfigVarResponse | PlainMessage<SetConfigVarResponse> | undefined, b: SetConfigVarResponse | PlainMessage<SetConfigVarResponse> | undefined): boolean {
+    return proto3.util.equals(SetConfigVarResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteConfigVarRequest
+ */
+export class DeleteConfigVarRequest extends Message<DeleteConfigVarRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<DeleteConfigVarRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteConfigVarRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteConfigVarRequest {
+    return new DeleteConfigVarRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteConfigVarRequest {
+    return new DeleteConfigVarRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteConfigVarRequest {
+    return new DeleteConfigVarRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteConfigVarRequest | PlainMessage<DeleteConfigVarRequest> | undefined, b: DeleteConfigVarRequest | PlainMessage<DeleteConfigVarRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteConfigVarRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListConfigVarRevisionsRequest
+ */
+export class ListConfigVarRevisionsRequest extends Message<ListConfigVarRevisionsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListConfigVarRevisionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListConfigVarRevisionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigVarRevisionsRequest {
+    return new ListConfigVarRevisionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigVarRevisionsRequest {
+    return new ListConfigVarRevisionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigVarRevisionsRequest {
+    return new ListConfigVarRevisionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigVarRevisionsRequest | PlainMessage<ListConfigVarRevisionsRequest> | undefined, b: ListConfigVarRevisionsRequest | PlainMessage<ListConfigVarRevisionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConfigVarRevisionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListConfigVarRevisionsResponse
+ */
+export class ListConfigVarRevisionsResponse extends Message<ListConfigVarRevisionsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ConfigVarRevision revisions = 1;
+   */
+  revisions: ConfigVarRevision[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListConfigVarRevisionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListConfigVarRevisionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revisions", kind: "message", T: ConfigVarRevision, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigVarRevisionsResponse {
+    return new ListConfigVarRevisionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigVarRevisionsResponse {
+    return new ListConfigVarRevisionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigVarRevisionsResponse {
+    return new ListConfigVarRevisionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigVarRevisionsResponse | PlainMessage<ListConfigVarRevisionsResponse> | undefined, b: ListConfigVarRevisionsResponse | PlainMessage<ListConfigVarRevisionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConfigVarRevisionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DiffConfigVarsRequest
+ */
+export class DiffConfigVarsRequest extends Message<DiffConfigVarsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * 0 compares against no config vars
+   *
+   * @generated from field: int64 from_revision = 2;
+   */
+  fromRevision = protoInt64.zero;
+
+  /**
+   * Defaults to the latest revision
+   *
+   * @generated from field: int64 to_revision = 3;
+   */
+  toRevision = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DiffConfigVarsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DiffConfigVarsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "to_revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffConfigVarsRequest {
+    return new DiffConfigVarsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffConfigVarsRequest {
+    return new DiffConfigVarsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffConfigVarsRequest {
+    return new DiffConfigVarsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffConfigVarsRequest | PlainMessage<DiffConfigVarsRequest> | undefined, b: DiffConfigVarsRequest | PlainMessage<DiffConfigVarsRequest> | undefined): boolean {
+    return proto3.util.equals(DiffConfigVarsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DiffConfigVarsResponse
+ */
+export class DiffConfigVarsResponse extends Message<DiffConfigVarsResponse> {
+  /**
+   * @generated from field: int64 from_revision = 1;
+   */
+  fromRevision = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 to_revision = 2;
+   */
+  toRevision = protoInt64.zero;
+
+  /**
+   * By name
+   *
+   * @generated from field: repeated libops.v1.ConfigVarDiff diffs = 3;
+   */
+  diffs: ConfigVarDiff[] = [];
+
+  constructor(data?: PartialMessage<DiffConfigVarsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DiffConfigVarsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "from_revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "to_revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "diffs", kind: "message", T: ConfigVarDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffConfigVarsResponse {
+    return new DiffConfigVarsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffConfigVarsResponse {
+    return new DiffConfigVarsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffConfigVarsResponse {
+    return new DiffConfigVarsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffConfigVarsResponse | PlainMessage<DiffConfigVarsResponse> | undefined, b: DiffConfigVarsResponse | PlainMessage<DiffConfigVarsResponse> | undefined): boolean {
+    return proto3.util.equals(DiffConfigVarsResponse, a, b);
+  }
+}
+
@@ -0,0 +1,58 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/database.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateDatabaseRequest, CreateDatabaseResponse, ListDatabasesRequest, ListDatabasesResponse, ResetDatabasePasswordRequest, ResetDatabasePasswordResponse } from "./database_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * DatabaseService manages MySQL databases libops runs for a site, either on a
+ * MySQL server next to the site on its VM or on a Cloud SQL instance of the
+ * site's own. Each database's credentials reach the site as managed site
+ * secrets, which libops writes and the secrets API can't change.
+ *
+ * @generated from service libops.v1.DatabaseService
+ */
+export const DatabaseService = {
+  typeName: "libops.v1.DatabaseService",
+  methods: {
+    /**
+     * Create a database for a site. It's provisioned by the site's next
+     * reconciliation, which creating it queues
+     *
+     * @generated from rpc libops.v1.DatabaseService.CreateDatabase
+     */
+    createDatabase: {
+      name: "CreateDatabase",
+      I: CreateDatabaseRequest,
+      O: CreateDatabaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List a site's databases
+     *
+     * @generated from rpc libops.v1.DatabaseService.ListDatabases
+     */
+    listDatabases: {
+      name: "ListDatabases",
+      I: ListDatabasesRequest,
+      O: ListDatabasesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Give a database's user a new password. The site gets it in its password
+     * secret with the reconciliation that sets it
+     *
+     * @generated from rpc libops.v1.DatabaseService.ResetDatabasePassword
+     */
+    resetDatabasePassword: {
+      name: "ResetDatabasePassword",
+      I: ResetDatabasePasswordRequest,
+      O: ResetDatabasePasswordResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
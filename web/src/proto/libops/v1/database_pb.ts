@@ -0,0 +1,442 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/database.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.DatabaseTier
+ */
+export enum DatabaseTier {
+  /**
+   * @generated from enum value: DATABASE_TIER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * On a MySQL server on the site's VM
+   *
+   * @generated from enum value: DATABASE_TIER_COLOCATED = 1;
+   */
+  COLOCATED = 1,
+
+  /**
+   * On a Cloud SQL instance of the site's own
+   *
+   * @generated from enum value: DATABASE_TIER_CLOUD_SQL = 2;
+   */
+  CLOUD_SQL = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DatabaseTier)
+proto3.util.setEnumType(DatabaseTier, "libops.v1.DatabaseTier", [
+  { no: 0, name: "DATABASE_TIER_UNSPECIFIED" },
+  { no: 1, name: "DATABASE_TIER_COLOCATED" },
+  { no: 2, name: "DATABASE_TIER_CLOUD_SQL" },
+]);
+
+/**
+ * @generated from enum libops.v1.DatabaseStatus
+ */
+export enum DatabaseStatus {
+  /**
+   * @generated from enum value: DATABASE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DATABASE_STATUS_PROVISIONING = 1;
+   */
+  PROVISIONING = 1,
+
+  /**
+   * @generated from enum value: DATABASE_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * @generated from enum value: DATABASE_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DatabaseStatus)
+proto3.util.setEnumType(DatabaseStatus, "libops.v1.DatabaseStatus", [
+  { no: 0, name: "DATABASE_STATUS_UNSPECIFIED" },
+  { no: 1, name: "DATABASE_STATUS_PROVISIONING" },
+  { no: 2, name: "DATABASE_STATUS_ACTIVE" },
+  { no: 3, name: "DATABASE_STATUS_FAILED" },
+]);
+
+/**
+ * @generated from message libops.v1.SiteDatabase
+ */
+export class SiteDatabase extends Message<SiteDatabase> {
+  /**
+   * @generated from field: string database_id = 1;
+   */
+  databaseId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * Also the name of its user
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.DatabaseTier tier = 4;
+   */
+  tier = DatabaseTier.UNSPECIFIED;
+
+  /**
+   * @generated from field: libops.v1.DatabaseStatus status = 5;
+   */
+  status = DatabaseStatus.UNSPECIFIED;
+
+  /**
+   * Why provisioning failed
+   *
+   * @generated from field: string status_message = 6;
+   */
+  statusMessage = "";
+
+  /**
+   * Empty until it's provisioned
+   *
+   * @generated from field: string host = 7;
+   */
+  host = "";
+
+  /**
+   * @generated from field: int32 port = 8;
+   */
+  port = 0;
+
+  /**
+   * Managed site secrets holding its credentials
+   *
+   * @generated from field: string user_secret_name = 9;
+   */
+  userSecretName = "";
+
+  /**
+   * @generated from field: string password_secret_name = 10;
+   */
+  passwordSecretName = "";
+
+  /**
+   * @generated from field: string host_secret_name = 11;
+   */
+  hostSecretName = "";
+
+  /**
+   * @generated from field: string port_secret_name = 12;
+   */
+  portSecretName = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 password_rotated_at = 13;
+   */
+  passwordRotatedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 14;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteDatabase>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteDatabase";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "tier", kind: "enum", T: proto3.getEnumType(DatabaseTier) },
+    { no: 5, name: "status", kind: "enum", T: proto3.getEnumType(DatabaseStatus) },
+    { no: 6, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "user_secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "password_secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "host_secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "port_secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "password_rotated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteDatabase {
+    return new SiteDatabase().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteDatabase {
+    return new SiteDatabase().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteDatabase {
+    return new SiteDatabase().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteDatabase | PlainMessage<SiteDatabase> | undefined, b: SiteDatabase | PlainMessage<SiteDatabase> | undefined): boolean {
+    return proto3.util.equals(SiteDatabase, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateDatabaseRequest
+ */
+export class CreateDatabaseRequest extends Message<CreateDatabaseRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Lowercase letters, digits and underscores, starting with a letter; at
+   * most 32 characters
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Defaults to colocated
+   *
+   * @generated from field: libops.v1.DatabaseTier tier = 3;
+   */
+  tier = DatabaseTier.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<CreateDatabaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateDatabaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tier", kind: "enum", T: proto3.getEnumType(DatabaseTier) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDatabaseRequest {
+    return new CreateDatabaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDatabaseRequest {
+    return new CreateDatabaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDatabaseRequest {
+    return new CreateDatabaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateDatabaseRequest | PlainMessage<CreateDatabaseRequest> | undefined, b: CreateDatabaseRequest | PlainMessage<CreateDatabaseRequest> | undefined): boolean {
+    return proto3.util.equals(CreateDatabaseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateDatabaseResponse
+ */
+export class CreateDatabaseResponse extends Message<CreateDatabaseResponse> {
+  /**
+   * @generated from field: libops.v1.SiteDatabase database = 1;
+   */
+  database?: SiteDatabase;
+
+  constructor(data?: PartialMessage<CreateDatabaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateDatabaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database", kind: "message", T: SiteDatabase },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDatabaseResponse {
+    return new CreateDatabaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDatabaseResponse {
+    return new CreateDatabaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDatabaseResponse {
+    return new CreateDatabaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateDatabaseResponse | PlainMessage<CreateDatabaseResponse> | undefined, b: CreateDatabaseResponse | PlainMessage<CreateDatabaseResponse> | undefined): boolean {
+    return proto3.util.equals(CreateDatabaseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListDatabasesRequest
+ */
+export class ListDatabasesRequest extends Message<ListDatabasesRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ListDatabasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListDatabasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDatabasesRequest {
+    return new ListDatabasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDatabasesRequest {
+    return new ListDatabasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDatabasesRequest {
+    return new ListDatabasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDatabasesRequest | PlainMessage<ListDatabasesRequest> | undefined, b: ListDatabasesRequest | PlainMessage<ListDatabasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListDatabasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListDatabasesResponse
+ */
+export class ListDatabasesResponse extends Message<ListDatabasesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SiteDatabase databases = 1;
+   */
+  databases: SiteDatabase[] = [];
+
+  constructor(data?: PartialMessage<ListDatabasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListDatabasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "databases", kind: "message", T: SiteDatabase, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDatabasesResponse {
+    return new ListDatabasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDatabasesResponse {
+    return new ListDatabasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDatabasesResponse {
+    return new ListDatabasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDatabasesResponse | PlainMessage<ListDatabasesResponse> | undefined, b: ListDatabasesResponse | PlainMessage<ListDatabasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListDatabasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResetDatabasePasswordRequest
+ */
+export class ResetDatabasePasswordRequest extends Message<ResetDatabasePasswordRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string database_id = 2;
+   */
+  databaseId = "";
+
+  constructor(data?: PartialMessage<ResetDatabasePasswordRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResetDatabasePasswordRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "database_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetDatabasePasswordRequest {
+    return new ResetDatabasePasswordRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetDatabasePasswordRequest {
+    return new ResetDatabasePasswordRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetDatabasePasswordRequest {
+    return new ResetDatabasePasswordRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetDatabasePasswordRequest | PlainMessage<ResetDatabasePasswordRequest> | undefined, b: ResetDatabasePasswordRequest | PlainMessage<ResetDatabasePasswordRequest> | undefined): boolean {
+    return proto3.util.equals(ResetDatabasePasswordRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ResetDatabasePasswordResponse
+ */
+export class ResetDatabasePasswordResponse extends Message<ResetDatabasePasswordResponse> {
+  /**
+   * @generated from field: libops.v1.SiteDatabase database = 1;
+   */
+  database?: SiteDatabase;
+
+  constructor(data?: PartialMessage<ResetDatabasePasswordResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ResetDatabasePasswordResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database", kind: "message", T: SiteDatabase },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetDatabasePasswordResponse {
+    return new ResetDatabasePasswordResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetDatabasePasswordResponse {
+    return new ResetDatabasePasswordResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetDatabasePasswordResponse {
+    return new ResetDatabasePasswordResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetDatabasePasswordResponse | PlainMessage<ResetDatabasePasswordResponse> | undefined, b: ResetDatabasePasswordResponse | PlainMessage<ResetDatabasePasswordResponse> | undefined): boolean {
+    return proto3.util.equals(ResetDatabasePasswordResponse, a, b);
+  }
+}
+
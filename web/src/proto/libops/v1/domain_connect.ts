@@ -0,0 +1,54 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/domain.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateSiteDomainRequest, CreateSiteDomainResponse, DeleteSiteDomainRequest, ListSiteDomainsRequest, ListSiteDomainsResponse } from "./domain_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SiteDomainService manages the custom domains a site is served on
+ *
+ * @generated from service libops.v1.SiteDomainService
+ */
+export const SiteDomainService = {
+  typeName: "libops.v1.SiteDomainService",
+  methods: {
+    /**
+     * List a site's domains, newest first
+     *
+     * @generated from rpc libops.v1.SiteDomainService.ListSiteDomains
+     */
+    listSiteDomains: {
+      name: "ListSiteDomains",
+      I: ListSiteDomainsRequest,
+      O: ListSiteDomainsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Add a domain to a site. A domain can only belong to one site.
+     *
+     * @generated from rpc libops.v1.SiteDomainService.CreateSiteDomain
+     */
+    createSiteDomain: {
+      name: "CreateSiteDomain",
+      I: CreateSiteDomainRequest,
+      O: CreateSiteDomainResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a domain from a site
+     *
+     * @generated from rpc libops.v1.SiteDomainService.DeleteSiteDomain
+     */
+    deleteSiteDomain: {
+      name: "DeleteSiteDomain",
+      I: DeleteSiteDomainRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,276 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/domain.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message libops.v1.SiteDomain
+ */
+export class SiteDomain extends Message<SiteDomain> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Lowercase hostname, e.g. "digital.example.edu"
+   *
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 3;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteDomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteDomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteDomain {
+    return new SiteDomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteDomain {
+    return new SiteDomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteDomain {
+    return new SiteDomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteDomain | PlainMessage<SiteDomain> | undefined, b: SiteDomain | PlainMessage<SiteDomain> | undefined): boolean {
+    return proto3.util.equals(SiteDomain, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteDomainsRequest
+ */
+export class ListSiteDomainsRequest extends Message<ListSiteDomainsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteDomainsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteDomainsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteDomainsRequest {
+    return new ListSiteDomainsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteDomainsRequest {
+    return new ListSiteDomainsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteDomainsRequest {
+    return new ListSiteDomainsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteDomainsRequest | PlainMessage<ListSiteDomainsRequest> | undefined, b: ListSiteDomainsRequest | PlainMessage<ListSiteDomainsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSiteDomainsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteDomainsResponse
+ */
+export class ListSiteDomainsResponse extends Message<ListSiteDomainsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SiteDomain domains = 1;
+   */
+  domains: SiteDomain[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteDomainsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domains", kind: "message", T: SiteDomain, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteDomainsResponse {
+    return new ListSiteDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteDomainsResponse {
+    return new ListSiteDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteDomainsResponse {
+    return new ListSiteDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteDomainsResponse | PlainMessage<ListSiteDomainsResponse> | undefined, b: ListSiteDomainsResponse | PlainMessage<ListSiteDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSiteDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateSiteDomainRequest
+ */
+export class CreateSiteDomainRequest extends Message<CreateSiteDomainRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<CreateSiteDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateSiteDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteDomainRequest {
+    return new CreateSiteDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteDomainRequest {
+    return new CreateSiteDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteDomainRequest {
+    return new CreateSiteDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSiteDomainRequest | PlainMessage<CreateSiteDomainRequest> | undefined, b: CreateSiteDomainRequest | PlainMessage<CreateSiteDomainRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSiteDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateSiteDomainResponse
+ */
+export class CreateSiteDomainResponse extends Message<CreateSiteDomainResponse> {
+  /**
+   * @generated from field: libops.v1.SiteDomain domain = 1;
+   */
+  domain?: SiteDomain;
+
+  constructor(data?: PartialMessage<CreateSiteDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateSiteDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "message", T: SiteDomain },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteDomainResponse {
+    return new CreateSiteDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteDomainResponse {
+    return new CreateSiteDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteDomainResponse {
+    return new CreateSiteDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSiteDomainResponse | PlainMessage<CreateSiteDomainResponse> | undefined, b: CreateSiteDomainResponse | PlainMessage<CreateSiteDomainResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSiteDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteDomainRequest
+ */
+export class DeleteSiteDomainRequest extends Message<DeleteSiteDomainRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<DeleteSiteDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteDomainRequest {
+    return new DeleteSiteDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteDomainRequest {
+    return new DeleteSiteDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteDomainRequest {
+    return new DeleteSiteDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteDomainRequest | PlainMessage<DeleteSiteDomainRequest> | undefined, b: DeleteSiteDomainRequest | PlainMessage<DeleteSiteDomainRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteDomainRequest, a, b);
+  }
+}
+
@@ -0,0 +1,46 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/egress.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ReleaseStaticEgressIpRequest, RequestStaticEgressIpRequest, RequestStaticEgressIpResponse } from "./egress_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SiteEgressService manages a site's static egress IP: a reserved external
+ * address its outbound traffic leaves from, for vendors that allowlist by IP.
+ * The address is reserved by the site's next reconciliation and shown on the
+ * site once it exists.
+ *
+ * @generated from service libops.v1.SiteEgressService
+ */
+export const SiteEgressService = {
+  typeName: "libops.v1.SiteEgressService",
+  methods: {
+    /**
+     * Request a static egress IP for a site. A site has at most one.
+     *
+     * @generated from rpc libops.v1.SiteEgressService.RequestStaticEgressIp
+     */
+    requestStaticEgressIp: {
+      name: "RequestStaticEgressIp",
+      I: RequestStaticEgressIpRequest,
+      O: RequestStaticEgressIpResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Release a site's static egress IP. Its outbound traffic goes back to an
+     * ephemeral address once the reconciliation releasing it reports back.
+     *
+     * @generated from rpc libops.v1.SiteEgressService.ReleaseStaticEgressIp
+     */
+    releaseStaticEgressIp: {
+      name: "ReleaseStaticEgressIp",
+      I: ReleaseStaticEgressIpRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
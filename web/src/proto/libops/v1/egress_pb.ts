@@ -0,0 +1,120 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/egress.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { StaticEgressIp } from "./common/site_pb.js";
+
+/**
+ * @generated from message libops.v1.RequestStaticEgressIpRequest
+ */
+export class RequestStaticEgressIpRequest extends Message<RequestStaticEgressIpRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<RequestStaticEgressIpRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestStaticEgressIpRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestStaticEgressIpRequest {
+    return new RequestStaticEgressIpRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestStaticEgressIpRequest {
+    return new RequestStaticEgressIpRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestStaticEgressIpRequest {
+    return new RequestStaticEgressIpRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestStaticEgressIpRequest | PlainMessage<RequestStaticEgressIpRequest> | undefined, b: RequestStaticEgressIpRequest | PlainMessage<RequestStaticEgressIpRequest> | undefined): boolean {
+    return proto3.util.equals(RequestStaticEgressIpRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RequestStaticEgressIpResponse
+ */
+export class RequestStaticEgressIpResponse extends Message<RequestStaticEgressIpResponse> {
+  /**
+   * @generated from field: libops.v1.common.StaticEgressIp static_egress_ip = 1;
+   */
+  staticEgressIp?: StaticEgressIp;
+
+  constructor(data?: PartialMessage<RequestStaticEgressIpResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestStaticEgressIpResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "static_egress_ip", kind: "message", T: StaticEgressIp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestStaticEgressIpResponse {
+    return new RequestStaticEgressIpResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestStaticEgressIpResponse {
+    return new RequestStaticEgressIpResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestStaticEgressIpResponse {
+    return new RequestStaticEgressIpResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestStaticEgressIpResponse | PlainMessage<RequestStaticEgressIpResponse> | undefined, b: RequestStaticEgressIpResponse | PlainMessage<RequestStaticEgressIpResponse> | undefined): boolean {
+    return proto3.util.equals(RequestStaticEgressIpResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReleaseStaticEgressIpRequest
+ */
+export class ReleaseStaticEgressIpRequest extends Message<ReleaseStaticEgressIpRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ReleaseStaticEgressIpRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReleaseStaticEgressIpRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReleaseStaticEgressIpRequest {
+    return new ReleaseStaticEgressIpRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReleaseStaticEgressIpRequest {
+    return new ReleaseStaticEgressIpRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReleaseStaticEgressIpRequest {
+    return new ReleaseStaticEgressIpRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReleaseStaticEgressIpRequest | PlainMessage<ReleaseStaticEgressIpRequest> | undefined, b: ReleaseStaticEgressIpRequest | PlainMessage<ReleaseStaticEgressIpRequest> | undefined): boolean {
+    return proto3.util.equals(ReleaseStaticEgressIpRequest, a, b);
+  }
+}
+
@@ -0,0 +1,79 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/elevation.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ApproveElevationRequest, ApproveElevationResponse, ListElevationsRequest, ListElevationsResponse, RejectElevationRequest, RejectElevationResponse, RequestElevationRequest, RequestElevationResponse, RevokeElevationRequest, RevokeElevationResponse } from "./elevation_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * ElevationService gives site members just-in-time elevated access: a member
+ * with read access requests a higher role on a site for a limited time, such
+ * as while responding to a production incident, and a site owner approves or
+ * rejects it. An approved role lapses on its own, so nobody needs standing
+ * admin access. Every step is audited.
+ *
+ * @generated from service libops.v1.ElevationService
+ */
+export const ElevationService = {
+  typeName: "libops.v1.ElevationService",
+  methods: {
+    /**
+     * Request a higher role on the site for a limited time
+     *
+     * @generated from rpc libops.v1.ElevationService.RequestElevation
+     */
+    requestElevation: {
+      name: "RequestElevation",
+      I: RequestElevationRequest,
+      O: RequestElevationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the site's elevation requests, newest first
+     *
+     * @generated from rpc libops.v1.ElevationService.ListElevations
+     */
+    listElevations: {
+      name: "ListElevations",
+      I: ListElevationsRequest,
+      O: ListElevationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Approve a request; the role lasts for the requested duration from now
+     *
+     * @generated from rpc libops.v1.ElevationService.ApproveElevation
+     */
+    approveElevation: {
+      name: "ApproveElevation",
+      I: ApproveElevationRequest,
+      O: ApproveElevationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reject a request
+     *
+     * @generated from rpc libops.v1.ElevationService.RejectElevation
+     */
+    rejectElevation: {
+      name: "RejectElevation",
+      I: RejectElevationRequest,
+      O: RejectElevationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * End an approved role early, or withdraw a pending request
+     *
+     * @generated from rpc libops.v1.ElevationService.RevokeElevation
+     */
+    revokeElevation: {
+      name: "RevokeElevation",
+      I: RevokeElevationRequest,
+      O: RevokeElevationResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
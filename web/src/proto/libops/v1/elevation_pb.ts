@@ -0,0 +1,605 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/elevation.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.ElevationStatus
+ */
+export enum ElevationStatus {
+  /**
+   * @generated from enum value: ELEVATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a site owner to approve it
+   *
+   * @generated from enum value: ELEVATION_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The account has the role until expires_at
+   *
+   * @generated from enum value: ELEVATION_STATUS_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * @generated from enum value: ELEVATION_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * @generated from enum value: ELEVATION_STATUS_REVOKED = 4;
+   */
+  REVOKED = 4,
+
+  /**
+   * @generated from enum value: ELEVATION_STATUS_EXPIRED = 5;
+   */
+  EXPIRED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ElevationStatus)
+proto3.util.setEnumType(ElevationStatus, "libops.v1.ElevationStatus", [
+  { no: 0, name: "ELEVATION_STATUS_UNSPECIFIED" },
+  { no: 1, name: "ELEVATION_STATUS_PENDING" },
+  { no: 2, name: "ELEVATION_STATUS_APPROVED" },
+  { no: 3, name: "ELEVATION_STATUS_REJECTED" },
+  { no: 4, name: "ELEVATION_STATUS_REVOKED" },
+  { no: 5, name: "ELEVATION_STATUS_EXPIRED" },
+]);
+
+/**
+ * @generated from message libops.v1.Elevation
+ */
+export class Elevation extends Message<Elevation> {
+  /**
+   * UUID
+   *
+   * @generated from field: string elevation_id = 1;
+   */
+  elevationId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * UUID of the account that requested it
+   *
+   * @generated from field: string account_id = 3;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * "owner" or "developer"
+   *
+   * @generated from field: string role = 5;
+   */
+  role = "";
+
+  /**
+   * @generated from field: string reason = 6;
+   */
+  reason = "";
+
+  /**
+   * How long the role lasts once approved
+   *
+   * @generated from field: int32 duration_minutes = 7;
+   */
+  durationMinutes = 0;
+
+  /**
+   * @generated from field: libops.v1.ElevationStatus status = 8;
+   */
+  status = ElevationStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 9;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until approved, rejected or revoked
+   *
+   * @generated from field: int64 resolved_at = 10;
+   */
+  resolvedAt = protoInt64.zero;
+
+  /**
+   * Email of the account that approved, rejected or revoked it
+   *
+   * @generated from field: string resolved_by = 11;
+   */
+  resolvedBy = "";
+
+  /**
+   * Unix timestamp the role lapses at, 0 until approved
+   *
+   * @generated from field: int64 expires_at = 12;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Elevation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Elevation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "duration_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "status", kind: "enum", T: proto3.getEnumType(ElevationStatus) },
+    { no: 9, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "resolved_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "resolved_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Elevation {
+    return new Elevation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Elevation {
+    return new Elevation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Elevation {
+    return new Elevation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Elevation | PlainMessage<Elevation> | undefined, b: Elevation | PlainMessage<Elevation> | undefined): boolean {
+    return proto3.util.equals(Elevation, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RequestElevationRequest
+ */
+export class RequestElevationRequest extends Message<RequestElevationRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * "owner" or "developer"
+   *
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * Why the role is needed, e.g. an incident link
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * 1 to 1440; defaults to 60
+   *
+   * @generated from field: int32 duration_minutes = 4;
+   */
+  durationMinutes = 0;
+
+  constructor(data?: PartialMessage<RequestElevationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestElevationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "duration_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestElevationRequest {
+    return new RequestElevationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestElevationRequest {
+    return new RequestElevationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestElevationRequest {
+    return new RequestElevationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestElevationRequest | PlainMessage<RequestElevationRequest> | undefined, b: RequestElevationRequest | PlainMessage<RequestElevationRequest> | undefined): boolean {
+    return proto3.util.equals(RequestElevationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RequestElevationResponse
+ */
+export class RequestElevationResponse extends Message<RequestElevationResponse> {
+  /**
+   * @generated from field: libops.v1.Elevation elevation = 1;
+   */
+  elevation?: Elevation;
+
+  constructor(data?: PartialMessage<RequestElevationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestElevationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevation", kind: "message", T: Elevation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestElevationResponse {
+    return new RequestElevationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestElevationResponse {
+    return new RequestElevationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestElevationResponse {
+    return new RequestElevationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestElevationResponse | PlainMessage<RequestElevationResponse> | undefined, b: RequestElevationResponse | PlainMessage<RequestElevationResponse> | undefined): boolean {
+    return proto3.util.equals(RequestElevationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListElevationsRequest
+ */
+export class ListElevationsRequest extends Message<ListElevationsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListElevationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListElevationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListElevationsRequest {
+    return new ListElevationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListElevationsRequest {
+    return new ListElevationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListElevationsRequest {
+    return new ListElevationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListElevationsRequest | PlainMessage<ListElevationsRequest> | undefined, b: ListElevationsRequest | PlainMessage<ListElevationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListElevationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListElevationsResponse
+ */
+export class ListElevationsResponse extends Message<ListElevationsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Elevation elevations = 1;
+   */
+  elevations: Elevation[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListElevationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListElevationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevations", kind: "message", T: Elevation, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListElevationsResponse {
+    return new ListElevationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListElevationsResponse {
+    return new ListElevationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListElevationsResponse {
+    return new ListElevationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListElevationsResponse | PlainMessage<ListElevationsResponse> | undefined, b: ListElevationsResponse | PlainMessage<ListElevationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListElevationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveElevationRequest
+ */
+export class ApproveElevationRequest extends Message<ApproveElevationRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string elevation_id = 2;
+   */
+  elevationId = "";
+
+  constructor(data?: PartialMessage<ApproveElevationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveElevationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "elevation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveElevationRequest {
+    return new ApproveElevationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveElevationRequest {
+    return new ApproveElevationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveElevationRequest {
+    return new ApproveElevationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveElevationRequest | PlainMessage<ApproveElevationRequest> | undefined, b: ApproveElevationRequest | PlainMessage<ApproveElevationRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveElevationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveElevationResponse
+ */
+export class ApproveElevationResponse extends Message<ApproveElevationResponse> {
+  /**
+   * @generated from field: libops.v1.Elevation elevation = 1;
+   */
+  elevation?: Elevation;
+
+  constructor(data?: PartialMessage<ApproveElevationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveElevationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevation", kind: "message", T: Elevation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveElevationResponse {
+    return new ApproveElevationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveElevationResponse {
+    return new ApproveElevationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveElevationResponse {
+    return new ApproveElevationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveElevationResponse | PlainMessage<ApproveElevationResponse> | undefined, b: ApproveElevationResponse | PlainMessage<ApproveElevationResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveElevationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RejectElevationRequest
+ */
+export class RejectElevationRequest extends Message<RejectElevationRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string elevation_id = 2;
+   */
+  elevationId = "";
+
+  constructor(data?: PartialMessage<RejectElevationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RejectElevationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "elevation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectElevationRequest {
+    return new RejectElevationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectElevationRequest {
+    return new RejectElevationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectElevationRequest {
+    return new RejectElevationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectElevationRequest | PlainMessage<RejectElevationRequest> | undefined, b: RejectElevationRequest | PlainMessage<RejectElevationRequest> | undefined): boolean {
+    return proto3.util.equals(RejectElevationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RejectElevationResponse
+ */
+export class RejectElevationResponse extends Message<RejectElevationResponse> {
+  /**
+   * @generated from field: libops.v1.Elevation elevation = 1;
+   */
+  elevation?: Elevation;
+
+  constructor(data?: PartialMessage<RejectElevationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RejectElevationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevation", kind: "message", T: Elevation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectElevationResponse {
+    return new RejectElevationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectElevationResponse {
+    return new RejectElevationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectElevationResponse {
+    return new RejectElevationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectElevationResponse | PlainMessage<RejectElevationResponse> | undefined, b: RejectElevationResponse | PlainMessage<RejectElevationResponse> | undefined): boolean {
+    return proto3.util.equals(RejectElevationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeElevationRequest
+ */
+export class RevokeElevationRequest extends Message<RevokeElevationRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string elevation_id = 2;
+   */
+  elevationId = "";
+
+  constructor(data?: PartialMessage<RevokeElevationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeElevationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "elevation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeElevationRequest {
+    return new RevokeElevationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeElevationRequest {
+    return new RevokeElevationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeElevationRequest {
+    return new RevokeElevationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeElevationRequest | PlainMessage<RevokeElevationRequest> | undefined, b: RevokeElevationRequest | PlainMessage<RevokeElevationRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeElevationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeElevationResponse
+ */
+export class RevokeElevationResponse extends Message<RevokeElevationResponse> {
+  /**
+   * @generated from field: libops.v1.Elevation elevation = 1;
+   */
+  elevation?: Elevation;
+
+  constructor(data?: PartialMessage<RevokeElevationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeElevationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "elevation", kind: "message", T: Elevation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeElevationResponse {
+    return new RevokeElevationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeElevationResponse {
+    return new RevokeElevationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeElevationResponse {
+    return new RevokeElevationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeElevationResponse | PlainMessage<RevokeElevationResponse> | undefined, b: RevokeElevationResponse | PlainMessage<RevokeElevationResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeElevationResponse, a, b);
+  }
+}
+
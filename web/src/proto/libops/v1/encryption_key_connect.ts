@@ -0,0 +1,80 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/encryption_key.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CheckEncryptionKeysRequest, CheckEncryptionKeysResponse, DeleteEncryptionKeyRequest, ListEncryptionKeysRequest, ListEncryptionKeysResponse, SetEncryptionKeyRequest, SetEncryptionKeyResponse } from "./encryption_key_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * EncryptionKeyService lets an organization encrypt its data with Cloud KMS
+ * keys it controls instead of Google-managed ones. Keys encrypt the
+ * organization's Terraform state bucket and its sites' Cloud SQL instances and
+ * backups. Google only lets a key encrypt data in the key's own location, so an
+ * organization has one key per location its data is in: its residency or
+ * location for the state bucket, and each project's region for Cloud SQL.
+ * Google's service agents for the organization's projects need
+ * roles/cloudkms.cryptoKeyEncrypterDecrypter on the keys, and the LibOps API
+ * needs roles/cloudkms.viewer to check they have it.
+ *
+ * @generated from service libops.v1.EncryptionKeyService
+ */
+export const EncryptionKeyService = {
+  typeName: "libops.v1.EncryptionKeyService",
+  methods: {
+    /**
+     * List the organization's keys, how each was last found, and the locations
+     * its data is in that have no key
+     *
+     * @generated from rpc libops.v1.EncryptionKeyService.ListEncryptionKeys
+     */
+    listEncryptionKeys: {
+      name: "ListEncryptionKeys",
+      I: ListEncryptionKeysRequest,
+      O: ListEncryptionKeysResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set the organization's key for the key's location, replacing the one it
+     * had there. The key is refused unless it's enabled and every service agent
+     * that encrypts data in the location can use it. It's applied by the next
+     * reconciliation, to the state bucket in place and to Cloud SQL instances
+     * created from then on.
+     *
+     * @generated from rpc libops.v1.EncryptionKeyService.SetEncryptionKey
+     */
+    setEncryptionKey: {
+      name: "SetEncryptionKey",
+      I: SetEncryptionKeyRequest,
+      O: SetEncryptionKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Check every key again, e.g. after granting a new project's service agent
+     *
+     * @generated from rpc libops.v1.EncryptionKeyService.CheckEncryptionKeys
+     */
+    checkEncryptionKeys: {
+      name: "CheckEncryptionKeys",
+      I: CheckEncryptionKeysRequest,
+      O: CheckEncryptionKeysResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Go back to Google-managed encryption in a location for whatever is
+     * created there from now on. Data already encrypted with the key still
+     * needs it.
+     *
+     * @generated from rpc libops.v1.EncryptionKeyService.DeleteEncryptionKey
+     */
+    deleteEncryptionKey: {
+      name: "DeleteEncryptionKey",
+      I: DeleteEncryptionKeyRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
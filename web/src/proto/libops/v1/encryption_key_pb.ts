@@ -0,0 +1,419 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/encryption_key.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.EncryptionKeyStatus
+ */
+export enum EncryptionKeyStatus {
+  /**
+   * @generated from enum value: ENCRYPTION_KEY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Nothing of the organization's is in the key's location yet
+   *
+   * @generated from enum value: ENCRYPTION_KEY_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * Enabled, and every service agent that needs it can use it
+   *
+   * @generated from enum value: ENCRYPTION_KEY_STATUS_HEALTHY = 2;
+   */
+  HEALTHY = 2,
+
+  /**
+   * See status_message
+   *
+   * @generated from enum value: ENCRYPTION_KEY_STATUS_UNHEALTHY = 3;
+   */
+  UNHEALTHY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EncryptionKeyStatus)
+proto3.util.setEnumType(EncryptionKeyStatus, "libops.v1.EncryptionKeyStatus", [
+  { no: 0, name: "ENCRYPTION_KEY_STATUS_UNSPECIFIED" },
+  { no: 1, name: "ENCRYPTION_KEY_STATUS_PENDING" },
+  { no: 2, name: "ENCRYPTION_KEY_STATUS_HEALTHY" },
+  { no: 3, name: "ENCRYPTION_KEY_STATUS_UNHEALTHY" },
+]);
+
+/**
+ * EncryptionKey is a Cloud KMS key an organization encrypts its data in one
+ * location with
+ *
+ * @generated from message libops.v1.EncryptionKey
+ */
+export class EncryptionKey extends Message<EncryptionKey> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * e.g. "us-central1" or "eu"
+   *
+   * @generated from field: string location = 2;
+   */
+  location = "";
+
+  /**
+   * projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{key}
+   *
+   * @generated from field: string kms_key_name = 3;
+   */
+  kmsKeyName = "";
+
+  /**
+   * @generated from field: libops.v1.EncryptionKeyStatus status = 4;
+   */
+  status = EncryptionKeyStatus.UNSPECIFIED;
+
+  /**
+   * Why the last check failed, empty when it didn't
+   *
+   * @generated from field: string status_message = 5;
+   */
+  statusMessage = "";
+
+  /**
+   * Accounts that need roles/cloudkms.cryptoKeyEncrypterDecrypter on the key
+   *
+   * @generated from field: repeated string service_agents = 6;
+   */
+  serviceAgents: string[] = [];
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 checked_at = 7;
+   */
+  checkedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 8;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<EncryptionKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.EncryptionKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kms_key_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(EncryptionKeyStatus) },
+    { no: 5, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "service_agents", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "checked_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EncryptionKey {
+    return new EncryptionKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EncryptionKey {
+    return new EncryptionKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EncryptionKey {
+    return new EncryptionKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EncryptionKey | PlainMessage<EncryptionKey> | undefined, b: EncryptionKey | PlainMessage<EncryptionKey> | undefined): boolean {
+    return proto3.util.equals(EncryptionKey, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListEncryptionKeysRequest
+ */
+export class ListEncryptionKeysRequest extends Message<ListEncryptionKeysRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<ListEncryptionKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListEncryptionKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEncryptionKeysRequest {
+    return new ListEncryptionKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEncryptionKeysRequest {
+    return new ListEncryptionKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEncryptionKeysRequest {
+    return new ListEncryptionKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListEncryptionKeysRequest | PlainMessage<ListEncryptionKeysRequest> | undefined, b: ListEncryptionKeysRequest | PlainMessage<ListEncryptionKeysRequest> | undefined): boolean {
+    return proto3.util.equals(ListEncryptionKeysRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListEncryptionKeysResponse
+ */
+export class ListEncryptionKeysResponse extends Message<ListEncryptionKeysResponse> {
+  /**
+   * @generated from field: repeated libops.v1.EncryptionKey keys = 1;
+   */
+  keys: EncryptionKey[] = [];
+
+  /**
+   * Locations the organization's data is in that use Google-managed encryption
+   *
+   * @generated from field: repeated string google_managed_locations = 2;
+   */
+  googleManagedLocations: string[] = [];
+
+  constructor(data?: PartialMessage<ListEncryptionKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListEncryptionKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keys", kind: "message", T: EncryptionKey, repeated: true },
+    { no: 2, name: "google_managed_locations", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEncryptionKeysResponse {
+    return new ListEncryptionKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEncryptionKeysResponse {
+    return new ListEncryptionKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEncryptionKeysResponse {
+    return new ListEncryptionKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListEncryptionKeysResponse | PlainMessage<ListEncryptionKeysResponse> | undefined, b: ListEncryptionKeysResponse | PlainMessage<ListEncryptionKeysResponse> | undefined): boolean {
+    return proto3.util.equals(ListEncryptionKeysResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetEncryptionKeyRequest
+ */
+export class SetEncryptionKeyRequest extends Message<SetEncryptionKeyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string kms_key_name = 2;
+   */
+  kmsKeyName = "";
+
+  constructor(data?: PartialMessage<SetEncryptionKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetEncryptionKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kms_key_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEncryptionKeyRequest {
+    return new SetEncryptionKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEncryptionKeyRequest {
+    return new SetEncryptionKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEncryptionKeyRequest {
+    return new SetEncryptionKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEncryptionKeyRequest | PlainMessage<SetEncryptionKeyRequest> | undefined, b: SetEncryptionKeyRequest | PlainMessage<SetEncryptionKeyRequest> | undefined): boolean {
+    return proto3.util.equals(SetEncryptionKeyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetEncryptionKeyResponse
+ */
+export class SetEncryptionKeyResponse extends Message<SetEncryptionKeyResponse> {
+  /**
+   * @generated from field: libops.v1.EncryptionKey key = 1;
+   */
+  key?: EncryptionKey;
+
+  constructor(data?: PartialMessage<SetEncryptionKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetEncryptionKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "message", T: EncryptionKey },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetEncryptionKeyResponse {
+    return new SetEncryptionKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetEncryptionKeyResponse {
+    return new SetEncryptionKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetEncryptionKeyResponse {
+    return new SetEncryptionKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetEncryptionKeyResponse | PlainMessage<SetEncryptionKeyResponse> | undefined, b: SetEncryptionKeyResponse | PlainMessage<SetEncryptionKeyResponse> | undefined): boolean {
+    return proto3.util.equals(SetEncryptionKeyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CheckEncryptionKeysRequest
+ */
+export class CheckEncryptionKeysRequest extends Message<CheckEncryptionKeysRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<CheckEncryptionKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CheckEncryptionKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEncryptionKeysRequest {
+    return new CheckEncryptionKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEncryptionKeysRequest {
+    return new CheckEncryptionKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEncryptionKeysRequest {
+    return new CheckEncryptionKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckEncryptionKeysRequest | PlainMessage<CheckEncryptionKeysRequest> | undefined, b: CheckEncryptionKeysRequest | PlainMessage<CheckEncryptionKeysRequest> | undefined): boolean {
+    return proto3.util.equals(CheckEncryptionKeysRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CheckEncryptionKeysResponse
+ */
+export class CheckEncryptionKeysResponse extends Message<CheckEncryptionKeysResponse> {
+  /**
+   * @generated from field: repeated libops.v1.EncryptionKey keys = 1;
+   */
+  keys: EncryptionKey[] = [];
+
+  constructor(data?: PartialMessage<CheckEncryptionKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CheckEncryptionKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keys", kind: "message", T: EncryptionKey, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEncryptionKeysResponse {
+    return new CheckEncryptionKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEncryptionKeysResponse {
+    return new CheckEncryptionKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEncryptionKeysResponse {
+    return new CheckEncryptionKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckEncryptionKeysResponse | PlainMessage<CheckEncryptionKeysResponse> | undefined, b: CheckEncryptionKeysResponse | PlainMessage<CheckEncryptionKeysResponse> | undefined): boolean {
+    return proto3.util.equals(CheckEncryptionKeysResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteEncryptionKeyRequest
+ */
+export class DeleteEncryptionKeyRequest extends Message<DeleteEncryptionKeyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string location = 2;
+   */
+  location = "";
+
+  constructor(data?: PartialMessage<DeleteEncryptionKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteEncryptionKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "location", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteEncryptionKeyRequest {
+    return new DeleteEncryptionKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteEncryptionKeyRequest {
+    return new DeleteEncryptionKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteEncryptionKeyRequest {
+    return new DeleteEncryptionKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteEncryptionKeyRequest | PlainMessage<DeleteEncryptionKeyRequest> | undefined, b: DeleteEncryptionKeyRequest | PlainMessage<DeleteEncryptionKeyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteEncryptionKeyRequest, a, b);
+  }
+}
+
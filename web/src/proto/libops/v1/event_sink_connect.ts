@@ -0,0 +1,79 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/event_sink.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateEventSinkRequest, CreateEventSinkResponse, DeleteEventSinkRequest, ListEventSinksRequest, ListEventSinksResponse, TestEventSinkRequest, TestEventSinkResponse, UpdateEventSinkRequest, UpdateEventSinkResponse } from "./event_sink_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * EventSinkService manages the customer-owned Pub/Sub topics and Cloud Storage
+ * buckets an organization's resource events are published to
+ *
+ * @generated from service libops.v1.EventSinkService
+ */
+export const EventSinkService = {
+  typeName: "libops.v1.EventSinkService",
+  methods: {
+    /**
+     * List an organization's event sinks
+     *
+     * @generated from rpc libops.v1.EventSinkService.ListEventSinks
+     */
+    listEventSinks: {
+      name: "ListEventSinks",
+      I: ListEventSinksRequest,
+      O: ListEventSinksResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Add an event sink to an organization.
+     * The response is the only time the sink's signing secret is returned.
+     *
+     * @generated from rpc libops.v1.EventSinkService.CreateEventSink
+     */
+    createEventSink: {
+      name: "CreateEventSink",
+      I: CreateEventSinkRequest,
+      O: CreateEventSinkResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Update an event sink's destination, event types or enabled state
+     *
+     * @generated from rpc libops.v1.EventSinkService.UpdateEventSink
+     */
+    updateEventSink: {
+      name: "UpdateEventSink",
+      I: UpdateEventSinkRequest,
+      O: UpdateEventSinkResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove an event sink from an organization
+     *
+     * @generated from rpc libops.v1.EventSinkService.DeleteEventSink
+     */
+    deleteEventSink: {
+      name: "DeleteEventSink",
+      I: DeleteEventSinkRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Queue a test event for an event sink.
+     * The event router publishes it and records the outcome on the sink.
+     *
+     * @generated from rpc libops.v1.EventSinkService.TestEventSink
+     */
+    testEventSink: {
+      name: "TestEventSink",
+      I: TestEventSinkRequest,
+      O: TestEventSinkResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,591 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/event_sink.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
+
+/**
+ * @generated from enum libops.v1.EventSinkKind
+ */
+export enum EventSinkKind {
+  /**
+   * @generated from enum value: EVENT_SINK_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Each event is a Pub/Sub message in the CloudEvents binary format
+   *
+   * @generated from enum value: EVENT_SINK_KIND_PUBSUB_TOPIC = 1;
+   */
+  PUBSUB_TOPIC = 1,
+
+  /**
+   * Each event is a CloudEvents JSON object under events/YYYY/MM/DD/
+   *
+   * @generated from enum value: EVENT_SINK_KIND_STORAGE_BUCKET = 2;
+   */
+  STORAGE_BUCKET = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EventSinkKind)
+proto3.util.setEnumType(EventSinkKind, "libops.v1.EventSinkKind", [
+  { no: 0, name: "EVENT_SINK_KIND_UNSPECIFIED" },
+  { no: 1, name: "EVENT_SINK_KIND_PUBSUB_TOPIC" },
+  { no: 2, name: "EVENT_SINK_KIND_STORAGE_BUCKET" },
+]);
+
+/**
+ * EventSink is a customer-owned destination for an organization's resource events.
+ * Messages carry a libops-signature attribute (or object metadata) of the form
+ * "t=<unix time>,v1=<hex HMAC-SHA256 of '<event id>.<unix time>.<payload>'>".
+ *
+ * @generated from message libops.v1.EventSink
+ */
+export class EventSink extends Message<EventSink> {
+  /**
+   * UUID
+   *
+   * @generated from field: string sink_id = 1;
+   */
+  sinkId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.EventSinkKind kind = 4;
+   */
+  kind = EventSinkKind.UNSPECIFIED;
+
+  /**
+   * projects/PROJECT/topics/TOPIC for PUBSUB_TOPIC, the bucket name for STORAGE_BUCKET
+   *
+   * @generated from field: string destination = 5;
+   */
+  destination = "";
+
+  /**
+   * Event type prefixes to publish, e.g. "io.libops.deployment."; empty publishes every event
+   *
+   * @generated from field: repeated string event_types = 6;
+   */
+  eventTypes: string[] = [];
+
+  /**
+   * @generated from field: bool enabled = 7;
+   */
+  enabled = false;
+
+  /**
+   * Service account events are published as. Grant it roles/pubsub.publisher on the
+   * topic or roles/storage.objectCreator on the bucket.
+   *
+   * @generated from field: string publisher_principal = 8;
+   */
+  publisherPrincipal = "";
+
+  /**
+   * Unix timestamp of the last delivery attempt, 0 if none
+   *
+   * @generated from field: int64 last_delivery_at = 9;
+   */
+  lastDeliveryAt = protoInt64.zero;
+
+  /**
+   * Error from the most recent delivery, empty when it succeeded
+   *
+   * @generated from field: string last_error = 10;
+   */
+  lastError = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 11;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<EventSink>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.EventSink";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sink_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "kind", kind: "enum", T: proto3.getEnumType(EventSinkKind) },
+    { no: 5, name: "destination", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "publisher_principal", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "last_delivery_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventSink {
+    return new EventSink().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventSink {
+    return new EventSink().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventSink {
+    return new EventSink().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EventSink | PlainMessage<EventSink> | undefined, b: EventSink | PlainMessage<EventSink> | undefined): boolean {
+    return proto3.util.equals(EventSink, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListEventSinksRequest
+ */
+export class ListEventSinksRequest extends Message<ListEventSinksRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListEventSinksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListEventSinksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEventSinksRequest {
+    return new ListEventSinksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEventSinksRequest {
+    return new ListEventSinksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEventSinksRequest {
+    return new ListEventSinksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListEventSinksRequest | PlainMessage<ListEventSinksRequest> | undefined, b: ListEventSinksRequest | PlainMessage<ListEventSinksRequest> | undefined): boolean {
+    return proto3.util.equals(ListEventSinksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListEventSinksResponse
+ */
+export class ListEventSinksResponse extends Message<ListEventSinksResponse> {
+  /**
+   * @generated from field: repeated libops.v1.EventSink sinks = 1;
+   */
+  sinks: EventSink[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListEventSinksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListEventSinksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sinks", kind: "message", T: EventSink, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEventSinksResponse {
+    return new ListEventSinksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEventSinksResponse {
+    return new ListEventSinksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEventSinksResponse {
+    return new ListEventSinksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListEventSinksResponse | PlainMessage<ListEventSinksResponse> | undefined, b: ListEventSinksResponse | PlainMessage<ListEventSinksResponse> | undefined): boolean {
+    return proto3.util.equals(ListEventSinksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateEventSinkRequest
+ */
+export class CreateEventSinkRequest extends Message<CreateEventSinkRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.EventSinkKind kind = 3;
+   */
+  kind = EventSinkKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string destination = 4;
+   */
+  destination = "";
+
+  /**
+   * @generated from field: repeated string event_types = 5;
+   */
+  eventTypes: string[] = [];
+
+  constructor(data?: PartialMessage<CreateEventSinkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateEventSinkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(EventSinkKind) },
+    { no: 4, name: "destination", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateEventSinkRequest {
+    return new CreateEventSinkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateEventSinkRequest {
+    return new CreateEventSinkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateEventSinkRequest {
+    return new CreateEventSinkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateEventSinkRequest | PlainMessage<CreateEventSinkRequest> | undefined, b: CreateEventSinkRequest | PlainMessage<CreateEventSinkRequest> | undefined): boolean {
+    return proto3.util.equals(CreateEventSinkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateEventSinkResponse
+ */
+export class CreateEventSinkResponse extends Message<CreateEventSinkResponse> {
+  /**
+   * @generated from field: libops.v1.EventSink sink = 1;
+   */
+  sink?: EventSink;
+
+  /**
+   * Key to verify the libops-signature of delivered events with; only returned here
+   *
+   * @generated from field: string signing_secret = 2;
+   */
+  signingSecret = "";
+
+  constructor(data?: PartialMessage<CreateEventSinkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateEventSinkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sink", kind: "message", T: EventSink },
+    { no: 2, name: "signing_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateEventSinkResponse {
+    return new CreateEventSinkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateEventSinkResponse {
+    return new CreateEventSinkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateEventSinkResponse {
+    return new CreateEventSinkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateEventSinkResponse | PlainMessage<CreateEventSinkResponse> | undefined, b: CreateEventSinkResponse | PlainMessage<CreateEventSinkResponse> | undefined): boolean {
+    return proto3.util.equals(CreateEventSinkResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateEventSinkRequest
+ */
+export class UpdateEventSinkRequest extends Message<UpdateEventSinkRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string sink_id = 2;
+   */
+  sinkId = "";
+
+  /**
+   * @generated from field: optional string name = 3;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string destination = 4;
+   */
+  destination?: string;
+
+  /**
+   * @generated from field: repeated string event_types = 5;
+   */
+  eventTypes: string[] = [];
+
+  /**
+   * @generated from field: optional bool enabled = 6;
+   */
+  enabled?: boolean;
+
+  /**
+   * Paths: name, destination, event_types, enabled.
+   * Without a mask every set field is applied, and event_types only when non-empty.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 7;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateEventSinkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateEventSinkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sink_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "destination", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "event_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 7, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEventSinkRequest {
+    return new UpdateEventSinkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEventSinkRequest {
+    return new UpdateEventSinkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEventSinkRequest {
+    return new UpdateEventSinkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateEventSinkRequest | PlainMessage<UpdateEventSinkRequest> | undefined, b: UpdateEventSinkRequest | PlainMessage<UpdateEventSinkRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateEventSinkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateEventSinkResponse
+ */
+export class UpdateEventSinkResponse extends Message<UpdateEventSinkResponse> {
+  /**
+   * @generated from field: libops.v1.EventSink sink = 1;
+   */
+  sink?: EventSink;
+
+  constructor(data?: PartialMessage<UpdateEventSinkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateEventSinkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sink", kind: "message", T: EventSink },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEventSinkResponse {
+    return new UpdateEventSinkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEventSinkResponse {
+    return new UpdateEventSinkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEventSinkResponse {
+    return new UpdateEventSinkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateEventSinkResponse | PlainMessage<UpdateEventSinkResponse> | undefined, b: UpdateEventSinkResponse | PlainMessage<UpdateEventSinkResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateEventSinkResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteEventSinkRequest
+ */
+export class DeleteEventSinkRequest extends Message<DeleteEventSinkRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string sink_id = 2;
+   */
+  sinkId = "";
+
+  constructor(data?: PartialMessage<DeleteEventSinkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteEventSinkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sink_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteEventSinkRequest {
+    return new DeleteEventSinkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteEventSinkRequest {
+    return new DeleteEventSinkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteEventSinkRequest {
+    return new DeleteEventSinkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteEventSinkRequest | PlainMessage<DeleteEventSinkRequest> | undefined, b: DeleteEventSinkRequest | PlainMessage<DeleteEventSinkRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteEventSinkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TestEventSinkRequest
+ */
+export class TestEventSinkRequest extends Message<TestEventSinkRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string sink_id = 2;
+   */
+  sinkId = "";
+
+  constructor(data?: PartialMessage<TestEventSinkRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TestEventSinkRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sink_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestEventSinkRequest {
+    return new TestEventSinkRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestEventSinkRequest {
+    return new TestEventSinkRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestEventSinkRequest {
+    return new TestEventSinkRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestEventSinkRequest | PlainMessage<TestEventSinkRequest> | undefined, b: TestEventSinkRequest | PlainMessage<TestEventSinkRequest> | undefined): boolean {
+    return proto3.util.equals(TestEventSinkRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TestEventSinkResponse
+ */
+export class TestEventSinkResponse extends Message<TestEventSinkResponse> {
+  /**
+   * @generated from field: libops.v1.EventSink sink = 1;
+   */
+  sink?: EventSink;
+
+  constructor(data?: PartialMessage<TestEventSinkResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TestEventSinkResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sink", kind: "message", T: EventSink },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestEventSinkResponse {
+    return new TestEventSinkResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestEventSinkResponse {
+    return new TestEventSinkResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestEventSinkResponse {
+    return new TestEventSinkResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestEventSinkResponse | PlainMessage<TestEventSinkResponse> | undefined, b: TestEventSinkResponse | PlainMessage<TestEventSinkResponse> | undefined): boolean {
+    return proto3.util.equals(TestEventSinkResponse, a, b);
+  }
+}
+
@@ -0,0 +1,635 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/events/events.proto (package libops.v1.events, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { Any } from "../../../google/protobuf/any_pb.js";
+
+/**
+ * SchemaVersion is the version of the Envelope layout. Consumers reject versions
+ * they don't know, so a breaking change ships as a new version both sides learn first.
+ *
+ * @generated from enum libops.v1.events.SchemaVersion
+ */
+export enum SchemaVersion {
+  /**
+   * @generated from enum value: SCHEMA_VERSION_UNSPECIFIED = 0;
+   */
+  SCHEMA_VERSION_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHEMA_VERSION_1 = 1;
+   */
+  SCHEMA_VERSION_1 = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SchemaVersion)
+proto3.util.setEnumType(SchemaVersion, "libops.v1.events.SchemaVersion", [
+  { no: 0, name: "SCHEMA_VERSION_UNSPECIFIED" },
+  { no: 1, name: "SCHEMA_VERSION_1" },
+]);
+
+/**
+ * EventType is the registry of events LibOps emits. Each value carries the
+ * CloudEvents type it is queued and published as, and the payload types its
+ * envelope's data may hold; an event whose type isn't registered here, or whose
+ * payload isn't registered for its type, is rejected by the event router.
+ *
+ * @generated from enum libops.v1.events.EventType
+ */
+export enum EventType {
+  /**
+   * @generated from enum value: EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ACCOUNT_CREATED = 1;
+   */
+  ACCOUNT_CREATED = 1,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ACCOUNT_UPDATED = 2;
+   */
+  ACCOUNT_UPDATED = 2,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ACCOUNT_DELETED = 3;
+   */
+  ACCOUNT_DELETED = 3,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_CREATED = 4;
+   */
+  ORGANIZATION_CREATED = 4,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_UPDATED = 5;
+   */
+  ORGANIZATION_UPDATED = 5,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_DELETED = 6;
+   */
+  ORGANIZATION_DELETED = 6,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_CREATED = 7;
+   */
+  PROJECT_CREATED = 7,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_UPDATED = 8;
+   */
+  PROJECT_UPDATED = 8,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_DELETED = 9;
+   */
+  PROJECT_DELETED = 9,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_CREATED = 10;
+   */
+  SITE_CREATED = 10,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_UPDATED = 11;
+   */
+  SITE_UPDATED = 11,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_DELETED = 12;
+   */
+  SITE_DELETED = 12,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SSH_KEY_CREATED = 13;
+   */
+  SSH_KEY_CREATED = 13,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SSH_KEY_DELETED = 14;
+   */
+  SSH_KEY_DELETED = 14,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_MEMBER_ADDED = 15;
+   */
+  ORGANIZATION_MEMBER_ADDED = 15,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED = 16;
+   */
+  ORGANIZATION_MEMBER_UPDATED = 16,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED = 17;
+   */
+  ORGANIZATION_MEMBER_REMOVED = 17,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED = 18;
+   */
+  ORGANIZATION_FIREWALL_RULE_ADDED = 18,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED = 19;
+   */
+  ORGANIZATION_FIREWALL_RULE_REMOVED = 19,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SECRET_CREATED = 20;
+   */
+  ORGANIZATION_SECRET_CREATED = 20,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SECRET_UPDATED = 21;
+   */
+  ORGANIZATION_SECRET_UPDATED = 21,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SECRET_DELETED = 22;
+   */
+  ORGANIZATION_SECRET_DELETED = 22,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SETTING_CREATED = 23;
+   */
+  ORGANIZATION_SETTING_CREATED = 23,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SETTING_UPDATED = 24;
+   */
+  ORGANIZATION_SETTING_UPDATED = 24,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SETTING_DELETED = 25;
+   */
+  ORGANIZATION_SETTING_DELETED = 25,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_ADDED = 26;
+   */
+  PROJECT_MEMBER_ADDED = 26,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_UPDATED = 27;
+   */
+  PROJECT_MEMBER_UPDATED = 27,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_MEMBER_REMOVED = 28;
+   */
+  PROJECT_MEMBER_REMOVED = 28,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED = 29;
+   */
+  PROJECT_FIREWALL_RULE_ADDED = 29,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED = 30;
+   */
+  PROJECT_FIREWALL_RULE_REMOVED = 30,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SECRET_CREATED = 31;
+   */
+  PROJECT_SECRET_CREATED = 31,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SECRET_UPDATED = 32;
+   */
+  PROJECT_SECRET_UPDATED = 32,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SECRET_DELETED = 33;
+   */
+  PROJECT_SECRET_DELETED = 33,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SETTING_CREATED = 34;
+   */
+  PROJECT_SETTING_CREATED = 34,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SETTING_UPDATED = 35;
+   */
+  PROJECT_SETTING_UPDATED = 35,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_PROJECT_SETTING_DELETED = 36;
+   */
+  PROJECT_SETTING_DELETED = 36,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_MEMBER_ADDED = 37;
+   */
+  SITE_MEMBER_ADDED = 37,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_MEMBER_UPDATED = 38;
+   */
+  SITE_MEMBER_UPDATED = 38,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_MEMBER_REMOVED = 39;
+   */
+  SITE_MEMBER_REMOVED = 39,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_FIREWALL_RULE_ADDED = 40;
+   */
+  SITE_FIREWALL_RULE_ADDED = 40,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED = 41;
+   */
+  SITE_FIREWALL_RULE_REMOVED = 41,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SECRET_CREATED = 42;
+   */
+  SITE_SECRET_CREATED = 42,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SECRET_UPDATED = 43;
+   */
+  SITE_SECRET_UPDATED = 43,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SECRET_DELETED = 44;
+   */
+  SITE_SECRET_DELETED = 44,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_REDIRECT_CREATED = 45;
+   */
+  SITE_REDIRECT_CREATED = 45,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_REDIRECT_UPDATED = 46;
+   */
+  SITE_REDIRECT_UPDATED = 46,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_REDIRECT_DELETED = 47;
+   */
+  SITE_REDIRECT_DELETED = 47,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED = 48;
+   */
+  SITE_ACCESS_PROTECTION_UPDATED = 48,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_WAF_UPDATED = 49;
+   */
+  SITE_WAF_UPDATED = 49,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED = 50;
+   */
+  SITE_RATE_LIMIT_RULE_ADDED = 50,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED = 51;
+   */
+  SITE_RATE_LIMIT_RULE_REMOVED = 51,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_TLS_POLICY_UPDATED = 52;
+   */
+  SITE_TLS_POLICY_UPDATED = 52,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ADDON_ATTACHED = 53;
+   */
+  SITE_ADDON_ATTACHED = 53,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ADDON_DETACHED = 54;
+   */
+  SITE_ADDON_DETACHED = 54,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SSH_ACCESS_GRANTED = 55;
+   */
+  SITE_SSH_ACCESS_GRANTED = 55,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SSH_ACCESS_UPDATED = 56;
+   */
+  SITE_SSH_ACCESS_UPDATED = 56,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SSH_ACCESS_REVOKED = 57;
+   */
+  SITE_SSH_ACCESS_REVOKED = 57,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ELEVATION_REQUESTED = 58;
+   */
+  SITE_ELEVATION_REQUESTED = 58,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ELEVATION_APPROVED = 59;
+   */
+  SITE_ELEVATION_APPROVED = 59,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ELEVATION_REJECTED = 60;
+   */
+  SITE_ELEVATION_REJECTED = 60,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ELEVATION_REVOKED = 61;
+   */
+  SITE_ELEVATION_REVOKED = 61,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_ELEVATION_EXPIRED = 62;
+   */
+  SITE_ELEVATION_EXPIRED = 62,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_CONFIG_VAR_SET = 63;
+   */
+  SITE_CONFIG_VAR_SET = 63,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_CONFIG_VAR_DELETED = 64;
+   */
+  SITE_CONFIG_VAR_DELETED = 64,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SETTING_CREATED = 65;
+   */
+  SITE_SETTING_CREATED = 65,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SETTING_UPDATED = 66;
+   */
+  SITE_SETTING_UPDATED = 66,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_SITE_SETTING_DELETED = 67;
+   */
+  SITE_SETTING_DELETED = 67,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_BILLING_PAYMENT_FAILED = 68;
+   */
+  BILLING_PAYMENT_FAILED = 68,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_BILLING_STATE_CHANGED = 69;
+   */
+  BILLING_STATE_CHANGED = 69,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_DEPLOYMENT_SUCCEEDED = 70;
+   */
+  DEPLOYMENT_SUCCEEDED = 70,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_DEPLOYMENT_FAILED = 71;
+   */
+  DEPLOYMENT_FAILED = 71,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RECONCILIATION_FAILED = 72;
+   */
+  RECONCILIATION_FAILED = 72,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_NOTIFICATION_CHANNEL_TEST = 73;
+   */
+  NOTIFICATION_CHANNEL_TEST = 73,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_EVENT_SINK_TEST = 74;
+   */
+  EVENT_SINK_TEST = 74,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_INCIDENT_OPENED = 75;
+   */
+  INCIDENT_OPENED = 75,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_INCIDENT_RESOLVED = 76;
+   */
+  INCIDENT_RESOLVED = 76,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RELATIONSHIP_CREATED = 77;
+   */
+  RELATIONSHIP_CREATED = 77,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RELATIONSHIP_APPROVED = 78;
+   */
+  RELATIONSHIP_APPROVED = 78,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RELATIONSHIP_REJECTED = 79;
+   */
+  RELATIONSHIP_REJECTED = 79,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RELATIONSHIP_UPDATED = 80;
+   */
+  RELATIONSHIP_UPDATED = 80,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_RELATIONSHIP_REVOKED = 81;
+   */
+  RELATIONSHIP_REVOKED = 81,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_POLICY_CREATED = 82;
+   */
+  ORGANIZATION_POLICY_CREATED = 82,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_POLICY_UPDATED = 83;
+   */
+  ORGANIZATION_POLICY_UPDATED = 83,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_POLICY_DELETED = 84;
+   */
+  ORGANIZATION_POLICY_DELETED = 84,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED = 85;
+   */
+  ORGANIZATION_POLICY_VIOLATED = 85,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY = 86;
+   */
+  ORGANIZATION_SECRET_ACCESS_ANOMALY = 86,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EventType)
+proto3.util.setEnumType(EventType, "libops.v1.events.EventType", [
+  { no: 0, name: "EVENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "EVENT_TYPE_ACCOUNT_CREATED" },
+  { no: 2, name: "EVENT_TYPE_ACCOUNT_UPDATED" },
+  { no: 3, name: "EVENT_TYPE_ACCOUNT_DELETED" },
+  { no: 4, name: "EVENT_TYPE_ORGANIZATION_CREATED" },
+  { no: 5, name: "EVENT_TYPE_ORGANIZATION_UPDATED" },
+  { no: 6, name: "EVENT_TYPE_ORGANIZATION_DELETED" },
+  { no: 7, name: "EVENT_TYPE_PROJECT_CREATED" },
+  { no: 8, name: "EVENT_TYPE_PROJECT_UPDATED" },
+  { no: 9, name: "EVENT_TYPE_PROJECT_DELETED" },
+  { no: 10, name: "EVENT_TYPE_SITE_CREATED" },
+  { no: 11, name: "EVENT_TYPE_SITE_UPDATED" },
+  { no: 12, name: "EVENT_TYPE_SITE_DELETED" },
+  { no: 13, name: "EVENT_TYPE_SSH_KEY_CREATED" },
+  { no: 14, name: "EVENT_TYPE_SSH_KEY_DELETED" },
+  { no: 15, name: "EVENT_TYPE_ORGANIZATION_MEMBER_ADDED" },
+  { no: 16, name: "EVENT_TYPE_ORGANIZATION_MEMBER_UPDATED" },
+  { no: 17, name: "EVENT_TYPE_ORGANIZATION_MEMBER_REMOVED" },
+  { no: 18, name: "EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_ADDED" },
+  { no: 19, name: "EVENT_TYPE_ORGANIZATION_FIREWALL_RULE_REMOVED" },
+  { no: 20, name: "EVENT_TYPE_ORGANIZATION_SECRET_CREATED" },
+  { no: 21, name: "EVENT_TYPE_ORGANIZATION_SECRET_UPDATED" },
+  { no: 22, name: "EVENT_TYPE_ORGANIZATION_SECRET_DELETED" },
+  { no: 23, name: "EVENT_TYPE_ORGANIZATION_SETTING_CREATED" },
+  { no: 24, name: "EVENT_TYPE_ORGANIZATION_SETTING_UPDATED" },
+  { no: 25, name: "EVENT_TYPE_ORGANIZATION_SETTING_DELETED" },
+  { no: 26, name: "EVENT_TYPE_PROJECT_MEMBER_ADDED" },
+  { no: 27, name: "EVENT_TYPE_PROJECT_MEMBER_UPDATED" },
+  { no: 28, name: "EVENT_TYPE_PROJECT_MEMBER_REMOVED" },
+  { no: 29, name: "EVENT_TYPE_PROJECT_FIREWALL_RULE_ADDED" },
+  { no: 30, name: "EVENT_TYPE_PROJECT_FIREWALL_RULE_REMOVED" },
+  { no: 31, name: "EVENT_TYPE_PROJECT_SECRET_CREATED" },
+  { no: 32, name: "EVENT_TYPE_PROJECT_SECRET_UPDATED" },
+  { no: 33, name: "EVENT_TYPE_PROJECT_SECRET_DELETED" },
+  { no: 34, name: "EVENT_TYPE_PROJECT_SETTING_CREATED" },
+  { no: 35, name: "EVENT_TYPE_PROJECT_SETTING_UPDATED" },
+  { no: 36, name: "EVENT_TYPE_PROJECT_SETTING_DELETED" },
+  { no: 37, name: "EVENT_TYPE_SITE_MEMBER_ADDED" },
+  { no: 38, name: "EVENT_TYPE_SITE_MEMBER_UPDATED" },
+  { no: 39, name: "EVENT_TYPE_SITE_MEMBER_REMOVED" },
+  { no: 40, name: "EVENT_TYPE_SITE_FIREWALL_RULE_ADDED" },
+  { no: 41, name: "EVENT_TYPE_SITE_FIREWALL_RULE_REMOVED" },
+  { no: 42, name: "EVENT_TYPE_SITE_SECRET_CREATED" },
+  { no: 43, name: "EVENT_TYPE_SITE_SECRET_UPDATED" },
+  { no: 44, name: "EVENT_TYPE_SITE_SECRET_DELETED" },
+  { no: 45, name: "EVENT_TYPE_SITE_REDIRECT_CREATED" },
+  { no: 46, name: "EVENT_TYPE_SITE_REDIRECT_UPDATED" },
+  { no: 47, name: "EVENT_TYPE_SITE_REDIRECT_DELETED" },
+  { no: 48, name: "EVENT_TYPE_SITE_ACCESS_PROTECTION_UPDATED" },
+  { no: 49, name: "EVENT_TYPE_SITE_WAF_UPDATED" },
+  { no: 50, name: "EVENT_TYPE_SITE_RATE_LIMIT_RULE_ADDED" },
+  { no: 51, name: "EVENT_TYPE_SITE_RATE_LIMIT_RULE_REMOVED" },
+  { no: 52, name: "EVENT_TYPE_SITE_TLS_POLICY_UPDATED" },
+  { no: 53, name: "EVENT_TYPE_SITE_ADDON_ATTACHED" },
+  { no: 54, name: "EVENT_TYPE_SITE_ADDON_DETACHED" },
+  { no: 55, name: "EVENT_TYPE_SITE_SSH_ACCESS_GRANTED" },
+  { no: 56, name: "EVENT_TYPE_SITE_SSH_ACCESS_UPDATED" },
+  { no: 57, name: "EVENT_TYPE_SITE_SSH_ACCESS_REVOKED" },
+  { no: 58, name: "EVENT_TYPE_SITE_ELEVATION_REQUESTED" },
+  { no: 59, name: "EVENT_TYPE_SITE_ELEVATION_APPROVED" },
+  { no: 60, name: "EVENT_TYPE_SITE_ELEVATION_REJECTED" },
+  { no: 61, name: "EVENT_TYPE_SITE_ELEVATION_REVOKED" },
+  { no: 62, name: "EVENT_TYPE_SITE_ELEVATION_EXPIRED" },
+  { no: 63, name: "EVENT_TYPE_SITE_CONFIG_VAR_SET" },
+  { no: 64, name: "EVENT_TYPE_SITE_CONFIG_VAR_DELETED" },
+  { no: 65, name: "EVENT_TYPE_SITE_SETTING_CREATED" },
+  { no: 66, name: "EVENT_TYPE_SITE_SETTING_UPDATED" },
+  { no: 67, name: "EVENT_TYPE_SITE_SETTING_DELETED" },
+  { no: 68, name: "EVENT_TYPE_BILLING_PAYMENT_FAILED" },
+  { no: 69, name: "EVENT_TYPE_BILLING_STATE_CHANGED" },
+  { no: 70, name: "EVENT_TYPE_DEPLOYMENT_SUCCEEDED" },
+  { no: 71, name: "EVENT_TYPE_DEPLOYMENT_FAILED" },
+  { no: 72, name: "EVENT_TYPE_RECONCILIATION_FAILED" },
+  { no: 73, name: "EVENT_TYPE_NOTIFICATION_CHANNEL_TEST" },
+  { no: 74, name: "EVENT_TYPE_EVENT_SINK_TEST" },
+  { no: 75, name: "EVENT_TYPE_INCIDENT_OPENED" },
+  { no: 76, name: "EVENT_TYPE_INCIDENT_RESOLVED" },
+  { no: 77, name: "EVENT_TYPE_RELATIONSHIP_CREATED" },
+  { no: 78, name: "EVENT_TYPE_RELATIONSHIP_APPROVED" },
+  { no: 79, name: "EVENT_TYPE_RELATIONSHIP_REJECTED" },
+  { no: 80, name: "EVENT_TYPE_RELATIONSHIP_UPDATED" },
+  { no: 81, name: "EVENT_TYPE_RELATIONSHIP_REVOKED" },
+  { no: 82, name: "EVENT_TYPE_ORGANIZATION_POLICY_CREATED" },
+  { no: 83, name: "EVENT_TYPE_ORGANIZATION_POLICY_UPDATED" },
+  { no: 84, name: "EVENT_TYPE_ORGANIZATION_POLICY_DELETED" },
+  { no: 85, name: "EVENT_TYPE_ORGANIZATION_POLICY_VIOLATED" },
+  { no: 86, name: "EVENT_TYPE_ORGANIZATION_SECRET_ACCESS_ANOMALY" },
+]);
+
+/**
+ * Envelope is the data of every event in event_queue and published to event sinks,
+ * with content type "application/protobuf; proto=libops.v1.events.Envelope".
+ * The subject and the organization, project and site an event is scoped to travel
+ * beside it as event_queue columns and CloudEvents attributes.
+ *
+ * @generated from message libops.v1.events.Envelope
+ */
+export class Envelope extends Message<Envelope> {
+  /**
+   * @generated from field: libops.v1.events.SchemaVersion schema_version = 1;
+   */
+  schemaVersion = SchemaVersion.SCHEMA_VERSION_UNSPECIFIED;
+
+  /**
+   * Must match the event's CloudEvents type
+   *
+   * @generated from field: libops.v1.events.EventType type = 2;
+   */
+  type = EventType.UNSPECIFIED;
+
+  /**
+   * The typed payload, one of the payload types registered for the event type, e.g. a
+   * libops.v1.Elevation; absent for events that only carry a subject
+   *
+   * @generated from field: google.protobuf.Any data = 3;
+   */
+  data?: Any;
+
+  /**
+   * Set by the "Libops-Urgent: true" request header: the change reaches sites at once,
+   * even outside their maintenance windows
+   *
+   * @generated from field: bool urgent = 4;
+   */
+  urgent = false;
+
+  constructor(data?: PartialMessage<Envelope>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.events.Envelope";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema_version", kind: "enum", T: proto3.getEnumType(SchemaVersion) },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(EventType) },
+    { no: 3, name: "data", kind: "message", T: Any },
+    { no: 4, name: "urgent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Envelope {
+    return new Envelope().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Envelope {
+    return new Envelope().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Envelope {
+    return new Envelope().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Envelope | PlainMessage<Envelope> | undefined, b: Envelope | PlainMessage<Envelope> | undefined): boolean {
+    return proto3.util.equals(Envelope, a, b);
+  }
+}
+
@@ -0,0 +1,58 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/export.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ExportOrganizationRequest, ExportOrganizationResponse, GetOrganizationExportRequest, GetOrganizationExportResponse, ListOrganizationExportsRequest, ListOrganizationExportsResponse } from "./export_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * ExportService packages an organization's site configurations, firewall rules,
+ * members, domains, latest backups and optionally its secrets into a bundle that
+ * can be downloaded, so customers can leave the platform cleanly.
+ * Bundles hold member emails and may hold secrets, so only owners can export.
+ *
+ * @generated from service libops.v1.ExportService
+ */
+export const ExportService = {
+  typeName: "libops.v1.ExportService",
+  methods: {
+    /**
+     * Start building an export bundle. The export is built in the background;
+     * poll GetOrganizationExport until it succeeds.
+     *
+     * @generated from rpc libops.v1.ExportService.ExportOrganization
+     */
+    exportOrganization: {
+      name: "ExportOrganization",
+      I: ExportOrganizationRequest,
+      O: ExportOrganizationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get an export, with a signed download URL once it succeeded
+     *
+     * @generated from rpc libops.v1.ExportService.GetOrganizationExport
+     */
+    getOrganizationExport: {
+      name: "GetOrganizationExport",
+      I: GetOrganizationExportRequest,
+      O: GetOrganizationExportResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * List an organization's exports, newest first
+     *
+     * @generated from rpc libops.v1.ExportService.ListOrganizationExports
+     */
+    listOrganizationExports: {
+      name: "ListOrganizationExports",
+      I: ListOrganizationExportsRequest,
+      O: ListOrganizationExportsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
@@ -0,0 +1,425 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/export.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.ExportStatus
+ */
+export enum ExportStatus {
+  /**
+   * @generated from enum value: EXPORT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting to be built
+   *
+   * @generated from enum value: EXPORT_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * Being built
+   *
+   * @generated from enum value: EXPORT_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * Ready to download until expires_at
+   *
+   * @generated from enum value: EXPORT_STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * See error
+   *
+   * @generated from enum value: EXPORT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ExportStatus)
+proto3.util.setEnumType(ExportStatus, "libops.v1.ExportStatus", [
+  { no: 0, name: "EXPORT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "EXPORT_STATUS_PENDING" },
+  { no: 2, name: "EXPORT_STATUS_RUNNING" },
+  { no: 3, name: "EXPORT_STATUS_SUCCEEDED" },
+  { no: 4, name: "EXPORT_STATUS_FAILED" },
+]);
+
+/**
+ * @generated from message libops.v1.OrganizationExport
+ */
+export class OrganizationExport extends Message<OrganizationExport> {
+  /**
+   * UUID
+   *
+   * @generated from field: string export_id = 1;
+   */
+  exportId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.ExportStatus status = 3;
+   */
+  status = ExportStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: bool include_secrets = 4;
+   */
+  includeSecrets = false;
+
+  /**
+   * Why the export failed
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  /**
+   * Size of the bundle
+   *
+   * @generated from field: int64 size_bytes = 6;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * Hex SHA-256 of the bundle, to check the download
+   *
+   * @generated from field: string sha256 = 7;
+   */
+  sha256 = "";
+
+  /**
+   * Signed URL of the .tar.gz bundle, valid for an hour. Only set by GetOrganizationExport
+   * on a succeeded export; get the export again for a fresh URL.
+   *
+   * @generated from field: string download_url = 8;
+   */
+  downloadUrl = "";
+
+  /**
+   * Unix timestamp after which the bundle is deleted
+   *
+   * @generated from field: int64 expires_at = 9;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 10;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until the export succeeds or fails
+   *
+   * @generated from field: int64 completed_at = 11;
+   */
+  completedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<OrganizationExport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.OrganizationExport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "export_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(ExportStatus) },
+    { no: 4, name: "include_secrets", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "download_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationExport {
+    return new OrganizationExport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationExport {
+    return new OrganizationExport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationExport {
+    return new OrganizationExport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationExport | PlainMessage<OrganizationExport> | undefined, b: OrganizationExport | PlainMessage<OrganizationExport> | undefined): boolean {
+    return proto3.util.equals(OrganizationExport, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExportOrganizationRequest
+ */
+export class ExportOrganizationRequest extends Message<ExportOrganizationRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Read secret values from the organization's Vault into the bundle. Without it
+   * the bundle lists secret names only.
+   *
+   * @generated from field: bool include_secrets = 2;
+   */
+  includeSecrets = false;
+
+  constructor(data?: PartialMessage<ExportOrganizationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExportOrganizationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_secrets", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportOrganizationRequest {
+    return new ExportOrganizationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportOrganizationRequest {
+    return new ExportOrganizationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportOrganizationRequest {
+    return new ExportOrganizationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportOrganizationRequest | PlainMessage<ExportOrganizationRequest> | undefined, b: ExportOrganizationRequest | PlainMessage<ExportOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(ExportOrganizationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExportOrganizationResponse
+ */
+export class ExportOrganizationResponse extends Message<ExportOrganizationResponse> {
+  /**
+   * @generated from field: libops.v1.OrganizationExport export = 1;
+   */
+  export?: OrganizationExport;
+
+  constructor(data?: PartialMessage<ExportOrganizationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExportOrganizationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "export", kind: "message", T: OrganizationExport },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportOrganizationResponse {
+    return new ExportOrganizationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportOrganizationResponse {
+    return new ExportOrganizationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportOrganizationResponse {
+    return new ExportOrganizationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportOrganizationResponse | PlainMessage<ExportOrganizationResponse> | undefined, b: ExportOrganizationResponse | PlainMessage<ExportOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(ExportOrganizationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationExportRequest
+ */
+export class GetOrganizationExportRequest extends Message<GetOrganizationExportRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string export_id = 2;
+   */
+  exportId = "";
+
+  constructor(data?: PartialMessage<GetOrganizationExportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationExportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "export_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationExportRequest {
+    return new GetOrganizationExportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationExportRequest {
+    return new GetOrganizationExportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationExportRequest {
+    return new GetOrganizationExportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationExportRequest | PlainMessage<GetOrganizationExportRequest> | undefined, b: GetOrganizationExportRequest | PlainMessage<GetOrganizationExportRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationExportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationExportResponse
+ */
+export class GetOrganizationExportResponse extends Message<GetOrganizationExportResponse> {
+  /**
+   * @generated from field: libops.v1.OrganizationExport export = 1;
+   */
+  export?: OrganizationExport;
+
+  constructor(data?: PartialMessage<GetOrganizationExportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationExportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "export", kind: "message", T: OrganizationExport },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationExportResponse {
+    return new GetOrganizationExportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationExportResponse {
+    return new GetOrganizationExportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationExportResponse {
+    return new GetOrganizationExportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationExportResponse | PlainMessage<GetOrganizationExportResponse> | undefined, b: GetOrganizationExportResponse | PlainMessage<GetOrganizationExportResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationExportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationExportsRequest
+ */
+export class ListOrganizationExportsRequest extends Message<ListOrganizationExportsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationExportsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationExportsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationExportsRequest {
+    return new ListOrganizationExportsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationExportsRequest {
+    return new ListOrganizationExportsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationExportsRequest {
+    return new ListOrganizationExportsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationExportsRequest | PlainMessage<ListOrganizationExportsRequest> | undefined, b: ListOrganizationExportsRequest | PlainMessage<ListOrganizationExportsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationExportsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationExportsResponse
+ */
+export class ListOrganizationExportsResponse extends Message<ListOrganizationExportsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.OrganizationExport exports = 1;
+   */
+  exports: OrganizationExport[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationExportsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationExportsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "exports", kind: "message", T: OrganizationExport, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationExportsResponse {
+    return new ListOrganizationExportsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationExportsResponse {
+    return new ListOrganizationExportsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationExportsResponse {
+    return new ListOrganizationExportsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationExportsResponse | PlainMessage<ListOrganizationExportsResponse> | undefined, b: ListOrganizationExportsResponse | PlainMessage<ListOrganizationExportsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationExportsResponse, a, b);
+  }
+}
+
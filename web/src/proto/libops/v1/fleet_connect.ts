@@ -0,0 +1,58 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/fleet.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ExportFleetSitesRequest, ExportFleetSitesResponse, ListFleetSitesRequest, ListFleetSitesResponse, ListOrganizationFleetSitesRequest } from "./fleet_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * FleetService gives an overview of site VMs: when each last checked in, the
+ * controller release it runs, reconciliations waiting on it, whether its OS
+ * is patched and how full its data disk is. Platform operators see every
+ * organization's VMs; organization owners get a read-only view of their own.
+ *
+ * @generated from service libops.v1.FleetService
+ */
+export const FleetService = {
+  typeName: "libops.v1.FleetService",
+  methods: {
+    /**
+     * List site VMs across the platform, stalest check-in first
+     *
+     * @generated from rpc libops.v1.FleetService.ListFleetSites
+     */
+    listFleetSites: {
+      name: "ListFleetSites",
+      I: ListFleetSitesRequest,
+      O: ListFleetSitesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Export the site VMs across the platform matching a filter as CSV
+     *
+     * @generated from rpc libops.v1.FleetService.ExportFleetSites
+     */
+    exportFleetSites: {
+      name: "ExportFleetSites",
+      I: ExportFleetSitesRequest,
+      O: ExportFleetSitesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * List an organization's site VMs, stalest check-in first
+     *
+     * @generated from rpc libops.v1.FleetService.ListOrganizationFleetSites
+     */
+    listOrganizationFleetSites: {
+      name: "ListOrganizationFleetSites",
+      I: ListOrganizationFleetSitesRequest,
+      O: ListFleetSitesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
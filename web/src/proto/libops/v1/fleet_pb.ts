@@ -0,0 +1,488 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/fleet.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * FleetSite is a site VM's health as its controller last reported it
+ *
+ * @generated from message libops.v1.FleetSite
+ */
+export class FleetSite extends Message<FleetSite> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string site_name = 2;
+   */
+  siteName = "";
+
+  /**
+   * Site status, e.g. "active" or "suspended"
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string project_id = 4;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 5;
+   */
+  projectName = "";
+
+  /**
+   * @generated from field: string organization_id = 6;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string organization_name = 7;
+   */
+  organizationName = "";
+
+  /**
+   * @generated from field: string external_ip = 8;
+   */
+  externalIp = "";
+
+  /**
+   * Unix timestamp, 0 if the VM never checked in
+   *
+   * @generated from field: int64 last_checkin_at = 9;
+   */
+  lastCheckinAt = protoInt64.zero;
+
+  /**
+   * Empty until a versioned controller checks in
+   *
+   * @generated from field: string controller_version = 10;
+   */
+  controllerVersion = "";
+
+  /**
+   * Runs pending, triggered or running for the site
+   *
+   * @generated from field: int64 pending_reconciliations = 11;
+   */
+  pendingReconciliations = protoInt64.zero;
+
+  /**
+   * The site's configured OS image
+   *
+   * @generated from field: string os_image = 12;
+   */
+  osImage = "";
+
+  /**
+   * The OS image the VM reported running
+   *
+   * @generated from field: string os_version = 13;
+   */
+  osVersion = "";
+
+  /**
+   * "current", "outdated" (the VM doesn't run the configured image),
+   * "reboot_required" or "unknown" (the controller hasn't reported it)
+   *
+   * @generated from field: string os_patch_status = 14;
+   */
+  osPatchStatus = "";
+
+  /**
+   * @generated from field: int64 disk_used_bytes = 15;
+   */
+  diskUsedBytes = protoInt64.zero;
+
+  /**
+   * 0 when the project's disk size isn't known
+   *
+   * @generated from field: int64 disk_size_bytes = 16;
+   */
+  diskSizeBytes = protoInt64.zero;
+
+  /**
+   * 0 when the disk size isn't known
+   *
+   * @generated from field: double disk_used_percent = 17;
+   */
+  diskUsedPercent = 0;
+
+  constructor(data?: PartialMessage<FleetSite>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.FleetSite";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "external_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "last_checkin_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "controller_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "pending_reconciliations", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "os_image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "os_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "os_patch_status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "disk_used_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "disk_size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 17, name: "disk_used_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FleetSite {
+    return new FleetSite().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FleetSite {
+    return new FleetSite().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FleetSite {
+    return new FleetSite().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FleetSite | PlainMessage<FleetSite> | undefined, b: FleetSite | PlainMessage<FleetSite> | undefined): boolean {
+    return proto3.util.equals(FleetSite, a, b);
+  }
+}
+
+/**
+ * FleetFilter narrows the fleet; unset fields match every VM
+ *
+ * @generated from message libops.v1.FleetFilter
+ */
+export class FleetFilter extends Message<FleetFilter> {
+  /**
+   * Only this organization's VMs; ignored for ListOrganizationFleetSites
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Site status
+   *
+   * @generated from field: optional string status = 2;
+   */
+  status?: string;
+
+  /**
+   * @generated from field: optional string controller_version = 3;
+   */
+  controllerVersion?: string;
+
+  /**
+   * Only VMs that haven't checked in for at least this many minutes
+   *
+   * @generated from field: int32 stale_minutes = 4;
+   */
+  staleMinutes = 0;
+
+  /**
+   * Only VMs whose data disk is at least this full, 1-100
+   *
+   * @generated from field: int32 min_disk_used_percent = 5;
+   */
+  minDiskUsedPercent = 0;
+
+  /**
+   * "current", "outdated", "reboot_required" or "unknown"
+   *
+   * @generated from field: optional string os_patch_status = 6;
+   */
+  osPatchStatus?: string;
+
+  /**
+   * Only VMs with reconciliations waiting
+   *
+   * @generated from field: bool pending_reconciliations = 7;
+   */
+  pendingReconciliations = false;
+
+  /**
+   * Comma-separated key=value pairs, e.g. "env=prod,department=library"
+   *
+   * @generated from field: optional string label_selector = 8;
+   */
+  labelSelector?: string;
+
+  constructor(data?: PartialMessage<FleetFilter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.FleetFilter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "controller_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "stale_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "min_disk_used_percent", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "os_patch_status", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "pending_reconciliations", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FleetFilter {
+    return new FleetFilter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FleetFilter {
+    return new FleetFilter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FleetFilter {
+    return new FleetFilter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FleetFilter | PlainMessage<FleetFilter> | undefined, b: FleetFilter | PlainMessage<FleetFilter> | undefined): boolean {
+    return proto3.util.equals(FleetFilter, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListFleetSitesRequest
+ */
+export class ListFleetSitesRequest extends Message<ListFleetSitesRequest> {
+  /**
+   * @generated from field: libops.v1.FleetFilter filter = 1;
+   */
+  filter?: FleetFilter;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListFleetSitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListFleetSitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: FleetFilter },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFleetSitesRequest {
+    return new ListFleetSitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFleetSitesRequest {
+    return new ListFleetSitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFleetSitesRequest {
+    return new ListFleetSitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFleetSitesRequest | PlainMessage<ListFleetSitesRequest> | undefined, b: ListFleetSitesRequest | PlainMessage<ListFleetSitesRequest> | undefined): boolean {
+    return proto3.util.equals(ListFleetSitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListFleetSitesResponse
+ */
+export class ListFleetSitesResponse extends Message<ListFleetSitesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.FleetSite sites = 1;
+   */
+  sites: FleetSite[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListFleetSitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListFleetSitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sites", kind: "message", T: FleetSite, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFleetSitesResponse {
+    return new ListFleetSitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFleetSitesResponse {
+    return new ListFleetSitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFleetSitesResponse {
+    return new ListFleetSitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFleetSitesResponse | PlainMessage<ListFleetSitesResponse> | undefined, b: ListFleetSitesResponse | PlainMessage<ListFleetSitesResponse> | undefined): boolean {
+    return proto3.util.equals(ListFleetSitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExportFleetSitesRequest
+ */
+export class ExportFleetSitesRequest extends Message<ExportFleetSitesRequest> {
+  /**
+   * @generated from field: libops.v1.FleetFilter filter = 1;
+   */
+  filter?: FleetFilter;
+
+  constructor(data?: PartialMessage<ExportFleetSitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExportFleetSitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: FleetFilter },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportFleetSitesRequest {
+    return new ExportFleetSitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportFleetSitesRequest {
+    return new ExportFleetSitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportFleetSitesRequest {
+    return new ExportFleetSitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportFleetSitesRequest | PlainMessage<ExportFleetSitesRequest> | undefined, b: ExportFleetSitesRequest | PlainMessage<ExportFleetSitesRequest> | undefined): boolean {
+    return proto3.util.equals(ExportFleetSitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ExportFleetSitesResponse
+ */
+export class ExportFleetSitesResponse extends Message<ExportFleetSitesResponse> {
+  /**
+   * e.g. "fleet-2026-01-31.csv"
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename = "";
+
+  /**
+   * One row per VM, with a header row
+   *
+   * @generated from field: bytes csv = 2;
+   */
+  csv = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ExportFleetSitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ExportFleetSitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "csv", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportFleetSitesResponse {
+    return new ExportFleetSitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportFleetSitesResponse {
+    return new ExportFleetSitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportFleetSitesResponse {
+    return new ExportFleetSitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportFleetSitesResponse | PlainMessage<ExportFleetSitesResponse> | undefined, b: ExportFleetSitesResponse | PlainMessage<ExportFleetSitesResponse> | undefined): boolean {
+    return proto3.util.equals(ExportFleetSitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationFleetSitesRequest
+ */
+export class ListOrganizationFleetSitesRequest extends Message<ListOrganizationFleetSitesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.FleetFilter filter = 2;
+   */
+  filter?: FleetFilter;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationFleetSitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationFleetSitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filter", kind: "message", T: FleetFilter },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationFleetSitesRequest {
+    return new ListOrganizationFleetSitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationFleetSitesRequest {
+    return new ListOrganizationFleetSitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationFleetSitesRequest {
+    return new ListOrganizationFleetSitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationFleetSitesRequest | PlainMessage<ListOrganizationFleetSitesRequest> | undefined, b: ListOrganizationFleetSitesRequest | PlainMessage<ListOrganizationFleetSitesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationFleetSitesRequest, a, b);
+  }
+}
+
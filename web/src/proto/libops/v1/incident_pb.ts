@@ -0,0 +1,156 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/incident.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.SiteIncidentCause
+ */
+export enum SiteIncidentCause {
+  /**
+   * @generated from enum value: SITE_INCIDENT_CAUSE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The site's VM controller stopped checking in
+   *
+   * @generated from enum value: SITE_INCIDENT_CAUSE_CHECKIN_MISSED = 1;
+   */
+  CHECKIN_MISSED = 1,
+
+  /**
+   * The site's health URL failed every external probe
+   *
+   * @generated from enum value: SITE_INCIDENT_CAUSE_PROBE_FAILED = 2;
+   */
+  PROBE_FAILED = 2,
+
+  /**
+   * The site's health score stayed below the threshold
+   *
+   * @generated from enum value: SITE_INCIDENT_CAUSE_HEALTH_DEGRADED = 3;
+   */
+  HEALTH_DEGRADED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SiteIncidentCause)
+proto3.util.setEnumType(SiteIncidentCause, "libops.v1.SiteIncidentCause", [
+  { no: 0, name: "SITE_INCIDENT_CAUSE_UNSPECIFIED" },
+  { no: 1, name: "SITE_INCIDENT_CAUSE_CHECKIN_MISSED" },
+  { no: 2, name: "SITE_INCIDENT_CAUSE_PROBE_FAILED" },
+  { no: 3, name: "SITE_INCIDENT_CAUSE_HEALTH_DEGRADED" },
+]);
+
+/**
+ * @generated from enum libops.v1.SiteIncidentStatus
+ */
+export enum SiteIncidentStatus {
+  /**
+   * @generated from enum value: SITE_INCIDENT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SITE_INCIDENT_STATUS_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: SITE_INCIDENT_STATUS_RESOLVED = 2;
+   */
+  RESOLVED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SiteIncidentStatus)
+proto3.util.setEnumType(SiteIncidentStatus, "libops.v1.SiteIncidentStatus", [
+  { no: 0, name: "SITE_INCIDENT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "SITE_INCIDENT_STATUS_OPEN" },
+  { no: 2, name: "SITE_INCIDENT_STATUS_RESOLVED" },
+]);
+
+/**
+ * SiteIncident is a period during which a site was considered down.
+ * It is the payload of the io.libops.incident.opened/resolved events.
+ *
+ * @generated from message libops.v1.SiteIncident
+ */
+export class SiteIncident extends Message<SiteIncident> {
+  /**
+   * UUID, also the PagerDuty dedup key and Opsgenie alias
+   *
+   * @generated from field: string incident_id = 1;
+   */
+  incidentId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string site_name = 3;
+   */
+  siteName = "";
+
+  /**
+   * @generated from field: libops.v1.SiteIncidentCause cause = 4;
+   */
+  cause = SiteIncidentCause.UNSPECIFIED;
+
+  /**
+   * @generated from field: libops.v1.SiteIncidentStatus status = 5;
+   */
+  status = SiteIncidentStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 opened_at = 6;
+   */
+  openedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 while open
+   *
+   * @generated from field: int64 resolved_at = 7;
+   */
+  resolvedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteIncident>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteIncident";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "incident_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "site_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "cause", kind: "enum", T: proto3.getEnumType(SiteIncidentCause) },
+    { no: 5, name: "status", kind: "enum", T: proto3.getEnumType(SiteIncidentStatus) },
+    { no: 6, name: "opened_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "resolved_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteIncident {
+    return new SiteIncident().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteIncident {
+    return new SiteIncident().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteIncident {
+    return new SiteIncident().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteIncident | PlainMessage<SiteIncident> | undefined, b: SiteIncident | PlainMessage<SiteIncident> | undefined): boolean {
+    return proto3.util.equals(SiteIncident, a, b);
+  }
+}
+
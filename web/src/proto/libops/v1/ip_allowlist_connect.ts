@@ -0,0 +1,47 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/ip_allowlist.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetIpAllowlistRequest, GetIpAllowlistResponse, UpdateIpAllowlistRequest, UpdateIpAllowlistResponse } from "./ip_allowlist_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * IpAllowlistService restricts an organization to a set of networks. Once an
+ * organization lists CIDRs, its members, and their API keys, can only reach it,
+ * its projects and its sites from those addresses, so stolen credentials can't
+ * be used from outside the campus network.
+ *
+ * @generated from service libops.v1.IpAllowlistService
+ */
+export const IpAllowlistService = {
+  typeName: "libops.v1.IpAllowlistService",
+  methods: {
+    /**
+     * Get the networks an organization can be reached from
+     *
+     * @generated from rpc libops.v1.IpAllowlistService.GetIpAllowlist
+     */
+    getIpAllowlist: {
+      name: "GetIpAllowlist",
+      I: GetIpAllowlistRequest,
+      O: GetIpAllowlistResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Replace the networks an organization can be reached from. An empty list
+     * allows any address. The list must include the caller's own address so
+     * they can't lock themselves out.
+     *
+     * @generated from rpc libops.v1.IpAllowlistService.UpdateIpAllowlist
+     */
+    updateIpAllowlist: {
+      name: "UpdateIpAllowlist",
+      I: UpdateIpAllowlistRequest,
+      O: UpdateIpAllowlistResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,219 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/ip_allowlist.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * IpAllowlist is the networks an organization can be reached from
+ *
+ * @generated from message libops.v1.IpAllowlist
+ */
+export class IpAllowlist extends Message<IpAllowlist> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * e.g. ["192.0.2.0/24", "2001:db8::/32"]; empty allows any address
+   *
+   * @generated from field: repeated string cidrs = 2;
+   */
+  cidrs: string[] = [];
+
+  /**
+   * The caller's address, as the API sees it
+   *
+   * @generated from field: string client_ip = 3;
+   */
+  clientIp = "";
+
+  constructor(data?: PartialMessage<IpAllowlist>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.IpAllowlist";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "client_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IpAllowlist {
+    return new IpAllowlist().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IpAllowlist {
+    return new IpAllowlist().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IpAllowlist {
+    return new IpAllowlist().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IpAllowlist | PlainMessage<IpAllowlist> | undefined, b: IpAllowlist | PlainMessage<IpAllowlist> | undefined): boolean {
+    return proto3.util.equals(IpAllowlist, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetIpAllowlistRequest
+ */
+export class GetIpAllowlistRequest extends Message<GetIpAllowlistRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<GetIpAllowlistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetIpAllowlistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIpAllowlistRequest {
+    return new GetIpAllowlistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIpAllowlistRequest | PlainMessage<GetIpAllowlistRequest> | undefined, b: GetIpAllowlistRequest | PlainMessage<GetIpAllowlistRequest> | undefined): boolean {
+    return proto3.util.equals(GetIpAllowlistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetIpAllowlistResponse
+ */
+export class GetIpAllowlistResponse extends Message<GetIpAllowlistResponse> {
+  /**
+   * @generated from field: libops.v1.IpAllowlist allowlist = 1;
+   */
+  allowlist?: IpAllowlist;
+
+  constructor(data?: PartialMessage<GetIpAllowlistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetIpAllowlistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "allowlist", kind: "message", T: IpAllowlist },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIpAllowlistResponse {
+    return new GetIpAllowlistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIpAllowlistResponse | PlainMessage<GetIpAllowlistResponse> | undefined, b: GetIpAllowlistResponse | PlainMessage<GetIpAllowlistResponse> | undefined): boolean {
+    return proto3.util.equals(GetIpAllowlistResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateIpAllowlistRequest
+ */
+export class UpdateIpAllowlistRequest extends Message<UpdateIpAllowlistRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * At most 50
+   *
+   * @generated from field: repeated string cidrs = 2;
+   */
+  cidrs: string[] = [];
+
+  constructor(data?: PartialMessage<UpdateIpAllowlistRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateIpAllowlistRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateIpAllowlistRequest {
+    return new UpdateIpAllowlistRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateIpAllowlistRequest {
+    return new UpdateIpAllowlistRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateIpAllowlistRequest {
+    return new UpdateIpAllowlistRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateIpAllowlistRequest | PlainMessage<UpdateIpAllowlistRequest> | undefined, b: UpdateIpAllowlistRequest | PlainMessage<UpdateIpAllowlistRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateIpAllowlistRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateIpAllowlistResponse
+ */
+export class UpdateIpAllowlistResponse extends Message<UpdateIpAllowlistResponse> {
+  /**
+   * @generated from field: libops.v1.IpAllowlist allowlist = 1;
+   */
+  allowlist?: IpAllowlist;
+
+  constructor(data?: PartialMessage<UpdateIpAllowlistResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateIpAllowlistResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "allowlist", kind: "message", T: IpAllowlist },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateIpAllowlistResponse {
+    return new UpdateIpAllowlistResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateIpAllowlistResponse {
+    return new UpdateIpAllowlistResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateIpAllowlistResponse {
+    return new UpdateIpAllowlistResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateIpAllowlistResponse | PlainMessage<UpdateIpAllowlistResponse> | undefined, b: UpdateIpAllowlistResponse | PlainMessage<UpdateIpAllowlistResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateIpAllowlistResponse, a, b);
+  }
+}
+
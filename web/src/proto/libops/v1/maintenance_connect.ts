@@ -0,0 +1,57 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/maintenance.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteSiteMaintenanceWindowRequest, DeleteSiteMaintenanceWindowResponse, GetSiteMaintenanceWindowRequest, GetSiteMaintenanceWindowResponse, UpdateSiteMaintenanceWindowRequest, UpdateSiteMaintenanceWindowResponse } from "./maintenance_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * MaintenanceWindowService manages when a site's VM may be changed. Secrets
+ * and firewall changes made outside the site's maintenance window reach the
+ * site when the window next opens; a change sent with the "Libops-Urgent: true"
+ * header is applied at once. Deployments and access changes are never held back.
+ *
+ * @generated from service libops.v1.MaintenanceWindowService
+ */
+export const MaintenanceWindowService = {
+  typeName: "libops.v1.MaintenanceWindowService",
+  methods: {
+    /**
+     * Get a site's maintenance window and the reconciliations waiting for it
+     *
+     * @generated from rpc libops.v1.MaintenanceWindowService.GetSiteMaintenanceWindow
+     */
+    getSiteMaintenanceWindow: {
+      name: "GetSiteMaintenanceWindow",
+      I: GetSiteMaintenanceWindowRequest,
+      O: GetSiteMaintenanceWindowResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set a site's maintenance window
+     *
+     * @generated from rpc libops.v1.MaintenanceWindowService.UpdateSiteMaintenanceWindow
+     */
+    updateSiteMaintenanceWindow: {
+      name: "UpdateSiteMaintenanceWindow",
+      I: UpdateSiteMaintenanceWindowRequest,
+      O: UpdateSiteMaintenanceWindowResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a site's maintenance window, so changes reach it at once again.
+     * Reconciliations waiting for the window run with the next poll
+     *
+     * @generated from rpc libops.v1.MaintenanceWindowService.DeleteSiteMaintenanceWindow
+     */
+    deleteSiteMaintenanceWindow: {
+      name: "DeleteSiteMaintenanceWindow",
+      I: DeleteSiteMaintenanceWindowRequest,
+      O: DeleteSiteMaintenanceWindowResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
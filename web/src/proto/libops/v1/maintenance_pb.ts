@@ -0,0 +1,399 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/maintenance.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * SiteMaintenanceWindow is when a site's secrets and firewall changes are
+ * applied. Windows don't span midnight; use two days' hours instead.
+ *
+ * @generated from message libops.v1.SiteMaintenanceWindow
+ */
+export class SiteMaintenanceWindow extends Message<SiteMaintenanceWindow> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * False while the site has no window and changes apply at once
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  /**
+   * Lowercase weekdays, e.g. "saturday"; empty means every day
+   *
+   * @generated from field: repeated string days = 3;
+   */
+  days: string[] = [];
+
+  /**
+   * First hour of the window, 0-23
+   *
+   * @generated from field: int32 start_hour = 4;
+   */
+  startHour = 0;
+
+  /**
+   * Hour the window closes, 1-24; 0 means 24
+   *
+   * @generated from field: int32 end_hour = 5;
+   */
+  endHour = 0;
+
+  /**
+   * IANA time zone, e.g. "Europe/London"; defaults to UTC
+   *
+   * @generated from field: string timezone = 6;
+   */
+  timezone = "";
+
+  /**
+   * Unix timestamp the window next opens; 0 while it's open or disabled
+   *
+   * @generated from field: int64 next_open_at = 7;
+   */
+  nextOpenAt = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated libops.v1.DeferredReconciliation deferred = 8;
+   */
+  deferred: DeferredReconciliation[] = [];
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 9;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteMaintenanceWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteMaintenanceWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "days", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "start_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "end_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "next_open_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "deferred", kind: "message", T: DeferredReconciliation, repeated: true },
+    { no: 9, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteMaintenanceWindow {
+    return new SiteMaintenanceWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteMaintenanceWindow {
+    return new SiteMaintenanceWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteMaintenanceWindow {
+    return new SiteMaintenanceWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteMaintenanceWindow | PlainMessage<SiteMaintenanceWindow> | undefined, b: SiteMaintenanceWindow | PlainMessage<SiteMaintenanceWindow> | undefined): boolean {
+    return proto3.util.equals(SiteMaintenanceWindow, a, b);
+  }
+}
+
+/**
+ * DeferredReconciliation is a kind of change waiting for the site's window
+ *
+ * @generated from message libops.v1.DeferredReconciliation
+ */
+export class DeferredReconciliation extends Message<DeferredReconciliation> {
+  /**
+   * "secrets" or "firewall"
+   *
+   * @generated from field: string request_type = 1;
+   */
+  requestType = "";
+
+  /**
+   * Unix timestamp of the first change held back
+   *
+   * @generated from field: int64 deferred_at = 2;
+   */
+  deferredAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DeferredReconciliation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeferredReconciliation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deferred_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeferredReconciliation {
+    return new DeferredReconciliation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeferredReconciliation {
+    return new DeferredReconciliation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeferredReconciliation {
+    return new DeferredReconciliation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeferredReconciliation | PlainMessage<DeferredReconciliation> | undefined, b: DeferredReconciliation | PlainMessage<DeferredReconciliation> | undefined): boolean {
+    return proto3.util.equals(DeferredReconciliation, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteMaintenanceWindowRequest
+ */
+export class GetSiteMaintenanceWindowRequest extends Message<GetSiteMaintenanceWindowRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteMaintenanceWindowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteMaintenanceWindowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteMaintenanceWindowRequest {
+    return new GetSiteMaintenanceWindowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteMaintenanceWindowRequest {
+    return new GetSiteMaintenanceWindowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteMaintenanceWindowRequest {
+    return new GetSiteMaintenanceWindowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteMaintenanceWindowRequest | PlainMessage<GetSiteMaintenanceWindowRequest> | undefined, b: GetSiteMaintenanceWindowRequest | PlainMessage<GetSiteMaintenanceWindowRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteMaintenanceWindowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteMaintenanceWindowResponse
+ */
+export class GetSiteMaintenanceWindowResponse extends Message<GetSiteMaintenanceWindowResponse> {
+  /**
+   * @generated from field: libops.v1.SiteMaintenanceWindow window = 1;
+   */
+  window?: SiteMaintenanceWindow;
+
+  constructor(data?: PartialMessage<GetSiteMaintenanceWindowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteMaintenanceWindowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "window", kind: "message", T: SiteMaintenanceWindow },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteMaintenanceWindowResponse {
+    return new GetSiteMaintenanceWindowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteMaintenanceWindowResponse {
+    return new GetSiteMaintenanceWindowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteMaintenanceWindowResponse {
+    return new GetSiteMaintenanceWindowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteMaintenanceWindowResponse | PlainMessage<GetSiteMaintenanceWindowResponse> | undefined, b: GetSiteMaintenanceWindowResponse | PlainMessage<GetSiteMaintenanceWindowResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteMaintenanceWindowResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteMaintenanceWindowRequest
+ */
+export class UpdateSiteMaintenanceWindowRequest extends Message<UpdateSiteMaintenanceWindowRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: repeated string days = 2;
+   */
+  days: string[] = [];
+
+  /**
+   * @generated from field: int32 start_hour = 3;
+   */
+  startHour = 0;
+
+  /**
+   * @generated from field: int32 end_hour = 4;
+   */
+  endHour = 0;
+
+  /**
+   * @generated from field: string timezone = 5;
+   */
+  timezone = "";
+
+  constructor(data?: PartialMessage<UpdateSiteMaintenanceWindowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteMaintenanceWindowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "days", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "start_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "end_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteMaintenanceWindowRequest {
+    return new UpdateSiteMaintenanceWindowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteMaintenanceWindowRequest {
+    return new UpdateSiteMaintenanceWindowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteMaintenanceWindowRequest {
+    return new UpdateSiteMaintenanceWindowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteMaintenanceWindowRequest | PlainMessage<UpdateSiteMaintenanceWindowRequest> | undefined, b: UpdateSiteMaintenanceWindowRequest | PlainMessage<UpdateSiteMaintenanceWindowRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteMaintenanceWindowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteMaintenanceWindowResponse
+ */
+export class UpdateSiteMaintenanceWindowResponse extends Message<UpdateSiteMaintenanceWindowResponse> {
+  /**
+   * @generated from field: libops.v1.SiteMaintenanceWindow window = 1;
+   */
+  window?: SiteMaintenanceWindow;
+
+  constructor(data?: PartialMessage<UpdateSiteMaintenanceWindowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteMaintenanceWindowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "window", kind: "message", T: SiteMaintenanceWindow },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteMaintenanceWindowResponse {
+    return new UpdateSiteMaintenanceWindowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteMaintenanceWindowResponse {
+    return new UpdateSiteMaintenanceWindowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteMaintenanceWindowResponse {
+    return new UpdateSiteMaintenanceWindowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteMaintenanceWindowResponse | PlainMessage<UpdateSiteMaintenanceWindowResponse> | undefined, b: UpdateSiteMaintenanceWindowResponse | PlainMessage<UpdateSiteMaintenanceWindowResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteMaintenanceWindowResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteMaintenanceWindowRequest
+ */
+export class DeleteSiteMaintenanceWindowRequest extends Message<DeleteSiteMaintenanceWindowRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<DeleteSiteMaintenanceWindowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteMaintenanceWindowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteMaintenanceWindowRequest {
+    return new DeleteSiteMaintenanceWindowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteMaintenanceWindowRequest {
+    return new DeleteSiteMaintenanceWindowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteMaintenanceWindowRequest {
+    return new DeleteSiteMaintenanceWindowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteMaintenanceWindowRequest | PlainMessage<DeleteSiteMaintenanceWindowRequest> | undefined, b: DeleteSiteMaintenanceWindowRequest | PlainMessage<DeleteSiteMaintenanceWindowRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteMaintenanceWindowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteMaintenanceWindowResponse
+ */
+export class DeleteSiteMaintenanceWindowResponse extends Message<DeleteSiteMaintenanceWindowResponse> {
+  constructor(data?: PartialMessage<DeleteSiteMaintenanceWindowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteMaintenanceWindowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteMaintenanceWindowResponse {
+    return new DeleteSiteMaintenanceWindowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteMaintenanceWindowResponse {
+    return new DeleteSiteMaintenanceWindowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteMaintenanceWindowResponse {
+    return new DeleteSiteMaintenanceWindowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteMaintenanceWindowResponse | PlainMessage<DeleteSiteMaintenanceWindowResponse> | undefined, b: DeleteSiteMaintenanceWindowResponse | PlainMessage<DeleteSiteMaintenanceWindowResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteMaintenanceWindowResponse, a, b);
+  }
+}
+
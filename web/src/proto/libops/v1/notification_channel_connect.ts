@@ -0,0 +1,78 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/notification_channel.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateNotificationChannelRequest, CreateNotificationChannelResponse, DeleteNotificationChannelRequest, ListNotificationChannelsRequest, ListNotificationChannelsResponse, TestNotificationChannelRequest, TestNotificationChannelResponse, UpdateNotificationChannelRequest, UpdateNotificationChannelResponse } from "./notification_channel_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * NotificationChannelService manages the Slack, Microsoft Teams, PagerDuty and
+ * Opsgenie channels that receive an organization's alerts
+ *
+ * @generated from service libops.v1.NotificationChannelService
+ */
+export const NotificationChannelService = {
+  typeName: "libops.v1.NotificationChannelService",
+  methods: {
+    /**
+     * List an organization's notification channels
+     *
+     * @generated from rpc libops.v1.NotificationChannelService.ListNotificationChannels
+     */
+    listNotificationChannels: {
+      name: "ListNotificationChannels",
+      I: ListNotificationChannelsRequest,
+      O: ListNotificationChannelsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Add a notification channel to an organization
+     *
+     * @generated from rpc libops.v1.NotificationChannelService.CreateNotificationChannel
+     */
+    createNotificationChannel: {
+      name: "CreateNotificationChannel",
+      I: CreateNotificationChannelRequest,
+      O: CreateNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Update a notification channel's destination, categories or enabled state
+     *
+     * @generated from rpc libops.v1.NotificationChannelService.UpdateNotificationChannel
+     */
+    updateNotificationChannel: {
+      name: "UpdateNotificationChannel",
+      I: UpdateNotificationChannelRequest,
+      O: UpdateNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a notification channel from an organization
+     *
+     * @generated from rpc libops.v1.NotificationChannelService.DeleteNotificationChannel
+     */
+    deleteNotificationChannel: {
+      name: "DeleteNotificationChannel",
+      I: DeleteNotificationChannelRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Queue a test alert for a notification channel.
+     * The event router delivers it and records the outcome on the channel.
+     *
+     * @generated from rpc libops.v1.NotificationChannelService.TestNotificationChannel
+     */
+    testNotificationChannel: {
+      name: "TestNotificationChannel",
+      I: TestNotificationChannelRequest,
+      O: TestNotificationChannelResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,778 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/notification_channel.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
+
+/**
+ * @generated from enum libops.v1.NotificationChannelKind
+ */
+export enum NotificationChannelKind {
+  /**
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Slack incoming webhook
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK = 1;
+   */
+  SLACK_WEBHOOK = 1,
+
+  /**
+   * Slack app bot token posting to a channel
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_SLACK_APP = 2;
+   */
+  SLACK_APP = 2,
+
+  /**
+   * Microsoft Teams incoming webhook
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK = 3;
+   */
+  TEAMS_WEBHOOK = 3,
+
+  /**
+   * PagerDuty Events API v2 integration, downtime only
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_PAGERDUTY = 4;
+   */
+  PAGERDUTY = 4,
+
+  /**
+   * Opsgenie API integration, downtime only
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_KIND_OPSGENIE = 5;
+   */
+  OPSGENIE = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(NotificationChannelKind)
+proto3.util.setEnumType(NotificationChannelKind, "libops.v1.NotificationChannelKind", [
+  { no: 0, name: "NOTIFICATION_CHANNEL_KIND_UNSPECIFIED" },
+  { no: 1, name: "NOTIFICATION_CHANNEL_KIND_SLACK_WEBHOOK" },
+  { no: 2, name: "NOTIFICATION_CHANNEL_KIND_SLACK_APP" },
+  { no: 3, name: "NOTIFICATION_CHANNEL_KIND_TEAMS_WEBHOOK" },
+  { no: 4, name: "NOTIFICATION_CHANNEL_KIND_PAGERDUTY" },
+  { no: 5, name: "NOTIFICATION_CHANNEL_KIND_OPSGENIE" },
+]);
+
+/**
+ * AlertCategory groups the events a notification channel can subscribe to
+ *
+ * @generated from enum libops.v1.AlertCategory
+ */
+export enum AlertCategory {
+  /**
+   * @generated from enum value: ALERT_CATEGORY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Site deployments finishing or failing
+   *
+   * @generated from enum value: ALERT_CATEGORY_DEPLOYMENTS = 1;
+   */
+  DEPLOYMENTS = 1,
+
+  /**
+   * Failed infrastructure reconciliations
+   *
+   * @generated from enum value: ALERT_CATEGORY_RECONCILIATION_FAILURES = 2;
+   */
+  RECONCILIATION_FAILURES = 2,
+
+  /**
+   * Failed payments and billing state changes
+   *
+   * @generated from enum value: ALERT_CATEGORY_BILLING = 3;
+   */
+  BILLING = 3,
+
+  /**
+   * Member, firewall and secret changes
+   *
+   * @generated from enum value: ALERT_CATEGORY_SECURITY = 4;
+   */
+  SECURITY = 4,
+
+  /**
+   * Sites going down and recovering; opens PagerDuty and Opsgenie incidents
+   *
+   * @generated from enum value: ALERT_CATEGORY_DOWNTIME = 5;
+   */
+  DOWNTIME = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AlertCategory)
+proto3.util.setEnumType(AlertCategory, "libops.v1.AlertCategory", [
+  { no: 0, name: "ALERT_CATEGORY_UNSPECIFIED" },
+  { no: 1, name: "ALERT_CATEGORY_DEPLOYMENTS" },
+  { no: 2, name: "ALERT_CATEGORY_RECONCILIATION_FAILURES" },
+  { no: 3, name: "ALERT_CATEGORY_BILLING" },
+  { no: 4, name: "ALERT_CATEGORY_SECURITY" },
+  { no: 5, name: "ALERT_CATEGORY_DOWNTIME" },
+]);
+
+/**
+ * @generated from message libops.v1.NotificationChannel
+ */
+export class NotificationChannel extends Message<NotificationChannel> {
+  /**
+   * UUID
+   *
+   * @generated from field: string channel_id = 1;
+   */
+  channelId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.NotificationChannelKind kind = 4;
+   */
+  kind = NotificationChannelKind.UNSPECIFIED;
+
+  /**
+   * Where alerts go with credentials redacted, e.g. "hooks.slack.com/…9f2c", "#ops" or "PagerDuty …1a2b"
+   *
+   * @generated from field: string destination = 5;
+   */
+  destination = "";
+
+  /**
+   * @generated from field: repeated libops.v1.AlertCategory categories = 6;
+   */
+  categories: AlertCategory[] = [];
+
+  /**
+   * @generated from field: bool enabled = 7;
+   */
+  enabled = false;
+
+  /**
+   * Unix timestamp of the last delivery attempt, 0 if none
+   *
+   * @generated from field: int64 last_delivery_at = 8;
+   */
+  lastDeliveryAt = protoInt64.zero;
+
+  /**
+   * Error from the most recent delivery, empty when it succeeded
+   *
+   * @generated from field: string last_error = 9;
+   */
+  lastError = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 10;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<NotificationChannel>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.NotificationChannel";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "kind", kind: "enum", T: proto3.getEnumType(NotificationChannelKind) },
+    { no: 5, name: "destination", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "categories", kind: "enum", T: proto3.getEnumType(AlertCategory), repeated: true },
+    { no: 7, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "last_delivery_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationChannel {
+    return new NotificationChannel().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationChannel | PlainMessage<NotificationChannel> | undefined, b: NotificationChannel | PlainMessage<NotificationChannel> | undefined): boolean {
+    return proto3.util.equals(NotificationChannel, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListNotificationChannelsRequest
+ */
+export class ListNotificationChannelsRequest extends Message<ListNotificationChannelsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListNotificationChannelsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListNotificationChannelsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationChannelsRequest {
+    return new ListNotificationChannelsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationChannelsRequest {
+    return new ListNotificationChannelsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationChannelsRequest {
+    return new ListNotificationChannelsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListNotificationChannelsRequest | PlainMessage<ListNotificationChannelsRequest> | undefined, b: ListNotificationChannelsRequest | PlainMessage<ListNotificationChannelsRequest> | undefined): boolean {
+    return proto3.util.equals(ListNotificationChannelsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListNotificationChannelsResponse
+ */
+export class ListNotificationChannelsResponse extends Message<ListNotificationChannelsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.NotificationChannel channels = 1;
+   */
+  channels: NotificationChannel[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListNotificationChannelsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListNotificationChannelsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channels", kind: "message", T: NotificationChannel, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationChannelsResponse {
+    return new ListNotificationChannelsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationChannelsResponse {
+    return new ListNotificationChannelsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationChannelsResponse {
+    return new ListNotificationChannelsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListNotificationChannelsResponse | PlainMessage<ListNotificationChannelsResponse> | undefined, b: ListNotificationChannelsResponse | PlainMessage<ListNotificationChannelsResponse> | undefined): boolean {
+    return proto3.util.equals(ListNotificationChannelsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateNotificationChannelRequest
+ */
+export class CreateNotificationChannelRequest extends Message<CreateNotificationChannelRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: libops.v1.NotificationChannelKind kind = 3;
+   */
+  kind = NotificationChannelKind.UNSPECIFIED;
+
+  /**
+   * Required for SLACK_WEBHOOK and TEAMS_WEBHOOK
+   *
+   * @generated from field: string webhook_url = 4;
+   */
+  webhookUrl = "";
+
+  /**
+   * Required for SLACK_APP
+   *
+   * @generated from field: string slack_bot_token = 5;
+   */
+  slackBotToken = "";
+
+  /**
+   * Channel ID or name, required for SLACK_APP
+   *
+   * @generated from field: string slack_channel = 6;
+   */
+  slackChannel = "";
+
+  /**
+   * @generated from field: repeated libops.v1.AlertCategory categories = 7;
+   */
+  categories: AlertCategory[] = [];
+
+  /**
+   * PagerDuty routing key or Opsgenie API key, required for PAGERDUTY and OPSGENIE
+   *
+   * @generated from field: string integration_key = 8;
+   */
+  integrationKey = "";
+
+  constructor(data?: PartialMessage<CreateNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(NotificationChannelKind) },
+    { no: 4, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "slack_bot_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "slack_channel", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "categories", kind: "enum", T: proto3.getEnumType(AlertCategory), repeated: true },
+    { no: 8, name: "integration_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationChannelRequest {
+    return new CreateNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationChannelRequest | PlainMessage<CreateNotificationChannelRequest> | undefined, b: CreateNotificationChannelRequest | PlainMessage<CreateNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateNotificationChannelResponse
+ */
+export class CreateNotificationChannelResponse extends Message<CreateNotificationChannelResponse> {
+  /**
+   * @generated from field: libops.v1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<CreateNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationChannelResponse {
+    return new CreateNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationChannelResponse | PlainMessage<CreateNotificationChannelResponse> | undefined, b: CreateNotificationChannelResponse | PlainMessage<CreateNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateNotificationChannelRequest
+ */
+export class UpdateNotificationChannelRequest extends Message<UpdateNotificationChannelRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId = "";
+
+  /**
+   * @generated from field: optional string name = 3;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string webhook_url = 4;
+   */
+  webhookUrl?: string;
+
+  /**
+   * @generated from field: optional string slack_bot_token = 5;
+   */
+  slackBotToken?: string;
+
+  /**
+   * @generated from field: optional string slack_channel = 6;
+   */
+  slackChannel?: string;
+
+  /**
+   * @generated from field: repeated libops.v1.AlertCategory categories = 7;
+   */
+  categories: AlertCategory[] = [];
+
+  /**
+   * @generated from field: optional bool enabled = 8;
+   */
+  enabled?: boolean;
+
+  /**
+   * Paths: name, webhook_url, slack_bot_token, slack_channel, integration_key, categories, enabled.
+   * Without a mask every set field is applied, and categories only when non-empty.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 9;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * @generated from field: optional string integration_key = 10;
+   */
+  integrationKey?: string;
+
+  constructor(data?: PartialMessage<UpdateNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "slack_bot_token", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "slack_channel", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "categories", kind: "enum", T: proto3.getEnumType(AlertCategory), repeated: true },
+    { no: 8, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 9, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 10, name: "integration_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationChannelRequest {
+    return new UpdateNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateNotificationChannelRequest | PlainMessage<UpdateNotificationChannelRequest> | undefined, b: UpdateNotificationChannelRequest | PlainMessage<UpdateNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateNotificationChannelResponse
+ */
+export class UpdateNotificationChannelResponse extends Message<UpdateNotificationChannelResponse> {
+  /**
+   * @generated from field: libops.v1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<UpdateNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationChannelResponse {
+    return new UpdateNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateNotificationChannelResponse | PlainMessage<UpdateNotificationChannelResponse> | undefined, b: UpdateNotificationChannelResponse | PlainMessage<UpdateNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteNotificationChannelRequest
+ */
+export class DeleteNotificationChannelRequest extends Message<DeleteNotificationChannelRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId = "";
+
+  constructor(data?: PartialMessage<DeleteNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteNotificationChannelRequest {
+    return new DeleteNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteNotificationChannelRequest | PlainMessage<DeleteNotificationChannelRequest> | undefined, b: DeleteNotificationChannelRequest | PlainMessage<DeleteNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TestNotificationChannelRequest
+ */
+export class TestNotificationChannelRequest extends Message<TestNotificationChannelRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId = "";
+
+  constructor(data?: PartialMessage<TestNotificationChannelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TestNotificationChannelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "channel_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestNotificationChannelRequest {
+    return new TestNotificationChannelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestNotificationChannelRequest {
+    return new TestNotificationChannelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestNotificationChannelRequest {
+    return new TestNotificationChannelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestNotificationChannelRequest | PlainMessage<TestNotificationChannelRequest> | undefined, b: TestNotificationChannelRequest | PlainMessage<TestNotificationChannelRequest> | undefined): boolean {
+    return proto3.util.equals(TestNotificationChannelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TestNotificationChannelResponse
+ */
+export class TestNotificationChannelResponse extends Message<TestNotificationChannelResponse> {
+  /**
+   * @generated from field: libops.v1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+
+  constructor(data?: PartialMessage<TestNotificationChannelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TestNotificationChannelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "channel", kind: "message", T: NotificationChannel },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestNotificationChannelResponse {
+    return new TestNotificationChannelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestNotificationChannelResponse {
+    return new TestNotificationChannelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestNotificationChannelResponse {
+    return new TestNotificationChannelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestNotificationChannelResponse | PlainMessage<TestNotificationChannelResponse> | undefined, b: TestNotificationChannelResponse | PlainMessage<TestNotificationChannelResponse> | undefined): boolean {
+    return proto3.util.equals(TestNotificationChannelResponse, a, b);
+  }
+}
+
+/**
+ * ReconciliationFinishedEvent is emitted when a reconciliation run completes or fails,
+ * so the event router can alert the organization's notification channels
+ *
+ * @generated from message libops.v1.ReconciliationFinishedEvent
+ */
+export class ReconciliationFinishedEvent extends Message<ReconciliationFinishedEvent> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * "terraform" or "vm_config"
+   *
+   * @generated from field: string run_type = 2;
+   */
+  runType = "";
+
+  /**
+   * "completed" or "failed"
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string error_message = 4;
+   */
+  errorMessage = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 5;
+   */
+  organizationId = "";
+
+  /**
+   * UUID, empty for organization runs
+   *
+   * @generated from field: string project_id = 6;
+   */
+  projectId = "";
+
+  /**
+   * UUID, empty for organization and project runs
+   *
+   * @generated from field: string site_id = 7;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ReconciliationFinishedEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconciliationFinishedEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconciliationFinishedEvent {
+    return new ReconciliationFinishedEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconciliationFinishedEvent {
+    return new ReconciliationFinishedEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconciliationFinishedEvent {
+    return new ReconciliationFinishedEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconciliationFinishedEvent | PlainMessage<ReconciliationFinishedEvent> | undefined, b: ReconciliationFinishedEvent | PlainMessage<ReconciliationFinishedEvent> | undefined): boolean {
+    return proto3.util.equals(ReconciliationFinishedEvent, a, b);
+  }
+}
+
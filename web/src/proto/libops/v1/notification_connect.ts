@@ -0,0 +1,54 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/notification.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetUnreadNotificationCountRequest, GetUnreadNotificationCountResponse, ListNotificationsRequest, ListNotificationsResponse, MarkNotificationsReadRequest, MarkNotificationsReadResponse } from "./notification_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * NotificationService manages the authenticated user's in-app notifications
+ *
+ * @generated from service libops.v1.NotificationService
+ */
+export const NotificationService = {
+  typeName: "libops.v1.NotificationService",
+  methods: {
+    /**
+     * List the authenticated user's notifications, newest first
+     *
+     * @generated from rpc libops.v1.NotificationService.ListNotifications
+     */
+    listNotifications: {
+      name: "ListNotifications",
+      I: ListNotificationsRequest,
+      O: ListNotificationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Mark some or all of the authenticated user's notifications as read
+     *
+     * @generated from rpc libops.v1.NotificationService.MarkNotificationsRead
+     */
+    markNotificationsRead: {
+      name: "MarkNotificationsRead",
+      I: MarkNotificationsReadRequest,
+      O: MarkNotificationsReadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Count the authenticated user's unread notifications
+     *
+     * @generated from rpc libops.v1.NotificationService.GetUnreadNotificationCount
+     */
+    getUnreadNotificationCount: {
+      name: "GetUnreadNotificationCount",
+      I: GetUnreadNotificationCountRequest,
+      O: GetUnreadNotificationCountResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
@@ -0,0 +1,337 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/notification.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message libops.v1.Notification
+ */
+export class Notification extends Message<Notification> {
+  /**
+   * @generated from field: string notification_id = 1;
+   */
+  notificationId = "";
+
+  /**
+   * Notification type: "deploy_finished", "member_added", "certificate_expiring" or "ownership_transfer"
+   *
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  /**
+   * @generated from field: string body = 4;
+   */
+  body = "";
+
+  /**
+   * Dashboard path the notification links to (e.g., "/sites/{site_id}")
+   *
+   * @generated from field: string link = 5;
+   */
+  link = "";
+
+  /**
+   * @generated from field: bool read = 6;
+   */
+  read = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 7;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Notification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Notification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "notification_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "link", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "read", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Notification {
+    return new Notification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Notification {
+    return new Notification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Notification {
+    return new Notification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Notification | PlainMessage<Notification> | undefined, b: Notification | PlainMessage<Notification> | undefined): boolean {
+    return proto3.util.equals(Notification, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListNotificationsRequest
+ */
+export class ListNotificationsRequest extends Message<ListNotificationsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken = "";
+
+  /**
+   * Only list notifications that have not been read
+   *
+   * @generated from field: bool unread_only = 3;
+   */
+  unreadOnly = false;
+
+  constructor(data?: PartialMessage<ListNotificationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListNotificationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unread_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationsRequest {
+    return new ListNotificationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationsRequest {
+    return new ListNotificationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationsRequest {
+    return new ListNotificationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListNotificationsRequest | PlainMessage<ListNotificationsRequest> | undefined, b: ListNotificationsRequest | PlainMessage<ListNotificationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListNotificationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListNotificationsResponse
+ */
+export class ListNotificationsResponse extends Message<ListNotificationsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Notification notifications = 1;
+   */
+  notifications: Notification[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  /**
+   * @generated from field: int64 unread_count = 3;
+   */
+  unreadCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ListNotificationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListNotificationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "notifications", kind: "message", T: Notification, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationsResponse {
+    return new ListNotificationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationsResponse {
+    return new ListNotificationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationsResponse {
+    return new ListNotificationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListNotificationsResponse | PlainMessage<ListNotificationsResponse> | undefined, b: ListNotificationsResponse | PlainMessage<ListNotificationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListNotificationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MarkNotificationsReadRequest
+ */
+export class MarkNotificationsReadRequest extends Message<MarkNotificationsReadRequest> {
+  /**
+   * @generated from field: repeated string notification_ids = 1;
+   */
+  notificationIds: string[] = [];
+
+  /**
+   * Mark every unread notification as read; notification_ids is ignored
+   *
+   * @generated from field: bool all = 2;
+   */
+  all = false;
+
+  constructor(data?: PartialMessage<MarkNotificationsReadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MarkNotificationsReadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "notification_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "all", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkNotificationsReadRequest {
+    return new MarkNotificationsReadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkNotificationsReadRequest {
+    return new MarkNotificationsReadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkNotificationsReadRequest {
+    return new MarkNotificationsReadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkNotificationsReadRequest | PlainMessage<MarkNotificationsReadRequest> | undefined, b: MarkNotificationsReadRequest | PlainMessage<MarkNotificationsReadRequest> | undefined): boolean {
+    return proto3.util.equals(MarkNotificationsReadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MarkNotificationsReadResponse
+ */
+export class MarkNotificationsReadResponse extends Message<MarkNotificationsReadResponse> {
+  /**
+   * @generated from field: int64 unread_count = 1;
+   */
+  unreadCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MarkNotificationsReadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MarkNotificationsReadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkNotificationsReadResponse {
+    return new MarkNotificationsReadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkNotificationsReadResponse {
+    return new MarkNotificationsReadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkNotificationsReadResponse {
+    return new MarkNotificationsReadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MarkNotificationsReadResponse | PlainMessage<MarkNotificationsReadResponse> | undefined, b: MarkNotificationsReadResponse | PlainMessage<MarkNotificationsReadResponse> | undefined): boolean {
+    return proto3.util.equals(MarkNotificationsReadResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetUnreadNotificationCountRequest
+ */
+export class GetUnreadNotificationCountRequest extends Message<GetUnreadNotificationCountRequest> {
+  constructor(data?: PartialMessage<GetUnreadNotificationCountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetUnreadNotificationCountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUnreadNotificationCountRequest {
+    return new GetUnreadNotificationCountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUnreadNotificationCountRequest {
+    return new GetUnreadNotificationCountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUnreadNotificationCountRequest {
+    return new GetUnreadNotificationCountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUnreadNotificationCountRequest | PlainMessage<GetUnreadNotificationCountRequest> | undefined, b: GetUnreadNotificationCountRequest | PlainMessage<GetUnreadNotificationCountRequest> | undefined): boolean {
+    return proto3.util.equals(GetUnreadNotificationCountRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetUnreadNotificationCountResponse
+ */
+export class GetUnreadNotificationCountResponse extends Message<GetUnreadNotificationCountResponse> {
+  /**
+   * @generated from field: int64 unread_count = 1;
+   */
+  unreadCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetUnreadNotificationCountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetUnreadNotificationCountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUnreadNotificationCountResponse {
+    return new GetUnreadNotificationCountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUnreadNotificationCountResponse {
+    return new GetUnreadNotificationCountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUnreadNotificationCountResponse {
+    return new GetUnreadNotificationCountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUnreadNotificationCountResponse | PlainMessage<GetUnreadNotificationCountResponse> | undefined, b: GetUnreadNotificationCountResponse | PlainMessage<GetUnreadNotificationCountResponse> | undefined): boolean {
+    return proto3.util.equals(GetUnreadNotificationCountResponse, a, b);
+  }
+}
+
@@ -0,0 +1,6 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/options/event.proto (package libops.v1.options, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateApiKeyRequest, CreateApiKeyResponse, GetAccountByEmailRequest, GetAccountByEmailResponse, ListApiKeysRequest, ListApiKeysResponse, RevokeApiKeyRequest, RevokeApiKeyResponse } from "./organization_account_api_pb.js";
+import { CreateApiKeyRequest, CreateApiKeyResponse, DeleteAccountAvatarRequest, DeleteAccountAvatarResponse, GetAccountByEmailRequest, GetAccountByEmailResponse, GetAccountPreferencesRequest, GetAccountPreferencesResponse, GetAccountProfileRequest, GetAccountProfileResponse, ListApiKeyTemplatesRequest, ListApiKeyTemplatesResponse, ListApiKeysRequest, ListApiKeysResponse, RevokeApiKeyRequest, RevokeApiKeyResponse, UpdateAccountPreferencesRequest, UpdateAccountPreferencesResponse, UpdateAccountProfileRequest, UpdateAccountProfileResponse, UploadAccountAvatarRequest, UploadAccountAvatarResponse } from "./organization_account_api_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -49,6 +49,18 @@ export const AccountService = {
       kind: MethodKind.Unary,
       idempotency: MethodIdempotency.NoSideEffects,
     },
+    /**
+     * List the templates API keys can be created from
+     *
+     * @generated from rpc libops.v1.AccountService.ListApiKeyTemplates
+     */
+    listApiKeyTemplates: {
+      name: "ListApiKeyTemplates",
+      I: ListApiKeyTemplatesRequest,
+      O: ListApiKeyTemplatesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
     /**
      * Revoke an API key for the authenticated user
      *
@@ -60,6 +72,74 @@ export const AccountService = {
       O: RevokeApiKeyResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Get the authenticated user's dashboard preferences
+     *
+     * @generated from rpc libops.v1.AccountService.GetAccountPreferences
+     */
+    getAccountPreferences: {
+      name: "GetAccountPreferences",
+      I: GetAccountPreferencesRequest,
+      O: GetAccountPreferencesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Update the authenticated user's dashboard preferences
+     *
+     * @generated from rpc libops.v1.AccountService.UpdateAccountPreferences
+     */
+    updateAccountPreferences: {
+      name: "UpdateAccountPreferences",
+      I: UpdateAccountPreferencesRequest,
+      O: UpdateAccountPreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the authenticated user's profile: how they're shown to other members
+     *
+     * @generated from rpc libops.v1.AccountService.GetAccountProfile
+     */
+    getAccountProfile: {
+      name: "GetAccountProfile",
+      I: GetAccountProfileRequest,
+      O: GetAccountProfileResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Update the authenticated user's name, time zone or contact details
+     *
+     * @generated from rpc libops.v1.AccountService.UpdateAccountProfile
+     */
+    updateAccountProfile: {
+      name: "UpdateAccountProfile",
+      I: UpdateAccountProfileRequest,
+      O: UpdateAccountProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replace the authenticated user's avatar
+     *
+     * @generated from rpc libops.v1.AccountService.UploadAccountAvatar
+     */
+    uploadAccountAvatar: {
+      name: "UploadAccountAvatar",
+      I: UploadAccountAvatarRequest,
+      O: UploadAccountAvatarResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove the authenticated user's avatar
+     *
+     * @generated from rpc libops.v1.AccountService.DeleteAccountAvatar
+     */
+    deleteAccountAvatar: {
+      name: "DeleteAccountAvatar",
+      I: DeleteAccountAvatarRequest,
+      O: DeleteAccountAvatarResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
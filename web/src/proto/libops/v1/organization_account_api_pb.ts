@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { AuthMethod } from "./common/types_pb.js";
+import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
 
 /**
  * @generated from message libops.v1.OrganizationAccount
@@ -205,6 +206,13 @@ export class ApiKeyMetadata extends Message<ApiKeyMetadata> {
    */
   lastUsedAt = protoInt64.zero;
 
+  /**
+   * Networks the key can be used from (empty = anywhere)
+   *
+   * @generated from field: repeated string allowed_cidrs = 8;
+   */
+  allowedCidrs: string[] = [];
+
   constructor(data?: PartialMessage<ApiKeyMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -220,6 +228,7 @@ export class ApiKeyMetadata extends Message<ApiKeyMetadata> {
     { no: 5, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 7, name: "last_used_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "allowed_cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApiKeyMetadata {
@@ -264,6 +273,20 @@ export class CreateApiKeyRequest extends Message<CreateApiKeyRequest> {
    */
   scopes: string[] = [];
 
+  /**
+   * Optional networks the key can be used from (e.g., ["192.0.2.0/24"])
+   *
+   * @generated from field: repeated string allowed_cidrs = 4;
+   */
+  allowedCidrs: string[] = [];
+
+  /**
+   * Optional template whose scopes the key gets instead of scopes (e.g., "deploy")
+   *
+   * @generated from field: string template = 5;
+   */
+  template = "";
+
   constructor(data?: PartialMessage<CreateApiKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -275,6 +298,8 @@ export class CreateApiKeyRequest extends Message<CreateApiKeyRequest> {
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "allowed_cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateApiKeyRequest {
@@ -332,6 +357,11 @@ export class CreateApiKeyResponse extends Message<CreateApiKeyResponse> {
    */
   createdAt = protoInt64.zero;
 
+  /**
+   * @generated from field: repeated string allowed_cidrs = 7;
+   */
+  allowedCidrs: string[] = [];
+
   constructor(data?: PartialMessage<CreateApiKeyResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -346,6 +376,7 @@ export class CreateApiKeyResponse extends Message<CreateApiKeyResponse> {
     { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "allowed_cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateApiKeyResponse {
@@ -365,6 +396,141 @@ export class CreateApiKeyResponse extends Message<CreateApiKeyResponse> {
   }
 }
 
+/**
+ * ApiKeyTemplate is a vetted set of scopes for a common use of an API key
+ *
+ * @generated from message libops.v1.ApiKeyTemplate
+ */
+export class ApiKeyTemplate extends Message<ApiKeyTemplate> {
+  /**
+   * Passed as CreateApiKeyRequest.template (e.g., "deploy")
+   *
+   * @generated from field: string template_id = 1;
+   */
+  templateId = "";
+
+  /**
+   * Display name (e.g., "Deploy only")
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * What keys created from it can do
+   *
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * Scopes keys created from it get
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[] = [];
+
+  constructor(data?: PartialMessage<ApiKeyTemplate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApiKeyTemplate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "template_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApiKeyTemplate {
+    return new ApiKeyTemplate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApiKeyTemplate {
+    return new ApiKeyTemplate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApiKeyTemplate {
+    return new ApiKeyTemplate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApiKeyTemplate | PlainMessage<ApiKeyTemplate> | undefined, b: ApiKeyTemplate | PlainMessage<ApiKeyTemplate> | undefined): boolean {
+    return proto3.util.equals(ApiKeyTemplate, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListApiKeyTemplatesRequest
+ */
+export class ListApiKeyTemplatesRequest extends Message<ListApiKeyTemplatesRequest> {
+  constructor(data?: PartialMessage<ListApiKeyTemplatesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListApiKeyTemplatesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListApiKeyTemplatesRequest {
+    return new ListApiKeyTemplatesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListApiKeyTemplatesRequest {
+    return new ListApiKeyTemplatesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListApiKeyTemplatesRequest {
+    return new ListApiKeyTemplatesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListApiKeyTemplatesRequest | PlainMessage<ListApiKeyTemplatesRequest> | undefined, b: ListApiKeyTemplatesRequest | PlainMessage<ListApiKeyTemplatesRequest> | undefined): boolean {
+    return proto3.util.equals(ListApiKeyTemplatesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListApiKeyTemplatesResponse
+ */
+export class ListApiKeyTemplatesResponse extends Message<ListApiKeyTemplatesResponse> {
+  /**
+   * Least privileged first
+   *
+   * @generated from field: repeated libops.v1.ApiKeyTemplate templates = 1;
+   */
+  templates: ApiKeyTemplate[] = [];
+
+  constructor(data?: PartialMessage<ListApiKeyTemplatesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListApiKeyTemplatesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "templates", kind: "message", T: ApiKeyTemplate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListApiKeyTemplatesResponse {
+    return new ListApiKeyTemplatesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListApiKeyTemplatesResponse {
+    return new ListApiKeyTemplatesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListApiKeyTemplatesResponse {
+    return new ListApiKeyTemplatesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListApiKeyTemplatesResponse | PlainMessage<ListApiKeyTemplatesResponse> | undefined, b: ListApiKeyTemplatesResponse | PlainMessage<ListApiKeyTemplatesResponse> | undefined): boolean {
+    return proto3.util.equals(ListApiKeyTemplatesResponse, a, b);
+  }
+}
+
 /**
  * @generated from message libops.v1.ListApiKeysRequest
  */
@@ -529,3 +695,647 @@ export class RevokeApiKeyResponse extends Message<RevokeApiKeyResponse> {
   }
 }
 
+/**
+ * @generated from message libops.v1.AccountPreferences
+ */
+export class AccountPreferences extends Message<AccountPreferences> {
+  /**
+   * "system" (follow the browser), "light" or "dark"
+   *
+   * @generated from field: string theme = 1;
+   */
+  theme = "";
+
+  /**
+   * IANA time zone timestamps are shown in, e.g. "America/New_York"
+   *
+   * @generated from field: string timezone = 2;
+   */
+  timezone = "";
+
+  /**
+   * Organization preselected in forms (UUID), empty for none
+   *
+   * @generated from field: string default_organization_id = 3;
+   */
+  defaultOrganizationId = "";
+
+  /**
+   * Rows per page in dashboard tables, 10 to 100
+   *
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize = 0;
+
+  /**
+   * Dashboard language, e.g. "fr"; empty follows the browser
+   *
+   * @generated from field: string locale = 5;
+   */
+  locale = "";
+
+  constructor(data?: PartialMessage<AccountPreferences>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AccountPreferences";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "theme", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "default_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountPreferences {
+    return new AccountPreferences().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountPreferences {
+    return new AccountPreferences().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountPreferences {
+    return new AccountPreferences().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountPreferences | PlainMessage<AccountPreferences> | undefined, b: AccountPreferences | PlainMessage<AccountPreferences> | undefined): boolean {
+    return proto3.util.equals(AccountPreferences, a, b);
+  }
+}
+
+/**
+ * NO account_id field - always the authenticated user's preferences
+ *
+ * @generated from message libops.v1.GetAccountPreferencesRequest
+ */
+export class GetAccountPreferencesRequest extends Message<GetAccountPreferencesRequest> {
+  constructor(data?: PartialMessage<GetAccountPreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAccountPreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountPreferencesRequest {
+    return new GetAccountPreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountPreferencesRequest {
+    return new GetAccountPreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountPreferencesRequest {
+    return new GetAccountPreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountPreferencesRequest | PlainMessage<GetAccountPreferencesRequest> | undefined, b: GetAccountPreferencesRequest | PlainMessage<GetAccountPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountPreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetAccountPreferencesResponse
+ */
+export class GetAccountPreferencesResponse extends Message<GetAccountPreferencesResponse> {
+  /**
+   * @generated from field: libops.v1.AccountPreferences preferences = 1;
+   */
+  preferences?: AccountPreferences;
+
+  constructor(data?: PartialMessage<GetAccountPreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAccountPreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: AccountPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountPreferencesResponse {
+    return new GetAccountPreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountPreferencesResponse {
+    return new GetAccountPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountPreferencesResponse {
+    return new GetAccountPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountPreferencesResponse | PlainMessage<GetAccountPreferencesResponse> | undefined, b: GetAccountPreferencesResponse | PlainMessage<GetAccountPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountPreferencesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateAccountPreferencesRequest
+ */
+export class UpdateAccountPreferencesRequest extends Message<UpdateAccountPreferencesRequest> {
+  /**
+   * @generated from field: optional string theme = 1;
+   */
+  theme?: string;
+
+  /**
+   * @generated from field: optional string timezone = 2;
+   */
+  timezone?: string;
+
+  /**
+   * Empty clears it
+   *
+   * @generated from field: optional string default_organization_id = 3;
+   */
+  defaultOrganizationId?: string;
+
+  /**
+   * @generated from field: optional int32 page_size = 4;
+   */
+  pageSize?: number;
+
+  /**
+   * Paths: theme, timezone, default_organization_id, page_size, locale.
+   * Without a mask every set field is applied.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 5;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * Empty follows the browser
+   *
+   * @generated from field: optional string locale = 6;
+   */
+  locale?: string;
+
+  constructor(data?: PartialMessage<UpdateAccountPreferencesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateAccountPreferencesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "theme", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "default_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 6, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountPreferencesRequest {
+    return new UpdateAccountPreferencesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountPreferencesRequest {
+    return new UpdateAccountPreferencesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountPreferencesRequest {
+    return new UpdateAccountPreferencesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountPreferencesRequest | PlainMessage<UpdateAccountPreferencesRequest> | undefined, b: UpdateAccountPreferencesRequest | PlainMessage<UpdateAccountPreferencesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountPreferencesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateAccountPreferencesResponse
+ */
+export class UpdateAccountPreferencesResponse extends Message<UpdateAccountPreferencesResponse> {
+  /**
+   * @generated from field: libops.v1.AccountPreferences preferences = 1;
+   */
+  preferences?: AccountPreferences;
+
+  constructor(data?: PartialMessage<UpdateAccountPreferencesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateAccountPreferencesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preferences", kind: "message", T: AccountPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountPreferencesResponse {
+    return new UpdateAccountPreferencesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountPreferencesResponse {
+    return new UpdateAccountPreferencesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountPreferencesResponse {
+    return new UpdateAccountPreferencesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountPreferencesResponse | PlainMessage<UpdateAccountPreferencesResponse> | undefined, b: UpdateAccountPreferencesResponse | PlainMessage<UpdateAccountPreferencesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountPreferencesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AccountProfile
+ */
+export class AccountProfile extends Message<AccountProfile> {
+  /**
+   * Unique account identifier (UUID)
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Sign-in address, not editable here
+   *
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * Display name shown instead of the email address
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * Signed URL valid for an hour, empty without an avatar
+   *
+   * @generated from field: string avatar_url = 4;
+   */
+  avatarUrl = "";
+
+  /**
+   * Same as the time zone preference
+   *
+   * @generated from field: string timezone = 5;
+   */
+  timezone = "";
+
+  /**
+   * Contact number in E.164 format, e.g. "+15551234567"
+   *
+   * @generated from field: string phone = 6;
+   */
+  phone = "";
+
+  constructor(data?: PartialMessage<AccountProfile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AccountProfile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "phone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountProfile {
+    return new AccountProfile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountProfile {
+    return new AccountProfile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountProfile {
+    return new AccountProfile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountProfile | PlainMessage<AccountProfile> | undefined, b: AccountProfile | PlainMessage<AccountProfile> | undefined): boolean {
+    return proto3.util.equals(AccountProfile, a, b);
+  }
+}
+
+/**
+ * NO account_id field - always the authenticated user's profile
+ *
+ * @generated from message libops.v1.GetAccountProfileRequest
+ */
+export class GetAccountProfileRequest extends Message<GetAccountProfileRequest> {
+  constructor(data?: PartialMessage<GetAccountProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAccountProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountProfileRequest {
+    return new GetAccountProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountProfileRequest {
+    return new GetAccountProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountProfileRequest {
+    return new GetAccountProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountProfileRequest | PlainMessage<GetAccountProfileRequest> | undefined, b: GetAccountProfileRequest | PlainMessage<GetAccountProfileRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountProfileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetAccountProfileResponse
+ */
+export class GetAccountProfileResponse extends Message<GetAccountProfileResponse> {
+  /**
+   * @generated from field: libops.v1.AccountProfile profile = 1;
+   */
+  profile?: AccountProfile;
+
+  constructor(data?: PartialMessage<GetAccountProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAccountProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: AccountProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountProfileResponse {
+    return new GetAccountProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountProfileResponse {
+    return new GetAccountProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountProfileResponse {
+    return new GetAccountProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountProfileResponse | PlainMessage<GetAccountProfileResponse> | undefined, b: GetAccountProfileResponse | PlainMessage<GetAccountProfileResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountProfileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateAccountProfileRequest
+ */
+export class UpdateAccountProfileRequest extends Message<UpdateAccountProfileRequest> {
+  /**
+   * At most 100 characters, empty clears it
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: optional string timezone = 2;
+   */
+  timezone?: string;
+
+  /**
+   * Empty clears it
+   *
+   * @generated from field: optional string phone = 3;
+   */
+  phone?: string;
+
+  /**
+   * Paths: name, timezone, phone.
+   * Without a mask every set field is applied.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateAccountProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateAccountProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "phone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountProfileRequest {
+    return new UpdateAccountProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountProfileRequest {
+    return new UpdateAccountProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountProfileRequest {
+    return new UpdateAccountProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountProfileRequest | PlainMessage<UpdateAccountProfileRequest> | undefined, b: UpdateAccountProfileRequest | PlainMessage<UpdateAccountProfileRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountProfileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateAccountProfileResponse
+ */
+export class UpdateAccountProfileResponse extends Message<UpdateAccountProfileResponse> {
+  /**
+   * @generated from field: libops.v1.AccountProfile profile = 1;
+   */
+  profile?: AccountProfile;
+
+  constructor(data?: PartialMessage<UpdateAccountProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateAccountProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: AccountProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAccountProfileResponse {
+    return new UpdateAccountProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAccountProfileResponse {
+    return new UpdateAccountProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAccountProfileResponse {
+    return new UpdateAccountProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAccountProfileResponse | PlainMessage<UpdateAccountProfileResponse> | undefined, b: UpdateAccountProfileResponse | PlainMessage<UpdateAccountProfileResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAccountProfileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UploadAccountAvatarRequest
+ */
+export class UploadAccountAvatarRequest extends Message<UploadAccountAvatarRequest> {
+  /**
+   * PNG, JPEG, GIF or WebP image of at most 1 MiB
+   *
+   * @generated from field: bytes image = 1;
+   */
+  image = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<UploadAccountAvatarRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UploadAccountAvatarRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAccountAvatarRequest {
+    return new UploadAccountAvatarRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAccountAvatarRequest {
+    return new UploadAccountAvatarRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAccountAvatarRequest {
+    return new UploadAccountAvatarRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAccountAvatarRequest | PlainMessage<UploadAccountAvatarRequest> | undefined, b: UploadAccountAvatarRequest | PlainMessage<UploadAccountAvatarRequest> | undefined): boolean {
+    return proto3.util.equals(UploadAccountAvatarRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UploadAccountAvatarResponse
+ */
+export class UploadAccountAvatarResponse extends Message<UploadAccountAvatarResponse> {
+  /**
+   * @generated from field: libops.v1.AccountProfile profile = 1;
+   */
+  profile?: AccountProfile;
+
+  constructor(data?: PartialMessage<UploadAccountAvatarResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UploadAccountAvatarResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: AccountProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadAccountAvatarResponse {
+    return new UploadAccountAvatarResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadAccountAvatarResponse {
+    return new UploadAccountAvatarResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadAccountAvatarResponse {
+    return new UploadAccountAvatarResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadAccountAvatarResponse | PlainMessage<UploadAccountAvatarResponse> | undefined, b: UploadAccountAvatarResponse | PlainMessage<UploadAccountAvatarResponse> | undefined): boolean {
+    return proto3.util.equals(UploadAccountAvatarResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteAccountAvatarRequest
+ */
+export class DeleteAccountAvatarRequest extends Message<DeleteAccountAvatarRequest> {
+  constructor(data?: PartialMessage<DeleteAccountAvatarRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteAccountAvatarRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAccountAvatarRequest {
+    return new DeleteAccountAvatarRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAccountAvatarRequest {
+    return new DeleteAccountAvatarRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAccountAvatarRequest {
+    return new DeleteAccountAvatarRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAccountAvatarRequest | PlainMessage<DeleteAccountAvatarRequest> | undefined, b: DeleteAccountAvatarRequest | PlainMessage<DeleteAccountAvatarRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAccountAvatarRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteAccountAvatarResponse
+ */
+export class DeleteAccountAvatarResponse extends Message<DeleteAccountAvatarResponse> {
+  /**
+   * @generated from field: libops.v1.AccountProfile profile = 1;
+   */
+  profile?: AccountProfile;
+
+  constructor(data?: PartialMessage<DeleteAccountAvatarResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteAccountAvatarResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: AccountProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAccountAvatarResponse {
+    return new DeleteAccountAvatarResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAccountAvatarResponse {
+    return new DeleteAccountAvatarResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAccountAvatarResponse {
+    return new DeleteAccountAvatarResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAccountAvatarResponse | PlainMessage<DeleteAccountAvatarResponse> | undefined, b: DeleteAccountAvatarResponse | PlainMessage<DeleteAccountAvatarResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAccountAvatarResponse, a, b);
+  }
+}
+
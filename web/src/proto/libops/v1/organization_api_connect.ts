@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateOrganizationFirewallRuleRequest, CreateOrganizationFirewallRuleResponse, CreateOrganizationMemberRequest, CreateOrganizationMemberResponse, CreateOrganizationRequest, CreateOrganizationResponse, CreateProjectFirewallRuleRequest, CreateProjectFirewallRuleResponse, CreateProjectMemberRequest, CreateProjectMemberResponse, CreateProjectRequest, CreateProjectResponse, CreateSiteFirewallRuleRequest, CreateSiteFirewallRuleResponse, CreateSiteMemberRequest, CreateSiteMemberResponse, CreateSiteRequest, CreateSiteResponse, CreateSshKeyRequest, CreateSshKeyResponse, DeleteOrganizationFirewallRuleRequest, DeleteOrganizationMemberRequest, DeleteOrganizationRequest, DeleteProjectFirewallRuleRequest, DeleteProjectMemberRequest, DeleteProjectRequest, DeleteSiteFirewallRuleRequest, DeleteSiteMemberRequest, DeleteSiteRequest, DeleteSshKeyRequest, DeploySiteRequest, DeploySiteResponse, GetOrganizationRequest, GetOrganizationResponse, GetProjectRequest, GetProjectResponse, GetSiteRequest, GetSiteResponse, GetSiteStatusRequest, GetSiteStatusResponse, ListOrganizationFirewallRulesRequest, ListOrganizationFirewallRulesResponse, ListOrganizationMembersRequest, ListOrganizationMembersResponse, ListOrganizationProjectsRequest, ListOrganizationProjectsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListProjectFirewallRulesRequest, ListProjectFirewallRulesResponse, ListProjectMembersRequest, ListProjectMembersResponse, ListProjectSitesRequest, ListProjectSitesResponse, ListProjectsRequest, ListProjectsResponse, ListSiteFirewallRulesRequest, ListSiteFirewallRulesResponse, ListSiteMembersRequest, ListSiteMembersResponse, ListSitesRequest, ListSitesResponse, ListSshKeysRequest, ListSshKeysResponse, UpdateOrganizationMemberRequest, UpdateOrganizationMemberResponse, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateProjectMemberRequest, UpdateProjectMemberResponse, UpdateProjectRequest, UpdateProjectResponse, UpdateSiteMemberRequest, UpdateSiteMemberResponse, UpdateSiteRequest, UpdateSiteResponse } from "./organization_api_pb.js";
+import { BulkCreateMembersRequest, BulkCreateMembersResponse, BulkRemoveMembersRequest, BulkRemoveMembersResponse, BulkUpdateMemberRolesRequest, BulkUpdateMemberRolesResponse, ChangePlanRequest, ChangePlanResponse, CreateBillingPortalSessionRequest, CreateBillingPortalSessionResponse, CreateOrganizationFirewallRuleRequest, CreateOrganizationFirewallRuleResponse, CreateOrganizationMemberRequest, CreateOrganizationMemberResponse, CreateOrganizationRequest, CreateOrganizationResponse, CreateProjectFirewallRuleRequest, CreateProjectFirewallRuleResponse, CreateProjectMemberRequest, CreateProjectMemberResponse, CreateProjectRequest, CreateProjectResponse, CreateSiteFirewallRuleRequest, CreateSiteFirewallRuleResponse, CreateSiteMemberRequest, CreateSiteMemberResponse, CreateSiteRateLimitRuleRequest, CreateSiteRateLimitRuleResponse, CreateSiteRequest, CreateSiteResponse, CreateSshKeyRequest, CreateSshKeyResponse, DeleteOrganizationFirewallRuleRequest, DeleteOrganizationMemberRequest, DeleteOrganizationRequest, DeleteProjectFirewallRuleRequest, DeleteProjectMemberRequest, DeleteProjectRequest, DeleteSiteFirewallRuleRequest, DeleteSiteMemberRequest, DeleteSiteRateLimitRuleRequest, DeleteSiteRequest, DeleteSshKeyRequest, DeploySiteRequest, DeploySiteResponse, GetInvoiceRequest, GetInvoiceResponse, GetOrganizationRequest, GetOrganizationResponse, GetOrganizationUsageRequest, GetOrganizationUsageResponse, GetProjectRequest, GetProjectResponse, GetQuotasRequest, GetQuotasResponse, GetSiteRequest, GetSiteResponse, GetSiteStatusRequest, GetSiteStatusResponse, ListInvoicesRequest, ListInvoicesResponse, ListOrganizationFirewallRulesRequest, ListOrganizationFirewallRulesResponse, ListOrganizationMembersRequest, ListOrganizationMembersResponse, ListOrganizationProjectsRequest, ListOrganizationProjectsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListPaymentMethodsRequest, ListPaymentMethodsResponse, ListProjectFirewallRulesRequest, ListProjectFirewallRulesResponse, ListProjectMembersRequest, ListProjectMembersResponse, ListProjectSitesRequest, ListProjectSitesResponse, ListProjectsRequest, ListProjectsResponse, ListSiteDeploymentsRequest, ListSiteDeploymentsResponse, ListSiteFirewallRulesRequest, ListSiteFirewallRulesResponse, ListSiteMembersRequest, ListSiteMembersResponse, ListSiteRateLimitRulesRequest, ListSiteRateLimitRulesResponse, ListSitesRequest, ListSitesResponse, ListSshKeysRequest, ListSshKeysResponse, PreviewUsageRequest, PreviewUsageResponse, RollbackSiteRequest, RollbackSiteResponse, SetDefaultPaymentMethodRequest, SetDefaultPaymentMethodResponse, UpdateOrganizationMemberRequest, UpdateOrganizationMemberResponse, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateProjectMemberRequest, UpdateProjectMemberResponse, UpdateProjectRequest, UpdateProjectResponse, UpdateSiteMemberRequest, UpdateSiteMemberResponse, UpdateSiteRequest, UpdateSiteResponse } from "./organization_api_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 import { Empty } from "../../google/protobuf/empty_pb.js";
 
@@ -84,6 +84,100 @@ export const OrganizationService = {
       kind: MethodKind.Unary,
       idempotency: MethodIdempotency.NoSideEffects,
     },
+    /**
+     * Get the organization's billing plan and effective quotas
+     *
+     * @generated from rpc libops.v1.OrganizationService.GetQuotas
+     */
+    getQuotas: {
+      name: "GetQuotas",
+      I: GetQuotasRequest,
+      O: GetQuotasResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get current resource usage and the Stripe subscription items it is billed through
+     *
+     * @generated from rpc libops.v1.OrganizationService.GetOrganizationUsage
+     */
+    getOrganizationUsage: {
+      name: "GetOrganizationUsage",
+      I: GetOrganizationUsageRequest,
+      O: GetOrganizationUsageResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Preview usage-based charges (egress, disk) accrued in the current billing period
+     *
+     * @generated from rpc libops.v1.OrganizationService.PreviewUsage
+     */
+    previewUsage: {
+      name: "PreviewUsage",
+      I: PreviewUsageRequest,
+      O: PreviewUsageResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Create a Stripe Billing Portal session for managing cards and downloading invoices
+     *
+     * @generated from rpc libops.v1.OrganizationService.CreateBillingPortalSession
+     */
+    createBillingPortalSession: {
+      name: "CreateBillingPortalSession",
+      I: CreateBillingPortalSessionRequest,
+      O: CreateBillingPortalSessionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the payment methods attached to the organization's Stripe customer
+     *
+     * @generated from rpc libops.v1.OrganizationService.ListPaymentMethods
+     */
+    listPaymentMethods: {
+      name: "ListPaymentMethods",
+      I: ListPaymentMethodsRequest,
+      O: ListPaymentMethodsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set the payment method charged for the organization's subscription
+     *
+     * @generated from rpc libops.v1.OrganizationService.SetDefaultPaymentMethod
+     */
+    setDefaultPaymentMethod: {
+      name: "SetDefaultPaymentMethod",
+      I: SetDefaultPaymentMethodRequest,
+      O: SetDefaultPaymentMethodResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the organization's invoices, newest first
+     *
+     * @generated from rpc libops.v1.OrganizationService.ListInvoices
+     */
+    listInvoices: {
+      name: "ListInvoices",
+      I: ListInvoicesRequest,
+      O: ListInvoicesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get a single invoice with its PDF and hosted payment links
+     *
+     * @generated from rpc libops.v1.OrganizationService.GetInvoice
+     */
+    getInvoice: {
+      name: "GetInvoice",
+      I: GetInvoiceRequest,
+      O: GetInvoiceResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
   }
 } as const;
 
@@ -197,6 +291,18 @@ export const ProjectService = {
       O: UpdateProjectResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Change a project's plan (machine type and/or disk size) with prorated billing
+     * and a terraform run that resizes the project's sites
+     *
+     * @generated from rpc libops.v1.ProjectService.ChangePlan
+     */
+    changePlan: {
+      name: "ChangePlan",
+      I: ChangePlanRequest,
+      O: ChangePlanResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Delete a project (must have no sites)
      *
@@ -367,6 +473,41 @@ export const SiteFirewallService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * List HTTP rate limits applied to a specific site, with the requests each
+     * rejected over the last 7 days
+     *
+     * @generated from rpc libops.v1.SiteFirewallService.ListSiteRateLimitRules
+     */
+    listSiteRateLimitRules: {
+      name: "ListSiteRateLimitRules",
+      I: ListSiteRateLimitRulesRequest,
+      O: ListSiteRateLimitRulesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Add an HTTP rate limit to a specific site, enforced by the site's proxy
+     *
+     * @generated from rpc libops.v1.SiteFirewallService.CreateSiteRateLimitRule
+     */
+    createSiteRateLimitRule: {
+      name: "CreateSiteRateLimitRule",
+      I: CreateSiteRateLimitRuleRequest,
+      O: CreateSiteRateLimitRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove an HTTP rate limit from a specific site
+     *
+     * @generated from rpc libops.v1.SiteFirewallService.DeleteSiteRateLimitRule
+     */
+    deleteSiteRateLimitRule: {
+      name: "DeleteSiteRateLimitRule",
+      I: DeleteSiteRateLimitRuleRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -423,6 +564,40 @@ export const MemberService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Add up to 500 people to a organization at once. Each entry succeeds or
+     * fails on its own and has a result in the same position
+     *
+     * @generated from rpc libops.v1.MemberService.BulkCreateMembers
+     */
+    bulkCreateMembers: {
+      name: "BulkCreateMembers",
+      I: BulkCreateMembersRequest,
+      O: BulkCreateMembersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Change the role of up to 500 members at once, with a result per entry
+     *
+     * @generated from rpc libops.v1.MemberService.BulkUpdateMemberRoles
+     */
+    bulkUpdateMemberRoles: {
+      name: "BulkUpdateMemberRoles",
+      I: BulkUpdateMemberRolesRequest,
+      O: BulkUpdateMemberRolesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove up to 500 members at once, with a result per entry
+     *
+     * @generated from rpc libops.v1.MemberService.BulkRemoveMembers
+     */
+    bulkRemoveMembers: {
+      name: "BulkRemoveMembers",
+      I: BulkRemoveMembersRequest,
+      O: BulkRemoveMembersResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -614,6 +789,29 @@ export const SiteOperationsService = {
       O: DeploySiteResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * List a site's deployments, newest first
+     *
+     * @generated from rpc libops.v1.SiteOperationsService.ListSiteDeployments
+     */
+    listSiteDeployments: {
+      name: "ListSiteDeployments",
+      I: ListSiteDeploymentsRequest,
+      O: ListSiteDeploymentsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Roll a site back by redeploying the commit of an earlier successful deployment
+     *
+     * @generated from rpc libops.v1.SiteOperationsService.RollbackSite
+     */
+    rollbackSite: {
+      name: "RollbackSite",
+      I: RollbackSiteRequest,
+      O: RollbackSiteResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -0,0 +1,48 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/organization_api_key.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListOrganizationApiKeysRequest, ListOrganizationApiKeysResponse, RevokeMemberKeyRequest } from "./organization_api_key_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * OrganizationApiKeyService shows owners the API keys that can reach their
+ * organization: the working keys of anyone who is a member of it, or of one of
+ * its projects or sites. Owners can revoke a key without waiting for its holder,
+ * such as when it leaks or breaks the service account write keys policy.
+ *
+ * @generated from service libops.v1.OrganizationApiKeyService
+ */
+export const OrganizationApiKeyService = {
+  typeName: "libops.v1.OrganizationApiKeyService",
+  methods: {
+    /**
+     * List the working API keys of an organization's members, newest first. Key
+     * values are never included.
+     *
+     * @generated from rpc libops.v1.OrganizationApiKeyService.ListOrganizationApiKeys
+     */
+    listOrganizationApiKeys: {
+      name: "ListOrganizationApiKeys",
+      I: ListOrganizationApiKeysRequest,
+      O: ListOrganizationApiKeysResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Revoke a member's API key. It stops working everywhere, not just in this
+     * organization.
+     *
+     * @generated from rpc libops.v1.OrganizationApiKeyService.RevokeMemberKey
+     */
+    revokeMemberKey: {
+      name: "RevokeMemberKey",
+      I: RevokeMemberKeyRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,270 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/organization_api_key.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * OrganizationApiKey is a member's API key, without its value
+ *
+ * @generated from message libops.v1.OrganizationApiKey
+ */
+export class OrganizationApiKey extends Message<OrganizationApiKey> {
+  /**
+   * @generated from field: string api_key_id = 1;
+   */
+  apiKeyId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * Empty means everything the holder can do
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[] = [];
+
+  /**
+   * Empty means any address
+   *
+   * @generated from field: repeated string allowed_cidrs = 5;
+   */
+  allowedCidrs: string[] = [];
+
+  /**
+   * Whether the key can make changes
+   *
+   * @generated from field: bool write_access = 6;
+   */
+  writeAccess = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 7;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp (0 if never used)
+   *
+   * @generated from field: int64 last_used_at = 8;
+   */
+  lastUsedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp (0 if it never expires)
+   *
+   * @generated from field: int64 expires_at = 9;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * Holder of the key
+   *
+   * @generated from field: string account_id = 10;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string email = 11;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string account_name = 12;
+   */
+  accountName = "";
+
+  /**
+   * Whether the holder is a service account
+   *
+   * @generated from field: bool service_account = 13;
+   */
+  serviceAccount = false;
+
+  constructor(data?: PartialMessage<OrganizationApiKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.OrganizationApiKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "allowed_cidrs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "write_access", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "last_used_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "service_account", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationApiKey {
+    return new OrganizationApiKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationApiKey {
+    return new OrganizationApiKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationApiKey {
+    return new OrganizationApiKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationApiKey | PlainMessage<OrganizationApiKey> | undefined, b: OrganizationApiKey | PlainMessage<OrganizationApiKey> | undefined): boolean {
+    return proto3.util.equals(OrganizationApiKey, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationApiKeysRequest
+ */
+export class ListOrganizationApiKeysRequest extends Message<ListOrganizationApiKeysRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationApiKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationApiKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationApiKeysRequest {
+    return new ListOrganizationApiKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationApiKeysRequest {
+    return new ListOrganizationApiKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationApiKeysRequest {
+    return new ListOrganizationApiKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationApiKeysRequest | PlainMessage<ListOrganizationApiKeysRequest> | undefined, b: ListOrganizationApiKeysRequest | PlainMessage<ListOrganizationApiKeysRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationApiKeysRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationApiKeysResponse
+ */
+export class ListOrganizationApiKeysResponse extends Message<ListOrganizationApiKeysResponse> {
+  /**
+   * @generated from field: repeated libops.v1.OrganizationApiKey api_keys = 1;
+   */
+  apiKeys: OrganizationApiKey[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationApiKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationApiKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_keys", kind: "message", T: OrganizationApiKey, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationApiKeysResponse {
+    return new ListOrganizationApiKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationApiKeysResponse {
+    return new ListOrganizationApiKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationApiKeysResponse {
+    return new ListOrganizationApiKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationApiKeysResponse | PlainMessage<ListOrganizationApiKeysResponse> | undefined, b: ListOrganizationApiKeysResponse | PlainMessage<ListOrganizationApiKeysResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationApiKeysResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeMemberKeyRequest
+ */
+export class RevokeMemberKeyRequest extends Message<RevokeMemberKeyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string api_key_id = 2;
+   */
+  apiKeyId = "";
+
+  constructor(data?: PartialMessage<RevokeMemberKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeMemberKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "api_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeMemberKeyRequest {
+    return new RevokeMemberKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeMemberKeyRequest {
+    return new RevokeMemberKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeMemberKeyRequest {
+    return new RevokeMemberKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeMemberKeyRequest | PlainMessage<RevokeMemberKeyRequest> | undefined, b: RevokeMemberKeyRequest | PlainMessage<RevokeMemberKeyRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeMemberKeyRequest, a, b);
+  }
+}
+
@@ -4,12 +4,14 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { ProjectConfig } from "./common/project_pb.js";
 import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
-import { FolderConfig } from "./common/organization_pb.js";
-import { SiteConfig } from "./common/site_pb.js";
+import { FolderConfig, Quota } from "./common/organization_pb.js";
+import { BillingSubscription, Invoice, MeteredUsage, PaymentMethod, ProjectUsage, SubscriptionItem } from "./common/billing_pb.js";
+import { SiteCdn, SiteConfig } from "./common/site_pb.js";
 import { Status } from "./common/types_pb.js";
+import { SiteAddon } from "./addon_pb.js";
 
 /**
  * @generated from enum libops.v1.FirewallRuleType
@@ -49,6 +51,74 @@ proto3.util.setEnumType(FirewallRuleType, "libops.v1.FirewallRuleType", [
   { no: 3, name: "FIREWALL_RULE_TYPE_BLOCKED" },
 ]);
 
+/**
+ * @generated from enum libops.v1.RateLimitWindow
+ */
+export enum RateLimitWindow {
+  /**
+   * @generated from enum value: RATE_LIMIT_WINDOW_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RATE_LIMIT_WINDOW_SECOND = 1;
+   */
+  SECOND = 1,
+
+  /**
+   * @generated from enum value: RATE_LIMIT_WINDOW_MINUTE = 2;
+   */
+  MINUTE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RateLimitWindow)
+proto3.util.setEnumType(RateLimitWindow, "libops.v1.RateLimitWindow", [
+  { no: 0, name: "RATE_LIMIT_WINDOW_UNSPECIFIED" },
+  { no: 1, name: "RATE_LIMIT_WINDOW_SECOND" },
+  { no: 2, name: "RATE_LIMIT_WINDOW_MINUTE" },
+]);
+
+/**
+ * @generated from enum libops.v1.SiteComponentState
+ */
+export enum SiteComponentState {
+  /**
+   * @generated from enum value: SITE_COMPONENT_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SITE_COMPONENT_STATE_OK = 1;
+   */
+  OK = 1,
+
+  /**
+   * Working, but needs attention soon
+   *
+   * @generated from enum value: SITE_COMPONENT_STATE_WARNING = 2;
+   */
+  WARNING = 2,
+
+  /**
+   * @generated from enum value: SITE_COMPONENT_STATE_ERROR = 3;
+   */
+  ERROR = 3,
+
+  /**
+   * Nothing reported to judge it by
+   *
+   * @generated from enum value: SITE_COMPONENT_STATE_UNKNOWN = 4;
+   */
+  UNKNOWN = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SiteComponentState)
+proto3.util.setEnumType(SiteComponentState, "libops.v1.SiteComponentState", [
+  { no: 0, name: "SITE_COMPONENT_STATE_UNSPECIFIED" },
+  { no: 1, name: "SITE_COMPONENT_STATE_OK" },
+  { no: 2, name: "SITE_COMPONENT_STATE_WARNING" },
+  { no: 3, name: "SITE_COMPONENT_STATE_ERROR" },
+  { no: 4, name: "SITE_COMPONENT_STATE_UNKNOWN" },
+]);
+
 /**
  * @generated from message libops.v1.GetProjectRequest
  */
@@ -301,6 +371,110 @@ export class UpdateProjectResponse extends Message<UpdateProjectResponse> {
   }
 }
 
+/**
+ * @generated from message libops.v1.ChangePlanRequest
+ */
+export class ChangePlanRequest extends Message<ChangePlanRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * New machine type; empty keeps the current machine type
+   *
+   * @generated from field: string machine_type = 3;
+   */
+  machineType = "";
+
+  /**
+   * New disk size in GB; 0 keeps the current size. Disks cannot shrink.
+   *
+   * @generated from field: int32 disk_size_gb = 4;
+   */
+  diskSizeGb = 0;
+
+  constructor(data?: PartialMessage<ChangePlanRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ChangePlanRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "machine_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "disk_size_gb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangePlanRequest {
+    return new ChangePlanRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangePlanRequest {
+    return new ChangePlanRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangePlanRequest {
+    return new ChangePlanRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangePlanRequest | PlainMessage<ChangePlanRequest> | undefined, b: ChangePlanRequest | PlainMessage<ChangePlanRequest> | undefined): boolean {
+    return proto3.util.equals(ChangePlanRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ChangePlanResponse
+ */
+export class ChangePlanResponse extends Message<ChangePlanResponse> {
+  /**
+   * @generated from field: libops.v1.common.ProjectConfig project = 1;
+   */
+  project?: ProjectConfig;
+
+  /**
+   * Terraform reconciliation run that applies the resize
+   *
+   * @generated from field: string run_id = 2;
+   */
+  runId = "";
+
+  constructor(data?: PartialMessage<ChangePlanResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ChangePlanResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "message", T: ProjectConfig },
+    { no: 2, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangePlanResponse {
+    return new ChangePlanResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangePlanResponse {
+    return new ChangePlanResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangePlanResponse {
+    return new ChangePlanResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangePlanResponse | PlainMessage<ChangePlanResponse> | undefined, b: ChangePlanResponse | PlainMessage<ChangePlanResponse> | undefined): boolean {
+    return proto3.util.equals(ChangePlanResponse, a, b);
+  }
+}
+
 /**
  * @generated from message libops.v1.DeleteProjectRequest
  */
@@ -359,10 +533,21 @@ export class ListProjectsRequest extends Message<ListProjectsRequest> {
   pageSize = 0;
 
   /**
+   * Continues after the last row of the previous page. Results are ordered
+   * newest first by creation time, then by ID, so rows created or deleted
+   * between requests don't shift pages.
+   *
    * @generated from field: string page_token = 3;
    */
   pageToken = "";
 
+  /**
+   * Comma-separated key=value pairs, e.g. "env=prod,department=library"
+   *
+   * @generated from field: optional string label_selector = 4;
+   */
+  labelSelector?: string;
+
   constructor(data?: PartialMessage<ListProjectsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -374,6 +559,7 @@ export class ListProjectsRequest extends Message<ListProjectsRequest> {
     { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectsRequest {
@@ -815,6 +1001,10 @@ export class ListOrganizationsRequest extends Message<ListOrganizationsRequest>
   pageSize = 0;
 
   /**
+   * Continues after the last row of the previous page. Results are ordered
+   * newest first by creation time, then by ID, so rows created or deleted
+   * between requests don't shift pages.
+   *
    * @generated from field: string page_token = 2;
    */
   pageToken = "";
@@ -984,1077 +1174,2737 @@ export class ListOrganizationProjectsResponse extends Message<ListOrganizationPr
 }
 
 /**
- * @generated from message libops.v1.GetSiteRequest
+ * @generated from message libops.v1.GetQuotasRequest
  */
-export class GetSiteRequest extends Message<GetSiteRequest> {
+export class GetQuotasRequest extends Message<GetQuotasRequest> {
   /**
-   * @generated from field: string site_id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  siteId = "";
+  organizationId = "";
 
-  constructor(data?: PartialMessage<GetSiteRequest>) {
+  constructor(data?: PartialMessage<GetQuotasRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetSiteRequest";
+  static readonly typeName = "libops.v1.GetQuotasRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteRequest {
-    return new GetSiteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuotasRequest {
+    return new GetQuotasRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteRequest {
-    return new GetSiteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuotasRequest {
+    return new GetQuotasRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteRequest {
-    return new GetSiteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuotasRequest {
+    return new GetQuotasRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSiteRequest | PlainMessage<GetSiteRequest> | undefined, b: GetSiteRequest | PlainMessage<GetSiteRequest> | undefined): boolean {
-    return proto3.util.equals(GetSiteRequest, a, b);
+  static equals(a: GetQuotasRequest | PlainMessage<GetQuotasRequest> | undefined, b: GetQuotasRequest | PlainMessage<GetQuotasRequest> | undefined): boolean {
+    return proto3.util.equals(GetQuotasRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.GetSiteResponse
+ * @generated from message libops.v1.GetQuotasResponse
  */
-export class GetSiteResponse extends Message<GetSiteResponse> {
+export class GetQuotasResponse extends Message<GetQuotasResponse> {
   /**
-   * @generated from field: libops.v1.common.SiteConfig site = 1;
+   * e.g., "trial", "standard"
+   *
+   * @generated from field: string plan = 1;
    */
-  site?: SiteConfig;
+  plan = "";
 
-  constructor(data?: PartialMessage<GetSiteResponse>) {
+  /**
+   * @generated from field: repeated libops.v1.common.Quota quotas = 2;
+   */
+  quotas: Quota[] = [];
+
+  constructor(data?: PartialMessage<GetQuotasResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.GetSiteResponse";
+  static readonly typeName = "libops.v1.GetQuotasResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site", kind: "message", T: SiteConfig },
+    { no: 1, name: "plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "quotas", kind: "message", T: Quota, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteResponse {
-    return new GetSiteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuotasResponse {
+    return new GetQuotasResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteResponse {
-    return new GetSiteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuotasResponse {
+    return new GetQuotasResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteResponse {
-    return new GetSiteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuotasResponse {
+    return new GetQuotasResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSiteResponse | PlainMessage<GetSiteResponse> | undefined, b: GetSiteResponse | PlainMessage<GetSiteResponse> | undefined): boolean {
-    return proto3.util.equals(GetSiteResponse, a, b);
+  static equals(a: GetQuotasResponse | PlainMessage<GetQuotasResponse> | undefined, b: GetQuotasResponse | PlainMessage<GetQuotasResponse> | undefined): boolean {
+    return proto3.util.equals(GetQuotasResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateSiteRequest
+ * @generated from message libops.v1.GetOrganizationUsageRequest
  */
-export class CreateSiteRequest extends Message<CreateSiteRequest> {
+export class GetOrganizationUsageRequest extends Message<GetOrganizationUsageRequest> {
   /**
    * @generated from field: string organization_id = 1;
    */
   organizationId = "";
 
-  /**
-   * @generated from field: string project_id = 2;
-   */
-  projectId = "";
-
-  /**
-   * @generated from field: libops.v1.common.SiteConfig site = 3;
-   */
-  site?: SiteConfig;
-
-  constructor(data?: PartialMessage<CreateSiteRequest>) {
+  constructor(data?: PartialMessage<GetOrganizationUsageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateSiteRequest";
+  static readonly typeName = "libops.v1.GetOrganizationUsageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "site", kind: "message", T: SiteConfig },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteRequest {
-    return new CreateSiteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationUsageRequest {
+    return new GetOrganizationUsageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteRequest {
-    return new CreateSiteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationUsageRequest {
+    return new GetOrganizationUsageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteRequest {
-    return new CreateSiteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationUsageRequest {
+    return new GetOrganizationUsageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSiteRequest | PlainMessage<CreateSiteRequest> | undefined, b: CreateSiteRequest | PlainMessage<CreateSiteRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSiteRequest, a, b);
+  static equals(a: GetOrganizationUsageRequest | PlainMessage<GetOrganizationUsageRequest> | undefined, b: GetOrganizationUsageRequest | PlainMessage<GetOrganizationUsageRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationUsageRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateSiteResponse
+ * @generated from message libops.v1.GetOrganizationUsageResponse
  */
-export class CreateSiteResponse extends Message<CreateSiteResponse> {
+export class GetOrganizationUsageResponse extends Message<GetOrganizationUsageResponse> {
   /**
-   * @generated from field: libops.v1.common.SiteConfig site = 1;
+   * Unset when billing is disabled or the organization has no subscription
+   *
+   * @generated from field: libops.v1.common.BillingSubscription subscription = 1;
    */
-  site?: SiteConfig;
+  subscription?: BillingSubscription;
 
-  constructor(data?: PartialMessage<CreateSiteResponse>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateSiteResponse";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site", kind: "message", T: SiteConfig },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteResponse {
-    return new CreateSiteResponse().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteResponse {
-    return new CreateSiteResponse().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: repeated libops.v1.common.ProjectUsage projects = 2;
+   */
+  projects: ProjectUsage[] = [];
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteResponse {
-    return new CreateSiteResponse().fromJsonString(jsonString, options);
-  }
+  /**
+   * Totals across all projects
+   *
+   * @generated from field: int64 total_disk_gb = 3;
+   */
+  totalDiskGb = protoInt64.zero;
 
-  static equals(a: CreateSiteResponse | PlainMessage<CreateSiteResponse> | undefined, b: CreateSiteResponse | PlainMessage<CreateSiteResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSiteResponse, a, b);
-  }
-}
+  /**
+   * @generated from field: int64 backup_storage_bytes = 4;
+   */
+  backupStorageBytes = protoInt64.zero;
 
-/**
- * @generated from message libops.v1.UpdateSiteRequest
- */
-export class UpdateSiteRequest extends Message<UpdateSiteRequest> {
   /**
-   * @generated from field: string site_id = 1;
+   * @generated from field: int64 egress_bytes = 5;
    */
-  siteId = "";
+  egressBytes = protoInt64.zero;
 
   /**
-   * @generated from field: libops.v1.common.SiteConfig site = 2;
+   * Live line items from Stripe
+   *
+   * @generated from field: repeated libops.v1.common.SubscriptionItem items = 6;
    */
-  site?: SiteConfig;
+  items: SubscriptionItem[] = [];
 
   /**
-   * @generated from field: google.protobuf.FieldMask update_mask = 3;
+   * Latest data disk usage reported by the organization's sites
+   *
+   * @generated from field: int64 disk_used_bytes = 7;
    */
-  updateMask?: FieldMask;
+  diskUsedBytes = protoInt64.zero;
 
-  constructor(data?: PartialMessage<UpdateSiteRequest>) {
+  constructor(data?: PartialMessage<GetOrganizationUsageResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateSiteRequest";
+  static readonly typeName = "libops.v1.GetOrganizationUsageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "site", kind: "message", T: SiteConfig },
-    { no: 3, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 1, name: "subscription", kind: "message", T: BillingSubscription },
+    { no: 2, name: "projects", kind: "message", T: ProjectUsage, repeated: true },
+    { no: 3, name: "total_disk_gb", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "backup_storage_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "egress_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "items", kind: "message", T: SubscriptionItem, repeated: true },
+    { no: 7, name: "disk_used_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteRequest {
-    return new UpdateSiteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationUsageResponse {
+    return new GetOrganizationUsageResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteRequest {
-    return new UpdateSiteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationUsageResponse {
+    return new GetOrganizationUsageResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteRequest {
-    return new UpdateSiteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationUsageResponse {
+    return new GetOrganizationUsageResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSiteRequest | PlainMessage<UpdateSiteRequest> | undefined, b: UpdateSiteRequest | PlainMessage<UpdateSiteRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateSiteRequest, a, b);
+  static equals(a: GetOrganizationUsageResponse | PlainMessage<GetOrganizationUsageResponse> | undefined, b: GetOrganizationUsageResponse | PlainMessage<GetOrganizationUsageResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationUsageResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.UpdateSiteResponse
+ * @generated from message libops.v1.PreviewUsageRequest
  */
-export class UpdateSiteResponse extends Message<UpdateSiteResponse> {
+export class PreviewUsageRequest extends Message<PreviewUsageRequest> {
   /**
-   * @generated from field: libops.v1.common.SiteConfig site = 1;
+   * @generated from field: string organization_id = 1;
    */
-  site?: SiteConfig;
+  organizationId = "";
 
-  constructor(data?: PartialMessage<UpdateSiteResponse>) {
+  constructor(data?: PartialMessage<PreviewUsageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateSiteResponse";
+  static readonly typeName = "libops.v1.PreviewUsageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site", kind: "message", T: SiteConfig },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteResponse {
-    return new UpdateSiteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewUsageRequest {
+    return new PreviewUsageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteResponse {
-    return new UpdateSiteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewUsageRequest {
+    return new PreviewUsageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteResponse {
-    return new UpdateSiteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewUsageRequest {
+    return new PreviewUsageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSiteResponse | PlainMessage<UpdateSiteResponse> | undefined, b: UpdateSiteResponse | PlainMessage<UpdateSiteResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateSiteResponse, a, b);
+  static equals(a: PreviewUsageRequest | PlainMessage<PreviewUsageRequest> | undefined, b: PreviewUsageRequest | PlainMessage<PreviewUsageRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewUsageRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.DeleteSiteRequest
+ * @generated from message libops.v1.PreviewUsageResponse
  */
-export class DeleteSiteRequest extends Message<DeleteSiteRequest> {
+export class PreviewUsageResponse extends Message<PreviewUsageResponse> {
   /**
-   * @generated from field: string site_id = 1;
+   * Unix timestamps of the billing period being previewed
+   *
+   * @generated from field: int64 period_start = 1;
    */
-  siteId = "";
+  periodStart = protoInt64.zero;
 
-  constructor(data?: PartialMessage<DeleteSiteRequest>) {
+  /**
+   * @generated from field: int64 period_end = 2;
+   */
+  periodEnd = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated libops.v1.common.MeteredUsage usage = 3;
+   */
+  usage: MeteredUsage[] = [];
+
+  /**
+   * Charges accrued so far, in cents
+   *
+   * @generated from field: int64 estimated_amount_cents = 4;
+   */
+  estimatedAmountCents = protoInt64.zero;
+
+  /**
+   * Charges at the current rate through period_end, in cents
+   *
+   * @generated from field: int64 projected_amount_cents = 5;
+   */
+  projectedAmountCents = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PreviewUsageResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.DeleteSiteRequest";
+  static readonly typeName = "libops.v1.PreviewUsageResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "period_start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "period_end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "usage", kind: "message", T: MeteredUsage, repeated: true },
+    { no: 4, name: "estimated_amount_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "projected_amount_cents", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteRequest {
-    return new DeleteSiteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewUsageResponse {
+    return new PreviewUsageResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteRequest {
-    return new DeleteSiteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewUsageResponse {
+    return new PreviewUsageResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteRequest {
-    return new DeleteSiteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewUsageResponse {
+    return new PreviewUsageResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteSiteRequest | PlainMessage<DeleteSiteRequest> | undefined, b: DeleteSiteRequest | PlainMessage<DeleteSiteRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteSiteRequest, a, b);
+  static equals(a: PreviewUsageResponse | PlainMessage<PreviewUsageResponse> | undefined, b: PreviewUsageResponse | PlainMessage<PreviewUsageResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewUsageResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListSitesRequest
+ * @generated from message libops.v1.CreateBillingPortalSessionRequest
  */
-export class ListSitesRequest extends Message<ListSitesRequest> {
+export class CreateBillingPortalSessionRequest extends Message<CreateBillingPortalSessionRequest> {
   /**
-   * @generated from field: optional string organization_id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  organizationId?: string;
+  organizationId = "";
 
-  /**
-   * @generated from field: optional string project_id = 2;
-   */
-  projectId?: string;
+  constructor(data?: PartialMessage<CreateBillingPortalSessionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: int32 page_size = 3;
-   */
-  pageSize = 0;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateBillingPortalSessionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBillingPortalSessionRequest {
+    return new CreateBillingPortalSessionRequest().fromBinary(bytes, options);
+  }
 
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBillingPortalSessionRequest {
+    return new CreateBillingPortalSessionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBillingPortalSessionRequest {
+    return new CreateBillingPortalSessionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateBillingPortalSessionRequest | PlainMessage<CreateBillingPortalSessionRequest> | undefined, b: CreateBillingPortalSessionRequest | PlainMessage<CreateBillingPortalSessionRequest> | undefined): boolean {
+    return proto3.util.equals(CreateBillingPortalSessionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateBillingPortalSessionResponse
+ */
+export class CreateBillingPortalSessionResponse extends Message<CreateBillingPortalSessionResponse> {
   /**
-   * @generated from field: string page_token = 4;
+   * Short-lived Stripe-hosted URL; the portal returns to the dashboard Billing page
+   *
+   * @generated from field: string url = 1;
    */
-  pageToken = "";
+  url = "";
 
-  constructor(data?: PartialMessage<ListSitesRequest>) {
+  constructor(data?: PartialMessage<CreateBillingPortalSessionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListSitesRequest";
+  static readonly typeName = "libops.v1.CreateBillingPortalSessionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSitesRequest {
-    return new ListSitesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateBillingPortalSessionResponse {
+    return new CreateBillingPortalSessionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSitesRequest {
-    return new ListSitesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateBillingPortalSessionResponse {
+    return new CreateBillingPortalSessionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSitesRequest {
-    return new ListSitesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateBillingPortalSessionResponse {
+    return new CreateBillingPortalSessionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSitesRequest | PlainMessage<ListSitesRequest> | undefined, b: ListSitesRequest | PlainMessage<ListSitesRequest> | undefined): boolean {
-    return proto3.util.equals(ListSitesRequest, a, b);
+  static equals(a: CreateBillingPortalSessionResponse | PlainMessage<CreateBillingPortalSessionResponse> | undefined, b: CreateBillingPortalSessionResponse | PlainMessage<CreateBillingPortalSessionResponse> | undefined): boolean {
+    return proto3.util.equals(CreateBillingPortalSessionResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListSitesResponse
+ * @generated from message libops.v1.ListPaymentMethodsRequest
  */
-export class ListSitesResponse extends Message<ListSitesResponse> {
-  /**
-   * @generated from field: repeated libops.v1.common.SiteConfig sites = 1;
-   */
-  sites: SiteConfig[] = [];
-
+export class ListPaymentMethodsRequest extends Message<ListPaymentMethodsRequest> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string organization_id = 1;
    */
-  nextPageToken = "";
+  organizationId = "";
 
-  constructor(data?: PartialMessage<ListSitesResponse>) {
+  constructor(data?: PartialMessage<ListPaymentMethodsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListSitesResponse";
+  static readonly typeName = "libops.v1.ListPaymentMethodsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "sites", kind: "message", T: SiteConfig, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSitesResponse {
-    return new ListSitesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPaymentMethodsRequest {
+    return new ListPaymentMethodsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSitesResponse {
-    return new ListSitesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPaymentMethodsRequest {
+    return new ListPaymentMethodsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSitesResponse {
-    return new ListSitesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPaymentMethodsRequest {
+    return new ListPaymentMethodsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSitesResponse | PlainMessage<ListSitesResponse> | undefined, b: ListSitesResponse | PlainMessage<ListSitesResponse> | undefined): boolean {
-    return proto3.util.equals(ListSitesResponse, a, b);
+  static equals(a: ListPaymentMethodsRequest | PlainMessage<ListPaymentMethodsRequest> | undefined, b: ListPaymentMethodsRequest | PlainMessage<ListPaymentMethodsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPaymentMethodsRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.OrganizationFirewallRule
+ * @generated from message libops.v1.ListPaymentMethodsResponse
  */
-export class OrganizationFirewallRule extends Message<OrganizationFirewallRule> {
+export class ListPaymentMethodsResponse extends Message<ListPaymentMethodsResponse> {
   /**
-   * Unique rule identifier
-   *
-   * @generated from field: string rule_id = 1;
+   * @generated from field: repeated libops.v1.common.PaymentMethod payment_methods = 1;
    */
-  ruleId = "";
+  paymentMethods: PaymentMethod[] = [];
 
-  /**
-   * Organization this rule applies to
-   *
-   * @generated from field: string organization_id = 2;
-   */
-  organizationId = "";
+  constructor(data?: PartialMessage<ListPaymentMethodsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * Type of rule
-   *
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
-   */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPaymentMethodsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "payment_methods", kind: "message", T: PaymentMethod, repeated: true },
+  ]);
 
-  /**
-   * CIDR block (e.g., "203.0.113.0/24")
-   *
-   * @generated from field: string cidr = 4;
-   */
-  cidr = "";
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPaymentMethodsResponse {
+    return new ListPaymentMethodsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPaymentMethodsResponse {
+    return new ListPaymentMethodsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPaymentMethodsResponse {
+    return new ListPaymentMethodsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPaymentMethodsResponse | PlainMessage<ListPaymentMethodsResponse> | undefined, b: ListPaymentMethodsResponse | PlainMessage<ListPaymentMethodsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPaymentMethodsResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message libops.v1.SetDefaultPaymentMethodRequest
+ */
+export class SetDefaultPaymentMethodRequest extends Message<SetDefaultPaymentMethodRequest> {
   /**
-   * Human-readable name for the rule
-   *
-   * @generated from field: string name = 5;
+   * @generated from field: string organization_id = 1;
    */
-  name = "";
+  organizationId = "";
 
   /**
-   * Rule status
-   *
-   * @generated from field: libops.v1.common.Status status = 6;
+   * @generated from field: string payment_method_id = 2;
    */
-  status = Status.UNSPECIFIED;
+  paymentMethodId = "";
 
-  constructor(data?: PartialMessage<OrganizationFirewallRule>) {
+  constructor(data?: PartialMessage<SetDefaultPaymentMethodRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.OrganizationFirewallRule";
+  static readonly typeName = "libops.v1.SetDefaultPaymentMethodRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "payment_method_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationFirewallRule {
-    return new OrganizationFirewallRule().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultPaymentMethodRequest {
+    return new SetDefaultPaymentMethodRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationFirewallRule {
-    return new OrganizationFirewallRule().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultPaymentMethodRequest {
+    return new SetDefaultPaymentMethodRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationFirewallRule {
-    return new OrganizationFirewallRule().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultPaymentMethodRequest {
+    return new SetDefaultPaymentMethodRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: OrganizationFirewallRule | PlainMessage<OrganizationFirewallRule> | undefined, b: OrganizationFirewallRule | PlainMessage<OrganizationFirewallRule> | undefined): boolean {
-    return proto3.util.equals(OrganizationFirewallRule, a, b);
+  static equals(a: SetDefaultPaymentMethodRequest | PlainMessage<SetDefaultPaymentMethodRequest> | undefined, b: SetDefaultPaymentMethodRequest | PlainMessage<SetDefaultPaymentMethodRequest> | undefined): boolean {
+    return proto3.util.equals(SetDefaultPaymentMethodRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ProjectFirewallRule
+ * @generated from message libops.v1.SetDefaultPaymentMethodResponse
  */
-export class ProjectFirewallRule extends Message<ProjectFirewallRule> {
+export class SetDefaultPaymentMethodResponse extends Message<SetDefaultPaymentMethodResponse> {
   /**
-   * Unique rule identifier
-   *
-   * @generated from field: string rule_id = 1;
+   * @generated from field: libops.v1.common.PaymentMethod payment_method = 1;
    */
-  ruleId = "";
+  paymentMethod?: PaymentMethod;
 
-  /**
-   * Project this rule applies to
-   *
-   * @generated from field: string project_id = 2;
-   */
-  projectId = "";
+  constructor(data?: PartialMessage<SetDefaultPaymentMethodResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * Type of rule
-   *
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
-   */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetDefaultPaymentMethodResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "payment_method", kind: "message", T: PaymentMethod },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultPaymentMethodResponse {
+    return new SetDefaultPaymentMethodResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultPaymentMethodResponse {
+    return new SetDefaultPaymentMethodResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultPaymentMethodResponse {
+    return new SetDefaultPaymentMethodResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetDefaultPaymentMethodResponse | PlainMessage<SetDefaultPaymentMethodResponse> | undefined, b: SetDefaultPaymentMethodResponse | PlainMessage<SetDefaultPaymentMethodResponse> | undefined): boolean {
+    return proto3.util.equals(SetDefaultPaymentMethodResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message libops.v1.ListInvoicesRequest
+ */
+export class ListInvoicesRequest extends Message<ListInvoicesRequest> {
   /**
-   * CIDR block
-   *
-   * @generated from field: string cidr = 4;
+   * @generated from field: string organization_id = 1;
    */
-  cidr = "";
+  organizationId = "";
 
   /**
-   * Human-readable name
-   *
-   * @generated from field: string name = 5;
+   * @generated from field: int32 page_size = 2;
    */
-  name = "";
+  pageSize = 0;
 
   /**
-   * Rule status
-   *
-   * @generated from field: libops.v1.common.Status status = 6;
+   * @generated from field: string page_token = 3;
    */
-  status = Status.UNSPECIFIED;
+  pageToken = "";
 
-  constructor(data?: PartialMessage<ProjectFirewallRule>) {
+  constructor(data?: PartialMessage<ListInvoicesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ProjectFirewallRule";
+  static readonly typeName = "libops.v1.ListInvoicesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectFirewallRule {
-    return new ProjectFirewallRule().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvoicesRequest {
+    return new ListInvoicesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectFirewallRule {
-    return new ProjectFirewallRule().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvoicesRequest {
+    return new ListInvoicesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectFirewallRule {
-    return new ProjectFirewallRule().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvoicesRequest {
+    return new ListInvoicesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ProjectFirewallRule | PlainMessage<ProjectFirewallRule> | undefined, b: ProjectFirewallRule | PlainMessage<ProjectFirewallRule> | undefined): boolean {
-    return proto3.util.equals(ProjectFirewallRule, a, b);
+  static equals(a: ListInvoicesRequest | PlainMessage<ListInvoicesRequest> | undefined, b: ListInvoicesRequest | PlainMessage<ListInvoicesRequest> | undefined): boolean {
+    return proto3.util.equals(ListInvoicesRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.SiteFirewallRule
+ * @generated from message libops.v1.ListInvoicesResponse
  */
-export class SiteFirewallRule extends Message<SiteFirewallRule> {
-  /**
-   * Unique rule identifier
-   *
-   * @generated from field: string rule_id = 1;
-   */
-  ruleId = "";
-
+export class ListInvoicesResponse extends Message<ListInvoicesResponse> {
   /**
-   * Site this rule applies to
-   *
-   * @generated from field: string site_id = 2;
+   * @generated from field: repeated libops.v1.common.Invoice invoices = 1;
    */
-  siteId = "";
+  invoices: Invoice[] = [];
 
   /**
-   * Type of rule
-   *
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
+   * @generated from field: string next_page_token = 2;
    */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  nextPageToken = "";
 
-  /**
-   * CIDR block
-   *
-   * @generated from field: string cidr = 4;
-   */
-  cidr = "";
+  constructor(data?: PartialMessage<ListInvoicesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListInvoicesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invoices", kind: "message", T: Invoice, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvoicesResponse {
+    return new ListInvoicesResponse().fromBinary(bytes, options);
+  }
 
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvoicesResponse {
+    return new ListInvoicesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvoicesResponse {
+    return new ListInvoicesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListInvoicesResponse | PlainMessage<ListInvoicesResponse> | undefined, b: ListInvoicesResponse | PlainMessage<ListInvoicesResponse> | undefined): boolean {
+    return proto3.util.equals(ListInvoicesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetInvoiceRequest
+ */
+export class GetInvoiceRequest extends Message<GetInvoiceRequest> {
   /**
-   * Human-readable name
-   *
-   * @generated from field: string name = 5;
+   * @generated from field: string organization_id = 1;
    */
-  name = "";
+  organizationId = "";
 
   /**
-   * Rule status
-   *
-   * @generated from field: libops.v1.common.Status status = 6;
+   * @generated from field: string invoice_id = 2;
    */
-  status = Status.UNSPECIFIED;
+  invoiceId = "";
 
-  constructor(data?: PartialMessage<SiteFirewallRule>) {
+  constructor(data?: PartialMessage<GetInvoiceRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.SiteFirewallRule";
+  static readonly typeName = "libops.v1.GetInvoiceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "invoice_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteFirewallRule {
-    return new SiteFirewallRule().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetInvoiceRequest {
+    return new GetInvoiceRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteFirewallRule {
-    return new SiteFirewallRule().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetInvoiceRequest {
+    return new GetInvoiceRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteFirewallRule {
-    return new SiteFirewallRule().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetInvoiceRequest {
+    return new GetInvoiceRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SiteFirewallRule | PlainMessage<SiteFirewallRule> | undefined, b: SiteFirewallRule | PlainMessage<SiteFirewallRule> | undefined): boolean {
-    return proto3.util.equals(SiteFirewallRule, a, b);
+  static equals(a: GetInvoiceRequest | PlainMessage<GetInvoiceRequest> | undefined, b: GetInvoiceRequest | PlainMessage<GetInvoiceRequest> | undefined): boolean {
+    return proto3.util.equals(GetInvoiceRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.MemberDetail
+ * @generated from message libops.v1.GetInvoiceResponse
  */
-export class MemberDetail extends Message<MemberDetail> {
+export class GetInvoiceResponse extends Message<GetInvoiceResponse> {
   /**
-   * Account ID of the member
-   *
-   * @generated from field: string account_id = 1;
+   * @generated from field: libops.v1.common.Invoice invoice = 1;
    */
-  accountId = "";
+  invoice?: Invoice;
+
+  constructor(data?: PartialMessage<GetInvoiceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetInvoiceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invoice", kind: "message", T: Invoice },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetInvoiceResponse {
+    return new GetInvoiceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetInvoiceResponse {
+    return new GetInvoiceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetInvoiceResponse {
+    return new GetInvoiceResponse().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: GetInvoiceResponse | PlainMessage<GetInvoiceResponse> | undefined, b: GetInvoiceResponse | PlainMessage<GetInvoiceResponse> | undefined): boolean {
+    return proto3.util.equals(GetInvoiceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteRequest
+ */
+export class GetSiteRequest extends Message<GetSiteRequest> {
   /**
-   * Member's email
-   *
-   * @generated from field: string email = 2;
+   * @generated from field: string site_id = 1;
    */
-  email = "";
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteRequest {
+    return new GetSiteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteRequest {
+    return new GetSiteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteRequest {
+    return new GetSiteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteRequest | PlainMessage<GetSiteRequest> | undefined, b: GetSiteRequest | PlainMessage<GetSiteRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteResponse
+ */
+export class GetSiteResponse extends Message<GetSiteResponse> {
   /**
-   * Member's display name
-   *
-   * @generated from field: string name = 3;
+   * @generated from field: libops.v1.common.SiteConfig site = 1;
    */
-  name = "";
+  site?: SiteConfig;
+
+  constructor(data?: PartialMessage<GetSiteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site", kind: "message", T: SiteConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteResponse {
+    return new GetSiteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteResponse {
+    return new GetSiteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteResponse {
+    return new GetSiteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteResponse | PlainMessage<GetSiteResponse> | undefined, b: GetSiteResponse | PlainMessage<GetSiteResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateSiteRequest
+ */
+export class CreateSiteRequest extends Message<CreateSiteRequest> {
   /**
-   * "owner", "developer", "read"
-   *
-   * @generated from field: string role = 4;
+   * @generated from field: string organization_id = 1;
    */
-  role = "";
+  organizationId = "";
 
   /**
-   * GitHub username
-   *
-   * @generated from field: optional string github_username = 5;
+   * @generated from field: string project_id = 2;
    */
-  githubUsername?: string;
+  projectId = "";
 
   /**
-   * Member status
-   *
-   * @generated from field: libops.v1.common.Status status = 6;
+   * @generated from field: libops.v1.common.SiteConfig site = 3;
    */
-  status = Status.UNSPECIFIED;
+  site?: SiteConfig;
+
+  constructor(data?: PartialMessage<CreateSiteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateSiteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "site", kind: "message", T: SiteConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteRequest {
+    return new CreateSiteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteRequest {
+    return new CreateSiteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteRequest {
+    return new CreateSiteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSiteRequest | PlainMessage<CreateSiteRequest> | undefined, b: CreateSiteRequest | PlainMessage<CreateSiteRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSiteRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message libops.v1.CreateSiteResponse
+ */
+export class CreateSiteResponse extends Message<CreateSiteResponse> {
   /**
-   * Member ID (public_id of the membership)
-   *
-   * @generated from field: string member_id = 7;
+   * @generated from field: libops.v1.common.SiteConfig site = 1;
    */
-  memberId = "";
+  site?: SiteConfig;
 
-  constructor(data?: PartialMessage<MemberDetail>) {
+  constructor(data?: PartialMessage<CreateSiteResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.MemberDetail";
+  static readonly typeName = "libops.v1.CreateSiteResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "github_username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
-    { no: 7, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "site", kind: "message", T: SiteConfig },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberDetail {
-    return new MemberDetail().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteResponse {
+    return new CreateSiteResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberDetail {
-    return new MemberDetail().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteResponse {
+    return new CreateSiteResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberDetail {
-    return new MemberDetail().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteResponse {
+    return new CreateSiteResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MemberDetail | PlainMessage<MemberDetail> | undefined, b: MemberDetail | PlainMessage<MemberDetail> | undefined): boolean {
-    return proto3.util.equals(MemberDetail, a, b);
+  static equals(a: CreateSiteResponse | PlainMessage<CreateSiteResponse> | undefined, b: CreateSiteResponse | PlainMessage<CreateSiteResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSiteResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.SshKey
+ * @generated from message libops.v1.UpdateSiteRequest
  */
-export class SshKey extends Message<SshKey> {
+export class UpdateSiteRequest extends Message<UpdateSiteRequest> {
   /**
-   * UUID
-   *
-   * @generated from field: string key_id = 1;
+   * @generated from field: string site_id = 1;
    */
-  keyId = "";
+  siteId = "";
 
   /**
-   * UUID of account that owns this key
-   *
-   * @generated from field: string account_id = 2;
+   * @generated from field: libops.v1.common.SiteConfig site = 2;
    */
-  accountId = "";
+  site?: SiteConfig;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 3;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateSiteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site", kind: "message", T: SiteConfig },
+    { no: 3, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteRequest {
+    return new UpdateSiteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteRequest {
+    return new UpdateSiteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteRequest {
+    return new UpdateSiteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteRequest | PlainMessage<UpdateSiteRequest> | undefined, b: UpdateSiteRequest | PlainMessage<UpdateSiteRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteResponse
+ */
+export class UpdateSiteResponse extends Message<UpdateSiteResponse> {
+  /**
+   * @generated from field: libops.v1.common.SiteConfig site = 1;
+   */
+  site?: SiteConfig;
+
+  constructor(data?: PartialMessage<UpdateSiteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site", kind: "message", T: SiteConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteResponse {
+    return new UpdateSiteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteResponse {
+    return new UpdateSiteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteResponse {
+    return new UpdateSiteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteResponse | PlainMessage<UpdateSiteResponse> | undefined, b: UpdateSiteResponse | PlainMessage<UpdateSiteResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteRequest
+ */
+export class DeleteSiteRequest extends Message<DeleteSiteRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<DeleteSiteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteRequest {
+    return new DeleteSiteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteRequest {
+    return new DeleteSiteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteRequest {
+    return new DeleteSiteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteRequest | PlainMessage<DeleteSiteRequest> | undefined, b: DeleteSiteRequest | PlainMessage<DeleteSiteRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSitesRequest
+ */
+export class ListSitesRequest extends Message<ListSitesRequest> {
+  /**
+   * @generated from field: optional string organization_id = 1;
+   */
+  organizationId?: string;
+
+  /**
+   * @generated from field: optional string project_id = 2;
+   */
+  projectId?: string;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * Continues after the last row of the previous page. Results are ordered
+   * newest first by creation time, then by ID, so rows created or deleted
+   * between requests don't shift pages.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  /**
+   * Comma-separated key=value pairs, e.g. "env=prod,department=library"
+   *
+   * @generated from field: optional string label_selector = 5;
+   */
+  labelSelector?: string;
+
+  constructor(data?: PartialMessage<ListSitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSitesRequest {
+    return new ListSitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSitesRequest {
+    return new ListSitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSitesRequest {
+    return new ListSitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSitesRequest | PlainMessage<ListSitesRequest> | undefined, b: ListSitesRequest | PlainMessage<ListSitesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSitesResponse
+ */
+export class ListSitesResponse extends Message<ListSitesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.common.SiteConfig sites = 1;
+   */
+  sites: SiteConfig[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sites", kind: "message", T: SiteConfig, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSitesResponse {
+    return new ListSitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSitesResponse {
+    return new ListSitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSitesResponse {
+    return new ListSitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSitesResponse | PlainMessage<ListSitesResponse> | undefined, b: ListSitesResponse | PlainMessage<ListSitesResponse> | undefined): boolean {
+    return proto3.util.equals(ListSitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.OrganizationFirewallRule
+ */
+export class OrganizationFirewallRule extends Message<OrganizationFirewallRule> {
+  /**
+   * Unique rule identifier
+   *
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  /**
+   * Organization this rule applies to
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * Type of rule
+   *
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * CIDR block (e.g., "203.0.113.0/24")
+   *
+   * @generated from field: string cidr = 4;
+   */
+  cidr = "";
+
+  /**
+   * Human-readable name for the rule
+   *
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  /**
+   * Rule status
+   *
+   * @generated from field: libops.v1.common.Status status = 6;
+   */
+  status = Status.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<OrganizationFirewallRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.OrganizationFirewallRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationFirewallRule {
+    return new OrganizationFirewallRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OrganizationFirewallRule {
+    return new OrganizationFirewallRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OrganizationFirewallRule {
+    return new OrganizationFirewallRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OrganizationFirewallRule | PlainMessage<OrganizationFirewallRule> | undefined, b: OrganizationFirewallRule | PlainMessage<OrganizationFirewallRule> | undefined): boolean {
+    return proto3.util.equals(OrganizationFirewallRule, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ProjectFirewallRule
+ */
+export class ProjectFirewallRule extends Message<ProjectFirewallRule> {
+  /**
+   * Unique rule identifier
+   *
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  /**
+   * Project this rule applies to
+   *
+   * @generated from field: string project_id = 2;
+   */
+  projectId = "";
+
+  /**
+   * Type of rule
+   *
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * CIDR block
+   *
+   * @generated from field: string cidr = 4;
+   */
+  cidr = "";
+
+  /**
+   * Human-readable name
+   *
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  /**
+   * Rule status
+   *
+   * @generated from field: libops.v1.common.Status status = 6;
+   */
+  status = Status.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ProjectFirewallRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ProjectFirewallRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectFirewallRule {
+    return new ProjectFirewallRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectFirewallRule {
+    return new ProjectFirewallRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectFirewallRule {
+    return new ProjectFirewallRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectFirewallRule | PlainMessage<ProjectFirewallRule> | undefined, b: ProjectFirewallRule | PlainMessage<ProjectFirewallRule> | undefined): boolean {
+    return proto3.util.equals(ProjectFirewallRule, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SiteFirewallRule
+ */
+export class SiteFirewallRule extends Message<SiteFirewallRule> {
+  /**
+   * Unique rule identifier
+   *
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  /**
+   * Site this rule applies to
+   *
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * Type of rule
+   *
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 3;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * CIDR block
+   *
+   * @generated from field: string cidr = 4;
+   */
+  cidr = "";
+
+  /**
+   * Human-readable name
+   *
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  /**
+   * Rule status
+   *
+   * @generated from field: libops.v1.common.Status status = 6;
+   */
+  status = Status.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SiteFirewallRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteFirewallRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 4, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteFirewallRule {
+    return new SiteFirewallRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteFirewallRule {
+    return new SiteFirewallRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteFirewallRule {
+    return new SiteFirewallRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteFirewallRule | PlainMessage<SiteFirewallRule> | undefined, b: SiteFirewallRule | PlainMessage<SiteFirewallRule> | undefined): boolean {
+    return proto3.util.equals(SiteFirewallRule, a, b);
+  }
+}
+
+/**
+ * SiteRateLimitRule limits how many requests each client IP can make to a
+ * site; requests over the limit get a 429
+ *
+ * @generated from message libops.v1.SiteRateLimitRule
+ */
+export class SiteRateLimitRule extends Message<SiteRateLimitRule> {
+  /**
+   * Unique rule identifier
+   *
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  /**
+   * Site this rule applies to
+   *
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * Human-readable name
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * Only requests under this path count; empty limits every request
+   *
+   * @generated from field: string path_prefix = 4;
+   */
+  pathPrefix = "";
+
+  /**
+   * Requests each client IP may make per window
+   *
+   * @generated from field: int32 requests = 5;
+   */
+  requests = 0;
+
+  /**
+   * @generated from field: libops.v1.RateLimitWindow window = 6;
+   */
+  window = RateLimitWindow.UNSPECIFIED;
+
+  /**
+   * Requests over the rate let through before rejecting
+   *
+   * @generated from field: int32 burst = 7;
+   */
+  burst = 0;
+
+  /**
+   * Requests rejected over the last 7 days
+   *
+   * @generated from field: int64 rejected_requests = 8;
+   */
+  rejectedRequests = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 9;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteRateLimitRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteRateLimitRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "path_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "requests", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "window", kind: "enum", T: proto3.getEnumType(RateLimitWindow) },
+    { no: 7, name: "burst", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "rejected_requests", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteRateLimitRule {
+    return new SiteRateLimitRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteRateLimitRule {
+    return new SiteRateLimitRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteRateLimitRule {
+    return new SiteRateLimitRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteRateLimitRule | PlainMessage<SiteRateLimitRule> | undefined, b: SiteRateLimitRule | PlainMessage<SiteRateLimitRule> | undefined): boolean {
+    return proto3.util.equals(SiteRateLimitRule, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.MemberDetail
+ */
+export class MemberDetail extends Message<MemberDetail> {
+  /**
+   * Account ID of the member
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Member's email
+   *
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * Member's display name
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * "owner", "developer", "read"
+   *
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  /**
+   * GitHub username
+   *
+   * @generated from field: optional string github_username = 5;
+   */
+  githubUsername?: string;
+
+  /**
+   * Member status
+   *
+   * @generated from field: libops.v1.common.Status status = 6;
+   */
+  status = Status.UNSPECIFIED;
+
+  /**
+   * Member ID (public_id of the membership)
+   *
+   * @generated from field: string member_id = 7;
+   */
+  memberId = "";
+
+  /**
+   * Unix timestamp the membership is removed at, 0 if it doesn't expire
+   *
+   * @generated from field: int64 expires_at = 8;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MemberDetail>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MemberDetail";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "github_username", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 7, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberDetail {
+    return new MemberDetail().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberDetail {
+    return new MemberDetail().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberDetail {
+    return new MemberDetail().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberDetail | PlainMessage<MemberDetail> | undefined, b: MemberDetail | PlainMessage<MemberDetail> | undefined): boolean {
+    return proto3.util.equals(MemberDetail, a, b);
+  }
+}
+
+/**
+ * MemberInvitation is a pending membership for someone without an account. It
+ * becomes a membership when they sign up with the invited email and accept it.
+ *
+ * @generated from message libops.v1.MemberInvitation
+ */
+export class MemberInvitation extends Message<MemberInvitation> {
+  /**
+   * @generated from field: string invitation_id = 1;
+   */
+  invitationId = "";
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  /**
+   * Unix seconds
+   *
+   * @generated from field: int64 expires_at = 4;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MemberInvitation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.MemberInvitation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invitation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberInvitation {
+    return new MemberInvitation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberInvitation {
+    return new MemberInvitation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberInvitation {
+    return new MemberInvitation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberInvitation | PlainMessage<MemberInvitation> | undefined, b: MemberInvitation | PlainMessage<MemberInvitation> | undefined): boolean {
+    return proto3.util.equals(MemberInvitation, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SshKey
+ */
+export class SshKey extends Message<SshKey> {
+  /**
+   * UUID
+   *
+   * @generated from field: string key_id = 1;
+   */
+  keyId = "";
+
+  /**
+   * UUID of account that owns this key
+   *
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * SSH public key content
+   *
+   * @generated from field: string public_key = 3;
+   */
+  publicKey = "";
+
+  /**
+   * User-friendly name
+   *
+   * @generated from field: optional string name = 4;
+   */
+  name?: string;
+
+  /**
+   * Key fingerprint (computed)
+   *
+   * @generated from field: optional string fingerprint = 5;
+   */
+  fingerprint?: string;
+
+  constructor(data?: PartialMessage<SshKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SshKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SshKey {
+    return new SshKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SshKey {
+    return new SshKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SshKey {
+    return new SshKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SshKey | PlainMessage<SshKey> | undefined, b: SshKey | PlainMessage<SshKey> | undefined): boolean {
+    return proto3.util.equals(SshKey, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SiteStatus
+ */
+export class SiteStatus extends Message<SiteStatus> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * "pending", "deploying", "deployed", "failed"
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * Status message
+   *
+   * @generated from field: optional string message = 3;
+   */
+  message?: string;
+
+  /**
+   * Timestamp of last deployment
+   *
+   * @generated from field: optional string deployed_at = 4;
+   */
+  deployedAt?: string;
+
+  /**
+   * Unset when the site has no CDN
+   *
+   * @generated from field: libops.v1.common.SiteCdn cdn = 5;
+   */
+  cdn?: SiteCdn;
+
+  /**
+   * With their last reported health
+   *
+   * @generated from field: repeated libops.v1.SiteAddon addons = 6;
+   */
+  addons: SiteAddon[] = [];
+
+  /**
+   * The VM, controller, containers, TLS certificate, DNS, last deployment and
+   * last backup, in that order
+   *
+   * @generated from field: repeated libops.v1.SiteComponentStatus components = 7;
+   */
+  components: SiteComponentStatus[] = [];
+
+  constructor(data?: PartialMessage<SiteStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "deployed_at", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "cdn", kind: "message", T: SiteCdn },
+    { no: 6, name: "addons", kind: "message", T: SiteAddon, repeated: true },
+    { no: 7, name: "components", kind: "message", T: SiteComponentStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteStatus {
+    return new SiteStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteStatus {
+    return new SiteStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteStatus {
+    return new SiteStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteStatus | PlainMessage<SiteStatus> | undefined, b: SiteStatus | PlainMessage<SiteStatus> | undefined): boolean {
+    return proto3.util.equals(SiteStatus, a, b);
+  }
+}
+
+/**
+ * SiteComponentStatus is the state of one part of a site, so support can
+ * triage it without logging in to the VM
+ *
+ * @generated from message libops.v1.SiteComponentStatus
+ */
+export class SiteComponentStatus extends Message<SiteComponentStatus> {
+  /**
+   * "vm", "controller", "containers", "tls", "dns", "deployment" or "backup"
+   *
+   * @generated from field: string component = 1;
+   */
+  component = "";
+
+  /**
+   * @generated from field: libops.v1.SiteComponentState state = 2;
+   */
+  state = SiteComponentState.UNSPECIFIED;
+
+  /**
+   * e.g. "Certificate expires in 5 days"
+   *
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  /**
+   * Unix timestamp of what the state is based on, 0 without anything
+   *
+   * @generated from field: int64 observed_at = 4;
+   */
+  observedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteComponentStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteComponentStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "component", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(SiteComponentState) },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "observed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteComponentStatus {
+    return new SiteComponentStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteComponentStatus {
+    return new SiteComponentStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteComponentStatus {
+    return new SiteComponentStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteComponentStatus | PlainMessage<SiteComponentStatus> | undefined, b: SiteComponentStatus | PlainMessage<SiteComponentStatus> | undefined): boolean {
+    return proto3.util.equals(SiteComponentStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationFirewallRulesRequest
+ */
+export class ListOrganizationFirewallRulesRequest extends Message<ListOrganizationFirewallRulesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationFirewallRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationFirewallRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationFirewallRulesRequest {
+    return new ListOrganizationFirewallRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesRequest {
+    return new ListOrganizationFirewallRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesRequest {
+    return new ListOrganizationFirewallRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationFirewallRulesRequest | PlainMessage<ListOrganizationFirewallRulesRequest> | undefined, b: ListOrganizationFirewallRulesRequest | PlainMessage<ListOrganizationFirewallRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationFirewallRulesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListOrganizationFirewallRulesResponse
+ */
+export class ListOrganizationFirewallRulesResponse extends Message<ListOrganizationFirewallRulesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.OrganizationFirewallRule rules = 1;
+   */
+  rules: OrganizationFirewallRule[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationFirewallRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListOrganizationFirewallRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: OrganizationFirewallRule, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationFirewallRulesResponse {
+    return new ListOrganizationFirewallRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesResponse {
+    return new ListOrganizationFirewallRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesResponse {
+    return new ListOrganizationFirewallRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationFirewallRulesResponse | PlainMessage<ListOrganizationFirewallRulesResponse> | undefined, b: ListOrganizationFirewallRulesResponse | PlainMessage<ListOrganizationFirewallRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationFirewallRulesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateOrganizationFirewallRuleRequest
+ */
+export class CreateOrganizationFirewallRuleRequest extends Message<CreateOrganizationFirewallRuleRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * @generated from field: string cidr = 3;
+   */
+  cidr = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateOrganizationFirewallRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateOrganizationFirewallRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationFirewallRuleRequest {
+    return new CreateOrganizationFirewallRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleRequest {
+    return new CreateOrganizationFirewallRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleRequest {
+    return new CreateOrganizationFirewallRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOrganizationFirewallRuleRequest | PlainMessage<CreateOrganizationFirewallRuleRequest> | undefined, b: CreateOrganizationFirewallRuleRequest | PlainMessage<CreateOrganizationFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationFirewallRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateOrganizationFirewallRuleResponse
+ */
+export class CreateOrganizationFirewallRuleResponse extends Message<CreateOrganizationFirewallRuleResponse> {
+  /**
+   * @generated from field: libops.v1.OrganizationFirewallRule rule = 1;
+   */
+  rule?: OrganizationFirewallRule;
+
+  constructor(data?: PartialMessage<CreateOrganizationFirewallRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateOrganizationFirewallRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: OrganizationFirewallRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationFirewallRuleResponse {
+    return new CreateOrganizationFirewallRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleResponse {
+    return new CreateOrganizationFirewallRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleResponse {
+    return new CreateOrganizationFirewallRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateOrganizationFirewallRuleResponse | PlainMessage<CreateOrganizationFirewallRuleResponse> | undefined, b: CreateOrganizationFirewallRuleResponse | PlainMessage<CreateOrganizationFirewallRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationFirewallRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteOrganizationFirewallRuleRequest
+ */
+export class DeleteOrganizationFirewallRuleRequest extends Message<DeleteOrganizationFirewallRuleRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId = "";
+
+  constructor(data?: PartialMessage<DeleteOrganizationFirewallRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteOrganizationFirewallRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationFirewallRuleRequest {
+    return new DeleteOrganizationFirewallRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationFirewallRuleRequest {
+    return new DeleteOrganizationFirewallRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationFirewallRuleRequest {
+    return new DeleteOrganizationFirewallRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteOrganizationFirewallRuleRequest | PlainMessage<DeleteOrganizationFirewallRuleRequest> | undefined, b: DeleteOrganizationFirewallRuleRequest | PlainMessage<DeleteOrganizationFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationFirewallRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListProjectFirewallRulesRequest
+ */
+export class ListProjectFirewallRulesRequest extends Message<ListProjectFirewallRulesRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectFirewallRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListProjectFirewallRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectFirewallRulesRequest {
+    return new ListProjectFirewallRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesRequest {
+    return new ListProjectFirewallRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesRequest {
+    return new ListProjectFirewallRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectFirewallRulesRequest | PlainMessage<ListProjectFirewallRulesRequest> | undefined, b: ListProjectFirewallRulesRequest | PlainMessage<ListProjectFirewallRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectFirewallRulesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListProjectFirewallRulesResponse
+ */
+export class ListProjectFirewallRulesResponse extends Message<ListProjectFirewallRulesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ProjectFirewallRule rules = 1;
+   */
+  rules: ProjectFirewallRule[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectFirewallRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListProjectFirewallRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: ProjectFirewallRule, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectFirewallRulesResponse {
+    return new ListProjectFirewallRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesResponse {
+    return new ListProjectFirewallRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesResponse {
+    return new ListProjectFirewallRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectFirewallRulesResponse | PlainMessage<ListProjectFirewallRulesResponse> | undefined, b: ListProjectFirewallRulesResponse | PlainMessage<ListProjectFirewallRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectFirewallRulesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateProjectFirewallRuleRequest
+ */
+export class CreateProjectFirewallRuleRequest extends Message<CreateProjectFirewallRuleRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * @generated from field: string cidr = 3;
+   */
+  cidr = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateProjectFirewallRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateProjectFirewallRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectFirewallRuleRequest {
+    return new CreateProjectFirewallRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleRequest {
+    return new CreateProjectFirewallRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleRequest {
+    return new CreateProjectFirewallRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectFirewallRuleRequest | PlainMessage<CreateProjectFirewallRuleRequest> | undefined, b: CreateProjectFirewallRuleRequest | PlainMessage<CreateProjectFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateProjectFirewallRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateProjectFirewallRuleResponse
+ */
+export class CreateProjectFirewallRuleResponse extends Message<CreateProjectFirewallRuleResponse> {
+  /**
+   * @generated from field: libops.v1.ProjectFirewallRule rule = 1;
+   */
+  rule?: ProjectFirewallRule;
+
+  constructor(data?: PartialMessage<CreateProjectFirewallRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateProjectFirewallRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: ProjectFirewallRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectFirewallRuleResponse {
+    return new CreateProjectFirewallRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleResponse {
+    return new CreateProjectFirewallRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleResponse {
+    return new CreateProjectFirewallRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateProjectFirewallRuleResponse | PlainMessage<CreateProjectFirewallRuleResponse> | undefined, b: CreateProjectFirewallRuleResponse | PlainMessage<CreateProjectFirewallRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateProjectFirewallRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteProjectFirewallRuleRequest
+ */
+export class DeleteProjectFirewallRuleRequest extends Message<DeleteProjectFirewallRuleRequest> {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId = "";
+
+  constructor(data?: PartialMessage<DeleteProjectFirewallRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteProjectFirewallRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectFirewallRuleRequest {
+    return new DeleteProjectFirewallRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectFirewallRuleRequest {
+    return new DeleteProjectFirewallRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectFirewallRuleRequest {
+    return new DeleteProjectFirewallRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteProjectFirewallRuleRequest | PlainMessage<DeleteProjectFirewallRuleRequest> | undefined, b: DeleteProjectFirewallRuleRequest | PlainMessage<DeleteProjectFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteProjectFirewallRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteFirewallRulesRequest
+ */
+export class ListSiteFirewallRulesRequest extends Message<ListSiteFirewallRulesRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteFirewallRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteFirewallRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteFirewallRulesRequest {
+    return new ListSiteFirewallRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesRequest {
+    return new ListSiteFirewallRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesRequest {
+    return new ListSiteFirewallRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteFirewallRulesRequest | PlainMessage<ListSiteFirewallRulesRequest> | undefined, b: ListSiteFirewallRulesRequest | PlainMessage<ListSiteFirewallRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSiteFirewallRulesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteFirewallRulesResponse
+ */
+export class ListSiteFirewallRulesResponse extends Message<ListSiteFirewallRulesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SiteFirewallRule rules = 1;
+   */
+  rules: SiteFirewallRule[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteFirewallRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteFirewallRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: SiteFirewallRule, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteFirewallRulesResponse {
+    return new ListSiteFirewallRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesResponse {
+    return new ListSiteFirewallRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesResponse {
+    return new ListSiteFirewallRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteFirewallRulesResponse | PlainMessage<ListSiteFirewallRulesResponse> | undefined, b: ListSiteFirewallRulesResponse | PlainMessage<ListSiteFirewallRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListSiteFirewallRulesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateSiteFirewallRuleRequest
+ */
+export class CreateSiteFirewallRuleRequest extends Message<CreateSiteFirewallRuleRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
+   */
+  ruleType = FirewallRuleType.UNSPECIFIED;
+
+  /**
+   * @generated from field: string cidr = 3;
+   */
+  cidr = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateSiteFirewallRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateSiteFirewallRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
+    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteFirewallRuleRequest {
+    return new CreateSiteFirewallRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleRequest {
+    return new CreateSiteFirewallRuleRequest().fromJson(jsonValue, options);
+  }
 
-  /**
-   * SSH public key content
-   *
-   * @generated from field: string public_key = 3;
-   */
-  publicKey = "";
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleRequest {
+    return new CreateSiteFirewallRuleRequest().fromJsonString(jsonString, options);
+  }
 
-  /**
-   * User-friendly name
-   *
-   * @generated from field: optional string name = 4;
-   */
-  name?: string;
+  static equals(a: CreateSiteFirewallRuleRequest | PlainMessage<CreateSiteFirewallRuleRequest> | undefined, b: CreateSiteFirewallRuleRequest | PlainMessage<CreateSiteFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSiteFirewallRuleRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message libops.v1.CreateSiteFirewallRuleResponse
+ */
+export class CreateSiteFirewallRuleResponse extends Message<CreateSiteFirewallRuleResponse> {
   /**
-   * Key fingerprint (computed)
-   *
-   * @generated from field: optional string fingerprint = 5;
+   * @generated from field: libops.v1.SiteFirewallRule rule = 1;
    */
-  fingerprint?: string;
+  rule?: SiteFirewallRule;
 
-  constructor(data?: PartialMessage<SshKey>) {
+  constructor(data?: PartialMessage<CreateSiteFirewallRuleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.SshKey";
+  static readonly typeName = "libops.v1.CreateSiteFirewallRuleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 5, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "rule", kind: "message", T: SiteFirewallRule },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SshKey {
-    return new SshKey().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteFirewallRuleResponse {
+    return new CreateSiteFirewallRuleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SshKey {
-    return new SshKey().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleResponse {
+    return new CreateSiteFirewallRuleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SshKey {
-    return new SshKey().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleResponse {
+    return new CreateSiteFirewallRuleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SshKey | PlainMessage<SshKey> | undefined, b: SshKey | PlainMessage<SshKey> | undefined): boolean {
-    return proto3.util.equals(SshKey, a, b);
+  static equals(a: CreateSiteFirewallRuleResponse | PlainMessage<CreateSiteFirewallRuleResponse> | undefined, b: CreateSiteFirewallRuleResponse | PlainMessage<CreateSiteFirewallRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSiteFirewallRuleResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.SiteStatus
+ * @generated from message libops.v1.DeleteSiteFirewallRuleRequest
  */
-export class SiteStatus extends Message<SiteStatus> {
+export class DeleteSiteFirewallRuleRequest extends Message<DeleteSiteFirewallRuleRequest> {
   /**
    * @generated from field: string site_id = 1;
    */
   siteId = "";
 
   /**
-   * "pending", "deploying", "deployed", "failed"
-   *
-   * @generated from field: string status = 2;
-   */
-  status = "";
-
-  /**
-   * Status message
-   *
-   * @generated from field: optional string message = 3;
-   */
-  message?: string;
-
-  /**
-   * Timestamp of last deployment
-   *
-   * @generated from field: optional string deployed_at = 4;
+   * @generated from field: string rule_id = 2;
    */
-  deployedAt?: string;
+  ruleId = "";
 
-  constructor(data?: PartialMessage<SiteStatus>) {
+  constructor(data?: PartialMessage<DeleteSiteFirewallRuleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.SiteStatus";
+  static readonly typeName = "libops.v1.DeleteSiteFirewallRuleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 4, name: "deployed_at", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteStatus {
-    return new SiteStatus().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteFirewallRuleRequest {
+    return new DeleteSiteFirewallRuleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteStatus {
-    return new SiteStatus().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteFirewallRuleRequest {
+    return new DeleteSiteFirewallRuleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteStatus {
-    return new SiteStatus().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteFirewallRuleRequest {
+    return new DeleteSiteFirewallRuleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SiteStatus | PlainMessage<SiteStatus> | undefined, b: SiteStatus | PlainMessage<SiteStatus> | undefined): boolean {
-    return proto3.util.equals(SiteStatus, a, b);
+  static equals(a: DeleteSiteFirewallRuleRequest | PlainMessage<DeleteSiteFirewallRuleRequest> | undefined, b: DeleteSiteFirewallRuleRequest | PlainMessage<DeleteSiteFirewallRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteFirewallRuleRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListOrganizationFirewallRulesRequest
+ * @generated from message libops.v1.ListSiteRateLimitRulesRequest
  */
-export class ListOrganizationFirewallRulesRequest extends Message<ListOrganizationFirewallRulesRequest> {
-  /**
-   * @generated from field: string organization_id = 1;
-   */
-  organizationId = "";
-
+export class ListSiteRateLimitRulesRequest extends Message<ListSiteRateLimitRulesRequest> {
   /**
-   * @generated from field: int32 page_size = 2;
-   */
-  pageSize = 0;
-
-  /**
-   * @generated from field: string page_token = 3;
+   * @generated from field: string site_id = 1;
    */
-  pageToken = "";
+  siteId = "";
 
-  constructor(data?: PartialMessage<ListOrganizationFirewallRulesRequest>) {
+  constructor(data?: PartialMessage<ListSiteRateLimitRulesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListOrganizationFirewallRulesRequest";
+  static readonly typeName = "libops.v1.ListSiteRateLimitRulesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationFirewallRulesRequest {
-    return new ListOrganizationFirewallRulesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteRateLimitRulesRequest {
+    return new ListSiteRateLimitRulesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesRequest {
-    return new ListOrganizationFirewallRulesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteRateLimitRulesRequest {
+    return new ListSiteRateLimitRulesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesRequest {
-    return new ListOrganizationFirewallRulesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteRateLimitRulesRequest {
+    return new ListSiteRateLimitRulesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationFirewallRulesRequest | PlainMessage<ListOrganizationFirewallRulesRequest> | undefined, b: ListOrganizationFirewallRulesRequest | PlainMessage<ListOrganizationFirewallRulesRequest> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationFirewallRulesRequest, a, b);
+  static equals(a: ListSiteRateLimitRulesRequest | PlainMessage<ListSiteRateLimitRulesRequest> | undefined, b: ListSiteRateLimitRulesRequest | PlainMessage<ListSiteRateLimitRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSiteRateLimitRulesRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListOrganizationFirewallRulesResponse
+ * @generated from message libops.v1.ListSiteRateLimitRulesResponse
  */
-export class ListOrganizationFirewallRulesResponse extends Message<ListOrganizationFirewallRulesResponse> {
-  /**
-   * @generated from field: repeated libops.v1.OrganizationFirewallRule rules = 1;
-   */
-  rules: OrganizationFirewallRule[] = [];
-
+export class ListSiteRateLimitRulesResponse extends Message<ListSiteRateLimitRulesResponse> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: repeated libops.v1.SiteRateLimitRule rules = 1;
    */
-  nextPageToken = "";
+  rules: SiteRateLimitRule[] = [];
 
-  constructor(data?: PartialMessage<ListOrganizationFirewallRulesResponse>) {
+  constructor(data?: PartialMessage<ListSiteRateLimitRulesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListOrganizationFirewallRulesResponse";
+  static readonly typeName = "libops.v1.ListSiteRateLimitRulesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rules", kind: "message", T: OrganizationFirewallRule, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "rules", kind: "message", T: SiteRateLimitRule, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationFirewallRulesResponse {
-    return new ListOrganizationFirewallRulesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteRateLimitRulesResponse {
+    return new ListSiteRateLimitRulesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesResponse {
-    return new ListOrganizationFirewallRulesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteRateLimitRulesResponse {
+    return new ListSiteRateLimitRulesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationFirewallRulesResponse {
-    return new ListOrganizationFirewallRulesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteRateLimitRulesResponse {
+    return new ListSiteRateLimitRulesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationFirewallRulesResponse | PlainMessage<ListOrganizationFirewallRulesResponse> | undefined, b: ListOrganizationFirewallRulesResponse | PlainMessage<ListOrganizationFirewallRulesResponse> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationFirewallRulesResponse, a, b);
+  static equals(a: ListSiteRateLimitRulesResponse | PlainMessage<ListSiteRateLimitRulesResponse> | undefined, b: ListSiteRateLimitRulesResponse | PlainMessage<ListSiteRateLimitRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListSiteRateLimitRulesResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateOrganizationFirewallRuleRequest
+ * @generated from message libops.v1.CreateSiteRateLimitRuleRequest
  */
-export class CreateOrganizationFirewallRuleRequest extends Message<CreateOrganizationFirewallRuleRequest> {
+export class CreateSiteRateLimitRuleRequest extends Message<CreateSiteRateLimitRuleRequest> {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string site_id = 1;
    */
-  organizationId = "";
+  siteId = "";
 
   /**
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
+   * @generated from field: string name = 2;
    */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  name = "";
 
   /**
-   * @generated from field: string cidr = 3;
+   * Starts with "/"; empty limits every request
+   *
+   * @generated from field: string path_prefix = 3;
    */
-  cidr = "";
+  pathPrefix = "";
 
   /**
-   * @generated from field: string name = 4;
+   * 1 to 100000
+   *
+   * @generated from field: int32 requests = 4;
    */
-  name = "";
+  requests = 0;
 
-  constructor(data?: PartialMessage<CreateOrganizationFirewallRuleRequest>) {
+  /**
+   * @generated from field: libops.v1.RateLimitWindow window = 5;
+   */
+  window = RateLimitWindow.UNSPECIFIED;
+
+  /**
+   * 0 to 10000
+   *
+   * @generated from field: int32 burst = 6;
+   */
+  burst = 0;
+
+  constructor(data?: PartialMessage<CreateSiteRateLimitRuleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateOrganizationFirewallRuleRequest";
+  static readonly typeName = "libops.v1.CreateSiteRateLimitRuleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "requests", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "window", kind: "enum", T: proto3.getEnumType(RateLimitWindow) },
+    { no: 6, name: "burst", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationFirewallRuleRequest {
-    return new CreateOrganizationFirewallRuleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteRateLimitRuleRequest {
+    return new CreateSiteRateLimitRuleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleRequest {
-    return new CreateOrganizationFirewallRuleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteRateLimitRuleRequest {
+    return new CreateSiteRateLimitRuleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleRequest {
-    return new CreateOrganizationFirewallRuleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteRateLimitRuleRequest {
+    return new CreateSiteRateLimitRuleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationFirewallRuleRequest | PlainMessage<CreateOrganizationFirewallRuleRequest> | undefined, b: CreateOrganizationFirewallRuleRequest | PlainMessage<CreateOrganizationFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationFirewallRuleRequest, a, b);
+  static equals(a: CreateSiteRateLimitRuleRequest | PlainMessage<CreateSiteRateLimitRuleRequest> | undefined, b: CreateSiteRateLimitRuleRequest | PlainMessage<CreateSiteRateLimitRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSiteRateLimitRuleRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateOrganizationFirewallRuleResponse
+ * @generated from message libops.v1.CreateSiteRateLimitRuleResponse
  */
-export class CreateOrganizationFirewallRuleResponse extends Message<CreateOrganizationFirewallRuleResponse> {
+export class CreateSiteRateLimitRuleResponse extends Message<CreateSiteRateLimitRuleResponse> {
   /**
-   * @generated from field: libops.v1.OrganizationFirewallRule rule = 1;
+   * @generated from field: libops.v1.SiteRateLimitRule rule = 1;
    */
-  rule?: OrganizationFirewallRule;
+  rule?: SiteRateLimitRule;
 
-  constructor(data?: PartialMessage<CreateOrganizationFirewallRuleResponse>) {
+  constructor(data?: PartialMessage<CreateSiteRateLimitRuleResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateOrganizationFirewallRuleResponse";
+  static readonly typeName = "libops.v1.CreateSiteRateLimitRuleResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule", kind: "message", T: OrganizationFirewallRule },
+    { no: 1, name: "rule", kind: "message", T: SiteRateLimitRule },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationFirewallRuleResponse {
-    return new CreateOrganizationFirewallRuleResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteRateLimitRuleResponse {
+    return new CreateSiteRateLimitRuleResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleResponse {
-    return new CreateOrganizationFirewallRuleResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteRateLimitRuleResponse {
+    return new CreateSiteRateLimitRuleResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationFirewallRuleResponse {
-    return new CreateOrganizationFirewallRuleResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteRateLimitRuleResponse {
+    return new CreateSiteRateLimitRuleResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationFirewallRuleResponse | PlainMessage<CreateOrganizationFirewallRuleResponse> | undefined, b: CreateOrganizationFirewallRuleResponse | PlainMessage<CreateOrganizationFirewallRuleResponse> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationFirewallRuleResponse, a, b);
+  static equals(a: CreateSiteRateLimitRuleResponse | PlainMessage<CreateSiteRateLimitRuleResponse> | undefined, b: CreateSiteRateLimitRuleResponse | PlainMessage<CreateSiteRateLimitRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSiteRateLimitRuleResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.DeleteOrganizationFirewallRuleRequest
+ * @generated from message libops.v1.DeleteSiteRateLimitRuleRequest
  */
-export class DeleteOrganizationFirewallRuleRequest extends Message<DeleteOrganizationFirewallRuleRequest> {
+export class DeleteSiteRateLimitRuleRequest extends Message<DeleteSiteRateLimitRuleRequest> {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string site_id = 1;
    */
-  organizationId = "";
+  siteId = "";
 
   /**
    * @generated from field: string rule_id = 2;
    */
   ruleId = "";
 
-  constructor(data?: PartialMessage<DeleteOrganizationFirewallRuleRequest>) {
+  constructor(data?: PartialMessage<DeleteSiteRateLimitRuleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.DeleteOrganizationFirewallRuleRequest";
+  static readonly typeName = "libops.v1.DeleteSiteRateLimitRuleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationFirewallRuleRequest {
-    return new DeleteOrganizationFirewallRuleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteRateLimitRuleRequest {
+    return new DeleteSiteRateLimitRuleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationFirewallRuleRequest {
-    return new DeleteOrganizationFirewallRuleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteRateLimitRuleRequest {
+    return new DeleteSiteRateLimitRuleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationFirewallRuleRequest {
-    return new DeleteOrganizationFirewallRuleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteRateLimitRuleRequest {
+    return new DeleteSiteRateLimitRuleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteOrganizationFirewallRuleRequest | PlainMessage<DeleteOrganizationFirewallRuleRequest> | undefined, b: DeleteOrganizationFirewallRuleRequest | PlainMessage<DeleteOrganizationFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteOrganizationFirewallRuleRequest, a, b);
+  static equals(a: DeleteSiteRateLimitRuleRequest | PlainMessage<DeleteSiteRateLimitRuleRequest> | undefined, b: DeleteSiteRateLimitRuleRequest | PlainMessage<DeleteSiteRateLimitRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteRateLimitRuleRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListProjectFirewallRulesRequest
+ * @generated from message libops.v1.ListOrganizationMembersRequest
  */
-export class ListProjectFirewallRulesRequest extends Message<ListProjectFirewallRulesRequest> {
+export class ListOrganizationMembersRequest extends Message<ListOrganizationMembersRequest> {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  projectId = "";
+  organizationId = "";
 
   /**
    * @generated from field: int32 page_size = 2;
@@ -2066,757 +3916,826 @@ export class ListProjectFirewallRulesRequest extends Message<ListProjectFirewall
    */
   pageToken = "";
 
-  constructor(data?: PartialMessage<ListProjectFirewallRulesRequest>) {
+  constructor(data?: PartialMessage<ListOrganizationMembersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListProjectFirewallRulesRequest";
+  static readonly typeName = "libops.v1.ListOrganizationMembersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectFirewallRulesRequest {
-    return new ListProjectFirewallRulesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesRequest {
-    return new ListProjectFirewallRulesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesRequest {
-    return new ListProjectFirewallRulesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
+    return new ListOrganizationMembersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListProjectFirewallRulesRequest | PlainMessage<ListProjectFirewallRulesRequest> | undefined, b: ListProjectFirewallRulesRequest | PlainMessage<ListProjectFirewallRulesRequest> | undefined): boolean {
-    return proto3.util.equals(ListProjectFirewallRulesRequest, a, b);
+  static equals(a: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined, b: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMembersRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListProjectFirewallRulesResponse
+ * @generated from message libops.v1.ListOrganizationMembersResponse
  */
-export class ListProjectFirewallRulesResponse extends Message<ListProjectFirewallRulesResponse> {
+export class ListOrganizationMembersResponse extends Message<ListOrganizationMembersResponse> {
   /**
-   * @generated from field: repeated libops.v1.ProjectFirewallRule rules = 1;
+   * @generated from field: repeated libops.v1.MemberDetail members = 1;
    */
-  rules: ProjectFirewallRule[] = [];
+  members: MemberDetail[] = [];
 
   /**
    * @generated from field: string next_page_token = 2;
    */
   nextPageToken = "";
 
-  constructor(data?: PartialMessage<ListProjectFirewallRulesResponse>) {
+  constructor(data?: PartialMessage<ListOrganizationMembersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListProjectFirewallRulesResponse";
+  static readonly typeName = "libops.v1.ListOrganizationMembersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rules", kind: "message", T: ProjectFirewallRule, repeated: true },
+    { no: 1, name: "members", kind: "message", T: MemberDetail, repeated: true },
     { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectFirewallRulesResponse {
-    return new ListProjectFirewallRulesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesResponse {
-    return new ListProjectFirewallRulesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectFirewallRulesResponse {
-    return new ListProjectFirewallRulesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
+    return new ListOrganizationMembersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListProjectFirewallRulesResponse | PlainMessage<ListProjectFirewallRulesResponse> | undefined, b: ListProjectFirewallRulesResponse | PlainMessage<ListProjectFirewallRulesResponse> | undefined): boolean {
-    return proto3.util.equals(ListProjectFirewallRulesResponse, a, b);
+  static equals(a: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined, b: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationMembersResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateProjectFirewallRuleRequest
+ * @generated from message libops.v1.CreateOrganizationMemberRequest
  */
-export class CreateProjectFirewallRuleRequest extends Message<CreateProjectFirewallRuleRequest> {
+export class CreateOrganizationMemberRequest extends Message<CreateOrganizationMemberRequest> {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  projectId = "";
+  organizationId = "";
 
   /**
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
+   * Account to add
+   *
+   * @generated from field: string account_id = 2;
    */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  accountId = "";
 
   /**
-   * @generated from field: string cidr = 3;
+   * "owner", "developer", "read"
+   *
+   * @generated from field: string role = 3;
    */
-  cidr = "";
+  role = "";
 
   /**
-   * @generated from field: string name = 4;
+   * Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+   *
+   * @generated from field: string email = 4;
    */
-  name = "";
-
-  constructor(data?: PartialMessage<CreateProjectFirewallRuleRequest>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateProjectFirewallRuleRequest";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectFirewallRuleRequest {
-    return new CreateProjectFirewallRuleRequest().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleRequest {
-    return new CreateProjectFirewallRuleRequest().fromJson(jsonValue, options);
-  }
-
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleRequest {
-    return new CreateProjectFirewallRuleRequest().fromJsonString(jsonString, options);
-  }
-
-  static equals(a: CreateProjectFirewallRuleRequest | PlainMessage<CreateProjectFirewallRuleRequest> | undefined, b: CreateProjectFirewallRuleRequest | PlainMessage<CreateProjectFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(CreateProjectFirewallRuleRequest, a, b);
-  }
-}
+  email = "";
 
-/**
- * @generated from message libops.v1.CreateProjectFirewallRuleResponse
- */
-export class CreateProjectFirewallRuleResponse extends Message<CreateProjectFirewallRuleResponse> {
   /**
-   * @generated from field: libops.v1.ProjectFirewallRule rule = 1;
+   * Optional Unix timestamp to remove the membership at. Not available for invitations
+   *
+   * @generated from field: int64 expires_at = 5;
    */
-  rule?: ProjectFirewallRule;
+  expiresAt = protoInt64.zero;
 
-  constructor(data?: PartialMessage<CreateProjectFirewallRuleResponse>) {
+  constructor(data?: PartialMessage<CreateOrganizationMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateProjectFirewallRuleResponse";
+  static readonly typeName = "libops.v1.CreateOrganizationMemberRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule", kind: "message", T: ProjectFirewallRule },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectFirewallRuleResponse {
-    return new CreateProjectFirewallRuleResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationMemberRequest {
+    return new CreateOrganizationMemberRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleResponse {
-    return new CreateProjectFirewallRuleResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationMemberRequest {
+    return new CreateOrganizationMemberRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateProjectFirewallRuleResponse {
-    return new CreateProjectFirewallRuleResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationMemberRequest {
+    return new CreateOrganizationMemberRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateProjectFirewallRuleResponse | PlainMessage<CreateProjectFirewallRuleResponse> | undefined, b: CreateProjectFirewallRuleResponse | PlainMessage<CreateProjectFirewallRuleResponse> | undefined): boolean {
-    return proto3.util.equals(CreateProjectFirewallRuleResponse, a, b);
+  static equals(a: CreateOrganizationMemberRequest | PlainMessage<CreateOrganizationMemberRequest> | undefined, b: CreateOrganizationMemberRequest | PlainMessage<CreateOrganizationMemberRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationMemberRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.DeleteProjectFirewallRuleRequest
+ * @generated from message libops.v1.CreateOrganizationMemberResponse
  */
-export class DeleteProjectFirewallRuleRequest extends Message<DeleteProjectFirewallRuleRequest> {
+export class CreateOrganizationMemberResponse extends Message<CreateOrganizationMemberResponse> {
   /**
-   * @generated from field: string project_id = 1;
+   * Set when an existing account was added
+   *
+   * @generated from field: libops.v1.MemberDetail member = 1;
    */
-  projectId = "";
+  member?: MemberDetail;
 
   /**
-   * @generated from field: string rule_id = 2;
+   * Set when an invitation was emailed instead
+   *
+   * @generated from field: libops.v1.MemberInvitation invitation = 2;
    */
-  ruleId = "";
+  invitation?: MemberInvitation;
 
-  constructor(data?: PartialMessage<DeleteProjectFirewallRuleRequest>) {
+  constructor(data?: PartialMessage<CreateOrganizationMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.DeleteProjectFirewallRuleRequest";
+  static readonly typeName = "libops.v1.CreateOrganizationMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "member", kind: "message", T: MemberDetail },
+    { no: 2, name: "invitation", kind: "message", T: MemberInvitation },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteProjectFirewallRuleRequest {
-    return new DeleteProjectFirewallRuleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationMemberResponse {
+    return new CreateOrganizationMemberResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteProjectFirewallRuleRequest {
-    return new DeleteProjectFirewallRuleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationMemberResponse {
+    return new CreateOrganizationMemberResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteProjectFirewallRuleRequest {
-    return new DeleteProjectFirewallRuleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationMemberResponse {
+    return new CreateOrganizationMemberResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteProjectFirewallRuleRequest | PlainMessage<DeleteProjectFirewallRuleRequest> | undefined, b: DeleteProjectFirewallRuleRequest | PlainMessage<DeleteProjectFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteProjectFirewallRuleRequest, a, b);
+  static equals(a: CreateOrganizationMemberResponse | PlainMessage<CreateOrganizationMemberResponse> | undefined, b: CreateOrganizationMemberResponse | PlainMessage<CreateOrganizationMemberResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationMemberResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListSiteFirewallRulesRequest
+ * @generated from message libops.v1.UpdateOrganizationMemberRequest
  */
-export class ListSiteFirewallRulesRequest extends Message<ListSiteFirewallRulesRequest> {
+export class UpdateOrganizationMemberRequest extends Message<UpdateOrganizationMemberRequest> {
   /**
-   * @generated from field: string site_id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  siteId = "";
+  organizationId = "";
 
   /**
-   * @generated from field: int32 page_size = 2;
+   * @generated from field: string account_id = 2;
    */
-  pageSize = 0;
+  accountId = "";
 
   /**
-   * @generated from field: string page_token = 3;
+   * New role
+   *
+   * @generated from field: string role = 3;
    */
-  pageToken = "";
+  role = "";
 
-  constructor(data?: PartialMessage<ListSiteFirewallRulesRequest>) {
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   */
+  updateMask?: FieldMask;
+
+  /**
+   * Unix timestamp to remove the membership at, 0 to keep it indefinitely
+   *
+   * @generated from field: optional int64 expires_at = 5;
+   */
+  expiresAt?: bigint;
+
+  constructor(data?: PartialMessage<UpdateOrganizationMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListSiteFirewallRulesRequest";
+  static readonly typeName = "libops.v1.UpdateOrganizationMemberRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteFirewallRulesRequest {
-    return new ListSiteFirewallRulesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationMemberRequest {
+    return new UpdateOrganizationMemberRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesRequest {
-    return new ListSiteFirewallRulesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberRequest {
+    return new UpdateOrganizationMemberRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesRequest {
-    return new ListSiteFirewallRulesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberRequest {
+    return new UpdateOrganizationMemberRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSiteFirewallRulesRequest | PlainMessage<ListSiteFirewallRulesRequest> | undefined, b: ListSiteFirewallRulesRequest | PlainMessage<ListSiteFirewallRulesRequest> | undefined): boolean {
-    return proto3.util.equals(ListSiteFirewallRulesRequest, a, b);
+  static equals(a: UpdateOrganizationMemberRequest | PlainMessage<UpdateOrganizationMemberRequest> | undefined, b: UpdateOrganizationMemberRequest | PlainMessage<UpdateOrganizationMemberRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationMemberRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListSiteFirewallRulesResponse
+ * @generated from message libops.v1.UpdateOrganizationMemberResponse
  */
-export class ListSiteFirewallRulesResponse extends Message<ListSiteFirewallRulesResponse> {
-  /**
-   * @generated from field: repeated libops.v1.SiteFirewallRule rules = 1;
-   */
-  rules: SiteFirewallRule[] = [];
-
+export class UpdateOrganizationMemberResponse extends Message<UpdateOrganizationMemberResponse> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: libops.v1.MemberDetail member = 1;
    */
-  nextPageToken = "";
+  member?: MemberDetail;
 
-  constructor(data?: PartialMessage<ListSiteFirewallRulesResponse>) {
+  constructor(data?: PartialMessage<UpdateOrganizationMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListSiteFirewallRulesResponse";
+  static readonly typeName = "libops.v1.UpdateOrganizationMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rules", kind: "message", T: SiteFirewallRule, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "member", kind: "message", T: MemberDetail },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteFirewallRulesResponse {
-    return new ListSiteFirewallRulesResponse().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesResponse {
-    return new ListSiteFirewallRulesResponse().fromJson(jsonValue, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationMemberResponse {
+    return new UpdateOrganizationMemberResponse().fromBinary(bytes, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteFirewallRulesResponse {
-    return new ListSiteFirewallRulesResponse().fromJsonString(jsonString, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberResponse {
+    return new UpdateOrganizationMemberResponse().fromJson(jsonValue, options);
   }
 
-  static equals(a: ListSiteFirewallRulesResponse | PlainMessage<ListSiteFirewallRulesResponse> | undefined, b: ListSiteFirewallRulesResponse | PlainMessage<ListSiteFirewallRulesResponse> | undefined): boolean {
-    return proto3.util.equals(ListSiteFirewallRulesResponse, a, b);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberResponse {
+    return new UpdateOrganizationMemberResponse().fromJsonString(jsonString, options);
   }
-}
-
-/**
- * @generated from message libops.v1.CreateSiteFirewallRuleRequest
- */
-export class CreateSiteFirewallRuleRequest extends Message<CreateSiteFirewallRuleRequest> {
-  /**
-   * @generated from field: string site_id = 1;
-   */
-  siteId = "";
 
-  /**
-   * @generated from field: libops.v1.FirewallRuleType rule_type = 2;
-   */
-  ruleType = FirewallRuleType.UNSPECIFIED;
+  static equals(a: UpdateOrganizationMemberResponse | PlainMessage<UpdateOrganizationMemberResponse> | undefined, b: UpdateOrganizationMemberResponse | PlainMessage<UpdateOrganizationMemberResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationMemberResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message libops.v1.DeleteOrganizationMemberRequest
+ */
+export class DeleteOrganizationMemberRequest extends Message<DeleteOrganizationMemberRequest> {
   /**
-   * @generated from field: string cidr = 3;
+   * @generated from field: string organization_id = 1;
    */
-  cidr = "";
+  organizationId = "";
 
   /**
-   * @generated from field: string name = 4;
+   * @generated from field: string account_id = 2;
    */
-  name = "";
+  accountId = "";
 
-  constructor(data?: PartialMessage<CreateSiteFirewallRuleRequest>) {
+  constructor(data?: PartialMessage<DeleteOrganizationMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateSiteFirewallRuleRequest";
+  static readonly typeName = "libops.v1.DeleteOrganizationMemberRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rule_type", kind: "enum", T: proto3.getEnumType(FirewallRuleType) },
-    { no: 3, name: "cidr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteFirewallRuleRequest {
-    return new CreateSiteFirewallRuleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationMemberRequest {
+    return new DeleteOrganizationMemberRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleRequest {
-    return new CreateSiteFirewallRuleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationMemberRequest {
+    return new DeleteOrganizationMemberRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleRequest {
-    return new CreateSiteFirewallRuleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationMemberRequest {
+    return new DeleteOrganizationMemberRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSiteFirewallRuleRequest | PlainMessage<CreateSiteFirewallRuleRequest> | undefined, b: CreateSiteFirewallRuleRequest | PlainMessage<CreateSiteFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSiteFirewallRuleRequest, a, b);
+  static equals(a: DeleteOrganizationMemberRequest | PlainMessage<DeleteOrganizationMemberRequest> | undefined, b: DeleteOrganizationMemberRequest | PlainMessage<DeleteOrganizationMemberRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteOrganizationMemberRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateSiteFirewallRuleResponse
+ * BulkMemberEntry is one person to add in a BulkCreateMembersRequest
+ *
+ * @generated from message libops.v1.BulkMemberEntry
  */
-export class CreateSiteFirewallRuleResponse extends Message<CreateSiteFirewallRuleResponse> {
+export class BulkMemberEntry extends Message<BulkMemberEntry> {
   /**
-   * @generated from field: libops.v1.SiteFirewallRule rule = 1;
+   * Account to add
+   *
+   * @generated from field: string account_id = 1;
    */
-  rule?: SiteFirewallRule;
+  accountId = "";
 
-  constructor(data?: PartialMessage<CreateSiteFirewallRuleResponse>) {
+  /**
+   * Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+   *
+   * @generated from field: string email = 2;
+   */
+  email = "";
+
+  /**
+   * "owner", "developer", "read"
+   *
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  /**
+   * Optional Unix timestamp to remove the membership at. Not available for invitations
+   *
+   * @generated from field: int64 expires_at = 4;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BulkMemberEntry>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateSiteFirewallRuleResponse";
+  static readonly typeName = "libops.v1.BulkMemberEntry";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "rule", kind: "message", T: SiteFirewallRule },
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteFirewallRuleResponse {
-    return new CreateSiteFirewallRuleResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkMemberEntry {
+    return new BulkMemberEntry().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleResponse {
-    return new CreateSiteFirewallRuleResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkMemberEntry {
+    return new BulkMemberEntry().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSiteFirewallRuleResponse {
-    return new CreateSiteFirewallRuleResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkMemberEntry {
+    return new BulkMemberEntry().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSiteFirewallRuleResponse | PlainMessage<CreateSiteFirewallRuleResponse> | undefined, b: CreateSiteFirewallRuleResponse | PlainMessage<CreateSiteFirewallRuleResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSiteFirewallRuleResponse, a, b);
+  static equals(a: BulkMemberEntry | PlainMessage<BulkMemberEntry> | undefined, b: BulkMemberEntry | PlainMessage<BulkMemberEntry> | undefined): boolean {
+    return proto3.util.equals(BulkMemberEntry, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.DeleteSiteFirewallRuleRequest
+ * BulkMemberRole is one role change in a BulkUpdateMemberRolesRequest
+ *
+ * @generated from message libops.v1.BulkMemberRole
  */
-export class DeleteSiteFirewallRuleRequest extends Message<DeleteSiteFirewallRuleRequest> {
+export class BulkMemberRole extends Message<BulkMemberRole> {
   /**
-   * @generated from field: string site_id = 1;
+   * @generated from field: string account_id = 1;
    */
-  siteId = "";
+  accountId = "";
 
   /**
-   * @generated from field: string rule_id = 2;
+   * New role
+   *
+   * @generated from field: string role = 2;
    */
-  ruleId = "";
+  role = "";
 
-  constructor(data?: PartialMessage<DeleteSiteFirewallRuleRequest>) {
+  constructor(data?: PartialMessage<BulkMemberRole>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.DeleteSiteFirewallRuleRequest";
+  static readonly typeName = "libops.v1.BulkMemberRole";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteFirewallRuleRequest {
-    return new DeleteSiteFirewallRuleRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkMemberRole {
+    return new BulkMemberRole().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteFirewallRuleRequest {
-    return new DeleteSiteFirewallRuleRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkMemberRole {
+    return new BulkMemberRole().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteFirewallRuleRequest {
-    return new DeleteSiteFirewallRuleRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkMemberRole {
+    return new BulkMemberRole().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteSiteFirewallRuleRequest | PlainMessage<DeleteSiteFirewallRuleRequest> | undefined, b: DeleteSiteFirewallRuleRequest | PlainMessage<DeleteSiteFirewallRuleRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteSiteFirewallRuleRequest, a, b);
+  static equals(a: BulkMemberRole | PlainMessage<BulkMemberRole> | undefined, b: BulkMemberRole | PlainMessage<BulkMemberRole> | undefined): boolean {
+    return proto3.util.equals(BulkMemberRole, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListOrganizationMembersRequest
+ * BulkMemberResult is the outcome of one entry of a bulk membership request
+ *
+ * @generated from message libops.v1.BulkMemberResult
  */
-export class ListOrganizationMembersRequest extends Message<ListOrganizationMembersRequest> {
+export class BulkMemberResult extends Message<BulkMemberResult> {
   /**
-   * @generated from field: string organization_id = 1;
+   * Position of the entry in the request
+   *
+   * @generated from field: int32 index = 1;
    */
-  organizationId = "";
+  index = 0;
 
   /**
-   * @generated from field: int32 page_size = 2;
+   * Account the entry named, if any
+   *
+   * @generated from field: string account_id = 2;
    */
-  pageSize = 0;
+  accountId = "";
 
   /**
-   * @generated from field: string page_token = 3;
+   * Email the entry named, if any
+   *
+   * @generated from field: string email = 3;
    */
-  pageToken = "";
+  email = "";
 
-  constructor(data?: PartialMessage<ListOrganizationMembersRequest>) {
+  /**
+   * The membership as it is now, when added or updated
+   *
+   * @generated from field: libops.v1.MemberDetail member = 4;
+   */
+  member?: MemberDetail;
+
+  /**
+   * Set when an invitation was emailed instead
+   *
+   * @generated from field: libops.v1.MemberInvitation invitation = 5;
+   */
+  invitation?: MemberInvitation;
+
+  /**
+   * Connect error code, e.g. "not_found"; empty when the entry succeeded
+   *
+   * @generated from field: string error_code = 6;
+   */
+  errorCode = "";
+
+  /**
+   * Why the entry failed
+   *
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<BulkMemberResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListOrganizationMembersRequest";
+  static readonly typeName = "libops.v1.BulkMemberResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "member", kind: "message", T: MemberDetail },
+    { no: 5, name: "invitation", kind: "message", T: MemberInvitation },
+    { no: 6, name: "error_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkMemberResult {
+    return new BulkMemberResult().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkMemberResult {
+    return new BulkMemberResult().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersRequest {
-    return new ListOrganizationMembersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkMemberResult {
+    return new BulkMemberResult().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined, b: ListOrganizationMembersRequest | PlainMessage<ListOrganizationMembersRequest> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationMembersRequest, a, b);
+  static equals(a: BulkMemberResult | PlainMessage<BulkMemberResult> | undefined, b: BulkMemberResult | PlainMessage<BulkMemberResult> | undefined): boolean {
+    return proto3.util.equals(BulkMemberResult, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.ListOrganizationMembersResponse
+ * @generated from message libops.v1.BulkCreateMembersRequest
  */
-export class ListOrganizationMembersResponse extends Message<ListOrganizationMembersResponse> {
+export class BulkCreateMembersRequest extends Message<BulkCreateMembersRequest> {
   /**
-   * @generated from field: repeated libops.v1.MemberDetail members = 1;
+   * @generated from field: string organization_id = 1;
    */
-  members: MemberDetail[] = [];
+  organizationId = "";
 
   /**
-   * @generated from field: string next_page_token = 2;
+   * At most 500
+   *
+   * @generated from field: repeated libops.v1.BulkMemberEntry members = 2;
    */
-  nextPageToken = "";
+  members: BulkMemberEntry[] = [];
 
-  constructor(data?: PartialMessage<ListOrganizationMembersResponse>) {
+  constructor(data?: PartialMessage<BulkCreateMembersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.ListOrganizationMembersResponse";
+  static readonly typeName = "libops.v1.BulkCreateMembersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "members", kind: "message", T: MemberDetail, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "members", kind: "message", T: BulkMemberEntry, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkCreateMembersRequest {
+    return new BulkCreateMembersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkCreateMembersRequest {
+    return new BulkCreateMembersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationMembersResponse {
-    return new ListOrganizationMembersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkCreateMembersRequest {
+    return new BulkCreateMembersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined, b: ListOrganizationMembersResponse | PlainMessage<ListOrganizationMembersResponse> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationMembersResponse, a, b);
+  static equals(a: BulkCreateMembersRequest | PlainMessage<BulkCreateMembersRequest> | undefined, b: BulkCreateMembersRequest | PlainMessage<BulkCreateMembersRequest> | undefined): boolean {
+    return proto3.util.equals(BulkCreateMembersRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateOrganizationMemberRequest
+ * @generated from message libops.v1.BulkCreateMembersResponse
  */
-export class CreateOrganizationMemberRequest extends Message<CreateOrganizationMemberRequest> {
+export class BulkCreateMembersResponse extends Message<BulkCreateMembersResponse> {
   /**
-   * @generated from field: string organization_id = 1;
+   * One per entry, in request order
+   *
+   * @generated from field: repeated libops.v1.BulkMemberResult results = 1;
    */
-  organizationId = "";
+  results: BulkMemberResult[] = [];
 
   /**
-   * Account to add
-   *
-   * @generated from field: string account_id = 2;
+   * @generated from field: int32 succeeded = 2;
    */
-  accountId = "";
+  succeeded = 0;
 
   /**
-   * "owner", "developer", "read"
-   *
-   * @generated from field: string role = 3;
+   * @generated from field: int32 failed = 3;
    */
-  role = "";
+  failed = 0;
 
-  constructor(data?: PartialMessage<CreateOrganizationMemberRequest>) {
+  constructor(data?: PartialMessage<BulkCreateMembersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateOrganizationMemberRequest";
+  static readonly typeName = "libops.v1.BulkCreateMembersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "results", kind: "message", T: BulkMemberResult, repeated: true },
+    { no: 2, name: "succeeded", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationMemberRequest {
-    return new CreateOrganizationMemberRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkCreateMembersResponse {
+    return new BulkCreateMembersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationMemberRequest {
-    return new CreateOrganizationMemberRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkCreateMembersResponse {
+    return new BulkCreateMembersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationMemberRequest {
-    return new CreateOrganizationMemberRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkCreateMembersResponse {
+    return new BulkCreateMembersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationMemberRequest | PlainMessage<CreateOrganizationMemberRequest> | undefined, b: CreateOrganizationMemberRequest | PlainMessage<CreateOrganizationMemberRequest> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationMemberRequest, a, b);
+  static equals(a: BulkCreateMembersResponse | PlainMessage<BulkCreateMembersResponse> | undefined, b: BulkCreateMembersResponse | PlainMessage<BulkCreateMembersResponse> | undefined): boolean {
+    return proto3.util.equals(BulkCreateMembersResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.CreateOrganizationMemberResponse
+ * @generated from message libops.v1.BulkUpdateMemberRolesRequest
  */
-export class CreateOrganizationMemberResponse extends Message<CreateOrganizationMemberResponse> {
+export class BulkUpdateMemberRolesRequest extends Message<BulkUpdateMemberRolesRequest> {
   /**
-   * @generated from field: libops.v1.MemberDetail member = 1;
+   * @generated from field: string organization_id = 1;
    */
-  member?: MemberDetail;
+  organizationId = "";
 
-  constructor(data?: PartialMessage<CreateOrganizationMemberResponse>) {
+  /**
+   * At most 500
+   *
+   * @generated from field: repeated libops.v1.BulkMemberRole members = 2;
+   */
+  members: BulkMemberRole[] = [];
+
+  constructor(data?: PartialMessage<BulkUpdateMemberRolesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.CreateOrganizationMemberResponse";
+  static readonly typeName = "libops.v1.BulkUpdateMemberRolesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "member", kind: "message", T: MemberDetail },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "members", kind: "message", T: BulkMemberRole, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationMemberResponse {
-    return new CreateOrganizationMemberResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkUpdateMemberRolesRequest {
+    return new BulkUpdateMemberRolesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationMemberResponse {
-    return new CreateOrganizationMemberResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkUpdateMemberRolesRequest {
+    return new BulkUpdateMemberRolesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationMemberResponse {
-    return new CreateOrganizationMemberResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkUpdateMemberRolesRequest {
+    return new BulkUpdateMemberRolesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationMemberResponse | PlainMessage<CreateOrganizationMemberResponse> | undefined, b: CreateOrganizationMemberResponse | PlainMessage<CreateOrganizationMemberResponse> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationMemberResponse, a, b);
+  static equals(a: BulkUpdateMemberRolesRequest | PlainMessage<BulkUpdateMemberRolesRequest> | undefined, b: BulkUpdateMemberRolesRequest | PlainMessage<BulkUpdateMemberRolesRequest> | undefined): boolean {
+    return proto3.util.equals(BulkUpdateMemberRolesRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.UpdateOrganizationMemberRequest
+ * @generated from message libops.v1.BulkUpdateMemberRolesResponse
  */
-export class UpdateOrganizationMemberRequest extends Message<UpdateOrganizationMemberRequest> {
-  /**
-   * @generated from field: string organization_id = 1;
-   */
-  organizationId = "";
-
+export class BulkUpdateMemberRolesResponse extends Message<BulkUpdateMemberRolesResponse> {
   /**
-   * @generated from field: string account_id = 2;
+   * One per entry, in request order
+   *
+   * @generated from field: repeated libops.v1.BulkMemberResult results = 1;
    */
-  accountId = "";
+  results: BulkMemberResult[] = [];
 
   /**
-   * New role
-   *
-   * @generated from field: string role = 3;
+   * @generated from field: int32 succeeded = 2;
    */
-  role = "";
+  succeeded = 0;
 
   /**
-   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   * @generated from field: int32 failed = 3;
    */
-  updateMask?: FieldMask;
+  failed = 0;
 
-  constructor(data?: PartialMessage<UpdateOrganizationMemberRequest>) {
+  constructor(data?: PartialMessage<BulkUpdateMemberRolesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateOrganizationMemberRequest";
+  static readonly typeName = "libops.v1.BulkUpdateMemberRolesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 1, name: "results", kind: "message", T: BulkMemberResult, repeated: true },
+    { no: 2, name: "succeeded", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationMemberRequest {
-    return new UpdateOrganizationMemberRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkUpdateMemberRolesResponse {
+    return new BulkUpdateMemberRolesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberRequest {
-    return new UpdateOrganizationMemberRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkUpdateMemberRolesResponse {
+    return new BulkUpdateMemberRolesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberRequest {
-    return new UpdateOrganizationMemberRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkUpdateMemberRolesResponse {
+    return new BulkUpdateMemberRolesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateOrganizationMemberRequest | PlainMessage<UpdateOrganizationMemberRequest> | undefined, b: UpdateOrganizationMemberRequest | PlainMessage<UpdateOrganizationMemberRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateOrganizationMemberRequest, a, b);
+  static equals(a: BulkUpdateMemberRolesResponse | PlainMessage<BulkUpdateMemberRolesResponse> | undefined, b: BulkUpdateMemberRolesResponse | PlainMessage<BulkUpdateMemberRolesResponse> | undefined): boolean {
+    return proto3.util.equals(BulkUpdateMemberRolesResponse, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.UpdateOrganizationMemberResponse
+ * @generated from message libops.v1.BulkRemoveMembersRequest
  */
-export class UpdateOrganizationMemberResponse extends Message<UpdateOrganizationMemberResponse> {
+export class BulkRemoveMembersRequest extends Message<BulkRemoveMembersRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
   /**
-   * @generated from field: libops.v1.MemberDetail member = 1;
+   * At most 500
+   *
+   * @generated from field: repeated string account_ids = 2;
    */
-  member?: MemberDetail;
+  accountIds: string[] = [];
 
-  constructor(data?: PartialMessage<UpdateOrganizationMemberResponse>) {
+  constructor(data?: PartialMessage<BulkRemoveMembersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.UpdateOrganizationMemberResponse";
+  static readonly typeName = "libops.v1.BulkRemoveMembersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "member", kind: "message", T: MemberDetail },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationMemberResponse {
-    return new UpdateOrganizationMemberResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkRemoveMembersRequest {
+    return new BulkRemoveMembersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberResponse {
-    return new UpdateOrganizationMemberResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkRemoveMembersRequest {
+    return new BulkRemoveMembersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationMemberResponse {
-    return new UpdateOrganizationMemberResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkRemoveMembersRequest {
+    return new BulkRemoveMembersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateOrganizationMemberResponse | PlainMessage<UpdateOrganizationMemberResponse> | undefined, b: UpdateOrganizationMemberResponse | PlainMessage<UpdateOrganizationMemberResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateOrganizationMemberResponse, a, b);
+  static equals(a: BulkRemoveMembersRequest | PlainMessage<BulkRemoveMembersRequest> | undefined, b: BulkRemoveMembersRequest | PlainMessage<BulkRemoveMembersRequest> | undefined): boolean {
+    return proto3.util.equals(BulkRemoveMembersRequest, a, b);
   }
 }
 
 /**
- * @generated from message libops.v1.DeleteOrganizationMemberRequest
+ * @generated from message libops.v1.BulkRemoveMembersResponse
  */
-export class DeleteOrganizationMemberRequest extends Message<DeleteOrganizationMemberRequest> {
+export class BulkRemoveMembersResponse extends Message<BulkRemoveMembersResponse> {
   /**
-   * @generated from field: string organization_id = 1;
+   * One per entry, in request order
+   *
+   * @generated from field: repeated libops.v1.BulkMemberResult results = 1;
    */
-  organizationId = "";
+  results: BulkMemberResult[] = [];
 
   /**
-   * @generated from field: string account_id = 2;
+   * @generated from field: int32 succeeded = 2;
    */
-  accountId = "";
+  succeeded = 0;
 
-  constructor(data?: PartialMessage<DeleteOrganizationMemberRequest>) {
+  /**
+   * @generated from field: int32 failed = 3;
+   */
+  failed = 0;
+
+  constructor(data?: PartialMessage<BulkRemoveMembersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "libops.v1.DeleteOrganizationMemberRequest";
+  static readonly typeName = "libops.v1.BulkRemoveMembersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "results", kind: "message", T: BulkMemberResult, repeated: true },
+    { no: 2, name: "succeeded", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteOrganizationMemberRequest {
-    return new DeleteOrganizationMemberRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BulkRemoveMembersResponse {
+    return new BulkRemoveMembersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteOrganizationMemberRequest {
-    return new DeleteOrganizationMemberRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BulkRemoveMembersResponse {
+    return new BulkRemoveMembersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteOrganizationMemberRequest {
-    return new DeleteOrganizationMemberRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BulkRemoveMembersResponse {
+    return new BulkRemoveMembersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteOrganizationMemberRequest | PlainMessage<DeleteOrganizationMemberRequest> | undefined, b: DeleteOrganizationMemberRequest | PlainMessage<DeleteOrganizationMemberRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteOrganizationMemberRequest, a, b);
+  static equals(a: BulkRemoveMembersResponse | PlainMessage<BulkRemoveMembersResponse> | undefined, b: BulkRemoveMembersResponse | PlainMessage<BulkRemoveMembersResponse> | undefined): boolean {
+    return proto3.util.equals(BulkRemoveMembersResponse, a, b);
   }
 }
 
@@ -2935,6 +4854,20 @@ export class CreateProjectMemberRequest extends Message<CreateProjectMemberReque
    */
   role = "";
 
+  /**
+   * Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+   *
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * Optional Unix timestamp to remove the membership at. Not available for invitations
+   *
+   * @generated from field: int64 expires_at = 5;
+   */
+  expiresAt = protoInt64.zero;
+
   constructor(data?: PartialMessage<CreateProjectMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2946,6 +4879,8 @@ export class CreateProjectMemberRequest extends Message<CreateProjectMemberReque
     { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectMemberRequest {
@@ -2970,10 +4905,19 @@ export class CreateProjectMemberRequest extends Message<CreateProjectMemberReque
  */
 export class CreateProjectMemberResponse extends Message<CreateProjectMemberResponse> {
   /**
+   * Set when an existing account was added
+   *
    * @generated from field: libops.v1.MemberDetail member = 1;
    */
   member?: MemberDetail;
 
+  /**
+   * Set when an invitation was emailed instead
+   *
+   * @generated from field: libops.v1.MemberInvitation invitation = 2;
+   */
+  invitation?: MemberInvitation;
+
   constructor(data?: PartialMessage<CreateProjectMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2983,6 +4927,7 @@ export class CreateProjectMemberResponse extends Message<CreateProjectMemberResp
   static readonly typeName = "libops.v1.CreateProjectMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "member", kind: "message", T: MemberDetail },
+    { no: 2, name: "invitation", kind: "message", T: MemberInvitation },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectMemberResponse {
@@ -3028,6 +4973,13 @@ export class UpdateProjectMemberRequest extends Message<UpdateProjectMemberReque
    */
   updateMask?: FieldMask;
 
+  /**
+   * Unix timestamp to remove the membership at, 0 to keep it indefinitely
+   *
+   * @generated from field: optional int64 expires_at = 5;
+   */
+  expiresAt?: bigint;
+
   constructor(data?: PartialMessage<UpdateProjectMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3040,6 +4992,7 @@ export class UpdateProjectMemberRequest extends Message<UpdateProjectMemberReque
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectMemberRequest {
@@ -3254,6 +5207,20 @@ export class CreateSiteMemberRequest extends Message<CreateSiteMemberRequest> {
    */
   role = "";
 
+  /**
+   * Email of the person to add, used when account_id is empty. Invites them if they have no account yet
+   *
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * Optional Unix timestamp to remove the membership at. Not available for invitations
+   *
+   * @generated from field: int64 expires_at = 5;
+   */
+  expiresAt = protoInt64.zero;
+
   constructor(data?: PartialMessage<CreateSiteMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3265,6 +5232,8 @@ export class CreateSiteMemberRequest extends Message<CreateSiteMemberRequest> {
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteMemberRequest {
@@ -3289,10 +5258,19 @@ export class CreateSiteMemberRequest extends Message<CreateSiteMemberRequest> {
  */
 export class CreateSiteMemberResponse extends Message<CreateSiteMemberResponse> {
   /**
+   * Set when an existing account was added
+   *
    * @generated from field: libops.v1.MemberDetail member = 1;
    */
   member?: MemberDetail;
 
+  /**
+   * Set when an invitation was emailed instead
+   *
+   * @generated from field: libops.v1.MemberInvitation invitation = 2;
+   */
+  invitation?: MemberInvitation;
+
   constructor(data?: PartialMessage<CreateSiteMemberResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3302,6 +5280,7 @@ export class CreateSiteMemberResponse extends Message<CreateSiteMemberResponse>
   static readonly typeName = "libops.v1.CreateSiteMemberResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "member", kind: "message", T: MemberDetail },
+    { no: 2, name: "invitation", kind: "message", T: MemberInvitation },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteMemberResponse {
@@ -3347,6 +5326,13 @@ export class UpdateSiteMemberRequest extends Message<UpdateSiteMemberRequest> {
    */
   updateMask?: FieldMask;
 
+  /**
+   * Unix timestamp to remove the membership at, 0 to keep it indefinitely
+   *
+   * @generated from field: optional int64 expires_at = 5;
+   */
+  expiresAt?: bigint;
+
   constructor(data?: PartialMessage<UpdateSiteMemberRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3359,6 +5345,7 @@ export class UpdateSiteMemberRequest extends Message<UpdateSiteMemberRequest> {
     { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteMemberRequest {
@@ -3841,3 +5828,290 @@ export class DeploySiteResponse extends Message<DeploySiteResponse> {
   }
 }
 
+/**
+ * Deployment is one deploy of a site
+ *
+ * @generated from message libops.v1.Deployment
+ */
+export class Deployment extends Message<Deployment> {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * "pending", "in_progress", "success", "failed"
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * Branch, tag, or commit that was requested
+   *
+   * @generated from field: string git_ref = 4;
+   */
+  gitRef = "";
+
+  /**
+   * Commit the ref pointed at, when it could be resolved
+   *
+   * @generated from field: string commit_sha = 5;
+   */
+  commitSha = "";
+
+  /**
+   * @generated from field: string commit_message = 6;
+   */
+  commitMessage = "";
+
+  /**
+   * Workflow run performing the deployment
+   *
+   * @generated from field: string run_url = 7;
+   */
+  runUrl = "";
+
+  /**
+   * @generated from field: string error_message = 8;
+   */
+  errorMessage = "";
+
+  /**
+   * Unix seconds
+   *
+   * @generated from field: int64 started_at = 9;
+   */
+  startedAt = protoInt64.zero;
+
+  /**
+   * Unix seconds, 0 until the deployment finishes
+   *
+   * @generated from field: int64 completed_at = 10;
+   */
+  completedAt = protoInt64.zero;
+
+  /**
+   * Deployment whose commit this rollback redeployed
+   *
+   * @generated from field: string rollback_of = 11;
+   */
+  rollbackOf = "";
+
+  constructor(data?: PartialMessage<Deployment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Deployment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "git_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "commit_sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "run_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "started_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "rollback_of", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deployment {
+    return new Deployment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Deployment {
+    return new Deployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Deployment {
+    return new Deployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Deployment | PlainMessage<Deployment> | undefined, b: Deployment | PlainMessage<Deployment> | undefined): boolean {
+    return proto3.util.equals(Deployment, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteDeploymentsRequest
+ */
+export class ListSiteDeploymentsRequest extends Message<ListSiteDeploymentsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteDeploymentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteDeploymentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteDeploymentsRequest {
+    return new ListSiteDeploymentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteDeploymentsRequest {
+    return new ListSiteDeploymentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteDeploymentsRequest {
+    return new ListSiteDeploymentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteDeploymentsRequest | PlainMessage<ListSiteDeploymentsRequest> | undefined, b: ListSiteDeploymentsRequest | PlainMessage<ListSiteDeploymentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSiteDeploymentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSiteDeploymentsResponse
+ */
+export class ListSiteDeploymentsResponse extends Message<ListSiteDeploymentsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Deployment deployments = 1;
+   */
+  deployments: Deployment[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSiteDeploymentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSiteDeploymentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployments", kind: "message", T: Deployment, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSiteDeploymentsResponse {
+    return new ListSiteDeploymentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSiteDeploymentsResponse {
+    return new ListSiteDeploymentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSiteDeploymentsResponse {
+    return new ListSiteDeploymentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSiteDeploymentsResponse | PlainMessage<ListSiteDeploymentsResponse> | undefined, b: ListSiteDeploymentsResponse | PlainMessage<ListSiteDeploymentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSiteDeploymentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RollbackSiteRequest
+ */
+export class RollbackSiteRequest extends Message<RollbackSiteRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Earlier successful deployment to return to
+   *
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId = "";
+
+  constructor(data?: PartialMessage<RollbackSiteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RollbackSiteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackSiteRequest {
+    return new RollbackSiteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackSiteRequest {
+    return new RollbackSiteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackSiteRequest {
+    return new RollbackSiteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackSiteRequest | PlainMessage<RollbackSiteRequest> | undefined, b: RollbackSiteRequest | PlainMessage<RollbackSiteRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackSiteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RollbackSiteResponse
+ */
+export class RollbackSiteResponse extends Message<RollbackSiteResponse> {
+  /**
+   * @generated from field: libops.v1.Deployment deployment = 1;
+   */
+  deployment?: Deployment;
+
+  constructor(data?: PartialMessage<RollbackSiteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RollbackSiteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment", kind: "message", T: Deployment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackSiteResponse {
+    return new RollbackSiteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackSiteResponse {
+    return new RollbackSiteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackSiteResponse {
+    return new RollbackSiteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackSiteResponse | PlainMessage<RollbackSiteResponse> | undefined, b: RollbackSiteResponse | PlainMessage<RollbackSiteResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackSiteResponse, a, b);
+  }
+}
+
@@ -0,0 +1,68 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/ownership.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AcceptOrganizationOwnershipTransferRequest, AcceptOrganizationOwnershipTransferResponse, CancelOrganizationOwnershipTransferRequest, CancelOrganizationOwnershipTransferResponse, GetOrganizationOwnershipTransferRequest, GetOrganizationOwnershipTransferResponse, TransferOrganizationOwnershipRequest, TransferOrganizationOwnershipResponse } from "./ownership_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * OwnershipService hands an organization from one owner to another member, so an
+ * organization isn't orphaned when its owner leaves their institution. The new
+ * owner accepts the transfer; the previous owner then becomes a developer, and
+ * the billing contact and the API keys they created for the organization's
+ * platform service accounts move to the new owner.
+ *
+ * @generated from service libops.v1.OwnershipService
+ */
+export const OwnershipService = {
+  typeName: "libops.v1.OwnershipService",
+  methods: {
+    /**
+     * Offer the organization to a member. Replaces any open transfer.
+     *
+     * @generated from rpc libops.v1.OwnershipService.TransferOrganizationOwnership
+     */
+    transferOrganizationOwnership: {
+      name: "TransferOrganizationOwnership",
+      I: TransferOrganizationOwnershipRequest,
+      O: TransferOrganizationOwnershipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the organization's open transfer, so the new owner can accept it
+     *
+     * @generated from rpc libops.v1.OwnershipService.GetOrganizationOwnershipTransfer
+     */
+    getOrganizationOwnershipTransfer: {
+      name: "GetOrganizationOwnershipTransfer",
+      I: GetOrganizationOwnershipTransferRequest,
+      O: GetOrganizationOwnershipTransferResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Accept a transfer. Only the member it was offered to can accept it.
+     *
+     * @generated from rpc libops.v1.OwnershipService.AcceptOrganizationOwnershipTransfer
+     */
+    acceptOrganizationOwnershipTransfer: {
+      name: "AcceptOrganizationOwnershipTransfer",
+      I: AcceptOrganizationOwnershipTransferRequest,
+      O: AcceptOrganizationOwnershipTransferResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Cancel an open transfer
+     *
+     * @generated from rpc libops.v1.OwnershipService.CancelOrganizationOwnershipTransfer
+     */
+    cancelOrganizationOwnershipTransfer: {
+      name: "CancelOrganizationOwnershipTransfer",
+      I: CancelOrganizationOwnershipTransferRequest,
+      O: CancelOrganizationOwnershipTransferResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,476 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/ownership.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.OwnershipTransferStatus
+ */
+export enum OwnershipTransferStatus {
+  /**
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for the new owner to accept
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * Cancelled, or replaced by a newer transfer
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_CANCELLED = 3;
+   */
+  CANCELLED = 3,
+
+  /**
+   * Not accepted before expires_at
+   *
+   * @generated from enum value: OWNERSHIP_TRANSFER_STATUS_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(OwnershipTransferStatus)
+proto3.util.setEnumType(OwnershipTransferStatus, "libops.v1.OwnershipTransferStatus", [
+  { no: 0, name: "OWNERSHIP_TRANSFER_STATUS_UNSPECIFIED" },
+  { no: 1, name: "OWNERSHIP_TRANSFER_STATUS_PENDING" },
+  { no: 2, name: "OWNERSHIP_TRANSFER_STATUS_ACCEPTED" },
+  { no: 3, name: "OWNERSHIP_TRANSFER_STATUS_CANCELLED" },
+  { no: 4, name: "OWNERSHIP_TRANSFER_STATUS_EXPIRED" },
+]);
+
+/**
+ * @generated from message libops.v1.OwnershipTransfer
+ */
+export class OwnershipTransfer extends Message<OwnershipTransfer> {
+  /**
+   * UUID
+   *
+   * @generated from field: string transfer_id = 1;
+   */
+  transferId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * UUID of the owner handing the organization over
+   *
+   * @generated from field: string from_account_id = 3;
+   */
+  fromAccountId = "";
+
+  /**
+   * @generated from field: string from_email = 4;
+   */
+  fromEmail = "";
+
+  /**
+   * UUID of the member taking it over
+   *
+   * @generated from field: string to_account_id = 5;
+   */
+  toAccountId = "";
+
+  /**
+   * @generated from field: string to_email = 6;
+   */
+  toEmail = "";
+
+  /**
+   * @generated from field: libops.v1.OwnershipTransferStatus status = 7;
+   */
+  status = OwnershipTransferStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 expires_at = 8;
+   */
+  expiresAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 9;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until accepted
+   *
+   * @generated from field: int64 accepted_at = 10;
+   */
+  acceptedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<OwnershipTransfer>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.OwnershipTransfer";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "from_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "to_account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "to_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(OwnershipTransferStatus) },
+    { no: 8, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "accepted_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OwnershipTransfer {
+    return new OwnershipTransfer().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OwnershipTransfer {
+    return new OwnershipTransfer().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OwnershipTransfer {
+    return new OwnershipTransfer().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OwnershipTransfer | PlainMessage<OwnershipTransfer> | undefined, b: OwnershipTransfer | PlainMessage<OwnershipTransfer> | undefined): boolean {
+    return proto3.util.equals(OwnershipTransfer, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TransferOrganizationOwnershipRequest
+ */
+export class TransferOrganizationOwnershipRequest extends Message<TransferOrganizationOwnershipRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * UUID of the account to hand the organization to. It must be an active member
+   * of the organization.
+   *
+   * @generated from field: string new_owner_id = 2;
+   */
+  newOwnerId = "";
+
+  constructor(data?: PartialMessage<TransferOrganizationOwnershipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TransferOrganizationOwnershipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "new_owner_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOrganizationOwnershipRequest {
+    return new TransferOrganizationOwnershipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOrganizationOwnershipRequest {
+    return new TransferOrganizationOwnershipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOrganizationOwnershipRequest {
+    return new TransferOrganizationOwnershipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOrganizationOwnershipRequest | PlainMessage<TransferOrganizationOwnershipRequest> | undefined, b: TransferOrganizationOwnershipRequest | PlainMessage<TransferOrganizationOwnershipRequest> | undefined): boolean {
+    return proto3.util.equals(TransferOrganizationOwnershipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.TransferOrganizationOwnershipResponse
+ */
+export class TransferOrganizationOwnershipResponse extends Message<TransferOrganizationOwnershipResponse> {
+  /**
+   * @generated from field: libops.v1.OwnershipTransfer transfer = 1;
+   */
+  transfer?: OwnershipTransfer;
+
+  constructor(data?: PartialMessage<TransferOrganizationOwnershipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TransferOrganizationOwnershipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer", kind: "message", T: OwnershipTransfer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOrganizationOwnershipResponse {
+    return new TransferOrganizationOwnershipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOrganizationOwnershipResponse {
+    return new TransferOrganizationOwnershipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOrganizationOwnershipResponse {
+    return new TransferOrganizationOwnershipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOrganizationOwnershipResponse | PlainMessage<TransferOrganizationOwnershipResponse> | undefined, b: TransferOrganizationOwnershipResponse | PlainMessage<TransferOrganizationOwnershipResponse> | undefined): boolean {
+    return proto3.util.equals(TransferOrganizationOwnershipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationOwnershipTransferRequest
+ */
+export class GetOrganizationOwnershipTransferRequest extends Message<GetOrganizationOwnershipTransferRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<GetOrganizationOwnershipTransferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationOwnershipTransferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationOwnershipTransferRequest {
+    return new GetOrganizationOwnershipTransferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationOwnershipTransferRequest {
+    return new GetOrganizationOwnershipTransferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationOwnershipTransferRequest {
+    return new GetOrganizationOwnershipTransferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationOwnershipTransferRequest | PlainMessage<GetOrganizationOwnershipTransferRequest> | undefined, b: GetOrganizationOwnershipTransferRequest | PlainMessage<GetOrganizationOwnershipTransferRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationOwnershipTransferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetOrganizationOwnershipTransferResponse
+ */
+export class GetOrganizationOwnershipTransferResponse extends Message<GetOrganizationOwnershipTransferResponse> {
+  /**
+   * Unset when no transfer is open
+   *
+   * @generated from field: libops.v1.OwnershipTransfer transfer = 1;
+   */
+  transfer?: OwnershipTransfer;
+
+  constructor(data?: PartialMessage<GetOrganizationOwnershipTransferResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetOrganizationOwnershipTransferResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer", kind: "message", T: OwnershipTransfer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationOwnershipTransferResponse {
+    return new GetOrganizationOwnershipTransferResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationOwnershipTransferResponse {
+    return new GetOrganizationOwnershipTransferResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationOwnershipTransferResponse {
+    return new GetOrganizationOwnershipTransferResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationOwnershipTransferResponse | PlainMessage<GetOrganizationOwnershipTransferResponse> | undefined, b: GetOrganizationOwnershipTransferResponse | PlainMessage<GetOrganizationOwnershipTransferResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationOwnershipTransferResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AcceptOrganizationOwnershipTransferRequest
+ */
+export class AcceptOrganizationOwnershipTransferRequest extends Message<AcceptOrganizationOwnershipTransferRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string transfer_id = 2;
+   */
+  transferId = "";
+
+  constructor(data?: PartialMessage<AcceptOrganizationOwnershipTransferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AcceptOrganizationOwnershipTransferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "transfer_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptOrganizationOwnershipTransferRequest {
+    return new AcceptOrganizationOwnershipTransferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptOrganizationOwnershipTransferRequest {
+    return new AcceptOrganizationOwnershipTransferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptOrganizationOwnershipTransferRequest {
+    return new AcceptOrganizationOwnershipTransferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptOrganizationOwnershipTransferRequest | PlainMessage<AcceptOrganizationOwnershipTransferRequest> | undefined, b: AcceptOrganizationOwnershipTransferRequest | PlainMessage<AcceptOrganizationOwnershipTransferRequest> | undefined): boolean {
+    return proto3.util.equals(AcceptOrganizationOwnershipTransferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.AcceptOrganizationOwnershipTransferResponse
+ */
+export class AcceptOrganizationOwnershipTransferResponse extends Message<AcceptOrganizationOwnershipTransferResponse> {
+  /**
+   * @generated from field: libops.v1.OwnershipTransfer transfer = 1;
+   */
+  transfer?: OwnershipTransfer;
+
+  constructor(data?: PartialMessage<AcceptOrganizationOwnershipTransferResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AcceptOrganizationOwnershipTransferResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer", kind: "message", T: OwnershipTransfer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptOrganizationOwnershipTransferResponse {
+    return new AcceptOrganizationOwnershipTransferResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptOrganizationOwnershipTransferResponse {
+    return new AcceptOrganizationOwnershipTransferResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptOrganizationOwnershipTransferResponse {
+    return new AcceptOrganizationOwnershipTransferResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptOrganizationOwnershipTransferResponse | PlainMessage<AcceptOrganizationOwnershipTransferResponse> | undefined, b: AcceptOrganizationOwnershipTransferResponse | PlainMessage<AcceptOrganizationOwnershipTransferResponse> | undefined): boolean {
+    return proto3.util.equals(AcceptOrganizationOwnershipTransferResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CancelOrganizationOwnershipTransferRequest
+ */
+export class CancelOrganizationOwnershipTransferRequest extends Message<CancelOrganizationOwnershipTransferRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string transfer_id = 2;
+   */
+  transferId = "";
+
+  constructor(data?: PartialMessage<CancelOrganizationOwnershipTransferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CancelOrganizationOwnershipTransferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "transfer_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelOrganizationOwnershipTransferRequest {
+    return new CancelOrganizationOwnershipTransferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelOrganizationOwnershipTransferRequest {
+    return new CancelOrganizationOwnershipTransferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelOrganizationOwnershipTransferRequest {
+    return new CancelOrganizationOwnershipTransferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelOrganizationOwnershipTransferRequest | PlainMessage<CancelOrganizationOwnershipTransferRequest> | undefined, b: CancelOrganizationOwnershipTransferRequest | PlainMessage<CancelOrganizationOwnershipTransferRequest> | undefined): boolean {
+    return proto3.util.equals(CancelOrganizationOwnershipTransferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CancelOrganizationOwnershipTransferResponse
+ */
+export class CancelOrganizationOwnershipTransferResponse extends Message<CancelOrganizationOwnershipTransferResponse> {
+  /**
+   * @generated from field: libops.v1.OwnershipTransfer transfer = 1;
+   */
+  transfer?: OwnershipTransfer;
+
+  constructor(data?: PartialMessage<CancelOrganizationOwnershipTransferResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CancelOrganizationOwnershipTransferResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "transfer", kind: "message", T: OwnershipTransfer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelOrganizationOwnershipTransferResponse {
+    return new CancelOrganizationOwnershipTransferResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelOrganizationOwnershipTransferResponse {
+    return new CancelOrganizationOwnershipTransferResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelOrganizationOwnershipTransferResponse {
+    return new CancelOrganizationOwnershipTransferResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelOrganizationOwnershipTransferResponse | PlainMessage<CancelOrganizationOwnershipTransferResponse> | undefined, b: CancelOrganizationOwnershipTransferResponse | PlainMessage<CancelOrganizationOwnershipTransferResponse> | undefined): boolean {
+    return proto3.util.equals(CancelOrganizationOwnershipTransferResponse, a, b);
+  }
+}
+
@@ -0,0 +1,81 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/policy.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreatePolicyRequest, CreatePolicyResponse, DeletePolicyRequest, ListPoliciesRequest, ListPoliciesResponse, ListPolicyViolationsRequest, ListPolicyViolationsResponse, UpdatePolicyRequest, UpdatePolicyResponse } from "./policy_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * PolicyService manages an organization's guardrails: rules changes to the
+ * organization, its projects and its sites are checked against before they
+ * run, such as only letting owners change a production site's secrets. An
+ * enforced policy rejects a change that breaks it and an audited one lets it
+ * through; either way the violation is recorded and owners are notified.
+ *
+ * @generated from service libops.v1.PolicyService
+ */
+export const PolicyService = {
+  typeName: "libops.v1.PolicyService",
+  methods: {
+    /**
+     * Add a policy to an organization. An organization has one policy per rule.
+     *
+     * @generated from rpc libops.v1.PolicyService.CreatePolicy
+     */
+    createPolicy: {
+      name: "CreatePolicy",
+      I: CreatePolicyRequest,
+      O: CreatePolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List an organization's policies
+     *
+     * @generated from rpc libops.v1.PolicyService.ListPolicies
+     */
+    listPolicies: {
+      name: "ListPolicies",
+      I: ListPoliciesRequest,
+      O: ListPoliciesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Change how a policy is enforced, or its deploy window
+     *
+     * @generated from rpc libops.v1.PolicyService.UpdatePolicy
+     */
+    updatePolicy: {
+      name: "UpdatePolicy",
+      I: UpdatePolicyRequest,
+      O: UpdatePolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a policy. Its violations are kept.
+     *
+     * @generated from rpc libops.v1.PolicyService.DeletePolicy
+     */
+    deletePolicy: {
+      name: "DeletePolicy",
+      I: DeletePolicyRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List changes that broke the organization's policies, newest first
+     *
+     * @generated from rpc libops.v1.PolicyService.ListPolicyViolations
+     */
+    listPolicyViolations: {
+      name: "ListPolicyViolations",
+      I: ListPolicyViolationsRequest,
+      O: ListPolicyViolationsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
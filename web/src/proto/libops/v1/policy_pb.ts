@@ -0,0 +1,749 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/policy.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.PolicyRule
+ */
+export enum PolicyRule {
+  /**
+   * @generated from enum value: POLICY_RULE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Only site owners can create, change or delete a production site's secrets
+   *
+   * @generated from enum value: POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY = 1;
+   */
+  PRODUCTION_SECRETS_OWNER_ONLY = 1,
+
+  /**
+   * Firewall rules can't allow SSH from every address (0.0.0.0/0 or ::/0)
+   *
+   * @generated from enum value: POLICY_RULE_NO_PUBLIC_SSH = 2;
+   */
+  NO_PUBLIC_SSH = 2,
+
+  /**
+   * Production sites only deploy within the policy's deploy window
+   *
+   * @generated from enum value: POLICY_RULE_PRODUCTION_DEPLOY_WINDOW = 3;
+   */
+  PRODUCTION_DEPLOY_WINDOW = 3,
+
+  /**
+   * Only service accounts can create API keys that can make changes. Everyone
+   * else's keys are limited to read scopes.
+   *
+   * @generated from enum value: POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS = 4;
+   */
+  SERVICE_ACCOUNT_WRITE_KEYS = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PolicyRule)
+proto3.util.setEnumType(PolicyRule, "libops.v1.PolicyRule", [
+  { no: 0, name: "POLICY_RULE_UNSPECIFIED" },
+  { no: 1, name: "POLICY_RULE_PRODUCTION_SECRETS_OWNER_ONLY" },
+  { no: 2, name: "POLICY_RULE_NO_PUBLIC_SSH" },
+  { no: 3, name: "POLICY_RULE_PRODUCTION_DEPLOY_WINDOW" },
+  { no: 4, name: "POLICY_RULE_SERVICE_ACCOUNT_WRITE_KEYS" },
+]);
+
+/**
+ * @generated from enum libops.v1.PolicyEnforcement
+ */
+export enum PolicyEnforcement {
+  /**
+   * @generated from enum value: POLICY_ENFORCEMENT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Reject changes that break the policy
+   *
+   * @generated from enum value: POLICY_ENFORCEMENT_ENFORCE = 1;
+   */
+  ENFORCE = 1,
+
+  /**
+   * Let them through, but record the violation
+   *
+   * @generated from enum value: POLICY_ENFORCEMENT_AUDIT = 2;
+   */
+  AUDIT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PolicyEnforcement)
+proto3.util.setEnumType(PolicyEnforcement, "libops.v1.PolicyEnforcement", [
+  { no: 0, name: "POLICY_ENFORCEMENT_UNSPECIFIED" },
+  { no: 1, name: "POLICY_ENFORCEMENT_ENFORCE" },
+  { no: 2, name: "POLICY_ENFORCEMENT_AUDIT" },
+]);
+
+/**
+ * DeployWindow is when production sites can deploy
+ *
+ * @generated from message libops.v1.DeployWindow
+ */
+export class DeployWindow extends Message<DeployWindow> {
+  /**
+   * Lowercase weekdays, e.g. "monday"; empty means Monday to Friday
+   *
+   * @generated from field: repeated string days = 1;
+   */
+  days: string[] = [];
+
+  /**
+   * First hour deploys are allowed, 0-23
+   *
+   * @generated from field: int32 start_hour = 2;
+   */
+  startHour = 0;
+
+  /**
+   * Hour deploys stop being allowed, 1-24; 0 means 24
+   *
+   * @generated from field: int32 end_hour = 3;
+   */
+  endHour = 0;
+
+  /**
+   * IANA time zone, e.g. "America/New_York"; defaults to UTC
+   *
+   * @generated from field: string timezone = 4;
+   */
+  timezone = "";
+
+  constructor(data?: PartialMessage<DeployWindow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeployWindow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "days", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "start_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "end_hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployWindow {
+    return new DeployWindow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployWindow {
+    return new DeployWindow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployWindow {
+    return new DeployWindow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeployWindow | PlainMessage<DeployWindow> | undefined, b: DeployWindow | PlainMessage<DeployWindow> | undefined): boolean {
+    return proto3.util.equals(DeployWindow, a, b);
+  }
+}
+
+/**
+ * Policy is a rule an organization's changes are checked against
+ *
+ * @generated from message libops.v1.Policy
+ */
+export class Policy extends Message<Policy> {
+  /**
+   * @generated from field: string policy_id = 1;
+   */
+  policyId = "";
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.PolicyRule rule = 3;
+   */
+  rule = PolicyRule.UNSPECIFIED;
+
+  /**
+   * @generated from field: libops.v1.PolicyEnforcement enforcement = 4;
+   */
+  enforcement = PolicyEnforcement.UNSPECIFIED;
+
+  /**
+   * Set for POLICY_RULE_PRODUCTION_DEPLOY_WINDOW
+   *
+   * @generated from field: libops.v1.DeployWindow deploy_window = 5;
+   */
+  deployWindow?: DeployWindow;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 7;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Policy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Policy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule", kind: "enum", T: proto3.getEnumType(PolicyRule) },
+    { no: 4, name: "enforcement", kind: "enum", T: proto3.getEnumType(PolicyEnforcement) },
+    { no: 5, name: "deploy_window", kind: "message", T: DeployWindow },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Policy {
+    return new Policy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Policy {
+    return new Policy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Policy {
+    return new Policy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Policy | PlainMessage<Policy> | undefined, b: Policy | PlainMessage<Policy> | undefined): boolean {
+    return proto3.util.equals(Policy, a, b);
+  }
+}
+
+/**
+ * PolicyViolation is a change that broke a policy
+ *
+ * @generated from message libops.v1.PolicyViolation
+ */
+export class PolicyViolation extends Message<PolicyViolation> {
+  /**
+   * @generated from field: string violation_id = 1;
+   */
+  violationId = "";
+
+  /**
+   * Empty once the policy is deleted
+   *
+   * @generated from field: string policy_id = 2;
+   */
+  policyId = "";
+
+  /**
+   * @generated from field: libops.v1.PolicyRule rule = 3;
+   */
+  rule = PolicyRule.UNSPECIFIED;
+
+  /**
+   * Who made the change
+   *
+   * @generated from field: string account_email = 4;
+   */
+  accountEmail = "";
+
+  /**
+   * The RPC, e.g. "/libops.v1.SiteSecretService/CreateSiteSecret"
+   *
+   * @generated from field: string procedure = 5;
+   */
+  procedure = "";
+
+  /**
+   * UUID of the organization, project or site being changed
+   *
+   * @generated from field: string resource_id = 6;
+   */
+  resourceId = "";
+
+  /**
+   * @generated from field: string message = 7;
+   */
+  message = "";
+
+  /**
+   * Whether the change was rejected
+   *
+   * @generated from field: bool blocked = 8;
+   */
+  blocked = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 9;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PolicyViolation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PolicyViolation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "violation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "policy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule", kind: "enum", T: proto3.getEnumType(PolicyRule) },
+    { no: 4, name: "account_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "blocked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PolicyViolation {
+    return new PolicyViolation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PolicyViolation {
+    return new PolicyViolation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PolicyViolation {
+    return new PolicyViolation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PolicyViolation | PlainMessage<PolicyViolation> | undefined, b: PolicyViolation | PlainMessage<PolicyViolation> | undefined): boolean {
+    return proto3.util.equals(PolicyViolation, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreatePolicyRequest
+ */
+export class CreatePolicyRequest extends Message<CreatePolicyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.PolicyRule rule = 2;
+   */
+  rule = PolicyRule.UNSPECIFIED;
+
+  /**
+   * Defaults to POLICY_ENFORCEMENT_ENFORCE
+   *
+   * @generated from field: libops.v1.PolicyEnforcement enforcement = 3;
+   */
+  enforcement = PolicyEnforcement.UNSPECIFIED;
+
+  /**
+   * Required for POLICY_RULE_PRODUCTION_DEPLOY_WINDOW
+   *
+   * @generated from field: libops.v1.DeployWindow deploy_window = 4;
+   */
+  deployWindow?: DeployWindow;
+
+  constructor(data?: PartialMessage<CreatePolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreatePolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule", kind: "enum", T: proto3.getEnumType(PolicyRule) },
+    { no: 3, name: "enforcement", kind: "enum", T: proto3.getEnumType(PolicyEnforcement) },
+    { no: 4, name: "deploy_window", kind: "message", T: DeployWindow },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePolicyRequest {
+    return new CreatePolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePolicyRequest {
+    return new CreatePolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePolicyRequest {
+    return new CreatePolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePolicyRequest | PlainMessage<CreatePolicyRequest> | undefined, b: CreatePolicyRequest | PlainMessage<CreatePolicyRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreatePolicyResponse
+ */
+export class CreatePolicyResponse extends Message<CreatePolicyResponse> {
+  /**
+   * @generated from field: libops.v1.Policy policy = 1;
+   */
+  policy?: Policy;
+
+  constructor(data?: PartialMessage<CreatePolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreatePolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: Policy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePolicyResponse {
+    return new CreatePolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePolicyResponse {
+    return new CreatePolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePolicyResponse {
+    return new CreatePolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePolicyResponse | PlainMessage<CreatePolicyResponse> | undefined, b: CreatePolicyResponse | PlainMessage<CreatePolicyResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPoliciesRequest
+ */
+export class ListPoliciesRequest extends Message<ListPoliciesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListPoliciesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPoliciesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPoliciesRequest {
+    return new ListPoliciesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPoliciesRequest {
+    return new ListPoliciesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPoliciesRequest {
+    return new ListPoliciesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPoliciesRequest | PlainMessage<ListPoliciesRequest> | undefined, b: ListPoliciesRequest | PlainMessage<ListPoliciesRequest> | undefined): boolean {
+    return proto3.util.equals(ListPoliciesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPoliciesResponse
+ */
+export class ListPoliciesResponse extends Message<ListPoliciesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Policy policies = 1;
+   */
+  policies: Policy[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListPoliciesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPoliciesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policies", kind: "message", T: Policy, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPoliciesResponse {
+    return new ListPoliciesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPoliciesResponse {
+    return new ListPoliciesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPoliciesResponse {
+    return new ListPoliciesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPoliciesResponse | PlainMessage<ListPoliciesResponse> | undefined, b: ListPoliciesResponse | PlainMessage<ListPoliciesResponse> | undefined): boolean {
+    return proto3.util.equals(ListPoliciesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdatePolicyRequest
+ */
+export class UpdatePolicyRequest extends Message<UpdatePolicyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string policy_id = 2;
+   */
+  policyId = "";
+
+  /**
+   * Unspecified keeps the current enforcement
+   *
+   * @generated from field: libops.v1.PolicyEnforcement enforcement = 3;
+   */
+  enforcement = PolicyEnforcement.UNSPECIFIED;
+
+  /**
+   * Replaces the deploy window when set
+   *
+   * @generated from field: libops.v1.DeployWindow deploy_window = 4;
+   */
+  deployWindow?: DeployWindow;
+
+  constructor(data?: PartialMessage<UpdatePolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdatePolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "policy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "enforcement", kind: "enum", T: proto3.getEnumType(PolicyEnforcement) },
+    { no: 4, name: "deploy_window", kind: "message", T: DeployWindow },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePolicyRequest {
+    return new UpdatePolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePolicyRequest {
+    return new UpdatePolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePolicyRequest {
+    return new UpdatePolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePolicyRequest | PlainMessage<UpdatePolicyRequest> | undefined, b: UpdatePolicyRequest | PlainMessage<UpdatePolicyRequest> | undefined): boolean {
+    return proto3.util.equals(UpdatePolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdatePolicyResponse
+ */
+export class UpdatePolicyResponse extends Message<UpdatePolicyResponse> {
+  /**
+   * @generated from field: libops.v1.Policy policy = 1;
+   */
+  policy?: Policy;
+
+  constructor(data?: PartialMessage<UpdatePolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdatePolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: Policy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePolicyResponse {
+    return new UpdatePolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePolicyResponse {
+    return new UpdatePolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePolicyResponse {
+    return new UpdatePolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePolicyResponse | PlainMessage<UpdatePolicyResponse> | undefined, b: UpdatePolicyResponse | PlainMessage<UpdatePolicyResponse> | undefined): boolean {
+    return proto3.util.equals(UpdatePolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeletePolicyRequest
+ */
+export class DeletePolicyRequest extends Message<DeletePolicyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string policy_id = 2;
+   */
+  policyId = "";
+
+  constructor(data?: PartialMessage<DeletePolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeletePolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "policy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePolicyRequest {
+    return new DeletePolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePolicyRequest {
+    return new DeletePolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePolicyRequest {
+    return new DeletePolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePolicyRequest | PlainMessage<DeletePolicyRequest> | undefined, b: DeletePolicyRequest | PlainMessage<DeletePolicyRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPolicyViolationsRequest
+ */
+export class ListPolicyViolationsRequest extends Message<ListPolicyViolationsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListPolicyViolationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPolicyViolationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPolicyViolationsRequest {
+    return new ListPolicyViolationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPolicyViolationsRequest {
+    return new ListPolicyViolationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPolicyViolationsRequest {
+    return new ListPolicyViolationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPolicyViolationsRequest | PlainMessage<ListPolicyViolationsRequest> | undefined, b: ListPolicyViolationsRequest | PlainMessage<ListPolicyViolationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPolicyViolationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPolicyViolationsResponse
+ */
+export class ListPolicyViolationsResponse extends Message<ListPolicyViolationsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PolicyViolation violations = 1;
+   */
+  violations: PolicyViolation[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListPolicyViolationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPolicyViolationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "violations", kind: "message", T: PolicyViolation, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPolicyViolationsResponse {
+    return new ListPolicyViolationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPolicyViolationsResponse {
+    return new ListPolicyViolationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPolicyViolationsResponse {
+    return new ListPolicyViolationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPolicyViolationsResponse | PlainMessage<ListPolicyViolationsResponse> | undefined, b: ListPolicyViolationsResponse | PlainMessage<ListPolicyViolationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPolicyViolationsResponse, a, b);
+  }
+}
+
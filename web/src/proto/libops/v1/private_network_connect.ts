@@ -0,0 +1,58 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/private_network.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreatePrivateServiceConnectEndpointRequest, CreatePrivateServiceConnectEndpointResponse, DeletePrivateServiceConnectEndpointRequest, ListPrivateServiceConnectEndpointsRequest, ListPrivateServiceConnectEndpointsResponse } from "./private_network_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * PrivateNetworkService manages an organization's Private Service Connect
+ * endpoints: internal addresses in the organization's network that reach the
+ * LibOps API, orchestrator and SMTP relay without leaving Google's network.
+ * Endpoints are created by the organization's next reconciliation, which
+ * reports the address each one got.
+ *
+ * @generated from service libops.v1.PrivateNetworkService
+ */
+export const PrivateNetworkService = {
+  typeName: "libops.v1.PrivateNetworkService",
+  methods: {
+    /**
+     * List the organization's endpoints
+     *
+     * @generated from rpc libops.v1.PrivateNetworkService.ListPrivateServiceConnectEndpoints
+     */
+    listPrivateServiceConnectEndpoints: {
+      name: "ListPrivateServiceConnectEndpoints",
+      I: ListPrivateServiceConnectEndpointsRequest,
+      O: ListPrivateServiceConnectEndpointsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Provision an endpoint. An organization has at most one per target.
+     *
+     * @generated from rpc libops.v1.PrivateNetworkService.CreatePrivateServiceConnectEndpoint
+     */
+    createPrivateServiceConnectEndpoint: {
+      name: "CreatePrivateServiceConnectEndpoint",
+      I: CreatePrivateServiceConnectEndpointRequest,
+      O: CreatePrivateServiceConnectEndpointResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove an endpoint. It's deleted once the reconciliation removing it reports back.
+     *
+     * @generated from rpc libops.v1.PrivateNetworkService.DeletePrivateServiceConnectEndpoint
+     */
+    deletePrivateServiceConnectEndpoint: {
+      name: "DeletePrivateServiceConnectEndpoint",
+      I: DeletePrivateServiceConnectEndpointRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,389 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/private_network.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * The LibOps service an endpoint connects to
+ *
+ * @generated from enum libops.v1.PrivateServiceConnectTarget
+ */
+export enum PrivateServiceConnectTarget {
+  /**
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The LibOps API, used by terraform runners and site controllers
+   *
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_TARGET_API = 1;
+   */
+  API = 1,
+
+  /**
+   * The orchestrator the organization VM connects to
+   *
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR = 2;
+   */
+  ORCHESTRATOR = 2,
+
+  /**
+   * The relay sites' outbound mail is redirected to
+   *
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY = 3;
+   */
+  SMTP_RELAY = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PrivateServiceConnectTarget)
+proto3.util.setEnumType(PrivateServiceConnectTarget, "libops.v1.PrivateServiceConnectTarget", [
+  { no: 0, name: "PRIVATE_SERVICE_CONNECT_TARGET_UNSPECIFIED" },
+  { no: 1, name: "PRIVATE_SERVICE_CONNECT_TARGET_API" },
+  { no: 2, name: "PRIVATE_SERVICE_CONNECT_TARGET_ORCHESTRATOR" },
+  { no: 3, name: "PRIVATE_SERVICE_CONNECT_TARGET_SMTP_RELAY" },
+]);
+
+/**
+ * @generated from enum libops.v1.PrivateServiceConnectEndpointStatus
+ */
+export enum PrivateServiceConnectEndpointStatus {
+  /**
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a reconciliation to create it
+   *
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING = 1;
+   */
+  PROVISIONING = 1,
+
+  /**
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * Waiting for a reconciliation to remove it
+   *
+   * @generated from enum value: PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING = 3;
+   */
+  DELETING = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PrivateServiceConnectEndpointStatus)
+proto3.util.setEnumType(PrivateServiceConnectEndpointStatus, "libops.v1.PrivateServiceConnectEndpointStatus", [
+  { no: 0, name: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_UNSPECIFIED" },
+  { no: 1, name: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_PROVISIONING" },
+  { no: 2, name: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_ACTIVE" },
+  { no: 3, name: "PRIVATE_SERVICE_CONNECT_ENDPOINT_STATUS_DELETING" },
+]);
+
+/**
+ * @generated from message libops.v1.PrivateServiceConnectEndpoint
+ */
+export class PrivateServiceConnectEndpoint extends Message<PrivateServiceConnectEndpoint> {
+  /**
+   * UUID
+   *
+   * @generated from field: string endpoint_id = 1;
+   */
+  endpointId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectTarget target = 3;
+   */
+  target = PrivateServiceConnectTarget.UNSPECIFIED;
+
+  /**
+   * Address asked for at creation, empty to let the reconciliation pick one
+   *
+   * @generated from field: string requested_ip_address = 4;
+   */
+  requestedIpAddress = "";
+
+  /**
+   * Address the endpoint has, empty until it's active
+   *
+   * @generated from field: string ip_address = 5;
+   */
+  ipAddress = "";
+
+  /**
+   * Name of the forwarding rule in the organization's GCP project
+   *
+   * @generated from field: string forwarding_rule = 6;
+   */
+  forwardingRule = "";
+
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectEndpointStatus status = 7;
+   */
+  status = PrivateServiceConnectEndpointStatus.UNSPECIFIED;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 9;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PrivateServiceConnectEndpoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PrivateServiceConnectEndpoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "target", kind: "enum", T: proto3.getEnumType(PrivateServiceConnectTarget) },
+    { no: 4, name: "requested_ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "forwarding_rule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(PrivateServiceConnectEndpointStatus) },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrivateServiceConnectEndpoint {
+    return new PrivateServiceConnectEndpoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrivateServiceConnectEndpoint {
+    return new PrivateServiceConnectEndpoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrivateServiceConnectEndpoint {
+    return new PrivateServiceConnectEndpoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PrivateServiceConnectEndpoint | PlainMessage<PrivateServiceConnectEndpoint> | undefined, b: PrivateServiceConnectEndpoint | PlainMessage<PrivateServiceConnectEndpoint> | undefined): boolean {
+    return proto3.util.equals(PrivateServiceConnectEndpoint, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPrivateServiceConnectEndpointsRequest
+ */
+export class ListPrivateServiceConnectEndpointsRequest extends Message<ListPrivateServiceConnectEndpointsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<ListPrivateServiceConnectEndpointsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPrivateServiceConnectEndpointsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPrivateServiceConnectEndpointsRequest {
+    return new ListPrivateServiceConnectEndpointsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPrivateServiceConnectEndpointsRequest {
+    return new ListPrivateServiceConnectEndpointsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPrivateServiceConnectEndpointsRequest {
+    return new ListPrivateServiceConnectEndpointsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPrivateServiceConnectEndpointsRequest | PlainMessage<ListPrivateServiceConnectEndpointsRequest> | undefined, b: ListPrivateServiceConnectEndpointsRequest | PlainMessage<ListPrivateServiceConnectEndpointsRequest> | undefined): boolean {
+    return proto3.util.equals(ListPrivateServiceConnectEndpointsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListPrivateServiceConnectEndpointsResponse
+ */
+export class ListPrivateServiceConnectEndpointsResponse extends Message<ListPrivateServiceConnectEndpointsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.PrivateServiceConnectEndpoint endpoints = 1;
+   */
+  endpoints: PrivateServiceConnectEndpoint[] = [];
+
+  constructor(data?: PartialMessage<ListPrivateServiceConnectEndpointsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListPrivateServiceConnectEndpointsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoints", kind: "message", T: PrivateServiceConnectEndpoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPrivateServiceConnectEndpointsResponse {
+    return new ListPrivateServiceConnectEndpointsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPrivateServiceConnectEndpointsResponse {
+    return new ListPrivateServiceConnectEndpointsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPrivateServiceConnectEndpointsResponse {
+    return new ListPrivateServiceConnectEndpointsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListPrivateServiceConnectEndpointsResponse | PlainMessage<ListPrivateServiceConnectEndpointsResponse> | undefined, b: ListPrivateServiceConnectEndpointsResponse | PlainMessage<ListPrivateServiceConnectEndpointsResponse> | undefined): boolean {
+    return proto3.util.equals(ListPrivateServiceConnectEndpointsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreatePrivateServiceConnectEndpointRequest
+ */
+export class CreatePrivateServiceConnectEndpointRequest extends Message<CreatePrivateServiceConnectEndpointRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectTarget target = 2;
+   */
+  target = PrivateServiceConnectTarget.UNSPECIFIED;
+
+  /**
+   * Internal address to reserve in the organization's subnet (10.128.0.0/20).
+   * Leave empty to have one assigned.
+   *
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress = "";
+
+  constructor(data?: PartialMessage<CreatePrivateServiceConnectEndpointRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreatePrivateServiceConnectEndpointRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target", kind: "enum", T: proto3.getEnumType(PrivateServiceConnectTarget) },
+    { no: 3, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePrivateServiceConnectEndpointRequest {
+    return new CreatePrivateServiceConnectEndpointRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePrivateServiceConnectEndpointRequest {
+    return new CreatePrivateServiceConnectEndpointRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePrivateServiceConnectEndpointRequest {
+    return new CreatePrivateServiceConnectEndpointRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePrivateServiceConnectEndpointRequest | PlainMessage<CreatePrivateServiceConnectEndpointRequest> | undefined, b: CreatePrivateServiceConnectEndpointRequest | PlainMessage<CreatePrivateServiceConnectEndpointRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePrivateServiceConnectEndpointRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreatePrivateServiceConnectEndpointResponse
+ */
+export class CreatePrivateServiceConnectEndpointResponse extends Message<CreatePrivateServiceConnectEndpointResponse> {
+  /**
+   * @generated from field: libops.v1.PrivateServiceConnectEndpoint endpoint = 1;
+   */
+  endpoint?: PrivateServiceConnectEndpoint;
+
+  constructor(data?: PartialMessage<CreatePrivateServiceConnectEndpointResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreatePrivateServiceConnectEndpointResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint", kind: "message", T: PrivateServiceConnectEndpoint },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePrivateServiceConnectEndpointResponse {
+    return new CreatePrivateServiceConnectEndpointResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePrivateServiceConnectEndpointResponse {
+    return new CreatePrivateServiceConnectEndpointResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePrivateServiceConnectEndpointResponse {
+    return new CreatePrivateServiceConnectEndpointResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePrivateServiceConnectEndpointResponse | PlainMessage<CreatePrivateServiceConnectEndpointResponse> | undefined, b: CreatePrivateServiceConnectEndpointResponse | PlainMessage<CreatePrivateServiceConnectEndpointResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePrivateServiceConnectEndpointResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeletePrivateServiceConnectEndpointRequest
+ */
+export class DeletePrivateServiceConnectEndpointRequest extends Message<DeletePrivateServiceConnectEndpointRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string endpoint_id = 2;
+   */
+  endpointId = "";
+
+  constructor(data?: PartialMessage<DeletePrivateServiceConnectEndpointRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeletePrivateServiceConnectEndpointRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "endpoint_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePrivateServiceConnectEndpointRequest {
+    return new DeletePrivateServiceConnectEndpointRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePrivateServiceConnectEndpointRequest {
+    return new DeletePrivateServiceConnectEndpointRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePrivateServiceConnectEndpointRequest {
+    return new DeletePrivateServiceConnectEndpointRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePrivateServiceConnectEndpointRequest | PlainMessage<DeletePrivateServiceConnectEndpointRequest> | undefined, b: DeletePrivateServiceConnectEndpointRequest | PlainMessage<DeletePrivateServiceConnectEndpointRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePrivateServiceConnectEndpointRequest, a, b);
+  }
+}
+
@@ -0,0 +1,32 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/reconciliation.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetSiteReconciliationTimelineRequest, GetSiteReconciliationTimelineResponse } from "./reconciliation_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * SiteReconciliationService reports how changes reached a site's VM, so users
+ * can tell whether a secrets or firewall change actually landed.
+ *
+ * @generated from service libops.v1.SiteReconciliationService
+ */
+export const SiteReconciliationService = {
+  typeName: "libops.v1.SiteReconciliationService",
+  methods: {
+    /**
+     * Get the latest reconciliations of each type for a site, newest first
+     *
+     * @generated from rpc libops.v1.SiteReconciliationService.GetSiteReconciliationTimeline
+     */
+    getSiteReconciliationTimeline: {
+      name: "GetSiteReconciliationTimeline",
+      I: GetSiteReconciliationTimelineRequest,
+      O: GetSiteReconciliationTimelineResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
@@ -0,0 +1,247 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/reconciliation.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * ReconciliationTimeline is the latest runs of one type of reconciliation
+ *
+ * @generated from message libops.v1.ReconciliationTimeline
+ */
+export class ReconciliationTimeline extends Message<ReconciliationTimeline> {
+  /**
+   * "ssh_keys", "secrets", "firewall" or "deployment"
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: repeated libops.v1.ReconciliationRun runs = 2;
+   */
+  runs: ReconciliationRun[] = [];
+
+  constructor(data?: PartialMessage<ReconciliationTimeline>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconciliationTimeline";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "runs", kind: "message", T: ReconciliationRun, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconciliationTimeline {
+    return new ReconciliationTimeline().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconciliationTimeline {
+    return new ReconciliationTimeline().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconciliationTimeline {
+    return new ReconciliationTimeline().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconciliationTimeline | PlainMessage<ReconciliationTimeline> | undefined, b: ReconciliationTimeline | PlainMessage<ReconciliationTimeline> | undefined): boolean {
+    return proto3.util.equals(ReconciliationTimeline, a, b);
+  }
+}
+
+/**
+ * ReconciliationRun is one attempt to apply changes to the site. Full
+ * reconciliations apply every type, so they appear in each type's timeline.
+ *
+ * @generated from message libops.v1.ReconciliationRun
+ */
+export class ReconciliationRun extends Message<ReconciliationRun> {
+  /**
+   * @generated from field: string run_id = 1;
+   */
+  runId = "";
+
+  /**
+   * "pending", "running", "succeeded" or "failed"
+   *
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 started_at = 3;
+   */
+  startedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp; 0 until the run finishes
+   *
+   * @generated from field: int64 completed_at = 4;
+   */
+  completedAt = protoInt64.zero;
+
+  /**
+   * 0 until the run finishes
+   *
+   * @generated from field: int64 duration_seconds = 5;
+   */
+  durationSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage = "";
+
+  /**
+   * The run applied every type
+   *
+   * @generated from field: bool full = 7;
+   */
+  full = false;
+
+  /**
+   * 1 for the first try, counting up with each automatic retry
+   *
+   * @generated from field: int32 attempt = 8;
+   */
+  attempt = 0;
+
+  /**
+   * Unix timestamp; 0 unless a retry of the failed run is scheduled
+   *
+   * @generated from field: int64 next_retry_at = 9;
+   */
+  nextRetryAt = protoInt64.zero;
+
+  /**
+   * The run failed on its last attempt and won't be retried
+   *
+   * @generated from field: bool retries_exhausted = 10;
+   */
+  retriesExhausted = false;
+
+  constructor(data?: PartialMessage<ReconciliationRun>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReconciliationRun";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "started_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "completed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "next_retry_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "retries_exhausted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconciliationRun {
+    return new ReconciliationRun().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconciliationRun {
+    return new ReconciliationRun().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconciliationRun {
+    return new ReconciliationRun().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReconciliationRun | PlainMessage<ReconciliationRun> | undefined, b: ReconciliationRun | PlainMessage<ReconciliationRun> | undefined): boolean {
+    return proto3.util.equals(ReconciliationRun, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteReconciliationTimelineRequest
+ */
+export class GetSiteReconciliationTimelineRequest extends Message<GetSiteReconciliationTimelineRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Runs per type; defaults to 10, at most 50
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<GetSiteReconciliationTimelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteReconciliationTimelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteReconciliationTimelineRequest {
+    return new GetSiteReconciliationTimelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteReconciliationTimelineRequest {
+    return new GetSiteReconciliationTimelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteReconciliationTimelineRequest {
+    return new GetSiteReconciliationTimelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteReconciliationTimelineRequest | PlainMessage<GetSiteReconciliationTimelineRequest> | undefined, b: GetSiteReconciliationTimelineRequest | PlainMessage<GetSiteReconciliationTimelineRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteReconciliationTimelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteReconciliationTimelineResponse
+ */
+export class GetSiteReconciliationTimelineResponse extends Message<GetSiteReconciliationTimelineResponse> {
+  /**
+   * @generated from field: repeated libops.v1.ReconciliationTimeline timelines = 1;
+   */
+  timelines: ReconciliationTimeline[] = [];
+
+  constructor(data?: PartialMessage<GetSiteReconciliationTimelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteReconciliationTimelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timelines", kind: "message", T: ReconciliationTimeline, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteReconciliationTimelineResponse {
+    return new GetSiteReconciliationTimelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteReconciliationTimelineResponse {
+    return new GetSiteReconciliationTimelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteReconciliationTimelineResponse {
+    return new GetSiteReconciliationTimelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteReconciliationTimelineResponse | PlainMessage<GetSiteReconciliationTimelineResponse> | undefined, b: GetSiteReconciliationTimelineResponse | PlainMessage<GetSiteReconciliationTimelineResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteReconciliationTimelineResponse, a, b);
+  }
+}
+
@@ -0,0 +1,68 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/redirect.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateRedirectRequest, CreateRedirectResponse, DeleteRedirectRequest, ListRedirectsRequest, ListRedirectsResponse, UpdateRedirectRequest, UpdateRedirectResponse } from "./redirect_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * RedirectService manages a site's HTTP redirects. The site's controller
+ * renders them into its reverse proxy, so an institution moving URLs doesn't
+ * have to change its application. Changes reach the site with its next
+ * reconciliation, which each change queues.
+ *
+ * @generated from service libops.v1.RedirectService
+ */
+export const RedirectService = {
+  typeName: "libops.v1.RedirectService",
+  methods: {
+    /**
+     * List a site's redirects
+     *
+     * @generated from rpc libops.v1.RedirectService.ListRedirects
+     */
+    listRedirects: {
+      name: "ListRedirects",
+      I: ListRedirectsRequest,
+      O: ListRedirectsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Add a redirect to a site. A site has one redirect per host and path.
+     *
+     * @generated from rpc libops.v1.RedirectService.CreateRedirect
+     */
+    createRedirect: {
+      name: "CreateRedirect",
+      I: CreateRedirectRequest,
+      O: CreateRedirectResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Change a redirect
+     *
+     * @generated from rpc libops.v1.RedirectService.UpdateRedirect
+     */
+    updateRedirect: {
+      name: "UpdateRedirect",
+      I: UpdateRedirectRequest,
+      O: UpdateRedirectResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a redirect from a site
+     *
+     * @generated from rpc libops.v1.RedirectService.DeleteRedirect
+     */
+    deleteRedirect: {
+      name: "DeleteRedirect",
+      I: DeleteRedirectRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
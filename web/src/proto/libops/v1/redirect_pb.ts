@@ -0,0 +1,473 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/redirect.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
+
+/**
+ * Redirect sends requests for a host and path somewhere else
+ *
+ * @generated from message libops.v1.Redirect
+ */
+export class Redirect extends Message<Redirect> {
+  /**
+   * @generated from field: string redirect_id = 1;
+   */
+  redirectId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * Lowercase hostname to match; empty matches every host
+   *
+   * @generated from field: string from_host = 3;
+   */
+  fromHost = "";
+
+  /**
+   * Path to match, e.g. "/old"; a trailing * matches every path with that prefix
+   *
+   * @generated from field: string from_path = 4;
+   */
+  fromPath = "";
+
+  /**
+   * Path or absolute http(s) URL to send requests to. When from_path ends in
+   * * and to does too, the part of the path the * matched replaces it.
+   *
+   * @generated from field: string to = 5;
+   */
+  to = "";
+
+  /**
+   * 301, 302, 307 or 308
+   *
+   * @generated from field: int32 status_code = 6;
+   */
+  statusCode = 0;
+
+  /**
+   * Append the request's query string to the target
+   *
+   * @generated from field: bool preserve_query = 7;
+   */
+  preserveQuery = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 9;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Redirect>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Redirect";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirect_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "from_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "preserve_query", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Redirect {
+    return new Redirect().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Redirect {
+    return new Redirect().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Redirect {
+    return new Redirect().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Redirect | PlainMessage<Redirect> | undefined, b: Redirect | PlainMessage<Redirect> | undefined): boolean {
+    return proto3.util.equals(Redirect, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListRedirectsRequest
+ */
+export class ListRedirectsRequest extends Message<ListRedirectsRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListRedirectsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRedirectsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRedirectsRequest {
+    return new ListRedirectsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRedirectsRequest {
+    return new ListRedirectsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRedirectsRequest {
+    return new ListRedirectsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRedirectsRequest | PlainMessage<ListRedirectsRequest> | undefined, b: ListRedirectsRequest | PlainMessage<ListRedirectsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRedirectsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListRedirectsResponse
+ */
+export class ListRedirectsResponse extends Message<ListRedirectsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Redirect redirects = 1;
+   */
+  redirects: Redirect[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListRedirectsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRedirectsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirects", kind: "message", T: Redirect, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRedirectsResponse {
+    return new ListRedirectsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRedirectsResponse {
+    return new ListRedirectsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRedirectsResponse {
+    return new ListRedirectsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRedirectsResponse | PlainMessage<ListRedirectsResponse> | undefined, b: ListRedirectsResponse | PlainMessage<ListRedirectsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRedirectsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateRedirectRequest
+ */
+export class CreateRedirectRequest extends Message<CreateRedirectRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string from_host = 2;
+   */
+  fromHost = "";
+
+  /**
+   * @generated from field: string from_path = 3;
+   */
+  fromPath = "";
+
+  /**
+   * @generated from field: string to = 4;
+   */
+  to = "";
+
+  /**
+   * Defaults to 301
+   *
+   * @generated from field: int32 status_code = 5;
+   */
+  statusCode = 0;
+
+  /**
+   * Defaults to true
+   *
+   * @generated from field: optional bool preserve_query = 6;
+   */
+  preserveQuery?: boolean;
+
+  constructor(data?: PartialMessage<CreateRedirectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateRedirectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "preserve_query", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRedirectRequest {
+    return new CreateRedirectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRedirectRequest {
+    return new CreateRedirectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRedirectRequest {
+    return new CreateRedirectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRedirectRequest | PlainMessage<CreateRedirectRequest> | undefined, b: CreateRedirectRequest | PlainMessage<CreateRedirectRequest> | undefined): boolean {
+    return proto3.util.equals(CreateRedirectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateRedirectResponse
+ */
+export class CreateRedirectResponse extends Message<CreateRedirectResponse> {
+  /**
+   * @generated from field: libops.v1.Redirect redirect = 1;
+   */
+  redirect?: Redirect;
+
+  constructor(data?: PartialMessage<CreateRedirectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateRedirectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirect", kind: "message", T: Redirect },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRedirectResponse {
+    return new CreateRedirectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRedirectResponse {
+    return new CreateRedirectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRedirectResponse {
+    return new CreateRedirectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRedirectResponse | PlainMessage<CreateRedirectResponse> | undefined, b: CreateRedirectResponse | PlainMessage<CreateRedirectResponse> | undefined): boolean {
+    return proto3.util.equals(CreateRedirectResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateRedirectRequest
+ */
+export class UpdateRedirectRequest extends Message<UpdateRedirectRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string redirect_id = 2;
+   */
+  redirectId = "";
+
+  /**
+   * @generated from field: optional string from_host = 3;
+   */
+  fromHost?: string;
+
+  /**
+   * @generated from field: optional string from_path = 4;
+   */
+  fromPath?: string;
+
+  /**
+   * @generated from field: optional string to = 5;
+   */
+  to?: string;
+
+  /**
+   * @generated from field: optional int32 status_code = 6;
+   */
+  statusCode?: number;
+
+  /**
+   * @generated from field: optional bool preserve_query = 7;
+   */
+  preserveQuery?: boolean;
+
+  /**
+   * Paths: from_host, from_path, to, status_code, preserve_query.
+   * Without a mask every set field is applied.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 8;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateRedirectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateRedirectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "redirect_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from_host", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "from_path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 7, name: "preserve_query", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 8, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateRedirectRequest {
+    return new UpdateRedirectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateRedirectRequest {
+    return new UpdateRedirectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateRedirectRequest {
+    return new UpdateRedirectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateRedirectRequest | PlainMessage<UpdateRedirectRequest> | undefined, b: UpdateRedirectRequest | PlainMessage<UpdateRedirectRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateRedirectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateRedirectResponse
+ */
+export class UpdateRedirectResponse extends Message<UpdateRedirectResponse> {
+  /**
+   * @generated from field: libops.v1.Redirect redirect = 1;
+   */
+  redirect?: Redirect;
+
+  constructor(data?: PartialMessage<UpdateRedirectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateRedirectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirect", kind: "message", T: Redirect },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateRedirectResponse {
+    return new UpdateRedirectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateRedirectResponse {
+    return new UpdateRedirectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateRedirectResponse {
+    return new UpdateRedirectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateRedirectResponse | PlainMessage<UpdateRedirectResponse> | undefined, b: UpdateRedirectResponse | PlainMessage<UpdateRedirectResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateRedirectResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteRedirectRequest
+ */
+export class DeleteRedirectRequest extends Message<DeleteRedirectRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string redirect_id = 2;
+   */
+  redirectId = "";
+
+  constructor(data?: PartialMessage<DeleteRedirectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteRedirectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "redirect_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRedirectRequest {
+    return new DeleteRedirectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRedirectRequest {
+    return new DeleteRedirectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRedirectRequest {
+    return new DeleteRedirectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRedirectRequest | PlainMessage<DeleteRedirectRequest> | undefined, b: DeleteRedirectRequest | PlainMessage<DeleteRedirectRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRedirectRequest, a, b);
+  }
+}
+
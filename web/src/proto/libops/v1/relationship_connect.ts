@@ -0,0 +1,91 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/relationship.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ApproveRelationshipRequest, ApproveRelationshipResponse, ListRelationshipsRequest, ListRelationshipsResponse, RejectRelationshipRequest, RejectRelationshipResponse, RequestRelationshipRequest, RequestRelationshipResponse, RevokeRelationshipRequest, RevokeRelationshipResponse, UpdateRelationshipRequest, UpdateRelationshipResponse } from "./relationship_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * RelationshipService delegates access to an organization to the members of
+ * another, such as a support vendor managing a library's organization. The
+ * organization wanting access (the source) requests it; the organization
+ * granting it (the target) approves or rejects the request and caps the role
+ * the source's members get. Either side can revoke the relationship.
+ *
+ * @generated from service libops.v1.RelationshipService
+ */
+export const RelationshipService = {
+  typeName: "libops.v1.RelationshipService",
+  methods: {
+    /**
+     * Request access to another organization for this organization's members
+     *
+     * @generated from rpc libops.v1.RelationshipService.RequestRelationship
+     */
+    requestRelationship: {
+      name: "RequestRelationship",
+      I: RequestRelationshipRequest,
+      O: RequestRelationshipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the relationships the organization is either side of
+     *
+     * @generated from rpc libops.v1.RelationshipService.ListRelationships
+     */
+    listRelationships: {
+      name: "ListRelationships",
+      I: ListRelationshipsRequest,
+      O: ListRelationshipsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Approve a request for access to the organization
+     *
+     * @generated from rpc libops.v1.RelationshipService.ApproveRelationship
+     */
+    approveRelationship: {
+      name: "ApproveRelationship",
+      I: ApproveRelationshipRequest,
+      O: ApproveRelationshipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reject a request for access to the organization
+     *
+     * @generated from rpc libops.v1.RelationshipService.RejectRelationship
+     */
+    rejectRelationship: {
+      name: "RejectRelationship",
+      I: RejectRelationshipRequest,
+      O: RejectRelationshipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Change the role the other organization's members get in the organization,
+     * or when their access ends
+     *
+     * @generated from rpc libops.v1.RelationshipService.UpdateRelationship
+     */
+    updateRelationship: {
+      name: "UpdateRelationship",
+      I: UpdateRelationshipRequest,
+      O: UpdateRelationshipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Revoke a relationship, or withdraw a request, from either side
+     *
+     * @generated from rpc libops.v1.RelationshipService.RevokeRelationship
+     */
+    revokeRelationship: {
+      name: "RevokeRelationship",
+      I: RevokeRelationshipRequest,
+      O: RevokeRelationshipResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,708 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/relationship.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.RelationshipStatus
+ */
+export enum RelationshipStatus {
+  /**
+   * @generated from enum value: RELATIONSHIP_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for the target organization to approve it
+   *
+   * @generated from enum value: RELATIONSHIP_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The source organization's members have access
+   *
+   * @generated from enum value: RELATIONSHIP_STATUS_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * @generated from enum value: RELATIONSHIP_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * @generated from enum value: RELATIONSHIP_STATUS_REVOKED = 4;
+   */
+  REVOKED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RelationshipStatus)
+proto3.util.setEnumType(RelationshipStatus, "libops.v1.RelationshipStatus", [
+  { no: 0, name: "RELATIONSHIP_STATUS_UNSPECIFIED" },
+  { no: 1, name: "RELATIONSHIP_STATUS_PENDING" },
+  { no: 2, name: "RELATIONSHIP_STATUS_APPROVED" },
+  { no: 3, name: "RELATIONSHIP_STATUS_REJECTED" },
+  { no: 4, name: "RELATIONSHIP_STATUS_REVOKED" },
+]);
+
+/**
+ * @generated from message libops.v1.Relationship
+ */
+export class Relationship extends Message<Relationship> {
+  /**
+   * UUID
+   *
+   * @generated from field: string relationship_id = 1;
+   */
+  relationshipId = "";
+
+  /**
+   * UUID of the organization whose members get access
+   *
+   * @generated from field: string source_organization_id = 2;
+   */
+  sourceOrganizationId = "";
+
+  /**
+   * @generated from field: string source_organization_name = 3;
+   */
+  sourceOrganizationName = "";
+
+  /**
+   * UUID of the organization they get access to
+   *
+   * @generated from field: string target_organization_id = 4;
+   */
+  targetOrganizationId = "";
+
+  /**
+   * @generated from field: string target_organization_name = 5;
+   */
+  targetOrganizationName = "";
+
+  /**
+   * "owner", "developer", "read"
+   *
+   * @generated from field: string max_role = 6;
+   */
+  maxRole = "";
+
+  /**
+   * @generated from field: libops.v1.RelationshipStatus status = 7;
+   */
+  status = RelationshipStatus.UNSPECIFIED;
+
+  /**
+   * Email of the account that requested it
+   *
+   * @generated from field: string requested_by = 8;
+   */
+  requestedBy = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 9;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp, 0 until approved, rejected or revoked
+   *
+   * @generated from field: int64 resolved_at = 10;
+   */
+  resolvedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp the relationship is revoked at, 0 if it doesn't expire
+   *
+   * @generated from field: int64 expires_at = 11;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Relationship>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.Relationship";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "target_organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "max_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(RelationshipStatus) },
+    { no: 8, name: "requested_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "resolved_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Relationship {
+    return new Relationship().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Relationship {
+    return new Relationship().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Relationship {
+    return new Relationship().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Relationship | PlainMessage<Relationship> | undefined, b: Relationship | PlainMessage<Relationship> | undefined): boolean {
+    return proto3.util.equals(Relationship, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RequestRelationshipRequest
+ */
+export class RequestRelationshipRequest extends Message<RequestRelationshipRequest> {
+  /**
+   * The organization requesting access
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * The organization to get access to
+   *
+   * @generated from field: string target_organization_id = 2;
+   */
+  targetOrganizationId = "";
+
+  /**
+   * Highest role the organization's members get in the target organization:
+   * "owner", "developer" or "read". Members get their own role up to it.
+   *
+   * @generated from field: string max_role = 3;
+   */
+  maxRole = "";
+
+  constructor(data?: PartialMessage<RequestRelationshipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestRelationshipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestRelationshipRequest {
+    return new RequestRelationshipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestRelationshipRequest {
+    return new RequestRelationshipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestRelationshipRequest {
+    return new RequestRelationshipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestRelationshipRequest | PlainMessage<RequestRelationshipRequest> | undefined, b: RequestRelationshipRequest | PlainMessage<RequestRelationshipRequest> | undefined): boolean {
+    return proto3.util.equals(RequestRelationshipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RequestRelationshipResponse
+ */
+export class RequestRelationshipResponse extends Message<RequestRelationshipResponse> {
+  /**
+   * @generated from field: libops.v1.Relationship relationship = 1;
+   */
+  relationship?: Relationship;
+
+  constructor(data?: PartialMessage<RequestRelationshipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RequestRelationshipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship", kind: "message", T: Relationship },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestRelationshipResponse {
+    return new RequestRelationshipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestRelationshipResponse {
+    return new RequestRelationshipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestRelationshipResponse {
+    return new RequestRelationshipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestRelationshipResponse | PlainMessage<RequestRelationshipResponse> | undefined, b: RequestRelationshipResponse | PlainMessage<RequestRelationshipResponse> | undefined): boolean {
+    return proto3.util.equals(RequestRelationshipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListRelationshipsRequest
+ */
+export class ListRelationshipsRequest extends Message<ListRelationshipsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListRelationshipsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRelationshipsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRelationshipsRequest {
+    return new ListRelationshipsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRelationshipsRequest {
+    return new ListRelationshipsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRelationshipsRequest {
+    return new ListRelationshipsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRelationshipsRequest | PlainMessage<ListRelationshipsRequest> | undefined, b: ListRelationshipsRequest | PlainMessage<ListRelationshipsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRelationshipsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListRelationshipsResponse
+ */
+export class ListRelationshipsResponse extends Message<ListRelationshipsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.Relationship relationships = 1;
+   */
+  relationships: Relationship[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListRelationshipsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListRelationshipsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationships", kind: "message", T: Relationship, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRelationshipsResponse {
+    return new ListRelationshipsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRelationshipsResponse {
+    return new ListRelationshipsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRelationshipsResponse {
+    return new ListRelationshipsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRelationshipsResponse | PlainMessage<ListRelationshipsResponse> | undefined, b: ListRelationshipsResponse | PlainMessage<ListRelationshipsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRelationshipsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveRelationshipRequest
+ */
+export class ApproveRelationshipRequest extends Message<ApproveRelationshipRequest> {
+  /**
+   * The target organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string relationship_id = 2;
+   */
+  relationshipId = "";
+
+  /**
+   * Optional; lowers the requested max role
+   *
+   * @generated from field: string max_role = 3;
+   */
+  maxRole = "";
+
+  /**
+   * Optional Unix timestamp to revoke the relationship at
+   *
+   * @generated from field: int64 expires_at = 4;
+   */
+  expiresAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ApproveRelationshipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveRelationshipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "relationship_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveRelationshipRequest {
+    return new ApproveRelationshipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveRelationshipRequest {
+    return new ApproveRelationshipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveRelationshipRequest {
+    return new ApproveRelationshipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveRelationshipRequest | PlainMessage<ApproveRelationshipRequest> | undefined, b: ApproveRelationshipRequest | PlainMessage<ApproveRelationshipRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveRelationshipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveRelationshipResponse
+ */
+export class ApproveRelationshipResponse extends Message<ApproveRelationshipResponse> {
+  /**
+   * @generated from field: libops.v1.Relationship relationship = 1;
+   */
+  relationship?: Relationship;
+
+  constructor(data?: PartialMessage<ApproveRelationshipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveRelationshipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship", kind: "message", T: Relationship },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveRelationshipResponse {
+    return new ApproveRelationshipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveRelationshipResponse {
+    return new ApproveRelationshipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveRelationshipResponse {
+    return new ApproveRelationshipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveRelationshipResponse | PlainMessage<ApproveRelationshipResponse> | undefined, b: ApproveRelationshipResponse | PlainMessage<ApproveRelationshipResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveRelationshipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RejectRelationshipRequest
+ */
+export class RejectRelationshipRequest extends Message<RejectRelationshipRequest> {
+  /**
+   * The target organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string relationship_id = 2;
+   */
+  relationshipId = "";
+
+  constructor(data?: PartialMessage<RejectRelationshipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RejectRelationshipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "relationship_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectRelationshipRequest {
+    return new RejectRelationshipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectRelationshipRequest {
+    return new RejectRelationshipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectRelationshipRequest {
+    return new RejectRelationshipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectRelationshipRequest | PlainMessage<RejectRelationshipRequest> | undefined, b: RejectRelationshipRequest | PlainMessage<RejectRelationshipRequest> | undefined): boolean {
+    return proto3.util.equals(RejectRelationshipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RejectRelationshipResponse
+ */
+export class RejectRelationshipResponse extends Message<RejectRelationshipResponse> {
+  /**
+   * @generated from field: libops.v1.Relationship relationship = 1;
+   */
+  relationship?: Relationship;
+
+  constructor(data?: PartialMessage<RejectRelationshipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RejectRelationshipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship", kind: "message", T: Relationship },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectRelationshipResponse {
+    return new RejectRelationshipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectRelationshipResponse {
+    return new RejectRelationshipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectRelationshipResponse {
+    return new RejectRelationshipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectRelationshipResponse | PlainMessage<RejectRelationshipResponse> | undefined, b: RejectRelationshipResponse | PlainMessage<RejectRelationshipResponse> | undefined): boolean {
+    return proto3.util.equals(RejectRelationshipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateRelationshipRequest
+ */
+export class UpdateRelationshipRequest extends Message<UpdateRelationshipRequest> {
+  /**
+   * The target organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string relationship_id = 2;
+   */
+  relationshipId = "";
+
+  /**
+   * Empty keeps the current max role
+   *
+   * @generated from field: string max_role = 3;
+   */
+  maxRole = "";
+
+  /**
+   * Unix timestamp to revoke the relationship at, 0 to keep it indefinitely
+   *
+   * @generated from field: optional int64 expires_at = 4;
+   */
+  expiresAt?: bigint;
+
+  constructor(data?: PartialMessage<UpdateRelationshipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateRelationshipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "relationship_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expires_at", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateRelationshipRequest {
+    return new UpdateRelationshipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateRelationshipRequest {
+    return new UpdateRelationshipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateRelationshipRequest {
+    return new UpdateRelationshipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateRelationshipRequest | PlainMessage<UpdateRelationshipRequest> | undefined, b: UpdateRelationshipRequest | PlainMessage<UpdateRelationshipRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateRelationshipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateRelationshipResponse
+ */
+export class UpdateRelationshipResponse extends Message<UpdateRelationshipResponse> {
+  /**
+   * @generated from field: libops.v1.Relationship relationship = 1;
+   */
+  relationship?: Relationship;
+
+  constructor(data?: PartialMessage<UpdateRelationshipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateRelationshipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship", kind: "message", T: Relationship },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateRelationshipResponse {
+    return new UpdateRelationshipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateRelationshipResponse {
+    return new UpdateRelationshipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateRelationshipResponse {
+    return new UpdateRelationshipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateRelationshipResponse | PlainMessage<UpdateRelationshipResponse> | undefined, b: UpdateRelationshipResponse | PlainMessage<UpdateRelationshipResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateRelationshipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeRelationshipRequest
+ */
+export class RevokeRelationshipRequest extends Message<RevokeRelationshipRequest> {
+  /**
+   * Either organization
+   *
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string relationship_id = 2;
+   */
+  relationshipId = "";
+
+  constructor(data?: PartialMessage<RevokeRelationshipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeRelationshipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "relationship_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeRelationshipRequest {
+    return new RevokeRelationshipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeRelationshipRequest {
+    return new RevokeRelationshipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeRelationshipRequest {
+    return new RevokeRelationshipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeRelationshipRequest | PlainMessage<RevokeRelationshipRequest> | undefined, b: RevokeRelationshipRequest | PlainMessage<RevokeRelationshipRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeRelationshipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeRelationshipResponse
+ */
+export class RevokeRelationshipResponse extends Message<RevokeRelationshipResponse> {
+  /**
+   * @generated from field: libops.v1.Relationship relationship = 1;
+   */
+  relationship?: Relationship;
+
+  constructor(data?: PartialMessage<RevokeRelationshipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeRelationshipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "relationship", kind: "message", T: Relationship },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeRelationshipResponse {
+    return new RevokeRelationshipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeRelationshipResponse {
+    return new RevokeRelationshipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeRelationshipResponse {
+    return new RevokeRelationshipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeRelationshipResponse | PlainMessage<RevokeRelationshipResponse> | undefined, b: RevokeRelationshipResponse | PlainMessage<RevokeRelationshipResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeRelationshipResponse, a, b);
+  }
+}
+
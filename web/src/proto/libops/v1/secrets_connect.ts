@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateOrganizationSecretRequest, CreateOrganizationSecretResponse, CreateProjectSecretRequest, CreateProjectSecretResponse, CreateSiteSecretRequest, CreateSiteSecretResponse, DeleteOrganizationSecretRequest, DeleteProjectSecretRequest, DeleteSiteSecretRequest, GetOrganizationSecretRequest, GetOrganizationSecretResponse, GetProjectSecretRequest, GetProjectSecretResponse, GetSiteSecretRequest, GetSiteSecretResponse, ListOrganizationSecretsRequest, ListOrganizationSecretsResponse, ListProjectSecretsRequest, ListProjectSecretsResponse, ListSiteSecretsRequest, ListSiteSecretsResponse, UpdateOrganizationSecretRequest, UpdateOrganizationSecretResponse, UpdateProjectSecretRequest, UpdateProjectSecretResponse, UpdateSiteSecretRequest, UpdateSiteSecretResponse } from "./secrets_pb.js";
+import { CreateOrganizationSecretRequest, CreateOrganizationSecretResponse, CreateProjectSecretRequest, CreateProjectSecretResponse, CreateSiteSecretRequest, CreateSiteSecretResponse, DeleteOrganizationSecretRequest, DeleteProjectSecretRequest, DeleteSiteSecretRequest, GetOrganizationSecretRequest, GetOrganizationSecretResponse, GetProjectSecretRequest, GetProjectSecretResponse, GetSiteSecretRequest, GetSiteSecretResponse, ListOrganizationSecretsRequest, ListOrganizationSecretsResponse, ListProjectSecretsRequest, ListProjectSecretsResponse, ListSecretAccessLogsRequest, ListSecretAccessLogsResponse, ListSiteSecretsRequest, ListSiteSecretsResponse, UpdateOrganizationSecretRequest, UpdateOrganizationSecretResponse, UpdateProjectSecretRequest, UpdateProjectSecretResponse, UpdateSiteSecretRequest, UpdateSiteSecretResponse } from "./secrets_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 import { Empty } from "../../google/protobuf/empty_pb.js";
 
@@ -72,6 +72,19 @@ export const OrganizationSecretService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * List who read the values of an organization's secrets, newest first.
+     * Unusual reads carry the reason they were flagged.
+     *
+     * @generated from rpc libops.v1.OrganizationSecretService.ListSecretAccessLogs
+     */
+    listSecretAccessLogs: {
+      name: "ListSecretAccessLogs",
+      I: ListSecretAccessLogsRequest,
+      O: ListSecretAccessLogsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 import { Status } from "./common/types_pb.js";
 import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
 
@@ -38,6 +38,20 @@ export class OrganizationSecret extends Message<OrganizationSecret> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * Data key version the value is sealed with; 0 if stored before envelope encryption
+   *
+   * @generated from field: int32 key_version = 5;
+   */
+  keyVersion = 0;
+
+  /**
+   * External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
+   *
+   * @generated from field: string reference = 6;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<OrganizationSecret>) {
     super();
     proto3.util.initPartial(data, this);
@@ -50,6 +64,8 @@ export class OrganizationSecret extends Message<OrganizationSecret> {
     { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 5, name: "key_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationSecret {
@@ -99,6 +115,20 @@ export class ProjectSecret extends Message<ProjectSecret> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * Data key version the value is sealed with; 0 if stored before envelope encryption
+   *
+   * @generated from field: int32 key_version = 5;
+   */
+  keyVersion = 0;
+
+  /**
+   * External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
+   *
+   * @generated from field: string reference = 6;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<ProjectSecret>) {
     super();
     proto3.util.initPartial(data, this);
@@ -111,6 +141,8 @@ export class ProjectSecret extends Message<ProjectSecret> {
     { no: 2, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 5, name: "key_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectSecret {
@@ -160,6 +192,27 @@ export class SiteSecret extends Message<SiteSecret> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * Written by libops, like a database's credentials; read-only
+   *
+   * @generated from field: bool managed = 5;
+   */
+  managed = false;
+
+  /**
+   * Data key version the value is sealed with; 0 if stored before envelope encryption
+   *
+   * @generated from field: int32 key_version = 6;
+   */
+  keyVersion = 0;
+
+  /**
+   * External secret the controller resolves, e.g. gcp-sm://project/name/version; empty for stored values
+   *
+   * @generated from field: string reference = 7;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<SiteSecret>) {
     super();
     proto3.util.initPartial(data, this);
@@ -172,6 +225,9 @@ export class SiteSecret extends Message<SiteSecret> {
     { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 5, name: "managed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "key_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteSecret {
@@ -214,6 +270,13 @@ export class CreateOrganizationSecretRequest extends Message<CreateOrganizationS
    */
   value = "";
 
+  /**
+   * Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
+   *
+   * @generated from field: string reference = 4;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<CreateOrganizationSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -225,6 +288,7 @@ export class CreateOrganizationSecretRequest extends Message<CreateOrganizationS
     { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationSecretRequest {
@@ -479,6 +543,13 @@ export class UpdateOrganizationSecretRequest extends Message<UpdateOrganizationS
    */
   updateMask?: FieldMask;
 
+  /**
+   * Replaces the value with an external secret reference
+   *
+   * @generated from field: optional string reference = 5;
+   */
+  reference?: string;
+
   constructor(data?: PartialMessage<UpdateOrganizationSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -491,6 +562,7 @@ export class UpdateOrganizationSecretRequest extends Message<UpdateOrganizationS
     { no: 2, name: "secret_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationSecretRequest {
@@ -609,6 +681,13 @@ export class CreateProjectSecretRequest extends Message<CreateProjectSecretReque
    */
   value = "";
 
+  /**
+   * Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
+   *
+   * @generated from field: string reference = 4;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<CreateProjectSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -620,6 +699,7 @@ export class CreateProjectSecretRequest extends Message<CreateProjectSecretReque
     { no: 1, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectSecretRequest {
@@ -872,6 +952,13 @@ export class UpdateProjectSecretRequest extends Message<UpdateProjectSecretReque
    */
   updateMask?: FieldMask;
 
+  /**
+   * Replaces the value with an external secret reference
+   *
+   * @generated from field: optional string reference = 5;
+   */
+  reference?: string;
+
   constructor(data?: PartialMessage<UpdateProjectSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -884,6 +971,7 @@ export class UpdateProjectSecretRequest extends Message<UpdateProjectSecretReque
     { no: 2, name: "secret_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectSecretRequest {
@@ -1002,6 +1090,13 @@ export class CreateSiteSecretRequest extends Message<CreateSiteSecretRequest> {
    */
   value = "";
 
+  /**
+   * Instead of value: gcp-sm://PROJECT/NAME[/VERSION] or vault://HOST/PATH[#FIELD]
+   *
+   * @generated from field: string reference = 4;
+   */
+  reference = "";
+
   constructor(data?: PartialMessage<CreateSiteSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1013,6 +1108,7 @@ export class CreateSiteSecretRequest extends Message<CreateSiteSecretRequest> {
     { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteSecretRequest {
@@ -1265,6 +1361,13 @@ export class UpdateSiteSecretRequest extends Message<UpdateSiteSecretRequest> {
    */
   updateMask?: FieldMask;
 
+  /**
+   * Replaces the value with an external secret reference
+   *
+   * @generated from field: optional string reference = 5;
+   */
+  reference?: string;
+
   constructor(data?: PartialMessage<UpdateSiteSecretRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1277,6 +1380,7 @@ export class UpdateSiteSecretRequest extends Message<UpdateSiteSecretRequest> {
     { no: 2, name: "secret_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+    { no: 5, name: "reference", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteSecretRequest {
@@ -1376,3 +1480,267 @@ export class DeleteSiteSecretRequest extends Message<DeleteSiteSecretRequest> {
   }
 }
 
+/**
+ * SecretAccessLog is one read of a secret's value
+ *
+ * @generated from message libops.v1.SecretAccessLog
+ */
+export class SecretAccessLog extends Message<SecretAccessLog> {
+  /**
+   * UUID
+   *
+   * @generated from field: string access_log_id = 1;
+   */
+  accessLogId = "";
+
+  /**
+   * UUID of the site it was read for; empty once the site is deleted
+   *
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string secret_name = 3;
+   */
+  secretName = "";
+
+  /**
+   * Email of the user or service account that read it
+   *
+   * @generated from field: string principal = 4;
+   */
+  principal = "";
+
+  /**
+   * Empty when it couldn't be determined
+   *
+   * @generated from field: string source_ip = 5;
+   */
+  sourceIp = "";
+
+  /**
+   * The RPC, e.g. "/libops.v1.AdminSiteService/GetSiteSecrets"
+   *
+   * @generated from field: string procedure = 6;
+   */
+  procedure = "";
+
+  /**
+   * Why the read was flagged as unusual; empty when it wasn't
+   *
+   * @generated from field: string anomaly = 7;
+   */
+  anomaly = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SecretAccessLog>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SecretAccessLog";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_log_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "principal", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "source_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "anomaly", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretAccessLog {
+    return new SecretAccessLog().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretAccessLog {
+    return new SecretAccessLog().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretAccessLog {
+    return new SecretAccessLog().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretAccessLog | PlainMessage<SecretAccessLog> | undefined, b: SecretAccessLog | PlainMessage<SecretAccessLog> | undefined): boolean {
+    return proto3.util.equals(SecretAccessLog, a, b);
+  }
+}
+
+/**
+ * SecretAccessAnomaly is the event sent to owners when secrets are read unusually
+ *
+ * @generated from message libops.v1.SecretAccessAnomaly
+ */
+export class SecretAccessAnomaly extends Message<SecretAccessAnomaly> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string principal = 3;
+   */
+  principal = "";
+
+  /**
+   * @generated from field: string source_ip = 4;
+   */
+  sourceIp = "";
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: repeated string secret_names = 6;
+   */
+  secretNames: string[] = [];
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 7;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SecretAccessAnomaly>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SecretAccessAnomaly";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "principal", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "source_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "secret_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretAccessAnomaly {
+    return new SecretAccessAnomaly().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretAccessAnomaly {
+    return new SecretAccessAnomaly().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretAccessAnomaly {
+    return new SecretAccessAnomaly().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretAccessAnomaly | PlainMessage<SecretAccessAnomaly> | undefined, b: SecretAccessAnomaly | PlainMessage<SecretAccessAnomaly> | undefined): boolean {
+    return proto3.util.equals(SecretAccessAnomaly, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSecretAccessLogsRequest
+ */
+export class ListSecretAccessLogsRequest extends Message<ListSecretAccessLogsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSecretAccessLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSecretAccessLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretAccessLogsRequest {
+    return new ListSecretAccessLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretAccessLogsRequest {
+    return new ListSecretAccessLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretAccessLogsRequest {
+    return new ListSecretAccessLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretAccessLogsRequest | PlainMessage<ListSecretAccessLogsRequest> | undefined, b: ListSecretAccessLogsRequest | PlainMessage<ListSecretAccessLogsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSecretAccessLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSecretAccessLogsResponse
+ */
+export class ListSecretAccessLogsResponse extends Message<ListSecretAccessLogsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SecretAccessLog access_logs = 1;
+   */
+  accessLogs: SecretAccessLog[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSecretAccessLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSecretAccessLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_logs", kind: "message", T: SecretAccessLog, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretAccessLogsResponse {
+    return new ListSecretAccessLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretAccessLogsResponse {
+    return new ListSecretAccessLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretAccessLogsResponse {
+    return new ListSecretAccessLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretAccessLogsResponse | PlainMessage<ListSecretAccessLogsResponse> | undefined, b: ListSecretAccessLogsResponse | PlainMessage<ListSecretAccessLogsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSecretAccessLogsResponse, a, b);
+  }
+}
+
@@ -8,6 +8,55 @@ import { Message, proto3 } from "@bufbuild/protobuf";
 import { Status } from "./common/types_pb.js";
 import { FieldMask } from "../../google/protobuf/field_mask_pb.js";
 
+/**
+ * SettingValueType is the type a setting's value is checked against whenever
+ * it is written
+ *
+ * @generated from enum libops.v1.SettingValueType
+ */
+export enum SettingValueType {
+  /**
+   * Treated as a string
+   *
+   * @generated from enum value: SETTING_VALUE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SETTING_VALUE_TYPE_STRING = 1;
+   */
+  STRING = 1,
+
+  /**
+   * A base 10 integer
+   *
+   * @generated from enum value: SETTING_VALUE_TYPE_INT = 2;
+   */
+  INT = 2,
+
+  /**
+   * "true" or "false"
+   *
+   * @generated from enum value: SETTING_VALUE_TYPE_BOOL = 3;
+   */
+  BOOL = 3,
+
+  /**
+   * One of the setting's allowed values
+   *
+   * @generated from enum value: SETTING_VALUE_TYPE_ENUM = 4;
+   */
+  ENUM = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SettingValueType)
+proto3.util.setEnumType(SettingValueType, "libops.v1.SettingValueType", [
+  { no: 0, name: "SETTING_VALUE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "SETTING_VALUE_TYPE_STRING" },
+  { no: 2, name: "SETTING_VALUE_TYPE_INT" },
+  { no: 3, name: "SETTING_VALUE_TYPE_BOOL" },
+  { no: 4, name: "SETTING_VALUE_TYPE_ENUM" },
+]);
+
 /**
  * @generated from message libops.v1.OrganizationSetting
  */
@@ -59,6 +108,25 @@ export class OrganizationSetting extends Message<OrganizationSetting> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 8;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Values an enum setting allows
+   *
+   * @generated from field: repeated string allowed_values = 9;
+   */
+  allowedValues: string[] = [];
+
+  /**
+   * Delivered to site VMs as an environment variable
+   *
+   * @generated from field: bool environment = 10;
+   */
+  environment = false;
+
   constructor(data?: PartialMessage<OrganizationSetting>) {
     super();
     proto3.util.initPartial(data, this);
@@ -74,6 +142,9 @@ export class OrganizationSetting extends Message<OrganizationSetting> {
     { no: 5, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 8, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 9, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "environment", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationSetting {
@@ -138,6 +209,25 @@ export class ProjectSetting extends Message<ProjectSetting> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 8;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Values an enum setting allows
+   *
+   * @generated from field: repeated string allowed_values = 9;
+   */
+  allowedValues: string[] = [];
+
+  /**
+   * Delivered to site VMs as an environment variable
+   *
+   * @generated from field: bool environment = 10;
+   */
+  environment = false;
+
   constructor(data?: PartialMessage<ProjectSetting>) {
     super();
     proto3.util.initPartial(data, this);
@@ -153,6 +243,9 @@ export class ProjectSetting extends Message<ProjectSetting> {
     { no: 5, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 8, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 9, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "environment", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectSetting {
@@ -217,6 +310,25 @@ export class SiteSetting extends Message<SiteSetting> {
    */
   status = Status.UNSPECIFIED;
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 8;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Values an enum setting allows
+   *
+   * @generated from field: repeated string allowed_values = 9;
+   */
+  allowedValues: string[] = [];
+
+  /**
+   * Delivered to site VMs as an environment variable
+   *
+   * @generated from field: bool environment = 10;
+   */
+  environment = false;
+
   constructor(data?: PartialMessage<SiteSetting>) {
     super();
     proto3.util.initPartial(data, this);
@@ -232,6 +344,9 @@ export class SiteSetting extends Message<SiteSetting> {
     { no: 5, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(Status) },
+    { no: 8, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 9, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "environment", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteSetting {
@@ -280,6 +395,18 @@ export class CreateOrganizationSettingRequest extends Message<CreateOrganization
    */
   description = "";
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 6;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Required for, and only allowed on, enum settings
+   *
+   * @generated from field: repeated string allowed_values = 7;
+   */
+  allowedValues: string[] = [];
+
   constructor(data?: PartialMessage<CreateOrganizationSettingRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -293,6 +420,8 @@ export class CreateOrganizationSettingRequest extends Message<CreateOrganization
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 7, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationSettingRequest {
@@ -685,6 +814,18 @@ export class CreateProjectSettingRequest extends Message<CreateProjectSettingReq
    */
   description = "";
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 6;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Required for, and only allowed on, enum settings
+   *
+   * @generated from field: repeated string allowed_values = 7;
+   */
+  allowedValues: string[] = [];
+
   constructor(data?: PartialMessage<CreateProjectSettingRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -698,6 +839,8 @@ export class CreateProjectSettingRequest extends Message<CreateProjectSettingReq
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 7, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateProjectSettingRequest {
@@ -1090,6 +1233,18 @@ export class CreateSiteSettingRequest extends Message<CreateSiteSettingRequest>
    */
   description = "";
 
+  /**
+   * @generated from field: libops.v1.SettingValueType value_type = 6;
+   */
+  valueType = SettingValueType.UNSPECIFIED;
+
+  /**
+   * Required for, and only allowed on, enum settings
+   *
+   * @generated from field: repeated string allowed_values = 7;
+   */
+  allowedValues: string[] = [];
+
   constructor(data?: PartialMessage<CreateSiteSettingRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1103,6 +1258,8 @@ export class CreateSiteSettingRequest extends Message<CreateSiteSettingRequest>
     { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "editable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "value_type", kind: "enum", T: proto3.getEnumType(SettingValueType) },
+    { no: 7, name: "allowed_values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSiteSettingRequest {
@@ -0,0 +1,105 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/signup.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ApproveJoinRequestRequest, ApproveJoinRequestResponse, DenyJoinRequestRequest, DenyJoinRequestResponse, GetJoinPolicyRequest, GetJoinPolicyResponse, ListJoinRequestsRequest, ListJoinRequestsResponse, SignupRequest, SignupResponse, UpdateJoinPolicyRequest, UpdateJoinPolicyResponse } from "./signup_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * SignupService lets people onboard themselves into the organizations that
+ * claim their email domain, instead of waiting for an owner to add them.
+ *
+ * @generated from service libops.v1.SignupService
+ */
+export const SignupService = {
+  typeName: "libops.v1.SignupService",
+  methods: {
+    /**
+     * Join every organization that claims the authenticated user's email
+     * domain. Organizations that auto-join add the user as a member right away;
+     * organizations that require approval get a join request instead.
+     *
+     * @generated from rpc libops.v1.SignupService.Signup
+     */
+    signup: {
+      name: "Signup",
+      I: SignupRequest,
+      O: SignupResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
+/**
+ * JoinPolicyService manages the email domains an organization claims, so
+ * staff signing up with an address at their institution join it on their
+ * own, and the join requests waiting for an administrator's approval.
+ *
+ * @generated from service libops.v1.JoinPolicyService
+ */
+export const JoinPolicyService = {
+  typeName: "libops.v1.JoinPolicyService",
+  methods: {
+    /**
+     * Get the email domains an organization claims
+     *
+     * @generated from rpc libops.v1.JoinPolicyService.GetJoinPolicy
+     */
+    getJoinPolicy: {
+      name: "GetJoinPolicy",
+      I: GetJoinPolicyRequest,
+      O: GetJoinPolicyResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Replace the email domains an organization claims. An empty list turns
+     * self-service signup off. Callers can only claim their own email domain.
+     *
+     * @generated from rpc libops.v1.JoinPolicyService.UpdateJoinPolicy
+     */
+    updateJoinPolicy: {
+      name: "UpdateJoinPolicy",
+      I: UpdateJoinPolicyRequest,
+      O: UpdateJoinPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the join requests waiting for an administrator's approval
+     *
+     * @generated from rpc libops.v1.JoinPolicyService.ListJoinRequests
+     */
+    listJoinRequests: {
+      name: "ListJoinRequests",
+      I: ListJoinRequestsRequest,
+      O: ListJoinRequestsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Approve a join request, adding the account as a member with the role
+     * its email domain grants
+     *
+     * @generated from rpc libops.v1.JoinPolicyService.ApproveJoinRequest
+     */
+    approveJoinRequest: {
+      name: "ApproveJoinRequest",
+      I: ApproveJoinRequestRequest,
+      O: ApproveJoinRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deny a join request. The account can't ask to join again.
+     *
+     * @generated from rpc libops.v1.JoinPolicyService.DenyJoinRequest
+     */
+    denyJoinRequest: {
+      name: "DenyJoinRequest",
+      I: DenyJoinRequestRequest,
+      O: DenyJoinRequestResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
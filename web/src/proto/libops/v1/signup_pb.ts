@@ -0,0 +1,716 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/signup.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * JoinDomain lets people with an email address at a domain join an organization
+ *
+ * @generated from message libops.v1.JoinDomain
+ */
+export class JoinDomain extends Message<JoinDomain> {
+  /**
+   * e.g. "mylibrary.edu"
+   *
+   * @generated from field: string domain = 1;
+   */
+  domain = "";
+
+  /**
+   * Role new members get: developer or read
+   *
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * Create a join request instead of a membership
+   *
+   * @generated from field: bool approval_required = 3;
+   */
+  approvalRequired = false;
+
+  constructor(data?: PartialMessage<JoinDomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.JoinDomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "approval_required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinDomain {
+    return new JoinDomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinDomain {
+    return new JoinDomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinDomain {
+    return new JoinDomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinDomain | PlainMessage<JoinDomain> | undefined, b: JoinDomain | PlainMessage<JoinDomain> | undefined): boolean {
+    return proto3.util.equals(JoinDomain, a, b);
+  }
+}
+
+/**
+ * JoinPolicy is the email domains an organization claims
+ *
+ * @generated from message libops.v1.JoinPolicy
+ */
+export class JoinPolicy extends Message<JoinPolicy> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Empty when self-service signup is off
+   *
+   * @generated from field: repeated libops.v1.JoinDomain domains = 2;
+   */
+  domains: JoinDomain[] = [];
+
+  constructor(data?: PartialMessage<JoinPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.JoinPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domains", kind: "message", T: JoinDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinPolicy {
+    return new JoinPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinPolicy {
+    return new JoinPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinPolicy {
+    return new JoinPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinPolicy | PlainMessage<JoinPolicy> | undefined, b: JoinPolicy | PlainMessage<JoinPolicy> | undefined): boolean {
+    return proto3.util.equals(JoinPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetJoinPolicyRequest
+ */
+export class GetJoinPolicyRequest extends Message<GetJoinPolicyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<GetJoinPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetJoinPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJoinPolicyRequest | PlainMessage<GetJoinPolicyRequest> | undefined, b: GetJoinPolicyRequest | PlainMessage<GetJoinPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(GetJoinPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetJoinPolicyResponse
+ */
+export class GetJoinPolicyResponse extends Message<GetJoinPolicyResponse> {
+  /**
+   * @generated from field: libops.v1.JoinPolicy policy = 1;
+   */
+  policy?: JoinPolicy;
+
+  constructor(data?: PartialMessage<GetJoinPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetJoinPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: JoinPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJoinPolicyResponse | PlainMessage<GetJoinPolicyResponse> | undefined, b: GetJoinPolicyResponse | PlainMessage<GetJoinPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(GetJoinPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateJoinPolicyRequest
+ */
+export class UpdateJoinPolicyRequest extends Message<UpdateJoinPolicyRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * At most 10
+   *
+   * @generated from field: repeated libops.v1.JoinDomain domains = 2;
+   */
+  domains: JoinDomain[] = [];
+
+  constructor(data?: PartialMessage<UpdateJoinPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateJoinPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domains", kind: "message", T: JoinDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateJoinPolicyRequest | PlainMessage<UpdateJoinPolicyRequest> | undefined, b: UpdateJoinPolicyRequest | PlainMessage<UpdateJoinPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateJoinPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateJoinPolicyResponse
+ */
+export class UpdateJoinPolicyResponse extends Message<UpdateJoinPolicyResponse> {
+  /**
+   * @generated from field: libops.v1.JoinPolicy policy = 1;
+   */
+  policy?: JoinPolicy;
+
+  constructor(data?: PartialMessage<UpdateJoinPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateJoinPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: JoinPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateJoinPolicyResponse | PlainMessage<UpdateJoinPolicyResponse> | undefined, b: UpdateJoinPolicyResponse | PlainMessage<UpdateJoinPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateJoinPolicyResponse, a, b);
+  }
+}
+
+/**
+ * JoinRequest is an account asking to join an organization through its email domain
+ *
+ * @generated from message libops.v1.JoinRequest
+ */
+export class JoinRequest extends Message<JoinRequest> {
+  /**
+   * @generated from field: string join_request_id = 1;
+   */
+  joinRequestId = "";
+
+  /**
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string account_id = 3;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  /**
+   * Role the account gets once approved
+   *
+   * @generated from field: string role = 6;
+   */
+  role = "";
+
+  /**
+   * pending, approved or denied
+   *
+   * @generated from field: string status = 7;
+   */
+  status = "";
+
+  /**
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<JoinRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.JoinRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRequest {
+    return new JoinRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRequest {
+    return new JoinRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRequest {
+    return new JoinRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRequest | PlainMessage<JoinRequest> | undefined, b: JoinRequest | PlainMessage<JoinRequest> | undefined): boolean {
+    return proto3.util.equals(JoinRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListJoinRequestsRequest
+ */
+export class ListJoinRequestsRequest extends Message<ListJoinRequestsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<ListJoinRequestsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListJoinRequestsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJoinRequestsRequest | PlainMessage<ListJoinRequestsRequest> | undefined, b: ListJoinRequestsRequest | PlainMessage<ListJoinRequestsRequest> | undefined): boolean {
+    return proto3.util.equals(ListJoinRequestsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListJoinRequestsResponse
+ */
+export class ListJoinRequestsResponse extends Message<ListJoinRequestsResponse> {
+  /**
+   * @generated from field: repeated libops.v1.JoinRequest join_requests = 1;
+   */
+  joinRequests: JoinRequest[] = [];
+
+  constructor(data?: PartialMessage<ListJoinRequestsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListJoinRequestsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_requests", kind: "message", T: JoinRequest, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJoinRequestsResponse | PlainMessage<ListJoinRequestsResponse> | undefined, b: ListJoinRequestsResponse | PlainMessage<ListJoinRequestsResponse> | undefined): boolean {
+    return proto3.util.equals(ListJoinRequestsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveJoinRequestRequest
+ */
+export class ApproveJoinRequestRequest extends Message<ApproveJoinRequestRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string join_request_id = 2;
+   */
+  joinRequestId = "";
+
+  constructor(data?: PartialMessage<ApproveJoinRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveJoinRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "join_request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveJoinRequestRequest | PlainMessage<ApproveJoinRequestRequest> | undefined, b: ApproveJoinRequestRequest | PlainMessage<ApproveJoinRequestRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveJoinRequestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ApproveJoinRequestResponse
+ */
+export class ApproveJoinRequestResponse extends Message<ApproveJoinRequestResponse> {
+  /**
+   * @generated from field: libops.v1.JoinRequest join_request = 1;
+   */
+  joinRequest?: JoinRequest;
+
+  constructor(data?: PartialMessage<ApproveJoinRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ApproveJoinRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request", kind: "message", T: JoinRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveJoinRequestResponse | PlainMessage<ApproveJoinRequestResponse> | undefined, b: ApproveJoinRequestResponse | PlainMessage<ApproveJoinRequestResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveJoinRequestResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DenyJoinRequestRequest
+ */
+export class DenyJoinRequestRequest extends Message<DenyJoinRequestRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string join_request_id = 2;
+   */
+  joinRequestId = "";
+
+  constructor(data?: PartialMessage<DenyJoinRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DenyJoinRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "join_request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DenyJoinRequestRequest {
+    return new DenyJoinRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DenyJoinRequestRequest {
+    return new DenyJoinRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DenyJoinRequestRequest {
+    return new DenyJoinRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DenyJoinRequestRequest | PlainMessage<DenyJoinRequestRequest> | undefined, b: DenyJoinRequestRequest | PlainMessage<DenyJoinRequestRequest> | undefined): boolean {
+    return proto3.util.equals(DenyJoinRequestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DenyJoinRequestResponse
+ */
+export class DenyJoinRequestResponse extends Message<DenyJoinRequestResponse> {
+  /**
+   * @generated from field: libops.v1.JoinRequest join_request = 1;
+   */
+  joinRequest?: JoinRequest;
+
+  constructor(data?: PartialMessage<DenyJoinRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DenyJoinRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request", kind: "message", T: JoinRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DenyJoinRequestResponse {
+    return new DenyJoinRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DenyJoinRequestResponse {
+    return new DenyJoinRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DenyJoinRequestResponse {
+    return new DenyJoinRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DenyJoinRequestResponse | PlainMessage<DenyJoinRequestResponse> | undefined, b: DenyJoinRequestResponse | PlainMessage<DenyJoinRequestResponse> | undefined): boolean {
+    return proto3.util.equals(DenyJoinRequestResponse, a, b);
+  }
+}
+
+/**
+ * SignupOrganization is an organization the user joined or asked to join
+ *
+ * @generated from message libops.v1.SignupOrganization
+ */
+export class SignupOrganization extends Message<SignupOrganization> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SignupOrganization>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SignupOrganization";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignupOrganization {
+    return new SignupOrganization().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignupOrganization {
+    return new SignupOrganization().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignupOrganization {
+    return new SignupOrganization().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SignupOrganization | PlainMessage<SignupOrganization> | undefined, b: SignupOrganization | PlainMessage<SignupOrganization> | undefined): boolean {
+    return proto3.util.equals(SignupOrganization, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SignupRequest
+ */
+export class SignupRequest extends Message<SignupRequest> {
+  constructor(data?: PartialMessage<SignupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SignupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignupRequest {
+    return new SignupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignupRequest {
+    return new SignupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignupRequest {
+    return new SignupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SignupRequest | PlainMessage<SignupRequest> | undefined, b: SignupRequest | PlainMessage<SignupRequest> | undefined): boolean {
+    return proto3.util.equals(SignupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SignupResponse
+ */
+export class SignupResponse extends Message<SignupResponse> {
+  /**
+   * Organizations the user is now a member of
+   *
+   * @generated from field: repeated libops.v1.SignupOrganization joined = 1;
+   */
+  joined: SignupOrganization[] = [];
+
+  /**
+   * Organizations waiting to approve the user
+   *
+   * @generated from field: repeated libops.v1.SignupOrganization pending = 2;
+   */
+  pending: SignupOrganization[] = [];
+
+  constructor(data?: PartialMessage<SignupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SignupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "joined", kind: "message", T: SignupOrganization, repeated: true },
+    { no: 2, name: "pending", kind: "message", T: SignupOrganization, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignupResponse {
+    return new SignupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignupResponse {
+    return new SignupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignupResponse {
+    return new SignupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SignupResponse | PlainMessage<SignupResponse> | undefined, b: SignupResponse | PlainMessage<SignupResponse> | undefined): boolean {
+    return proto3.util.equals(SignupResponse, a, b);
+  }
+}
+
@@ -0,0 +1,105 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/site_agent.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetSiteFirewallRequest, GetSiteFirewallResponse, GetSiteSSHKeysRequest, GetSiteSSHKeysResponse, GetSiteSecretsRequest, GetSiteSecretsResponse, SiteCheckInRequest, SiteCheckInResponse } from "./admin_api_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { GetAgentDeploymentRequest, GetAgentDeploymentResponse, ReportAgentDeploymentStatusRequest, ReportAgentDeploymentStatusResponse, ReportAgentReconciliationStatusRequest, ReportAgentReconciliationStatusResponse } from "./site_agent_pb.js";
+
+/**
+ * SiteAgentService is the API site VM controllers call with their VM's service
+ * account token. It replaces the controller's hand-rolled REST calls under
+ * /admin/sites/{id}, which remain as a deprecated shim until every controller
+ * runs a release built against this service.
+ *
+ * @generated from service libops.v1.SiteAgentService
+ */
+export const SiteAgentService = {
+  typeName: "libops.v1.SiteAgentService",
+  methods: {
+    /**
+     * Get the SSH keys of the site's members, with the account each belongs to
+     *
+     * @generated from rpc libops.v1.SiteAgentService.GetSSHKeys
+     */
+    getSSHKeys: {
+      name: "GetSSHKeys",
+      I: GetSiteSSHKeysRequest,
+      O: GetSiteSSHKeysResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get the site's secrets
+     *
+     * @generated from rpc libops.v1.SiteAgentService.GetSecrets
+     */
+    getSecrets: {
+      name: "GetSecrets",
+      I: GetSiteSecretsRequest,
+      O: GetSiteSecretsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get the site's firewall rules
+     *
+     * @generated from rpc libops.v1.SiteAgentService.GetFirewall
+     */
+    getFirewall: {
+      name: "GetFirewall",
+      I: GetSiteFirewallRequest,
+      O: GetSiteFirewallResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record that the site's VM is up, with its usage since the previous
+     * check-in, and return its status and controller config
+     *
+     * @generated from rpc libops.v1.SiteAgentService.CheckIn
+     */
+    checkIn: {
+      name: "CheckIn",
+      I: SiteCheckInRequest,
+      O: SiteCheckInResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the site's latest deployment for the controller to run
+     *
+     * @generated from rpc libops.v1.SiteAgentService.GetDeployment
+     */
+    getDeployment: {
+      name: "GetDeployment",
+      I: GetAgentDeploymentRequest,
+      O: GetAgentDeploymentResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Record how the controller's run of a deployment went
+     *
+     * @generated from rpc libops.v1.SiteAgentService.ReportDeploymentStatus
+     */
+    reportDeploymentStatus: {
+      name: "ReportDeploymentStatus",
+      I: ReportAgentDeploymentStatusRequest,
+      O: ReportAgentDeploymentStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Record how the controller applied a type of change to the VM
+     *
+     * @generated from rpc libops.v1.SiteAgentService.ReportReconciliationStatus
+     */
+    reportReconciliationStatus: {
+      name: "ReportReconciliationStatus",
+      I: ReportAgentReconciliationStatusRequest,
+      O: ReportAgentReconciliationStatusResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,363 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/site_agent.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * AgentDeployment is what the controller checks out and starts for a deployment
+ *
+ * @generated from message libops.v1.AgentDeployment
+ */
+export class AgentDeployment extends Message<AgentDeployment> {
+  /**
+   * @generated from field: string deployment_id = 1;
+   */
+  deploymentId = "";
+
+  /**
+   * e.g. "org/repo"
+   *
+   * @generated from field: string github_repo = 2;
+   */
+  githubRepo = "";
+
+  /**
+   * Branch, tag or commit requested
+   *
+   * @generated from field: string git_ref = 3;
+   */
+  gitRef = "";
+
+  /**
+   * Empty when the commit wasn't resolved
+   *
+   * @generated from field: string commit_sha = 4;
+   */
+  commitSha = "";
+
+  /**
+   * @generated from field: string commit_message = 5;
+   */
+  commitMessage = "";
+
+  /**
+   * Where the repository is checked out; empty for the default
+   *
+   * @generated from field: string compose_path = 6;
+   */
+  composePath = "";
+
+  /**
+   * Compose file in the checkout; empty for the default
+   *
+   * @generated from field: string compose_file = 7;
+   */
+  composeFile = "";
+
+  constructor(data?: PartialMessage<AgentDeployment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.AgentDeployment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "github_repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "git_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "commit_sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "compose_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "compose_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AgentDeployment {
+    return new AgentDeployment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AgentDeployment {
+    return new AgentDeployment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AgentDeployment {
+    return new AgentDeployment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AgentDeployment | PlainMessage<AgentDeployment> | undefined, b: AgentDeployment | PlainMessage<AgentDeployment> | undefined): boolean {
+    return proto3.util.equals(AgentDeployment, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetAgentDeploymentRequest
+ */
+export class GetAgentDeploymentRequest extends Message<GetAgentDeploymentRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetAgentDeploymentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAgentDeploymentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAgentDeploymentRequest {
+    return new GetAgentDeploymentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAgentDeploymentRequest {
+    return new GetAgentDeploymentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAgentDeploymentRequest {
+    return new GetAgentDeploymentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAgentDeploymentRequest | PlainMessage<GetAgentDeploymentRequest> | undefined, b: GetAgentDeploymentRequest | PlainMessage<GetAgentDeploymentRequest> | undefined): boolean {
+    return proto3.util.equals(GetAgentDeploymentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetAgentDeploymentResponse
+ */
+export class GetAgentDeploymentResponse extends Message<GetAgentDeploymentResponse> {
+  /**
+   * @generated from field: libops.v1.AgentDeployment deployment = 1;
+   */
+  deployment?: AgentDeployment;
+
+  constructor(data?: PartialMessage<GetAgentDeploymentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetAgentDeploymentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment", kind: "message", T: AgentDeployment },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAgentDeploymentResponse {
+    return new GetAgentDeploymentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAgentDeploymentResponse {
+    return new GetAgentDeploymentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAgentDeploymentResponse {
+    return new GetAgentDeploymentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAgentDeploymentResponse | PlainMessage<GetAgentDeploymentResponse> | undefined, b: GetAgentDeploymentResponse | PlainMessage<GetAgentDeploymentResponse> | undefined): boolean {
+    return proto3.util.equals(GetAgentDeploymentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportAgentDeploymentStatusRequest
+ */
+export class ReportAgentDeploymentStatusRequest extends Message<ReportAgentDeploymentStatusRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string deployment_id = 2;
+   */
+  deploymentId = "";
+
+  /**
+   * "in_progress", "success" or "failed"
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * Why the deployment failed
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ReportAgentDeploymentStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportAgentDeploymentStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportAgentDeploymentStatusRequest {
+    return new ReportAgentDeploymentStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportAgentDeploymentStatusRequest {
+    return new ReportAgentDeploymentStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportAgentDeploymentStatusRequest {
+    return new ReportAgentDeploymentStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportAgentDeploymentStatusRequest | PlainMessage<ReportAgentDeploymentStatusRequest> | undefined, b: ReportAgentDeploymentStatusRequest | PlainMessage<ReportAgentDeploymentStatusRequest> | undefined): boolean {
+    return proto3.util.equals(ReportAgentDeploymentStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportAgentDeploymentStatusResponse
+ */
+export class ReportAgentDeploymentStatusResponse extends Message<ReportAgentDeploymentStatusResponse> {
+  constructor(data?: PartialMessage<ReportAgentDeploymentStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportAgentDeploymentStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportAgentDeploymentStatusResponse {
+    return new ReportAgentDeploymentStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportAgentDeploymentStatusResponse {
+    return new ReportAgentDeploymentStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportAgentDeploymentStatusResponse {
+    return new ReportAgentDeploymentStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportAgentDeploymentStatusResponse | PlainMessage<ReportAgentDeploymentStatusResponse> | undefined, b: ReportAgentDeploymentStatusResponse | PlainMessage<ReportAgentDeploymentStatusResponse> | undefined): boolean {
+    return proto3.util.equals(ReportAgentDeploymentStatusResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportAgentReconciliationStatusRequest
+ */
+export class ReportAgentReconciliationStatusRequest extends Message<ReportAgentReconciliationStatusRequest> {
+  /**
+   * Site public ID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * "ssh_keys", "secrets", "firewall" or "deployment"
+   *
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * "active" or "failed"
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * Resources the controller applied, e.g. the accounts whose keys it installed
+   *
+   * @generated from field: repeated string resource_ids = 4;
+   */
+  resourceIds: string[] = [];
+
+  /**
+   * Why the reconciliation failed
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ReportAgentReconciliationStatusRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportAgentReconciliationStatusRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "resource_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportAgentReconciliationStatusRequest {
+    return new ReportAgentReconciliationStatusRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportAgentReconciliationStatusRequest {
+    return new ReportAgentReconciliationStatusRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportAgentReconciliationStatusRequest {
+    return new ReportAgentReconciliationStatusRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportAgentReconciliationStatusRequest | PlainMessage<ReportAgentReconciliationStatusRequest> | undefined, b: ReportAgentReconciliationStatusRequest | PlainMessage<ReportAgentReconciliationStatusRequest> | undefined): boolean {
+    return proto3.util.equals(ReportAgentReconciliationStatusRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ReportAgentReconciliationStatusResponse
+ */
+export class ReportAgentReconciliationStatusResponse extends Message<ReportAgentReconciliationStatusResponse> {
+  constructor(data?: PartialMessage<ReportAgentReconciliationStatusResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ReportAgentReconciliationStatusResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportAgentReconciliationStatusResponse {
+    return new ReportAgentReconciliationStatusResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportAgentReconciliationStatusResponse {
+    return new ReportAgentReconciliationStatusResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportAgentReconciliationStatusResponse {
+    return new ReportAgentReconciliationStatusResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportAgentReconciliationStatusResponse | PlainMessage<ReportAgentReconciliationStatusResponse> | undefined, b: ReportAgentReconciliationStatusResponse | PlainMessage<ReportAgentReconciliationStatusResponse> | undefined): boolean {
+    return proto3.util.equals(ReportAgentReconciliationStatusResponse, a, b);
+  }
+}
+
@@ -0,0 +1,69 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/ssh_access.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GrantSshAccessRequest, GrantSshAccessResponse, ListSshAccessRequest, ListSshAccessResponse, RevokeSshAccessRequest, UpdateSshAccessRequest, UpdateSshAccessResponse } from "./ssh_access_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * SshAccessService grants site members SSH access to a site's VM beyond what
+ * their role gives. Owners and developers always have a shell; a grant gives
+ * one to another member of the site, or, flagged sftp_only, a chrooted,
+ * shell-less login to the site's files directory for content editors. A grant
+ * lapses with the account's site membership.
+ *
+ * @generated from service libops.v1.SshAccessService
+ */
+export const SshAccessService = {
+  typeName: "libops.v1.SshAccessService",
+  methods: {
+    /**
+     * List the site's SSH access grants
+     *
+     * @generated from rpc libops.v1.SshAccessService.ListSshAccess
+     */
+    listSshAccess: {
+      name: "ListSshAccess",
+      I: ListSshAccessRequest,
+      O: ListSshAccessResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Grant a site member SSH access to the site
+     *
+     * @generated from rpc libops.v1.SshAccessService.GrantSshAccess
+     */
+    grantSshAccess: {
+      name: "GrantSshAccess",
+      I: GrantSshAccessRequest,
+      O: GrantSshAccessResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Change whether a grant is SFTP only
+     *
+     * @generated from rpc libops.v1.SshAccessService.UpdateSshAccess
+     */
+    updateSshAccess: {
+      name: "UpdateSshAccess",
+      I: UpdateSshAccessRequest,
+      O: UpdateSshAccessResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Revoke a grant
+     *
+     * @generated from rpc libops.v1.SshAccessService.RevokeSshAccess
+     */
+    revokeSshAccess: {
+      name: "RevokeSshAccess",
+      I: RevokeSshAccessRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
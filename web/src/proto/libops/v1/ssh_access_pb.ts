@@ -0,0 +1,371 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/ssh_access.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message libops.v1.SshAccess
+ */
+export class SshAccess extends Message<SshAccess> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string site_id = 2;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * The account gets a chrooted, shell-less SFTP login to the site's files
+   * directory instead of a shell
+   *
+   * @generated from field: bool sftp_only = 5;
+   */
+  sftpOnly = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SshAccess>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SshAccess";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "sftp_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SshAccess {
+    return new SshAccess().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SshAccess {
+    return new SshAccess().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SshAccess {
+    return new SshAccess().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SshAccess | PlainMessage<SshAccess> | undefined, b: SshAccess | PlainMessage<SshAccess> | undefined): boolean {
+    return proto3.util.equals(SshAccess, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSshAccessRequest
+ */
+export class ListSshAccessRequest extends Message<ListSshAccessRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<ListSshAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSshAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSshAccessRequest {
+    return new ListSshAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSshAccessRequest {
+    return new ListSshAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSshAccessRequest {
+    return new ListSshAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSshAccessRequest | PlainMessage<ListSshAccessRequest> | undefined, b: ListSshAccessRequest | PlainMessage<ListSshAccessRequest> | undefined): boolean {
+    return proto3.util.equals(ListSshAccessRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListSshAccessResponse
+ */
+export class ListSshAccessResponse extends Message<ListSshAccessResponse> {
+  /**
+   * @generated from field: repeated libops.v1.SshAccess ssh_access = 1;
+   */
+  sshAccess: SshAccess[] = [];
+
+  constructor(data?: PartialMessage<ListSshAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListSshAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ssh_access", kind: "message", T: SshAccess, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSshAccessResponse {
+    return new ListSshAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSshAccessResponse {
+    return new ListSshAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSshAccessResponse {
+    return new ListSshAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSshAccessResponse | PlainMessage<ListSshAccessResponse> | undefined, b: ListSshAccessResponse | PlainMessage<ListSshAccessResponse> | undefined): boolean {
+    return proto3.util.equals(ListSshAccessResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GrantSshAccessRequest
+ */
+export class GrantSshAccessRequest extends Message<GrantSshAccessRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Must be a member of the site
+   *
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: bool sftp_only = 3;
+   */
+  sftpOnly = false;
+
+  constructor(data?: PartialMessage<GrantSshAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GrantSshAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sftp_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantSshAccessRequest {
+    return new GrantSshAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantSshAccessRequest {
+    return new GrantSshAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantSshAccessRequest {
+    return new GrantSshAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantSshAccessRequest | PlainMessage<GrantSshAccessRequest> | undefined, b: GrantSshAccessRequest | PlainMessage<GrantSshAccessRequest> | undefined): boolean {
+    return proto3.util.equals(GrantSshAccessRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GrantSshAccessResponse
+ */
+export class GrantSshAccessResponse extends Message<GrantSshAccessResponse> {
+  /**
+   * @generated from field: libops.v1.SshAccess ssh_access = 1;
+   */
+  sshAccess?: SshAccess;
+
+  constructor(data?: PartialMessage<GrantSshAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GrantSshAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ssh_access", kind: "message", T: SshAccess },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrantSshAccessResponse {
+    return new GrantSshAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrantSshAccessResponse {
+    return new GrantSshAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrantSshAccessResponse {
+    return new GrantSshAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GrantSshAccessResponse | PlainMessage<GrantSshAccessResponse> | undefined, b: GrantSshAccessResponse | PlainMessage<GrantSshAccessResponse> | undefined): boolean {
+    return proto3.util.equals(GrantSshAccessResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSshAccessRequest
+ */
+export class UpdateSshAccessRequest extends Message<UpdateSshAccessRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: bool sftp_only = 3;
+   */
+  sftpOnly = false;
+
+  constructor(data?: PartialMessage<UpdateSshAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSshAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sftp_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSshAccessRequest {
+    return new UpdateSshAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSshAccessRequest {
+    return new UpdateSshAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSshAccessRequest {
+    return new UpdateSshAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSshAccessRequest | PlainMessage<UpdateSshAccessRequest> | undefined, b: UpdateSshAccessRequest | PlainMessage<UpdateSshAccessRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSshAccessRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSshAccessResponse
+ */
+export class UpdateSshAccessResponse extends Message<UpdateSshAccessResponse> {
+  /**
+   * @generated from field: libops.v1.SshAccess ssh_access = 1;
+   */
+  sshAccess?: SshAccess;
+
+  constructor(data?: PartialMessage<UpdateSshAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSshAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ssh_access", kind: "message", T: SshAccess },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSshAccessResponse {
+    return new UpdateSshAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSshAccessResponse {
+    return new UpdateSshAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSshAccessResponse {
+    return new UpdateSshAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSshAccessResponse | PlainMessage<UpdateSshAccessResponse> | undefined, b: UpdateSshAccessResponse | PlainMessage<UpdateSshAccessResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSshAccessResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.RevokeSshAccessRequest
+ */
+export class RevokeSshAccessRequest extends Message<RevokeSshAccessRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<RevokeSshAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.RevokeSshAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeSshAccessRequest {
+    return new RevokeSshAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeSshAccessRequest {
+    return new RevokeSshAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeSshAccessRequest {
+    return new RevokeSshAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeSshAccessRequest | PlainMessage<RevokeSshAccessRequest> | undefined, b: RevokeSshAccessRequest | PlainMessage<RevokeSshAccessRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeSshAccessRequest, a, b);
+  }
+}
+
@@ -0,0 +1,100 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/status_page.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteStatusPageRequest, DeleteStatusPageUpdateRequest, GetStatusPageRequest, GetStatusPageResponse, ListStatusPageUpdatesRequest, ListStatusPageUpdatesResponse, PostStatusPageUpdateRequest, PostStatusPageUpdateResponse, SetStatusPageSitesRequest, SetStatusPageSitesResponse, UpdateStatusPageRequest, UpdateStatusPageResponse } from "./status_page_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * StatusPageService manages an organization's status page: which sites it
+ * lists, whether anyone can view it, and the incident updates posted to it
+ *
+ * @generated from service libops.v1.StatusPageService
+ */
+export const StatusPageService = {
+  typeName: "libops.v1.StatusPageService",
+  methods: {
+    /**
+     * Get an organization's status page and the sites it lists
+     *
+     * @generated from rpc libops.v1.StatusPageService.GetStatusPage
+     */
+    getStatusPage: {
+      name: "GetStatusPage",
+      I: GetStatusPageRequest,
+      O: GetStatusPageResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Create the organization's status page, or update its slug, title and visibility
+     *
+     * @generated from rpc libops.v1.StatusPageService.UpdateStatusPage
+     */
+    updateStatusPage: {
+      name: "UpdateStatusPage",
+      I: UpdateStatusPageRequest,
+      O: UpdateStatusPageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Delete the organization's status page and its updates
+     *
+     * @generated from rpc libops.v1.StatusPageService.DeleteStatusPage
+     */
+    deleteStatusPage: {
+      name: "DeleteStatusPage",
+      I: DeleteStatusPageRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Replace the sites listed on the status page, in display order
+     *
+     * @generated from rpc libops.v1.StatusPageService.SetStatusPageSites
+     */
+    setStatusPageSites: {
+      name: "SetStatusPageSites",
+      I: SetStatusPageSitesRequest,
+      O: SetStatusPageSitesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Post an incident update to the status page
+     *
+     * @generated from rpc libops.v1.StatusPageService.PostStatusPageUpdate
+     */
+    postStatusPageUpdate: {
+      name: "PostStatusPageUpdate",
+      I: PostStatusPageUpdateRequest,
+      O: PostStatusPageUpdateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the updates posted to the status page, newest first
+     *
+     * @generated from rpc libops.v1.StatusPageService.ListStatusPageUpdates
+     */
+    listStatusPageUpdates: {
+      name: "ListStatusPageUpdates",
+      I: ListStatusPageUpdatesRequest,
+      O: ListStatusPageUpdatesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Remove an update posted by mistake
+     *
+     * @generated from rpc libops.v1.StatusPageService.DeleteStatusPageUpdate
+     */
+    deleteStatusPageUpdate: {
+      name: "DeleteStatusPageUpdate",
+      I: DeleteStatusPageUpdateRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,793 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/status_page.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.StatusPageUpdateStatus
+ */
+export enum StatusPageUpdateStatus {
+  /**
+   * @generated from enum value: STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATUS_PAGE_UPDATE_STATUS_INVESTIGATING = 1;
+   */
+  INVESTIGATING = 1,
+
+  /**
+   * @generated from enum value: STATUS_PAGE_UPDATE_STATUS_IDENTIFIED = 2;
+   */
+  IDENTIFIED = 2,
+
+  /**
+   * @generated from enum value: STATUS_PAGE_UPDATE_STATUS_MONITORING = 3;
+   */
+  MONITORING = 3,
+
+  /**
+   * @generated from enum value: STATUS_PAGE_UPDATE_STATUS_RESOLVED = 4;
+   */
+  RESOLVED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(StatusPageUpdateStatus)
+proto3.util.setEnumType(StatusPageUpdateStatus, "libops.v1.StatusPageUpdateStatus", [
+  { no: 0, name: "STATUS_PAGE_UPDATE_STATUS_UNSPECIFIED" },
+  { no: 1, name: "STATUS_PAGE_UPDATE_STATUS_INVESTIGATING" },
+  { no: 2, name: "STATUS_PAGE_UPDATE_STATUS_IDENTIFIED" },
+  { no: 3, name: "STATUS_PAGE_UPDATE_STATUS_MONITORING" },
+  { no: 4, name: "STATUS_PAGE_UPDATE_STATUS_RESOLVED" },
+]);
+
+/**
+ * @generated from message libops.v1.StatusPageSite
+ */
+export class StatusPageSite extends Message<StatusPageSite> {
+  /**
+   * UUID
+   *
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string site_name = 2;
+   */
+  siteName = "";
+
+  /**
+   * Shown on the page instead of the site name when set
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName = "";
+
+  constructor(data?: PartialMessage<StatusPageSite>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.StatusPageSite";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "site_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusPageSite {
+    return new StatusPageSite().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusPageSite {
+    return new StatusPageSite().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusPageSite {
+    return new StatusPageSite().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusPageSite | PlainMessage<StatusPageSite> | undefined, b: StatusPageSite | PlainMessage<StatusPageSite> | undefined): boolean {
+    return proto3.util.equals(StatusPageSite, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.StatusPage
+ */
+export class StatusPage extends Message<StatusPage> {
+  /**
+   * UUID
+   *
+   * @generated from field: string status_page_id = 1;
+   */
+  statusPageId = "";
+
+  /**
+   * UUID
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * DNS label the page is served under
+   *
+   * @generated from field: string slug = 3;
+   */
+  slug = "";
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * Private pages are only shown to organization members
+   *
+   * @generated from field: bool public = 5;
+   */
+  public = false;
+
+  /**
+   * Where the page is served
+   *
+   * @generated from field: string url = 6;
+   */
+  url = "";
+
+  /**
+   * @generated from field: repeated libops.v1.StatusPageSite sites = 7;
+   */
+  sites: StatusPageSite[] = [];
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 8;
+   */
+  createdAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 updated_at = 9;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StatusPage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.StatusPage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status_page_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "slug", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "public", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "sites", kind: "message", T: StatusPageSite, repeated: true },
+    { no: 8, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusPage {
+    return new StatusPage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusPage {
+    return new StatusPage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusPage {
+    return new StatusPage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusPage | PlainMessage<StatusPage> | undefined, b: StatusPage | PlainMessage<StatusPage> | undefined): boolean {
+    return proto3.util.equals(StatusPage, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.StatusPageUpdate
+ */
+export class StatusPageUpdate extends Message<StatusPageUpdate> {
+  /**
+   * UUID
+   *
+   * @generated from field: string update_id = 1;
+   */
+  updateId = "";
+
+  /**
+   * @generated from field: libops.v1.StatusPageUpdateStatus status = 2;
+   */
+  status = StatusPageUpdateStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * Site incident the update is about, empty when none
+   *
+   * @generated from field: string incident_id = 5;
+   */
+  incidentId = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 6;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StatusPageUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.StatusPageUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "update_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(StatusPageUpdateStatus) },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "incident_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusPageUpdate {
+    return new StatusPageUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusPageUpdate {
+    return new StatusPageUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusPageUpdate {
+    return new StatusPageUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatusPageUpdate | PlainMessage<StatusPageUpdate> | undefined, b: StatusPageUpdate | PlainMessage<StatusPageUpdate> | undefined): boolean {
+    return proto3.util.equals(StatusPageUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetStatusPageRequest
+ */
+export class GetStatusPageRequest extends Message<GetStatusPageRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<GetStatusPageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetStatusPageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusPageRequest {
+    return new GetStatusPageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusPageRequest {
+    return new GetStatusPageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusPageRequest {
+    return new GetStatusPageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStatusPageRequest | PlainMessage<GetStatusPageRequest> | undefined, b: GetStatusPageRequest | PlainMessage<GetStatusPageRequest> | undefined): boolean {
+    return proto3.util.equals(GetStatusPageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetStatusPageResponse
+ */
+export class GetStatusPageResponse extends Message<GetStatusPageResponse> {
+  /**
+   * @generated from field: libops.v1.StatusPage status_page = 1;
+   */
+  statusPage?: StatusPage;
+
+  constructor(data?: PartialMessage<GetStatusPageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetStatusPageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status_page", kind: "message", T: StatusPage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusPageResponse {
+    return new GetStatusPageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusPageResponse {
+    return new GetStatusPageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusPageResponse {
+    return new GetStatusPageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStatusPageResponse | PlainMessage<GetStatusPageResponse> | undefined, b: GetStatusPageResponse | PlainMessage<GetStatusPageResponse> | undefined): boolean {
+    return proto3.util.equals(GetStatusPageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateStatusPageRequest
+ */
+export class UpdateStatusPageRequest extends Message<UpdateStatusPageRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * Lowercase letters, digits and hyphens, e.g. "acme" for status.acme.libops.site
+   *
+   * @generated from field: string slug = 2;
+   */
+  slug = "";
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  /**
+   * @generated from field: bool public = 4;
+   */
+  public = false;
+
+  constructor(data?: PartialMessage<UpdateStatusPageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateStatusPageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "slug", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "public", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateStatusPageRequest {
+    return new UpdateStatusPageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateStatusPageRequest {
+    return new UpdateStatusPageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateStatusPageRequest {
+    return new UpdateStatusPageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateStatusPageRequest | PlainMessage<UpdateStatusPageRequest> | undefined, b: UpdateStatusPageRequest | PlainMessage<UpdateStatusPageRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateStatusPageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateStatusPageResponse
+ */
+export class UpdateStatusPageResponse extends Message<UpdateStatusPageResponse> {
+  /**
+   * @generated from field: libops.v1.StatusPage status_page = 1;
+   */
+  statusPage?: StatusPage;
+
+  constructor(data?: PartialMessage<UpdateStatusPageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateStatusPageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status_page", kind: "message", T: StatusPage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateStatusPageResponse {
+    return new UpdateStatusPageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateStatusPageResponse {
+    return new UpdateStatusPageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateStatusPageResponse {
+    return new UpdateStatusPageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateStatusPageResponse | PlainMessage<UpdateStatusPageResponse> | undefined, b: UpdateStatusPageResponse | PlainMessage<UpdateStatusPageResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateStatusPageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteStatusPageRequest
+ */
+export class DeleteStatusPageRequest extends Message<DeleteStatusPageRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  constructor(data?: PartialMessage<DeleteStatusPageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteStatusPageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteStatusPageRequest {
+    return new DeleteStatusPageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteStatusPageRequest {
+    return new DeleteStatusPageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteStatusPageRequest {
+    return new DeleteStatusPageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteStatusPageRequest | PlainMessage<DeleteStatusPageRequest> | undefined, b: DeleteStatusPageRequest | PlainMessage<DeleteStatusPageRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteStatusPageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetStatusPageSitesRequest
+ */
+export class SetStatusPageSitesRequest extends Message<SetStatusPageSitesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * At most 50, listed in this order. Sites must belong to the organization.
+   *
+   * @generated from field: repeated libops.v1.StatusPageSite sites = 2;
+   */
+  sites: StatusPageSite[] = [];
+
+  constructor(data?: PartialMessage<SetStatusPageSitesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetStatusPageSitesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sites", kind: "message", T: StatusPageSite, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetStatusPageSitesRequest {
+    return new SetStatusPageSitesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetStatusPageSitesRequest {
+    return new SetStatusPageSitesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetStatusPageSitesRequest {
+    return new SetStatusPageSitesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetStatusPageSitesRequest | PlainMessage<SetStatusPageSitesRequest> | undefined, b: SetStatusPageSitesRequest | PlainMessage<SetStatusPageSitesRequest> | undefined): boolean {
+    return proto3.util.equals(SetStatusPageSitesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SetStatusPageSitesResponse
+ */
+export class SetStatusPageSitesResponse extends Message<SetStatusPageSitesResponse> {
+  /**
+   * @generated from field: libops.v1.StatusPage status_page = 1;
+   */
+  statusPage?: StatusPage;
+
+  constructor(data?: PartialMessage<SetStatusPageSitesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SetStatusPageSitesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status_page", kind: "message", T: StatusPage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetStatusPageSitesResponse {
+    return new SetStatusPageSitesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetStatusPageSitesResponse {
+    return new SetStatusPageSitesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetStatusPageSitesResponse {
+    return new SetStatusPageSitesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetStatusPageSitesResponse | PlainMessage<SetStatusPageSitesResponse> | undefined, b: SetStatusPageSitesResponse | PlainMessage<SetStatusPageSitesResponse> | undefined): boolean {
+    return proto3.util.equals(SetStatusPageSitesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.PostStatusPageUpdateRequest
+ */
+export class PostStatusPageUpdateRequest extends Message<PostStatusPageUpdateRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: libops.v1.StatusPageUpdateStatus status = 2;
+   */
+  status = StatusPageUpdateStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * Optional site incident the update is about
+   *
+   * @generated from field: string incident_id = 5;
+   */
+  incidentId = "";
+
+  constructor(data?: PartialMessage<PostStatusPageUpdateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PostStatusPageUpdateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(StatusPageUpdateStatus) },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "incident_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostStatusPageUpdateRequest {
+    return new PostStatusPageUpdateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostStatusPageUpdateRequest {
+    return new PostStatusPageUpdateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostStatusPageUpdateRequest {
+    return new PostStatusPageUpdateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PostStatusPageUpdateRequest | PlainMessage<PostStatusPageUpdateRequest> | undefined, b: PostStatusPageUpdateRequest | PlainMessage<PostStatusPageUpdateRequest> | undefined): boolean {
+    return proto3.util.equals(PostStatusPageUpdateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.PostStatusPageUpdateResponse
+ */
+export class PostStatusPageUpdateResponse extends Message<PostStatusPageUpdateResponse> {
+  /**
+   * @generated from field: libops.v1.StatusPageUpdate update = 1;
+   */
+  update?: StatusPageUpdate;
+
+  constructor(data?: PartialMessage<PostStatusPageUpdateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.PostStatusPageUpdateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "update", kind: "message", T: StatusPageUpdate },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostStatusPageUpdateResponse {
+    return new PostStatusPageUpdateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostStatusPageUpdateResponse {
+    return new PostStatusPageUpdateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostStatusPageUpdateResponse {
+    return new PostStatusPageUpdateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PostStatusPageUpdateResponse | PlainMessage<PostStatusPageUpdateResponse> | undefined, b: PostStatusPageUpdateResponse | PlainMessage<PostStatusPageUpdateResponse> | undefined): boolean {
+    return proto3.util.equals(PostStatusPageUpdateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListStatusPageUpdatesRequest
+ */
+export class ListStatusPageUpdatesRequest extends Message<ListStatusPageUpdatesRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListStatusPageUpdatesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListStatusPageUpdatesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStatusPageUpdatesRequest {
+    return new ListStatusPageUpdatesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStatusPageUpdatesRequest {
+    return new ListStatusPageUpdatesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStatusPageUpdatesRequest {
+    return new ListStatusPageUpdatesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStatusPageUpdatesRequest | PlainMessage<ListStatusPageUpdatesRequest> | undefined, b: ListStatusPageUpdatesRequest | PlainMessage<ListStatusPageUpdatesRequest> | undefined): boolean {
+    return proto3.util.equals(ListStatusPageUpdatesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.ListStatusPageUpdatesResponse
+ */
+export class ListStatusPageUpdatesResponse extends Message<ListStatusPageUpdatesResponse> {
+  /**
+   * @generated from field: repeated libops.v1.StatusPageUpdate updates = 1;
+   */
+  updates: StatusPageUpdate[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListStatusPageUpdatesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.ListStatusPageUpdatesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "updates", kind: "message", T: StatusPageUpdate, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListStatusPageUpdatesResponse {
+    return new ListStatusPageUpdatesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListStatusPageUpdatesResponse {
+    return new ListStatusPageUpdatesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListStatusPageUpdatesResponse {
+    return new ListStatusPageUpdatesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListStatusPageUpdatesResponse | PlainMessage<ListStatusPageUpdatesResponse> | undefined, b: ListStatusPageUpdatesResponse | PlainMessage<ListStatusPageUpdatesResponse> | undefined): boolean {
+    return proto3.util.equals(ListStatusPageUpdatesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteStatusPageUpdateRequest
+ */
+export class DeleteStatusPageUpdateRequest extends Message<DeleteStatusPageUpdateRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
+  /**
+   * @generated from field: string update_id = 2;
+   */
+  updateId = "";
+
+  constructor(data?: PartialMessage<DeleteStatusPageUpdateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteStatusPageUpdateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "update_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteStatusPageUpdateRequest {
+    return new DeleteStatusPageUpdateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteStatusPageUpdateRequest {
+    return new DeleteStatusPageUpdateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteStatusPageUpdateRequest {
+    return new DeleteStatusPageUpdateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteStatusPageUpdateRequest | PlainMessage<DeleteStatusPageUpdateRequest> | undefined, b: DeleteStatusPageUpdateRequest | PlainMessage<DeleteStatusPageUpdateRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteStatusPageUpdateRequest, a, b);
+  }
+}
+
@@ -0,0 +1,69 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/tls.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteSiteTlsCertificateRequest, DeleteSiteTlsCertificateResponse, GetSiteTlsPolicyRequest, GetSiteTlsPolicyResponse, UpdateSiteTlsPolicyRequest, UpdateSiteTlsPolicyResponse, UploadSiteTlsCertificateRequest, UploadSiteTlsCertificateResponse } from "./tls_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * TlsPolicyService manages how a site's nginx terminates TLS: the minimum
+ * protocol version, HSTS, OCSP stapling and whether it serves its ACME
+ * certificate or one uploaded for it. Changes reach the site with its next
+ * reconciliation, which each change queues, and the site's controller probes
+ * the site once it's applied them and reports whether the policy holds.
+ *
+ * @generated from service libops.v1.TlsPolicyService
+ */
+export const TlsPolicyService = {
+  typeName: "libops.v1.TlsPolicyService",
+  methods: {
+    /**
+     * Get a site's TLS policy and the result of its last probe
+     *
+     * @generated from rpc libops.v1.TlsPolicyService.GetSiteTlsPolicy
+     */
+    getSiteTlsPolicy: {
+      name: "GetSiteTlsPolicy",
+      I: GetSiteTlsPolicyRequest,
+      O: GetSiteTlsPolicyResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Change a site's minimum TLS version, HSTS or OCSP stapling
+     *
+     * @generated from rpc libops.v1.TlsPolicyService.UpdateSiteTlsPolicy
+     */
+    updateSiteTlsPolicy: {
+      name: "UpdateSiteTlsPolicy",
+      I: UpdateSiteTlsPolicyRequest,
+      O: UpdateSiteTlsPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Serve a custom certificate instead of the ACME one. It has to cover
+     * every domain of the site
+     *
+     * @generated from rpc libops.v1.TlsPolicyService.UploadSiteTlsCertificate
+     */
+    uploadSiteTlsCertificate: {
+      name: "UploadSiteTlsCertificate",
+      I: UploadSiteTlsCertificateRequest,
+      O: UploadSiteTlsCertificateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a site's custom certificate, so it serves its ACME one again
+     *
+     * @generated from rpc libops.v1.TlsPolicyService.DeleteSiteTlsCertificate
+     */
+    deleteSiteTlsCertificate: {
+      name: "DeleteSiteTlsCertificate",
+      I: DeleteSiteTlsCertificateRequest,
+      O: DeleteSiteTlsCertificateResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,626 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/tls.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.TlsVersion
+ */
+export enum TlsVersion {
+  /**
+   * @generated from enum value: TLS_VERSION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TLS_VERSION_1_2 = 1;
+   */
+  TLS_VERSION_1_2 = 1,
+
+  /**
+   * @generated from enum value: TLS_VERSION_1_3 = 2;
+   */
+  TLS_VERSION_1_3 = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TlsVersion)
+proto3.util.setEnumType(TlsVersion, "libops.v1.TlsVersion", [
+  { no: 0, name: "TLS_VERSION_UNSPECIFIED" },
+  { no: 1, name: "TLS_VERSION_1_2" },
+  { no: 2, name: "TLS_VERSION_1_3" },
+]);
+
+/**
+ * @generated from enum libops.v1.TlsCertificateSource
+ */
+export enum TlsCertificateSource {
+  /**
+   * @generated from enum value: TLS_CERTIFICATE_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Issued and renewed automatically
+   *
+   * @generated from enum value: TLS_CERTIFICATE_SOURCE_ACME = 1;
+   */
+  ACME = 1,
+
+  /**
+   * Uploaded, and renewed by uploading again
+   *
+   * @generated from enum value: TLS_CERTIFICATE_SOURCE_CUSTOM = 2;
+   */
+  CUSTOM = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TlsCertificateSource)
+proto3.util.setEnumType(TlsCertificateSource, "libops.v1.TlsCertificateSource", [
+  { no: 0, name: "TLS_CERTIFICATE_SOURCE_UNSPECIFIED" },
+  { no: 1, name: "TLS_CERTIFICATE_SOURCE_ACME" },
+  { no: 2, name: "TLS_CERTIFICATE_SOURCE_CUSTOM" },
+]);
+
+/**
+ * @generated from enum libops.v1.TlsProbeStatus
+ */
+export enum TlsProbeStatus {
+  /**
+   * @generated from enum value: TLS_PROBE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The policy changed since the last probe
+   *
+   * @generated from enum value: TLS_PROBE_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: TLS_PROBE_STATUS_PASSED = 2;
+   */
+  PASSED = 2,
+
+  /**
+   * @generated from enum value: TLS_PROBE_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TlsProbeStatus)
+proto3.util.setEnumType(TlsProbeStatus, "libops.v1.TlsProbeStatus", [
+  { no: 0, name: "TLS_PROBE_STATUS_UNSPECIFIED" },
+  { no: 1, name: "TLS_PROBE_STATUS_PENDING" },
+  { no: 2, name: "TLS_PROBE_STATUS_PASSED" },
+  { no: 3, name: "TLS_PROBE_STATUS_FAILED" },
+]);
+
+/**
+ * @generated from message libops.v1.SiteTlsPolicy
+ */
+export class SiteTlsPolicy extends Message<SiteTlsPolicy> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: libops.v1.TlsVersion min_version = 2;
+   */
+  minVersion = TlsVersion.UNSPECIFIED;
+
+  /**
+   * How long browsers remember to only use HTTPS; 0 sends no HSTS header
+   *
+   * @generated from field: int32 hsts_max_age_seconds = 3;
+   */
+  hstsMaxAgeSeconds = 0;
+
+  /**
+   * @generated from field: bool hsts_include_subdomains = 4;
+   */
+  hstsIncludeSubdomains = false;
+
+  /**
+   * Asks to be on browsers' preload lists; needs a max age of a year or more
+   * and subdomains included
+   *
+   * @generated from field: bool hsts_preload = 5;
+   */
+  hstsPreload = false;
+
+  /**
+   * @generated from field: bool ocsp_stapling = 6;
+   */
+  ocspStapling = false;
+
+  /**
+   * @generated from field: libops.v1.TlsCertificateSource certificate_source = 7;
+   */
+  certificateSource = TlsCertificateSource.UNSPECIFIED;
+
+  /**
+   * The custom certificate; unset while the site serves its ACME one
+   *
+   * @generated from field: libops.v1.TlsCertificate certificate = 8;
+   */
+  certificate?: TlsCertificate;
+
+  /**
+   * @generated from field: libops.v1.TlsProbeStatus probe_status = 9;
+   */
+  probeStatus = TlsProbeStatus.UNSPECIFIED;
+
+  /**
+   * Why the last probe failed
+   *
+   * @generated from field: string probe_message = 10;
+   */
+  probeMessage = "";
+
+  /**
+   * Unix timestamp; 0 while the site has never been probed
+   *
+   * @generated from field: int64 probed_at = 11;
+   */
+  probedAt = protoInt64.zero;
+
+  /**
+   * Unix timestamp; 0 while the site has the default policy
+   *
+   * @generated from field: int64 updated_at = 12;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteTlsPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteTlsPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_version", kind: "enum", T: proto3.getEnumType(TlsVersion) },
+    { no: 3, name: "hsts_max_age_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "hsts_include_subdomains", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "hsts_preload", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "ocsp_stapling", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "certificate_source", kind: "enum", T: proto3.getEnumType(TlsCertificateSource) },
+    { no: 8, name: "certificate", kind: "message", T: TlsCertificate },
+    { no: 9, name: "probe_status", kind: "enum", T: proto3.getEnumType(TlsProbeStatus) },
+    { no: 10, name: "probe_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "probed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteTlsPolicy {
+    return new SiteTlsPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteTlsPolicy {
+    return new SiteTlsPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteTlsPolicy {
+    return new SiteTlsPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteTlsPolicy | PlainMessage<SiteTlsPolicy> | undefined, b: SiteTlsPolicy | PlainMessage<SiteTlsPolicy> | undefined): boolean {
+    return proto3.util.equals(SiteTlsPolicy, a, b);
+  }
+}
+
+/**
+ * TlsCertificate describes an uploaded certificate; its private key is never
+ * returned
+ *
+ * @generated from message libops.v1.TlsCertificate
+ */
+export class TlsCertificate extends Message<TlsCertificate> {
+  /**
+   * @generated from field: repeated string dns_names = 1;
+   */
+  dnsNames: string[] = [];
+
+  /**
+   * @generated from field: string issuer = 2;
+   */
+  issuer = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 not_before = 3;
+   */
+  notBefore = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 not_after = 4;
+   */
+  notAfter = protoInt64.zero;
+
+  /**
+   * Hex
+   *
+   * @generated from field: string sha256_fingerprint = 5;
+   */
+  sha256Fingerprint = "";
+
+  constructor(data?: PartialMessage<TlsCertificate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.TlsCertificate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dns_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "not_before", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "not_after", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "sha256_fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TlsCertificate {
+    return new TlsCertificate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TlsCertificate {
+    return new TlsCertificate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TlsCertificate {
+    return new TlsCertificate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TlsCertificate | PlainMessage<TlsCertificate> | undefined, b: TlsCertificate | PlainMessage<TlsCertificate> | undefined): boolean {
+    return proto3.util.equals(TlsCertificate, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteTlsPolicyRequest
+ */
+export class GetSiteTlsPolicyRequest extends Message<GetSiteTlsPolicyRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteTlsPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteTlsPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteTlsPolicyRequest {
+    return new GetSiteTlsPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteTlsPolicyRequest {
+    return new GetSiteTlsPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteTlsPolicyRequest {
+    return new GetSiteTlsPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteTlsPolicyRequest | PlainMessage<GetSiteTlsPolicyRequest> | undefined, b: GetSiteTlsPolicyRequest | PlainMessage<GetSiteTlsPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteTlsPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteTlsPolicyResponse
+ */
+export class GetSiteTlsPolicyResponse extends Message<GetSiteTlsPolicyResponse> {
+  /**
+   * @generated from field: libops.v1.SiteTlsPolicy policy = 1;
+   */
+  policy?: SiteTlsPolicy;
+
+  constructor(data?: PartialMessage<GetSiteTlsPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteTlsPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: SiteTlsPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteTlsPolicyResponse {
+    return new GetSiteTlsPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteTlsPolicyResponse {
+    return new GetSiteTlsPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteTlsPolicyResponse {
+    return new GetSiteTlsPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteTlsPolicyResponse | PlainMessage<GetSiteTlsPolicyResponse> | undefined, b: GetSiteTlsPolicyResponse | PlainMessage<GetSiteTlsPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteTlsPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteTlsPolicyRequest
+ */
+export class UpdateSiteTlsPolicyRequest extends Message<UpdateSiteTlsPolicyRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Defaults to TLS 1.2
+   *
+   * @generated from field: libops.v1.TlsVersion min_version = 2;
+   */
+  minVersion = TlsVersion.UNSPECIFIED;
+
+  /**
+   * @generated from field: int32 hsts_max_age_seconds = 3;
+   */
+  hstsMaxAgeSeconds = 0;
+
+  /**
+   * @generated from field: bool hsts_include_subdomains = 4;
+   */
+  hstsIncludeSubdomains = false;
+
+  /**
+   * @generated from field: bool hsts_preload = 5;
+   */
+  hstsPreload = false;
+
+  /**
+   * @generated from field: bool ocsp_stapling = 6;
+   */
+  ocspStapling = false;
+
+  constructor(data?: PartialMessage<UpdateSiteTlsPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteTlsPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_version", kind: "enum", T: proto3.getEnumType(TlsVersion) },
+    { no: 3, name: "hsts_max_age_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "hsts_include_subdomains", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "hsts_preload", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "ocsp_stapling", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteTlsPolicyRequest {
+    return new UpdateSiteTlsPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteTlsPolicyRequest {
+    return new UpdateSiteTlsPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteTlsPolicyRequest {
+    return new UpdateSiteTlsPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteTlsPolicyRequest | PlainMessage<UpdateSiteTlsPolicyRequest> | undefined, b: UpdateSiteTlsPolicyRequest | PlainMessage<UpdateSiteTlsPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteTlsPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteTlsPolicyResponse
+ */
+export class UpdateSiteTlsPolicyResponse extends Message<UpdateSiteTlsPolicyResponse> {
+  /**
+   * @generated from field: libops.v1.SiteTlsPolicy policy = 1;
+   */
+  policy?: SiteTlsPolicy;
+
+  constructor(data?: PartialMessage<UpdateSiteTlsPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteTlsPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: SiteTlsPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteTlsPolicyResponse {
+    return new UpdateSiteTlsPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteTlsPolicyResponse {
+    return new UpdateSiteTlsPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteTlsPolicyResponse {
+    return new UpdateSiteTlsPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteTlsPolicyResponse | PlainMessage<UpdateSiteTlsPolicyResponse> | undefined, b: UpdateSiteTlsPolicyResponse | PlainMessage<UpdateSiteTlsPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteTlsPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UploadSiteTlsCertificateRequest
+ */
+export class UploadSiteTlsCertificateRequest extends Message<UploadSiteTlsCertificateRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * PEM leaf certificate, followed by its intermediates
+   *
+   * @generated from field: string certificate_pem = 2;
+   */
+  certificatePem = "";
+
+  /**
+   * @generated from field: string private_key_pem = 3;
+   */
+  privateKeyPem = "";
+
+  constructor(data?: PartialMessage<UploadSiteTlsCertificateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UploadSiteTlsCertificateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "certificate_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "private_key_pem", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadSiteTlsCertificateRequest {
+    return new UploadSiteTlsCertificateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadSiteTlsCertificateRequest {
+    return new UploadSiteTlsCertificateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadSiteTlsCertificateRequest {
+    return new UploadSiteTlsCertificateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadSiteTlsCertificateRequest | PlainMessage<UploadSiteTlsCertificateRequest> | undefined, b: UploadSiteTlsCertificateRequest | PlainMessage<UploadSiteTlsCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(UploadSiteTlsCertificateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UploadSiteTlsCertificateResponse
+ */
+export class UploadSiteTlsCertificateResponse extends Message<UploadSiteTlsCertificateResponse> {
+  /**
+   * @generated from field: libops.v1.SiteTlsPolicy policy = 1;
+   */
+  policy?: SiteTlsPolicy;
+
+  constructor(data?: PartialMessage<UploadSiteTlsCertificateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UploadSiteTlsCertificateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: SiteTlsPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadSiteTlsCertificateResponse {
+    return new UploadSiteTlsCertificateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadSiteTlsCertificateResponse {
+    return new UploadSiteTlsCertificateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadSiteTlsCertificateResponse {
+    return new UploadSiteTlsCertificateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadSiteTlsCertificateResponse | PlainMessage<UploadSiteTlsCertificateResponse> | undefined, b: UploadSiteTlsCertificateResponse | PlainMessage<UploadSiteTlsCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(UploadSiteTlsCertificateResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteTlsCertificateRequest
+ */
+export class DeleteSiteTlsCertificateRequest extends Message<DeleteSiteTlsCertificateRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<DeleteSiteTlsCertificateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteTlsCertificateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteTlsCertificateRequest {
+    return new DeleteSiteTlsCertificateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteTlsCertificateRequest {
+    return new DeleteSiteTlsCertificateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteTlsCertificateRequest {
+    return new DeleteSiteTlsCertificateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteTlsCertificateRequest | PlainMessage<DeleteSiteTlsCertificateRequest> | undefined, b: DeleteSiteTlsCertificateRequest | PlainMessage<DeleteSiteTlsCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteTlsCertificateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteSiteTlsCertificateResponse
+ */
+export class DeleteSiteTlsCertificateResponse extends Message<DeleteSiteTlsCertificateResponse> {
+  /**
+   * @generated from field: libops.v1.SiteTlsPolicy policy = 1;
+   */
+  policy?: SiteTlsPolicy;
+
+  constructor(data?: PartialMessage<DeleteSiteTlsCertificateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteSiteTlsCertificateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: SiteTlsPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSiteTlsCertificateResponse {
+    return new DeleteSiteTlsCertificateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSiteTlsCertificateResponse {
+    return new DeleteSiteTlsCertificateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSiteTlsCertificateResponse {
+    return new DeleteSiteTlsCertificateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSiteTlsCertificateResponse | PlainMessage<DeleteSiteTlsCertificateResponse> | undefined, b: DeleteSiteTlsCertificateResponse | PlainMessage<DeleteSiteTlsCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSiteTlsCertificateResponse, a, b);
+  }
+}
+
@@ -0,0 +1,42 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/uptime.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetSiteUptimeRequest, GetSiteUptimeResponse, UpdateSiteHealthCheckRequest, UpdateSiteHealthCheckResponse } from "./uptime_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * UptimeService reports the results of external HTTP probes of each site's health URL
+ *
+ * @generated from service libops.v1.UptimeService
+ */
+export const UptimeService = {
+  typeName: "libops.v1.UptimeService",
+  methods: {
+    /**
+     * Get a site's uptime and response times over a window
+     *
+     * @generated from rpc libops.v1.UptimeService.GetSiteUptime
+     */
+    getSiteUptime: {
+      name: "GetSiteUptime",
+      I: GetSiteUptimeRequest,
+      O: GetSiteUptimeResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Set the path uptime probes request on a site
+     *
+     * @generated from rpc libops.v1.UptimeService.UpdateSiteHealthCheck
+     */
+    updateSiteHealthCheck: {
+      name: "UpdateSiteHealthCheck",
+      I: UpdateSiteHealthCheckRequest,
+      O: UpdateSiteHealthCheckResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,409 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/uptime.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * UptimeBucket aggregates the probes in one slice of the uptime window
+ *
+ * @generated from message libops.v1.UptimeBucket
+ */
+export class UptimeBucket extends Message<UptimeBucket> {
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 start_time = 1;
+   */
+  startTime = protoInt64.zero;
+
+  /**
+   * Probes in the bucket, 0 when the site was not probed
+   *
+   * @generated from field: int32 checks = 2;
+   */
+  checks = 0;
+
+  /**
+   * @generated from field: int32 failures = 3;
+   */
+  failures = 0;
+
+  /**
+   * Over successful probes, 0 when there were none
+   *
+   * @generated from field: int32 average_response_ms = 4;
+   */
+  averageResponseMs = 0;
+
+  constructor(data?: PartialMessage<UptimeBucket>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UptimeBucket";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failures", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "average_response_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UptimeBucket {
+    return new UptimeBucket().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UptimeBucket {
+    return new UptimeBucket().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UptimeBucket {
+    return new UptimeBucket().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UptimeBucket | PlainMessage<UptimeBucket> | undefined, b: UptimeBucket | PlainMessage<UptimeBucket> | undefined): boolean {
+    return proto3.util.equals(UptimeBucket, a, b);
+  }
+}
+
+/**
+ * UptimeProbe is the outcome of one request to a site's health URL
+ *
+ * @generated from message libops.v1.UptimeProbe
+ */
+export class UptimeProbe extends Message<UptimeProbe> {
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 probed_at = 1;
+   */
+  probedAt = protoInt64.zero;
+
+  /**
+   * A 2xx or 3xx response within the timeout
+   *
+   * @generated from field: bool up = 2;
+   */
+  up = false;
+
+  /**
+   * HTTP status, 0 when no response was received
+   *
+   * @generated from field: int32 status_code = 3;
+   */
+  statusCode = 0;
+
+  /**
+   * @generated from field: int32 response_ms = 4;
+   */
+  responseMs = 0;
+
+  /**
+   * Why the probe failed, empty when it was up
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<UptimeProbe>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UptimeProbe";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "probed_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "up", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "response_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UptimeProbe {
+    return new UptimeProbe().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UptimeProbe {
+    return new UptimeProbe().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UptimeProbe {
+    return new UptimeProbe().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UptimeProbe | PlainMessage<UptimeProbe> | undefined, b: UptimeProbe | PlainMessage<UptimeProbe> | undefined): boolean {
+    return proto3.util.equals(UptimeProbe, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.SiteUptime
+ */
+export class SiteUptime extends Message<SiteUptime> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string health_check_path = 2;
+   */
+  healthCheckPath = "";
+
+  /**
+   * @generated from field: int32 window_hours = 3;
+   */
+  windowHours = 0;
+
+  /**
+   * Share of successful probes in the window, 100 when there were none
+   *
+   * @generated from field: double uptime_percent = 4;
+   */
+  uptimePercent = 0;
+
+  /**
+   * @generated from field: int32 checks = 5;
+   */
+  checks = 0;
+
+  /**
+   * @generated from field: int32 failures = 6;
+   */
+  failures = 0;
+
+  /**
+   * @generated from field: int32 average_response_ms = 7;
+   */
+  averageResponseMs = 0;
+
+  /**
+   * @generated from field: int32 bucket_seconds = 8;
+   */
+  bucketSeconds = 0;
+
+  /**
+   * Oldest first, covering the whole window
+   *
+   * @generated from field: repeated libops.v1.UptimeBucket buckets = 9;
+   */
+  buckets: UptimeBucket[] = [];
+
+  /**
+   * Unset when the site was never probed
+   *
+   * @generated from field: libops.v1.UptimeProbe last_probe = 10;
+   */
+  lastProbe?: UptimeProbe;
+
+  constructor(data?: PartialMessage<SiteUptime>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteUptime";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "health_check_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "window_hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "uptime_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "checks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "failures", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "average_response_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "bucket_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "buckets", kind: "message", T: UptimeBucket, repeated: true },
+    { no: 10, name: "last_probe", kind: "message", T: UptimeProbe },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteUptime {
+    return new SiteUptime().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteUptime {
+    return new SiteUptime().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteUptime {
+    return new SiteUptime().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteUptime | PlainMessage<SiteUptime> | undefined, b: SiteUptime | PlainMessage<SiteUptime> | undefined): boolean {
+    return proto3.util.equals(SiteUptime, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteUptimeRequest
+ */
+export class GetSiteUptimeRequest extends Message<GetSiteUptimeRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Defaults to 24, at most 720 (30 days)
+   *
+   * @generated from field: int32 window_hours = 2;
+   */
+  windowHours = 0;
+
+  constructor(data?: PartialMessage<GetSiteUptimeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteUptimeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window_hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteUptimeRequest {
+    return new GetSiteUptimeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteUptimeRequest {
+    return new GetSiteUptimeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteUptimeRequest {
+    return new GetSiteUptimeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteUptimeRequest | PlainMessage<GetSiteUptimeRequest> | undefined, b: GetSiteUptimeRequest | PlainMessage<GetSiteUptimeRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteUptimeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteUptimeResponse
+ */
+export class GetSiteUptimeResponse extends Message<GetSiteUptimeResponse> {
+  /**
+   * @generated from field: libops.v1.SiteUptime uptime = 1;
+   */
+  uptime?: SiteUptime;
+
+  constructor(data?: PartialMessage<GetSiteUptimeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteUptimeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "uptime", kind: "message", T: SiteUptime },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteUptimeResponse {
+    return new GetSiteUptimeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteUptimeResponse {
+    return new GetSiteUptimeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteUptimeResponse {
+    return new GetSiteUptimeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteUptimeResponse | PlainMessage<GetSiteUptimeResponse> | undefined, b: GetSiteUptimeResponse | PlainMessage<GetSiteUptimeResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteUptimeResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteHealthCheckRequest
+ */
+export class UpdateSiteHealthCheckRequest extends Message<UpdateSiteHealthCheckRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * Absolute path with an optional query, e.g. "/healthz"
+   *
+   * @generated from field: string health_check_path = 2;
+   */
+  healthCheckPath = "";
+
+  constructor(data?: PartialMessage<UpdateSiteHealthCheckRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteHealthCheckRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "health_check_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteHealthCheckRequest {
+    return new UpdateSiteHealthCheckRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteHealthCheckRequest {
+    return new UpdateSiteHealthCheckRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteHealthCheckRequest {
+    return new UpdateSiteHealthCheckRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteHealthCheckRequest | PlainMessage<UpdateSiteHealthCheckRequest> | undefined, b: UpdateSiteHealthCheckRequest | PlainMessage<UpdateSiteHealthCheckRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteHealthCheckRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteHealthCheckResponse
+ */
+export class UpdateSiteHealthCheckResponse extends Message<UpdateSiteHealthCheckResponse> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string health_check_path = 2;
+   */
+  healthCheckPath = "";
+
+  constructor(data?: PartialMessage<UpdateSiteHealthCheckResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteHealthCheckResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "health_check_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteHealthCheckResponse {
+    return new UpdateSiteHealthCheckResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteHealthCheckResponse {
+    return new UpdateSiteHealthCheckResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteHealthCheckResponse {
+    return new UpdateSiteHealthCheckResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteHealthCheckResponse | PlainMessage<UpdateSiteHealthCheckResponse> | undefined, b: UpdateSiteHealthCheckResponse | PlainMessage<UpdateSiteHealthCheckResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteHealthCheckResponse, a, b);
+  }
+}
+
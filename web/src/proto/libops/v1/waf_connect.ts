@@ -0,0 +1,82 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file libops/v1/waf.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateWafRuleExclusionRequest, CreateWafRuleExclusionResponse, DeleteWafRuleExclusionRequest, GetSiteWafRequest, GetSiteWafResponse, GetWafReportRequest, GetWafReportResponse, UpdateSiteWafRequest, UpdateSiteWafResponse } from "./waf_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { Empty } from "../../google/protobuf/empty_pb.js";
+
+/**
+ * WafService manages a site's web application firewall: the OWASP core rule
+ * set, run by ModSecurity in the site's nginx. The WAF is off until it's
+ * enabled. Rules that get in a site's way can be excluded everywhere or under
+ * a path, and the requests the WAF matched are reported back by the site's
+ * controller. Changes reach the site with its next reconciliation, which each
+ * change queues.
+ *
+ * @generated from service libops.v1.WafService
+ */
+export const WafService = {
+  typeName: "libops.v1.WafService",
+  methods: {
+    /**
+     * Get a site's WAF config and rule exclusions
+     *
+     * @generated from rpc libops.v1.WafService.GetSiteWaf
+     */
+    getSiteWaf: {
+      name: "GetSiteWaf",
+      I: GetSiteWafRequest,
+      O: GetSiteWafResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Enable or disable a site's WAF, or change how strict it is
+     *
+     * @generated from rpc libops.v1.WafService.UpdateSiteWaf
+     */
+    updateSiteWaf: {
+      name: "UpdateSiteWaf",
+      I: UpdateSiteWafRequest,
+      O: UpdateSiteWafResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Exclude a core rule set rule from a site, everywhere or under a path
+     *
+     * @generated from rpc libops.v1.WafService.CreateWafRuleExclusion
+     */
+    createWafRuleExclusion: {
+      name: "CreateWafRuleExclusion",
+      I: CreateWafRuleExclusionRequest,
+      O: CreateWafRuleExclusionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remove a rule exclusion, so the rule applies again
+     *
+     * @generated from rpc libops.v1.WafService.DeleteWafRuleExclusion
+     */
+    deleteWafRuleExclusion: {
+      name: "DeleteWafRuleExclusion",
+      I: DeleteWafRuleExclusionRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the requests the WAF matched, per rule and most recent first
+     *
+     * @generated from rpc libops.v1.WafService.GetWafReport
+     */
+    getWafReport: {
+      name: "GetWafReport",
+      I: GetWafReportRequest,
+      O: GetWafReportResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
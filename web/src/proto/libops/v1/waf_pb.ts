@@ -0,0 +1,714 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file libops/v1/waf.proto (package libops.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum libops.v1.WafMode
+ */
+export enum WafMode {
+  /**
+   * @generated from enum value: WAF_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Matched requests are reported but let through
+   *
+   * @generated from enum value: WAF_MODE_DETECTION_ONLY = 1;
+   */
+  DETECTION_ONLY = 1,
+
+  /**
+   * Matched requests get a 403
+   *
+   * @generated from enum value: WAF_MODE_BLOCKING = 2;
+   */
+  BLOCKING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WafMode)
+proto3.util.setEnumType(WafMode, "libops.v1.WafMode", [
+  { no: 0, name: "WAF_MODE_UNSPECIFIED" },
+  { no: 1, name: "WAF_MODE_DETECTION_ONLY" },
+  { no: 2, name: "WAF_MODE_BLOCKING" },
+]);
+
+/**
+ * @generated from message libops.v1.SiteWaf
+ */
+export class SiteWaf extends Message<SiteWaf> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: libops.v1.WafMode mode = 3;
+   */
+  mode = WafMode.UNSPECIFIED;
+
+  /**
+   * OWASP core rule set paranoia level, 1 to 4; higher levels catch more
+   * attacks and more legitimate requests
+   *
+   * @generated from field: int32 paranoia_level = 4;
+   */
+  paranoiaLevel = 0;
+
+  /**
+   * @generated from field: repeated libops.v1.WafRuleExclusion exclusions = 5;
+   */
+  exclusions: WafRuleExclusion[] = [];
+
+  /**
+   * Unix timestamp; 0 while the WAF has never been enabled
+   *
+   * @generated from field: int64 updated_at = 6;
+   */
+  updatedAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SiteWaf>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.SiteWaf";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "mode", kind: "enum", T: proto3.getEnumType(WafMode) },
+    { no: 4, name: "paranoia_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "exclusions", kind: "message", T: WafRuleExclusion, repeated: true },
+    { no: 6, name: "updated_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SiteWaf {
+    return new SiteWaf().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SiteWaf {
+    return new SiteWaf().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SiteWaf {
+    return new SiteWaf().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SiteWaf | PlainMessage<SiteWaf> | undefined, b: SiteWaf | PlainMessage<SiteWaf> | undefined): boolean {
+    return proto3.util.equals(SiteWaf, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.WafRuleExclusion
+ */
+export class WafRuleExclusion extends Message<WafRuleExclusion> {
+  /**
+   * @generated from field: string exclusion_id = 1;
+   */
+  exclusionId = "";
+
+  /**
+   * Core rule set rule ID, e.g. 942100
+   *
+   * @generated from field: int32 rule_id = 2;
+   */
+  ruleId = 0;
+
+  /**
+   * Empty excludes the rule everywhere
+   *
+   * @generated from field: string path_prefix = 3;
+   */
+  pathPrefix = "";
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 created_at = 5;
+   */
+  createdAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WafRuleExclusion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.WafRuleExclusion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "exclusion_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "path_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WafRuleExclusion {
+    return new WafRuleExclusion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WafRuleExclusion {
+    return new WafRuleExclusion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WafRuleExclusion {
+    return new WafRuleExclusion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WafRuleExclusion | PlainMessage<WafRuleExclusion> | undefined, b: WafRuleExclusion | PlainMessage<WafRuleExclusion> | undefined): boolean {
+    return proto3.util.equals(WafRuleExclusion, a, b);
+  }
+}
+
+/**
+ * WafBlock is a request the WAF matched
+ *
+ * @generated from message libops.v1.WafBlock
+ */
+export class WafBlock extends Message<WafBlock> {
+  /**
+   * @generated from field: int32 rule_id = 1;
+   */
+  ruleId = 0;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string method = 3;
+   */
+  method = "";
+
+  /**
+   * @generated from field: string uri = 4;
+   */
+  uri = "";
+
+  /**
+   * @generated from field: string client_ip = 5;
+   */
+  clientIp = "";
+
+  /**
+   * False when the WAF only detected the request
+   *
+   * @generated from field: bool blocked = 6;
+   */
+  blocked = false;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 occurred_at = 7;
+   */
+  occurredAt = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WafBlock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.WafBlock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "client_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "blocked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "occurred_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WafBlock {
+    return new WafBlock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WafBlock {
+    return new WafBlock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WafBlock {
+    return new WafBlock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WafBlock | PlainMessage<WafBlock> | undefined, b: WafBlock | PlainMessage<WafBlock> | undefined): boolean {
+    return proto3.util.equals(WafBlock, a, b);
+  }
+}
+
+/**
+ * WafRuleReport is how often one rule matched
+ *
+ * @generated from message libops.v1.WafRuleReport
+ */
+export class WafRuleReport extends Message<WafRuleReport> {
+  /**
+   * @generated from field: int32 rule_id = 1;
+   */
+  ruleId = 0;
+
+  /**
+   * @generated from field: int64 matches = 2;
+   */
+  matches = protoInt64.zero;
+
+  /**
+   * Matches that were blocked rather than only detected
+   *
+   * @generated from field: int64 blocked = 3;
+   */
+  blocked = protoInt64.zero;
+
+  /**
+   * Unix timestamp
+   *
+   * @generated from field: int64 last_seen = 4;
+   */
+  lastSeen = protoInt64.zero;
+
+  constructor(data?: PartialMessage<WafRuleReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.WafRuleReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "matches", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "blocked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "last_seen", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WafRuleReport {
+    return new WafRuleReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WafRuleReport {
+    return new WafRuleReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WafRuleReport {
+    return new WafRuleReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WafRuleReport | PlainMessage<WafRuleReport> | undefined, b: WafRuleReport | PlainMessage<WafRuleReport> | undefined): boolean {
+    return proto3.util.equals(WafRuleReport, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteWafRequest
+ */
+export class GetSiteWafRequest extends Message<GetSiteWafRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  constructor(data?: PartialMessage<GetSiteWafRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteWafRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteWafRequest {
+    return new GetSiteWafRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteWafRequest {
+    return new GetSiteWafRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteWafRequest {
+    return new GetSiteWafRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteWafRequest | PlainMessage<GetSiteWafRequest> | undefined, b: GetSiteWafRequest | PlainMessage<GetSiteWafRequest> | undefined): boolean {
+    return proto3.util.equals(GetSiteWafRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetSiteWafResponse
+ */
+export class GetSiteWafResponse extends Message<GetSiteWafResponse> {
+  /**
+   * @generated from field: libops.v1.SiteWaf waf = 1;
+   */
+  waf?: SiteWaf;
+
+  constructor(data?: PartialMessage<GetSiteWafResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetSiteWafResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "waf", kind: "message", T: SiteWaf },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSiteWafResponse {
+    return new GetSiteWafResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSiteWafResponse {
+    return new GetSiteWafResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSiteWafResponse {
+    return new GetSiteWafResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSiteWafResponse | PlainMessage<GetSiteWafResponse> | undefined, b: GetSiteWafResponse | PlainMessage<GetSiteWafResponse> | undefined): boolean {
+    return proto3.util.equals(GetSiteWafResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteWafRequest
+ */
+export class UpdateSiteWafRequest extends Message<UpdateSiteWafRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled = false;
+
+  /**
+   * Defaults to blocking
+   *
+   * @generated from field: libops.v1.WafMode mode = 3;
+   */
+  mode = WafMode.UNSPECIFIED;
+
+  /**
+   * Defaults to 1
+   *
+   * @generated from field: int32 paranoia_level = 4;
+   */
+  paranoiaLevel = 0;
+
+  constructor(data?: PartialMessage<UpdateSiteWafRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteWafRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "mode", kind: "enum", T: proto3.getEnumType(WafMode) },
+    { no: 4, name: "paranoia_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteWafRequest {
+    return new UpdateSiteWafRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteWafRequest {
+    return new UpdateSiteWafRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteWafRequest {
+    return new UpdateSiteWafRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteWafRequest | PlainMessage<UpdateSiteWafRequest> | undefined, b: UpdateSiteWafRequest | PlainMessage<UpdateSiteWafRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteWafRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.UpdateSiteWafResponse
+ */
+export class UpdateSiteWafResponse extends Message<UpdateSiteWafResponse> {
+  /**
+   * @generated from field: libops.v1.SiteWaf waf = 1;
+   */
+  waf?: SiteWaf;
+
+  constructor(data?: PartialMessage<UpdateSiteWafResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.UpdateSiteWafResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "waf", kind: "message", T: SiteWaf },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSiteWafResponse {
+    return new UpdateSiteWafResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSiteWafResponse {
+    return new UpdateSiteWafResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSiteWafResponse {
+    return new UpdateSiteWafResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSiteWafResponse | PlainMessage<UpdateSiteWafResponse> | undefined, b: UpdateSiteWafResponse | PlainMessage<UpdateSiteWafResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSiteWafResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateWafRuleExclusionRequest
+ */
+export class CreateWafRuleExclusionRequest extends Message<CreateWafRuleExclusionRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: int32 rule_id = 2;
+   */
+  ruleId = 0;
+
+  /**
+   * Starts with "/"; empty excludes the rule everywhere
+   *
+   * @generated from field: string path_prefix = 3;
+   */
+  pathPrefix = "";
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<CreateWafRuleExclusionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateWafRuleExclusionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "path_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWafRuleExclusionRequest {
+    return new CreateWafRuleExclusionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWafRuleExclusionRequest {
+    return new CreateWafRuleExclusionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWafRuleExclusionRequest {
+    return new CreateWafRuleExclusionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWafRuleExclusionRequest | PlainMessage<CreateWafRuleExclusionRequest> | undefined, b: CreateWafRuleExclusionRequest | PlainMessage<CreateWafRuleExclusionRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWafRuleExclusionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.CreateWafRuleExclusionResponse
+ */
+export class CreateWafRuleExclusionResponse extends Message<CreateWafRuleExclusionResponse> {
+  /**
+   * @generated from field: libops.v1.WafRuleExclusion exclusion = 1;
+   */
+  exclusion?: WafRuleExclusion;
+
+  constructor(data?: PartialMessage<CreateWafRuleExclusionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.CreateWafRuleExclusionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "exclusion", kind: "message", T: WafRuleExclusion },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWafRuleExclusionResponse {
+    return new CreateWafRuleExclusionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWafRuleExclusionResponse {
+    return new CreateWafRuleExclusionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWafRuleExclusionResponse {
+    return new CreateWafRuleExclusionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWafRuleExclusionResponse | PlainMessage<CreateWafRuleExclusionResponse> | undefined, b: CreateWafRuleExclusionResponse | PlainMessage<CreateWafRuleExclusionResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWafRuleExclusionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.DeleteWafRuleExclusionRequest
+ */
+export class DeleteWafRuleExclusionRequest extends Message<DeleteWafRuleExclusionRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * @generated from field: string exclusion_id = 2;
+   */
+  exclusionId = "";
+
+  constructor(data?: PartialMessage<DeleteWafRuleExclusionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.DeleteWafRuleExclusionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "exclusion_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWafRuleExclusionRequest {
+    return new DeleteWafRuleExclusionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWafRuleExclusionRequest {
+    return new DeleteWafRuleExclusionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWafRuleExclusionRequest {
+    return new DeleteWafRuleExclusionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWafRuleExclusionRequest | PlainMessage<DeleteWafRuleExclusionRequest> | undefined, b: DeleteWafRuleExclusionRequest | PlainMessage<DeleteWafRuleExclusionRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWafRuleExclusionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetWafReportRequest
+ */
+export class GetWafReportRequest extends Message<GetWafReportRequest> {
+  /**
+   * @generated from field: string site_id = 1;
+   */
+  siteId = "";
+
+  /**
+   * How far back to report, at most 720; defaults to 24
+   *
+   * @generated from field: int32 hours = 2;
+   */
+  hours = 0;
+
+  constructor(data?: PartialMessage<GetWafReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetWafReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "site_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWafReportRequest {
+    return new GetWafReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWafReportRequest {
+    return new GetWafReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWafReportRequest {
+    return new GetWafReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWafReportRequest | PlainMessage<GetWafReportRequest> | undefined, b: GetWafReportRequest | PlainMessage<GetWafReportRequest> | undefined): boolean {
+    return proto3.util.equals(GetWafReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message libops.v1.GetWafReportResponse
+ */
+export class GetWafReportResponse extends Message<GetWafReportResponse> {
+  /**
+   * @generated from field: repeated libops.v1.WafRuleReport rules = 1;
+   */
+  rules: WafRuleReport[] = [];
+
+  /**
+   * At most 100
+   *
+   * @generated from field: repeated libops.v1.WafBlock recent = 2;
+   */
+  recent: WafBlock[] = [];
+
+  constructor(data?: PartialMessage<GetWafReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "libops.v1.GetWafReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: WafRuleReport, repeated: true },
+    { no: 2, name: "recent", kind: "message", T: WafBlock, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWafReportResponse {
+    return new GetWafReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWafReportResponse {
+    return new GetWafReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWafReportResponse {
+    return new GetWafReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWafReportResponse | PlainMessage<GetWafReportResponse> | undefined, b: GetWafReportResponse | PlainMessage<GetWafReportResponse> | undefined): boolean {
+    return proto3.util.equals(GetWafReportResponse, a, b);
+  }
+}
+
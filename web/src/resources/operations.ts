@@ -10,24 +10,61 @@ import {
   memberClient,
   projectMemberClient,
   siteMemberClient,
+  siteOperationsClient,
   organizationSecretClient,
   projectSecretClient,
   siteSecretClient,
   organizationSettingClient,
   projectSettingClient,
   siteSettingClient,
+  notificationChannelClient,
+  uptimeClient,
+  siteEgressClient,
+  siteCdnClient,
+  sshAccessClient,
+  elevationClient,
+  siteConfigVarClient,
+  encryptionKeyClient,
 } from "@/api/client";
+import { Code, ConnectError } from "@connectrpc/connect";
+import { AlertCategory, NotificationChannelKind } from "@proto/libops/v1/notification_channel_pb";
+import { ConfigVarChangeType } from "@proto/libops/v1/config_var_pb";
 import { getPageContext } from "@/utils/context";
 import { showNotification, capitalize, singularize } from "@/utils/helpers";
 import { closeModal } from "@/utils/modal";
 
+// parseLabels converts "key=value" lines (or comma-separated pairs) from the
+// labels textarea into a labels map.
+export function parseLabels(input?: string): { [key: string]: string } {
+  const labels: { [key: string]: string } = {};
+  if (!input) {
+    return labels;
+  }
+  for (const term of input.split(/[\n,]/)) {
+    const trimmed = term.trim();
+    if (!trimmed) {
+      continue;
+    }
+    const [key, ...rest] = trimmed.split("=");
+    labels[key.trim()] = rest.join("=").trim();
+  }
+  return labels;
+}
+
+// isConflict reports whether an update was refused because someone else
+// changed the resource after its etag was read.
+export function isConflict(error: unknown): boolean {
+  return error instanceof ConnectError && error.code === Code.FailedPrecondition;
+}
+
 // Organization operations
-export async function createOrganization(data: { name: string; description?: string }) {
+export async function createOrganization(data: { name: string; description?: string; labels?: string }) {
   try {
     const response = await organizationClient.createOrganization({
       folder: {
         name: data.name,
         description: data.description || "",
+        labels: parseLabels(data.labels),
       },
     });
     showNotification("success", "Organization created successfully");
@@ -42,22 +79,27 @@ export async function createOrganization(data: { name: string; description?: str
 
 export async function updateOrganization(
   organizationId: string,
-  data: { name?: string; description?: string }
+  data: { name?: string; labels?: string; etag?: string }
 ) {
   try {
     const response = await organizationClient.updateOrganization({
       organizationId,
       folder: {
-        name: data.name || "",
-        description: data.description || "",
+        organizationName: data.name || "",
+        labels: parseLabels(data.labels),
+        etag: data.etag || "",
       },
+      updateMask: { paths: ["folder.organization_name", "folder.labels"] },
     });
     showNotification("success", "Organization updated successfully");
     closeModal();
     window.location.reload();
     return response;
   } catch (error) {
-    showNotification("error", (error as Error).message);
+    // The edit form offers to resolve conflicts instead
+    if (!isConflict(error)) {
+      showNotification("error", (error as Error).message);
+    }
     throw error;
   }
 }
@@ -87,6 +129,7 @@ export async function createProject(data: {
   machine_type?: string;
   disk_size_gb?: string;
   create_branch_sites?: boolean;
+  labels?: string;
 }) {
   try {
     const response = await projectClient.createProject({
@@ -100,6 +143,7 @@ export async function createProject(data: {
         zone: data.zone || "",
         machineType: data.machine_type || "",
         diskSizeGb: data.disk_size_gb ? parseInt(data.disk_size_gb) : 20,
+        labels: parseLabels(data.labels),
       },
     });
     showNotification("success", "Project created successfully");
@@ -115,23 +159,27 @@ export async function createProject(data: {
 export async function updateProject(
   organizationId: string,
   projectId: string,
-  data: { name?: string; description?: string }
+  data: { name?: string; labels?: string; etag?: string }
 ) {
   try {
     const response = await projectClient.updateProject({
       organizationId,
       projectId,
       project: {
-        name: data.name || "",
-        description: data.description || "",
+        projectName: data.name || "",
+        labels: parseLabels(data.labels),
+        etag: data.etag || "",
       },
+      updateMask: { paths: ["project.project_name", "project.labels"] },
     });
     showNotification("success", "Project updated successfully");
     closeModal();
     window.location.reload();
     return response;
   } catch (error) {
-    showNotification("error", (error as Error).message);
+    if (!isConflict(error)) {
+      showNotification("error", (error as Error).message);
+    }
     throw error;
   }
 }
@@ -161,6 +209,7 @@ export async function createSite(data: {
   compose_path?: string;
   compose_file?: string;
   port?: string;
+  labels?: string;
 }) {
   try {
     const response = await siteClient.createSite({
@@ -176,6 +225,7 @@ export async function createSite(data: {
         composePath: data.compose_path || "",
         composeFile: data.compose_file || "docker-compose.yml",
         port: data.port ? parseInt(data.port) : 80,
+        labels: parseLabels(data.labels),
       },
     });
     showNotification("success", "Site created successfully");
@@ -190,22 +240,27 @@ export async function createSite(data: {
 
 export async function updateSite(
   siteId: string,
-  data: { name?: string; gitRepoUrl?: string }
+  data: { name?: string; github_ref?: string; labels?: string; etag?: string }
 ) {
   try {
     const response = await siteClient.updateSite({
       siteId,
       site: {
-        name: data.name || "",
-        gitRepoUrl: data.gitRepoUrl || "",
+        siteName: data.name || "",
+        githubRef: data.github_ref || "",
+        labels: parseLabels(data.labels),
+        etag: data.etag || "",
       },
+      updateMask: { paths: ["site.site_name", "site.github_ref", "site.labels"] },
     });
     showNotification("success", "Site updated successfully");
     closeModal();
     window.location.reload();
     return response;
   } catch (error) {
-    showNotification("error", (error as Error).message);
+    if (!isConflict(error)) {
+      showNotification("error", (error as Error).message);
+    }
     throw error;
   }
 }
@@ -312,41 +367,31 @@ export async function createMember(data: {
   organizationId?: string;
   projectId?: string;
   siteId?: string;
-  accountId: string;
+  accountId?: string;
+  email?: string;
   role: string;
 }) {
+  const member = { accountId: data.accountId || "", email: data.email || "", role: data.role };
   try {
+    let response;
     if (data.siteId) {
-      const response = await siteMemberClient.createSiteMember({
-        siteId: data.siteId,
-        accountId: data.accountId,
-        role: data.role,
-      });
-      showNotification("success", "Member added successfully");
-      closeModal();
-      window.location.reload();
-      return response;
+      response = await siteMemberClient.createSiteMember({ siteId: data.siteId, ...member });
     } else if (data.projectId) {
-      const response = await projectMemberClient.createProjectMember({
-        projectId: data.projectId,
-        accountId: data.accountId,
-        role: data.role,
-      });
-      showNotification("success", "Member added successfully");
-      closeModal();
-      window.location.reload();
-      return response;
+      response = await projectMemberClient.createProjectMember({ projectId: data.projectId, ...member });
     } else if (data.organizationId) {
-      const response = await memberClient.createOrganizationMember({
-        organizationId: data.organizationId,
-        accountId: data.accountId,
-        role: data.role,
-      });
+      response = await memberClient.createOrganizationMember({ organizationId: data.organizationId, ...member });
+    } else {
+      return;
+    }
+    // People without an account are emailed an invitation instead of being added right away
+    if (response.invitation) {
+      showNotification("success", `Invitation sent to ${response.invitation.email}`);
+    } else {
       showNotification("success", "Member added successfully");
-      closeModal();
-      window.location.reload();
-      return response;
     }
+    closeModal();
+    window.location.reload();
+    return response;
   } catch (error) {
     showNotification("error", (error as Error).message);
     throw error;
@@ -430,6 +475,115 @@ export async function deleteMember(accountId: string) {
   }
 }
 
+// memberRow returns the members table row holding el, which records the membership's
+// account and the organization, project or site it belongs to
+function memberRow(el: HTMLElement): HTMLTableRowElement | null {
+  const row = el.closest("tr");
+  if (!row || !row.dataset.accountId || !row.dataset.parentType || !row.dataset.parentId) {
+    showNotification("error", "Member not found");
+    return null;
+  }
+  return row;
+}
+
+// changeMemberRole saves the role picked in a members table row. The picker shows the
+// new role right away and goes back to the previous one if the change is rejected.
+export async function changeMemberRole(select: HTMLSelectElement) {
+  const row = memberRow(select);
+  if (!row) {
+    return;
+  }
+  const accountId = row.dataset.accountId!;
+  const parentId = row.dataset.parentId!;
+  const previousRole = row.dataset.role || "";
+  const role = select.value;
+
+  row.dataset.role = role;
+  select.disabled = true;
+  try {
+    switch (row.dataset.parentType) {
+      case "site":
+        await siteMemberClient.updateSiteMember({ siteId: parentId, accountId, role });
+        break;
+      case "project":
+        await projectMemberClient.updateProjectMember({ projectId: parentId, accountId, role });
+        break;
+      default:
+        await memberClient.updateOrganizationMember({ organizationId: parentId, accountId, role });
+    }
+    showNotification("success", `Role changed to ${capitalize(role)}`);
+  } catch (error) {
+    row.dataset.role = previousRole;
+    select.value = previousRole;
+    showNotification("error", (error as Error).message);
+  } finally {
+    select.disabled = false;
+  }
+}
+
+// changeSshAccess saves the SSH access picked for a read member of a site: none, a
+// shell, or an SFTP-only login to the site's files. Like the role picker, it goes back
+// to the previous access if the change is rejected.
+export async function changeSshAccess(select: HTMLSelectElement) {
+  const row = memberRow(select);
+  if (!row) {
+    return;
+  }
+  const accountId = row.dataset.accountId!;
+  const siteId = row.dataset.parentId!;
+  const previousAccess = row.dataset.sshAccess || "none";
+  const access = select.value;
+
+  row.dataset.sshAccess = access;
+  select.disabled = true;
+  try {
+    if (access === "none") {
+      await sshAccessClient.revokeSshAccess({ siteId, accountId });
+    } else if (previousAccess === "none") {
+      await sshAccessClient.grantSshAccess({ siteId, accountId, sftpOnly: access === "sftp" });
+    } else {
+      await sshAccessClient.updateSshAccess({ siteId, accountId, sftpOnly: access === "sftp" });
+    }
+    showNotification("success", "SSH access updated");
+  } catch (error) {
+    row.dataset.sshAccess = previousAccess;
+    select.value = previousAccess;
+    showNotification("error", (error as Error).message);
+  } finally {
+    select.disabled = false;
+  }
+}
+
+// removeMember removes the member in a members table row. The row is hidden right away
+// and shown again if the removal fails.
+export async function removeMember(button: HTMLElement) {
+  const row = memberRow(button);
+  if (!row || !confirm("Are you sure you want to remove this member?")) {
+    return;
+  }
+  const accountId = row.dataset.accountId!;
+  const parentId = row.dataset.parentId!;
+
+  row.classList.add("hidden");
+  try {
+    switch (row.dataset.parentType) {
+      case "site":
+        await siteMemberClient.deleteSiteMember({ siteId: parentId, accountId });
+        break;
+      case "project":
+        await projectMemberClient.deleteProjectMember({ projectId: parentId, accountId });
+        break;
+      default:
+        await memberClient.deleteOrganizationMember({ organizationId: parentId, accountId });
+    }
+    row.remove();
+    showNotification("success", "Member removed successfully");
+  } catch (error) {
+    row.classList.remove("hidden");
+    showNotification("error", (error as Error).message);
+  }
+}
+
 // Secret operations
 export async function createSecret(data: {
   organizationId?: string;
@@ -700,6 +854,469 @@ export async function deleteSetting(settingId: string) {
   }
 }
 
+// Notification channel operations
+export async function createNotificationChannel(data: {
+  organizationId: string;
+  name: string;
+  kind: number;
+  webhookUrl?: string;
+  slackBotToken?: string;
+  slackChannel?: string;
+  integrationKey?: string;
+  categories: number[];
+}) {
+  try {
+    const response = await notificationChannelClient.createNotificationChannel({
+      organizationId: data.organizationId,
+      name: data.name,
+      kind: data.kind as NotificationChannelKind,
+      webhookUrl: data.webhookUrl || "",
+      slackBotToken: data.slackBotToken || "",
+      slackChannel: data.slackChannel || "",
+      integrationKey: data.integrationKey || "",
+      categories: data.categories as AlertCategory[],
+    });
+    showNotification("success", "Alert channel created successfully");
+    closeModal();
+    window.location.reload();
+    return response;
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function setNotificationChannelEnabled(channelId: string, enabled: boolean) {
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+
+  try {
+    await notificationChannelClient.updateNotificationChannel({
+      organizationId: context.organizationId,
+      channelId,
+      enabled,
+      updateMask: { paths: ["enabled"] },
+    });
+    showNotification("success", enabled ? "Alert channel enabled" : "Alert channel paused");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function testNotificationChannel(channelId: string) {
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+
+  try {
+    const response = await notificationChannelClient.testNotificationChannel({
+      organizationId: context.organizationId,
+      channelId,
+    });
+    const channel = response.channel;
+    // PagerDuty and Opsgenie channels are paged right away, so the outcome is already known
+    if (
+      channel?.kind === NotificationChannelKind.PAGERDUTY ||
+      channel?.kind === NotificationChannelKind.OPSGENIE
+    ) {
+      if (channel.lastError) {
+        showNotification("error", `Test page failed: ${channel.lastError}`);
+      } else {
+        showNotification("success", "Test page sent and resolved");
+      }
+      return;
+    }
+    showNotification("success", "Test alert queued, it should arrive within a minute");
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function deleteNotificationChannel(channelId: string) {
+  if (!confirm("Are you sure you want to delete this alert channel?")) {
+    return;
+  }
+
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+
+  try {
+    await notificationChannelClient.deleteNotificationChannel({
+      organizationId: context.organizationId,
+      channelId,
+    });
+    showNotification("success", "Alert channel deleted successfully");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+// requestElevation asks the site's owners for a higher role on the site for a while,
+// e.g. to respond to an incident. The role lapses on its own.
+export async function requestElevation(siteId: string) {
+  const role = prompt("Role to request: developer or owner", "developer");
+  if (role === null) {
+    return;
+  }
+  const reason = prompt("Why do you need it? e.g. a link to the incident");
+  if (reason === null) {
+    return;
+  }
+  const minutes = prompt("For how many minutes? Up to 1440", "60");
+  if (minutes === null) {
+    return;
+  }
+
+  try {
+    await elevationClient.requestElevation({
+      siteId,
+      role: role.trim().toLowerCase(),
+      reason: reason.trim(),
+      durationMinutes: parseInt(minutes, 10) || 0,
+    });
+    showNotification("success", "Elevated access requested; the site's owners have been notified");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function approveElevation(siteId: string, elevationId: string) {
+  if (!confirm("Approve this request? The role starts now and lapses after the requested time.")) {
+    return;
+  }
+
+  try {
+    await elevationClient.approveElevation({ siteId, elevationId });
+    showNotification("success", "Elevated access approved");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function rejectElevation(siteId: string, elevationId: string) {
+  try {
+    await elevationClient.rejectElevation({ siteId, elevationId });
+    showNotification("success", "Elevated access rejected");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function revokeElevation(siteId: string, elevationId: string) {
+  if (!confirm("Revoke this elevated access now?")) {
+    return;
+  }
+
+  try {
+    await elevationClient.revokeElevation({ siteId, elevationId });
+    showNotification("success", "Elevated access revoked");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function editHealthCheckPath(siteId: string, currentPath: string) {
+  const path = prompt("Path uptime probes request on this site, e.g. /healthz", currentPath);
+  if (path === null || path.trim() === currentPath) {
+    return;
+  }
+
+  try {
+    await uptimeClient.updateSiteHealthCheck({
+      siteId,
+      healthCheckPath: path.trim(),
+    });
+    showNotification("success", "Health check path updated");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+// setConfigVar adds a config var to a site, asking for its name and value.
+export async function setConfigVar(siteId: string) {
+  const name = prompt("Name, e.g. PHP_MEMORY_LIMIT");
+  if (!name || !name.trim()) {
+    return;
+  }
+  const value = prompt(`Value of ${name.trim()}`);
+  if (value === null) {
+    return;
+  }
+  await saveConfigVar(siteId, name.trim(), value);
+}
+
+// editConfigVar changes the value of the config var in a config vars table row.
+export async function editConfigVar(button: HTMLElement, siteId: string) {
+  const row = button.closest("tr");
+  const name = row?.dataset.name;
+  if (!row || !name) {
+    return;
+  }
+  const current = row.dataset.value || "";
+  const value = prompt(`Value of ${name}`, current);
+  if (value === null || value === current) {
+    return;
+  }
+  await saveConfigVar(siteId, name, value);
+}
+
+async function saveConfigVar(siteId: string, name: string, value: string) {
+  try {
+    await siteConfigVarClient.setConfigVar({ siteId, name, value });
+    showNotification("success", `${name} saved, the site picks it up with its next reconciliation`);
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+// deleteConfigVar removes the config var in a config vars table row.
+export async function deleteConfigVar(button: HTMLElement, siteId: string) {
+  const name = button.closest("tr")?.dataset.name;
+  if (!name || !confirm(`Remove ${name} from this site's environment?`)) {
+    return;
+  }
+
+  try {
+    await siteConfigVarClient.deleteConfigVar({ siteId, name });
+    showNotification("success", `${name} removed`);
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+// compareConfigVars shows how a site's config vars changed between an earlier
+// revision and the latest one.
+export async function compareConfigVars(siteId: string, latest: number) {
+  const input = prompt(`Compare revision ${latest} with which earlier revision?`, String(Math.max(latest - 1, 0)));
+  if (input === null) {
+    return;
+  }
+  const from = Number.parseInt(input, 10);
+  if (Number.isNaN(from) || from < 0 || from > latest) {
+    showNotification("error", `Pick a revision between 0 and ${latest}`);
+    return;
+  }
+
+  try {
+    const resp = await siteConfigVarClient.diffConfigVars({ siteId, fromRevision: BigInt(from) });
+    if (resp.diffs.length === 0) {
+      alert(`No differences between revisions ${resp.fromRevision} and ${resp.toRevision}`);
+      return;
+    }
+    const lines = resp.diffs.map((diff) => {
+      switch (diff.changeType) {
+        case ConfigVarChangeType.ADDED:
+          return `+ ${diff.name}=${diff.toValue}`;
+        case ConfigVarChangeType.REMOVED:
+          return `- ${diff.name}=${diff.fromValue}`;
+        default:
+          return `~ ${diff.name}: ${diff.fromValue} → ${diff.toValue}`;
+      }
+    });
+    alert(`Revision ${resp.fromRevision} → ${resp.toRevision}\n\n${lines.join("\n")}`);
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function requestStaticEgressIp(siteId: string) {
+  if (!confirm("Reserve a static egress IP for this site? It's assigned by the next reconciliation.")) {
+    return;
+  }
+
+  try {
+    await siteEgressClient.requestStaticEgressIp({ siteId });
+    showNotification("success", "Static egress IP requested");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function releaseStaticEgressIp(siteId: string, ipAddress: string) {
+  if (!confirm(`Release ${ipAddress || "this site's static egress IP"}? Vendors allowlisting it will stop accepting the site's traffic.`)) {
+    return;
+  }
+
+  try {
+    await siteEgressClient.releaseStaticEgressIp({ siteId });
+    showNotification("success", "Static egress IP released");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function enableSiteCdn(siteId: string) {
+  if (!confirm("Put a CDN in front of this site? It's built by the next reconciliation, then the site's domains need to point at its address.")) {
+    return;
+  }
+
+  try {
+    await siteCdnClient.enableSiteCdn({ siteId });
+    showNotification("success", "CDN enabled");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function disableSiteCdn(siteId: string) {
+  if (!confirm("Disable this site's CDN? Point the site's domains back at the site first, or they stop working once it's removed.")) {
+    return;
+  }
+
+  try {
+    await siteCdnClient.disableSiteCdn({ siteId });
+    showNotification("success", "CDN disabled");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function purgeSiteCache(siteId: string) {
+  const path = prompt("Path to purge; a trailing * purges everything under it", "/*");
+  if (path === null) {
+    return;
+  }
+
+  try {
+    await siteCdnClient.purgeSiteCache({ siteId, paths: [path.trim()] });
+    showNotification("success", "Cache purge queued");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function deploySite(siteId: string, defaultRef: string) {
+  const gitRef = prompt("Branch, tag or commit to deploy, e.g. heads/main or tags/v1.2.0", defaultRef);
+  if (gitRef === null || gitRef.trim() === "") {
+    return;
+  }
+
+  try {
+    await siteOperationsClient.deploySite({
+      siteId,
+      gitRef: gitRef.trim(),
+    });
+    showNotification("success", `Deploying ${gitRef.trim()}`);
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function rollbackSite(siteId: string, deploymentId: string, label: string) {
+  if (!confirm(`Roll this site back to ${label}?`)) {
+    return;
+  }
+
+  try {
+    await siteOperationsClient.rollbackSite({
+      siteId,
+      deploymentId,
+    });
+    showNotification("success", `Rolling back to ${label}`);
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function setEncryptionKey() {
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+  const kmsKeyName = prompt("Cloud KMS key (projects/PROJECT/locations/LOCATION/keyRings/KEY_RING/cryptoKeys/KEY)");
+  if (!kmsKeyName) {
+    return;
+  }
+
+  try {
+    await encryptionKeyClient.setEncryptionKey({ organizationId: context.organizationId, kmsKeyName: kmsKeyName.trim() });
+    showNotification("success", "Encryption key set; it's applied by the next reconciliation");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function checkEncryptionKeys() {
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+
+  try {
+    await encryptionKeyClient.checkEncryptionKeys({ organizationId: context.organizationId });
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
+export async function deleteEncryptionKey(location: string) {
+  const context = getPageContext();
+  if (!context.organizationId) {
+    showNotification("error", "Organization ID not found");
+    return;
+  }
+  if (!confirm(`Stop using this key in ${location}? Data already encrypted with it still needs it, so don't disable the key.`)) {
+    return;
+  }
+
+  try {
+    await encryptionKeyClient.deleteEncryptionKey({ organizationId: context.organizationId, location });
+    showNotification("success", "Encryption key removed");
+    window.location.reload();
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+    throw error;
+  }
+}
+
 // Generic delete resource function
 export async function deleteResource(resourceType: string, resourceId: string) {
   const context = getPageContext();
@@ -723,6 +1340,8 @@ export async function deleteResource(resourceType: string, resourceId: string) {
       return deleteSecret(resourceId);
     case "setting":
       return deleteSetting(resourceId);
+    case "channel":
+      return deleteNotificationChannel(resourceId);
     default:
       showNotification("error", `Unknown resource type: ${resourceType}`);
   }
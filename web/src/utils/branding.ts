@@ -0,0 +1,131 @@
+// Organization branding dialog on the organization page: the colors and logo its
+// pages and notification emails are shown with (BrandingService).
+import { getBranding, updateBranding } from "@/api/branding";
+import { closeModal, openModal } from "@/utils/modal";
+import { showNotification } from "@/utils/helpers";
+
+// Defaults from internal/branding, shown in the pickers when no color is chosen
+const DEFAULT_PRIMARY = "#7f1d1d";
+const DEFAULT_ACCENT = "#10b981";
+// internal/branding.MaxLogoSize
+const MAX_LOGO_SIZE = 256 * 1024;
+
+// openBranding shows the branding dialog for an organization
+export async function openBranding(organizationId: string) {
+  const branding = await getBranding(organizationId);
+  if (!branding) {
+    return;
+  }
+
+  const form = document.createElement("form");
+  form.className = "space-y-4";
+
+  const primary = colorField("primary_color", "Buttons and headers", branding.primaryColor, DEFAULT_PRIMARY);
+  const accent = colorField("accent_color", "Links and highlights", branding.accentColor, DEFAULT_ACCENT);
+
+  const logo = document.createElement("input");
+  logo.type = "file";
+  logo.accept = "image/png,image/jpeg";
+  logo.id = "branding-logo";
+  logo.className = "block w-full text-sm text-gray-700";
+  const logoRow = document.createElement("div");
+  const logoLabel = document.createElement("label");
+  logoLabel.htmlFor = logo.id;
+  logoLabel.className = "block text-sm font-medium text-gray-700 mb-1";
+  logoLabel.textContent = "Logo (PNG or JPEG, at most 256 KiB)";
+  logoRow.append(logoLabel);
+  if (branding.logoUrl) {
+    const preview = document.createElement("img");
+    preview.src = branding.logoUrl;
+    preview.alt = "";
+    preview.className = "h-8 w-auto mb-2";
+    logoRow.append(preview);
+  }
+  logoRow.append(logo);
+  const removeLogo = checkbox("remove_logo", "Remove the logo");
+  if (branding.logoUrl) {
+    logoRow.append(removeLogo.row);
+  }
+
+  const submit = document.createElement("button");
+  submit.type = "submit";
+  submit.className = "px-4 py-2 bg-red-900 text-white text-sm font-medium rounded-lg hover:bg-red-950";
+  submit.textContent = "Save";
+  const actions = document.createElement("div");
+  actions.className = "flex justify-end";
+  actions.appendChild(submit);
+
+  form.append(primary.row, accent.row, logoRow, actions);
+  form.addEventListener("submit", async (e) => {
+    e.preventDefault();
+    const changes: { primaryColor: string; accentColor: string; logo?: Uint8Array } = {
+      primaryColor: primary.value(),
+      accentColor: accent.value(),
+    };
+    const file = logo.files?.[0];
+    if (file) {
+      if (file.size > MAX_LOGO_SIZE) {
+        showNotification("error", "The logo must be at most 256 KiB");
+        return;
+      }
+      changes.logo = new Uint8Array(await file.arrayBuffer());
+    } else if (removeLogo.field.checked) {
+      changes.logo = new Uint8Array();
+    }
+
+    submit.disabled = true;
+    try {
+      await updateBranding(organizationId, changes);
+      closeModal();
+      showNotification("success", "Branding saved");
+      // Colors and the logo are rendered on the server
+      window.location.reload();
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+      submit.disabled = false;
+    }
+  });
+
+  openModal("Branding", form);
+}
+
+// colorField is a color picker with a checkbox to fall back to the libops color,
+// since a color input can't be empty
+function colorField(name: string, label: string, value: string, fallback: string) {
+  const picker = document.createElement("input");
+  picker.type = "color";
+  picker.id = `branding-${name}`;
+  picker.value = value || fallback;
+  picker.className = "h-9 w-16 border border-gray-300 rounded";
+
+  const useDefault = checkbox(`${name}_default`, "Use the libops color");
+  useDefault.field.checked = !value;
+  picker.disabled = !value;
+  useDefault.field.addEventListener("change", () => {
+    picker.disabled = useDefault.field.checked;
+  });
+
+  const row = document.createElement("div");
+  const labelElement = document.createElement("label");
+  labelElement.htmlFor = picker.id;
+  labelElement.className = "block text-sm font-medium text-gray-700 mb-1";
+  labelElement.textContent = label;
+  const controls = document.createElement("div");
+  controls.className = "flex items-center gap-4";
+  controls.append(picker, useDefault.row);
+  row.append(labelElement, controls);
+
+  return { row, value: () => (useDefault.field.checked ? "" : picker.value) };
+}
+
+function checkbox(name: string, label: string) {
+  const field = document.createElement("input");
+  field.type = "checkbox";
+  field.id = `branding-${name}`;
+  field.className = "mr-2";
+  const row = document.createElement("label");
+  row.htmlFor = field.id;
+  row.className = "flex items-center text-sm text-gray-700 mt-2";
+  row.append(field, label);
+  return { field, row };
+}
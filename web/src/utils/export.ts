@@ -0,0 +1,141 @@
+// Organization export dialog on the organization page: start an export bundle
+// and download finished ones (ExportService).
+import { exportOrganization, getExport, listExports } from "@/api/export";
+import { ExportStatus, OrganizationExport } from "@proto/libops/v1/export_pb";
+import { closeModal, openModal } from "@/utils/modal";
+import { showNotification } from "@/utils/helpers";
+
+// How often the list is refreshed while an export is being built
+const POLL_INTERVAL_MS = 5000;
+
+const STATUS_LABELS: Record<ExportStatus, string> = {
+  [ExportStatus.UNSPECIFIED]: "Unknown",
+  [ExportStatus.PENDING]: "Queued",
+  [ExportStatus.RUNNING]: "Building",
+  [ExportStatus.SUCCEEDED]: "Ready",
+  [ExportStatus.FAILED]: "Failed",
+};
+
+// openExports shows the export dialog for an organization
+export async function openExports(organizationId: string) {
+  const exports = await listExports(organizationId);
+
+  const container = document.createElement("div");
+  container.className = "space-y-4";
+
+  const intro = document.createElement("p");
+  intro.className = "text-sm text-gray-600";
+  intro.textContent =
+    "An export bundles the configuration of every project and site, settings, firewall rules, members, " +
+    "domains and links to each site's latest backup, so you can move off libops. Bundles can be downloaded until they expire.";
+
+  const form = document.createElement("form");
+  form.className = "flex items-center justify-between gap-4";
+  const secrets = document.createElement("input");
+  secrets.type = "checkbox";
+  secrets.id = "export-include-secrets";
+  secrets.className = "mr-2";
+  const secretsLabel = document.createElement("label");
+  secretsLabel.htmlFor = secrets.id;
+  secretsLabel.className = "flex items-center text-sm text-gray-700";
+  secretsLabel.append(secrets, "Include secret values");
+  const submit = document.createElement("button");
+  submit.type = "submit";
+  submit.className = "px-4 py-2 bg-red-900 text-white text-sm font-medium rounded-lg hover:bg-red-950";
+  submit.textContent = "Start export";
+  form.append(secretsLabel, submit);
+
+  const list = document.createElement("ul");
+  list.className = "divide-y divide-gray-200 border border-gray-200 rounded-lg";
+
+  container.append(intro, form, list);
+
+  let timer: number | undefined;
+  const render = (items: OrganizationExport[]) => {
+    list.replaceChildren(...items.map((item) => exportRow(organizationId, item)));
+    if (items.length === 0) {
+      const empty = document.createElement("li");
+      empty.className = "px-4 py-3 text-sm text-gray-500";
+      empty.textContent = "No exports yet";
+      list.append(empty);
+    }
+    // Refresh while an export is being built and the dialog is still open
+    window.clearTimeout(timer);
+    const building = items.some((item) => item.status === ExportStatus.PENDING || item.status === ExportStatus.RUNNING);
+    if (building) {
+      timer = window.setTimeout(async () => {
+        if (document.body.contains(list)) {
+          render(await listExports(organizationId));
+        }
+      }, POLL_INTERVAL_MS);
+    }
+  };
+  render(exports);
+
+  form.addEventListener("submit", async (e) => {
+    e.preventDefault();
+    if (secrets.checked && !confirm("The bundle will contain your secrets in plain text. Continue?")) {
+      return;
+    }
+    submit.disabled = true;
+    try {
+      await exportOrganization(organizationId, secrets.checked);
+      showNotification("success", "Export started");
+      render(await listExports(organizationId));
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+    } finally {
+      submit.disabled = false;
+    }
+  });
+
+  openModal("Export organization", container);
+}
+
+function exportRow(organizationId: string, item: OrganizationExport) {
+  const row = document.createElement("li");
+  row.className = "flex items-center justify-between px-4 py-3 text-sm";
+
+  const details = document.createElement("div");
+  const title = document.createElement("div");
+  title.className = "font-medium text-gray-900";
+  title.textContent = new Date(Number(item.createdAt) * 1000).toLocaleString();
+  const meta = document.createElement("div");
+  meta.className = "text-gray-500";
+  const parts = [STATUS_LABELS[item.status]];
+  if (item.includeSecrets) parts.push("with secrets");
+  if (item.status === ExportStatus.SUCCEEDED) parts.push(formatSize(Number(item.sizeBytes)));
+  if (item.status === ExportStatus.FAILED && item.error) parts.push(item.error);
+  meta.textContent = parts.join(" · ");
+  details.append(title, meta);
+  row.append(details);
+
+  const expired = Number(item.expiresAt) * 1000 < Date.now();
+  if (item.status === ExportStatus.SUCCEEDED && !expired) {
+    const download = document.createElement("button");
+    download.type = "button";
+    download.className = "px-3 py-1 border border-gray-300 text-gray-700 rounded-lg hover:bg-gray-50";
+    download.textContent = "Download";
+    download.title = `SHA-256 ${item.sha256}`;
+    download.addEventListener("click", async () => {
+      try {
+        // Download URLs are short-lived, so one is signed per click
+        const fresh = await getExport(organizationId, item.exportId);
+        if (fresh?.downloadUrl) {
+          closeModal();
+          window.location.href = fresh.downloadUrl;
+        }
+      } catch (error) {
+        showNotification("error", (error as Error).message);
+      }
+    });
+    row.append(download);
+  }
+  return row;
+}
+
+function formatSize(bytes: number) {
+  if (bytes < 1024) return `${bytes} B`;
+  if (bytes < 1024 * 1024) return `${(bytes / 1024).toFixed(1)} KiB`;
+  return `${(bytes / 1024 / 1024).toFixed(1)} MiB`;
+}
@@ -0,0 +1,167 @@
+// Live resource status. Pages mark the statuses they show with data attributes
+// holding the site ID; a server-sent event stream sends the current state on
+// connect and every change after, and this module patches the marked elements:
+//
+//   data-site-status            site status badge
+//   data-deployment-status      latest deployment
+//   data-reconciliation-status  latest reconciliation run
+import { getPageContext } from "@/utils/context";
+import { capitalize, showNotification } from "@/utils/helpers";
+
+const STREAM_URL = "/events/stream";
+
+const BADGE_CLASS = "inline-flex items-center px-2 py-0.5 rounded text-xs font-medium";
+
+const SITE_STATUS_CLASS: Record<string, string> = {
+  active: "bg-green-100 text-green-800",
+  provisioning: "bg-blue-100 text-blue-800",
+  failed: "bg-red-100 text-red-800",
+};
+
+const INCIDENT_TITLE: Record<string, string> = {
+  checkin_missed: "The site's controller stopped checking in",
+  probe_failed: "The site is failing its health checks",
+  health_degraded: "The site's health score has stayed low",
+};
+
+const PROGRESS_CLASS: Record<string, string> = {
+  pending: "text-gray-600",
+  triggered: "text-blue-700",
+  in_progress: "text-blue-700",
+  running: "text-blue-700",
+  success: "text-green-700",
+  completed: "text-green-700",
+  failed: "text-red-700",
+};
+
+const FINISHED = ["success", "completed", "failed"];
+
+interface SiteEvent {
+  siteId: string;
+  status: string;
+  incident?: string;
+}
+
+interface DeploymentEvent {
+  siteId: string;
+  deploymentId: string;
+  status: string;
+  url?: string;
+  error?: string;
+}
+
+interface ReconciliationEvent {
+  siteId: string;
+  runId: string;
+  status: string;
+  error?: string;
+}
+
+export function initLiveStatus() {
+  if (typeof EventSource === "undefined") {
+    return;
+  }
+  if (!document.querySelector("[data-site-status], [data-deployment-status], [data-reconciliation-status]")) {
+    return;
+  }
+
+  // A site's detail page only needs that site
+  const context = getPageContext();
+  const url = context.resourceType === "site" && context.siteId
+    ? `${STREAM_URL}?site=${encodeURIComponent(context.siteId)}`
+    : STREAM_URL;
+
+  const stream = new EventSource(url);
+  stream.addEventListener("site", (e) => {
+    updateSite(JSON.parse((e as MessageEvent).data));
+  });
+  stream.addEventListener("deployment", (e) => {
+    updateDeployment(JSON.parse((e as MessageEvent).data));
+  });
+  stream.addEventListener("reconciliation", (e) => {
+    updateReconciliation(JSON.parse((e as MessageEvent).data));
+  });
+}
+
+function elementsFor(attribute: string, siteId: string): NodeListOf<HTMLElement> {
+  return document.querySelectorAll<HTMLElement>(`[${attribute}="${CSS.escape(siteId)}"]`);
+}
+
+function label(status: string): string {
+  return capitalize(status.replace(/_/g, " "));
+}
+
+function updateSite(event: SiteEvent) {
+  elementsFor("data-site-status", event.siteId).forEach((badge) => {
+    if (event.incident) {
+      badge.className = `${BADGE_CLASS} bg-red-100 text-red-800`;
+      badge.textContent = "Down";
+      badge.title = INCIDENT_TITLE[event.incident] ?? "";
+      return;
+    }
+    badge.className = `${BADGE_CLASS} ${SITE_STATUS_CLASS[event.status] ?? "bg-gray-100 text-gray-800"}`;
+    badge.textContent = event.status && event.status !== "unspecified" ? label(event.status) : "Unknown";
+    badge.title = "";
+  });
+}
+
+function updateDeployment(event: DeploymentEvent) {
+  elementsFor("data-deployment-status", event.siteId).forEach((el) => {
+    const key = `${event.deploymentId}:${event.status}`;
+    if (el.dataset.current === key) {
+      return;
+    }
+    notifyFinished(el, event.deploymentId, event.status, "Deployment", event.error);
+    el.dataset.current = key;
+    renderProgress(el, event.status, event.error, event.url);
+  });
+}
+
+function updateReconciliation(event: ReconciliationEvent) {
+  elementsFor("data-reconciliation-status", event.siteId).forEach((el) => {
+    const key = `${event.runId}:${event.status}`;
+    if (el.dataset.current === key) {
+      return;
+    }
+    notifyFinished(el, event.runId, event.status, "Reconciliation", event.error);
+    el.dataset.current = key;
+    renderProgress(el, event.status, event.error);
+  });
+}
+
+// notifyFinished toasts when a deployment or run this page watched in progress finishes.
+// The first event on connect only records the current state.
+function notifyFinished(el: HTMLElement, id: string, status: string, kind: string, error?: string) {
+  const previous = el.dataset.current;
+  if (!previous || !FINISHED.includes(status)) {
+    return;
+  }
+  const [previousId, previousStatus] = previous.split(":");
+  if (previousId === id && FINISHED.includes(previousStatus)) {
+    return;
+  }
+  if (status === "failed") {
+    showNotification("error", error ? `${kind} failed: ${error}` : `${kind} failed`);
+  } else {
+    showNotification("success", `${kind} finished`);
+  }
+}
+
+function renderProgress(el: HTMLElement, status: string, error?: string, url?: string) {
+  el.className = `text-sm ${PROGRESS_CLASS[status] ?? "text-gray-600"}`;
+  el.replaceChildren(label(status));
+  if (status === "failed" && error) {
+    el.title = error;
+  } else {
+    el.removeAttribute("title");
+  }
+  if (url) {
+    const link = document.createElement("a");
+    link.href = url;
+    link.target = "_blank";
+    link.rel = "noopener";
+    link.className = "ml-2 text-blue-600 hover:text-blue-800";
+    link.textContent = "View run";
+    el.append(link);
+  }
+}
@@ -0,0 +1,162 @@
+// Notification bell in the dashboard banner. The menu lists recent notifications
+// through NotificationService; a server-sent event stream keeps the unread badge
+// and the list current while the page is open.
+import { Notification } from "@proto/libops/v1/notification_pb";
+import { listNotifications, markAllNotificationsRead, markNotificationsRead } from "@/api/notifications";
+
+const STREAM_URL = "/api/notifications/stream";
+
+let loaded = false;
+
+export function initNotificationCenter() {
+  const bell = document.getElementById("notification-bell");
+  const menu = document.getElementById("notification-menu");
+  if (!bell || !menu) {
+    return;
+  }
+
+  bell.addEventListener("click", (e) => {
+    e.stopPropagation();
+    menu.classList.toggle("hidden");
+    if (!menu.classList.contains("hidden") && !loaded) {
+      void loadNotifications();
+    }
+  });
+  document.addEventListener("click", (e) => {
+    if (!menu.contains(e.target as Node)) {
+      menu.classList.add("hidden");
+    }
+  });
+  document.addEventListener("keydown", (e) => {
+    if (e.key === "Escape") {
+      menu.classList.add("hidden");
+    }
+  });
+
+  document.getElementById("notification-mark-all")?.addEventListener("click", async () => {
+    setUnreadCount(await markAllNotificationsRead());
+    document.querySelectorAll("#notification-list [data-unread]").forEach((item) => markItemRead(item as HTMLElement));
+  });
+
+  openStream();
+}
+
+function openStream() {
+  if (typeof EventSource === "undefined") {
+    return;
+  }
+  const stream = new EventSource(STREAM_URL);
+  stream.addEventListener("unread", (e) => {
+    const data = JSON.parse((e as MessageEvent).data);
+    setUnreadCount(Number(data.unreadCount ?? 0));
+  });
+  stream.addEventListener("notification", (e) => {
+    const notification = Notification.fromJsonString((e as MessageEvent).data);
+    if (loaded) {
+      const list = document.getElementById("notification-list");
+      list?.querySelector("[data-empty]")?.remove();
+      list?.prepend(renderNotification(notification));
+    }
+  });
+  // The browser reconnects on its own; refresh the list so nothing sent
+  // while disconnected is missed.
+  stream.addEventListener("open", () => {
+    if (loaded) {
+      void loadNotifications();
+    }
+  });
+}
+
+async function loadNotifications() {
+  const list = document.getElementById("notification-list");
+  if (!list) {
+    return;
+  }
+  try {
+    const response = await listNotifications();
+    loaded = true;
+    setUnreadCount(Number(response.unreadCount));
+    list.replaceChildren(...response.notifications.map(renderNotification));
+    if (response.notifications.length === 0) {
+      const empty = document.createElement("p");
+      empty.dataset.empty = "true";
+      empty.className = "px-4 py-6 text-sm text-center text-gray-500";
+      empty.textContent = "You're all caught up.";
+      list.appendChild(empty);
+    }
+  } catch (error) {
+    console.error("Failed to load notifications", error);
+  }
+}
+
+function renderNotification(notification: Notification): HTMLElement {
+  const item = document.createElement("a");
+  item.href = notification.link || "#";
+  item.className = "block px-4 py-3 border-b border-gray-100 hover:bg-gray-50";
+  if (!notification.read) {
+    item.dataset.unread = "true";
+    item.classList.add("bg-red-50");
+  }
+
+  const title = document.createElement("p");
+  title.className = "text-sm font-medium text-gray-900";
+  title.textContent = notification.title;
+
+  const body = document.createElement("p");
+  body.className = "text-sm text-gray-600";
+  body.textContent = notification.body;
+
+  const time = document.createElement("p");
+  time.className = "mt-1 text-xs text-gray-400";
+  time.textContent = timeAgo(Number(notification.createdAt));
+
+  item.append(title, body, time);
+  item.addEventListener("click", async (e) => {
+    if (!item.dataset.unread) {
+      return;
+    }
+    e.preventDefault();
+    try {
+      setUnreadCount(await markNotificationsRead([notification.notificationId]));
+    } finally {
+      markItemRead(item);
+      if (notification.link) {
+        window.location.href = notification.link;
+      }
+    }
+  });
+  return item;
+}
+
+function markItemRead(item: HTMLElement) {
+  delete item.dataset.unread;
+  item.classList.remove("bg-red-50");
+}
+
+function setUnreadCount(count: number) {
+  const badge = document.getElementById("notification-count");
+  if (!badge) {
+    return;
+  }
+  badge.textContent = count > 99 ? "99+" : String(count);
+  badge.classList.toggle("hidden", count === 0);
+}
+
+function timeAgo(unixSeconds: number): string {
+  const seconds = Math.max(0, Math.floor(Date.now() / 1000 - unixSeconds));
+  if (seconds < 60) {
+    return "just now";
+  }
+  const units: [number, string][] = [
+    [86400, "day"],
+    [3600, "hour"],
+    [60, "minute"],
+  ];
+  for (const [size, unit] of units) {
+    if (seconds >= size) {
+      const n = Math.floor(seconds / size);
+      return `${n} ${unit}${n === 1 ? "" : "s"} ago`;
+    }
+  }
+  return "just now";
+}
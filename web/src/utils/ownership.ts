@@ -0,0 +1,116 @@
+// Ownership dialog on the organization page: offer the organization to another
+// member, and accept or cancel the open transfer (OwnershipService).
+import { acceptOwnershipTransfer, cancelOwnershipTransfer, getOwnershipTransfer, transferOwnership } from "@/api/ownership";
+import { memberClient } from "@/api/client";
+import { OwnershipTransfer } from "@proto/libops/v1/ownership_pb";
+import { closeModal, openModal } from "@/utils/modal";
+import { showNotification } from "@/utils/helpers";
+
+// openOwnership shows the ownership dialog for an organization
+export async function openOwnership(organizationId: string) {
+  const transfer = await getOwnershipTransfer(organizationId);
+
+  const container = document.createElement("div");
+  container.className = "space-y-4";
+  if (transfer) {
+    container.append(...openTransfer(organizationId, transfer));
+  } else {
+    container.append(...await transferForm(organizationId));
+  }
+
+  openModal("Organization ownership", container);
+}
+
+// openTransfer describes the open transfer. Only the new owner can accept it and
+// only owners can cancel it; the API refuses everyone else.
+function openTransfer(organizationId: string, transfer: OwnershipTransfer) {
+  const details = document.createElement("p");
+  details.className = "text-sm text-gray-600";
+  const expires = new Date(Number(transfer.expiresAt) * 1000).toLocaleDateString();
+  details.textContent =
+    `${transfer.fromEmail} offered this organization to ${transfer.toEmail}. ` +
+    `Once accepted before ${expires}, ${transfer.fromEmail} becomes a developer and the billing contact moves to the new owner.`;
+
+  const actions = document.createElement("div");
+  actions.className = "flex justify-end gap-2";
+  const cancel = document.createElement("button");
+  cancel.type = "button";
+  cancel.className = "px-4 py-2 border border-gray-300 text-gray-700 text-sm font-medium rounded-lg hover:bg-gray-50";
+  cancel.textContent = "Cancel transfer";
+  cancel.addEventListener("click", async () => {
+    try {
+      await cancelOwnershipTransfer(organizationId, transfer.transferId);
+      showNotification("success", "Transfer cancelled");
+      closeModal();
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+    }
+  });
+  const accept = document.createElement("button");
+  accept.type = "button";
+  accept.className = "px-4 py-2 bg-red-900 text-white text-sm font-medium rounded-lg hover:bg-red-950";
+  accept.textContent = "Accept ownership";
+  accept.addEventListener("click", async () => {
+    try {
+      await acceptOwnershipTransfer(organizationId, transfer.transferId);
+      showNotification("success", "You now own this organization");
+      window.location.reload();
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+    }
+  });
+  actions.append(cancel, accept);
+  return [details, actions];
+}
+
+// transferForm offers the organization to one of its other members
+async function transferForm(organizationId: string) {
+  const response = await memberClient.listOrganizationMembers({ organizationId, pageSize: 100 });
+
+  const intro = document.createElement("p");
+  intro.className = "text-sm text-gray-600";
+  intro.textContent =
+    "Hand this organization to another member, for example before you leave your institution. " +
+    "They have a week to accept; you then stay on as a developer.";
+
+  const form = document.createElement("form");
+  form.className = "flex items-center justify-between gap-4";
+  const select = document.createElement("select");
+  select.required = true;
+  select.className = "flex-1 px-3 py-2 border border-gray-300 rounded-lg text-sm focus:outline-none focus:ring-2 focus:ring-red-900";
+  const placeholder = document.createElement("option");
+  placeholder.value = "";
+  placeholder.textContent = "Choose a member";
+  select.append(placeholder);
+  for (const member of response.members) {
+    const option = document.createElement("option");
+    option.value = member.accountId;
+    option.textContent = `${member.email} (${member.role})`;
+    select.append(option);
+  }
+  const submit = document.createElement("button");
+  submit.type = "submit";
+  submit.className = "px-4 py-2 bg-red-900 text-white text-sm font-medium rounded-lg hover:bg-red-950";
+  submit.textContent = "Transfer";
+  form.append(select, submit);
+
+  form.addEventListener("submit", async (e) => {
+    e.preventDefault();
+    const member = select.options[select.selectedIndex].textContent;
+    if (!confirm(`Offer this organization to ${member}?`)) {
+      return;
+    }
+    submit.disabled = true;
+    try {
+      const transfer = await transferOwnership(organizationId, select.value);
+      showNotification("success", `Transfer offered to ${transfer?.toEmail}`);
+      closeModal();
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+    } finally {
+      submit.disabled = false;
+    }
+  });
+
+  return [intro, form];
+}
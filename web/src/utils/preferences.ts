@@ -0,0 +1,180 @@
+// Account preferences in the dashboard: the light/dark theme toggle in the banner
+// and the preferences dialog in the sidebar. The theme is cached in localStorage so
+// the inline script in base.html can apply it before the page paints; the server
+// copy (AccountService.GetAccountPreferences) wins once it loads.
+import { AccountPreferences } from "@proto/libops/v1/organization_account_api_pb";
+import { getPreferences, updatePreferences } from "@/api/preferences";
+import { organizationClient } from "@/api/client";
+import { closeModal, openModal } from "@/utils/modal";
+import { capitalize, showNotification } from "@/utils/helpers";
+
+const STORAGE_KEY = "libops.preferences";
+const THEMES = ["light", "dark", "system"];
+const PAGE_SIZES = [10, 25, 50, 100];
+// Languages with a catalog in internal/i18n/locales, named in their own language
+const LANGUAGES = [
+  { value: "", label: "Browser default" },
+  { value: "en", label: "English" },
+  { value: "es", label: "Español" },
+  { value: "fr", label: "Français" },
+];
+
+let current: AccountPreferences | null = null;
+
+// cachedPreferences returns the preferences saved by the last page load, if any
+export function cachedPreferences(): { theme?: string; defaultOrganizationId?: string } {
+  try {
+    return JSON.parse(localStorage.getItem(STORAGE_KEY) ?? "{}");
+  } catch {
+    return {};
+  }
+}
+
+export function initPreferences() {
+  const media = window.matchMedia("(prefers-color-scheme: dark)");
+  media.addEventListener("change", () => applyTheme(current?.theme ?? cachedPreferences().theme ?? "system"));
+  document.getElementById("theme-toggle")?.addEventListener("click", () => void cycleTheme());
+
+  applyTheme(cachedPreferences().theme ?? "system");
+  getPreferences()
+    .then((prefs) => {
+      if (prefs) {
+        remember(prefs);
+      }
+    })
+    .catch((error) => console.error("Failed to load preferences", error));
+}
+
+async function cycleTheme() {
+  const theme = current?.theme ?? cachedPreferences().theme ?? "system";
+  const next = THEMES[(THEMES.indexOf(theme) + 1) % THEMES.length] ?? "system";
+  applyTheme(next);
+  try {
+    const prefs = await updatePreferences({ theme: next });
+    if (prefs) {
+      remember(prefs);
+    }
+  } catch (error) {
+    showNotification("error", (error as Error).message);
+  }
+}
+
+function remember(prefs: AccountPreferences) {
+  current = prefs;
+  localStorage.setItem(
+    STORAGE_KEY,
+    JSON.stringify({ theme: prefs.theme, defaultOrganizationId: prefs.defaultOrganizationId }),
+  );
+  applyTheme(prefs.theme);
+}
+
+function applyTheme(theme: string) {
+  const dark = theme === "dark" || (theme === "system" && window.matchMedia("(prefers-color-scheme: dark)").matches);
+  document.documentElement.classList.toggle("dark", dark);
+
+  const toggle = document.getElementById("theme-toggle");
+  if (toggle) {
+    const label = `Theme: ${theme}`;
+    toggle.setAttribute("title", label);
+    toggle.setAttribute("aria-label", label);
+    toggle.dataset.theme = theme;
+  }
+}
+
+// openPreferences shows the preferences dialog
+export async function openPreferences() {
+  const prefs = current ?? (await getPreferences());
+  if (!prefs) {
+    return;
+  }
+
+  const form = document.createElement("form");
+  form.className = "space-y-4";
+
+  const language = select("locale", "Language", LANGUAGES, prefs.locale);
+  const theme = select("theme", "Theme", THEMES.map((t) => ({ value: t, label: capitalize(t) })), prefs.theme);
+
+  const zones: string[] = (Intl as any).supportedValuesOf?.("timeZone") ?? [];
+  const timezone = zones.length
+    ? select("timezone", "Time zone", ["UTC", ...zones.filter((z) => z !== "UTC")].map((z) => ({ value: z, label: z })), prefs.timezone)
+    : input("timezone", "Time zone", prefs.timezone);
+
+  const orgOptions = [{ value: "", label: "None" }];
+  try {
+    const response = await organizationClient.listOrganizations({});
+    orgOptions.push(...response.organizations.map((org) => ({ value: org.organizationId, label: org.organizationName })));
+  } catch (error) {
+    console.error("Failed to load organizations", error);
+  }
+  const org = select("default_organization_id", "Default organization", orgOptions, prefs.defaultOrganizationId);
+
+  const pageSize = select(
+    "page_size",
+    "Rows per page",
+    PAGE_SIZES.map((n) => ({ value: String(n), label: String(n) })),
+    String(prefs.pageSize),
+  );
+
+  const submit = document.createElement("button");
+  submit.type = "submit";
+  submit.className = "px-4 py-2 bg-red-900 text-white text-sm font-medium rounded-lg hover:bg-red-800";
+  submit.textContent = "Save";
+  const actions = document.createElement("div");
+  actions.className = "flex justify-end";
+  actions.appendChild(submit);
+
+  form.append(language.row, theme.row, timezone.row, org.row, pageSize.row, actions);
+  form.addEventListener("submit", async (e) => {
+    e.preventDefault();
+    submit.disabled = true;
+    try {
+      const saved = await updatePreferences({
+        theme: theme.field.value,
+        timezone: timezone.field.value,
+        defaultOrganizationId: org.field.value,
+        pageSize: Number(pageSize.field.value),
+        locale: language.field.value,
+      });
+      if (saved) {
+        remember(saved);
+      }
+      closeModal();
+      showNotification("success", "Preferences saved");
+      // Timestamps, page sizes and translations are rendered on the server
+      window.location.reload();
+    } catch (error) {
+      showNotification("error", (error as Error).message);
+      submit.disabled = false;
+    }
+  });
+
+  openModal("Preferences", form);
+}
+
+function row(name: string, label: string, field: HTMLElement) {
+  const wrapper = document.createElement("div");
+  const labelElement = document.createElement("label");
+  labelElement.htmlFor = `pref-${name}`;
+  labelElement.className = "block text-sm font-medium text-gray-700 mb-1";
+  labelElement.textContent = label;
+  field.id = `pref-${name}`;
+  field.className = "w-full px-3 py-2 text-sm border border-gray-300 rounded-lg bg-white";
+  wrapper.append(labelElement, field);
+  return wrapper;
+}
+
+function select(name: string, label: string, options: { value: string; label: string }[], value: string) {
+  const field = document.createElement("select");
+  field.name = name;
+  for (const option of options) {
+    field.add(new Option(option.label, option.value, false, option.value === value));
+  }
+  return { field, row: row(name, label, field) };
+}
+
+function input(name: string, label: string, value: string) {
+  const field = document.createElement("input");
+  field.name = name;
+  field.value = value;
+  return { field, row: row(name, label, field) };
+}
@@ -0,0 +1,182 @@
+// Global search box in the dashboard banner. Matches come from GET /search, which only
+// returns resources the signed-in user can access. "/" focuses the box; the arrow
+// keys move through the results, Enter opens one and Escape closes the list.
+import { capitalize } from "@/utils/helpers";
+
+const SEARCH_URL = "/search";
+const DEBOUNCE_MS = 200;
+const MIN_LENGTH = 2;
+
+interface SearchResult {
+  kind: string;
+  id: string;
+  name: string;
+  detail?: string;
+  link: string;
+}
+
+let results: SearchResult[] = [];
+let active = -1;
+let pending: AbortController | null = null;
+
+export function initSearch() {
+  const input = document.getElementById("global-search") as HTMLInputElement | null;
+  const list = document.getElementById("global-search-results");
+  if (!input || !list) {
+    return;
+  }
+
+  let timer: number | undefined;
+  input.addEventListener("input", () => {
+    window.clearTimeout(timer);
+    timer = window.setTimeout(() => void search(input, list), DEBOUNCE_MS);
+  });
+
+  input.addEventListener("keydown", (e) => {
+    switch (e.key) {
+      case "ArrowDown":
+        e.preventDefault();
+        setActive(list, Math.min(active + 1, results.length - 1));
+        break;
+      case "ArrowUp":
+        e.preventDefault();
+        setActive(list, Math.max(active - 1, 0));
+        break;
+      case "Enter":
+        if (active >= 0 && results[active]) {
+          e.preventDefault();
+          window.location.href = results[active].link;
+        }
+        break;
+      case "Escape":
+        close(input, list);
+        input.blur();
+        break;
+    }
+  });
+
+  input.addEventListener("focus", () => {
+    if (results.length > 0) {
+      open(input, list);
+    }
+  });
+
+  document.addEventListener("click", (e) => {
+    if (e.target !== input && !list.contains(e.target as Node)) {
+      close(input, list);
+    }
+  });
+
+  // "/" jumps to the search box unless the user is already typing somewhere
+  document.addEventListener("keydown", (e) => {
+    if (e.key !== "/" || e.ctrlKey || e.metaKey || e.altKey) {
+      return;
+    }
+    const target = e.target as HTMLElement;
+    if (target.isContentEditable || ["INPUT", "TEXTAREA", "SELECT"].includes(target.tagName)) {
+      return;
+    }
+    e.preventDefault();
+    input.focus();
+    input.select();
+  });
+}
+
+async function search(input: HTMLInputElement, list: HTMLElement) {
+  const query = input.value.trim();
+  pending?.abort();
+  if (query.length < MIN_LENGTH) {
+    results = [];
+    close(input, list);
+    return;
+  }
+
+  pending = new AbortController();
+  try {
+    const response = await fetch(`${SEARCH_URL}?q=${encodeURIComponent(query)}`, {
+      signal: pending.signal,
+      headers: { Accept: "application/json" },
+    });
+    if (!response.ok) {
+      throw new Error(`search failed: ${response.status}`);
+    }
+    const data = await response.json();
+    results = data.results ?? [];
+    render(list, query);
+    open(input, list);
+  } catch (error) {
+    if ((error as Error).name !== "AbortError") {
+      console.error("Failed to search", error);
+    }
+  }
+}
+
+function render(list: HTMLElement, query: string) {
+  active = -1;
+  if (results.length === 0) {
+    const empty = document.createElement("li");
+    empty.className = "px-4 py-3 text-sm text-gray-500";
+    empty.textContent = `No matches for "${query}"`;
+    list.replaceChildren(empty);
+    return;
+  }
+
+  list.replaceChildren(
+    ...results.map((result, i) => {
+      const item = document.createElement("li");
+      item.id = `global-search-result-${i}`;
+      item.setAttribute("role", "option");
+
+      const link = document.createElement("a");
+      link.href = result.link;
+      link.className = "flex items-center justify-between gap-3 px-4 py-2 hover:bg-gray-50";
+
+      const text = document.createElement("div");
+      text.className = "min-w-0";
+      const name = document.createElement("p");
+      name.className = "text-sm font-medium text-gray-900 truncate";
+      name.textContent = result.name;
+      text.appendChild(name);
+      if (result.detail) {
+        const detail = document.createElement("p");
+        detail.className = "text-xs text-gray-500 truncate";
+        detail.textContent = result.detail;
+        text.appendChild(detail);
+      }
+
+      const kind = document.createElement("span");
+      kind.className = "flex-shrink-0 px-2 py-0.5 text-xs rounded bg-gray-100 text-gray-600";
+      kind.textContent = capitalize(result.kind);
+
+      link.append(text, kind);
+      item.appendChild(link);
+      item.addEventListener("mouseenter", () => setActive(list, i));
+      return item;
+    }),
+  );
+}
+
+function setActive(list: HTMLElement, index: number) {
+  const input = document.getElementById("global-search");
+  list.querySelectorAll("[role=option]").forEach((item, i) => {
+    const selected = i === index;
+    item.setAttribute("aria-selected", String(selected));
+    item.classList.toggle("bg-gray-100", selected);
+    if (selected) {
+      item.scrollIntoView({ block: "nearest" });
+      input?.setAttribute("aria-activedescendant", item.id);
+    }
+  });
+  active = index;
+}
+
+function open(input: HTMLInputElement, list: HTMLElement) {
+  list.classList.remove("hidden");
+  input.setAttribute("aria-expanded", "true");
+}
+
+function close(input: HTMLInputElement, list: HTMLElement) {
+  list.classList.add("hidden");
+  input.setAttribute("aria-expanded", "false");
+  input.removeAttribute("aria-activedescendant");
+}
@@ -0,0 +1,372 @@
+// Browser terminal on the site detail page. The API proxies a websocket to an SSH
+// session on the site's VM (GET /sites/{id}/terminal): keystrokes go up as
+// {"type": "input"} messages, window sizes as {"type": "resize"}, and the VM's
+// output comes back as binary messages.
+//
+// The screen is a small VT100 subset, enough for shells, less, top and vim:
+// cursor movement, erasing, scrolling and the alternate screen. Colors and other
+// text attributes are dropped.
+import { showNotification } from "@/utils/helpers";
+
+const ROWS = 24;
+
+const KEYS: Record<string, string> = {
+  Enter: "\r",
+  Backspace: "\x7f",
+  Tab: "\t",
+  Escape: "\x1b",
+  ArrowUp: "\x1b[A",
+  ArrowDown: "\x1b[B",
+  ArrowRight: "\x1b[C",
+  ArrowLeft: "\x1b[D",
+  Home: "\x1b[H",
+  End: "\x1b[F",
+  Delete: "\x1b[3~",
+  PageUp: "\x1b[5~",
+  PageDown: "\x1b[6~",
+};
+
+class Screen {
+  lines: string[][] = [];
+  x = 0;
+  y = 0;
+  private saved: { lines: string[][]; x: number; y: number } | null = null;
+  private state: "text" | "escape" | "charset" | "csi" | "osc" = "text";
+  private params = "";
+
+  constructor(public cols: number, public rows: number) {
+    this.clear();
+  }
+
+  resize(cols: number, rows: number) {
+    this.lines = this.lines.slice(-rows).map((line) => line.slice(0, cols).concat(blank(cols - line.length)));
+    while (this.lines.length < rows) {
+      this.lines.push(blank(cols));
+    }
+    this.cols = cols;
+    this.rows = rows;
+    this.x = Math.min(this.x, cols - 1);
+    this.y = Math.min(this.y, rows - 1);
+  }
+
+  write(text: string) {
+    for (const ch of text) {
+      switch (this.state) {
+        case "text":
+          this.text(ch);
+          break;
+        case "escape":
+          this.state = "text";
+          if (ch === "[") {
+            this.state = "csi";
+            this.params = "";
+          } else if (ch === "]") {
+            this.state = "osc";
+          } else if (ch === "(" || ch === ")") {
+            this.state = "charset";
+          } else if (ch === "M") {
+            this.reverseLineFeed();
+          }
+          break;
+        case "charset":
+          this.state = "text";
+          break;
+        case "csi":
+          if (ch >= "@" && ch <= "~") {
+            this.state = "text";
+            this.csi(ch);
+          } else {
+            this.params += ch;
+          }
+          break;
+        case "osc":
+          // Window titles end with BEL or ESC \
+          if (ch === "\x07") {
+            this.state = "text";
+          } else if (ch === "\x1b") {
+            this.state = "escape";
+          }
+          break;
+      }
+    }
+  }
+
+  private text(ch: string) {
+    switch (ch) {
+      case "\x1b":
+        this.state = "escape";
+        return;
+      case "\r":
+        this.x = 0;
+        return;
+      case "\n":
+        this.lineFeed();
+        return;
+      case "\b":
+        this.x = Math.max(0, this.x - 1);
+        return;
+      case "\t":
+        this.x = Math.min(this.cols - 1, (Math.floor(this.x / 8) + 1) * 8);
+        return;
+    }
+    if (ch < " ") {
+      return;
+    }
+    if (this.x >= this.cols) {
+      this.x = 0;
+      this.lineFeed();
+    }
+    const line = this.lines[this.y];
+    if (line) {
+      line[this.x] = ch;
+    }
+    this.x++;
+  }
+
+  private csi(final: string) {
+    const priv = this.params.startsWith("?");
+    const [first = 0, second = 0] = this.params.replace("?", "").split(";").map((p) => parseInt(p, 10) || 0);
+    const n = Math.max(1, first);
+    const line = this.lines[this.y] ?? [];
+    switch (final) {
+      case "A":
+        this.y = Math.max(0, this.y - n);
+        break;
+      case "B":
+        this.y = Math.min(this.rows - 1, this.y + n);
+        break;
+      case "C":
+        this.x = Math.min(this.cols - 1, this.x + n);
+        break;
+      case "D":
+        this.x = Math.max(0, this.x - n);
+        break;
+      case "G":
+        this.x = Math.min(this.cols - 1, n - 1);
+        break;
+      case "d":
+        this.y = Math.min(this.rows - 1, n - 1);
+        break;
+      case "H":
+      case "f":
+        this.y = Math.min(this.rows - 1, Math.max(1, first) - 1);
+        this.x = Math.min(this.cols - 1, Math.max(1, second) - 1);
+        break;
+      case "J":
+        if (first === 0) {
+          this.eraseLine(this.y, this.x, this.cols);
+          for (let y = this.y + 1; y < this.rows; y++) {
+            this.eraseLine(y, 0, this.cols);
+          }
+        } else if (first === 1) {
+          for (let y = 0; y < this.y; y++) {
+            this.eraseLine(y, 0, this.cols);
+          }
+          this.eraseLine(this.y, 0, this.x + 1);
+        } else {
+          this.clear(false);
+        }
+        break;
+      case "K":
+        if (first === 0) {
+          this.eraseLine(this.y, this.x, this.cols);
+        } else if (first === 1) {
+          this.eraseLine(this.y, 0, this.x + 1);
+        } else {
+          this.eraseLine(this.y, 0, this.cols);
+        }
+        break;
+      case "L":
+        this.lines.splice(this.y, 0, ...Array.from({ length: n }, () => blank(this.cols)));
+        this.lines.splice(this.rows);
+        break;
+      case "M":
+        this.lines.splice(this.y, n);
+        while (this.lines.length < this.rows) {
+          this.lines.push(blank(this.cols));
+        }
+        break;
+      case "P":
+        line.splice(this.x, n);
+        line.push(...blank(n));
+        break;
+      case "@":
+        line.splice(this.x, 0, ...blank(n));
+        line.splice(this.cols);
+        break;
+      case "h":
+      case "l":
+        // Full-screen programs switch to the alternate screen and back
+        if (priv && [47, 1047, 1049].includes(first)) {
+          if (final === "h" && !this.saved) {
+            this.saved = { lines: this.lines, x: this.x, y: this.y };
+            this.clear();
+          } else if (final === "l" && this.saved) {
+            ({ lines: this.lines, x: this.x, y: this.y } = this.saved);
+            this.saved = null;
+            this.resize(this.cols, this.rows);
+          }
+        }
+        break;
+    }
+  }
+
+  private lineFeed() {
+    if (this.y < this.rows - 1) {
+      this.y++;
+      return;
+    }
+    this.lines.shift();
+    this.lines.push(blank(this.cols));
+  }
+
+  private reverseLineFeed() {
+    if (this.y > 0) {
+      this.y--;
+      return;
+    }
+    this.lines.pop();
+    this.lines.unshift(blank(this.cols));
+  }
+
+  private eraseLine(y: number, from: number, to: number) {
+    const line = this.lines[y];
+    for (let x = from; line && x < Math.min(to, this.cols); x++) {
+      line[x] = " ";
+    }
+  }
+
+  private clear(home = true) {
+    this.lines = Array.from({ length: this.rows }, () => blank(this.cols));
+    if (home) {
+      this.x = 0;
+      this.y = 0;
+    }
+  }
+}
+
+function blank(n: number): string[] {
+  return new Array(Math.max(0, n)).fill(" ");
+}
+
+let socket: WebSocket | null = null;
+
+export function toggleTerminal(siteId: string) {
+  const panel = document.getElementById("terminal");
+  const view = document.getElementById("terminal-screen");
+  const button = document.getElementById("terminal-toggle");
+  if (!panel || !view) {
+    return;
+  }
+
+  if (socket) {
+    socket.close();
+    return;
+  }
+
+  panel.classList.remove("hidden");
+  const screen = new Screen(fitColumns(view), ROWS);
+  if (button) {
+    button.textContent = "Close Terminal";
+  }
+
+  const scheme = window.location.protocol === "https:" ? "wss" : "ws";
+  const url = `${scheme}://${window.location.host}/sites/${encodeURIComponent(siteId)}/terminal?cols=${screen.cols}&rows=${screen.rows}`;
+  const ws = new WebSocket(url);
+  ws.binaryType = "arraybuffer";
+  socket = ws;
+
+  const decoder = new TextDecoder();
+  let frame = 0;
+  const render = () => {
+    frame = 0;
+    renderScreen(view, screen);
+  };
+  const send = (data: string) => {
+    if (ws.readyState === WebSocket.OPEN) {
+      ws.send(JSON.stringify({ type: "input", data }));
+    }
+  };
+
+  const onKey = (e: KeyboardEvent) => {
+    if (e.metaKey) {
+      return;
+    }
+    let data = KEYS[e.key];
+    if (e.ctrlKey && e.key.length === 1 && /[a-z]/i.test(e.key)) {
+      data = String.fromCharCode(e.key.toUpperCase().charCodeAt(0) - 64);
+    } else if (!data && e.key.length === 1 && !e.ctrlKey) {
+      data = e.key;
+    }
+    if (data) {
+      e.preventDefault();
+      send(data);
+    }
+  };
+  const onPaste = (e: ClipboardEvent) => {
+    e.preventDefault();
+    send(e.clipboardData?.getData("text") ?? "");
+  };
+  const onResize = () => {
+    const cols = fitColumns(view);
+    if (cols !== screen.cols && ws.readyState === WebSocket.OPEN) {
+      screen.resize(cols, ROWS);
+      ws.send(JSON.stringify({ type: "resize", cols, rows: ROWS }));
+      renderScreen(view, screen);
+    }
+  };
+
+  view.addEventListener("keydown", onKey);
+  view.addEventListener("paste", onPaste);
+  window.addEventListener("resize", onResize);
+
+  ws.onmessage = (e) => {
+    screen.write(decoder.decode(e.data as ArrayBuffer, { stream: true }));
+    if (!frame) {
+      frame = window.requestAnimationFrame(render);
+    }
+  };
+  ws.onclose = (e) => {
+    view.removeEventListener("keydown", onKey);
+    view.removeEventListener("paste", onPaste);
+    window.removeEventListener("resize", onResize);
+    socket = null;
+    if (button) {
+      button.textContent = "Open Terminal";
+    }
+    if (e.code !== 1000 && e.code !== 1005) {
+      showNotification("error", "The terminal session ended unexpectedly");
+    }
+    screen.write("\r\n\x1b[2m[session closed]\x1b[0m");
+    renderScreen(view, screen);
+  };
+
+  renderScreen(view, screen);
+  view.focus();
+}
+
+// fitColumns is how many characters fit across the terminal's width
+function fitColumns(view: HTMLElement): number {
+  const probe = document.createElement("span");
+  probe.textContent = "0".repeat(10);
+  view.appendChild(probe);
+  const charWidth = probe.getBoundingClientRect().width / 10 || 7;
+  view.removeChild(probe);
+  return Math.max(20, Math.floor(view.clientWidth / charWidth));
+}
+
+function renderScreen(view: HTMLElement, screen: Screen) {
+  const before = screen.lines.slice(0, screen.y).map((line) => line.join("")).join("\n");
+  const line = screen.lines[screen.y] ?? [];
+  const cursor = document.createElement("span");
+  cursor.className = "bg-gray-100 text-gray-900";
+  cursor.textContent = line[screen.x] ?? " ";
+
+  view.replaceChildren(
+    document.createTextNode((before ? before + "\n" : "") + line.slice(0, screen.x).join("")),
+    cursor,
+    document.createTextNode(
+      line.slice(screen.x + 1).join("") + "\n" + screen.lines.slice(screen.y + 1).map((l) => l.join("")).join("\n"),
+    ),
+  );
+}